@@ -13,6 +13,7 @@ import (
 	"monorepo/bin-common-handler/pkg/notifyhandler"
 	"monorepo/bin-common-handler/pkg/requesthandler"
 	"monorepo/bin-common-handler/pkg/sockhandler"
+	"monorepo/bin-common-handler/pkg/tracehandler"
 
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/pkg/errors"
@@ -65,6 +66,10 @@ func runDaemon() error {
 	log := logrus.WithField("func", "runDaemon")
 	log.WithField("config", config.Get()).Info("Starting agent-manager...")
 
+	// init tracing
+	tracehandler.Init(serviceName)
+	defer tracehandler.Shutdown()
+
 	sqlDB, err := commondatabasehandler.Connect(config.Get().DatabaseDSN)
 	if err != nil {
 		return errors.Wrapf(err, "could not connect to the database")
@@ -88,7 +93,7 @@ func runDaemon() error {
 func runServices(sqlDB *sql.DB, cache cachehandler.CacheHandler) error {
 	db := dbhandler.NewHandler(sqlDB, cache)
 
-	sockHandler := sockhandler.NewSockHandler(sock.TypeRabbitMQ, config.Get().RabbitMQAddress)
+	sockHandler := sockhandler.NewSockHandler(sock.Type(config.Get().SockType), config.Get().RabbitMQAddress)
 	sockHandler.Connect()
 
 	reqHandler := requesthandler.NewRequestHandler(sockHandler, serviceName)
@@ -15,6 +15,7 @@ import (
 
 	"monorepo/bin-common-handler/models/sock"
 	"monorepo/bin-common-handler/pkg/sockhandler"
+	"monorepo/bin-common-handler/pkg/tracehandler"
 	"monorepo/bin-common-handler/pkg/utilhandler"
 
 	"github.com/sirupsen/logrus"
@@ -145,7 +146,8 @@ func (h *listenHandler) processRequest(m *sock.Request) (*sock.Response, error)
 	var err error
 	var response *sock.Response
 
-	ctx := context.Background()
+	ctx, span := tracehandler.StartServerSpan(context.Background(), m)
+	defer span.End()
 	log := logrus.WithFields(logrus.Fields{
 		"request": m,
 	})
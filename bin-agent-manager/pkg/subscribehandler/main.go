@@ -12,6 +12,7 @@ import (
 	commonoutline "monorepo/bin-common-handler/models/outline"
 	"monorepo/bin-common-handler/models/sock"
 	"monorepo/bin-common-handler/pkg/sockhandler"
+	"monorepo/bin-common-handler/pkg/tracehandler"
 
 	cmcustomer "monorepo/bin-customer-manager/models/customer"
 
@@ -122,7 +123,8 @@ func (h *subscribeHandler) processEvent(m *sock.Event) {
 		"func":    "processEvent",
 		"message": m,
 	})
-	ctx := context.Background()
+	ctx, span := tracehandler.StartConsumerSpan(context.Background(), m)
+	defer span.End()
 	log.WithField("event", m).Debugf("Received subscribed event. publisher: %s, type: %s", m.Publisher, m.Type)
 
 	var err error
@@ -238,7 +238,8 @@ func runCreate(cmd *cobra.Command, args []string) error {
 		nil, // toolNames - nil means default (all tools)
 		vadConfig,
 		smartTurnEnabled,
-		false, // autoAICallAuditEnabled - not supported via CLI yet
+		false,               // autoAICallAuditEnabled - not supported via CLI yet
+		aihandler.Options{}, // engine fallbacks, redaction, guardrail, response cache - not supported via CLI yet
 	)
 	if err != nil {
 		return errors.Wrap(err, "failed to create AI")
@@ -362,7 +363,8 @@ func runUpdate(cmd *cobra.Command, args []string) error {
 		nil, // toolNames - nil keeps existing value
 		vadConfig,
 		smartTurnEnabled,
-		false, // autoAICallAuditEnabled - not supported via CLI yet
+		false,               // autoAICallAuditEnabled - not supported via CLI yet
+		aihandler.Options{}, // engine fallbacks, redaction, guardrail, response cache - not supported via CLI yet
 	)
 	if err != nil {
 		return errors.Wrap(err, "failed to update AI")
@@ -416,7 +418,7 @@ func initCache() (cachehandler.CacheHandler, error) {
 
 func initAIHandler(sqlDB *sql.DB, cache cachehandler.CacheHandler) (aihandler.AIHandler, error) {
 	db := dbhandler.NewHandler(sqlDB, cache)
-	sockHandler := sockhandler.NewSockHandler(sock.TypeRabbitMQ, config.Get().RabbitMQAddress)
+	sockHandler := sockhandler.NewSockHandler(sock.Type(config.Get().SockType), config.Get().RabbitMQAddress)
 	sockHandler.Connect()
 
 	reqHandler := requesthandler.NewRequestHandler(sockHandler, serviceName)
@@ -462,14 +464,14 @@ func initAIcallHandler() (aicallhandler.AIcallHandler, error) {
 	}
 
 	dbHandler := dbhandler.NewHandler(db, cache)
-	sockHandler := sockhandler.NewSockHandler(sock.TypeRabbitMQ, config.Get().RabbitMQAddress)
+	sockHandler := sockhandler.NewSockHandler(sock.Type(config.Get().SockType), config.Get().RabbitMQAddress)
 	sockHandler.Connect()
 
 	reqHandler := requesthandler.NewRequestHandler(sockHandler, serviceName)
 	notifyHandler := notifyhandler.NewNotifyHandler(sockHandler, reqHandler, commonoutline.QueueNameAIEvent, serviceName)
 
 	// For these operations, we don't need aiHandler, messageHandler, or participantHandler
-	return aicallhandler.NewAIcallHandler(reqHandler, notifyHandler, dbHandler, nil, nil, nil, nil), nil
+	return aicallhandler.NewAIcallHandler(reqHandler, notifyHandler, dbHandler, nil, nil, nil, nil, nil, nil, nil, nil, nil), nil
 }
 
 func cmdAIcallGet() *cobra.Command {
@@ -15,6 +15,7 @@ import (
 	"monorepo/bin-common-handler/pkg/notifyhandler"
 	"monorepo/bin-common-handler/pkg/requesthandler"
 	"monorepo/bin-common-handler/pkg/sockhandler"
+	"monorepo/bin-common-handler/pkg/tracehandler"
 	"monorepo/bin-common-handler/pkg/utilhandler"
 
 	_ "github.com/go-sql-driver/mysql"
@@ -22,24 +23,32 @@ import (
 	"github.com/spf13/cobra"
 
 	"monorepo/bin-ai-manager/internal/config"
+	"monorepo/bin-ai-manager/pkg/aiassisthandler"
 	"monorepo/bin-ai-manager/pkg/aicallhandler"
 	"monorepo/bin-ai-manager/pkg/aiaudithandler"
 	"monorepo/bin-ai-manager/pkg/aihandler"
 	"monorepo/bin-ai-manager/pkg/aiprompthistoryhandler"
 	"monorepo/bin-ai-manager/pkg/aipromptproposalhandler"
+	"monorepo/bin-ai-manager/pkg/customtoolhandler"
+	"monorepo/bin-ai-manager/pkg/mcpserverhandler"
 	"monorepo/bin-ai-manager/pkg/analysishandler"
 	"monorepo/bin-ai-manager/pkg/cachehandler"
 	"monorepo/bin-ai-manager/pkg/dbhandler"
 	"monorepo/bin-ai-manager/pkg/engine_dialogflow_handler"
 	"monorepo/bin-ai-manager/pkg/engine_openai_handler"
+	"monorepo/bin-ai-manager/pkg/extractionhandler"
+	"monorepo/bin-ai-manager/pkg/guardrailhandler"
 	"monorepo/bin-ai-manager/pkg/geminiaudithandler"
 	"monorepo/bin-ai-manager/pkg/geminiproposalhandler"
 	"monorepo/bin-ai-manager/pkg/listenhandler"
 	"monorepo/bin-ai-manager/pkg/messagehandler"
 	"monorepo/bin-ai-manager/pkg/participanthandler"
+	"monorepo/bin-ai-manager/pkg/redactionhandler"
+	"monorepo/bin-ai-manager/pkg/responsecachehandler"
 	"monorepo/bin-ai-manager/pkg/subscribehandler"
 	"monorepo/bin-ai-manager/pkg/summaryhandler"
 	"monorepo/bin-ai-manager/pkg/teamhandler"
+	"monorepo/bin-ai-manager/pkg/testsuitehandler"
 	"monorepo/bin-ai-manager/pkg/toolhandler"
 )
 
@@ -74,6 +83,10 @@ func runDaemon() error {
 
 	cfg := config.Get()
 
+	// init tracing
+	tracehandler.Init(serviceName)
+	defer tracehandler.Shutdown()
+
 	// connect to database
 	sqlDB, err := commondatabasehandler.Connect(cfg.DatabaseDSN)
 	if err != nil {
@@ -113,7 +126,7 @@ func run(sqlDB *sql.DB, cache cachehandler.CacheHandler) error {
 	db := dbhandler.NewHandler(sqlDB, cache)
 
 	// rabbitmq sock connect
-	sockHandler := sockhandler.NewSockHandler(sock.TypeRabbitMQ, cfg.RabbitMQAddress)
+	sockHandler := sockhandler.NewSockHandler(sock.Type(cfg.SockType), cfg.RabbitMQAddress)
 	sockHandler.Connect()
 
 	requestHandler := requesthandler.NewRequestHandler(sockHandler, serviceName)
@@ -121,13 +134,18 @@ func run(sqlDB *sql.DB, cache cachehandler.CacheHandler) error {
 
 	aiHandler := aihandler.NewAIHandler(requestHandler, notifyHandler, db)
 	teamHandler := teamhandler.NewTeamHandler(requestHandler, notifyHandler, db)
+	customToolHandler := customtoolhandler.NewCustomToolHandler(requestHandler, notifyHandler, db)
+	mcpServerHandler := mcpserverhandler.NewMCPServerHandler(notifyHandler, db)
 
 	engineOpenaiHandler := engine_openai_handler.NewEngineOpenaiHandler(cfg.EngineKeyChatGPT)
 	engineDialogflowHandler := engine_dialogflow_handler.NewEngineDialogflowHandler()
 
 	participantHandler := participanthandler.New(db)
-	messageHandler := messagehandler.NewMessageHandler(requestHandler, notifyHandler, db, engineOpenaiHandler, engineDialogflowHandler, participantHandler)
-	aicallHandler := aicallhandler.NewAIcallHandler(requestHandler, notifyHandler, db, aiHandler, teamHandler, messageHandler, participantHandler)
+	redactionHandler := redactionhandler.NewRedactionHandler(requestHandler, db)
+	messageHandler := messagehandler.NewMessageHandler(requestHandler, notifyHandler, db, engineOpenaiHandler, engineDialogflowHandler, participantHandler, redactionHandler)
+	guardrailHandler := guardrailhandler.NewGuardrailHandler(notifyHandler, db)
+	responseCacheHandler := responsecachehandler.NewResponseCacheHandler(requestHandler, db)
+	aicallHandler := aicallhandler.NewAIcallHandler(requestHandler, notifyHandler, db, aiHandler, teamHandler, messageHandler, participantHandler, customToolHandler, mcpServerHandler, redactionHandler, guardrailHandler, responseCacheHandler)
 	summaryHandler := summaryhandler.NewSummaryHandler(requestHandler, notifyHandler, db, engineOpenaiHandler)
 
 	// Build a dedicated engine for the analysis gateway. The provider is
@@ -155,6 +173,10 @@ func run(sqlDB *sql.DB, cache cachehandler.CacheHandler) error {
 	if cfg.GoogleAPIKey == "" {
 		logrus.Error("GOOGLE_API_KEY is not configured; all Gemini audit requests will fail with evaluator_unavailable")
 	}
+	extractionHandler := extractionhandler.NewExtractionHandler(requestHandler, notifyHandler, db, analysisHandler)
+	aiassistHandler := aiassisthandler.NewAIAssistHandler(requestHandler, notifyHandler, db, aiHandler, analysisHandler)
+	testSuiteHandler := testsuitehandler.NewTestSuiteHandler(notifyHandler, db, aiHandler, aicallHandler, analysisHandler)
+
 	aiauditHandler := aiaudithandler.NewAIAuditHandler(db, geminiaudithandler.NewGeminiAuditHandler(cfg.GoogleAPIKey))
 	aiauditHandler.SweepStaleAudits(context.Background())
 
@@ -163,13 +185,13 @@ func run(sqlDB *sql.DB, cache cachehandler.CacheHandler) error {
 	aipromptproposalHandler.SweepStaleProposals(context.Background())
 
 	// run listen
-	if errListen := runListen(sockHandler, aiHandler, aicallHandler, aiauditHandler, aiprompthistoryHandler, aipromptproposalHandler, messageHandler, summaryHandler, teamHandler, participantHandler, analysisHandler); errListen != nil {
+	if errListen := runListen(sockHandler, aiHandler, aicallHandler, aiauditHandler, aiprompthistoryHandler, aipromptproposalHandler, messageHandler, summaryHandler, extractionHandler, aiassistHandler, guardrailHandler, teamHandler, customToolHandler, mcpServerHandler, testSuiteHandler, participantHandler, analysisHandler); errListen != nil {
 		log.Errorf("Could not start runListen. err: %v", errListen)
 		return errListen
 	}
 
 	// run subscribe
-	if errSubscribe := runSubscribe(sockHandler, aicallHandler, summaryHandler, messageHandler); errSubscribe != nil {
+	if errSubscribe := runSubscribe(sockHandler, aicallHandler, summaryHandler, extractionHandler, messageHandler, aiassistHandler); errSubscribe != nil {
 		log.Errorf("Could not start runSubscribe. err: %v", errSubscribe)
 		return errSubscribe
 	}
@@ -182,7 +204,9 @@ func runSubscribe(
 	sockHandler sockhandler.SockHandler,
 	aicallHandler aicallhandler.AIcallHandler,
 	summaryHandler summaryhandler.SummaryHandler,
+	extractionHandler extractionhandler.ExtractionHandler,
 	messageHandler messagehandler.MessageHandler,
+	aiassistHandler aiassisthandler.AIAssistHandler,
 ) error {
 
 	subscribeTargets := []string{
@@ -199,7 +223,9 @@ func runSubscribe(
 		subscribeTargets,
 		aicallHandler,
 		summaryHandler,
+		extractionHandler,
 		messageHandler,
+		aiassistHandler,
 	)
 
 	// run
@@ -221,7 +247,13 @@ func runListen(
 	aipromptproposalHandler aipromptproposalhandler.AIPromptProposalHandler,
 	messageHandler messagehandler.MessageHandler,
 	summaryHandler summaryhandler.SummaryHandler,
+	extractionHandler extractionhandler.ExtractionHandler,
+	aiassistHandler aiassisthandler.AIAssistHandler,
+	guardrailHandler guardrailhandler.GuardrailHandler,
 	teamHandler teamhandler.TeamHandler,
+	customToolHandler customtoolhandler.CustomToolHandler,
+	mcpServerHandler mcpserverhandler.MCPServerHandler,
+	testSuiteHandler testsuitehandler.TestSuiteHandler,
 	participantHandler participanthandler.ParticipantHandler,
 	analysisHandler analysishandler.AnalysisHandler,
 ) error {
@@ -240,8 +272,14 @@ func runListen(
 		aipromptproposalHandler,
 		messageHandler,
 		summaryHandler,
+		extractionHandler,
+		aiassistHandler,
+		guardrailHandler,
 		toolHandler,
 		teamHandler,
+		customToolHandler,
+		mcpServerHandler,
+		testSuiteHandler,
 		participantHandler,
 		analysisHandler,
 	)
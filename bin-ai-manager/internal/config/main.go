@@ -21,6 +21,7 @@ var (
 // flags and environment variables for the service.
 type Config struct {
 	RabbitMQAddress         string // RabbitMQAddress is the address (including host and port) of the RabbitMQ server.
+	SockType                string // SockType is the message broker type. rabbitMQ or nats.
 	PrometheusEndpoint      string // PrometheusEndpoint is the HTTP path at which Prometheus metrics are exposed.
 	PrometheusListenAddress string // PrometheusListenAddress is the network address on which the Prometheus metrics HTTP server listens (for example, ":8080").
 	DatabaseDSN             string // DatabaseDSN is the data source name used to connect to the primary database.
@@ -60,6 +61,7 @@ func bindConfig(cmd *cobra.Command) error {
 	f := cmd.PersistentFlags()
 
 	f.String("rabbitmq_address", "", "RabbitMQ server address")
+	f.String("sock_type", "rabbitMQ", "Message broker type. rabbitMQ or nats")
 	f.String("prometheus_endpoint", "", "Prometheus metrics endpoint")
 	f.String("prometheus_listen_address", "", "Prometheus listen address")
 	f.String("database_dsn", "", "Database connection DSN")
@@ -80,6 +82,7 @@ func bindConfig(cmd *cobra.Command) error {
 
 	bindings := map[string]string{
 		"rabbitmq_address":          "RABBITMQ_ADDRESS",
+		"sock_type":                 "SOCK_TYPE",
 		"prometheus_endpoint":       "PROMETHEUS_ENDPOINT",
 		"prometheus_listen_address": "PROMETHEUS_LISTEN_ADDRESS",
 		"database_dsn":              "DATABASE_DSN",
@@ -125,6 +128,7 @@ func LoadGlobalConfig() {
 	once.Do(func() {
 		globalConfig = Config{
 			RabbitMQAddress:         viper.GetString("rabbitmq_address"),
+			SockType:                viper.GetString("sock_type"),
 			PrometheusEndpoint:      viper.GetString("prometheus_endpoint"),
 			PrometheusListenAddress: viper.GetString("prometheus_listen_address"),
 			DatabaseDSN:             viper.GetString("database_dsn"),
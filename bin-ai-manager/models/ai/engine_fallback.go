@@ -0,0 +1,47 @@
+package ai
+
+import "fmt"
+
+// MaxEngineFallbacks is the maximum number of fallback engines an AI can have.
+const MaxEngineFallbacks = 3
+
+// EngineFallback is an alternative LLM engine the AI fails over to when the
+// engines before it in the chain are rate-limited or unavailable.
+type EngineFallback struct {
+	EngineModel EngineModel `json:"engine_model"`
+	EngineKey   string      `json:"engine_key,omitempty"`
+}
+
+// EngineChain returns the AI's engines in failover order: the primary
+// EngineModel/EngineKey first, followed by the EngineFallbacks.
+func (h *AI) EngineChain() []EngineFallback {
+	res := make([]EngineFallback, 0, len(h.EngineFallbacks)+1)
+	res = append(res, EngineFallback{
+		EngineModel: h.EngineModel,
+		EngineKey:   h.EngineKey,
+	})
+	res = append(res, h.EngineFallbacks...)
+
+	return res
+}
+
+// ValidateEngineFallbacks checks the fallback chain. The same model may appear
+// more than once with different keys, e.g. to rotate to a second account.
+func ValidateEngineFallbacks(fallbacks []EngineFallback) error {
+	if len(fallbacks) > MaxEngineFallbacks {
+		return fmt.Errorf("too many engine fallbacks. max: %d", MaxEngineFallbacks)
+	}
+
+	seen := map[EngineFallback]bool{}
+	for _, f := range fallbacks {
+		if !IsValidEngineModel(f.EngineModel) {
+			return fmt.Errorf("invalid fallback engine model: %s", f.EngineModel)
+		}
+		if seen[f] {
+			return fmt.Errorf("duplicated fallback engine: %s", f.EngineModel)
+		}
+		seen[f] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package ai
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValidateEngineFallbacks(t *testing.T) {
+	tests := []struct {
+		name      string
+		fallbacks []EngineFallback
+		wantError bool
+	}{
+		{
+			name:      "nil fallbacks are valid",
+			fallbacks: nil,
+		},
+		{
+			name: "valid fallbacks",
+			fallbacks: []EngineFallback{
+				{EngineModel: EngineModelOpenaiGPT5Mini, EngineKey: "key-1"},
+				{EngineModel: "gemini.gemini-2.0-flash"},
+			},
+		},
+		{
+			name: "same model with another key",
+			fallbacks: []EngineFallback{
+				{EngineModel: EngineModelOpenaiGPT5Mini, EngineKey: "key-1"},
+				{EngineModel: EngineModelOpenaiGPT5Mini, EngineKey: "key-2"},
+			},
+		},
+		{
+			name: "invalid model",
+			fallbacks: []EngineFallback{
+				{EngineModel: "gpt-4o"},
+			},
+			wantError: true,
+		},
+		{
+			name: "duplicated engine",
+			fallbacks: []EngineFallback{
+				{EngineModel: EngineModelOpenaiGPT5Mini, EngineKey: "key-1"},
+				{EngineModel: EngineModelOpenaiGPT5Mini, EngineKey: "key-1"},
+			},
+			wantError: true,
+		},
+		{
+			name: "too many fallbacks",
+			fallbacks: []EngineFallback{
+				{EngineModel: EngineModelOpenaiGPT5Mini, EngineKey: "key-1"},
+				{EngineModel: EngineModelOpenaiGPT5Mini, EngineKey: "key-2"},
+				{EngineModel: EngineModelOpenaiGPT5Mini, EngineKey: "key-3"},
+				{EngineModel: EngineModelOpenaiGPT5Mini, EngineKey: "key-4"},
+			},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateEngineFallbacks(tt.fallbacks)
+			if (err != nil) != tt.wantError {
+				t.Errorf("ValidateEngineFallbacks() error = %v, wantError %v", err, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestAI_EngineChain(t *testing.T) {
+	a := &AI{
+		EngineModel: EngineModelOpenaiGPT5,
+		EngineKey:   "primary-key",
+		EngineFallbacks: []EngineFallback{
+			{EngineModel: EngineModelOpenaiGPT5Mini, EngineKey: "fallback-key"},
+		},
+	}
+
+	expectRes := []EngineFallback{
+		{EngineModel: EngineModelOpenaiGPT5, EngineKey: "primary-key"},
+		{EngineModel: EngineModelOpenaiGPT5Mini, EngineKey: "fallback-key"},
+	}
+
+	res := a.EngineChain()
+	if !reflect.DeepEqual(res, expectRes) {
+		t.Errorf("Wrong match.\nexpect: %v\ngot: %v", expectRes, res)
+	}
+}
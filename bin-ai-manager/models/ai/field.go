@@ -19,6 +19,8 @@ const (
 	FieldEngineKey   Field = "engine_key"
 	FieldRagID       Field = "rag_id"
 
+	FieldEngineFallbacks Field = "engine_fallbacks"
+
 	FieldInitPrompt Field = "init_prompt"
 
 	FieldCurrentPromptHistoryID Field = "current_prompt_history_id"
@@ -33,6 +35,11 @@ const (
 
 	FieldAutoAICallAuditEnabled Field = "auto_aicall_audit_enabled"
 
+	FieldRedaction Field = "redaction"
+	FieldGuardrail Field = "guardrail"
+
+	FieldResponseCache Field = "response_cache"
+
 	FieldToolNames Field = "tool_names"
 
 	FieldDirectID   Field = "direct_id"
@@ -8,6 +8,9 @@ import (
 
 	"github.com/gofrs/uuid"
 
+	"monorepo/bin-ai-manager/models/guardrail"
+	"monorepo/bin-ai-manager/models/redaction"
+	"monorepo/bin-ai-manager/models/responsecache"
 	"monorepo/bin-ai-manager/models/tool"
 	"monorepo/bin-common-handler/models/identity"
 )
@@ -67,6 +70,9 @@ type AI struct {
 	EngineKey   string         `json:"engine_key,omitempty" db:"engine_key"` // ai(llm) service api key
 	RagID       uuid.UUID      `json:"rag_id,omitempty" db:"rag_id,uuid"`
 
+	// EngineFallbacks are tried in order when the primary engine fails.
+	EngineFallbacks []EngineFallback `json:"engine_fallbacks,omitempty" db:"engine_fallbacks,json"`
+
 	InitPrompt string `json:"init_prompt,omitempty" db:"init_prompt"`
 
 	CurrentPromptHistoryID uuid.UUID `json:"current_prompt_history_id" db:"current_prompt_history_id,uuid"`
@@ -83,6 +89,17 @@ type AI struct {
 	// trigger an AICall audit automatically.
 	AutoAICallAuditEnabled bool `json:"auto_aicall_audit_enabled,omitempty" db:"auto_aicall_audit_enabled"`
 
+	// Redaction defines the personal data redacted from the AI's aicalls.
+	// nil falls back to the customer's default.
+	Redaction *redaction.Config `json:"redaction,omitempty" db:"redaction,json"`
+
+	// Guardrail defines the policy the AI's output is checked against.
+	Guardrail *guardrail.Config `json:"guardrail,omitempty" db:"guardrail,json"`
+
+	// ResponseCache defines the semantic cache of the AI's responses.
+	// nil disables the cache.
+	ResponseCache *responsecache.Config `json:"response_cache,omitempty" db:"response_cache,json"`
+
 	// ToolNames defines which tools are enabled for this AI
 	// ["all"] = all tools, ["connect_call", "send_email"] = specific tools, [] or nil = no tools
 	ToolNames []tool.ToolName `json:"tool_names,omitempty" db:"tool_names,json"`
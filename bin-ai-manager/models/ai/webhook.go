@@ -8,6 +8,9 @@ import (
 
 	commonidentity "monorepo/bin-common-handler/models/identity"
 
+	"monorepo/bin-ai-manager/models/guardrail"
+	"monorepo/bin-ai-manager/models/redaction"
+	"monorepo/bin-ai-manager/models/responsecache"
 	"monorepo/bin-ai-manager/models/tool"
 )
 
@@ -29,6 +32,8 @@ type WebhookMessage struct {
 	EngineKey   string         `json:"engine_key,omitempty"`
 	RagID       uuid.UUID      `json:"rag_id,omitempty"`
 
+	EngineFallbacks []EngineFallback `json:"engine_fallbacks,omitempty"`
+
 	InitPrompt             string    `json:"init_prompt,omitempty"`
 	CurrentPromptHistoryID uuid.UUID `json:"current_prompt_history_id"`
 
@@ -42,6 +47,11 @@ type WebhookMessage struct {
 
 	AutoAICallAuditEnabled bool `json:"auto_aicall_audit_enabled,omitempty"`
 
+	Redaction *redaction.Config `json:"redaction,omitempty"`
+	Guardrail *guardrail.Config `json:"guardrail,omitempty"`
+
+	ResponseCache *responsecache.Config `json:"response_cache,omitempty"`
+
 	ToolNames []tool.ToolName `json:"tool_names,omitempty"`
 
 	DirectHash string `json:"direct_hash,omitempty"`
@@ -68,6 +78,8 @@ func (h *AI) ConvertWebhookMessage() *WebhookMessage {
 		EngineKey:   h.EngineKey,
 		RagID:       h.RagID,
 
+		EngineFallbacks: h.EngineFallbacks,
+
 		InitPrompt:             h.InitPrompt,
 		CurrentPromptHistoryID: h.CurrentPromptHistoryID,
 
@@ -81,6 +93,11 @@ func (h *AI) ConvertWebhookMessage() *WebhookMessage {
 
 		AutoAICallAuditEnabled: h.AutoAICallAuditEnabled,
 
+		Redaction: h.Redaction,
+		Guardrail: h.Guardrail,
+
+		ResponseCache: h.ResponseCache,
+
 		ToolNames: h.ToolNames,
 
 		DirectHash: h.DirectHash,
@@ -0,0 +1,12 @@
+package aiassist
+
+// list of event types
+const (
+	EventTypeCreated string = "aiassist_created" // the aiassist has created
+	EventTypeUpdated string = "aiassist_updated" // the aiassist has updated
+	EventTypeDeleted string = "aiassist_deleted" // the aiassist has deleted
+
+	EventTypeStatusTerminated string = "aiassist_status_terminated" // the aiassist has terminated. carries the final usage.
+
+	EventTypeSuggestionCreated string = "aiassist_suggestion_created" // the aiassist has a new suggestion for the agent
+)
@@ -0,0 +1,37 @@
+package aiassist
+
+// Field represents AIAssist field for database queries
+type Field string
+
+// List of fields
+const (
+	FieldID         Field = "id"
+	FieldCustomerID Field = "customer_id"
+
+	FieldOwnerType Field = "owner_type"
+	FieldOwnerID   Field = "owner_id"
+
+	FieldAIID Field = "ai_id"
+
+	FieldReferenceType Field = "reference_type"
+	FieldReferenceID   Field = "reference_id"
+	FieldTranscribeID  Field = "transcribe_id"
+
+	FieldStatus   Field = "status"
+	FieldLanguage Field = "language"
+
+	FieldChecklist Field = "checklist"
+	FieldSentiment Field = "sentiment"
+	FieldSummary   Field = "summary"
+
+	FieldPromptTokens     Field = "prompt_tokens"
+	FieldCompletionTokens Field = "completion_tokens"
+	FieldCachedTokens     Field = "cached_tokens"
+	FieldEngineModel      Field = "engine_model"
+
+	FieldTMCreate Field = "tm_create"
+	FieldTMUpdate Field = "tm_update"
+	FieldTMDelete Field = "tm_delete"
+
+	FieldDeleted Field = "deleted"
+)
@@ -0,0 +1,16 @@
+package aiassist
+
+import "github.com/gofrs/uuid"
+
+// FieldStruct defines allowed filters for AIAssist queries
+// Each field corresponds to a filterable database column
+type FieldStruct struct {
+	CustomerID    uuid.UUID     `filter:"customer_id"`
+	OwnerID       uuid.UUID     `filter:"owner_id"`
+	AIID          uuid.UUID     `filter:"ai_id"`
+	ReferenceType ReferenceType `filter:"reference_type"`
+	ReferenceID   uuid.UUID     `filter:"reference_id"`
+	TranscribeID  uuid.UUID     `filter:"transcribe_id"`
+	Status        Status        `filter:"status"`
+	Deleted       bool          `filter:"deleted"`
+}
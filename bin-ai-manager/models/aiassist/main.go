@@ -0,0 +1,106 @@
+package aiassist
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"monorepo/bin-ai-manager/models/ai"
+	"monorepo/bin-ai-manager/models/usage"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+
+	"github.com/gofrs/uuid"
+)
+
+// AIAssist is a real-time assist session for a human agent on a call.
+// It follows the call's live transcripts and keeps the agent posted with
+// knowledge base answers, next best action hints, the compliance checklist
+// progress and the customer's sentiment. Unlike the aicall, it never speaks
+// into the call.
+type AIAssist struct {
+	commonidentity.Identity
+	commonidentity.Owner // the assisted agent. the assist's events are sent to the owner's websocket.
+
+	AIID uuid.UUID `json:"ai_id,omitempty" db:"ai_id,uuid"` // the AI's init prompt guides the suggestions and its rag answers the knowledge questions.
+
+	ReferenceType ReferenceType `json:"reference_type,omitempty" db:"reference_type"`
+	ReferenceID   uuid.UUID     `json:"reference_id,omitempty" db:"reference_id,uuid"`
+	TranscribeID  uuid.UUID     `json:"transcribe_id,omitempty" db:"transcribe_id,uuid"`
+
+	Status   Status `json:"status,omitempty" db:"status"`
+	Language string `json:"language,omitempty" db:"language"`
+
+	Checklist []ChecklistItem `json:"checklist,omitempty" db:"checklist,json"` // the compliance items the agent has to cover in the call.
+	Sentiment Sentiment       `json:"sentiment,omitempty" db:"sentiment"`      // the customer's latest sentiment.
+
+	Summary string `json:"summary,omitempty" db:"summary"` // the running summary of the conversation. sent to the LLM with the latest transcripts.
+
+	// Usage is the LLM usage of the assist. It is billed by the engine model
+	// when the assist is terminated.
+	usage.Usage `json:"usage,omitzero"`
+	EngineModel ai.EngineModel `json:"engine_model,omitempty" db:"engine_model"`
+
+	TMCreate *time.Time `json:"tm_create" db:"tm_create"`
+	TMUpdate *time.Time `json:"tm_update" db:"tm_update"`
+	TMDelete *time.Time `json:"tm_delete" db:"tm_delete"`
+}
+
+// ChecklistItem is a compliance item of the checklist.
+type ChecklistItem struct {
+	Name string `json:"name"`
+	Done bool   `json:"done"`
+}
+
+// ReferenceType defines the assisted resource.
+type ReferenceType string
+
+// list of reference types
+const (
+	ReferenceTypeNone ReferenceType = ""
+	ReferenceTypeCall ReferenceType = "call"
+)
+
+// Status defines the assist status.
+type Status string
+
+// list of statuses
+const (
+	StatusNone        Status = ""
+	StatusProgressing Status = "progressing"
+	StatusTerminated  Status = "terminated"
+)
+
+// Sentiment defines the customer's sentiment.
+type Sentiment string
+
+// list of sentiments
+const (
+	SentimentNone     Sentiment = ""
+	SentimentPositive Sentiment = "positive"
+	SentimentNeutral  Sentiment = "neutral"
+	SentimentNegative Sentiment = "negative"
+)
+
+// list of limits
+const (
+	MaxChecklistItems      = 20
+	MaxChecklistItemLength = 200
+)
+
+// ValidateChecklist checks the checklist. An empty checklist is valid.
+func ValidateChecklist(items []ChecklistItem) error {
+	if len(items) > MaxChecklistItems {
+		return fmt.Errorf("too many checklist items. max: %d", MaxChecklistItems)
+	}
+
+	for _, item := range items {
+		if strings.TrimSpace(item.Name) == "" {
+			return fmt.Errorf("empty checklist item")
+		}
+		if len(item.Name) > MaxChecklistItemLength {
+			return fmt.Errorf("checklist item exceeds %d characters: %s", MaxChecklistItemLength, item.Name)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package aiassist
+
+import (
+	"strings"
+	"testing"
+)
+
+func Test_ValidateChecklist(t *testing.T) {
+	tests := []struct {
+		name      string
+		items     []ChecklistItem
+		wantError bool
+	}{
+		{
+			name:  "empty checklist is valid",
+			items: nil,
+		},
+		{
+			name: "valid checklist",
+			items: []ChecklistItem{
+				{Name: "verify identity"},
+				{Name: "read the disclosure"},
+			},
+		},
+		{
+			name: "empty item",
+			items: []ChecklistItem{
+				{Name: " "},
+			},
+			wantError: true,
+		},
+		{
+			name: "too long item",
+			items: []ChecklistItem{
+				{Name: strings.Repeat("a", MaxChecklistItemLength+1)},
+			},
+			wantError: true,
+		},
+		{
+			name:      "too many items",
+			items:     make([]ChecklistItem, MaxChecklistItems+1),
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateChecklist(tt.items)
+			if (err != nil) != tt.wantError {
+				t.Errorf("Wrong match. want error: %v, got: %v", tt.wantError, err)
+			}
+		})
+	}
+}
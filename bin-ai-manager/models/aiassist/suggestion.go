@@ -0,0 +1,52 @@
+package aiassist
+
+import (
+	"encoding/json"
+	"time"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+
+	"github.com/gofrs/uuid"
+)
+
+// Suggestion is a hint for the assisted agent. Suggestions are delivered as
+// events only and are not stored.
+type Suggestion struct {
+	commonidentity.Identity
+	commonidentity.Owner
+
+	AIAssistID   uuid.UUID `json:"aiassist_id"`
+	TranscriptID uuid.UUID `json:"transcript_id,omitempty"` // the transcript that triggered the suggestion.
+
+	Type    SuggestionType     `json:"type"`
+	Content string             `json:"content"`
+	Sources []SuggestionSource `json:"sources,omitempty"` // valid only for the knowledge type.
+
+	TMCreate *time.Time `json:"tm_create"`
+}
+
+// SuggestionType defines the type of the suggestion.
+type SuggestionType string
+
+// list of suggestion types
+const (
+	SuggestionTypeKnowledge      SuggestionType = "knowledge"        // an answer from the AI's knowledge base.
+	SuggestionTypeNextBestAction SuggestionType = "next_best_action" // what the agent should do or say next.
+)
+
+// SuggestionSource is the knowledge base document the suggestion came from.
+type SuggestionSource struct {
+	DocumentName   string  `json:"document_name"`
+	SectionTitle   string  `json:"section_title,omitempty"`
+	RelevanceScore float64 `json:"relevance_score"`
+}
+
+// CreateWebhookEvent generate WebhookEvent
+func (h *Suggestion) CreateWebhookEvent() ([]byte, error) {
+	m, err := json.Marshal(h)
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
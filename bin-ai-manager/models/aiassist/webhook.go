@@ -0,0 +1,71 @@
+package aiassist
+
+import (
+	"encoding/json"
+	"time"
+
+	"monorepo/bin-ai-manager/models/usage"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+
+	"github.com/gofrs/uuid"
+)
+
+// WebhookMessage defines webhook event
+type WebhookMessage struct {
+	commonidentity.Identity
+	commonidentity.Owner
+
+	AIID uuid.UUID `json:"ai_id,omitempty"`
+
+	ReferenceType ReferenceType `json:"reference_type,omitempty"`
+	ReferenceID   uuid.UUID     `json:"reference_id,omitempty"`
+
+	Status   Status `json:"status,omitempty"`
+	Language string `json:"language,omitempty"`
+
+	Checklist []ChecklistItem `json:"checklist,omitempty"`
+	Sentiment Sentiment       `json:"sentiment,omitempty"`
+
+	Usage usage.Usage `json:"usage,omitzero"`
+
+	TMCreate *time.Time `json:"tm_create"`
+	TMUpdate *time.Time `json:"tm_update"`
+	TMDelete *time.Time `json:"tm_delete"`
+}
+
+// ConvertWebhookMessage converts to the event
+func (h *AIAssist) ConvertWebhookMessage() *WebhookMessage {
+	return &WebhookMessage{
+		Identity: h.Identity,
+		Owner:    h.Owner,
+
+		AIID: h.AIID,
+
+		ReferenceType: h.ReferenceType,
+		ReferenceID:   h.ReferenceID,
+
+		Status:   h.Status,
+		Language: h.Language,
+
+		Checklist: h.Checklist,
+		Sentiment: h.Sentiment,
+
+		Usage: h.Usage,
+
+		TMCreate: h.TMCreate,
+		TMUpdate: h.TMUpdate,
+		TMDelete: h.TMDelete,
+	}
+}
+
+// CreateWebhookEvent generate WebhookEvent
+func (h *AIAssist) CreateWebhookEvent() ([]byte, error) {
+	e := h.ConvertWebhookMessage()
+
+	m, err := json.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
@@ -0,0 +1,132 @@
+package aiassist
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"monorepo/bin-ai-manager/models/usage"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+
+	"github.com/gofrs/uuid"
+)
+
+func Test_CreateWebhookEvent(t *testing.T) {
+	tests := []struct {
+		name string
+
+		aiassist *AIAssist
+
+		expectRes string
+	}{
+		{
+			name: "normal",
+
+			aiassist: &AIAssist{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("0a1c5e2e-b1f4-11f0-8a61-4f0b9c2d1e01"),
+					CustomerID: uuid.FromStringOrNil("0a4b7d3c-b1f4-11f0-9e27-13a8c6f4b202"),
+				},
+				Owner: commonidentity.Owner{
+					OwnerType: commonidentity.OwnerTypeAgent,
+					OwnerID:   uuid.FromStringOrNil("0a76e1f4-b1f4-11f0-b0c3-6f2d8e1a5c03"),
+				},
+				AIID:          uuid.FromStringOrNil("0aa2c8b6-b1f4-11f0-8d54-2b7e4f9c1a04"),
+				ReferenceType: ReferenceTypeCall,
+				ReferenceID:   uuid.FromStringOrNil("0acd91e8-b1f4-11f0-a1f6-7c3b5d8e2f05"),
+				TranscribeID:  uuid.FromStringOrNil("0af7a2d0-b1f4-11f0-9b38-1e6c4a7d3b06"),
+				Status:        StatusProgressing,
+				Language:      "en-US",
+				Checklist: []ChecklistItem{
+					{Name: "verify identity", Done: true},
+					{Name: "read the disclosure"},
+				},
+				Sentiment: SentimentNegative,
+				Usage: usage.Usage{
+					PromptTokens:     310,
+					CompletionTokens: 42,
+				},
+			},
+
+			expectRes: `{"id":"0a1c5e2e-b1f4-11f0-8a61-4f0b9c2d1e01","customer_id":"0a4b7d3c-b1f4-11f0-9e27-13a8c6f4b202","owner_type":"agent","owner_id":"0a76e1f4-b1f4-11f0-b0c3-6f2d8e1a5c03","ai_id":"0aa2c8b6-b1f4-11f0-8d54-2b7e4f9c1a04","reference_type":"call","reference_id":"0acd91e8-b1f4-11f0-a1f6-7c3b5d8e2f05","status":"progressing","language":"en-US","checklist":[{"name":"verify identity","done":true},{"name":"read the disclosure","done":false}],"sentiment":"negative","usage":{"prompt_tokens":310,"completion_tokens":42,"cached_tokens":0,"stt_seconds":0,"tts_seconds":0},"tm_create":null,"tm_update":null,"tm_delete":null}`,
+		},
+		{
+			name: "empty usage is omitted",
+
+			aiassist: &AIAssist{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("0b22d6a4-b1f4-11f0-8c7e-5d1f3b9a4e07"),
+				},
+				Status: StatusTerminated,
+			},
+
+			expectRes: `{"id":"0b22d6a4-b1f4-11f0-8c7e-5d1f3b9a4e07","customer_id":"00000000-0000-0000-0000-000000000000","owner_type":"","owner_id":"00000000-0000-0000-0000-000000000000","ai_id":"00000000-0000-0000-0000-000000000000","reference_id":"00000000-0000-0000-0000-000000000000","status":"terminated","tm_create":null,"tm_update":null,"tm_delete":null}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := tt.aiassist.CreateWebhookEvent()
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			var got, expect map[string]any
+			_ = json.Unmarshal(res, &got)
+			_ = json.Unmarshal([]byte(tt.expectRes), &expect)
+			if !reflect.DeepEqual(got, expect) {
+				t.Errorf("Wrong match.\nexpect: %s\ngot: %s", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_SuggestionCreateWebhookEvent(t *testing.T) {
+	tests := []struct {
+		name string
+
+		suggestion *Suggestion
+
+		expectRes string
+	}{
+		{
+			name: "knowledge",
+
+			suggestion: &Suggestion{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("0b4e1f9c-b1f4-11f0-a9d2-3c7a5e1b6f08"),
+					CustomerID: uuid.FromStringOrNil("0a4b7d3c-b1f4-11f0-9e27-13a8c6f4b202"),
+				},
+				Owner: commonidentity.Owner{
+					OwnerType: commonidentity.OwnerTypeAgent,
+					OwnerID:   uuid.FromStringOrNil("0a76e1f4-b1f4-11f0-b0c3-6f2d8e1a5c03"),
+				},
+				AIAssistID:   uuid.FromStringOrNil("0a1c5e2e-b1f4-11f0-8a61-4f0b9c2d1e01"),
+				TranscriptID: uuid.FromStringOrNil("0b79c3e2-b1f4-11f0-b6a5-4e2d8f1c7a09"),
+				Type:         SuggestionTypeKnowledge,
+				Content:      "Refunds are processed within 5 business days.",
+				Sources: []SuggestionSource{
+					{DocumentName: "refund-policy.pdf", SectionTitle: "Timeline", RelevanceScore: 0.91},
+				},
+			},
+
+			expectRes: `{"id":"0b4e1f9c-b1f4-11f0-a9d2-3c7a5e1b6f08","customer_id":"0a4b7d3c-b1f4-11f0-9e27-13a8c6f4b202","owner_type":"agent","owner_id":"0a76e1f4-b1f4-11f0-b0c3-6f2d8e1a5c03","aiassist_id":"0a1c5e2e-b1f4-11f0-8a61-4f0b9c2d1e01","transcript_id":"0b79c3e2-b1f4-11f0-b6a5-4e2d8f1c7a09","type":"knowledge","content":"Refunds are processed within 5 business days.","sources":[{"document_name":"refund-policy.pdf","section_title":"Timeline","relevance_score":0.91}],"tm_create":null}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := tt.suggestion.CreateWebhookEvent()
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			var got, expect map[string]any
+			_ = json.Unmarshal(res, &got)
+			_ = json.Unmarshal([]byte(tt.expectRes), &expect)
+			if !reflect.DeepEqual(got, expect) {
+				t.Errorf("Wrong match.\nexpect: %s\ngot: %s", tt.expectRes, res)
+			}
+		})
+	}
+}
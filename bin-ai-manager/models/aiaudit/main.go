@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/gofrs/uuid"
+	"monorepo/bin-ai-manager/models/usage"
 	commonidentity "monorepo/bin-common-handler/models/identity"
 )
 
@@ -44,6 +45,9 @@ type AIAudit struct {
 	Language     string          `json:"language,omitempty" db:"language"`
 	Error        string          `json:"error,omitempty"   db:"error"`
 
+	// Usage is the LLM usage of the evaluation.
+	usage.Usage `json:"usage,omitzero"`
+
 	TMCreate *time.Time `json:"tm_create" db:"tm_create"`
 	TMUpdate *time.Time `json:"tm_update" db:"tm_update"`
 	TMDelete *time.Time `json:"tm_delete" db:"tm_delete"`
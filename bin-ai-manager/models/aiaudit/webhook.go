@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/gofrs/uuid"
+	"monorepo/bin-ai-manager/models/usage"
 	commonidentity "monorepo/bin-common-handler/models/identity"
 )
 
@@ -21,6 +22,7 @@ type WebhookMessage struct {
 	MessageIDs      []uuid.UUID     `json:"message_ids,omitempty"`
 	Language        string          `json:"language,omitempty"`
 	Error           string          `json:"error,omitempty"`
+	Usage           usage.Usage     `json:"usage,omitzero"`
 
 	TMCreate *time.Time `json:"tm_create"`
 	TMUpdate *time.Time `json:"tm_update"`
@@ -40,6 +42,7 @@ func (a *AIAudit) ConvertWebhookMessage() *WebhookMessage {
 		MessageIDs:      a.MessageIDs,
 		Language:        a.Language,
 		Error:           a.Error,
+		Usage:           a.Usage,
 		TMCreate:        a.TMCreate,
 		TMUpdate:        a.TMUpdate,
 		TMDelete:        a.TMDelete,
@@ -0,0 +1,15 @@
+package aicall
+
+import (
+	"monorepo/bin-ai-manager/models/guardrail"
+)
+
+// MetaKeyGuardrail is the Metadata map key (guardrail.Config) recording the guardrail policy
+// applied to this AICall's output. Frozen from the AI's config at call-creation time.
+const MetaKeyGuardrail = "guardrail"
+
+// GuardrailConfig returns the guardrail config of the aicall.
+// Returns nil if the aicall has none.
+func (h *AIcall) GuardrailConfig() *guardrail.Config {
+	return metadataGet[guardrail.Config](h.Metadata, MetaKeyGuardrail)
+}
@@ -0,0 +1,83 @@
+package aicall
+
+import (
+	"reflect"
+	"testing"
+
+	"monorepo/bin-ai-manager/models/guardrail"
+
+	"github.com/gofrs/uuid"
+)
+
+func Test_GuardrailConfig(t *testing.T) {
+	tests := []struct {
+		name string
+
+		metadata map[string]any
+
+		expectRes *guardrail.Config
+	}{
+		{
+			name: "config set in memory",
+
+			metadata: map[string]any{
+				MetaKeyGuardrail: &guardrail.Config{
+					BlockedTopics: []string{"investment advice"},
+				},
+			},
+
+			expectRes: &guardrail.Config{
+				BlockedTopics: []string{"investment advice"},
+			},
+		},
+		{
+			name: "config decoded from the database",
+
+			metadata: map[string]any{
+				MetaKeyGuardrail: map[string]any{
+					"forbidden_phrases":          []any{"guaranteed return"},
+					"disclaimer":                 "This call is handled by an automated assistant.",
+					"max_consecutive_tool_calls": float64(3),
+					"escalation": map[string]any{
+						"action":   "transfer",
+						"queue_id": "8e1f4b2a-ad5d-11f0-a6c3-4d2e9b7f1a01",
+						"message":  "Let me connect you with an agent.",
+					},
+				},
+			},
+
+			expectRes: &guardrail.Config{
+				ForbiddenPhrases:        []string{"guaranteed return"},
+				Disclaimer:              "This call is handled by an automated assistant.",
+				MaxConsecutiveToolCalls: 3,
+				Escalation: &guardrail.Escalation{
+					Action:  guardrail.EscalationActionTransfer,
+					QueueID: uuid.FromStringOrNil("8e1f4b2a-ad5d-11f0-a6c3-4d2e9b7f1a01"),
+					Message: "Let me connect you with an agent.",
+				},
+			},
+		},
+		{
+			name: "no config",
+
+			metadata: map[string]any{
+				MetaKeyAutoAuditEnabled: true,
+			},
+
+			expectRes: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &AIcall{
+				Metadata: tt.metadata,
+			}
+
+			res := c.GuardrailConfig()
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/gofrs/uuid"
 
 	"monorepo/bin-ai-manager/models/ai"
+	"monorepo/bin-ai-manager/models/usage"
 	"monorepo/bin-common-handler/models/identity"
 )
 
@@ -56,6 +57,10 @@ type AIcall struct {
 
 	Metadata map[string]any `json:"metadata,omitempty" db:"metadata,json"`
 
+	// Usage is the AI provider usage of the aicall, aggregated over its
+	// pipecatcalls when each of them ends.
+	usage.Usage `json:"usage,omitzero"`
+
 	TMEnd    *time.Time `json:"tm_end" db:"tm_end"`
 	TMCreate *time.Time `json:"tm_create" db:"tm_create"`
 	TMUpdate *time.Time `json:"tm_update" db:"tm_update"`
@@ -72,6 +77,7 @@ const (
 	ReferenceTypeConversation ReferenceType = "conversation"
 	ReferenceTypeTask         ReferenceType = "task"
 	ReferenceTypeContactCase  ReferenceType = "contact_case"
+	ReferenceTypeTestRun      ReferenceType = "test_run" // text-only aicall driven by a test run. tools are not executed.
 )
 
 // AssistanceType defines the type of assistance entity backing an AIcall.
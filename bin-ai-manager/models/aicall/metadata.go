@@ -0,0 +1,34 @@
+package aicall
+
+import (
+	"encoding/json"
+)
+
+// metadataGet returns the value of the metadata key as T.
+// Returns nil if the key is missing or its value cannot be decoded as T.
+//
+// The value is a *T when it was set in this process, but a map[string]any
+// once it was decoded from the database or a request. The latter is
+// re-encoded and decoded into T.
+func metadataGet[T any](m map[string]any, key string) *T {
+	raw, ok := m[key]
+	if !ok || raw == nil {
+		return nil
+	}
+
+	if res, ok := raw.(*T); ok {
+		return res
+	}
+
+	encoded, err := json.Marshal(raw)
+	if err != nil {
+		return nil
+	}
+
+	res := new(T)
+	if err := json.Unmarshal(encoded, res); err != nil {
+		return nil
+	}
+
+	return res
+}
@@ -0,0 +1,91 @@
+package aicall
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_metadataGet(t *testing.T) {
+	type testValue struct {
+		Name  string `json:"name"`
+		Count int    `json:"count"`
+	}
+
+	tests := []struct {
+		name string
+
+		metadata map[string]any
+		key      string
+
+		expectRes *testValue
+	}{
+		{
+			name: "value set in memory",
+
+			metadata: map[string]any{
+				"test": &testValue{Name: "a", Count: 1},
+			},
+			key: "test",
+
+			expectRes: &testValue{Name: "a", Count: 1},
+		},
+		{
+			name: "value decoded from the database",
+
+			metadata: map[string]any{
+				"test": map[string]any{
+					"name":  "b",
+					"count": float64(2),
+				},
+			},
+			key: "test",
+
+			expectRes: &testValue{Name: "b", Count: 2},
+		},
+		{
+			name: "missing key",
+
+			metadata: map[string]any{},
+			key:      "test",
+
+			expectRes: nil,
+		},
+		{
+			name: "nil metadata",
+
+			metadata: nil,
+			key:      "test",
+
+			expectRes: nil,
+		},
+		{
+			name: "nil value",
+
+			metadata: map[string]any{
+				"test": nil,
+			},
+			key: "test",
+
+			expectRes: nil,
+		},
+		{
+			name: "value of another type",
+
+			metadata: map[string]any{
+				"test": "not an object",
+			},
+			key: "test",
+
+			expectRes: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := metadataGet[testValue](tt.metadata, tt.key)
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
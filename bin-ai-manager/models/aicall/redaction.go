@@ -0,0 +1,16 @@
+package aicall
+
+import (
+	"monorepo/bin-ai-manager/models/redaction"
+)
+
+// MetaKeyRedaction is the Metadata map key (redaction.Config) recording the personal data
+// redaction applied to this AICall's messages. Frozen from the AI's config, or its customer's
+// default, at call-creation time.
+const MetaKeyRedaction = "redaction"
+
+// RedactionConfig returns the redaction config of the aicall.
+// Returns nil if the aicall has none.
+func (h *AIcall) RedactionConfig() *redaction.Config {
+	return metadataGet[redaction.Config](h.Metadata, MetaKeyRedaction)
+}
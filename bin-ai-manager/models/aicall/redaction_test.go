@@ -0,0 +1,80 @@
+package aicall
+
+import (
+	"reflect"
+	"testing"
+
+	"monorepo/bin-ai-manager/models/redaction"
+)
+
+func Test_RedactionConfig(t *testing.T) {
+	tests := []struct {
+		name string
+
+		metadata map[string]any
+
+		expectRes *redaction.Config
+	}{
+		{
+			name: "config set in memory",
+
+			metadata: map[string]any{
+				MetaKeyRedaction: &redaction.Config{
+					EntityTypes: []redaction.EntityType{redaction.EntityTypeCreditCard},
+					Mode:        redaction.ModeMask,
+				},
+			},
+
+			expectRes: &redaction.Config{
+				EntityTypes: []redaction.EntityType{redaction.EntityTypeCreditCard},
+				Mode:        redaction.ModeMask,
+			},
+		},
+		{
+			name: "config decoded from the database",
+
+			metadata: map[string]any{
+				MetaKeyRedaction: map[string]any{
+					"entity_types": []any{"iban", "email"},
+					"mode":         "tokenize",
+					"tool_access":  true,
+				},
+			},
+
+			expectRes: &redaction.Config{
+				EntityTypes: []redaction.EntityType{redaction.EntityTypeIBAN, redaction.EntityTypeEmail},
+				Mode:        redaction.ModeTokenize,
+				ToolAccess:  true,
+			},
+		},
+		{
+			name: "no config",
+
+			metadata: map[string]any{
+				MetaKeyAutoAuditEnabled: true,
+			},
+
+			expectRes: nil,
+		},
+		{
+			name: "nil metadata",
+
+			metadata: nil,
+
+			expectRes: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &AIcall{
+				Metadata: tt.metadata,
+			}
+
+			res := c.RedactionConfig()
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
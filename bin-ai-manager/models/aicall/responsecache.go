@@ -0,0 +1,27 @@
+package aicall
+
+import (
+	"monorepo/bin-ai-manager/models/responsecache"
+)
+
+// MetaKeyResponseCache is the Metadata map key (responsecache.Config) recording the response
+// cache config of this AICall. Frozen from the AI's config at call-creation time.
+const MetaKeyResponseCache = "response_cache"
+
+// MetaKeyResponseCacheScope is the Metadata map key (string) recording the fingerprint of the
+// AI's init prompt and rag at call-creation time. Only the responses cached under the same
+// scope are reused.
+const MetaKeyResponseCacheScope = "response_cache_scope"
+
+// ResponseCacheConfig returns the response cache config of the aicall.
+// Returns nil if the aicall has none.
+func (h *AIcall) ResponseCacheConfig() *responsecache.Config {
+	return metadataGet[responsecache.Config](h.Metadata, MetaKeyResponseCache)
+}
+
+// ResponseCacheScope returns the response cache scope of the aicall.
+// Returns empty if the aicall has none.
+func (h *AIcall) ResponseCacheScope() string {
+	res, _ := h.Metadata[MetaKeyResponseCacheScope].(string)
+	return res
+}
@@ -0,0 +1,82 @@
+package aicall
+
+import (
+	"reflect"
+	"testing"
+
+	"monorepo/bin-ai-manager/models/responsecache"
+)
+
+func Test_ResponseCacheConfig(t *testing.T) {
+	tests := []struct {
+		name string
+
+		metadata map[string]any
+
+		expectRes   *responsecache.Config
+		expectScope string
+	}{
+		{
+			name: "config set in memory",
+
+			metadata: map[string]any{
+				MetaKeyResponseCache: &responsecache.Config{
+					Enabled: true,
+				},
+				MetaKeyResponseCacheScope: "3f9a1c2e7b4d8a60",
+			},
+
+			expectRes: &responsecache.Config{
+				Enabled: true,
+			},
+			expectScope: "3f9a1c2e7b4d8a60",
+		},
+		{
+			name: "config decoded from the database",
+
+			metadata: map[string]any{
+				MetaKeyResponseCache: map[string]any{
+					"enabled":   true,
+					"threshold": 0.9,
+					"ttl":       float64(3600),
+					"turns":     float64(2),
+					"variables": []any{"customer.language"},
+				},
+				MetaKeyResponseCacheScope: "3f9a1c2e7b4d8a60",
+			},
+
+			expectRes: &responsecache.Config{
+				Enabled:   true,
+				Threshold: 0.9,
+				TTL:       3600,
+				Turns:     2,
+				Variables: []string{"customer.language"},
+			},
+			expectScope: "3f9a1c2e7b4d8a60",
+		},
+		{
+			name: "no config",
+
+			metadata: map[string]any{},
+
+			expectRes: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &AIcall{
+				Metadata: tt.metadata,
+			}
+
+			res := c.ResponseCacheConfig()
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+
+			if scope := c.ResponseCacheScope(); scope != tt.expectScope {
+				t.Errorf("Wrong match. expect: %s, got: %s", tt.expectScope, scope)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"monorepo/bin-ai-manager/models/ai"
+	"monorepo/bin-ai-manager/models/usage"
 	"monorepo/bin-common-handler/models/identity"
 
 	"github.com/gofrs/uuid"
@@ -40,6 +41,8 @@ type WebhookMessage struct {
 
 	Metadata map[string]any `json:"metadata,omitempty"`
 
+	Usage usage.Usage `json:"usage,omitzero"`
+
 	TMEnd    *time.Time `json:"tm_end"`
 	TMCreate *time.Time `json:"tm_create"`
 	TMUpdate *time.Time `json:"tm_update"`
@@ -77,6 +80,8 @@ func (h *AIcall) ConvertWebhookMessage() *WebhookMessage {
 
 		Metadata: h.Metadata,
 
+		Usage: h.Usage,
+
 		TMEnd:    h.TMEnd,
 		TMCreate: h.TMCreate,
 		TMUpdate: h.TMUpdate,
@@ -0,0 +1,8 @@
+package customtool
+
+// list of event types
+const (
+	EventTypeCreated string = "custom_tool_created"
+	EventTypeUpdated string = "custom_tool_updated"
+	EventTypeDeleted string = "custom_tool_deleted"
+)
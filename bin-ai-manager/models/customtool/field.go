@@ -0,0 +1,21 @@
+package customtool
+
+// Field represents a database field name for type-safe updates.
+type Field string
+
+const (
+	FieldID               Field = "id"
+	FieldCustomerID       Field = "customer_id"
+	FieldName             Field = "name"
+	FieldDetail           Field = "detail"
+	FieldDescription      Field = "description"
+	FieldParameters       Field = "parameters"
+	FieldURL              Field = "url"
+	FieldHeaders          Field = "headers"
+	FieldTimeout          Field = "timeout"
+	FieldResponseTemplate Field = "response_template"
+	FieldTMCreate         Field = "tm_create"
+	FieldTMUpdate         Field = "tm_update"
+	FieldTMDelete         Field = "tm_delete"
+	FieldDeleted          Field = "deleted"
+)
@@ -0,0 +1,10 @@
+package customtool
+
+import "github.com/gofrs/uuid"
+
+// FieldStruct defines filterable fields for CustomTool list queries.
+type FieldStruct struct {
+	CustomerID uuid.UUID `filter:"customer_id"`
+	Name       string    `filter:"name"`
+	Deleted    bool      `filter:"deleted"`
+}
@@ -0,0 +1,36 @@
+package customtool
+
+import (
+	"time"
+
+	"monorepo/bin-common-handler/models/identity"
+)
+
+// CustomTool is a customer-defined tool backed by the customer's own HTTPS
+// endpoint. When an AI lists the tool's name in its tool_names, the tool is
+// offered to the LLM alongside the built-in tools. When the LLM calls it,
+// ai-manager POSTs the call arguments to URL and feeds the response back to
+// the model.
+type CustomTool struct {
+	identity.Identity
+
+	Name        string         `json:"name,omitempty" db:"name"`               // function name exposed to the LLM
+	Detail      string         `json:"detail,omitempty" db:"detail"`           // operator-facing note, never sent to the LLM
+	Description string         `json:"description,omitempty" db:"description"` // LLM-facing description of what the tool does
+	Parameters  map[string]any `json:"parameters,omitempty" db:"parameters,json"`
+
+	URL              string            `json:"url,omitempty" db:"url"`
+	Headers          map[string]string `json:"headers,omitempty" db:"headers,json"`
+	Timeout          int               `json:"timeout,omitempty" db:"timeout"` // request timeout in milliseconds
+	ResponseTemplate string            `json:"response_template,omitempty" db:"response_template"`
+
+	TMCreate *time.Time `json:"tm_create" db:"tm_create"`
+	TMUpdate *time.Time `json:"tm_update" db:"tm_update"`
+	TMDelete *time.Time `json:"tm_delete" db:"tm_delete"`
+}
+
+// list of timeout bounds in milliseconds
+const (
+	TimeoutDefault = 5000
+	TimeoutMax     = 30000
+)
@@ -0,0 +1,104 @@
+package customtool
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/gofrs/uuid"
+
+	"monorepo/bin-ai-manager/models/tool"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+)
+
+func Test_ConvertTool(t *testing.T) {
+	tests := []struct {
+		name string
+
+		customTool *CustomTool
+		expectRes  tool.Tool
+	}{
+		{
+			name: "normal",
+
+			customTool: &CustomTool{
+				Name:        "lookup_order",
+				Description: "Look up an order by its number.",
+				Parameters: map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"order_number": map[string]any{"type": "string"},
+					},
+				},
+				URL: "https://example.com/orders",
+				Headers: map[string]string{
+					"Authorization": "Bearer token",
+				},
+			},
+			expectRes: tool.Tool{
+				Name:        "lookup_order",
+				Description: "Look up an order by its number.",
+				Parameters: map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"order_number": map[string]any{"type": "string"},
+					},
+				},
+				RunLLM: true,
+			},
+		},
+		{
+			name: "no parameters",
+
+			customTool: &CustomTool{
+				Name:        "ping",
+				Description: "Ping the backend.",
+			},
+			expectRes: tool.Tool{
+				Name:        "ping",
+				Description: "Ping the backend.",
+				Parameters: map[string]any{
+					"type":       "object",
+					"properties": map[string]any{},
+				},
+				RunLLM: true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := tt.customTool.ConvertTool()
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_CreateWebhookEvent(t *testing.T) {
+	c := &CustomTool{
+		Identity: commonidentity.Identity{
+			ID:         uuid.FromStringOrNil("4e0f1a2c-8d6d-11f1-9a4b-1f3d5b7c9e01"),
+			CustomerID: uuid.FromStringOrNil("4e3b6c8a-8d6d-11f1-8c2d-3e5f7a9b1c02"),
+		},
+		Name:             "lookup_order",
+		URL:              "https://example.com/orders",
+		Timeout:          3000,
+		ResponseTemplate: "{{.status}}",
+	}
+
+	data, err := c.CreateWebhookEvent()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	res := &WebhookMessage{}
+	if err := json.Unmarshal(data, res); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(res, c.ConvertWebhookMessage()) {
+		t.Errorf("Wrong match.\nexpect: %v\ngot: %v", c.ConvertWebhookMessage(), res)
+	}
+}
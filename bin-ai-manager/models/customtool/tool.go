@@ -0,0 +1,25 @@
+package customtool
+
+import (
+	"monorepo/bin-ai-manager/models/tool"
+)
+
+// ConvertTool returns the LLM-facing tool definition of the custom tool.
+// The endpoint, headers and response template stay inside ai-manager; only
+// the name, description and parameter schema are handed to the LLM.
+func (h *CustomTool) ConvertTool() tool.Tool {
+	parameters := h.Parameters
+	if parameters == nil {
+		parameters = map[string]any{
+			"type":       "object",
+			"properties": map[string]any{},
+		}
+	}
+
+	return tool.Tool{
+		Name:        tool.ToolName(h.Name),
+		Description: h.Description,
+		Parameters:  parameters,
+		RunLLM:      true,
+	}
+}
@@ -0,0 +1,60 @@
+package customtool
+
+import (
+	"encoding/json"
+	"time"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+)
+
+// WebhookMessage is the external-facing representation of a CustomTool.
+type WebhookMessage struct {
+	commonidentity.Identity
+
+	Name        string         `json:"name,omitempty"`
+	Detail      string         `json:"detail,omitempty"`
+	Description string         `json:"description,omitempty"`
+	Parameters  map[string]any `json:"parameters,omitempty"`
+
+	URL              string            `json:"url,omitempty"`
+	Headers          map[string]string `json:"headers,omitempty"`
+	Timeout          int               `json:"timeout,omitempty"`
+	ResponseTemplate string            `json:"response_template,omitempty"`
+
+	TMCreate *time.Time `json:"tm_create"`
+	TMUpdate *time.Time `json:"tm_update"`
+	TMDelete *time.Time `json:"tm_delete"`
+}
+
+// ConvertWebhookMessage converts the internal CustomTool to an external WebhookMessage.
+func (h *CustomTool) ConvertWebhookMessage() *WebhookMessage {
+	return &WebhookMessage{
+		Identity: h.Identity,
+
+		Name:        h.Name,
+		Detail:      h.Detail,
+		Description: h.Description,
+		Parameters:  h.Parameters,
+
+		URL:              h.URL,
+		Headers:          h.Headers,
+		Timeout:          h.Timeout,
+		ResponseTemplate: h.ResponseTemplate,
+
+		TMCreate: h.TMCreate,
+		TMUpdate: h.TMUpdate,
+		TMDelete: h.TMDelete,
+	}
+}
+
+// CreateWebhookEvent generate WebhookEvent
+func (h *CustomTool) CreateWebhookEvent() ([]byte, error) {
+	e := h.ConvertWebhookMessage()
+
+	m, err := json.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
@@ -0,0 +1,8 @@
+package extraction
+
+// list of event types
+const (
+	EventTypeCreated string = "extraction_created" // the extraction has created
+	EventTypeUpdated string = "extraction_updated" // the extraction has updated
+	EventTypeDeleted string = "extraction_deleted" // the extraction has deleted
+)
@@ -0,0 +1,34 @@
+package extraction
+
+// Field represents Extraction field for database queries
+type Field string
+
+// List of fields
+const (
+	FieldID         Field = "id"
+	FieldCustomerID Field = "customer_id"
+
+	FieldActiveflowID Field = "activeflow_id"
+	FieldOnEndFlowID  Field = "on_end_flow_id"
+
+	FieldReferenceType Field = "reference_type"
+	FieldReferenceID   Field = "reference_id"
+
+	FieldStatus   Field = "status"
+	FieldLanguage Field = "language"
+
+	FieldSchema       Field = "json_schema"
+	FieldSetVariables Field = "set_variables"
+
+	FieldResult Field = "result"
+
+	FieldPromptTokens     Field = "prompt_tokens"
+	FieldCompletionTokens Field = "completion_tokens"
+	FieldCachedTokens     Field = "cached_tokens"
+
+	FieldTMCreate Field = "tm_create"
+	FieldTMUpdate Field = "tm_update"
+	FieldTMDelete Field = "tm_delete"
+
+	FieldDeleted Field = "deleted"
+)
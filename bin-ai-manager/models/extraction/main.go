@@ -0,0 +1,62 @@
+package extraction
+
+import (
+	"time"
+
+	"monorepo/bin-ai-manager/models/usage"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+
+	"github.com/gofrs/uuid"
+)
+
+// Extraction is a structured data extraction job. It fills the customer-supplied
+// JSON schema from the transcript of the referenced resource (or from the
+// messages of the referenced aicall) with the LLM, and keeps the validated result.
+type Extraction struct {
+	commonidentity.Identity
+
+	ActiveflowID uuid.UUID `json:"activeflow_id,omitempty" db:"activeflow_id,uuid"`
+	OnEndFlowID  uuid.UUID `json:"on_end_flow_id,omitempty" db:"on_end_flow_id,uuid"`
+
+	ReferenceType ReferenceType `json:"reference_type,omitempty" db:"reference_type"`
+	ReferenceID   uuid.UUID     `json:"reference_id,omitempty" db:"reference_id,uuid"`
+
+	Status   Status `json:"status,omitempty" db:"status"`
+	Language string `json:"language,omitempty" db:"language"`
+
+	Schema       map[string]any `json:"schema,omitempty" db:"json_schema,json"`     // JSON schema of the result. The root must be an object. ("schema" is a reserved word in MySQL)
+	SetVariables bool           `json:"set_variables,omitempty" db:"set_variables"` // if true, every result property is written into the activeflow's variables.
+
+	Result map[string]any `json:"result,omitempty" db:"result,json"` // the schema-conformant extracted data. Valid only when the status is done.
+
+	// Usage is the LLM usage of the extraction.
+	usage.Usage `json:"usage,omitzero"`
+
+	TMCreate *time.Time `json:"tm_create" db:"tm_create"`
+	TMUpdate *time.Time `json:"tm_update" db:"tm_update"`
+	TMDelete *time.Time `json:"tm_delete" db:"tm_delete"`
+}
+
+// ReferenceType defines the source of the extraction.
+type ReferenceType string
+
+// list of reference types
+const (
+	ReferenceTypeNone       ReferenceType = ""
+	ReferenceTypeCall       ReferenceType = "call"
+	ReferenceTypeConference ReferenceType = "conference"
+	ReferenceTypeTranscribe ReferenceType = "transcribe"
+	ReferenceTypeRecording  ReferenceType = "recording"
+	ReferenceTypeAIcall     ReferenceType = "aicall"
+)
+
+// Status defines the extraction status.
+type Status string
+
+// list of statuses
+const (
+	StatusNone        Status = ""
+	StatusProgressing Status = "progressing"
+	StatusDone        Status = "done"
+	StatusFailed      Status = "failed" // the LLM output could not be validated against the schema
+)
@@ -0,0 +1,75 @@
+package extraction
+
+import (
+	"encoding/json"
+	"time"
+
+	"monorepo/bin-ai-manager/models/usage"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+
+	"github.com/gofrs/uuid"
+)
+
+// WebhookMessage defines webhook event
+type WebhookMessage struct {
+	commonidentity.Identity
+
+	ActiveflowID uuid.UUID `json:"activeflow_id,omitempty"`
+	OnEndFlowID  uuid.UUID `json:"on_end_flow_id,omitempty"`
+
+	ReferenceType ReferenceType `json:"reference_type,omitempty"`
+	ReferenceID   uuid.UUID     `json:"reference_id,omitempty"`
+
+	Status   Status `json:"status,omitempty"`
+	Language string `json:"language,omitempty"`
+
+	Schema       map[string]any `json:"schema,omitempty"`
+	SetVariables bool           `json:"set_variables,omitempty"`
+
+	Result map[string]any `json:"result,omitempty"`
+
+	Usage usage.Usage `json:"usage,omitzero"`
+
+	TMCreate *time.Time `json:"tm_create"`
+	TMUpdate *time.Time `json:"tm_update"`
+	TMDelete *time.Time `json:"tm_delete"`
+}
+
+// ConvertWebhookMessage converts to the event
+func (h *Extraction) ConvertWebhookMessage() *WebhookMessage {
+	return &WebhookMessage{
+		Identity: h.Identity,
+
+		ActiveflowID: h.ActiveflowID,
+		OnEndFlowID:  h.OnEndFlowID,
+
+		ReferenceType: h.ReferenceType,
+		ReferenceID:   h.ReferenceID,
+
+		Status:   h.Status,
+		Language: h.Language,
+
+		Schema:       h.Schema,
+		SetVariables: h.SetVariables,
+
+		Result: h.Result,
+
+		Usage: h.Usage,
+
+		TMCreate: h.TMCreate,
+		TMUpdate: h.TMUpdate,
+		TMDelete: h.TMDelete,
+	}
+}
+
+// CreateWebhookEvent generate WebhookEvent
+func (h *Extraction) CreateWebhookEvent() ([]byte, error) {
+	e := h.ConvertWebhookMessage()
+
+	m, err := json.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
@@ -0,0 +1,76 @@
+package extraction
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"monorepo/bin-ai-manager/models/usage"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+
+	"github.com/gofrs/uuid"
+)
+
+func Test_CreateWebhookEvent(t *testing.T) {
+	tests := []struct {
+		name string
+
+		extraction *Extraction
+
+		expectRes string
+	}{
+		{
+			name: "normal",
+
+			extraction: &Extraction{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("5c0f9a2e-ad46-11f0-9d2c-8b1e6a6f1f01"),
+					CustomerID: uuid.FromStringOrNil("5c3a7b4e-ad46-11f0-8e41-1f7c2f0d2a02"),
+				},
+				ReferenceType: ReferenceTypeAIcall,
+				ReferenceID:   uuid.FromStringOrNil("5c61b2a2-ad46-11f0-a5b6-77d94f1c5e03"),
+				Status:        StatusDone,
+				Schema: map[string]any{
+					"type": "object",
+				},
+				Result: map[string]any{
+					"intent": "refund",
+				},
+				Usage: usage.Usage{
+					PromptTokens:     120,
+					CompletionTokens: 15,
+				},
+			},
+
+			expectRes: `{"id":"5c0f9a2e-ad46-11f0-9d2c-8b1e6a6f1f01","customer_id":"5c3a7b4e-ad46-11f0-8e41-1f7c2f0d2a02","activeflow_id":"00000000-0000-0000-0000-000000000000","on_end_flow_id":"00000000-0000-0000-0000-000000000000","reference_type":"aicall","reference_id":"5c61b2a2-ad46-11f0-a5b6-77d94f1c5e03","status":"done","schema":{"type":"object"},"result":{"intent":"refund"},"usage":{"prompt_tokens":120,"completion_tokens":15,"cached_tokens":0,"stt_seconds":0,"tts_seconds":0},"tm_create":null,"tm_update":null,"tm_delete":null}`,
+		},
+		{
+			name: "empty usage is omitted",
+
+			extraction: &Extraction{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("5c8d6c7a-ad46-11f0-b8f4-3bb1d0e1a104"),
+				},
+				Status: StatusProgressing,
+			},
+
+			expectRes: `{"id":"5c8d6c7a-ad46-11f0-b8f4-3bb1d0e1a104","customer_id":"00000000-0000-0000-0000-000000000000","activeflow_id":"00000000-0000-0000-0000-000000000000","on_end_flow_id":"00000000-0000-0000-0000-000000000000","reference_id":"00000000-0000-0000-0000-000000000000","status":"progressing","tm_create":null,"tm_update":null,"tm_delete":null}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := tt.extraction.CreateWebhookEvent()
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			var got, expect map[string]any
+			_ = json.Unmarshal(res, &got)
+			_ = json.Unmarshal([]byte(tt.expectRes), &expect)
+			if !reflect.DeepEqual(got, expect) {
+				t.Errorf("Wrong match.\nexpect: %s\ngot: %s", tt.expectRes, res)
+			}
+		})
+	}
+}
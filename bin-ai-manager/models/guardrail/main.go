@@ -0,0 +1,136 @@
+package guardrail
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gofrs/uuid"
+)
+
+// Config defines the guardrail policy of an AI. The AI's output is checked
+// against the policy before it is spoken, and every violation is recorded.
+type Config struct {
+	BlockedTopics    []string `json:"blocked_topics,omitempty"`    // topics the AI must not talk about. matched as whole words, case-insensitive.
+	ForbiddenPhrases []string `json:"forbidden_phrases,omitempty"` // phrases the AI must never say. matched case-insensitive.
+
+	// Disclaimer is said at the start of the AI's first response in the session.
+	Disclaimer string `json:"disclaimer,omitempty"`
+
+	// MaxConsecutiveToolCalls limits the tool calls the AI can make without
+	// the user saying anything in between. 0 is unlimited.
+	MaxConsecutiveToolCalls int `json:"max_consecutive_tool_calls,omitempty"`
+
+	// Escalation defines what happens when a rule triggers. nil only records the violation.
+	Escalation *Escalation `json:"escalation,omitempty"`
+}
+
+// Escalation defines the action taken when a guardrail rule triggers.
+type Escalation struct {
+	Action  EscalationAction `json:"action,omitempty"`
+	QueueID uuid.UUID        `json:"queue_id,omitempty"` // valid only for EscalationActionTransfer.
+
+	// Message is said in place of the blocked output.
+	// Empty drops the blocked output without saying anything.
+	Message string `json:"message,omitempty"`
+}
+
+// EscalationAction defines the escalation action.
+type EscalationAction string
+
+// list of escalation actions
+const (
+	EscalationActionNone        EscalationAction = ""             // records the violation only
+	EscalationActionStopService EscalationAction = "stop_service" // stops the ai service. the flow continues to the next action.
+	EscalationActionTransfer    EscalationAction = "transfer"     // joins the call to the queue.
+)
+
+// list of limits
+const (
+	MaxItems      = 50   // max number of the blocked topics and the forbidden phrases each
+	MaxItemLength = 200  // max length of a blocked topic or a forbidden phrase
+	MaxTextLength = 1000 // max length of the disclaimer and the escalation message
+
+	MaxConsecutiveToolCallsLimit = 100
+)
+
+// IsEnabled returns true if the config has any rule.
+func (h *Config) IsEnabled() bool {
+	if h == nil {
+		return false
+	}
+
+	return len(h.BlockedTopics) > 0 ||
+		len(h.ForbiddenPhrases) > 0 ||
+		h.Disclaimer != "" ||
+		h.MaxConsecutiveToolCalls > 0
+}
+
+// GetEscalation returns the config's escalation, or an empty escalation if not set.
+func (h *Config) GetEscalation() Escalation {
+	if h.Escalation == nil {
+		return Escalation{}
+	}
+	return *h.Escalation
+}
+
+// Validate checks the config. A nil config is valid.
+func Validate(c *Config) error {
+	if c == nil {
+		return nil
+	}
+
+	if err := validateItems("blocked topic", c.BlockedTopics); err != nil {
+		return err
+	}
+	if err := validateItems("forbidden phrase", c.ForbiddenPhrases); err != nil {
+		return err
+	}
+
+	if len(c.Disclaimer) > MaxTextLength {
+		return fmt.Errorf("disclaimer exceeds %d characters", MaxTextLength)
+	}
+
+	if c.MaxConsecutiveToolCalls < 0 || c.MaxConsecutiveToolCalls > MaxConsecutiveToolCallsLimit {
+		return fmt.Errorf("max_consecutive_tool_calls must be between 0 and %d", MaxConsecutiveToolCallsLimit)
+	}
+
+	if c.Escalation == nil {
+		return nil
+	}
+
+	switch c.Escalation.Action {
+	case EscalationActionNone, EscalationActionStopService:
+		if c.Escalation.QueueID != uuid.Nil {
+			return fmt.Errorf("queue_id is valid only for the %s action", EscalationActionTransfer)
+		}
+	case EscalationActionTransfer:
+		if c.Escalation.QueueID == uuid.Nil {
+			return fmt.Errorf("the %s action requires a queue_id", EscalationActionTransfer)
+		}
+	default:
+		return fmt.Errorf("invalid escalation action: %s", c.Escalation.Action)
+	}
+
+	if len(c.Escalation.Message) > MaxTextLength {
+		return fmt.Errorf("escalation message exceeds %d characters", MaxTextLength)
+	}
+
+	return nil
+}
+
+func validateItems(name string, items []string) error {
+	if len(items) > MaxItems {
+		return fmt.Errorf("too many %ss. max: %d", name, MaxItems)
+	}
+
+	for _, item := range items {
+		if strings.TrimSpace(item) == "" {
+			return fmt.Errorf("empty %s", name)
+		}
+		if len(item) > MaxItemLength {
+			return fmt.Errorf("%s exceeds %d characters: %s", name, MaxItemLength, item)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,140 @@
+package guardrail
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gofrs/uuid"
+)
+
+func Test_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		config    *Config
+		wantError bool
+	}{
+		{
+			name:   "nil config is valid",
+			config: nil,
+		},
+		{
+			name:   "empty config is valid",
+			config: &Config{},
+		},
+		{
+			name: "valid config",
+			config: &Config{
+				BlockedTopics:           []string{"investment advice"},
+				ForbiddenPhrases:        []string{"guaranteed return"},
+				Disclaimer:              "This call is handled by an automated assistant.",
+				MaxConsecutiveToolCalls: 5,
+				Escalation: &Escalation{
+					Action:  EscalationActionTransfer,
+					QueueID: uuid.FromStringOrNil("4f0c6e2a-ad5d-11f0-9b1e-3f2a7c8d1e01"),
+					Message: "Let me connect you with an agent.",
+				},
+			},
+		},
+		{
+			name: "empty blocked topic",
+			config: &Config{
+				BlockedTopics: []string{" "},
+			},
+			wantError: true,
+		},
+		{
+			name: "too long forbidden phrase",
+			config: &Config{
+				ForbiddenPhrases: []string{strings.Repeat("a", MaxItemLength+1)},
+			},
+			wantError: true,
+		},
+		{
+			name: "too long disclaimer",
+			config: &Config{
+				Disclaimer: strings.Repeat("a", MaxTextLength+1),
+			},
+			wantError: true,
+		},
+		{
+			name: "negative max consecutive tool calls",
+			config: &Config{
+				MaxConsecutiveToolCalls: -1,
+			},
+			wantError: true,
+		},
+		{
+			name: "invalid escalation action",
+			config: &Config{
+				Escalation: &Escalation{Action: "hangup"},
+			},
+			wantError: true,
+		},
+		{
+			name: "transfer without queue id",
+			config: &Config{
+				Escalation: &Escalation{Action: EscalationActionTransfer},
+			},
+			wantError: true,
+		},
+		{
+			name: "stop service with queue id",
+			config: &Config{
+				Escalation: &Escalation{
+					Action:  EscalationActionStopService,
+					QueueID: uuid.FromStringOrNil("4f3b91d6-ad5d-11f0-8a4f-6b1e9d2c7f02"),
+				},
+			},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.config)
+			if (err != nil) != tt.wantError {
+				t.Errorf("Validate() error = %v, wantError %v", err, tt.wantError)
+			}
+		})
+	}
+}
+
+func Test_IsEnabled(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *Config
+
+		expectRes bool
+	}{
+		{
+			name:      "nil config",
+			config:    nil,
+			expectRes: false,
+		},
+		{
+			name: "escalation only",
+			config: &Config{
+				Escalation: &Escalation{Action: EscalationActionStopService},
+			},
+			expectRes: false,
+		},
+		{
+			name:      "has disclaimer",
+			config:    &Config{Disclaimer: "This call is recorded."},
+			expectRes: true,
+		},
+		{
+			name:      "has max consecutive tool calls",
+			config:    &Config{MaxConsecutiveToolCalls: 3},
+			expectRes: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if res := tt.config.IsEnabled(); res != tt.expectRes {
+				t.Errorf("Wrong match. expect: %v, got: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
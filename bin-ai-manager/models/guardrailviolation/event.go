@@ -0,0 +1,6 @@
+package guardrailviolation
+
+// list of event types
+const (
+	EventTypeCreated string = "guardrail_violation_created" // the guardrail violation has created
+)
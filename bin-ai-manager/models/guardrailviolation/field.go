@@ -0,0 +1,26 @@
+package guardrailviolation
+
+// Field represents GuardrailViolation field for database queries
+type Field string
+
+// List of fields
+const (
+	FieldID         Field = "id"
+	FieldCustomerID Field = "customer_id"
+
+	FieldAIcallID     Field = "aicall_id"
+	FieldAIID         Field = "ai_id"
+	FieldActiveflowID Field = "activeflow_id"
+
+	FieldRule    Field = "rule"
+	FieldDetail  Field = "detail"
+	FieldContent Field = "content"
+
+	FieldAction Field = "action"
+
+	FieldTMCreate Field = "tm_create"
+	FieldTMUpdate Field = "tm_update"
+	FieldTMDelete Field = "tm_delete"
+
+	FieldDeleted Field = "deleted"
+)
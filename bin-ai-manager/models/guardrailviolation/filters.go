@@ -0,0 +1,14 @@
+package guardrailviolation
+
+import "github.com/gofrs/uuid"
+
+// FieldStruct defines allowed filters for GuardrailViolation queries
+// Each field corresponds to a filterable database column
+type FieldStruct struct {
+	CustomerID   uuid.UUID `filter:"customer_id"`
+	AIcallID     uuid.UUID `filter:"aicall_id"`
+	AIID         uuid.UUID `filter:"ai_id"`
+	ActiveflowID uuid.UUID `filter:"activeflow_id"`
+	Rule         Rule      `filter:"rule"`
+	Deleted      bool      `filter:"deleted"`
+}
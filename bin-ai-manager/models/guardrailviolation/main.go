@@ -0,0 +1,41 @@
+package guardrailviolation
+
+import (
+	"time"
+
+	"monorepo/bin-ai-manager/models/guardrail"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+
+	"github.com/gofrs/uuid"
+)
+
+// GuardrailViolation records a guardrail rule triggered by an AI in an aicall.
+// The records are never updated, so they can be used as an audit trail.
+type GuardrailViolation struct {
+	commonidentity.Identity
+
+	AIcallID     uuid.UUID `json:"aicall_id,omitempty" db:"aicall_id,uuid"`
+	AIID         uuid.UUID `json:"ai_id,omitempty" db:"ai_id,uuid"`
+	ActiveflowID uuid.UUID `json:"activeflow_id,omitempty" db:"activeflow_id,uuid"`
+
+	Rule    Rule   `json:"rule,omitempty" db:"rule"`
+	Detail  string `json:"detail,omitempty" db:"detail"`   // the matched topic or phrase, or the tool call count.
+	Content string `json:"content,omitempty" db:"content"` // the blocked output, or the refused tool call's name.
+
+	Action guardrail.EscalationAction `json:"action,omitempty" db:"action"` // the escalation action taken.
+
+	TMCreate *time.Time `json:"tm_create" db:"tm_create"`
+	TMUpdate *time.Time `json:"tm_update" db:"tm_update"`
+	TMDelete *time.Time `json:"tm_delete" db:"tm_delete"`
+}
+
+// Rule defines the guardrail rule.
+type Rule string
+
+// list of rules
+const (
+	RuleNone                    Rule = ""
+	RuleBlockedTopic            Rule = "blocked_topic"
+	RuleForbiddenPhrase         Rule = "forbidden_phrase"
+	RuleMaxConsecutiveToolCalls Rule = "max_consecutive_tool_calls"
+)
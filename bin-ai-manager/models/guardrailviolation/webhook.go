@@ -0,0 +1,63 @@
+package guardrailviolation
+
+import (
+	"encoding/json"
+	"time"
+
+	"monorepo/bin-ai-manager/models/guardrail"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+
+	"github.com/gofrs/uuid"
+)
+
+// WebhookMessage defines webhook event
+type WebhookMessage struct {
+	commonidentity.Identity
+
+	AIcallID     uuid.UUID `json:"aicall_id,omitempty"`
+	AIID         uuid.UUID `json:"ai_id,omitempty"`
+	ActiveflowID uuid.UUID `json:"activeflow_id,omitempty"`
+
+	Rule    Rule   `json:"rule,omitempty"`
+	Detail  string `json:"detail,omitempty"`
+	Content string `json:"content,omitempty"`
+
+	Action guardrail.EscalationAction `json:"action,omitempty"`
+
+	TMCreate *time.Time `json:"tm_create"`
+	TMUpdate *time.Time `json:"tm_update"`
+	TMDelete *time.Time `json:"tm_delete"`
+}
+
+// ConvertWebhookMessage converts to the event
+func (h *GuardrailViolation) ConvertWebhookMessage() *WebhookMessage {
+	return &WebhookMessage{
+		Identity: h.Identity,
+
+		AIcallID:     h.AIcallID,
+		AIID:         h.AIID,
+		ActiveflowID: h.ActiveflowID,
+
+		Rule:    h.Rule,
+		Detail:  h.Detail,
+		Content: h.Content,
+
+		Action: h.Action,
+
+		TMCreate: h.TMCreate,
+		TMUpdate: h.TMUpdate,
+		TMDelete: h.TMDelete,
+	}
+}
+
+// CreateWebhookEvent generate WebhookEvent
+func (h *GuardrailViolation) CreateWebhookEvent() ([]byte, error) {
+	e := h.ConvertWebhookMessage()
+
+	m, err := json.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
@@ -0,0 +1,59 @@
+package guardrailviolation
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"monorepo/bin-ai-manager/models/guardrail"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+
+	"github.com/gofrs/uuid"
+)
+
+func Test_CreateWebhookEvent(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		violation *GuardrailViolation
+
+		expectRes string
+	}{
+		{
+			name: "normal",
+
+			violation: &GuardrailViolation{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("6a2d1f0e-ad5d-11f0-94c1-2b8e5f7a1c01"),
+					CustomerID: uuid.FromStringOrNil("6a5e7b3c-ad5d-11f0-8f2d-7c1a4e9b3d02"),
+				},
+				AIcallID:     uuid.FromStringOrNil("6a8f2c6a-ad5d-11f0-a3e6-1d9b7f2c5e03"),
+				AIID:         uuid.FromStringOrNil("6ac0b4d8-ad5d-11f0-b7f1-5e2c8a1d6f04"),
+				ActiveflowID: uuid.FromStringOrNil("6af1e2b6-ad5d-11f0-9c4a-3a7d1e5b8c05"),
+				Rule:         RuleForbiddenPhrase,
+				Detail:       "guaranteed return",
+				Content:      "This fund has a guaranteed return.",
+				Action:       guardrail.EscalationActionTransfer,
+			},
+
+			expectRes: `{"id":"6a2d1f0e-ad5d-11f0-94c1-2b8e5f7a1c01","customer_id":"6a5e7b3c-ad5d-11f0-8f2d-7c1a4e9b3d02","aicall_id":"6a8f2c6a-ad5d-11f0-a3e6-1d9b7f2c5e03","ai_id":"6ac0b4d8-ad5d-11f0-b7f1-5e2c8a1d6f04","activeflow_id":"6af1e2b6-ad5d-11f0-9c4a-3a7d1e5b8c05","rule":"forbidden_phrase","detail":"guaranteed return","content":"This fund has a guaranteed return.","action":"transfer","tm_create":null,"tm_update":null,"tm_delete":null}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := tt.violation.CreateWebhookEvent()
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			var got, expect map[string]any
+			_ = json.Unmarshal(res, &got)
+			_ = json.Unmarshal([]byte(tt.expectRes), &expect)
+			if !reflect.DeepEqual(got, expect) {
+				t.Errorf("Wrong match.\nexpect: %s\ngot: %s", tt.expectRes, res)
+			}
+		})
+	}
+}
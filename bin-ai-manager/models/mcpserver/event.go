@@ -0,0 +1,8 @@
+package mcpserver
+
+// list of event types
+const (
+	EventTypeCreated string = "mcp_server_created"
+	EventTypeUpdated string = "mcp_server_updated"
+	EventTypeDeleted string = "mcp_server_deleted"
+)
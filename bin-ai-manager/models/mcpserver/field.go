@@ -0,0 +1,20 @@
+package mcpserver
+
+// Field represents a database field name for type-safe updates.
+type Field string
+
+const (
+	FieldID           Field = "id"
+	FieldCustomerID   Field = "customer_id"
+	FieldName         Field = "name"
+	FieldDetail       Field = "detail"
+	FieldTransport    Field = "transport"
+	FieldURL          Field = "url"
+	FieldHeaders      Field = "headers"
+	FieldTimeout      Field = "timeout"
+	FieldAllowedTools Field = "allowed_tools"
+	FieldTMCreate     Field = "tm_create"
+	FieldTMUpdate     Field = "tm_update"
+	FieldTMDelete     Field = "tm_delete"
+	FieldDeleted      Field = "deleted"
+)
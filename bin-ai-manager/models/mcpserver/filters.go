@@ -0,0 +1,10 @@
+package mcpserver
+
+import "github.com/gofrs/uuid"
+
+// FieldStruct defines filterable fields for MCPServer list queries.
+type FieldStruct struct {
+	CustomerID uuid.UUID `filter:"customer_id"`
+	Name       string    `filter:"name"`
+	Deleted    bool      `filter:"deleted"`
+}
@@ -0,0 +1,87 @@
+package mcpserver
+
+import (
+	"strings"
+	"time"
+
+	"monorepo/bin-common-handler/models/identity"
+)
+
+// MCPServer is a customer-registered Model Context Protocol server. When an
+// AI lists the server's name in its tool_names, the tools, resources and
+// prompts the server advertises are discovered at session start and offered
+// to the LLM as "<name>__<tool>" functions. Calls are proxied to the server
+// by ai-manager.
+type MCPServer struct {
+	identity.Identity
+
+	Name      string    `json:"name,omitempty" db:"name"`     // namespace prefix of the exposed tool names
+	Detail    string    `json:"detail,omitempty" db:"detail"` // operator-facing note, never sent to the LLM
+	Transport Transport `json:"transport,omitempty" db:"transport"`
+
+	URL          string            `json:"url,omitempty" db:"url"`
+	Headers      map[string]string `json:"headers,omitempty" db:"headers,json"`
+	Timeout      int               `json:"timeout,omitempty" db:"timeout"`                  // per-request timeout in milliseconds
+	AllowedTools []string          `json:"allowed_tools,omitempty" db:"allowed_tools,json"` // empty allows every tool the server advertises
+
+	TMCreate *time.Time `json:"tm_create" db:"tm_create"`
+	TMUpdate *time.Time `json:"tm_update" db:"tm_update"`
+	TMDelete *time.Time `json:"tm_delete" db:"tm_delete"`
+}
+
+// Transport defines the MCP transport used to reach the server.
+type Transport string
+
+// list of transports
+const (
+	TransportStreamableHTTP Transport = "streamable_http" // MCP 2025-03-26 streamable HTTP
+	TransportSSE            Transport = "sse"             // MCP 2024-11-05 HTTP+SSE
+)
+
+// list of timeout bounds in milliseconds
+const (
+	TimeoutDefault = 10000
+	TimeoutMax     = 60000
+)
+
+// ToolNameSeparator separates the server name from the MCP tool name in
+// the function names exposed to the LLM.
+const ToolNameSeparator = "__"
+
+// list of synthetic tool names. These are exposed in addition to the
+// server's own tools when the server advertises resources or prompts.
+const (
+	ToolNameReadResource = "read_resource"
+	ToolNameGetPrompt    = "get_prompt"
+)
+
+// ExposedToolName returns the function name the LLM sees for the given MCP tool.
+func (h *MCPServer) ExposedToolName(toolName string) string {
+	return h.Name + ToolNameSeparator + toolName
+}
+
+// ParseExposedToolName splits an exposed function name into the server name
+// and the MCP tool name. ok is false if name is not in the exposed form.
+func ParseExposedToolName(name string) (serverName string, toolName string, ok bool) {
+	serverName, toolName, ok = strings.Cut(name, ToolNameSeparator)
+	if !ok || serverName == "" || toolName == "" {
+		return "", "", false
+	}
+
+	return serverName, toolName, true
+}
+
+// IsToolAllowed returns true if the server's allowlist permits the given MCP tool.
+func (h *MCPServer) IsToolAllowed(toolName string) bool {
+	if len(h.AllowedTools) == 0 {
+		return true
+	}
+
+	for _, t := range h.AllowedTools {
+		if t == toolName {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,91 @@
+package mcpserver
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/gofrs/uuid"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+)
+
+func Test_ParseExposedToolName(t *testing.T) {
+	tests := []struct {
+		name string
+
+		exposedName string
+
+		expectServerName string
+		expectToolName   string
+		expectOK         bool
+	}{
+		{"normal", "crm__lookup_contact", "crm", "lookup_contact", true},
+		{"tool name with separator", "crm__get__all", "crm", "get__all", true},
+		{"no separator", "lookup_contact", "", "", false},
+		{"empty server name", "__lookup", "", "", false},
+		{"empty tool name", "crm__", "", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serverName, toolName, ok := ParseExposedToolName(tt.exposedName)
+			if serverName != tt.expectServerName || toolName != tt.expectToolName || ok != tt.expectOK {
+				t.Errorf("Wrong match. expect: %s, %s, %v, got: %s, %s, %v", tt.expectServerName, tt.expectToolName, tt.expectOK, serverName, toolName, ok)
+			}
+		})
+	}
+}
+
+func Test_IsToolAllowed(t *testing.T) {
+	tests := []struct {
+		name string
+
+		allowedTools []string
+		toolName     string
+
+		expectRes bool
+	}{
+		{"empty allowlist allows everything", nil, "lookup_contact", true},
+		{"listed", []string{"lookup_contact", ToolNameReadResource}, "lookup_contact", true},
+		{"listed synthetic", []string{"lookup_contact", ToolNameReadResource}, ToolNameReadResource, true},
+		{"not listed", []string{"lookup_contact"}, "delete_contact", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &MCPServer{AllowedTools: tt.allowedTools}
+			if res := s.IsToolAllowed(tt.toolName); res != tt.expectRes {
+				t.Errorf("Wrong match. expect: %v, got: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_CreateWebhookEvent(t *testing.T) {
+	s := &MCPServer{
+		Identity: commonidentity.Identity{
+			ID:         uuid.FromStringOrNil("9c1d2e3f-a904-11f0-8a1b-2c3d4e5f6a01"),
+			CustomerID: uuid.FromStringOrNil("9c4e5f60-a904-11f0-9b2c-3d4e5f6a7b02"),
+		},
+		Name:         "crm",
+		Transport:    TransportStreamableHTTP,
+		URL:          "https://mcp.example.com/mcp",
+		Timeout:      10000,
+		AllowedTools: []string{"lookup_contact"},
+	}
+
+	data, err := s.CreateWebhookEvent()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	res := &WebhookMessage{}
+	if err := json.Unmarshal(data, res); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(res, s.ConvertWebhookMessage()) {
+		t.Errorf("Wrong match.\nexpect: %v\ngot: %v", s.ConvertWebhookMessage(), res)
+	}
+}
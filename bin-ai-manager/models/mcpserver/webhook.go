@@ -0,0 +1,58 @@
+package mcpserver
+
+import (
+	"encoding/json"
+	"time"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+)
+
+// WebhookMessage is the external-facing representation of an MCPServer.
+type WebhookMessage struct {
+	commonidentity.Identity
+
+	Name      string    `json:"name,omitempty"`
+	Detail    string    `json:"detail,omitempty"`
+	Transport Transport `json:"transport,omitempty"`
+
+	URL          string            `json:"url,omitempty"`
+	Headers      map[string]string `json:"headers,omitempty"`
+	Timeout      int               `json:"timeout,omitempty"`
+	AllowedTools []string          `json:"allowed_tools,omitempty"`
+
+	TMCreate *time.Time `json:"tm_create"`
+	TMUpdate *time.Time `json:"tm_update"`
+	TMDelete *time.Time `json:"tm_delete"`
+}
+
+// ConvertWebhookMessage converts the internal MCPServer to an external WebhookMessage.
+func (h *MCPServer) ConvertWebhookMessage() *WebhookMessage {
+	return &WebhookMessage{
+		Identity: h.Identity,
+
+		Name:      h.Name,
+		Detail:    h.Detail,
+		Transport: h.Transport,
+
+		URL:          h.URL,
+		Headers:      h.Headers,
+		Timeout:      h.Timeout,
+		AllowedTools: h.AllowedTools,
+
+		TMCreate: h.TMCreate,
+		TMUpdate: h.TMUpdate,
+		TMDelete: h.TMDelete,
+	}
+}
+
+// CreateWebhookEvent generate WebhookEvent
+func (h *MCPServer) CreateWebhookEvent() ([]byte, error) {
+	e := h.ConvertWebhookMessage()
+
+	m, err := json.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
@@ -3,6 +3,8 @@ package message
 import (
 	"time"
 
+	"monorepo/bin-ai-manager/models/ai"
+	"monorepo/bin-ai-manager/models/usage"
 	"monorepo/bin-common-handler/models/identity"
 
 	"github.com/gofrs/uuid"
@@ -23,6 +25,17 @@ type Message struct {
 	ToolCalls  []ToolCall `json:"tool_calls,omitempty" db:"tool_calls,json"`
 	ToolCallID string     `json:"tool_call_id,omitempty" db:"tool_call_id"`
 
+	// EngineModel is the LLM engine that generated an assistant message. It
+	// differs from the AI's configured engine model when the call failed over
+	// to one of its engine fallbacks.
+	EngineModel ai.EngineModel `json:"engine_model,omitempty" db:"engine_model"`
+
+	// Usage is the AI provider usage of the message. An assistant message
+	// carries the LLM tokens of its generation and the TTS audio generated
+	// since the previous assistant message. A user message carries the STT
+	// audio streamed since the previous user message.
+	usage.Usage `json:"usage,omitzero"`
+
 	PipecatcallID  uuid.UUID      `json:"-" db:"pipecatcall_id,uuid"`
 	DeliveryStatus DeliveryStatus `json:"-" db:"delivery_status"`
 
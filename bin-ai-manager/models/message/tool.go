@@ -42,6 +42,7 @@ const (
 	FunctionCallNameGetResource       FunctionCallName = "get_resource"
 	FunctionCallNameDescribeAction    FunctionCallName = "describe_action"
 	FunctionCallNameCaseCreate        FunctionCallName = "case_create"
+	FunctionCallNameSetDisposition    FunctionCallName = "set_disposition"
 
 	FunctionCallNameGetContactInteractions FunctionCallName = "get_contact_interactions"
 	FunctionCallNameGetConversationContent FunctionCallName = "get_conversation_content"
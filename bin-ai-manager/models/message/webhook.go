@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"time"
 
+	"monorepo/bin-ai-manager/models/ai"
+	"monorepo/bin-ai-manager/models/usage"
 	"monorepo/bin-common-handler/models/identity"
 
 	"github.com/gofrs/uuid"
@@ -23,6 +25,10 @@ type WebhookMessage struct {
 	ToolCalls  []ToolCall `json:"tool_calls,omitempty"`
 	ToolCallID string     `json:"tool_call_id,omitempty"`
 
+	EngineModel ai.EngineModel `json:"engine_model,omitempty"`
+
+	Usage usage.Usage `json:"usage,omitzero"`
+
 	TMCreate *time.Time `json:"tm_create"`
 }
 
@@ -41,6 +47,10 @@ func (h *Message) ConvertWebhookMessage() *WebhookMessage {
 		ToolCalls:  h.ToolCalls,
 		ToolCallID: h.ToolCallID,
 
+		EngineModel: h.EngineModel,
+
+		Usage: h.Usage,
+
 		TMCreate: h.TMCreate,
 	}
 }
@@ -0,0 +1,128 @@
+package redaction
+
+import (
+	"fmt"
+
+	cmcustomer "monorepo/bin-customer-manager/models/customer"
+)
+
+// Config defines which personal data is redacted from an aicall's messages
+// and how.
+//
+// An AI's config overrides its customer's default. An AI config with no entity
+// types turns the redaction off for the AI even when the customer has a default.
+type Config struct {
+	EntityTypes []EntityType `json:"entity_types,omitempty"`
+	Mode        Mode         `json:"mode,omitempty"`
+
+	// ToolAccess, when true, restores the tokenized values in the tool call
+	// arguments, so the tools still receive the real values. Only applies to
+	// ModeTokenize.
+	ToolAccess bool `json:"tool_access,omitempty"`
+}
+
+// EntityType defines the kind of personal data to detect.
+type EntityType string
+
+// list of entity types
+const (
+	EntityTypeCreditCard  EntityType = "credit_card"  // 13-19 digits passing the Luhn check
+	EntityTypeIBAN        EntityType = "iban"         // international bank account number passing the mod-97 check
+	EntityTypeSSN         EntityType = "ssn"          // US social security number style (AAA-GG-SSSS)
+	EntityTypeEmail       EntityType = "email"        // email address
+	EntityTypePhoneNumber EntityType = "phone_number" // 8-15 digits phone number
+)
+
+// EntityTypes lists all the entity types in the order they are detected.
+// When detections overlap, the one of the earlier entity type wins.
+var EntityTypes = []EntityType{
+	EntityTypeCreditCard,
+	EntityTypeIBAN,
+	EntityTypeSSN,
+	EntityTypeEmail,
+	EntityTypePhoneNumber,
+}
+
+// Mode defines how the detected personal data is redacted.
+type Mode string
+
+// list of modes
+const (
+	ModeReplace  Mode = "replace"  // replaced by the entity type. e.g. [CREDIT_CARD]
+	ModeMask     Mode = "mask"     // masked except the last 4 characters. e.g. **** **** **** 1111, j***@example.com
+	ModeTokenize Mode = "tokenize" // replaced by a token that is restorable within the aicall. e.g. [CREDIT_CARD_QWERTY]
+)
+
+// DefaultMode is the mode used when the config has no mode.
+const DefaultMode = ModeReplace
+
+// IsEnabled returns true if the config redacts anything.
+func (h *Config) IsEnabled() bool {
+	return h != nil && len(h.EntityTypes) > 0
+}
+
+// GetMode returns the config's mode, or DefaultMode if not set.
+func (h *Config) GetMode() Mode {
+	if h.Mode == "" {
+		return DefaultMode
+	}
+	return h.Mode
+}
+
+// Validate checks the config. A nil config is valid.
+func Validate(c *Config) error {
+	if c == nil {
+		return nil
+	}
+
+	seen := map[EntityType]bool{}
+	for _, t := range c.EntityTypes {
+		if !isValidEntityType(t) {
+			return fmt.Errorf("invalid entity type: %s", t)
+		}
+		if seen[t] {
+			return fmt.Errorf("duplicated entity type: %s", t)
+		}
+		seen[t] = true
+	}
+
+	switch c.Mode {
+	case "", ModeReplace, ModeMask, ModeTokenize:
+	default:
+		return fmt.Errorf("invalid mode: %s", c.Mode)
+	}
+
+	if c.ToolAccess && c.GetMode() != ModeTokenize {
+		return fmt.Errorf("tool_access requires the %s mode", ModeTokenize)
+	}
+
+	return nil
+}
+
+func isValidEntityType(t EntityType) bool {
+	for _, e := range EntityTypes {
+		if e == t {
+			return true
+		}
+	}
+	return false
+}
+
+// FromCustomer returns the redaction config of the customer's default.
+// Returns nil if the given config is nil.
+func FromCustomer(c *cmcustomer.PIIRedaction) *Config {
+	if c == nil {
+		return nil
+	}
+
+	res := &Config{
+		EntityTypes: make([]EntityType, 0, len(c.EntityTypes)),
+		Mode:        Mode(c.Mode),
+		ToolAccess:  c.ToolAccess,
+	}
+	for _, t := range c.EntityTypes {
+		res.EntityTypes = append(res.EntityTypes, EntityType(t))
+	}
+
+	return res
+}
@@ -0,0 +1,143 @@
+package redaction
+
+import (
+	"reflect"
+	"testing"
+
+	cmcustomer "monorepo/bin-customer-manager/models/customer"
+)
+
+func Test_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		config    *Config
+		wantError bool
+	}{
+		{
+			name:   "nil config is valid",
+			config: nil,
+		},
+		{
+			name:   "empty config is valid",
+			config: &Config{},
+		},
+		{
+			name: "valid config",
+			config: &Config{
+				EntityTypes: []EntityType{EntityTypeCreditCard, EntityTypeEmail},
+				Mode:        ModeTokenize,
+				ToolAccess:  true,
+			},
+		},
+		{
+			name: "invalid entity type",
+			config: &Config{
+				EntityTypes: []EntityType{"passport"},
+			},
+			wantError: true,
+		},
+		{
+			name: "duplicated entity type",
+			config: &Config{
+				EntityTypes: []EntityType{EntityTypeIBAN, EntityTypeIBAN},
+			},
+			wantError: true,
+		},
+		{
+			name: "invalid mode",
+			config: &Config{
+				EntityTypes: []EntityType{EntityTypeSSN},
+				Mode:        "hash",
+			},
+			wantError: true,
+		},
+		{
+			name: "tool access without tokenize mode",
+			config: &Config{
+				EntityTypes: []EntityType{EntityTypeSSN},
+				Mode:        ModeMask,
+				ToolAccess:  true,
+			},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.config)
+			if (err != nil) != tt.wantError {
+				t.Errorf("Validate() error = %v, wantError %v", err, tt.wantError)
+			}
+		})
+	}
+}
+
+func Test_IsEnabled(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *Config
+
+		expectRes bool
+	}{
+		{
+			name:      "nil config",
+			config:    nil,
+			expectRes: false,
+		},
+		{
+			name:      "no entity types",
+			config:    &Config{Mode: ModeMask},
+			expectRes: false,
+		},
+		{
+			name:      "has entity types",
+			config:    &Config{EntityTypes: []EntityType{EntityTypeEmail}},
+			expectRes: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if res := tt.config.IsEnabled(); res != tt.expectRes {
+				t.Errorf("Wrong match. expect: %v, got: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_FromCustomer(t *testing.T) {
+	tests := []struct {
+		name  string
+		input *cmcustomer.PIIRedaction
+
+		expectRes *Config
+	}{
+		{
+			name:      "nil",
+			input:     nil,
+			expectRes: nil,
+		},
+		{
+			name: "normal",
+			input: &cmcustomer.PIIRedaction{
+				EntityTypes: []string{"credit_card", "iban"},
+				Mode:        "tokenize",
+				ToolAccess:  true,
+			},
+			expectRes: &Config{
+				EntityTypes: []EntityType{EntityTypeCreditCard, EntityTypeIBAN},
+				Mode:        ModeTokenize,
+				ToolAccess:  true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := FromCustomer(tt.input)
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
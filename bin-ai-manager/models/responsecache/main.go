@@ -0,0 +1,127 @@
+package responsecache
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Config defines the semantic response cache of an AI. The AI's responses
+// are reused for the user turns similar enough to the ones answered before,
+// without asking the LLM again.
+//
+// The cache is dropped whenever the AI's init prompt or its rag changes.
+type Config struct {
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Threshold is the minimum cosine similarity of the user turns to reuse
+	// a response. 0 uses DefaultThreshold.
+	Threshold float64 `json:"threshold,omitempty"`
+
+	// TTL is how long a response is reused, in seconds. 0 uses DefaultTTL.
+	TTL int `json:"ttl,omitempty"`
+
+	// Turns is the number of the latest user turns the cache is keyed on.
+	// 0 uses DefaultTurns.
+	Turns int `json:"turns,omitempty"`
+
+	// Variables are the activeflow variables the cache is keyed on. A response
+	// is reused only when these variables have the same values.
+	Variables []string `json:"variables,omitempty"`
+}
+
+// Entry is a cached response.
+type Entry struct {
+	Question  string    `json:"question,omitempty"`  // the user turns the response was given to
+	Embedding []float32 `json:"embedding,omitempty"` // the question's embedding
+	Response  string    `json:"response,omitempty"`
+
+	TMCreate *time.Time `json:"tm_create"`
+}
+
+// Pending is the question of the aicall's last cache miss, waiting for the
+// LLM's response to be stored.
+type Pending struct {
+	Bucket    string    `json:"bucket,omitempty"`
+	Question  string    `json:"question,omitempty"`
+	Embedding []float32 `json:"embedding,omitempty"`
+}
+
+// list of defaults
+const (
+	DefaultThreshold = 0.95
+	DefaultTTL       = 60 * 60 * 24 // 1 day
+	DefaultTurns     = 1
+)
+
+// list of limits
+const (
+	MinThreshold = 0.5
+	MaxTTL       = 60 * 60 * 24 * 30 // 30 days
+	MaxTurns     = 5
+
+	MaxVariables      = 10
+	MaxVariableLength = 100
+)
+
+// IsEnabled returns true if the cache is enabled.
+func (h *Config) IsEnabled() bool {
+	return h != nil && h.Enabled
+}
+
+// GetThreshold returns the config's threshold, or the default if not set.
+func (h *Config) GetThreshold() float64 {
+	if h.Threshold == 0 {
+		return DefaultThreshold
+	}
+	return h.Threshold
+}
+
+// GetTTL returns the config's ttl, or the default if not set.
+func (h *Config) GetTTL() time.Duration {
+	if h.TTL == 0 {
+		return time.Duration(DefaultTTL) * time.Second
+	}
+	return time.Duration(h.TTL) * time.Second
+}
+
+// GetTurns returns the config's turns, or the default if not set.
+func (h *Config) GetTurns() int {
+	if h.Turns == 0 {
+		return DefaultTurns
+	}
+	return h.Turns
+}
+
+// Validate checks the config. A nil config is valid.
+func Validate(c *Config) error {
+	if c == nil {
+		return nil
+	}
+
+	if c.Threshold != 0 && (c.Threshold < MinThreshold || c.Threshold > 1) {
+		return fmt.Errorf("threshold must be between %.1f and 1", MinThreshold)
+	}
+
+	if c.TTL < 0 || c.TTL > MaxTTL {
+		return fmt.Errorf("ttl must be between 0 and %d", MaxTTL)
+	}
+
+	if c.Turns < 0 || c.Turns > MaxTurns {
+		return fmt.Errorf("turns must be between 0 and %d", MaxTurns)
+	}
+
+	if len(c.Variables) > MaxVariables {
+		return fmt.Errorf("too many variables. max: %d", MaxVariables)
+	}
+	for _, v := range c.Variables {
+		if strings.TrimSpace(v) == "" {
+			return fmt.Errorf("empty variable")
+		}
+		if len(v) > MaxVariableLength {
+			return fmt.Errorf("variable exceeds %d characters: %s", MaxVariableLength, v)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,166 @@
+package responsecache
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		config    *Config
+		wantError bool
+	}{
+		{
+			name:      "nil config",
+			config:    nil,
+			wantError: false,
+		},
+		{
+			name: "valid config",
+			config: &Config{
+				Enabled:   true,
+				Threshold: 0.9,
+				TTL:       3600,
+				Turns:     2,
+				Variables: []string{"customer.language"},
+			},
+			wantError: false,
+		},
+		{
+			name:      "defaults",
+			config:    &Config{Enabled: true},
+			wantError: false,
+		},
+		{
+			name:      "threshold too low",
+			config:    &Config{Threshold: 0.3},
+			wantError: true,
+		},
+		{
+			name:      "threshold too high",
+			config:    &Config{Threshold: 1.1},
+			wantError: true,
+		},
+		{
+			name:      "negative ttl",
+			config:    &Config{TTL: -1},
+			wantError: true,
+		},
+		{
+			name:      "ttl too long",
+			config:    &Config{TTL: MaxTTL + 1},
+			wantError: true,
+		},
+		{
+			name:      "too many turns",
+			config:    &Config{Turns: MaxTurns + 1},
+			wantError: true,
+		},
+		{
+			name:      "empty variable",
+			config:    &Config{Variables: []string{" "}},
+			wantError: true,
+		},
+		{
+			name:      "variable too long",
+			config:    &Config{Variables: []string{strings.Repeat("a", MaxVariableLength+1)}},
+			wantError: true,
+		},
+		{
+			name:      "too many variables",
+			config:    &Config{Variables: strings.Split(strings.Repeat("a,", MaxVariables), ",")},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.config)
+			if (err != nil) != tt.wantError {
+				t.Errorf("Validate() error = %v, wantError %v", err, tt.wantError)
+			}
+		})
+	}
+}
+
+func Test_Getters(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *Config
+
+		expectThreshold float64
+		expectTTL       time.Duration
+		expectTurns     int
+	}{
+		{
+			name:   "defaults",
+			config: &Config{Enabled: true},
+
+			expectThreshold: DefaultThreshold,
+			expectTTL:       time.Duration(DefaultTTL) * time.Second,
+			expectTurns:     DefaultTurns,
+		},
+		{
+			name: "set",
+			config: &Config{
+				Enabled:   true,
+				Threshold: 0.9,
+				TTL:       600,
+				Turns:     3,
+			},
+
+			expectThreshold: 0.9,
+			expectTTL:       10 * time.Minute,
+			expectTurns:     3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if res := tt.config.GetThreshold(); res != tt.expectThreshold {
+				t.Errorf("Wrong match. expect: %v, got: %v", tt.expectThreshold, res)
+			}
+			if res := tt.config.GetTTL(); res != tt.expectTTL {
+				t.Errorf("Wrong match. expect: %v, got: %v", tt.expectTTL, res)
+			}
+			if res := tt.config.GetTurns(); res != tt.expectTurns {
+				t.Errorf("Wrong match. expect: %v, got: %v", tt.expectTurns, res)
+			}
+		})
+	}
+}
+
+func Test_IsEnabled(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *Config
+
+		expectRes bool
+	}{
+		{
+			name:      "nil config",
+			config:    nil,
+			expectRes: false,
+		},
+		{
+			name:      "disabled",
+			config:    &Config{Threshold: 0.9},
+			expectRes: false,
+		},
+		{
+			name:      "enabled",
+			config:    &Config{Enabled: true},
+			expectRes: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if res := tt.config.IsEnabled(); res != tt.expectRes {
+				t.Errorf("Wrong match. expect: %v, got: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
@@ -18,6 +18,10 @@ const (
 	FieldLanguage Field = "language"
 	FieldContent  Field = "content"
 
+	FieldPromptTokens     Field = "prompt_tokens"
+	FieldCompletionTokens Field = "completion_tokens"
+	FieldCachedTokens     Field = "cached_tokens"
+
 	FieldTMCreate Field = "tm_create"
 	FieldTMUpdate Field = "tm_update"
 	FieldTMDelete Field = "tm_delete"
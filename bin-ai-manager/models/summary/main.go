@@ -3,6 +3,7 @@ package summary
 import (
 	"time"
 
+	"monorepo/bin-ai-manager/models/usage"
 	commonidentity "monorepo/bin-common-handler/models/identity"
 
 	"github.com/gofrs/uuid"
@@ -21,6 +22,9 @@ type Summary struct {
 	Language string `json:"language,omitempty" db:"language"`
 	Content  string `json:"content,omitempty" db:"content"`
 
+	// Usage is the LLM usage of generating the summary content.
+	usage.Usage `json:"usage,omitzero"`
+
 	TMCreate *time.Time `json:"tm_create" db:"tm_create"`
 	TMUpdate *time.Time `json:"tm_update" db:"tm_update"`
 	TMDelete *time.Time `json:"tm_delete" db:"tm_delete"`
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"time"
 
+	"monorepo/bin-ai-manager/models/usage"
 	commonidentity "monorepo/bin-common-handler/models/identity"
 
 	"github.com/gofrs/uuid"
@@ -23,6 +24,8 @@ type WebhookMessage struct {
 	Language string `json:"language,omitempty"`
 	Content  string `json:"content,omitempty"`
 
+	Usage usage.Usage `json:"usage,omitzero"`
+
 	TMCreate *time.Time `json:"tm_create"`
 	TMUpdate *time.Time `json:"tm_update"`
 	TMDelete *time.Time `json:"tm_delete"`
@@ -43,6 +46,8 @@ func (h *Summary) ConvertWebhookMessage() *WebhookMessage {
 		Language: h.Language,
 		Content:  h.Content,
 
+		Usage: h.Usage,
+
 		TMCreate: h.TMCreate,
 		TMUpdate: h.TMUpdate,
 		TMDelete: h.TMDelete,
@@ -0,0 +1,8 @@
+package testrun
+
+// list of event types
+const (
+	EventTypeCreated string = "test_run_created"
+	EventTypeUpdated string = "test_run_updated"
+	EventTypeDeleted string = "test_run_deleted"
+)
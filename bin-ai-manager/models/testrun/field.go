@@ -0,0 +1,18 @@
+package testrun
+
+// Field represents a database field name for type-safe updates.
+type Field string
+
+const (
+	FieldID              Field = "id"
+	FieldCustomerID      Field = "customer_id"
+	FieldTestSuiteID     Field = "test_suite_id"
+	FieldAIID            Field = "ai_id"
+	FieldPromptHistoryID Field = "prompt_history_id"
+	FieldStatus          Field = "status"
+	FieldResults         Field = "results"
+	FieldTMCreate        Field = "tm_create"
+	FieldTMUpdate        Field = "tm_update"
+	FieldTMDelete        Field = "tm_delete"
+	FieldDeleted         Field = "deleted"
+)
@@ -0,0 +1,13 @@
+package testrun
+
+import "github.com/gofrs/uuid"
+
+// FieldStruct defines filterable fields for TestRun list queries.
+type FieldStruct struct {
+	CustomerID      uuid.UUID `filter:"customer_id"`
+	TestSuiteID     uuid.UUID `filter:"test_suite_id"`
+	AIID            uuid.UUID `filter:"ai_id"`
+	PromptHistoryID uuid.UUID `filter:"prompt_history_id"`
+	Status          Status    `filter:"status"`
+	Deleted         bool      `filter:"deleted"`
+}
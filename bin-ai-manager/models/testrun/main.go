@@ -0,0 +1,37 @@
+package testrun
+
+import (
+	"time"
+
+	"monorepo/bin-common-handler/models/identity"
+
+	"github.com/gofrs/uuid"
+)
+
+// TestRun is a single run of a test suite. It keeps the AI's prompt history
+// the suite was run against, so the results can be compared across prompt versions.
+type TestRun struct {
+	identity.Identity
+
+	TestSuiteID     uuid.UUID `json:"test_suite_id,omitempty" db:"test_suite_id,uuid"`
+	AIID            uuid.UUID `json:"ai_id,omitempty" db:"ai_id,uuid"`
+	PromptHistoryID uuid.UUID `json:"prompt_history_id,omitempty" db:"prompt_history_id,uuid"` // the AI's current prompt history at the time of the run.
+
+	Status  Status   `json:"status,omitempty" db:"status"`
+	Results []Result `json:"results,omitempty" db:"results,json"` // one result per scenario, in the suite's order.
+
+	TMCreate *time.Time `json:"tm_create" db:"tm_create"`
+	TMUpdate *time.Time `json:"tm_update" db:"tm_update"`
+	TMDelete *time.Time `json:"tm_delete" db:"tm_delete"`
+}
+
+// Status defines the test run status.
+type Status string
+
+// list of statuses
+const (
+	StatusNone        Status = ""
+	StatusProgressing Status = "progressing"
+	StatusPassed      Status = "passed" // every scenario has passed.
+	StatusFailed      Status = "failed" // at least one scenario has failed.
+)
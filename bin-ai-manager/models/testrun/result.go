@@ -0,0 +1,26 @@
+package testrun
+
+import (
+	"monorepo/bin-ai-manager/models/testsuite"
+
+	"github.com/gofrs/uuid"
+)
+
+// Result is the result of a single scenario.
+type Result struct {
+	Scenario string    `json:"scenario,omitempty"`  // the scenario's name
+	AIcallID uuid.UUID `json:"aicall_id,omitempty"` // the aicall the scenario was run with. the conversation is kept in its messages.
+
+	Passed bool   `json:"passed"`
+	Turns  int    `json:"turns"`           // the number of the user turns sent.
+	Error  string `json:"error,omitempty"` // set when the scenario could not be run to the end.
+
+	Assertions []AssertionResult `json:"assertions,omitempty"`
+}
+
+// AssertionResult is the result of a single assertion.
+type AssertionResult struct {
+	Assertion testsuite.Assertion `json:"assertion"`
+	Passed    bool                `json:"passed"`
+	Reason    string              `json:"reason,omitempty"` // why the assertion has failed.
+}
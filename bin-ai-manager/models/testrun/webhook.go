@@ -0,0 +1,56 @@
+package testrun
+
+import (
+	"encoding/json"
+	"time"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+
+	"github.com/gofrs/uuid"
+)
+
+// WebhookMessage is the external-facing representation of a TestRun.
+type WebhookMessage struct {
+	commonidentity.Identity
+
+	TestSuiteID     uuid.UUID `json:"test_suite_id,omitempty"`
+	AIID            uuid.UUID `json:"ai_id,omitempty"`
+	PromptHistoryID uuid.UUID `json:"prompt_history_id,omitempty"`
+
+	Status  Status   `json:"status,omitempty"`
+	Results []Result `json:"results,omitempty"`
+
+	TMCreate *time.Time `json:"tm_create"`
+	TMUpdate *time.Time `json:"tm_update"`
+	TMDelete *time.Time `json:"tm_delete"`
+}
+
+// ConvertWebhookMessage converts the internal TestRun to an external WebhookMessage.
+func (h *TestRun) ConvertWebhookMessage() *WebhookMessage {
+	return &WebhookMessage{
+		Identity: h.Identity,
+
+		TestSuiteID:     h.TestSuiteID,
+		AIID:            h.AIID,
+		PromptHistoryID: h.PromptHistoryID,
+
+		Status:  h.Status,
+		Results: h.Results,
+
+		TMCreate: h.TMCreate,
+		TMUpdate: h.TMUpdate,
+		TMDelete: h.TMDelete,
+	}
+}
+
+// CreateWebhookEvent generate WebhookEvent
+func (h *TestRun) CreateWebhookEvent() ([]byte, error) {
+	e := h.ConvertWebhookMessage()
+
+	m, err := json.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
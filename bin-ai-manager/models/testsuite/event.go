@@ -0,0 +1,8 @@
+package testsuite
+
+// list of event types
+const (
+	EventTypeCreated string = "test_suite_created"
+	EventTypeUpdated string = "test_suite_updated"
+	EventTypeDeleted string = "test_suite_deleted"
+)
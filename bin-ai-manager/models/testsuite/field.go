@@ -0,0 +1,17 @@
+package testsuite
+
+// Field represents a database field name for type-safe updates.
+type Field string
+
+const (
+	FieldID         Field = "id"
+	FieldCustomerID Field = "customer_id"
+	FieldAIID       Field = "ai_id"
+	FieldName       Field = "name"
+	FieldDetail     Field = "detail"
+	FieldScenarios  Field = "scenarios"
+	FieldTMCreate   Field = "tm_create"
+	FieldTMUpdate   Field = "tm_update"
+	FieldTMDelete   Field = "tm_delete"
+	FieldDeleted    Field = "deleted"
+)
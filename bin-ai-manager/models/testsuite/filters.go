@@ -0,0 +1,11 @@
+package testsuite
+
+import "github.com/gofrs/uuid"
+
+// FieldStruct defines filterable fields for TestSuite list queries.
+type FieldStruct struct {
+	CustomerID uuid.UUID `filter:"customer_id"`
+	AIID       uuid.UUID `filter:"ai_id"`
+	Name       string    `filter:"name"`
+	Deleted    bool      `filter:"deleted"`
+}
@@ -0,0 +1,36 @@
+package testsuite
+
+import (
+	"time"
+
+	"monorepo/bin-common-handler/models/identity"
+
+	"github.com/gofrs/uuid"
+)
+
+// TestSuite is a set of conversation scenarios used to regression-test an AI.
+// Running the suite drives a text-only aicall per scenario and checks the
+// scenario's assertions against the conversation. The tools called by the AI
+// during a run are recorded but never executed.
+type TestSuite struct {
+	identity.Identity
+
+	AIID uuid.UUID `json:"ai_id,omitempty" db:"ai_id,uuid"`
+
+	Name   string `json:"name,omitempty" db:"name"`
+	Detail string `json:"detail,omitempty" db:"detail"`
+
+	Scenarios []Scenario `json:"scenarios,omitempty" db:"scenarios,json"`
+
+	TMCreate *time.Time `json:"tm_create" db:"tm_create"`
+	TMUpdate *time.Time `json:"tm_update" db:"tm_update"`
+	TMDelete *time.Time `json:"tm_delete" db:"tm_delete"`
+}
+
+// list of limits
+const (
+	MaxScenarios = 20
+	MaxTurns     = 20
+
+	DefaultSimulatedMaxTurns = 10
+)
@@ -0,0 +1,56 @@
+package testsuite
+
+// Scenario is a single conversation of the test suite.
+type Scenario struct {
+	Name string       `json:"name,omitempty"`
+	Type ScenarioType `json:"type,omitempty"`
+
+	// Turns are the user messages sent in order. Valid only for the scripted scenario.
+	Turns []string `json:"turns,omitempty"`
+
+	// Persona and Goal describe the simulated user. Valid only for the simulated scenario.
+	// The LLM plays the persona until the goal is reached or MaxTurns is reached.
+	Persona  string `json:"persona,omitempty"`
+	Goal     string `json:"goal,omitempty"`
+	MaxTurns int    `json:"max_turns,omitempty"`
+
+	Assertions []Assertion `json:"assertions,omitempty"`
+}
+
+// ScenarioType defines how the user turns of the scenario are made.
+type ScenarioType string
+
+// list of scenario types
+const (
+	ScenarioTypeScripted  ScenarioType = "scripted"  // the user turns are given in the scenario.
+	ScenarioTypeSimulated ScenarioType = "simulated" // the user turns are made by the LLM playing the persona.
+)
+
+// Assertion is a check made on the conversation of the scenario.
+type Assertion struct {
+	Type AssertionType `json:"type,omitempty"`
+
+	// ToolName and Arguments are used by the tool_called and tool_not_called assertions.
+	// The Arguments match if every given argument equals the called one.
+	ToolName  string         `json:"tool_name,omitempty"`
+	Arguments map[string]any `json:"arguments,omitempty"`
+
+	// Text is used by the must_mention and must_not_mention assertions.
+	// It is matched case-insensitively against the AI's replies.
+	Text string `json:"text,omitempty"`
+
+	// Value is used by the max_turns assertion.
+	Value int `json:"value,omitempty"`
+}
+
+// AssertionType defines the type of the assertion.
+type AssertionType string
+
+// list of assertion types
+const (
+	AssertionTypeToolCalled     AssertionType = "tool_called"
+	AssertionTypeToolNotCalled  AssertionType = "tool_not_called"
+	AssertionTypeMustMention    AssertionType = "must_mention"
+	AssertionTypeMustNotMention AssertionType = "must_not_mention"
+	AssertionTypeMaxTurns       AssertionType = "max_turns" // the conversation must end within the given number of user turns.
+)
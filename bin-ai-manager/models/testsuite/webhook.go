@@ -0,0 +1,56 @@
+package testsuite
+
+import (
+	"encoding/json"
+	"time"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+
+	"github.com/gofrs/uuid"
+)
+
+// WebhookMessage is the external-facing representation of a TestSuite.
+type WebhookMessage struct {
+	commonidentity.Identity
+
+	AIID uuid.UUID `json:"ai_id,omitempty"`
+
+	Name   string `json:"name,omitempty"`
+	Detail string `json:"detail,omitempty"`
+
+	Scenarios []Scenario `json:"scenarios,omitempty"`
+
+	TMCreate *time.Time `json:"tm_create"`
+	TMUpdate *time.Time `json:"tm_update"`
+	TMDelete *time.Time `json:"tm_delete"`
+}
+
+// ConvertWebhookMessage converts the internal TestSuite to an external WebhookMessage.
+func (h *TestSuite) ConvertWebhookMessage() *WebhookMessage {
+	return &WebhookMessage{
+		Identity: h.Identity,
+
+		AIID: h.AIID,
+
+		Name:   h.Name,
+		Detail: h.Detail,
+
+		Scenarios: h.Scenarios,
+
+		TMCreate: h.TMCreate,
+		TMUpdate: h.TMUpdate,
+		TMDelete: h.TMDelete,
+	}
+}
+
+// CreateWebhookEvent generate WebhookEvent
+func (h *TestSuite) CreateWebhookEvent() ([]byte, error) {
+	e := h.ConvertWebhookMessage()
+
+	m, err := json.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
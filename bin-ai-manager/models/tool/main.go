@@ -21,6 +21,7 @@ const (
 	ToolNameGetResource       ToolName = "get_resource"
 	ToolNameDescribeAction    ToolName = "describe_action"
 	ToolNameCaseCreate        ToolName = "case_create"
+	ToolNameSetDisposition    ToolName = "set_disposition"
 
 	// Insight AI tool set (VOIP-1234).
 	ToolNameGetContactInteractions ToolName = "get_contact_interactions"
@@ -49,6 +50,7 @@ var AllToolNames = []ToolName{
 	ToolNameGetResource,
 	ToolNameDescribeAction,
 	ToolNameCaseCreate,
+	ToolNameSetDisposition,
 }
 
 // AllInsightToolNames defines the tool set available to ai.TypeInsight AIs.
@@ -0,0 +1,49 @@
+package usage
+
+import (
+	pmpipecatcall "monorepo/bin-pipecat-manager/models/pipecatcall"
+)
+
+// Usage is the AI provider usage of a message, an aicall, a summary or an audit.
+//
+// It is embedded into those models, so the counters are stored as their own
+// columns (prompt_tokens, completion_tokens, ...) and exposed as a nested
+// "usage" object.
+type Usage struct {
+	PromptTokens     int64 `json:"prompt_tokens" db:"prompt_tokens"`         // LLM input tokens, including the cached ones
+	CompletionTokens int64 `json:"completion_tokens" db:"completion_tokens"` // LLM output tokens
+	CachedTokens     int64 `json:"cached_tokens" db:"cached_tokens"`         // LLM input tokens served from the provider's prompt cache
+
+	STTSeconds float64 `json:"stt_seconds" db:"stt_seconds"` // seconds of audio sent to the speech-to-text engine
+	TTSSeconds float64 `json:"tts_seconds" db:"tts_seconds"` // seconds of audio generated by the text-to-speech engine
+}
+
+// IsEmpty returns true if the usage has nothing recorded.
+func (h *Usage) IsEmpty() bool {
+	return h.PromptTokens == 0 && h.CompletionTokens == 0 && h.CachedTokens == 0 && h.STTSeconds == 0 && h.TTSSeconds == 0
+}
+
+// Add adds the given usage to the usage.
+func (h *Usage) Add(u Usage) {
+	h.PromptTokens += u.PromptTokens
+	h.CompletionTokens += u.CompletionTokens
+	h.CachedTokens += u.CachedTokens
+	h.STTSeconds += u.STTSeconds
+	h.TTSSeconds += u.TTSSeconds
+}
+
+// FromPipecatcall returns the usage reported by the pipecat-manager.
+// Returns an empty usage if the given usage is nil.
+func FromPipecatcall(u *pmpipecatcall.Usage) Usage {
+	if u == nil {
+		return Usage{}
+	}
+
+	return Usage{
+		PromptTokens:     u.PromptTokens,
+		CompletionTokens: u.CompletionTokens,
+		CachedTokens:     u.CachedTokens,
+		STTSeconds:       u.STTSeconds,
+		TTSSeconds:       u.TTSSeconds,
+	}
+}
@@ -0,0 +1,124 @@
+package usage
+
+import (
+	"reflect"
+	"testing"
+
+	pmpipecatcall "monorepo/bin-pipecat-manager/models/pipecatcall"
+)
+
+func Test_IsEmpty(t *testing.T) {
+	tests := []struct {
+		name string
+
+		usage Usage
+
+		expectRes bool
+	}{
+		{
+			name: "empty",
+
+			usage: Usage{},
+
+			expectRes: true,
+		},
+		{
+			name: "tokens only",
+
+			usage: Usage{
+				PromptTokens: 10,
+			},
+
+			expectRes: false,
+		},
+		{
+			name: "tts seconds only",
+
+			usage: Usage{
+				TTSSeconds: 0.5,
+			},
+
+			expectRes: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if res := tt.usage.IsEmpty(); res != tt.expectRes {
+				t.Errorf("Wrong match. expect: %v, got: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_Add(t *testing.T) {
+	u := Usage{
+		PromptTokens:     100,
+		CompletionTokens: 20,
+		CachedTokens:     50,
+		STTSeconds:       1.5,
+	}
+
+	u.Add(Usage{
+		PromptTokens:     10,
+		CompletionTokens: 2,
+		TTSSeconds:       3.25,
+	})
+
+	expectRes := Usage{
+		PromptTokens:     110,
+		CompletionTokens: 22,
+		CachedTokens:     50,
+		STTSeconds:       1.5,
+		TTSSeconds:       3.25,
+	}
+	if !reflect.DeepEqual(u, expectRes) {
+		t.Errorf("Wrong match.\nexpect: %v\ngot: %v", expectRes, u)
+	}
+}
+
+func Test_FromPipecatcall(t *testing.T) {
+	tests := []struct {
+		name string
+
+		usage *pmpipecatcall.Usage
+
+		expectRes Usage
+	}{
+		{
+			name: "nil",
+
+			usage: nil,
+
+			expectRes: Usage{},
+		},
+		{
+			name: "normal",
+
+			usage: &pmpipecatcall.Usage{
+				PromptTokens:     1500,
+				CompletionTokens: 50,
+				CachedTokens:     1024,
+				STTSeconds:       12.5,
+				TTSSeconds:       8.25,
+			},
+
+			expectRes: Usage{
+				PromptTokens:     1500,
+				CompletionTokens: 50,
+				CachedTokens:     1024,
+				STTSeconds:       12.5,
+				TTSSeconds:       8.25,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := FromPipecatcall(tt.usage)
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
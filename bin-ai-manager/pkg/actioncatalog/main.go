@@ -62,6 +62,14 @@ var actionCatalog = []actionCatalogEntry{
 		{Name: "async", Type: "bool", Required: false, Description: "If false, the flow waits until AMD finishes before continuing."},
 	}},
 	{Type: fmaction.TypeAnswer, Summary: "Answer the incoming call.", Options: nil},
+	{Type: fmaction.TypeAIExtract, Summary: "Extract structured data matching a JSON schema from a reference (e.g. a call or aicall).", Options: []actionOptionField{
+		{Name: "on_end_flow_id", Type: "uuid", Required: false, Description: "Flow id to run when the extraction finishes."},
+		{Name: "reference_type", Type: "string (call|conference|transcribe|recording|aicall)", Required: true, Description: "Type of the resource to extract from."},
+		{Name: "reference_id", Type: "uuid", Required: true, Description: "Id of the resource to extract from."},
+		{Name: "language", Type: "string", Required: false, Description: "Language of the extracted values (IETF locale, e.g. en-US)."},
+		{Name: "schema", Type: "object (JSON schema)", Required: true, Description: "JSON schema of the data to extract. The root must be an object with properties."},
+		{Name: "set_variables", Type: "bool", Required: false, Description: "If true, each extracted field is set as a flow variable voipbin.ai_extract.result.<field>."},
+	}},
 	{Type: fmaction.TypeAISummary, Summary: "Generate an AI summary of a reference (e.g. a recording or transcribe).", Options: []actionOptionField{
 		{Name: "on_end_flow_id", Type: "uuid", Required: false, Description: "Flow id to run when the summary finishes."},
 		{Name: "reference_type", Type: "string (call|conference|transcribe|recording)", Required: true, Description: "Type of the resource to summarize."},
@@ -156,6 +164,9 @@ var actionCatalog = []actionCatalogEntry{
 		{Name: "duration", Type: "int (ms)", Required: false, Description: "DTMF tone duration per key (100-1000)."},
 		{Name: "interval", Type: "int (ms)", Required: false, Description: "Interval between keys (0-5000)."},
 	}},
+	{Type: fmaction.TypeDispositionSet, Summary: "Set the business outcome (disposition) of the campaign call running this flow. No-op if the flow was not started by a campaign.", Options: []actionOptionField{
+		{Name: "disposition", Type: "string", Required: true, Description: "The customer's disposition code. e.g. sale, callback_requested, wrong_number. Empty clears the disposition."},
+	}},
 	{Type: fmaction.TypeEcho, Summary: "Echo the caller's audio back to them.", Options: []actionOptionField{
 		{Name: "duration", Type: "int", Required: false, Description: "Echo duration."},
 	}},
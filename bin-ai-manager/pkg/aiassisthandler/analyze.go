@@ -0,0 +1,279 @@
+package aiassisthandler
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+	"time"
+
+	"monorepo/bin-ai-manager/models/ai"
+	"monorepo/bin-ai-manager/models/aiassist"
+	"monorepo/bin-ai-manager/models/analysis"
+	"monorepo/bin-ai-manager/models/usage"
+	tmtranscript "monorepo/bin-transcribe-manager/models/transcript"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// requestData is the conversation sent to the LLM.
+type requestData struct {
+	Language    string                   `json:"language,omitempty"`
+	Guidance    string                   `json:"guidance,omitempty"`
+	Checklist   []aiassist.ChecklistItem `json:"checklist,omitempty"`
+	Summary     string                   `json:"summary,omitempty"`
+	Transcripts []requestTranscript      `json:"transcripts"`
+}
+
+// requestTranscript is the transcript sent to the LLM.
+type requestTranscript struct {
+	Direction tmtranscript.Direction `json:"direction"`
+	Message   string                 `json:"message"`
+}
+
+// analysisResult is the LLM output.
+type analysisResult struct {
+	Sentiment      aiassist.Sentiment `json:"sentiment"`
+	NextBestAction string             `json:"next_best_action"`
+	KnowledgeQuery string             `json:"knowledge_query"`
+	ChecklistDone  []int              `json:"checklist_done"`
+	Summary        string             `json:"summary"`
+}
+
+// analysisSchema is the JSON schema of the analysisResult.
+var analysisSchema = json.RawMessage(`{
+	"type": "object",
+	"properties": {
+		"sentiment": {"type": "string", "enum": ["positive", "neutral", "negative"]},
+		"next_best_action": {"type": "string"},
+		"knowledge_query": {"type": "string"},
+		"checklist_done": {"type": "array", "items": {"type": "integer"}},
+		"summary": {"type": "string"}
+	},
+	"required": ["sentiment", "next_best_action", "knowledge_query", "checklist_done", "summary"],
+	"additionalProperties": false
+}`)
+
+// analyze analyzes the aiassist's conversation for a new transcript.
+//
+// Only the holder of the aiassist's analysis lease analyzes, so the analyses of
+// an aiassist run one at a time across the pods. A transcript arriving while
+// the lease is held marks an analysis pending, and the holder runs one more
+// analysis for all the pending transcripts before releasing the lease.
+func (h *aiassistHandler) analyze(ctx context.Context, id uuid.UUID) error {
+	log := logrus.WithFields(logrus.Fields{
+		"func":        "analyze",
+		"aiassist_id": id,
+	})
+
+	claimed, err := h.db.AIAssistAnalyzeClaim(ctx, id, h.analyzeLease())
+	if err != nil {
+		return errors.Wrapf(err, "could not claim the analysis")
+	}
+	if !claimed {
+		log.Debugf("The analysis is pending on the lease holder.")
+		return nil
+	}
+
+	for {
+		if errRun := h.analyzeRun(ctx, id); errRun != nil {
+			// the pending analyses still run. they cover this one's transcripts.
+			log.Errorf("Could not run the analysis. err: %v", errRun)
+		}
+
+		renewed, err := h.db.AIAssistAnalyzeRelease(ctx, id, h.analyzeLease())
+		if err != nil {
+			// the lease expires by itself.
+			return errors.Wrapf(err, "could not release the analysis")
+		}
+		if !renewed {
+			return nil
+		}
+		log.Debugf("Running the pending analysis.")
+	}
+}
+
+// analyzeLease returns the expiry of an analysis lease claimed now.
+func (h *aiassistHandler) analyzeLease() *time.Time {
+	res := h.utilHandler.TimeNow().Add(analyzeTimeout)
+	return &res
+}
+
+// analyzeRun runs an analysis of the aiassist's latest transcripts and the
+// running summary, and delivers the result to the agent.
+func (h *aiassistHandler) analyzeRun(ctx context.Context, id uuid.UUID) error {
+	log := logrus.WithFields(logrus.Fields{
+		"func":        "analyzeRun",
+		"aiassist_id": id,
+	})
+
+	ctx, cancel := context.WithTimeout(ctx, analyzeTimeout)
+	defer cancel()
+
+	// get the aiassist again to have the latest checklist progress.
+	a, err := h.db.AIAssistGet(ctx, id)
+	if err != nil {
+		return errors.Wrapf(err, "could not get the aiassist")
+	}
+	if a.Status != aiassist.StatusProgressing {
+		return nil
+	}
+
+	tmpAI, err := h.aiHandler.Get(ctx, a.AIID)
+	if err != nil {
+		return errors.Wrapf(err, "could not get the ai")
+	}
+
+	transcripts, transcriptID, err := h.getTranscripts(ctx, a.TranscribeID)
+	if err != nil {
+		return errors.Wrapf(err, "could not get the transcripts")
+	}
+	if len(transcripts) == 0 {
+		return nil
+	}
+
+	tmpData, err := json.Marshal(&requestData{
+		Language:    a.Language,
+		Guidance:    tmpAI.InitPrompt,
+		Checklist:   a.Checklist,
+		Summary:     a.Summary,
+		Transcripts: transcripts,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "could not marshal the data")
+	}
+
+	tmp, err := h.analysisHandler.Run(ctx, &analysis.Request{
+		Prompt:     defaultAssistPrompt,
+		Data:       tmpData,
+		Schema:     analysisSchema,
+		SchemaName: schemaName,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "could not run the analysis")
+	}
+
+	if errUsage := h.addUsage(ctx, a, engineModel(tmp.Model), &usage.Usage{
+		PromptTokens:     int64(tmp.PromptTokens),
+		CompletionTokens: int64(tmp.OutputTokens),
+	}); errUsage != nil {
+		// the suggestions are more important than the usage.
+		log.Errorf("Could not add the usage. err: %v", errUsage)
+	}
+
+	if tmp.Truncated {
+		return errors.Errorf("the result has been truncated. finish_reason: %s", tmp.FinishReason)
+	}
+
+	res := &analysisResult{}
+	if errUnmarshal := json.Unmarshal(tmp.Result, res); errUnmarshal != nil {
+		return errors.Wrapf(errUnmarshal, "could not unmarshal the result")
+	}
+	log.WithField("result", res).Debugf("Analyzed the transcripts.")
+
+	// the summary is for the next analysis only. the agent is not notified.
+	if summary := truncateSummary(res.Summary); summary != "" && summary != a.Summary {
+		if errUpdate := h.db.AIAssistUpdate(ctx, a.ID, map[aiassist.Field]any{
+			aiassist.FieldSummary: summary,
+		}); errUpdate != nil {
+			log.Errorf("Could not update the summary. err: %v", errUpdate)
+		}
+	}
+
+	if fields := updateFields(a, res); len(fields) > 0 {
+		if _, errUpdate := h.update(ctx, a.ID, fields); errUpdate != nil {
+			return errors.Wrapf(errUpdate, "could not update the aiassist")
+		}
+	}
+
+	if action := strings.TrimSpace(res.NextBestAction); action != "" {
+		h.publishSuggestion(ctx, a, transcriptID, aiassist.SuggestionTypeNextBestAction, action, nil)
+	}
+
+	if query := strings.TrimSpace(res.KnowledgeQuery); query != "" && tmpAI.RagID != uuid.Nil {
+		h.suggestKnowledge(ctx, a, transcriptID, tmpAI.RagID, query)
+	}
+
+	return nil
+}
+
+// engineModel returns the engine model of the analysis gateway's model.
+// The gateway reports the bare model name, so the engine model target is found
+// by the known engine models. An unknown model is returned as it is.
+func engineModel(model string) ai.EngineModel {
+	for _, target := range ai.EngineModelTargets {
+		res := ai.EngineModel(string(target) + "." + model)
+		if ai.GetEngineModelTarget(res) != ai.EngineModelTargetNone {
+			return res
+		}
+	}
+
+	return ai.EngineModel(model)
+}
+
+// truncateSummary trims the summary to the maxSummaryLength characters.
+func truncateSummary(summary string) string {
+	res := []rune(strings.TrimSpace(summary))
+	if len(res) > maxSummaryLength {
+		res = res[:maxSummaryLength]
+	}
+
+	return string(res)
+}
+
+// updateFields returns the aiassist fields changed by the analysis result.
+// The checklist progress only moves forward, an item once covered stays done.
+func updateFields(a *aiassist.AIAssist, res *analysisResult) map[aiassist.Field]any {
+	res.Sentiment = aiassist.Sentiment(strings.ToLower(string(res.Sentiment)))
+
+	fields := map[aiassist.Field]any{}
+	switch res.Sentiment {
+	case aiassist.SentimentPositive, aiassist.SentimentNeutral, aiassist.SentimentNegative:
+		if res.Sentiment != a.Sentiment {
+			fields[aiassist.FieldSentiment] = res.Sentiment
+		}
+	}
+
+	checklist := slices.Clone(a.Checklist)
+	changed := false
+	for _, idx := range res.ChecklistDone {
+		if idx < 0 || idx >= len(checklist) || checklist[idx].Done {
+			continue
+		}
+		checklist[idx].Done = true
+		changed = true
+	}
+	if changed {
+		fields[aiassist.FieldChecklist] = checklist
+	}
+
+	return fields
+}
+
+// getTranscripts returns the latest transcripts of the transcribe in the chronological order
+// and the id of the latest one.
+func (h *aiassistHandler) getTranscripts(ctx context.Context, transcribeID uuid.UUID) ([]requestTranscript, uuid.UUID, error) {
+	filters := map[tmtranscript.Field]any{
+		tmtranscript.FieldDeleted:      false,
+		tmtranscript.FieldTranscribeID: transcribeID.String(),
+	}
+	transcripts, err := h.reqHandler.TranscribeV1TranscriptList(ctx, "", maxTranscripts, filters)
+	if err != nil {
+		return nil, uuid.Nil, errors.Wrapf(err, "could not get the transcript data")
+	}
+	if len(transcripts) == 0 {
+		return []requestTranscript{}, uuid.Nil, nil
+	}
+
+	res := []requestTranscript{}
+	for _, t := range slices.Backward(transcripts) {
+		res = append(res, requestTranscript{
+			Direction: t.Direction,
+			Message:   t.Message,
+		})
+	}
+
+	return res, transcripts[0].ID, nil
+}
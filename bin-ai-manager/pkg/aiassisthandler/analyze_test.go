@@ -0,0 +1,188 @@
+package aiassisthandler
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"monorepo/bin-ai-manager/models/ai"
+	"monorepo/bin-ai-manager/models/aiassist"
+	"monorepo/bin-ai-manager/pkg/dbhandler"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_analyze(t *testing.T) {
+
+	curTime := func() *time.Time { t := time.Date(2023, 1, 3, 21, 35, 2, 809000000, time.UTC); return &t }()
+	lease := func() *time.Time { t := time.Date(2023, 1, 3, 21, 35, 32, 809000000, time.UTC); return &t }()
+
+	tests := []struct {
+		name string
+
+		id uuid.UUID
+
+		responseClaimed bool
+		responseRenewed []bool
+	}{
+		{
+			name: "lease held by another analysis",
+
+			id: uuid.FromStringOrNil("2c4f6a8e-b4a1-11f0-9d2e-3b7c1f5a8e01"),
+
+			responseClaimed: false,
+		},
+		{
+			name: "pending analysis runs once more",
+
+			id: uuid.FromStringOrNil("2c7a1b3c-b4a1-11f0-a4f6-1e8d2c6b9f02"),
+
+			responseClaimed: true,
+			responseRenewed: []bool{true, false},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+
+			h := &aiassistHandler{
+				utilHandler: mockUtil,
+				db:          mockDB,
+			}
+			ctx := context.Background()
+
+			mockUtil.EXPECT().TimeNow().Return(curTime)
+			mockDB.EXPECT().AIAssistAnalyzeClaim(ctx, tt.id, lease).Return(tt.responseClaimed, nil)
+
+			for _, renewed := range tt.responseRenewed {
+				// the aiassist has been stopped. the analysis does nothing.
+				mockDB.EXPECT().AIAssistGet(gomock.Any(), tt.id).Return(&aiassist.AIAssist{Status: aiassist.StatusTerminated}, nil)
+				mockUtil.EXPECT().TimeNow().Return(curTime)
+				mockDB.EXPECT().AIAssistAnalyzeRelease(ctx, tt.id, lease).Return(renewed, nil)
+			}
+
+			if err := h.analyze(ctx, tt.id); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+		})
+	}
+}
+
+func Test_engineModel(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		model string
+
+		expectRes ai.EngineModel
+	}{
+		{
+			name: "known model",
+
+			model: "gemini-2.5-flash",
+
+			expectRes: ai.EngineModelGeminiGemini2Dot5Flash,
+		},
+		{
+			name: "unknown model",
+
+			model: "some-model",
+
+			expectRes: ai.EngineModel("some-model"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := engineModel(tt.model)
+			if res != tt.expectRes {
+				t.Errorf("Wrong match. expect: %s, got: %s", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_updateFields(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		aiassist *aiassist.AIAssist
+		result   *analysisResult
+
+		expectRes map[aiassist.Field]any
+	}{
+		{
+			name: "checklist progress and sentiment",
+
+			aiassist: &aiassist.AIAssist{
+				Checklist: []aiassist.ChecklistItem{
+					{Name: "verify identity", Done: true},
+					{Name: "read the disclosure"},
+				},
+				Sentiment: aiassist.SentimentNeutral,
+			},
+			result: &analysisResult{
+				Sentiment:     "Positive",
+				ChecklistDone: []int{1},
+			},
+
+			expectRes: map[aiassist.Field]any{
+				aiassist.FieldSentiment: aiassist.SentimentPositive,
+				aiassist.FieldChecklist: []aiassist.ChecklistItem{
+					{Name: "verify identity", Done: true},
+					{Name: "read the disclosure", Done: true},
+				},
+			},
+		},
+		{
+			name: "done item stays done",
+
+			aiassist: &aiassist.AIAssist{
+				Checklist: []aiassist.ChecklistItem{
+					{Name: "verify identity", Done: true},
+				},
+				Sentiment: aiassist.SentimentNeutral,
+			},
+			result: &analysisResult{
+				Sentiment:     aiassist.SentimentNeutral,
+				ChecklistDone: []int{},
+			},
+
+			expectRes: map[aiassist.Field]any{},
+		},
+		{
+			name: "invalid values are ignored",
+
+			aiassist: &aiassist.AIAssist{
+				Checklist: []aiassist.ChecklistItem{
+					{Name: "verify identity"},
+				},
+			},
+			result: &analysisResult{
+				Sentiment:     "angry",
+				ChecklistDone: []int{-1, 3},
+			},
+
+			expectRes: map[aiassist.Field]any{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := updateFields(tt.aiassist, tt.result)
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package aiassisthandler
+
+import (
+	"context"
+	stderrors "errors"
+
+	"monorepo/bin-ai-manager/models/ai"
+	"monorepo/bin-ai-manager/models/aiassist"
+	"monorepo/bin-ai-manager/models/usage"
+	"monorepo/bin-ai-manager/pkg/dbhandler"
+	cerrors "monorepo/bin-common-handler/models/errors"
+	commonoutline "monorepo/bin-common-handler/models/outline"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+)
+
+// create creates a new aiassist and publishes the created event.
+func (h *aiassistHandler) create(ctx context.Context, a *aiassist.AIAssist) (*aiassist.AIAssist, error) {
+	a.ID = h.utilHandler.UUIDCreate()
+	a.Status = aiassist.StatusProgressing
+
+	if errCreate := h.db.AIAssistCreate(ctx, a); errCreate != nil {
+		return nil, errors.Wrapf(errCreate, "could not create aiassist")
+	}
+
+	res, err := h.db.AIAssistGet(ctx, a.ID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get created data")
+	}
+	h.notifyHandler.PublishWebhookEvent(ctx, res.CustomerID, aiassist.EventTypeCreated, res)
+
+	return res, nil
+}
+
+// Get returns the aiassist.
+func (h *aiassistHandler) Get(ctx context.Context, id uuid.UUID) (*aiassist.AIAssist, error) {
+	res, err := h.db.AIAssistGet(ctx, id)
+	if err != nil {
+		if stderrors.Is(err, dbhandler.ErrNotFound) {
+			return nil, cerrors.NotFound(
+				commonoutline.ServiceNameAIManager,
+				"AIASSIST_NOT_FOUND",
+				"The aiassist was not found.",
+			).Wrap(err)
+		}
+		return nil, errors.Wrapf(err, "could not get data")
+	}
+
+	return res, nil
+}
+
+// List returns the list of aiassists.
+func (h *aiassistHandler) List(ctx context.Context, size uint64, token string, filters map[aiassist.Field]any) ([]*aiassist.AIAssist, error) {
+	res, err := h.db.AIAssistList(ctx, size, token, filters)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get data")
+	}
+
+	return res, nil
+}
+
+// Delete stops and deletes the aiassist.
+func (h *aiassistHandler) Delete(ctx context.Context, id uuid.UUID) (*aiassist.AIAssist, error) {
+	if _, err := h.Stop(ctx, id); err != nil {
+		return nil, errors.Wrapf(err, "could not stop the aiassist")
+	}
+
+	if err := h.db.AIAssistDelete(ctx, id); err != nil {
+		return nil, errors.Wrapf(err, "could not delete the aiassist")
+	}
+
+	res, err := h.db.AIAssistGet(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get deleted aiassist")
+	}
+	h.notifyHandler.PublishWebhookEvent(ctx, res.CustomerID, aiassist.EventTypeDeleted, res)
+
+	return res, nil
+}
+
+// update updates the aiassist fields and publishes the updated event.
+func (h *aiassistHandler) update(ctx context.Context, id uuid.UUID, fields map[aiassist.Field]any) (*aiassist.AIAssist, error) {
+	if err := h.db.AIAssistUpdate(ctx, id, fields); err != nil {
+		return nil, errors.Wrapf(err, "could not update the aiassist")
+	}
+
+	res, err := h.db.AIAssistGet(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get updated aiassist")
+	}
+	h.notifyHandler.PublishWebhookEvent(ctx, res.CustomerID, aiassist.EventTypeUpdated, res)
+
+	return res, nil
+}
+
+// addUsage adds the LLM usage of an analysis to the aiassist, and keeps the
+// engine model the usage is billed by.
+func (h *aiassistHandler) addUsage(ctx context.Context, a *aiassist.AIAssist, engineModel ai.EngineModel, u *usage.Usage) error {
+	if err := h.db.AIAssistAddUsage(ctx, a.ID, u); err != nil {
+		return errors.Wrapf(err, "could not add the usage")
+	}
+
+	if engineModel != a.EngineModel {
+		if err := h.db.AIAssistUpdate(ctx, a.ID, map[aiassist.Field]any{
+			aiassist.FieldEngineModel: engineModel,
+		}); err != nil {
+			return errors.Wrapf(err, "could not update the engine model")
+		}
+	}
+
+	return nil
+}
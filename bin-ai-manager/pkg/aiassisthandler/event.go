@@ -0,0 +1,73 @@
+package aiassisthandler
+
+import (
+	"context"
+
+	"monorepo/bin-ai-manager/models/aiassist"
+	cmcall "monorepo/bin-call-manager/models/call"
+	cmcustomer "monorepo/bin-customer-manager/models/customer"
+	tmtranscript "monorepo/bin-transcribe-manager/models/transcript"
+
+	"github.com/sirupsen/logrus"
+)
+
+// EventTMTranscriptCreated handles the transcribe-manager's transcript_created event
+func (h *aiassistHandler) EventTMTranscriptCreated(ctx context.Context, t *tmtranscript.Transcript) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":          "EventTMTranscriptCreated",
+		"transcribe_id": t.TranscribeID,
+	})
+
+	if t.CustomerID != cmcustomer.IDAIManager {
+		// not the ai manager's transcribe. nothing to do
+		return
+	}
+
+	filters := map[aiassist.Field]any{
+		aiassist.FieldDeleted:      false,
+		aiassist.FieldTranscribeID: t.TranscribeID,
+		aiassist.FieldStatus:       aiassist.StatusProgressing,
+	}
+	as, err := h.db.AIAssistList(ctx, 1, "", filters)
+	if err != nil {
+		log.Errorf("Could not get the aiassists. err: %v", err)
+		return
+	}
+	if len(as) == 0 {
+		// the transcribe is not for an aiassist. nothing to do
+		return
+	}
+
+	if errAnalyze := h.analyze(ctx, as[0].ID); errAnalyze != nil {
+		log.Errorf("Could not analyze the transcript. aiassist_id: %s, err: %v", as[0].ID, errAnalyze)
+	}
+}
+
+// EventCMCallHangup handles the call-manager's call_hangup event
+func (h *aiassistHandler) EventCMCallHangup(ctx context.Context, c *cmcall.Call) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":    "EventCMCallHangup",
+		"call_id": c.ID,
+	})
+
+	filters := map[aiassist.Field]any{
+		aiassist.FieldDeleted:       false,
+		aiassist.FieldReferenceType: aiassist.ReferenceTypeCall,
+		aiassist.FieldReferenceID:   c.ID,
+		aiassist.FieldStatus:        aiassist.StatusProgressing,
+	}
+	as, err := h.db.AIAssistList(ctx, 100, "", filters)
+	if err != nil {
+		log.Errorf("Could not get the aiassists. err: %v", err)
+		return
+	}
+
+	for _, a := range as {
+		tmp, err := h.Stop(ctx, a.ID)
+		if err != nil {
+			log.Errorf("Could not stop the aiassist. aiassist_id: %s, err: %v", a.ID, err)
+			continue
+		}
+		log.WithField("aiassist", tmp).Debugf("Stopped the aiassist. aiassist_id: %s", tmp.ID)
+	}
+}
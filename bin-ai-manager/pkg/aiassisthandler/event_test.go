@@ -0,0 +1,329 @@
+package aiassisthandler
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"monorepo/bin-ai-manager/models/ai"
+	"monorepo/bin-ai-manager/models/aiassist"
+	"monorepo/bin-ai-manager/models/analysis"
+	"monorepo/bin-ai-manager/models/usage"
+	"monorepo/bin-ai-manager/pkg/aihandler"
+	"monorepo/bin-ai-manager/pkg/analysishandler"
+	"monorepo/bin-ai-manager/pkg/dbhandler"
+	cmcall "monorepo/bin-call-manager/models/call"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/notifyhandler"
+	"monorepo/bin-common-handler/pkg/requesthandler"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+	cmcustomer "monorepo/bin-customer-manager/models/customer"
+	rmquery "monorepo/bin-rag-manager/models/query"
+	tmtranscript "monorepo/bin-transcribe-manager/models/transcript"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_EventTMTranscriptCreated(t *testing.T) {
+
+	curTime := func() *time.Time { t := time.Date(2023, 1, 3, 21, 35, 2, 809000000, time.UTC); return &t }()
+
+	tests := []struct {
+		name string
+
+		transcript *tmtranscript.Transcript
+
+		responseAIAssist    *aiassist.AIAssist
+		responseAI          *ai.AI
+		responseTranscripts []tmtranscript.Transcript
+		responseAnalysis    *analysis.Response
+		responseRAG         *rmquery.Response
+		responseUUIDs       []uuid.UUID
+
+		expectData        string
+		expectLease       *time.Time
+		expectFields      map[aiassist.Field]any
+		expectSuggestions []*aiassist.Suggestion
+	}{
+		{
+			name: "normal",
+
+			transcript: &tmtranscript.Transcript{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("6a1f0c2e-b1f9-11f0-8c61-2e5a7d1f3b01"),
+					CustomerID: cmcustomer.IDAIManager,
+				},
+				TranscribeID: uuid.FromStringOrNil("6a4a8d3c-b1f9-11f0-a7b2-5f1c3e9d2a02"),
+				Direction:    tmtranscript.DirectionIn,
+				Message:      "How long does the refund take? I am really upset.",
+			},
+
+			responseAIAssist: &aiassist.AIAssist{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("6a75b1e6-b1f9-11f0-9e14-3c8b6f2a1d03"),
+					CustomerID: uuid.FromStringOrNil("6aa0c4f0-b1f9-11f0-b3d5-1a4e7c9f2b04"),
+				},
+				Owner: commonidentity.Owner{
+					OwnerType: commonidentity.OwnerTypeAgent,
+					OwnerID:   uuid.FromStringOrNil("6acb2e8a-b1f9-11f0-8d46-6e2f1b3c5a05"),
+				},
+				AIID:         uuid.FromStringOrNil("6af6a3b4-b1f9-11f0-a9c7-2b5d8e1f4c06"),
+				TranscribeID: uuid.FromStringOrNil("6a4a8d3c-b1f9-11f0-a7b2-5f1c3e9d2a02"),
+				Status:       aiassist.StatusProgressing,
+				Language:     "en-US",
+				Checklist: []aiassist.ChecklistItem{
+					{Name: "verify identity", Done: true},
+					{Name: "offer the survey"},
+				},
+				Sentiment: aiassist.SentimentNeutral,
+			},
+			responseAI: &ai.AI{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("6af6a3b4-b1f9-11f0-a9c7-2b5d8e1f4c06"),
+				},
+				RagID:      uuid.FromStringOrNil("6b21c5de-b1f9-11f0-8b38-4f6a1c2e7d07"),
+				InitPrompt: "You are a support agent of an online shop.",
+			},
+			responseTranscripts: []tmtranscript.Transcript{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("6a1f0c2e-b1f9-11f0-8c61-2e5a7d1f3b01"),
+					},
+					Direction: tmtranscript.DirectionIn,
+					Message:   "How long does the refund take? I am really upset.",
+				},
+				{Direction: tmtranscript.DirectionOut, Message: "Thank you for calling. How can I help you?"},
+			},
+			responseAnalysis: &analysis.Response{
+				Result:       json.RawMessage(`{"sentiment":"negative","next_best_action":"Apologize and explain the refund timeline.","knowledge_query":"refund timeline","checklist_done":[0,5],"summary":"The customer is upset and asks about the refund timeline."}`),
+				Model:        "gemini-2.5-flash",
+				FinishReason: "stop",
+				PromptTokens: 410,
+				OutputTokens: 35,
+			},
+			responseRAG: &rmquery.Response{
+				Sources: []rmquery.Source{
+					{DocumentName: "refund-policy.pdf", SectionTitle: "Timeline", RelevanceScore: 0.91, Text: "Refunds are processed within 5 business days."},
+				},
+			},
+			responseUUIDs: []uuid.UUID{
+				uuid.FromStringOrNil("6b4cd1f8-b1f9-11f0-9f29-7d3e5a1b6c08"),
+				uuid.FromStringOrNil("6b77e212-b1f9-11f0-a61a-1e4c7f2d8b09"),
+			},
+
+			expectData:  `{"language":"en-US","guidance":"You are a support agent of an online shop.","checklist":[{"name":"verify identity","done":true},{"name":"offer the survey","done":false}],"transcripts":[{"direction":"out","message":"Thank you for calling. How can I help you?"},{"direction":"in","message":"How long does the refund take? I am really upset."}]}`,
+			expectLease: func() *time.Time { t := time.Date(2023, 1, 3, 21, 35, 32, 809000000, time.UTC); return &t }(),
+			expectFields: map[aiassist.Field]any{
+				aiassist.FieldSentiment: aiassist.SentimentNegative,
+			},
+			expectSuggestions: []*aiassist.Suggestion{
+				{
+					Identity: commonidentity.Identity{
+						ID:         uuid.FromStringOrNil("6b4cd1f8-b1f9-11f0-9f29-7d3e5a1b6c08"),
+						CustomerID: uuid.FromStringOrNil("6aa0c4f0-b1f9-11f0-b3d5-1a4e7c9f2b04"),
+					},
+					Owner: commonidentity.Owner{
+						OwnerType: commonidentity.OwnerTypeAgent,
+						OwnerID:   uuid.FromStringOrNil("6acb2e8a-b1f9-11f0-8d46-6e2f1b3c5a05"),
+					},
+					AIAssistID:   uuid.FromStringOrNil("6a75b1e6-b1f9-11f0-9e14-3c8b6f2a1d03"),
+					TranscriptID: uuid.FromStringOrNil("6a1f0c2e-b1f9-11f0-8c61-2e5a7d1f3b01"),
+					Type:         aiassist.SuggestionTypeNextBestAction,
+					Content:      "Apologize and explain the refund timeline.",
+					TMCreate:     curTime,
+				},
+				{
+					Identity: commonidentity.Identity{
+						ID:         uuid.FromStringOrNil("6b77e212-b1f9-11f0-a61a-1e4c7f2d8b09"),
+						CustomerID: uuid.FromStringOrNil("6aa0c4f0-b1f9-11f0-b3d5-1a4e7c9f2b04"),
+					},
+					Owner: commonidentity.Owner{
+						OwnerType: commonidentity.OwnerTypeAgent,
+						OwnerID:   uuid.FromStringOrNil("6acb2e8a-b1f9-11f0-8d46-6e2f1b3c5a05"),
+					},
+					AIAssistID:   uuid.FromStringOrNil("6a75b1e6-b1f9-11f0-9e14-3c8b6f2a1d03"),
+					TranscriptID: uuid.FromStringOrNil("6a1f0c2e-b1f9-11f0-8c61-2e5a7d1f3b01"),
+					Type:         aiassist.SuggestionTypeKnowledge,
+					Content:      "Refunds are processed within 5 business days.",
+					Sources: []aiassist.SuggestionSource{
+						{DocumentName: "refund-policy.pdf", SectionTitle: "Timeline", RelevanceScore: 0.91},
+					},
+					TMCreate: curTime,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+			mockAI := aihandler.NewMockAIHandler(mc)
+			mockAnalysis := analysishandler.NewMockAnalysisHandler(mc)
+
+			h := &aiassistHandler{
+				utilHandler:     mockUtil,
+				db:              mockDB,
+				notifyHandler:   mockNotify,
+				reqHandler:      mockReq,
+				aiHandler:       mockAI,
+				analysisHandler: mockAnalysis,
+			}
+			ctx := context.Background()
+
+			mockDB.EXPECT().AIAssistList(ctx, uint64(1), "", map[aiassist.Field]any{
+				aiassist.FieldDeleted:      false,
+				aiassist.FieldTranscribeID: tt.transcript.TranscribeID,
+				aiassist.FieldStatus:       aiassist.StatusProgressing,
+			}).Return([]*aiassist.AIAssist{tt.responseAIAssist}, nil)
+			mockUtil.EXPECT().TimeNow().Return(curTime)
+			mockDB.EXPECT().AIAssistAnalyzeClaim(ctx, tt.responseAIAssist.ID, tt.expectLease).Return(true, nil)
+
+			// the analysis runs with its own timeout.
+			mockDB.EXPECT().AIAssistGet(gomock.Any(), tt.responseAIAssist.ID).Return(tt.responseAIAssist, nil)
+			mockAI.EXPECT().Get(gomock.Any(), tt.responseAIAssist.AIID).Return(tt.responseAI, nil)
+			mockReq.EXPECT().TranscribeV1TranscriptList(gomock.Any(), "", uint64(maxTranscripts), gomock.Any()).Return(tt.responseTranscripts, nil)
+			mockAnalysis.EXPECT().Run(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, req *analysis.Request) (*analysis.Response, error) {
+				if string(req.Data) != tt.expectData {
+					t.Errorf("Wrong match.\nexpect: %s\ngot: %s", tt.expectData, req.Data)
+				}
+				if req.SchemaName != schemaName {
+					t.Errorf("Wrong match. expect: %s, got: %s", schemaName, req.SchemaName)
+				}
+				return tt.responseAnalysis, nil
+			})
+			mockDB.EXPECT().AIAssistAddUsage(gomock.Any(), tt.responseAIAssist.ID, &usage.Usage{
+				PromptTokens:     int64(tt.responseAnalysis.PromptTokens),
+				CompletionTokens: int64(tt.responseAnalysis.OutputTokens),
+			}).Return(nil)
+			mockDB.EXPECT().AIAssistUpdate(gomock.Any(), tt.responseAIAssist.ID, map[aiassist.Field]any{
+				aiassist.FieldEngineModel: ai.EngineModelGeminiGemini2Dot5Flash,
+			}).Return(nil)
+			mockDB.EXPECT().AIAssistUpdate(gomock.Any(), tt.responseAIAssist.ID, map[aiassist.Field]any{
+				aiassist.FieldSummary: "The customer is upset and asks about the refund timeline.",
+			}).Return(nil)
+
+			// the covered item 0 was done already and the item 5 does not exist.
+			mockDB.EXPECT().AIAssistUpdate(gomock.Any(), tt.responseAIAssist.ID, tt.expectFields).Return(nil)
+			mockDB.EXPECT().AIAssistGet(gomock.Any(), tt.responseAIAssist.ID).Return(tt.responseAIAssist, nil)
+			mockNotify.EXPECT().PublishWebhookEvent(gomock.Any(), tt.responseAIAssist.CustomerID, aiassist.EventTypeUpdated, tt.responseAIAssist)
+
+			mockReq.EXPECT().RagV1RagQuery(gomock.Any(), tt.responseAI.RagID, "refund timeline", ragTopK).Return(tt.responseRAG, nil)
+			for i, s := range tt.expectSuggestions {
+				mockUtil.EXPECT().UUIDCreate().Return(tt.responseUUIDs[i])
+				mockUtil.EXPECT().TimeNow().Return(curTime)
+				mockNotify.EXPECT().PublishWebhookEvent(gomock.Any(), tt.responseAIAssist.CustomerID, aiassist.EventTypeSuggestionCreated, s)
+			}
+
+			mockUtil.EXPECT().TimeNow().Return(curTime)
+			mockDB.EXPECT().AIAssistAnalyzeRelease(ctx, tt.responseAIAssist.ID, tt.expectLease).Return(false, nil)
+
+			h.EventTMTranscriptCreated(ctx, tt.transcript)
+		})
+	}
+}
+
+func Test_EventTMTranscriptCreated_notAIManager(t *testing.T) {
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockDB := dbhandler.NewMockDBHandler(mc)
+
+	h := &aiassistHandler{
+		db: mockDB,
+	}
+
+	// the customer's own transcribe is never looked up.
+	h.EventTMTranscriptCreated(context.Background(), &tmtranscript.Transcript{
+		Identity: commonidentity.Identity{
+			CustomerID: uuid.FromStringOrNil("6ba2f42c-b1f9-11f0-8e5b-5c2a7e3d1f0a"),
+		},
+		TranscribeID: uuid.FromStringOrNil("6bcd0346-b1f9-11f0-b47c-3f6d1a8e2c0b"),
+	})
+}
+
+func Test_EventCMCallHangup(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		call *cmcall.Call
+
+		responseAIAssists  []*aiassist.AIAssist
+		responseTerminated *aiassist.AIAssist
+	}{
+		{
+			name: "normal",
+
+			call: &cmcall.Call{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("6bf81460-b1f9-11f0-9c8d-1b4e6f2a3d0c"),
+				},
+			},
+
+			responseAIAssists: []*aiassist.AIAssist{
+				{
+					Identity: commonidentity.Identity{
+						ID:         uuid.FromStringOrNil("6c23257a-b1f9-11f0-a09e-2d5f7b1c4e0d"),
+						CustomerID: uuid.FromStringOrNil("6c4e3694-b1f9-11f0-8caf-4e6a2c8d1f0e"),
+					},
+					TranscribeID: uuid.FromStringOrNil("6c7947ae-b1f9-11f0-b1c0-5f7b3d9e2a0f"),
+					Status:       aiassist.StatusProgressing,
+				},
+			},
+			responseTerminated: &aiassist.AIAssist{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("6c23257a-b1f9-11f0-a09e-2d5f7b1c4e0d"),
+					CustomerID: uuid.FromStringOrNil("6c4e3694-b1f9-11f0-8caf-4e6a2c8d1f0e"),
+				},
+				TranscribeID: uuid.FromStringOrNil("6c7947ae-b1f9-11f0-b1c0-5f7b3d9e2a0f"),
+				Status:       aiassist.StatusTerminated,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+
+			h := &aiassistHandler{
+				db:            mockDB,
+				notifyHandler: mockNotify,
+				reqHandler:    mockReq,
+			}
+			ctx := context.Background()
+
+			mockDB.EXPECT().AIAssistList(ctx, uint64(100), "", map[aiassist.Field]any{
+				aiassist.FieldDeleted:       false,
+				aiassist.FieldReferenceType: aiassist.ReferenceTypeCall,
+				aiassist.FieldReferenceID:   tt.call.ID,
+				aiassist.FieldStatus:        aiassist.StatusProgressing,
+			}).Return(tt.responseAIAssists, nil)
+
+			for _, a := range tt.responseAIAssists {
+				mockDB.EXPECT().AIAssistGet(ctx, a.ID).Return(a, nil)
+				mockReq.EXPECT().TranscribeV1TranscribeStop(ctx, a.TranscribeID).Return(nil, nil)
+				mockDB.EXPECT().AIAssistUpdate(ctx, a.ID, map[aiassist.Field]any{
+					aiassist.FieldStatus: aiassist.StatusTerminated,
+				}).Return(nil)
+				mockDB.EXPECT().AIAssistGet(ctx, a.ID).Return(tt.responseTerminated, nil)
+				mockNotify.EXPECT().PublishWebhookEvent(ctx, a.CustomerID, aiassist.EventTypeUpdated, tt.responseTerminated)
+				mockNotify.EXPECT().PublishWebhookEvent(ctx, a.CustomerID, aiassist.EventTypeStatusTerminated, tt.responseTerminated)
+			}
+
+			h.EventCMCallHangup(ctx, tt.call)
+		})
+	}
+}
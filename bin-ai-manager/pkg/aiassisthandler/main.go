@@ -0,0 +1,143 @@
+package aiassisthandler
+
+//go:generate mockgen -package aiassisthandler -destination ./mock_main.go -source main.go -build_flags=-mod=mod
+
+import (
+	"context"
+	"time"
+
+	"monorepo/bin-ai-manager/models/aiassist"
+	"monorepo/bin-ai-manager/pkg/aihandler"
+	"monorepo/bin-ai-manager/pkg/analysishandler"
+	"monorepo/bin-ai-manager/pkg/dbhandler"
+	cmcall "monorepo/bin-call-manager/models/call"
+	"monorepo/bin-common-handler/pkg/notifyhandler"
+	"monorepo/bin-common-handler/pkg/requesthandler"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+	tmtranscript "monorepo/bin-transcribe-manager/models/transcript"
+
+	"github.com/gofrs/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// AIAssistHandler assists a human agent on a call in real time.
+//
+// The assist follows the call's live transcripts. The transcripts run the
+// analyses through the analysis gateway one at a time, the transcripts arriving
+// during an analysis are batched into the next one. The result is delivered to
+// the agent as the aiassist's updated event (checklist and sentiment) and the
+// suggestion events (knowledge base answers and next best actions).
+// The assist never speaks into the call.
+type AIAssistHandler interface {
+	Start(
+		ctx context.Context,
+		customerID uuid.UUID,
+		agentID uuid.UUID,
+		aiID uuid.UUID,
+		referenceType aiassist.ReferenceType,
+		referenceID uuid.UUID,
+		language string,
+		checklist []aiassist.ChecklistItem,
+	) (*aiassist.AIAssist, error)
+	Get(ctx context.Context, id uuid.UUID) (*aiassist.AIAssist, error)
+	List(ctx context.Context, size uint64, token string, filters map[aiassist.Field]any) ([]*aiassist.AIAssist, error)
+	Delete(ctx context.Context, id uuid.UUID) (*aiassist.AIAssist, error)
+	Stop(ctx context.Context, id uuid.UUID) (*aiassist.AIAssist, error)
+
+	EventTMTranscriptCreated(ctx context.Context, t *tmtranscript.Transcript)
+	EventCMCallHangup(ctx context.Context, c *cmcall.Call)
+}
+
+type aiassistHandler struct {
+	utilHandler   utilhandler.UtilHandler
+	notifyHandler notifyhandler.NotifyHandler
+	reqHandler    requesthandler.RequestHandler
+	db            dbhandler.DBHandler
+
+	aiHandler       aihandler.AIHandler
+	analysisHandler analysishandler.AnalysisHandler
+}
+
+var (
+	metricsNamespace = "ai_manager"
+
+	// aiassist_start_total counts aiassist starts by reference type.
+	promAIAssistStartTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "aiassist_start_total",
+			Help:      "Total number of aiassist starts by reference type.",
+		},
+		[]string{"reference_type"},
+	)
+
+	// aiassist_suggestion_total counts delivered suggestions by type.
+	promAIAssistSuggestionTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "aiassist_suggestion_total",
+			Help:      "Total number of aiassist suggestions by type.",
+		},
+		[]string{"type"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(
+		promAIAssistStartTotal,
+		promAIAssistSuggestionTotal,
+	)
+}
+
+// NewAIAssistHandler creates a new AIAssistHandler
+func NewAIAssistHandler(
+	requestHandler requesthandler.RequestHandler,
+	notifyHandler notifyhandler.NotifyHandler,
+	db dbhandler.DBHandler,
+
+	aiHandler aihandler.AIHandler,
+	analysisHandler analysishandler.AnalysisHandler,
+) AIAssistHandler {
+	return &aiassistHandler{
+		utilHandler:   utilhandler.NewUtilHandler(),
+		reqHandler:    requestHandler,
+		notifyHandler: notifyHandler,
+		db:            db,
+
+		aiHandler:       aiHandler,
+		analysisHandler: analysisHandler,
+	}
+}
+
+const (
+	schemaName = "aiassist"
+
+	// maxTranscripts caps the number of the latest transcripts sent to the LLM.
+	// The conversation before them is sent as the running summary.
+	maxTranscripts = 10
+
+	// maxSummaryLength caps the running summary in characters.
+	maxSummaryLength = 2000
+
+	// analyzeTimeout bounds an analysis. The analysis lease expires with it, so
+	// an analysis lost with its pod does not block the aiassist.
+	analyzeTimeout = 30 * time.Second
+
+	// ragTopK is the number of the knowledge base sources of a knowledge suggestion.
+	ragTopK = 3
+
+	defaultAssistPrompt = `
+You are assisting a human agent on a live call. You never talk to the customer. Analyze the latest part of the conversation and help the agent.
+
+The transcripts are the latest part of the conversation in the chronological order. The 'in' direction is the customer and the 'out' direction is the agent.
+The 'summary' is your summary of the conversation so far. It may overlap with the transcripts.
+The 'guidance' is the agent's role and the business rules. Follow it when suggesting the next best action.
+
+**Rules:**
+- sentiment: the customer's current sentiment. One of positive, neutral or negative.
+- next_best_action: a short hint of what the agent should say or do next, in the language specified in 'language'. Empty if the last utterance does not call for a new action.
+- knowledge_query: a search query for the knowledge base when the customer asks something the agent may need to look up. Empty otherwise.
+- checklist_done: the indices of the 'checklist' items the agent has covered in the conversation so far.
+- summary: the 'summary' updated with the transcripts, in a few sentences. Keep the facts the agent may need later, like the customer's name, the issue and what has been agreed.
+`
+)
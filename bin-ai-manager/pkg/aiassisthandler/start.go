@@ -0,0 +1,125 @@
+package aiassisthandler
+
+import (
+	"context"
+
+	"monorepo/bin-ai-manager/models/aiassist"
+	cmcall "monorepo/bin-call-manager/models/call"
+	cerrors "monorepo/bin-common-handler/models/errors"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	commonoutline "monorepo/bin-common-handler/models/outline"
+	cmcustomer "monorepo/bin-customer-manager/models/customer"
+	tmtranscribe "monorepo/bin-transcribe-manager/models/transcribe"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// Start starts assisting the given agent on the referenced call.
+//
+// It starts the transcribe of the call and nothing else, so the assist only
+// listens to the call.
+func (h *aiassistHandler) Start(
+	ctx context.Context,
+	customerID uuid.UUID,
+	agentID uuid.UUID,
+	aiID uuid.UUID,
+	referenceType aiassist.ReferenceType,
+	referenceID uuid.UUID,
+	language string,
+	checklist []aiassist.ChecklistItem,
+) (*aiassist.AIAssist, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":         "Start",
+		"customer_id":  customerID,
+		"agent_id":     agentID,
+		"reference_id": referenceID,
+	})
+
+	if referenceType != aiassist.ReferenceTypeCall {
+		return nil, errors.Errorf("unsupported reference type: %s", referenceType)
+	}
+
+	if errValidate := aiassist.ValidateChecklist(checklist); errValidate != nil {
+		return nil, cerrors.InvalidArgument(
+			commonoutline.ServiceNameAIManager,
+			"INVALID_AIASSIST_CHECKLIST",
+			"The checklist is not valid. "+errValidate.Error(),
+		)
+	}
+
+	a, err := h.aiHandler.Get(ctx, aiID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get the ai")
+	}
+	if a.CustomerID != customerID {
+		return nil, cerrors.NotFound(
+			commonoutline.ServiceNameAIManager,
+			"AI_NOT_FOUND",
+			"The AI was not found.",
+		)
+	}
+
+	c, err := h.reqHandler.CallV1CallGet(ctx, referenceID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get the call data")
+	}
+	if c.CustomerID != customerID {
+		return nil, errors.Errorf("the call does not belong to the customer")
+	}
+	if c.Status == cmcall.StatusHangup {
+		return nil, errors.Errorf("the call has already been hung up")
+	}
+
+	promAIAssistStartTotal.WithLabelValues(string(referenceType)).Inc()
+
+	// note: the transcribe is created with the ai manager's customer id, so it
+	// is not shown in the customer's transcribe list.
+	tr, err := h.reqHandler.TranscribeV1TranscribeStart(
+		ctx,
+		cmcustomer.IDAIManager,
+		c.ActiveflowID,
+		uuid.Nil,
+		tmtranscribe.ReferenceTypeCall,
+		referenceID,
+		language,
+		tmtranscribe.DirectionBoth,
+		tmtranscribe.ProviderEmpty,
+		5000,
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not start the transcribe")
+	}
+	log.WithField("transcribe", tr).Debugf("Started transcribe. transcribe_id: %s", tr.ID)
+
+	// the checklist progress starts from scratch.
+	items := make([]aiassist.ChecklistItem, len(checklist))
+	for i, item := range checklist {
+		items[i] = aiassist.ChecklistItem{Name: item.Name}
+	}
+
+	res, err := h.create(ctx, &aiassist.AIAssist{
+		Identity: commonidentity.Identity{
+			CustomerID: customerID,
+		},
+		Owner: commonidentity.Owner{
+			OwnerType: commonidentity.OwnerTypeAgent,
+			OwnerID:   agentID,
+		},
+		AIID:          aiID,
+		ReferenceType: referenceType,
+		ReferenceID:   referenceID,
+		TranscribeID:  tr.ID,
+		Language:      language,
+		Checklist:     items,
+	})
+	if err != nil {
+		if _, errStop := h.reqHandler.TranscribeV1TranscribeStop(ctx, tr.ID); errStop != nil {
+			log.Errorf("Could not stop the transcribe. err: %v", errStop)
+		}
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,227 @@
+package aiassisthandler
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"monorepo/bin-ai-manager/models/ai"
+	"monorepo/bin-ai-manager/models/aiassist"
+	"monorepo/bin-ai-manager/pkg/aihandler"
+	"monorepo/bin-ai-manager/pkg/dbhandler"
+	cmcall "monorepo/bin-call-manager/models/call"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/notifyhandler"
+	"monorepo/bin-common-handler/pkg/requesthandler"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+	cmcustomer "monorepo/bin-customer-manager/models/customer"
+	tmtranscribe "monorepo/bin-transcribe-manager/models/transcribe"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_Start(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		customerID  uuid.UUID
+		agentID     uuid.UUID
+		aiID        uuid.UUID
+		referenceID uuid.UUID
+		language    string
+		checklist   []aiassist.ChecklistItem
+
+		responseAI         *ai.AI
+		responseCall       *cmcall.Call
+		responseTranscribe *tmtranscribe.Transcribe
+		responseUUID       uuid.UUID
+
+		expectAIAssist *aiassist.AIAssist
+	}{
+		{
+			name: "normal",
+
+			customerID:  uuid.FromStringOrNil("5a0e3c1e-b1f8-11f0-9d27-3b6f1a8c2e01"),
+			agentID:     uuid.FromStringOrNil("5a39b6d4-b1f8-11f0-a4e1-6c2d9f3b1a02"),
+			aiID:        uuid.FromStringOrNil("5a64a2f0-b1f8-11f0-8f53-1e7a4c6d2b03"),
+			referenceID: uuid.FromStringOrNil("5a8f1c6a-b1f8-11f0-b8c6-4d3e7a1f5c04"),
+			language:    "en-US",
+			checklist: []aiassist.ChecklistItem{
+				{Name: "verify identity", Done: true},
+			},
+
+			responseAI: &ai.AI{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("5a64a2f0-b1f8-11f0-8f53-1e7a4c6d2b03"),
+					CustomerID: uuid.FromStringOrNil("5a0e3c1e-b1f8-11f0-9d27-3b6f1a8c2e01"),
+				},
+			},
+			responseCall: &cmcall.Call{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("5a8f1c6a-b1f8-11f0-b8c6-4d3e7a1f5c04"),
+					CustomerID: uuid.FromStringOrNil("5a0e3c1e-b1f8-11f0-9d27-3b6f1a8c2e01"),
+				},
+				ActiveflowID: uuid.FromStringOrNil("5ab9e4b2-b1f8-11f0-9a71-2f5c8e3d6a05"),
+				Status:       cmcall.StatusProgressing,
+			},
+			responseTranscribe: &tmtranscribe.Transcribe{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("5ae4d0ce-b1f8-11f0-8e92-7a1b3c5d9f06"),
+				},
+			},
+			responseUUID: uuid.FromStringOrNil("5b0fa8e8-b1f8-11f0-a1d4-5c6e2f8a3b07"),
+
+			expectAIAssist: &aiassist.AIAssist{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("5b0fa8e8-b1f8-11f0-a1d4-5c6e2f8a3b07"),
+					CustomerID: uuid.FromStringOrNil("5a0e3c1e-b1f8-11f0-9d27-3b6f1a8c2e01"),
+				},
+				Owner: commonidentity.Owner{
+					OwnerType: commonidentity.OwnerTypeAgent,
+					OwnerID:   uuid.FromStringOrNil("5a39b6d4-b1f8-11f0-a4e1-6c2d9f3b1a02"),
+				},
+				AIID:          uuid.FromStringOrNil("5a64a2f0-b1f8-11f0-8f53-1e7a4c6d2b03"),
+				ReferenceType: aiassist.ReferenceTypeCall,
+				ReferenceID:   uuid.FromStringOrNil("5a8f1c6a-b1f8-11f0-b8c6-4d3e7a1f5c04"),
+				TranscribeID:  uuid.FromStringOrNil("5ae4d0ce-b1f8-11f0-8e92-7a1b3c5d9f06"),
+				Status:        aiassist.StatusProgressing,
+				Language:      "en-US",
+				Checklist: []aiassist.ChecklistItem{
+					{Name: "verify identity"},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+			mockAI := aihandler.NewMockAIHandler(mc)
+
+			h := aiassistHandler{
+				utilHandler:   mockUtil,
+				db:            mockDB,
+				notifyHandler: mockNotify,
+				reqHandler:    mockReq,
+				aiHandler:     mockAI,
+			}
+			ctx := context.Background()
+
+			mockAI.EXPECT().Get(ctx, tt.aiID).Return(tt.responseAI, nil)
+			mockReq.EXPECT().CallV1CallGet(ctx, tt.referenceID).Return(tt.responseCall, nil)
+			mockReq.EXPECT().TranscribeV1TranscribeStart(
+				ctx,
+				cmcustomer.IDAIManager,
+				tt.responseCall.ActiveflowID,
+				uuid.Nil,
+				tmtranscribe.ReferenceTypeCall,
+				tt.referenceID,
+				tt.language,
+				tmtranscribe.DirectionBoth,
+				tmtranscribe.ProviderEmpty,
+				5000,
+			).Return(tt.responseTranscribe, nil)
+
+			mockUtil.EXPECT().UUIDCreate().Return(tt.responseUUID)
+			mockDB.EXPECT().AIAssistCreate(ctx, tt.expectAIAssist).Return(nil)
+			mockDB.EXPECT().AIAssistGet(ctx, tt.responseUUID).Return(tt.expectAIAssist, nil)
+			mockNotify.EXPECT().PublishWebhookEvent(ctx, tt.customerID, aiassist.EventTypeCreated, tt.expectAIAssist)
+
+			res, err := h.Start(ctx, tt.customerID, tt.agentID, tt.aiID, aiassist.ReferenceTypeCall, tt.referenceID, tt.language, tt.checklist)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectAIAssist) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectAIAssist, res)
+			}
+		})
+	}
+}
+
+func Test_Start_error(t *testing.T) {
+
+	customerID := uuid.FromStringOrNil("5b3a6e02-b1f8-11f0-9c35-1d4f7a2e6c08")
+	aiID := uuid.FromStringOrNil("5b64f91c-b1f8-11f0-b6e8-3e2a5c8d1f09")
+	referenceID := uuid.FromStringOrNil("5b8f7d36-b1f8-11f0-8a47-6f1c3e9b2d0a")
+
+	tests := []struct {
+		name string
+
+		checklist []aiassist.ChecklistItem
+
+		responseAI   *ai.AI
+		responseCall *cmcall.Call
+	}{
+		{
+			name: "invalid checklist",
+
+			checklist: []aiassist.ChecklistItem{
+				{Name: strings.Repeat("a", aiassist.MaxChecklistItemLength+1)},
+			},
+		},
+		{
+			name: "ai of another customer",
+
+			responseAI: &ai.AI{
+				Identity: commonidentity.Identity{
+					ID:         aiID,
+					CustomerID: uuid.FromStringOrNil("5bb9f350-b1f8-11f0-a2d9-4b7e1f3c5a0b"),
+				},
+			},
+		},
+		{
+			name: "call hung up",
+
+			responseAI: &ai.AI{
+				Identity: commonidentity.Identity{
+					ID:         aiID,
+					CustomerID: customerID,
+				},
+			},
+			responseCall: &cmcall.Call{
+				Identity: commonidentity.Identity{
+					ID:         referenceID,
+					CustomerID: customerID,
+				},
+				Status: cmcall.StatusHangup,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockAI := aihandler.NewMockAIHandler(mc)
+
+			h := aiassistHandler{
+				reqHandler: mockReq,
+				aiHandler:  mockAI,
+			}
+			ctx := context.Background()
+
+			if tt.responseAI != nil {
+				mockAI.EXPECT().Get(ctx, aiID).Return(tt.responseAI, nil)
+			}
+			if tt.responseCall != nil {
+				mockReq.EXPECT().CallV1CallGet(ctx, referenceID).Return(tt.responseCall, nil)
+			}
+
+			if _, err := h.Start(ctx, customerID, uuid.Nil, aiID, aiassist.ReferenceTypeCall, referenceID, "en-US", tt.checklist); err == nil {
+				t.Errorf("Wrong match. expect: error, got: ok")
+			}
+		})
+	}
+}
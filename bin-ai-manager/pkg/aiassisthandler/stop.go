@@ -0,0 +1,46 @@
+package aiassisthandler
+
+import (
+	"context"
+
+	"monorepo/bin-ai-manager/models/aiassist"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// Stop stops the aiassist. Stopping a terminated aiassist does nothing.
+func (h *aiassistHandler) Stop(ctx context.Context, id uuid.UUID) (*aiassist.AIAssist, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":        "Stop",
+		"aiassist_id": id,
+	})
+
+	a, err := h.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if a.Status == aiassist.StatusTerminated {
+		return a, nil
+	}
+
+	// the transcribe could have been stopped already with the call's hangup.
+	// the aiassist is terminated anyway.
+	if _, errStop := h.reqHandler.TranscribeV1TranscribeStop(ctx, a.TranscribeID); errStop != nil {
+		log.Infof("Could not stop the transcribe. transcribe_id: %s, err: %v", a.TranscribeID, errStop)
+	}
+
+	res, err := h.update(ctx, id, map[aiassist.Field]any{
+		aiassist.FieldStatus: aiassist.StatusTerminated,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not update the status")
+	}
+
+	// the usage is not added after the termination. billing-manager charges it with this event.
+	h.notifyHandler.PublishWebhookEvent(ctx, res.CustomerID, aiassist.EventTypeStatusTerminated, res)
+
+	return res, nil
+}
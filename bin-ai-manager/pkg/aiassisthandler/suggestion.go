@@ -0,0 +1,70 @@
+package aiassisthandler
+
+import (
+	"context"
+
+	"monorepo/bin-ai-manager/models/aiassist"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// suggestKnowledge searches the AI's knowledge base with the given query and
+// delivers the best matching source to the agent.
+func (h *aiassistHandler) suggestKnowledge(ctx context.Context, a *aiassist.AIAssist, transcriptID uuid.UUID, ragID uuid.UUID, query string) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":        "suggestKnowledge",
+		"aiassist_id": a.ID,
+		"rag_id":      ragID,
+	})
+
+	res, err := h.reqHandler.RagV1RagQuery(ctx, ragID, query, ragTopK)
+	if err != nil {
+		log.Errorf("RAG query failed. err: %v", err)
+		return
+	}
+	log.Debugf("RAG query completed. source_count: %d", len(res.Sources))
+
+	if len(res.Sources) == 0 {
+		return
+	}
+
+	sources := make([]aiassist.SuggestionSource, 0, len(res.Sources))
+	for _, s := range res.Sources {
+		sources = append(sources, aiassist.SuggestionSource{
+			DocumentName:   s.DocumentName,
+			SectionTitle:   s.SectionTitle,
+			RelevanceScore: s.RelevanceScore,
+		})
+	}
+
+	h.publishSuggestion(ctx, a, transcriptID, aiassist.SuggestionTypeKnowledge, res.Sources[0].Text, sources)
+}
+
+// publishSuggestion delivers the suggestion to the aiassist's agent.
+func (h *aiassistHandler) publishSuggestion(
+	ctx context.Context,
+	a *aiassist.AIAssist,
+	transcriptID uuid.UUID,
+	suggestionType aiassist.SuggestionType,
+	content string,
+	sources []aiassist.SuggestionSource,
+) {
+	s := &aiassist.Suggestion{
+		Identity: commonidentity.Identity{
+			ID:         h.utilHandler.UUIDCreate(),
+			CustomerID: a.CustomerID,
+		},
+		Owner:        a.Owner,
+		AIAssistID:   a.ID,
+		TranscriptID: transcriptID,
+		Type:         suggestionType,
+		Content:      content,
+		Sources:      sources,
+		TMCreate:     h.utilHandler.TimeNow(),
+	}
+
+	promAIAssistSuggestionTotal.WithLabelValues(string(suggestionType)).Inc()
+	h.notifyHandler.PublishWebhookEvent(ctx, a.CustomerID, aiassist.EventTypeSuggestionCreated, s)
+}
@@ -17,6 +17,7 @@ import (
 	"monorepo/bin-ai-manager/models/aicall"
 	"monorepo/bin-ai-manager/models/aiaudit"
 	"monorepo/bin-ai-manager/models/message"
+	"monorepo/bin-ai-manager/models/usage"
 	"monorepo/bin-ai-manager/pkg/dbhandler"
 	"monorepo/bin-ai-manager/pkg/geminiaudithandler"
 	commonidentity "monorepo/bin-common-handler/models/identity"
@@ -200,6 +201,7 @@ func (h *aiauditHandler) runAuditJob(ctx context.Context, recordID uuid.UUID, ac
 	var finalEvalJSON json.RawMessage
 	finalErr := ""
 	var finalMsgIDs []uuid.UUID // nil unless audit completes successfully
+	var finalUsage *usage.Usage
 
 	defer func() {
 		defer func() { <-h.semaphore }() // released after all cleanup
@@ -211,7 +213,7 @@ func (h *aiauditHandler) runAuditJob(ctx context.Context, recordID uuid.UUID, ac
 		log.Debugf("writing final audit result: status=%s score=%v err=%q", finalStatus, finalScore, finalErr)
 		writeCtx, writeCancel := context.WithTimeout(context.Background(), 10*time.Second)
 		defer writeCancel()
-		n, dbErr := h.db.AIAuditUpdateFinal(writeCtx, recordID, finalStatus, finalScore, finalEvalJSON, finalErr, finalMsgIDs)
+		n, dbErr := h.db.AIAuditUpdateFinal(writeCtx, recordID, finalStatus, finalScore, finalEvalJSON, finalErr, finalMsgIDs, finalUsage)
 		if dbErr != nil {
 			log.WithError(dbErr).Error("could not write final audit result")
 		} else if n == 0 {
@@ -314,6 +316,7 @@ func (h *aiauditHandler) runAuditJob(ctx context.Context, recordID uuid.UUID, ac
 	finalScore = &score
 	finalEvalJSON = rawJSON
 	finalMsgIDs = msgIDs // only assigned on success; nil on every failure path
+	finalUsage = &result.Usage
 }
 
 // Get returns a single audit record by ID.
@@ -371,7 +374,7 @@ func (h *aiauditHandler) SweepStaleAudits(ctx context.Context) {
 
 	logrus.Infof("startup stale audit sweep: found %d stale audit(s), marking as failed", len(stale))
 	for _, a := range stale {
-		if _, dbErr := h.db.AIAuditUpdateFinal(ctx, a.ID, aiaudit.StatusFailed, nil, nil, string(aiaudit.ErrorEvaluatorUnavailable), nil); dbErr != nil {
+		if _, dbErr := h.db.AIAuditUpdateFinal(ctx, a.ID, aiaudit.StatusFailed, nil, nil, string(aiaudit.ErrorEvaluatorUnavailable), nil, nil); dbErr != nil {
 			logrus.WithError(dbErr).Errorf("startup stale audit sweep: failed to mark audit %s as failed", a.ID)
 		} else {
 			logrus.Infof("startup stale audit sweep: marked audit %s as failed (aicall_id=%s)", a.ID, a.AIcallID)
@@ -11,6 +11,7 @@ import (
 
 	"monorepo/bin-ai-manager/models/aicall"
 	"monorepo/bin-ai-manager/models/aiaudit"
+	"monorepo/bin-ai-manager/models/usage"
 	message "monorepo/bin-ai-manager/models/message"
 	"monorepo/bin-ai-manager/pkg/aiaudithandler"
 	"monorepo/bin-ai-manager/pkg/dbhandler"
@@ -68,7 +69,7 @@ func TestCreate_HappyPath(t *testing.T) {
 	// Background goroutine may call these; AnyTimes to prevent test flakiness.
 	mockDB.EXPECT().MessageList(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
 	mockGemini.EXPECT().Evaluate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil, nil).AnyTimes()
-	mockDB.EXPECT().AIAuditUpdateFinal(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(int64(1), nil).AnyTimes()
+	mockDB.EXPECT().AIAuditUpdateFinal(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(int64(1), nil).AnyTimes()
 
 	h := aiaudithandler.NewAIAuditHandler(mockDB, mockGemini)
 	got, err := h.Create(context.Background(), customerID, aicallID, "en-US")
@@ -260,7 +261,10 @@ func TestCreate_CompletedPath_StoresMessageIDs(t *testing.T) {
 	}
 
 	score := 88
-	evalResult := &geminiaudithandler.EvaluationResponse{OverallScore: score}
+	evalResult := &geminiaudithandler.EvaluationResponse{
+		OverallScore: score,
+		Usage:        usage.Usage{PromptTokens: 2400, CompletionTokens: 350},
+	}
 	rawJSON := json.RawMessage(`{"overall_score":88}`)
 
 	resultCh := make(chan []uuid.UUID, 1)
@@ -279,7 +283,8 @@ func TestCreate_CompletedPath_StoresMessageIDs(t *testing.T) {
 		gomock.Any(),
 		"",
 		gomock.Any(),
-	).DoAndReturn(func(_ context.Context, _ uuid.UUID, _ aiaudit.Status, _ *int, _ json.RawMessage, _ string, messageIDs []uuid.UUID) (int64, error) {
+		&evalResult.Usage,
+	).DoAndReturn(func(_ context.Context, _ uuid.UUID, _ aiaudit.Status, _ *int, _ json.RawMessage, _ string, messageIDs []uuid.UUID, _ *usage.Usage) (int64, error) {
 		resultCh <- messageIDs
 		return int64(1), nil
 	})
@@ -374,7 +379,8 @@ func TestCreate_FailedPath_MessageIDsNil(t *testing.T) {
 		gomock.Nil(),
 		gomock.Any(),
 		gomock.Any(),
-	).DoAndReturn(func(_ context.Context, _ uuid.UUID, _ aiaudit.Status, _ *int, _ json.RawMessage, _ string, messageIDs []uuid.UUID) (int64, error) {
+		gomock.Nil(),
+	).DoAndReturn(func(_ context.Context, _ uuid.UUID, _ aiaudit.Status, _ *int, _ json.RawMessage, _ string, messageIDs []uuid.UUID, _ *usage.Usage) (int64, error) {
 		resultCh <- messageIDs
 		return int64(1), nil
 	})
@@ -11,6 +11,7 @@ import (
 
 	"monorepo/bin-ai-manager/models/ai"
 	"monorepo/bin-ai-manager/models/aicall"
+	"monorepo/bin-ai-manager/models/usage"
 	"monorepo/bin-ai-manager/pkg/dbhandler"
 	cerrors "monorepo/bin-common-handler/models/errors"
 	"monorepo/bin-common-handler/models/identity"
@@ -362,3 +363,22 @@ func (h *aicallHandler) UpdateStatus(ctx context.Context, id uuid.UUID, status a
 
 	return res, nil
 }
+
+// addUsage adds the given pipecatcall's AI provider usage to the aicall.
+// The usage of the same pipecatcall is added only once, so it is safe to call
+// for both the terminate response and the pipecatcall_terminated event.
+func (h *aicallHandler) addUsage(ctx context.Context, id uuid.UUID, pipecatcallID uuid.UUID, u usage.Usage) error {
+	if u.IsEmpty() {
+		return nil
+	}
+
+	n, err := h.db.AIcallAddUsage(ctx, id, pipecatcallID, &u)
+	if err != nil {
+		return errors.Wrapf(err, "could not add the usage to the aicall. aicall_id: %s", id)
+	}
+	if n == 0 {
+		logrus.WithField("aicall_id", id).Debugf("The pipecatcall's usage was already added. pipecatcall_id: %s", pipecatcallID)
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"monorepo/bin-ai-manager/models/aicall"
 	"monorepo/bin-ai-manager/models/message"
+	"monorepo/bin-ai-manager/models/usage"
 	cmcall "monorepo/bin-call-manager/models/call"
 	cmconfbridge "monorepo/bin-call-manager/models/confbridge"
 	cmdtmf "monorepo/bin-call-manager/models/dtmf"
@@ -117,3 +118,22 @@ func (h *aicallHandler) EventPMPipecatcallInitialized(ctx context.Context, evt *
 		return
 	}
 }
+
+// EventPMPipecatcallTerminated handles the pipecat-manager's pipecatcall_terminated event.
+// It adds the pipecatcall's AI provider usage to the aicall.
+func (h *aicallHandler) EventPMPipecatcallTerminated(ctx context.Context, evt *pmpipecatcall.Pipecatcall) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":           "EventPMPipecatcallTerminated",
+		"pipecatcall_id": evt.ID,
+	})
+
+	if evt.ReferenceType != pmpipecatcall.ReferenceTypeAICall {
+		// nothing to do
+		return
+	}
+
+	if errUsage := h.addUsage(ctx, evt.ReferenceID, evt.ID, usage.FromPipecatcall(evt.Usage)); errUsage != nil {
+		log.Errorf("Could not add the pipecatcall's usage. err: %v", errUsage)
+		return
+	}
+}
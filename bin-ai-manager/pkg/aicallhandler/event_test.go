@@ -4,6 +4,7 @@ import (
 	"context"
 	"monorepo/bin-ai-manager/models/aicall"
 	"monorepo/bin-ai-manager/models/message"
+	"monorepo/bin-ai-manager/models/usage"
 	"monorepo/bin-ai-manager/pkg/aihandler"
 	"monorepo/bin-ai-manager/pkg/dbhandler"
 	"monorepo/bin-ai-manager/pkg/messagehandler"
@@ -171,3 +172,90 @@ func Test_EventPMPipecatcallInitialized(t *testing.T) {
 		})
 	}
 }
+
+func Test_EventPMPipecatcallTerminated(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		evt *pmpipecatcall.Pipecatcall
+
+		expectAIcallID      uuid.UUID
+		expectPipecatcallID uuid.UUID
+		expectUsage         *usage.Usage
+	}{
+		{
+			name: "normal",
+
+			evt: &pmpipecatcall.Pipecatcall{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("8a1c6f52-b3c4-11f0-a0e5-8b2c9f1d3e01"),
+				},
+				ReferenceType: pmpipecatcall.ReferenceTypeAICall,
+				ReferenceID:   uuid.FromStringOrNil("8a4d2e14-b3c4-11f0-9b7a-2f6e1c8d4a02"),
+				Usage: &pmpipecatcall.Usage{
+					PromptTokens:     1200,
+					CompletionTokens: 40,
+					CachedTokens:     1024,
+					STTSeconds:       12.5,
+					TTSSeconds:       8.25,
+				},
+			},
+
+			expectAIcallID:      uuid.FromStringOrNil("8a4d2e14-b3c4-11f0-9b7a-2f6e1c8d4a02"),
+			expectPipecatcallID: uuid.FromStringOrNil("8a1c6f52-b3c4-11f0-a0e5-8b2c9f1d3e01"),
+			expectUsage: &usage.Usage{
+				PromptTokens:     1200,
+				CompletionTokens: 40,
+				CachedTokens:     1024,
+				STTSeconds:       12.5,
+				TTSSeconds:       8.25,
+			},
+		},
+		{
+			name: "no usage",
+
+			evt: &pmpipecatcall.Pipecatcall{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("8a7b3c96-b3c4-11f0-8c41-5d3a7e2f1b03"),
+				},
+				ReferenceType: pmpipecatcall.ReferenceTypeAICall,
+				ReferenceID:   uuid.FromStringOrNil("8aa9f0d8-b3c4-11f0-b2d6-9e4f8a1c5d04"),
+			},
+		},
+		{
+			name: "not an aicall",
+
+			evt: &pmpipecatcall.Pipecatcall{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("8ad6e21a-b3c4-11f0-a7f8-1c5b9d2e6f05"),
+				},
+				ReferenceType: pmpipecatcall.ReferenceTypeCall,
+				ReferenceID:   uuid.FromStringOrNil("8b04a75c-b3c4-11f0-9e13-7a2d4c8f1e06"),
+				Usage: &pmpipecatcall.Usage{
+					STTSeconds: 3,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+
+			h := &aicallHandler{
+				db: mockDB,
+			}
+			ctx := context.Background()
+
+			if tt.expectUsage != nil {
+				mockDB.EXPECT().AIcallAddUsage(ctx, tt.expectAIcallID, tt.expectPipecatcallID, tt.expectUsage).Return(int64(1), nil)
+			}
+
+			h.EventPMPipecatcallTerminated(ctx, tt.evt)
+		})
+	}
+}
@@ -0,0 +1,258 @@
+package aicallhandler
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	fmaction "monorepo/bin-flow-manager/models/action"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"monorepo/bin-ai-manager/models/ai"
+	"monorepo/bin-ai-manager/models/aicall"
+	"monorepo/bin-ai-manager/models/guardrail"
+	"monorepo/bin-ai-manager/models/guardrailviolation"
+	"monorepo/bin-ai-manager/models/message"
+)
+
+// GuardrailCheck checks the AI's output against the aicall's forbidden phrases
+// and returns the text to say instead and whether the output was blocked.
+// Called with every sentence of the AI's output before it reaches the TTS.
+//
+// A blocked output is replaced by the escalation message (or dropped), the
+// violation is recorded and the escalation is triggered. The disclaimer is
+// put in front of the first output of the aicall.
+func (h *aicallHandler) GuardrailCheck(ctx context.Context, id uuid.UUID, text string) (string, bool, error) {
+	if h.guardrailHandler == nil {
+		return text, false, nil
+	}
+
+	c, err := h.Get(ctx, id)
+	if err != nil {
+		return "", false, errors.Wrapf(err, "could not get aicall %s", id)
+	}
+
+	cfg := c.GuardrailConfig()
+	if !cfg.IsEnabled() {
+		return text, false, nil
+	}
+
+	res := text
+	blocked := false
+	if rule, detail := h.guardrailHandler.EvaluateOutput(cfg, text); rule != guardrailviolation.RuleNone {
+		h.guardrailViolate(ctx, c, cfg, rule, detail, text)
+		res = cfg.GetEscalation().Message
+		blocked = true
+	}
+
+	if cfg.Disclaimer != "" {
+		first, errClaim := h.guardrailHandler.DisclaimerClaim(ctx, c.ID)
+		if errClaim != nil {
+			return "", false, errors.Wrapf(errClaim, "could not claim the disclaimer")
+		}
+
+		if first {
+			res = strings.TrimSpace(cfg.Disclaimer + " " + res)
+		}
+	}
+
+	return res, blocked, nil
+}
+
+// GuardrailInputCheck checks the user's input against the aicall's blocked
+// topics and returns the text to say instead of the AI's answer and whether the
+// input was blocked. Called with every user turn before it reaches the LLM.
+//
+// The topics are checked on the input rather than the AI's output, so the AI's
+// refusal, which names the topic, is not taken for a violation. A blocked input
+// is never answered by the AI. The escalation message (or nothing) is said
+// instead, the violation is recorded and the escalation is triggered.
+func (h *aicallHandler) GuardrailInputCheck(ctx context.Context, id uuid.UUID, text string) (string, bool, error) {
+	if h.guardrailHandler == nil {
+		return "", false, nil
+	}
+
+	c, err := h.Get(ctx, id)
+	if err != nil {
+		return "", false, errors.Wrapf(err, "could not get aicall %s", id)
+	}
+
+	cfg := c.GuardrailConfig()
+	if !cfg.IsEnabled() {
+		return "", false, nil
+	}
+
+	rule, detail := h.guardrailHandler.EvaluateInput(cfg, text)
+	if rule == guardrailviolation.RuleNone {
+		return "", false, nil
+	}
+	h.guardrailViolate(ctx, c, cfg, rule, detail, text)
+
+	return cfg.GetEscalation().Message, true, nil
+}
+
+// setGuardrailMetadata freezes the AI's guardrail config into the aicall's
+// metadata. For a team, the starting member's AI decides the guardrail of the
+// whole aicall.
+func (h *aicallHandler) setGuardrailMetadata(a *ai.AI, metadata map[string]any) {
+	if a.Guardrail.IsEnabled() {
+		metadata[aicall.MetaKeyGuardrail] = a.Guardrail
+	}
+}
+
+// guardrailPrompt returns the system prompt telling the AI the guardrail
+// policy, so most of the violations never happen. Returns empty if there is
+// nothing to tell.
+func guardrailPrompt(cfg *guardrail.Config) string {
+	if cfg == nil || (len(cfg.BlockedTopics) == 0 && len(cfg.ForbiddenPhrases) == 0) {
+		return ""
+	}
+
+	lines := []string{"Guardrails:"}
+	if len(cfg.BlockedTopics) > 0 {
+		lines = append(lines, fmt.Sprintf("- Never discuss the following topics. If the user asks about them, politely decline: %s", strings.Join(cfg.BlockedTopics, ", ")))
+	}
+	if len(cfg.ForbiddenPhrases) > 0 {
+		lines = append(lines, fmt.Sprintf("- Never say the following phrases: %s", strings.Join(cfg.ForbiddenPhrases, ", ")))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// guardrailToolCallCheck refuses the tool call if the AI has made more tool
+// calls than allowed since the user's last message. Returns nil if the tool
+// call is allowed.
+func (h *aicallHandler) guardrailToolCallCheck(ctx context.Context, c *aicall.AIcall, tool *message.ToolCall) *messageContent {
+	log := logrus.WithFields(logrus.Fields{
+		"func":      "guardrailToolCallCheck",
+		"aicall_id": c.ID,
+	})
+
+	if h.guardrailHandler == nil {
+		return nil
+	}
+
+	cfg := c.GuardrailConfig()
+	if cfg == nil || cfg.MaxConsecutiveToolCalls == 0 {
+		return nil
+	}
+
+	// the tool calls come in pairs of the assistant and the tool messages.
+	// leave some room for the assistant's texts in between.
+	filters := map[message.Field]any{
+		message.FieldAIcallID: c.ID,
+		message.FieldDeleted:  false,
+	}
+	messages, err := h.messageHandler.List(ctx, uint64((cfg.MaxConsecutiveToolCalls+1)*3), "", filters)
+	if err != nil {
+		// never block the tool call on a lookup failure.
+		log.Errorf("Could not get the messages. err: %v", err)
+		return nil
+	}
+
+	count := 0
+	for _, m := range messages {
+		if m.Role == message.RoleUser {
+			break
+		}
+		if m.Role == message.RoleAssistant {
+			count += len(m.ToolCalls)
+		}
+	}
+	if count <= cfg.MaxConsecutiveToolCalls {
+		return nil
+	}
+	log.Infof("The AI has exceeded the max consecutive tool calls. count: %d, max: %d", count, cfg.MaxConsecutiveToolCalls)
+
+	h.guardrailViolate(ctx, c, cfg, guardrailviolation.RuleMaxConsecutiveToolCalls, strconv.Itoa(count), string(tool.Function.Name))
+
+	res := newToolResult(tool.ID)
+	fillFailed(res, fmt.Errorf("the tool call was refused by the guardrail. too many consecutive tool calls. wait for the user's response"))
+	return res
+}
+
+// guardrailViolate records the violation and triggers the escalation.
+func (h *aicallHandler) guardrailViolate(ctx context.Context, c *aicall.AIcall, cfg *guardrail.Config, rule guardrailviolation.Rule, detail string, content string) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":      "guardrailViolate",
+		"aicall_id": c.ID,
+		"rule":      rule,
+	})
+
+	// the violation record must not keep the personal data the aicall redacts.
+	if h.redactionHandler != nil {
+		tmp, errRedact := h.redactionHandler.Redact(ctx, c.ID, content)
+		if errRedact != nil {
+			log.Errorf("Could not redact the content. Dropping the content. err: %v", errRedact)
+			tmp = ""
+		}
+		content = tmp
+	}
+
+	escalation := cfg.GetEscalation()
+	aiID := h.resolveActiveAIIDFromAIcall(ctx, c)
+	v, err := h.guardrailHandler.ViolationCreate(ctx, c, aiID, rule, detail, content, escalation.Action)
+	if err != nil {
+		// the escalation goes on without the record.
+		log.Errorf("Could not create the guardrail violation. err: %v", err)
+	} else {
+		log.WithField("guardrail_violation", v).Debugf("Created the guardrail violation. guardrail_violation_id: %s", v.ID)
+	}
+
+	if escalation.Action == guardrail.EscalationActionNone || c.Status == aicall.StatusTerminating || c.Status == aicall.StatusTerminated {
+		return
+	}
+
+	go func() {
+		if errEscalate := h.guardrailEscalate(context.Background(), c, escalation); errEscalate != nil {
+			log.Errorf("Could not escalate the guardrail violation. err: %v", errEscalate)
+		}
+	}()
+}
+
+// guardrailEscalate takes the escalation action on the aicall.
+// A transfer is possible only for the call reference type. For the others, the
+// aicall service is stopped instead.
+func (h *aicallHandler) guardrailEscalate(ctx context.Context, c *aicall.AIcall, escalation guardrail.Escalation) error {
+	log := logrus.WithFields(logrus.Fields{
+		"func":      "guardrailEscalate",
+		"aicall_id": c.ID,
+		"action":    escalation.Action,
+	})
+
+	if escalation.Action == guardrail.EscalationActionTransfer && c.ReferenceType == aicall.ReferenceTypeCall {
+		actions := []fmaction.Action{
+			{
+				Type: fmaction.TypeQueueJoin,
+				Option: fmaction.ConvertOption(fmaction.OptionQueueJoin{
+					QueueID: escalation.QueueID,
+				}),
+			},
+		}
+
+		af, err := h.reqHandler.FlowV1ActiveflowAddActions(ctx, c.ActiveflowID, actions)
+		if err != nil {
+			return errors.Wrapf(err, "could not add the queue join action")
+		}
+		log.WithField("activeflow", af).Debugf("Added the queue join action. activeflow_id: %s, queue_id: %s", c.ActiveflowID, escalation.QueueID)
+
+		// terminating the aicall moves the call on to the queue join action right away.
+		tmp, err := h.reqHandler.AIV1AIcallTerminate(ctx, c.ID)
+		if err != nil {
+			return errors.Wrapf(err, "could not terminate the aicall")
+		}
+		log.WithField("aicall", tmp).Debugf("Terminating the aicall for the transfer. aicall_id: %s", c.ID)
+
+		return nil
+	}
+
+	if errStop := h.serviceStop(ctx, c); errStop != nil {
+		return errors.Wrapf(errStop, "could not stop the service")
+	}
+
+	return nil
+}
@@ -0,0 +1,613 @@
+package aicallhandler
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	fmaction "monorepo/bin-flow-manager/models/action"
+	fmactiveflow "monorepo/bin-flow-manager/models/activeflow"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/requesthandler"
+
+	"monorepo/bin-ai-manager/models/ai"
+	"monorepo/bin-ai-manager/models/aicall"
+	"monorepo/bin-ai-manager/models/guardrail"
+	"monorepo/bin-ai-manager/models/guardrailviolation"
+	"monorepo/bin-ai-manager/models/message"
+	"monorepo/bin-ai-manager/pkg/dbhandler"
+	"monorepo/bin-ai-manager/pkg/guardrailhandler"
+	"monorepo/bin-ai-manager/pkg/messagehandler"
+)
+
+func Test_GuardrailCheck(t *testing.T) {
+	tests := []struct {
+		name string
+
+		id   uuid.UUID
+		text string
+
+		responseAIcall          *aicall.AIcall
+		responseRule            guardrailviolation.Rule
+		responseDetail          string
+		responseDisclaimerFirst bool
+
+		expectDisclaimerClaim bool
+		expectRes             string
+		expectBlocked         bool
+	}{
+		{
+			name: "guardrail disabled",
+
+			id:   uuid.FromStringOrNil("3e0a4f6c-ad0f-11f0-8a3d-5f2b7c9e1a40"),
+			text: "hello there",
+
+			responseAIcall: &aicall.AIcall{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("3e0a4f6c-ad0f-11f0-8a3d-5f2b7c9e1a40"),
+				},
+				Metadata: map[string]any{},
+			},
+
+			expectRes: "hello there",
+		},
+		{
+			name: "passed",
+
+			id:   uuid.FromStringOrNil("3e3c1a82-ad0f-11f0-b7e4-1d6a9f3c5b21"),
+			text: "hello there",
+
+			responseAIcall: &aicall.AIcall{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("3e3c1a82-ad0f-11f0-b7e4-1d6a9f3c5b21"),
+				},
+				Metadata: map[string]any{
+					aicall.MetaKeyGuardrail: &guardrail.Config{
+						BlockedTopics: []string{"politics"},
+					},
+				},
+			},
+			responseRule: guardrailviolation.RuleNone,
+
+			expectRes: "hello there",
+		},
+		{
+			name: "blocked without escalation",
+
+			id:   uuid.FromStringOrNil("3e6e2b94-ad0f-11f0-9c51-8b4e2d7f6a32"),
+			text: "this fund is risk free",
+
+			responseAIcall: &aicall.AIcall{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("3e6e2b94-ad0f-11f0-9c51-8b4e2d7f6a32"),
+				},
+				AssistanceType: aicall.AssistanceTypeAI,
+				AssistanceID:   uuid.FromStringOrNil("3ea0f6c8-ad0f-11f0-a2d6-6c3f8e1b9d43"),
+				Metadata: map[string]any{
+					aicall.MetaKeyGuardrail: &guardrail.Config{
+						ForbiddenPhrases: []string{"risk free"},
+						Escalation: &guardrail.Escalation{
+							Message: "I can't help with that.",
+						},
+					},
+				},
+			},
+			responseRule:   guardrailviolation.RuleForbiddenPhrase,
+			responseDetail: "risk free",
+
+			expectRes:     "I can't help with that.",
+			expectBlocked: true,
+		},
+		{
+			name: "disclaimer on the first output",
+
+			id:   uuid.FromStringOrNil("3ed3a1de-ad0f-11f0-8f7b-2a5d9c4e7b54"),
+			text: "how can I help you?",
+
+			responseAIcall: &aicall.AIcall{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("3ed3a1de-ad0f-11f0-8f7b-2a5d9c4e7b54"),
+				},
+				Metadata: map[string]any{
+					aicall.MetaKeyGuardrail: &guardrail.Config{
+						Disclaimer: "This call is recorded.",
+					},
+				},
+			},
+			responseRule:            guardrailviolation.RuleNone,
+			responseDisclaimerFirst: true,
+
+			expectDisclaimerClaim: true,
+			expectRes:             "This call is recorded. how can I help you?",
+		},
+		{
+			name: "disclaimer already said",
+
+			id:   uuid.FromStringOrNil("3f05d3f0-ad0f-11f0-b4c8-7e1a3f6d2c65"),
+			text: "anything else?",
+
+			responseAIcall: &aicall.AIcall{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("3f05d3f0-ad0f-11f0-b4c8-7e1a3f6d2c65"),
+				},
+				Metadata: map[string]any{
+					aicall.MetaKeyGuardrail: &guardrail.Config{
+						Disclaimer: "This call is recorded.",
+					},
+				},
+			},
+			responseRule:            guardrailviolation.RuleNone,
+			responseDisclaimerFirst: false,
+
+			expectDisclaimerClaim: true,
+			expectRes:             "anything else?",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockGuardrail := guardrailhandler.NewMockGuardrailHandler(mc)
+			h := &aicallHandler{
+				db:               mockDB,
+				guardrailHandler: mockGuardrail,
+			}
+			ctx := context.Background()
+
+			mockDB.EXPECT().AIcallGet(ctx, tt.id).Return(tt.responseAIcall, nil)
+
+			cfg := tt.responseAIcall.GuardrailConfig()
+			if cfg.IsEnabled() {
+				mockGuardrail.EXPECT().EvaluateOutput(cfg, tt.text).Return(tt.responseRule, tt.responseDetail)
+				if tt.responseRule != guardrailviolation.RuleNone {
+					mockGuardrail.EXPECT().ViolationCreate(ctx, tt.responseAIcall, tt.responseAIcall.AssistanceID, tt.responseRule, tt.responseDetail, tt.text, guardrail.EscalationActionNone).Return(&guardrailviolation.GuardrailViolation{}, nil)
+				}
+			}
+			if tt.expectDisclaimerClaim {
+				mockGuardrail.EXPECT().DisclaimerClaim(ctx, tt.id).Return(tt.responseDisclaimerFirst, nil)
+			}
+
+			res, blocked, err := h.GuardrailCheck(ctx, tt.id, tt.text)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if res != tt.expectRes {
+				t.Errorf("Wrong match.\nexpect: %s\ngot: %s", tt.expectRes, res)
+			}
+			if blocked != tt.expectBlocked {
+				t.Errorf("Wrong match. expect: %v, got: %v", tt.expectBlocked, blocked)
+			}
+		})
+	}
+}
+
+func Test_GuardrailCheck_refusal(t *testing.T) {
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockDB := dbhandler.NewMockDBHandler(mc)
+	mockReq := requesthandler.NewMockRequestHandler(mc)
+	h := &aicallHandler{
+		db:               mockDB,
+		reqHandler:       mockReq,
+		guardrailHandler: guardrailhandler.NewGuardrailHandler(nil, mockDB),
+	}
+	ctx := context.Background()
+
+	id := uuid.FromStringOrNil("3f37e0a2-ad0f-11f0-9d1e-4b8c2e6f1a76")
+	c := &aicall.AIcall{
+		Identity: commonidentity.Identity{
+			ID: id,
+		},
+		ReferenceType: aicall.ReferenceTypeCall,
+		Metadata: map[string]any{
+			aicall.MetaKeyGuardrail: &guardrail.Config{
+				BlockedTopics: []string{"investment advice"},
+				Escalation: &guardrail.Escalation{
+					Action:  guardrail.EscalationActionTransfer,
+					QueueID: uuid.FromStringOrNil("3f69b4c6-ad0f-11f0-8e2f-5c9d3f7a2b87"),
+					Message: "Let me transfer you to an agent.",
+				},
+			},
+		},
+	}
+	text := "I'm sorry, I can't give investment advice."
+
+	// no violation is created and no escalation is triggered.
+	mockDB.EXPECT().AIcallGet(ctx, id).Return(c, nil)
+
+	res, blocked, err := h.GuardrailCheck(ctx, id, text)
+	if err != nil {
+		t.Errorf("Wrong match. expect: ok, got: %v", err)
+	}
+
+	if res != text {
+		t.Errorf("Wrong match.\nexpect: %s\ngot: %s", text, res)
+	}
+	if blocked {
+		t.Errorf("Wrong match. expect: false, got: true")
+	}
+}
+
+func Test_GuardrailInputCheck(t *testing.T) {
+	tests := []struct {
+		name string
+
+		id   uuid.UUID
+		text string
+
+		responseAIcall *aicall.AIcall
+		responseRule   guardrailviolation.Rule
+		responseDetail string
+
+		expectRes     string
+		expectBlocked bool
+	}{
+		{
+			name: "guardrail disabled",
+
+			id:   uuid.FromStringOrNil("3f9b88ea-ad0f-11f0-b3a0-6dae4f8b3c98"),
+			text: "let's talk about politics",
+
+			responseAIcall: &aicall.AIcall{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("3f9b88ea-ad0f-11f0-b3a0-6dae4f8b3c98"),
+				},
+				Metadata: map[string]any{},
+			},
+		},
+		{
+			name: "passed",
+
+			id:   uuid.FromStringOrNil("3fcd5d0e-ad0f-11f0-a4b1-7ebf5a9c4da9"),
+			text: "what is my balance?",
+
+			responseAIcall: &aicall.AIcall{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("3fcd5d0e-ad0f-11f0-a4b1-7ebf5a9c4da9"),
+				},
+				Metadata: map[string]any{
+					aicall.MetaKeyGuardrail: &guardrail.Config{
+						BlockedTopics: []string{"politics"},
+					},
+				},
+			},
+			responseRule: guardrailviolation.RuleNone,
+		},
+		{
+			name: "blocked without escalation",
+
+			id:   uuid.FromStringOrNil("3fff3132-ad0f-11f0-95c2-8fc06bad5eba"),
+			text: "let's talk about politics",
+
+			responseAIcall: &aicall.AIcall{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("3fff3132-ad0f-11f0-95c2-8fc06bad5eba"),
+				},
+				AssistanceType: aicall.AssistanceTypeAI,
+				AssistanceID:   uuid.FromStringOrNil("40310556-ad0f-11f0-86d3-90d17cbe6fcb"),
+				Metadata: map[string]any{
+					aicall.MetaKeyGuardrail: &guardrail.Config{
+						BlockedTopics: []string{"politics"},
+						Escalation: &guardrail.Escalation{
+							Message: "I can't help with that.",
+						},
+					},
+				},
+			},
+			responseRule:   guardrailviolation.RuleBlockedTopic,
+			responseDetail: "politics",
+
+			expectRes:     "I can't help with that.",
+			expectBlocked: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockGuardrail := guardrailhandler.NewMockGuardrailHandler(mc)
+			h := &aicallHandler{
+				db:               mockDB,
+				guardrailHandler: mockGuardrail,
+			}
+			ctx := context.Background()
+
+			mockDB.EXPECT().AIcallGet(ctx, tt.id).Return(tt.responseAIcall, nil)
+
+			cfg := tt.responseAIcall.GuardrailConfig()
+			if cfg.IsEnabled() {
+				mockGuardrail.EXPECT().EvaluateInput(cfg, tt.text).Return(tt.responseRule, tt.responseDetail)
+				if tt.responseRule != guardrailviolation.RuleNone {
+					mockGuardrail.EXPECT().ViolationCreate(ctx, tt.responseAIcall, tt.responseAIcall.AssistanceID, tt.responseRule, tt.responseDetail, tt.text, guardrail.EscalationActionNone).Return(&guardrailviolation.GuardrailViolation{}, nil)
+				}
+			}
+
+			res, blocked, err := h.GuardrailInputCheck(ctx, tt.id, tt.text)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if res != tt.expectRes {
+				t.Errorf("Wrong match.\nexpect: %s\ngot: %s", tt.expectRes, res)
+			}
+			if blocked != tt.expectBlocked {
+				t.Errorf("Wrong match. expect: %v, got: %v", tt.expectBlocked, blocked)
+			}
+		})
+	}
+}
+
+func Test_setGuardrailMetadata(t *testing.T) {
+	tests := []struct {
+		name string
+
+		ai *ai.AI
+
+		expectRes map[string]any
+	}{
+		{
+			name: "guardrail enabled",
+
+			ai: &ai.AI{
+				Guardrail: &guardrail.Config{
+					ForbiddenPhrases: []string{"guaranteed return"},
+				},
+			},
+
+			expectRes: map[string]any{
+				aicall.MetaKeyGuardrail: &guardrail.Config{
+					ForbiddenPhrases: []string{"guaranteed return"},
+				},
+			},
+		},
+		{
+			name: "guardrail disabled",
+
+			ai: &ai.AI{},
+
+			expectRes: map[string]any{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &aicallHandler{}
+
+			res := map[string]any{}
+			h.setGuardrailMetadata(tt.ai, res)
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_guardrailPrompt(t *testing.T) {
+	tests := []struct {
+		name string
+
+		cfg *guardrail.Config
+
+		expectRes string
+	}{
+		{
+			name: "nil config",
+
+			cfg: nil,
+
+			expectRes: "",
+		},
+		{
+			name: "disclaimer only",
+
+			cfg: &guardrail.Config{
+				Disclaimer: "This call is recorded.",
+			},
+
+			expectRes: "",
+		},
+		{
+			name: "topics and phrases",
+
+			cfg: &guardrail.Config{
+				BlockedTopics:    []string{"politics", "medical advice"},
+				ForbiddenPhrases: []string{"guaranteed return"},
+			},
+
+			expectRes: "Guardrails:\n" +
+				"- Never discuss the following topics. If the user asks about them, politely decline: politics, medical advice\n" +
+				"- Never say the following phrases: guaranteed return",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := guardrailPrompt(tt.cfg)
+			if res != tt.expectRes {
+				t.Errorf("Wrong match.\nexpect: %s\ngot: %s", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_guardrailToolCallCheck(t *testing.T) {
+	tests := []struct {
+		name string
+
+		aicall *aicall.AIcall
+		tool   *message.ToolCall
+
+		responseMessages []*message.Message
+
+		expectSize      uint64
+		expectViolation bool
+		expectRes       *messageContent
+	}{
+		{
+			name: "under the limit",
+
+			aicall: &aicall.AIcall{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("5a1c7e3a-ad0f-11f0-9e2f-3b6d8a1c4f70"),
+				},
+				AssistanceType: aicall.AssistanceTypeAI,
+				AssistanceID:   uuid.FromStringOrNil("5a4e2b4c-ad0f-11f0-a7c3-8d2f5b9e1a81"),
+				Metadata: map[string]any{
+					aicall.MetaKeyGuardrail: &guardrail.Config{
+						MaxConsecutiveToolCalls: 2,
+					},
+				},
+			},
+			tool: &message.ToolCall{
+				ID: "call_1",
+				Function: message.FunctionCall{
+					Name: message.FunctionCallNameGetVariables,
+				},
+			},
+
+			responseMessages: []*message.Message{
+				{Role: message.RoleAssistant, ToolCalls: []message.ToolCall{{ID: "call_1"}}},
+				{Role: message.RoleTool},
+				{Role: message.RoleAssistant, ToolCalls: []message.ToolCall{{ID: "call_0"}}},
+				{Role: message.RoleUser},
+				{Role: message.RoleAssistant, ToolCalls: []message.ToolCall{{ID: "call_old"}}},
+			},
+
+			expectSize: 9,
+			expectRes:  nil,
+		},
+		{
+			name: "over the limit",
+
+			aicall: &aicall.AIcall{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("5a80f65e-ad0f-11f0-8b14-4c7e9a2d5b92"),
+				},
+				AssistanceType: aicall.AssistanceTypeAI,
+				AssistanceID:   uuid.FromStringOrNil("5ab2a170-ad0f-11f0-bc85-1e3a6f8d2c03"),
+				Metadata: map[string]any{
+					aicall.MetaKeyGuardrail: &guardrail.Config{
+						MaxConsecutiveToolCalls: 1,
+					},
+				},
+			},
+			tool: &message.ToolCall{
+				ID: "call_2",
+				Function: message.FunctionCall{
+					Name: message.FunctionCallNameGetVariables,
+				},
+			},
+
+			responseMessages: []*message.Message{
+				{Role: message.RoleAssistant, ToolCalls: []message.ToolCall{{ID: "call_2"}}},
+				{Role: message.RoleTool},
+				{Role: message.RoleAssistant, ToolCalls: []message.ToolCall{{ID: "call_1"}}},
+				{Role: message.RoleUser},
+			},
+
+			expectSize:      6,
+			expectViolation: true,
+			expectRes: &messageContent{
+				ToolCallID: "call_2",
+				Result:     "failed",
+				Message:    "the tool call was refused by the guardrail. too many consecutive tool calls. wait for the user's response",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockMessage := messagehandler.NewMockMessageHandler(mc)
+			mockGuardrail := guardrailhandler.NewMockGuardrailHandler(mc)
+			h := &aicallHandler{
+				messageHandler:   mockMessage,
+				guardrailHandler: mockGuardrail,
+			}
+			ctx := context.Background()
+
+			filters := map[message.Field]any{
+				message.FieldAIcallID: tt.aicall.ID,
+				message.FieldDeleted:  false,
+			}
+			mockMessage.EXPECT().List(ctx, tt.expectSize, "", filters).Return(tt.responseMessages, nil)
+			if tt.expectViolation {
+				mockGuardrail.EXPECT().ViolationCreate(ctx, tt.aicall, tt.aicall.AssistanceID, guardrailviolation.RuleMaxConsecutiveToolCalls, "2", string(tt.tool.Function.Name), guardrail.EscalationActionNone).Return(&guardrailviolation.GuardrailViolation{}, nil)
+			}
+
+			res := h.guardrailToolCallCheck(ctx, tt.aicall, tt.tool)
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_guardrailEscalate_transfer(t *testing.T) {
+	tests := []struct {
+		name string
+
+		aicall     *aicall.AIcall
+		escalation guardrail.Escalation
+
+		expectActions []fmaction.Action
+	}{
+		{
+			name: "transfer to the queue",
+
+			aicall: &aicall.AIcall{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("6c2e8f4a-ad0f-11f0-9d36-5a8c1e4f7b14"),
+				},
+				ReferenceType: aicall.ReferenceTypeCall,
+				ActiveflowID:  uuid.FromStringOrNil("6c60a35c-ad0f-11f0-a4e7-2f9b6d3c8a25"),
+			},
+			escalation: guardrail.Escalation{
+				Action:  guardrail.EscalationActionTransfer,
+				QueueID: uuid.FromStringOrNil("6c92d46e-ad0f-11f0-8f58-7c4a2e9d1b36"),
+			},
+
+			expectActions: []fmaction.Action{
+				{
+					Type:   fmaction.TypeQueueJoin,
+					Option: map[string]any{"queue_id": "6c92d46e-ad0f-11f0-8f58-7c4a2e9d1b36"},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			h := &aicallHandler{
+				reqHandler: mockReq,
+			}
+			ctx := context.Background()
+
+			mockReq.EXPECT().FlowV1ActiveflowAddActions(ctx, tt.aicall.ActiveflowID, tt.expectActions).Return(&fmactiveflow.Activeflow{}, nil)
+			mockReq.EXPECT().AIV1AIcallTerminate(ctx, tt.aicall.ID).Return(&aicall.AIcall{}, nil)
+
+			if err := h.guardrailEscalate(ctx, tt.aicall, tt.escalation); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+		})
+	}
+}
@@ -20,9 +20,14 @@ import (
 	"monorepo/bin-ai-manager/models/aicall"
 	"monorepo/bin-ai-manager/models/message"
 	"monorepo/bin-ai-manager/pkg/aihandler"
+	"monorepo/bin-ai-manager/pkg/customtoolhandler"
+	"monorepo/bin-ai-manager/pkg/mcpserverhandler"
 	"monorepo/bin-ai-manager/pkg/dbhandler"
+	"monorepo/bin-ai-manager/pkg/guardrailhandler"
 	"monorepo/bin-ai-manager/pkg/messagehandler"
 	"monorepo/bin-ai-manager/pkg/participanthandler"
+	"monorepo/bin-ai-manager/pkg/redactionhandler"
+	"monorepo/bin-ai-manager/pkg/responsecachehandler"
 	"monorepo/bin-ai-manager/pkg/teamhandler"
 	commonservice "monorepo/bin-common-handler/models/service"
 )
@@ -38,6 +43,11 @@ type AIcallHandler interface {
 	ProcessTerminate(ctx context.Context, id uuid.UUID) (*aicall.AIcall, error)
 
 	ToolHandle(ctx context.Context, id uuid.UUID, toolID string, toolType message.ToolType, function message.FunctionCall) (map[string]any, error)
+	Redact(ctx context.Context, id uuid.UUID, text string) (string, error)
+	GuardrailCheck(ctx context.Context, id uuid.UUID, text string) (string, bool, error)
+	GuardrailInputCheck(ctx context.Context, id uuid.UUID, text string) (string, bool, error)
+	ResponseCacheLookup(ctx context.Context, id uuid.UUID, userTurns []string) (string, error)
+	ResponseCacheStore(ctx context.Context, id uuid.UUID, userTurns []string, response string) error
 
 	Start(
 		ctx context.Context,
@@ -65,6 +75,7 @@ type AIcallHandler interface {
 	EventCMConfbridgeLeaved(ctx context.Context, evt *cmconfbridge.EventConfbridgeLeaved)
 	EventCMDTMFReceived(ctx context.Context, evt *cmdtmf.DTMF)
 	EventPMPipecatcallInitialized(ctx context.Context, evt *pmpipecatcall.Pipecatcall)
+	EventPMPipecatcallTerminated(ctx context.Context, evt *pmpipecatcall.Pipecatcall)
 
 	UpdateActiveflowID(ctx context.Context, id uuid.UUID, activeflowID uuid.UUID) (*aicall.AIcall, error)
 	UpdatePipecatcallIDAndActiveflowID(ctx context.Context, id uuid.UUID, pipecatcallID uuid.UUID, activeflowID uuid.UUID) (*aicall.AIcall, error)
@@ -127,6 +138,12 @@ type aicallHandler struct {
 	teamHandler        teamhandler.TeamHandler
 	messageHandler     messagehandler.MessageHandler
 	participantHandler participanthandler.ParticipantHandler
+	customToolHandler  customtoolhandler.CustomToolHandler
+	mcpServerHandler   mcpserverhandler.MCPServerHandler
+	redactionHandler   redactionhandler.RedactionHandler
+	guardrailHandler   guardrailhandler.GuardrailHandler
+
+	responseCacheHandler responsecachehandler.ResponseCacheHandler
 }
 
 var (
@@ -185,6 +202,11 @@ func NewAIcallHandler(
 	teamHandler teamhandler.TeamHandler,
 	messageHandler messagehandler.MessageHandler,
 	participantHandler participanthandler.ParticipantHandler,
+	customToolHandler customtoolhandler.CustomToolHandler,
+	mcpServerHandler mcpserverhandler.MCPServerHandler,
+	redactionHandler redactionhandler.RedactionHandler,
+	guardrailHandler guardrailhandler.GuardrailHandler,
+	responseCacheHandler responsecachehandler.ResponseCacheHandler,
 ) AIcallHandler {
 	return &aicallHandler{
 		utilHandler:   utilhandler.NewUtilHandler(),
@@ -196,6 +218,12 @@ func NewAIcallHandler(
 		teamHandler:        teamHandler,
 		messageHandler:     messageHandler,
 		participantHandler: participantHandler,
+		customToolHandler:  customToolHandler,
+		mcpServerHandler:   mcpServerHandler,
+		redactionHandler:   redactionHandler,
+		guardrailHandler:   guardrailHandler,
+
+		responseCacheHandler: responseCacheHandler,
 	}
 }
 
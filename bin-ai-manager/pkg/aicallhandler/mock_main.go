@@ -123,6 +123,18 @@ func (mr *MockAIcallHandlerMockRecorder) EventPMPipecatcallInitialized(ctx, evt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EventPMPipecatcallInitialized", reflect.TypeOf((*MockAIcallHandler)(nil).EventPMPipecatcallInitialized), ctx, evt)
 }
 
+// EventPMPipecatcallTerminated mocks base method.
+func (m *MockAIcallHandler) EventPMPipecatcallTerminated(ctx context.Context, evt *pipecatcall.Pipecatcall) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "EventPMPipecatcallTerminated", ctx, evt)
+}
+
+// EventPMPipecatcallTerminated indicates an expected call of EventPMPipecatcallTerminated.
+func (mr *MockAIcallHandlerMockRecorder) EventPMPipecatcallTerminated(ctx, evt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EventPMPipecatcallTerminated", reflect.TypeOf((*MockAIcallHandler)(nil).EventPMPipecatcallTerminated), ctx, evt)
+}
+
 // Get mocks base method.
 func (m *MockAIcallHandler) Get(ctx context.Context, id uuid.UUID) (*aicall.AIcall, error) {
 	m.ctrl.T.Helper()
@@ -153,6 +165,38 @@ func (mr *MockAIcallHandlerMockRecorder) GetByReferenceID(ctx, referenceID any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByReferenceID", reflect.TypeOf((*MockAIcallHandler)(nil).GetByReferenceID), ctx, referenceID)
 }
 
+// GuardrailCheck mocks base method.
+func (m *MockAIcallHandler) GuardrailCheck(ctx context.Context, id uuid.UUID, text string) (string, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuardrailCheck", ctx, id, text)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GuardrailCheck indicates an expected call of GuardrailCheck.
+func (mr *MockAIcallHandlerMockRecorder) GuardrailCheck(ctx, id, text any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuardrailCheck", reflect.TypeOf((*MockAIcallHandler)(nil).GuardrailCheck), ctx, id, text)
+}
+
+// GuardrailInputCheck mocks base method.
+func (m *MockAIcallHandler) GuardrailInputCheck(ctx context.Context, id uuid.UUID, text string) (string, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuardrailInputCheck", ctx, id, text)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GuardrailInputCheck indicates an expected call of GuardrailInputCheck.
+func (mr *MockAIcallHandlerMockRecorder) GuardrailInputCheck(ctx, id, text any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuardrailInputCheck", reflect.TypeOf((*MockAIcallHandler)(nil).GuardrailInputCheck), ctx, id, text)
+}
+
 // List mocks base method.
 func (m *MockAIcallHandler) List(ctx context.Context, size uint64, token string, filters map[aicall.Field]any) ([]*aicall.AIcall, error) {
 	m.ctrl.T.Helper()
@@ -198,6 +242,50 @@ func (mr *MockAIcallHandlerMockRecorder) ProcessTerminate(ctx, id any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessTerminate", reflect.TypeOf((*MockAIcallHandler)(nil).ProcessTerminate), ctx, id)
 }
 
+// Redact mocks base method.
+func (m *MockAIcallHandler) Redact(ctx context.Context, id uuid.UUID, text string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Redact", ctx, id, text)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Redact indicates an expected call of Redact.
+func (mr *MockAIcallHandlerMockRecorder) Redact(ctx, id, text any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Redact", reflect.TypeOf((*MockAIcallHandler)(nil).Redact), ctx, id, text)
+}
+
+// ResponseCacheLookup mocks base method.
+func (m *MockAIcallHandler) ResponseCacheLookup(ctx context.Context, id uuid.UUID, userTurns []string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResponseCacheLookup", ctx, id, userTurns)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResponseCacheLookup indicates an expected call of ResponseCacheLookup.
+func (mr *MockAIcallHandlerMockRecorder) ResponseCacheLookup(ctx, id, userTurns any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResponseCacheLookup", reflect.TypeOf((*MockAIcallHandler)(nil).ResponseCacheLookup), ctx, id, userTurns)
+}
+
+// ResponseCacheStore mocks base method.
+func (m *MockAIcallHandler) ResponseCacheStore(ctx context.Context, id uuid.UUID, userTurns []string, response string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResponseCacheStore", ctx, id, userTurns, response)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResponseCacheStore indicates an expected call of ResponseCacheStore.
+func (mr *MockAIcallHandlerMockRecorder) ResponseCacheStore(ctx, id, userTurns, response any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResponseCacheStore", reflect.TypeOf((*MockAIcallHandler)(nil).ResponseCacheStore), ctx, id, userTurns, response)
+}
+
 // Send mocks base method.
 func (m *MockAIcallHandler) Send(ctx context.Context, id uuid.UUID, role message.Role, messageText string, runImmediately, audioResponse bool) (*message.Message, error) {
 	m.ctrl.T.Helper()
@@ -9,6 +9,7 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"monorepo/bin-ai-manager/models/aicall"
+	"monorepo/bin-ai-manager/models/usage"
 	cerrors "monorepo/bin-common-handler/models/errors"
 )
 
@@ -92,6 +93,11 @@ func (h *aicallHandler) ProcessTerminate(ctx context.Context, id uuid.UUID) (*ai
 				}
 			} else {
 				log.Debugf("Pipecatcall terminate RPC completed. pipecatcall_id: %s", tmpPC.ID)
+
+				// add the usage before the terminated event goes out, so the event carries it.
+				if errUsage := h.addUsage(ctx, tmp.ID, tmpPC.ID, usage.FromPipecatcall(tmpPC.Usage)); errUsage != nil {
+					log.Errorf("Could not add the pipecatcall's usage. err: %v", errUsage)
+				}
 			}
 		}
 	}
@@ -21,6 +21,7 @@ import (
 	gomock "go.uber.org/mock/gomock"
 
 	"monorepo/bin-ai-manager/models/aicall"
+	"monorepo/bin-ai-manager/models/usage"
 	"monorepo/bin-ai-manager/pkg/aihandler"
 	"monorepo/bin-ai-manager/pkg/dbhandler"
 )
@@ -155,6 +156,12 @@ func Test_ProcessTerminate(t *testing.T) {
 					ID: uuid.FromStringOrNil("da4a0d92-d9d8-11f0-9dfe-4b563d93e22c"),
 				},
 				HostID: "host-12345",
+				Usage: &pmpipecatcall.Usage{
+					PromptTokens:     3200,
+					CompletionTokens: 150,
+					STTSeconds:       42.5,
+					TTSSeconds:       18.75,
+				},
 			},
 		},
 		{
@@ -234,6 +241,10 @@ func Test_ProcessTerminate(t *testing.T) {
 					} else {
 						mockReq.EXPECT().PipecatV1PipecatcallGet(ctx, tt.responseAicall.PipecatcallID).Return(tt.responsePipecatcall, nil)
 						mockReq.EXPECT().PipecatV1PipecatcallTerminate(ctx, tt.responsePipecatcall.HostID, tt.responsePipecatcall.ID).Return(tt.responsePipecatcall, nil)
+						if tt.responsePipecatcall.Usage != nil {
+							u := usage.FromPipecatcall(tt.responsePipecatcall.Usage)
+							mockDB.EXPECT().AIcallAddUsage(ctx, tt.responseAicall.ID, tt.responsePipecatcall.ID, &u).Return(int64(1), nil)
+						}
 					}
 				}
 
@@ -0,0 +1,34 @@
+package aicallhandler
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid"
+
+	"monorepo/bin-ai-manager/models/ai"
+	"monorepo/bin-ai-manager/models/aicall"
+)
+
+// Redact returns the text with the personal data redacted by the aicall's
+// redaction config. Used for the texts which reach the llm engine without
+// being stored first, e.g. the user's transcriptions in a realtime aicall.
+func (h *aicallHandler) Redact(ctx context.Context, id uuid.UUID, text string) (string, error) {
+	if h.redactionHandler == nil {
+		return text, nil
+	}
+
+	return h.redactionHandler.Redact(ctx, id, text)
+}
+
+// setRedactionMetadata freezes the AI's redaction config, or its customer's
+// default, into the aicall's metadata. For a team, the starting member's AI
+// decides the redaction of the whole aicall.
+func (h *aicallHandler) setRedactionMetadata(ctx context.Context, a *ai.AI, metadata map[string]any) {
+	if h.redactionHandler == nil {
+		return
+	}
+
+	if cfg := h.redactionHandler.ConfigGet(ctx, a); cfg.IsEnabled() {
+		metadata[aicall.MetaKeyRedaction] = cfg
+	}
+}
@@ -0,0 +1,133 @@
+package aicallhandler
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+
+	"monorepo/bin-ai-manager/models/ai"
+	"monorepo/bin-ai-manager/models/aicall"
+	"monorepo/bin-ai-manager/models/redaction"
+	"monorepo/bin-ai-manager/pkg/redactionhandler"
+)
+
+func Test_Redact(t *testing.T) {
+	tests := []struct {
+		name string
+
+		id   uuid.UUID
+		text string
+
+		responseText string
+
+		expectRes string
+	}{
+		{
+			name: "normal",
+
+			id:   uuid.FromStringOrNil("7b0e4c2a-a9fd-11f0-8d3f-2c6e9a1b5d70"),
+			text: "my email is john@example.com",
+
+			responseText: "my email is [EMAIL]",
+
+			expectRes: "my email is [EMAIL]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockRedaction := redactionhandler.NewMockRedactionHandler(mc)
+			h := &aicallHandler{
+				redactionHandler: mockRedaction,
+			}
+			ctx := context.Background()
+
+			mockRedaction.EXPECT().Redact(ctx, tt.id, tt.text).Return(tt.responseText, nil)
+
+			res, err := h.Redact(ctx, tt.id, tt.text)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if res != tt.expectRes {
+				t.Errorf("Wrong match. expect: %s, got: %s", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_setRedactionMetadata(t *testing.T) {
+	tests := []struct {
+		name string
+
+		ai *ai.AI
+
+		responseConfig *redaction.Config
+
+		expectRes map[string]any
+	}{
+		{
+			name: "redaction enabled",
+
+			ai: &ai.AI{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("7a1c3e5f-a9fd-11f0-9d2b-4e6a8c1f3b50"),
+				},
+			},
+
+			responseConfig: &redaction.Config{
+				EntityTypes: []redaction.EntityType{redaction.EntityTypeCreditCard},
+				Mode:        redaction.ModeTokenize,
+			},
+
+			expectRes: map[string]any{
+				aicall.MetaKeyRedaction: &redaction.Config{
+					EntityTypes: []redaction.EntityType{redaction.EntityTypeCreditCard},
+					Mode:        redaction.ModeTokenize,
+				},
+			},
+		},
+		{
+			name: "redaction disabled",
+
+			ai: &ai.AI{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("7a4e2d6c-a9fd-11f0-b3a1-8f2c5e7d9a61"),
+				},
+			},
+
+			responseConfig: &redaction.Config{},
+
+			expectRes: map[string]any{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockRedaction := redactionhandler.NewMockRedactionHandler(mc)
+			h := &aicallHandler{
+				redactionHandler: mockRedaction,
+			}
+			ctx := context.Background()
+
+			mockRedaction.EXPECT().ConfigGet(ctx, tt.ai).Return(tt.responseConfig)
+
+			res := map[string]any{}
+			h.setRedactionMetadata(ctx, tt.ai, res)
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package aicallhandler
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"monorepo/bin-ai-manager/models/ai"
+	"monorepo/bin-ai-manager/models/aicall"
+)
+
+// ResponseCacheLookup returns the cached response for the aicall's user turns.
+// Called before every LLM request of the aicall. Returns empty on a miss.
+func (h *aicallHandler) ResponseCacheLookup(ctx context.Context, id uuid.UUID, userTurns []string) (string, error) {
+	if h.responseCacheHandler == nil {
+		return "", nil
+	}
+
+	c, err := h.Get(ctx, id)
+	if err != nil {
+		return "", errors.Wrapf(err, "could not get aicall %s", id)
+	}
+
+	return h.responseCacheHandler.Lookup(ctx, c, userTurns)
+}
+
+// ResponseCacheStore caches the LLM's response to the aicall's user turns.
+func (h *aicallHandler) ResponseCacheStore(ctx context.Context, id uuid.UUID, userTurns []string, response string) error {
+	if h.responseCacheHandler == nil {
+		return nil
+	}
+
+	c, err := h.Get(ctx, id)
+	if err != nil {
+		return errors.Wrapf(err, "could not get aicall %s", id)
+	}
+
+	return h.responseCacheHandler.Store(ctx, c, userTurns, response)
+}
+
+// setResponseCacheMetadata freezes the AI's response cache config and scope
+// into the aicall's metadata. Only the single AI aicalls are cached.
+//
+// The cache stays off for the aicall if the scope can't be made, since the
+// AI's rag might have changed.
+func (h *aicallHandler) setResponseCacheMetadata(ctx context.Context, a *ai.AI, assistanceType aicall.AssistanceType, metadata map[string]any) {
+	if h.responseCacheHandler == nil || !a.ResponseCache.IsEnabled() || assistanceType != aicall.AssistanceTypeAI {
+		return
+	}
+
+	scope, err := h.responseCacheHandler.Scope(ctx, a)
+	if err != nil {
+		logrus.WithField("func", "setResponseCacheMetadata").Errorf("Could not get the response cache scope. ai_id: %s, err: %v", a.ID, err)
+		return
+	}
+
+	metadata[aicall.MetaKeyResponseCache] = a.ResponseCache
+	metadata[aicall.MetaKeyResponseCacheScope] = scope
+}
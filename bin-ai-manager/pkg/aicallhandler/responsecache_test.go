@@ -0,0 +1,163 @@
+package aicallhandler
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+
+	"monorepo/bin-ai-manager/models/ai"
+	"monorepo/bin-ai-manager/models/aicall"
+	"monorepo/bin-ai-manager/models/responsecache"
+	"monorepo/bin-ai-manager/pkg/dbhandler"
+	"monorepo/bin-ai-manager/pkg/responsecachehandler"
+)
+
+func Test_ResponseCacheLookup(t *testing.T) {
+	tests := []struct {
+		name string
+
+		id        uuid.UUID
+		userTurns []string
+
+		responseAIcall *aicall.AIcall
+		responseLookup string
+
+		expectRes string
+	}{
+		{
+			name: "normal",
+
+			id:        uuid.FromStringOrNil("5a1c3e70-ada0-11f0-9d2b-3f6e8a1c4b01"),
+			userTurns: []string{"what are your opening hours"},
+
+			responseAIcall: &aicall.AIcall{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("5a1c3e70-ada0-11f0-9d2b-3f6e8a1c4b01"),
+				},
+			},
+			responseLookup: "We are open from 9am to 6pm.",
+
+			expectRes: "We are open from 9am to 6pm.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockResponseCache := responsecachehandler.NewMockResponseCacheHandler(mc)
+			h := &aicallHandler{
+				db:                   mockDB,
+				responseCacheHandler: mockResponseCache,
+			}
+			ctx := context.Background()
+
+			mockDB.EXPECT().AIcallGet(ctx, tt.id).Return(tt.responseAIcall, nil)
+			mockResponseCache.EXPECT().Lookup(ctx, tt.responseAIcall, tt.userTurns).Return(tt.responseLookup, nil)
+
+			res, err := h.ResponseCacheLookup(ctx, tt.id, tt.userTurns)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if res != tt.expectRes {
+				t.Errorf("Wrong match.\nexpect: %s\ngot: %s", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_setResponseCacheMetadata(t *testing.T) {
+	tests := []struct {
+		name string
+
+		ai             *ai.AI
+		assistanceType aicall.AssistanceType
+
+		expectScope   bool
+		responseScope string
+		responseErr   error
+
+		expectRes map[string]any
+	}{
+		{
+			name: "response cache enabled",
+
+			ai: &ai.AI{
+				ResponseCache: &responsecache.Config{Enabled: true},
+			},
+			assistanceType: aicall.AssistanceTypeAI,
+
+			expectScope:   true,
+			responseScope: "3f9a1c2e7b4d8a60",
+
+			expectRes: map[string]any{
+				aicall.MetaKeyResponseCache:      &responsecache.Config{Enabled: true},
+				aicall.MetaKeyResponseCacheScope: "3f9a1c2e7b4d8a60",
+			},
+		},
+		{
+			name: "team",
+
+			ai: &ai.AI{
+				ResponseCache: &responsecache.Config{Enabled: true},
+			},
+			assistanceType: aicall.AssistanceTypeTeam,
+
+			expectRes: map[string]any{},
+		},
+		{
+			name: "scope error",
+
+			ai: &ai.AI{
+				ResponseCache: &responsecache.Config{Enabled: true},
+			},
+			assistanceType: aicall.AssistanceTypeAI,
+
+			expectScope: true,
+			responseErr: fmt.Errorf("rag not found"),
+
+			expectRes: map[string]any{},
+		},
+		{
+			name: "response cache disabled",
+
+			ai:             &ai.AI{},
+			assistanceType: aicall.AssistanceTypeAI,
+
+			expectRes: map[string]any{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockResponseCache := responsecachehandler.NewMockResponseCacheHandler(mc)
+			h := &aicallHandler{
+				responseCacheHandler: mockResponseCache,
+			}
+			ctx := context.Background()
+
+			if tt.expectScope {
+				mockResponseCache.EXPECT().Scope(ctx, tt.ai).Return(tt.responseScope, tt.responseErr)
+			}
+
+			res := map[string]any{}
+			h.setResponseCacheMetadata(ctx, tt.ai, tt.assistanceType, res)
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
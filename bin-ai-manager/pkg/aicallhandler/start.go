@@ -193,6 +193,9 @@ func (h *aicallHandler) Start(
 	case aicall.ReferenceTypeNone:
 		return h.startReferenceTypeNone(ctx, c, assistanceType, assistanceID, activeflowID, teamParameter, currentMemberID)
 
+	case aicall.ReferenceTypeTestRun:
+		return h.startReferenceTypeTestRun(ctx, c, assistanceType, assistanceID, referenceID, teamParameter, currentMemberID)
+
 	default:
 		return nil, fmt.Errorf("unsupported reference type")
 	}
@@ -617,6 +620,38 @@ func (h *aicallHandler) startReferenceTypeNone(
 	return res, nil
 }
 
+// startReferenceTypeTestRun starts a new text-only aicall for the given test run.
+// The conversation is driven by Send, the same as the aicall with no reference.
+func (h *aicallHandler) startReferenceTypeTestRun(
+	ctx context.Context,
+	c *ai.AI,
+	assistanceType aicall.AssistanceType,
+	assistanceID uuid.UUID,
+	referenceID uuid.UUID,
+	teamParameter map[string]any,
+	currentMemberID uuid.UUID,
+) (*aicall.AIcall, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":         "startReferenceTypeTestRun",
+		"ai":           c,
+		"reference_id": referenceID,
+	})
+
+	// start ai call
+	tmp, err := h.startAIcallByMessaging(ctx, c, assistanceType, assistanceID, uuid.Nil, aicall.ReferenceTypeTestRun, referenceID, false, teamParameter, currentMemberID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not create aicall for the test run. test_run_id: %s", referenceID)
+	}
+	log.WithField("aicall", tmp).Debugf("Created aicall. aicall_id: %s", tmp.ID)
+
+	res, err := h.UpdateStatus(ctx, tmp.ID, aicall.StatusProgressing)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not update the status to start. aicall_id: %s", tmp.ID)
+	}
+
+	return res, nil
+}
+
 func (h *aicallHandler) getPipecatcallMessages(ctx context.Context, c *aicall.AIcall) ([]map[string]any, error) {
 
 	// retrieve previous messages
@@ -803,6 +838,11 @@ func (h *aicallHandler) startInitMessages(ctx context.Context, a *ai.AI, c *aica
 	}
 	log.Debugf("Parsed init prompt. aicall_id: %s", c.ID)
 
+	// tell the guardrail policy
+	if msg := guardrailPrompt(c.GuardrailConfig()); msg != "" {
+		messages = append(messages, msg)
+	}
+
 	// parse parameter (merged ai + team parameter)
 	if msg := h.getDataAsJSON(ctx, c.Parameter, c.ActiveflowID); msg != "{}" {
 		messages = append(messages, msg)
@@ -865,6 +905,9 @@ func (h *aicallHandler) startAIcallByRealtime(
 		aicall.MetaKeyPromptSnapshots:  snapshots,
 		aicall.MetaKeyAutoAuditEnabled: autoAudit,
 	}
+	h.setRedactionMetadata(ctx, a, metadata)
+	h.setGuardrailMetadata(a, metadata)
+	h.setResponseCacheMetadata(ctx, a, assistanceType, metadata)
 	res, err := h.Create(ctx, a, assistanceType, assistanceID, activeflowID, referenceType, referenceID,
 		confbridgeID, pipecatcallID, currentMemberID, parameter, metadata)
 	if err != nil {
@@ -921,6 +964,9 @@ func (h *aicallHandler) startAIcallByMessaging(
 		aicall.MetaKeyPromptSnapshots:  snapshots,
 		aicall.MetaKeyAutoAuditEnabled: autoAudit,
 	}
+	h.setRedactionMetadata(ctx, a, metadata)
+	h.setGuardrailMetadata(a, metadata)
+	h.setResponseCacheMetadata(ctx, a, assistanceType, metadata)
 	res, err := h.CreateByMessaging(ctx, a, assistanceType, assistanceID, activeflowID, referenceType, referenceID,
 		pipecatcallID, currentMemberID, parameter, metadata)
 	if err != nil {
@@ -456,6 +456,109 @@ func Test_startReferenceTypeNone(t *testing.T) {
 	}
 }
 
+func Test_startReferenceTypeTestRun(t *testing.T) {
+	tests := []struct {
+		name string
+
+		ai             *ai.AI
+		assistanceType aicall.AssistanceType
+		assistanceID   uuid.UUID
+		referenceID    uuid.UUID
+
+		responseUUIDPipecatcallID uuid.UUID
+		responseUUIDAIcallID      uuid.UUID
+		responseAIcall            *aicall.AIcall
+
+		expectAIcall *aicall.AIcall
+		expectRes    *aicall.AIcall
+	}{
+		{
+			name: "normal",
+
+			ai: &ai.AI{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("1d758ff0-f06f-11ef-bcb1-1ff1f3691915"),
+					CustomerID: uuid.FromStringOrNil("1dbecf3a-f06f-11ef-bb0a-bfec64e31a47"),
+				},
+				InitPrompt:  "hello, this is init prompt message.",
+				STTLanguage: "en-US",
+			},
+			assistanceType: aicall.AssistanceTypeAI,
+			assistanceID:   uuid.FromStringOrNil("1d758ff0-f06f-11ef-bcb1-1ff1f3691915"),
+			referenceID:    uuid.FromStringOrNil("5e2a8f44-ad5a-11f0-b5d1-3f7c9e2a6b01"),
+
+			responseUUIDPipecatcallID: uuid.FromStringOrNil("78a31220-b465-11f0-a3f2-b77bb59ccdcd"),
+			responseUUIDAIcallID:      uuid.FromStringOrNil("1e1a95ea-f06f-11ef-b98e-cf0423a1e383"),
+			responseAIcall: &aicall.AIcall{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("1e1a95ea-f06f-11ef-b98e-cf0423a1e383"),
+				},
+				ReferenceType: aicall.ReferenceTypeTestRun,
+				ReferenceID:   uuid.FromStringOrNil("5e2a8f44-ad5a-11f0-b5d1-3f7c9e2a6b01"),
+			},
+			expectRes: &aicall.AIcall{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("1e1a95ea-f06f-11ef-b98e-cf0423a1e383"),
+				},
+				ReferenceType: aicall.ReferenceTypeTestRun,
+				ReferenceID:   uuid.FromStringOrNil("5e2a8f44-ad5a-11f0-b5d1-3f7c9e2a6b01"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockAI := aihandler.NewMockAIHandler(mc)
+			mockMessage := messagehandler.NewMockMessageHandler(mc)
+
+			h := &aicallHandler{
+				utilHandler:    mockUtil,
+				reqHandler:     mockReq,
+				notifyHandler:  mockNotify,
+				db:             mockDB,
+				aiHandler:      mockAI,
+				messageHandler: mockMessage,
+			}
+			ctx := context.Background()
+
+			// startAIcall
+			mockUtil.EXPECT().UUIDCreate().Return(tt.responseUUIDPipecatcallID)
+			mockUtil.EXPECT().UUIDCreate().Return(tt.responseUUIDAIcallID)
+			mockDB.EXPECT().AIcallCreate(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, c *aicall.AIcall) error {
+				if c.ReferenceType != aicall.ReferenceTypeTestRun || c.ReferenceID != tt.referenceID {
+					t.Errorf("Wrong match. expect: test_run %s, got: %s %s", tt.referenceID, c.ReferenceType, c.ReferenceID)
+				}
+				return nil
+			})
+			mockDB.EXPECT().AIcallGet(ctx, gomock.Any()).Return(tt.responseAIcall, nil)
+			mockNotify.EXPECT().PublishWebhookEvent(ctx, gomock.Any(), gomock.Any(), gomock.Any())
+			mockMessage.EXPECT().Create(ctx, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(&message.Message{}, nil)
+
+			mockDB.EXPECT().AIcallUpdate(ctx, tt.responseAIcall.ID, gomock.Any()).Return(nil)
+			mockDB.EXPECT().AIcallGet(ctx, tt.responseAIcall.ID).Return(tt.responseAIcall, nil)
+			mockNotify.EXPECT().PublishWebhookEvent(ctx, tt.responseAIcall.CustomerID, aicall.EventTypeStatusProgressing, tt.responseAIcall)
+
+			res, err := h.startReferenceTypeTestRun(ctx, tt.ai, tt.assistanceType, tt.assistanceID, tt.referenceID, nil, uuid.Nil)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			time.Sleep(100 * time.Millisecond)
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("expected: %v, got: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
 func Test_startReferenceTypeConversation(t *testing.T) {
 	// ensure idle threshold is set deterministically for cases that depend on it
 	config.SetAIcallConversationIdleTimeoutHoursForTest(24)
@@ -10,6 +10,7 @@ import (
 	"monorepo/bin-ai-manager/models/aicall"
 	"monorepo/bin-ai-manager/models/message"
 	"monorepo/bin-ai-manager/pkg/messagehandler"
+	cacampaigncall "monorepo/bin-campaign-manager/models/campaigncall"
 	commonaddress "monorepo/bin-common-handler/models/address"
 	fmaction "monorepo/bin-flow-manager/models/action"
 	fmflow "monorepo/bin-flow-manager/models/flow"
@@ -51,6 +52,16 @@ func (h *aicallHandler) ToolHandle(ctx context.Context, id uuid.UUID, toolID str
 	}
 	log.WithField("message", tmp).Debugf("Created the tool message for the actions. message_id: %s", tmp.ID)
 
+	// the stored message keeps the redaction tokens. the tool gets the real
+	// values if the aicall's redaction config allows.
+	if h.redactionHandler != nil {
+		arguments, errRestore := h.redactionHandler.Restore(ctx, c.ID, tool.Function.Arguments)
+		if errRestore != nil {
+			return nil, errors.Wrapf(errRestore, "could not restore the tool call arguments")
+		}
+		tool.Function.Arguments = arguments
+	}
+
 	mapFunctions := map[message.FunctionCallName]func(context.Context, *aicall.AIcall, *message.ToolCall) *messageContent{
 		message.FunctionCallNameConnectCall:            h.toolHandleConnect,
 		message.FunctionCallNameCreateCall:             h.toolHandleCreateCall,
@@ -67,6 +78,7 @@ func (h *aicallHandler) ToolHandle(ctx context.Context, id uuid.UUID, toolID str
 		message.FunctionCallNameGetResource:            h.toolHandleGetResource,
 		message.FunctionCallNameDescribeAction:         h.toolHandleDescribeAction,
 		message.FunctionCallNameCaseCreate:             h.toolHandleCaseCreate,
+		message.FunctionCallNameSetDisposition:         h.toolHandleSetDisposition,
 		message.FunctionCallNameGetContactInteractions: h.toolHandleGetContactInteractions,
 		message.FunctionCallNameGetConversationContent: h.toolHandleGetConversationContent,
 		message.FunctionCallNameGetRelatedCases:        h.toolHandleGetRelatedCases,
@@ -76,8 +88,17 @@ func (h *aicallHandler) ToolHandle(ctx context.Context, id uuid.UUID, toolID str
 	promAIcallToolExecuteTotal.WithLabelValues(string(tool.Function.Name)).Inc()
 
 	var tmpMessageContent *messageContent
-	if fn, exists := mapFunctions[tool.Function.Name]; exists {
+	if c.ReferenceType == aicall.ReferenceTypeTestRun {
+		// the test run checks the tool calls only. never cause any side effect.
+		tmpMessageContent = h.toolHandleTestRun(tool)
+	} else if refused := h.guardrailToolCallCheck(ctx, c, tool); refused != nil {
+		tmpMessageContent = refused
+	} else if fn, exists := mapFunctions[tool.Function.Name]; exists {
 		tmpMessageContent = fn(ctx, c, tool)
+	} else if ct := h.toolGetCustomTool(ctx, c, toolCallActiveAIID, tool.Function.Name); ct != nil {
+		tmpMessageContent = h.toolHandleCustomTool(ctx, c, tool, ct)
+	} else if s, toolName := h.toolGetMCPServer(ctx, c, toolCallActiveAIID, tool.Function.Name); s != nil {
+		tmpMessageContent = h.toolHandleMCPServer(ctx, c, tool, s, toolName)
 	} else {
 		log.Debugf("unknown tool call: %s", tool.Function.Name)
 		return nil, fmt.Errorf("unknown tool call: %s", tool.Function.Name)
@@ -126,6 +147,13 @@ func (h *aicallHandler) toolCreateResultMessage(
 	return tmp, nil
 }
 
+// toolHandleTestRun returns the stub result of the tool call made in a test run.
+func (h *aicallHandler) toolHandleTestRun(tool *message.ToolCall) *messageContent {
+	res := newToolResult(tool.ID)
+	fillSuccess(res, "", "", "test run: the tool was not executed.")
+	return res
+}
+
 func newToolResult(toolID string) *messageContent {
 	return &messageContent{ToolCallID: toolID}
 }
@@ -596,6 +624,50 @@ func (h *aicallHandler) toolHandleCaseCreate(ctx context.Context, c *aicall.AIca
 	return res
 }
 
+// toolHandleSetDisposition handles tool call set_disposition: sets the
+// disposition of the campaigncall which runs the AIcall's activeflow.
+// An AIcall outside of the campaign is reported via fillFailed, so the LLM
+// does not assume the outcome was recorded.
+func (h *aicallHandler) toolHandleSetDisposition(ctx context.Context, c *aicall.AIcall, tool *message.ToolCall) *messageContent {
+	log := logrus.WithFields(logrus.Fields{
+		"func":      "toolHandleSetDisposition",
+		"aicall_id": c.ID,
+	})
+	log.Debugf("handling tool set_disposition.")
+
+	res := newToolResult(tool.ID)
+
+	var tmpOpt fmaction.OptionDispositionSet
+	if errUnmarshal := json.Unmarshal([]byte(tool.Function.Arguments), &tmpOpt); errUnmarshal != nil {
+		fillFailed(res, errUnmarshal)
+		return res
+	}
+
+	filters := map[cacampaigncall.Field]any{
+		cacampaigncall.FieldActiveflowID: c.ActiveflowID,
+		cacampaigncall.FieldDeleted:      false,
+	}
+	ccs, err := h.reqHandler.CampaignV1CampaigncallList(ctx, "", 1, filters)
+	if err != nil {
+		fillFailed(res, err)
+		return res
+	}
+	if len(ccs) == 0 {
+		fillFailed(res, fmt.Errorf("this call is not a campaign call"))
+		return res
+	}
+
+	cc, err := h.reqHandler.CampaignV1CampaigncallUpdateDisposition(ctx, ccs[0].ID, tmpOpt.Disposition)
+	if err != nil {
+		fillFailed(res, err)
+		return res
+	}
+
+	fillSuccess(res, "campaigncall", cc.ID.String(), "Disposition set successfully.")
+
+	return res
+}
+
 func (h *aicallHandler) toolHandleServiceStop(ctx context.Context, c *aicall.AIcall, tool *message.ToolCall) *messageContent {
 	log := logrus.WithFields(logrus.Fields{
 		"func":      "toolHandleServiceStop",
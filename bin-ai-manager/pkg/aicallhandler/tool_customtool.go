@@ -0,0 +1,72 @@
+package aicallhandler
+
+import (
+	"context"
+	"slices"
+
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+
+	"monorepo/bin-ai-manager/models/ai"
+	"monorepo/bin-ai-manager/models/aicall"
+	"monorepo/bin-ai-manager/models/customtool"
+	"monorepo/bin-ai-manager/models/message"
+	"monorepo/bin-ai-manager/models/tool"
+)
+
+// toolGetCustomTool returns the customer's custom tool the LLM called, or
+// nil when name is not a custom tool enabled on the active AI. The AI's
+// tool_names is re-checked here so the model can not reach a custom tool the
+// AI was never given, even if it guesses the name.
+func (h *aicallHandler) toolGetCustomTool(ctx context.Context, c *aicall.AIcall, activeAIID uuid.UUID, name message.FunctionCallName) *customtool.CustomTool {
+	log := logrus.WithFields(logrus.Fields{
+		"func":      "toolGetCustomTool",
+		"aicall_id": c.ID,
+		"name":      name,
+	})
+
+	if h.customToolHandler == nil || h.aiHandler == nil || activeAIID == uuid.Nil {
+		return nil
+	}
+
+	a, err := h.aiHandler.Get(ctx, activeAIID)
+	if err != nil {
+		log.Errorf("Could not get the active ai. ai_id: %s, err: %v", activeAIID, err)
+		return nil
+	}
+
+	if a.Type != ai.TypeNormal || !slices.Contains(a.ToolNames, tool.ToolName(name)) {
+		return nil
+	}
+
+	res, err := h.customToolHandler.GetByName(ctx, c.CustomerID, tool.ToolName(name))
+	if err != nil {
+		log.Debugf("Could not get the custom tool. err: %v", err)
+		return nil
+	}
+
+	return res
+}
+
+// toolHandleCustomTool executes the customer's custom tool and returns its
+// rendered response as the tool result.
+func (h *aicallHandler) toolHandleCustomTool(ctx context.Context, c *aicall.AIcall, tc *message.ToolCall, ct *customtool.CustomTool) *messageContent {
+	log := logrus.WithFields(logrus.Fields{
+		"func":           "toolHandleCustomTool",
+		"aicall_id":      c.ID,
+		"custom_tool_id": ct.ID,
+	})
+
+	res := newToolResult(tc.ID)
+
+	text, err := h.customToolHandler.Execute(ctx, ct, c, tc.ID, tc.Function.Arguments)
+	if err != nil {
+		log.Infof("Could not execute the custom tool. err: %v", err)
+		fillFailed(res, err)
+		return res
+	}
+	log.Debugf("Executed the custom tool. name: %s", ct.Name)
+
+	fillSuccess(res, "custom_tool", ct.ID.String(), text)
+	return res
+}
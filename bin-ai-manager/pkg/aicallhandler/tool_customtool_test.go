@@ -0,0 +1,182 @@
+package aicallhandler
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+
+	"monorepo/bin-ai-manager/models/ai"
+	"monorepo/bin-ai-manager/models/aicall"
+	"monorepo/bin-ai-manager/models/customtool"
+	"monorepo/bin-ai-manager/models/message"
+	"monorepo/bin-ai-manager/models/tool"
+	"monorepo/bin-ai-manager/pkg/aihandler"
+	"monorepo/bin-ai-manager/pkg/customtoolhandler"
+)
+
+func Test_toolGetCustomTool(t *testing.T) {
+	customerID := uuid.FromStringOrNil("9c1e3a5c-8d73-11f1-b3e5-3a5c7e9a0b11")
+	aiID := uuid.FromStringOrNil("9c4d5b7d-8d73-11f1-8f26-5c7e9a0b2c22")
+
+	customTool := &customtool.CustomTool{
+		Identity: commonidentity.Identity{
+			ID:         uuid.FromStringOrNil("9c7b8c9e-8d73-11f1-a047-7e9a0b2c4d33"),
+			CustomerID: customerID,
+		},
+		Name: "lookup_order",
+	}
+
+	tests := []struct {
+		name string
+
+		responseAI *ai.AI
+
+		expectLookup bool
+		expectRes    *customtool.CustomTool
+	}{
+		{
+			name: "enabled on the ai",
+
+			responseAI: &ai.AI{
+				Type:      ai.TypeNormal,
+				ToolNames: []tool.ToolName{tool.ToolNameConnectCall, "lookup_order"},
+			},
+
+			expectLookup: true,
+			expectRes:    customTool,
+		},
+		{
+			name: "not enabled on the ai",
+
+			responseAI: &ai.AI{
+				Type:      ai.TypeNormal,
+				ToolNames: []tool.ToolName{tool.ToolNameAll},
+			},
+		},
+		{
+			name: "insight ai",
+
+			responseAI: &ai.AI{
+				Type:      ai.TypeInsight,
+				ToolNames: []tool.ToolName{"lookup_order"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockAI := aihandler.NewMockAIHandler(mc)
+			mockCustomTool := customtoolhandler.NewMockCustomToolHandler(mc)
+
+			h := &aicallHandler{
+				aiHandler:         mockAI,
+				customToolHandler: mockCustomTool,
+			}
+			ctx := context.Background()
+
+			c := &aicall.AIcall{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("9caa9daf-8d73-11f1-9158-9a0b2c4d6e44"),
+					CustomerID: customerID,
+				},
+			}
+
+			mockAI.EXPECT().Get(ctx, aiID).Return(tt.responseAI, nil)
+			if tt.expectLookup {
+				mockCustomTool.EXPECT().GetByName(ctx, customerID, tool.ToolName("lookup_order")).Return(customTool, nil)
+			}
+
+			res := h.toolGetCustomTool(ctx, c, aiID, "lookup_order")
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_toolHandleCustomTool(t *testing.T) {
+	customTool := &customtool.CustomTool{
+		Identity: commonidentity.Identity{
+			ID: uuid.FromStringOrNil("c3d5f7a1-8d73-11f1-a269-0b2c4d6e8f55"),
+		},
+		Name: "lookup_order",
+	}
+
+	tests := []struct {
+		name string
+
+		responseText string
+		responseErr  error
+
+		expectRes *messageContent
+	}{
+		{
+			name: "normal",
+
+			responseText: "Order A-100 is shipped.",
+
+			expectRes: &messageContent{
+				ToolCallID:   "call_1",
+				Result:       "success",
+				Message:      "Order A-100 is shipped.",
+				ResourceType: "custom_tool",
+				ResourceID:   "c3d5f7a1-8d73-11f1-a269-0b2c4d6e8f55",
+			},
+		},
+		{
+			name: "execution failed",
+
+			responseErr: fmt.Errorf("the endpoint returned status 500"),
+
+			expectRes: &messageContent{
+				ToolCallID: "call_1",
+				Result:     "failed",
+				Message:    "the endpoint returned status 500",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockCustomTool := customtoolhandler.NewMockCustomToolHandler(mc)
+
+			h := &aicallHandler{
+				customToolHandler: mockCustomTool,
+			}
+			ctx := context.Background()
+
+			c := &aicall.AIcall{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("c404e8b2-8d73-11f1-937a-2c4d6e8f0a66"),
+				},
+			}
+			tc := &message.ToolCall{
+				ID:   "call_1",
+				Type: message.ToolTypeFunction,
+				Function: message.FunctionCall{
+					Name:      "lookup_order",
+					Arguments: `{"order_number":"A-100"}`,
+				},
+			}
+
+			mockCustomTool.EXPECT().Execute(ctx, customTool, c, "call_1", `{"order_number":"A-100"}`).Return(tt.responseText, tt.responseErr)
+
+			res := h.toolHandleCustomTool(ctx, c, tc, customTool)
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package aicallhandler
+
+import (
+	"context"
+	"slices"
+
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+
+	"monorepo/bin-ai-manager/models/ai"
+	"monorepo/bin-ai-manager/models/aicall"
+	"monorepo/bin-ai-manager/models/mcpserver"
+	"monorepo/bin-ai-manager/models/message"
+	"monorepo/bin-ai-manager/models/tool"
+)
+
+// toolGetMCPServer returns the customer's MCP server that owns the
+// "<server>__<tool>" function the LLM called, together with the server-side
+// tool name. It returns nil when name is not in that form or the server is
+// not enabled on the active AI, for the same reason toolGetCustomTool
+// re-checks the AI's tool_names.
+func (h *aicallHandler) toolGetMCPServer(ctx context.Context, c *aicall.AIcall, activeAIID uuid.UUID, name message.FunctionCallName) (*mcpserver.MCPServer, string) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":      "toolGetMCPServer",
+		"aicall_id": c.ID,
+		"name":      name,
+	})
+
+	if h.mcpServerHandler == nil || h.aiHandler == nil || activeAIID == uuid.Nil {
+		return nil, ""
+	}
+
+	serverName, toolName, ok := mcpserver.ParseExposedToolName(string(name))
+	if !ok {
+		return nil, ""
+	}
+
+	a, err := h.aiHandler.Get(ctx, activeAIID)
+	if err != nil {
+		log.Errorf("Could not get the active ai. ai_id: %s, err: %v", activeAIID, err)
+		return nil, ""
+	}
+
+	if a.Type != ai.TypeNormal || !slices.Contains(a.ToolNames, tool.ToolName(serverName)) {
+		return nil, ""
+	}
+
+	res, err := h.mcpServerHandler.GetByName(ctx, c.CustomerID, serverName)
+	if err != nil {
+		log.Debugf("Could not get the mcp server. err: %v", err)
+		return nil, ""
+	}
+
+	return res, toolName
+}
+
+// toolHandleMCPServer proxies the tool call to the MCP server and returns
+// the server's response as the tool result.
+func (h *aicallHandler) toolHandleMCPServer(ctx context.Context, c *aicall.AIcall, tc *message.ToolCall, s *mcpserver.MCPServer, toolName string) *messageContent {
+	log := logrus.WithFields(logrus.Fields{
+		"func":          "toolHandleMCPServer",
+		"aicall_id":     c.ID,
+		"mcp_server_id": s.ID,
+	})
+
+	res := newToolResult(tc.ID)
+
+	text, err := h.mcpServerHandler.Execute(ctx, s, toolName, tc.Function.Arguments)
+	if err != nil {
+		log.Infof("Could not execute the mcp tool. tool: %s, err: %v", toolName, err)
+		fillFailed(res, err)
+		return res
+	}
+	log.Debugf("Executed the mcp tool. server: %s, tool: %s", s.Name, toolName)
+
+	fillSuccess(res, "mcp_server", s.ID.String(), text)
+	return res
+}
@@ -0,0 +1,193 @@
+package aicallhandler
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+
+	"monorepo/bin-ai-manager/models/ai"
+	"monorepo/bin-ai-manager/models/aicall"
+	"monorepo/bin-ai-manager/models/mcpserver"
+	"monorepo/bin-ai-manager/models/message"
+	"monorepo/bin-ai-manager/models/tool"
+	"monorepo/bin-ai-manager/pkg/aihandler"
+	"monorepo/bin-ai-manager/pkg/mcpserverhandler"
+)
+
+func Test_toolGetMCPServer(t *testing.T) {
+	customerID := uuid.FromStringOrNil("a1c3e5a7-8e31-11f1-b4f6-1a3c5e7a9c11")
+	aiID := uuid.FromStringOrNil("a1f2d6b8-8e31-11f1-8a07-3c5e7a9c1e22")
+
+	mcpServer := &mcpserver.MCPServer{
+		Identity: commonidentity.Identity{
+			ID:         uuid.FromStringOrNil("a221e7c9-8e31-11f1-9b18-5e7a9c1e3a33"),
+			CustomerID: customerID,
+		},
+		Name: "crm",
+	}
+
+	tests := []struct {
+		name string
+
+		functionName message.FunctionCallName
+		responseAI   *ai.AI
+
+		expectGetAI    bool
+		expectLookup   bool
+		expectRes      *mcpserver.MCPServer
+		expectToolName string
+	}{
+		{
+			name: "enabled on the ai",
+
+			functionName: "crm__lookup_order",
+			responseAI: &ai.AI{
+				Type:      ai.TypeNormal,
+				ToolNames: []tool.ToolName{tool.ToolNameConnectCall, "crm"},
+			},
+
+			expectGetAI:    true,
+			expectLookup:   true,
+			expectRes:      mcpServer,
+			expectToolName: "lookup_order",
+		},
+		{
+			name: "not enabled on the ai",
+
+			functionName: "crm__lookup_order",
+			responseAI: &ai.AI{
+				Type:      ai.TypeNormal,
+				ToolNames: []tool.ToolName{tool.ToolNameAll},
+			},
+
+			expectGetAI: true,
+		},
+		{
+			name: "not an mcp tool name",
+
+			functionName: "lookup_order",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockAI := aihandler.NewMockAIHandler(mc)
+			mockMCPServer := mcpserverhandler.NewMockMCPServerHandler(mc)
+
+			h := &aicallHandler{
+				aiHandler:        mockAI,
+				mcpServerHandler: mockMCPServer,
+			}
+			ctx := context.Background()
+
+			c := &aicall.AIcall{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("a250f8da-8e31-11f1-8c29-7a9c1e3a5c44"),
+					CustomerID: customerID,
+				},
+			}
+
+			if tt.expectGetAI {
+				mockAI.EXPECT().Get(ctx, aiID).Return(tt.responseAI, nil)
+			}
+			if tt.expectLookup {
+				mockMCPServer.EXPECT().GetByName(ctx, customerID, "crm").Return(mcpServer, nil)
+			}
+
+			res, toolName := h.toolGetMCPServer(ctx, c, aiID, tt.functionName)
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+			if toolName != tt.expectToolName {
+				t.Errorf("Wrong match. expect: %s, got: %s", tt.expectToolName, toolName)
+			}
+		})
+	}
+}
+
+func Test_toolHandleMCPServer(t *testing.T) {
+	mcpServer := &mcpserver.MCPServer{
+		Identity: commonidentity.Identity{
+			ID: uuid.FromStringOrNil("b3e5a7c9-8e31-11f1-a93a-9c1e3a5c7e55"),
+		},
+		Name: "crm",
+	}
+
+	tests := []struct {
+		name string
+
+		responseText string
+		responseErr  error
+
+		expectRes *messageContent
+	}{
+		{
+			name: "normal",
+
+			responseText: "Order A-100 is shipped.",
+
+			expectRes: &messageContent{
+				ToolCallID:   "call_1",
+				Result:       "success",
+				Message:      "Order A-100 is shipped.",
+				ResourceType: "mcp_server",
+				ResourceID:   "b3e5a7c9-8e31-11f1-a93a-9c1e3a5c7e55",
+			},
+		},
+		{
+			name: "execution failed",
+
+			responseErr: fmt.Errorf("the tool returned an error: backend down"),
+
+			expectRes: &messageContent{
+				ToolCallID: "call_1",
+				Result:     "failed",
+				Message:    "the tool returned an error: backend down",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockMCPServer := mcpserverhandler.NewMockMCPServerHandler(mc)
+
+			h := &aicallHandler{
+				mcpServerHandler: mockMCPServer,
+			}
+			ctx := context.Background()
+
+			c := &aicall.AIcall{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("b414b8da-8e31-11f1-8a4b-1e3a5c7e9a66"),
+				},
+			}
+			tc := &message.ToolCall{
+				ID:   "call_1",
+				Type: message.ToolTypeFunction,
+				Function: message.FunctionCall{
+					Name:      "crm__lookup_order",
+					Arguments: `{"order_number":"A-100"}`,
+				},
+			}
+
+			mockMCPServer.EXPECT().Execute(ctx, mcpServer, "lookup_order", `{"order_number":"A-100"}`).Return(tt.responseText, tt.responseErr)
+
+			res := h.toolHandleMCPServer(ctx, c, tc, mcpServer, "lookup_order")
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package aicallhandler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/requesthandler"
+
+	"monorepo/bin-ai-manager/models/aicall"
+	"monorepo/bin-ai-manager/models/message"
+	"monorepo/bin-ai-manager/pkg/dbhandler"
+	"monorepo/bin-ai-manager/pkg/messagehandler"
+	"monorepo/bin-ai-manager/pkg/redactionhandler"
+)
+
+func Test_ToolHandle_redactionRestore(t *testing.T) {
+	tests := []struct {
+		name string
+
+		id       uuid.UUID
+		toolID   string
+		function message.FunctionCall
+
+		responseAIcall    *aicall.AIcall
+		responseArguments string
+		responseMessage   *message.Message
+
+		expectToolCalls []message.ToolCall
+		expectVariables map[string]string
+		expectContent   string
+	}{
+		{
+			name: "tool gets the restored values",
+
+			id:     uuid.FromStringOrNil("6e1d2a3c-a9fd-11f0-9f2a-3b7c1e5d8a10"),
+			toolID: "call_1",
+			function: message.FunctionCall{
+				Name:      message.FunctionCallNameSetVariables,
+				Arguments: `{"variables":{"card_number":"[CREDIT_CARD_PSLZPV]"}}`,
+			},
+
+			responseAIcall: &aicall.AIcall{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("6e1d2a3c-a9fd-11f0-9f2a-3b7c1e5d8a10"),
+					CustomerID: uuid.FromStringOrNil("6e4b8c2e-a9fd-11f0-8c1d-5f2a9e3b7c21"),
+				},
+				AssistanceType: aicall.AssistanceTypeAI,
+				AssistanceID:   uuid.FromStringOrNil("6e79f1a4-a9fd-11f0-b6e3-1d8c4a2f9e32"),
+				ActiveflowID:   uuid.FromStringOrNil("6ea6d2c8-a9fd-11f0-a4b7-7e3f1c9d2b43"),
+			},
+			responseArguments: `{"variables":{"card_number":"4111 1111 1111 1111"}}`,
+			responseMessage: &message.Message{
+				Content: `{"tool_call_id":"call_1","result":"success","message":"Variables set successfully.","resource_type":"activeflow","resource_id":"6ea6d2c8-a9fd-11f0-a4b7-7e3f1c9d2b43"}`,
+			},
+
+			expectToolCalls: []message.ToolCall{
+				{
+					ID:   "call_1",
+					Type: message.ToolTypeFunction,
+					Function: message.FunctionCall{
+						Name:      message.FunctionCallNameSetVariables,
+						Arguments: `{"variables":{"card_number":"[CREDIT_CARD_PSLZPV]"}}`,
+					},
+				},
+			},
+			expectVariables: map[string]string{
+				"card_number": "4111 1111 1111 1111",
+			},
+			expectContent: `{"tool_call_id":"call_1","result":"success","message":"Variables set successfully.","resource_type":"activeflow","resource_id":"6ea6d2c8-a9fd-11f0-a4b7-7e3f1c9d2b43"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockMessage := messagehandler.NewMockMessageHandler(mc)
+			mockRedaction := redactionhandler.NewMockRedactionHandler(mc)
+
+			h := &aicallHandler{
+				db:               mockDB,
+				reqHandler:       mockReq,
+				messageHandler:   mockMessage,
+				redactionHandler: mockRedaction,
+			}
+			ctx := context.Background()
+
+			mockDB.EXPECT().AIcallGet(ctx, tt.id).Return(tt.responseAIcall, nil)
+
+			// the stored tool call keeps the tokens
+			mockMessage.EXPECT().Create(ctx, uuid.Nil, tt.responseAIcall.CustomerID, tt.responseAIcall.ID, tt.responseAIcall.ActiveflowID, message.DirectionIncoming, message.RoleAssistant, "", tt.expectToolCalls, "", gomock.Any()).Return(&message.Message{}, nil)
+			mockRedaction.EXPECT().Restore(ctx, tt.responseAIcall.ID, tt.function.Arguments).Return(tt.responseArguments, nil)
+			mockReq.EXPECT().FlowV1VariableSetVariable(ctx, tt.responseAIcall.ActiveflowID, tt.expectVariables).Return(nil)
+			mockMessage.EXPECT().Create(ctx, uuid.Nil, tt.responseAIcall.CustomerID, tt.responseAIcall.ID, tt.responseAIcall.ActiveflowID, message.DirectionOutgoing, message.RoleTool, tt.expectContent, nil, tt.toolID, gomock.Any()).Return(tt.responseMessage, nil)
+
+			res, err := h.ToolHandle(ctx, tt.id, tt.toolID, message.ToolTypeFunction, tt.function)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if res["result"] != "success" {
+				t.Errorf("Wrong match. expect: success, got: %v", res["result"])
+			}
+		})
+	}
+}
@@ -0,0 +1,175 @@
+package aicallhandler
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	cacampaigncall "monorepo/bin-campaign-manager/models/campaigncall"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/requesthandler"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+
+	"monorepo/bin-ai-manager/models/aicall"
+	"monorepo/bin-ai-manager/models/message"
+)
+
+func Test_toolHandleSetDisposition(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		aicall *aicall.AIcall
+		tool   *message.ToolCall
+
+		responseCampaigncalls []cacampaigncall.Campaigncall
+		responseCampaigncall  *cacampaigncall.Campaigncall
+		responseUpdateErr     error
+
+		expectFilters     map[cacampaigncall.Field]any
+		expectDisposition string
+		expectRes         *messageContent
+	}{
+		{
+			name: "normal",
+
+			aicall: &aicall.AIcall{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("9a1b2c3d-ad7d-11f0-8a01-0a1b2c3d4e01"),
+				},
+				ActiveflowID: uuid.FromStringOrNil("9a4a3d4e-ad7d-11f0-9b12-1b2c3d4e5f02"),
+			},
+			tool: &message.ToolCall{
+				ID:   "9a794e5f-ad7d-11f0-ac23-2c3d4e5f6a03",
+				Type: message.ToolTypeFunction,
+				Function: message.FunctionCall{
+					Name:      message.FunctionCallNameSetDisposition,
+					Arguments: `{"disposition": "sale"}`,
+				},
+			},
+
+			responseCampaigncalls: []cacampaigncall.Campaigncall{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("9aa85f70-ad7d-11f0-bd34-3d4e5f6a7b04"),
+					},
+				},
+			},
+			responseCampaigncall: &cacampaigncall.Campaigncall{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("9aa85f70-ad7d-11f0-bd34-3d4e5f6a7b04"),
+				},
+				Disposition: "sale",
+			},
+
+			expectFilters: map[cacampaigncall.Field]any{
+				cacampaigncall.FieldActiveflowID: uuid.FromStringOrNil("9a4a3d4e-ad7d-11f0-9b12-1b2c3d4e5f02"),
+				cacampaigncall.FieldDeleted:      false,
+			},
+			expectDisposition: "sale",
+			expectRes: &messageContent{
+				ToolCallID:   "9a794e5f-ad7d-11f0-ac23-2c3d4e5f6a03",
+				Result:       "success",
+				Message:      "Disposition set successfully.",
+				ResourceType: "campaigncall",
+				ResourceID:   "9aa85f70-ad7d-11f0-bd34-3d4e5f6a7b04",
+			},
+		},
+		{
+			name: "not a campaign call",
+
+			aicall: &aicall.AIcall{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("9ad77082-ad7d-11f0-8e45-4e5f6a7b8c05"),
+				},
+				ActiveflowID: uuid.FromStringOrNil("9b068194-ad7d-11f0-9f56-5f6a7b8c9d06"),
+			},
+			tool: &message.ToolCall{
+				ID:   "9b3592a6-ad7d-11f0-a067-6a7b8c9d0e07",
+				Type: message.ToolTypeFunction,
+				Function: message.FunctionCall{
+					Name:      message.FunctionCallNameSetDisposition,
+					Arguments: `{"disposition": "sale"}`,
+				},
+			},
+
+			responseCampaigncalls: []cacampaigncall.Campaigncall{},
+
+			expectFilters: map[cacampaigncall.Field]any{
+				cacampaigncall.FieldActiveflowID: uuid.FromStringOrNil("9b068194-ad7d-11f0-9f56-5f6a7b8c9d06"),
+				cacampaigncall.FieldDeleted:      false,
+			},
+			expectRes: &messageContent{
+				ToolCallID: "9b3592a6-ad7d-11f0-a067-6a7b8c9d0e07",
+				Result:     "failed",
+				Message:    "this call is not a campaign call",
+			},
+		},
+		{
+			name: "unknown disposition",
+
+			aicall: &aicall.AIcall{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("9b64a3b8-ad7d-11f0-b178-7b8c9d0e1f08"),
+				},
+				ActiveflowID: uuid.FromStringOrNil("9b93b4ca-ad7d-11f0-8289-8c9d0e1f2a09"),
+			},
+			tool: &message.ToolCall{
+				ID:   "9bc2c5dc-ad7d-11f0-939a-9d0e1f2a3b10",
+				Type: message.ToolTypeFunction,
+				Function: message.FunctionCall{
+					Name:      message.FunctionCallNameSetDisposition,
+					Arguments: `{"disposition": "unknown"}`,
+				},
+			},
+
+			responseCampaigncalls: []cacampaigncall.Campaigncall{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("9bf1d6ee-ad7d-11f0-a4ab-0e1f2a3b4c11"),
+					},
+				},
+			},
+			responseUpdateErr: fmt.Errorf("disposition not found"),
+
+			expectFilters: map[cacampaigncall.Field]any{
+				cacampaigncall.FieldActiveflowID: uuid.FromStringOrNil("9b93b4ca-ad7d-11f0-8289-8c9d0e1f2a09"),
+				cacampaigncall.FieldDeleted:      false,
+			},
+			expectDisposition: "unknown",
+			expectRes: &messageContent{
+				ToolCallID: "9bc2c5dc-ad7d-11f0-939a-9d0e1f2a3b10",
+				Result:     "failed",
+				Message:    "disposition not found",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+
+			h := &aicallHandler{
+				reqHandler: mockReq,
+			}
+			ctx := context.Background()
+
+			mockReq.EXPECT().CampaignV1CampaigncallList(ctx, "", uint64(1), tt.expectFilters).Return(tt.responseCampaigncalls, nil)
+			if len(tt.responseCampaigncalls) > 0 {
+				mockReq.EXPECT().CampaignV1CampaigncallUpdateDisposition(ctx, tt.responseCampaigncalls[0].ID, tt.expectDisposition).Return(tt.responseCampaigncall, tt.responseUpdateErr)
+			}
+
+			res := h.toolHandleSetDisposition(ctx, tt.aicall, tt.tool)
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package aicallhandler
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+
+	"monorepo/bin-ai-manager/models/aicall"
+	"monorepo/bin-ai-manager/models/message"
+	"monorepo/bin-ai-manager/pkg/dbhandler"
+	"monorepo/bin-ai-manager/pkg/messagehandler"
+)
+
+func Test_ToolHandle_testRun(t *testing.T) {
+	tests := []struct {
+		name string
+
+		id       uuid.UUID
+		toolID   string
+		toolType message.ToolType
+		function message.FunctionCall
+
+		responseAIcall  *aicall.AIcall
+		responseMessage *message.Message
+
+		expectContent string
+		expectRes     map[string]any
+	}{
+		{
+			name: "connect call is not executed",
+
+			id:       uuid.FromStringOrNil("2b6f0c2e-ad5a-11f0-9a4d-4f0d7c1a8e01"),
+			toolID:   "call_1",
+			toolType: message.ToolTypeFunction,
+			function: message.FunctionCall{
+				Name:      message.FunctionCallNameConnectCall,
+				Arguments: `{"destinations":[{"type":"tel","target":"+821100000001"}]}`,
+			},
+
+			responseAIcall: &aicall.AIcall{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("2b6f0c2e-ad5a-11f0-9a4d-4f0d7c1a8e01"),
+					CustomerID: uuid.FromStringOrNil("2b9a1e56-ad5a-11f0-8f1b-93a2d6c0b702"),
+				},
+				AssistanceType: aicall.AssistanceTypeAI,
+				AssistanceID:   uuid.FromStringOrNil("2bc3f0a4-ad5a-11f0-b0e6-2f6c1d9e4a03"),
+				ReferenceType:  aicall.ReferenceTypeTestRun,
+				ReferenceID:    uuid.FromStringOrNil("2bec5f12-ad5a-11f0-a7c8-0b5e3f2d1c04"),
+			},
+			responseMessage: &message.Message{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("2c16d1b8-ad5a-11f0-9c5f-6d8a4b2e3f05"),
+				},
+				Content: `{"tool_call_id":"call_1","result":"success","message":"test run: the tool was not executed.","resource_type":"","resource_id":""}`,
+			},
+
+			expectContent: `{"tool_call_id":"call_1","result":"success","message":"test run: the tool was not executed.","resource_type":"","resource_id":""}`,
+			expectRes: map[string]any{
+				"tool_call_id":  "call_1",
+				"result":        "success",
+				"message":       "test run: the tool was not executed.",
+				"resource_type": "",
+				"resource_id":   "",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockMessage := messagehandler.NewMockMessageHandler(mc)
+
+			h := &aicallHandler{
+				db:             mockDB,
+				messageHandler: mockMessage,
+			}
+			ctx := context.Background()
+
+			mockDB.EXPECT().AIcallGet(ctx, tt.id).Return(tt.responseAIcall, nil)
+			mockMessage.EXPECT().Create(ctx, uuid.Nil, tt.responseAIcall.CustomerID, tt.responseAIcall.ID, tt.responseAIcall.ActiveflowID, message.DirectionIncoming, message.RoleAssistant, "", gomock.Any(), "", gomock.Any()).Return(&message.Message{}, nil)
+			mockMessage.EXPECT().Create(ctx, uuid.Nil, tt.responseAIcall.CustomerID, tt.responseAIcall.ID, tt.responseAIcall.ActiveflowID, message.DirectionOutgoing, message.RoleTool, tt.expectContent, nil, tt.toolID, gomock.Any()).Return(tt.responseMessage, nil)
+
+			res, err := h.ToolHandle(ctx, tt.id, tt.toolID, tt.toolType, tt.function)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
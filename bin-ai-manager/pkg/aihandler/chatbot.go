@@ -36,6 +36,7 @@ func (h *aiHandler) Create(
 	vadConfig *ai.VADConfig,
 	smartTurnEnabled bool,
 	autoAICallAuditEnabled bool,
+	opts Options,
 ) (*ai.AI, error) {
 
 	if !ai.IsValidEngineModel(engineModel) {
@@ -49,7 +50,7 @@ func (h *aiHandler) Create(
 		return nil, fmt.Errorf("invalid type: %s. valid values: %s", aiType, strings.Join(aiType.ValidValues(), ", "))
 	}
 
-	if err := ai.ValidateToolNames(aiType, toolNames); err != nil {
+	if err := h.validateToolNames(ctx, customerID, aiType, toolNames); err != nil {
 		return nil, cerrors.InvalidArgument(commonoutline.ServiceNameAIManager, "INVALID_TOOL_NAMES", err.Error()).Wrap(err)
 	}
 
@@ -65,6 +66,10 @@ func (h *aiHandler) Create(
 		return nil, fmt.Errorf("invalid vad_config: %w", err)
 	}
 
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
 	// Pre-generate the history ID so we can write it into the AI row at creation time
 	var currentPromptHistoryID uuid.UUID
 	if initPrompt != "" {
@@ -73,7 +78,7 @@ func (h *aiHandler) Create(
 
 	res, err := h.dbCreate(ctx, customerID, name, detail, aiType, engineModel, parameter, engineKey, ragID,
 		initPrompt, ttsType, ttsVoiceID, sttType, sttLanguage, toolNames, vadConfig, smartTurnEnabled,
-		autoAICallAuditEnabled, currentPromptHistoryID)
+		autoAICallAuditEnabled, opts, currentPromptHistoryID)
 	if err != nil {
 		return nil, errors.Wrapf(err, "could not create ai")
 	}
@@ -114,6 +119,7 @@ func (h *aiHandler) Update(
 	vadConfig *ai.VADConfig,
 	smartTurnEnabled bool,
 	autoAICallAuditEnabled bool,
+	opts Options,
 ) (*ai.AI, error) {
 
 	if !ai.IsValidEngineModel(engineModel) {
@@ -132,6 +138,10 @@ func (h *aiHandler) Update(
 		return nil, fmt.Errorf("invalid vad_config: %w", err)
 	}
 
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
 	// Pre-fetch unconditionally so all three branches can detect changes.
 	preUpdateAI, errGet := h.db.AIGet(ctx, id)
 	if errGet != nil {
@@ -152,7 +162,7 @@ func (h *aiHandler) Update(
 		return nil, fmt.Errorf("invalid type: %s. valid values: %s", aiType, strings.Join(aiType.ValidValues(), ", "))
 	}
 
-	if err := ai.ValidateToolNames(aiType, toolNames); err != nil {
+	if err := h.validateToolNames(ctx, preUpdateAI.CustomerID, aiType, toolNames); err != nil {
 		return nil, cerrors.InvalidArgument(commonoutline.ServiceNameAIManager, "INVALID_TOOL_NAMES", err.Error()).Wrap(err)
 	}
 
@@ -163,7 +173,7 @@ func (h *aiHandler) Update(
 	case promptChanged:
 		historyID := h.utilHandler.UUIDCreate()
 		fields := h.buildUpdateFields(name, detail, aiType, engineModel, parameter, engineKey, ragID, initPrompt,
-			ttsType, ttsVoiceID, sttType, sttLanguage, toolNames, vadConfig, smartTurnEnabled, autoAICallAuditEnabled)
+			ttsType, ttsVoiceID, sttType, sttLanguage, toolNames, vadConfig, smartTurnEnabled, autoAICallAuditEnabled, opts)
 		fields[ai.FieldCurrentPromptHistoryID] = historyID
 		if err := h.db.AIUpdate(ctx, id, fields); err != nil {
 			return nil, errors.Wrapf(err, "could not update ai")
@@ -187,7 +197,7 @@ func (h *aiHandler) Update(
 
 	case promptCleared:
 		fields := h.buildUpdateFields(name, detail, aiType, engineModel, parameter, engineKey, ragID, "",
-			ttsType, ttsVoiceID, sttType, sttLanguage, toolNames, vadConfig, smartTurnEnabled, autoAICallAuditEnabled)
+			ttsType, ttsVoiceID, sttType, sttLanguage, toolNames, vadConfig, smartTurnEnabled, autoAICallAuditEnabled, opts)
 		fields[ai.FieldCurrentPromptHistoryID] = uuid.Nil
 		if err := h.db.AIUpdate(ctx, id, fields); err != nil {
 			return nil, errors.Wrapf(err, "could not update ai (clear prompt)")
@@ -201,6 +211,6 @@ func (h *aiHandler) Update(
 
 	default: // prompt unchanged
 		return h.dbUpdate(ctx, id, name, detail, aiType, engineModel, parameter, engineKey, ragID, initPrompt,
-			ttsType, ttsVoiceID, sttType, sttLanguage, toolNames, vadConfig, smartTurnEnabled, autoAICallAuditEnabled)
+			ttsType, ttsVoiceID, sttType, sttLanguage, toolNames, vadConfig, smartTurnEnabled, autoAICallAuditEnabled, opts)
 	}
 }
@@ -13,6 +13,9 @@ import (
 
 	"monorepo/bin-ai-manager/models/ai"
 	"monorepo/bin-ai-manager/models/aiprompthistory"
+	"monorepo/bin-ai-manager/models/guardrail"
+	"monorepo/bin-ai-manager/models/redaction"
+	"monorepo/bin-ai-manager/models/responsecache"
 	"monorepo/bin-ai-manager/models/tool"
 	"monorepo/bin-ai-manager/pkg/dbhandler"
 	cerrors "monorepo/bin-common-handler/models/errors"
@@ -35,6 +38,10 @@ func TestCreate(t *testing.T) {
 		sttType          ai.STTType
 		vadConfig        *ai.VADConfig
 		smartTurnEnabled bool
+		engineFallbacks  []ai.EngineFallback
+		redaction        *redaction.Config
+		guardrail        *guardrail.Config
+		responseCache    *responsecache.Config
 		setupMock        func(*dbhandler.MockDBHandler, *requesthandler.MockRequestHandler)
 		wantError        bool
 		errorMsg         string
@@ -125,6 +132,71 @@ func TestCreate(t *testing.T) {
 			wantError: true,
 			errorMsg:  "invalid vad_config",
 		},
+		{
+			name:        "fails_with_invalid_engine_fallbacks",
+			customerID:  uuid.Must(uuid.NewV4()),
+			aiName:      "Test AI",
+			engineModel: ai.EngineModelOpenaiGPT5,
+			ttsType:     ai.TTSTypeNone,
+			sttType:     ai.STTTypeNone,
+			engineFallbacks: []ai.EngineFallback{
+				{EngineModel: ai.EngineModel("gpt-5-mini")},
+			},
+			setupMock: func(m *dbhandler.MockDBHandler, r *requesthandler.MockRequestHandler) {
+				// Should not call database
+			},
+			wantError: true,
+			errorMsg:  "invalid engine_fallbacks",
+		},
+		{
+			name:        "fails_with_invalid_redaction",
+			customerID:  uuid.Must(uuid.NewV4()),
+			aiName:      "Test AI",
+			engineModel: ai.EngineModelOpenaiGPT5,
+			ttsType:     ai.TTSTypeNone,
+			sttType:     ai.STTTypeNone,
+			redaction: &redaction.Config{
+				EntityTypes: []redaction.EntityType{"passport"},
+			},
+			setupMock: func(m *dbhandler.MockDBHandler, r *requesthandler.MockRequestHandler) {
+				// Should not call database
+			},
+			wantError: true,
+			errorMsg:  "invalid redaction",
+		},
+		{
+			name:        "fails_with_invalid_guardrail",
+			customerID:  uuid.Must(uuid.NewV4()),
+			aiName:      "Test AI",
+			engineModel: ai.EngineModelOpenaiGPT5,
+			ttsType:     ai.TTSTypeNone,
+			sttType:     ai.STTTypeNone,
+			guardrail: &guardrail.Config{
+				Escalation: &guardrail.Escalation{Action: guardrail.EscalationActionTransfer},
+			},
+			setupMock: func(m *dbhandler.MockDBHandler, r *requesthandler.MockRequestHandler) {
+				// Should not call database
+			},
+			wantError: true,
+			errorMsg:  "invalid guardrail",
+		},
+		{
+			name:        "fails_with_invalid_response_cache",
+			customerID:  uuid.Must(uuid.NewV4()),
+			aiName:      "Test AI",
+			engineModel: ai.EngineModelOpenaiGPT5,
+			ttsType:     ai.TTSTypeNone,
+			sttType:     ai.STTTypeNone,
+			responseCache: &responsecache.Config{
+				Enabled:   true,
+				Threshold: 0.2,
+			},
+			setupMock: func(m *dbhandler.MockDBHandler, r *requesthandler.MockRequestHandler) {
+				// Should not call database
+			},
+			wantError: true,
+			errorMsg:  "invalid response_cache",
+		},
 		{
 			name:        "creates_ai_with_valid_vad_config",
 			customerID:  uuid.Must(uuid.NewV4()),
@@ -202,6 +274,12 @@ func TestCreate(t *testing.T) {
 				tt.vadConfig,
 				tt.smartTurnEnabled,
 				false,
+				Options{
+					EngineFallbacks: tt.engineFallbacks,
+					Redaction:       tt.redaction,
+					Guardrail:       tt.guardrail,
+					ResponseCache:   tt.responseCache,
+				},
 			)
 
 			if (err != nil) != tt.wantError {
@@ -232,6 +310,10 @@ func TestUpdate(t *testing.T) {
 		sttType          ai.STTType
 		vadConfig        *ai.VADConfig
 		smartTurnEnabled bool
+		engineFallbacks  []ai.EngineFallback
+		redaction        *redaction.Config
+		guardrail        *guardrail.Config
+		responseCache    *responsecache.Config
 		setupMock        func(*dbhandler.MockDBHandler)
 		wantError        bool
 		errorMsg         string
@@ -323,6 +405,74 @@ func TestUpdate(t *testing.T) {
 			wantError: true,
 			errorMsg:  "invalid vad_config",
 		},
+		{
+			name:        "fails_with_duplicated_engine_fallbacks",
+			aiID:        uuid.Must(uuid.NewV4()),
+			aiName:      "Updated AI",
+			engineModel: ai.EngineModelOpenaiGPT5,
+			ttsType:     ai.TTSTypeOpenAI,
+			sttType:     ai.STTTypeDeepgram,
+			engineFallbacks: []ai.EngineFallback{
+				{EngineModel: ai.EngineModelOpenaiGPT5Mini},
+				{EngineModel: ai.EngineModelOpenaiGPT5Mini},
+			},
+			setupMock: func(m *dbhandler.MockDBHandler) {
+				// Should not call database
+			},
+			wantError: true,
+			errorMsg:  "invalid engine_fallbacks",
+		},
+		{
+			name:        "fails_with_tool_access_without_tokenize_redaction",
+			aiID:        uuid.Must(uuid.NewV4()),
+			aiName:      "Updated AI",
+			engineModel: ai.EngineModelOpenaiGPT5,
+			ttsType:     ai.TTSTypeOpenAI,
+			sttType:     ai.STTTypeDeepgram,
+			redaction: &redaction.Config{
+				EntityTypes: []redaction.EntityType{redaction.EntityTypeCreditCard},
+				Mode:        redaction.ModeMask,
+				ToolAccess:  true,
+			},
+			setupMock: func(m *dbhandler.MockDBHandler) {
+				// Should not call database
+			},
+			wantError: true,
+			errorMsg:  "invalid redaction",
+		},
+		{
+			name:        "fails_with_negative_max_consecutive_tool_calls",
+			aiID:        uuid.Must(uuid.NewV4()),
+			aiName:      "Updated AI",
+			engineModel: ai.EngineModelOpenaiGPT5,
+			ttsType:     ai.TTSTypeOpenAI,
+			sttType:     ai.STTTypeDeepgram,
+			guardrail: &guardrail.Config{
+				MaxConsecutiveToolCalls: -1,
+			},
+			setupMock: func(m *dbhandler.MockDBHandler) {
+				// Should not call database
+			},
+			wantError: true,
+			errorMsg:  "invalid guardrail",
+		},
+		{
+			name:        "fails_with_invalid_response_cache_ttl",
+			aiID:        uuid.Must(uuid.NewV4()),
+			aiName:      "Updated AI",
+			engineModel: ai.EngineModelOpenaiGPT5,
+			ttsType:     ai.TTSTypeOpenAI,
+			sttType:     ai.STTTypeDeepgram,
+			responseCache: &responsecache.Config{
+				Enabled: true,
+				TTL:     -1,
+			},
+			setupMock: func(m *dbhandler.MockDBHandler) {
+				// Should not call database
+			},
+			wantError: true,
+			errorMsg:  "invalid response_cache",
+		},
 		{
 			name:        "updates_ai_with_valid_vad_config",
 			aiID:        uuid.Must(uuid.NewV4()),
@@ -396,6 +546,12 @@ func TestUpdate(t *testing.T) {
 				tt.vadConfig,
 				tt.smartTurnEnabled,
 				false,
+				Options{
+					EngineFallbacks: tt.engineFallbacks,
+					Redaction:       tt.redaction,
+					Guardrail:       tt.guardrail,
+					ResponseCache:   tt.responseCache,
+				},
 			)
 
 			if (err != nil) != tt.wantError {
@@ -459,6 +615,7 @@ func TestCreate_RecordsPromptHistory(t *testing.T) {
 		nil,
 		false,
 		false,
+		Options{},
 	)
 	if err != nil {
 		t.Errorf("Create() unexpected error: %v", err)
@@ -508,6 +665,7 @@ func TestCreate_EmptyPrompt_NoHistory(t *testing.T) {
 		nil,
 		false,
 		false,
+		Options{},
 	)
 	if err != nil {
 		t.Errorf("Create() unexpected error: %v", err)
@@ -564,6 +722,7 @@ func TestUpdate_NewPrompt_RecordsHistory(t *testing.T) {
 		nil,
 		false,
 		false,
+		Options{},
 	)
 	if err != nil {
 		t.Errorf("Update() unexpected error: %v", err)
@@ -619,6 +778,7 @@ func TestUpdate_SamePrompt_NoHistory(t *testing.T) {
 		nil,
 		false,
 		false,
+		Options{},
 	)
 	if err != nil {
 		t.Errorf("Update() unexpected error: %v", err)
@@ -669,6 +829,7 @@ func TestUpdate_EmptyPrompt_AlreadyEmpty_NoHistory(t *testing.T) {
 		nil,
 		false,
 		false,
+		Options{},
 	)
 	if err != nil {
 		t.Errorf("Update() unexpected error: %v", err)
@@ -719,6 +880,7 @@ func TestCreate_HistoryFails_CreateSucceeds(t *testing.T) {
 		nil,
 		false,
 		false,
+		Options{},
 	)
 	if err != nil {
 		t.Errorf("Create() should succeed even when history fails, got error: %v", err)
@@ -789,6 +951,7 @@ func TestCreate_WithPrompt_SetsCurrentHistoryIDAtomically(t *testing.T) {
 		nil,
 		false,
 		false,
+		Options{},
 	)
 	if err != nil {
 		t.Errorf("Create() unexpected error: %v", err)
@@ -838,6 +1001,7 @@ func TestCreate_WithoutPrompt_NoCurrentHistoryID(t *testing.T) {
 		nil,
 		false,
 		false,
+		Options{},
 	)
 	if err != nil {
 		t.Errorf("Create() unexpected error: %v", err)
@@ -881,7 +1045,7 @@ func TestUpdate_promptChangedCreatesHistoryAndSetsID(t *testing.T) {
 		utilHandler:   mockUtil,
 	}
 	_, err := h.Update(context.Background(), aiID, "name", "", ai.TypeNormal, ai.EngineModelOpenaiGPT5, nil, "", uuid.Nil,
-		"new prompt", ai.TTSTypeNone, "", ai.STTTypeNone, "", nil, nil, false, false)
+		"new prompt", ai.TTSTypeNone, "", ai.STTTypeNone, "", nil, nil, false, false, Options{})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -925,7 +1089,7 @@ func TestUpdate_promptClearedResetsID(t *testing.T) {
 		utilHandler:   mockUtil,
 	}
 	_, err := h.Update(context.Background(), aiID, "name", "", ai.TypeNormal, ai.EngineModelOpenaiGPT5, nil, "", uuid.Nil,
-		"", ai.TTSTypeNone, "", ai.STTTypeNone, "", nil, nil, false, false)
+		"", ai.TTSTypeNone, "", ai.STTTypeNone, "", nil, nil, false, false, Options{})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -959,7 +1123,7 @@ func TestUpdate_promptUnchangedDoesNotCreateHistory(t *testing.T) {
 		utilHandler:   mockUtil,
 	}
 	_, err := h.Update(context.Background(), aiID, "name", "", ai.TypeNormal, ai.EngineModelOpenaiGPT5, nil, "", uuid.Nil,
-		same, ai.TTSTypeNone, "", ai.STTTypeNone, "", nil, nil, false, false)
+		same, ai.TTSTypeNone, "", ai.STTTypeNone, "", nil, nil, false, false, Options{})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -1034,6 +1198,7 @@ func TestCreate_RejectsInsightAIWithNormalTools(t *testing.T) {
 		nil,
 		false,
 		false,
+		Options{},
 	)
 	if err == nil {
 		t.Fatal("Create() with Type=insight and Normal-only tool_names should have been rejected, got nil error")
@@ -1078,6 +1243,7 @@ func TestCreate_RejectsNormalAIWithInsightTools(t *testing.T) {
 		nil,
 		false,
 		false,
+		Options{},
 	)
 	if err == nil {
 		t.Fatal("Create() with Type=normal and Insight-only tool_names should have been rejected, got nil error")
@@ -1132,6 +1298,7 @@ func TestCreate_AllowsValidInsightAI(t *testing.T) {
 		nil,
 		false,
 		false,
+		Options{},
 	)
 	if err != nil {
 		t.Fatalf("Create() with a valid Insight tool should succeed, got error: %v", err)
@@ -1203,6 +1370,7 @@ func TestUpdate_RejectsInsightAIWithNormalTools(t *testing.T) {
 		nil,
 		false,
 		false,
+		Options{},
 	)
 	if err == nil {
 		t.Fatal("Update() on an Insight AI with Normal-only tool_names should have been rejected, got nil error")
@@ -40,6 +40,7 @@ func (h *aiHandler) dbCreate(
 	vadConfig *ai.VADConfig,
 	smartTurnEnabled bool,
 	autoAICallAuditEnabled bool,
+	opts Options,
 	currentPromptHistoryID uuid.UUID,
 ) (*ai.AI, error) {
 	log := logrus.WithFields(logrus.Fields{
@@ -71,6 +72,8 @@ func (h *aiHandler) dbCreate(
 		EngineKey:   engineKey,
 		RagID:       ragID,
 
+		EngineFallbacks: opts.EngineFallbacks,
+
 		InitPrompt: initPrompt,
 
 		CurrentPromptHistoryID: currentPromptHistoryID,
@@ -88,6 +91,11 @@ func (h *aiHandler) dbCreate(
 
 		AutoAICallAuditEnabled: autoAICallAuditEnabled,
 
+		Redaction: opts.Redaction,
+		Guardrail: opts.Guardrail,
+
+		ResponseCache: opts.ResponseCache,
+
 		DirectID:   d.ID,
 		DirectHash: d.Hash,
 	}
@@ -189,9 +197,10 @@ func (h *aiHandler) dbUpdate(
 	vadConfig *ai.VADConfig,
 	smartTurnEnabled bool,
 	autoAICallAuditEnabled bool,
+	opts Options,
 ) (*ai.AI, error) {
 	fields := h.buildUpdateFields(name, detail, aiType, engineModel, parameter, engineKey, ragID, initPrompt,
-		ttsType, ttsVoice, sttType, sttLanguage, toolNames, vadConfig, smartTurnEnabled, autoAICallAuditEnabled)
+		ttsType, ttsVoice, sttType, sttLanguage, toolNames, vadConfig, smartTurnEnabled, autoAICallAuditEnabled, opts)
 
 	if err := h.db.AIUpdate(ctx, id, fields); err != nil {
 		return nil, errors.Wrapf(err, "could not update ai")
@@ -270,6 +279,7 @@ func (h *aiHandler) buildUpdateFields(
 	vadConfig *ai.VADConfig,
 	smartTurnEnabled bool,
 	autoAICallAuditEnabled bool,
+	opts Options,
 ) map[ai.Field]any {
 	res := map[ai.Field]any{
 		ai.FieldName:                   name,
@@ -288,6 +298,10 @@ func (h *aiHandler) buildUpdateFields(
 		ai.FieldVADConfig:              vadConfig,
 		ai.FieldSmartTurnEnabled:       smartTurnEnabled,
 		ai.FieldAutoAICallAuditEnabled: autoAICallAuditEnabled,
+		ai.FieldEngineFallbacks:        opts.EngineFallbacks,
+		ai.FieldRedaction:              opts.Redaction,
+		ai.FieldGuardrail:              opts.Guardrail,
+		ai.FieldResponseCache:          opts.ResponseCache,
 	}
 
 	// Any row that is not (or is no longer) an Insight AI must not keep an
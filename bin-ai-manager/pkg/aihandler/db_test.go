@@ -131,7 +131,7 @@ func Test_Create(t *testing.T) {
 			// prompt history recorded (best-effort) using the pre-generated history UUID
 			mockDB.EXPECT().AIPromptHistoryCreate(ctx, gomock.Any()).Return(nil)
 
-			res, err := h.Create(ctx, tt.customerID, tt.aiName, tt.detail, ai.TypeNormal, tt.engineModel, tt.parameter, tt.engineKey, uuid.Nil, tt.initPrompt, tt.ttsType, tt.ttsVoiceID, tt.sttType, "", nil, nil, false, false)
+			res, err := h.Create(ctx, tt.customerID, tt.aiName, tt.detail, ai.TypeNormal, tt.engineModel, tt.parameter, tt.engineKey, uuid.Nil, tt.initPrompt, tt.ttsType, tt.ttsVoiceID, tt.sttType, "", nil, nil, false, false, Options{})
 			if err != nil {
 				t.Errorf("Wrong match. expect: ok, got: %v", err)
 			}
@@ -412,6 +412,7 @@ func Test_Update(t *testing.T) {
 				nil,
 				false,
 				false,
+				Options{},
 			)
 			if err != nil {
 				t.Errorf("Wrong match. expect: ok, got: %v", err)
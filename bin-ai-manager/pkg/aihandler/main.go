@@ -37,6 +37,7 @@ type AIHandler interface {
 		vadConfig *ai.VADConfig,
 		smartTurnEnabled bool,
 		autoAICallAuditEnabled bool,
+		opts Options,
 	) (*ai.AI, error)
 	Get(ctx context.Context, id uuid.UUID) (*ai.AI, error)
 	List(ctx context.Context, size uint64, token string, filters map[ai.Field]any) ([]*ai.AI, error)
@@ -60,6 +61,7 @@ type AIHandler interface {
 		vadConfig *ai.VADConfig,
 		smartTurnEnabled bool,
 		autoAICallAuditEnabled bool,
+		opts Options,
 	) (*ai.AI, error)
 	ActivateInsight(ctx context.Context, id uuid.UUID) (*ai.AI, error)
 	DirectHashRegenerate(ctx context.Context, id uuid.UUID) (*ai.AI, error)
@@ -0,0 +1,40 @@
+package aihandler
+
+import (
+	"fmt"
+
+	"monorepo/bin-ai-manager/models/ai"
+	"monorepo/bin-ai-manager/models/guardrail"
+	"monorepo/bin-ai-manager/models/redaction"
+	"monorepo/bin-ai-manager/models/responsecache"
+)
+
+// Options is the optional config of an AI given to Create and Update.
+// A zero field leaves the AI without the config.
+type Options struct {
+	EngineFallbacks []ai.EngineFallback
+	Redaction       *redaction.Config
+	Guardrail       *guardrail.Config
+	ResponseCache   *responsecache.Config
+}
+
+// validate returns an error if any of the options is invalid.
+func (o *Options) validate() error {
+	if err := ai.ValidateEngineFallbacks(o.EngineFallbacks); err != nil {
+		return fmt.Errorf("invalid engine_fallbacks: %w", err)
+	}
+
+	if err := redaction.Validate(o.Redaction); err != nil {
+		return fmt.Errorf("invalid redaction: %w", err)
+	}
+
+	if err := guardrail.Validate(o.Guardrail); err != nil {
+		return fmt.Errorf("invalid guardrail: %w", err)
+	}
+
+	if err := responsecache.Validate(o.ResponseCache); err != nil {
+		return fmt.Errorf("invalid response_cache: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package aihandler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+
+	"monorepo/bin-ai-manager/models/ai"
+	"monorepo/bin-ai-manager/models/customtool"
+	"monorepo/bin-ai-manager/models/mcpserver"
+	"monorepo/bin-ai-manager/models/tool"
+)
+
+// validateToolNames validates toolNames for the given Type. On top of
+// ai.ValidateToolNames, a Normal AI may reference the customer's own custom
+// tools and MCP servers by name. Insight AIs stay restricted to their
+// read-only built-in set, since a custom tool's side effects are unknown.
+//
+// The custom tool and MCP server lookups only run for names that are not built-in tools of
+// any Type, so the common all-built-in case never touches the database.
+func (h *aiHandler) validateToolNames(ctx context.Context, customerID uuid.UUID, aiType ai.Type, toolNames []tool.ToolName) error {
+	errValidate := ai.ValidateToolNames(aiType, toolNames)
+	if errValidate == nil || aiType != ai.TypeNormal {
+		return errValidate
+	}
+
+	allowed := ai.AllowedToolNames(aiType)
+	insight := ai.AllowedToolNames(ai.TypeInsight)
+
+	var invalid []tool.ToolName
+	for _, name := range toolNames {
+		if name == tool.ToolNameAll || allowed[name] {
+			continue
+		}
+		if insight[name] {
+			invalid = append(invalid, name)
+			continue
+		}
+
+		exists, err := h.customToolExists(ctx, customerID, name)
+		if err != nil {
+			return err
+		}
+		if exists {
+			continue
+		}
+
+		exists, err = h.mcpServerExists(ctx, customerID, name)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			invalid = append(invalid, name)
+		}
+	}
+
+	if len(invalid) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("invalid tool_names for type=%s: %v is not allowed", aiType, invalid)
+}
+
+// customToolExists reports whether the customer owns an active custom tool
+// with the given name.
+func (h *aiHandler) customToolExists(ctx context.Context, customerID uuid.UUID, name tool.ToolName) (bool, error) {
+	filters := map[customtool.Field]any{
+		customtool.FieldCustomerID: customerID,
+		customtool.FieldName:       string(name),
+		customtool.FieldDeleted:    false,
+	}
+
+	tmp, err := h.db.CustomToolList(ctx, 1, "", filters)
+	if err != nil {
+		return false, errors.Wrapf(err, "could not get custom tools")
+	}
+
+	return len(tmp) > 0, nil
+}
+
+// mcpServerExists reports whether the customer owns an active MCP server
+// with the given name.
+func (h *aiHandler) mcpServerExists(ctx context.Context, customerID uuid.UUID, name tool.ToolName) (bool, error) {
+	filters := map[mcpserver.Field]any{
+		mcpserver.FieldCustomerID: customerID,
+		mcpserver.FieldName:       string(name),
+		mcpserver.FieldDeleted:    false,
+	}
+
+	tmp, err := h.db.MCPServerList(ctx, 1, "", filters)
+	if err != nil {
+		return false, errors.Wrapf(err, "could not get mcp servers")
+	}
+
+	return len(tmp) > 0, nil
+}
@@ -0,0 +1,134 @@
+package aihandler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	"monorepo/bin-common-handler/models/identity"
+
+	"monorepo/bin-ai-manager/models/ai"
+	"monorepo/bin-ai-manager/models/customtool"
+	"monorepo/bin-ai-manager/models/mcpserver"
+	"monorepo/bin-ai-manager/models/tool"
+	"monorepo/bin-ai-manager/pkg/dbhandler"
+)
+
+func Test_validateToolNames(t *testing.T) {
+	customerID := uuid.FromStringOrNil("6d8f0b2d-8d72-11f1-a1c3-0b2d4f6a8c11")
+
+	tests := []struct {
+		name string
+
+		aiType    ai.Type
+		toolNames []tool.ToolName
+
+		lookupNames       []string
+		responseExists    map[string]bool
+		responseMCPServer map[string]bool
+		expectErr         bool
+	}{
+		{
+			name: "builtin only",
+
+			aiType:    ai.TypeNormal,
+			toolNames: []tool.ToolName{tool.ToolNameConnectCall, tool.ToolNameAll},
+		},
+		{
+			name: "custom tool of the customer",
+
+			aiType:    ai.TypeNormal,
+			toolNames: []tool.ToolName{tool.ToolNameConnectCall, "lookup_order"},
+
+			lookupNames:    []string{"lookup_order"},
+			responseExists: map[string]bool{"lookup_order": true},
+		},
+		{
+			name: "unknown name",
+
+			aiType:    ai.TypeNormal,
+			toolNames: []tool.ToolName{"lookup_order"},
+
+			lookupNames:    []string{"lookup_order"},
+			responseExists: map[string]bool{"lookup_order": false},
+			expectErr:      true,
+		},
+		{
+			name: "mcp server of the customer",
+
+			aiType:    ai.TypeNormal,
+			toolNames: []tool.ToolName{"crm"},
+
+			lookupNames:       []string{"crm"},
+			responseExists:    map[string]bool{"crm": false},
+			responseMCPServer: map[string]bool{"crm": true},
+		},
+		{
+			name: "insight tool on normal ai does not hit the database",
+
+			aiType:    ai.TypeNormal,
+			toolNames: []tool.ToolName{tool.ToolNameGetContactInteractions},
+			expectErr: true,
+		},
+		{
+			name: "custom tool on insight ai",
+
+			aiType:    ai.TypeInsight,
+			toolNames: []tool.ToolName{"lookup_order"},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+
+			h := &aiHandler{
+				db: mockDB,
+			}
+
+			ctx := context.Background()
+
+			for _, name := range tt.lookupNames {
+				res := []*customtool.CustomTool{}
+				if tt.responseExists[name] {
+					res = append(res, &customtool.CustomTool{
+						Identity: identity.Identity{CustomerID: customerID},
+						Name:     name,
+					})
+				}
+				mockDB.EXPECT().CustomToolList(ctx, uint64(1), "", map[customtool.Field]any{
+					customtool.FieldCustomerID: customerID,
+					customtool.FieldName:       name,
+					customtool.FieldDeleted:    false,
+				}).Return(res, nil)
+				if tt.responseExists[name] {
+					continue
+				}
+
+				servers := []*mcpserver.MCPServer{}
+				if tt.responseMCPServer[name] {
+					servers = append(servers, &mcpserver.MCPServer{
+						Identity: identity.Identity{CustomerID: customerID},
+						Name:     name,
+					})
+				}
+				mockDB.EXPECT().MCPServerList(ctx, uint64(1), "", map[mcpserver.Field]any{
+					mcpserver.FieldCustomerID: customerID,
+					mcpserver.FieldName:       name,
+					mcpserver.FieldDeleted:    false,
+				}).Return(servers, nil)
+			}
+
+			err := h.validateToolNames(ctx, customerID, tt.aiType, tt.toolNames)
+			if (err != nil) != tt.expectErr {
+				t.Errorf("Wrong match. expect error: %v, got: %v", tt.expectErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package cachehandler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	uuid "github.com/gofrs/uuid"
+)
+
+// guardrailDisclaimerTTL is how long the aicall's disclaimer mark is kept.
+const guardrailDisclaimerTTL = time.Hour * 24
+
+// GuardrailDisclaimerSet marks the aicall's disclaimer as said.
+// Returns false if it was already marked.
+func (h *handler) GuardrailDisclaimerSet(ctx context.Context, aicallID uuid.UUID) (bool, error) {
+	key := fmt.Sprintf("ai:guardrail:disclaimer:%s", aicallID)
+
+	res, err := h.Cache.SetNX(ctx, key, 1, guardrailDisclaimerTTL).Result()
+	if err != nil {
+		return false, err
+	}
+
+	return res, nil
+}
@@ -4,6 +4,7 @@ package cachehandler
 
 import (
 	"context"
+	"time"
 
 	"github.com/go-redis/redis/v8"
 	uuid "github.com/gofrs/uuid"
@@ -11,6 +12,7 @@ import (
 	"monorepo/bin-ai-manager/models/ai"
 	"monorepo/bin-ai-manager/models/aicall"
 	"monorepo/bin-ai-manager/models/message"
+	"monorepo/bin-ai-manager/models/responsecache"
 	"monorepo/bin-ai-manager/models/summary"
 	"monorepo/bin-ai-manager/models/team"
 )
@@ -43,6 +45,18 @@ type CacheHandler interface {
 
 	TeamGet(ctx context.Context, id uuid.UUID) (*team.Team, error)
 	TeamSet(ctx context.Context, data *team.Team) error
+
+	RedactionTokenSet(ctx context.Context, aicallID uuid.UUID, token string, value string, ttl time.Duration) error
+	RedactionTokenRefresh(ctx context.Context, aicallID uuid.UUID, ttl time.Duration) error
+	RedactionTokenGets(ctx context.Context, aicallID uuid.UUID) (map[string]string, error)
+
+	GuardrailDisclaimerSet(ctx context.Context, aicallID uuid.UUID) (bool, error)
+
+	ResponseCacheEntryGets(ctx context.Context, bucket string) ([]*responsecache.Entry, error)
+	ResponseCacheEntryGetByHash(ctx context.Context, bucket string, hash string) (*responsecache.Entry, error)
+	ResponseCacheEntryAdd(ctx context.Context, bucket string, hash string, e *responsecache.Entry, ttl time.Duration) error
+	ResponseCachePendingSet(ctx context.Context, aicallID uuid.UUID, p *responsecache.Pending) error
+	ResponseCachePendingPop(ctx context.Context, aicallID uuid.UUID) (*responsecache.Pending, error)
 }
 
 // NewHandler creates DBHandler
@@ -14,9 +14,11 @@ import (
 	ai "monorepo/bin-ai-manager/models/ai"
 	aicall "monorepo/bin-ai-manager/models/aicall"
 	message "monorepo/bin-ai-manager/models/message"
+	responsecache "monorepo/bin-ai-manager/models/responsecache"
 	summary "monorepo/bin-ai-manager/models/summary"
 	team "monorepo/bin-ai-manager/models/team"
 	reflect "reflect"
+	time "time"
 
 	uuid "github.com/gofrs/uuid"
 	gomock "go.uber.org/mock/gomock"
@@ -148,6 +150,21 @@ func (mr *MockCacheHandlerMockRecorder) Connect() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Connect", reflect.TypeOf((*MockCacheHandler)(nil).Connect))
 }
 
+// GuardrailDisclaimerSet mocks base method.
+func (m *MockCacheHandler) GuardrailDisclaimerSet(ctx context.Context, aicallID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuardrailDisclaimerSet", ctx, aicallID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuardrailDisclaimerSet indicates an expected call of GuardrailDisclaimerSet.
+func (mr *MockCacheHandlerMockRecorder) GuardrailDisclaimerSet(ctx, aicallID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuardrailDisclaimerSet", reflect.TypeOf((*MockCacheHandler)(nil).GuardrailDisclaimerSet), ctx, aicallID)
+}
+
 // MessageGet mocks base method.
 func (m *MockCacheHandler) MessageGet(ctx context.Context, id uuid.UUID) (*message.Message, error) {
 	m.ctrl.T.Helper()
@@ -177,6 +194,122 @@ func (mr *MockCacheHandlerMockRecorder) MessageSet(ctx, data any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MessageSet", reflect.TypeOf((*MockCacheHandler)(nil).MessageSet), ctx, data)
 }
 
+// RedactionTokenGets mocks base method.
+func (m *MockCacheHandler) RedactionTokenGets(ctx context.Context, aicallID uuid.UUID) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedactionTokenGets", ctx, aicallID)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RedactionTokenGets indicates an expected call of RedactionTokenGets.
+func (mr *MockCacheHandlerMockRecorder) RedactionTokenGets(ctx, aicallID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedactionTokenGets", reflect.TypeOf((*MockCacheHandler)(nil).RedactionTokenGets), ctx, aicallID)
+}
+
+// RedactionTokenRefresh mocks base method.
+func (m *MockCacheHandler) RedactionTokenRefresh(ctx context.Context, aicallID uuid.UUID, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedactionTokenRefresh", ctx, aicallID, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RedactionTokenRefresh indicates an expected call of RedactionTokenRefresh.
+func (mr *MockCacheHandlerMockRecorder) RedactionTokenRefresh(ctx, aicallID, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedactionTokenRefresh", reflect.TypeOf((*MockCacheHandler)(nil).RedactionTokenRefresh), ctx, aicallID, ttl)
+}
+
+// RedactionTokenSet mocks base method.
+func (m *MockCacheHandler) RedactionTokenSet(ctx context.Context, aicallID uuid.UUID, token, value string, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedactionTokenSet", ctx, aicallID, token, value, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RedactionTokenSet indicates an expected call of RedactionTokenSet.
+func (mr *MockCacheHandlerMockRecorder) RedactionTokenSet(ctx, aicallID, token, value, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedactionTokenSet", reflect.TypeOf((*MockCacheHandler)(nil).RedactionTokenSet), ctx, aicallID, token, value, ttl)
+}
+
+// ResponseCacheEntryAdd mocks base method.
+func (m *MockCacheHandler) ResponseCacheEntryAdd(ctx context.Context, bucket, hash string, e *responsecache.Entry, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResponseCacheEntryAdd", ctx, bucket, hash, e, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResponseCacheEntryAdd indicates an expected call of ResponseCacheEntryAdd.
+func (mr *MockCacheHandlerMockRecorder) ResponseCacheEntryAdd(ctx, bucket, hash, e, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResponseCacheEntryAdd", reflect.TypeOf((*MockCacheHandler)(nil).ResponseCacheEntryAdd), ctx, bucket, hash, e, ttl)
+}
+
+// ResponseCacheEntryGetByHash mocks base method.
+func (m *MockCacheHandler) ResponseCacheEntryGetByHash(ctx context.Context, bucket, hash string) (*responsecache.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResponseCacheEntryGetByHash", ctx, bucket, hash)
+	ret0, _ := ret[0].(*responsecache.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResponseCacheEntryGetByHash indicates an expected call of ResponseCacheEntryGetByHash.
+func (mr *MockCacheHandlerMockRecorder) ResponseCacheEntryGetByHash(ctx, bucket, hash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResponseCacheEntryGetByHash", reflect.TypeOf((*MockCacheHandler)(nil).ResponseCacheEntryGetByHash), ctx, bucket, hash)
+}
+
+// ResponseCacheEntryGets mocks base method.
+func (m *MockCacheHandler) ResponseCacheEntryGets(ctx context.Context, bucket string) ([]*responsecache.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResponseCacheEntryGets", ctx, bucket)
+	ret0, _ := ret[0].([]*responsecache.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResponseCacheEntryGets indicates an expected call of ResponseCacheEntryGets.
+func (mr *MockCacheHandlerMockRecorder) ResponseCacheEntryGets(ctx, bucket any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResponseCacheEntryGets", reflect.TypeOf((*MockCacheHandler)(nil).ResponseCacheEntryGets), ctx, bucket)
+}
+
+// ResponseCachePendingPop mocks base method.
+func (m *MockCacheHandler) ResponseCachePendingPop(ctx context.Context, aicallID uuid.UUID) (*responsecache.Pending, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResponseCachePendingPop", ctx, aicallID)
+	ret0, _ := ret[0].(*responsecache.Pending)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResponseCachePendingPop indicates an expected call of ResponseCachePendingPop.
+func (mr *MockCacheHandlerMockRecorder) ResponseCachePendingPop(ctx, aicallID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResponseCachePendingPop", reflect.TypeOf((*MockCacheHandler)(nil).ResponseCachePendingPop), ctx, aicallID)
+}
+
+// ResponseCachePendingSet mocks base method.
+func (m *MockCacheHandler) ResponseCachePendingSet(ctx context.Context, aicallID uuid.UUID, p *responsecache.Pending) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResponseCachePendingSet", ctx, aicallID, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResponseCachePendingSet indicates an expected call of ResponseCachePendingSet.
+func (mr *MockCacheHandlerMockRecorder) ResponseCachePendingSet(ctx, aicallID, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResponseCachePendingSet", reflect.TypeOf((*MockCacheHandler)(nil).ResponseCachePendingSet), ctx, aicallID, p)
+}
+
 // SummaryGet mocks base method.
 func (m *MockCacheHandler) SummaryGet(ctx context.Context, id uuid.UUID) (*summary.Summary, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,49 @@
+package cachehandler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	uuid "github.com/gofrs/uuid"
+)
+
+// RedactionTokenSet adds the value of the redaction token to the aicall's tokens
+// and keeps the tokens for the given ttl.
+func (h *handler) RedactionTokenSet(ctx context.Context, aicallID uuid.UUID, token string, value string, ttl time.Duration) error {
+	key := fmt.Sprintf("ai:redaction:%s", aicallID)
+
+	if err := h.Cache.HSet(ctx, key, token, value).Err(); err != nil {
+		return err
+	}
+
+	if err := h.Cache.Expire(ctx, key, ttl).Err(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RedactionTokenRefresh keeps the aicall's redaction tokens for the given ttl
+// from now. Does nothing if the aicall has no tokens.
+func (h *handler) RedactionTokenRefresh(ctx context.Context, aicallID uuid.UUID, ttl time.Duration) error {
+	key := fmt.Sprintf("ai:redaction:%s", aicallID)
+
+	if err := h.Cache.Expire(ctx, key, ttl).Err(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RedactionTokenGets returns the aicall's redaction tokens and their values.
+func (h *handler) RedactionTokenGets(ctx context.Context, aicallID uuid.UUID) (map[string]string, error) {
+	key := fmt.Sprintf("ai:redaction:%s", aicallID)
+
+	res, err := h.Cache.HGetAll(ctx, key).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,143 @@
+package cachehandler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	uuid "github.com/gofrs/uuid"
+
+	"monorepo/bin-ai-manager/models/responsecache"
+)
+
+// responseCacheMaxEntries is the max number of the responses kept in a bucket
+// for the similarity search. The oldest responses are dropped first.
+const responseCacheMaxEntries = 50
+
+// responseCachePendingTTL is how long the question of a cache miss waits for
+// the LLM's response to be stored.
+const responseCachePendingTTL = time.Minute * 5
+
+// ResponseCacheEntryGets returns the cached responses of the bucket, the newest first.
+func (h *handler) ResponseCacheEntryGets(ctx context.Context, bucket string) ([]*responsecache.Entry, error) {
+	key := fmt.Sprintf("ai:response_cache:%s", bucket)
+
+	tmps, err := h.Cache.LRange(ctx, key, 0, responseCacheMaxEntries-1).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*responsecache.Entry, 0, len(tmps))
+	for _, tmp := range tmps {
+		e := &responsecache.Entry{}
+		if errUnmarshal := json.Unmarshal([]byte(tmp), e); errUnmarshal != nil {
+			continue
+		}
+		res = append(res, e)
+	}
+
+	return res, nil
+}
+
+// ResponseCacheEntryGetByHash returns the cached response of the bucket for
+// the question of the given hash. Returns nil if there is none.
+func (h *handler) ResponseCacheEntryGetByHash(ctx context.Context, bucket string, hash string) (*responsecache.Entry, error) {
+	key := fmt.Sprintf("ai:response_cache:exact:%s:%s", bucket, hash)
+
+	tmp, err := h.Cache.Get(ctx, key).Result()
+	if err == redis.Nil {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	res := &responsecache.Entry{}
+	if err := json.Unmarshal([]byte(tmp), res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// ResponseCacheEntryAdd adds the response to the bucket, and indexes it by
+// the hash of its question. The bucket expires after the ttl since its last
+// response was added.
+func (h *handler) ResponseCacheEntryAdd(ctx context.Context, bucket string, hash string, e *responsecache.Entry, ttl time.Duration) error {
+	key := fmt.Sprintf("ai:response_cache:%s", bucket)
+
+	tmp, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	// the exact match needs no embedding.
+	exact, err := json.Marshal(&responsecache.Entry{
+		Question: e.Question,
+		Response: e.Response,
+		TMCreate: e.TMCreate,
+	})
+	if err != nil {
+		return err
+	}
+
+	keyExact := fmt.Sprintf("ai:response_cache:exact:%s:%s", bucket, hash)
+	if err := h.Cache.Set(ctx, keyExact, exact, ttl).Err(); err != nil {
+		return err
+	}
+
+	if err := h.Cache.LPush(ctx, key, tmp).Err(); err != nil {
+		return err
+	}
+
+	if err := h.Cache.LTrim(ctx, key, 0, responseCacheMaxEntries-1).Err(); err != nil {
+		return err
+	}
+
+	if err := h.Cache.Expire(ctx, key, ttl).Err(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ResponseCachePendingSet sets the question of the aicall's last cache miss.
+func (h *handler) ResponseCachePendingSet(ctx context.Context, aicallID uuid.UUID, p *responsecache.Pending) error {
+	key := fmt.Sprintf("ai:response_cache:pending:%s", aicallID)
+
+	tmp, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+
+	if err := h.Cache.Set(ctx, key, tmp, responseCachePendingTTL).Err(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ResponseCachePendingPop returns the question of the aicall's last cache miss and removes it.
+// Returns nil if there is none.
+func (h *handler) ResponseCachePendingPop(ctx context.Context, aicallID uuid.UUID) (*responsecache.Pending, error) {
+	key := fmt.Sprintf("ai:response_cache:pending:%s", aicallID)
+
+	tmp, err := h.Cache.Get(ctx, key).Result()
+	if err == redis.Nil {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if err := h.Cache.Del(ctx, key).Err(); err != nil {
+		return nil, err
+	}
+
+	res := &responsecache.Pending{}
+	if err := json.Unmarshal([]byte(tmp), res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
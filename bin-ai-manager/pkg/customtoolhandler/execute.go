@@ -0,0 +1,170 @@
+package customtoolhandler
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"monorepo/bin-ai-manager/models/aicall"
+	"monorepo/bin-ai-manager/models/customtool"
+)
+
+// signatureHeader carries the HMAC-SHA256 signature of the request body,
+// using the same header and format as bin-webhook-manager so customers can
+// reuse one verifier for webhooks and tool calls.
+const signatureHeader = "X-VoIPBIN-Signature"
+
+const (
+	maxResponseBodySize = 64 * 1024 // bytes read from the endpoint's response
+	maxResultLength     = 8 * 1024  // bytes of tool result handed back to the LLM
+)
+
+// executeRequest is the body POSTed to the custom tool's endpoint.
+type executeRequest struct {
+	CustomToolID  uuid.UUID            `json:"custom_tool_id"`
+	Name          string               `json:"name"`
+	ToolCallID    string               `json:"tool_call_id"`
+	AIcallID      uuid.UUID            `json:"aicall_id"`
+	ActiveflowID  uuid.UUID            `json:"activeflow_id"`
+	ReferenceType aicall.ReferenceType `json:"reference_type"`
+	ReferenceID   uuid.UUID            `json:"reference_id"`
+	Arguments     map[string]any       `json:"arguments"`
+}
+
+// computeSignature returns the hex-encoded HMAC-SHA256 of body keyed by
+// secret, formatted as "sha256=<hex>".
+func computeSignature(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Execute calls the custom tool's endpoint with the LLM's arguments and
+// returns the text to feed back to the model. The request is signed with the
+// customer's webhook secret when one is set.
+func (h *customToolHandler) Execute(ctx context.Context, c *customtool.CustomTool, ac *aicall.AIcall, toolCallID string, arguments string) (string, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":           "Execute",
+		"custom_tool_id": c.ID,
+		"aicall_id":      ac.ID,
+	})
+
+	args := map[string]any{}
+	if strings.TrimSpace(arguments) != "" {
+		if err := json.Unmarshal([]byte(arguments), &args); err != nil {
+			return "", errors.Wrapf(err, "could not parse the tool arguments")
+		}
+	}
+
+	body, err := json.Marshal(&executeRequest{
+		CustomToolID:  c.ID,
+		Name:          c.Name,
+		ToolCallID:    toolCallID,
+		AIcallID:      ac.ID,
+		ActiveflowID:  ac.ActiveflowID,
+		ReferenceType: ac.ReferenceType,
+		ReferenceID:   ac.ReferenceID,
+		Arguments:     args,
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "could not marshal the request")
+	}
+
+	// the url was validated on save. only the scheme is re-checked here;
+	// private/reserved addresses are blocked by the http client's dialer.
+	if !strings.HasPrefix(c.URL, "https://") {
+		return "", fmt.Errorf("invalid custom tool url. only https is allowed")
+	}
+
+	timeout := c.Timeout
+	if timeout <= 0 || timeout > customtool.TimeoutMax {
+		timeout = customtool.TimeoutDefault
+	}
+	ctxReq, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Millisecond)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctxReq, http.MethodPost, c.URL, bytes.NewReader(body))
+	if err != nil {
+		return "", errors.Wrapf(err, "could not create the request")
+	}
+	for k, v := range c.Headers {
+		req.Header.Set(k, v)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	cu, err := h.reqHandler.CustomerV1CustomerGet(ctx, c.CustomerID)
+	if err != nil {
+		return "", errors.Wrapf(err, "could not get the customer info")
+	}
+	if cu.WebhookSecret != "" {
+		req.Header.Set(signatureHeader, computeSignature(cu.WebhookSecret, body))
+	}
+
+	resp, err := h.httpClient.Do(req)
+	if err != nil {
+		return "", errors.Wrapf(err, "could not send the request")
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBodySize))
+	if err != nil {
+		return "", errors.Wrapf(err, "could not read the response")
+	}
+	log.Debugf("Received custom tool response. status: %d, size: %d", resp.StatusCode, len(data))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", fmt.Errorf("the endpoint returned status %d", resp.StatusCode)
+	}
+
+	res, err := renderResponse(c.ResponseTemplate, data)
+	if err != nil {
+		return "", errors.Wrapf(err, "could not render the response")
+	}
+
+	return res, nil
+}
+
+// renderResponse applies the custom tool's response template to the
+// endpoint's response. A JSON response is decoded first so the template can
+// address its fields (e.g. "{{.order.status}}"); any other body is passed to
+// the template as a string. Without a template the raw body is returned.
+func renderResponse(responseTemplate string, data []byte) (string, error) {
+	res := string(data)
+
+	if responseTemplate != "" {
+		var v any
+		if errUnmarshal := json.Unmarshal(data, &v); errUnmarshal != nil {
+			v = string(data)
+		}
+
+		tmpl, err := template.New("response").Parse(responseTemplate)
+		if err != nil {
+			return "", errors.Wrapf(err, "could not parse the response template")
+		}
+
+		var buf bytes.Buffer
+		if errExecute := tmpl.Execute(&buf, v); errExecute != nil {
+			return "", errors.Wrapf(errExecute, "could not execute the response template")
+		}
+		res = buf.String()
+	}
+
+	if len(res) > maxResultLength {
+		res = res[:maxResultLength]
+	}
+
+	return res, nil
+}
@@ -0,0 +1,178 @@
+package customtoolhandler
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	cscustomer "monorepo/bin-customer-manager/models/customer"
+
+	"monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/requesthandler"
+
+	"monorepo/bin-ai-manager/models/aicall"
+	"monorepo/bin-ai-manager/models/customtool"
+)
+
+func Test_Execute(t *testing.T) {
+	customerID := uuid.FromStringOrNil("3a5c7e9a-8d71-11f1-b4d6-2a4c6e8a0c11")
+	aicallID := uuid.FromStringOrNil("3a8b0d2c-8d71-11f1-8e07-4c6e8a0c2e22")
+
+	tests := []struct {
+		name string
+
+		responseTemplate string
+		responseStatus   int
+		responseBody     string
+		webhookSecret    string
+
+		expectRes string
+		expectErr bool
+	}{
+		{
+			name: "json response with template",
+
+			responseTemplate: "Order {{.order.id}} is {{.order.status}}.",
+			responseStatus:   http.StatusOK,
+			responseBody:     `{"order":{"id":"A-100","status":"shipped"}}`,
+			webhookSecret:    "secret",
+
+			expectRes: "Order A-100 is shipped.",
+		},
+		{
+			name: "raw response without template",
+
+			responseStatus: http.StatusOK,
+			responseBody:   "plain text result",
+
+			expectRes: "plain text result",
+		},
+		{
+			name: "error status",
+
+			responseStatus: http.StatusInternalServerError,
+			responseBody:   "boom",
+
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			var gotBody []byte
+			var gotHeader http.Header
+			server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotBody, _ = io.ReadAll(r.Body)
+				gotHeader = r.Header.Clone()
+				w.WriteHeader(tt.responseStatus)
+				_, _ = w.Write([]byte(tt.responseBody))
+			}))
+			defer server.Close()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+
+			// the test server listens on loopback, so use its client instead of
+			// the ssrf-safe one. the dial-time block list is covered by safehttp's Test_NewClient.
+			h := &customToolHandler{
+				reqHandler: mockReq,
+				httpClient: server.Client(),
+			}
+
+			ctx := context.Background()
+
+			c := &customtool.CustomTool{
+				Identity: identity.Identity{
+					ID:         uuid.FromStringOrNil("3ab92f4e-8d71-11f1-9f18-6e8a0c2e4a33"),
+					CustomerID: customerID,
+				},
+				Name:             "lookup_order",
+				URL:              server.URL + "/orders",
+				Headers:          map[string]string{"Authorization": "Bearer token"},
+				Timeout:          3000,
+				ResponseTemplate: tt.responseTemplate,
+			}
+			ac := &aicall.AIcall{
+				Identity: identity.Identity{
+					ID:         aicallID,
+					CustomerID: customerID,
+				},
+			}
+
+			mockReq.EXPECT().CustomerV1CustomerGet(ctx, customerID).Return(&cscustomer.Customer{WebhookSecret: tt.webhookSecret}, nil)
+
+			res, err := h.Execute(ctx, c, ac, "call_1", `{"order_number":"A-100"}`)
+			if tt.expectErr {
+				if err == nil {
+					t.Errorf("Wrong match. expect: error, got: ok")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if res != tt.expectRes {
+				t.Errorf("Wrong match.\nexpect: %s\ngot: %s", tt.expectRes, res)
+			}
+
+			req := &executeRequest{}
+			if errUnmarshal := json.Unmarshal(gotBody, req); errUnmarshal != nil {
+				t.Fatalf("Could not unmarshal the request body. err: %v", errUnmarshal)
+			}
+			if req.AIcallID != aicallID || req.ToolCallID != "call_1" || req.Arguments["order_number"] != "A-100" {
+				t.Errorf("Wrong match. got: %v", req)
+			}
+
+			if gotHeader.Get("Authorization") != "Bearer token" {
+				t.Errorf("Wrong match. expect: Bearer token, got: %s", gotHeader.Get("Authorization"))
+			}
+
+			expectSignature := ""
+			if tt.webhookSecret != "" {
+				expectSignature = computeSignature(tt.webhookSecret, gotBody)
+			}
+			if gotHeader.Get(signatureHeader) != expectSignature {
+				t.Errorf("Wrong match. expect: %s, got: %s", expectSignature, gotHeader.Get(signatureHeader))
+			}
+		})
+	}
+}
+
+func Test_renderResponse(t *testing.T) {
+	tests := []struct {
+		name string
+
+		responseTemplate string
+		data             string
+
+		expectRes string
+	}{
+		{"json fields", "{{.status}}", `{"status":"ok"}`, "ok"},
+		{"json array", "{{range .}}{{.}},{{end}}", `["a","b"]`, "a,b,"},
+		{"non json body", "result: {{.}}", "hello", "result: hello"},
+		{"no template", "", `{"status":"ok"}`, `{"status":"ok"}`},
+		{"truncated", "", strings.Repeat("a", maxResultLength+10), strings.Repeat("a", maxResultLength)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := renderResponse(tt.responseTemplate, []byte(tt.data))
+			if err != nil {
+				t.Fatalf("Wrong match. expect: ok, got: %v", err)
+			}
+			if res != tt.expectRes {
+				t.Errorf("Wrong match.\nexpect: %s\ngot: %s", tt.expectRes, res)
+			}
+		})
+	}
+}
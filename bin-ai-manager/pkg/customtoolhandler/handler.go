@@ -0,0 +1,259 @@
+package customtoolhandler
+
+import (
+	"context"
+	stderrors "errors"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	cerrors "monorepo/bin-common-handler/models/errors"
+	"monorepo/bin-common-handler/models/identity"
+	commonoutline "monorepo/bin-common-handler/models/outline"
+
+	"monorepo/bin-ai-manager/models/customtool"
+	"monorepo/bin-ai-manager/models/mcpserver"
+	"monorepo/bin-ai-manager/models/tool"
+	"monorepo/bin-ai-manager/pkg/dbhandler"
+)
+
+// Create creates a new custom tool record.
+func (h *customToolHandler) Create(
+	ctx context.Context,
+	customerID uuid.UUID,
+	name string,
+	detail string,
+	description string,
+	parameters map[string]any,
+	url string,
+	headers map[string]string,
+	timeout int,
+	responseTemplate string,
+) (*customtool.CustomTool, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":        "Create",
+		"customer_id": customerID,
+		"name":        name,
+	})
+
+	if err := validateCustomTool(name, description, parameters, url, headers, timeout, responseTemplate); err != nil {
+		return nil, cerrors.InvalidArgument(commonoutline.ServiceNameAIManager, "INVALID_CUSTOM_TOOL", err.Error()).Wrap(err)
+	}
+
+	if err := h.validateNameAvailable(ctx, customerID, uuid.Nil, name); err != nil {
+		return nil, err
+	}
+
+	if timeout == 0 {
+		timeout = customtool.TimeoutDefault
+	}
+
+	c := &customtool.CustomTool{
+		Identity: identity.Identity{
+			ID:         h.utilHandler.UUIDCreate(),
+			CustomerID: customerID,
+		},
+		Name:             name,
+		Detail:           detail,
+		Description:      description,
+		Parameters:       parameters,
+		URL:              url,
+		Headers:          headers,
+		Timeout:          timeout,
+		ResponseTemplate: responseTemplate,
+	}
+
+	if err := h.db.CustomToolCreate(ctx, c); err != nil {
+		return nil, errors.Wrapf(err, "could not create custom tool")
+	}
+
+	res, err := h.db.CustomToolGet(ctx, c.ID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get created custom tool")
+	}
+	log.WithField("custom_tool", res).Debugf("Created custom tool. custom_tool_id: %s", res.ID)
+	h.notifyHandler.PublishWebhookEvent(ctx, res.CustomerID, customtool.EventTypeCreated, res)
+
+	return res, nil
+}
+
+// Get returns custom tool.
+func (h *customToolHandler) Get(ctx context.Context, id uuid.UUID) (*customtool.CustomTool, error) {
+	res, err := h.db.CustomToolGet(ctx, id)
+	if err != nil {
+		if stderrors.Is(err, dbhandler.ErrNotFound) {
+			return nil, cerrors.NotFound(
+				commonoutline.ServiceNameAIManager,
+				"CUSTOM_TOOL_NOT_FOUND",
+				"The custom tool was not found.",
+			).Wrap(err)
+		}
+		return nil, errors.Wrapf(err, "could not get custom tool")
+	}
+
+	return res, nil
+}
+
+// List returns list of custom tools.
+func (h *customToolHandler) List(ctx context.Context, size uint64, token string, filters map[customtool.Field]any) ([]*customtool.CustomTool, error) {
+	res, err := h.db.CustomToolList(ctx, size, token, filters)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not list custom tools")
+	}
+
+	return res, nil
+}
+
+// GetByName returns the customer's active custom tool with the given name.
+func (h *customToolHandler) GetByName(ctx context.Context, customerID uuid.UUID, name tool.ToolName) (*customtool.CustomTool, error) {
+	filters := map[customtool.Field]any{
+		customtool.FieldCustomerID: customerID,
+		customtool.FieldName:       string(name),
+		customtool.FieldDeleted:    false,
+	}
+
+	tmp, err := h.db.CustomToolList(ctx, 1, "", filters)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get custom tool by name")
+	}
+
+	if len(tmp) == 0 {
+		return nil, cerrors.NotFound(
+			commonoutline.ServiceNameAIManager,
+			"CUSTOM_TOOL_NOT_FOUND",
+			"The custom tool was not found.",
+		)
+	}
+
+	return tmp[0], nil
+}
+
+// Delete deletes the custom tool.
+func (h *customToolHandler) Delete(ctx context.Context, id uuid.UUID) (*customtool.CustomTool, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":           "Delete",
+		"custom_tool_id": id,
+	})
+
+	if err := h.db.CustomToolDelete(ctx, id); err != nil {
+		return nil, errors.Wrapf(err, "could not delete custom tool")
+	}
+
+	res, err := h.db.CustomToolGet(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get deleted custom tool")
+	}
+	log.WithField("custom_tool", res).Debugf("Deleted custom tool. custom_tool_id: %s", res.ID)
+	h.notifyHandler.PublishWebhookEvent(ctx, res.CustomerID, customtool.EventTypeDeleted, res)
+
+	return res, nil
+}
+
+// Update updates the custom tool.
+func (h *customToolHandler) Update(
+	ctx context.Context,
+	id uuid.UUID,
+	name string,
+	detail string,
+	description string,
+	parameters map[string]any,
+	url string,
+	headers map[string]string,
+	timeout int,
+	responseTemplate string,
+) (*customtool.CustomTool, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":           "Update",
+		"custom_tool_id": id,
+	})
+
+	if err := validateCustomTool(name, description, parameters, url, headers, timeout, responseTemplate); err != nil {
+		return nil, cerrors.InvalidArgument(commonoutline.ServiceNameAIManager, "INVALID_CUSTOM_TOOL", err.Error()).Wrap(err)
+	}
+
+	c, err := h.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.Name != name {
+		if errName := h.validateNameAvailable(ctx, c.CustomerID, c.ID, name); errName != nil {
+			return nil, errName
+		}
+	}
+
+	if timeout == 0 {
+		timeout = customtool.TimeoutDefault
+	}
+
+	fields := map[customtool.Field]any{
+		customtool.FieldName:             name,
+		customtool.FieldDetail:           detail,
+		customtool.FieldDescription:      description,
+		customtool.FieldParameters:       parameters,
+		customtool.FieldURL:              url,
+		customtool.FieldHeaders:          headers,
+		customtool.FieldTimeout:          timeout,
+		customtool.FieldResponseTemplate: responseTemplate,
+	}
+
+	if err := h.db.CustomToolUpdate(ctx, id, fields); err != nil {
+		return nil, errors.Wrapf(err, "could not update custom tool")
+	}
+
+	res, err := h.db.CustomToolGet(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get updated custom tool")
+	}
+	log.WithField("custom_tool", res).Debugf("Updated custom tool. custom_tool_id: %s", res.ID)
+	h.notifyHandler.PublishWebhookEvent(ctx, res.CustomerID, customtool.EventTypeUpdated, res)
+
+	return res, nil
+}
+
+// validateNameAvailable returns an AlreadyExists error if the customer
+// already owns another active custom tool or an MCP server with the given
+// name. Names are the key AI.ToolNames refers to, so they must be unique per
+// customer.
+func (h *customToolHandler) validateNameAvailable(ctx context.Context, customerID uuid.UUID, exceptID uuid.UUID, name string) error {
+	filters := map[customtool.Field]any{
+		customtool.FieldCustomerID: customerID,
+		customtool.FieldName:       name,
+		customtool.FieldDeleted:    false,
+	}
+
+	tmp, err := h.db.CustomToolList(ctx, 1, "", filters)
+	if err != nil {
+		return errors.Wrapf(err, "could not check the custom tool name")
+	}
+
+	if len(tmp) > 0 && tmp[0].ID != exceptID {
+		return cerrors.AlreadyExists(
+			commonoutline.ServiceNameAIManager,
+			"CUSTOM_TOOL_NAME_EXISTS",
+			"A custom tool with the same name already exists.",
+		)
+	}
+
+	mcpServerFilters := map[mcpserver.Field]any{
+		mcpserver.FieldCustomerID: customerID,
+		mcpserver.FieldName:       name,
+		mcpserver.FieldDeleted:    false,
+	}
+
+	mcpServers, err := h.db.MCPServerList(ctx, 1, "", mcpServerFilters)
+	if err != nil {
+		return errors.Wrapf(err, "could not check the mcp server name")
+	}
+
+	if len(mcpServers) > 0 {
+		return cerrors.AlreadyExists(
+			commonoutline.ServiceNameAIManager,
+			"CUSTOM_TOOL_NAME_EXISTS",
+			"An MCP server with the same name already exists.",
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,485 @@
+package customtoolhandler
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	"monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/notifyhandler"
+	"monorepo/bin-common-handler/pkg/requesthandler"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+
+	"monorepo/bin-ai-manager/models/customtool"
+	"monorepo/bin-ai-manager/models/mcpserver"
+	"monorepo/bin-ai-manager/pkg/dbhandler"
+)
+
+func Test_Create(t *testing.T) {
+	customerID := uuid.FromStringOrNil("5b0a7f2e-8d70-11f1-a5c1-2e4f6a8b0c11")
+	customToolID := uuid.FromStringOrNil("5b3d9c4a-8d70-11f1-8f2e-4a6c8e0a2c22")
+
+	tests := []struct {
+		name string
+
+		customerID       uuid.UUID
+		toolName         string
+		detail           string
+		description      string
+		parameters       map[string]any
+		url              string
+		headers          map[string]string
+		timeout          int
+		responseTemplate string
+
+		responseUUID       uuid.UUID
+		responseCustomTool *customtool.CustomTool
+
+		expectCustomTool *customtool.CustomTool
+	}{
+		{
+			name: "normal",
+
+			customerID:  customerID,
+			toolName:    "lookup_order",
+			detail:      "order lookup",
+			description: "Look up an order by its number.",
+			parameters: map[string]any{
+				"type": "object",
+			},
+			url: "https://example.com/orders",
+			headers: map[string]string{
+				"Authorization": "Bearer token",
+			},
+			timeout:          3000,
+			responseTemplate: "Order status: {{.status}}",
+
+			responseUUID: customToolID,
+			responseCustomTool: &customtool.CustomTool{
+				Identity: identity.Identity{
+					ID:         customToolID,
+					CustomerID: customerID,
+				},
+			},
+
+			expectCustomTool: &customtool.CustomTool{
+				Identity: identity.Identity{
+					ID:         customToolID,
+					CustomerID: customerID,
+				},
+				Name:        "lookup_order",
+				Detail:      "order lookup",
+				Description: "Look up an order by its number.",
+				Parameters: map[string]any{
+					"type": "object",
+				},
+				URL: "https://example.com/orders",
+				Headers: map[string]string{
+					"Authorization": "Bearer token",
+				},
+				Timeout:          3000,
+				ResponseTemplate: "Order status: {{.status}}",
+			},
+		},
+		{
+			name: "default timeout",
+
+			customerID:  customerID,
+			toolName:    "ping",
+			description: "Ping the backend.",
+			url:         "https://example.com/ping",
+
+			responseUUID: customToolID,
+			responseCustomTool: &customtool.CustomTool{
+				Identity: identity.Identity{
+					ID:         customToolID,
+					CustomerID: customerID,
+				},
+			},
+
+			expectCustomTool: &customtool.CustomTool{
+				Identity: identity.Identity{
+					ID:         customToolID,
+					CustomerID: customerID,
+				},
+				Name:        "ping",
+				Description: "Ping the backend.",
+				URL:         "https://example.com/ping",
+				Timeout:     customtool.TimeoutDefault,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+
+			h := &customToolHandler{
+				utilHandler:   mockUtil,
+				reqHandler:    mockReq,
+				notifyHandler: mockNotify,
+				db:            mockDB,
+			}
+
+			ctx := context.Background()
+
+			mockDB.EXPECT().CustomToolList(ctx, uint64(1), "", map[customtool.Field]any{
+				customtool.FieldCustomerID: tt.customerID,
+				customtool.FieldName:       tt.toolName,
+				customtool.FieldDeleted:    false,
+			}).Return([]*customtool.CustomTool{}, nil)
+			mockDB.EXPECT().MCPServerList(ctx, uint64(1), "", map[mcpserver.Field]any{
+				mcpserver.FieldCustomerID: tt.customerID,
+				mcpserver.FieldName:       tt.toolName,
+				mcpserver.FieldDeleted:    false,
+			}).Return([]*mcpserver.MCPServer{}, nil)
+			mockUtil.EXPECT().UUIDCreate().Return(tt.responseUUID)
+			mockDB.EXPECT().CustomToolCreate(ctx, tt.expectCustomTool).Return(nil)
+			mockDB.EXPECT().CustomToolGet(ctx, tt.responseUUID).Return(tt.responseCustomTool, nil)
+			mockNotify.EXPECT().PublishWebhookEvent(ctx, tt.responseCustomTool.CustomerID, customtool.EventTypeCreated, tt.responseCustomTool)
+
+			res, err := h.Create(ctx, tt.customerID, tt.toolName, tt.detail, tt.description, tt.parameters, tt.url, tt.headers, tt.timeout, tt.responseTemplate)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.responseCustomTool) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.responseCustomTool, res)
+			}
+		})
+	}
+}
+
+func Test_Create_error(t *testing.T) {
+	customerID := uuid.FromStringOrNil("8e1b3d5f-8d70-11f1-b2a4-6c8e0a2c4e33")
+
+	tests := []struct {
+		name string
+
+		toolName string
+		url      string
+
+		responseList      []*customtool.CustomTool
+		responseMCPServer []*mcpserver.MCPServer
+	}{
+		{
+			name: "builtin tool name",
+
+			toolName: "connect_call",
+			url:      "https://example.com/orders",
+		},
+		{
+			name: "private url",
+
+			toolName: "lookup_order",
+			url:      "https://10.0.0.1/orders",
+		},
+		{
+			name: "name already exists",
+
+			toolName: "lookup_order",
+			url:      "https://example.com/orders",
+
+			responseList: []*customtool.CustomTool{
+				{
+					Identity: identity.Identity{
+						ID: uuid.FromStringOrNil("8e4a6c8e-8d70-11f1-9d3b-8e0a2c4e6a44"),
+					},
+				},
+			},
+		},
+		{
+			name: "mcp server with the same name",
+
+			toolName: "crm",
+			url:      "https://example.com/orders",
+
+			responseList: []*customtool.CustomTool{},
+			responseMCPServer: []*mcpserver.MCPServer{
+				{
+					Identity: identity.Identity{
+						ID: uuid.FromStringOrNil("8e6b8d0f-8d70-11f1-a4e1-0a2c4e6a8c45"),
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+
+			h := &customToolHandler{
+				utilHandler: mockUtil,
+				db:          mockDB,
+			}
+
+			ctx := context.Background()
+
+			if tt.responseList != nil {
+				mockDB.EXPECT().CustomToolList(ctx, uint64(1), "", gomock.Any()).Return(tt.responseList, nil)
+			}
+			if tt.responseMCPServer != nil {
+				mockDB.EXPECT().MCPServerList(ctx, uint64(1), "", gomock.Any()).Return(tt.responseMCPServer, nil)
+			}
+
+			_, err := h.Create(ctx, customerID, tt.toolName, "", "Look up an order.", nil, tt.url, nil, 0, "")
+			if err == nil {
+				t.Errorf("Wrong match. expect: error, got: ok")
+			}
+		})
+	}
+}
+
+func Test_Update(t *testing.T) {
+	customerID := uuid.FromStringOrNil("b7c9e1a3-8d70-11f1-a6e5-0a2c4e6a8c55")
+	customToolID := uuid.FromStringOrNil("b7f6a8c0-8d70-11f1-8c17-2c4e6a8c0e66")
+
+	tests := []struct {
+		name string
+
+		id               uuid.UUID
+		toolName         string
+		description      string
+		url              string
+		timeout          int
+		responseTemplate string
+
+		responseCurrent    *customtool.CustomTool
+		responseCustomTool *customtool.CustomTool
+
+		expectCheckName bool
+		expectFields    map[customtool.Field]any
+	}{
+		{
+			name: "same name",
+
+			id:               customToolID,
+			toolName:         "lookup_order",
+			description:      "Look up an order.",
+			url:              "https://example.com/v2/orders",
+			timeout:          1000,
+			responseTemplate: "{{.status}}",
+
+			responseCurrent: &customtool.CustomTool{
+				Identity: identity.Identity{
+					ID:         customToolID,
+					CustomerID: customerID,
+				},
+				Name: "lookup_order",
+			},
+			responseCustomTool: &customtool.CustomTool{
+				Identity: identity.Identity{
+					ID:         customToolID,
+					CustomerID: customerID,
+				},
+			},
+
+			expectFields: map[customtool.Field]any{
+				customtool.FieldName:             "lookup_order",
+				customtool.FieldDetail:           "",
+				customtool.FieldDescription:      "Look up an order.",
+				customtool.FieldParameters:       map[string]any(nil),
+				customtool.FieldURL:              "https://example.com/v2/orders",
+				customtool.FieldHeaders:          map[string]string(nil),
+				customtool.FieldTimeout:          1000,
+				customtool.FieldResponseTemplate: "{{.status}}",
+			},
+		},
+		{
+			name: "renamed",
+
+			id:          customToolID,
+			toolName:    "find_order",
+			description: "Look up an order.",
+			url:         "https://example.com/orders",
+
+			responseCurrent: &customtool.CustomTool{
+				Identity: identity.Identity{
+					ID:         customToolID,
+					CustomerID: customerID,
+				},
+				Name: "lookup_order",
+			},
+			responseCustomTool: &customtool.CustomTool{
+				Identity: identity.Identity{
+					ID:         customToolID,
+					CustomerID: customerID,
+				},
+			},
+
+			expectCheckName: true,
+			expectFields: map[customtool.Field]any{
+				customtool.FieldName:             "find_order",
+				customtool.FieldDetail:           "",
+				customtool.FieldDescription:      "Look up an order.",
+				customtool.FieldParameters:       map[string]any(nil),
+				customtool.FieldURL:              "https://example.com/orders",
+				customtool.FieldHeaders:          map[string]string(nil),
+				customtool.FieldTimeout:          customtool.TimeoutDefault,
+				customtool.FieldResponseTemplate: "",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+
+			h := &customToolHandler{
+				notifyHandler: mockNotify,
+				db:            mockDB,
+			}
+
+			ctx := context.Background()
+
+			mockDB.EXPECT().CustomToolGet(ctx, tt.id).Return(tt.responseCurrent, nil)
+			if tt.expectCheckName {
+				mockDB.EXPECT().CustomToolList(ctx, uint64(1), "", map[customtool.Field]any{
+					customtool.FieldCustomerID: customerID,
+					customtool.FieldName:       tt.toolName,
+					customtool.FieldDeleted:    false,
+				}).Return([]*customtool.CustomTool{}, nil)
+				mockDB.EXPECT().MCPServerList(ctx, uint64(1), "", map[mcpserver.Field]any{
+					mcpserver.FieldCustomerID: customerID,
+					mcpserver.FieldName:       tt.toolName,
+					mcpserver.FieldDeleted:    false,
+				}).Return([]*mcpserver.MCPServer{}, nil)
+			}
+			mockDB.EXPECT().CustomToolUpdate(ctx, tt.id, tt.expectFields).Return(nil)
+			mockDB.EXPECT().CustomToolGet(ctx, tt.id).Return(tt.responseCustomTool, nil)
+			mockNotify.EXPECT().PublishWebhookEvent(ctx, tt.responseCustomTool.CustomerID, customtool.EventTypeUpdated, tt.responseCustomTool)
+
+			res, err := h.Update(ctx, tt.id, tt.toolName, "", tt.description, nil, tt.url, nil, tt.timeout, tt.responseTemplate)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.responseCustomTool) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.responseCustomTool, res)
+			}
+		})
+	}
+}
+
+func Test_Delete(t *testing.T) {
+	customToolID := uuid.FromStringOrNil("e2a4c6e8-8d70-11f1-b9f1-4e6a8c0e2a77")
+
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+	mockDB := dbhandler.NewMockDBHandler(mc)
+
+	h := &customToolHandler{
+		notifyHandler: mockNotify,
+		db:            mockDB,
+	}
+
+	ctx := context.Background()
+
+	responseCustomTool := &customtool.CustomTool{
+		Identity: identity.Identity{
+			ID:         customToolID,
+			CustomerID: uuid.FromStringOrNil("e2d1e30a-8d70-11f1-8a4c-6a8c0e2a4c88"),
+		},
+	}
+
+	mockDB.EXPECT().CustomToolDelete(ctx, customToolID).Return(nil)
+	mockDB.EXPECT().CustomToolGet(ctx, customToolID).Return(responseCustomTool, nil)
+	mockNotify.EXPECT().PublishWebhookEvent(ctx, responseCustomTool.CustomerID, customtool.EventTypeDeleted, responseCustomTool)
+
+	res, err := h.Delete(ctx, customToolID)
+	if err != nil {
+		t.Errorf("Wrong match. expect: ok, got: %v", err)
+	}
+
+	if !reflect.DeepEqual(res, responseCustomTool) {
+		t.Errorf("Wrong match.\nexpect: %v\ngot: %v", responseCustomTool, res)
+	}
+}
+
+func Test_GetByName(t *testing.T) {
+	customerID := uuid.FromStringOrNil("0f3b5d7f-8d71-11f1-a7c3-8c0e2a4c6e99")
+
+	tests := []struct {
+		name string
+
+		responseList []*customtool.CustomTool
+
+		expectErr bool
+	}{
+		{
+			name: "found",
+
+			responseList: []*customtool.CustomTool{
+				{
+					Identity: identity.Identity{
+						ID:         uuid.FromStringOrNil("0f6c8e0a-8d71-11f1-9b5d-0e2a4c6e8a00"),
+						CustomerID: customerID,
+					},
+					Name: "lookup_order",
+				},
+			},
+		},
+		{
+			name: "not found",
+
+			responseList: []*customtool.CustomTool{},
+			expectErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+
+			h := &customToolHandler{
+				db: mockDB,
+			}
+
+			ctx := context.Background()
+
+			mockDB.EXPECT().CustomToolList(ctx, uint64(1), "", map[customtool.Field]any{
+				customtool.FieldCustomerID: customerID,
+				customtool.FieldName:       "lookup_order",
+				customtool.FieldDeleted:    false,
+			}).Return(tt.responseList, nil)
+
+			res, err := h.GetByName(ctx, customerID, "lookup_order")
+			if tt.expectErr {
+				if err == nil {
+					t.Errorf("Wrong match. expect: error, got: ok")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.responseList[0]) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.responseList[0], res)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package customtoolhandler
+
+//go:generate mockgen -package customtoolhandler -destination ./mock_main.go -source main.go -build_flags=-mod=mod
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"monorepo/bin-common-handler/pkg/notifyhandler"
+	"monorepo/bin-common-handler/pkg/requesthandler"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+
+	"monorepo/bin-ai-manager/models/aicall"
+	"monorepo/bin-ai-manager/models/customtool"
+	"monorepo/bin-ai-manager/models/tool"
+	"monorepo/bin-ai-manager/pkg/dbhandler"
+	"monorepo/bin-ai-manager/pkg/safehttp"
+)
+
+// CustomToolHandler provides CRUD operations for CustomTool resources and
+// executes them on behalf of an aicall.
+type CustomToolHandler interface {
+	Create(
+		ctx context.Context,
+		customerID uuid.UUID,
+		name string,
+		detail string,
+		description string,
+		parameters map[string]any,
+		url string,
+		headers map[string]string,
+		timeout int,
+		responseTemplate string,
+	) (*customtool.CustomTool, error)
+	Get(ctx context.Context, id uuid.UUID) (*customtool.CustomTool, error)
+	List(ctx context.Context, size uint64, token string, filters map[customtool.Field]any) ([]*customtool.CustomTool, error)
+	Delete(ctx context.Context, id uuid.UUID) (*customtool.CustomTool, error)
+	Update(
+		ctx context.Context,
+		id uuid.UUID,
+		name string,
+		detail string,
+		description string,
+		parameters map[string]any,
+		url string,
+		headers map[string]string,
+		timeout int,
+		responseTemplate string,
+	) (*customtool.CustomTool, error)
+
+	GetByName(ctx context.Context, customerID uuid.UUID, name tool.ToolName) (*customtool.CustomTool, error)
+	Execute(ctx context.Context, c *customtool.CustomTool, ac *aicall.AIcall, toolCallID string, arguments string) (string, error)
+}
+
+type customToolHandler struct {
+	utilHandler   utilhandler.UtilHandler
+	reqHandler    requesthandler.RequestHandler
+	notifyHandler notifyhandler.NotifyHandler
+	db            dbhandler.DBHandler
+
+	httpClient *http.Client
+}
+
+// NewCustomToolHandler creates a new CustomToolHandler
+func NewCustomToolHandler(
+	reqHandler requesthandler.RequestHandler,
+	notifyHandler notifyhandler.NotifyHandler,
+	db dbhandler.DBHandler,
+) CustomToolHandler {
+	return &customToolHandler{
+		utilHandler:   utilhandler.NewUtilHandler(),
+		reqHandler:    reqHandler,
+		notifyHandler: notifyHandler,
+		db:            db,
+
+		httpClient: safehttp.NewClient(time.Duration(customtool.TimeoutMax) * time.Millisecond),
+	}
+}
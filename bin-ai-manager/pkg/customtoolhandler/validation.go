@@ -0,0 +1,124 @@
+package customtoolhandler
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"monorepo/bin-ai-manager/models/customtool"
+	"monorepo/bin-ai-manager/models/mcpserver"
+	"monorepo/bin-ai-manager/models/tool"
+	"monorepo/bin-ai-manager/pkg/safehttp"
+)
+
+// regName matches the function names accepted by every supported LLM
+// provider: a letter followed by letters, digits or underscores, 64 chars max.
+var regName = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]{0,63}$`)
+
+// regHeaderName matches an HTTP header field name (RFC 7230 token).
+var regHeaderName = regexp.MustCompile("^[!#$%&'*+.^_`|~0-9A-Za-z-]+$")
+
+// reservedHeaders are set by ai-manager on every request and can not be
+// overridden by the customer. Keys are in canonical form.
+var reservedHeaders = map[string]bool{
+	"Host":              true,
+	"Content-Length":    true,
+	"Content-Type":      true,
+	"Transfer-Encoding": true,
+	"Connection":        true,
+
+	http.CanonicalHeaderKey(signatureHeader): true,
+}
+
+const (
+	maxDescriptionLength      = 1024
+	maxResponseTemplateLength = 4096
+	maxHeaders                = 20
+)
+
+// isBuiltinToolName reports whether name collides with a built-in tool or
+// the "all" selector. A custom tool with such a name could never be
+// distinguished from the built-in one in AI.ToolNames.
+func isBuiltinToolName(name string) bool {
+	if tool.ToolName(name) == tool.ToolNameAll {
+		return true
+	}
+	for _, n := range tool.AllToolNames {
+		if string(n) == name {
+			return true
+		}
+	}
+	for _, n := range tool.AllInsightToolNames {
+		if string(n) == name {
+			return true
+		}
+	}
+	return false
+}
+
+// validateCustomTool checks the static constraints of a custom tool.
+// Name uniqueness within the customer is checked separately because it
+// needs the database.
+func validateCustomTool(
+	name string,
+	description string,
+	parameters map[string]any,
+	url string,
+	headers map[string]string,
+	timeout int,
+	responseTemplate string,
+) error {
+	if !regName.MatchString(name) {
+		return fmt.Errorf("invalid name: %q. must start with a letter and contain only letters, digits and underscores (max 64)", name)
+	}
+	if isBuiltinToolName(name) {
+		return fmt.Errorf("invalid name: %q is reserved by a built-in tool", name)
+	}
+	if strings.Contains(name, mcpserver.ToolNameSeparator) {
+		return fmt.Errorf("invalid name: %q. %q is reserved for MCP server tools", name, mcpserver.ToolNameSeparator)
+	}
+
+	if description == "" {
+		return fmt.Errorf("description is required")
+	}
+	if len(description) > maxDescriptionLength {
+		return fmt.Errorf("description is too long. max: %d", maxDescriptionLength)
+	}
+
+	if parameters != nil {
+		if t, ok := parameters["type"]; !ok || t != "object" {
+			return fmt.Errorf("invalid parameters: the top-level json schema type must be \"object\"")
+		}
+	}
+
+	if err := safehttp.ValidateURL(url); err != nil {
+		return fmt.Errorf("invalid url: %w", err)
+	}
+
+	if len(headers) > maxHeaders {
+		return fmt.Errorf("too many headers. max: %d", maxHeaders)
+	}
+	for k := range headers {
+		if !regHeaderName.MatchString(k) {
+			return fmt.Errorf("invalid header name: %q", k)
+		}
+		if reservedHeaders[http.CanonicalHeaderKey(k)] {
+			return fmt.Errorf("header %q is reserved", k)
+		}
+	}
+
+	if timeout < 0 || timeout > customtool.TimeoutMax {
+		return fmt.Errorf("invalid timeout: %d. must be between 0 and %d milliseconds", timeout, customtool.TimeoutMax)
+	}
+
+	if len(responseTemplate) > maxResponseTemplateLength {
+		return fmt.Errorf("response_template is too long. max: %d", maxResponseTemplateLength)
+	}
+	if _, err := template.New("response").Parse(responseTemplate); err != nil {
+		return fmt.Errorf("invalid response_template: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,154 @@
+package customtoolhandler
+
+import (
+	"strings"
+	"testing"
+)
+
+func Test_validateCustomTool(t *testing.T) {
+	tests := []struct {
+		name string
+
+		toolName         string
+		description      string
+		parameters       map[string]any
+		url              string
+		headers          map[string]string
+		timeout          int
+		responseTemplate string
+
+		expectErr bool
+	}{
+		{
+			name: "normal",
+
+			toolName:    "lookup_order",
+			description: "Look up an order.",
+			parameters: map[string]any{
+				"type": "object",
+			},
+			url: "https://example.com/orders",
+			headers: map[string]string{
+				"Authorization": "Bearer token",
+				"X-Api-Key":     "key",
+			},
+			timeout:          3000,
+			responseTemplate: "Order status: {{.status}}",
+		},
+		{
+			name: "invalid name",
+
+			toolName:    "lookup order",
+			description: "Look up an order.",
+			url:         "https://example.com/orders",
+			expectErr:   true,
+		},
+		{
+			name: "name too long",
+
+			toolName:    "a" + strings.Repeat("b", 64),
+			description: "Look up an order.",
+			url:         "https://example.com/orders",
+			expectErr:   true,
+		},
+		{
+			name: "builtin name",
+
+			toolName:    "send_email",
+			description: "Look up an order.",
+			url:         "https://example.com/orders",
+			expectErr:   true,
+		},
+		{
+			name: "mcp tool separator",
+
+			toolName:    "crm__lookup_order",
+			description: "Look up an order.",
+			url:         "https://example.com/orders",
+			expectErr:   true,
+		},
+		{
+			name: "all selector",
+
+			toolName:    "all",
+			description: "Look up an order.",
+			url:         "https://example.com/orders",
+			expectErr:   true,
+		},
+		{
+			name: "empty description",
+
+			toolName:  "lookup_order",
+			url:       "https://example.com/orders",
+			expectErr: true,
+		},
+		{
+			name: "parameters not an object",
+
+			toolName:    "lookup_order",
+			description: "Look up an order.",
+			parameters: map[string]any{
+				"type": "string",
+			},
+			url:       "https://example.com/orders",
+			expectErr: true,
+		},
+		{
+			name: "http url",
+
+			toolName:    "lookup_order",
+			description: "Look up an order.",
+			url:         "http://example.com/orders",
+			expectErr:   true,
+		},
+		{
+			name: "reserved header",
+
+			toolName:    "lookup_order",
+			description: "Look up an order.",
+			url:         "https://example.com/orders",
+			headers: map[string]string{
+				"x-voipbin-signature": "sha256=forged",
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid header name",
+
+			toolName:    "lookup_order",
+			description: "Look up an order.",
+			url:         "https://example.com/orders",
+			headers: map[string]string{
+				"Bad Header": "value",
+			},
+			expectErr: true,
+		},
+		{
+			name: "timeout too long",
+
+			toolName:    "lookup_order",
+			description: "Look up an order.",
+			url:         "https://example.com/orders",
+			timeout:     30001,
+			expectErr:   true,
+		},
+		{
+			name: "invalid response template",
+
+			toolName:         "lookup_order",
+			description:      "Look up an order.",
+			url:              "https://example.com/orders",
+			responseTemplate: "{{.status",
+			expectErr:        true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateCustomTool(tt.toolName, tt.description, tt.parameters, tt.url, tt.headers, tt.timeout, tt.responseTemplate)
+			if (err != nil) != tt.expectErr {
+				t.Errorf("Wrong match. expect error: %v, got: %v", tt.expectErr, err)
+			}
+		})
+	}
+}
@@ -14,6 +14,9 @@ import (
 	gomock "go.uber.org/mock/gomock"
 
 	"monorepo/bin-ai-manager/models/ai"
+	"monorepo/bin-ai-manager/models/guardrail"
+	"monorepo/bin-ai-manager/models/redaction"
+	"monorepo/bin-ai-manager/models/responsecache"
 	"monorepo/bin-ai-manager/pkg/cachehandler"
 )
 
@@ -44,10 +47,24 @@ func Test_AICreate(t *testing.T) {
 					"key2": 2.0,
 				},
 				EngineKey:  "test engine key",
+				EngineFallbacks: []ai.EngineFallback{
+					{EngineModel: ai.EngineModelGeminiGemini2Dot5Flash, EngineKey: "fallback engine key"},
+				},
 				InitPrompt: "test init prompt",
 				TTSType:    ai.TTSTypeCartesia,
 				TTSVoiceID: "test tts voice id",
 				STTType:    ai.STTTypeElevenLabs,
+				Redaction: &redaction.Config{
+					EntityTypes: []redaction.EntityType{redaction.EntityTypeCreditCard},
+					Mode:        redaction.ModeMask,
+				},
+				Guardrail: &guardrail.Config{
+					ForbiddenPhrases: []string{"guaranteed return"},
+				},
+				ResponseCache: &responsecache.Config{
+					Enabled:   true,
+					Threshold: 0.9,
+				},
 			},
 
 			responseCurTime: curTime,
@@ -63,10 +80,24 @@ func Test_AICreate(t *testing.T) {
 					"key2": 2.0,
 				},
 				EngineKey:  "test engine key",
+				EngineFallbacks: []ai.EngineFallback{
+					{EngineModel: ai.EngineModelGeminiGemini2Dot5Flash, EngineKey: "fallback engine key"},
+				},
 				InitPrompt: "test init prompt",
 				TTSType:    ai.TTSTypeCartesia,
 				TTSVoiceID: "test tts voice id",
 				STTType:    ai.STTTypeElevenLabs,
+				Redaction: &redaction.Config{
+					EntityTypes: []redaction.EntityType{redaction.EntityTypeCreditCard},
+					Mode:        redaction.ModeMask,
+				},
+				Guardrail: &guardrail.Config{
+					ForbiddenPhrases: []string{"guaranteed return"},
+				},
+				ResponseCache: &responsecache.Config{
+					Enabled:   true,
+					Threshold: 0.9,
+				},
 
 				TMCreate: curTime,
 				TMUpdate: nil,
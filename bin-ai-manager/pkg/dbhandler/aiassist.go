@@ -0,0 +1,302 @@
+package dbhandler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	uuid "github.com/gofrs/uuid"
+
+	commondatabasehandler "monorepo/bin-common-handler/pkg/databasehandler"
+
+	"monorepo/bin-ai-manager/models/aiassist"
+	"monorepo/bin-ai-manager/models/usage"
+)
+
+const (
+	aiassistTable = "ai_aiassists"
+)
+
+// AIAssistCreate creates a new aiassist record.
+func (h *handler) AIAssistCreate(ctx context.Context, a *aiassist.AIAssist) error {
+	a.TMCreate = h.utilHandler.TimeNow()
+	a.TMUpdate = nil
+	a.TMDelete = nil
+
+	fields, err := commondatabasehandler.PrepareFields(a)
+	if err != nil {
+		return fmt.Errorf("AIAssistCreate: could not prepare fields. err: %v", err)
+	}
+
+	query, args, err := sq.Insert(aiassistTable).SetMap(fields).ToSql()
+	if err != nil {
+		return fmt.Errorf("AIAssistCreate: could not build query. err: %v", err)
+	}
+
+	_, err = h.db.Exec(query, args...)
+	if err != nil {
+		return fmt.Errorf("AIAssistCreate: could not execute query. err: %v", err)
+	}
+
+	return nil
+}
+
+// AIAssistGet returns the aiassist.
+// The aiassist is looked up once per transcript, which the database index covers, so it is not cached.
+func (h *handler) AIAssistGet(ctx context.Context, id uuid.UUID) (*aiassist.AIAssist, error) {
+	cols := commondatabasehandler.GetDBFields(aiassist.AIAssist{})
+
+	query, args, err := sq.Select(cols...).
+		From(aiassistTable).
+		Where(sq.Eq{"id": id.Bytes()}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("AIAssistGet: could not build query. err: %v", err)
+	}
+
+	rows, err := h.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("AIAssistGet: could not query. err: %v", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	if !rows.Next() {
+		return nil, ErrNotFound
+	}
+
+	res := &aiassist.AIAssist{}
+	if err := commondatabasehandler.ScanRow(rows, res); err != nil {
+		return nil, fmt.Errorf("AIAssistGet: could not scan row. err: %v", err)
+	}
+
+	return res, nil
+}
+
+// AIAssistDelete deletes the aiassist.
+func (h *handler) AIAssistDelete(ctx context.Context, id uuid.UUID) error {
+	ts := h.utilHandler.TimeNow()
+
+	query, args, err := sq.Update(aiassistTable).
+		SetMap(map[string]any{
+			"tm_update": ts,
+			"tm_delete": ts,
+		}).
+		Where(sq.Eq{"id": id.Bytes()}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("AIAssistDelete: could not build query. err: %v", err)
+	}
+
+	_, err = h.db.Exec(query, args...)
+	if err != nil {
+		return fmt.Errorf("AIAssistDelete: could not execute. err: %v", err)
+	}
+
+	return nil
+}
+
+// AIAssistList returns a list of aiassists.
+func (h *handler) AIAssistList(ctx context.Context, size uint64, token string, filters map[aiassist.Field]any) ([]*aiassist.AIAssist, error) {
+	if token == "" {
+		token = h.utilHandler.TimeGetCurTime()
+	}
+
+	cols := commondatabasehandler.GetDBFields(aiassist.AIAssist{})
+
+	builder := sq.Select(cols...).
+		From(aiassistTable).
+		Where(sq.Lt{"tm_create": token}).
+		OrderBy("tm_create desc").
+		Limit(size)
+
+	builder, err := commondatabasehandler.ApplyFields(builder, filters)
+	if err != nil {
+		return nil, fmt.Errorf("AIAssistList: could not apply filters. err: %v", err)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("AIAssistList: could not build query. err: %v", err)
+	}
+
+	rows, err := h.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("AIAssistList: could not query. err: %v", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	res := []*aiassist.AIAssist{}
+	for rows.Next() {
+		c := &aiassist.AIAssist{}
+		if err := commondatabasehandler.ScanRow(rows, c); err != nil {
+			return nil, fmt.Errorf("AIAssistList: could not scan row. err: %v", err)
+		}
+		res = append(res, c)
+	}
+
+	return res, nil
+}
+
+// AIAssistUpdate updates the aiassist fields.
+func (h *handler) AIAssistUpdate(ctx context.Context, id uuid.UUID, fields map[aiassist.Field]any) error {
+	updateFields := make(map[string]any)
+	for k, v := range fields {
+		updateFields[string(k)] = v
+	}
+	updateFields["tm_update"] = h.utilHandler.TimeNow()
+
+	preparedFields, err := commondatabasehandler.PrepareFields(updateFields)
+	if err != nil {
+		return fmt.Errorf("AIAssistUpdate: could not prepare fields. err: %v", err)
+	}
+
+	query, args, err := sq.Update(aiassistTable).
+		SetMap(preparedFields).
+		Where(sq.Eq{"id": id.Bytes()}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("AIAssistUpdate: could not build query. err: %v", err)
+	}
+
+	_, err = h.db.Exec(query, args...)
+	if err != nil {
+		return fmt.Errorf("AIAssistUpdate: could not execute. err: %v", err)
+	}
+
+	return nil
+}
+
+// AIAssistAddUsage adds the given LLM usage to the progressing aiassist's usage.
+// The usage of a terminated aiassist has been billed already, so it does not change anymore.
+func (h *handler) AIAssistAddUsage(ctx context.Context, id uuid.UUID, u *usage.Usage) error {
+	query, args, err := sq.Update(aiassistTable).
+		Set("prompt_tokens", sq.Expr("prompt_tokens + ?", u.PromptTokens)).
+		Set("completion_tokens", sq.Expr("completion_tokens + ?", u.CompletionTokens)).
+		Set("cached_tokens", sq.Expr("cached_tokens + ?", u.CachedTokens)).
+		Set("tm_update", h.utilHandler.TimeNow()).
+		Where(sq.Eq{
+			"id":     id.Bytes(),
+			"status": aiassist.StatusProgressing,
+		}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("AIAssistAddUsage: could not build query. err: %v", err)
+	}
+
+	_, err = h.db.Exec(query, args...)
+	if err != nil {
+		return fmt.Errorf("AIAssistAddUsage: could not execute. err: %v", err)
+	}
+
+	return nil
+}
+
+// AIAssistAnalyzeClaim marks an analysis of the aiassist as pending and claims the
+// analysis lease until the given time. The lease is claimed only when the aiassist
+// is progressing and no other pod holds an unexpired lease.
+// Returns false if the lease was not claimed. The pending analysis is then run by
+// the lease holder, see AIAssistAnalyzeRelease.
+func (h *handler) AIAssistAnalyzeClaim(ctx context.Context, id uuid.UUID, leaseUntil *time.Time) (bool, error) {
+	query, args, err := sq.Update(aiassistTable).
+		Set("analyze_pending", true).
+		Where(sq.Eq{
+			"id":     id.Bytes(),
+			"status": aiassist.StatusProgressing,
+		}).
+		ToSql()
+	if err != nil {
+		return false, fmt.Errorf("AIAssistAnalyzeClaim: could not build query. err: %v", err)
+	}
+
+	if _, err := h.db.ExecContext(ctx, query, args...); err != nil {
+		return false, fmt.Errorf("AIAssistAnalyzeClaim: could not mark the pending analysis. err: %v", err)
+	}
+
+	query, args, err = sq.Update(aiassistTable).
+		SetMap(map[string]any{
+			"analyze_pending":  false,
+			"tm_analyze_lease": leaseUntil,
+		}).
+		Where(sq.Eq{
+			"id":     id.Bytes(),
+			"status": aiassist.StatusProgressing,
+		}).
+		Where(sq.Or{
+			sq.Eq{"tm_analyze_lease": nil},
+			sq.LtOrEq{"tm_analyze_lease": h.utilHandler.TimeNow()},
+		}).
+		ToSql()
+	if err != nil {
+		return false, fmt.Errorf("AIAssistAnalyzeClaim: could not build query. err: %v", err)
+	}
+
+	result, err := h.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return false, fmt.Errorf("AIAssistAnalyzeClaim: could not execute. err: %v", err)
+	}
+
+	n, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("AIAssistAnalyzeClaim: could not get rows affected. err: %v", err)
+	}
+
+	return n > 0, nil
+}
+
+// AIAssistAnalyzeRelease releases the aiassist's analysis lease held by the caller.
+// If other analyses were marked pending while the lease was held, the lease is
+// renewed until the given time instead, and the caller runs one more analysis for them.
+// Returns true if the lease was renewed.
+func (h *handler) AIAssistAnalyzeRelease(ctx context.Context, id uuid.UUID, leaseUntil *time.Time) (bool, error) {
+	query, args, err := sq.Update(aiassistTable).
+		Set("tm_analyze_lease", nil).
+		Where(sq.Eq{
+			"id":              id.Bytes(),
+			"analyze_pending": false,
+		}).
+		ToSql()
+	if err != nil {
+		return false, fmt.Errorf("AIAssistAnalyzeRelease: could not build query. err: %v", err)
+	}
+
+	result, err := h.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return false, fmt.Errorf("AIAssistAnalyzeRelease: could not execute. err: %v", err)
+	}
+
+	n, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("AIAssistAnalyzeRelease: could not get rows affected. err: %v", err)
+	}
+	if n > 0 {
+		return false, nil
+	}
+
+	// an analysis is pending. renew the lease for it.
+	query, args, err = sq.Update(aiassistTable).
+		SetMap(map[string]any{
+			"analyze_pending":  false,
+			"tm_analyze_lease": leaseUntil,
+		}).
+		Where(sq.Eq{
+			"id":     id.Bytes(),
+			"status": aiassist.StatusProgressing,
+		}).
+		ToSql()
+	if err != nil {
+		return false, fmt.Errorf("AIAssistAnalyzeRelease: could not build query. err: %v", err)
+	}
+
+	result, err = h.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return false, fmt.Errorf("AIAssistAnalyzeRelease: could not renew the lease. err: %v", err)
+	}
+
+	n, err = result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("AIAssistAnalyzeRelease: could not get rows affected. err: %v", err)
+	}
+
+	return n > 0, nil
+}
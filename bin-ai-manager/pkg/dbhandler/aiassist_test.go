@@ -0,0 +1,275 @@
+package dbhandler
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"monorepo/bin-ai-manager/models/aiassist"
+	"monorepo/bin-ai-manager/models/usage"
+	"monorepo/bin-ai-manager/pkg/cachehandler"
+	"monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func Test_AIAssistCreate(t *testing.T) {
+
+	curTime := func() *time.Time { t := time.Date(2023, 1, 3, 21, 35, 2, 809000000, time.UTC); return &t }()
+
+	tests := []struct {
+		name string
+
+		aiassist *aiassist.AIAssist
+
+		responseCurTime *time.Time
+		expectRes       *aiassist.AIAssist
+	}{
+		{
+			name: "normal",
+
+			aiassist: &aiassist.AIAssist{
+				Identity: identity.Identity{
+					ID:         uuid.FromStringOrNil("1e2a6c3a-b1f6-11f0-9f41-2d7b5c8e1a01"),
+					CustomerID: uuid.FromStringOrNil("1e55b8d2-b1f6-11f0-a3c7-6e1f4a9d2b02"),
+				},
+				Owner: identity.Owner{
+					OwnerType: identity.OwnerTypeAgent,
+					OwnerID:   uuid.FromStringOrNil("1e80c4e6-b1f6-11f0-8b2e-3f9a6d1c4e03"),
+				},
+				AIID:          uuid.FromStringOrNil("1eab2f7c-b1f6-11f0-b5d1-7a2c8e4f1b04"),
+				ReferenceType: aiassist.ReferenceTypeCall,
+				ReferenceID:   uuid.FromStringOrNil("1ed5a0b8-b1f6-11f0-9e63-1c4b7f2a8d05"),
+				TranscribeID:  uuid.FromStringOrNil("1f00d3f2-b1f6-11f0-a7f4-5b3e9c1d6a06"),
+				Status:        aiassist.StatusProgressing,
+				Language:      "en-US",
+				Checklist: []aiassist.ChecklistItem{
+					{Name: "verify identity"},
+				},
+			},
+
+			responseCurTime: curTime,
+			expectRes: &aiassist.AIAssist{
+				Identity: identity.Identity{
+					ID:         uuid.FromStringOrNil("1e2a6c3a-b1f6-11f0-9f41-2d7b5c8e1a01"),
+					CustomerID: uuid.FromStringOrNil("1e55b8d2-b1f6-11f0-a3c7-6e1f4a9d2b02"),
+				},
+				Owner: identity.Owner{
+					OwnerType: identity.OwnerTypeAgent,
+					OwnerID:   uuid.FromStringOrNil("1e80c4e6-b1f6-11f0-8b2e-3f9a6d1c4e03"),
+				},
+				AIID:          uuid.FromStringOrNil("1eab2f7c-b1f6-11f0-b5d1-7a2c8e4f1b04"),
+				ReferenceType: aiassist.ReferenceTypeCall,
+				ReferenceID:   uuid.FromStringOrNil("1ed5a0b8-b1f6-11f0-9e63-1c4b7f2a8d05"),
+				TranscribeID:  uuid.FromStringOrNil("1f00d3f2-b1f6-11f0-a7f4-5b3e9c1d6a06"),
+				Status:        aiassist.StatusProgressing,
+				Language:      "en-US",
+				Checklist: []aiassist.ChecklistItem{
+					{Name: "verify identity"},
+				},
+				TMCreate: curTime,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockCache := cachehandler.NewMockCacheHandler(mc)
+
+			h := handler{
+				utilHandler: mockUtil,
+				db:          dbTest,
+				cache:       mockCache,
+			}
+
+			ctx := context.Background()
+
+			mockUtil.EXPECT().TimeNow().Return(tt.responseCurTime)
+			if err := h.AIAssistCreate(ctx, tt.aiassist); err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+
+			res, err := h.AIAssistGet(ctx, tt.aiassist.ID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_AIAssistListUpdateDelete(t *testing.T) {
+
+	curTime := func() *time.Time { t := time.Date(2023, 1, 3, 21, 35, 2, 809000000, time.UTC); return &t }()
+	customerID := uuid.FromStringOrNil("1f2b7e0c-b1f6-11f0-8c15-4d6a2e9f3b07")
+	transcribeID := uuid.FromStringOrNil("1f56a4c6-b1f6-11f0-b2d8-2e7c5a1f9d08")
+
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockUtil := utilhandler.NewMockUtilHandler(mc)
+	mockCache := cachehandler.NewMockCacheHandler(mc)
+
+	h := handler{
+		utilHandler: mockUtil,
+		db:          dbTest,
+		cache:       mockCache,
+	}
+
+	ctx := context.Background()
+
+	a := &aiassist.AIAssist{
+		Identity: identity.Identity{
+			ID:         uuid.FromStringOrNil("1f81c9e0-b1f6-11f0-9a4b-6c3f8d2e1a09"),
+			CustomerID: customerID,
+		},
+		ReferenceType: aiassist.ReferenceTypeCall,
+		TranscribeID:  transcribeID,
+		Status:        aiassist.StatusProgressing,
+		Checklist: []aiassist.ChecklistItem{
+			{Name: "verify identity"},
+		},
+	}
+
+	mockUtil.EXPECT().TimeNow().Return(curTime)
+	if err := h.AIAssistCreate(ctx, a); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	res, err := h.AIAssistList(ctx, 10, utilhandler.TimeGetCurTime(), map[aiassist.Field]any{
+		aiassist.FieldTranscribeID: transcribeID,
+		aiassist.FieldStatus:       aiassist.StatusProgressing,
+		aiassist.FieldDeleted:      false,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(res) != 1 || res[0].ID != a.ID {
+		t.Errorf("Wrong match. expect: %s, got: %v", a.ID, res)
+	}
+
+	mockUtil.EXPECT().TimeNow().Return(curTime)
+	if err := h.AIAssistUpdate(ctx, a.ID, map[aiassist.Field]any{
+		aiassist.FieldSentiment: aiassist.SentimentNegative,
+		aiassist.FieldChecklist: []aiassist.ChecklistItem{{Name: "verify identity", Done: true}},
+	}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	mockUtil.EXPECT().TimeNow().Return(curTime).Times(2)
+	for range 2 {
+		if err := h.AIAssistAddUsage(ctx, a.ID, &usage.Usage{PromptTokens: 100, CompletionTokens: 10}); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+
+	tmp, err := h.AIAssistGet(ctx, a.ID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expectChecklist := []aiassist.ChecklistItem{{Name: "verify identity", Done: true}}
+	if tmp.Sentiment != aiassist.SentimentNegative || !reflect.DeepEqual(tmp.Checklist, expectChecklist) || tmp.TMUpdate == nil {
+		t.Errorf("Wrong match. got: %v", tmp)
+	}
+	if tmp.PromptTokens != 200 || tmp.CompletionTokens != 20 {
+		t.Errorf("Wrong match. expect: 200/20 tokens, got: %v", tmp.Usage)
+	}
+
+	mockUtil.EXPECT().TimeNow().Return(curTime)
+	if err := h.AIAssistDelete(ctx, a.ID); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	tmp, err = h.AIAssistGet(ctx, a.ID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if tmp.TMDelete == nil {
+		t.Errorf("Wrong match. expect: deleted, got: %v", tmp)
+	}
+}
+
+func Test_AIAssistAnalyzeClaimRelease(t *testing.T) {
+
+	curTime := func() *time.Time { t := time.Date(2023, 1, 3, 21, 35, 2, 809000000, time.UTC); return &t }()
+	lease := func() *time.Time { t := time.Date(2023, 1, 3, 21, 35, 32, 809000000, time.UTC); return &t }()
+	expiredTime := func() *time.Time { t := time.Date(2023, 1, 3, 21, 36, 0, 0, time.UTC); return &t }()
+
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockUtil := utilhandler.NewMockUtilHandler(mc)
+	mockCache := cachehandler.NewMockCacheHandler(mc)
+
+	h := handler{
+		utilHandler: mockUtil,
+		db:          dbTest,
+		cache:       mockCache,
+	}
+
+	ctx := context.Background()
+
+	a := &aiassist.AIAssist{
+		Identity: identity.Identity{
+			ID:         uuid.FromStringOrNil("3a1c5e7f-b4a1-11f0-8e3d-2c6f9a1b4d01"),
+			CustomerID: uuid.FromStringOrNil("3a4d7b9e-b4a1-11f0-b1a5-5e2c8f3a6d02"),
+		},
+		ReferenceType: aiassist.ReferenceTypeCall,
+		Status:        aiassist.StatusProgressing,
+	}
+
+	mockUtil.EXPECT().TimeNow().Return(curTime)
+	if err := h.AIAssistCreate(ctx, a); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	claim := func(now *time.Time) bool {
+		mockUtil.EXPECT().TimeNow().Return(now)
+		res, err := h.AIAssistAnalyzeClaim(ctx, a.ID, lease)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return res
+	}
+	release := func() bool {
+		res, err := h.AIAssistAnalyzeRelease(ctx, a.ID, lease)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return res
+	}
+
+	if !claim(curTime) {
+		t.Errorf("Wrong match. expect: claimed")
+	}
+
+	// the second transcript is pending on the lease holder.
+	if claim(curTime) {
+		t.Errorf("Wrong match. expect: not claimed")
+	}
+	if !release() {
+		t.Errorf("Wrong match. expect: renewed for the pending analysis")
+	}
+	if release() {
+		t.Errorf("Wrong match. expect: released")
+	}
+
+	if !claim(curTime) {
+		t.Errorf("Wrong match. expect: claimed after the release")
+	}
+
+	// the lease of a lost analysis expires.
+	if !claim(expiredTime) {
+		t.Errorf("Wrong match. expect: claimed after the expiry")
+	}
+}
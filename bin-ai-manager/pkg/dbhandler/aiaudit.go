@@ -10,6 +10,7 @@ import (
 	"github.com/gofrs/uuid"
 
 	"monorepo/bin-ai-manager/models/aiaudit"
+	"monorepo/bin-ai-manager/models/usage"
 	commondatabasehandler "monorepo/bin-common-handler/pkg/databasehandler"
 )
 
@@ -179,11 +180,11 @@ func (h *handler) AIAuditDelete(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
-// AIAuditUpdateFinal writes the goroutine's final result atomically.
+// AIAuditUpdateFinal writes the goroutine's final result and the evaluation's LLM usage atomically.
 // Only updates rows where status='progressing' AND tm_delete IS NULL to
 // prevent overwriting a 'failed' status set by the stale recovery sweep.
 // Returns rowsAffected: 0 means the record was already soft-deleted or swept.
-func (h *handler) AIAuditUpdateFinal(ctx context.Context, id uuid.UUID, status aiaudit.Status, overallScore *int, evaluation json.RawMessage, errStr string, messageIDs []uuid.UUID) (int64, error) {
+func (h *handler) AIAuditUpdateFinal(ctx context.Context, id uuid.UUID, status aiaudit.Status, overallScore *int, evaluation json.RawMessage, errStr string, messageIDs []uuid.UUID, u *usage.Usage) (int64, error) {
 	ts := h.utilHandler.TimeNow()
 
 	var evalJSON sql.NullString
@@ -200,20 +201,28 @@ func (h *handler) AIAuditUpdateFinal(ctx context.Context, id uuid.UUID, status a
 		msgIDsJSON = sql.NullString{String: string(b), Valid: true}
 	}
 
+	if u == nil {
+		u = &usage.Usage{}
+	}
+
 	query := fmt.Sprintf(`
 		UPDATE %s
-		SET status = ?, overall_score = ?, evaluation = ?, message_ids = ?, error = ?, tm_update = ?
+		SET status = ?, overall_score = ?, evaluation = ?, message_ids = ?, error = ?,
+			prompt_tokens = ?, completion_tokens = ?, cached_tokens = ?, tm_update = ?
 		WHERE id = ? AND tm_delete IS NULL AND status = 'progressing'
 	`, aiauditTable)
 
 	result, err := h.db.ExecContext(ctx, query,
-		string(status),  // 1
-		overallScore,    // 2
-		evalJSON,        // 3
-		msgIDsJSON,      // 4
-		errStr,          // 5
-		ts,              // 6
-		id.Bytes(),      // 7 (WHERE)
+		string(status),     // 1
+		overallScore,       // 2
+		evalJSON,           // 3
+		msgIDsJSON,         // 4
+		errStr,             // 5
+		u.PromptTokens,     // 6
+		u.CompletionTokens, // 7
+		u.CachedTokens,     // 8
+		ts,                 // 9
+		id.Bytes(),         // 10 (WHERE)
 	)
 	if err != nil {
 		return 0, fmt.Errorf("AIAuditUpdateFinal: could not execute. err: %v", err)
@@ -9,6 +9,7 @@ import (
 	gomock "go.uber.org/mock/gomock"
 
 	"monorepo/bin-ai-manager/models/aiaudit"
+	"monorepo/bin-ai-manager/models/usage"
 	"monorepo/bin-ai-manager/pkg/cachehandler"
 	"monorepo/bin-common-handler/models/identity"
 	"monorepo/bin-common-handler/pkg/utilhandler"
@@ -161,7 +162,7 @@ func Test_AIAuditUpdateFinal_OnlyUpdatesWhenNotDeleted(t *testing.T) {
 
 	// Live record: should update (1 row affected) and final state verified.
 	mockUtil.EXPECT().TimeNow().Return(curTime)
-	n, err := h.AIAuditUpdateFinal(ctx, liveID, aiaudit.StatusCompleted, &score, nil, "", nil)
+	n, err := h.AIAuditUpdateFinal(ctx, liveID, aiaudit.StatusCompleted, &score, nil, "", nil, nil)
 	if err != nil {
 		t.Fatalf("AIAuditUpdateFinal (live) error = %v", err)
 	}
@@ -183,7 +184,7 @@ func Test_AIAuditUpdateFinal_OnlyUpdatesWhenNotDeleted(t *testing.T) {
 
 	// Soft-deleted record: must return 0 rows and remain unchanged.
 	mockUtil.EXPECT().TimeNow().Return(curTime)
-	n, err = h.AIAuditUpdateFinal(ctx, deletedID, aiaudit.StatusCompleted, &score, nil, "", nil)
+	n, err = h.AIAuditUpdateFinal(ctx, deletedID, aiaudit.StatusCompleted, &score, nil, "", nil, nil)
 	if err != nil {
 		t.Fatalf("AIAuditUpdateFinal (deleted) error = %v", err)
 	}
@@ -288,9 +289,10 @@ func Test_AIAuditUpdateFinal_StoresMessageIDs(t *testing.T) {
 
 	score := 90
 	msgIDs := []uuid.UUID{msgID1, msgID2}
+	u := &usage.Usage{PromptTokens: 1200, CompletionTokens: 300, CachedTokens: 1000}
 
 	mockUtil.EXPECT().TimeNow().Return(curTime)
-	n, err := h.AIAuditUpdateFinal(ctx, auditID, aiaudit.StatusCompleted, &score, nil, "", msgIDs)
+	n, err := h.AIAuditUpdateFinal(ctx, auditID, aiaudit.StatusCompleted, &score, nil, "", msgIDs, u)
 	if err != nil {
 		t.Fatalf("AIAuditUpdateFinal error = %v", err)
 	}
@@ -305,6 +307,9 @@ func Test_AIAuditUpdateFinal_StoresMessageIDs(t *testing.T) {
 	if got.Status != aiaudit.StatusCompleted {
 		t.Errorf("expected status completed, got %s", got.Status)
 	}
+	if got.Usage != *u {
+		t.Errorf("expected usage %v, got %v", *u, got.Usage)
+	}
 	if len(got.MessageIDs) != 2 {
 		t.Fatalf("expected 2 message IDs, got %d", len(got.MessageIDs))
 	}
@@ -10,6 +10,7 @@ import (
 	commondatabasehandler "monorepo/bin-common-handler/pkg/databasehandler"
 
 	"monorepo/bin-ai-manager/models/aicall"
+	"monorepo/bin-ai-manager/models/usage"
 )
 
 const (
@@ -307,3 +308,44 @@ func (h *handler) AIcallUpdateIfActive(ctx context.Context, id uuid.UUID, fields
 
 	return n, nil
 }
+
+// AIcallAddUsage adds the given pipecatcall's usage to the aicall's usage.
+// The pipecatcalls of an aicall run one after another, so the aicall keeps the
+// last pipecatcall whose usage was added and ignores it when it is added again.
+// Returns rowsAffected: 0 means the usage was already added (or the aicall does not exist).
+func (h *handler) AIcallAddUsage(ctx context.Context, id uuid.UUID, pipecatcallID uuid.UUID, u *usage.Usage) (int64, error) {
+	query, args, err := sq.Update(aicallTable).
+		Set("prompt_tokens", sq.Expr("prompt_tokens + ?", u.PromptTokens)).
+		Set("completion_tokens", sq.Expr("completion_tokens + ?", u.CompletionTokens)).
+		Set("cached_tokens", sq.Expr("cached_tokens + ?", u.CachedTokens)).
+		Set("stt_seconds", sq.Expr("stt_seconds + ?", u.STTSeconds)).
+		Set("tts_seconds", sq.Expr("tts_seconds + ?", u.TTSSeconds)).
+		Set("usage_pipecatcall_id", pipecatcallID.Bytes()).
+		Set("tm_update", h.utilHandler.TimeNow()).
+		Where(sq.Eq{"id": id.Bytes()}).
+		Where(sq.Or{
+			sq.Eq{"usage_pipecatcall_id": nil},
+			sq.NotEq{"usage_pipecatcall_id": pipecatcallID.Bytes()},
+		}).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("AIcallAddUsage: could not build query. err: %v", err)
+	}
+
+	res, err := h.db.Exec(query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("AIcallAddUsage: could not execute. err: %v", err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("AIcallAddUsage: could not get rows affected. err: %v", err)
+	}
+
+	if n > 0 {
+		// update the cache
+		_ = h.aicallUpdateToCache(ctx, id)
+	}
+
+	return n, nil
+}
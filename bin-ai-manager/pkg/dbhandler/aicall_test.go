@@ -15,6 +15,7 @@ import (
 
 	"monorepo/bin-ai-manager/models/ai"
 	"monorepo/bin-ai-manager/models/aicall"
+	"monorepo/bin-ai-manager/models/usage"
 	"monorepo/bin-ai-manager/pkg/cachehandler"
 )
 
@@ -342,6 +343,122 @@ func Test_AIcallUpdate(t *testing.T) {
 	}
 }
 
+func Test_AIcallAddUsage(t *testing.T) {
+
+	curTime := func() *time.Time { t := time.Date(2023, 1, 3, 21, 35, 2, 809000000, time.UTC); return &t }()
+
+	tests := []struct {
+		name string
+		ai   *aicall.AIcall
+
+		id            uuid.UUID
+		pipecatcallID uuid.UUID
+		usages        []*usage.Usage
+
+		responseCurTime *time.Time
+
+		expectRowsAffected []int64
+		expectRes          *aicall.AIcall
+	}{
+		{
+			name: "adds the usage of each pipecatcall once",
+			ai: &aicall.AIcall{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("5b0e8a2e-b3a1-11f0-9a55-2f2a8a0f1c01"),
+				},
+				ReferenceID: uuid.FromStringOrNil("5b0e8a2e-b3a1-11f0-9a55-2f2a8a0f1c01"),
+				Usage: usage.Usage{
+					PromptTokens: 100,
+					STTSeconds:   1.5,
+				},
+			},
+
+			id:            uuid.FromStringOrNil("5b0e8a2e-b3a1-11f0-9a55-2f2a8a0f1c01"),
+			pipecatcallID: uuid.FromStringOrNil("5b3f61c4-b3a1-11f0-8f0b-4b7c7e3d2a02"),
+			usages: []*usage.Usage{
+				{
+					PromptTokens:     1200,
+					CompletionTokens: 80,
+					CachedTokens:     1000,
+					STTSeconds:       12.5,
+					TTSSeconds:       8.25,
+				},
+				{
+					PromptTokens: 1200,
+				},
+			},
+
+			responseCurTime: curTime,
+
+			expectRowsAffected: []int64{1, 0},
+			expectRes: &aicall.AIcall{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("5b0e8a2e-b3a1-11f0-9a55-2f2a8a0f1c01"),
+				},
+				ReferenceID: uuid.FromStringOrNil("5b0e8a2e-b3a1-11f0-9a55-2f2a8a0f1c01"),
+				Usage: usage.Usage{
+					PromptTokens:     1300,
+					CompletionTokens: 80,
+					CachedTokens:     1000,
+					STTSeconds:       14,
+					TTSSeconds:       8.25,
+				},
+				TMCreate: curTime,
+				TMUpdate: curTime,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockCache := cachehandler.NewMockCacheHandler(mc)
+
+			h := handler{
+				utilHandler: mockUtil,
+				db:          dbTest,
+				cache:       mockCache,
+			}
+
+			ctx := context.Background()
+
+			mockUtil.EXPECT().TimeNow().Return(tt.responseCurTime)
+			mockCache.EXPECT().AIcallSet(ctx, gomock.Any())
+			if err := h.AIcallCreate(ctx, tt.ai); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			for i, u := range tt.usages {
+				mockUtil.EXPECT().TimeNow().Return(tt.responseCurTime)
+				if tt.expectRowsAffected[i] > 0 {
+					mockCache.EXPECT().AIcallSet(ctx, gomock.Any())
+				}
+				n, err := h.AIcallAddUsage(ctx, tt.id, tt.pipecatcallID, u)
+				if err != nil {
+					t.Errorf("Wrong match. expect: ok, got: %v", err)
+				}
+				if n != tt.expectRowsAffected[i] {
+					t.Errorf("Wrong match. expect: %d, got: %d", tt.expectRowsAffected[i], n)
+				}
+			}
+
+			mockCache.EXPECT().AIcallGet(ctx, tt.id).Return(nil, fmt.Errorf(""))
+			mockCache.EXPECT().AIcallSet(ctx, gomock.Any())
+			res, err := h.AIcallGet(ctx, tt.id)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(tt.expectRes, res) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
 func Test_AIcallDelete(t *testing.T) {
 
 	curTime := func() *time.Time { t := time.Date(2023, 1, 3, 21, 35, 2, 809000000, time.UTC); return &t }()
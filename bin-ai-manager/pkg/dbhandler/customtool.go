@@ -0,0 +1,166 @@
+package dbhandler
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	uuid "github.com/gofrs/uuid"
+
+	commondatabasehandler "monorepo/bin-common-handler/pkg/databasehandler"
+
+	"monorepo/bin-ai-manager/models/customtool"
+)
+
+const (
+	customToolTable = "ai_custom_tools"
+)
+
+// CustomToolCreate creates a new custom tool record.
+func (h *handler) CustomToolCreate(ctx context.Context, c *customtool.CustomTool) error {
+	c.TMCreate = h.utilHandler.TimeNow()
+	c.TMUpdate = nil
+	c.TMDelete = nil
+
+	fields, err := commondatabasehandler.PrepareFields(c)
+	if err != nil {
+		return fmt.Errorf("CustomToolCreate: could not prepare fields. err: %v", err)
+	}
+
+	query, args, err := sq.Insert(customToolTable).SetMap(fields).ToSql()
+	if err != nil {
+		return fmt.Errorf("CustomToolCreate: could not build query. err: %v", err)
+	}
+
+	_, err = h.db.Exec(query, args...)
+	if err != nil {
+		return fmt.Errorf("CustomToolCreate: could not execute query. err: %v", err)
+	}
+
+	return nil
+}
+
+// CustomToolGet returns custom tool.
+// Custom tools are read once per LLM tool call, so they are not cached.
+func (h *handler) CustomToolGet(ctx context.Context, id uuid.UUID) (*customtool.CustomTool, error) {
+	cols := commondatabasehandler.GetDBFields(customtool.CustomTool{})
+
+	query, args, err := sq.Select(cols...).
+		From(customToolTable).
+		Where(sq.Eq{"id": id.Bytes()}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("CustomToolGet: could not build query. err: %v", err)
+	}
+
+	rows, err := h.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("CustomToolGet: could not query. err: %v", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	if !rows.Next() {
+		return nil, ErrNotFound
+	}
+
+	res := &customtool.CustomTool{}
+	if err := commondatabasehandler.ScanRow(rows, res); err != nil {
+		return nil, fmt.Errorf("CustomToolGet: could not scan row. err: %v", err)
+	}
+
+	return res, nil
+}
+
+// CustomToolDelete deletes the custom tool.
+func (h *handler) CustomToolDelete(ctx context.Context, id uuid.UUID) error {
+	ts := h.utilHandler.TimeNow()
+
+	query, args, err := sq.Update(customToolTable).
+		SetMap(map[string]any{
+			"tm_update": ts,
+			"tm_delete": ts,
+		}).
+		Where(sq.Eq{"id": id.Bytes()}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("CustomToolDelete: could not build query. err: %v", err)
+	}
+
+	_, err = h.db.Exec(query, args...)
+	if err != nil {
+		return fmt.Errorf("CustomToolDelete: could not execute. err: %v", err)
+	}
+
+	return nil
+}
+
+// CustomToolList returns a list of custom tools.
+func (h *handler) CustomToolList(ctx context.Context, size uint64, token string, filters map[customtool.Field]any) ([]*customtool.CustomTool, error) {
+	if token == "" {
+		token = h.utilHandler.TimeGetCurTime()
+	}
+
+	cols := commondatabasehandler.GetDBFields(customtool.CustomTool{})
+
+	builder := sq.Select(cols...).
+		From(customToolTable).
+		Where(sq.Lt{"tm_create": token}).
+		OrderBy("tm_create desc").
+		Limit(size)
+
+	builder, err := commondatabasehandler.ApplyFields(builder, filters)
+	if err != nil {
+		return nil, fmt.Errorf("CustomToolList: could not apply filters. err: %v", err)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("CustomToolList: could not build query. err: %v", err)
+	}
+
+	rows, err := h.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("CustomToolList: could not query. err: %v", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	res := []*customtool.CustomTool{}
+	for rows.Next() {
+		c := &customtool.CustomTool{}
+		if err := commondatabasehandler.ScanRow(rows, c); err != nil {
+			return nil, fmt.Errorf("CustomToolList: could not scan row. err: %v", err)
+		}
+		res = append(res, c)
+	}
+
+	return res, nil
+}
+
+// CustomToolUpdate updates the custom tool fields.
+func (h *handler) CustomToolUpdate(ctx context.Context, id uuid.UUID, fields map[customtool.Field]any) error {
+	updateFields := make(map[string]any)
+	for k, v := range fields {
+		updateFields[string(k)] = v
+	}
+	updateFields["tm_update"] = h.utilHandler.TimeNow()
+
+	preparedFields, err := commondatabasehandler.PrepareFields(updateFields)
+	if err != nil {
+		return fmt.Errorf("CustomToolUpdate: could not prepare fields. err: %v", err)
+	}
+
+	query, args, err := sq.Update(customToolTable).
+		SetMap(preparedFields).
+		Where(sq.Eq{"id": id.Bytes()}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("CustomToolUpdate: could not build query. err: %v", err)
+	}
+
+	_, err = h.db.Exec(query, args...)
+	if err != nil {
+		return fmt.Errorf("CustomToolUpdate: could not execute. err: %v", err)
+	}
+
+	return nil
+}
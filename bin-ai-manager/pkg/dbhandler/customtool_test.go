@@ -0,0 +1,264 @@
+package dbhandler
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"monorepo/bin-ai-manager/models/customtool"
+	"monorepo/bin-ai-manager/pkg/cachehandler"
+	"monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func Test_CustomToolCreate(t *testing.T) {
+
+	curTime := func() *time.Time { t := time.Date(2023, 1, 3, 21, 35, 2, 809000000, time.UTC); return &t }()
+
+	tests := []struct {
+		name string
+
+		customTool *customtool.CustomTool
+
+		responseCurTime *time.Time
+		expectRes       *customtool.CustomTool
+	}{
+		{
+			name: "normal",
+
+			customTool: &customtool.CustomTool{
+				Identity: identity.Identity{
+					ID:         uuid.FromStringOrNil("0c9a3d5e-8d6b-11f1-a0f3-8b0f5a9f2c11"),
+					CustomerID: uuid.FromStringOrNil("0cc7b6c8-8d6b-11f1-9d1e-2f4d0c6b7a22"),
+				},
+				Name:        "lookup_order",
+				Detail:      "order lookup",
+				Description: "Look up an order by its number.",
+				Parameters: map[string]any{
+					"type": "object",
+				},
+				URL: "https://example.com/orders",
+				Headers: map[string]string{
+					"Authorization": "Bearer token",
+				},
+				Timeout:          3000,
+				ResponseTemplate: "Order status: {{.status}}",
+			},
+
+			responseCurTime: curTime,
+			expectRes: &customtool.CustomTool{
+				Identity: identity.Identity{
+					ID:         uuid.FromStringOrNil("0c9a3d5e-8d6b-11f1-a0f3-8b0f5a9f2c11"),
+					CustomerID: uuid.FromStringOrNil("0cc7b6c8-8d6b-11f1-9d1e-2f4d0c6b7a22"),
+				},
+				Name:        "lookup_order",
+				Detail:      "order lookup",
+				Description: "Look up an order by its number.",
+				Parameters: map[string]any{
+					"type": "object",
+				},
+				URL: "https://example.com/orders",
+				Headers: map[string]string{
+					"Authorization": "Bearer token",
+				},
+				Timeout:          3000,
+				ResponseTemplate: "Order status: {{.status}}",
+				TMCreate:         curTime,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockCache := cachehandler.NewMockCacheHandler(mc)
+
+			h := handler{
+				utilHandler: mockUtil,
+				db:          dbTest,
+				cache:       mockCache,
+			}
+
+			ctx := context.Background()
+
+			mockUtil.EXPECT().TimeNow().Return(tt.responseCurTime)
+			if err := h.CustomToolCreate(ctx, tt.customTool); err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+
+			res, err := h.CustomToolGet(ctx, tt.customTool.ID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_CustomToolGet_notFound(t *testing.T) {
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	h := handler{
+		utilHandler: utilhandler.NewMockUtilHandler(mc),
+		db:          dbTest,
+		cache:       cachehandler.NewMockCacheHandler(mc),
+	}
+
+	_, err := h.CustomToolGet(context.Background(), uuid.FromStringOrNil("7d5a0a0e-8d6b-11f1-8b58-6b9e0b1f7e33"))
+	if err != ErrNotFound {
+		t.Errorf("Wrong match. expect: ErrNotFound, got: %v", err)
+	}
+}
+
+func Test_CustomToolList(t *testing.T) {
+
+	curTime := func() *time.Time { t := time.Date(2023, 1, 3, 21, 35, 2, 809000000, time.UTC); return &t }()
+	customerID := uuid.FromStringOrNil("9a3c2b1e-8d6b-11f1-b7d4-4f2a6e8c9d44")
+
+	tests := []struct {
+		name string
+
+		customTools []*customtool.CustomTool
+		filters     map[customtool.Field]any
+
+		expectNames []string
+	}{
+		{
+			name: "filter by customer and name",
+
+			customTools: []*customtool.CustomTool{
+				{
+					Identity: identity.Identity{
+						ID:         uuid.FromStringOrNil("9a6e1c0a-8d6b-11f1-a4c2-3b5d7f9e1a55"),
+						CustomerID: customerID,
+					},
+					Name: "lookup_order",
+				},
+				{
+					Identity: identity.Identity{
+						ID:         uuid.FromStringOrNil("9a9a4e3c-8d6b-11f1-8a61-1c3e5a7b9d66"),
+						CustomerID: customerID,
+					},
+					Name: "cancel_order",
+				},
+			},
+			filters: map[customtool.Field]any{
+				customtool.FieldCustomerID: customerID,
+				customtool.FieldName:       "lookup_order",
+				customtool.FieldDeleted:    false,
+			},
+
+			expectNames: []string{"lookup_order"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockCache := cachehandler.NewMockCacheHandler(mc)
+
+			h := handler{
+				utilHandler: mockUtil,
+				db:          dbTest,
+				cache:       mockCache,
+			}
+
+			ctx := context.Background()
+
+			for _, c := range tt.customTools {
+				mockUtil.EXPECT().TimeNow().Return(curTime)
+				if err := h.CustomToolCreate(ctx, c); err != nil {
+					t.Errorf("Unexpected error: %v", err)
+				}
+			}
+
+			res, err := h.CustomToolList(ctx, 10, utilhandler.TimeGetCurTime(), tt.filters)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			names := []string{}
+			for _, r := range res {
+				names = append(names, r.Name)
+			}
+			if !reflect.DeepEqual(names, tt.expectNames) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectNames, names)
+			}
+		})
+	}
+}
+
+func Test_CustomToolUpdateDelete(t *testing.T) {
+
+	curTime := func() *time.Time { t := time.Date(2023, 1, 3, 21, 35, 2, 809000000, time.UTC); return &t }()
+
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockUtil := utilhandler.NewMockUtilHandler(mc)
+	mockCache := cachehandler.NewMockCacheHandler(mc)
+
+	h := handler{
+		utilHandler: mockUtil,
+		db:          dbTest,
+		cache:       mockCache,
+	}
+
+	ctx := context.Background()
+
+	c := &customtool.CustomTool{
+		Identity: identity.Identity{
+			ID:         uuid.FromStringOrNil("c1f0a7d2-8d6b-11f1-9c3e-5d7f9b1c3e77"),
+			CustomerID: uuid.FromStringOrNil("c21c6e24-8d6b-11f1-b0a8-7e9a1c3e5f88"),
+		},
+		Name: "lookup_order",
+	}
+
+	mockUtil.EXPECT().TimeNow().Return(curTime)
+	if err := h.CustomToolCreate(ctx, c); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	mockUtil.EXPECT().TimeNow().Return(curTime)
+	if err := h.CustomToolUpdate(ctx, c.ID, map[customtool.Field]any{
+		customtool.FieldURL:     "https://example.com/v2",
+		customtool.FieldTimeout: 1000,
+	}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	res, err := h.CustomToolGet(ctx, c.ID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if res.URL != "https://example.com/v2" || res.Timeout != 1000 || res.TMUpdate == nil {
+		t.Errorf("Wrong match. got: %v", res)
+	}
+
+	mockUtil.EXPECT().TimeNow().Return(curTime)
+	if err := h.CustomToolDelete(ctx, c.ID); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	res, err = h.CustomToolGet(ctx, c.ID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if res.TMDelete == nil {
+		t.Errorf("Wrong match. expect: deleted, got: %v", res)
+	}
+}
@@ -0,0 +1,166 @@
+package dbhandler
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	uuid "github.com/gofrs/uuid"
+
+	commondatabasehandler "monorepo/bin-common-handler/pkg/databasehandler"
+
+	"monorepo/bin-ai-manager/models/extraction"
+)
+
+const (
+	extractionTable = "ai_extractions"
+)
+
+// ExtractionCreate creates a new extraction record.
+func (h *handler) ExtractionCreate(ctx context.Context, e *extraction.Extraction) error {
+	e.TMCreate = h.utilHandler.TimeNow()
+	e.TMUpdate = nil
+	e.TMDelete = nil
+
+	fields, err := commondatabasehandler.PrepareFields(e)
+	if err != nil {
+		return fmt.Errorf("ExtractionCreate: could not prepare fields. err: %v", err)
+	}
+
+	query, args, err := sq.Insert(extractionTable).SetMap(fields).ToSql()
+	if err != nil {
+		return fmt.Errorf("ExtractionCreate: could not build query. err: %v", err)
+	}
+
+	_, err = h.db.Exec(query, args...)
+	if err != nil {
+		return fmt.Errorf("ExtractionCreate: could not execute query. err: %v", err)
+	}
+
+	return nil
+}
+
+// ExtractionGet returns extraction.
+// Extractions are read only on the API and the post-call events, so they are not cached.
+func (h *handler) ExtractionGet(ctx context.Context, id uuid.UUID) (*extraction.Extraction, error) {
+	cols := commondatabasehandler.GetDBFields(extraction.Extraction{})
+
+	query, args, err := sq.Select(cols...).
+		From(extractionTable).
+		Where(sq.Eq{"id": id.Bytes()}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("ExtractionGet: could not build query. err: %v", err)
+	}
+
+	rows, err := h.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("ExtractionGet: could not query. err: %v", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	if !rows.Next() {
+		return nil, ErrNotFound
+	}
+
+	res := &extraction.Extraction{}
+	if err := commondatabasehandler.ScanRow(rows, res); err != nil {
+		return nil, fmt.Errorf("ExtractionGet: could not scan row. err: %v", err)
+	}
+
+	return res, nil
+}
+
+// ExtractionDelete deletes the extraction.
+func (h *handler) ExtractionDelete(ctx context.Context, id uuid.UUID) error {
+	ts := h.utilHandler.TimeNow()
+
+	query, args, err := sq.Update(extractionTable).
+		SetMap(map[string]any{
+			"tm_update": ts,
+			"tm_delete": ts,
+		}).
+		Where(sq.Eq{"id": id.Bytes()}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("ExtractionDelete: could not build query. err: %v", err)
+	}
+
+	_, err = h.db.Exec(query, args...)
+	if err != nil {
+		return fmt.Errorf("ExtractionDelete: could not execute. err: %v", err)
+	}
+
+	return nil
+}
+
+// ExtractionList returns a list of extractions.
+func (h *handler) ExtractionList(ctx context.Context, size uint64, token string, filters map[extraction.Field]any) ([]*extraction.Extraction, error) {
+	if token == "" {
+		token = h.utilHandler.TimeGetCurTime()
+	}
+
+	cols := commondatabasehandler.GetDBFields(extraction.Extraction{})
+
+	builder := sq.Select(cols...).
+		From(extractionTable).
+		Where(sq.Lt{"tm_create": token}).
+		OrderBy("tm_create desc").
+		Limit(size)
+
+	builder, err := commondatabasehandler.ApplyFields(builder, filters)
+	if err != nil {
+		return nil, fmt.Errorf("ExtractionList: could not apply filters. err: %v", err)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("ExtractionList: could not build query. err: %v", err)
+	}
+
+	rows, err := h.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("ExtractionList: could not query. err: %v", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	res := []*extraction.Extraction{}
+	for rows.Next() {
+		c := &extraction.Extraction{}
+		if err := commondatabasehandler.ScanRow(rows, c); err != nil {
+			return nil, fmt.Errorf("ExtractionList: could not scan row. err: %v", err)
+		}
+		res = append(res, c)
+	}
+
+	return res, nil
+}
+
+// ExtractionUpdate updates the extraction fields.
+func (h *handler) ExtractionUpdate(ctx context.Context, id uuid.UUID, fields map[extraction.Field]any) error {
+	updateFields := make(map[string]any)
+	for k, v := range fields {
+		updateFields[string(k)] = v
+	}
+	updateFields["tm_update"] = h.utilHandler.TimeNow()
+
+	preparedFields, err := commondatabasehandler.PrepareFields(updateFields)
+	if err != nil {
+		return fmt.Errorf("ExtractionUpdate: could not prepare fields. err: %v", err)
+	}
+
+	query, args, err := sq.Update(extractionTable).
+		SetMap(preparedFields).
+		Where(sq.Eq{"id": id.Bytes()}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("ExtractionUpdate: could not build query. err: %v", err)
+	}
+
+	_, err = h.db.Exec(query, args...)
+	if err != nil {
+		return fmt.Errorf("ExtractionUpdate: could not execute. err: %v", err)
+	}
+
+	return nil
+}
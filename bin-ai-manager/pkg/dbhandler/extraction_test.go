@@ -0,0 +1,198 @@
+package dbhandler
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"monorepo/bin-ai-manager/models/extraction"
+	"monorepo/bin-ai-manager/models/usage"
+	"monorepo/bin-ai-manager/pkg/cachehandler"
+	"monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func Test_ExtractionCreate(t *testing.T) {
+
+	curTime := func() *time.Time { t := time.Date(2023, 1, 3, 21, 35, 2, 809000000, time.UTC); return &t }()
+
+	tests := []struct {
+		name string
+
+		extraction *extraction.Extraction
+
+		responseCurTime *time.Time
+		expectRes       *extraction.Extraction
+	}{
+		{
+			name: "normal",
+
+			extraction: &extraction.Extraction{
+				Identity: identity.Identity{
+					ID:         uuid.FromStringOrNil("7a1c3e52-ad46-11f0-92f1-3f6e1a9b0c01"),
+					CustomerID: uuid.FromStringOrNil("7a4b8d1e-ad46-11f0-b0d2-5c8e2f1a7d02"),
+				},
+				ActiveflowID:  uuid.FromStringOrNil("7a7d2c6a-ad46-11f0-8b4c-2e9a6d3f1b03"),
+				ReferenceType: extraction.ReferenceTypeAIcall,
+				ReferenceID:   uuid.FromStringOrNil("7aa3f1b4-ad46-11f0-a7e5-9d1c4b2e6f04"),
+				Status:        extraction.StatusDone,
+				Language:      "en-US",
+				Schema: map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"intent": map[string]any{"type": "string"},
+					},
+				},
+				SetVariables: true,
+				Result: map[string]any{
+					"intent": "refund",
+				},
+				Usage: usage.Usage{
+					PromptTokens:     320,
+					CompletionTokens: 12,
+				},
+			},
+
+			responseCurTime: curTime,
+			expectRes: &extraction.Extraction{
+				Identity: identity.Identity{
+					ID:         uuid.FromStringOrNil("7a1c3e52-ad46-11f0-92f1-3f6e1a9b0c01"),
+					CustomerID: uuid.FromStringOrNil("7a4b8d1e-ad46-11f0-b0d2-5c8e2f1a7d02"),
+				},
+				ActiveflowID:  uuid.FromStringOrNil("7a7d2c6a-ad46-11f0-8b4c-2e9a6d3f1b03"),
+				ReferenceType: extraction.ReferenceTypeAIcall,
+				ReferenceID:   uuid.FromStringOrNil("7aa3f1b4-ad46-11f0-a7e5-9d1c4b2e6f04"),
+				Status:        extraction.StatusDone,
+				Language:      "en-US",
+				Schema: map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"intent": map[string]any{"type": "string"},
+					},
+				},
+				SetVariables: true,
+				Result: map[string]any{
+					"intent": "refund",
+				},
+				Usage: usage.Usage{
+					PromptTokens:     320,
+					CompletionTokens: 12,
+				},
+				TMCreate: curTime,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockCache := cachehandler.NewMockCacheHandler(mc)
+
+			h := handler{
+				utilHandler: mockUtil,
+				db:          dbTest,
+				cache:       mockCache,
+			}
+
+			ctx := context.Background()
+
+			mockUtil.EXPECT().TimeNow().Return(tt.responseCurTime)
+			if err := h.ExtractionCreate(ctx, tt.extraction); err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+
+			res, err := h.ExtractionGet(ctx, tt.extraction.ID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_ExtractionListUpdateDelete(t *testing.T) {
+
+	curTime := func() *time.Time { t := time.Date(2023, 1, 3, 21, 35, 2, 809000000, time.UTC); return &t }()
+	customerID := uuid.FromStringOrNil("7ad0a2c8-ad46-11f0-9c3b-6a2f8e1d4b05")
+	referenceID := uuid.FromStringOrNil("7afb6e14-ad46-11f0-8d7a-1b5c9e3f2a06")
+
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockUtil := utilhandler.NewMockUtilHandler(mc)
+	mockCache := cachehandler.NewMockCacheHandler(mc)
+
+	h := handler{
+		utilHandler: mockUtil,
+		db:          dbTest,
+		cache:       mockCache,
+	}
+
+	ctx := context.Background()
+
+	e := &extraction.Extraction{
+		Identity: identity.Identity{
+			ID:         uuid.FromStringOrNil("7b26d0a6-ad46-11f0-b6e8-4f3a7c2d9e07"),
+			CustomerID: customerID,
+		},
+		ReferenceType: extraction.ReferenceTypeCall,
+		ReferenceID:   referenceID,
+		Status:        extraction.StatusProgressing,
+	}
+
+	mockUtil.EXPECT().TimeNow().Return(curTime)
+	if err := h.ExtractionCreate(ctx, e); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	res, err := h.ExtractionList(ctx, 10, utilhandler.TimeGetCurTime(), map[extraction.Field]any{
+		extraction.FieldCustomerID:  customerID,
+		extraction.FieldReferenceID: referenceID,
+		extraction.FieldDeleted:     false,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(res) != 1 || res[0].ID != e.ID {
+		t.Errorf("Wrong match. expect: %s, got: %v", e.ID, res)
+	}
+
+	mockUtil.EXPECT().TimeNow().Return(curTime)
+	if err := h.ExtractionUpdate(ctx, e.ID, map[extraction.Field]any{
+		extraction.FieldStatus: extraction.StatusDone,
+		extraction.FieldResult: map[string]any{"order_id": "A-100"},
+	}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	tmp, err := h.ExtractionGet(ctx, e.ID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if tmp.Status != extraction.StatusDone || !reflect.DeepEqual(tmp.Result, map[string]any{"order_id": "A-100"}) || tmp.TMUpdate == nil {
+		t.Errorf("Wrong match. got: %v", tmp)
+	}
+
+	mockUtil.EXPECT().TimeNow().Return(curTime)
+	if err := h.ExtractionDelete(ctx, e.ID); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	tmp, err = h.ExtractionGet(ctx, e.ID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if tmp.TMDelete == nil {
+		t.Errorf("Wrong match. expect: deleted, got: %v", tmp)
+	}
+}
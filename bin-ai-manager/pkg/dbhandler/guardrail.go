@@ -0,0 +1,13 @@
+package dbhandler
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid"
+)
+
+// GuardrailDisclaimerSet marks the aicall's disclaimer as said.
+// Returns false if it was already marked.
+func (h *handler) GuardrailDisclaimerSet(ctx context.Context, aicallID uuid.UUID) (bool, error) {
+	return h.cache.GuardrailDisclaimerSet(ctx, aicallID)
+}
@@ -0,0 +1,114 @@
+package dbhandler
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	uuid "github.com/gofrs/uuid"
+
+	commondatabasehandler "monorepo/bin-common-handler/pkg/databasehandler"
+
+	"monorepo/bin-ai-manager/models/guardrailviolation"
+)
+
+const (
+	guardrailViolationTable = "ai_guardrail_violations"
+)
+
+// GuardrailViolationCreate creates a new guardrail violation record.
+func (h *handler) GuardrailViolationCreate(ctx context.Context, v *guardrailviolation.GuardrailViolation) error {
+	v.TMCreate = h.utilHandler.TimeNow()
+	v.TMUpdate = nil
+	v.TMDelete = nil
+
+	fields, err := commondatabasehandler.PrepareFields(v)
+	if err != nil {
+		return fmt.Errorf("GuardrailViolationCreate: could not prepare fields. err: %v", err)
+	}
+
+	query, args, err := sq.Insert(guardrailViolationTable).SetMap(fields).ToSql()
+	if err != nil {
+		return fmt.Errorf("GuardrailViolationCreate: could not build query. err: %v", err)
+	}
+
+	_, err = h.db.Exec(query, args...)
+	if err != nil {
+		return fmt.Errorf("GuardrailViolationCreate: could not execute query. err: %v", err)
+	}
+
+	return nil
+}
+
+// GuardrailViolationGet returns guardrail violation.
+// Guardrail violations are read only on the API, so they are not cached.
+func (h *handler) GuardrailViolationGet(ctx context.Context, id uuid.UUID) (*guardrailviolation.GuardrailViolation, error) {
+	cols := commondatabasehandler.GetDBFields(guardrailviolation.GuardrailViolation{})
+
+	query, args, err := sq.Select(cols...).
+		From(guardrailViolationTable).
+		Where(sq.Eq{"id": id.Bytes()}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("GuardrailViolationGet: could not build query. err: %v", err)
+	}
+
+	rows, err := h.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("GuardrailViolationGet: could not query. err: %v", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	if !rows.Next() {
+		return nil, ErrNotFound
+	}
+
+	res := &guardrailviolation.GuardrailViolation{}
+	if err := commondatabasehandler.ScanRow(rows, res); err != nil {
+		return nil, fmt.Errorf("GuardrailViolationGet: could not scan row. err: %v", err)
+	}
+
+	return res, nil
+}
+
+// GuardrailViolationList returns a list of guardrail violations.
+func (h *handler) GuardrailViolationList(ctx context.Context, size uint64, token string, filters map[guardrailviolation.Field]any) ([]*guardrailviolation.GuardrailViolation, error) {
+	if token == "" {
+		token = h.utilHandler.TimeGetCurTime()
+	}
+
+	cols := commondatabasehandler.GetDBFields(guardrailviolation.GuardrailViolation{})
+
+	builder := sq.Select(cols...).
+		From(guardrailViolationTable).
+		Where(sq.Lt{"tm_create": token}).
+		OrderBy("tm_create desc").
+		Limit(size)
+
+	builder, err := commondatabasehandler.ApplyFields(builder, filters)
+	if err != nil {
+		return nil, fmt.Errorf("GuardrailViolationList: could not apply filters. err: %v", err)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("GuardrailViolationList: could not build query. err: %v", err)
+	}
+
+	rows, err := h.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("GuardrailViolationList: could not query. err: %v", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	res := []*guardrailviolation.GuardrailViolation{}
+	for rows.Next() {
+		v := &guardrailviolation.GuardrailViolation{}
+		if err := commondatabasehandler.ScanRow(rows, v); err != nil {
+			return nil, fmt.Errorf("GuardrailViolationList: could not scan row. err: %v", err)
+		}
+		res = append(res, v)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,169 @@
+package dbhandler
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"monorepo/bin-ai-manager/models/guardrail"
+	"monorepo/bin-ai-manager/models/guardrailviolation"
+	"monorepo/bin-ai-manager/pkg/cachehandler"
+	"monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func Test_GuardrailViolationCreate(t *testing.T) {
+
+	curTime := func() *time.Time { t := time.Date(2023, 1, 3, 21, 35, 2, 809000000, time.UTC); return &t }()
+
+	tests := []struct {
+		name string
+
+		violation *guardrailviolation.GuardrailViolation
+
+		responseCurTime *time.Time
+		expectRes       *guardrailviolation.GuardrailViolation
+	}{
+		{
+			name: "normal",
+
+			violation: &guardrailviolation.GuardrailViolation{
+				Identity: identity.Identity{
+					ID:         uuid.FromStringOrNil("a41e2c7a-ad5d-11f0-8b3e-5d1c7f9a2e01"),
+					CustomerID: uuid.FromStringOrNil("a44f6d18-ad5d-11f0-9a2f-2c8e4b1d7f02"),
+				},
+				AIcallID:     uuid.FromStringOrNil("a4809b26-ad5d-11f0-b1d4-7e3a9c5f2b03"),
+				AIID:         uuid.FromStringOrNil("a4b1c8f4-ad5d-11f0-8e6c-1f4d2b7a9c04"),
+				ActiveflowID: uuid.FromStringOrNil("a4e2f6c2-ad5d-11f0-a7b5-3c9e1d5f8a05"),
+				Rule:         guardrailviolation.RuleBlockedTopic,
+				Detail:       "investment advice",
+				Content:      "Here is some investment advice for you.",
+				Action:       guardrail.EscalationActionStopService,
+			},
+
+			responseCurTime: curTime,
+			expectRes: &guardrailviolation.GuardrailViolation{
+				Identity: identity.Identity{
+					ID:         uuid.FromStringOrNil("a41e2c7a-ad5d-11f0-8b3e-5d1c7f9a2e01"),
+					CustomerID: uuid.FromStringOrNil("a44f6d18-ad5d-11f0-9a2f-2c8e4b1d7f02"),
+				},
+				AIcallID:     uuid.FromStringOrNil("a4809b26-ad5d-11f0-b1d4-7e3a9c5f2b03"),
+				AIID:         uuid.FromStringOrNil("a4b1c8f4-ad5d-11f0-8e6c-1f4d2b7a9c04"),
+				ActiveflowID: uuid.FromStringOrNil("a4e2f6c2-ad5d-11f0-a7b5-3c9e1d5f8a05"),
+				Rule:         guardrailviolation.RuleBlockedTopic,
+				Detail:       "investment advice",
+				Content:      "Here is some investment advice for you.",
+				Action:       guardrail.EscalationActionStopService,
+				TMCreate:     curTime,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockCache := cachehandler.NewMockCacheHandler(mc)
+
+			h := handler{
+				utilHandler: mockUtil,
+				db:          dbTest,
+				cache:       mockCache,
+			}
+
+			ctx := context.Background()
+
+			mockUtil.EXPECT().TimeNow().Return(tt.responseCurTime)
+			if err := h.GuardrailViolationCreate(ctx, tt.violation); err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+
+			res, err := h.GuardrailViolationGet(ctx, tt.violation.ID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_GuardrailViolationList(t *testing.T) {
+
+	curTime := func() *time.Time { t := time.Date(2023, 1, 3, 21, 35, 2, 809000000, time.UTC); return &t }()
+	customerID := uuid.FromStringOrNil("a5142d90-ad5d-11f0-9d8e-6b2f4c1a7e06")
+	aicallID := uuid.FromStringOrNil("a5456b5e-ad5d-11f0-8c1a-4e7d2f9b3a07")
+
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockUtil := utilhandler.NewMockUtilHandler(mc)
+	mockCache := cachehandler.NewMockCacheHandler(mc)
+
+	h := handler{
+		utilHandler: mockUtil,
+		db:          dbTest,
+		cache:       mockCache,
+	}
+
+	ctx := context.Background()
+
+	v := &guardrailviolation.GuardrailViolation{
+		Identity: identity.Identity{
+			ID:         uuid.FromStringOrNil("a5769f2c-ad5d-11f0-b4e7-1a5c8d3f6b08"),
+			CustomerID: customerID,
+		},
+		AIcallID: aicallID,
+		Rule:     guardrailviolation.RuleMaxConsecutiveToolCalls,
+		Detail:   "4",
+		Content:  "get_variables",
+	}
+
+	mockUtil.EXPECT().TimeNow().Return(curTime)
+	if err := h.GuardrailViolationCreate(ctx, v); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	res, err := h.GuardrailViolationList(ctx, 10, utilhandler.TimeGetCurTime(), map[guardrailviolation.Field]any{
+		guardrailviolation.FieldCustomerID: customerID,
+		guardrailviolation.FieldAIcallID:   aicallID,
+		guardrailviolation.FieldDeleted:    false,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(res) != 1 || res[0].ID != v.ID {
+		t.Errorf("Wrong match. expect: %s, got: %v", v.ID, res)
+	}
+}
+
+func Test_GuardrailDisclaimerSet(t *testing.T) {
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockCache := cachehandler.NewMockCacheHandler(mc)
+	h := handler{
+		db:    dbTest,
+		cache: mockCache,
+	}
+	ctx := context.Background()
+
+	aicallID := uuid.FromStringOrNil("a5a7d4fa-ad5d-11f0-9f2b-8d6e1c4a7f09")
+
+	mockCache.EXPECT().GuardrailDisclaimerSet(ctx, aicallID).Return(true, nil)
+	res, err := h.GuardrailDisclaimerSet(ctx, aicallID)
+	if err != nil {
+		t.Errorf("Wrong match. expect: ok, got: %v", err)
+	}
+	if !res {
+		t.Errorf("Wrong match. expect: true, got: false")
+	}
+}
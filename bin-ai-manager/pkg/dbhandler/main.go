@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"errors"
 	"strings"
+	"time"
 
 	"monorepo/bin-common-handler/pkg/utilhandler"
 
@@ -15,13 +16,22 @@ import (
 
 	"monorepo/bin-ai-manager/models/ai"
 	"monorepo/bin-ai-manager/models/aicall"
+	"monorepo/bin-ai-manager/models/aiassist"
 	"monorepo/bin-ai-manager/models/aiaudit"
 	"monorepo/bin-ai-manager/models/aiprompthistory"
 	"monorepo/bin-ai-manager/models/aipromptproposal"
+	"monorepo/bin-ai-manager/models/customtool"
+	"monorepo/bin-ai-manager/models/extraction"
+	"monorepo/bin-ai-manager/models/guardrailviolation"
+	"monorepo/bin-ai-manager/models/mcpserver"
 	"monorepo/bin-ai-manager/models/message"
 	"monorepo/bin-ai-manager/models/participant"
+	"monorepo/bin-ai-manager/models/responsecache"
 	"monorepo/bin-ai-manager/models/summary"
 	"monorepo/bin-ai-manager/models/team"
+	"monorepo/bin-ai-manager/models/testrun"
+	"monorepo/bin-ai-manager/models/testsuite"
+	"monorepo/bin-ai-manager/models/usage"
 	"monorepo/bin-ai-manager/pkg/cachehandler"
 )
 
@@ -47,6 +57,19 @@ type DBHandler interface {
 	AIcallList(ctx context.Context, size uint64, token string, filters map[aicall.Field]any) ([]*aicall.AIcall, error)
 	AIcallUpdate(ctx context.Context, id uuid.UUID, fields map[aicall.Field]any) error
 	AIcallUpdateIfActive(ctx context.Context, id uuid.UUID, fields map[aicall.Field]any) (rowsAffected int64, err error)
+	AIcallAddUsage(ctx context.Context, id uuid.UUID, pipecatcallID uuid.UUID, u *usage.Usage) (rowsAffected int64, err error)
+
+	RedactionTokenSet(ctx context.Context, aicallID uuid.UUID, token string, value string, ttl time.Duration) error
+	RedactionTokenRefresh(ctx context.Context, aicallID uuid.UUID, ttl time.Duration) error
+	RedactionTokenGets(ctx context.Context, aicallID uuid.UUID) (map[string]string, error)
+
+	GuardrailDisclaimerSet(ctx context.Context, aicallID uuid.UUID) (bool, error)
+
+	ResponseCacheEntryGets(ctx context.Context, bucket string) ([]*responsecache.Entry, error)
+	ResponseCacheEntryGetByHash(ctx context.Context, bucket string, hash string) (*responsecache.Entry, error)
+	ResponseCacheEntryAdd(ctx context.Context, bucket string, hash string, e *responsecache.Entry, ttl time.Duration) error
+	ResponseCachePendingSet(ctx context.Context, aicallID uuid.UUID, p *responsecache.Pending) error
+	ResponseCachePendingPop(ctx context.Context, aicallID uuid.UUID) (*responsecache.Pending, error)
 
 	MessageCreate(ctx context.Context, c *message.Message) error
 	MessageGet(ctx context.Context, id uuid.UUID) (*message.Message, error)
@@ -61,11 +84,30 @@ type DBHandler interface {
 	SummaryList(ctx context.Context, size uint64, token string, filters map[summary.Field]any) ([]*summary.Summary, error)
 	SummaryUpdate(ctx context.Context, id uuid.UUID, fields map[summary.Field]any) error
 
+	ExtractionCreate(ctx context.Context, e *extraction.Extraction) error
+	ExtractionGet(ctx context.Context, id uuid.UUID) (*extraction.Extraction, error)
+	ExtractionDelete(ctx context.Context, id uuid.UUID) error
+	ExtractionList(ctx context.Context, size uint64, token string, filters map[extraction.Field]any) ([]*extraction.Extraction, error)
+	ExtractionUpdate(ctx context.Context, id uuid.UUID, fields map[extraction.Field]any) error
+
+	AIAssistCreate(ctx context.Context, a *aiassist.AIAssist) error
+	AIAssistGet(ctx context.Context, id uuid.UUID) (*aiassist.AIAssist, error)
+	AIAssistDelete(ctx context.Context, id uuid.UUID) error
+	AIAssistList(ctx context.Context, size uint64, token string, filters map[aiassist.Field]any) ([]*aiassist.AIAssist, error)
+	AIAssistUpdate(ctx context.Context, id uuid.UUID, fields map[aiassist.Field]any) error
+	AIAssistAddUsage(ctx context.Context, id uuid.UUID, u *usage.Usage) error
+	AIAssistAnalyzeClaim(ctx context.Context, id uuid.UUID, leaseUntil *time.Time) (bool, error)
+	AIAssistAnalyzeRelease(ctx context.Context, id uuid.UUID, leaseUntil *time.Time) (bool, error)
+
+	GuardrailViolationCreate(ctx context.Context, v *guardrailviolation.GuardrailViolation) error
+	GuardrailViolationGet(ctx context.Context, id uuid.UUID) (*guardrailviolation.GuardrailViolation, error)
+	GuardrailViolationList(ctx context.Context, size uint64, token string, filters map[guardrailviolation.Field]any) ([]*guardrailviolation.GuardrailViolation, error)
+
 	AIAuditUpsert(ctx context.Context, a *aiaudit.AIAudit) (rowsAffected int64, err error)
 	AIAuditGet(ctx context.Context, id uuid.UUID) (*aiaudit.AIAudit, error)
 	AIAuditList(ctx context.Context, size uint64, token string, filters map[aiaudit.Field]any) ([]*aiaudit.AIAudit, error)
 	AIAuditDelete(ctx context.Context, id uuid.UUID) error
-	AIAuditUpdateFinal(ctx context.Context, id uuid.UUID, status aiaudit.Status, overallScore *int, evaluation json.RawMessage, errStr string, messageIDs []uuid.UUID) (rowsAffected int64, err error)
+	AIAuditUpdateFinal(ctx context.Context, id uuid.UUID, status aiaudit.Status, overallScore *int, evaluation json.RawMessage, errStr string, messageIDs []uuid.UUID, u *usage.Usage) (rowsAffected int64, err error)
 	AIAuditCountProgressing(ctx context.Context, customerID uuid.UUID) (int64, error)
 
 	TeamCreate(ctx context.Context, t *team.Team) error
@@ -74,6 +116,30 @@ type DBHandler interface {
 	TeamList(ctx context.Context, size uint64, token string, filters map[team.Field]any) ([]*team.Team, error)
 	TeamUpdate(ctx context.Context, id uuid.UUID, fields map[team.Field]any) error
 
+	CustomToolCreate(ctx context.Context, c *customtool.CustomTool) error
+	CustomToolDelete(ctx context.Context, id uuid.UUID) error
+	CustomToolGet(ctx context.Context, id uuid.UUID) (*customtool.CustomTool, error)
+	CustomToolList(ctx context.Context, size uint64, token string, filters map[customtool.Field]any) ([]*customtool.CustomTool, error)
+	CustomToolUpdate(ctx context.Context, id uuid.UUID, fields map[customtool.Field]any) error
+
+	MCPServerCreate(ctx context.Context, s *mcpserver.MCPServer) error
+	MCPServerDelete(ctx context.Context, id uuid.UUID) error
+	MCPServerGet(ctx context.Context, id uuid.UUID) (*mcpserver.MCPServer, error)
+	MCPServerList(ctx context.Context, size uint64, token string, filters map[mcpserver.Field]any) ([]*mcpserver.MCPServer, error)
+	MCPServerUpdate(ctx context.Context, id uuid.UUID, fields map[mcpserver.Field]any) error
+
+	TestSuiteCreate(ctx context.Context, s *testsuite.TestSuite) error
+	TestSuiteDelete(ctx context.Context, id uuid.UUID) error
+	TestSuiteGet(ctx context.Context, id uuid.UUID) (*testsuite.TestSuite, error)
+	TestSuiteList(ctx context.Context, size uint64, token string, filters map[testsuite.Field]any) ([]*testsuite.TestSuite, error)
+	TestSuiteUpdate(ctx context.Context, id uuid.UUID, fields map[testsuite.Field]any) error
+
+	TestRunCreate(ctx context.Context, r *testrun.TestRun) error
+	TestRunDelete(ctx context.Context, id uuid.UUID) error
+	TestRunGet(ctx context.Context, id uuid.UUID) (*testrun.TestRun, error)
+	TestRunList(ctx context.Context, size uint64, token string, filters map[testrun.Field]any) ([]*testrun.TestRun, error)
+	TestRunUpdate(ctx context.Context, id uuid.UUID, fields map[testrun.Field]any) error
+
 	// Participant
 	ParticipantCreate(ctx context.Context, aicallID uuid.UUID, aiID uuid.UUID) error
 	ParticipantListByAIcallID(ctx context.Context, aicallID uuid.UUID, size uint64, token string) ([]*participant.Participant, error)
@@ -0,0 +1,166 @@
+package dbhandler
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	uuid "github.com/gofrs/uuid"
+
+	commondatabasehandler "monorepo/bin-common-handler/pkg/databasehandler"
+
+	"monorepo/bin-ai-manager/models/mcpserver"
+)
+
+const (
+	mcpServerTable = "ai_mcp_servers"
+)
+
+// MCPServerCreate creates a new MCP server record.
+func (h *handler) MCPServerCreate(ctx context.Context, s *mcpserver.MCPServer) error {
+	s.TMCreate = h.utilHandler.TimeNow()
+	s.TMUpdate = nil
+	s.TMDelete = nil
+
+	fields, err := commondatabasehandler.PrepareFields(s)
+	if err != nil {
+		return fmt.Errorf("MCPServerCreate: could not prepare fields. err: %v", err)
+	}
+
+	query, args, err := sq.Insert(mcpServerTable).SetMap(fields).ToSql()
+	if err != nil {
+		return fmt.Errorf("MCPServerCreate: could not build query. err: %v", err)
+	}
+
+	_, err = h.db.Exec(query, args...)
+	if err != nil {
+		return fmt.Errorf("MCPServerCreate: could not execute query. err: %v", err)
+	}
+
+	return nil
+}
+
+// MCPServerGet returns MCP server.
+// Custom tools are read once per LLM tool call, so they are not cached.
+func (h *handler) MCPServerGet(ctx context.Context, id uuid.UUID) (*mcpserver.MCPServer, error) {
+	cols := commondatabasehandler.GetDBFields(mcpserver.MCPServer{})
+
+	query, args, err := sq.Select(cols...).
+		From(mcpServerTable).
+		Where(sq.Eq{"id": id.Bytes()}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("MCPServerGet: could not build query. err: %v", err)
+	}
+
+	rows, err := h.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("MCPServerGet: could not query. err: %v", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	if !rows.Next() {
+		return nil, ErrNotFound
+	}
+
+	res := &mcpserver.MCPServer{}
+	if err := commondatabasehandler.ScanRow(rows, res); err != nil {
+		return nil, fmt.Errorf("MCPServerGet: could not scan row. err: %v", err)
+	}
+
+	return res, nil
+}
+
+// MCPServerDelete deletes the MCP server.
+func (h *handler) MCPServerDelete(ctx context.Context, id uuid.UUID) error {
+	ts := h.utilHandler.TimeNow()
+
+	query, args, err := sq.Update(mcpServerTable).
+		SetMap(map[string]any{
+			"tm_update": ts,
+			"tm_delete": ts,
+		}).
+		Where(sq.Eq{"id": id.Bytes()}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("MCPServerDelete: could not build query. err: %v", err)
+	}
+
+	_, err = h.db.Exec(query, args...)
+	if err != nil {
+		return fmt.Errorf("MCPServerDelete: could not execute. err: %v", err)
+	}
+
+	return nil
+}
+
+// MCPServerList returns a list of MCP servers.
+func (h *handler) MCPServerList(ctx context.Context, size uint64, token string, filters map[mcpserver.Field]any) ([]*mcpserver.MCPServer, error) {
+	if token == "" {
+		token = h.utilHandler.TimeGetCurTime()
+	}
+
+	cols := commondatabasehandler.GetDBFields(mcpserver.MCPServer{})
+
+	builder := sq.Select(cols...).
+		From(mcpServerTable).
+		Where(sq.Lt{"tm_create": token}).
+		OrderBy("tm_create desc").
+		Limit(size)
+
+	builder, err := commondatabasehandler.ApplyFields(builder, filters)
+	if err != nil {
+		return nil, fmt.Errorf("MCPServerList: could not apply filters. err: %v", err)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("MCPServerList: could not build query. err: %v", err)
+	}
+
+	rows, err := h.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("MCPServerList: could not query. err: %v", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	res := []*mcpserver.MCPServer{}
+	for rows.Next() {
+		c := &mcpserver.MCPServer{}
+		if err := commondatabasehandler.ScanRow(rows, c); err != nil {
+			return nil, fmt.Errorf("MCPServerList: could not scan row. err: %v", err)
+		}
+		res = append(res, c)
+	}
+
+	return res, nil
+}
+
+// MCPServerUpdate updates the MCP server fields.
+func (h *handler) MCPServerUpdate(ctx context.Context, id uuid.UUID, fields map[mcpserver.Field]any) error {
+	updateFields := make(map[string]any)
+	for k, v := range fields {
+		updateFields[string(k)] = v
+	}
+	updateFields["tm_update"] = h.utilHandler.TimeNow()
+
+	preparedFields, err := commondatabasehandler.PrepareFields(updateFields)
+	if err != nil {
+		return fmt.Errorf("MCPServerUpdate: could not prepare fields. err: %v", err)
+	}
+
+	query, args, err := sq.Update(mcpServerTable).
+		SetMap(preparedFields).
+		Where(sq.Eq{"id": id.Bytes()}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("MCPServerUpdate: could not build query. err: %v", err)
+	}
+
+	_, err = h.db.Exec(query, args...)
+	if err != nil {
+		return fmt.Errorf("MCPServerUpdate: could not execute. err: %v", err)
+	}
+
+	return nil
+}
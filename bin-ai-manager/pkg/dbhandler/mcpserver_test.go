@@ -0,0 +1,175 @@
+package dbhandler
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"monorepo/bin-ai-manager/models/mcpserver"
+	"monorepo/bin-ai-manager/pkg/cachehandler"
+	"monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func Test_MCPServerCreate(t *testing.T) {
+
+	curTime := func() *time.Time { t := time.Date(2023, 1, 3, 21, 35, 2, 809000000, time.UTC); return &t }()
+
+	tests := []struct {
+		name string
+
+		mcpServer *mcpserver.MCPServer
+
+		responseCurTime *time.Time
+		expectRes       *mcpserver.MCPServer
+	}{
+		{
+			name: "normal",
+
+			mcpServer: &mcpserver.MCPServer{
+				Identity: identity.Identity{
+					ID:         uuid.FromStringOrNil("a1b2c3d4-a905-11f0-8e1f-1a2b3c4d5e01"),
+					CustomerID: uuid.FromStringOrNil("a1e4f5a6-a905-11f0-9f2a-2b3c4d5e6f02"),
+				},
+				Name:      "crm",
+				Detail:    "internal crm",
+				Transport: mcpserver.TransportStreamableHTTP,
+				URL:       "https://mcp.example.com/mcp",
+				Headers: map[string]string{
+					"Authorization": "Bearer token",
+				},
+				Timeout:      10000,
+				AllowedTools: []string{"lookup_contact"},
+			},
+
+			responseCurTime: curTime,
+			expectRes: &mcpserver.MCPServer{
+				Identity: identity.Identity{
+					ID:         uuid.FromStringOrNil("a1b2c3d4-a905-11f0-8e1f-1a2b3c4d5e01"),
+					CustomerID: uuid.FromStringOrNil("a1e4f5a6-a905-11f0-9f2a-2b3c4d5e6f02"),
+				},
+				Name:      "crm",
+				Detail:    "internal crm",
+				Transport: mcpserver.TransportStreamableHTTP,
+				URL:       "https://mcp.example.com/mcp",
+				Headers: map[string]string{
+					"Authorization": "Bearer token",
+				},
+				Timeout:      10000,
+				AllowedTools: []string{"lookup_contact"},
+				TMCreate:     curTime,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockCache := cachehandler.NewMockCacheHandler(mc)
+
+			h := handler{
+				utilHandler: mockUtil,
+				db:          dbTest,
+				cache:       mockCache,
+			}
+
+			ctx := context.Background()
+
+			mockUtil.EXPECT().TimeNow().Return(tt.responseCurTime)
+			if err := h.MCPServerCreate(ctx, tt.mcpServer); err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+
+			res, err := h.MCPServerGet(ctx, tt.mcpServer.ID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_MCPServerListUpdateDelete(t *testing.T) {
+
+	curTime := func() *time.Time { t := time.Date(2023, 1, 3, 21, 35, 2, 809000000, time.UTC); return &t }()
+	customerID := uuid.FromStringOrNil("b2c3d4e5-a905-11f0-a03b-3c4d5e6f7a03")
+
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockUtil := utilhandler.NewMockUtilHandler(mc)
+	mockCache := cachehandler.NewMockCacheHandler(mc)
+
+	h := handler{
+		utilHandler: mockUtil,
+		db:          dbTest,
+		cache:       mockCache,
+	}
+
+	ctx := context.Background()
+
+	s := &mcpserver.MCPServer{
+		Identity: identity.Identity{
+			ID:         uuid.FromStringOrNil("b2f6a7b8-a905-11f0-b14c-4d5e6f7a8b04"),
+			CustomerID: customerID,
+		},
+		Name:      "ticketing",
+		Transport: mcpserver.TransportSSE,
+	}
+
+	mockUtil.EXPECT().TimeNow().Return(curTime)
+	if err := h.MCPServerCreate(ctx, s); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	res, err := h.MCPServerList(ctx, 10, utilhandler.TimeGetCurTime(), map[mcpserver.Field]any{
+		mcpserver.FieldCustomerID: customerID,
+		mcpserver.FieldName:       "ticketing",
+		mcpserver.FieldDeleted:    false,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(res) != 1 || res[0].ID != s.ID {
+		t.Errorf("Wrong match. expect: %s, got: %v", s.ID, res)
+	}
+
+	mockUtil.EXPECT().TimeNow().Return(curTime)
+	if err := h.MCPServerUpdate(ctx, s.ID, map[mcpserver.Field]any{
+		mcpserver.FieldURL:          "https://mcp.example.com/v2",
+		mcpserver.FieldAllowedTools: []string{"create_ticket"},
+	}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	tmp, err := h.MCPServerGet(ctx, s.ID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if tmp.URL != "https://mcp.example.com/v2" || !reflect.DeepEqual(tmp.AllowedTools, []string{"create_ticket"}) || tmp.TMUpdate == nil {
+		t.Errorf("Wrong match. got: %v", tmp)
+	}
+
+	mockUtil.EXPECT().TimeNow().Return(curTime)
+	if err := h.MCPServerDelete(ctx, s.ID); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	tmp, err = h.MCPServerGet(ctx, s.ID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if tmp.TMDelete == nil {
+		t.Errorf("Wrong match. expect: deleted, got: %v", tmp)
+	}
+}
@@ -13,15 +13,25 @@ import (
 	context "context"
 	json "encoding/json"
 	ai "monorepo/bin-ai-manager/models/ai"
+	aiassist "monorepo/bin-ai-manager/models/aiassist"
 	aiaudit "monorepo/bin-ai-manager/models/aiaudit"
 	aicall "monorepo/bin-ai-manager/models/aicall"
 	aiprompthistory "monorepo/bin-ai-manager/models/aiprompthistory"
 	aipromptproposal "monorepo/bin-ai-manager/models/aipromptproposal"
+	customtool "monorepo/bin-ai-manager/models/customtool"
+	extraction "monorepo/bin-ai-manager/models/extraction"
+	guardrailviolation "monorepo/bin-ai-manager/models/guardrailviolation"
+	mcpserver "monorepo/bin-ai-manager/models/mcpserver"
 	message "monorepo/bin-ai-manager/models/message"
 	participant "monorepo/bin-ai-manager/models/participant"
+	responsecache "monorepo/bin-ai-manager/models/responsecache"
 	summary "monorepo/bin-ai-manager/models/summary"
 	team "monorepo/bin-ai-manager/models/team"
+	testrun "monorepo/bin-ai-manager/models/testrun"
+	testsuite "monorepo/bin-ai-manager/models/testsuite"
+	usage "monorepo/bin-ai-manager/models/usage"
 	reflect "reflect"
+	time "time"
 
 	uuid "github.com/gofrs/uuid"
 	gomock "go.uber.org/mock/gomock"
@@ -81,6 +91,122 @@ func (mr *MockDBHandlerMockRecorder) AIActivateInsight(ctx, id any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIActivateInsight", reflect.TypeOf((*MockDBHandler)(nil).AIActivateInsight), ctx, id)
 }
 
+// AIAssistAddUsage mocks base method.
+func (m *MockDBHandler) AIAssistAddUsage(ctx context.Context, id uuid.UUID, u *usage.Usage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIAssistAddUsage", ctx, id, u)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AIAssistAddUsage indicates an expected call of AIAssistAddUsage.
+func (mr *MockDBHandlerMockRecorder) AIAssistAddUsage(ctx, id, u any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIAssistAddUsage", reflect.TypeOf((*MockDBHandler)(nil).AIAssistAddUsage), ctx, id, u)
+}
+
+// AIAssistAnalyzeClaim mocks base method.
+func (m *MockDBHandler) AIAssistAnalyzeClaim(ctx context.Context, id uuid.UUID, leaseUntil *time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIAssistAnalyzeClaim", ctx, id, leaseUntil)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AIAssistAnalyzeClaim indicates an expected call of AIAssistAnalyzeClaim.
+func (mr *MockDBHandlerMockRecorder) AIAssistAnalyzeClaim(ctx, id, leaseUntil any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIAssistAnalyzeClaim", reflect.TypeOf((*MockDBHandler)(nil).AIAssistAnalyzeClaim), ctx, id, leaseUntil)
+}
+
+// AIAssistAnalyzeRelease mocks base method.
+func (m *MockDBHandler) AIAssistAnalyzeRelease(ctx context.Context, id uuid.UUID, leaseUntil *time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIAssistAnalyzeRelease", ctx, id, leaseUntil)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AIAssistAnalyzeRelease indicates an expected call of AIAssistAnalyzeRelease.
+func (mr *MockDBHandlerMockRecorder) AIAssistAnalyzeRelease(ctx, id, leaseUntil any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIAssistAnalyzeRelease", reflect.TypeOf((*MockDBHandler)(nil).AIAssistAnalyzeRelease), ctx, id, leaseUntil)
+}
+
+// AIAssistCreate mocks base method.
+func (m *MockDBHandler) AIAssistCreate(ctx context.Context, a *aiassist.AIAssist) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIAssistCreate", ctx, a)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AIAssistCreate indicates an expected call of AIAssistCreate.
+func (mr *MockDBHandlerMockRecorder) AIAssistCreate(ctx, a any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIAssistCreate", reflect.TypeOf((*MockDBHandler)(nil).AIAssistCreate), ctx, a)
+}
+
+// AIAssistDelete mocks base method.
+func (m *MockDBHandler) AIAssistDelete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIAssistDelete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AIAssistDelete indicates an expected call of AIAssistDelete.
+func (mr *MockDBHandlerMockRecorder) AIAssistDelete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIAssistDelete", reflect.TypeOf((*MockDBHandler)(nil).AIAssistDelete), ctx, id)
+}
+
+// AIAssistGet mocks base method.
+func (m *MockDBHandler) AIAssistGet(ctx context.Context, id uuid.UUID) (*aiassist.AIAssist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIAssistGet", ctx, id)
+	ret0, _ := ret[0].(*aiassist.AIAssist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AIAssistGet indicates an expected call of AIAssistGet.
+func (mr *MockDBHandlerMockRecorder) AIAssistGet(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIAssistGet", reflect.TypeOf((*MockDBHandler)(nil).AIAssistGet), ctx, id)
+}
+
+// AIAssistList mocks base method.
+func (m *MockDBHandler) AIAssistList(ctx context.Context, size uint64, token string, filters map[aiassist.Field]any) ([]*aiassist.AIAssist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIAssistList", ctx, size, token, filters)
+	ret0, _ := ret[0].([]*aiassist.AIAssist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AIAssistList indicates an expected call of AIAssistList.
+func (mr *MockDBHandlerMockRecorder) AIAssistList(ctx, size, token, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIAssistList", reflect.TypeOf((*MockDBHandler)(nil).AIAssistList), ctx, size, token, filters)
+}
+
+// AIAssistUpdate mocks base method.
+func (m *MockDBHandler) AIAssistUpdate(ctx context.Context, id uuid.UUID, fields map[aiassist.Field]any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIAssistUpdate", ctx, id, fields)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AIAssistUpdate indicates an expected call of AIAssistUpdate.
+func (mr *MockDBHandlerMockRecorder) AIAssistUpdate(ctx, id, fields any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIAssistUpdate", reflect.TypeOf((*MockDBHandler)(nil).AIAssistUpdate), ctx, id, fields)
+}
+
 // AIAuditCountProgressing mocks base method.
 func (m *MockDBHandler) AIAuditCountProgressing(ctx context.Context, customerID uuid.UUID) (int64, error) {
 	m.ctrl.T.Helper()
@@ -141,18 +267,18 @@ func (mr *MockDBHandlerMockRecorder) AIAuditList(ctx, size, token, filters any)
 }
 
 // AIAuditUpdateFinal mocks base method.
-func (m *MockDBHandler) AIAuditUpdateFinal(ctx context.Context, id uuid.UUID, status aiaudit.Status, overallScore *int, evaluation json.RawMessage, errStr string, messageIDs []uuid.UUID) (int64, error) {
+func (m *MockDBHandler) AIAuditUpdateFinal(ctx context.Context, id uuid.UUID, status aiaudit.Status, overallScore *int, evaluation json.RawMessage, errStr string, messageIDs []uuid.UUID, u *usage.Usage) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AIAuditUpdateFinal", ctx, id, status, overallScore, evaluation, errStr, messageIDs)
+	ret := m.ctrl.Call(m, "AIAuditUpdateFinal", ctx, id, status, overallScore, evaluation, errStr, messageIDs, u)
 	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AIAuditUpdateFinal indicates an expected call of AIAuditUpdateFinal.
-func (mr *MockDBHandlerMockRecorder) AIAuditUpdateFinal(ctx, id, status, overallScore, evaluation, errStr, messageIDs any) *gomock.Call {
+func (mr *MockDBHandlerMockRecorder) AIAuditUpdateFinal(ctx, id, status, overallScore, evaluation, errStr, messageIDs, u any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIAuditUpdateFinal", reflect.TypeOf((*MockDBHandler)(nil).AIAuditUpdateFinal), ctx, id, status, overallScore, evaluation, errStr, messageIDs)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIAuditUpdateFinal", reflect.TypeOf((*MockDBHandler)(nil).AIAuditUpdateFinal), ctx, id, status, overallScore, evaluation, errStr, messageIDs, u)
 }
 
 // AIAuditUpsert mocks base method.
@@ -404,6 +530,21 @@ func (mr *MockDBHandlerMockRecorder) AIUpdate(ctx, id, fields any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIUpdate", reflect.TypeOf((*MockDBHandler)(nil).AIUpdate), ctx, id, fields)
 }
 
+// AIcallAddUsage mocks base method.
+func (m *MockDBHandler) AIcallAddUsage(ctx context.Context, id, pipecatcallID uuid.UUID, u *usage.Usage) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIcallAddUsage", ctx, id, pipecatcallID, u)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AIcallAddUsage indicates an expected call of AIcallAddUsage.
+func (mr *MockDBHandlerMockRecorder) AIcallAddUsage(ctx, id, pipecatcallID, u any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIcallAddUsage", reflect.TypeOf((*MockDBHandler)(nil).AIcallAddUsage), ctx, id, pipecatcallID, u)
+}
+
 // AIcallCreate mocks base method.
 func (m *MockDBHandler) AIcallCreate(ctx context.Context, cb *aicall.AIcall) error {
 	m.ctrl.T.Helper()
@@ -506,6 +647,281 @@ func (mr *MockDBHandlerMockRecorder) AIcallUpdateIfActive(ctx, id, fields any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIcallUpdateIfActive", reflect.TypeOf((*MockDBHandler)(nil).AIcallUpdateIfActive), ctx, id, fields)
 }
 
+// CustomToolCreate mocks base method.
+func (m *MockDBHandler) CustomToolCreate(ctx context.Context, c *customtool.CustomTool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CustomToolCreate", ctx, c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CustomToolCreate indicates an expected call of CustomToolCreate.
+func (mr *MockDBHandlerMockRecorder) CustomToolCreate(ctx, c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CustomToolCreate", reflect.TypeOf((*MockDBHandler)(nil).CustomToolCreate), ctx, c)
+}
+
+// CustomToolDelete mocks base method.
+func (m *MockDBHandler) CustomToolDelete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CustomToolDelete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CustomToolDelete indicates an expected call of CustomToolDelete.
+func (mr *MockDBHandlerMockRecorder) CustomToolDelete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CustomToolDelete", reflect.TypeOf((*MockDBHandler)(nil).CustomToolDelete), ctx, id)
+}
+
+// CustomToolGet mocks base method.
+func (m *MockDBHandler) CustomToolGet(ctx context.Context, id uuid.UUID) (*customtool.CustomTool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CustomToolGet", ctx, id)
+	ret0, _ := ret[0].(*customtool.CustomTool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CustomToolGet indicates an expected call of CustomToolGet.
+func (mr *MockDBHandlerMockRecorder) CustomToolGet(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CustomToolGet", reflect.TypeOf((*MockDBHandler)(nil).CustomToolGet), ctx, id)
+}
+
+// CustomToolList mocks base method.
+func (m *MockDBHandler) CustomToolList(ctx context.Context, size uint64, token string, filters map[customtool.Field]any) ([]*customtool.CustomTool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CustomToolList", ctx, size, token, filters)
+	ret0, _ := ret[0].([]*customtool.CustomTool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CustomToolList indicates an expected call of CustomToolList.
+func (mr *MockDBHandlerMockRecorder) CustomToolList(ctx, size, token, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CustomToolList", reflect.TypeOf((*MockDBHandler)(nil).CustomToolList), ctx, size, token, filters)
+}
+
+// CustomToolUpdate mocks base method.
+func (m *MockDBHandler) CustomToolUpdate(ctx context.Context, id uuid.UUID, fields map[customtool.Field]any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CustomToolUpdate", ctx, id, fields)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CustomToolUpdate indicates an expected call of CustomToolUpdate.
+func (mr *MockDBHandlerMockRecorder) CustomToolUpdate(ctx, id, fields any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CustomToolUpdate", reflect.TypeOf((*MockDBHandler)(nil).CustomToolUpdate), ctx, id, fields)
+}
+
+// ExtractionCreate mocks base method.
+func (m *MockDBHandler) ExtractionCreate(ctx context.Context, e *extraction.Extraction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtractionCreate", ctx, e)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExtractionCreate indicates an expected call of ExtractionCreate.
+func (mr *MockDBHandlerMockRecorder) ExtractionCreate(ctx, e any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtractionCreate", reflect.TypeOf((*MockDBHandler)(nil).ExtractionCreate), ctx, e)
+}
+
+// ExtractionDelete mocks base method.
+func (m *MockDBHandler) ExtractionDelete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtractionDelete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExtractionDelete indicates an expected call of ExtractionDelete.
+func (mr *MockDBHandlerMockRecorder) ExtractionDelete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtractionDelete", reflect.TypeOf((*MockDBHandler)(nil).ExtractionDelete), ctx, id)
+}
+
+// ExtractionGet mocks base method.
+func (m *MockDBHandler) ExtractionGet(ctx context.Context, id uuid.UUID) (*extraction.Extraction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtractionGet", ctx, id)
+	ret0, _ := ret[0].(*extraction.Extraction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExtractionGet indicates an expected call of ExtractionGet.
+func (mr *MockDBHandlerMockRecorder) ExtractionGet(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtractionGet", reflect.TypeOf((*MockDBHandler)(nil).ExtractionGet), ctx, id)
+}
+
+// ExtractionList mocks base method.
+func (m *MockDBHandler) ExtractionList(ctx context.Context, size uint64, token string, filters map[extraction.Field]any) ([]*extraction.Extraction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtractionList", ctx, size, token, filters)
+	ret0, _ := ret[0].([]*extraction.Extraction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExtractionList indicates an expected call of ExtractionList.
+func (mr *MockDBHandlerMockRecorder) ExtractionList(ctx, size, token, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtractionList", reflect.TypeOf((*MockDBHandler)(nil).ExtractionList), ctx, size, token, filters)
+}
+
+// ExtractionUpdate mocks base method.
+func (m *MockDBHandler) ExtractionUpdate(ctx context.Context, id uuid.UUID, fields map[extraction.Field]any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtractionUpdate", ctx, id, fields)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExtractionUpdate indicates an expected call of ExtractionUpdate.
+func (mr *MockDBHandlerMockRecorder) ExtractionUpdate(ctx, id, fields any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtractionUpdate", reflect.TypeOf((*MockDBHandler)(nil).ExtractionUpdate), ctx, id, fields)
+}
+
+// GuardrailDisclaimerSet mocks base method.
+func (m *MockDBHandler) GuardrailDisclaimerSet(ctx context.Context, aicallID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuardrailDisclaimerSet", ctx, aicallID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuardrailDisclaimerSet indicates an expected call of GuardrailDisclaimerSet.
+func (mr *MockDBHandlerMockRecorder) GuardrailDisclaimerSet(ctx, aicallID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuardrailDisclaimerSet", reflect.TypeOf((*MockDBHandler)(nil).GuardrailDisclaimerSet), ctx, aicallID)
+}
+
+// GuardrailViolationCreate mocks base method.
+func (m *MockDBHandler) GuardrailViolationCreate(ctx context.Context, v *guardrailviolation.GuardrailViolation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuardrailViolationCreate", ctx, v)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GuardrailViolationCreate indicates an expected call of GuardrailViolationCreate.
+func (mr *MockDBHandlerMockRecorder) GuardrailViolationCreate(ctx, v any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuardrailViolationCreate", reflect.TypeOf((*MockDBHandler)(nil).GuardrailViolationCreate), ctx, v)
+}
+
+// GuardrailViolationGet mocks base method.
+func (m *MockDBHandler) GuardrailViolationGet(ctx context.Context, id uuid.UUID) (*guardrailviolation.GuardrailViolation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuardrailViolationGet", ctx, id)
+	ret0, _ := ret[0].(*guardrailviolation.GuardrailViolation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuardrailViolationGet indicates an expected call of GuardrailViolationGet.
+func (mr *MockDBHandlerMockRecorder) GuardrailViolationGet(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuardrailViolationGet", reflect.TypeOf((*MockDBHandler)(nil).GuardrailViolationGet), ctx, id)
+}
+
+// GuardrailViolationList mocks base method.
+func (m *MockDBHandler) GuardrailViolationList(ctx context.Context, size uint64, token string, filters map[guardrailviolation.Field]any) ([]*guardrailviolation.GuardrailViolation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuardrailViolationList", ctx, size, token, filters)
+	ret0, _ := ret[0].([]*guardrailviolation.GuardrailViolation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuardrailViolationList indicates an expected call of GuardrailViolationList.
+func (mr *MockDBHandlerMockRecorder) GuardrailViolationList(ctx, size, token, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuardrailViolationList", reflect.TypeOf((*MockDBHandler)(nil).GuardrailViolationList), ctx, size, token, filters)
+}
+
+// MCPServerCreate mocks base method.
+func (m *MockDBHandler) MCPServerCreate(ctx context.Context, s *mcpserver.MCPServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MCPServerCreate", ctx, s)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MCPServerCreate indicates an expected call of MCPServerCreate.
+func (mr *MockDBHandlerMockRecorder) MCPServerCreate(ctx, s any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MCPServerCreate", reflect.TypeOf((*MockDBHandler)(nil).MCPServerCreate), ctx, s)
+}
+
+// MCPServerDelete mocks base method.
+func (m *MockDBHandler) MCPServerDelete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MCPServerDelete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MCPServerDelete indicates an expected call of MCPServerDelete.
+func (mr *MockDBHandlerMockRecorder) MCPServerDelete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MCPServerDelete", reflect.TypeOf((*MockDBHandler)(nil).MCPServerDelete), ctx, id)
+}
+
+// MCPServerGet mocks base method.
+func (m *MockDBHandler) MCPServerGet(ctx context.Context, id uuid.UUID) (*mcpserver.MCPServer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MCPServerGet", ctx, id)
+	ret0, _ := ret[0].(*mcpserver.MCPServer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MCPServerGet indicates an expected call of MCPServerGet.
+func (mr *MockDBHandlerMockRecorder) MCPServerGet(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MCPServerGet", reflect.TypeOf((*MockDBHandler)(nil).MCPServerGet), ctx, id)
+}
+
+// MCPServerList mocks base method.
+func (m *MockDBHandler) MCPServerList(ctx context.Context, size uint64, token string, filters map[mcpserver.Field]any) ([]*mcpserver.MCPServer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MCPServerList", ctx, size, token, filters)
+	ret0, _ := ret[0].([]*mcpserver.MCPServer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MCPServerList indicates an expected call of MCPServerList.
+func (mr *MockDBHandlerMockRecorder) MCPServerList(ctx, size, token, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MCPServerList", reflect.TypeOf((*MockDBHandler)(nil).MCPServerList), ctx, size, token, filters)
+}
+
+// MCPServerUpdate mocks base method.
+func (m *MockDBHandler) MCPServerUpdate(ctx context.Context, id uuid.UUID, fields map[mcpserver.Field]any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MCPServerUpdate", ctx, id, fields)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MCPServerUpdate indicates an expected call of MCPServerUpdate.
+func (mr *MockDBHandlerMockRecorder) MCPServerUpdate(ctx, id, fields any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MCPServerUpdate", reflect.TypeOf((*MockDBHandler)(nil).MCPServerUpdate), ctx, id, fields)
+}
+
 // MessageAssistantReplyExists mocks base method.
 func (m *MockDBHandler) MessageAssistantReplyExists(ctx context.Context, pipecatcallID uuid.UUID) (bool, error) {
 	m.ctrl.T.Helper()
@@ -637,6 +1053,122 @@ func (mr *MockDBHandlerMockRecorder) ParticipantListByAIcallID(ctx, aicallID, si
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParticipantListByAIcallID", reflect.TypeOf((*MockDBHandler)(nil).ParticipantListByAIcallID), ctx, aicallID, size, token)
 }
 
+// RedactionTokenGets mocks base method.
+func (m *MockDBHandler) RedactionTokenGets(ctx context.Context, aicallID uuid.UUID) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedactionTokenGets", ctx, aicallID)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RedactionTokenGets indicates an expected call of RedactionTokenGets.
+func (mr *MockDBHandlerMockRecorder) RedactionTokenGets(ctx, aicallID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedactionTokenGets", reflect.TypeOf((*MockDBHandler)(nil).RedactionTokenGets), ctx, aicallID)
+}
+
+// RedactionTokenRefresh mocks base method.
+func (m *MockDBHandler) RedactionTokenRefresh(ctx context.Context, aicallID uuid.UUID, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedactionTokenRefresh", ctx, aicallID, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RedactionTokenRefresh indicates an expected call of RedactionTokenRefresh.
+func (mr *MockDBHandlerMockRecorder) RedactionTokenRefresh(ctx, aicallID, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedactionTokenRefresh", reflect.TypeOf((*MockDBHandler)(nil).RedactionTokenRefresh), ctx, aicallID, ttl)
+}
+
+// RedactionTokenSet mocks base method.
+func (m *MockDBHandler) RedactionTokenSet(ctx context.Context, aicallID uuid.UUID, token, value string, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedactionTokenSet", ctx, aicallID, token, value, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RedactionTokenSet indicates an expected call of RedactionTokenSet.
+func (mr *MockDBHandlerMockRecorder) RedactionTokenSet(ctx, aicallID, token, value, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedactionTokenSet", reflect.TypeOf((*MockDBHandler)(nil).RedactionTokenSet), ctx, aicallID, token, value, ttl)
+}
+
+// ResponseCacheEntryAdd mocks base method.
+func (m *MockDBHandler) ResponseCacheEntryAdd(ctx context.Context, bucket, hash string, e *responsecache.Entry, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResponseCacheEntryAdd", ctx, bucket, hash, e, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResponseCacheEntryAdd indicates an expected call of ResponseCacheEntryAdd.
+func (mr *MockDBHandlerMockRecorder) ResponseCacheEntryAdd(ctx, bucket, hash, e, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResponseCacheEntryAdd", reflect.TypeOf((*MockDBHandler)(nil).ResponseCacheEntryAdd), ctx, bucket, hash, e, ttl)
+}
+
+// ResponseCacheEntryGetByHash mocks base method.
+func (m *MockDBHandler) ResponseCacheEntryGetByHash(ctx context.Context, bucket, hash string) (*responsecache.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResponseCacheEntryGetByHash", ctx, bucket, hash)
+	ret0, _ := ret[0].(*responsecache.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResponseCacheEntryGetByHash indicates an expected call of ResponseCacheEntryGetByHash.
+func (mr *MockDBHandlerMockRecorder) ResponseCacheEntryGetByHash(ctx, bucket, hash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResponseCacheEntryGetByHash", reflect.TypeOf((*MockDBHandler)(nil).ResponseCacheEntryGetByHash), ctx, bucket, hash)
+}
+
+// ResponseCacheEntryGets mocks base method.
+func (m *MockDBHandler) ResponseCacheEntryGets(ctx context.Context, bucket string) ([]*responsecache.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResponseCacheEntryGets", ctx, bucket)
+	ret0, _ := ret[0].([]*responsecache.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResponseCacheEntryGets indicates an expected call of ResponseCacheEntryGets.
+func (mr *MockDBHandlerMockRecorder) ResponseCacheEntryGets(ctx, bucket any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResponseCacheEntryGets", reflect.TypeOf((*MockDBHandler)(nil).ResponseCacheEntryGets), ctx, bucket)
+}
+
+// ResponseCachePendingPop mocks base method.
+func (m *MockDBHandler) ResponseCachePendingPop(ctx context.Context, aicallID uuid.UUID) (*responsecache.Pending, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResponseCachePendingPop", ctx, aicallID)
+	ret0, _ := ret[0].(*responsecache.Pending)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResponseCachePendingPop indicates an expected call of ResponseCachePendingPop.
+func (mr *MockDBHandlerMockRecorder) ResponseCachePendingPop(ctx, aicallID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResponseCachePendingPop", reflect.TypeOf((*MockDBHandler)(nil).ResponseCachePendingPop), ctx, aicallID)
+}
+
+// ResponseCachePendingSet mocks base method.
+func (m *MockDBHandler) ResponseCachePendingSet(ctx context.Context, aicallID uuid.UUID, p *responsecache.Pending) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResponseCachePendingSet", ctx, aicallID, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResponseCachePendingSet indicates an expected call of ResponseCachePendingSet.
+func (mr *MockDBHandlerMockRecorder) ResponseCachePendingSet(ctx, aicallID, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResponseCachePendingSet", reflect.TypeOf((*MockDBHandler)(nil).ResponseCachePendingSet), ctx, aicallID, p)
+}
+
 // SummaryCreate mocks base method.
 func (m *MockDBHandler) SummaryCreate(ctx context.Context, c *summary.Summary) error {
 	m.ctrl.T.Helper()
@@ -780,3 +1312,147 @@ func (mr *MockDBHandlerMockRecorder) TeamUpdate(ctx, id, fields any) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TeamUpdate", reflect.TypeOf((*MockDBHandler)(nil).TeamUpdate), ctx, id, fields)
 }
+
+// TestRunCreate mocks base method.
+func (m *MockDBHandler) TestRunCreate(ctx context.Context, r *testrun.TestRun) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TestRunCreate", ctx, r)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TestRunCreate indicates an expected call of TestRunCreate.
+func (mr *MockDBHandlerMockRecorder) TestRunCreate(ctx, r any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TestRunCreate", reflect.TypeOf((*MockDBHandler)(nil).TestRunCreate), ctx, r)
+}
+
+// TestRunDelete mocks base method.
+func (m *MockDBHandler) TestRunDelete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TestRunDelete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TestRunDelete indicates an expected call of TestRunDelete.
+func (mr *MockDBHandlerMockRecorder) TestRunDelete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TestRunDelete", reflect.TypeOf((*MockDBHandler)(nil).TestRunDelete), ctx, id)
+}
+
+// TestRunGet mocks base method.
+func (m *MockDBHandler) TestRunGet(ctx context.Context, id uuid.UUID) (*testrun.TestRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TestRunGet", ctx, id)
+	ret0, _ := ret[0].(*testrun.TestRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TestRunGet indicates an expected call of TestRunGet.
+func (mr *MockDBHandlerMockRecorder) TestRunGet(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TestRunGet", reflect.TypeOf((*MockDBHandler)(nil).TestRunGet), ctx, id)
+}
+
+// TestRunList mocks base method.
+func (m *MockDBHandler) TestRunList(ctx context.Context, size uint64, token string, filters map[testrun.Field]any) ([]*testrun.TestRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TestRunList", ctx, size, token, filters)
+	ret0, _ := ret[0].([]*testrun.TestRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TestRunList indicates an expected call of TestRunList.
+func (mr *MockDBHandlerMockRecorder) TestRunList(ctx, size, token, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TestRunList", reflect.TypeOf((*MockDBHandler)(nil).TestRunList), ctx, size, token, filters)
+}
+
+// TestRunUpdate mocks base method.
+func (m *MockDBHandler) TestRunUpdate(ctx context.Context, id uuid.UUID, fields map[testrun.Field]any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TestRunUpdate", ctx, id, fields)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TestRunUpdate indicates an expected call of TestRunUpdate.
+func (mr *MockDBHandlerMockRecorder) TestRunUpdate(ctx, id, fields any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TestRunUpdate", reflect.TypeOf((*MockDBHandler)(nil).TestRunUpdate), ctx, id, fields)
+}
+
+// TestSuiteCreate mocks base method.
+func (m *MockDBHandler) TestSuiteCreate(ctx context.Context, s *testsuite.TestSuite) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TestSuiteCreate", ctx, s)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TestSuiteCreate indicates an expected call of TestSuiteCreate.
+func (mr *MockDBHandlerMockRecorder) TestSuiteCreate(ctx, s any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TestSuiteCreate", reflect.TypeOf((*MockDBHandler)(nil).TestSuiteCreate), ctx, s)
+}
+
+// TestSuiteDelete mocks base method.
+func (m *MockDBHandler) TestSuiteDelete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TestSuiteDelete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TestSuiteDelete indicates an expected call of TestSuiteDelete.
+func (mr *MockDBHandlerMockRecorder) TestSuiteDelete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TestSuiteDelete", reflect.TypeOf((*MockDBHandler)(nil).TestSuiteDelete), ctx, id)
+}
+
+// TestSuiteGet mocks base method.
+func (m *MockDBHandler) TestSuiteGet(ctx context.Context, id uuid.UUID) (*testsuite.TestSuite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TestSuiteGet", ctx, id)
+	ret0, _ := ret[0].(*testsuite.TestSuite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TestSuiteGet indicates an expected call of TestSuiteGet.
+func (mr *MockDBHandlerMockRecorder) TestSuiteGet(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TestSuiteGet", reflect.TypeOf((*MockDBHandler)(nil).TestSuiteGet), ctx, id)
+}
+
+// TestSuiteList mocks base method.
+func (m *MockDBHandler) TestSuiteList(ctx context.Context, size uint64, token string, filters map[testsuite.Field]any) ([]*testsuite.TestSuite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TestSuiteList", ctx, size, token, filters)
+	ret0, _ := ret[0].([]*testsuite.TestSuite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TestSuiteList indicates an expected call of TestSuiteList.
+func (mr *MockDBHandlerMockRecorder) TestSuiteList(ctx, size, token, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TestSuiteList", reflect.TypeOf((*MockDBHandler)(nil).TestSuiteList), ctx, size, token, filters)
+}
+
+// TestSuiteUpdate mocks base method.
+func (m *MockDBHandler) TestSuiteUpdate(ctx context.Context, id uuid.UUID, fields map[testsuite.Field]any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TestSuiteUpdate", ctx, id, fields)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TestSuiteUpdate indicates an expected call of TestSuiteUpdate.
+func (mr *MockDBHandlerMockRecorder) TestSuiteUpdate(ctx, id, fields any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TestSuiteUpdate", reflect.TypeOf((*MockDBHandler)(nil).TestSuiteUpdate), ctx, id, fields)
+}
@@ -0,0 +1,29 @@
+package dbhandler
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+// RedactionTokenSet stores the value of the aicall's redaction token for the given ttl.
+// The tokens are kept in the cache only, so the redacted values never reach the database.
+func (h *handler) RedactionTokenSet(ctx context.Context, aicallID uuid.UUID, token string, value string, ttl time.Duration) error {
+	return h.cache.RedactionTokenSet(ctx, aicallID, token, value, ttl)
+}
+
+// RedactionTokenRefresh keeps the aicall's redaction tokens for the given ttl from now.
+func (h *handler) RedactionTokenRefresh(ctx context.Context, aicallID uuid.UUID, ttl time.Duration) error {
+	return h.cache.RedactionTokenRefresh(ctx, aicallID, ttl)
+}
+
+// RedactionTokenGets returns the aicall's redaction tokens and their values.
+func (h *handler) RedactionTokenGets(ctx context.Context, aicallID uuid.UUID) (map[string]string, error) {
+	res, err := h.cache.RedactionTokenGets(ctx, aicallID)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
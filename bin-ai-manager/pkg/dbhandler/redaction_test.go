@@ -0,0 +1,138 @@
+package dbhandler
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"monorepo/bin-ai-manager/pkg/cachehandler"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func Test_RedactionTokenSet(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		aicallID uuid.UUID
+		token    string
+		value    string
+		ttl      time.Duration
+	}{
+		{
+			name: "normal",
+
+			aicallID: uuid.FromStringOrNil("0c8c1a5e-a9f1-11f0-9e0a-5b1b0d0b7b6e"),
+			token:    "[CREDIT_CARD_QWERTY]",
+			value:    "4111 1111 1111 1111",
+			ttl:      25 * time.Hour,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockCache := cachehandler.NewMockCacheHandler(mc)
+			h := handler{
+				db:    dbTest,
+				cache: mockCache,
+			}
+			ctx := context.Background()
+
+			mockCache.EXPECT().RedactionTokenSet(ctx, tt.aicallID, tt.token, tt.value, tt.ttl).Return(nil)
+			if err := h.RedactionTokenSet(ctx, tt.aicallID, tt.token, tt.value, tt.ttl); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+		})
+	}
+}
+
+func Test_RedactionTokenRefresh(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		aicallID uuid.UUID
+		ttl      time.Duration
+	}{
+		{
+			name: "normal",
+
+			aicallID: uuid.FromStringOrNil("5d0e7a36-b0a1-11f0-8f43-1b7f2f6b9a51"),
+			ttl:      25 * time.Hour,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockCache := cachehandler.NewMockCacheHandler(mc)
+			h := handler{
+				db:    dbTest,
+				cache: mockCache,
+			}
+			ctx := context.Background()
+
+			mockCache.EXPECT().RedactionTokenRefresh(ctx, tt.aicallID, tt.ttl).Return(nil)
+			if err := h.RedactionTokenRefresh(ctx, tt.aicallID, tt.ttl); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+		})
+	}
+}
+
+func Test_RedactionTokenGets(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		aicallID uuid.UUID
+
+		responseTokens map[string]string
+		expectRes      map[string]string
+	}{
+		{
+			name: "normal",
+
+			aicallID: uuid.FromStringOrNil("0cdb5e7a-a9f1-11f0-8d1c-27f4c0d6e0c1"),
+
+			responseTokens: map[string]string{
+				"[CREDIT_CARD_QWERTY]": "4111 1111 1111 1111",
+			},
+			expectRes: map[string]string{
+				"[CREDIT_CARD_QWERTY]": "4111 1111 1111 1111",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockCache := cachehandler.NewMockCacheHandler(mc)
+			h := handler{
+				db:    dbTest,
+				cache: mockCache,
+			}
+			ctx := context.Background()
+
+			mockCache.EXPECT().RedactionTokenGets(ctx, tt.aicallID).Return(tt.responseTokens, nil)
+			res, err := h.RedactionTokenGets(ctx, tt.aicallID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package dbhandler
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"monorepo/bin-ai-manager/models/responsecache"
+)
+
+// ResponseCacheEntryGets returns the cached responses of the bucket, the newest first.
+// The responses are kept in the cache only, and expire with their bucket.
+func (h *handler) ResponseCacheEntryGets(ctx context.Context, bucket string) ([]*responsecache.Entry, error) {
+	res, err := h.cache.ResponseCacheEntryGets(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// ResponseCacheEntryGetByHash returns the cached response of the bucket for the question of the given hash.
+// Returns nil if there is none.
+func (h *handler) ResponseCacheEntryGetByHash(ctx context.Context, bucket string, hash string) (*responsecache.Entry, error) {
+	res, err := h.cache.ResponseCacheEntryGetByHash(ctx, bucket, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// ResponseCacheEntryAdd adds the response to the bucket, indexed by the hash of its question.
+func (h *handler) ResponseCacheEntryAdd(ctx context.Context, bucket string, hash string, e *responsecache.Entry, ttl time.Duration) error {
+	return h.cache.ResponseCacheEntryAdd(ctx, bucket, hash, e, ttl)
+}
+
+// ResponseCachePendingSet sets the question of the aicall's last cache miss.
+func (h *handler) ResponseCachePendingSet(ctx context.Context, aicallID uuid.UUID, p *responsecache.Pending) error {
+	return h.cache.ResponseCachePendingSet(ctx, aicallID, p)
+}
+
+// ResponseCachePendingPop returns the question of the aicall's last cache miss and removes it.
+// Returns nil if there is none.
+func (h *handler) ResponseCachePendingPop(ctx context.Context, aicallID uuid.UUID) (*responsecache.Pending, error) {
+	res, err := h.cache.ResponseCachePendingPop(ctx, aicallID)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
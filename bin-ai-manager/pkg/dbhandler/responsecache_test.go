@@ -0,0 +1,179 @@
+package dbhandler
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"monorepo/bin-ai-manager/models/responsecache"
+	"monorepo/bin-ai-manager/pkg/cachehandler"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func Test_ResponseCacheEntryGetByHash(t *testing.T) {
+
+	tmCreate := time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+
+		bucket string
+		hash   string
+
+		responseEntry *responsecache.Entry
+	}{
+		{
+			name: "normal",
+
+			bucket: "a1c3e5f0-ad9f-11f0-8d1b-3b7e5c2a9f10:3f9a1c2e7b4d8a60:none",
+			hash:   "6f1e2d3c4b5a69788796a5b4c3d2e1f0",
+
+			responseEntry: &responsecache.Entry{
+				Question: "what are your opening hours",
+				Response: "We are open from 9am to 6pm.",
+				TMCreate: &tmCreate,
+			},
+		},
+		{
+			name: "not found",
+
+			bucket: "a1c3e5f0-ad9f-11f0-8d1b-3b7e5c2a9f10:3f9a1c2e7b4d8a60:none",
+			hash:   "0a1b2c3d4e5f60718293a4b5c6d7e8f9",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockCache := cachehandler.NewMockCacheHandler(mc)
+			h := handler{
+				db:    dbTest,
+				cache: mockCache,
+			}
+			ctx := context.Background()
+
+			mockCache.EXPECT().ResponseCacheEntryGetByHash(ctx, tt.bucket, tt.hash).Return(tt.responseEntry, nil)
+			res, err := h.ResponseCacheEntryGetByHash(ctx, tt.bucket, tt.hash)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.responseEntry) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.responseEntry, res)
+			}
+		})
+	}
+}
+
+func Test_ResponseCacheEntryAdd(t *testing.T) {
+
+	tmCreate := time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+
+		bucket string
+		hash   string
+		entry  *responsecache.Entry
+		ttl    time.Duration
+	}{
+		{
+			name: "normal",
+
+			bucket: "a1c3e5f0-ad9f-11f0-8d1b-3b7e5c2a9f10:3f9a1c2e7b4d8a60:none",
+			hash:   "6f1e2d3c4b5a69788796a5b4c3d2e1f0",
+			entry: &responsecache.Entry{
+				Question:  "what are your opening hours",
+				Embedding: []float32{0.1, 0.2},
+				Response:  "We are open from 9am to 6pm.",
+				TMCreate:  &tmCreate,
+			},
+			ttl: time.Hour,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockCache := cachehandler.NewMockCacheHandler(mc)
+			h := handler{
+				db:    dbTest,
+				cache: mockCache,
+			}
+			ctx := context.Background()
+
+			mockCache.EXPECT().ResponseCacheEntryAdd(ctx, tt.bucket, tt.hash, tt.entry, tt.ttl).Return(nil)
+			if err := h.ResponseCacheEntryAdd(ctx, tt.bucket, tt.hash, tt.entry, tt.ttl); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+		})
+	}
+}
+
+func Test_ResponseCachePendingPop(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		aicallID uuid.UUID
+
+		responsePending *responsecache.Pending
+		expectRes       *responsecache.Pending
+	}{
+		{
+			name: "normal",
+
+			aicallID: uuid.FromStringOrNil("a20d7f42-ad9f-11f0-9c4e-4f8a6d3b0e21"),
+
+			responsePending: &responsecache.Pending{
+				Bucket:    "a1c3e5f0-ad9f-11f0-8d1b-3b7e5c2a9f10:3f9a1c2e7b4d8a60:none",
+				Question:  "what are your opening hours",
+				Embedding: []float32{0.1, 0.2},
+			},
+			expectRes: &responsecache.Pending{
+				Bucket:    "a1c3e5f0-ad9f-11f0-8d1b-3b7e5c2a9f10:3f9a1c2e7b4d8a60:none",
+				Question:  "what are your opening hours",
+				Embedding: []float32{0.1, 0.2},
+			},
+		},
+		{
+			name: "no pending",
+
+			aicallID: uuid.FromStringOrNil("a24b3c6e-ad9f-11f0-b27f-5a9b7e4c1f32"),
+
+			responsePending: nil,
+			expectRes:       nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockCache := cachehandler.NewMockCacheHandler(mc)
+			h := handler{
+				db:    dbTest,
+				cache: mockCache,
+			}
+			ctx := context.Background()
+
+			mockCache.EXPECT().ResponseCachePendingPop(ctx, tt.aicallID).Return(tt.responsePending, nil)
+			res, err := h.ResponseCachePendingPop(ctx, tt.aicallID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,165 @@
+package dbhandler
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	uuid "github.com/gofrs/uuid"
+
+	commondatabasehandler "monorepo/bin-common-handler/pkg/databasehandler"
+
+	"monorepo/bin-ai-manager/models/testrun"
+)
+
+const (
+	testRunTable = "ai_test_runs"
+)
+
+// TestRunCreate creates a new test run record.
+func (h *handler) TestRunCreate(ctx context.Context, r *testrun.TestRun) error {
+	r.TMCreate = h.utilHandler.TimeNow()
+	r.TMUpdate = nil
+	r.TMDelete = nil
+
+	fields, err := commondatabasehandler.PrepareFields(r)
+	if err != nil {
+		return fmt.Errorf("TestRunCreate: could not prepare fields. err: %v", err)
+	}
+
+	query, args, err := sq.Insert(testRunTable).SetMap(fields).ToSql()
+	if err != nil {
+		return fmt.Errorf("TestRunCreate: could not build query. err: %v", err)
+	}
+
+	_, err = h.db.Exec(query, args...)
+	if err != nil {
+		return fmt.Errorf("TestRunCreate: could not execute query. err: %v", err)
+	}
+
+	return nil
+}
+
+// TestRunGet returns test run.
+func (h *handler) TestRunGet(ctx context.Context, id uuid.UUID) (*testrun.TestRun, error) {
+	cols := commondatabasehandler.GetDBFields(testrun.TestRun{})
+
+	query, args, err := sq.Select(cols...).
+		From(testRunTable).
+		Where(sq.Eq{"id": id.Bytes()}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("TestRunGet: could not build query. err: %v", err)
+	}
+
+	rows, err := h.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("TestRunGet: could not query. err: %v", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	if !rows.Next() {
+		return nil, ErrNotFound
+	}
+
+	res := &testrun.TestRun{}
+	if err := commondatabasehandler.ScanRow(rows, res); err != nil {
+		return nil, fmt.Errorf("TestRunGet: could not scan row. err: %v", err)
+	}
+
+	return res, nil
+}
+
+// TestRunDelete deletes the test run.
+func (h *handler) TestRunDelete(ctx context.Context, id uuid.UUID) error {
+	ts := h.utilHandler.TimeNow()
+
+	query, args, err := sq.Update(testRunTable).
+		SetMap(map[string]any{
+			"tm_update": ts,
+			"tm_delete": ts,
+		}).
+		Where(sq.Eq{"id": id.Bytes()}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("TestRunDelete: could not build query. err: %v", err)
+	}
+
+	_, err = h.db.Exec(query, args...)
+	if err != nil {
+		return fmt.Errorf("TestRunDelete: could not execute. err: %v", err)
+	}
+
+	return nil
+}
+
+// TestRunList returns a list of test runs.
+func (h *handler) TestRunList(ctx context.Context, size uint64, token string, filters map[testrun.Field]any) ([]*testrun.TestRun, error) {
+	if token == "" {
+		token = h.utilHandler.TimeGetCurTime()
+	}
+
+	cols := commondatabasehandler.GetDBFields(testrun.TestRun{})
+
+	builder := sq.Select(cols...).
+		From(testRunTable).
+		Where(sq.Lt{"tm_create": token}).
+		OrderBy("tm_create desc").
+		Limit(size)
+
+	builder, err := commondatabasehandler.ApplyFields(builder, filters)
+	if err != nil {
+		return nil, fmt.Errorf("TestRunList: could not apply filters. err: %v", err)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("TestRunList: could not build query. err: %v", err)
+	}
+
+	rows, err := h.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("TestRunList: could not query. err: %v", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	res := []*testrun.TestRun{}
+	for rows.Next() {
+		r := &testrun.TestRun{}
+		if err := commondatabasehandler.ScanRow(rows, r); err != nil {
+			return nil, fmt.Errorf("TestRunList: could not scan row. err: %v", err)
+		}
+		res = append(res, r)
+	}
+
+	return res, nil
+}
+
+// TestRunUpdate updates the test run fields.
+func (h *handler) TestRunUpdate(ctx context.Context, id uuid.UUID, fields map[testrun.Field]any) error {
+	updateFields := make(map[string]any)
+	for k, v := range fields {
+		updateFields[string(k)] = v
+	}
+	updateFields["tm_update"] = h.utilHandler.TimeNow()
+
+	preparedFields, err := commondatabasehandler.PrepareFields(updateFields)
+	if err != nil {
+		return fmt.Errorf("TestRunUpdate: could not prepare fields. err: %v", err)
+	}
+
+	query, args, err := sq.Update(testRunTable).
+		SetMap(preparedFields).
+		Where(sq.Eq{"id": id.Bytes()}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("TestRunUpdate: could not build query. err: %v", err)
+	}
+
+	_, err = h.db.Exec(query, args...)
+	if err != nil {
+		return fmt.Errorf("TestRunUpdate: could not execute. err: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package dbhandler
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"monorepo/bin-ai-manager/models/testrun"
+	"monorepo/bin-ai-manager/models/testsuite"
+	"monorepo/bin-ai-manager/pkg/cachehandler"
+	"monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func Test_TestRunCreateUpdate(t *testing.T) {
+
+	curTime := func() *time.Time { t := time.Date(2023, 1, 3, 21, 35, 2, 809000000, time.UTC); return &t }()
+
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockUtil := utilhandler.NewMockUtilHandler(mc)
+
+	h := handler{
+		utilHandler: mockUtil,
+		db:          dbTest,
+		cache:       cachehandler.NewMockCacheHandler(mc),
+	}
+	ctx := context.Background()
+
+	r := &testrun.TestRun{
+		Identity: identity.Identity{
+			ID:         uuid.FromStringOrNil("d01e3c5a-ad5d-11f0-a7b8-2c4e6a8f0b11"),
+			CustomerID: uuid.FromStringOrNil("d04b7e92-ad5d-11f0-9f0c-7d1b3f5a9c12"),
+		},
+		TestSuiteID:     uuid.FromStringOrNil("d0771f3c-ad5d-11f0-86d4-1e3a5c7f9b13"),
+		AIID:            uuid.FromStringOrNil("d0a2c5e6-ad5d-11f0-b1e9-4f6b8d0a2c14"),
+		PromptHistoryID: uuid.FromStringOrNil("d0ce6a8e-ad5d-11f0-8c3a-9b1d3f5e7a15"),
+		Status:          testrun.StatusProgressing,
+	}
+
+	mockUtil.EXPECT().TimeNow().Return(curTime)
+	if err := h.TestRunCreate(ctx, r); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	res, err := h.TestRunGet(ctx, r.ID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(res, r) {
+		t.Errorf("Wrong match.\nexpect: %v\ngot: %v", r, res)
+	}
+
+	results := []testrun.Result{
+		{
+			Scenario: "asks for a refund",
+			AIcallID: uuid.FromStringOrNil("d0fa1c36-ad5d-11f0-a4f2-3c5e7a9b1d16"),
+			Passed:   false,
+			Turns:    1,
+			Assertions: []testrun.AssertionResult{
+				{
+					Assertion: testsuite.Assertion{
+						Type: testsuite.AssertionTypeMustNotMention,
+						Text: "refund approved",
+					},
+					Passed: false,
+					Reason: "the reply mentioned the text.",
+				},
+			},
+		},
+	}
+	mockUtil.EXPECT().TimeNow().Return(curTime)
+	if err := h.TestRunUpdate(ctx, r.ID, map[testrun.Field]any{
+		testrun.FieldStatus:  testrun.StatusFailed,
+		testrun.FieldResults: results,
+	}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	res, err = h.TestRunGet(ctx, r.ID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if res.Status != testrun.StatusFailed || !reflect.DeepEqual(res.Results, results) {
+		t.Errorf("Wrong match. got: %v", res)
+	}
+
+	list, err := h.TestRunList(ctx, 10, utilhandler.TimeGetCurTime(), map[testrun.Field]any{
+		testrun.FieldTestSuiteID: r.TestSuiteID,
+		testrun.FieldDeleted:     false,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(list) != 1 || list[0].ID != r.ID {
+		t.Errorf("Wrong match. expect: [%s], got: %v", r.ID, list)
+	}
+
+	mockUtil.EXPECT().TimeNow().Return(curTime)
+	if err := h.TestRunDelete(ctx, r.ID); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	_, err = h.TestRunGet(context.Background(), uuid.FromStringOrNil("d126b7de-ad5d-11f0-95c1-6e8a0c2e4f17"))
+	if err != ErrNotFound {
+		t.Errorf("Wrong match. expect: ErrNotFound, got: %v", err)
+	}
+}
@@ -0,0 +1,165 @@
+package dbhandler
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	uuid "github.com/gofrs/uuid"
+
+	commondatabasehandler "monorepo/bin-common-handler/pkg/databasehandler"
+
+	"monorepo/bin-ai-manager/models/testsuite"
+)
+
+const (
+	testSuiteTable = "ai_test_suites"
+)
+
+// TestSuiteCreate creates a new test suite record.
+func (h *handler) TestSuiteCreate(ctx context.Context, s *testsuite.TestSuite) error {
+	s.TMCreate = h.utilHandler.TimeNow()
+	s.TMUpdate = nil
+	s.TMDelete = nil
+
+	fields, err := commondatabasehandler.PrepareFields(s)
+	if err != nil {
+		return fmt.Errorf("TestSuiteCreate: could not prepare fields. err: %v", err)
+	}
+
+	query, args, err := sq.Insert(testSuiteTable).SetMap(fields).ToSql()
+	if err != nil {
+		return fmt.Errorf("TestSuiteCreate: could not build query. err: %v", err)
+	}
+
+	_, err = h.db.Exec(query, args...)
+	if err != nil {
+		return fmt.Errorf("TestSuiteCreate: could not execute query. err: %v", err)
+	}
+
+	return nil
+}
+
+// TestSuiteGet returns test suite.
+func (h *handler) TestSuiteGet(ctx context.Context, id uuid.UUID) (*testsuite.TestSuite, error) {
+	cols := commondatabasehandler.GetDBFields(testsuite.TestSuite{})
+
+	query, args, err := sq.Select(cols...).
+		From(testSuiteTable).
+		Where(sq.Eq{"id": id.Bytes()}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("TestSuiteGet: could not build query. err: %v", err)
+	}
+
+	rows, err := h.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("TestSuiteGet: could not query. err: %v", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	if !rows.Next() {
+		return nil, ErrNotFound
+	}
+
+	res := &testsuite.TestSuite{}
+	if err := commondatabasehandler.ScanRow(rows, res); err != nil {
+		return nil, fmt.Errorf("TestSuiteGet: could not scan row. err: %v", err)
+	}
+
+	return res, nil
+}
+
+// TestSuiteDelete deletes the test suite.
+func (h *handler) TestSuiteDelete(ctx context.Context, id uuid.UUID) error {
+	ts := h.utilHandler.TimeNow()
+
+	query, args, err := sq.Update(testSuiteTable).
+		SetMap(map[string]any{
+			"tm_update": ts,
+			"tm_delete": ts,
+		}).
+		Where(sq.Eq{"id": id.Bytes()}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("TestSuiteDelete: could not build query. err: %v", err)
+	}
+
+	_, err = h.db.Exec(query, args...)
+	if err != nil {
+		return fmt.Errorf("TestSuiteDelete: could not execute. err: %v", err)
+	}
+
+	return nil
+}
+
+// TestSuiteList returns a list of test suites.
+func (h *handler) TestSuiteList(ctx context.Context, size uint64, token string, filters map[testsuite.Field]any) ([]*testsuite.TestSuite, error) {
+	if token == "" {
+		token = h.utilHandler.TimeGetCurTime()
+	}
+
+	cols := commondatabasehandler.GetDBFields(testsuite.TestSuite{})
+
+	builder := sq.Select(cols...).
+		From(testSuiteTable).
+		Where(sq.Lt{"tm_create": token}).
+		OrderBy("tm_create desc").
+		Limit(size)
+
+	builder, err := commondatabasehandler.ApplyFields(builder, filters)
+	if err != nil {
+		return nil, fmt.Errorf("TestSuiteList: could not apply filters. err: %v", err)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("TestSuiteList: could not build query. err: %v", err)
+	}
+
+	rows, err := h.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("TestSuiteList: could not query. err: %v", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	res := []*testsuite.TestSuite{}
+	for rows.Next() {
+		s := &testsuite.TestSuite{}
+		if err := commondatabasehandler.ScanRow(rows, s); err != nil {
+			return nil, fmt.Errorf("TestSuiteList: could not scan row. err: %v", err)
+		}
+		res = append(res, s)
+	}
+
+	return res, nil
+}
+
+// TestSuiteUpdate updates the test suite fields.
+func (h *handler) TestSuiteUpdate(ctx context.Context, id uuid.UUID, fields map[testsuite.Field]any) error {
+	updateFields := make(map[string]any)
+	for k, v := range fields {
+		updateFields[string(k)] = v
+	}
+	updateFields["tm_update"] = h.utilHandler.TimeNow()
+
+	preparedFields, err := commondatabasehandler.PrepareFields(updateFields)
+	if err != nil {
+		return fmt.Errorf("TestSuiteUpdate: could not prepare fields. err: %v", err)
+	}
+
+	query, args, err := sq.Update(testSuiteTable).
+		SetMap(preparedFields).
+		Where(sq.Eq{"id": id.Bytes()}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("TestSuiteUpdate: could not build query. err: %v", err)
+	}
+
+	_, err = h.db.Exec(query, args...)
+	if err != nil {
+		return fmt.Errorf("TestSuiteUpdate: could not execute. err: %v", err)
+	}
+
+	return nil
+}
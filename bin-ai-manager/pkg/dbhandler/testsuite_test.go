@@ -0,0 +1,237 @@
+package dbhandler
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"monorepo/bin-ai-manager/models/testsuite"
+	"monorepo/bin-ai-manager/pkg/cachehandler"
+	"monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func Test_TestSuiteCreate(t *testing.T) {
+
+	curTime := func() *time.Time { t := time.Date(2023, 1, 3, 21, 35, 2, 809000000, time.UTC); return &t }()
+
+	tests := []struct {
+		name string
+
+		testSuite *testsuite.TestSuite
+
+		responseCurTime *time.Time
+		expectRes       *testsuite.TestSuite
+	}{
+		{
+			name: "normal",
+
+			testSuite: &testsuite.TestSuite{
+				Identity: identity.Identity{
+					ID:         uuid.FromStringOrNil("4a1c6f3e-ad5d-11f0-9e2b-7b3d5f1a0c01"),
+					CustomerID: uuid.FromStringOrNil("4a4a8e52-ad5d-11f0-8c7d-2e6f1b9d3a02"),
+				},
+				AIID:   uuid.FromStringOrNil("4a76c1d0-ad5d-11f0-b3f4-5c8e2a7d1b03"),
+				Name:   "refund policy",
+				Detail: "refund regression",
+				Scenarios: []testsuite.Scenario{
+					{
+						Name:  "asks for a refund",
+						Type:  testsuite.ScenarioTypeScripted,
+						Turns: []string{"I want my money back."},
+						Assertions: []testsuite.Assertion{
+							{
+								Type: testsuite.AssertionTypeMustNotMention,
+								Text: "refund approved",
+							},
+						},
+					},
+				},
+			},
+
+			responseCurTime: curTime,
+			expectRes: &testsuite.TestSuite{
+				Identity: identity.Identity{
+					ID:         uuid.FromStringOrNil("4a1c6f3e-ad5d-11f0-9e2b-7b3d5f1a0c01"),
+					CustomerID: uuid.FromStringOrNil("4a4a8e52-ad5d-11f0-8c7d-2e6f1b9d3a02"),
+				},
+				AIID:   uuid.FromStringOrNil("4a76c1d0-ad5d-11f0-b3f4-5c8e2a7d1b03"),
+				Name:   "refund policy",
+				Detail: "refund regression",
+				Scenarios: []testsuite.Scenario{
+					{
+						Name:  "asks for a refund",
+						Type:  testsuite.ScenarioTypeScripted,
+						Turns: []string{"I want my money back."},
+						Assertions: []testsuite.Assertion{
+							{
+								Type: testsuite.AssertionTypeMustNotMention,
+								Text: "refund approved",
+							},
+						},
+					},
+				},
+				TMCreate: curTime,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockCache := cachehandler.NewMockCacheHandler(mc)
+
+			h := handler{
+				utilHandler: mockUtil,
+				db:          dbTest,
+				cache:       mockCache,
+			}
+
+			ctx := context.Background()
+
+			mockUtil.EXPECT().TimeNow().Return(tt.responseCurTime)
+			if err := h.TestSuiteCreate(ctx, tt.testSuite); err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+
+			res, err := h.TestSuiteGet(ctx, tt.testSuite.ID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_TestSuiteList(t *testing.T) {
+
+	curTime := func() *time.Time { t := time.Date(2023, 1, 3, 21, 35, 2, 809000000, time.UTC); return &t }()
+	customerID := uuid.FromStringOrNil("7e0d2c4a-ad5d-11f0-a1b6-3d9f5e7c2b04")
+	aiID := uuid.FromStringOrNil("7e39b5f0-ad5d-11f0-86e3-9a4c1e6d8f05")
+
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockUtil := utilhandler.NewMockUtilHandler(mc)
+
+	h := handler{
+		utilHandler: mockUtil,
+		db:          dbTest,
+		cache:       cachehandler.NewMockCacheHandler(mc),
+	}
+	ctx := context.Background()
+
+	for _, s := range []*testsuite.TestSuite{
+		{
+			Identity: identity.Identity{
+				ID:         uuid.FromStringOrNil("7e64a1ae-ad5d-11f0-bf27-1f8b3d5a7c06"),
+				CustomerID: customerID,
+			},
+			AIID: aiID,
+			Name: "greeting",
+		},
+		{
+			Identity: identity.Identity{
+				ID:         uuid.FromStringOrNil("7e8f2d6c-ad5d-11f0-9b4a-6e2c8f1d3a07"),
+				CustomerID: customerID,
+			},
+			AIID: uuid.FromStringOrNil("7eb9e34a-ad5d-11f0-a0d5-4b7e9c2f1d08"),
+			Name: "other ai",
+		},
+	} {
+		mockUtil.EXPECT().TimeNow().Return(curTime)
+		if err := h.TestSuiteCreate(ctx, s); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+
+	res, err := h.TestSuiteList(ctx, 10, utilhandler.TimeGetCurTime(), map[testsuite.Field]any{
+		testsuite.FieldCustomerID: customerID,
+		testsuite.FieldAIID:       aiID,
+		testsuite.FieldDeleted:    false,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(res) != 1 || res[0].Name != "greeting" {
+		t.Errorf("Wrong match. expect: [greeting], got: %v", res)
+	}
+}
+
+func Test_TestSuiteUpdateDelete(t *testing.T) {
+
+	curTime := func() *time.Time { t := time.Date(2023, 1, 3, 21, 35, 2, 809000000, time.UTC); return &t }()
+
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockUtil := utilhandler.NewMockUtilHandler(mc)
+
+	h := handler{
+		utilHandler: mockUtil,
+		db:          dbTest,
+		cache:       cachehandler.NewMockCacheHandler(mc),
+	}
+	ctx := context.Background()
+
+	s := &testsuite.TestSuite{
+		Identity: identity.Identity{
+			ID:         uuid.FromStringOrNil("a2c4e6f8-ad5d-11f0-8d1b-5f7a9c1e3b09"),
+			CustomerID: uuid.FromStringOrNil("a2f0b3d4-ad5d-11f0-b6c2-8e1a3c5d7f0a"),
+		},
+		Name: "greeting",
+	}
+
+	mockUtil.EXPECT().TimeNow().Return(curTime)
+	if err := h.TestSuiteCreate(ctx, s); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	scenarios := []testsuite.Scenario{
+		{
+			Name:    "angry customer",
+			Type:    testsuite.ScenarioTypeSimulated,
+			Persona: "an angry customer",
+			Goal:    "get a refund",
+		},
+	}
+	mockUtil.EXPECT().TimeNow().Return(curTime)
+	if err := h.TestSuiteUpdate(ctx, s.ID, map[testsuite.Field]any{
+		testsuite.FieldName:      "refund",
+		testsuite.FieldScenarios: scenarios,
+	}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	res, err := h.TestSuiteGet(ctx, s.ID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if res.Name != "refund" || !reflect.DeepEqual(res.Scenarios, scenarios) || res.TMUpdate == nil {
+		t.Errorf("Wrong match. got: %v", res)
+	}
+
+	mockUtil.EXPECT().TimeNow().Return(curTime)
+	if err := h.TestSuiteDelete(ctx, s.ID); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	res, err = h.TestSuiteGet(ctx, s.ID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if res.TMDelete == nil {
+		t.Errorf("Wrong match. expect: deleted, got: %v", res)
+	}
+}
@@ -0,0 +1,20 @@
+package engine_openai_handler
+
+import (
+	"github.com/sashabaranov/go-openai"
+
+	"monorepo/bin-ai-manager/models/usage"
+)
+
+// ConvertUsage returns the usage of an OpenAI compatible chat completion.
+func ConvertUsage(u openai.Usage) usage.Usage {
+	res := usage.Usage{
+		PromptTokens:     int64(u.PromptTokens),
+		CompletionTokens: int64(u.CompletionTokens),
+	}
+	if u.PromptTokensDetails != nil {
+		res.CachedTokens = int64(u.PromptTokensDetails.CachedTokens)
+	}
+
+	return res
+}
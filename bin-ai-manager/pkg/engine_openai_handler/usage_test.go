@@ -0,0 +1,62 @@
+package engine_openai_handler
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sashabaranov/go-openai"
+
+	"monorepo/bin-ai-manager/models/usage"
+)
+
+func Test_ConvertUsage(t *testing.T) {
+	tests := []struct {
+		name string
+
+		usage openai.Usage
+
+		expectRes usage.Usage
+	}{
+		{
+			name: "without prompt token details",
+
+			usage: openai.Usage{
+				PromptTokens:     120,
+				CompletionTokens: 30,
+				TotalTokens:      150,
+			},
+
+			expectRes: usage.Usage{
+				PromptTokens:     120,
+				CompletionTokens: 30,
+			},
+		},
+		{
+			name: "with cached tokens",
+
+			usage: openai.Usage{
+				PromptTokens:     120,
+				CompletionTokens: 30,
+				TotalTokens:      150,
+				PromptTokensDetails: &openai.PromptTokensDetails{
+					CachedTokens: 100,
+				},
+			},
+
+			expectRes: usage.Usage{
+				PromptTokens:     120,
+				CompletionTokens: 30,
+				CachedTokens:     100,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := ConvertUsage(tt.usage)
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
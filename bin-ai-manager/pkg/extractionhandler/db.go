@@ -0,0 +1,138 @@
+package extractionhandler
+
+import (
+	"context"
+	stderrors "errors"
+
+	"monorepo/bin-ai-manager/models/extraction"
+	"monorepo/bin-ai-manager/models/usage"
+	"monorepo/bin-ai-manager/pkg/dbhandler"
+	cerrors "monorepo/bin-common-handler/models/errors"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	commonoutline "monorepo/bin-common-handler/models/outline"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+)
+
+// create creates a new extraction and publishes the created event.
+func (h *extractionHandler) create(
+	ctx context.Context,
+	customerID uuid.UUID,
+	activeflowID uuid.UUID,
+	onEndFlowID uuid.UUID,
+	referenceType extraction.ReferenceType,
+	referenceID uuid.UUID,
+	status extraction.Status,
+	language string,
+	schema map[string]any,
+	setVariables bool,
+	result map[string]any,
+	u usage.Usage,
+) (*extraction.Extraction, error) {
+	id := h.utilHandler.UUIDCreate()
+
+	e := &extraction.Extraction{
+		Identity: commonidentity.Identity{
+			ID:         id,
+			CustomerID: customerID,
+		},
+
+		ActiveflowID: activeflowID,
+		OnEndFlowID:  onEndFlowID,
+
+		ReferenceType: referenceType,
+		ReferenceID:   referenceID,
+
+		Status:   status,
+		Language: language,
+
+		Schema:       schema,
+		SetVariables: setVariables,
+
+		Result: result,
+
+		Usage: u,
+	}
+
+	if errCreate := h.db.ExtractionCreate(ctx, e); errCreate != nil {
+		return nil, errors.Wrapf(errCreate, "could not create extraction")
+	}
+
+	res, err := h.db.ExtractionGet(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get created data")
+	}
+
+	if res.Status != extraction.StatusProgressing {
+		promExtractionEndTotal.WithLabelValues(string(res.ReferenceType), string(res.Status)).Inc()
+	}
+
+	h.notifyHandler.PublishWebhookEvent(ctx, res.CustomerID, extraction.EventTypeCreated, res)
+	return res, nil
+}
+
+// Get returns the extraction.
+func (h *extractionHandler) Get(ctx context.Context, id uuid.UUID) (*extraction.Extraction, error) {
+	res, err := h.db.ExtractionGet(ctx, id)
+	if err != nil {
+		if stderrors.Is(err, dbhandler.ErrNotFound) {
+			return nil, cerrors.NotFound(
+				commonoutline.ServiceNameAIManager,
+				"EXTRACTION_NOT_FOUND",
+				"The extraction was not found.",
+			).Wrap(err)
+		}
+		return nil, errors.Wrapf(err, "could not get data")
+	}
+
+	return res, nil
+}
+
+// List returns the list of extractions.
+func (h *extractionHandler) List(ctx context.Context, size uint64, token string, filters map[extraction.Field]any) ([]*extraction.Extraction, error) {
+	res, err := h.db.ExtractionList(ctx, size, token, filters)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get data")
+	}
+
+	return res, nil
+}
+
+// Delete deletes the extraction.
+func (h *extractionHandler) Delete(ctx context.Context, id uuid.UUID) (*extraction.Extraction, error) {
+	if err := h.db.ExtractionDelete(ctx, id); err != nil {
+		return nil, errors.Wrapf(err, "could not delete the extraction")
+	}
+
+	res, err := h.db.ExtractionGet(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get deleted extraction")
+	}
+	h.notifyHandler.PublishWebhookEvent(ctx, res.CustomerID, extraction.EventTypeDeleted, res)
+
+	return res, nil
+}
+
+// updateResult updates the extraction with the given status, result and LLM usage.
+func (h *extractionHandler) updateResult(ctx context.Context, id uuid.UUID, status extraction.Status, result map[string]any, u usage.Usage) (*extraction.Extraction, error) {
+	fields := map[extraction.Field]any{
+		extraction.FieldStatus:           status,
+		extraction.FieldResult:           result,
+		extraction.FieldPromptTokens:     u.PromptTokens,
+		extraction.FieldCompletionTokens: u.CompletionTokens,
+		extraction.FieldCachedTokens:     u.CachedTokens,
+	}
+	if err := h.db.ExtractionUpdate(ctx, id, fields); err != nil {
+		return nil, errors.Wrapf(err, "could not update the extraction")
+	}
+
+	res, err := h.db.ExtractionGet(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get updated extraction")
+	}
+	promExtractionEndTotal.WithLabelValues(string(res.ReferenceType), string(res.Status)).Inc()
+	h.notifyHandler.PublishWebhookEvent(ctx, res.CustomerID, extraction.EventTypeUpdated, res)
+
+	return res, nil
+}
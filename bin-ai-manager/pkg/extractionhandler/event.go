@@ -0,0 +1,79 @@
+package extractionhandler
+
+import (
+	"context"
+
+	"monorepo/bin-ai-manager/models/extraction"
+	cmcall "monorepo/bin-call-manager/models/call"
+	cfconference "monorepo/bin-conference-manager/models/conference"
+
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// EventCMCallHangup handles the call-manager's call_hangup event
+func (h *extractionHandler) EventCMCallHangup(ctx context.Context, c *cmcall.Call) {
+	h.processProgressing(ctx, extraction.ReferenceTypeCall, c.ID, c.ID)
+}
+
+// EventCMConferenceUpdated handles the conference-manager's conference event
+func (h *extractionHandler) EventCMConferenceUpdated(ctx context.Context, c *cfconference.Conference) {
+	if c.Status != cfconference.StatusTerminated {
+		// nothing to do
+		return
+	}
+
+	h.processProgressing(ctx, extraction.ReferenceTypeConference, c.ID, c.ConfbridgeID)
+}
+
+// processProgressing finishes the progressing extractions of the given reference.
+// transcribeReferenceID is the reference id of the transcribe started for the extractions.
+func (h *extractionHandler) processProgressing(ctx context.Context, referenceType extraction.ReferenceType, referenceID uuid.UUID, transcribeReferenceID uuid.UUID) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":           "processProgressing",
+		"reference_type": referenceType,
+		"reference_id":   referenceID,
+	})
+
+	filters := map[extraction.Field]any{
+		extraction.FieldDeleted:       false,
+		extraction.FieldReferenceType: referenceType,
+		extraction.FieldReferenceID:   referenceID,
+		extraction.FieldStatus:        extraction.StatusProgressing,
+	}
+	es, err := h.db.ExtractionList(ctx, 100, "", filters)
+	if err != nil {
+		log.Errorf("Could not get the extractions. err: %v", err)
+		return
+	}
+	if len(es) == 0 {
+		// extraction not found. nothing todo
+		return
+	}
+
+	transcripts, err := h.getTranscripts(ctx, transcribeReferenceID)
+	if err != nil {
+		log.Errorf("Could not get the transcripts. err: %v", err)
+		return
+	}
+
+	for _, e := range es {
+		status, result, u, err := h.extract(ctx, e, &requestData{Transcripts: transcripts})
+		if err != nil {
+			log.Errorf("Could not extract the data. extraction_id: %s, err: %v", e.ID, err)
+			continue
+		}
+
+		tmp, err := h.updateResult(ctx, e.ID, status, result, u)
+		if err != nil {
+			log.Errorf("Could not update the extraction. extraction_id: %s, err: %v", e.ID, err)
+			continue
+		}
+		log.WithField("extraction", tmp).Debugf("Updated the extraction. extraction_id: %s", tmp.ID)
+
+		if errFlow := h.startOnEndFlow(ctx, tmp); errFlow != nil {
+			// we could not start the on end flow, but we can continue the process
+			log.Errorf("Could not start the on end flow. err: %v", errFlow)
+		}
+	}
+}
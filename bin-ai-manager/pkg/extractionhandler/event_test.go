@@ -0,0 +1,179 @@
+package extractionhandler
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"monorepo/bin-ai-manager/models/analysis"
+	"monorepo/bin-ai-manager/models/extraction"
+	"monorepo/bin-ai-manager/models/usage"
+	"monorepo/bin-ai-manager/pkg/analysishandler"
+	"monorepo/bin-ai-manager/pkg/dbhandler"
+	cmcall "monorepo/bin-call-manager/models/call"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/notifyhandler"
+	"monorepo/bin-common-handler/pkg/requesthandler"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+	tmtranscribe "monorepo/bin-transcribe-manager/models/transcribe"
+	tmtranscript "monorepo/bin-transcribe-manager/models/transcript"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_EventCMCallHangup(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		call *cmcall.Call
+
+		responseExtractions []*extraction.Extraction
+		responseTranscribes []tmtranscribe.Transcribe
+		responseTranscripts []tmtranscript.Transcript
+		responseAnalysis    *analysis.Response
+
+		expectStatus extraction.Status
+		expectResult map[string]any
+		expectUsage  usage.Usage
+	}{
+		{
+			name: "normal",
+
+			call: &cmcall.Call{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("6b1e4d8a-ad47-11f0-8c2f-7d9f1b3c5e01"),
+				},
+			},
+
+			responseExtractions: []*extraction.Extraction{
+				{
+					Identity: commonidentity.Identity{
+						ID:         uuid.FromStringOrNil("6b48f2c0-ad47-11f0-b5a3-8e0a2c4d6f02"),
+						CustomerID: uuid.FromStringOrNil("6b72c7f6-ad47-11f0-9e4b-9f1b3d5e7a03"),
+					},
+					ReferenceType: extraction.ReferenceTypeCall,
+					ReferenceID:   uuid.FromStringOrNil("6b1e4d8a-ad47-11f0-8c2f-7d9f1b3c5e01"),
+					Status:        extraction.StatusProgressing,
+					Schema:        testSchema,
+				},
+			},
+			responseTranscribes: []tmtranscribe.Transcribe{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("6b9c9d2c-ad47-11f0-a1d6-0a2c4e6f8b04"),
+					},
+				},
+			},
+			responseTranscripts: []tmtranscript.Transcript{
+				{
+					Direction: tmtranscript.DirectionIn,
+					Message:   "I'd like to order two pizzas.",
+				},
+			},
+			responseAnalysis: &analysis.Response{
+				Result:       json.RawMessage(`{"intent":"order"}`),
+				FinishReason: "stop",
+				PromptTokens: 100,
+				OutputTokens: 8,
+			},
+
+			expectStatus: extraction.StatusDone,
+			expectResult: map[string]any{
+				"intent": "order",
+			},
+			expectUsage: usage.Usage{
+				PromptTokens:     100,
+				CompletionTokens: 8,
+			},
+		},
+		{
+			name: "result does not conform to the schema",
+
+			call: &cmcall.Call{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("7c2f5e9b-ad47-11f0-9d3a-8e0a2c4d6f01"),
+				},
+			},
+
+			responseExtractions: []*extraction.Extraction{
+				{
+					Identity: commonidentity.Identity{
+						ID:         uuid.FromStringOrNil("7c59a3d1-ad47-11f0-b6b4-9f1b3d5e7a02"),
+						CustomerID: uuid.FromStringOrNil("7c83d8e7-ad47-11f0-8f5c-0a2c4e6f8b03"),
+					},
+					ReferenceType: extraction.ReferenceTypeCall,
+					ReferenceID:   uuid.FromStringOrNil("7c2f5e9b-ad47-11f0-9d3a-8e0a2c4d6f01"),
+					Status:        extraction.StatusProgressing,
+					Schema:        testSchema,
+				},
+			},
+			responseTranscribes: []tmtranscribe.Transcribe{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("7cadae3d-ad47-11f0-a2e7-1b3d5f7a9c04"),
+					},
+				},
+			},
+			responseTranscripts: []tmtranscript.Transcript{},
+			responseAnalysis: &analysis.Response{
+				Result:       json.RawMessage(`{"order_id":"A-100"}`),
+				FinishReason: "stop",
+				PromptTokens: 90,
+				OutputTokens: 7,
+			},
+
+			expectStatus: extraction.StatusFailed,
+			expectUsage: usage.Usage{
+				PromptTokens:     90,
+				CompletionTokens: 7,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+			mockAnalysis := analysishandler.NewMockAnalysisHandler(mc)
+
+			h := extractionHandler{
+				utilHandler:     mockUtil,
+				db:              mockDB,
+				notifyHandler:   mockNotify,
+				reqHandler:      mockReq,
+				analysisHandler: mockAnalysis,
+			}
+			ctx := context.Background()
+
+			e := tt.responseExtractions[0]
+			mockDB.EXPECT().ExtractionList(ctx, uint64(100), "", map[extraction.Field]any{
+				extraction.FieldDeleted:       false,
+				extraction.FieldReferenceType: extraction.ReferenceTypeCall,
+				extraction.FieldReferenceID:   tt.call.ID,
+				extraction.FieldStatus:        extraction.StatusProgressing,
+			}).Return(tt.responseExtractions, nil)
+			mockReq.EXPECT().TranscribeV1TranscribeList(ctx, "", uint64(1), gomock.Any()).Return(tt.responseTranscribes, nil)
+			mockReq.EXPECT().TranscribeV1TranscriptList(ctx, "", uint64(1000), gomock.Any()).Return(tt.responseTranscripts, nil)
+			mockAnalysis.EXPECT().Run(ctx, gomock.Any()).Return(tt.responseAnalysis, nil)
+
+			mockDB.EXPECT().ExtractionUpdate(ctx, e.ID, map[extraction.Field]any{
+				extraction.FieldStatus:           tt.expectStatus,
+				extraction.FieldResult:           tt.expectResult,
+				extraction.FieldPromptTokens:     tt.expectUsage.PromptTokens,
+				extraction.FieldCompletionTokens: tt.expectUsage.CompletionTokens,
+				extraction.FieldCachedTokens:     tt.expectUsage.CachedTokens,
+			}).Return(nil)
+			mockDB.EXPECT().ExtractionGet(ctx, e.ID).Return(e, nil)
+			mockNotify.EXPECT().PublishWebhookEvent(ctx, e.CustomerID, extraction.EventTypeUpdated, e)
+
+			h.EventCMCallHangup(ctx, tt.call)
+		})
+	}
+}
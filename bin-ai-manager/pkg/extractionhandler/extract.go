@@ -0,0 +1,154 @@
+package extractionhandler
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+
+	"monorepo/bin-ai-manager/models/analysis"
+	"monorepo/bin-ai-manager/models/extraction"
+	"monorepo/bin-ai-manager/models/message"
+	"monorepo/bin-ai-manager/models/usage"
+	cmcustomer "monorepo/bin-customer-manager/models/customer"
+	tmtranscribe "monorepo/bin-transcribe-manager/models/transcribe"
+	tmtranscript "monorepo/bin-transcribe-manager/models/transcript"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// requestData is the conversation sent to the LLM.
+type requestData struct {
+	Language    string                    `json:"language,omitempty"`
+	Transcripts []tmtranscript.Transcript `json:"transcripts,omitempty"`
+	Messages    []requestMessage          `json:"messages,omitempty"`
+	Variables   map[string]string         `json:"variables,omitempty"`
+}
+
+// requestMessage is the aicall message sent to the LLM.
+type requestMessage struct {
+	Role    message.Role `json:"role"`
+	Content string       `json:"content"`
+}
+
+// extract fills the extraction's schema from the given conversation.
+// It returns StatusFailed if the LLM output does not conform to the schema.
+// The error is returned only when the LLM could not be reached.
+func (h *extractionHandler) extract(ctx context.Context, e *extraction.Extraction, data *requestData) (extraction.Status, map[string]any, usage.Usage, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":           "extract",
+		"reference_type": e.ReferenceType,
+		"reference_id":   e.ReferenceID,
+	})
+
+	def, err := parseSchema(e.Schema)
+	if err != nil {
+		return extraction.StatusNone, nil, usage.Usage{}, err
+	}
+
+	data.Language = e.Language
+	if e.ActiveflowID != uuid.Nil {
+		v, err := h.reqHandler.FlowV1VariableGet(ctx, e.ActiveflowID)
+		if err != nil {
+			return extraction.StatusNone, nil, usage.Usage{}, errors.Wrapf(err, "could not get the variable")
+		}
+		data.Variables = v.Variables
+	}
+
+	tmpData, err := json.Marshal(data)
+	if err != nil {
+		return extraction.StatusNone, nil, usage.Usage{}, errors.Wrapf(err, "could not marshal the data")
+	}
+
+	tmpSchema, err := json.Marshal(e.Schema)
+	if err != nil {
+		return extraction.StatusNone, nil, usage.Usage{}, errors.Wrapf(err, "could not marshal the schema")
+	}
+
+	tmp, err := h.analysisHandler.Run(ctx, &analysis.Request{
+		Prompt:     defaultExtractionPrompt,
+		Data:       tmpData,
+		Schema:     tmpSchema,
+		SchemaName: schemaName,
+	})
+	if err != nil {
+		return extraction.StatusNone, nil, usage.Usage{}, errors.Wrapf(err, "could not run the analysis")
+	}
+
+	u := usage.Usage{
+		PromptTokens:     int64(tmp.PromptTokens),
+		CompletionTokens: int64(tmp.OutputTokens),
+	}
+
+	if tmp.Truncated {
+		log.Errorf("The result has been truncated. finish_reason: %s", tmp.FinishReason)
+		return extraction.StatusFailed, nil, u, nil
+	}
+
+	res, err := parseResult(def, tmp.Result)
+	if err != nil {
+		log.Errorf("Could not validate the result. err: %v", err)
+		return extraction.StatusFailed, nil, u, nil
+	}
+
+	return extraction.StatusDone, res, u, nil
+}
+
+// getAIcallMessages returns the aicall's conversation in the chronological order.
+// The tool calls and the system messages are not part of the conversation.
+func (h *extractionHandler) getAIcallMessages(ctx context.Context, aicallID uuid.UUID) ([]requestMessage, error) {
+	filters := map[message.Field]any{
+		message.FieldAIcallID: aicallID,
+		message.FieldDeleted:  false,
+	}
+
+	msgs, err := h.db.MessageList(ctx, maxMessages, "", filters)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get the messages")
+	}
+
+	res := []requestMessage{}
+	for _, m := range slices.Backward(msgs) {
+		if m.Role != message.RoleUser && m.Role != message.RoleAssistant {
+			continue
+		}
+		if m.Content == "" {
+			continue
+		}
+
+		res = append(res, requestMessage{
+			Role:    m.Role,
+			Content: m.Content,
+		})
+	}
+
+	return res, nil
+}
+
+// getTranscripts returns the transcripts of the transcribe started for the given reference.
+func (h *extractionHandler) getTranscripts(ctx context.Context, referenceID uuid.UUID) ([]tmtranscript.Transcript, error) {
+	transcribeFilters := map[tmtranscribe.Field]any{
+		tmtranscribe.FieldDeleted:     false,
+		tmtranscribe.FieldCustomerID:  cmcustomer.IDAIManager.String(),
+		tmtranscribe.FieldReferenceID: referenceID.String(),
+	}
+
+	tr, err := h.reqHandler.TranscribeV1TranscribeList(ctx, "", 1, transcribeFilters)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get the transcribe data")
+	} else if len(tr) == 0 {
+		return nil, errors.Errorf("could not find the transcribe data")
+	}
+
+	transcriptFilters := map[tmtranscript.Field]any{
+		tmtranscript.FieldDeleted:      false,
+		tmtranscript.FieldTranscribeID: tr[0].ID.String(),
+	}
+	res, err := h.reqHandler.TranscribeV1TranscriptList(ctx, "", 1000, transcriptFilters)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get the transcript data")
+	}
+
+	return res, nil
+}
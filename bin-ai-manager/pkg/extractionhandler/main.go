@@ -0,0 +1,146 @@
+package extractionhandler
+
+//go:generate mockgen -package extractionhandler -destination ./mock_main.go -source main.go -build_flags=-mod=mod
+
+import (
+	"context"
+
+	"monorepo/bin-ai-manager/models/extraction"
+	"monorepo/bin-ai-manager/pkg/analysishandler"
+	"monorepo/bin-ai-manager/pkg/dbhandler"
+	cmcall "monorepo/bin-call-manager/models/call"
+	commonservice "monorepo/bin-common-handler/models/service"
+	"monorepo/bin-common-handler/pkg/notifyhandler"
+	"monorepo/bin-common-handler/pkg/requesthandler"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+	cfconference "monorepo/bin-conference-manager/models/conference"
+
+	"github.com/gofrs/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// ExtractionHandler fills the customer-supplied JSON schema from a call,
+// conference, transcribe, recording or aicall with the LLM.
+//
+// The LLM call goes through the analysis gateway, so the configured gateway
+// model is used. The returned data is validated against the schema before it
+// is stored.
+type ExtractionHandler interface {
+	Start(
+		ctx context.Context,
+		customerID uuid.UUID,
+		activeflowID uuid.UUID,
+		onEndFlowID uuid.UUID,
+		referenceType extraction.ReferenceType,
+		referenceID uuid.UUID,
+		language string,
+		schema map[string]any,
+		setVariables bool,
+	) (*extraction.Extraction, error)
+	Get(ctx context.Context, id uuid.UUID) (*extraction.Extraction, error)
+	List(ctx context.Context, size uint64, token string, filters map[extraction.Field]any) ([]*extraction.Extraction, error)
+	Delete(ctx context.Context, id uuid.UUID) (*extraction.Extraction, error)
+
+	ServiceStart(
+		ctx context.Context,
+		customerID uuid.UUID,
+		activeflowID uuid.UUID,
+		onEndFlowID uuid.UUID,
+		referenceType extraction.ReferenceType,
+		referenceID uuid.UUID,
+		language string,
+		schema map[string]any,
+		setVariables bool,
+	) (*commonservice.Service, error)
+
+	EventCMCallHangup(ctx context.Context, c *cmcall.Call)
+	EventCMConferenceUpdated(ctx context.Context, c *cfconference.Conference)
+}
+
+type extractionHandler struct {
+	utilHandler   utilhandler.UtilHandler
+	notifyHandler notifyhandler.NotifyHandler
+	reqHandler    requesthandler.RequestHandler
+	db            dbhandler.DBHandler
+
+	analysisHandler analysishandler.AnalysisHandler
+}
+
+var (
+	metricsNamespace = "ai_manager"
+
+	// extraction_start_total counts extraction starts by reference type.
+	promExtractionStartTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "extraction_start_total",
+			Help:      "Total number of extraction starts by reference type.",
+		},
+		[]string{"reference_type"},
+	)
+
+	// extraction_end_total counts finished extractions by reference type and status.
+	promExtractionEndTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "extraction_end_total",
+			Help:      "Total number of finished extractions by reference type and status.",
+		},
+		[]string{"reference_type", "status"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(
+		promExtractionStartTotal,
+		promExtractionEndTotal,
+	)
+}
+
+// NewExtractionHandler creates a new ExtractionHandler
+func NewExtractionHandler(
+	requestHandler requesthandler.RequestHandler,
+	notifyHandler notifyhandler.NotifyHandler,
+	db dbhandler.DBHandler,
+
+	analysisHandler analysishandler.AnalysisHandler,
+) ExtractionHandler {
+	return &extractionHandler{
+		utilHandler:   utilhandler.NewUtilHandler(),
+		reqHandler:    requestHandler,
+		notifyHandler: notifyHandler,
+		db:            db,
+
+		analysisHandler: analysisHandler,
+	}
+}
+
+// list of variables
+const (
+	variableExtractionID            = "voipbin.ai_extract.id"
+	variableExtractionReferenceType = "voipbin.ai_extract.reference_type"
+	variableExtractionReferenceID   = "voipbin.ai_extract.reference_id"
+	variableExtractionStatus        = "voipbin.ai_extract.status"
+	variableExtractionResult        = "voipbin.ai_extract.result"
+
+	// variableExtractionResultPrefix prefixes the per-property variables.
+	// e.g. voipbin.ai_extract.result.order_id
+	variableExtractionResultPrefix = "voipbin.ai_extract.result."
+)
+
+const (
+	schemaName = "extraction"
+
+	// maxMessages caps the number of aicall messages sent to the LLM.
+	maxMessages = 500
+
+	defaultExtractionPrompt = `
+Extract the requested data from the provided conversation (call transcripts or AI conversation messages) and the variables.
+
+**Rules:**
+- Return a single JSON object that conforms to the given JSON schema.
+- Use only the information stated in the conversation or the variables. Never guess or invent values.
+- If a value is not mentioned, omit the property unless the schema requires it. For a required property that is not mentioned, use an empty value of its type.
+- Keep free-text values in the language specified in 'language'.
+`
+)
@@ -0,0 +1,101 @@
+package extractionhandler
+
+import (
+	"encoding/json"
+	"fmt"
+
+	cerrors "monorepo/bin-common-handler/models/errors"
+	commonoutline "monorepo/bin-common-handler/models/outline"
+
+	"github.com/pkg/errors"
+	"github.com/sashabaranov/go-openai/jsonschema"
+)
+
+// parseSchema parses the customer-supplied JSON schema.
+// The root must be an object with at least one property, and every nested
+// definition must have a single type so the result can be validated.
+func parseSchema(schema map[string]any) (*jsonschema.Definition, error) {
+	tmp, err := json.Marshal(schema)
+	if err != nil {
+		return nil, invalidSchema(err.Error())
+	}
+
+	res := &jsonschema.Definition{}
+	if errUnmarshal := json.Unmarshal(tmp, res); errUnmarshal != nil {
+		return nil, invalidSchema(errUnmarshal.Error())
+	}
+
+	if res.Type != jsonschema.Object {
+		return nil, invalidSchema("the root of the schema must be an object")
+	}
+	if len(res.Properties) == 0 {
+		return nil, invalidSchema("the schema must have at least one property")
+	}
+
+	if errCheck := checkDefinition("", res); errCheck != nil {
+		return nil, invalidSchema(errCheck.Error())
+	}
+
+	return res, nil
+}
+
+// checkDefinition checks the given definition and its children recursively.
+func checkDefinition(path string, d *jsonschema.Definition) error {
+	switch d.Type {
+	case jsonschema.Object:
+		for _, name := range d.Required {
+			if _, ok := d.Properties[name]; !ok {
+				return fmt.Errorf("%s: the required property %q is not defined", pathName(path), name)
+			}
+		}
+		for name, p := range d.Properties {
+			if errCheck := checkDefinition(path+"."+name, &p); errCheck != nil {
+				return errCheck
+			}
+		}
+
+	case jsonschema.Array:
+		if d.Items == nil {
+			return fmt.Errorf("%s: the array has no items", pathName(path))
+		}
+		return checkDefinition(path+"[]", d.Items)
+
+	case jsonschema.String, jsonschema.Number, jsonschema.Integer, jsonschema.Boolean, jsonschema.Null:
+		// nothing to check
+
+	default:
+		return fmt.Errorf("%s: unsupported type %q", pathName(path), d.Type)
+	}
+
+	return nil
+}
+
+// pathName returns the human readable name of the schema path.
+func pathName(path string) string {
+	if path == "" {
+		return "root"
+	}
+	return path[1:]
+}
+
+// parseResult parses the LLM output and validates it against the schema.
+func parseResult(def *jsonschema.Definition, data []byte) (map[string]any, error) {
+	res := map[string]any{}
+	if errUnmarshal := json.Unmarshal(data, &res); errUnmarshal != nil {
+		return nil, errors.Wrapf(errUnmarshal, "could not unmarshal the result")
+	}
+
+	if !jsonschema.Validate(*def, res) {
+		return nil, errors.New("the result does not conform to the schema")
+	}
+
+	return res, nil
+}
+
+func invalidSchema(reason string) error {
+	return cerrors.InvalidArgument(
+		commonoutline.ServiceNameAIManager,
+		"INVALID_EXTRACTION_SCHEMA",
+		"The schema is not valid. "+reason,
+	)
+}
@@ -0,0 +1,178 @@
+package extractionhandler
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_parseSchema(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		schema map[string]any
+
+		expectErr bool
+	}{
+		{
+			name: "normal",
+
+			schema: map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"intent":        map[string]any{"type": "string", "enum": []any{"order", "refund"}},
+					"order_id":      map[string]any{"type": "string"},
+					"callback_time": map[string]any{"type": "string"},
+					"items": map[string]any{
+						"type":  "array",
+						"items": map[string]any{"type": "string"},
+					},
+				},
+				"required": []any{"intent"},
+			},
+		},
+		{
+			name: "root is not an object",
+
+			schema: map[string]any{
+				"type": "string",
+			},
+			expectErr: true,
+		},
+		{
+			name: "no properties",
+
+			schema: map[string]any{
+				"type": "object",
+			},
+			expectErr: true,
+		},
+		{
+			name: "required property is not defined",
+
+			schema: map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"intent": map[string]any{"type": "string"},
+				},
+				"required": []any{"order_id"},
+			},
+			expectErr: true,
+		},
+		{
+			name: "property has no type",
+
+			schema: map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"intent": map[string]any{"description": "the caller's intent"},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "array has no items",
+
+			schema: map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"items": map[string]any{"type": "array"},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "multiple types",
+
+			schema: map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"intent": map[string]any{"type": []any{"string", "null"}},
+				},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseSchema(tt.schema)
+			if (err != nil) != tt.expectErr {
+				t.Errorf("Wrong match. expect error: %v, got: %v", tt.expectErr, err)
+			}
+		})
+	}
+}
+
+func Test_parseResult(t *testing.T) {
+
+	schema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"intent":   map[string]any{"type": "string", "enum": []any{"order", "refund"}},
+			"quantity": map[string]any{"type": "integer"},
+		},
+		"required": []any{"intent"},
+	}
+
+	tests := []struct {
+		name string
+
+		data string
+
+		expectRes map[string]any
+		expectErr bool
+	}{
+		{
+			name: "normal",
+
+			data: `{"intent":"refund","quantity":2}`,
+			expectRes: map[string]any{
+				"intent":   "refund",
+				"quantity": float64(2),
+			},
+		},
+		{
+			name: "missing required property",
+
+			data:      `{"quantity":2}`,
+			expectErr: true,
+		},
+		{
+			name: "value not in the enum",
+
+			data:      `{"intent":"complaint"}`,
+			expectErr: true,
+		},
+		{
+			name: "wrong type",
+
+			data:      `{"intent":"order","quantity":1.5}`,
+			expectErr: true,
+		},
+		{
+			name: "not a json",
+
+			data:      `intent: order`,
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			def, err := parseSchema(schema)
+			if err != nil {
+				t.Fatalf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			res, err := parseResult(def, []byte(tt.data))
+			if (err != nil) != tt.expectErr {
+				t.Fatalf("Wrong match. expect error: %v, got: %v", tt.expectErr, err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package extractionhandler
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"monorepo/bin-ai-manager/models/extraction"
+	commonservice "monorepo/bin-common-handler/models/service"
+	fmaction "monorepo/bin-flow-manager/models/action"
+)
+
+// ServiceStart is starting a new service for extraction.
+func (h *extractionHandler) ServiceStart(
+	ctx context.Context,
+	customerID uuid.UUID,
+	activeflowID uuid.UUID,
+	onEndFlowID uuid.UUID,
+	referenceType extraction.ReferenceType,
+	referenceID uuid.UUID,
+	language string,
+	schema map[string]any,
+	setVariables bool,
+) (*commonservice.Service, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":           "ServiceStart",
+		"customer_id":    customerID,
+		"activeflow_id":  activeflowID,
+		"on_end_flow_id": onEndFlowID,
+		"reference_type": referenceType,
+		"reference_id":   referenceID,
+		"language":       language,
+	})
+
+	e, err := h.Start(
+		ctx,
+		customerID,
+		activeflowID,
+		onEndFlowID,
+		referenceType,
+		referenceID,
+		language,
+		schema,
+		setVariables,
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Could not start the extraction. activeflow_id: %s", activeflowID)
+	}
+	log.WithField("extraction", e).Debugf("Started the extraction. extraction_id: %s", e.ID)
+
+	res := &commonservice.Service{
+		ID:          e.ID,
+		Type:        commonservice.TypeAIExtract,
+		PushActions: []fmaction.Action{},
+	}
+
+	return res, nil
+}
@@ -0,0 +1,324 @@
+package extractionhandler
+
+import (
+	"context"
+	"fmt"
+
+	"monorepo/bin-ai-manager/models/extraction"
+	"monorepo/bin-ai-manager/models/usage"
+	cmcall "monorepo/bin-call-manager/models/call"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	cfconference "monorepo/bin-conference-manager/models/conference"
+	cmcustomer "monorepo/bin-customer-manager/models/customer"
+	fmactiveflow "monorepo/bin-flow-manager/models/activeflow"
+	tmtranscribe "monorepo/bin-transcribe-manager/models/transcribe"
+	tmtranscript "monorepo/bin-transcribe-manager/models/transcript"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// Start starts the extraction of the given reference.
+//
+// The transcribe, recording and aicall references are extracted right away.
+// The call and conference references start the transcribe and are extracted
+// once the call is hung up or the conference is terminated.
+func (h *extractionHandler) Start(
+	ctx context.Context,
+	customerID uuid.UUID,
+	activeflowID uuid.UUID,
+	onEndFlowID uuid.UUID,
+	referenceType extraction.ReferenceType,
+	referenceID uuid.UUID,
+	language string,
+	schema map[string]any,
+	setVariables bool,
+) (*extraction.Extraction, error) {
+	if _, err := parseSchema(schema); err != nil {
+		return nil, err
+	}
+
+	promExtractionStartTotal.WithLabelValues(string(referenceType)).Inc()
+
+	e := &extraction.Extraction{
+		Identity: commonidentity.Identity{
+			CustomerID: customerID,
+		},
+		ActiveflowID:  activeflowID,
+		OnEndFlowID:   onEndFlowID,
+		ReferenceType: referenceType,
+		ReferenceID:   referenceID,
+		Language:      language,
+		Schema:        schema,
+		SetVariables:  setVariables,
+	}
+
+	switch referenceType {
+	case extraction.ReferenceTypeTranscribe:
+		return h.startReferenceTypeTranscribe(ctx, e)
+
+	case extraction.ReferenceTypeRecording:
+		return h.startReferenceTypeRecording(ctx, e)
+
+	case extraction.ReferenceTypeCall:
+		return h.startReferenceTypeCall(ctx, e)
+
+	case extraction.ReferenceTypeConference:
+		return h.startReferenceTypeConference(ctx, e)
+
+	case extraction.ReferenceTypeAIcall:
+		return h.startReferenceTypeAIcall(ctx, e)
+
+	default:
+		return nil, errors.Errorf("unsupported reference type: %s", referenceType)
+	}
+}
+
+func (h *extractionHandler) startReferenceTypeCall(ctx context.Context, e *extraction.Extraction) (*extraction.Extraction, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":          "startReferenceTypeCall",
+		"activeflow_id": e.ActiveflowID,
+		"reference_id":  e.ReferenceID,
+	})
+
+	c, err := h.reqHandler.CallV1CallGet(ctx, e.ReferenceID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get the call data")
+	}
+
+	if c.Status == cmcall.StatusHangup {
+		return nil, fmt.Errorf("the call has already been hung up")
+	}
+
+	if e.ActiveflowID == uuid.Nil {
+		log.Debugf("ActiveflowID is nil. Set the activeflowID as the call's activeflowID.")
+		e.ActiveflowID = c.ActiveflowID
+	}
+
+	// note: the transcribe is created with the ai manager's customer id, so it
+	// is not shown in the customer's transcribe list.
+	tr, err := h.reqHandler.TranscribeV1TranscribeStart(
+		ctx,
+		cmcustomer.IDAIManager,
+		e.ActiveflowID,
+		uuid.Nil,
+		tmtranscribe.ReferenceTypeCall,
+		e.ReferenceID,
+		e.Language,
+		tmtranscribe.DirectionBoth,
+		tmtranscribe.ProviderEmpty,
+		5000,
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not start the transcribe")
+	}
+	log.WithField("transcribe", tr).Debugf("Started transcribe. transcribe_id: %s", tr.ID)
+
+	return h.createFrom(ctx, e, extraction.StatusProgressing, nil, usage.Usage{})
+}
+
+func (h *extractionHandler) startReferenceTypeConference(ctx context.Context, e *extraction.Extraction) (*extraction.Extraction, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":          "startReferenceTypeConference",
+		"activeflow_id": e.ActiveflowID,
+		"reference_id":  e.ReferenceID,
+	})
+
+	cf, err := h.reqHandler.ConferenceV1ConferenceGet(ctx, e.ReferenceID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get the conference data")
+	}
+
+	if cf.Status != cfconference.StatusProgressing {
+		return nil, fmt.Errorf("the conference is not progressing")
+	}
+
+	tr, err := h.reqHandler.TranscribeV1TranscribeStart(
+		ctx,
+		cmcustomer.IDAIManager,
+		e.ActiveflowID,
+		uuid.Nil,
+		tmtranscribe.ReferenceTypeConfbridge,
+		cf.ConfbridgeID,
+		e.Language,
+		tmtranscribe.DirectionIn,
+		tmtranscribe.ProviderEmpty,
+		5000,
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not start the transcribe")
+	}
+	log.WithField("transcribe", tr).Debugf("Started transcribe. transcribe_id: %s", tr.ID)
+
+	return h.createFrom(ctx, e, extraction.StatusProgressing, nil, usage.Usage{})
+}
+
+func (h *extractionHandler) startReferenceTypeTranscribe(ctx context.Context, e *extraction.Extraction) (*extraction.Extraction, error) {
+	filters := map[tmtranscript.Field]any{
+		tmtranscript.FieldDeleted:      false,
+		tmtranscript.FieldTranscribeID: e.ReferenceID.String(),
+	}
+	transcripts, err := h.reqHandler.TranscribeV1TranscriptList(ctx, "", 1000, filters)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get the transcribe data")
+	}
+
+	return h.extractAndCreate(ctx, e, &requestData{Transcripts: transcripts})
+}
+
+func (h *extractionHandler) startReferenceTypeRecording(ctx context.Context, e *extraction.Extraction) (*extraction.Extraction, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":          "startReferenceTypeRecording",
+		"activeflow_id": e.ActiveflowID,
+		"reference_id":  e.ReferenceID,
+	})
+
+	tr, err := h.reqHandler.TranscribeV1TranscribeStart(
+		ctx,
+		cmcustomer.IDAIManager,
+		e.ActiveflowID,
+		uuid.Nil,
+		tmtranscribe.ReferenceTypeRecording,
+		e.ReferenceID,
+		e.Language,
+		tmtranscribe.DirectionBoth,
+		tmtranscribe.ProviderEmpty,
+		300000,
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not start the transcribe")
+	}
+	log.WithField("transcribe", tr).Debugf("Finished transcribe. transcribe_id: %s", tr.ID)
+
+	filters := map[tmtranscript.Field]any{
+		tmtranscript.FieldDeleted:      false,
+		tmtranscript.FieldTranscribeID: tr.ID.String(),
+	}
+	transcripts, err := h.reqHandler.TranscribeV1TranscriptList(ctx, "", 1000, filters)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get the transcribe data")
+	}
+
+	return h.extractAndCreate(ctx, e, &requestData{Transcripts: transcripts})
+}
+
+func (h *extractionHandler) startReferenceTypeAIcall(ctx context.Context, e *extraction.Extraction) (*extraction.Extraction, error) {
+	c, err := h.db.AIcallGet(ctx, e.ReferenceID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get the aicall data")
+	}
+
+	if e.ActiveflowID == uuid.Nil {
+		e.ActiveflowID = c.ActiveflowID
+	}
+
+	messages, err := h.getAIcallMessages(ctx, c.ID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get the aicall messages")
+	}
+
+	return h.extractAndCreate(ctx, e, &requestData{Messages: messages})
+}
+
+// extractAndCreate extracts the data and creates the finished extraction.
+func (h *extractionHandler) extractAndCreate(ctx context.Context, e *extraction.Extraction, data *requestData) (*extraction.Extraction, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":           "extractAndCreate",
+		"reference_type": e.ReferenceType,
+		"reference_id":   e.ReferenceID,
+	})
+
+	status, result, u, err := h.extract(ctx, e, data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not extract the data")
+	}
+
+	res, err := h.createFrom(ctx, e, status, result, u)
+	if err != nil {
+		return nil, err
+	}
+
+	if errFlow := h.startOnEndFlow(ctx, res); errFlow != nil {
+		// we could not start the on end flow, but we can continue the process
+		log.Errorf("Could not start the on end flow. err: %v", errFlow)
+	}
+
+	return res, nil
+}
+
+// createFrom creates the extraction of the given request with the given status and result.
+func (h *extractionHandler) createFrom(
+	ctx context.Context,
+	e *extraction.Extraction,
+	status extraction.Status,
+	result map[string]any,
+	u usage.Usage,
+) (*extraction.Extraction, error) {
+	res, err := h.create(
+		ctx,
+		e.CustomerID,
+		e.ActiveflowID,
+		e.OnEndFlowID,
+		e.ReferenceType,
+		e.ReferenceID,
+		status,
+		e.Language,
+		e.Schema,
+		e.SetVariables,
+		result,
+		u,
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not create the extraction")
+	}
+
+	if errSet := h.variableSet(ctx, res.ActiveflowID, res); errSet != nil {
+		// we could not set the variable, but we can continue the process
+		logrus.WithField("extraction_id", res.ID).Errorf("Could not set the variables. err: %v", errSet)
+	}
+
+	return res, nil
+}
+
+// startOnEndFlow starts the on end flow of the finished extraction.
+func (h *extractionHandler) startOnEndFlow(ctx context.Context, e *extraction.Extraction) error {
+	log := logrus.WithFields(logrus.Fields{
+		"func":       "startOnEndFlow",
+		"extraction": e,
+	})
+
+	if e.OnEndFlowID == uuid.Nil {
+		// has no on end flow. nothing to do
+		return nil
+	}
+
+	af, err := h.reqHandler.FlowV1ActiveflowCreate(
+		ctx,
+		uuid.Nil,
+		e.CustomerID,
+		e.OnEndFlowID,
+		fmactiveflow.ReferenceTypeAI,
+		e.ID,
+		e.ActiveflowID,
+		nil,
+		"",
+		fmactiveflow.WebhookMethodNone,
+	)
+	if err != nil {
+		return errors.Wrapf(err, "could not create the activeflow")
+	}
+	log.WithField("activeflow", af).Debugf("Created the activeflow")
+
+	if errSet := h.variableSet(ctx, af.ID, e); errSet != nil {
+		// we could not set the variable, but we can continue the process
+		log.Errorf("could not set the variable. activeflow_id: %s", af.ID)
+	}
+
+	if errExecute := h.reqHandler.FlowV1ActiveflowExecute(ctx, af.ID); errExecute != nil {
+		return errors.Wrapf(errExecute, "could not execute the activeflow")
+	}
+	log.Debugf("Executed the activeflow")
+
+	return nil
+}
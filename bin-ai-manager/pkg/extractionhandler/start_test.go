@@ -0,0 +1,332 @@
+package extractionhandler
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"monorepo/bin-ai-manager/models/aicall"
+	"monorepo/bin-ai-manager/models/analysis"
+	"monorepo/bin-ai-manager/models/extraction"
+	"monorepo/bin-ai-manager/models/message"
+	"monorepo/bin-ai-manager/models/usage"
+	"monorepo/bin-ai-manager/pkg/analysishandler"
+	"monorepo/bin-ai-manager/pkg/dbhandler"
+	cmcall "monorepo/bin-call-manager/models/call"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/notifyhandler"
+	"monorepo/bin-common-handler/pkg/requesthandler"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+	cmcustomer "monorepo/bin-customer-manager/models/customer"
+	fmactiveflow "monorepo/bin-flow-manager/models/activeflow"
+	fmvariable "monorepo/bin-flow-manager/models/variable"
+	tmtranscribe "monorepo/bin-transcribe-manager/models/transcribe"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+var testSchema = map[string]any{
+	"type": "object",
+	"properties": map[string]any{
+		"intent":   map[string]any{"type": "string"},
+		"order_id": map[string]any{"type": "string"},
+	},
+	"required": []any{"intent"},
+}
+
+func Test_Start_referenceTypeAIcall(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		customerID   uuid.UUID
+		onEndFlowID  uuid.UUID
+		referenceID  uuid.UUID
+		language     string
+		setVariables bool
+
+		responseAIcall     *aicall.AIcall
+		responseMessages   []*message.Message
+		responseVariable   *fmvariable.Variable
+		responseAnalysis   *analysis.Response
+		responseUUID       uuid.UUID
+		responseActiveflow *fmactiveflow.Activeflow
+
+		expectData       string
+		expectExtraction *extraction.Extraction
+		expectVariables  map[string]string
+	}{
+		{
+			name: "normal",
+
+			customerID:   uuid.FromStringOrNil("3e0b6a56-ad47-11f0-8f1e-7b2c4a9d1e01"),
+			onEndFlowID:  uuid.FromStringOrNil("3e35d7d4-ad47-11f0-a3b2-1d6e8f2c4a02"),
+			referenceID:  uuid.FromStringOrNil("3e5f0e9a-ad47-11f0-9c4d-5a7b3e1f6c03"),
+			language:     "en-US",
+			setVariables: true,
+
+			responseAIcall: &aicall.AIcall{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("3e5f0e9a-ad47-11f0-9c4d-5a7b3e1f6c03"),
+				},
+				ActiveflowID: uuid.FromStringOrNil("3e89c6b0-ad47-11f0-b7e1-2f4d6a8c0e04"),
+			},
+			responseMessages: []*message.Message{
+				{Role: message.RoleAssistant, Content: "Your refund for A-100 has been requested."},
+				{Role: message.RoleTool, Content: `{"result":"ok"}`},
+				{Role: message.RoleUser, Content: "I want a refund for the order A-100."},
+				{Role: message.RoleSystem, Content: "You are a helpful agent."},
+			},
+			responseVariable: &fmvariable.Variable{
+				Variables: map[string]string{
+					"voipbin.call.source.target": "+821100000001",
+				},
+			},
+			responseAnalysis: &analysis.Response{
+				Result:       json.RawMessage(`{"intent":"refund","order_id":"A-100"}`),
+				FinishReason: "stop",
+				PromptTokens: 230,
+				OutputTokens: 18,
+			},
+			responseUUID: uuid.FromStringOrNil("3eb2f1c6-ad47-11f0-8a5f-6c1e3b7d9f05"),
+			responseActiveflow: &fmactiveflow.Activeflow{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("3edc4a0e-ad47-11f0-94a6-3b8f5d2e1a06"),
+				},
+			},
+
+			expectData: `{"language":"en-US","messages":[{"role":"user","content":"I want a refund for the order A-100."},{"role":"assistant","content":"Your refund for A-100 has been requested."}],"variables":{"voipbin.call.source.target":"+821100000001"}}`,
+			expectExtraction: &extraction.Extraction{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("3eb2f1c6-ad47-11f0-8a5f-6c1e3b7d9f05"),
+					CustomerID: uuid.FromStringOrNil("3e0b6a56-ad47-11f0-8f1e-7b2c4a9d1e01"),
+				},
+				ActiveflowID:  uuid.FromStringOrNil("3e89c6b0-ad47-11f0-b7e1-2f4d6a8c0e04"),
+				OnEndFlowID:   uuid.FromStringOrNil("3e35d7d4-ad47-11f0-a3b2-1d6e8f2c4a02"),
+				ReferenceType: extraction.ReferenceTypeAIcall,
+				ReferenceID:   uuid.FromStringOrNil("3e5f0e9a-ad47-11f0-9c4d-5a7b3e1f6c03"),
+				Status:        extraction.StatusDone,
+				Language:      "en-US",
+				Schema:        testSchema,
+				SetVariables:  true,
+				Result: map[string]any{
+					"intent":   "refund",
+					"order_id": "A-100",
+				},
+				Usage: usage.Usage{
+					PromptTokens:     230,
+					CompletionTokens: 18,
+				},
+			},
+			expectVariables: map[string]string{
+				variableExtractionID:                        "3eb2f1c6-ad47-11f0-8a5f-6c1e3b7d9f05",
+				variableExtractionReferenceType:             "aicall",
+				variableExtractionReferenceID:               "3e5f0e9a-ad47-11f0-9c4d-5a7b3e1f6c03",
+				variableExtractionStatus:                    "done",
+				variableExtractionResult:                    `{"intent":"refund","order_id":"A-100"}`,
+				variableExtractionResultPrefix + "intent":   "refund",
+				variableExtractionResultPrefix + "order_id": "A-100",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+			mockAnalysis := analysishandler.NewMockAnalysisHandler(mc)
+
+			h := extractionHandler{
+				utilHandler:     mockUtil,
+				db:              mockDB,
+				notifyHandler:   mockNotify,
+				reqHandler:      mockReq,
+				analysisHandler: mockAnalysis,
+			}
+			ctx := context.Background()
+
+			mockDB.EXPECT().AIcallGet(ctx, tt.referenceID).Return(tt.responseAIcall, nil)
+			mockDB.EXPECT().MessageList(ctx, uint64(maxMessages), "", gomock.Any()).Return(tt.responseMessages, nil)
+			mockReq.EXPECT().FlowV1VariableGet(ctx, tt.responseAIcall.ActiveflowID).Return(tt.responseVariable, nil)
+			mockAnalysis.EXPECT().Run(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, req *analysis.Request) (*analysis.Response, error) {
+				if string(req.Data) != tt.expectData {
+					t.Errorf("Wrong match.\nexpect: %s\ngot: %s", tt.expectData, req.Data)
+				}
+				if req.SchemaName != schemaName {
+					t.Errorf("Wrong match. expect: %s, got: %s", schemaName, req.SchemaName)
+				}
+				return tt.responseAnalysis, nil
+			})
+
+			mockUtil.EXPECT().UUIDCreate().Return(tt.responseUUID)
+			mockDB.EXPECT().ExtractionCreate(ctx, tt.expectExtraction).Return(nil)
+			mockDB.EXPECT().ExtractionGet(ctx, tt.responseUUID).Return(tt.expectExtraction, nil)
+			mockNotify.EXPECT().PublishWebhookEvent(ctx, tt.customerID, extraction.EventTypeCreated, tt.expectExtraction)
+			mockReq.EXPECT().FlowV1VariableSetVariable(ctx, tt.responseAIcall.ActiveflowID, tt.expectVariables).Return(nil)
+
+			// on end flow
+			mockReq.EXPECT().FlowV1ActiveflowCreate(
+				ctx,
+				uuid.Nil,
+				tt.customerID,
+				tt.onEndFlowID,
+				fmactiveflow.ReferenceTypeAI,
+				tt.responseUUID,
+				tt.responseAIcall.ActiveflowID,
+				nil,
+				"",
+				fmactiveflow.WebhookMethodNone,
+			).Return(tt.responseActiveflow, nil)
+			mockReq.EXPECT().FlowV1VariableSetVariable(ctx, tt.responseActiveflow.ID, tt.expectVariables).Return(nil)
+			mockReq.EXPECT().FlowV1ActiveflowExecute(ctx, tt.responseActiveflow.ID).Return(nil)
+
+			res, err := h.Start(ctx, tt.customerID, uuid.Nil, tt.onEndFlowID, extraction.ReferenceTypeAIcall, tt.referenceID, tt.language, testSchema, tt.setVariables)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectExtraction) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectExtraction, res)
+			}
+		})
+	}
+}
+
+func Test_Start_referenceTypeCall(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		customerID   uuid.UUID
+		activeflowID uuid.UUID
+		referenceID  uuid.UUID
+		language     string
+
+		responseCall       *cmcall.Call
+		responseTranscribe *tmtranscribe.Transcribe
+		responseUUID       uuid.UUID
+
+		expectExtraction *extraction.Extraction
+		expectVariables  map[string]string
+	}{
+		{
+			name: "normal",
+
+			customerID:   uuid.FromStringOrNil("4f1d2a3c-ad47-11f0-8b6e-1c3e5a7f9b01"),
+			activeflowID: uuid.FromStringOrNil("4f48e6b2-ad47-11f0-a0c7-2d4f6b8e0c02"),
+			referenceID:  uuid.FromStringOrNil("4f72b9d8-ad47-11f0-9f1a-3e5a7c9d1f03"),
+			language:     "en-US",
+
+			responseCall: &cmcall.Call{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("4f72b9d8-ad47-11f0-9f1a-3e5a7c9d1f03"),
+				},
+				Status: cmcall.StatusProgressing,
+			},
+			responseTranscribe: &tmtranscribe.Transcribe{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("4f9c8e0a-ad47-11f0-b2d3-4f6b8d0e2a04"),
+				},
+			},
+			responseUUID: uuid.FromStringOrNil("4fc6a4e6-ad47-11f0-8e5c-5a7c9e1f3b05"),
+
+			expectExtraction: &extraction.Extraction{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("4fc6a4e6-ad47-11f0-8e5c-5a7c9e1f3b05"),
+					CustomerID: uuid.FromStringOrNil("4f1d2a3c-ad47-11f0-8b6e-1c3e5a7f9b01"),
+				},
+				ActiveflowID:  uuid.FromStringOrNil("4f48e6b2-ad47-11f0-a0c7-2d4f6b8e0c02"),
+				ReferenceType: extraction.ReferenceTypeCall,
+				ReferenceID:   uuid.FromStringOrNil("4f72b9d8-ad47-11f0-9f1a-3e5a7c9d1f03"),
+				Status:        extraction.StatusProgressing,
+				Language:      "en-US",
+				Schema:        testSchema,
+			},
+			expectVariables: map[string]string{
+				variableExtractionID:            "4fc6a4e6-ad47-11f0-8e5c-5a7c9e1f3b05",
+				variableExtractionReferenceType: "call",
+				variableExtractionReferenceID:   "4f72b9d8-ad47-11f0-9f1a-3e5a7c9d1f03",
+				variableExtractionStatus:        "progressing",
+				variableExtractionResult:        "",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+
+			h := extractionHandler{
+				utilHandler:   mockUtil,
+				db:            mockDB,
+				notifyHandler: mockNotify,
+				reqHandler:    mockReq,
+			}
+			ctx := context.Background()
+
+			mockReq.EXPECT().CallV1CallGet(ctx, tt.referenceID).Return(tt.responseCall, nil)
+			mockReq.EXPECT().TranscribeV1TranscribeStart(
+				ctx,
+				cmcustomer.IDAIManager,
+				tt.activeflowID,
+				uuid.Nil,
+				tmtranscribe.ReferenceTypeCall,
+				tt.referenceID,
+				tt.language,
+				tmtranscribe.DirectionBoth,
+				tmtranscribe.ProviderEmpty,
+				5000,
+			).Return(tt.responseTranscribe, nil)
+
+			mockUtil.EXPECT().UUIDCreate().Return(tt.responseUUID)
+			mockDB.EXPECT().ExtractionCreate(ctx, tt.expectExtraction).Return(nil)
+			mockDB.EXPECT().ExtractionGet(ctx, tt.responseUUID).Return(tt.expectExtraction, nil)
+			mockNotify.EXPECT().PublishWebhookEvent(ctx, tt.customerID, extraction.EventTypeCreated, tt.expectExtraction)
+			mockReq.EXPECT().FlowV1VariableSetVariable(ctx, tt.activeflowID, tt.expectVariables).Return(nil)
+
+			res, err := h.Start(ctx, tt.customerID, tt.activeflowID, uuid.Nil, extraction.ReferenceTypeCall, tt.referenceID, tt.language, testSchema, false)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectExtraction) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectExtraction, res)
+			}
+		})
+	}
+}
+
+func Test_Start_invalidSchema(t *testing.T) {
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	h := extractionHandler{}
+
+	_, err := h.Start(
+		context.Background(),
+		uuid.FromStringOrNil("5a0e3c7e-ad47-11f0-9d8f-6b8d0f2a4c01"),
+		uuid.Nil,
+		uuid.Nil,
+		extraction.ReferenceTypeAIcall,
+		uuid.FromStringOrNil("5a38d2b4-ad47-11f0-a6e1-7c9e1a3b5d02"),
+		"en-US",
+		map[string]any{"type": "string"},
+		false,
+	)
+	if err == nil {
+		t.Errorf("Wrong match. expect: error, got: ok")
+	}
+}
@@ -0,0 +1,68 @@
+package extractionhandler
+
+import (
+	"context"
+	"encoding/json"
+
+	"monorepo/bin-ai-manager/models/extraction"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+)
+
+// variableSet sets the extraction's variables to the given activeflow.
+// If the extraction's set_variables is true, every result property is set as well.
+func (h *extractionHandler) variableSet(ctx context.Context, activeflowID uuid.UUID, e *extraction.Extraction) error {
+
+	if activeflowID == uuid.Nil {
+		return nil
+	}
+
+	result := ""
+	if e.Result != nil {
+		tmp, err := json.Marshal(e.Result)
+		if err != nil {
+			return errors.Wrapf(err, "could not marshal the result")
+		}
+		result = string(tmp)
+	}
+
+	variables := map[string]string{
+		variableExtractionID:            e.ID.String(),
+		variableExtractionReferenceType: string(e.ReferenceType),
+		variableExtractionReferenceID:   e.ReferenceID.String(),
+		variableExtractionStatus:        string(e.Status),
+		variableExtractionResult:        result,
+	}
+
+	if e.SetVariables {
+		for k, v := range e.Result {
+			variables[variableExtractionResultPrefix+k] = variableValue(v)
+		}
+	}
+
+	if errSet := h.reqHandler.FlowV1VariableSetVariable(ctx, activeflowID, variables); errSet != nil {
+		return errors.Wrapf(errSet, "could not set the variable. extraction_id: %s", e.ID)
+	}
+
+	return nil
+}
+
+// variableValue returns the variable value of the given result property.
+// The strings are set as they are, and the others are set in the JSON format.
+func variableValue(v any) string {
+	switch t := v.(type) {
+	case string:
+		return t
+
+	case nil:
+		return ""
+
+	default:
+		tmp, err := json.Marshal(t)
+		if err != nil {
+			return ""
+		}
+		return string(tmp)
+	}
+}
@@ -11,6 +11,9 @@ import (
 
 	openai "github.com/sashabaranov/go-openai"
 	"github.com/sirupsen/logrus"
+
+	"monorepo/bin-ai-manager/models/usage"
+	"monorepo/bin-ai-manager/pkg/engine_openai_handler"
 )
 
 const (
@@ -60,6 +63,8 @@ type EvaluationResponse struct {
 	OverallScore int                  `json:"overall_score"`
 	Dimensions   EvaluationDimensions `json:"dimensions"`
 	Summary      string               `json:"summary"`
+
+	Usage usage.Usage `json:"-"` // LLM usage of the evaluation call
 }
 
 // GeminiAuditHandler handles calling Gemini for audit evaluation.
@@ -275,6 +280,8 @@ func (h *geminiAuditHandler) Evaluate(ctx context.Context, promptText, transcrip
 		return nil, nil, fmt.Errorf("invalid_evaluator_response: %w", parseErr)
 	}
 
+	parsed.Usage = engine_openai_handler.ConvertUsage(resp.Usage)
+
 	logrus.Debugf("gemini Evaluate: parse succeeded score=%d", parsed.OverallScore)
 	return parsed, raw, nil
 }
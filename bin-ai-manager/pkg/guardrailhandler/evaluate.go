@@ -0,0 +1,111 @@
+package guardrailhandler
+
+import (
+	"context"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/gofrs/uuid"
+
+	"monorepo/bin-ai-manager/models/guardrail"
+	"monorepo/bin-ai-manager/models/guardrailviolation"
+)
+
+// EvaluateOutput checks the AI's output against the config's forbidden
+// phrases. Returns the triggered rule and the matched phrase, or RuleNone if
+// the output passes.
+//
+// The blocked topics are not checked here, since the AI's refusal of a blocked
+// topic names the topic too. They are checked on the user's input instead.
+//
+// The phrase is matched case-insensitive with the whitespace collapsed.
+func (h *guardrailHandler) EvaluateOutput(cfg *guardrail.Config, text string) (guardrailviolation.Rule, string) {
+	if cfg == nil {
+		return guardrailviolation.RuleNone, ""
+	}
+
+	normalized := normalize(text)
+	if normalized == "" {
+		return guardrailviolation.RuleNone, ""
+	}
+
+	for _, phrase := range cfg.ForbiddenPhrases {
+		if p := normalize(phrase); p != "" && strings.Contains(normalized, p) {
+			return guardrailviolation.RuleForbiddenPhrase, phrase
+		}
+	}
+
+	return guardrailviolation.RuleNone, ""
+}
+
+// EvaluateInput checks the user's input against the config's blocked topics.
+// Returns the triggered rule and the matched topic, or RuleNone if the input
+// passes.
+//
+// The topic is matched case-insensitive with the whitespace collapsed, and on
+// the word boundaries, so "art" does not block "start".
+func (h *guardrailHandler) EvaluateInput(cfg *guardrail.Config, text string) (guardrailviolation.Rule, string) {
+	if cfg == nil {
+		return guardrailviolation.RuleNone, ""
+	}
+
+	normalized := normalize(text)
+	if normalized == "" {
+		return guardrailviolation.RuleNone, ""
+	}
+
+	for _, topic := range cfg.BlockedTopics {
+		if t := normalize(topic); t != "" && containsWord(normalized, t) {
+			return guardrailviolation.RuleBlockedTopic, topic
+		}
+	}
+
+	return guardrailviolation.RuleNone, ""
+}
+
+// DisclaimerClaim returns true only for the first claim of the aicall, so the
+// disclaimer is said once.
+func (h *guardrailHandler) DisclaimerClaim(ctx context.Context, aicallID uuid.UUID) (bool, error) {
+	return h.db.GuardrailDisclaimerSet(ctx, aicallID)
+}
+
+// normalize lowercases the text and collapses its whitespace.
+func normalize(text string) string {
+	return strings.Join(strings.Fields(strings.ToLower(text)), " ")
+}
+
+// containsWord returns true if the text contains the word on the word boundaries.
+// The boundary is checked only on the word's ASCII letter or digit edges, since
+// the languages without spaces have no word boundary to check.
+func containsWord(text string, word string) bool {
+	first, _ := utf8.DecodeRuneInString(word)
+	last, _ := utf8.DecodeLastRuneInString(word)
+
+	for offset := 0; offset < len(text); {
+		idx := strings.Index(text[offset:], word)
+		if idx < 0 {
+			return false
+		}
+		start := offset + idx
+		end := start + len(word)
+
+		before, _ := utf8.DecodeLastRuneInString(text[:start])
+		after, _ := utf8.DecodeRuneInString(text[end:])
+		if !(isASCIIWordRune(first) && isWordRune(before)) && !(isASCIIWordRune(last) && isWordRune(after)) {
+			return true
+		}
+
+		offset = start + 1
+	}
+
+	return false
+}
+
+func isASCIIWordRune(r rune) bool {
+	return r < utf8.RuneSelf && isWordRune(r)
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
@@ -0,0 +1,177 @@
+package guardrailhandler
+
+import (
+	"testing"
+
+	"monorepo/bin-ai-manager/models/guardrail"
+	"monorepo/bin-ai-manager/models/guardrailviolation"
+)
+
+func Test_EvaluateOutput(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		config *guardrail.Config
+		text   string
+
+		expectRule   guardrailviolation.Rule
+		expectDetail string
+	}{
+		{
+			name: "nil config",
+
+			config: nil,
+			text:   "anything goes",
+
+			expectRule: guardrailviolation.RuleNone,
+		},
+		{
+			name: "forbidden phrase with different case and spacing",
+
+			config: &guardrail.Config{
+				ForbiddenPhrases: []string{"Guaranteed Return"},
+			},
+			text: "This fund has a guaranteed\n return of 10%.",
+
+			expectRule:   guardrailviolation.RuleForbiddenPhrase,
+			expectDetail: "Guaranteed Return",
+		},
+		{
+			name: "refusal naming a blocked topic",
+
+			config: &guardrail.Config{
+				BlockedTopics:    []string{"investment advice"},
+				ForbiddenPhrases: []string{"guaranteed return"},
+			},
+			text: "I'm sorry, I can't give investment advice.",
+
+			expectRule: guardrailviolation.RuleNone,
+		},
+		{
+			name: "clean text",
+
+			config: &guardrail.Config{
+				BlockedTopics:    []string{"investment advice"},
+				ForbiddenPhrases: []string{"guaranteed return"},
+			},
+			text: "Your balance is 100 dollars.",
+
+			expectRule: guardrailviolation.RuleNone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &guardrailHandler{}
+
+			rule, detail := h.EvaluateOutput(tt.config, tt.text)
+			if rule != tt.expectRule {
+				t.Errorf("Wrong match. expect: %s, got: %s", tt.expectRule, rule)
+			}
+			if detail != tt.expectDetail {
+				t.Errorf("Wrong match. expect: %s, got: %s", tt.expectDetail, detail)
+			}
+		})
+	}
+}
+
+func Test_EvaluateInput(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		config *guardrail.Config
+		text   string
+
+		expectRule   guardrailviolation.Rule
+		expectDetail string
+	}{
+		{
+			name: "nil config",
+
+			config: nil,
+			text:   "anything goes",
+
+			expectRule: guardrailviolation.RuleNone,
+		},
+		{
+			name: "blocked topic",
+
+			config: &guardrail.Config{
+				BlockedTopics: []string{"investment advice"},
+			},
+			text: "Can you give me some Investment Advice?",
+
+			expectRule:   guardrailviolation.RuleBlockedTopic,
+			expectDetail: "investment advice",
+		},
+		{
+			name: "blocked topic inside another word",
+
+			config: &guardrail.Config{
+				BlockedTopics: []string{"art"},
+			},
+			text: "Let's start with my account.",
+
+			expectRule: guardrailviolation.RuleNone,
+		},
+		{
+			name: "blocked topic after an inner-word occurrence",
+
+			config: &guardrail.Config{
+				BlockedTopics: []string{"art"},
+			},
+			text: "Let's start talking about art.",
+
+			expectRule:   guardrailviolation.RuleBlockedTopic,
+			expectDetail: "art",
+		},
+		{
+			name: "blocked topic without word boundaries",
+
+			config: &guardrail.Config{
+				BlockedTopics: []string{"투자"},
+			},
+			text: "투자상담 좀 해주세요.",
+
+			expectRule:   guardrailviolation.RuleBlockedTopic,
+			expectDetail: "투자",
+		},
+		{
+			name: "forbidden phrase is not checked",
+
+			config: &guardrail.Config{
+				ForbiddenPhrases: []string{"risk free"},
+			},
+			text: "Is this risk free?",
+
+			expectRule: guardrailviolation.RuleNone,
+		},
+		{
+			name: "clean text",
+
+			config: &guardrail.Config{
+				BlockedTopics:    []string{"investment advice"},
+				ForbiddenPhrases: []string{"guaranteed return"},
+			},
+			text: "What is my balance?",
+
+			expectRule: guardrailviolation.RuleNone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &guardrailHandler{}
+
+			rule, detail := h.EvaluateInput(tt.config, tt.text)
+			if rule != tt.expectRule {
+				t.Errorf("Wrong match. expect: %s, got: %s", tt.expectRule, rule)
+			}
+			if detail != tt.expectDetail {
+				t.Errorf("Wrong match. expect: %s, got: %s", tt.expectDetail, detail)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package guardrailhandler
+
+//go:generate mockgen -package guardrailhandler -destination ./mock_main.go -source main.go -build_flags=-mod=mod
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"monorepo/bin-common-handler/pkg/notifyhandler"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+
+	"monorepo/bin-ai-manager/models/aicall"
+	"monorepo/bin-ai-manager/models/guardrail"
+	"monorepo/bin-ai-manager/models/guardrailviolation"
+	"monorepo/bin-ai-manager/pkg/dbhandler"
+)
+
+// GuardrailHandler checks the AI's output and the user's input against the
+// guardrail policy and records the violations.
+//
+// The escalation of a violation (transfer, stop_service) is up to the caller,
+// since it acts on the aicall.
+type GuardrailHandler interface {
+	EvaluateOutput(cfg *guardrail.Config, text string) (guardrailviolation.Rule, string)
+	EvaluateInput(cfg *guardrail.Config, text string) (guardrailviolation.Rule, string)
+	DisclaimerClaim(ctx context.Context, aicallID uuid.UUID) (bool, error)
+
+	ViolationCreate(
+		ctx context.Context,
+		c *aicall.AIcall,
+		aiID uuid.UUID,
+		rule guardrailviolation.Rule,
+		detail string,
+		content string,
+		action guardrail.EscalationAction,
+	) (*guardrailviolation.GuardrailViolation, error)
+	ViolationGet(ctx context.Context, id uuid.UUID) (*guardrailviolation.GuardrailViolation, error)
+	ViolationList(ctx context.Context, size uint64, token string, filters map[guardrailviolation.Field]any) ([]*guardrailviolation.GuardrailViolation, error)
+}
+
+type guardrailHandler struct {
+	utilHandler   utilhandler.UtilHandler
+	notifyHandler notifyhandler.NotifyHandler
+	db            dbhandler.DBHandler
+}
+
+var (
+	metricsNamespace = "ai_manager"
+
+	promGuardrailViolationTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "guardrail_violation_total",
+			Help:      "Total number of guardrail violations with rule and escalation action.",
+		},
+		[]string{"rule", "action"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(
+		promGuardrailViolationTotal,
+	)
+}
+
+// NewGuardrailHandler creates a new GuardrailHandler
+func NewGuardrailHandler(
+	notifyHandler notifyhandler.NotifyHandler,
+	db dbhandler.DBHandler,
+) GuardrailHandler {
+	return &guardrailHandler{
+		utilHandler:   utilhandler.NewUtilHandler(),
+		notifyHandler: notifyHandler,
+		db:            db,
+	}
+}
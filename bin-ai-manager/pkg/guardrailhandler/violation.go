@@ -0,0 +1,87 @@
+package guardrailhandler
+
+import (
+	"context"
+	stderrors "errors"
+
+	"monorepo/bin-ai-manager/models/aicall"
+	"monorepo/bin-ai-manager/models/guardrail"
+	"monorepo/bin-ai-manager/models/guardrailviolation"
+	"monorepo/bin-ai-manager/pkg/dbhandler"
+	cerrors "monorepo/bin-common-handler/models/errors"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	commonoutline "monorepo/bin-common-handler/models/outline"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+)
+
+// ViolationCreate records the guardrail violation of the aicall and publishes the created event.
+func (h *guardrailHandler) ViolationCreate(
+	ctx context.Context,
+	c *aicall.AIcall,
+	aiID uuid.UUID,
+	rule guardrailviolation.Rule,
+	detail string,
+	content string,
+	action guardrail.EscalationAction,
+) (*guardrailviolation.GuardrailViolation, error) {
+	id := h.utilHandler.UUIDCreate()
+
+	v := &guardrailviolation.GuardrailViolation{
+		Identity: commonidentity.Identity{
+			ID:         id,
+			CustomerID: c.CustomerID,
+		},
+
+		AIcallID:     c.ID,
+		AIID:         aiID,
+		ActiveflowID: c.ActiveflowID,
+
+		Rule:    rule,
+		Detail:  detail,
+		Content: content,
+
+		Action: action,
+	}
+
+	if errCreate := h.db.GuardrailViolationCreate(ctx, v); errCreate != nil {
+		return nil, errors.Wrapf(errCreate, "could not create guardrail violation")
+	}
+	promGuardrailViolationTotal.WithLabelValues(string(rule), string(action)).Inc()
+
+	res, err := h.db.GuardrailViolationGet(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get created data")
+	}
+
+	h.notifyHandler.PublishWebhookEvent(ctx, res.CustomerID, guardrailviolation.EventTypeCreated, res)
+	return res, nil
+}
+
+// ViolationGet returns the guardrail violation.
+func (h *guardrailHandler) ViolationGet(ctx context.Context, id uuid.UUID) (*guardrailviolation.GuardrailViolation, error) {
+	res, err := h.db.GuardrailViolationGet(ctx, id)
+	if err != nil {
+		if stderrors.Is(err, dbhandler.ErrNotFound) {
+			return nil, cerrors.NotFound(
+				commonoutline.ServiceNameAIManager,
+				"GUARDRAIL_VIOLATION_NOT_FOUND",
+				"The guardrail violation was not found.",
+			).Wrap(err)
+		}
+		return nil, errors.Wrapf(err, "could not get data")
+	}
+
+	return res, nil
+}
+
+// ViolationList returns the list of guardrail violations.
+func (h *guardrailHandler) ViolationList(ctx context.Context, size uint64, token string, filters map[guardrailviolation.Field]any) ([]*guardrailviolation.GuardrailViolation, error) {
+	res, err := h.db.GuardrailViolationList(ctx, size, token, filters)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get data")
+	}
+
+	return res, nil
+}
@@ -0,0 +1,126 @@
+package guardrailhandler
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"monorepo/bin-ai-manager/models/aicall"
+	"monorepo/bin-ai-manager/models/guardrail"
+	"monorepo/bin-ai-manager/models/guardrailviolation"
+	"monorepo/bin-ai-manager/pkg/dbhandler"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/notifyhandler"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func Test_ViolationCreate(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		aicall  *aicall.AIcall
+		aiID    uuid.UUID
+		rule    guardrailviolation.Rule
+		detail  string
+		content string
+		action  guardrail.EscalationAction
+
+		responseUUID      uuid.UUID
+		responseViolation *guardrailviolation.GuardrailViolation
+
+		expectViolation *guardrailviolation.GuardrailViolation
+	}{
+		{
+			name: "normal",
+
+			aicall: &aicall.AIcall{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("c01d5e6a-ad5d-11f0-a2b4-3e8f1c7d9a01"),
+					CustomerID: uuid.FromStringOrNil("c04e8b38-ad5d-11f0-9c3e-6d1a4f2b8e02"),
+				},
+				ActiveflowID: uuid.FromStringOrNil("c07fa0f6-ad5d-11f0-8d5f-2b9c7e1a4f03"),
+			},
+			aiID:    uuid.FromStringOrNil("c0b0c3d4-ad5d-11f0-b6a1-5f3d8e2c1b04"),
+			rule:    guardrailviolation.RuleForbiddenPhrase,
+			detail:  "guaranteed return",
+			content: "This fund has a guaranteed return.",
+			action:  guardrail.EscalationActionTransfer,
+
+			responseUUID: uuid.FromStringOrNil("c0e1e8b2-ad5d-11f0-9e7c-1c6a3d9f5e05"),
+			responseViolation: &guardrailviolation.GuardrailViolation{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("c0e1e8b2-ad5d-11f0-9e7c-1c6a3d9f5e05"),
+					CustomerID: uuid.FromStringOrNil("c04e8b38-ad5d-11f0-9c3e-6d1a4f2b8e02"),
+				},
+			},
+
+			expectViolation: &guardrailviolation.GuardrailViolation{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("c0e1e8b2-ad5d-11f0-9e7c-1c6a3d9f5e05"),
+					CustomerID: uuid.FromStringOrNil("c04e8b38-ad5d-11f0-9c3e-6d1a4f2b8e02"),
+				},
+				AIcallID:     uuid.FromStringOrNil("c01d5e6a-ad5d-11f0-a2b4-3e8f1c7d9a01"),
+				AIID:         uuid.FromStringOrNil("c0b0c3d4-ad5d-11f0-b6a1-5f3d8e2c1b04"),
+				ActiveflowID: uuid.FromStringOrNil("c07fa0f6-ad5d-11f0-8d5f-2b9c7e1a4f03"),
+				Rule:         guardrailviolation.RuleForbiddenPhrase,
+				Detail:       "guaranteed return",
+				Content:      "This fund has a guaranteed return.",
+				Action:       guardrail.EscalationActionTransfer,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+
+			h := &guardrailHandler{
+				utilHandler:   mockUtil,
+				notifyHandler: mockNotify,
+				db:            mockDB,
+			}
+			ctx := context.Background()
+
+			mockUtil.EXPECT().UUIDCreate().Return(tt.responseUUID)
+			mockDB.EXPECT().GuardrailViolationCreate(ctx, tt.expectViolation).Return(nil)
+			mockDB.EXPECT().GuardrailViolationGet(ctx, tt.responseUUID).Return(tt.responseViolation, nil)
+			mockNotify.EXPECT().PublishWebhookEvent(ctx, tt.responseViolation.CustomerID, guardrailviolation.EventTypeCreated, tt.responseViolation)
+
+			res, err := h.ViolationCreate(ctx, tt.aicall, tt.aiID, tt.rule, tt.detail, tt.content, tt.action)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.responseViolation) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.responseViolation, res)
+			}
+		})
+	}
+}
+
+func Test_ViolationGet_notFound(t *testing.T) {
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockDB := dbhandler.NewMockDBHandler(mc)
+	h := &guardrailHandler{
+		db: mockDB,
+	}
+	ctx := context.Background()
+
+	id := uuid.FromStringOrNil("c1130f70-ad5d-11f0-8b2d-4a7e1f9c3d06")
+	mockDB.EXPECT().GuardrailViolationGet(ctx, id).Return(nil, dbhandler.ErrNotFound)
+
+	if _, err := h.ViolationGet(ctx, id); err == nil {
+		t.Errorf("Wrong match. expect: error, got: ok")
+	}
+}
@@ -13,23 +13,30 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
 
+	"monorepo/bin-ai-manager/pkg/aiassisthandler"
 	"monorepo/bin-ai-manager/pkg/aicallhandler"
 	"monorepo/bin-ai-manager/pkg/aiaudithandler"
 	"monorepo/bin-ai-manager/pkg/aihandler"
 	"monorepo/bin-ai-manager/pkg/aiprompthistoryhandler"
 	"monorepo/bin-ai-manager/pkg/aipromptproposalhandler"
 	"monorepo/bin-ai-manager/pkg/analysishandler"
+	"monorepo/bin-ai-manager/pkg/customtoolhandler"
+	"monorepo/bin-ai-manager/pkg/extractionhandler"
+	"monorepo/bin-ai-manager/pkg/guardrailhandler"
+	"monorepo/bin-ai-manager/pkg/mcpserverhandler"
 	"monorepo/bin-ai-manager/pkg/dbhandler"
 	"monorepo/bin-ai-manager/pkg/messagehandler"
 	"monorepo/bin-ai-manager/pkg/participanthandler"
 	"monorepo/bin-ai-manager/pkg/summaryhandler"
 	"monorepo/bin-ai-manager/pkg/teamhandler"
+	"monorepo/bin-ai-manager/pkg/testsuitehandler"
 	"monorepo/bin-ai-manager/pkg/toolhandler"
 	cerrors "monorepo/bin-common-handler/models/errors"
 	"monorepo/bin-common-handler/models/outline"
 	"monorepo/bin-common-handler/models/sock"
 	"monorepo/bin-common-handler/pkg/sockhandler"
 	"monorepo/bin-common-handler/pkg/utilhandler"
+	"monorepo/bin-common-handler/pkg/tracehandler"
 )
 
 // pagination parameters
@@ -58,8 +65,14 @@ type listenHandler struct {
 	aipromptproposalHandler aipromptproposalhandler.AIPromptProposalHandler
 	messageHandler          messagehandler.MessageHandler
 	summaryHandler          summaryhandler.SummaryHandler
+	extractionHandler       extractionhandler.ExtractionHandler
+	aiassistHandler         aiassisthandler.AIAssistHandler
+	guardrailHandler        guardrailhandler.GuardrailHandler
 	toolHandler             toolhandler.ToolHandler
 	teamHandler             teamhandler.TeamHandler
+	customToolHandler       customtoolhandler.CustomToolHandler
+	mcpServerHandler        mcpserverhandler.MCPServerHandler
+	testSuiteHandler        testsuitehandler.TestSuiteHandler
 	participantHandler      participanthandler.ParticipantHandler
 	analysisHandler         analysishandler.AnalysisHandler
 }
@@ -82,12 +95,17 @@ var (
 	regV1AIsIDPromptHistoriesIDGet = regexp.MustCompile("/v1/ais/" + regUUID + "/prompt_histories/" + regUUID + "$")
 
 	// aicalls
-	regV1AIcallsGet                 = regexp.MustCompile(`/v1/aicalls\?`)
-	regV1AIcalls                    = regexp.MustCompile(`/v1/aicalls$`)
-	regV1AIcallsIDParticipants      = regexp.MustCompile("/v1/aicalls/" + regUUID + `/participants(\?|$)`)
-	regV1AIcallsID                  = regexp.MustCompile("/v1/aicalls/" + regUUID + "$")
-	regV1AIcallsIDTerminate         = regexp.MustCompile("/v1/aicalls/" + regUUID + "/terminate$")
-	regV1AIcallsIDToolExecute       = regexp.MustCompile("/v1/aicalls/" + regUUID + "/tool_execute$")
+	regV1AIcallsGet                   = regexp.MustCompile(`/v1/aicalls\?`)
+	regV1AIcalls                      = regexp.MustCompile(`/v1/aicalls$`)
+	regV1AIcallsIDParticipants        = regexp.MustCompile("/v1/aicalls/" + regUUID + `/participants(\?|$)`)
+	regV1AIcallsID                    = regexp.MustCompile("/v1/aicalls/" + regUUID + "$")
+	regV1AIcallsIDTerminate           = regexp.MustCompile("/v1/aicalls/" + regUUID + "/terminate$")
+	regV1AIcallsIDToolExecute         = regexp.MustCompile("/v1/aicalls/" + regUUID + "/tool_execute$")
+	regV1AIcallsIDRedact              = regexp.MustCompile("/v1/aicalls/" + regUUID + "/redact$")
+	regV1AIcallsIDGuardrailCheck      = regexp.MustCompile("/v1/aicalls/" + regUUID + "/guardrail_check$")
+	regV1AIcallsIDGuardrailInputCheck = regexp.MustCompile("/v1/aicalls/" + regUUID + "/guardrail_input_check$")
+	regV1AIcallsIDResponseCacheLookup = regexp.MustCompile("/v1/aicalls/" + regUUID + "/response_cache_lookup$")
+	regV1AIcallsIDResponseCacheStore  = regexp.MustCompile("/v1/aicalls/" + regUUID + "/response_cache_store$")
 
 	// aiaudits
 	regV1AIAuditsGet = regexp.MustCompile(`/v1/aiaudits\?`)
@@ -110,6 +128,7 @@ var (
 	// service
 	regV1ServicesTypeAIcall  = regexp.MustCompile("/v1/services/type/aicall$")
 	regV1ServicesTypeSummary = regexp.MustCompile("/v1/services/type/summary$")
+	regV1ServicesTypeExtraction = regexp.MustCompile("/v1/services/type/extraction$")
 	regV1ServicesTypeTask    = regexp.MustCompile("/v1/services/type/task$")
 	regV1ServicesTypeAnalysis = regexp.MustCompile("/v1/services/type/analysis$")
 
@@ -118,6 +137,21 @@ var (
 	regV1Summaries    = regexp.MustCompile("/v1/summaries$")
 	regV1SummariesID  = regexp.MustCompile("/v1/summaries/" + regUUID + "$")
 
+	// extraction
+	regV1ExtractionsGet = regexp.MustCompile(`/v1/extractions\?`)
+	regV1Extractions    = regexp.MustCompile("/v1/extractions$")
+	regV1ExtractionsID  = regexp.MustCompile("/v1/extractions/" + regUUID + "$")
+
+	// aiassist
+	regV1AIAssistsGet    = regexp.MustCompile(`/v1/aiassists\?`)
+	regV1AIAssists       = regexp.MustCompile("/v1/aiassists$")
+	regV1AIAssistsID     = regexp.MustCompile("/v1/aiassists/" + regUUID + "$")
+	regV1AIAssistsIDStop = regexp.MustCompile("/v1/aiassists/" + regUUID + "/stop$")
+
+	// guardrail violations
+	regV1GuardrailViolationsGet = regexp.MustCompile(`/v1/guardrail_violations\?`)
+	regV1GuardrailViolationsID  = regexp.MustCompile("/v1/guardrail_violations/" + regUUID + "$")
+
 	// tools
 	regV1Tools = regexp.MustCompile("/v1/tools$")
 
@@ -126,6 +160,27 @@ var (
 	regV1Teams                       = regexp.MustCompile("/v1/teams$")
 	regV1TeamsIDDirectHashRegenerate = regexp.MustCompile("/v1/teams/" + regUUID + "/direct-hash-regenerate$")
 	regV1TeamsID                     = regexp.MustCompile("/v1/teams/" + regUUID + "$")
+
+	// custom tools
+	regV1CustomToolsGet = regexp.MustCompile(`/v1/custom_tools\?`)
+	regV1CustomTools    = regexp.MustCompile("/v1/custom_tools$")
+	regV1CustomToolsID  = regexp.MustCompile("/v1/custom_tools/" + regUUID + "$")
+
+	// mcp servers
+	regV1MCPServersGet     = regexp.MustCompile(`/v1/mcp_servers\?`)
+	regV1MCPServers        = regexp.MustCompile("/v1/mcp_servers$")
+	regV1MCPServersID      = regexp.MustCompile("/v1/mcp_servers/" + regUUID + "$")
+	regV1MCPServersIDTools = regexp.MustCompile("/v1/mcp_servers/" + regUUID + "/tools$")
+
+	// test suites
+	regV1TestSuitesGet   = regexp.MustCompile(`/v1/test_suites\?`)
+	regV1TestSuites      = regexp.MustCompile("/v1/test_suites$")
+	regV1TestSuitesID    = regexp.MustCompile("/v1/test_suites/" + regUUID + "$")
+	regV1TestSuitesIDRun = regexp.MustCompile("/v1/test_suites/" + regUUID + "/run$")
+
+	// test runs
+	regV1TestRunsGet = regexp.MustCompile(`/v1/test_runs\?`)
+	regV1TestRunsID  = regexp.MustCompile("/v1/test_runs/" + regUUID + "$")
 )
 
 var (
@@ -198,8 +253,14 @@ func NewListenHandler(
 	aipromptproposalHandler aipromptproposalhandler.AIPromptProposalHandler,
 	messageHandler messagehandler.MessageHandler,
 	summaryHandler summaryhandler.SummaryHandler,
+	extractionHandler extractionhandler.ExtractionHandler,
+	aiassistHandler aiassisthandler.AIAssistHandler,
+	guardrailHandler guardrailhandler.GuardrailHandler,
 	toolHandler toolhandler.ToolHandler,
 	teamHandler teamhandler.TeamHandler,
+	customToolHandler customtoolhandler.CustomToolHandler,
+	mcpServerHandler mcpserverhandler.MCPServerHandler,
+	testSuiteHandler testsuitehandler.TestSuiteHandler,
 	participantHandler participanthandler.ParticipantHandler,
 	analysisHandler analysishandler.AnalysisHandler,
 ) ListenHandler {
@@ -217,8 +278,14 @@ func NewListenHandler(
 		aipromptproposalHandler: aipromptproposalHandler,
 		messageHandler:          messageHandler,
 		summaryHandler:          summaryHandler,
+		extractionHandler:       extractionHandler,
+		aiassistHandler:         aiassistHandler,
+		guardrailHandler:        guardrailHandler,
 		toolHandler:             toolHandler,
 		teamHandler:             teamHandler,
+		customToolHandler:       customToolHandler,
+		mcpServerHandler:        mcpServerHandler,
+		testSuiteHandler:        testSuiteHandler,
 		participantHandler:      participantHandler,
 		analysisHandler:         analysisHandler,
 	}
@@ -257,7 +324,8 @@ func (h *listenHandler) processRequest(m *sock.Request) (*sock.Response, error)
 	var err error
 	var response *sock.Response
 
-	ctx := context.Background()
+	ctx, span := tracehandler.StartServerSpan(context.Background(), m)
+	defer span.End()
 	start := time.Now()
 	switch {
 	/////////////////////////////////////////////////////////////////////////////////////////////////
@@ -355,6 +423,31 @@ func (h *listenHandler) processRequest(m *sock.Request) (*sock.Response, error)
 		response, err = h.processV1AIcallsIDToolExecutePost(ctx, m)
 		requestType = "/v1/aicalls/<aicall-id>/tool_execute"
 
+	// POST /aicalls/<aicall-id>/redact
+	case regV1AIcallsIDRedact.MatchString(m.URI) && m.Method == sock.RequestMethodPost:
+		response, err = h.processV1AIcallsIDRedactPost(ctx, m)
+		requestType = "/v1/aicalls/<aicall-id>/redact"
+
+	// POST /aicalls/<aicall-id>/guardrail_check
+	case regV1AIcallsIDGuardrailCheck.MatchString(m.URI) && m.Method == sock.RequestMethodPost:
+		response, err = h.processV1AIcallsIDGuardrailCheckPost(ctx, m)
+		requestType = "/v1/aicalls/<aicall-id>/guardrail_check"
+
+	// POST /aicalls/<aicall-id>/guardrail_input_check
+	case regV1AIcallsIDGuardrailInputCheck.MatchString(m.URI) && m.Method == sock.RequestMethodPost:
+		response, err = h.processV1AIcallsIDGuardrailInputCheckPost(ctx, m)
+		requestType = "/v1/aicalls/<aicall-id>/guardrail_input_check"
+
+	// POST /aicalls/<aicall-id>/response_cache_lookup
+	case regV1AIcallsIDResponseCacheLookup.MatchString(m.URI) && m.Method == sock.RequestMethodPost:
+		response, err = h.processV1AIcallsIDResponseCacheLookupPost(ctx, m)
+		requestType = "/v1/aicalls/<aicall-id>/response_cache_lookup"
+
+	// POST /aicalls/<aicall-id>/response_cache_store
+	case regV1AIcallsIDResponseCacheStore.MatchString(m.URI) && m.Method == sock.RequestMethodPost:
+		response, err = h.processV1AIcallsIDResponseCacheStorePost(ctx, m)
+		requestType = "/v1/aicalls/<aicall-id>/response_cache_store"
+
 	///////////////
 	// aiaudits
 	///////////////
@@ -447,6 +540,11 @@ func (h *listenHandler) processRequest(m *sock.Request) (*sock.Response, error)
 		response, err = h.processV1ServicesTypeSummaryPost(ctx, m)
 		requestType = "/v1/services/type/summary"
 
+	// POST /services/type/extraction
+	case regV1ServicesTypeExtraction.MatchString(m.URI) && m.Method == sock.RequestMethodPost:
+		response, err = h.processV1ServicesTypeExtractionPost(ctx, m)
+		requestType = "/v1/services/type/extraction"
+
 	// POST /services/type/task
 	case regV1ServicesTypeTask.MatchString(m.URI) && m.Method == sock.RequestMethodPost:
 		response, err = h.processV1ServicesTypeTaskPost(ctx, m)
@@ -480,6 +578,70 @@ func (h *listenHandler) processRequest(m *sock.Request) (*sock.Response, error)
 		response, err = h.processV1SummariesIDDelete(ctx, m)
 		requestType = "/v1/summaries/<summary-id>"
 
+	/////////////////
+	// extractions
+	/////////////////
+	// GET /extractions
+	case regV1ExtractionsGet.MatchString(m.URI) && m.Method == sock.RequestMethodGet:
+		response, err = h.processV1ExtractionsGet(ctx, m)
+		requestType = "/v1/extractions"
+
+	// POST /extractions
+	case regV1Extractions.MatchString(m.URI) && m.Method == sock.RequestMethodPost:
+		response, err = h.processV1ExtractionsPost(ctx, m)
+		requestType = "/v1/extractions"
+
+	// GET /extractions/<extraction-id>
+	case regV1ExtractionsID.MatchString(m.URI) && m.Method == sock.RequestMethodGet:
+		response, err = h.processV1ExtractionsIDGet(ctx, m)
+		requestType = "/v1/extractions/<extraction-id>"
+
+	// DELETE /extractions/<extraction-id>
+	case regV1ExtractionsID.MatchString(m.URI) && m.Method == sock.RequestMethodDelete:
+		response, err = h.processV1ExtractionsIDDelete(ctx, m)
+		requestType = "/v1/extractions/<extraction-id>"
+
+	/////////////////
+	// aiassists
+	/////////////////
+	// GET /aiassists
+	case regV1AIAssistsGet.MatchString(m.URI) && m.Method == sock.RequestMethodGet:
+		response, err = h.processV1AIAssistsGet(ctx, m)
+		requestType = "/v1/aiassists"
+
+	// POST /aiassists
+	case regV1AIAssists.MatchString(m.URI) && m.Method == sock.RequestMethodPost:
+		response, err = h.processV1AIAssistsPost(ctx, m)
+		requestType = "/v1/aiassists"
+
+	// GET /aiassists/<aiassist-id>
+	case regV1AIAssistsID.MatchString(m.URI) && m.Method == sock.RequestMethodGet:
+		response, err = h.processV1AIAssistsIDGet(ctx, m)
+		requestType = "/v1/aiassists/<aiassist-id>"
+
+	// DELETE /aiassists/<aiassist-id>
+	case regV1AIAssistsID.MatchString(m.URI) && m.Method == sock.RequestMethodDelete:
+		response, err = h.processV1AIAssistsIDDelete(ctx, m)
+		requestType = "/v1/aiassists/<aiassist-id>"
+
+	// POST /aiassists/<aiassist-id>/stop
+	case regV1AIAssistsIDStop.MatchString(m.URI) && m.Method == sock.RequestMethodPost:
+		response, err = h.processV1AIAssistsIDStopPost(ctx, m)
+		requestType = "/v1/aiassists/<aiassist-id>/stop"
+
+	/////////////////
+	// guardrail_violations
+	/////////////////
+	// GET /guardrail_violations
+	case regV1GuardrailViolationsGet.MatchString(m.URI) && m.Method == sock.RequestMethodGet:
+		response, err = h.processV1GuardrailViolationsGet(ctx, m)
+		requestType = "/v1/guardrail_violations"
+
+	// GET /guardrail_violations/<guardrail-violation-id>
+	case regV1GuardrailViolationsID.MatchString(m.URI) && m.Method == sock.RequestMethodGet:
+		response, err = h.processV1GuardrailViolationsIDGet(ctx, m)
+		requestType = "/v1/guardrail_violations/<guardrail-violation-id>"
+
 	/////////////////
 	// tools
 	/////////////////
@@ -521,6 +683,118 @@ func (h *listenHandler) processRequest(m *sock.Request) (*sock.Response, error)
 		response, err = h.processV1TeamsIDDelete(ctx, m)
 		requestType = "/v1/teams/<team-id>"
 
+	////////////////
+	// custom_tools
+	////////////////
+	// GET /custom_tools
+	case regV1CustomToolsGet.MatchString(m.URI) && m.Method == sock.RequestMethodGet:
+		response, err = h.processV1CustomToolsGet(ctx, m)
+		requestType = "/v1/custom_tools"
+
+	// POST /custom_tools
+	case regV1CustomTools.MatchString(m.URI) && m.Method == sock.RequestMethodPost:
+		response, err = h.processV1CustomToolsPost(ctx, m)
+		requestType = "/v1/custom_tools"
+
+	// GET /custom_tools/<custom-tool-id>
+	case regV1CustomToolsID.MatchString(m.URI) && m.Method == sock.RequestMethodGet:
+		response, err = h.processV1CustomToolsIDGet(ctx, m)
+		requestType = "/v1/custom_tools/<custom-tool-id>"
+
+	// PUT /custom_tools/<custom-tool-id>
+	case regV1CustomToolsID.MatchString(m.URI) && m.Method == sock.RequestMethodPut:
+		response, err = h.processV1CustomToolsIDPut(ctx, m)
+		requestType = "/v1/custom_tools/<custom-tool-id>"
+
+	// DELETE /custom_tools/<custom-tool-id>
+	case regV1CustomToolsID.MatchString(m.URI) && m.Method == sock.RequestMethodDelete:
+		response, err = h.processV1CustomToolsIDDelete(ctx, m)
+		requestType = "/v1/custom_tools/<custom-tool-id>"
+
+	////////////////
+	// mcp_servers
+	////////////////
+	// GET /mcp_servers
+	case regV1MCPServersGet.MatchString(m.URI) && m.Method == sock.RequestMethodGet:
+		response, err = h.processV1MCPServersGet(ctx, m)
+		requestType = "/v1/mcp_servers"
+
+	// POST /mcp_servers
+	case regV1MCPServers.MatchString(m.URI) && m.Method == sock.RequestMethodPost:
+		response, err = h.processV1MCPServersPost(ctx, m)
+		requestType = "/v1/mcp_servers"
+
+	// GET /mcp_servers/<mcp-server-id>
+	case regV1MCPServersID.MatchString(m.URI) && m.Method == sock.RequestMethodGet:
+		response, err = h.processV1MCPServersIDGet(ctx, m)
+		requestType = "/v1/mcp_servers/<mcp-server-id>"
+
+	// PUT /mcp_servers/<mcp-server-id>
+	case regV1MCPServersID.MatchString(m.URI) && m.Method == sock.RequestMethodPut:
+		response, err = h.processV1MCPServersIDPut(ctx, m)
+		requestType = "/v1/mcp_servers/<mcp-server-id>"
+
+	// DELETE /mcp_servers/<mcp-server-id>
+	case regV1MCPServersID.MatchString(m.URI) && m.Method == sock.RequestMethodDelete:
+		response, err = h.processV1MCPServersIDDelete(ctx, m)
+		requestType = "/v1/mcp_servers/<mcp-server-id>"
+
+	// GET /mcp_servers/<mcp-server-id>/tools
+	case regV1MCPServersIDTools.MatchString(m.URI) && m.Method == sock.RequestMethodGet:
+		response, err = h.processV1MCPServersIDToolsGet(ctx, m)
+		requestType = "/v1/mcp_servers/<mcp-server-id>/tools"
+
+	////////////////
+	// test_suites
+	////////////////
+	// GET /test_suites
+	case regV1TestSuitesGet.MatchString(m.URI) && m.Method == sock.RequestMethodGet:
+		response, err = h.processV1TestSuitesGet(ctx, m)
+		requestType = "/v1/test_suites"
+
+	// POST /test_suites
+	case regV1TestSuites.MatchString(m.URI) && m.Method == sock.RequestMethodPost:
+		response, err = h.processV1TestSuitesPost(ctx, m)
+		requestType = "/v1/test_suites"
+
+	// GET /test_suites/<test-suite-id>
+	case regV1TestSuitesID.MatchString(m.URI) && m.Method == sock.RequestMethodGet:
+		response, err = h.processV1TestSuitesIDGet(ctx, m)
+		requestType = "/v1/test_suites/<test-suite-id>"
+
+	// PUT /test_suites/<test-suite-id>
+	case regV1TestSuitesID.MatchString(m.URI) && m.Method == sock.RequestMethodPut:
+		response, err = h.processV1TestSuitesIDPut(ctx, m)
+		requestType = "/v1/test_suites/<test-suite-id>"
+
+	// DELETE /test_suites/<test-suite-id>
+	case regV1TestSuitesID.MatchString(m.URI) && m.Method == sock.RequestMethodDelete:
+		response, err = h.processV1TestSuitesIDDelete(ctx, m)
+		requestType = "/v1/test_suites/<test-suite-id>"
+
+	// POST /test_suites/<test-suite-id>/run
+	case regV1TestSuitesIDRun.MatchString(m.URI) && m.Method == sock.RequestMethodPost:
+		response, err = h.processV1TestSuitesIDRunPost(ctx, m)
+		requestType = "/v1/test_suites/<test-suite-id>/run"
+
+	////////////////
+	// test_runs
+	////////////////
+	// GET /test_runs
+	case regV1TestRunsGet.MatchString(m.URI) && m.Method == sock.RequestMethodGet:
+		response, err = h.processV1TestRunsGet(ctx, m)
+		requestType = "/v1/test_runs"
+
+	// GET /test_runs/<test-run-id>
+	case regV1TestRunsID.MatchString(m.URI) && m.Method == sock.RequestMethodGet:
+		response, err = h.processV1TestRunsIDGet(ctx, m)
+		requestType = "/v1/test_runs/<test-run-id>"
+
+	// DELETE /test_runs/<test-run-id>
+	case regV1TestRunsID.MatchString(m.URI) && m.Method == sock.RequestMethodDelete:
+		response, err = h.processV1TestRunsIDDelete(ctx, m)
+		requestType = "/v1/test_runs/<test-run-id>"
+
 	/////////////////////////////////////////////////////////////////////////////////////////////////
 	// No handler found
 	/////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,22 @@
+package request
+
+import (
+	"monorepo/bin-ai-manager/models/aiassist"
+
+	"github.com/gofrs/uuid"
+)
+
+// V1DataAIAssistsPost is
+// v1 data type request struct for
+// /v1/aiassists POST
+type V1DataAIAssistsPost struct {
+	CustomerID uuid.UUID `json:"customer_id,omitempty"`
+	AgentID    uuid.UUID `json:"agent_id,omitempty"`
+	AIID       uuid.UUID `json:"ai_id,omitempty"`
+
+	ReferenceType aiassist.ReferenceType `json:"reference_type,omitempty"`
+	ReferenceID   uuid.UUID              `json:"reference_id,omitempty"`
+
+	Language  string                   `json:"language,omitempty"`
+	Checklist []aiassist.ChecklistItem `json:"checklist,omitempty"`
+}
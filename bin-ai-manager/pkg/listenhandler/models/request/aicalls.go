@@ -37,3 +37,39 @@ type V1DataAIcallsIDToolExecutePost struct {
 	Type     message.ToolType     `json:"type,omitempty"`
 	Function message.FunctionCall `json:"function,omitempty"`
 }
+
+// V1DataAIcallsIDRedactPost is
+// v1 data type request struct for
+// /v1/aicalls/<aicall-id>/redact POST
+type V1DataAIcallsIDRedactPost struct {
+	Text string `json:"text"`
+}
+
+// V1DataAIcallsIDGuardrailCheckPost is
+// v1 data type request struct for
+// /v1/aicalls/<aicall-id>/guardrail_check POST
+type V1DataAIcallsIDGuardrailCheckPost struct {
+	Text string `json:"text"`
+}
+
+// V1DataAIcallsIDGuardrailInputCheckPost is
+// v1 data type request struct for
+// /v1/aicalls/<aicall-id>/guardrail_input_check POST
+type V1DataAIcallsIDGuardrailInputCheckPost struct {
+	Text string `json:"text"`
+}
+
+// V1DataAIcallsIDResponseCacheLookupPost is
+// v1 data type request struct for
+// /v1/aicalls/<aicall-id>/response_cache_lookup POST
+type V1DataAIcallsIDResponseCacheLookupPost struct {
+	UserTurns []string `json:"user_turns"`
+}
+
+// V1DataAIcallsIDResponseCacheStorePost is
+// v1 data type request struct for
+// /v1/aicalls/<aicall-id>/response_cache_store POST
+type V1DataAIcallsIDResponseCacheStorePost struct {
+	UserTurns []string `json:"user_turns"`
+	Response  string   `json:"response"`
+}
@@ -4,6 +4,9 @@ import (
 	"github.com/gofrs/uuid"
 
 	"monorepo/bin-ai-manager/models/ai"
+	"monorepo/bin-ai-manager/models/guardrail"
+	"monorepo/bin-ai-manager/models/redaction"
+	"monorepo/bin-ai-manager/models/responsecache"
 	"monorepo/bin-ai-manager/models/tool"
 )
 
@@ -21,6 +24,8 @@ type V1DataAIsPost struct {
 	EngineKey   string         `json:"engine_key,omitempty"`
 	RagID       uuid.UUID      `json:"rag_id,omitempty"`
 
+	EngineFallbacks []ai.EngineFallback `json:"engine_fallbacks,omitempty"`
+
 	InitPrompt string `json:"init_prompt,omitempty"`
 
 	TTSType    ai.TTSType `json:"tts_type,omitempty"`
@@ -35,6 +40,11 @@ type V1DataAIsPost struct {
 	SmartTurnEnabled bool          `json:"smart_turn_enabled,omitempty"`
 
 	AutoAICallAuditEnabled bool `json:"auto_aicall_audit_enabled,omitempty"`
+
+	Redaction *redaction.Config `json:"redaction,omitempty"`
+	Guardrail *guardrail.Config `json:"guardrail,omitempty"`
+
+	ResponseCache *responsecache.Config `json:"response_cache,omitempty"`
 }
 
 // V1DataAIsIDPut is
@@ -50,6 +60,8 @@ type V1DataAIsIDPut struct {
 	EngineKey   string         `json:"engine_key,omitempty"`
 	RagID       uuid.UUID      `json:"rag_id,omitempty"`
 
+	EngineFallbacks []ai.EngineFallback `json:"engine_fallbacks,omitempty"`
+
 	InitPrompt string `json:"init_prompt,omitempty"`
 
 	TTSType    ai.TTSType `json:"tts_type,omitempty"`
@@ -64,4 +76,9 @@ type V1DataAIsIDPut struct {
 	SmartTurnEnabled bool          `json:"smart_turn_enabled,omitempty"`
 
 	AutoAICallAuditEnabled bool `json:"auto_aicall_audit_enabled,omitempty"`
+
+	Redaction *redaction.Config `json:"redaction,omitempty"`
+	Guardrail *guardrail.Config `json:"guardrail,omitempty"`
+
+	ResponseCache *responsecache.Config `json:"response_cache,omitempty"`
 }
@@ -0,0 +1,34 @@
+package request
+
+import (
+	"github.com/gofrs/uuid"
+)
+
+// V1DataCustomToolsPost is
+// v1 data type request struct for
+// /v1/custom_tools POST
+type V1DataCustomToolsPost struct {
+	CustomerID       uuid.UUID         `json:"customer_id,omitempty"`
+	Name             string            `json:"name,omitempty"`
+	Detail           string            `json:"detail,omitempty"`
+	Description      string            `json:"description,omitempty"`
+	Parameters       map[string]any    `json:"parameters,omitempty"`
+	URL              string            `json:"url,omitempty"`
+	Headers          map[string]string `json:"headers,omitempty"`
+	Timeout          int               `json:"timeout,omitempty"`
+	ResponseTemplate string            `json:"response_template,omitempty"`
+}
+
+// V1DataCustomToolsIDPut is
+// v1 data type request struct for
+// /v1/custom_tools/<custom-tool-id> PUT
+type V1DataCustomToolsIDPut struct {
+	Name             string            `json:"name,omitempty"`
+	Detail           string            `json:"detail,omitempty"`
+	Description      string            `json:"description,omitempty"`
+	Parameters       map[string]any    `json:"parameters,omitempty"`
+	URL              string            `json:"url,omitempty"`
+	Headers          map[string]string `json:"headers,omitempty"`
+	Timeout          int               `json:"timeout,omitempty"`
+	ResponseTemplate string            `json:"response_template,omitempty"`
+}
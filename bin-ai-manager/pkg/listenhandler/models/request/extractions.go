@@ -0,0 +1,25 @@
+package request
+
+import (
+	"monorepo/bin-ai-manager/models/extraction"
+
+	"github.com/gofrs/uuid"
+)
+
+// V1DataExtractionsPost is
+// v1 data type request struct for
+// /v1/extractions POST
+type V1DataExtractionsPost struct {
+	CustomerID uuid.UUID `json:"customer_id,omitempty"`
+
+	ActiveflowID uuid.UUID `json:"activeflow_id,omitempty"`
+	OnEndFlowID  uuid.UUID `json:"on_end_flow_id,omitempty"`
+
+	ReferenceType extraction.ReferenceType `json:"reference_type,omitempty"`
+	ReferenceID   uuid.UUID                `json:"reference_id,omitempty"`
+
+	Language string `json:"language,omitempty"`
+
+	Schema       map[string]any `json:"schema,omitempty"`
+	SetVariables bool           `json:"set_variables,omitempty"`
+}
@@ -0,0 +1,34 @@
+package request
+
+import (
+	"github.com/gofrs/uuid"
+
+	"monorepo/bin-ai-manager/models/mcpserver"
+)
+
+// V1DataMCPServersPost is
+// v1 data type request struct for
+// /v1/mcp_servers POST
+type V1DataMCPServersPost struct {
+	CustomerID   uuid.UUID           `json:"customer_id,omitempty"`
+	Name         string              `json:"name,omitempty"`
+	Detail       string              `json:"detail,omitempty"`
+	Transport    mcpserver.Transport `json:"transport,omitempty"`
+	URL          string              `json:"url,omitempty"`
+	Headers      map[string]string   `json:"headers,omitempty"`
+	Timeout      int                 `json:"timeout,omitempty"`
+	AllowedTools []string            `json:"allowed_tools,omitempty"`
+}
+
+// V1DataMCPServersIDPut is
+// v1 data type request struct for
+// /v1/mcp_servers/<mcp-server-id> PUT
+type V1DataMCPServersIDPut struct {
+	Name         string              `json:"name,omitempty"`
+	Detail       string              `json:"detail,omitempty"`
+	Transport    mcpserver.Transport `json:"transport,omitempty"`
+	URL          string              `json:"url,omitempty"`
+	Headers      map[string]string   `json:"headers,omitempty"`
+	Timeout      int                 `json:"timeout,omitempty"`
+	AllowedTools []string            `json:"allowed_tools,omitempty"`
+}
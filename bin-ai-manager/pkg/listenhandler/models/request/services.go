@@ -4,6 +4,7 @@ import (
 	"github.com/gofrs/uuid"
 
 	"monorepo/bin-ai-manager/models/aicall"
+	"monorepo/bin-ai-manager/models/extraction"
 	"monorepo/bin-ai-manager/models/summary"
 )
 
@@ -35,6 +36,24 @@ type V1DataServicesTypeSummaryPost struct {
 	Language string `json:"language,omitempty"`
 }
 
+// V1DataServicesTypeExtractionPost is
+// data type request struct for
+// /v1/services/type/extraction POST
+type V1DataServicesTypeExtractionPost struct {
+	CustomerID uuid.UUID `json:"customer_id,omitempty"`
+
+	ActiveflowID uuid.UUID `json:"activeflow_id,omitempty"`
+	OnEndFlowID  uuid.UUID `json:"on_end_flow_id,omitempty"`
+
+	ReferenceType extraction.ReferenceType `json:"reference_type,omitempty"`
+	ReferenceID   uuid.UUID                `json:"reference_id,omitempty"`
+
+	Language string `json:"language,omitempty"`
+
+	Schema       map[string]any `json:"schema,omitempty"`
+	SetVariables bool           `json:"set_variables,omitempty"`
+}
+
 // V1DataServicesTypeTaskPost is
 // data type request struct for
 // /v1/services/type/task POST
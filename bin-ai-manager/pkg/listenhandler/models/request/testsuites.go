@@ -0,0 +1,27 @@
+package request
+
+import (
+	"monorepo/bin-ai-manager/models/testsuite"
+
+	"github.com/gofrs/uuid"
+)
+
+// V1DataTestSuitesPost is
+// v1 data type request struct for
+// /v1/test_suites POST
+type V1DataTestSuitesPost struct {
+	CustomerID uuid.UUID            `json:"customer_id,omitempty"`
+	AIID       uuid.UUID            `json:"ai_id,omitempty"`
+	Name       string               `json:"name,omitempty"`
+	Detail     string               `json:"detail,omitempty"`
+	Scenarios  []testsuite.Scenario `json:"scenarios,omitempty"`
+}
+
+// V1DataTestSuitesIDPut is
+// v1 data type request struct for
+// /v1/test_suites/<test-suite-id> PUT
+type V1DataTestSuitesIDPut struct {
+	Name      string               `json:"name,omitempty"`
+	Detail    string               `json:"detail,omitempty"`
+	Scenarios []testsuite.Scenario `json:"scenarios,omitempty"`
+}
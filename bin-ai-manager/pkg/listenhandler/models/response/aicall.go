@@ -0,0 +1,26 @@
+package response
+
+// V1AIcallsIDRedactPost is the response for POST /v1/aicalls/<aicall-id>/redact
+type V1AIcallsIDRedactPost struct {
+	Text string `json:"text"`
+}
+
+// V1AIcallsIDGuardrailCheckPost is the response for POST /v1/aicalls/<aicall-id>/guardrail_check
+// Blocked is true if the output was blocked and Text is the escalation message.
+type V1AIcallsIDGuardrailCheckPost struct {
+	Text    string `json:"text"`
+	Blocked bool   `json:"blocked"`
+}
+
+// V1AIcallsIDGuardrailInputCheckPost is the response for POST /v1/aicalls/<aicall-id>/guardrail_input_check
+// Blocked is true if the user's input was blocked and Text is the escalation message.
+type V1AIcallsIDGuardrailInputCheckPost struct {
+	Text    string `json:"text"`
+	Blocked bool   `json:"blocked"`
+}
+
+// V1AIcallsIDResponseCacheLookupPost is the response for POST /v1/aicalls/<aicall-id>/response_cache_lookup
+// Text is empty on a cache miss.
+type V1AIcallsIDResponseCacheLookupPost struct {
+	Text string `json:"text"`
+}
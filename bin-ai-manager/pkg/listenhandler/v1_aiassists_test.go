@@ -0,0 +1,240 @@
+package listenhandler
+
+import (
+	"monorepo/bin-ai-manager/models/aiassist"
+	"monorepo/bin-ai-manager/pkg/aiassisthandler"
+	"monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/models/sock"
+	"monorepo/bin-common-handler/pkg/sockhandler"
+	"reflect"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_processV1AIAssistsGet(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		responseAIAssists []*aiassist.AIAssist
+
+		expectPageSize  uint64
+		expectPageToken string
+		expectFilters   map[aiassist.Field]any
+		expectRes       *sock.Response
+	}{
+		{
+			name: "normal",
+			request: &sock.Request{
+				URI:      "/v1/aiassists?page_size=10&page_token=2020-05-03T21:35:02.809Z",
+				Method:   sock.RequestMethodGet,
+				DataType: "application/json",
+				Data:     []byte(`{"customer_id":"8e0a4c12-b1fb-11f0-9d4e-2b6f1c8a3e01","owner_id":"8e35d8a6-b1fb-11f0-a1f7-5c2e9d3b1a02","deleted":false}`),
+			},
+
+			responseAIAssists: []*aiassist.AIAssist{
+				{
+					Identity: identity.Identity{
+						ID: uuid.FromStringOrNil("8e60f2c4-b1fb-11f0-8b28-1d7a4e6f2c03"),
+					},
+				},
+			},
+
+			expectPageSize:  10,
+			expectPageToken: "2020-05-03T21:35:02.809Z",
+			expectFilters: map[aiassist.Field]any{
+				aiassist.FieldDeleted:    false,
+				aiassist.FieldCustomerID: uuid.FromStringOrNil("8e0a4c12-b1fb-11f0-9d4e-2b6f1c8a3e01"),
+				aiassist.FieldOwnerID:    uuid.FromStringOrNil("8e35d8a6-b1fb-11f0-a1f7-5c2e9d3b1a02"),
+			},
+			expectRes: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`[{"id":"8e60f2c4-b1fb-11f0-8b28-1d7a4e6f2c03","customer_id":"00000000-0000-0000-0000-000000000000","owner_type":"","owner_id":"00000000-0000-0000-0000-000000000000","ai_id":"00000000-0000-0000-0000-000000000000","reference_id":"00000000-0000-0000-0000-000000000000","transcribe_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}]`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockAIAssist := aiassisthandler.NewMockAIAssistHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:     mockSock,
+				aiassistHandler: mockAIAssist,
+			}
+
+			mockAIAssist.EXPECT().List(gomock.Any(), tt.expectPageSize, tt.expectPageToken, tt.expectFilters).Return(tt.responseAIAssists, nil)
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.expectRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_processV1AIAssistsPost(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		responseAIAssist *aiassist.AIAssist
+
+		expectedCustomerID    uuid.UUID
+		expectedAgentID       uuid.UUID
+		expectedAIID          uuid.UUID
+		expectedReferenceType aiassist.ReferenceType
+		expectedReferenceID   uuid.UUID
+		expectedLanguage      string
+		expectedChecklist     []aiassist.ChecklistItem
+		expectedRes           *sock.Response
+	}{
+		{
+			name: "normal",
+			request: &sock.Request{
+				URI:      "/v1/aiassists",
+				Method:   sock.RequestMethodPost,
+				DataType: "application/json",
+				Data:     []byte(`{"customer_id": "8e8b7a9e-b1fb-11f0-9c39-3f1b5d7e2a04", "agent_id": "8eb5f0b8-b1fb-11f0-a64a-6e2c8f1d3b05", "ai_id": "8ee06cd2-b1fb-11f0-8f5b-2a4d7c9e1f06", "reference_type": "call", "reference_id": "8f0ae1ec-b1fb-11f0-b36c-5d1e3a8f2c07", "language": "en-US", "checklist": [{"name": "verify identity"}]}`),
+			},
+
+			responseAIAssist: &aiassist.AIAssist{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("8f355806-b1fb-11f0-9a7d-1c6f2e4b3d08"),
+				},
+			},
+
+			expectedCustomerID:    uuid.FromStringOrNil("8e8b7a9e-b1fb-11f0-9c39-3f1b5d7e2a04"),
+			expectedAgentID:       uuid.FromStringOrNil("8eb5f0b8-b1fb-11f0-a64a-6e2c8f1d3b05"),
+			expectedAIID:          uuid.FromStringOrNil("8ee06cd2-b1fb-11f0-8f5b-2a4d7c9e1f06"),
+			expectedReferenceType: aiassist.ReferenceTypeCall,
+			expectedReferenceID:   uuid.FromStringOrNil("8f0ae1ec-b1fb-11f0-b36c-5d1e3a8f2c07"),
+			expectedLanguage:      "en-US",
+			expectedChecklist: []aiassist.ChecklistItem{
+				{Name: "verify identity"},
+			},
+			expectedRes: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"8f355806-b1fb-11f0-9a7d-1c6f2e4b3d08","customer_id":"00000000-0000-0000-0000-000000000000","owner_type":"","owner_id":"00000000-0000-0000-0000-000000000000","ai_id":"00000000-0000-0000-0000-000000000000","reference_id":"00000000-0000-0000-0000-000000000000","transcribe_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockAIAssist := aiassisthandler.NewMockAIAssistHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:     mockSock,
+				aiassistHandler: mockAIAssist,
+			}
+
+			mockAIAssist.EXPECT().Start(gomock.Any(), tt.expectedCustomerID, tt.expectedAgentID, tt.expectedAIID, tt.expectedReferenceType, tt.expectedReferenceID, tt.expectedLanguage, tt.expectedChecklist).Return(tt.responseAIAssist, nil)
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.expectedRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectedRes, res)
+			}
+		})
+	}
+}
+
+func Test_processV1AIAssistsID(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		responseAIAssist *aiassist.AIAssist
+
+		expectedID  uuid.UUID
+		expectedRes *sock.Response
+	}{
+		{
+			name: "get",
+			request: &sock.Request{
+				URI:    "/v1/aiassists/8f5fd020-b1fb-11f0-8b8e-4e2a6c1f5d09",
+				Method: sock.RequestMethodGet,
+			},
+		},
+		{
+			name: "delete",
+			request: &sock.Request{
+				URI:    "/v1/aiassists/8f5fd020-b1fb-11f0-8b8e-4e2a6c1f5d09",
+				Method: sock.RequestMethodDelete,
+			},
+		},
+		{
+			name: "stop",
+			request: &sock.Request{
+				URI:    "/v1/aiassists/8f5fd020-b1fb-11f0-8b8e-4e2a6c1f5d09/stop",
+				Method: sock.RequestMethodPost,
+			},
+		},
+	}
+
+	id := uuid.FromStringOrNil("8f5fd020-b1fb-11f0-8b8e-4e2a6c1f5d09")
+	responseAIAssist := &aiassist.AIAssist{
+		Identity: identity.Identity{
+			ID: id,
+		},
+	}
+	expectRes := &sock.Response{
+		StatusCode: 200,
+		DataType:   "application/json",
+		Data:       []byte(`{"id":"8f5fd020-b1fb-11f0-8b8e-4e2a6c1f5d09","customer_id":"00000000-0000-0000-0000-000000000000","owner_type":"","owner_id":"00000000-0000-0000-0000-000000000000","ai_id":"00000000-0000-0000-0000-000000000000","reference_id":"00000000-0000-0000-0000-000000000000","transcribe_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`),
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockAIAssist := aiassisthandler.NewMockAIAssistHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:     mockSock,
+				aiassistHandler: mockAIAssist,
+			}
+
+			switch tt.name {
+			case "get":
+				mockAIAssist.EXPECT().Get(gomock.Any(), id).Return(responseAIAssist, nil)
+			case "delete":
+				mockAIAssist.EXPECT().Delete(gomock.Any(), id).Return(responseAIAssist, nil)
+			case "stop":
+				mockAIAssist.EXPECT().Stop(gomock.Any(), id).Return(responseAIAssist, nil)
+			}
+
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, expectRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", expectRes, res)
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"monorepo/bin-ai-manager/models/aicall"
 	"monorepo/bin-ai-manager/pkg/listenhandler/models/request"
+	"monorepo/bin-ai-manager/pkg/listenhandler/models/response"
 	"monorepo/bin-common-handler/models/sock"
 	"monorepo/bin-common-handler/pkg/utilhandler"
 
@@ -266,3 +267,200 @@ func (h *listenHandler) processV1AIcallsIDToolExecutePost(ctx context.Context, m
 
 	return res, nil
 }
+
+// processV1AIcallsIDRedactPost handles
+// POST /v1/aicalls/<aicall-id>/redact request
+func (h *listenHandler) processV1AIcallsIDRedactPost(ctx context.Context, m *sock.Request) (*sock.Response, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"handler": "processV1AIcallsIDRedactPost",
+		"request": m,
+	})
+
+	uriItems := strings.Split(m.URI, "/")
+	if len(uriItems) < 4 {
+		log.Errorf("Wrong uri item count. uri_items: %d", len(uriItems))
+		return simpleResponse(400), nil
+	}
+	id := uuid.FromStringOrNil(uriItems[3])
+
+	var req request.V1DataAIcallsIDRedactPost
+	if err := json.Unmarshal([]byte(m.Data), &req); err != nil {
+		log.Errorf("Could not unmarshal the requested data. err: %v", err)
+		return simpleResponse(400), nil
+	}
+
+	text, err := h.aicallHandler.Redact(ctx, id, req.Text)
+	if err != nil {
+		log.Errorf("Could not redact the text. err: %v", err)
+		return errorResponse(err), nil
+	}
+
+	data, err := json.Marshal(&response.V1AIcallsIDRedactPost{Text: text})
+	if err != nil {
+		log.Errorf("Could not marshal the response message. err: %v", err)
+		return simpleResponse(500), nil
+	}
+
+	res := &sock.Response{
+		StatusCode: 200,
+		DataType:   "application/json",
+		Data:       data,
+	}
+
+	return res, nil
+}
+
+// processV1AIcallsIDGuardrailCheckPost handles
+// POST /v1/aicalls/<aicall-id>/guardrail_check request
+func (h *listenHandler) processV1AIcallsIDGuardrailCheckPost(ctx context.Context, m *sock.Request) (*sock.Response, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"handler": "processV1AIcallsIDGuardrailCheckPost",
+		"request": m,
+	})
+
+	uriItems := strings.Split(m.URI, "/")
+	if len(uriItems) < 4 {
+		log.Errorf("Wrong uri item count. uri_items: %d", len(uriItems))
+		return simpleResponse(400), nil
+	}
+	id := uuid.FromStringOrNil(uriItems[3])
+
+	var req request.V1DataAIcallsIDGuardrailCheckPost
+	if err := json.Unmarshal([]byte(m.Data), &req); err != nil {
+		log.Errorf("Could not unmarshal the requested data. err: %v", err)
+		return simpleResponse(400), nil
+	}
+
+	text, blocked, err := h.aicallHandler.GuardrailCheck(ctx, id, req.Text)
+	if err != nil {
+		log.Errorf("Could not check the text. err: %v", err)
+		return errorResponse(err), nil
+	}
+
+	data, err := json.Marshal(&response.V1AIcallsIDGuardrailCheckPost{Text: text, Blocked: blocked})
+	if err != nil {
+		log.Errorf("Could not marshal the response message. err: %v", err)
+		return simpleResponse(500), nil
+	}
+
+	res := &sock.Response{
+		StatusCode: 200,
+		DataType:   "application/json",
+		Data:       data,
+	}
+
+	return res, nil
+}
+
+// processV1AIcallsIDGuardrailInputCheckPost handles
+// POST /v1/aicalls/<aicall-id>/guardrail_input_check request
+func (h *listenHandler) processV1AIcallsIDGuardrailInputCheckPost(ctx context.Context, m *sock.Request) (*sock.Response, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"handler": "processV1AIcallsIDGuardrailInputCheckPost",
+		"request": m,
+	})
+
+	uriItems := strings.Split(m.URI, "/")
+	if len(uriItems) < 4 {
+		log.Errorf("Wrong uri item count. uri_items: %d", len(uriItems))
+		return simpleResponse(400), nil
+	}
+	id := uuid.FromStringOrNil(uriItems[3])
+
+	var req request.V1DataAIcallsIDGuardrailInputCheckPost
+	if err := json.Unmarshal([]byte(m.Data), &req); err != nil {
+		log.Errorf("Could not unmarshal the requested data. err: %v", err)
+		return simpleResponse(400), nil
+	}
+
+	text, blocked, err := h.aicallHandler.GuardrailInputCheck(ctx, id, req.Text)
+	if err != nil {
+		log.Errorf("Could not check the text. err: %v", err)
+		return errorResponse(err), nil
+	}
+
+	data, err := json.Marshal(&response.V1AIcallsIDGuardrailInputCheckPost{Text: text, Blocked: blocked})
+	if err != nil {
+		log.Errorf("Could not marshal the response message. err: %v", err)
+		return simpleResponse(500), nil
+	}
+
+	res := &sock.Response{
+		StatusCode: 200,
+		DataType:   "application/json",
+		Data:       data,
+	}
+
+	return res, nil
+}
+
+// processV1AIcallsIDResponseCacheLookupPost handles
+// POST /v1/aicalls/<aicall-id>/response_cache_lookup request
+func (h *listenHandler) processV1AIcallsIDResponseCacheLookupPost(ctx context.Context, m *sock.Request) (*sock.Response, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"handler": "processV1AIcallsIDResponseCacheLookupPost",
+		"request": m,
+	})
+
+	uriItems := strings.Split(m.URI, "/")
+	if len(uriItems) < 4 {
+		log.Errorf("Wrong uri item count. uri_items: %d", len(uriItems))
+		return simpleResponse(400), nil
+	}
+	id := uuid.FromStringOrNil(uriItems[3])
+
+	var req request.V1DataAIcallsIDResponseCacheLookupPost
+	if err := json.Unmarshal([]byte(m.Data), &req); err != nil {
+		log.Errorf("Could not unmarshal the requested data. err: %v", err)
+		return simpleResponse(400), nil
+	}
+
+	text, err := h.aicallHandler.ResponseCacheLookup(ctx, id, req.UserTurns)
+	if err != nil {
+		log.Errorf("Could not look up the response cache. err: %v", err)
+		return errorResponse(err), nil
+	}
+
+	data, err := json.Marshal(&response.V1AIcallsIDResponseCacheLookupPost{Text: text})
+	if err != nil {
+		log.Errorf("Could not marshal the response message. err: %v", err)
+		return simpleResponse(500), nil
+	}
+
+	res := &sock.Response{
+		StatusCode: 200,
+		DataType:   "application/json",
+		Data:       data,
+	}
+
+	return res, nil
+}
+
+// processV1AIcallsIDResponseCacheStorePost handles
+// POST /v1/aicalls/<aicall-id>/response_cache_store request
+func (h *listenHandler) processV1AIcallsIDResponseCacheStorePost(ctx context.Context, m *sock.Request) (*sock.Response, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"handler": "processV1AIcallsIDResponseCacheStorePost",
+		"request": m,
+	})
+
+	uriItems := strings.Split(m.URI, "/")
+	if len(uriItems) < 4 {
+		log.Errorf("Wrong uri item count. uri_items: %d", len(uriItems))
+		return simpleResponse(400), nil
+	}
+	id := uuid.FromStringOrNil(uriItems[3])
+
+	var req request.V1DataAIcallsIDResponseCacheStorePost
+	if err := json.Unmarshal([]byte(m.Data), &req); err != nil {
+		log.Errorf("Could not unmarshal the requested data. err: %v", err)
+		return simpleResponse(400), nil
+	}
+
+	if err := h.aicallHandler.ResponseCacheStore(ctx, id, req.UserTurns, req.Response); err != nil {
+		log.Errorf("Could not store the response. err: %v", err)
+		return errorResponse(err), nil
+	}
+
+	return simpleResponse(200), nil
+}
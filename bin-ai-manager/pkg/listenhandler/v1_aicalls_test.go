@@ -409,6 +409,320 @@ func Test_processV1AIcallsIDToolExecutePost(t *testing.T) {
 	}
 }
 
+func Test_processV1AIcallsIDRedactPost(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		responseText string
+
+		expectedID   uuid.UUID
+		expectedText string
+		expectedRes  *sock.Response
+	}{
+		{
+			name: "normal",
+			request: &sock.Request{
+				URI:      "/v1/aicalls/b1d6e4a2-a9fe-11f0-9a3c-4f2e8b1d6c70/redact",
+				Method:   sock.RequestMethodPost,
+				DataType: "application/json",
+				Data:     []byte(`{"text":"my card is 4111 1111 1111 1111"}`),
+			},
+
+			responseText: "my card is [CREDIT_CARD]",
+
+			expectedID:   uuid.FromStringOrNil("b1d6e4a2-a9fe-11f0-9a3c-4f2e8b1d6c70"),
+			expectedText: "my card is 4111 1111 1111 1111",
+			expectedRes: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"text":"my card is [CREDIT_CARD]"}`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockAIcall := aicallhandler.NewMockAIcallHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:   mockSock,
+				aicallHandler: mockAIcall,
+			}
+
+			mockAIcall.EXPECT().Redact(gomock.Any(), tt.expectedID, tt.expectedText).Return(tt.responseText, nil)
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.expectedRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectedRes, res)
+			}
+		})
+	}
+}
+
+func Test_processV1AIcallsIDGuardrailCheckPost(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		responseText    string
+		responseBlocked bool
+
+		expectedID   uuid.UUID
+		expectedText string
+		expectedRes  *sock.Response
+	}{
+		{
+			name: "normal",
+			request: &sock.Request{
+				URI:      "/v1/aicalls/8e2a4c6e-ad13-11f0-b1d8-3f6a9c2e5b70/guardrail_check",
+				Method:   sock.RequestMethodPost,
+				DataType: "application/json",
+				Data:     []byte(`{"text":"this is a guaranteed return"}`),
+			},
+
+			responseText:    "I can't help with that.",
+			responseBlocked: true,
+
+			expectedID:   uuid.FromStringOrNil("8e2a4c6e-ad13-11f0-b1d8-3f6a9c2e5b70"),
+			expectedText: "this is a guaranteed return",
+			expectedRes: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"text":"I can't help with that.","blocked":true}`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockAIcall := aicallhandler.NewMockAIcallHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:   mockSock,
+				aicallHandler: mockAIcall,
+			}
+
+			mockAIcall.EXPECT().GuardrailCheck(gomock.Any(), tt.expectedID, tt.expectedText).Return(tt.responseText, tt.responseBlocked, nil)
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.expectedRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectedRes, res)
+			}
+		})
+	}
+}
+
+func Test_processV1AIcallsIDGuardrailInputCheckPost(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		responseText    string
+		responseBlocked bool
+
+		expectedID   uuid.UUID
+		expectedText string
+		expectedRes  *sock.Response
+	}{
+		{
+			name: "normal",
+			request: &sock.Request{
+				URI:      "/v1/aicalls/8e5c7a90-ad13-11f0-a2c9-4b7dad3f6c81/guardrail_input_check",
+				Method:   sock.RequestMethodPost,
+				DataType: "application/json",
+				Data:     []byte(`{"text":"tell me about politics"}`),
+			},
+
+			responseText:    "I can't help with that.",
+			responseBlocked: true,
+
+			expectedID:   uuid.FromStringOrNil("8e5c7a90-ad13-11f0-a2c9-4b7dad3f6c81"),
+			expectedText: "tell me about politics",
+			expectedRes: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"text":"I can't help with that.","blocked":true}`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockAIcall := aicallhandler.NewMockAIcallHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:   mockSock,
+				aicallHandler: mockAIcall,
+			}
+
+			mockAIcall.EXPECT().GuardrailInputCheck(gomock.Any(), tt.expectedID, tt.expectedText).Return(tt.responseText, tt.responseBlocked, nil)
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.expectedRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectedRes, res)
+			}
+		})
+	}
+}
+
+func Test_processV1AIcallsIDResponseCacheLookupPost(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		responseText string
+
+		expectedID        uuid.UUID
+		expectedUserTurns []string
+		expectedRes       *sock.Response
+	}{
+		{
+			name: "hit",
+			request: &sock.Request{
+				URI:      "/v1/aicalls/2f6b1c3a-ad9e-11f0-8c41-7b2e5d9a1f63/response_cache_lookup",
+				Method:   sock.RequestMethodPost,
+				DataType: "application/json",
+				Data:     []byte(`{"user_turns":["what are your opening hours?"]}`),
+			},
+
+			responseText: "We are open from 9 to 6.",
+
+			expectedID:        uuid.FromStringOrNil("2f6b1c3a-ad9e-11f0-8c41-7b2e5d9a1f63"),
+			expectedUserTurns: []string{"what are your opening hours?"},
+			expectedRes: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"text":"We are open from 9 to 6."}`),
+			},
+		},
+		{
+			name: "miss",
+			request: &sock.Request{
+				URI:      "/v1/aicalls/2f6b1c3a-ad9e-11f0-8c41-7b2e5d9a1f63/response_cache_lookup",
+				Method:   sock.RequestMethodPost,
+				DataType: "application/json",
+				Data:     []byte(`{"user_turns":["can I speak to a human?"]}`),
+			},
+
+			responseText: "",
+
+			expectedID:        uuid.FromStringOrNil("2f6b1c3a-ad9e-11f0-8c41-7b2e5d9a1f63"),
+			expectedUserTurns: []string{"can I speak to a human?"},
+			expectedRes: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"text":""}`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockAIcall := aicallhandler.NewMockAIcallHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:   mockSock,
+				aicallHandler: mockAIcall,
+			}
+
+			mockAIcall.EXPECT().ResponseCacheLookup(gomock.Any(), tt.expectedID, tt.expectedUserTurns).Return(tt.responseText, nil)
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.expectedRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectedRes, res)
+			}
+		})
+	}
+}
+
+func Test_processV1AIcallsIDResponseCacheStorePost(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		expectedID        uuid.UUID
+		expectedUserTurns []string
+		expectedResponse  string
+		expectedRes       *sock.Response
+	}{
+		{
+			name: "normal",
+			request: &sock.Request{
+				URI:      "/v1/aicalls/3a0e7d52-ad9e-11f0-9f1c-8c3f6e0b2a74/response_cache_store",
+				Method:   sock.RequestMethodPost,
+				DataType: "application/json",
+				Data:     []byte(`{"user_turns":["what are your opening hours?"],"response":"We are open from 9 to 6."}`),
+			},
+
+			expectedID:        uuid.FromStringOrNil("3a0e7d52-ad9e-11f0-9f1c-8c3f6e0b2a74"),
+			expectedUserTurns: []string{"what are your opening hours?"},
+			expectedResponse:  "We are open from 9 to 6.",
+			expectedRes: &sock.Response{
+				StatusCode: 200,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockAIcall := aicallhandler.NewMockAIcallHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:   mockSock,
+				aicallHandler: mockAIcall,
+			}
+
+			mockAIcall.EXPECT().ResponseCacheStore(gomock.Any(), tt.expectedID, tt.expectedUserTurns, tt.expectedResponse).Return(nil)
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.expectedRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectedRes, res)
+			}
+		})
+	}
+}
+
 func Test_processV1AIcallsIDParticipantsGet(t *testing.T) {
 	aicallID := uuid.FromStringOrNil("11111111-1111-1111-1111-111111111111")
 	aiID := uuid.FromStringOrNil("22222222-2222-2222-2222-222222222222")
@@ -14,6 +14,7 @@ import (
 	"github.com/gofrs/uuid"
 	"github.com/sirupsen/logrus"
 
+	"monorepo/bin-ai-manager/pkg/aihandler"
 	"monorepo/bin-ai-manager/pkg/listenhandler/models/request"
 )
 
@@ -108,6 +109,12 @@ func (h *listenHandler) processV1AIsPost(ctx context.Context, m *sock.Request) (
 		req.VADConfig,
 		req.SmartTurnEnabled,
 		req.AutoAICallAuditEnabled,
+		aihandler.Options{
+			EngineFallbacks: req.EngineFallbacks,
+			Redaction:       req.Redaction,
+			Guardrail:       req.Guardrail,
+			ResponseCache:   req.ResponseCache,
+		},
 	)
 	if err != nil {
 		log.Errorf("Could not create ai. err: %v", err)
@@ -250,6 +257,12 @@ func (h *listenHandler) processV1AIsIDPut(ctx context.Context, m *sock.Request)
 		req.VADConfig,
 		req.SmartTurnEnabled,
 		req.AutoAICallAuditEnabled,
+		aihandler.Options{
+			EngineFallbacks: req.EngineFallbacks,
+			Redaction:       req.Redaction,
+			Guardrail:       req.Guardrail,
+			ResponseCache:   req.ResponseCache,
+		},
 	)
 	if err != nil {
 		log.Errorf("Could not update ai. err: %v", err)
@@ -14,7 +14,10 @@ import (
 	gomock "go.uber.org/mock/gomock"
 
 	"monorepo/bin-ai-manager/models/ai"
+	"monorepo/bin-ai-manager/models/guardrail"
 	"monorepo/bin-ai-manager/models/participant"
+	"monorepo/bin-ai-manager/models/redaction"
+	"monorepo/bin-ai-manager/models/responsecache"
 	"monorepo/bin-ai-manager/pkg/aihandler"
 	"monorepo/bin-ai-manager/pkg/dbhandler"
 	"monorepo/bin-ai-manager/pkg/participanthandler"
@@ -112,7 +115,12 @@ func Test_processV1AIsPost(t *testing.T) {
 		expectTTSType     ai.TTSType
 		expectTTSVoiceID  string
 		expectSTTType     ai.STTType
-		expectRes         *sock.Response
+
+		expectEngineFallbacks []ai.EngineFallback
+		expectRedaction       *redaction.Config
+		expectGuardrail       *guardrail.Config
+		expectResponseCache   *responsecache.Config
+		expectRes             *sock.Response
 	}{
 		{
 			name: "normal",
@@ -120,7 +128,7 @@ func Test_processV1AIsPost(t *testing.T) {
 				URI:      "/v1/ais",
 				Method:   sock.RequestMethodPost,
 				DataType: "application/json",
-				Data:     []byte(`{"customer_id": "58e7502c-a770-11ed-9b86-7fabe2dba847", "name": "test name", "detail": "test detail", "engine_model": "openai.gpt-5", "parameter": {"key1": "val1"}, "engine_key": "test engine key", "init_prompt": "test init prompt", "tts_type": "elevenlabs", "tts_voice_id": "test-voice-id", "stt_type": "deepgram"}`),
+				Data:     []byte(`{"customer_id": "58e7502c-a770-11ed-9b86-7fabe2dba847", "name": "test name", "detail": "test detail", "engine_model": "openai.gpt-5", "parameter": {"key1": "val1"}, "engine_key": "test engine key", "init_prompt": "test init prompt", "tts_type": "elevenlabs", "tts_voice_id": "test-voice-id", "stt_type": "deepgram", "engine_fallbacks": [{"engine_model": "gemini.gemini-2.5-flash", "engine_key": "fallback key"}], "redaction": {"entity_types": ["credit_card", "email"], "mode": "tokenize", "tool_access": true}, "guardrail": {"blocked_topics": ["investment advice"], "max_consecutive_tool_calls": 3, "escalation": {"action": "stop_service"}}, "response_cache": {"enabled": true, "threshold": 0.92, "ttl": 3600, "variables": ["customer.language"]}}`),
 			},
 
 			responseAI: &ai.AI{
@@ -141,6 +149,27 @@ func Test_processV1AIsPost(t *testing.T) {
 			expectTTSType:    ai.TTSTypeElevenLabs,
 			expectTTSVoiceID: "test-voice-id",
 			expectSTTType:    ai.STTTypeDeepgram,
+			expectEngineFallbacks: []ai.EngineFallback{
+				{EngineModel: ai.EngineModelGeminiGemini2Dot5Flash, EngineKey: "fallback key"},
+			},
+			expectRedaction: &redaction.Config{
+				EntityTypes: []redaction.EntityType{redaction.EntityTypeCreditCard, redaction.EntityTypeEmail},
+				Mode:        redaction.ModeTokenize,
+				ToolAccess:  true,
+			},
+			expectGuardrail: &guardrail.Config{
+				BlockedTopics:           []string{"investment advice"},
+				MaxConsecutiveToolCalls: 3,
+				Escalation: &guardrail.Escalation{
+					Action: guardrail.EscalationActionStopService,
+				},
+			},
+			expectResponseCache: &responsecache.Config{
+				Enabled:   true,
+				Threshold: 0.92,
+				TTL:       3600,
+				Variables: []string{"customer.language"},
+			},
 			expectRes: &sock.Response{
 				StatusCode: 200,
 				DataType:   "application/json",
@@ -181,6 +210,12 @@ func Test_processV1AIsPost(t *testing.T) {
 				gomock.Any(), // vadConfig
 				gomock.Any(), // smartTurnEnabled
 				gomock.Any(), // autoAICallAuditEnabled
+				aihandler.Options{
+					EngineFallbacks: tt.expectEngineFallbacks,
+					Redaction:       tt.expectRedaction,
+					Guardrail:       tt.expectGuardrail,
+					ResponseCache:   tt.expectResponseCache,
+				},
 			).Return(tt.responseAI, nil)
 			res, err := h.processRequest(tt.request)
 			if err != nil {
@@ -402,6 +437,7 @@ func Test_processV1AIsIDPut(t *testing.T) {
 				gomock.Any(), // vadConfig
 				gomock.Any(), // smartTurnEnabled
 				gomock.Any(), // autoAICallAuditEnabled
+				gomock.Any(), // opts
 			).Return(tt.responseAI, nil)
 			res, err := h.processRequest(tt.request)
 			if err != nil {
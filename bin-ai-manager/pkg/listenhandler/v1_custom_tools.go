@@ -0,0 +1,256 @@
+package listenhandler
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+
+	"monorepo/bin-ai-manager/models/customtool"
+	"monorepo/bin-ai-manager/pkg/listenhandler/models/request"
+
+	"monorepo/bin-common-handler/models/sock"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+)
+
+// processV1CustomToolsGet handles GET /v1/custom_tools request
+func (h *listenHandler) processV1CustomToolsGet(ctx context.Context, m *sock.Request) (*sock.Response, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":    "processV1CustomToolsGet",
+		"request": m,
+	})
+
+	u, err := url.Parse(m.URI)
+	if err != nil {
+		log.Errorf("Could not parse the request uri. err: %v", err)
+		return simpleResponse(400), nil
+	}
+
+	// parse the pagination params
+	tmpSize, _ := strconv.Atoi(u.Query().Get(PageSize))
+	pageSize := uint64(tmpSize)
+	pageToken := u.Query().Get(PageToken)
+
+	// get filters from request body
+	tmpFilters, err := utilhandler.ParseFiltersFromRequestBody(m.Data)
+	if err != nil {
+		log.Errorf("Could not parse filters. err: %v", err)
+		return simpleResponse(400), nil
+	}
+
+	// convert to typed filters
+	typedFilters, err := utilhandler.ConvertFilters[customtool.FieldStruct, customtool.Field](customtool.FieldStruct{}, tmpFilters)
+	if err != nil {
+		log.Errorf("Could not convert filters. err: %v", err)
+		return simpleResponse(400), nil
+	}
+
+	log = log.WithFields(logrus.Fields{
+		"size":    pageSize,
+		"token":   pageToken,
+		"filters": typedFilters,
+	})
+
+	tmp, err := h.customToolHandler.List(ctx, pageSize, pageToken, typedFilters)
+	if err != nil {
+		log.Debugf("Could not get custom tools. err: %v", err)
+		return errorResponse(err), nil
+	}
+
+	data, err := json.Marshal(tmp)
+	if err != nil {
+		log.Debugf("Could not marshal the response message. message: %v, err: %v", tmp, err)
+		return simpleResponse(500), nil
+	}
+
+	res := &sock.Response{
+		StatusCode: 200,
+		DataType:   "application/json",
+		Data:       data,
+	}
+
+	return res, nil
+}
+
+// processV1CustomToolsPost handles POST /v1/custom_tools request
+func (h *listenHandler) processV1CustomToolsPost(ctx context.Context, m *sock.Request) (*sock.Response, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"handler": "processV1CustomToolsPost",
+		"request": m,
+	})
+
+	var req request.V1DataCustomToolsPost
+	if err := json.Unmarshal([]byte(m.Data), &req); err != nil {
+		log.Errorf("Could not unmarshal the requested data. err: %v", err)
+		return simpleResponse(400), nil
+	}
+
+	tmp, err := h.customToolHandler.Create(
+		ctx,
+		req.CustomerID,
+		req.Name,
+		req.Detail,
+		req.Description,
+		req.Parameters,
+		req.URL,
+		req.Headers,
+		req.Timeout,
+		req.ResponseTemplate,
+	)
+	if err != nil {
+		log.Errorf("Could not create custom tool. err: %v", err)
+		return errorResponse(err), nil
+	}
+
+	data, err := json.Marshal(tmp)
+	if err != nil {
+		log.Errorf("Could not marshal the response message. message: %v, err: %v", tmp, err)
+		return simpleResponse(500), nil
+	}
+
+	res := &sock.Response{
+		StatusCode: 200,
+		DataType:   "application/json",
+		Data:       data,
+	}
+
+	return res, nil
+}
+
+// processV1CustomToolsIDGet handles GET /v1/custom_tools/<custom-tool-id> request
+func (h *listenHandler) processV1CustomToolsIDGet(ctx context.Context, m *sock.Request) (*sock.Response, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"handler": "processV1CustomToolsIDGet",
+		"request": m,
+	})
+
+	uriItems := strings.Split(m.URI, "/")
+	if len(uriItems) < 4 {
+		log.Errorf("Wrong uri item count. uri_items: %d", len(uriItems))
+		return simpleResponse(400), nil
+	}
+	id := uuid.FromStringOrNil(uriItems[3])
+	if id == uuid.Nil {
+		log.Errorf("Invalid custom tool ID.")
+		return simpleResponse(400), nil
+	}
+
+	tmp, err := h.customToolHandler.Get(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get custom tool. err: %v", err)
+		return errorResponse(err), nil
+	}
+
+	data, err := json.Marshal(tmp)
+	if err != nil {
+		log.Errorf("Could not marshal the response message. message: %v, err: %v", tmp, err)
+		return simpleResponse(500), nil
+	}
+
+	res := &sock.Response{
+		StatusCode: 200,
+		DataType:   "application/json",
+		Data:       data,
+	}
+
+	return res, nil
+}
+
+// processV1CustomToolsIDPut handles PUT /v1/custom_tools/<custom-tool-id> request
+func (h *listenHandler) processV1CustomToolsIDPut(ctx context.Context, m *sock.Request) (*sock.Response, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"handler": "processV1CustomToolsIDPut",
+		"request": m,
+	})
+
+	var req request.V1DataCustomToolsIDPut
+	if err := json.Unmarshal([]byte(m.Data), &req); err != nil {
+		log.Errorf("Could not unmarshal the requested data. err: %v", err)
+		return simpleResponse(400), nil
+	}
+
+	uriItems := strings.Split(m.URI, "/")
+	if len(uriItems) < 4 {
+		log.Errorf("Wrong uri item count. uri_items: %d", len(uriItems))
+		return simpleResponse(400), nil
+	}
+	id := uuid.FromStringOrNil(uriItems[3])
+	if id == uuid.Nil {
+		log.Errorf("Invalid custom tool ID.")
+		return simpleResponse(400), nil
+	}
+
+	tmp, err := h.customToolHandler.Update(
+		ctx,
+		id,
+		req.Name,
+		req.Detail,
+		req.Description,
+		req.Parameters,
+		req.URL,
+		req.Headers,
+		req.Timeout,
+		req.ResponseTemplate,
+	)
+	if err != nil {
+		log.Errorf("Could not update custom tool. err: %v", err)
+		return errorResponse(err), nil
+	}
+
+	data, err := json.Marshal(tmp)
+	if err != nil {
+		log.Errorf("Could not marshal the response message. message: %v, err: %v", tmp, err)
+		return simpleResponse(500), nil
+	}
+
+	res := &sock.Response{
+		StatusCode: 200,
+		DataType:   "application/json",
+		Data:       data,
+	}
+
+	return res, nil
+}
+
+// processV1CustomToolsIDDelete handles DELETE /v1/custom_tools/<custom-tool-id> request
+func (h *listenHandler) processV1CustomToolsIDDelete(ctx context.Context, m *sock.Request) (*sock.Response, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"handler": "processV1CustomToolsIDDelete",
+		"request": m,
+	})
+
+	uriItems := strings.Split(m.URI, "/")
+	if len(uriItems) < 4 {
+		log.Errorf("Wrong uri item count. uri_items: %d", len(uriItems))
+		return simpleResponse(400), nil
+	}
+	id := uuid.FromStringOrNil(uriItems[3])
+	if id == uuid.Nil {
+		log.Errorf("Invalid custom tool ID.")
+		return simpleResponse(400), nil
+	}
+
+	tmp, err := h.customToolHandler.Delete(ctx, id)
+	if err != nil {
+		log.Errorf("Could not delete custom tool. err: %v", err)
+		return errorResponse(err), nil
+	}
+
+	data, err := json.Marshal(tmp)
+	if err != nil {
+		log.Errorf("Could not marshal the response message. message: %v, err: %v", tmp, err)
+		return simpleResponse(500), nil
+	}
+
+	res := &sock.Response{
+		StatusCode: 200,
+		DataType:   "application/json",
+		Data:       data,
+	}
+
+	return res, nil
+}
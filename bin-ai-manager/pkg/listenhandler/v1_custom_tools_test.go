@@ -0,0 +1,380 @@
+package listenhandler
+
+import (
+	reflect "reflect"
+	"testing"
+
+	"monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/models/sock"
+	"monorepo/bin-common-handler/pkg/sockhandler"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	"monorepo/bin-ai-manager/models/customtool"
+	"monorepo/bin-ai-manager/pkg/customtoolhandler"
+)
+
+func Test_processV1CustomToolsGet(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		responseCustomTools []*customtool.CustomTool
+
+		expectPageSize  uint64
+		expectPageToken string
+		expectFilters   map[customtool.Field]any
+		expectRes       *sock.Response
+	}{
+		{
+			name: "normal",
+			request: &sock.Request{
+				URI:      "/v1/custom_tools?page_size=10&page_token=2020-05-03T21:35:02.809Z",
+				Method:   sock.RequestMethodGet,
+				DataType: "application/json",
+				Data:     []byte(`{"customer_id":"0d2f4a6c-8d75-11f1-a8e2-4a6c8e0a2c11","deleted":false}`),
+			},
+
+			responseCustomTools: []*customtool.CustomTool{
+				{
+					Identity: identity.Identity{
+						ID: uuid.FromStringOrNil("0d5e6b8d-8d75-11f1-9c03-6c8e0a2c4e22"),
+					},
+					Name: "lookup_order",
+				},
+			},
+
+			expectPageSize:  10,
+			expectPageToken: "2020-05-03T21:35:02.809Z",
+			expectFilters: map[customtool.Field]any{
+				customtool.FieldCustomerID: uuid.FromStringOrNil("0d2f4a6c-8d75-11f1-a8e2-4a6c8e0a2c11"),
+				customtool.FieldDeleted:    false,
+			},
+
+			expectRes: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`[{"id":"0d5e6b8d-8d75-11f1-9c03-6c8e0a2c4e22","customer_id":"00000000-0000-0000-0000-000000000000","name":"lookup_order","tm_create":null,"tm_update":null,"tm_delete":null}]`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockCustomTool := customtoolhandler.NewMockCustomToolHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:       mockSock,
+				customToolHandler: mockCustomTool,
+			}
+
+			mockCustomTool.EXPECT().List(gomock.Any(), tt.expectPageSize, tt.expectPageToken, tt.expectFilters).Return(tt.responseCustomTools, nil)
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.expectRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_processV1CustomToolsPost(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		responseCustomTool *customtool.CustomTool
+
+		expectCustomerID       uuid.UUID
+		expectName             string
+		expectDetail           string
+		expectDescription      string
+		expectParameters       map[string]any
+		expectURL              string
+		expectHeaders          map[string]string
+		expectTimeout          int
+		expectResponseTemplate string
+		expectRes              *sock.Response
+	}{
+		{
+			name: "normal",
+			request: &sock.Request{
+				URI:      "/v1/custom_tools",
+				Method:   sock.RequestMethodPost,
+				DataType: "application/json",
+				Data:     []byte(`{"customer_id":"4b1d3f5b-8d75-11f1-b1f4-8e0a2c4e6a33","name":"lookup_order","detail":"order lookup","description":"Look up an order.","parameters":{"type":"object"},"url":"https://example.com/orders","headers":{"Authorization":"Bearer token"},"timeout":3000,"response_template":"{{.status}}"}`),
+			},
+
+			responseCustomTool: &customtool.CustomTool{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("4b4c5e7c-8d75-11f1-8d25-0a2c4e6a8c44"),
+				},
+			},
+
+			expectCustomerID:  uuid.FromStringOrNil("4b1d3f5b-8d75-11f1-b1f4-8e0a2c4e6a33"),
+			expectName:        "lookup_order",
+			expectDetail:      "order lookup",
+			expectDescription: "Look up an order.",
+			expectParameters: map[string]any{
+				"type": "object",
+			},
+			expectURL: "https://example.com/orders",
+			expectHeaders: map[string]string{
+				"Authorization": "Bearer token",
+			},
+			expectTimeout:          3000,
+			expectResponseTemplate: "{{.status}}",
+			expectRes: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"4b4c5e7c-8d75-11f1-8d25-0a2c4e6a8c44","customer_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockCustomTool := customtoolhandler.NewMockCustomToolHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:       mockSock,
+				customToolHandler: mockCustomTool,
+			}
+
+			mockCustomTool.EXPECT().Create(
+				gomock.Any(),
+				tt.expectCustomerID,
+				tt.expectName,
+				tt.expectDetail,
+				tt.expectDescription,
+				tt.expectParameters,
+				tt.expectURL,
+				tt.expectHeaders,
+				tt.expectTimeout,
+				tt.expectResponseTemplate,
+			).Return(tt.responseCustomTool, nil)
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.expectRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_processV1CustomToolsIDGet(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		responseCustomTool *customtool.CustomTool
+
+		expectID  uuid.UUID
+		expectRes *sock.Response
+	}{
+		{
+			name: "normal",
+			request: &sock.Request{
+				URI:    "/v1/custom_tools/7a2e4c6e-8d75-11f1-a136-2c4e6a8c0e55",
+				Method: sock.RequestMethodGet,
+			},
+
+			responseCustomTool: &customtool.CustomTool{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("7a2e4c6e-8d75-11f1-a136-2c4e6a8c0e55"),
+				},
+			},
+
+			expectID: uuid.FromStringOrNil("7a2e4c6e-8d75-11f1-a136-2c4e6a8c0e55"),
+			expectRes: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"7a2e4c6e-8d75-11f1-a136-2c4e6a8c0e55","customer_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockCustomTool := customtoolhandler.NewMockCustomToolHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:       mockSock,
+				customToolHandler: mockCustomTool,
+			}
+
+			mockCustomTool.EXPECT().Get(gomock.Any(), tt.expectID).Return(tt.responseCustomTool, nil)
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.expectRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_processV1CustomToolsIDPut(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		responseCustomTool *customtool.CustomTool
+
+		expectID               uuid.UUID
+		expectName             string
+		expectDescription      string
+		expectURL              string
+		expectTimeout          int
+		expectResponseTemplate string
+		expectRes              *sock.Response
+	}{
+		{
+			name: "normal",
+			request: &sock.Request{
+				URI:      "/v1/custom_tools/a9f3b5d7-8d75-11f1-9247-4e6a8c0e2a66",
+				Method:   sock.RequestMethodPut,
+				DataType: "application/json",
+				Data:     []byte(`{"name":"lookup_order","description":"Look up an order.","url":"https://example.com/v2/orders","timeout":1000,"response_template":"{{.status}}"}`),
+			},
+
+			responseCustomTool: &customtool.CustomTool{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("a9f3b5d7-8d75-11f1-9247-4e6a8c0e2a66"),
+				},
+			},
+
+			expectID:               uuid.FromStringOrNil("a9f3b5d7-8d75-11f1-9247-4e6a8c0e2a66"),
+			expectName:             "lookup_order",
+			expectDescription:      "Look up an order.",
+			expectURL:              "https://example.com/v2/orders",
+			expectTimeout:          1000,
+			expectResponseTemplate: "{{.status}}",
+			expectRes: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"a9f3b5d7-8d75-11f1-9247-4e6a8c0e2a66","customer_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockCustomTool := customtoolhandler.NewMockCustomToolHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:       mockSock,
+				customToolHandler: mockCustomTool,
+			}
+
+			mockCustomTool.EXPECT().Update(
+				gomock.Any(),
+				tt.expectID,
+				tt.expectName,
+				"",
+				tt.expectDescription,
+				map[string]any(nil),
+				tt.expectURL,
+				map[string]string(nil),
+				tt.expectTimeout,
+				tt.expectResponseTemplate,
+			).Return(tt.responseCustomTool, nil)
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.expectRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_processV1CustomToolsIDDelete(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		responseCustomTool *customtool.CustomTool
+
+		expectID  uuid.UUID
+		expectRes *sock.Response
+	}{
+		{
+			name: "normal",
+			request: &sock.Request{
+				URI:    "/v1/custom_tools/d8c1e3a5-8d75-11f1-b358-6a8c0e2a4c77",
+				Method: sock.RequestMethodDelete,
+			},
+
+			responseCustomTool: &customtool.CustomTool{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("d8c1e3a5-8d75-11f1-b358-6a8c0e2a4c77"),
+				},
+			},
+
+			expectID: uuid.FromStringOrNil("d8c1e3a5-8d75-11f1-b358-6a8c0e2a4c77"),
+			expectRes: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"d8c1e3a5-8d75-11f1-b358-6a8c0e2a4c77","customer_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockCustomTool := customtoolhandler.NewMockCustomToolHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:       mockSock,
+				customToolHandler: mockCustomTool,
+			}
+
+			mockCustomTool.EXPECT().Delete(gomock.Any(), tt.expectID).Return(tt.responseCustomTool, nil)
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.expectRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,285 @@
+package listenhandler
+
+import (
+	"monorepo/bin-ai-manager/models/extraction"
+	"monorepo/bin-ai-manager/pkg/extractionhandler"
+	"monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/models/sock"
+	"monorepo/bin-common-handler/pkg/sockhandler"
+	"reflect"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_processV1ExtractionsGet(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		responseExtractions []*extraction.Extraction
+
+		expectPageSize  uint64
+		expectPageToken string
+		expectFilters   map[extraction.Field]any
+		expectRes       *sock.Response
+	}{
+		{
+			name: "normal",
+			request: &sock.Request{
+				URI:    "/v1/extractions?page_size=10&page_token=2020-05-03T21:35:02.809Z&filter_customer_id=fa74c67c-0baa-11f0-9d9b-f79be2dd6ee6&filter_deleted=false",
+				Method: sock.RequestMethodGet,
+			},
+
+			responseExtractions: []*extraction.Extraction{
+				{
+					Identity: identity.Identity{
+						ID: uuid.FromStringOrNil("faa75204-0baa-11f0-8aaa-831a1ee94d5d"),
+					},
+				},
+				{
+					Identity: identity.Identity{
+						ID: uuid.FromStringOrNil("facc2566-0baa-11f0-b71b-1bbb47f50b3b"),
+					},
+				},
+			},
+
+			expectPageSize:  10,
+			expectPageToken: "2020-05-03T21:35:02.809Z",
+			expectFilters: map[extraction.Field]any{
+				extraction.FieldDeleted:    false,
+				extraction.FieldCustomerID: uuid.FromStringOrNil("fa74c67c-0baa-11f0-9d9b-f79be2dd6ee6"),
+			},
+			expectRes: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`[{"id":"faa75204-0baa-11f0-8aaa-831a1ee94d5d","customer_id":"00000000-0000-0000-0000-000000000000","activeflow_id":"00000000-0000-0000-0000-000000000000","on_end_flow_id":"00000000-0000-0000-0000-000000000000","reference_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null},{"id":"facc2566-0baa-11f0-b71b-1bbb47f50b3b","customer_id":"00000000-0000-0000-0000-000000000000","activeflow_id":"00000000-0000-0000-0000-000000000000","on_end_flow_id":"00000000-0000-0000-0000-000000000000","reference_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}]`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockExtraction := extractionhandler.NewMockExtractionHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:       mockSock,
+				extractionHandler: mockExtraction,
+			}
+
+			mockExtraction.EXPECT().List(gomock.Any(), tt.expectPageSize, tt.expectPageToken, gomock.Any()).Return(tt.responseExtractions, nil)
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.expectRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_processV1ExtractionsPost(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		responseExtraction *extraction.Extraction
+
+		expectedCustomerID    uuid.UUID
+		expectedActiveflowID  uuid.UUID
+		expectedOnEndFlowID   uuid.UUID
+		expectedReferenceType extraction.ReferenceType
+		expectedReferenceID   uuid.UUID
+		expectedLanguage      string
+		expectedSchema        map[string]any
+		expectedSetVariables  bool
+		expectedRes           *sock.Response
+	}{
+		{
+			name: "normal",
+			request: &sock.Request{
+				URI:      "/v1/extractions",
+				Method:   sock.RequestMethodPost,
+				DataType: "application/json",
+				Data:     []byte(`{"customer_id": "62eb1516-0bac-11f0-a9f1-dbac9c204aa7", "activeflow_id": "62817d0e-0bac-11f0-8772-1f86bc7d4822", "on_end_flow_id": "813df6be-0bde-11f0-98b7-1b88d5c94e92", "reference_type": "aicall", "reference_id": "62a5dea6-0bac-11f0-aed5-67b4506078c7", "language": "en-US", "schema": {"type": "object", "properties": {"intent": {"type": "string"}}}, "set_variables": true}`),
+			},
+
+			responseExtraction: &extraction.Extraction{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("62c6a992-0bac-11f0-8ae6-db52e5aaf23d"),
+				},
+			},
+
+			expectedCustomerID:    uuid.FromStringOrNil("62eb1516-0bac-11f0-a9f1-dbac9c204aa7"),
+			expectedActiveflowID:  uuid.FromStringOrNil("62817d0e-0bac-11f0-8772-1f86bc7d4822"),
+			expectedOnEndFlowID:   uuid.FromStringOrNil("813df6be-0bde-11f0-98b7-1b88d5c94e92"),
+			expectedReferenceType: extraction.ReferenceTypeAIcall,
+			expectedReferenceID:   uuid.FromStringOrNil("62a5dea6-0bac-11f0-aed5-67b4506078c7"),
+			expectedLanguage:      "en-US",
+			expectedSchema: map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"intent": map[string]any{"type": "string"},
+				},
+			},
+			expectedSetVariables: true,
+			expectedRes: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"62c6a992-0bac-11f0-8ae6-db52e5aaf23d","customer_id":"00000000-0000-0000-0000-000000000000","activeflow_id":"00000000-0000-0000-0000-000000000000","on_end_flow_id":"00000000-0000-0000-0000-000000000000","reference_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockExtraction := extractionhandler.NewMockExtractionHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:       mockSock,
+				extractionHandler: mockExtraction,
+			}
+
+			mockExtraction.EXPECT().Start(gomock.Any(), tt.expectedCustomerID, tt.expectedActiveflowID, tt.expectedOnEndFlowID, tt.expectedReferenceType, tt.expectedReferenceID, tt.expectedLanguage, tt.expectedSchema, tt.expectedSetVariables).Return(tt.responseExtraction, nil)
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.expectedRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectedRes, res)
+			}
+		})
+	}
+}
+
+func Test_processV1ExtractionsIDGet(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		responseExtraction *extraction.Extraction
+
+		expectedID  uuid.UUID
+		expectedRes *sock.Response
+	}{
+		{
+			name: "normal",
+			request: &sock.Request{
+				URI:    "/v1/extractions/4520a2ac-0bad-11f0-a428-679c2b6f1888",
+				Method: sock.RequestMethodGet,
+			},
+
+			responseExtraction: &extraction.Extraction{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("4520a2ac-0bad-11f0-a428-679c2b6f1888"),
+				},
+			},
+
+			expectedID: uuid.FromStringOrNil("4520a2ac-0bad-11f0-a428-679c2b6f1888"),
+			expectedRes: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"4520a2ac-0bad-11f0-a428-679c2b6f1888","customer_id":"00000000-0000-0000-0000-000000000000","activeflow_id":"00000000-0000-0000-0000-000000000000","on_end_flow_id":"00000000-0000-0000-0000-000000000000","reference_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockExtraction := extractionhandler.NewMockExtractionHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:       mockSock,
+				extractionHandler: mockExtraction,
+			}
+
+			mockExtraction.EXPECT().Get(gomock.Any(), tt.expectedID).Return(tt.responseExtraction, nil)
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.expectedRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectedRes, res)
+			}
+		})
+	}
+}
+
+func Test_processV1ExtractionsIDDelete(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		responseExtraction *extraction.Extraction
+
+		expectedID  uuid.UUID
+		expectedRes *sock.Response
+	}{
+		{
+			name: "normal",
+			request: &sock.Request{
+				URI:    "/v1/extractions/93f1f214-0bad-11f0-980a-bba7be7d0493",
+				Method: sock.RequestMethodDelete,
+			},
+
+			responseExtraction: &extraction.Extraction{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("93f1f214-0bad-11f0-980a-bba7be7d0493"),
+				},
+			},
+
+			expectedID: uuid.FromStringOrNil("93f1f214-0bad-11f0-980a-bba7be7d0493"),
+			expectedRes: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"93f1f214-0bad-11f0-980a-bba7be7d0493","customer_id":"00000000-0000-0000-0000-000000000000","activeflow_id":"00000000-0000-0000-0000-000000000000","on_end_flow_id":"00000000-0000-0000-0000-000000000000","reference_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockExtraction := extractionhandler.NewMockExtractionHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:       mockSock,
+				extractionHandler: mockExtraction,
+			}
+
+			mockExtraction.EXPECT().Delete(gomock.Any(), tt.expectedID).Return(tt.responseExtraction, nil)
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.expectedRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectedRes, res)
+			}
+		})
+	}
+}
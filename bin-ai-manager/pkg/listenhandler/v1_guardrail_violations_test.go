@@ -0,0 +1,146 @@
+package listenhandler
+
+import (
+	"monorepo/bin-ai-manager/models/guardrailviolation"
+	"monorepo/bin-ai-manager/pkg/guardrailhandler"
+	"monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/models/sock"
+	"monorepo/bin-common-handler/pkg/sockhandler"
+	"reflect"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_processV1GuardrailViolationsGet(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		responseViolations []*guardrailviolation.GuardrailViolation
+
+		expectPageSize  uint64
+		expectPageToken string
+		expectFilters   map[guardrailviolation.Field]any
+		expectRes       *sock.Response
+	}{
+		{
+			name: "normal",
+			request: &sock.Request{
+				URI:      "/v1/guardrail_violations?page_size=10&page_token=2020-05-03T21:35:02.809Z",
+				Method:   sock.RequestMethodGet,
+				DataType: "application/json",
+				Data:     []byte(`{"customer_id":"7d1e3a5c-ad13-11f0-8c2e-4b7f9a1d3e50","deleted":false}`),
+			},
+
+			responseViolations: []*guardrailviolation.GuardrailViolation{
+				{
+					Identity: identity.Identity{
+						ID: uuid.FromStringOrNil("7d503c6e-ad13-11f0-a5b7-2e8c4f1a6d61"),
+					},
+					Rule: guardrailviolation.RuleBlockedTopic,
+				},
+			},
+
+			expectPageSize:  10,
+			expectPageToken: "2020-05-03T21:35:02.809Z",
+			expectFilters: map[guardrailviolation.Field]any{
+				guardrailviolation.FieldCustomerID: uuid.FromStringOrNil("7d1e3a5c-ad13-11f0-8c2e-4b7f9a1d3e50"),
+				guardrailviolation.FieldDeleted:    false,
+			},
+			expectRes: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`[{"id":"7d503c6e-ad13-11f0-a5b7-2e8c4f1a6d61","customer_id":"00000000-0000-0000-0000-000000000000","aicall_id":"00000000-0000-0000-0000-000000000000","ai_id":"00000000-0000-0000-0000-000000000000","activeflow_id":"00000000-0000-0000-0000-000000000000","rule":"blocked_topic","tm_create":null,"tm_update":null,"tm_delete":null}]`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockGuardrail := guardrailhandler.NewMockGuardrailHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:      mockSock,
+				guardrailHandler: mockGuardrail,
+			}
+
+			mockGuardrail.EXPECT().ViolationList(gomock.Any(), tt.expectPageSize, tt.expectPageToken, tt.expectFilters).Return(tt.responseViolations, nil)
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.expectRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_processV1GuardrailViolationsIDGet(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		responseViolation *guardrailviolation.GuardrailViolation
+
+		expectID  uuid.UUID
+		expectRes *sock.Response
+	}{
+		{
+			name: "normal",
+			request: &sock.Request{
+				URI:    "/v1/guardrail_violations/7d82f080-ad13-11f0-9e6d-8a1c5f3b2e72",
+				Method: sock.RequestMethodGet,
+			},
+
+			responseViolation: &guardrailviolation.GuardrailViolation{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("7d82f080-ad13-11f0-9e6d-8a1c5f3b2e72"),
+				},
+				Rule:   guardrailviolation.RuleForbiddenPhrase,
+				Detail: "guaranteed return",
+			},
+
+			expectID: uuid.FromStringOrNil("7d82f080-ad13-11f0-9e6d-8a1c5f3b2e72"),
+			expectRes: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"7d82f080-ad13-11f0-9e6d-8a1c5f3b2e72","customer_id":"00000000-0000-0000-0000-000000000000","aicall_id":"00000000-0000-0000-0000-000000000000","ai_id":"00000000-0000-0000-0000-000000000000","activeflow_id":"00000000-0000-0000-0000-000000000000","rule":"forbidden_phrase","detail":"guaranteed return","tm_create":null,"tm_update":null,"tm_delete":null}`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockGuardrail := guardrailhandler.NewMockGuardrailHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:      mockSock,
+				guardrailHandler: mockGuardrail,
+			}
+
+			mockGuardrail.EXPECT().ViolationGet(gomock.Any(), tt.expectID).Return(tt.responseViolation, nil)
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.expectRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,305 @@
+package listenhandler
+
+import (
+	reflect "reflect"
+	"testing"
+
+	"monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/models/sock"
+	"monorepo/bin-common-handler/pkg/sockhandler"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	"monorepo/bin-ai-manager/models/mcpserver"
+	"monorepo/bin-ai-manager/models/tool"
+	"monorepo/bin-ai-manager/pkg/mcpserverhandler"
+)
+
+func Test_processV1MCPServersGet(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		responseMCPServers []*mcpserver.MCPServer
+
+		expectPageSize  uint64
+		expectPageToken string
+		expectFilters   map[mcpserver.Field]any
+		expectRes       *sock.Response
+	}{
+		{
+			name: "normal",
+			request: &sock.Request{
+				URI:      "/v1/mcp_servers?page_size=10&page_token=2020-05-03T21:35:02.809Z",
+				Method:   sock.RequestMethodGet,
+				DataType: "application/json",
+				Data:     []byte(`{"customer_id":"1c3e5a7c-8e41-11f1-a1b2-3c5e7a9c1e11","deleted":false}`),
+			},
+
+			responseMCPServers: []*mcpserver.MCPServer{
+				{
+					Identity: identity.Identity{
+						ID: uuid.FromStringOrNil("1c6f7b9d-8e41-11f1-9c3d-5e7a9c1e3a22"),
+					},
+					Name: "crm",
+				},
+			},
+
+			expectPageSize:  10,
+			expectPageToken: "2020-05-03T21:35:02.809Z",
+			expectFilters: map[mcpserver.Field]any{
+				mcpserver.FieldCustomerID: uuid.FromStringOrNil("1c3e5a7c-8e41-11f1-a1b2-3c5e7a9c1e11"),
+				mcpserver.FieldDeleted:    false,
+			},
+
+			expectRes: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`[{"id":"1c6f7b9d-8e41-11f1-9c3d-5e7a9c1e3a22","customer_id":"00000000-0000-0000-0000-000000000000","name":"crm","tm_create":null,"tm_update":null,"tm_delete":null}]`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockMCPServer := mcpserverhandler.NewMockMCPServerHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:      mockSock,
+				mcpServerHandler: mockMCPServer,
+			}
+
+			mockMCPServer.EXPECT().List(gomock.Any(), tt.expectPageSize, tt.expectPageToken, tt.expectFilters).Return(tt.responseMCPServers, nil)
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.expectRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_processV1MCPServersPost(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		responseMCPServer *mcpserver.MCPServer
+
+		expectCustomerID   uuid.UUID
+		expectName         string
+		expectDetail       string
+		expectTransport    mcpserver.Transport
+		expectURL          string
+		expectHeaders      map[string]string
+		expectTimeout      int
+		expectAllowedTools []string
+		expectRes          *sock.Response
+	}{
+		{
+			name: "normal",
+			request: &sock.Request{
+				URI:      "/v1/mcp_servers",
+				Method:   sock.RequestMethodPost,
+				DataType: "application/json",
+				Data:     []byte(`{"customer_id":"2d4f6b8d-8e41-11f1-b4e5-7a9c1e3a5c33","name":"crm","detail":"internal crm","transport":"streamable_http","url":"https://mcp.example.com/mcp","headers":{"Authorization":"Bearer token"},"timeout":5000,"allowed_tools":["lookup_order"]}`),
+			},
+
+			responseMCPServer: &mcpserver.MCPServer{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("2d7e7c9e-8e41-11f1-8f06-9c1e3a5c7e44"),
+				},
+			},
+
+			expectCustomerID: uuid.FromStringOrNil("2d4f6b8d-8e41-11f1-b4e5-7a9c1e3a5c33"),
+			expectName:       "crm",
+			expectDetail:     "internal crm",
+			expectTransport:  mcpserver.TransportStreamableHTTP,
+			expectURL:        "https://mcp.example.com/mcp",
+			expectHeaders: map[string]string{
+				"Authorization": "Bearer token",
+			},
+			expectTimeout:      5000,
+			expectAllowedTools: []string{"lookup_order"},
+			expectRes: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"2d7e7c9e-8e41-11f1-8f06-9c1e3a5c7e44","customer_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockMCPServer := mcpserverhandler.NewMockMCPServerHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:      mockSock,
+				mcpServerHandler: mockMCPServer,
+			}
+
+			mockMCPServer.EXPECT().Create(
+				gomock.Any(),
+				tt.expectCustomerID,
+				tt.expectName,
+				tt.expectDetail,
+				tt.expectTransport,
+				tt.expectURL,
+				tt.expectHeaders,
+				tt.expectTimeout,
+				tt.expectAllowedTools,
+			).Return(tt.responseMCPServer, nil)
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.expectRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_processV1MCPServersIDDelete(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		responseMCPServer *mcpserver.MCPServer
+
+		expectID  uuid.UUID
+		expectRes *sock.Response
+	}{
+		{
+			name: "normal",
+			request: &sock.Request{
+				URI:    "/v1/mcp_servers/3e5a7c9e-8e41-11f1-a217-1e3a5c7e9a55",
+				Method: sock.RequestMethodDelete,
+			},
+
+			responseMCPServer: &mcpserver.MCPServer{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("3e5a7c9e-8e41-11f1-a217-1e3a5c7e9a55"),
+				},
+			},
+
+			expectID: uuid.FromStringOrNil("3e5a7c9e-8e41-11f1-a217-1e3a5c7e9a55"),
+			expectRes: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"3e5a7c9e-8e41-11f1-a217-1e3a5c7e9a55","customer_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockMCPServer := mcpserverhandler.NewMockMCPServerHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:      mockSock,
+				mcpServerHandler: mockMCPServer,
+			}
+
+			mockMCPServer.EXPECT().Delete(gomock.Any(), tt.expectID).Return(tt.responseMCPServer, nil)
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.expectRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_processV1MCPServersIDToolsGet(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		responseMCPServer *mcpserver.MCPServer
+		responseTools     []tool.Tool
+
+		expectID  uuid.UUID
+		expectRes *sock.Response
+	}{
+		{
+			name: "normal",
+			request: &sock.Request{
+				URI:    "/v1/mcp_servers/4f6b8daf-8e41-11f1-9328-3a5c7e9a0b66/tools",
+				Method: sock.RequestMethodGet,
+			},
+
+			responseMCPServer: &mcpserver.MCPServer{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("4f6b8daf-8e41-11f1-9328-3a5c7e9a0b66"),
+				},
+				Name: "crm",
+			},
+			responseTools: []tool.Tool{
+				{
+					Name:        "crm__lookup_order",
+					Description: "Look up an order.",
+					Parameters:  map[string]any{"type": "object"},
+					RunLLM:      true,
+				},
+			},
+
+			expectID: uuid.FromStringOrNil("4f6b8daf-8e41-11f1-9328-3a5c7e9a0b66"),
+			expectRes: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`[{"name":"crm__lookup_order","description":"Look up an order.","parameters":{"type":"object"},"run_llm":true}]`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockMCPServer := mcpserverhandler.NewMockMCPServerHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:      mockSock,
+				mcpServerHandler: mockMCPServer,
+			}
+
+			mockMCPServer.EXPECT().Get(gomock.Any(), tt.expectID).Return(tt.responseMCPServer, nil)
+			mockMCPServer.EXPECT().ListTools(gomock.Any(), tt.responseMCPServer).Return(tt.responseTools, nil)
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.expectRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
@@ -88,6 +88,50 @@ func (h *listenHandler) processV1ServicesTypeSummaryPost(ctx context.Context, m
 	return res, nil
 }
 
+// processV1ServicesTypeExtractionPost handles POST /v1/services/type/extraction request
+func (h *listenHandler) processV1ServicesTypeExtractionPost(ctx context.Context, m *sock.Request) (*sock.Response, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"handler": "processV1ServicesTypeExtractionPost",
+		"request": m,
+	})
+
+	var req request.V1DataServicesTypeExtractionPost
+	if err := json.Unmarshal([]byte(m.Data), &req); err != nil {
+		log.Errorf("Could not unmarshal the requested data. err: %v", err)
+		return simpleResponse(400), nil
+	}
+
+	tmp, err := h.extractionHandler.ServiceStart(
+		ctx,
+		req.CustomerID,
+		req.ActiveflowID,
+		req.OnEndFlowID,
+		req.ReferenceType,
+		req.ReferenceID,
+		req.Language,
+		req.Schema,
+		req.SetVariables,
+	)
+	if err != nil {
+		log.Errorf("Could not start extraction service. err: %v", err)
+		return errorResponse(err), nil
+	}
+
+	data, err := json.Marshal(tmp)
+	if err != nil {
+		log.Errorf("Could not marshal the response message. message: %v, err: %v", tmp, err)
+		return simpleResponse(500), nil
+	}
+
+	res := &sock.Response{
+		StatusCode: 200,
+		DataType:   "application/json",
+		Data:       data,
+	}
+
+	return res, nil
+}
+
 // processV1ServicesTypeAnalysisPost handles POST /v1/services/type/analysis request.
 //
 // This is the generic internal-only LLM gateway: it takes a prompt + data + JSON
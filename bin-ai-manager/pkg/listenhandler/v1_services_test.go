@@ -13,8 +13,10 @@ import (
 	gomock "go.uber.org/mock/gomock"
 
 	"monorepo/bin-ai-manager/models/aicall"
+	"monorepo/bin-ai-manager/models/extraction"
 	"monorepo/bin-ai-manager/models/summary"
 	"monorepo/bin-ai-manager/pkg/aicallhandler"
+	"monorepo/bin-ai-manager/pkg/extractionhandler"
 	"monorepo/bin-ai-manager/pkg/summaryhandler"
 )
 
@@ -170,6 +172,98 @@ func Test_processV1ServicesTypeSummaryPost(t *testing.T) {
 	}
 }
 
+func Test_processV1ServicesTypeExtractionPost(t *testing.T) {
+
+	type test struct {
+		name string
+
+		request *sock.Request
+
+		responseService *commonservice.Service
+
+		expectedCustomerID    uuid.UUID
+		expectedActiveflowID  uuid.UUID
+		expectedOnEndFlowID   uuid.UUID
+		expectedReferenceType extraction.ReferenceType
+		expectedReferenceID   uuid.UUID
+		expectedLanguage      string
+		expectedSchema        map[string]any
+		expectedSetVariables  bool
+
+		expectRes *sock.Response
+	}
+
+	tests := []test{
+		{
+			name: "normal",
+			request: &sock.Request{
+				URI:      "/v1/services/type/extraction",
+				Method:   sock.RequestMethodPost,
+				DataType: "application/json",
+				Data:     []byte(`{"customer_id":"8e1a2b3c-ad48-11f0-b912-c31db4ee4c89","activeflow_id":"8e4c5d6e-ad48-11f0-8a14-cf95de241a6c","on_end_flow_id":"8e7e8f90-ad48-11f0-ad2a-c336f03a042c","reference_type":"call","reference_id":"8eb0c1d2-ad48-11f0-8a19-9b60da0bf67a","language":"en-US","schema":{"type":"object","properties":{"order_id":{"type":"string"}}}}`),
+			},
+
+			responseService: &commonservice.Service{
+				ID: uuid.FromStringOrNil("8ee2f304-ad48-11f0-b0e8-b308c2bf4c33"),
+			},
+
+			expectedCustomerID:    uuid.FromStringOrNil("8e1a2b3c-ad48-11f0-b912-c31db4ee4c89"),
+			expectedActiveflowID:  uuid.FromStringOrNil("8e4c5d6e-ad48-11f0-8a14-cf95de241a6c"),
+			expectedOnEndFlowID:   uuid.FromStringOrNil("8e7e8f90-ad48-11f0-ad2a-c336f03a042c"),
+			expectedReferenceType: extraction.ReferenceTypeCall,
+			expectedReferenceID:   uuid.FromStringOrNil("8eb0c1d2-ad48-11f0-8a19-9b60da0bf67a"),
+			expectedLanguage:      "en-US",
+			expectedSchema: map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"order_id": map[string]any{"type": "string"},
+				},
+			},
+
+			expectRes: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"8ee2f304-ad48-11f0-b0e8-b308c2bf4c33","type":"","push_actions":null}`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockExtraction := extractionhandler.NewMockExtractionHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:       mockSock,
+				extractionHandler: mockExtraction,
+			}
+
+			mockExtraction.EXPECT().ServiceStart(
+				gomock.Any(),
+				tt.expectedCustomerID,
+				tt.expectedActiveflowID,
+				tt.expectedOnEndFlowID,
+				tt.expectedReferenceType,
+				tt.expectedReferenceID,
+				tt.expectedLanguage,
+				tt.expectedSchema,
+				tt.expectedSetVariables,
+			).Return(tt.responseService, nil)
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.expectRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
 func Test_processV1ServicesTypeTaskPost(t *testing.T) {
 
 	type test struct {
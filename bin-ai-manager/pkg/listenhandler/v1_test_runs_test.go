@@ -0,0 +1,155 @@
+package listenhandler
+
+import (
+	reflect "reflect"
+	"testing"
+
+	"monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/models/sock"
+	"monorepo/bin-common-handler/pkg/sockhandler"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	"monorepo/bin-ai-manager/models/testrun"
+	"monorepo/bin-ai-manager/pkg/testsuitehandler"
+)
+
+func Test_processV1TestRunsGet(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		responseTestRuns []*testrun.TestRun
+
+		expectPageSize  uint64
+		expectPageToken string
+		expectFilters   map[testrun.Field]any
+		expectRes       *sock.Response
+	}{
+		{
+			name: "normal",
+			request: &sock.Request{
+				URI:      "/v1/test_runs?page_size=10&page_token=2020-05-03T21:35:02.809Z",
+				Method:   sock.RequestMethodGet,
+				DataType: "application/json",
+				Data:     []byte(`{"customer_id":"e1a3c5e7-ad66-11f0-9b18-5d7f9b1d3f11","test_suite_id":"e1d0f2a4-ad66-11f0-8c29-8a0c2e4a6c12","deleted":false}`),
+			},
+
+			responseTestRuns: []*testrun.TestRun{
+				{
+					Identity: identity.Identity{
+						ID: uuid.FromStringOrNil("e1fd3b6a-ad66-11f0-a83a-1b3d5f7b9d13"),
+					},
+					Status: testrun.StatusPassed,
+				},
+			},
+
+			expectPageSize:  10,
+			expectPageToken: "2020-05-03T21:35:02.809Z",
+			expectFilters: map[testrun.Field]any{
+				testrun.FieldCustomerID:  uuid.FromStringOrNil("e1a3c5e7-ad66-11f0-9b18-5d7f9b1d3f11"),
+				testrun.FieldTestSuiteID: uuid.FromStringOrNil("e1d0f2a4-ad66-11f0-8c29-8a0c2e4a6c12"),
+				testrun.FieldDeleted:     false,
+			},
+
+			expectRes: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`[{"id":"e1fd3b6a-ad66-11f0-a83a-1b3d5f7b9d13","customer_id":"00000000-0000-0000-0000-000000000000","test_suite_id":"00000000-0000-0000-0000-000000000000","ai_id":"00000000-0000-0000-0000-000000000000","prompt_history_id":"00000000-0000-0000-0000-000000000000","status":"passed","tm_create":null,"tm_update":null,"tm_delete":null}]`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockTestSuite := testsuitehandler.NewMockTestSuiteHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:      mockSock,
+				testSuiteHandler: mockTestSuite,
+			}
+
+			mockTestSuite.EXPECT().TestRunList(gomock.Any(), tt.expectPageSize, tt.expectPageToken, tt.expectFilters).Return(tt.responseTestRuns, nil)
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.expectRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_processV1TestRunsIDGet(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		responseTestRun *testrun.TestRun
+
+		expectID  uuid.UUID
+		expectRes *sock.Response
+	}{
+		{
+			name: "normal",
+			request: &sock.Request{
+				URI:    "/v1/test_runs/f3b5d7f9-ad66-11f0-9e4b-4e6a8c0e2a14",
+				Method: sock.RequestMethodGet,
+			},
+
+			responseTestRun: &testrun.TestRun{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("f3b5d7f9-ad66-11f0-9e4b-4e6a8c0e2a14"),
+				},
+				Status: testrun.StatusFailed,
+				Results: []testrun.Result{
+					{
+						Scenario: "refund",
+						Turns:    1,
+					},
+				},
+			},
+
+			expectID: uuid.FromStringOrNil("f3b5d7f9-ad66-11f0-9e4b-4e6a8c0e2a14"),
+			expectRes: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"f3b5d7f9-ad66-11f0-9e4b-4e6a8c0e2a14","customer_id":"00000000-0000-0000-0000-000000000000","test_suite_id":"00000000-0000-0000-0000-000000000000","ai_id":"00000000-0000-0000-0000-000000000000","prompt_history_id":"00000000-0000-0000-0000-000000000000","status":"failed","results":[{"scenario":"refund","aicall_id":"00000000-0000-0000-0000-000000000000","passed":false,"turns":1}],"tm_create":null,"tm_update":null,"tm_delete":null}`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockTestSuite := testsuitehandler.NewMockTestSuiteHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:      mockSock,
+				testSuiteHandler: mockTestSuite,
+			}
+
+			mockTestSuite.EXPECT().TestRunGet(gomock.Any(), tt.expectID).Return(tt.responseTestRun, nil)
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.expectRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,363 @@
+package listenhandler
+
+import (
+	reflect "reflect"
+	"testing"
+
+	"monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/models/sock"
+	"monorepo/bin-common-handler/pkg/sockhandler"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	"monorepo/bin-ai-manager/models/testrun"
+	"monorepo/bin-ai-manager/models/testsuite"
+	"monorepo/bin-ai-manager/pkg/testsuitehandler"
+)
+
+func Test_processV1TestSuitesGet(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		responseTestSuites []*testsuite.TestSuite
+
+		expectPageSize  uint64
+		expectPageToken string
+		expectFilters   map[testsuite.Field]any
+		expectRes       *sock.Response
+	}{
+		{
+			name: "normal",
+			request: &sock.Request{
+				URI:      "/v1/test_suites?page_size=10&page_token=2020-05-03T21:35:02.809Z",
+				Method:   sock.RequestMethodGet,
+				DataType: "application/json",
+				Data:     []byte(`{"customer_id":"1a3c5e7f-ad66-11f0-9b2d-4f6a8c0e2a01","ai_id":"1a6e8f02-ad66-11f0-8c3e-7b9d1f3a5c02","deleted":false}`),
+			},
+
+			responseTestSuites: []*testsuite.TestSuite{
+				{
+					Identity: identity.Identity{
+						ID: uuid.FromStringOrNil("1a9b2c46-ad66-11f0-a4f5-2c4e6a8c0e03"),
+					},
+					Name: "greeting",
+				},
+			},
+
+			expectPageSize:  10,
+			expectPageToken: "2020-05-03T21:35:02.809Z",
+			expectFilters: map[testsuite.Field]any{
+				testsuite.FieldCustomerID: uuid.FromStringOrNil("1a3c5e7f-ad66-11f0-9b2d-4f6a8c0e2a01"),
+				testsuite.FieldAIID:       uuid.FromStringOrNil("1a6e8f02-ad66-11f0-8c3e-7b9d1f3a5c02"),
+				testsuite.FieldDeleted:    false,
+			},
+
+			expectRes: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`[{"id":"1a9b2c46-ad66-11f0-a4f5-2c4e6a8c0e03","customer_id":"00000000-0000-0000-0000-000000000000","ai_id":"00000000-0000-0000-0000-000000000000","name":"greeting","tm_create":null,"tm_update":null,"tm_delete":null}]`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockTestSuite := testsuitehandler.NewMockTestSuiteHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:      mockSock,
+				testSuiteHandler: mockTestSuite,
+			}
+
+			mockTestSuite.EXPECT().List(gomock.Any(), tt.expectPageSize, tt.expectPageToken, tt.expectFilters).Return(tt.responseTestSuites, nil)
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.expectRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_processV1TestSuitesPost(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		responseTestSuite *testsuite.TestSuite
+
+		expectCustomerID uuid.UUID
+		expectAIID       uuid.UUID
+		expectName       string
+		expectDetail     string
+		expectScenarios  []testsuite.Scenario
+		expectRes        *sock.Response
+	}{
+		{
+			name: "normal",
+			request: &sock.Request{
+				URI:      "/v1/test_suites",
+				Method:   sock.RequestMethodPost,
+				DataType: "application/json",
+				Data:     []byte(`{"customer_id":"4c1e3a5b-ad66-11f0-b7d8-5e7a9c1e3a04","ai_id":"4c4b6d7e-ad66-11f0-8e09-8a0c2e4a6c05","name":"refund","detail":"refund policy","scenarios":[{"name":"asks for a refund","type":"scripted","turns":["I want my money back."],"assertions":[{"type":"must_not_mention","text":"refund approved"},{"type":"tool_called","tool_name":"connect_call","arguments":{"run_llm":true}}]}]}`),
+			},
+
+			responseTestSuite: &testsuite.TestSuite{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("4c77a0c2-ad66-11f0-9a1b-1b3d5f7b9d06"),
+				},
+			},
+
+			expectCustomerID: uuid.FromStringOrNil("4c1e3a5b-ad66-11f0-b7d8-5e7a9c1e3a04"),
+			expectAIID:       uuid.FromStringOrNil("4c4b6d7e-ad66-11f0-8e09-8a0c2e4a6c05"),
+			expectName:       "refund",
+			expectDetail:     "refund policy",
+			expectScenarios: []testsuite.Scenario{
+				{
+					Name:  "asks for a refund",
+					Type:  testsuite.ScenarioTypeScripted,
+					Turns: []string{"I want my money back."},
+					Assertions: []testsuite.Assertion{
+						{Type: testsuite.AssertionTypeMustNotMention, Text: "refund approved"},
+						{Type: testsuite.AssertionTypeToolCalled, ToolName: "connect_call", Arguments: map[string]any{"run_llm": true}},
+					},
+				},
+			},
+			expectRes: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"4c77a0c2-ad66-11f0-9a1b-1b3d5f7b9d06","customer_id":"00000000-0000-0000-0000-000000000000","ai_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockTestSuite := testsuitehandler.NewMockTestSuiteHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:      mockSock,
+				testSuiteHandler: mockTestSuite,
+			}
+
+			mockTestSuite.EXPECT().Create(gomock.Any(), tt.expectCustomerID, tt.expectAIID, tt.expectName, tt.expectDetail, tt.expectScenarios).Return(tt.responseTestSuite, nil)
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.expectRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_processV1TestSuitesIDPut(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		responseTestSuite *testsuite.TestSuite
+
+		expectID        uuid.UUID
+		expectName      string
+		expectDetail    string
+		expectScenarios []testsuite.Scenario
+		expectRes       *sock.Response
+	}{
+		{
+			name: "normal",
+			request: &sock.Request{
+				URI:      "/v1/test_suites/7e2a4c6e-ad66-11f0-8b2c-4d6f8b0d2f07",
+				Method:   sock.RequestMethodPut,
+				DataType: "application/json",
+				Data:     []byte(`{"name":"angry","scenarios":[{"name":"angry customer","type":"simulated","persona":"an angry customer","goal":"get a refund","max_turns":5}]}`),
+			},
+
+			responseTestSuite: &testsuite.TestSuite{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("7e2a4c6e-ad66-11f0-8b2c-4d6f8b0d2f07"),
+				},
+			},
+
+			expectID:   uuid.FromStringOrNil("7e2a4c6e-ad66-11f0-8b2c-4d6f8b0d2f07"),
+			expectName: "angry",
+			expectScenarios: []testsuite.Scenario{
+				{
+					Name:     "angry customer",
+					Type:     testsuite.ScenarioTypeSimulated,
+					Persona:  "an angry customer",
+					Goal:     "get a refund",
+					MaxTurns: 5,
+				},
+			},
+			expectRes: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"7e2a4c6e-ad66-11f0-8b2c-4d6f8b0d2f07","customer_id":"00000000-0000-0000-0000-000000000000","ai_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockTestSuite := testsuitehandler.NewMockTestSuiteHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:      mockSock,
+				testSuiteHandler: mockTestSuite,
+			}
+
+			mockTestSuite.EXPECT().Update(gomock.Any(), tt.expectID, tt.expectName, tt.expectDetail, tt.expectScenarios).Return(tt.responseTestSuite, nil)
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.expectRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_processV1TestSuitesIDRunPost(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		responseTestRun *testrun.TestRun
+
+		expectID  uuid.UUID
+		expectRes *sock.Response
+	}{
+		{
+			name: "normal",
+			request: &sock.Request{
+				URI:    "/v1/test_suites/a1c3e5f7-ad66-11f0-9d4e-6f8b0d2f4b08/run",
+				Method: sock.RequestMethodPost,
+			},
+
+			responseTestRun: &testrun.TestRun{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("a1f0b2d4-ad66-11f0-a5f6-9b1d3f5b7d09"),
+				},
+				TestSuiteID: uuid.FromStringOrNil("a1c3e5f7-ad66-11f0-9d4e-6f8b0d2f4b08"),
+				Status:      testrun.StatusProgressing,
+			},
+
+			expectID: uuid.FromStringOrNil("a1c3e5f7-ad66-11f0-9d4e-6f8b0d2f4b08"),
+			expectRes: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"a1f0b2d4-ad66-11f0-a5f6-9b1d3f5b7d09","customer_id":"00000000-0000-0000-0000-000000000000","test_suite_id":"a1c3e5f7-ad66-11f0-9d4e-6f8b0d2f4b08","ai_id":"00000000-0000-0000-0000-000000000000","prompt_history_id":"00000000-0000-0000-0000-000000000000","status":"progressing","tm_create":null,"tm_update":null,"tm_delete":null}`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockTestSuite := testsuitehandler.NewMockTestSuiteHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:      mockSock,
+				testSuiteHandler: mockTestSuite,
+			}
+
+			mockTestSuite.EXPECT().Run(gomock.Any(), tt.expectID).Return(tt.responseTestRun, nil)
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.expectRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_processV1TestSuitesIDDelete(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		responseTestSuite *testsuite.TestSuite
+
+		expectID  uuid.UUID
+		expectRes *sock.Response
+	}{
+		{
+			name: "normal",
+			request: &sock.Request{
+				URI:    "/v1/test_suites/c2e4a6c8-ad66-11f0-8f07-2c4e6a8c0e10",
+				Method: sock.RequestMethodDelete,
+			},
+
+			responseTestSuite: &testsuite.TestSuite{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("c2e4a6c8-ad66-11f0-8f07-2c4e6a8c0e10"),
+				},
+			},
+
+			expectID: uuid.FromStringOrNil("c2e4a6c8-ad66-11f0-8f07-2c4e6a8c0e10"),
+			expectRes: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"c2e4a6c8-ad66-11f0-8f07-2c4e6a8c0e10","customer_id":"00000000-0000-0000-0000-000000000000","ai_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockTestSuite := testsuitehandler.NewMockTestSuiteHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:      mockSock,
+				testSuiteHandler: mockTestSuite,
+			}
+
+			mockTestSuite.EXPECT().Delete(gomock.Any(), tt.expectID).Return(tt.responseTestSuite, nil)
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.expectRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
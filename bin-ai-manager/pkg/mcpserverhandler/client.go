@@ -0,0 +1,562 @@
+package mcpserverhandler
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"monorepo/bin-ai-manager/models/mcpserver"
+)
+
+// protocolVersion is the MCP revision requested in the initialize handshake.
+// Servers may answer with an older revision; the client only uses methods
+// that exist in every revision since 2024-11-05.
+const protocolVersion = "2025-03-26"
+
+const (
+	clientName    = "voipbin-ai-manager"
+	clientVersion = "1.0.0"
+)
+
+// list of MCP HTTP headers
+const (
+	headerSessionID       = "Mcp-Session-Id"
+	headerProtocolVersion = "MCP-Protocol-Version"
+)
+
+const (
+	maxMessageSize = 1024 * 1024 // bytes of a single JSON-RPC message or SSE event
+	maxListPages   = 10          // pages fetched from a paginated list method
+)
+
+// rpcRequest is a JSON-RPC 2.0 request, or a notification when ID is nil.
+type rpcRequest struct {
+	JSONRPC string `json:"jsonrpc"`
+	ID      *int64 `json:"id,omitempty"`
+	Method  string `json:"method"`
+	Params  any    `json:"params,omitempty"`
+}
+
+// rpcMessage is any JSON-RPC 2.0 message received from the server. Servers
+// may interleave their own requests and notifications with the response.
+type rpcMessage struct {
+	ID     json.RawMessage `json:"id,omitempty"`
+	Method string          `json:"method,omitempty"`
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  *rpcError       `json:"error,omitempty"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *rpcError) Error() string {
+	return fmt.Sprintf("mcp error %d: %s", e.Code, e.Message)
+}
+
+// isResponseTo reports whether the message is the response to the request with the given id.
+func (m *rpcMessage) isResponseTo(id int64) bool {
+	return m.Method == "" && string(bytes.TrimSpace(m.ID)) == strconv.FormatInt(id, 10)
+}
+
+// decodeResult unmarshals the response's result into result, or returns the
+// JSON-RPC error the server responded with.
+func (m *rpcMessage) decodeResult(result any) error {
+	if m.Error != nil {
+		return m.Error
+	}
+	if result == nil || len(m.Result) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(m.Result, result); err != nil {
+		return errors.Wrapf(err, "could not parse the result")
+	}
+
+	return nil
+}
+
+// session is an initialized connection to an MCP server.
+type session interface {
+	call(ctx context.Context, method string, params any, result any) error
+	notify(ctx context.Context, method string, params any) error
+	close(ctx context.Context)
+}
+
+type initializeResult struct {
+	ProtocolVersion string `json:"protocolVersion"`
+	Capabilities    struct {
+		Tools     *struct{} `json:"tools,omitempty"`
+		Resources *struct{} `json:"resources,omitempty"`
+		Prompts   *struct{} `json:"prompts,omitempty"`
+	} `json:"capabilities"`
+}
+
+// connect opens a session to the server over its transport and performs the
+// initialize handshake. The caller must close the returned session.
+func (h *mcpServerHandler) connect(ctx context.Context, s *mcpserver.MCPServer) (session, *initializeResult, error) {
+	// the url was validated on save. only the scheme is re-checked here;
+	// private/reserved addresses are blocked by the http client's dialer.
+	if !strings.HasPrefix(s.URL, "https://") {
+		return nil, nil, fmt.Errorf("invalid mcp server url. only https is allowed")
+	}
+
+	var sess session
+	switch s.Transport {
+	case mcpserver.TransportStreamableHTTP:
+		sess = &streamableSession{
+			client:  h.httpClient,
+			url:     s.URL,
+			headers: s.Headers,
+		}
+
+	case mcpserver.TransportSSE:
+		tmp, err := openSSESession(ctx, h.httpClient, s.URL, s.Headers)
+		if err != nil {
+			return nil, nil, err
+		}
+		sess = tmp
+
+	default:
+		return nil, nil, fmt.Errorf("unsupported transport: %s", s.Transport)
+	}
+
+	params := map[string]any{
+		"protocolVersion": protocolVersion,
+		"capabilities":    map[string]any{},
+		"clientInfo": map[string]any{
+			"name":    clientName,
+			"version": clientVersion,
+		},
+	}
+	res := &initializeResult{}
+	if err := sess.call(ctx, "initialize", params, res); err != nil {
+		sess.close(ctx)
+		return nil, nil, errors.Wrapf(err, "could not initialize the session")
+	}
+
+	if st, ok := sess.(*streamableSession); ok {
+		st.protocolVersion = res.ProtocolVersion
+	}
+
+	if err := sess.notify(ctx, "notifications/initialized", nil); err != nil {
+		sess.close(ctx)
+		return nil, nil, errors.Wrapf(err, "could not send the initialized notification")
+	}
+
+	return sess, res, nil
+}
+
+// listPages calls a paginated list method until the server stops returning
+// a cursor or maxListPages is reached. Each page is handed to handle.
+func listPages(ctx context.Context, sess session, method string, handle func(page map[string]json.RawMessage) error) error {
+	cursor := ""
+	for i := 0; i < maxListPages; i++ {
+		var params any
+		if cursor != "" {
+			params = map[string]any{"cursor": cursor}
+		}
+
+		page := map[string]json.RawMessage{}
+		if err := sess.call(ctx, method, params, &page); err != nil {
+			return errors.Wrapf(err, "could not call %s", method)
+		}
+
+		if err := handle(page); err != nil {
+			return errors.Wrapf(err, "could not parse the %s result", method)
+		}
+
+		cursor = ""
+		if raw, ok := page["nextCursor"]; ok {
+			_ = json.Unmarshal(raw, &cursor)
+		}
+		if cursor == "" {
+			break
+		}
+	}
+
+	return nil
+}
+
+// streamableSession speaks the streamable HTTP transport: every message is
+// POSTed to the server's single endpoint and the response comes back either
+// as a JSON body or as an SSE stream.
+type streamableSession struct {
+	client  *http.Client
+	url     string
+	headers map[string]string
+
+	sessionID       string
+	protocolVersion string
+	lastID          int64
+}
+
+func (s *streamableSession) newRequest(ctx context.Context, method string, body []byte) (*http.Request, error) {
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, s.url, reader)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not create the request")
+	}
+	for k, v := range s.headers {
+		req.Header.Set(k, v)
+	}
+	req.Header.Set("Accept", "application/json, text/event-stream")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if s.sessionID != "" {
+		req.Header.Set(headerSessionID, s.sessionID)
+	}
+	if s.protocolVersion != "" {
+		req.Header.Set(headerProtocolVersion, s.protocolVersion)
+	}
+
+	return req, nil
+}
+
+func (s *streamableSession) post(ctx context.Context, msg *rpcRequest) (*http.Response, error) {
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not marshal the message")
+	}
+
+	req, err := s.newRequest(ctx, http.MethodPost, body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not send the request")
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("the server returned status %d", resp.StatusCode)
+	}
+
+	if id := resp.Header.Get(headerSessionID); id != "" {
+		s.sessionID = id
+	}
+
+	return resp, nil
+}
+
+func (s *streamableSession) call(ctx context.Context, method string, params any, result any) error {
+	s.lastID++
+	id := s.lastID
+
+	resp, err := s.post(ctx, &rpcRequest{JSONRPC: "2.0", ID: &id, Method: method, Params: params})
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	var msg *rpcMessage
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	switch mediaType {
+	case "text/event-stream":
+		msg, err = readSSEResponse(newSSEReader(resp.Body), id)
+
+	case "application/json":
+		msg, err = readJSONResponse(resp.Body, id)
+
+	default:
+		return fmt.Errorf("unexpected content type: %q", mediaType)
+	}
+	if err != nil {
+		return err
+	}
+
+	return msg.decodeResult(result)
+}
+
+func (s *streamableSession) notify(ctx context.Context, method string, params any) error {
+	resp, err := s.post(ctx, &rpcRequest{JSONRPC: "2.0", Method: method, Params: params})
+	if err != nil {
+		return err
+	}
+	_ = resp.Body.Close()
+
+	return nil
+}
+
+// close terminates the server-side session. Servers that don't support
+// explicit termination answer 405, which is fine to ignore.
+func (s *streamableSession) close(ctx context.Context) {
+	if s.sessionID == "" {
+		return
+	}
+
+	req, err := s.newRequest(ctx, http.MethodDelete, nil)
+	if err != nil {
+		return
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return
+	}
+	_ = resp.Body.Close()
+}
+
+// sseSession speaks the legacy HTTP+SSE transport: the client keeps a GET
+// stream open, the server announces a message endpoint on it, and the
+// responses to the messages POSTed there arrive as SSE "message" events.
+type sseSession struct {
+	client   *http.Client
+	headers  map[string]string
+	endpoint string
+
+	body   io.ReadCloser
+	reader *sseReader
+	lastID int64
+}
+
+func openSSESession(ctx context.Context, client *http.Client, rawURL string, headers map[string]string) (*sseSession, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not create the request")
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	req.Header.Set("Accept", "text/event-stream")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not open the event stream")
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("the server returned status %d", resp.StatusCode)
+	}
+
+	reader := newSSEReader(resp.Body)
+	for {
+		ev, errNext := reader.next()
+		if errNext != nil {
+			_ = resp.Body.Close()
+			return nil, errors.Wrapf(errNext, "could not receive the endpoint event")
+		}
+		if ev.event != "endpoint" {
+			continue
+		}
+
+		endpoint, errEndpoint := resolveEndpoint(rawURL, ev.data)
+		if errEndpoint != nil {
+			_ = resp.Body.Close()
+			return nil, errEndpoint
+		}
+
+		return &sseSession{
+			client:   client,
+			headers:  headers,
+			endpoint: endpoint,
+
+			body:   resp.Body,
+			reader: reader,
+		}, nil
+	}
+}
+
+// resolveEndpoint resolves the message endpoint announced by a legacy SSE
+// server against the server's url. The endpoint must stay on the same origin
+// so a server can not direct the client, and the customer's headers,
+// somewhere else.
+func resolveEndpoint(base string, ref string) (string, error) {
+	b, err := url.Parse(base)
+	if err != nil {
+		return "", errors.Wrapf(err, "could not parse the server url")
+	}
+
+	r, err := url.Parse(strings.TrimSpace(ref))
+	if err != nil {
+		return "", errors.Wrapf(err, "could not parse the endpoint")
+	}
+
+	res := b.ResolveReference(r)
+	if res.Scheme != b.Scheme || res.Host != b.Host {
+		return "", fmt.Errorf("the endpoint is not on the server's origin: %s", res.String())
+	}
+
+	return res.String(), nil
+}
+
+func (s *sseSession) post(ctx context.Context, msg *rpcRequest) error {
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return errors.Wrapf(err, "could not marshal the message")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrapf(err, "could not create the request")
+	}
+	for k, v := range s.headers {
+		req.Header.Set(k, v)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "could not send the request")
+	}
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxMessageSize))
+	_ = resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("the server returned status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+func (s *sseSession) call(ctx context.Context, method string, params any, result any) error {
+	s.lastID++
+	id := s.lastID
+
+	if err := s.post(ctx, &rpcRequest{JSONRPC: "2.0", ID: &id, Method: method, Params: params}); err != nil {
+		return err
+	}
+
+	msg, err := readSSEResponse(s.reader, id)
+	if err != nil {
+		return err
+	}
+
+	return msg.decodeResult(result)
+}
+
+func (s *sseSession) notify(ctx context.Context, method string, params any) error {
+	return s.post(ctx, &rpcRequest{JSONRPC: "2.0", Method: method, Params: params})
+}
+
+func (s *sseSession) close(ctx context.Context) {
+	_ = s.body.Close()
+}
+
+// readJSONResponse reads a single JSON-RPC response body.
+func readJSONResponse(r io.Reader, id int64) (*rpcMessage, error) {
+	data, err := io.ReadAll(io.LimitReader(r, maxMessageSize+1))
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read the response")
+	}
+	if len(data) > maxMessageSize {
+		return nil, fmt.Errorf("the response is too large. max: %d bytes", maxMessageSize)
+	}
+
+	res := &rpcMessage{}
+	if errUnmarshal := json.Unmarshal(data, res); errUnmarshal != nil {
+		return nil, errors.Wrapf(errUnmarshal, "could not parse the response")
+	}
+	if !res.isResponseTo(id) {
+		return nil, fmt.Errorf("unexpected response id: %s", string(res.ID))
+	}
+
+	return res, nil
+}
+
+// readSSEResponse reads SSE "message" events until the response to the
+// request with the given id arrives. Server requests and notifications sent
+// in between are skipped.
+func readSSEResponse(r *sseReader, id int64) (*rpcMessage, error) {
+	for {
+		ev, err := r.next()
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not receive the response")
+		}
+		if ev.event != "message" {
+			continue
+		}
+
+		res := &rpcMessage{}
+		if errUnmarshal := json.Unmarshal([]byte(ev.data), res); errUnmarshal != nil {
+			continue
+		}
+		if res.isResponseTo(id) {
+			return res, nil
+		}
+	}
+}
+
+type sseEvent struct {
+	event string
+	data  string
+}
+
+// sseReader parses a text/event-stream body into events.
+type sseReader struct {
+	scanner *bufio.Scanner
+}
+
+func newSSEReader(r io.Reader) *sseReader {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxMessageSize)
+
+	return &sseReader{
+		scanner: scanner,
+	}
+}
+
+// next returns the next dispatched event. An event without an explicit
+// type is a "message" event.
+func (r *sseReader) next() (*sseEvent, error) {
+	res := &sseEvent{}
+	var data []string
+	size := 0
+
+	for r.scanner.Scan() {
+		line := r.scanner.Text()
+		if line == "" {
+			if len(data) == 0 && res.event == "" {
+				continue
+			}
+			if res.event == "" {
+				res.event = "message"
+			}
+			res.data = strings.Join(data, "\n")
+			return res, nil
+		}
+
+		// lines starting with a colon are comments (keep-alives).
+		if strings.HasPrefix(line, ":") {
+			continue
+		}
+
+		field, value, _ := strings.Cut(line, ":")
+		value = strings.TrimPrefix(value, " ")
+		switch field {
+		case "event":
+			res.event = value
+		case "data":
+			size += len(value)
+			if size > maxMessageSize {
+				return nil, fmt.Errorf("the event is too large. max: %d bytes", maxMessageSize)
+			}
+			data = append(data, value)
+		}
+	}
+
+	if err := r.scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return nil, io.EOF
+}
@@ -0,0 +1,279 @@
+package mcpserverhandler
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeMessage is a JSON-RPC message received by the fake MCP server.
+type fakeMessage struct {
+	ID     json.RawMessage `json:"id,omitempty"`
+	Method string          `json:"method"`
+	Params map[string]any  `json:"params"`
+}
+
+// fakeHandle returns the result of a request to the fake MCP server. The
+// server has two pages of tools, one resource and one prompt.
+func fakeHandle(m *fakeMessage) any {
+	switch m.Method {
+	case "initialize":
+		return map[string]any{
+			"protocolVersion": protocolVersion,
+			"capabilities": map[string]any{
+				"tools":     map[string]any{},
+				"resources": map[string]any{},
+				"prompts":   map[string]any{},
+			},
+			"serverInfo": map[string]any{"name": "fake", "version": "1"},
+		}
+
+	case "tools/list":
+		if m.Params["cursor"] == "2" {
+			return map[string]any{
+				"tools": []map[string]any{
+					{"name": "delete_order", "description": "Delete an order."},
+				},
+			}
+		}
+		return map[string]any{
+			"tools": []map[string]any{
+				{
+					"name":        "lookup_order",
+					"description": "Look up an order.",
+					"inputSchema": map[string]any{
+						"type": "object",
+						"properties": map[string]any{
+							"order_number": map[string]any{"type": "string"},
+						},
+					},
+				},
+				{"name": "read_resource", "description": "Collides with the synthetic tool."},
+				{"name": "bad.name", "description": "Not a valid function name."},
+				{"name": "fail", "description": "Always fails."},
+			},
+			"nextCursor": "2",
+		}
+
+	case "resources/list":
+		return map[string]any{
+			"resources": []map[string]any{
+				{"uri": "file:///faq.md", "name": "faq", "description": "Frequently asked questions."},
+			},
+		}
+
+	case "prompts/list":
+		return map[string]any{
+			"prompts": []map[string]any{
+				{
+					"name":      "greeting",
+					"arguments": []map[string]any{{"name": "customer", "required": true}},
+				},
+			},
+		}
+
+	case "tools/call":
+		args, _ := m.Params["arguments"].(map[string]any)
+		if m.Params["name"] == "fail" {
+			return map[string]any{
+				"content": []map[string]any{{"type": "text", "text": "backend down"}},
+				"isError": true,
+			}
+		}
+		return map[string]any{
+			"content": []map[string]any{
+				{"type": "text", "text": fmt.Sprintf("Order %v is shipped.", args["order_number"])},
+				{"type": "image", "data": "aGVsbG8=", "mimeType": "image/png"},
+			},
+		}
+
+	case "resources/read":
+		return map[string]any{
+			"contents": []map[string]any{
+				{"uri": m.Params["uri"], "text": "Opening hours are 9 to 5."},
+			},
+		}
+
+	case "prompts/get":
+		args, _ := m.Params["arguments"].(map[string]any)
+		return map[string]any{
+			"messages": []map[string]any{
+				{"role": "user", "content": map[string]any{"type": "text", "text": fmt.Sprintf("Greet %v.", args["customer"])}},
+			},
+		}
+	}
+
+	return nil
+}
+
+func fakeResponse(m *fakeMessage) []byte {
+	res, _ := json.Marshal(map[string]any{
+		"jsonrpc": "2.0",
+		"id":      m.ID,
+		"result":  fakeHandle(m),
+	})
+	return res
+}
+
+// fakeStreamableServer serves the streamable HTTP transport. With sse set,
+// responses are sent as an event stream preceded by a server notification.
+type fakeStreamableServer struct {
+	sse bool
+
+	mu         sync.Mutex
+	methods    []string
+	sessionIDs []string
+	deleted    bool
+}
+
+func (f *fakeStreamableServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if r.Method == http.MethodDelete {
+		f.deleted = r.Header.Get(headerSessionID) == "session-1"
+		return
+	}
+
+	m := &fakeMessage{}
+	if err := json.NewDecoder(r.Body).Decode(m); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	f.methods = append(f.methods, m.Method)
+	f.sessionIDs = append(f.sessionIDs, r.Header.Get(headerSessionID))
+
+	if len(m.ID) == 0 {
+		w.WriteHeader(http.StatusAccepted)
+		return
+	}
+
+	if m.Method == "initialize" {
+		w.Header().Set(headerSessionID, "session-1")
+	}
+
+	if !f.sse {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(fakeResponse(m))
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	_, _ = fmt.Fprintf(w, ": keep-alive\n\n")
+	_, _ = fmt.Fprintf(w, "event: message\ndata: {\"jsonrpc\":\"2.0\",\"method\":\"notifications/progress\"}\n\n")
+	_, _ = fmt.Fprintf(w, "event: message\ndata: %s\n\n", fakeResponse(m))
+}
+
+// fakeSSEServer serves the legacy HTTP+SSE transport.
+type fakeSSEServer struct {
+	messages chan []byte
+}
+
+func newFakeSSEServer() *fakeSSEServer {
+	return &fakeSSEServer{
+		messages: make(chan []byte, 10),
+	}
+}
+
+func (f *fakeSSEServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/sse":
+		w.Header().Set("Content-Type", "text/event-stream")
+		_, _ = fmt.Fprintf(w, "event: endpoint\ndata: /messages?session_id=1\n\n")
+		w.(http.Flusher).Flush()
+
+		for {
+			select {
+			case <-r.Context().Done():
+				return
+			case msg := <-f.messages:
+				_, _ = fmt.Fprintf(w, "event: message\ndata: %s\n\n", msg)
+				w.(http.Flusher).Flush()
+			}
+		}
+
+	case r.Method == http.MethodPost && r.URL.Path == "/messages":
+		m := &fakeMessage{}
+		if err := json.NewDecoder(r.Body).Decode(m); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if len(m.ID) > 0 {
+			f.messages <- fakeResponse(m)
+		}
+		w.WriteHeader(http.StatusAccepted)
+
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func Test_resolveEndpoint(t *testing.T) {
+	tests := []struct {
+		name string
+
+		base string
+		ref  string
+
+		expectRes string
+		expectErr bool
+	}{
+		{"relative path", "https://mcp.example.com/v1/sse", "/messages?session_id=1", "https://mcp.example.com/messages?session_id=1", false},
+		{"relative to the stream", "https://mcp.example.com/v1/sse", "messages", "https://mcp.example.com/v1/messages", false},
+		{"same origin absolute", "https://mcp.example.com/sse", "https://mcp.example.com/messages", "https://mcp.example.com/messages", false},
+		{"other host", "https://mcp.example.com/sse", "https://evil.example.com/messages", "", true},
+		{"other scheme", "https://mcp.example.com/sse", "http://mcp.example.com/messages", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := resolveEndpoint(tt.base, tt.ref)
+			if (err != nil) != tt.expectErr {
+				t.Fatalf("Wrong match. expect error: %v, got: %v", tt.expectErr, err)
+			}
+			if res != tt.expectRes {
+				t.Errorf("Wrong match.\nexpect: %s\ngot: %s", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_sseReader(t *testing.T) {
+	stream := ": comment\n\nevent: endpoint\ndata: /messages\n\ndata: line1\ndata: line2\n\n"
+
+	r := newSSEReader(strings.NewReader(stream))
+
+	ev, err := r.next()
+	if err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+	if ev.event != "endpoint" || ev.data != "/messages" {
+		t.Errorf("Wrong match. got: %v", ev)
+	}
+
+	ev, err = r.next()
+	if err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+	if ev.event != "message" || ev.data != "line1\nline2" {
+		t.Errorf("Wrong match. got: %v", ev)
+	}
+
+	if _, err := r.next(); err != io.EOF {
+		t.Errorf("Wrong match. expect: EOF, got: %v", err)
+	}
+}
+
+// newTestHandler returns a handler whose http client trusts the test server.
+// the test server listens on loopback, so the ssrf-safe client can't be used.
+// the dial-time block list is covered by safehttp's Test_NewClient.
+func newTestHandler(server *httptest.Server) *mcpServerHandler {
+	return &mcpServerHandler{
+		httpClient: server.Client(),
+	}
+}
@@ -0,0 +1,275 @@
+package mcpserverhandler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	cerrors "monorepo/bin-common-handler/models/errors"
+	commonoutline "monorepo/bin-common-handler/models/outline"
+
+	"monorepo/bin-ai-manager/models/mcpserver"
+	"monorepo/bin-ai-manager/models/tool"
+)
+
+// regToolName matches the MCP tool names that can be handed to every
+// supported LLM provider as part of an exposed function name.
+var regToolName = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+const (
+	maxDescriptionLength = 2048 // bytes of a tool description handed to the LLM
+	maxListedResources   = 50   // resources enumerated in the read_resource description
+)
+
+type mcpTool struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	InputSchema map[string]any `json:"inputSchema"`
+}
+
+type mcpResource struct {
+	URI         string `json:"uri"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type mcpPrompt struct {
+	Name        string              `json:"name"`
+	Description string              `json:"description"`
+	Arguments   []mcpPromptArgument `json:"arguments"`
+}
+
+type mcpPromptArgument struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Required    bool   `json:"required"`
+}
+
+// withTimeout bounds a whole discovery or tool call, handshake included,
+// by the server's timeout.
+func withTimeout(ctx context.Context, s *mcpserver.MCPServer) (context.Context, context.CancelFunc) {
+	timeout := s.Timeout
+	if timeout <= 0 || timeout > mcpserver.TimeoutMax {
+		timeout = mcpserver.TimeoutDefault
+	}
+
+	return context.WithTimeout(ctx, time.Duration(timeout)*time.Millisecond)
+}
+
+// ListTools connects to the MCP server and returns the LLM-facing tool
+// definitions of everything it offers that the server's allowlist permits:
+// its tools as "<name>__<tool>", plus the synthetic "<name>__read_resource"
+// and "<name>__get_prompt" tools when it has resources or prompts.
+func (h *mcpServerHandler) ListTools(ctx context.Context, s *mcpserver.MCPServer) ([]tool.Tool, error) {
+	res, err := h.listTools(ctx, s)
+	if err != nil {
+		return nil, cerrors.Unavailable(
+			commonoutline.ServiceNameAIManager,
+			"MCP_SERVER_UNAVAILABLE",
+			"Could not discover the MCP server's tools.",
+		).Wrap(err)
+	}
+
+	return res, nil
+}
+
+func (h *mcpServerHandler) listTools(ctx context.Context, s *mcpserver.MCPServer) ([]tool.Tool, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":          "listTools",
+		"mcp_server_id": s.ID,
+	})
+
+	ctx, cancel := withTimeout(ctx, s)
+	defer cancel()
+
+	sess, init, err := h.connect(ctx, s)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not connect to the mcp server")
+	}
+	defer sess.close(ctx)
+
+	res := []tool.Tool{}
+
+	if init.Capabilities.Tools != nil {
+		tools := []mcpTool{}
+		if errList := listPages(ctx, sess, "tools/list", func(page map[string]json.RawMessage) error {
+			tmp := []mcpTool{}
+			if errUnmarshal := json.Unmarshal(page["tools"], &tmp); errUnmarshal != nil {
+				return errUnmarshal
+			}
+			tools = append(tools, tmp...)
+			return nil
+		}); errList != nil {
+			return nil, errList
+		}
+
+		for _, t := range tools {
+			if t.Name == mcpserver.ToolNameReadResource || t.Name == mcpserver.ToolNameGetPrompt {
+				log.Debugf("Skipping the tool that collides with a synthetic tool name. tool: %s", t.Name)
+				continue
+			}
+			if !s.IsToolAllowed(t.Name) {
+				continue
+			}
+
+			name := s.ExposedToolName(t.Name)
+			if !regToolName.MatchString(t.Name) || len(name) > maxToolNameLen {
+				log.Debugf("Skipping the tool with an unsupported name. tool: %s", t.Name)
+				continue
+			}
+
+			parameters := t.InputSchema
+			if parameters == nil || parameters["type"] != "object" {
+				parameters = map[string]any{
+					"type":       "object",
+					"properties": map[string]any{},
+				}
+			}
+
+			res = append(res, tool.Tool{
+				Name:        tool.ToolName(name),
+				Description: truncate(t.Description, maxDescriptionLength),
+				Parameters:  parameters,
+				RunLLM:      true,
+			})
+		}
+	}
+
+	if init.Capabilities.Resources != nil && s.IsToolAllowed(mcpserver.ToolNameReadResource) {
+		resources := []mcpResource{}
+		if errList := listPages(ctx, sess, "resources/list", func(page map[string]json.RawMessage) error {
+			tmp := []mcpResource{}
+			if errUnmarshal := json.Unmarshal(page["resources"], &tmp); errUnmarshal != nil {
+				return errUnmarshal
+			}
+			resources = append(resources, tmp...)
+			return nil
+		}); errList != nil {
+			return nil, errList
+		}
+
+		if len(resources) > 0 {
+			res = append(res, readResourceTool(s, resources))
+		}
+	}
+
+	if init.Capabilities.Prompts != nil && s.IsToolAllowed(mcpserver.ToolNameGetPrompt) {
+		prompts := []mcpPrompt{}
+		if errList := listPages(ctx, sess, "prompts/list", func(page map[string]json.RawMessage) error {
+			tmp := []mcpPrompt{}
+			if errUnmarshal := json.Unmarshal(page["prompts"], &tmp); errUnmarshal != nil {
+				return errUnmarshal
+			}
+			prompts = append(prompts, tmp...)
+			return nil
+		}); errList != nil {
+			return nil, errList
+		}
+
+		if len(prompts) > 0 {
+			res = append(res, getPromptTool(s, prompts))
+		}
+	}
+	log.Debugf("Discovered mcp server tools. count: %d", len(res))
+
+	return res, nil
+}
+
+// readResourceTool returns the synthetic tool that reads one of the server's
+// resources. The available resources are listed in the description so the
+// LLM can pick a uri.
+func readResourceTool(s *mcpserver.MCPServer, resources []mcpResource) tool.Tool {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Read a resource from the %s MCP server. Available resources:", s.Name)
+	for i, r := range resources {
+		if i >= maxListedResources {
+			break
+		}
+		fmt.Fprintf(&sb, "\n- %s", r.URI)
+		if r.Name != "" {
+			fmt.Fprintf(&sb, " (%s)", r.Name)
+		}
+		if r.Description != "" {
+			fmt.Fprintf(&sb, ": %s", r.Description)
+		}
+	}
+
+	return tool.Tool{
+		Name:        tool.ToolName(s.ExposedToolName(mcpserver.ToolNameReadResource)),
+		Description: truncate(sb.String(), maxDescriptionLength),
+		Parameters: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"uri": map[string]any{
+					"type":        "string",
+					"description": "The URI of the resource to read.",
+				},
+			},
+			"required": []string{"uri"},
+		},
+		RunLLM: true,
+	}
+}
+
+// getPromptTool returns the synthetic tool that renders one of the server's
+// prompt templates.
+func getPromptTool(s *mcpserver.MCPServer, prompts []mcpPrompt) tool.Tool {
+	names := []string{}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Get a prompt template from the %s MCP server. Available prompts:", s.Name)
+	for _, p := range prompts {
+		names = append(names, p.Name)
+
+		fmt.Fprintf(&sb, "\n- %s", p.Name)
+		if p.Description != "" {
+			fmt.Fprintf(&sb, ": %s", p.Description)
+		}
+		for _, a := range p.Arguments {
+			required := ""
+			if a.Required {
+				required = ", required"
+			}
+			fmt.Fprintf(&sb, "\n  - argument %s (string%s)", a.Name, required)
+			if a.Description != "" {
+				fmt.Fprintf(&sb, ": %s", a.Description)
+			}
+		}
+	}
+
+	return tool.Tool{
+		Name:        tool.ToolName(s.ExposedToolName(mcpserver.ToolNameGetPrompt)),
+		Description: truncate(sb.String(), maxDescriptionLength),
+		Parameters: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"name": map[string]any{
+					"type":        "string",
+					"description": "The name of the prompt.",
+					"enum":        names,
+				},
+				"arguments": map[string]any{
+					"type":                 "object",
+					"description":          "The prompt arguments.",
+					"additionalProperties": map[string]any{"type": "string"},
+				},
+			},
+			"required": []string{"name"},
+		},
+		RunLLM: true,
+	}
+}
+
+// truncate cuts s to at most size bytes.
+func truncate(s string, size int) string {
+	if len(s) > size {
+		return s[:size]
+	}
+	return s
+}
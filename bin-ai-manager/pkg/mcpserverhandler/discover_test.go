@@ -0,0 +1,131 @@
+package mcpserverhandler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"monorepo/bin-ai-manager/models/mcpserver"
+	"monorepo/bin-ai-manager/models/tool"
+)
+
+func Test_ListTools(t *testing.T) {
+	tests := []struct {
+		name string
+
+		transport    mcpserver.Transport
+		sse          bool
+		allowedTools []string
+
+		expectNames []tool.ToolName
+	}{
+		{
+			name: "streamable http with json responses",
+
+			transport: mcpserver.TransportStreamableHTTP,
+
+			expectNames: []tool.ToolName{"crm__lookup_order", "crm__fail", "crm__delete_order", "crm__read_resource", "crm__get_prompt"},
+		},
+		{
+			name: "streamable http with sse responses",
+
+			transport: mcpserver.TransportStreamableHTTP,
+			sse:       true,
+
+			expectNames: []tool.ToolName{"crm__lookup_order", "crm__fail", "crm__delete_order", "crm__read_resource", "crm__get_prompt"},
+		},
+		{
+			name: "legacy sse",
+
+			transport: mcpserver.TransportSSE,
+
+			expectNames: []tool.ToolName{"crm__lookup_order", "crm__fail", "crm__delete_order", "crm__read_resource", "crm__get_prompt"},
+		},
+		{
+			name: "allowlist",
+
+			transport:    mcpserver.TransportStreamableHTTP,
+			allowedTools: []string{"lookup_order", "read_resource"},
+
+			expectNames: []tool.ToolName{"crm__lookup_order", "crm__read_resource"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var handler http.Handler
+			path := "/mcp"
+			if tt.transport == mcpserver.TransportSSE {
+				handler = newFakeSSEServer()
+				path = "/sse"
+			} else {
+				handler = &fakeStreamableServer{sse: tt.sse}
+			}
+			server := httptest.NewTLSServer(handler)
+			defer server.Close()
+
+			h := newTestHandler(server)
+
+			s := &mcpserver.MCPServer{
+				Name:         "crm",
+				Transport:    tt.transport,
+				URL:          server.URL + path,
+				Timeout:      5000,
+				AllowedTools: tt.allowedTools,
+			}
+
+			res, err := h.ListTools(context.Background(), s)
+			if err != nil {
+				t.Fatalf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			names := []tool.ToolName{}
+			for _, r := range res {
+				names = append(names, r.Name)
+			}
+			if !reflect.DeepEqual(names, tt.expectNames) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectNames, names)
+			}
+
+			if res[0].Parameters["type"] != "object" || res[0].Description != "Look up an order." {
+				t.Errorf("Wrong match. got: %v", res[0])
+			}
+		})
+	}
+}
+
+func Test_ListTools_session(t *testing.T) {
+	f := &fakeStreamableServer{}
+	server := httptest.NewTLSServer(f)
+	defer server.Close()
+
+	h := newTestHandler(server)
+
+	s := &mcpserver.MCPServer{
+		Name:      "crm",
+		Transport: mcpserver.TransportStreamableHTTP,
+		URL:       server.URL + "/mcp",
+	}
+
+	if _, err := h.ListTools(context.Background(), s); err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+
+	expectMethods := []string{"initialize", "notifications/initialized", "tools/list", "tools/list", "resources/list", "prompts/list"}
+	if !reflect.DeepEqual(f.methods, expectMethods) {
+		t.Errorf("Wrong match.\nexpect: %v\ngot: %v", expectMethods, f.methods)
+	}
+
+	// every request after initialize must carry the session id.
+	for i, id := range f.sessionIDs[1:] {
+		if id != "session-1" {
+			t.Errorf("Wrong match. request: %d, expect: session-1, got: %s", i+1, id)
+		}
+	}
+
+	if !f.deleted {
+		t.Errorf("Wrong match. expect: session deleted")
+	}
+}
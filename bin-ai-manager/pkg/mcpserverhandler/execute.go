@@ -0,0 +1,189 @@
+package mcpserverhandler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"monorepo/bin-ai-manager/models/mcpserver"
+)
+
+const maxResultLength = 8 * 1024 // bytes of tool result handed back to the LLM
+
+type mcpContent struct {
+	Type     string               `json:"type"`
+	Text     string               `json:"text"`
+	Resource *mcpResourceContents `json:"resource,omitempty"`
+}
+
+type mcpResourceContents struct {
+	URI  string `json:"uri"`
+	Text string `json:"text"`
+}
+
+type callToolResult struct {
+	Content []mcpContent `json:"content"`
+	IsError bool         `json:"isError"`
+}
+
+type readResourceResult struct {
+	Contents []mcpResourceContents `json:"contents"`
+}
+
+type getPromptResult struct {
+	Messages []struct {
+		Role    string     `json:"role"`
+		Content mcpContent `json:"content"`
+	} `json:"messages"`
+}
+
+// Execute runs the MCP tool with the LLM's arguments and returns the text to
+// feed back to the model. toolName is the server-side name, i.e. the exposed
+// name without the "<name>__" prefix, and may be one of the synthetic
+// read_resource/get_prompt tools. Every call opens its own MCP session.
+func (h *mcpServerHandler) Execute(ctx context.Context, s *mcpserver.MCPServer, toolName string, arguments string) (string, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":          "Execute",
+		"mcp_server_id": s.ID,
+		"tool_name":     toolName,
+	})
+
+	// the allowlist is re-checked because the LLM may call a name that was
+	// never offered to it.
+	if !s.IsToolAllowed(toolName) {
+		return "", fmt.Errorf("the tool is not allowed on this mcp server. tool: %s", toolName)
+	}
+
+	args := map[string]any{}
+	if strings.TrimSpace(arguments) != "" {
+		if err := json.Unmarshal([]byte(arguments), &args); err != nil {
+			return "", errors.Wrapf(err, "could not parse the tool arguments")
+		}
+	}
+
+	ctx, cancel := withTimeout(ctx, s)
+	defer cancel()
+
+	sess, _, err := h.connect(ctx, s)
+	if err != nil {
+		return "", errors.Wrapf(err, "could not connect to the mcp server")
+	}
+	defer sess.close(ctx)
+
+	var res string
+	switch toolName {
+	case mcpserver.ToolNameReadResource:
+		res, err = readResource(ctx, sess, args)
+
+	case mcpserver.ToolNameGetPrompt:
+		res, err = getPrompt(ctx, sess, args)
+
+	default:
+		res, err = callTool(ctx, sess, toolName, args)
+	}
+	if err != nil {
+		return "", err
+	}
+	log.Debugf("Executed mcp tool. result_size: %d", len(res))
+
+	return truncate(res, maxResultLength), nil
+}
+
+func callTool(ctx context.Context, sess session, name string, args map[string]any) (string, error) {
+	params := map[string]any{
+		"name":      name,
+		"arguments": args,
+	}
+
+	tmp := &callToolResult{}
+	if err := sess.call(ctx, "tools/call", params, tmp); err != nil {
+		return "", errors.Wrapf(err, "could not call the tool")
+	}
+
+	res := renderContents(tmp.Content)
+	if tmp.IsError {
+		return "", fmt.Errorf("the tool returned an error: %s", truncate(res, maxResultLength))
+	}
+
+	return res, nil
+}
+
+func readResource(ctx context.Context, sess session, args map[string]any) (string, error) {
+	uri, _ := args["uri"].(string)
+	if uri == "" {
+		return "", fmt.Errorf("uri is required")
+	}
+
+	tmp := &readResourceResult{}
+	if err := sess.call(ctx, "resources/read", map[string]any{"uri": uri}, tmp); err != nil {
+		return "", errors.Wrapf(err, "could not read the resource")
+	}
+
+	texts := []string{}
+	for _, c := range tmp.Contents {
+		if c.Text == "" {
+			texts = append(texts, fmt.Sprintf("[binary content omitted: %s]", c.URI))
+			continue
+		}
+		texts = append(texts, c.Text)
+	}
+
+	return strings.Join(texts, "\n"), nil
+}
+
+func getPrompt(ctx context.Context, sess session, args map[string]any) (string, error) {
+	name, _ := args["name"].(string)
+	if name == "" {
+		return "", fmt.Errorf("name is required")
+	}
+
+	// prompt arguments are string-valued in MCP.
+	promptArgs := map[string]string{}
+	if tmp, ok := args["arguments"].(map[string]any); ok {
+		for k, v := range tmp {
+			promptArgs[k] = fmt.Sprint(v)
+		}
+	}
+
+	params := map[string]any{
+		"name":      name,
+		"arguments": promptArgs,
+	}
+
+	tmp := &getPromptResult{}
+	if err := sess.call(ctx, "prompts/get", params, tmp); err != nil {
+		return "", errors.Wrapf(err, "could not get the prompt")
+	}
+
+	lines := []string{}
+	for _, m := range tmp.Messages {
+		lines = append(lines, fmt.Sprintf("%s: %s", m.Role, renderContents([]mcpContent{m.Content})))
+	}
+
+	return strings.Join(lines, "\n"), nil
+}
+
+// renderContents joins the text of MCP content blocks. Images, audio and
+// binary resources can't be handed to the LLM as tool output and are
+// replaced by a placeholder.
+func renderContents(contents []mcpContent) string {
+	texts := []string{}
+	for _, c := range contents {
+		switch {
+		case c.Type == "text":
+			texts = append(texts, c.Text)
+
+		case c.Type == "resource" && c.Resource != nil && c.Resource.Text != "":
+			texts = append(texts, c.Resource.Text)
+
+		default:
+			texts = append(texts, fmt.Sprintf("[%s content omitted]", c.Type))
+		}
+	}
+
+	return strings.Join(texts, "\n")
+}
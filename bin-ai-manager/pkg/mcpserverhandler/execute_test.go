@@ -0,0 +1,127 @@
+package mcpserverhandler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"monorepo/bin-ai-manager/models/mcpserver"
+)
+
+func Test_Execute(t *testing.T) {
+	tests := []struct {
+		name string
+
+		transport    mcpserver.Transport
+		allowedTools []string
+		toolName     string
+		arguments    string
+
+		expectRes string
+		expectErr bool
+	}{
+		{
+			name: "tool call",
+
+			transport: mcpserver.TransportStreamableHTTP,
+			toolName:  "lookup_order",
+			arguments: `{"order_number":"A-100"}`,
+
+			expectRes: "Order A-100 is shipped.\n[image content omitted]",
+		},
+		{
+			name: "tool call over legacy sse",
+
+			transport: mcpserver.TransportSSE,
+			toolName:  "lookup_order",
+			arguments: `{"order_number":"A-100"}`,
+
+			expectRes: "Order A-100 is shipped.\n[image content omitted]",
+		},
+		{
+			name: "read resource",
+
+			transport: mcpserver.TransportStreamableHTTP,
+			toolName:  mcpserver.ToolNameReadResource,
+			arguments: `{"uri":"file:///faq.md"}`,
+
+			expectRes: "Opening hours are 9 to 5.",
+		},
+		{
+			name: "get prompt",
+
+			transport: mcpserver.TransportStreamableHTTP,
+			toolName:  mcpserver.ToolNameGetPrompt,
+			arguments: `{"name":"greeting","arguments":{"customer":"Alice"}}`,
+
+			expectRes: "user: Greet Alice.",
+		},
+		{
+			name: "tool returned an error",
+
+			transport: mcpserver.TransportStreamableHTTP,
+			toolName:  "fail",
+
+			expectErr: true,
+		},
+		{
+			name: "tool not allowed",
+
+			transport:    mcpserver.TransportStreamableHTTP,
+			allowedTools: []string{"lookup_order"},
+			toolName:     "delete_order",
+
+			expectErr: true,
+		},
+		{
+			name: "read resource without uri",
+
+			transport: mcpserver.TransportStreamableHTTP,
+			toolName:  mcpserver.ToolNameReadResource,
+			arguments: `{}`,
+
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var handler http.Handler
+			path := "/mcp"
+			if tt.transport == mcpserver.TransportSSE {
+				handler = newFakeSSEServer()
+				path = "/sse"
+			} else {
+				handler = &fakeStreamableServer{}
+			}
+			server := httptest.NewTLSServer(handler)
+			defer server.Close()
+
+			h := newTestHandler(server)
+
+			s := &mcpserver.MCPServer{
+				Name:         "crm",
+				Transport:    tt.transport,
+				URL:          server.URL + path,
+				Timeout:      5000,
+				AllowedTools: tt.allowedTools,
+			}
+
+			res, err := h.Execute(context.Background(), s, tt.toolName, tt.arguments)
+			if tt.expectErr {
+				if err == nil {
+					t.Errorf("Wrong match. expect: error, got: ok")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if res != tt.expectRes {
+				t.Errorf("Wrong match.\nexpect: %s\ngot: %s", tt.expectRes, res)
+			}
+		})
+	}
+}
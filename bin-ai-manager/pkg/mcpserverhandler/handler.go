@@ -0,0 +1,254 @@
+package mcpserverhandler
+
+import (
+	"context"
+	stderrors "errors"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	cerrors "monorepo/bin-common-handler/models/errors"
+	"monorepo/bin-common-handler/models/identity"
+	commonoutline "monorepo/bin-common-handler/models/outline"
+
+	"monorepo/bin-ai-manager/models/customtool"
+	"monorepo/bin-ai-manager/models/mcpserver"
+	"monorepo/bin-ai-manager/pkg/dbhandler"
+)
+
+// Create creates a new mcp server record.
+func (h *mcpServerHandler) Create(
+	ctx context.Context,
+	customerID uuid.UUID,
+	name string,
+	detail string,
+	transport mcpserver.Transport,
+	url string,
+	headers map[string]string,
+	timeout int,
+	allowedTools []string,
+) (*mcpserver.MCPServer, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":        "Create",
+		"customer_id": customerID,
+		"name":        name,
+	})
+
+	if err := validateMCPServer(name, transport, url, headers, timeout, allowedTools); err != nil {
+		return nil, cerrors.InvalidArgument(commonoutline.ServiceNameAIManager, "INVALID_MCP_SERVER", err.Error()).Wrap(err)
+	}
+
+	if err := h.validateNameAvailable(ctx, customerID, uuid.Nil, name); err != nil {
+		return nil, err
+	}
+
+	if timeout == 0 {
+		timeout = mcpserver.TimeoutDefault
+	}
+
+	s := &mcpserver.MCPServer{
+		Identity: identity.Identity{
+			ID:         h.utilHandler.UUIDCreate(),
+			CustomerID: customerID,
+		},
+		Name:         name,
+		Detail:       detail,
+		Transport:    transport,
+		URL:          url,
+		Headers:      headers,
+		Timeout:      timeout,
+		AllowedTools: allowedTools,
+	}
+
+	if err := h.db.MCPServerCreate(ctx, s); err != nil {
+		return nil, errors.Wrapf(err, "could not create mcp server")
+	}
+
+	res, err := h.db.MCPServerGet(ctx, s.ID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get created mcp server")
+	}
+	log.WithField("mcp_server", res).Debugf("Created mcp server. mcp_server_id: %s", res.ID)
+	h.notifyHandler.PublishWebhookEvent(ctx, res.CustomerID, mcpserver.EventTypeCreated, res)
+
+	return res, nil
+}
+
+// Get returns mcp server.
+func (h *mcpServerHandler) Get(ctx context.Context, id uuid.UUID) (*mcpserver.MCPServer, error) {
+	res, err := h.db.MCPServerGet(ctx, id)
+	if err != nil {
+		if stderrors.Is(err, dbhandler.ErrNotFound) {
+			return nil, cerrors.NotFound(
+				commonoutline.ServiceNameAIManager,
+				"MCP_SERVER_NOT_FOUND",
+				"The MCP server was not found.",
+			).Wrap(err)
+		}
+		return nil, errors.Wrapf(err, "could not get mcp server")
+	}
+
+	return res, nil
+}
+
+// List returns list of mcp servers.
+func (h *mcpServerHandler) List(ctx context.Context, size uint64, token string, filters map[mcpserver.Field]any) ([]*mcpserver.MCPServer, error) {
+	res, err := h.db.MCPServerList(ctx, size, token, filters)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not list mcp servers")
+	}
+
+	return res, nil
+}
+
+// GetByName returns the customer's active mcp server with the given name.
+func (h *mcpServerHandler) GetByName(ctx context.Context, customerID uuid.UUID, name string) (*mcpserver.MCPServer, error) {
+	filters := map[mcpserver.Field]any{
+		mcpserver.FieldCustomerID: customerID,
+		mcpserver.FieldName:       name,
+		mcpserver.FieldDeleted:    false,
+	}
+
+	tmp, err := h.db.MCPServerList(ctx, 1, "", filters)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get mcp server by name")
+	}
+
+	if len(tmp) == 0 {
+		return nil, cerrors.NotFound(
+			commonoutline.ServiceNameAIManager,
+			"MCP_SERVER_NOT_FOUND",
+			"The MCP server was not found.",
+		)
+	}
+
+	return tmp[0], nil
+}
+
+// Delete deletes the mcp server.
+func (h *mcpServerHandler) Delete(ctx context.Context, id uuid.UUID) (*mcpserver.MCPServer, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":          "Delete",
+		"mcp_server_id": id,
+	})
+
+	if err := h.db.MCPServerDelete(ctx, id); err != nil {
+		return nil, errors.Wrapf(err, "could not delete mcp server")
+	}
+
+	res, err := h.db.MCPServerGet(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get deleted mcp server")
+	}
+	log.WithField("mcp_server", res).Debugf("Deleted mcp server. mcp_server_id: %s", res.ID)
+	h.notifyHandler.PublishWebhookEvent(ctx, res.CustomerID, mcpserver.EventTypeDeleted, res)
+
+	return res, nil
+}
+
+// Update updates the mcp server.
+func (h *mcpServerHandler) Update(
+	ctx context.Context,
+	id uuid.UUID,
+	name string,
+	detail string,
+	transport mcpserver.Transport,
+	url string,
+	headers map[string]string,
+	timeout int,
+	allowedTools []string,
+) (*mcpserver.MCPServer, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":          "Update",
+		"mcp_server_id": id,
+	})
+
+	if err := validateMCPServer(name, transport, url, headers, timeout, allowedTools); err != nil {
+		return nil, cerrors.InvalidArgument(commonoutline.ServiceNameAIManager, "INVALID_MCP_SERVER", err.Error()).Wrap(err)
+	}
+
+	s, err := h.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.Name != name {
+		if errName := h.validateNameAvailable(ctx, s.CustomerID, s.ID, name); errName != nil {
+			return nil, errName
+		}
+	}
+
+	if timeout == 0 {
+		timeout = mcpserver.TimeoutDefault
+	}
+
+	fields := map[mcpserver.Field]any{
+		mcpserver.FieldName:         name,
+		mcpserver.FieldDetail:       detail,
+		mcpserver.FieldTransport:    transport,
+		mcpserver.FieldURL:          url,
+		mcpserver.FieldHeaders:      headers,
+		mcpserver.FieldTimeout:      timeout,
+		mcpserver.FieldAllowedTools: allowedTools,
+	}
+
+	if err := h.db.MCPServerUpdate(ctx, id, fields); err != nil {
+		return nil, errors.Wrapf(err, "could not update mcp server")
+	}
+
+	res, err := h.db.MCPServerGet(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get updated mcp server")
+	}
+	log.WithField("mcp_server", res).Debugf("Updated mcp server. mcp_server_id: %s", res.ID)
+	h.notifyHandler.PublishWebhookEvent(ctx, res.CustomerID, mcpserver.EventTypeUpdated, res)
+
+	return res, nil
+}
+
+// validateNameAvailable returns an AlreadyExists error if the customer
+// already owns another active mcp server or a custom tool with the given
+// name. Both are referenced by name from AI.ToolNames, so the names share
+// one namespace per customer.
+func (h *mcpServerHandler) validateNameAvailable(ctx context.Context, customerID uuid.UUID, exceptID uuid.UUID, name string) error {
+	filters := map[mcpserver.Field]any{
+		mcpserver.FieldCustomerID: customerID,
+		mcpserver.FieldName:       name,
+		mcpserver.FieldDeleted:    false,
+	}
+
+	tmp, err := h.db.MCPServerList(ctx, 1, "", filters)
+	if err != nil {
+		return errors.Wrapf(err, "could not check the mcp server name")
+	}
+
+	if len(tmp) > 0 && tmp[0].ID != exceptID {
+		return cerrors.AlreadyExists(
+			commonoutline.ServiceNameAIManager,
+			"MCP_SERVER_NAME_EXISTS",
+			"An MCP server with the same name already exists.",
+		)
+	}
+
+	customToolFilters := map[customtool.Field]any{
+		customtool.FieldCustomerID: customerID,
+		customtool.FieldName:       name,
+		customtool.FieldDeleted:    false,
+	}
+
+	customTools, err := h.db.CustomToolList(ctx, 1, "", customToolFilters)
+	if err != nil {
+		return errors.Wrapf(err, "could not check the custom tool name")
+	}
+
+	if len(customTools) > 0 {
+		return cerrors.AlreadyExists(
+			commonoutline.ServiceNameAIManager,
+			"MCP_SERVER_NAME_EXISTS",
+			"A custom tool with the same name already exists.",
+		)
+	}
+
+	return nil
+}
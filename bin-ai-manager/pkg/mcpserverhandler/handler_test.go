@@ -0,0 +1,291 @@
+package mcpserverhandler
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	"monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/notifyhandler"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+
+	"monorepo/bin-ai-manager/models/customtool"
+	"monorepo/bin-ai-manager/models/mcpserver"
+	"monorepo/bin-ai-manager/pkg/dbhandler"
+)
+
+func Test_Create(t *testing.T) {
+	customerID := uuid.FromStringOrNil("c1d3e5f7-8e21-11f1-9a0b-1c3e5a7c9e11")
+	mcpServerID := uuid.FromStringOrNil("c205a7c9-8e21-11f1-8b1c-3e5a7c9e1a22")
+
+	tests := []struct {
+		name string
+
+		customerID   uuid.UUID
+		serverName   string
+		detail       string
+		transport    mcpserver.Transport
+		url          string
+		headers      map[string]string
+		timeout      int
+		allowedTools []string
+
+		responseUUID      uuid.UUID
+		responseMCPServer *mcpserver.MCPServer
+
+		expectMCPServer *mcpserver.MCPServer
+	}{
+		{
+			name: "normal",
+
+			customerID: customerID,
+			serverName: "crm",
+			detail:     "internal crm",
+			transport:  mcpserver.TransportStreamableHTTP,
+			url:        "https://mcp.example.com/mcp",
+			headers: map[string]string{
+				"Authorization": "Bearer token",
+			},
+			timeout:      5000,
+			allowedTools: []string{"lookup_order"},
+
+			responseUUID: mcpServerID,
+			responseMCPServer: &mcpserver.MCPServer{
+				Identity: identity.Identity{
+					ID:         mcpServerID,
+					CustomerID: customerID,
+				},
+			},
+
+			expectMCPServer: &mcpserver.MCPServer{
+				Identity: identity.Identity{
+					ID:         mcpServerID,
+					CustomerID: customerID,
+				},
+				Name:      "crm",
+				Detail:    "internal crm",
+				Transport: mcpserver.TransportStreamableHTTP,
+				URL:       "https://mcp.example.com/mcp",
+				Headers: map[string]string{
+					"Authorization": "Bearer token",
+				},
+				Timeout:      5000,
+				AllowedTools: []string{"lookup_order"},
+			},
+		},
+		{
+			name: "default timeout",
+
+			customerID: customerID,
+			serverName: "docs",
+			transport:  mcpserver.TransportSSE,
+			url:        "https://mcp.example.com/sse",
+
+			responseUUID: mcpServerID,
+			responseMCPServer: &mcpserver.MCPServer{
+				Identity: identity.Identity{
+					ID:         mcpServerID,
+					CustomerID: customerID,
+				},
+			},
+
+			expectMCPServer: &mcpserver.MCPServer{
+				Identity: identity.Identity{
+					ID:         mcpServerID,
+					CustomerID: customerID,
+				},
+				Name:      "docs",
+				Transport: mcpserver.TransportSSE,
+				URL:       "https://mcp.example.com/sse",
+				Timeout:   mcpserver.TimeoutDefault,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+
+			h := &mcpServerHandler{
+				utilHandler:   mockUtil,
+				notifyHandler: mockNotify,
+				db:            mockDB,
+			}
+
+			ctx := context.Background()
+
+			mockDB.EXPECT().MCPServerList(ctx, uint64(1), "", map[mcpserver.Field]any{
+				mcpserver.FieldCustomerID: tt.customerID,
+				mcpserver.FieldName:       tt.serverName,
+				mcpserver.FieldDeleted:    false,
+			}).Return([]*mcpserver.MCPServer{}, nil)
+			mockDB.EXPECT().CustomToolList(ctx, uint64(1), "", map[customtool.Field]any{
+				customtool.FieldCustomerID: tt.customerID,
+				customtool.FieldName:       tt.serverName,
+				customtool.FieldDeleted:    false,
+			}).Return([]*customtool.CustomTool{}, nil)
+			mockUtil.EXPECT().UUIDCreate().Return(tt.responseUUID)
+			mockDB.EXPECT().MCPServerCreate(ctx, tt.expectMCPServer).Return(nil)
+			mockDB.EXPECT().MCPServerGet(ctx, tt.responseUUID).Return(tt.responseMCPServer, nil)
+			mockNotify.EXPECT().PublishWebhookEvent(ctx, tt.responseMCPServer.CustomerID, mcpserver.EventTypeCreated, tt.responseMCPServer)
+
+			res, err := h.Create(ctx, tt.customerID, tt.serverName, tt.detail, tt.transport, tt.url, tt.headers, tt.timeout, tt.allowedTools)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.responseMCPServer) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.responseMCPServer, res)
+			}
+		})
+	}
+}
+
+func Test_Create_error(t *testing.T) {
+	customerID := uuid.FromStringOrNil("e4a6c8e0-8e21-11f1-b2c3-5a7c9e1a3c33")
+
+	tests := []struct {
+		name string
+
+		serverName string
+		url        string
+
+		responseMCPServers []*mcpserver.MCPServer
+		responseCustomTool []*customtool.CustomTool
+	}{
+		{
+			name: "name with underscore",
+
+			serverName: "my_crm",
+			url:        "https://mcp.example.com/mcp",
+		},
+		{
+			name: "private url",
+
+			serverName: "crm",
+			url:        "https://192.168.0.10/mcp",
+		},
+		{
+			name: "name already exists",
+
+			serverName: "crm",
+			url:        "https://mcp.example.com/mcp",
+
+			responseMCPServers: []*mcpserver.MCPServer{
+				{
+					Identity: identity.Identity{
+						ID: uuid.FromStringOrNil("e4d8eb02-8e21-11f1-8d4e-7c9e1a3c5e44"),
+					},
+				},
+			},
+		},
+		{
+			name: "custom tool with the same name",
+
+			serverName: "crm",
+			url:        "https://mcp.example.com/mcp",
+
+			responseMCPServers: []*mcpserver.MCPServer{},
+			responseCustomTool: []*customtool.CustomTool{
+				{
+					Identity: identity.Identity{
+						ID: uuid.FromStringOrNil("e50b0d24-8e21-11f1-a85f-9e1a3c5e7a55"),
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+
+			h := &mcpServerHandler{
+				utilHandler: mockUtil,
+				db:          mockDB,
+			}
+
+			ctx := context.Background()
+
+			if tt.responseMCPServers != nil {
+				mockDB.EXPECT().MCPServerList(ctx, uint64(1), "", gomock.Any()).Return(tt.responseMCPServers, nil)
+			}
+			if tt.responseCustomTool != nil {
+				mockDB.EXPECT().CustomToolList(ctx, uint64(1), "", gomock.Any()).Return(tt.responseCustomTool, nil)
+			}
+
+			_, err := h.Create(ctx, customerID, tt.serverName, "", mcpserver.TransportStreamableHTTP, tt.url, nil, 0, nil)
+			if err == nil {
+				t.Errorf("Wrong match. expect: error, got: ok")
+			}
+		})
+	}
+}
+
+func Test_Update(t *testing.T) {
+	customerID := uuid.FromStringOrNil("f1a3c5e7-8e21-11f1-9e6a-1a3c5e7a9c66")
+	mcpServerID := uuid.FromStringOrNil("f1d5e709-8e21-11f1-8f7b-3c5e7a9c1e77")
+
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+	mockDB := dbhandler.NewMockDBHandler(mc)
+
+	h := &mcpServerHandler{
+		notifyHandler: mockNotify,
+		db:            mockDB,
+	}
+
+	ctx := context.Background()
+
+	current := &mcpserver.MCPServer{
+		Identity: identity.Identity{
+			ID:         mcpServerID,
+			CustomerID: customerID,
+		},
+		Name: "crm",
+	}
+	updated := &mcpserver.MCPServer{
+		Identity: identity.Identity{
+			ID:         mcpServerID,
+			CustomerID: customerID,
+		},
+		Name:    "crm",
+		Timeout: 2000,
+	}
+
+	mockDB.EXPECT().MCPServerGet(ctx, mcpServerID).Return(current, nil)
+	mockDB.EXPECT().MCPServerUpdate(ctx, mcpServerID, map[mcpserver.Field]any{
+		mcpserver.FieldName:         "crm",
+		mcpserver.FieldDetail:       "",
+		mcpserver.FieldTransport:    mcpserver.TransportSSE,
+		mcpserver.FieldURL:          "https://mcp.example.com/sse",
+		mcpserver.FieldHeaders:      map[string]string(nil),
+		mcpserver.FieldTimeout:      2000,
+		mcpserver.FieldAllowedTools: []string(nil),
+	}).Return(nil)
+	mockDB.EXPECT().MCPServerGet(ctx, mcpServerID).Return(updated, nil)
+	mockNotify.EXPECT().PublishWebhookEvent(ctx, customerID, mcpserver.EventTypeUpdated, updated)
+
+	res, err := h.Update(ctx, mcpServerID, "crm", "", mcpserver.TransportSSE, "https://mcp.example.com/sse", nil, 2000, nil)
+	if err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+
+	if !reflect.DeepEqual(res, updated) {
+		t.Errorf("Wrong match.\nexpect: %v\ngot: %v", updated, res)
+	}
+}
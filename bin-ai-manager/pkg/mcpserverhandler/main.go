@@ -0,0 +1,75 @@
+package mcpserverhandler
+
+//go:generate mockgen -package mcpserverhandler -destination ./mock_main.go -source main.go -build_flags=-mod=mod
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"monorepo/bin-common-handler/pkg/notifyhandler"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+
+	"monorepo/bin-ai-manager/models/mcpserver"
+	"monorepo/bin-ai-manager/models/tool"
+	"monorepo/bin-ai-manager/pkg/dbhandler"
+	"monorepo/bin-ai-manager/pkg/safehttp"
+)
+
+// MCPServerHandler provides CRUD operations for MCPServer resources and
+// acts as the MCP client that discovers and calls the servers' tools.
+type MCPServerHandler interface {
+	Create(
+		ctx context.Context,
+		customerID uuid.UUID,
+		name string,
+		detail string,
+		transport mcpserver.Transport,
+		url string,
+		headers map[string]string,
+		timeout int,
+		allowedTools []string,
+	) (*mcpserver.MCPServer, error)
+	Get(ctx context.Context, id uuid.UUID) (*mcpserver.MCPServer, error)
+	List(ctx context.Context, size uint64, token string, filters map[mcpserver.Field]any) ([]*mcpserver.MCPServer, error)
+	Delete(ctx context.Context, id uuid.UUID) (*mcpserver.MCPServer, error)
+	Update(
+		ctx context.Context,
+		id uuid.UUID,
+		name string,
+		detail string,
+		transport mcpserver.Transport,
+		url string,
+		headers map[string]string,
+		timeout int,
+		allowedTools []string,
+	) (*mcpserver.MCPServer, error)
+
+	GetByName(ctx context.Context, customerID uuid.UUID, name string) (*mcpserver.MCPServer, error)
+	ListTools(ctx context.Context, s *mcpserver.MCPServer) ([]tool.Tool, error)
+	Execute(ctx context.Context, s *mcpserver.MCPServer, toolName string, arguments string) (string, error)
+}
+
+type mcpServerHandler struct {
+	utilHandler   utilhandler.UtilHandler
+	notifyHandler notifyhandler.NotifyHandler
+	db            dbhandler.DBHandler
+
+	httpClient *http.Client
+}
+
+// NewMCPServerHandler creates a new MCPServerHandler
+func NewMCPServerHandler(
+	notifyHandler notifyhandler.NotifyHandler,
+	db dbhandler.DBHandler,
+) MCPServerHandler {
+	return &mcpServerHandler{
+		utilHandler:   utilhandler.NewUtilHandler(),
+		notifyHandler: notifyHandler,
+		db:            db,
+
+		httpClient: safehttp.NewClient(time.Duration(mcpserver.TimeoutMax) * time.Millisecond),
+	}
+}
@@ -0,0 +1,96 @@
+package mcpserverhandler
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"monorepo/bin-ai-manager/models/mcpserver"
+	"monorepo/bin-ai-manager/models/tool"
+	"monorepo/bin-ai-manager/pkg/safehttp"
+)
+
+// regName matches a server name. Underscores are not allowed so the
+// "<name>__<tool>" form of the exposed tool names can always be split
+// unambiguously, and the length leaves room for the tool name within the
+// 64 chars every LLM provider accepts.
+var regName = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9]{0,31}$`)
+
+// regHeaderName matches an HTTP header field name (RFC 7230 token).
+var regHeaderName = regexp.MustCompile("^[!#$%&'*+.^_`|~0-9A-Za-z-]+$")
+
+// reservedHeaders are set by the MCP client on every request and can not be
+// overridden by the customer. Keys are in canonical form.
+var reservedHeaders = map[string]bool{
+	"Host":              true,
+	"Content-Length":    true,
+	"Content-Type":      true,
+	"Transfer-Encoding": true,
+	"Connection":        true,
+	"Accept":            true,
+
+	http.CanonicalHeaderKey(headerSessionID):       true,
+	http.CanonicalHeaderKey(headerProtocolVersion): true,
+}
+
+const (
+	maxHeaders      = 20
+	maxAllowedTools = 100
+	maxToolNameLen  = 64
+)
+
+// validateMCPServer checks the static constraints of an MCP server.
+// Name uniqueness within the customer is checked separately because it
+// needs the database.
+func validateMCPServer(
+	name string,
+	transport mcpserver.Transport,
+	url string,
+	headers map[string]string,
+	timeout int,
+	allowedTools []string,
+) error {
+	if !regName.MatchString(name) {
+		return fmt.Errorf("invalid name: %q. must start with a letter and contain only letters and digits (max 32)", name)
+	}
+	if tool.ToolName(name) == tool.ToolNameAll {
+		return fmt.Errorf("invalid name: %q is reserved", name)
+	}
+
+	switch transport {
+	case mcpserver.TransportStreamableHTTP, mcpserver.TransportSSE:
+	default:
+		return fmt.Errorf("invalid transport: %q", transport)
+	}
+
+	if err := safehttp.ValidateURL(url); err != nil {
+		return fmt.Errorf("invalid url: %w", err)
+	}
+
+	if len(headers) > maxHeaders {
+		return fmt.Errorf("too many headers. max: %d", maxHeaders)
+	}
+	for k := range headers {
+		if !regHeaderName.MatchString(k) {
+			return fmt.Errorf("invalid header name: %q", k)
+		}
+		if reservedHeaders[http.CanonicalHeaderKey(k)] {
+			return fmt.Errorf("header %q is reserved", k)
+		}
+	}
+
+	if timeout < 0 || timeout > mcpserver.TimeoutMax {
+		return fmt.Errorf("invalid timeout: %d. must be between 0 and %d milliseconds", timeout, mcpserver.TimeoutMax)
+	}
+
+	if len(allowedTools) > maxAllowedTools {
+		return fmt.Errorf("too many allowed_tools. max: %d", maxAllowedTools)
+	}
+	for _, t := range allowedTools {
+		if t == "" || len(t) > maxToolNameLen {
+			return fmt.Errorf("invalid allowed tool name: %q", t)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package mcpserverhandler
+
+import (
+	"strings"
+	"testing"
+
+	"monorepo/bin-ai-manager/models/mcpserver"
+)
+
+func Test_validateMCPServer(t *testing.T) {
+	tests := []struct {
+		name string
+
+		serverName   string
+		transport    mcpserver.Transport
+		url          string
+		headers      map[string]string
+		timeout      int
+		allowedTools []string
+
+		expectErr bool
+	}{
+		{
+			name: "normal",
+
+			serverName: "crm",
+			transport:  mcpserver.TransportStreamableHTTP,
+			url:        "https://mcp.example.com/mcp",
+			headers: map[string]string{
+				"Authorization": "Bearer token",
+			},
+			timeout:      5000,
+			allowedTools: []string{"lookup_order", "read_resource"},
+		},
+		{
+			name: "name with underscore",
+
+			serverName: "my_crm",
+			transport:  mcpserver.TransportStreamableHTTP,
+			url:        "https://mcp.example.com/mcp",
+			expectErr:  true,
+		},
+		{
+			name: "name too long",
+
+			serverName: "a" + strings.Repeat("b", 32),
+			transport:  mcpserver.TransportStreamableHTTP,
+			url:        "https://mcp.example.com/mcp",
+			expectErr:  true,
+		},
+		{
+			name: "all selector",
+
+			serverName: "all",
+			transport:  mcpserver.TransportStreamableHTTP,
+			url:        "https://mcp.example.com/mcp",
+			expectErr:  true,
+		},
+		{
+			name: "invalid transport",
+
+			serverName: "crm",
+			transport:  "stdio",
+			url:        "https://mcp.example.com/mcp",
+			expectErr:  true,
+		},
+		{
+			name: "http url",
+
+			serverName: "crm",
+			transport:  mcpserver.TransportSSE,
+			url:        "http://mcp.example.com/sse",
+			expectErr:  true,
+		},
+		{
+			name: "reserved header",
+
+			serverName: "crm",
+			transport:  mcpserver.TransportStreamableHTTP,
+			url:        "https://mcp.example.com/mcp",
+			headers: map[string]string{
+				"mcp-session-id": "forged",
+			},
+			expectErr: true,
+		},
+		{
+			name: "timeout too long",
+
+			serverName: "crm",
+			transport:  mcpserver.TransportStreamableHTTP,
+			url:        "https://mcp.example.com/mcp",
+			timeout:    60001,
+			expectErr:  true,
+		},
+		{
+			name: "empty allowed tool",
+
+			serverName:   "crm",
+			transport:    mcpserver.TransportStreamableHTTP,
+			url:          "https://mcp.example.com/mcp",
+			allowedTools: []string{""},
+			expectErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateMCPServer(tt.serverName, tt.transport, tt.url, tt.headers, tt.timeout, tt.allowedTools)
+			if (err != nil) != tt.expectErr {
+				t.Errorf("Wrong match. expect error: %v, got: %v", tt.expectErr, err)
+			}
+		})
+	}
+}
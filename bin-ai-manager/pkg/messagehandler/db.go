@@ -31,6 +31,13 @@ func (h *messageHandler) Create(
 		id = h.utilHandler.UUIDCreate()
 	}
 
+	// redact before persisting, so the personal data never reaches the database
+	// nor the llm engine, which is given the stored messages.
+	content, toolCalls, err := h.redact(ctx, aicallID, role, content, toolCalls)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not redact the message")
+	}
+
 	tmpToolCalls := toolCalls
 	if tmpToolCalls == nil {
 		tmpToolCalls = []message.ToolCall{}
@@ -62,6 +69,9 @@ func (h *messageHandler) Create(
 		ToolCalls:  tmpToolCalls,
 		ToolCallID: toolCallID,
 
+		EngineModel: p.engineModel,
+		Usage:       p.usage,
+
 		PipecatcallID:  p.pipecatcallID,
 		DeliveryStatus: p.deliveryStatus,
 
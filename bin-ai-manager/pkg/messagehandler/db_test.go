@@ -3,6 +3,7 @@ package messagehandler
 import (
 	"context"
 	"monorepo/bin-ai-manager/models/message"
+	"monorepo/bin-ai-manager/models/usage"
 	"monorepo/bin-ai-manager/pkg/dbhandler"
 	"monorepo/bin-common-handler/models/identity"
 	"monorepo/bin-common-handler/pkg/notifyhandler"
@@ -28,6 +29,7 @@ func Test_Create(t *testing.T) {
 		content    string
 		toolCalls  []message.ToolCall
 		toolCallID string
+		usage      usage.Usage
 
 		responseUUID uuid.UUID
 
@@ -54,6 +56,11 @@ func Test_Create(t *testing.T) {
 				},
 			},
 			toolCallID: "62ed2280-943b-11f0-b762-4f0b5a0bd115",
+			usage: usage.Usage{
+				PromptTokens:     1200,
+				CompletionTokens: 40,
+				TTSSeconds:       3.5,
+			},
 
 			responseUUID: uuid.FromStringOrNil("751956c2-8482-11f0-846a-c71f69f8c722"),
 
@@ -81,6 +88,12 @@ func Test_Create(t *testing.T) {
 				},
 				ToolCallID: "62ed2280-943b-11f0-b762-4f0b5a0bd115",
 
+				Usage: usage.Usage{
+					PromptTokens:     1200,
+					CompletionTokens: 40,
+					TTSSeconds:       3.5,
+				},
+
 				DeliveryStatus: message.DeliveryStatusDelivered,
 			},
 		},
@@ -124,6 +137,9 @@ func Test_Create(t *testing.T) {
 			if tt.activeAIID != uuid.Nil {
 				opts = append(opts, WithActiveAIID(tt.activeAIID))
 			}
+			if !tt.usage.IsEmpty() {
+				opts = append(opts, WithUsage(tt.usage))
+			}
 			res, err := h.Create(ctx, uuid.Nil, tt.customerID, tt.aicallID, tt.activeflowID, tt.direction, tt.role, tt.content, tt.toolCalls, tt.toolCallID, opts...)
 			if err != nil {
 				t.Errorf("Wrong match. expect: ok, got: %v", err)
@@ -3,8 +3,10 @@ package messagehandler
 import (
 	"context"
 	"encoding/json"
+	"monorepo/bin-ai-manager/models/ai"
 	"monorepo/bin-ai-manager/models/aicall"
 	"monorepo/bin-ai-manager/models/message"
+	"monorepo/bin-ai-manager/models/usage"
 	identity "monorepo/bin-common-handler/models/identity"
 	cvmedia "monorepo/bin-conversation-manager/models/media"
 	pmmessage "monorepo/bin-pipecat-manager/models/message"
@@ -124,7 +126,8 @@ func (h *messageHandler) EventPMMessageUserTranscription(ctx context.Context, ev
 
 	activeAIID := h.resolveActiveAIID(ctx, evt.PipecatcallReferenceID)
 	tmp, err := h.Create(ctx, uuid.Nil, evt.CustomerID, evt.PipecatcallReferenceID, evt.ActiveflowID, message.DirectionOutgoing, message.RoleUser, evt.Text, nil, "",
-		WithActiveAIID(activeAIID))
+		WithActiveAIID(activeAIID),
+		WithUsage(usage.FromPipecatcall(evt.Usage)))
 	if err != nil {
 		log.Errorf("Could not create the message. err: %v", err)
 		return
@@ -148,7 +151,9 @@ func (h *messageHandler) EventPMMessageBotLLM(ctx context.Context, evt *pmmessag
 	if evt.PipecatcallReferenceType != pmpipecatcall.ReferenceTypeAICall {
 		tmp, err := h.Create(ctx, evt.ID, evt.CustomerID, evt.PipecatcallReferenceID, evt.ActiveflowID,
 			message.DirectionIncoming, message.RoleAssistant, evt.Text, nil, "",
-			WithInReplyToMessageID(evt.InReplyToMessageID))
+			WithInReplyToMessageID(evt.InReplyToMessageID),
+			WithEngineModel(ai.EngineModel(evt.EngineModel)),
+			WithUsage(usage.FromPipecatcall(evt.Usage)))
 		if err != nil {
 			log.Errorf("Could not create the message. err: %v", err)
 			return
@@ -170,7 +175,9 @@ func (h *messageHandler) EventPMMessageBotLLM(ctx context.Context, evt *pmmessag
 		tmp, errCreate := h.Create(ctx, evt.ID, evt.CustomerID, evt.PipecatcallReferenceID, evt.ActiveflowID,
 			message.DirectionIncoming, message.RoleAssistant, evt.Text, nil, "",
 			WithActiveAIID(activeAIID),
-			WithInReplyToMessageID(evt.InReplyToMessageID))
+			WithInReplyToMessageID(evt.InReplyToMessageID),
+			WithEngineModel(ai.EngineModel(evt.EngineModel)),
+			WithUsage(usage.FromPipecatcall(evt.Usage)))
 		if errCreate != nil {
 			log.Errorf("Could not create the message. err: %v", errCreate)
 			return
@@ -197,7 +204,9 @@ func (h *messageHandler) EventPMMessageBotLLM(ctx context.Context, evt *pmmessag
 		WithPipecatcallID(evt.PipecatcallID),
 		WithDeliveryStatus(message.DeliveryStatusPending),
 		WithActiveAIID(activeAIID),
-		WithInReplyToMessageID(evt.InReplyToMessageID))
+		WithInReplyToMessageID(evt.InReplyToMessageID),
+		WithEngineModel(ai.EngineModel(evt.EngineModel)),
+		WithUsage(usage.FromPipecatcall(evt.Usage)))
 	if err != nil {
 		log.Errorf("Could not create the message. err: %v", err)
 		return
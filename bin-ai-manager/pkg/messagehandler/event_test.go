@@ -10,6 +10,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus/testutil"
 	"go.uber.org/mock/gomock"
 
+	"monorepo/bin-ai-manager/models/ai"
 	"monorepo/bin-ai-manager/models/aicall"
 	"monorepo/bin-ai-manager/models/message"
 	"monorepo/bin-ai-manager/models/team"
@@ -686,6 +687,55 @@ func TestEventPMMessageBotLLM_forwards_pre_generated_id(t *testing.T) {
 	h.EventPMMessageBotLLM(context.Background(), evt)
 }
 
+func TestEventPMMessageBotLLM_records_engine_model(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	referenceID := uuid.Must(uuid.NewV4())
+	pipecatcallID := uuid.Must(uuid.NewV4())
+
+	// The call failed over from its primary engine; the message must record
+	// the fallback engine that actually produced the response.
+	evt := &pmmessage.Message{
+		PipecatcallID:            pipecatcallID,
+		PipecatcallReferenceType: pmpipecatcall.ReferenceTypeAICall,
+		PipecatcallReferenceID:   referenceID,
+		EngineModel:              "gemini.gemini-2.5-flash",
+		Text:                     "Bot response",
+	}
+	evt.CustomerID = uuid.Must(uuid.NewV4())
+
+	mockDB := dbhandler.NewMockDBHandler(ctrl)
+	mockNotify := notifyhandler.NewMockNotifyHandler(ctrl)
+	mockReq := requesthandler.NewMockRequestHandler(ctrl)
+
+	voiceAIcall := &aicall.AIcall{
+		PipecatcallID: pipecatcallID,
+		ReferenceType: aicall.ReferenceTypeCall,
+	}
+	mockReq.EXPECT().AIV1AIcallGet(gomock.Any(), referenceID).Return(voiceAIcall, nil).Times(1)
+
+	mockDB.EXPECT().MessageCreate(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, m *message.Message) error {
+			if m.EngineModel != ai.EngineModelGeminiGemini2Dot5Flash {
+				t.Errorf("Wrong match. expect: %s, got: %s", ai.EngineModelGeminiGemini2Dot5Flash, m.EngineModel)
+			}
+			return nil
+		},
+	).Times(1)
+	mockDB.EXPECT().MessageGet(gomock.Any(), gomock.Any()).Return(&message.Message{}, nil).Times(1)
+	mockNotify.EXPECT().PublishWebhookEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	h := &messageHandler{
+		db:            mockDB,
+		notifyHandler: mockNotify,
+		reqHandler:    mockReq,
+		utilHandler:   utilhandler.NewUtilHandler(),
+	}
+
+	h.EventPMMessageBotLLM(context.Background(), evt)
+}
+
 func TestEventPMMessageBotLLMIntermediate(t *testing.T) {
 	tests := []struct {
 		name          string
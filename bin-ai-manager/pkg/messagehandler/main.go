@@ -4,11 +4,14 @@ package messagehandler
 
 import (
 	"context"
+	"monorepo/bin-ai-manager/models/ai"
 	"monorepo/bin-ai-manager/models/message"
+	"monorepo/bin-ai-manager/models/usage"
 	"monorepo/bin-ai-manager/pkg/dbhandler"
 	"monorepo/bin-ai-manager/pkg/engine_dialogflow_handler"
 	"monorepo/bin-ai-manager/pkg/engine_openai_handler"
 	"monorepo/bin-ai-manager/pkg/participanthandler"
+	"monorepo/bin-ai-manager/pkg/redactionhandler"
 	"monorepo/bin-common-handler/pkg/notifyhandler"
 	"monorepo/bin-common-handler/pkg/requesthandler"
 	"monorepo/bin-common-handler/pkg/utilhandler"
@@ -28,6 +31,8 @@ type createParams struct {
 	deliveryStatus     message.DeliveryStatus
 	activeAIID         uuid.UUID
 	inReplyToMessageID uuid.UUID
+	engineModel        ai.EngineModel
+	usage              usage.Usage
 }
 
 // WithPipecatcallID sets the pipecatcall ID on createParams.
@@ -51,6 +56,16 @@ func WithInReplyToMessageID(id uuid.UUID) CreateOption {
 	return func(p *createParams) { p.inReplyToMessageID = id }
 }
 
+// WithEngineModel sets the LLM engine model that generated the message.
+func WithEngineModel(engineModel ai.EngineModel) CreateOption {
+	return func(p *createParams) { p.engineModel = engineModel }
+}
+
+// WithUsage sets the AI provider usage attributed to the message.
+func WithUsage(u usage.Usage) CreateOption {
+	return func(p *createParams) { p.usage = u }
+}
+
 type MessageHandler interface {
 	Create(
 		ctx context.Context,
@@ -85,6 +100,7 @@ type messageHandler struct {
 	engineOpenaiHandler     engine_openai_handler.EngineOpenaiHandler
 	engineDialogflowHandler engine_dialogflow_handler.EngineDialogflowHandler
 	participantHandler      participanthandler.ParticipantHandler
+	redactionHandler        redactionhandler.RedactionHandler
 }
 
 var (
@@ -114,6 +130,7 @@ func NewMessageHandler(
 	engineOpenaiHandler engine_openai_handler.EngineOpenaiHandler,
 	engineDialogflowHandler engine_dialogflow_handler.EngineDialogflowHandler,
 	participantHandler participanthandler.ParticipantHandler,
+	redactionHandler redactionhandler.RedactionHandler,
 ) MessageHandler {
 
 	return &messageHandler{
@@ -125,5 +142,6 @@ func NewMessageHandler(
 		engineOpenaiHandler:     engineOpenaiHandler,
 		engineDialogflowHandler: engineDialogflowHandler,
 		participantHandler:      participantHandler,
+		redactionHandler:        redactionHandler,
 	}
 }
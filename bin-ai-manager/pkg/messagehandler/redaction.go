@@ -0,0 +1,44 @@
+package messagehandler
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+
+	"monorepo/bin-ai-manager/models/message"
+)
+
+// redact returns the content and the tool calls with the personal data
+// redacted by the aicall's redaction config.
+// The system messages are kept as they are, so the AI's instructions are
+// never altered.
+func (h *messageHandler) redact(ctx context.Context, aicallID uuid.UUID, role message.Role, content string, toolCalls []message.ToolCall) (string, []message.ToolCall, error) {
+	if h.redactionHandler == nil {
+		return content, toolCalls, nil
+	}
+
+	switch role {
+	case message.RoleUser, message.RoleAssistant, message.RoleTool, message.RoleFunction:
+	default:
+		return content, toolCalls, nil
+	}
+
+	resContent, err := h.redactionHandler.Redact(ctx, aicallID, content)
+	if err != nil {
+		return "", nil, errors.Wrapf(err, "could not redact the content")
+	}
+
+	resToolCalls := make([]message.ToolCall, 0, len(toolCalls))
+	for _, tc := range toolCalls {
+		arguments, err := h.redactionHandler.Redact(ctx, aicallID, tc.Function.Arguments)
+		if err != nil {
+			return "", nil, errors.Wrapf(err, "could not redact the tool call arguments")
+		}
+
+		tc.Function.Arguments = arguments
+		resToolCalls = append(resToolCalls, tc)
+	}
+
+	return resContent, resToolCalls, nil
+}
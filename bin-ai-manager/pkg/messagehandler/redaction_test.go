@@ -0,0 +1,163 @@
+package messagehandler
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+
+	"monorepo/bin-ai-manager/models/message"
+	"monorepo/bin-ai-manager/pkg/dbhandler"
+	"monorepo/bin-ai-manager/pkg/redactionhandler"
+	"monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/notifyhandler"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+)
+
+func Test_Create_redaction(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		customerID uuid.UUID
+		aicallID   uuid.UUID
+		role       message.Role
+		content    string
+		toolCalls  []message.ToolCall
+
+		responseUUID     uuid.UUID
+		responseRedacted map[string]string
+
+		expectMessage *message.Message
+	}{
+		{
+			name: "user message",
+
+			customerID: uuid.FromStringOrNil("3c1e6f2a-a9fb-11f0-9b1d-6b3f0e2a8c41"),
+			aicallID:   uuid.FromStringOrNil("3c4f8d2e-a9fb-11f0-a3f0-1f7c2e9b6d58"),
+			role:       message.RoleUser,
+			content:    "my card is 4111 1111 1111 1111",
+
+			responseUUID: uuid.FromStringOrNil("3c7d1e4a-a9fb-11f0-8e2c-9b5a1f3d7e60"),
+			responseRedacted: map[string]string{
+				"my card is 4111 1111 1111 1111": "my card is [CREDIT_CARD]",
+			},
+
+			expectMessage: &message.Message{
+				Identity: identity.Identity{
+					ID:         uuid.FromStringOrNil("3c7d1e4a-a9fb-11f0-8e2c-9b5a1f3d7e60"),
+					CustomerID: uuid.FromStringOrNil("3c1e6f2a-a9fb-11f0-9b1d-6b3f0e2a8c41"),
+				},
+				AIcallID:       uuid.FromStringOrNil("3c4f8d2e-a9fb-11f0-a3f0-1f7c2e9b6d58"),
+				Role:           message.RoleUser,
+				Content:        "my card is [CREDIT_CARD]",
+				ToolCalls:      []message.ToolCall{},
+				DeliveryStatus: message.DeliveryStatusDelivered,
+			},
+		},
+		{
+			name: "assistant tool call",
+
+			customerID: uuid.FromStringOrNil("3cab2f6e-a9fb-11f0-b4d7-2e8c5a1f9b30"),
+			aicallID:   uuid.FromStringOrNil("3cd8e1a2-a9fb-11f0-97c3-5f1b7e2d4a69"),
+			role:       message.RoleAssistant,
+			toolCalls: []message.ToolCall{
+				{
+					ID:   "call_1",
+					Type: message.ToolTypeFunction,
+					Function: message.FunctionCall{
+						Name:      "send_email",
+						Arguments: `{"to":"john@example.com"}`,
+					},
+				},
+			},
+
+			responseUUID: uuid.FromStringOrNil("3d05a7c4-a9fb-11f0-8a1e-7c3d9f2b5e81"),
+			responseRedacted: map[string]string{
+				"":                          "",
+				`{"to":"john@example.com"}`: `{"to":"[EMAIL_ZAHAYH]"}`,
+			},
+
+			expectMessage: &message.Message{
+				Identity: identity.Identity{
+					ID:         uuid.FromStringOrNil("3d05a7c4-a9fb-11f0-8a1e-7c3d9f2b5e81"),
+					CustomerID: uuid.FromStringOrNil("3cab2f6e-a9fb-11f0-b4d7-2e8c5a1f9b30"),
+				},
+				AIcallID: uuid.FromStringOrNil("3cd8e1a2-a9fb-11f0-97c3-5f1b7e2d4a69"),
+				Role:     message.RoleAssistant,
+				ToolCalls: []message.ToolCall{
+					{
+						ID:   "call_1",
+						Type: message.ToolTypeFunction,
+						Function: message.FunctionCall{
+							Name:      "send_email",
+							Arguments: `{"to":"[EMAIL_ZAHAYH]"}`,
+						},
+					},
+				},
+				DeliveryStatus: message.DeliveryStatusDelivered,
+			},
+		},
+		{
+			name: "system message is not redacted",
+
+			customerID: uuid.FromStringOrNil("3d32c9e6-a9fb-11f0-a5b8-3e9d1c7f2a40"),
+			aicallID:   uuid.FromStringOrNil("3d5fe0a8-a9fb-11f0-9c6d-8f2e4b1a7d53"),
+			role:       message.RoleSystem,
+			content:    "transfer the call to +1 415 555 0100",
+
+			responseUUID: uuid.FromStringOrNil("3d8c4f2a-a9fb-11f0-b1e9-6a7c3d5f8e92"),
+
+			expectMessage: &message.Message{
+				Identity: identity.Identity{
+					ID:         uuid.FromStringOrNil("3d8c4f2a-a9fb-11f0-b1e9-6a7c3d5f8e92"),
+					CustomerID: uuid.FromStringOrNil("3d32c9e6-a9fb-11f0-a5b8-3e9d1c7f2a40"),
+				},
+				AIcallID:       uuid.FromStringOrNil("3d5fe0a8-a9fb-11f0-9c6d-8f2e4b1a7d53"),
+				Role:           message.RoleSystem,
+				Content:        "transfer the call to +1 415 555 0100",
+				ToolCalls:      []message.ToolCall{},
+				DeliveryStatus: message.DeliveryStatusDelivered,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+			mockRedaction := redactionhandler.NewMockRedactionHandler(mc)
+
+			h := messageHandler{
+				utilHandler:      mockUtil,
+				db:               mockDB,
+				notifyHandler:    mockNotify,
+				redactionHandler: mockRedaction,
+			}
+			ctx := context.Background()
+
+			mockUtil.EXPECT().UUIDCreate().Return(tt.responseUUID)
+			for text, redacted := range tt.responseRedacted {
+				mockRedaction.EXPECT().Redact(ctx, tt.aicallID, text).Return(redacted, nil)
+			}
+			mockDB.EXPECT().MessageCreate(ctx, tt.expectMessage).Return(nil)
+			mockDB.EXPECT().MessageGet(ctx, tt.responseUUID).Return(tt.expectMessage, nil)
+			mockNotify.EXPECT().PublishWebhookEvent(ctx, tt.expectMessage.CustomerID, message.EventTypeMessageCreated, tt.expectMessage)
+
+			res, err := h.Create(ctx, uuid.Nil, tt.customerID, tt.aicallID, uuid.Nil, message.DirectionNone, tt.role, tt.content, tt.toolCalls, "")
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectMessage) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectMessage, res)
+			}
+		})
+	}
+}
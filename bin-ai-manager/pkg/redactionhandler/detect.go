@@ -0,0 +1,227 @@
+package redactionhandler
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"monorepo/bin-ai-manager/models/redaction"
+)
+
+// detection is a personal data found in a text.
+type detection struct {
+	entityType redaction.EntityType
+	start      int // byte offset of the first character
+	end        int // byte offset after the last character
+}
+
+var (
+	// the candidates are matched loosely, then trimmed to the longest valid
+	// prefix of their groups, e.g. a card number followed by its expiry date.
+	regCreditCard  = regexp.MustCompile(`\b\d(?:[ -]?\d){12,30}\b`)
+	regIBAN        = regexp.MustCompile(`(?i)\b[a-z]{2}\d{2}(?: ?[a-z0-9]){11,40}\b`)
+	regSSN         = regexp.MustCompile(`\b\d{3}[- ]?\d{2}[- ]?\d{4}\b`)
+	regEmail       = regexp.MustCompile(`[a-zA-Z0-9._%+\-]+@[a-zA-Z0-9.\-]+\.[a-zA-Z]{2,}`)
+	regPhoneNumber = regexp.MustCompile(`\+?\d[\d ().\-]{6,}\d\b`)
+)
+
+const (
+	creditCardDigitsMin  = 13
+	creditCardDigitsMax  = 19
+	ibanLengthMin        = 15
+	ibanLengthMax        = 34
+	phoneNumberDigitsMin = 8
+	phoneNumberDigitsMax = 15
+)
+
+// detect returns the personal data of the given entity types found in the text,
+// ordered by their position. When detections overlap, the one of the entity
+// type earlier in redaction.EntityTypes wins, so a card number is not also
+// reported as a phone number.
+func detect(text string, entityTypes []redaction.EntityType) []detection {
+	enabled := map[redaction.EntityType]bool{}
+	for _, t := range entityTypes {
+		enabled[t] = true
+	}
+
+	res := []detection{}
+	for _, t := range redaction.EntityTypes {
+		if !enabled[t] {
+			continue
+		}
+
+		for _, d := range detectEntityType(text, t) {
+			if overlaps(res, d) {
+				continue
+			}
+			res = append(res, d)
+		}
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].start < res[j].start
+	})
+	return res
+}
+
+func detectEntityType(text string, entityType redaction.EntityType) []detection {
+	switch entityType {
+	case redaction.EntityTypeCreditCard:
+		return detectByGroups(text, regCreditCard, entityType, isValidCreditCard)
+
+	case redaction.EntityTypeIBAN:
+		return detectByGroups(text, regIBAN, entityType, isValidIBAN)
+
+	case redaction.EntityTypeSSN:
+		return detectByMatch(text, regSSN, entityType, isValidSSN)
+
+	case redaction.EntityTypeEmail:
+		return detectByMatch(text, regEmail, entityType, nil)
+
+	case redaction.EntityTypePhoneNumber:
+		return detectByMatch(text, regPhoneNumber, entityType, isValidPhoneNumber)
+
+	default:
+		return nil
+	}
+}
+
+// detectByMatch returns the matches of the regexp which are valid.
+// A nil valid accepts every match.
+func detectByMatch(text string, reg *regexp.Regexp, entityType redaction.EntityType, valid func(string) bool) []detection {
+	res := []detection{}
+	for _, loc := range reg.FindAllStringIndex(text, -1) {
+		if valid != nil && !valid(text[loc[0]:loc[1]]) {
+			continue
+		}
+		res = append(res, detection{entityType: entityType, start: loc[0], end: loc[1]})
+	}
+
+	return res
+}
+
+// detectByGroups returns the longest valid prefix of each match of the regexp.
+// The prefix is cut at the match's separators, so the trailing groups which
+// are not part of the entity are dropped.
+func detectByGroups(text string, reg *regexp.Regexp, entityType redaction.EntityType, valid func(string) bool) []detection {
+	res := []detection{}
+	for _, loc := range reg.FindAllStringIndex(text, -1) {
+		candidate := text[loc[0]:loc[1]]
+
+		// ends of the candidate's groups, the whole candidate first
+		ends := []int{len(candidate)}
+		for i := len(candidate) - 1; i > 0; i-- {
+			if candidate[i] == ' ' || candidate[i] == '-' {
+				ends = append(ends, i)
+			}
+		}
+
+		for _, end := range ends {
+			if valid(candidate[:end]) {
+				res = append(res, detection{entityType: entityType, start: loc[0], end: loc[0] + end})
+				break
+			}
+		}
+	}
+
+	return res
+}
+
+func overlaps(detections []detection, d detection) bool {
+	for _, tmp := range detections {
+		if d.start < tmp.end && tmp.start < d.end {
+			return true
+		}
+	}
+	return false
+}
+
+// digits returns the digits of the given string.
+func digits(s string) string {
+	var sb strings.Builder
+	for _, c := range s {
+		if c >= '0' && c <= '9' {
+			sb.WriteRune(c)
+		}
+	}
+	return sb.String()
+}
+
+// isValidCreditCard returns true if the given string has a valid card number length and passes the Luhn check.
+func isValidCreditCard(s string) bool {
+	d := digits(s)
+	if len(d) < creditCardDigitsMin || len(d) > creditCardDigitsMax {
+		return false
+	}
+
+	return luhn(d)
+}
+
+// luhn returns true if the given digits pass the Luhn checksum.
+func luhn(d string) bool {
+	sum := 0
+	double := false
+	for i := len(d) - 1; i >= 0; i-- {
+		n := int(d[i] - '0')
+		if double {
+			n *= 2
+			if n > 9 {
+				n -= 9
+			}
+		}
+		sum += n
+		double = !double
+	}
+
+	return sum%10 == 0
+}
+
+// isValidIBAN returns true if the given string has a valid IBAN length and passes the ISO 13616 mod-97 check.
+func isValidIBAN(s string) bool {
+	iban := strings.ToUpper(strings.ReplaceAll(s, " ", ""))
+	if len(iban) < ibanLengthMin || len(iban) > ibanLengthMax {
+		return false
+	}
+
+	// move the country code and the check digits to the end, then convert
+	// the letters to numbers (A=10, ..., Z=35) and take the remainder of 97.
+	rearranged := iban[4:] + iban[:4]
+	remainder := 0
+	for _, c := range rearranged {
+		switch {
+		case c >= '0' && c <= '9':
+			remainder = (remainder*10 + int(c-'0')) % 97
+		case c >= 'A' && c <= 'Z':
+			remainder = (remainder*100 + int(c-'A'+10)) % 97
+		default:
+			return false
+		}
+	}
+
+	return remainder == 1
+}
+
+// isValidSSN returns true if the given string is a US social security number which could be issued.
+// The area can't be 000, 666 or 9xx, the group can't be 00 and the serial can't be 0000.
+func isValidSSN(s string) bool {
+	d := digits(s)
+	if len(d) != 9 {
+		return false
+	}
+
+	area, group, serial := d[:3], d[3:5], d[5:]
+	if area == "000" || area == "666" || area[0] == '9' {
+		return false
+	}
+	if group == "00" || serial == "0000" {
+		return false
+	}
+
+	return true
+}
+
+// isValidPhoneNumber returns true if the given string has the number of digits of a phone number.
+func isValidPhoneNumber(s string) bool {
+	d := digits(s)
+	return len(d) >= phoneNumberDigitsMin && len(d) <= phoneNumberDigitsMax
+}
@@ -0,0 +1,169 @@
+package redactionhandler
+
+import (
+	"reflect"
+	"testing"
+
+	"monorepo/bin-ai-manager/models/redaction"
+)
+
+func Test_detect(t *testing.T) {
+
+	tests := []struct {
+		name        string
+		text        string
+		entityTypes []redaction.EntityType
+
+		expectRes []string
+	}{
+		{
+			name:        "credit card",
+			text:        "my card is 4111 1111 1111 1111, thanks",
+			entityTypes: []redaction.EntityType{redaction.EntityTypeCreditCard},
+
+			expectRes: []string{"4111 1111 1111 1111"},
+		},
+		{
+			name:        "credit card followed by the expiry month",
+			text:        "4111-1111-1111-1111 12/27",
+			entityTypes: []redaction.EntityType{redaction.EntityTypeCreditCard},
+
+			expectRes: []string{"4111-1111-1111-1111"},
+		},
+		{
+			name:        "credit card failing the luhn check",
+			text:        "my card is 4111 1111 1111 1112",
+			entityTypes: []redaction.EntityType{redaction.EntityTypeCreditCard},
+
+			expectRes: []string{},
+		},
+		{
+			name:        "iban",
+			text:        "send it to DE89 3704 0044 0532 0130 00 please",
+			entityTypes: []redaction.EntityType{redaction.EntityTypeIBAN},
+
+			expectRes: []string{"DE89 3704 0044 0532 0130 00"},
+		},
+		{
+			name:        "iban without spaces",
+			text:        "iban: GB82WEST12345698765432",
+			entityTypes: []redaction.EntityType{redaction.EntityTypeIBAN},
+
+			expectRes: []string{"GB82WEST12345698765432"},
+		},
+		{
+			name:        "iban failing the mod-97 check",
+			text:        "send it to DE88 3704 0044 0532 0130 00",
+			entityTypes: []redaction.EntityType{redaction.EntityTypeIBAN},
+
+			expectRes: []string{},
+		},
+		{
+			name:        "ssn",
+			text:        "my ssn is 123-45-6789.",
+			entityTypes: []redaction.EntityType{redaction.EntityTypeSSN},
+
+			expectRes: []string{"123-45-6789"},
+		},
+		{
+			name:        "ssn which can't be issued",
+			text:        "my ssn is 666-45-6789.",
+			entityTypes: []redaction.EntityType{redaction.EntityTypeSSN},
+
+			expectRes: []string{},
+		},
+		{
+			name:        "email",
+			text:        "reach me at john.doe+test@example.com.",
+			entityTypes: []redaction.EntityType{redaction.EntityTypeEmail},
+
+			expectRes: []string{"john.doe+test@example.com"},
+		},
+		{
+			name:        "phone number",
+			text:        "call me at +1 (415) 555-0100 tomorrow",
+			entityTypes: []redaction.EntityType{redaction.EntityTypePhoneNumber},
+
+			expectRes: []string{"+1 (415) 555-0100"},
+		},
+		{
+			name:        "short number is not a phone number",
+			text:        "order 1234-567",
+			entityTypes: []redaction.EntityType{redaction.EntityTypePhoneNumber},
+
+			expectRes: []string{},
+		},
+		{
+			name: "card number is not reported as a phone number",
+			text: "card 4111 1111 1111 1111 and phone +821012345678",
+			entityTypes: []redaction.EntityType{
+				redaction.EntityTypePhoneNumber,
+				redaction.EntityTypeCreditCard,
+			},
+
+			expectRes: []string{"4111 1111 1111 1111", "+821012345678"},
+		},
+		{
+			name:        "entity type not enabled",
+			text:        "reach me at john.doe@example.com",
+			entityTypes: []redaction.EntityType{redaction.EntityTypeCreditCard},
+
+			expectRes: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := []string{}
+			for _, d := range detect(tt.text, tt.entityTypes) {
+				res = append(res, tt.text[d.start:d.end])
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_mask(t *testing.T) {
+
+	tests := []struct {
+		name       string
+		entityType redaction.EntityType
+		value      string
+
+		expectRes string
+	}{
+		{
+			name:       "credit card",
+			entityType: redaction.EntityTypeCreditCard,
+			value:      "4111 1111 1111 1111",
+
+			expectRes: "**** **** **** 1111",
+		},
+		{
+			name:       "iban",
+			entityType: redaction.EntityTypeIBAN,
+			value:      "GB82WEST12345698765432",
+
+			expectRes: "******************5432",
+		},
+		{
+			name:       "email",
+			entityType: redaction.EntityTypeEmail,
+			value:      "john@example.com",
+
+			expectRes: "j***@example.com",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := mask(tt.entityType, tt.value)
+			if res != tt.expectRes {
+				t.Errorf("Wrong match. expect: %s, got: %s", tt.expectRes, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package redactionhandler
+
+//go:generate mockgen -package redactionhandler -destination ./mock_main.go -source main.go -build_flags=-mod=mod
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"monorepo/bin-common-handler/pkg/requesthandler"
+
+	"monorepo/bin-ai-manager/models/ai"
+	"monorepo/bin-ai-manager/models/redaction"
+	"monorepo/bin-ai-manager/pkg/dbhandler"
+)
+
+// RedactionHandler redacts personal data from the aicall's texts before they
+// are sent to the LLM engine or stored, and restores the tokenized values for
+// the tools.
+//
+// The redaction config is frozen into the aicall's metadata when the aicall
+// starts (see ConfigGet), so the texts of an aicall are redacted the same way
+// for its whole life.
+type RedactionHandler interface {
+	ConfigGet(ctx context.Context, a *ai.AI) *redaction.Config
+
+	Redact(ctx context.Context, aicallID uuid.UUID, text string) (string, error)
+	Restore(ctx context.Context, aicallID uuid.UUID, text string) (string, error)
+}
+
+type redactionHandler struct {
+	reqHandler requesthandler.RequestHandler
+	db         dbhandler.DBHandler
+}
+
+var (
+	metricsNamespace = "ai_manager"
+
+	promRedactionEntityTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "redaction_entity_total",
+			Help:      "Total number of redacted personal data with entity type and mode.",
+		},
+		[]string{"entity_type", "mode"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(
+		promRedactionEntityTotal,
+	)
+}
+
+// NewRedactionHandler creates a new RedactionHandler
+func NewRedactionHandler(
+	reqHandler requesthandler.RequestHandler,
+	db dbhandler.DBHandler,
+) RedactionHandler {
+	return &redactionHandler{
+		reqHandler: reqHandler,
+		db:         db,
+	}
+}
@@ -0,0 +1,218 @@
+package redactionhandler
+
+import (
+	"context"
+	"crypto/sha256"
+	stderrors "errors"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"monorepo/bin-ai-manager/internal/config"
+	"monorepo/bin-ai-manager/models/ai"
+	"monorepo/bin-ai-manager/models/redaction"
+	"monorepo/bin-ai-manager/pkg/dbhandler"
+)
+
+const (
+	maskVisibleChars = 4   // number of trailing characters kept by the mask mode
+	tokenLength      = 6   // number of letters of the token's suffix
+	maskChar         = '*' // character replacing the masked characters
+
+	tokenTTLMin    = time.Hour * 24 // the shortest time the redaction tokens are kept after the aicall's last turn
+	tokenTTLMargin = time.Hour      // extra time the tokens outlive the aicall's idle timeout
+)
+
+// ConfigGet returns the redaction config for the aicalls of the given AI: the
+// AI's own config or, if it has none, its customer's default. Returns nil if
+// neither has one.
+func (h *redactionHandler) ConfigGet(ctx context.Context, a *ai.AI) *redaction.Config {
+	if a.Redaction != nil {
+		return a.Redaction
+	}
+
+	c, err := h.reqHandler.CustomerV1CustomerGet(ctx, a.CustomerID)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"func":        "ConfigGet",
+			"customer_id": a.CustomerID,
+		}).Errorf("Could not get the customer. Continuing without the customer's redaction config. err: %v", err)
+		return nil
+	}
+
+	return redaction.FromCustomer(c.Metadata.PIIRedaction)
+}
+
+// Redact returns the text with the personal data redacted by the aicall's
+// redaction config. Returns the text as it is if the aicall has no config or
+// does not exist.
+func (h *redactionHandler) Redact(ctx context.Context, aicallID uuid.UUID, text string) (string, error) {
+	if text == "" {
+		return text, nil
+	}
+
+	ac, err := h.db.AIcallGet(ctx, aicallID)
+	if err != nil {
+		if stderrors.Is(err, dbhandler.ErrNotFound) {
+			return text, nil
+		}
+		return "", errors.Wrapf(err, "could not get the aicall. aicall_id: %s", aicallID)
+	}
+
+	cfg := ac.RedactionConfig()
+	if !cfg.IsEnabled() {
+		return text, nil
+	}
+
+	mode := cfg.GetMode()
+	ttl := tokenTTL()
+	if mode == redaction.ModeTokenize {
+		// every turn keeps the aicall's tokens alive, so the tools can restore
+		// the values for as long as the aicall can be continued.
+		if errRefresh := h.db.RedactionTokenRefresh(ctx, aicallID, ttl); errRefresh != nil {
+			return "", errors.Wrapf(errRefresh, "could not refresh the redaction tokens. aicall_id: %s", aicallID)
+		}
+	}
+
+	detections := detect(text, cfg.EntityTypes)
+	if len(detections) == 0 {
+		return text, nil
+	}
+
+	var sb strings.Builder
+	last := 0
+	for _, d := range detections {
+		value := text[d.start:d.end]
+
+		var redacted string
+		switch mode {
+		case redaction.ModeMask:
+			redacted = mask(d.entityType, value)
+
+		case redaction.ModeTokenize:
+			redacted = tokenize(aicallID, d.entityType, value)
+			if errSet := h.db.RedactionTokenSet(ctx, aicallID, redacted, value, ttl); errSet != nil {
+				return "", errors.Wrapf(errSet, "could not store the redaction token. aicall_id: %s", aicallID)
+			}
+
+		default:
+			redacted = "[" + entityLabel(d.entityType) + "]"
+		}
+
+		sb.WriteString(text[last:d.start])
+		sb.WriteString(redacted)
+		last = d.end
+		promRedactionEntityTotal.WithLabelValues(string(d.entityType), string(mode)).Inc()
+	}
+	sb.WriteString(text[last:])
+
+	return sb.String(), nil
+}
+
+// Restore returns the text with the aicall's redaction tokens replaced by
+// their values. Returns the text as it is unless the aicall's redaction
+// config tokenizes and allows the tools to access the values.
+//
+// The restored values consist of the characters matched by the detectors
+// only, so restoring them inside a JSON string keeps it valid.
+func (h *redactionHandler) Restore(ctx context.Context, aicallID uuid.UUID, text string) (string, error) {
+	if text == "" {
+		return text, nil
+	}
+
+	ac, err := h.db.AIcallGet(ctx, aicallID)
+	if err != nil {
+		if stderrors.Is(err, dbhandler.ErrNotFound) {
+			return text, nil
+		}
+		return "", errors.Wrapf(err, "could not get the aicall. aicall_id: %s", aicallID)
+	}
+
+	cfg := ac.RedactionConfig()
+	if !cfg.IsEnabled() || cfg.GetMode() != redaction.ModeTokenize || !cfg.ToolAccess {
+		return text, nil
+	}
+
+	tokens, err := h.db.RedactionTokenGets(ctx, aicallID)
+	if err != nil {
+		return "", errors.Wrapf(err, "could not get the redaction tokens. aicall_id: %s", aicallID)
+	}
+
+	// replace the longer tokens first, so a token never replaces a part of another one.
+	keys := make([]string, 0, len(tokens))
+	for k := range tokens {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return len(keys[i]) > len(keys[j])
+	})
+
+	res := text
+	for _, k := range keys {
+		res = strings.ReplaceAll(res, k, tokens[k])
+	}
+
+	return res, nil
+}
+
+// tokenTTL returns how long the redaction tokens are kept after the aicall's
+// last turn. The tokens outlive the conversation idle timeout, after which the
+// aicall is not continued anymore, so they never expire under a live aicall.
+func tokenTTL() time.Duration {
+	res := time.Duration(config.Get().AIcallConversationIdleTimeoutHours)*time.Hour + tokenTTLMargin
+	if res < tokenTTLMin {
+		return tokenTTLMin
+	}
+
+	return res
+}
+
+// entityLabel returns the label of the entity type used in the redacted text. e.g. CREDIT_CARD
+func entityLabel(entityType redaction.EntityType) string {
+	return strings.ToUpper(string(entityType))
+}
+
+// mask returns the value with its letters and digits masked except the last
+// few ones. The separators are kept, so the value keeps its format.
+// An email keeps the first character of the local part and the domain.
+func mask(entityType redaction.EntityType, value string) string {
+	if entityType == redaction.EntityTypeEmail {
+		at := strings.LastIndex(value, "@")
+		local := value[:at]
+		return local[:1] + strings.Repeat(string(maskChar), len(local)-1) + value[at:]
+	}
+
+	runes := []rune(value)
+	visible := 0
+	for i := len(runes) - 1; i >= 0; i-- {
+		if !unicode.IsLetter(runes[i]) && !unicode.IsDigit(runes[i]) {
+			continue
+		}
+		if visible < maskVisibleChars {
+			visible++
+			continue
+		}
+		runes[i] = maskChar
+	}
+
+	return string(runes)
+}
+
+// tokenize returns the token of the value within the aicall. The same value
+// always gets the same token in an aicall, so the LLM can tell repeated
+// values apart. The token has no digits, so it is never detected again.
+func tokenize(aicallID uuid.UUID, entityType redaction.EntityType, value string) string {
+	sum := sha256.Sum256([]byte(aicallID.String() + ":" + value))
+
+	suffix := make([]byte, tokenLength)
+	for i := range suffix {
+		suffix[i] = 'A' + sum[i]%26
+	}
+
+	return "[" + entityLabel(entityType) + "_" + string(suffix) + "]"
+}
@@ -0,0 +1,394 @@
+package redactionhandler
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	cmcustomer "monorepo/bin-customer-manager/models/customer"
+
+	"monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/requesthandler"
+
+	"monorepo/bin-ai-manager/internal/config"
+	"monorepo/bin-ai-manager/models/ai"
+	"monorepo/bin-ai-manager/models/aicall"
+	"monorepo/bin-ai-manager/models/redaction"
+	"monorepo/bin-ai-manager/pkg/dbhandler"
+)
+
+func Test_ConfigGet(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		ai *ai.AI
+
+		responseCustomer *cmcustomer.Customer
+		responseErr      error
+
+		expectRes *redaction.Config
+	}{
+		{
+			name: "ai has its own config",
+
+			ai: &ai.AI{
+				Identity: identity.Identity{
+					CustomerID: uuid.FromStringOrNil("8a3e0b0c-a9f5-11f0-a4a4-6fd0b8a9f4c1"),
+				},
+				Redaction: &redaction.Config{
+					EntityTypes: []redaction.EntityType{redaction.EntityTypeEmail},
+				},
+			},
+
+			expectRes: &redaction.Config{
+				EntityTypes: []redaction.EntityType{redaction.EntityTypeEmail},
+			},
+		},
+		{
+			name: "falls back to the customer's default",
+
+			ai: &ai.AI{
+				Identity: identity.Identity{
+					CustomerID: uuid.FromStringOrNil("8a6c2d7e-a9f5-11f0-b3f7-2f1f7e3c6d9a"),
+				},
+			},
+
+			responseCustomer: &cmcustomer.Customer{
+				Metadata: cmcustomer.Metadata{
+					PIIRedaction: &cmcustomer.PIIRedaction{
+						EntityTypes: []string{"credit_card"},
+						Mode:        "mask",
+					},
+				},
+			},
+
+			expectRes: &redaction.Config{
+				EntityTypes: []redaction.EntityType{redaction.EntityTypeCreditCard},
+				Mode:        redaction.ModeMask,
+			},
+		},
+		{
+			name: "customer has no default",
+
+			ai: &ai.AI{
+				Identity: identity.Identity{
+					CustomerID: uuid.FromStringOrNil("8a9b1c4a-a9f5-11f0-8e0b-4b7f5f1d2e3c"),
+				},
+			},
+
+			responseCustomer: &cmcustomer.Customer{},
+
+			expectRes: nil,
+		},
+		{
+			name: "could not get the customer",
+
+			ai: &ai.AI{
+				Identity: identity.Identity{
+					CustomerID: uuid.FromStringOrNil("8ac7f2b6-a9f5-11f0-9d55-8f9b6a2c1e7d"),
+				},
+			},
+
+			responseErr: fmt.Errorf("timeout"),
+
+			expectRes: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			h := &redactionHandler{
+				reqHandler: mockReq,
+				db:         mockDB,
+			}
+			ctx := context.Background()
+
+			if tt.ai.Redaction == nil {
+				mockReq.EXPECT().CustomerV1CustomerGet(ctx, tt.ai.CustomerID).Return(tt.responseCustomer, tt.responseErr)
+			}
+
+			res := h.ConfigGet(ctx, tt.ai)
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_Redact(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		aicallID uuid.UUID
+		text     string
+
+		responseAIcall *aicall.AIcall
+
+		expectTokens map[string]string
+		expectRes    string
+	}{
+		{
+			name: "replace mode",
+
+			aicallID: uuid.FromStringOrNil("5d1c2f0e-a9f5-11f0-9c4e-0f3b8e2f6a11"),
+			text:     "card 4111 1111 1111 1111, email john@example.com",
+
+			responseAIcall: &aicall.AIcall{
+				Metadata: map[string]any{
+					aicall.MetaKeyRedaction: map[string]any{
+						"entity_types": []any{"credit_card", "email"},
+					},
+				},
+			},
+
+			expectRes: "card [CREDIT_CARD], email [EMAIL]",
+		},
+		{
+			name: "mask mode",
+
+			aicallID: uuid.FromStringOrNil("5d1c2f0e-a9f5-11f0-9c4e-0f3b8e2f6a11"),
+			text:     "card 4111 1111 1111 1111, email john@example.com",
+
+			responseAIcall: &aicall.AIcall{
+				Metadata: map[string]any{
+					aicall.MetaKeyRedaction: &redaction.Config{
+						EntityTypes: []redaction.EntityType{redaction.EntityTypeCreditCard, redaction.EntityTypeEmail},
+						Mode:        redaction.ModeMask,
+					},
+				},
+			},
+
+			expectRes: "card **** **** **** 1111, email j***@example.com",
+		},
+		{
+			name: "tokenize mode",
+
+			aicallID: uuid.FromStringOrNil("5d1c2f0e-a9f5-11f0-9c4e-0f3b8e2f6a11"),
+			text:     "card 4111 1111 1111 1111, email john@example.com",
+
+			responseAIcall: &aicall.AIcall{
+				Metadata: map[string]any{
+					aicall.MetaKeyRedaction: &redaction.Config{
+						EntityTypes: []redaction.EntityType{redaction.EntityTypeCreditCard, redaction.EntityTypeEmail},
+						Mode:        redaction.ModeTokenize,
+					},
+				},
+			},
+
+			expectTokens: map[string]string{
+				"[CREDIT_CARD_PSLZPV]": "4111 1111 1111 1111",
+				"[EMAIL_ZAHAYH]":       "john@example.com",
+			},
+			expectRes: "card [CREDIT_CARD_PSLZPV], email [EMAIL_ZAHAYH]",
+		},
+		{
+			name: "aicall has no redaction config",
+
+			aicallID: uuid.FromStringOrNil("5d4a7c52-a9f5-11f0-bd1e-7b9c0e4f2d38"),
+			text:     "card 4111 1111 1111 1111",
+
+			responseAIcall: &aicall.AIcall{},
+
+			expectRes: "card 4111 1111 1111 1111",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			h := &redactionHandler{
+				db: mockDB,
+			}
+			ctx := context.Background()
+
+			mockDB.EXPECT().AIcallGet(ctx, tt.aicallID).Return(tt.responseAIcall, nil)
+			if len(tt.expectTokens) > 0 {
+				mockDB.EXPECT().RedactionTokenRefresh(ctx, tt.aicallID, tokenTTLMin).Return(nil)
+			}
+			for token, value := range tt.expectTokens {
+				mockDB.EXPECT().RedactionTokenSet(ctx, tt.aicallID, token, value, tokenTTLMin).Return(nil)
+			}
+
+			res, err := h.Redact(ctx, tt.aicallID, tt.text)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if res != tt.expectRes {
+				t.Errorf("Wrong match.\nexpect: %s\ngot: %s", tt.expectRes, res)
+			}
+
+			// redacting the redacted text again must not change it
+			if len(tt.expectTokens) > 0 {
+				mockDB.EXPECT().AIcallGet(ctx, tt.aicallID).Return(tt.responseAIcall, nil)
+				mockDB.EXPECT().RedactionTokenRefresh(ctx, tt.aicallID, tokenTTLMin).Return(nil)
+				resAgain, err := h.Redact(ctx, tt.aicallID, res)
+				if err != nil {
+					t.Errorf("Wrong match. expect: ok, got: %v", err)
+				}
+				if resAgain != res {
+					t.Errorf("Wrong match.\nexpect: %s\ngot: %s", res, resAgain)
+				}
+			}
+		})
+	}
+}
+
+func Test_tokenTTL(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		idleTimeoutHours int
+
+		expectRes time.Duration
+	}{
+		{
+			name: "idle timeout shorter than the minimum",
+
+			idleTimeoutHours: 1,
+
+			expectRes: tokenTTLMin,
+		},
+		{
+			name: "idle timeout longer than the minimum",
+
+			idleTimeoutHours: 72,
+
+			expectRes: 73 * time.Hour,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config.SetAIcallConversationIdleTimeoutHoursForTest(tt.idleTimeoutHours)
+			defer config.SetAIcallConversationIdleTimeoutHoursForTest(0)
+
+			res := tokenTTL()
+			if res != tt.expectRes {
+				t.Errorf("Wrong match. expect: %v, got: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_Redact_aicallNotFound(t *testing.T) {
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockDB := dbhandler.NewMockDBHandler(mc)
+	h := &redactionHandler{
+		db: mockDB,
+	}
+	ctx := context.Background()
+
+	aicallID := uuid.FromStringOrNil("5d7b3f6c-a9f5-11f0-8a61-0b4d1c7e9f52")
+	text := "card 4111 1111 1111 1111"
+
+	mockDB.EXPECT().AIcallGet(ctx, aicallID).Return(nil, dbhandler.ErrNotFound)
+
+	res, err := h.Redact(ctx, aicallID, text)
+	if err != nil {
+		t.Errorf("Wrong match. expect: ok, got: %v", err)
+	}
+	if res != text {
+		t.Errorf("Wrong match. expect: %s, got: %s", text, res)
+	}
+}
+
+func Test_Restore(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		aicallID uuid.UUID
+		text     string
+
+		responseAIcall *aicall.AIcall
+		responseTokens map[string]string
+
+		expectRes string
+	}{
+		{
+			name: "restores the tokens",
+
+			aicallID: uuid.FromStringOrNil("9a1f0d3e-a9f5-11f0-8c2b-1f6e3d9a7b40"),
+			text:     `{"card_number":"[CREDIT_CARD_PSLZPV]","email":"[EMAIL_ZAHAYH]"}`,
+
+			responseAIcall: &aicall.AIcall{
+				Metadata: map[string]any{
+					aicall.MetaKeyRedaction: &redaction.Config{
+						EntityTypes: []redaction.EntityType{redaction.EntityTypeCreditCard, redaction.EntityTypeEmail},
+						Mode:        redaction.ModeTokenize,
+						ToolAccess:  true,
+					},
+				},
+			},
+			responseTokens: map[string]string{
+				"[CREDIT_CARD_PSLZPV]": "4111 1111 1111 1111",
+				"[EMAIL_ZAHAYH]":       "john@example.com",
+			},
+
+			expectRes: `{"card_number":"4111 1111 1111 1111","email":"john@example.com"}`,
+		},
+		{
+			name: "tool access is not allowed",
+
+			aicallID: uuid.FromStringOrNil("9a4d6b1c-a9f5-11f0-a7e3-5b2c8f0d1e69"),
+			text:     `{"card_number":"[CREDIT_CARD_PSLZPV]"}`,
+
+			responseAIcall: &aicall.AIcall{
+				Metadata: map[string]any{
+					aicall.MetaKeyRedaction: &redaction.Config{
+						EntityTypes: []redaction.EntityType{redaction.EntityTypeCreditCard},
+						Mode:        redaction.ModeTokenize,
+					},
+				},
+			},
+
+			expectRes: `{"card_number":"[CREDIT_CARD_PSLZPV]"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			h := &redactionHandler{
+				db: mockDB,
+			}
+			ctx := context.Background()
+
+			mockDB.EXPECT().AIcallGet(ctx, tt.aicallID).Return(tt.responseAIcall, nil)
+			if tt.responseTokens != nil {
+				mockDB.EXPECT().RedactionTokenGets(ctx, tt.aicallID).Return(tt.responseTokens, nil)
+			}
+
+			res, err := h.Restore(ctx, tt.aicallID, tt.text)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if res != tt.expectRes {
+				t.Errorf("Wrong match.\nexpect: %s\ngot: %s", tt.expectRes, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,178 @@
+package responsecachehandler
+
+import (
+	"context"
+	"math"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"monorepo/bin-ai-manager/models/aicall"
+	"monorepo/bin-ai-manager/models/responsecache"
+)
+
+// Lookup returns the cached response for the aicall's user turns.
+// Returns empty if there is no similar enough question in the cache.
+//
+// On a miss, the question is kept until the LLM's response is stored.
+func (h *responseCacheHandler) Lookup(ctx context.Context, c *aicall.AIcall, userTurns []string) (string, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":      "Lookup",
+		"aicall_id": c.ID,
+	})
+
+	cfg := c.ResponseCacheConfig()
+	if !isCacheable(c, cfg) {
+		return "", nil
+	}
+
+	q := question(userTurns, cfg.GetTurns())
+	if q == "" {
+		return "", nil
+	}
+
+	res, err := h.lookup(ctx, c, cfg, q)
+	if err != nil {
+		promResponseCacheLookupTotal.WithLabelValues(resultError).Inc()
+		return "", err
+	}
+
+	if res == "" {
+		promResponseCacheLookupTotal.WithLabelValues(resultMiss).Inc()
+		log.Debugf("Response cache miss. aicall_id: %s", c.ID)
+		return "", nil
+	}
+
+	promResponseCacheLookupTotal.WithLabelValues(resultHit).Inc()
+	log.Debugf("Response cache hit. aicall_id: %s", c.ID)
+	return res, nil
+}
+
+func (h *responseCacheHandler) lookup(ctx context.Context, c *aicall.AIcall, cfg *responsecache.Config, q string) (string, error) {
+	bucket, err := h.bucket(ctx, c, cfg)
+	if err != nil {
+		return "", errors.Wrapf(err, "could not get the bucket")
+	}
+
+	now := h.utilHandler.TimeNow()
+	ttl := cfg.GetTTL()
+
+	// the same question asked again is answered without the embedding.
+	exact, err := h.db.ResponseCacheEntryGetByHash(ctx, bucket, questionHash(q))
+	if err != nil {
+		return "", errors.Wrapf(err, "could not get the cached response")
+	}
+	if exact != nil && exact.TMCreate != nil && now.Sub(*exact.TMCreate) <= ttl {
+		return exact.Response, nil
+	}
+
+	embeddings, err := h.reqHandler.RagV1EmbeddingCreate(ctx, []string{q})
+	if err != nil {
+		return "", errors.Wrapf(err, "could not embed the question")
+	}
+	if len(embeddings) != 1 {
+		return "", errors.Errorf("wrong number of embeddings. expect: 1, got: %d", len(embeddings))
+	}
+	embedding := embeddings[0]
+
+	entries, err := h.db.ResponseCacheEntryGets(ctx, bucket)
+	if err != nil {
+		return "", errors.Wrapf(err, "could not get the cached responses")
+	}
+
+	threshold := cfg.GetThreshold()
+	bestScore := 0.0
+	res := ""
+	for _, e := range entries {
+		if e.TMCreate == nil || now.Sub(*e.TMCreate) > ttl {
+			continue
+		}
+
+		if score := cosineSimilarity(embedding, e.Embedding); score >= threshold && score > bestScore {
+			bestScore = score
+			res = e.Response
+		}
+	}
+	if res != "" {
+		return res, nil
+	}
+
+	p := &responsecache.Pending{
+		Bucket:    bucket,
+		Question:  q,
+		Embedding: embedding,
+	}
+	if errSet := h.db.ResponseCachePendingSet(ctx, c.ID, p); errSet != nil {
+		return "", errors.Wrapf(errSet, "could not set the pending question")
+	}
+
+	return "", nil
+}
+
+// Store caches the LLM's response to the aicall's last cache miss. The
+// response is dropped if the user turns are not the ones of the miss.
+func (h *responseCacheHandler) Store(ctx context.Context, c *aicall.AIcall, userTurns []string, response string) error {
+	log := logrus.WithFields(logrus.Fields{
+		"func":      "Store",
+		"aicall_id": c.ID,
+	})
+
+	cfg := c.ResponseCacheConfig()
+	if !isCacheable(c, cfg) || strings.TrimSpace(response) == "" {
+		return nil
+	}
+
+	p, err := h.db.ResponseCachePendingPop(ctx, c.ID)
+	if err != nil {
+		return errors.Wrapf(err, "could not get the pending question")
+	}
+
+	q := question(userTurns, cfg.GetTurns())
+	if p == nil || p.Question != q {
+		log.Debugf("No pending question for the response. Skipping. aicall_id: %s", c.ID)
+		return nil
+	}
+
+	e := &responsecache.Entry{
+		Question:  p.Question,
+		Embedding: p.Embedding,
+		Response:  response,
+		TMCreate:  h.utilHandler.TimeNow(),
+	}
+	if errAdd := h.db.ResponseCacheEntryAdd(ctx, p.Bucket, questionHash(p.Question), e, cfg.GetTTL()); errAdd != nil {
+		return errors.Wrapf(errAdd, "could not add the response")
+	}
+	promResponseCacheStoreTotal.Inc()
+
+	return nil
+}
+
+// isCacheable returns true if the aicall's responses can be cached.
+// Only the single AI aicalls are cached, since the member answering a team's
+// aicall changes during the aicall.
+func isCacheable(c *aicall.AIcall, cfg *responsecache.Config) bool {
+	return cfg.IsEnabled() &&
+		c.ResponseCacheScope() != "" &&
+		c.AssistanceType == aicall.AssistanceTypeAI
+}
+
+// cosineSimilarity returns the cosine similarity of the two vectors.
+// Returns 0 if they can't be compared.
+func cosineSimilarity(a []float32, b []float32) float64 {
+	if len(a) == 0 || len(a) != len(b) {
+		return 0
+	}
+
+	var dot, normA, normB float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		normA += float64(a[i]) * float64(a[i])
+		normB += float64(b[i]) * float64(b[i])
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
@@ -0,0 +1,312 @@
+package responsecachehandler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"monorepo/bin-ai-manager/models/aicall"
+	"monorepo/bin-ai-manager/models/responsecache"
+	"monorepo/bin-ai-manager/pkg/dbhandler"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/requesthandler"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+	fmvariable "monorepo/bin-flow-manager/models/variable"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func Test_Lookup(t *testing.T) {
+
+	curTime := time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)
+	tmFresh := curTime.Add(-time.Minute)
+	tmExpired := curTime.Add(-time.Hour * 48)
+
+	aicallID := uuid.FromStringOrNil("e1a2b3c4-ad9f-11f0-8f1a-3d5b7c9e1a01")
+	aiID := uuid.FromStringOrNil("e1d3e5f6-ad9f-11f0-a2b3-4e6c8d0f2b02")
+	activeflowID := uuid.FromStringOrNil("e204f718-ad9f-11f0-b3c4-5f7d9e1a3c03")
+
+	newAIcall := func(cfg *responsecache.Config) *aicall.AIcall {
+		return &aicall.AIcall{
+			Identity: commonidentity.Identity{
+				ID: aicallID,
+			},
+			AssistanceType: aicall.AssistanceTypeAI,
+			AssistanceID:   aiID,
+			ActiveflowID:   activeflowID,
+			Metadata: map[string]any{
+				aicall.MetaKeyResponseCache:      cfg,
+				aicall.MetaKeyResponseCacheScope: "3f9a1c2e7b4d8a60",
+			},
+		}
+	}
+
+	tests := []struct {
+		name string
+
+		aicall    *aicall.AIcall
+		userTurns []string
+		lookedUp  bool
+
+		responseVariables *fmvariable.Variable
+		responseExact     *responsecache.Entry
+		responseEmbedding [][]float32
+		responseEntries   []*responsecache.Entry
+
+		expectBucket  string
+		expectPending *responsecache.Pending
+		expectRes     string
+	}{
+		{
+			name: "hit",
+
+			aicall:    newAIcall(&responsecache.Config{Enabled: true}),
+			userTurns: []string{"hello", "what are your opening hours"},
+			lookedUp:  true,
+
+			responseEmbedding: [][]float32{{1, 0}},
+			responseEntries: []*responsecache.Entry{
+				{Embedding: []float32{0, 1}, Response: "not similar", TMCreate: &tmFresh},
+				{Embedding: []float32{0.99, 0.05}, Response: "We are open from 9am to 6pm.", TMCreate: &tmFresh},
+			},
+
+			expectBucket: "e1d3e5f6-ad9f-11f0-a2b3-4e6c8d0f2b02:3f9a1c2e7b4d8a60:none",
+			expectRes:    "We are open from 9am to 6pm.",
+		},
+		{
+			name: "miss on an expired response",
+
+			aicall:    newAIcall(&responsecache.Config{Enabled: true}),
+			userTurns: []string{"what are your opening hours"},
+			lookedUp:  true,
+
+			responseExact:     &responsecache.Entry{Response: "We are open from 9am to 5pm.", TMCreate: &tmExpired},
+			responseEmbedding: [][]float32{{1, 0}},
+			responseEntries: []*responsecache.Entry{
+				{Embedding: []float32{1, 0}, Response: "We are open from 9am to 5pm.", TMCreate: &tmExpired},
+			},
+
+			expectBucket: "e1d3e5f6-ad9f-11f0-a2b3-4e6c8d0f2b02:3f9a1c2e7b4d8a60:none",
+			expectPending: &responsecache.Pending{
+				Bucket:    "e1d3e5f6-ad9f-11f0-a2b3-4e6c8d0f2b02:3f9a1c2e7b4d8a60:none",
+				Question:  "what are your opening hours",
+				Embedding: []float32{1, 0},
+			},
+			expectRes: "",
+		},
+		{
+			name: "miss keyed on the variables",
+
+			aicall: newAIcall(&responsecache.Config{
+				Enabled:   true,
+				Threshold: 0.8,
+				Variables: []string{"customer.language"},
+			}),
+			userTurns: []string{"what are your opening hours"},
+			lookedUp:  true,
+
+			responseVariables: &fmvariable.Variable{
+				Variables: map[string]string{"customer.language": "en-US"},
+			},
+			responseEmbedding: [][]float32{{1, 0}},
+			responseEntries:   []*responsecache.Entry{},
+
+			expectBucket: "e1d3e5f6-ad9f-11f0-a2b3-4e6c8d0f2b02:3f9a1c2e7b4d8a60:b4529c36ecd8b44d",
+			expectPending: &responsecache.Pending{
+				Question:  "what are your opening hours",
+				Embedding: []float32{1, 0},
+			},
+			expectRes: "",
+		},
+		{
+			name: "exact hit",
+
+			aicall:    newAIcall(&responsecache.Config{Enabled: true}),
+			userTurns: []string{"What are your  opening hours?"},
+			lookedUp:  true,
+
+			responseExact: &responsecache.Entry{Response: "We are open from 9am to 6pm.", TMCreate: &tmFresh},
+
+			expectBucket: "e1d3e5f6-ad9f-11f0-a2b3-4e6c8d0f2b02:3f9a1c2e7b4d8a60:none",
+			expectRes:    "We are open from 9am to 6pm.",
+		},
+		{
+			name: "disabled",
+
+			aicall:    newAIcall(&responsecache.Config{}),
+			userTurns: []string{"what are your opening hours"},
+
+			expectRes: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			h := responseCacheHandler{
+				utilHandler: mockUtil,
+				reqHandler:  mockReq,
+				db:          mockDB,
+			}
+			ctx := context.Background()
+
+			var bucket string
+			if tt.lookedUp {
+				if tt.responseVariables != nil {
+					mockReq.EXPECT().FlowV1VariableGet(ctx, activeflowID).Return(tt.responseVariables, nil)
+				}
+				mockUtil.EXPECT().TimeNow().Return(&curTime)
+				mockDB.EXPECT().ResponseCacheEntryGetByHash(ctx, gomock.Any(), questionHash("what are your opening hours")).DoAndReturn(func(_ context.Context, b string, _ string) (*responsecache.Entry, error) {
+					bucket = b
+					return tt.responseExact, nil
+				})
+			}
+			if tt.responseEmbedding != nil {
+				mockReq.EXPECT().RagV1EmbeddingCreate(ctx, gomock.Any()).Return(tt.responseEmbedding, nil)
+				mockDB.EXPECT().ResponseCacheEntryGets(ctx, gomock.Any()).Return(tt.responseEntries, nil)
+			}
+			if tt.expectPending != nil {
+				mockDB.EXPECT().ResponseCachePendingSet(ctx, aicallID, gomock.Any()).DoAndReturn(func(_ context.Context, _ uuid.UUID, p *responsecache.Pending) error {
+					if p.Bucket != bucket || p.Question != tt.expectPending.Question || len(p.Embedding) != len(tt.expectPending.Embedding) {
+						t.Errorf("Wrong match. expect: %v, got: %v", tt.expectPending, p)
+					}
+					return nil
+				})
+			}
+
+			res, err := h.Lookup(ctx, tt.aicall, tt.userTurns)
+			if err != nil {
+				t.Fatalf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if res != tt.expectRes {
+				t.Errorf("Wrong match. expect: %s, got: %s", tt.expectRes, res)
+			}
+
+			if bucket != tt.expectBucket {
+				t.Errorf("Wrong match. expect bucket: %s, got: %s", tt.expectBucket, bucket)
+			}
+		})
+	}
+}
+
+func Test_Store(t *testing.T) {
+
+	curTime := time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)
+
+	aicallID := uuid.FromStringOrNil("f1a2b3c4-ad9f-11f0-8a1b-3c5d7e9f1a01")
+	c := &aicall.AIcall{
+		Identity: commonidentity.Identity{
+			ID: aicallID,
+		},
+		AssistanceType: aicall.AssistanceTypeAI,
+		Metadata: map[string]any{
+			aicall.MetaKeyResponseCache:      &responsecache.Config{Enabled: true, TTL: 600},
+			aicall.MetaKeyResponseCacheScope: "3f9a1c2e7b4d8a60",
+		},
+	}
+
+	tests := []struct {
+		name string
+
+		userTurns []string
+		response  string
+
+		responsePending *responsecache.Pending
+
+		expectEntry *responsecache.Entry
+	}{
+		{
+			name: "stored",
+
+			userTurns: []string{"what are your opening hours"},
+			response:  "We are open from 9am to 6pm.",
+
+			responsePending: &responsecache.Pending{
+				Bucket:    "f1d3e5f6-ad9f-11f0-9b2c-4d6e8f0a2b02:3f9a1c2e7b4d8a60:none",
+				Question:  "what are your opening hours",
+				Embedding: []float32{1, 0},
+			},
+
+			expectEntry: &responsecache.Entry{
+				Question:  "what are your opening hours",
+				Embedding: []float32{1, 0},
+				Response:  "We are open from 9am to 6pm.",
+				TMCreate:  &curTime,
+			},
+		},
+		{
+			name: "the user turns moved on",
+
+			userTurns: []string{"what are your opening hours", "and on sunday?"},
+			response:  "We are closed on sunday.",
+
+			responsePending: &responsecache.Pending{
+				Bucket:   "f1d3e5f6-ad9f-11f0-9b2c-4d6e8f0a2b02:3f9a1c2e7b4d8a60:none",
+				Question: "what are your opening hours",
+			},
+		},
+		{
+			name: "no pending question",
+
+			userTurns: []string{"what are your opening hours"},
+			response:  "We are open from 9am to 6pm.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			h := responseCacheHandler{
+				utilHandler: mockUtil,
+				db:          mockDB,
+			}
+			ctx := context.Background()
+
+			mockDB.EXPECT().ResponseCachePendingPop(ctx, aicallID).Return(tt.responsePending, nil)
+			if tt.expectEntry != nil {
+				mockUtil.EXPECT().TimeNow().Return(&curTime)
+				mockDB.EXPECT().ResponseCacheEntryAdd(ctx, tt.responsePending.Bucket, questionHash(tt.expectEntry.Question), tt.expectEntry, 10*time.Minute).Return(nil)
+			}
+
+			if err := h.Store(ctx, c, tt.userTurns, tt.response); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+		})
+	}
+}
+
+func Test_cosineSimilarity(t *testing.T) {
+	tests := []struct {
+		name string
+
+		a []float32
+		b []float32
+
+		expectRes float64
+	}{
+		{"same direction", []float32{1, 2}, []float32{2, 4}, 1},
+		{"orthogonal", []float32{1, 0}, []float32{0, 1}, 0},
+		{"different length", []float32{1, 0}, []float32{1}, 0},
+		{"zero vector", []float32{0, 0}, []float32{1, 0}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := cosineSimilarity(tt.a, tt.b)
+			if res < tt.expectRes-1e-9 || res > tt.expectRes+1e-9 {
+				t.Errorf("Wrong match. expect: %v, got: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
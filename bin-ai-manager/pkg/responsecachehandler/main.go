@@ -0,0 +1,83 @@
+package responsecachehandler
+
+//go:generate mockgen -package responsecachehandler -destination ./mock_main.go -source main.go -build_flags=-mod=mod
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"monorepo/bin-common-handler/pkg/requesthandler"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+
+	"monorepo/bin-ai-manager/models/ai"
+	"monorepo/bin-ai-manager/models/aicall"
+	"monorepo/bin-ai-manager/pkg/dbhandler"
+)
+
+// ResponseCacheHandler reuses the AI's responses for the user turns similar
+// enough to the ones answered before.
+//
+// The user turns are embedded with the rag-manager's embedder and compared by
+// the cosine similarity. The responses are cached per AI, per scope (the AI's
+// init prompt and rag) and per the values of the config's variables, so a
+// change of any of them never reuses an old response.
+type ResponseCacheHandler interface {
+	Scope(ctx context.Context, a *ai.AI) (string, error)
+
+	Lookup(ctx context.Context, c *aicall.AIcall, userTurns []string) (string, error)
+	Store(ctx context.Context, c *aicall.AIcall, userTurns []string, response string) error
+}
+
+type responseCacheHandler struct {
+	utilHandler utilhandler.UtilHandler
+	reqHandler  requesthandler.RequestHandler
+	db          dbhandler.DBHandler
+}
+
+// list of lookup results
+const (
+	resultHit   = "hit"
+	resultMiss  = "miss"
+	resultError = "error"
+)
+
+var (
+	metricsNamespace = "ai_manager"
+
+	promResponseCacheLookupTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "response_cache_lookup_total",
+			Help:      "Total number of response cache lookups with result.",
+		},
+		[]string{"result"},
+	)
+
+	promResponseCacheStoreTotal = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "response_cache_store_total",
+			Help:      "Total number of responses stored in the response cache.",
+		},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(
+		promResponseCacheLookupTotal,
+		promResponseCacheStoreTotal,
+	)
+}
+
+// NewResponseCacheHandler creates a new ResponseCacheHandler
+func NewResponseCacheHandler(
+	reqHandler requesthandler.RequestHandler,
+	db dbhandler.DBHandler,
+) ResponseCacheHandler {
+	return &responseCacheHandler{
+		utilHandler: utilhandler.NewUtilHandler(),
+		reqHandler:  reqHandler,
+		db:          db,
+	}
+}
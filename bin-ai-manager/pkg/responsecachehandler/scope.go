@@ -0,0 +1,95 @@
+package responsecachehandler
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+
+	"monorepo/bin-ai-manager/models/ai"
+	"monorepo/bin-ai-manager/models/aicall"
+	"monorepo/bin-ai-manager/models/responsecache"
+)
+
+// Scope returns the fingerprint of the AI's init prompt and rag. The rag's
+// sources and their ingestion status are part of it, so adding, removing or
+// re-ingesting a source drops the cache as well.
+func (h *responseCacheHandler) Scope(ctx context.Context, a *ai.AI) (string, error) {
+	hash := sha256.New()
+	_, _ = fmt.Fprintf(hash, "init_prompt:%s\n", a.InitPrompt)
+
+	if a.RagID != uuid.Nil {
+		r, err := h.reqHandler.RagV1RagGet(ctx, a.RagID)
+		if err != nil {
+			return "", errors.Wrapf(err, "could not get the rag. rag_id: %s", a.RagID)
+		}
+
+		var tmUpdate int64
+		if r.TMUpdate != nil {
+			tmUpdate = r.TMUpdate.UnixNano()
+		}
+		_, _ = fmt.Fprintf(hash, "rag:%s:%d\n", r.ID, tmUpdate)
+
+		sources := make([]string, 0, len(r.Sources))
+		for _, s := range r.Sources {
+			sources = append(sources, fmt.Sprintf("source:%s:%s\n", s.ID, s.Status))
+		}
+		sort.Strings(sources)
+		_, _ = fmt.Fprint(hash, strings.Join(sources, ""))
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))[:16], nil
+}
+
+// bucket returns the cache bucket of the aicall. The responses are reused only
+// within the same bucket.
+func (h *responseCacheHandler) bucket(ctx context.Context, c *aicall.AIcall, cfg *responsecache.Config) (string, error) {
+	variables := "none"
+	if len(cfg.Variables) > 0 {
+		values := map[string]string{}
+		if c.ActiveflowID != uuid.Nil {
+			v, err := h.reqHandler.FlowV1VariableGet(ctx, c.ActiveflowID)
+			if err != nil {
+				return "", errors.Wrapf(err, "could not get the activeflow variables. activeflow_id: %s", c.ActiveflowID)
+			}
+			values = v.Variables
+		}
+
+		hash := sha256.New()
+		for _, name := range cfg.Variables {
+			_, _ = fmt.Fprintf(hash, "%s=%s\n", name, values[name])
+		}
+		variables = hex.EncodeToString(hash.Sum(nil))[:16]
+	}
+
+	return fmt.Sprintf("%s:%s:%s", c.AssistanceID, c.ResponseCacheScope(), variables), nil
+}
+
+// question returns the latest user turns the cache is keyed on.
+func question(userTurns []string, turns int) string {
+	res := []string{}
+	for i := len(userTurns) - 1; i >= 0 && len(res) < turns; i-- {
+		if t := strings.TrimSpace(userTurns[i]); t != "" {
+			res = append([]string{t}, res...)
+		}
+	}
+
+	return strings.Join(res, "\n")
+}
+
+// questionHash returns the hash of the normalized question, so the questions
+// differing only in case, spacing or the trailing punctuation share the hash.
+func questionHash(q string) string {
+	lines := strings.Split(strings.ToLower(q), "\n")
+	for i, l := range lines {
+		lines[i] = strings.TrimRight(strings.Join(strings.Fields(l), " "), ".?!。？！ ")
+	}
+
+	hash := sha256.Sum256([]byte(strings.Join(lines, "\n")))
+	return hex.EncodeToString(hash[:])[:32]
+}
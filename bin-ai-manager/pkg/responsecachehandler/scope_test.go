@@ -0,0 +1,204 @@
+package responsecachehandler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"monorepo/bin-ai-manager/models/ai"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/requesthandler"
+	rmdocument "monorepo/bin-rag-manager/models/document"
+	rmrag "monorepo/bin-rag-manager/models/rag"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func Test_Scope(t *testing.T) {
+
+	ragID := uuid.FromStringOrNil("d1a2b3c4-ad9f-11f0-8e5a-2c7d9f1b3a01")
+	sourceID := uuid.FromStringOrNil("d1d3e5f6-ad9f-11f0-9b6c-4e8a2d5c7b02")
+	tmUpdate := time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)
+	tmUpdated := tmUpdate.Add(time.Minute)
+
+	rag := func(tm *time.Time, status rmdocument.Status) *rmrag.Rag {
+		return &rmrag.Rag{
+			ID:       ragID,
+			TMUpdate: tm,
+			Sources: []rmrag.Source{
+				{
+					Identity: commonidentity.Identity{ID: sourceID},
+					Status:   status,
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name string
+
+		initPrompt  string
+		responseRag *rmrag.Rag
+
+		sameAsBase bool
+	}{
+		{
+			name: "same init prompt and rag",
+
+			initPrompt:  "You are a helpful assistant.",
+			responseRag: rag(&tmUpdate, rmdocument.StatusReady),
+
+			sameAsBase: true,
+		},
+		{
+			name: "init prompt changed",
+
+			initPrompt:  "You are a rude assistant.",
+			responseRag: rag(&tmUpdate, rmdocument.StatusReady),
+
+			sameAsBase: false,
+		},
+		{
+			name: "rag updated",
+
+			initPrompt:  "You are a helpful assistant.",
+			responseRag: rag(&tmUpdated, rmdocument.StatusReady),
+
+			sameAsBase: false,
+		},
+		{
+			name: "source re-ingesting",
+
+			initPrompt:  "You are a helpful assistant.",
+			responseRag: rag(&tmUpdate, rmdocument.StatusProcessing),
+
+			sameAsBase: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			h := responseCacheHandler{
+				reqHandler: mockReq,
+			}
+			ctx := context.Background()
+
+			mockReq.EXPECT().RagV1RagGet(ctx, ragID).Return(rag(&tmUpdate, rmdocument.StatusReady), nil)
+			base, err := h.Scope(ctx, &ai.AI{InitPrompt: "You are a helpful assistant.", RagID: ragID})
+			if err != nil {
+				t.Fatalf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			mockReq.EXPECT().RagV1RagGet(ctx, ragID).Return(tt.responseRag, nil)
+			res, err := h.Scope(ctx, &ai.AI{InitPrompt: tt.initPrompt, RagID: ragID})
+			if err != nil {
+				t.Fatalf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if (res == base) != tt.sameAsBase {
+				t.Errorf("Wrong match. expect same: %v, base: %s, got: %s", tt.sameAsBase, base, res)
+			}
+		})
+	}
+}
+
+func Test_question(t *testing.T) {
+	tests := []struct {
+		name string
+
+		userTurns []string
+		turns     int
+
+		expectRes string
+	}{
+		{
+			name: "last turn",
+
+			userTurns: []string{"hello", "what are your opening hours?"},
+			turns:     1,
+
+			expectRes: "what are your opening hours?",
+		},
+		{
+			name: "last two turns skipping the empty ones",
+
+			userTurns: []string{"hello", "I want to book", " ", "for tomorrow"},
+			turns:     2,
+
+			expectRes: "I want to book\nfor tomorrow",
+		},
+		{
+			name: "no turns",
+
+			userTurns: []string{},
+			turns:     1,
+
+			expectRes: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if res := question(tt.userTurns, tt.turns); res != tt.expectRes {
+				t.Errorf("Wrong match. expect: %q, got: %q", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_questionHash(t *testing.T) {
+	tests := []struct {
+		name string
+
+		a string
+		b string
+
+		expectSame bool
+	}{
+		{
+			name: "case, spacing and the trailing punctuation",
+
+			a: "What are your  opening hours?",
+			b: "what are your opening hours",
+
+			expectSame: true,
+		},
+		{
+			name: "every turn is normalized",
+
+			a: "Hello.\nWhat are your opening hours?",
+			b: "hello\nwhat are your opening hours",
+
+			expectSame: true,
+		},
+		{
+			name: "different turns",
+
+			a: "hello\nwhat are your opening hours",
+			b: "hello what are your opening hours",
+
+			expectSame: false,
+		},
+		{
+			name: "different questions",
+
+			a: "what are your opening hours",
+			b: "what are your closing hours",
+
+			expectSame: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if res := questionHash(tt.a) == questionHash(tt.b); res != tt.expectSame {
+				t.Errorf("Wrong match. expect: %v, got: %v", tt.expectSame, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+// Package safehttp provides an outbound HTTP client and URL validation for
+// requests sent to customer-supplied endpoints (custom tools, MCP servers).
+package safehttp
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// privateNetworks defines IP ranges that customer endpoints must not resolve to.
+// Kept in sync with bin-webhook-manager's block list.
+// Note: Go's net.IPNet.Contains correctly handles IPv4-mapped IPv6 addresses
+// (e.g., ::ffff:127.0.0.1 is matched by the 127.0.0.0/8 range).
+var privateNetworks = []net.IPNet{
+	// IPv4 private/reserved (RFC 1918)
+	{IP: net.IPv4(10, 0, 0, 0), Mask: net.CIDRMask(8, 32)},
+	{IP: net.IPv4(172, 16, 0, 0), Mask: net.CIDRMask(12, 32)},
+	{IP: net.IPv4(192, 168, 0, 0), Mask: net.CIDRMask(16, 32)},
+	{IP: net.IPv4(127, 0, 0, 0), Mask: net.CIDRMask(8, 32)},
+	{IP: net.IPv4(169, 254, 0, 0), Mask: net.CIDRMask(16, 32)}, // link-local / cloud metadata
+	{IP: net.IPv4(0, 0, 0, 0), Mask: net.CIDRMask(8, 32)},
+	{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)},      // CGN (RFC 6598)
+	{IP: net.IPv4(192, 0, 0, 0), Mask: net.CIDRMask(24, 32)},       // IETF Protocol Assignments
+	{IP: net.IPv4(192, 0, 2, 0), Mask: net.CIDRMask(24, 32)},       // TEST-NET-1 (RFC 5737)
+	{IP: net.IPv4(198, 51, 100, 0), Mask: net.CIDRMask(24, 32)},    // TEST-NET-2 (RFC 5737)
+	{IP: net.IPv4(203, 0, 113, 0), Mask: net.CIDRMask(24, 32)},     // TEST-NET-3 (RFC 5737)
+	{IP: net.IPv4(198, 18, 0, 0), Mask: net.CIDRMask(15, 32)},      // Benchmarking (RFC 2544)
+	{IP: net.IPv4(240, 0, 0, 0), Mask: net.CIDRMask(4, 32)},        // Reserved for future use
+	{IP: net.IPv4(255, 255, 255, 255), Mask: net.CIDRMask(32, 32)}, // Broadcast
+
+	// IPv6 private/reserved
+	{IP: net.ParseIP("::"), Mask: net.CIDRMask(128, 128)},        // Unspecified
+	{IP: net.ParseIP("::1"), Mask: net.CIDRMask(128, 128)},       // Loopback
+	{IP: net.ParseIP("fc00::"), Mask: net.CIDRMask(7, 128)},      // Unique local (RFC 4193)
+	{IP: net.ParseIP("fe80::"), Mask: net.CIDRMask(10, 128)},     // Link-local
+	{IP: net.ParseIP("2001:db8::"), Mask: net.CIDRMask(32, 128)}, // Documentation (RFC 3849)
+}
+
+// ValidateURL checks that a customer endpoint is an https URL that does
+// not point at an obviously internal host. Hostnames are re-checked against
+// the block list at dial time, so DNS changes after save cannot bypass it.
+func ValidateURL(rawURL string) error {
+	if rawURL == "" {
+		return fmt.Errorf("empty URL")
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("invalid URL: %w", err)
+	}
+
+	if u.Scheme != "https" {
+		return fmt.Errorf("unsupported scheme: %s. only https is allowed", u.Scheme)
+	}
+
+	if u.User != nil {
+		return fmt.Errorf("credentials in URL are not allowed. use headers instead")
+	}
+
+	host := u.Hostname()
+	if host == "" {
+		return fmt.Errorf("empty hostname")
+	}
+
+	if strings.EqualFold(host, "localhost") || strings.HasSuffix(strings.ToLower(host), ".localhost") {
+		return fmt.Errorf("URL points to a private/reserved host: %s", host)
+	}
+
+	if ip := net.ParseIP(host); ip != nil && IsPrivateIP(ip) {
+		return fmt.Errorf("URL points to a private/reserved IP: %s", host)
+	}
+
+	return nil
+}
+
+// IsPrivateIP reports whether ip is in a private/reserved range.
+func IsPrivateIP(ip net.IP) bool {
+	for _, network := range privateNetworks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// NewClient returns an *http.Client hardened against SSRF.
+// It mirrors bin-webhook-manager's client: every resolved address is checked
+// against the private/reserved block list at dial time (prevents DNS
+// rebinding), TLS 1.2 is the minimum, and redirects are never followed so a
+// public endpoint cannot bounce the request to an internal one.
+func NewClient(responseHeaderTimeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: 10 * time.Second,
+	}
+
+	transport := &http.Transport{
+		DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+			host, port, err := net.SplitHostPort(addr)
+			if err != nil {
+				return nil, fmt.Errorf("invalid address %s: %w", addr, err)
+			}
+
+			// Resolve DNS and check each IP against the block list (prevents DNS rebinding).
+			ips, err := net.DefaultResolver.LookupIP(ctx, "ip", host)
+			if err != nil {
+				return nil, fmt.Errorf("cannot resolve %s: %w", host, err)
+			}
+
+			for _, ip := range ips {
+				if IsPrivateIP(ip) {
+					return nil, fmt.Errorf("connection to private/reserved IP blocked: %s -> %s", host, ip)
+				}
+			}
+
+			// Dial the first resolved public IP.
+			return dialer.DialContext(ctx, network, net.JoinHostPort(ips[0].String(), port))
+		},
+		TLSClientConfig:       &tls.Config{MinVersion: tls.VersionTLS12},
+		TLSHandshakeTimeout:   10 * time.Second,
+		ResponseHeaderTimeout: responseHeaderTimeout,
+		IdleConnTimeout:       30 * time.Second,
+		MaxIdleConns:          50,
+		MaxIdleConnsPerHost:   5,
+	}
+
+	return &http.Client{
+		Transport: transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
@@ -46,6 +46,8 @@ func (h *subscribeHandler) processEventCMCallHangup(ctx context.Context, m *sock
 
 	go h.aicallHandler.EventCMCallHangup(context.Background(), &evt)
 	go h.summaryHandler.EventCMCallHangup(context.Background(), &evt)
+	go h.extractionHandler.EventCMCallHangup(context.Background(), &evt)
+	go h.aiassistHandler.EventCMCallHangup(context.Background(), &evt)
 
 	return nil
 }
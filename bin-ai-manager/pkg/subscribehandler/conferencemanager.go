@@ -23,6 +23,7 @@ func (h *subscribeHandler) processEventCMConferenceUpdated(ctx context.Context,
 	}
 
 	go h.summaryHandler.EventCMConferenceUpdated(context.Background(), &evt)
+	go h.extractionHandler.EventCMConferenceUpdated(context.Background(), &evt)
 
 	return nil
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/gofrs/uuid"
 	"go.uber.org/mock/gomock"
 
+	"monorepo/bin-ai-manager/pkg/extractionhandler"
 	"monorepo/bin-ai-manager/pkg/summaryhandler"
 	"monorepo/bin-common-handler/models/sock"
 	cfconference "monorepo/bin-conference-manager/models/conference"
@@ -18,7 +19,7 @@ func TestProcessEventCMConferenceUpdated(t *testing.T) {
 	tests := []struct {
 		name      string
 		event     *sock.Event
-		setupMock func(*summaryhandler.MockSummaryHandler)
+		setupMock func(*summaryhandler.MockSummaryHandler, *extractionhandler.MockExtractionHandler)
 		wantError bool
 	}{
 		{
@@ -37,8 +38,9 @@ func TestProcessEventCMConferenceUpdated(t *testing.T) {
 					Data:      json.RawMessage(data),
 				}
 			}(),
-			setupMock: func(m *summaryhandler.MockSummaryHandler) {
+			setupMock: func(m *summaryhandler.MockSummaryHandler, me *extractionhandler.MockExtractionHandler) {
 				m.EXPECT().EventCMConferenceUpdated(gomock.Any(), gomock.Any()).AnyTimes()
+				me.EXPECT().EventCMConferenceUpdated(gomock.Any(), gomock.Any()).AnyTimes()
 			},
 			wantError: false,
 		},
@@ -49,7 +51,7 @@ func TestProcessEventCMConferenceUpdated(t *testing.T) {
 				Type:      string(cfconference.EventTypeConferenceUpdated),
 				Data:      json.RawMessage([]byte("invalid json")),
 			},
-			setupMock: func(m *summaryhandler.MockSummaryHandler) {
+			setupMock: func(m *summaryhandler.MockSummaryHandler, me *extractionhandler.MockExtractionHandler) {
 				// Should not be called on error
 			},
 			wantError: true,
@@ -62,10 +64,12 @@ func TestProcessEventCMConferenceUpdated(t *testing.T) {
 			defer ctrl.Finish()
 
 			mockSummaryHandler := summaryhandler.NewMockSummaryHandler(ctrl)
-			tt.setupMock(mockSummaryHandler)
+			mockExtractionHandler := extractionhandler.NewMockExtractionHandler(ctrl)
+			tt.setupMock(mockSummaryHandler, mockExtractionHandler)
 
 			h := &subscribeHandler{
-				summaryHandler: mockSummaryHandler,
+				summaryHandler:    mockSummaryHandler,
+				extractionHandler: mockExtractionHandler,
 			}
 
 			err := h.processEventCMConferenceUpdated(context.Background(), tt.event)
@@ -12,17 +12,21 @@ import (
 	cmdtmf "monorepo/bin-call-manager/models/dtmf"
 	cfconference "monorepo/bin-conference-manager/models/conference"
 	pmpipecatcall "monorepo/bin-pipecat-manager/models/pipecatcall"
+	tmtranscript "monorepo/bin-transcribe-manager/models/transcript"
 
 	commonoutline "monorepo/bin-common-handler/models/outline"
 	"monorepo/bin-common-handler/models/sock"
 	"monorepo/bin-common-handler/pkg/sockhandler"
+	"monorepo/bin-common-handler/pkg/tracehandler"
 
 	pmmessage "monorepo/bin-pipecat-manager/models/message"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
 
+	"monorepo/bin-ai-manager/pkg/aiassisthandler"
 	"monorepo/bin-ai-manager/pkg/aicallhandler"
+	"monorepo/bin-ai-manager/pkg/extractionhandler"
 	"monorepo/bin-ai-manager/pkg/messagehandler"
 	"monorepo/bin-ai-manager/pkg/summaryhandler"
 )
@@ -47,9 +51,11 @@ type subscribeHandler struct {
 	subscribeQueue   string
 	subscribeTargets []string
 
-	aicallHandler  aicallhandler.AIcallHandler
-	summaryHandler summaryhandler.SummaryHandler
-	messageHandler messagehandler.MessageHandler
+	aicallHandler     aicallhandler.AIcallHandler
+	summaryHandler    summaryhandler.SummaryHandler
+	extractionHandler extractionhandler.ExtractionHandler
+	messageHandler    messagehandler.MessageHandler
+	aiassistHandler   aiassisthandler.AIAssistHandler
 }
 
 var (
@@ -82,16 +88,20 @@ func NewSubscribeHandler(
 	subscribeTargets []string,
 	aicallHandler aicallhandler.AIcallHandler,
 	summaryHandler summaryhandler.SummaryHandler,
+	extractionHandler extractionhandler.ExtractionHandler,
 	messageHandler messagehandler.MessageHandler,
+	aiassistHandler aiassisthandler.AIAssistHandler,
 ) SubscribeHandler {
 	h := &subscribeHandler{
-		serviceName:      serviceName,
-		sockHandler:      sock,
-		subscribeQueue:   subscribeQueue,
-		subscribeTargets: subscribeTargets,
-		aicallHandler:    aicallHandler,
-		summaryHandler:   summaryHandler,
-		messageHandler:   messageHandler,
+		serviceName:       serviceName,
+		sockHandler:       sock,
+		subscribeQueue:    subscribeQueue,
+		subscribeTargets:  subscribeTargets,
+		aicallHandler:     aicallHandler,
+		summaryHandler:    summaryHandler,
+		extractionHandler: extractionHandler,
+		messageHandler:    messageHandler,
+		aiassistHandler:   aiassistHandler,
 	}
 
 	return h
@@ -140,7 +150,8 @@ func (h *subscribeHandler) processEvent(m *sock.Event) {
 		"event": m,
 	})
 
-	ctx := context.Background()
+	ctx, span := tracehandler.StartConsumerSpan(context.Background(), m)
+	defer span.End()
 
 	var err error
 	start := time.Now()
@@ -160,6 +171,10 @@ func (h *subscribeHandler) processEvent(m *sock.Event) {
 	case m.Publisher == publisherCallManager && m.Type == string(cmdtmf.EventTypeDTMFReceived):
 		err = h.processEventCMDTMFReceived(ctx, m)
 
+	// transcribe-manager
+	case m.Publisher == publisherTranscribeManager && m.Type == tmtranscript.EventTypeTranscriptCreated:
+		err = h.processEventTMTranscriptCreated(ctx, m)
+
 	// conference-manager
 	case m.Publisher == string(commonoutline.ServiceNameConferenceManager) && m.Type == string(cfconference.EventTypeConferenceUpdated):
 		err = h.processEventCMConferenceUpdated(ctx, m)
@@ -29,9 +29,11 @@ func (h *subscribeHandler) processEventPMPipecatcallInitialized(ctx context.Cont
 }
 
 // processEventPMPipecatcallTerminated dispatches pipecat-manager's
-// pipecatcall_terminated event to messagehandler.EventPMPipecatcallTerminated,
-// which is the cross-pod backstop that finalises any AIcall whose pipecatcall
-// has terminated without an explicit terminate path completing first.
+// pipecatcall_terminated event to aicallhandler.EventPMPipecatcallTerminated,
+// which adds the pipecatcall's usage to the AIcall, and then to
+// messagehandler.EventPMPipecatcallTerminated, which is the cross-pod backstop
+// that finalises any AIcall whose pipecatcall has terminated without an
+// explicit terminate path completing first.
 func (h *subscribeHandler) processEventPMPipecatcallTerminated(ctx context.Context, m *sock.Event) error {
 	log := logrus.WithFields(logrus.Fields{
 		"func":  "processEventPMPipecatcallTerminated",
@@ -45,5 +47,7 @@ func (h *subscribeHandler) processEventPMPipecatcallTerminated(ctx context.Conte
 		return errors.Wrap(err, "could not unmarshal pipecatcall_terminated payload")
 	}
 
+	h.aicallHandler.EventPMPipecatcallTerminated(ctx, &evt)
+
 	return h.messageHandler.EventPMPipecatcallTerminated(ctx, &evt)
 }
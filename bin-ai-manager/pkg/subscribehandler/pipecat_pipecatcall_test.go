@@ -78,13 +78,18 @@ func Test_processEventPMPipecatcallTerminated(t *testing.T) {
 				Publisher: "pipecat-manager",
 				Type:      pmpipecatcall.EventTypePipecatcallTerminated,
 				DataType:  "application/json",
-				Data:      []byte(`{"id":"d1ea9b4c-cb5c-11f0-b1c4-3744a2c2f8a6"}`),
+				Data:      []byte(`{"id":"d1ea9b4c-cb5c-11f0-b1c4-3744a2c2f8a6","usage":{"prompt_tokens":1200,"completion_tokens":40,"stt_seconds":12.5}}`),
 			},
 
 			expectedEvent: &pmpipecatcall.Pipecatcall{
 				Identity: commonidentity.Identity{
 					ID: uuid.FromStringOrNil("d1ea9b4c-cb5c-11f0-b1c4-3744a2c2f8a6"),
 				},
+				Usage: &pmpipecatcall.Usage{
+					PromptTokens:     1200,
+					CompletionTokens: 40,
+					STTSeconds:       12.5,
+				},
 			},
 		},
 	}
@@ -96,12 +101,15 @@ func Test_processEventPMPipecatcallTerminated(t *testing.T) {
 
 			mockSock := sockhandler.NewMockSockHandler(mc)
 			mockMessage := messagehandler.NewMockMessageHandler(mc)
+			mockAIcall := aicallhandler.NewMockAIcallHandler(mc)
 
 			h := subscribeHandler{
 				sockHandler:    mockSock,
 				messageHandler: mockMessage,
+				aicallHandler:  mockAIcall,
 			}
 
+			mockAIcall.EXPECT().EventPMPipecatcallTerminated(gomock.Any(), tt.expectedEvent)
 			mockMessage.EXPECT().EventPMPipecatcallTerminated(gomock.Any(), tt.expectedEvent).Return(nil)
 
 			if err := h.processEventPMPipecatcallTerminated(context.Background(), tt.event); err != nil {
@@ -1,28 +1,23 @@
 package subscribehandler
 
-// // processEventTMTranscriptCreated handles the call-manager's call related event
-// func (h *subscribeHandler) processEventTMTranscriptCreated(ctx context.Context, m *sock.Event) error {
-// 	log := logrus.WithFields(logrus.Fields{
-// 		"func":  "processEventTMTranscriptCreated",
-// 		"event": m,
-// 	})
+import (
+	"context"
+	"encoding/json"
 
-// 	var evt tmtranscript.Transcript
-// 	if err := json.Unmarshal([]byte(m.Data), &evt); err != nil {
-// 		log.Errorf("Could not unmarshal the data. err: %v", err)
-// 		return err
-// 	}
+	"monorepo/bin-common-handler/models/sock"
+	tmtranscript "monorepo/bin-transcribe-manager/models/transcript"
 
-// 	cb, err := h.aicallHandler.GetByTranscribeID(ctx, evt.TranscribeID)
-// 	if err != nil {
-// 		// no transcribe id found
-// 		return nil
-// 	}
+	"github.com/pkg/errors"
+)
 
-// 	if errChat := h.aicallHandler.ChatMessage(ctx, cb, evt.Message); errChat != nil {
-// 		log.Errorf("Could not chat to the aicall. err: %v", errChat)
-// 		return errors.Wrap(errChat, "could not chat to the aicall")
-// 	}
+// processEventTMTranscriptCreated handles the transcribe-manager's transcript_created event
+func (h *subscribeHandler) processEventTMTranscriptCreated(ctx context.Context, m *sock.Event) error {
+	evt := tmtranscript.Transcript{}
+	if err := json.Unmarshal([]byte(m.Data), &evt); err != nil {
+		return errors.Wrapf(err, "Could not unmarshal the data")
+	}
 
-// 	return nil
-// }
+	go h.aiassistHandler.EventTMTranscriptCreated(context.Background(), &evt)
+
+	return nil
+}
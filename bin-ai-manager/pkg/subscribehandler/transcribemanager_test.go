@@ -0,0 +1,63 @@
+package subscribehandler
+
+import (
+	"testing"
+	"time"
+
+	"monorepo/bin-ai-manager/pkg/aiassisthandler"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/models/sock"
+	tmtranscript "monorepo/bin-transcribe-manager/models/transcript"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func Test_processEventTMTranscriptCreated(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		event *sock.Event
+
+		expectedEvent *tmtranscript.Transcript
+	}{
+		{
+			name: "normal",
+
+			event: &sock.Event{
+				Publisher: "transcribe-manager",
+				Type:      "transcript_created",
+				DataType:  "application/json",
+				Data:      []byte(`{"id":"7d2e4a1c-b1fa-11f0-9b36-2f5c8e1a3d01","transcribe_id":"7d58b2e6-b1fa-11f0-a4c7-1e6d3f9b2a02","direction":"in","message":"hello"}`),
+			},
+
+			expectedEvent: &tmtranscript.Transcript{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("7d2e4a1c-b1fa-11f0-9b36-2f5c8e1a3d01"),
+				},
+				TranscribeID: uuid.FromStringOrNil("7d58b2e6-b1fa-11f0-a4c7-1e6d3f9b2a02"),
+				Direction:    tmtranscript.DirectionIn,
+				Message:      "hello",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockAIAssist := aiassisthandler.NewMockAIAssistHandler(mc)
+
+			h := subscribeHandler{
+				aiassistHandler: mockAIAssist,
+			}
+
+			mockAIAssist.EXPECT().EventTMTranscriptCreated(gomock.Any(), tt.expectedEvent)
+
+			h.processEvent(tt.event)
+
+			time.Sleep(100 * time.Millisecond)
+		})
+	}
+}
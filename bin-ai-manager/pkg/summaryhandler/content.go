@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"monorepo/bin-ai-manager/models/summary"
+	"monorepo/bin-ai-manager/models/usage"
+	"monorepo/bin-ai-manager/pkg/engine_openai_handler"
 	cmcustomer "monorepo/bin-customer-manager/models/customer"
 	tmtranscribe "monorepo/bin-transcribe-manager/models/transcribe"
 	tmtranscript "monorepo/bin-transcribe-manager/models/transcript"
@@ -60,13 +62,13 @@ func (h *summaryHandler) contentProcessReferenceTypeCall(ctx context.Context, ca
 		return errors.Wrapf(err, "could not get the transcripts")
 	}
 
-	content, err := h.contentGet(ctx, sm.ActiveflowID, transcripts)
+	content, u, err := h.contentGet(ctx, sm.ActiveflowID, transcripts)
 	if err != nil {
 		return errors.Wrapf(err, "could not send the request")
 	}
 	log.WithField("content", content).Debugf("Parsed summary content.")
 
-	tmp, err := h.UpdateStatusDone(ctx, sm.ID, content)
+	tmp, err := h.UpdateStatusDone(ctx, sm.ID, content, u)
 	if err != nil {
 		return errors.Wrapf(err, "could not update the status")
 	}
@@ -102,13 +104,13 @@ func (h *summaryHandler) contentProcessReferenceTypeConference(ctx context.Conte
 		return errors.Wrapf(err, "could not get the transcripts")
 	}
 
-	content, err := h.contentGet(ctx, sm.ActiveflowID, transcripts)
+	content, u, err := h.contentGet(ctx, sm.ActiveflowID, transcripts)
 	if err != nil {
 		return errors.Wrapf(err, "could not send the request")
 	}
 	log.WithField("content", content).Debugf("Parsed summary content.")
 
-	tmp, err := h.UpdateStatusDone(ctx, sm.ID, content)
+	tmp, err := h.UpdateStatusDone(ctx, sm.ID, content, u)
 	if err != nil {
 		return errors.Wrapf(err, "could not update the status")
 	}
@@ -155,7 +157,9 @@ func (h *summaryHandler) contentGetTranscripts(ctx context.Context, referenceID
 	return res, nil
 }
 
-func (h *summaryHandler) contentGet(ctx context.Context, activeflowID uuid.UUID, ts []tmtranscript.Transcript) (string, error) {
+// contentGet generates the summary content of the given transcripts.
+// It returns the content and the LLM usage of the generation.
+func (h *summaryHandler) contentGet(ctx context.Context, activeflowID uuid.UUID, ts []tmtranscript.Transcript) (string, usage.Usage, error) {
 	log := logrus.WithFields(logrus.Fields{
 		"func":          "contentGet",
 		"activeflow_id": activeflowID,
@@ -166,7 +170,7 @@ func (h *summaryHandler) contentGet(ctx context.Context, activeflowID uuid.UUID,
 	if activeflowID != uuid.Nil {
 		tmp, err := h.reqHandler.FlowV1VariableGet(ctx, activeflowID)
 		if err != nil {
-			return "", errors.Wrapf(err, "could not get the variable")
+			return "", usage.Usage{}, errors.Wrapf(err, "could not get the variable")
 		}
 		log.WithField("variable", tmp).Debugf("Received variable")
 
@@ -181,7 +185,7 @@ func (h *summaryHandler) contentGet(ctx context.Context, activeflowID uuid.UUID,
 
 	tmpContent, err := json.Marshal(requestContent)
 	if err != nil {
-		return "", errors.Wrapf(err, "could not marshal the data")
+		return "", usage.Usage{}, errors.Wrapf(err, "could not marshal the data")
 	}
 	log.WithField("request_content", requestContent).Debugf("Created request content.")
 
@@ -196,15 +200,15 @@ func (h *summaryHandler) contentGet(ctx context.Context, activeflowID uuid.UUID,
 	}
 	tmpRes, err := h.engineOpenaiHandler.Send(ctx, req)
 	if err != nil {
-		return "", errors.Wrapf(err, "could not send the request")
+		return "", usage.Usage{}, errors.Wrapf(err, "could not send the request")
 	}
 	log.WithField("response", tmpRes).Debugf("Received response")
 
 	if tmpRes == nil || len(tmpRes.Choices) == 0 {
 		log.Debugf("Received response with empty choices")
-		return "", nil
+		return "", usage.Usage{}, nil
 	}
 
 	res := tmpRes.Choices[0].Message.Content
-	return res, nil
+	return res, engine_openai_handler.ConvertUsage(tmpRes.Usage), nil
 }
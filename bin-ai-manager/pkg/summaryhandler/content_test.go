@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"monorepo/bin-ai-manager/models/summary"
+	"monorepo/bin-ai-manager/models/usage"
 	"monorepo/bin-ai-manager/pkg/dbhandler"
 	"monorepo/bin-ai-manager/pkg/engine_openai_handler"
 	commonidentity "monorepo/bin-common-handler/models/identity"
@@ -36,6 +37,7 @@ func Test_contentGet(t *testing.T) {
 
 		expectedRequestContent RequestContent
 		expectedRes            string
+		expectedUsage          usage.Usage
 	}{
 		{
 			name: "normal",
@@ -67,6 +69,11 @@ func Test_contentGet(t *testing.T) {
 						},
 					},
 				},
+				Usage: openai.Usage{
+					PromptTokens:     1500,
+					CompletionTokens: 120,
+					TotalTokens:      1620,
+				},
 			},
 
 			expectedRequestContent: RequestContent{
@@ -88,6 +95,10 @@ func Test_contentGet(t *testing.T) {
 				},
 			},
 			expectedRes: "response content",
+			expectedUsage: usage.Usage{
+				PromptTokens:     1500,
+				CompletionTokens: 120,
+			},
 		},
 	}
 
@@ -129,7 +140,7 @@ func Test_contentGet(t *testing.T) {
 			}
 			mockOpenai.EXPECT().Send(ctx, tmpRequestContent).Return(tt.responseOpenai, nil)
 
-			res, err := h.contentGet(ctx, tt.activeflowID, tt.transcripts)
+			res, resUsage, err := h.contentGet(ctx, tt.activeflowID, tt.transcripts)
 			if err != nil {
 				t.Errorf("Wrong match. expect: ok, got: %v", err)
 			}
@@ -137,6 +148,9 @@ func Test_contentGet(t *testing.T) {
 			if !reflect.DeepEqual(res, tt.expectedRes) {
 				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectedRes, res)
 			}
+			if resUsage != tt.expectedUsage {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectedUsage, resUsage)
+			}
 		})
 	}
 }
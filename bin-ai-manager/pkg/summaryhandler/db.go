@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"monorepo/bin-ai-manager/models/summary"
+	"monorepo/bin-ai-manager/models/usage"
 	"monorepo/bin-ai-manager/pkg/dbhandler"
 	cerrors "monorepo/bin-common-handler/models/errors"
 	commonidentity "monorepo/bin-common-handler/models/identity"
@@ -25,6 +26,7 @@ func (h *summaryHandler) Create(
 	status summary.Status,
 	language string,
 	content string,
+	u usage.Usage,
 ) (*summary.Summary, error) {
 
 	id := h.utilHandler.UUIDCreate()
@@ -44,6 +46,8 @@ func (h *summaryHandler) Create(
 		Status:   status,
 		Language: language,
 		Content:  content,
+
+		Usage: u,
 	}
 
 	if errCreate := h.db.SummaryCreate(ctx, m); errCreate != nil {
@@ -150,11 +154,14 @@ func (h *summaryHandler) Delete(ctx context.Context, id uuid.UUID) (*summary.Sum
 	return res, nil
 }
 
-// UpdateStatusDone updates the summary status to done.
-func (h *summaryHandler) UpdateStatusDone(ctx context.Context, id uuid.UUID, content string) (*summary.Summary, error) {
+// UpdateStatusDone updates the summary status to done with the generated content and its LLM usage.
+func (h *summaryHandler) UpdateStatusDone(ctx context.Context, id uuid.UUID, content string, u usage.Usage) (*summary.Summary, error) {
 	fields := map[summary.Field]any{
-		summary.FieldStatus:  summary.StatusDone,
-		summary.FieldContent: content,
+		summary.FieldStatus:           summary.StatusDone,
+		summary.FieldContent:          content,
+		summary.FieldPromptTokens:     u.PromptTokens,
+		summary.FieldCompletionTokens: u.CompletionTokens,
+		summary.FieldCachedTokens:     u.CachedTokens,
 	}
 	if err := h.db.SummaryUpdate(ctx, id, fields); err != nil {
 		return nil, errors.Wrapf(err, "could not update the summary")
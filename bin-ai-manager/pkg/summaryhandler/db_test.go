@@ -3,6 +3,7 @@ package summaryhandler
 import (
 	"context"
 	"monorepo/bin-ai-manager/models/summary"
+	"monorepo/bin-ai-manager/models/usage"
 	"monorepo/bin-ai-manager/pkg/dbhandler"
 	commonidentity "monorepo/bin-common-handler/models/identity"
 	"monorepo/bin-common-handler/pkg/notifyhandler"
@@ -28,6 +29,7 @@ func Test_Create(t *testing.T) {
 		status        summary.Status
 		language      string
 		content       string
+		usage         usage.Usage
 
 		responseUUID uuid.UUID
 
@@ -45,6 +47,10 @@ func Test_Create(t *testing.T) {
 			status:        summary.StatusDone,
 			language:      "en-US",
 			content:       "Hello, world!",
+			usage: usage.Usage{
+				PromptTokens:     2400,
+				CompletionTokens: 180,
+			},
 
 			responseUUID: uuid.FromStringOrNil("57c44d50-0b8f-11f0-91ab-174598f05899"),
 
@@ -62,6 +68,11 @@ func Test_Create(t *testing.T) {
 				Status:   summary.StatusDone,
 				Language: "en-US",
 				Content:  "Hello, world!",
+
+				Usage: usage.Usage{
+					PromptTokens:     2400,
+					CompletionTokens: 180,
+				},
 			},
 			expectedVariables: map[string]string{
 				variableSummaryID:            "57c44d50-0b8f-11f0-91ab-174598f05899",
@@ -113,7 +124,7 @@ func Test_Create(t *testing.T) {
 
 			mockNotify.EXPECT().PublishWebhookEvent(ctx, tt.expectedSummary.CustomerID, summary.EventTypeCreated, tt.expectedSummary)
 
-			res, err := h.Create(ctx, tt.customerID, tt.activeflowID, tt.onEndFlowID, tt.referenceType, tt.referenceID, tt.status, tt.language, tt.content)
+			res, err := h.Create(ctx, tt.customerID, tt.activeflowID, tt.onEndFlowID, tt.referenceType, tt.referenceID, tt.status, tt.language, tt.content, tt.usage)
 			if err != nil {
 				t.Errorf("Wrong match. expect: ok, got: %v", err)
 			}
@@ -395,21 +406,36 @@ func Test_UpdateStatusDone(t *testing.T) {
 
 		id      uuid.UUID
 		content string
+		usage   usage.Usage
 
 		responseSummary *summary.Summary
 
-		expectedRes *summary.Summary
+		expectedFields map[summary.Field]any
+		expectedRes    *summary.Summary
 	}{
 		{
 			name: "normal",
 
 			id:      uuid.FromStringOrNil("fa821a22-0bd5-11f0-b67e-8728e18c09de"),
 			content: "Hello, world!",
+			usage: usage.Usage{
+				PromptTokens:     2400,
+				CompletionTokens: 180,
+				CachedTokens:     1024,
+			},
 
 			responseSummary: &summary.Summary{
 				Identity: commonidentity.Identity{},
 			},
 
+			expectedFields: map[summary.Field]any{
+				summary.FieldStatus:           summary.StatusDone,
+				summary.FieldContent:          "Hello, world!",
+				summary.FieldPromptTokens:     int64(2400),
+				summary.FieldCompletionTokens: int64(180),
+				summary.FieldCachedTokens:     int64(1024),
+			},
+
 			expectedRes: &summary.Summary{
 				Identity: commonidentity.Identity{
 					ID: uuid.FromStringOrNil("fa821a22-0bd5-11f0-b67e-8728e18c09de"),
@@ -436,11 +462,11 @@ func Test_UpdateStatusDone(t *testing.T) {
 			}
 			ctx := context.Background()
 
-			mockDB.EXPECT().SummaryUpdate(ctx, tt.id, gomock.Any()).Return(nil)
+			mockDB.EXPECT().SummaryUpdate(ctx, tt.id, tt.expectedFields).Return(nil)
 			mockDB.EXPECT().SummaryGet(ctx, tt.id).Return(tt.responseSummary, nil)
 			mockNotify.EXPECT().PublishWebhookEvent(ctx, tt.responseSummary.CustomerID, summary.EventTypeUpdated, tt.responseSummary)
 
-			res, err := h.UpdateStatusDone(ctx, tt.id, tt.content)
+			res, err := h.UpdateStatusDone(ctx, tt.id, tt.content, tt.usage)
 			if err != nil {
 				t.Errorf("Wrong match. expect: ok, got: %v", err)
 			}
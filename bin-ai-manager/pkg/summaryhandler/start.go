@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"monorepo/bin-ai-manager/models/summary"
+	"monorepo/bin-ai-manager/models/usage"
 	cmcall "monorepo/bin-call-manager/models/call"
 	cfconference "monorepo/bin-conference-manager/models/conference"
 	cmcustomer "monorepo/bin-customer-manager/models/customer"
@@ -112,6 +113,7 @@ func (h *summaryHandler) startReferenceTypeCall(
 		summary.StatusProgressing,
 		language,
 		"",
+		usage.Usage{},
 	)
 	if err != nil {
 		return nil, errors.Wrapf(err, "could not create the summary")
@@ -175,6 +177,7 @@ func (h *summaryHandler) startReferenceTypeConference(
 		summary.StatusProgressing,
 		language,
 		"",
+		usage.Usage{},
 	)
 	if err != nil {
 		return nil, errors.Wrapf(err, "could not create the summary")
@@ -209,13 +212,13 @@ func (h *summaryHandler) startReferenceTypeTranscribe(
 		return nil, errors.Wrapf(err, "could not get the transcribe data")
 	}
 
-	content, err := h.contentGet(ctx, activeflowID, ts)
+	content, u, err := h.contentGet(ctx, activeflowID, ts)
 	if err != nil {
 		return nil, errors.Wrapf(err, "could not send the request")
 	}
 	log.WithField("content", content).Debugf("Parsed summary content.")
 
-	res, err := h.Create(ctx, customerID, activeflowID, onEndFlowID, summary.ReferenceTypeTranscribe, referenceID, summary.StatusDone, language, content)
+	res, err := h.Create(ctx, customerID, activeflowID, onEndFlowID, summary.ReferenceTypeTranscribe, referenceID, summary.StatusDone, language, content, u)
 	if err != nil {
 		return nil, errors.Wrapf(err, "could not create the summary")
 	}
@@ -276,13 +279,13 @@ func (h *summaryHandler) startReferenceTypeRecording(
 		return nil, errors.Wrapf(err, "could not get the transcribe data")
 	}
 
-	content, err := h.contentGet(ctx, activeflowID, transcripts)
+	content, u, err := h.contentGet(ctx, activeflowID, transcripts)
 	if err != nil {
 		return nil, errors.Wrapf(err, "could not send the request")
 	}
 	log.WithField("content", content).Debugf("Parsed summary content.")
 
-	res, err := h.Create(ctx, customerID, activeflowID, onEndFlowID, summary.ReferenceTypeRecording, referenceID, summary.StatusDone, language, content)
+	res, err := h.Create(ctx, customerID, activeflowID, onEndFlowID, summary.ReferenceTypeRecording, referenceID, summary.StatusDone, language, content, u)
 	if err != nil {
 		return nil, errors.Wrapf(err, "could not create the summary")
 	}
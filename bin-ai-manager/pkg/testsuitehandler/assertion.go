@@ -0,0 +1,117 @@
+package testsuitehandler
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"monorepo/bin-ai-manager/models/message"
+	"monorepo/bin-ai-manager/models/testrun"
+	"monorepo/bin-ai-manager/models/testsuite"
+)
+
+// evaluateAssertions checks the assertions against the aicall's messages.
+// turns is the number of the user turns sent, and finished tells whether the
+// conversation has finished within the scenario's turn limit.
+func evaluateAssertions(assertions []testsuite.Assertion, msgs []*message.Message, turns int, finished bool) []testrun.AssertionResult {
+	replies := []string{}
+	toolCalls := []message.ToolCall{}
+	for _, m := range msgs {
+		if m.Role != message.RoleAssistant {
+			continue
+		}
+		if m.Content != "" {
+			replies = append(replies, strings.ToLower(m.Content))
+		}
+		toolCalls = append(toolCalls, m.ToolCalls...)
+	}
+
+	res := make([]testrun.AssertionResult, 0, len(assertions))
+	for _, a := range assertions {
+		r := testrun.AssertionResult{
+			Assertion: a,
+		}
+
+		switch a.Type {
+		case testsuite.AssertionTypeToolCalled:
+			r.Passed = isToolCalled(toolCalls, a.ToolName, a.Arguments)
+			if !r.Passed {
+				r.Reason = fmt.Sprintf("the tool %s was not called with the given arguments.", a.ToolName)
+			}
+
+		case testsuite.AssertionTypeToolNotCalled:
+			r.Passed = !isToolCalled(toolCalls, a.ToolName, a.Arguments)
+			if !r.Passed {
+				r.Reason = fmt.Sprintf("the tool %s was called.", a.ToolName)
+			}
+
+		case testsuite.AssertionTypeMustMention:
+			r.Passed = isMentioned(replies, a.Text)
+			if !r.Passed {
+				r.Reason = "no reply mentioned the text."
+			}
+
+		case testsuite.AssertionTypeMustNotMention:
+			r.Passed = !isMentioned(replies, a.Text)
+			if !r.Passed {
+				r.Reason = "a reply mentioned the text."
+			}
+
+		case testsuite.AssertionTypeMaxTurns:
+			r.Passed = finished && turns <= a.Value
+			if !r.Passed {
+				r.Reason = fmt.Sprintf("the conversation did not finish within %d turns.", a.Value)
+			}
+
+		default:
+			r.Reason = fmt.Sprintf("unsupported assertion type: %s", a.Type)
+		}
+
+		res = append(res, r)
+	}
+
+	return res
+}
+
+// isMentioned returns true if any of the lower-cased replies contains the text case-insensitively.
+func isMentioned(replies []string, text string) bool {
+	tmp := strings.ToLower(text)
+	for _, r := range replies {
+		if strings.Contains(r, tmp) {
+			return true
+		}
+	}
+	return false
+}
+
+// isToolCalled returns true if the tool was called with every given argument.
+func isToolCalled(toolCalls []message.ToolCall, name string, arguments map[string]any) bool {
+	for _, tc := range toolCalls {
+		if string(tc.Function.Name) != name {
+			continue
+		}
+
+		if len(arguments) == 0 {
+			return true
+		}
+
+		called := map[string]any{}
+		if err := json.Unmarshal([]byte(tc.Function.Arguments), &called); err != nil {
+			continue
+		}
+
+		matched := true
+		for k, v := range arguments {
+			if !reflect.DeepEqual(called[k], v) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,155 @@
+package testsuitehandler
+
+import (
+	"reflect"
+	"testing"
+
+	"monorepo/bin-ai-manager/models/message"
+	"monorepo/bin-ai-manager/models/testrun"
+	"monorepo/bin-ai-manager/models/testsuite"
+)
+
+func Test_evaluateAssertions(t *testing.T) {
+	msgs := []*message.Message{
+		{
+			Role:    message.RoleAssistant,
+			Content: "I have transferred your call to the Billing team.",
+		},
+		{
+			Role: message.RoleAssistant,
+			ToolCalls: []message.ToolCall{
+				{
+					ID:   "call_1",
+					Type: message.ToolTypeFunction,
+					Function: message.FunctionCall{
+						Name:      message.FunctionCallNameConnectCall,
+						Arguments: `{"destinations":[{"type":"agent","target":"billing"}],"run_llm":true}`,
+					},
+				},
+			},
+		},
+		{
+			Role:    message.RoleUser,
+			Content: "Can I get a refund?",
+		},
+	}
+
+	tests := []struct {
+		name string
+
+		assertions []testsuite.Assertion
+		turns      int
+		finished   bool
+
+		expectRes []testrun.AssertionResult
+	}{
+		{
+			name: "tool called",
+
+			assertions: []testsuite.Assertion{
+				{Type: testsuite.AssertionTypeToolCalled, ToolName: "connect_call"},
+				{Type: testsuite.AssertionTypeToolCalled, ToolName: "connect_call", Arguments: map[string]any{"run_llm": true}},
+				{Type: testsuite.AssertionTypeToolCalled, ToolName: "connect_call", Arguments: map[string]any{"run_llm": false}},
+				{Type: testsuite.AssertionTypeToolNotCalled, ToolName: "send_email"},
+				{Type: testsuite.AssertionTypeToolNotCalled, ToolName: "connect_call"},
+			},
+			turns:    1,
+			finished: true,
+
+			expectRes: []testrun.AssertionResult{
+				{
+					Assertion: testsuite.Assertion{Type: testsuite.AssertionTypeToolCalled, ToolName: "connect_call"},
+					Passed:    true,
+				},
+				{
+					Assertion: testsuite.Assertion{Type: testsuite.AssertionTypeToolCalled, ToolName: "connect_call", Arguments: map[string]any{"run_llm": true}},
+					Passed:    true,
+				},
+				{
+					Assertion: testsuite.Assertion{Type: testsuite.AssertionTypeToolCalled, ToolName: "connect_call", Arguments: map[string]any{"run_llm": false}},
+					Reason:    "the tool connect_call was not called with the given arguments.",
+				},
+				{
+					Assertion: testsuite.Assertion{Type: testsuite.AssertionTypeToolNotCalled, ToolName: "send_email"},
+					Passed:    true,
+				},
+				{
+					Assertion: testsuite.Assertion{Type: testsuite.AssertionTypeToolNotCalled, ToolName: "connect_call"},
+					Reason:    "the tool connect_call was called.",
+				},
+			},
+		},
+		{
+			name: "mention",
+
+			assertions: []testsuite.Assertion{
+				{Type: testsuite.AssertionTypeMustMention, Text: "billing team"},
+				{Type: testsuite.AssertionTypeMustMention, Text: "refund"},
+				{Type: testsuite.AssertionTypeMustNotMention, Text: "refund"},
+				{Type: testsuite.AssertionTypeMustNotMention, Text: "TRANSFERRED"},
+			},
+			turns:    1,
+			finished: true,
+
+			expectRes: []testrun.AssertionResult{
+				{
+					Assertion: testsuite.Assertion{Type: testsuite.AssertionTypeMustMention, Text: "billing team"},
+					Passed:    true,
+				},
+				{
+					Assertion: testsuite.Assertion{Type: testsuite.AssertionTypeMustMention, Text: "refund"},
+					Reason:    "no reply mentioned the text.",
+				},
+				{
+					Assertion: testsuite.Assertion{Type: testsuite.AssertionTypeMustNotMention, Text: "refund"},
+					Passed:    true,
+				},
+				{
+					Assertion: testsuite.Assertion{Type: testsuite.AssertionTypeMustNotMention, Text: "TRANSFERRED"},
+					Reason:    "a reply mentioned the text.",
+				},
+			},
+		},
+		{
+			name: "max turns",
+
+			assertions: []testsuite.Assertion{
+				{Type: testsuite.AssertionTypeMaxTurns, Value: 3},
+			},
+			turns:    3,
+			finished: true,
+
+			expectRes: []testrun.AssertionResult{
+				{
+					Assertion: testsuite.Assertion{Type: testsuite.AssertionTypeMaxTurns, Value: 3},
+					Passed:    true,
+				},
+			},
+		},
+		{
+			name: "max turns not finished",
+
+			assertions: []testsuite.Assertion{
+				{Type: testsuite.AssertionTypeMaxTurns, Value: 3},
+			},
+			turns:    3,
+			finished: false,
+
+			expectRes: []testrun.AssertionResult{
+				{
+					Assertion: testsuite.Assertion{Type: testsuite.AssertionTypeMaxTurns, Value: 3},
+					Reason:    "the conversation did not finish within 3 turns.",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := evaluateAssertions(tt.assertions, msgs, tt.turns, tt.finished)
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package testsuitehandler
+
+import (
+	"context"
+	stderrors "errors"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	cerrors "monorepo/bin-common-handler/models/errors"
+	"monorepo/bin-common-handler/models/identity"
+	commonoutline "monorepo/bin-common-handler/models/outline"
+
+	"monorepo/bin-ai-manager/models/testsuite"
+	"monorepo/bin-ai-manager/pkg/dbhandler"
+)
+
+// Create creates a new test suite record.
+func (h *testSuiteHandler) Create(
+	ctx context.Context,
+	customerID uuid.UUID,
+	aiID uuid.UUID,
+	name string,
+	detail string,
+	scenarios []testsuite.Scenario,
+) (*testsuite.TestSuite, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":        "Create",
+		"customer_id": customerID,
+		"ai_id":       aiID,
+	})
+
+	if err := validateScenarios(scenarios); err != nil {
+		return nil, cerrors.InvalidArgument(commonoutline.ServiceNameAIManager, "INVALID_TEST_SUITE", err.Error()).Wrap(err)
+	}
+
+	a, err := h.aiHandler.Get(ctx, aiID)
+	if err != nil {
+		return nil, err
+	}
+	if a.CustomerID != customerID {
+		return nil, cerrors.InvalidArgument(commonoutline.ServiceNameAIManager, "INVALID_TEST_SUITE", "The ai does not belong to the customer.")
+	}
+
+	s := &testsuite.TestSuite{
+		Identity: identity.Identity{
+			ID:         h.utilHandler.UUIDCreate(),
+			CustomerID: customerID,
+		},
+		AIID:      aiID,
+		Name:      name,
+		Detail:    detail,
+		Scenarios: scenarios,
+	}
+
+	if err := h.db.TestSuiteCreate(ctx, s); err != nil {
+		return nil, errors.Wrapf(err, "could not create test suite")
+	}
+
+	res, err := h.db.TestSuiteGet(ctx, s.ID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get created test suite")
+	}
+	log.WithField("test_suite", res).Debugf("Created test suite. test_suite_id: %s", res.ID)
+	h.notifyHandler.PublishWebhookEvent(ctx, res.CustomerID, testsuite.EventTypeCreated, res)
+
+	return res, nil
+}
+
+// Get returns test suite.
+func (h *testSuiteHandler) Get(ctx context.Context, id uuid.UUID) (*testsuite.TestSuite, error) {
+	res, err := h.db.TestSuiteGet(ctx, id)
+	if err != nil {
+		if stderrors.Is(err, dbhandler.ErrNotFound) {
+			return nil, cerrors.NotFound(
+				commonoutline.ServiceNameAIManager,
+				"TEST_SUITE_NOT_FOUND",
+				"The test suite was not found.",
+			).Wrap(err)
+		}
+		return nil, errors.Wrapf(err, "could not get test suite")
+	}
+
+	return res, nil
+}
+
+// List returns list of test suites.
+func (h *testSuiteHandler) List(ctx context.Context, size uint64, token string, filters map[testsuite.Field]any) ([]*testsuite.TestSuite, error) {
+	res, err := h.db.TestSuiteList(ctx, size, token, filters)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not list test suites")
+	}
+
+	return res, nil
+}
+
+// Delete deletes the test suite.
+// The test runs of the suite are kept as the report history.
+func (h *testSuiteHandler) Delete(ctx context.Context, id uuid.UUID) (*testsuite.TestSuite, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":          "Delete",
+		"test_suite_id": id,
+	})
+
+	if err := h.db.TestSuiteDelete(ctx, id); err != nil {
+		return nil, errors.Wrapf(err, "could not delete test suite")
+	}
+
+	res, err := h.db.TestSuiteGet(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get deleted test suite")
+	}
+	log.WithField("test_suite", res).Debugf("Deleted test suite. test_suite_id: %s", res.ID)
+	h.notifyHandler.PublishWebhookEvent(ctx, res.CustomerID, testsuite.EventTypeDeleted, res)
+
+	return res, nil
+}
+
+// Update updates the test suite.
+func (h *testSuiteHandler) Update(
+	ctx context.Context,
+	id uuid.UUID,
+	name string,
+	detail string,
+	scenarios []testsuite.Scenario,
+) (*testsuite.TestSuite, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":          "Update",
+		"test_suite_id": id,
+	})
+
+	if err := validateScenarios(scenarios); err != nil {
+		return nil, cerrors.InvalidArgument(commonoutline.ServiceNameAIManager, "INVALID_TEST_SUITE", err.Error()).Wrap(err)
+	}
+
+	fields := map[testsuite.Field]any{
+		testsuite.FieldName:      name,
+		testsuite.FieldDetail:    detail,
+		testsuite.FieldScenarios: scenarios,
+	}
+
+	if err := h.db.TestSuiteUpdate(ctx, id, fields); err != nil {
+		return nil, errors.Wrapf(err, "could not update test suite")
+	}
+
+	res, err := h.db.TestSuiteGet(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get updated test suite")
+	}
+	log.WithField("test_suite", res).Debugf("Updated test suite. test_suite_id: %s", res.ID)
+	h.notifyHandler.PublishWebhookEvent(ctx, res.CustomerID, testsuite.EventTypeUpdated, res)
+
+	return res, nil
+}
@@ -0,0 +1,177 @@
+package testsuitehandler
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	"monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/notifyhandler"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+
+	"monorepo/bin-ai-manager/models/ai"
+	"monorepo/bin-ai-manager/models/testsuite"
+	"monorepo/bin-ai-manager/pkg/aihandler"
+	"monorepo/bin-ai-manager/pkg/dbhandler"
+)
+
+func Test_Create(t *testing.T) {
+	customerID := uuid.FromStringOrNil("31c7e5a2-ad62-11f0-9b1e-4d6f8a0c2e01")
+	aiID := uuid.FromStringOrNil("31f2d4b6-ad62-11f0-a8c3-7e9b1d3f5a02")
+	testSuiteID := uuid.FromStringOrNil("321e8f0a-ad62-11f0-86d7-2a4c6e8b0d03")
+
+	scenarios := []testsuite.Scenario{
+		{
+			Name:  "greeting",
+			Type:  testsuite.ScenarioTypeScripted,
+			Turns: []string{"hello"},
+			Assertions: []testsuite.Assertion{
+				{Type: testsuite.AssertionTypeMustMention, Text: "welcome"},
+			},
+		},
+	}
+
+	tests := []struct {
+		name string
+
+		customerID uuid.UUID
+		aiID       uuid.UUID
+		suiteName  string
+		detail     string
+		scenarios  []testsuite.Scenario
+
+		responseAI        *ai.AI
+		responseUUID      uuid.UUID
+		responseTestSuite *testsuite.TestSuite
+
+		expectTestSuite *testsuite.TestSuite
+		expectErr       bool
+	}{
+		{
+			name: "normal",
+
+			customerID: customerID,
+			aiID:       aiID,
+			suiteName:  "greeting",
+			detail:     "greeting regression",
+			scenarios:  scenarios,
+
+			responseAI: &ai.AI{
+				Identity: identity.Identity{
+					ID:         aiID,
+					CustomerID: customerID,
+				},
+			},
+			responseUUID: testSuiteID,
+			responseTestSuite: &testsuite.TestSuite{
+				Identity: identity.Identity{
+					ID:         testSuiteID,
+					CustomerID: customerID,
+				},
+			},
+
+			expectTestSuite: &testsuite.TestSuite{
+				Identity: identity.Identity{
+					ID:         testSuiteID,
+					CustomerID: customerID,
+				},
+				AIID:      aiID,
+				Name:      "greeting",
+				Detail:    "greeting regression",
+				Scenarios: scenarios,
+			},
+		},
+		{
+			name: "ai of another customer",
+
+			customerID: customerID,
+			aiID:       aiID,
+			suiteName:  "greeting",
+			scenarios:  scenarios,
+
+			responseAI: &ai.AI{
+				Identity: identity.Identity{
+					ID:         aiID,
+					CustomerID: uuid.FromStringOrNil("3249b7ce-ad62-11f0-b5f2-9c1e3a5d7f04"),
+				},
+			},
+
+			expectErr: true,
+		},
+		{
+			name: "invalid scenarios",
+
+			customerID: customerID,
+			aiID:       aiID,
+			suiteName:  "greeting",
+
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockAI := aihandler.NewMockAIHandler(mc)
+
+			h := &testSuiteHandler{
+				utilHandler:   mockUtil,
+				notifyHandler: mockNotify,
+				db:            mockDB,
+				aiHandler:     mockAI,
+			}
+			ctx := context.Background()
+
+			if tt.responseAI != nil {
+				mockAI.EXPECT().Get(ctx, tt.aiID).Return(tt.responseAI, nil)
+			}
+			if tt.expectTestSuite != nil {
+				mockUtil.EXPECT().UUIDCreate().Return(tt.responseUUID)
+				mockDB.EXPECT().TestSuiteCreate(ctx, tt.expectTestSuite).Return(nil)
+				mockDB.EXPECT().TestSuiteGet(ctx, tt.responseUUID).Return(tt.responseTestSuite, nil)
+				mockNotify.EXPECT().PublishWebhookEvent(ctx, tt.responseTestSuite.CustomerID, testsuite.EventTypeCreated, tt.responseTestSuite)
+			}
+
+			res, err := h.Create(ctx, tt.customerID, tt.aiID, tt.suiteName, tt.detail, tt.scenarios)
+			if tt.expectErr {
+				if err == nil {
+					t.Errorf("Wrong match. expect: error, got: ok")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.responseTestSuite) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.responseTestSuite, res)
+			}
+		})
+	}
+}
+
+func Test_Get_notFound(t *testing.T) {
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockDB := dbhandler.NewMockDBHandler(mc)
+	h := &testSuiteHandler{
+		db: mockDB,
+	}
+	ctx := context.Background()
+
+	id := uuid.FromStringOrNil("5f3a1c7e-ad62-11f0-8e4d-1b3d5f7a9c05")
+	mockDB.EXPECT().TestSuiteGet(ctx, id).Return(nil, dbhandler.ErrNotFound)
+
+	if _, err := h.Get(ctx, id); err == nil {
+		t.Errorf("Wrong match. expect: error, got: ok")
+	}
+}
@@ -0,0 +1,122 @@
+package testsuitehandler
+
+//go:generate mockgen -package testsuitehandler -destination ./mock_main.go -source main.go -build_flags=-mod=mod
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"monorepo/bin-common-handler/pkg/notifyhandler"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+
+	"monorepo/bin-ai-manager/models/testrun"
+	"monorepo/bin-ai-manager/models/testsuite"
+	"monorepo/bin-ai-manager/pkg/aicallhandler"
+	"monorepo/bin-ai-manager/pkg/aihandler"
+	"monorepo/bin-ai-manager/pkg/analysishandler"
+	"monorepo/bin-ai-manager/pkg/dbhandler"
+)
+
+// TestSuiteHandler provides CRUD operations for TestSuite resources and runs
+// them against the suite's AI.
+//
+// Each scenario of a run is a text-only aicall (reference type test_run) driven
+// through the same Send path the chat uses, so no telephony is involved. The
+// tools called by the AI in a test run are recorded but never executed.
+type TestSuiteHandler interface {
+	Create(
+		ctx context.Context,
+		customerID uuid.UUID,
+		aiID uuid.UUID,
+		name string,
+		detail string,
+		scenarios []testsuite.Scenario,
+	) (*testsuite.TestSuite, error)
+	Get(ctx context.Context, id uuid.UUID) (*testsuite.TestSuite, error)
+	List(ctx context.Context, size uint64, token string, filters map[testsuite.Field]any) ([]*testsuite.TestSuite, error)
+	Delete(ctx context.Context, id uuid.UUID) (*testsuite.TestSuite, error)
+	Update(
+		ctx context.Context,
+		id uuid.UUID,
+		name string,
+		detail string,
+		scenarios []testsuite.Scenario,
+	) (*testsuite.TestSuite, error)
+
+	Run(ctx context.Context, id uuid.UUID) (*testrun.TestRun, error)
+
+	TestRunGet(ctx context.Context, id uuid.UUID) (*testrun.TestRun, error)
+	TestRunList(ctx context.Context, size uint64, token string, filters map[testrun.Field]any) ([]*testrun.TestRun, error)
+	TestRunDelete(ctx context.Context, id uuid.UUID) (*testrun.TestRun, error)
+}
+
+type testSuiteHandler struct {
+	utilHandler   utilhandler.UtilHandler
+	notifyHandler notifyhandler.NotifyHandler
+	db            dbhandler.DBHandler
+
+	aiHandler       aihandler.AIHandler
+	aicallHandler   aicallhandler.AIcallHandler
+	analysisHandler analysishandler.AnalysisHandler
+
+	// replyTimeout and pollInterval bound the wait for the AI's reply to a user turn.
+	replyTimeout time.Duration
+	pollInterval time.Duration
+}
+
+const (
+	// maxProgressingRuns caps the concurrent test runs per customer to bound the LLM spend.
+	maxProgressingRuns = 3
+
+	// maxMessages caps the number of aicall messages read for the assertions.
+	maxMessages = 500
+
+	defaultReplyTimeout = 30 * time.Second // same as the text aicall's pipecatcall lifetime.
+	defaultPollInterval = 500 * time.Millisecond
+)
+
+var (
+	metricsNamespace = "ai_manager"
+
+	// test_run_scenario_total counts finished test scenarios by result.
+	promTestRunScenarioTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "test_run_scenario_total",
+			Help:      "Total number of finished test run scenarios by result.",
+		},
+		[]string{"result"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(
+		promTestRunScenarioTotal,
+	)
+}
+
+// NewTestSuiteHandler creates a new TestSuiteHandler
+func NewTestSuiteHandler(
+	notifyHandler notifyhandler.NotifyHandler,
+	db dbhandler.DBHandler,
+
+	aiHandler aihandler.AIHandler,
+	aicallHandler aicallhandler.AIcallHandler,
+	analysisHandler analysishandler.AnalysisHandler,
+) TestSuiteHandler {
+	return &testSuiteHandler{
+		utilHandler:   utilhandler.NewUtilHandler(),
+		notifyHandler: notifyHandler,
+		db:            db,
+
+		aiHandler:       aiHandler,
+		aicallHandler:   aicallHandler,
+		analysisHandler: analysisHandler,
+
+		replyTimeout: defaultReplyTimeout,
+		pollInterval: defaultPollInterval,
+	}
+}
@@ -0,0 +1,249 @@
+package testsuitehandler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	cerrors "monorepo/bin-common-handler/models/errors"
+	commonoutline "monorepo/bin-common-handler/models/outline"
+
+	"monorepo/bin-ai-manager/internal/config"
+	"monorepo/bin-ai-manager/models/aicall"
+	"monorepo/bin-ai-manager/models/message"
+	"monorepo/bin-ai-manager/models/testrun"
+	"monorepo/bin-ai-manager/models/testsuite"
+)
+
+// Run starts a test run of the test suite against the suite's AI and returns
+// the progressing test run. The scenarios run one by one in the background and
+// the test run is updated with the results when all of them are done.
+func (h *testSuiteHandler) Run(ctx context.Context, id uuid.UUID) (*testrun.TestRun, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":          "Run",
+		"test_suite_id": id,
+	})
+
+	s, err := h.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if s.TMDelete != nil {
+		return nil, cerrors.NotFound(commonoutline.ServiceNameAIManager, "TEST_SUITE_NOT_FOUND", "The test suite was not found.")
+	}
+
+	filters := map[testrun.Field]any{
+		testrun.FieldCustomerID: s.CustomerID,
+		testrun.FieldStatus:     testrun.StatusProgressing,
+		testrun.FieldDeleted:    false,
+	}
+	progressing, err := h.db.TestRunList(ctx, maxProgressingRuns, "", filters)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not count progressing test runs")
+	}
+	if len(progressing) >= maxProgressingRuns {
+		return nil, cerrors.ResourceExhausted(
+			commonoutline.ServiceNameAIManager,
+			"TEST_RUN_LIMIT_EXCEEDED",
+			fmt.Sprintf("The customer already has %d test runs in progress.", len(progressing)),
+		)
+	}
+
+	a, err := h.aiHandler.Get(ctx, s.AIID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get the ai. ai_id: %s", s.AIID)
+	}
+
+	res, err := h.testRunCreate(ctx, s.CustomerID, s.ID, a.ID, a.CurrentPromptHistoryID)
+	if err != nil {
+		return nil, err
+	}
+	log.WithField("test_run", res).Debugf("Created test run. test_run_id: %s", res.ID)
+
+	go h.run(context.Background(), res, s)
+
+	return res, nil
+}
+
+// run runs every scenario of the test suite and updates the test run with the results.
+func (h *testSuiteHandler) run(ctx context.Context, tr *testrun.TestRun, s *testsuite.TestSuite) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":        "run",
+		"test_run_id": tr.ID,
+	})
+
+	status := testrun.StatusPassed
+	results := make([]testrun.Result, 0, len(s.Scenarios))
+	for _, sc := range s.Scenarios {
+		r := h.runScenario(ctx, tr, &sc)
+		if !r.Passed {
+			status = testrun.StatusFailed
+		}
+		promTestRunScenarioTotal.WithLabelValues(resultLabel(r)).Inc()
+		results = append(results, r)
+	}
+
+	if _, err := h.testRunUpdateResults(ctx, tr.ID, status, results); err != nil {
+		log.Errorf("Could not update the test run results. err: %v", err)
+		return
+	}
+	log.Debugf("Finished the test run. status: %s", status)
+}
+
+// runScenario plays the scenario on a new text-only aicall and evaluates its assertions.
+func (h *testSuiteHandler) runScenario(ctx context.Context, tr *testrun.TestRun, sc *testsuite.Scenario) testrun.Result {
+	log := logrus.WithFields(logrus.Fields{
+		"func":        "runScenario",
+		"test_run_id": tr.ID,
+		"scenario":    sc.Name,
+	})
+
+	res := testrun.Result{
+		Scenario: sc.Name,
+	}
+
+	ac, err := h.aicallHandler.Start(ctx, aicall.AssistanceTypeAI, tr.AIID, uuid.Nil, aicall.ReferenceTypeTestRun, tr.ID)
+	if err != nil {
+		log.Errorf("Could not start the aicall. err: %v", err)
+		res.Error = "could not start the aicall"
+		return res
+	}
+	res.AIcallID = ac.ID
+	defer func() {
+		if _, errTerminate := h.aicallHandler.ProcessTerminate(ctx, ac.ID); errTerminate != nil {
+			log.Errorf("Could not terminate the aicall. aicall_id: %s, err: %v", ac.ID, errTerminate)
+		}
+	}()
+
+	finished, err := h.converse(ctx, ac.ID, sc, &res)
+	if err != nil {
+		log.Errorf("Could not finish the conversation. err: %v", err)
+		res.Error = err.Error()
+		return res
+	}
+
+	filters := map[message.Field]any{
+		message.FieldAIcallID: ac.ID,
+		message.FieldDeleted:  false,
+	}
+	msgs, err := h.db.MessageList(ctx, maxMessages, "", filters)
+	if err != nil {
+		log.Errorf("Could not get the messages. err: %v", err)
+		res.Error = "could not get the conversation"
+		return res
+	}
+
+	res.Assertions = evaluateAssertions(sc.Assertions, msgs, res.Turns, finished)
+	res.Passed = true
+	for _, a := range res.Assertions {
+		if !a.Passed {
+			res.Passed = false
+			break
+		}
+	}
+
+	return res
+}
+
+// converse sends the scenario's user turns and waits for the AI's reply to each of them.
+// It returns true if the conversation has finished within the turn limit.
+// The scripted conversation always finishes. The simulated one finishes when
+// the simulated user reports its goal is done.
+func (h *testSuiteHandler) converse(ctx context.Context, aicallID uuid.UUID, sc *testsuite.Scenario, res *testrun.Result) (bool, error) {
+	maxTurns := len(sc.Turns)
+	if sc.Type == testsuite.ScenarioTypeSimulated {
+		maxTurns = sc.MaxTurns
+		if maxTurns == 0 {
+			maxTurns = testsuite.DefaultSimulatedMaxTurns
+		}
+	}
+
+	conversation := []conversationMessage{}
+	var lastSend time.Time
+	for res.Turns < maxTurns {
+		text := ""
+		if sc.Type == testsuite.ScenarioTypeScripted {
+			text = sc.Turns[res.Turns]
+		} else {
+			tmp, done, err := h.simulateUserTurn(ctx, sc, conversation)
+			if err != nil {
+				return false, errors.Wrapf(err, "could not simulate the user turn %d", res.Turns+1)
+			}
+			if done {
+				return true, nil
+			}
+			text = tmp
+		}
+
+		// respect the aicall's send cooldown.
+		cooldown := time.Duration(config.Get().AIcallSendCooldownSeconds) * time.Second
+		if wait := cooldown - time.Since(lastSend); wait > 0 {
+			time.Sleep(wait)
+		}
+
+		m, err := h.aicallHandler.Send(ctx, aicallID, message.RoleUser, text, true, false)
+		if err != nil {
+			return false, errors.Wrapf(err, "could not send the user turn %d", res.Turns+1)
+		}
+		lastSend = time.Now()
+		res.Turns++
+
+		reply, err := h.waitReply(ctx, aicallID, m)
+		if err != nil {
+			return false, errors.Wrapf(err, "could not get the reply of the user turn %d", res.Turns)
+		}
+
+		conversation = append(conversation,
+			conversationMessage{Role: message.RoleUser, Content: text},
+			conversationMessage{Role: message.RoleAssistant, Content: reply},
+		)
+	}
+
+	return sc.Type == testsuite.ScenarioTypeScripted, nil
+}
+
+// waitReply waits for the AI's text reply to the given user message.
+// The tool call messages made before the reply are skipped.
+func (h *testSuiteHandler) waitReply(ctx context.Context, aicallID uuid.UUID, m *message.Message) (string, error) {
+	filters := map[message.Field]any{
+		message.FieldAIcallID: aicallID,
+		message.FieldRole:     message.RoleAssistant,
+		message.FieldDeleted:  false,
+	}
+
+	deadline := time.Now().Add(h.replyTimeout)
+	for {
+		msgs, err := h.db.MessageList(ctx, 10, "", filters)
+		if err != nil {
+			return "", errors.Wrapf(err, "could not get the messages")
+		}
+
+		for _, tmp := range msgs {
+			if tmp.Content == "" || m.TMCreate == nil || tmp.TMCreate == nil || !tmp.TMCreate.After(*m.TMCreate) {
+				continue
+			}
+			return tmp.Content, nil
+		}
+
+		if time.Now().After(deadline) {
+			return "", fmt.Errorf("the ai did not reply in %s", h.replyTimeout)
+		}
+		time.Sleep(h.pollInterval)
+	}
+}
+
+// resultLabel returns the metric label of the scenario result.
+func resultLabel(r testrun.Result) string {
+	switch {
+	case r.Error != "":
+		return "error"
+	case r.Passed:
+		return "passed"
+	default:
+		return "failed"
+	}
+}
@@ -0,0 +1,345 @@
+package testsuitehandler
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	"monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/notifyhandler"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+
+	"monorepo/bin-ai-manager/models/ai"
+	"monorepo/bin-ai-manager/models/aicall"
+	"monorepo/bin-ai-manager/models/analysis"
+	"monorepo/bin-ai-manager/models/message"
+	"monorepo/bin-ai-manager/models/testrun"
+	"monorepo/bin-ai-manager/models/testsuite"
+	"monorepo/bin-ai-manager/pkg/aicallhandler"
+	"monorepo/bin-ai-manager/pkg/aihandler"
+	"monorepo/bin-ai-manager/pkg/analysishandler"
+	"monorepo/bin-ai-manager/pkg/dbhandler"
+)
+
+func Test_Run(t *testing.T) {
+	customerID := uuid.FromStringOrNil("8a1d3f5b-ad62-11f0-9c7e-3e5a7c9b1d06")
+	aiID := uuid.FromStringOrNil("8a4c6e80-ad62-11f0-a2b1-6f8d0b2d4f07")
+	promptHistoryID := uuid.FromStringOrNil("8a77b1c4-ad62-11f0-b5e3-9a2c4e6a8c08")
+
+	tests := []struct {
+		name string
+
+		id uuid.UUID
+
+		responseTestSuite   *testsuite.TestSuite
+		responseProgressing []*testrun.TestRun
+		responseAI          *ai.AI
+		responseUUID        uuid.UUID
+		responseTestRun     *testrun.TestRun
+
+		expectTestRun *testrun.TestRun
+		expectErr     bool
+	}{
+		{
+			name: "normal",
+
+			id: uuid.FromStringOrNil("8aa2f6e8-ad62-11f0-8d04-1c3e5a7c9e09"),
+
+			responseTestSuite: &testsuite.TestSuite{
+				Identity: identity.Identity{
+					ID:         uuid.FromStringOrNil("8aa2f6e8-ad62-11f0-8d04-1c3e5a7c9e09"),
+					CustomerID: customerID,
+				},
+				AIID: aiID,
+			},
+			responseProgressing: []*testrun.TestRun{},
+			responseAI: &ai.AI{
+				Identity: identity.Identity{
+					ID:         aiID,
+					CustomerID: customerID,
+				},
+				CurrentPromptHistoryID: promptHistoryID,
+			},
+			responseUUID: uuid.FromStringOrNil("8ace3b2c-ad62-11f0-a6f5-4e6a8c0e2a10"),
+			responseTestRun: &testrun.TestRun{
+				Identity: identity.Identity{
+					ID:         uuid.FromStringOrNil("8ace3b2c-ad62-11f0-a6f5-4e6a8c0e2a10"),
+					CustomerID: customerID,
+				},
+				Status: testrun.StatusProgressing,
+			},
+
+			expectTestRun: &testrun.TestRun{
+				Identity: identity.Identity{
+					ID:         uuid.FromStringOrNil("8ace3b2c-ad62-11f0-a6f5-4e6a8c0e2a10"),
+					CustomerID: customerID,
+				},
+				TestSuiteID:     uuid.FromStringOrNil("8aa2f6e8-ad62-11f0-8d04-1c3e5a7c9e09"),
+				AIID:            aiID,
+				PromptHistoryID: promptHistoryID,
+				Status:          testrun.StatusProgressing,
+				Results:         []testrun.Result{},
+			},
+		},
+		{
+			name: "too many progressing runs",
+
+			id: uuid.FromStringOrNil("8af97e70-ad62-11f0-9f16-7a9c1e3a5c11"),
+
+			responseTestSuite: &testsuite.TestSuite{
+				Identity: identity.Identity{
+					ID:         uuid.FromStringOrNil("8af97e70-ad62-11f0-9f16-7a9c1e3a5c11"),
+					CustomerID: customerID,
+				},
+				AIID: aiID,
+			},
+			responseProgressing: []*testrun.TestRun{{}, {}, {}},
+
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockAI := aihandler.NewMockAIHandler(mc)
+
+			h := &testSuiteHandler{
+				utilHandler:   mockUtil,
+				notifyHandler: mockNotify,
+				db:            mockDB,
+				aiHandler:     mockAI,
+			}
+			ctx := context.Background()
+
+			mockDB.EXPECT().TestSuiteGet(ctx, tt.id).Return(tt.responseTestSuite, nil)
+			mockDB.EXPECT().TestRunList(ctx, uint64(maxProgressingRuns), "", map[testrun.Field]any{
+				testrun.FieldCustomerID: customerID,
+				testrun.FieldStatus:     testrun.StatusProgressing,
+				testrun.FieldDeleted:    false,
+			}).Return(tt.responseProgressing, nil)
+
+			if !tt.expectErr {
+				mockAI.EXPECT().Get(ctx, aiID).Return(tt.responseAI, nil)
+				mockUtil.EXPECT().UUIDCreate().Return(tt.responseUUID)
+				mockDB.EXPECT().TestRunCreate(ctx, tt.expectTestRun).Return(nil)
+				mockDB.EXPECT().TestRunGet(ctx, tt.responseUUID).Return(tt.responseTestRun, nil)
+				mockNotify.EXPECT().PublishWebhookEvent(ctx, customerID, testrun.EventTypeCreated, tt.responseTestRun)
+
+				// the suite has no scenario. the run finishes right away.
+				mockDB.EXPECT().TestRunUpdate(gomock.Any(), tt.responseUUID, map[testrun.Field]any{
+					testrun.FieldStatus:  testrun.StatusPassed,
+					testrun.FieldResults: []testrun.Result{},
+				}).Return(nil)
+				mockDB.EXPECT().TestRunGet(gomock.Any(), tt.responseUUID).Return(tt.responseTestRun, nil)
+				mockNotify.EXPECT().PublishWebhookEvent(gomock.Any(), customerID, testrun.EventTypeUpdated, tt.responseTestRun)
+			}
+
+			res, err := h.Run(ctx, tt.id)
+			if tt.expectErr {
+				if err == nil {
+					t.Errorf("Wrong match. expect: error, got: ok")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+			time.Sleep(100 * time.Millisecond)
+
+			if !reflect.DeepEqual(res, tt.responseTestRun) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.responseTestRun, res)
+			}
+		})
+	}
+}
+
+func Test_runScenario(t *testing.T) {
+	tmUser := time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)
+	tmReply := tmUser.Add(time.Second)
+
+	tr := &testrun.TestRun{
+		Identity: identity.Identity{
+			ID: uuid.FromStringOrNil("c40a2e6c-ad62-11f0-8b27-5c7e9a1c3e12"),
+		},
+		AIID: uuid.FromStringOrNil("c4366fb0-ad62-11f0-9d38-8e0a2c4e6a13"),
+	}
+
+	tests := []struct {
+		name string
+
+		scenario *testsuite.Scenario
+
+		responseAIcall    *aicall.AIcall
+		responseSimulated []string
+		responseMessages  []*message.Message
+
+		expectSent []string
+		expectRes  testrun.Result
+	}{
+		{
+			name: "scripted",
+
+			scenario: &testsuite.Scenario{
+				Name:  "refund",
+				Type:  testsuite.ScenarioTypeScripted,
+				Turns: []string{"I want a refund."},
+				Assertions: []testsuite.Assertion{
+					{Type: testsuite.AssertionTypeMustNotMention, Text: "refund approved"},
+					{Type: testsuite.AssertionTypeToolCalled, ToolName: "connect_call"},
+				},
+			},
+
+			responseAIcall: &aicall.AIcall{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("c461b0f4-ad62-11f0-a149-1b3d5f7b9b14"),
+				},
+			},
+			responseMessages: []*message.Message{
+				{
+					Role:     message.RoleAssistant,
+					Content:  "Your refund approved.",
+					TMCreate: &tmReply,
+				},
+			},
+
+			expectSent: []string{"I want a refund."},
+			expectRes: testrun.Result{
+				Scenario: "refund",
+				AIcallID: uuid.FromStringOrNil("c461b0f4-ad62-11f0-a149-1b3d5f7b9b14"),
+				Turns:    1,
+				Assertions: []testrun.AssertionResult{
+					{
+						Assertion: testsuite.Assertion{Type: testsuite.AssertionTypeMustNotMention, Text: "refund approved"},
+						Reason:    "a reply mentioned the text.",
+					},
+					{
+						Assertion: testsuite.Assertion{Type: testsuite.AssertionTypeToolCalled, ToolName: "connect_call"},
+						Reason:    "the tool connect_call was not called with the given arguments.",
+					},
+				},
+			},
+		},
+		{
+			name: "simulated",
+
+			scenario: &testsuite.Scenario{
+				Name:    "angry customer",
+				Type:    testsuite.ScenarioTypeSimulated,
+				Persona: "an angry customer",
+				Goal:    "get a refund",
+				Assertions: []testsuite.Assertion{
+					{Type: testsuite.AssertionTypeMaxTurns, Value: 2},
+				},
+			},
+
+			responseAIcall: &aicall.AIcall{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("c48cf238-ad62-11f0-b25a-4e6a8c0e2c15"),
+				},
+			},
+			responseSimulated: []string{"Where is my money?"},
+			responseMessages: []*message.Message{
+				{
+					Role:     message.RoleAssistant,
+					Content:  "Let me connect you to the billing team.",
+					TMCreate: &tmReply,
+				},
+			},
+
+			expectSent: []string{"Where is my money?"},
+			expectRes: testrun.Result{
+				Scenario: "angry customer",
+				AIcallID: uuid.FromStringOrNil("c48cf238-ad62-11f0-b25a-4e6a8c0e2c15"),
+				Passed:   true,
+				Turns:    1,
+				Assertions: []testrun.AssertionResult{
+					{
+						Assertion: testsuite.Assertion{Type: testsuite.AssertionTypeMaxTurns, Value: 2},
+						Passed:    true,
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockAIcall := aicallhandler.NewMockAIcallHandler(mc)
+			mockAnalysis := analysishandler.NewMockAnalysisHandler(mc)
+
+			h := &testSuiteHandler{
+				db:              mockDB,
+				aicallHandler:   mockAIcall,
+				analysisHandler: mockAnalysis,
+
+				replyTimeout: time.Second,
+				pollInterval: 10 * time.Millisecond,
+			}
+			ctx := context.Background()
+
+			mockAIcall.EXPECT().Start(ctx, aicall.AssistanceTypeAI, tr.AIID, uuid.Nil, aicall.ReferenceTypeTestRun, tr.ID).Return(tt.responseAIcall, nil)
+			for _, text := range tt.responseSimulated {
+				mockAnalysis.EXPECT().Run(ctx, gomock.Any()).Return(&analysis.Response{Result: json.RawMessage(`{"message":"` + text + `","done":false}`)}, nil)
+			}
+			if tt.scenario.Type == testsuite.ScenarioTypeSimulated {
+				mockAnalysis.EXPECT().Run(ctx, gomock.Any()).Return(&analysis.Response{Result: json.RawMessage(`{"message":"","done":true}`)}, nil)
+			}
+			for _, text := range tt.expectSent {
+				mockAIcall.EXPECT().Send(ctx, tt.responseAIcall.ID, message.RoleUser, text, true, false).Return(&message.Message{TMCreate: &tmUser}, nil)
+				mockDB.EXPECT().MessageList(ctx, uint64(10), "", gomock.Any()).Return(tt.responseMessages, nil)
+			}
+			mockDB.EXPECT().MessageList(ctx, uint64(maxMessages), "", gomock.Any()).Return(tt.responseMessages, nil)
+			mockAIcall.EXPECT().ProcessTerminate(ctx, tt.responseAIcall.ID).Return(tt.responseAIcall, nil)
+
+			res := h.runScenario(ctx, tr, tt.scenario)
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_waitReply_timeout(t *testing.T) {
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockDB := dbhandler.NewMockDBHandler(mc)
+	h := &testSuiteHandler{
+		db: mockDB,
+
+		replyTimeout: 30 * time.Millisecond,
+		pollInterval: 10 * time.Millisecond,
+	}
+	ctx := context.Background()
+
+	tmUser := time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)
+	tmOld := tmUser.Add(-time.Second)
+
+	// only the reply of the previous turn exists.
+	mockDB.EXPECT().MessageList(ctx, uint64(10), "", gomock.Any()).Return([]*message.Message{
+		{
+			Role:     message.RoleAssistant,
+			Content:  "hello",
+			TMCreate: &tmOld,
+		},
+	}, nil).MinTimes(1)
+
+	if _, err := h.waitReply(ctx, uuid.FromStringOrNil("e2c4a6f8-ad62-11f0-8a1c-3d5f7b9d1f16"), &message.Message{TMCreate: &tmUser}); err == nil {
+		t.Errorf("Wrong match. expect: error, got: ok")
+	}
+}
@@ -0,0 +1,98 @@
+package testsuitehandler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"monorepo/bin-ai-manager/models/analysis"
+	"monorepo/bin-ai-manager/models/message"
+	"monorepo/bin-ai-manager/models/testsuite"
+)
+
+// conversationMessage is a turn of the conversation sent to the simulated user.
+type conversationMessage struct {
+	Role    message.Role `json:"role"`
+	Content string       `json:"content"`
+}
+
+// simulateRequest is the data sent to the LLM playing the simulated user.
+type simulateRequest struct {
+	Persona      string                `json:"persona"`
+	Goal         string                `json:"goal"`
+	Conversation []conversationMessage `json:"conversation"`
+}
+
+// simulateResponse is the next move of the simulated user.
+type simulateResponse struct {
+	Message string `json:"message"`
+	Done    bool   `json:"done"`
+}
+
+const (
+	simulateSchemaName = "simulated_user"
+
+	simulateSchema = `{
+  "type": "object",
+  "properties": {
+    "message": {"type": "string", "description": "the next message of the user. empty when done."},
+    "done": {"type": "boolean", "description": "true when the goal is reached or can not be reached anymore."}
+  },
+  "required": ["message", "done"],
+  "additionalProperties": false
+}`
+
+	defaultSimulatePrompt = `
+You are playing the user in a test conversation with an AI agent.
+
+**Rules:**
+- Act as the given persona and pursue the given goal.
+- Write only the next user message, as the persona would say it. Never reveal that this is a test.
+- The 'conversation' is the conversation so far. 'user' messages are yours, 'assistant' messages are the AI agent's.
+- Set 'done' to true, with an empty 'message', when the goal has been reached or clearly can not be reached anymore.
+`
+)
+
+// simulateUserTurn returns the next message of the scenario's simulated user.
+// It returns done=true when the simulated user has finished the conversation.
+func (h *testSuiteHandler) simulateUserTurn(ctx context.Context, sc *testsuite.Scenario, conversation []conversationMessage) (string, bool, error) {
+	data, err := json.Marshal(&simulateRequest{
+		Persona:      sc.Persona,
+		Goal:         sc.Goal,
+		Conversation: conversation,
+	})
+	if err != nil {
+		return "", false, errors.Wrapf(err, "could not marshal the data")
+	}
+
+	tmp, err := h.analysisHandler.Run(ctx, &analysis.Request{
+		Prompt:     defaultSimulatePrompt,
+		Data:       data,
+		Schema:     json.RawMessage(simulateSchema),
+		SchemaName: simulateSchemaName,
+	})
+	if err != nil {
+		return "", false, errors.Wrapf(err, "could not run the analysis")
+	}
+	if tmp.Truncated {
+		return "", false, fmt.Errorf("the simulated user turn has been truncated")
+	}
+
+	res := simulateResponse{}
+	if errUnmarshal := json.Unmarshal(tmp.Result, &res); errUnmarshal != nil {
+		return "", false, errors.Wrapf(errUnmarshal, "could not unmarshal the simulated user turn")
+	}
+
+	if res.Done {
+		return "", true, nil
+	}
+	if strings.TrimSpace(res.Message) == "" {
+		// nothing more to say
+		return "", true, nil
+	}
+
+	return res.Message, false, nil
+}
@@ -0,0 +1,104 @@
+package testsuitehandler
+
+import (
+	"context"
+	stderrors "errors"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+
+	cerrors "monorepo/bin-common-handler/models/errors"
+	"monorepo/bin-common-handler/models/identity"
+	commonoutline "monorepo/bin-common-handler/models/outline"
+
+	"monorepo/bin-ai-manager/models/testrun"
+	"monorepo/bin-ai-manager/pkg/dbhandler"
+)
+
+// testRunCreate creates a new progressing test run and publishes the created event.
+func (h *testSuiteHandler) testRunCreate(ctx context.Context, customerID uuid.UUID, testSuiteID uuid.UUID, aiID uuid.UUID, promptHistoryID uuid.UUID) (*testrun.TestRun, error) {
+	r := &testrun.TestRun{
+		Identity: identity.Identity{
+			ID:         h.utilHandler.UUIDCreate(),
+			CustomerID: customerID,
+		},
+		TestSuiteID:     testSuiteID,
+		AIID:            aiID,
+		PromptHistoryID: promptHistoryID,
+		Status:          testrun.StatusProgressing,
+		Results:         []testrun.Result{},
+	}
+
+	if err := h.db.TestRunCreate(ctx, r); err != nil {
+		return nil, errors.Wrapf(err, "could not create test run")
+	}
+
+	res, err := h.db.TestRunGet(ctx, r.ID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get created test run")
+	}
+	h.notifyHandler.PublishWebhookEvent(ctx, res.CustomerID, testrun.EventTypeCreated, res)
+
+	return res, nil
+}
+
+// testRunUpdateResults updates the test run with the given status and results.
+func (h *testSuiteHandler) testRunUpdateResults(ctx context.Context, id uuid.UUID, status testrun.Status, results []testrun.Result) (*testrun.TestRun, error) {
+	fields := map[testrun.Field]any{
+		testrun.FieldStatus:  status,
+		testrun.FieldResults: results,
+	}
+	if err := h.db.TestRunUpdate(ctx, id, fields); err != nil {
+		return nil, errors.Wrapf(err, "could not update test run")
+	}
+
+	res, err := h.db.TestRunGet(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get updated test run")
+	}
+	h.notifyHandler.PublishWebhookEvent(ctx, res.CustomerID, testrun.EventTypeUpdated, res)
+
+	return res, nil
+}
+
+// TestRunGet returns test run.
+func (h *testSuiteHandler) TestRunGet(ctx context.Context, id uuid.UUID) (*testrun.TestRun, error) {
+	res, err := h.db.TestRunGet(ctx, id)
+	if err != nil {
+		if stderrors.Is(err, dbhandler.ErrNotFound) {
+			return nil, cerrors.NotFound(
+				commonoutline.ServiceNameAIManager,
+				"TEST_RUN_NOT_FOUND",
+				"The test run was not found.",
+			).Wrap(err)
+		}
+		return nil, errors.Wrapf(err, "could not get test run")
+	}
+
+	return res, nil
+}
+
+// TestRunList returns list of test runs.
+func (h *testSuiteHandler) TestRunList(ctx context.Context, size uint64, token string, filters map[testrun.Field]any) ([]*testrun.TestRun, error) {
+	res, err := h.db.TestRunList(ctx, size, token, filters)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not list test runs")
+	}
+
+	return res, nil
+}
+
+// TestRunDelete deletes the test run.
+func (h *testSuiteHandler) TestRunDelete(ctx context.Context, id uuid.UUID) (*testrun.TestRun, error) {
+	if err := h.db.TestRunDelete(ctx, id); err != nil {
+		return nil, errors.Wrapf(err, "could not delete test run")
+	}
+
+	res, err := h.db.TestRunGet(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get deleted test run")
+	}
+	h.notifyHandler.PublishWebhookEvent(ctx, res.CustomerID, testrun.EventTypeDeleted, res)
+
+	return res, nil
+}
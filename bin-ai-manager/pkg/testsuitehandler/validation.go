@@ -0,0 +1,114 @@
+package testsuitehandler
+
+import (
+	"fmt"
+	"strings"
+
+	"monorepo/bin-ai-manager/models/testsuite"
+)
+
+const (
+	maxNameLength = 255
+	maxTextLength = 4096 // the max length of a turn, persona, goal and assertion text.
+)
+
+// validateScenarios checks the static constraints of the test suite's scenarios.
+func validateScenarios(scenarios []testsuite.Scenario) error {
+	if len(scenarios) == 0 {
+		return fmt.Errorf("at least one scenario is required")
+	}
+	if len(scenarios) > testsuite.MaxScenarios {
+		return fmt.Errorf("too many scenarios: %d. max: %d", len(scenarios), testsuite.MaxScenarios)
+	}
+
+	names := map[string]bool{}
+	for i, s := range scenarios {
+		if err := validateScenario(&s); err != nil {
+			return fmt.Errorf("invalid scenario[%d]: %v", i, err)
+		}
+
+		// the results are reported by the scenario's name.
+		if names[s.Name] {
+			return fmt.Errorf("duplicated scenario name: %s", s.Name)
+		}
+		names[s.Name] = true
+	}
+
+	return nil
+}
+
+func validateScenario(s *testsuite.Scenario) error {
+	if strings.TrimSpace(s.Name) == "" {
+		return fmt.Errorf("name is required")
+	}
+	if len(s.Name) > maxNameLength {
+		return fmt.Errorf("name is too long. max: %d", maxNameLength)
+	}
+
+	switch s.Type {
+	case testsuite.ScenarioTypeScripted:
+		if len(s.Turns) == 0 {
+			return fmt.Errorf("at least one turn is required")
+		}
+		if len(s.Turns) > testsuite.MaxTurns {
+			return fmt.Errorf("too many turns: %d. max: %d", len(s.Turns), testsuite.MaxTurns)
+		}
+		for j, t := range s.Turns {
+			if strings.TrimSpace(t) == "" {
+				return fmt.Errorf("turn[%d] is empty", j)
+			}
+			if len(t) > maxTextLength {
+				return fmt.Errorf("turn[%d] is too long. max: %d", j, maxTextLength)
+			}
+		}
+
+	case testsuite.ScenarioTypeSimulated:
+		if strings.TrimSpace(s.Persona) == "" || strings.TrimSpace(s.Goal) == "" {
+			return fmt.Errorf("persona and goal are required")
+		}
+		if len(s.Persona) > maxTextLength || len(s.Goal) > maxTextLength {
+			return fmt.Errorf("persona or goal is too long. max: %d", maxTextLength)
+		}
+		if s.MaxTurns < 0 || s.MaxTurns > testsuite.MaxTurns {
+			return fmt.Errorf("invalid max_turns: %d. must be between 0 and %d", s.MaxTurns, testsuite.MaxTurns)
+		}
+
+	default:
+		return fmt.Errorf("unsupported type: %q", s.Type)
+	}
+
+	for j, a := range s.Assertions {
+		if err := validateAssertion(&a); err != nil {
+			return fmt.Errorf("invalid assertion[%d]: %v", j, err)
+		}
+	}
+
+	return nil
+}
+
+func validateAssertion(a *testsuite.Assertion) error {
+	switch a.Type {
+	case testsuite.AssertionTypeToolCalled, testsuite.AssertionTypeToolNotCalled:
+		if a.ToolName == "" {
+			return fmt.Errorf("tool_name is required")
+		}
+
+	case testsuite.AssertionTypeMustMention, testsuite.AssertionTypeMustNotMention:
+		if strings.TrimSpace(a.Text) == "" {
+			return fmt.Errorf("text is required")
+		}
+		if len(a.Text) > maxTextLength {
+			return fmt.Errorf("text is too long. max: %d", maxTextLength)
+		}
+
+	case testsuite.AssertionTypeMaxTurns:
+		if a.Value <= 0 {
+			return fmt.Errorf("value must be positive")
+		}
+
+	default:
+		return fmt.Errorf("unsupported type: %q", a.Type)
+	}
+
+	return nil
+}
@@ -0,0 +1,188 @@
+package testsuitehandler
+
+import (
+	"strings"
+	"testing"
+
+	"monorepo/bin-ai-manager/models/testsuite"
+)
+
+func Test_validateScenarios(t *testing.T) {
+	scripted := testsuite.Scenario{
+		Name:  "greeting",
+		Type:  testsuite.ScenarioTypeScripted,
+		Turns: []string{"hello"},
+	}
+
+	tests := []struct {
+		name string
+
+		scenarios []testsuite.Scenario
+
+		expectErr bool
+	}{
+		{
+			name: "normal",
+
+			scenarios: []testsuite.Scenario{
+				scripted,
+				{
+					Name:     "angry customer",
+					Type:     testsuite.ScenarioTypeSimulated,
+					Persona:  "an angry customer whose order is late",
+					Goal:     "get a refund",
+					MaxTurns: 5,
+					Assertions: []testsuite.Assertion{
+						{Type: testsuite.AssertionTypeToolCalled, ToolName: "connect_call"},
+						{Type: testsuite.AssertionTypeMustNotMention, Text: "refund approved"},
+						{Type: testsuite.AssertionTypeMaxTurns, Value: 5},
+					},
+				},
+			},
+		},
+		{
+			name: "empty",
+
+			scenarios: []testsuite.Scenario{},
+			expectErr: true,
+		},
+		{
+			name: "too many scenarios",
+
+			scenarios: func() []testsuite.Scenario {
+				res := []testsuite.Scenario{}
+				for range testsuite.MaxScenarios + 1 {
+					res = append(res, scripted)
+				}
+				return res
+			}(),
+			expectErr: true,
+		},
+		{
+			name: "no name",
+
+			scenarios: []testsuite.Scenario{
+				{Type: testsuite.ScenarioTypeScripted, Turns: []string{"hello"}},
+			},
+			expectErr: true,
+		},
+		{
+			name: "scripted without turns",
+
+			scenarios: []testsuite.Scenario{
+				{Name: "greeting", Type: testsuite.ScenarioTypeScripted},
+			},
+			expectErr: true,
+		},
+		{
+			name: "scripted with an empty turn",
+
+			scenarios: []testsuite.Scenario{
+				{Name: "greeting", Type: testsuite.ScenarioTypeScripted, Turns: []string{"hello", " "}},
+			},
+			expectErr: true,
+		},
+		{
+			name: "turn too long",
+
+			scenarios: []testsuite.Scenario{
+				{Name: "greeting", Type: testsuite.ScenarioTypeScripted, Turns: []string{strings.Repeat("a", maxTextLength+1)}},
+			},
+			expectErr: true,
+		},
+		{
+			name: "simulated without goal",
+
+			scenarios: []testsuite.Scenario{
+				{Name: "angry customer", Type: testsuite.ScenarioTypeSimulated, Persona: "an angry customer"},
+			},
+			expectErr: true,
+		},
+		{
+			name: "simulated with too many turns",
+
+			scenarios: []testsuite.Scenario{
+				{Name: "angry customer", Type: testsuite.ScenarioTypeSimulated, Persona: "an angry customer", Goal: "get a refund", MaxTurns: testsuite.MaxTurns + 1},
+			},
+			expectErr: true,
+		},
+		{
+			name: "duplicated scenario name",
+
+			scenarios: []testsuite.Scenario{
+				{Name: "greeting", Type: testsuite.ScenarioTypeScripted, Turns: []string{"hello"}},
+				{Name: "greeting", Type: testsuite.ScenarioTypeScripted, Turns: []string{"hi"}},
+			},
+			expectErr: true,
+		},
+		{
+			name: "unsupported type",
+
+			scenarios: []testsuite.Scenario{
+				{Name: "greeting", Type: "voice", Turns: []string{"hello"}},
+			},
+			expectErr: true,
+		},
+		{
+			name: "tool assertion without tool name",
+
+			scenarios: []testsuite.Scenario{
+				{
+					Name:       "greeting",
+					Type:       testsuite.ScenarioTypeScripted,
+					Turns:      []string{"hello"},
+					Assertions: []testsuite.Assertion{{Type: testsuite.AssertionTypeToolCalled}},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "mention assertion without text",
+
+			scenarios: []testsuite.Scenario{
+				{
+					Name:       "greeting",
+					Type:       testsuite.ScenarioTypeScripted,
+					Turns:      []string{"hello"},
+					Assertions: []testsuite.Assertion{{Type: testsuite.AssertionTypeMustMention}},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "max turns assertion without value",
+
+			scenarios: []testsuite.Scenario{
+				{
+					Name:       "greeting",
+					Type:       testsuite.ScenarioTypeScripted,
+					Turns:      []string{"hello"},
+					Assertions: []testsuite.Assertion{{Type: testsuite.AssertionTypeMaxTurns}},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "unsupported assertion type",
+
+			scenarios: []testsuite.Scenario{
+				{
+					Name:       "greeting",
+					Type:       testsuite.ScenarioTypeScripted,
+					Turns:      []string{"hello"},
+					Assertions: []testsuite.Assertion{{Type: "sentiment"}},
+				},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateScenarios(tt.scenarios)
+			if (err != nil) != tt.expectErr {
+				t.Errorf("Wrong match. expect error: %v, got: %v", tt.expectErr, err)
+			}
+		})
+	}
+}
@@ -750,6 +750,32 @@ Optional name/detail/note describe the case for a human agent reviewing it later
 		},
 		RunLLM: true,
 	},
+	{
+		Name: tool.ToolNameSetDisposition,
+		Description: `Sets the business outcome (disposition) of the current campaign call.
+
+WHEN TO USE:
+- The outcome of the campaign call is clear (e.g. the callee made a purchase, asked for a callback, or the number belongs to someone else).
+
+WHEN NOT TO USE:
+- The call was not made by a campaign -- this call will fail.
+- The outcome is not clear yet.
+
+The disposition must be one of the customer's disposition codes (e.g. sale, callback_requested, wrong_number). The disposition can decide whether the campaign retries the callee. Setting it again overwrites the previous disposition.`,
+		Parameters: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"run_llm": map[string]any{
+					"type":        "boolean",
+					"description": "Set true to continue the conversation after setting the disposition. Set false to set it silently.",
+					"default":     false,
+				},
+				"disposition": map[string]any{"type": "string", "description": "The customer's disposition code. e.g. sale, callback_requested, wrong_number."},
+			},
+			"required": []string{"disposition"},
+		},
+		RunLLM: false,
+	},
 	{
 		Name:   tool.ToolNameGetContactInteractions,
 		RunLLM: true,
@@ -152,6 +152,7 @@ func TestAllToolNames(t *testing.T) {
 		tool.ToolNameGetResource,
 		tool.ToolNameDescribeAction,
 		tool.ToolNameCaseCreate,
+		tool.ToolNameSetDisposition,
 	}
 
 	if len(tool.AllToolNames) != len(expectedNames) {
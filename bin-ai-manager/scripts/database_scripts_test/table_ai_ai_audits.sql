@@ -14,6 +14,12 @@ CREATE TABLE ai_ai_audits (
   language      varchar(16)  NOT NULL DEFAULT '',
   error         varchar(255) NOT NULL DEFAULT '',
 
+  prompt_tokens     bigint NOT NULL DEFAULT 0,
+  completion_tokens bigint NOT NULL DEFAULT 0,
+  cached_tokens     bigint NOT NULL DEFAULT 0,
+  stt_seconds       double NOT NULL DEFAULT 0,
+  tts_seconds       double NOT NULL DEFAULT 0,
+
   tm_create datetime(6),
   tm_update datetime(6),
   tm_delete datetime(6),
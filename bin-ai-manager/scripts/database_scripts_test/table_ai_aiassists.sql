@@ -0,0 +1,41 @@
+CREATE TABLE ai_aiassists (
+  id            BINARY(16) NOT NULL,
+  customer_id   BINARY(16) NOT NULL,
+
+  owner_type    VARCHAR(255) NOT NULL,
+  owner_id      BINARY(16) NOT NULL,
+
+  ai_id         BINARY(16) NOT NULL,
+
+  reference_type    VARCHAR(255) NOT NULL,
+  reference_id      BINARY(16) NOT NULL,
+  transcribe_id     BINARY(16) NOT NULL,
+
+  status    VARCHAR(16) NOT NULL,
+  language  VARCHAR(16) NOT NULL,
+
+  checklist     JSON,
+  sentiment     VARCHAR(16) NOT NULL DEFAULT '',
+  summary       TEXT,
+
+  prompt_tokens     BIGINT NOT NULL DEFAULT 0,
+  completion_tokens BIGINT NOT NULL DEFAULT 0,
+  cached_tokens     BIGINT NOT NULL DEFAULT 0,
+  stt_seconds       DOUBLE NOT NULL DEFAULT 0,
+  tts_seconds       DOUBLE NOT NULL DEFAULT 0,
+  engine_model      VARCHAR(255) NOT NULL DEFAULT '',
+
+  analyze_pending   BOOLEAN NOT NULL DEFAULT FALSE,
+  tm_analyze_lease  DATETIME(6),
+
+  tm_create DATETIME(6),
+  tm_update DATETIME(6),
+  tm_delete DATETIME(6),
+
+  PRIMARY KEY(id)
+);
+
+CREATE INDEX idx_ai_aiassists_customer_id ON ai_aiassists(customer_id);
+CREATE INDEX idx_ai_aiassists_owner_id ON ai_aiassists(owner_id);
+CREATE INDEX idx_ai_aiassists_reference_id ON ai_aiassists(reference_id);
+CREATE INDEX idx_ai_aiassists_transcribe_id ON ai_aiassists(transcribe_id);
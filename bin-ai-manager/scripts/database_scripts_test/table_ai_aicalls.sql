@@ -28,6 +28,14 @@ create table ai_aicalls(
 
   metadata  json,   -- metadata
 
+  -- ai provider usage
+  prompt_tokens     bigint not null default 0,
+  completion_tokens bigint not null default 0,
+  cached_tokens     bigint not null default 0,
+  stt_seconds       double not null default 0,
+  tts_seconds       double not null default 0,
+  usage_pipecatcall_id binary(16),  -- last pipecatcall whose usage was added
+
   -- timestamps
   tm_end    datetime(6),  --
   tm_create datetime(6),  --
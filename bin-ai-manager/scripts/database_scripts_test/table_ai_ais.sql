@@ -13,6 +13,8 @@ create table ai_ais(
   engine_key    varchar(255),
   rag_id        binary(16),
 
+  engine_fallbacks  json,     -- fallback engines tried in order
+
   init_prompt   text,           -- initial prompt
 
   tts_type      varchar(255),
@@ -27,6 +29,10 @@ create table ai_ais(
 
   auto_aicall_audit_enabled  boolean not null default 0,   -- auto aicall audit enabled
 
+  redaction  json,            -- pii redaction config
+  guardrail  json,            -- guardrail policy
+  response_cache  json,       -- semantic response cache config
+
   type  varchar(255) not null default 'normal',   -- ai type: normal, insight
 
   is_insight_active  boolean not null default 0,   -- the customer's single active insight ai
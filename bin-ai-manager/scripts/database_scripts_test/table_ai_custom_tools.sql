@@ -0,0 +1,27 @@
+create table ai_custom_tools(
+  -- identity
+  id              binary(16),   -- id
+  customer_id     binary(16),   -- customer id
+
+  -- info
+  name            varchar(255),   -- function name exposed to the llm
+  detail          text,           -- detail description
+  description     text,           -- llm-facing description
+  parameters      json,           -- json schema of the arguments
+
+  -- endpoint
+  url               text,           -- https endpoint
+  headers           json,           -- additional request headers
+  timeout           integer,        -- request timeout in milliseconds
+  response_template text,           -- response template
+
+  -- timestamps
+  tm_create datetime(6),  --
+  tm_update datetime(6),  --
+  tm_delete datetime(6),  --
+
+  primary key(id)
+);
+
+create index idx_ai_custom_tools_create on ai_custom_tools(tm_create);
+create index idx_ai_custom_tools_customer_id on ai_custom_tools(customer_id);
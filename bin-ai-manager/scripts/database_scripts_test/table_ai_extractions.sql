@@ -0,0 +1,34 @@
+CREATE TABLE ai_extractions (
+  id            BINARY(16) NOT NULL,
+  customer_id   BINARY(16) NOT NULL,
+
+  activeflow_id     BINARY(16) NOT NULL,
+  on_end_flow_id    BINARY(16) NOT NULL,
+
+  reference_type    VARCHAR(255) NOT NULL,
+  reference_id      BINARY(16) NOT NULL,
+
+  status    VARCHAR(16) NOT NULL,
+  language  VARCHAR(16) NOT NULL,
+
+  json_schema       JSON,
+  set_variables     BOOLEAN NOT NULL DEFAULT 0,
+
+  result            JSON,
+
+  prompt_tokens     BIGINT NOT NULL DEFAULT 0,
+  completion_tokens BIGINT NOT NULL DEFAULT 0,
+  cached_tokens     BIGINT NOT NULL DEFAULT 0,
+  stt_seconds       DOUBLE NOT NULL DEFAULT 0,
+  tts_seconds       DOUBLE NOT NULL DEFAULT 0,
+
+  tm_create DATETIME(6),
+  tm_update DATETIME(6),
+  tm_delete DATETIME(6),
+
+  PRIMARY KEY(id)
+);
+
+CREATE INDEX idx_ai_extractions_customer_id ON ai_extractions(customer_id);
+CREATE INDEX idx_ai_extractions_activeflow ON ai_extractions(activeflow_id);
+CREATE INDEX idx_ai_extractions_reference_id ON ai_extractions(reference_id);
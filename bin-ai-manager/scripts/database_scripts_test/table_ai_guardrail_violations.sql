@@ -0,0 +1,24 @@
+CREATE TABLE ai_guardrail_violations (
+  id            BINARY(16) NOT NULL,
+  customer_id   BINARY(16) NOT NULL,
+
+  aicall_id     BINARY(16) NOT NULL,
+  ai_id         BINARY(16) NOT NULL,
+  activeflow_id BINARY(16) NOT NULL,
+
+  rule      VARCHAR(255) NOT NULL,
+  detail    TEXT,
+  content   TEXT,
+
+  action    VARCHAR(255) NOT NULL,
+
+  tm_create DATETIME(6),
+  tm_update DATETIME(6),
+  tm_delete DATETIME(6),
+
+  PRIMARY KEY(id)
+);
+
+CREATE INDEX idx_ai_guardrail_violations_customer_id ON ai_guardrail_violations(customer_id);
+CREATE INDEX idx_ai_guardrail_violations_aicall_id ON ai_guardrail_violations(aicall_id);
+CREATE INDEX idx_ai_guardrail_violations_ai_id ON ai_guardrail_violations(ai_id);
@@ -0,0 +1,26 @@
+create table ai_mcp_servers(
+  -- identity
+  id              binary(16),   -- id
+  customer_id     binary(16),   -- customer id
+
+  -- info
+  name            varchar(255),   -- namespace prefix of the exposed tool names
+  detail          text,           -- detail description
+  transport       varchar(255),   -- streamable_http, sse
+
+  -- endpoint
+  url             text,           -- https endpoint
+  headers         json,           -- additional request headers
+  timeout         integer,        -- request timeout in milliseconds
+  allowed_tools   json,           -- allowlist of mcp tool names
+
+  -- timestamps
+  tm_create datetime(6),  --
+  tm_update datetime(6),  --
+  tm_delete datetime(6),  --
+
+  primary key(id)
+);
+
+create index idx_ai_mcp_servers_create on ai_mcp_servers(tm_create);
+create index idx_ai_mcp_servers_customer_id on ai_mcp_servers(customer_id);
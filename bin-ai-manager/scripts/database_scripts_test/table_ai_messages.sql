@@ -13,6 +13,16 @@ create table ai_messages(
   tool_calls    json,
   tool_call_id  varchar(255),
 
+  -- llm engine that generated the message
+  engine_model  varchar(255),
+
+  -- ai provider usage
+  prompt_tokens     bigint not null default 0,
+  completion_tokens bigint not null default 0,
+  cached_tokens     bigint not null default 0,
+  stt_seconds       double not null default 0,
+  tts_seconds       double not null default 0,
+
   -- active ai
   active_ai_id  binary(16),
 
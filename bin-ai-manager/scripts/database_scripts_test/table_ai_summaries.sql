@@ -13,6 +13,12 @@ CREATE TABLE ai_summaries (
   language  VARCHAR(16) NOT NULL,
   content   TEXT NOT NULL,
 
+  prompt_tokens     BIGINT NOT NULL DEFAULT 0,
+  completion_tokens BIGINT NOT NULL DEFAULT 0,
+  cached_tokens     BIGINT NOT NULL DEFAULT 0,
+  stt_seconds       DOUBLE NOT NULL DEFAULT 0,
+  tts_seconds       DOUBLE NOT NULL DEFAULT 0,
+
   tm_create DATETIME(6),
   tm_update DATETIME(6),
   tm_delete DATETIME(6),
@@ -0,0 +1,25 @@
+create table ai_test_runs(
+  -- identity
+  id              binary(16),   -- id
+  customer_id     binary(16),   -- customer id
+
+  test_suite_id     binary(16),   -- test suite id
+  ai_id             binary(16),   -- ai id
+  prompt_history_id binary(16),   -- ai's prompt history id at the time of the run
+
+  -- info
+  status          varchar(255),   -- status
+  results         json,           -- per scenario results
+
+  -- timestamps
+  tm_create datetime(6),  --
+  tm_update datetime(6),  --
+  tm_delete datetime(6),  --
+
+  primary key(id)
+);
+
+create index idx_ai_test_runs_create on ai_test_runs(tm_create);
+create index idx_ai_test_runs_customer_id on ai_test_runs(customer_id);
+create index idx_ai_test_runs_test_suite_id on ai_test_runs(test_suite_id);
+create index idx_ai_test_runs_ai_id on ai_test_runs(ai_id);
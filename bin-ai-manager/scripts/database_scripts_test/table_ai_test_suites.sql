@@ -0,0 +1,23 @@
+create table ai_test_suites(
+  -- identity
+  id              binary(16),   -- id
+  customer_id     binary(16),   -- customer id
+
+  ai_id           binary(16),   -- ai id
+
+  -- info
+  name            varchar(255),   -- name
+  detail          text,           -- detail description
+  scenarios       json,           -- scenarios
+
+  -- timestamps
+  tm_create datetime(6),  --
+  tm_update datetime(6),  --
+  tm_delete datetime(6),  --
+
+  primary key(id)
+);
+
+create index idx_ai_test_suites_create on ai_test_suites(tm_create);
+create index idx_ai_test_suites_customer_id on ai_test_suites(customer_id);
+create index idx_ai_test_suites_ai_id on ai_test_suites(ai_id);
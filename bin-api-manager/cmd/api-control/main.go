@@ -132,7 +132,7 @@ func runHealth(cmd *cobra.Command, args []string) error {
 	}
 
 	// Test RabbitMQ connection
-	sockHandler := sockhandler.NewSockHandler(sock.TypeRabbitMQ, config.Get().RabbitMQAddress)
+	sockHandler := sockhandler.NewSockHandler(sock.Type(config.Get().SockType), config.Get().RabbitMQAddress)
 	sockHandler.Connect()
 	health["rabbitmq"] = map[string]string{
 		"status": "ok",
@@ -14,6 +14,7 @@ import (
 	commondatabasehandler "monorepo/bin-common-handler/pkg/databasehandler"
 	"monorepo/bin-common-handler/pkg/requesthandler"
 	"monorepo/bin-common-handler/pkg/sockhandler"
+	"monorepo/bin-common-handler/pkg/tracehandler"
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
@@ -89,6 +90,10 @@ func runDaemon(cmd *cobra.Command, args []string) {
 
 	cfg := config.Get()
 
+	// init tracing
+	tracehandler.Init(commonoutline.ServiceNameAPIManager)
+	defer tracehandler.Shutdown()
+
 	// connect to database
 	sqlDB, err := commondatabasehandler.Connect(cfg.DatabaseDSN)
 	if err != nil {
@@ -126,7 +131,7 @@ func runDaemon(cmd *cobra.Command, args []string) {
 	rateLimiter := ratelimithandler.NewHandler(rateLimitRedisClient)
 
 	// connect to rabbitmq
-	sockHandler := sockhandler.NewSockHandler(sock.TypeRabbitMQ, cfg.RabbitMQAddress)
+	sockHandler := sockhandler.NewSockHandler(sock.Type(cfg.SockType), cfg.RabbitMQAddress)
 	sockHandler.Connect()
 
 	run(ctx, sockHandler, db, rateLimiter)
@@ -211,6 +216,7 @@ func runListenHTTP(serviceHandler servicehandler.ServiceHandler, rateLimiter rat
 
 	app := gin.Default()
 	app.Use(middleware.RequestID()) // NEW — tag every request with a correlation ID first.
+	app.Use(middleware.Tracing())   // Start the root span of the request's trace.
 	app.NoRoute(server.NoRoute())   // Emit the canonical error envelope for unrouted paths.
 
 	// Use Cloudflare's CF-Connecting-IP header to get the real client IP.
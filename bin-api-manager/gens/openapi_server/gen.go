@@ -24,6 +24,27 @@ const (
 	AIManagerAITypeNormal  AIManagerAIType = "normal"
 )
 
+// Defines values for AIManagerAIAssistReferenceType.
+const (
+	AIManagerAIAssistReferenceTypeCall AIManagerAIAssistReferenceType = "call"
+	AIManagerAIAssistReferenceTypeNone AIManagerAIAssistReferenceType = ""
+)
+
+// Defines values for AIManagerAIAssistSentiment.
+const (
+	AIManagerAIAssistSentimentNegative AIManagerAIAssistSentiment = "negative"
+	AIManagerAIAssistSentimentNeutral  AIManagerAIAssistSentiment = "neutral"
+	AIManagerAIAssistSentimentNone     AIManagerAIAssistSentiment = ""
+	AIManagerAIAssistSentimentPositive AIManagerAIAssistSentiment = "positive"
+)
+
+// Defines values for AIManagerAIAssistStatus.
+const (
+	AIManagerAIAssistStatusNone        AIManagerAIAssistStatus = ""
+	AIManagerAIAssistStatusProgressing AIManagerAIAssistStatus = "progressing"
+	AIManagerAIAssistStatusTerminated  AIManagerAIAssistStatus = "terminated"
+)
+
 // Defines values for AIManagerAIAuditStatus.
 const (
 	AIManagerAIAuditStatusCompleted   AIManagerAIAuditStatus = "completed"
@@ -104,6 +125,7 @@ const (
 	AIManagerAIcallReferenceTypeConversation AIManagerAIcallReferenceType = "conversation"
 	AIManagerAIcallReferenceTypeNone         AIManagerAIcallReferenceType = ""
 	AIManagerAIcallReferenceTypeTask         AIManagerAIcallReferenceType = "task"
+	AIManagerAIcallReferenceTypeTestRun      AIManagerAIcallReferenceType = "test_run"
 )
 
 // Defines values for AIManagerAIcallStatus.
@@ -116,6 +138,44 @@ const (
 	AIManagerAIcallStatusTerminating AIManagerAIcallStatus = "terminating"
 )
 
+// Defines values for AIManagerExtractionReferenceType.
+const (
+	AIManagerExtractionReferenceTypeAIcall     AIManagerExtractionReferenceType = "aicall"
+	AIManagerExtractionReferenceTypeCall       AIManagerExtractionReferenceType = "call"
+	AIManagerExtractionReferenceTypeConference AIManagerExtractionReferenceType = "conference"
+	AIManagerExtractionReferenceTypeNone       AIManagerExtractionReferenceType = ""
+	AIManagerExtractionReferenceTypeRecording  AIManagerExtractionReferenceType = "recording"
+	AIManagerExtractionReferenceTypeTranscribe AIManagerExtractionReferenceType = "transcribe"
+)
+
+// Defines values for AIManagerExtractionStatus.
+const (
+	AIManagerExtractionStatusDone        AIManagerExtractionStatus = "done"
+	AIManagerExtractionStatusFailed      AIManagerExtractionStatus = "failed"
+	AIManagerExtractionStatusNone        AIManagerExtractionStatus = ""
+	AIManagerExtractionStatusProgressing AIManagerExtractionStatus = "progressing"
+)
+
+// Defines values for AIManagerGuardrailEscalationAction.
+const (
+	AIManagerGuardrailEscalationActionNone        AIManagerGuardrailEscalationAction = ""
+	AIManagerGuardrailEscalationActionStopService AIManagerGuardrailEscalationAction = "stop_service"
+	AIManagerGuardrailEscalationActionTransfer    AIManagerGuardrailEscalationAction = "transfer"
+)
+
+// Defines values for AIManagerGuardrailViolationRule.
+const (
+	AIManagerGuardrailViolationRuleBlockedTopic            AIManagerGuardrailViolationRule = "blocked_topic"
+	AIManagerGuardrailViolationRuleForbiddenPhrase         AIManagerGuardrailViolationRule = "forbidden_phrase"
+	AIManagerGuardrailViolationRuleMaxConsecutiveToolCalls AIManagerGuardrailViolationRule = "max_consecutive_tool_calls"
+)
+
+// Defines values for AIManagerMCPServerTransport.
+const (
+	AIManagerMCPServerTransportSSE            AIManagerMCPServerTransport = "sse"
+	AIManagerMCPServerTransportStreamableHTTP AIManagerMCPServerTransport = "streamable_http"
+)
+
 // Defines values for AIManagerMessageDirection.
 const (
 	AIManagerMessageDirectionIncoming AIManagerMessageDirection = "incoming"
@@ -134,6 +194,22 @@ const (
 	AIManagerMessageRoleUser         AIManagerMessageRole = "user"
 )
 
+// Defines values for AIManagerRedactionEntityType.
+const (
+	AIManagerRedactionEntityTypeCreditCard  AIManagerRedactionEntityType = "credit_card"
+	AIManagerRedactionEntityTypeEmail       AIManagerRedactionEntityType = "email"
+	AIManagerRedactionEntityTypeIBAN        AIManagerRedactionEntityType = "iban"
+	AIManagerRedactionEntityTypePhoneNumber AIManagerRedactionEntityType = "phone_number"
+	AIManagerRedactionEntityTypeSSN         AIManagerRedactionEntityType = "ssn"
+)
+
+// Defines values for AIManagerRedactionMode.
+const (
+	AIManagerRedactionModeMask     AIManagerRedactionMode = "mask"
+	AIManagerRedactionModeReplace  AIManagerRedactionMode = "replace"
+	AIManagerRedactionModeTokenize AIManagerRedactionMode = "tokenize"
+)
+
 // Defines values for AIManagerSummaryReferenceType.
 const (
 	AIManagerSummaryReferenceTypeCall       AIManagerSummaryReferenceType = "call"
@@ -150,6 +226,29 @@ const (
 	AIManagerSummaryStatusProgressing AIManagerSummaryStatus = "progressing"
 )
 
+// Defines values for AIManagerTestRunStatus.
+const (
+	AIManagerTestRunStatusFailed      AIManagerTestRunStatus = "failed"
+	AIManagerTestRunStatusNone        AIManagerTestRunStatus = ""
+	AIManagerTestRunStatusPassed      AIManagerTestRunStatus = "passed"
+	AIManagerTestRunStatusProgressing AIManagerTestRunStatus = "progressing"
+)
+
+// Defines values for AIManagerTestSuiteAssertionType.
+const (
+	AIManagerTestSuiteAssertionTypeMaxTurns       AIManagerTestSuiteAssertionType = "max_turns"
+	AIManagerTestSuiteAssertionTypeMustMention    AIManagerTestSuiteAssertionType = "must_mention"
+	AIManagerTestSuiteAssertionTypeMustNotMention AIManagerTestSuiteAssertionType = "must_not_mention"
+	AIManagerTestSuiteAssertionTypeToolCalled     AIManagerTestSuiteAssertionType = "tool_called"
+	AIManagerTestSuiteAssertionTypeToolNotCalled  AIManagerTestSuiteAssertionType = "tool_not_called"
+)
+
+// Defines values for AIManagerTestSuiteScenarioType.
+const (
+	AIManagerTestSuiteScenarioTypeScripted  AIManagerTestSuiteScenarioType = "scripted"
+	AIManagerTestSuiteScenarioTypeSimulated AIManagerTestSuiteScenarioType = "simulated"
+)
+
 // Defines values for AIManagerToolName.
 const (
 	AIManagerToolNameAll               AIManagerToolName = "all"
@@ -159,6 +258,7 @@ const (
 	AIManagerToolNameSearchKnowledge   AIManagerToolName = "search_knowledge"
 	AIManagerToolNameSendEmail         AIManagerToolName = "send_email"
 	AIManagerToolNameSendMessage       AIManagerToolName = "send_message"
+	AIManagerToolNameSetDisposition    AIManagerToolName = "set_disposition"
 	AIManagerToolNameSetVariables      AIManagerToolName = "set_variables"
 	AIManagerToolNameStopFlow          AIManagerToolName = "stop_flow"
 	AIManagerToolNameStopMedia         AIManagerToolName = "stop_media"
@@ -224,6 +324,12 @@ const (
 	BillingManagerAccountPlanTypeUnlimited    BillingManagerAccountPlanType = "unlimited"
 )
 
+// Defines values for BillingManagerAccountSpendLimitPeriod.
+const (
+	BillingManagerAccountSpendLimitPeriodDaily   BillingManagerAccountSpendLimitPeriod = "daily"
+	BillingManagerAccountSpendLimitPeriodMonthly BillingManagerAccountSpendLimitPeriod = "monthly"
+)
+
 // Defines values for BillingManagerAccountStatus.
 const (
 	BillingManagerAccountStatusActive  BillingManagerAccountStatus = "active"
@@ -233,6 +339,7 @@ const (
 
 // Defines values for BillingManagerBillingCostType.
 const (
+	BillingManagerBillingCostTypeAIUsage          BillingManagerBillingCostType = "ai_usage"
 	BillingManagerBillingCostTypeCallDirectExt    BillingManagerBillingCostType = "call_direct_ext"
 	BillingManagerBillingCostTypeCallExtension    BillingManagerBillingCostType = "call_extension"
 	BillingManagerBillingCostTypeCallPSTNIncoming BillingManagerBillingCostType = "call_pstn_incoming"
@@ -265,6 +372,8 @@ const (
 
 // Defines values for BillingManagerBillingreferenceType.
 const (
+	BillingManagerBillingreferenceTypeAIAssist         BillingManagerBillingreferenceType = "aiassist"
+	BillingManagerBillingreferenceTypeAIcall           BillingManagerBillingreferenceType = "aicall"
 	BillingManagerBillingreferenceTypeCall             BillingManagerBillingreferenceType = "call"
 	BillingManagerBillingreferenceTypeCallExtension    BillingManagerBillingreferenceType = "call_extension"
 	BillingManagerBillingreferenceTypeCreditAdjustment BillingManagerBillingreferenceType = "credit_adjustment"
@@ -300,6 +409,7 @@ const (
 	CallManagerCallHangupReasonCancel   CallManagerCallHangupReason = "cancel"
 	CallManagerCallHangupReasonDialout  CallManagerCallHangupReason = "dialout"
 	CallManagerCallHangupReasonFailed   CallManagerCallHangupReason = "failed"
+	CallManagerCallHangupReasonMaxCost  CallManagerCallHangupReason = "max_cost"
 	CallManagerCallHangupReasonNoanswer CallManagerCallHangupReason = "noanswer"
 	CallManagerCallHangupReasonNone     CallManagerCallHangupReason = ""
 	CallManagerCallHangupReasonNormal   CallManagerCallHangupReason = "normal"
@@ -414,6 +524,26 @@ const (
 	CallManagerRecordingStatusStopping   CallManagerRecordingStatus = "stopping"
 )
 
+// Defines values for CampaignManagerCampaignCallingWindowDay.
+const (
+	CampaignManagerCampaignCallingWindowDayFriday    CampaignManagerCampaignCallingWindowDay = "friday"
+	CampaignManagerCampaignCallingWindowDayMonday    CampaignManagerCampaignCallingWindowDay = "monday"
+	CampaignManagerCampaignCallingWindowDaySaturday  CampaignManagerCampaignCallingWindowDay = "saturday"
+	CampaignManagerCampaignCallingWindowDaySunday    CampaignManagerCampaignCallingWindowDay = "sunday"
+	CampaignManagerCampaignCallingWindowDayThursday  CampaignManagerCampaignCallingWindowDay = "thursday"
+	CampaignManagerCampaignCallingWindowDayTuesday   CampaignManagerCampaignCallingWindowDay = "tuesday"
+	CampaignManagerCampaignCallingWindowDayWednesday CampaignManagerCampaignCallingWindowDay = "wednesday"
+)
+
+// Defines values for CampaignManagerCampaignDialMode.
+const (
+	CampaignManagerCampaignDialModeNone        CampaignManagerCampaignDialMode = ""
+	CampaignManagerCampaignDialModePower       CampaignManagerCampaignDialMode = "power"
+	CampaignManagerCampaignDialModePredictive  CampaignManagerCampaignDialMode = "predictive"
+	CampaignManagerCampaignDialModePreview     CampaignManagerCampaignDialMode = "preview"
+	CampaignManagerCampaignDialModeProgressive CampaignManagerCampaignDialMode = "progressive"
+)
+
 // Defines values for CampaignManagerCampaignEndHandle.
 const (
 	CampaignManagerCampaignEndHandleContinue CampaignManagerCampaignEndHandle = "continue"
@@ -457,9 +587,24 @@ const (
 const (
 	CampaignManagerCampaigncallStatusDialing     CampaignManagerCampaigncallStatus = "dialing"
 	CampaignManagerCampaigncallStatusDone        CampaignManagerCampaigncallStatus = "done"
+	CampaignManagerCampaigncallStatusPreviewing  CampaignManagerCampaigncallStatus = "previewing"
 	CampaignManagerCampaigncallStatusProgressing CampaignManagerCampaigncallStatus = "progressing"
 )
 
+// Defines values for CampaignManagerCampaignresultjobStatus.
+const (
+	CampaignManagerCampaignresultjobStatusDone       CampaignManagerCampaignresultjobStatus = "done"
+	CampaignManagerCampaignresultjobStatusFailed     CampaignManagerCampaignresultjobStatus = "failed"
+	CampaignManagerCampaignresultjobStatusProcessing CampaignManagerCampaignresultjobStatus = "processing"
+)
+
+// Defines values for CampaignManagerDispositionRetryMode.
+const (
+	CampaignManagerDispositionRetryModeDefault CampaignManagerDispositionRetryMode = "default"
+	CampaignManagerDispositionRetryModeNoRetry CampaignManagerDispositionRetryMode = "no_retry"
+	CampaignManagerDispositionRetryModeRetry   CampaignManagerDispositionRetryMode = "retry"
+)
+
 // Defines values for CommonAddressType.
 const (
 	CommonAddressTypeCommonAddressTypeAgent      CommonAddressType = "agent"
@@ -681,6 +826,7 @@ const (
 
 // Defines values for FlowManagerActionType.
 const (
+	FlowManagerActionTypeAIExtract           FlowManagerActionType = "ai_extract"
 	FlowManagerActionTypeAISummary           FlowManagerActionType = "ai_summary"
 	FlowManagerActionTypeAITalk              FlowManagerActionType = "ai_talk"
 	FlowManagerActionTypeAMD                 FlowManagerActionType = "amd"
@@ -698,6 +844,7 @@ const (
 	FlowManagerActionTypeConversationSend    FlowManagerActionType = "conversation_send"
 	FlowManagerActionTypeDigitsReceive       FlowManagerActionType = "digits_receive"
 	FlowManagerActionTypeDigitsSend          FlowManagerActionType = "digits_send"
+	FlowManagerActionTypeDispositionSet      FlowManagerActionType = "disposition_set"
 	FlowManagerActionTypeEcho                FlowManagerActionType = "echo"
 	FlowManagerActionTypeEmailSend           FlowManagerActionType = "email_send"
 	FlowManagerActionTypeExternalMediaStart  FlowManagerActionType = "external_media_start"
@@ -824,6 +971,33 @@ const (
 	OutdialManagerOutdialtargetStatusProgressing OutdialManagerOutdialtargetStatus = "progressing"
 )
 
+// Defines values for OutdialManagerOutdialtargetjobStatus.
+const (
+	OutdialManagerOutdialtargetjobStatusDone       OutdialManagerOutdialtargetjobStatus = "done"
+	OutdialManagerOutdialtargetjobStatusFailed     OutdialManagerOutdialtargetjobStatus = "failed"
+	OutdialManagerOutdialtargetjobStatusProcessing OutdialManagerOutdialtargetjobStatus = "processing"
+)
+
+// Defines values for OutdialManagerOutdialtargetjobType.
+const (
+	OutdialManagerOutdialtargetjobTypeExport OutdialManagerOutdialtargetjobType = "export"
+	OutdialManagerOutdialtargetjobTypeImport OutdialManagerOutdialtargetjobType = "import"
+)
+
+// Defines values for OutdialManagerSuppressionBlockReferenceType.
+const (
+	OutdialManagerSuppressionBlockReferenceTypeCall     OutdialManagerSuppressionBlockReferenceType = "call"
+	OutdialManagerSuppressionBlockReferenceTypeCampaign OutdialManagerSuppressionBlockReferenceType = "campaign"
+	OutdialManagerSuppressionBlockReferenceTypeMessage  OutdialManagerSuppressionBlockReferenceType = "message"
+)
+
+// Defines values for OutdialManagerSuppressionListType.
+const (
+	OutdialManagerSuppressionListTypeDNC       OutdialManagerSuppressionListType = "dnc"
+	OutdialManagerSuppressionListTypeLitigator OutdialManagerSuppressionListType = "litigator"
+	OutdialManagerSuppressionListTypeOptOut    OutdialManagerSuppressionListType = "opt_out"
+)
+
 // Defines values for QueueManagerQueueRoutingMethod.
 const (
 	QueueManagerQueueRoutingMethodNone   QueueManagerQueueRoutingMethod = ""
@@ -1225,12 +1399,18 @@ type AIManagerAI struct {
 	// DirectHash Hash for direct access via SIP URI sip:direct.<hash>@sip.voipbin.net. Returned from the resource's `direct_hash` field.
 	DirectHash *string `json:"direct_hash,omitempty"`
 
+	// EngineFallbacks Engines tried in order when the primary engine fails during a call (max 3). Engines that keep failing are skipped until they recover.
+	EngineFallbacks *[]AIManagerAIEngineFallback `json:"engine_fallbacks,omitempty"`
+
 	// EngineKey API key or authentication key for the AI engine. Write-only; not returned in responses.
 	EngineKey *string `json:"engine_key,omitempty"`
 
 	// EngineModel Model of the AI engine. Uses target.model format (e.g., openai.gpt-5). The target prefix identifies the provider, and the model name follows after the dot.
 	EngineModel *AIManagerAIEngineModel `json:"engine_model,omitempty"`
 
+	// Guardrail Guardrail policy of the AI. The user's speech is checked against the blocked topics before the AI answers, and the AI's spoken output is checked against the forbidden phrases before it reaches the text-to-speech. Every violation is recorded as an AI guardrail violation.
+	Guardrail *AIManagerGuardrail `json:"guardrail,omitempty"`
+
 	// Id The unique identifier of the AI.
 	Id *string `json:"id,omitempty"`
 
@@ -1249,6 +1429,12 @@ type AIManagerAI struct {
 	// RagId The knowledge base ID for the search_knowledge tool. Returned from the `id` field of the `GET /rags` response. When set, the AI assistant can search this knowledge base during voice calls.
 	RagId *string `json:"rag_id,omitempty"`
 
+	// Redaction Redaction of personal data in the AI conversations. The detected values are redacted before they are stored in the AI messages and before they reach the LLM.
+	Redaction *AIManagerRedaction `json:"redaction,omitempty"`
+
+	// ResponseCache Semantic response cache of the AI. When the user asks a question similar enough to one the AI already answered, the cached answer is said without running the LLM. The cache is kept per AI and dropped when the AI's `init_prompt` or its RAG changes. Available for the AI calls of a single AI only.
+	ResponseCache *AIManagerResponseCache `json:"response_cache,omitempty"`
+
 	// SmartTurnEnabled Enable smart turn detection using Pipecat's LocalSmartTurnAnalyzerV3. When enabled, forces VAD stop_secs to 0.2 for optimal turn-taking.
 	SmartTurnEnabled *bool `json:"smart_turn_enabled,omitempty"`
 
@@ -1286,6 +1472,72 @@ type AIManagerAI struct {
 // AIManagerAIType AI type. `normal` (default) is a general-purpose AI. `insight` restricts the AI to the Insight tool set and uses a dedicated system prompt for analyzing existing contact/conversation data.
 type AIManagerAIType string
 
+// AIManagerAIAssist A real-time assist session for a human agent on a call. It follows the call's live transcripts and sends suggestions to the agent. Unlike the AI call, it never speaks into the call.
+type AIManagerAIAssist struct {
+	// AiId The unique identifier of the AI. Its init prompt guides the suggestions and its RAG answers the knowledge questions. Returned from the `GET /ais` response.
+	AiId *string `json:"ai_id,omitempty"`
+
+	// Checklist The compliance checklist and its progress.
+	Checklist *[]AIManagerAIAssistChecklistItem `json:"checklist,omitempty"`
+
+	// CustomerId The unique identifier of the associated customer. Returned from the `GET /customers` response.
+	CustomerId *string `json:"customer_id,omitempty"`
+
+	// Id The unique identifier of the AI assist.
+	Id *string `json:"id,omitempty"`
+
+	// Language Language of the call.
+	Language *string `json:"language,omitempty"`
+
+	// OwnerId The unique identifier of the assisted agent. Returned from the `GET /agents` response.
+	OwnerId *string `json:"owner_id,omitempty"`
+
+	// OwnerType Type of the resource owner. Always agent.
+	OwnerType *string `json:"owner_type,omitempty"`
+
+	// ReferenceId The unique identifier of the assisted call. Returned from the `GET /calls` response.
+	ReferenceId *string `json:"reference_id,omitempty"`
+
+	// ReferenceType Type of reference for the AI assist.
+	ReferenceType *AIManagerAIAssistReferenceType `json:"reference_type,omitempty"`
+
+	// Sentiment The customer's latest sentiment.
+	Sentiment *AIManagerAIAssistSentiment `json:"sentiment,omitempty"`
+
+	// Status Status of the AI assist.
+	Status *AIManagerAIAssistStatus `json:"status,omitempty"`
+
+	// TmCreate Timestamp when the AI assist was created.
+	TmCreate *string `json:"tm_create,omitempty"`
+
+	// TmDelete Timestamp when the AI assist was deleted.
+	TmDelete *string `json:"tm_delete,omitempty"`
+
+	// TmUpdate Timestamp when the AI assist was last updated.
+	TmUpdate *string `json:"tm_update,omitempty"`
+
+	// Usage AI provider usage. LLM tokens and speech-to-text/text-to-speech audio seconds.
+	Usage *AIManagerUsage `json:"usage,omitempty"`
+}
+
+// AIManagerAIAssistChecklistItem A compliance item the agent has to cover in the call.
+type AIManagerAIAssistChecklistItem struct {
+	// Done True once the item was covered in the call.
+	Done *bool `json:"done,omitempty"`
+
+	// Name The compliance item.
+	Name *string `json:"name,omitempty"`
+}
+
+// AIManagerAIAssistReferenceType Type of reference for the AI assist.
+type AIManagerAIAssistReferenceType string
+
+// AIManagerAIAssistSentiment The customer's latest sentiment.
+type AIManagerAIAssistSentiment string
+
+// AIManagerAIAssistStatus Status of the AI assist.
+type AIManagerAIAssistStatus string
+
 // AIManagerAIAudit defines model for AIManagerAIAudit.
 type AIManagerAIAudit struct {
 	// AiId The AI participant that was evaluated. Returned from the `GET /ais` response.
@@ -1329,11 +1581,23 @@ type AIManagerAIAudit struct {
 
 	// TmUpdate Timestamp when the audit was last updated.
 	TmUpdate *string `json:"tm_update"`
+
+	// Usage AI provider usage. LLM tokens and speech-to-text/text-to-speech audio seconds.
+	Usage *AIManagerUsage `json:"usage,omitempty"`
 }
 
 // AIManagerAIAuditStatus Status of the AI audit.
 type AIManagerAIAuditStatus string
 
+// AIManagerAIEngineFallback An alternative LLM engine the AI fails over to when the engines before it in the chain are rate-limited or unavailable.
+type AIManagerAIEngineFallback struct {
+	// EngineKey API key or authentication key for the fallback engine. Empty uses the platform key of the engine's provider.
+	EngineKey *string `json:"engine_key,omitempty"`
+
+	// EngineModel Model of the AI engine. Uses target.model format (e.g., openai.gpt-5). The target prefix identifies the provider, and the model name follows after the dot.
+	EngineModel AIManagerAIEngineModel `json:"engine_model"`
+}
+
 // AIManagerAIEngineModel Model of the AI engine. Uses target.model format (e.g., openai.gpt-5). The target prefix identifies the provider, and the model name follows after the dot.
 type AIManagerAIEngineModel string
 
@@ -1479,6 +1743,9 @@ type AIManagerAIcall struct {
 
 	// TmUpdate Timestamp when the AI call was last updated.
 	TmUpdate *string `json:"tm_update,omitempty"`
+
+	// Usage AI provider usage. LLM tokens and speech-to-text/text-to-speech audio seconds.
+	Usage *AIManagerUsage `json:"usage,omitempty"`
 }
 
 // AIManagerAIcallAssistanceType Type of assistance entity associated with the AI call.
@@ -1490,6 +1757,235 @@ type AIManagerAIcallReferenceType string
 // AIManagerAIcallStatus Status of the ai call.
 type AIManagerAIcallStatus string
 
+// AIManagerCustomTool defines model for AIManagerCustomTool.
+type AIManagerCustomTool struct {
+	// CustomerId The unique identifier of the associated customer. Returned from the `GET /customers` response.
+	CustomerId string `json:"customer_id"`
+
+	// Description Description shown to the LLM that explains when and how to call the tool.
+	Description string `json:"description"`
+
+	// Detail Free-form note about the custom tool. Not shown to the LLM.
+	Detail *string `json:"detail,omitempty"`
+
+	// Headers Extra HTTP headers sent with each request (max 20).
+	Headers *map[string]string `json:"headers,omitempty"`
+
+	// Id The unique identifier of the custom tool.
+	Id string `json:"id"`
+
+	// Name Function name exposed to the LLM. Must start with a letter and contain only letters, digits and underscores (max 64 characters). Must not collide with a built-in tool name. Add this name to an AI's `tool_names` to enable the tool for that AI.
+	Name string `json:"name"`
+
+	// Parameters JSON Schema of the tool arguments. The root must be of type `object`.
+	Parameters *map[string]interface{} `json:"parameters,omitempty"`
+
+	// ResponseTemplate Optional Go text/template applied to the decoded JSON response before it is returned to the LLM. When empty, the raw response body is returned.
+	ResponseTemplate *string `json:"response_template,omitempty"`
+
+	// Timeout Request timeout in milliseconds. Defaults to 5000, maximum 30000.
+	Timeout *int `json:"timeout,omitempty"`
+
+	// TmCreate Timestamp when the custom tool was created.
+	TmCreate *string `json:"tm_create,omitempty"`
+
+	// TmDelete Timestamp when the custom tool was deleted.
+	TmDelete *string `json:"tm_delete,omitempty"`
+
+	// TmUpdate Timestamp when the custom tool was last updated.
+	TmUpdate *string `json:"tm_update,omitempty"`
+
+	// Url HTTPS endpoint called when the LLM invokes the tool. The request is a `POST` signed with the customer's webhook secret in the `X-VoIPBIN-Signature` header. Private and reserved addresses are rejected.
+	Url string `json:"url"`
+}
+
+// AIManagerExtraction defines model for AIManagerExtraction.
+type AIManagerExtraction struct {
+	// ActiveflowId The unique identifier of the activeflow. Returned from the `GET /activeflows` response.
+	ActiveflowId *string `json:"activeflow_id,omitempty"`
+
+	// CustomerId The unique identifier of the associated customer. Returned from the `GET /customers` response.
+	CustomerId *string `json:"customer_id,omitempty"`
+
+	// Id The unique identifier of the extraction.
+	Id *string `json:"id,omitempty"`
+
+	// Language Language of the extracted values.
+	Language *string `json:"language,omitempty"`
+
+	// OnEndFlowId The unique identifier of the flow to execute when the extraction completes. Returned from the `POST /flows` or `GET /flows` response.
+	OnEndFlowId *string `json:"on_end_flow_id,omitempty"`
+
+	// ReferenceId The unique identifier of the referenced resource. The actual resource type is determined by reference_type. Returned from the corresponding resource endpoint.
+	ReferenceId *string `json:"reference_id,omitempty"`
+
+	// ReferenceType Type of reference for the AI extraction.
+	ReferenceType *AIManagerExtractionReferenceType `json:"reference_type,omitempty"`
+
+	// Result Extracted data. Validated against the schema.
+	Result *map[string]interface{} `json:"result,omitempty"`
+
+	// Schema JSON schema of the data to extract. The root must be an object with properties.
+	Schema *map[string]interface{} `json:"schema,omitempty"`
+
+	// SetVariables If true, each extracted field is set as the flow variable `voipbin.ai_extract.result.<field>`.
+	SetVariables *bool `json:"set_variables,omitempty"`
+
+	// Status Status of the AI extraction.
+	Status *AIManagerExtractionStatus `json:"status,omitempty"`
+
+	// TmCreate Timestamp when the extraction was created.
+	TmCreate *string `json:"tm_create,omitempty"`
+
+	// TmDelete Timestamp when the extraction was deleted.
+	TmDelete *string `json:"tm_delete,omitempty"`
+
+	// TmUpdate Timestamp when the extraction was last updated.
+	TmUpdate *string `json:"tm_update,omitempty"`
+
+	// Usage AI provider usage. LLM tokens and speech-to-text/text-to-speech audio seconds.
+	Usage *AIManagerUsage `json:"usage,omitempty"`
+}
+
+// AIManagerExtractionReferenceType Type of reference for the AI extraction.
+type AIManagerExtractionReferenceType string
+
+// AIManagerExtractionStatus Status of the AI extraction.
+type AIManagerExtractionStatus string
+
+// AIManagerGuardrail Guardrail policy of the AI. The user's speech is checked against the blocked topics before the AI answers, and the AI's spoken output is checked against the forbidden phrases before it reaches the text-to-speech. Every violation is recorded as an AI guardrail violation.
+type AIManagerGuardrail struct {
+	// BlockedTopics Topics the AI must not talk about. Checked on the user's speech, so the AI never answers a question about them. Matched as whole words, case-insensitive. Up to 50 items of up to 200 characters each.
+	BlockedTopics *[]string `json:"blocked_topics,omitempty"`
+
+	// Disclaimer Disclaimer said at the start of the AI's first response in the session. Up to 1000 characters.
+	Disclaimer *string `json:"disclaimer,omitempty"`
+
+	// Escalation Action taken when a guardrail rule triggers.
+	Escalation *AIManagerGuardrailEscalation `json:"escalation,omitempty"`
+
+	// ForbiddenPhrases Phrases the AI must never say. Matched case-insensitive. Up to 50 items of up to 200 characters each.
+	ForbiddenPhrases *[]string `json:"forbidden_phrases,omitempty"`
+
+	// MaxConsecutiveToolCalls Maximum number of tool calls the AI can make without the user saying anything in between. The tool calls over the limit are refused. `0` is unlimited. Up to 100.
+	MaxConsecutiveToolCalls *int `json:"max_consecutive_tool_calls,omitempty"`
+}
+
+// AIManagerGuardrailEscalation Action taken when a guardrail rule triggers.
+type AIManagerGuardrailEscalation struct {
+	// Action Escalation action of the guardrail. `stop_service` stops the AI and moves the flow on to the next action. `transfer` joins the call to the queue. The `transfer` action is available for calls only. For the other AI calls, the AI is stopped instead.
+	Action *AIManagerGuardrailEscalationAction `json:"action,omitempty"`
+
+	// Message Message said in place of the blocked output, or in place of the AI's answer to a blocked topic. When empty, nothing is said. Up to 1000 characters.
+	Message *string `json:"message,omitempty"`
+
+	// QueueId The queue to transfer the call to. Required for the `transfer` action. Returned from the `GET /queues` response.
+	QueueId *string `json:"queue_id,omitempty"`
+}
+
+// AIManagerGuardrailEscalationAction Escalation action of the guardrail. `stop_service` stops the AI and moves the flow on to the next action. `transfer` joins the call to the queue. The `transfer` action is available for calls only. For the other AI calls, the AI is stopped instead.
+type AIManagerGuardrailEscalationAction string
+
+// AIManagerGuardrailViolation A guardrail rule triggered by an AI in an AI call. The records are never updated, so they can be used as an audit trail.
+type AIManagerGuardrailViolation struct {
+	// Action Escalation action of the guardrail. `stop_service` stops the AI and moves the flow on to the next action. `transfer` joins the call to the queue. The `transfer` action is available for calls only. For the other AI calls, the AI is stopped instead.
+	Action *AIManagerGuardrailEscalationAction `json:"action,omitempty"`
+
+	// ActiveflowId The unique identifier of the activeflow. Returned from the `GET /activeflows` response.
+	ActiveflowId *string `json:"activeflow_id,omitempty"`
+
+	// AiId The unique identifier of the AI that triggered the rule. Returned from the `GET /ais` response.
+	AiId *string `json:"ai_id,omitempty"`
+
+	// AicallId The unique identifier of the AI call. Returned from the `GET /aicalls` response.
+	AicallId *string `json:"aicall_id,omitempty"`
+
+	// Content The blocked output, the user's speech about a blocked topic, or the name of the refused tool call. Redacted when the AI call redacts personal data.
+	Content *string `json:"content,omitempty"`
+
+	// CustomerId The unique identifier of the associated customer. Returned from the `GET /customers` response.
+	CustomerId *string `json:"customer_id,omitempty"`
+
+	// Detail The matched topic or phrase, or the tool call count.
+	Detail *string `json:"detail,omitempty"`
+
+	// Id The unique identifier of the guardrail violation.
+	Id *string `json:"id,omitempty"`
+
+	// Rule The guardrail rule that triggered.
+	Rule *AIManagerGuardrailViolationRule `json:"rule,omitempty"`
+
+	// TmCreate Timestamp when the guardrail violation was created.
+	TmCreate *string `json:"tm_create,omitempty"`
+
+	// TmDelete Timestamp when the guardrail violation was deleted.
+	TmDelete *string `json:"tm_delete,omitempty"`
+
+	// TmUpdate Timestamp when the guardrail violation was last updated.
+	TmUpdate *string `json:"tm_update,omitempty"`
+}
+
+// AIManagerGuardrailViolationRule The guardrail rule that triggered.
+type AIManagerGuardrailViolationRule string
+
+// AIManagerMCPServer defines model for AIManagerMCPServer.
+type AIManagerMCPServer struct {
+	// AllowedTools Names of the server's tools the LLM may call. `read_resource` and `get_prompt` allow reading the server's resources and prompts. When empty, every tool is allowed.
+	AllowedTools *[]string `json:"allowed_tools,omitempty"`
+
+	// CustomerId The unique identifier of the associated customer. Returned from the `GET /customers` response.
+	CustomerId string `json:"customer_id"`
+
+	// Detail Free-form note about the MCP server. Not shown to the LLM.
+	Detail *string `json:"detail,omitempty"`
+
+	// Headers Extra HTTP headers sent with each request, typically for authentication (max 20).
+	Headers *map[string]string `json:"headers,omitempty"`
+
+	// Id The unique identifier of the MCP server.
+	Id string `json:"id"`
+
+	// Name Name of the MCP server. Must start with a letter and contain only letters and digits (max 32 characters). The server's tools are exposed to the LLM as `<name>__<tool>`. Add this name to an AI's `tool_names` to enable all of the server's allowed tools for that AI.
+	Name string `json:"name"`
+
+	// Timeout Timeout of a whole tool discovery or tool call in milliseconds. Defaults to 10000, maximum 60000.
+	Timeout *int `json:"timeout,omitempty"`
+
+	// TmCreate Timestamp when the MCP server was created.
+	TmCreate *string `json:"tm_create,omitempty"`
+
+	// TmDelete Timestamp when the MCP server was deleted.
+	TmDelete *string `json:"tm_delete,omitempty"`
+
+	// TmUpdate Timestamp when the MCP server was last updated.
+	TmUpdate *string `json:"tm_update,omitempty"`
+
+	// Transport Transport used to reach the MCP server.
+	// - `streamable_http`: Streamable HTTP transport (a single endpoint accepting `POST` requests).
+	// - `sse`: Legacy HTTP+SSE transport (an event stream endpoint plus a message endpoint).
+	Transport AIManagerMCPServerTransport `json:"transport"`
+
+	// Url HTTPS endpoint of the MCP server. For the `sse` transport this is the event stream endpoint. Private and reserved addresses are rejected.
+	Url string `json:"url"`
+}
+
+// AIManagerMCPServerTool A tool discovered on an MCP server, as it is presented to the LLM.
+type AIManagerMCPServerTool struct {
+	// Description Description of the tool reported by the MCP server.
+	Description *string `json:"description,omitempty"`
+
+	// Name Function name exposed to the LLM, in the form `<server name>__<tool>`.
+	Name string `json:"name"`
+
+	// Parameters JSON Schema of the tool arguments reported by the MCP server.
+	Parameters *map[string]interface{} `json:"parameters,omitempty"`
+}
+
+// AIManagerMCPServerTransport Transport used to reach the MCP server.
+// - `streamable_http`: Streamable HTTP transport (a single endpoint accepting `POST` requests).
+// - `sse`: Legacy HTTP+SSE transport (an event stream endpoint plus a message endpoint).
+type AIManagerMCPServerTransport string
+
 // AIManagerMessage defines model for AIManagerMessage.
 type AIManagerMessage struct {
 	// ActiveAiId The unique identifier of the AI configuration that was active when this message was created. Returned from the `GET /ais` response.
@@ -1510,6 +2006,9 @@ type AIManagerMessage struct {
 	// Direction Direction of the message.
 	Direction *AIManagerMessageDirection `json:"direction,omitempty"`
 
+	// EngineModel Model of the AI engine. Uses target.model format (e.g., openai.gpt-5). The target prefix identifies the provider, and the model name follows after the dot.
+	EngineModel *AIManagerAIEngineModel `json:"engine_model,omitempty"`
+
 	// Id The unique identifier of the message.
 	Id *string `json:"id,omitempty"`
 
@@ -1539,6 +2038,9 @@ type AIManagerMessage struct {
 		// Type The type of tool call.
 		Type *string `json:"type,omitempty"`
 	} `json:"tool_calls,omitempty"`
+
+	// Usage AI provider usage. LLM tokens and speech-to-text/text-to-speech audio seconds.
+	Usage *AIManagerUsage `json:"usage,omitempty"`
 }
 
 // AIManagerMessageDirection Direction of the message.
@@ -1574,6 +2076,42 @@ type AIManagerPromptSnapshot struct {
 	PromptHistoryId *string `json:"prompt_history_id,omitempty"`
 }
 
+// AIManagerRedaction Redaction of personal data in the AI conversations. The detected values are redacted before they are stored in the AI messages and before they reach the LLM.
+type AIManagerRedaction struct {
+	// EntityTypes Kinds of personal data to redact. Empty turns the redaction off.
+	EntityTypes *[]AIManagerRedactionEntityType `json:"entity_types,omitempty"`
+
+	// Mode How the detected personal data is redacted. `replace` replaces it with its kind (e.g. `[CREDIT_CARD]`). `mask` keeps the last 4 characters (e.g. `**** **** **** 1111`). `tokenize` replaces it with a token unique within the AI call (e.g. `[CREDIT_CARD_QWERTY]`).
+	Mode *AIManagerRedactionMode `json:"mode,omitempty"`
+
+	// ToolAccess When true, the tokenized values are restored in the tool call arguments so the tools receive the real values. Requires the `tokenize` mode.
+	ToolAccess *bool `json:"tool_access,omitempty"`
+}
+
+// AIManagerRedactionEntityType Kind of personal data to redact.
+type AIManagerRedactionEntityType string
+
+// AIManagerRedactionMode How the detected personal data is redacted. `replace` replaces it with its kind (e.g. `[CREDIT_CARD]`). `mask` keeps the last 4 characters (e.g. `**** **** **** 1111`). `tokenize` replaces it with a token unique within the AI call (e.g. `[CREDIT_CARD_QWERTY]`).
+type AIManagerRedactionMode string
+
+// AIManagerResponseCache Semantic response cache of the AI. When the user asks a question similar enough to one the AI already answered, the cached answer is said without running the LLM. The cache is kept per AI and dropped when the AI's `init_prompt` or its RAG changes. Available for the AI calls of a single AI only.
+type AIManagerResponseCache struct {
+	// Enabled Whether the response cache is on.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Threshold Minimum cosine similarity between the question and a cached question to reuse the answer. From `0.5` to `1`. Defaults to `0.95`.
+	Threshold *float64 `json:"threshold,omitempty"`
+
+	// Ttl How long a cached answer is reused, in seconds. Up to 30 days. Defaults to 1 day.
+	Ttl *int `json:"ttl,omitempty"`
+
+	// Turns Number of the latest user turns the cache is keyed on. From `1` to `5`. Defaults to `1`.
+	Turns *int `json:"turns,omitempty"`
+
+	// Variables Flow variables the answers depend on. Answers are reused only between the AI calls with the same values of these variables. Up to 10 items of up to 100 characters each.
+	Variables *[]string `json:"variables,omitempty"`
+}
+
 // AIManagerSummary defines model for AIManagerSummary.
 type AIManagerSummary struct {
 	// ActiveflowId The unique identifier of the activeflow. Returned from the `GET /activeflows` response.
@@ -1611,6 +2149,9 @@ type AIManagerSummary struct {
 
 	// TmUpdate Timestamp when the summary was last updated.
 	TmUpdate *string `json:"tm_update,omitempty"`
+
+	// Usage AI provider usage. LLM tokens and speech-to-text/text-to-speech audio seconds.
+	Usage *AIManagerUsage `json:"usage,omitempty"`
 }
 
 // AIManagerSummaryReferenceType Type of reference for the AI summary.
@@ -1682,9 +2223,194 @@ type AIManagerTeamTransition struct {
 	NextMemberId string `json:"next_member_id"`
 }
 
+// AIManagerTestRun defines model for AIManagerTestRun.
+type AIManagerTestRun struct {
+	// AiId The unique identifier of the AI under test. Returned from the `GET /ais` response.
+	AiId string `json:"ai_id"`
+
+	// CustomerId The unique identifier of the associated customer. Returned from the `GET /customers` response.
+	CustomerId string `json:"customer_id"`
+
+	// Id The unique identifier of the test run.
+	Id string `json:"id"`
+
+	// PromptHistoryId The unique identifier of the AI's prompt history entry the run was made with. Compare runs of different prompt versions with it. Returned from the `GET /ais/{id}/prompt_histories` response.
+	PromptHistoryId *string `json:"prompt_history_id,omitempty"`
+
+	// Results Results of the scenarios, in the order of the test suite.
+	Results *[]AIManagerTestRunResult `json:"results,omitempty"`
+
+	// Status Status of the test run.
+	// - `progressing`: The scenarios are being run.
+	// - `passed`: Every scenario has passed.
+	// - `failed`: At least one scenario has failed.
+	Status AIManagerTestRunStatus `json:"status"`
+
+	// TestSuiteId The unique identifier of the test suite. Returned from the `GET /test_suites` response.
+	TestSuiteId string `json:"test_suite_id"`
+
+	// TmCreate Timestamp when the test run was created.
+	TmCreate *string `json:"tm_create,omitempty"`
+
+	// TmDelete Timestamp when the test run was deleted.
+	TmDelete *string `json:"tm_delete,omitempty"`
+
+	// TmUpdate Timestamp when the test run was last updated.
+	TmUpdate *string `json:"tm_update,omitempty"`
+}
+
+// AIManagerTestRunAssertionResult Result of a single assertion.
+type AIManagerTestRunAssertionResult struct {
+	// Assertion A check made on the conversation of the scenario.
+	Assertion *AIManagerTestSuiteAssertion `json:"assertion,omitempty"`
+
+	// Passed Whether the assertion has passed.
+	Passed *bool `json:"passed,omitempty"`
+
+	// Reason Why the assertion has failed.
+	Reason *string `json:"reason,omitempty"`
+}
+
+// AIManagerTestRunResult Result of a single scenario.
+type AIManagerTestRunResult struct {
+	// AicallId The unique identifier of the aicall the scenario was run with. The conversation is available from the `GET /aimessages` response.
+	AicallId *string `json:"aicall_id,omitempty"`
+
+	// Assertions Results of the scenario's assertions.
+	Assertions *[]AIManagerTestRunAssertionResult `json:"assertions,omitempty"`
+
+	// Error Set when the scenario could not be run to the end. The assertions are not evaluated.
+	Error *string `json:"error,omitempty"`
+
+	// Passed Whether every assertion of the scenario has passed.
+	Passed *bool `json:"passed,omitempty"`
+
+	// Scenario Name of the scenario.
+	Scenario *string `json:"scenario,omitempty"`
+
+	// Turns Number of the user turns sent.
+	Turns *int `json:"turns,omitempty"`
+}
+
+// AIManagerTestRunStatus Status of the test run.
+// - `progressing`: The scenarios are being run.
+// - `passed`: Every scenario has passed.
+// - `failed`: At least one scenario has failed.
+type AIManagerTestRunStatus string
+
+// AIManagerTestSuite defines model for AIManagerTestSuite.
+type AIManagerTestSuite struct {
+	// AiId The unique identifier of the AI under test. Returned from the `GET /ais` response.
+	AiId string `json:"ai_id"`
+
+	// CustomerId The unique identifier of the associated customer. Returned from the `GET /customers` response.
+	CustomerId string `json:"customer_id"`
+
+	// Detail Free-form note about the test suite.
+	Detail *string `json:"detail,omitempty"`
+
+	// Id The unique identifier of the test suite.
+	Id string `json:"id"`
+
+	// Name Name of the test suite.
+	Name string `json:"name"`
+
+	// Scenarios Scenarios of the test suite (max 20).
+	Scenarios *[]AIManagerTestSuiteScenario `json:"scenarios,omitempty"`
+
+	// TmCreate Timestamp when the test suite was created.
+	TmCreate *string `json:"tm_create,omitempty"`
+
+	// TmDelete Timestamp when the test suite was deleted.
+	TmDelete *string `json:"tm_delete,omitempty"`
+
+	// TmUpdate Timestamp when the test suite was last updated.
+	TmUpdate *string `json:"tm_update,omitempty"`
+}
+
+// AIManagerTestSuiteAssertion A check made on the conversation of the scenario.
+type AIManagerTestSuiteAssertion struct {
+	// Arguments Arguments the tool call must have. Used by `tool_called` and `tool_not_called`.
+	Arguments *map[string]interface{} `json:"arguments,omitempty"`
+
+	// Text Text to look for in the AI's replies. Used by `must_mention` and `must_not_mention`.
+	Text *string `json:"text,omitempty"`
+
+	// ToolName Name of the tool. Used by `tool_called` and `tool_not_called`.
+	ToolName *string `json:"tool_name,omitempty"`
+
+	// Type Type of the assertion.
+	// - `tool_called`: The AI must call the tool `tool_name`. When `arguments` is given, every given argument must match the called one.
+	// - `tool_not_called`: The AI must not call the tool `tool_name` with the given `arguments`.
+	// - `must_mention`: One of the AI's replies must contain `text` (case-insensitive).
+	// - `must_not_mention`: None of the AI's replies may contain `text` (case-insensitive).
+	// - `max_turns`: The conversation must end within `value` user turns.
+	Type AIManagerTestSuiteAssertionType `json:"type"`
+
+	// Value Maximum number of user turns. Used by `max_turns`.
+	Value *int `json:"value,omitempty"`
+}
+
+// AIManagerTestSuiteAssertionType Type of the assertion.
+// - `tool_called`: The AI must call the tool `tool_name`. When `arguments` is given, every given argument must match the called one.
+// - `tool_not_called`: The AI must not call the tool `tool_name` with the given `arguments`.
+// - `must_mention`: One of the AI's replies must contain `text` (case-insensitive).
+// - `must_not_mention`: None of the AI's replies may contain `text` (case-insensitive).
+// - `max_turns`: The conversation must end within `value` user turns.
+type AIManagerTestSuiteAssertionType string
+
+// AIManagerTestSuiteScenario A single conversation of the test suite.
+type AIManagerTestSuiteScenario struct {
+	// Assertions Checks made on the conversation. The scenario passes when every assertion passes.
+	Assertions *[]AIManagerTestSuiteAssertion `json:"assertions,omitempty"`
+
+	// Goal What the simulated user wants to achieve. Required for the `simulated` scenario.
+	Goal *string `json:"goal,omitempty"`
+
+	// MaxTurns Maximum number of user turns of the `simulated` scenario. Defaults to 10, maximum 20.
+	MaxTurns *int `json:"max_turns,omitempty"`
+
+	// Name Name of the scenario. Must be unique within the test suite.
+	Name string `json:"name"`
+
+	// Persona Who the simulated user is. Required for the `simulated` scenario.
+	Persona *string `json:"persona,omitempty"`
+
+	// Turns User messages sent in order. Required for the `scripted` scenario (max 20).
+	Turns *[]string `json:"turns,omitempty"`
+
+	// Type How the user turns of the scenario are made.
+	// - `scripted`: The user turns are given in `turns` and sent in order.
+	// - `simulated`: An LLM plays the `persona` and talks to the AI until the `goal` is reached or `max_turns` is reached.
+	Type AIManagerTestSuiteScenarioType `json:"type"`
+}
+
+// AIManagerTestSuiteScenarioType How the user turns of the scenario are made.
+// - `scripted`: The user turns are given in `turns` and sent in order.
+// - `simulated`: An LLM plays the `persona` and talks to the AI until the `goal` is reached or `max_turns` is reached.
+type AIManagerTestSuiteScenarioType string
+
 // AIManagerToolName Name of an AI tool function. Use `all` to enable every available tool.
 type AIManagerToolName string
 
+// AIManagerUsage AI provider usage. LLM tokens and speech-to-text/text-to-speech audio seconds.
+type AIManagerUsage struct {
+	// CachedTokens LLM input tokens served from the provider's prompt cache.
+	CachedTokens *int64 `json:"cached_tokens,omitempty"`
+
+	// CompletionTokens LLM output tokens.
+	CompletionTokens *int64 `json:"completion_tokens,omitempty"`
+
+	// PromptTokens LLM input tokens, including the cached ones.
+	PromptTokens *int64 `json:"prompt_tokens,omitempty"`
+
+	// SttSeconds Seconds of audio sent to the speech-to-text engine.
+	SttSeconds *float64 `json:"stt_seconds,omitempty"`
+
+	// TtsSeconds Seconds of audio generated by the text-to-speech engine.
+	TtsSeconds *float64 `json:"tts_seconds,omitempty"`
+}
+
 // AIManagerVADConfig Voice Activity Detection configuration. Omitted fields use Pipecat defaults (confidence=0.7, start_secs=0.2, stop_secs=0.2, min_volume=0.6).
 type AIManagerVADConfig struct {
 	// Confidence Minimum confidence threshold to detect voice. Range 0.0–1.0. Omitted fields use Pipecat default.
@@ -1790,6 +2516,15 @@ type AuthBootResponseType string
 
 // BillingManagerAccount defines model for BillingManagerAccount.
 type BillingManagerAccount struct {
+	// AutoTopupAmount The credit amount in micros charged to the subscription's payment method by the auto top-up. 0 disables the auto top-up.
+	AutoTopupAmount *int64 `json:"auto_topup_amount,omitempty"`
+
+	// AutoTopupThreshold The credit balance in micros which triggers the auto top-up.
+	AutoTopupThreshold *int64 `json:"auto_topup_threshold,omitempty"`
+
+	// BalanceAlertThresholds The credit balances in micros which trigger the low balance alert. The `account_balance_low` webhook event and an email are sent when the balance drops below the threshold.
+	BalanceAlertThresholds *[]int64 `json:"balance_alert_thresholds,omitempty"`
+
 	// BalanceCredit The credit balance of the account in micros (1 USD = 1,000,000).
 	BalanceCredit *int64 `json:"balance_credit,omitempty"`
 
@@ -1826,6 +2561,9 @@ type BillingManagerAccount struct {
 	// PlanType The plan tier of the billing account. Determines resource creation limits.
 	PlanType *BillingManagerAccountPlanType `json:"plan_type,omitempty"`
 
+	// SpendLimits The spending caps of the account by period and cost type. Calls and messages are rejected once the period's spending reaches the cap.
+	SpendLimits *[]BillingManagerAccountSpendLimit `json:"spend_limits,omitempty"`
+
 	// TmCreate The timestamp when the account was created.
 	TmCreate *string `json:"tm_create,omitempty"`
 
@@ -1844,6 +2582,18 @@ type BillingManagerAccount struct {
 
 // BillingManagerAccountAdmin Internal billing account representation for project admins. Includes all fields including status.
 type BillingManagerAccountAdmin struct {
+	// AutoTopupAmount The credit amount in micros charged to the subscription's payment method by the auto top-up. 0 disables the auto top-up.
+	AutoTopupAmount *int64 `json:"auto_topup_amount,omitempty"`
+
+	// AutoTopupPendingId The ID of the auto top-up whose charge is waiting for the payment to complete. Other auto top-ups are skipped meanwhile. Nil UUID means none is pending.
+	AutoTopupPendingId *string `json:"auto_topup_pending_id,omitempty"`
+
+	// AutoTopupThreshold The credit balance in micros which triggers the auto top-up.
+	AutoTopupThreshold *int64 `json:"auto_topup_threshold,omitempty"`
+
+	// BalanceAlertThresholds The credit balances in micros which trigger the low balance alert. The `account_balance_low` webhook event and an email are sent when the balance drops below the threshold.
+	BalanceAlertThresholds *[]int64 `json:"balance_alert_thresholds,omitempty"`
+
 	// BalanceCredit The credit balance of the account in micros (1 USD = 1,000,000).
 	BalanceCredit *int64 `json:"balance_credit,omitempty"`
 
@@ -1880,9 +2630,18 @@ type BillingManagerAccountAdmin struct {
 	// PlanType The plan tier of the billing account. Determines resource creation limits.
 	PlanType *BillingManagerAccountPlanType `json:"plan_type,omitempty"`
 
+	// RateDeckId The ID of the rate deck assigned to the account. Nil UUID means the rate deck of the plan type.
+	RateDeckId *string `json:"rate_deck_id,omitempty"`
+
+	// SpendLimits The spending caps of the account by period and cost type. Calls and messages are rejected once the period's spending reaches the cap.
+	SpendLimits *[]BillingManagerAccountSpendLimit `json:"spend_limits,omitempty"`
+
 	// Status The status of the billing account.
 	Status *BillingManagerAccountStatus `json:"status,omitempty"`
 
+	// TmAutoTopupPending The timestamp the pending auto top-up was charged. Null if none is pending.
+	TmAutoTopupPending *string `json:"tm_auto_topup_pending,omitempty"`
+
 	// TmCreate The timestamp when the account was created.
 	TmCreate *string `json:"tm_create,omitempty"`
 
@@ -1911,6 +2670,21 @@ type BillingManagerAccountPlanStatus string
 // BillingManagerAccountPlanType The plan tier of the billing account. Determines resource creation limits.
 type BillingManagerAccountPlanType string
 
+// BillingManagerAccountSpendLimit The spending cap of the account in the period.
+type BillingManagerAccountSpendLimit struct {
+	// CostType The cost type the spend limit applies to. Empty applies to all cost types.
+	CostType *string `json:"cost_type,omitempty"`
+
+	// LimitCredit The spending cap of the period in micros (1 USD = 1,000,000).
+	LimitCredit *int64 `json:"limit_credit,omitempty"`
+
+	// Period The period of the spend limit. The periods are based on UTC.
+	Period *BillingManagerAccountSpendLimitPeriod `json:"period,omitempty"`
+}
+
+// BillingManagerAccountSpendLimitPeriod The period of the spend limit. The periods are based on UTC.
+type BillingManagerAccountSpendLimitPeriod string
+
 // BillingManagerAccountStatus The status of the billing account.
 type BillingManagerAccountStatus string
 
@@ -1931,9 +2705,12 @@ type BillingManagerBilling struct {
 	// BalanceTokenSnapshot The token balance after this transaction.
 	BalanceTokenSnapshot *int64 `json:"balance_token_snapshot,omitempty"`
 
-	// BillableUnits The number of billable units (e.g. minutes, rounded up).
+	// BillableUnits The number of billable units. The billed minutes, rounded up, for the duration cost types.
 	BillableUnits *int `json:"billable_units,omitempty"`
 
+	// BilledDuration The billed duration in seconds after the billing increments. 0 for the non-duration cost types.
+	BilledDuration *int `json:"billed_duration,omitempty"`
+
 	// CostType The classification of the billing cost.
 	CostType *BillingManagerBillingCostType `json:"cost_type,omitempty"`
 
@@ -1946,9 +2723,21 @@ type BillingManagerBilling struct {
 	// IdempotencyKey A unique key to prevent duplicate transactions.
 	IdempotencyKey *string `json:"idempotency_key,omitempty"`
 
+	// RateConnectionFee The connection fee of the applied rate in micros, charged once per billing.
+	RateConnectionFee *int64 `json:"rate_connection_fee,omitempty"`
+
 	// RateCreditPerUnit The credit rate per billable unit in micros.
 	RateCreditPerUnit *int64 `json:"rate_credit_per_unit,omitempty"`
 
+	// RateId The ID of the rate deck's rate applied to this billing. Nil UUID means the default rate.
+	RateId *string `json:"rate_id,omitempty"`
+
+	// RateIncrementInitial The initial billing increment in seconds. 0 means per-minute billing.
+	RateIncrementInitial *int `json:"rate_increment_initial,omitempty"`
+
+	// RateIncrementSubsequent The subsequent billing increment in seconds.
+	RateIncrementSubsequent *int `json:"rate_increment_subsequent,omitempty"`
+
 	// RateTokenPerUnit The token rate per billable unit.
 	RateTokenPerUnit *int64 `json:"rate_token_per_unit,omitempty"`
 
@@ -1995,6 +2784,165 @@ type BillingManagerBillingTransactionType string
 // BillingManagerBillingreferenceType The type of reference associated with this billing.
 type BillingManagerBillingreferenceType string
 
+// BillingManagerEstimate The credit cost of a usage priced by the rate applied to the customer's billing account. The amount is positive and does not consider the account's token balance.
+type BillingManagerEstimate struct {
+	// AmountCredit The estimated credit in micros.
+	AmountCredit *int64 `json:"amount_credit,omitempty"`
+
+	// BillableUnits The billable minutes of the duration, rounded up.
+	BillableUnits *int `json:"billable_units,omitempty"`
+
+	// BilledDuration The billed duration in seconds after the billing increments.
+	BilledDuration *int `json:"billed_duration,omitempty"`
+
+	// CostType The classification of the billing cost.
+	CostType *BillingManagerBillingCostType `json:"cost_type,omitempty"`
+
+	// Duration The estimated duration in seconds.
+	Duration *int `json:"duration,omitempty"`
+
+	// RateConnectionFee The credit charged once per billing in micros.
+	RateConnectionFee *int64 `json:"rate_connection_fee,omitempty"`
+
+	// RateCreditPerUnit The credit per minute (or per unit for the non-duration cost types) in micros.
+	RateCreditPerUnit *int64 `json:"rate_credit_per_unit,omitempty"`
+
+	// RateId The rate deck's rate applied. Empty means the default rate of the cost type.
+	RateId *string `json:"rate_id,omitempty"`
+
+	// RateIncrementInitial The initial billing increment in seconds. 0 means per-minute billing.
+	RateIncrementInitial *int `json:"rate_increment_initial,omitempty"`
+
+	// RateIncrementSubsequent The subsequent billing increment in seconds.
+	RateIncrementSubsequent *int `json:"rate_increment_subsequent,omitempty"`
+}
+
+// BillingManagerStatement The billing account's monthly statement. The amounts follow the ledger's sign (usage is negative, top-up is positive).
+type BillingManagerStatement struct {
+	// AccountId The billing account ID. Returned from the `GET /billing_accounts/{id}` response.
+	AccountId *string `json:"account_id,omitempty"`
+
+	// BalanceCreditEnd The credit balance at the end of the period in micros.
+	BalanceCreditEnd *int64 `json:"balance_credit_end,omitempty"`
+
+	// BalanceTokenEnd The token balance at the end of the period.
+	BalanceTokenEnd *int64 `json:"balance_token_end,omitempty"`
+
+	// CsvFileId The file ID of the statement's CSV. Downloadable from the `GET /storage_files/{id}/file` endpoint.
+	CsvFileId *string `json:"csv_file_id,omitempty"`
+
+	// CustomerId The customer's unique identifier. Returned from the `GET /customers` response.
+	CustomerId *string `json:"customer_id,omitempty"`
+
+	// Id The unique identifier of the statement.
+	Id *string `json:"id,omitempty"`
+
+	// Items The line items of the period.
+	Items *[]BillingManagerStatementItem `json:"items,omitempty"`
+
+	// PdfFileId The file ID of the statement's PDF. Downloadable from the `GET /storage_files/{id}/file` endpoint.
+	PdfFileId *string `json:"pdf_file_id,omitempty"`
+
+	// TmCreate The creation timestamp.
+	TmCreate *string `json:"tm_create,omitempty"`
+
+	// TmDelete The deletion timestamp, if applicable.
+	TmDelete *string `json:"tm_delete,omitempty"`
+
+	// TmPeriodEnd The end of the statement period (exclusive, UTC).
+	TmPeriodEnd *string `json:"tm_period_end,omitempty"`
+
+	// TmPeriodStart The start of the statement period (inclusive, UTC).
+	TmPeriodStart *string `json:"tm_period_start,omitempty"`
+
+	// TmUpdate The last update timestamp.
+	TmUpdate *string `json:"tm_update,omitempty"`
+
+	// TopDestinations The destinations of the largest usage in the period. Up to 10.
+	TopDestinations *[]BillingManagerStatementDestination `json:"top_destinations,omitempty"`
+
+	// TopUps The top-ups of the period.
+	TopUps *[]BillingManagerStatementTopUp `json:"top_ups,omitempty"`
+
+	// TotalTopUpCredit The total credit top-up of the period in micros.
+	TotalTopUpCredit *int64 `json:"total_top_up_credit,omitempty"`
+
+	// TotalTopUpToken The total token top-up of the period.
+	TotalTopUpToken *int64 `json:"total_top_up_token,omitempty"`
+
+	// TotalUsageCredit The total credit usage of the period in micros.
+	TotalUsageCredit *int64 `json:"total_usage_credit,omitempty"`
+
+	// TotalUsageToken The total token usage of the period.
+	TotalUsageToken *int64 `json:"total_usage_token,omitempty"`
+}
+
+// BillingManagerStatementDestination The period's usage billed by the same rate deck prefix.
+type BillingManagerStatementDestination struct {
+	// AmountCredit The sum of the credit deltas in micros.
+	AmountCredit *int64 `json:"amount_credit,omitempty"`
+
+	// CostType The classification of the billing cost.
+	CostType *BillingManagerBillingCostType `json:"cost_type,omitempty"`
+
+	// Count The number of the billings.
+	Count *int `json:"count,omitempty"`
+
+	// Prefix The destination prefix of the applied rate. Empty for the default rate.
+	Prefix *string `json:"prefix,omitempty"`
+
+	// RateId The ID of the rate deck's rate applied. Nil UUID means the default rate.
+	RateId *string `json:"rate_id,omitempty"`
+
+	// UsageDuration The sum of the usage durations in seconds.
+	UsageDuration *int `json:"usage_duration,omitempty"`
+}
+
+// BillingManagerStatementItem The period's ledger entries of the same transaction type, reference type and cost type.
+type BillingManagerStatementItem struct {
+	// AmountCredit The sum of the credit deltas in micros (negative for usage, positive for top-up).
+	AmountCredit *int64 `json:"amount_credit,omitempty"`
+
+	// AmountToken The sum of the token deltas (negative for usage, positive for top-up).
+	AmountToken *int64 `json:"amount_token,omitempty"`
+
+	// BillableUnits The sum of the billable units.
+	BillableUnits *int `json:"billable_units,omitempty"`
+
+	// CostType The classification of the billing cost.
+	CostType *BillingManagerBillingCostType `json:"cost_type,omitempty"`
+
+	// Count The number of the ledger entries.
+	Count *int `json:"count,omitempty"`
+
+	// ReferenceType The type of reference associated with this billing.
+	ReferenceType *BillingManagerBillingreferenceType `json:"reference_type,omitempty"`
+
+	// TransactionType The nature of the ledger entry.
+	TransactionType *BillingManagerBillingTransactionType `json:"transaction_type,omitempty"`
+
+	// UsageDuration The sum of the usage durations in seconds.
+	UsageDuration *int `json:"usage_duration,omitempty"`
+}
+
+// BillingManagerStatementTopUp A top-up ledger entry of the period.
+type BillingManagerStatementTopUp struct {
+	// AmountCredit The credit added in micros.
+	AmountCredit *int64 `json:"amount_credit,omitempty"`
+
+	// AmountToken The token added.
+	AmountToken *int64 `json:"amount_token,omitempty"`
+
+	// BillingId The ID of the top-up billing. Returned from the `GET /billings` response.
+	BillingId *string `json:"billing_id,omitempty"`
+
+	// ReferenceType The type of reference associated with this billing.
+	ReferenceType *BillingManagerBillingreferenceType `json:"reference_type,omitempty"`
+
+	// TmCreate The timestamp of the top-up.
+	TmCreate *string `json:"tm_create,omitempty"`
+}
+
 // CallManagerCall defines model for CallManagerCall.
 type CallManagerCall struct {
 	Action *FlowManagerAction `json:"action,omitempty"`
@@ -2032,6 +2980,9 @@ type CallManagerCall struct {
 	// MasterCallId The unique identifier of the master call that initiated this call. Returned from the `POST /calls` or `GET /calls` response.
 	MasterCallId *string `json:"master_call_id,omitempty"`
 
+	// MaxCost The call's max cost in micros. The call is hung up with the `max_cost` hangup reason once its cost reaches it. 0 means no limit.
+	MaxCost *int64 `json:"max_cost,omitempty"`
+
 	// Metadata Internal metadata for the call. Contains key-value pairs set by the system.
 	// Currently supported keys:
 	// - `rtp_debug` (boolean): When `true`, RTPEngine is capturing RTP traffic for this call.
@@ -2052,6 +3003,9 @@ type CallManagerCall struct {
 	// RecordingIds Recording IDs associated with this call. Each ID is returned from the `GET /recordings` response.
 	RecordingIds *[]string `json:"recording_ids,omitempty"`
 
+	// RunningCost The credit in micros accrued so far by the progressing call.
+	RunningCost *int64 `json:"running_cost,omitempty"`
+
 	// Source Contains source or destination detail info.
 	Source *CommonAddress `json:"source,omitempty"`
 
@@ -2379,18 +3333,27 @@ type CampaignManagerCampaign struct {
 	// Actions Ordered list of actions to execute for each campaign call.
 	Actions *[]FlowManagerAction `json:"actions,omitempty"`
 
+	// CallingWindows The targets are dialed only in these windows of the callee's local time. Empty means any time.
+	CallingWindows *[]CampaignManagerCampaignCallingWindow `json:"calling_windows,omitempty"`
+
 	// CustomerId The unique identifier of the customer. Returned from the `GET /customers` response.
 	CustomerId *string `json:"customer_id,omitempty"`
 
 	// Detail Additional details about the campaign.
 	Detail *string `json:"detail,omitempty"`
 
+	// DialMode Dial mode of the campaign. Applies when the campaign has a queue.
+	DialMode *CampaignManagerCampaignDialMode `json:"dial_mode,omitempty"`
+
 	// EndHandle Behavior of the campaign after outdial has no more targets.
 	EndHandle *CampaignManagerCampaignEndHandle `json:"end_handle,omitempty"`
 
 	// Id The unique identifier of the campaign.
 	Id *string `json:"id,omitempty"`
 
+	// MaxAbandonRate Max abandon rate percentage of the predictive dial mode. 0 means the default 3.
+	MaxAbandonRate *int `json:"max_abandon_rate,omitempty"`
+
 	// Name Display name of the campaign.
 	Name *string `json:"name,omitempty"`
 
@@ -2412,6 +3375,9 @@ type CampaignManagerCampaign struct {
 	// Status Status of the campaign.
 	Status *CampaignManagerCampaignStatus `json:"status,omitempty"`
 
+	// Timezone IANA time zone used when the callee's time zone is unknown. Empty means UTC.
+	Timezone *string `json:"timezone,omitempty"`
+
 	// TmCreate Timestamp when the campaign was created.
 	TmCreate *string `json:"tm_create,omitempty"`
 
@@ -2425,12 +3391,93 @@ type CampaignManagerCampaign struct {
 	Type *CampaignManagerCampaignType `json:"type,omitempty"`
 }
 
+// CampaignManagerCampaignCallingWindow Days and hours the callee can be called. Evaluated in the callee's local time.
+type CampaignManagerCampaignCallingWindow struct {
+	// Days Days of the week the window is open. Empty means every day.
+	Days *[]CampaignManagerCampaignCallingWindowDay `json:"days,omitempty"`
+
+	// End End time of the window in HH:MM format. Exclusive. 24:00 means the end of the day.
+	End string `json:"end"`
+
+	// Start Start time of the window in HH:MM format. Inclusive.
+	Start string `json:"start"`
+}
+
+// CampaignManagerCampaignCallingWindowDay Day of the week.
+type CampaignManagerCampaignCallingWindowDay string
+
+// CampaignManagerCampaignDialMode Dial mode of the campaign. Empty means power.
+type CampaignManagerCampaignDialMode string
+
 // CampaignManagerCampaignEndHandle Behavior of the campaign after outdial has no more targets.
 type CampaignManagerCampaignEndHandle string
 
 // CampaignManagerCampaignExecute Execution action for the campaign.
 type CampaignManagerCampaignExecute string
 
+// CampaignManagerCampaignResult The campaign's result of a single outdial target.
+type CampaignManagerCampaignResult struct {
+	// Attempts The dialing attempts to the outdial target. Oldest first.
+	Attempts *[]CampaignManagerCampaignResultAttempt `json:"attempts,omitempty"`
+
+	// CampaignId The unique identifier of the campaign. Returned from the `POST /campaigns` or `GET /campaigns` response.
+	CampaignId *string `json:"campaign_id,omitempty"`
+
+	// Name Name of the outdial target.
+	Name *string `json:"name,omitempty"`
+
+	// OutdialTargetId The unique identifier of the outbound dial target. Returned from the `GET /outdials/{id}/targets` response.
+	OutdialTargetId *string `json:"outdial_target_id,omitempty"`
+
+	// Status Status of the outdial target.
+	Status *string `json:"status,omitempty"`
+
+	// TmCreate Timestamp when the outdial target was created.
+	TmCreate *string `json:"tm_create,omitempty"`
+}
+
+// CampaignManagerCampaignResultAttempt A single dialing attempt to the outdial target.
+type CampaignManagerCampaignResultAttempt struct {
+	// Abandoned Whether the answered call left the queue before an agent was connected.
+	Abandoned *bool `json:"abandoned,omitempty"`
+
+	// CampaigncallId The unique identifier of the campaign call. Returned from the `GET /campaigncalls` response.
+	CampaigncallId *string `json:"campaigncall_id,omitempty"`
+
+	// Destination Contains source or destination detail info.
+	Destination *CommonAddress `json:"destination,omitempty"`
+
+	// DestinationIndex Index of the dialed destination.
+	DestinationIndex *int `json:"destination_index,omitempty"`
+
+	// Disposition The business outcome of the campaign call. Empty if not set.
+	Disposition *string `json:"disposition,omitempty"`
+
+	// Duration Duration in milliseconds from the dialing to the end. 0 until the campaign call is done.
+	Duration *int `json:"duration,omitempty"`
+
+	// Result Result of the campaign call attempt.
+	Result *CampaignManagerCampaigncallResult `json:"result,omitempty"`
+
+	// Status Status of the campaign call.
+	Status *CampaignManagerCampaigncallStatus `json:"status,omitempty"`
+
+	// TalkDuration Duration in milliseconds from the answer to the end. 0 if the call was not answered.
+	TalkDuration *int `json:"talk_duration,omitempty"`
+
+	// TmCreate Timestamp when the campaign call was created.
+	TmCreate *string `json:"tm_create,omitempty"`
+
+	// TmEnd Timestamp when the campaign call was done.
+	TmEnd *string `json:"tm_end,omitempty"`
+
+	// TmProgressing Timestamp when the campaign call was answered.
+	TmProgressing *string `json:"tm_progressing,omitempty"`
+
+	// TryCount Number of dialing attempts made to the destination.
+	TryCount *int `json:"try_count,omitempty"`
+}
+
 // CampaignManagerCampaignStatus Status of the campaign.
 type CampaignManagerCampaignStatus string
 
@@ -2439,9 +3486,15 @@ type CampaignManagerCampaignType string
 
 // CampaignManagerCampaigncall defines model for CampaignManagerCampaigncall.
 type CampaignManagerCampaigncall struct {
+	// Abandoned Whether the answered call left the queue before an agent was connected.
+	Abandoned *bool `json:"abandoned,omitempty"`
+
 	// ActiveflowId The unique identifier of the activeflow. Returned from the `GET /activeflows` response.
 	ActiveflowId *string `json:"activeflow_id,omitempty"`
 
+	// AgentId The unique identifier of the agent who accepted the previewing campaign call. Returned from the `GET /agents` response.
+	AgentId *string `json:"agent_id,omitempty"`
+
 	// CampaignId The unique identifier of the campaign. Returned from the `POST /campaigns` or `GET /campaigns` response.
 	CampaignId *string `json:"campaign_id,omitempty"`
 
@@ -2454,6 +3507,9 @@ type CampaignManagerCampaigncall struct {
 	// DestinationIndex Index of the current destination being dialed.
 	DestinationIndex *int `json:"destination_index,omitempty"`
 
+	// Disposition The business outcome of the campaign call. One of the customer's disposition codes. Returned from the `GET /dispositions` response. Empty if not set.
+	Disposition *string `json:"disposition,omitempty"`
+
 	// FlowId The unique identifier of the flow. Returned from the `POST /flows` or `GET /flows` response.
 	FlowId *string `json:"flow_id,omitempty"`
 
@@ -2493,6 +3549,12 @@ type CampaignManagerCampaigncall struct {
 	// TmDelete Timestamp when the campaign call was deleted.
 	TmDelete *string `json:"tm_delete,omitempty"`
 
+	// TmEnd Timestamp when the campaign call was done.
+	TmEnd *string `json:"tm_end,omitempty"`
+
+	// TmProgressing Timestamp when the campaign call was answered.
+	TmProgressing *string `json:"tm_progressing,omitempty"`
+
 	// TmUpdate Timestamp when the campaign call was last updated.
 	TmUpdate *string `json:"tm_update,omitempty"`
 
@@ -2509,6 +3571,78 @@ type CampaignManagerCampaigncallResult string
 // CampaignManagerCampaigncallStatus Status of the campaign call.
 type CampaignManagerCampaigncallStatus string
 
+// CampaignManagerCampaignresultjob The asynchronous export of the campaign's results to a csv file.
+type CampaignManagerCampaignresultjob struct {
+	// CampaignId The unique identifier of the campaign. Returned from the `POST /campaigns` or `GET /campaigns` response.
+	CampaignId *string `json:"campaign_id,omitempty"`
+
+	// CustomerId The unique identifier for the customer associated with the campaign result job. Returned from the `GET /customers` response.
+	CustomerId *string `json:"customer_id,omitempty"`
+
+	// Detail The reason of the failure.
+	Detail *string `json:"detail,omitempty"`
+
+	// FileId The unique identifier of the exported csv file. Empty until the job is done. Returned from the `GET /files` response.
+	FileId *string `json:"file_id,omitempty"`
+
+	// Id The unique identifier for the campaign result job.
+	Id *string `json:"id,omitempty"`
+
+	// RowCount The number of the exported csv rows. Each row is a dialing attempt.
+	RowCount *int `json:"row_count,omitempty"`
+
+	// Status The status of the campaign result job.
+	Status *CampaignManagerCampaignresultjobStatus `json:"status,omitempty"`
+
+	// TargetCount The number of the exported outdial targets.
+	TargetCount *int `json:"target_count,omitempty"`
+
+	// TmCreate Timestamp when the campaign result job was created.
+	TmCreate *string `json:"tm_create,omitempty"`
+
+	// TmDelete Timestamp when the campaign result job was deleted.
+	TmDelete *string `json:"tm_delete,omitempty"`
+
+	// TmUpdate Timestamp when the campaign result job was last updated.
+	TmUpdate *string `json:"tm_update,omitempty"`
+}
+
+// CampaignManagerCampaignresultjobStatus The status of the campaign result job.
+type CampaignManagerCampaignresultjobStatus string
+
+// CampaignManagerDisposition A customer defined business outcome of the campaign call. e.g. sale, callback requested, wrong number.
+type CampaignManagerDisposition struct {
+	// Code The code of the disposition. Unique in the customer. Lowercase letters, digits and underscores.
+	Code *string `json:"code,omitempty"`
+
+	// CustomerId The unique identifier of the customer. Returned from the `GET /customers` response.
+	CustomerId *string `json:"customer_id,omitempty"`
+
+	// Detail Detailed description of the disposition.
+	Detail *string `json:"detail,omitempty"`
+
+	// Id The unique identifier of the disposition.
+	Id *string `json:"id,omitempty"`
+
+	// Name Name of the disposition.
+	Name *string `json:"name,omitempty"`
+
+	// RetryMode Decides the outdial target's retry when the campaign call with the disposition is done.
+	RetryMode *CampaignManagerDispositionRetryMode `json:"retry_mode,omitempty"`
+
+	// TmCreate Timestamp when the disposition was created.
+	TmCreate *string `json:"tm_create,omitempty"`
+
+	// TmDelete Timestamp when the disposition was deleted.
+	TmDelete *string `json:"tm_delete,omitempty"`
+
+	// TmUpdate Timestamp when the disposition was last updated.
+	TmUpdate *string `json:"tm_update,omitempty"`
+}
+
+// CampaignManagerDispositionRetryMode Decides the outdial target's retry when the campaign call with the disposition is done.
+type CampaignManagerDispositionRetryMode string
+
 // CampaignManagerOutplan defines model for CampaignManagerOutplan.
 type CampaignManagerOutplan struct {
 	// CustomerId The unique identifier of the customer. Returned from the `GET /customers` response.
@@ -3248,6 +4382,9 @@ type CustomerManagerEmailVerifyResult struct {
 // Updatable by CustomerAdmin via `PUT /customer/metadata`
 // or by ProjectSuperAdmin via `PUT /customers/{id}/metadata`.
 type CustomerManagerMetadata struct {
+	// PiiRedaction Redaction of personal data in the AI conversations. The detected values are redacted before they are stored in the AI messages and before they reach the LLM.
+	PiiRedaction *AIManagerRedaction `json:"pii_redaction,omitempty"`
+
 	// RtpDebug When set to `true`, RTPEngine captures RTP traffic as PCAP files for this customer's calls.
 	// Use this to debug audio quality issues (one-way audio, codec problems, jitter).
 	// Default is `false`. Enabling this increases storage usage — disable after debugging.
@@ -3346,6 +4483,7 @@ type FlowManagerAction struct {
 	NextId *string `json:"next_id,omitempty"`
 
 	// Option Additional options based on the `type` field.
+	// - For `FlowManagerActionTypeAIExtract`: see `FlowManagerActionOptionAIExtract`
 	// - For `FlowManagerActionTypeAISummary`: see `FlowManagerActionOptionAISummary`
 	// - For `FlowManagerActionTypeAITalk`: see `FlowManagerActionOptionAITalk`
 	// - For `FlowManagerActionTypeAMD`: see `FlowManagerActionOptionAMD`
@@ -3359,6 +4497,7 @@ type FlowManagerAction struct {
 	// - For `FlowManagerActionTypeConversationSend`: see FlowManagerActionOptionConversationSend
 	// - For `FlowManagerActionTypeDigitsReceive`: see FlowManagerActionOptionDigitsReceive
 	// - For `FlowManagerActionTypeDigitsSend`: see FlowManagerActionOptionDigitsSend
+	// - For `FlowManagerActionTypeDispositionSet`: see FlowManagerActionOptionDispositionSet
 	// - For `FlowManagerActionTypeEcho`: see FlowManagerActionOptionEcho
 	// - For `FlowManagerActionTypeEmailSend`: see FlowManagerActionOptionEmailSend
 	// - For `FlowManagerActionTypeExternalMediaStart`: see FlowManagerActionOptionExternalMediaStart
@@ -3390,6 +4529,27 @@ type FlowManagerAction struct {
 	Type FlowManagerActionType `json:"type"`
 }
 
+// FlowManagerActionOptionAIExtract defines model for FlowManagerActionOptionAIExtract.
+type FlowManagerActionOptionAIExtract struct {
+	// Language BCP47 language code for the extracted values.
+	Language *string `json:"language,omitempty"`
+
+	// OnEndFlowId The unique identifier of the flow to execute when AI extraction completes. Returned from the `POST /flows` or `GET /flows` response.
+	OnEndFlowId *string `json:"on_end_flow_id,omitempty"`
+
+	// ReferenceId The unique identifier of the referenced resource. Returned from the corresponding resource endpoint.
+	ReferenceId *string `json:"reference_id,omitempty"`
+
+	// ReferenceType Type of reference for the AI extraction.
+	ReferenceType *AIManagerExtractionReferenceType `json:"reference_type,omitempty"`
+
+	// Schema JSON schema of the data to extract. The root must be an object with properties.
+	Schema *map[string]interface{} `json:"schema,omitempty"`
+
+	// SetVariables If true, each extracted field is set as the flow variable `voipbin.ai_extract.result.<field>`.
+	SetVariables *bool `json:"set_variables,omitempty"`
+}
+
 // FlowManagerActionOptionAISummary defines model for FlowManagerActionOptionAISummary.
 type FlowManagerActionOptionAISummary struct {
 	// Language BCP47 language code for the summary.
@@ -3547,6 +4707,12 @@ type FlowManagerActionOptionDigitsSend struct {
 	Interval *int `json:"interval,omitempty"`
 }
 
+// FlowManagerActionOptionDispositionSet defines model for FlowManagerActionOptionDispositionSet.
+type FlowManagerActionOptionDispositionSet struct {
+	// Disposition The code of the disposition to set on the activeflow's campaign call. Returned from the `GET /dispositions` response. Does nothing if the activeflow was not started by a campaign call.
+	Disposition *string `json:"disposition,omitempty"`
+}
+
 // FlowManagerActionOptionEcho defines model for FlowManagerActionOptionEcho.
 type FlowManagerActionOptionEcho struct {
 	// Duration Duration of the echo in milliseconds.
@@ -4059,6 +5225,9 @@ type OutdialManagerOutdial struct {
 
 // OutdialManagerOutdialtarget defines model for OutdialManagerOutdialtarget.
 type OutdialManagerOutdialtarget struct {
+	// CallbackAgentId The agent who handles the scheduled callback. Returned from the `GET /agents` response. Empty means any agent.
+	CallbackAgentId *string `json:"callback_agent_id,omitempty"`
+
 	// Data The data associated with the outdial target.
 	Data *string `json:"data,omitempty"`
 
@@ -4092,6 +5261,12 @@ type OutdialManagerOutdialtarget struct {
 	// Status The status of the outdial.
 	Status *OutdialManagerOutdialtargetStatus `json:"status,omitempty"`
 
+	// Timezone IANA time zone of the callee. Empty means derived from the destination number.
+	Timezone *string `json:"timezone,omitempty"`
+
+	// TmCallback The scheduled callback timestamp. The campaign does not dial the outdial target until this time. Null means no callback is scheduled.
+	TmCallback *string `json:"tm_callback,omitempty"`
+
 	// TmCreate The creation timestamp.
 	TmCreate *string `json:"tm_create,omitempty"`
 
@@ -4120,6 +5295,165 @@ type OutdialManagerOutdialtarget struct {
 // OutdialManagerOutdialtargetStatus The status of the outdial.
 type OutdialManagerOutdialtargetStatus string
 
+// OutdialManagerOutdialtargetjob defines model for OutdialManagerOutdialtargetjob.
+type OutdialManagerOutdialtargetjob struct {
+	// CustomerId The unique identifier for the customer associated with the outdial target job. Returned from the `GET /customers` response.
+	CustomerId *string `json:"customer_id,omitempty"`
+
+	// Detail The reason of the failure.
+	Detail *string `json:"detail,omitempty"`
+
+	// DuplicateCount The number of the rows skipped because of the duplicated destination 0.
+	DuplicateCount *int `json:"duplicate_count,omitempty"`
+
+	// ErrorCount The number of the invalid rows.
+	ErrorCount *int `json:"error_count,omitempty"`
+
+	// ErrorFileId The unique identifier of the error report csv file listing the invalid rows with the reasons. Returned from the `GET /files` response.
+	ErrorFileId *string `json:"error_file_id,omitempty"`
+
+	// FileId The unique identifier of the csv file. The imported file for the `import` and the exported file for the `export`. Returned from the `GET /files` response.
+	FileId *string `json:"file_id,omitempty"`
+
+	// Id The unique identifier for the outdial target job.
+	Id *string `json:"id,omitempty"`
+
+	// Mapping The csv header names of the outdial target's fields. An omitted field uses the field's own name as the header name.
+	Mapping *OutdialManagerOutdialtargetjobMapping `json:"mapping,omitempty"`
+
+	// OutdialId The unique identifier of the outdial. Returned from the `POST /outdials` or `GET /outdials` response.
+	OutdialId *string `json:"outdial_id,omitempty"`
+
+	// ProcessedCount The number of the processed rows.
+	ProcessedCount *int `json:"processed_count,omitempty"`
+
+	// Status The status of the outdial target job.
+	Status *OutdialManagerOutdialtargetjobStatus `json:"status,omitempty"`
+
+	// SuccessCount The number of the imported or exported targets.
+	SuccessCount *int `json:"success_count,omitempty"`
+
+	// TmCreate Timestamp when the outdial target job was created.
+	TmCreate *string `json:"tm_create,omitempty"`
+
+	// TmDelete Timestamp when the outdial target job was deleted.
+	TmDelete *string `json:"tm_delete,omitempty"`
+
+	// TmUpdate Timestamp when the outdial target job was last updated.
+	TmUpdate *string `json:"tm_update,omitempty"`
+
+	// TotalCount The number of the csv data rows or the exported targets.
+	TotalCount *int `json:"total_count,omitempty"`
+
+	// Type The type of the outdial target job.
+	Type *OutdialManagerOutdialtargetjobType `json:"type,omitempty"`
+}
+
+// OutdialManagerOutdialtargetjobMapping The csv header names of the outdial target's fields. An omitted field uses the field's own name as the header name.
+type OutdialManagerOutdialtargetjobMapping struct {
+	// Data The header name of the target's data column.
+	Data *string `json:"data,omitempty"`
+
+	// Destination0 The header name of the target's destination 0 column.
+	Destination0 *string `json:"destination_0,omitempty"`
+
+	// Destination1 The header name of the target's destination 1 column.
+	Destination1 *string `json:"destination_1,omitempty"`
+
+	// Destination2 The header name of the target's destination 2 column.
+	Destination2 *string `json:"destination_2,omitempty"`
+
+	// Destination3 The header name of the target's destination 3 column.
+	Destination3 *string `json:"destination_3,omitempty"`
+
+	// Destination4 The header name of the target's destination 4 column.
+	Destination4 *string `json:"destination_4,omitempty"`
+
+	// Detail The header name of the target's detail column.
+	Detail *string `json:"detail,omitempty"`
+
+	// Name The header name of the target's name column.
+	Name *string `json:"name,omitempty"`
+
+	// Timezone The header name of the target's timezone column.
+	Timezone *string `json:"timezone,omitempty"`
+}
+
+// OutdialManagerOutdialtargetjobStatus The status of the outdial target job.
+type OutdialManagerOutdialtargetjobStatus string
+
+// OutdialManagerOutdialtargetjobType The type of the outdial target job.
+type OutdialManagerOutdialtargetjobType string
+
+// OutdialManagerSuppression defines model for OutdialManagerSuppression.
+type OutdialManagerSuppression struct {
+	// CustomerId The unique identifier for the customer associated with the suppression. Returned from the `GET /customers` response.
+	CustomerId *string `json:"customer_id,omitempty"`
+
+	// Detail The detailed description of the suppression.
+	Detail *string `json:"detail,omitempty"`
+
+	// Id The unique identifier for the suppression.
+	Id *string `json:"id,omitempty"`
+
+	// ListType The type of the suppression list.
+	ListType *OutdialManagerSuppressionListType `json:"list_type,omitempty"`
+
+	// Target The suppressed destination number in E.164 format.
+	Target *string `json:"target,omitempty"`
+
+	// TmCreate Timestamp when the suppression was created.
+	TmCreate *string `json:"tm_create,omitempty"`
+
+	// TmDelete Timestamp when the suppression was deleted.
+	TmDelete *string `json:"tm_delete,omitempty"`
+
+	// TmUpdate Timestamp when the suppression was last updated.
+	TmUpdate *string `json:"tm_update,omitempty"`
+}
+
+// OutdialManagerSuppressionBlock defines model for OutdialManagerSuppressionBlock.
+type OutdialManagerSuppressionBlock struct {
+	// CustomerId The unique identifier for the customer associated with the suppression block. Returned from the `GET /customers` response.
+	CustomerId *string `json:"customer_id,omitempty"`
+
+	// Id The unique identifier for the suppression block.
+	Id *string `json:"id,omitempty"`
+
+	// ListType The type of the suppression list.
+	ListType *OutdialManagerSuppressionListType `json:"list_type,omitempty"`
+
+	// Reason The reason the attempt was blocked.
+	Reason *string `json:"reason,omitempty"`
+
+	// ReferenceId The unique identifier of the blocked attempt's resource. The master call for the `call`, the message for the `message` and the campaign for the `campaign`.
+	ReferenceId *string `json:"reference_id,omitempty"`
+
+	// ReferenceType The type of the blocked outbound attempt.
+	ReferenceType *OutdialManagerSuppressionBlockReferenceType `json:"reference_type,omitempty"`
+
+	// SuppressionId The unique identifier of the suppression which blocked the attempt. Returned from the `GET /suppressions` response.
+	SuppressionId *string `json:"suppression_id,omitempty"`
+
+	// Target The blocked destination number in E.164 format.
+	Target *string `json:"target,omitempty"`
+
+	// TmCreate Timestamp when the attempt was blocked.
+	TmCreate *string `json:"tm_create,omitempty"`
+
+	// TmDelete Timestamp when the suppression block was deleted.
+	TmDelete *string `json:"tm_delete,omitempty"`
+
+	// TmUpdate Timestamp when the suppression block was last updated.
+	TmUpdate *string `json:"tm_update,omitempty"`
+}
+
+// OutdialManagerSuppressionBlockReferenceType The type of the blocked outbound attempt.
+type OutdialManagerSuppressionBlockReferenceType string
+
+// OutdialManagerSuppressionListType The type of the suppression list.
+type OutdialManagerSuppressionListType string
+
 // QueueManagerQueue defines model for QueueManagerQueue.
 type QueueManagerQueue struct {
 	// CustomerId The unique identifier of the customer who owns this queue. Returned from the `GET /customers` response.
@@ -5437,6 +6771,36 @@ type GetAggregatedEventsParams struct {
 	PageToken *PageToken `form:"page_token,omitempty" json:"page_token,omitempty"`
 }
 
+// GetAiassistsParams defines parameters for GetAiassists.
+type GetAiassistsParams struct {
+	// PageSize Number of results to return per page.
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// PageToken Cursor token for pagination. Use the `next_page_token` value from the previous response.
+	PageToken *PageToken `form:"page_token,omitempty" json:"page_token,omitempty"`
+}
+
+// PostAiassistsJSONBody defines parameters for PostAiassists.
+type PostAiassistsJSONBody struct {
+	// AgentId The ID of the assisted agent. Defaults to the requesting agent.
+	AgentId *string `json:"agent_id,omitempty"`
+
+	// AiId The ID of the AI. Its init prompt guides the suggestions and its RAG answers the knowledge questions.
+	AiId string `json:"ai_id"`
+
+	// Checklist The compliance items the agent has to cover in the call.
+	Checklist *[]string `json:"checklist,omitempty"`
+
+	// Language The language of the call.
+	Language *string `json:"language,omitempty"`
+
+	// ReferenceId The ID of the call to assist.
+	ReferenceId string `json:"reference_id"`
+
+	// ReferenceType Type of reference for the AI assist.
+	ReferenceType AIManagerAIAssistReferenceType `json:"reference_type"`
+}
+
 // GetAiauditsParams defines parameters for GetAiaudits.
 type GetAiauditsParams struct {
 	// PageSize Number of results to return per page.
@@ -5554,13 +6918,19 @@ type PostAisJSONBody struct {
 	AutoAicallAuditEnabled *bool  `json:"auto_aicall_audit_enabled,omitempty"`
 	Detail                 string `json:"detail"`
 
+	// EngineFallbacks Engines tried in order when the primary engine fails during a call (max 3). The same model may be listed again with a different engine_key.
+	EngineFallbacks *[]AIManagerAIEngineFallback `json:"engine_fallbacks,omitempty"`
+
 	// EngineKey API key or credential for the AI engine.
 	EngineKey string `json:"engine_key"`
 
 	// EngineModel Model of the AI engine. Uses target.model format (e.g., openai.gpt-5). The target prefix identifies the provider, and the model name follows after the dot.
 	EngineModel AIManagerAIEngineModel `json:"engine_model"`
-	InitPrompt  string                 `json:"init_prompt"`
-	Name        string                 `json:"name"`
+
+	// Guardrail Guardrail policy of the AI. The user's speech is checked against the blocked topics before the AI answers, and the AI's spoken output is checked against the forbidden phrases before it reaches the text-to-speech. Every violation is recorded as an AI guardrail violation.
+	Guardrail  *AIManagerGuardrail `json:"guardrail,omitempty"`
+	InitPrompt string              `json:"init_prompt"`
+	Name       string              `json:"name"`
 
 	// Parameter Data associated with the ai's engine, can be dynamic and vary based on the engine type.
 	Parameter map[string]interface{} `json:"parameter"`
@@ -5568,6 +6938,12 @@ type PostAisJSONBody struct {
 	// RagId The knowledge base ID (UUID) for the search_knowledge tool. Returned from the `id` field of the `GET /rags` response. Send empty string or omit to clear.
 	RagId *string `json:"rag_id,omitempty"`
 
+	// Redaction Redaction of personal data in the AI conversations. The detected values are redacted before they are stored in the AI messages and before they reach the LLM.
+	Redaction *AIManagerRedaction `json:"redaction,omitempty"`
+
+	// ResponseCache Semantic response cache of the AI. When the user asks a question similar enough to one the AI already answered, the cached answer is said without running the LLM. The cache is kept per AI and dropped when the AI's `init_prompt` or its RAG changes. Available for the AI calls of a single AI only.
+	ResponseCache *AIManagerResponseCache `json:"response_cache,omitempty"`
+
 	// SttLanguage STT language in BCP-47 format (e.g., ko-KR, en-US).
 	SttLanguage *string `json:"stt_language,omitempty"`
 
@@ -5599,13 +6975,19 @@ type PutAisIdJSONBody struct {
 	AutoAicallAuditEnabled *bool  `json:"auto_aicall_audit_enabled,omitempty"`
 	Detail                 string `json:"detail"`
 
+	// EngineFallbacks Engines tried in order when the primary engine fails during a call (max 3). The same model may be listed again with a different engine_key.
+	EngineFallbacks *[]AIManagerAIEngineFallback `json:"engine_fallbacks,omitempty"`
+
 	// EngineKey API key or credential for the AI engine.
 	EngineKey string `json:"engine_key"`
 
 	// EngineModel Model of the AI engine. Uses target.model format (e.g., openai.gpt-5). The target prefix identifies the provider, and the model name follows after the dot.
 	EngineModel AIManagerAIEngineModel `json:"engine_model"`
-	InitPrompt  string                 `json:"init_prompt"`
-	Name        string                 `json:"name"`
+
+	// Guardrail Guardrail policy of the AI. The user's speech is checked against the blocked topics before the AI answers, and the AI's spoken output is checked against the forbidden phrases before it reaches the text-to-speech. Every violation is recorded as an AI guardrail violation.
+	Guardrail  *AIManagerGuardrail `json:"guardrail,omitempty"`
+	InitPrompt string              `json:"init_prompt"`
+	Name       string              `json:"name"`
 
 	// Parameter Data associated with the ai's engine, can be dynamic and vary based on the engine type.
 	Parameter map[string]interface{} `json:"parameter"`
@@ -5613,6 +6995,12 @@ type PutAisIdJSONBody struct {
 	// RagId The knowledge base ID (UUID) for the search_knowledge tool. Returned from the `id` field of the `GET /rags` response. Send empty string or omit to clear.
 	RagId *string `json:"rag_id,omitempty"`
 
+	// Redaction Redaction of personal data in the AI conversations. The detected values are redacted before they are stored in the AI messages and before they reach the LLM.
+	Redaction *AIManagerRedaction `json:"redaction,omitempty"`
+
+	// ResponseCache Semantic response cache of the AI. When the user asks a question similar enough to one the AI already answered, the cached answer is said without running the LLM. The cache is kept per AI and dropped when the AI's `init_prompt` or its RAG changes. Available for the AI calls of a single AI only.
+	ResponseCache *AIManagerResponseCache `json:"response_cache,omitempty"`
+
 	// SttLanguage STT language in BCP-47 format (e.g., ko-KR, en-US).
 	SttLanguage *string `json:"stt_language,omitempty"`
 
@@ -5656,6 +7044,51 @@ type GetAisIdPromptHistoriesParams struct {
 	PageToken *PageToken `form:"page_token,omitempty" json:"page_token,omitempty"`
 }
 
+// GetAiextractionsParams defines parameters for GetAiextractions.
+type GetAiextractionsParams struct {
+	// PageSize Number of results to return per page.
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// PageToken Cursor token for pagination. Use the `next_page_token` value from the previous response.
+	PageToken *PageToken `form:"page_token,omitempty" json:"page_token,omitempty"`
+}
+
+// PostAiextractionsJSONBody defines parameters for PostAiextractions.
+type PostAiextractionsJSONBody struct {
+	// Language The language of the extracted values.
+	Language *string `json:"language,omitempty"`
+
+	// OnEndFlowId The ID of the flow to be executed when the ai extraction ends.
+	OnEndFlowId *string `json:"on_end_flow_id,omitempty"`
+
+	// ReferenceId The ID of the reference for the ai extraction.
+	ReferenceId string `json:"reference_id"`
+
+	// ReferenceType Type of reference for the AI extraction.
+	ReferenceType AIManagerExtractionReferenceType `json:"reference_type"`
+
+	// Schema JSON schema of the data to extract. The root must be an object with properties.
+	Schema map[string]interface{} `json:"schema"`
+
+	// SetVariables If true, each extracted field is set as a flow variable of the activeflow.
+	SetVariables *bool `json:"set_variables,omitempty"`
+}
+
+// GetAiguardrailviolationsParams defines parameters for GetAiguardrailviolations.
+type GetAiguardrailviolationsParams struct {
+	// PageSize Number of results to return per page.
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// PageToken Cursor token for pagination. Use the `next_page_token` value from the previous response.
+	PageToken *PageToken `form:"page_token,omitempty" json:"page_token,omitempty"`
+
+	// AicallId Filter by AI call ID.
+	AicallId *openapi_types.UUID `form:"aicall_id,omitempty" json:"aicall_id,omitempty"`
+
+	// AiId Filter by AI ID.
+	AiId *openapi_types.UUID `form:"ai_id,omitempty" json:"ai_id,omitempty"`
+}
+
 // GetAisummariesParams defines parameters for GetAisummaries.
 type GetAisummariesParams struct {
 	// PageSize Number of results to return per page.
@@ -5728,6 +7161,21 @@ type PutBillingAccountPaymentInfoJSONBody struct {
 	PaymentType *BillingManagerAccountPaymentType `json:"payment_type,omitempty"`
 }
 
+// PutBillingAccountSpendingSettingsJSONBody defines parameters for PutBillingAccountSpendingSettings.
+type PutBillingAccountSpendingSettingsJSONBody struct {
+	// AutoTopupAmount The credit amount in micros charged by the auto top-up. Must be a multiple of 10000 (1 cent). 0 disables the auto top-up.
+	AutoTopupAmount *int64 `json:"auto_topup_amount,omitempty"`
+
+	// AutoTopupThreshold The credit balance in micros which triggers the auto top-up.
+	AutoTopupThreshold *int64 `json:"auto_topup_threshold,omitempty"`
+
+	// BalanceAlertThresholds The credit balances in micros which trigger the low balance alert. Up to 5 thresholds.
+	BalanceAlertThresholds *[]int64 `json:"balance_alert_thresholds,omitempty"`
+
+	// SpendLimits The spending caps by period and cost type. Up to 10 limits.
+	SpendLimits *[]BillingManagerAccountSpendLimit `json:"spend_limits,omitempty"`
+}
+
 // GetBillingAccountsParams defines parameters for GetBillingAccounts.
 type GetBillingAccountsParams struct {
 	// PageSize Number of results to return per page.
@@ -5762,6 +7210,27 @@ type PutBillingAccountsIdPaymentInfoJSONBody struct {
 	PaymentType *BillingManagerAccountPaymentType `json:"payment_type,omitempty"`
 }
 
+// PostBillingEstimatesJSONBody defines parameters for PostBillingEstimates.
+type PostBillingEstimatesJSONBody struct {
+	// CostType The classification of the billing cost.
+	CostType BillingManagerBillingCostType `json:"cost_type"`
+
+	// Destination Contains source or destination detail info.
+	Destination *CommonAddress `json:"destination,omitempty"`
+
+	// Duration The duration of the usage in seconds. Ignored for the non-duration cost types.
+	Duration *int `json:"duration,omitempty"`
+}
+
+// GetBillingStatementsParams defines parameters for GetBillingStatements.
+type GetBillingStatementsParams struct {
+	// PageSize Number of results to return per page.
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// PageToken Cursor token for pagination. Use the `next_page_token` value from the previous response.
+	PageToken *PageToken `form:"page_token,omitempty" json:"page_token,omitempty"`
+}
+
 // GetBillingsParams defines parameters for GetBillings.
 type GetBillingsParams struct {
 	// PageSize Number of results to return per page.
@@ -5792,6 +7261,9 @@ type PostCallsJSONBody struct {
 	// FlowId The flow to execute for this call. The flow ID returned from the POST /flows or GET /flows response. Provide either flow_id or actions. If both are supplied, flow_id takes precedence and actions is ignored.
 	FlowId *string `json:"flow_id,omitempty"`
 
+	// MaxCost Optional max cost of each created call in micros. The call is hung up with the `max_cost` hangup reason once its cost reaches it. 0 means no limit.
+	MaxCost *int64 `json:"max_cost,omitempty"`
+
 	// Source Contains source or destination detail info.
 	Source *CommonAddress `json:"source,omitempty"`
 
@@ -5861,6 +7333,27 @@ type GetCampaigncallsParams struct {
 	PageToken *PageToken `form:"page_token,omitempty" json:"page_token,omitempty"`
 }
 
+// PostCampaigncallsIdAcceptJSONBody defines parameters for PostCampaigncallsIdAccept.
+type PostCampaigncallsIdAcceptJSONBody struct {
+	// AgentId The ID of the agent accepting the campaign call. Returned from the `GET /agents` response.
+	AgentId string `json:"agent_id"`
+}
+
+// PutCampaigncallsIdCallbackJSONBody defines parameters for PutCampaigncallsIdCallback.
+type PutCampaigncallsIdCallbackJSONBody struct {
+	// AgentId The ID of the agent who handles the callback. Returned from the `GET /agents` response. Empty means any agent.
+	AgentId *string `json:"agent_id,omitempty"`
+
+	// TmCallback The callback timestamp in ISO 8601 format. e.g. `2026-01-15T15:00:00Z`. Empty cancels the scheduled callback.
+	TmCallback string `json:"tm_callback"`
+}
+
+// PutCampaigncallsIdDispositionJSONBody defines parameters for PutCampaigncallsIdDisposition.
+type PutCampaigncallsIdDispositionJSONBody struct {
+	// Disposition The code of the disposition. Returned from the `GET /dispositions` response. Empty clears the disposition.
+	Disposition string `json:"disposition"`
+}
+
 // GetCampaignsParams defines parameters for GetCampaigns.
 type GetCampaignsParams struct {
 	// PageSize Number of results to return per page.
@@ -5926,6 +7419,15 @@ type PutCampaignsIdActionsJSONBody struct {
 	Actions []FlowManagerAction `json:"actions"`
 }
 
+// PutCampaignsIdCallingWindowsJSONBody defines parameters for PutCampaignsIdCallingWindows.
+type PutCampaignsIdCallingWindowsJSONBody struct {
+	// CallingWindows The campaign's calling windows. Empty means any time.
+	CallingWindows []CampaignManagerCampaignCallingWindow `json:"calling_windows"`
+
+	// Timezone IANA time zone used when the callee's time zone is unknown. Empty means UTC.
+	Timezone *string `json:"timezone,omitempty"`
+}
+
 // GetCampaignsIdCampaigncallsParams defines parameters for GetCampaignsIdCampaigncalls.
 type GetCampaignsIdCampaigncallsParams struct {
 	// PageSize Number of results to return per page.
@@ -5935,6 +7437,15 @@ type GetCampaignsIdCampaigncallsParams struct {
 	PageToken *PageToken `form:"page_token,omitempty" json:"page_token,omitempty"`
 }
 
+// PutCampaignsIdDialModeJSONBody defines parameters for PutCampaignsIdDialMode.
+type PutCampaignsIdDialModeJSONBody struct {
+	// DialMode Dial mode of the campaign. Empty means power.
+	DialMode CampaignManagerCampaignDialMode `json:"dial_mode"`
+
+	// MaxAbandonRate The max abandon rate percentage of the predictive dial mode. 0 means the default 3.
+	MaxAbandonRate *int `json:"max_abandon_rate,omitempty"`
+}
+
 // PutCampaignsIdNextCampaignIdJSONBody defines parameters for PutCampaignsIdNextCampaignId.
 type PutCampaignsIdNextCampaignIdJSONBody struct {
 	// NextCampaignId The next campaign's id.
@@ -5956,6 +7467,24 @@ type PutCampaignsIdResourceInfoJSONBody struct {
 	QueueId string `json:"queue_id"`
 }
 
+// GetCampaignsIdResultjobsParams defines parameters for GetCampaignsIdResultjobs.
+type GetCampaignsIdResultjobsParams struct {
+	// PageSize Number of results to return per page.
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// PageToken Cursor token for pagination. Use the `next_page_token` value from the previous response.
+	PageToken *PageToken `form:"page_token,omitempty" json:"page_token,omitempty"`
+}
+
+// GetCampaignsIdResultsParams defines parameters for GetCampaignsIdResults.
+type GetCampaignsIdResultsParams struct {
+	// PageSize Number of results to return per page.
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// PageToken Cursor token for pagination. Use the `next_page_token` value from the previous response.
+	PageToken *PageToken `form:"page_token,omitempty" json:"page_token,omitempty"`
+}
+
 // PutCampaignsIdServiceLevelJSONBody defines parameters for PutCampaignsIdServiceLevel.
 type PutCampaignsIdServiceLevelJSONBody struct {
 	// ServiceLevel The campaign's service level.
@@ -6428,6 +7957,69 @@ type PostConversationsIdMessagesJSONBody struct {
 	Text string `json:"text"`
 }
 
+// GetCustomToolsParams defines parameters for GetCustomTools.
+type GetCustomToolsParams struct {
+	// PageSize Number of results to return per page.
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// PageToken Cursor token for pagination. Use the `next_page_token` value from the previous response.
+	PageToken *PageToken `form:"page_token,omitempty" json:"page_token,omitempty"`
+}
+
+// PostCustomToolsJSONBody defines parameters for PostCustomTools.
+type PostCustomToolsJSONBody struct {
+	// Description Description shown to the LLM that explains when and how to call the tool.
+	Description string `json:"description"`
+
+	// Detail Free-form note about the custom tool. Not shown to the LLM.
+	Detail *string `json:"detail,omitempty"`
+
+	// Headers Extra HTTP headers sent with each request (max 20).
+	Headers *map[string]string `json:"headers,omitempty"`
+
+	// Name Function name exposed to the LLM. Must start with a letter and contain only letters, digits and underscores (max 64 characters).
+	Name string `json:"name"`
+
+	// Parameters JSON Schema of the tool arguments. The root must be of type `object`.
+	Parameters *map[string]interface{} `json:"parameters,omitempty"`
+
+	// ResponseTemplate Optional Go text/template applied to the decoded JSON response before it is returned to the LLM.
+	ResponseTemplate *string `json:"response_template,omitempty"`
+
+	// Timeout Request timeout in milliseconds. 0 uses the default of 5000, maximum 30000.
+	Timeout *int `json:"timeout,omitempty"`
+
+	// Url HTTPS endpoint called when the LLM invokes the tool. Private and reserved addresses are rejected.
+	Url string `json:"url"`
+}
+
+// PutCustomToolsIdJSONBody defines parameters for PutCustomToolsId.
+type PutCustomToolsIdJSONBody struct {
+	// Description Description shown to the LLM that explains when and how to call the tool.
+	Description string `json:"description"`
+
+	// Detail Free-form note about the custom tool. Not shown to the LLM.
+	Detail *string `json:"detail,omitempty"`
+
+	// Headers Extra HTTP headers sent with each request (max 20).
+	Headers *map[string]string `json:"headers,omitempty"`
+
+	// Name Function name exposed to the LLM. Must start with a letter and contain only letters, digits and underscores (max 64 characters).
+	Name string `json:"name"`
+
+	// Parameters JSON Schema of the tool arguments. The root must be of type `object`.
+	Parameters *map[string]interface{} `json:"parameters,omitempty"`
+
+	// ResponseTemplate Optional Go text/template applied to the decoded JSON response before it is returned to the LLM.
+	ResponseTemplate *string `json:"response_template,omitempty"`
+
+	// Timeout Request timeout in milliseconds. 0 uses the default of 5000, maximum 30000.
+	Timeout *int `json:"timeout,omitempty"`
+
+	// Url HTTPS endpoint called when the LLM invokes the tool. Private and reserved addresses are rejected.
+	Url string `json:"url"`
+}
+
 // PutCustomerJSONBody defines parameters for PutCustomer.
 type PutCustomerJSONBody struct {
 	// Address The customer's address.
@@ -6460,6 +8052,9 @@ type PutCustomerBillingAccountIdJSONBody struct {
 
 // PutCustomerMetadataJSONBody defines parameters for PutCustomerMetadata.
 type PutCustomerMetadataJSONBody struct {
+	// PiiRedaction Redaction of personal data in the AI conversations. The detected values are redacted before they are stored in the AI messages and before they reach the LLM.
+	PiiRedaction *AIManagerRedaction `json:"pii_redaction,omitempty"`
+
 	// RtpDebug When set to `true`, RTPEngine captures RTP traffic as PCAP files for this customer's calls.
 	// Default is `false`. Enabling this increases storage usage — disable after debugging.
 	RtpDebug *bool `json:"rtp_debug,omitempty"`
@@ -6505,6 +8100,34 @@ type PutCustomersIdBillingAccountIdJSONBody struct {
 	BillingAccountId string `json:"billing_account_id"`
 }
 
+// GetDispositionsParams defines parameters for GetDispositions.
+type GetDispositionsParams struct {
+	// PageSize Number of results to return per page.
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// PageToken Cursor token for pagination. Use the `next_page_token` value from the previous response.
+	PageToken *PageToken `form:"page_token,omitempty" json:"page_token,omitempty"`
+}
+
+// PostDispositionsJSONBody defines parameters for PostDispositions.
+type PostDispositionsJSONBody struct {
+	Code   string  `json:"code"`
+	Detail *string `json:"detail,omitempty"`
+	Name   *string `json:"name,omitempty"`
+
+	// RetryMode Decides the outdial target's retry when the campaign call with the disposition is done.
+	RetryMode *CampaignManagerDispositionRetryMode `json:"retry_mode,omitempty"`
+}
+
+// PutDispositionsIdJSONBody defines parameters for PutDispositionsId.
+type PutDispositionsIdJSONBody struct {
+	Detail string `json:"detail"`
+	Name   string `json:"name"`
+
+	// RetryMode Decides the outdial target's retry when the campaign call with the disposition is done.
+	RetryMode CampaignManagerDispositionRetryMode `json:"retry_mode"`
+}
+
 // GetEmailsParams defines parameters for GetEmails.
 type GetEmailsParams struct {
 	// PageSize Number of results to return per page.
@@ -6620,6 +8243,67 @@ type PostGroupcallsJSONBody struct {
 	Source CommonAddress `json:"source"`
 }
 
+// GetMcpServersParams defines parameters for GetMcpServers.
+type GetMcpServersParams struct {
+	// PageSize Number of results to return per page.
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// PageToken Cursor token for pagination. Use the `next_page_token` value from the previous response.
+	PageToken *PageToken `form:"page_token,omitempty" json:"page_token,omitempty"`
+}
+
+// PostMcpServersJSONBody defines parameters for PostMcpServers.
+type PostMcpServersJSONBody struct {
+	// AllowedTools Names of the server's tools the LLM may call. When empty, every tool is allowed.
+	AllowedTools *[]string `json:"allowed_tools,omitempty"`
+
+	// Detail Free-form note about the MCP server. Not shown to the LLM.
+	Detail *string `json:"detail,omitempty"`
+
+	// Headers Extra HTTP headers sent with each request, typically for authentication (max 20).
+	Headers *map[string]string `json:"headers,omitempty"`
+
+	// Name Name of the MCP server. Must start with a letter and contain only letters and digits (max 32 characters). The server's tools are exposed to the LLM as `<name>__<tool>`.
+	Name string `json:"name"`
+
+	// Timeout Timeout of a whole tool discovery or tool call in milliseconds. 0 uses the default of 10000, maximum 60000.
+	Timeout *int `json:"timeout,omitempty"`
+
+	// Transport Transport used to reach the MCP server.
+	// - `streamable_http`: Streamable HTTP transport (a single endpoint accepting `POST` requests).
+	// - `sse`: Legacy HTTP+SSE transport (an event stream endpoint plus a message endpoint).
+	Transport AIManagerMCPServerTransport `json:"transport"`
+
+	// Url HTTPS endpoint of the MCP server. Private and reserved addresses are rejected.
+	Url string `json:"url"`
+}
+
+// PutMcpServersIdJSONBody defines parameters for PutMcpServersId.
+type PutMcpServersIdJSONBody struct {
+	// AllowedTools Names of the server's tools the LLM may call. When empty, every tool is allowed.
+	AllowedTools *[]string `json:"allowed_tools,omitempty"`
+
+	// Detail Free-form note about the MCP server. Not shown to the LLM.
+	Detail *string `json:"detail,omitempty"`
+
+	// Headers Extra HTTP headers sent with each request, typically for authentication (max 20).
+	Headers *map[string]string `json:"headers,omitempty"`
+
+	// Name Name of the MCP server. Must start with a letter and contain only letters and digits (max 32 characters). The server's tools are exposed to the LLM as `<name>__<tool>`.
+	Name string `json:"name"`
+
+	// Timeout Timeout of a whole tool discovery or tool call in milliseconds. 0 uses the default of 10000, maximum 60000.
+	Timeout *int `json:"timeout,omitempty"`
+
+	// Transport Transport used to reach the MCP server.
+	// - `streamable_http`: Streamable HTTP transport (a single endpoint accepting `POST` requests).
+	// - `sse`: Legacy HTTP+SSE transport (an event stream endpoint plus a message endpoint).
+	Transport AIManagerMCPServerTransport `json:"transport"`
+
+	// Url HTTPS endpoint of the MCP server. Private and reserved addresses are rejected.
+	Url string `json:"url"`
+}
+
 // GetMessagesParams defines parameters for GetMessages.
 type GetMessagesParams struct {
 	// PageSize Number of results to return per page.
@@ -6746,6 +8430,27 @@ type PutOutdialsIdDataJSONBody struct {
 	Data string `json:"data"`
 }
 
+// GetOutdialsIdTargetjobsParams defines parameters for GetOutdialsIdTargetjobs.
+type GetOutdialsIdTargetjobsParams struct {
+	// PageSize Number of results to return per page.
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// PageToken Cursor token for pagination. Use the `next_page_token` value from the previous response.
+	PageToken *PageToken `form:"page_token,omitempty" json:"page_token,omitempty"`
+}
+
+// PostOutdialsIdTargetjobsJSONBody defines parameters for PostOutdialsIdTargetjobs.
+type PostOutdialsIdTargetjobsJSONBody struct {
+	// FileId The ID of the csv file to import. Returned from the `POST /files` response. Required for the `import`.
+	FileId *string `json:"file_id,omitempty"`
+
+	// Mapping The csv header names of the outdial target's fields. An omitted field uses the field's own name as the header name.
+	Mapping *OutdialManagerOutdialtargetjobMapping `json:"mapping,omitempty"`
+
+	// Type The type of the outdial target job.
+	Type OutdialManagerOutdialtargetjobType `json:"type"`
+}
+
 // GetOutdialsIdTargetsParams defines parameters for GetOutdialsIdTargets.
 type GetOutdialsIdTargetsParams struct {
 	// PageSize Number of results to return per page.
@@ -6775,6 +8480,18 @@ type PostOutdialsIdTargetsJSONBody struct {
 	Destination4 CommonAddress `json:"destination_4"`
 	Detail       string        `json:"detail"`
 	Name         string        `json:"name"`
+
+	// Timezone IANA time zone of the callee. Empty means derived from the destination number.
+	Timezone *string `json:"timezone,omitempty"`
+}
+
+// PutOutdialsIdTargetsTargetIdCallbackJSONBody defines parameters for PutOutdialsIdTargetsTargetIdCallback.
+type PutOutdialsIdTargetsTargetIdCallbackJSONBody struct {
+	// AgentId The ID of the agent who handles the callback. Returned from the `GET /agents` response. Empty means any agent.
+	AgentId *string `json:"agent_id,omitempty"`
+
+	// TmCallback The callback timestamp in ISO 8601 format. e.g. `2026-01-15T15:00:00Z`. Empty cancels the scheduled callback.
+	TmCallback string `json:"tm_callback"`
 }
 
 // GetOutplansParams defines parameters for GetOutplans.
@@ -7707,6 +9424,42 @@ type PostStorageFilesMultipartBody struct {
 // PostStorageFilesMultipartBodyType defines parameters for PostStorageFiles.
 type PostStorageFilesMultipartBodyType string
 
+// GetSuppressionBlocksParams defines parameters for GetSuppressionBlocks.
+type GetSuppressionBlocksParams struct {
+	// PageSize Number of results to return per page.
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// PageToken Cursor token for pagination. Use the `next_page_token` value from the previous response.
+	PageToken *PageToken `form:"page_token,omitempty" json:"page_token,omitempty"`
+}
+
+// GetSuppressionsParams defines parameters for GetSuppressions.
+type GetSuppressionsParams struct {
+	// PageSize Number of results to return per page.
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// PageToken Cursor token for pagination. Use the `next_page_token` value from the previous response.
+	PageToken *PageToken `form:"page_token,omitempty" json:"page_token,omitempty"`
+}
+
+// PostSuppressionsJSONBody defines parameters for PostSuppressions.
+type PostSuppressionsJSONBody struct {
+	Detail *string `json:"detail,omitempty"`
+
+	// ListType The type of the suppression list.
+	ListType OutdialManagerSuppressionListType `json:"list_type"`
+	Target   string                            `json:"target"`
+}
+
+// PostSuppressionsImportJSONBody defines parameters for PostSuppressionsImport.
+type PostSuppressionsImportJSONBody struct {
+	Detail *string `json:"detail,omitempty"`
+
+	// ListType The type of the suppression list.
+	ListType OutdialManagerSuppressionListType `json:"list_type"`
+	Targets  []string                          `json:"targets"`
+}
+
 // GetTagsParams defines parameters for GetTags.
 type GetTagsParams struct {
 	// PageSize Number of results to return per page.
@@ -7773,6 +9526,57 @@ type PutTeamsIdJSONBody struct {
 	StartMemberId string `json:"start_member_id"`
 }
 
+// GetTestRunsParams defines parameters for GetTestRuns.
+type GetTestRunsParams struct {
+	// PageSize Number of results to return per page.
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// PageToken Cursor token for pagination. Use the `next_page_token` value from the previous response.
+	PageToken *PageToken `form:"page_token,omitempty" json:"page_token,omitempty"`
+
+	// TestSuiteId Returns only the runs of the given test suite.
+	TestSuiteId *string `form:"test_suite_id,omitempty" json:"test_suite_id,omitempty"`
+}
+
+// GetTestSuitesParams defines parameters for GetTestSuites.
+type GetTestSuitesParams struct {
+	// PageSize Number of results to return per page.
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// PageToken Cursor token for pagination. Use the `next_page_token` value from the previous response.
+	PageToken *PageToken `form:"page_token,omitempty" json:"page_token,omitempty"`
+
+	// AiId Returns only the test suites of the given AI.
+	AiId *string `form:"ai_id,omitempty" json:"ai_id,omitempty"`
+}
+
+// PostTestSuitesJSONBody defines parameters for PostTestSuites.
+type PostTestSuitesJSONBody struct {
+	// AiId The unique identifier of the AI under test. Returned from the `GET /ais` response.
+	AiId string `json:"ai_id"`
+
+	// Detail Free-form note about the test suite.
+	Detail *string `json:"detail,omitempty"`
+
+	// Name Name of the test suite.
+	Name string `json:"name"`
+
+	// Scenarios Scenarios of the test suite (1 to 20).
+	Scenarios []AIManagerTestSuiteScenario `json:"scenarios"`
+}
+
+// PutTestSuitesIdJSONBody defines parameters for PutTestSuitesId.
+type PutTestSuitesIdJSONBody struct {
+	// Detail Free-form note about the test suite.
+	Detail *string `json:"detail,omitempty"`
+
+	// Name Name of the test suite.
+	Name string `json:"name"`
+
+	// Scenarios Scenarios of the test suite (1 to 20).
+	Scenarios []AIManagerTestSuiteScenario `json:"scenarios"`
+}
+
 // GetTimelineAnalysesParams defines parameters for GetTimelineAnalyses.
 type GetTimelineAnalysesParams struct {
 	// PageSize Number of results to return per page.
@@ -8015,6 +9819,9 @@ type PutAgentsIdStatusJSONRequestBody PutAgentsIdStatusJSONBody
 // PutAgentsIdTagIdsJSONRequestBody defines body for PutAgentsIdTagIds for application/json ContentType.
 type PutAgentsIdTagIdsJSONRequestBody PutAgentsIdTagIdsJSONBody
 
+// PostAiassistsJSONRequestBody defines body for PostAiassists for application/json ContentType.
+type PostAiassistsJSONRequestBody PostAiassistsJSONBody
+
 // PostAiauditsJSONRequestBody defines body for PostAiaudits for application/json ContentType.
 type PostAiauditsJSONRequestBody PostAiauditsJSONBody
 
@@ -8033,6 +9840,9 @@ type PostAisJSONRequestBody PostAisJSONBody
 // PutAisIdJSONRequestBody defines body for PutAisId for application/json ContentType.
 type PutAisIdJSONRequestBody PutAisIdJSONBody
 
+// PostAiextractionsJSONRequestBody defines body for PostAiextractions for application/json ContentType.
+type PostAiextractionsJSONRequestBody PostAiextractionsJSONBody
+
 // PostAisummariesJSONRequestBody defines body for PostAisummaries for application/json ContentType.
 type PostAisummariesJSONRequestBody PostAisummariesJSONBody
 
@@ -8060,6 +9870,9 @@ type PutBillingAccountJSONRequestBody PutBillingAccountJSONBody
 // PutBillingAccountPaymentInfoJSONRequestBody defines body for PutBillingAccountPaymentInfo for application/json ContentType.
 type PutBillingAccountPaymentInfoJSONRequestBody PutBillingAccountPaymentInfoJSONBody
 
+// PutBillingAccountSpendingSettingsJSONRequestBody defines body for PutBillingAccountSpendingSettings for application/json ContentType.
+type PutBillingAccountSpendingSettingsJSONRequestBody PutBillingAccountSpendingSettingsJSONBody
+
 // PutBillingAccountsIdJSONRequestBody defines body for PutBillingAccountsId for application/json ContentType.
 type PutBillingAccountsIdJSONRequestBody PutBillingAccountsIdJSONBody
 
@@ -8072,6 +9885,9 @@ type PostBillingAccountsIdBalanceSubtractForceJSONRequestBody PostBillingAccount
 // PutBillingAccountsIdPaymentInfoJSONRequestBody defines body for PutBillingAccountsIdPaymentInfo for application/json ContentType.
 type PutBillingAccountsIdPaymentInfoJSONRequestBody PutBillingAccountsIdPaymentInfoJSONBody
 
+// PostBillingEstimatesJSONRequestBody defines body for PostBillingEstimates for application/json ContentType.
+type PostBillingEstimatesJSONRequestBody PostBillingEstimatesJSONBody
+
 // PostCallsJSONRequestBody defines body for PostCalls for application/json ContentType.
 type PostCallsJSONRequestBody PostCallsJSONBody
 
@@ -8087,6 +9903,15 @@ type PostCallsIdRecordingStartJSONRequestBody PostCallsIdRecordingStartJSONBody
 // PostCallsIdTalkJSONRequestBody defines body for PostCallsIdTalk for application/json ContentType.
 type PostCallsIdTalkJSONRequestBody PostCallsIdTalkJSONBody
 
+// PostCampaigncallsIdAcceptJSONRequestBody defines body for PostCampaigncallsIdAccept for application/json ContentType.
+type PostCampaigncallsIdAcceptJSONRequestBody PostCampaigncallsIdAcceptJSONBody
+
+// PutCampaigncallsIdCallbackJSONRequestBody defines body for PutCampaigncallsIdCallback for application/json ContentType.
+type PutCampaigncallsIdCallbackJSONRequestBody PutCampaigncallsIdCallbackJSONBody
+
+// PutCampaigncallsIdDispositionJSONRequestBody defines body for PutCampaigncallsIdDisposition for application/json ContentType.
+type PutCampaigncallsIdDispositionJSONRequestBody PutCampaigncallsIdDispositionJSONBody
+
 // PostCampaignsJSONRequestBody defines body for PostCampaigns for application/json ContentType.
 type PostCampaignsJSONRequestBody PostCampaignsJSONBody
 
@@ -8096,6 +9921,12 @@ type PutCampaignsIdJSONRequestBody PutCampaignsIdJSONBody
 // PutCampaignsIdActionsJSONRequestBody defines body for PutCampaignsIdActions for application/json ContentType.
 type PutCampaignsIdActionsJSONRequestBody PutCampaignsIdActionsJSONBody
 
+// PutCampaignsIdCallingWindowsJSONRequestBody defines body for PutCampaignsIdCallingWindows for application/json ContentType.
+type PutCampaignsIdCallingWindowsJSONRequestBody PutCampaignsIdCallingWindowsJSONBody
+
+// PutCampaignsIdDialModeJSONRequestBody defines body for PutCampaignsIdDialMode for application/json ContentType.
+type PutCampaignsIdDialModeJSONRequestBody PutCampaignsIdDialModeJSONBody
+
 // PutCampaignsIdNextCampaignIdJSONRequestBody defines body for PutCampaignsIdNextCampaignId for application/json ContentType.
 type PutCampaignsIdNextCampaignIdJSONRequestBody PutCampaignsIdNextCampaignIdJSONBody
 
@@ -8168,6 +9999,12 @@ type PutConversationsIdJSONRequestBody PutConversationsIdJSONBody
 // PostConversationsIdMessagesJSONRequestBody defines body for PostConversationsIdMessages for application/json ContentType.
 type PostConversationsIdMessagesJSONRequestBody PostConversationsIdMessagesJSONBody
 
+// PostCustomToolsJSONRequestBody defines body for PostCustomTools for application/json ContentType.
+type PostCustomToolsJSONRequestBody PostCustomToolsJSONBody
+
+// PutCustomToolsIdJSONRequestBody defines body for PutCustomToolsId for application/json ContentType.
+type PutCustomToolsIdJSONRequestBody PutCustomToolsIdJSONBody
+
 // PutCustomerJSONRequestBody defines body for PutCustomer for application/json ContentType.
 type PutCustomerJSONRequestBody PutCustomerJSONBody
 
@@ -8189,6 +10026,12 @@ type PutCustomersIdBillingAccountIdJSONRequestBody PutCustomersIdBillingAccountI
 // PutCustomersIdMetadataJSONRequestBody defines body for PutCustomersIdMetadata for application/json ContentType.
 type PutCustomersIdMetadataJSONRequestBody = CustomerManagerMetadata
 
+// PostDispositionsJSONRequestBody defines body for PostDispositions for application/json ContentType.
+type PostDispositionsJSONRequestBody PostDispositionsJSONBody
+
+// PutDispositionsIdJSONRequestBody defines body for PutDispositionsId for application/json ContentType.
+type PutDispositionsIdJSONRequestBody PutDispositionsIdJSONBody
+
 // PostEmailsJSONRequestBody defines body for PostEmails for application/json ContentType.
 type PostEmailsJSONRequestBody PostEmailsJSONBody
 
@@ -8207,6 +10050,12 @@ type PutFlowsIdJSONRequestBody PutFlowsIdJSONBody
 // PostGroupcallsJSONRequestBody defines body for PostGroupcalls for application/json ContentType.
 type PostGroupcallsJSONRequestBody PostGroupcallsJSONBody
 
+// PostMcpServersJSONRequestBody defines body for PostMcpServers for application/json ContentType.
+type PostMcpServersJSONRequestBody PostMcpServersJSONBody
+
+// PutMcpServersIdJSONRequestBody defines body for PutMcpServersId for application/json ContentType.
+type PutMcpServersIdJSONRequestBody PutMcpServersIdJSONBody
+
 // PostMessagesJSONRequestBody defines body for PostMessages for application/json ContentType.
 type PostMessagesJSONRequestBody PostMessagesJSONBody
 
@@ -8246,9 +10095,15 @@ type PutOutdialsIdCampaignIdJSONRequestBody PutOutdialsIdCampaignIdJSONBody
 // PutOutdialsIdDataJSONRequestBody defines body for PutOutdialsIdData for application/json ContentType.
 type PutOutdialsIdDataJSONRequestBody PutOutdialsIdDataJSONBody
 
+// PostOutdialsIdTargetjobsJSONRequestBody defines body for PostOutdialsIdTargetjobs for application/json ContentType.
+type PostOutdialsIdTargetjobsJSONRequestBody PostOutdialsIdTargetjobsJSONBody
+
 // PostOutdialsIdTargetsJSONRequestBody defines body for PostOutdialsIdTargets for application/json ContentType.
 type PostOutdialsIdTargetsJSONRequestBody PostOutdialsIdTargetsJSONBody
 
+// PutOutdialsIdTargetsTargetIdCallbackJSONRequestBody defines body for PutOutdialsIdTargetsTargetIdCallback for application/json ContentType.
+type PutOutdialsIdTargetsTargetIdCallbackJSONRequestBody PutOutdialsIdTargetsTargetIdCallbackJSONBody
+
 // PostOutplansJSONRequestBody defines body for PostOutplans for application/json ContentType.
 type PostOutplansJSONRequestBody PostOutplansJSONBody
 
@@ -8387,6 +10242,12 @@ type PostStorageAccountsJSONRequestBody PostStorageAccountsJSONBody
 // PostStorageFilesMultipartRequestBody defines body for PostStorageFiles for multipart/form-data ContentType.
 type PostStorageFilesMultipartRequestBody PostStorageFilesMultipartBody
 
+// PostSuppressionsJSONRequestBody defines body for PostSuppressions for application/json ContentType.
+type PostSuppressionsJSONRequestBody PostSuppressionsJSONBody
+
+// PostSuppressionsImportJSONRequestBody defines body for PostSuppressionsImport for application/json ContentType.
+type PostSuppressionsImportJSONRequestBody PostSuppressionsImportJSONBody
+
 // PostTagsJSONRequestBody defines body for PostTags for application/json ContentType.
 type PostTagsJSONRequestBody PostTagsJSONBody
 
@@ -8399,6 +10260,12 @@ type PostTeamsJSONRequestBody PostTeamsJSONBody
 // PutTeamsIdJSONRequestBody defines body for PutTeamsId for application/json ContentType.
 type PutTeamsIdJSONRequestBody PutTeamsIdJSONBody
 
+// PostTestSuitesJSONRequestBody defines body for PostTestSuites for application/json ContentType.
+type PostTestSuitesJSONRequestBody PostTestSuitesJSONBody
+
+// PutTestSuitesIdJSONRequestBody defines body for PutTestSuitesId for application/json ContentType.
+type PutTestSuitesIdJSONRequestBody PutTestSuitesIdJSONBody
+
 // PostTimelineAnalysesJSONRequestBody defines body for PostTimelineAnalyses for application/json ContentType.
 type PostTimelineAnalysesJSONRequestBody PostTimelineAnalysesJSONBody
 
@@ -8530,6 +10397,21 @@ type ServerInterface interface {
 	// Get aggregated timeline events
 	// (GET /aggregated-events)
 	GetAggregatedEvents(c *gin.Context, params GetAggregatedEventsParams)
+	// Gets a list of ai assists.
+	// (GET /aiassists)
+	GetAiassists(c *gin.Context, params GetAiassistsParams)
+	// Start a new ai assist.
+	// (POST /aiassists)
+	PostAiassists(c *gin.Context)
+	// Delete an ai assist.
+	// (DELETE /aiassists/{id})
+	DeleteAiassistsId(c *gin.Context, id string)
+	// Get ai assist details.
+	// (GET /aiassists/{id})
+	GetAiassistsId(c *gin.Context, id string)
+	// Stop an ai assist.
+	// (POST /aiassists/{id}/stop)
+	PostAiassistsIdStop(c *gin.Context, id string)
 	// Gets a list of AI audits.
 	// (GET /aiaudits)
 	GetAiaudits(c *gin.Context, params GetAiauditsParams)
@@ -8620,6 +10502,24 @@ type ServerInterface interface {
 	// Get a single AI prompt history entry.
 	// (GET /ais/{id}/prompt_histories/{history_id})
 	GetAisIdPromptHistoriesHistoryId(c *gin.Context, id string, historyId string)
+	// Gets a list of ai extractions.
+	// (GET /aiextractions)
+	GetAiextractions(c *gin.Context, params GetAiextractionsParams)
+	// Create a new ai extraction.
+	// (POST /aiextractions)
+	PostAiextractions(c *gin.Context)
+	// Delete an ai extraction.
+	// (DELETE /aiextractions/{id})
+	DeleteAiextractionsId(c *gin.Context, id string)
+	// Get ai extraction details.
+	// (GET /aiextractions/{id})
+	GetAiextractionsId(c *gin.Context, id string)
+	// Gets a list of AI guardrail violations.
+	// (GET /aiguardrailviolations)
+	GetAiguardrailviolations(c *gin.Context, params GetAiguardrailviolationsParams)
+	// Get AI guardrail violation details.
+	// (GET /aiguardrailviolations/{id})
+	GetAiguardrailviolationsId(c *gin.Context, id string)
 	// Gets a list of ai summaries.
 	// (GET /aisummaries)
 	GetAisummaries(c *gin.Context, params GetAisummariesParams)
@@ -8671,6 +10571,9 @@ type ServerInterface interface {
 	// Update billing account payment info
 	// (PUT /billing_account/payment_info)
 	PutBillingAccountPaymentInfo(c *gin.Context)
+	// Update billing account spending settings
+	// (PUT /billing_account/spending_settings)
+	PutBillingAccountSpendingSettings(c *gin.Context)
 	// Get list of billing accounts
 	// (GET /billing_accounts)
 	GetBillingAccounts(c *gin.Context, params GetBillingAccountsParams)
@@ -8689,6 +10592,15 @@ type ServerInterface interface {
 	// Update billing account's payment info
 	// (PUT /billing_accounts/{id}/payment_info)
 	PutBillingAccountsIdPaymentInfo(c *gin.Context, id string)
+	// Quote a usage cost
+	// (POST /billing_estimates)
+	PostBillingEstimates(c *gin.Context)
+	// Get list of billing statements
+	// (GET /billing_statements)
+	GetBillingStatements(c *gin.Context, params GetBillingStatementsParams)
+	// Get a billing statement by ID
+	// (GET /billing_statements/{id})
+	GetBillingStatementsId(c *gin.Context, id openapi_types.UUID)
 	// Get list of billings
 	// (GET /billings)
 	GetBillings(c *gin.Context, params GetBillingsParams)
@@ -8755,6 +10667,18 @@ type ServerInterface interface {
 	// Get campaign call details
 	// (GET /campaigncalls/{id})
 	GetCampaigncallsId(c *gin.Context, id string)
+	// Accept a previewing campaign call
+	// (POST /campaigncalls/{id}/accept)
+	PostCampaigncallsIdAccept(c *gin.Context, id string)
+	// Schedule a callback of the campaign call's target
+	// (PUT /campaigncalls/{id}/callback)
+	PutCampaigncallsIdCallback(c *gin.Context, id string)
+	// Set the campaign call's disposition
+	// (PUT /campaigncalls/{id}/disposition)
+	PutCampaigncallsIdDisposition(c *gin.Context, id string)
+	// Skip a previewing campaign call
+	// (POST /campaigncalls/{id}/skip)
+	PostCampaigncallsIdSkip(c *gin.Context, id string)
 	// Get a list of campaigns
 	// (GET /campaigns)
 	GetCampaigns(c *gin.Context, params GetCampaignsParams)
@@ -8773,15 +10697,33 @@ type ServerInterface interface {
 	// Update campaign's actions
 	// (PUT /campaigns/{id}/actions)
 	PutCampaignsIdActions(c *gin.Context, id string)
+	// Update campaign's calling windows
+	// (PUT /campaigns/{id}/calling_windows)
+	PutCampaignsIdCallingWindows(c *gin.Context, id string)
 	// Update campaign's actions
 	// (GET /campaigns/{id}/campaigncalls)
 	GetCampaignsIdCampaigncalls(c *gin.Context, id string, params GetCampaignsIdCampaigncallsParams)
+	// Update campaign's dial mode
+	// (PUT /campaigns/{id}/dial_mode)
+	PutCampaignsIdDialMode(c *gin.Context, id string)
 	// Update campaign's service level
 	// (PUT /campaigns/{id}/next_campaign_id)
 	PutCampaignsIdNextCampaignId(c *gin.Context, id string)
 	// Update campaign's resource info
 	// (PUT /campaigns/{id}/resource_info)
 	PutCampaignsIdResourceInfo(c *gin.Context, id string)
+	// Retrieve a list of campaign result jobs.
+	// (GET /campaigns/{id}/resultjobs)
+	GetCampaignsIdResultjobs(c *gin.Context, id string, params GetCampaignsIdResultjobsParams)
+	// Create a new campaign result job.
+	// (POST /campaigns/{id}/resultjobs)
+	PostCampaignsIdResultjobs(c *gin.Context, id string)
+	// Retrieve a campaign result job by its ID.
+	// (GET /campaigns/{id}/resultjobs/{resultjob_id})
+	GetCampaignsIdResultjobsResultjobId(c *gin.Context, id string, resultjobId string)
+	// Get the campaign's results
+	// (GET /campaigns/{id}/results)
+	GetCampaignsIdResults(c *gin.Context, id string, params GetCampaignsIdResultsParams)
 	// Update campaign's service level
 	// (PUT /campaigns/{id}/service_level)
 	PutCampaignsIdServiceLevel(c *gin.Context, id string)
@@ -8950,6 +10892,21 @@ type ServerInterface interface {
 	// Unassign the conversation
 	// (POST /conversations/{id}/unassign)
 	PostConversationsIdUnassign(c *gin.Context, id openapi_types.UUID)
+	// Gets a list of custom tools.
+	// (GET /custom_tools)
+	GetCustomTools(c *gin.Context, params GetCustomToolsParams)
+	// Create a new custom tool.
+	// (POST /custom_tools)
+	PostCustomTools(c *gin.Context)
+	// Delete a custom tool.
+	// (DELETE /custom_tools/{id})
+	DeleteCustomToolsId(c *gin.Context, id string)
+	// Get custom tool details.
+	// (GET /custom_tools/{id})
+	GetCustomToolsId(c *gin.Context, id string)
+	// Update a custom tool.
+	// (PUT /custom_tools/{id})
+	PutCustomToolsId(c *gin.Context, id string)
 	// Get customer info
 	// (GET /customer)
 	GetCustomer(c *gin.Context)
@@ -8989,6 +10946,21 @@ type ServerInterface interface {
 	// Cancel customer deletion (recover account).
 	// (POST /customers/{id}/recover)
 	PostCustomersIdRecover(c *gin.Context, id string)
+	// Retrieve a list of dispositions.
+	// (GET /dispositions)
+	GetDispositions(c *gin.Context, params GetDispositionsParams)
+	// Create a new disposition.
+	// (POST /dispositions)
+	PostDispositions(c *gin.Context)
+	// Delete an existing disposition.
+	// (DELETE /dispositions/{id})
+	DeleteDispositionsId(c *gin.Context, id string)
+	// Retrieve a disposition by its ID.
+	// (GET /dispositions/{id})
+	GetDispositionsId(c *gin.Context, id string)
+	// Update a disposition.
+	// (PUT /dispositions/{id})
+	PutDispositionsId(c *gin.Context, id string)
 	// Retrieve a list of emails
 	// (GET /emails)
 	GetEmails(c *gin.Context, params GetEmailsParams)
@@ -9052,6 +11024,24 @@ type ServerInterface interface {
 	// Hangup the groupcall
 	// (POST /groupcalls/{id}/hangup)
 	PostGroupcallsIdHangup(c *gin.Context, id string)
+	// Gets a list of MCP servers.
+	// (GET /mcp_servers)
+	GetMcpServers(c *gin.Context, params GetMcpServersParams)
+	// Register a new MCP server.
+	// (POST /mcp_servers)
+	PostMcpServers(c *gin.Context)
+	// Delete an MCP server.
+	// (DELETE /mcp_servers/{id})
+	DeleteMcpServersId(c *gin.Context, id string)
+	// Get MCP server details.
+	// (GET /mcp_servers/{id})
+	GetMcpServersId(c *gin.Context, id string)
+	// Update an MCP server.
+	// (PUT /mcp_servers/{id})
+	PutMcpServersId(c *gin.Context, id string)
+	// Get the tools of an MCP server.
+	// (GET /mcp_servers/{id}/tools)
+	GetMcpServersIdTools(c *gin.Context, id string)
 	// Get the logged-in agent
 	// (GET /me)
 	GetMe(c *gin.Context)
@@ -9133,6 +11123,15 @@ type ServerInterface interface {
 	// Update an outdial's data.
 	// (PUT /outdials/{id}/data)
 	PutOutdialsIdData(c *gin.Context, id string)
+	// Retrieve a list of outdial target jobs.
+	// (GET /outdials/{id}/targetjobs)
+	GetOutdialsIdTargetjobs(c *gin.Context, id string, params GetOutdialsIdTargetjobsParams)
+	// Create a new outdial target job.
+	// (POST /outdials/{id}/targetjobs)
+	PostOutdialsIdTargetjobs(c *gin.Context, id string)
+	// Retrieve an outdial target job by its ID.
+	// (GET /outdials/{id}/targetjobs/{targetjob_id})
+	GetOutdialsIdTargetjobsTargetjobId(c *gin.Context, id string, targetjobId string)
 	// Retrieve a list of outdial targets.
 	// (GET /outdials/{id}/targets)
 	GetOutdialsIdTargets(c *gin.Context, id string, params GetOutdialsIdTargetsParams)
@@ -9145,6 +11144,9 @@ type ServerInterface interface {
 	// Retrieve an outdial target by its ID.
 	// (GET /outdials/{id}/targets/{target_id})
 	GetOutdialsIdTargetsTargetId(c *gin.Context, id string, targetId string)
+	// Schedule a callback of an outdial target.
+	// (PUT /outdials/{id}/targets/{target_id}/callback)
+	PutOutdialsIdTargetsTargetIdCallback(c *gin.Context, id string, targetId string)
 	// Retrieve a list of outplans.
 	// (GET /outplans)
 	GetOutplans(c *gin.Context, params GetOutplansParams)
@@ -9556,6 +11558,27 @@ type ServerInterface interface {
 	// Download the storage file
 	// (GET /storage_files/{id}/file)
 	GetStorageFilesIdFile(c *gin.Context, id openapi_types.UUID)
+	// Retrieve a list of suppression blocks.
+	// (GET /suppression_blocks)
+	GetSuppressionBlocks(c *gin.Context, params GetSuppressionBlocksParams)
+	// Retrieve a suppression block by its ID.
+	// (GET /suppression_blocks/{id})
+	GetSuppressionBlocksId(c *gin.Context, id string)
+	// Retrieve a list of suppressions.
+	// (GET /suppressions)
+	GetSuppressions(c *gin.Context, params GetSuppressionsParams)
+	// Create a new suppression.
+	// (POST /suppressions)
+	PostSuppressions(c *gin.Context)
+	// Import suppressions in bulk.
+	// (POST /suppressions/import)
+	PostSuppressionsImport(c *gin.Context)
+	// Delete an existing suppression.
+	// (DELETE /suppressions/{id})
+	DeleteSuppressionsId(c *gin.Context, id string)
+	// Retrieve a suppression by its ID.
+	// (GET /suppressions/{id})
+	GetSuppressionsId(c *gin.Context, id string)
 	// List tags
 	// (GET /tags)
 	GetTags(c *gin.Context, params GetTagsParams)
@@ -9589,6 +11612,33 @@ type ServerInterface interface {
 	// Regenerate direct hash for team
 	// (POST /teams/{id}/direct-hash-regenerate)
 	PostTeamsIdDirectHashRegenerate(c *gin.Context, id openapi_types.UUID)
+	// Gets a list of test runs.
+	// (GET /test_runs)
+	GetTestRuns(c *gin.Context, params GetTestRunsParams)
+	// Delete a test run.
+	// (DELETE /test_runs/{id})
+	DeleteTestRunsId(c *gin.Context, id string)
+	// Get test run details.
+	// (GET /test_runs/{id})
+	GetTestRunsId(c *gin.Context, id string)
+	// Gets a list of test suites.
+	// (GET /test_suites)
+	GetTestSuites(c *gin.Context, params GetTestSuitesParams)
+	// Create a new test suite.
+	// (POST /test_suites)
+	PostTestSuites(c *gin.Context)
+	// Delete a test suite.
+	// (DELETE /test_suites/{id})
+	DeleteTestSuitesId(c *gin.Context, id string)
+	// Get test suite details.
+	// (GET /test_suites/{id})
+	GetTestSuitesId(c *gin.Context, id string)
+	// Update a test suite.
+	// (PUT /test_suites/{id})
+	PutTestSuitesId(c *gin.Context, id string)
+	// Run a test suite.
+	// (POST /test_suites/{id}/run)
+	PostTestSuitesIdRun(c *gin.Context, id string)
 	// Get a list of timeline analyses.
 	// (GET /timeline-analyses)
 	GetTimelineAnalyses(c *gin.Context, params GetTimelineAnalysesParams)
@@ -10272,6 +12322,125 @@ func (siw *ServerInterfaceWrapper) GetAggregatedEvents(c *gin.Context) {
 	siw.Handler.GetAggregatedEvents(c, params)
 }
 
+// GetAiassists operation middleware
+func (siw *ServerInterfaceWrapper) GetAiassists(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAiassistsParams
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_size", c.Request.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page_size: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "page_token" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_token", c.Request.URL.Query(), &params.PageToken)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page_token: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAiassists(c, params)
+}
+
+// PostAiassists operation middleware
+func (siw *ServerInterfaceWrapper) PostAiassists(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostAiassists(c)
+}
+
+// DeleteAiassistsId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAiassistsId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteAiassistsId(c, id)
+}
+
+// GetAiassistsId operation middleware
+func (siw *ServerInterfaceWrapper) GetAiassistsId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAiassistsId(c, id)
+}
+
+// PostAiassistsIdStop operation middleware
+func (siw *ServerInterfaceWrapper) PostAiassistsIdStop(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostAiassistsIdStop(c, id)
+}
+
 // GetAiaudits operation middleware
 func (siw *ServerInterfaceWrapper) GetAiaudits(c *gin.Context) {
 
@@ -11100,6 +13269,175 @@ func (siw *ServerInterfaceWrapper) GetAisIdPromptHistoriesHistoryId(c *gin.Conte
 	siw.Handler.GetAisIdPromptHistoriesHistoryId(c, id, historyId)
 }
 
+// GetAiextractions operation middleware
+func (siw *ServerInterfaceWrapper) GetAiextractions(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAiextractionsParams
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_size", c.Request.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page_size: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "page_token" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_token", c.Request.URL.Query(), &params.PageToken)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page_token: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAiextractions(c, params)
+}
+
+// PostAiextractions operation middleware
+func (siw *ServerInterfaceWrapper) PostAiextractions(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostAiextractions(c)
+}
+
+// DeleteAiextractionsId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAiextractionsId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteAiextractionsId(c, id)
+}
+
+// GetAiextractionsId operation middleware
+func (siw *ServerInterfaceWrapper) GetAiextractionsId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAiextractionsId(c, id)
+}
+
+// GetAiguardrailviolations operation middleware
+func (siw *ServerInterfaceWrapper) GetAiguardrailviolations(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAiguardrailviolationsParams
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_size", c.Request.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page_size: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "page_token" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_token", c.Request.URL.Query(), &params.PageToken)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page_token: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "aicall_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "aicall_id", c.Request.URL.Query(), &params.AicallId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter aicall_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "ai_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "ai_id", c.Request.URL.Query(), &params.AiId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter ai_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAiguardrailviolations(c, params)
+}
+
+// GetAiguardrailviolationsId operation middleware
+func (siw *ServerInterfaceWrapper) GetAiguardrailviolationsId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAiguardrailviolationsId(c, id)
+}
+
 // GetAisummaries operation middleware
 func (siw *ServerInterfaceWrapper) GetAisummaries(c *gin.Context) {
 
@@ -11439,6 +13777,19 @@ func (siw *ServerInterfaceWrapper) PutBillingAccountPaymentInfo(c *gin.Context)
 	siw.Handler.PutBillingAccountPaymentInfo(c)
 }
 
+// PutBillingAccountSpendingSettings operation middleware
+func (siw *ServerInterfaceWrapper) PutBillingAccountSpendingSettings(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutBillingAccountSpendingSettings(c)
+}
+
 // GetBillingAccounts operation middleware
 func (siw *ServerInterfaceWrapper) GetBillingAccounts(c *gin.Context) {
 
@@ -11593,6 +13944,77 @@ func (siw *ServerInterfaceWrapper) PutBillingAccountsIdPaymentInfo(c *gin.Contex
 	siw.Handler.PutBillingAccountsIdPaymentInfo(c, id)
 }
 
+// PostBillingEstimates operation middleware
+func (siw *ServerInterfaceWrapper) PostBillingEstimates(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostBillingEstimates(c)
+}
+
+// GetBillingStatements operation middleware
+func (siw *ServerInterfaceWrapper) GetBillingStatements(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBillingStatementsParams
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_size", c.Request.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page_size: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "page_token" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_token", c.Request.URL.Query(), &params.PageToken)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page_token: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetBillingStatements(c, params)
+}
+
+// GetBillingStatementsId operation middleware
+func (siw *ServerInterfaceWrapper) GetBillingStatementsId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetBillingStatementsId(c, id)
+}
+
 // GetBillings operation middleware
 func (siw *ServerInterfaceWrapper) GetBillings(c *gin.Context) {
 
@@ -12151,27 +14573,17 @@ func (siw *ServerInterfaceWrapper) GetCampaigncallsId(c *gin.Context) {
 	siw.Handler.GetCampaigncallsId(c, id)
 }
 
-// GetCampaigns operation middleware
-func (siw *ServerInterfaceWrapper) GetCampaigns(c *gin.Context) {
+// PostCampaigncallsIdAccept operation middleware
+func (siw *ServerInterfaceWrapper) PostCampaigncallsIdAccept(c *gin.Context) {
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetCampaignsParams
-
-	// ------------- Optional query parameter "page_size" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "page_size", c.Request.URL.Query(), &params.PageSize)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page_size: %w", err), http.StatusBadRequest)
-		return
-	}
-
-	// ------------- Optional query parameter "page_token" -------------
+	// ------------- Path parameter "id" -------------
+	var id string
 
-	err = runtime.BindQueryParameter("form", true, false, "page_token", c.Request.URL.Query(), &params.PageToken)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page_token: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -12182,24 +14594,11 @@ func (siw *ServerInterfaceWrapper) GetCampaigns(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetCampaigns(c, params)
+	siw.Handler.PostCampaigncallsIdAccept(c, id)
 }
 
-// PostCampaigns operation middleware
-func (siw *ServerInterfaceWrapper) PostCampaigns(c *gin.Context) {
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
-
-	siw.Handler.PostCampaigns(c)
-}
-
-// DeleteCampaignsId operation middleware
-func (siw *ServerInterfaceWrapper) DeleteCampaignsId(c *gin.Context) {
+// PutCampaigncallsIdCallback operation middleware
+func (siw *ServerInterfaceWrapper) PutCampaigncallsIdCallback(c *gin.Context) {
 
 	var err error
 
@@ -12219,11 +14618,11 @@ func (siw *ServerInterfaceWrapper) DeleteCampaignsId(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteCampaignsId(c, id)
+	siw.Handler.PutCampaigncallsIdCallback(c, id)
 }
 
-// GetCampaignsId operation middleware
-func (siw *ServerInterfaceWrapper) GetCampaignsId(c *gin.Context) {
+// PutCampaigncallsIdDisposition operation middleware
+func (siw *ServerInterfaceWrapper) PutCampaigncallsIdDisposition(c *gin.Context) {
 
 	var err error
 
@@ -12243,11 +14642,11 @@ func (siw *ServerInterfaceWrapper) GetCampaignsId(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetCampaignsId(c, id)
+	siw.Handler.PutCampaigncallsIdDisposition(c, id)
 }
 
-// PutCampaignsId operation middleware
-func (siw *ServerInterfaceWrapper) PutCampaignsId(c *gin.Context) {
+// PostCampaigncallsIdSkip operation middleware
+func (siw *ServerInterfaceWrapper) PostCampaigncallsIdSkip(c *gin.Context) {
 
 	var err error
 
@@ -12267,20 +14666,30 @@ func (siw *ServerInterfaceWrapper) PutCampaignsId(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PutCampaignsId(c, id)
+	siw.Handler.PostCampaigncallsIdSkip(c, id)
 }
 
-// PutCampaignsIdActions operation middleware
-func (siw *ServerInterfaceWrapper) PutCampaignsIdActions(c *gin.Context) {
+// GetCampaigns operation middleware
+func (siw *ServerInterfaceWrapper) GetCampaigns(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCampaignsParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_size", c.Request.URL.Query(), &params.PageSize)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page_size: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "page_token" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_token", c.Request.URL.Query(), &params.PageToken)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page_token: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -12291,11 +14700,24 @@ func (siw *ServerInterfaceWrapper) PutCampaignsIdActions(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PutCampaignsIdActions(c, id)
+	siw.Handler.GetCampaigns(c, params)
 }
 
-// GetCampaignsIdCampaigncalls operation middleware
-func (siw *ServerInterfaceWrapper) GetCampaignsIdCampaigncalls(c *gin.Context) {
+// PostCampaigns operation middleware
+func (siw *ServerInterfaceWrapper) PostCampaigns(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostCampaigns(c)
+}
+
+// DeleteCampaignsId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCampaignsId(c *gin.Context) {
 
 	var err error
 
@@ -12308,25 +14730,6 @@ func (siw *ServerInterfaceWrapper) GetCampaignsIdCampaigncalls(c *gin.Context) {
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetCampaignsIdCampaigncallsParams
-
-	// ------------- Optional query parameter "page_size" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "page_size", c.Request.URL.Query(), &params.PageSize)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page_size: %w", err), http.StatusBadRequest)
-		return
-	}
-
-	// ------------- Optional query parameter "page_token" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "page_token", c.Request.URL.Query(), &params.PageToken)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page_token: %w", err), http.StatusBadRequest)
-		return
-	}
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -12334,11 +14737,11 @@ func (siw *ServerInterfaceWrapper) GetCampaignsIdCampaigncalls(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetCampaignsIdCampaigncalls(c, id, params)
+	siw.Handler.DeleteCampaignsId(c, id)
 }
 
-// PutCampaignsIdNextCampaignId operation middleware
-func (siw *ServerInterfaceWrapper) PutCampaignsIdNextCampaignId(c *gin.Context) {
+// GetCampaignsId operation middleware
+func (siw *ServerInterfaceWrapper) GetCampaignsId(c *gin.Context) {
 
 	var err error
 
@@ -12358,11 +14761,11 @@ func (siw *ServerInterfaceWrapper) PutCampaignsIdNextCampaignId(c *gin.Context)
 		}
 	}
 
-	siw.Handler.PutCampaignsIdNextCampaignId(c, id)
+	siw.Handler.GetCampaignsId(c, id)
 }
 
-// PutCampaignsIdResourceInfo operation middleware
-func (siw *ServerInterfaceWrapper) PutCampaignsIdResourceInfo(c *gin.Context) {
+// PutCampaignsId operation middleware
+func (siw *ServerInterfaceWrapper) PutCampaignsId(c *gin.Context) {
 
 	var err error
 
@@ -12382,11 +14785,11 @@ func (siw *ServerInterfaceWrapper) PutCampaignsIdResourceInfo(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PutCampaignsIdResourceInfo(c, id)
+	siw.Handler.PutCampaignsId(c, id)
 }
 
-// PutCampaignsIdServiceLevel operation middleware
-func (siw *ServerInterfaceWrapper) PutCampaignsIdServiceLevel(c *gin.Context) {
+// PutCampaignsIdActions operation middleware
+func (siw *ServerInterfaceWrapper) PutCampaignsIdActions(c *gin.Context) {
 
 	var err error
 
@@ -12406,11 +14809,11 @@ func (siw *ServerInterfaceWrapper) PutCampaignsIdServiceLevel(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PutCampaignsIdServiceLevel(c, id)
+	siw.Handler.PutCampaignsIdActions(c, id)
 }
 
-// PutCampaignsIdStatus operation middleware
-func (siw *ServerInterfaceWrapper) PutCampaignsIdStatus(c *gin.Context) {
+// PutCampaignsIdCallingWindows operation middleware
+func (siw *ServerInterfaceWrapper) PutCampaignsIdCallingWindows(c *gin.Context) {
 
 	var err error
 
@@ -12430,16 +14833,25 @@ func (siw *ServerInterfaceWrapper) PutCampaignsIdStatus(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PutCampaignsIdStatus(c, id)
+	siw.Handler.PutCampaignsIdCallingWindows(c, id)
 }
 
-// GetConferencecalls operation middleware
-func (siw *ServerInterfaceWrapper) GetConferencecalls(c *gin.Context) {
+// GetCampaignsIdCampaigncalls operation middleware
+func (siw *ServerInterfaceWrapper) GetCampaignsIdCampaigncalls(c *gin.Context) {
 
 	var err error
 
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetConferencecallsParams
+	var params GetCampaignsIdCampaigncallsParams
 
 	// ------------- Optional query parameter "page_size" -------------
 
@@ -12464,11 +14876,11 @@ func (siw *ServerInterfaceWrapper) GetConferencecalls(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetConferencecalls(c, params)
+	siw.Handler.GetCampaignsIdCampaigncalls(c, id, params)
 }
 
-// DeleteConferencecallsId operation middleware
-func (siw *ServerInterfaceWrapper) DeleteConferencecallsId(c *gin.Context) {
+// PutCampaignsIdDialMode operation middleware
+func (siw *ServerInterfaceWrapper) PutCampaignsIdDialMode(c *gin.Context) {
 
 	var err error
 
@@ -12488,11 +14900,11 @@ func (siw *ServerInterfaceWrapper) DeleteConferencecallsId(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteConferencecallsId(c, id)
+	siw.Handler.PutCampaignsIdDialMode(c, id)
 }
 
-// GetConferencecallsId operation middleware
-func (siw *ServerInterfaceWrapper) GetConferencecallsId(c *gin.Context) {
+// PutCampaignsIdNextCampaignId operation middleware
+func (siw *ServerInterfaceWrapper) PutCampaignsIdNextCampaignId(c *gin.Context) {
 
 	var err error
 
@@ -12512,16 +14924,313 @@ func (siw *ServerInterfaceWrapper) GetConferencecallsId(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetConferencecallsId(c, id)
+	siw.Handler.PutCampaignsIdNextCampaignId(c, id)
 }
 
-// GetConferences operation middleware
-func (siw *ServerInterfaceWrapper) GetConferences(c *gin.Context) {
+// PutCampaignsIdResourceInfo operation middleware
+func (siw *ServerInterfaceWrapper) PutCampaignsIdResourceInfo(c *gin.Context) {
 
 	var err error
 
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutCampaignsIdResourceInfo(c, id)
+}
+
+// GetCampaignsIdResultjobs operation middleware
+func (siw *ServerInterfaceWrapper) GetCampaignsIdResultjobs(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetConferencesParams
+	var params GetCampaignsIdResultjobsParams
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_size", c.Request.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page_size: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "page_token" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_token", c.Request.URL.Query(), &params.PageToken)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page_token: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetCampaignsIdResultjobs(c, id, params)
+}
+
+// PostCampaignsIdResultjobs operation middleware
+func (siw *ServerInterfaceWrapper) PostCampaignsIdResultjobs(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostCampaignsIdResultjobs(c, id)
+}
+
+// GetCampaignsIdResultjobsResultjobId operation middleware
+func (siw *ServerInterfaceWrapper) GetCampaignsIdResultjobsResultjobId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "resultjob_id" -------------
+	var resultjobId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resultjob_id", c.Param("resultjob_id"), &resultjobId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter resultjob_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetCampaignsIdResultjobsResultjobId(c, id, resultjobId)
+}
+
+// GetCampaignsIdResults operation middleware
+func (siw *ServerInterfaceWrapper) GetCampaignsIdResults(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCampaignsIdResultsParams
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_size", c.Request.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page_size: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "page_token" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_token", c.Request.URL.Query(), &params.PageToken)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page_token: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetCampaignsIdResults(c, id, params)
+}
+
+// PutCampaignsIdServiceLevel operation middleware
+func (siw *ServerInterfaceWrapper) PutCampaignsIdServiceLevel(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutCampaignsIdServiceLevel(c, id)
+}
+
+// PutCampaignsIdStatus operation middleware
+func (siw *ServerInterfaceWrapper) PutCampaignsIdStatus(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutCampaignsIdStatus(c, id)
+}
+
+// GetConferencecalls operation middleware
+func (siw *ServerInterfaceWrapper) GetConferencecalls(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetConferencecallsParams
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_size", c.Request.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page_size: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "page_token" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_token", c.Request.URL.Query(), &params.PageToken)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page_token: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetConferencecalls(c, params)
+}
+
+// DeleteConferencecallsId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteConferencecallsId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteConferencecallsId(c, id)
+}
+
+// GetConferencecallsId operation middleware
+func (siw *ServerInterfaceWrapper) GetConferencecallsId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetConferencecallsId(c, id)
+}
+
+// GetConferences operation middleware
+func (siw *ServerInterfaceWrapper) GetConferences(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetConferencesParams
 
 	// ------------- Optional query parameter "page_size" -------------
 
@@ -14004,65 +16713,184 @@ func (siw *ServerInterfaceWrapper) PostConversationsIdUnassign(c *gin.Context) {
 	siw.Handler.PostConversationsIdUnassign(c, id)
 }
 
-// GetCustomer operation middleware
-func (siw *ServerInterfaceWrapper) GetCustomer(c *gin.Context) {
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
-
-	siw.Handler.GetCustomer(c)
-}
-
-// PutCustomer operation middleware
-func (siw *ServerInterfaceWrapper) PutCustomer(c *gin.Context) {
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
-
-	siw.Handler.PutCustomer(c)
-}
-
-// PutCustomerBillingAccountId operation middleware
-func (siw *ServerInterfaceWrapper) PutCustomerBillingAccountId(c *gin.Context) {
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
-
-	siw.Handler.PutCustomerBillingAccountId(c)
-}
-
-// PutCustomerMetadata operation middleware
-func (siw *ServerInterfaceWrapper) PutCustomerMetadata(c *gin.Context) {
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
-
-	siw.Handler.PutCustomerMetadata(c)
-}
-
-// GetCustomers operation middleware
-func (siw *ServerInterfaceWrapper) GetCustomers(c *gin.Context) {
+// GetCustomTools operation middleware
+func (siw *ServerInterfaceWrapper) GetCustomTools(c *gin.Context) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetCustomersParams
+	var params GetCustomToolsParams
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_size", c.Request.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page_size: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "page_token" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_token", c.Request.URL.Query(), &params.PageToken)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page_token: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetCustomTools(c, params)
+}
+
+// PostCustomTools operation middleware
+func (siw *ServerInterfaceWrapper) PostCustomTools(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostCustomTools(c)
+}
+
+// DeleteCustomToolsId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCustomToolsId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteCustomToolsId(c, id)
+}
+
+// GetCustomToolsId operation middleware
+func (siw *ServerInterfaceWrapper) GetCustomToolsId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetCustomToolsId(c, id)
+}
+
+// PutCustomToolsId operation middleware
+func (siw *ServerInterfaceWrapper) PutCustomToolsId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutCustomToolsId(c, id)
+}
+
+// GetCustomer operation middleware
+func (siw *ServerInterfaceWrapper) GetCustomer(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetCustomer(c)
+}
+
+// PutCustomer operation middleware
+func (siw *ServerInterfaceWrapper) PutCustomer(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutCustomer(c)
+}
+
+// PutCustomerBillingAccountId operation middleware
+func (siw *ServerInterfaceWrapper) PutCustomerBillingAccountId(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutCustomerBillingAccountId(c)
+}
+
+// PutCustomerMetadata operation middleware
+func (siw *ServerInterfaceWrapper) PutCustomerMetadata(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutCustomerMetadata(c)
+}
+
+// GetCustomers operation middleware
+func (siw *ServerInterfaceWrapper) GetCustomers(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCustomersParams
 
 	// ------------- Optional query parameter "page_size" -------------
 
@@ -14271,6 +17099,125 @@ func (siw *ServerInterfaceWrapper) PostCustomersIdRecover(c *gin.Context) {
 	siw.Handler.PostCustomersIdRecover(c, id)
 }
 
+// GetDispositions operation middleware
+func (siw *ServerInterfaceWrapper) GetDispositions(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDispositionsParams
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_size", c.Request.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page_size: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "page_token" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_token", c.Request.URL.Query(), &params.PageToken)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page_token: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetDispositions(c, params)
+}
+
+// PostDispositions operation middleware
+func (siw *ServerInterfaceWrapper) PostDispositions(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostDispositions(c)
+}
+
+// DeleteDispositionsId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteDispositionsId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteDispositionsId(c, id)
+}
+
+// GetDispositionsId operation middleware
+func (siw *ServerInterfaceWrapper) GetDispositionsId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetDispositionsId(c, id)
+}
+
+// PutDispositionsId operation middleware
+func (siw *ServerInterfaceWrapper) PutDispositionsId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutDispositionsId(c, id)
+}
+
 // GetEmails operation middleware
 func (siw *ServerInterfaceWrapper) GetEmails(c *gin.Context) {
 
@@ -14771,6 +17718,149 @@ func (siw *ServerInterfaceWrapper) PostGroupcallsIdHangup(c *gin.Context) {
 	siw.Handler.PostGroupcallsIdHangup(c, id)
 }
 
+// GetMcpServers operation middleware
+func (siw *ServerInterfaceWrapper) GetMcpServers(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetMcpServersParams
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_size", c.Request.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page_size: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "page_token" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_token", c.Request.URL.Query(), &params.PageToken)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page_token: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetMcpServers(c, params)
+}
+
+// PostMcpServers operation middleware
+func (siw *ServerInterfaceWrapper) PostMcpServers(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostMcpServers(c)
+}
+
+// DeleteMcpServersId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteMcpServersId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteMcpServersId(c, id)
+}
+
+// GetMcpServersId operation middleware
+func (siw *ServerInterfaceWrapper) GetMcpServersId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetMcpServersId(c, id)
+}
+
+// PutMcpServersId operation middleware
+func (siw *ServerInterfaceWrapper) PutMcpServersId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutMcpServersId(c, id)
+}
+
+// GetMcpServersIdTools operation middleware
+func (siw *ServerInterfaceWrapper) GetMcpServersIdTools(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetMcpServersIdTools(c, id)
+}
+
 // GetMe operation middleware
 func (siw *ServerInterfaceWrapper) GetMe(c *gin.Context) {
 
@@ -15379,6 +18469,106 @@ func (siw *ServerInterfaceWrapper) PutOutdialsIdData(c *gin.Context) {
 	siw.Handler.PutOutdialsIdData(c, id)
 }
 
+// GetOutdialsIdTargetjobs operation middleware
+func (siw *ServerInterfaceWrapper) GetOutdialsIdTargetjobs(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOutdialsIdTargetjobsParams
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_size", c.Request.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page_size: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "page_token" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_token", c.Request.URL.Query(), &params.PageToken)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page_token: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetOutdialsIdTargetjobs(c, id, params)
+}
+
+// PostOutdialsIdTargetjobs operation middleware
+func (siw *ServerInterfaceWrapper) PostOutdialsIdTargetjobs(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostOutdialsIdTargetjobs(c, id)
+}
+
+// GetOutdialsIdTargetjobsTargetjobId operation middleware
+func (siw *ServerInterfaceWrapper) GetOutdialsIdTargetjobsTargetjobId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "targetjob_id" -------------
+	var targetjobId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "targetjob_id", c.Param("targetjob_id"), &targetjobId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter targetjob_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetOutdialsIdTargetjobsTargetjobId(c, id, targetjobId)
+}
+
 // GetOutdialsIdTargets operation middleware
 func (siw *ServerInterfaceWrapper) GetOutdialsIdTargets(c *gin.Context) {
 
@@ -15512,6 +18702,39 @@ func (siw *ServerInterfaceWrapper) GetOutdialsIdTargetsTargetId(c *gin.Context)
 	siw.Handler.GetOutdialsIdTargetsTargetId(c, id, targetId)
 }
 
+// PutOutdialsIdTargetsTargetIdCallback operation middleware
+func (siw *ServerInterfaceWrapper) PutOutdialsIdTargetsTargetIdCallback(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "target_id" -------------
+	var targetId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "target_id", c.Param("target_id"), &targetId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter target_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutOutdialsIdTargetsTargetIdCallback(c, id, targetId)
+}
+
 // GetOutplans operation middleware
 func (siw *ServerInterfaceWrapper) GetOutplans(c *gin.Context) {
 
@@ -19076,13 +22299,13 @@ func (siw *ServerInterfaceWrapper) GetStorageFilesIdFile(c *gin.Context) {
 	siw.Handler.GetStorageFilesIdFile(c, id)
 }
 
-// GetTags operation middleware
-func (siw *ServerInterfaceWrapper) GetTags(c *gin.Context) {
+// GetSuppressionBlocks operation middleware
+func (siw *ServerInterfaceWrapper) GetSuppressionBlocks(c *gin.Context) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetTagsParams
+	var params GetSuppressionBlocksParams
 
 	// ------------- Optional query parameter "page_size" -------------
 
@@ -19107,11 +22330,22 @@ func (siw *ServerInterfaceWrapper) GetTags(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetTags(c, params)
+	siw.Handler.GetSuppressionBlocks(c, params)
 }
 
-// PostTags operation middleware
-func (siw *ServerInterfaceWrapper) PostTags(c *gin.Context) {
+// GetSuppressionBlocksId operation middleware
+func (siw *ServerInterfaceWrapper) GetSuppressionBlocksId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -19120,20 +22354,30 @@ func (siw *ServerInterfaceWrapper) PostTags(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostTags(c)
+	siw.Handler.GetSuppressionBlocksId(c, id)
 }
 
-// DeleteTagsId operation middleware
-func (siw *ServerInterfaceWrapper) DeleteTagsId(c *gin.Context) {
+// GetSuppressions operation middleware
+func (siw *ServerInterfaceWrapper) GetSuppressions(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSuppressionsParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_size", c.Request.URL.Query(), &params.PageSize)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page_size: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "page_token" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_token", c.Request.URL.Query(), &params.PageToken)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page_token: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -19144,11 +22388,37 @@ func (siw *ServerInterfaceWrapper) DeleteTagsId(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteTagsId(c, id)
+	siw.Handler.GetSuppressions(c, params)
 }
 
-// GetTagsId operation middleware
-func (siw *ServerInterfaceWrapper) GetTagsId(c *gin.Context) {
+// PostSuppressions operation middleware
+func (siw *ServerInterfaceWrapper) PostSuppressions(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostSuppressions(c)
+}
+
+// PostSuppressionsImport operation middleware
+func (siw *ServerInterfaceWrapper) PostSuppressionsImport(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostSuppressionsImport(c)
+}
+
+// DeleteSuppressionsId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSuppressionsId(c *gin.Context) {
 
 	var err error
 
@@ -19168,11 +22438,11 @@ func (siw *ServerInterfaceWrapper) GetTagsId(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetTagsId(c, id)
+	siw.Handler.DeleteSuppressionsId(c, id)
 }
 
-// PutTagsId operation middleware
-func (siw *ServerInterfaceWrapper) PutTagsId(c *gin.Context) {
+// GetSuppressionsId operation middleware
+func (siw *ServerInterfaceWrapper) GetSuppressionsId(c *gin.Context) {
 
 	var err error
 
@@ -19192,16 +22462,135 @@ func (siw *ServerInterfaceWrapper) PutTagsId(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PutTagsId(c, id)
+	siw.Handler.GetSuppressionsId(c, id)
 }
 
-// GetTeams operation middleware
-func (siw *ServerInterfaceWrapper) GetTeams(c *gin.Context) {
+// GetTags operation middleware
+func (siw *ServerInterfaceWrapper) GetTags(c *gin.Context) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetTeamsParams
+	var params GetTagsParams
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_size", c.Request.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page_size: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "page_token" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_token", c.Request.URL.Query(), &params.PageToken)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page_token: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTags(c, params)
+}
+
+// PostTags operation middleware
+func (siw *ServerInterfaceWrapper) PostTags(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostTags(c)
+}
+
+// DeleteTagsId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTagsId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteTagsId(c, id)
+}
+
+// GetTagsId operation middleware
+func (siw *ServerInterfaceWrapper) GetTagsId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTagsId(c, id)
+}
+
+// PutTagsId operation middleware
+func (siw *ServerInterfaceWrapper) PutTagsId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutTagsId(c, id)
+}
+
+// GetTeams operation middleware
+func (siw *ServerInterfaceWrapper) GetTeams(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamsParams
 
 	// ------------- Optional query parameter "page_size" -------------
 
@@ -19338,6 +22727,247 @@ func (siw *ServerInterfaceWrapper) PostTeamsIdDirectHashRegenerate(c *gin.Contex
 	siw.Handler.PostTeamsIdDirectHashRegenerate(c, id)
 }
 
+// GetTestRuns operation middleware
+func (siw *ServerInterfaceWrapper) GetTestRuns(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTestRunsParams
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_size", c.Request.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page_size: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "page_token" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_token", c.Request.URL.Query(), &params.PageToken)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page_token: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "test_suite_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "test_suite_id", c.Request.URL.Query(), &params.TestSuiteId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter test_suite_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTestRuns(c, params)
+}
+
+// DeleteTestRunsId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTestRunsId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteTestRunsId(c, id)
+}
+
+// GetTestRunsId operation middleware
+func (siw *ServerInterfaceWrapper) GetTestRunsId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTestRunsId(c, id)
+}
+
+// GetTestSuites operation middleware
+func (siw *ServerInterfaceWrapper) GetTestSuites(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTestSuitesParams
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_size", c.Request.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page_size: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "page_token" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_token", c.Request.URL.Query(), &params.PageToken)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page_token: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "ai_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "ai_id", c.Request.URL.Query(), &params.AiId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter ai_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTestSuites(c, params)
+}
+
+// PostTestSuites operation middleware
+func (siw *ServerInterfaceWrapper) PostTestSuites(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostTestSuites(c)
+}
+
+// DeleteTestSuitesId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTestSuitesId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteTestSuitesId(c, id)
+}
+
+// GetTestSuitesId operation middleware
+func (siw *ServerInterfaceWrapper) GetTestSuitesId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTestSuitesId(c, id)
+}
+
+// PutTestSuitesId operation middleware
+func (siw *ServerInterfaceWrapper) PutTestSuitesId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutTestSuitesId(c, id)
+}
+
+// PostTestSuitesIdRun operation middleware
+func (siw *ServerInterfaceWrapper) PostTestSuitesIdRun(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostTestSuitesIdRun(c, id)
+}
+
 // GetTimelineAnalyses operation middleware
 func (siw *ServerInterfaceWrapper) GetTimelineAnalyses(c *gin.Context) {
 
@@ -20300,6 +23930,11 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	router.PUT(options.BaseURL+"/agents/:id/status", wrapper.PutAgentsIdStatus)
 	router.PUT(options.BaseURL+"/agents/:id/tag_ids", wrapper.PutAgentsIdTagIds)
 	router.GET(options.BaseURL+"/aggregated-events", wrapper.GetAggregatedEvents)
+	router.GET(options.BaseURL+"/aiassists", wrapper.GetAiassists)
+	router.POST(options.BaseURL+"/aiassists", wrapper.PostAiassists)
+	router.DELETE(options.BaseURL+"/aiassists/:id", wrapper.DeleteAiassistsId)
+	router.GET(options.BaseURL+"/aiassists/:id", wrapper.GetAiassistsId)
+	router.POST(options.BaseURL+"/aiassists/:id/stop", wrapper.PostAiassistsIdStop)
 	router.GET(options.BaseURL+"/aiaudits", wrapper.GetAiaudits)
 	router.POST(options.BaseURL+"/aiaudits", wrapper.PostAiaudits)
 	router.DELETE(options.BaseURL+"/aiaudits/:id", wrapper.DeleteAiauditsId)
@@ -20330,6 +23965,12 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	router.GET(options.BaseURL+"/ais/:id/participants", wrapper.GetAisIdParticipants)
 	router.GET(options.BaseURL+"/ais/:id/prompt_histories", wrapper.GetAisIdPromptHistories)
 	router.GET(options.BaseURL+"/ais/:id/prompt_histories/:history_id", wrapper.GetAisIdPromptHistoriesHistoryId)
+	router.GET(options.BaseURL+"/aiextractions", wrapper.GetAiextractions)
+	router.POST(options.BaseURL+"/aiextractions", wrapper.PostAiextractions)
+	router.DELETE(options.BaseURL+"/aiextractions/:id", wrapper.DeleteAiextractionsId)
+	router.GET(options.BaseURL+"/aiextractions/:id", wrapper.GetAiextractionsId)
+	router.GET(options.BaseURL+"/aiguardrailviolations", wrapper.GetAiguardrailviolations)
+	router.GET(options.BaseURL+"/aiguardrailviolations/:id", wrapper.GetAiguardrailviolationsId)
 	router.GET(options.BaseURL+"/aisummaries", wrapper.GetAisummaries)
 	router.POST(options.BaseURL+"/aisummaries", wrapper.PostAisummaries)
 	router.DELETE(options.BaseURL+"/aisummaries/:id", wrapper.DeleteAisummariesId)
@@ -20347,12 +23988,16 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	router.PUT(options.BaseURL+"/billing_account", wrapper.PutBillingAccount)
 	router.POST(options.BaseURL+"/billing_account/paddle_portal_session", wrapper.PostBillingAccountPaddlePortalSession)
 	router.PUT(options.BaseURL+"/billing_account/payment_info", wrapper.PutBillingAccountPaymentInfo)
+	router.PUT(options.BaseURL+"/billing_account/spending_settings", wrapper.PutBillingAccountSpendingSettings)
 	router.GET(options.BaseURL+"/billing_accounts", wrapper.GetBillingAccounts)
 	router.GET(options.BaseURL+"/billing_accounts/:id", wrapper.GetBillingAccountsId)
 	router.PUT(options.BaseURL+"/billing_accounts/:id", wrapper.PutBillingAccountsId)
 	router.POST(options.BaseURL+"/billing_accounts/:id/balance_add_force", wrapper.PostBillingAccountsIdBalanceAddForce)
 	router.POST(options.BaseURL+"/billing_accounts/:id/balance_subtract_force", wrapper.PostBillingAccountsIdBalanceSubtractForce)
 	router.PUT(options.BaseURL+"/billing_accounts/:id/payment_info", wrapper.PutBillingAccountsIdPaymentInfo)
+	router.POST(options.BaseURL+"/billing_estimates", wrapper.PostBillingEstimates)
+	router.GET(options.BaseURL+"/billing_statements", wrapper.GetBillingStatements)
+	router.GET(options.BaseURL+"/billing_statements/:id", wrapper.GetBillingStatementsId)
 	router.GET(options.BaseURL+"/billings", wrapper.GetBillings)
 	router.GET(options.BaseURL+"/billings/:billing-id", wrapper.GetBillingsBillingId)
 	router.GET(options.BaseURL+"/calls", wrapper.GetCalls)
@@ -20375,15 +24020,25 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	router.GET(options.BaseURL+"/campaigncalls", wrapper.GetCampaigncalls)
 	router.DELETE(options.BaseURL+"/campaigncalls/:id", wrapper.DeleteCampaigncallsId)
 	router.GET(options.BaseURL+"/campaigncalls/:id", wrapper.GetCampaigncallsId)
+	router.POST(options.BaseURL+"/campaigncalls/:id/accept", wrapper.PostCampaigncallsIdAccept)
+	router.PUT(options.BaseURL+"/campaigncalls/:id/callback", wrapper.PutCampaigncallsIdCallback)
+	router.PUT(options.BaseURL+"/campaigncalls/:id/disposition", wrapper.PutCampaigncallsIdDisposition)
+	router.POST(options.BaseURL+"/campaigncalls/:id/skip", wrapper.PostCampaigncallsIdSkip)
 	router.GET(options.BaseURL+"/campaigns", wrapper.GetCampaigns)
 	router.POST(options.BaseURL+"/campaigns", wrapper.PostCampaigns)
 	router.DELETE(options.BaseURL+"/campaigns/:id", wrapper.DeleteCampaignsId)
 	router.GET(options.BaseURL+"/campaigns/:id", wrapper.GetCampaignsId)
 	router.PUT(options.BaseURL+"/campaigns/:id", wrapper.PutCampaignsId)
 	router.PUT(options.BaseURL+"/campaigns/:id/actions", wrapper.PutCampaignsIdActions)
+	router.PUT(options.BaseURL+"/campaigns/:id/calling_windows", wrapper.PutCampaignsIdCallingWindows)
 	router.GET(options.BaseURL+"/campaigns/:id/campaigncalls", wrapper.GetCampaignsIdCampaigncalls)
+	router.PUT(options.BaseURL+"/campaigns/:id/dial_mode", wrapper.PutCampaignsIdDialMode)
 	router.PUT(options.BaseURL+"/campaigns/:id/next_campaign_id", wrapper.PutCampaignsIdNextCampaignId)
 	router.PUT(options.BaseURL+"/campaigns/:id/resource_info", wrapper.PutCampaignsIdResourceInfo)
+	router.GET(options.BaseURL+"/campaigns/:id/resultjobs", wrapper.GetCampaignsIdResultjobs)
+	router.POST(options.BaseURL+"/campaigns/:id/resultjobs", wrapper.PostCampaignsIdResultjobs)
+	router.GET(options.BaseURL+"/campaigns/:id/resultjobs/:resultjob_id", wrapper.GetCampaignsIdResultjobsResultjobId)
+	router.GET(options.BaseURL+"/campaigns/:id/results", wrapper.GetCampaignsIdResults)
 	router.PUT(options.BaseURL+"/campaigns/:id/service_level", wrapper.PutCampaignsIdServiceLevel)
 	router.PUT(options.BaseURL+"/campaigns/:id/status", wrapper.PutCampaignsIdStatus)
 	router.GET(options.BaseURL+"/conferencecalls", wrapper.GetConferencecalls)
@@ -20440,6 +24095,11 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	router.GET(options.BaseURL+"/conversations/:id/messages", wrapper.GetConversationsIdMessages)
 	router.POST(options.BaseURL+"/conversations/:id/messages", wrapper.PostConversationsIdMessages)
 	router.POST(options.BaseURL+"/conversations/:id/unassign", wrapper.PostConversationsIdUnassign)
+	router.GET(options.BaseURL+"/custom_tools", wrapper.GetCustomTools)
+	router.POST(options.BaseURL+"/custom_tools", wrapper.PostCustomTools)
+	router.DELETE(options.BaseURL+"/custom_tools/:id", wrapper.DeleteCustomToolsId)
+	router.GET(options.BaseURL+"/custom_tools/:id", wrapper.GetCustomToolsId)
+	router.PUT(options.BaseURL+"/custom_tools/:id", wrapper.PutCustomToolsId)
 	router.GET(options.BaseURL+"/customer", wrapper.GetCustomer)
 	router.PUT(options.BaseURL+"/customer", wrapper.PutCustomer)
 	router.PUT(options.BaseURL+"/customer/billing_account_id", wrapper.PutCustomerBillingAccountId)
@@ -20453,6 +24113,11 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	router.POST(options.BaseURL+"/customers/:id/freeze", wrapper.PostCustomersIdFreeze)
 	router.PUT(options.BaseURL+"/customers/:id/metadata", wrapper.PutCustomersIdMetadata)
 	router.POST(options.BaseURL+"/customers/:id/recover", wrapper.PostCustomersIdRecover)
+	router.GET(options.BaseURL+"/dispositions", wrapper.GetDispositions)
+	router.POST(options.BaseURL+"/dispositions", wrapper.PostDispositions)
+	router.DELETE(options.BaseURL+"/dispositions/:id", wrapper.DeleteDispositionsId)
+	router.GET(options.BaseURL+"/dispositions/:id", wrapper.GetDispositionsId)
+	router.PUT(options.BaseURL+"/dispositions/:id", wrapper.PutDispositionsId)
 	router.GET(options.BaseURL+"/emails", wrapper.GetEmails)
 	router.POST(options.BaseURL+"/emails", wrapper.PostEmails)
 	router.DELETE(options.BaseURL+"/emails/:id", wrapper.DeleteEmailsId)
@@ -20474,6 +24139,12 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	router.DELETE(options.BaseURL+"/groupcalls/:id", wrapper.DeleteGroupcallsId)
 	router.GET(options.BaseURL+"/groupcalls/:id", wrapper.GetGroupcallsId)
 	router.POST(options.BaseURL+"/groupcalls/:id/hangup", wrapper.PostGroupcallsIdHangup)
+	router.GET(options.BaseURL+"/mcp_servers", wrapper.GetMcpServers)
+	router.POST(options.BaseURL+"/mcp_servers", wrapper.PostMcpServers)
+	router.DELETE(options.BaseURL+"/mcp_servers/:id", wrapper.DeleteMcpServersId)
+	router.GET(options.BaseURL+"/mcp_servers/:id", wrapper.GetMcpServersId)
+	router.PUT(options.BaseURL+"/mcp_servers/:id", wrapper.PutMcpServersId)
+	router.GET(options.BaseURL+"/mcp_servers/:id/tools", wrapper.GetMcpServersIdTools)
 	router.GET(options.BaseURL+"/me", wrapper.GetMe)
 	router.GET(options.BaseURL+"/messages", wrapper.GetMessages)
 	router.POST(options.BaseURL+"/messages", wrapper.PostMessages)
@@ -20501,10 +24172,14 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	router.PUT(options.BaseURL+"/outdials/:id", wrapper.PutOutdialsId)
 	router.PUT(options.BaseURL+"/outdials/:id/campaign_id", wrapper.PutOutdialsIdCampaignId)
 	router.PUT(options.BaseURL+"/outdials/:id/data", wrapper.PutOutdialsIdData)
+	router.GET(options.BaseURL+"/outdials/:id/targetjobs", wrapper.GetOutdialsIdTargetjobs)
+	router.POST(options.BaseURL+"/outdials/:id/targetjobs", wrapper.PostOutdialsIdTargetjobs)
+	router.GET(options.BaseURL+"/outdials/:id/targetjobs/:targetjob_id", wrapper.GetOutdialsIdTargetjobsTargetjobId)
 	router.GET(options.BaseURL+"/outdials/:id/targets", wrapper.GetOutdialsIdTargets)
 	router.POST(options.BaseURL+"/outdials/:id/targets", wrapper.PostOutdialsIdTargets)
 	router.DELETE(options.BaseURL+"/outdials/:id/targets/:target_id", wrapper.DeleteOutdialsIdTargetsTargetId)
 	router.GET(options.BaseURL+"/outdials/:id/targets/:target_id", wrapper.GetOutdialsIdTargetsTargetId)
+	router.PUT(options.BaseURL+"/outdials/:id/targets/:target_id/callback", wrapper.PutOutdialsIdTargetsTargetIdCallback)
 	router.GET(options.BaseURL+"/outplans", wrapper.GetOutplans)
 	router.POST(options.BaseURL+"/outplans", wrapper.PostOutplans)
 	router.DELETE(options.BaseURL+"/outplans/:id", wrapper.DeleteOutplansId)
@@ -20642,6 +24317,13 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	router.DELETE(options.BaseURL+"/storage_files/:id", wrapper.DeleteStorageFilesId)
 	router.GET(options.BaseURL+"/storage_files/:id", wrapper.GetStorageFilesId)
 	router.GET(options.BaseURL+"/storage_files/:id/file", wrapper.GetStorageFilesIdFile)
+	router.GET(options.BaseURL+"/suppression_blocks", wrapper.GetSuppressionBlocks)
+	router.GET(options.BaseURL+"/suppression_blocks/:id", wrapper.GetSuppressionBlocksId)
+	router.GET(options.BaseURL+"/suppressions", wrapper.GetSuppressions)
+	router.POST(options.BaseURL+"/suppressions", wrapper.PostSuppressions)
+	router.POST(options.BaseURL+"/suppressions/import", wrapper.PostSuppressionsImport)
+	router.DELETE(options.BaseURL+"/suppressions/:id", wrapper.DeleteSuppressionsId)
+	router.GET(options.BaseURL+"/suppressions/:id", wrapper.GetSuppressionsId)
 	router.GET(options.BaseURL+"/tags", wrapper.GetTags)
 	router.POST(options.BaseURL+"/tags", wrapper.PostTags)
 	router.DELETE(options.BaseURL+"/tags/:id", wrapper.DeleteTagsId)
@@ -20653,6 +24335,15 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	router.GET(options.BaseURL+"/teams/:id", wrapper.GetTeamsId)
 	router.PUT(options.BaseURL+"/teams/:id", wrapper.PutTeamsId)
 	router.POST(options.BaseURL+"/teams/:id/direct-hash-regenerate", wrapper.PostTeamsIdDirectHashRegenerate)
+	router.GET(options.BaseURL+"/test_runs", wrapper.GetTestRuns)
+	router.DELETE(options.BaseURL+"/test_runs/:id", wrapper.DeleteTestRunsId)
+	router.GET(options.BaseURL+"/test_runs/:id", wrapper.GetTestRunsId)
+	router.GET(options.BaseURL+"/test_suites", wrapper.GetTestSuites)
+	router.POST(options.BaseURL+"/test_suites", wrapper.PostTestSuites)
+	router.DELETE(options.BaseURL+"/test_suites/:id", wrapper.DeleteTestSuitesId)
+	router.GET(options.BaseURL+"/test_suites/:id", wrapper.GetTestSuitesId)
+	router.PUT(options.BaseURL+"/test_suites/:id", wrapper.PutTestSuitesId)
+	router.POST(options.BaseURL+"/test_suites/:id/run", wrapper.PostTestSuitesIdRun)
 	router.GET(options.BaseURL+"/timeline-analyses", wrapper.GetTimelineAnalyses)
 	router.POST(options.BaseURL+"/timeline-analyses", wrapper.PostTimelineAnalyses)
 	router.DELETE(options.BaseURL+"/timeline-analyses/:id", wrapper.DeleteTimelineAnalysesId)
@@ -21958,6 +25649,284 @@ func (response GetAggregatedEvents500JSONResponse) VisitGetAggregatedEventsRespo
 	return json.NewEncoder(w).Encode(response)
 }
 
+type GetAiassistsRequestObject struct {
+	Params GetAiassistsParams
+}
+
+type GetAiassistsResponseObject interface {
+	VisitGetAiassistsResponse(w http.ResponseWriter) error
+}
+
+type GetAiassists200JSONResponse struct {
+	// NextPageToken Cursor token for the next page of results. Pass this value as the page_token parameter in the next request.
+	NextPageToken *string              `json:"next_page_token,omitempty"`
+	Result        *[]AIManagerAIAssist `json:"result,omitempty"`
+}
+
+func (response GetAiassists200JSONResponse) VisitGetAiassistsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAiassists401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetAiassists401JSONResponse) VisitGetAiassistsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAiassists500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetAiassists500JSONResponse) VisitGetAiassistsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAiassistsRequestObject struct {
+	Body *PostAiassistsJSONRequestBody
+}
+
+type PostAiassistsResponseObject interface {
+	VisitPostAiassistsResponse(w http.ResponseWriter) error
+}
+
+type PostAiassists200JSONResponse AIManagerAIAssist
+
+func (response PostAiassists200JSONResponse) VisitPostAiassistsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAiassists400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostAiassists400JSONResponse) VisitPostAiassistsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAiassists401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response PostAiassists401JSONResponse) VisitPostAiassistsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAiassists403JSONResponse struct{ PermissionDeniedJSONResponse }
+
+func (response PostAiassists403JSONResponse) VisitPostAiassistsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAiassists500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostAiassists500JSONResponse) VisitPostAiassistsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAiassistsIdRequestObject struct {
+	Id string `json:"id"`
+}
+
+type DeleteAiassistsIdResponseObject interface {
+	VisitDeleteAiassistsIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteAiassistsId200JSONResponse AIManagerAIAssist
+
+func (response DeleteAiassistsId200JSONResponse) VisitDeleteAiassistsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAiassistsId400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DeleteAiassistsId400JSONResponse) VisitDeleteAiassistsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAiassistsId401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response DeleteAiassistsId401JSONResponse) VisitDeleteAiassistsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAiassistsId403JSONResponse struct{ PermissionDeniedJSONResponse }
+
+func (response DeleteAiassistsId403JSONResponse) VisitDeleteAiassistsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAiassistsId404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteAiassistsId404JSONResponse) VisitDeleteAiassistsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAiassistsId500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response DeleteAiassistsId500JSONResponse) VisitDeleteAiassistsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAiassistsIdRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetAiassistsIdResponseObject interface {
+	VisitGetAiassistsIdResponse(w http.ResponseWriter) error
+}
+
+type GetAiassistsId200JSONResponse AIManagerAIAssist
+
+func (response GetAiassistsId200JSONResponse) VisitGetAiassistsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAiassistsId400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetAiassistsId400JSONResponse) VisitGetAiassistsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAiassistsId401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetAiassistsId401JSONResponse) VisitGetAiassistsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAiassistsId403JSONResponse struct{ PermissionDeniedJSONResponse }
+
+func (response GetAiassistsId403JSONResponse) VisitGetAiassistsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAiassistsId404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetAiassistsId404JSONResponse) VisitGetAiassistsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAiassistsId500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetAiassistsId500JSONResponse) VisitGetAiassistsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAiassistsIdStopRequestObject struct {
+	Id string `json:"id"`
+}
+
+type PostAiassistsIdStopResponseObject interface {
+	VisitPostAiassistsIdStopResponse(w http.ResponseWriter) error
+}
+
+type PostAiassistsIdStop200JSONResponse AIManagerAIAssist
+
+func (response PostAiassistsIdStop200JSONResponse) VisitPostAiassistsIdStopResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAiassistsIdStop400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostAiassistsIdStop400JSONResponse) VisitPostAiassistsIdStopResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAiassistsIdStop401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response PostAiassistsIdStop401JSONResponse) VisitPostAiassistsIdStopResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAiassistsIdStop403JSONResponse struct{ PermissionDeniedJSONResponse }
+
+func (response PostAiassistsIdStop403JSONResponse) VisitPostAiassistsIdStopResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAiassistsIdStop404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response PostAiassistsIdStop404JSONResponse) VisitPostAiassistsIdStopResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAiassistsIdStop500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostAiassistsIdStop500JSONResponse) VisitPostAiassistsIdStopResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type GetAiauditsRequestObject struct {
 	Params GetAiauditsParams
 }
@@ -23700,6 +27669,314 @@ func (response GetAisIdPromptHistoriesHistoryId500JSONResponse) VisitGetAisIdPro
 	return json.NewEncoder(w).Encode(response)
 }
 
+type GetAiextractionsRequestObject struct {
+	Params GetAiextractionsParams
+}
+
+type GetAiextractionsResponseObject interface {
+	VisitGetAiextractionsResponse(w http.ResponseWriter) error
+}
+
+type GetAiextractions200JSONResponse struct {
+	// NextPageToken Cursor token for the next page of results. Pass this value as the page_token parameter in the next request.
+	NextPageToken *string                `json:"next_page_token,omitempty"`
+	Result        *[]AIManagerExtraction `json:"result,omitempty"`
+}
+
+func (response GetAiextractions200JSONResponse) VisitGetAiextractionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAiextractions401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetAiextractions401JSONResponse) VisitGetAiextractionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAiextractions500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetAiextractions500JSONResponse) VisitGetAiextractionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAiextractionsRequestObject struct {
+	Body *PostAiextractionsJSONRequestBody
+}
+
+type PostAiextractionsResponseObject interface {
+	VisitPostAiextractionsResponse(w http.ResponseWriter) error
+}
+
+type PostAiextractions200JSONResponse AIManagerExtraction
+
+func (response PostAiextractions200JSONResponse) VisitPostAiextractionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAiextractions400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostAiextractions400JSONResponse) VisitPostAiextractionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAiextractions401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response PostAiextractions401JSONResponse) VisitPostAiextractionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAiextractions500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostAiextractions500JSONResponse) VisitPostAiextractionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAiextractionsIdRequestObject struct {
+	Id string `json:"id"`
+}
+
+type DeleteAiextractionsIdResponseObject interface {
+	VisitDeleteAiextractionsIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteAiextractionsId200JSONResponse AIManagerExtraction
+
+func (response DeleteAiextractionsId200JSONResponse) VisitDeleteAiextractionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAiextractionsId400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DeleteAiextractionsId400JSONResponse) VisitDeleteAiextractionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAiextractionsId401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response DeleteAiextractionsId401JSONResponse) VisitDeleteAiextractionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAiextractionsId403JSONResponse struct{ PermissionDeniedJSONResponse }
+
+func (response DeleteAiextractionsId403JSONResponse) VisitDeleteAiextractionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAiextractionsId404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteAiextractionsId404JSONResponse) VisitDeleteAiextractionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAiextractionsId500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response DeleteAiextractionsId500JSONResponse) VisitDeleteAiextractionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAiextractionsIdRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetAiextractionsIdResponseObject interface {
+	VisitGetAiextractionsIdResponse(w http.ResponseWriter) error
+}
+
+type GetAiextractionsId200JSONResponse AIManagerExtraction
+
+func (response GetAiextractionsId200JSONResponse) VisitGetAiextractionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAiextractionsId400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetAiextractionsId400JSONResponse) VisitGetAiextractionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAiextractionsId401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetAiextractionsId401JSONResponse) VisitGetAiextractionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAiextractionsId403JSONResponse struct{ PermissionDeniedJSONResponse }
+
+func (response GetAiextractionsId403JSONResponse) VisitGetAiextractionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAiextractionsId404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetAiextractionsId404JSONResponse) VisitGetAiextractionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAiextractionsId500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetAiextractionsId500JSONResponse) VisitGetAiextractionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAiguardrailviolationsRequestObject struct {
+	Params GetAiguardrailviolationsParams
+}
+
+type GetAiguardrailviolationsResponseObject interface {
+	VisitGetAiguardrailviolationsResponse(w http.ResponseWriter) error
+}
+
+type GetAiguardrailviolations200JSONResponse struct {
+	// NextPageToken Cursor token for the next page of results. Pass this value as the page_token parameter in the next request.
+	NextPageToken *string                        `json:"next_page_token,omitempty"`
+	Result        *[]AIManagerGuardrailViolation `json:"result,omitempty"`
+}
+
+func (response GetAiguardrailviolations200JSONResponse) VisitGetAiguardrailviolationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAiguardrailviolations401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetAiguardrailviolations401JSONResponse) VisitGetAiguardrailviolationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAiguardrailviolations500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetAiguardrailviolations500JSONResponse) VisitGetAiguardrailviolationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAiguardrailviolationsIdRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetAiguardrailviolationsIdResponseObject interface {
+	VisitGetAiguardrailviolationsIdResponse(w http.ResponseWriter) error
+}
+
+type GetAiguardrailviolationsId200JSONResponse AIManagerGuardrailViolation
+
+func (response GetAiguardrailviolationsId200JSONResponse) VisitGetAiguardrailviolationsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAiguardrailviolationsId400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetAiguardrailviolationsId400JSONResponse) VisitGetAiguardrailviolationsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAiguardrailviolationsId401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetAiguardrailviolationsId401JSONResponse) VisitGetAiguardrailviolationsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAiguardrailviolationsId403JSONResponse struct{ PermissionDeniedJSONResponse }
+
+func (response GetAiguardrailviolationsId403JSONResponse) VisitGetAiguardrailviolationsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAiguardrailviolationsId404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetAiguardrailviolationsId404JSONResponse) VisitGetAiguardrailviolationsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAiguardrailviolationsId500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetAiguardrailviolationsId500JSONResponse) VisitGetAiguardrailviolationsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type GetAisummariesRequestObject struct {
 	Params GetAisummariesParams
 }
@@ -24348,6 +28625,50 @@ func (response PutBillingAccountPaymentInfo500JSONResponse) VisitPutBillingAccou
 	return json.NewEncoder(w).Encode(response)
 }
 
+type PutBillingAccountSpendingSettingsRequestObject struct {
+	Body *PutBillingAccountSpendingSettingsJSONRequestBody
+}
+
+type PutBillingAccountSpendingSettingsResponseObject interface {
+	VisitPutBillingAccountSpendingSettingsResponse(w http.ResponseWriter) error
+}
+
+type PutBillingAccountSpendingSettings200JSONResponse BillingManagerAccount
+
+func (response PutBillingAccountSpendingSettings200JSONResponse) VisitPutBillingAccountSpendingSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutBillingAccountSpendingSettings400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PutBillingAccountSpendingSettings400JSONResponse) VisitPutBillingAccountSpendingSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutBillingAccountSpendingSettings401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response PutBillingAccountSpendingSettings401JSONResponse) VisitPutBillingAccountSpendingSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutBillingAccountSpendingSettings500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PutBillingAccountSpendingSettings500JSONResponse) VisitPutBillingAccountSpendingSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type GetBillingAccountsRequestObject struct {
 	Params GetBillingAccountsParams
 }
@@ -24701,6 +29022,169 @@ func (response PutBillingAccountsIdPaymentInfo500JSONResponse) VisitPutBillingAc
 	return json.NewEncoder(w).Encode(response)
 }
 
+type PostBillingEstimatesRequestObject struct {
+	Body *PostBillingEstimatesJSONRequestBody
+}
+
+type PostBillingEstimatesResponseObject interface {
+	VisitPostBillingEstimatesResponse(w http.ResponseWriter) error
+}
+
+type PostBillingEstimates200JSONResponse BillingManagerEstimate
+
+func (response PostBillingEstimates200JSONResponse) VisitPostBillingEstimatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostBillingEstimates400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostBillingEstimates400JSONResponse) VisitPostBillingEstimatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostBillingEstimates401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response PostBillingEstimates401JSONResponse) VisitPostBillingEstimatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostBillingEstimates403JSONResponse struct{ PermissionDeniedJSONResponse }
+
+func (response PostBillingEstimates403JSONResponse) VisitPostBillingEstimatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostBillingEstimates500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostBillingEstimates500JSONResponse) VisitPostBillingEstimatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBillingStatementsRequestObject struct {
+	Params GetBillingStatementsParams
+}
+
+type GetBillingStatementsResponseObject interface {
+	VisitGetBillingStatementsResponse(w http.ResponseWriter) error
+}
+
+type GetBillingStatements200JSONResponse struct {
+	// NextPageToken Cursor token for the next page of results. Pass this value as the page_token parameter in the next request.
+	NextPageToken *string                    `json:"next_page_token,omitempty"`
+	Result        *[]BillingManagerStatement `json:"result,omitempty"`
+}
+
+func (response GetBillingStatements200JSONResponse) VisitGetBillingStatementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBillingStatements401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetBillingStatements401JSONResponse) VisitGetBillingStatementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBillingStatements403JSONResponse struct{ PermissionDeniedJSONResponse }
+
+func (response GetBillingStatements403JSONResponse) VisitGetBillingStatementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBillingStatements500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetBillingStatements500JSONResponse) VisitGetBillingStatementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBillingStatementsIdRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetBillingStatementsIdResponseObject interface {
+	VisitGetBillingStatementsIdResponse(w http.ResponseWriter) error
+}
+
+type GetBillingStatementsId200JSONResponse BillingManagerStatement
+
+func (response GetBillingStatementsId200JSONResponse) VisitGetBillingStatementsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBillingStatementsId400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetBillingStatementsId400JSONResponse) VisitGetBillingStatementsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBillingStatementsId401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetBillingStatementsId401JSONResponse) VisitGetBillingStatementsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBillingStatementsId403JSONResponse struct{ PermissionDeniedJSONResponse }
+
+func (response GetBillingStatementsId403JSONResponse) VisitGetBillingStatementsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBillingStatementsId404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetBillingStatementsId404JSONResponse) VisitGetBillingStatementsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBillingStatementsId500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetBillingStatementsId500JSONResponse) VisitGetBillingStatementsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type GetBillingsRequestObject struct {
 	Params GetBillingsParams
 }
@@ -26210,6 +30694,257 @@ func (response GetCampaigncallsId500JSONResponse) VisitGetCampaigncallsIdRespons
 	return json.NewEncoder(w).Encode(response)
 }
 
+type PostCampaigncallsIdAcceptRequestObject struct {
+	Id   string `json:"id"`
+	Body *PostCampaigncallsIdAcceptJSONRequestBody
+}
+
+type PostCampaigncallsIdAcceptResponseObject interface {
+	VisitPostCampaigncallsIdAcceptResponse(w http.ResponseWriter) error
+}
+
+type PostCampaigncallsIdAccept200JSONResponse CampaignManagerCampaigncall
+
+func (response PostCampaigncallsIdAccept200JSONResponse) VisitPostCampaigncallsIdAcceptResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCampaigncallsIdAccept400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostCampaigncallsIdAccept400JSONResponse) VisitPostCampaigncallsIdAcceptResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCampaigncallsIdAccept401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response PostCampaigncallsIdAccept401JSONResponse) VisitPostCampaigncallsIdAcceptResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCampaigncallsIdAccept403JSONResponse struct{ PermissionDeniedJSONResponse }
+
+func (response PostCampaigncallsIdAccept403JSONResponse) VisitPostCampaigncallsIdAcceptResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCampaigncallsIdAccept404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response PostCampaigncallsIdAccept404JSONResponse) VisitPostCampaigncallsIdAcceptResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCampaigncallsIdAccept500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostCampaigncallsIdAccept500JSONResponse) VisitPostCampaigncallsIdAcceptResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutCampaigncallsIdCallbackRequestObject struct {
+	Id   string `json:"id"`
+	Body *PutCampaigncallsIdCallbackJSONRequestBody
+}
+
+type PutCampaigncallsIdCallbackResponseObject interface {
+	VisitPutCampaigncallsIdCallbackResponse(w http.ResponseWriter) error
+}
+
+type PutCampaigncallsIdCallback200JSONResponse OutdialManagerOutdialtarget
+
+func (response PutCampaigncallsIdCallback200JSONResponse) VisitPutCampaigncallsIdCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutCampaigncallsIdCallback400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PutCampaigncallsIdCallback400JSONResponse) VisitPutCampaigncallsIdCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutCampaigncallsIdCallback401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response PutCampaigncallsIdCallback401JSONResponse) VisitPutCampaigncallsIdCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutCampaigncallsIdCallback403JSONResponse struct{ PermissionDeniedJSONResponse }
+
+func (response PutCampaigncallsIdCallback403JSONResponse) VisitPutCampaigncallsIdCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutCampaigncallsIdCallback404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response PutCampaigncallsIdCallback404JSONResponse) VisitPutCampaigncallsIdCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutCampaigncallsIdCallback500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PutCampaigncallsIdCallback500JSONResponse) VisitPutCampaigncallsIdCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutCampaigncallsIdDispositionRequestObject struct {
+	Id   string `json:"id"`
+	Body *PutCampaigncallsIdDispositionJSONRequestBody
+}
+
+type PutCampaigncallsIdDispositionResponseObject interface {
+	VisitPutCampaigncallsIdDispositionResponse(w http.ResponseWriter) error
+}
+
+type PutCampaigncallsIdDisposition200JSONResponse CampaignManagerCampaigncall
+
+func (response PutCampaigncallsIdDisposition200JSONResponse) VisitPutCampaigncallsIdDispositionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutCampaigncallsIdDisposition400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PutCampaigncallsIdDisposition400JSONResponse) VisitPutCampaigncallsIdDispositionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutCampaigncallsIdDisposition401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response PutCampaigncallsIdDisposition401JSONResponse) VisitPutCampaigncallsIdDispositionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutCampaigncallsIdDisposition403JSONResponse struct{ PermissionDeniedJSONResponse }
+
+func (response PutCampaigncallsIdDisposition403JSONResponse) VisitPutCampaigncallsIdDispositionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutCampaigncallsIdDisposition404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response PutCampaigncallsIdDisposition404JSONResponse) VisitPutCampaigncallsIdDispositionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutCampaigncallsIdDisposition500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PutCampaigncallsIdDisposition500JSONResponse) VisitPutCampaigncallsIdDispositionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCampaigncallsIdSkipRequestObject struct {
+	Id string `json:"id"`
+}
+
+type PostCampaigncallsIdSkipResponseObject interface {
+	VisitPostCampaigncallsIdSkipResponse(w http.ResponseWriter) error
+}
+
+type PostCampaigncallsIdSkip200JSONResponse CampaignManagerCampaigncall
+
+func (response PostCampaigncallsIdSkip200JSONResponse) VisitPostCampaigncallsIdSkipResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCampaigncallsIdSkip400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostCampaigncallsIdSkip400JSONResponse) VisitPostCampaigncallsIdSkipResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCampaigncallsIdSkip401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response PostCampaigncallsIdSkip401JSONResponse) VisitPostCampaigncallsIdSkipResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCampaigncallsIdSkip403JSONResponse struct{ PermissionDeniedJSONResponse }
+
+func (response PostCampaigncallsIdSkip403JSONResponse) VisitPostCampaigncallsIdSkipResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCampaigncallsIdSkip404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response PostCampaigncallsIdSkip404JSONResponse) VisitPostCampaigncallsIdSkipResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCampaigncallsIdSkip500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostCampaigncallsIdSkip500JSONResponse) VisitPostCampaigncallsIdSkipResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type GetCampaignsRequestObject struct {
 	Params GetCampaignsParams
 }
@@ -26543,6 +31278,69 @@ func (response PutCampaignsIdActions500JSONResponse) VisitPutCampaignsIdActionsR
 	return json.NewEncoder(w).Encode(response)
 }
 
+type PutCampaignsIdCallingWindowsRequestObject struct {
+	Id   string `json:"id"`
+	Body *PutCampaignsIdCallingWindowsJSONRequestBody
+}
+
+type PutCampaignsIdCallingWindowsResponseObject interface {
+	VisitPutCampaignsIdCallingWindowsResponse(w http.ResponseWriter) error
+}
+
+type PutCampaignsIdCallingWindows200JSONResponse CampaignManagerCampaign
+
+func (response PutCampaignsIdCallingWindows200JSONResponse) VisitPutCampaignsIdCallingWindowsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutCampaignsIdCallingWindows400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PutCampaignsIdCallingWindows400JSONResponse) VisitPutCampaignsIdCallingWindowsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutCampaignsIdCallingWindows401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response PutCampaignsIdCallingWindows401JSONResponse) VisitPutCampaignsIdCallingWindowsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutCampaignsIdCallingWindows403JSONResponse struct{ PermissionDeniedJSONResponse }
+
+func (response PutCampaignsIdCallingWindows403JSONResponse) VisitPutCampaignsIdCallingWindowsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutCampaignsIdCallingWindows404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response PutCampaignsIdCallingWindows404JSONResponse) VisitPutCampaignsIdCallingWindowsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutCampaignsIdCallingWindows500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PutCampaignsIdCallingWindows500JSONResponse) VisitPutCampaignsIdCallingWindowsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type GetCampaignsIdCampaigncallsRequestObject struct {
 	Id     string `json:"id"`
 	Params GetCampaignsIdCampaigncallsParams
@@ -26610,6 +31408,69 @@ func (response GetCampaignsIdCampaigncalls500JSONResponse) VisitGetCampaignsIdCa
 	return json.NewEncoder(w).Encode(response)
 }
 
+type PutCampaignsIdDialModeRequestObject struct {
+	Id   string `json:"id"`
+	Body *PutCampaignsIdDialModeJSONRequestBody
+}
+
+type PutCampaignsIdDialModeResponseObject interface {
+	VisitPutCampaignsIdDialModeResponse(w http.ResponseWriter) error
+}
+
+type PutCampaignsIdDialMode200JSONResponse CampaignManagerCampaign
+
+func (response PutCampaignsIdDialMode200JSONResponse) VisitPutCampaignsIdDialModeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutCampaignsIdDialMode400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PutCampaignsIdDialMode400JSONResponse) VisitPutCampaignsIdDialModeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutCampaignsIdDialMode401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response PutCampaignsIdDialMode401JSONResponse) VisitPutCampaignsIdDialModeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutCampaignsIdDialMode403JSONResponse struct{ PermissionDeniedJSONResponse }
+
+func (response PutCampaignsIdDialMode403JSONResponse) VisitPutCampaignsIdDialModeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutCampaignsIdDialMode404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response PutCampaignsIdDialMode404JSONResponse) VisitPutCampaignsIdDialModeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutCampaignsIdDialMode500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PutCampaignsIdDialMode500JSONResponse) VisitPutCampaignsIdDialModeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type PutCampaignsIdNextCampaignIdRequestObject struct {
 	Id   string `json:"id"`
 	Body *PutCampaignsIdNextCampaignIdJSONRequestBody
@@ -26736,6 +31597,265 @@ func (response PutCampaignsIdResourceInfo500JSONResponse) VisitPutCampaignsIdRes
 	return json.NewEncoder(w).Encode(response)
 }
 
+type GetCampaignsIdResultjobsRequestObject struct {
+	Id     string `json:"id"`
+	Params GetCampaignsIdResultjobsParams
+}
+
+type GetCampaignsIdResultjobsResponseObject interface {
+	VisitGetCampaignsIdResultjobsResponse(w http.ResponseWriter) error
+}
+
+type GetCampaignsIdResultjobs200JSONResponse struct {
+	// NextPageToken Cursor token for the next page of results. Pass this value as the page_token parameter in the next request.
+	NextPageToken *string                             `json:"next_page_token,omitempty"`
+	Result        *[]CampaignManagerCampaignresultjob `json:"result,omitempty"`
+}
+
+func (response GetCampaignsIdResultjobs200JSONResponse) VisitGetCampaignsIdResultjobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCampaignsIdResultjobs400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetCampaignsIdResultjobs400JSONResponse) VisitGetCampaignsIdResultjobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCampaignsIdResultjobs401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetCampaignsIdResultjobs401JSONResponse) VisitGetCampaignsIdResultjobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCampaignsIdResultjobs403JSONResponse struct{ PermissionDeniedJSONResponse }
+
+func (response GetCampaignsIdResultjobs403JSONResponse) VisitGetCampaignsIdResultjobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCampaignsIdResultjobs404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetCampaignsIdResultjobs404JSONResponse) VisitGetCampaignsIdResultjobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCampaignsIdResultjobs500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetCampaignsIdResultjobs500JSONResponse) VisitGetCampaignsIdResultjobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCampaignsIdResultjobsRequestObject struct {
+	Id string `json:"id"`
+}
+
+type PostCampaignsIdResultjobsResponseObject interface {
+	VisitPostCampaignsIdResultjobsResponse(w http.ResponseWriter) error
+}
+
+type PostCampaignsIdResultjobs200JSONResponse CampaignManagerCampaignresultjob
+
+func (response PostCampaignsIdResultjobs200JSONResponse) VisitPostCampaignsIdResultjobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCampaignsIdResultjobs400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostCampaignsIdResultjobs400JSONResponse) VisitPostCampaignsIdResultjobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCampaignsIdResultjobs401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response PostCampaignsIdResultjobs401JSONResponse) VisitPostCampaignsIdResultjobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCampaignsIdResultjobs403JSONResponse struct{ PermissionDeniedJSONResponse }
+
+func (response PostCampaignsIdResultjobs403JSONResponse) VisitPostCampaignsIdResultjobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCampaignsIdResultjobs404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response PostCampaignsIdResultjobs404JSONResponse) VisitPostCampaignsIdResultjobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCampaignsIdResultjobs500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostCampaignsIdResultjobs500JSONResponse) VisitPostCampaignsIdResultjobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCampaignsIdResultjobsResultjobIdRequestObject struct {
+	Id          string `json:"id"`
+	ResultjobId string `json:"resultjob_id"`
+}
+
+type GetCampaignsIdResultjobsResultjobIdResponseObject interface {
+	VisitGetCampaignsIdResultjobsResultjobIdResponse(w http.ResponseWriter) error
+}
+
+type GetCampaignsIdResultjobsResultjobId200JSONResponse CampaignManagerCampaignresultjob
+
+func (response GetCampaignsIdResultjobsResultjobId200JSONResponse) VisitGetCampaignsIdResultjobsResultjobIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCampaignsIdResultjobsResultjobId400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetCampaignsIdResultjobsResultjobId400JSONResponse) VisitGetCampaignsIdResultjobsResultjobIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCampaignsIdResultjobsResultjobId401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetCampaignsIdResultjobsResultjobId401JSONResponse) VisitGetCampaignsIdResultjobsResultjobIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCampaignsIdResultjobsResultjobId403JSONResponse struct{ PermissionDeniedJSONResponse }
+
+func (response GetCampaignsIdResultjobsResultjobId403JSONResponse) VisitGetCampaignsIdResultjobsResultjobIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCampaignsIdResultjobsResultjobId404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetCampaignsIdResultjobsResultjobId404JSONResponse) VisitGetCampaignsIdResultjobsResultjobIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCampaignsIdResultjobsResultjobId500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetCampaignsIdResultjobsResultjobId500JSONResponse) VisitGetCampaignsIdResultjobsResultjobIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCampaignsIdResultsRequestObject struct {
+	Id     string `json:"id"`
+	Params GetCampaignsIdResultsParams
+}
+
+type GetCampaignsIdResultsResponseObject interface {
+	VisitGetCampaignsIdResultsResponse(w http.ResponseWriter) error
+}
+
+type GetCampaignsIdResults200JSONResponse struct {
+	// NextPageToken Cursor token for the next page of results. Pass this value as the page_token parameter in the next request.
+	NextPageToken *string                          `json:"next_page_token,omitempty"`
+	Result        *[]CampaignManagerCampaignResult `json:"result,omitempty"`
+}
+
+func (response GetCampaignsIdResults200JSONResponse) VisitGetCampaignsIdResultsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCampaignsIdResults400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetCampaignsIdResults400JSONResponse) VisitGetCampaignsIdResultsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCampaignsIdResults401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetCampaignsIdResults401JSONResponse) VisitGetCampaignsIdResultsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCampaignsIdResults403JSONResponse struct{ PermissionDeniedJSONResponse }
+
+func (response GetCampaignsIdResults403JSONResponse) VisitGetCampaignsIdResultsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCampaignsIdResults404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetCampaignsIdResults404JSONResponse) VisitGetCampaignsIdResultsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCampaignsIdResults500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetCampaignsIdResults500JSONResponse) VisitGetCampaignsIdResultsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type PutCampaignsIdServiceLevelRequestObject struct {
 	Id   string `json:"id"`
 	Body *PutCampaignsIdServiceLevelJSONRequestBody
@@ -30003,6 +35123,285 @@ func (response PostConversationsIdUnassign500JSONResponse) VisitPostConversation
 	return json.NewEncoder(w).Encode(response)
 }
 
+type GetCustomToolsRequestObject struct {
+	Params GetCustomToolsParams
+}
+
+type GetCustomToolsResponseObject interface {
+	VisitGetCustomToolsResponse(w http.ResponseWriter) error
+}
+
+type GetCustomTools200JSONResponse struct {
+	// NextPageToken Cursor token for the next page of results. Pass this value as the page_token parameter in the next request.
+	NextPageToken *string                `json:"next_page_token,omitempty"`
+	Result        *[]AIManagerCustomTool `json:"result,omitempty"`
+}
+
+func (response GetCustomTools200JSONResponse) VisitGetCustomToolsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCustomTools401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetCustomTools401JSONResponse) VisitGetCustomToolsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCustomTools500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetCustomTools500JSONResponse) VisitGetCustomToolsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCustomToolsRequestObject struct {
+	Body *PostCustomToolsJSONRequestBody
+}
+
+type PostCustomToolsResponseObject interface {
+	VisitPostCustomToolsResponse(w http.ResponseWriter) error
+}
+
+type PostCustomTools200JSONResponse AIManagerCustomTool
+
+func (response PostCustomTools200JSONResponse) VisitPostCustomToolsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCustomTools400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostCustomTools400JSONResponse) VisitPostCustomToolsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCustomTools401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response PostCustomTools401JSONResponse) VisitPostCustomToolsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCustomTools409JSONResponse struct{ ConflictJSONResponse }
+
+func (response PostCustomTools409JSONResponse) VisitPostCustomToolsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCustomTools500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostCustomTools500JSONResponse) VisitPostCustomToolsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCustomToolsIdRequestObject struct {
+	Id string `json:"id"`
+}
+
+type DeleteCustomToolsIdResponseObject interface {
+	VisitDeleteCustomToolsIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteCustomToolsId200JSONResponse AIManagerCustomTool
+
+func (response DeleteCustomToolsId200JSONResponse) VisitDeleteCustomToolsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCustomToolsId400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DeleteCustomToolsId400JSONResponse) VisitDeleteCustomToolsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCustomToolsId401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response DeleteCustomToolsId401JSONResponse) VisitDeleteCustomToolsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCustomToolsId403JSONResponse struct{ PermissionDeniedJSONResponse }
+
+func (response DeleteCustomToolsId403JSONResponse) VisitDeleteCustomToolsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCustomToolsId404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteCustomToolsId404JSONResponse) VisitDeleteCustomToolsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCustomToolsId500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response DeleteCustomToolsId500JSONResponse) VisitDeleteCustomToolsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCustomToolsIdRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetCustomToolsIdResponseObject interface {
+	VisitGetCustomToolsIdResponse(w http.ResponseWriter) error
+}
+
+type GetCustomToolsId200JSONResponse AIManagerCustomTool
+
+func (response GetCustomToolsId200JSONResponse) VisitGetCustomToolsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCustomToolsId400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetCustomToolsId400JSONResponse) VisitGetCustomToolsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCustomToolsId401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetCustomToolsId401JSONResponse) VisitGetCustomToolsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCustomToolsId403JSONResponse struct{ PermissionDeniedJSONResponse }
+
+func (response GetCustomToolsId403JSONResponse) VisitGetCustomToolsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCustomToolsId404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetCustomToolsId404JSONResponse) VisitGetCustomToolsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCustomToolsId500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetCustomToolsId500JSONResponse) VisitGetCustomToolsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutCustomToolsIdRequestObject struct {
+	Id   string `json:"id"`
+	Body *PutCustomToolsIdJSONRequestBody
+}
+
+type PutCustomToolsIdResponseObject interface {
+	VisitPutCustomToolsIdResponse(w http.ResponseWriter) error
+}
+
+type PutCustomToolsId200JSONResponse AIManagerCustomTool
+
+func (response PutCustomToolsId200JSONResponse) VisitPutCustomToolsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutCustomToolsId400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PutCustomToolsId400JSONResponse) VisitPutCustomToolsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutCustomToolsId401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response PutCustomToolsId401JSONResponse) VisitPutCustomToolsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutCustomToolsId403JSONResponse struct{ PermissionDeniedJSONResponse }
+
+func (response PutCustomToolsId403JSONResponse) VisitPutCustomToolsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutCustomToolsId404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response PutCustomToolsId404JSONResponse) VisitPutCustomToolsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutCustomToolsId500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PutCustomToolsId500JSONResponse) VisitPutCustomToolsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type GetCustomerRequestObject struct {
 }
 
@@ -30707,6 +36106,276 @@ func (response PostCustomersIdRecover500JSONResponse) VisitPostCustomersIdRecove
 	return json.NewEncoder(w).Encode(response)
 }
 
+type GetDispositionsRequestObject struct {
+	Params GetDispositionsParams
+}
+
+type GetDispositionsResponseObject interface {
+	VisitGetDispositionsResponse(w http.ResponseWriter) error
+}
+
+type GetDispositions200JSONResponse struct {
+	// NextPageToken Cursor token for the next page of results. Pass this value as the page_token parameter in the next request.
+	NextPageToken *string                       `json:"next_page_token,omitempty"`
+	Result        *[]CampaignManagerDisposition `json:"result,omitempty"`
+}
+
+func (response GetDispositions200JSONResponse) VisitGetDispositionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDispositions401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetDispositions401JSONResponse) VisitGetDispositionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDispositions500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetDispositions500JSONResponse) VisitGetDispositionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostDispositionsRequestObject struct {
+	Body *PostDispositionsJSONRequestBody
+}
+
+type PostDispositionsResponseObject interface {
+	VisitPostDispositionsResponse(w http.ResponseWriter) error
+}
+
+type PostDispositions200JSONResponse CampaignManagerDisposition
+
+func (response PostDispositions200JSONResponse) VisitPostDispositionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostDispositions400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostDispositions400JSONResponse) VisitPostDispositionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostDispositions401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response PostDispositions401JSONResponse) VisitPostDispositionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostDispositions500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostDispositions500JSONResponse) VisitPostDispositionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteDispositionsIdRequestObject struct {
+	Id string `json:"id"`
+}
+
+type DeleteDispositionsIdResponseObject interface {
+	VisitDeleteDispositionsIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteDispositionsId200JSONResponse CampaignManagerDisposition
+
+func (response DeleteDispositionsId200JSONResponse) VisitDeleteDispositionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteDispositionsId400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DeleteDispositionsId400JSONResponse) VisitDeleteDispositionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteDispositionsId401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response DeleteDispositionsId401JSONResponse) VisitDeleteDispositionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteDispositionsId403JSONResponse struct{ PermissionDeniedJSONResponse }
+
+func (response DeleteDispositionsId403JSONResponse) VisitDeleteDispositionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteDispositionsId404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteDispositionsId404JSONResponse) VisitDeleteDispositionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteDispositionsId500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response DeleteDispositionsId500JSONResponse) VisitDeleteDispositionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDispositionsIdRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetDispositionsIdResponseObject interface {
+	VisitGetDispositionsIdResponse(w http.ResponseWriter) error
+}
+
+type GetDispositionsId200JSONResponse CampaignManagerDisposition
+
+func (response GetDispositionsId200JSONResponse) VisitGetDispositionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDispositionsId400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetDispositionsId400JSONResponse) VisitGetDispositionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDispositionsId401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetDispositionsId401JSONResponse) VisitGetDispositionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDispositionsId403JSONResponse struct{ PermissionDeniedJSONResponse }
+
+func (response GetDispositionsId403JSONResponse) VisitGetDispositionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDispositionsId404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetDispositionsId404JSONResponse) VisitGetDispositionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDispositionsId500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetDispositionsId500JSONResponse) VisitGetDispositionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutDispositionsIdRequestObject struct {
+	Id   string `json:"id"`
+	Body *PutDispositionsIdJSONRequestBody
+}
+
+type PutDispositionsIdResponseObject interface {
+	VisitPutDispositionsIdResponse(w http.ResponseWriter) error
+}
+
+type PutDispositionsId200JSONResponse CampaignManagerDisposition
+
+func (response PutDispositionsId200JSONResponse) VisitPutDispositionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutDispositionsId400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PutDispositionsId400JSONResponse) VisitPutDispositionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutDispositionsId401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response PutDispositionsId401JSONResponse) VisitPutDispositionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutDispositionsId403JSONResponse struct{ PermissionDeniedJSONResponse }
+
+func (response PutDispositionsId403JSONResponse) VisitPutDispositionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutDispositionsId404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response PutDispositionsId404JSONResponse) VisitPutDispositionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutDispositionsId500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PutDispositionsId500JSONResponse) VisitPutDispositionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type GetEmailsRequestObject struct {
 	Params GetEmailsParams
 }
@@ -31919,6 +37588,365 @@ func (response PostGroupcallsIdHangup503JSONResponse) VisitPostGroupcallsIdHangu
 	return json.NewEncoder(w).Encode(response)
 }
 
+type GetMcpServersRequestObject struct {
+	Params GetMcpServersParams
+}
+
+type GetMcpServersResponseObject interface {
+	VisitGetMcpServersResponse(w http.ResponseWriter) error
+}
+
+type GetMcpServers200JSONResponse struct {
+	// NextPageToken Cursor token for the next page of results. Pass this value as the page_token parameter in the next request.
+	NextPageToken *string               `json:"next_page_token,omitempty"`
+	Result        *[]AIManagerMCPServer `json:"result,omitempty"`
+}
+
+func (response GetMcpServers200JSONResponse) VisitGetMcpServersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMcpServers401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetMcpServers401JSONResponse) VisitGetMcpServersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMcpServers500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetMcpServers500JSONResponse) VisitGetMcpServersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostMcpServersRequestObject struct {
+	Body *PostMcpServersJSONRequestBody
+}
+
+type PostMcpServersResponseObject interface {
+	VisitPostMcpServersResponse(w http.ResponseWriter) error
+}
+
+type PostMcpServers200JSONResponse AIManagerMCPServer
+
+func (response PostMcpServers200JSONResponse) VisitPostMcpServersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostMcpServers400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostMcpServers400JSONResponse) VisitPostMcpServersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostMcpServers401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response PostMcpServers401JSONResponse) VisitPostMcpServersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostMcpServers409JSONResponse struct{ ConflictJSONResponse }
+
+func (response PostMcpServers409JSONResponse) VisitPostMcpServersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostMcpServers500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostMcpServers500JSONResponse) VisitPostMcpServersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteMcpServersIdRequestObject struct {
+	Id string `json:"id"`
+}
+
+type DeleteMcpServersIdResponseObject interface {
+	VisitDeleteMcpServersIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteMcpServersId200JSONResponse AIManagerMCPServer
+
+func (response DeleteMcpServersId200JSONResponse) VisitDeleteMcpServersIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteMcpServersId400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DeleteMcpServersId400JSONResponse) VisitDeleteMcpServersIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteMcpServersId401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response DeleteMcpServersId401JSONResponse) VisitDeleteMcpServersIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteMcpServersId403JSONResponse struct{ PermissionDeniedJSONResponse }
+
+func (response DeleteMcpServersId403JSONResponse) VisitDeleteMcpServersIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteMcpServersId404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteMcpServersId404JSONResponse) VisitDeleteMcpServersIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteMcpServersId500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response DeleteMcpServersId500JSONResponse) VisitDeleteMcpServersIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMcpServersIdRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetMcpServersIdResponseObject interface {
+	VisitGetMcpServersIdResponse(w http.ResponseWriter) error
+}
+
+type GetMcpServersId200JSONResponse AIManagerMCPServer
+
+func (response GetMcpServersId200JSONResponse) VisitGetMcpServersIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMcpServersId400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetMcpServersId400JSONResponse) VisitGetMcpServersIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMcpServersId401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetMcpServersId401JSONResponse) VisitGetMcpServersIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMcpServersId403JSONResponse struct{ PermissionDeniedJSONResponse }
+
+func (response GetMcpServersId403JSONResponse) VisitGetMcpServersIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMcpServersId404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetMcpServersId404JSONResponse) VisitGetMcpServersIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMcpServersId500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetMcpServersId500JSONResponse) VisitGetMcpServersIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutMcpServersIdRequestObject struct {
+	Id   string `json:"id"`
+	Body *PutMcpServersIdJSONRequestBody
+}
+
+type PutMcpServersIdResponseObject interface {
+	VisitPutMcpServersIdResponse(w http.ResponseWriter) error
+}
+
+type PutMcpServersId200JSONResponse AIManagerMCPServer
+
+func (response PutMcpServersId200JSONResponse) VisitPutMcpServersIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutMcpServersId400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PutMcpServersId400JSONResponse) VisitPutMcpServersIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutMcpServersId401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response PutMcpServersId401JSONResponse) VisitPutMcpServersIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutMcpServersId403JSONResponse struct{ PermissionDeniedJSONResponse }
+
+func (response PutMcpServersId403JSONResponse) VisitPutMcpServersIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutMcpServersId404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response PutMcpServersId404JSONResponse) VisitPutMcpServersIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutMcpServersId409JSONResponse struct{ ConflictJSONResponse }
+
+func (response PutMcpServersId409JSONResponse) VisitPutMcpServersIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutMcpServersId500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PutMcpServersId500JSONResponse) VisitPutMcpServersIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMcpServersIdToolsRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetMcpServersIdToolsResponseObject interface {
+	VisitGetMcpServersIdToolsResponse(w http.ResponseWriter) error
+}
+
+type GetMcpServersIdTools200JSONResponse []AIManagerMCPServerTool
+
+func (response GetMcpServersIdTools200JSONResponse) VisitGetMcpServersIdToolsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMcpServersIdTools400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetMcpServersIdTools400JSONResponse) VisitGetMcpServersIdToolsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMcpServersIdTools401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetMcpServersIdTools401JSONResponse) VisitGetMcpServersIdToolsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMcpServersIdTools403JSONResponse struct{ PermissionDeniedJSONResponse }
+
+func (response GetMcpServersIdTools403JSONResponse) VisitGetMcpServersIdToolsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMcpServersIdTools404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetMcpServersIdTools404JSONResponse) VisitGetMcpServersIdToolsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMcpServersIdTools500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetMcpServersIdTools500JSONResponse) VisitGetMcpServersIdToolsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMcpServersIdTools503JSONResponse struct{ UnavailableJSONResponse }
+
+func (response GetMcpServersIdTools503JSONResponse) VisitGetMcpServersIdToolsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type GetMeRequestObject struct {
 }
 
@@ -33421,6 +39449,199 @@ func (response PutOutdialsIdData500JSONResponse) VisitPutOutdialsIdDataResponse(
 	return json.NewEncoder(w).Encode(response)
 }
 
+type GetOutdialsIdTargetjobsRequestObject struct {
+	Id     string `json:"id"`
+	Params GetOutdialsIdTargetjobsParams
+}
+
+type GetOutdialsIdTargetjobsResponseObject interface {
+	VisitGetOutdialsIdTargetjobsResponse(w http.ResponseWriter) error
+}
+
+type GetOutdialsIdTargetjobs200JSONResponse struct {
+	// NextPageToken Cursor token for the next page of results. Pass this value as the page_token parameter in the next request.
+	NextPageToken *string                           `json:"next_page_token,omitempty"`
+	Result        *[]OutdialManagerOutdialtargetjob `json:"result,omitempty"`
+}
+
+func (response GetOutdialsIdTargetjobs200JSONResponse) VisitGetOutdialsIdTargetjobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOutdialsIdTargetjobs400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetOutdialsIdTargetjobs400JSONResponse) VisitGetOutdialsIdTargetjobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOutdialsIdTargetjobs401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetOutdialsIdTargetjobs401JSONResponse) VisitGetOutdialsIdTargetjobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOutdialsIdTargetjobs403JSONResponse struct{ PermissionDeniedJSONResponse }
+
+func (response GetOutdialsIdTargetjobs403JSONResponse) VisitGetOutdialsIdTargetjobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOutdialsIdTargetjobs404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetOutdialsIdTargetjobs404JSONResponse) VisitGetOutdialsIdTargetjobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOutdialsIdTargetjobs500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetOutdialsIdTargetjobs500JSONResponse) VisitGetOutdialsIdTargetjobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostOutdialsIdTargetjobsRequestObject struct {
+	Id   string `json:"id"`
+	Body *PostOutdialsIdTargetjobsJSONRequestBody
+}
+
+type PostOutdialsIdTargetjobsResponseObject interface {
+	VisitPostOutdialsIdTargetjobsResponse(w http.ResponseWriter) error
+}
+
+type PostOutdialsIdTargetjobs200JSONResponse OutdialManagerOutdialtargetjob
+
+func (response PostOutdialsIdTargetjobs200JSONResponse) VisitPostOutdialsIdTargetjobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostOutdialsIdTargetjobs400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostOutdialsIdTargetjobs400JSONResponse) VisitPostOutdialsIdTargetjobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostOutdialsIdTargetjobs401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response PostOutdialsIdTargetjobs401JSONResponse) VisitPostOutdialsIdTargetjobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostOutdialsIdTargetjobs403JSONResponse struct{ PermissionDeniedJSONResponse }
+
+func (response PostOutdialsIdTargetjobs403JSONResponse) VisitPostOutdialsIdTargetjobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostOutdialsIdTargetjobs404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response PostOutdialsIdTargetjobs404JSONResponse) VisitPostOutdialsIdTargetjobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostOutdialsIdTargetjobs500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostOutdialsIdTargetjobs500JSONResponse) VisitPostOutdialsIdTargetjobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOutdialsIdTargetjobsTargetjobIdRequestObject struct {
+	Id          string `json:"id"`
+	TargetjobId string `json:"targetjob_id"`
+}
+
+type GetOutdialsIdTargetjobsTargetjobIdResponseObject interface {
+	VisitGetOutdialsIdTargetjobsTargetjobIdResponse(w http.ResponseWriter) error
+}
+
+type GetOutdialsIdTargetjobsTargetjobId200JSONResponse OutdialManagerOutdialtargetjob
+
+func (response GetOutdialsIdTargetjobsTargetjobId200JSONResponse) VisitGetOutdialsIdTargetjobsTargetjobIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOutdialsIdTargetjobsTargetjobId400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetOutdialsIdTargetjobsTargetjobId400JSONResponse) VisitGetOutdialsIdTargetjobsTargetjobIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOutdialsIdTargetjobsTargetjobId401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetOutdialsIdTargetjobsTargetjobId401JSONResponse) VisitGetOutdialsIdTargetjobsTargetjobIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOutdialsIdTargetjobsTargetjobId403JSONResponse struct{ PermissionDeniedJSONResponse }
+
+func (response GetOutdialsIdTargetjobsTargetjobId403JSONResponse) VisitGetOutdialsIdTargetjobsTargetjobIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOutdialsIdTargetjobsTargetjobId404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetOutdialsIdTargetjobsTargetjobId404JSONResponse) VisitGetOutdialsIdTargetjobsTargetjobIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOutdialsIdTargetjobsTargetjobId500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetOutdialsIdTargetjobsTargetjobId500JSONResponse) VisitGetOutdialsIdTargetjobsTargetjobIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type GetOutdialsIdTargetsRequestObject struct {
 	Id     string `json:"id"`
 	Params GetOutdialsIdTargetsParams
@@ -33677,6 +39898,70 @@ func (response GetOutdialsIdTargetsTargetId500JSONResponse) VisitGetOutdialsIdTa
 	return json.NewEncoder(w).Encode(response)
 }
 
+type PutOutdialsIdTargetsTargetIdCallbackRequestObject struct {
+	Id       string `json:"id"`
+	TargetId string `json:"target_id"`
+	Body     *PutOutdialsIdTargetsTargetIdCallbackJSONRequestBody
+}
+
+type PutOutdialsIdTargetsTargetIdCallbackResponseObject interface {
+	VisitPutOutdialsIdTargetsTargetIdCallbackResponse(w http.ResponseWriter) error
+}
+
+type PutOutdialsIdTargetsTargetIdCallback200JSONResponse OutdialManagerOutdialtarget
+
+func (response PutOutdialsIdTargetsTargetIdCallback200JSONResponse) VisitPutOutdialsIdTargetsTargetIdCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutOutdialsIdTargetsTargetIdCallback400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PutOutdialsIdTargetsTargetIdCallback400JSONResponse) VisitPutOutdialsIdTargetsTargetIdCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutOutdialsIdTargetsTargetIdCallback401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response PutOutdialsIdTargetsTargetIdCallback401JSONResponse) VisitPutOutdialsIdTargetsTargetIdCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutOutdialsIdTargetsTargetIdCallback403JSONResponse struct{ PermissionDeniedJSONResponse }
+
+func (response PutOutdialsIdTargetsTargetIdCallback403JSONResponse) VisitPutOutdialsIdTargetsTargetIdCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutOutdialsIdTargetsTargetIdCallback404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response PutOutdialsIdTargetsTargetIdCallback404JSONResponse) VisitPutOutdialsIdTargetsTargetIdCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutOutdialsIdTargetsTargetIdCallback500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PutOutdialsIdTargetsTargetIdCallback500JSONResponse) VisitPutOutdialsIdTargetsTargetIdCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type GetOutplansRequestObject struct {
 	Params GetOutplansParams
 }
@@ -41216,6 +47501,364 @@ func (response GetStorageFilesIdFile500JSONResponse) VisitGetStorageFilesIdFileR
 	return json.NewEncoder(w).Encode(response)
 }
 
+type GetSuppressionBlocksRequestObject struct {
+	Params GetSuppressionBlocksParams
+}
+
+type GetSuppressionBlocksResponseObject interface {
+	VisitGetSuppressionBlocksResponse(w http.ResponseWriter) error
+}
+
+type GetSuppressionBlocks200JSONResponse struct {
+	// NextPageToken Cursor token for the next page of results. Pass this value as the page_token parameter in the next request.
+	NextPageToken *string                           `json:"next_page_token,omitempty"`
+	Result        *[]OutdialManagerSuppressionBlock `json:"result,omitempty"`
+}
+
+func (response GetSuppressionBlocks200JSONResponse) VisitGetSuppressionBlocksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSuppressionBlocks401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetSuppressionBlocks401JSONResponse) VisitGetSuppressionBlocksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSuppressionBlocks500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetSuppressionBlocks500JSONResponse) VisitGetSuppressionBlocksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSuppressionBlocksIdRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetSuppressionBlocksIdResponseObject interface {
+	VisitGetSuppressionBlocksIdResponse(w http.ResponseWriter) error
+}
+
+type GetSuppressionBlocksId200JSONResponse OutdialManagerSuppressionBlock
+
+func (response GetSuppressionBlocksId200JSONResponse) VisitGetSuppressionBlocksIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSuppressionBlocksId400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetSuppressionBlocksId400JSONResponse) VisitGetSuppressionBlocksIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSuppressionBlocksId401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetSuppressionBlocksId401JSONResponse) VisitGetSuppressionBlocksIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSuppressionBlocksId403JSONResponse struct{ PermissionDeniedJSONResponse }
+
+func (response GetSuppressionBlocksId403JSONResponse) VisitGetSuppressionBlocksIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSuppressionBlocksId404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetSuppressionBlocksId404JSONResponse) VisitGetSuppressionBlocksIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSuppressionBlocksId500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetSuppressionBlocksId500JSONResponse) VisitGetSuppressionBlocksIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSuppressionsRequestObject struct {
+	Params GetSuppressionsParams
+}
+
+type GetSuppressionsResponseObject interface {
+	VisitGetSuppressionsResponse(w http.ResponseWriter) error
+}
+
+type GetSuppressions200JSONResponse struct {
+	// NextPageToken Cursor token for the next page of results. Pass this value as the page_token parameter in the next request.
+	NextPageToken *string                      `json:"next_page_token,omitempty"`
+	Result        *[]OutdialManagerSuppression `json:"result,omitempty"`
+}
+
+func (response GetSuppressions200JSONResponse) VisitGetSuppressionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSuppressions401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetSuppressions401JSONResponse) VisitGetSuppressionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSuppressions500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetSuppressions500JSONResponse) VisitGetSuppressionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSuppressionsRequestObject struct {
+	Body *PostSuppressionsJSONRequestBody
+}
+
+type PostSuppressionsResponseObject interface {
+	VisitPostSuppressionsResponse(w http.ResponseWriter) error
+}
+
+type PostSuppressions200JSONResponse OutdialManagerSuppression
+
+func (response PostSuppressions200JSONResponse) VisitPostSuppressionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSuppressions400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostSuppressions400JSONResponse) VisitPostSuppressionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSuppressions401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response PostSuppressions401JSONResponse) VisitPostSuppressionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSuppressions500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostSuppressions500JSONResponse) VisitPostSuppressionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSuppressionsImportRequestObject struct {
+	Body *PostSuppressionsImportJSONRequestBody
+}
+
+type PostSuppressionsImportResponseObject interface {
+	VisitPostSuppressionsImportResponse(w http.ResponseWriter) error
+}
+
+type PostSuppressionsImport200JSONResponse struct {
+	// Imported The number of the imported suppressions.
+	Imported *int `json:"imported,omitempty"`
+
+	// Skipped The number of the skipped targets.
+	Skipped *int `json:"skipped,omitempty"`
+}
+
+func (response PostSuppressionsImport200JSONResponse) VisitPostSuppressionsImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSuppressionsImport400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostSuppressionsImport400JSONResponse) VisitPostSuppressionsImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSuppressionsImport401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response PostSuppressionsImport401JSONResponse) VisitPostSuppressionsImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSuppressionsImport500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostSuppressionsImport500JSONResponse) VisitPostSuppressionsImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSuppressionsIdRequestObject struct {
+	Id string `json:"id"`
+}
+
+type DeleteSuppressionsIdResponseObject interface {
+	VisitDeleteSuppressionsIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteSuppressionsId200JSONResponse OutdialManagerSuppression
+
+func (response DeleteSuppressionsId200JSONResponse) VisitDeleteSuppressionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSuppressionsId400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DeleteSuppressionsId400JSONResponse) VisitDeleteSuppressionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSuppressionsId401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response DeleteSuppressionsId401JSONResponse) VisitDeleteSuppressionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSuppressionsId403JSONResponse struct{ PermissionDeniedJSONResponse }
+
+func (response DeleteSuppressionsId403JSONResponse) VisitDeleteSuppressionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSuppressionsId404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteSuppressionsId404JSONResponse) VisitDeleteSuppressionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSuppressionsId500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response DeleteSuppressionsId500JSONResponse) VisitDeleteSuppressionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSuppressionsIdRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetSuppressionsIdResponseObject interface {
+	VisitGetSuppressionsIdResponse(w http.ResponseWriter) error
+}
+
+type GetSuppressionsId200JSONResponse OutdialManagerSuppression
+
+func (response GetSuppressionsId200JSONResponse) VisitGetSuppressionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSuppressionsId400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetSuppressionsId400JSONResponse) VisitGetSuppressionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSuppressionsId401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetSuppressionsId401JSONResponse) VisitGetSuppressionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSuppressionsId403JSONResponse struct{ PermissionDeniedJSONResponse }
+
+func (response GetSuppressionsId403JSONResponse) VisitGetSuppressionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSuppressionsId404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetSuppressionsId404JSONResponse) VisitGetSuppressionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSuppressionsId500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetSuppressionsId500JSONResponse) VisitGetSuppressionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type GetTagsRequestObject struct {
 	Params GetTagsParams
 }
@@ -41818,6 +48461,519 @@ func (response PostTeamsIdDirectHashRegenerate500JSONResponse) VisitPostTeamsIdD
 	return json.NewEncoder(w).Encode(response)
 }
 
+type GetTestRunsRequestObject struct {
+	Params GetTestRunsParams
+}
+
+type GetTestRunsResponseObject interface {
+	VisitGetTestRunsResponse(w http.ResponseWriter) error
+}
+
+type GetTestRuns200JSONResponse struct {
+	// NextPageToken Cursor token for the next page of results. Pass this value as the page_token parameter in the next request.
+	NextPageToken *string             `json:"next_page_token,omitempty"`
+	Result        *[]AIManagerTestRun `json:"result,omitempty"`
+}
+
+func (response GetTestRuns200JSONResponse) VisitGetTestRunsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTestRuns401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetTestRuns401JSONResponse) VisitGetTestRunsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTestRuns500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetTestRuns500JSONResponse) VisitGetTestRunsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTestRunsIdRequestObject struct {
+	Id string `json:"id"`
+}
+
+type DeleteTestRunsIdResponseObject interface {
+	VisitDeleteTestRunsIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteTestRunsId200JSONResponse AIManagerTestRun
+
+func (response DeleteTestRunsId200JSONResponse) VisitDeleteTestRunsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTestRunsId400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DeleteTestRunsId400JSONResponse) VisitDeleteTestRunsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTestRunsId401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response DeleteTestRunsId401JSONResponse) VisitDeleteTestRunsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTestRunsId403JSONResponse struct{ PermissionDeniedJSONResponse }
+
+func (response DeleteTestRunsId403JSONResponse) VisitDeleteTestRunsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTestRunsId404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteTestRunsId404JSONResponse) VisitDeleteTestRunsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTestRunsId500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response DeleteTestRunsId500JSONResponse) VisitDeleteTestRunsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTestRunsIdRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetTestRunsIdResponseObject interface {
+	VisitGetTestRunsIdResponse(w http.ResponseWriter) error
+}
+
+type GetTestRunsId200JSONResponse AIManagerTestRun
+
+func (response GetTestRunsId200JSONResponse) VisitGetTestRunsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTestRunsId400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetTestRunsId400JSONResponse) VisitGetTestRunsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTestRunsId401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetTestRunsId401JSONResponse) VisitGetTestRunsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTestRunsId403JSONResponse struct{ PermissionDeniedJSONResponse }
+
+func (response GetTestRunsId403JSONResponse) VisitGetTestRunsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTestRunsId404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetTestRunsId404JSONResponse) VisitGetTestRunsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTestRunsId500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetTestRunsId500JSONResponse) VisitGetTestRunsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTestSuitesRequestObject struct {
+	Params GetTestSuitesParams
+}
+
+type GetTestSuitesResponseObject interface {
+	VisitGetTestSuitesResponse(w http.ResponseWriter) error
+}
+
+type GetTestSuites200JSONResponse struct {
+	// NextPageToken Cursor token for the next page of results. Pass this value as the page_token parameter in the next request.
+	NextPageToken *string               `json:"next_page_token,omitempty"`
+	Result        *[]AIManagerTestSuite `json:"result,omitempty"`
+}
+
+func (response GetTestSuites200JSONResponse) VisitGetTestSuitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTestSuites401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetTestSuites401JSONResponse) VisitGetTestSuitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTestSuites500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetTestSuites500JSONResponse) VisitGetTestSuitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTestSuitesRequestObject struct {
+	Body *PostTestSuitesJSONRequestBody
+}
+
+type PostTestSuitesResponseObject interface {
+	VisitPostTestSuitesResponse(w http.ResponseWriter) error
+}
+
+type PostTestSuites200JSONResponse AIManagerTestSuite
+
+func (response PostTestSuites200JSONResponse) VisitPostTestSuitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTestSuites400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostTestSuites400JSONResponse) VisitPostTestSuitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTestSuites401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response PostTestSuites401JSONResponse) VisitPostTestSuitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTestSuites404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response PostTestSuites404JSONResponse) VisitPostTestSuitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTestSuites500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostTestSuites500JSONResponse) VisitPostTestSuitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTestSuitesIdRequestObject struct {
+	Id string `json:"id"`
+}
+
+type DeleteTestSuitesIdResponseObject interface {
+	VisitDeleteTestSuitesIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteTestSuitesId200JSONResponse AIManagerTestSuite
+
+func (response DeleteTestSuitesId200JSONResponse) VisitDeleteTestSuitesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTestSuitesId400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DeleteTestSuitesId400JSONResponse) VisitDeleteTestSuitesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTestSuitesId401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response DeleteTestSuitesId401JSONResponse) VisitDeleteTestSuitesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTestSuitesId403JSONResponse struct{ PermissionDeniedJSONResponse }
+
+func (response DeleteTestSuitesId403JSONResponse) VisitDeleteTestSuitesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTestSuitesId404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteTestSuitesId404JSONResponse) VisitDeleteTestSuitesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTestSuitesId500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response DeleteTestSuitesId500JSONResponse) VisitDeleteTestSuitesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTestSuitesIdRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetTestSuitesIdResponseObject interface {
+	VisitGetTestSuitesIdResponse(w http.ResponseWriter) error
+}
+
+type GetTestSuitesId200JSONResponse AIManagerTestSuite
+
+func (response GetTestSuitesId200JSONResponse) VisitGetTestSuitesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTestSuitesId400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetTestSuitesId400JSONResponse) VisitGetTestSuitesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTestSuitesId401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetTestSuitesId401JSONResponse) VisitGetTestSuitesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTestSuitesId403JSONResponse struct{ PermissionDeniedJSONResponse }
+
+func (response GetTestSuitesId403JSONResponse) VisitGetTestSuitesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTestSuitesId404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetTestSuitesId404JSONResponse) VisitGetTestSuitesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTestSuitesId500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetTestSuitesId500JSONResponse) VisitGetTestSuitesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutTestSuitesIdRequestObject struct {
+	Id   string `json:"id"`
+	Body *PutTestSuitesIdJSONRequestBody
+}
+
+type PutTestSuitesIdResponseObject interface {
+	VisitPutTestSuitesIdResponse(w http.ResponseWriter) error
+}
+
+type PutTestSuitesId200JSONResponse AIManagerTestSuite
+
+func (response PutTestSuitesId200JSONResponse) VisitPutTestSuitesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutTestSuitesId400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PutTestSuitesId400JSONResponse) VisitPutTestSuitesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutTestSuitesId401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response PutTestSuitesId401JSONResponse) VisitPutTestSuitesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutTestSuitesId403JSONResponse struct{ PermissionDeniedJSONResponse }
+
+func (response PutTestSuitesId403JSONResponse) VisitPutTestSuitesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutTestSuitesId404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response PutTestSuitesId404JSONResponse) VisitPutTestSuitesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutTestSuitesId500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PutTestSuitesId500JSONResponse) VisitPutTestSuitesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTestSuitesIdRunRequestObject struct {
+	Id string `json:"id"`
+}
+
+type PostTestSuitesIdRunResponseObject interface {
+	VisitPostTestSuitesIdRunResponse(w http.ResponseWriter) error
+}
+
+type PostTestSuitesIdRun200JSONResponse AIManagerTestRun
+
+func (response PostTestSuitesIdRun200JSONResponse) VisitPostTestSuitesIdRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTestSuitesIdRun400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostTestSuitesIdRun400JSONResponse) VisitPostTestSuitesIdRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTestSuitesIdRun401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response PostTestSuitesIdRun401JSONResponse) VisitPostTestSuitesIdRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTestSuitesIdRun403JSONResponse struct{ PermissionDeniedJSONResponse }
+
+func (response PostTestSuitesIdRun403JSONResponse) VisitPostTestSuitesIdRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTestSuitesIdRun404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response PostTestSuitesIdRun404JSONResponse) VisitPostTestSuitesIdRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTestSuitesIdRun429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response PostTestSuitesIdRun429JSONResponse) VisitPostTestSuitesIdRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTestSuitesIdRun500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostTestSuitesIdRun500JSONResponse) VisitPostTestSuitesIdRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type GetTimelineAnalysesRequestObject struct {
 	Params GetTimelineAnalysesParams
 }
@@ -43909,6 +51065,21 @@ type StrictServerInterface interface {
 	// Get aggregated timeline events
 	// (GET /aggregated-events)
 	GetAggregatedEvents(ctx context.Context, request GetAggregatedEventsRequestObject) (GetAggregatedEventsResponseObject, error)
+	// Gets a list of ai assists.
+	// (GET /aiassists)
+	GetAiassists(ctx context.Context, request GetAiassistsRequestObject) (GetAiassistsResponseObject, error)
+	// Start a new ai assist.
+	// (POST /aiassists)
+	PostAiassists(ctx context.Context, request PostAiassistsRequestObject) (PostAiassistsResponseObject, error)
+	// Delete an ai assist.
+	// (DELETE /aiassists/{id})
+	DeleteAiassistsId(ctx context.Context, request DeleteAiassistsIdRequestObject) (DeleteAiassistsIdResponseObject, error)
+	// Get ai assist details.
+	// (GET /aiassists/{id})
+	GetAiassistsId(ctx context.Context, request GetAiassistsIdRequestObject) (GetAiassistsIdResponseObject, error)
+	// Stop an ai assist.
+	// (POST /aiassists/{id}/stop)
+	PostAiassistsIdStop(ctx context.Context, request PostAiassistsIdStopRequestObject) (PostAiassistsIdStopResponseObject, error)
 	// Gets a list of AI audits.
 	// (GET /aiaudits)
 	GetAiaudits(ctx context.Context, request GetAiauditsRequestObject) (GetAiauditsResponseObject, error)
@@ -43999,6 +51170,24 @@ type StrictServerInterface interface {
 	// Get a single AI prompt history entry.
 	// (GET /ais/{id}/prompt_histories/{history_id})
 	GetAisIdPromptHistoriesHistoryId(ctx context.Context, request GetAisIdPromptHistoriesHistoryIdRequestObject) (GetAisIdPromptHistoriesHistoryIdResponseObject, error)
+	// Gets a list of ai extractions.
+	// (GET /aiextractions)
+	GetAiextractions(ctx context.Context, request GetAiextractionsRequestObject) (GetAiextractionsResponseObject, error)
+	// Create a new ai extraction.
+	// (POST /aiextractions)
+	PostAiextractions(ctx context.Context, request PostAiextractionsRequestObject) (PostAiextractionsResponseObject, error)
+	// Delete an ai extraction.
+	// (DELETE /aiextractions/{id})
+	DeleteAiextractionsId(ctx context.Context, request DeleteAiextractionsIdRequestObject) (DeleteAiextractionsIdResponseObject, error)
+	// Get ai extraction details.
+	// (GET /aiextractions/{id})
+	GetAiextractionsId(ctx context.Context, request GetAiextractionsIdRequestObject) (GetAiextractionsIdResponseObject, error)
+	// Gets a list of AI guardrail violations.
+	// (GET /aiguardrailviolations)
+	GetAiguardrailviolations(ctx context.Context, request GetAiguardrailviolationsRequestObject) (GetAiguardrailviolationsResponseObject, error)
+	// Get AI guardrail violation details.
+	// (GET /aiguardrailviolations/{id})
+	GetAiguardrailviolationsId(ctx context.Context, request GetAiguardrailviolationsIdRequestObject) (GetAiguardrailviolationsIdResponseObject, error)
 	// Gets a list of ai summaries.
 	// (GET /aisummaries)
 	GetAisummaries(ctx context.Context, request GetAisummariesRequestObject) (GetAisummariesResponseObject, error)
@@ -44050,6 +51239,9 @@ type StrictServerInterface interface {
 	// Update billing account payment info
 	// (PUT /billing_account/payment_info)
 	PutBillingAccountPaymentInfo(ctx context.Context, request PutBillingAccountPaymentInfoRequestObject) (PutBillingAccountPaymentInfoResponseObject, error)
+	// Update billing account spending settings
+	// (PUT /billing_account/spending_settings)
+	PutBillingAccountSpendingSettings(ctx context.Context, request PutBillingAccountSpendingSettingsRequestObject) (PutBillingAccountSpendingSettingsResponseObject, error)
 	// Get list of billing accounts
 	// (GET /billing_accounts)
 	GetBillingAccounts(ctx context.Context, request GetBillingAccountsRequestObject) (GetBillingAccountsResponseObject, error)
@@ -44068,6 +51260,15 @@ type StrictServerInterface interface {
 	// Update billing account's payment info
 	// (PUT /billing_accounts/{id}/payment_info)
 	PutBillingAccountsIdPaymentInfo(ctx context.Context, request PutBillingAccountsIdPaymentInfoRequestObject) (PutBillingAccountsIdPaymentInfoResponseObject, error)
+	// Quote a usage cost
+	// (POST /billing_estimates)
+	PostBillingEstimates(ctx context.Context, request PostBillingEstimatesRequestObject) (PostBillingEstimatesResponseObject, error)
+	// Get list of billing statements
+	// (GET /billing_statements)
+	GetBillingStatements(ctx context.Context, request GetBillingStatementsRequestObject) (GetBillingStatementsResponseObject, error)
+	// Get a billing statement by ID
+	// (GET /billing_statements/{id})
+	GetBillingStatementsId(ctx context.Context, request GetBillingStatementsIdRequestObject) (GetBillingStatementsIdResponseObject, error)
 	// Get list of billings
 	// (GET /billings)
 	GetBillings(ctx context.Context, request GetBillingsRequestObject) (GetBillingsResponseObject, error)
@@ -44134,6 +51335,18 @@ type StrictServerInterface interface {
 	// Get campaign call details
 	// (GET /campaigncalls/{id})
 	GetCampaigncallsId(ctx context.Context, request GetCampaigncallsIdRequestObject) (GetCampaigncallsIdResponseObject, error)
+	// Accept a previewing campaign call
+	// (POST /campaigncalls/{id}/accept)
+	PostCampaigncallsIdAccept(ctx context.Context, request PostCampaigncallsIdAcceptRequestObject) (PostCampaigncallsIdAcceptResponseObject, error)
+	// Schedule a callback of the campaign call's target
+	// (PUT /campaigncalls/{id}/callback)
+	PutCampaigncallsIdCallback(ctx context.Context, request PutCampaigncallsIdCallbackRequestObject) (PutCampaigncallsIdCallbackResponseObject, error)
+	// Set the campaign call's disposition
+	// (PUT /campaigncalls/{id}/disposition)
+	PutCampaigncallsIdDisposition(ctx context.Context, request PutCampaigncallsIdDispositionRequestObject) (PutCampaigncallsIdDispositionResponseObject, error)
+	// Skip a previewing campaign call
+	// (POST /campaigncalls/{id}/skip)
+	PostCampaigncallsIdSkip(ctx context.Context, request PostCampaigncallsIdSkipRequestObject) (PostCampaigncallsIdSkipResponseObject, error)
 	// Get a list of campaigns
 	// (GET /campaigns)
 	GetCampaigns(ctx context.Context, request GetCampaignsRequestObject) (GetCampaignsResponseObject, error)
@@ -44152,15 +51365,33 @@ type StrictServerInterface interface {
 	// Update campaign's actions
 	// (PUT /campaigns/{id}/actions)
 	PutCampaignsIdActions(ctx context.Context, request PutCampaignsIdActionsRequestObject) (PutCampaignsIdActionsResponseObject, error)
+	// Update campaign's calling windows
+	// (PUT /campaigns/{id}/calling_windows)
+	PutCampaignsIdCallingWindows(ctx context.Context, request PutCampaignsIdCallingWindowsRequestObject) (PutCampaignsIdCallingWindowsResponseObject, error)
 	// Update campaign's actions
 	// (GET /campaigns/{id}/campaigncalls)
 	GetCampaignsIdCampaigncalls(ctx context.Context, request GetCampaignsIdCampaigncallsRequestObject) (GetCampaignsIdCampaigncallsResponseObject, error)
+	// Update campaign's dial mode
+	// (PUT /campaigns/{id}/dial_mode)
+	PutCampaignsIdDialMode(ctx context.Context, request PutCampaignsIdDialModeRequestObject) (PutCampaignsIdDialModeResponseObject, error)
 	// Update campaign's service level
 	// (PUT /campaigns/{id}/next_campaign_id)
 	PutCampaignsIdNextCampaignId(ctx context.Context, request PutCampaignsIdNextCampaignIdRequestObject) (PutCampaignsIdNextCampaignIdResponseObject, error)
 	// Update campaign's resource info
 	// (PUT /campaigns/{id}/resource_info)
 	PutCampaignsIdResourceInfo(ctx context.Context, request PutCampaignsIdResourceInfoRequestObject) (PutCampaignsIdResourceInfoResponseObject, error)
+	// Retrieve a list of campaign result jobs.
+	// (GET /campaigns/{id}/resultjobs)
+	GetCampaignsIdResultjobs(ctx context.Context, request GetCampaignsIdResultjobsRequestObject) (GetCampaignsIdResultjobsResponseObject, error)
+	// Create a new campaign result job.
+	// (POST /campaigns/{id}/resultjobs)
+	PostCampaignsIdResultjobs(ctx context.Context, request PostCampaignsIdResultjobsRequestObject) (PostCampaignsIdResultjobsResponseObject, error)
+	// Retrieve a campaign result job by its ID.
+	// (GET /campaigns/{id}/resultjobs/{resultjob_id})
+	GetCampaignsIdResultjobsResultjobId(ctx context.Context, request GetCampaignsIdResultjobsResultjobIdRequestObject) (GetCampaignsIdResultjobsResultjobIdResponseObject, error)
+	// Get the campaign's results
+	// (GET /campaigns/{id}/results)
+	GetCampaignsIdResults(ctx context.Context, request GetCampaignsIdResultsRequestObject) (GetCampaignsIdResultsResponseObject, error)
 	// Update campaign's service level
 	// (PUT /campaigns/{id}/service_level)
 	PutCampaignsIdServiceLevel(ctx context.Context, request PutCampaignsIdServiceLevelRequestObject) (PutCampaignsIdServiceLevelResponseObject, error)
@@ -44329,6 +51560,21 @@ type StrictServerInterface interface {
 	// Unassign the conversation
 	// (POST /conversations/{id}/unassign)
 	PostConversationsIdUnassign(ctx context.Context, request PostConversationsIdUnassignRequestObject) (PostConversationsIdUnassignResponseObject, error)
+	// Gets a list of custom tools.
+	// (GET /custom_tools)
+	GetCustomTools(ctx context.Context, request GetCustomToolsRequestObject) (GetCustomToolsResponseObject, error)
+	// Create a new custom tool.
+	// (POST /custom_tools)
+	PostCustomTools(ctx context.Context, request PostCustomToolsRequestObject) (PostCustomToolsResponseObject, error)
+	// Delete a custom tool.
+	// (DELETE /custom_tools/{id})
+	DeleteCustomToolsId(ctx context.Context, request DeleteCustomToolsIdRequestObject) (DeleteCustomToolsIdResponseObject, error)
+	// Get custom tool details.
+	// (GET /custom_tools/{id})
+	GetCustomToolsId(ctx context.Context, request GetCustomToolsIdRequestObject) (GetCustomToolsIdResponseObject, error)
+	// Update a custom tool.
+	// (PUT /custom_tools/{id})
+	PutCustomToolsId(ctx context.Context, request PutCustomToolsIdRequestObject) (PutCustomToolsIdResponseObject, error)
 	// Get customer info
 	// (GET /customer)
 	GetCustomer(ctx context.Context, request GetCustomerRequestObject) (GetCustomerResponseObject, error)
@@ -44368,6 +51614,21 @@ type StrictServerInterface interface {
 	// Cancel customer deletion (recover account).
 	// (POST /customers/{id}/recover)
 	PostCustomersIdRecover(ctx context.Context, request PostCustomersIdRecoverRequestObject) (PostCustomersIdRecoverResponseObject, error)
+	// Retrieve a list of dispositions.
+	// (GET /dispositions)
+	GetDispositions(ctx context.Context, request GetDispositionsRequestObject) (GetDispositionsResponseObject, error)
+	// Create a new disposition.
+	// (POST /dispositions)
+	PostDispositions(ctx context.Context, request PostDispositionsRequestObject) (PostDispositionsResponseObject, error)
+	// Delete an existing disposition.
+	// (DELETE /dispositions/{id})
+	DeleteDispositionsId(ctx context.Context, request DeleteDispositionsIdRequestObject) (DeleteDispositionsIdResponseObject, error)
+	// Retrieve a disposition by its ID.
+	// (GET /dispositions/{id})
+	GetDispositionsId(ctx context.Context, request GetDispositionsIdRequestObject) (GetDispositionsIdResponseObject, error)
+	// Update a disposition.
+	// (PUT /dispositions/{id})
+	PutDispositionsId(ctx context.Context, request PutDispositionsIdRequestObject) (PutDispositionsIdResponseObject, error)
 	// Retrieve a list of emails
 	// (GET /emails)
 	GetEmails(ctx context.Context, request GetEmailsRequestObject) (GetEmailsResponseObject, error)
@@ -44431,6 +51692,24 @@ type StrictServerInterface interface {
 	// Hangup the groupcall
 	// (POST /groupcalls/{id}/hangup)
 	PostGroupcallsIdHangup(ctx context.Context, request PostGroupcallsIdHangupRequestObject) (PostGroupcallsIdHangupResponseObject, error)
+	// Gets a list of MCP servers.
+	// (GET /mcp_servers)
+	GetMcpServers(ctx context.Context, request GetMcpServersRequestObject) (GetMcpServersResponseObject, error)
+	// Register a new MCP server.
+	// (POST /mcp_servers)
+	PostMcpServers(ctx context.Context, request PostMcpServersRequestObject) (PostMcpServersResponseObject, error)
+	// Delete an MCP server.
+	// (DELETE /mcp_servers/{id})
+	DeleteMcpServersId(ctx context.Context, request DeleteMcpServersIdRequestObject) (DeleteMcpServersIdResponseObject, error)
+	// Get MCP server details.
+	// (GET /mcp_servers/{id})
+	GetMcpServersId(ctx context.Context, request GetMcpServersIdRequestObject) (GetMcpServersIdResponseObject, error)
+	// Update an MCP server.
+	// (PUT /mcp_servers/{id})
+	PutMcpServersId(ctx context.Context, request PutMcpServersIdRequestObject) (PutMcpServersIdResponseObject, error)
+	// Get the tools of an MCP server.
+	// (GET /mcp_servers/{id}/tools)
+	GetMcpServersIdTools(ctx context.Context, request GetMcpServersIdToolsRequestObject) (GetMcpServersIdToolsResponseObject, error)
 	// Get the logged-in agent
 	// (GET /me)
 	GetMe(ctx context.Context, request GetMeRequestObject) (GetMeResponseObject, error)
@@ -44512,6 +51791,15 @@ type StrictServerInterface interface {
 	// Update an outdial's data.
 	// (PUT /outdials/{id}/data)
 	PutOutdialsIdData(ctx context.Context, request PutOutdialsIdDataRequestObject) (PutOutdialsIdDataResponseObject, error)
+	// Retrieve a list of outdial target jobs.
+	// (GET /outdials/{id}/targetjobs)
+	GetOutdialsIdTargetjobs(ctx context.Context, request GetOutdialsIdTargetjobsRequestObject) (GetOutdialsIdTargetjobsResponseObject, error)
+	// Create a new outdial target job.
+	// (POST /outdials/{id}/targetjobs)
+	PostOutdialsIdTargetjobs(ctx context.Context, request PostOutdialsIdTargetjobsRequestObject) (PostOutdialsIdTargetjobsResponseObject, error)
+	// Retrieve an outdial target job by its ID.
+	// (GET /outdials/{id}/targetjobs/{targetjob_id})
+	GetOutdialsIdTargetjobsTargetjobId(ctx context.Context, request GetOutdialsIdTargetjobsTargetjobIdRequestObject) (GetOutdialsIdTargetjobsTargetjobIdResponseObject, error)
 	// Retrieve a list of outdial targets.
 	// (GET /outdials/{id}/targets)
 	GetOutdialsIdTargets(ctx context.Context, request GetOutdialsIdTargetsRequestObject) (GetOutdialsIdTargetsResponseObject, error)
@@ -44524,6 +51812,9 @@ type StrictServerInterface interface {
 	// Retrieve an outdial target by its ID.
 	// (GET /outdials/{id}/targets/{target_id})
 	GetOutdialsIdTargetsTargetId(ctx context.Context, request GetOutdialsIdTargetsTargetIdRequestObject) (GetOutdialsIdTargetsTargetIdResponseObject, error)
+	// Schedule a callback of an outdial target.
+	// (PUT /outdials/{id}/targets/{target_id}/callback)
+	PutOutdialsIdTargetsTargetIdCallback(ctx context.Context, request PutOutdialsIdTargetsTargetIdCallbackRequestObject) (PutOutdialsIdTargetsTargetIdCallbackResponseObject, error)
 	// Retrieve a list of outplans.
 	// (GET /outplans)
 	GetOutplans(ctx context.Context, request GetOutplansRequestObject) (GetOutplansResponseObject, error)
@@ -44935,6 +52226,27 @@ type StrictServerInterface interface {
 	// Download the storage file
 	// (GET /storage_files/{id}/file)
 	GetStorageFilesIdFile(ctx context.Context, request GetStorageFilesIdFileRequestObject) (GetStorageFilesIdFileResponseObject, error)
+	// Retrieve a list of suppression blocks.
+	// (GET /suppression_blocks)
+	GetSuppressionBlocks(ctx context.Context, request GetSuppressionBlocksRequestObject) (GetSuppressionBlocksResponseObject, error)
+	// Retrieve a suppression block by its ID.
+	// (GET /suppression_blocks/{id})
+	GetSuppressionBlocksId(ctx context.Context, request GetSuppressionBlocksIdRequestObject) (GetSuppressionBlocksIdResponseObject, error)
+	// Retrieve a list of suppressions.
+	// (GET /suppressions)
+	GetSuppressions(ctx context.Context, request GetSuppressionsRequestObject) (GetSuppressionsResponseObject, error)
+	// Create a new suppression.
+	// (POST /suppressions)
+	PostSuppressions(ctx context.Context, request PostSuppressionsRequestObject) (PostSuppressionsResponseObject, error)
+	// Import suppressions in bulk.
+	// (POST /suppressions/import)
+	PostSuppressionsImport(ctx context.Context, request PostSuppressionsImportRequestObject) (PostSuppressionsImportResponseObject, error)
+	// Delete an existing suppression.
+	// (DELETE /suppressions/{id})
+	DeleteSuppressionsId(ctx context.Context, request DeleteSuppressionsIdRequestObject) (DeleteSuppressionsIdResponseObject, error)
+	// Retrieve a suppression by its ID.
+	// (GET /suppressions/{id})
+	GetSuppressionsId(ctx context.Context, request GetSuppressionsIdRequestObject) (GetSuppressionsIdResponseObject, error)
 	// List tags
 	// (GET /tags)
 	GetTags(ctx context.Context, request GetTagsRequestObject) (GetTagsResponseObject, error)
@@ -44968,6 +52280,33 @@ type StrictServerInterface interface {
 	// Regenerate direct hash for team
 	// (POST /teams/{id}/direct-hash-regenerate)
 	PostTeamsIdDirectHashRegenerate(ctx context.Context, request PostTeamsIdDirectHashRegenerateRequestObject) (PostTeamsIdDirectHashRegenerateResponseObject, error)
+	// Gets a list of test runs.
+	// (GET /test_runs)
+	GetTestRuns(ctx context.Context, request GetTestRunsRequestObject) (GetTestRunsResponseObject, error)
+	// Delete a test run.
+	// (DELETE /test_runs/{id})
+	DeleteTestRunsId(ctx context.Context, request DeleteTestRunsIdRequestObject) (DeleteTestRunsIdResponseObject, error)
+	// Get test run details.
+	// (GET /test_runs/{id})
+	GetTestRunsId(ctx context.Context, request GetTestRunsIdRequestObject) (GetTestRunsIdResponseObject, error)
+	// Gets a list of test suites.
+	// (GET /test_suites)
+	GetTestSuites(ctx context.Context, request GetTestSuitesRequestObject) (GetTestSuitesResponseObject, error)
+	// Create a new test suite.
+	// (POST /test_suites)
+	PostTestSuites(ctx context.Context, request PostTestSuitesRequestObject) (PostTestSuitesResponseObject, error)
+	// Delete a test suite.
+	// (DELETE /test_suites/{id})
+	DeleteTestSuitesId(ctx context.Context, request DeleteTestSuitesIdRequestObject) (DeleteTestSuitesIdResponseObject, error)
+	// Get test suite details.
+	// (GET /test_suites/{id})
+	GetTestSuitesId(ctx context.Context, request GetTestSuitesIdRequestObject) (GetTestSuitesIdResponseObject, error)
+	// Update a test suite.
+	// (PUT /test_suites/{id})
+	PutTestSuitesId(ctx context.Context, request PutTestSuitesIdRequestObject) (PutTestSuitesIdResponseObject, error)
+	// Run a test suite.
+	// (POST /test_suites/{id}/run)
+	PostTestSuitesIdRun(ctx context.Context, request PostTestSuitesIdRunRequestObject) (PostTestSuitesIdRunResponseObject, error)
 	// Get a list of timeline analyses.
 	// (GET /timeline-analyses)
 	GetTimelineAnalyses(ctx context.Context, request GetTimelineAnalysesRequestObject) (GetTimelineAnalysesResponseObject, error)
@@ -45755,6 +53094,147 @@ func (sh *strictHandler) GetAggregatedEvents(ctx *gin.Context, params GetAggrega
 	}
 }
 
+// GetAiassists operation middleware
+func (sh *strictHandler) GetAiassists(ctx *gin.Context, params GetAiassistsParams) {
+	var request GetAiassistsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAiassists(ctx, request.(GetAiassistsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAiassists")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAiassistsResponseObject); ok {
+		if err := validResponse.VisitGetAiassistsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAiassists operation middleware
+func (sh *strictHandler) PostAiassists(ctx *gin.Context) {
+	var request PostAiassistsRequestObject
+
+	var body PostAiassistsJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAiassists(ctx, request.(PostAiassistsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAiassists")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostAiassistsResponseObject); ok {
+		if err := validResponse.VisitPostAiassistsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteAiassistsId operation middleware
+func (sh *strictHandler) DeleteAiassistsId(ctx *gin.Context, id string) {
+	var request DeleteAiassistsIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteAiassistsId(ctx, request.(DeleteAiassistsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteAiassistsId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteAiassistsIdResponseObject); ok {
+		if err := validResponse.VisitDeleteAiassistsIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAiassistsId operation middleware
+func (sh *strictHandler) GetAiassistsId(ctx *gin.Context, id string) {
+	var request GetAiassistsIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAiassistsId(ctx, request.(GetAiassistsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAiassistsId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAiassistsIdResponseObject); ok {
+		if err := validResponse.VisitGetAiassistsIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAiassistsIdStop operation middleware
+func (sh *strictHandler) PostAiassistsIdStop(ctx *gin.Context, id string) {
+	var request PostAiassistsIdStopRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAiassistsIdStop(ctx, request.(PostAiassistsIdStopRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAiassistsIdStop")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostAiassistsIdStopResponseObject); ok {
+		if err := validResponse.VisitPostAiassistsIdStopResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetAiaudits operation middleware
 func (sh *strictHandler) GetAiaudits(ctx *gin.Context, params GetAiauditsParams) {
 	var request GetAiauditsRequestObject
@@ -46607,6 +54087,174 @@ func (sh *strictHandler) GetAisIdPromptHistoriesHistoryId(ctx *gin.Context, id s
 	}
 }
 
+// GetAiextractions operation middleware
+func (sh *strictHandler) GetAiextractions(ctx *gin.Context, params GetAiextractionsParams) {
+	var request GetAiextractionsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAiextractions(ctx, request.(GetAiextractionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAiextractions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAiextractionsResponseObject); ok {
+		if err := validResponse.VisitGetAiextractionsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAiextractions operation middleware
+func (sh *strictHandler) PostAiextractions(ctx *gin.Context) {
+	var request PostAiextractionsRequestObject
+
+	var body PostAiextractionsJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAiextractions(ctx, request.(PostAiextractionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAiextractions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostAiextractionsResponseObject); ok {
+		if err := validResponse.VisitPostAiextractionsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteAiextractionsId operation middleware
+func (sh *strictHandler) DeleteAiextractionsId(ctx *gin.Context, id string) {
+	var request DeleteAiextractionsIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteAiextractionsId(ctx, request.(DeleteAiextractionsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteAiextractionsId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteAiextractionsIdResponseObject); ok {
+		if err := validResponse.VisitDeleteAiextractionsIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAiextractionsId operation middleware
+func (sh *strictHandler) GetAiextractionsId(ctx *gin.Context, id string) {
+	var request GetAiextractionsIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAiextractionsId(ctx, request.(GetAiextractionsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAiextractionsId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAiextractionsIdResponseObject); ok {
+		if err := validResponse.VisitGetAiextractionsIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAiguardrailviolations operation middleware
+func (sh *strictHandler) GetAiguardrailviolations(ctx *gin.Context, params GetAiguardrailviolationsParams) {
+	var request GetAiguardrailviolationsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAiguardrailviolations(ctx, request.(GetAiguardrailviolationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAiguardrailviolations")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAiguardrailviolationsResponseObject); ok {
+		if err := validResponse.VisitGetAiguardrailviolationsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAiguardrailviolationsId operation middleware
+func (sh *strictHandler) GetAiguardrailviolationsId(ctx *gin.Context, id string) {
+	var request GetAiguardrailviolationsIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAiguardrailviolationsId(ctx, request.(GetAiguardrailviolationsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAiguardrailviolationsId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAiguardrailviolationsIdResponseObject); ok {
+		if err := validResponse.VisitGetAiguardrailviolationsIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetAisummaries operation middleware
 func (sh *strictHandler) GetAisummaries(ctx *gin.Context, params GetAisummariesParams) {
 	var request GetAisummariesRequestObject
@@ -47118,6 +54766,39 @@ func (sh *strictHandler) PutBillingAccountPaymentInfo(ctx *gin.Context) {
 	}
 }
 
+// PutBillingAccountSpendingSettings operation middleware
+func (sh *strictHandler) PutBillingAccountSpendingSettings(ctx *gin.Context) {
+	var request PutBillingAccountSpendingSettingsRequestObject
+
+	var body PutBillingAccountSpendingSettingsJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutBillingAccountSpendingSettings(ctx, request.(PutBillingAccountSpendingSettingsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutBillingAccountSpendingSettings")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PutBillingAccountSpendingSettingsResponseObject); ok {
+		if err := validResponse.VisitPutBillingAccountSpendingSettingsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetBillingAccounts operation middleware
 func (sh *strictHandler) GetBillingAccounts(ctx *gin.Context, params GetBillingAccountsParams) {
 	var request GetBillingAccountsRequestObject
@@ -47312,6 +54993,93 @@ func (sh *strictHandler) PutBillingAccountsIdPaymentInfo(ctx *gin.Context, id st
 	}
 }
 
+// PostBillingEstimates operation middleware
+func (sh *strictHandler) PostBillingEstimates(ctx *gin.Context) {
+	var request PostBillingEstimatesRequestObject
+
+	var body PostBillingEstimatesJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostBillingEstimates(ctx, request.(PostBillingEstimatesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostBillingEstimates")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostBillingEstimatesResponseObject); ok {
+		if err := validResponse.VisitPostBillingEstimatesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetBillingStatements operation middleware
+func (sh *strictHandler) GetBillingStatements(ctx *gin.Context, params GetBillingStatementsParams) {
+	var request GetBillingStatementsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetBillingStatements(ctx, request.(GetBillingStatementsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetBillingStatements")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetBillingStatementsResponseObject); ok {
+		if err := validResponse.VisitGetBillingStatementsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetBillingStatementsId operation middleware
+func (sh *strictHandler) GetBillingStatementsId(ctx *gin.Context, id openapi_types.UUID) {
+	var request GetBillingStatementsIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetBillingStatementsId(ctx, request.(GetBillingStatementsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetBillingStatementsId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetBillingStatementsIdResponseObject); ok {
+		if err := validResponse.VisitGetBillingStatementsIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetBillings operation middleware
 func (sh *strictHandler) GetBillings(ctx *gin.Context, params GetBillingsParams) {
 	var request GetBillingsRequestObject
@@ -47945,6 +55713,138 @@ func (sh *strictHandler) GetCampaigncallsId(ctx *gin.Context, id string) {
 	}
 }
 
+// PostCampaigncallsIdAccept operation middleware
+func (sh *strictHandler) PostCampaigncallsIdAccept(ctx *gin.Context, id string) {
+	var request PostCampaigncallsIdAcceptRequestObject
+
+	request.Id = id
+
+	var body PostCampaigncallsIdAcceptJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCampaigncallsIdAccept(ctx, request.(PostCampaigncallsIdAcceptRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCampaigncallsIdAccept")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostCampaigncallsIdAcceptResponseObject); ok {
+		if err := validResponse.VisitPostCampaigncallsIdAcceptResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutCampaigncallsIdCallback operation middleware
+func (sh *strictHandler) PutCampaigncallsIdCallback(ctx *gin.Context, id string) {
+	var request PutCampaigncallsIdCallbackRequestObject
+
+	request.Id = id
+
+	var body PutCampaigncallsIdCallbackJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutCampaigncallsIdCallback(ctx, request.(PutCampaigncallsIdCallbackRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutCampaigncallsIdCallback")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PutCampaigncallsIdCallbackResponseObject); ok {
+		if err := validResponse.VisitPutCampaigncallsIdCallbackResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutCampaigncallsIdDisposition operation middleware
+func (sh *strictHandler) PutCampaigncallsIdDisposition(ctx *gin.Context, id string) {
+	var request PutCampaigncallsIdDispositionRequestObject
+
+	request.Id = id
+
+	var body PutCampaigncallsIdDispositionJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutCampaigncallsIdDisposition(ctx, request.(PutCampaigncallsIdDispositionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutCampaigncallsIdDisposition")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PutCampaigncallsIdDispositionResponseObject); ok {
+		if err := validResponse.VisitPutCampaigncallsIdDispositionResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostCampaigncallsIdSkip operation middleware
+func (sh *strictHandler) PostCampaigncallsIdSkip(ctx *gin.Context, id string) {
+	var request PostCampaigncallsIdSkipRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCampaigncallsIdSkip(ctx, request.(PostCampaigncallsIdSkipRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCampaigncallsIdSkip")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostCampaigncallsIdSkipResponseObject); ok {
+		if err := validResponse.VisitPostCampaigncallsIdSkipResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetCampaigns operation middleware
 func (sh *strictHandler) GetCampaigns(ctx *gin.Context, params GetCampaignsParams) {
 	var request GetCampaignsRequestObject
@@ -48129,6 +56029,41 @@ func (sh *strictHandler) PutCampaignsIdActions(ctx *gin.Context, id string) {
 	}
 }
 
+// PutCampaignsIdCallingWindows operation middleware
+func (sh *strictHandler) PutCampaignsIdCallingWindows(ctx *gin.Context, id string) {
+	var request PutCampaignsIdCallingWindowsRequestObject
+
+	request.Id = id
+
+	var body PutCampaignsIdCallingWindowsJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutCampaignsIdCallingWindows(ctx, request.(PutCampaignsIdCallingWindowsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutCampaignsIdCallingWindows")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PutCampaignsIdCallingWindowsResponseObject); ok {
+		if err := validResponse.VisitPutCampaignsIdCallingWindowsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetCampaignsIdCampaigncalls operation middleware
 func (sh *strictHandler) GetCampaignsIdCampaigncalls(ctx *gin.Context, id string, params GetCampaignsIdCampaigncallsParams) {
 	var request GetCampaignsIdCampaigncallsRequestObject
@@ -48157,6 +56092,41 @@ func (sh *strictHandler) GetCampaignsIdCampaigncalls(ctx *gin.Context, id string
 	}
 }
 
+// PutCampaignsIdDialMode operation middleware
+func (sh *strictHandler) PutCampaignsIdDialMode(ctx *gin.Context, id string) {
+	var request PutCampaignsIdDialModeRequestObject
+
+	request.Id = id
+
+	var body PutCampaignsIdDialModeJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutCampaignsIdDialMode(ctx, request.(PutCampaignsIdDialModeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutCampaignsIdDialMode")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PutCampaignsIdDialModeResponseObject); ok {
+		if err := validResponse.VisitPutCampaignsIdDialModeResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // PutCampaignsIdNextCampaignId operation middleware
 func (sh *strictHandler) PutCampaignsIdNextCampaignId(ctx *gin.Context, id string) {
 	var request PutCampaignsIdNextCampaignIdRequestObject
@@ -48227,6 +56197,117 @@ func (sh *strictHandler) PutCampaignsIdResourceInfo(ctx *gin.Context, id string)
 	}
 }
 
+// GetCampaignsIdResultjobs operation middleware
+func (sh *strictHandler) GetCampaignsIdResultjobs(ctx *gin.Context, id string, params GetCampaignsIdResultjobsParams) {
+	var request GetCampaignsIdResultjobsRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCampaignsIdResultjobs(ctx, request.(GetCampaignsIdResultjobsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCampaignsIdResultjobs")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetCampaignsIdResultjobsResponseObject); ok {
+		if err := validResponse.VisitGetCampaignsIdResultjobsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostCampaignsIdResultjobs operation middleware
+func (sh *strictHandler) PostCampaignsIdResultjobs(ctx *gin.Context, id string) {
+	var request PostCampaignsIdResultjobsRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCampaignsIdResultjobs(ctx, request.(PostCampaignsIdResultjobsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCampaignsIdResultjobs")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostCampaignsIdResultjobsResponseObject); ok {
+		if err := validResponse.VisitPostCampaignsIdResultjobsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetCampaignsIdResultjobsResultjobId operation middleware
+func (sh *strictHandler) GetCampaignsIdResultjobsResultjobId(ctx *gin.Context, id string, resultjobId string) {
+	var request GetCampaignsIdResultjobsResultjobIdRequestObject
+
+	request.Id = id
+	request.ResultjobId = resultjobId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCampaignsIdResultjobsResultjobId(ctx, request.(GetCampaignsIdResultjobsResultjobIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCampaignsIdResultjobsResultjobId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetCampaignsIdResultjobsResultjobIdResponseObject); ok {
+		if err := validResponse.VisitGetCampaignsIdResultjobsResultjobIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetCampaignsIdResults operation middleware
+func (sh *strictHandler) GetCampaignsIdResults(ctx *gin.Context, id string, params GetCampaignsIdResultsParams) {
+	var request GetCampaignsIdResultsRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCampaignsIdResults(ctx, request.(GetCampaignsIdResultsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCampaignsIdResults")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetCampaignsIdResultsResponseObject); ok {
+		if err := validResponse.VisitGetCampaignsIdResultsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // PutCampaignsIdServiceLevel operation middleware
 func (sh *strictHandler) PutCampaignsIdServiceLevel(ctx *gin.Context, id string) {
 	var request PutCampaignsIdServiceLevelRequestObject
@@ -49913,6 +57994,155 @@ func (sh *strictHandler) PostConversationsIdUnassign(ctx *gin.Context, id openap
 	}
 }
 
+// GetCustomTools operation middleware
+func (sh *strictHandler) GetCustomTools(ctx *gin.Context, params GetCustomToolsParams) {
+	var request GetCustomToolsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCustomTools(ctx, request.(GetCustomToolsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCustomTools")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetCustomToolsResponseObject); ok {
+		if err := validResponse.VisitGetCustomToolsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostCustomTools operation middleware
+func (sh *strictHandler) PostCustomTools(ctx *gin.Context) {
+	var request PostCustomToolsRequestObject
+
+	var body PostCustomToolsJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCustomTools(ctx, request.(PostCustomToolsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCustomTools")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostCustomToolsResponseObject); ok {
+		if err := validResponse.VisitPostCustomToolsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteCustomToolsId operation middleware
+func (sh *strictHandler) DeleteCustomToolsId(ctx *gin.Context, id string) {
+	var request DeleteCustomToolsIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteCustomToolsId(ctx, request.(DeleteCustomToolsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteCustomToolsId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteCustomToolsIdResponseObject); ok {
+		if err := validResponse.VisitDeleteCustomToolsIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetCustomToolsId operation middleware
+func (sh *strictHandler) GetCustomToolsId(ctx *gin.Context, id string) {
+	var request GetCustomToolsIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCustomToolsId(ctx, request.(GetCustomToolsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCustomToolsId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetCustomToolsIdResponseObject); ok {
+		if err := validResponse.VisitGetCustomToolsIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutCustomToolsId operation middleware
+func (sh *strictHandler) PutCustomToolsId(ctx *gin.Context, id string) {
+	var request PutCustomToolsIdRequestObject
+
+	request.Id = id
+
+	var body PutCustomToolsIdJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutCustomToolsId(ctx, request.(PutCustomToolsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutCustomToolsId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PutCustomToolsIdResponseObject); ok {
+		if err := validResponse.VisitPutCustomToolsIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetCustomer operation middleware
 func (sh *strictHandler) GetCustomer(ctx *gin.Context) {
 	var request GetCustomerRequestObject
@@ -50310,6 +58540,155 @@ func (sh *strictHandler) PostCustomersIdRecover(ctx *gin.Context, id string) {
 	}
 }
 
+// GetDispositions operation middleware
+func (sh *strictHandler) GetDispositions(ctx *gin.Context, params GetDispositionsParams) {
+	var request GetDispositionsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDispositions(ctx, request.(GetDispositionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDispositions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetDispositionsResponseObject); ok {
+		if err := validResponse.VisitGetDispositionsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostDispositions operation middleware
+func (sh *strictHandler) PostDispositions(ctx *gin.Context) {
+	var request PostDispositionsRequestObject
+
+	var body PostDispositionsJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostDispositions(ctx, request.(PostDispositionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostDispositions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostDispositionsResponseObject); ok {
+		if err := validResponse.VisitPostDispositionsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteDispositionsId operation middleware
+func (sh *strictHandler) DeleteDispositionsId(ctx *gin.Context, id string) {
+	var request DeleteDispositionsIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteDispositionsId(ctx, request.(DeleteDispositionsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteDispositionsId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteDispositionsIdResponseObject); ok {
+		if err := validResponse.VisitDeleteDispositionsIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetDispositionsId operation middleware
+func (sh *strictHandler) GetDispositionsId(ctx *gin.Context, id string) {
+	var request GetDispositionsIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDispositionsId(ctx, request.(GetDispositionsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDispositionsId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetDispositionsIdResponseObject); ok {
+		if err := validResponse.VisitGetDispositionsIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutDispositionsId operation middleware
+func (sh *strictHandler) PutDispositionsId(ctx *gin.Context, id string) {
+	var request PutDispositionsIdRequestObject
+
+	request.Id = id
+
+	var body PutDispositionsIdJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutDispositionsId(ctx, request.(PutDispositionsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutDispositionsId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PutDispositionsIdResponseObject); ok {
+		if err := validResponse.VisitPutDispositionsIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetEmails operation middleware
 func (sh *strictHandler) GetEmails(ctx *gin.Context, params GetEmailsParams) {
 	var request GetEmailsRequestObject
@@ -50917,6 +59296,182 @@ func (sh *strictHandler) PostGroupcallsIdHangup(ctx *gin.Context, id string) {
 	}
 }
 
+// GetMcpServers operation middleware
+func (sh *strictHandler) GetMcpServers(ctx *gin.Context, params GetMcpServersParams) {
+	var request GetMcpServersRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetMcpServers(ctx, request.(GetMcpServersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetMcpServers")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetMcpServersResponseObject); ok {
+		if err := validResponse.VisitGetMcpServersResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostMcpServers operation middleware
+func (sh *strictHandler) PostMcpServers(ctx *gin.Context) {
+	var request PostMcpServersRequestObject
+
+	var body PostMcpServersJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostMcpServers(ctx, request.(PostMcpServersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostMcpServers")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostMcpServersResponseObject); ok {
+		if err := validResponse.VisitPostMcpServersResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteMcpServersId operation middleware
+func (sh *strictHandler) DeleteMcpServersId(ctx *gin.Context, id string) {
+	var request DeleteMcpServersIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteMcpServersId(ctx, request.(DeleteMcpServersIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteMcpServersId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteMcpServersIdResponseObject); ok {
+		if err := validResponse.VisitDeleteMcpServersIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetMcpServersId operation middleware
+func (sh *strictHandler) GetMcpServersId(ctx *gin.Context, id string) {
+	var request GetMcpServersIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetMcpServersId(ctx, request.(GetMcpServersIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetMcpServersId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetMcpServersIdResponseObject); ok {
+		if err := validResponse.VisitGetMcpServersIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutMcpServersId operation middleware
+func (sh *strictHandler) PutMcpServersId(ctx *gin.Context, id string) {
+	var request PutMcpServersIdRequestObject
+
+	request.Id = id
+
+	var body PutMcpServersIdJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutMcpServersId(ctx, request.(PutMcpServersIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutMcpServersId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PutMcpServersIdResponseObject); ok {
+		if err := validResponse.VisitPutMcpServersIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetMcpServersIdTools operation middleware
+func (sh *strictHandler) GetMcpServersIdTools(ctx *gin.Context, id string) {
+	var request GetMcpServersIdToolsRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetMcpServersIdTools(ctx, request.(GetMcpServersIdToolsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetMcpServersIdTools")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetMcpServersIdToolsResponseObject); ok {
+		if err := validResponse.VisitGetMcpServersIdToolsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetMe operation middleware
 func (sh *strictHandler) GetMe(ctx *gin.Context) {
 	var request GetMeRequestObject
@@ -51734,6 +60289,97 @@ func (sh *strictHandler) PutOutdialsIdData(ctx *gin.Context, id string) {
 	}
 }
 
+// GetOutdialsIdTargetjobs operation middleware
+func (sh *strictHandler) GetOutdialsIdTargetjobs(ctx *gin.Context, id string, params GetOutdialsIdTargetjobsParams) {
+	var request GetOutdialsIdTargetjobsRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOutdialsIdTargetjobs(ctx, request.(GetOutdialsIdTargetjobsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOutdialsIdTargetjobs")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetOutdialsIdTargetjobsResponseObject); ok {
+		if err := validResponse.VisitGetOutdialsIdTargetjobsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostOutdialsIdTargetjobs operation middleware
+func (sh *strictHandler) PostOutdialsIdTargetjobs(ctx *gin.Context, id string) {
+	var request PostOutdialsIdTargetjobsRequestObject
+
+	request.Id = id
+
+	var body PostOutdialsIdTargetjobsJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostOutdialsIdTargetjobs(ctx, request.(PostOutdialsIdTargetjobsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostOutdialsIdTargetjobs")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostOutdialsIdTargetjobsResponseObject); ok {
+		if err := validResponse.VisitPostOutdialsIdTargetjobsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetOutdialsIdTargetjobsTargetjobId operation middleware
+func (sh *strictHandler) GetOutdialsIdTargetjobsTargetjobId(ctx *gin.Context, id string, targetjobId string) {
+	var request GetOutdialsIdTargetjobsTargetjobIdRequestObject
+
+	request.Id = id
+	request.TargetjobId = targetjobId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOutdialsIdTargetjobsTargetjobId(ctx, request.(GetOutdialsIdTargetjobsTargetjobIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOutdialsIdTargetjobsTargetjobId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetOutdialsIdTargetjobsTargetjobIdResponseObject); ok {
+		if err := validResponse.VisitGetOutdialsIdTargetjobsTargetjobIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetOutdialsIdTargets operation middleware
 func (sh *strictHandler) GetOutdialsIdTargets(ctx *gin.Context, id string, params GetOutdialsIdTargetsParams) {
 	var request GetOutdialsIdTargetsRequestObject
@@ -51853,6 +60499,42 @@ func (sh *strictHandler) GetOutdialsIdTargetsTargetId(ctx *gin.Context, id strin
 	}
 }
 
+// PutOutdialsIdTargetsTargetIdCallback operation middleware
+func (sh *strictHandler) PutOutdialsIdTargetsTargetIdCallback(ctx *gin.Context, id string, targetId string) {
+	var request PutOutdialsIdTargetsTargetIdCallbackRequestObject
+
+	request.Id = id
+	request.TargetId = targetId
+
+	var body PutOutdialsIdTargetsTargetIdCallbackJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutOutdialsIdTargetsTargetIdCallback(ctx, request.(PutOutdialsIdTargetsTargetIdCallbackRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutOutdialsIdTargetsTargetIdCallback")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PutOutdialsIdTargetsTargetIdCallbackResponseObject); ok {
+		if err := validResponse.VisitPutOutdialsIdTargetsTargetIdCallbackResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetOutplans operation middleware
 func (sh *strictHandler) GetOutplans(ctx *gin.Context, params GetOutplansParams) {
 	var request GetOutplansRequestObject
@@ -55873,6 +64555,207 @@ func (sh *strictHandler) GetStorageFilesIdFile(ctx *gin.Context, id openapi_type
 	}
 }
 
+// GetSuppressionBlocks operation middleware
+func (sh *strictHandler) GetSuppressionBlocks(ctx *gin.Context, params GetSuppressionBlocksParams) {
+	var request GetSuppressionBlocksRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSuppressionBlocks(ctx, request.(GetSuppressionBlocksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSuppressionBlocks")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetSuppressionBlocksResponseObject); ok {
+		if err := validResponse.VisitGetSuppressionBlocksResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetSuppressionBlocksId operation middleware
+func (sh *strictHandler) GetSuppressionBlocksId(ctx *gin.Context, id string) {
+	var request GetSuppressionBlocksIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSuppressionBlocksId(ctx, request.(GetSuppressionBlocksIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSuppressionBlocksId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetSuppressionBlocksIdResponseObject); ok {
+		if err := validResponse.VisitGetSuppressionBlocksIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetSuppressions operation middleware
+func (sh *strictHandler) GetSuppressions(ctx *gin.Context, params GetSuppressionsParams) {
+	var request GetSuppressionsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSuppressions(ctx, request.(GetSuppressionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSuppressions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetSuppressionsResponseObject); ok {
+		if err := validResponse.VisitGetSuppressionsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostSuppressions operation middleware
+func (sh *strictHandler) PostSuppressions(ctx *gin.Context) {
+	var request PostSuppressionsRequestObject
+
+	var body PostSuppressionsJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostSuppressions(ctx, request.(PostSuppressionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostSuppressions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostSuppressionsResponseObject); ok {
+		if err := validResponse.VisitPostSuppressionsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostSuppressionsImport operation middleware
+func (sh *strictHandler) PostSuppressionsImport(ctx *gin.Context) {
+	var request PostSuppressionsImportRequestObject
+
+	var body PostSuppressionsImportJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostSuppressionsImport(ctx, request.(PostSuppressionsImportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostSuppressionsImport")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostSuppressionsImportResponseObject); ok {
+		if err := validResponse.VisitPostSuppressionsImportResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteSuppressionsId operation middleware
+func (sh *strictHandler) DeleteSuppressionsId(ctx *gin.Context, id string) {
+	var request DeleteSuppressionsIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteSuppressionsId(ctx, request.(DeleteSuppressionsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteSuppressionsId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteSuppressionsIdResponseObject); ok {
+		if err := validResponse.VisitDeleteSuppressionsIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetSuppressionsId operation middleware
+func (sh *strictHandler) GetSuppressionsId(ctx *gin.Context, id string) {
+	var request GetSuppressionsIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSuppressionsId(ctx, request.(GetSuppressionsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSuppressionsId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetSuppressionsIdResponseObject); ok {
+		if err := validResponse.VisitGetSuppressionsIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetTags operation middleware
 func (sh *strictHandler) GetTags(ctx *gin.Context, params GetTagsParams) {
 	var request GetTagsRequestObject
@@ -56198,6 +65081,263 @@ func (sh *strictHandler) PostTeamsIdDirectHashRegenerate(ctx *gin.Context, id op
 	}
 }
 
+// GetTestRuns operation middleware
+func (sh *strictHandler) GetTestRuns(ctx *gin.Context, params GetTestRunsParams) {
+	var request GetTestRunsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTestRuns(ctx, request.(GetTestRunsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTestRuns")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetTestRunsResponseObject); ok {
+		if err := validResponse.VisitGetTestRunsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteTestRunsId operation middleware
+func (sh *strictHandler) DeleteTestRunsId(ctx *gin.Context, id string) {
+	var request DeleteTestRunsIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteTestRunsId(ctx, request.(DeleteTestRunsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteTestRunsId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteTestRunsIdResponseObject); ok {
+		if err := validResponse.VisitDeleteTestRunsIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetTestRunsId operation middleware
+func (sh *strictHandler) GetTestRunsId(ctx *gin.Context, id string) {
+	var request GetTestRunsIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTestRunsId(ctx, request.(GetTestRunsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTestRunsId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetTestRunsIdResponseObject); ok {
+		if err := validResponse.VisitGetTestRunsIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetTestSuites operation middleware
+func (sh *strictHandler) GetTestSuites(ctx *gin.Context, params GetTestSuitesParams) {
+	var request GetTestSuitesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTestSuites(ctx, request.(GetTestSuitesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTestSuites")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetTestSuitesResponseObject); ok {
+		if err := validResponse.VisitGetTestSuitesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostTestSuites operation middleware
+func (sh *strictHandler) PostTestSuites(ctx *gin.Context) {
+	var request PostTestSuitesRequestObject
+
+	var body PostTestSuitesJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTestSuites(ctx, request.(PostTestSuitesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTestSuites")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostTestSuitesResponseObject); ok {
+		if err := validResponse.VisitPostTestSuitesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteTestSuitesId operation middleware
+func (sh *strictHandler) DeleteTestSuitesId(ctx *gin.Context, id string) {
+	var request DeleteTestSuitesIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteTestSuitesId(ctx, request.(DeleteTestSuitesIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteTestSuitesId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteTestSuitesIdResponseObject); ok {
+		if err := validResponse.VisitDeleteTestSuitesIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetTestSuitesId operation middleware
+func (sh *strictHandler) GetTestSuitesId(ctx *gin.Context, id string) {
+	var request GetTestSuitesIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTestSuitesId(ctx, request.(GetTestSuitesIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTestSuitesId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetTestSuitesIdResponseObject); ok {
+		if err := validResponse.VisitGetTestSuitesIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutTestSuitesId operation middleware
+func (sh *strictHandler) PutTestSuitesId(ctx *gin.Context, id string) {
+	var request PutTestSuitesIdRequestObject
+
+	request.Id = id
+
+	var body PutTestSuitesIdJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutTestSuitesId(ctx, request.(PutTestSuitesIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutTestSuitesId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PutTestSuitesIdResponseObject); ok {
+		if err := validResponse.VisitPutTestSuitesIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostTestSuitesIdRun operation middleware
+func (sh *strictHandler) PostTestSuitesIdRun(ctx *gin.Context, id string) {
+	var request PostTestSuitesIdRunRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTestSuitesIdRun(ctx, request.(PostTestSuitesIdRunRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTestSuitesIdRun")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostTestSuitesIdRunResponseObject); ok {
+		if err := validResponse.VisitPostTestSuitesIdRunResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetTimelineAnalyses operation middleware
 func (sh *strictHandler) GetTimelineAnalyses(ctx *gin.Context, params GetTimelineAnalysesParams) {
 	var request GetTimelineAnalysesRequestObject
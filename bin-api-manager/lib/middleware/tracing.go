@@ -0,0 +1,55 @@
+package middleware
+
+import (
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName is the instrumentation name of the HTTP entry spans.
+const tracerName = "monorepo/bin-api-manager/lib/middleware"
+
+// Tracing returns a Gin middleware that starts the server span of every
+// HTTP request. The span is the root of the request's trace: the inbound
+// traceparent header is ignored because the API is public and the client's
+// trace IDs are not trusted. The span context is propagated to
+// c.Request.Context(), so the RPCs the servicehandler sends downstream
+// become its children.
+//
+// The span is named after the route template (i.e. "POST /v1.0/calls")
+// to keep the span names low-cardinality. Must run after RequestID so the
+// correlation ID can be attached to the span.
+func Tracing() gin.HandlerFunc {
+	tracer := otel.Tracer(tracerName)
+
+	return func(c *gin.Context) {
+		route := c.FullPath()
+		if route == "" {
+			route = "unrouted"
+		}
+
+		ctx, span := tracer.Start(c.Request.Context(), c.Request.Method+" "+route,
+			trace.WithNewRoot(),
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				attribute.String("http.request.method", c.Request.Method),
+				attribute.String("http.route", route),
+				attribute.String("request_id", RequestIDFromContext(c)),
+			),
+		)
+		defer span.End()
+
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+
+		status := c.Writer.Status()
+		span.SetAttributes(attribute.Int("http.response.status_code", status))
+		if status >= 500 {
+			span.SetStatus(codes.Error, fmt.Sprintf("status code: %d", status))
+		}
+	}
+}
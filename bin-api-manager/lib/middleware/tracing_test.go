@@ -0,0 +1,54 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTracingStartsServerSpan(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(RequestID())
+	r.Use(Tracing())
+
+	var seen trace.SpanContext
+	r.POST("/v1.0/calls/:id", func(c *gin.Context) {
+		seen = trace.SpanContextFromContext(c.Request.Context())
+		c.String(http.StatusInternalServerError, "fail")
+	})
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1.0/calls/0f3f1a46-7d57-11ee-9c6d-2b2f4e0a7f7b", nil)
+	req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	r.ServeHTTP(w, req)
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("ended spans = %d want 1", len(spans))
+	}
+	span := spans[0]
+	if span.Name() != "POST /v1.0/calls/:id" {
+		t.Errorf("span name = %q want %q", span.Name(), "POST /v1.0/calls/:id")
+	}
+	if span.SpanContext().SpanID() != seen.SpanID() {
+		t.Errorf("handler context span = %v want %v", seen.SpanID(), span.SpanContext().SpanID())
+	}
+	if span.SpanContext().TraceID().String() == "4bf92f3577b34da6a3ce929d0e0e4736" {
+		t.Errorf("inbound traceparent should not be trusted")
+	}
+	if span.Status().Code.String() != "Error" {
+		t.Errorf("span status = %v want Error", span.Status().Code)
+	}
+}
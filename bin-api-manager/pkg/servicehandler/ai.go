@@ -6,6 +6,9 @@ import (
 
 	amagent "monorepo/bin-agent-manager/models/agent"
 	amai "monorepo/bin-ai-manager/models/ai"
+	amguardrail "monorepo/bin-ai-manager/models/guardrail"
+	amredaction "monorepo/bin-ai-manager/models/redaction"
+	amresponsecache "monorepo/bin-ai-manager/models/responsecache"
 	amtool "monorepo/bin-ai-manager/models/tool"
 	"monorepo/bin-api-manager/models/auth"
 	"monorepo/bin-api-manager/pkg/serviceerrors"
@@ -50,6 +53,10 @@ func (h *serviceHandler) AICreate(
 	sttLanguage string,
 	toolNames []amtool.ToolName,
 	autoAICallAuditEnabled bool,
+	engineFallbacks []amai.EngineFallback,
+	redactionConfig *amredaction.Config,
+	guardrailConfig *amguardrail.Config,
+	responseCacheConfig *amresponsecache.Config,
 ) (*amai.WebhookMessage, error) {
 	if a.IsDirect() {
 		return nil, serviceerrors.ErrDirectAccessNotSupported
@@ -72,6 +79,10 @@ func (h *serviceHandler) AICreate(
 		"tool_names":   toolNames,
 
 		"auto_aicall_audit_enabled": autoAICallAuditEnabled,
+		"engine_fallbacks":          engineFallbacks,
+		"redaction":                 redactionConfig,
+		"guardrail":                 guardrailConfig,
+		"response_cache":            responseCacheConfig,
 	})
 
 	if !h.hasPermission(ctx, a, a.CustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager) {
@@ -111,6 +122,10 @@ func (h *serviceHandler) AICreate(
 		sttLanguage,
 		toolNames,
 		autoAICallAuditEnabled,
+		engineFallbacks,
+		redactionConfig,
+		guardrailConfig,
+		responseCacheConfig,
 	)
 	if err != nil {
 		log.Errorf("Could not create a new ai. err: %v", err)
@@ -354,6 +369,10 @@ func (h *serviceHandler) AIUpdate(
 	sttLanguage string,
 	toolNames []amtool.ToolName,
 	autoAICallAuditEnabled bool,
+	engineFallbacks []amai.EngineFallback,
+	redactionConfig *amredaction.Config,
+	guardrailConfig *amguardrail.Config,
+	responseCacheConfig *amresponsecache.Config,
 ) (*amai.WebhookMessage, error) {
 	if a.IsDirect() {
 		return nil, serviceerrors.ErrDirectAccessNotSupported
@@ -377,6 +396,10 @@ func (h *serviceHandler) AIUpdate(
 		"tool_names":   toolNames,
 
 		"auto_aicall_audit_enabled": autoAICallAuditEnabled,
+		"engine_fallbacks":          engineFallbacks,
+		"redaction":                 redactionConfig,
+		"guardrail":                 guardrailConfig,
+		"response_cache":            responseCacheConfig,
 	})
 
 	// get chat
@@ -423,6 +446,10 @@ func (h *serviceHandler) AIUpdate(
 		sttLanguage,
 		toolNames,
 		autoAICallAuditEnabled,
+		engineFallbacks,
+		redactionConfig,
+		guardrailConfig,
+		responseCacheConfig,
 	)
 	if err != nil {
 		log.Errorf("Could not update the ai. err: %v", err)
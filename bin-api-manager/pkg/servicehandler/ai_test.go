@@ -108,6 +108,10 @@ func Test_AICreate(t *testing.T) {
 				tt.sttLanguage,
 				nil,   // toolNames
 				false, // autoAICallAuditEnabled
+				nil,   // engineFallbacks
+				nil,   // redactionConfig
+				nil,   // guardrailConfig
+				nil,   // responseCacheConfig
 			).Return(tt.response, nil)
 
 			res, err := h.AICreate(
@@ -127,6 +131,10 @@ func Test_AICreate(t *testing.T) {
 				tt.sttLanguage,
 				nil,   // toolNames
 				false, // autoAICallAuditEnabled
+				nil,   // engineFallbacks
+				nil,   // redactionConfig
+				nil,   // guardrailConfig
+				nil,   // responseCacheConfig
 			)
 			if err != nil {
 				t.Errorf("Wrong match. expect: ok, got: %v", err)
@@ -0,0 +1,245 @@
+package servicehandler
+
+import (
+	"context"
+	"fmt"
+
+	amagent "monorepo/bin-agent-manager/models/agent"
+	amaiassist "monorepo/bin-ai-manager/models/aiassist"
+	"monorepo/bin-api-manager/models/auth"
+	"monorepo/bin-api-manager/pkg/serviceerrors"
+	commondatabasehandler "monorepo/bin-common-handler/pkg/databasehandler"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+)
+
+// AIAssistStart is a service handler for starting the ai assist.
+// The agent defaults to the requesting agent. Assisting other agents
+// requires the customer admin or manager permission.
+func (h *serviceHandler) AIAssistStart(
+	ctx context.Context,
+	a *auth.AuthIdentity,
+	agentID uuid.UUID,
+	aiID uuid.UUID,
+	referenceType amaiassist.ReferenceType,
+	referenceID uuid.UUID,
+	language string,
+	checklist []string,
+) (*amaiassist.WebhookMessage, error) {
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	if agentID == uuid.Nil {
+		agentID = a.AgentID()
+	}
+	if agentID == uuid.Nil {
+		return nil, fmt.Errorf("%w: agent_id is required", serviceerrors.ErrInvalidArgument)
+	}
+
+	var tmpCustomerID uuid.UUID
+	// get reference's customer info
+	switch referenceType {
+	case amaiassist.ReferenceTypeCall:
+		tmp, err := h.callGet(ctx, referenceID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get call info")
+		}
+		tmpCustomerID = tmp.CustomerID
+
+	default:
+		return nil, fmt.Errorf("%w: unsupported reference type", serviceerrors.ErrInvalidArgument)
+	}
+
+	if !h.hasPermission(ctx, a, tmpCustomerID, amagent.PermissionCustomerAll) {
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	if agentID != a.AgentID() {
+		if !h.hasPermission(ctx, a, tmpCustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager) {
+			return nil, serviceerrors.ErrPermissionDenied
+		}
+
+		ag, err := h.agentGet(ctx, agentID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get agent info")
+		}
+		if ag.CustomerID != tmpCustomerID {
+			return nil, serviceerrors.ErrPermissionDenied
+		}
+	}
+
+	items := make([]amaiassist.ChecklistItem, 0, len(checklist))
+	for _, name := range checklist {
+		items = append(items, amaiassist.ChecklistItem{Name: name})
+	}
+
+	tmp, err := h.reqHandler.AIV1AIAssistStart(
+		ctx,
+		tmpCustomerID,
+		agentID,
+		aiID,
+		referenceType,
+		referenceID,
+		language,
+		items,
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not start ai assist")
+	}
+
+	res := tmp.ConvertWebhookMessage()
+	return res, nil
+}
+
+// aiassistGet returns the ai assist info.
+func (h *serviceHandler) aiassistGet(ctx context.Context, id uuid.UUID) (*amaiassist.AIAssist, error) {
+	res, err := h.reqHandler.AIV1AIAssistGet(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get the resource info")
+	}
+
+	return res, nil
+}
+
+// aiassistHasPermission returns true if the given auth can access the ai assist.
+// The assisted agent can access its own ai assists.
+func (h *serviceHandler) aiassistHasPermission(ctx context.Context, a *auth.AuthIdentity, aa *amaiassist.AIAssist) bool {
+	if a.CustomerID == aa.CustomerID && a.AgentID() == aa.OwnerID {
+		return true
+	}
+
+	return h.hasPermission(ctx, a, aa.CustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager)
+}
+
+// AIAssistGetsByCustomerID gets the list of ai assists of the given customer id.
+// Agents without the customer admin or manager permission get their own ai assists only.
+func (h *serviceHandler) AIAssistGetsByCustomerID(ctx context.Context, a *auth.AuthIdentity, size uint64, token string) ([]*amaiassist.WebhookMessage, error) {
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	if token == "" {
+		token = h.utilHandler.TimeGetCurTime()
+	}
+
+	if !h.hasPermission(ctx, a, a.CustomerID, amagent.PermissionCustomerAll) {
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	// filters
+	filters := map[string]string{
+		"deleted":     "false", // we don't need deleted items
+		"customer_id": a.CustomerID.String(),
+	}
+	if !h.hasPermission(ctx, a, a.CustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager) {
+		filters["owner_id"] = a.AgentID().String()
+	}
+
+	typedFilters, err := h.convertAIAssistFilters(filters)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not convert filters")
+	}
+
+	tmps, err := h.reqHandler.AIV1AIAssistList(ctx, token, size, typedFilters)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get ai assists info")
+	}
+
+	// create result
+	res := make([]*amaiassist.WebhookMessage, 0, len(tmps))
+	for _, t := range tmps {
+		res = append(res, t.ConvertWebhookMessage())
+	}
+
+	return res, nil
+}
+
+// convertAIAssistFilters converts map[string]string to map[amaiassist.Field]any
+func (h *serviceHandler) convertAIAssistFilters(filters map[string]string) (map[amaiassist.Field]any, error) {
+	srcAny := make(map[string]any, len(filters))
+	for k, v := range filters {
+		srcAny[k] = v
+	}
+
+	typed, err := commondatabasehandler.ConvertMapToTypedMap(srcAny, amaiassist.AIAssist{})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[amaiassist.Field]any, len(typed))
+	for k, v := range typed {
+		result[amaiassist.Field(k)] = v
+	}
+
+	return result, nil
+}
+
+// AIAssistGet gets the ai assist of the given id.
+// It returns ai assist if it succeed.
+func (h *serviceHandler) AIAssistGet(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*amaiassist.WebhookMessage, error) {
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	tmp, err := h.aiassistGet(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get ai assist info")
+	}
+
+	if !h.aiassistHasPermission(ctx, a, tmp) {
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	res := tmp.ConvertWebhookMessage()
+	return res, nil
+}
+
+// AIAssistDelete deletes the ai assist.
+func (h *serviceHandler) AIAssistDelete(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*amaiassist.WebhookMessage, error) {
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	c, err := h.aiassistGet(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get ai assist info")
+	}
+
+	if !h.aiassistHasPermission(ctx, a, c) {
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	tmp, err := h.reqHandler.AIV1AIAssistDelete(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not delete the ai assist")
+	}
+
+	res := tmp.ConvertWebhookMessage()
+	return res, nil
+}
+
+// AIAssistStop stops the ai assist.
+func (h *serviceHandler) AIAssistStop(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*amaiassist.WebhookMessage, error) {
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	c, err := h.aiassistGet(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get ai assist info")
+	}
+
+	if !h.aiassistHasPermission(ctx, a, c) {
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	tmp, err := h.reqHandler.AIV1AIAssistStop(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not stop the ai assist")
+	}
+
+	res := tmp.ConvertWebhookMessage()
+	return res, nil
+}
@@ -0,0 +1,466 @@
+package servicehandler
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	amagent "monorepo/bin-agent-manager/models/agent"
+	amaiassist "monorepo/bin-ai-manager/models/aiassist"
+	"monorepo/bin-api-manager/models/auth"
+	"monorepo/bin-api-manager/pkg/dbhandler"
+	cmcall "monorepo/bin-call-manager/models/call"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/requesthandler"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_AIAssistStart(t *testing.T) {
+	tests := []struct {
+		name string
+
+		agent         *auth.AuthIdentity
+		agentID       uuid.UUID
+		aiID          uuid.UUID
+		referenceType amaiassist.ReferenceType
+		referenceID   uuid.UUID
+		language      string
+		checklist     []string
+
+		responseCall   *cmcall.Call
+		responseAgent  *amagent.Agent
+		responseAssist *amaiassist.AIAssist
+
+		expectAgentID   uuid.UUID
+		expectChecklist []amaiassist.ChecklistItem
+		expectRes       *amaiassist.WebhookMessage
+	}{
+		{
+			name: "requesting agent",
+
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("5a1c2e3a-ad92-11f0-8f21-2b7c9d4e1a01"),
+					CustomerID: uuid.FromStringOrNil("5a4e7f9c-ad92-11f0-9c32-3c8d0e5f2b02"),
+				},
+				Permission: amagent.PermissionCustomerAgent,
+			}),
+			agentID:       uuid.Nil,
+			aiID:          uuid.FromStringOrNil("5a80e1fe-ad92-11f0-ad43-4d9e1f603c03"),
+			referenceType: amaiassist.ReferenceTypeCall,
+			referenceID:   uuid.FromStringOrNil("5ab34460-ad92-11f0-be54-5eaf20714d04"),
+			language:      "en-US",
+			checklist:     []string{"Confirm the customer's date of birth"},
+
+			responseCall: &cmcall.Call{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("5ab34460-ad92-11f0-be54-5eaf20714d04"),
+					CustomerID: uuid.FromStringOrNil("5a4e7f9c-ad92-11f0-9c32-3c8d0e5f2b02"),
+				},
+			},
+			responseAssist: &amaiassist.AIAssist{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("5ae5a6c2-ad92-11f0-8f65-6fb031825e05"),
+				},
+			},
+
+			expectAgentID: uuid.FromStringOrNil("5a1c2e3a-ad92-11f0-8f21-2b7c9d4e1a01"),
+			expectChecklist: []amaiassist.ChecklistItem{
+				{Name: "Confirm the customer's date of birth"},
+			},
+			expectRes: &amaiassist.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("5ae5a6c2-ad92-11f0-8f65-6fb031825e05"),
+				},
+			},
+		},
+		{
+			name: "other agent by the customer manager",
+
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("5b180924-ad92-11f0-9076-70c142936f06"),
+					CustomerID: uuid.FromStringOrNil("5b4a6b86-ad92-11f0-a187-81d253a48007"),
+				},
+				Permission: amagent.PermissionCustomerManager,
+			}),
+			agentID:       uuid.FromStringOrNil("5b7ccde8-ad92-11f0-b298-92e364b59108"),
+			aiID:          uuid.FromStringOrNil("5baf304a-ad92-11f0-83a9-a3f475c6a209"),
+			referenceType: amaiassist.ReferenceTypeCall,
+			referenceID:   uuid.FromStringOrNil("5be192ac-ad92-11f0-94ba-b40586d7b30a"),
+
+			responseCall: &cmcall.Call{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("5be192ac-ad92-11f0-94ba-b40586d7b30a"),
+					CustomerID: uuid.FromStringOrNil("5b4a6b86-ad92-11f0-a187-81d253a48007"),
+				},
+			},
+			responseAgent: &amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("5b7ccde8-ad92-11f0-b298-92e364b59108"),
+					CustomerID: uuid.FromStringOrNil("5b4a6b86-ad92-11f0-a187-81d253a48007"),
+				},
+			},
+			responseAssist: &amaiassist.AIAssist{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("5c13f50e-ad92-11f0-a5cb-c51697e8c40b"),
+				},
+			},
+
+			expectAgentID:   uuid.FromStringOrNil("5b7ccde8-ad92-11f0-b298-92e364b59108"),
+			expectChecklist: []amaiassist.ChecklistItem{},
+			expectRes: &amaiassist.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("5c13f50e-ad92-11f0-a5cb-c51697e8c40b"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+
+			h := serviceHandler{
+				reqHandler: mockReq,
+				dbHandler:  mockDB,
+			}
+			ctx := context.Background()
+
+			mockReq.EXPECT().CallV1CallGet(ctx, tt.referenceID).Return(tt.responseCall, nil)
+			if tt.responseAgent != nil {
+				mockReq.EXPECT().AgentV1AgentGet(ctx, tt.agentID).Return(tt.responseAgent, nil)
+			}
+			mockReq.EXPECT().AIV1AIAssistStart(
+				ctx,
+				tt.responseCall.CustomerID,
+				tt.expectAgentID,
+				tt.aiID,
+				tt.referenceType,
+				tt.referenceID,
+				tt.language,
+				tt.expectChecklist,
+			).Return(tt.responseAssist, nil)
+
+			res, err := h.AIAssistStart(ctx, tt.agent, tt.agentID, tt.aiID, tt.referenceType, tt.referenceID, tt.language, tt.checklist)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_AIAssistStart_error(t *testing.T) {
+	tests := []struct {
+		name string
+
+		agent         *auth.AuthIdentity
+		agentID       uuid.UUID
+		referenceType amaiassist.ReferenceType
+		referenceID   uuid.UUID
+
+		responseCall *cmcall.Call
+	}{
+		{
+			name: "unsupported reference type",
+
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("5c465770-ad92-11f0-b6dc-d627a8f9d50c"),
+					CustomerID: uuid.FromStringOrNil("5c78b9d2-ad92-11f0-87ed-e738b90ae60d"),
+				},
+				Permission: amagent.PermissionCustomerAgent,
+			}),
+			referenceType: amaiassist.ReferenceType("conference"),
+			referenceID:   uuid.FromStringOrNil("5cab1c34-ad92-11f0-98fe-f849ca1bf70e"),
+		},
+		{
+			name: "call of another customer",
+
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("5cdd7e96-ad92-11f0-aa0f-095adb2c080f"),
+					CustomerID: uuid.FromStringOrNil("5d0fe0f8-ad92-11f0-bb10-1a6bec3d1910"),
+				},
+				Permission: amagent.PermissionCustomerAdmin,
+			}),
+			referenceType: amaiassist.ReferenceTypeCall,
+			referenceID:   uuid.FromStringOrNil("5d42435a-ad92-11f0-8c21-2b7cfd4e2a11"),
+
+			responseCall: &cmcall.Call{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("5d42435a-ad92-11f0-8c21-2b7cfd4e2a11"),
+					CustomerID: uuid.FromStringOrNil("5d74a5bc-ad92-11f0-9d32-3c8d0e5f3b12"),
+				},
+			},
+		},
+		{
+			name: "other agent without the manager permission",
+
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("5da7081e-ad92-11f0-ae43-4d9e1f604c13"),
+					CustomerID: uuid.FromStringOrNil("5dd96a80-ad92-11f0-bf54-5eaf20715d14"),
+				},
+				Permission: amagent.PermissionCustomerAgent,
+			}),
+			agentID:       uuid.FromStringOrNil("5e0bcce2-ad92-11f0-8065-6fb031826e15"),
+			referenceType: amaiassist.ReferenceTypeCall,
+			referenceID:   uuid.FromStringOrNil("5e3e2f44-ad92-11f0-9176-70c142937f16"),
+
+			responseCall: &cmcall.Call{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("5e3e2f44-ad92-11f0-9176-70c142937f16"),
+					CustomerID: uuid.FromStringOrNil("5dd96a80-ad92-11f0-bf54-5eaf20715d14"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+
+			h := serviceHandler{
+				reqHandler: mockReq,
+				dbHandler:  mockDB,
+			}
+			ctx := context.Background()
+
+			if tt.responseCall != nil {
+				mockReq.EXPECT().CallV1CallGet(ctx, tt.referenceID).Return(tt.responseCall, nil)
+			}
+
+			_, err := h.AIAssistStart(ctx, tt.agent, tt.agentID, uuid.Nil, tt.referenceType, tt.referenceID, "", nil)
+			if err == nil {
+				t.Errorf("Wrong match. expect: error, got: ok")
+			}
+		})
+	}
+}
+
+func Test_AIAssistGetsByCustomerID(t *testing.T) {
+	tests := []struct {
+		name string
+
+		agent *auth.AuthIdentity
+		size  uint64
+		token string
+
+		responseAssists []amaiassist.AIAssist
+
+		expectFilters map[amaiassist.Field]any
+		expectRes     []*amaiassist.WebhookMessage
+	}{
+		{
+			name: "customer admin",
+
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("5e7091a6-ad92-11f0-a287-81d253a49017"),
+					CustomerID: uuid.FromStringOrNil("5ea2f408-ad92-11f0-b398-92e364b5a118"),
+				},
+				Permission: amagent.PermissionCustomerAdmin,
+			}),
+			size:  10,
+			token: "2020-09-20T03:23:20.995000Z",
+
+			responseAssists: []amaiassist.AIAssist{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("5ed5566a-ad92-11f0-84a9-a3f475c6b219"),
+					},
+				},
+			},
+
+			expectFilters: map[amaiassist.Field]any{
+				amaiassist.FieldDeleted:    false,
+				amaiassist.FieldCustomerID: uuid.FromStringOrNil("5ea2f408-ad92-11f0-b398-92e364b5a118"),
+			},
+			expectRes: []*amaiassist.WebhookMessage{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("5ed5566a-ad92-11f0-84a9-a3f475c6b219"),
+					},
+				},
+			},
+		},
+		{
+			name: "customer agent gets own assists only",
+
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("5f07b8cc-ad92-11f0-95ba-b40586d7c31a"),
+					CustomerID: uuid.FromStringOrNil("5f3a1b2e-ad92-11f0-a6cb-c51697e8d41b"),
+				},
+				Permission: amagent.PermissionCustomerAgent,
+			}),
+			size:  10,
+			token: "2020-09-20T03:23:20.995000Z",
+
+			responseAssists: []amaiassist.AIAssist{},
+
+			expectFilters: map[amaiassist.Field]any{
+				amaiassist.FieldDeleted:    false,
+				amaiassist.FieldCustomerID: uuid.FromStringOrNil("5f3a1b2e-ad92-11f0-a6cb-c51697e8d41b"),
+				amaiassist.FieldOwnerID:    uuid.FromStringOrNil("5f07b8cc-ad92-11f0-95ba-b40586d7c31a"),
+			},
+			expectRes: []*amaiassist.WebhookMessage{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+
+			h := serviceHandler{
+				reqHandler:  mockReq,
+				dbHandler:   mockDB,
+				utilHandler: mockUtil,
+			}
+			ctx := context.Background()
+
+			mockReq.EXPECT().AIV1AIAssistList(ctx, tt.token, tt.size, tt.expectFilters).Return(tt.responseAssists, nil)
+
+			res, err := h.AIAssistGetsByCustomerID(ctx, tt.agent, tt.size, tt.token)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_AIAssistStop(t *testing.T) {
+	tests := []struct {
+		name string
+
+		agent *auth.AuthIdentity
+		id    uuid.UUID
+
+		responseAssist *amaiassist.AIAssist
+
+		expectRes *amaiassist.WebhookMessage
+		expectErr bool
+	}{
+		{
+			name: "assisted agent",
+
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("5f6c7d90-ad92-11f0-b7dc-d627a8f9e51c"),
+					CustomerID: uuid.FromStringOrNil("5f9edff2-ad92-11f0-88ed-e738b90af61d"),
+				},
+				Permission: amagent.PermissionCustomerAgent,
+			}),
+			id: uuid.FromStringOrNil("5fd14254-ad92-11f0-99fe-f849ca1c071e"),
+
+			responseAssist: &amaiassist.AIAssist{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("5fd14254-ad92-11f0-99fe-f849ca1c071e"),
+					CustomerID: uuid.FromStringOrNil("5f9edff2-ad92-11f0-88ed-e738b90af61d"),
+				},
+				Owner: commonidentity.Owner{
+					OwnerType: commonidentity.OwnerTypeAgent,
+					OwnerID:   uuid.FromStringOrNil("5f6c7d90-ad92-11f0-b7dc-d627a8f9e51c"),
+				},
+			},
+
+			expectRes: &amaiassist.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("5fd14254-ad92-11f0-99fe-f849ca1c071e"),
+					CustomerID: uuid.FromStringOrNil("5f9edff2-ad92-11f0-88ed-e738b90af61d"),
+				},
+				Owner: commonidentity.Owner{
+					OwnerType: commonidentity.OwnerTypeAgent,
+					OwnerID:   uuid.FromStringOrNil("5f6c7d90-ad92-11f0-b7dc-d627a8f9e51c"),
+				},
+			},
+		},
+		{
+			name: "other agent",
+
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("6003a4b6-ad92-11f0-aa0f-095adb2c181f"),
+					CustomerID: uuid.FromStringOrNil("60360718-ad92-11f0-bb10-1a6bec3d2920"),
+				},
+				Permission: amagent.PermissionCustomerAgent,
+			}),
+			id: uuid.FromStringOrNil("6068697a-ad92-11f0-8c21-2b7cfd4e3a21"),
+
+			responseAssist: &amaiassist.AIAssist{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("6068697a-ad92-11f0-8c21-2b7cfd4e3a21"),
+					CustomerID: uuid.FromStringOrNil("60360718-ad92-11f0-bb10-1a6bec3d2920"),
+				},
+				Owner: commonidentity.Owner{
+					OwnerType: commonidentity.OwnerTypeAgent,
+					OwnerID:   uuid.FromStringOrNil("609acbdc-ad92-11f0-9d32-3c8d0e5f4b22"),
+				},
+			},
+
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+
+			h := serviceHandler{
+				reqHandler: mockReq,
+				dbHandler:  mockDB,
+			}
+			ctx := context.Background()
+
+			mockReq.EXPECT().AIV1AIAssistGet(ctx, tt.id).Return(tt.responseAssist, nil)
+			if !tt.expectErr {
+				mockReq.EXPECT().AIV1AIAssistStop(ctx, tt.id).Return(tt.responseAssist, nil)
+			}
+
+			res, err := h.AIAssistStop(ctx, tt.agent, tt.id)
+			if tt.expectErr {
+				if err == nil {
+					t.Errorf("Wrong match. expect: error, got: ok")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
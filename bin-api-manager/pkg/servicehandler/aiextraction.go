@@ -0,0 +1,215 @@
+package servicehandler
+
+import (
+	"context"
+	"fmt"
+	amagent "monorepo/bin-agent-manager/models/agent"
+	amextraction "monorepo/bin-ai-manager/models/extraction"
+	"monorepo/bin-api-manager/models/auth"
+	"monorepo/bin-api-manager/pkg/serviceerrors"
+	commondatabasehandler "monorepo/bin-common-handler/pkg/databasehandler"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+)
+
+// AIExtractionCreate is a service handler for ai extraction creation.
+func (h *serviceHandler) AIExtractionCreate(
+	ctx context.Context,
+	a *auth.AuthIdentity,
+	onEndFlowID uuid.UUID,
+	referenceType amextraction.ReferenceType,
+	referenceID uuid.UUID,
+	language string,
+	schema map[string]any,
+	setVariables bool,
+) (*amextraction.WebhookMessage, error) {
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	var tmpCustomerID uuid.UUID
+	// get reference's customer info
+	switch referenceType {
+	case amextraction.ReferenceTypeCall:
+		tmp, err := h.callGet(ctx, referenceID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get call info")
+		}
+		tmpCustomerID = tmp.CustomerID
+
+	case amextraction.ReferenceTypeTranscribe:
+		tmp, err := h.transcribeGet(ctx, referenceID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get transcribe info")
+		}
+		tmpCustomerID = tmp.CustomerID
+
+	case amextraction.ReferenceTypeRecording:
+		tmp, err := h.recordingGet(ctx, referenceID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get recording info")
+		}
+		tmpCustomerID = tmp.CustomerID
+
+	case amextraction.ReferenceTypeConference:
+		tmp, err := h.conferenceGet(ctx, referenceID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get conference info")
+		}
+		tmpCustomerID = tmp.CustomerID
+
+	case amextraction.ReferenceTypeAIcall:
+		tmp, err := h.aicallGet(ctx, referenceID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get aicall info")
+		}
+		tmpCustomerID = tmp.CustomerID
+
+	default:
+		return nil, fmt.Errorf("%w: unsupported reference type", serviceerrors.ErrInvalidArgument)
+	}
+
+	if !h.hasPermission(ctx, a, tmpCustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager) {
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	tmp, err := h.reqHandler.AIV1ExtractionCreate(
+		ctx,
+		a.CustomerID,
+		uuid.Nil,
+		onEndFlowID,
+		referenceType,
+		referenceID,
+		language,
+		schema,
+		setVariables,
+		50000,
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not create ai extraction")
+	}
+
+	res := tmp.ConvertWebhookMessage()
+	return res, nil
+}
+
+// aiextractionGet returns the ai extraction info.
+func (h *serviceHandler) aiextractionGet(ctx context.Context, id uuid.UUID) (*amextraction.Extraction, error) {
+	// send request
+	res, err := h.reqHandler.AIV1ExtractionGet(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get the resource info")
+	}
+
+	return res, nil
+}
+
+// AIExtractionGetsByCustomerID gets the list of aiextractions of the given customer id.
+// It returns list of aiextractions if it succeed.
+func (h *serviceHandler) AIExtractionGetsByCustomerID(ctx context.Context, a *auth.AuthIdentity, size uint64, token string) ([]*amextraction.WebhookMessage, error) {
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	if token == "" {
+		token = h.utilHandler.TimeGetCurTime()
+	}
+
+	if !h.hasPermission(ctx, a, a.CustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager) {
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	// filters
+	filters := map[string]string{
+		"deleted":     "false", // we don't need deleted items
+		"customer_id": a.CustomerID.String(),
+	}
+
+	// Convert string filters to typed filters
+	typedFilters, err := h.convertAIExtractionFilters(filters)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not convert filters")
+	}
+
+	tmps, err := h.reqHandler.AIV1ExtractionList(ctx, token, size, typedFilters)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get ai extractions info")
+	}
+
+	// create result
+	res := []*amextraction.WebhookMessage{}
+	for _, f := range tmps {
+		tmp := f.ConvertWebhookMessage()
+		res = append(res, tmp)
+	}
+
+	return res, nil
+}
+
+// convertAIExtractionFilters converts map[string]string to map[amextraction.Field]any
+func (h *serviceHandler) convertAIExtractionFilters(filters map[string]string) (map[amextraction.Field]any, error) {
+	// Convert to map[string]any first
+	srcAny := make(map[string]any, len(filters))
+	for k, v := range filters {
+		srcAny[k] = v
+	}
+
+	// Use reflection-based converter
+	typed, err := commondatabasehandler.ConvertMapToTypedMap(srcAny, amextraction.Extraction{})
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert string keys to Field type
+	result := make(map[amextraction.Field]any, len(typed))
+	for k, v := range typed {
+		result[amextraction.Field(k)] = v
+	}
+
+	return result, nil
+}
+
+// AIExtractionGet gets the ai extraction of the given id.
+// It returns ai extraction if it succeed.
+func (h *serviceHandler) AIExtractionGet(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*amextraction.WebhookMessage, error) {
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	tmp, err := h.aiextractionGet(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get ai extractions info")
+	}
+
+	if !h.hasPermission(ctx, a, tmp.CustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager) {
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	res := tmp.ConvertWebhookMessage()
+	return res, nil
+}
+
+// AIExtractionDelete deletes the ai extraction.
+func (h *serviceHandler) AIExtractionDelete(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*amextraction.WebhookMessage, error) {
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	c, err := h.aiextractionGet(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get ai extraction info")
+	}
+
+	if !h.hasPermission(ctx, a, c.CustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager) {
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	tmp, err := h.reqHandler.AIV1ExtractionDelete(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not delete the ai extractions")
+	}
+
+	res := tmp.ConvertWebhookMessage()
+	return res, nil
+}
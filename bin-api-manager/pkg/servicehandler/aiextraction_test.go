@@ -0,0 +1,330 @@
+package servicehandler
+
+import (
+	"context"
+	amagent "monorepo/bin-agent-manager/models/agent"
+	amextraction "monorepo/bin-ai-manager/models/extraction"
+	"monorepo/bin-api-manager/models/auth"
+	"monorepo/bin-api-manager/pkg/dbhandler"
+	cmcall "monorepo/bin-call-manager/models/call"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/requesthandler"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+	"reflect"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_AIExtractionCreate_referencetype_call(t *testing.T) {
+
+	type test struct {
+		name string
+
+		agent         *auth.AuthIdentity
+		onEndFlowID   uuid.UUID
+		referenceType amextraction.ReferenceType
+		referenceID   uuid.UUID
+		language      string
+		schema        map[string]any
+		setVariables  bool
+
+		responseCall       *cmcall.Call
+		responseExtraction *amextraction.Extraction
+
+		expectRes *amextraction.WebhookMessage
+	}
+
+	tests := []test{
+		{
+			name: "normal",
+
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("b270ed74-cbca-11f1-8300-02fc00000001"),
+					CustomerID: uuid.FromStringOrNil("b270ee64-cbca-11f1-8300-02fc00000001"),
+				},
+				Permission: amagent.PermissionProjectSuperAdmin,
+			}),
+			onEndFlowID:   uuid.FromStringOrNil("b270eec8-cbca-11f1-8300-02fc00000001"),
+			referenceType: amextraction.ReferenceTypeCall,
+			referenceID:   uuid.FromStringOrNil("b270ef2c-cbca-11f1-8300-02fc00000001"),
+			language:      "en-US",
+			schema: map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"intent": map[string]any{"type": "string"},
+				},
+			},
+			setVariables: true,
+
+			responseCall: &cmcall.Call{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("b270ef2c-cbca-11f1-8300-02fc00000001"),
+					CustomerID: uuid.FromStringOrNil("b270ee64-cbca-11f1-8300-02fc00000001"),
+				},
+				TMDelete: nil,
+			},
+			responseExtraction: &amextraction.Extraction{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("b270f01c-cbca-11f1-8300-02fc00000001"),
+				},
+			},
+
+			expectRes: &amextraction.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("b270f01c-cbca-11f1-8300-02fc00000001"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+
+			h := serviceHandler{
+				reqHandler:  mockReq,
+				dbHandler:   mockDB,
+				utilHandler: mockUtil,
+			}
+			ctx := context.Background()
+
+			mockReq.EXPECT().CallV1CallGet(ctx, tt.referenceID).Return(tt.responseCall, nil)
+			mockReq.EXPECT().AIV1ExtractionCreate(
+				ctx,
+				tt.agent.CustomerID,
+				uuid.Nil,
+				tt.onEndFlowID,
+				tt.referenceType,
+				tt.referenceID,
+				tt.language,
+				tt.schema,
+				tt.setVariables,
+				gomock.Any(),
+			).Return(tt.responseExtraction, nil)
+
+			res, err := h.AIExtractionCreate(ctx, tt.agent, tt.onEndFlowID, tt.referenceType, tt.referenceID, tt.language, tt.schema, tt.setVariables)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v\n", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_AIExtractionListByCustomerID(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		agent   *auth.AuthIdentity
+		size    uint64
+		token   string
+		filters map[amextraction.Field]any
+
+		response  []amextraction.Extraction
+		expectRes []*amextraction.WebhookMessage
+	}{
+		{
+			name: "normal",
+
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("b270f1a2-cbca-11f1-8300-02fc00000001"),
+					CustomerID: uuid.FromStringOrNil("b270f1fc-cbca-11f1-8300-02fc00000001"),
+				},
+				Permission: amagent.PermissionCustomerAdmin,
+			}),
+			size:  10,
+			token: "2020-09-20T03:23:20.995000Z",
+			filters: map[amextraction.Field]any{
+				amextraction.FieldDeleted:    false,
+				amextraction.FieldCustomerID: uuid.FromStringOrNil("b270f1fc-cbca-11f1-8300-02fc00000001"),
+			},
+
+			response: []amextraction.Extraction{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("b270f2a6-cbca-11f1-8300-02fc00000001"),
+					},
+				},
+			},
+			expectRes: []*amextraction.WebhookMessage{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("b270f2a6-cbca-11f1-8300-02fc00000001"),
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			h := serviceHandler{
+				reqHandler: mockReq,
+				dbHandler:  mockDB,
+			}
+			ctx := context.Background()
+
+			mockReq.EXPECT().AIV1ExtractionList(ctx, tt.token, tt.size, tt.filters).Return(tt.response, nil)
+
+			res, err := h.AIExtractionGetsByCustomerID(ctx, tt.agent, tt.size, tt.token)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect:%v\ngot:%v\n", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_AIExtractionGet(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		agent       *auth.AuthIdentity
+		aisummaryID uuid.UUID
+
+		response  *amextraction.Extraction
+		expectRes *amextraction.WebhookMessage
+	}{
+		{
+			name: "normal",
+
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("d152e69e-105b-11ee-b395-eb18426de979"),
+					CustomerID: uuid.FromStringOrNil("5f621078-8e5f-11ee-97b2-cfe7337b701c"),
+				},
+				Permission: amagent.PermissionCustomerAdmin,
+			}),
+			aisummaryID: uuid.FromStringOrNil("b270f418-cbca-11f1-8300-02fc00000001"),
+
+			response: &amextraction.Extraction{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("b270f418-cbca-11f1-8300-02fc00000001"),
+					CustomerID: uuid.FromStringOrNil("5f621078-8e5f-11ee-97b2-cfe7337b701c"),
+				},
+			},
+			expectRes: &amextraction.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("b270f418-cbca-11f1-8300-02fc00000001"),
+					CustomerID: uuid.FromStringOrNil("5f621078-8e5f-11ee-97b2-cfe7337b701c"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			h := serviceHandler{
+				reqHandler: mockReq,
+				dbHandler:  mockDB,
+			}
+			ctx := context.Background()
+
+			mockReq.EXPECT().AIV1ExtractionGet(ctx, tt.aisummaryID).Return(tt.response, nil)
+
+			res, err := h.AIExtractionGet(ctx, tt.agent, tt.aisummaryID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect:%v\ngot:%v\n", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_AIExtractionDelete(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		agent       *auth.AuthIdentity
+		aisummaryID uuid.UUID
+
+		responseAIExtraction *amextraction.Extraction
+		expectRes            *amextraction.WebhookMessage
+	}{
+		{
+			name: "normal",
+
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("d152e69e-105b-11ee-b395-eb18426de979"),
+					CustomerID: uuid.FromStringOrNil("5f621078-8e5f-11ee-97b2-cfe7337b701c"),
+				},
+				Permission: amagent.PermissionCustomerAdmin,
+			}),
+			aisummaryID: uuid.FromStringOrNil("b270f58a-cbca-11f1-8300-02fc00000001"),
+
+			responseAIExtraction: &amextraction.Extraction{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("b270f58a-cbca-11f1-8300-02fc00000001"),
+					CustomerID: uuid.FromStringOrNil("5f621078-8e5f-11ee-97b2-cfe7337b701c"),
+				},
+			},
+			expectRes: &amextraction.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("b270f58a-cbca-11f1-8300-02fc00000001"),
+					CustomerID: uuid.FromStringOrNil("5f621078-8e5f-11ee-97b2-cfe7337b701c"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			h := serviceHandler{
+				reqHandler: mockReq,
+				dbHandler:  mockDB,
+			}
+			ctx := context.Background()
+
+			mockReq.EXPECT().AIV1ExtractionGet(ctx, tt.aisummaryID).Return(tt.responseAIExtraction, nil)
+			mockReq.EXPECT().AIV1ExtractionDelete(ctx, tt.aisummaryID).Return(tt.responseAIExtraction, nil)
+
+			res, err := h.AIExtractionDelete(ctx, tt.agent, tt.aisummaryID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
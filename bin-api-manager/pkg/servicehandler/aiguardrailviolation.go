@@ -0,0 +1,114 @@
+package servicehandler
+
+import (
+	"context"
+
+	amagent "monorepo/bin-agent-manager/models/agent"
+	amguardrailviolation "monorepo/bin-ai-manager/models/guardrailviolation"
+	"monorepo/bin-api-manager/models/auth"
+	"monorepo/bin-api-manager/pkg/serviceerrors"
+	commondatabasehandler "monorepo/bin-common-handler/pkg/databasehandler"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+)
+
+// aiguardrailviolationGet returns the ai guardrail violation info.
+func (h *serviceHandler) aiguardrailviolationGet(ctx context.Context, id uuid.UUID) (*amguardrailviolation.GuardrailViolation, error) {
+	res, err := h.reqHandler.AIV1GuardrailViolationGet(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get ai guardrail violation info")
+	}
+
+	return res, nil
+}
+
+// AIGuardrailViolationGetsByCustomerID returns a paginated list of ai guardrail violations for the authenticated customer.
+func (h *serviceHandler) AIGuardrailViolationGetsByCustomerID(
+	ctx context.Context,
+	a *auth.AuthIdentity,
+	size uint64,
+	token string,
+	aicallID uuid.UUID,
+	aiID uuid.UUID,
+) ([]*amguardrailviolation.WebhookMessage, error) {
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	if token == "" {
+		token = h.utilHandler.TimeGetCurTime()
+	}
+
+	if !h.hasPermission(ctx, a, a.CustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager) {
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	filters := map[string]string{
+		"deleted":     "false",
+		"customer_id": a.CustomerID.String(),
+	}
+
+	if aicallID != uuid.Nil {
+		filters["aicall_id"] = aicallID.String()
+	}
+
+	if aiID != uuid.Nil {
+		filters["ai_id"] = aiID.String()
+	}
+
+	typedFilters, err := h.convertAIGuardrailViolationFilters(filters)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not convert ai guardrail violation filters")
+	}
+
+	tmps, err := h.reqHandler.AIV1GuardrailViolationList(ctx, token, size, typedFilters)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get ai guardrail violations info")
+	}
+
+	res := make([]*amguardrailviolation.WebhookMessage, 0, len(tmps))
+	for _, t := range tmps {
+		res = append(res, t.ConvertWebhookMessage())
+	}
+
+	return res, nil
+}
+
+// convertAIGuardrailViolationFilters converts map[string]string to map[amguardrailviolation.Field]any.
+func (h *serviceHandler) convertAIGuardrailViolationFilters(filters map[string]string) (map[amguardrailviolation.Field]any, error) {
+	srcAny := make(map[string]any, len(filters))
+	for k, v := range filters {
+		srcAny[k] = v
+	}
+
+	typed, err := commondatabasehandler.ConvertMapToTypedMap(srcAny, amguardrailviolation.GuardrailViolation{})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[amguardrailviolation.Field]any, len(typed))
+	for k, v := range typed {
+		result[amguardrailviolation.Field(k)] = v
+	}
+
+	return result, nil
+}
+
+// AIGuardrailViolationGet returns a single ai guardrail violation by ID after checking ownership.
+func (h *serviceHandler) AIGuardrailViolationGet(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*amguardrailviolation.WebhookMessage, error) {
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	tmp, err := h.aiguardrailviolationGet(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get ai guardrail violation info")
+	}
+
+	if !h.hasPermission(ctx, a, tmp.CustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager) {
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	return tmp.ConvertWebhookMessage(), nil
+}
@@ -0,0 +1,229 @@
+package servicehandler
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	amagent "monorepo/bin-agent-manager/models/agent"
+	amguardrailviolation "monorepo/bin-ai-manager/models/guardrailviolation"
+	"monorepo/bin-api-manager/models/auth"
+	"monorepo/bin-api-manager/pkg/dbhandler"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/requesthandler"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_AIGuardrailViolationGetsByCustomerID(t *testing.T) {
+	tests := []struct {
+		name string
+
+		agent    *auth.AuthIdentity
+		size     uint64
+		token    string
+		aicallID uuid.UUID
+		aiID     uuid.UUID
+
+		mockToken          string
+		responseViolations []amguardrailviolation.GuardrailViolation
+
+		expectFilters map[amguardrailviolation.Field]any
+		expectRes     []*amguardrailviolation.WebhookMessage
+	}{
+		{
+			name: "normal",
+
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("f1a2b3c4-ad1c-11f0-9e1a-2b3c4d5e6f70"),
+					CustomerID: uuid.FromStringOrNil("f1d4e5f6-ad1c-11f0-8c2b-3c4d5e6f7081"),
+				},
+				Permission: amagent.PermissionCustomerAdmin,
+			}),
+			size:     10,
+			token:    "2020-09-20T03:23:20.995000Z",
+			aicallID: uuid.FromStringOrNil("f2071829-ad1c-11f0-a3d4-4d5e6f708192"),
+			aiID:     uuid.Nil,
+
+			responseViolations: []amguardrailviolation.GuardrailViolation{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("f2398a4b-ad1c-11f0-b4e5-5e6f708192a3"),
+					},
+					Rule: amguardrailviolation.RuleBlockedTopic,
+				},
+			},
+
+			expectFilters: map[amguardrailviolation.Field]any{
+				amguardrailviolation.FieldDeleted:    false,
+				amguardrailviolation.FieldCustomerID: uuid.FromStringOrNil("f1d4e5f6-ad1c-11f0-8c2b-3c4d5e6f7081"),
+				amguardrailviolation.FieldAIcallID:   uuid.FromStringOrNil("f2071829-ad1c-11f0-a3d4-4d5e6f708192"),
+			},
+			expectRes: []*amguardrailviolation.WebhookMessage{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("f2398a4b-ad1c-11f0-b4e5-5e6f708192a3"),
+					},
+					Rule: amguardrailviolation.RuleBlockedTopic,
+				},
+			},
+		},
+		{
+			name: "empty_token_uses_current_time",
+
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("f26bfc6d-ad1c-11f0-85f6-6f708192a3b4"),
+					CustomerID: uuid.FromStringOrNil("f29e6e8f-ad1c-11f0-96a7-708192a3b4c5"),
+				},
+				Permission: amagent.PermissionCustomerAdmin,
+			}),
+			size:     10,
+			token:    "",
+			aicallID: uuid.Nil,
+			aiID:     uuid.Nil,
+
+			mockToken: "2020-09-20T03:23:20.995000Z",
+
+			responseViolations: []amguardrailviolation.GuardrailViolation{},
+
+			expectFilters: map[amguardrailviolation.Field]any{
+				amguardrailviolation.FieldDeleted:    false,
+				amguardrailviolation.FieldCustomerID: uuid.FromStringOrNil("f29e6e8f-ad1c-11f0-96a7-708192a3b4c5"),
+			},
+			expectRes: []*amguardrailviolation.WebhookMessage{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+
+			h := serviceHandler{
+				reqHandler:  mockReq,
+				dbHandler:   mockDB,
+				utilHandler: mockUtil,
+			}
+			ctx := context.Background()
+
+			effectiveToken := tt.token
+			if tt.token == "" {
+				mockUtil.EXPECT().TimeGetCurTime().Return(tt.mockToken)
+				effectiveToken = tt.mockToken
+			}
+
+			mockReq.EXPECT().AIV1GuardrailViolationList(ctx, effectiveToken, tt.size, tt.expectFilters).Return(tt.responseViolations, nil)
+
+			res, err := h.AIGuardrailViolationGetsByCustomerID(ctx, tt.agent, tt.size, tt.token, tt.aicallID, tt.aiID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_AIGuardrailViolationGet(t *testing.T) {
+	tests := []struct {
+		name string
+
+		agent       *auth.AuthIdentity
+		violationID uuid.UUID
+
+		responseViolation *amguardrailviolation.GuardrailViolation
+		responseErr       error
+
+		expectRes *amguardrailviolation.WebhookMessage
+		expectErr bool
+	}{
+		{
+			name: "normal",
+
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("f2d0e0b1-ad1c-11f0-a7b8-8192a3b4c5d6"),
+					CustomerID: uuid.FromStringOrNil("f30352d3-ad1c-11f0-b8c9-92a3b4c5d6e7"),
+				},
+				Permission: amagent.PermissionCustomerAdmin,
+			}),
+			violationID: uuid.FromStringOrNil("f335c4f5-ad1c-11f0-89da-a3b4c5d6e7f8"),
+
+			responseViolation: &amguardrailviolation.GuardrailViolation{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("f335c4f5-ad1c-11f0-89da-a3b4c5d6e7f8"),
+					CustomerID: uuid.FromStringOrNil("f30352d3-ad1c-11f0-b8c9-92a3b4c5d6e7"),
+				},
+			},
+
+			expectRes: &amguardrailviolation.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("f335c4f5-ad1c-11f0-89da-a3b4c5d6e7f8"),
+					CustomerID: uuid.FromStringOrNil("f30352d3-ad1c-11f0-b8c9-92a3b4c5d6e7"),
+				},
+			},
+		},
+		{
+			name: "error_not_found",
+
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("f3683717-ad1c-11f0-9aeb-b4c5d6e7f809"),
+					CustomerID: uuid.FromStringOrNil("f39aa939-ad1c-11f0-abfc-c5d6e7f8091a"),
+				},
+				Permission: amagent.PermissionCustomerAdmin,
+			}),
+			violationID: uuid.FromStringOrNil("f3cd1b5b-ad1c-11f0-bc0d-d6e7f8091a2b"),
+
+			responseViolation: nil,
+			responseErr:       fmt.Errorf("not found"),
+
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+
+			h := serviceHandler{
+				reqHandler: mockReq,
+				dbHandler:  mockDB,
+			}
+			ctx := context.Background()
+
+			mockReq.EXPECT().AIV1GuardrailViolationGet(ctx, tt.violationID).Return(tt.responseViolation, tt.responseErr)
+
+			res, err := h.AIGuardrailViolationGet(ctx, tt.agent, tt.violationID)
+			if tt.expectErr {
+				if err == nil {
+					t.Errorf("Wrong match. expect: error, got: ok")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
@@ -297,6 +297,43 @@ func (h *serviceHandler) BillingAccountSelfUpdatePaymentInfo(ctx context.Context
 	return tmp.ConvertWebhookMessage(), nil
 }
 
+// BillingAccountSelfUpdateSpendingSettings updates the authenticated agent's own billing account's spend limits, low balance alerts and auto top-up.
+func (h *serviceHandler) BillingAccountSelfUpdateSpendingSettings(ctx context.Context, a *auth.AuthIdentity, spendLimits []bmaccount.SpendLimit, balanceAlertThresholds []int64, autoTopUpThreshold int64, autoTopUpAmount int64) (*bmaccount.WebhookMessage, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":        "BillingAccountSelfUpdateSpendingSettings",
+		"customer_id": a.CustomerID,
+	})
+
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	if !h.hasPermission(ctx, a, a.CustomerID, amagent.PermissionCustomerAdmin) {
+		log.Info("The agent has no permission.")
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	c, err := h.customerGet(ctx, a.CustomerID)
+	if err != nil {
+		log.Errorf("Could not get the customer info. err: %v", err)
+		return nil, err
+	}
+	log.WithField("customer_id", c.ID).Debugf("Retrieved customer info. customer_id: %s", c.ID)
+
+	if c.BillingAccountID == uuid.Nil {
+		log.Info("Customer has no billing account.")
+		return nil, fmt.Errorf("%w: customer has no billing account configured", serviceerrors.ErrStateInvalid)
+	}
+
+	tmp, err := h.reqHandler.BillingV1AccountUpdateSpendingSettings(ctx, c.BillingAccountID, spendLimits, balanceAlertThresholds, autoTopUpThreshold, autoTopUpAmount)
+	if err != nil {
+		log.Infof("Could not update account spending settings. err: %v", err)
+		return nil, err
+	}
+
+	return tmp.ConvertWebhookMessage(), nil
+}
+
 // BillingAccountSelfCreatePaddlePortalSession creates a Paddle portal session for the authenticated user.
 func (h *serviceHandler) BillingAccountSelfCreatePaddlePortalSession(ctx context.Context, a *auth.AuthIdentity) (string, error) {
 	log := logrus.WithFields(logrus.Fields{
@@ -979,6 +979,107 @@ func Test_BillingAccountSelfUpdatePaymentInfo(t *testing.T) {
 	}
 }
 
+func Test_BillingAccountSelfUpdateSpendingSettings(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		agent                  *auth.AuthIdentity
+		spendLimits            []bmaccount.SpendLimit
+		balanceAlertThresholds []int64
+		autoTopUpThreshold     int64
+		autoTopUpAmount        int64
+
+		responseCustomer       *cscustomer.Customer
+		responseBillingAccount *bmaccount.Account
+		expectRes              *bmaccount.WebhookMessage
+	}{
+		{
+			name: "normal",
+
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("d152e69e-105b-11ee-b395-eb18426de979"),
+					CustomerID: uuid.FromStringOrNil("5f621078-8e5f-11ee-97b2-cfe7337b701c"),
+				},
+				Permission: amagent.PermissionCustomerAdmin,
+			}),
+			spendLimits: []bmaccount.SpendLimit{
+				{
+					Period:      bmaccount.SpendLimitPeriodDaily,
+					LimitCredit: 10000000,
+				},
+			},
+			balanceAlertThresholds: []int64{5000000},
+			autoTopUpThreshold:     5000000,
+			autoTopUpAmount:        20000000,
+
+			responseCustomer: &cscustomer.Customer{
+				ID:               uuid.FromStringOrNil("5f621078-8e5f-11ee-97b2-cfe7337b701c"),
+				BillingAccountID: uuid.FromStringOrNil("0a0fc97c-4cdc-11ee-ac88-130f1afddcfa"),
+			},
+			responseBillingAccount: &bmaccount.Account{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("0a0fc97c-4cdc-11ee-ac88-130f1afddcfa"),
+					CustomerID: uuid.FromStringOrNil("5f621078-8e5f-11ee-97b2-cfe7337b701c"),
+				},
+				SpendLimits: []bmaccount.SpendLimit{
+					{
+						Period:      bmaccount.SpendLimitPeriodDaily,
+						LimitCredit: 10000000,
+					},
+				},
+				BalanceAlertThresholds: []int64{5000000},
+				AutoTopUpThreshold:     5000000,
+				AutoTopUpAmount:        20000000,
+			},
+			expectRes: &bmaccount.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("0a0fc97c-4cdc-11ee-ac88-130f1afddcfa"),
+					CustomerID: uuid.FromStringOrNil("5f621078-8e5f-11ee-97b2-cfe7337b701c"),
+				},
+				SpendLimits: []bmaccount.SpendLimit{
+					{
+						Period:      bmaccount.SpendLimitPeriodDaily,
+						LimitCredit: 10000000,
+					},
+				},
+				BalanceAlertThresholds: []int64{5000000},
+				AutoTopUpThreshold:     5000000,
+				AutoTopUpAmount:        20000000,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+
+			h := serviceHandler{
+				reqHandler: mockReq,
+				dbHandler:  mockDB,
+			}
+			ctx := context.Background()
+
+			mockReq.EXPECT().CustomerV1CustomerGet(ctx, tt.agent.CustomerID).Return(tt.responseCustomer, nil)
+			mockReq.EXPECT().BillingV1AccountUpdateSpendingSettings(ctx, tt.responseCustomer.BillingAccountID, tt.spendLimits, tt.balanceAlertThresholds, tt.autoTopUpThreshold, tt.autoTopUpAmount).Return(tt.responseBillingAccount, nil)
+
+			res, err := h.BillingAccountSelfUpdateSpendingSettings(ctx, tt.agent, tt.spendLimits, tt.balanceAlertThresholds, tt.autoTopUpThreshold, tt.autoTopUpAmount)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v\n", tt.expectRes, res)
+			}
+		})
+	}
+}
+
 func Test_BillingAccountSelfUpdatePaymentInfo_NoBillingAccount(t *testing.T) {
 
 	tests := []struct {
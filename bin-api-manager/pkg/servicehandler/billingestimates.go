@@ -0,0 +1,45 @@
+package servicehandler
+
+import (
+	"context"
+
+	"monorepo/bin-api-manager/models/auth"
+	"monorepo/bin-api-manager/pkg/serviceerrors"
+	bmbilling "monorepo/bin-billing-manager/models/billing"
+	bmestimate "monorepo/bin-billing-manager/models/estimate"
+
+	amagent "monorepo/bin-agent-manager/models/agent"
+	commonaddress "monorepo/bin-common-handler/models/address"
+
+	"github.com/sirupsen/logrus"
+)
+
+// BillingEstimateQuote sends a request to billing-manager
+// to quote the cost of the given usage.
+// it returns the estimate if it succeed.
+func (h *serviceHandler) BillingEstimateQuote(ctx context.Context, a *auth.AuthIdentity, costType bmbilling.CostType, destination *commonaddress.Address, duration int) (*bmestimate.Estimate, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":        "BillingEstimateQuote",
+		"customer_id": a.CustomerID,
+		"username":    a.DisplayName(),
+		"cost_type":   costType,
+		"destination": destination,
+		"duration":    duration,
+	})
+
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	if !h.hasPermission(ctx, a, a.CustomerID, amagent.PermissionCustomerAdmin) {
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	res, err := h.reqHandler.BillingV1EstimateQuote(ctx, a.CustomerID, costType, destination, duration)
+	if err != nil {
+		log.Errorf("Could not quote the cost. err: %v", err)
+		return nil, err
+	}
+
+	return res, nil
+}
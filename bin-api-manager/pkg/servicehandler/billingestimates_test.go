@@ -0,0 +1,98 @@
+package servicehandler
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	bmbilling "monorepo/bin-billing-manager/models/billing"
+	bmestimate "monorepo/bin-billing-manager/models/estimate"
+
+	amagent "monorepo/bin-agent-manager/models/agent"
+	"monorepo/bin-api-manager/models/auth"
+
+	"monorepo/bin-api-manager/pkg/dbhandler"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+
+	commonaddress "monorepo/bin-common-handler/models/address"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/requesthandler"
+)
+
+func Test_BillingEstimateQuote(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		agent       *auth.AuthIdentity
+		costType    bmbilling.CostType
+		destination *commonaddress.Address
+		duration    int
+
+		responseEstimate *bmestimate.Estimate
+		expectRes        *bmestimate.Estimate
+	}{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("e3a1c5f2-b2e6-11f0-9b7d-1f3a5c7e9b20"),
+					CustomerID: uuid.FromStringOrNil("e3d4f6a8-b2e6-11f0-a2c8-2a4c6e8a0c30"),
+				},
+				Permission: amagent.PermissionCustomerAdmin,
+			}),
+			costType: bmbilling.CostTypeCallPSTNOutgoing,
+			destination: &commonaddress.Address{
+				Type:   commonaddress.TypeTel,
+				Target: "+821100000001",
+			},
+			duration: 300,
+
+			responseEstimate: &bmestimate.Estimate{
+				CustomerID:        uuid.FromStringOrNil("e3d4f6a8-b2e6-11f0-a2c8-2a4c6e8a0c30"),
+				CostType:          bmbilling.CostTypeCallPSTNOutgoing,
+				RateCreditPerUnit: 10000,
+				Duration:          300,
+				BillableUnits:     5,
+				AmountCredit:      50000,
+			},
+			expectRes: &bmestimate.Estimate{
+				CustomerID:        uuid.FromStringOrNil("e3d4f6a8-b2e6-11f0-a2c8-2a4c6e8a0c30"),
+				CostType:          bmbilling.CostTypeCallPSTNOutgoing,
+				RateCreditPerUnit: 10000,
+				Duration:          300,
+				BillableUnits:     5,
+				AmountCredit:      50000,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			h := serviceHandler{
+				reqHandler: mockReq,
+				dbHandler:  mockDB,
+			}
+			ctx := context.Background()
+
+			mockReq.EXPECT().BillingV1EstimateQuote(ctx, tt.agent.CustomerID, tt.costType, tt.destination, tt.duration).Return(tt.responseEstimate, nil)
+
+			res, err := h.BillingEstimateQuote(ctx, tt.agent, tt.costType, tt.destination, tt.duration)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
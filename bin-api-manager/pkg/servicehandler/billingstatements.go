@@ -0,0 +1,129 @@
+package servicehandler
+
+import (
+	"context"
+
+	"monorepo/bin-api-manager/models/auth"
+	"monorepo/bin-api-manager/pkg/serviceerrors"
+	bmstatement "monorepo/bin-billing-manager/models/statement"
+
+	amagent "monorepo/bin-agent-manager/models/agent"
+	commondatabasehandler "monorepo/bin-common-handler/pkg/databasehandler"
+
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// BillingStatementList sends a request to billing-manager
+// to getting a list of billing statements.
+// it returns list of billing statements if it succeed.
+func (h *serviceHandler) BillingStatementList(ctx context.Context, a *auth.AuthIdentity, size uint64, token string) ([]*bmstatement.WebhookMessage, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":        "BillingStatementList",
+		"customer_id": a.CustomerID,
+		"username":    a.DisplayName(),
+		"size":        size,
+		"token":       token,
+	})
+
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	if token == "" {
+		token = h.utilHandler.TimeGetCurTime()
+	}
+
+	if !h.hasPermission(ctx, a, a.CustomerID, amagent.PermissionCustomerAdmin) {
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	// filters
+	filters := map[string]string{
+		"customer_id": a.CustomerID.String(),
+		"deleted":     "false", // we don't need deleted items
+		"status":      string(bmstatement.StatusDone),
+	}
+
+	// Convert string filters to typed filters
+	typedFilters, err := h.convertBillingStatementFilters(filters)
+	if err != nil {
+		log.Errorf("Could not convert filters. err: %v", err)
+		return nil, err
+	}
+
+	// get statements
+	tmps, err := h.reqHandler.BillingV1StatementList(ctx, token, size, typedFilters)
+	if err != nil {
+		log.Infof("Could not get billing statements info. err: %v", err)
+		return nil, err
+	}
+
+	// create result
+	res := []*bmstatement.WebhookMessage{}
+	for _, tmp := range tmps {
+		c := tmp.ConvertWebhookMessage()
+		res = append(res, c)
+	}
+
+	return res, nil
+}
+
+// convertBillingStatementFilters converts map[string]string to map[bmstatement.Field]any
+func (h *serviceHandler) convertBillingStatementFilters(filters map[string]string) (map[bmstatement.Field]any, error) {
+	// Convert to map[string]any first
+	srcAny := make(map[string]any, len(filters))
+	for k, v := range filters {
+		srcAny[k] = v
+	}
+
+	// Use reflection-based converter
+	typed, err := commondatabasehandler.ConvertMapToTypedMap(srcAny, bmstatement.Statement{})
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert string keys to Field type
+	result := make(map[bmstatement.Field]any, len(typed))
+	for k, v := range typed {
+		result[bmstatement.Field(k)] = v
+	}
+
+	return result, nil
+}
+
+// BillingStatementGet sends a request to billing-manager
+// to getting a billing statement.
+// it returns billing statement if it succeed.
+func (h *serviceHandler) BillingStatementGet(ctx context.Context, a *auth.AuthIdentity, statementID uuid.UUID) (*bmstatement.WebhookMessage, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":         "BillingStatementGet",
+		"customer_id":  a.CustomerID,
+		"username":     a.DisplayName(),
+		"statement_id": statementID,
+	})
+
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	// get statement
+	tmp, err := h.reqHandler.BillingV1StatementGet(ctx, statementID)
+	if err != nil {
+		log.Infof("Could not get billing statement info. err: %v", err)
+		return nil, err
+	}
+
+	if !h.hasPermission(ctx, a, tmp.CustomerID, amagent.PermissionCustomerAdmin) {
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	if tmp.Status != bmstatement.StatusDone {
+		// the statement is being generated
+		return nil, serviceerrors.ErrNotFound
+	}
+
+	// convert
+	res := tmp.ConvertWebhookMessage()
+	return res, nil
+}
@@ -0,0 +1,214 @@
+package servicehandler
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	bmstatement "monorepo/bin-billing-manager/models/statement"
+
+	amagent "monorepo/bin-agent-manager/models/agent"
+	"monorepo/bin-api-manager/models/auth"
+
+	"monorepo/bin-api-manager/pkg/dbhandler"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/requesthandler"
+)
+
+func Test_BillingStatementList(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		agent *auth.AuthIdentity
+		size  uint64
+		token string
+
+		responseStatements []bmstatement.Statement
+		expectFilters      map[bmstatement.Field]any
+		expectRes          []*bmstatement.WebhookMessage
+	}{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("7b1d3f5a-b1a6-11f0-8e2c-3d5f7b9d1f70"),
+					CustomerID: uuid.FromStringOrNil("7b4e6a8c-b1a6-11f0-9f3d-4e6a8c0e2a80"),
+				},
+				Permission: amagent.PermissionCustomerAdmin,
+			}),
+			size:  10,
+			token: "2026-10-01T03:00:00.000000Z",
+
+			responseStatements: []bmstatement.Statement{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("7b7f9c1e-b1a6-11f0-a04e-5f7b9d1f3b90"),
+					},
+					Status: bmstatement.StatusDone,
+				},
+			},
+			expectFilters: map[bmstatement.Field]any{
+				bmstatement.FieldCustomerID: uuid.FromStringOrNil("7b4e6a8c-b1a6-11f0-9f3d-4e6a8c0e2a80"),
+				bmstatement.FieldDeleted:    false,
+				bmstatement.FieldStatus:     string(bmstatement.StatusDone),
+			},
+			expectRes: []*bmstatement.WebhookMessage{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("7b7f9c1e-b1a6-11f0-a04e-5f7b9d1f3b90"),
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			h := serviceHandler{
+				reqHandler: mockReq,
+				dbHandler:  mockDB,
+			}
+
+			ctx := context.Background()
+
+			mockReq.EXPECT().BillingV1StatementList(ctx, tt.token, tt.size, tt.expectFilters).Return(tt.responseStatements, nil)
+
+			res, err := h.BillingStatementList(ctx, tt.agent, tt.size, tt.token)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect:%v\ngot:%v\n", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_BillingStatementGet(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		agent       *auth.AuthIdentity
+		statementID uuid.UUID
+
+		responseStatement *bmstatement.Statement
+
+		expectRes *bmstatement.WebhookMessage
+		expectErr bool
+	}{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("9c2e4a6c-b1a6-11f0-8b1d-6a8c0e2a4c10"),
+					CustomerID: uuid.FromStringOrNil("9c5f7b9d-b1a6-11f0-9c2e-7b9d1f3b5d20"),
+				},
+				Permission: amagent.PermissionCustomerAdmin,
+			}),
+			statementID: uuid.FromStringOrNil("9c8a0c2e-b1a6-11f0-ad3f-8c0e2a4c6e30"),
+
+			responseStatement: &bmstatement.Statement{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("9c8a0c2e-b1a6-11f0-ad3f-8c0e2a4c6e30"),
+					CustomerID: uuid.FromStringOrNil("9c5f7b9d-b1a6-11f0-9c2e-7b9d1f3b5d20"),
+				},
+				Status: bmstatement.StatusDone,
+			},
+
+			expectRes: &bmstatement.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("9c8a0c2e-b1a6-11f0-ad3f-8c0e2a4c6e30"),
+					CustomerID: uuid.FromStringOrNil("9c5f7b9d-b1a6-11f0-9c2e-7b9d1f3b5d20"),
+				},
+			},
+		},
+		{
+			name: "other customer's statement",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("9c2e4a6c-b1a6-11f0-8b1d-6a8c0e2a4c10"),
+					CustomerID: uuid.FromStringOrNil("9c5f7b9d-b1a6-11f0-9c2e-7b9d1f3b5d20"),
+				},
+				Permission: amagent.PermissionCustomerAdmin,
+			}),
+			statementID: uuid.FromStringOrNil("9c8a0c2e-b1a6-11f0-ad3f-8c0e2a4c6e30"),
+
+			responseStatement: &bmstatement.Statement{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("9c8a0c2e-b1a6-11f0-ad3f-8c0e2a4c6e30"),
+					CustomerID: uuid.FromStringOrNil("9cb51d3f-b1a6-11f0-be40-9d1f3b5d7f40"),
+				},
+			},
+
+			expectErr: true,
+		},
+		{
+			name: "statement is being generated",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("9c2e4a6c-b1a6-11f0-8b1d-6a8c0e2a4c10"),
+					CustomerID: uuid.FromStringOrNil("9c5f7b9d-b1a6-11f0-9c2e-7b9d1f3b5d20"),
+				},
+				Permission: amagent.PermissionCustomerAdmin,
+			}),
+			statementID: uuid.FromStringOrNil("9c8a0c2e-b1a6-11f0-ad3f-8c0e2a4c6e30"),
+
+			responseStatement: &bmstatement.Statement{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("9c8a0c2e-b1a6-11f0-ad3f-8c0e2a4c6e30"),
+					CustomerID: uuid.FromStringOrNil("9c5f7b9d-b1a6-11f0-9c2e-7b9d1f3b5d20"),
+				},
+				Status: bmstatement.StatusProgressing,
+			},
+
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			h := serviceHandler{
+				reqHandler: mockReq,
+				dbHandler:  mockDB,
+			}
+
+			ctx := context.Background()
+
+			mockReq.EXPECT().BillingV1StatementGet(ctx, tt.statementID).Return(tt.responseStatement, nil)
+
+			res, err := h.BillingStatementGet(ctx, tt.agent, tt.statementID)
+			if tt.expectErr {
+				if err == nil {
+					t.Errorf("Wrong match. expect: error, got: ok")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect:%v\ngot:%v\n", tt.expectRes, res)
+			}
+		})
+	}
+}
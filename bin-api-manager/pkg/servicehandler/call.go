@@ -43,7 +43,7 @@ func (h *serviceHandler) callGet(ctx context.Context, callID uuid.UUID) (*cmcall
 // CallCreate sends a request to call-manager
 // to creating a call.
 // it returns created calls and groupcalls info if it succeed.
-func (h *serviceHandler) CallCreate(ctx context.Context, a *auth.AuthIdentity, flowID uuid.UUID, actions []fmaction.Action, source *commonaddress.Address, destinations []commonaddress.Address, anonymous string, variables map[string]string) ([]*cmcall.WebhookMessage, []*cmgroupcall.WebhookMessage, error) {
+func (h *serviceHandler) CallCreate(ctx context.Context, a *auth.AuthIdentity, flowID uuid.UUID, actions []fmaction.Action, source *commonaddress.Address, destinations []commonaddress.Address, anonymous string, variables map[string]string, maxCost int64) ([]*cmcall.WebhookMessage, []*cmgroupcall.WebhookMessage, error) {
 	log := logrus.WithFields(logrus.Fields{
 		"func":        "CallCreate",
 		"customer_id": a.CustomerID,
@@ -52,6 +52,7 @@ func (h *serviceHandler) CallCreate(ctx context.Context, a *auth.AuthIdentity, f
 		"actions":     actions,
 		"source":      source,
 		"destination": destinations,
+		"max_cost":    maxCost,
 	})
 	log.Debug("Creating a new call.")
 
@@ -63,6 +64,10 @@ func (h *serviceHandler) CallCreate(ctx context.Context, a *auth.AuthIdentity, f
 		return nil, nil, serviceerrors.ErrPermissionDenied
 	}
 
+	if maxCost < 0 {
+		return nil, nil, fmt.Errorf("%w: max_cost must not be negative", serviceerrors.ErrInvalidArgument)
+	}
+
 	// check identity verification for PSTN outbound calls
 	hasTelDestination := false
 	for _, d := range destinations {
@@ -117,6 +122,14 @@ func (h *serviceHandler) CallCreate(ctx context.Context, a *auth.AuthIdentity, f
 
 	resCalls := []*cmcall.WebhookMessage{}
 	for _, tmp := range tmpCalls {
+		if maxCost > 0 {
+			tmp, err = h.callUpdateMaxCost(ctx, tmp, maxCost)
+			if err != nil {
+				log.Errorf("Could not set the call's max cost. call_id: %s, err: %v", tmp.ID, err)
+				return nil, nil, err
+			}
+		}
+
 		t := tmp.ConvertWebhookMessage()
 		resCalls = append(resCalls, t)
 	}
@@ -159,9 +172,40 @@ func (h *serviceHandler) CallGet(ctx context.Context, a *auth.AuthIdentity, call
 
 	// convert
 	res := c.ConvertWebhookMessage()
+	res.RunningCost = h.callGetRunningCost(ctx, c)
+	return res, nil
+}
+
+// callUpdateMaxCost sets the max cost of the created call.
+// the call is hung up if the max cost could not be set, so it never runs without the limit.
+func (h *serviceHandler) callUpdateMaxCost(ctx context.Context, c *cmcall.Call, maxCost int64) (*cmcall.Call, error) {
+	res, err := h.reqHandler.CallV1CallUpdateMaxCost(ctx, c.ID, maxCost)
+	if err != nil {
+		if _, errHangup := h.reqHandler.CallV1CallHangup(ctx, c.ID); errHangup != nil {
+			logrus.WithField("call_id", c.ID).Errorf("Could not hang up the call. err: %v", errHangup)
+		}
+		return c, errors.Wrapf(err, "could not update the call's max cost")
+	}
+
 	return res, nil
 }
 
+// callGetRunningCost returns the credit accrued so far by the progressing call.
+// it returns 0 for the other calls or if the estimate is not available.
+func (h *serviceHandler) callGetRunningCost(ctx context.Context, c *cmcall.Call) int64 {
+	if c.Status != cmcall.StatusProgressing {
+		return 0
+	}
+
+	e, err := h.reqHandler.BillingV1EstimateGetByReferenceID(ctx, c.ID)
+	if err != nil {
+		logrus.WithField("call_id", c.ID).Debugf("Could not get the call's cost estimate. err: %v", err)
+		return 0
+	}
+
+	return e.AmountCredit
+}
+
 // CallGets sends a request to call-manager
 // to getting a list of calls.
 // it returns list of calls if it succeed.
@@ -235,6 +279,7 @@ func (h *serviceHandler) callGetsByFilters(ctx context.Context, size uint64, tok
 	res := []*cmcall.WebhookMessage{}
 	for _, tmp := range tmps {
 		c := tmp.ConvertWebhookMessage()
+		c.RunningCost = h.callGetRunningCost(ctx, &tmp)
 		res = append(res, c)
 	}
 
@@ -7,6 +7,7 @@ import (
 	"reflect"
 	"testing"
 
+	bmestimate "monorepo/bin-billing-manager/models/estimate"
 	cmcall "monorepo/bin-call-manager/models/call"
 	cmexternalmedia "monorepo/bin-call-manager/models/externalmedia"
 	cmgroupcall "monorepo/bin-call-manager/models/groupcall"
@@ -166,6 +167,7 @@ func Test_CallCreate(t *testing.T) {
 		actions      []fmaction.Action
 		source       *commonaddress.Address
 		destinations []commonaddress.Address
+		maxCost      int64
 
 		responseFlow       *fmflow.Flow
 		responseCalls      []*cmcall.Call
@@ -362,6 +364,69 @@ func Test_CallCreate(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "with max cost",
+
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("d152e69e-105b-11ee-b395-eb18426de979"),
+					CustomerID: uuid.FromStringOrNil("5f621078-8e5f-11ee-97b2-cfe7337b701c"),
+				},
+				Permission: amagent.PermissionCustomerAdmin,
+			}),
+			flowID:  uuid.FromStringOrNil("2c45d0b8-efc4-11ea-9a45-4f30fc2e0b02"),
+			actions: []fmaction.Action{},
+			source: &commonaddress.Address{
+				Type:   commonaddress.TypeSIP,
+				Target: "testsource@test.com",
+			},
+			destinations: []commonaddress.Address{
+				{
+					Type:   commonaddress.TypeSIP,
+					Target: "testdestination@test.com",
+				},
+			},
+			maxCost: 3000000,
+
+			responseFlow: &fmflow.Flow{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("2c45d0b8-efc4-11ea-9a45-4f30fc2e0b02"),
+					CustomerID: uuid.FromStringOrNil("5f621078-8e5f-11ee-97b2-cfe7337b701c"),
+				},
+				TMDelete: nil,
+			},
+			responseCalls: []*cmcall.Call{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("88d05668-efc5-11ea-940c-b39a697e7abe"),
+					},
+					MaxCost: 3000000,
+				},
+			},
+			responseGroupcalls: []*cmgroupcall.Groupcall{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("44b6d84f-48bd-4189-aad2-b9271de78ca7"),
+					},
+				},
+			},
+
+			expectResCalls: []*cmcall.WebhookMessage{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("88d05668-efc5-11ea-940c-b39a697e7abe"),
+					},
+					MaxCost: 3000000,
+				},
+			},
+			expectResGroupcalls: []*cmgroupcall.WebhookMessage{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("44b6d84f-48bd-4189-aad2-b9271de78ca7"),
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -386,8 +451,13 @@ func Test_CallCreate(t *testing.T) {
 			mockReq.EXPECT().FlowV1FlowGet(ctx, flowID).Return(tt.responseFlow, nil)
 
 			mockReq.EXPECT().CallV1CallsCreate(ctx, tt.agent.CustomerID, tt.responseFlow.ID, uuid.Nil, tt.source, tt.destinations, false, false, "", nil, gomock.Any()).Return(tt.responseCalls, tt.responseGroupcalls, nil)
+			if tt.maxCost > 0 {
+				for _, c := range tt.responseCalls {
+					mockReq.EXPECT().CallV1CallUpdateMaxCost(ctx, c.ID, tt.maxCost).Return(c, nil)
+				}
+			}
 
-			resCalls, resGroupcalls, err := h.CallCreate(ctx, tt.agent, tt.flowID, tt.actions, tt.source, tt.destinations, "", nil)
+			resCalls, resGroupcalls, err := h.CallCreate(ctx, tt.agent, tt.flowID, tt.actions, tt.source, tt.destinations, "", nil, tt.maxCost)
 			if err != nil {
 				t.Errorf("Wrong match. expect: ok, got: %v", err)
 			}
@@ -824,6 +894,115 @@ func Test_CallMuteOff(t *testing.T) {
 	}
 }
 
+func Test_CallGet(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		agent  *auth.AuthIdentity
+		callID uuid.UUID
+
+		responseCall     *cmcall.Call
+		responseEstimate *bmestimate.Estimate
+
+		expectRes *cmcall.WebhookMessage
+	}{
+		{
+			name: "normal",
+
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("4a2c6e8a-b2e7-11f0-8d1f-3b5d7f9b1d40"),
+					CustomerID: uuid.FromStringOrNil("4a5f7b9d-b2e7-11f0-9e2a-4c6e8a0c2e50"),
+				},
+				Permission: amagent.PermissionCustomerAdmin,
+			}),
+			callID: uuid.FromStringOrNil("4a8e0c2e-b2e7-11f0-af3b-5d7f9b1d3f60"),
+
+			responseCall: &cmcall.Call{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("4a8e0c2e-b2e7-11f0-af3b-5d7f9b1d3f60"),
+					CustomerID: uuid.FromStringOrNil("4a5f7b9d-b2e7-11f0-9e2a-4c6e8a0c2e50"),
+				},
+				Status: cmcall.StatusHangup,
+			},
+
+			expectRes: &cmcall.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("4a8e0c2e-b2e7-11f0-af3b-5d7f9b1d3f60"),
+					CustomerID: uuid.FromStringOrNil("4a5f7b9d-b2e7-11f0-9e2a-4c6e8a0c2e50"),
+				},
+				Status: cmcall.StatusHangup,
+			},
+		},
+		{
+			name: "progressing call has running cost",
+
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("4a2c6e8a-b2e7-11f0-8d1f-3b5d7f9b1d40"),
+					CustomerID: uuid.FromStringOrNil("4a5f7b9d-b2e7-11f0-9e2a-4c6e8a0c2e50"),
+				},
+				Permission: amagent.PermissionCustomerAdmin,
+			}),
+			callID: uuid.FromStringOrNil("4ac01e4a-b2e7-11f0-b04c-6e8a0c2e4a70"),
+
+			responseCall: &cmcall.Call{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("4ac01e4a-b2e7-11f0-b04c-6e8a0c2e4a70"),
+					CustomerID: uuid.FromStringOrNil("4a5f7b9d-b2e7-11f0-9e2a-4c6e8a0c2e50"),
+				},
+				Status:  cmcall.StatusProgressing,
+				MaxCost: 3000000,
+			},
+			responseEstimate: &bmestimate.Estimate{
+				ReferenceID:  uuid.FromStringOrNil("4ac01e4a-b2e7-11f0-b04c-6e8a0c2e4a70"),
+				AmountCredit: 20000,
+			},
+
+			expectRes: &cmcall.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("4ac01e4a-b2e7-11f0-b04c-6e8a0c2e4a70"),
+					CustomerID: uuid.FromStringOrNil("4a5f7b9d-b2e7-11f0-9e2a-4c6e8a0c2e50"),
+				},
+				Status:      cmcall.StatusProgressing,
+				MaxCost:     3000000,
+				RunningCost: 20000,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+
+			h := serviceHandler{
+				reqHandler: mockReq,
+				dbHandler:  mockDB,
+			}
+			ctx := context.Background()
+
+			mockReq.EXPECT().CallV1CallGet(ctx, tt.callID).Return(tt.responseCall, nil)
+			if tt.responseEstimate != nil {
+				mockReq.EXPECT().BillingV1EstimateGetByReferenceID(ctx, tt.callID).Return(tt.responseEstimate, nil)
+			}
+
+			res, err := h.CallGet(ctx, tt.agent, tt.callID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
 func Test_CallList(t *testing.T) {
 
 	tests := []struct {
@@ -0,0 +1,154 @@
+package servicehandler
+
+import (
+	"context"
+	"fmt"
+
+	"monorepo/bin-api-manager/models/auth"
+	"monorepo/bin-api-manager/pkg/serviceerrors"
+
+	cacampaignresultjob "monorepo/bin-campaign-manager/models/campaignresultjob"
+
+	amagent "monorepo/bin-agent-manager/models/agent"
+
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// CampaignResultjobCreate starts a new export of the campaign's results.
+// The exported csv file is stored to the storage-manager once the job is done.
+// It returns created campaignresultjob if it succeed.
+func (h *serviceHandler) CampaignResultjobCreate(ctx context.Context, a *auth.AuthIdentity, campaignID uuid.UUID) (*cacampaignresultjob.WebhookMessage, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":        "CampaignResultjobCreate",
+		"customer_id": a.CustomerID,
+		"username":    a.DisplayName(),
+		"campaign_id": campaignID,
+	})
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	log.Debug("Executing CampaignResultjobCreate.")
+
+	// get campaign
+	c, err := h.campaignGet(ctx, campaignID)
+	if err != nil {
+		log.Errorf("Could not get campaign info from the campaign-manager. err: %v", err)
+		return nil, fmt.Errorf("%w: could not find campaign info", err)
+	}
+
+	if !h.hasPermission(ctx, a, c.CustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager) {
+		log.Info("The agent has no permission.")
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	// create
+	tmp, err := h.reqHandler.CampaignV1CampaignresultjobCreate(ctx, campaignID)
+	if err != nil {
+		log.Errorf("Could not create the campaignresultjob. err: %v", err)
+		return nil, err
+	}
+
+	res := tmp.ConvertWebhookMessage()
+	return res, nil
+}
+
+// CampaignResultjobGet gets a campaignresultjob.
+// It returns campaignresultjob if it succeed.
+func (h *serviceHandler) CampaignResultjobGet(ctx context.Context, a *auth.AuthIdentity, campaignID uuid.UUID, campaignresultjobID uuid.UUID) (*cacampaignresultjob.WebhookMessage, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":                 "CampaignResultjobGet",
+		"customer_id":          a.CustomerID,
+		"username":             a.DisplayName(),
+		"campaign_id":          campaignID,
+		"campaignresultjob_id": campaignresultjobID,
+	})
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	log.Debug("Executing CampaignResultjobGet.")
+
+	// get campaign
+	c, err := h.campaignGet(ctx, campaignID)
+	if err != nil {
+		log.Errorf("Could not get campaign info from the campaign-manager. err: %v", err)
+		return nil, fmt.Errorf("%w: could not find campaign info", err)
+	}
+
+	if !h.hasPermission(ctx, a, c.CustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager) {
+		log.Info("The agent has no permission.")
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	// get campaignresultjob
+	tmp, err := h.reqHandler.CampaignV1CampaignresultjobGet(ctx, campaignresultjobID)
+	if err != nil {
+		log.Errorf("Could not get campaignresultjob info from the campaign-manager. err: %v", err)
+		return nil, fmt.Errorf("%w: could not find campaignresultjob info", err)
+	}
+
+	// check the campaign_id
+	if tmp.CampaignID != campaignID {
+		log.Errorf("The campaign_id is wrong. campaign_id: %s", tmp.CampaignID)
+		return nil, fmt.Errorf("%w: wrong campaign_id. campaign_id: %s", serviceerrors.ErrInvalidArgument, tmp.CampaignID)
+	}
+
+	res := tmp.ConvertWebhookMessage()
+	return res, nil
+}
+
+// CampaignResultjobList gets the list of campaignresultjobs of the given campaign id.
+// It returns list of campaignresultjobs if it succeed.
+func (h *serviceHandler) CampaignResultjobList(ctx context.Context, a *auth.AuthIdentity, campaignID uuid.UUID, size uint64, token string) ([]*cacampaignresultjob.WebhookMessage, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":        "CampaignResultjobList",
+		"customer_id": a.CustomerID,
+		"username":    a.DisplayName(),
+		"campaign_id": campaignID,
+		"size":        size,
+		"token":       token,
+	})
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	log.Debug("Getting campaignresultjobs.")
+
+	if token == "" {
+		token = h.utilHandler.TimeGetCurTime()
+	}
+
+	// get campaign
+	c, err := h.campaignGet(ctx, campaignID)
+	if err != nil {
+		log.Errorf("Could not get campaign info from the campaign-manager. err: %v", err)
+		return nil, fmt.Errorf("%w: could not find campaign info", err)
+	}
+
+	if !h.hasPermission(ctx, a, c.CustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager) {
+		log.Info("The agent has no permission.")
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	// get jobs
+	filters := map[cacampaignresultjob.Field]any{
+		cacampaignresultjob.FieldCampaignID: campaignID,
+		cacampaignresultjob.FieldDeleted:    false,
+	}
+	jobs, err := h.reqHandler.CampaignV1CampaignresultjobList(ctx, token, size, filters)
+	if err != nil {
+		log.Errorf("Could not get campaignresultjobs info from the campaign-manager. err: %v", err)
+		return nil, fmt.Errorf("%w: could not find campaignresultjobs info", err)
+	}
+
+	// create result
+	res := []*cacampaignresultjob.WebhookMessage{}
+	for _, j := range jobs {
+		tmp := j.ConvertWebhookMessage()
+		res = append(res, tmp)
+	}
+
+	return res, nil
+}
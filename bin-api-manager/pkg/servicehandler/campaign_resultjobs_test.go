@@ -0,0 +1,306 @@
+package servicehandler
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/requesthandler"
+
+	cacampaign "monorepo/bin-campaign-manager/models/campaign"
+	cacampaignresultjob "monorepo/bin-campaign-manager/models/campaignresultjob"
+
+	amagent "monorepo/bin-agent-manager/models/agent"
+
+	"monorepo/bin-api-manager/models/auth"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_CampaignResultjobCreate(t *testing.T) {
+
+	tests := []struct {
+		name       string
+		agent      *auth.AuthIdentity
+		campaignID uuid.UUID
+
+		responseCampaign *cacampaign.Campaign
+		response         *cacampaignresultjob.CampaignResultJob
+		expectRes        *cacampaignresultjob.WebhookMessage
+	}{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("1f0a2c7e-b5e8-11f0-8e1f-0a3c5e7f9b01"),
+					CustomerID: uuid.FromStringOrNil("1f3b4d9a-b5e8-11f0-9f2a-1b4d6f8a0c02"),
+				},
+				Permission: amagent.PermissionCustomerAdmin,
+			}),
+			campaignID: uuid.FromStringOrNil("1f6c6eb6-b5e8-11f0-a03b-2c5e7a9b1d03"),
+
+			responseCampaign: &cacampaign.Campaign{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("1f6c6eb6-b5e8-11f0-a03b-2c5e7a9b1d03"),
+					CustomerID: uuid.FromStringOrNil("1f3b4d9a-b5e8-11f0-9f2a-1b4d6f8a0c02"),
+				},
+			},
+			response: &cacampaignresultjob.CampaignResultJob{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("1f9d8fd2-b5e8-11f0-b14c-3d6f8b0c2e04"),
+				},
+				CampaignID: uuid.FromStringOrNil("1f6c6eb6-b5e8-11f0-a03b-2c5e7a9b1d03"),
+				Status:     cacampaignresultjob.StatusProcessing,
+			},
+			expectRes: &cacampaignresultjob.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("1f9d8fd2-b5e8-11f0-b14c-3d6f8b0c2e04"),
+				},
+				CampaignID: uuid.FromStringOrNil("1f6c6eb6-b5e8-11f0-a03b-2c5e7a9b1d03"),
+				Status:     cacampaignresultjob.StatusProcessing,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+
+			h := &serviceHandler{
+				reqHandler: mockReq,
+			}
+			ctx := context.Background()
+
+			mockReq.EXPECT().CampaignV1CampaignGet(ctx, tt.campaignID).Return(tt.responseCampaign, nil)
+			mockReq.EXPECT().CampaignV1CampaignresultjobCreate(ctx, tt.campaignID).Return(tt.response, nil)
+
+			res, err := h.CampaignResultjobCreate(ctx, tt.agent, tt.campaignID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_CampaignResultjobCreate_error(t *testing.T) {
+
+	tests := []struct {
+		name       string
+		agent      *auth.AuthIdentity
+		campaignID uuid.UUID
+
+		responseCampaign *cacampaign.Campaign
+	}{
+		{
+			name: "agent of the other customer",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("2a0a2c7e-b5e8-11f0-8e1f-0a3c5e7f9b01"),
+					CustomerID: uuid.FromStringOrNil("2a3b4d9a-b5e8-11f0-9f2a-1b4d6f8a0c02"),
+				},
+				Permission: amagent.PermissionCustomerAdmin,
+			}),
+			campaignID: uuid.FromStringOrNil("2a6c6eb6-b5e8-11f0-a03b-2c5e7a9b1d03"),
+
+			responseCampaign: &cacampaign.Campaign{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("2a6c6eb6-b5e8-11f0-a03b-2c5e7a9b1d03"),
+					CustomerID: uuid.FromStringOrNil("2a9d8fd2-b5e8-11f0-b14c-3d6f8b0c2e04"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+
+			h := &serviceHandler{
+				reqHandler: mockReq,
+			}
+			ctx := context.Background()
+
+			mockReq.EXPECT().CampaignV1CampaignGet(ctx, tt.campaignID).Return(tt.responseCampaign, nil)
+
+			_, err := h.CampaignResultjobCreate(ctx, tt.agent, tt.campaignID)
+			if err == nil {
+				t.Errorf("Wrong match. expect: error, got: ok")
+			}
+		})
+	}
+}
+
+func Test_CampaignResultjobGet(t *testing.T) {
+
+	tests := []struct {
+		name                string
+		agent               *auth.AuthIdentity
+		campaignID          uuid.UUID
+		campaignresultjobID uuid.UUID
+
+		responseCampaign *cacampaign.Campaign
+		response         *cacampaignresultjob.CampaignResultJob
+		expectRes        *cacampaignresultjob.WebhookMessage
+	}{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("3b0a2c7e-b5e8-11f0-8e1f-0a3c5e7f9b01"),
+					CustomerID: uuid.FromStringOrNil("3b3b4d9a-b5e8-11f0-9f2a-1b4d6f8a0c02"),
+				},
+				Permission: amagent.PermissionCustomerAdmin,
+			}),
+			campaignID:          uuid.FromStringOrNil("3b6c6eb6-b5e8-11f0-a03b-2c5e7a9b1d03"),
+			campaignresultjobID: uuid.FromStringOrNil("3b9d8fd2-b5e8-11f0-b14c-3d6f8b0c2e04"),
+
+			responseCampaign: &cacampaign.Campaign{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("3b6c6eb6-b5e8-11f0-a03b-2c5e7a9b1d03"),
+					CustomerID: uuid.FromStringOrNil("3b3b4d9a-b5e8-11f0-9f2a-1b4d6f8a0c02"),
+				},
+			},
+			response: &cacampaignresultjob.CampaignResultJob{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("3b9d8fd2-b5e8-11f0-b14c-3d6f8b0c2e04"),
+				},
+				CampaignID:  uuid.FromStringOrNil("3b6c6eb6-b5e8-11f0-a03b-2c5e7a9b1d03"),
+				Status:      cacampaignresultjob.StatusDone,
+				FileID:      uuid.FromStringOrNil("3bcea8ee-b5e8-11f0-825d-4e7a9c1d3f05"),
+				TargetCount: 2,
+				RowCount:    3,
+			},
+			expectRes: &cacampaignresultjob.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("3b9d8fd2-b5e8-11f0-b14c-3d6f8b0c2e04"),
+				},
+				CampaignID:  uuid.FromStringOrNil("3b6c6eb6-b5e8-11f0-a03b-2c5e7a9b1d03"),
+				Status:      cacampaignresultjob.StatusDone,
+				FileID:      uuid.FromStringOrNil("3bcea8ee-b5e8-11f0-825d-4e7a9c1d3f05"),
+				TargetCount: 2,
+				RowCount:    3,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+
+			h := &serviceHandler{
+				reqHandler: mockReq,
+			}
+			ctx := context.Background()
+
+			mockReq.EXPECT().CampaignV1CampaignGet(ctx, tt.campaignID).Return(tt.responseCampaign, nil)
+			mockReq.EXPECT().CampaignV1CampaignresultjobGet(ctx, tt.campaignresultjobID).Return(tt.response, nil)
+
+			res, err := h.CampaignResultjobGet(ctx, tt.agent, tt.campaignID, tt.campaignresultjobID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_CampaignResultjobList(t *testing.T) {
+
+	tests := []struct {
+		name       string
+		agent      *auth.AuthIdentity
+		campaignID uuid.UUID
+		pageToken  string
+		pageSize   uint64
+
+		responseCampaign *cacampaign.Campaign
+		response         []cacampaignresultjob.CampaignResultJob
+
+		expectFilters map[cacampaignresultjob.Field]any
+		expectRes     []*cacampaignresultjob.WebhookMessage
+	}{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("4c0a2c7e-b5e8-11f0-8e1f-0a3c5e7f9b01"),
+					CustomerID: uuid.FromStringOrNil("4c3b4d9a-b5e8-11f0-9f2a-1b4d6f8a0c02"),
+				},
+				Permission: amagent.PermissionCustomerAdmin,
+			}),
+			campaignID: uuid.FromStringOrNil("4c6c6eb6-b5e8-11f0-a03b-2c5e7a9b1d03"),
+			pageToken:  "2021-03-01T01:00:00.995000Z",
+			pageSize:   10,
+
+			responseCampaign: &cacampaign.Campaign{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("4c6c6eb6-b5e8-11f0-a03b-2c5e7a9b1d03"),
+					CustomerID: uuid.FromStringOrNil("4c3b4d9a-b5e8-11f0-9f2a-1b4d6f8a0c02"),
+				},
+			},
+			response: []cacampaignresultjob.CampaignResultJob{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("4c9d8fd2-b5e8-11f0-b14c-3d6f8b0c2e04"),
+					},
+				},
+			},
+
+			expectFilters: map[cacampaignresultjob.Field]any{
+				cacampaignresultjob.FieldCampaignID: uuid.FromStringOrNil("4c6c6eb6-b5e8-11f0-a03b-2c5e7a9b1d03"),
+				cacampaignresultjob.FieldDeleted:    false,
+			},
+			expectRes: []*cacampaignresultjob.WebhookMessage{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("4c9d8fd2-b5e8-11f0-b14c-3d6f8b0c2e04"),
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+
+			h := &serviceHandler{
+				reqHandler: mockReq,
+			}
+			ctx := context.Background()
+
+			mockReq.EXPECT().CampaignV1CampaignGet(ctx, tt.campaignID).Return(tt.responseCampaign, nil)
+			mockReq.EXPECT().CampaignV1CampaignresultjobList(ctx, tt.pageToken, tt.pageSize, tt.expectFilters).Return(tt.response, nil)
+
+			res, err := h.CampaignResultjobList(ctx, tt.agent, tt.campaignID, tt.pageSize, tt.pageToken)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
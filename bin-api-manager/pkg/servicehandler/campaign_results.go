@@ -0,0 +1,60 @@
+package servicehandler
+
+import (
+	"context"
+	"fmt"
+
+	"monorepo/bin-api-manager/models/auth"
+	"monorepo/bin-api-manager/pkg/serviceerrors"
+	cacampaignresult "monorepo/bin-campaign-manager/models/campaignresult"
+
+	amagent "monorepo/bin-agent-manager/models/agent"
+
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// CampaignResultList returns the campaign's results per outdial target.
+func (h *serviceHandler) CampaignResultList(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID, size uint64, token string) ([]*cacampaignresult.CampaignResult, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":        "CampaignResultList",
+		"customer_id": a.CustomerID,
+		"username":    a.DisplayName(),
+		"campaign_id": id,
+		"size":        size,
+		"token":       token,
+	})
+	log.Debug("Getting the campaign results.")
+
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	if token == "" {
+		token = h.utilHandler.TimeGetCurTime()
+	}
+
+	// get campaign
+	c, err := h.campaignGet(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get campaign info from the campaign-manager. err: %v", err)
+		return nil, fmt.Errorf("%w: could not find campaign info", err)
+	}
+
+	if !h.hasPermission(ctx, a, c.CustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager) {
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	tmps, err := h.reqHandler.CampaignV1CampaignResultList(ctx, id, token, size)
+	if err != nil {
+		log.Errorf("Could not get campaign results from the campaign-manager. err: %v", err)
+		return nil, fmt.Errorf("%w: could not find campaign results", err)
+	}
+
+	res := []*cacampaignresult.CampaignResult{}
+	for i := range tmps {
+		res = append(res, &tmps[i])
+	}
+
+	return res, nil
+}
@@ -0,0 +1,96 @@
+package servicehandler
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/requesthandler"
+
+	cacampaign "monorepo/bin-campaign-manager/models/campaign"
+	cacampaignresult "monorepo/bin-campaign-manager/models/campaignresult"
+
+	amagent "monorepo/bin-agent-manager/models/agent"
+
+	"monorepo/bin-api-manager/models/auth"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_CampaignResultList(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		agent     *auth.AuthIdentity
+		id        uuid.UUID
+		pageSize  uint64
+		pageToken string
+
+		responseCampaign *cacampaign.Campaign
+		responseResults  []cacampaignresult.CampaignResult
+		expectRes        []*cacampaignresult.CampaignResult
+	}{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("5e0a2c7e-ad30-11f1-8e1f-0a3c5e7f9b01"),
+					CustomerID: uuid.FromStringOrNil("5e3b4d9a-ad30-11f1-9f2a-1b4d6f8a0c02"),
+				},
+				Permission: amagent.PermissionCustomerManager,
+			}),
+			id:        uuid.FromStringOrNil("5e6c6eb6-ad30-11f1-a03b-2c5e7a9b1d03"),
+			pageSize:  10,
+			pageToken: "2020-10-20T01:00:00.995000Z",
+
+			responseCampaign: &cacampaign.Campaign{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("5e6c6eb6-ad30-11f1-a03b-2c5e7a9b1d03"),
+					CustomerID: uuid.FromStringOrNil("5e3b4d9a-ad30-11f1-9f2a-1b4d6f8a0c02"),
+				},
+			},
+			responseResults: []cacampaignresult.CampaignResult{
+				{
+					CampaignID:      uuid.FromStringOrNil("5e6c6eb6-ad30-11f1-a03b-2c5e7a9b1d03"),
+					OutdialTargetID: uuid.FromStringOrNil("5e9d8fd2-ad30-11f1-b14c-3d6f8b0c2e04"),
+					Attempts:        []cacampaignresult.Attempt{},
+				},
+			},
+			expectRes: []*cacampaignresult.CampaignResult{
+				{
+					CampaignID:      uuid.FromStringOrNil("5e6c6eb6-ad30-11f1-a03b-2c5e7a9b1d03"),
+					OutdialTargetID: uuid.FromStringOrNil("5e9d8fd2-ad30-11f1-b14c-3d6f8b0c2e04"),
+					Attempts:        []cacampaignresult.Attempt{},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			h := &serviceHandler{
+				reqHandler: mockReq,
+			}
+			ctx := context.Background()
+
+			mockReq.EXPECT().CampaignV1CampaignGet(ctx, tt.id).Return(tt.responseCampaign, nil)
+			mockReq.EXPECT().CampaignV1CampaignResultList(ctx, tt.id, tt.pageToken, tt.pageSize).Return(tt.responseResults, nil)
+
+			res, err := h.CampaignResultList(ctx, tt.agent, tt.id, tt.pageSize, tt.pageToken)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
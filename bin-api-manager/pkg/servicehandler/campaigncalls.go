@@ -3,11 +3,14 @@ package servicehandler
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"monorepo/bin-api-manager/models/auth"
 	"monorepo/bin-api-manager/pkg/serviceerrors"
 	cacampaigncall "monorepo/bin-campaign-manager/models/campaigncall"
 
+	omoutdialtarget "monorepo/bin-outdial-manager/models/outdialtarget"
+
 	amagent "monorepo/bin-agent-manager/models/agent"
 
 	"github.com/gofrs/uuid"
@@ -189,3 +192,152 @@ func (h *serviceHandler) CampaigncallDelete(ctx context.Context, a *auth.AuthIde
 	res := tmp.ConvertWebhookMessage()
 	return res, nil
 }
+
+// CampaigncallAccept accepts the previewing campaigncall by the agent.
+// The agent without admin/manager permission can accept the campaigncall for itself only.
+// It returns accepted campaigncall if it succeed.
+func (h *serviceHandler) CampaigncallAccept(ctx context.Context, a *auth.AuthIdentity, campaigncallID uuid.UUID, agentID uuid.UUID) (*cacampaigncall.WebhookMessage, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":            "CampaigncallAccept",
+		"agent":           a,
+		"campaigncall_id": campaigncallID,
+		"agent_id":        agentID,
+	})
+	log.Debug("Accepting a campaigncall.")
+
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	c, err := h.campaigncallGet(ctx, campaigncallID)
+	if err != nil {
+		log.Errorf("Could not get campaigncall info from the campaign-manager. err: %v", err)
+		return nil, fmt.Errorf("%w: could not find campaigncall info", err)
+	}
+
+	if !h.hasPermission(ctx, a, c.CustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager) {
+		if !a.IsAgent() || a.Agent == nil || a.Agent.CustomerID != c.CustomerID || agentID != a.Agent.ID {
+			return nil, serviceerrors.ErrPermissionDenied
+		}
+	}
+
+	tmp, err := h.reqHandler.CampaignV1CampaigncallAccept(ctx, campaigncallID, agentID)
+	if err != nil {
+		log.Errorf("Could not accept the campaign call. err: %v", err)
+		return nil, err
+	}
+
+	res := tmp.ConvertWebhookMessage()
+	return res, nil
+}
+
+// CampaigncallSkip skips the previewing campaigncall.
+// It returns skipped campaigncall if it succeed.
+func (h *serviceHandler) CampaigncallSkip(ctx context.Context, a *auth.AuthIdentity, campaigncallID uuid.UUID) (*cacampaigncall.WebhookMessage, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":            "CampaigncallSkip",
+		"agent":           a,
+		"campaigncall_id": campaigncallID,
+	})
+	log.Debug("Skipping a campaigncall.")
+
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	c, err := h.campaigncallGet(ctx, campaigncallID)
+	if err != nil {
+		log.Errorf("Could not get campaigncall info from the campaign-manager. err: %v", err)
+		return nil, fmt.Errorf("%w: could not find campaigncall info", err)
+	}
+
+	if !h.hasPermission(ctx, a, c.CustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager|amagent.PermissionCustomerAgent) {
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	tmp, err := h.reqHandler.CampaignV1CampaigncallSkip(ctx, campaigncallID)
+	if err != nil {
+		log.Errorf("Could not skip the campaign call. err: %v", err)
+		return nil, err
+	}
+
+	res := tmp.ConvertWebhookMessage()
+	return res, nil
+}
+
+// CampaigncallUpdateDisposition sets the campaigncall's disposition.
+// It returns updated campaigncall if it succeed.
+func (h *serviceHandler) CampaigncallUpdateDisposition(ctx context.Context, a *auth.AuthIdentity, campaigncallID uuid.UUID, disposition string) (*cacampaigncall.WebhookMessage, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":            "CampaigncallUpdateDisposition",
+		"agent":           a,
+		"campaigncall_id": campaigncallID,
+		"disposition":     disposition,
+	})
+	log.Debug("Updating the campaigncall's disposition.")
+
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	c, err := h.campaigncallGet(ctx, campaigncallID)
+	if err != nil {
+		log.Errorf("Could not get campaigncall info from the campaign-manager. err: %v", err)
+		return nil, fmt.Errorf("%w: could not find campaigncall info", err)
+	}
+
+	if !h.hasPermission(ctx, a, c.CustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager|amagent.PermissionCustomerAgent) {
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	tmp, err := h.reqHandler.CampaignV1CampaigncallUpdateDisposition(ctx, campaigncallID, disposition)
+	if err != nil {
+		log.Errorf("Could not update the campaign call's disposition. err: %v", err)
+		return nil, err
+	}
+
+	res := tmp.ConvertWebhookMessage()
+	return res, nil
+}
+
+// CampaigncallUpdateCallback schedules the callback of the campaigncall's outdial target.
+// The campaign redials the outdial target at the given time, by the given agent if it is set.
+// It returns updated outdial target if it succeed.
+func (h *serviceHandler) CampaigncallUpdateCallback(ctx context.Context, a *auth.AuthIdentity, campaigncallID uuid.UUID, tmCallback *time.Time, agentID uuid.UUID) (*omoutdialtarget.WebhookMessage, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":            "CampaigncallUpdateCallback",
+		"agent":           a,
+		"campaigncall_id": campaigncallID,
+		"tm_callback":     tmCallback,
+		"agent_id":        agentID,
+	})
+	log.Debug("Scheduling the campaigncall's callback.")
+
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	c, err := h.campaigncallGet(ctx, campaigncallID)
+	if err != nil {
+		log.Errorf("Could not get campaigncall info from the campaign-manager. err: %v", err)
+		return nil, fmt.Errorf("%w: could not find campaigncall info", err)
+	}
+
+	if !h.hasPermission(ctx, a, c.CustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager|amagent.PermissionCustomerAgent) {
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	if errValidate := h.outdialtargetValidateCallbackAgent(ctx, c.CustomerID, agentID); errValidate != nil {
+		log.Errorf("Could not validate the callback agent. err: %v", errValidate)
+		return nil, errValidate
+	}
+
+	tmp, err := h.reqHandler.OutdialV1OutdialtargetUpdateCallback(ctx, c.OutdialTargetID, tmCallback, agentID)
+	if err != nil {
+		log.Errorf("Could not update the outdialtarget's callback. err: %v", err)
+		return nil, err
+	}
+
+	res := tmp.ConvertWebhookMessage()
+	return res, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"reflect"
 	"testing"
+	"time"
 
 	commonidentity "monorepo/bin-common-handler/models/identity"
 	"monorepo/bin-common-handler/pkg/requesthandler"
@@ -11,6 +12,8 @@ import (
 	cacampaign "monorepo/bin-campaign-manager/models/campaign"
 	cacampaigncall "monorepo/bin-campaign-manager/models/campaigncall"
 
+	omoutdialtarget "monorepo/bin-outdial-manager/models/outdialtarget"
+
 	amagent "monorepo/bin-agent-manager/models/agent"
 	"monorepo/bin-api-manager/models/auth"
 
@@ -317,3 +320,390 @@ func Test_CampaigncallDelete(t *testing.T) {
 		})
 	}
 }
+
+func Test_CampaigncallSkip(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		agent *auth.AuthIdentity
+		id    uuid.UUID
+
+		responseCampaigncall *cacampaigncall.Campaigncall
+		expectRes            *cacampaigncall.WebhookMessage
+	}{
+		{
+			"normal",
+			auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("d152e69e-105b-11ee-b395-eb18426de979"),
+					CustomerID: uuid.FromStringOrNil("5f621078-8e5f-11ee-97b2-cfe7337b701c"),
+				},
+				Permission: amagent.PermissionCustomerAgent,
+			}),
+			uuid.FromStringOrNil("b2e41c7a-4f31-11f0-9d5e-3a7c1f8b2e41"),
+
+			&cacampaigncall.Campaigncall{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("b2e41c7a-4f31-11f0-9d5e-3a7c1f8b2e41"),
+					CustomerID: uuid.FromStringOrNil("5f621078-8e5f-11ee-97b2-cfe7337b701c"),
+				},
+			},
+			&cacampaigncall.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("b2e41c7a-4f31-11f0-9d5e-3a7c1f8b2e41"),
+					CustomerID: uuid.FromStringOrNil("5f621078-8e5f-11ee-97b2-cfe7337b701c"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+
+			h := &serviceHandler{
+				reqHandler: mockReq,
+				dbHandler:  mockDB,
+			}
+
+			ctx := context.Background()
+
+			mockReq.EXPECT().CampaignV1CampaigncallGet(ctx, tt.id).Return(tt.responseCampaigncall, nil)
+			mockReq.EXPECT().CampaignV1CampaigncallSkip(ctx, tt.id).Return(tt.responseCampaigncall, nil)
+			res, err := h.CampaigncallSkip(ctx, tt.agent, tt.id)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(*res, *tt.expectRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\n, got: %v\n", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_CampaigncallAccept(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		agent   *auth.AuthIdentity
+		id      uuid.UUID
+		agentID uuid.UUID
+
+		responseCampaigncall *cacampaigncall.Campaigncall
+		expectRes            *cacampaigncall.WebhookMessage
+	}{
+		{
+			"admin accepts for the agent",
+			auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("d152e69e-105b-11ee-b395-eb18426de979"),
+					CustomerID: uuid.FromStringOrNil("5f621078-8e5f-11ee-97b2-cfe7337b701c"),
+				},
+				Permission: amagent.PermissionCustomerAdmin,
+			}),
+			uuid.FromStringOrNil("c4a1d6e2-4f31-11f0-8b7c-5e2f9a1d3c41"),
+			uuid.FromStringOrNil("c4d3f8a6-4f31-11f0-a2e9-7b1c4d6f8a51"),
+
+			&cacampaigncall.Campaigncall{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("c4a1d6e2-4f31-11f0-8b7c-5e2f9a1d3c41"),
+					CustomerID: uuid.FromStringOrNil("5f621078-8e5f-11ee-97b2-cfe7337b701c"),
+				},
+			},
+			&cacampaigncall.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("c4a1d6e2-4f31-11f0-8b7c-5e2f9a1d3c41"),
+					CustomerID: uuid.FromStringOrNil("5f621078-8e5f-11ee-97b2-cfe7337b701c"),
+				},
+			},
+		},
+		{
+			"agent accepts for itself",
+			auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("c4d3f8a6-4f31-11f0-a2e9-7b1c4d6f8a51"),
+					CustomerID: uuid.FromStringOrNil("5f621078-8e5f-11ee-97b2-cfe7337b701c"),
+				},
+				Permission: amagent.PermissionCustomerAgent,
+			}),
+			uuid.FromStringOrNil("c4a1d6e2-4f31-11f0-8b7c-5e2f9a1d3c41"),
+			uuid.FromStringOrNil("c4d3f8a6-4f31-11f0-a2e9-7b1c4d6f8a51"),
+
+			&cacampaigncall.Campaigncall{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("c4a1d6e2-4f31-11f0-8b7c-5e2f9a1d3c41"),
+					CustomerID: uuid.FromStringOrNil("5f621078-8e5f-11ee-97b2-cfe7337b701c"),
+				},
+			},
+			&cacampaigncall.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("c4a1d6e2-4f31-11f0-8b7c-5e2f9a1d3c41"),
+					CustomerID: uuid.FromStringOrNil("5f621078-8e5f-11ee-97b2-cfe7337b701c"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+
+			h := &serviceHandler{
+				reqHandler: mockReq,
+				dbHandler:  mockDB,
+			}
+
+			ctx := context.Background()
+
+			mockReq.EXPECT().CampaignV1CampaigncallGet(ctx, tt.id).Return(tt.responseCampaigncall, nil)
+			mockReq.EXPECT().CampaignV1CampaigncallAccept(ctx, tt.id, tt.agentID).Return(tt.responseCampaigncall, nil)
+			res, err := h.CampaigncallAccept(ctx, tt.agent, tt.id, tt.agentID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(*res, *tt.expectRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\n, got: %v\n", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_CampaigncallAccept_permissionDenied(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		agent   *auth.AuthIdentity
+		id      uuid.UUID
+		agentID uuid.UUID
+
+		responseCampaigncall *cacampaigncall.Campaigncall
+	}{
+		{
+			"agent accepts for another agent",
+			auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("c4d3f8a6-4f31-11f0-a2e9-7b1c4d6f8a51"),
+					CustomerID: uuid.FromStringOrNil("5f621078-8e5f-11ee-97b2-cfe7337b701c"),
+				},
+				Permission: amagent.PermissionCustomerAgent,
+			}),
+			uuid.FromStringOrNil("c4a1d6e2-4f31-11f0-8b7c-5e2f9a1d3c41"),
+			uuid.FromStringOrNil("d1f6a2c8-4f31-11f0-8c4d-2b9e7f1a3d61"),
+
+			&cacampaigncall.Campaigncall{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("c4a1d6e2-4f31-11f0-8b7c-5e2f9a1d3c41"),
+					CustomerID: uuid.FromStringOrNil("5f621078-8e5f-11ee-97b2-cfe7337b701c"),
+				},
+			},
+		},
+		{
+			"agent of another customer",
+			auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("c4d3f8a6-4f31-11f0-a2e9-7b1c4d6f8a51"),
+					CustomerID: uuid.FromStringOrNil("d22a7e4c-4f31-11f0-b5f1-6c3d8a2e4f71"),
+				},
+				Permission: amagent.PermissionCustomerAgent,
+			}),
+			uuid.FromStringOrNil("c4a1d6e2-4f31-11f0-8b7c-5e2f9a1d3c41"),
+			uuid.FromStringOrNil("c4d3f8a6-4f31-11f0-a2e9-7b1c4d6f8a51"),
+
+			&cacampaigncall.Campaigncall{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("c4a1d6e2-4f31-11f0-8b7c-5e2f9a1d3c41"),
+					CustomerID: uuid.FromStringOrNil("5f621078-8e5f-11ee-97b2-cfe7337b701c"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+
+			h := &serviceHandler{
+				reqHandler: mockReq,
+				dbHandler:  mockDB,
+			}
+
+			ctx := context.Background()
+
+			mockReq.EXPECT().CampaignV1CampaigncallGet(ctx, tt.id).Return(tt.responseCampaigncall, nil)
+			if _, err := h.CampaigncallAccept(ctx, tt.agent, tt.id, tt.agentID); err == nil {
+				t.Errorf("Wrong match. expect: error, got: ok")
+			}
+		})
+	}
+}
+
+func Test_CampaigncallUpdateDisposition(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		agent       *auth.AuthIdentity
+		id          uuid.UUID
+		disposition string
+
+		responseCampaigncall *cacampaigncall.Campaigncall
+		expectRes            *cacampaigncall.WebhookMessage
+	}{
+		{
+			"agent sets the disposition",
+			auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("d152e69e-105b-11ee-b395-eb18426de979"),
+					CustomerID: uuid.FromStringOrNil("5f621078-8e5f-11ee-97b2-cfe7337b701c"),
+				},
+				Permission: amagent.PermissionCustomerAgent,
+			}),
+			uuid.FromStringOrNil("0b4e6c2a-ad30-11f1-9c1d-7f3a5b9e2d41"),
+			"sale",
+
+			&cacampaigncall.Campaigncall{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("0b4e6c2a-ad30-11f1-9c1d-7f3a5b9e2d41"),
+					CustomerID: uuid.FromStringOrNil("5f621078-8e5f-11ee-97b2-cfe7337b701c"),
+				},
+				Disposition: "sale",
+			},
+			&cacampaigncall.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("0b4e6c2a-ad30-11f1-9c1d-7f3a5b9e2d41"),
+					CustomerID: uuid.FromStringOrNil("5f621078-8e5f-11ee-97b2-cfe7337b701c"),
+				},
+				Disposition: "sale",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+
+			h := &serviceHandler{
+				reqHandler: mockReq,
+				dbHandler:  mockDB,
+			}
+
+			ctx := context.Background()
+
+			mockReq.EXPECT().CampaignV1CampaigncallGet(ctx, tt.id).Return(tt.responseCampaigncall, nil)
+			mockReq.EXPECT().CampaignV1CampaigncallUpdateDisposition(ctx, tt.id, tt.disposition).Return(tt.responseCampaigncall, nil)
+			res, err := h.CampaigncallUpdateDisposition(ctx, tt.agent, tt.id, tt.disposition)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(*res, *tt.expectRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\n, got: %v\n", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_CampaigncallUpdateCallback(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		agent      *auth.AuthIdentity
+		id         uuid.UUID
+		tmCallback *time.Time
+		agentID    uuid.UUID
+
+		responseCampaigncall *cacampaigncall.Campaigncall
+		responseAgent        *amagent.Agent
+		responseTarget       *omoutdialtarget.OutdialTarget
+		expectRes            *omoutdialtarget.WebhookMessage
+	}{
+		{
+			"agent schedules the callback for self",
+			auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("d152e69e-105b-11ee-b395-eb18426de979"),
+					CustomerID: uuid.FromStringOrNil("5f621078-8e5f-11ee-97b2-cfe7337b701c"),
+				},
+				Permission: amagent.PermissionCustomerAgent,
+			}),
+			uuid.FromStringOrNil("8c2e4a60-4fa4-11f1-b283-0e1f2a3b4c5d"),
+			timePtr("2026-10-22T15:00:00.000000Z"),
+			uuid.FromStringOrNil("d152e69e-105b-11ee-b395-eb18426de979"),
+
+			&cacampaigncall.Campaigncall{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("8c2e4a60-4fa4-11f1-b283-0e1f2a3b4c5d"),
+					CustomerID: uuid.FromStringOrNil("5f621078-8e5f-11ee-97b2-cfe7337b701c"),
+				},
+				OutdialTargetID: uuid.FromStringOrNil("8c635b92-4fa4-11f1-8394-1f2a3b4c5d6e"),
+			},
+			&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("d152e69e-105b-11ee-b395-eb18426de979"),
+					CustomerID: uuid.FromStringOrNil("5f621078-8e5f-11ee-97b2-cfe7337b701c"),
+				},
+			},
+			&omoutdialtarget.OutdialTarget{
+				ID:              uuid.FromStringOrNil("8c635b92-4fa4-11f1-8394-1f2a3b4c5d6e"),
+				CallbackAgentID: uuid.FromStringOrNil("d152e69e-105b-11ee-b395-eb18426de979"),
+				TMCallback:      timePtr("2026-10-22T15:00:00.000000Z"),
+			},
+			&omoutdialtarget.WebhookMessage{
+				ID:              uuid.FromStringOrNil("8c635b92-4fa4-11f1-8394-1f2a3b4c5d6e"),
+				CallbackAgentID: uuid.FromStringOrNil("d152e69e-105b-11ee-b395-eb18426de979"),
+				TMCallback:      timePtr("2026-10-22T15:00:00.000000Z"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+
+			h := &serviceHandler{
+				reqHandler: mockReq,
+				dbHandler:  mockDB,
+			}
+
+			ctx := context.Background()
+
+			mockReq.EXPECT().CampaignV1CampaigncallGet(ctx, tt.id).Return(tt.responseCampaigncall, nil)
+			mockReq.EXPECT().AgentV1AgentGet(ctx, tt.agentID).Return(tt.responseAgent, nil)
+			mockReq.EXPECT().OutdialV1OutdialtargetUpdateCallback(ctx, tt.responseCampaigncall.OutdialTargetID, tt.tmCallback, tt.agentID).Return(tt.responseTarget, nil)
+			res, err := h.CampaigncallUpdateCallback(ctx, tt.agent, tt.id, tt.tmCallback, tt.agentID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(*res, *tt.expectRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\n, got: %v\n", tt.expectRes, res)
+			}
+		})
+	}
+}
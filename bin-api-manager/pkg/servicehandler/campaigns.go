@@ -319,6 +319,82 @@ func (h *serviceHandler) CampaignUpdateServiceLevel(ctx context.Context, a *auth
 	return res, nil
 }
 
+// CampaignUpdateDialMode updates the campaign's dial mode.
+// It returns updated campaign if it succeed.
+func (h *serviceHandler) CampaignUpdateDialMode(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID, dialMode cacampaign.DialMode, maxAbandonRate int) (*cacampaign.WebhookMessage, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":             "CampaignUpdateDialMode",
+		"customer_id":      a.CustomerID,
+		"username":         a.DisplayName(),
+		"campaign_id":      id,
+		"dial_mode":        dialMode,
+		"max_abandon_rate": maxAbandonRate,
+	})
+	log.Debug("Updating an campaign.")
+
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	// get campaign
+	c, err := h.campaignGet(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get campaign info from the campaign-manager. err: %v", err)
+		return nil, fmt.Errorf("%w: could not find campaign info", err)
+	}
+
+	if !h.hasPermission(ctx, a, c.CustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager) {
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	tmp, err := h.reqHandler.CampaignV1CampaignUpdateDialMode(ctx, id, dialMode, maxAbandonRate)
+	if err != nil {
+		log.Errorf("Could not update the campaign. err: %v", err)
+		return nil, err
+	}
+
+	res := tmp.ConvertWebhookMessage()
+	return res, nil
+}
+
+// CampaignUpdateCallingWindows updates the campaign's calling windows.
+// It returns updated campaign if it succeed.
+func (h *serviceHandler) CampaignUpdateCallingWindows(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID, callingWindows []cacampaign.CallingWindow, timezone string) (*cacampaign.WebhookMessage, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":            "CampaignUpdateCallingWindows",
+		"customer_id":     a.CustomerID,
+		"username":        a.DisplayName(),
+		"campaign_id":     id,
+		"calling_windows": callingWindows,
+		"timezone":        timezone,
+	})
+	log.Debug("Updating an campaign.")
+
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	// get campaign
+	c, err := h.campaignGet(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get campaign info from the campaign-manager. err: %v", err)
+		return nil, fmt.Errorf("%w: could not find campaign info", err)
+	}
+
+	if !h.hasPermission(ctx, a, c.CustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager) {
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	tmp, err := h.reqHandler.CampaignV1CampaignUpdateCallingWindows(ctx, id, callingWindows, timezone)
+	if err != nil {
+		log.Errorf("Could not update the campaign. err: %v", err)
+		return nil, err
+	}
+
+	res := tmp.ConvertWebhookMessage()
+	return res, nil
+}
+
 // CampaignUpdateActions updates the campaign's actions.
 // It returns updated campaign if it succeed.
 func (h *serviceHandler) CampaignUpdateActions(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID, actions []fmaction.Action) (*cacampaign.WebhookMessage, error) {
@@ -530,6 +530,152 @@ func Test_CampaignUpdateServiceLevel(t *testing.T) {
 	}
 }
 
+func Test_CampaignUpdateDialMode(t *testing.T) {
+
+	tests := []struct {
+		name           string
+		agent          *auth.AuthIdentity
+		campaignID     uuid.UUID
+		dialMode       cacampaign.DialMode
+		maxAbandonRate int
+
+		response  *cacampaign.Campaign
+		expectRes *cacampaign.WebhookMessage
+	}{
+		{
+			"normal",
+			auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("d152e69e-105b-11ee-b395-eb18426de979"),
+					CustomerID: uuid.FromStringOrNil("5f621078-8e5f-11ee-97b2-cfe7337b701c"),
+				},
+				Permission: amagent.PermissionCustomerAdmin,
+			}),
+
+			uuid.FromStringOrNil("e7b2c4d8-4f31-11f0-9f3a-1d6e8b2c4a81"),
+			cacampaign.DialModeProgressive,
+			0,
+
+			&cacampaign.Campaign{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("e7b2c4d8-4f31-11f0-9f3a-1d6e8b2c4a81"),
+					CustomerID: uuid.FromStringOrNil("5f621078-8e5f-11ee-97b2-cfe7337b701c"),
+				},
+			},
+			&cacampaign.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("e7b2c4d8-4f31-11f0-9f3a-1d6e8b2c4a81"),
+					CustomerID: uuid.FromStringOrNil("5f621078-8e5f-11ee-97b2-cfe7337b701c"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+
+			h := &serviceHandler{
+				reqHandler: mockReq,
+				dbHandler:  mockDB,
+			}
+
+			ctx := context.Background()
+
+			mockReq.EXPECT().CampaignV1CampaignGet(ctx, tt.campaignID).Return(tt.response, nil)
+			mockReq.EXPECT().CampaignV1CampaignUpdateDialMode(ctx, tt.campaignID, tt.dialMode, tt.maxAbandonRate).Return(tt.response, nil)
+			res, err := h.CampaignUpdateDialMode(ctx, tt.agent, tt.campaignID, tt.dialMode, tt.maxAbandonRate)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(*res, *tt.expectRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\n, got: %v\n", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_CampaignUpdateCallingWindows(t *testing.T) {
+
+	tests := []struct {
+		name           string
+		agent          *auth.AuthIdentity
+		campaignID     uuid.UUID
+		callingWindows []cacampaign.CallingWindow
+		timezone       string
+
+		response  *cacampaign.Campaign
+		expectRes *cacampaign.WebhookMessage
+	}{
+		{
+			"normal",
+			auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("d152e69e-105b-11ee-b395-eb18426de979"),
+					CustomerID: uuid.FromStringOrNil("5f621078-8e5f-11ee-97b2-cfe7337b701c"),
+				},
+				Permission: amagent.PermissionCustomerAdmin,
+			}),
+
+			uuid.FromStringOrNil("b4e2f6a8-adbb-11f0-8c3d-7e1a5b9f2c01"),
+			[]cacampaign.CallingWindow{
+				{
+					Days:  []cacampaign.Day{cacampaign.DaySaturday, cacampaign.DaySunday},
+					Start: "10:00",
+					End:   "18:00",
+				},
+			},
+			"Europe/London",
+
+			&cacampaign.Campaign{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("b4e2f6a8-adbb-11f0-8c3d-7e1a5b9f2c01"),
+					CustomerID: uuid.FromStringOrNil("5f621078-8e5f-11ee-97b2-cfe7337b701c"),
+				},
+			},
+			&cacampaign.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("b4e2f6a8-adbb-11f0-8c3d-7e1a5b9f2c01"),
+					CustomerID: uuid.FromStringOrNil("5f621078-8e5f-11ee-97b2-cfe7337b701c"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+
+			h := &serviceHandler{
+				reqHandler: mockReq,
+				dbHandler:  mockDB,
+			}
+
+			ctx := context.Background()
+
+			mockReq.EXPECT().CampaignV1CampaignGet(ctx, tt.campaignID).Return(tt.response, nil)
+			mockReq.EXPECT().CampaignV1CampaignUpdateCallingWindows(ctx, tt.campaignID, tt.callingWindows, tt.timezone).Return(tt.response, nil)
+			res, err := h.CampaignUpdateCallingWindows(ctx, tt.agent, tt.campaignID, tt.callingWindows, tt.timezone)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(*res, *tt.expectRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\n, got: %v\n", tt.expectRes, res)
+			}
+		})
+	}
+}
+
 func Test_CampaignUpdateActions(t *testing.T) {
 
 	tests := []struct {
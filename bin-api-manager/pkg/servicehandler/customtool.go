@@ -0,0 +1,274 @@
+package servicehandler
+
+import (
+	"context"
+	"fmt"
+
+	amagent "monorepo/bin-agent-manager/models/agent"
+	amcustomtool "monorepo/bin-ai-manager/models/customtool"
+	"monorepo/bin-api-manager/models/auth"
+	"monorepo/bin-api-manager/pkg/serviceerrors"
+	commondatabasehandler "monorepo/bin-common-handler/pkg/databasehandler"
+
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// customToolGet returns the custom tool info.
+func (h *serviceHandler) customToolGet(ctx context.Context, id uuid.UUID) (*amcustomtool.CustomTool, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":           "customToolGet",
+		"custom_tool_id": id,
+	})
+
+	res, err := h.reqHandler.AIV1CustomToolGet(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get the resource info. err: %v", err)
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// CustomToolCreate is a service handler for custom tool creation.
+func (h *serviceHandler) CustomToolCreate(
+	ctx context.Context,
+	a *auth.AuthIdentity,
+	name string,
+	detail string,
+	description string,
+	parameters map[string]any,
+	endpointURL string,
+	headers map[string]string,
+	timeout int,
+	responseTemplate string,
+) (*amcustomtool.WebhookMessage, error) {
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	log := logrus.WithFields(logrus.Fields{
+		"func":        "CustomToolCreate",
+		"customer_id": a.CustomerID,
+		"name":        name,
+		"url":         endpointURL,
+	})
+
+	if !h.hasPermission(ctx, a, a.CustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager) {
+		log.Info("The user has no permission for this agent.")
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	tmp, err := h.reqHandler.AIV1CustomToolCreate(
+		ctx,
+		a.CustomerID,
+		name,
+		detail,
+		description,
+		parameters,
+		endpointURL,
+		headers,
+		timeout,
+		responseTemplate,
+	)
+	if err != nil {
+		log.Errorf("Could not create a new custom tool. err: %v", err)
+		return nil, err
+	}
+	log.WithField("custom_tool", tmp).Debug("Created a new custom tool.")
+
+	res := tmp.ConvertWebhookMessage()
+	return res, nil
+}
+
+// CustomToolGetsByCustomerID gets the list of custom tools of the given customer id.
+func (h *serviceHandler) CustomToolGetsByCustomerID(ctx context.Context, a *auth.AuthIdentity, size uint64, token string) ([]*amcustomtool.WebhookMessage, error) {
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	log := logrus.WithFields(logrus.Fields{
+		"func":        "CustomToolGetsByCustomerID",
+		"customer_id": a.CustomerID,
+		"username":    a.DisplayName(),
+		"size":        size,
+		"token":       token,
+	})
+
+	if token == "" {
+		token = h.utilHandler.TimeGetCurTime()
+	}
+
+	if !h.hasPermission(ctx, a, a.CustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager) {
+		log.Info("The user has no permission for this agent.")
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	// filters
+	filters := map[string]string{
+		"deleted":     "false",
+		"customer_id": a.CustomerID.String(),
+	}
+
+	typedFilters, err := h.convertCustomToolFilters(filters)
+	if err != nil {
+		log.Errorf("Could not convert filters. err: %v", err)
+		return nil, err
+	}
+
+	tmps, err := h.reqHandler.AIV1CustomToolList(ctx, token, size, typedFilters)
+	if err != nil {
+		log.Errorf("Could not get custom tools info from the ai manager. err: %v", err)
+		return nil, fmt.Errorf("%w: could not find custom tools info", err)
+	}
+
+	res := []*amcustomtool.WebhookMessage{}
+	for _, f := range tmps {
+		tmp := f.ConvertWebhookMessage()
+		res = append(res, tmp)
+	}
+
+	return res, nil
+}
+
+// convertCustomToolFilters converts map[string]string to map[amcustomtool.Field]any
+func (h *serviceHandler) convertCustomToolFilters(filters map[string]string) (map[amcustomtool.Field]any, error) {
+	srcAny := make(map[string]any, len(filters))
+	for k, v := range filters {
+		srcAny[k] = v
+	}
+
+	typed, err := commondatabasehandler.ConvertMapToTypedMap(srcAny, amcustomtool.CustomTool{})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[amcustomtool.Field]any, len(typed))
+	for k, v := range typed {
+		result[amcustomtool.Field(k)] = v
+	}
+
+	return result, nil
+}
+
+// CustomToolGet gets the custom tool of the given id.
+func (h *serviceHandler) CustomToolGet(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*amcustomtool.WebhookMessage, error) {
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	log := logrus.WithFields(logrus.Fields{
+		"func":           "CustomToolGet",
+		"customer_id":    a.CustomerID,
+		"username":       a.DisplayName(),
+		"custom_tool_id": id,
+	})
+
+	tmp, err := h.customToolGet(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get custom tool info from the ai manager. err: %v", err)
+		return nil, fmt.Errorf("%w: could not find custom tool info", err)
+	}
+
+	if !h.hasPermission(ctx, a, tmp.CustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager) {
+		log.Info("The user has no permission for this agent.")
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	res := tmp.ConvertWebhookMessage()
+	return res, nil
+}
+
+// CustomToolDelete deletes the custom tool.
+func (h *serviceHandler) CustomToolDelete(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*amcustomtool.WebhookMessage, error) {
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	log := logrus.WithFields(logrus.Fields{
+		"func":           "CustomToolDelete",
+		"customer_id":    a.CustomerID,
+		"username":       a.DisplayName(),
+		"custom_tool_id": id,
+	})
+	log.Debug("Deleting a custom tool.")
+
+	c, err := h.customToolGet(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get custom tool info from the ai-manager. err: %v", err)
+		return nil, fmt.Errorf("%w: could not find custom tool info", err)
+	}
+
+	if !h.hasPermission(ctx, a, c.CustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager) {
+		log.Info("The user has no permission for this agent.")
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	tmp, err := h.reqHandler.AIV1CustomToolDelete(ctx, id)
+	if err != nil {
+		log.Errorf("Could not delete the custom tool. err: %v", err)
+		return nil, err
+	}
+
+	res := tmp.ConvertWebhookMessage()
+	return res, nil
+}
+
+// CustomToolUpdate is a service handler for custom tool update.
+func (h *serviceHandler) CustomToolUpdate(
+	ctx context.Context,
+	a *auth.AuthIdentity,
+	id uuid.UUID,
+	name string,
+	detail string,
+	description string,
+	parameters map[string]any,
+	endpointURL string,
+	headers map[string]string,
+	timeout int,
+	responseTemplate string,
+) (*amcustomtool.WebhookMessage, error) {
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	log := logrus.WithFields(logrus.Fields{
+		"func":        "CustomToolUpdate",
+		"customer_id": a.CustomerID,
+		"id":          id,
+		"name":        name,
+		"url":         endpointURL,
+	})
+
+	c, err := h.customToolGet(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get custom tool info from the ai-manager. err: %v", err)
+		return nil, fmt.Errorf("%w: could not find custom tool info", err)
+	}
+
+	if !h.hasPermission(ctx, a, c.CustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager) {
+		log.Info("The user has no permission for this agent.")
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	tmp, err := h.reqHandler.AIV1CustomToolUpdate(
+		ctx,
+		id,
+		name,
+		detail,
+		description,
+		parameters,
+		endpointURL,
+		headers,
+		timeout,
+		responseTemplate,
+	)
+	if err != nil {
+		log.Errorf("Could not update the custom tool. err: %v", err)
+		return nil, err
+	}
+	log.WithField("custom_tool", tmp).Debugf("Updated custom tool info. custom_tool_id: %s", tmp.ID)
+
+	res := tmp.ConvertWebhookMessage()
+	return res, nil
+}
@@ -0,0 +1,496 @@
+package servicehandler
+
+import (
+	"context"
+	amagent "monorepo/bin-agent-manager/models/agent"
+	amcustomtool "monorepo/bin-ai-manager/models/customtool"
+	"monorepo/bin-api-manager/models/auth"
+	"monorepo/bin-api-manager/pkg/dbhandler"
+	"monorepo/bin-api-manager/pkg/serviceerrors"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/requesthandler"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+	"reflect"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_CustomToolCreate(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		agent            *auth.AuthIdentity
+		toolName         string
+		detail           string
+		description      string
+		parameters       map[string]any
+		url              string
+		headers          map[string]string
+		timeout          int
+		responseTemplate string
+
+		responseCustomTool *amcustomtool.CustomTool
+
+		expectRes *amcustomtool.WebhookMessage
+	}{
+		{
+			name: "normal",
+
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("1b3e5c7a-a8f8-11f0-8e2d-4f6a1c3b5d01"),
+					CustomerID: uuid.FromStringOrNil("1b6a2e4c-a8f8-11f0-9c1f-5a7b2d4e6f02"),
+				},
+				Permission: amagent.PermissionCustomerAdmin,
+			}),
+			toolName:    "lookup_order",
+			detail:      "test detail",
+			description: "Look up an order.",
+			parameters: map[string]any{
+				"type": "object",
+			},
+			url: "https://example.com/orders",
+			headers: map[string]string{
+				"X-Api-Key": "key",
+			},
+			timeout:          3000,
+			responseTemplate: "{{.status}}",
+
+			responseCustomTool: &amcustomtool.CustomTool{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("1b9c4f6e-a8f8-11f0-a0b3-6c8d3e5f7a03"),
+				},
+			},
+
+			expectRes: &amcustomtool.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("1b9c4f6e-a8f8-11f0-a0b3-6c8d3e5f7a03"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+
+			h := serviceHandler{
+				reqHandler:  mockReq,
+				dbHandler:   mockDB,
+				utilHandler: mockUtil,
+			}
+			ctx := context.Background()
+
+			mockReq.EXPECT().AIV1CustomToolCreate(
+				ctx,
+				tt.agent.CustomerID,
+				tt.toolName,
+				tt.detail,
+				tt.description,
+				tt.parameters,
+				tt.url,
+				tt.headers,
+				tt.timeout,
+				tt.responseTemplate,
+			).Return(tt.responseCustomTool, nil)
+
+			res, err := h.CustomToolCreate(ctx, tt.agent, tt.toolName, tt.detail, tt.description, tt.parameters, tt.url, tt.headers, tt.timeout, tt.responseTemplate)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v\n", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_CustomToolCreate_error(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		agent *auth.AuthIdentity
+
+		expectErr error
+	}{
+		{
+			name: "agent has no permission",
+
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("1bc8a1f0-a8f8-11f0-b2c5-7d9e4f6a8b04"),
+					CustomerID: uuid.FromStringOrNil("1b6a2e4c-a8f8-11f0-9c1f-5a7b2d4e6f02"),
+				},
+				Permission: amagent.PermissionNone,
+			}),
+
+			expectErr: serviceerrors.ErrPermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+
+			h := serviceHandler{
+				reqHandler: mockReq,
+			}
+			ctx := context.Background()
+
+			_, err := h.CustomToolCreate(ctx, tt.agent, "lookup_order", "", "Look up an order.", nil, "https://example.com/orders", nil, 0, "")
+			if err != tt.expectErr {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v\n", tt.expectErr, err)
+			}
+		})
+	}
+}
+
+func Test_CustomToolGetsByCustomerID(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		agent *auth.AuthIdentity
+		size  uint64
+		token string
+
+		responseCustomTools []amcustomtool.CustomTool
+
+		expectFilters map[amcustomtool.Field]any
+		expectRes     []*amcustomtool.WebhookMessage
+	}{
+		{
+			name: "normal",
+
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("1bf4c2d6-a8f8-11f0-8a7e-8e1f5a7b9c05"),
+					CustomerID: uuid.FromStringOrNil("1c20e3bc-a8f8-11f0-9f4a-9f2a6b8cad06"),
+				},
+				Permission: amagent.PermissionCustomerManager,
+			}),
+			size:  10,
+			token: "2020-09-20 03:23:20.995000",
+
+			responseCustomTools: []amcustomtool.CustomTool{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("1c4d04a2-a8f8-11f0-b6d9-a03b7c9dbe07"),
+					},
+				},
+			},
+
+			expectFilters: map[amcustomtool.Field]any{
+				amcustomtool.FieldCustomerID: uuid.FromStringOrNil("1c20e3bc-a8f8-11f0-9f4a-9f2a6b8cad06"),
+				amcustomtool.FieldDeleted:    false,
+			},
+			expectRes: []*amcustomtool.WebhookMessage{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("1c4d04a2-a8f8-11f0-b6d9-a03b7c9dbe07"),
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+
+			h := serviceHandler{
+				reqHandler:  mockReq,
+				dbHandler:   mockDB,
+				utilHandler: mockUtil,
+			}
+			ctx := context.Background()
+
+			mockReq.EXPECT().AIV1CustomToolList(ctx, tt.token, tt.size, tt.expectFilters).Return(tt.responseCustomTools, nil)
+
+			res, err := h.CustomToolGetsByCustomerID(ctx, tt.agent, tt.size, tt.token)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v\n", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_CustomToolGet(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		agent        *auth.AuthIdentity
+		customToolID uuid.UUID
+
+		responseCustomTool *amcustomtool.CustomTool
+
+		expectRes *amcustomtool.WebhookMessage
+	}{
+		{
+			name: "normal",
+
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("1c792588-a8f8-11f0-8c3b-b14c8daecf08"),
+					CustomerID: uuid.FromStringOrNil("1ca5466e-a8f8-11f0-a4e7-c25d9ebfd009"),
+				},
+				Permission: amagent.PermissionCustomerAdmin,
+			}),
+			customToolID: uuid.FromStringOrNil("1cd16754-a8f8-11f0-9b2f-d36eafc0e10a"),
+
+			responseCustomTool: &amcustomtool.CustomTool{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("1cd16754-a8f8-11f0-9b2f-d36eafc0e10a"),
+					CustomerID: uuid.FromStringOrNil("1ca5466e-a8f8-11f0-a4e7-c25d9ebfd009"),
+				},
+			},
+
+			expectRes: &amcustomtool.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("1cd16754-a8f8-11f0-9b2f-d36eafc0e10a"),
+					CustomerID: uuid.FromStringOrNil("1ca5466e-a8f8-11f0-a4e7-c25d9ebfd009"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+
+			h := serviceHandler{
+				reqHandler: mockReq,
+			}
+			ctx := context.Background()
+
+			mockReq.EXPECT().AIV1CustomToolGet(ctx, tt.customToolID).Return(tt.responseCustomTool, nil)
+
+			res, err := h.CustomToolGet(ctx, tt.agent, tt.customToolID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v\n", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_CustomToolGet_otherCustomer(t *testing.T) {
+
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockReq := requesthandler.NewMockRequestHandler(mc)
+
+	h := serviceHandler{
+		reqHandler: mockReq,
+	}
+	ctx := context.Background()
+
+	agent := auth.NewAgentIdentity(&amagent.Agent{
+		Identity: commonidentity.Identity{
+			ID:         uuid.FromStringOrNil("1cfd883a-a8f8-11f0-b8a1-e47fb0d1f20b"),
+			CustomerID: uuid.FromStringOrNil("1d29a920-a8f8-11f0-8f6c-f580c1e2030c"),
+		},
+		Permission: amagent.PermissionCustomerAdmin,
+	})
+	customToolID := uuid.FromStringOrNil("1d55ca06-a8f8-11f0-a2d8-0691d2f3140d")
+
+	mockReq.EXPECT().AIV1CustomToolGet(ctx, customToolID).Return(&amcustomtool.CustomTool{
+		Identity: commonidentity.Identity{
+			ID:         customToolID,
+			CustomerID: uuid.FromStringOrNil("1d81eaec-a8f8-11f0-9e4b-17a2e304250e"),
+		},
+	}, nil)
+
+	_, err := h.CustomToolGet(ctx, agent, customToolID)
+	if err != serviceerrors.ErrPermissionDenied {
+		t.Errorf("Wrong match. expect: %v, got: %v", serviceerrors.ErrPermissionDenied, err)
+	}
+}
+
+func Test_CustomToolDelete(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		agent        *auth.AuthIdentity
+		customToolID uuid.UUID
+
+		responseCustomTool *amcustomtool.CustomTool
+
+		expectRes *amcustomtool.WebhookMessage
+	}{
+		{
+			name: "normal",
+
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("1dae0bd2-a8f8-11f0-8d7f-28b3f415360f"),
+					CustomerID: uuid.FromStringOrNil("1dda2cb8-a8f8-11f0-a6b2-39c40526471a"),
+				},
+				Permission: amagent.PermissionCustomerAdmin,
+			}),
+			customToolID: uuid.FromStringOrNil("1e064d9e-a8f8-11f0-9c15-4ad51637582b"),
+
+			responseCustomTool: &amcustomtool.CustomTool{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("1e064d9e-a8f8-11f0-9c15-4ad51637582b"),
+					CustomerID: uuid.FromStringOrNil("1dda2cb8-a8f8-11f0-a6b2-39c40526471a"),
+				},
+			},
+
+			expectRes: &amcustomtool.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("1e064d9e-a8f8-11f0-9c15-4ad51637582b"),
+					CustomerID: uuid.FromStringOrNil("1dda2cb8-a8f8-11f0-a6b2-39c40526471a"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+
+			h := serviceHandler{
+				reqHandler: mockReq,
+			}
+			ctx := context.Background()
+
+			mockReq.EXPECT().AIV1CustomToolGet(ctx, tt.customToolID).Return(tt.responseCustomTool, nil)
+			mockReq.EXPECT().AIV1CustomToolDelete(ctx, tt.customToolID).Return(tt.responseCustomTool, nil)
+
+			res, err := h.CustomToolDelete(ctx, tt.agent, tt.customToolID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v\n", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_CustomToolUpdate(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		agent            *auth.AuthIdentity
+		customToolID     uuid.UUID
+		toolName         string
+		detail           string
+		description      string
+		parameters       map[string]any
+		url              string
+		headers          map[string]string
+		timeout          int
+		responseTemplate string
+
+		responseCustomTool *amcustomtool.CustomTool
+
+		expectRes *amcustomtool.WebhookMessage
+	}{
+		{
+			name: "normal",
+
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("1e326e84-a8f8-11f0-b0e9-5be62748693c"),
+					CustomerID: uuid.FromStringOrNil("1e5e8f6a-a8f8-11f0-9a3d-6cf7385a7a4d"),
+				},
+				Permission: amagent.PermissionCustomerAdmin,
+			}),
+			customToolID: uuid.FromStringOrNil("1e8ab050-a8f8-11f0-8b6e-7d08496b8b5e"),
+			toolName:     "lookup_order",
+			detail:       "updated detail",
+			description:  "Look up an order.",
+			url:          "https://example.com/orders/v2",
+			timeout:      5000,
+
+			responseCustomTool: &amcustomtool.CustomTool{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("1e8ab050-a8f8-11f0-8b6e-7d08496b8b5e"),
+					CustomerID: uuid.FromStringOrNil("1e5e8f6a-a8f8-11f0-9a3d-6cf7385a7a4d"),
+				},
+			},
+
+			expectRes: &amcustomtool.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("1e8ab050-a8f8-11f0-8b6e-7d08496b8b5e"),
+					CustomerID: uuid.FromStringOrNil("1e5e8f6a-a8f8-11f0-9a3d-6cf7385a7a4d"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+
+			h := serviceHandler{
+				reqHandler: mockReq,
+			}
+			ctx := context.Background()
+
+			mockReq.EXPECT().AIV1CustomToolGet(ctx, tt.customToolID).Return(tt.responseCustomTool, nil)
+			mockReq.EXPECT().AIV1CustomToolUpdate(
+				ctx,
+				tt.customToolID,
+				tt.toolName,
+				tt.detail,
+				tt.description,
+				tt.parameters,
+				tt.url,
+				tt.headers,
+				tt.timeout,
+				tt.responseTemplate,
+			).Return(tt.responseCustomTool, nil)
+
+			res, err := h.CustomToolUpdate(ctx, tt.agent, tt.customToolID, tt.toolName, tt.detail, tt.description, tt.parameters, tt.url, tt.headers, tt.timeout, tt.responseTemplate)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v\n", tt.expectRes, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,197 @@
+package servicehandler
+
+import (
+	"context"
+	"fmt"
+
+	"monorepo/bin-api-manager/models/auth"
+	"monorepo/bin-api-manager/pkg/serviceerrors"
+	cadisposition "monorepo/bin-campaign-manager/models/disposition"
+
+	amagent "monorepo/bin-agent-manager/models/agent"
+
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// dispositionGet returns the disposition info.
+func (h *serviceHandler) dispositionGet(ctx context.Context, id uuid.UUID) (*cadisposition.Disposition, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":           "dispositionGet",
+		"disposition_id": id,
+	})
+
+	// send request
+	res, err := h.reqHandler.CampaignV1DispositionGet(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get the disposition info. err: %v", err)
+		return nil, err
+	}
+	log.WithField("disposition", res).Debug("Received result.")
+
+	return res, nil
+}
+
+// DispositionCreate creates a new disposition.
+func (h *serviceHandler) DispositionCreate(ctx context.Context, a *auth.AuthIdentity, code string, name string, detail string, retryMode cadisposition.RetryMode) (*cadisposition.WebhookMessage, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":        "DispositionCreate",
+		"customer_id": a.CustomerID,
+		"code":        code,
+		"retry_mode":  retryMode,
+	})
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	log.Debug("Creating a new disposition.")
+
+	if !h.hasPermission(ctx, a, a.CustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager) {
+		log.Info("The agent has no permission.")
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	tmp, err := h.reqHandler.CampaignV1DispositionCreate(ctx, a.CustomerID, code, name, detail, retryMode)
+	if err != nil {
+		log.Errorf("Could not create a new disposition. err: %v", err)
+		return nil, err
+	}
+
+	res := tmp.ConvertWebhookMessage()
+	return res, nil
+}
+
+// DispositionList returns the list of the customer's dispositions.
+func (h *serviceHandler) DispositionList(ctx context.Context, a *auth.AuthIdentity, size uint64, token string) ([]*cadisposition.WebhookMessage, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":        "DispositionList",
+		"customer_id": a.CustomerID,
+		"username":    a.DisplayName(),
+		"size":        size,
+		"token":       token,
+	})
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	if token == "" {
+		token = h.utilHandler.TimeGetCurTime()
+	}
+
+	// the agents set the campaigncall's disposition, so they can see the list
+	if !h.hasPermission(ctx, a, a.CustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager|amagent.PermissionCustomerAgent) {
+		log.Info("The agent has no permission.")
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	filters := map[cadisposition.Field]any{
+		cadisposition.FieldCustomerID: a.CustomerID,
+		cadisposition.FieldDeleted:    false,
+	}
+	tmps, err := h.reqHandler.CampaignV1DispositionList(ctx, token, size, filters)
+	if err != nil {
+		log.Errorf("Could not get dispositions info from the campaign-manager. err: %v", err)
+		return nil, fmt.Errorf("%w: could not find dispositions info", err)
+	}
+
+	res := []*cadisposition.WebhookMessage{}
+	for _, tmp := range tmps {
+		res = append(res, tmp.ConvertWebhookMessage())
+	}
+
+	return res, nil
+}
+
+// DispositionGet returns the disposition of the given id.
+func (h *serviceHandler) DispositionGet(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*cadisposition.WebhookMessage, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":           "DispositionGet",
+		"customer_id":    a.CustomerID,
+		"username":       a.DisplayName(),
+		"disposition_id": id,
+	})
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	tmp, err := h.dispositionGet(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get disposition info from the campaign-manager. err: %v", err)
+		return nil, fmt.Errorf("%w: could not get disposition info", err)
+	}
+
+	if !h.hasPermission(ctx, a, tmp.CustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager|amagent.PermissionCustomerAgent) {
+		log.Info("The agent has no permission.")
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	res := tmp.ConvertWebhookMessage()
+	return res, nil
+}
+
+// DispositionUpdate updates the disposition of the given id.
+func (h *serviceHandler) DispositionUpdate(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID, name string, detail string, retryMode cadisposition.RetryMode) (*cadisposition.WebhookMessage, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":           "DispositionUpdate",
+		"customer_id":    a.CustomerID,
+		"username":       a.DisplayName(),
+		"disposition_id": id,
+		"retry_mode":     retryMode,
+	})
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	d, err := h.dispositionGet(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get disposition info from the campaign-manager. err: %v", err)
+		return nil, fmt.Errorf("%w: could not get disposition info", err)
+	}
+
+	if !h.hasPermission(ctx, a, d.CustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager) {
+		log.Info("The agent has no permission.")
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	tmp, err := h.reqHandler.CampaignV1DispositionUpdate(ctx, id, name, detail, retryMode)
+	if err != nil {
+		log.Errorf("Could not update the disposition. err: %v", err)
+		return nil, err
+	}
+
+	res := tmp.ConvertWebhookMessage()
+	return res, nil
+}
+
+// DispositionDelete deletes the disposition of the given id.
+func (h *serviceHandler) DispositionDelete(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*cadisposition.WebhookMessage, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":           "DispositionDelete",
+		"customer_id":    a.CustomerID,
+		"username":       a.DisplayName(),
+		"disposition_id": id,
+	})
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	d, err := h.dispositionGet(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get disposition info from the campaign-manager. err: %v", err)
+		return nil, fmt.Errorf("%w: could not get disposition info", err)
+	}
+
+	if !h.hasPermission(ctx, a, d.CustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager) {
+		log.Info("The agent has no permission.")
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	tmp, err := h.reqHandler.CampaignV1DispositionDelete(ctx, id)
+	if err != nil {
+		log.Errorf("Could not delete the disposition. err: %v", err)
+		return nil, err
+	}
+
+	res := tmp.ConvertWebhookMessage()
+	return res, nil
+}
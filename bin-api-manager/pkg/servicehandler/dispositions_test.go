@@ -0,0 +1,438 @@
+package servicehandler
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/requesthandler"
+
+	cadisposition "monorepo/bin-campaign-manager/models/disposition"
+
+	amagent "monorepo/bin-agent-manager/models/agent"
+
+	"monorepo/bin-api-manager/models/auth"
+	"monorepo/bin-api-manager/pkg/serviceerrors"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_DispositionCreate(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		agent     *auth.AuthIdentity
+		code      string
+		dispName  string
+		detail    string
+		retryMode cadisposition.RetryMode
+
+		response  *cadisposition.Disposition
+		expectRes *cadisposition.WebhookMessage
+	}{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("6a1d0e4c-ad2f-11f1-8d3a-0b5c7e9f1a01"),
+					CustomerID: uuid.FromStringOrNil("6a4b2f7e-ad2f-11f1-9e4b-1c6d8f0a2b02"),
+				},
+				Permission: amagent.PermissionCustomerAdmin,
+			}),
+			code:      "wrong_number",
+			dispName:  "Wrong number",
+			detail:    "test detail",
+			retryMode: cadisposition.RetryModeNoRetry,
+
+			response: &cadisposition.Disposition{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("6a7a3b8a-ad2f-11f1-af5c-2d7e9a1b3c03"),
+					CustomerID: uuid.FromStringOrNil("6a4b2f7e-ad2f-11f1-9e4b-1c6d8f0a2b02"),
+				},
+				Code:      "wrong_number",
+				Name:      "Wrong number",
+				Detail:    "test detail",
+				RetryMode: cadisposition.RetryModeNoRetry,
+			},
+			expectRes: &cadisposition.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("6a7a3b8a-ad2f-11f1-af5c-2d7e9a1b3c03"),
+					CustomerID: uuid.FromStringOrNil("6a4b2f7e-ad2f-11f1-9e4b-1c6d8f0a2b02"),
+				},
+				Code:      "wrong_number",
+				Name:      "Wrong number",
+				Detail:    "test detail",
+				RetryMode: cadisposition.RetryModeNoRetry,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			h := &serviceHandler{
+				reqHandler: mockReq,
+			}
+			ctx := context.Background()
+
+			mockReq.EXPECT().CampaignV1DispositionCreate(ctx, tt.agent.CustomerID, tt.code, tt.dispName, tt.detail, tt.retryMode).Return(tt.response, nil)
+
+			res, err := h.DispositionCreate(ctx, tt.agent, tt.code, tt.dispName, tt.detail, tt.retryMode)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_DispositionList(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		agent     *auth.AuthIdentity
+		pageToken string
+		pageSize  uint64
+
+		response      []cadisposition.Disposition
+		expectFilters map[cadisposition.Field]any
+		expectRes     []*cadisposition.WebhookMessage
+	}{
+		{
+			name: "agent can list the dispositions",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("6a1d0e4c-ad2f-11f1-8d3a-0b5c7e9f1a01"),
+					CustomerID: uuid.FromStringOrNil("6a4b2f7e-ad2f-11f1-9e4b-1c6d8f0a2b02"),
+				},
+				Permission: amagent.PermissionCustomerAgent,
+			}),
+			pageToken: "2020-10-20T01:00:00.995000Z",
+			pageSize:  10,
+
+			response: []cadisposition.Disposition{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("6aa8c4f6-ad2f-11f1-b06d-3e8f0b2c4d04"),
+					},
+					Code: "sale",
+				},
+			},
+			expectFilters: map[cadisposition.Field]any{
+				cadisposition.FieldCustomerID: uuid.FromStringOrNil("6a4b2f7e-ad2f-11f1-9e4b-1c6d8f0a2b02"),
+				cadisposition.FieldDeleted:    false,
+			},
+			expectRes: []*cadisposition.WebhookMessage{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("6aa8c4f6-ad2f-11f1-b06d-3e8f0b2c4d04"),
+					},
+					Code: "sale",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			h := &serviceHandler{
+				reqHandler: mockReq,
+			}
+			ctx := context.Background()
+
+			mockReq.EXPECT().CampaignV1DispositionList(ctx, tt.pageToken, tt.pageSize, tt.expectFilters).Return(tt.response, nil)
+
+			res, err := h.DispositionList(ctx, tt.agent, tt.pageSize, tt.pageToken)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_DispositionGet(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		agent         *auth.AuthIdentity
+		dispositionID uuid.UUID
+
+		response  *cadisposition.Disposition
+		expectRes *cadisposition.WebhookMessage
+	}{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("6a1d0e4c-ad2f-11f1-8d3a-0b5c7e9f1a01"),
+					CustomerID: uuid.FromStringOrNil("6a4b2f7e-ad2f-11f1-9e4b-1c6d8f0a2b02"),
+				},
+				Permission: amagent.PermissionCustomerAdmin,
+			}),
+			dispositionID: uuid.FromStringOrNil("6ad6e2a8-ad2f-11f1-917e-4f9a1c3d5e05"),
+
+			response: &cadisposition.Disposition{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("6ad6e2a8-ad2f-11f1-917e-4f9a1c3d5e05"),
+					CustomerID: uuid.FromStringOrNil("6a4b2f7e-ad2f-11f1-9e4b-1c6d8f0a2b02"),
+				},
+				Code: "sale",
+			},
+			expectRes: &cadisposition.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("6ad6e2a8-ad2f-11f1-917e-4f9a1c3d5e05"),
+					CustomerID: uuid.FromStringOrNil("6a4b2f7e-ad2f-11f1-9e4b-1c6d8f0a2b02"),
+				},
+				Code: "sale",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			h := &serviceHandler{
+				reqHandler: mockReq,
+			}
+			ctx := context.Background()
+
+			mockReq.EXPECT().CampaignV1DispositionGet(ctx, tt.dispositionID).Return(tt.response, nil)
+
+			res, err := h.DispositionGet(ctx, tt.agent, tt.dispositionID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_DispositionUpdate(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		agent         *auth.AuthIdentity
+		dispositionID uuid.UUID
+		dispName      string
+		detail        string
+		retryMode     cadisposition.RetryMode
+
+		responseGet    *cadisposition.Disposition
+		responseUpdate *cadisposition.Disposition
+		expectRes      *cadisposition.WebhookMessage
+	}{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("6a1d0e4c-ad2f-11f1-8d3a-0b5c7e9f1a01"),
+					CustomerID: uuid.FromStringOrNil("6a4b2f7e-ad2f-11f1-9e4b-1c6d8f0a2b02"),
+				},
+				Permission: amagent.PermissionCustomerManager,
+			}),
+			dispositionID: uuid.FromStringOrNil("6ad6e2a8-ad2f-11f1-917e-4f9a1c3d5e05"),
+			dispName:      "Callback requested",
+			detail:        "update detail",
+			retryMode:     cadisposition.RetryModeRetry,
+
+			responseGet: &cadisposition.Disposition{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("6ad6e2a8-ad2f-11f1-917e-4f9a1c3d5e05"),
+					CustomerID: uuid.FromStringOrNil("6a4b2f7e-ad2f-11f1-9e4b-1c6d8f0a2b02"),
+				},
+			},
+			responseUpdate: &cadisposition.Disposition{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("6ad6e2a8-ad2f-11f1-917e-4f9a1c3d5e05"),
+					CustomerID: uuid.FromStringOrNil("6a4b2f7e-ad2f-11f1-9e4b-1c6d8f0a2b02"),
+				},
+				Code:      "callback_requested",
+				Name:      "Callback requested",
+				Detail:    "update detail",
+				RetryMode: cadisposition.RetryModeRetry,
+			},
+			expectRes: &cadisposition.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("6ad6e2a8-ad2f-11f1-917e-4f9a1c3d5e05"),
+					CustomerID: uuid.FromStringOrNil("6a4b2f7e-ad2f-11f1-9e4b-1c6d8f0a2b02"),
+				},
+				Code:      "callback_requested",
+				Name:      "Callback requested",
+				Detail:    "update detail",
+				RetryMode: cadisposition.RetryModeRetry,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			h := &serviceHandler{
+				reqHandler: mockReq,
+			}
+			ctx := context.Background()
+
+			mockReq.EXPECT().CampaignV1DispositionGet(ctx, tt.dispositionID).Return(tt.responseGet, nil)
+			mockReq.EXPECT().CampaignV1DispositionUpdate(ctx, tt.dispositionID, tt.dispName, tt.detail, tt.retryMode).Return(tt.responseUpdate, nil)
+
+			res, err := h.DispositionUpdate(ctx, tt.agent, tt.dispositionID, tt.dispName, tt.detail, tt.retryMode)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_DispositionUpdate_permissionDenied(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		agent         *auth.AuthIdentity
+		dispositionID uuid.UUID
+
+		responseGet *cadisposition.Disposition
+	}{
+		{
+			name: "agent can not update the disposition",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("6a1d0e4c-ad2f-11f1-8d3a-0b5c7e9f1a01"),
+					CustomerID: uuid.FromStringOrNil("6a4b2f7e-ad2f-11f1-9e4b-1c6d8f0a2b02"),
+				},
+				Permission: amagent.PermissionCustomerAgent,
+			}),
+			dispositionID: uuid.FromStringOrNil("6ad6e2a8-ad2f-11f1-917e-4f9a1c3d5e05"),
+
+			responseGet: &cadisposition.Disposition{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("6ad6e2a8-ad2f-11f1-917e-4f9a1c3d5e05"),
+					CustomerID: uuid.FromStringOrNil("6a4b2f7e-ad2f-11f1-9e4b-1c6d8f0a2b02"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			h := &serviceHandler{
+				reqHandler: mockReq,
+			}
+			ctx := context.Background()
+
+			mockReq.EXPECT().CampaignV1DispositionGet(ctx, tt.dispositionID).Return(tt.responseGet, nil)
+
+			_, err := h.DispositionUpdate(ctx, tt.agent, tt.dispositionID, "", "", cadisposition.RetryModeDefault)
+			if err != serviceerrors.ErrPermissionDenied {
+				t.Errorf("Wrong match. expect: %v, got: %v", serviceerrors.ErrPermissionDenied, err)
+			}
+		})
+	}
+}
+
+func Test_DispositionDelete(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		agent         *auth.AuthIdentity
+		dispositionID uuid.UUID
+
+		responseGet    *cadisposition.Disposition
+		responseDelete *cadisposition.Disposition
+		expectRes      *cadisposition.WebhookMessage
+	}{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("6a1d0e4c-ad2f-11f1-8d3a-0b5c7e9f1a01"),
+					CustomerID: uuid.FromStringOrNil("6a4b2f7e-ad2f-11f1-9e4b-1c6d8f0a2b02"),
+				},
+				Permission: amagent.PermissionCustomerAdmin,
+			}),
+			dispositionID: uuid.FromStringOrNil("6ad6e2a8-ad2f-11f1-917e-4f9a1c3d5e05"),
+
+			responseGet: &cadisposition.Disposition{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("6ad6e2a8-ad2f-11f1-917e-4f9a1c3d5e05"),
+					CustomerID: uuid.FromStringOrNil("6a4b2f7e-ad2f-11f1-9e4b-1c6d8f0a2b02"),
+				},
+			},
+			responseDelete: &cadisposition.Disposition{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("6ad6e2a8-ad2f-11f1-917e-4f9a1c3d5e05"),
+					CustomerID: uuid.FromStringOrNil("6a4b2f7e-ad2f-11f1-9e4b-1c6d8f0a2b02"),
+				},
+			},
+			expectRes: &cadisposition.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("6ad6e2a8-ad2f-11f1-917e-4f9a1c3d5e05"),
+					CustomerID: uuid.FromStringOrNil("6a4b2f7e-ad2f-11f1-9e4b-1c6d8f0a2b02"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			h := &serviceHandler{
+				reqHandler: mockReq,
+			}
+			ctx := context.Background()
+
+			mockReq.EXPECT().CampaignV1DispositionGet(ctx, tt.dispositionID).Return(tt.responseGet, nil)
+			mockReq.EXPECT().CampaignV1DispositionDelete(ctx, tt.dispositionID).Return(tt.responseDelete, nil)
+
+			res, err := h.DispositionDelete(ctx, tt.agent, tt.dispositionID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
@@ -20,8 +20,13 @@ import (
 
 	bmaccount "monorepo/bin-billing-manager/models/account"
 	bmbilling "monorepo/bin-billing-manager/models/billing"
+	bmestimate "monorepo/bin-billing-manager/models/estimate"
+	bmstatement "monorepo/bin-billing-manager/models/statement"
 	cacampaign "monorepo/bin-campaign-manager/models/campaign"
 	cacampaigncall "monorepo/bin-campaign-manager/models/campaigncall"
+	cacampaignresult "monorepo/bin-campaign-manager/models/campaignresult"
+	cacampaignresultjob "monorepo/bin-campaign-manager/models/campaignresultjob"
+	cadisposition "monorepo/bin-campaign-manager/models/disposition"
 	caoutplan "monorepo/bin-campaign-manager/models/outplan"
 	commonaddress "monorepo/bin-common-handler/models/address"
 	"monorepo/bin-common-handler/pkg/requesthandler"
@@ -30,14 +35,24 @@ import (
 	cscustomer "monorepo/bin-customer-manager/models/customer"
 
 	amai "monorepo/bin-ai-manager/models/ai"
+	amaiassist "monorepo/bin-ai-manager/models/aiassist"
 	amaiaudit "monorepo/bin-ai-manager/models/aiaudit"
 	amaicall "monorepo/bin-ai-manager/models/aicall"
 	amaiprompthistory "monorepo/bin-ai-manager/models/aiprompthistory"
 	amaipromptproposal "monorepo/bin-ai-manager/models/aipromptproposal"
+	amcustomtool "monorepo/bin-ai-manager/models/customtool"
+	amextraction "monorepo/bin-ai-manager/models/extraction"
+	amguardrail "monorepo/bin-ai-manager/models/guardrail"
+	amguardrailviolation "monorepo/bin-ai-manager/models/guardrailviolation"
+	ammcpserver "monorepo/bin-ai-manager/models/mcpserver"
 	ammessage "monorepo/bin-ai-manager/models/message"
 	amparticipant "monorepo/bin-ai-manager/models/participant"
+	amredaction "monorepo/bin-ai-manager/models/redaction"
+	amresponsecache "monorepo/bin-ai-manager/models/responsecache"
 	amsummary "monorepo/bin-ai-manager/models/summary"
 	amteam "monorepo/bin-ai-manager/models/team"
+	amtestrun "monorepo/bin-ai-manager/models/testrun"
+	amtestsuite "monorepo/bin-ai-manager/models/testsuite"
 	amtool "monorepo/bin-ai-manager/models/tool"
 
 	cfconference "monorepo/bin-conference-manager/models/conference"
@@ -65,6 +80,9 @@ import (
 
 	omoutdial "monorepo/bin-outdial-manager/models/outdial"
 	omoutdialtarget "monorepo/bin-outdial-manager/models/outdialtarget"
+	omoutdialtargetjob "monorepo/bin-outdial-manager/models/outdialtargetjob"
+	omsuppression "monorepo/bin-outdial-manager/models/suppression"
+	omsuppressionblock "monorepo/bin-outdial-manager/models/suppressionblock"
 	qmqueue "monorepo/bin-queue-manager/models/queue"
 	qmqueuecall "monorepo/bin-queue-manager/models/queuecall"
 	wcmessage "monorepo/bin-webchat-manager/models/message"
@@ -178,6 +196,7 @@ type ServiceHandler interface {
 	BillingAccountSelfGet(ctx context.Context, a *auth.AuthIdentity) (*bmaccount.WebhookMessage, error)
 	BillingAccountSelfUpdateBasicInfo(ctx context.Context, a *auth.AuthIdentity, name string, detail string) (*bmaccount.WebhookMessage, error)
 	BillingAccountSelfUpdatePaymentInfo(ctx context.Context, a *auth.AuthIdentity, paymentType bmaccount.PaymentType, paymentMethod bmaccount.PaymentMethod) (*bmaccount.WebhookMessage, error)
+	BillingAccountSelfUpdateSpendingSettings(ctx context.Context, a *auth.AuthIdentity, spendLimits []bmaccount.SpendLimit, balanceAlertThresholds []int64, autoTopUpThreshold int64, autoTopUpAmount int64) (*bmaccount.WebhookMessage, error)
 	BillingAccountSelfCreatePaddlePortalSession(ctx context.Context, a *auth.AuthIdentity) (string, error)
 	BillingAccountList(ctx context.Context, a *auth.AuthIdentity, size uint64, token string, filters map[string]string) ([]*bmaccount.Account, error)
 
@@ -185,8 +204,15 @@ type ServiceHandler interface {
 	BillingList(ctx context.Context, a *auth.AuthIdentity, size uint64, token string) ([]*bmbilling.WebhookMessage, error)
 	BillingGet(ctx context.Context, a *auth.AuthIdentity, billingID uuid.UUID) (*bmbilling.WebhookMessage, error)
 
+	// billing statements
+	BillingStatementList(ctx context.Context, a *auth.AuthIdentity, size uint64, token string) ([]*bmstatement.WebhookMessage, error)
+	BillingStatementGet(ctx context.Context, a *auth.AuthIdentity, statementID uuid.UUID) (*bmstatement.WebhookMessage, error)
+
+	// billing estimates
+	BillingEstimateQuote(ctx context.Context, a *auth.AuthIdentity, costType bmbilling.CostType, destination *commonaddress.Address, duration int) (*bmestimate.Estimate, error)
+
 	// call handlers
-	CallCreate(ctx context.Context, a *auth.AuthIdentity, flowID uuid.UUID, actions []fmaction.Action, source *commonaddress.Address, destinations []commonaddress.Address, anonymous string, variables map[string]string) ([]*cmcall.WebhookMessage, []*cmgroupcall.WebhookMessage, error)
+	CallCreate(ctx context.Context, a *auth.AuthIdentity, flowID uuid.UUID, actions []fmaction.Action, source *commonaddress.Address, destinations []commonaddress.Address, anonymous string, variables map[string]string, maxCost int64) ([]*cmcall.WebhookMessage, []*cmgroupcall.WebhookMessage, error)
 	CallGet(ctx context.Context, a *auth.AuthIdentity, callID uuid.UUID) (*cmcall.WebhookMessage, error)
 	CallList(ctx context.Context, a *auth.AuthIdentity, size uint64, token string) ([]*cmcall.WebhookMessage, error)
 	CallDelete(ctx context.Context, a *auth.AuthIdentity, callID uuid.UUID) (*cmcall.WebhookMessage, error)
@@ -252,15 +278,34 @@ type ServiceHandler interface {
 	) (*cacampaign.WebhookMessage, error)
 	CampaignUpdateStatus(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID, status cacampaign.Status) (*cacampaign.WebhookMessage, error)
 	CampaignUpdateServiceLevel(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID, serviceLevel int) (*cacampaign.WebhookMessage, error)
+	CampaignUpdateDialMode(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID, dialMode cacampaign.DialMode, maxAbandonRate int) (*cacampaign.WebhookMessage, error)
+	CampaignUpdateCallingWindows(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID, callingWindows []cacampaign.CallingWindow, timezone string) (*cacampaign.WebhookMessage, error)
 	CampaignUpdateActions(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID, actions []fmaction.Action) (*cacampaign.WebhookMessage, error)
 	CampaignUpdateResourceInfo(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID, outplanID uuid.UUID, outdialID uuid.UUID, queueID uuid.UUID, nextCampaignID uuid.UUID) (*cacampaign.WebhookMessage, error)
 	CampaignUpdateNextCampaignID(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID, nextCampaignID uuid.UUID) (*cacampaign.WebhookMessage, error)
+	CampaignResultList(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID, size uint64, token string) ([]*cacampaignresult.CampaignResult, error)
+
+	// campaignresultjob handlers
+	CampaignResultjobCreate(ctx context.Context, a *auth.AuthIdentity, campaignID uuid.UUID) (*cacampaignresultjob.WebhookMessage, error)
+	CampaignResultjobGet(ctx context.Context, a *auth.AuthIdentity, campaignID uuid.UUID, campaignresultjobID uuid.UUID) (*cacampaignresultjob.WebhookMessage, error)
+	CampaignResultjobList(ctx context.Context, a *auth.AuthIdentity, campaignID uuid.UUID, size uint64, token string) ([]*cacampaignresultjob.WebhookMessage, error)
 
 	// campaigncall handlers
 	CampaigncallList(ctx context.Context, a *auth.AuthIdentity, size uint64, token string) ([]*cacampaigncall.WebhookMessage, error)
 	CampaigncallGetsByCampaignID(ctx context.Context, a *auth.AuthIdentity, campaignID uuid.UUID, size uint64, token string) ([]*cacampaigncall.WebhookMessage, error)
 	CampaigncallGet(ctx context.Context, a *auth.AuthIdentity, campaigncallID uuid.UUID) (*cacampaigncall.WebhookMessage, error)
 	CampaigncallDelete(ctx context.Context, a *auth.AuthIdentity, campaigncallID uuid.UUID) (*cacampaigncall.WebhookMessage, error)
+	CampaigncallAccept(ctx context.Context, a *auth.AuthIdentity, campaigncallID uuid.UUID, agentID uuid.UUID) (*cacampaigncall.WebhookMessage, error)
+	CampaigncallSkip(ctx context.Context, a *auth.AuthIdentity, campaigncallID uuid.UUID) (*cacampaigncall.WebhookMessage, error)
+	CampaigncallUpdateDisposition(ctx context.Context, a *auth.AuthIdentity, campaigncallID uuid.UUID, disposition string) (*cacampaigncall.WebhookMessage, error)
+	CampaigncallUpdateCallback(ctx context.Context, a *auth.AuthIdentity, campaigncallID uuid.UUID, tmCallback *time.Time, agentID uuid.UUID) (*omoutdialtarget.WebhookMessage, error)
+
+	// disposition handlers
+	DispositionCreate(ctx context.Context, a *auth.AuthIdentity, code string, name string, detail string, retryMode cadisposition.RetryMode) (*cadisposition.WebhookMessage, error)
+	DispositionList(ctx context.Context, a *auth.AuthIdentity, size uint64, token string) ([]*cadisposition.WebhookMessage, error)
+	DispositionGet(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*cadisposition.WebhookMessage, error)
+	DispositionUpdate(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID, name string, detail string, retryMode cadisposition.RetryMode) (*cadisposition.WebhookMessage, error)
+	DispositionDelete(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*cadisposition.WebhookMessage, error)
 
 	// ai handlers
 	AICreate(
@@ -280,6 +325,10 @@ type ServiceHandler interface {
 		sttLanguage string,
 		toolNames []amtool.ToolName,
 		autoAICallAuditEnabled bool,
+		engineFallbacks []amai.EngineFallback,
+		redactionConfig *amredaction.Config,
+		guardrailConfig *amguardrail.Config,
+		responseCacheConfig *amresponsecache.Config,
 	) (*amai.WebhookMessage, error)
 	AIGetsByCustomerID(ctx context.Context, a *auth.AuthIdentity, size uint64, token string) ([]*amai.WebhookMessage, error)
 	AIGet(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*amai.WebhookMessage, error)
@@ -302,6 +351,10 @@ type ServiceHandler interface {
 		sttLanguage string,
 		toolNames []amtool.ToolName,
 		autoAICallAuditEnabled bool,
+		engineFallbacks []amai.EngineFallback,
+		redactionConfig *amredaction.Config,
+		guardrailConfig *amguardrail.Config,
+		responseCacheConfig *amresponsecache.Config,
 	) (*amai.WebhookMessage, error)
 	AIActivateInsight(ctx context.Context, a *auth.AuthIdentity, aiID uuid.UUID) (*amai.WebhookMessage, error)
 	AIDirectHashRegenerate(ctx context.Context, a *auth.AuthIdentity, aiID uuid.UUID) (*amai.WebhookMessage, error)
@@ -318,6 +371,92 @@ type ServiceHandler interface {
 	TeamUpdate(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID, name string, detail string, startMemberID uuid.UUID, members []amteam.Member, parameter map[string]any) (*amteam.WebhookMessage, error)
 	TeamDirectHashRegenerate(ctx context.Context, a *auth.AuthIdentity, teamID uuid.UUID) (*amteam.WebhookMessage, error)
 
+	// custom tool handlers
+	CustomToolCreate(
+		ctx context.Context,
+		a *auth.AuthIdentity,
+		name string,
+		detail string,
+		description string,
+		parameters map[string]any,
+		endpointURL string,
+		headers map[string]string,
+		timeout int,
+		responseTemplate string,
+	) (*amcustomtool.WebhookMessage, error)
+	CustomToolGetsByCustomerID(ctx context.Context, a *auth.AuthIdentity, size uint64, token string) ([]*amcustomtool.WebhookMessage, error)
+	CustomToolGet(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*amcustomtool.WebhookMessage, error)
+	CustomToolDelete(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*amcustomtool.WebhookMessage, error)
+	CustomToolUpdate(
+		ctx context.Context,
+		a *auth.AuthIdentity,
+		id uuid.UUID,
+		name string,
+		detail string,
+		description string,
+		parameters map[string]any,
+		endpointURL string,
+		headers map[string]string,
+		timeout int,
+		responseTemplate string,
+	) (*amcustomtool.WebhookMessage, error)
+
+	// mcp server handlers
+	MCPServerCreate(
+		ctx context.Context,
+		a *auth.AuthIdentity,
+		name string,
+		detail string,
+		transport ammcpserver.Transport,
+		endpointURL string,
+		headers map[string]string,
+		timeout int,
+		allowedTools []string,
+	) (*ammcpserver.WebhookMessage, error)
+	MCPServerGetsByCustomerID(ctx context.Context, a *auth.AuthIdentity, size uint64, token string) ([]*ammcpserver.WebhookMessage, error)
+	MCPServerGet(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*ammcpserver.WebhookMessage, error)
+	MCPServerDelete(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*ammcpserver.WebhookMessage, error)
+	MCPServerUpdate(
+		ctx context.Context,
+		a *auth.AuthIdentity,
+		id uuid.UUID,
+		name string,
+		detail string,
+		transport ammcpserver.Transport,
+		endpointURL string,
+		headers map[string]string,
+		timeout int,
+		allowedTools []string,
+	) (*ammcpserver.WebhookMessage, error)
+	MCPServerToolList(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) ([]amtool.Tool, error)
+
+	// test suite handlers
+	TestSuiteCreate(
+		ctx context.Context,
+		a *auth.AuthIdentity,
+		aiID uuid.UUID,
+		name string,
+		detail string,
+		scenarios []amtestsuite.Scenario,
+	) (*amtestsuite.WebhookMessage, error)
+	TestSuiteGetsByCustomerID(ctx context.Context, a *auth.AuthIdentity, size uint64, token string, aiID uuid.UUID) ([]*amtestsuite.WebhookMessage, error)
+	TestSuiteGet(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*amtestsuite.WebhookMessage, error)
+	TestSuiteDelete(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*amtestsuite.WebhookMessage, error)
+	TestSuiteUpdate(
+		ctx context.Context,
+		a *auth.AuthIdentity,
+		id uuid.UUID,
+		name string,
+		detail string,
+		scenarios []amtestsuite.Scenario,
+	) (*amtestsuite.WebhookMessage, error)
+	TestSuiteRun(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*amtestrun.WebhookMessage, error)
+
+	// test run handlers
+	TestRunGetsByCustomerID(ctx context.Context, a *auth.AuthIdentity, size uint64, token string, testSuiteID uuid.UUID) ([]*amtestrun.WebhookMessage, error)
+	TestRunGet(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*amtestrun.WebhookMessage, error)
+	TestRunDelete(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*amtestrun.WebhookMessage, error)
+
 	// aicall handlers
 	AIcallCreate(
 		ctx context.Context,
@@ -361,12 +500,47 @@ type ServiceHandler interface {
 	AISummaryGet(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*amsummary.WebhookMessage, error)
 	AISummaryDelete(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*amsummary.WebhookMessage, error)
 
+	// ai extraction handlers
+	AIExtractionCreate(
+		ctx context.Context,
+		a *auth.AuthIdentity,
+		onEndFlowID uuid.UUID,
+		referenceType amextraction.ReferenceType,
+		referenceID uuid.UUID,
+		language string,
+		schema map[string]any,
+		setVariables bool,
+	) (*amextraction.WebhookMessage, error)
+	AIExtractionGetsByCustomerID(ctx context.Context, a *auth.AuthIdentity, size uint64, token string) ([]*amextraction.WebhookMessage, error)
+	AIExtractionGet(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*amextraction.WebhookMessage, error)
+	AIExtractionDelete(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*amextraction.WebhookMessage, error)
+
+	// ai assist handlers
+	AIAssistStart(
+		ctx context.Context,
+		a *auth.AuthIdentity,
+		agentID uuid.UUID,
+		aiID uuid.UUID,
+		referenceType amaiassist.ReferenceType,
+		referenceID uuid.UUID,
+		language string,
+		checklist []string,
+	) (*amaiassist.WebhookMessage, error)
+	AIAssistGetsByCustomerID(ctx context.Context, a *auth.AuthIdentity, size uint64, token string) ([]*amaiassist.WebhookMessage, error)
+	AIAssistGet(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*amaiassist.WebhookMessage, error)
+	AIAssistDelete(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*amaiassist.WebhookMessage, error)
+	AIAssistStop(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*amaiassist.WebhookMessage, error)
+
 	// ai audit handlers
 	AIAuditCreate(ctx context.Context, a *auth.AuthIdentity, aicallID uuid.UUID, language string) ([]*amaiaudit.WebhookMessage, error)
 	AIAuditGetsByCustomerID(ctx context.Context, a *auth.AuthIdentity, size uint64, token string, aicallID, aiID uuid.UUID) ([]*amaiaudit.WebhookMessage, error)
 	AIAuditGet(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*amaiaudit.WebhookMessage, error)
 	AIAuditDelete(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*amaiaudit.WebhookMessage, error)
 
+	// ai guardrail violation handlers
+	AIGuardrailViolationGetsByCustomerID(ctx context.Context, a *auth.AuthIdentity, size uint64, token string, aicallID, aiID uuid.UUID) ([]*amguardrailviolation.WebhookMessage, error)
+	AIGuardrailViolationGet(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*amguardrailviolation.WebhookMessage, error)
+
 	// ai prompt proposal handlers
 	AIPromptProposalCreate(ctx context.Context, a *auth.AuthIdentity, aiID uuid.UUID, auditIDs []uuid.UUID, language string) (*amaipromptproposal.WebhookMessage, error)
 	AIPromptProposalGetsByCustomerID(ctx context.Context, a *auth.AuthIdentity, size uint64, token string, aiID uuid.UUID, status amaipromptproposal.Status) ([]*amaipromptproposal.WebhookMessage, error)
@@ -745,6 +919,7 @@ type ServiceHandler interface {
 		name string,
 		detail string,
 		data string,
+		timezone string,
 		destination0 *commonaddress.Address,
 		destination1 *commonaddress.Address,
 		destination2 *commonaddress.Address,
@@ -753,6 +928,28 @@ type ServiceHandler interface {
 	) (*omoutdialtarget.WebhookMessage, error)
 	OutdialtargetGet(ctx context.Context, a *auth.AuthIdentity, outdialID uuid.UUID, outdialtargetID uuid.UUID) (*omoutdialtarget.WebhookMessage, error)
 	OutdialtargetDelete(ctx context.Context, a *auth.AuthIdentity, outdialID uuid.UUID, outdialtargetID uuid.UUID) (*omoutdialtarget.WebhookMessage, error)
+	OutdialtargetUpdateCallback(ctx context.Context, a *auth.AuthIdentity, outdialID uuid.UUID, outdialtargetID uuid.UUID, tmCallback *time.Time, agentID uuid.UUID) (*omoutdialtarget.WebhookMessage, error)
+
+	// outdialtargetjobs
+	OutdialtargetjobCreate(
+		ctx context.Context,
+		a *auth.AuthIdentity,
+		outdialID uuid.UUID,
+		jobType omoutdialtargetjob.Type,
+		fileID uuid.UUID,
+		mapping *omoutdialtargetjob.Mapping,
+	) (*omoutdialtargetjob.WebhookMessage, error)
+	OutdialtargetjobGet(ctx context.Context, a *auth.AuthIdentity, outdialID uuid.UUID, outdialtargetjobID uuid.UUID) (*omoutdialtargetjob.WebhookMessage, error)
+	OutdialtargetjobList(ctx context.Context, a *auth.AuthIdentity, outdialID uuid.UUID, size uint64, token string) ([]*omoutdialtargetjob.WebhookMessage, error)
+
+	// suppressions
+	SuppressionCreate(ctx context.Context, a *auth.AuthIdentity, listType omsuppression.ListType, target string, detail string) (*omsuppression.WebhookMessage, error)
+	SuppressionImport(ctx context.Context, a *auth.AuthIdentity, listType omsuppression.ListType, targets []string, detail string) (int, int, error)
+	SuppressionList(ctx context.Context, a *auth.AuthIdentity, size uint64, token string) ([]*omsuppression.WebhookMessage, error)
+	SuppressionGet(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*omsuppression.WebhookMessage, error)
+	SuppressionDelete(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*omsuppression.WebhookMessage, error)
+	SuppressionBlockList(ctx context.Context, a *auth.AuthIdentity, size uint64, token string) ([]*omsuppressionblock.WebhookMessage, error)
+	SuppressionBlockGet(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*omsuppressionblock.WebhookMessage, error)
 
 	OutplanCreate(
 		ctx context.Context,
@@ -0,0 +1,304 @@
+package servicehandler
+
+import (
+	"context"
+	"fmt"
+
+	amagent "monorepo/bin-agent-manager/models/agent"
+	ammcpserver "monorepo/bin-ai-manager/models/mcpserver"
+	amtool "monorepo/bin-ai-manager/models/tool"
+	"monorepo/bin-api-manager/models/auth"
+	"monorepo/bin-api-manager/pkg/serviceerrors"
+	commondatabasehandler "monorepo/bin-common-handler/pkg/databasehandler"
+
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// mcpServerGet returns the mcp server info.
+func (h *serviceHandler) mcpServerGet(ctx context.Context, id uuid.UUID) (*ammcpserver.MCPServer, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":          "mcpServerGet",
+		"mcp_server_id": id,
+	})
+
+	res, err := h.reqHandler.AIV1MCPServerGet(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get the resource info. err: %v", err)
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// MCPServerCreate is a service handler for mcp server creation.
+func (h *serviceHandler) MCPServerCreate(
+	ctx context.Context,
+	a *auth.AuthIdentity,
+	name string,
+	detail string,
+	transport ammcpserver.Transport,
+	endpointURL string,
+	headers map[string]string,
+	timeout int,
+	allowedTools []string,
+) (*ammcpserver.WebhookMessage, error) {
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	log := logrus.WithFields(logrus.Fields{
+		"func":        "MCPServerCreate",
+		"customer_id": a.CustomerID,
+		"name":        name,
+		"url":         endpointURL,
+	})
+
+	if !h.hasPermission(ctx, a, a.CustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager) {
+		log.Info("The user has no permission for this agent.")
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	tmp, err := h.reqHandler.AIV1MCPServerCreate(
+		ctx,
+		a.CustomerID,
+		name,
+		detail,
+		transport,
+		endpointURL,
+		headers,
+		timeout,
+		allowedTools,
+	)
+	if err != nil {
+		log.Errorf("Could not create a new mcp server. err: %v", err)
+		return nil, err
+	}
+	log.WithField("mcp_server", tmp).Debug("Created a new mcp server.")
+
+	res := tmp.ConvertWebhookMessage()
+	return res, nil
+}
+
+// MCPServerGetsByCustomerID gets the list of mcp servers of the given customer id.
+func (h *serviceHandler) MCPServerGetsByCustomerID(ctx context.Context, a *auth.AuthIdentity, size uint64, token string) ([]*ammcpserver.WebhookMessage, error) {
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	log := logrus.WithFields(logrus.Fields{
+		"func":        "MCPServerGetsByCustomerID",
+		"customer_id": a.CustomerID,
+		"username":    a.DisplayName(),
+		"size":        size,
+		"token":       token,
+	})
+
+	if token == "" {
+		token = h.utilHandler.TimeGetCurTime()
+	}
+
+	if !h.hasPermission(ctx, a, a.CustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager) {
+		log.Info("The user has no permission for this agent.")
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	// filters
+	filters := map[string]string{
+		"deleted":     "false",
+		"customer_id": a.CustomerID.String(),
+	}
+
+	typedFilters, err := h.convertMCPServerFilters(filters)
+	if err != nil {
+		log.Errorf("Could not convert filters. err: %v", err)
+		return nil, err
+	}
+
+	tmps, err := h.reqHandler.AIV1MCPServerList(ctx, token, size, typedFilters)
+	if err != nil {
+		log.Errorf("Could not get mcp servers info from the ai manager. err: %v", err)
+		return nil, fmt.Errorf("%w: could not find mcp servers info", err)
+	}
+
+	res := []*ammcpserver.WebhookMessage{}
+	for _, f := range tmps {
+		tmp := f.ConvertWebhookMessage()
+		res = append(res, tmp)
+	}
+
+	return res, nil
+}
+
+// convertMCPServerFilters converts map[string]string to map[ammcpserver.Field]any
+func (h *serviceHandler) convertMCPServerFilters(filters map[string]string) (map[ammcpserver.Field]any, error) {
+	srcAny := make(map[string]any, len(filters))
+	for k, v := range filters {
+		srcAny[k] = v
+	}
+
+	typed, err := commondatabasehandler.ConvertMapToTypedMap(srcAny, ammcpserver.MCPServer{})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[ammcpserver.Field]any, len(typed))
+	for k, v := range typed {
+		result[ammcpserver.Field(k)] = v
+	}
+
+	return result, nil
+}
+
+// MCPServerGet gets the mcp server of the given id.
+func (h *serviceHandler) MCPServerGet(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*ammcpserver.WebhookMessage, error) {
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	log := logrus.WithFields(logrus.Fields{
+		"func":          "MCPServerGet",
+		"customer_id":   a.CustomerID,
+		"username":      a.DisplayName(),
+		"mcp_server_id": id,
+	})
+
+	tmp, err := h.mcpServerGet(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get mcp server info from the ai manager. err: %v", err)
+		return nil, fmt.Errorf("%w: could not find mcp server info", err)
+	}
+
+	if !h.hasPermission(ctx, a, tmp.CustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager) {
+		log.Info("The user has no permission for this agent.")
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	res := tmp.ConvertWebhookMessage()
+	return res, nil
+}
+
+// MCPServerDelete deletes the mcp server.
+func (h *serviceHandler) MCPServerDelete(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*ammcpserver.WebhookMessage, error) {
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	log := logrus.WithFields(logrus.Fields{
+		"func":          "MCPServerDelete",
+		"customer_id":   a.CustomerID,
+		"username":      a.DisplayName(),
+		"mcp_server_id": id,
+	})
+	log.Debug("Deleting an mcp server.")
+
+	c, err := h.mcpServerGet(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get mcp server info from the ai-manager. err: %v", err)
+		return nil, fmt.Errorf("%w: could not find mcp server info", err)
+	}
+
+	if !h.hasPermission(ctx, a, c.CustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager) {
+		log.Info("The user has no permission for this agent.")
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	tmp, err := h.reqHandler.AIV1MCPServerDelete(ctx, id)
+	if err != nil {
+		log.Errorf("Could not delete the mcp server. err: %v", err)
+		return nil, err
+	}
+
+	res := tmp.ConvertWebhookMessage()
+	return res, nil
+}
+
+// MCPServerUpdate is a service handler for mcp server update.
+func (h *serviceHandler) MCPServerUpdate(
+	ctx context.Context,
+	a *auth.AuthIdentity,
+	id uuid.UUID,
+	name string,
+	detail string,
+	transport ammcpserver.Transport,
+	endpointURL string,
+	headers map[string]string,
+	timeout int,
+	allowedTools []string,
+) (*ammcpserver.WebhookMessage, error) {
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	log := logrus.WithFields(logrus.Fields{
+		"func":        "MCPServerUpdate",
+		"customer_id": a.CustomerID,
+		"id":          id,
+		"name":        name,
+		"url":         endpointURL,
+	})
+
+	c, err := h.mcpServerGet(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get mcp server info from the ai-manager. err: %v", err)
+		return nil, fmt.Errorf("%w: could not find mcp server info", err)
+	}
+
+	if !h.hasPermission(ctx, a, c.CustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager) {
+		log.Info("The user has no permission for this agent.")
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	tmp, err := h.reqHandler.AIV1MCPServerUpdate(
+		ctx,
+		id,
+		name,
+		detail,
+		transport,
+		endpointURL,
+		headers,
+		timeout,
+		allowedTools,
+	)
+	if err != nil {
+		log.Errorf("Could not update the mcp server. err: %v", err)
+		return nil, err
+	}
+	log.WithField("mcp_server", tmp).Debugf("Updated mcp server info. mcp_server_id: %s", tmp.ID)
+
+	res := tmp.ConvertWebhookMessage()
+	return res, nil
+}
+
+// MCPServerToolList returns the tools the mcp server currently offers to the ai.
+func (h *serviceHandler) MCPServerToolList(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) ([]amtool.Tool, error) {
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	log := logrus.WithFields(logrus.Fields{
+		"func":          "MCPServerToolList",
+		"customer_id":   a.CustomerID,
+		"username":      a.DisplayName(),
+		"mcp_server_id": id,
+	})
+
+	c, err := h.mcpServerGet(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get mcp server info from the ai-manager. err: %v", err)
+		return nil, fmt.Errorf("%w: could not find mcp server info", err)
+	}
+
+	if !h.hasPermission(ctx, a, c.CustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager) {
+		log.Info("The user has no permission for this agent.")
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	res, err := h.reqHandler.AIV1MCPServerToolList(ctx, id)
+	if err != nil {
+		log.Errorf("Could not list the mcp server tools. err: %v", err)
+		return nil, err
+	}
+
+	return res, nil
+}
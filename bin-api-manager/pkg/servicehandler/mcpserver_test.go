@@ -0,0 +1,384 @@
+package servicehandler
+
+import (
+	"context"
+	amagent "monorepo/bin-agent-manager/models/agent"
+	ammcpserver "monorepo/bin-ai-manager/models/mcpserver"
+	amtool "monorepo/bin-ai-manager/models/tool"
+	"monorepo/bin-api-manager/models/auth"
+	"monorepo/bin-api-manager/pkg/serviceerrors"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/requesthandler"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+	"reflect"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_MCPServerCreate(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		agent        *auth.AuthIdentity
+		serverName   string
+		detail       string
+		transport    ammcpserver.Transport
+		url          string
+		headers      map[string]string
+		timeout      int
+		allowedTools []string
+
+		responseMCPServer *ammcpserver.MCPServer
+
+		expectRes *ammcpserver.WebhookMessage
+	}{
+		{
+			name: "normal",
+
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("5a1c3e50-8e3a-11f1-9d2b-1f3a5c7e9b01"),
+					CustomerID: uuid.FromStringOrNil("5a4e6082-8e3a-11f1-a3c4-2b4c6d8e0f02"),
+				},
+				Permission: amagent.PermissionCustomerAdmin,
+			}),
+			serverName: "crm",
+			detail:     "internal crm",
+			transport:  ammcpserver.TransportStreamableHTTP,
+			url:        "https://mcp.example.com/mcp",
+			headers: map[string]string{
+				"Authorization": "Bearer token",
+			},
+			timeout:      5000,
+			allowedTools: []string{"lookup_order"},
+
+			responseMCPServer: &ammcpserver.MCPServer{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("5a8082b4-8e3a-11f1-8e5d-3c5d7e9f1a03"),
+				},
+			},
+
+			expectRes: &ammcpserver.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("5a8082b4-8e3a-11f1-8e5d-3c5d7e9f1a03"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+
+			h := serviceHandler{
+				reqHandler: mockReq,
+			}
+			ctx := context.Background()
+
+			mockReq.EXPECT().AIV1MCPServerCreate(
+				ctx,
+				tt.agent.CustomerID,
+				tt.serverName,
+				tt.detail,
+				tt.transport,
+				tt.url,
+				tt.headers,
+				tt.timeout,
+				tt.allowedTools,
+			).Return(tt.responseMCPServer, nil)
+
+			res, err := h.MCPServerCreate(ctx, tt.agent, tt.serverName, tt.detail, tt.transport, tt.url, tt.headers, tt.timeout, tt.allowedTools)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v\n", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_MCPServerCreate_error(t *testing.T) {
+
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockReq := requesthandler.NewMockRequestHandler(mc)
+
+	h := serviceHandler{
+		reqHandler: mockReq,
+	}
+	ctx := context.Background()
+
+	agent := auth.NewAgentIdentity(&amagent.Agent{
+		Identity: commonidentity.Identity{
+			ID:         uuid.FromStringOrNil("5ab2a4e6-8e3a-11f1-b6f7-4d6e8f0a2b04"),
+			CustomerID: uuid.FromStringOrNil("5a4e6082-8e3a-11f1-a3c4-2b4c6d8e0f02"),
+		},
+		Permission: amagent.PermissionNone,
+	})
+
+	_, err := h.MCPServerCreate(ctx, agent, "crm", "", ammcpserver.TransportStreamableHTTP, "https://mcp.example.com/mcp", nil, 0, nil)
+	if err != serviceerrors.ErrPermissionDenied {
+		t.Errorf("Wrong match. expect: %v, got: %v", serviceerrors.ErrPermissionDenied, err)
+	}
+}
+
+func Test_MCPServerGetsByCustomerID(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		agent *auth.AuthIdentity
+		size  uint64
+		token string
+
+		responseMCPServers []ammcpserver.MCPServer
+
+		expectFilters map[ammcpserver.Field]any
+		expectRes     []*ammcpserver.WebhookMessage
+	}{
+		{
+			name: "normal",
+
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("5ae4c718-8e3a-11f1-8f08-5e7f9a1b3c05"),
+					CustomerID: uuid.FromStringOrNil("5b16e94a-8e3a-11f1-9a19-6f8a0b2c4d06"),
+				},
+				Permission: amagent.PermissionCustomerManager,
+			}),
+			size:  10,
+			token: "2020-09-20 03:23:20.995000",
+
+			responseMCPServers: []ammcpserver.MCPServer{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("5b490b7c-8e3a-11f1-ab2a-7a9b1c3d5e07"),
+					},
+				},
+			},
+
+			expectFilters: map[ammcpserver.Field]any{
+				ammcpserver.FieldCustomerID: uuid.FromStringOrNil("5b16e94a-8e3a-11f1-9a19-6f8a0b2c4d06"),
+				ammcpserver.FieldDeleted:    false,
+			},
+			expectRes: []*ammcpserver.WebhookMessage{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("5b490b7c-8e3a-11f1-ab2a-7a9b1c3d5e07"),
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+
+			h := serviceHandler{
+				reqHandler:  mockReq,
+				utilHandler: mockUtil,
+			}
+			ctx := context.Background()
+
+			mockReq.EXPECT().AIV1MCPServerList(ctx, tt.token, tt.size, tt.expectFilters).Return(tt.responseMCPServers, nil)
+
+			res, err := h.MCPServerGetsByCustomerID(ctx, tt.agent, tt.size, tt.token)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v\n", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_MCPServerGet_otherCustomer(t *testing.T) {
+
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockReq := requesthandler.NewMockRequestHandler(mc)
+
+	h := serviceHandler{
+		reqHandler: mockReq,
+	}
+	ctx := context.Background()
+
+	agent := auth.NewAgentIdentity(&amagent.Agent{
+		Identity: commonidentity.Identity{
+			ID:         uuid.FromStringOrNil("5b7b2dae-8e3a-11f1-8c3b-8bac2d4e6f08"),
+			CustomerID: uuid.FromStringOrNil("5bad4fe0-8e3a-11f1-9d4c-9cbd3e5f7a09"),
+		},
+		Permission: amagent.PermissionCustomerAdmin,
+	})
+	mcpServerID := uuid.FromStringOrNil("5bdf7212-8e3a-11f1-ae5d-adce4f6a8b0a")
+
+	mockReq.EXPECT().AIV1MCPServerGet(ctx, mcpServerID).Return(&ammcpserver.MCPServer{
+		Identity: commonidentity.Identity{
+			ID:         mcpServerID,
+			CustomerID: uuid.FromStringOrNil("5c119444-8e3a-11f1-8f6e-bedf5a7b9c0b"),
+		},
+	}, nil)
+
+	_, err := h.MCPServerGet(ctx, agent, mcpServerID)
+	if err != serviceerrors.ErrPermissionDenied {
+		t.Errorf("Wrong match. expect: %v, got: %v", serviceerrors.ErrPermissionDenied, err)
+	}
+}
+
+func Test_MCPServerDelete(t *testing.T) {
+
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockReq := requesthandler.NewMockRequestHandler(mc)
+
+	h := serviceHandler{
+		reqHandler: mockReq,
+	}
+	ctx := context.Background()
+
+	agent := auth.NewAgentIdentity(&amagent.Agent{
+		Identity: commonidentity.Identity{
+			ID:         uuid.FromStringOrNil("5c43b676-8e3a-11f1-a07f-cfe06b8cad0c"),
+			CustomerID: uuid.FromStringOrNil("5c75d8a8-8e3a-11f1-b180-d0f17c9dbe0d"),
+		},
+		Permission: amagent.PermissionCustomerAdmin,
+	})
+	mcpServerID := uuid.FromStringOrNil("5ca7fada-8e3a-11f1-8291-e1028daecf0e")
+
+	responseMCPServer := &ammcpserver.MCPServer{
+		Identity: commonidentity.Identity{
+			ID:         mcpServerID,
+			CustomerID: agent.CustomerID,
+		},
+	}
+
+	mockReq.EXPECT().AIV1MCPServerGet(ctx, mcpServerID).Return(responseMCPServer, nil)
+	mockReq.EXPECT().AIV1MCPServerDelete(ctx, mcpServerID).Return(responseMCPServer, nil)
+
+	res, err := h.MCPServerDelete(ctx, agent, mcpServerID)
+	if err != nil {
+		t.Errorf("Wrong match. expect: ok, got: %v", err)
+	}
+
+	expectRes := responseMCPServer.ConvertWebhookMessage()
+	if !reflect.DeepEqual(res, expectRes) {
+		t.Errorf("Wrong match.\nexpect: %v\ngot: %v\n", expectRes, res)
+	}
+}
+
+func Test_MCPServerUpdate(t *testing.T) {
+
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockReq := requesthandler.NewMockRequestHandler(mc)
+
+	h := serviceHandler{
+		reqHandler: mockReq,
+	}
+	ctx := context.Background()
+
+	agent := auth.NewAgentIdentity(&amagent.Agent{
+		Identity: commonidentity.Identity{
+			ID:         uuid.FromStringOrNil("5cda1d0c-8e3a-11f1-93a2-f2139ebfd00f"),
+			CustomerID: uuid.FromStringOrNil("5d0c3f3e-8e3a-11f1-a4b3-0324afc0e110"),
+		},
+		Permission: amagent.PermissionCustomerAdmin,
+	})
+	mcpServerID := uuid.FromStringOrNil("5d3e6170-8e3a-11f1-b5c4-1435b0d1f211")
+
+	responseMCPServer := &ammcpserver.MCPServer{
+		Identity: commonidentity.Identity{
+			ID:         mcpServerID,
+			CustomerID: agent.CustomerID,
+		},
+	}
+
+	mockReq.EXPECT().AIV1MCPServerGet(ctx, mcpServerID).Return(responseMCPServer, nil)
+	mockReq.EXPECT().AIV1MCPServerUpdate(
+		ctx,
+		mcpServerID,
+		"crm",
+		"updated detail",
+		ammcpserver.TransportSSE,
+		"https://mcp.example.com/sse",
+		map[string]string(nil),
+		2000,
+		[]string{"lookup_order", "read_resource"},
+	).Return(responseMCPServer, nil)
+
+	res, err := h.MCPServerUpdate(ctx, agent, mcpServerID, "crm", "updated detail", ammcpserver.TransportSSE, "https://mcp.example.com/sse", nil, 2000, []string{"lookup_order", "read_resource"})
+	if err != nil {
+		t.Errorf("Wrong match. expect: ok, got: %v", err)
+	}
+
+	expectRes := responseMCPServer.ConvertWebhookMessage()
+	if !reflect.DeepEqual(res, expectRes) {
+		t.Errorf("Wrong match.\nexpect: %v\ngot: %v\n", expectRes, res)
+	}
+}
+
+func Test_MCPServerToolList(t *testing.T) {
+
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockReq := requesthandler.NewMockRequestHandler(mc)
+
+	h := serviceHandler{
+		reqHandler: mockReq,
+	}
+	ctx := context.Background()
+
+	agent := auth.NewAgentIdentity(&amagent.Agent{
+		Identity: commonidentity.Identity{
+			ID:         uuid.FromStringOrNil("5d7083a2-8e3a-11f1-86d5-2546c1e2a312"),
+			CustomerID: uuid.FromStringOrNil("5da2a5d4-8e3a-11f1-97e6-3657d2f3b413"),
+		},
+		Permission: amagent.PermissionCustomerManager,
+	})
+	mcpServerID := uuid.FromStringOrNil("5dd4c806-8e3a-11f1-a8f7-4768e304c514")
+
+	responseTools := []amtool.Tool{
+		{
+			Name:        "crm__lookup_order",
+			Description: "Look up an order.",
+			RunLLM:      true,
+		},
+	}
+
+	mockReq.EXPECT().AIV1MCPServerGet(ctx, mcpServerID).Return(&ammcpserver.MCPServer{
+		Identity: commonidentity.Identity{
+			ID:         mcpServerID,
+			CustomerID: agent.CustomerID,
+		},
+	}, nil)
+	mockReq.EXPECT().AIV1MCPServerToolList(ctx, mcpServerID).Return(responseTools, nil)
+
+	res, err := h.MCPServerToolList(ctx, agent, mcpServerID)
+	if err != nil {
+		t.Errorf("Wrong match. expect: ok, got: %v", err)
+	}
+
+	if !reflect.DeepEqual(res, responseTools) {
+		t.Errorf("Wrong match.\nexpect: %v\ngot: %v\n", responseTools, res)
+	}
+}
@@ -15,24 +15,39 @@ import (
 	multipart "mime/multipart"
 	agent "monorepo/bin-agent-manager/models/agent"
 	ai "monorepo/bin-ai-manager/models/ai"
+	aiassist "monorepo/bin-ai-manager/models/aiassist"
 	aiaudit "monorepo/bin-ai-manager/models/aiaudit"
 	aicall "monorepo/bin-ai-manager/models/aicall"
 	aiprompthistory "monorepo/bin-ai-manager/models/aiprompthistory"
 	aipromptproposal "monorepo/bin-ai-manager/models/aipromptproposal"
+	customtool "monorepo/bin-ai-manager/models/customtool"
+	extraction "monorepo/bin-ai-manager/models/extraction"
+	guardrail "monorepo/bin-ai-manager/models/guardrail"
+	guardrailviolation "monorepo/bin-ai-manager/models/guardrailviolation"
+	mcpserver "monorepo/bin-ai-manager/models/mcpserver"
 	message "monorepo/bin-ai-manager/models/message"
 	participant "monorepo/bin-ai-manager/models/participant"
+	redaction "monorepo/bin-ai-manager/models/redaction"
+	responsecache "monorepo/bin-ai-manager/models/responsecache"
 	summary "monorepo/bin-ai-manager/models/summary"
 	team "monorepo/bin-ai-manager/models/team"
+	testrun "monorepo/bin-ai-manager/models/testrun"
+	testsuite "monorepo/bin-ai-manager/models/testsuite"
 	tool "monorepo/bin-ai-manager/models/tool"
 	auth "monorepo/bin-api-manager/models/auth"
 	account "monorepo/bin-billing-manager/models/account"
 	billing "monorepo/bin-billing-manager/models/billing"
+	estimate "monorepo/bin-billing-manager/models/estimate"
+	statement "monorepo/bin-billing-manager/models/statement"
 	call "monorepo/bin-call-manager/models/call"
 	groupcall "monorepo/bin-call-manager/models/groupcall"
 	outboundconfig "monorepo/bin-call-manager/models/outboundconfig"
 	recording "monorepo/bin-call-manager/models/recording"
 	campaign "monorepo/bin-campaign-manager/models/campaign"
 	campaigncall "monorepo/bin-campaign-manager/models/campaigncall"
+	campaignresult "monorepo/bin-campaign-manager/models/campaignresult"
+	campaignresultjob "monorepo/bin-campaign-manager/models/campaignresultjob"
+	disposition "monorepo/bin-campaign-manager/models/disposition"
 	outplan "monorepo/bin-campaign-manager/models/outplan"
 	address "monorepo/bin-common-handler/models/address"
 	conference "monorepo/bin-conference-manager/models/conference"
@@ -56,6 +71,9 @@ import (
 	number "monorepo/bin-number-manager/models/number"
 	outdial "monorepo/bin-outdial-manager/models/outdial"
 	outdialtarget "monorepo/bin-outdial-manager/models/outdialtarget"
+	outdialtargetjob "monorepo/bin-outdial-manager/models/outdialtargetjob"
+	suppression "monorepo/bin-outdial-manager/models/suppression"
+	suppressionblock "monorepo/bin-outdial-manager/models/suppressionblock"
 	queue "monorepo/bin-queue-manager/models/queue"
 	queuecall "monorepo/bin-queue-manager/models/queuecall"
 	rag "monorepo/bin-rag-manager/models/rag"
@@ -129,6 +147,81 @@ func (mr *MockServiceHandlerMockRecorder) AIActivateInsight(ctx, a, aiID any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIActivateInsight", reflect.TypeOf((*MockServiceHandler)(nil).AIActivateInsight), ctx, a, aiID)
 }
 
+// AIAssistDelete mocks base method.
+func (m *MockServiceHandler) AIAssistDelete(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*aiassist.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIAssistDelete", ctx, a, id)
+	ret0, _ := ret[0].(*aiassist.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AIAssistDelete indicates an expected call of AIAssistDelete.
+func (mr *MockServiceHandlerMockRecorder) AIAssistDelete(ctx, a, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIAssistDelete", reflect.TypeOf((*MockServiceHandler)(nil).AIAssistDelete), ctx, a, id)
+}
+
+// AIAssistGet mocks base method.
+func (m *MockServiceHandler) AIAssistGet(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*aiassist.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIAssistGet", ctx, a, id)
+	ret0, _ := ret[0].(*aiassist.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AIAssistGet indicates an expected call of AIAssistGet.
+func (mr *MockServiceHandlerMockRecorder) AIAssistGet(ctx, a, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIAssistGet", reflect.TypeOf((*MockServiceHandler)(nil).AIAssistGet), ctx, a, id)
+}
+
+// AIAssistGetsByCustomerID mocks base method.
+func (m *MockServiceHandler) AIAssistGetsByCustomerID(ctx context.Context, a *auth.AuthIdentity, size uint64, token string) ([]*aiassist.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIAssistGetsByCustomerID", ctx, a, size, token)
+	ret0, _ := ret[0].([]*aiassist.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AIAssistGetsByCustomerID indicates an expected call of AIAssistGetsByCustomerID.
+func (mr *MockServiceHandlerMockRecorder) AIAssistGetsByCustomerID(ctx, a, size, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIAssistGetsByCustomerID", reflect.TypeOf((*MockServiceHandler)(nil).AIAssistGetsByCustomerID), ctx, a, size, token)
+}
+
+// AIAssistStart mocks base method.
+func (m *MockServiceHandler) AIAssistStart(ctx context.Context, a *auth.AuthIdentity, agentID, aiID uuid.UUID, referenceType aiassist.ReferenceType, referenceID uuid.UUID, language string, checklist []string) (*aiassist.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIAssistStart", ctx, a, agentID, aiID, referenceType, referenceID, language, checklist)
+	ret0, _ := ret[0].(*aiassist.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AIAssistStart indicates an expected call of AIAssistStart.
+func (mr *MockServiceHandlerMockRecorder) AIAssistStart(ctx, a, agentID, aiID, referenceType, referenceID, language, checklist any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIAssistStart", reflect.TypeOf((*MockServiceHandler)(nil).AIAssistStart), ctx, a, agentID, aiID, referenceType, referenceID, language, checklist)
+}
+
+// AIAssistStop mocks base method.
+func (m *MockServiceHandler) AIAssistStop(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*aiassist.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIAssistStop", ctx, a, id)
+	ret0, _ := ret[0].(*aiassist.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AIAssistStop indicates an expected call of AIAssistStop.
+func (mr *MockServiceHandlerMockRecorder) AIAssistStop(ctx, a, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIAssistStop", reflect.TypeOf((*MockServiceHandler)(nil).AIAssistStop), ctx, a, id)
+}
+
 // AIAuditCreate mocks base method.
 func (m *MockServiceHandler) AIAuditCreate(ctx context.Context, a *auth.AuthIdentity, aicallID uuid.UUID, language string) ([]*aiaudit.WebhookMessage, error) {
 	m.ctrl.T.Helper()
@@ -190,18 +283,18 @@ func (mr *MockServiceHandlerMockRecorder) AIAuditGetsByCustomerID(ctx, a, size,
 }
 
 // AICreate mocks base method.
-func (m *MockServiceHandler) AICreate(ctx context.Context, a *auth.AuthIdentity, name, detail string, aiType ai.Type, engineModel ai.EngineModel, parameter map[string]any, engineKey string, ragID uuid.UUID, initPrompt string, ttsType ai.TTSType, ttsVoiceID string, sttType ai.STTType, sttLanguage string, toolNames []tool.ToolName, autoAICallAuditEnabled bool) (*ai.WebhookMessage, error) {
+func (m *MockServiceHandler) AICreate(ctx context.Context, a *auth.AuthIdentity, name, detail string, aiType ai.Type, engineModel ai.EngineModel, parameter map[string]any, engineKey string, ragID uuid.UUID, initPrompt string, ttsType ai.TTSType, ttsVoiceID string, sttType ai.STTType, sttLanguage string, toolNames []tool.ToolName, autoAICallAuditEnabled bool, engineFallbacks []ai.EngineFallback, redactionConfig *redaction.Config, guardrailConfig *guardrail.Config, responseCacheConfig *responsecache.Config) (*ai.WebhookMessage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AICreate", ctx, a, name, detail, aiType, engineModel, parameter, engineKey, ragID, initPrompt, ttsType, ttsVoiceID, sttType, sttLanguage, toolNames, autoAICallAuditEnabled)
+	ret := m.ctrl.Call(m, "AICreate", ctx, a, name, detail, aiType, engineModel, parameter, engineKey, ragID, initPrompt, ttsType, ttsVoiceID, sttType, sttLanguage, toolNames, autoAICallAuditEnabled, engineFallbacks, redactionConfig, guardrailConfig, responseCacheConfig)
 	ret0, _ := ret[0].(*ai.WebhookMessage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AICreate indicates an expected call of AICreate.
-func (mr *MockServiceHandlerMockRecorder) AICreate(ctx, a, name, detail, aiType, engineModel, parameter, engineKey, ragID, initPrompt, ttsType, ttsVoiceID, sttType, sttLanguage, toolNames, autoAICallAuditEnabled any) *gomock.Call {
+func (mr *MockServiceHandlerMockRecorder) AICreate(ctx, a, name, detail, aiType, engineModel, parameter, engineKey, ragID, initPrompt, ttsType, ttsVoiceID, sttType, sttLanguage, toolNames, autoAICallAuditEnabled, engineFallbacks, redactionConfig, guardrailConfig, responseCacheConfig any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AICreate", reflect.TypeOf((*MockServiceHandler)(nil).AICreate), ctx, a, name, detail, aiType, engineModel, parameter, engineKey, ragID, initPrompt, ttsType, ttsVoiceID, sttType, sttLanguage, toolNames, autoAICallAuditEnabled)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AICreate", reflect.TypeOf((*MockServiceHandler)(nil).AICreate), ctx, a, name, detail, aiType, engineModel, parameter, engineKey, ragID, initPrompt, ttsType, ttsVoiceID, sttType, sttLanguage, toolNames, autoAICallAuditEnabled, engineFallbacks, redactionConfig, guardrailConfig, responseCacheConfig)
 }
 
 // AIDelete mocks base method.
@@ -234,6 +327,66 @@ func (mr *MockServiceHandlerMockRecorder) AIDirectHashRegenerate(ctx, a, aiID an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIDirectHashRegenerate", reflect.TypeOf((*MockServiceHandler)(nil).AIDirectHashRegenerate), ctx, a, aiID)
 }
 
+// AIExtractionCreate mocks base method.
+func (m *MockServiceHandler) AIExtractionCreate(ctx context.Context, a *auth.AuthIdentity, onEndFlowID uuid.UUID, referenceType extraction.ReferenceType, referenceID uuid.UUID, language string, schema map[string]any, setVariables bool) (*extraction.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIExtractionCreate", ctx, a, onEndFlowID, referenceType, referenceID, language, schema, setVariables)
+	ret0, _ := ret[0].(*extraction.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AIExtractionCreate indicates an expected call of AIExtractionCreate.
+func (mr *MockServiceHandlerMockRecorder) AIExtractionCreate(ctx, a, onEndFlowID, referenceType, referenceID, language, schema, setVariables any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIExtractionCreate", reflect.TypeOf((*MockServiceHandler)(nil).AIExtractionCreate), ctx, a, onEndFlowID, referenceType, referenceID, language, schema, setVariables)
+}
+
+// AIExtractionDelete mocks base method.
+func (m *MockServiceHandler) AIExtractionDelete(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*extraction.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIExtractionDelete", ctx, a, id)
+	ret0, _ := ret[0].(*extraction.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AIExtractionDelete indicates an expected call of AIExtractionDelete.
+func (mr *MockServiceHandlerMockRecorder) AIExtractionDelete(ctx, a, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIExtractionDelete", reflect.TypeOf((*MockServiceHandler)(nil).AIExtractionDelete), ctx, a, id)
+}
+
+// AIExtractionGet mocks base method.
+func (m *MockServiceHandler) AIExtractionGet(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*extraction.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIExtractionGet", ctx, a, id)
+	ret0, _ := ret[0].(*extraction.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AIExtractionGet indicates an expected call of AIExtractionGet.
+func (mr *MockServiceHandlerMockRecorder) AIExtractionGet(ctx, a, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIExtractionGet", reflect.TypeOf((*MockServiceHandler)(nil).AIExtractionGet), ctx, a, id)
+}
+
+// AIExtractionGetsByCustomerID mocks base method.
+func (m *MockServiceHandler) AIExtractionGetsByCustomerID(ctx context.Context, a *auth.AuthIdentity, size uint64, token string) ([]*extraction.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIExtractionGetsByCustomerID", ctx, a, size, token)
+	ret0, _ := ret[0].([]*extraction.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AIExtractionGetsByCustomerID indicates an expected call of AIExtractionGetsByCustomerID.
+func (mr *MockServiceHandlerMockRecorder) AIExtractionGetsByCustomerID(ctx, a, size, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIExtractionGetsByCustomerID", reflect.TypeOf((*MockServiceHandler)(nil).AIExtractionGetsByCustomerID), ctx, a, size, token)
+}
+
 // AIGet mocks base method.
 func (m *MockServiceHandler) AIGet(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*ai.WebhookMessage, error) {
 	m.ctrl.T.Helper()
@@ -264,6 +417,36 @@ func (mr *MockServiceHandlerMockRecorder) AIGetsByCustomerID(ctx, a, size, token
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIGetsByCustomerID", reflect.TypeOf((*MockServiceHandler)(nil).AIGetsByCustomerID), ctx, a, size, token)
 }
 
+// AIGuardrailViolationGet mocks base method.
+func (m *MockServiceHandler) AIGuardrailViolationGet(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*guardrailviolation.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIGuardrailViolationGet", ctx, a, id)
+	ret0, _ := ret[0].(*guardrailviolation.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AIGuardrailViolationGet indicates an expected call of AIGuardrailViolationGet.
+func (mr *MockServiceHandlerMockRecorder) AIGuardrailViolationGet(ctx, a, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIGuardrailViolationGet", reflect.TypeOf((*MockServiceHandler)(nil).AIGuardrailViolationGet), ctx, a, id)
+}
+
+// AIGuardrailViolationGetsByCustomerID mocks base method.
+func (m *MockServiceHandler) AIGuardrailViolationGetsByCustomerID(ctx context.Context, a *auth.AuthIdentity, size uint64, token string, aicallID, aiID uuid.UUID) ([]*guardrailviolation.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIGuardrailViolationGetsByCustomerID", ctx, a, size, token, aicallID, aiID)
+	ret0, _ := ret[0].([]*guardrailviolation.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AIGuardrailViolationGetsByCustomerID indicates an expected call of AIGuardrailViolationGetsByCustomerID.
+func (mr *MockServiceHandlerMockRecorder) AIGuardrailViolationGetsByCustomerID(ctx, a, size, token, aicallID, aiID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIGuardrailViolationGetsByCustomerID", reflect.TypeOf((*MockServiceHandler)(nil).AIGuardrailViolationGetsByCustomerID), ctx, a, size, token, aicallID, aiID)
+}
+
 // AIParticipantGets mocks base method.
 func (m *MockServiceHandler) AIParticipantGets(ctx context.Context, a *auth.AuthIdentity, aiID uuid.UUID, pageToken string, pageSize uint64) ([]*participant.WebhookMessage, error) {
 	m.ctrl.T.Helper()
@@ -460,18 +643,18 @@ func (mr *MockServiceHandlerMockRecorder) AISummaryGetsByCustomerID(ctx, a, size
 }
 
 // AIUpdate mocks base method.
-func (m *MockServiceHandler) AIUpdate(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID, name, detail string, aiType ai.Type, engineModel ai.EngineModel, parameter map[string]any, engineKey string, ragID uuid.UUID, initPrompt string, ttsType ai.TTSType, ttsVoiceID string, sttType ai.STTType, sttLanguage string, toolNames []tool.ToolName, autoAICallAuditEnabled bool) (*ai.WebhookMessage, error) {
+func (m *MockServiceHandler) AIUpdate(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID, name, detail string, aiType ai.Type, engineModel ai.EngineModel, parameter map[string]any, engineKey string, ragID uuid.UUID, initPrompt string, ttsType ai.TTSType, ttsVoiceID string, sttType ai.STTType, sttLanguage string, toolNames []tool.ToolName, autoAICallAuditEnabled bool, engineFallbacks []ai.EngineFallback, redactionConfig *redaction.Config, guardrailConfig *guardrail.Config, responseCacheConfig *responsecache.Config) (*ai.WebhookMessage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AIUpdate", ctx, a, id, name, detail, aiType, engineModel, parameter, engineKey, ragID, initPrompt, ttsType, ttsVoiceID, sttType, sttLanguage, toolNames, autoAICallAuditEnabled)
+	ret := m.ctrl.Call(m, "AIUpdate", ctx, a, id, name, detail, aiType, engineModel, parameter, engineKey, ragID, initPrompt, ttsType, ttsVoiceID, sttType, sttLanguage, toolNames, autoAICallAuditEnabled, engineFallbacks, redactionConfig, guardrailConfig, responseCacheConfig)
 	ret0, _ := ret[0].(*ai.WebhookMessage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AIUpdate indicates an expected call of AIUpdate.
-func (mr *MockServiceHandlerMockRecorder) AIUpdate(ctx, a, id, name, detail, aiType, engineModel, parameter, engineKey, ragID, initPrompt, ttsType, ttsVoiceID, sttType, sttLanguage, toolNames, autoAICallAuditEnabled any) *gomock.Call {
+func (mr *MockServiceHandlerMockRecorder) AIUpdate(ctx, a, id, name, detail, aiType, engineModel, parameter, engineKey, ragID, initPrompt, ttsType, ttsVoiceID, sttType, sttLanguage, toolNames, autoAICallAuditEnabled, engineFallbacks, redactionConfig, guardrailConfig, responseCacheConfig any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIUpdate", reflect.TypeOf((*MockServiceHandler)(nil).AIUpdate), ctx, a, id, name, detail, aiType, engineModel, parameter, engineKey, ragID, initPrompt, ttsType, ttsVoiceID, sttType, sttLanguage, toolNames, autoAICallAuditEnabled)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIUpdate", reflect.TypeOf((*MockServiceHandler)(nil).AIUpdate), ctx, a, id, name, detail, aiType, engineModel, parameter, engineKey, ragID, initPrompt, ttsType, ttsVoiceID, sttType, sttLanguage, toolNames, autoAICallAuditEnabled, engineFallbacks, redactionConfig, guardrailConfig, responseCacheConfig)
 }
 
 // AIcallCreate mocks base method.
@@ -1193,6 +1376,21 @@ func (mr *MockServiceHandlerMockRecorder) BillingAccountSelfUpdatePaymentInfo(ct
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BillingAccountSelfUpdatePaymentInfo", reflect.TypeOf((*MockServiceHandler)(nil).BillingAccountSelfUpdatePaymentInfo), ctx, a, paymentType, paymentMethod)
 }
 
+// BillingAccountSelfUpdateSpendingSettings mocks base method.
+func (m *MockServiceHandler) BillingAccountSelfUpdateSpendingSettings(ctx context.Context, a *auth.AuthIdentity, spendLimits []account.SpendLimit, balanceAlertThresholds []int64, autoTopUpThreshold, autoTopUpAmount int64) (*account.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BillingAccountSelfUpdateSpendingSettings", ctx, a, spendLimits, balanceAlertThresholds, autoTopUpThreshold, autoTopUpAmount)
+	ret0, _ := ret[0].(*account.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BillingAccountSelfUpdateSpendingSettings indicates an expected call of BillingAccountSelfUpdateSpendingSettings.
+func (mr *MockServiceHandlerMockRecorder) BillingAccountSelfUpdateSpendingSettings(ctx, a, spendLimits, balanceAlertThresholds, autoTopUpThreshold, autoTopUpAmount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BillingAccountSelfUpdateSpendingSettings", reflect.TypeOf((*MockServiceHandler)(nil).BillingAccountSelfUpdateSpendingSettings), ctx, a, spendLimits, balanceAlertThresholds, autoTopUpThreshold, autoTopUpAmount)
+}
+
 // BillingAccountSubtractBalanceForce mocks base method.
 func (m *MockServiceHandler) BillingAccountSubtractBalanceForce(ctx context.Context, a *auth.AuthIdentity, billingAccountID uuid.UUID, balance int64) (*account.Account, error) {
 	m.ctrl.T.Helper()
@@ -1238,6 +1436,21 @@ func (mr *MockServiceHandlerMockRecorder) BillingAccountUpdatePaymentInfo(ctx, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BillingAccountUpdatePaymentInfo", reflect.TypeOf((*MockServiceHandler)(nil).BillingAccountUpdatePaymentInfo), ctx, a, billingAccountID, paymentType, paymentMethod)
 }
 
+// BillingEstimateQuote mocks base method.
+func (m *MockServiceHandler) BillingEstimateQuote(ctx context.Context, a *auth.AuthIdentity, costType billing.CostType, destination *address.Address, duration int) (*estimate.Estimate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BillingEstimateQuote", ctx, a, costType, destination, duration)
+	ret0, _ := ret[0].(*estimate.Estimate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BillingEstimateQuote indicates an expected call of BillingEstimateQuote.
+func (mr *MockServiceHandlerMockRecorder) BillingEstimateQuote(ctx, a, costType, destination, duration any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BillingEstimateQuote", reflect.TypeOf((*MockServiceHandler)(nil).BillingEstimateQuote), ctx, a, costType, destination, duration)
+}
+
 // BillingGet mocks base method.
 func (m *MockServiceHandler) BillingGet(ctx context.Context, a *auth.AuthIdentity, billingID uuid.UUID) (*billing.WebhookMessage, error) {
 	m.ctrl.T.Helper()
@@ -1268,10 +1481,40 @@ func (mr *MockServiceHandlerMockRecorder) BillingList(ctx, a, size, token any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BillingList", reflect.TypeOf((*MockServiceHandler)(nil).BillingList), ctx, a, size, token)
 }
 
+// BillingStatementGet mocks base method.
+func (m *MockServiceHandler) BillingStatementGet(ctx context.Context, a *auth.AuthIdentity, statementID uuid.UUID) (*statement.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BillingStatementGet", ctx, a, statementID)
+	ret0, _ := ret[0].(*statement.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BillingStatementGet indicates an expected call of BillingStatementGet.
+func (mr *MockServiceHandlerMockRecorder) BillingStatementGet(ctx, a, statementID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BillingStatementGet", reflect.TypeOf((*MockServiceHandler)(nil).BillingStatementGet), ctx, a, statementID)
+}
+
+// BillingStatementList mocks base method.
+func (m *MockServiceHandler) BillingStatementList(ctx context.Context, a *auth.AuthIdentity, size uint64, token string) ([]*statement.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BillingStatementList", ctx, a, size, token)
+	ret0, _ := ret[0].([]*statement.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BillingStatementList indicates an expected call of BillingStatementList.
+func (mr *MockServiceHandlerMockRecorder) BillingStatementList(ctx, a, size, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BillingStatementList", reflect.TypeOf((*MockServiceHandler)(nil).BillingStatementList), ctx, a, size, token)
+}
+
 // CallCreate mocks base method.
-func (m *MockServiceHandler) CallCreate(ctx context.Context, a *auth.AuthIdentity, flowID uuid.UUID, actions []action.Action, source *address.Address, destinations []address.Address, anonymous string, variables map[string]string) ([]*call.WebhookMessage, []*groupcall.WebhookMessage, error) {
+func (m *MockServiceHandler) CallCreate(ctx context.Context, a *auth.AuthIdentity, flowID uuid.UUID, actions []action.Action, source *address.Address, destinations []address.Address, anonymous string, variables map[string]string, maxCost int64) ([]*call.WebhookMessage, []*groupcall.WebhookMessage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CallCreate", ctx, a, flowID, actions, source, destinations, anonymous, variables)
+	ret := m.ctrl.Call(m, "CallCreate", ctx, a, flowID, actions, source, destinations, anonymous, variables, maxCost)
 	ret0, _ := ret[0].([]*call.WebhookMessage)
 	ret1, _ := ret[1].([]*groupcall.WebhookMessage)
 	ret2, _ := ret[2].(error)
@@ -1279,9 +1522,9 @@ func (m *MockServiceHandler) CallCreate(ctx context.Context, a *auth.AuthIdentit
 }
 
 // CallCreate indicates an expected call of CallCreate.
-func (mr *MockServiceHandlerMockRecorder) CallCreate(ctx, a, flowID, actions, source, destinations, anonymous, variables any) *gomock.Call {
+func (mr *MockServiceHandlerMockRecorder) CallCreate(ctx, a, flowID, actions, source, destinations, anonymous, variables, maxCost any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CallCreate", reflect.TypeOf((*MockServiceHandler)(nil).CallCreate), ctx, a, flowID, actions, source, destinations, anonymous, variables)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CallCreate", reflect.TypeOf((*MockServiceHandler)(nil).CallCreate), ctx, a, flowID, actions, source, destinations, anonymous, variables, maxCost)
 }
 
 // CallDelete mocks base method.
@@ -1574,6 +1817,66 @@ func (mr *MockServiceHandlerMockRecorder) CampaignGetsByCustomerID(ctx, a, size,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CampaignGetsByCustomerID", reflect.TypeOf((*MockServiceHandler)(nil).CampaignGetsByCustomerID), ctx, a, size, token)
 }
 
+// CampaignResultList mocks base method.
+func (m *MockServiceHandler) CampaignResultList(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID, size uint64, token string) ([]*campaignresult.CampaignResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CampaignResultList", ctx, a, id, size, token)
+	ret0, _ := ret[0].([]*campaignresult.CampaignResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CampaignResultList indicates an expected call of CampaignResultList.
+func (mr *MockServiceHandlerMockRecorder) CampaignResultList(ctx, a, id, size, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CampaignResultList", reflect.TypeOf((*MockServiceHandler)(nil).CampaignResultList), ctx, a, id, size, token)
+}
+
+// CampaignResultjobCreate mocks base method.
+func (m *MockServiceHandler) CampaignResultjobCreate(ctx context.Context, a *auth.AuthIdentity, campaignID uuid.UUID) (*campaignresultjob.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CampaignResultjobCreate", ctx, a, campaignID)
+	ret0, _ := ret[0].(*campaignresultjob.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CampaignResultjobCreate indicates an expected call of CampaignResultjobCreate.
+func (mr *MockServiceHandlerMockRecorder) CampaignResultjobCreate(ctx, a, campaignID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CampaignResultjobCreate", reflect.TypeOf((*MockServiceHandler)(nil).CampaignResultjobCreate), ctx, a, campaignID)
+}
+
+// CampaignResultjobGet mocks base method.
+func (m *MockServiceHandler) CampaignResultjobGet(ctx context.Context, a *auth.AuthIdentity, campaignID, campaignresultjobID uuid.UUID) (*campaignresultjob.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CampaignResultjobGet", ctx, a, campaignID, campaignresultjobID)
+	ret0, _ := ret[0].(*campaignresultjob.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CampaignResultjobGet indicates an expected call of CampaignResultjobGet.
+func (mr *MockServiceHandlerMockRecorder) CampaignResultjobGet(ctx, a, campaignID, campaignresultjobID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CampaignResultjobGet", reflect.TypeOf((*MockServiceHandler)(nil).CampaignResultjobGet), ctx, a, campaignID, campaignresultjobID)
+}
+
+// CampaignResultjobList mocks base method.
+func (m *MockServiceHandler) CampaignResultjobList(ctx context.Context, a *auth.AuthIdentity, campaignID uuid.UUID, size uint64, token string) ([]*campaignresultjob.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CampaignResultjobList", ctx, a, campaignID, size, token)
+	ret0, _ := ret[0].([]*campaignresultjob.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CampaignResultjobList indicates an expected call of CampaignResultjobList.
+func (mr *MockServiceHandlerMockRecorder) CampaignResultjobList(ctx, a, campaignID, size, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CampaignResultjobList", reflect.TypeOf((*MockServiceHandler)(nil).CampaignResultjobList), ctx, a, campaignID, size, token)
+}
+
 // CampaignUpdateActions mocks base method.
 func (m *MockServiceHandler) CampaignUpdateActions(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID, actions []action.Action) (*campaign.WebhookMessage, error) {
 	m.ctrl.T.Helper()
@@ -1604,6 +1907,36 @@ func (mr *MockServiceHandlerMockRecorder) CampaignUpdateBasicInfo(ctx, a, id, na
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CampaignUpdateBasicInfo", reflect.TypeOf((*MockServiceHandler)(nil).CampaignUpdateBasicInfo), ctx, a, id, name, detail, campaignType, serviceLevel, endHandle)
 }
 
+// CampaignUpdateCallingWindows mocks base method.
+func (m *MockServiceHandler) CampaignUpdateCallingWindows(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID, callingWindows []campaign.CallingWindow, timezone string) (*campaign.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CampaignUpdateCallingWindows", ctx, a, id, callingWindows, timezone)
+	ret0, _ := ret[0].(*campaign.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CampaignUpdateCallingWindows indicates an expected call of CampaignUpdateCallingWindows.
+func (mr *MockServiceHandlerMockRecorder) CampaignUpdateCallingWindows(ctx, a, id, callingWindows, timezone any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CampaignUpdateCallingWindows", reflect.TypeOf((*MockServiceHandler)(nil).CampaignUpdateCallingWindows), ctx, a, id, callingWindows, timezone)
+}
+
+// CampaignUpdateDialMode mocks base method.
+func (m *MockServiceHandler) CampaignUpdateDialMode(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID, dialMode campaign.DialMode, maxAbandonRate int) (*campaign.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CampaignUpdateDialMode", ctx, a, id, dialMode, maxAbandonRate)
+	ret0, _ := ret[0].(*campaign.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CampaignUpdateDialMode indicates an expected call of CampaignUpdateDialMode.
+func (mr *MockServiceHandlerMockRecorder) CampaignUpdateDialMode(ctx, a, id, dialMode, maxAbandonRate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CampaignUpdateDialMode", reflect.TypeOf((*MockServiceHandler)(nil).CampaignUpdateDialMode), ctx, a, id, dialMode, maxAbandonRate)
+}
+
 // CampaignUpdateNextCampaignID mocks base method.
 func (m *MockServiceHandler) CampaignUpdateNextCampaignID(ctx context.Context, a *auth.AuthIdentity, id, nextCampaignID uuid.UUID) (*campaign.WebhookMessage, error) {
 	m.ctrl.T.Helper()
@@ -1664,6 +1997,21 @@ func (mr *MockServiceHandlerMockRecorder) CampaignUpdateStatus(ctx, a, id, statu
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CampaignUpdateStatus", reflect.TypeOf((*MockServiceHandler)(nil).CampaignUpdateStatus), ctx, a, id, status)
 }
 
+// CampaigncallAccept mocks base method.
+func (m *MockServiceHandler) CampaigncallAccept(ctx context.Context, a *auth.AuthIdentity, campaigncallID, agentID uuid.UUID) (*campaigncall.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CampaigncallAccept", ctx, a, campaigncallID, agentID)
+	ret0, _ := ret[0].(*campaigncall.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CampaigncallAccept indicates an expected call of CampaigncallAccept.
+func (mr *MockServiceHandlerMockRecorder) CampaigncallAccept(ctx, a, campaigncallID, agentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CampaigncallAccept", reflect.TypeOf((*MockServiceHandler)(nil).CampaigncallAccept), ctx, a, campaigncallID, agentID)
+}
+
 // CampaigncallDelete mocks base method.
 func (m *MockServiceHandler) CampaigncallDelete(ctx context.Context, a *auth.AuthIdentity, campaigncallID uuid.UUID) (*campaigncall.WebhookMessage, error) {
 	m.ctrl.T.Helper()
@@ -1724,6 +2072,51 @@ func (mr *MockServiceHandlerMockRecorder) CampaigncallList(ctx, a, size, token a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CampaigncallList", reflect.TypeOf((*MockServiceHandler)(nil).CampaigncallList), ctx, a, size, token)
 }
 
+// CampaigncallSkip mocks base method.
+func (m *MockServiceHandler) CampaigncallSkip(ctx context.Context, a *auth.AuthIdentity, campaigncallID uuid.UUID) (*campaigncall.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CampaigncallSkip", ctx, a, campaigncallID)
+	ret0, _ := ret[0].(*campaigncall.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CampaigncallSkip indicates an expected call of CampaigncallSkip.
+func (mr *MockServiceHandlerMockRecorder) CampaigncallSkip(ctx, a, campaigncallID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CampaigncallSkip", reflect.TypeOf((*MockServiceHandler)(nil).CampaigncallSkip), ctx, a, campaigncallID)
+}
+
+// CampaigncallUpdateCallback mocks base method.
+func (m *MockServiceHandler) CampaigncallUpdateCallback(ctx context.Context, a *auth.AuthIdentity, campaigncallID uuid.UUID, tmCallback *time.Time, agentID uuid.UUID) (*outdialtarget.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CampaigncallUpdateCallback", ctx, a, campaigncallID, tmCallback, agentID)
+	ret0, _ := ret[0].(*outdialtarget.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CampaigncallUpdateCallback indicates an expected call of CampaigncallUpdateCallback.
+func (mr *MockServiceHandlerMockRecorder) CampaigncallUpdateCallback(ctx, a, campaigncallID, tmCallback, agentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CampaigncallUpdateCallback", reflect.TypeOf((*MockServiceHandler)(nil).CampaigncallUpdateCallback), ctx, a, campaigncallID, tmCallback, agentID)
+}
+
+// CampaigncallUpdateDisposition mocks base method.
+func (m *MockServiceHandler) CampaigncallUpdateDisposition(ctx context.Context, a *auth.AuthIdentity, campaigncallID uuid.UUID, arg3 string) (*campaigncall.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CampaigncallUpdateDisposition", ctx, a, campaigncallID, arg3)
+	ret0, _ := ret[0].(*campaigncall.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CampaigncallUpdateDisposition indicates an expected call of CampaigncallUpdateDisposition.
+func (mr *MockServiceHandlerMockRecorder) CampaigncallUpdateDisposition(ctx, a, campaigncallID, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CampaigncallUpdateDisposition", reflect.TypeOf((*MockServiceHandler)(nil).CampaigncallUpdateDisposition), ctx, a, campaigncallID, arg3)
+}
+
 // CaseClose mocks base method.
 func (m *MockServiceHandler) CaseClose(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*kase.Case, error) {
 	m.ctrl.T.Helper()
@@ -2504,6 +2897,81 @@ func (mr *MockServiceHandlerMockRecorder) ConversationUpdate(ctx, a, conversatio
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConversationUpdate", reflect.TypeOf((*MockServiceHandler)(nil).ConversationUpdate), ctx, a, conversationID, fields)
 }
 
+// CustomToolCreate mocks base method.
+func (m *MockServiceHandler) CustomToolCreate(ctx context.Context, a *auth.AuthIdentity, name, detail, description string, parameters map[string]any, endpointURL string, headers map[string]string, timeout int, responseTemplate string) (*customtool.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CustomToolCreate", ctx, a, name, detail, description, parameters, endpointURL, headers, timeout, responseTemplate)
+	ret0, _ := ret[0].(*customtool.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CustomToolCreate indicates an expected call of CustomToolCreate.
+func (mr *MockServiceHandlerMockRecorder) CustomToolCreate(ctx, a, name, detail, description, parameters, endpointURL, headers, timeout, responseTemplate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CustomToolCreate", reflect.TypeOf((*MockServiceHandler)(nil).CustomToolCreate), ctx, a, name, detail, description, parameters, endpointURL, headers, timeout, responseTemplate)
+}
+
+// CustomToolDelete mocks base method.
+func (m *MockServiceHandler) CustomToolDelete(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*customtool.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CustomToolDelete", ctx, a, id)
+	ret0, _ := ret[0].(*customtool.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CustomToolDelete indicates an expected call of CustomToolDelete.
+func (mr *MockServiceHandlerMockRecorder) CustomToolDelete(ctx, a, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CustomToolDelete", reflect.TypeOf((*MockServiceHandler)(nil).CustomToolDelete), ctx, a, id)
+}
+
+// CustomToolGet mocks base method.
+func (m *MockServiceHandler) CustomToolGet(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*customtool.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CustomToolGet", ctx, a, id)
+	ret0, _ := ret[0].(*customtool.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CustomToolGet indicates an expected call of CustomToolGet.
+func (mr *MockServiceHandlerMockRecorder) CustomToolGet(ctx, a, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CustomToolGet", reflect.TypeOf((*MockServiceHandler)(nil).CustomToolGet), ctx, a, id)
+}
+
+// CustomToolGetsByCustomerID mocks base method.
+func (m *MockServiceHandler) CustomToolGetsByCustomerID(ctx context.Context, a *auth.AuthIdentity, size uint64, token string) ([]*customtool.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CustomToolGetsByCustomerID", ctx, a, size, token)
+	ret0, _ := ret[0].([]*customtool.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CustomToolGetsByCustomerID indicates an expected call of CustomToolGetsByCustomerID.
+func (mr *MockServiceHandlerMockRecorder) CustomToolGetsByCustomerID(ctx, a, size, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CustomToolGetsByCustomerID", reflect.TypeOf((*MockServiceHandler)(nil).CustomToolGetsByCustomerID), ctx, a, size, token)
+}
+
+// CustomToolUpdate mocks base method.
+func (m *MockServiceHandler) CustomToolUpdate(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID, name, detail, description string, parameters map[string]any, endpointURL string, headers map[string]string, timeout int, responseTemplate string) (*customtool.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CustomToolUpdate", ctx, a, id, name, detail, description, parameters, endpointURL, headers, timeout, responseTemplate)
+	ret0, _ := ret[0].(*customtool.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CustomToolUpdate indicates an expected call of CustomToolUpdate.
+func (mr *MockServiceHandlerMockRecorder) CustomToolUpdate(ctx, a, id, name, detail, description, parameters, endpointURL, headers, timeout, responseTemplate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CustomToolUpdate", reflect.TypeOf((*MockServiceHandler)(nil).CustomToolUpdate), ctx, a, id, name, detail, description, parameters, endpointURL, headers, timeout, responseTemplate)
+}
+
 // CustomerCreate mocks base method.
 func (m *MockServiceHandler) CustomerCreate(ctx context.Context, a *auth.AuthIdentity, name, detail, arg4, phoneNumber, arg6 string, webhookMethod customer.WebhookMethod, webhookURI string) (*customer.WebhookMessage, error) {
 	m.ctrl.T.Helper()
@@ -2789,6 +3257,81 @@ func (mr *MockServiceHandlerMockRecorder) CustomerUpdateMetadata(ctx, a, custome
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CustomerUpdateMetadata", reflect.TypeOf((*MockServiceHandler)(nil).CustomerUpdateMetadata), ctx, a, customerID, metadata)
 }
 
+// DispositionCreate mocks base method.
+func (m *MockServiceHandler) DispositionCreate(ctx context.Context, a *auth.AuthIdentity, code, name, detail string, retryMode disposition.RetryMode) (*disposition.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DispositionCreate", ctx, a, code, name, detail, retryMode)
+	ret0, _ := ret[0].(*disposition.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DispositionCreate indicates an expected call of DispositionCreate.
+func (mr *MockServiceHandlerMockRecorder) DispositionCreate(ctx, a, code, name, detail, retryMode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DispositionCreate", reflect.TypeOf((*MockServiceHandler)(nil).DispositionCreate), ctx, a, code, name, detail, retryMode)
+}
+
+// DispositionDelete mocks base method.
+func (m *MockServiceHandler) DispositionDelete(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*disposition.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DispositionDelete", ctx, a, id)
+	ret0, _ := ret[0].(*disposition.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DispositionDelete indicates an expected call of DispositionDelete.
+func (mr *MockServiceHandlerMockRecorder) DispositionDelete(ctx, a, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DispositionDelete", reflect.TypeOf((*MockServiceHandler)(nil).DispositionDelete), ctx, a, id)
+}
+
+// DispositionGet mocks base method.
+func (m *MockServiceHandler) DispositionGet(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*disposition.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DispositionGet", ctx, a, id)
+	ret0, _ := ret[0].(*disposition.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DispositionGet indicates an expected call of DispositionGet.
+func (mr *MockServiceHandlerMockRecorder) DispositionGet(ctx, a, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DispositionGet", reflect.TypeOf((*MockServiceHandler)(nil).DispositionGet), ctx, a, id)
+}
+
+// DispositionList mocks base method.
+func (m *MockServiceHandler) DispositionList(ctx context.Context, a *auth.AuthIdentity, size uint64, token string) ([]*disposition.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DispositionList", ctx, a, size, token)
+	ret0, _ := ret[0].([]*disposition.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DispositionList indicates an expected call of DispositionList.
+func (mr *MockServiceHandlerMockRecorder) DispositionList(ctx, a, size, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DispositionList", reflect.TypeOf((*MockServiceHandler)(nil).DispositionList), ctx, a, size, token)
+}
+
+// DispositionUpdate mocks base method.
+func (m *MockServiceHandler) DispositionUpdate(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID, name, detail string, retryMode disposition.RetryMode) (*disposition.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DispositionUpdate", ctx, a, id, name, detail, retryMode)
+	ret0, _ := ret[0].(*disposition.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DispositionUpdate indicates an expected call of DispositionUpdate.
+func (mr *MockServiceHandlerMockRecorder) DispositionUpdate(ctx, a, id, name, detail, retryMode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DispositionUpdate", reflect.TypeOf((*MockServiceHandler)(nil).DispositionUpdate), ctx, a, id, name, detail, retryMode)
+}
+
 // EmailDelete mocks base method.
 func (m *MockServiceHandler) EmailDelete(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*email.WebhookMessage, error) {
 	m.ctrl.T.Helper()
@@ -3120,6 +3663,96 @@ func (mr *MockServiceHandlerMockRecorder) InteractionList(ctx, a, size, token, p
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InteractionList", reflect.TypeOf((*MockServiceHandler)(nil).InteractionList), ctx, a, size, token, peerType, peerTarget, contactID, addressID)
 }
 
+// MCPServerCreate mocks base method.
+func (m *MockServiceHandler) MCPServerCreate(ctx context.Context, a *auth.AuthIdentity, name, detail string, transport mcpserver.Transport, endpointURL string, headers map[string]string, timeout int, allowedTools []string) (*mcpserver.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MCPServerCreate", ctx, a, name, detail, transport, endpointURL, headers, timeout, allowedTools)
+	ret0, _ := ret[0].(*mcpserver.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MCPServerCreate indicates an expected call of MCPServerCreate.
+func (mr *MockServiceHandlerMockRecorder) MCPServerCreate(ctx, a, name, detail, transport, endpointURL, headers, timeout, allowedTools any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MCPServerCreate", reflect.TypeOf((*MockServiceHandler)(nil).MCPServerCreate), ctx, a, name, detail, transport, endpointURL, headers, timeout, allowedTools)
+}
+
+// MCPServerDelete mocks base method.
+func (m *MockServiceHandler) MCPServerDelete(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*mcpserver.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MCPServerDelete", ctx, a, id)
+	ret0, _ := ret[0].(*mcpserver.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MCPServerDelete indicates an expected call of MCPServerDelete.
+func (mr *MockServiceHandlerMockRecorder) MCPServerDelete(ctx, a, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MCPServerDelete", reflect.TypeOf((*MockServiceHandler)(nil).MCPServerDelete), ctx, a, id)
+}
+
+// MCPServerGet mocks base method.
+func (m *MockServiceHandler) MCPServerGet(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*mcpserver.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MCPServerGet", ctx, a, id)
+	ret0, _ := ret[0].(*mcpserver.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MCPServerGet indicates an expected call of MCPServerGet.
+func (mr *MockServiceHandlerMockRecorder) MCPServerGet(ctx, a, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MCPServerGet", reflect.TypeOf((*MockServiceHandler)(nil).MCPServerGet), ctx, a, id)
+}
+
+// MCPServerGetsByCustomerID mocks base method.
+func (m *MockServiceHandler) MCPServerGetsByCustomerID(ctx context.Context, a *auth.AuthIdentity, size uint64, token string) ([]*mcpserver.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MCPServerGetsByCustomerID", ctx, a, size, token)
+	ret0, _ := ret[0].([]*mcpserver.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MCPServerGetsByCustomerID indicates an expected call of MCPServerGetsByCustomerID.
+func (mr *MockServiceHandlerMockRecorder) MCPServerGetsByCustomerID(ctx, a, size, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MCPServerGetsByCustomerID", reflect.TypeOf((*MockServiceHandler)(nil).MCPServerGetsByCustomerID), ctx, a, size, token)
+}
+
+// MCPServerToolList mocks base method.
+func (m *MockServiceHandler) MCPServerToolList(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) ([]tool.Tool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MCPServerToolList", ctx, a, id)
+	ret0, _ := ret[0].([]tool.Tool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MCPServerToolList indicates an expected call of MCPServerToolList.
+func (mr *MockServiceHandlerMockRecorder) MCPServerToolList(ctx, a, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MCPServerToolList", reflect.TypeOf((*MockServiceHandler)(nil).MCPServerToolList), ctx, a, id)
+}
+
+// MCPServerUpdate mocks base method.
+func (m *MockServiceHandler) MCPServerUpdate(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID, name, detail string, transport mcpserver.Transport, endpointURL string, headers map[string]string, timeout int, allowedTools []string) (*mcpserver.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MCPServerUpdate", ctx, a, id, name, detail, transport, endpointURL, headers, timeout, allowedTools)
+	ret0, _ := ret[0].(*mcpserver.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MCPServerUpdate indicates an expected call of MCPServerUpdate.
+func (mr *MockServiceHandlerMockRecorder) MCPServerUpdate(ctx, a, id, name, detail, transport, endpointURL, headers, timeout, allowedTools any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MCPServerUpdate", reflect.TypeOf((*MockServiceHandler)(nil).MCPServerUpdate), ctx, a, id, name, detail, transport, endpointURL, headers, timeout, allowedTools)
+}
+
 // MessageDelete mocks base method.
 func (m *MockServiceHandler) MessageDelete(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*message1.WebhookMessage, error) {
 	m.ctrl.T.Helper()
@@ -3511,18 +4144,18 @@ func (mr *MockServiceHandlerMockRecorder) OutdialUpdateData(ctx, a, id, data any
 }
 
 // OutdialtargetCreate mocks base method.
-func (m *MockServiceHandler) OutdialtargetCreate(ctx context.Context, a *auth.AuthIdentity, outdialID uuid.UUID, name, detail, data string, destination0, destination1, destination2, destination3, destination4 *address.Address) (*outdialtarget.WebhookMessage, error) {
+func (m *MockServiceHandler) OutdialtargetCreate(ctx context.Context, a *auth.AuthIdentity, outdialID uuid.UUID, name, detail, data, timezone string, destination0, destination1, destination2, destination3, destination4 *address.Address) (*outdialtarget.WebhookMessage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "OutdialtargetCreate", ctx, a, outdialID, name, detail, data, destination0, destination1, destination2, destination3, destination4)
+	ret := m.ctrl.Call(m, "OutdialtargetCreate", ctx, a, outdialID, name, detail, data, timezone, destination0, destination1, destination2, destination3, destination4)
 	ret0, _ := ret[0].(*outdialtarget.WebhookMessage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // OutdialtargetCreate indicates an expected call of OutdialtargetCreate.
-func (mr *MockServiceHandlerMockRecorder) OutdialtargetCreate(ctx, a, outdialID, name, detail, data, destination0, destination1, destination2, destination3, destination4 any) *gomock.Call {
+func (mr *MockServiceHandlerMockRecorder) OutdialtargetCreate(ctx, a, outdialID, name, detail, data, timezone, destination0, destination1, destination2, destination3, destination4 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutdialtargetCreate", reflect.TypeOf((*MockServiceHandler)(nil).OutdialtargetCreate), ctx, a, outdialID, name, detail, data, destination0, destination1, destination2, destination3, destination4)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutdialtargetCreate", reflect.TypeOf((*MockServiceHandler)(nil).OutdialtargetCreate), ctx, a, outdialID, name, detail, data, timezone, destination0, destination1, destination2, destination3, destination4)
 }
 
 // OutdialtargetDelete mocks base method.
@@ -3570,6 +4203,66 @@ func (mr *MockServiceHandlerMockRecorder) OutdialtargetGetsByOutdialID(ctx, a, o
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutdialtargetGetsByOutdialID", reflect.TypeOf((*MockServiceHandler)(nil).OutdialtargetGetsByOutdialID), ctx, a, outdialID, size, token)
 }
 
+// OutdialtargetUpdateCallback mocks base method.
+func (m *MockServiceHandler) OutdialtargetUpdateCallback(ctx context.Context, a *auth.AuthIdentity, outdialID, outdialtargetID uuid.UUID, tmCallback *time.Time, agentID uuid.UUID) (*outdialtarget.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OutdialtargetUpdateCallback", ctx, a, outdialID, outdialtargetID, tmCallback, agentID)
+	ret0, _ := ret[0].(*outdialtarget.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OutdialtargetUpdateCallback indicates an expected call of OutdialtargetUpdateCallback.
+func (mr *MockServiceHandlerMockRecorder) OutdialtargetUpdateCallback(ctx, a, outdialID, outdialtargetID, tmCallback, agentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutdialtargetUpdateCallback", reflect.TypeOf((*MockServiceHandler)(nil).OutdialtargetUpdateCallback), ctx, a, outdialID, outdialtargetID, tmCallback, agentID)
+}
+
+// OutdialtargetjobCreate mocks base method.
+func (m *MockServiceHandler) OutdialtargetjobCreate(ctx context.Context, a *auth.AuthIdentity, outdialID uuid.UUID, jobType outdialtargetjob.Type, fileID uuid.UUID, mapping *outdialtargetjob.Mapping) (*outdialtargetjob.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OutdialtargetjobCreate", ctx, a, outdialID, jobType, fileID, mapping)
+	ret0, _ := ret[0].(*outdialtargetjob.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OutdialtargetjobCreate indicates an expected call of OutdialtargetjobCreate.
+func (mr *MockServiceHandlerMockRecorder) OutdialtargetjobCreate(ctx, a, outdialID, jobType, fileID, mapping any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutdialtargetjobCreate", reflect.TypeOf((*MockServiceHandler)(nil).OutdialtargetjobCreate), ctx, a, outdialID, jobType, fileID, mapping)
+}
+
+// OutdialtargetjobGet mocks base method.
+func (m *MockServiceHandler) OutdialtargetjobGet(ctx context.Context, a *auth.AuthIdentity, outdialID, outdialtargetjobID uuid.UUID) (*outdialtargetjob.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OutdialtargetjobGet", ctx, a, outdialID, outdialtargetjobID)
+	ret0, _ := ret[0].(*outdialtargetjob.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OutdialtargetjobGet indicates an expected call of OutdialtargetjobGet.
+func (mr *MockServiceHandlerMockRecorder) OutdialtargetjobGet(ctx, a, outdialID, outdialtargetjobID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutdialtargetjobGet", reflect.TypeOf((*MockServiceHandler)(nil).OutdialtargetjobGet), ctx, a, outdialID, outdialtargetjobID)
+}
+
+// OutdialtargetjobList mocks base method.
+func (m *MockServiceHandler) OutdialtargetjobList(ctx context.Context, a *auth.AuthIdentity, outdialID uuid.UUID, size uint64, token string) ([]*outdialtargetjob.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OutdialtargetjobList", ctx, a, outdialID, size, token)
+	ret0, _ := ret[0].([]*outdialtargetjob.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OutdialtargetjobList indicates an expected call of OutdialtargetjobList.
+func (mr *MockServiceHandlerMockRecorder) OutdialtargetjobList(ctx, a, outdialID, size, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutdialtargetjobList", reflect.TypeOf((*MockServiceHandler)(nil).OutdialtargetjobList), ctx, a, outdialID, size, token)
+}
+
 // OutplanCreate mocks base method.
 func (m *MockServiceHandler) OutplanCreate(ctx context.Context, a *auth.AuthIdentity, name, detail string, source *address.Address, dialTimeout, tryInterval, maxTryCount0, maxTryCount1, maxTryCount2, maxTryCount3, maxTryCount4 int) (*outplan.WebhookMessage, error) {
 	m.ctrl.T.Helper()
@@ -5673,6 +6366,112 @@ func (mr *MockServiceHandlerMockRecorder) StorageFileList(ctx, a, size, token an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StorageFileList", reflect.TypeOf((*MockServiceHandler)(nil).StorageFileList), ctx, a, size, token)
 }
 
+// SuppressionBlockGet mocks base method.
+func (m *MockServiceHandler) SuppressionBlockGet(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*suppressionblock.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuppressionBlockGet", ctx, a, id)
+	ret0, _ := ret[0].(*suppressionblock.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuppressionBlockGet indicates an expected call of SuppressionBlockGet.
+func (mr *MockServiceHandlerMockRecorder) SuppressionBlockGet(ctx, a, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuppressionBlockGet", reflect.TypeOf((*MockServiceHandler)(nil).SuppressionBlockGet), ctx, a, id)
+}
+
+// SuppressionBlockList mocks base method.
+func (m *MockServiceHandler) SuppressionBlockList(ctx context.Context, a *auth.AuthIdentity, size uint64, token string) ([]*suppressionblock.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuppressionBlockList", ctx, a, size, token)
+	ret0, _ := ret[0].([]*suppressionblock.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuppressionBlockList indicates an expected call of SuppressionBlockList.
+func (mr *MockServiceHandlerMockRecorder) SuppressionBlockList(ctx, a, size, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuppressionBlockList", reflect.TypeOf((*MockServiceHandler)(nil).SuppressionBlockList), ctx, a, size, token)
+}
+
+// SuppressionCreate mocks base method.
+func (m *MockServiceHandler) SuppressionCreate(ctx context.Context, a *auth.AuthIdentity, listType suppression.ListType, target, detail string) (*suppression.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuppressionCreate", ctx, a, listType, target, detail)
+	ret0, _ := ret[0].(*suppression.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuppressionCreate indicates an expected call of SuppressionCreate.
+func (mr *MockServiceHandlerMockRecorder) SuppressionCreate(ctx, a, listType, target, detail any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuppressionCreate", reflect.TypeOf((*MockServiceHandler)(nil).SuppressionCreate), ctx, a, listType, target, detail)
+}
+
+// SuppressionDelete mocks base method.
+func (m *MockServiceHandler) SuppressionDelete(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*suppression.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuppressionDelete", ctx, a, id)
+	ret0, _ := ret[0].(*suppression.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuppressionDelete indicates an expected call of SuppressionDelete.
+func (mr *MockServiceHandlerMockRecorder) SuppressionDelete(ctx, a, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuppressionDelete", reflect.TypeOf((*MockServiceHandler)(nil).SuppressionDelete), ctx, a, id)
+}
+
+// SuppressionGet mocks base method.
+func (m *MockServiceHandler) SuppressionGet(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*suppression.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuppressionGet", ctx, a, id)
+	ret0, _ := ret[0].(*suppression.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuppressionGet indicates an expected call of SuppressionGet.
+func (mr *MockServiceHandlerMockRecorder) SuppressionGet(ctx, a, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuppressionGet", reflect.TypeOf((*MockServiceHandler)(nil).SuppressionGet), ctx, a, id)
+}
+
+// SuppressionImport mocks base method.
+func (m *MockServiceHandler) SuppressionImport(ctx context.Context, a *auth.AuthIdentity, listType suppression.ListType, targets []string, detail string) (int, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuppressionImport", ctx, a, listType, targets, detail)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SuppressionImport indicates an expected call of SuppressionImport.
+func (mr *MockServiceHandlerMockRecorder) SuppressionImport(ctx, a, listType, targets, detail any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuppressionImport", reflect.TypeOf((*MockServiceHandler)(nil).SuppressionImport), ctx, a, listType, targets, detail)
+}
+
+// SuppressionList mocks base method.
+func (m *MockServiceHandler) SuppressionList(ctx context.Context, a *auth.AuthIdentity, size uint64, token string) ([]*suppression.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuppressionList", ctx, a, size, token)
+	ret0, _ := ret[0].([]*suppression.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuppressionList indicates an expected call of SuppressionList.
+func (mr *MockServiceHandlerMockRecorder) SuppressionList(ctx, a, size, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuppressionList", reflect.TypeOf((*MockServiceHandler)(nil).SuppressionList), ctx, a, size, token)
+}
+
 // TagCreate mocks base method.
 func (m *MockServiceHandler) TagCreate(ctx context.Context, a *auth.AuthIdentity, name, detail string) (*tag.WebhookMessage, error) {
 	m.ctrl.T.Helper()
@@ -5838,6 +6637,141 @@ func (mr *MockServiceHandlerMockRecorder) TeamUpdate(ctx, a, id, name, detail, s
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TeamUpdate", reflect.TypeOf((*MockServiceHandler)(nil).TeamUpdate), ctx, a, id, name, detail, startMemberID, members, parameter)
 }
 
+// TestRunDelete mocks base method.
+func (m *MockServiceHandler) TestRunDelete(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*testrun.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TestRunDelete", ctx, a, id)
+	ret0, _ := ret[0].(*testrun.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TestRunDelete indicates an expected call of TestRunDelete.
+func (mr *MockServiceHandlerMockRecorder) TestRunDelete(ctx, a, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TestRunDelete", reflect.TypeOf((*MockServiceHandler)(nil).TestRunDelete), ctx, a, id)
+}
+
+// TestRunGet mocks base method.
+func (m *MockServiceHandler) TestRunGet(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*testrun.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TestRunGet", ctx, a, id)
+	ret0, _ := ret[0].(*testrun.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TestRunGet indicates an expected call of TestRunGet.
+func (mr *MockServiceHandlerMockRecorder) TestRunGet(ctx, a, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TestRunGet", reflect.TypeOf((*MockServiceHandler)(nil).TestRunGet), ctx, a, id)
+}
+
+// TestRunGetsByCustomerID mocks base method.
+func (m *MockServiceHandler) TestRunGetsByCustomerID(ctx context.Context, a *auth.AuthIdentity, size uint64, token string, testSuiteID uuid.UUID) ([]*testrun.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TestRunGetsByCustomerID", ctx, a, size, token, testSuiteID)
+	ret0, _ := ret[0].([]*testrun.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TestRunGetsByCustomerID indicates an expected call of TestRunGetsByCustomerID.
+func (mr *MockServiceHandlerMockRecorder) TestRunGetsByCustomerID(ctx, a, size, token, testSuiteID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TestRunGetsByCustomerID", reflect.TypeOf((*MockServiceHandler)(nil).TestRunGetsByCustomerID), ctx, a, size, token, testSuiteID)
+}
+
+// TestSuiteCreate mocks base method.
+func (m *MockServiceHandler) TestSuiteCreate(ctx context.Context, a *auth.AuthIdentity, aiID uuid.UUID, name, detail string, scenarios []testsuite.Scenario) (*testsuite.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TestSuiteCreate", ctx, a, aiID, name, detail, scenarios)
+	ret0, _ := ret[0].(*testsuite.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TestSuiteCreate indicates an expected call of TestSuiteCreate.
+func (mr *MockServiceHandlerMockRecorder) TestSuiteCreate(ctx, a, aiID, name, detail, scenarios any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TestSuiteCreate", reflect.TypeOf((*MockServiceHandler)(nil).TestSuiteCreate), ctx, a, aiID, name, detail, scenarios)
+}
+
+// TestSuiteDelete mocks base method.
+func (m *MockServiceHandler) TestSuiteDelete(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*testsuite.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TestSuiteDelete", ctx, a, id)
+	ret0, _ := ret[0].(*testsuite.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TestSuiteDelete indicates an expected call of TestSuiteDelete.
+func (mr *MockServiceHandlerMockRecorder) TestSuiteDelete(ctx, a, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TestSuiteDelete", reflect.TypeOf((*MockServiceHandler)(nil).TestSuiteDelete), ctx, a, id)
+}
+
+// TestSuiteGet mocks base method.
+func (m *MockServiceHandler) TestSuiteGet(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*testsuite.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TestSuiteGet", ctx, a, id)
+	ret0, _ := ret[0].(*testsuite.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TestSuiteGet indicates an expected call of TestSuiteGet.
+func (mr *MockServiceHandlerMockRecorder) TestSuiteGet(ctx, a, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TestSuiteGet", reflect.TypeOf((*MockServiceHandler)(nil).TestSuiteGet), ctx, a, id)
+}
+
+// TestSuiteGetsByCustomerID mocks base method.
+func (m *MockServiceHandler) TestSuiteGetsByCustomerID(ctx context.Context, a *auth.AuthIdentity, size uint64, token string, aiID uuid.UUID) ([]*testsuite.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TestSuiteGetsByCustomerID", ctx, a, size, token, aiID)
+	ret0, _ := ret[0].([]*testsuite.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TestSuiteGetsByCustomerID indicates an expected call of TestSuiteGetsByCustomerID.
+func (mr *MockServiceHandlerMockRecorder) TestSuiteGetsByCustomerID(ctx, a, size, token, aiID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TestSuiteGetsByCustomerID", reflect.TypeOf((*MockServiceHandler)(nil).TestSuiteGetsByCustomerID), ctx, a, size, token, aiID)
+}
+
+// TestSuiteRun mocks base method.
+func (m *MockServiceHandler) TestSuiteRun(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*testrun.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TestSuiteRun", ctx, a, id)
+	ret0, _ := ret[0].(*testrun.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TestSuiteRun indicates an expected call of TestSuiteRun.
+func (mr *MockServiceHandlerMockRecorder) TestSuiteRun(ctx, a, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TestSuiteRun", reflect.TypeOf((*MockServiceHandler)(nil).TestSuiteRun), ctx, a, id)
+}
+
+// TestSuiteUpdate mocks base method.
+func (m *MockServiceHandler) TestSuiteUpdate(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID, name, detail string, scenarios []testsuite.Scenario) (*testsuite.WebhookMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TestSuiteUpdate", ctx, a, id, name, detail, scenarios)
+	ret0, _ := ret[0].(*testsuite.WebhookMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TestSuiteUpdate indicates an expected call of TestSuiteUpdate.
+func (mr *MockServiceHandlerMockRecorder) TestSuiteUpdate(ctx, a, id, name, detail, scenarios any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TestSuiteUpdate", reflect.TypeOf((*MockServiceHandler)(nil).TestSuiteUpdate), ctx, a, id, name, detail, scenarios)
+}
+
 // TimelineAnalysisCreate mocks base method.
 func (m *MockServiceHandler) TimelineAnalysisCreate(ctx context.Context, a *auth.AuthIdentity, activeflowID uuid.UUID, reanalyze bool) (*analysis.WebhookMessage, error) {
 	m.ctrl.T.Helper()
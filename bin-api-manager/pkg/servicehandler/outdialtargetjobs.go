@@ -0,0 +1,161 @@
+package servicehandler
+
+import (
+	"context"
+	"fmt"
+
+	"monorepo/bin-api-manager/models/auth"
+	"monorepo/bin-api-manager/pkg/serviceerrors"
+
+	omoutdialtargetjob "monorepo/bin-outdial-manager/models/outdialtargetjob"
+
+	amagent "monorepo/bin-agent-manager/models/agent"
+
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// OutdialtargetjobCreate creates a new outdialtargetjob.
+// It returns created outdialtargetjob if it succeed.
+func (h *serviceHandler) OutdialtargetjobCreate(
+	ctx context.Context,
+	a *auth.AuthIdentity,
+	outdialID uuid.UUID,
+	jobType omoutdialtargetjob.Type,
+	fileID uuid.UUID,
+	mapping *omoutdialtargetjob.Mapping,
+) (*omoutdialtargetjob.WebhookMessage, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":        "OutdialtargetjobCreate",
+		"customer_id": a.CustomerID,
+		"username":    a.DisplayName(),
+		"outdial_id":  outdialID,
+		"type":        jobType,
+		"file_id":     fileID,
+	})
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	log.Debug("Executing OutdialtargetjobCreate.")
+
+	// get outdial
+	od, err := h.outdialGet(ctx, outdialID)
+	if err != nil {
+		log.Errorf("Could not get outdial info from the outdial-manager. err: %v", err)
+		return nil, fmt.Errorf("%w: could not get outdial info", err)
+	}
+
+	if !h.hasPermission(ctx, a, od.CustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager) {
+		log.Info("The agent has no permission.")
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	// create
+	tmp, err := h.reqHandler.OutdialV1OutdialtargetjobCreate(ctx, outdialID, jobType, fileID, mapping)
+	if err != nil {
+		log.Errorf("Could not create the outdialtargetjob. err: %v", err)
+		return nil, err
+	}
+
+	res := tmp.ConvertWebhookMessage()
+	return res, nil
+}
+
+// OutdialtargetjobGet gets an outdialtargetjob.
+// It returns outdialtargetjob if it succeed.
+func (h *serviceHandler) OutdialtargetjobGet(ctx context.Context, a *auth.AuthIdentity, outdialID uuid.UUID, outdialtargetjobID uuid.UUID) (*omoutdialtargetjob.WebhookMessage, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":        "OutdialtargetjobGet",
+		"customer_id": a.CustomerID,
+		"username":    a.DisplayName(),
+		"outdial_id":  outdialID,
+	})
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	log.Debug("Executing OutdialtargetjobGet.")
+
+	// get outdial
+	od, err := h.outdialGet(ctx, outdialID)
+	if err != nil {
+		log.Errorf("Could not get outdial info from the outdial-manager. err: %v", err)
+		return nil, fmt.Errorf("%w: could not get outdial info", err)
+	}
+
+	if !h.hasPermission(ctx, a, od.CustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager) {
+		log.Info("The agent has no permission.")
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	// get outdialtargetjob
+	tmp, err := h.reqHandler.OutdialV1OutdialtargetjobGet(ctx, outdialtargetjobID)
+	if err != nil {
+		log.Errorf("Could not get outdialtargetjob info from the outdial-manager. err: %v", err)
+		return nil, fmt.Errorf("%w: could not find outdialtargetjob info", err)
+	}
+
+	// check the outdial_id
+	if tmp.OutdialID != outdialID {
+		log.Errorf("The outdial_id is wrong. outdial_id: %s", tmp.OutdialID)
+		return nil, fmt.Errorf("%w: wrong outdial_id. outdial_id: %s", serviceerrors.ErrInvalidArgument, tmp.OutdialID)
+	}
+
+	res := tmp.ConvertWebhookMessage()
+	return res, nil
+}
+
+// OutdialtargetjobList gets the list of outdialtargetjobs of the given outdial id.
+// It returns list of outdialtargetjobs if it succeed.
+func (h *serviceHandler) OutdialtargetjobList(ctx context.Context, a *auth.AuthIdentity, outdialID uuid.UUID, size uint64, token string) ([]*omoutdialtargetjob.WebhookMessage, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":        "OutdialtargetjobList",
+		"customer_id": a.CustomerID,
+		"username":    a.DisplayName(),
+		"outdial_id":  outdialID,
+		"size":        size,
+		"token":       token,
+	})
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	log.Debug("Getting outdialtargetjobs.")
+
+	if token == "" {
+		token = h.utilHandler.TimeGetCurTime()
+	}
+
+	// get outdial
+	od, err := h.outdialGet(ctx, outdialID)
+	if err != nil {
+		log.Errorf("Could not get outdial info from the outdial-manager. err: %v", err)
+		return nil, fmt.Errorf("%w: could not get outdial info", err)
+	}
+
+	if !h.hasPermission(ctx, a, od.CustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager) {
+		log.Info("The agent has no permission.")
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	// get jobs
+	filters := map[omoutdialtargetjob.Field]any{
+		omoutdialtargetjob.FieldOutdialID: outdialID,
+		omoutdialtargetjob.FieldDeleted:   false,
+	}
+	jobs, err := h.reqHandler.OutdialV1OutdialtargetjobList(ctx, token, size, filters)
+	if err != nil {
+		log.Errorf("Could not get outdialtargetjobs info from the outdial-manager. err: %v", err)
+		return nil, fmt.Errorf("%w: could not find outdialtargetjobs info", err)
+	}
+
+	// create result
+	res := []*omoutdialtargetjob.WebhookMessage{}
+	for _, j := range jobs {
+		tmp := j.ConvertWebhookMessage()
+		res = append(res, tmp)
+	}
+
+	return res, nil
+}
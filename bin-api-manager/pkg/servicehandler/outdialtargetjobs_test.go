@@ -0,0 +1,324 @@
+package servicehandler
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/requesthandler"
+
+	omoutdial "monorepo/bin-outdial-manager/models/outdial"
+	omoutdialtargetjob "monorepo/bin-outdial-manager/models/outdialtargetjob"
+
+	amagent "monorepo/bin-agent-manager/models/agent"
+
+	"monorepo/bin-api-manager/models/auth"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+
+	"monorepo/bin-api-manager/pkg/dbhandler"
+)
+
+func Test_OutdialtargetjobCreate(t *testing.T) {
+
+	tests := []struct {
+		name      string
+		agent     *auth.AuthIdentity
+		outdialID uuid.UUID
+		jobType   omoutdialtargetjob.Type
+		fileID    uuid.UUID
+		mapping   *omoutdialtargetjob.Mapping
+
+		responseOutdial *omoutdial.Outdial
+		response        *omoutdialtargetjob.OutdialTargetJob
+		expectRes       *omoutdialtargetjob.WebhookMessage
+	}{
+		{
+			name: "import",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("b3a1c2d4-2d60-11f1-8a01-1b2c3d4e5f01"),
+					CustomerID: uuid.FromStringOrNil("b3cf3e50-2d60-11f1-9b12-2c3d4e5f6a02"),
+				},
+				Permission: amagent.PermissionCustomerAdmin,
+			}),
+			outdialID: uuid.FromStringOrNil("b3fc5f6c-2d60-11f1-ac23-3d4e5f6a7b03"),
+			jobType:   omoutdialtargetjob.TypeImport,
+			fileID:    uuid.FromStringOrNil("b4298088-2d60-11f1-bd34-4e5f6a7b8c04"),
+			mapping: &omoutdialtargetjob.Mapping{
+				Destination0: "phone",
+			},
+
+			responseOutdial: &omoutdial.Outdial{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("b3fc5f6c-2d60-11f1-ac23-3d4e5f6a7b03"),
+					CustomerID: uuid.FromStringOrNil("b3cf3e50-2d60-11f1-9b12-2c3d4e5f6a02"),
+				},
+			},
+			response: &omoutdialtargetjob.OutdialTargetJob{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("b456a1a4-2d60-11f1-8e45-5f6a7b8c9d05"),
+				},
+				OutdialID: uuid.FromStringOrNil("b3fc5f6c-2d60-11f1-ac23-3d4e5f6a7b03"),
+				Type:      omoutdialtargetjob.TypeImport,
+				Status:    omoutdialtargetjob.StatusProcessing,
+			},
+			expectRes: &omoutdialtargetjob.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("b456a1a4-2d60-11f1-8e45-5f6a7b8c9d05"),
+				},
+				OutdialID: uuid.FromStringOrNil("b3fc5f6c-2d60-11f1-ac23-3d4e5f6a7b03"),
+				Type:      omoutdialtargetjob.TypeImport,
+				Status:    omoutdialtargetjob.StatusProcessing,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+
+			h := &serviceHandler{
+				reqHandler: mockReq,
+				dbHandler:  mockDB,
+			}
+			ctx := context.Background()
+
+			mockReq.EXPECT().OutdialV1OutdialGet(ctx, tt.outdialID).Return(tt.responseOutdial, nil)
+			mockReq.EXPECT().OutdialV1OutdialtargetjobCreate(ctx, tt.outdialID, tt.jobType, tt.fileID, tt.mapping).Return(tt.response, nil)
+
+			res, err := h.OutdialtargetjobCreate(ctx, tt.agent, tt.outdialID, tt.jobType, tt.fileID, tt.mapping)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_OutdialtargetjobCreate_error(t *testing.T) {
+
+	tests := []struct {
+		name      string
+		agent     *auth.AuthIdentity
+		outdialID uuid.UUID
+
+		responseOutdial *omoutdial.Outdial
+	}{
+		{
+			name: "agent of the other customer",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("c5a1b2c3-2d60-11f1-9f56-6a7b8c9d0e01"),
+					CustomerID: uuid.FromStringOrNil("c5ce3f2a-2d60-11f1-a067-7b8c9d0e1f02"),
+				},
+				Permission: amagent.PermissionCustomerAdmin,
+			}),
+			outdialID: uuid.FromStringOrNil("c5fb5f46-2d60-11f1-b178-8c9d0e1f2a03"),
+
+			responseOutdial: &omoutdial.Outdial{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("c5fb5f46-2d60-11f1-b178-8c9d0e1f2a03"),
+					CustomerID: uuid.FromStringOrNil("c6288062-2d60-11f1-8289-9d0e1f2a3b04"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+
+			h := &serviceHandler{
+				reqHandler: mockReq,
+				dbHandler:  mockDB,
+			}
+			ctx := context.Background()
+
+			mockReq.EXPECT().OutdialV1OutdialGet(ctx, tt.outdialID).Return(tt.responseOutdial, nil)
+
+			_, err := h.OutdialtargetjobCreate(ctx, tt.agent, tt.outdialID, omoutdialtargetjob.TypeExport, uuid.Nil, nil)
+			if err == nil {
+				t.Errorf("Wrong match. expect: error, got: ok")
+			}
+		})
+	}
+}
+
+func Test_OutdialtargetjobGet(t *testing.T) {
+
+	tests := []struct {
+		name               string
+		agent              *auth.AuthIdentity
+		outdialID          uuid.UUID
+		outdialtargetjobID uuid.UUID
+
+		responseOutdial *omoutdial.Outdial
+		response        *omoutdialtargetjob.OutdialTargetJob
+		expectRes       *omoutdialtargetjob.WebhookMessage
+	}{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("d7a1b2c3-2d60-11f1-939a-0e1f2a3b4c01"),
+					CustomerID: uuid.FromStringOrNil("d7ce3f2a-2d60-11f1-a4ab-1f2a3b4c5d02"),
+				},
+				Permission: amagent.PermissionCustomerAdmin,
+			}),
+			outdialID:          uuid.FromStringOrNil("d7fb5f46-2d60-11f1-b5bc-2a3b4c5d6e03"),
+			outdialtargetjobID: uuid.FromStringOrNil("d8288062-2d60-11f1-86cd-3b4c5d6e7f04"),
+
+			responseOutdial: &omoutdial.Outdial{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("d7fb5f46-2d60-11f1-b5bc-2a3b4c5d6e03"),
+					CustomerID: uuid.FromStringOrNil("d7ce3f2a-2d60-11f1-a4ab-1f2a3b4c5d02"),
+				},
+			},
+			response: &omoutdialtargetjob.OutdialTargetJob{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("d8288062-2d60-11f1-86cd-3b4c5d6e7f04"),
+				},
+				OutdialID:   uuid.FromStringOrNil("d7fb5f46-2d60-11f1-b5bc-2a3b4c5d6e03"),
+				Status:      omoutdialtargetjob.StatusDone,
+				ErrorCount:  2,
+				ErrorFileID: uuid.FromStringOrNil("d855a17e-2d60-11f1-97de-4c5d6e7f8a05"),
+			},
+			expectRes: &omoutdialtargetjob.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("d8288062-2d60-11f1-86cd-3b4c5d6e7f04"),
+				},
+				OutdialID:   uuid.FromStringOrNil("d7fb5f46-2d60-11f1-b5bc-2a3b4c5d6e03"),
+				Status:      omoutdialtargetjob.StatusDone,
+				ErrorCount:  2,
+				ErrorFileID: uuid.FromStringOrNil("d855a17e-2d60-11f1-97de-4c5d6e7f8a05"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+
+			h := &serviceHandler{
+				reqHandler: mockReq,
+				dbHandler:  mockDB,
+			}
+			ctx := context.Background()
+
+			mockReq.EXPECT().OutdialV1OutdialGet(ctx, tt.outdialID).Return(tt.responseOutdial, nil)
+			mockReq.EXPECT().OutdialV1OutdialtargetjobGet(ctx, tt.outdialtargetjobID).Return(tt.response, nil)
+
+			res, err := h.OutdialtargetjobGet(ctx, tt.agent, tt.outdialID, tt.outdialtargetjobID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_OutdialtargetjobList(t *testing.T) {
+
+	tests := []struct {
+		name      string
+		agent     *auth.AuthIdentity
+		outdialID uuid.UUID
+		pageToken string
+		pageSize  uint64
+
+		responseOutdial *omoutdial.Outdial
+		response        []omoutdialtargetjob.OutdialTargetJob
+
+		expectFilters map[omoutdialtargetjob.Field]any
+		expectRes     []*omoutdialtargetjob.WebhookMessage
+	}{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("e9a1b2c3-2d60-11f1-a8ef-5d6e7f8a9b01"),
+					CustomerID: uuid.FromStringOrNil("e9ce3f2a-2d60-11f1-b9f0-6e7f8a9b0c02"),
+				},
+				Permission: amagent.PermissionCustomerAdmin,
+			}),
+			outdialID: uuid.FromStringOrNil("e9fb5f46-2d60-11f1-8a01-7f8a9b0c1d03"),
+			pageToken: "2021-03-01T01:00:00.995000Z",
+			pageSize:  10,
+
+			responseOutdial: &omoutdial.Outdial{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("e9fb5f46-2d60-11f1-8a01-7f8a9b0c1d03"),
+					CustomerID: uuid.FromStringOrNil("e9ce3f2a-2d60-11f1-b9f0-6e7f8a9b0c02"),
+				},
+			},
+			response: []omoutdialtargetjob.OutdialTargetJob{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("ea288062-2d60-11f1-9b12-8a9b0c1d2e04"),
+					},
+				},
+			},
+
+			expectFilters: map[omoutdialtargetjob.Field]any{
+				omoutdialtargetjob.FieldOutdialID: uuid.FromStringOrNil("e9fb5f46-2d60-11f1-8a01-7f8a9b0c1d03"),
+				omoutdialtargetjob.FieldDeleted:   false,
+			},
+			expectRes: []*omoutdialtargetjob.WebhookMessage{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("ea288062-2d60-11f1-9b12-8a9b0c1d2e04"),
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+
+			h := &serviceHandler{
+				reqHandler: mockReq,
+				dbHandler:  mockDB,
+			}
+			ctx := context.Background()
+
+			mockReq.EXPECT().OutdialV1OutdialGet(ctx, tt.outdialID).Return(tt.responseOutdial, nil)
+			mockReq.EXPECT().OutdialV1OutdialtargetjobList(ctx, tt.pageToken, tt.pageSize, tt.expectFilters).Return(tt.response, nil)
+
+			res, err := h.OutdialtargetjobList(ctx, tt.agent, tt.outdialID, tt.pageSize, tt.pageToken)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package servicehandler
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"monorepo/bin-api-manager/models/auth"
 	"monorepo/bin-api-manager/pkg/serviceerrors"
@@ -25,6 +26,7 @@ func (h *serviceHandler) OutdialtargetCreate(
 	name string,
 	detail string,
 	data string,
+	timezone string,
 	destination0 *commonaddress.Address,
 	destination1 *commonaddress.Address,
 	destination2 *commonaddress.Address,
@@ -63,6 +65,7 @@ func (h *serviceHandler) OutdialtargetCreate(
 		name,
 		detail,
 		data,
+		timezone,
 		destination0,
 		destination1,
 		destination2,
@@ -221,3 +224,79 @@ func (h *serviceHandler) OutdialtargetDelete(ctx context.Context, a *auth.AuthId
 	res := tmp.ConvertWebhookMessage()
 	return res, nil
 }
+
+// OutdialtargetUpdateCallback schedules the outdialtarget's callback.
+// The outdialtarget is not dialed by the campaign until the callback time.
+// It returns updated outdialtarget if it succeed.
+func (h *serviceHandler) OutdialtargetUpdateCallback(ctx context.Context, a *auth.AuthIdentity, outdialID uuid.UUID, outdialtargetID uuid.UUID, tmCallback *time.Time, agentID uuid.UUID) (*omoutdialtarget.WebhookMessage, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":             "OutdialtargetUpdateCallback",
+		"customer_id":      a.CustomerID,
+		"username":         a.DisplayName(),
+		"outdial_id":       outdialID,
+		"outdialtarget_id": outdialtargetID,
+	})
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	log.Debug("Executing OutdialtargetUpdateCallback.")
+
+	// get outdial
+	od, err := h.outdialGet(ctx, outdialID)
+	if err != nil {
+		log.Errorf("Could not get outdial info from the outdial-manager. err: %v", err)
+		return nil, fmt.Errorf("%w: could not get outdial info", err)
+	}
+
+	if !h.hasPermission(ctx, a, od.CustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager) {
+		log.Info("The agent has no permission.")
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	// get outdialtarget
+	t, err := h.reqHandler.OutdialV1OutdialtargetGet(ctx, outdialtargetID)
+	if err != nil {
+		log.Errorf("Could not get outdialtarget info from the outdial-manager. err: %v", err)
+		return nil, fmt.Errorf("%w: could not find outdialtarget info", err)
+	}
+
+	// check the outdial_id
+	if t.OutdialID != outdialID {
+		log.Errorf("The outdial_id is wrong. outdial_id: %s", t.OutdialID)
+		return nil, fmt.Errorf("%w: wrong outdial_id. outdial_id: %s", serviceerrors.ErrInvalidArgument, t.OutdialID)
+	}
+
+	if errValidate := h.outdialtargetValidateCallbackAgent(ctx, od.CustomerID, agentID); errValidate != nil {
+		log.Errorf("Could not validate the callback agent. err: %v", errValidate)
+		return nil, errValidate
+	}
+
+	tmp, err := h.reqHandler.OutdialV1OutdialtargetUpdateCallback(ctx, outdialtargetID, tmCallback, agentID)
+	if err != nil {
+		log.Errorf("Could not update the outdialtarget's callback. err: %v", err)
+		return nil, err
+	}
+
+	res := tmp.ConvertWebhookMessage()
+	return res, nil
+}
+
+// outdialtargetValidateCallbackAgent returns error if the given callback agent does not belong to the customer.
+// Empty agent id is valid, which means any agent can handle the callback.
+func (h *serviceHandler) outdialtargetValidateCallbackAgent(ctx context.Context, customerID uuid.UUID, agentID uuid.UUID) error {
+	if agentID == uuid.Nil {
+		return nil
+	}
+
+	ag, err := h.agentGet(ctx, agentID)
+	if err != nil {
+		return fmt.Errorf("%w: could not find the agent info", serviceerrors.ErrInvalidArgument)
+	}
+
+	if ag.CustomerID != customerID {
+		return fmt.Errorf("%w: the agent belongs to the other customer", serviceerrors.ErrInvalidArgument)
+	}
+
+	return nil
+}
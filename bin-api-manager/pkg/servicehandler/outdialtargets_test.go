@@ -4,6 +4,7 @@ import (
 	"context"
 	"reflect"
 	"testing"
+	"time"
 
 	commonaddress "monorepo/bin-common-handler/models/address"
 	commonidentity "monorepo/bin-common-handler/models/identity"
@@ -33,6 +34,7 @@ func Test_OutdialtargetCreate(t *testing.T) {
 		outdialtargetName string
 		detail            string
 		data              string
+		timezone          string
 
 		destination0 *commonaddress.Address
 		destination1 *commonaddress.Address
@@ -58,6 +60,7 @@ func Test_OutdialtargetCreate(t *testing.T) {
 			"test name",
 			"test detail",
 			"test data",
+			"America/New_York",
 
 			&commonaddress.Address{
 				Type:   commonaddress.TypeTel,
@@ -107,6 +110,7 @@ func Test_OutdialtargetCreate(t *testing.T) {
 			"test name",
 			"test detail",
 			"test data",
+			"",
 
 			&commonaddress.Address{
 				Type:   commonaddress.TypeTel,
@@ -147,8 +151,8 @@ func Test_OutdialtargetCreate(t *testing.T) {
 			ctx := context.Background()
 
 			mockReq.EXPECT().OutdialV1OutdialGet(ctx, tt.outdialID).Return(tt.responseOutdial, nil)
-			mockReq.EXPECT().OutdialV1OutdialtargetCreate(ctx, tt.outdialID, tt.outdialtargetName, tt.detail, tt.data, tt.destination0, tt.destination1, tt.destination2, tt.destination3, tt.destination4).Return(tt.response, nil)
-			res, err := h.OutdialtargetCreate(ctx, tt.agent, tt.outdialID, tt.outdialtargetName, tt.detail, tt.data, tt.destination0, tt.destination1, tt.destination2, tt.destination3, tt.destination4)
+			mockReq.EXPECT().OutdialV1OutdialtargetCreate(ctx, tt.outdialID, tt.outdialtargetName, tt.detail, tt.data, tt.timezone, tt.destination0, tt.destination1, tt.destination2, tt.destination3, tt.destination4).Return(tt.response, nil)
+			res, err := h.OutdialtargetCreate(ctx, tt.agent, tt.outdialID, tt.outdialtargetName, tt.detail, tt.data, tt.timezone, tt.destination0, tt.destination1, tt.destination2, tt.destination3, tt.destination4)
 			if err != nil {
 				t.Errorf("Wrong match. expect: ok, got: %v", err)
 			}
@@ -381,3 +385,156 @@ func Test_OutdialtargetDelete(t *testing.T) {
 		})
 	}
 }
+
+func Test_OutdialtargetUpdateCallback(t *testing.T) {
+
+	tests := []struct {
+		name            string
+		agent           *auth.AuthIdentity
+		outdialID       uuid.UUID
+		outdialtargetID uuid.UUID
+		tmCallback      *time.Time
+		agentID         uuid.UUID
+
+		responseOutdial *omoutdial.Outdial
+		responseTarget  *omoutdialtarget.OutdialTarget
+		responseAgent   *amagent.Agent
+		expectRes       *omoutdialtarget.WebhookMessage
+	}{
+		{
+			"normal",
+			auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("d152e69e-105b-11ee-b395-eb18426de979"),
+					CustomerID: uuid.FromStringOrNil("5f621078-8e5f-11ee-97b2-cfe7337b701c"),
+				},
+				Permission: amagent.PermissionCustomerAdmin,
+			}),
+			uuid.FromStringOrNil("2a4d6f80-4fa4-11f1-8b1c-3d4e5f6a7b8c"),
+			uuid.FromStringOrNil("2a8e70b2-4fa4-11f1-9c2d-4e5f6a7b8c9d"),
+			timePtr("2026-10-22T15:00:00.000000Z"),
+			uuid.FromStringOrNil("2ac381e4-4fa4-11f1-ad3e-5f6a7b8c9d0e"),
+
+			&omoutdial.Outdial{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("2a4d6f80-4fa4-11f1-8b1c-3d4e5f6a7b8c"),
+					CustomerID: uuid.FromStringOrNil("5f621078-8e5f-11ee-97b2-cfe7337b701c"),
+				},
+			},
+			&omoutdialtarget.OutdialTarget{
+				ID:        uuid.FromStringOrNil("2a8e70b2-4fa4-11f1-9c2d-4e5f6a7b8c9d"),
+				OutdialID: uuid.FromStringOrNil("2a4d6f80-4fa4-11f1-8b1c-3d4e5f6a7b8c"),
+			},
+			&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("2ac381e4-4fa4-11f1-ad3e-5f6a7b8c9d0e"),
+					CustomerID: uuid.FromStringOrNil("5f621078-8e5f-11ee-97b2-cfe7337b701c"),
+				},
+			},
+			&omoutdialtarget.WebhookMessage{
+				ID:        uuid.FromStringOrNil("2a8e70b2-4fa4-11f1-9c2d-4e5f6a7b8c9d"),
+				OutdialID: uuid.FromStringOrNil("2a4d6f80-4fa4-11f1-8b1c-3d4e5f6a7b8c"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+
+			h := &serviceHandler{
+				reqHandler: mockReq,
+				dbHandler:  mockDB,
+			}
+			ctx := context.Background()
+
+			mockReq.EXPECT().OutdialV1OutdialGet(ctx, tt.outdialID).Return(tt.responseOutdial, nil)
+			mockReq.EXPECT().OutdialV1OutdialtargetGet(ctx, tt.outdialtargetID).Return(tt.responseTarget, nil)
+			mockReq.EXPECT().AgentV1AgentGet(ctx, tt.agentID).Return(tt.responseAgent, nil)
+			mockReq.EXPECT().OutdialV1OutdialtargetUpdateCallback(ctx, tt.outdialtargetID, tt.tmCallback, tt.agentID).Return(tt.responseTarget, nil)
+			res, err := h.OutdialtargetUpdateCallback(ctx, tt.agent, tt.outdialID, tt.outdialtargetID, tt.tmCallback, tt.agentID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(*res, *tt.expectRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v\n", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_OutdialtargetUpdateCallback_error(t *testing.T) {
+
+	tests := []struct {
+		name            string
+		agent           *auth.AuthIdentity
+		outdialID       uuid.UUID
+		outdialtargetID uuid.UUID
+		tmCallback      *time.Time
+		agentID         uuid.UUID
+
+		responseOutdial *omoutdial.Outdial
+		responseTarget  *omoutdialtarget.OutdialTarget
+		responseAgent   *amagent.Agent
+	}{
+		{
+			"callback agent belongs to the other customer",
+			auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("d152e69e-105b-11ee-b395-eb18426de979"),
+					CustomerID: uuid.FromStringOrNil("5f621078-8e5f-11ee-97b2-cfe7337b701c"),
+				},
+				Permission: amagent.PermissionCustomerAdmin,
+			}),
+			uuid.FromStringOrNil("5b1e3d50-4fa4-11f1-be4f-6a7b8c9d0e1f"),
+			uuid.FromStringOrNil("5b534e82-4fa4-11f1-8f50-7b8c9d0e1f2a"),
+			timePtr("2026-10-22T15:00:00.000000Z"),
+			uuid.FromStringOrNil("5b885fb4-4fa4-11f1-9061-8c9d0e1f2a3b"),
+
+			&omoutdial.Outdial{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("5b1e3d50-4fa4-11f1-be4f-6a7b8c9d0e1f"),
+					CustomerID: uuid.FromStringOrNil("5f621078-8e5f-11ee-97b2-cfe7337b701c"),
+				},
+			},
+			&omoutdialtarget.OutdialTarget{
+				ID:        uuid.FromStringOrNil("5b534e82-4fa4-11f1-8f50-7b8c9d0e1f2a"),
+				OutdialID: uuid.FromStringOrNil("5b1e3d50-4fa4-11f1-be4f-6a7b8c9d0e1f"),
+			},
+			&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("5b885fb4-4fa4-11f1-9061-8c9d0e1f2a3b"),
+					CustomerID: uuid.FromStringOrNil("5bbd70e6-4fa4-11f1-a172-9d0e1f2a3b4c"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+
+			h := &serviceHandler{
+				reqHandler: mockReq,
+				dbHandler:  mockDB,
+			}
+			ctx := context.Background()
+
+			mockReq.EXPECT().OutdialV1OutdialGet(ctx, tt.outdialID).Return(tt.responseOutdial, nil)
+			mockReq.EXPECT().OutdialV1OutdialtargetGet(ctx, tt.outdialtargetID).Return(tt.responseTarget, nil)
+			mockReq.EXPECT().AgentV1AgentGet(ctx, tt.agentID).Return(tt.responseAgent, nil)
+			if _, err := h.OutdialtargetUpdateCallback(ctx, tt.agent, tt.outdialID, tt.outdialtargetID, tt.tmCallback, tt.agentID); err == nil {
+				t.Errorf("Wrong match. expect: error, got: ok")
+			}
+		})
+	}
+}
@@ -0,0 +1,259 @@
+package servicehandler
+
+import (
+	"context"
+	"fmt"
+
+	"monorepo/bin-api-manager/models/auth"
+	"monorepo/bin-api-manager/pkg/serviceerrors"
+	omsuppression "monorepo/bin-outdial-manager/models/suppression"
+	omsuppressionblock "monorepo/bin-outdial-manager/models/suppressionblock"
+
+	amagent "monorepo/bin-agent-manager/models/agent"
+
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// suppressionGet returns the suppression info.
+func (h *serviceHandler) suppressionGet(ctx context.Context, id uuid.UUID) (*omsuppression.Suppression, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":           "suppressionGet",
+		"suppression_id": id,
+	})
+
+	// send request
+	res, err := h.reqHandler.OutdialV1SuppressionGet(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get the suppression info. err: %v", err)
+		return nil, err
+	}
+	log.WithField("suppression", res).Debug("Received result.")
+
+	return res, nil
+}
+
+// SuppressionCreate adds the target to the customer's suppression list.
+func (h *serviceHandler) SuppressionCreate(ctx context.Context, a *auth.AuthIdentity, listType omsuppression.ListType, target string, detail string) (*omsuppression.WebhookMessage, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":        "SuppressionCreate",
+		"customer_id": a.CustomerID,
+		"list_type":   listType,
+		"target":      target,
+	})
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	log.Debug("Creating a new suppression.")
+
+	if !h.hasPermission(ctx, a, a.CustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager) {
+		log.Info("The agent has no permission.")
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	tmp, err := h.reqHandler.OutdialV1SuppressionCreate(ctx, a.CustomerID, listType, target, detail)
+	if err != nil {
+		log.Errorf("Could not create a new suppression. err: %v", err)
+		return nil, err
+	}
+
+	res := tmp.ConvertWebhookMessage()
+	return res, nil
+}
+
+// SuppressionImport adds the targets to the customer's suppression list in bulk.
+// It returns the number of the imported and skipped targets.
+func (h *serviceHandler) SuppressionImport(ctx context.Context, a *auth.AuthIdentity, listType omsuppression.ListType, targets []string, detail string) (int, int, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":        "SuppressionImport",
+		"customer_id": a.CustomerID,
+		"list_type":   listType,
+		"targets":     len(targets),
+	})
+	if a.IsDirect() {
+		return 0, 0, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	log.Debug("Importing suppressions.")
+
+	if !h.hasPermission(ctx, a, a.CustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager) {
+		log.Info("The agent has no permission.")
+		return 0, 0, serviceerrors.ErrPermissionDenied
+	}
+
+	imported, skipped, err := h.reqHandler.OutdialV1SuppressionImport(ctx, a.CustomerID, listType, targets, detail)
+	if err != nil {
+		log.Errorf("Could not import the suppressions. err: %v", err)
+		return 0, 0, err
+	}
+
+	return imported, skipped, nil
+}
+
+// SuppressionList returns the list of the customer's suppressions.
+func (h *serviceHandler) SuppressionList(ctx context.Context, a *auth.AuthIdentity, size uint64, token string) ([]*omsuppression.WebhookMessage, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":        "SuppressionList",
+		"customer_id": a.CustomerID,
+		"username":    a.DisplayName(),
+		"size":        size,
+		"token":       token,
+	})
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	if token == "" {
+		token = h.utilHandler.TimeGetCurTime()
+	}
+
+	if !h.hasPermission(ctx, a, a.CustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager) {
+		log.Info("The agent has no permission.")
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	filters := map[omsuppression.Field]any{
+		omsuppression.FieldCustomerID: a.CustomerID,
+		omsuppression.FieldDeleted:    false,
+	}
+	tmps, err := h.reqHandler.OutdialV1SuppressionList(ctx, token, size, filters)
+	if err != nil {
+		log.Errorf("Could not get suppressions info from the outdial-manager. err: %v", err)
+		return nil, fmt.Errorf("%w: could not find suppressions info", err)
+	}
+
+	res := []*omsuppression.WebhookMessage{}
+	for _, tmp := range tmps {
+		res = append(res, tmp.ConvertWebhookMessage())
+	}
+
+	return res, nil
+}
+
+// SuppressionGet returns the suppression of the given id.
+func (h *serviceHandler) SuppressionGet(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*omsuppression.WebhookMessage, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":           "SuppressionGet",
+		"customer_id":    a.CustomerID,
+		"username":       a.DisplayName(),
+		"suppression_id": id,
+	})
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	tmp, err := h.suppressionGet(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get suppression info from the outdial-manager. err: %v", err)
+		return nil, fmt.Errorf("%w: could not get suppression info", err)
+	}
+
+	if !h.hasPermission(ctx, a, tmp.CustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager) {
+		log.Info("The agent has no permission.")
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	res := tmp.ConvertWebhookMessage()
+	return res, nil
+}
+
+// SuppressionDelete removes the suppression of the given id.
+func (h *serviceHandler) SuppressionDelete(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*omsuppression.WebhookMessage, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":           "SuppressionDelete",
+		"customer_id":    a.CustomerID,
+		"username":       a.DisplayName(),
+		"suppression_id": id,
+	})
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	s, err := h.suppressionGet(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get suppression info from the outdial-manager. err: %v", err)
+		return nil, fmt.Errorf("%w: could not get suppression info", err)
+	}
+
+	if !h.hasPermission(ctx, a, s.CustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager) {
+		log.Info("The agent has no permission.")
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	tmp, err := h.reqHandler.OutdialV1SuppressionDelete(ctx, id)
+	if err != nil {
+		log.Errorf("Could not delete the suppression. err: %v", err)
+		return nil, err
+	}
+
+	res := tmp.ConvertWebhookMessage()
+	return res, nil
+}
+
+// SuppressionBlockList returns the list of the customer's outbound attempts blocked by the suppression lists.
+func (h *serviceHandler) SuppressionBlockList(ctx context.Context, a *auth.AuthIdentity, size uint64, token string) ([]*omsuppressionblock.WebhookMessage, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":        "SuppressionBlockList",
+		"customer_id": a.CustomerID,
+		"username":    a.DisplayName(),
+		"size":        size,
+		"token":       token,
+	})
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	if token == "" {
+		token = h.utilHandler.TimeGetCurTime()
+	}
+
+	if !h.hasPermission(ctx, a, a.CustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager) {
+		log.Info("The agent has no permission.")
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	filters := map[omsuppressionblock.Field]any{
+		omsuppressionblock.FieldCustomerID: a.CustomerID,
+		omsuppressionblock.FieldDeleted:    false,
+	}
+	tmps, err := h.reqHandler.OutdialV1SuppressionblockList(ctx, token, size, filters)
+	if err != nil {
+		log.Errorf("Could not get suppression blocks info from the outdial-manager. err: %v", err)
+		return nil, fmt.Errorf("%w: could not find suppression blocks info", err)
+	}
+
+	res := []*omsuppressionblock.WebhookMessage{}
+	for _, tmp := range tmps {
+		res = append(res, tmp.ConvertWebhookMessage())
+	}
+
+	return res, nil
+}
+
+// SuppressionBlockGet returns the suppression block of the given id.
+func (h *serviceHandler) SuppressionBlockGet(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*omsuppressionblock.WebhookMessage, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":                "SuppressionBlockGet",
+		"customer_id":         a.CustomerID,
+		"username":            a.DisplayName(),
+		"suppressionblock_id": id,
+	})
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	tmp, err := h.reqHandler.OutdialV1SuppressionblockGet(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get suppression block info from the outdial-manager. err: %v", err)
+		return nil, fmt.Errorf("%w: could not get suppression block info", err)
+	}
+
+	if !h.hasPermission(ctx, a, tmp.CustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager) {
+		log.Info("The agent has no permission.")
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	res := tmp.ConvertWebhookMessage()
+	return res, nil
+}
@@ -0,0 +1,512 @@
+package servicehandler
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/requesthandler"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+
+	omsuppression "monorepo/bin-outdial-manager/models/suppression"
+	omsuppressionblock "monorepo/bin-outdial-manager/models/suppressionblock"
+
+	amagent "monorepo/bin-agent-manager/models/agent"
+
+	"monorepo/bin-api-manager/models/auth"
+	"monorepo/bin-api-manager/pkg/serviceerrors"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_SuppressionCreate(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		agent    *auth.AuthIdentity
+		listType omsuppression.ListType
+		target   string
+		detail   string
+
+		response  *omsuppression.Suppression
+		expectRes *omsuppression.WebhookMessage
+	}{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("0d5e3c1a-3c2a-11f1-9b1e-2f6c8d4a1e01"),
+					CustomerID: uuid.FromStringOrNil("0d8a4f2c-3c2a-11f1-8c2f-5a7d9e1b2f02"),
+				},
+				Permission: amagent.PermissionCustomerAdmin,
+			}),
+			listType: omsuppression.ListTypeDNC,
+			target:   "+821100000001",
+			detail:   "test detail",
+
+			response: &omsuppression.Suppression{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("0db5e0d2-3c2a-11f1-a3d4-1c8e2f6a3a03"),
+					CustomerID: uuid.FromStringOrNil("0d8a4f2c-3c2a-11f1-8c2f-5a7d9e1b2f02"),
+				},
+				ListType: omsuppression.ListTypeDNC,
+				Target:   "+821100000001",
+				Detail:   "test detail",
+			},
+			expectRes: &omsuppression.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("0db5e0d2-3c2a-11f1-a3d4-1c8e2f6a3a03"),
+					CustomerID: uuid.FromStringOrNil("0d8a4f2c-3c2a-11f1-8c2f-5a7d9e1b2f02"),
+				},
+				ListType: omsuppression.ListTypeDNC,
+				Target:   "+821100000001",
+				Detail:   "test detail",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			h := &serviceHandler{
+				reqHandler: mockReq,
+			}
+			ctx := context.Background()
+
+			mockReq.EXPECT().OutdialV1SuppressionCreate(ctx, tt.agent.CustomerID, tt.listType, tt.target, tt.detail).Return(tt.response, nil)
+
+			res, err := h.SuppressionCreate(ctx, tt.agent, tt.listType, tt.target, tt.detail)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_SuppressionImport(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		agent    *auth.AuthIdentity
+		listType omsuppression.ListType
+		targets  []string
+		detail   string
+
+		responseImported int
+		responseSkipped  int
+	}{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("0d5e3c1a-3c2a-11f1-9b1e-2f6c8d4a1e01"),
+					CustomerID: uuid.FromStringOrNil("0d8a4f2c-3c2a-11f1-8c2f-5a7d9e1b2f02"),
+				},
+				Permission: amagent.PermissionCustomerManager,
+			}),
+			listType: omsuppression.ListTypeLitigator,
+			targets:  []string{"+821100000001", "+821100000002", "invalid"},
+			detail:   "imported",
+
+			responseImported: 2,
+			responseSkipped:  1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			h := &serviceHandler{
+				reqHandler: mockReq,
+			}
+			ctx := context.Background()
+
+			mockReq.EXPECT().OutdialV1SuppressionImport(ctx, tt.agent.CustomerID, tt.listType, tt.targets, tt.detail).Return(tt.responseImported, tt.responseSkipped, nil)
+
+			imported, skipped, err := h.SuppressionImport(ctx, tt.agent, tt.listType, tt.targets, tt.detail)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if imported != tt.responseImported || skipped != tt.responseSkipped {
+				t.Errorf("Wrong match. expect: %d/%d, got: %d/%d", tt.responseImported, tt.responseSkipped, imported, skipped)
+			}
+		})
+	}
+}
+
+func Test_SuppressionList(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		agent     *auth.AuthIdentity
+		pageToken string
+		pageSize  uint64
+
+		response      []omsuppression.Suppression
+		expectFilters map[omsuppression.Field]any
+		expectRes     []*omsuppression.WebhookMessage
+	}{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("0d5e3c1a-3c2a-11f1-9b1e-2f6c8d4a1e01"),
+					CustomerID: uuid.FromStringOrNil("0d8a4f2c-3c2a-11f1-8c2f-5a7d9e1b2f02"),
+				},
+				Permission: amagent.PermissionCustomerAdmin,
+			}),
+			pageToken: "2020-10-20T01:00:00.995000Z",
+			pageSize:  10,
+
+			response: []omsuppression.Suppression{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("0de0f6c4-3c2a-11f1-b6e5-3d9f1a7b4b04"),
+					},
+				},
+			},
+			expectFilters: map[omsuppression.Field]any{
+				omsuppression.FieldCustomerID: uuid.FromStringOrNil("0d8a4f2c-3c2a-11f1-8c2f-5a7d9e1b2f02"),
+				omsuppression.FieldDeleted:    false,
+			},
+			expectRes: []*omsuppression.WebhookMessage{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("0de0f6c4-3c2a-11f1-b6e5-3d9f1a7b4b04"),
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			h := &serviceHandler{
+				reqHandler: mockReq,
+			}
+			ctx := context.Background()
+
+			mockReq.EXPECT().OutdialV1SuppressionList(ctx, tt.pageToken, tt.pageSize, tt.expectFilters).Return(tt.response, nil)
+
+			res, err := h.SuppressionList(ctx, tt.agent, tt.pageSize, tt.pageToken)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_SuppressionGet(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		agent         *auth.AuthIdentity
+		suppressionID uuid.UUID
+
+		response  *omsuppression.Suppression
+		expectRes *omsuppression.WebhookMessage
+		expectErr error
+	}{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("0d5e3c1a-3c2a-11f1-9b1e-2f6c8d4a1e01"),
+					CustomerID: uuid.FromStringOrNil("0d8a4f2c-3c2a-11f1-8c2f-5a7d9e1b2f02"),
+				},
+				Permission: amagent.PermissionCustomerAdmin,
+			}),
+			suppressionID: uuid.FromStringOrNil("0e0c1a86-3c2a-11f1-8f16-4e1a2b8c5c05"),
+
+			response: &omsuppression.Suppression{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("0e0c1a86-3c2a-11f1-8f16-4e1a2b8c5c05"),
+					CustomerID: uuid.FromStringOrNil("0d8a4f2c-3c2a-11f1-8c2f-5a7d9e1b2f02"),
+				},
+			},
+			expectRes: &omsuppression.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("0e0c1a86-3c2a-11f1-8f16-4e1a2b8c5c05"),
+					CustomerID: uuid.FromStringOrNil("0d8a4f2c-3c2a-11f1-8c2f-5a7d9e1b2f02"),
+				},
+			},
+		},
+		{
+			name: "other customer's suppression",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("0d5e3c1a-3c2a-11f1-9b1e-2f6c8d4a1e01"),
+					CustomerID: uuid.FromStringOrNil("0d8a4f2c-3c2a-11f1-8c2f-5a7d9e1b2f02"),
+				},
+				Permission: amagent.PermissionCustomerAdmin,
+			}),
+			suppressionID: uuid.FromStringOrNil("0e0c1a86-3c2a-11f1-8f16-4e1a2b8c5c05"),
+
+			response: &omsuppression.Suppression{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("0e0c1a86-3c2a-11f1-8f16-4e1a2b8c5c05"),
+					CustomerID: uuid.FromStringOrNil("0e37a2ae-3c2a-11f1-9e27-6f2b3c9d6d06"),
+				},
+			},
+			expectErr: serviceerrors.ErrPermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			h := &serviceHandler{
+				reqHandler: mockReq,
+			}
+			ctx := context.Background()
+
+			mockReq.EXPECT().OutdialV1SuppressionGet(ctx, tt.suppressionID).Return(tt.response, nil)
+
+			res, err := h.SuppressionGet(ctx, tt.agent, tt.suppressionID)
+			if err != tt.expectErr {
+				t.Errorf("Wrong match. expect: %v, got: %v", tt.expectErr, err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_SuppressionDelete(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		agent         *auth.AuthIdentity
+		suppressionID uuid.UUID
+
+		response  *omsuppression.Suppression
+		expectRes *omsuppression.WebhookMessage
+	}{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("0d5e3c1a-3c2a-11f1-9b1e-2f6c8d4a1e01"),
+					CustomerID: uuid.FromStringOrNil("0d8a4f2c-3c2a-11f1-8c2f-5a7d9e1b2f02"),
+				},
+				Permission: amagent.PermissionCustomerAdmin,
+			}),
+			suppressionID: uuid.FromStringOrNil("0e62b3d0-3c2a-11f1-a038-7a3c4d1e7e07"),
+
+			response: &omsuppression.Suppression{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("0e62b3d0-3c2a-11f1-a038-7a3c4d1e7e07"),
+					CustomerID: uuid.FromStringOrNil("0d8a4f2c-3c2a-11f1-8c2f-5a7d9e1b2f02"),
+				},
+			},
+			expectRes: &omsuppression.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("0e62b3d0-3c2a-11f1-a038-7a3c4d1e7e07"),
+					CustomerID: uuid.FromStringOrNil("0d8a4f2c-3c2a-11f1-8c2f-5a7d9e1b2f02"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			h := &serviceHandler{
+				reqHandler: mockReq,
+			}
+			ctx := context.Background()
+
+			mockReq.EXPECT().OutdialV1SuppressionGet(ctx, tt.suppressionID).Return(tt.response, nil)
+			mockReq.EXPECT().OutdialV1SuppressionDelete(ctx, tt.suppressionID).Return(tt.response, nil)
+
+			res, err := h.SuppressionDelete(ctx, tt.agent, tt.suppressionID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_SuppressionBlockList(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		agent     *auth.AuthIdentity
+		pageToken string
+		pageSize  uint64
+
+		responseCurTime string
+		response        []omsuppressionblock.SuppressionBlock
+
+		expectPageToken string
+		expectFilters   map[omsuppressionblock.Field]any
+		expectRes       []*omsuppressionblock.WebhookMessage
+	}{
+		{
+			name: "empty page token",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("0d5e3c1a-3c2a-11f1-9b1e-2f6c8d4a1e01"),
+					CustomerID: uuid.FromStringOrNil("0d8a4f2c-3c2a-11f1-8c2f-5a7d9e1b2f02"),
+				},
+				Permission: amagent.PermissionCustomerAdmin,
+			}),
+			pageToken: "",
+			pageSize:  10,
+
+			responseCurTime: "2026-03-02T03:23:20.995000Z",
+			response: []omsuppressionblock.SuppressionBlock{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("0e8dc4f2-3c2a-11f1-b149-8b4d5e2f8f08"),
+					},
+					ReferenceType: omsuppressionblock.ReferenceTypeCall,
+				},
+			},
+
+			expectPageToken: "2026-03-02T03:23:20.995000Z",
+			expectFilters: map[omsuppressionblock.Field]any{
+				omsuppressionblock.FieldCustomerID: uuid.FromStringOrNil("0d8a4f2c-3c2a-11f1-8c2f-5a7d9e1b2f02"),
+				omsuppressionblock.FieldDeleted:    false,
+			},
+			expectRes: []*omsuppressionblock.WebhookMessage{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("0e8dc4f2-3c2a-11f1-b149-8b4d5e2f8f08"),
+					},
+					ReferenceType: omsuppressionblock.ReferenceTypeCall,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			h := &serviceHandler{
+				reqHandler:  mockReq,
+				utilHandler: mockUtil,
+			}
+			ctx := context.Background()
+
+			mockUtil.EXPECT().TimeGetCurTime().Return(tt.responseCurTime)
+			mockReq.EXPECT().OutdialV1SuppressionblockList(ctx, tt.expectPageToken, tt.pageSize, tt.expectFilters).Return(tt.response, nil)
+
+			res, err := h.SuppressionBlockList(ctx, tt.agent, tt.pageSize, tt.pageToken)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_SuppressionBlockGet(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		agent              *auth.AuthIdentity
+		suppressionblockID uuid.UUID
+
+		response  *omsuppressionblock.SuppressionBlock
+		expectRes *omsuppressionblock.WebhookMessage
+	}{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("0d5e3c1a-3c2a-11f1-9b1e-2f6c8d4a1e01"),
+					CustomerID: uuid.FromStringOrNil("0d8a4f2c-3c2a-11f1-8c2f-5a7d9e1b2f02"),
+				},
+				Permission: amagent.PermissionCustomerAdmin,
+			}),
+			suppressionblockID: uuid.FromStringOrNil("0eb8d5a0-3c2a-11f1-825a-9c5e6f3a9a09"),
+
+			response: &omsuppressionblock.SuppressionBlock{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("0eb8d5a0-3c2a-11f1-825a-9c5e6f3a9a09"),
+					CustomerID: uuid.FromStringOrNil("0d8a4f2c-3c2a-11f1-8c2f-5a7d9e1b2f02"),
+				},
+				Reason: "The destination is in the dnc list.",
+			},
+			expectRes: &omsuppressionblock.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("0eb8d5a0-3c2a-11f1-825a-9c5e6f3a9a09"),
+					CustomerID: uuid.FromStringOrNil("0d8a4f2c-3c2a-11f1-8c2f-5a7d9e1b2f02"),
+				},
+				Reason: "The destination is in the dnc list.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			h := &serviceHandler{
+				reqHandler: mockReq,
+			}
+			ctx := context.Background()
+
+			mockReq.EXPECT().OutdialV1SuppressionblockGet(ctx, tt.suppressionblockID).Return(tt.response, nil)
+
+			res, err := h.SuppressionBlockGet(ctx, tt.agent, tt.suppressionblockID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package servicehandler
+
+import (
+	"context"
+	"fmt"
+
+	amagent "monorepo/bin-agent-manager/models/agent"
+	amtestrun "monorepo/bin-ai-manager/models/testrun"
+	"monorepo/bin-api-manager/models/auth"
+	"monorepo/bin-api-manager/pkg/serviceerrors"
+	commondatabasehandler "monorepo/bin-common-handler/pkg/databasehandler"
+
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// testRunGet returns the test run info.
+func (h *serviceHandler) testRunGet(ctx context.Context, id uuid.UUID) (*amtestrun.TestRun, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":        "testRunGet",
+		"test_run_id": id,
+	})
+
+	res, err := h.reqHandler.AIV1TestRunGet(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get the resource info. err: %v", err)
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// TestRunGetsByCustomerID gets the list of test runs of the given customer id.
+// If the testSuiteID is given, returns only the runs of the test suite.
+func (h *serviceHandler) TestRunGetsByCustomerID(ctx context.Context, a *auth.AuthIdentity, size uint64, token string, testSuiteID uuid.UUID) ([]*amtestrun.WebhookMessage, error) {
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	log := logrus.WithFields(logrus.Fields{
+		"func":          "TestRunGetsByCustomerID",
+		"customer_id":   a.CustomerID,
+		"username":      a.DisplayName(),
+		"size":          size,
+		"token":         token,
+		"test_suite_id": testSuiteID,
+	})
+
+	if token == "" {
+		token = h.utilHandler.TimeGetCurTime()
+	}
+
+	if !h.hasPermission(ctx, a, a.CustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager) {
+		log.Info("The user has no permission for this agent.")
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	// filters
+	filters := map[string]string{
+		"deleted":     "false",
+		"customer_id": a.CustomerID.String(),
+	}
+	if testSuiteID != uuid.Nil {
+		filters["test_suite_id"] = testSuiteID.String()
+	}
+
+	typedFilters, err := h.convertTestRunFilters(filters)
+	if err != nil {
+		log.Errorf("Could not convert filters. err: %v", err)
+		return nil, err
+	}
+
+	tmps, err := h.reqHandler.AIV1TestRunList(ctx, token, size, typedFilters)
+	if err != nil {
+		log.Errorf("Could not get test runs info from the ai manager. err: %v", err)
+		return nil, fmt.Errorf("%w: could not find test runs info", err)
+	}
+
+	res := []*amtestrun.WebhookMessage{}
+	for _, f := range tmps {
+		tmp := f.ConvertWebhookMessage()
+		res = append(res, tmp)
+	}
+
+	return res, nil
+}
+
+// convertTestRunFilters converts map[string]string to map[amtestrun.Field]any
+func (h *serviceHandler) convertTestRunFilters(filters map[string]string) (map[amtestrun.Field]any, error) {
+	srcAny := make(map[string]any, len(filters))
+	for k, v := range filters {
+		srcAny[k] = v
+	}
+
+	typed, err := commondatabasehandler.ConvertMapToTypedMap(srcAny, amtestrun.TestRun{})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[amtestrun.Field]any, len(typed))
+	for k, v := range typed {
+		result[amtestrun.Field(k)] = v
+	}
+
+	return result, nil
+}
+
+// TestRunGet gets the test run of the given id.
+func (h *serviceHandler) TestRunGet(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*amtestrun.WebhookMessage, error) {
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	log := logrus.WithFields(logrus.Fields{
+		"func":        "TestRunGet",
+		"customer_id": a.CustomerID,
+		"username":    a.DisplayName(),
+		"test_run_id": id,
+	})
+
+	tmp, err := h.testRunGet(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get test run info from the ai manager. err: %v", err)
+		return nil, fmt.Errorf("%w: could not find test run info", err)
+	}
+
+	if !h.hasPermission(ctx, a, tmp.CustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager) {
+		log.Info("The user has no permission for this agent.")
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	res := tmp.ConvertWebhookMessage()
+	return res, nil
+}
+
+// TestRunDelete deletes the test run.
+func (h *serviceHandler) TestRunDelete(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*amtestrun.WebhookMessage, error) {
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	log := logrus.WithFields(logrus.Fields{
+		"func":        "TestRunDelete",
+		"customer_id": a.CustomerID,
+		"username":    a.DisplayName(),
+		"test_run_id": id,
+	})
+	log.Debug("Deleting a test run.")
+
+	c, err := h.testRunGet(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get test run info from the ai-manager. err: %v", err)
+		return nil, fmt.Errorf("%w: could not find test run info", err)
+	}
+
+	if !h.hasPermission(ctx, a, c.CustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager) {
+		log.Info("The user has no permission for this agent.")
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	tmp, err := h.reqHandler.AIV1TestRunDelete(ctx, id)
+	if err != nil {
+		log.Errorf("Could not delete the test run. err: %v", err)
+		return nil, err
+	}
+
+	res := tmp.ConvertWebhookMessage()
+	return res, nil
+}
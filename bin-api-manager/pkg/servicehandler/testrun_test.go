@@ -0,0 +1,152 @@
+package servicehandler
+
+import (
+	"context"
+	amagent "monorepo/bin-agent-manager/models/agent"
+	amtestrun "monorepo/bin-ai-manager/models/testrun"
+	"monorepo/bin-api-manager/models/auth"
+	"monorepo/bin-api-manager/pkg/serviceerrors"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/requesthandler"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+	"reflect"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_TestRunGetsByCustomerID(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		agent       *auth.AuthIdentity
+		size        uint64
+		token       string
+		testSuiteID uuid.UUID
+
+		responseTestRuns []amtestrun.TestRun
+
+		expectFilters map[amtestrun.Field]any
+		expectRes     []*amtestrun.WebhookMessage
+	}{
+		{
+			name: "normal",
+
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("4d1a3c50-ad74-11f0-9d2b-1f3a5c7e9b01"),
+					CustomerID: uuid.FromStringOrNil("4d4c5e72-ad74-11f0-a3c4-2b4c6d8e0f02"),
+				},
+				Permission: amagent.PermissionCustomerManager,
+			}),
+			size:  10,
+			token: "2020-09-20 03:23:20.995000",
+
+			responseTestRuns: []amtestrun.TestRun{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("4d7e8094-ad74-11f0-8e5d-3c5d7e9f1a03"),
+					},
+				},
+			},
+
+			expectFilters: map[amtestrun.Field]any{
+				amtestrun.FieldCustomerID: uuid.FromStringOrNil("4d4c5e72-ad74-11f0-a3c4-2b4c6d8e0f02"),
+				amtestrun.FieldDeleted:    false,
+			},
+			expectRes: []*amtestrun.WebhookMessage{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("4d7e8094-ad74-11f0-8e5d-3c5d7e9f1a03"),
+					},
+				},
+			},
+		},
+		{
+			name: "filtered by test suite",
+
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("4d1a3c50-ad74-11f0-9d2b-1f3a5c7e9b01"),
+					CustomerID: uuid.FromStringOrNil("4d4c5e72-ad74-11f0-a3c4-2b4c6d8e0f02"),
+				},
+				Permission: amagent.PermissionCustomerManager,
+			}),
+			size:        10,
+			token:       "2020-09-20 03:23:20.995000",
+			testSuiteID: uuid.FromStringOrNil("4db0a2b6-ad74-11f0-b6f7-4d6e8f0a2b04"),
+
+			responseTestRuns: []amtestrun.TestRun{},
+
+			expectFilters: map[amtestrun.Field]any{
+				amtestrun.FieldCustomerID:  uuid.FromStringOrNil("4d4c5e72-ad74-11f0-a3c4-2b4c6d8e0f02"),
+				amtestrun.FieldTestSuiteID: uuid.FromStringOrNil("4db0a2b6-ad74-11f0-b6f7-4d6e8f0a2b04"),
+				amtestrun.FieldDeleted:     false,
+			},
+			expectRes: []*amtestrun.WebhookMessage{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+
+			h := serviceHandler{
+				reqHandler:  mockReq,
+				utilHandler: mockUtil,
+			}
+			ctx := context.Background()
+
+			mockReq.EXPECT().AIV1TestRunList(ctx, tt.token, tt.size, tt.expectFilters).Return(tt.responseTestRuns, nil)
+
+			res, err := h.TestRunGetsByCustomerID(ctx, tt.agent, tt.size, tt.token, tt.testSuiteID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v\n", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_TestRunGet_otherCustomer(t *testing.T) {
+
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockReq := requesthandler.NewMockRequestHandler(mc)
+
+	h := serviceHandler{
+		reqHandler: mockReq,
+	}
+	ctx := context.Background()
+
+	agent := auth.NewAgentIdentity(&amagent.Agent{
+		Identity: commonidentity.Identity{
+			ID:         uuid.FromStringOrNil("4de2c4d8-ad74-11f0-8f08-5e7f9a1b3c05"),
+			CustomerID: uuid.FromStringOrNil("4e14e6fa-ad74-11f0-9a19-6f8a0b2c4d06"),
+		},
+		Permission: amagent.PermissionCustomerAdmin,
+	})
+	testRunID := uuid.FromStringOrNil("4e47091c-ad74-11f0-ab2a-7a9b1c3d5e07")
+
+	mockReq.EXPECT().AIV1TestRunGet(ctx, testRunID).Return(&amtestrun.TestRun{
+		Identity: commonidentity.Identity{
+			ID:         testRunID,
+			CustomerID: uuid.FromStringOrNil("4e792b3e-ad74-11f0-8c3b-8bac2d4e6f08"),
+		},
+	}, nil)
+
+	_, err := h.TestRunGet(ctx, agent, testRunID)
+	if err != serviceerrors.ErrPermissionDenied {
+		t.Errorf("Wrong match. expect: %v, got: %v", serviceerrors.ErrPermissionDenied, err)
+	}
+}
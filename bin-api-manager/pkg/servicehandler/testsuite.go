@@ -0,0 +1,290 @@
+package servicehandler
+
+import (
+	"context"
+	"fmt"
+
+	amagent "monorepo/bin-agent-manager/models/agent"
+	amtestrun "monorepo/bin-ai-manager/models/testrun"
+	amtestsuite "monorepo/bin-ai-manager/models/testsuite"
+	"monorepo/bin-api-manager/models/auth"
+	"monorepo/bin-api-manager/pkg/serviceerrors"
+	commondatabasehandler "monorepo/bin-common-handler/pkg/databasehandler"
+
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// testSuiteGet returns the test suite info.
+func (h *serviceHandler) testSuiteGet(ctx context.Context, id uuid.UUID) (*amtestsuite.TestSuite, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":          "testSuiteGet",
+		"test_suite_id": id,
+	})
+
+	res, err := h.reqHandler.AIV1TestSuiteGet(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get the resource info. err: %v", err)
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// TestSuiteCreate is a service handler for test suite creation.
+func (h *serviceHandler) TestSuiteCreate(
+	ctx context.Context,
+	a *auth.AuthIdentity,
+	aiID uuid.UUID,
+	name string,
+	detail string,
+	scenarios []amtestsuite.Scenario,
+) (*amtestsuite.WebhookMessage, error) {
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	log := logrus.WithFields(logrus.Fields{
+		"func":        "TestSuiteCreate",
+		"customer_id": a.CustomerID,
+		"ai_id":       aiID,
+		"name":        name,
+	})
+
+	ai, err := h.aiGet(ctx, aiID)
+	if err != nil {
+		log.Errorf("Could not get ai info from the ai-manager. err: %v", err)
+		return nil, fmt.Errorf("%w: could not find ai info", err)
+	}
+
+	if !h.hasPermission(ctx, a, ai.CustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager) {
+		log.Info("The user has no permission for this agent.")
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	tmp, err := h.reqHandler.AIV1TestSuiteCreate(ctx, ai.CustomerID, aiID, name, detail, scenarios)
+	if err != nil {
+		log.Errorf("Could not create a new test suite. err: %v", err)
+		return nil, err
+	}
+	log.WithField("test_suite", tmp).Debug("Created a new test suite.")
+
+	res := tmp.ConvertWebhookMessage()
+	return res, nil
+}
+
+// TestSuiteGetsByCustomerID gets the list of test suites of the given customer id.
+// If the aiID is given, returns only the test suites of the ai.
+func (h *serviceHandler) TestSuiteGetsByCustomerID(ctx context.Context, a *auth.AuthIdentity, size uint64, token string, aiID uuid.UUID) ([]*amtestsuite.WebhookMessage, error) {
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	log := logrus.WithFields(logrus.Fields{
+		"func":        "TestSuiteGetsByCustomerID",
+		"customer_id": a.CustomerID,
+		"username":    a.DisplayName(),
+		"size":        size,
+		"token":       token,
+		"ai_id":       aiID,
+	})
+
+	if token == "" {
+		token = h.utilHandler.TimeGetCurTime()
+	}
+
+	if !h.hasPermission(ctx, a, a.CustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager) {
+		log.Info("The user has no permission for this agent.")
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	// filters
+	filters := map[string]string{
+		"deleted":     "false",
+		"customer_id": a.CustomerID.String(),
+	}
+	if aiID != uuid.Nil {
+		filters["ai_id"] = aiID.String()
+	}
+
+	typedFilters, err := h.convertTestSuiteFilters(filters)
+	if err != nil {
+		log.Errorf("Could not convert filters. err: %v", err)
+		return nil, err
+	}
+
+	tmps, err := h.reqHandler.AIV1TestSuiteList(ctx, token, size, typedFilters)
+	if err != nil {
+		log.Errorf("Could not get test suites info from the ai manager. err: %v", err)
+		return nil, fmt.Errorf("%w: could not find test suites info", err)
+	}
+
+	res := []*amtestsuite.WebhookMessage{}
+	for _, f := range tmps {
+		tmp := f.ConvertWebhookMessage()
+		res = append(res, tmp)
+	}
+
+	return res, nil
+}
+
+// convertTestSuiteFilters converts map[string]string to map[amtestsuite.Field]any
+func (h *serviceHandler) convertTestSuiteFilters(filters map[string]string) (map[amtestsuite.Field]any, error) {
+	srcAny := make(map[string]any, len(filters))
+	for k, v := range filters {
+		srcAny[k] = v
+	}
+
+	typed, err := commondatabasehandler.ConvertMapToTypedMap(srcAny, amtestsuite.TestSuite{})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[amtestsuite.Field]any, len(typed))
+	for k, v := range typed {
+		result[amtestsuite.Field(k)] = v
+	}
+
+	return result, nil
+}
+
+// TestSuiteGet gets the test suite of the given id.
+func (h *serviceHandler) TestSuiteGet(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*amtestsuite.WebhookMessage, error) {
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	log := logrus.WithFields(logrus.Fields{
+		"func":          "TestSuiteGet",
+		"customer_id":   a.CustomerID,
+		"username":      a.DisplayName(),
+		"test_suite_id": id,
+	})
+
+	tmp, err := h.testSuiteGet(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get test suite info from the ai manager. err: %v", err)
+		return nil, fmt.Errorf("%w: could not find test suite info", err)
+	}
+
+	if !h.hasPermission(ctx, a, tmp.CustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager) {
+		log.Info("The user has no permission for this agent.")
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	res := tmp.ConvertWebhookMessage()
+	return res, nil
+}
+
+// TestSuiteDelete deletes the test suite.
+func (h *serviceHandler) TestSuiteDelete(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*amtestsuite.WebhookMessage, error) {
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	log := logrus.WithFields(logrus.Fields{
+		"func":          "TestSuiteDelete",
+		"customer_id":   a.CustomerID,
+		"username":      a.DisplayName(),
+		"test_suite_id": id,
+	})
+	log.Debug("Deleting a test suite.")
+
+	c, err := h.testSuiteGet(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get test suite info from the ai-manager. err: %v", err)
+		return nil, fmt.Errorf("%w: could not find test suite info", err)
+	}
+
+	if !h.hasPermission(ctx, a, c.CustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager) {
+		log.Info("The user has no permission for this agent.")
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	tmp, err := h.reqHandler.AIV1TestSuiteDelete(ctx, id)
+	if err != nil {
+		log.Errorf("Could not delete the test suite. err: %v", err)
+		return nil, err
+	}
+
+	res := tmp.ConvertWebhookMessage()
+	return res, nil
+}
+
+// TestSuiteUpdate is a service handler for test suite update.
+func (h *serviceHandler) TestSuiteUpdate(
+	ctx context.Context,
+	a *auth.AuthIdentity,
+	id uuid.UUID,
+	name string,
+	detail string,
+	scenarios []amtestsuite.Scenario,
+) (*amtestsuite.WebhookMessage, error) {
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	log := logrus.WithFields(logrus.Fields{
+		"func":        "TestSuiteUpdate",
+		"customer_id": a.CustomerID,
+		"id":          id,
+		"name":        name,
+	})
+
+	c, err := h.testSuiteGet(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get test suite info from the ai-manager. err: %v", err)
+		return nil, fmt.Errorf("%w: could not find test suite info", err)
+	}
+
+	if !h.hasPermission(ctx, a, c.CustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager) {
+		log.Info("The user has no permission for this agent.")
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	tmp, err := h.reqHandler.AIV1TestSuiteUpdate(ctx, id, name, detail, scenarios)
+	if err != nil {
+		log.Errorf("Could not update the test suite. err: %v", err)
+		return nil, err
+	}
+	log.WithField("test_suite", tmp).Debugf("Updated test suite info. test_suite_id: %s", tmp.ID)
+
+	res := tmp.ConvertWebhookMessage()
+	return res, nil
+}
+
+// TestSuiteRun starts a run of the test suite.
+// It returns the created test run. The scenarios are run in the background.
+func (h *serviceHandler) TestSuiteRun(ctx context.Context, a *auth.AuthIdentity, id uuid.UUID) (*amtestrun.WebhookMessage, error) {
+	if a.IsDirect() {
+		return nil, serviceerrors.ErrDirectAccessNotSupported
+	}
+
+	log := logrus.WithFields(logrus.Fields{
+		"func":          "TestSuiteRun",
+		"customer_id":   a.CustomerID,
+		"username":      a.DisplayName(),
+		"test_suite_id": id,
+	})
+
+	c, err := h.testSuiteGet(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get test suite info from the ai-manager. err: %v", err)
+		return nil, fmt.Errorf("%w: could not find test suite info", err)
+	}
+
+	if !h.hasPermission(ctx, a, c.CustomerID, amagent.PermissionCustomerAdmin|amagent.PermissionCustomerManager) {
+		log.Info("The user has no permission for this agent.")
+		return nil, serviceerrors.ErrPermissionDenied
+	}
+
+	tmp, err := h.reqHandler.AIV1TestSuiteRun(ctx, id)
+	if err != nil {
+		log.Errorf("Could not run the test suite. err: %v", err)
+		return nil, err
+	}
+	log.WithField("test_run", tmp).Debugf("Started a test run. test_run_id: %s", tmp.ID)
+
+	res := tmp.ConvertWebhookMessage()
+	return res, nil
+}
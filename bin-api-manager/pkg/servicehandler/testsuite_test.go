@@ -0,0 +1,269 @@
+package servicehandler
+
+import (
+	"context"
+	amagent "monorepo/bin-agent-manager/models/agent"
+	amai "monorepo/bin-ai-manager/models/ai"
+	amtestrun "monorepo/bin-ai-manager/models/testrun"
+	amtestsuite "monorepo/bin-ai-manager/models/testsuite"
+	"monorepo/bin-api-manager/models/auth"
+	"monorepo/bin-api-manager/pkg/serviceerrors"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/requesthandler"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+	"reflect"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_TestSuiteCreate(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		agent     *auth.AuthIdentity
+		aiID      uuid.UUID
+		suiteName string
+		detail    string
+		scenarios []amtestsuite.Scenario
+
+		responseAI        *amai.AI
+		responseTestSuite *amtestsuite.TestSuite
+
+		expectRes *amtestsuite.WebhookMessage
+	}{
+		{
+			name: "normal",
+
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("0e1a3c50-ad74-11f0-9d2b-1f3a5c7e9b01"),
+					CustomerID: uuid.FromStringOrNil("0e4c5e72-ad74-11f0-a3c4-2b4c6d8e0f02"),
+				},
+				Permission: amagent.PermissionCustomerAdmin,
+			}),
+			aiID:      uuid.FromStringOrNil("0e7e8094-ad74-11f0-8e5d-3c5d7e9f1a03"),
+			suiteName: "refund",
+			detail:    "refund policy",
+			scenarios: []amtestsuite.Scenario{
+				{
+					Name:  "asks for a refund",
+					Type:  amtestsuite.ScenarioTypeScripted,
+					Turns: []string{"I want my money back."},
+				},
+			},
+
+			responseAI: &amai.AI{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("0e7e8094-ad74-11f0-8e5d-3c5d7e9f1a03"),
+					CustomerID: uuid.FromStringOrNil("0e4c5e72-ad74-11f0-a3c4-2b4c6d8e0f02"),
+				},
+			},
+			responseTestSuite: &amtestsuite.TestSuite{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("0eb0a2b6-ad74-11f0-b6f7-4d6e8f0a2b04"),
+				},
+			},
+
+			expectRes: &amtestsuite.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("0eb0a2b6-ad74-11f0-b6f7-4d6e8f0a2b04"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+
+			h := serviceHandler{
+				reqHandler: mockReq,
+			}
+			ctx := context.Background()
+
+			mockReq.EXPECT().AIV1AIGet(ctx, tt.aiID).Return(tt.responseAI, nil)
+			mockReq.EXPECT().AIV1TestSuiteCreate(ctx, tt.agent.CustomerID, tt.aiID, tt.suiteName, tt.detail, tt.scenarios).Return(tt.responseTestSuite, nil)
+
+			res, err := h.TestSuiteCreate(ctx, tt.agent, tt.aiID, tt.suiteName, tt.detail, tt.scenarios)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v\n", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_TestSuiteCreate_otherCustomerAI(t *testing.T) {
+
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockReq := requesthandler.NewMockRequestHandler(mc)
+
+	h := serviceHandler{
+		reqHandler: mockReq,
+	}
+	ctx := context.Background()
+
+	agent := auth.NewAgentIdentity(&amagent.Agent{
+		Identity: commonidentity.Identity{
+			ID:         uuid.FromStringOrNil("0ee2c4d8-ad74-11f0-8f08-5e7f9a1b3c05"),
+			CustomerID: uuid.FromStringOrNil("0f14e6fa-ad74-11f0-9a19-6f8a0b2c4d06"),
+		},
+		Permission: amagent.PermissionCustomerAdmin,
+	})
+	aiID := uuid.FromStringOrNil("0f47091c-ad74-11f0-ab2a-7a9b1c3d5e07")
+
+	mockReq.EXPECT().AIV1AIGet(ctx, aiID).Return(&amai.AI{
+		Identity: commonidentity.Identity{
+			ID:         aiID,
+			CustomerID: uuid.FromStringOrNil("0f792b3e-ad74-11f0-8c3b-8bac2d4e6f08"),
+		},
+	}, nil)
+
+	_, err := h.TestSuiteCreate(ctx, agent, aiID, "refund", "", nil)
+	if err != serviceerrors.ErrPermissionDenied {
+		t.Errorf("Wrong match. expect: %v, got: %v", serviceerrors.ErrPermissionDenied, err)
+	}
+}
+
+func Test_TestSuiteGetsByCustomerID(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		agent *auth.AuthIdentity
+		size  uint64
+		token string
+		aiID  uuid.UUID
+
+		responseTestSuites []amtestsuite.TestSuite
+
+		expectFilters map[amtestsuite.Field]any
+		expectRes     []*amtestsuite.WebhookMessage
+	}{
+		{
+			name: "filtered by ai",
+
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("0fab4d60-ad74-11f0-9d4c-9cbd3e5f7a09"),
+					CustomerID: uuid.FromStringOrNil("0fdd6f82-ad74-11f0-ae5d-adce4f6a8b0a"),
+				},
+				Permission: amagent.PermissionCustomerManager,
+			}),
+			size:  10,
+			token: "2020-09-20 03:23:20.995000",
+			aiID:  uuid.FromStringOrNil("100f91a4-ad74-11f0-8f6e-bedf5a7b9c0b"),
+
+			responseTestSuites: []amtestsuite.TestSuite{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("1041b3c6-ad74-11f0-907f-cfe06b8cad0c"),
+					},
+				},
+			},
+
+			expectFilters: map[amtestsuite.Field]any{
+				amtestsuite.FieldCustomerID: uuid.FromStringOrNil("0fdd6f82-ad74-11f0-ae5d-adce4f6a8b0a"),
+				amtestsuite.FieldAIID:       uuid.FromStringOrNil("100f91a4-ad74-11f0-8f6e-bedf5a7b9c0b"),
+				amtestsuite.FieldDeleted:    false,
+			},
+			expectRes: []*amtestsuite.WebhookMessage{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("1041b3c6-ad74-11f0-907f-cfe06b8cad0c"),
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+
+			h := serviceHandler{
+				reqHandler:  mockReq,
+				utilHandler: mockUtil,
+			}
+			ctx := context.Background()
+
+			mockReq.EXPECT().AIV1TestSuiteList(ctx, tt.token, tt.size, tt.expectFilters).Return(tt.responseTestSuites, nil)
+
+			res, err := h.TestSuiteGetsByCustomerID(ctx, tt.agent, tt.size, tt.token, tt.aiID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v\n", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_TestSuiteRun(t *testing.T) {
+
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockReq := requesthandler.NewMockRequestHandler(mc)
+
+	h := serviceHandler{
+		reqHandler: mockReq,
+	}
+	ctx := context.Background()
+
+	agent := auth.NewAgentIdentity(&amagent.Agent{
+		Identity: commonidentity.Identity{
+			ID:         uuid.FromStringOrNil("1073d5e8-ad74-11f0-a180-d0f17c9dbe0d"),
+			CustomerID: uuid.FromStringOrNil("10a5f80a-ad74-11f0-b291-e1028daecf0e"),
+		},
+		Permission: amagent.PermissionCustomerAdmin,
+	})
+	testSuiteID := uuid.FromStringOrNil("10d81a2c-ad74-11f0-83a2-f213aebfd00f")
+
+	mockReq.EXPECT().AIV1TestSuiteGet(ctx, testSuiteID).Return(&amtestsuite.TestSuite{
+		Identity: commonidentity.Identity{
+			ID:         testSuiteID,
+			CustomerID: uuid.FromStringOrNil("10a5f80a-ad74-11f0-b291-e1028daecf0e"),
+		},
+	}, nil)
+	mockReq.EXPECT().AIV1TestSuiteRun(ctx, testSuiteID).Return(&amtestrun.TestRun{
+		Identity: commonidentity.Identity{
+			ID: uuid.FromStringOrNil("110a3c4e-ad74-11f0-94b3-0324bfc0e110"),
+		},
+		TestSuiteID: testSuiteID,
+		Status:      amtestrun.StatusProgressing,
+	}, nil)
+
+	res, err := h.TestSuiteRun(ctx, agent, testSuiteID)
+	if err != nil {
+		t.Errorf("Wrong match. expect: ok, got: %v", err)
+	}
+
+	expectRes := &amtestrun.WebhookMessage{
+		Identity: commonidentity.Identity{
+			ID: uuid.FromStringOrNil("110a3c4e-ad74-11f0-94b3-0324bfc0e110"),
+		},
+		TestSuiteID: testSuiteID,
+		Status:      amtestrun.StatusProgressing,
+	}
+	if !reflect.DeepEqual(res, expectRes) {
+		t.Errorf("Wrong match.\nexpect: %v\ngot: %v\n", expectRes, res)
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"monorepo/bin-common-handler/models/sock"
 	"monorepo/bin-common-handler/pkg/requesthandler"
 	"monorepo/bin-common-handler/pkg/sockhandler"
+	"monorepo/bin-common-handler/pkg/tracehandler"
 
 	wmwebhook "monorepo/bin-webhook-manager/models/webhook"
 
@@ -135,7 +136,8 @@ func (h *subscribeHandler) processEvent(m *sock.Event) {
 
 	var err error
 	start := time.Now()
-	ctx := context.Background()
+	ctx, span := tracehandler.StartConsumerSpan(context.Background(), m)
+	defer span.End()
 
 	switch {
 
@@ -186,6 +186,27 @@ func (h *subscribeHandler) createTopics(ctx context.Context, messageType string,
 			res = append(res, fmt.Sprintf("customer_id:%s:aicall:%s", d.CustomerID, extra.AIcallID))
 		}
 
+	case "aiassist":
+		// aiassist_suggestion_created carries its own suggestion id, so
+		// the suggestions are scoped by the parent aiassist_id (decoded
+		// locally) to share the topic with the aiassist's own events.
+		var extra struct {
+			AIAssistID uuid.UUID `json:"aiassist_id"`
+		}
+		_ = json.Unmarshal(raw, &extra)
+
+		aiassistID := extra.AIAssistID
+		if aiassistID == uuid.Nil {
+			aiassistID = d.ID
+		}
+
+		if d.CustomerID != uuid.Nil {
+			res = append(res, fmt.Sprintf("customer_id:%s:aiassist:%s", d.CustomerID, aiassistID))
+		}
+		if d.OwnerID != uuid.Nil {
+			res = append(res, fmt.Sprintf("agent_id:%s:aiassist:%s", d.OwnerID, aiassistID))
+		}
+
 	case "chat", "chatmessage", "chatparticipant":
 		// chat_id is specific to chat-family events only -- decoded
 		// locally rather than carried on the shared CommonData struct.
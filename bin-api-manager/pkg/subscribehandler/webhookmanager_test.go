@@ -163,6 +163,69 @@ func Test_processEventWebhookManagerWebhookPublished(t *testing.T) {
 			},
 			expectEvent: `{"data":{"aicall_id":"c3d4e5f6-a1b2-7890-abcd-1234567890ef","customer_id":"5e4a0680-804e-11ec-8477-2fea5968d85b","id":"b2c3d4e5-f6a1-7890-abcd-234567890ef1"},"type":"aimessage_intermediate"}`,
 		},
+		{
+			name: "aiassist_suggestion_created with aiassist_id",
+
+			request: &sock.Event{
+				Type:      "webhook_published",
+				Publisher: "webhook-manager",
+				DataType:  "application/json",
+				Data: json.RawMessage([]byte(`{
+					"data": {
+					  "data": {
+						"id": "0e6c1f5a-ad94-11f0-9a41-5b2c7d8e9f01",
+						"customer_id": "5e4a0680-804e-11ec-8477-2fea5968d85b",
+						"owner_id": "0e9e83bc-ad94-11f0-8b52-6c3d8e9fa002",
+						"aiassist_id": "0ed0e61e-ad94-11f0-9c63-7d4e9fab1103"
+					  },
+					  "type": "aiassist_suggestion_created"
+					},
+					"data_type": "application/json",
+					"customer_id": "5e4a0680-804e-11ec-8477-2fea5968d85b"
+				  }`)),
+			},
+
+			expectTopics: []string{
+				// Old format uses aiassist_id instead of the suggestion's id
+				"customer_id:5e4a0680-804e-11ec-8477-2fea5968d85b:aiassist:0ed0e61e-ad94-11f0-9c63-7d4e9fab1103",
+				"agent_id:0e9e83bc-ad94-11f0-8b52-6c3d8e9fa002:aiassist:0ed0e61e-ad94-11f0-9c63-7d4e9fab1103",
+				// New format (service-namespaced)
+				"customer_id:5e4a0680-804e-11ec-8477-2fea5968d85b:webhook:aiassist_suggestion_created:0e6c1f5a-ad94-11f0-9a41-5b2c7d8e9f01",
+				"agent_id:0e9e83bc-ad94-11f0-8b52-6c3d8e9fa002:webhook:aiassist_suggestion_created:0e6c1f5a-ad94-11f0-9a41-5b2c7d8e9f01",
+			},
+			expectEvent: `{"data":{"aiassist_id":"0ed0e61e-ad94-11f0-9c63-7d4e9fab1103","customer_id":"5e4a0680-804e-11ec-8477-2fea5968d85b","id":"0e6c1f5a-ad94-11f0-9a41-5b2c7d8e9f01","owner_id":"0e9e83bc-ad94-11f0-8b52-6c3d8e9fa002"},"type":"aiassist_suggestion_created"}`,
+		},
+		{
+			name: "aiassist_updated (aiassist_id fallback to d.ID)",
+
+			request: &sock.Event{
+				Type:      "webhook_published",
+				Publisher: "webhook-manager",
+				DataType:  "application/json",
+				Data: json.RawMessage([]byte(`{
+					"data": {
+					  "data": {
+						"id": "0ed0e61e-ad94-11f0-9c63-7d4e9fab1103",
+						"customer_id": "5e4a0680-804e-11ec-8477-2fea5968d85b",
+						"owner_id": "0e9e83bc-ad94-11f0-8b52-6c3d8e9fa002"
+					  },
+					  "type": "aiassist_updated"
+					},
+					"data_type": "application/json",
+					"customer_id": "5e4a0680-804e-11ec-8477-2fea5968d85b"
+				  }`)),
+			},
+
+			expectTopics: []string{
+				// Old format (backward compatible)
+				"customer_id:5e4a0680-804e-11ec-8477-2fea5968d85b:aiassist:0ed0e61e-ad94-11f0-9c63-7d4e9fab1103",
+				"agent_id:0e9e83bc-ad94-11f0-8b52-6c3d8e9fa002:aiassist:0ed0e61e-ad94-11f0-9c63-7d4e9fab1103",
+				// New format (service-namespaced)
+				"customer_id:5e4a0680-804e-11ec-8477-2fea5968d85b:webhook:aiassist_updated:0ed0e61e-ad94-11f0-9c63-7d4e9fab1103",
+				"agent_id:0e9e83bc-ad94-11f0-8b52-6c3d8e9fa002:webhook:aiassist_updated:0ed0e61e-ad94-11f0-9c63-7d4e9fab1103",
+			},
+			expectEvent: `{"data":{"customer_id":"5e4a0680-804e-11ec-8477-2fea5968d85b","id":"0ed0e61e-ad94-11f0-9c63-7d4e9fab1103","owner_id":"0e9e83bc-ad94-11f0-8b52-6c3d8e9fa002"},"type":"aiassist_updated"}`,
+		},
 		{
 			name: "chatmessage_created with participants",
 
@@ -0,0 +1,212 @@
+package server
+
+import (
+	amaiassist "monorepo/bin-ai-manager/models/aiassist"
+	"monorepo/bin-api-manager/gens/openapi_server"
+	cerrors "monorepo/bin-common-handler/models/errors"
+	commonoutline "monorepo/bin-common-handler/models/outline"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+func (h *server) PostAiassists(c *gin.Context) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":            "PostAiassists",
+		"request_address": c.ClientIP,
+	})
+
+	a, ok := getAuthIdentity(c)
+	if !ok {
+		log.Errorf("Could not find auth identity.")
+		abortWithError(c, cerrors.Unauthenticated(commonoutline.ServiceNameAPIManager, "AUTHENTICATION_REQUIRED", "Authentication is required."))
+		return
+	}
+	log = log.WithFields(logrus.Fields{
+		"auth": a,
+	})
+
+	var req openapi_server.PostAiassistsJSONBody
+	if err := c.BindJSON(&req); err != nil {
+		log.Errorf("Could not parse the request. err: %v", err)
+		abortWithError(c, cerrors.InvalidArgument(commonoutline.ServiceNameAPIManager, "INVALID_JSON_BODY", "The request body is not valid JSON.").Wrap(err))
+		return
+	}
+
+	agentID := uuid.Nil
+	if req.AgentId != nil {
+		agentID = uuid.FromStringOrNil(*req.AgentId)
+	}
+	aiID := uuid.FromStringOrNil(req.AiId)
+	referenceID := uuid.FromStringOrNil(req.ReferenceId)
+
+	language := ""
+	if req.Language != nil {
+		language = *req.Language
+	}
+
+	checklist := []string{}
+	if req.Checklist != nil {
+		checklist = *req.Checklist
+	}
+
+	res, err := h.serviceHandler.AIAssistStart(c.Request.Context(), a, agentID, aiID, amaiassist.ReferenceType(req.ReferenceType), referenceID, language, checklist)
+	if err != nil {
+		log.Errorf("Could not start an ai assist. err: %v", err)
+		abortWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(200, res)
+}
+
+func (h *server) GetAiassists(c *gin.Context, params openapi_server.GetAiassistsParams) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":            "GetAiassists",
+		"request_address": c.ClientIP,
+	})
+
+	a, ok := getAuthIdentity(c)
+	if !ok {
+		log.Errorf("Could not find auth identity.")
+		abortWithError(c, cerrors.Unauthenticated(commonoutline.ServiceNameAPIManager, "AUTHENTICATION_REQUIRED", "Authentication is required."))
+		return
+	}
+	log = log.WithFields(logrus.Fields{
+		"auth": a,
+	})
+
+	pageSize := uint64(100)
+	if params.PageSize != nil {
+		pageSize = uint64(*params.PageSize)
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 100
+		log.Debugf("Invalid requested page size. Set to default. page_size: %d", pageSize)
+	}
+
+	pageToken := ""
+	if params.PageToken != nil {
+		pageToken = *params.PageToken
+	}
+
+	tmps, err := h.serviceHandler.AIAssistGetsByCustomerID(c.Request.Context(), a, pageSize, pageToken)
+	if err != nil {
+		log.Errorf("Could not get an ai assist list. err: %v", err)
+		abortWithServiceError(c, err)
+		return
+	}
+
+	nextToken := ""
+	if len(tmps) > 0 {
+		if tmps[len(tmps)-1].TMCreate != nil {
+			nextToken = tmps[len(tmps)-1].TMCreate.UTC().Format("2006-01-02T15:04:05.000000Z")
+		}
+	}
+
+	res := GenerateListResponse(tmps, nextToken)
+	c.JSON(200, res)
+}
+
+func (h *server) GetAiassistsId(c *gin.Context, id string) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":            "GetAiassistsId",
+		"request_address": c.ClientIP,
+		"aiassist_id":     id,
+	})
+
+	a, ok := getAuthIdentity(c)
+	if !ok {
+		log.Errorf("Could not find auth identity.")
+		abortWithError(c, cerrors.Unauthenticated(commonoutline.ServiceNameAPIManager, "AUTHENTICATION_REQUIRED", "Authentication is required."))
+		return
+	}
+	log = log.WithFields(logrus.Fields{
+		"auth": a,
+	})
+
+	target := uuid.FromStringOrNil(id)
+	if target == uuid.Nil {
+		log.Error("Could not parse the id.")
+		abortWithError(c, cerrors.InvalidArgument(commonoutline.ServiceNameAPIManager, "INVALID_ID", "The provided id is not a valid UUID."))
+		return
+	}
+
+	res, err := h.serviceHandler.AIAssistGet(c.Request.Context(), a, target)
+	if err != nil {
+		log.Errorf("Could not get an ai assist. err: %v", err)
+		abortWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(200, res)
+}
+
+func (h *server) DeleteAiassistsId(c *gin.Context, id string) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":            "DeleteAiassistsId",
+		"request_address": c.ClientIP,
+		"aiassist_id":     id,
+	})
+
+	a, ok := getAuthIdentity(c)
+	if !ok {
+		log.Errorf("Could not find auth identity.")
+		abortWithError(c, cerrors.Unauthenticated(commonoutline.ServiceNameAPIManager, "AUTHENTICATION_REQUIRED", "Authentication is required."))
+		return
+	}
+	log = log.WithFields(logrus.Fields{
+		"auth": a,
+	})
+
+	target := uuid.FromStringOrNil(id)
+	if target == uuid.Nil {
+		log.Error("Could not parse the id.")
+		abortWithError(c, cerrors.InvalidArgument(commonoutline.ServiceNameAPIManager, "INVALID_ID", "The provided id is not a valid UUID."))
+		return
+	}
+
+	res, err := h.serviceHandler.AIAssistDelete(c.Request.Context(), a, target)
+	if err != nil {
+		log.Errorf("Could not delete the ai assist. err: %v", err)
+		abortWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(200, res)
+}
+
+func (h *server) PostAiassistsIdStop(c *gin.Context, id string) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":            "PostAiassistsIdStop",
+		"request_address": c.ClientIP,
+		"aiassist_id":     id,
+	})
+
+	a, ok := getAuthIdentity(c)
+	if !ok {
+		log.Errorf("Could not find auth identity.")
+		abortWithError(c, cerrors.Unauthenticated(commonoutline.ServiceNameAPIManager, "AUTHENTICATION_REQUIRED", "Authentication is required."))
+		return
+	}
+	log = log.WithFields(logrus.Fields{
+		"auth": a,
+	})
+
+	target := uuid.FromStringOrNil(id)
+	if target == uuid.Nil {
+		log.Error("Could not parse the id.")
+		abortWithError(c, cerrors.InvalidArgument(commonoutline.ServiceNameAPIManager, "INVALID_ID", "The provided id is not a valid UUID."))
+		return
+	}
+
+	res, err := h.serviceHandler.AIAssistStop(c.Request.Context(), a, target)
+	if err != nil {
+		log.Errorf("Could not stop the ai assist. err: %v", err)
+		abortWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(200, res)
+}
@@ -0,0 +1,410 @@
+package server
+
+import (
+	"bytes"
+	amagent "monorepo/bin-agent-manager/models/agent"
+	amaiassist "monorepo/bin-ai-manager/models/aiassist"
+	"monorepo/bin-api-manager/gens/openapi_server"
+	"monorepo/bin-api-manager/models/auth"
+	"monorepo/bin-api-manager/pkg/servicehandler"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_PostAiassists(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		agent *auth.AuthIdentity
+
+		reqQuery string
+		reqBody  []byte
+
+		responseAIassist *amaiassist.WebhookMessage
+
+		expectedAgentID       uuid.UUID
+		expectedAIID          uuid.UUID
+		expectedReferenceType amaiassist.ReferenceType
+		expectedReferenceID   uuid.UUID
+		expectedLanguage      string
+		expectedChecklist     []string
+		expectedRes           string
+	}{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("2a2ec0ba-8004-11ec-aea5-439829c92a7c"),
+				},
+			}),
+
+			reqQuery: "/aiassists",
+			reqBody:  []byte(`{"ai_id":"fb095d2a-ad93-11f0-b380-02fc00000001","reference_type":"call","reference_id":"fb095fda-ad93-11f0-b380-02fc00000001","language":"en-US","checklist":["Confirm the customer's date of birth"]}`),
+
+			responseAIassist: &amaiassist.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("fb0960c0-ad93-11f0-b380-02fc00000001"),
+				},
+			},
+
+			expectedAgentID:       uuid.Nil,
+			expectedAIID:          uuid.FromStringOrNil("fb095d2a-ad93-11f0-b380-02fc00000001"),
+			expectedReferenceType: amaiassist.ReferenceTypeCall,
+			expectedReferenceID:   uuid.FromStringOrNil("fb095fda-ad93-11f0-b380-02fc00000001"),
+			expectedLanguage:      "en-US",
+			expectedChecklist:     []string{"Confirm the customer's date of birth"},
+			expectedRes:           `{"id":"fb0960c0-ad93-11f0-b380-02fc00000001","customer_id":"00000000-0000-0000-0000-000000000000","owner_type":"","owner_id":"00000000-0000-0000-0000-000000000000","ai_id":"00000000-0000-0000-0000-000000000000","reference_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`,
+		},
+		{
+			name: "other agent",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("2a2ec0ba-8004-11ec-aea5-439829c92a7c"),
+				},
+			}),
+
+			reqQuery: "/aiassists",
+			reqBody:  []byte(`{"agent_id":"fb09621e-ad93-11f0-b380-02fc00000001","ai_id":"fb095d2a-ad93-11f0-b380-02fc00000001","reference_type":"call","reference_id":"fb095fda-ad93-11f0-b380-02fc00000001"}`),
+
+			responseAIassist: &amaiassist.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("fb0960c0-ad93-11f0-b380-02fc00000001"),
+				},
+			},
+
+			expectedAgentID:       uuid.FromStringOrNil("fb09621e-ad93-11f0-b380-02fc00000001"),
+			expectedAIID:          uuid.FromStringOrNil("fb095d2a-ad93-11f0-b380-02fc00000001"),
+			expectedReferenceType: amaiassist.ReferenceTypeCall,
+			expectedReferenceID:   uuid.FromStringOrNil("fb095fda-ad93-11f0-b380-02fc00000001"),
+			expectedChecklist:     []string{},
+			expectedRes:           `{"id":"fb0960c0-ad93-11f0-b380-02fc00000001","customer_id":"00000000-0000-0000-0000-000000000000","owner_type":"","owner_id":"00000000-0000-0000-0000-000000000000","ai_id":"00000000-0000-0000-0000-000000000000","reference_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// create mock
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSvc := servicehandler.NewMockServiceHandler(mc)
+			h := &server{
+				serviceHandler: mockSvc,
+			}
+
+			w := httptest.NewRecorder()
+			_, r := gin.CreateTestContext(w)
+
+			r.Use(func(c *gin.Context) {
+				c.Set("auth_identity", tt.agent)
+			})
+			openapi_server.RegisterHandlers(r, h)
+
+			req, _ := http.NewRequest("POST", tt.reqQuery, bytes.NewBuffer(tt.reqBody))
+			req.Header.Set("Content-Type", "application/json")
+			mockSvc.EXPECT().AIAssistStart(
+				req.Context(),
+				tt.agent,
+				tt.expectedAgentID,
+				tt.expectedAIID,
+				tt.expectedReferenceType,
+				tt.expectedReferenceID,
+				tt.expectedLanguage,
+				tt.expectedChecklist,
+			).Return(tt.responseAIassist, nil)
+
+			r.ServeHTTP(w, req)
+			if w.Code != http.StatusOK {
+				t.Errorf("Wrong match. expect: %d, got: %d", http.StatusOK, w.Code)
+			}
+
+			if w.Body.String() != tt.expectedRes {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectedRes, w.Body)
+			}
+		})
+	}
+}
+
+func Test_GetAiassists(t *testing.T) {
+
+	type test struct {
+		name  string
+		agent *auth.AuthIdentity
+
+		reqQuery string
+
+		responseAIassists []*amaiassist.WebhookMessage
+
+		expectedPageSize  uint64
+		expectedPageToken string
+		expectedRes       string
+	}
+
+	tests := []test{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("fb096412-ad93-11f0-b380-02fc00000001"),
+				},
+			}),
+
+			reqQuery: "/aiassists?page_size=10&page_token=2020-09-20T03:23:20.995000Z",
+
+			responseAIassists: []*amaiassist.WebhookMessage{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("fb09653e-ad93-11f0-b380-02fc00000001"),
+					},
+					TMCreate: timePtr("2020-09-20T03:23:21.995000Z"),
+				},
+			},
+			expectedPageSize:  10,
+			expectedPageToken: "2020-09-20T03:23:20.995000Z",
+			expectedRes:       `{"result":[{"id":"fb09653e-ad93-11f0-b380-02fc00000001","customer_id":"00000000-0000-0000-0000-000000000000","owner_type":"","owner_id":"00000000-0000-0000-0000-000000000000","ai_id":"00000000-0000-0000-0000-000000000000","reference_id":"00000000-0000-0000-0000-000000000000","tm_create":"2020-09-20T03:23:21.995Z","tm_update":null,"tm_delete":null}],"next_page_token":"2020-09-20T03:23:21.995000Z"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// create mock
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSvc := servicehandler.NewMockServiceHandler(mc)
+			h := &server{
+				serviceHandler: mockSvc,
+			}
+
+			w := httptest.NewRecorder()
+			_, r := gin.CreateTestContext(w)
+
+			r.Use(func(c *gin.Context) {
+				c.Set("auth_identity", tt.agent)
+			})
+			openapi_server.RegisterHandlers(r, h)
+
+			req, _ := http.NewRequest("GET", tt.reqQuery, nil)
+			mockSvc.EXPECT().AIAssistGetsByCustomerID(req.Context(), tt.agent, tt.expectedPageSize, tt.expectedPageToken).Return(tt.responseAIassists, nil)
+
+			r.ServeHTTP(w, req)
+			if w.Code != http.StatusOK {
+				t.Errorf("Wrong match. expect: %d, got: %d", http.StatusOK, w.Code)
+			}
+
+			if w.Body.String() != tt.expectedRes {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectedRes, w.Body)
+			}
+		})
+	}
+}
+
+func Test_GetAiassistsId(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		agent *auth.AuthIdentity
+
+		reqQuery string
+
+		responseAI *amaiassist.WebhookMessage
+
+		expectAIID uuid.UUID
+		expectRes  string
+	}{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("2a2ec0ba-8004-11ec-aea5-439829c92a7c"),
+				},
+			}),
+
+			reqQuery: "/aiassists/fb09680e-ad93-11f0-b380-02fc00000001",
+
+			responseAI: &amaiassist.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("fb09680e-ad93-11f0-b380-02fc00000001"),
+				},
+			},
+
+			expectAIID: uuid.FromStringOrNil("fb09680e-ad93-11f0-b380-02fc00000001"),
+			expectRes:  `{"id":"fb09680e-ad93-11f0-b380-02fc00000001","customer_id":"00000000-0000-0000-0000-000000000000","owner_type":"","owner_id":"00000000-0000-0000-0000-000000000000","ai_id":"00000000-0000-0000-0000-000000000000","reference_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// create mock
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSvc := servicehandler.NewMockServiceHandler(mc)
+			h := &server{
+				serviceHandler: mockSvc,
+			}
+
+			w := httptest.NewRecorder()
+			_, r := gin.CreateTestContext(w)
+
+			r.Use(func(c *gin.Context) {
+				c.Set("auth_identity", tt.agent)
+			})
+			openapi_server.RegisterHandlers(r, h)
+
+			req, _ := http.NewRequest("GET", tt.reqQuery, nil)
+			mockSvc.EXPECT().AIAssistGet(req.Context(), tt.agent, tt.expectAIID).Return(tt.responseAI, nil)
+
+			r.ServeHTTP(w, req)
+			if w.Code != http.StatusOK {
+				t.Errorf("Wrong match. expect: %d, got: %d", http.StatusOK, w.Code)
+			}
+
+			if w.Body.String() != tt.expectRes {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, w.Body)
+			}
+		})
+	}
+}
+
+func Test_DeleteAiassistsId(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		agent *auth.AuthIdentity
+
+		reqQuery string
+
+		responseAI *amaiassist.WebhookMessage
+
+		expectAIID uuid.UUID
+		expectRes  string
+	}{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("2a2ec0ba-8004-11ec-aea5-439829c92a7c"),
+				},
+			}),
+
+			reqQuery: "/aiassists/fb096ab6-ad93-11f0-b380-02fc00000001",
+
+			responseAI: &amaiassist.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("fb096ab6-ad93-11f0-b380-02fc00000001"),
+				},
+			},
+
+			expectAIID: uuid.FromStringOrNil("fb096ab6-ad93-11f0-b380-02fc00000001"),
+			expectRes:  `{"id":"fb096ab6-ad93-11f0-b380-02fc00000001","customer_id":"00000000-0000-0000-0000-000000000000","owner_type":"","owner_id":"00000000-0000-0000-0000-000000000000","ai_id":"00000000-0000-0000-0000-000000000000","reference_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// create mock
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSvc := servicehandler.NewMockServiceHandler(mc)
+			h := &server{
+				serviceHandler: mockSvc,
+			}
+
+			w := httptest.NewRecorder()
+			_, r := gin.CreateTestContext(w)
+
+			r.Use(func(c *gin.Context) {
+				c.Set("auth_identity", tt.agent)
+			})
+			openapi_server.RegisterHandlers(r, h)
+
+			req, _ := http.NewRequest("DELETE", tt.reqQuery, nil)
+			mockSvc.EXPECT().AIAssistDelete(req.Context(), tt.agent, tt.expectAIID).Return(tt.responseAI, nil)
+
+			r.ServeHTTP(w, req)
+			if w.Code != http.StatusOK {
+				t.Errorf("Wrong match. expect: %d, got: %d", http.StatusOK, w.Code)
+			}
+
+			if w.Body.String() != tt.expectRes {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, w.Body)
+			}
+		})
+	}
+}
+
+func Test_PostAiassistsIdStop(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		agent *auth.AuthIdentity
+
+		reqQuery string
+
+		responseAI *amaiassist.WebhookMessage
+
+		expectAIID uuid.UUID
+		expectRes  string
+	}{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("2a2ec0ba-8004-11ec-aea5-439829c92a7c"),
+				},
+			}),
+
+			reqQuery: "/aiassists/fb096c2e-ad93-11f0-b380-02fc00000001/stop",
+
+			responseAI: &amaiassist.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("fb096c2e-ad93-11f0-b380-02fc00000001"),
+				},
+			},
+
+			expectAIID: uuid.FromStringOrNil("fb096c2e-ad93-11f0-b380-02fc00000001"),
+			expectRes:  `{"id":"fb096c2e-ad93-11f0-b380-02fc00000001","customer_id":"00000000-0000-0000-0000-000000000000","owner_type":"","owner_id":"00000000-0000-0000-0000-000000000000","ai_id":"00000000-0000-0000-0000-000000000000","reference_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// create mock
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSvc := servicehandler.NewMockServiceHandler(mc)
+			h := &server{
+				serviceHandler: mockSvc,
+			}
+
+			w := httptest.NewRecorder()
+			_, r := gin.CreateTestContext(w)
+
+			r.Use(func(c *gin.Context) {
+				c.Set("auth_identity", tt.agent)
+			})
+			openapi_server.RegisterHandlers(r, h)
+
+			req, _ := http.NewRequest("POST", tt.reqQuery, nil)
+			mockSvc.EXPECT().AIAssistStop(req.Context(), tt.agent, tt.expectAIID).Return(tt.responseAI, nil)
+
+			r.ServeHTTP(w, req)
+			if w.Code != http.StatusOK {
+				t.Errorf("Wrong match. expect: %d, got: %d", http.StatusOK, w.Code)
+			}
+
+			if w.Body.String() != tt.expectRes {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, w.Body)
+			}
+		})
+	}
+}
@@ -0,0 +1,177 @@
+package server
+
+import (
+	amextraction "monorepo/bin-ai-manager/models/extraction"
+	"monorepo/bin-api-manager/gens/openapi_server"
+	cerrors "monorepo/bin-common-handler/models/errors"
+	commonoutline "monorepo/bin-common-handler/models/outline"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+func (h *server) PostAiextractions(c *gin.Context) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":            "PostAiextractions",
+		"request_address": c.ClientIP,
+	})
+
+	a, ok := getAuthIdentity(c)
+	if !ok {
+		log.Errorf("Could not find auth identity.")
+		abortWithError(c, cerrors.Unauthenticated(commonoutline.ServiceNameAPIManager, "AUTHENTICATION_REQUIRED", "Authentication is required."))
+		return
+	}
+	log = log.WithFields(logrus.Fields{
+		"auth": a,
+	})
+
+	var req openapi_server.PostAiextractionsJSONBody
+	if err := c.BindJSON(&req); err != nil {
+		log.Errorf("Could not parse the request. err: %v", err)
+		abortWithError(c, cerrors.InvalidArgument(commonoutline.ServiceNameAPIManager, "INVALID_JSON_BODY", "The request body is not valid JSON.").Wrap(err))
+		return
+	}
+
+	onEndFlowID := uuid.Nil
+	if req.OnEndFlowId != nil {
+		onEndFlowID = uuid.FromStringOrNil(*req.OnEndFlowId)
+	}
+	referenceID := uuid.FromStringOrNil(req.ReferenceId)
+
+	language := ""
+	if req.Language != nil {
+		language = *req.Language
+	}
+
+	setVariables := false
+	if req.SetVariables != nil {
+		setVariables = *req.SetVariables
+	}
+
+	res, err := h.serviceHandler.AIExtractionCreate(c.Request.Context(), a, onEndFlowID, amextraction.ReferenceType(req.ReferenceType), referenceID, language, req.Schema, setVariables)
+	if err != nil {
+		log.Errorf("Could not create an ai extraction. err: %v", err)
+		abortWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(200, res)
+}
+
+func (h *server) GetAiextractions(c *gin.Context, params openapi_server.GetAiextractionsParams) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":            "GetAiextractions",
+		"request_address": c.ClientIP,
+	})
+
+	a, ok := getAuthIdentity(c)
+	if !ok {
+		log.Errorf("Could not find auth identity.")
+		abortWithError(c, cerrors.Unauthenticated(commonoutline.ServiceNameAPIManager, "AUTHENTICATION_REQUIRED", "Authentication is required."))
+		return
+	}
+	log = log.WithFields(logrus.Fields{
+		"auth": a,
+	})
+
+	pageSize := uint64(100)
+	if params.PageSize != nil {
+		pageSize = uint64(*params.PageSize)
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 100
+		log.Debugf("Invalid requested page size. Set to default. page_size: %d", pageSize)
+	}
+
+	pageToken := ""
+	if params.PageToken != nil {
+		pageToken = *params.PageToken
+	}
+
+	tmps, err := h.serviceHandler.AIExtractionGetsByCustomerID(c.Request.Context(), a, pageSize, pageToken)
+	if err != nil {
+		log.Errorf("Could not get an ai extraction list. err: %v", err)
+		abortWithServiceError(c, err)
+		return
+	}
+
+	nextToken := ""
+	if len(tmps) > 0 {
+		if tmps[len(tmps)-1].TMCreate != nil {
+			nextToken = tmps[len(tmps)-1].TMCreate.UTC().Format("2006-01-02T15:04:05.000000Z")
+		}
+	}
+
+	res := GenerateListResponse(tmps, nextToken)
+	c.JSON(200, res)
+}
+
+func (h *server) GetAiextractionsId(c *gin.Context, id string) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":            "GetAiextractionsId",
+		"request_address": c.ClientIP,
+		"extraction_id":   id,
+	})
+
+	a, ok := getAuthIdentity(c)
+	if !ok {
+		log.Errorf("Could not find auth identity.")
+		abortWithError(c, cerrors.Unauthenticated(commonoutline.ServiceNameAPIManager, "AUTHENTICATION_REQUIRED", "Authentication is required."))
+		return
+	}
+	log = log.WithFields(logrus.Fields{
+		"auth": a,
+	})
+
+	target := uuid.FromStringOrNil(id)
+	if target == uuid.Nil {
+		log.Error("Could not parse the id.")
+		abortWithError(c, cerrors.InvalidArgument(commonoutline.ServiceNameAPIManager, "INVALID_ID", "The provided id is not a valid UUID."))
+		return
+	}
+
+	res, err := h.serviceHandler.AIExtractionGet(c.Request.Context(), a, target)
+	if err != nil {
+		log.Errorf("Could not get an ai extraction. err: %v", err)
+		abortWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(200, res)
+}
+
+func (h *server) DeleteAiextractionsId(c *gin.Context, id string) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":            "DeleteAiextractionsId",
+		"request_address": c.ClientIP,
+		"extraction_id":   id,
+	})
+
+	a, ok := getAuthIdentity(c)
+	if !ok {
+		log.Errorf("Could not find auth identity.")
+		abortWithError(c, cerrors.Unauthenticated(commonoutline.ServiceNameAPIManager, "AUTHENTICATION_REQUIRED", "Authentication is required."))
+		return
+	}
+	log = log.WithFields(logrus.Fields{
+		"auth": a,
+	})
+
+	target := uuid.FromStringOrNil(id)
+	if target == uuid.Nil {
+		log.Error("Could not parse the id.")
+		abortWithError(c, cerrors.InvalidArgument(commonoutline.ServiceNameAPIManager, "INVALID_ID", "The provided id is not a valid UUID."))
+		return
+	}
+
+	res, err := h.serviceHandler.AIExtractionDelete(c.Request.Context(), a, target)
+	if err != nil {
+		log.Errorf("Could not delete the ai extraction. err: %v", err)
+		abortWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(200, res)
+}
@@ -0,0 +1,323 @@
+package server
+
+import (
+	"bytes"
+	amagent "monorepo/bin-agent-manager/models/agent"
+	amextraction "monorepo/bin-ai-manager/models/extraction"
+	"monorepo/bin-api-manager/gens/openapi_server"
+	"monorepo/bin-api-manager/models/auth"
+	"monorepo/bin-api-manager/pkg/servicehandler"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_PostAiextractions(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		agent *auth.AuthIdentity
+
+		reqQuery string
+		reqBody  []byte
+
+		responseAIextraction *amextraction.WebhookMessage
+
+		expectedLanguage      string
+		expectedOnEndFlowID   uuid.UUID
+		expectedReferenceType amextraction.ReferenceType
+		expectedReferenceID   uuid.UUID
+		expectedSchema        map[string]any
+		expectedSetVariables  bool
+		expectedRes           string
+	}{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("2a2ec0ba-8004-11ec-aea5-439829c92a7c"),
+				},
+			}),
+
+			reqQuery: "/aiextractions",
+			reqBody:  []byte(`{"language":"en-US","on_end_flow_id":"fb095e86-cbca-11f1-b380-02fc00000001","reference_type":"call","reference_id":"fb095fda-cbca-11f1-b380-02fc00000001","schema":{"type":"object","properties":{"intent":{"type":"string"}}},"set_variables":true}`),
+
+			responseAIextraction: &amextraction.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("fb0960c0-cbca-11f1-b380-02fc00000001"),
+				},
+			},
+
+			expectedLanguage:      "en-US",
+			expectedOnEndFlowID:   uuid.FromStringOrNil("fb095e86-cbca-11f1-b380-02fc00000001"),
+			expectedReferenceType: amextraction.ReferenceTypeCall,
+			expectedReferenceID:   uuid.FromStringOrNil("fb095fda-cbca-11f1-b380-02fc00000001"),
+			expectedSchema: map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"intent": map[string]any{"type": "string"},
+				},
+			},
+			expectedSetVariables: true,
+			expectedRes:          `{"id":"fb0960c0-cbca-11f1-b380-02fc00000001","customer_id":"00000000-0000-0000-0000-000000000000","activeflow_id":"00000000-0000-0000-0000-000000000000","on_end_flow_id":"00000000-0000-0000-0000-000000000000","reference_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// create mock
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSvc := servicehandler.NewMockServiceHandler(mc)
+			h := &server{
+				serviceHandler: mockSvc,
+			}
+
+			w := httptest.NewRecorder()
+			_, r := gin.CreateTestContext(w)
+
+			r.Use(func(c *gin.Context) {
+				c.Set("auth_identity", tt.agent)
+			})
+			openapi_server.RegisterHandlers(r, h)
+
+			req, _ := http.NewRequest("POST", tt.reqQuery, bytes.NewBuffer(tt.reqBody))
+			req.Header.Set("Content-Type", "application/json")
+			mockSvc.EXPECT().AIExtractionCreate(
+				req.Context(),
+				tt.agent,
+				tt.expectedOnEndFlowID,
+				tt.expectedReferenceType,
+				tt.expectedReferenceID,
+				tt.expectedLanguage,
+				tt.expectedSchema,
+				tt.expectedSetVariables,
+			).Return(tt.responseAIextraction, nil)
+
+			r.ServeHTTP(w, req)
+			if w.Code != http.StatusOK {
+				t.Errorf("Wrong match. expect: %d, got: %d", http.StatusOK, w.Code)
+			}
+
+			if w.Body.String() != tt.expectedRes {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectedRes, w.Body)
+			}
+		})
+	}
+}
+
+func Test_GetAiextractions(t *testing.T) {
+
+	type test struct {
+		name  string
+		agent *auth.AuthIdentity
+
+		reqQuery string
+
+		responseAIsummaries []*amextraction.WebhookMessage
+
+		expectedPageSize  uint64
+		expectedPageToken string
+		expectedRes       string
+	}
+
+	tests := []test{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("fb096412-cbca-11f1-b380-02fc00000001"),
+				},
+			}),
+
+			reqQuery: "/aiextractions?page_size=10&page_token=2020-09-20T03:23:20.995000Z",
+
+			responseAIsummaries: []*amextraction.WebhookMessage{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("fb09653e-cbca-11f1-b380-02fc00000001"),
+					},
+					TMCreate: timePtr("2020-09-20T03:23:21.995000Z"),
+				},
+			},
+			expectedPageSize:  10,
+			expectedPageToken: "2020-09-20T03:23:20.995000Z",
+			expectedRes:       `{"result":[{"id":"fb09653e-cbca-11f1-b380-02fc00000001","customer_id":"00000000-0000-0000-0000-000000000000","activeflow_id":"00000000-0000-0000-0000-000000000000","on_end_flow_id":"00000000-0000-0000-0000-000000000000","reference_id":"00000000-0000-0000-0000-000000000000","tm_create":"2020-09-20T03:23:21.995Z","tm_update":null,"tm_delete":null}],"next_page_token":"2020-09-20T03:23:21.995000Z"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// create mock
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSvc := servicehandler.NewMockServiceHandler(mc)
+			h := &server{
+				serviceHandler: mockSvc,
+			}
+
+			w := httptest.NewRecorder()
+			_, r := gin.CreateTestContext(w)
+
+			r.Use(func(c *gin.Context) {
+				c.Set("auth_identity", tt.agent)
+			})
+			openapi_server.RegisterHandlers(r, h)
+
+			req, _ := http.NewRequest("GET", tt.reqQuery, nil)
+			mockSvc.EXPECT().AIExtractionGetsByCustomerID(req.Context(), tt.agent, tt.expectedPageSize, tt.expectedPageToken).Return(tt.responseAIsummaries, nil)
+
+			r.ServeHTTP(w, req)
+			if w.Code != http.StatusOK {
+				t.Errorf("Wrong match. expect: %d, got: %d", http.StatusOK, w.Code)
+			}
+
+			if w.Body.String() != tt.expectedRes {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectedRes, w.Body)
+			}
+		})
+	}
+}
+
+func Test_GetAiextractionsId(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		agent *auth.AuthIdentity
+
+		reqQuery string
+
+		responseAI *amextraction.WebhookMessage
+
+		expectAIID uuid.UUID
+		expectRes  string
+	}{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("2a2ec0ba-8004-11ec-aea5-439829c92a7c"),
+				},
+			}),
+
+			reqQuery: "/aiextractions/fb09680e-cbca-11f1-b380-02fc00000001",
+
+			responseAI: &amextraction.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("fb09680e-cbca-11f1-b380-02fc00000001"),
+				},
+			},
+
+			expectAIID: uuid.FromStringOrNil("fb09680e-cbca-11f1-b380-02fc00000001"),
+			expectRes:  `{"id":"fb09680e-cbca-11f1-b380-02fc00000001","customer_id":"00000000-0000-0000-0000-000000000000","activeflow_id":"00000000-0000-0000-0000-000000000000","on_end_flow_id":"00000000-0000-0000-0000-000000000000","reference_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// create mock
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSvc := servicehandler.NewMockServiceHandler(mc)
+			h := &server{
+				serviceHandler: mockSvc,
+			}
+
+			w := httptest.NewRecorder()
+			_, r := gin.CreateTestContext(w)
+
+			r.Use(func(c *gin.Context) {
+				c.Set("auth_identity", tt.agent)
+			})
+			openapi_server.RegisterHandlers(r, h)
+
+			req, _ := http.NewRequest("GET", tt.reqQuery, nil)
+			mockSvc.EXPECT().AIExtractionGet(req.Context(), tt.agent, tt.expectAIID).Return(tt.responseAI, nil)
+
+			r.ServeHTTP(w, req)
+			if w.Code != http.StatusOK {
+				t.Errorf("Wrong match. expect: %d, got: %d", http.StatusOK, w.Code)
+			}
+
+			if w.Body.String() != tt.expectRes {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, w.Body)
+			}
+		})
+	}
+}
+
+func Test_DeleteAiextractionsId(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		agent *auth.AuthIdentity
+
+		reqQuery string
+
+		responseAI *amextraction.WebhookMessage
+
+		expectAIID uuid.UUID
+		expectRes  string
+	}{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("2a2ec0ba-8004-11ec-aea5-439829c92a7c"),
+				},
+			}),
+
+			reqQuery: "/aiextractions/fb096ab6-cbca-11f1-b380-02fc00000001",
+
+			responseAI: &amextraction.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("fb096ab6-cbca-11f1-b380-02fc00000001"),
+				},
+			},
+
+			expectAIID: uuid.FromStringOrNil("fb096ab6-cbca-11f1-b380-02fc00000001"),
+			expectRes:  `{"id":"fb096ab6-cbca-11f1-b380-02fc00000001","customer_id":"00000000-0000-0000-0000-000000000000","activeflow_id":"00000000-0000-0000-0000-000000000000","on_end_flow_id":"00000000-0000-0000-0000-000000000000","reference_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// create mock
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSvc := servicehandler.NewMockServiceHandler(mc)
+			h := &server{
+				serviceHandler: mockSvc,
+			}
+
+			w := httptest.NewRecorder()
+			_, r := gin.CreateTestContext(w)
+
+			r.Use(func(c *gin.Context) {
+				c.Set("auth_identity", tt.agent)
+			})
+			openapi_server.RegisterHandlers(r, h)
+
+			req, _ := http.NewRequest("DELETE", tt.reqQuery, nil)
+			mockSvc.EXPECT().AIExtractionDelete(req.Context(), tt.agent, tt.expectAIID).Return(tt.responseAI, nil)
+
+			r.ServeHTTP(w, req)
+			if w.Code != http.StatusOK {
+				t.Errorf("Wrong match. expect: %d, got: %d", http.StatusOK, w.Code)
+			}
+
+			if w.Body.String() != tt.expectRes {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, w.Body)
+			}
+		})
+	}
+}
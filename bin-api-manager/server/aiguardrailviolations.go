@@ -0,0 +1,103 @@
+package server
+
+import (
+	"monorepo/bin-api-manager/gens/openapi_server"
+	cerrors "monorepo/bin-common-handler/models/errors"
+	commonoutline "monorepo/bin-common-handler/models/outline"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+func (h *server) GetAiguardrailviolations(c *gin.Context, params openapi_server.GetAiguardrailviolationsParams) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":            "GetAiguardrailviolations",
+		"request_address": c.ClientIP,
+	})
+
+	a, ok := getAuthIdentity(c)
+	if !ok {
+		log.Errorf("Could not find auth identity.")
+		abortWithError(c, cerrors.Unauthenticated(commonoutline.ServiceNameAPIManager, "AUTHENTICATION_REQUIRED", "Authentication is required."))
+		return
+	}
+	log = log.WithFields(logrus.Fields{
+		"auth": a,
+	})
+
+	pageSize := uint64(100)
+	if params.PageSize != nil {
+		pageSize = uint64(*params.PageSize)
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 100
+		log.Debugf("Invalid requested page size. Set to default. page_size: %d", pageSize)
+	}
+
+	pageToken := ""
+	if params.PageToken != nil {
+		pageToken = *params.PageToken
+	}
+
+	aicallID := uuid.Nil
+	if params.AicallId != nil {
+		aicallID = uuid.UUID(*params.AicallId)
+	}
+
+	aiID := uuid.Nil
+	if params.AiId != nil {
+		aiID = uuid.UUID(*params.AiId)
+	}
+
+	tmps, err := h.serviceHandler.AIGuardrailViolationGetsByCustomerID(c.Request.Context(), a, pageSize, pageToken, aicallID, aiID)
+	if err != nil {
+		log.Errorf("Could not get AI guardrail violation list. err: %v", err)
+		abortWithServiceError(c, err)
+		return
+	}
+
+	nextToken := ""
+	if len(tmps) > 0 {
+		if tmps[len(tmps)-1].TMCreate != nil {
+			nextToken = tmps[len(tmps)-1].TMCreate.UTC().Format("2006-01-02T15:04:05.000000Z")
+		}
+	}
+
+	res := GenerateListResponse(tmps, nextToken)
+	c.JSON(200, res)
+}
+
+func (h *server) GetAiguardrailviolationsId(c *gin.Context, id string) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":                   "GetAiguardrailviolationsId",
+		"request_address":        c.ClientIP,
+		"guardrail_violation_id": id,
+	})
+
+	a, ok := getAuthIdentity(c)
+	if !ok {
+		log.Errorf("Could not find auth identity.")
+		abortWithError(c, cerrors.Unauthenticated(commonoutline.ServiceNameAPIManager, "AUTHENTICATION_REQUIRED", "Authentication is required."))
+		return
+	}
+	log = log.WithFields(logrus.Fields{
+		"auth": a,
+	})
+
+	target := uuid.FromStringOrNil(id)
+	if target == uuid.Nil {
+		log.Error("Could not parse the id.")
+		abortWithError(c, cerrors.InvalidArgument(commonoutline.ServiceNameAPIManager, "INVALID_ID", "The provided id is not a valid UUID."))
+		return
+	}
+
+	res, err := h.serviceHandler.AIGuardrailViolationGet(c.Request.Context(), a, target)
+	if err != nil {
+		log.Errorf("Could not get AI guardrail violation. err: %v", err)
+		abortWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(200, res)
+}
@@ -0,0 +1,170 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	amagent "monorepo/bin-agent-manager/models/agent"
+	amguardrailviolation "monorepo/bin-ai-manager/models/guardrailviolation"
+	"monorepo/bin-api-manager/gens/openapi_server"
+	"monorepo/bin-api-manager/models/auth"
+	"monorepo/bin-api-manager/pkg/servicehandler"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_GetAiguardrailviolations(t *testing.T) {
+	tests := []struct {
+		name  string
+		agent *auth.AuthIdentity
+
+		reqQuery string
+
+		responseViolations []*amguardrailviolation.WebhookMessage
+
+		expectedPageSize  uint64
+		expectedPageToken string
+		expectedAicallID  uuid.UUID
+		expectedAIID      uuid.UUID
+		expectedRes       string
+	}{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("e1a2b3c4-ad1b-11f0-8a5e-3c7f9b1d2e40"),
+				},
+			}),
+
+			reqQuery: "/aiguardrailviolations?page_size=10&page_token=2020-09-20T03:23:20.995000Z&aicall_id=e1d4e5f6-ad1b-11f0-b2c7-5e9a1c3f4b51",
+
+			responseViolations: []*amguardrailviolation.WebhookMessage{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("e2071829-ad1b-11f0-9d3e-7a2c4e6f8b62"),
+					},
+					Rule:     amguardrailviolation.RuleBlockedTopic,
+					TMCreate: timePtr("2020-09-20T03:23:21.995000Z"),
+				},
+			},
+
+			expectedPageSize:  10,
+			expectedPageToken: "2020-09-20T03:23:20.995000Z",
+			expectedAicallID:  uuid.FromStringOrNil("e1d4e5f6-ad1b-11f0-b2c7-5e9a1c3f4b51"),
+			expectedAIID:      uuid.Nil,
+			expectedRes:       `{"result":[{"id":"e2071829-ad1b-11f0-9d3e-7a2c4e6f8b62","customer_id":"00000000-0000-0000-0000-000000000000","aicall_id":"00000000-0000-0000-0000-000000000000","ai_id":"00000000-0000-0000-0000-000000000000","activeflow_id":"00000000-0000-0000-0000-000000000000","rule":"blocked_topic","tm_create":"2020-09-20T03:23:21.995Z","tm_update":null,"tm_delete":null}],"next_page_token":"2020-09-20T03:23:21.995000Z"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSvc := servicehandler.NewMockServiceHandler(mc)
+			h := &server{
+				serviceHandler: mockSvc,
+			}
+
+			w := httptest.NewRecorder()
+			_, r := gin.CreateTestContext(w)
+
+			r.Use(func(c *gin.Context) {
+				c.Set("auth_identity", tt.agent)
+			})
+			openapi_server.RegisterHandlers(r, h)
+
+			req, _ := http.NewRequest("GET", tt.reqQuery, nil)
+			mockSvc.EXPECT().AIGuardrailViolationGetsByCustomerID(
+				req.Context(),
+				tt.agent,
+				tt.expectedPageSize,
+				tt.expectedPageToken,
+				tt.expectedAicallID,
+				tt.expectedAIID,
+			).Return(tt.responseViolations, nil)
+
+			r.ServeHTTP(w, req)
+			if w.Code != http.StatusOK {
+				t.Errorf("Wrong match. expect: %d, got: %d", http.StatusOK, w.Code)
+			}
+
+			if w.Body.String() != tt.expectedRes {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectedRes, w.Body)
+			}
+		})
+	}
+}
+
+func Test_GetAiguardrailviolationsId(t *testing.T) {
+	tests := []struct {
+		name  string
+		agent *auth.AuthIdentity
+
+		reqQuery string
+
+		responseViolation *amguardrailviolation.WebhookMessage
+
+		expectedID  uuid.UUID
+		expectedRes string
+	}{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("e2398a4b-ad1b-11f0-a4f1-9c3e5a7b1d73"),
+				},
+			}),
+
+			reqQuery: "/aiguardrailviolations/e26bfc6d-ad1b-11f0-8b52-1e4a6c8d2f84",
+
+			responseViolation: &amguardrailviolation.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("e26bfc6d-ad1b-11f0-8b52-1e4a6c8d2f84"),
+				},
+				Rule:    amguardrailviolation.RuleForbiddenPhrase,
+				Detail:  "guaranteed return",
+				Content: "this is a guaranteed return",
+			},
+
+			expectedID:  uuid.FromStringOrNil("e26bfc6d-ad1b-11f0-8b52-1e4a6c8d2f84"),
+			expectedRes: `{"id":"e26bfc6d-ad1b-11f0-8b52-1e4a6c8d2f84","customer_id":"00000000-0000-0000-0000-000000000000","aicall_id":"00000000-0000-0000-0000-000000000000","ai_id":"00000000-0000-0000-0000-000000000000","activeflow_id":"00000000-0000-0000-0000-000000000000","rule":"forbidden_phrase","detail":"guaranteed return","content":"this is a guaranteed return","tm_create":null,"tm_update":null,"tm_delete":null}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSvc := servicehandler.NewMockServiceHandler(mc)
+			h := &server{
+				serviceHandler: mockSvc,
+			}
+
+			w := httptest.NewRecorder()
+			_, r := gin.CreateTestContext(w)
+
+			r.Use(func(c *gin.Context) {
+				c.Set("auth_identity", tt.agent)
+			})
+			openapi_server.RegisterHandlers(r, h)
+
+			req, _ := http.NewRequest("GET", tt.reqQuery, nil)
+			mockSvc.EXPECT().AIGuardrailViolationGet(req.Context(), tt.agent, tt.expectedID).Return(tt.responseViolation, nil)
+
+			r.ServeHTTP(w, req)
+			if w.Code != http.StatusOK {
+				t.Errorf("Wrong match. expect: %d, got: %d", http.StatusOK, w.Code)
+			}
+
+			if w.Body.String() != tt.expectedRes {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectedRes, w.Body)
+			}
+		})
+	}
+}
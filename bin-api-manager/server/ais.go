@@ -2,6 +2,9 @@ package server
 
 import (
 	amai "monorepo/bin-ai-manager/models/ai"
+	amguardrail "monorepo/bin-ai-manager/models/guardrail"
+	amredaction "monorepo/bin-ai-manager/models/redaction"
+	amresponsecache "monorepo/bin-ai-manager/models/responsecache"
 	amtool "monorepo/bin-ai-manager/models/tool"
 	"monorepo/bin-api-manager/gens/openapi_server"
 	cerrors "monorepo/bin-common-handler/models/errors"
@@ -82,6 +85,10 @@ func (h *server) PostAis(c *gin.Context) {
 		sttLanguage,
 		toolNames,
 		autoAICallAuditEnabled,
+		convertAIEngineFallbacks(req.EngineFallbacks),
+		convertAIRedaction(req.Redaction),
+		convertAIGuardrail(req.Guardrail),
+		convertAIResponseCache(req.ResponseCache),
 	)
 	if err != nil {
 		log.Errorf("Could not create a AI. err: %v", err)
@@ -286,6 +293,10 @@ func (h *server) PutAisId(c *gin.Context, id string) {
 		sttLanguage,
 		toolNames,
 		autoAICallAuditEnabled,
+		convertAIEngineFallbacks(req.EngineFallbacks),
+		convertAIRedaction(req.Redaction),
+		convertAIGuardrail(req.Guardrail),
+		convertAIResponseCache(req.ResponseCache),
 	)
 	if err != nil {
 		log.Errorf("Could not update the ai. err: %v", err)
@@ -415,3 +426,109 @@ func (h *server) GetAisIdParticipants(c *gin.Context, id openapi_types.UUID, par
 	res := GenerateListResponse(tmps, nextToken)
 	c.JSON(200, res)
 }
+
+// convertAIEngineFallbacks converts the request's engine fallbacks to the ai-manager model.
+func convertAIEngineFallbacks(fallbacks *[]openapi_server.AIManagerAIEngineFallback) []amai.EngineFallback {
+	if fallbacks == nil {
+		return nil
+	}
+
+	res := make([]amai.EngineFallback, 0, len(*fallbacks))
+	for _, f := range *fallbacks {
+		tmp := amai.EngineFallback{
+			EngineModel: amai.EngineModel(f.EngineModel),
+		}
+		if f.EngineKey != nil {
+			tmp.EngineKey = *f.EngineKey
+		}
+		res = append(res, tmp)
+	}
+
+	return res
+}
+
+// convertAIRedaction converts the request's redaction config to the ai-manager model.
+func convertAIRedaction(r *openapi_server.AIManagerRedaction) *amredaction.Config {
+	if r == nil {
+		return nil
+	}
+
+	res := &amredaction.Config{
+		EntityTypes: []amredaction.EntityType{},
+	}
+	if r.EntityTypes != nil {
+		for _, t := range *r.EntityTypes {
+			res.EntityTypes = append(res.EntityTypes, amredaction.EntityType(t))
+		}
+	}
+	if r.Mode != nil {
+		res.Mode = amredaction.Mode(*r.Mode)
+	}
+	if r.ToolAccess != nil {
+		res.ToolAccess = *r.ToolAccess
+	}
+
+	return res
+}
+
+// convertAIGuardrail converts the request's guardrail config to the ai-manager model.
+func convertAIGuardrail(g *openapi_server.AIManagerGuardrail) *amguardrail.Config {
+	if g == nil {
+		return nil
+	}
+
+	res := &amguardrail.Config{}
+	if g.BlockedTopics != nil {
+		res.BlockedTopics = *g.BlockedTopics
+	}
+	if g.ForbiddenPhrases != nil {
+		res.ForbiddenPhrases = *g.ForbiddenPhrases
+	}
+	if g.Disclaimer != nil {
+		res.Disclaimer = *g.Disclaimer
+	}
+	if g.MaxConsecutiveToolCalls != nil {
+		res.MaxConsecutiveToolCalls = *g.MaxConsecutiveToolCalls
+	}
+
+	if g.Escalation != nil {
+		res.Escalation = &amguardrail.Escalation{}
+		if g.Escalation.Action != nil {
+			res.Escalation.Action = amguardrail.EscalationAction(*g.Escalation.Action)
+		}
+		if g.Escalation.QueueId != nil {
+			res.Escalation.QueueID = uuid.FromStringOrNil(*g.Escalation.QueueId)
+		}
+		if g.Escalation.Message != nil {
+			res.Escalation.Message = *g.Escalation.Message
+		}
+	}
+
+	return res
+}
+
+// convertAIResponseCache converts the request's response cache config to the ai-manager model.
+func convertAIResponseCache(r *openapi_server.AIManagerResponseCache) *amresponsecache.Config {
+	if r == nil {
+		return nil
+	}
+
+	res := &amresponsecache.Config{}
+	if r.Enabled != nil {
+		res.Enabled = *r.Enabled
+	}
+	if r.Threshold != nil {
+		res.Threshold = *r.Threshold
+	}
+	if r.Ttl != nil {
+		res.TTL = *r.Ttl
+	}
+	if r.Turns != nil {
+		res.Turns = *r.Turns
+	}
+	if r.Variables != nil {
+		res.Variables = *r.Variables
+	}
+
+	return res
+}
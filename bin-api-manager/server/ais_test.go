@@ -9,6 +9,9 @@ import (
 
 	amagent "monorepo/bin-agent-manager/models/agent"
 	amai "monorepo/bin-ai-manager/models/ai"
+	amguardrail "monorepo/bin-ai-manager/models/guardrail"
+	amredaction "monorepo/bin-ai-manager/models/redaction"
+	amresponsecache "monorepo/bin-ai-manager/models/responsecache"
 	amtool "monorepo/bin-ai-manager/models/tool"
 	"monorepo/bin-api-manager/gens/openapi_server"
 	"monorepo/bin-api-manager/lib/middleware"
@@ -33,20 +36,24 @@ func Test_PostAis(t *testing.T) {
 
 		responseAI *amai.WebhookMessage
 
-		expectedName        string
-		expectedDetail      string
-		expectedAIType      amai.Type
-		expectedEngineModel amai.EngineModel
-		expectedParameter   map[string]any
-		expectedEngineKey   string
-		expectedInitPrompt  string
-		expectedTTSType     amai.TTSType
-		expectedTTSVoiceID  string
-		expectedSTTType     amai.STTType
-		expectedSTTLanguage string
-		expectedRagID       uuid.UUID
-		expectedToolNames   []amtool.ToolName
-		expectedRes         string
+		expectedName          string
+		expectedDetail        string
+		expectedAIType        amai.Type
+		expectedEngineModel   amai.EngineModel
+		expectedParameter     map[string]any
+		expectedEngineKey     string
+		expectedInitPrompt    string
+		expectedTTSType       amai.TTSType
+		expectedTTSVoiceID    string
+		expectedSTTType       amai.STTType
+		expectedSTTLanguage   string
+		expectedRagID         uuid.UUID
+		expectedToolNames     []amtool.ToolName
+		expectedFallbacks     []amai.EngineFallback
+		expectedRedaction     *amredaction.Config
+		expectedGuardrail     *amguardrail.Config
+		expectedResponseCache *amresponsecache.Config
+		expectedRes           string
 	}{
 		{
 			name: "normal without tool_names",
@@ -246,6 +253,148 @@ func Test_PostAis(t *testing.T) {
 			expectedToolNames:   nil,
 			expectedRes:         `{"id":"dbceb866-4506-4e86-9851-a82d4d3ced88","customer_id":"00000000-0000-0000-0000-000000000000","is_insight_active":false,"rag_id":"00000000-0000-0000-0000-000000000000","current_prompt_history_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`,
 		},
+		{
+			name: "with engine_fallbacks",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("2a2ec0ba-8004-11ec-aea5-439829c92a7c"),
+				},
+			}),
+
+			reqQuery: "/ais",
+			reqBody:  []byte(`{"name":"test name","detail":"test detail","engine_model":"openai.gpt-5","parameter":{"key1":"val1"},"engine_key":"test engine key","engine_fallbacks":[{"engine_model":"gemini.gemini-2.5-flash","engine_key":"fallback engine key"},{"engine_model":"openai.gpt-5-mini"}],"init_prompt":"test init prompt","tts_type":"elevenlabs","tts_voice_id":"test voice id","stt_type":"cartesia"}`),
+
+			responseAI: &amai.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("dbceb866-4506-4e86-9851-a82d4d3ced88"),
+				},
+			},
+
+			expectedName:        "test name",
+			expectedDetail:      "test detail",
+			expectedEngineModel: amai.EngineModelOpenaiGPT5,
+			expectedParameter: map[string]any{
+				"key1": "val1",
+			},
+			expectedEngineKey:  "test engine key",
+			expectedInitPrompt: "test init prompt",
+			expectedTTSType:    amai.TTSTypeElevenLabs,
+			expectedTTSVoiceID: "test voice id",
+			expectedSTTType:    amai.STTTypeCartesia,
+			expectedRagID:      uuid.Nil,
+			expectedFallbacks: []amai.EngineFallback{
+				{EngineModel: amai.EngineModelGeminiGemini2Dot5Flash, EngineKey: "fallback engine key"},
+				{EngineModel: amai.EngineModelOpenaiGPT5Mini},
+			},
+			expectedRes: `{"id":"dbceb866-4506-4e86-9851-a82d4d3ced88","customer_id":"00000000-0000-0000-0000-000000000000","is_insight_active":false,"rag_id":"00000000-0000-0000-0000-000000000000","current_prompt_history_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`,
+		},
+		{
+			name: "with redaction",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("2a2ec0ba-8004-11ec-aea5-439829c92a7c"),
+				},
+			}),
+
+			reqQuery: "/ais",
+			reqBody:  []byte(`{"name":"test name","detail":"test detail","engine_model":"openai.gpt-5","engine_key":"test engine key","redaction":{"entity_types":["credit_card","email"],"mode":"tokenize","tool_access":true},"init_prompt":"test init prompt","tts_type":"elevenlabs","tts_voice_id":"test voice id","stt_type":"cartesia"}`),
+
+			responseAI: &amai.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("dbceb866-4506-4e86-9851-a82d4d3ced88"),
+				},
+			},
+
+			expectedName:        "test name",
+			expectedDetail:      "test detail",
+			expectedEngineModel: amai.EngineModelOpenaiGPT5,
+			expectedEngineKey:   "test engine key",
+			expectedInitPrompt:  "test init prompt",
+			expectedTTSType:     amai.TTSTypeElevenLabs,
+			expectedTTSVoiceID:  "test voice id",
+			expectedSTTType:     amai.STTTypeCartesia,
+			expectedRagID:       uuid.Nil,
+			expectedRedaction: &amredaction.Config{
+				EntityTypes: []amredaction.EntityType{amredaction.EntityTypeCreditCard, amredaction.EntityTypeEmail},
+				Mode:        amredaction.ModeTokenize,
+				ToolAccess:  true,
+			},
+			expectedRes: `{"id":"dbceb866-4506-4e86-9851-a82d4d3ced88","customer_id":"00000000-0000-0000-0000-000000000000","is_insight_active":false,"rag_id":"00000000-0000-0000-0000-000000000000","current_prompt_history_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`,
+		},
+		{
+			name: "with guardrail",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("2a2ec0ba-8004-11ec-aea5-439829c92a7c"),
+				},
+			}),
+
+			reqQuery: "/ais",
+			reqBody:  []byte(`{"name":"test name","detail":"test detail","engine_model":"openai.gpt-5","engine_key":"test engine key","guardrail":{"blocked_topics":["politics"],"forbidden_phrases":["guaranteed return"],"disclaimer":"This call is recorded.","max_consecutive_tool_calls":3,"escalation":{"action":"transfer","queue_id":"5c1e7a3b-ad1a-11f0-9d4f-2b6e8c1a3f50","message":"Let me transfer you."}},"init_prompt":"test init prompt","tts_type":"elevenlabs","tts_voice_id":"test voice id","stt_type":"cartesia"}`),
+
+			responseAI: &amai.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("dbceb866-4506-4e86-9851-a82d4d3ced88"),
+				},
+			},
+
+			expectedName:        "test name",
+			expectedDetail:      "test detail",
+			expectedEngineModel: amai.EngineModelOpenaiGPT5,
+			expectedEngineKey:   "test engine key",
+			expectedInitPrompt:  "test init prompt",
+			expectedTTSType:     amai.TTSTypeElevenLabs,
+			expectedTTSVoiceID:  "test voice id",
+			expectedSTTType:     amai.STTTypeCartesia,
+			expectedRagID:       uuid.Nil,
+			expectedGuardrail: &amguardrail.Config{
+				BlockedTopics:           []string{"politics"},
+				ForbiddenPhrases:        []string{"guaranteed return"},
+				Disclaimer:              "This call is recorded.",
+				MaxConsecutiveToolCalls: 3,
+				Escalation: &amguardrail.Escalation{
+					Action:  amguardrail.EscalationActionTransfer,
+					QueueID: uuid.FromStringOrNil("5c1e7a3b-ad1a-11f0-9d4f-2b6e8c1a3f50"),
+					Message: "Let me transfer you.",
+				},
+			},
+			expectedRes: `{"id":"dbceb866-4506-4e86-9851-a82d4d3ced88","customer_id":"00000000-0000-0000-0000-000000000000","is_insight_active":false,"rag_id":"00000000-0000-0000-0000-000000000000","current_prompt_history_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`,
+		},
+		{
+			name: "with response cache",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("2a2ec0ba-8004-11ec-aea5-439829c92a7c"),
+				},
+			}),
+
+			reqQuery: "/ais",
+			reqBody:  []byte(`{"name":"test name","detail":"test detail","engine_model":"openai.gpt-5","engine_key":"test engine key","response_cache":{"enabled":true,"threshold":0.9,"ttl":3600,"turns":2,"variables":["customer.plan"]},"init_prompt":"test init prompt","tts_type":"elevenlabs","tts_voice_id":"test voice id","stt_type":"cartesia"}`),
+
+			responseAI: &amai.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("dbceb866-4506-4e86-9851-a82d4d3ced88"),
+				},
+			},
+
+			expectedName:        "test name",
+			expectedDetail:      "test detail",
+			expectedEngineModel: amai.EngineModelOpenaiGPT5,
+			expectedEngineKey:   "test engine key",
+			expectedInitPrompt:  "test init prompt",
+			expectedTTSType:     amai.TTSTypeElevenLabs,
+			expectedTTSVoiceID:  "test voice id",
+			expectedSTTType:     amai.STTTypeCartesia,
+			expectedRagID:       uuid.Nil,
+			expectedResponseCache: &amresponsecache.Config{
+				Enabled:   true,
+				Threshold: 0.9,
+				TTL:       3600,
+				Turns:     2,
+				Variables: []string{"customer.plan"},
+			},
+			expectedRes: `{"id":"dbceb866-4506-4e86-9851-a82d4d3ced88","customer_id":"00000000-0000-0000-0000-000000000000","is_insight_active":false,"rag_id":"00000000-0000-0000-0000-000000000000","current_prompt_history_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`,
+		},
 	}
 
 	for _, tt := range tests {
@@ -286,6 +435,10 @@ func Test_PostAis(t *testing.T) {
 				tt.expectedSTTLanguage,
 				tt.expectedToolNames,
 				false, // autoAICallAuditEnabled
+				tt.expectedFallbacks,
+				tt.expectedRedaction,
+				tt.expectedGuardrail,
+				tt.expectedResponseCache,
 			).Return(tt.responseAI, nil)
 
 			r.ServeHTTP(w, req)
@@ -556,21 +709,25 @@ func Test_PutAisId(t *testing.T) {
 
 		responseAI *amai.WebhookMessage
 
-		expectedAIID        uuid.UUID
-		expectedName        string
-		expectedDetail      string
-		expectedAIType      amai.Type
-		epxectedEngineModel amai.EngineModel
-		expectedParameter   map[string]any
-		expectedEngineKey   string
-		expectedInitPrompt  string
-		expectedTTSType     amai.TTSType
-		expectedTTSVoiceID  string
-		expectedSTTType     amai.STTType
-		expectedSTTLanguage string
-		expectedRagID       uuid.UUID
-		expectedToolNames   []amtool.ToolName
-		expectedRes         string
+		expectedAIID          uuid.UUID
+		expectedName          string
+		expectedDetail        string
+		expectedAIType        amai.Type
+		epxectedEngineModel   amai.EngineModel
+		expectedParameter     map[string]any
+		expectedEngineKey     string
+		expectedInitPrompt    string
+		expectedTTSType       amai.TTSType
+		expectedTTSVoiceID    string
+		expectedSTTType       amai.STTType
+		expectedSTTLanguage   string
+		expectedRagID         uuid.UUID
+		expectedToolNames     []amtool.ToolName
+		expectedFallbacks     []amai.EngineFallback
+		expectedRedaction     *amredaction.Config
+		expectedGuardrail     *amguardrail.Config
+		expectedResponseCache *amresponsecache.Config
+		expectedRes           string
 	}{
 		{
 			name: "normal without tool_names",
@@ -783,6 +940,10 @@ func Test_PutAisId(t *testing.T) {
 				tt.expectedSTTLanguage,
 				tt.expectedToolNames,
 				false, // autoAICallAuditEnabled
+				tt.expectedFallbacks,
+				tt.expectedRedaction,
+				tt.expectedGuardrail,
+				tt.expectedResponseCache,
 			).Return(tt.responseAI, nil)
 
 			r.ServeHTTP(w, req)
@@ -3,6 +3,7 @@ package server
 import (
 	"monorepo/bin-api-manager/gens/openapi_server"
 	bmaccount "monorepo/bin-billing-manager/models/account"
+	bmbilling "monorepo/bin-billing-manager/models/billing"
 	cerrors "monorepo/bin-common-handler/models/errors"
 	commonoutline "monorepo/bin-common-handler/models/outline"
 
@@ -116,6 +117,69 @@ func (h *server) PutBillingAccountPaymentInfo(c *gin.Context) {
 	c.JSON(200, res)
 }
 
+func (h *server) PutBillingAccountSpendingSettings(c *gin.Context) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":            "PutBillingAccountSpendingSettings",
+		"request_address": c.ClientIP(),
+	})
+
+	a, ok := getAuthIdentity(c)
+	if !ok {
+		log.Errorf("Could not find auth identity.")
+		abortWithError(c, cerrors.Unauthenticated(commonoutline.ServiceNameAPIManager, "AUTHENTICATION_REQUIRED", "Authentication is required."))
+		return
+	}
+	log = log.WithField("auth", a)
+
+	var req openapi_server.PutBillingAccountSpendingSettingsJSONBody
+	if err := c.BindJSON(&req); err != nil {
+		log.Errorf("Could not parse the request. err: %v", err)
+		abortWithError(c, cerrors.InvalidArgument(commonoutline.ServiceNameAPIManager, "INVALID_JSON_BODY", "The request body is not valid JSON."))
+		return
+	}
+
+	spendLimits := []bmaccount.SpendLimit{}
+	if req.SpendLimits != nil {
+		for _, l := range *req.SpendLimits {
+			tmp := bmaccount.SpendLimit{}
+			if l.Period != nil {
+				tmp.Period = bmaccount.SpendLimitPeriod(*l.Period)
+			}
+			if l.CostType != nil {
+				tmp.CostType = bmbilling.CostType(*l.CostType)
+			}
+			if l.LimitCredit != nil {
+				tmp.LimitCredit = *l.LimitCredit
+			}
+			spendLimits = append(spendLimits, tmp)
+		}
+	}
+
+	balanceAlertThresholds := []int64{}
+	if req.BalanceAlertThresholds != nil {
+		balanceAlertThresholds = *req.BalanceAlertThresholds
+	}
+
+	var autoTopUpThreshold int64
+	if req.AutoTopupThreshold != nil {
+		autoTopUpThreshold = *req.AutoTopupThreshold
+	}
+
+	var autoTopUpAmount int64
+	if req.AutoTopupAmount != nil {
+		autoTopUpAmount = *req.AutoTopupAmount
+	}
+
+	res, err := h.serviceHandler.BillingAccountSelfUpdateSpendingSettings(c.Request.Context(), a, spendLimits, balanceAlertThresholds, autoTopUpThreshold, autoTopUpAmount)
+	if err != nil {
+		log.Errorf("Could not update spending settings. err: %v", err)
+		abortWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(200, res)
+}
+
 func (h *server) PostBillingAccountPaddlePortalSession(c *gin.Context) {
 	log := logrus.WithFields(logrus.Fields{
 		"func":            "PostBillingAccountPaddlePortalSession",
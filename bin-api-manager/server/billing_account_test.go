@@ -11,6 +11,7 @@ import (
 	"monorepo/bin-api-manager/models/auth"
 	"monorepo/bin-api-manager/pkg/servicehandler"
 	bmaccount "monorepo/bin-billing-manager/models/account"
+	bmbilling "monorepo/bin-billing-manager/models/billing"
 	commonidentity "monorepo/bin-common-handler/models/identity"
 
 	"github.com/gin-gonic/gin"
@@ -46,7 +47,7 @@ func TestGetBillingAccount(t *testing.T) {
 					ID: uuid.FromStringOrNil("602eb6b4-11eb-11ee-b79f-03124621dcc4"),
 				},
 			},
-			expectRes: `{"id":"602eb6b4-11eb-11ee-b79f-03124621dcc4","customer_id":"00000000-0000-0000-0000-000000000000","name":"","detail":"","plan_type":"","plan_status":"","balance_credit":0,"balance_token":0,"payment_type":"","payment_method":"","tm_last_topup":null,"tm_next_topup":null,"tm_create":null,"tm_update":null,"tm_delete":null}`,
+			expectRes: `{"id":"602eb6b4-11eb-11ee-b79f-03124621dcc4","customer_id":"00000000-0000-0000-0000-000000000000","name":"","detail":"","plan_type":"","plan_status":"","balance_credit":0,"balance_token":0,"payment_type":"","payment_method":"","auto_topup_threshold":0,"auto_topup_amount":0,"tm_last_topup":null,"tm_next_topup":null,"tm_create":null,"tm_update":null,"tm_delete":null}`,
 		},
 	}
 
@@ -120,7 +121,7 @@ func TestPutBillingAccount(t *testing.T) {
 
 			expectName:   "update name",
 			expectDetail: "update detail",
-			expectRes:    `{"id":"8d1d01bc-4cdd-11ee-a22f-03714037d3db","customer_id":"00000000-0000-0000-0000-000000000000","name":"","detail":"","plan_type":"","plan_status":"","balance_credit":0,"balance_token":0,"payment_type":"","payment_method":"","tm_last_topup":null,"tm_next_topup":null,"tm_create":null,"tm_update":null,"tm_delete":null}`,
+			expectRes:    `{"id":"8d1d01bc-4cdd-11ee-a22f-03714037d3db","customer_id":"00000000-0000-0000-0000-000000000000","name":"","detail":"","plan_type":"","plan_status":"","balance_credit":0,"balance_token":0,"payment_type":"","payment_method":"","auto_topup_threshold":0,"auto_topup_amount":0,"tm_last_topup":null,"tm_next_topup":null,"tm_create":null,"tm_update":null,"tm_delete":null}`,
 		},
 	}
 
@@ -197,7 +198,7 @@ func TestPutBillingAccountPaymentInfo(t *testing.T) {
 
 			expectPaymentType:   bmaccount.PaymentTypePrepaid,
 			expectPaymentMethod: bmaccount.PaymentMethodCreditCard,
-			expectRes:           `{"id":"64461024-4cdf-11ee-be1f-e7111eb57d28","customer_id":"00000000-0000-0000-0000-000000000000","name":"","detail":"","plan_type":"","plan_status":"","balance_credit":0,"balance_token":0,"payment_type":"","payment_method":"","tm_last_topup":null,"tm_next_topup":null,"tm_create":null,"tm_update":null,"tm_delete":null}`,
+			expectRes:           `{"id":"64461024-4cdf-11ee-be1f-e7111eb57d28","customer_id":"00000000-0000-0000-0000-000000000000","name":"","detail":"","plan_type":"","plan_status":"","balance_credit":0,"balance_token":0,"payment_type":"","payment_method":"","auto_topup_threshold":0,"auto_topup_amount":0,"tm_last_topup":null,"tm_next_topup":null,"tm_create":null,"tm_update":null,"tm_delete":null}`,
 		},
 	}
 
@@ -238,6 +239,93 @@ func TestPutBillingAccountPaymentInfo(t *testing.T) {
 	}
 }
 
+func TestPutBillingAccountSpendingSettings(t *testing.T) {
+
+	type test struct {
+		name  string
+		agent *auth.AuthIdentity
+
+		reqQuery string
+		reqBody  []byte
+
+		responseBillingAccount *bmaccount.WebhookMessage
+
+		expectSpendLimits            []bmaccount.SpendLimit
+		expectBalanceAlertThresholds []int64
+		expectAutoTopUpThreshold     int64
+		expectAutoTopUpAmount        int64
+		expectRes                    string
+	}
+
+	tests := []test{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("cdb5213a-8003-11ec-84ca-9fa226fcda9f"),
+				},
+			}),
+
+			reqQuery: "/billing_account/spending_settings",
+			reqBody:  []byte(`{"spend_limits":[{"period":"daily","cost_type":"call_pstn_outgoing","limit_credit":10000000}],"balance_alert_thresholds":[5000000],"auto_topup_threshold":5000000,"auto_topup_amount":20000000}`),
+
+			responseBillingAccount: &bmaccount.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("64461024-4cdf-11ee-be1f-e7111eb57d28"),
+				},
+			},
+
+			expectSpendLimits: []bmaccount.SpendLimit{
+				{
+					Period:      bmaccount.SpendLimitPeriodDaily,
+					CostType:    bmbilling.CostTypeCallPSTNOutgoing,
+					LimitCredit: 10000000,
+				},
+			},
+			expectBalanceAlertThresholds: []int64{5000000},
+			expectAutoTopUpThreshold:     5000000,
+			expectAutoTopUpAmount:        20000000,
+			expectRes:                    `{"id":"64461024-4cdf-11ee-be1f-e7111eb57d28","customer_id":"00000000-0000-0000-0000-000000000000","name":"","detail":"","plan_type":"","plan_status":"","balance_credit":0,"balance_token":0,"payment_type":"","payment_method":"","auto_topup_threshold":0,"auto_topup_amount":0,"tm_last_topup":null,"tm_next_topup":null,"tm_create":null,"tm_update":null,"tm_delete":null}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// create mock
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSvc := servicehandler.NewMockServiceHandler(mc)
+			h := &server{
+				serviceHandler: mockSvc,
+			}
+
+			w := httptest.NewRecorder()
+			_, r := gin.CreateTestContext(w)
+
+			r.Use(func(c *gin.Context) {
+				c.Set("auth_identity", tt.agent)
+			})
+			openapi_server.RegisterHandlers(r, h)
+
+			req, _ := http.NewRequest("PUT", tt.reqQuery, bytes.NewBuffer(tt.reqBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			mockSvc.EXPECT().BillingAccountSelfUpdateSpendingSettings(req.Context(), tt.agent, tt.expectSpendLimits, tt.expectBalanceAlertThresholds, tt.expectAutoTopUpThreshold, tt.expectAutoTopUpAmount).Return(tt.responseBillingAccount, nil)
+
+			r.ServeHTTP(w, req)
+			if w.Code != http.StatusOK {
+				t.Errorf("Wrong match. expect: %d, got: %d", http.StatusOK, w.Code)
+			}
+
+			if w.Body.String() != tt.expectRes {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, w.Body)
+			}
+
+		})
+	}
+}
+
 // Test_billingAccountPaddlePortalSessionPost_MissingAuthIdentity exercises
 // the auth-identity-missing branch of PostBillingAccountPaddlePortalSession.
 func Test_billingAccountPaddlePortalSessionPost_MissingAuthIdentity(t *testing.T) {
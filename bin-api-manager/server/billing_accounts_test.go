@@ -50,7 +50,7 @@ func Test_GetBillingAccountsId(t *testing.T) {
 					ID: uuid.FromStringOrNil("602eb6b4-11eb-11ee-b79f-03124621dcc4"),
 				},
 			},
-			expectRes: `{"id":"602eb6b4-11eb-11ee-b79f-03124621dcc4","customer_id":"00000000-0000-0000-0000-000000000000","status":"","name":"","detail":"","plan_type":"","plan_status":"","balance_credit":0,"balance_token":0,"payment_type":"","payment_method":"","paddle_subscription_id":"","paddle_customer_id":"","tm_last_topup":null,"tm_next_topup":null,"tm_create":null,"tm_update":null,"tm_delete":null}`,
+			expectRes: `{"id":"602eb6b4-11eb-11ee-b79f-03124621dcc4","customer_id":"00000000-0000-0000-0000-000000000000","status":"","name":"","detail":"","plan_type":"","plan_status":"","rate_deck_id":"00000000-0000-0000-0000-000000000000","balance_credit":0,"balance_token":0,"payment_type":"","payment_method":"","spend_limits":null,"balance_alert_thresholds":null,"auto_topup_threshold":0,"auto_topup_amount":0,"auto_topup_pending_id":"00000000-0000-0000-0000-000000000000","tm_auto_topup_pending":null,"paddle_subscription_id":"","paddle_customer_id":"","tm_last_topup":null,"tm_next_topup":null,"tm_create":null,"tm_update":null,"tm_delete":null}`,
 		},
 	}
 
@@ -126,7 +126,7 @@ func Test_PutBillingAccountsId(t *testing.T) {
 			expectBillingAccountID: uuid.FromStringOrNil("8d1d01bc-4cdd-11ee-a22f-03714037d3db"),
 			expectName:             "update name",
 			expectDetail:           "update detail",
-			expectRes:              `{"id":"8d1d01bc-4cdd-11ee-a22f-03714037d3db","customer_id":"00000000-0000-0000-0000-000000000000","status":"","name":"","detail":"","plan_type":"","plan_status":"","balance_credit":0,"balance_token":0,"payment_type":"","payment_method":"","paddle_subscription_id":"","paddle_customer_id":"","tm_last_topup":null,"tm_next_topup":null,"tm_create":null,"tm_update":null,"tm_delete":null}`,
+			expectRes:              `{"id":"8d1d01bc-4cdd-11ee-a22f-03714037d3db","customer_id":"00000000-0000-0000-0000-000000000000","status":"","name":"","detail":"","plan_type":"","plan_status":"","rate_deck_id":"00000000-0000-0000-0000-000000000000","balance_credit":0,"balance_token":0,"payment_type":"","payment_method":"","spend_limits":null,"balance_alert_thresholds":null,"auto_topup_threshold":0,"auto_topup_amount":0,"auto_topup_pending_id":"00000000-0000-0000-0000-000000000000","tm_auto_topup_pending":null,"paddle_subscription_id":"","paddle_customer_id":"","tm_last_topup":null,"tm_next_topup":null,"tm_create":null,"tm_update":null,"tm_delete":null}`,
 		},
 	}
 
@@ -205,7 +205,7 @@ func Test_PutBillingAccountsIdPaymentInfo(t *testing.T) {
 			expectBillingAccountID: uuid.FromStringOrNil("64461024-4cdf-11ee-be1f-e7111eb57d28"),
 			expectPaymentType:      bmaccount.PaymentTypePrepaid,
 			expectPaymentMethod:    bmaccount.PaymentMethodCreditCard,
-			expectRes:              `{"id":"64461024-4cdf-11ee-be1f-e7111eb57d28","customer_id":"00000000-0000-0000-0000-000000000000","status":"","name":"","detail":"","plan_type":"","plan_status":"","balance_credit":0,"balance_token":0,"payment_type":"","payment_method":"","paddle_subscription_id":"","paddle_customer_id":"","tm_last_topup":null,"tm_next_topup":null,"tm_create":null,"tm_update":null,"tm_delete":null}`,
+			expectRes:              `{"id":"64461024-4cdf-11ee-be1f-e7111eb57d28","customer_id":"00000000-0000-0000-0000-000000000000","status":"","name":"","detail":"","plan_type":"","plan_status":"","rate_deck_id":"00000000-0000-0000-0000-000000000000","balance_credit":0,"balance_token":0,"payment_type":"","payment_method":"","spend_limits":null,"balance_alert_thresholds":null,"auto_topup_threshold":0,"auto_topup_amount":0,"auto_topup_pending_id":"00000000-0000-0000-0000-000000000000","tm_auto_topup_pending":null,"paddle_subscription_id":"","paddle_customer_id":"","tm_last_topup":null,"tm_next_topup":null,"tm_create":null,"tm_update":null,"tm_delete":null}`,
 		},
 	}
 
@@ -282,7 +282,7 @@ func Test_PostBillingAccountsIdBalanceAddForce(t *testing.T) {
 
 			expectBillingAccountID: uuid.FromStringOrNil("605eae78-11eb-11ee-b8d3-6fd8da9d9879"),
 			expectBalance:          20000000,
-			expectRes:              `{"id":"605eae78-11eb-11ee-b8d3-6fd8da9d9879","customer_id":"00000000-0000-0000-0000-000000000000","status":"","name":"","detail":"","plan_type":"","plan_status":"","balance_credit":0,"balance_token":0,"payment_type":"","payment_method":"","paddle_subscription_id":"","paddle_customer_id":"","tm_last_topup":null,"tm_next_topup":null,"tm_create":null,"tm_update":null,"tm_delete":null}`,
+			expectRes:              `{"id":"605eae78-11eb-11ee-b8d3-6fd8da9d9879","customer_id":"00000000-0000-0000-0000-000000000000","status":"","name":"","detail":"","plan_type":"","plan_status":"","rate_deck_id":"00000000-0000-0000-0000-000000000000","balance_credit":0,"balance_token":0,"payment_type":"","payment_method":"","spend_limits":null,"balance_alert_thresholds":null,"auto_topup_threshold":0,"auto_topup_amount":0,"auto_topup_pending_id":"00000000-0000-0000-0000-000000000000","tm_auto_topup_pending":null,"paddle_subscription_id":"","paddle_customer_id":"","tm_last_topup":null,"tm_next_topup":null,"tm_create":null,"tm_update":null,"tm_delete":null}`,
 		},
 	}
 
@@ -356,7 +356,7 @@ func Test_PostBillingAccountsIdBalanceSubtractForce(t *testing.T) {
 
 			expectBillingAccountID: uuid.FromStringOrNil("e4e38ff6-11eb-11ee-879b-cb22a78168e4"),
 			expectBalance:          20000000,
-			expectRes:              `{"id":"e4e38ff6-11eb-11ee-879b-cb22a78168e4","customer_id":"00000000-0000-0000-0000-000000000000","status":"","name":"","detail":"","plan_type":"","plan_status":"","balance_credit":0,"balance_token":0,"payment_type":"","payment_method":"","paddle_subscription_id":"","paddle_customer_id":"","tm_last_topup":null,"tm_next_topup":null,"tm_create":null,"tm_update":null,"tm_delete":null}`,
+			expectRes:              `{"id":"e4e38ff6-11eb-11ee-879b-cb22a78168e4","customer_id":"00000000-0000-0000-0000-000000000000","status":"","name":"","detail":"","plan_type":"","plan_status":"","rate_deck_id":"00000000-0000-0000-0000-000000000000","balance_credit":0,"balance_token":0,"payment_type":"","payment_method":"","spend_limits":null,"balance_alert_thresholds":null,"auto_topup_threshold":0,"auto_topup_amount":0,"auto_topup_pending_id":"00000000-0000-0000-0000-000000000000","tm_auto_topup_pending":null,"paddle_subscription_id":"","paddle_customer_id":"","tm_last_topup":null,"tm_next_topup":null,"tm_create":null,"tm_update":null,"tm_delete":null}`,
 		},
 	}
 
@@ -0,0 +1,56 @@
+package server
+
+import (
+	"monorepo/bin-api-manager/gens/openapi_server"
+	bmbilling "monorepo/bin-billing-manager/models/billing"
+	commonaddress "monorepo/bin-common-handler/models/address"
+	cerrors "monorepo/bin-common-handler/models/errors"
+	commonoutline "monorepo/bin-common-handler/models/outline"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+func (h *server) PostBillingEstimates(c *gin.Context) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":            "PostBillingEstimates",
+		"request_address": c.ClientIP(),
+	})
+
+	a, ok := getAuthIdentity(c)
+	if !ok {
+		log.Errorf("Could not find auth identity.")
+		abortWithError(c, cerrors.Unauthenticated(commonoutline.ServiceNameAPIManager, "AUTHENTICATION_REQUIRED", "Authentication is required."))
+		return
+	}
+	log = log.WithFields(logrus.Fields{
+		"auth": a,
+	})
+
+	var req openapi_server.PostBillingEstimatesJSONBody
+	if err := c.BindJSON(&req); err != nil {
+		log.Errorf("Could not parse the request. err: %v", err)
+		abortWithError(c, cerrors.InvalidArgument(commonoutline.ServiceNameAPIManager, "INVALID_JSON_BODY", "The request body is not valid JSON."))
+		return
+	}
+
+	var destination *commonaddress.Address
+	if req.Destination != nil {
+		tmp := ConvertCommonAddress(*req.Destination)
+		destination = &tmp
+	}
+
+	duration := 0
+	if req.Duration != nil {
+		duration = *req.Duration
+	}
+
+	res, err := h.serviceHandler.BillingEstimateQuote(c.Request.Context(), a, bmbilling.CostType(req.CostType), destination, duration)
+	if err != nil {
+		log.Errorf("Could not quote the cost. err: %v", err)
+		abortWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(200, res)
+}
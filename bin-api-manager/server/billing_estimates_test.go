@@ -0,0 +1,103 @@
+package server
+
+import (
+	"bytes"
+	"monorepo/bin-api-manager/gens/openapi_server"
+	"monorepo/bin-api-manager/pkg/servicehandler"
+
+	amagent "monorepo/bin-agent-manager/models/agent"
+	"monorepo/bin-api-manager/models/auth"
+	bmbilling "monorepo/bin-billing-manager/models/billing"
+	bmestimate "monorepo/bin-billing-manager/models/estimate"
+	commonaddress "monorepo/bin-common-handler/models/address"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_billingEstimatesPOST(t *testing.T) {
+
+	type test struct {
+		name  string
+		agent *auth.AuthIdentity
+
+		reqBody []byte
+
+		responseEstimate *bmestimate.Estimate
+
+		expectCostType    bmbilling.CostType
+		expectDestination *commonaddress.Address
+		expectDuration    int
+		expectRes         string
+	}
+
+	tests := []test{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("8c1e3a5c-b2e7-11f0-9a4d-7f9b1d3f5b80"),
+				},
+			}),
+
+			reqBody: []byte(`{"cost_type":"call_pstn_outgoing","destination":{"type":"tel","target":"+821100000001"},"duration":300}`),
+
+			responseEstimate: &bmestimate.Estimate{
+				CostType:          bmbilling.CostTypeCallPSTNOutgoing,
+				RateCreditPerUnit: 10000,
+				Duration:          300,
+				BillableUnits:     5,
+				AmountCredit:      50000,
+			},
+
+			expectCostType: bmbilling.CostTypeCallPSTNOutgoing,
+			expectDestination: &commonaddress.Address{
+				Type:   commonaddress.TypeTel,
+				Target: "+821100000001",
+			},
+			expectDuration: 300,
+			expectRes:      `{"customer_id":"00000000-0000-0000-0000-000000000000","account_id":"00000000-0000-0000-0000-000000000000","billing_id":"00000000-0000-0000-0000-000000000000","reference_type":"","reference_id":"00000000-0000-0000-0000-000000000000","cost_type":"call_pstn_outgoing","rate_id":"00000000-0000-0000-0000-000000000000","rate_credit_per_unit":10000,"rate_connection_fee":0,"rate_increment_initial":0,"rate_increment_subsequent":0,"duration":300,"billable_units":5,"billed_duration":0,"amount_credit":50000}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// create mock
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSvc := servicehandler.NewMockServiceHandler(mc)
+			h := &server{
+				serviceHandler: mockSvc,
+			}
+
+			w := httptest.NewRecorder()
+			_, r := gin.CreateTestContext(w)
+
+			r.Use(func(c *gin.Context) {
+				c.Set("auth_identity", tt.agent)
+			})
+			openapi_server.RegisterHandlers(r, h)
+
+			req, _ := http.NewRequest("POST", "/billing_estimates", bytes.NewBuffer(tt.reqBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			mockSvc.EXPECT().BillingEstimateQuote(req.Context(), tt.agent, tt.expectCostType, tt.expectDestination, tt.expectDuration).Return(tt.responseEstimate, nil)
+
+			r.ServeHTTP(w, req)
+			if w.Code != http.StatusOK {
+				t.Errorf("Wrong match. expect: %d, got: %d", http.StatusOK, w.Code)
+			}
+
+			if w.Body.String() != tt.expectRes {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, w.Body)
+			}
+		})
+	}
+}
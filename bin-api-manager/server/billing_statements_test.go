@@ -0,0 +1,166 @@
+package server
+
+import (
+	"monorepo/bin-api-manager/gens/openapi_server"
+	"monorepo/bin-api-manager/pkg/servicehandler"
+
+	amagent "monorepo/bin-agent-manager/models/agent"
+	"monorepo/bin-api-manager/models/auth"
+	bmstatement "monorepo/bin-billing-manager/models/statement"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_billingStatementsGET(t *testing.T) {
+
+	type test struct {
+		name  string
+		agent *auth.AuthIdentity
+
+		reqQuery string
+
+		responseStatements []*bmstatement.WebhookMessage
+
+		expectPageSize  uint64
+		expectPageToken string
+		expectRes       string
+	}
+
+	tests := []test{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("1f3a5c7e-b1a6-11f0-9b2d-4e6f8a0c2e40"),
+				},
+			}),
+
+			reqQuery: "/billing_statements?page_size=10&page_token=2026-10-01T03:00:00.000000Z",
+
+			responseStatements: []*bmstatement.WebhookMessage{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("1f6d8e0a-b1a6-11f0-a4c3-5f7a9b1d3f50"),
+					},
+					TMCreate: timePtr("2026-09-01T03:00:12.000000Z"),
+				},
+			},
+
+			expectPageSize:  10,
+			expectPageToken: "2026-10-01T03:00:00.000000Z",
+			expectRes:       `{"result":[{"id":"1f6d8e0a-b1a6-11f0-a4c3-5f7a9b1d3f50","customer_id":"00000000-0000-0000-0000-000000000000","account_id":"00000000-0000-0000-0000-000000000000","tm_period_start":null,"tm_period_end":null,"items":null,"top_destinations":null,"top_ups":null,"total_usage_token":0,"total_usage_credit":0,"total_top_up_token":0,"total_top_up_credit":0,"balance_token_end":0,"balance_credit_end":0,"pdf_file_id":"00000000-0000-0000-0000-000000000000","csv_file_id":"00000000-0000-0000-0000-000000000000","tm_create":"2026-09-01T03:00:12Z","tm_update":null,"tm_delete":null}],"next_page_token":"2026-09-01T03:00:12.000000Z"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// create mock
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSvc := servicehandler.NewMockServiceHandler(mc)
+			h := &server{
+				serviceHandler: mockSvc,
+			}
+
+			w := httptest.NewRecorder()
+			_, r := gin.CreateTestContext(w)
+
+			r.Use(func(c *gin.Context) {
+				c.Set("auth_identity", tt.agent)
+			})
+			openapi_server.RegisterHandlers(r, h)
+
+			req, _ := http.NewRequest("GET", tt.reqQuery, nil)
+
+			mockSvc.EXPECT().BillingStatementList(req.Context(), tt.agent, tt.expectPageSize, tt.expectPageToken).Return(tt.responseStatements, nil)
+
+			r.ServeHTTP(w, req)
+			if w.Code != http.StatusOK {
+				t.Errorf("Wrong match. expect: %d, got: %d", http.StatusOK, w.Code)
+			}
+
+			if w.Body.String() != tt.expectRes {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, w.Body)
+			}
+		})
+	}
+}
+
+func Test_billingStatementsIDGET(t *testing.T) {
+
+	type test struct {
+		name  string
+		agent *auth.AuthIdentity
+
+		reqQuery string
+
+		responseStatement *bmstatement.WebhookMessage
+
+		expectStatementID uuid.UUID
+		expectRes         string
+	}
+
+	tests := []test{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("1f3a5c7e-b1a6-11f0-9b2d-4e6f8a0c2e40"),
+				},
+			}),
+
+			reqQuery: "/billing_statements/4a8c0e2f-b1a6-11f0-8d5e-6a8b0c2e4f60",
+
+			responseStatement: &bmstatement.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("4a8c0e2f-b1a6-11f0-8d5e-6a8b0c2e4f60"),
+				},
+			},
+
+			expectStatementID: uuid.FromStringOrNil("4a8c0e2f-b1a6-11f0-8d5e-6a8b0c2e4f60"),
+			expectRes:         `{"id":"4a8c0e2f-b1a6-11f0-8d5e-6a8b0c2e4f60","customer_id":"00000000-0000-0000-0000-000000000000","account_id":"00000000-0000-0000-0000-000000000000","tm_period_start":null,"tm_period_end":null,"items":null,"top_destinations":null,"top_ups":null,"total_usage_token":0,"total_usage_credit":0,"total_top_up_token":0,"total_top_up_credit":0,"balance_token_end":0,"balance_credit_end":0,"pdf_file_id":"00000000-0000-0000-0000-000000000000","csv_file_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// create mock
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSvc := servicehandler.NewMockServiceHandler(mc)
+			h := &server{
+				serviceHandler: mockSvc,
+			}
+
+			w := httptest.NewRecorder()
+			_, r := gin.CreateTestContext(w)
+
+			r.Use(func(c *gin.Context) {
+				c.Set("auth_identity", tt.agent)
+			})
+			openapi_server.RegisterHandlers(r, h)
+
+			req, _ := http.NewRequest("GET", tt.reqQuery, nil)
+
+			mockSvc.EXPECT().BillingStatementGet(req.Context(), tt.agent, tt.expectStatementID).Return(tt.responseStatement, nil)
+
+			r.ServeHTTP(w, req)
+			if w.Code != http.StatusOK {
+				t.Errorf("Wrong match. expect: %d, got: %d", http.StatusOK, w.Code)
+			}
+
+			if w.Body.String() != tt.expectRes {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, w.Body)
+			}
+		})
+	}
+}
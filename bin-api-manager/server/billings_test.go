@@ -55,7 +55,7 @@ func Test_billingsGET(t *testing.T) {
 
 			expectPageSize:  10,
 			expectPageToken: "2020-09-20T03:23:20.995000Z",
-			expectRes:       `{"result":[{"id":"30984a42-11ea-11ee-b5d2-93d4f8db3dca","customer_id":"00000000-0000-0000-0000-000000000000","account_id":"00000000-0000-0000-0000-000000000000","transaction_type":"","status":"","reference_type":"","reference_id":"00000000-0000-0000-0000-000000000000","cost_type":"","usage_duration":0,"billable_units":0,"rate_token_per_unit":0,"rate_credit_per_unit":0,"amount_token":0,"amount_credit":0,"balance_token_snapshot":0,"balance_credit_snapshot":0,"idempotency_key":"00000000-0000-0000-0000-000000000000","tm_billing_start":null,"tm_billing_end":null,"tm_create":"2020-09-20T03:23:21.995Z","tm_update":null,"tm_delete":null}],"next_page_token":"2020-09-20T03:23:21.995000Z"}`,
+			expectRes:       `{"result":[{"id":"30984a42-11ea-11ee-b5d2-93d4f8db3dca","customer_id":"00000000-0000-0000-0000-000000000000","account_id":"00000000-0000-0000-0000-000000000000","transaction_type":"","status":"","reference_type":"","reference_id":"00000000-0000-0000-0000-000000000000","cost_type":"","usage_duration":0,"billable_units":0,"billed_duration":0,"rate_id":"00000000-0000-0000-0000-000000000000","rate_token_per_unit":0,"rate_credit_per_unit":0,"rate_connection_fee":0,"rate_increment_initial":0,"rate_increment_subsequent":0,"amount_token":0,"amount_credit":0,"balance_token_snapshot":0,"balance_credit_snapshot":0,"idempotency_key":"00000000-0000-0000-0000-000000000000","tm_billing_start":null,"tm_billing_end":null,"tm_create":"2020-09-20T03:23:21.995Z","tm_update":null,"tm_delete":null}],"next_page_token":"2020-09-20T03:23:21.995000Z"}`,
 		},
 		{
 			name: "more than 2 items",
@@ -89,7 +89,7 @@ func Test_billingsGET(t *testing.T) {
 			},
 			expectPageSize:  10,
 			expectPageToken: "2020-09-20T03:23:20.995000Z",
-			expectRes:       `{"result":[{"id":"30caab9a-11ea-11ee-8f18-5735018f9df2","customer_id":"00000000-0000-0000-0000-000000000000","account_id":"00000000-0000-0000-0000-000000000000","transaction_type":"","status":"","reference_type":"","reference_id":"00000000-0000-0000-0000-000000000000","cost_type":"","usage_duration":0,"billable_units":0,"rate_token_per_unit":0,"rate_credit_per_unit":0,"amount_token":0,"amount_credit":0,"balance_token_snapshot":0,"balance_credit_snapshot":0,"idempotency_key":"00000000-0000-0000-0000-000000000000","tm_billing_start":null,"tm_billing_end":null,"tm_create":"2020-09-20T03:23:21.995Z","tm_update":null,"tm_delete":null},{"id":"30f86f76-11ea-11ee-ae51-ef177df11436","customer_id":"00000000-0000-0000-0000-000000000000","account_id":"00000000-0000-0000-0000-000000000000","transaction_type":"","status":"","reference_type":"","reference_id":"00000000-0000-0000-0000-000000000000","cost_type":"","usage_duration":0,"billable_units":0,"rate_token_per_unit":0,"rate_credit_per_unit":0,"amount_token":0,"amount_credit":0,"balance_token_snapshot":0,"balance_credit_snapshot":0,"idempotency_key":"00000000-0000-0000-0000-000000000000","tm_billing_start":null,"tm_billing_end":null,"tm_create":"2020-09-20T03:23:22.995Z","tm_update":null,"tm_delete":null},{"id":"312228ca-11ea-11ee-9004-eb6099103496","customer_id":"00000000-0000-0000-0000-000000000000","account_id":"00000000-0000-0000-0000-000000000000","transaction_type":"","status":"","reference_type":"","reference_id":"00000000-0000-0000-0000-000000000000","cost_type":"","usage_duration":0,"billable_units":0,"rate_token_per_unit":0,"rate_credit_per_unit":0,"amount_token":0,"amount_credit":0,"balance_token_snapshot":0,"balance_credit_snapshot":0,"idempotency_key":"00000000-0000-0000-0000-000000000000","tm_billing_start":null,"tm_billing_end":null,"tm_create":"2020-09-20T03:23:23.995Z","tm_update":null,"tm_delete":null}],"next_page_token":"2020-09-20T03:23:23.995000Z"}`,
+			expectRes:       `{"result":[{"id":"30caab9a-11ea-11ee-8f18-5735018f9df2","customer_id":"00000000-0000-0000-0000-000000000000","account_id":"00000000-0000-0000-0000-000000000000","transaction_type":"","status":"","reference_type":"","reference_id":"00000000-0000-0000-0000-000000000000","cost_type":"","usage_duration":0,"billable_units":0,"billed_duration":0,"rate_id":"00000000-0000-0000-0000-000000000000","rate_token_per_unit":0,"rate_credit_per_unit":0,"rate_connection_fee":0,"rate_increment_initial":0,"rate_increment_subsequent":0,"amount_token":0,"amount_credit":0,"balance_token_snapshot":0,"balance_credit_snapshot":0,"idempotency_key":"00000000-0000-0000-0000-000000000000","tm_billing_start":null,"tm_billing_end":null,"tm_create":"2020-09-20T03:23:21.995Z","tm_update":null,"tm_delete":null},{"id":"30f86f76-11ea-11ee-ae51-ef177df11436","customer_id":"00000000-0000-0000-0000-000000000000","account_id":"00000000-0000-0000-0000-000000000000","transaction_type":"","status":"","reference_type":"","reference_id":"00000000-0000-0000-0000-000000000000","cost_type":"","usage_duration":0,"billable_units":0,"billed_duration":0,"rate_id":"00000000-0000-0000-0000-000000000000","rate_token_per_unit":0,"rate_credit_per_unit":0,"rate_connection_fee":0,"rate_increment_initial":0,"rate_increment_subsequent":0,"amount_token":0,"amount_credit":0,"balance_token_snapshot":0,"balance_credit_snapshot":0,"idempotency_key":"00000000-0000-0000-0000-000000000000","tm_billing_start":null,"tm_billing_end":null,"tm_create":"2020-09-20T03:23:22.995Z","tm_update":null,"tm_delete":null},{"id":"312228ca-11ea-11ee-9004-eb6099103496","customer_id":"00000000-0000-0000-0000-000000000000","account_id":"00000000-0000-0000-0000-000000000000","transaction_type":"","status":"","reference_type":"","reference_id":"00000000-0000-0000-0000-000000000000","cost_type":"","usage_duration":0,"billable_units":0,"billed_duration":0,"rate_id":"00000000-0000-0000-0000-000000000000","rate_token_per_unit":0,"rate_credit_per_unit":0,"rate_connection_fee":0,"rate_increment_initial":0,"rate_increment_subsequent":0,"amount_token":0,"amount_credit":0,"balance_token_snapshot":0,"balance_credit_snapshot":0,"idempotency_key":"00000000-0000-0000-0000-000000000000","tm_billing_start":null,"tm_billing_end":null,"tm_create":"2020-09-20T03:23:23.995Z","tm_update":null,"tm_delete":null}],"next_page_token":"2020-09-20T03:23:23.995000Z"}`,
 		},
 	}
 
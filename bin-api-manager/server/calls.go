@@ -85,7 +85,12 @@ func (h *server) PostCalls(c *gin.Context) {
 		return
 	}
 
-	tmpCalls, tmpGroupcalls, err := h.serviceHandler.CallCreate(c.Request.Context(), a, flowID, actions, &source, destinations, anonymous, variables)
+	maxCost := int64(0)
+	if req.MaxCost != nil {
+		maxCost = *req.MaxCost
+	}
+
+	tmpCalls, tmpGroupcalls, err := h.serviceHandler.CallCreate(c.Request.Context(), a, flowID, actions, &source, destinations, anonymous, variables, maxCost)
 	if err != nil {
 		log.Errorf("Could not create a call for outgoing. err; %v", err)
 		abortWithServiceError(c, err)
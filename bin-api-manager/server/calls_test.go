@@ -41,6 +41,7 @@ func Test_CallsPOST(t *testing.T) {
 		expectActions      []fmaction.Action
 		expectSource       *commonaddress.Address
 		expectDestinations []commonaddress.Address
+		expectMaxCost      int64
 		expectRes          string
 	}
 
@@ -53,7 +54,7 @@ func Test_CallsPOST(t *testing.T) {
 				},
 			}),
 
-			reqBody: []byte(`{"source":{"type":"sip","target":"source@test.voipbin.net"},"destinations":[{"type":"sip","target":"destination@test.voipbin.net"}],"flow_id":"f0f80af2-d7c8-11ef-bc6a-03858a6b220f","actions":[{"type":"answer"}]}`),
+			reqBody: []byte(`{"source":{"type":"sip","target":"source@test.voipbin.net"},"destinations":[{"type":"sip","target":"destination@test.voipbin.net"}],"flow_id":"f0f80af2-d7c8-11ef-bc6a-03858a6b220f","actions":[{"type":"answer"}],"max_cost":3000000}`),
 
 			responseCalls: []*cmcall.WebhookMessage{
 				{
@@ -86,7 +87,8 @@ func Test_CallsPOST(t *testing.T) {
 					Target: "destination@test.voipbin.net",
 				},
 			},
-			expectRes: `{"calls":[{"id":"98b963ac-8df9-11ec-b26b-031d30ff93df","customer_id":"00000000-0000-0000-0000-000000000000","owner_type":"","owner_id":"00000000-0000-0000-0000-000000000000","flow_id":"00000000-0000-0000-0000-000000000000","activeflow_id":"00000000-0000-0000-0000-000000000000","master_call_id":"00000000-0000-0000-0000-000000000000","recording_id":"00000000-0000-0000-0000-000000000000","groupcall_id":"00000000-0000-0000-0000-000000000000","source":{},"destination":{},"action":{"id":"00000000-0000-0000-0000-000000000000","next_id":"00000000-0000-0000-0000-000000000000","tm_execute":null}}],"groupcalls":[{"id":"37d675b5-83b2-4a78-8ed4-fe680ec41060","customer_id":"00000000-0000-0000-0000-000000000000","owner_type":"","owner_id":"00000000-0000-0000-0000-000000000000","flow_id":"00000000-0000-0000-0000-000000000000","master_call_id":"00000000-0000-0000-0000-000000000000","master_groupcall_id":"00000000-0000-0000-0000-000000000000","answer_call_id":"00000000-0000-0000-0000-000000000000","answer_groupcall_id":"00000000-0000-0000-0000-000000000000"}]}`,
+			expectMaxCost: 3000000,
+			expectRes:     `{"calls":[{"id":"98b963ac-8df9-11ec-b26b-031d30ff93df","customer_id":"00000000-0000-0000-0000-000000000000","owner_type":"","owner_id":"00000000-0000-0000-0000-000000000000","flow_id":"00000000-0000-0000-0000-000000000000","activeflow_id":"00000000-0000-0000-0000-000000000000","master_call_id":"00000000-0000-0000-0000-000000000000","recording_id":"00000000-0000-0000-0000-000000000000","groupcall_id":"00000000-0000-0000-0000-000000000000","source":{},"destination":{},"action":{"id":"00000000-0000-0000-0000-000000000000","next_id":"00000000-0000-0000-0000-000000000000","tm_execute":null}}],"groupcalls":[{"id":"37d675b5-83b2-4a78-8ed4-fe680ec41060","customer_id":"00000000-0000-0000-0000-000000000000","owner_type":"","owner_id":"00000000-0000-0000-0000-000000000000","flow_id":"00000000-0000-0000-0000-000000000000","master_call_id":"00000000-0000-0000-0000-000000000000","master_groupcall_id":"00000000-0000-0000-0000-000000000000","answer_call_id":"00000000-0000-0000-0000-000000000000","answer_groupcall_id":"00000000-0000-0000-0000-000000000000"}]}`,
 		},
 	}
 
@@ -112,7 +114,7 @@ func Test_CallsPOST(t *testing.T) {
 			req, _ := http.NewRequest("POST", "/calls", bytes.NewBuffer(tt.reqBody))
 			req.Header.Set("Content-Type", "application/json")
 
-			mockSvc.EXPECT().CallCreate(req.Context(), tt.agent, tt.expectFlowID, tt.expectActions, tt.expectSource, tt.expectDestinations, "", gomock.Any()).Return(tt.responseCalls, tt.responseGroupcalls, nil)
+			mockSvc.EXPECT().CallCreate(req.Context(), tt.agent, tt.expectFlowID, tt.expectActions, tt.expectSource, tt.expectDestinations, "", gomock.Any(), tt.expectMaxCost).Return(tt.responseCalls, tt.responseGroupcalls, nil)
 
 			r.ServeHTTP(w, req)
 			if w.Code != http.StatusOK {
@@ -10,10 +10,12 @@ import (
 	cacampaigncall "monorepo/bin-campaign-manager/models/campaigncall"
 	cerrors "monorepo/bin-common-handler/models/errors"
 	commonidentity "monorepo/bin-common-handler/models/identity"
+	omoutdialtarget "monorepo/bin-outdial-manager/models/outdialtarget"
 
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gofrs/uuid"
@@ -57,7 +59,7 @@ func Test_campaigncallsGET(t *testing.T) {
 
 			expectPageSize:  10,
 			expectPageToken: "2020-09-20T03:23:20.995000Z",
-			expectRes:       `{"result":[{"id":"13d06624-6e29-11ee-8c18-37f3708d43b9","customer_id":"00000000-0000-0000-0000-000000000000","campaign_id":"00000000-0000-0000-0000-000000000000","outplan_id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","outdial_target_id":"00000000-0000-0000-0000-000000000000","queue_id":"00000000-0000-0000-0000-000000000000","activeflow_id":"00000000-0000-0000-0000-000000000000","flow_id":"00000000-0000-0000-0000-000000000000","reference_type":"","reference_id":"00000000-0000-0000-0000-000000000000","status":"","result":"","source":null,"destination":null,"destination_index":0,"try_count":0,"tm_create":"2020-09-20T03:23:21.995Z","tm_update":null,"tm_delete":null}],"next_page_token":"2020-09-20T03:23:21.995000Z"}`,
+			expectRes:       `{"result":[{"id":"13d06624-6e29-11ee-8c18-37f3708d43b9","customer_id":"00000000-0000-0000-0000-000000000000","campaign_id":"00000000-0000-0000-0000-000000000000","outplan_id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","outdial_target_id":"00000000-0000-0000-0000-000000000000","queue_id":"00000000-0000-0000-0000-000000000000","agent_id":"00000000-0000-0000-0000-000000000000","activeflow_id":"00000000-0000-0000-0000-000000000000","flow_id":"00000000-0000-0000-0000-000000000000","reference_type":"","reference_id":"00000000-0000-0000-0000-000000000000","status":"","result":"","disposition":"","source":null,"destination":null,"destination_index":0,"try_count":0,"abandoned":false,"tm_progressing":null,"tm_end":null,"tm_create":"2020-09-20T03:23:21.995Z","tm_update":null,"tm_delete":null}],"next_page_token":"2020-09-20T03:23:21.995000Z"}`,
 		},
 		{
 			name: "more than 2 items",
@@ -92,7 +94,7 @@ func Test_campaigncallsGET(t *testing.T) {
 
 			expectPageSize:  10,
 			expectPageToken: "2020-09-20T03:23:20.995000Z",
-			expectRes:       `{"result":[{"id":"1402a4ea-6e29-11ee-a53c-1b448648df2e","customer_id":"00000000-0000-0000-0000-000000000000","campaign_id":"00000000-0000-0000-0000-000000000000","outplan_id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","outdial_target_id":"00000000-0000-0000-0000-000000000000","queue_id":"00000000-0000-0000-0000-000000000000","activeflow_id":"00000000-0000-0000-0000-000000000000","flow_id":"00000000-0000-0000-0000-000000000000","reference_type":"","reference_id":"00000000-0000-0000-0000-000000000000","status":"","result":"","source":null,"destination":null,"destination_index":0,"try_count":0,"tm_create":"2020-09-20T03:23:21.995Z","tm_update":null,"tm_delete":null},{"id":"142f6b10-6e29-11ee-b771-a7835a2bf8ef","customer_id":"00000000-0000-0000-0000-000000000000","campaign_id":"00000000-0000-0000-0000-000000000000","outplan_id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","outdial_target_id":"00000000-0000-0000-0000-000000000000","queue_id":"00000000-0000-0000-0000-000000000000","activeflow_id":"00000000-0000-0000-0000-000000000000","flow_id":"00000000-0000-0000-0000-000000000000","reference_type":"","reference_id":"00000000-0000-0000-0000-000000000000","status":"","result":"","source":null,"destination":null,"destination_index":0,"try_count":0,"tm_create":"2020-09-20T03:23:22.995Z","tm_update":null,"tm_delete":null},{"id":"212ed990-6e29-11ee-951e-9ffe2d340f93","customer_id":"00000000-0000-0000-0000-000000000000","campaign_id":"00000000-0000-0000-0000-000000000000","outplan_id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","outdial_target_id":"00000000-0000-0000-0000-000000000000","queue_id":"00000000-0000-0000-0000-000000000000","activeflow_id":"00000000-0000-0000-0000-000000000000","flow_id":"00000000-0000-0000-0000-000000000000","reference_type":"","reference_id":"00000000-0000-0000-0000-000000000000","status":"","result":"","source":null,"destination":null,"destination_index":0,"try_count":0,"tm_create":"2020-09-20T03:23:23.995Z","tm_update":null,"tm_delete":null}],"next_page_token":"2020-09-20T03:23:23.995000Z"}`,
+			expectRes:       `{"result":[{"id":"1402a4ea-6e29-11ee-a53c-1b448648df2e","customer_id":"00000000-0000-0000-0000-000000000000","campaign_id":"00000000-0000-0000-0000-000000000000","outplan_id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","outdial_target_id":"00000000-0000-0000-0000-000000000000","queue_id":"00000000-0000-0000-0000-000000000000","agent_id":"00000000-0000-0000-0000-000000000000","activeflow_id":"00000000-0000-0000-0000-000000000000","flow_id":"00000000-0000-0000-0000-000000000000","reference_type":"","reference_id":"00000000-0000-0000-0000-000000000000","status":"","result":"","disposition":"","source":null,"destination":null,"destination_index":0,"try_count":0,"abandoned":false,"tm_progressing":null,"tm_end":null,"tm_create":"2020-09-20T03:23:21.995Z","tm_update":null,"tm_delete":null},{"id":"142f6b10-6e29-11ee-b771-a7835a2bf8ef","customer_id":"00000000-0000-0000-0000-000000000000","campaign_id":"00000000-0000-0000-0000-000000000000","outplan_id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","outdial_target_id":"00000000-0000-0000-0000-000000000000","queue_id":"00000000-0000-0000-0000-000000000000","agent_id":"00000000-0000-0000-0000-000000000000","activeflow_id":"00000000-0000-0000-0000-000000000000","flow_id":"00000000-0000-0000-0000-000000000000","reference_type":"","reference_id":"00000000-0000-0000-0000-000000000000","status":"","result":"","disposition":"","source":null,"destination":null,"destination_index":0,"try_count":0,"abandoned":false,"tm_progressing":null,"tm_end":null,"tm_create":"2020-09-20T03:23:22.995Z","tm_update":null,"tm_delete":null},{"id":"212ed990-6e29-11ee-951e-9ffe2d340f93","customer_id":"00000000-0000-0000-0000-000000000000","campaign_id":"00000000-0000-0000-0000-000000000000","outplan_id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","outdial_target_id":"00000000-0000-0000-0000-000000000000","queue_id":"00000000-0000-0000-0000-000000000000","agent_id":"00000000-0000-0000-0000-000000000000","activeflow_id":"00000000-0000-0000-0000-000000000000","flow_id":"00000000-0000-0000-0000-000000000000","reference_type":"","reference_id":"00000000-0000-0000-0000-000000000000","status":"","result":"","disposition":"","source":null,"destination":null,"destination_index":0,"try_count":0,"abandoned":false,"tm_progressing":null,"tm_end":null,"tm_create":"2020-09-20T03:23:23.995Z","tm_update":null,"tm_delete":null}],"next_page_token":"2020-09-20T03:23:23.995000Z"}`,
 		},
 	}
 
@@ -161,7 +163,7 @@ func Test_campaigncallsIDGET(t *testing.T) {
 			},
 
 			expectCampaigncallID: uuid.FromStringOrNil("897e611a-c870-11ec-9b81-a7b70b7cdaa1"),
-			expectRes:            `{"id":"897e611a-c870-11ec-9b81-a7b70b7cdaa1","customer_id":"00000000-0000-0000-0000-000000000000","campaign_id":"00000000-0000-0000-0000-000000000000","outplan_id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","outdial_target_id":"00000000-0000-0000-0000-000000000000","queue_id":"00000000-0000-0000-0000-000000000000","activeflow_id":"00000000-0000-0000-0000-000000000000","flow_id":"00000000-0000-0000-0000-000000000000","reference_type":"","reference_id":"00000000-0000-0000-0000-000000000000","status":"","result":"","source":null,"destination":null,"destination_index":0,"try_count":0,"tm_create":null,"tm_update":null,"tm_delete":null}`,
+			expectRes:            `{"id":"897e611a-c870-11ec-9b81-a7b70b7cdaa1","customer_id":"00000000-0000-0000-0000-000000000000","campaign_id":"00000000-0000-0000-0000-000000000000","outplan_id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","outdial_target_id":"00000000-0000-0000-0000-000000000000","queue_id":"00000000-0000-0000-0000-000000000000","agent_id":"00000000-0000-0000-0000-000000000000","activeflow_id":"00000000-0000-0000-0000-000000000000","flow_id":"00000000-0000-0000-0000-000000000000","reference_type":"","reference_id":"00000000-0000-0000-0000-000000000000","status":"","result":"","disposition":"","source":null,"destination":null,"destination_index":0,"try_count":0,"abandoned":false,"tm_progressing":null,"tm_end":null,"tm_create":null,"tm_update":null,"tm_delete":null}`,
 		},
 	}
 
@@ -227,7 +229,7 @@ func Test_campaigncallsIDDELETE(t *testing.T) {
 			},
 
 			expectCampaigncallID: uuid.FromStringOrNil("afe97cd6-c870-11ec-b750-f3db7eda3a33"),
-			expectRes:            `{"id":"afe97cd6-c870-11ec-b750-f3db7eda3a33","customer_id":"00000000-0000-0000-0000-000000000000","campaign_id":"00000000-0000-0000-0000-000000000000","outplan_id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","outdial_target_id":"00000000-0000-0000-0000-000000000000","queue_id":"00000000-0000-0000-0000-000000000000","activeflow_id":"00000000-0000-0000-0000-000000000000","flow_id":"00000000-0000-0000-0000-000000000000","reference_type":"","reference_id":"00000000-0000-0000-0000-000000000000","status":"","result":"","source":null,"destination":null,"destination_index":0,"try_count":0,"tm_create":null,"tm_update":null,"tm_delete":null}`,
+			expectRes:            `{"id":"afe97cd6-c870-11ec-b750-f3db7eda3a33","customer_id":"00000000-0000-0000-0000-000000000000","campaign_id":"00000000-0000-0000-0000-000000000000","outplan_id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","outdial_target_id":"00000000-0000-0000-0000-000000000000","queue_id":"00000000-0000-0000-0000-000000000000","agent_id":"00000000-0000-0000-0000-000000000000","activeflow_id":"00000000-0000-0000-0000-000000000000","flow_id":"00000000-0000-0000-0000-000000000000","reference_type":"","reference_id":"00000000-0000-0000-0000-000000000000","status":"","result":"","disposition":"","source":null,"destination":null,"destination_index":0,"try_count":0,"abandoned":false,"tm_progressing":null,"tm_end":null,"tm_create":null,"tm_update":null,"tm_delete":null}`,
 		},
 	}
 
@@ -296,3 +298,321 @@ func Test_campaigncallsIDDelete_InvalidID(t *testing.T) {
 
 	assertErrorResponse(t, w, cerrors.StatusInvalidArgument, "INVALID_ID")
 }
+
+func Test_campaigncallsIDAcceptPOST(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		agent *auth.AuthIdentity
+
+		reqQuery             string
+		reqBody              []byte
+		responseCampaigncall *cacampaigncall.WebhookMessage
+
+		expectCampaigncallID uuid.UUID
+		expectAgentID        uuid.UUID
+		expectRes            string
+	}{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("7c3f4d62-4f31-11f0-9e1b-4b7a2c8d6e11"),
+				},
+			}),
+
+			reqQuery: "/campaigncalls/7c2e9b54-4f31-11f0-8a7d-1f6e3c5b9d21/accept",
+			reqBody:  []byte(`{"agent_id":"7c3f4d62-4f31-11f0-9e1b-4b7a2c8d6e11"}`),
+			responseCampaigncall: &cacampaigncall.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("7c2e9b54-4f31-11f0-8a7d-1f6e3c5b9d21"),
+				},
+			},
+
+			expectCampaigncallID: uuid.FromStringOrNil("7c2e9b54-4f31-11f0-8a7d-1f6e3c5b9d21"),
+			expectAgentID:        uuid.FromStringOrNil("7c3f4d62-4f31-11f0-9e1b-4b7a2c8d6e11"),
+			expectRes:            `{"id":"7c2e9b54-4f31-11f0-8a7d-1f6e3c5b9d21","customer_id":"00000000-0000-0000-0000-000000000000","campaign_id":"00000000-0000-0000-0000-000000000000","outplan_id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","outdial_target_id":"00000000-0000-0000-0000-000000000000","queue_id":"00000000-0000-0000-0000-000000000000","agent_id":"00000000-0000-0000-0000-000000000000","activeflow_id":"00000000-0000-0000-0000-000000000000","flow_id":"00000000-0000-0000-0000-000000000000","reference_type":"","reference_id":"00000000-0000-0000-0000-000000000000","status":"","result":"","disposition":"","source":null,"destination":null,"destination_index":0,"try_count":0,"abandoned":false,"tm_progressing":null,"tm_end":null,"tm_create":null,"tm_update":null,"tm_delete":null}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// create mock
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSvc := servicehandler.NewMockServiceHandler(mc)
+			h := &server{
+				serviceHandler: mockSvc,
+			}
+
+			w := httptest.NewRecorder()
+			_, r := gin.CreateTestContext(w)
+
+			r.Use(func(c *gin.Context) {
+				c.Set("auth_identity", tt.agent)
+			})
+			openapi_server.RegisterHandlers(r, h)
+
+			req, _ := http.NewRequest("POST", tt.reqQuery, bytes.NewBuffer(tt.reqBody))
+			req.Header.Set("Content-Type", "application/json")
+			mockSvc.EXPECT().CampaigncallAccept(req.Context(), tt.agent, tt.expectCampaigncallID, tt.expectAgentID).Return(tt.responseCampaigncall, nil)
+
+			r.ServeHTTP(w, req)
+			if w.Code != http.StatusOK {
+				t.Errorf("Wrong match. expect: %d, got: %d", http.StatusOK, w.Code)
+			}
+
+			if w.Body.String() != tt.expectRes {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, w.Body)
+			}
+		})
+	}
+}
+
+func Test_campaigncallsIDSkipPOST(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		agent *auth.AuthIdentity
+
+		reqQuery             string
+		responseCampaigncall *cacampaigncall.WebhookMessage
+
+		expectCampaigncallID uuid.UUID
+		expectRes            string
+	}{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("7c3f4d62-4f31-11f0-9e1b-4b7a2c8d6e11"),
+				},
+			}),
+
+			reqQuery: "/campaigncalls/7c5a1e8c-4f31-11f0-b4c2-6d8f2a7e1c31/skip",
+			responseCampaigncall: &cacampaigncall.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("7c5a1e8c-4f31-11f0-b4c2-6d8f2a7e1c31"),
+				},
+			},
+
+			expectCampaigncallID: uuid.FromStringOrNil("7c5a1e8c-4f31-11f0-b4c2-6d8f2a7e1c31"),
+			expectRes:            `{"id":"7c5a1e8c-4f31-11f0-b4c2-6d8f2a7e1c31","customer_id":"00000000-0000-0000-0000-000000000000","campaign_id":"00000000-0000-0000-0000-000000000000","outplan_id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","outdial_target_id":"00000000-0000-0000-0000-000000000000","queue_id":"00000000-0000-0000-0000-000000000000","agent_id":"00000000-0000-0000-0000-000000000000","activeflow_id":"00000000-0000-0000-0000-000000000000","flow_id":"00000000-0000-0000-0000-000000000000","reference_type":"","reference_id":"00000000-0000-0000-0000-000000000000","status":"","result":"","disposition":"","source":null,"destination":null,"destination_index":0,"try_count":0,"abandoned":false,"tm_progressing":null,"tm_end":null,"tm_create":null,"tm_update":null,"tm_delete":null}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// create mock
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSvc := servicehandler.NewMockServiceHandler(mc)
+			h := &server{
+				serviceHandler: mockSvc,
+			}
+
+			w := httptest.NewRecorder()
+			_, r := gin.CreateTestContext(w)
+
+			r.Use(func(c *gin.Context) {
+				c.Set("auth_identity", tt.agent)
+			})
+			openapi_server.RegisterHandlers(r, h)
+
+			req, _ := http.NewRequest("POST", tt.reqQuery, nil)
+			mockSvc.EXPECT().CampaigncallSkip(req.Context(), tt.agent, tt.expectCampaigncallID).Return(tt.responseCampaigncall, nil)
+
+			r.ServeHTTP(w, req)
+			if w.Code != http.StatusOK {
+				t.Errorf("Wrong match. expect: %d, got: %d", http.StatusOK, w.Code)
+			}
+
+			if w.Body.String() != tt.expectRes {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, w.Body)
+			}
+		})
+	}
+}
+
+func Test_campaigncallsIDDispositionPUT(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		agent *auth.AuthIdentity
+
+		reqQuery             string
+		reqBody              []byte
+		responseCampaigncall *cacampaigncall.WebhookMessage
+
+		expectCampaigncallID uuid.UUID
+		expectDisposition    string
+	}{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("c4e1a6b2-ad30-11f1-8f1a-0b3d5f7a9c01"),
+				},
+			}),
+
+			reqQuery: "/campaigncalls/c512c7ce-ad30-11f1-902b-1c4e6a8b0d02/disposition",
+			reqBody:  []byte(`{"disposition":"sale"}`),
+			responseCampaigncall: &cacampaigncall.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("c512c7ce-ad30-11f1-902b-1c4e6a8b0d02"),
+				},
+				Disposition: "sale",
+			},
+
+			expectCampaigncallID: uuid.FromStringOrNil("c512c7ce-ad30-11f1-902b-1c4e6a8b0d02"),
+			expectDisposition:    "sale",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// create mock
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSvc := servicehandler.NewMockServiceHandler(mc)
+			h := &server{
+				serviceHandler: mockSvc,
+			}
+
+			w := httptest.NewRecorder()
+			_, r := gin.CreateTestContext(w)
+
+			r.Use(func(c *gin.Context) {
+				c.Set("auth_identity", tt.agent)
+			})
+			openapi_server.RegisterHandlers(r, h)
+
+			req, _ := http.NewRequest("PUT", tt.reqQuery, bytes.NewBuffer(tt.reqBody))
+			req.Header.Set("Content-Type", "application/json")
+			mockSvc.EXPECT().CampaigncallUpdateDisposition(req.Context(), tt.agent, tt.expectCampaigncallID, tt.expectDisposition).Return(tt.responseCampaigncall, nil)
+
+			r.ServeHTTP(w, req)
+			if w.Code != http.StatusOK {
+				t.Errorf("Wrong match. expect: %d, got: %d", http.StatusOK, w.Code)
+			}
+		})
+	}
+}
+
+func Test_campaigncallsIDCallbackPUT(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		agent *auth.AuthIdentity
+
+		reqQuery              string
+		reqBody               []byte
+		responseOutdialtarget *omoutdialtarget.WebhookMessage
+
+		expectCampaigncallID uuid.UUID
+		expectTMCallback     *time.Time
+		expectAgentID        uuid.UUID
+	}{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("0c3e5a70-4fb2-11f1-8a1b-2c3d4e5f6a01"),
+				},
+			}),
+
+			reqQuery: "/campaigncalls/0c6d8e12-4fb2-11f1-b2c3-4d5e6f7a8b02/callback",
+			reqBody:  []byte(`{"tm_callback":"2026-10-22T15:00:00Z","agent_id":"0c9a1c34-4fb2-11f1-9d4e-6f7a8b9c0d03"}`),
+			responseOutdialtarget: &omoutdialtarget.WebhookMessage{
+				ID: uuid.FromStringOrNil("0cc7a456-4fb2-11f1-a5f6-8b9c0d1e2f04"),
+			},
+
+			expectCampaigncallID: uuid.FromStringOrNil("0c6d8e12-4fb2-11f1-b2c3-4d5e6f7a8b02"),
+			expectTMCallback:     func() *time.Time { t := time.Date(2026, 10, 22, 15, 0, 0, 0, time.UTC); return &t }(),
+			expectAgentID:        uuid.FromStringOrNil("0c9a1c34-4fb2-11f1-9d4e-6f7a8b9c0d03"),
+		},
+		{
+			name: "cancel",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("0c3e5a70-4fb2-11f1-8a1b-2c3d4e5f6a01"),
+				},
+			}),
+
+			reqQuery: "/campaigncalls/0c6d8e12-4fb2-11f1-b2c3-4d5e6f7a8b02/callback",
+			reqBody:  []byte(`{"tm_callback":""}`),
+			responseOutdialtarget: &omoutdialtarget.WebhookMessage{
+				ID: uuid.FromStringOrNil("0cc7a456-4fb2-11f1-a5f6-8b9c0d1e2f04"),
+			},
+
+			expectCampaigncallID: uuid.FromStringOrNil("0c6d8e12-4fb2-11f1-b2c3-4d5e6f7a8b02"),
+			expectTMCallback:     nil,
+			expectAgentID:        uuid.Nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// create mock
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSvc := servicehandler.NewMockServiceHandler(mc)
+			h := &server{
+				serviceHandler: mockSvc,
+			}
+
+			w := httptest.NewRecorder()
+			_, r := gin.CreateTestContext(w)
+
+			r.Use(func(c *gin.Context) {
+				c.Set("auth_identity", tt.agent)
+			})
+			openapi_server.RegisterHandlers(r, h)
+
+			req, _ := http.NewRequest("PUT", tt.reqQuery, bytes.NewBuffer(tt.reqBody))
+			req.Header.Set("Content-Type", "application/json")
+			mockSvc.EXPECT().CampaigncallUpdateCallback(req.Context(), tt.agent, tt.expectCampaigncallID, tt.expectTMCallback, tt.expectAgentID).Return(tt.responseOutdialtarget, nil)
+
+			r.ServeHTTP(w, req)
+			if w.Code != http.StatusOK {
+				t.Errorf("Wrong match. expect: %d, got: %d", http.StatusOK, w.Code)
+			}
+		})
+	}
+}
+
+func Test_campaigncallsIDCallbackPUT_InvalidTMCallback(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	agent := auth.NewAgentIdentity(&amagent.Agent{
+		Identity: commonidentity.Identity{
+			ID: uuid.FromStringOrNil("0d02b678-4fb2-11f1-b7a8-0d1e2f3a4b05"),
+		},
+	})
+
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockSvc := servicehandler.NewMockServiceHandler(mc)
+	h := &server{serviceHandler: mockSvc}
+
+	w := httptest.NewRecorder()
+	_, r := gin.CreateTestContext(w)
+	r.Use(middleware.RequestID())
+	r.Use(func(c *gin.Context) {
+		c.Set("auth_identity", agent)
+	})
+	openapi_server.RegisterHandlers(r, h)
+
+	req, _ := http.NewRequest(http.MethodPut,
+		"/campaigncalls/0d2fc89a-4fb2-11f1-8c9d-2f3a4b5c6d06/callback",
+		bytes.NewBufferString(`{"tm_callback":"tomorrow"}`))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+
+	assertErrorResponse(t, w, cerrors.StatusInvalidArgument, "INVALID_TM_CALLBACK")
+}
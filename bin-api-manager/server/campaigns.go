@@ -290,6 +290,110 @@ func (h *server) PutCampaignsIdServiceLevel(c *gin.Context, id string) {
 	c.JSON(200, res)
 }
 
+func (h *server) PutCampaignsIdDialMode(c *gin.Context, id string) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":            "PutCampaignsIdDialMode",
+		"request_address": c.ClientIP,
+	})
+
+	a, ok := getAuthIdentity(c)
+	if !ok {
+		log.Errorf("Could not find auth identity.")
+		abortWithError(c, cerrors.Unauthenticated(commonoutline.ServiceNameAPIManager, "AUTHENTICATION_REQUIRED", "Authentication is required."))
+		return
+	}
+	log = log.WithFields(logrus.Fields{
+		"auth": a,
+	})
+
+	target := uuid.FromStringOrNil(id)
+	if target == uuid.Nil {
+		log.Error("Could not parse the id.")
+		abortWithError(c, cerrors.InvalidArgument(commonoutline.ServiceNameAPIManager, "INVALID_ID", "The provided id is not a valid UUID."))
+		return
+	}
+
+	var req openapi_server.PutCampaignsIdDialModeJSONBody
+	if err := c.BindJSON(&req); err != nil {
+		log.Errorf("Could not parse the request. err: %v", err)
+		abortWithError(c, cerrors.InvalidArgument(commonoutline.ServiceNameAPIManager, "INVALID_JSON_BODY", "The request body is not valid JSON.").Wrap(err))
+		return
+	}
+
+	maxAbandonRate := 0
+	if req.MaxAbandonRate != nil {
+		maxAbandonRate = *req.MaxAbandonRate
+	}
+
+	res, err := h.serviceHandler.CampaignUpdateDialMode(c.Request.Context(), a, target, cmcampaign.DialMode(req.DialMode), maxAbandonRate)
+	if err != nil {
+		log.Errorf("Could not update the campaign. err: %v", err)
+		abortWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(200, res)
+}
+
+func (h *server) PutCampaignsIdCallingWindows(c *gin.Context, id string) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":            "PutCampaignsIdCallingWindows",
+		"request_address": c.ClientIP,
+	})
+
+	a, ok := getAuthIdentity(c)
+	if !ok {
+		log.Errorf("Could not find auth identity.")
+		abortWithError(c, cerrors.Unauthenticated(commonoutline.ServiceNameAPIManager, "AUTHENTICATION_REQUIRED", "Authentication is required."))
+		return
+	}
+	log = log.WithFields(logrus.Fields{
+		"auth": a,
+	})
+
+	target := uuid.FromStringOrNil(id)
+	if target == uuid.Nil {
+		log.Error("Could not parse the id.")
+		abortWithError(c, cerrors.InvalidArgument(commonoutline.ServiceNameAPIManager, "INVALID_ID", "The provided id is not a valid UUID."))
+		return
+	}
+
+	var req openapi_server.PutCampaignsIdCallingWindowsJSONBody
+	if err := c.BindJSON(&req); err != nil {
+		log.Errorf("Could not parse the request. err: %v", err)
+		abortWithError(c, cerrors.InvalidArgument(commonoutline.ServiceNameAPIManager, "INVALID_JSON_BODY", "The request body is not valid JSON.").Wrap(err))
+		return
+	}
+
+	callingWindows := []cmcampaign.CallingWindow{}
+	for _, v := range req.CallingWindows {
+		tmp := cmcampaign.CallingWindow{
+			Start: v.Start,
+			End:   v.End,
+		}
+		if v.Days != nil {
+			for _, d := range *v.Days {
+				tmp.Days = append(tmp.Days, cmcampaign.Day(d))
+			}
+		}
+		callingWindows = append(callingWindows, tmp)
+	}
+
+	timezone := ""
+	if req.Timezone != nil {
+		timezone = *req.Timezone
+	}
+
+	res, err := h.serviceHandler.CampaignUpdateCallingWindows(c.Request.Context(), a, target, callingWindows, timezone)
+	if err != nil {
+		log.Errorf("Could not update the campaign. err: %v", err)
+		abortWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(200, res)
+}
+
 func (h *server) PutCampaignsIdActions(c *gin.Context, id string) {
 	log := logrus.WithFields(logrus.Fields{
 		"func":            "campaignsIDActionsPUT",
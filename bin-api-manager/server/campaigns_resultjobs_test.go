@@ -0,0 +1,233 @@
+package server
+
+import (
+	amagent "monorepo/bin-agent-manager/models/agent"
+	"monorepo/bin-api-manager/gens/openapi_server"
+	"monorepo/bin-api-manager/models/auth"
+	"monorepo/bin-api-manager/pkg/servicehandler"
+	cmcampaignresultjob "monorepo/bin-campaign-manager/models/campaignresultjob"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_campaignsIDResultjobsPOST(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		agent *auth.AuthIdentity
+
+		reqQuery string
+
+		responseCampaignresultjob *cmcampaignresultjob.WebhookMessage
+
+		expectCampaignID uuid.UUID
+		expectRes        string
+	}{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("2a2ec0ba-8004-11ec-aea5-439829c92a7c"),
+				},
+			}),
+
+			reqQuery: "/campaigns/7a1b2c3d-b5f0-11f0-8a01-0a1b2c3d4e01/resultjobs",
+
+			responseCampaignresultjob: &cmcampaignresultjob.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("7a4c5d6e-b5f0-11f0-9b12-1b2c3d4e5f02"),
+				},
+				CampaignID: uuid.FromStringOrNil("7a1b2c3d-b5f0-11f0-8a01-0a1b2c3d4e01"),
+				Status:     cmcampaignresultjob.StatusProcessing,
+			},
+
+			expectCampaignID: uuid.FromStringOrNil("7a1b2c3d-b5f0-11f0-8a01-0a1b2c3d4e01"),
+			expectRes:        `{"id":"7a4c5d6e-b5f0-11f0-9b12-1b2c3d4e5f02","customer_id":"00000000-0000-0000-0000-000000000000","campaign_id":"7a1b2c3d-b5f0-11f0-8a01-0a1b2c3d4e01","status":"processing","file_id":"00000000-0000-0000-0000-000000000000","target_count":0,"row_count":0,"detail":"","tm_create":null,"tm_update":null,"tm_delete":null}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSvc := servicehandler.NewMockServiceHandler(mc)
+			h := &server{
+				serviceHandler: mockSvc,
+			}
+
+			w := httptest.NewRecorder()
+			_, r := gin.CreateTestContext(w)
+
+			r.Use(func(c *gin.Context) {
+				c.Set("auth_identity", tt.agent)
+			})
+			openapi_server.RegisterHandlers(r, h)
+
+			req, _ := http.NewRequest("POST", tt.reqQuery, nil)
+			mockSvc.EXPECT().CampaignResultjobCreate(req.Context(), tt.agent, tt.expectCampaignID).Return(tt.responseCampaignresultjob, nil)
+
+			r.ServeHTTP(w, req)
+			if w.Code != http.StatusOK {
+				t.Errorf("Wrong match. expect: %d, got: %d", http.StatusOK, w.Code)
+			}
+
+			if w.Body.String() != tt.expectRes {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, w.Body)
+			}
+		})
+	}
+}
+
+func Test_campaignsIDResultjobsGET(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		agent *auth.AuthIdentity
+
+		reqQuery string
+
+		responseCampaignresultjobs []*cmcampaignresultjob.WebhookMessage
+
+		expectCampaignID uuid.UUID
+		expectPageSize   uint64
+		expectPageToken  string
+		expectRes        string
+	}{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("2a2ec0ba-8004-11ec-aea5-439829c92a7c"),
+				},
+			}),
+
+			reqQuery: "/campaigns/7a7d8e9f-b5f0-11f0-ac23-2c3d4e5f6a03/resultjobs?page_size=10&page_token=2021-03-02T03:23:20.995000Z",
+
+			responseCampaignresultjobs: []*cmcampaignresultjob.WebhookMessage{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("7aae9fa0-b5f0-11f0-bd34-3d4e5f6a7b04"),
+					},
+					TMCreate: timePtr("2020-09-20T03:23:21.995000Z"),
+				},
+			},
+
+			expectCampaignID: uuid.FromStringOrNil("7a7d8e9f-b5f0-11f0-ac23-2c3d4e5f6a03"),
+			expectPageSize:   10,
+			expectPageToken:  "2021-03-02T03:23:20.995000Z",
+			expectRes:        `{"result":[{"id":"7aae9fa0-b5f0-11f0-bd34-3d4e5f6a7b04","customer_id":"00000000-0000-0000-0000-000000000000","campaign_id":"00000000-0000-0000-0000-000000000000","status":"","file_id":"00000000-0000-0000-0000-000000000000","target_count":0,"row_count":0,"detail":"","tm_create":"2020-09-20T03:23:21.995Z","tm_update":null,"tm_delete":null}],"next_page_token":"2020-09-20T03:23:21.995000Z"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSvc := servicehandler.NewMockServiceHandler(mc)
+			h := &server{
+				serviceHandler: mockSvc,
+			}
+
+			w := httptest.NewRecorder()
+			_, r := gin.CreateTestContext(w)
+
+			r.Use(func(c *gin.Context) {
+				c.Set("auth_identity", tt.agent)
+			})
+			openapi_server.RegisterHandlers(r, h)
+
+			req, _ := http.NewRequest("GET", tt.reqQuery, nil)
+			mockSvc.EXPECT().CampaignResultjobList(req.Context(), tt.agent, tt.expectCampaignID, tt.expectPageSize, tt.expectPageToken).Return(tt.responseCampaignresultjobs, nil)
+
+			r.ServeHTTP(w, req)
+			if w.Code != http.StatusOK {
+				t.Errorf("Wrong match. expect: %d, got: %d", http.StatusOK, w.Code)
+			}
+
+			if w.Body.String() != tt.expectRes {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, w.Body)
+			}
+		})
+	}
+}
+
+func Test_campaignsIDResultjobsIDGET(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		agent *auth.AuthIdentity
+
+		reqQuery string
+
+		responseCampaignresultjob *cmcampaignresultjob.WebhookMessage
+
+		expectCampaignID          uuid.UUID
+		expectCampaignresultjobID uuid.UUID
+		expectRes                 string
+	}{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("2a2ec0ba-8004-11ec-aea5-439829c92a7c"),
+				},
+			}),
+
+			reqQuery: "/campaigns/7adfb0b1-b5f0-11f0-8e45-4e5f6a7b8c05/resultjobs/7b10c1c2-b5f0-11f0-9f56-5f6a7b8c9d06",
+
+			responseCampaignresultjob: &cmcampaignresultjob.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("7b10c1c2-b5f0-11f0-9f56-5f6a7b8c9d06"),
+				},
+				CampaignID: uuid.FromStringOrNil("7adfb0b1-b5f0-11f0-8e45-4e5f6a7b8c05"),
+				Status:     cmcampaignresultjob.StatusDone,
+				FileID:     uuid.FromStringOrNil("7b41d2d3-b5f0-11f0-a067-6a7b8c9d0e07"),
+			},
+
+			expectCampaignID:          uuid.FromStringOrNil("7adfb0b1-b5f0-11f0-8e45-4e5f6a7b8c05"),
+			expectCampaignresultjobID: uuid.FromStringOrNil("7b10c1c2-b5f0-11f0-9f56-5f6a7b8c9d06"),
+			expectRes:                 `{"id":"7b10c1c2-b5f0-11f0-9f56-5f6a7b8c9d06","customer_id":"00000000-0000-0000-0000-000000000000","campaign_id":"7adfb0b1-b5f0-11f0-8e45-4e5f6a7b8c05","status":"done","file_id":"7b41d2d3-b5f0-11f0-a067-6a7b8c9d0e07","target_count":0,"row_count":0,"detail":"","tm_create":null,"tm_update":null,"tm_delete":null}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSvc := servicehandler.NewMockServiceHandler(mc)
+			h := &server{
+				serviceHandler: mockSvc,
+			}
+
+			w := httptest.NewRecorder()
+			_, r := gin.CreateTestContext(w)
+
+			r.Use(func(c *gin.Context) {
+				c.Set("auth_identity", tt.agent)
+			})
+			openapi_server.RegisterHandlers(r, h)
+
+			req, _ := http.NewRequest("GET", tt.reqQuery, nil)
+			mockSvc.EXPECT().CampaignResultjobGet(req.Context(), tt.agent, tt.expectCampaignID, tt.expectCampaignresultjobID).Return(tt.responseCampaignresultjob, nil)
+
+			r.ServeHTTP(w, req)
+			if w.Code != http.StatusOK {
+				t.Errorf("Wrong match. expect: %d, got: %d", http.StatusOK, w.Code)
+			}
+
+			if w.Body.String() != tt.expectRes {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, w.Body)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package server
+
+import (
+	"monorepo/bin-api-manager/gens/openapi_server"
+	cerrors "monorepo/bin-common-handler/models/errors"
+	commonoutline "monorepo/bin-common-handler/models/outline"
+	omoutdialtarget "monorepo/bin-outdial-manager/models/outdialtarget"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+func (h *server) GetCampaignsIdResults(c *gin.Context, id string, params openapi_server.GetCampaignsIdResultsParams) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":            "GetCampaignsIdResults",
+		"request_address": c.ClientIP,
+	})
+
+	a, ok := getAuthIdentity(c)
+	if !ok {
+		log.Errorf("Could not find auth identity.")
+		abortWithError(c, cerrors.Unauthenticated(commonoutline.ServiceNameAPIManager, "AUTHENTICATION_REQUIRED", "Authentication is required."))
+		return
+	}
+	log = log.WithFields(logrus.Fields{
+		"auth": a,
+	})
+
+	target := uuid.FromStringOrNil(id)
+	if target == uuid.Nil {
+		log.Error("Could not parse the id.")
+		abortWithError(c, cerrors.InvalidArgument(commonoutline.ServiceNameAPIManager, "INVALID_ID", "The provided id is not a valid UUID."))
+		return
+	}
+
+	pageSize := uint64(100)
+	if params.PageSize != nil {
+		pageSize = uint64(*params.PageSize)
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 100
+		log.Debugf("Invalid requested page size. Set to default. page_size: %d", pageSize)
+	}
+
+	pageToken := ""
+	if params.PageToken != nil {
+		pageToken = *params.PageToken
+	}
+
+	tmps, err := h.serviceHandler.CampaignResultList(c.Request.Context(), a, target, pageSize, pageToken)
+	if err != nil {
+		log.Errorf("Could not get the campaign results. err: %v", err)
+		abortWithServiceError(c, err)
+		return
+	}
+
+	// the targets of the same import share the tm_create. use the (tm_create, id) cursor not to skip them.
+	nextToken := ""
+	if len(tmps) > 0 {
+		nextToken = omoutdialtarget.CursorToken(tmps[len(tmps)-1].TMCreate, tmps[len(tmps)-1].OutdialTargetID)
+	}
+
+	res := GenerateListResponse(tmps, nextToken)
+	c.JSON(200, res)
+}
@@ -0,0 +1,92 @@
+package server
+
+import (
+	amagent "monorepo/bin-agent-manager/models/agent"
+	"monorepo/bin-api-manager/gens/openapi_server"
+	"monorepo/bin-api-manager/models/auth"
+	"monorepo/bin-api-manager/pkg/servicehandler"
+	cmcampaignresult "monorepo/bin-campaign-manager/models/campaignresult"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_campaignsIDResultsGET(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		agent *auth.AuthIdentity
+
+		reqQuery string
+
+		responseResults []*cmcampaignresult.CampaignResult
+
+		expectCampaignID uuid.UUID
+		expectPageSize   uint64
+		expectPageToken  string
+		expectRes        string
+	}{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("b1a2c4e0-ad30-11f1-8c1d-0e2a4c6e8a01"),
+				},
+			}),
+
+			reqQuery: "/campaigns/b1d3e5fc-ad30-11f1-9d2e-1f3b5d7f9b02/results?page_size=10&page_token=2021-03-02T03:23:20.995000Z",
+
+			responseResults: []*cmcampaignresult.CampaignResult{
+				{
+					CampaignID:      uuid.FromStringOrNil("b1d3e5fc-ad30-11f1-9d2e-1f3b5d7f9b02"),
+					OutdialTargetID: uuid.FromStringOrNil("b2050718-ad30-11f1-ae3f-2a4c6e8a0c03"),
+					Attempts:        []cmcampaignresult.Attempt{},
+					TMCreate:        timePtr("2020-09-20T03:23:21.995000Z"),
+				},
+			},
+
+			expectCampaignID: uuid.FromStringOrNil("b1d3e5fc-ad30-11f1-9d2e-1f3b5d7f9b02"),
+			expectPageSize:   10,
+			expectPageToken:  "2021-03-02T03:23:20.995000Z",
+			expectRes:        `{"result":[{"campaign_id":"b1d3e5fc-ad30-11f1-9d2e-1f3b5d7f9b02","outdial_target_id":"b2050718-ad30-11f1-ae3f-2a4c6e8a0c03","name":"","status":"","attempts":[],"tm_create":"2020-09-20T03:23:21.995Z"}],"next_page_token":"2020-09-20T03:23:21.995000Z,b2050718-ad30-11f1-ae3f-2a4c6e8a0c03"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSvc := servicehandler.NewMockServiceHandler(mc)
+			h := &server{
+				serviceHandler: mockSvc,
+			}
+
+			w := httptest.NewRecorder()
+			_, r := gin.CreateTestContext(w)
+
+			r.Use(func(c *gin.Context) {
+				c.Set("auth_identity", tt.agent)
+			})
+			openapi_server.RegisterHandlers(r, h)
+
+			req, _ := http.NewRequest("GET", tt.reqQuery, nil)
+			mockSvc.EXPECT().CampaignResultList(req.Context(), tt.agent, tt.expectCampaignID, tt.expectPageSize, tt.expectPageToken).Return(tt.responseResults, nil)
+
+			r.ServeHTTP(w, req)
+			if w.Code != http.StatusOK {
+				t.Errorf("Wrong match. expect: %d, got: %d", http.StatusOK, w.Code)
+			}
+
+			if w.Body.String() != tt.expectRes {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, w.Body)
+			}
+		})
+	}
+}
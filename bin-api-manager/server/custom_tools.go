@@ -0,0 +1,264 @@
+package server
+
+import (
+	"monorepo/bin-api-manager/gens/openapi_server"
+	cerrors "monorepo/bin-common-handler/models/errors"
+	commonoutline "monorepo/bin-common-handler/models/outline"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+func (h *server) PostCustomTools(c *gin.Context) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":            "PostCustomTools",
+		"request_address": c.ClientIP,
+	})
+
+	a, ok := getAuthIdentity(c)
+	if !ok {
+		log.Errorf("Could not find auth identity.")
+		abortWithError(c, cerrors.Unauthenticated(commonoutline.ServiceNameAPIManager, "AUTHENTICATION_REQUIRED", "Authentication is required."))
+		return
+	}
+	log = log.WithFields(logrus.Fields{
+		"agent": a,
+	})
+
+	var req openapi_server.PostCustomToolsJSONBody
+	if err := c.BindJSON(&req); err != nil {
+		log.Errorf("Could not parse the request. err: %v", err)
+		abortWithError(c, cerrors.InvalidArgument(commonoutline.ServiceNameAPIManager, "INVALID_JSON_BODY", "The request body is not valid JSON.").Wrap(err))
+		return
+	}
+
+	detail, parameters, headers, timeout, responseTemplate := convertCustomToolOptionals(req.Detail, req.Parameters, req.Headers, req.Timeout, req.ResponseTemplate)
+
+	res, err := h.serviceHandler.CustomToolCreate(
+		c.Request.Context(),
+		a,
+		req.Name,
+		detail,
+		req.Description,
+		parameters,
+		req.Url,
+		headers,
+		timeout,
+		responseTemplate,
+	)
+	if err != nil {
+		log.Errorf("Could not create a custom tool. err: %v", err)
+		abortWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(200, res)
+}
+
+func (h *server) GetCustomTools(c *gin.Context, params openapi_server.GetCustomToolsParams) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":            "GetCustomTools",
+		"request_address": c.ClientIP,
+	})
+
+	a, ok := getAuthIdentity(c)
+	if !ok {
+		log.Errorf("Could not find auth identity.")
+		abortWithError(c, cerrors.Unauthenticated(commonoutline.ServiceNameAPIManager, "AUTHENTICATION_REQUIRED", "Authentication is required."))
+		return
+	}
+	log = log.WithFields(logrus.Fields{
+		"agent": a,
+	})
+
+	pageSize := uint64(100)
+	if params.PageSize != nil {
+		pageSize = uint64(*params.PageSize)
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 100
+		log.Debugf("Invalid requested page size. Set to default. page_size: %d", pageSize)
+	}
+
+	pageToken := ""
+	if params.PageToken != nil {
+		pageToken = *params.PageToken
+	}
+
+	tmps, err := h.serviceHandler.CustomToolGetsByCustomerID(c.Request.Context(), a, pageSize, pageToken)
+	if err != nil {
+		log.Errorf("Could not get a custom tool list. err: %v", err)
+		abortWithServiceError(c, err)
+		return
+	}
+
+	nextToken := ""
+	if len(tmps) > 0 {
+		if tmps[len(tmps)-1].TMCreate != nil {
+			nextToken = tmps[len(tmps)-1].TMCreate.UTC().Format("2006-01-02T15:04:05.000000Z")
+		}
+	}
+
+	res := GenerateListResponse(tmps, nextToken)
+	c.JSON(200, res)
+}
+
+func (h *server) GetCustomToolsId(c *gin.Context, id string) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":            "GetCustomToolsId",
+		"request_address": c.ClientIP,
+		"custom_tool_id":  id,
+	})
+
+	a, ok := getAuthIdentity(c)
+	if !ok {
+		log.Errorf("Could not find auth identity.")
+		abortWithError(c, cerrors.Unauthenticated(commonoutline.ServiceNameAPIManager, "AUTHENTICATION_REQUIRED", "Authentication is required."))
+		return
+	}
+	log = log.WithFields(logrus.Fields{
+		"agent": a,
+	})
+
+	target := uuid.FromStringOrNil(id)
+	if target == uuid.Nil {
+		log.Error("Could not parse the id.")
+		abortWithError(c, cerrors.InvalidArgument(commonoutline.ServiceNameAPIManager, "INVALID_ID", "The provided id is not a valid UUID."))
+		return
+	}
+
+	res, err := h.serviceHandler.CustomToolGet(c.Request.Context(), a, target)
+	if err != nil {
+		log.Errorf("Could not get a custom tool. err: %v", err)
+		abortWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(200, res)
+}
+
+func (h *server) DeleteCustomToolsId(c *gin.Context, id string) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":            "DeleteCustomToolsId",
+		"request_address": c.ClientIP,
+		"custom_tool_id":  id,
+	})
+
+	a, ok := getAuthIdentity(c)
+	if !ok {
+		log.Errorf("Could not find auth identity.")
+		abortWithError(c, cerrors.Unauthenticated(commonoutline.ServiceNameAPIManager, "AUTHENTICATION_REQUIRED", "Authentication is required."))
+		return
+	}
+	log = log.WithFields(logrus.Fields{
+		"agent": a,
+	})
+
+	target := uuid.FromStringOrNil(id)
+	if target == uuid.Nil {
+		log.Error("Could not parse the id.")
+		abortWithError(c, cerrors.InvalidArgument(commonoutline.ServiceNameAPIManager, "INVALID_ID", "The provided id is not a valid UUID."))
+		return
+	}
+
+	res, err := h.serviceHandler.CustomToolDelete(c.Request.Context(), a, target)
+	if err != nil {
+		log.Errorf("Could not delete the custom tool. err: %v", err)
+		abortWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(200, res)
+}
+
+func (h *server) PutCustomToolsId(c *gin.Context, id string) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":            "PutCustomToolsId",
+		"request_address": c.ClientIP,
+		"custom_tool_id":  id,
+	})
+
+	a, ok := getAuthIdentity(c)
+	if !ok {
+		log.Errorf("Could not find auth identity.")
+		abortWithError(c, cerrors.Unauthenticated(commonoutline.ServiceNameAPIManager, "AUTHENTICATION_REQUIRED", "Authentication is required."))
+		return
+	}
+	log = log.WithFields(logrus.Fields{
+		"agent": a,
+	})
+
+	target := uuid.FromStringOrNil(id)
+	if target == uuid.Nil {
+		log.Error("Could not parse the id.")
+		abortWithError(c, cerrors.InvalidArgument(commonoutline.ServiceNameAPIManager, "INVALID_ID", "The provided id is not a valid UUID."))
+		return
+	}
+
+	var req openapi_server.PutCustomToolsIdJSONBody
+	if err := c.BindJSON(&req); err != nil {
+		log.Errorf("Could not parse the request. err: %v", err)
+		abortWithError(c, cerrors.InvalidArgument(commonoutline.ServiceNameAPIManager, "INVALID_JSON_BODY", "The request body is not valid JSON.").Wrap(err))
+		return
+	}
+
+	detail, parameters, headers, timeout, responseTemplate := convertCustomToolOptionals(req.Detail, req.Parameters, req.Headers, req.Timeout, req.ResponseTemplate)
+
+	res, err := h.serviceHandler.CustomToolUpdate(
+		c.Request.Context(),
+		a,
+		target,
+		req.Name,
+		detail,
+		req.Description,
+		parameters,
+		req.Url,
+		headers,
+		timeout,
+		responseTemplate,
+	)
+	if err != nil {
+		log.Errorf("Could not update the custom tool. err: %v", err)
+		abortWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(200, res)
+}
+
+// convertCustomToolOptionals dereferences the optional custom tool request fields.
+func convertCustomToolOptionals(
+	reqDetail *string,
+	reqParameters *map[string]any,
+	reqHeaders *map[string]string,
+	reqTimeout *int,
+	reqResponseTemplate *string,
+) (string, map[string]any, map[string]string, int, string) {
+	detail := ""
+	if reqDetail != nil {
+		detail = *reqDetail
+	}
+
+	var parameters map[string]any
+	if reqParameters != nil {
+		parameters = *reqParameters
+	}
+
+	var headers map[string]string
+	if reqHeaders != nil {
+		headers = *reqHeaders
+	}
+
+	timeout := 0
+	if reqTimeout != nil {
+		timeout = *reqTimeout
+	}
+
+	responseTemplate := ""
+	if reqResponseTemplate != nil {
+		responseTemplate = *reqResponseTemplate
+	}
+
+	return detail, parameters, headers, timeout, responseTemplate
+}
@@ -0,0 +1,279 @@
+package server
+
+import (
+	"bytes"
+	amagent "monorepo/bin-agent-manager/models/agent"
+	amcustomtool "monorepo/bin-ai-manager/models/customtool"
+	"monorepo/bin-api-manager/gens/openapi_server"
+	"monorepo/bin-api-manager/lib/middleware"
+	"monorepo/bin-api-manager/models/auth"
+	"monorepo/bin-api-manager/pkg/servicehandler"
+	cerrors "monorepo/bin-common-handler/models/errors"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_PostCustomTools(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		agent *auth.AuthIdentity
+
+		reqQuery string
+		reqBody  []byte
+
+		responseCustomTool *amcustomtool.WebhookMessage
+
+		expectName             string
+		expectDetail           string
+		expectDescription      string
+		expectParameters       map[string]any
+		expectURL              string
+		expectHeaders          map[string]string
+		expectTimeout          int
+		expectResponseTemplate string
+		expectRes              string
+	}{
+		{
+			name: "full request",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("5a0b3c1e-a8fc-11f0-8e5d-2b7c4d1e3f01"),
+				},
+			}),
+
+			reqQuery: "/custom_tools",
+			reqBody:  []byte(`{"name":"lookup_order","detail":"shop","description":"Look up an order.","parameters":{"type":"object"},"url":"https://example.com/orders","headers":{"X-Api-Key":"key"},"timeout":3000,"response_template":"{{.status}}"}`),
+
+			responseCustomTool: &amcustomtool.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("5a3764e4-a8fc-11f0-9a1b-3c8d5e2f4a02"),
+				},
+			},
+
+			expectName:        "lookup_order",
+			expectDetail:      "shop",
+			expectDescription: "Look up an order.",
+			expectParameters: map[string]any{
+				"type": "object",
+			},
+			expectURL: "https://example.com/orders",
+			expectHeaders: map[string]string{
+				"X-Api-Key": "key",
+			},
+			expectTimeout:          3000,
+			expectResponseTemplate: "{{.status}}",
+			expectRes:              `{"id":"5a3764e4-a8fc-11f0-9a1b-3c8d5e2f4a02","customer_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`,
+		},
+		{
+			name: "optional fields omitted",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("5a0b3c1e-a8fc-11f0-8e5d-2b7c4d1e3f01"),
+				},
+			}),
+
+			reqQuery: "/custom_tools",
+			reqBody:  []byte(`{"name":"lookup_order","description":"Look up an order.","url":"https://example.com/orders"}`),
+
+			responseCustomTool: &amcustomtool.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("5a3764e4-a8fc-11f0-9a1b-3c8d5e2f4a02"),
+				},
+			},
+
+			expectName:        "lookup_order",
+			expectDescription: "Look up an order.",
+			expectURL:         "https://example.com/orders",
+			expectRes:         `{"id":"5a3764e4-a8fc-11f0-9a1b-3c8d5e2f4a02","customer_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSvc := servicehandler.NewMockServiceHandler(mc)
+			h := &server{
+				serviceHandler: mockSvc,
+			}
+
+			w := httptest.NewRecorder()
+			_, r := gin.CreateTestContext(w)
+
+			r.Use(func(c *gin.Context) {
+				c.Set("auth_identity", tt.agent)
+			})
+			openapi_server.RegisterHandlers(r, h)
+
+			req, _ := http.NewRequest("POST", tt.reqQuery, bytes.NewBuffer(tt.reqBody))
+			req.Header.Set("Content-Type", "application/json")
+			mockSvc.EXPECT().CustomToolCreate(
+				req.Context(),
+				tt.agent,
+				tt.expectName,
+				tt.expectDetail,
+				tt.expectDescription,
+				tt.expectParameters,
+				tt.expectURL,
+				tt.expectHeaders,
+				tt.expectTimeout,
+				tt.expectResponseTemplate,
+			).Return(tt.responseCustomTool, nil)
+
+			r.ServeHTTP(w, req)
+			if w.Code != http.StatusOK {
+				t.Errorf("Wrong match. expect: %d, got: %d", http.StatusOK, w.Code)
+			}
+
+			if w.Body.String() != tt.expectRes {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, w.Body)
+			}
+		})
+	}
+}
+
+func Test_GetCustomTools(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		agent *auth.AuthIdentity
+
+		reqQuery string
+
+		responseCustomTools []*amcustomtool.WebhookMessage
+
+		expectPageSize  uint64
+		expectPageToken string
+		expectRes       string
+	}{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("5a637d0a-a8fc-11f0-b2c7-4d9e6f3a5b03"),
+				},
+			}),
+
+			reqQuery: "/custom_tools?page_size=10&page_token=2020-09-20%2003:23:20.995000",
+
+			responseCustomTools: []*amcustomtool.WebhookMessage{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("5a8f9630-a8fc-11f0-8c3e-5eaf7a4b6c04"),
+					},
+				},
+			},
+
+			expectPageSize:  10,
+			expectPageToken: "2020-09-20 03:23:20.995000",
+			expectRes:       `{"result":[{"id":"5a8f9630-a8fc-11f0-8c3e-5eaf7a4b6c04","customer_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}],"next_page_token":""}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSvc := servicehandler.NewMockServiceHandler(mc)
+			h := &server{
+				serviceHandler: mockSvc,
+			}
+
+			w := httptest.NewRecorder()
+			_, r := gin.CreateTestContext(w)
+
+			r.Use(func(c *gin.Context) {
+				c.Set("auth_identity", tt.agent)
+			})
+			openapi_server.RegisterHandlers(r, h)
+
+			req, _ := http.NewRequest("GET", tt.reqQuery, nil)
+			mockSvc.EXPECT().CustomToolGetsByCustomerID(req.Context(), tt.agent, tt.expectPageSize, tt.expectPageToken).Return(tt.responseCustomTools, nil)
+
+			r.ServeHTTP(w, req)
+			if w.Code != http.StatusOK {
+				t.Errorf("Wrong match. expect: %d, got: %d", http.StatusOK, w.Code)
+			}
+
+			if w.Body.String() != tt.expectRes {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, w.Body)
+			}
+		})
+	}
+}
+
+// Test_customToolsIDGet_InvalidID verifies that a malformed UUID in the path
+// triggers INVALID_ARGUMENT / INVALID_ID before the servicehandler is
+// consulted.
+func Test_customToolsIDGet_InvalidID(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	agent := auth.NewAgentIdentity(&amagent.Agent{
+		Identity: commonidentity.Identity{
+			ID: uuid.FromStringOrNil("5abbaf56-a8fc-11f0-9d4f-6fb08b5c7d05"),
+		},
+	})
+
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockSvc := servicehandler.NewMockServiceHandler(mc)
+	h := &server{serviceHandler: mockSvc}
+
+	w := httptest.NewRecorder()
+	_, r := gin.CreateTestContext(w)
+	r.Use(middleware.RequestID())
+	r.Use(func(c *gin.Context) {
+		c.Set("auth_identity", agent)
+	})
+	openapi_server.RegisterHandlers(r, h)
+
+	req, _ := http.NewRequest(http.MethodGet, "/custom_tools/not-a-uuid", nil)
+	r.ServeHTTP(w, req)
+
+	assertErrorResponse(t, w, cerrors.StatusInvalidArgument, "INVALID_ID")
+}
+
+func Test_DeleteCustomToolsId(t *testing.T) {
+
+	agent := auth.NewAgentIdentity(&amagent.Agent{
+		Identity: commonidentity.Identity{
+			ID: uuid.FromStringOrNil("5ae7c87c-a8fc-11f0-ae50-70c19c6d8e06"),
+		},
+	})
+	customToolID := uuid.FromStringOrNil("5b13e1a2-a8fc-11f0-8f61-81d2ad7e9f07")
+
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockSvc := servicehandler.NewMockServiceHandler(mc)
+	h := &server{serviceHandler: mockSvc}
+
+	w := httptest.NewRecorder()
+	_, r := gin.CreateTestContext(w)
+	r.Use(func(c *gin.Context) {
+		c.Set("auth_identity", agent)
+	})
+	openapi_server.RegisterHandlers(r, h)
+
+	req, _ := http.NewRequest(http.MethodDelete, "/custom_tools/"+customToolID.String(), nil)
+	mockSvc.EXPECT().CustomToolDelete(req.Context(), agent, customToolID).Return(&amcustomtool.WebhookMessage{
+		Identity: commonidentity.Identity{
+			ID: customToolID,
+		},
+	}, nil)
+
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Errorf("Wrong match. expect: %d, got: %d", http.StatusOK, w.Code)
+	}
+}
@@ -1,6 +1,7 @@
 package server
 
 import (
+	amredaction "monorepo/bin-ai-manager/models/redaction"
 	"monorepo/bin-api-manager/gens/openapi_server"
 	cerrors "monorepo/bin-common-handler/models/errors"
 	commonoutline "monorepo/bin-common-handler/models/outline"
@@ -108,7 +109,17 @@ func (h *server) PutCustomerMetadata(c *gin.Context) {
 	}
 
 	metadata := cmcustomer.Metadata{
-		RTPDebug: req.RtpDebug != nil && *req.RtpDebug,
+		RTPDebug:     req.RtpDebug != nil && *req.RtpDebug,
+		PIIRedaction: convertCustomerPIIRedaction(req.PiiRedaction),
+	}
+	if errValidate := amredaction.Validate(amredaction.FromCustomer(metadata.PIIRedaction)); errValidate != nil {
+		log.Errorf("Wrong pii redaction. err: %v", errValidate)
+		abortWithError(c, cerrors.InvalidArgument(
+			commonoutline.ServiceNameAPIManager,
+			"INVALID_PII_REDACTION",
+			"The pii_redaction is not valid.",
+		))
+		return
 	}
 
 	res, err := h.serviceHandler.CustomerSelfUpdateMetadata(c.Request.Context(), a, metadata)
@@ -171,3 +182,21 @@ func (h *server) PutCustomerBillingAccountId(c *gin.Context) {
 	c.JSON(200, res)
 }
 
+// convertCustomerPIIRedaction converts the request's pii redaction to the customer-manager model.
+func convertCustomerPIIRedaction(r *openapi_server.AIManagerRedaction) *cmcustomer.PIIRedaction {
+	c := convertAIRedaction(r)
+	if c == nil {
+		return nil
+	}
+
+	res := &cmcustomer.PIIRedaction{
+		EntityTypes: make([]string, 0, len(c.EntityTypes)),
+		Mode:        string(c.Mode),
+		ToolAccess:  c.ToolAccess,
+	}
+	for _, t := range c.EntityTypes {
+		res.EntityTypes = append(res.EntityTypes, string(t))
+	}
+
+	return res
+}
@@ -423,6 +423,36 @@ func Test_customerMetadataPut(t *testing.T) {
 				RTPDebug: true,
 			},
 			expectedRes: `{"id":"b2c3d4e5-f6a7-8901-bcde-f12345678901","billing_account_id":"00000000-0000-0000-0000-000000000000","metadata":{"rtp_debug":true},"email_verified":false,"status":"","identity_verification_status":"","tm_deletion_scheduled":null,"tm_create":null,"tm_update":null,"tm_delete":null}`,
+		},		{
+			name: "with pii_redaction",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("a1b2c3d4-e5f6-7890-abcd-ef1234567890"),
+					CustomerID: uuid.FromStringOrNil("b2c3d4e5-f6a7-8901-bcde-f12345678901"),
+				},
+				Permission: amagent.PermissionCustomerAdmin,
+			}),
+
+			reqQuery: "/customer/metadata",
+			reqBody:  []byte(`{"pii_redaction":{"entity_types":["credit_card","ssn"],"mode":"mask"}}`),
+
+			responseCustomer: &cscustomer.WebhookMessage{
+				ID: uuid.FromStringOrNil("b2c3d4e5-f6a7-8901-bcde-f12345678901"),
+				Metadata: cscustomer.Metadata{
+					PIIRedaction: &cscustomer.PIIRedaction{
+						EntityTypes: []string{"credit_card", "ssn"},
+						Mode:        "mask",
+					},
+				},
+			},
+
+			expectedMetadata: cscustomer.Metadata{
+				PIIRedaction: &cscustomer.PIIRedaction{
+					EntityTypes: []string{"credit_card", "ssn"},
+					Mode:        "mask",
+				},
+			},
+			expectedRes: `{"id":"b2c3d4e5-f6a7-8901-bcde-f12345678901","billing_account_id":"00000000-0000-0000-0000-000000000000","metadata":{"rtp_debug":false,"pii_redaction":{"entity_types":["credit_card","ssn"],"mode":"mask"}},"email_verified":false,"status":"","identity_verification_status":"","tm_deletion_scheduled":null,"tm_create":null,"tm_update":null,"tm_delete":null}`,
 		},
 	}
 
@@ -461,6 +491,43 @@ func Test_customerMetadataPut(t *testing.T) {
 	}
 }
 
+// Test_customerMetadataPut_InvalidPIIRedaction verifies that an invalid
+// pii_redaction is rejected with INVALID_PII_REDACTION.
+func Test_customerMetadataPut_InvalidPIIRedaction(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	agent := auth.NewAgentIdentity(&amagent.Agent{
+		Identity: commonidentity.Identity{
+			ID:         uuid.FromStringOrNil("6f0c1e2a-ad1b-11f0-a3c1-4b8e2f7d9a10"),
+			CustomerID: uuid.FromStringOrNil("6f3a5d8c-ad1b-11f0-8f2e-1c7a9b3d5e42"),
+		},
+		Permission: amagent.PermissionCustomerAdmin,
+	})
+
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockSvc := servicehandler.NewMockServiceHandler(mc)
+	h := &server{
+		serviceHandler: mockSvc,
+	}
+
+	w := httptest.NewRecorder()
+	_, r := gin.CreateTestContext(w)
+	r.Use(middleware.RequestID())
+	r.Use(func(c *gin.Context) {
+		c.Set("auth_identity", agent)
+	})
+	openapi_server.RegisterHandlers(r, h)
+
+	// tool_access needs the tokenize mode.
+	req, _ := http.NewRequest(http.MethodPut, "/customer/metadata", bytes.NewBufferString(`{"pii_redaction":{"entity_types":["email"],"mode":"mask","tool_access":true}}`))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+
+	assertErrorResponse(t, w, cerrors.StatusInvalidArgument, "INVALID_PII_REDACTION")
+}
+
 // assertMissingAuthIdentity is a small helper that reduces boilerplate for
 // the auth-identity-missing branch of each handler. It builds a minimal
 // Gin router with the RequestID middleware installed (but no auth_identity
@@ -1,6 +1,7 @@
 package server
 
 import (
+	amredaction "monorepo/bin-ai-manager/models/redaction"
 	"monorepo/bin-api-manager/gens/openapi_server"
 	cerrors "monorepo/bin-common-handler/models/errors"
 	commonoutline "monorepo/bin-common-handler/models/outline"
@@ -349,7 +350,17 @@ func (h *server) PutCustomersIdMetadata(c *gin.Context, id string) {
 	}
 
 	metadata := cucustomer.Metadata{
-		RTPDebug: req.RtpDebug != nil && *req.RtpDebug,
+		RTPDebug:     req.RtpDebug != nil && *req.RtpDebug,
+		PIIRedaction: convertCustomerPIIRedaction(req.PiiRedaction),
+	}
+	if errValidate := amredaction.Validate(amredaction.FromCustomer(metadata.PIIRedaction)); errValidate != nil {
+		log.Errorf("Wrong pii redaction. err: %v", errValidate)
+		abortWithError(c, cerrors.InvalidArgument(
+			commonoutline.ServiceNameAPIManager,
+			"INVALID_PII_REDACTION",
+			"The pii_redaction is not valid.",
+		))
+		return
 	}
 
 	res, err := h.serviceHandler.CustomerUpdateMetadata(c.Request.Context(), a, target, metadata)
@@ -0,0 +1,421 @@
+package server
+
+import (
+	"bytes"
+	amagent "monorepo/bin-agent-manager/models/agent"
+	"monorepo/bin-api-manager/gens/openapi_server"
+	"monorepo/bin-api-manager/models/auth"
+	"monorepo/bin-api-manager/pkg/servicehandler"
+	cmdisposition "monorepo/bin-campaign-manager/models/disposition"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_dispositionsGET(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		agent *auth.AuthIdentity
+
+		reqQuery string
+
+		responseDispositions []*cmdisposition.WebhookMessage
+
+		expectPageSize  uint64
+		expectPageToken string
+		expectRes       string
+	}{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("8c0e4a6a-ad30-11f1-8a1b-0c2e4a6b8d01"),
+				},
+			}),
+
+			reqQuery: "/dispositions?page_size=10&page_token=2021-03-02T03:23:20.995000Z",
+
+			responseDispositions: []*cmdisposition.WebhookMessage{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("8c3f6b86-ad30-11f1-9b2c-1d3f5b7c9e02"),
+					},
+					Code:      "wrong_number",
+					RetryMode: cmdisposition.RetryModeNoRetry,
+					TMCreate:  timePtr("2020-09-20T03:23:21.995000Z"),
+				},
+			},
+
+			expectPageSize:  10,
+			expectPageToken: "2021-03-02T03:23:20.995000Z",
+			expectRes:       `{"result":[{"id":"8c3f6b86-ad30-11f1-9b2c-1d3f5b7c9e02","customer_id":"00000000-0000-0000-0000-000000000000","code":"wrong_number","name":"","detail":"","retry_mode":"no_retry","tm_create":"2020-09-20T03:23:21.995Z","tm_update":null,"tm_delete":null}],"next_page_token":"2020-09-20T03:23:21.995000Z"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSvc := servicehandler.NewMockServiceHandler(mc)
+			h := &server{
+				serviceHandler: mockSvc,
+			}
+
+			w := httptest.NewRecorder()
+			_, r := gin.CreateTestContext(w)
+
+			r.Use(func(c *gin.Context) {
+				c.Set("auth_identity", tt.agent)
+			})
+			openapi_server.RegisterHandlers(r, h)
+
+			req, _ := http.NewRequest("GET", tt.reqQuery, nil)
+			mockSvc.EXPECT().DispositionList(req.Context(), tt.agent, tt.expectPageSize, tt.expectPageToken).Return(tt.responseDispositions, nil)
+
+			r.ServeHTTP(w, req)
+			if w.Code != http.StatusOK {
+				t.Errorf("Wrong match. expect: %d, got: %d", http.StatusOK, w.Code)
+			}
+
+			if w.Body.String() != tt.expectRes {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, w.Body)
+			}
+		})
+	}
+}
+
+func Test_dispositionsPOST(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		agent *auth.AuthIdentity
+
+		reqBody []byte
+
+		responseDisposition *cmdisposition.WebhookMessage
+
+		expectCode      string
+		expectName      string
+		expectDetail    string
+		expectRetryMode cmdisposition.RetryMode
+
+		expectCallService bool
+		expectStatus      int
+		expectRes         string
+	}{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("8c0e4a6a-ad30-11f1-8a1b-0c2e4a6b8d01"),
+				},
+			}),
+
+			reqBody: []byte(`{"code":"wrong_number","name":"Wrong number","detail":"test detail","retry_mode":"no_retry"}`),
+
+			responseDisposition: &cmdisposition.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("8c7088a2-ad30-11f1-ac3d-2e4a6c8d0f03"),
+				},
+			},
+
+			expectCode:      "wrong_number",
+			expectName:      "Wrong number",
+			expectDetail:    "test detail",
+			expectRetryMode: cmdisposition.RetryModeNoRetry,
+
+			expectCallService: true,
+			expectStatus:      http.StatusOK,
+			expectRes:         `{"id":"8c7088a2-ad30-11f1-ac3d-2e4a6c8d0f03","customer_id":"00000000-0000-0000-0000-000000000000","code":"","name":"","detail":"","retry_mode":"","tm_create":null,"tm_update":null,"tm_delete":null}`,
+		},
+		{
+			name: "retry mode omitted is default",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("8c0e4a6a-ad30-11f1-8a1b-0c2e4a6b8d01"),
+				},
+			}),
+
+			reqBody: []byte(`{"code":"sale"}`),
+
+			responseDisposition: &cmdisposition.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("8ca1a9be-ad30-11f1-bd4e-3f5b7d9e1a04"),
+				},
+			},
+
+			expectCode:      "sale",
+			expectRetryMode: cmdisposition.RetryModeDefault,
+
+			expectCallService: true,
+			expectStatus:      http.StatusOK,
+		},
+		{
+			name: "code missing is rejected",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("8c0e4a6a-ad30-11f1-8a1b-0c2e4a6b8d01"),
+				},
+			}),
+
+			reqBody: []byte(`{"name":"Sale"}`),
+
+			expectCallService: false,
+			expectStatus:      http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSvc := servicehandler.NewMockServiceHandler(mc)
+			h := &server{
+				serviceHandler: mockSvc,
+			}
+
+			w := httptest.NewRecorder()
+			_, r := gin.CreateTestContext(w)
+
+			r.Use(func(c *gin.Context) {
+				c.Set("auth_identity", tt.agent)
+			})
+			openapi_server.RegisterHandlers(r, h)
+
+			req, _ := http.NewRequest("POST", "/dispositions", bytes.NewBuffer(tt.reqBody))
+			req.Header.Set("Content-Type", "application/json")
+			if tt.expectCallService {
+				mockSvc.EXPECT().DispositionCreate(req.Context(), tt.agent, tt.expectCode, tt.expectName, tt.expectDetail, tt.expectRetryMode).Return(tt.responseDisposition, nil)
+			}
+
+			r.ServeHTTP(w, req)
+			if w.Code != tt.expectStatus {
+				t.Errorf("Wrong match. expect: %d, got: %d", tt.expectStatus, w.Code)
+			}
+
+			if tt.expectRes != "" && w.Body.String() != tt.expectRes {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, w.Body)
+			}
+		})
+	}
+}
+
+func Test_dispositionsIDGET(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		agent *auth.AuthIdentity
+
+		reqQuery string
+
+		responseDisposition *cmdisposition.WebhookMessage
+
+		expectDispositionID uuid.UUID
+		expectRes           string
+	}{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("8c0e4a6a-ad30-11f1-8a1b-0c2e4a6b8d01"),
+				},
+			}),
+
+			reqQuery: "/dispositions/8cd2cada-ad30-11f1-8e5f-4a6c8e0f2b05",
+
+			responseDisposition: &cmdisposition.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("8cd2cada-ad30-11f1-8e5f-4a6c8e0f2b05"),
+				},
+				Code: "sale",
+			},
+
+			expectDispositionID: uuid.FromStringOrNil("8cd2cada-ad30-11f1-8e5f-4a6c8e0f2b05"),
+			expectRes:           `{"id":"8cd2cada-ad30-11f1-8e5f-4a6c8e0f2b05","customer_id":"00000000-0000-0000-0000-000000000000","code":"sale","name":"","detail":"","retry_mode":"","tm_create":null,"tm_update":null,"tm_delete":null}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSvc := servicehandler.NewMockServiceHandler(mc)
+			h := &server{
+				serviceHandler: mockSvc,
+			}
+
+			w := httptest.NewRecorder()
+			_, r := gin.CreateTestContext(w)
+
+			r.Use(func(c *gin.Context) {
+				c.Set("auth_identity", tt.agent)
+			})
+			openapi_server.RegisterHandlers(r, h)
+
+			req, _ := http.NewRequest("GET", tt.reqQuery, nil)
+			mockSvc.EXPECT().DispositionGet(req.Context(), tt.agent, tt.expectDispositionID).Return(tt.responseDisposition, nil)
+
+			r.ServeHTTP(w, req)
+			if w.Code != http.StatusOK {
+				t.Errorf("Wrong match. expect: %d, got: %d", http.StatusOK, w.Code)
+			}
+
+			if w.Body.String() != tt.expectRes {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, w.Body)
+			}
+		})
+	}
+}
+
+func Test_dispositionsIDPUT(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		agent *auth.AuthIdentity
+
+		reqQuery string
+		reqBody  []byte
+
+		responseDisposition *cmdisposition.WebhookMessage
+
+		expectDispositionID uuid.UUID
+		expectName          string
+		expectDetail        string
+		expectRetryMode     cmdisposition.RetryMode
+		expectRes           string
+	}{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("8c0e4a6a-ad30-11f1-8a1b-0c2e4a6b8d01"),
+				},
+			}),
+
+			reqQuery: "/dispositions/8cd2cada-ad30-11f1-8e5f-4a6c8e0f2b05",
+			reqBody:  []byte(`{"name":"Callback requested","detail":"update detail","retry_mode":"retry"}`),
+
+			responseDisposition: &cmdisposition.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("8cd2cada-ad30-11f1-8e5f-4a6c8e0f2b05"),
+				},
+			},
+
+			expectDispositionID: uuid.FromStringOrNil("8cd2cada-ad30-11f1-8e5f-4a6c8e0f2b05"),
+			expectName:          "Callback requested",
+			expectDetail:        "update detail",
+			expectRetryMode:     cmdisposition.RetryModeRetry,
+			expectRes:           `{"id":"8cd2cada-ad30-11f1-8e5f-4a6c8e0f2b05","customer_id":"00000000-0000-0000-0000-000000000000","code":"","name":"","detail":"","retry_mode":"","tm_create":null,"tm_update":null,"tm_delete":null}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSvc := servicehandler.NewMockServiceHandler(mc)
+			h := &server{
+				serviceHandler: mockSvc,
+			}
+
+			w := httptest.NewRecorder()
+			_, r := gin.CreateTestContext(w)
+
+			r.Use(func(c *gin.Context) {
+				c.Set("auth_identity", tt.agent)
+			})
+			openapi_server.RegisterHandlers(r, h)
+
+			req, _ := http.NewRequest("PUT", tt.reqQuery, bytes.NewBuffer(tt.reqBody))
+			req.Header.Set("Content-Type", "application/json")
+			mockSvc.EXPECT().DispositionUpdate(req.Context(), tt.agent, tt.expectDispositionID, tt.expectName, tt.expectDetail, tt.expectRetryMode).Return(tt.responseDisposition, nil)
+
+			r.ServeHTTP(w, req)
+			if w.Code != http.StatusOK {
+				t.Errorf("Wrong match. expect: %d, got: %d", http.StatusOK, w.Code)
+			}
+
+			if w.Body.String() != tt.expectRes {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, w.Body)
+			}
+		})
+	}
+}
+
+func Test_dispositionsIDDELETE(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		agent *auth.AuthIdentity
+
+		reqQuery string
+
+		responseDisposition *cmdisposition.WebhookMessage
+
+		expectDispositionID uuid.UUID
+		expectRes           string
+	}{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("8c0e4a6a-ad30-11f1-8a1b-0c2e4a6b8d01"),
+				},
+			}),
+
+			reqQuery: "/dispositions/8cd2cada-ad30-11f1-8e5f-4a6c8e0f2b05",
+
+			responseDisposition: &cmdisposition.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("8cd2cada-ad30-11f1-8e5f-4a6c8e0f2b05"),
+				},
+			},
+
+			expectDispositionID: uuid.FromStringOrNil("8cd2cada-ad30-11f1-8e5f-4a6c8e0f2b05"),
+			expectRes:           `{"id":"8cd2cada-ad30-11f1-8e5f-4a6c8e0f2b05","customer_id":"00000000-0000-0000-0000-000000000000","code":"","name":"","detail":"","retry_mode":"","tm_create":null,"tm_update":null,"tm_delete":null}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSvc := servicehandler.NewMockServiceHandler(mc)
+			h := &server{
+				serviceHandler: mockSvc,
+			}
+
+			w := httptest.NewRecorder()
+			_, r := gin.CreateTestContext(w)
+
+			r.Use(func(c *gin.Context) {
+				c.Set("auth_identity", tt.agent)
+			})
+			openapi_server.RegisterHandlers(r, h)
+
+			req, _ := http.NewRequest("DELETE", tt.reqQuery, nil)
+			mockSvc.EXPECT().DispositionDelete(req.Context(), tt.agent, tt.expectDispositionID).Return(tt.responseDisposition, nil)
+
+			r.ServeHTTP(w, req)
+			if w.Code != http.StatusOK {
+				t.Errorf("Wrong match. expect: %d, got: %d", http.StatusOK, w.Code)
+			}
+
+			if w.Body.String() != tt.expectRes {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, w.Body)
+			}
+		})
+	}
+}
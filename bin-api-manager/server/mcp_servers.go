@@ -0,0 +1,291 @@
+package server
+
+import (
+	ammcpserver "monorepo/bin-ai-manager/models/mcpserver"
+	"monorepo/bin-api-manager/gens/openapi_server"
+	cerrors "monorepo/bin-common-handler/models/errors"
+	commonoutline "monorepo/bin-common-handler/models/outline"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+func (h *server) PostMcpServers(c *gin.Context) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":            "PostMcpServers",
+		"request_address": c.ClientIP,
+	})
+
+	a, ok := getAuthIdentity(c)
+	if !ok {
+		log.Errorf("Could not find auth identity.")
+		abortWithError(c, cerrors.Unauthenticated(commonoutline.ServiceNameAPIManager, "AUTHENTICATION_REQUIRED", "Authentication is required."))
+		return
+	}
+	log = log.WithFields(logrus.Fields{
+		"agent": a,
+	})
+
+	var req openapi_server.PostMcpServersJSONBody
+	if err := c.BindJSON(&req); err != nil {
+		log.Errorf("Could not parse the request. err: %v", err)
+		abortWithError(c, cerrors.InvalidArgument(commonoutline.ServiceNameAPIManager, "INVALID_JSON_BODY", "The request body is not valid JSON.").Wrap(err))
+		return
+	}
+
+	detail, headers, timeout, allowedTools := convertMCPServerOptionals(req.Detail, req.Headers, req.Timeout, req.AllowedTools)
+
+	res, err := h.serviceHandler.MCPServerCreate(
+		c.Request.Context(),
+		a,
+		req.Name,
+		detail,
+		ammcpserver.Transport(req.Transport),
+		req.Url,
+		headers,
+		timeout,
+		allowedTools,
+	)
+	if err != nil {
+		log.Errorf("Could not create an mcp server. err: %v", err)
+		abortWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(200, res)
+}
+
+func (h *server) GetMcpServers(c *gin.Context, params openapi_server.GetMcpServersParams) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":            "GetMcpServers",
+		"request_address": c.ClientIP,
+	})
+
+	a, ok := getAuthIdentity(c)
+	if !ok {
+		log.Errorf("Could not find auth identity.")
+		abortWithError(c, cerrors.Unauthenticated(commonoutline.ServiceNameAPIManager, "AUTHENTICATION_REQUIRED", "Authentication is required."))
+		return
+	}
+	log = log.WithFields(logrus.Fields{
+		"agent": a,
+	})
+
+	pageSize := uint64(100)
+	if params.PageSize != nil {
+		pageSize = uint64(*params.PageSize)
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 100
+		log.Debugf("Invalid requested page size. Set to default. page_size: %d", pageSize)
+	}
+
+	pageToken := ""
+	if params.PageToken != nil {
+		pageToken = *params.PageToken
+	}
+
+	tmps, err := h.serviceHandler.MCPServerGetsByCustomerID(c.Request.Context(), a, pageSize, pageToken)
+	if err != nil {
+		log.Errorf("Could not get an mcp server list. err: %v", err)
+		abortWithServiceError(c, err)
+		return
+	}
+
+	nextToken := ""
+	if len(tmps) > 0 {
+		if tmps[len(tmps)-1].TMCreate != nil {
+			nextToken = tmps[len(tmps)-1].TMCreate.UTC().Format("2006-01-02T15:04:05.000000Z")
+		}
+	}
+
+	res := GenerateListResponse(tmps, nextToken)
+	c.JSON(200, res)
+}
+
+func (h *server) GetMcpServersId(c *gin.Context, id string) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":            "GetMcpServersId",
+		"request_address": c.ClientIP,
+		"mcp_server_id":   id,
+	})
+
+	a, ok := getAuthIdentity(c)
+	if !ok {
+		log.Errorf("Could not find auth identity.")
+		abortWithError(c, cerrors.Unauthenticated(commonoutline.ServiceNameAPIManager, "AUTHENTICATION_REQUIRED", "Authentication is required."))
+		return
+	}
+	log = log.WithFields(logrus.Fields{
+		"agent": a,
+	})
+
+	target := uuid.FromStringOrNil(id)
+	if target == uuid.Nil {
+		log.Error("Could not parse the id.")
+		abortWithError(c, cerrors.InvalidArgument(commonoutline.ServiceNameAPIManager, "INVALID_ID", "The provided id is not a valid UUID."))
+		return
+	}
+
+	res, err := h.serviceHandler.MCPServerGet(c.Request.Context(), a, target)
+	if err != nil {
+		log.Errorf("Could not get an mcp server. err: %v", err)
+		abortWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(200, res)
+}
+
+func (h *server) DeleteMcpServersId(c *gin.Context, id string) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":            "DeleteMcpServersId",
+		"request_address": c.ClientIP,
+		"mcp_server_id":   id,
+	})
+
+	a, ok := getAuthIdentity(c)
+	if !ok {
+		log.Errorf("Could not find auth identity.")
+		abortWithError(c, cerrors.Unauthenticated(commonoutline.ServiceNameAPIManager, "AUTHENTICATION_REQUIRED", "Authentication is required."))
+		return
+	}
+	log = log.WithFields(logrus.Fields{
+		"agent": a,
+	})
+
+	target := uuid.FromStringOrNil(id)
+	if target == uuid.Nil {
+		log.Error("Could not parse the id.")
+		abortWithError(c, cerrors.InvalidArgument(commonoutline.ServiceNameAPIManager, "INVALID_ID", "The provided id is not a valid UUID."))
+		return
+	}
+
+	res, err := h.serviceHandler.MCPServerDelete(c.Request.Context(), a, target)
+	if err != nil {
+		log.Errorf("Could not delete the mcp server. err: %v", err)
+		abortWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(200, res)
+}
+
+func (h *server) PutMcpServersId(c *gin.Context, id string) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":            "PutMcpServersId",
+		"request_address": c.ClientIP,
+		"mcp_server_id":   id,
+	})
+
+	a, ok := getAuthIdentity(c)
+	if !ok {
+		log.Errorf("Could not find auth identity.")
+		abortWithError(c, cerrors.Unauthenticated(commonoutline.ServiceNameAPIManager, "AUTHENTICATION_REQUIRED", "Authentication is required."))
+		return
+	}
+	log = log.WithFields(logrus.Fields{
+		"agent": a,
+	})
+
+	target := uuid.FromStringOrNil(id)
+	if target == uuid.Nil {
+		log.Error("Could not parse the id.")
+		abortWithError(c, cerrors.InvalidArgument(commonoutline.ServiceNameAPIManager, "INVALID_ID", "The provided id is not a valid UUID."))
+		return
+	}
+
+	var req openapi_server.PutMcpServersIdJSONBody
+	if err := c.BindJSON(&req); err != nil {
+		log.Errorf("Could not parse the request. err: %v", err)
+		abortWithError(c, cerrors.InvalidArgument(commonoutline.ServiceNameAPIManager, "INVALID_JSON_BODY", "The request body is not valid JSON.").Wrap(err))
+		return
+	}
+
+	detail, headers, timeout, allowedTools := convertMCPServerOptionals(req.Detail, req.Headers, req.Timeout, req.AllowedTools)
+
+	res, err := h.serviceHandler.MCPServerUpdate(
+		c.Request.Context(),
+		a,
+		target,
+		req.Name,
+		detail,
+		ammcpserver.Transport(req.Transport),
+		req.Url,
+		headers,
+		timeout,
+		allowedTools,
+	)
+	if err != nil {
+		log.Errorf("Could not update the mcp server. err: %v", err)
+		abortWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(200, res)
+}
+
+func (h *server) GetMcpServersIdTools(c *gin.Context, id string) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":            "GetMcpServersIdTools",
+		"request_address": c.ClientIP,
+		"mcp_server_id":   id,
+	})
+
+	a, ok := getAuthIdentity(c)
+	if !ok {
+		log.Errorf("Could not find auth identity.")
+		abortWithError(c, cerrors.Unauthenticated(commonoutline.ServiceNameAPIManager, "AUTHENTICATION_REQUIRED", "Authentication is required."))
+		return
+	}
+	log = log.WithFields(logrus.Fields{
+		"agent": a,
+	})
+
+	target := uuid.FromStringOrNil(id)
+	if target == uuid.Nil {
+		log.Error("Could not parse the id.")
+		abortWithError(c, cerrors.InvalidArgument(commonoutline.ServiceNameAPIManager, "INVALID_ID", "The provided id is not a valid UUID."))
+		return
+	}
+
+	res, err := h.serviceHandler.MCPServerToolList(c.Request.Context(), a, target)
+	if err != nil {
+		log.Errorf("Could not get the mcp server tools. err: %v", err)
+		abortWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(200, res)
+}
+
+// convertMCPServerOptionals dereferences the optional mcp server request fields.
+func convertMCPServerOptionals(
+	reqDetail *string,
+	reqHeaders *map[string]string,
+	reqTimeout *int,
+	reqAllowedTools *[]string,
+) (string, map[string]string, int, []string) {
+	detail := ""
+	if reqDetail != nil {
+		detail = *reqDetail
+	}
+
+	var headers map[string]string
+	if reqHeaders != nil {
+		headers = *reqHeaders
+	}
+
+	timeout := 0
+	if reqTimeout != nil {
+		timeout = *reqTimeout
+	}
+
+	var allowedTools []string
+	if reqAllowedTools != nil {
+		allowedTools = *reqAllowedTools
+	}
+
+	return detail, headers, timeout, allowedTools
+}
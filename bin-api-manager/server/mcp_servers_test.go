@@ -0,0 +1,284 @@
+package server
+
+import (
+	"bytes"
+	amagent "monorepo/bin-agent-manager/models/agent"
+	ammcpserver "monorepo/bin-ai-manager/models/mcpserver"
+	amtool "monorepo/bin-ai-manager/models/tool"
+	"monorepo/bin-api-manager/gens/openapi_server"
+	"monorepo/bin-api-manager/lib/middleware"
+	"monorepo/bin-api-manager/models/auth"
+	"monorepo/bin-api-manager/pkg/servicehandler"
+	cerrors "monorepo/bin-common-handler/models/errors"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	commonoutline "monorepo/bin-common-handler/models/outline"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_PostMcpServers(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		agent *auth.AuthIdentity
+
+		reqQuery string
+		reqBody  []byte
+
+		responseMCPServer *ammcpserver.WebhookMessage
+
+		expectName         string
+		expectDetail       string
+		expectTransport    ammcpserver.Transport
+		expectURL          string
+		expectHeaders      map[string]string
+		expectTimeout      int
+		expectAllowedTools []string
+		expectRes          string
+	}{
+		{
+			name: "full request",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("7a1c2e30-8e3c-11f1-9b4d-1a2b3c4d5e01"),
+				},
+			}),
+
+			reqQuery: "/mcp_servers",
+			reqBody:  []byte(`{"name":"crm","detail":"internal crm","transport":"streamable_http","url":"https://mcp.example.com/mcp","headers":{"Authorization":"Bearer token"},"timeout":5000,"allowed_tools":["lookup_order"]}`),
+
+			responseMCPServer: &ammcpserver.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("7a4e4f62-8e3c-11f1-8c5e-2b3c4d5e6f02"),
+				},
+			},
+
+			expectName:      "crm",
+			expectDetail:    "internal crm",
+			expectTransport: ammcpserver.TransportStreamableHTTP,
+			expectURL:       "https://mcp.example.com/mcp",
+			expectHeaders: map[string]string{
+				"Authorization": "Bearer token",
+			},
+			expectTimeout:      5000,
+			expectAllowedTools: []string{"lookup_order"},
+			expectRes:          `{"id":"7a4e4f62-8e3c-11f1-8c5e-2b3c4d5e6f02","customer_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`,
+		},
+		{
+			name: "optional fields omitted",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("7a1c2e30-8e3c-11f1-9b4d-1a2b3c4d5e01"),
+				},
+			}),
+
+			reqQuery: "/mcp_servers",
+			reqBody:  []byte(`{"name":"docs","transport":"sse","url":"https://mcp.example.com/sse"}`),
+
+			responseMCPServer: &ammcpserver.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("7a4e4f62-8e3c-11f1-8c5e-2b3c4d5e6f02"),
+				},
+			},
+
+			expectName:      "docs",
+			expectTransport: ammcpserver.TransportSSE,
+			expectURL:       "https://mcp.example.com/sse",
+			expectRes:       `{"id":"7a4e4f62-8e3c-11f1-8c5e-2b3c4d5e6f02","customer_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSvc := servicehandler.NewMockServiceHandler(mc)
+			h := &server{
+				serviceHandler: mockSvc,
+			}
+
+			w := httptest.NewRecorder()
+			_, r := gin.CreateTestContext(w)
+
+			r.Use(func(c *gin.Context) {
+				c.Set("auth_identity", tt.agent)
+			})
+			openapi_server.RegisterHandlers(r, h)
+
+			req, _ := http.NewRequest("POST", tt.reqQuery, bytes.NewBuffer(tt.reqBody))
+			req.Header.Set("Content-Type", "application/json")
+			mockSvc.EXPECT().MCPServerCreate(
+				req.Context(),
+				tt.agent,
+				tt.expectName,
+				tt.expectDetail,
+				tt.expectTransport,
+				tt.expectURL,
+				tt.expectHeaders,
+				tt.expectTimeout,
+				tt.expectAllowedTools,
+			).Return(tt.responseMCPServer, nil)
+
+			r.ServeHTTP(w, req)
+			if w.Code != http.StatusOK {
+				t.Errorf("Wrong match. expect: %d, got: %d", http.StatusOK, w.Code)
+			}
+
+			if w.Body.String() != tt.expectRes {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, w.Body)
+			}
+		})
+	}
+}
+
+func Test_GetMcpServers(t *testing.T) {
+
+	agent := auth.NewAgentIdentity(&amagent.Agent{
+		Identity: commonidentity.Identity{
+			ID: uuid.FromStringOrNil("7a807094-8e3c-11f1-ad6f-3c4d5e6f7a03"),
+		},
+	})
+
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockSvc := servicehandler.NewMockServiceHandler(mc)
+	h := &server{serviceHandler: mockSvc}
+
+	w := httptest.NewRecorder()
+	_, r := gin.CreateTestContext(w)
+	r.Use(func(c *gin.Context) {
+		c.Set("auth_identity", agent)
+	})
+	openapi_server.RegisterHandlers(r, h)
+
+	req, _ := http.NewRequest("GET", "/mcp_servers?page_size=10&page_token=2020-09-20%2003:23:20.995000", nil)
+	mockSvc.EXPECT().MCPServerGetsByCustomerID(req.Context(), agent, uint64(10), "2020-09-20 03:23:20.995000").Return([]*ammcpserver.WebhookMessage{
+		{
+			Identity: commonidentity.Identity{
+				ID: uuid.FromStringOrNil("7ab291c6-8e3c-11f1-9e70-4d5e6f7a8b04"),
+			},
+		},
+	}, nil)
+
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Errorf("Wrong match. expect: %d, got: %d", http.StatusOK, w.Code)
+	}
+
+	expectRes := `{"result":[{"id":"7ab291c6-8e3c-11f1-9e70-4d5e6f7a8b04","customer_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}],"next_page_token":""}`
+	if w.Body.String() != expectRes {
+		t.Errorf("Wrong match.\nexpect: %v\ngot: %v", expectRes, w.Body)
+	}
+}
+
+func Test_GetMcpServersIdTools(t *testing.T) {
+
+	agent := auth.NewAgentIdentity(&amagent.Agent{
+		Identity: commonidentity.Identity{
+			ID: uuid.FromStringOrNil("7ae4b2f8-8e3c-11f1-8f81-5e6f7a8b9c05"),
+		},
+	})
+	mcpServerID := uuid.FromStringOrNil("7b16d42a-8e3c-11f1-a092-6f7a8b9cad06")
+
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockSvc := servicehandler.NewMockServiceHandler(mc)
+	h := &server{serviceHandler: mockSvc}
+
+	w := httptest.NewRecorder()
+	_, r := gin.CreateTestContext(w)
+	r.Use(func(c *gin.Context) {
+		c.Set("auth_identity", agent)
+	})
+	openapi_server.RegisterHandlers(r, h)
+
+	req, _ := http.NewRequest(http.MethodGet, "/mcp_servers/"+mcpServerID.String()+"/tools", nil)
+	mockSvc.EXPECT().MCPServerToolList(req.Context(), agent, mcpServerID).Return([]amtool.Tool{
+		{
+			Name:        "crm__lookup_order",
+			Description: "Look up an order.",
+			RunLLM:      true,
+		},
+	}, nil)
+
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Errorf("Wrong match. expect: %d, got: %d", http.StatusOK, w.Code)
+	}
+
+	expectRes := `[{"name":"crm__lookup_order","description":"Look up an order.","parameters":null,"run_llm":true}]`
+	if w.Body.String() != expectRes {
+		t.Errorf("Wrong match.\nexpect: %v\ngot: %v", expectRes, w.Body)
+	}
+}
+
+// Test_GetMcpServersIdTools_unavailable verifies that a failed tool
+// discovery is surfaced as UNAVAILABLE rather than an internal error.
+func Test_GetMcpServersIdTools_unavailable(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	agent := auth.NewAgentIdentity(&amagent.Agent{
+		Identity: commonidentity.Identity{
+			ID: uuid.FromStringOrNil("7b48f55c-8e3c-11f1-81a3-7a8b9cbdbe07"),
+		},
+	})
+	mcpServerID := uuid.FromStringOrNil("7b7b168e-8e3c-11f1-92b4-8b9cadcecf08")
+
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockSvc := servicehandler.NewMockServiceHandler(mc)
+	h := &server{serviceHandler: mockSvc}
+
+	w := httptest.NewRecorder()
+	_, r := gin.CreateTestContext(w)
+	r.Use(middleware.RequestID())
+	r.Use(func(c *gin.Context) {
+		c.Set("auth_identity", agent)
+	})
+	openapi_server.RegisterHandlers(r, h)
+
+	req, _ := http.NewRequest(http.MethodGet, "/mcp_servers/"+mcpServerID.String()+"/tools", nil)
+	mockSvc.EXPECT().MCPServerToolList(gomock.Any(), agent, mcpServerID).Return(nil, cerrors.Unavailable(commonoutline.ServiceNameAIManager, "MCP_SERVER_UNAVAILABLE", "Could not discover the MCP server's tools."))
+
+	r.ServeHTTP(w, req)
+
+	assertErrorResponse(t, w, cerrors.StatusUnavailable, "MCP_SERVER_UNAVAILABLE")
+}
+
+func Test_mcpServersIDGet_InvalidID(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	agent := auth.NewAgentIdentity(&amagent.Agent{
+		Identity: commonidentity.Identity{
+			ID: uuid.FromStringOrNil("7bad37c0-8e3c-11f1-a3c5-9cadbedfd009"),
+		},
+	})
+
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockSvc := servicehandler.NewMockServiceHandler(mc)
+	h := &server{serviceHandler: mockSvc}
+
+	w := httptest.NewRecorder()
+	_, r := gin.CreateTestContext(w)
+	r.Use(middleware.RequestID())
+	r.Use(func(c *gin.Context) {
+		c.Set("auth_identity", agent)
+	})
+	openapi_server.RegisterHandlers(r, h)
+
+	req, _ := http.NewRequest(http.MethodGet, "/mcp_servers/not-a-uuid", nil)
+	r.ServeHTTP(w, req)
+
+	assertErrorResponse(t, w, cerrors.StatusInvalidArgument, "INVALID_ID")
+}
@@ -1,6 +1,8 @@
 package server
 
 import (
+	"time"
+
 	"monorepo/bin-api-manager/gens/openapi_server"
 	cerrors "monorepo/bin-common-handler/models/errors"
 	commonoutline "monorepo/bin-common-handler/models/outline"
@@ -362,7 +364,12 @@ func (h *server) PostOutdialsIdTargets(c *gin.Context, id string) {
 		return
 	}
 
-	res, err := h.serviceHandler.OutdialtargetCreate(c.Request.Context(), a, target, req.Name, req.Detail, req.Data, &destination0, &destination1, &destination2, &destination3, &destination4)
+	timezone := ""
+	if req.Timezone != nil {
+		timezone = *req.Timezone
+	}
+
+	res, err := h.serviceHandler.OutdialtargetCreate(c.Request.Context(), a, target, req.Name, req.Detail, req.Data, timezone, &destination0, &destination1, &destination2, &destination3, &destination4)
 	if err != nil {
 		log.Errorf("Could not update the outdial. err: %v", err)
 		abortWithServiceError(c, err)
@@ -505,3 +512,81 @@ func (h *server) GetOutdialsIdTargets(c *gin.Context, id string, params openapi_
 	res := GenerateListResponse(tmps, nextToken)
 	c.JSON(200, res)
 }
+
+func (h *server) PutOutdialsIdTargetsTargetIdCallback(c *gin.Context, id string, targetId string) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":            "PutOutdialsIdTargetsTargetIdCallback",
+		"request_address": c.ClientIP,
+		"outdial_id":      id,
+		"target_id":       targetId,
+	})
+
+	a, ok := getAuthIdentity(c)
+	if !ok {
+		log.Errorf("Could not find auth identity.")
+		abortWithError(c, cerrors.Unauthenticated(commonoutline.ServiceNameAPIManager, "AUTHENTICATION_REQUIRED", "Authentication is required."))
+		return
+	}
+	log = log.WithField("agent", a)
+
+	target := uuid.FromStringOrNil(id)
+	if target == uuid.Nil {
+		log.Error("Could not parse the id.")
+		abortWithError(c, cerrors.InvalidArgument(commonoutline.ServiceNameAPIManager, "INVALID_ID", "The provided id is not a valid UUID."))
+		return
+	}
+
+	targetID := uuid.FromStringOrNil(targetId)
+	if targetID == uuid.Nil {
+		log.Error("Could not parse the target_id.")
+		abortWithError(c, cerrors.InvalidArgument(commonoutline.ServiceNameAPIManager, "INVALID_ID", "The provided target_id is not a valid UUID."))
+		return
+	}
+
+	var req openapi_server.PutOutdialsIdTargetsTargetIdCallbackJSONBody
+	if err := c.BindJSON(&req); err != nil {
+		log.Errorf("Could not parse the request. err: %v", err)
+		abortWithError(c, cerrors.InvalidArgument(commonoutline.ServiceNameAPIManager, "INVALID_JSON_BODY", "The request body is not valid JSON.").Wrap(err))
+		return
+	}
+
+	tmCallback, agentID, errParse := parseOutdialtargetCallback(req.TmCallback, req.AgentId)
+	if errParse != nil {
+		log.Errorf("Could not parse the callback. err: %v", errParse)
+		abortWithError(c, errParse)
+		return
+	}
+
+	res, err := h.serviceHandler.OutdialtargetUpdateCallback(c.Request.Context(), a, target, targetID, tmCallback, agentID)
+	if err != nil {
+		log.Errorf("Could not update the outdial target's callback. err: %v", err)
+		abortWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(200, res)
+}
+
+// parseOutdialtargetCallback parses the callback timestamp and the callback agent id of the request.
+// Empty timestamp returns nil, which cancels the scheduled callback.
+func parseOutdialtargetCallback(tmCallback string, agentID *string) (*time.Time, uuid.UUID, *cerrors.VoipbinError) {
+	var resTM *time.Time
+	if tmCallback != "" {
+		tmp, err := time.Parse(time.RFC3339Nano, tmCallback)
+		if err != nil {
+			return nil, uuid.Nil, cerrors.InvalidArgument(commonoutline.ServiceNameAPIManager, "INVALID_TM_CALLBACK", "The tm_callback is not a valid ISO 8601 timestamp.").Wrap(err)
+		}
+		tmp = tmp.UTC()
+		resTM = &tmp
+	}
+
+	resAgentID := uuid.Nil
+	if agentID != nil && *agentID != "" {
+		resAgentID = uuid.FromStringOrNil(*agentID)
+		if resAgentID == uuid.Nil {
+			return nil, uuid.Nil, cerrors.InvalidArgument(commonoutline.ServiceNameAPIManager, "INVALID_AGENT_ID", "The provided agent_id is not a valid UUID.")
+		}
+	}
+
+	return resTM, resAgentID, nil
+}
@@ -16,6 +16,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gofrs/uuid"
@@ -614,6 +615,7 @@ func Test_outdialsIDTargetsPOST(t *testing.T) {
 		expectName         string
 		expectDetail       string
 		expectData         string
+		expectTimezone     string
 		expectDestination0 *commonaddress.Address
 		expectDestination1 *commonaddress.Address
 		expectDestination2 *commonaddress.Address
@@ -633,7 +635,7 @@ func Test_outdialsIDTargetsPOST(t *testing.T) {
 			}),
 
 			reqQuery: "/outdials/726d6b88-2028-44fe-a415-a58067d98acf/targets",
-			reqBody:  []byte(`{"name":"test name","detail":"test detail","data":"test data","destination_0":{"type":"tel","target":"+821100000001"},"destination_1":{"type":"tel","target":"+821100000002"},"destination_2":{"type":"tel","target":"+821100000003"},"destination_3":{"type":"tel","target":"+821100000004"},"destination_4":{"type":"tel","target":"+821100000005"}}`),
+			reqBody:  []byte(`{"name":"test name","detail":"test detail","data":"test data","timezone":"Asia/Seoul","destination_0":{"type":"tel","target":"+821100000001"},"destination_1":{"type":"tel","target":"+821100000002"},"destination_2":{"type":"tel","target":"+821100000003"},"destination_3":{"type":"tel","target":"+821100000004"},"destination_4":{"type":"tel","target":"+821100000005"}}`),
 
 			responseOutdialtarget: &omoutdialtarget.WebhookMessage{
 				ID: uuid.FromStringOrNil("e3097653-4c68-4915-add3-78b12a4ba151"),
@@ -643,6 +645,7 @@ func Test_outdialsIDTargetsPOST(t *testing.T) {
 			expectName:      "test name",
 			expectDetail:    "test detail",
 			expectData:      "test data",
+			expectTimezone:  "Asia/Seoul",
 			expectDestination0: &commonaddress.Address{
 				Type:   commonaddress.TypeTel,
 				Target: "+821100000001",
@@ -666,7 +669,7 @@ func Test_outdialsIDTargetsPOST(t *testing.T) {
 
 			expectCallService: true,
 			expectStatus:      http.StatusOK,
-			expectRes:         `{"id":"e3097653-4c68-4915-add3-78b12a4ba151","outdial_id":"00000000-0000-0000-0000-000000000000","name":"","detail":"","data":"","status":"","destination_0":null,"destination_1":null,"destination_2":null,"destination_3":null,"destination_4":null,"try_count_0":0,"try_count_1":0,"try_count_2":0,"try_count_3":0,"try_count_4":0,"tm_create":null,"tm_update":null,"tm_delete":null}`,
+			expectRes:         `{"id":"e3097653-4c68-4915-add3-78b12a4ba151","outdial_id":"00000000-0000-0000-0000-000000000000","name":"","detail":"","data":"","status":"","timezone":"","destination_0":null,"destination_1":null,"destination_2":null,"destination_3":null,"destination_4":null,"try_count_0":0,"try_count_1":0,"try_count_2":0,"try_count_3":0,"try_count_4":0,"callback_agent_id":"00000000-0000-0000-0000-000000000000","tm_callback":null,"tm_create":null,"tm_update":null,"tm_delete":null}`,
 		},
 		{
 			// name/detail/data and at least one destination_N target are
@@ -725,7 +728,7 @@ func Test_outdialsIDTargetsPOST(t *testing.T) {
 			req, _ := http.NewRequest("POST", tt.reqQuery, bytes.NewBuffer(tt.reqBody))
 			req.Header.Set("Content-Type", "application/json")
 			if tt.expectCallService {
-				mockSvc.EXPECT().OutdialtargetCreate(req.Context(), tt.agent, tt.expectOutdialID, tt.expectName, tt.expectDetail, tt.expectData, tt.expectDestination0, tt.expectDestination1, tt.expectDestination2, tt.expectDestination3, tt.expectDestination4).Return(tt.responseOutdialtarget, nil)
+				mockSvc.EXPECT().OutdialtargetCreate(req.Context(), tt.agent, tt.expectOutdialID, tt.expectName, tt.expectDetail, tt.expectData, tt.expectTimezone, tt.expectDestination0, tt.expectDestination1, tt.expectDestination2, tt.expectDestination3, tt.expectDestination4).Return(tt.responseOutdialtarget, nil)
 			}
 
 			r.ServeHTTP(w, req)
@@ -770,7 +773,7 @@ func Test_outdialsIDTargetsIDGET(t *testing.T) {
 
 			expectOutdialID:       uuid.FromStringOrNil("112950f8-e3d3-4585-b858-125a59f8f51f"),
 			expectOutdialtargetID: uuid.FromStringOrNil("86a52dde-c523-11ec-a8b0-53d9628a5d7f"),
-			expectRes:             `{"id":"86a52dde-c523-11ec-a8b0-53d9628a5d7f","outdial_id":"00000000-0000-0000-0000-000000000000","name":"","detail":"","data":"","status":"","destination_0":null,"destination_1":null,"destination_2":null,"destination_3":null,"destination_4":null,"try_count_0":0,"try_count_1":0,"try_count_2":0,"try_count_3":0,"try_count_4":0,"tm_create":null,"tm_update":null,"tm_delete":null}`,
+			expectRes:             `{"id":"86a52dde-c523-11ec-a8b0-53d9628a5d7f","outdial_id":"00000000-0000-0000-0000-000000000000","name":"","detail":"","data":"","status":"","timezone":"","destination_0":null,"destination_1":null,"destination_2":null,"destination_3":null,"destination_4":null,"try_count_0":0,"try_count_1":0,"try_count_2":0,"try_count_3":0,"try_count_4":0,"callback_agent_id":"00000000-0000-0000-0000-000000000000","tm_callback":null,"tm_create":null,"tm_update":null,"tm_delete":null}`,
 		},
 	}
 
@@ -839,7 +842,7 @@ func Test_outdialsIDTargetsIDDELETE(t *testing.T) {
 
 			expectOutdialID:       uuid.FromStringOrNil("112950f8-e3d3-4585-b858-125a59f8f51f"),
 			expectOutdialtargetID: uuid.FromStringOrNil("0adb2487-eea7-4ec9-bb7f-b2b2aa5af49e"),
-			expectRes:             `{"id":"0adb2487-eea7-4ec9-bb7f-b2b2aa5af49e","outdial_id":"00000000-0000-0000-0000-000000000000","name":"","detail":"","data":"","status":"","destination_0":null,"destination_1":null,"destination_2":null,"destination_3":null,"destination_4":null,"try_count_0":0,"try_count_1":0,"try_count_2":0,"try_count_3":0,"try_count_4":0,"tm_create":null,"tm_update":null,"tm_delete":null}`,
+			expectRes:             `{"id":"0adb2487-eea7-4ec9-bb7f-b2b2aa5af49e","outdial_id":"00000000-0000-0000-0000-000000000000","name":"","detail":"","data":"","status":"","timezone":"","destination_0":null,"destination_1":null,"destination_2":null,"destination_3":null,"destination_4":null,"try_count_0":0,"try_count_1":0,"try_count_2":0,"try_count_3":0,"try_count_4":0,"callback_agent_id":"00000000-0000-0000-0000-000000000000","tm_callback":null,"tm_create":null,"tm_update":null,"tm_delete":null}`,
 		},
 	}
 
@@ -914,7 +917,7 @@ func Test_outdialsIDTargetGET(t *testing.T) {
 			expectOutdialID: uuid.FromStringOrNil("fe7a06b6-c82c-11ec-89fd-f741623099f0"),
 			expectPageSize:  10,
 			expectPageToken: "2020-09-20T03:23:21.995000Z",
-			expectRes:       `{"result":[{"id":"80fcacd4-c82c-11ec-b008-67e3b5299bec","outdial_id":"00000000-0000-0000-0000-000000000000","name":"","detail":"","data":"","status":"","destination_0":null,"destination_1":null,"destination_2":null,"destination_3":null,"destination_4":null,"try_count_0":0,"try_count_1":0,"try_count_2":0,"try_count_3":0,"try_count_4":0,"tm_create":"2020-09-20T03:23:21.995Z","tm_update":null,"tm_delete":null}],"next_page_token":"2020-09-20T03:23:21.995000Z"}`,
+			expectRes:       `{"result":[{"id":"80fcacd4-c82c-11ec-b008-67e3b5299bec","outdial_id":"00000000-0000-0000-0000-000000000000","name":"","detail":"","data":"","status":"","timezone":"","destination_0":null,"destination_1":null,"destination_2":null,"destination_3":null,"destination_4":null,"try_count_0":0,"try_count_1":0,"try_count_2":0,"try_count_3":0,"try_count_4":0,"callback_agent_id":"00000000-0000-0000-0000-000000000000","tm_callback":null,"tm_create":"2020-09-20T03:23:21.995Z","tm_update":null,"tm_delete":null}],"next_page_token":"2020-09-20T03:23:21.995000Z"}`,
 		},
 		{
 			name: "more than 2 items",
@@ -944,7 +947,7 @@ func Test_outdialsIDTargetGET(t *testing.T) {
 			expectOutdialID: uuid.FromStringOrNil("33d8b93c-c82e-11ec-b630-f304b7d48448"),
 			expectPageSize:  15,
 			expectPageToken: "2020-09-20T03:23:21.995000Z",
-			expectRes:       `{"result":[{"id":"340757d8-c82e-11ec-92ef-235422080f76","outdial_id":"00000000-0000-0000-0000-000000000000","name":"","detail":"","data":"","status":"","destination_0":null,"destination_1":null,"destination_2":null,"destination_3":null,"destination_4":null,"try_count_0":0,"try_count_1":0,"try_count_2":0,"try_count_3":0,"try_count_4":0,"tm_create":"2020-09-20T03:23:21.995Z","tm_update":null,"tm_delete":null},{"id":"34353180-c82e-11ec-b8f2-87eaa2dc5a1b","outdial_id":"00000000-0000-0000-0000-000000000000","name":"","detail":"","data":"","status":"","destination_0":null,"destination_1":null,"destination_2":null,"destination_3":null,"destination_4":null,"try_count_0":0,"try_count_1":0,"try_count_2":0,"try_count_3":0,"try_count_4":0,"tm_create":"2020-09-20T03:23:22.995Z","tm_update":null,"tm_delete":null},{"id":"61f53c3c-c82e-11ec-ba3d-f387359c8014","outdial_id":"00000000-0000-0000-0000-000000000000","name":"","detail":"","data":"","status":"","destination_0":null,"destination_1":null,"destination_2":null,"destination_3":null,"destination_4":null,"try_count_0":0,"try_count_1":0,"try_count_2":0,"try_count_3":0,"try_count_4":0,"tm_create":"2020-09-20T03:23:23.995Z","tm_update":null,"tm_delete":null}],"next_page_token":"2020-09-20T03:23:23.995000Z"}`,
+			expectRes:       `{"result":[{"id":"340757d8-c82e-11ec-92ef-235422080f76","outdial_id":"00000000-0000-0000-0000-000000000000","name":"","detail":"","data":"","status":"","timezone":"","destination_0":null,"destination_1":null,"destination_2":null,"destination_3":null,"destination_4":null,"try_count_0":0,"try_count_1":0,"try_count_2":0,"try_count_3":0,"try_count_4":0,"callback_agent_id":"00000000-0000-0000-0000-000000000000","tm_callback":null,"tm_create":"2020-09-20T03:23:21.995Z","tm_update":null,"tm_delete":null},{"id":"34353180-c82e-11ec-b8f2-87eaa2dc5a1b","outdial_id":"00000000-0000-0000-0000-000000000000","name":"","detail":"","data":"","status":"","timezone":"","destination_0":null,"destination_1":null,"destination_2":null,"destination_3":null,"destination_4":null,"try_count_0":0,"try_count_1":0,"try_count_2":0,"try_count_3":0,"try_count_4":0,"callback_agent_id":"00000000-0000-0000-0000-000000000000","tm_callback":null,"tm_create":"2020-09-20T03:23:22.995Z","tm_update":null,"tm_delete":null},{"id":"61f53c3c-c82e-11ec-ba3d-f387359c8014","outdial_id":"00000000-0000-0000-0000-000000000000","name":"","detail":"","data":"","status":"","timezone":"","destination_0":null,"destination_1":null,"destination_2":null,"destination_3":null,"destination_4":null,"try_count_0":0,"try_count_1":0,"try_count_2":0,"try_count_3":0,"try_count_4":0,"callback_agent_id":"00000000-0000-0000-0000-000000000000","tm_callback":null,"tm_create":"2020-09-20T03:23:23.995Z","tm_update":null,"tm_delete":null}],"next_page_token":"2020-09-20T03:23:23.995000Z"}`,
 		},
 	}
 
@@ -1017,3 +1020,72 @@ func Test_outdialsIDTargetsTargetIDDelete_InvalidTargetID(t *testing.T) {
 
 	assertErrorResponse(t, w, cerrors.StatusInvalidArgument, "INVALID_ID")
 }
+
+func Test_outdialsIDTargetsIDCallbackPUT(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		agent *auth.AuthIdentity
+
+		reqQuery string
+		reqBody  []byte
+
+		responseOutdialtarget *omoutdialtarget.WebhookMessage
+
+		expectOutdialID       uuid.UUID
+		expectOutdialtargetID uuid.UUID
+		expectTMCallback      *time.Time
+		expectAgentID         uuid.UUID
+	}{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("1a4c6e80-4fb2-11f1-9e0f-3a4b5c6d7e01"),
+				},
+			}),
+
+			reqQuery: "/outdials/1a7b9f02-4fb2-11f1-a1b2-5c6d7e8f9a02/targets/1aa8c124-4fb2-11f1-b3c4-7e8f9a0b1c03/callback",
+			reqBody:  []byte(`{"tm_callback":"2026-10-22T15:00:00.000000Z","agent_id":"1ad5e346-4fb2-11f1-85d6-9a0b1c2d3e04"}`),
+
+			responseOutdialtarget: &omoutdialtarget.WebhookMessage{
+				ID: uuid.FromStringOrNil("1aa8c124-4fb2-11f1-b3c4-7e8f9a0b1c03"),
+			},
+
+			expectOutdialID:       uuid.FromStringOrNil("1a7b9f02-4fb2-11f1-a1b2-5c6d7e8f9a02"),
+			expectOutdialtargetID: uuid.FromStringOrNil("1aa8c124-4fb2-11f1-b3c4-7e8f9a0b1c03"),
+			expectTMCallback:      func() *time.Time { t := time.Date(2026, 10, 22, 15, 0, 0, 0, time.UTC); return &t }(),
+			expectAgentID:         uuid.FromStringOrNil("1ad5e346-4fb2-11f1-85d6-9a0b1c2d3e04"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// create mock
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSvc := servicehandler.NewMockServiceHandler(mc)
+			h := &server{
+				serviceHandler: mockSvc,
+			}
+
+			w := httptest.NewRecorder()
+			_, r := gin.CreateTestContext(w)
+
+			r.Use(func(c *gin.Context) {
+				c.Set("auth_identity", tt.agent)
+			})
+			openapi_server.RegisterHandlers(r, h)
+
+			req, _ := http.NewRequest("PUT", tt.reqQuery, bytes.NewBuffer(tt.reqBody))
+			req.Header.Set("Content-Type", "application/json")
+			mockSvc.EXPECT().OutdialtargetUpdateCallback(req.Context(), tt.agent, tt.expectOutdialID, tt.expectOutdialtargetID, tt.expectTMCallback, tt.expectAgentID).Return(tt.responseOutdialtarget, nil)
+
+			r.ServeHTTP(w, req)
+			if w.Code != http.StatusOK {
+				t.Errorf("Wrong match. expect: %d, got: %d", http.StatusOK, w.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,194 @@
+package server
+
+import (
+	"monorepo/bin-api-manager/gens/openapi_server"
+	cerrors "monorepo/bin-common-handler/models/errors"
+	commonoutline "monorepo/bin-common-handler/models/outline"
+	omoutdialtargetjob "monorepo/bin-outdial-manager/models/outdialtargetjob"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+func (h *server) PostOutdialsIdTargetjobs(c *gin.Context, id string) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":            "PostOutdialsIdTargetjobs",
+		"request_address": c.ClientIP,
+		"outdial_id":      id,
+	})
+
+	a, ok := getAuthIdentity(c)
+	if !ok {
+		log.Errorf("Could not find auth identity.")
+		abortWithError(c, cerrors.Unauthenticated(commonoutline.ServiceNameAPIManager, "AUTHENTICATION_REQUIRED", "Authentication is required."))
+		return
+	}
+	log = log.WithField("agent", a)
+
+	target := uuid.FromStringOrNil(id)
+	if target == uuid.Nil {
+		log.Error("Could not parse the id.")
+		abortWithError(c, cerrors.InvalidArgument(commonoutline.ServiceNameAPIManager, "INVALID_ID", "The provided id is not a valid UUID."))
+		return
+	}
+
+	var req openapi_server.PostOutdialsIdTargetjobsJSONBody
+	if err := c.BindJSON(&req); err != nil {
+		log.Errorf("Could not parse the request. err: %v", err)
+		abortWithError(c, cerrors.InvalidArgument(commonoutline.ServiceNameAPIManager, "INVALID_JSON_BODY", "The request body is not valid JSON.").Wrap(err))
+		return
+	}
+
+	fileID := uuid.Nil
+	switch omoutdialtargetjob.Type(req.Type) {
+	case omoutdialtargetjob.TypeImport:
+		if req.FileId != nil {
+			fileID = uuid.FromStringOrNil(*req.FileId)
+		}
+		if fileID == uuid.Nil {
+			log.Error("file_id is required for the import.")
+			abortWithError(c, cerrors.InvalidArgument(commonoutline.ServiceNameAPIManager, "INVALID_ARGUMENT", "file_id is required for the import."))
+			return
+		}
+
+	case omoutdialtargetjob.TypeExport:
+		// nothing to check
+
+	default:
+		log.Errorf("Invalid type. type: %s", req.Type)
+		abortWithError(c, cerrors.InvalidArgument(commonoutline.ServiceNameAPIManager, "INVALID_ARGUMENT", "type must be import or export."))
+		return
+	}
+
+	res, err := h.serviceHandler.OutdialtargetjobCreate(c.Request.Context(), a, target, omoutdialtargetjob.Type(req.Type), fileID, convertOutdialtargetjobMapping(req.Mapping))
+	if err != nil {
+		log.Errorf("Could not create the outdial target job. err: %v", err)
+		abortWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(200, res)
+}
+
+func (h *server) GetOutdialsIdTargetjobs(c *gin.Context, id string, params openapi_server.GetOutdialsIdTargetjobsParams) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":            "GetOutdialsIdTargetjobs",
+		"request_address": c.ClientIP,
+		"outdial_id":      id,
+	})
+
+	a, ok := getAuthIdentity(c)
+	if !ok {
+		log.Errorf("Could not find auth identity.")
+		abortWithError(c, cerrors.Unauthenticated(commonoutline.ServiceNameAPIManager, "AUTHENTICATION_REQUIRED", "Authentication is required."))
+		return
+	}
+	log = log.WithField("agent", a)
+
+	target := uuid.FromStringOrNil(id)
+	if target == uuid.Nil {
+		log.Error("Could not parse the id.")
+		abortWithError(c, cerrors.InvalidArgument(commonoutline.ServiceNameAPIManager, "INVALID_ID", "The provided id is not a valid UUID."))
+		return
+	}
+
+	pageSize := uint64(100)
+	if params.PageSize != nil {
+		pageSize = uint64(*params.PageSize)
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 100
+		log.Debugf("Invalid requested page size. Set to default. page_size: %d", pageSize)
+	}
+
+	pageToken := ""
+	if params.PageToken != nil {
+		pageToken = *params.PageToken
+	}
+
+	tmps, err := h.serviceHandler.OutdialtargetjobList(c.Request.Context(), a, target, pageSize, pageToken)
+	if err != nil {
+		log.Errorf("Could not get an outdial target job list. err: %v", err)
+		abortWithServiceError(c, err)
+		return
+	}
+
+	nextToken := ""
+	if len(tmps) > 0 {
+		if tmps[len(tmps)-1].TMCreate != nil {
+			nextToken = tmps[len(tmps)-1].TMCreate.UTC().Format("2006-01-02T15:04:05.000000Z")
+		}
+	}
+
+	res := GenerateListResponse(tmps, nextToken)
+	c.JSON(200, res)
+}
+
+func (h *server) GetOutdialsIdTargetjobsTargetjobId(c *gin.Context, id string, targetjobId string) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":            "GetOutdialsIdTargetjobsTargetjobId",
+		"request_address": c.ClientIP,
+		"outdial_id":      id,
+		"targetjob_id":    targetjobId,
+	})
+
+	a, ok := getAuthIdentity(c)
+	if !ok {
+		log.Errorf("Could not find auth identity.")
+		abortWithError(c, cerrors.Unauthenticated(commonoutline.ServiceNameAPIManager, "AUTHENTICATION_REQUIRED", "Authentication is required."))
+		return
+	}
+	log = log.WithField("agent", a)
+
+	target := uuid.FromStringOrNil(id)
+	if target == uuid.Nil {
+		log.Error("Could not parse the id.")
+		abortWithError(c, cerrors.InvalidArgument(commonoutline.ServiceNameAPIManager, "INVALID_ID", "The provided id is not a valid UUID."))
+		return
+	}
+
+	targetjobID := uuid.FromStringOrNil(targetjobId)
+	if targetjobID == uuid.Nil {
+		log.Error("Could not parse the targetjob_id.")
+		abortWithError(c, cerrors.InvalidArgument(commonoutline.ServiceNameAPIManager, "INVALID_ID", "The provided targetjob_id is not a valid UUID."))
+		return
+	}
+
+	res, err := h.serviceHandler.OutdialtargetjobGet(c.Request.Context(), a, target, targetjobID)
+	if err != nil {
+		log.Errorf("Could not get the outdial target job. err: %v", err)
+		abortWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(200, res)
+}
+
+// convertOutdialtargetjobMapping converts the request's column mapping.
+// The omitted mapping fields are left empty, so the outdial-manager uses the default header names.
+func convertOutdialtargetjobMapping(m *openapi_server.OutdialManagerOutdialtargetjobMapping) *omoutdialtargetjob.Mapping {
+	if m == nil {
+		return nil
+	}
+
+	value := func(v *string) string {
+		if v == nil {
+			return ""
+		}
+		return *v
+	}
+
+	return &omoutdialtargetjob.Mapping{
+		Name:     value(m.Name),
+		Detail:   value(m.Detail),
+		Data:     value(m.Data),
+		Timezone: value(m.Timezone),
+
+		Destination0: value(m.Destination0),
+		Destination1: value(m.Destination1),
+		Destination2: value(m.Destination2),
+		Destination3: value(m.Destination3),
+		Destination4: value(m.Destination4),
+	}
+}
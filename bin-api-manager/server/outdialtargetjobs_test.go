@@ -0,0 +1,326 @@
+package server
+
+import (
+	"bytes"
+	amagent "monorepo/bin-agent-manager/models/agent"
+	"monorepo/bin-api-manager/gens/openapi_server"
+	"monorepo/bin-api-manager/models/auth"
+	"monorepo/bin-api-manager/pkg/servicehandler"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	omoutdialtargetjob "monorepo/bin-outdial-manager/models/outdialtargetjob"
+
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_outdialsIDTargetjobsPOST(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		agent *auth.AuthIdentity
+
+		reqQuery string
+		reqBody  []byte
+
+		responseOutdialtargetjob *omoutdialtargetjob.WebhookMessage
+
+		expectOutdialID uuid.UUID
+		expectType      omoutdialtargetjob.Type
+		expectFileID    uuid.UUID
+		expectMapping   *omoutdialtargetjob.Mapping
+		expectRes       string
+	}{
+		{
+			name: "import",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("2a2ec0ba-8004-11ec-aea5-439829c92a7c"),
+				},
+			}),
+
+			reqQuery: "/outdials/f1a2b3c4-2d61-11f1-8a01-0a1b2c3d4e01/targetjobs",
+			reqBody:  []byte(`{"type":"import","file_id":"f1cf3e50-2d61-11f1-9b12-1b2c3d4e5f02","mapping":{"name":"customer","destination_0":"mobile"}}`),
+
+			responseOutdialtargetjob: &omoutdialtargetjob.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("f1fc5f6c-2d61-11f1-ac23-2c3d4e5f6a03"),
+				},
+			},
+
+			expectOutdialID: uuid.FromStringOrNil("f1a2b3c4-2d61-11f1-8a01-0a1b2c3d4e01"),
+			expectType:      omoutdialtargetjob.TypeImport,
+			expectFileID:    uuid.FromStringOrNil("f1cf3e50-2d61-11f1-9b12-1b2c3d4e5f02"),
+			expectMapping: &omoutdialtargetjob.Mapping{
+				Name:         "customer",
+				Destination0: "mobile",
+			},
+			expectRes: `{"id":"f1fc5f6c-2d61-11f1-ac23-2c3d4e5f6a03","customer_id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","type":"","status":"","file_id":"00000000-0000-0000-0000-000000000000","total_count":0,"processed_count":0,"success_count":0,"duplicate_count":0,"error_count":0,"error_file_id":"00000000-0000-0000-0000-000000000000","detail":"","tm_create":null,"tm_update":null,"tm_delete":null}`,
+		},
+		{
+			name: "export",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("2a2ec0ba-8004-11ec-aea5-439829c92a7c"),
+				},
+			}),
+
+			reqQuery: "/outdials/f1a2b3c4-2d61-11f1-8a01-0a1b2c3d4e01/targetjobs",
+			reqBody:  []byte(`{"type":"export"}`),
+
+			responseOutdialtargetjob: &omoutdialtargetjob.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("f2298088-2d61-11f1-bd34-3d4e5f6a7b04"),
+				},
+			},
+
+			expectOutdialID: uuid.FromStringOrNil("f1a2b3c4-2d61-11f1-8a01-0a1b2c3d4e01"),
+			expectType:      omoutdialtargetjob.TypeExport,
+			expectFileID:    uuid.Nil,
+			expectRes:       `{"id":"f2298088-2d61-11f1-bd34-3d4e5f6a7b04","customer_id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","type":"","status":"","file_id":"00000000-0000-0000-0000-000000000000","total_count":0,"processed_count":0,"success_count":0,"duplicate_count":0,"error_count":0,"error_file_id":"00000000-0000-0000-0000-000000000000","detail":"","tm_create":null,"tm_update":null,"tm_delete":null}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSvc := servicehandler.NewMockServiceHandler(mc)
+			h := &server{
+				serviceHandler: mockSvc,
+			}
+
+			w := httptest.NewRecorder()
+			_, r := gin.CreateTestContext(w)
+
+			r.Use(func(c *gin.Context) {
+				c.Set("auth_identity", tt.agent)
+			})
+			openapi_server.RegisterHandlers(r, h)
+
+			req, _ := http.NewRequest("POST", tt.reqQuery, bytes.NewBuffer(tt.reqBody))
+			req.Header.Set("Content-Type", "application/json")
+			mockSvc.EXPECT().OutdialtargetjobCreate(req.Context(), tt.agent, tt.expectOutdialID, tt.expectType, tt.expectFileID, tt.expectMapping).Return(tt.responseOutdialtargetjob, nil)
+
+			r.ServeHTTP(w, req)
+			if w.Code != http.StatusOK {
+				t.Errorf("Wrong match. expect: %d, got: %d", http.StatusOK, w.Code)
+			}
+
+			if w.Body.String() != tt.expectRes {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, w.Body)
+			}
+		})
+	}
+}
+
+func Test_outdialsIDTargetjobsPOST_error(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		agent *auth.AuthIdentity
+
+		reqQuery string
+		reqBody  []byte
+	}{
+		{
+			name: "import without file id",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("2a2ec0ba-8004-11ec-aea5-439829c92a7c"),
+				},
+			}),
+
+			reqQuery: "/outdials/f1a2b3c4-2d61-11f1-8a01-0a1b2c3d4e01/targetjobs",
+			reqBody:  []byte(`{"type":"import"}`),
+		},
+		{
+			name: "invalid type",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("2a2ec0ba-8004-11ec-aea5-439829c92a7c"),
+				},
+			}),
+
+			reqQuery: "/outdials/f1a2b3c4-2d61-11f1-8a01-0a1b2c3d4e01/targetjobs",
+			reqBody:  []byte(`{"type":"delete"}`),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSvc := servicehandler.NewMockServiceHandler(mc)
+			h := &server{
+				serviceHandler: mockSvc,
+			}
+
+			w := httptest.NewRecorder()
+			_, r := gin.CreateTestContext(w)
+
+			r.Use(func(c *gin.Context) {
+				c.Set("auth_identity", tt.agent)
+			})
+			openapi_server.RegisterHandlers(r, h)
+
+			req, _ := http.NewRequest("POST", tt.reqQuery, bytes.NewBuffer(tt.reqBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			r.ServeHTTP(w, req)
+			if w.Code != http.StatusBadRequest {
+				t.Errorf("Wrong match. expect: %d, got: %d", http.StatusBadRequest, w.Code)
+			}
+		})
+	}
+}
+
+func Test_outdialsIDTargetjobsGET(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		agent *auth.AuthIdentity
+
+		reqQuery string
+
+		responseOutdialtargetjobs []*omoutdialtargetjob.WebhookMessage
+
+		expectOutdialID uuid.UUID
+		expectPageSize  uint64
+		expectPageToken string
+		expectRes       string
+	}{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("2a2ec0ba-8004-11ec-aea5-439829c92a7c"),
+				},
+			}),
+
+			reqQuery: "/outdials/f3a2b3c4-2d61-11f1-8e45-4e5f6a7b8c05/targetjobs?page_size=10&page_token=2020-09-20T03:23:21.995000Z",
+
+			responseOutdialtargetjobs: []*omoutdialtargetjob.WebhookMessage{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("f3cf3e50-2d61-11f1-9f56-5f6a7b8c9d06"),
+					},
+					TMCreate: timePtr("2020-09-20T03:23:21.995000Z"),
+				},
+			},
+
+			expectOutdialID: uuid.FromStringOrNil("f3a2b3c4-2d61-11f1-8e45-4e5f6a7b8c05"),
+			expectPageSize:  10,
+			expectPageToken: "2020-09-20T03:23:21.995000Z",
+			expectRes:       `{"result":[{"id":"f3cf3e50-2d61-11f1-9f56-5f6a7b8c9d06","customer_id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","type":"","status":"","file_id":"00000000-0000-0000-0000-000000000000","total_count":0,"processed_count":0,"success_count":0,"duplicate_count":0,"error_count":0,"error_file_id":"00000000-0000-0000-0000-000000000000","detail":"","tm_create":"2020-09-20T03:23:21.995Z","tm_update":null,"tm_delete":null}],"next_page_token":"2020-09-20T03:23:21.995000Z"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSvc := servicehandler.NewMockServiceHandler(mc)
+			h := &server{
+				serviceHandler: mockSvc,
+			}
+
+			w := httptest.NewRecorder()
+			_, r := gin.CreateTestContext(w)
+
+			r.Use(func(c *gin.Context) {
+				c.Set("auth_identity", tt.agent)
+			})
+			openapi_server.RegisterHandlers(r, h)
+
+			req, _ := http.NewRequest("GET", tt.reqQuery, nil)
+			mockSvc.EXPECT().OutdialtargetjobList(req.Context(), tt.agent, tt.expectOutdialID, tt.expectPageSize, tt.expectPageToken).Return(tt.responseOutdialtargetjobs, nil)
+
+			r.ServeHTTP(w, req)
+			if w.Code != http.StatusOK {
+				t.Errorf("Wrong match. expect: %d, got: %d", http.StatusOK, w.Code)
+			}
+
+			if w.Body.String() != tt.expectRes {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, w.Body.String())
+			}
+		})
+	}
+}
+
+func Test_outdialsIDTargetjobsIDGET(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		agent *auth.AuthIdentity
+
+		reqQuery string
+
+		responseOutdialtargetjob *omoutdialtargetjob.WebhookMessage
+
+		expectOutdialID          uuid.UUID
+		expectOutdialtargetjobID uuid.UUID
+		expectRes                string
+	}{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("2a2ec0ba-8004-11ec-aea5-439829c92a7c"),
+				},
+			}),
+
+			reqQuery: "/outdials/f4a2b3c4-2d61-11f1-a067-6a7b8c9d0e07/targetjobs/f4cf3e50-2d61-11f1-b178-7b8c9d0e1f08",
+
+			responseOutdialtargetjob: &omoutdialtargetjob.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("f4cf3e50-2d61-11f1-b178-7b8c9d0e1f08"),
+				},
+			},
+
+			expectOutdialID:          uuid.FromStringOrNil("f4a2b3c4-2d61-11f1-a067-6a7b8c9d0e07"),
+			expectOutdialtargetjobID: uuid.FromStringOrNil("f4cf3e50-2d61-11f1-b178-7b8c9d0e1f08"),
+			expectRes:                `{"id":"f4cf3e50-2d61-11f1-b178-7b8c9d0e1f08","customer_id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","type":"","status":"","file_id":"00000000-0000-0000-0000-000000000000","total_count":0,"processed_count":0,"success_count":0,"duplicate_count":0,"error_count":0,"error_file_id":"00000000-0000-0000-0000-000000000000","detail":"","tm_create":null,"tm_update":null,"tm_delete":null}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSvc := servicehandler.NewMockServiceHandler(mc)
+			h := &server{
+				serviceHandler: mockSvc,
+			}
+
+			w := httptest.NewRecorder()
+			_, r := gin.CreateTestContext(w)
+
+			r.Use(func(c *gin.Context) {
+				c.Set("auth_identity", tt.agent)
+			})
+			openapi_server.RegisterHandlers(r, h)
+
+			req, _ := http.NewRequest("GET", tt.reqQuery, nil)
+			req.Header.Set("Content-Type", "application/json")
+			mockSvc.EXPECT().OutdialtargetjobGet(req.Context(), tt.agent, tt.expectOutdialID, tt.expectOutdialtargetjobID).Return(tt.responseOutdialtargetjob, nil)
+
+			r.ServeHTTP(w, req)
+			if w.Code != http.StatusOK {
+				t.Errorf("Wrong match. expect: %d, got: %d", http.StatusOK, w.Code)
+			}
+
+			if w.Body.String() != tt.expectRes {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, w.Body)
+			}
+		})
+	}
+}
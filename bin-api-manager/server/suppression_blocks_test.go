@@ -0,0 +1,164 @@
+package server
+
+import (
+	amagent "monorepo/bin-agent-manager/models/agent"
+	"monorepo/bin-api-manager/gens/openapi_server"
+	"monorepo/bin-api-manager/models/auth"
+	"monorepo/bin-api-manager/pkg/servicehandler"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	omsuppression "monorepo/bin-outdial-manager/models/suppression"
+	omsuppressionblock "monorepo/bin-outdial-manager/models/suppressionblock"
+
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_suppressionBlocksGET(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		agent *auth.AuthIdentity
+
+		reqQuery string
+
+		responseSuppressionBlocks []*omsuppressionblock.WebhookMessage
+
+		expectPageSize  uint64
+		expectPageToken string
+		expectRes       string
+	}{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("5c2e4a6c-3c2b-11f1-9f1a-1b3d5f7a9c01"),
+				},
+			}),
+
+			reqQuery: "/suppression_blocks?page_size=10&page_token=2021-03-02T03:23:20.995000Z",
+
+			responseSuppressionBlocks: []*omsuppressionblock.WebhookMessage{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("5c59b3de-3c2b-11f1-a02b-2c4e6a8b0d02"),
+					},
+					SuppressionID: uuid.FromStringOrNil("5c84c5f0-3c2b-11f1-b13c-3d5f7b9c1e03"),
+					ListType:      omsuppression.ListTypeDNC,
+					Target:        "+821100000001",
+					ReferenceType: omsuppressionblock.ReferenceTypeCall,
+					ReferenceID:   uuid.FromStringOrNil("5cafd7a2-3c2b-11f1-824d-4e6a8c0d2f04"),
+					Reason:        "The destination is in the dnc list.",
+					TMCreate:      timePtr("2020-09-20T03:23:21.995000Z"),
+				},
+			},
+
+			expectPageSize:  10,
+			expectPageToken: "2021-03-02T03:23:20.995000Z",
+			expectRes:       `{"result":[{"id":"5c59b3de-3c2b-11f1-a02b-2c4e6a8b0d02","customer_id":"00000000-0000-0000-0000-000000000000","suppression_id":"5c84c5f0-3c2b-11f1-b13c-3d5f7b9c1e03","list_type":"dnc","target":"+821100000001","reference_type":"call","reference_id":"5cafd7a2-3c2b-11f1-824d-4e6a8c0d2f04","reason":"The destination is in the dnc list.","tm_create":"2020-09-20T03:23:21.995Z","tm_update":null,"tm_delete":null}],"next_page_token":"2020-09-20T03:23:21.995000Z"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSvc := servicehandler.NewMockServiceHandler(mc)
+			h := &server{
+				serviceHandler: mockSvc,
+			}
+
+			w := httptest.NewRecorder()
+			_, r := gin.CreateTestContext(w)
+
+			r.Use(func(c *gin.Context) {
+				c.Set("auth_identity", tt.agent)
+			})
+			openapi_server.RegisterHandlers(r, h)
+
+			req, _ := http.NewRequest("GET", tt.reqQuery, nil)
+			mockSvc.EXPECT().SuppressionBlockList(req.Context(), tt.agent, tt.expectPageSize, tt.expectPageToken).Return(tt.responseSuppressionBlocks, nil)
+
+			r.ServeHTTP(w, req)
+			if w.Code != http.StatusOK {
+				t.Errorf("Wrong match. expect: %d, got: %d", http.StatusOK, w.Code)
+			}
+
+			if w.Body.String() != tt.expectRes {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, w.Body)
+			}
+		})
+	}
+}
+
+func Test_suppressionBlocksIDGET(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		agent *auth.AuthIdentity
+
+		reqQuery string
+
+		responseSuppressionBlock *omsuppressionblock.WebhookMessage
+
+		expectSuppressionBlockID uuid.UUID
+		expectRes                string
+	}{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("5c2e4a6c-3c2b-11f1-9f1a-1b3d5f7a9c01"),
+				},
+			}),
+
+			reqQuery: "/suppression_blocks/5cdae8b4-3c2b-11f1-935e-5f7b9d1e3a05",
+
+			responseSuppressionBlock: &omsuppressionblock.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("5cdae8b4-3c2b-11f1-935e-5f7b9d1e3a05"),
+				},
+			},
+
+			expectSuppressionBlockID: uuid.FromStringOrNil("5cdae8b4-3c2b-11f1-935e-5f7b9d1e3a05"),
+			expectRes:                `{"id":"5cdae8b4-3c2b-11f1-935e-5f7b9d1e3a05","customer_id":"00000000-0000-0000-0000-000000000000","suppression_id":"00000000-0000-0000-0000-000000000000","list_type":"","target":"","reference_type":"","reference_id":"00000000-0000-0000-0000-000000000000","reason":"","tm_create":null,"tm_update":null,"tm_delete":null}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSvc := servicehandler.NewMockServiceHandler(mc)
+			h := &server{
+				serviceHandler: mockSvc,
+			}
+
+			w := httptest.NewRecorder()
+			_, r := gin.CreateTestContext(w)
+
+			r.Use(func(c *gin.Context) {
+				c.Set("auth_identity", tt.agent)
+			})
+			openapi_server.RegisterHandlers(r, h)
+
+			req, _ := http.NewRequest("GET", tt.reqQuery, nil)
+			mockSvc.EXPECT().SuppressionBlockGet(req.Context(), tt.agent, tt.expectSuppressionBlockID).Return(tt.responseSuppressionBlock, nil)
+
+			r.ServeHTTP(w, req)
+			if w.Code != http.StatusOK {
+				t.Errorf("Wrong match. expect: %d, got: %d", http.StatusOK, w.Code)
+			}
+
+			if w.Body.String() != tt.expectRes {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, w.Body)
+			}
+		})
+	}
+}
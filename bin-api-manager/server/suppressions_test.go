@@ -0,0 +1,411 @@
+package server
+
+import (
+	"bytes"
+	amagent "monorepo/bin-agent-manager/models/agent"
+	"monorepo/bin-api-manager/gens/openapi_server"
+	"monorepo/bin-api-manager/models/auth"
+	"monorepo/bin-api-manager/pkg/servicehandler"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	omsuppression "monorepo/bin-outdial-manager/models/suppression"
+
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_suppressionsGET(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		agent *auth.AuthIdentity
+
+		reqQuery string
+
+		responseSuppressions []*omsuppression.WebhookMessage
+
+		expectPageSize  uint64
+		expectPageToken string
+		expectRes       string
+	}{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("3a1c5e7e-3c2b-11f1-9a2b-1d3f5a7c9e01"),
+				},
+			}),
+
+			reqQuery: "/suppressions?page_size=10&page_token=2021-03-02T03:23:20.995000Z",
+
+			responseSuppressions: []*omsuppression.WebhookMessage{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("3a4d7f2a-3c2b-11f1-8b3c-2e4a6b8d0f02"),
+					},
+					ListType: omsuppression.ListTypeOptOut,
+					Target:   "+821100000001",
+					TMCreate: timePtr("2020-09-20T03:23:21.995000Z"),
+				},
+			},
+
+			expectPageSize:  10,
+			expectPageToken: "2021-03-02T03:23:20.995000Z",
+			expectRes:       `{"result":[{"id":"3a4d7f2a-3c2b-11f1-8b3c-2e4a6b8d0f02","customer_id":"00000000-0000-0000-0000-000000000000","list_type":"opt_out","target":"+821100000001","detail":"","tm_create":"2020-09-20T03:23:21.995Z","tm_update":null,"tm_delete":null}],"next_page_token":"2020-09-20T03:23:21.995000Z"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSvc := servicehandler.NewMockServiceHandler(mc)
+			h := &server{
+				serviceHandler: mockSvc,
+			}
+
+			w := httptest.NewRecorder()
+			_, r := gin.CreateTestContext(w)
+
+			r.Use(func(c *gin.Context) {
+				c.Set("auth_identity", tt.agent)
+			})
+			openapi_server.RegisterHandlers(r, h)
+
+			req, _ := http.NewRequest("GET", tt.reqQuery, nil)
+			mockSvc.EXPECT().SuppressionList(req.Context(), tt.agent, tt.expectPageSize, tt.expectPageToken).Return(tt.responseSuppressions, nil)
+
+			r.ServeHTTP(w, req)
+			if w.Code != http.StatusOK {
+				t.Errorf("Wrong match. expect: %d, got: %d", http.StatusOK, w.Code)
+			}
+
+			if w.Body.String() != tt.expectRes {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, w.Body)
+			}
+		})
+	}
+}
+
+func Test_suppressionsPOST(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		agent *auth.AuthIdentity
+
+		reqBody []byte
+
+		responseSuppression *omsuppression.WebhookMessage
+
+		expectListType omsuppression.ListType
+		expectTarget   string
+		expectDetail   string
+
+		expectCallService bool
+		expectStatus      int
+		expectRes         string
+	}{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("3a1c5e7e-3c2b-11f1-9a2b-1d3f5a7c9e01"),
+				},
+			}),
+
+			reqBody: []byte(`{"list_type":"dnc","target":"+821100000001","detail":"test detail"}`),
+
+			responseSuppression: &omsuppression.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("3a78a0c6-3c2b-11f1-ac4d-3f5b7c9e1a03"),
+				},
+			},
+
+			expectListType: omsuppression.ListTypeDNC,
+			expectTarget:   "+821100000001",
+			expectDetail:   "test detail",
+
+			expectCallService: true,
+			expectStatus:      http.StatusOK,
+			expectRes:         `{"id":"3a78a0c6-3c2b-11f1-ac4d-3f5b7c9e1a03","customer_id":"00000000-0000-0000-0000-000000000000","list_type":"","target":"","detail":"","tm_create":null,"tm_update":null,"tm_delete":null}`,
+		},
+		{
+			name: "target missing is rejected",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("3a1c5e7e-3c2b-11f1-9a2b-1d3f5a7c9e01"),
+				},
+			}),
+
+			reqBody: []byte(`{"list_type":"dnc"}`),
+
+			expectCallService: false,
+			expectStatus:      http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSvc := servicehandler.NewMockServiceHandler(mc)
+			h := &server{
+				serviceHandler: mockSvc,
+			}
+
+			w := httptest.NewRecorder()
+			_, r := gin.CreateTestContext(w)
+
+			r.Use(func(c *gin.Context) {
+				c.Set("auth_identity", tt.agent)
+			})
+			openapi_server.RegisterHandlers(r, h)
+
+			req, _ := http.NewRequest("POST", "/suppressions", bytes.NewBuffer(tt.reqBody))
+			req.Header.Set("Content-Type", "application/json")
+			if tt.expectCallService {
+				mockSvc.EXPECT().SuppressionCreate(req.Context(), tt.agent, tt.expectListType, tt.expectTarget, tt.expectDetail).Return(tt.responseSuppression, nil)
+			}
+
+			r.ServeHTTP(w, req)
+			if w.Code != tt.expectStatus {
+				t.Errorf("Wrong match. expect: %d, got: %d", tt.expectStatus, w.Code)
+			}
+
+			if tt.expectRes != "" && w.Body.String() != tt.expectRes {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, w.Body)
+			}
+		})
+	}
+}
+
+func Test_suppressionsImportPOST(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		agent *auth.AuthIdentity
+
+		reqBody []byte
+
+		responseImported int
+		responseSkipped  int
+
+		expectListType omsuppression.ListType
+		expectTargets  []string
+		expectDetail   string
+
+		expectCallService bool
+		expectStatus      int
+		expectRes         string
+	}{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("3a1c5e7e-3c2b-11f1-9a2b-1d3f5a7c9e01"),
+				},
+			}),
+
+			reqBody: []byte(`{"list_type":"litigator","targets":["+821100000001","+821100000002","invalid"]}`),
+
+			responseImported: 2,
+			responseSkipped:  1,
+
+			expectListType: omsuppression.ListTypeLitigator,
+			expectTargets:  []string{"+821100000001", "+821100000002", "invalid"},
+			expectDetail:   "",
+
+			expectCallService: true,
+			expectStatus:      http.StatusOK,
+			expectRes:         `{"imported":2,"skipped":1}`,
+		},
+		{
+			name: "empty targets is rejected",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("3a1c5e7e-3c2b-11f1-9a2b-1d3f5a7c9e01"),
+				},
+			}),
+
+			reqBody: []byte(`{"list_type":"dnc","targets":[]}`),
+
+			expectCallService: false,
+			expectStatus:      http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSvc := servicehandler.NewMockServiceHandler(mc)
+			h := &server{
+				serviceHandler: mockSvc,
+			}
+
+			w := httptest.NewRecorder()
+			_, r := gin.CreateTestContext(w)
+
+			r.Use(func(c *gin.Context) {
+				c.Set("auth_identity", tt.agent)
+			})
+			openapi_server.RegisterHandlers(r, h)
+
+			req, _ := http.NewRequest("POST", "/suppressions/import", bytes.NewBuffer(tt.reqBody))
+			req.Header.Set("Content-Type", "application/json")
+			if tt.expectCallService {
+				mockSvc.EXPECT().SuppressionImport(req.Context(), tt.agent, tt.expectListType, tt.expectTargets, tt.expectDetail).Return(tt.responseImported, tt.responseSkipped, nil)
+			}
+
+			r.ServeHTTP(w, req)
+			if w.Code != tt.expectStatus {
+				t.Errorf("Wrong match. expect: %d, got: %d", tt.expectStatus, w.Code)
+			}
+
+			if tt.expectRes != "" && w.Body.String() != tt.expectRes {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, w.Body)
+			}
+		})
+	}
+}
+
+func Test_suppressionsIDGET(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		agent *auth.AuthIdentity
+
+		reqQuery string
+
+		responseSuppression *omsuppression.WebhookMessage
+
+		expectSuppressionID uuid.UUID
+		expectRes           string
+	}{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("3a1c5e7e-3c2b-11f1-9a2b-1d3f5a7c9e01"),
+				},
+			}),
+
+			reqQuery: "/suppressions/3aa3c1e2-3c2b-11f1-9d5e-4a6c8d0f2b04",
+
+			responseSuppression: &omsuppression.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("3aa3c1e2-3c2b-11f1-9d5e-4a6c8d0f2b04"),
+				},
+			},
+
+			expectSuppressionID: uuid.FromStringOrNil("3aa3c1e2-3c2b-11f1-9d5e-4a6c8d0f2b04"),
+			expectRes:           `{"id":"3aa3c1e2-3c2b-11f1-9d5e-4a6c8d0f2b04","customer_id":"00000000-0000-0000-0000-000000000000","list_type":"","target":"","detail":"","tm_create":null,"tm_update":null,"tm_delete":null}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSvc := servicehandler.NewMockServiceHandler(mc)
+			h := &server{
+				serviceHandler: mockSvc,
+			}
+
+			w := httptest.NewRecorder()
+			_, r := gin.CreateTestContext(w)
+
+			r.Use(func(c *gin.Context) {
+				c.Set("auth_identity", tt.agent)
+			})
+			openapi_server.RegisterHandlers(r, h)
+
+			req, _ := http.NewRequest("GET", tt.reqQuery, nil)
+			mockSvc.EXPECT().SuppressionGet(req.Context(), tt.agent, tt.expectSuppressionID).Return(tt.responseSuppression, nil)
+
+			r.ServeHTTP(w, req)
+			if w.Code != http.StatusOK {
+				t.Errorf("Wrong match. expect: %d, got: %d", http.StatusOK, w.Code)
+			}
+
+			if w.Body.String() != tt.expectRes {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, w.Body)
+			}
+		})
+	}
+}
+
+func Test_suppressionsIDDELETE(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		agent *auth.AuthIdentity
+
+		reqQuery string
+
+		responseSuppression *omsuppression.WebhookMessage
+
+		expectSuppressionID uuid.UUID
+		expectRes           string
+	}{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("3a1c5e7e-3c2b-11f1-9a2b-1d3f5a7c9e01"),
+				},
+			}),
+
+			reqQuery: "/suppressions/3acee2fe-3c2b-11f1-be6f-5b7d9e1a3c05",
+
+			responseSuppression: &omsuppression.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("3acee2fe-3c2b-11f1-be6f-5b7d9e1a3c05"),
+				},
+			},
+
+			expectSuppressionID: uuid.FromStringOrNil("3acee2fe-3c2b-11f1-be6f-5b7d9e1a3c05"),
+			expectRes:           `{"id":"3acee2fe-3c2b-11f1-be6f-5b7d9e1a3c05","customer_id":"00000000-0000-0000-0000-000000000000","list_type":"","target":"","detail":"","tm_create":null,"tm_update":null,"tm_delete":null}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSvc := servicehandler.NewMockServiceHandler(mc)
+			h := &server{
+				serviceHandler: mockSvc,
+			}
+
+			w := httptest.NewRecorder()
+			_, r := gin.CreateTestContext(w)
+
+			r.Use(func(c *gin.Context) {
+				c.Set("auth_identity", tt.agent)
+			})
+			openapi_server.RegisterHandlers(r, h)
+
+			req, _ := http.NewRequest("DELETE", tt.reqQuery, nil)
+			mockSvc.EXPECT().SuppressionDelete(req.Context(), tt.agent, tt.expectSuppressionID).Return(tt.responseSuppression, nil)
+
+			r.ServeHTTP(w, req)
+			if w.Code != http.StatusOK {
+				t.Errorf("Wrong match. expect: %d, got: %d", http.StatusOK, w.Code)
+			}
+
+			if w.Body.String() != tt.expectRes {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, w.Body)
+			}
+		})
+	}
+}
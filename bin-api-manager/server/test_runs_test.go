@@ -0,0 +1,61 @@
+package server
+
+import (
+	amagent "monorepo/bin-agent-manager/models/agent"
+	amtestrun "monorepo/bin-ai-manager/models/testrun"
+	"monorepo/bin-api-manager/gens/openapi_server"
+	"monorepo/bin-api-manager/models/auth"
+	"monorepo/bin-api-manager/pkg/servicehandler"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_GetTestRuns(t *testing.T) {
+
+	agent := auth.NewAgentIdentity(&amagent.Agent{
+		Identity: commonidentity.Identity{
+			ID: uuid.FromStringOrNil("c31c2e30-ad75-11f0-9b4d-1a2b3c4d5e01"),
+		},
+	})
+	testSuiteID := uuid.FromStringOrNil("c34e4f62-ad75-11f0-8c5e-2b3c4d5e6f02")
+
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockSvc := servicehandler.NewMockServiceHandler(mc)
+	h := &server{serviceHandler: mockSvc}
+
+	w := httptest.NewRecorder()
+	_, r := gin.CreateTestContext(w)
+	r.Use(func(c *gin.Context) {
+		c.Set("auth_identity", agent)
+	})
+	openapi_server.RegisterHandlers(r, h)
+
+	req, _ := http.NewRequest("GET", "/test_runs?page_size=10&page_token=2020-09-20%2003:23:20.995000&test_suite_id="+testSuiteID.String(), nil)
+	mockSvc.EXPECT().TestRunGetsByCustomerID(req.Context(), agent, uint64(10), "2020-09-20 03:23:20.995000", testSuiteID).Return([]*amtestrun.WebhookMessage{
+		{
+			Identity: commonidentity.Identity{
+				ID: uuid.FromStringOrNil("c3807094-ad75-11f0-ad6f-3c4d5e6f7a03"),
+			},
+			TestSuiteID: testSuiteID,
+			Status:      amtestrun.StatusPassed,
+		},
+	}, nil)
+
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Errorf("Wrong match. expect: %d, got: %d", http.StatusOK, w.Code)
+	}
+
+	expectRes := `{"result":[{"id":"c3807094-ad75-11f0-ad6f-3c4d5e6f7a03","customer_id":"00000000-0000-0000-0000-000000000000","test_suite_id":"c34e4f62-ad75-11f0-8c5e-2b3c4d5e6f02","ai_id":"00000000-0000-0000-0000-000000000000","prompt_history_id":"00000000-0000-0000-0000-000000000000","status":"passed","tm_create":null,"tm_update":null,"tm_delete":null}],"next_page_token":""}`
+	if w.Body.String() != expectRes {
+		t.Errorf("Wrong match.\nexpect: %v\ngot: %v", expectRes, w.Body)
+	}
+}
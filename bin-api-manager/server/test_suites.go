@@ -0,0 +1,320 @@
+package server
+
+import (
+	amtestsuite "monorepo/bin-ai-manager/models/testsuite"
+	"monorepo/bin-api-manager/gens/openapi_server"
+	cerrors "monorepo/bin-common-handler/models/errors"
+	commonoutline "monorepo/bin-common-handler/models/outline"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+func (h *server) PostTestSuites(c *gin.Context) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":            "PostTestSuites",
+		"request_address": c.ClientIP,
+	})
+
+	a, ok := getAuthIdentity(c)
+	if !ok {
+		log.Errorf("Could not find auth identity.")
+		abortWithError(c, cerrors.Unauthenticated(commonoutline.ServiceNameAPIManager, "AUTHENTICATION_REQUIRED", "Authentication is required."))
+		return
+	}
+	log = log.WithFields(logrus.Fields{
+		"agent": a,
+	})
+
+	var req openapi_server.PostTestSuitesJSONBody
+	if err := c.BindJSON(&req); err != nil {
+		log.Errorf("Could not parse the request. err: %v", err)
+		abortWithError(c, cerrors.InvalidArgument(commonoutline.ServiceNameAPIManager, "INVALID_JSON_BODY", "The request body is not valid JSON.").Wrap(err))
+		return
+	}
+
+	aiID := uuid.FromStringOrNil(req.AiId)
+	if aiID == uuid.Nil {
+		log.Error("Could not parse the ai id.")
+		abortWithError(c, cerrors.InvalidArgument(commonoutline.ServiceNameAPIManager, "INVALID_AI_ID", "The provided ai_id is not a valid UUID."))
+		return
+	}
+
+	detail := ""
+	if req.Detail != nil {
+		detail = *req.Detail
+	}
+
+	res, err := h.serviceHandler.TestSuiteCreate(
+		c.Request.Context(),
+		a,
+		aiID,
+		req.Name,
+		detail,
+		convertTestSuiteScenarios(req.Scenarios),
+	)
+	if err != nil {
+		log.Errorf("Could not create a test suite. err: %v", err)
+		abortWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(200, res)
+}
+
+func (h *server) GetTestSuites(c *gin.Context, params openapi_server.GetTestSuitesParams) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":            "GetTestSuites",
+		"request_address": c.ClientIP,
+	})
+
+	a, ok := getAuthIdentity(c)
+	if !ok {
+		log.Errorf("Could not find auth identity.")
+		abortWithError(c, cerrors.Unauthenticated(commonoutline.ServiceNameAPIManager, "AUTHENTICATION_REQUIRED", "Authentication is required."))
+		return
+	}
+	log = log.WithFields(logrus.Fields{
+		"agent": a,
+	})
+
+	pageSize := uint64(100)
+	if params.PageSize != nil {
+		pageSize = uint64(*params.PageSize)
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 100
+		log.Debugf("Invalid requested page size. Set to default. page_size: %d", pageSize)
+	}
+
+	pageToken := ""
+	if params.PageToken != nil {
+		pageToken = *params.PageToken
+	}
+
+	aiID := uuid.Nil
+	if params.AiId != nil {
+		aiID = uuid.FromStringOrNil(*params.AiId)
+	}
+
+	tmps, err := h.serviceHandler.TestSuiteGetsByCustomerID(c.Request.Context(), a, pageSize, pageToken, aiID)
+	if err != nil {
+		log.Errorf("Could not get a test suite list. err: %v", err)
+		abortWithServiceError(c, err)
+		return
+	}
+
+	nextToken := ""
+	if len(tmps) > 0 {
+		if tmps[len(tmps)-1].TMCreate != nil {
+			nextToken = tmps[len(tmps)-1].TMCreate.UTC().Format("2006-01-02T15:04:05.000000Z")
+		}
+	}
+
+	res := GenerateListResponse(tmps, nextToken)
+	c.JSON(200, res)
+}
+
+func (h *server) GetTestSuitesId(c *gin.Context, id string) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":            "GetTestSuitesId",
+		"request_address": c.ClientIP,
+		"test_suite_id":   id,
+	})
+
+	a, ok := getAuthIdentity(c)
+	if !ok {
+		log.Errorf("Could not find auth identity.")
+		abortWithError(c, cerrors.Unauthenticated(commonoutline.ServiceNameAPIManager, "AUTHENTICATION_REQUIRED", "Authentication is required."))
+		return
+	}
+	log = log.WithFields(logrus.Fields{
+		"agent": a,
+	})
+
+	target := uuid.FromStringOrNil(id)
+	if target == uuid.Nil {
+		log.Error("Could not parse the id.")
+		abortWithError(c, cerrors.InvalidArgument(commonoutline.ServiceNameAPIManager, "INVALID_ID", "The provided id is not a valid UUID."))
+		return
+	}
+
+	res, err := h.serviceHandler.TestSuiteGet(c.Request.Context(), a, target)
+	if err != nil {
+		log.Errorf("Could not get a test suite. err: %v", err)
+		abortWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(200, res)
+}
+
+func (h *server) DeleteTestSuitesId(c *gin.Context, id string) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":            "DeleteTestSuitesId",
+		"request_address": c.ClientIP,
+		"test_suite_id":   id,
+	})
+
+	a, ok := getAuthIdentity(c)
+	if !ok {
+		log.Errorf("Could not find auth identity.")
+		abortWithError(c, cerrors.Unauthenticated(commonoutline.ServiceNameAPIManager, "AUTHENTICATION_REQUIRED", "Authentication is required."))
+		return
+	}
+	log = log.WithFields(logrus.Fields{
+		"agent": a,
+	})
+
+	target := uuid.FromStringOrNil(id)
+	if target == uuid.Nil {
+		log.Error("Could not parse the id.")
+		abortWithError(c, cerrors.InvalidArgument(commonoutline.ServiceNameAPIManager, "INVALID_ID", "The provided id is not a valid UUID."))
+		return
+	}
+
+	res, err := h.serviceHandler.TestSuiteDelete(c.Request.Context(), a, target)
+	if err != nil {
+		log.Errorf("Could not delete the test suite. err: %v", err)
+		abortWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(200, res)
+}
+
+func (h *server) PutTestSuitesId(c *gin.Context, id string) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":            "PutTestSuitesId",
+		"request_address": c.ClientIP,
+		"test_suite_id":   id,
+	})
+
+	a, ok := getAuthIdentity(c)
+	if !ok {
+		log.Errorf("Could not find auth identity.")
+		abortWithError(c, cerrors.Unauthenticated(commonoutline.ServiceNameAPIManager, "AUTHENTICATION_REQUIRED", "Authentication is required."))
+		return
+	}
+	log = log.WithFields(logrus.Fields{
+		"agent": a,
+	})
+
+	target := uuid.FromStringOrNil(id)
+	if target == uuid.Nil {
+		log.Error("Could not parse the id.")
+		abortWithError(c, cerrors.InvalidArgument(commonoutline.ServiceNameAPIManager, "INVALID_ID", "The provided id is not a valid UUID."))
+		return
+	}
+
+	var req openapi_server.PutTestSuitesIdJSONBody
+	if err := c.BindJSON(&req); err != nil {
+		log.Errorf("Could not parse the request. err: %v", err)
+		abortWithError(c, cerrors.InvalidArgument(commonoutline.ServiceNameAPIManager, "INVALID_JSON_BODY", "The request body is not valid JSON.").Wrap(err))
+		return
+	}
+
+	detail := ""
+	if req.Detail != nil {
+		detail = *req.Detail
+	}
+
+	res, err := h.serviceHandler.TestSuiteUpdate(
+		c.Request.Context(),
+		a,
+		target,
+		req.Name,
+		detail,
+		convertTestSuiteScenarios(req.Scenarios),
+	)
+	if err != nil {
+		log.Errorf("Could not update the test suite. err: %v", err)
+		abortWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(200, res)
+}
+
+func (h *server) PostTestSuitesIdRun(c *gin.Context, id string) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":            "PostTestSuitesIdRun",
+		"request_address": c.ClientIP,
+		"test_suite_id":   id,
+	})
+
+	a, ok := getAuthIdentity(c)
+	if !ok {
+		log.Errorf("Could not find auth identity.")
+		abortWithError(c, cerrors.Unauthenticated(commonoutline.ServiceNameAPIManager, "AUTHENTICATION_REQUIRED", "Authentication is required."))
+		return
+	}
+	log = log.WithFields(logrus.Fields{
+		"agent": a,
+	})
+
+	target := uuid.FromStringOrNil(id)
+	if target == uuid.Nil {
+		log.Error("Could not parse the id.")
+		abortWithError(c, cerrors.InvalidArgument(commonoutline.ServiceNameAPIManager, "INVALID_ID", "The provided id is not a valid UUID."))
+		return
+	}
+
+	res, err := h.serviceHandler.TestSuiteRun(c.Request.Context(), a, target)
+	if err != nil {
+		log.Errorf("Could not run the test suite. err: %v", err)
+		abortWithServiceError(c, err)
+		return
+	}
+
+	c.JSON(200, res)
+}
+
+// convertTestSuiteScenarios converts the request's scenarios to the ai-manager model.
+func convertTestSuiteScenarios(scenarios []openapi_server.AIManagerTestSuiteScenario) []amtestsuite.Scenario {
+	res := make([]amtestsuite.Scenario, 0, len(scenarios))
+	for _, s := range scenarios {
+		tmp := amtestsuite.Scenario{
+			Name: s.Name,
+			Type: amtestsuite.ScenarioType(s.Type),
+		}
+		if s.Turns != nil {
+			tmp.Turns = *s.Turns
+		}
+		if s.Persona != nil {
+			tmp.Persona = *s.Persona
+		}
+		if s.Goal != nil {
+			tmp.Goal = *s.Goal
+		}
+		if s.MaxTurns != nil {
+			tmp.MaxTurns = *s.MaxTurns
+		}
+
+		if s.Assertions != nil {
+			for _, as := range *s.Assertions {
+				assertion := amtestsuite.Assertion{
+					Type: amtestsuite.AssertionType(as.Type),
+				}
+				if as.ToolName != nil {
+					assertion.ToolName = *as.ToolName
+				}
+				if as.Arguments != nil {
+					assertion.Arguments = *as.Arguments
+				}
+				if as.Text != nil {
+					assertion.Text = *as.Text
+				}
+				if as.Value != nil {
+					assertion.Value = *as.Value
+				}
+				tmp.Assertions = append(tmp.Assertions, assertion)
+			}
+		}
+
+		res = append(res, tmp)
+	}
+
+	return res
+}
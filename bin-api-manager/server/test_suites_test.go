@@ -0,0 +1,164 @@
+package server
+
+import (
+	"bytes"
+	amagent "monorepo/bin-agent-manager/models/agent"
+	amtestrun "monorepo/bin-ai-manager/models/testrun"
+	amtestsuite "monorepo/bin-ai-manager/models/testsuite"
+	"monorepo/bin-api-manager/gens/openapi_server"
+	"monorepo/bin-api-manager/models/auth"
+	"monorepo/bin-api-manager/pkg/servicehandler"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_PostTestSuites(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		agent *auth.AuthIdentity
+
+		reqQuery string
+		reqBody  []byte
+
+		responseTestSuite *amtestsuite.WebhookMessage
+
+		expectAIID      uuid.UUID
+		expectName      string
+		expectDetail    string
+		expectScenarios []amtestsuite.Scenario
+		expectRes       string
+	}{
+		{
+			name: "normal",
+			agent: auth.NewAgentIdentity(&amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("9a1c2e30-ad75-11f0-9b4d-1a2b3c4d5e01"),
+				},
+			}),
+
+			reqQuery: "/test_suites",
+			reqBody:  []byte(`{"ai_id":"9a4e4f62-ad75-11f0-8c5e-2b3c4d5e6f02","name":"refund","detail":"refund policy","scenarios":[{"name":"asks for a refund","type":"scripted","turns":["I want my money back."],"assertions":[{"type":"tool_called","tool_name":"connect_call","arguments":{"run_llm":true}},{"type":"must_mention","text":"30 days"}]},{"name":"angry customer","type":"simulated","persona":"an angry customer","goal":"get a refund","max_turns":5,"assertions":[{"type":"max_turns","value":5}]}]}`),
+
+			responseTestSuite: &amtestsuite.WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("9a807094-ad75-11f0-ad6f-3c4d5e6f7a03"),
+				},
+			},
+
+			expectAIID:   uuid.FromStringOrNil("9a4e4f62-ad75-11f0-8c5e-2b3c4d5e6f02"),
+			expectName:   "refund",
+			expectDetail: "refund policy",
+			expectScenarios: []amtestsuite.Scenario{
+				{
+					Name:  "asks for a refund",
+					Type:  amtestsuite.ScenarioTypeScripted,
+					Turns: []string{"I want my money back."},
+					Assertions: []amtestsuite.Assertion{
+						{Type: amtestsuite.AssertionTypeToolCalled, ToolName: "connect_call", Arguments: map[string]any{"run_llm": true}},
+						{Type: amtestsuite.AssertionTypeMustMention, Text: "30 days"},
+					},
+				},
+				{
+					Name:     "angry customer",
+					Type:     amtestsuite.ScenarioTypeSimulated,
+					Persona:  "an angry customer",
+					Goal:     "get a refund",
+					MaxTurns: 5,
+					Assertions: []amtestsuite.Assertion{
+						{Type: amtestsuite.AssertionTypeMaxTurns, Value: 5},
+					},
+				},
+			},
+			expectRes: `{"id":"9a807094-ad75-11f0-ad6f-3c4d5e6f7a03","customer_id":"00000000-0000-0000-0000-000000000000","ai_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSvc := servicehandler.NewMockServiceHandler(mc)
+			h := &server{
+				serviceHandler: mockSvc,
+			}
+
+			w := httptest.NewRecorder()
+			_, r := gin.CreateTestContext(w)
+
+			r.Use(func(c *gin.Context) {
+				c.Set("auth_identity", tt.agent)
+			})
+			openapi_server.RegisterHandlers(r, h)
+
+			req, _ := http.NewRequest("POST", tt.reqQuery, bytes.NewBuffer(tt.reqBody))
+			req.Header.Set("Content-Type", "application/json")
+			mockSvc.EXPECT().TestSuiteCreate(
+				req.Context(),
+				tt.agent,
+				tt.expectAIID,
+				tt.expectName,
+				tt.expectDetail,
+				tt.expectScenarios,
+			).Return(tt.responseTestSuite, nil)
+
+			r.ServeHTTP(w, req)
+			if w.Code != http.StatusOK {
+				t.Errorf("Wrong match. expect: %d, got: %d", http.StatusOK, w.Code)
+			}
+
+			if w.Body.String() != tt.expectRes {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, w.Body)
+			}
+		})
+	}
+}
+
+func Test_PostTestSuitesIdRun(t *testing.T) {
+
+	agent := auth.NewAgentIdentity(&amagent.Agent{
+		Identity: commonidentity.Identity{
+			ID: uuid.FromStringOrNil("9ab291c6-ad75-11f0-9e70-4d5e6f7a8b04"),
+		},
+	})
+	testSuiteID := uuid.FromStringOrNil("9ae4b2f8-ad75-11f0-8f81-5e6f7a8b9c05")
+
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockSvc := servicehandler.NewMockServiceHandler(mc)
+	h := &server{serviceHandler: mockSvc}
+
+	w := httptest.NewRecorder()
+	_, r := gin.CreateTestContext(w)
+	r.Use(func(c *gin.Context) {
+		c.Set("auth_identity", agent)
+	})
+	openapi_server.RegisterHandlers(r, h)
+
+	req, _ := http.NewRequest(http.MethodPost, "/test_suites/"+testSuiteID.String()+"/run", nil)
+	mockSvc.EXPECT().TestSuiteRun(req.Context(), agent, testSuiteID).Return(&amtestrun.WebhookMessage{
+		Identity: commonidentity.Identity{
+			ID: uuid.FromStringOrNil("9b16d42a-ad75-11f0-a092-6f7a8b9cad06"),
+		},
+		TestSuiteID: testSuiteID,
+		Status:      amtestrun.StatusProgressing,
+	}, nil)
+
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Errorf("Wrong match. expect: %d, got: %d", http.StatusOK, w.Code)
+	}
+
+	expectRes := `{"id":"9b16d42a-ad75-11f0-a092-6f7a8b9cad06","customer_id":"00000000-0000-0000-0000-000000000000","test_suite_id":"9ae4b2f8-ad75-11f0-8f81-5e6f7a8b9c05","ai_id":"00000000-0000-0000-0000-000000000000","prompt_history_id":"00000000-0000-0000-0000-000000000000","status":"progressing","tm_create":null,"tm_update":null,"tm_delete":null}`
+	if w.Body.String() != expectRes {
+		t.Errorf("Wrong match.\nexpect: %v\ngot: %v", expectRes, w.Body)
+	}
+}
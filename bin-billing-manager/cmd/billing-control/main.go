@@ -6,22 +6,27 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"os"
 	"time"
 
 	commonoutline "monorepo/bin-common-handler/models/outline"
 	"monorepo/bin-common-handler/models/sock"
 	commondatabasehandler "monorepo/bin-common-handler/pkg/databasehandler"
 	"monorepo/bin-common-handler/pkg/notifyhandler"
+	"monorepo/bin-common-handler/pkg/outboxhandler"
 	"monorepo/bin-common-handler/pkg/requesthandler"
 	"monorepo/bin-common-handler/pkg/sockhandler"
 
 	"monorepo/bin-billing-manager/internal/config"
 	"monorepo/bin-billing-manager/models/account"
 	"monorepo/bin-billing-manager/models/billing"
+	"monorepo/bin-billing-manager/models/rate"
+	"monorepo/bin-billing-manager/models/ratedeck"
 	"monorepo/bin-billing-manager/pkg/accounthandler"
 	"monorepo/bin-billing-manager/pkg/billinghandler"
 	"monorepo/bin-billing-manager/pkg/cachehandler"
 	"monorepo/bin-billing-manager/pkg/dbhandler"
+	"monorepo/bin-billing-manager/pkg/ratedeckhandler"
 
 	_ "github.com/go-sql-driver/mysql"
 
@@ -66,6 +71,7 @@ func initCommand() *cobra.Command {
 	cmdAccount.AddCommand(cmdAccountUpdate())
 	cmdAccount.AddCommand(cmdAccountUpdatePaymentInfo())
 	cmdAccount.AddCommand(cmdAccountUpdatePlanType())
+	cmdAccount.AddCommand(cmdAccountUpdateRateDeck())
 	cmdAccount.AddCommand(cmdAccountDelete())
 	cmdAccount.AddCommand(cmdAccountAddBalance())
 	cmdAccount.AddCommand(cmdAccountSubtractBalance())
@@ -78,8 +84,27 @@ func initCommand() *cobra.Command {
 	cmdBilling.AddCommand(cmdBillingGet())
 	cmdBilling.AddCommand(cmdBillingList())
 
+	// Rate deck subcommands
+	cmdRateDeck := &cobra.Command{Use: "ratedeck", Short: "Rate deck operations"}
+	cmdRateDeck.AddCommand(cmdRateDeckCreate())
+	cmdRateDeck.AddCommand(cmdRateDeckGet())
+	cmdRateDeck.AddCommand(cmdRateDeckList())
+	cmdRateDeck.AddCommand(cmdRateDeckUpdate())
+	cmdRateDeck.AddCommand(cmdRateDeckUpdatePlanType())
+	cmdRateDeck.AddCommand(cmdRateDeckDelete())
+	cmdRateDeck.AddCommand(cmdRateDeckImport())
+
+	// Rate subcommands
+	cmdRate := &cobra.Command{Use: "rate", Short: "Rate operations"}
+	cmdRate.AddCommand(cmdRateCreate())
+	cmdRate.AddCommand(cmdRateGet())
+	cmdRate.AddCommand(cmdRateList())
+	cmdRate.AddCommand(cmdRateDelete())
+
 	cmdRoot.AddCommand(cmdAccount)
 	cmdRoot.AddCommand(cmdBilling)
+	cmdRoot.AddCommand(cmdRateDeck)
+	cmdRoot.AddCommand(cmdRate)
 
 	// Top-up subcommands
 	cmdTopUp := &cobra.Command{Use: "topup", Short: "Top-up operations"}
@@ -265,6 +290,55 @@ func runAccountUpdatePlanType(cmd *cobra.Command, args []string) error {
 	return printJSON(res)
 }
 
+func cmdAccountUpdateRateDeck() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-rate-deck",
+		Short: "Assign a rate deck to the account",
+		RunE:  runAccountUpdateRateDeck,
+	}
+
+	flags := cmd.Flags()
+	flags.String("id", "", "Account ID (required)")
+	flags.String("rate-deck-id", "", "Rate deck ID. Empty clears the assignment and uses the plan type's rate deck")
+
+	return cmd
+}
+
+func runAccountUpdateRateDeck(cmd *cobra.Command, args []string) error {
+	accountHandler, rateDeckHandler, err := initRateDeckHandlers()
+	if err != nil {
+		return errors.Wrap(err, "failed to initialize handlers")
+	}
+
+	targetID, err := resolveUUID("id", "Account ID")
+	if err != nil {
+		return errors.Wrap(err, "invalid account ID format")
+	}
+
+	rateDeckID := uuid.Nil
+	if viper.GetString("rate-deck-id") != "" {
+		rateDeckID, err = resolveUUID("rate-deck-id", "Rate deck ID")
+		if err != nil {
+			return errors.Wrap(err, "invalid rate deck ID format")
+		}
+
+		d, err := rateDeckHandler.Get(context.Background(), rateDeckID)
+		if err != nil {
+			return errors.Wrap(err, "failed to retrieve rate deck")
+		}
+		if d.TMDelete != nil {
+			return fmt.Errorf("the rate deck was deleted: %s", rateDeckID)
+		}
+	}
+
+	res, err := accountHandler.UpdateRateDeckID(context.Background(), targetID, rateDeckID)
+	if err != nil {
+		return errors.Wrap(err, "failed to update account rate deck")
+	}
+
+	return printJSON(res)
+}
+
 func cmdAccountDelete() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "delete",
@@ -650,6 +724,439 @@ func runBillingList(cmd *cobra.Command, args []string) error {
 	return printJSON(res)
 }
 
+// Rate deck commands
+
+func cmdRateDeckCreate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a new rate deck",
+		RunE:  runRateDeckCreate,
+	}
+
+	flags := cmd.Flags()
+	flags.String("name", "", "Rate deck name")
+	flags.String("detail", "", "Rate deck detail")
+	flags.String("plan-type", "", "Plan type the rate deck applies to (free, basic, professional, unlimited)")
+
+	return cmd
+}
+
+func runRateDeckCreate(cmd *cobra.Command, args []string) error {
+	_, rateDeckHandler, err := initRateDeckHandlers()
+	if err != nil {
+		return errors.Wrap(err, "failed to initialize handlers")
+	}
+
+	res, err := rateDeckHandler.Create(
+		context.Background(),
+		viper.GetString("name"),
+		viper.GetString("detail"),
+		account.PlanType(viper.GetString("plan-type")),
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to create rate deck")
+	}
+
+	return printJSON(res)
+}
+
+func cmdRateDeckGet() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get",
+		Short: "Get a rate deck by ID",
+		RunE:  runRateDeckGet,
+	}
+
+	flags := cmd.Flags()
+	flags.String("id", "", "Rate deck ID (required)")
+
+	return cmd
+}
+
+func runRateDeckGet(cmd *cobra.Command, args []string) error {
+	_, rateDeckHandler, err := initRateDeckHandlers()
+	if err != nil {
+		return errors.Wrap(err, "failed to initialize handlers")
+	}
+
+	targetID, err := resolveUUID("id", "Rate deck ID")
+	if err != nil {
+		return errors.Wrap(err, "invalid rate deck ID format")
+	}
+
+	res, err := rateDeckHandler.Get(context.Background(), targetID)
+	if err != nil {
+		return errors.Wrap(err, "failed to retrieve rate deck")
+	}
+
+	return printJSON(res)
+}
+
+func cmdRateDeckList() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "Get rate deck list",
+		RunE:  runRateDeckList,
+	}
+
+	flags := cmd.Flags()
+	flags.Int("limit", 100, "Limit the number of rate decks to retrieve")
+	flags.String("token", "", "Retrieve rate decks before this token (pagination)")
+
+	return cmd
+}
+
+func runRateDeckList(cmd *cobra.Command, args []string) error {
+	_, rateDeckHandler, err := initRateDeckHandlers()
+	if err != nil {
+		return errors.Wrap(err, "failed to initialize handlers")
+	}
+
+	filters := map[ratedeck.Field]any{
+		ratedeck.FieldDeleted: false,
+	}
+
+	res, err := rateDeckHandler.List(context.Background(), uint64(viper.GetInt("limit")), viper.GetString("token"), filters)
+	if err != nil {
+		return errors.Wrap(err, "failed to retrieve rate decks")
+	}
+
+	return printJSON(res)
+}
+
+func cmdRateDeckUpdate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update",
+		Short: "Update rate deck basic info",
+		RunE:  runRateDeckUpdate,
+	}
+
+	flags := cmd.Flags()
+	flags.String("id", "", "Rate deck ID (required)")
+	flags.String("name", "", "Rate deck name")
+	flags.String("detail", "", "Rate deck detail")
+
+	return cmd
+}
+
+func runRateDeckUpdate(cmd *cobra.Command, args []string) error {
+	_, rateDeckHandler, err := initRateDeckHandlers()
+	if err != nil {
+		return errors.Wrap(err, "failed to initialize handlers")
+	}
+
+	targetID, err := resolveUUID("id", "Rate deck ID")
+	if err != nil {
+		return errors.Wrap(err, "invalid rate deck ID format")
+	}
+
+	res, err := rateDeckHandler.UpdateBasicInfo(context.Background(), targetID, viper.GetString("name"), viper.GetString("detail"))
+	if err != nil {
+		return errors.Wrap(err, "failed to update rate deck")
+	}
+
+	return printJSON(res)
+}
+
+func cmdRateDeckUpdatePlanType() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-plan-type",
+		Short: "Update the plan type the rate deck applies to",
+		RunE:  runRateDeckUpdatePlanType,
+	}
+
+	flags := cmd.Flags()
+	flags.String("id", "", "Rate deck ID (required)")
+	flags.String("plan-type", "", "Plan type (free, basic, professional, unlimited). Empty detaches the rate deck from the plan")
+
+	return cmd
+}
+
+func runRateDeckUpdatePlanType(cmd *cobra.Command, args []string) error {
+	_, rateDeckHandler, err := initRateDeckHandlers()
+	if err != nil {
+		return errors.Wrap(err, "failed to initialize handlers")
+	}
+
+	targetID, err := resolveUUID("id", "Rate deck ID")
+	if err != nil {
+		return errors.Wrap(err, "invalid rate deck ID format")
+	}
+
+	res, err := rateDeckHandler.UpdatePlanType(context.Background(), targetID, account.PlanType(viper.GetString("plan-type")))
+	if err != nil {
+		return errors.Wrap(err, "failed to update rate deck plan type")
+	}
+
+	return printJSON(res)
+}
+
+func cmdRateDeckDelete() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete a rate deck",
+		RunE:  runRateDeckDelete,
+	}
+
+	flags := cmd.Flags()
+	flags.String("id", "", "Rate deck ID (required)")
+
+	return cmd
+}
+
+func runRateDeckDelete(cmd *cobra.Command, args []string) error {
+	_, rateDeckHandler, err := initRateDeckHandlers()
+	if err != nil {
+		return errors.Wrap(err, "failed to initialize handlers")
+	}
+
+	targetID, err := resolveUUID("id", "Rate deck ID")
+	if err != nil {
+		return errors.Wrap(err, "invalid rate deck ID format")
+	}
+
+	res, err := rateDeckHandler.Delete(context.Background(), targetID)
+	if err != nil {
+		return errors.Wrap(err, "failed to delete rate deck")
+	}
+
+	return printJSON(res)
+}
+
+func cmdRateDeckImport() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import rates of a csv file into the rate deck",
+		Long: "Import rates of a csv file into the rate deck. The csv must have a header row with the columns\n" +
+			"cost_type, prefix, credit_per_unit and optionally connection_fee, increment_initial,\n" +
+			"increment_subsequent, tm_effective_start and tm_effective_end (RFC3339).\n" +
+			"The import is all or nothing.",
+		RunE: runRateDeckImport,
+	}
+
+	flags := cmd.Flags()
+	flags.String("id", "", "Rate deck ID (required)")
+	flags.String("file", "", "Path of the csv file (required)")
+
+	return cmd
+}
+
+func runRateDeckImport(cmd *cobra.Command, args []string) error {
+	_, rateDeckHandler, err := initRateDeckHandlers()
+	if err != nil {
+		return errors.Wrap(err, "failed to initialize handlers")
+	}
+
+	targetID, err := resolveUUID("id", "Rate deck ID")
+	if err != nil {
+		return errors.Wrap(err, "invalid rate deck ID format")
+	}
+
+	path := viper.GetString("file")
+	if path == "" {
+		return fmt.Errorf("file is required")
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return errors.Wrap(err, "failed to open the file")
+	}
+	defer func() { _ = f.Close() }()
+
+	count, err := rateDeckHandler.RateImport(context.Background(), targetID, f)
+	if err != nil {
+		return errors.Wrap(err, "failed to import rates")
+	}
+
+	fmt.Printf("Imported %d rates.\n", count)
+	return nil
+}
+
+// Rate commands
+
+func cmdRateCreate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a new rate in the rate deck",
+		RunE:  runRateCreate,
+	}
+
+	flags := cmd.Flags()
+	flags.String("rate-deck-id", "", "Rate deck ID (required)")
+	flags.String("cost-type", "", "Cost type (call_pstn_outgoing, call_pstn_incoming, sms) (required)")
+	flags.String("prefix", "", "Destination number prefix. Empty matches every destination")
+	flags.Int64("credit-per-unit", 0, "Credit in micros per minute for calls, per message for sms")
+	flags.Int64("connection-fee", 0, "Credit in micros charged once per billed call/message")
+	flags.Int("increment-initial", 0, "Initial billing increment in seconds. 0 means per-minute billing")
+	flags.Int("increment-subsequent", 0, "Subsequent billing increment in seconds")
+	flags.String("tm-effective-start", "", "Effective start in RFC3339. Empty means now")
+	flags.String("tm-effective-end", "", "Effective end in RFC3339. Empty means no end")
+
+	return cmd
+}
+
+func runRateCreate(cmd *cobra.Command, args []string) error {
+	_, rateDeckHandler, err := initRateDeckHandlers()
+	if err != nil {
+		return errors.Wrap(err, "failed to initialize handlers")
+	}
+
+	rateDeckID, err := resolveUUID("rate-deck-id", "Rate deck ID")
+	if err != nil {
+		return errors.Wrap(err, "invalid rate deck ID format")
+	}
+
+	tmEffectiveStart, err := parseOptionalTime("tm-effective-start")
+	if err != nil {
+		return err
+	}
+	tmEffectiveEnd, err := parseOptionalTime("tm-effective-end")
+	if err != nil {
+		return err
+	}
+
+	res, err := rateDeckHandler.RateCreate(
+		context.Background(),
+		rateDeckID,
+		billing.CostType(viper.GetString("cost-type")),
+		viper.GetString("prefix"),
+		viper.GetInt64("credit-per-unit"),
+		viper.GetInt64("connection-fee"),
+		viper.GetInt("increment-initial"),
+		viper.GetInt("increment-subsequent"),
+		tmEffectiveStart,
+		tmEffectiveEnd,
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to create rate")
+	}
+
+	return printJSON(res)
+}
+
+func cmdRateGet() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get",
+		Short: "Get a rate by ID",
+		RunE:  runRateGet,
+	}
+
+	flags := cmd.Flags()
+	flags.String("id", "", "Rate ID (required)")
+
+	return cmd
+}
+
+func runRateGet(cmd *cobra.Command, args []string) error {
+	_, rateDeckHandler, err := initRateDeckHandlers()
+	if err != nil {
+		return errors.Wrap(err, "failed to initialize handlers")
+	}
+
+	targetID, err := resolveUUID("id", "Rate ID")
+	if err != nil {
+		return errors.Wrap(err, "invalid rate ID format")
+	}
+
+	res, err := rateDeckHandler.RateGet(context.Background(), targetID)
+	if err != nil {
+		return errors.Wrap(err, "failed to retrieve rate")
+	}
+
+	return printJSON(res)
+}
+
+func cmdRateList() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "Get rate list of the rate deck",
+		RunE:  runRateList,
+	}
+
+	flags := cmd.Flags()
+	flags.String("rate-deck-id", "", "Rate deck ID (required)")
+	flags.String("cost-type", "", "Filter by cost type")
+	flags.Int("limit", 100, "Limit the number of rates to retrieve")
+	flags.String("token", "", "Retrieve rates before this token (pagination)")
+
+	return cmd
+}
+
+func runRateList(cmd *cobra.Command, args []string) error {
+	_, rateDeckHandler, err := initRateDeckHandlers()
+	if err != nil {
+		return errors.Wrap(err, "failed to initialize handlers")
+	}
+
+	rateDeckID, err := resolveUUID("rate-deck-id", "Rate deck ID")
+	if err != nil {
+		return errors.Wrap(err, "invalid rate deck ID format")
+	}
+
+	filters := map[rate.Field]any{
+		rate.FieldRateDeckID: rateDeckID,
+		rate.FieldDeleted:    false,
+	}
+	if costType := viper.GetString("cost-type"); costType != "" {
+		filters[rate.FieldCostType] = billing.CostType(costType)
+	}
+
+	res, err := rateDeckHandler.RateList(context.Background(), uint64(viper.GetInt("limit")), viper.GetString("token"), filters)
+	if err != nil {
+		return errors.Wrap(err, "failed to retrieve rates")
+	}
+
+	return printJSON(res)
+}
+
+func cmdRateDelete() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete a rate",
+		RunE:  runRateDelete,
+	}
+
+	flags := cmd.Flags()
+	flags.String("id", "", "Rate ID (required)")
+
+	return cmd
+}
+
+func runRateDelete(cmd *cobra.Command, args []string) error {
+	_, rateDeckHandler, err := initRateDeckHandlers()
+	if err != nil {
+		return errors.Wrap(err, "failed to initialize handlers")
+	}
+
+	targetID, err := resolveUUID("id", "Rate ID")
+	if err != nil {
+		return errors.Wrap(err, "invalid rate ID format")
+	}
+
+	res, err := rateDeckHandler.RateDelete(context.Background(), targetID)
+	if err != nil {
+		return errors.Wrap(err, "failed to delete rate")
+	}
+
+	return printJSON(res)
+}
+
+// parseOptionalTime returns the RFC3339 time of the given flag. nil if the flag is empty.
+func parseOptionalTime(flagName string) (*time.Time, error) {
+	val := viper.GetString(flagName)
+	if val == "" {
+		return nil, nil
+	}
+
+	res, err := time.Parse(time.RFC3339, val)
+	if err != nil {
+		return nil, fmt.Errorf("invalid format for %s: '%s' is not a RFC3339 timestamp", flagName, val)
+	}
+	res = res.UTC()
+
+	return &res, nil
+}
+
 // Handler initialization
 
 func initHandlers() (accounthandler.AccountHandler, billinghandler.BillingHandler, error) {
@@ -675,17 +1182,45 @@ func initCache() (cachehandler.CacheHandler, error) {
 }
 
 func initBillingHandlers(sqlDB *sql.DB, cache cachehandler.CacheHandler) (accounthandler.AccountHandler, billinghandler.BillingHandler, error) {
-	db := dbhandler.NewHandler(sqlDB, cache)
-	sockHandler := sockhandler.NewSockHandler(sock.TypeRabbitMQ, config.Get().RabbitMQAddress)
+	reqHandler, notifyHandler, db := initBaseHandlers(sqlDB, cache)
+
+	rateDeckHandler := ratedeckhandler.NewRateDeckHandler(db, notifyHandler)
+	accHandler := accounthandler.NewAccountHandler(reqHandler, db, notifyHandler, nil, rateDeckHandler)
+	billHandler := billinghandler.NewBillingHandler(reqHandler, db, notifyHandler, accHandler, rateDeckHandler, config.Get().AIUsageMarkupPercent)
+
+	return accHandler, billHandler, nil
+}
+
+func initRateDeckHandlers() (accounthandler.AccountHandler, ratedeckhandler.RateDeckHandler, error) {
+	sqlDB, err := commondatabasehandler.Connect(config.Get().DatabaseDSN)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "could not connect to the database")
+	}
+
+	cache, err := initCache()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reqHandler, notifyHandler, db := initBaseHandlers(sqlDB, cache)
+	rateDeckHandler := ratedeckhandler.NewRateDeckHandler(db, notifyHandler)
+	accHandler := accounthandler.NewAccountHandler(reqHandler, db, notifyHandler, nil, rateDeckHandler)
+
+	return accHandler, rateDeckHandler, nil
+}
+
+func initBaseHandlers(sqlDB *sql.DB, cache cachehandler.CacheHandler) (requesthandler.RequestHandler, notifyhandler.NotifyHandler, dbhandler.DBHandler) {
+	sockHandler := sockhandler.NewSockHandler(sock.Type(config.Get().SockType), config.Get().RabbitMQAddress)
 	sockHandler.Connect()
 
 	reqHandler := requesthandler.NewRequestHandler(sockHandler, serviceName)
 	notifyHandler := notifyhandler.NewNotifyHandler(sockHandler, reqHandler, commonoutline.QueueNameBillingEvent, serviceName)
 
-	accHandler := accounthandler.NewAccountHandler(reqHandler, db, notifyHandler, nil)
-	billHandler := billinghandler.NewBillingHandler(reqHandler, db, notifyHandler, accHandler)
+	// the events stored by the cli are relayed by the billing-manager daemon.
+	outboxHandler := outboxhandler.NewOutboxHandler(sqlDB, sockHandler, reqHandler, dbhandler.OutboxTable, commonoutline.QueueNameBillingEvent, serviceName)
+	db := dbhandler.NewHandler(sqlDB, cache, outboxHandler)
 
-	return accHandler, billHandler, nil
+	return reqHandler, notifyHandler, db
 }
 
 func initDB() (dbhandler.DBHandler, error) {
@@ -699,7 +1234,9 @@ func initDB() (dbhandler.DBHandler, error) {
 		return nil, err
 	}
 
-	return dbhandler.NewHandler(sqlDB, cache), nil
+	// the cli doesn't relay the outbox events. the billing-manager daemon does.
+	outboxHandler := outboxhandler.NewOutboxHandler(sqlDB, nil, nil, dbhandler.OutboxTable, commonoutline.QueueNameBillingEvent, serviceName)
+	return dbhandler.NewHandler(sqlDB, cache, outboxHandler), nil
 }
 
 // Top-up commands
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"os"
 	"os/signal"
@@ -10,8 +11,10 @@ import (
 	"monorepo/bin-common-handler/models/sock"
 	commondatabasehandler "monorepo/bin-common-handler/pkg/databasehandler"
 	"monorepo/bin-common-handler/pkg/notifyhandler"
+	"monorepo/bin-common-handler/pkg/outboxhandler"
 	"monorepo/bin-common-handler/pkg/requesthandler"
 	"monorepo/bin-common-handler/pkg/sockhandler"
+	"monorepo/bin-common-handler/pkg/tracehandler"
 
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/sirupsen/logrus"
@@ -25,6 +28,8 @@ import (
 	"monorepo/bin-billing-manager/pkg/failedeventhandler"
 	"monorepo/bin-billing-manager/pkg/listenhandler"
 	"monorepo/bin-billing-manager/pkg/paddlehandler"
+	"monorepo/bin-billing-manager/pkg/ratedeckhandler"
+	"monorepo/bin-billing-manager/pkg/statementhandler"
 	"monorepo/bin-billing-manager/pkg/subscribehandler"
 )
 
@@ -72,6 +77,10 @@ func runDaemon() error {
 	// init prometheus
 	config.InitPrometheus()
 
+	// init tracing
+	tracehandler.Init(serviceName)
+	defer tracehandler.Shutdown()
+
 	// connect to database
 	sqlDB, err := commondatabasehandler.Connect(config.Get().DatabaseDSN)
 	if err != nil {
@@ -106,32 +115,40 @@ func signalHandler() {
 
 // run runs the billing-manager
 func run(sqlDB *sql.DB, cache cachehandler.CacheHandler) error {
-	// dbhandler
-	db := dbhandler.NewHandler(sqlDB, cache)
-
 	// rabbitmq sock connect
-	sockHandler := sockhandler.NewSockHandler(sock.TypeRabbitMQ, config.Get().RabbitMQAddress)
+	sockHandler := sockhandler.NewSockHandler(sock.Type(config.Get().SockType), config.Get().RabbitMQAddress)
 	sockHandler.Connect()
 
 	reqHandler := requesthandler.NewRequestHandler(sockHandler, serviceName)
 	notifyHandler := notifyhandler.NewNotifyHandler(sockHandler, reqHandler, commonoutline.QueueNameBillingEvent, serviceName)
 
+	// outbox relay
+	outboxHandler := outboxhandler.NewOutboxHandler(sqlDB, sockHandler, reqHandler, dbhandler.OutboxTable, commonoutline.QueueNameBillingEvent, serviceName)
+	outboxHandler.Run(context.Background())
+
+	// dbhandler
+	db := dbhandler.NewHandler(sqlDB, cache, outboxHandler)
+
 	paddleHandler := paddlehandler.NewPaddleHandler(
 		config.Get().PaddleAPIKey,
 		config.Get().PaddlePriceIDBasic,
 		config.Get().PaddlePriceIDProfessional,
+		config.Get().PaddleProductIDCredit,
 	)
 
-	accountHandler := accounthandler.NewAccountHandler(reqHandler, db, notifyHandler, paddleHandler)
-	billingHandler := billinghandler.NewBillingHandler(reqHandler, db, notifyHandler, accountHandler)
+	rateDeckHandler := ratedeckhandler.NewRateDeckHandler(db, notifyHandler)
+	accountHandler := accounthandler.NewAccountHandler(reqHandler, db, notifyHandler, paddleHandler, rateDeckHandler)
+	billingHandler := billinghandler.NewBillingHandler(reqHandler, db, notifyHandler, accountHandler, rateDeckHandler, config.Get().AIUsageMarkupPercent)
+
+	statementHandler := statementhandler.NewStatementHandler(reqHandler, db, notifyHandler)
 
 	// build the subscribe handler and its failed event handler together — this must
 	// happen before runListen so the failed event handler can be wired into the
 	// listenhandler for the /v1/failed_events/retry route.
-	subHandler, failedHandler := buildFailedEventHandler(db, sockHandler, accountHandler, billingHandler)
+	subHandler, failedHandler := buildFailedEventHandler(db, sockHandler, accountHandler, billingHandler, statementHandler)
 
 	// run listen
-	if err := runListen(sockHandler, accountHandler, billingHandler, paddleHandler, failedHandler); err != nil {
+	if err := runListen(sockHandler, accountHandler, billingHandler, paddleHandler, failedHandler, statementHandler); err != nil {
 		return err
 	}
 
@@ -150,7 +167,7 @@ func run(sqlDB *sql.DB, cache cachehandler.CacheHandler) error {
 // subscribe handler is created first with a nil failed-event processor placeholder,
 // then the failed event handler is built from its event processor, then patched back
 // onto the subscribe handler.
-func buildFailedEventHandler(db dbhandler.DBHandler, sockHandler sockhandler.SockHandler, accoutHandler accounthandler.AccountHandler, billingHandler billinghandler.BillingHandler) (subscribehandler.SubscribeHandler, failedeventhandler.FailedEventHandler) {
+func buildFailedEventHandler(db dbhandler.DBHandler, sockHandler sockhandler.SockHandler, accoutHandler accounthandler.AccountHandler, billingHandler billinghandler.BillingHandler, statementHandler statementhandler.StatementHandler) (subscribehandler.SubscribeHandler, failedeventhandler.FailedEventHandler) {
 	subscribeTargets := []string{
 		string(commonoutline.QueueNameCallEvent),
 		string(commonoutline.QueueNameMessageEvent),
@@ -158,6 +175,8 @@ func buildFailedEventHandler(db dbhandler.DBHandler, sockHandler sockhandler.Soc
 		string(commonoutline.QueueNameCustomerEvent),
 		string(commonoutline.QueueNameNumberEvent),
 		string(commonoutline.QueueNameTTSEvent),
+		string(commonoutline.QueueNameAIEvent),
+		string(commonoutline.QueueNameBillingEvent), // the auto top-up and statement generation requests
 	}
 
 	// placeholder processor — will be set after subscribe handler is created
@@ -167,6 +186,7 @@ func buildFailedEventHandler(db dbhandler.DBHandler, sockHandler sockhandler.Soc
 		subscribeTargets,
 		accoutHandler,
 		billingHandler,
+		statementHandler,
 		nil, // temporary nil — set below
 	)
 
@@ -194,12 +214,12 @@ func runSubscribe(subHandler subscribehandler.SubscribeHandler) error {
 }
 
 // runListen runs the listen handler
-func runListen(sockHandler sockhandler.SockHandler, accoutHandler accounthandler.AccountHandler, billingHandler billinghandler.BillingHandler, paddleHandler paddlehandler.PaddleHandler, failedHandler failedeventhandler.FailedEventHandler) error {
+func runListen(sockHandler sockhandler.SockHandler, accoutHandler accounthandler.AccountHandler, billingHandler billinghandler.BillingHandler, paddleHandler paddlehandler.PaddleHandler, failedHandler failedeventhandler.FailedEventHandler, statementHandler statementhandler.StatementHandler) error {
 	log := logrus.WithFields(logrus.Fields{
 		"func": "runListen",
 	})
 
-	listenHandler := listenhandler.NewListenHandler(sockHandler, accoutHandler, billingHandler, paddleHandler, failedHandler)
+	listenHandler := listenhandler.NewListenHandler(sockHandler, accoutHandler, billingHandler, paddleHandler, failedHandler, statementHandler)
 
 	if err := listenHandler.Run(string(commonoutline.QueueNameBillingRequest), string(commonoutline.QueueNameDelay)); err != nil {
 		log.Errorf("Could not run the listenhandler correctly. err: %v", err)
@@ -21,6 +21,7 @@ var (
 // flags and environment variables for the service.
 type Config struct {
 	RabbitMQAddress         string // RabbitMQAddress is the address (including host and port) of the RabbitMQ server.
+	SockType                string // SockType is the message broker type. rabbitMQ or nats.
 	PrometheusEndpoint      string // PrometheusEndpoint is the HTTP path at which Prometheus metrics are exposed.
 	PrometheusListenAddress string // PrometheusListenAddress is the network address on which the Prometheus metrics HTTP server listens (for example, ":8080").
 	DatabaseDSN             string // DatabaseDSN is the data source name used to connect to the primary database.
@@ -31,6 +32,9 @@ type Config struct {
 	PaddleAPIKey              string // PaddleAPIKey is the API key for authenticating with the Paddle API.
 	PaddlePriceIDBasic        string // PaddlePriceIDBasic is the Paddle price ID for the basic plan.
 	PaddlePriceIDProfessional string // PaddlePriceIDProfessional is the Paddle price ID for the professional plan.
+	PaddleProductIDCredit     string // PaddleProductIDCredit is the Paddle product ID for the credit auto top-up charges.
+
+	AIUsageMarkupPercent int // AIUsageMarkupPercent is the markup in percent applied to the AI provider cost when charging the AI usage.
 }
 
 func Bootstrap(cmd *cobra.Command) error {
@@ -49,6 +53,7 @@ func bindConfig(cmd *cobra.Command) error {
 	f := cmd.PersistentFlags()
 
 	f.String("rabbitmq_address", "", "RabbitMQ server address")
+	f.String("sock_type", "rabbitMQ", "Message broker type. rabbitMQ or nats")
 	f.String("prometheus_endpoint", "", "Prometheus metrics endpoint")
 	f.String("prometheus_listen_address", "", "Prometheus listen address")
 	f.String("database_dsn", "", "Database connection DSN")
@@ -58,9 +63,12 @@ func bindConfig(cmd *cobra.Command) error {
 	f.String("paddle_api_key", "", "Paddle API key")
 	f.String("paddle_price_id_basic", "", "Paddle price ID for basic plan")
 	f.String("paddle_price_id_professional", "", "Paddle price ID for professional plan")
+	f.String("paddle_product_id_credit", "", "Paddle product ID for credit auto top-up")
+	f.Int("ai_usage_markup_percent", 0, "Markup in percent applied to the AI usage cost")
 
 	bindings := map[string]string{
 		"rabbitmq_address":          "RABBITMQ_ADDRESS",
+		"sock_type":                 "SOCK_TYPE",
 		"prometheus_endpoint":       "PROMETHEUS_ENDPOINT",
 		"prometheus_listen_address": "PROMETHEUS_LISTEN_ADDRESS",
 		"database_dsn":              "DATABASE_DSN",
@@ -68,9 +76,12 @@ func bindConfig(cmd *cobra.Command) error {
 		"redis_password":            "REDIS_PASSWORD",
 		"redis_database":            "REDIS_DATABASE",
 
-		"paddle_api_key":              "PADDLE_API_KEY",
-		"paddle_price_id_basic":       "PADDLE_PRICE_ID_BASIC",
+		"paddle_api_key":               "PADDLE_API_KEY",
+		"paddle_price_id_basic":        "PADDLE_PRICE_ID_BASIC",
 		"paddle_price_id_professional": "PADDLE_PRICE_ID_PROFESSIONAL",
+		"paddle_product_id_credit":     "PADDLE_PRODUCT_ID_CREDIT",
+
+		"ai_usage_markup_percent": "AI_USAGE_MARKUP_PERCENT",
 	}
 
 	for flagKey, envKey := range bindings {
@@ -97,6 +108,7 @@ func LoadGlobalConfig() {
 	once.Do(func() {
 		globalConfig = Config{
 			RabbitMQAddress:         viper.GetString("rabbitmq_address"),
+			SockType:                viper.GetString("sock_type"),
 			PrometheusEndpoint:      viper.GetString("prometheus_endpoint"),
 			PrometheusListenAddress: viper.GetString("prometheus_listen_address"),
 			DatabaseDSN:             viper.GetString("database_dsn"),
@@ -107,6 +119,9 @@ func LoadGlobalConfig() {
 			PaddleAPIKey:              viper.GetString("paddle_api_key"),
 			PaddlePriceIDBasic:        viper.GetString("paddle_price_id_basic"),
 			PaddlePriceIDProfessional: viper.GetString("paddle_price_id_professional"),
+			PaddleProductIDCredit:     viper.GetString("paddle_product_id_credit"),
+
+			AIUsageMarkupPercent: viper.GetInt("ai_usage_markup_percent"),
 		}
 		logrus.Debug("Configuration has been loaded and locked.")
 	})
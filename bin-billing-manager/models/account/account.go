@@ -4,6 +4,8 @@ import (
 	"time"
 
 	commonidentity "monorepo/bin-common-handler/models/identity"
+
+	"github.com/gofrs/uuid"
 )
 
 // Account define
@@ -18,12 +20,23 @@ type Account struct {
 	PlanType   PlanType   `json:"plan_type" db:"plan_type"`
 	PlanStatus PlanStatus `json:"plan_status" db:"plan_status"`
 
+	RateDeckID uuid.UUID `json:"rate_deck_id" db:"rate_deck_id,uuid"` // the rate deck assigned to the account. nil means the rate deck of the plan type
+
 	BalanceCredit int64 `json:"balance_credit" db:"balance_credit"`
 	BalanceToken  int64 `json:"balance_token" db:"balance_token"`
 
 	PaymentType   PaymentType   `json:"payment_type" db:"payment_type"`
 	PaymentMethod PaymentMethod `json:"payment_method" db:"payment_method"`
 
+	// spending settings
+	SpendLimits            []SpendLimit `json:"spend_limits" db:"spend_limits,json"`                         // the spending caps by period and cost type
+	BalanceAlertThresholds []int64      `json:"balance_alert_thresholds" db:"balance_alert_thresholds,json"` // the balances in micros which trigger the low balance alert
+	AutoTopUpThreshold     int64        `json:"auto_topup_threshold" db:"auto_topup_threshold"`              // the balance in micros which triggers the auto top-up
+	AutoTopUpAmount        int64        `json:"auto_topup_amount" db:"auto_topup_amount"`                    // the auto top-up amount in micros. 0 disables the auto top-up
+
+	AutoTopUpPendingID uuid.UUID  `json:"auto_topup_pending_id" db:"auto_topup_pending_id,uuid"` // the auto top-up request whose charge is waiting for the paddle webhook
+	TmAutoTopUpPending *time.Time `json:"tm_auto_topup_pending" db:"tm_auto_topup_pending"`      // the time the pending auto top-up was charged. nil if none is pending
+
 	PaddleSubscriptionID string `json:"paddle_subscription_id" db:"paddle_subscription_id"`
 	PaddleCustomerID     string `json:"paddle_customer_id" db:"paddle_customer_id"`
 
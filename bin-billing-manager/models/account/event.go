@@ -5,4 +5,8 @@ const (
 	EventTypeAccountCreated string = "account_created" // the account has created
 	EventTypeAccountUpdated string = "account_updated" // the account's info has updated
 	EventTypeAccountDeleted string = "account_deleted" // the account's info has deleted
+
+	EventTypeAccountBalanceLow string = "account_balance_low" // the account's balance has crossed the alert threshold
+
+	EventTypeAccountAutoTopUpRequested string = "account_auto_topup_requested" // the account's balance has crossed the auto top-up threshold. internal only
 )
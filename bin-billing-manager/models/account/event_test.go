@@ -13,6 +13,7 @@ func TestEventTypeConstants(t *testing.T) {
 		{"event_type_account_created", EventTypeAccountCreated, "account_created"},
 		{"event_type_account_updated", EventTypeAccountUpdated, "account_updated"},
 		{"event_type_account_deleted", EventTypeAccountDeleted, "account_deleted"},
+		{"event_type_account_auto_topup_requested", EventTypeAccountAutoTopUpRequested, "account_auto_topup_requested"},
 	}
 
 	for _, tt := range tests {
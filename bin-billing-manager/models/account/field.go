@@ -16,12 +16,22 @@ const (
 	FieldPlanType   Field = "plan_type"
 	FieldPlanStatus Field = "plan_status"
 
+	FieldRateDeckID Field = "rate_deck_id"
+
 	FieldBalanceCredit Field = "balance_credit"
 	FieldBalanceToken  Field = "balance_token"
 
 	FieldPaymentType   Field = "payment_type"
 	FieldPaymentMethod Field = "payment_method"
 
+	FieldSpendLimits            Field = "spend_limits"
+	FieldBalanceAlertThresholds Field = "balance_alert_thresholds"
+	FieldAutoTopUpThreshold     Field = "auto_topup_threshold"
+	FieldAutoTopUpAmount        Field = "auto_topup_amount"
+
+	FieldAutoTopUpPendingID Field = "auto_topup_pending_id"
+	FieldTmAutoTopUpPending Field = "tm_auto_topup_pending"
+
 	FieldPaddleSubscriptionID Field = "paddle_subscription_id"
 	FieldPaddleCustomerID     Field = "paddle_customer_id"
 
@@ -20,6 +20,12 @@ func TestFieldConstants(t *testing.T) {
 		{"field_balance_token", FieldBalanceToken, "balance_token"},
 		{"field_payment_type", FieldPaymentType, "payment_type"},
 		{"field_payment_method", FieldPaymentMethod, "payment_method"},
+		{"field_spend_limits", FieldSpendLimits, "spend_limits"},
+		{"field_balance_alert_thresholds", FieldBalanceAlertThresholds, "balance_alert_thresholds"},
+		{"field_auto_topup_threshold", FieldAutoTopUpThreshold, "auto_topup_threshold"},
+		{"field_auto_topup_amount", FieldAutoTopUpAmount, "auto_topup_amount"},
+		{"field_auto_topup_pending_id", FieldAutoTopUpPendingID, "auto_topup_pending_id"},
+		{"field_tm_auto_topup_pending", FieldTmAutoTopUpPending, "tm_auto_topup_pending"},
 		{"field_tm_last_topup", FieldTmLastTopUp, "tm_last_topup"},
 		{"field_tm_next_topup", FieldTmNextTopUp, "tm_next_topup"},
 		{"field_tm_create", FieldTMCreate, "tm_create"},
@@ -9,6 +9,7 @@ type FieldStruct struct {
 	CustomerID    uuid.UUID     `filter:"customer_id"`
 	Name          string        `filter:"name"`
 	PlanType      PlanType      `filter:"plan_type"`
+	RateDeckID    uuid.UUID     `filter:"rate_deck_id"`
 	BalanceCredit int64         `filter:"balance_credit"`
 	BalanceToken  int64         `filter:"balance_token"`
 	PaymentType   PaymentType   `filter:"payment_type"`
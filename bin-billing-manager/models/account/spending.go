@@ -0,0 +1,85 @@
+package account
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"monorepo/bin-billing-manager/models/billing"
+)
+
+// SpendLimitPeriod defines the period of the spend limit
+type SpendLimitPeriod string
+
+// list of spend limit periods
+const (
+	SpendLimitPeriodDaily   SpendLimitPeriod = "daily"
+	SpendLimitPeriodMonthly SpendLimitPeriod = "monthly"
+)
+
+// SpendLimit defines the customer's spending cap in the period
+type SpendLimit struct {
+	Period      SpendLimitPeriod `json:"period"`
+	CostType    billing.CostType `json:"cost_type,omitempty"` // empty means all cost types
+	LimitCredit int64            `json:"limit_credit"`        // in micros
+}
+
+// list of spending settings limits
+const (
+	MaxSpendLimits                  = 10
+	MaxBalanceAlertThresholds       = 5
+	AutoTopUpAmountUnit       int64 = 10000 // the auto top-up amount is charged in cents
+
+	// the pending auto top-up blocks the other top-ups until its webhook arrives or this long.
+	AutoTopUpPendingTimeout = time.Hour
+)
+
+// IsValid returns true if the period is supported.
+func (p SpendLimitPeriod) IsValid() bool {
+	switch p {
+	case SpendLimitPeriodDaily, SpendLimitPeriodMonthly:
+		return true
+	default:
+		return false
+	}
+}
+
+// Start returns the start of the period which contains the given time.
+// The periods are based on UTC.
+func (p SpendLimitPeriod) Start(t time.Time) time.Time {
+	t = t.UTC()
+	switch p {
+	case SpendLimitPeriodMonthly:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	}
+}
+
+// BalanceAlert defines the low balance alert of the account
+type BalanceAlert struct {
+	AccountID     uuid.UUID `json:"account_id"`
+	CustomerID    uuid.UUID `json:"customer_id"`
+	Threshold     int64     `json:"threshold"`      // the crossed threshold in micros
+	BalanceCredit int64     `json:"balance_credit"` // the balance after the crossing in micros
+}
+
+// CreateWebhookEvent generate WebhookEvent
+func (h *BalanceAlert) CreateWebhookEvent() ([]byte, error) {
+	m, err := json.Marshal(h)
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// AutoTopUpRequest defines the auto top-up requested by the balance crossing the auto top-up threshold.
+// The charge is made by the event's subscriber, so the failed charge is retried.
+type AutoTopUpRequest struct {
+	ID            uuid.UUID `json:"id"` // the charge's idempotency key
+	AccountID     uuid.UUID `json:"account_id"`
+	Amount        int64     `json:"amount"`         // the auto top-up amount in micros
+	BalanceCredit int64     `json:"balance_credit"` // the balance after the crossing in micros
+}
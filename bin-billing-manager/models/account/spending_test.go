@@ -0,0 +1,84 @@
+package account
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+func Test_SpendLimitPeriodIsValid(t *testing.T) {
+	tests := []struct {
+		name   string
+		period SpendLimitPeriod
+		expect bool
+	}{
+		{"daily", SpendLimitPeriodDaily, true},
+		{"monthly", SpendLimitPeriodMonthly, true},
+		{"empty", SpendLimitPeriod(""), false},
+		{"weekly", SpendLimitPeriod("weekly"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if res := tt.period.IsValid(); res != tt.expect {
+				t.Errorf("Wrong match. expect: %v, got: %v", tt.expect, res)
+			}
+		})
+	}
+}
+
+func Test_SpendLimitPeriodStart(t *testing.T) {
+	tests := []struct {
+		name   string
+		period SpendLimitPeriod
+		tm     time.Time
+		expect time.Time
+	}{
+		{
+			"daily",
+			SpendLimitPeriodDaily,
+			time.Date(2024, 3, 15, 13, 20, 10, 500, time.UTC),
+			time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			"monthly",
+			SpendLimitPeriodMonthly,
+			time.Date(2024, 3, 15, 13, 20, 10, 500, time.UTC),
+			time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			"daily is based on utc",
+			SpendLimitPeriodDaily,
+			time.Date(2024, 3, 15, 1, 0, 0, 0, time.FixedZone("KST", 9*60*60)),
+			time.Date(2024, 3, 14, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if res := tt.period.Start(tt.tm); !res.Equal(tt.expect) {
+				t.Errorf("Wrong match. expect: %v, got: %v", tt.expect, res)
+			}
+		})
+	}
+}
+
+func Test_BalanceAlertCreateWebhookEvent(t *testing.T) {
+	a := &BalanceAlert{
+		AccountID:     uuid.FromStringOrNil("5e8f0a5c-0b52-11f0-9c41-7b3f3f0e7c11"),
+		CustomerID:    uuid.FromStringOrNil("5eb8c6f6-0b52-11f0-8d6b-0f2b8f7e1a22"),
+		Threshold:     5000000,
+		BalanceCredit: 4990000,
+	}
+
+	res, err := a.CreateWebhookEvent()
+	if err != nil {
+		t.Errorf("Wrong match. expect: ok, got: %v", err)
+	}
+
+	expect := `{"account_id":"5e8f0a5c-0b52-11f0-9c41-7b3f3f0e7c11","customer_id":"5eb8c6f6-0b52-11f0-8d6b-0f2b8f7e1a22","threshold":5000000,"balance_credit":4990000}`
+	if string(res) != expect {
+		t.Errorf("Wrong match.\nexpect: %s\ngot: %s", expect, res)
+	}
+}
@@ -23,6 +23,11 @@ type WebhookMessage struct {
 	PaymentType   PaymentType   `json:"payment_type"`
 	PaymentMethod PaymentMethod `json:"payment_method"`
 
+	SpendLimits            []SpendLimit `json:"spend_limits,omitempty"`
+	BalanceAlertThresholds []int64      `json:"balance_alert_thresholds,omitempty"`
+	AutoTopUpThreshold     int64        `json:"auto_topup_threshold"`
+	AutoTopUpAmount        int64        `json:"auto_topup_amount"`
+
 	PaddleSubscriptionID string `json:"paddle_subscription_id,omitempty"`
 	PaddleCustomerID     string `json:"paddle_customer_id,omitempty"`
 
@@ -52,6 +57,11 @@ func (h *Account) ConvertWebhookMessage() *WebhookMessage {
 		PaymentType:   h.PaymentType,
 		PaymentMethod: h.PaymentMethod,
 
+		SpendLimits:            h.SpendLimits,
+		BalanceAlertThresholds: h.BalanceAlertThresholds,
+		AutoTopUpThreshold:     h.AutoTopUpThreshold,
+		AutoTopUpAmount:        h.AutoTopUpAmount,
+
 		PaddleSubscriptionID: h.PaddleSubscriptionID,
 		PaddleCustomerID:     h.PaddleCustomerID,
 
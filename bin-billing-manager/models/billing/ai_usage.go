@@ -0,0 +1,82 @@
+package billing
+
+import "math"
+
+// Default AI usage rates in micros (1 dollar = 1,000,000 micros).
+// They are applied when the account's rate deck has no rate of the engine.
+const (
+	DefaultCreditPerKTokenAIPrompt     int64 = 2500  // $0.0025/1K prompt tokens
+	DefaultCreditPerKTokenAICompletion int64 = 10000 // $0.01/1K completion tokens
+	DefaultCreditPerKTokenAICached     int64 = 1250  // $0.00125/1K cached prompt tokens
+	DefaultCreditPerMinuteAISTT        int64 = 10000 // $0.01/min
+	DefaultCreditPerMinuteAITTS        int64 = 30000 // $0.03/min
+)
+
+// AIUsage is the AI provider usage of an aicall.
+type AIUsage struct {
+	PromptTokens     int64   // LLM input tokens, including the cached ones
+	CompletionTokens int64   // LLM output tokens
+	CachedTokens     int64   // LLM input tokens served from the provider's prompt cache
+	STTSeconds       float64 // seconds of audio sent to the speech-to-text engine
+	TTSSeconds       float64 // seconds of audio generated by the text-to-speech engine
+}
+
+// BillableUnits returns the billable units of the ai usage, the LLM tokens.
+func (u AIUsage) BillableUnits() int {
+	return int(u.PromptTokens + u.CompletionTokens)
+}
+
+// UsageDuration returns the seconds of the STT and TTS audio, rounded up.
+func (u AIUsage) UsageDuration() int {
+	return int(math.Ceil(u.STTSeconds + u.TTSSeconds))
+}
+
+// AIUsageRates defines the credit in micros of the ai usage's pricing components.
+// The tokens are priced per 1K tokens, the STT and TTS audio per minute.
+type AIUsageRates struct {
+	PromptToken     int64
+	CachedToken     int64
+	CompletionToken int64
+	STT             int64
+	TTS             int64
+}
+
+// DefaultAIUsageRates returns the default rates of the ai usage's pricing components.
+func DefaultAIUsageRates() AIUsageRates {
+	return AIUsageRates{
+		PromptToken:     DefaultCreditPerKTokenAIPrompt,
+		CachedToken:     DefaultCreditPerKTokenAICached,
+		CompletionToken: DefaultCreditPerKTokenAICompletion,
+		STT:             DefaultCreditPerMinuteAISTT,
+		TTS:             DefaultCreditPerMinuteAITTS,
+	}
+}
+
+// IsAIUsageComponent returns true if the given cost type is a pricing component of the ai usage.
+func IsAIUsageComponent(ct CostType) bool {
+	switch ct {
+	case CostTypeAIPromptToken, CostTypeAICachedToken, CostTypeAICompletionToken, CostTypeAISTT, CostTypeAITTS:
+		return true
+	default:
+		return false
+	}
+}
+
+// CalculateAIUsageCredit returns the credit in micros of the given AI usage
+// priced by the given rates with the markup applied. The markup is in percent of the provider cost.
+// The result is rounded up to the micro.
+func CalculateAIUsageCredit(u AIUsage, rates AIUsageRates, markupPercent int) int64 {
+	uncachedTokens := max(u.PromptTokens-u.CachedTokens, 0)
+
+	cost := float64(uncachedTokens)*float64(rates.PromptToken)/1000 +
+		float64(u.CachedTokens)*float64(rates.CachedToken)/1000 +
+		float64(u.CompletionTokens)*float64(rates.CompletionToken)/1000 +
+		u.STTSeconds*float64(rates.STT)/60 +
+		u.TTSSeconds*float64(rates.TTS)/60
+	if cost <= 0 {
+		return 0
+	}
+
+	cost = cost * float64(100+max(markupPercent, 0)) / 100
+	return int64(math.Ceil(cost))
+}
@@ -0,0 +1,139 @@
+package billing
+
+import (
+	"testing"
+)
+
+func Test_CalculateAIUsageCredit(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		usage         AIUsage
+		markupPercent int
+
+		expectRes int64
+	}{
+		{
+			name: "tokens only",
+
+			usage: AIUsage{
+				PromptTokens:     2000,
+				CompletionTokens: 500,
+			},
+			markupPercent: 0,
+
+			expectRes: 10000,
+		},
+		{
+			name: "cached tokens are charged at the cached rate",
+
+			usage: AIUsage{
+				PromptTokens: 2000,
+				CachedTokens: 1000,
+			},
+			markupPercent: 0,
+
+			expectRes: 3750,
+		},
+		{
+			name: "stt and tts",
+
+			usage: AIUsage{
+				STTSeconds: 30,
+				TTSSeconds: 6,
+			},
+			markupPercent: 0,
+
+			expectRes: 8000,
+		},
+		{
+			name: "markup applied",
+
+			usage: AIUsage{
+				PromptTokens:     2000,
+				CompletionTokens: 500,
+			},
+			markupPercent: 20,
+
+			expectRes: 12000,
+		},
+		{
+			name: "rounded up to the micro",
+
+			usage: AIUsage{
+				PromptTokens: 1,
+			},
+			markupPercent: 0,
+
+			expectRes: 3,
+		},
+		{
+			name: "negative markup is ignored",
+
+			usage: AIUsage{
+				CompletionTokens: 1000,
+			},
+			markupPercent: -50,
+
+			expectRes: 10000,
+		},
+		{
+			name: "empty usage",
+
+			usage:         AIUsage{},
+			markupPercent: 20,
+
+			expectRes: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := CalculateAIUsageCredit(tt.usage, DefaultAIUsageRates(), tt.markupPercent)
+			if res != tt.expectRes {
+				t.Errorf("Wrong match. expect: %d, got: %d", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_CalculateAIUsageCredit_rates(t *testing.T) {
+	u := AIUsage{
+		PromptTokens:     3000,
+		CachedTokens:     1000,
+		CompletionTokens: 1000,
+		STTSeconds:       60,
+		TTSSeconds:       30,
+	}
+	rates := AIUsageRates{
+		PromptToken:     1000,
+		CachedToken:     500,
+		CompletionToken: 4000,
+		STT:             6000,
+		TTS:             12000,
+	}
+
+	// 2*1000 + 1*500 + 1*4000 + 6000 + 6000
+	res := CalculateAIUsageCredit(u, rates, 0)
+	if res != 18500 {
+		t.Errorf("Wrong match. expect: 18500, got: %d", res)
+	}
+}
+
+func Test_AIUsage_BillableUnits(t *testing.T) {
+	u := AIUsage{
+		PromptTokens:     2000,
+		CachedTokens:     1000,
+		CompletionTokens: 500,
+		STTSeconds:       30.2,
+		TTSSeconds:       6.5,
+	}
+
+	if res := u.BillableUnits(); res != 2500 {
+		t.Errorf("Wrong match. expect: 2500, got: %d", res)
+	}
+	if res := u.UsageDuration(); res != 37 {
+		t.Errorf("Wrong match. expect: 37, got: %d", res)
+	}
+}
@@ -34,12 +34,17 @@ type Billing struct {
 	CostType      CostType      `json:"cost_type" db:"cost_type"`
 
 	// Usage measurement
-	UsageDuration int `json:"usage_duration" db:"usage_duration"`
-	BillableUnits int `json:"billable_units" db:"billable_units"`
+	UsageDuration  int `json:"usage_duration" db:"usage_duration"`
+	BillableUnits  int `json:"billable_units" db:"billable_units"`   // billed minutes for the duration cost types
+	BilledDuration int `json:"billed_duration" db:"billed_duration"` // billed seconds of the duration after the billing increments
 
 	// Rates
-	RateTokenPerUnit  int64 `json:"rate_token_per_unit" db:"rate_token_per_unit"`
-	RateCreditPerUnit int64 `json:"rate_credit_per_unit" db:"rate_credit_per_unit"`
+	RateID                  uuid.UUID `json:"rate_id" db:"rate_id,uuid"` // the rate deck's rate applied. nil means the default rate of the cost type
+	RateTokenPerUnit        int64     `json:"rate_token_per_unit" db:"rate_token_per_unit"`
+	RateCreditPerUnit       int64     `json:"rate_credit_per_unit" db:"rate_credit_per_unit"`
+	RateConnectionFee       int64     `json:"rate_connection_fee" db:"rate_connection_fee"`
+	RateIncrementInitial    int       `json:"rate_increment_initial" db:"rate_increment_initial"`
+	RateIncrementSubsequent int       `json:"rate_increment_subsequent" db:"rate_increment_subsequent"`
 
 	// Ledger delta (signed: usage = negative, top_up/refund = positive)
 	AmountToken  int64 `json:"amount_token" db:"amount_token"`
@@ -79,6 +84,8 @@ const (
 	ReferenceTypeTokenAdjustment   ReferenceType = "token_adjustment"
 	ReferenceTypeSpeaking          ReferenceType = "speaking"
 	ReferenceTypeRecording         ReferenceType = "recording"
+	ReferenceTypeAIcall            ReferenceType = "aicall"
+	ReferenceTypeAIAssist          ReferenceType = "aiassist"
 
 	ReferenceTypePaddleCreditPurchase ReferenceType = "paddle_credit_purchase"
 	ReferenceTypePaddleSubscription   ReferenceType = "paddle_subscription"
@@ -96,6 +103,22 @@ const (
 	StatusFinished    Status = "finished"
 )
 
+// GetCostInfo returns the billing mode and rates of the billing.
+// The rate deck's rate stored at the billing creation overrides the default rate of the cost type.
+func (h *Billing) GetCostInfo() CostInfo {
+	res := GetCostInfo(h.CostType)
+	if h.RateID == uuid.Nil {
+		return res
+	}
+
+	res.CreditPerUnit = h.RateCreditPerUnit
+	res.ConnectionFee = h.RateConnectionFee
+	res.IncrementInitial = h.RateIncrementInitial
+	res.IncrementSubsequent = h.RateIncrementSubsequent
+
+	return res
+}
+
 // CalculateBillableUnits returns billable minutes (ceiling-rounded from seconds).
 func CalculateBillableUnits(durationSec int) int {
 	if durationSec <= 0 {
@@ -15,6 +15,87 @@ type CostInfo struct {
 	Mode          CostMode
 	TokenPerUnit  int64
 	CreditPerUnit int64
+
+	// rate deck's pricing. zero values keep the flat per-unit pricing.
+	ConnectionFee       int64 // credit charged once per billing
+	IncrementInitial    int   // initial billing increment in seconds. 0 means per-minute billing
+	IncrementSubsequent int   // subsequent billing increment in seconds
+}
+
+// CalculateBillableUnits returns the billable units of the given duration, the billed minutes rounded up.
+func (c CostInfo) CalculateBillableUnits(durationSec int) int {
+	return CalculateBillableUnits(c.CalculateBilledDuration(durationSec))
+}
+
+// CalculateBilledDuration returns the billed seconds of the given duration.
+// With the billing increments, the duration is rounded up to the initial increment, then to the subsequent increments.
+// Otherwise, it is rounded up to the minute.
+func (c CostInfo) CalculateBilledDuration(durationSec int) int {
+	if durationSec <= 0 {
+		return 0
+	}
+
+	if c.IncrementInitial <= 0 {
+		return CalculateBillableUnits(durationSec) * 60
+	}
+
+	if durationSec <= c.IncrementInitial || c.IncrementSubsequent <= 0 {
+		return max(c.IncrementInitial, durationSec)
+	}
+
+	remain := durationSec - c.IncrementInitial
+	return c.IncrementInitial + (remain+c.IncrementSubsequent-1)/c.IncrementSubsequent*c.IncrementSubsequent
+}
+
+// CalculateCredit returns the credit of the given billable units including the connection fee.
+// With the billing increments, the credit is of the given billed duration in seconds instead of the billable minutes,
+// and the credit per unit is per minute.
+func (c CostInfo) CalculateCredit(billableUnits int, billedDuration int) int64 {
+	if billableUnits <= 0 {
+		return 0
+	}
+
+	if c.IncrementInitial <= 0 {
+		return int64(billableUnits)*c.CreditPerUnit + c.ConnectionFee
+	}
+
+	// round up to the micro
+	return (int64(billedDuration)*c.CreditPerUnit+59)/60 + c.ConnectionFee
+}
+
+// CalculateDurationCredit returns the credit of the given duration including the connection fee.
+func (c CostInfo) CalculateDurationCredit(durationSec int) int64 {
+	return c.CalculateCredit(c.CalculateBillableUnits(durationSec), c.CalculateBilledDuration(durationSec))
+}
+
+// CalculateMaxDuration returns the longest duration in seconds of which the credit does not exceed the given max credit.
+// It returns -1 if the credit never exceeds the max credit.
+func (c CostInfo) CalculateMaxDuration(maxCredit int64) int {
+	if c.CreditPerUnit <= 0 {
+		if c.ConnectionFee > maxCredit {
+			return 0
+		}
+		return -1
+	}
+
+	remain := maxCredit - c.ConnectionFee
+	if remain < 0 {
+		return 0
+	}
+
+	if c.IncrementInitial <= 0 {
+		return int(remain/c.CreditPerUnit) * 60
+	}
+
+	maxUnits := int(remain * 60 / c.CreditPerUnit)
+	if maxUnits < c.IncrementInitial {
+		return 0
+	}
+	if c.IncrementSubsequent <= 0 {
+		return maxUnits
+	}
+
+	return c.IncrementInitial + (maxUnits-c.IncrementInitial)/c.IncrementSubsequent*c.IncrementSubsequent
 }
 
 // CostType classifies why a billing cost was applied.
@@ -33,6 +114,14 @@ const (
 	CostTypeNumberRenew      CostType = "number_renew"
 	CostTypeTTS              CostType = "tts"
 	CostTypeRecording        CostType = "recording"
+	CostTypeAIUsage          CostType = "ai_usage"
+
+	// the ai usage's pricing components. the rate decks price them by the engine. they are not billed by themselves.
+	CostTypeAIPromptToken     CostType = "ai_prompt_token"     // per 1K uncached prompt tokens
+	CostTypeAICachedToken     CostType = "ai_cached_token"     // per 1K cached prompt tokens
+	CostTypeAICompletionToken CostType = "ai_completion_token" // per 1K completion tokens
+	CostTypeAISTT             CostType = "ai_stt"              // per minute of the audio sent to the speech-to-text engine
+	CostTypeAITTS             CostType = "ai_tts"              // per minute of the audio generated by the text-to-speech engine
 )
 
 // Default credit rates per unit in micros (1 dollar = 1,000,000 micros).
@@ -58,24 +147,27 @@ const (
 func GetCostInfo(ct CostType) CostInfo {
 	switch ct {
 	case CostTypeCallPSTNOutgoing:
-		return CostInfo{CostModeCreditOnly, 0, DefaultCreditPerUnitCallPSTNOutgoing}
+		return CostInfo{Mode: CostModeCreditOnly, TokenPerUnit: 0, CreditPerUnit: DefaultCreditPerUnitCallPSTNOutgoing}
 	case CostTypeCallPSTNIncoming:
-		return CostInfo{CostModeCreditOnly, 0, DefaultCreditPerUnitCallPSTNIncoming}
+		return CostInfo{Mode: CostModeCreditOnly, TokenPerUnit: 0, CreditPerUnit: DefaultCreditPerUnitCallPSTNIncoming}
 	case CostTypeCallVN:
-		return CostInfo{CostModeTokenFirst, DefaultTokenPerUnitCallVN, DefaultCreditPerUnitCallVN}
+		return CostInfo{Mode: CostModeTokenFirst, TokenPerUnit: DefaultTokenPerUnitCallVN, CreditPerUnit: DefaultCreditPerUnitCallVN}
 	case CostTypeCallExtension, CostTypeCallDirectExt:
-		return CostInfo{CostModeFree, 0, 0}
+		return CostInfo{Mode: CostModeFree, TokenPerUnit: 0, CreditPerUnit: 0}
 	case CostTypeSMS:
-		return CostInfo{CostModeCreditOnly, 0, DefaultCreditPerUnitSMS}
+		return CostInfo{Mode: CostModeCreditOnly, TokenPerUnit: 0, CreditPerUnit: DefaultCreditPerUnitSMS}
 	case CostTypeEmail:
-		return CostInfo{CostModeCreditOnly, 0, DefaultCreditPerUnitEmail}
+		return CostInfo{Mode: CostModeCreditOnly, TokenPerUnit: 0, CreditPerUnit: DefaultCreditPerUnitEmail}
 	case CostTypeNumber, CostTypeNumberRenew:
-		return CostInfo{CostModeCreditOnly, 0, DefaultCreditPerUnitNumber}
+		return CostInfo{Mode: CostModeCreditOnly, TokenPerUnit: 0, CreditPerUnit: DefaultCreditPerUnitNumber}
 	case CostTypeTTS:
-		return CostInfo{CostModeTokenFirst, DefaultTokenPerUnitTTS, DefaultCreditPerUnitTTS}
+		return CostInfo{Mode: CostModeTokenFirst, TokenPerUnit: DefaultTokenPerUnitTTS, CreditPerUnit: DefaultCreditPerUnitTTS}
 	case CostTypeRecording:
-		return CostInfo{CostModeTokenFirst, DefaultTokenPerUnitRecording, DefaultCreditPerUnitRecording}
+		return CostInfo{Mode: CostModeTokenFirst, TokenPerUnit: DefaultTokenPerUnitRecording, CreditPerUnit: DefaultCreditPerUnitRecording}
+	case CostTypeAIUsage:
+		// the billable units of the ai usage are the LLM tokens. the credit is calculated from its components. see CalculateAIUsageCredit.
+		return CostInfo{Mode: CostModeCreditOnly, TokenPerUnit: 0, CreditPerUnit: 0}
 	default:
-		return CostInfo{CostModeDisabled, 0, 0}
+		return CostInfo{Mode: CostModeDisabled, TokenPerUnit: 0, CreditPerUnit: 0}
 	}
 }
@@ -85,6 +85,13 @@ func Test_GetCostInfo(t *testing.T) {
 			expectTokenPerUnit:  DefaultTokenPerUnitTTS,
 			expectCreditPerUnit: DefaultCreditPerUnitTTS,
 		},
+		{
+			name:                "ai_usage - credit only",
+			costType:            CostTypeAIUsage,
+			expectMode:          CostModeCreditOnly,
+			expectTokenPerUnit:  0,
+			expectCreditPerUnit: 0,
+		},
 		{
 			name:                "none - disabled",
 			costType:            CostTypeNone,
@@ -116,3 +123,147 @@ func Test_GetCostInfo(t *testing.T) {
 		})
 	}
 }
+
+func Test_CostInfo_CalculateBillableUnits(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		costInfo    CostInfo
+		durationSec int
+
+		expectRes int
+	}{
+		{"per-minute billing", CostInfo{}, 61, 2},
+		{"zero duration", CostInfo{IncrementInitial: 60, IncrementSubsequent: 6}, 0, 0},
+		{"60/6 within the initial increment", CostInfo{IncrementInitial: 60, IncrementSubsequent: 6}, 5, 1},
+		{"60/6 one subsequent increment", CostInfo{IncrementInitial: 60, IncrementSubsequent: 6}, 61, 2},
+		{"1/1 per-second billing", CostInfo{IncrementInitial: 1, IncrementSubsequent: 1}, 73, 2},
+		{"120 initial increment", CostInfo{IncrementInitial: 120, IncrementSubsequent: 6}, 5, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := tt.costInfo.CalculateBillableUnits(tt.durationSec)
+			if res != tt.expectRes {
+				t.Errorf("Wrong match. expect: %d, got: %d", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_CostInfo_CalculateBilledDuration(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		costInfo    CostInfo
+		durationSec int
+
+		expectRes int
+	}{
+		{"per-minute billing", CostInfo{}, 61, 120},
+		{"zero duration", CostInfo{IncrementInitial: 60, IncrementSubsequent: 6}, 0, 0},
+		{"60/6 within the initial increment", CostInfo{IncrementInitial: 60, IncrementSubsequent: 6}, 5, 60},
+		{"60/6 exactly the initial increment", CostInfo{IncrementInitial: 60, IncrementSubsequent: 6}, 60, 60},
+		{"60/6 one subsequent increment", CostInfo{IncrementInitial: 60, IncrementSubsequent: 6}, 61, 66},
+		{"60/6 two subsequent increments", CostInfo{IncrementInitial: 60, IncrementSubsequent: 6}, 72, 72},
+		{"1/1 per-second billing", CostInfo{IncrementInitial: 1, IncrementSubsequent: 1}, 73, 73},
+		{"30 without subsequent increment", CostInfo{IncrementInitial: 30}, 45, 45},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := tt.costInfo.CalculateBilledDuration(tt.durationSec)
+			if res != tt.expectRes {
+				t.Errorf("Wrong match. expect: %d, got: %d", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_CostInfo_CalculateCredit(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		costInfo       CostInfo
+		billableUnits  int
+		billedDuration int
+
+		expectRes int64
+	}{
+		{"per-minute billing", CostInfo{CreditPerUnit: 10000}, 3, 180, 30000},
+		{"per-minute billing with connection fee", CostInfo{CreditPerUnit: 10000, ConnectionFee: 5000}, 3, 180, 35000},
+		{"per-message billing", CostInfo{CreditPerUnit: 10000}, 1, 0, 10000},
+		{"zero units has no connection fee", CostInfo{CreditPerUnit: 10000, ConnectionFee: 5000}, 0, 0, 0},
+		{"60/6 billing", CostInfo{CreditPerUnit: 12000, IncrementInitial: 60, IncrementSubsequent: 6}, 2, 66, 13200},
+		{"60/6 billing rounds up to the micro", CostInfo{CreditPerUnit: 10001, IncrementInitial: 60, IncrementSubsequent: 6}, 2, 66, 11002},
+		{"60/6 billing with connection fee", CostInfo{CreditPerUnit: 12000, ConnectionFee: 1000, IncrementInitial: 60, IncrementSubsequent: 6}, 1, 60, 13000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := tt.costInfo.CalculateCredit(tt.billableUnits, tt.billedDuration)
+			if res != tt.expectRes {
+				t.Errorf("Wrong match. expect: %d, got: %d", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_CostInfo_CalculateDurationCredit(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		costInfo    CostInfo
+		durationSec int
+
+		expectRes int64
+	}{
+		{"per-minute billing", CostInfo{CreditPerUnit: 10000}, 61, 20000},
+		{"60/6 billing", CostInfo{CreditPerUnit: 12000, IncrementInitial: 60, IncrementSubsequent: 6}, 61, 13200},
+		{"zero duration", CostInfo{CreditPerUnit: 12000, ConnectionFee: 1000}, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := tt.costInfo.CalculateDurationCredit(tt.durationSec)
+			if res != tt.expectRes {
+				t.Errorf("Wrong match. expect: %d, got: %d", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_CostInfo_CalculateMaxDuration(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		costInfo  CostInfo
+		maxCredit int64
+
+		expectRes int
+	}{
+		{"per-minute billing", CostInfo{CreditPerUnit: 10000}, 35000, 180},
+		{"per-minute billing with connection fee", CostInfo{CreditPerUnit: 10000, ConnectionFee: 5000}, 35000, 180},
+		{"max credit under the first minute", CostInfo{CreditPerUnit: 10000}, 9999, 0},
+		{"max credit under the connection fee", CostInfo{CreditPerUnit: 10000, ConnectionFee: 5000}, 4000, 0},
+		{"60/6 billing", CostInfo{CreditPerUnit: 12000, IncrementInitial: 60, IncrementSubsequent: 6}, 13300, 66},
+		{"60/6 billing under the initial increment", CostInfo{CreditPerUnit: 12000, IncrementInitial: 60, IncrementSubsequent: 6}, 11999, 0},
+		{"60/0 billing", CostInfo{CreditPerUnit: 12000, IncrementInitial: 60}, 13300, 66},
+		{"free", CostInfo{}, 10000, -1},
+		{"free with connection fee over the max credit", CostInfo{ConnectionFee: 20000}, 10000, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := tt.costInfo.CalculateMaxDuration(tt.maxCredit)
+			if res != tt.expectRes {
+				t.Errorf("Wrong match. expect: %d, got: %d", tt.expectRes, res)
+			}
+		})
+	}
+}
@@ -16,12 +16,17 @@ const (
 	FieldReferenceType Field = "reference_type"
 	FieldReferenceID   Field = "reference_id"
 
-	FieldCostType      Field = "cost_type"
-	FieldUsageDuration Field = "usage_duration"
-	FieldBillableUnits Field = "billable_units"
-
-	FieldRateTokenPerUnit  Field = "rate_token_per_unit"
-	FieldRateCreditPerUnit Field = "rate_credit_per_unit"
+	FieldCostType       Field = "cost_type"
+	FieldUsageDuration  Field = "usage_duration"
+	FieldBillableUnits  Field = "billable_units"
+	FieldBilledDuration Field = "billed_duration"
+
+	FieldRateID                  Field = "rate_id"
+	FieldRateTokenPerUnit        Field = "rate_token_per_unit"
+	FieldRateCreditPerUnit       Field = "rate_credit_per_unit"
+	FieldRateConnectionFee       Field = "rate_connection_fee"
+	FieldRateIncrementInitial    Field = "rate_increment_initial"
+	FieldRateIncrementSubsequent Field = "rate_increment_subsequent"
 
 	FieldAmountToken  Field = "amount_token"
 	FieldAmountCredit Field = "amount_credit"
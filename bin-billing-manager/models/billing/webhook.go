@@ -21,12 +21,17 @@ type WebhookMessage struct {
 	ReferenceType ReferenceType `json:"reference_type"`
 	ReferenceID   uuid.UUID     `json:"reference_id"`
 
-	CostType      CostType `json:"cost_type"`
-	UsageDuration int      `json:"usage_duration"`
-	BillableUnits int      `json:"billable_units"`
-
-	RateTokenPerUnit  int64 `json:"rate_token_per_unit"`
-	RateCreditPerUnit int64 `json:"rate_credit_per_unit"`
+	CostType       CostType `json:"cost_type"`
+	UsageDuration  int      `json:"usage_duration"`
+	BillableUnits  int      `json:"billable_units"`
+	BilledDuration int      `json:"billed_duration"`
+
+	RateID                  uuid.UUID `json:"rate_id"`
+	RateTokenPerUnit        int64     `json:"rate_token_per_unit"`
+	RateCreditPerUnit       int64     `json:"rate_credit_per_unit"`
+	RateConnectionFee       int64     `json:"rate_connection_fee"`
+	RateIncrementInitial    int       `json:"rate_increment_initial"`
+	RateIncrementSubsequent int       `json:"rate_increment_subsequent"`
 
 	AmountToken  int64 `json:"amount_token"`
 	AmountCredit int64 `json:"amount_credit"`
@@ -57,12 +62,17 @@ func (h *Billing) ConvertWebhookMessage() *WebhookMessage {
 		ReferenceType: h.ReferenceType,
 		ReferenceID:   h.ReferenceID,
 
-		CostType:      h.CostType,
-		UsageDuration: h.UsageDuration,
-		BillableUnits: h.BillableUnits,
-
-		RateTokenPerUnit:  h.RateTokenPerUnit,
-		RateCreditPerUnit: h.RateCreditPerUnit,
+		CostType:       h.CostType,
+		UsageDuration:  h.UsageDuration,
+		BillableUnits:  h.BillableUnits,
+		BilledDuration: h.BilledDuration,
+
+		RateID:                  h.RateID,
+		RateTokenPerUnit:        h.RateTokenPerUnit,
+		RateCreditPerUnit:       h.RateCreditPerUnit,
+		RateConnectionFee:       h.RateConnectionFee,
+		RateIncrementInitial:    h.RateIncrementInitial,
+		RateIncrementSubsequent: h.RateIncrementSubsequent,
 
 		AmountToken:  h.AmountToken,
 		AmountCredit: h.AmountCredit,
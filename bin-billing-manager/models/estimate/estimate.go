@@ -0,0 +1,45 @@
+package estimate
+
+import (
+	"github.com/gofrs/uuid"
+
+	"monorepo/bin-billing-manager/models/billing"
+)
+
+// Estimate is the credit cost of a usage priced by the rate applied to the account.
+// The amount is positive and does not consider the account's token balance.
+type Estimate struct {
+	CustomerID uuid.UUID `json:"customer_id"`
+	AccountID  uuid.UUID `json:"account_id"`
+
+	// the billing of the estimated usage. empty for the quotes
+	BillingID     uuid.UUID             `json:"billing_id"`
+	ReferenceType billing.ReferenceType `json:"reference_type"`
+	ReferenceID   uuid.UUID             `json:"reference_id"`
+
+	CostType billing.CostType `json:"cost_type"`
+
+	// Rates
+	RateID                  uuid.UUID `json:"rate_id"` // the rate deck's rate applied. nil means the default rate of the cost type
+	RateCreditPerUnit       int64     `json:"rate_credit_per_unit"`
+	RateConnectionFee       int64     `json:"rate_connection_fee"`
+	RateIncrementInitial    int       `json:"rate_increment_initial"`
+	RateIncrementSubsequent int       `json:"rate_increment_subsequent"`
+
+	Duration       int   `json:"duration"` // seconds
+	BillableUnits  int   `json:"billable_units"`
+	BilledDuration int   `json:"billed_duration"` // billed seconds after the billing increments
+	AmountCredit   int64 `json:"amount_credit"`   // credit in micros
+}
+
+// GetCostInfo returns the billing mode and rates of the estimate.
+func (h *Estimate) GetCostInfo() billing.CostInfo {
+	res := billing.GetCostInfo(h.CostType)
+
+	res.CreditPerUnit = h.RateCreditPerUnit
+	res.ConnectionFee = h.RateConnectionFee
+	res.IncrementInitial = h.RateIncrementInitial
+	res.IncrementSubsequent = h.RateIncrementSubsequent
+
+	return res
+}
@@ -0,0 +1,29 @@
+package rate
+
+// Field represents rate field for database queries
+type Field string
+
+// List of fields
+const (
+	FieldID         Field = "id"
+	FieldRateDeckID Field = "rate_deck_id"
+
+	FieldCostType Field = "cost_type"
+	FieldPrefix   Field = "prefix"
+
+	FieldCreditPerUnit Field = "credit_per_unit"
+	FieldConnectionFee Field = "connection_fee"
+
+	FieldIncrementInitial    Field = "increment_initial"
+	FieldIncrementSubsequent Field = "increment_subsequent"
+
+	FieldTMEffectiveStart Field = "tm_effective_start"
+	FieldTMEffectiveEnd   Field = "tm_effective_end"
+
+	FieldTMCreate Field = "tm_create"
+	FieldTMUpdate Field = "tm_update"
+	FieldTMDelete Field = "tm_delete"
+
+	// filter only
+	FieldDeleted Field = "deleted"
+)
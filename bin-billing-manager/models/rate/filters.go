@@ -0,0 +1,17 @@
+package rate
+
+import (
+	"github.com/gofrs/uuid"
+
+	"monorepo/bin-billing-manager/models/billing"
+)
+
+// FieldStruct defines allowed filters for Rate queries
+// Each field corresponds to a filterable database column
+type FieldStruct struct {
+	ID         uuid.UUID        `filter:"id"`
+	RateDeckID uuid.UUID        `filter:"rate_deck_id"`
+	CostType   billing.CostType `filter:"cost_type"`
+	Prefix     string           `filter:"prefix"`
+	Deleted    bool             `filter:"deleted"`
+}
@@ -0,0 +1,45 @@
+package rate
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"monorepo/bin-billing-manager/models/billing"
+)
+
+// Rate defines the price of a destination prefix in a rate deck.
+// The rate of the longest prefix matching the destination number is applied.
+type Rate struct {
+	ID         uuid.UUID `json:"id" db:"id,uuid"`
+	RateDeckID uuid.UUID `json:"rate_deck_id" db:"rate_deck_id,uuid"`
+
+	CostType billing.CostType `json:"cost_type" db:"cost_type"`
+	Prefix   string           `json:"prefix" db:"prefix"` // destination number prefix in digits without the leading '+', or the engine prefix of the ai usage's components. empty matches every destination
+
+	CreditPerUnit int64 `json:"credit_per_unit" db:"credit_per_unit"` // credit in micros per minute for calls, per message for sms, per 1K tokens or per minute for the ai usage's components
+	ConnectionFee int64 `json:"connection_fee" db:"connection_fee"`   // credit in micros charged once per billed call/message
+
+	// billing increments in seconds. e.g. 60/6 bills the first 60 seconds, then every 6 seconds.
+	// 0 means per-minute billing.
+	IncrementInitial    int `json:"increment_initial" db:"increment_initial"`
+	IncrementSubsequent int `json:"increment_subsequent" db:"increment_subsequent"`
+
+	TMEffectiveStart *time.Time `json:"tm_effective_start" db:"tm_effective_start"`
+	TMEffectiveEnd   *time.Time `json:"tm_effective_end" db:"tm_effective_end"` // nil means no end
+
+	// timestamp
+	TMCreate *time.Time `json:"tm_create" db:"tm_create"`
+	TMUpdate *time.Time `json:"tm_update" db:"tm_update"`
+	TMDelete *time.Time `json:"tm_delete" db:"tm_delete"`
+}
+
+// IsRatable returns true if the given cost type can be priced by a rate deck.
+func IsRatable(costType billing.CostType) bool {
+	switch costType {
+	case billing.CostTypeCallPSTNOutgoing, billing.CostTypeCallPSTNIncoming, billing.CostTypeSMS:
+		return true
+	default:
+		return billing.IsAIUsageComponent(costType)
+	}
+}
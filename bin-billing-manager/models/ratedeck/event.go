@@ -0,0 +1,8 @@
+package ratedeck
+
+// list of event types
+const (
+	EventTypeRateDeckCreated string = "rate_deck_created" // the rate deck has created
+	EventTypeRateDeckUpdated string = "rate_deck_updated" // the rate deck's info has updated
+	EventTypeRateDeckDeleted string = "rate_deck_deleted" // the rate deck has deleted
+)
@@ -0,0 +1,21 @@
+package ratedeck
+
+// Field represents rate deck field for database queries
+type Field string
+
+// List of fields
+const (
+	FieldID Field = "id"
+
+	FieldName   Field = "name"
+	FieldDetail Field = "detail"
+
+	FieldPlanType Field = "plan_type"
+
+	FieldTMCreate Field = "tm_create"
+	FieldTMUpdate Field = "tm_update"
+	FieldTMDelete Field = "tm_delete"
+
+	// filter only
+	FieldDeleted Field = "deleted"
+)
@@ -0,0 +1,16 @@
+package ratedeck
+
+import (
+	"github.com/gofrs/uuid"
+
+	"monorepo/bin-billing-manager/models/account"
+)
+
+// FieldStruct defines allowed filters for RateDeck queries
+// Each field corresponds to a filterable database column
+type FieldStruct struct {
+	ID       uuid.UUID        `filter:"id"`
+	Name     string           `filter:"name"`
+	PlanType account.PlanType `filter:"plan_type"`
+	Deleted  bool             `filter:"deleted"`
+}
@@ -0,0 +1,25 @@
+package ratedeck
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"monorepo/bin-billing-manager/models/account"
+)
+
+// RateDeck defines a set of destination prices.
+// The rate deck applies to the accounts assigned to it, or to the accounts of its plan type.
+type RateDeck struct {
+	ID uuid.UUID `json:"id" db:"id,uuid"`
+
+	Name   string `json:"name" db:"name"`
+	Detail string `json:"detail" db:"detail"`
+
+	PlanType account.PlanType `json:"plan_type" db:"plan_type"` // the plan type the rate deck applies to by default. empty means the accounts are assigned explicitly
+
+	// timestamp
+	TMCreate *time.Time `json:"tm_create" db:"tm_create"`
+	TMUpdate *time.Time `json:"tm_update" db:"tm_update"`
+	TMDelete *time.Time `json:"tm_delete" db:"tm_delete"`
+}
@@ -0,0 +1,8 @@
+package statement
+
+// list of event types
+const (
+	EventTypeStatementCreated string = "statement_created" // the statement has created
+
+	EventTypeStatementGenerateRequested string = "statement_generate_requested" // the account's statement generation has requested by the daily sweep. internal only
+)
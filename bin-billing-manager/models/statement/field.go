@@ -0,0 +1,37 @@
+package statement
+
+// Field represents statement field for database queries
+type Field string
+
+// List of fields
+const (
+	FieldID         Field = "id"
+	FieldCustomerID Field = "customer_id"
+	FieldAccountID  Field = "account_id"
+	FieldStatus     Field = "status"
+
+	FieldTMPeriodStart Field = "tm_period_start"
+	FieldTMPeriodEnd   Field = "tm_period_end"
+
+	FieldItems           Field = "items"
+	FieldTopDestinations Field = "top_destinations"
+	FieldTopUps          Field = "top_ups"
+
+	FieldTotalUsageToken  Field = "total_usage_token"
+	FieldTotalUsageCredit Field = "total_usage_credit"
+	FieldTotalTopUpToken  Field = "total_top_up_token"
+	FieldTotalTopUpCredit Field = "total_top_up_credit"
+
+	FieldBalanceTokenEnd  Field = "balance_token_end"
+	FieldBalanceCreditEnd Field = "balance_credit_end"
+
+	FieldPDFFileID Field = "pdf_file_id"
+	FieldCSVFileID Field = "csv_file_id"
+
+	FieldTMCreate Field = "tm_create"
+	FieldTMUpdate Field = "tm_update"
+	FieldTMDelete Field = "tm_delete"
+
+	// filter only
+	FieldDeleted Field = "deleted"
+)
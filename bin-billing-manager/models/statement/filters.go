@@ -0,0 +1,15 @@
+package statement
+
+import (
+	"github.com/gofrs/uuid"
+)
+
+// FieldStruct defines allowed filters for Statement queries
+// Each field corresponds to a filterable database column
+type FieldStruct struct {
+	ID         uuid.UUID `filter:"id"`
+	CustomerID uuid.UUID `filter:"customer_id"`
+	AccountID  uuid.UUID `filter:"account_id"`
+	Status     Status    `filter:"status"`
+	Deleted    bool      `filter:"deleted"`
+}
@@ -0,0 +1,105 @@
+package statement
+
+import (
+	"time"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+
+	"github.com/gofrs/uuid"
+
+	"monorepo/bin-billing-manager/models/billing"
+)
+
+// Statement is the account's billing ledger rolled up for a period.
+// The amounts follow the ledger's sign: usage is negative, top-ups are positive.
+type Statement struct {
+	commonidentity.Identity
+
+	AccountID uuid.UUID `json:"account_id" db:"account_id,uuid"`
+	Status    Status    `json:"status" db:"status"`
+
+	// the period is [tm_period_start, tm_period_end) in UTC
+	TMPeriodStart *time.Time `json:"tm_period_start" db:"tm_period_start"`
+	TMPeriodEnd   *time.Time `json:"tm_period_end" db:"tm_period_end"`
+
+	Items           []Item        `json:"items" db:"items,json"`
+	TopDestinations []Destination `json:"top_destinations" db:"top_destinations,json"`
+	TopUps          []TopUp       `json:"top_ups" db:"top_ups,json"`
+
+	TotalUsageToken  int64 `json:"total_usage_token" db:"total_usage_token"`
+	TotalUsageCredit int64 `json:"total_usage_credit" db:"total_usage_credit"`
+	TotalTopUpToken  int64 `json:"total_top_up_token" db:"total_top_up_token"`
+	TotalTopUpCredit int64 `json:"total_top_up_credit" db:"total_top_up_credit"`
+
+	// the account's balances at the end of the period
+	BalanceTokenEnd  int64 `json:"balance_token_end" db:"balance_token_end"`
+	BalanceCreditEnd int64 `json:"balance_credit_end" db:"balance_credit_end"`
+
+	// the storage-manager files of the rendered statement
+	PDFFileID uuid.UUID `json:"pdf_file_id" db:"pdf_file_id,uuid"`
+	CSVFileID uuid.UUID `json:"csv_file_id" db:"csv_file_id,uuid"`
+
+	TMCreate *time.Time `json:"tm_create" db:"tm_create"`
+	TMUpdate *time.Time `json:"tm_update" db:"tm_update"`
+	TMDelete *time.Time `json:"tm_delete" db:"tm_delete"`
+}
+
+// Status defines the statement's generation status.
+type Status string
+
+// list of statuses
+const (
+	StatusProgressing Status = "progressing" // the statement is being generated. claimed by its generator
+	StatusDone        Status = "done"        // the statement has generated
+)
+
+// Item is the period's ledger entries of the same transaction type, reference type and cost type.
+type Item struct {
+	TransactionType billing.TransactionType `json:"transaction_type"`
+	ReferenceType   billing.ReferenceType   `json:"reference_type"`
+	CostType        billing.CostType        `json:"cost_type,omitempty"`
+
+	Count         int   `json:"count"`
+	BillableUnits int   `json:"billable_units"`
+	UsageDuration int   `json:"usage_duration"` // seconds
+	AmountToken   int64 `json:"amount_token"`
+	AmountCredit  int64 `json:"amount_credit"`
+}
+
+// Destination is the period's usage billed by the same rate deck prefix.
+// The empty prefix is the usage billed by the default rate of the cost type.
+type Destination struct {
+	RateID   uuid.UUID        `json:"rate_id"`
+	Prefix   string           `json:"prefix"`
+	CostType billing.CostType `json:"cost_type"`
+
+	Count         int   `json:"count"`
+	UsageDuration int   `json:"usage_duration"` // seconds
+	AmountCredit  int64 `json:"amount_credit"`
+}
+
+// TopUp is a top-up ledger entry of the period.
+type TopUp struct {
+	BillingID     uuid.UUID             `json:"billing_id"`
+	ReferenceType billing.ReferenceType `json:"reference_type"`
+	AmountToken   int64                 `json:"amount_token"`
+	AmountCredit  int64                 `json:"amount_credit"`
+	TMCreate      *time.Time            `json:"tm_create"`
+}
+
+// MaxTopDestinations is the max number of the statement's top destinations.
+const MaxTopDestinations = 10
+
+// GenerateRequest defines the statement generation requested by the daily sweep.
+// The statement is generated by the event's subscriber, so the failed generation is retried.
+type GenerateRequest struct {
+	AccountID     uuid.UUID  `json:"account_id"`
+	TMPeriodStart *time.Time `json:"tm_period_start"`
+}
+
+// PeriodMonth returns the UTC month period containing the given time.
+func PeriodMonth(t time.Time) (time.Time, time.Time) {
+	t = t.UTC()
+	start := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	return start, start.AddDate(0, 1, 0)
+}
@@ -0,0 +1,54 @@
+package statement
+
+import (
+	"testing"
+	"time"
+)
+
+func Test_PeriodMonth(t *testing.T) {
+	tests := []struct {
+		name string
+
+		t time.Time
+
+		expectStart time.Time
+		expectEnd   time.Time
+	}{
+		{
+			name: "middle of the month",
+
+			t: time.Date(2026, 9, 15, 10, 20, 30, 0, time.UTC),
+
+			expectStart: time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC),
+			expectEnd:   time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "december",
+
+			t: time.Date(2026, 12, 31, 23, 59, 59, 0, time.UTC),
+
+			expectStart: time.Date(2026, 12, 1, 0, 0, 0, 0, time.UTC),
+			expectEnd:   time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "non utc time",
+
+			t: time.Date(2026, 10, 1, 5, 0, 0, 0, time.FixedZone("KST", 9*3600)),
+
+			expectStart: time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC),
+			expectEnd:   time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end := PeriodMonth(tt.t)
+			if !start.Equal(tt.expectStart) {
+				t.Errorf("Wrong match. expect: %v, got: %v", tt.expectStart, start)
+			}
+			if !end.Equal(tt.expectEnd) {
+				t.Errorf("Wrong match. expect: %v, got: %v", tt.expectEnd, end)
+			}
+		})
+	}
+}
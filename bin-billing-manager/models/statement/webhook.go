@@ -0,0 +1,82 @@
+package statement
+
+import (
+	"encoding/json"
+	"time"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+
+	"github.com/gofrs/uuid"
+)
+
+// WebhookMessage defines
+type WebhookMessage struct {
+	commonidentity.Identity
+
+	AccountID uuid.UUID `json:"account_id"`
+
+	TMPeriodStart *time.Time `json:"tm_period_start"`
+	TMPeriodEnd   *time.Time `json:"tm_period_end"`
+
+	Items           []Item        `json:"items"`
+	TopDestinations []Destination `json:"top_destinations"`
+	TopUps          []TopUp       `json:"top_ups"`
+
+	TotalUsageToken  int64 `json:"total_usage_token"`
+	TotalUsageCredit int64 `json:"total_usage_credit"`
+	TotalTopUpToken  int64 `json:"total_top_up_token"`
+	TotalTopUpCredit int64 `json:"total_top_up_credit"`
+
+	BalanceTokenEnd  int64 `json:"balance_token_end"`
+	BalanceCreditEnd int64 `json:"balance_credit_end"`
+
+	PDFFileID uuid.UUID `json:"pdf_file_id"`
+	CSVFileID uuid.UUID `json:"csv_file_id"`
+
+	TMCreate *time.Time `json:"tm_create"`
+	TMUpdate *time.Time `json:"tm_update"`
+	TMDelete *time.Time `json:"tm_delete"`
+}
+
+// ConvertWebhookMessage converts to the event
+func (h *Statement) ConvertWebhookMessage() *WebhookMessage {
+	return &WebhookMessage{
+		Identity: h.Identity,
+
+		AccountID: h.AccountID,
+
+		TMPeriodStart: h.TMPeriodStart,
+		TMPeriodEnd:   h.TMPeriodEnd,
+
+		Items:           h.Items,
+		TopDestinations: h.TopDestinations,
+		TopUps:          h.TopUps,
+
+		TotalUsageToken:  h.TotalUsageToken,
+		TotalUsageCredit: h.TotalUsageCredit,
+		TotalTopUpToken:  h.TotalTopUpToken,
+		TotalTopUpCredit: h.TotalTopUpCredit,
+
+		BalanceTokenEnd:  h.BalanceTokenEnd,
+		BalanceCreditEnd: h.BalanceCreditEnd,
+
+		PDFFileID: h.PDFFileID,
+		CSVFileID: h.CSVFileID,
+
+		TMCreate: h.TMCreate,
+		TMUpdate: h.TMUpdate,
+		TMDelete: h.TMDelete,
+	}
+}
+
+// CreateWebhookEvent generate WebhookEvent
+func (h *Statement) CreateWebhookEvent() ([]byte, error) {
+	e := h.ConvertWebhookMessage()
+
+	m, err := json.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
@@ -0,0 +1,71 @@
+package statement
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+
+	"github.com/gofrs/uuid"
+
+	"monorepo/bin-billing-manager/models/billing"
+)
+
+func TestStatement_ConvertWebhookMessage(t *testing.T) {
+	tmPeriodStart := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	tmPeriodEnd := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	s := &Statement{
+		Identity: commonidentity.Identity{
+			ID:         uuid.FromStringOrNil("3e0b35a4-ab9e-11f0-a58b-5bb0cd5b6e0e"),
+			CustomerID: uuid.FromStringOrNil("3e3d7c7e-ab9e-11f0-8a0f-d3a1f7b0c2a4"),
+		},
+		AccountID:     uuid.FromStringOrNil("3e6b2a4c-ab9e-11f0-9e1e-9b7d1e5f2c3a"),
+		TMPeriodStart: &tmPeriodStart,
+		TMPeriodEnd:   &tmPeriodEnd,
+		Items: []Item{
+			{
+				TransactionType: billing.TransactionTypeUsage,
+				ReferenceType:   billing.ReferenceTypeCall,
+				CostType:        billing.CostTypeCallPSTNOutgoing,
+				Count:           2,
+				BillableUnits:   3,
+				UsageDuration:   150,
+				AmountCredit:    -18000,
+			},
+		},
+		TotalUsageCredit: -18000,
+		BalanceCreditEnd: 982000,
+		PDFFileID:        uuid.FromStringOrNil("3e9a1d2e-ab9e-11f0-b3c4-1f2e3d4c5b6a"),
+		CSVFileID:        uuid.FromStringOrNil("3ec8f0a0-ab9e-11f0-8d7e-6f5e4d3c2b1a"),
+	}
+
+	res := s.ConvertWebhookMessage()
+	if res.ID != s.ID || res.AccountID != s.AccountID {
+		t.Errorf("Wrong match. expect: %v, got: %v", s, res)
+	}
+	if !reflect.DeepEqual(res.Items, s.Items) {
+		t.Errorf("Wrong match. expect: %v, got: %v", s.Items, res.Items)
+	}
+	if res.TotalUsageCredit != s.TotalUsageCredit || res.BalanceCreditEnd != s.BalanceCreditEnd {
+		t.Errorf("Wrong match. expect: %v, got: %v", s, res)
+	}
+	if res.PDFFileID != s.PDFFileID || res.CSVFileID != s.CSVFileID {
+		t.Errorf("Wrong match. expect: %v, got: %v", s, res)
+	}
+
+	data, err := s.CreateWebhookEvent()
+	if err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+
+	var tmp WebhookMessage
+	if errUnmarshal := json.Unmarshal(data, &tmp); errUnmarshal != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", errUnmarshal)
+	}
+	if tmp.ID != s.ID {
+		t.Errorf("Wrong match. expect: %v, got: %v", s.ID, tmp.ID)
+	}
+}
@@ -65,6 +65,24 @@ func (h *accountHandler) UpdatePlanType(ctx context.Context, id uuid.UUID, planT
 	return res, nil
 }
 
+// UpdateRateDeckID updates the account's rate deck.
+// uuid.Nil clears the assignment, so the account is billed by the rate deck of its plan type.
+func (h *accountHandler) UpdateRateDeckID(ctx context.Context, id uuid.UUID, rateDeckID uuid.UUID) (*account.Account, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":         "UpdateRateDeckID",
+		"id":           id,
+		"rate_deck_id": rateDeckID,
+	})
+
+	res, err := h.dbUpdateRateDeckID(ctx, id, rateDeckID)
+	if err != nil {
+		log.Errorf("Could not update the account rate deck. err: %v", err)
+		return nil, errors.Wrap(err, "could not update the account rate deck")
+	}
+
+	return res, nil
+}
+
 // UpdatePaymentInfo updates the account's basic info
 func (h *accountHandler) UpdatePaymentInfo(ctx context.Context, id uuid.UUID, paymentType account.PaymentType, paymentMethod account.PaymentMethod) (*account.Account, error) {
 	log := logrus.WithFields(logrus.Fields{
@@ -10,6 +10,7 @@ import (
 
 	"monorepo/bin-billing-manager/models/account"
 	"monorepo/bin-billing-manager/models/billing"
+	"monorepo/bin-billing-manager/models/rate"
 	cerrors "monorepo/bin-common-handler/models/errors"
 	commonoutline "monorepo/bin-common-handler/models/outline"
 	cmcustomer "monorepo/bin-customer-manager/models/customer"
@@ -76,6 +77,25 @@ func (h *accountHandler) IsValidBalance(ctx context.Context, accountID uuid.UUID
 		count = 1
 	}
 
+	// customer's spend limits. the expected cost is the worst case of the billing type.
+	if costTypes := spendCostTypes(billingType); len(a.SpendLimits) > 0 && len(costTypes) > 0 {
+		expectCost, err := h.expectCost(ctx, a, costTypes[0], count)
+		if err != nil {
+			log.Errorf("Could not get the expected cost. err: %v", err)
+			return false, errors.Wrap(err, "could not get the expected cost")
+		}
+
+		valid, err := h.isValidSpendLimits(ctx, a, billingType, expectCost)
+		if err != nil {
+			log.Errorf("Could not validate the spend limits. err: %v", err)
+			return false, errors.Wrap(err, "could not validate the spend limits")
+		}
+		if !valid {
+			return false, nil
+		}
+	}
+
+	var costType billing.CostType
 	switch billingType {
 	case billing.ReferenceTypeCall:
 		// Calls can be VN (TokenFirst) or PSTN (CreditOnly).
@@ -84,48 +104,23 @@ func (h *accountHandler) IsValidBalance(ctx context.Context, accountID uuid.UUID
 			promAccountBalanceCheckTotal.WithLabelValues("valid").Inc()
 			return true, nil
 		}
-		costInfo := billing.GetCostInfo(billing.CostTypeCallPSTNOutgoing)
-		expectCost := costInfo.CreditPerUnit * int64(count)
-		if a.BalanceCredit >= expectCost {
-			promAccountBalanceCheckTotal.WithLabelValues("valid").Inc()
-			return true, nil
-		}
+		costType = billing.CostTypeCallPSTNOutgoing
 
 	case billing.ReferenceTypeSMS:
-		costInfo := billing.GetCostInfo(billing.CostTypeSMS)
-		expectCost := costInfo.CreditPerUnit * int64(count)
-		if a.BalanceCredit >= expectCost {
-			promAccountBalanceCheckTotal.WithLabelValues("valid").Inc()
-			return true, nil
-		}
+		costType = billing.CostTypeSMS
 
 	case billing.ReferenceTypeEmail:
-		costInfo := billing.GetCostInfo(billing.CostTypeEmail)
-		expectCost := costInfo.CreditPerUnit * int64(count)
-		if a.BalanceCredit >= expectCost {
-			promAccountBalanceCheckTotal.WithLabelValues("valid").Inc()
-			return true, nil
-		}
+		costType = billing.CostTypeEmail
 
 	case billing.ReferenceTypeNumber, billing.ReferenceTypeNumberRenew:
-		costInfo := billing.GetCostInfo(billing.CostTypeNumber)
-		expectCost := costInfo.CreditPerUnit * int64(count)
-		if a.BalanceCredit >= expectCost {
-			promAccountBalanceCheckTotal.WithLabelValues("valid").Inc()
-			return true, nil
-		}
+		costType = billing.CostTypeNumber
 
 	case billing.ReferenceTypeRecording:
 		if a.BalanceToken > 0 {
 			promAccountBalanceCheckTotal.WithLabelValues("valid").Inc()
 			return true, nil
 		}
-		costInfo := billing.GetCostInfo(billing.CostTypeRecording)
-		expectCost := costInfo.CreditPerUnit * int64(count)
-		if a.BalanceCredit >= expectCost {
-			promAccountBalanceCheckTotal.WithLabelValues("valid").Inc()
-			return true, nil
-		}
+		costType = billing.CostTypeRecording
 
 	default:
 		log.Errorf("Unsupported billing type. billing_type: %s", billingType)
@@ -136,7 +131,38 @@ func (h *accountHandler) IsValidBalance(ctx context.Context, accountID uuid.UUID
 		)
 	}
 
+	expectCost, err := h.expectCost(ctx, a, costType, count)
+	if err != nil {
+		log.Errorf("Could not get the expected cost. err: %v", err)
+		return false, errors.Wrap(err, "could not get the expected cost")
+	}
+	if a.BalanceCredit >= expectCost {
+		promAccountBalanceCheckTotal.WithLabelValues("valid").Inc()
+		return true, nil
+	}
+
 	log.Infof("The account has not enough balance or tokens. balance_credit: %d, balance_token: %d", a.BalanceCredit, a.BalanceToken)
 	promAccountBalanceCheckTotal.WithLabelValues("invalid").Inc()
 	return false, nil
 }
+
+// expectCost returns the expected credit of the given count of the cost type's units.
+// The rate deck's most expensive rate applied to the account overrides the default rate of the cost type,
+// as the destination is not known yet.
+func (h *accountHandler) expectCost(ctx context.Context, a *account.Account, costType billing.CostType, count int) (int64, error) {
+	costInfo := billing.GetCostInfo(costType)
+
+	if rate.IsRatable(costType) {
+		rt, err := h.rateDeckHandler.GetMaxRate(ctx, a, costType, nil)
+		if err != nil {
+			return 0, errors.Wrap(err, "could not get the rate")
+		}
+
+		if rt != nil {
+			costInfo.CreditPerUnit = rt.CreditPerUnit
+			costInfo.ConnectionFee = rt.ConnectionFee
+		}
+	}
+
+	return (costInfo.CreditPerUnit + costInfo.ConnectionFee) * int64(count), nil
+}
@@ -17,7 +17,9 @@ import (
 
 	"monorepo/bin-billing-manager/models/account"
 	"monorepo/bin-billing-manager/models/billing"
+	"monorepo/bin-billing-manager/models/rate"
 	"monorepo/bin-billing-manager/pkg/dbhandler"
+	"monorepo/bin-billing-manager/pkg/ratedeckhandler"
 )
 
 func Test_IsValidBalanceByCustomerID(t *testing.T) {
@@ -107,12 +109,14 @@ func Test_IsValidBalanceByCustomerID(t *testing.T) {
 			mockDB := dbhandler.NewMockDBHandler(mc)
 			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
 			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockRateDeck := ratedeckhandler.NewMockRateDeckHandler(mc)
 
 			h := accountHandler{
-				utilHandler:   mockUtil,
-				db:            mockDB,
-				notifyHandler: mockNotify,
-				reqHandler:    mockReq,
+				utilHandler:     mockUtil,
+				db:              mockDB,
+				notifyHandler:   mockNotify,
+				reqHandler:      mockReq,
+				rateDeckHandler: mockRateDeck,
 			}
 			ctx := context.Background()
 
@@ -142,6 +146,7 @@ func Test_IsValidBalanceByCustomerID(t *testing.T) {
 
 			// IsValidBalance will call AccountGet again
 			mockDB.EXPECT().AccountGet(ctx, tt.responseCustomer.BillingAccountID).Return(tt.responseAccount, nil)
+			mockRateDeck.EXPECT().GetMaxRate(ctx, tt.responseAccount, gomock.Any(), nil).Return(nil, nil).AnyTimes()
 
 			res, err := h.IsValidBalanceByCustomerID(ctx, tt.customerID, tt.billingType, tt.country, tt.count)
 			if err != nil {
@@ -166,6 +171,7 @@ func Test_IsValidBalance(t *testing.T) {
 		count       int
 
 		responseAccount *account.Account
+		responseRate    *rate.Rate
 		expectRes       bool
 		expectErr       bool
 	}
@@ -237,6 +243,27 @@ func Test_IsValidBalance(t *testing.T) {
 			},
 			expectRes: false,
 		},
+		{
+			name: "insufficient balance for the rate deck's rate",
+
+			accountID:   uuid.FromStringOrNil("1a6e5c40-aebb-11f1-8d2e-6f7a8b9c0d01"),
+			billingType: billing.ReferenceTypeCall,
+			count:       1,
+
+			responseAccount: &account.Account{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("1a6e5c40-aebb-11f1-8d2e-6f7a8b9c0d01"),
+				},
+				BalanceCredit: 50000,
+				RateDeckID:    uuid.FromStringOrNil("1abf6d51-aebb-11f1-9e3f-7a8b9c0d1e01"),
+			},
+			responseRate: &rate.Rate{
+				ID:            uuid.FromStringOrNil("1b107e62-aebb-11f1-af4a-8b9c0d1e2f01"),
+				CreditPerUnit: 40000,
+				ConnectionFee: 20000,
+			},
+			expectRes: false,
+		},
 		{
 			name: "insufficient balance for call",
 
@@ -461,16 +488,19 @@ func Test_IsValidBalance(t *testing.T) {
 			mockDB := dbhandler.NewMockDBHandler(mc)
 			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
 			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockRateDeck := ratedeckhandler.NewMockRateDeckHandler(mc)
 
 			h := accountHandler{
-				utilHandler:   mockUtil,
-				db:            mockDB,
-				notifyHandler: mockNotify,
-				reqHandler:    mockReq,
+				utilHandler:     mockUtil,
+				db:              mockDB,
+				notifyHandler:   mockNotify,
+				reqHandler:      mockReq,
+				rateDeckHandler: mockRateDeck,
 			}
 			ctx := context.Background()
 
 			mockDB.EXPECT().AccountGet(ctx, tt.accountID).Return(tt.responseAccount, nil)
+			mockRateDeck.EXPECT().GetMaxRate(ctx, tt.responseAccount, gomock.Any(), nil).Return(tt.responseRate, nil).AnyTimes()
 
 			res, err := h.IsValidBalance(ctx, tt.accountID, tt.billingType, tt.country, tt.count)
 			if tt.expectErr {
@@ -365,6 +365,32 @@ func (h *accountHandler) dbUpdatePlanType(ctx context.Context, id uuid.UUID, pla
 	return res, nil
 }
 
+// dbUpdateRateDeckID updates the account's rate deck
+func (h *accountHandler) dbUpdateRateDeckID(ctx context.Context, id uuid.UUID, rateDeckID uuid.UUID) (*account.Account, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":         "dbUpdateRateDeckID",
+		"id":           id,
+		"rate_deck_id": rateDeckID,
+	})
+
+	fields := map[account.Field]any{
+		account.FieldRateDeckID: rateDeckID,
+	}
+
+	if errUpdate := h.db.AccountUpdate(ctx, id, fields); errUpdate != nil {
+		log.Errorf("Could not update the account rate deck. err: %v", errUpdate)
+		return nil, errors.Wrap(errUpdate, "could not update the account rate deck")
+	}
+
+	res, err := h.Get(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get updated account. err: %v", err)
+		return nil, errors.Wrap(err, "could not get updated account")
+	}
+
+	return res, nil
+}
+
 // dbUpdatePaymentInfo updates the account's payment info
 func (h *accountHandler) dbUpdatePaymentInfo(ctx context.Context, id uuid.UUID, paymentType account.PaymentType, paymentMethod account.PaymentMethod) (*account.Account, error) {
 	log := logrus.WithFields(logrus.Fields{
@@ -897,6 +897,83 @@ func Test_dbUpdatePlanType(t *testing.T) {
 	}
 }
 
+func Test_dbUpdateRateDeckID(t *testing.T) {
+
+	type test struct {
+		name string
+
+		id         uuid.UUID
+		rateDeckID uuid.UUID
+
+		expectFields     map[account.Field]any
+		responseAccounts *account.Account
+	}
+
+	tests := []test{
+		{
+			name: "assign",
+
+			id:         uuid.FromStringOrNil("0a1b2c3d-ad30-11f0-8f9e-1a2b3c4d5e6f"),
+			rateDeckID: uuid.FromStringOrNil("1b2c3d4e-ad30-11f0-9faf-2b3c4d5e6f7a"),
+
+			expectFields: map[account.Field]any{
+				account.FieldRateDeckID: uuid.FromStringOrNil("1b2c3d4e-ad30-11f0-9faf-2b3c4d5e6f7a"),
+			},
+			responseAccounts: &account.Account{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("0a1b2c3d-ad30-11f0-8f9e-1a2b3c4d5e6f"),
+				},
+				RateDeckID: uuid.FromStringOrNil("1b2c3d4e-ad30-11f0-9faf-2b3c4d5e6f7a"),
+			},
+		},
+		{
+			name: "clear",
+
+			id:         uuid.FromStringOrNil("2c3d4e5f-ad30-11f0-a0b1-3c4d5e6f7a8b"),
+			rateDeckID: uuid.Nil,
+
+			expectFields: map[account.Field]any{
+				account.FieldRateDeckID: uuid.Nil,
+			},
+			responseAccounts: &account.Account{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("2c3d4e5f-ad30-11f0-a0b1-3c4d5e6f7a8b"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+
+			h := accountHandler{
+				utilHandler:   mockUtil,
+				db:            mockDB,
+				notifyHandler: mockNotify,
+			}
+			ctx := context.Background()
+
+			mockDB.EXPECT().AccountUpdate(ctx, tt.id, tt.expectFields).Return(nil)
+			mockDB.EXPECT().AccountGet(ctx, tt.id).Return(tt.responseAccounts, nil)
+
+			res, err := h.dbUpdateRateDeckID(ctx, tt.id, tt.rateDeckID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(tt.responseAccounts, res) == false {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.responseAccounts, res)
+			}
+		})
+	}
+}
+
 func Test_dbUpdatePlanType_get_error(t *testing.T) {
 	mc := gomock.NewController(t)
 	defer mc.Finish()
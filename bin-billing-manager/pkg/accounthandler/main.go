@@ -17,6 +17,7 @@ import (
 	"monorepo/bin-billing-manager/models/billing"
 	"monorepo/bin-billing-manager/pkg/dbhandler"
 	"monorepo/bin-billing-manager/pkg/paddlehandler"
+	"monorepo/bin-billing-manager/pkg/ratedeckhandler"
 )
 
 // AccountHandler define
@@ -34,6 +35,8 @@ type AccountHandler interface {
 	UpdateBasicInfo(ctx context.Context, id uuid.UUID, name string, detail string) (*account.Account, error)
 	UpdatePaymentInfo(ctx context.Context, id uuid.UUID, paymentType account.PaymentType, paymentMethod account.PaymentMethod) (*account.Account, error)
 	UpdatePlanType(ctx context.Context, id uuid.UUID, planType account.PlanType) (*account.Account, error)
+	UpdateRateDeckID(ctx context.Context, id uuid.UUID, rateDeckID uuid.UUID) (*account.Account, error)
+	UpdateSpendingSettings(ctx context.Context, id uuid.UUID, spendLimits []account.SpendLimit, balanceAlertThresholds []int64, autoTopUpThreshold int64, autoTopUpAmount int64) (*account.Account, error)
 	SetStatus(ctx context.Context, id uuid.UUID, status account.Status) (*account.Account, error)
 
 	GetByPaddleSubscriptionID(ctx context.Context, paddleSubscriptionID string) (*account.Account, error)
@@ -55,6 +58,10 @@ type AccountHandler interface {
 	IsValidResourceLimit(ctx context.Context, accountID uuid.UUID, resourceType account.ResourceType) (bool, error)
 	IsValidResourceLimitByCustomerID(ctx context.Context, customerID uuid.UUID, resourceType account.ResourceType) (bool, error)
 
+	CheckBalanceThresholds(ctx context.Context, accountID uuid.UUID, balanceBefore int64, balanceAfter int64) error
+	ChargeAutoTopUp(ctx context.Context, req *account.AutoTopUpRequest) error
+	ClearAutoTopUpPending(ctx context.Context, id uuid.UUID) error
+
 	EventCUCustomerCreated(ctx context.Context, cu *cucustomer.Customer) error
 	EventCUCustomerDeleted(ctx context.Context, cu *cucustomer.Customer) error
 	EventCUCustomerFrozen(ctx context.Context, cu *cucustomer.Customer) error
@@ -65,11 +72,12 @@ type AccountHandler interface {
 
 // accountHandler define
 type accountHandler struct {
-	utilHandler   utilhandler.UtilHandler
-	reqHandler    requesthandler.RequestHandler
-	db            dbhandler.DBHandler
-	notifyHandler notifyhandler.NotifyHandler
-	paddleHandler paddlehandler.PaddleHandler
+	utilHandler     utilhandler.UtilHandler
+	reqHandler      requesthandler.RequestHandler
+	db              dbhandler.DBHandler
+	notifyHandler   notifyhandler.NotifyHandler
+	paddleHandler   paddlehandler.PaddleHandler
+	rateDeckHandler ratedeckhandler.RateDeckHandler
 }
 
 var (
@@ -92,22 +100,50 @@ var (
 		},
 		[]string{"result"},
 	)
+
+	// account_balance_alert_total tracks the low balance alerts sent.
+	promAccountBalanceAlertTotal = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "account_balance_alert_total",
+			Help:      "Total number of low balance alerts sent.",
+		},
+	)
+
+	// account_auto_topup_total tracks the auto top-up charges by result.
+	promAccountAutoTopUpTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "account_auto_topup_total",
+			Help:      "Total number of auto top-up charges by result.",
+		},
+		[]string{"result"},
+	)
 )
 
 func init() {
 	prometheus.MustRegister(
 		promAccountCreateTotal,
 		promAccountBalanceCheckTotal,
+		promAccountBalanceAlertTotal,
+		promAccountAutoTopUpTotal,
 	)
 }
 
 // NewAccountHandler returns a new AccountHandler
-func NewAccountHandler(reqHandler requesthandler.RequestHandler, db dbhandler.DBHandler, notifyHandler notifyhandler.NotifyHandler, paddleHandler paddlehandler.PaddleHandler) AccountHandler {
+func NewAccountHandler(
+	reqHandler requesthandler.RequestHandler,
+	db dbhandler.DBHandler,
+	notifyHandler notifyhandler.NotifyHandler,
+	paddleHandler paddlehandler.PaddleHandler,
+	rateDeckHandler ratedeckhandler.RateDeckHandler,
+) AccountHandler {
 	return &accountHandler{
-		utilHandler:   utilhandler.NewUtilHandler(),
-		reqHandler:    reqHandler,
-		db:            db,
-		notifyHandler: notifyHandler,
-		paddleHandler: paddleHandler,
+		utilHandler:     utilhandler.NewUtilHandler(),
+		reqHandler:      reqHandler,
+		db:              db,
+		notifyHandler:   notifyHandler,
+		paddleHandler:   paddleHandler,
+		rateDeckHandler: rateDeckHandler,
 	}
 }
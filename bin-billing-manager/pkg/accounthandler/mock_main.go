@@ -74,6 +74,48 @@ func (mr *MockAccountHandlerMockRecorder) AddTokens(ctx, accountID, amount any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTokens", reflect.TypeOf((*MockAccountHandler)(nil).AddTokens), ctx, accountID, amount)
 }
 
+// ChargeAutoTopUp mocks base method.
+func (m *MockAccountHandler) ChargeAutoTopUp(ctx context.Context, req *account.AutoTopUpRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChargeAutoTopUp", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChargeAutoTopUp indicates an expected call of ChargeAutoTopUp.
+func (mr *MockAccountHandlerMockRecorder) ChargeAutoTopUp(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChargeAutoTopUp", reflect.TypeOf((*MockAccountHandler)(nil).ChargeAutoTopUp), ctx, req)
+}
+
+// CheckBalanceThresholds mocks base method.
+func (m *MockAccountHandler) CheckBalanceThresholds(ctx context.Context, accountID uuid.UUID, balanceBefore, balanceAfter int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckBalanceThresholds", ctx, accountID, balanceBefore, balanceAfter)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckBalanceThresholds indicates an expected call of CheckBalanceThresholds.
+func (mr *MockAccountHandlerMockRecorder) CheckBalanceThresholds(ctx, accountID, balanceBefore, balanceAfter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckBalanceThresholds", reflect.TypeOf((*MockAccountHandler)(nil).CheckBalanceThresholds), ctx, accountID, balanceBefore, balanceAfter)
+}
+
+// ClearAutoTopUpPending mocks base method.
+func (m *MockAccountHandler) ClearAutoTopUpPending(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearAutoTopUpPending", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearAutoTopUpPending indicates an expected call of ClearAutoTopUpPending.
+func (mr *MockAccountHandlerMockRecorder) ClearAutoTopUpPending(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearAutoTopUpPending", reflect.TypeOf((*MockAccountHandler)(nil).ClearAutoTopUpPending), ctx, id)
+}
+
 // Create mocks base method.
 func (m *MockAccountHandler) Create(ctx context.Context, customerID uuid.UUID, name, detail string, paymentType account.PaymentType, payemntMethod account.PaymentMethod) (*account.Account, error) {
 	m.ctrl.T.Helper()
@@ -528,3 +570,33 @@ func (mr *MockAccountHandlerMockRecorder) UpdatePlanType(ctx, id, planType any)
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePlanType", reflect.TypeOf((*MockAccountHandler)(nil).UpdatePlanType), ctx, id, planType)
 }
+
+// UpdateRateDeckID mocks base method.
+func (m *MockAccountHandler) UpdateRateDeckID(ctx context.Context, id, rateDeckID uuid.UUID) (*account.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRateDeckID", ctx, id, rateDeckID)
+	ret0, _ := ret[0].(*account.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRateDeckID indicates an expected call of UpdateRateDeckID.
+func (mr *MockAccountHandlerMockRecorder) UpdateRateDeckID(ctx, id, rateDeckID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRateDeckID", reflect.TypeOf((*MockAccountHandler)(nil).UpdateRateDeckID), ctx, id, rateDeckID)
+}
+
+// UpdateSpendingSettings mocks base method.
+func (m *MockAccountHandler) UpdateSpendingSettings(ctx context.Context, id uuid.UUID, spendLimits []account.SpendLimit, balanceAlertThresholds []int64, autoTopUpThreshold, autoTopUpAmount int64) (*account.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSpendingSettings", ctx, id, spendLimits, balanceAlertThresholds, autoTopUpThreshold, autoTopUpAmount)
+	ret0, _ := ret[0].(*account.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSpendingSettings indicates an expected call of UpdateSpendingSettings.
+func (mr *MockAccountHandlerMockRecorder) UpdateSpendingSettings(ctx, id, spendLimits, balanceAlertThresholds, autoTopUpThreshold, autoTopUpAmount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSpendingSettings", reflect.TypeOf((*MockAccountHandler)(nil).UpdateSpendingSettings), ctx, id, spendLimits, balanceAlertThresholds, autoTopUpThreshold, autoTopUpAmount)
+}
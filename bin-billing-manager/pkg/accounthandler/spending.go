@@ -0,0 +1,437 @@
+package accounthandler
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	commonaddress "monorepo/bin-common-handler/models/address"
+	cerrors "monorepo/bin-common-handler/models/errors"
+	commonoutline "monorepo/bin-common-handler/models/outline"
+	cmcustomer "monorepo/bin-customer-manager/models/customer"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"monorepo/bin-billing-manager/models/account"
+	"monorepo/bin-billing-manager/models/billing"
+)
+
+// UpdateSpendingSettings updates the account's spend limits, low balance alert thresholds and auto top-up
+func (h *accountHandler) UpdateSpendingSettings(
+	ctx context.Context,
+	id uuid.UUID,
+	spendLimits []account.SpendLimit,
+	balanceAlertThresholds []int64,
+	autoTopUpThreshold int64,
+	autoTopUpAmount int64,
+) (*account.Account, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":                     "UpdateSpendingSettings",
+		"id":                       id,
+		"spend_limits":             spendLimits,
+		"balance_alert_thresholds": balanceAlertThresholds,
+		"auto_topup_threshold":     autoTopUpThreshold,
+		"auto_topup_amount":        autoTopUpAmount,
+	})
+
+	a, err := h.Get(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get account info. err: %v", err)
+		return nil, errors.Wrap(err, "could not get account info")
+	}
+
+	if errValidate := validateSpendLimits(spendLimits); errValidate != nil {
+		return nil, errValidate
+	}
+
+	thresholds, errValidate := normalizeBalanceAlertThresholds(balanceAlertThresholds)
+	if errValidate != nil {
+		return nil, errValidate
+	}
+
+	if errValidate := validateAutoTopUp(a, autoTopUpThreshold, autoTopUpAmount); errValidate != nil {
+		return nil, errValidate
+	}
+
+	fields := map[account.Field]any{
+		account.FieldSpendLimits:            spendLimits,
+		account.FieldBalanceAlertThresholds: thresholds,
+		account.FieldAutoTopUpThreshold:     autoTopUpThreshold,
+		account.FieldAutoTopUpAmount:        autoTopUpAmount,
+	}
+	if errUpdate := h.db.AccountUpdate(ctx, id, fields); errUpdate != nil {
+		log.Errorf("Could not update the account spending settings. err: %v", errUpdate)
+		return nil, errors.Wrap(errUpdate, "could not update the account spending settings")
+	}
+
+	res, err := h.Get(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get updated account. err: %v", err)
+		return nil, errors.Wrap(err, "could not get updated account")
+	}
+	h.notifyHandler.PublishEvent(ctx, account.EventTypeAccountUpdated, res)
+
+	return res, nil
+}
+
+// validateSpendLimits returns an error if the given spend limits are not valid.
+func validateSpendLimits(spendLimits []account.SpendLimit) error {
+	if len(spendLimits) > account.MaxSpendLimits {
+		return cerrors.InvalidArgument(
+			commonoutline.ServiceNameBillingManager,
+			"INVALID_SPEND_LIMIT",
+			fmt.Sprintf("The number of spend limits must be %d or less.", account.MaxSpendLimits),
+		)
+	}
+
+	type key struct {
+		period   account.SpendLimitPeriod
+		costType billing.CostType
+	}
+	seen := map[key]bool{}
+	for _, l := range spendLimits {
+		if !l.Period.IsValid() {
+			return cerrors.InvalidArgument(
+				commonoutline.ServiceNameBillingManager,
+				"INVALID_SPEND_LIMIT",
+				fmt.Sprintf("The spend limit period %q is not valid. Allowed: daily, monthly.", string(l.Period)),
+			)
+		}
+
+		if l.CostType != billing.CostTypeNone && billing.GetCostInfo(l.CostType).Mode == billing.CostModeDisabled {
+			return cerrors.InvalidArgument(
+				commonoutline.ServiceNameBillingManager,
+				"INVALID_SPEND_LIMIT",
+				fmt.Sprintf("The spend limit cost type %q is not valid.", string(l.CostType)),
+			)
+		}
+
+		if l.LimitCredit <= 0 {
+			return cerrors.InvalidArgument(
+				commonoutline.ServiceNameBillingManager,
+				"INVALID_SPEND_LIMIT",
+				"The spend limit's limit_credit must be positive.",
+			)
+		}
+
+		k := key{period: l.Period, costType: l.CostType}
+		if seen[k] {
+			return cerrors.InvalidArgument(
+				commonoutline.ServiceNameBillingManager,
+				"INVALID_SPEND_LIMIT",
+				fmt.Sprintf("The spend limit of the period %q and the cost type %q is duplicated.", string(l.Period), string(l.CostType)),
+			)
+		}
+		seen[k] = true
+	}
+
+	return nil
+}
+
+// normalizeBalanceAlertThresholds returns the sorted and deduplicated thresholds.
+func normalizeBalanceAlertThresholds(thresholds []int64) ([]int64, error) {
+	res := []int64{}
+	for _, t := range thresholds {
+		if t <= 0 {
+			return nil, cerrors.InvalidArgument(
+				commonoutline.ServiceNameBillingManager,
+				"INVALID_BALANCE_ALERT_THRESHOLD",
+				"The balance alert thresholds must be positive.",
+			)
+		}
+
+		if !slices.Contains(res, t) {
+			res = append(res, t)
+		}
+	}
+
+	if len(res) > account.MaxBalanceAlertThresholds {
+		return nil, cerrors.InvalidArgument(
+			commonoutline.ServiceNameBillingManager,
+			"INVALID_BALANCE_ALERT_THRESHOLD",
+			fmt.Sprintf("The number of balance alert thresholds must be %d or less.", account.MaxBalanceAlertThresholds),
+		)
+	}
+	slices.Sort(res)
+
+	return res, nil
+}
+
+// validateAutoTopUp returns an error if the given auto top-up settings are not valid for the account.
+func validateAutoTopUp(a *account.Account, threshold int64, amount int64) error {
+	if threshold < 0 || amount < 0 {
+		return cerrors.InvalidArgument(
+			commonoutline.ServiceNameBillingManager,
+			"INVALID_AUTO_TOPUP",
+			"The auto top-up threshold and amount must not be negative.",
+		)
+	}
+
+	if amount == 0 {
+		// disabled
+		return nil
+	}
+
+	if amount%account.AutoTopUpAmountUnit != 0 {
+		return cerrors.InvalidArgument(
+			commonoutline.ServiceNameBillingManager,
+			"INVALID_AUTO_TOPUP",
+			fmt.Sprintf("The auto top-up amount must be a multiple of %d micros.", account.AutoTopUpAmountUnit),
+		)
+	}
+
+	if a.PaddleSubscriptionID == "" {
+		return cerrors.FailedPrecondition(
+			commonoutline.ServiceNameBillingManager,
+			"PAYMENT_METHOD_REQUIRED",
+			"The auto top-up requires a subscription with a saved payment method.",
+		)
+	}
+
+	return nil
+}
+
+// spendCostTypes returns the cost types which the given billing type might be charged by.
+func spendCostTypes(billingType billing.ReferenceType) []billing.CostType {
+	switch billingType {
+	case billing.ReferenceTypeCall:
+		return []billing.CostType{billing.CostTypeCallPSTNOutgoing, billing.CostTypeCallPSTNIncoming, billing.CostTypeCallVN}
+	case billing.ReferenceTypeSMS:
+		return []billing.CostType{billing.CostTypeSMS}
+	case billing.ReferenceTypeEmail:
+		return []billing.CostType{billing.CostTypeEmail}
+	case billing.ReferenceTypeNumber, billing.ReferenceTypeNumberRenew:
+		return []billing.CostType{billing.CostTypeNumber, billing.CostTypeNumberRenew}
+	case billing.ReferenceTypeRecording:
+		return []billing.CostType{billing.CostTypeRecording}
+	case billing.ReferenceTypeAIcall:
+		return []billing.CostType{billing.CostTypeAIUsage}
+	default:
+		return nil
+	}
+}
+
+// isValidSpendLimits returns false if the expected cost exceeds any of the account's spend limits
+// applied to the given billing type.
+func (h *accountHandler) isValidSpendLimits(ctx context.Context, a *account.Account, billingType billing.ReferenceType, expectCost int64) (bool, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":         "isValidSpendLimits",
+		"account_id":   a.ID,
+		"billing_type": billingType,
+	})
+
+	costTypes := spendCostTypes(billingType)
+	now := h.utilHandler.TimeNow()
+	for _, l := range a.SpendLimits {
+		if l.CostType != billing.CostTypeNone && !slices.Contains(costTypes, l.CostType) {
+			continue
+		}
+
+		spent, err := h.spentCredit(ctx, a.ID, l.CostType, l.Period.Start(*now), *now)
+		if err != nil {
+			log.Errorf("Could not get the spent credit. err: %v", err)
+			return false, errors.Wrap(err, "could not get the spent credit")
+		}
+
+		if spent+expectCost > l.LimitCredit {
+			log.Infof("The account has reached the spend limit. period: %s, cost_type: %s, limit_credit: %d, spent: %d", l.Period, l.CostType, l.LimitCredit, spent)
+			promAccountBalanceCheckTotal.WithLabelValues("spend_limit").Inc()
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// spentCredit returns the credit spent by the account's usages of the cost type started since the given time.
+// The progressing usages are not charged yet, so their credit accrued until now is added.
+func (h *accountHandler) spentCredit(ctx context.Context, accountID uuid.UUID, costType billing.CostType, tmStart time.Time, now time.Time) (int64, error) {
+	res, err := h.db.BillingSumUsageCredit(ctx, accountID, costType, tmStart)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not get the charged credit")
+	}
+
+	progressings, err := h.db.BillingListProgressingUsage(ctx, accountID, costType, tmStart)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not get the progressing usages")
+	}
+
+	for _, b := range progressings {
+		costInfo := b.GetCostInfo()
+		if costInfo.Mode != billing.CostModeCreditOnly || b.TMBillingStart == nil {
+			// the token first usages are charged from the tokens first
+			continue
+		}
+
+		duration := max(int(now.Sub(*b.TMBillingStart).Seconds()), 1)
+		res += costInfo.CalculateDurationCredit(duration)
+	}
+
+	return res, nil
+}
+
+// CheckBalanceThresholds sends the low balance alerts and requests the auto top-up
+// when the account's credit balance has crossed the thresholds by a deduction.
+func (h *accountHandler) CheckBalanceThresholds(ctx context.Context, accountID uuid.UUID, balanceBefore int64, balanceAfter int64) error {
+	log := logrus.WithFields(logrus.Fields{
+		"func":           "CheckBalanceThresholds",
+		"account_id":     accountID,
+		"balance_before": balanceBefore,
+		"balance_after":  balanceAfter,
+	})
+
+	if balanceAfter >= balanceBefore {
+		return nil
+	}
+
+	a, err := h.Get(ctx, accountID)
+	if err != nil {
+		log.Errorf("Could not get account info. err: %v", err)
+		return errors.Wrap(err, "could not get account info")
+	}
+
+	crossed := func(threshold int64) bool {
+		return balanceBefore > threshold && balanceAfter <= threshold
+	}
+
+	// the lowest crossed threshold only. a large deduction crossing several thresholds sends one alert.
+	for _, t := range a.BalanceAlertThresholds {
+		if !crossed(t) {
+			continue
+		}
+
+		h.sendBalanceAlert(ctx, a, t, balanceAfter)
+		break
+	}
+
+	if a.AutoTopUpAmount > 0 && crossed(a.AutoTopUpThreshold) {
+		// the charge is made by the event's subscriber, so the failed charge is retried without re-sending the alerts.
+		req := &account.AutoTopUpRequest{
+			ID:            h.utilHandler.UUIDCreate(),
+			AccountID:     a.ID,
+			Amount:        a.AutoTopUpAmount,
+			BalanceCredit: balanceAfter,
+		}
+		h.notifyHandler.PublishEvent(ctx, account.EventTypeAccountAutoTopUpRequested, req)
+		log.Infof("Requested the auto top-up. account_id: %s, amount: %d", a.ID, a.AutoTopUpAmount)
+	}
+
+	return nil
+}
+
+// ChargeAutoTopUp charges the account's auto top-up amount to the subscription's payment method.
+// It is skipped if the auto top-up has been disabled or the balance is above the auto top-up threshold again.
+// The charged request is kept pending on the account until its webhook arrives, and the other requests
+// are skipped meanwhile, so a balance crossing the threshold again before the credit is added is not charged twice.
+// The returned error makes the request retried. The retry charges with the same idempotency key.
+func (h *accountHandler) ChargeAutoTopUp(ctx context.Context, req *account.AutoTopUpRequest) error {
+	log := logrus.WithFields(logrus.Fields{
+		"func":       "ChargeAutoTopUp",
+		"account_id": req.AccountID,
+		"amount":     req.Amount,
+	})
+
+	a, err := h.Get(ctx, req.AccountID)
+	if err != nil {
+		log.Errorf("Could not get account info. err: %v", err)
+		return errors.Wrap(err, "could not get account info")
+	}
+
+	if a.TMDelete != nil || a.AutoTopUpAmount <= 0 || a.PaddleSubscriptionID == "" {
+		log.Infof("The auto top-up is not enabled anymore. account_id: %s", a.ID)
+		return nil
+	}
+
+	if a.BalanceCredit > a.AutoTopUpThreshold {
+		log.Infof("The balance is above the auto top-up threshold. account_id: %s, balance_credit: %d", a.ID, a.BalanceCredit)
+		return nil
+	}
+
+	if req.ID == uuid.Nil {
+		log.Errorf("The auto top-up request has no id. account_id: %s", a.ID)
+		return nil
+	}
+
+	// the retried request is pending already
+	if a.AutoTopUpPendingID != req.ID {
+		tmExpire := h.utilHandler.TimeNowAdd(-account.AutoTopUpPendingTimeout)
+		pending, errPending := h.db.AccountSetAutoTopUpPending(ctx, a.ID, req.ID, tmExpire)
+		if errPending != nil {
+			log.Errorf("Could not set the pending auto top-up. err: %v", errPending)
+			return errors.Wrap(errPending, "could not set the pending auto top-up")
+		}
+		if !pending {
+			log.Infof("Another auto top-up is pending. account_id: %s, pending_id: %s", a.ID, a.AutoTopUpPendingID)
+			return nil
+		}
+	}
+
+	if errCharge := h.paddleHandler.ChargeSubscription(ctx, a.PaddleSubscriptionID, a.AutoTopUpAmount/account.AutoTopUpAmountUnit, req.ID.String()); errCharge != nil {
+		log.Errorf("Could not charge the auto top-up. err: %v", errCharge)
+		promAccountAutoTopUpTotal.WithLabelValues("failure").Inc()
+		return errors.Wrap(errCharge, "could not charge the auto top-up")
+	}
+	log.Infof("Charged the auto top-up. account_id: %s, amount: %d", a.ID, a.AutoTopUpAmount)
+	promAccountAutoTopUpTotal.WithLabelValues("success").Inc()
+
+	return nil
+}
+
+// ClearAutoTopUpPending clears the account's pending auto top-up once its charge's webhook has arrived,
+// so the next crossing of the auto top-up threshold is charged.
+func (h *accountHandler) ClearAutoTopUpPending(ctx context.Context, id uuid.UUID) error {
+	if errClear := h.db.AccountClearAutoTopUpPending(ctx, id); errClear != nil {
+		return errors.Wrap(errClear, "could not clear the pending auto top-up")
+	}
+
+	return nil
+}
+
+// sendBalanceAlert publishes the low balance alert event and sends the alert email to the customer.
+func (h *accountHandler) sendBalanceAlert(ctx context.Context, a *account.Account, threshold int64, balance int64) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":       "sendBalanceAlert",
+		"account_id": a.ID,
+		"threshold":  threshold,
+	})
+	promAccountBalanceAlertTotal.Inc()
+
+	alert := &account.BalanceAlert{
+		AccountID:     a.ID,
+		CustomerID:    a.CustomerID,
+		Threshold:     threshold,
+		BalanceCredit: balance,
+	}
+	h.notifyHandler.PublishWebhookEvent(ctx, a.CustomerID, account.EventTypeAccountBalanceLow, alert)
+
+	cu, err := h.reqHandler.CustomerV1CustomerGet(ctx, a.CustomerID)
+	if err != nil {
+		log.Errorf("Could not get customer info. err: %v", err)
+		return
+	}
+	if cu.Email == "" {
+		return
+	}
+
+	destinations := []commonaddress.Address{
+		{
+			Type:   commonaddress.TypeEmail,
+			Target: cu.Email,
+		},
+	}
+	subject := "VoIPbin: Your account balance is low"
+	content := fmt.Sprintf(
+		"Your billing account's balance has dropped to $%.2f, below the alert threshold of $%.2f.\n\n"+
+			"Calls and messages are rejected once the balance runs out. Please top up your balance to avoid service interruption.",
+		float64(balance)/1000000,
+		float64(threshold)/1000000,
+	)
+
+	// the system customer sends the alert, so the customer is not charged for it.
+	if _, errSend := h.reqHandler.EmailV1EmailSend(ctx, cmcustomer.IDSystem, uuid.Nil, destinations, subject, content, nil); errSend != nil {
+		log.Errorf("Could not send the balance alert email. err: %v", errSend)
+	}
+}
@@ -0,0 +1,806 @@
+package accounthandler
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	commonaddress "monorepo/bin-common-handler/models/address"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/notifyhandler"
+	"monorepo/bin-common-handler/pkg/requesthandler"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+	cmcustomer "monorepo/bin-customer-manager/models/customer"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+
+	"monorepo/bin-billing-manager/models/account"
+	"monorepo/bin-billing-manager/models/billing"
+	"monorepo/bin-billing-manager/models/rate"
+	"monorepo/bin-billing-manager/pkg/dbhandler"
+	"monorepo/bin-billing-manager/pkg/paddlehandler"
+	"monorepo/bin-billing-manager/pkg/ratedeckhandler"
+)
+
+func Test_UpdateSpendingSettings(t *testing.T) {
+
+	type test struct {
+		name string
+
+		id                     uuid.UUID
+		spendLimits            []account.SpendLimit
+		balanceAlertThresholds []int64
+		autoTopUpThreshold     int64
+		autoTopUpAmount        int64
+
+		responseAccount *account.Account
+
+		expectFields map[account.Field]any
+	}
+
+	tests := []test{
+		{
+			name: "normal",
+
+			id: uuid.FromStringOrNil("7b2e4c10-0b70-11f0-8d1e-3f5a6b7c8d01"),
+			spendLimits: []account.SpendLimit{
+				{
+					Period:      account.SpendLimitPeriodDaily,
+					LimitCredit: 10000000,
+				},
+				{
+					Period:      account.SpendLimitPeriodMonthly,
+					CostType:    billing.CostTypeCallPSTNOutgoing,
+					LimitCredit: 100000000,
+				},
+			},
+			balanceAlertThresholds: []int64{10000000, 5000000, 10000000},
+			autoTopUpThreshold:     2000000,
+			autoTopUpAmount:        20000000,
+
+			responseAccount: &account.Account{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("7b2e4c10-0b70-11f0-8d1e-3f5a6b7c8d01"),
+				},
+				PaddleSubscriptionID: "sub_001",
+			},
+
+			expectFields: map[account.Field]any{
+				account.FieldSpendLimits: []account.SpendLimit{
+					{
+						Period:      account.SpendLimitPeriodDaily,
+						LimitCredit: 10000000,
+					},
+					{
+						Period:      account.SpendLimitPeriodMonthly,
+						CostType:    billing.CostTypeCallPSTNOutgoing,
+						LimitCredit: 100000000,
+					},
+				},
+				account.FieldBalanceAlertThresholds: []int64{5000000, 10000000},
+				account.FieldAutoTopUpThreshold:     int64(2000000),
+				account.FieldAutoTopUpAmount:        int64(20000000),
+			},
+		},
+		{
+			name: "clear all",
+
+			id: uuid.FromStringOrNil("7b5c8e22-0b70-11f0-9a3f-4e6b7c8d9e01"),
+
+			responseAccount: &account.Account{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("7b5c8e22-0b70-11f0-9a3f-4e6b7c8d9e01"),
+				},
+			},
+
+			expectFields: map[account.Field]any{
+				account.FieldSpendLimits:            []account.SpendLimit(nil),
+				account.FieldBalanceAlertThresholds: []int64{},
+				account.FieldAutoTopUpThreshold:     int64(0),
+				account.FieldAutoTopUpAmount:        int64(0),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+
+			h := accountHandler{
+				db:            mockDB,
+				notifyHandler: mockNotify,
+			}
+			ctx := context.Background()
+
+			mockDB.EXPECT().AccountGet(ctx, tt.id).Return(tt.responseAccount, nil)
+			mockDB.EXPECT().AccountUpdate(ctx, tt.id, tt.expectFields).Return(nil)
+			mockDB.EXPECT().AccountGet(ctx, tt.id).Return(tt.responseAccount, nil)
+			mockNotify.EXPECT().PublishEvent(ctx, account.EventTypeAccountUpdated, tt.responseAccount)
+
+			res, err := h.UpdateSpendingSettings(ctx, tt.id, tt.spendLimits, tt.balanceAlertThresholds, tt.autoTopUpThreshold, tt.autoTopUpAmount)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.responseAccount) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.responseAccount, res)
+			}
+		})
+	}
+}
+
+func Test_UpdateSpendingSettings_error(t *testing.T) {
+
+	type test struct {
+		name string
+
+		spendLimits            []account.SpendLimit
+		balanceAlertThresholds []int64
+		autoTopUpThreshold     int64
+		autoTopUpAmount        int64
+
+		responseAccount *account.Account
+	}
+
+	tests := []test{
+		{
+			name: "invalid period",
+
+			spendLimits: []account.SpendLimit{
+				{Period: account.SpendLimitPeriod("weekly"), LimitCredit: 1000000},
+			},
+			responseAccount: &account.Account{},
+		},
+		{
+			name: "disabled cost type",
+
+			spendLimits: []account.SpendLimit{
+				{Period: account.SpendLimitPeriodDaily, CostType: billing.CostType("unknown"), LimitCredit: 1000000},
+			},
+			responseAccount: &account.Account{},
+		},
+		{
+			name: "zero limit credit",
+
+			spendLimits: []account.SpendLimit{
+				{Period: account.SpendLimitPeriodDaily},
+			},
+			responseAccount: &account.Account{},
+		},
+		{
+			name: "duplicated spend limit",
+
+			spendLimits: []account.SpendLimit{
+				{Period: account.SpendLimitPeriodDaily, LimitCredit: 1000000},
+				{Period: account.SpendLimitPeriodDaily, LimitCredit: 2000000},
+			},
+			responseAccount: &account.Account{},
+		},
+		{
+			name: "negative balance alert threshold",
+
+			balanceAlertThresholds: []int64{-1},
+			responseAccount:        &account.Account{},
+		},
+		{
+			name: "too many balance alert thresholds",
+
+			balanceAlertThresholds: []int64{1, 2, 3, 4, 5, 6},
+			responseAccount:        &account.Account{},
+		},
+		{
+			name: "auto top-up amount is not a multiple of cents",
+
+			autoTopUpAmount: 10000001,
+			responseAccount: &account.Account{
+				PaddleSubscriptionID: "sub_001",
+			},
+		},
+		{
+			name: "auto top-up without the subscription",
+
+			autoTopUpAmount: 10000000,
+			responseAccount: &account.Account{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+
+			h := accountHandler{
+				db: mockDB,
+			}
+			ctx := context.Background()
+
+			id := uuid.FromStringOrNil("7b8f1a34-0b70-11f0-b2c4-5f7c8d9e0f01")
+			mockDB.EXPECT().AccountGet(ctx, id).Return(tt.responseAccount, nil)
+
+			if _, err := h.UpdateSpendingSettings(ctx, id, tt.spendLimits, tt.balanceAlertThresholds, tt.autoTopUpThreshold, tt.autoTopUpAmount); err == nil {
+				t.Errorf("Wrong match. expect: error, got: ok")
+			}
+		})
+	}
+}
+
+func Test_IsValidBalance_spendLimits(t *testing.T) {
+
+	type test struct {
+		name string
+
+		billingType billing.ReferenceType
+		count       int
+
+		responseAccount      *account.Account
+		responseRate         *rate.Rate
+		responseSpent        []int64
+		responseProgressings [][]*billing.Billing
+
+		expectCostTypes []billing.CostType
+		expectTmStarts  []time.Time
+		expectRes       bool
+	}
+
+	curTime := time.Date(2024, 3, 15, 13, 20, 10, 0, time.UTC)
+	tmProgressingStart := time.Date(2024, 3, 15, 13, 10, 10, 0, time.UTC)
+
+	tests := []test{
+		{
+			name: "under the limits",
+
+			billingType: billing.ReferenceTypeCall,
+			count:       1,
+
+			responseAccount: &account.Account{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("7bc2a346-0b70-11f0-8e5d-6a8d9e0f1a01"),
+				},
+				BalanceCredit: 100000000,
+				SpendLimits: []account.SpendLimit{
+					{Period: account.SpendLimitPeriodDaily, LimitCredit: 10000000},
+					{Period: account.SpendLimitPeriodMonthly, CostType: billing.CostTypeCallPSTNOutgoing, LimitCredit: 50000000},
+				},
+			},
+			responseSpent:        []int64{5000000, 40000000},
+			responseProgressings: [][]*billing.Billing{{}, {}},
+
+			expectCostTypes: []billing.CostType{billing.CostTypeNone, billing.CostTypeCallPSTNOutgoing},
+			expectTmStarts: []time.Time{
+				time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+			},
+			expectRes: true,
+		},
+		{
+			name: "the expected cost exceeds the daily limit",
+
+			billingType: billing.ReferenceTypeSMS,
+			count:       2,
+
+			responseAccount: &account.Account{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("7bf0c458-0b70-11f0-9f6e-7b9e0f1a2b01"),
+				},
+				BalanceCredit: 100000000,
+				SpendLimits: []account.SpendLimit{
+					{Period: account.SpendLimitPeriodDaily, CostType: billing.CostTypeSMS, LimitCredit: 1000000},
+				},
+			},
+			responseSpent:        []int64{990000},
+			responseProgressings: [][]*billing.Billing{{}},
+
+			expectCostTypes: []billing.CostType{billing.CostTypeSMS},
+			expectTmStarts: []time.Time{
+				time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC),
+			},
+			expectRes: false,
+		},
+		{
+			name: "the progressing usage's accrued credit exceeds the limit",
+
+			billingType: billing.ReferenceTypeCall,
+			count:       1,
+
+			responseAccount: &account.Account{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("2d4f8a10-aeba-11f1-8b1c-3d4e5f6a7b01"),
+				},
+				BalanceCredit: 100000000,
+				SpendLimits: []account.SpendLimit{
+					{Period: account.SpendLimitPeriodDaily, LimitCredit: 1000000},
+				},
+			},
+			responseSpent: []int64{900000},
+			responseProgressings: [][]*billing.Billing{
+				{
+					{
+						CostType:       billing.CostTypeCallPSTNOutgoing,
+						Status:         billing.StatusProgressing,
+						TMBillingStart: &tmProgressingStart,
+					},
+					{
+						// token first usage is charged from the tokens first
+						CostType:       billing.CostTypeCallVN,
+						Status:         billing.StatusProgressing,
+						TMBillingStart: &tmProgressingStart,
+					},
+				},
+			},
+
+			expectCostTypes: []billing.CostType{billing.CostTypeNone},
+			expectTmStarts: []time.Time{
+				time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC),
+			},
+			expectRes: false,
+		},
+		{
+			name: "the rate deck's rate exceeds the limit",
+
+			billingType: billing.ReferenceTypeSMS,
+			count:       1,
+
+			responseAccount: &account.Account{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("2d9c9b21-aeba-11f1-9c2d-4e5f6a7b8c01"),
+				},
+				BalanceCredit: 100000000,
+				SpendLimits: []account.SpendLimit{
+					{Period: account.SpendLimitPeriodDaily, CostType: billing.CostTypeSMS, LimitCredit: 1000000},
+				},
+			},
+			responseRate: &rate.Rate{
+				ID:            uuid.FromStringOrNil("2de9ac32-aeba-11f1-ad3e-5f6a7b8c9d01"),
+				CreditPerUnit: 150000,
+			},
+			responseSpent:        []int64{900000},
+			responseProgressings: [][]*billing.Billing{{}},
+
+			expectCostTypes: []billing.CostType{billing.CostTypeSMS},
+			expectTmStarts: []time.Time{
+				time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC),
+			},
+			expectRes: false,
+		},
+		{
+			name: "the limit of the other cost type is skipped",
+
+			billingType: billing.ReferenceTypeEmail,
+			count:       1,
+
+			responseAccount: &account.Account{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("7c1e5a6a-0b70-11f0-a07f-8c0f1a2b3c01"),
+				},
+				BalanceCredit: 100000000,
+				SpendLimits: []account.SpendLimit{
+					{Period: account.SpendLimitPeriodDaily, CostType: billing.CostTypeSMS, LimitCredit: 1000},
+				},
+			},
+
+			expectRes: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockRateDeck := ratedeckhandler.NewMockRateDeckHandler(mc)
+
+			h := accountHandler{
+				utilHandler:     mockUtil,
+				db:              mockDB,
+				rateDeckHandler: mockRateDeck,
+			}
+			ctx := context.Background()
+
+			mockDB.EXPECT().AccountGet(ctx, tt.responseAccount.ID).Return(tt.responseAccount, nil)
+			mockRateDeck.EXPECT().GetMaxRate(ctx, tt.responseAccount, gomock.Any(), nil).Return(tt.responseRate, nil).AnyTimes()
+			mockUtil.EXPECT().TimeNow().Return(&curTime)
+			for i := range tt.expectCostTypes {
+				mockDB.EXPECT().BillingSumUsageCredit(ctx, tt.responseAccount.ID, tt.expectCostTypes[i], tt.expectTmStarts[i]).Return(tt.responseSpent[i], nil)
+				mockDB.EXPECT().BillingListProgressingUsage(ctx, tt.responseAccount.ID, tt.expectCostTypes[i], tt.expectTmStarts[i]).Return(tt.responseProgressings[i], nil)
+			}
+
+			res, err := h.IsValidBalance(ctx, tt.responseAccount.ID, tt.billingType, "", tt.count)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if res != tt.expectRes {
+				t.Errorf("Wrong match. expect: %v, got: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_CheckBalanceThresholds(t *testing.T) {
+
+	type test struct {
+		name string
+
+		accountID     uuid.UUID
+		balanceBefore int64
+		balanceAfter  int64
+
+		responseAccount  *account.Account
+		responseCustomer *cmcustomer.Customer
+		responseUUID     uuid.UUID
+
+		expectAlert    *account.BalanceAlert
+		expectTopUpReq *account.AutoTopUpRequest
+	}
+
+	tests := []test{
+		{
+			name: "crossed the alert threshold and the auto top-up threshold",
+
+			accountID:     uuid.FromStringOrNil("7c4b6e7c-0b70-11f0-b180-9d1a2b3c4d01"),
+			balanceBefore: 5010000,
+			balanceAfter:  4990000,
+
+			responseAccount: &account.Account{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("7c4b6e7c-0b70-11f0-b180-9d1a2b3c4d01"),
+					CustomerID: uuid.FromStringOrNil("7c79828e-0b70-11f0-8291-ae2b3c4d5e01"),
+				},
+				BalanceAlertThresholds: []int64{5000000, 10000000},
+				AutoTopUpThreshold:     5000000,
+				AutoTopUpAmount:        20000000,
+				PaddleSubscriptionID:   "sub_001",
+			},
+			responseCustomer: &cmcustomer.Customer{
+				ID:    uuid.FromStringOrNil("7c79828e-0b70-11f0-8291-ae2b3c4d5e01"),
+				Email: "test@voipbin.net",
+			},
+			responseUUID: uuid.FromStringOrNil("2ea1c8f4-ab70-11f0-9b52-d5b7c236f301"),
+
+			expectAlert: &account.BalanceAlert{
+				AccountID:     uuid.FromStringOrNil("7c4b6e7c-0b70-11f0-b180-9d1a2b3c4d01"),
+				CustomerID:    uuid.FromStringOrNil("7c79828e-0b70-11f0-8291-ae2b3c4d5e01"),
+				Threshold:     5000000,
+				BalanceCredit: 4990000,
+			},
+			expectTopUpReq: &account.AutoTopUpRequest{
+				ID:            uuid.FromStringOrNil("2ea1c8f4-ab70-11f0-9b52-d5b7c236f301"),
+				AccountID:     uuid.FromStringOrNil("7c4b6e7c-0b70-11f0-b180-9d1a2b3c4d01"),
+				Amount:        20000000,
+				BalanceCredit: 4990000,
+			},
+		},
+		{
+			name: "crossed several alert thresholds alerts the lowest one",
+
+			accountID:     uuid.FromStringOrNil("7ca6a4a0-0b70-11f0-93a2-bf3c4d5e6f01"),
+			balanceBefore: 20000000,
+			balanceAfter:  1000000,
+
+			responseAccount: &account.Account{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("7ca6a4a0-0b70-11f0-93a2-bf3c4d5e6f01"),
+					CustomerID: uuid.FromStringOrNil("7cd3c6b2-0b70-11f0-a4b3-c04d5e6f7a01"),
+				},
+				BalanceAlertThresholds: []int64{5000000, 10000000},
+			},
+			responseCustomer: &cmcustomer.Customer{
+				ID: uuid.FromStringOrNil("7cd3c6b2-0b70-11f0-a4b3-c04d5e6f7a01"),
+			},
+
+			expectAlert: &account.BalanceAlert{
+				AccountID:     uuid.FromStringOrNil("7ca6a4a0-0b70-11f0-93a2-bf3c4d5e6f01"),
+				CustomerID:    uuid.FromStringOrNil("7cd3c6b2-0b70-11f0-a4b3-c04d5e6f7a01"),
+				Threshold:     5000000,
+				BalanceCredit: 1000000,
+			},
+		},
+		{
+			name: "already below the thresholds",
+
+			accountID:     uuid.FromStringOrNil("7d00e8c4-0b70-11f0-b5c4-d15e6f7a8b01"),
+			balanceBefore: 4000000,
+			balanceAfter:  3000000,
+
+			responseAccount: &account.Account{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("7d00e8c4-0b70-11f0-b5c4-d15e6f7a8b01"),
+				},
+				BalanceAlertThresholds: []int64{5000000},
+				AutoTopUpThreshold:     5000000,
+				AutoTopUpAmount:        20000000,
+				PaddleSubscriptionID:   "sub_002",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+
+			h := accountHandler{
+				utilHandler:   mockUtil,
+				db:            mockDB,
+				notifyHandler: mockNotify,
+				reqHandler:    mockReq,
+			}
+			ctx := context.Background()
+
+			mockDB.EXPECT().AccountGet(ctx, tt.accountID).Return(tt.responseAccount, nil)
+			if tt.expectAlert != nil {
+				mockNotify.EXPECT().PublishWebhookEvent(ctx, tt.responseAccount.CustomerID, account.EventTypeAccountBalanceLow, tt.expectAlert)
+				mockReq.EXPECT().CustomerV1CustomerGet(ctx, tt.responseAccount.CustomerID).Return(tt.responseCustomer, nil)
+				if tt.responseCustomer.Email != "" {
+					destinations := []commonaddress.Address{
+						{
+							Type:   commonaddress.TypeEmail,
+							Target: tt.responseCustomer.Email,
+						},
+					}
+					mockReq.EXPECT().EmailV1EmailSend(ctx, cmcustomer.IDSystem, uuid.Nil, destinations, gomock.Any(), gomock.Any(), nil).Return(nil, nil)
+				}
+			}
+			if tt.expectTopUpReq != nil {
+				mockUtil.EXPECT().UUIDCreate().Return(tt.responseUUID)
+				mockNotify.EXPECT().PublishEvent(ctx, account.EventTypeAccountAutoTopUpRequested, tt.expectTopUpReq)
+			}
+
+			if err := h.CheckBalanceThresholds(ctx, tt.accountID, tt.balanceBefore, tt.balanceAfter); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+		})
+	}
+}
+
+func Test_ChargeAutoTopUp(t *testing.T) {
+
+	type test struct {
+		name string
+
+		req *account.AutoTopUpRequest
+
+		responseAccount  *account.Account
+		responseTMExpire *time.Time
+		responsePending  bool
+
+		expectSetPending   bool
+		expectChargeAmount int64
+	}
+
+	tmExpire := time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)
+
+	tests := []test{
+		{
+			name: "normal",
+
+			req: &account.AutoTopUpRequest{
+				ID:            uuid.FromStringOrNil("2ed4e9dc-ab70-11f0-8a63-e6c8d347f401"),
+				AccountID:     uuid.FromStringOrNil("7d2e0ad6-0b70-11f0-86d5-e26f7a8b9c01"),
+				Amount:        10000000,
+				BalanceCredit: 5000000,
+			},
+
+			responseAccount: &account.Account{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("7d2e0ad6-0b70-11f0-86d5-e26f7a8b9c01"),
+				},
+				BalanceCredit:        5000000,
+				AutoTopUpThreshold:   5000000,
+				AutoTopUpAmount:      10000000,
+				PaddleSubscriptionID: "sub_003",
+			},
+			responseTMExpire: &tmExpire,
+			responsePending:  true,
+
+			expectSetPending:   true,
+			expectChargeAmount: 1000,
+		},
+		{
+			name: "another auto top-up is pending",
+
+			req: &account.AutoTopUpRequest{
+				ID:        uuid.FromStringOrNil("2f080ac4-ab70-11f0-b974-f7d9e458f501"),
+				AccountID: uuid.FromStringOrNil("2f3b2bac-ab70-11f0-a285-08eaf569f601"),
+				Amount:    10000000,
+			},
+
+			responseAccount: &account.Account{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("2f3b2bac-ab70-11f0-a285-08eaf569f601"),
+				},
+				BalanceCredit:        4000000,
+				AutoTopUpThreshold:   5000000,
+				AutoTopUpAmount:      10000000,
+				AutoTopUpPendingID:   uuid.FromStringOrNil("2f6e4c94-ab70-11f0-9396-19fb067af701"),
+				PaddleSubscriptionID: "sub_007",
+			},
+			responseTMExpire: &tmExpire,
+			responsePending:  false,
+
+			expectSetPending: true,
+		},
+		{
+			name: "retry of the pending auto top-up",
+
+			req: &account.AutoTopUpRequest{
+				ID:        uuid.FromStringOrNil("2fa16d7c-ab70-11f0-84a7-2a0c178bf801"),
+				AccountID: uuid.FromStringOrNil("2fd48e64-ab70-11f0-b5b8-3b1d289cf901"),
+				Amount:    10000000,
+			},
+
+			responseAccount: &account.Account{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("2fd48e64-ab70-11f0-b5b8-3b1d289cf901"),
+				},
+				BalanceCredit:        4000000,
+				AutoTopUpThreshold:   5000000,
+				AutoTopUpAmount:      10000000,
+				AutoTopUpPendingID:   uuid.FromStringOrNil("2fa16d7c-ab70-11f0-84a7-2a0c178bf801"),
+				PaddleSubscriptionID: "sub_008",
+			},
+
+			expectChargeAmount: 1000,
+		},
+		{
+			name: "request without id",
+
+			req: &account.AutoTopUpRequest{
+				AccountID: uuid.FromStringOrNil("3007af4c-ab70-11f0-a6c9-4c2e39adfa01"),
+				Amount:    10000000,
+			},
+
+			responseAccount: &account.Account{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("3007af4c-ab70-11f0-a6c9-4c2e39adfa01"),
+				},
+				BalanceCredit:        4000000,
+				AutoTopUpThreshold:   5000000,
+				AutoTopUpAmount:      10000000,
+				PaddleSubscriptionID: "sub_009",
+			},
+		},
+		{
+			name: "balance is above the threshold again",
+
+			req: &account.AutoTopUpRequest{
+				ID:        uuid.FromStringOrNil("303ad034-ab70-11f0-97da-5d3f4abefb01"),
+				AccountID: uuid.FromStringOrNil("7d5b2be8-0b70-11f0-97e6-f37a8b9c0d01"),
+				Amount:    10000000,
+			},
+
+			responseAccount: &account.Account{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("7d5b2be8-0b70-11f0-97e6-f37a8b9c0d01"),
+				},
+				BalanceCredit:        15000000,
+				AutoTopUpThreshold:   5000000,
+				AutoTopUpAmount:      10000000,
+				PaddleSubscriptionID: "sub_004",
+			},
+		},
+		{
+			name: "auto top-up has been disabled",
+
+			req: &account.AutoTopUpRequest{
+				ID:        uuid.FromStringOrNil("306df11c-ab70-11f0-88eb-6e405bcffc01"),
+				AccountID: uuid.FromStringOrNil("7d884cfa-0b70-11f0-a8f7-048b9c0d1e01"),
+				Amount:    10000000,
+			},
+
+			responseAccount: &account.Account{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("7d884cfa-0b70-11f0-a8f7-048b9c0d1e01"),
+				},
+				PaddleSubscriptionID: "sub_005",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockPaddle := paddlehandler.NewMockPaddleHandler(mc)
+
+			h := accountHandler{
+				utilHandler:   mockUtil,
+				db:            mockDB,
+				paddleHandler: mockPaddle,
+			}
+			ctx := context.Background()
+
+			mockDB.EXPECT().AccountGet(ctx, tt.req.AccountID).Return(tt.responseAccount, nil)
+			if tt.expectSetPending {
+				mockUtil.EXPECT().TimeNowAdd(-account.AutoTopUpPendingTimeout).Return(tt.responseTMExpire)
+				mockDB.EXPECT().AccountSetAutoTopUpPending(ctx, tt.responseAccount.ID, tt.req.ID, tt.responseTMExpire).Return(tt.responsePending, nil)
+			}
+			if tt.expectChargeAmount > 0 {
+				mockPaddle.EXPECT().ChargeSubscription(ctx, tt.responseAccount.PaddleSubscriptionID, tt.expectChargeAmount, tt.req.ID.String()).Return(nil)
+			}
+
+			if err := h.ChargeAutoTopUp(ctx, tt.req); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+		})
+	}
+}
+
+func Test_ChargeAutoTopUp_chargeError(t *testing.T) {
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockUtil := utilhandler.NewMockUtilHandler(mc)
+	mockDB := dbhandler.NewMockDBHandler(mc)
+	mockPaddle := paddlehandler.NewMockPaddleHandler(mc)
+
+	h := accountHandler{
+		utilHandler:   mockUtil,
+		db:            mockDB,
+		paddleHandler: mockPaddle,
+	}
+	ctx := context.Background()
+
+	a := &account.Account{
+		Identity: commonidentity.Identity{
+			ID: uuid.FromStringOrNil("7db56e0c-0b70-11f0-b908-159c0d1e2f01"),
+		},
+		BalanceCredit:        5000000,
+		AutoTopUpThreshold:   5000000,
+		AutoTopUpAmount:      10000000,
+		PaddleSubscriptionID: "sub_006",
+	}
+	req := &account.AutoTopUpRequest{
+		ID:        uuid.FromStringOrNil("30a11204-ab70-11f0-a0fc-7f516cd0fd01"),
+		AccountID: a.ID,
+		Amount:    a.AutoTopUpAmount,
+	}
+	tmExpire := time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)
+
+	mockDB.EXPECT().AccountGet(ctx, a.ID).Return(a, nil)
+	mockUtil.EXPECT().TimeNowAdd(-account.AutoTopUpPendingTimeout).Return(&tmExpire)
+	mockDB.EXPECT().AccountSetAutoTopUpPending(ctx, a.ID, req.ID, &tmExpire).Return(true, nil)
+	mockPaddle.EXPECT().ChargeSubscription(ctx, "sub_006", int64(1000), req.ID.String()).Return(fmt.Errorf("paddle API returned status 400"))
+
+	if err := h.ChargeAutoTopUp(ctx, req); err == nil {
+		t.Errorf("Wrong match. expect: error, got: ok")
+	}
+}
+
+func Test_ClearAutoTopUpPending(t *testing.T) {
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockDB := dbhandler.NewMockDBHandler(mc)
+
+	h := accountHandler{
+		db: mockDB,
+	}
+	ctx := context.Background()
+
+	id := uuid.FromStringOrNil("30d432ec-ab70-11f0-b20d-806270e1fe01")
+	mockDB.EXPECT().AccountClearAutoTopUpPending(ctx, id).Return(nil)
+
+	if err := h.ClearAutoTopUpPending(ctx, id); err != nil {
+		t.Errorf("Wrong match. expect: ok, got: %v", err)
+	}
+}
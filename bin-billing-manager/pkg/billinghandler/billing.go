@@ -16,6 +16,7 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"monorepo/bin-billing-manager/models/billing"
+	"monorepo/bin-billing-manager/models/rate"
 	"monorepo/bin-billing-manager/pkg/dbhandler"
 )
 
@@ -79,6 +80,9 @@ func (h *billingHandler) BillingStart(
 	switch referenceType {
 	case billing.ReferenceTypeCall, billing.ReferenceTypeCallExtension, billing.ReferenceTypeSpeaking, billing.ReferenceTypeRecording:
 		flagEnd = false
+	case billing.ReferenceTypeAIcall, billing.ReferenceTypeAIAssist:
+		// the ai usage is known only after the aicall or aiassist is terminated. see aiUsageCharge.
+		flagEnd = false
 	case billing.ReferenceTypeSMS, billing.ReferenceTypeEmail:
 		flagEnd = true
 	case billing.ReferenceTypeNumber, billing.ReferenceTypeNumberRenew:
@@ -95,7 +99,18 @@ func (h *billingHandler) BillingStart(
 		)
 	}
 
-	tmp, err := h.Create(ctx, a.CustomerID, a.ID, referenceType, referenceID, costType, tmBillingStart)
+	// the rate deck's rate of the destination. the default rate applies only if no rate deck's rate applies.
+	// the failed lookup fails the billing start, so the event is retried instead of billed by the wrong rate.
+	var rt *rate.Rate
+	if rate.IsRatable(costType) {
+		rt, err = h.rateDeckHandler.GetRate(ctx, a, costType, destination, tmBillingStart)
+		if err != nil {
+			log.Errorf("Could not get the rate. err: %v", err)
+			return errors.Wrap(err, "could not get the rate")
+		}
+	}
+
+	tmp, err := h.Create(ctx, a.CustomerID, a.ID, referenceType, referenceID, costType, rt, tmBillingStart)
 	if err != nil {
 		log.Errorf("Could not create a billing. err: %v", err)
 		return errors.Wrap(err, "could not create a billing")
@@ -131,7 +146,7 @@ func (h *billingHandler) BillingEnd(
 
 	// Extension calls are free — no billing needed
 	if bill.CostType == billing.CostTypeCallExtension || bill.CostType == billing.CostTypeCallDirectExt {
-		// Just mark as end with zero costs
+		// Just mark as end with zero costs. The billing_updated event is stored in the outbox with it.
 		if err := h.db.BillingSetStatusEnd(ctx, bill.ID, 0, 0, 0, 0, 0, 0, tmBillingEnd); err != nil {
 			log.Errorf("Could not set status to end for free call. err: %v", err)
 			return fmt.Errorf("could not end free billing. err: %v", err)
@@ -147,7 +162,6 @@ func (h *billingHandler) BillingEnd(
 		if res.TMBillingStart != nil && res.TMBillingEnd != nil {
 			promBillingDurationSeconds.WithLabelValues(string(res.ReferenceType)).Observe(res.TMBillingEnd.Sub(*res.TMBillingStart).Seconds())
 		}
-		h.notifyHandler.PublishEvent(ctx, billing.EventTypeBillingUpdated, res)
 		return nil
 	}
 
@@ -158,7 +172,7 @@ func (h *billingHandler) BillingEnd(
 	case billing.ReferenceTypeCall, billing.ReferenceTypeCallExtension, billing.ReferenceTypeSpeaking, billing.ReferenceTypeRecording:
 		if bill.TMBillingStart != nil && tmBillingEnd != nil {
 			usageDuration = int(tmBillingEnd.Sub(*bill.TMBillingStart).Seconds())
-			billableUnits = billing.CalculateBillableUnits(usageDuration)
+			billableUnits = bill.GetCostInfo().CalculateBillableUnits(usageDuration)
 		}
 	case billing.ReferenceTypeSMS, billing.ReferenceTypeEmail, billing.ReferenceTypeNumber, billing.ReferenceTypeNumberRenew:
 		usageDuration = 0
@@ -175,8 +189,27 @@ func (h *billingHandler) BillingEnd(
 		)
 	}
 
+	return h.billingConsume(ctx, bill, billableUnits, usageDuration, tmBillingEnd)
+}
+
+// billingConsume consumes the given billable units from the billing's account
+// and records the billing as ended using BillingConsumeAndRecord.
+func (h *billingHandler) billingConsume(
+	ctx context.Context,
+	bill *billing.Billing,
+	billableUnits int,
+	usageDuration int,
+	tmBillingEnd *time.Time,
+) error {
+	log := logrus.WithFields(logrus.Fields{
+		"func":           "billingConsume",
+		"id":             bill.ID,
+		"billable_units": billableUnits,
+		"tm_billing_end": tmBillingEnd,
+	})
+
 	// Use atomic consume-and-record transaction
-	costInfo := billing.GetCostInfo(bill.CostType)
+	costInfo := bill.GetCostInfo()
 	res, err := h.db.BillingConsumeAndRecord(ctx, bill, bill.AccountID, billableUnits, usageDuration, costInfo, tmBillingEnd)
 	if err != nil {
 		log.Errorf("Could not consume and record billing. err: %v", err)
@@ -184,11 +217,59 @@ func (h *billingHandler) BillingEnd(
 	}
 	log.WithField("billing", res).Debugf("Billing consumed and recorded. billing_id: %s", res.ID)
 
+	h.billingConsumed(ctx, res)
+
+	return nil
+}
+
+// billingConsumeCredit consumes the given credit calculated by the caller from the billing's account
+// and records the billing as ended using BillingConsumeCreditAndRecord.
+func (h *billingHandler) billingConsumeCredit(
+	ctx context.Context,
+	bill *billing.Billing,
+	billableUnits int,
+	usageDuration int,
+	credit int64,
+	tmBillingEnd *time.Time,
+) error {
+	log := logrus.WithFields(logrus.Fields{
+		"func":           "billingConsumeCredit",
+		"id":             bill.ID,
+		"billable_units": billableUnits,
+		"credit":         credit,
+		"tm_billing_end": tmBillingEnd,
+	})
+
+	res, err := h.db.BillingConsumeCreditAndRecord(ctx, bill, bill.AccountID, billableUnits, usageDuration, credit, tmBillingEnd)
+	if err != nil {
+		log.Errorf("Could not consume and record billing. err: %v", err)
+		return fmt.Errorf("could not consume and record billing. err: %v", err)
+	}
+	log.WithField("billing", res).Debugf("Billing consumed and recorded. billing_id: %s", res.ID)
+
+	h.billingConsumed(ctx, res)
+
+	return nil
+}
+
+// billingConsumed updates the metrics and checks the balance thresholds of the consumed billing.
+func (h *billingHandler) billingConsumed(ctx context.Context, res *billing.Billing) {
+	log := logrus.WithFields(logrus.Fields{
+		"func": "billingConsumed",
+		"id":   res.ID,
+	})
+
 	promBillingEndTotal.WithLabelValues(string(res.ReferenceType)).Inc()
 	if res.TMBillingStart != nil && res.TMBillingEnd != nil {
 		promBillingDurationSeconds.WithLabelValues(string(res.ReferenceType)).Observe(res.TMBillingEnd.Sub(*res.TMBillingStart).Seconds())
 	}
-	h.notifyHandler.PublishEvent(ctx, billing.EventTypeBillingUpdated, res)
+	// the billing_updated event was stored in the outbox together with the ledger entry. the outbox relay publishes it.
 
-	return nil
+	// low balance alerts and auto top-up. the billing is already recorded, so the failure is logged only.
+	if res.AmountCredit < 0 {
+		balanceBefore := res.BalanceCreditSnapshot - res.AmountCredit
+		if errCheck := h.accountHandler.CheckBalanceThresholds(ctx, res.AccountID, balanceBefore, res.BalanceCreditSnapshot); errCheck != nil {
+			log.Errorf("Could not check the balance thresholds. err: %v", errCheck)
+		}
+	}
 }
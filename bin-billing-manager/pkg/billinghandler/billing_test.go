@@ -17,8 +17,10 @@ import (
 
 	"monorepo/bin-billing-manager/models/account"
 	"monorepo/bin-billing-manager/models/billing"
+	"monorepo/bin-billing-manager/models/rate"
 	"monorepo/bin-billing-manager/pkg/accounthandler"
 	"monorepo/bin-billing-manager/pkg/dbhandler"
+	"monorepo/bin-billing-manager/pkg/ratedeckhandler"
 )
 
 func Test_BillingStart(t *testing.T) {
@@ -147,12 +149,14 @@ func Test_BillingStart(t *testing.T) {
 			mockDB := dbhandler.NewMockDBHandler(mc)
 			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
 			mockAccount := accounthandler.NewMockAccountHandler(mc)
+			mockRateDeck := ratedeckhandler.NewMockRateDeckHandler(mc)
 
 			h := billingHandler{
-				utilHandler:    mockUtil,
-				db:             mockDB,
-				notifyHandler:  mockNotify,
-				accountHandler: mockAccount,
+				utilHandler:     mockUtil,
+				db:              mockDB,
+				notifyHandler:   mockNotify,
+				accountHandler:  mockAccount,
+				rateDeckHandler: mockRateDeck,
 			}
 			ctx := context.Background()
 
@@ -160,6 +164,9 @@ func Test_BillingStart(t *testing.T) {
 			mockDB.EXPECT().BillingGetByReferenceTypeAndID(ctx, tt.referenceType, tt.referenceID).Return(nil, dbhandler.ErrNotFound)
 
 			mockAccount.EXPECT().GetByCustomerID(ctx, tt.customerID).Return(tt.responseAccount, nil)
+			if rate.IsRatable(tt.costType) {
+				mockRateDeck.EXPECT().GetRate(ctx, tt.responseAccount, tt.costType, tt.destination, tt.tmBillingStart).Return(nil, nil)
+			}
 			mockUtil.EXPECT().UUIDCreate().Return(tt.responseUUID)
 			mockDB.EXPECT().BillingCreate(ctx, tt.expectBilling).Return(nil)
 			mockDB.EXPECT().BillingGet(ctx, tt.responseUUID).Return(tt.responseBilling, nil)
@@ -260,12 +267,14 @@ func Test_BillingStart_number_sms(t *testing.T) {
 			mockDB := dbhandler.NewMockDBHandler(mc)
 			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
 			mockAccount := accounthandler.NewMockAccountHandler(mc)
+			mockRateDeck := ratedeckhandler.NewMockRateDeckHandler(mc)
 
 			h := billingHandler{
-				utilHandler:    mockUtil,
-				db:             mockDB,
-				notifyHandler:  mockNotify,
-				accountHandler: mockAccount,
+				utilHandler:     mockUtil,
+				db:              mockDB,
+				notifyHandler:   mockNotify,
+				accountHandler:  mockAccount,
+				rateDeckHandler: mockRateDeck,
 			}
 			ctx := context.Background()
 
@@ -273,6 +282,7 @@ func Test_BillingStart_number_sms(t *testing.T) {
 			mockDB.EXPECT().BillingGetByReferenceTypeAndID(ctx, tt.referenceType, tt.referenceID).Return(nil, dbhandler.ErrNotFound)
 
 			mockAccount.EXPECT().GetByCustomerID(ctx, tt.customerID).Return(tt.responseAccount, nil)
+			mockRateDeck.EXPECT().GetRate(ctx, tt.responseAccount, tt.costType, tt.destination, tt.tmBillingStart).Return(nil, nil)
 			mockUtil.EXPECT().UUIDCreate().Return(tt.responseUUID)
 			mockDB.EXPECT().BillingCreate(ctx, tt.expectBilling).Return(nil)
 			mockDB.EXPECT().BillingGet(ctx, tt.responseUUID).Return(tt.responseBilling, nil)
@@ -280,7 +290,6 @@ func Test_BillingStart_number_sms(t *testing.T) {
 
 			// billing end - SMS uses BillingConsumeAndRecord atomically
 			mockDB.EXPECT().BillingConsumeAndRecord(ctx, tt.responseBilling, tt.responseBilling.AccountID, 1, 0, billing.GetCostInfo(tt.responseBilling.CostType), tt.tmBillingStart).Return(tt.responseBilling, nil)
-			mockNotify.EXPECT().PublishEvent(ctx, billing.EventTypeBillingUpdated, tt.responseBilling)
 
 			if err := h.BillingStart(ctx, tt.customerID, tt.referenceType, tt.referenceID, tt.costType, tt.tmBillingStart, tt.source, tt.destination); err != nil {
 				t.Errorf("Wrong match. expect: ok, got: %v", err)
@@ -364,12 +373,14 @@ func Test_BillingEnd(t *testing.T) {
 					ID:         uuid.FromStringOrNil("9dc58620-16ae-11ee-8e68-639b889e0538"),
 					CustomerID: uuid.FromStringOrNil("9de95a50-16ae-11ee-a50f-dfdbce035244"),
 				},
-				AccountID:         uuid.FromStringOrNil("9e128c36-16ae-11ee-9655-2f9b21f8f7ba"),
-				ReferenceType:     billing.ReferenceTypeSMS,
-				CostType:          billing.CostTypeSMS,
-				RateTokenPerUnit:  0,
-				RateCreditPerUnit: billing.DefaultCreditPerUnitSMS,
-				TMBillingStart:    &tmBillingStart,
+				AccountID:             uuid.FromStringOrNil("9e128c36-16ae-11ee-9655-2f9b21f8f7ba"),
+				ReferenceType:         billing.ReferenceTypeSMS,
+				CostType:              billing.CostTypeSMS,
+				RateTokenPerUnit:      0,
+				RateCreditPerUnit:     billing.DefaultCreditPerUnitSMS,
+				AmountCredit:          -billing.DefaultCreditPerUnitSMS,
+				BalanceCreditSnapshot: 990000,
+				TMBillingStart:        &tmBillingStart,
 			},
 		},
 	}
@@ -383,12 +394,14 @@ func Test_BillingEnd(t *testing.T) {
 			mockDB := dbhandler.NewMockDBHandler(mc)
 			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
 			mockAccount := accounthandler.NewMockAccountHandler(mc)
+			mockRateDeck := ratedeckhandler.NewMockRateDeckHandler(mc)
 
 			h := billingHandler{
-				utilHandler:    mockUtil,
-				db:             mockDB,
-				notifyHandler:  mockNotify,
-				accountHandler: mockAccount,
+				utilHandler:     mockUtil,
+				db:              mockDB,
+				notifyHandler:   mockNotify,
+				accountHandler:  mockAccount,
+				rateDeckHandler: mockRateDeck,
 			}
 			ctx := context.Background()
 
@@ -398,14 +411,16 @@ func Test_BillingEnd(t *testing.T) {
 			switch tt.billing.ReferenceType {
 			case billing.ReferenceTypeCall:
 				expectUsageDuration = int(tt.tmBillingEnd.Sub(*tt.billing.TMBillingStart).Seconds())
-				expectBillableUnits = billing.CalculateBillableUnits(expectUsageDuration)
+				expectBillableUnits = tt.billing.GetCostInfo().CalculateBillableUnits(expectUsageDuration)
 			default:
 				expectUsageDuration = 0
 				expectBillableUnits = 1
 			}
 
 			mockDB.EXPECT().BillingConsumeAndRecord(ctx, tt.billing, tt.billing.AccountID, expectBillableUnits, expectUsageDuration, billing.GetCostInfo(tt.billing.CostType), tt.tmBillingEnd).Return(tt.responseBilling, nil)
-			mockNotify.EXPECT().PublishEvent(ctx, billing.EventTypeBillingUpdated, tt.responseBilling)
+			if tt.responseBilling.AmountCredit < 0 {
+				mockAccount.EXPECT().CheckBalanceThresholds(ctx, tt.responseBilling.AccountID, tt.responseBilling.BalanceCreditSnapshot-tt.responseBilling.AmountCredit, tt.responseBilling.BalanceCreditSnapshot).Return(nil)
+			}
 
 			if err := h.BillingEnd(ctx, tt.billing, tt.tmBillingEnd, tt.source, tt.destination); err != nil {
 				t.Errorf("Wrong match. expect: ok, got: %v", err)
@@ -474,19 +489,20 @@ func Test_BillingEnd_call_extension(t *testing.T) {
 			mockDB := dbhandler.NewMockDBHandler(mc)
 			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
 			mockAccount := accounthandler.NewMockAccountHandler(mc)
+			mockRateDeck := ratedeckhandler.NewMockRateDeckHandler(mc)
 
 			h := billingHandler{
-				utilHandler:    mockUtil,
-				db:             mockDB,
-				notifyHandler:  mockNotify,
-				accountHandler: mockAccount,
+				utilHandler:     mockUtil,
+				db:              mockDB,
+				notifyHandler:   mockNotify,
+				accountHandler:  mockAccount,
+				rateDeckHandler: mockRateDeck,
 			}
 			ctx := context.Background()
 
 			// CostTypeCallExtension -> BillingSetStatusEnd with zero costs
 			mockDB.EXPECT().BillingSetStatusEnd(ctx, tt.responseBilling.ID, 0, 0, int64(0), int64(0), int64(0), int64(0), tt.tmBillingEnd).Return(nil)
 			mockDB.EXPECT().BillingGet(ctx, tt.responseBilling.ID).Return(tt.responseBilling, nil)
-			mockNotify.EXPECT().PublishEvent(ctx, billing.EventTypeBillingUpdated, tt.responseBilling)
 
 			if err := h.BillingEnd(ctx, tt.billing, tt.tmBillingEnd, tt.source, tt.destination); err != nil {
 				t.Errorf("Wrong match. expect: ok, got: %v", err)
@@ -543,12 +559,14 @@ func Test_BillingStart_idempotent(t *testing.T) {
 			mockDB := dbhandler.NewMockDBHandler(mc)
 			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
 			mockAccount := accounthandler.NewMockAccountHandler(mc)
+			mockRateDeck := ratedeckhandler.NewMockRateDeckHandler(mc)
 
 			h := billingHandler{
-				utilHandler:    mockUtil,
-				db:             mockDB,
-				notifyHandler:  mockNotify,
-				accountHandler: mockAccount,
+				utilHandler:     mockUtil,
+				db:              mockDB,
+				notifyHandler:   mockNotify,
+				accountHandler:  mockAccount,
+				rateDeckHandler: mockRateDeck,
 			}
 			ctx := context.Background()
 
@@ -605,18 +623,20 @@ func Test_BillingEnd_consume_error(t *testing.T) {
 			mockDB := dbhandler.NewMockDBHandler(mc)
 			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
 			mockAccount := accounthandler.NewMockAccountHandler(mc)
+			mockRateDeck := ratedeckhandler.NewMockRateDeckHandler(mc)
 
 			h := billingHandler{
-				utilHandler:    mockUtil,
-				db:             mockDB,
-				notifyHandler:  mockNotify,
-				accountHandler: mockAccount,
+				utilHandler:     mockUtil,
+				db:              mockDB,
+				notifyHandler:   mockNotify,
+				accountHandler:  mockAccount,
+				rateDeckHandler: mockRateDeck,
 			}
 			ctx := context.Background()
 
 			// BillingConsumeAndRecord returns error
 			usageDuration := int(tt.tmBillingEnd.Sub(*tt.billing.TMBillingStart).Seconds())
-			billableUnits := billing.CalculateBillableUnits(usageDuration)
+			billableUnits := tt.billing.GetCostInfo().CalculateBillableUnits(usageDuration)
 			mockDB.EXPECT().BillingConsumeAndRecord(ctx, tt.billing, tt.billing.AccountID, billableUnits, usageDuration, billing.GetCostInfo(tt.billing.CostType), tt.tmBillingEnd).Return(nil, fmt.Errorf("insufficient balance"))
 
 			err := h.BillingEnd(ctx, tt.billing, tt.tmBillingEnd, tt.source, tt.destination)
@@ -664,12 +684,14 @@ func Test_BillingStart_db_error_on_idempotency_check(t *testing.T) {
 			mockDB := dbhandler.NewMockDBHandler(mc)
 			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
 			mockAccount := accounthandler.NewMockAccountHandler(mc)
+			mockRateDeck := ratedeckhandler.NewMockRateDeckHandler(mc)
 
 			h := billingHandler{
-				utilHandler:    mockUtil,
-				db:             mockDB,
-				notifyHandler:  mockNotify,
-				accountHandler: mockAccount,
+				utilHandler:     mockUtil,
+				db:              mockDB,
+				notifyHandler:   mockNotify,
+				accountHandler:  mockAccount,
+				rateDeckHandler: mockRateDeck,
 			}
 			ctx := context.Background()
 
@@ -753,12 +775,14 @@ func Test_BillingStart_idempotent_retry_sms(t *testing.T) {
 			mockDB := dbhandler.NewMockDBHandler(mc)
 			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
 			mockAccount := accounthandler.NewMockAccountHandler(mc)
+			mockRateDeck := ratedeckhandler.NewMockRateDeckHandler(mc)
 
 			h := billingHandler{
-				utilHandler:    mockUtil,
-				db:             mockDB,
-				notifyHandler:  mockNotify,
-				accountHandler: mockAccount,
+				utilHandler:     mockUtil,
+				db:              mockDB,
+				notifyHandler:   mockNotify,
+				accountHandler:  mockAccount,
+				rateDeckHandler: mockRateDeck,
 			}
 			ctx := context.Background()
 
@@ -767,7 +791,6 @@ func Test_BillingStart_idempotent_retry_sms(t *testing.T) {
 
 			// BillingEnd should be called - SMS uses BillingConsumeAndRecord atomically
 			mockDB.EXPECT().BillingConsumeAndRecord(ctx, tt.existingBilling, tt.existingBilling.AccountID, 1, 0, billing.GetCostInfo(tt.existingBilling.CostType), tt.tmBillingStart).Return(tt.responseBilling, nil)
-			mockNotify.EXPECT().PublishEvent(ctx, billing.EventTypeBillingUpdated, tt.responseBilling)
 
 			err := h.BillingStart(ctx, tt.customerID, tt.referenceType, tt.referenceID, tt.costType, tt.tmBillingStart, tt.source, tt.destination)
 			if err != nil {
@@ -825,12 +848,14 @@ func Test_BillingStart_idempotent_progressing_call(t *testing.T) {
 			mockDB := dbhandler.NewMockDBHandler(mc)
 			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
 			mockAccount := accounthandler.NewMockAccountHandler(mc)
+			mockRateDeck := ratedeckhandler.NewMockRateDeckHandler(mc)
 
 			h := billingHandler{
-				utilHandler:    mockUtil,
-				db:             mockDB,
-				notifyHandler:  mockNotify,
-				accountHandler: mockAccount,
+				utilHandler:     mockUtil,
+				db:              mockDB,
+				notifyHandler:   mockNotify,
+				accountHandler:  mockAccount,
+				rateDeckHandler: mockRateDeck,
 			}
 			ctx := context.Background()
 
@@ -893,12 +918,14 @@ func Test_BillingStart_unsupported_reference_type(t *testing.T) {
 			mockDB := dbhandler.NewMockDBHandler(mc)
 			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
 			mockAccount := accounthandler.NewMockAccountHandler(mc)
+			mockRateDeck := ratedeckhandler.NewMockRateDeckHandler(mc)
 
 			h := billingHandler{
-				utilHandler:    mockUtil,
-				db:             mockDB,
-				notifyHandler:  mockNotify,
-				accountHandler: mockAccount,
+				utilHandler:     mockUtil,
+				db:              mockDB,
+				notifyHandler:   mockNotify,
+				accountHandler:  mockAccount,
+				rateDeckHandler: mockRateDeck,
 			}
 			ctx := context.Background()
 
@@ -955,12 +982,14 @@ func Test_BillingStart_account_not_found(t *testing.T) {
 			mockDB := dbhandler.NewMockDBHandler(mc)
 			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
 			mockAccount := accounthandler.NewMockAccountHandler(mc)
+			mockRateDeck := ratedeckhandler.NewMockRateDeckHandler(mc)
 
 			h := billingHandler{
-				utilHandler:    mockUtil,
-				db:             mockDB,
-				notifyHandler:  mockNotify,
-				accountHandler: mockAccount,
+				utilHandler:     mockUtil,
+				db:              mockDB,
+				notifyHandler:   mockNotify,
+				accountHandler:  mockAccount,
+				rateDeckHandler: mockRateDeck,
 			}
 			ctx := context.Background()
 
@@ -1026,12 +1055,14 @@ func Test_BillingStart_create_error(t *testing.T) {
 			mockDB := dbhandler.NewMockDBHandler(mc)
 			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
 			mockAccount := accounthandler.NewMockAccountHandler(mc)
+			mockRateDeck := ratedeckhandler.NewMockRateDeckHandler(mc)
 
 			h := billingHandler{
-				utilHandler:    mockUtil,
-				db:             mockDB,
-				notifyHandler:  mockNotify,
-				accountHandler: mockAccount,
+				utilHandler:     mockUtil,
+				db:              mockDB,
+				notifyHandler:   mockNotify,
+				accountHandler:  mockAccount,
+				rateDeckHandler: mockRateDeck,
 			}
 			ctx := context.Background()
 
@@ -1040,6 +1071,7 @@ func Test_BillingStart_create_error(t *testing.T) {
 
 			// GetByCustomerID succeeds
 			mockAccount.EXPECT().GetByCustomerID(ctx, tt.customerID).Return(tt.responseAccount, nil)
+			mockRateDeck.EXPECT().GetRate(ctx, tt.responseAccount, tt.costType, tt.destination, tt.tmBillingStart).Return(nil, nil)
 
 			// Create fails
 			mockUtil.EXPECT().UUIDCreate().Return(tt.responseUUID)
@@ -1053,6 +1085,80 @@ func Test_BillingStart_create_error(t *testing.T) {
 	}
 }
 
+func Test_BillingStart_rate_error(t *testing.T) {
+
+	tmBillingStart := time.Date(2023, 6, 8, 3, 22, 17, 995000000, time.UTC)
+
+	tests := []struct {
+		name string
+
+		customerID     uuid.UUID
+		referenceType  billing.ReferenceType
+		referenceID    uuid.UUID
+		costType       billing.CostType
+		tmBillingStart *time.Time
+		source         *commonaddress.Address
+		destination    *commonaddress.Address
+
+		responseAccount *account.Account
+	}{
+		{
+			name: "rate lookup error",
+
+			customerID:     uuid.FromStringOrNil("1000001f-0000-0000-0000-000000000001"),
+			referenceType:  billing.ReferenceTypeCall,
+			referenceID:    uuid.FromStringOrNil("10000020-0000-0000-0000-000000000001"),
+			costType:       billing.CostTypeCallPSTNOutgoing,
+			tmBillingStart: &tmBillingStart,
+			source:         &commonaddress.Address{Target: "+1234"},
+			destination:    &commonaddress.Address{Target: "+5678"},
+
+			responseAccount: &account.Account{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("10000021-0000-0000-0000-000000000001"),
+					CustomerID: uuid.FromStringOrNil("1000001f-0000-0000-0000-000000000001"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+			mockAccount := accounthandler.NewMockAccountHandler(mc)
+			mockRateDeck := ratedeckhandler.NewMockRateDeckHandler(mc)
+
+			h := billingHandler{
+				utilHandler:     mockUtil,
+				db:              mockDB,
+				notifyHandler:   mockNotify,
+				accountHandler:  mockAccount,
+				rateDeckHandler: mockRateDeck,
+			}
+			ctx := context.Background()
+
+			// idempotency check - no existing billing
+			mockDB.EXPECT().BillingGetByReferenceTypeAndID(ctx, tt.referenceType, tt.referenceID).Return(nil, dbhandler.ErrNotFound)
+
+			// GetByCustomerID succeeds
+			mockAccount.EXPECT().GetByCustomerID(ctx, tt.customerID).Return(tt.responseAccount, nil)
+
+			// GetRate fails. the billing must not be created with the default rate
+			mockRateDeck.EXPECT().GetRate(ctx, tt.responseAccount, tt.costType, tt.destination, tt.tmBillingStart).Return(nil, fmt.Errorf("db connection lost"))
+
+			err := h.BillingStart(ctx, tt.customerID, tt.referenceType, tt.referenceID, tt.costType, tt.tmBillingStart, tt.source, tt.destination)
+			if err == nil {
+				t.Errorf("Wrong match. expect: error, got: nil")
+			}
+		})
+	}
+}
+
 func Test_BillingEnd_nil_timestamps(t *testing.T) {
 
 	tests := []struct {
@@ -1103,18 +1209,19 @@ func Test_BillingEnd_nil_timestamps(t *testing.T) {
 			mockDB := dbhandler.NewMockDBHandler(mc)
 			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
 			mockAccount := accounthandler.NewMockAccountHandler(mc)
+			mockRateDeck := ratedeckhandler.NewMockRateDeckHandler(mc)
 
 			h := billingHandler{
-				utilHandler:    mockUtil,
-				db:             mockDB,
-				notifyHandler:  mockNotify,
-				accountHandler: mockAccount,
+				utilHandler:     mockUtil,
+				db:              mockDB,
+				notifyHandler:   mockNotify,
+				accountHandler:  mockAccount,
+				rateDeckHandler: mockRateDeck,
 			}
 			ctx := context.Background()
 
 			// nil timestamps: usageDuration=0, billableUnits=0
 			mockDB.EXPECT().BillingConsumeAndRecord(ctx, tt.billing, tt.billing.AccountID, 0, 0, billing.GetCostInfo(tt.billing.CostType), (*time.Time)(nil)).Return(tt.responseBilling, nil)
-			mockNotify.EXPECT().PublishEvent(ctx, billing.EventTypeBillingUpdated, tt.responseBilling)
 
 			err := h.BillingEnd(ctx, tt.billing, tt.tmBillingEnd, tt.source, tt.destination)
 			if err != nil {
@@ -1160,12 +1267,14 @@ func Test_BillingEnd_unsupported_reference_type(t *testing.T) {
 			mockDB := dbhandler.NewMockDBHandler(mc)
 			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
 			mockAccount := accounthandler.NewMockAccountHandler(mc)
+			mockRateDeck := ratedeckhandler.NewMockRateDeckHandler(mc)
 
 			h := billingHandler{
-				utilHandler:    mockUtil,
-				db:             mockDB,
-				notifyHandler:  mockNotify,
-				accountHandler: mockAccount,
+				utilHandler:     mockUtil,
+				db:              mockDB,
+				notifyHandler:   mockNotify,
+				accountHandler:  mockAccount,
+				rateDeckHandler: mockRateDeck,
 			}
 			ctx := context.Background()
 
@@ -1220,17 +1329,19 @@ func Test_BillingEnd_set_status_end_error(t *testing.T) {
 			mockDB := dbhandler.NewMockDBHandler(mc)
 			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
 			mockAccount := accounthandler.NewMockAccountHandler(mc)
+			mockRateDeck := ratedeckhandler.NewMockRateDeckHandler(mc)
 
 			h := billingHandler{
-				utilHandler:    mockUtil,
-				db:             mockDB,
-				notifyHandler:  mockNotify,
-				accountHandler: mockAccount,
+				utilHandler:     mockUtil,
+				db:              mockDB,
+				notifyHandler:   mockNotify,
+				accountHandler:  mockAccount,
+				rateDeckHandler: mockRateDeck,
 			}
 			ctx := context.Background()
 
 			usageDuration := int(tt.tmBillingEnd.Sub(*tt.billing.TMBillingStart).Seconds())
-			billableUnits := billing.CalculateBillableUnits(usageDuration)
+			billableUnits := tt.billing.GetCostInfo().CalculateBillableUnits(usageDuration)
 			mockDB.EXPECT().BillingConsumeAndRecord(ctx, tt.billing, tt.billing.AccountID, billableUnits, usageDuration, billing.GetCostInfo(tt.billing.CostType), tt.tmBillingEnd).Return(nil, fmt.Errorf("connection timeout"))
 
 			err := h.BillingEnd(ctx, tt.billing, tt.tmBillingEnd, tt.source, tt.destination)
@@ -1296,12 +1407,14 @@ func Test_BillingStart_system_customer(t *testing.T) {
 			mockDB := dbhandler.NewMockDBHandler(mc)
 			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
 			mockAccount := accounthandler.NewMockAccountHandler(mc)
+			mockRateDeck := ratedeckhandler.NewMockRateDeckHandler(mc)
 
 			h := billingHandler{
-				utilHandler:    mockUtil,
-				db:             mockDB,
-				notifyHandler:  mockNotify,
-				accountHandler: mockAccount,
+				utilHandler:     mockUtil,
+				db:              mockDB,
+				notifyHandler:   mockNotify,
+				accountHandler:  mockAccount,
+				rateDeckHandler: mockRateDeck,
 			}
 			ctx := context.Background()
 
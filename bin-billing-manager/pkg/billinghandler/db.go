@@ -11,6 +11,7 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"monorepo/bin-billing-manager/models/billing"
+	"monorepo/bin-billing-manager/models/rate"
 	"monorepo/bin-billing-manager/pkg/dbhandler"
 	cerrors "monorepo/bin-common-handler/models/errors"
 	commonidentity "monorepo/bin-common-handler/models/identity"
@@ -18,6 +19,7 @@ import (
 )
 
 // Create creates a new billing and return the created billing.
+// The given rate overrides the default rate of the cost type. nil means the default rate.
 func (h *billingHandler) Create(
 	ctx context.Context,
 	customerID uuid.UUID,
@@ -25,6 +27,7 @@ func (h *billingHandler) Create(
 	referenceType billing.ReferenceType,
 	referenceID uuid.UUID,
 	costType billing.CostType,
+	rt *rate.Rate,
 	tmBillingStart *time.Time,
 ) (*billing.Billing, error) {
 	log := logrus.WithFields(logrus.Fields{
@@ -56,6 +59,13 @@ func (h *billingHandler) Create(
 		RateCreditPerUnit: costInfo.CreditPerUnit,
 		TMBillingStart:    tmBillingStart,
 	}
+	if rt != nil {
+		c.RateID = rt.ID
+		c.RateCreditPerUnit = rt.CreditPerUnit
+		c.RateConnectionFee = rt.ConnectionFee
+		c.RateIncrementInitial = rt.IncrementInitial
+		c.RateIncrementSubsequent = rt.IncrementSubsequent
+	}
 
 	if errCreate := h.db.BillingCreate(ctx, c); errCreate != nil {
 		log.Errorf("Could not create a billing. err: %v", errCreate)
@@ -15,6 +15,7 @@ import (
 	gomock "go.uber.org/mock/gomock"
 
 	"monorepo/bin-billing-manager/models/billing"
+	"monorepo/bin-billing-manager/models/rate"
 	"monorepo/bin-billing-manager/pkg/accounthandler"
 	"monorepo/bin-billing-manager/pkg/dbhandler"
 )
@@ -29,6 +30,7 @@ func Test_Create(t *testing.T) {
 		referenceType  billing.ReferenceType
 		referenceID    uuid.UUID
 		costType       billing.CostType
+		rate           *rate.Rate
 		tmBillingStart *time.Time
 
 		responseUUID    uuid.UUID
@@ -74,6 +76,50 @@ func Test_Create(t *testing.T) {
 				TMBillingEnd:      nil,
 			},
 		},
+		{
+			name: "rate deck's rate",
+
+			customerID:    uuid.FromStringOrNil("9727c0a0-08fb-11ee-b990-6ba2967f21c4"),
+			accountID:     uuid.FromStringOrNil("975d1a8e-08fb-11ee-abea-539ff7bc4054"),
+			referenceType: billing.ReferenceTypeCall,
+			referenceID:   uuid.FromStringOrNil("6e0f1a2b-ad31-11f0-8a7b-4c5d6e7f8a9b"),
+			costType:      billing.CostTypeCallPSTNOutgoing,
+			rate: &rate.Rate{
+				ID:                  uuid.FromStringOrNil("7f1a2b3c-ad31-11f0-9b8c-5d6e7f8a9b0c"),
+				CreditPerUnit:       12000,
+				ConnectionFee:       5000,
+				IncrementInitial:    60,
+				IncrementSubsequent: 6,
+			},
+			tmBillingStart: &tmBillingStart,
+
+			responseUUID: uuid.FromStringOrNil("802b3c4d-ad31-11f0-ac9d-6e7f8a9b0c1d"),
+			responseBilling: &billing.Billing{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("802b3c4d-ad31-11f0-ac9d-6e7f8a9b0c1d"),
+				},
+			},
+
+			expectBilling: &billing.Billing{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("802b3c4d-ad31-11f0-ac9d-6e7f8a9b0c1d"),
+					CustomerID: uuid.FromStringOrNil("9727c0a0-08fb-11ee-b990-6ba2967f21c4"),
+				},
+				AccountID:               uuid.FromStringOrNil("975d1a8e-08fb-11ee-abea-539ff7bc4054"),
+				TransactionType:         billing.TransactionTypeUsage,
+				Status:                  billing.StatusProgressing,
+				ReferenceType:           billing.ReferenceTypeCall,
+				ReferenceID:             uuid.FromStringOrNil("6e0f1a2b-ad31-11f0-8a7b-4c5d6e7f8a9b"),
+				IdempotencyKey:          uuid.FromStringOrNil("802b3c4d-ad31-11f0-ac9d-6e7f8a9b0c1d"),
+				CostType:                billing.CostTypeCallPSTNOutgoing,
+				RateID:                  uuid.FromStringOrNil("7f1a2b3c-ad31-11f0-9b8c-5d6e7f8a9b0c"),
+				RateCreditPerUnit:       12000,
+				RateConnectionFee:       5000,
+				RateIncrementInitial:    60,
+				RateIncrementSubsequent: 6,
+				TMBillingStart:          &tmBillingStart,
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -98,7 +144,7 @@ func Test_Create(t *testing.T) {
 
 			mockNotify.EXPECT().PublishEvent(ctx, billing.EventTypeBillingCreated, tt.responseBilling)
 
-			res, err := h.Create(ctx, tt.customerID, tt.accountID, tt.referenceType, tt.referenceID, tt.costType, tt.tmBillingStart)
+			res, err := h.Create(ctx, tt.customerID, tt.accountID, tt.referenceType, tt.referenceID, tt.costType, tt.rate, tt.tmBillingStart)
 			if err != nil {
 				t.Errorf("Wrong match. expect: ok, got: %v", err)
 			}
@@ -368,7 +414,7 @@ func Test_Create_db_create_error(t *testing.T) {
 			mockUtil.EXPECT().UUIDCreate().Return(tt.responseUUID)
 			mockDB.EXPECT().BillingCreate(ctx, tt.expectBilling).Return(fmt.Errorf("db connection lost"))
 
-			_, err := h.Create(ctx, tt.customerID, tt.accountID, tt.referenceType, tt.referenceID, tt.costType, tt.tmBillingStart)
+			_, err := h.Create(ctx, tt.customerID, tt.accountID, tt.referenceType, tt.referenceID, tt.costType, nil, tt.tmBillingStart)
 			if err == nil {
 				t.Errorf("Wrong match. expect: error, got: nil")
 			}
@@ -447,7 +493,7 @@ func Test_Create_db_get_error(t *testing.T) {
 			mockDB.EXPECT().BillingCreate(ctx, tt.expectBilling).Return(nil)
 			mockDB.EXPECT().BillingGet(ctx, tt.responseUUID).Return(nil, fmt.Errorf("connection timeout"))
 
-			_, err := h.Create(ctx, tt.customerID, tt.accountID, tt.referenceType, tt.referenceID, tt.costType, tt.tmBillingStart)
+			_, err := h.Create(ctx, tt.customerID, tt.accountID, tt.referenceType, tt.referenceID, tt.costType, nil, tt.tmBillingStart)
 			if err == nil {
 				t.Errorf("Wrong match. expect: error, got: nil")
 			}
@@ -0,0 +1,161 @@
+package billinghandler
+
+import (
+	"context"
+	stderrors "errors"
+
+	commonaddress "monorepo/bin-common-handler/models/address"
+	cerrors "monorepo/bin-common-handler/models/errors"
+	commonoutline "monorepo/bin-common-handler/models/outline"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"monorepo/bin-billing-manager/models/billing"
+	"monorepo/bin-billing-manager/models/estimate"
+	"monorepo/bin-billing-manager/models/rate"
+	"monorepo/bin-billing-manager/pkg/dbhandler"
+)
+
+// EstimateQuote returns the estimated cost of the usage of the given cost type, destination and duration.
+// The rate applied to the customer's account is used as the billing would do.
+func (h *billingHandler) EstimateQuote(
+	ctx context.Context,
+	customerID uuid.UUID,
+	costType billing.CostType,
+	destination *commonaddress.Address,
+	duration int,
+) (*estimate.Estimate, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":        "EstimateQuote",
+		"customer_id": customerID,
+		"cost_type":   costType,
+		"destination": destination,
+		"duration":    duration,
+	})
+
+	costInfo := billing.GetCostInfo(costType)
+	if costInfo.Mode == billing.CostModeDisabled {
+		return nil, cerrors.InvalidArgument(
+			commonoutline.ServiceNameBillingManager,
+			"INVALID_COST_TYPE",
+			"The cost type can not be estimated.",
+		)
+	}
+	if duration < 0 {
+		return nil, cerrors.InvalidArgument(
+			commonoutline.ServiceNameBillingManager,
+			"INVALID_DURATION",
+			"The duration must not be negative.",
+		)
+	}
+
+	a, err := h.accountHandler.GetByCustomerID(ctx, customerID)
+	if err != nil {
+		log.Errorf("Could not get account info. err: %v", err)
+		return nil, errors.Wrap(err, "could not get account info")
+	}
+
+	res := &estimate.Estimate{
+		CustomerID: a.CustomerID,
+		AccountID:  a.ID,
+		CostType:   costType,
+
+		RateCreditPerUnit: costInfo.CreditPerUnit,
+	}
+
+	if rate.IsRatable(costType) {
+		rt, err := h.rateDeckHandler.GetRate(ctx, a, costType, destination, nil)
+		if err != nil {
+			log.Errorf("Could not get the rate. err: %v", err)
+			return nil, errors.Wrap(err, "could not get the rate")
+		}
+
+		if rt != nil {
+			res.RateID = rt.ID
+			res.RateCreditPerUnit = rt.CreditPerUnit
+			res.RateConnectionFee = rt.ConnectionFee
+			res.RateIncrementInitial = rt.IncrementInitial
+			res.RateIncrementSubsequent = rt.IncrementSubsequent
+		}
+	}
+
+	calculateEstimate(res, duration)
+
+	return res, nil
+}
+
+// EstimateGetByReferenceID returns the estimated cost of the billing of the given reference id.
+// The cost of the progressing billing is accrued until now.
+func (h *billingHandler) EstimateGetByReferenceID(ctx context.Context, referenceID uuid.UUID) (*estimate.Estimate, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":         "EstimateGetByReferenceID",
+		"reference_id": referenceID,
+	})
+
+	b, err := h.GetByReferenceID(ctx, referenceID)
+	if err != nil {
+		log.Errorf("Could not get billing. err: %v", err)
+		if stderrors.Is(err, dbhandler.ErrNotFound) {
+			return nil, cerrors.NotFound(
+				commonoutline.ServiceNameBillingManager,
+				"BILLING_NOT_FOUND",
+				"The billing record was not found.",
+			).Wrap(err)
+		}
+		return nil, err
+	}
+
+	a, err := h.accountHandler.Get(ctx, b.AccountID)
+	if err != nil {
+		log.Errorf("Could not get account info. err: %v", err)
+		return nil, errors.Wrap(err, "could not get account info")
+	}
+
+	costInfo := b.GetCostInfo()
+	res := &estimate.Estimate{
+		CustomerID: a.CustomerID,
+		AccountID:  a.ID,
+
+		BillingID:     b.ID,
+		ReferenceType: b.ReferenceType,
+		ReferenceID:   b.ReferenceID,
+		CostType:      b.CostType,
+
+		RateID:                  b.RateID,
+		RateCreditPerUnit:       costInfo.CreditPerUnit,
+		RateConnectionFee:       costInfo.ConnectionFee,
+		RateIncrementInitial:    costInfo.IncrementInitial,
+		RateIncrementSubsequent: costInfo.IncrementSubsequent,
+	}
+
+	duration := b.UsageDuration
+	if b.Status == billing.StatusProgressing && b.TMBillingStart != nil {
+		duration = int(h.utilHandler.TimeNow().Sub(*b.TMBillingStart).Seconds())
+	}
+
+	calculateEstimate(res, duration)
+
+	return res, nil
+}
+
+// calculateEstimate sets the estimate's billable units and amount of the given duration.
+func calculateEstimate(e *estimate.Estimate, duration int) {
+	costInfo := e.GetCostInfo()
+
+	switch e.CostType {
+	case billing.CostTypeCallPSTNOutgoing, billing.CostTypeCallPSTNIncoming, billing.CostTypeCallVN,
+		billing.CostTypeCallExtension, billing.CostTypeCallDirectExt,
+		billing.CostTypeTTS, billing.CostTypeRecording:
+		e.Duration = duration
+		e.BillableUnits = costInfo.CalculateBillableUnits(duration)
+		e.BilledDuration = costInfo.CalculateBilledDuration(duration)
+
+	default:
+		e.Duration = 0
+		e.BillableUnits = 1
+	}
+
+	e.AmountCredit = costInfo.CalculateCredit(e.BillableUnits, e.BilledDuration)
+}
@@ -0,0 +1,360 @@
+package billinghandler
+
+import (
+	"context"
+	"fmt"
+	reflect "reflect"
+	"testing"
+	"time"
+
+	commonaddress "monorepo/bin-common-handler/models/address"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	"monorepo/bin-billing-manager/models/account"
+	"monorepo/bin-billing-manager/models/billing"
+	"monorepo/bin-billing-manager/models/estimate"
+	"monorepo/bin-billing-manager/models/rate"
+	"monorepo/bin-billing-manager/pkg/accounthandler"
+	"monorepo/bin-billing-manager/pkg/dbhandler"
+	"monorepo/bin-billing-manager/pkg/ratedeckhandler"
+)
+
+func Test_EstimateQuote(t *testing.T) {
+
+	type test struct {
+		name string
+
+		customerID  uuid.UUID
+		costType    billing.CostType
+		destination *commonaddress.Address
+		duration    int
+
+		responseAccount *account.Account
+		responseRate    *rate.Rate
+
+		expectRes *estimate.Estimate
+	}
+
+	tests := []test{
+		{
+			name: "default rate",
+
+			customerID: uuid.FromStringOrNil("4b1e2c3d-b2d0-11f0-9a8b-1c2d3e4f5a6b"),
+			costType:   billing.CostTypeCallPSTNOutgoing,
+			destination: &commonaddress.Address{
+				Type:   commonaddress.TypeTel,
+				Target: "+821012345678",
+			},
+			duration: 150,
+
+			responseAccount: &account.Account{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("4b4f5a6b-b2d0-11f0-8c7d-2d3e4f5a6b7c"),
+					CustomerID: uuid.FromStringOrNil("4b1e2c3d-b2d0-11f0-9a8b-1c2d3e4f5a6b"),
+				},
+			},
+
+			expectRes: &estimate.Estimate{
+				CustomerID:        uuid.FromStringOrNil("4b1e2c3d-b2d0-11f0-9a8b-1c2d3e4f5a6b"),
+				AccountID:         uuid.FromStringOrNil("4b4f5a6b-b2d0-11f0-8c7d-2d3e4f5a6b7c"),
+				CostType:          billing.CostTypeCallPSTNOutgoing,
+				RateCreditPerUnit: billing.DefaultCreditPerUnitCallPSTNOutgoing,
+				Duration:          150,
+				BillableUnits:     3,
+				BilledDuration:    180,
+				AmountCredit:      30000,
+			},
+		},
+		{
+			name: "rate deck's rate",
+
+			customerID: uuid.FromStringOrNil("4b1e2c3d-b2d0-11f0-9a8b-1c2d3e4f5a6b"),
+			costType:   billing.CostTypeCallPSTNOutgoing,
+			destination: &commonaddress.Address{
+				Type:   commonaddress.TypeTel,
+				Target: "+821012345678",
+			},
+			duration: 65,
+
+			responseAccount: &account.Account{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("4b4f5a6b-b2d0-11f0-8c7d-2d3e4f5a6b7c"),
+					CustomerID: uuid.FromStringOrNil("4b1e2c3d-b2d0-11f0-9a8b-1c2d3e4f5a6b"),
+				},
+			},
+			responseRate: &rate.Rate{
+				ID:                  uuid.FromStringOrNil("4b7c8d9e-b2d0-11f0-9e8f-3e4f5a6b7c8d"),
+				CreditPerUnit:       12000,
+				ConnectionFee:       1000,
+				IncrementInitial:    60,
+				IncrementSubsequent: 6,
+			},
+
+			expectRes: &estimate.Estimate{
+				CustomerID:              uuid.FromStringOrNil("4b1e2c3d-b2d0-11f0-9a8b-1c2d3e4f5a6b"),
+				AccountID:               uuid.FromStringOrNil("4b4f5a6b-b2d0-11f0-8c7d-2d3e4f5a6b7c"),
+				CostType:                billing.CostTypeCallPSTNOutgoing,
+				RateID:                  uuid.FromStringOrNil("4b7c8d9e-b2d0-11f0-9e8f-3e4f5a6b7c8d"),
+				RateCreditPerUnit:       12000,
+				RateConnectionFee:       1000,
+				RateIncrementInitial:    60,
+				RateIncrementSubsequent: 6,
+				Duration:                65,
+				BillableUnits:           2,
+				BilledDuration:          66,
+				AmountCredit:            14200,
+			},
+		},
+		{
+			name: "sms is billed per message",
+
+			customerID: uuid.FromStringOrNil("4b1e2c3d-b2d0-11f0-9a8b-1c2d3e4f5a6b"),
+			costType:   billing.CostTypeSMS,
+			destination: &commonaddress.Address{
+				Type:   commonaddress.TypeTel,
+				Target: "+821012345678",
+			},
+			duration: 30,
+
+			responseAccount: &account.Account{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("4b4f5a6b-b2d0-11f0-8c7d-2d3e4f5a6b7c"),
+					CustomerID: uuid.FromStringOrNil("4b1e2c3d-b2d0-11f0-9a8b-1c2d3e4f5a6b"),
+				},
+			},
+
+			expectRes: &estimate.Estimate{
+				CustomerID:        uuid.FromStringOrNil("4b1e2c3d-b2d0-11f0-9a8b-1c2d3e4f5a6b"),
+				AccountID:         uuid.FromStringOrNil("4b4f5a6b-b2d0-11f0-8c7d-2d3e4f5a6b7c"),
+				CostType:          billing.CostTypeSMS,
+				RateCreditPerUnit: billing.DefaultCreditPerUnitSMS,
+				BillableUnits:     1,
+				AmountCredit:      billing.DefaultCreditPerUnitSMS,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockAccount := accounthandler.NewMockAccountHandler(mc)
+			mockRateDeck := ratedeckhandler.NewMockRateDeckHandler(mc)
+
+			h := billingHandler{
+				accountHandler:  mockAccount,
+				rateDeckHandler: mockRateDeck,
+			}
+			ctx := context.Background()
+
+			mockAccount.EXPECT().GetByCustomerID(ctx, tt.customerID).Return(tt.responseAccount, nil)
+			mockRateDeck.EXPECT().GetRate(ctx, tt.responseAccount, tt.costType, tt.destination, nil).Return(tt.responseRate, nil)
+
+			res, err := h.EstimateQuote(ctx, tt.customerID, tt.costType, tt.destination, tt.duration)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_EstimateQuote_error(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		costType billing.CostType
+		duration int
+	}{
+		{
+			name:     "unsupported cost type",
+			costType: billing.CostTypeNone,
+			duration: 60,
+		},
+		{
+			name:     "negative duration",
+			costType: billing.CostTypeCallPSTNOutgoing,
+			duration: -1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			h := billingHandler{}
+			ctx := context.Background()
+
+			_, err := h.EstimateQuote(ctx, uuid.FromStringOrNil("4b1e2c3d-b2d0-11f0-9a8b-1c2d3e4f5a6b"), tt.costType, nil, tt.duration)
+			if err == nil {
+				t.Errorf("Wrong match. expect: error, got: ok")
+			}
+		})
+	}
+}
+
+func Test_EstimateGetByReferenceID(t *testing.T) {
+
+	tmBillingStart := time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)
+	tmNow := time.Date(2026, 10, 19, 10, 2, 5, 0, time.UTC)
+
+	type test struct {
+		name string
+
+		referenceID uuid.UUID
+
+		responseBilling *billing.Billing
+		responseAccount *account.Account
+
+		expectRes *estimate.Estimate
+	}
+
+	tests := []test{
+		{
+			name: "progressing call",
+
+			referenceID: uuid.FromStringOrNil("7a1b2c3d-b2d0-11f0-8a9b-4f5a6b7c8d9e"),
+
+			responseBilling: &billing.Billing{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("7a4e5f6a-b2d0-11f0-9b8c-5a6b7c8d9e0f"),
+				},
+				AccountID:               uuid.FromStringOrNil("7a7b8c9d-b2d0-11f0-ac9d-6b7c8d9e0f1a"),
+				Status:                  billing.StatusProgressing,
+				ReferenceType:           billing.ReferenceTypeCall,
+				ReferenceID:             uuid.FromStringOrNil("7a1b2c3d-b2d0-11f0-8a9b-4f5a6b7c8d9e"),
+				CostType:                billing.CostTypeCallPSTNOutgoing,
+				RateID:                  uuid.FromStringOrNil("7aa9b0c1-b2d0-11f0-bd0e-7c8d9e0f1a2b"),
+				RateCreditPerUnit:       12000,
+				RateIncrementInitial:    60,
+				RateIncrementSubsequent: 6,
+				TMBillingStart:          &tmBillingStart,
+			},
+			responseAccount: &account.Account{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("7a7b8c9d-b2d0-11f0-ac9d-6b7c8d9e0f1a"),
+					CustomerID: uuid.FromStringOrNil("7ad7e8f9-b2d0-11f0-8e1f-8d9e0f1a2b3c"),
+				},
+			},
+
+			expectRes: &estimate.Estimate{
+				CustomerID:              uuid.FromStringOrNil("7ad7e8f9-b2d0-11f0-8e1f-8d9e0f1a2b3c"),
+				AccountID:               uuid.FromStringOrNil("7a7b8c9d-b2d0-11f0-ac9d-6b7c8d9e0f1a"),
+				BillingID:               uuid.FromStringOrNil("7a4e5f6a-b2d0-11f0-9b8c-5a6b7c8d9e0f"),
+				ReferenceType:           billing.ReferenceTypeCall,
+				ReferenceID:             uuid.FromStringOrNil("7a1b2c3d-b2d0-11f0-8a9b-4f5a6b7c8d9e"),
+				CostType:                billing.CostTypeCallPSTNOutgoing,
+				RateID:                  uuid.FromStringOrNil("7aa9b0c1-b2d0-11f0-bd0e-7c8d9e0f1a2b"),
+				RateCreditPerUnit:       12000,
+				RateIncrementInitial:    60,
+				RateIncrementSubsequent: 6,
+				Duration:                125,
+				BillableUnits:           3,
+				BilledDuration:          126,
+				AmountCredit:            25200,
+			},
+		},
+		{
+			name: "ended call",
+
+			referenceID: uuid.FromStringOrNil("7a1b2c3d-b2d0-11f0-8a9b-4f5a6b7c8d9e"),
+
+			responseBilling: &billing.Billing{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("7a4e5f6a-b2d0-11f0-9b8c-5a6b7c8d9e0f"),
+				},
+				AccountID:         uuid.FromStringOrNil("7a7b8c9d-b2d0-11f0-ac9d-6b7c8d9e0f1a"),
+				Status:            billing.StatusEnd,
+				ReferenceType:     billing.ReferenceTypeCall,
+				ReferenceID:       uuid.FromStringOrNil("7a1b2c3d-b2d0-11f0-8a9b-4f5a6b7c8d9e"),
+				CostType:          billing.CostTypeCallPSTNOutgoing,
+				RateCreditPerUnit: billing.DefaultCreditPerUnitCallPSTNOutgoing,
+				UsageDuration:     61,
+				TMBillingStart:    &tmBillingStart,
+			},
+			responseAccount: &account.Account{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("7a7b8c9d-b2d0-11f0-ac9d-6b7c8d9e0f1a"),
+					CustomerID: uuid.FromStringOrNil("7ad7e8f9-b2d0-11f0-8e1f-8d9e0f1a2b3c"),
+				},
+			},
+
+			expectRes: &estimate.Estimate{
+				CustomerID:        uuid.FromStringOrNil("7ad7e8f9-b2d0-11f0-8e1f-8d9e0f1a2b3c"),
+				AccountID:         uuid.FromStringOrNil("7a7b8c9d-b2d0-11f0-ac9d-6b7c8d9e0f1a"),
+				BillingID:         uuid.FromStringOrNil("7a4e5f6a-b2d0-11f0-9b8c-5a6b7c8d9e0f"),
+				ReferenceType:     billing.ReferenceTypeCall,
+				ReferenceID:       uuid.FromStringOrNil("7a1b2c3d-b2d0-11f0-8a9b-4f5a6b7c8d9e"),
+				CostType:          billing.CostTypeCallPSTNOutgoing,
+				RateCreditPerUnit: billing.DefaultCreditPerUnitCallPSTNOutgoing,
+				Duration:          61,
+				BillableUnits:     2,
+				BilledDuration:    120,
+				AmountCredit:      20000,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockAccount := accounthandler.NewMockAccountHandler(mc)
+
+			h := billingHandler{
+				utilHandler:    mockUtil,
+				db:             mockDB,
+				accountHandler: mockAccount,
+			}
+			ctx := context.Background()
+
+			mockDB.EXPECT().BillingGetByReferenceID(ctx, tt.referenceID).Return(tt.responseBilling, nil)
+			mockAccount.EXPECT().Get(ctx, tt.responseBilling.AccountID).Return(tt.responseAccount, nil)
+			if tt.responseBilling.Status == billing.StatusProgressing {
+				mockUtil.EXPECT().TimeNow().Return(&tmNow)
+			}
+
+			res, err := h.EstimateGetByReferenceID(ctx, tt.referenceID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_EstimateGetByReferenceID_error(t *testing.T) {
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockDB := dbhandler.NewMockDBHandler(mc)
+
+	h := billingHandler{
+		db: mockDB,
+	}
+	ctx := context.Background()
+
+	referenceID := uuid.FromStringOrNil("9c1d2e3f-b2d0-11f0-9f2a-9e0f1a2b3c4d")
+	mockDB.EXPECT().BillingGetByReferenceID(ctx, referenceID).Return(nil, fmt.Errorf("could not get billing: %w", dbhandler.ErrNotFound))
+
+	_, err := h.EstimateGetByReferenceID(ctx, referenceID)
+	if err == nil {
+		t.Errorf("Wrong match. expect: error, got: ok")
+	}
+}
@@ -0,0 +1,39 @@
+package billinghandler
+
+import (
+	"context"
+
+	amaiassist "monorepo/bin-ai-manager/models/aiassist"
+
+	"monorepo/bin-billing-manager/models/billing"
+
+	"github.com/sirupsen/logrus"
+)
+
+// EventAIAIAssistTerminated handles the ai-manager's aiassist_status_terminated event.
+// It charges the aiassist's LLM usage priced by the account's rate deck with the markup.
+func (h *billingHandler) EventAIAIAssistTerminated(ctx context.Context, a *amaiassist.AIAssist) error {
+	log := logrus.WithFields(logrus.Fields{
+		"func":        "EventAIAIAssistTerminated",
+		"aiassist_id": a.ID,
+		"customer_id": a.CustomerID,
+	})
+	log.Debugf("Received aiassist_status_terminated event. aiassist_id: %s", a.ID)
+
+	if a.Usage.IsEmpty() {
+		log.Debugf("The aiassist has no ai usage. Nothing to charge. aiassist_id: %s", a.ID)
+		return nil
+	}
+
+	return h.aiUsageCharge(
+		ctx,
+		a.CustomerID,
+		billing.ReferenceTypeAIAssist,
+		a.ID,
+		&a.Usage,
+		aiUsageEngines{
+			llm: string(a.EngineModel),
+		},
+		a.TMUpdate,
+	)
+}
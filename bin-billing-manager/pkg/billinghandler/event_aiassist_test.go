@@ -0,0 +1,173 @@
+package billinghandler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	amai "monorepo/bin-ai-manager/models/ai"
+	amaiassist "monorepo/bin-ai-manager/models/aiassist"
+	amusage "monorepo/bin-ai-manager/models/usage"
+	"monorepo/bin-billing-manager/models/account"
+	"monorepo/bin-billing-manager/models/billing"
+	"monorepo/bin-billing-manager/models/rate"
+	"monorepo/bin-billing-manager/pkg/accounthandler"
+	"monorepo/bin-billing-manager/pkg/dbhandler"
+	"monorepo/bin-billing-manager/pkg/ratedeckhandler"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/notifyhandler"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func Test_EventAIAIAssistTerminated(t *testing.T) {
+
+	tmUpdate := time.Date(2026, 10, 30, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+
+		aiassist *amaiassist.AIAssist
+
+		responseAccount         *account.Account
+		responseUUID            uuid.UUID
+		responseBilling         *billing.Billing
+		responseConsumedBilling *billing.Billing
+		responseRates           map[billing.CostType]*rate.Rate
+
+		expectBillableUnits int
+		expectCredit        int64
+	}{
+		{
+			name: "normal",
+
+			aiassist: &amaiassist.AIAssist{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("f1000001-0000-0000-0000-000000000001"),
+					CustomerID: uuid.FromStringOrNil("f1000002-0000-0000-0000-000000000001"),
+				},
+				Usage: amusage.Usage{
+					PromptTokens:     4000,
+					CompletionTokens: 200,
+				},
+				EngineModel: amai.EngineModelGeminiGemini2Dot5Flash,
+				TMUpdate:    &tmUpdate,
+			},
+
+			responseAccount: &account.Account{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("f1000003-0000-0000-0000-000000000001"),
+				},
+			},
+			responseUUID: uuid.FromStringOrNil("f1000004-0000-0000-0000-000000000001"),
+			responseBilling: &billing.Billing{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("f1000004-0000-0000-0000-000000000001"),
+				},
+				AccountID:       uuid.FromStringOrNil("f1000003-0000-0000-0000-000000000001"),
+				TransactionType: billing.TransactionTypeUsage,
+				Status:          billing.StatusProgressing,
+				ReferenceType:   billing.ReferenceTypeAIAssist,
+				ReferenceID:     uuid.FromStringOrNil("f1000001-0000-0000-0000-000000000001"),
+				CostType:        billing.CostTypeAIUsage,
+				TMBillingStart:  &tmUpdate,
+			},
+			responseConsumedBilling: &billing.Billing{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("f1000004-0000-0000-0000-000000000001"),
+				},
+				AccountID:             uuid.FromStringOrNil("f1000003-0000-0000-0000-000000000001"),
+				Status:                billing.StatusEnd,
+				ReferenceType:         billing.ReferenceTypeAIAssist,
+				CostType:              billing.CostTypeAIUsage,
+				AmountCredit:          -2400,
+				BalanceCreditSnapshot: 97600,
+			},
+			responseRates: map[billing.CostType]*rate.Rate{
+				billing.CostTypeAIPromptToken:     {CreditPerUnit: 500},
+				billing.CostTypeAICompletionToken: {CreditPerUnit: 2000},
+			},
+
+			expectBillableUnits: 4200,
+			// (4000 * 500 + 200 * 2000) / 1000 = 2400 micros. the assist has no stt/tts usage.
+			expectCredit: 2400,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+			mockAccount := accounthandler.NewMockAccountHandler(mc)
+			mockRateDeck := ratedeckhandler.NewMockRateDeckHandler(mc)
+
+			h := billingHandler{
+				utilHandler:     mockUtil,
+				db:              mockDB,
+				notifyHandler:   mockNotify,
+				accountHandler:  mockAccount,
+				rateDeckHandler: mockRateDeck,
+			}
+			ctx := context.Background()
+
+			// BillingStart
+			mockDB.EXPECT().BillingGetByReferenceTypeAndID(ctx, billing.ReferenceTypeAIAssist, tt.aiassist.ID).Return(nil, dbhandler.ErrNotFound)
+			mockAccount.EXPECT().GetByCustomerID(ctx, tt.aiassist.CustomerID).Return(tt.responseAccount, nil)
+			mockUtil.EXPECT().UUIDCreate().Return(tt.responseUUID)
+			mockDB.EXPECT().BillingCreate(ctx, gomock.Any()).Return(nil)
+			mockDB.EXPECT().BillingGet(ctx, tt.responseUUID).Return(tt.responseBilling, nil)
+			mockNotify.EXPECT().PublishEvent(ctx, billing.EventTypeBillingCreated, tt.responseBilling)
+
+			// consume. the stt/tts components have no engine and are not looked up.
+			mockDB.EXPECT().BillingGetByReferenceTypeAndID(ctx, billing.ReferenceTypeAIAssist, tt.aiassist.ID).Return(tt.responseBilling, nil)
+			mockAccount.EXPECT().Get(ctx, tt.responseBilling.AccountID).Return(tt.responseAccount, nil)
+			for _, ct := range []billing.CostType{billing.CostTypeAIPromptToken, billing.CostTypeAICachedToken, billing.CostTypeAICompletionToken} {
+				mockRateDeck.EXPECT().GetRateByEngine(ctx, tt.responseAccount, ct, string(tt.aiassist.EngineModel), tt.aiassist.TMUpdate).Return(tt.responseRates[ct], nil)
+			}
+			mockDB.EXPECT().BillingConsumeCreditAndRecord(
+				ctx,
+				tt.responseBilling,
+				tt.responseBilling.AccountID,
+				tt.expectBillableUnits,
+				0,
+				tt.expectCredit,
+				tt.aiassist.TMUpdate,
+			).Return(tt.responseConsumedBilling, nil)
+			balanceBefore := tt.responseConsumedBilling.BalanceCreditSnapshot - tt.responseConsumedBilling.AmountCredit
+			mockAccount.EXPECT().CheckBalanceThresholds(ctx, tt.responseConsumedBilling.AccountID, balanceBefore, tt.responseConsumedBilling.BalanceCreditSnapshot).Return(nil)
+
+			if err := h.EventAIAIAssistTerminated(ctx, tt.aiassist); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+		})
+	}
+}
+
+func Test_EventAIAIAssistTerminated_empty_usage(t *testing.T) {
+
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockDB := dbhandler.NewMockDBHandler(mc)
+
+	h := billingHandler{
+		db: mockDB,
+	}
+
+	a := &amaiassist.AIAssist{
+		Identity: commonidentity.Identity{
+			ID: uuid.FromStringOrNil("f2000001-0000-0000-0000-000000000001"),
+		},
+	}
+
+	// no billing expected
+	if err := h.EventAIAIAssistTerminated(context.Background(), a); err != nil {
+		t.Errorf("Wrong match. expect: nil, got: %v", err)
+	}
+}
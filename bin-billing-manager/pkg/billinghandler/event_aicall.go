@@ -0,0 +1,168 @@
+package billinghandler
+
+import (
+	"context"
+	stderrors "errors"
+	"time"
+
+	amaicall "monorepo/bin-ai-manager/models/aicall"
+	amusage "monorepo/bin-ai-manager/models/usage"
+	commonaddress "monorepo/bin-common-handler/models/address"
+
+	"monorepo/bin-billing-manager/models/account"
+	"monorepo/bin-billing-manager/models/billing"
+	"monorepo/bin-billing-manager/pkg/dbhandler"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// EventAIAIcallTerminated handles the ai-manager's aicall_status_terminated event.
+// It charges the aicall's ai usage priced by the account's rate deck with the markup.
+func (h *billingHandler) EventAIAIcallTerminated(ctx context.Context, c *amaicall.AIcall) error {
+	log := logrus.WithFields(logrus.Fields{
+		"func":        "EventAIAIcallTerminated",
+		"aicall_id":   c.ID,
+		"customer_id": c.CustomerID,
+	})
+	log.Debugf("Received aicall_status_terminated event. aicall_id: %s", c.ID)
+
+	if c.Usage.IsEmpty() {
+		log.Debugf("The aicall has no ai usage. Nothing to charge. aicall_id: %s", c.ID)
+		return nil
+	}
+
+	tmEnd := c.TMEnd
+	if tmEnd == nil {
+		tmEnd = c.TMUpdate
+	}
+
+	return h.aiUsageCharge(
+		ctx,
+		c.CustomerID,
+		billing.ReferenceTypeAIcall,
+		c.ID,
+		&c.Usage,
+		aiUsageEngines{
+			llm: string(c.AIEngineModel),
+			stt: string(c.AISTTType),
+			tts: string(c.AITTSType),
+		},
+		tmEnd,
+	)
+}
+
+// aiUsageEngines are the engines the ai usage components are priced by.
+type aiUsageEngines struct {
+	llm string
+	stt string
+	tts string
+}
+
+// aiUsageCharge charges the ai usage of the terminated aicall or aiassist once.
+// The usage is priced by the account's rate deck with the markup.
+func (h *billingHandler) aiUsageCharge(
+	ctx context.Context,
+	customerID uuid.UUID,
+	referenceType billing.ReferenceType,
+	referenceID uuid.UUID,
+	usage *amusage.Usage,
+	engines aiUsageEngines,
+	tmEnd *time.Time,
+) error {
+	log := logrus.WithFields(logrus.Fields{
+		"func":           "aiUsageCharge",
+		"customer_id":    customerID,
+		"reference_type": referenceType,
+		"reference_id":   referenceID,
+	})
+
+	if errBilling := h.BillingStart(
+		ctx,
+		customerID,
+		referenceType,
+		referenceID,
+		billing.CostTypeAIUsage,
+		tmEnd,
+		&commonaddress.Address{},
+		&commonaddress.Address{},
+	); errBilling != nil {
+		return errors.Wrap(errBilling, "could not start a billing")
+	}
+
+	b, err := h.db.BillingGetByReferenceTypeAndID(ctx, referenceType, referenceID)
+	if err != nil {
+		if stderrors.Is(err, dbhandler.ErrNotFound) {
+			// no billing was created. i.e. the system customer.
+			return nil
+		}
+		return errors.Wrapf(err, "could not get the billing. reference_id: %s", referenceID)
+	}
+
+	if b.Status == billing.StatusEnd {
+		log.WithField("billing", b).Debugf("The billing has ended already. billing_id: %s", b.ID)
+		return nil
+	}
+
+	a, err := h.accountHandler.Get(ctx, b.AccountID)
+	if err != nil {
+		return errors.Wrapf(err, "could not get the account. account_id: %s", b.AccountID)
+	}
+
+	rates, err := h.getAIUsageRates(ctx, a, engines, tmEnd)
+	if err != nil {
+		return errors.Wrapf(err, "could not get the ai usage rates. reference_id: %s", referenceID)
+	}
+
+	u := billing.AIUsage{
+		PromptTokens:     usage.PromptTokens,
+		CompletionTokens: usage.CompletionTokens,
+		CachedTokens:     usage.CachedTokens,
+		STTSeconds:       usage.STTSeconds,
+		TTSSeconds:       usage.TTSSeconds,
+	}
+	credit := billing.CalculateAIUsageCredit(u, rates, h.aiUsageMarkupPercent)
+	log.Debugf("Calculated the ai usage credit. credit: %d, markup_percent: %d", credit, h.aiUsageMarkupPercent)
+
+	if errEnd := h.billingConsumeCredit(ctx, b, u.BillableUnits(), u.UsageDuration(), credit, tmEnd); errEnd != nil {
+		return errors.Wrapf(errEnd, "could not end the billing. billing_id: %s, reference_id: %s", b.ID, referenceID)
+	}
+
+	return nil
+}
+
+// getAIUsageRates returns the rates of the ai usage components.
+// The account's rate deck prices the tokens by the llm engine model and the audio by the stt/tts type.
+// The components without an engine or the rate deck's rate are priced by the default rates.
+func (h *billingHandler) getAIUsageRates(ctx context.Context, a *account.Account, engines aiUsageEngines, tm *time.Time) (billing.AIUsageRates, error) {
+	res := billing.DefaultAIUsageRates()
+
+	components := []struct {
+		costType billing.CostType
+		engine   string
+		rate     *int64
+	}{
+		{billing.CostTypeAIPromptToken, engines.llm, &res.PromptToken},
+		{billing.CostTypeAICachedToken, engines.llm, &res.CachedToken},
+		{billing.CostTypeAICompletionToken, engines.llm, &res.CompletionToken},
+		{billing.CostTypeAISTT, engines.stt, &res.STT},
+		{billing.CostTypeAITTS, engines.tts, &res.TTS},
+	}
+	for _, comp := range components {
+		if comp.engine == "" {
+			continue
+		}
+
+		rt, err := h.rateDeckHandler.GetRateByEngine(ctx, a, comp.costType, comp.engine, tm)
+		if err != nil {
+			return billing.AIUsageRates{}, errors.Wrapf(err, "could not get the rate. cost_type: %s", comp.costType)
+		}
+
+		if rt != nil {
+			*comp.rate = rt.CreditPerUnit
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,287 @@
+package billinghandler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	amai "monorepo/bin-ai-manager/models/ai"
+	amaicall "monorepo/bin-ai-manager/models/aicall"
+	amusage "monorepo/bin-ai-manager/models/usage"
+	"monorepo/bin-billing-manager/models/account"
+	"monorepo/bin-billing-manager/models/billing"
+	"monorepo/bin-billing-manager/models/rate"
+	"monorepo/bin-billing-manager/pkg/accounthandler"
+	"monorepo/bin-billing-manager/pkg/dbhandler"
+	"monorepo/bin-billing-manager/pkg/ratedeckhandler"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/notifyhandler"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func Test_EventAIAIcallTerminated(t *testing.T) {
+
+	tmEnd := time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+
+		aicall               *amaicall.AIcall
+		aiUsageMarkupPercent int
+
+		responseAccount         *account.Account
+		responseUUID            uuid.UUID
+		responseBilling         *billing.Billing
+		responseConsumedBilling *billing.Billing
+		responseRates           map[billing.CostType]*rate.Rate
+
+		expectBillableUnits int
+		expectUsageDuration int
+		expectCredit        int64
+	}{
+		{
+			name: "normal",
+
+			aicall: &amaicall.AIcall{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("ee000001-0000-0000-0000-000000000001"),
+					CustomerID: uuid.FromStringOrNil("ee000002-0000-0000-0000-000000000001"),
+				},
+				AIEngineModel: amai.EngineModelOpenaiGPT5Mini,
+				AISTTType:     amai.STTTypeDeepgram,
+				AITTSType:     amai.TTSTypeElevenLabs,
+				Usage: amusage.Usage{
+					PromptTokens:     2000,
+					CompletionTokens: 500,
+				},
+				TMEnd: &tmEnd,
+			},
+			aiUsageMarkupPercent: 20,
+
+			responseAccount: &account.Account{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("ee000003-0000-0000-0000-000000000001"),
+				},
+			},
+			responseUUID: uuid.FromStringOrNil("ee000004-0000-0000-0000-000000000001"),
+			responseBilling: &billing.Billing{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("ee000004-0000-0000-0000-000000000001"),
+				},
+				AccountID:         uuid.FromStringOrNil("ee000003-0000-0000-0000-000000000001"),
+				TransactionType:   billing.TransactionTypeUsage,
+				Status:            billing.StatusProgressing,
+				ReferenceType:     billing.ReferenceTypeAIcall,
+				ReferenceID:       uuid.FromStringOrNil("ee000001-0000-0000-0000-000000000001"),
+				CostType:          billing.CostTypeAIUsage,
+				RateCreditPerUnit: 0,
+				TMBillingStart:    &tmEnd,
+			},
+			responseConsumedBilling: &billing.Billing{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("ee000004-0000-0000-0000-000000000001"),
+				},
+				AccountID:             uuid.FromStringOrNil("ee000003-0000-0000-0000-000000000001"),
+				Status:                billing.StatusEnd,
+				ReferenceType:         billing.ReferenceTypeAIcall,
+				CostType:              billing.CostTypeAIUsage,
+				AmountCredit:          -12000,
+				BalanceCreditSnapshot: 88000,
+			},
+
+			responseRates: map[billing.CostType]*rate.Rate{},
+
+			expectBillableUnits: 2500,
+			expectUsageDuration: 0,
+			// default rates. (2000 * 2500 + 500 * 10000) / 1000 = 10000 micros. 20% markup -> 12000 micros
+			expectCredit: 12000,
+		},
+		{
+			name: "rate deck prices the engine",
+
+			aicall: &amaicall.AIcall{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("ee000001-0000-0000-0000-000000000002"),
+					CustomerID: uuid.FromStringOrNil("ee000002-0000-0000-0000-000000000002"),
+				},
+				AIEngineModel: amai.EngineModelOpenaiGPT5Mini,
+				AISTTType:     amai.STTTypeDeepgram,
+				AITTSType:     amai.TTSTypeElevenLabs,
+				Usage: amusage.Usage{
+					PromptTokens:     2000,
+					CompletionTokens: 500,
+					STTSeconds:       30,
+					TTSSeconds:       6.5,
+				},
+				TMEnd: &tmEnd,
+			},
+			aiUsageMarkupPercent: 0,
+
+			responseAccount: &account.Account{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("ee000003-0000-0000-0000-000000000002"),
+				},
+			},
+			responseUUID: uuid.FromStringOrNil("ee000004-0000-0000-0000-000000000002"),
+			responseBilling: &billing.Billing{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("ee000004-0000-0000-0000-000000000002"),
+				},
+				AccountID:       uuid.FromStringOrNil("ee000003-0000-0000-0000-000000000002"),
+				TransactionType: billing.TransactionTypeUsage,
+				Status:          billing.StatusProgressing,
+				ReferenceType:   billing.ReferenceTypeAIcall,
+				ReferenceID:     uuid.FromStringOrNil("ee000001-0000-0000-0000-000000000002"),
+				CostType:        billing.CostTypeAIUsage,
+				TMBillingStart:  &tmEnd,
+			},
+			responseConsumedBilling: &billing.Billing{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("ee000004-0000-0000-0000-000000000002"),
+				},
+				AccountID:             uuid.FromStringOrNil("ee000003-0000-0000-0000-000000000002"),
+				Status:                billing.StatusEnd,
+				ReferenceType:         billing.ReferenceTypeAIcall,
+				CostType:              billing.CostTypeAIUsage,
+				AmountCredit:          -31500,
+				BalanceCreditSnapshot: 68500,
+			},
+			responseRates: map[billing.CostType]*rate.Rate{
+				billing.CostTypeAIPromptToken:     {CreditPerUnit: 5000},
+				billing.CostTypeAICompletionToken: {CreditPerUnit: 20000},
+				billing.CostTypeAITTS:             {CreditPerUnit: 60000},
+			},
+
+			expectBillableUnits: 2500,
+			expectUsageDuration: 37,
+			// tokens: (2000 * 5000 + 500 * 20000) / 1000 = 20000 micros.
+			// stt (default rate): 30 * 10000 / 60 = 5000 micros. tts: 6.5 * 60000 / 60 = 6500 micros.
+			expectCredit: 31500,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+			mockAccount := accounthandler.NewMockAccountHandler(mc)
+			mockRateDeck := ratedeckhandler.NewMockRateDeckHandler(mc)
+
+			h := billingHandler{
+				utilHandler:     mockUtil,
+				db:              mockDB,
+				notifyHandler:   mockNotify,
+				accountHandler:  mockAccount,
+				rateDeckHandler: mockRateDeck,
+
+				aiUsageMarkupPercent: tt.aiUsageMarkupPercent,
+			}
+			ctx := context.Background()
+
+			// BillingStart
+			mockDB.EXPECT().BillingGetByReferenceTypeAndID(ctx, billing.ReferenceTypeAIcall, tt.aicall.ID).Return(nil, dbhandler.ErrNotFound)
+			mockAccount.EXPECT().GetByCustomerID(ctx, tt.aicall.CustomerID).Return(tt.responseAccount, nil)
+			mockUtil.EXPECT().UUIDCreate().Return(tt.responseUUID)
+			mockDB.EXPECT().BillingCreate(ctx, gomock.Any()).Return(nil)
+			mockDB.EXPECT().BillingGet(ctx, tt.responseUUID).Return(tt.responseBilling, nil)
+			mockNotify.EXPECT().PublishEvent(ctx, billing.EventTypeBillingCreated, tt.responseBilling)
+
+			// consume
+			mockDB.EXPECT().BillingGetByReferenceTypeAndID(ctx, billing.ReferenceTypeAIcall, tt.aicall.ID).Return(tt.responseBilling, nil)
+			mockAccount.EXPECT().Get(ctx, tt.responseBilling.AccountID).Return(tt.responseAccount, nil)
+			for _, ct := range []billing.CostType{billing.CostTypeAIPromptToken, billing.CostTypeAICachedToken, billing.CostTypeAICompletionToken} {
+				mockRateDeck.EXPECT().GetRateByEngine(ctx, tt.responseAccount, ct, string(tt.aicall.AIEngineModel), tt.aicall.TMEnd).Return(tt.responseRates[ct], nil)
+			}
+			mockRateDeck.EXPECT().GetRateByEngine(ctx, tt.responseAccount, billing.CostTypeAISTT, string(tt.aicall.AISTTType), tt.aicall.TMEnd).Return(tt.responseRates[billing.CostTypeAISTT], nil)
+			mockRateDeck.EXPECT().GetRateByEngine(ctx, tt.responseAccount, billing.CostTypeAITTS, string(tt.aicall.AITTSType), tt.aicall.TMEnd).Return(tt.responseRates[billing.CostTypeAITTS], nil)
+			mockDB.EXPECT().BillingConsumeCreditAndRecord(
+				ctx,
+				tt.responseBilling,
+				tt.responseBilling.AccountID,
+				tt.expectBillableUnits,
+				tt.expectUsageDuration,
+				tt.expectCredit,
+				tt.aicall.TMEnd,
+			).Return(tt.responseConsumedBilling, nil)
+			balanceBefore := tt.responseConsumedBilling.BalanceCreditSnapshot - tt.responseConsumedBilling.AmountCredit
+			mockAccount.EXPECT().CheckBalanceThresholds(ctx, tt.responseConsumedBilling.AccountID, balanceBefore, tt.responseConsumedBilling.BalanceCreditSnapshot).Return(nil)
+
+			if err := h.EventAIAIcallTerminated(ctx, tt.aicall); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+		})
+	}
+}
+
+func Test_EventAIAIcallTerminated_empty_usage(t *testing.T) {
+
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockDB := dbhandler.NewMockDBHandler(mc)
+	mockAccount := accounthandler.NewMockAccountHandler(mc)
+
+	h := billingHandler{
+		db:             mockDB,
+		accountHandler: mockAccount,
+	}
+	ctx := context.Background()
+
+	c := &amaicall.AIcall{
+		Identity: commonidentity.Identity{
+			ID: uuid.FromStringOrNil("ef000001-0000-0000-0000-000000000001"),
+		},
+	}
+
+	// no billing expected
+	if err := h.EventAIAIcallTerminated(ctx, c); err != nil {
+		t.Errorf("Wrong match. expect: nil, got: %v", err)
+	}
+}
+
+func Test_EventAIAIcallTerminated_already_ended(t *testing.T) {
+
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockDB := dbhandler.NewMockDBHandler(mc)
+	mockAccount := accounthandler.NewMockAccountHandler(mc)
+
+	h := billingHandler{
+		db:             mockDB,
+		accountHandler: mockAccount,
+	}
+	ctx := context.Background()
+
+	c := &amaicall.AIcall{
+		Identity: commonidentity.Identity{
+			ID:         uuid.FromStringOrNil("f0000001-0000-0000-0000-000000000001"),
+			CustomerID: uuid.FromStringOrNil("f0000002-0000-0000-0000-000000000001"),
+		},
+		Usage: amusage.Usage{
+			CompletionTokens: 100,
+		},
+	}
+
+	ended := &billing.Billing{
+		Identity: commonidentity.Identity{
+			ID: uuid.FromStringOrNil("f0000003-0000-0000-0000-000000000001"),
+		},
+		ReferenceType: billing.ReferenceTypeAIcall,
+		Status:        billing.StatusEnd,
+	}
+
+	// BillingStart's idempotency check and the consume check both see the ended billing
+	mockDB.EXPECT().BillingGetByReferenceTypeAndID(ctx, billing.ReferenceTypeAIcall, c.ID).Return(ended, nil).Times(2)
+
+	if err := h.EventAIAIcallTerminated(ctx, c); err != nil {
+		t.Errorf("Wrong match. expect: nil, got: %v", err)
+	}
+}
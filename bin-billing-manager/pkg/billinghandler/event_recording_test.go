@@ -183,7 +183,6 @@ func Test_EventCMRecordingFinished(t *testing.T) {
 				billing.GetCostInfo(tt.responseBilling.CostType),
 				tt.recording.TMEnd,
 			).Return(tt.responseConsumedBilling, nil)
-			mockNotify.EXPECT().PublishEvent(ctx, billing.EventTypeBillingUpdated, tt.responseConsumedBilling)
 
 			if err := h.EventCMRecordingFinished(ctx, tt.recording); err != nil {
 				t.Errorf("Wrong match. expect: ok, got: %v", err)
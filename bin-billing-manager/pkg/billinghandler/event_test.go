@@ -10,8 +10,10 @@ import (
 
 	"monorepo/bin-billing-manager/models/account"
 	"monorepo/bin-billing-manager/models/billing"
+	"monorepo/bin-billing-manager/models/rate"
 	"monorepo/bin-billing-manager/pkg/accounthandler"
 	"monorepo/bin-billing-manager/pkg/dbhandler"
+	"monorepo/bin-billing-manager/pkg/ratedeckhandler"
 	commonaddress "monorepo/bin-common-handler/models/address"
 	commonidentity "monorepo/bin-common-handler/models/identity"
 	"monorepo/bin-common-handler/pkg/notifyhandler"
@@ -121,12 +123,14 @@ func Test_EventCMCallProgressing(t *testing.T) {
 			mockDB := dbhandler.NewMockDBHandler(mc)
 			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
 			mockAccount := accounthandler.NewMockAccountHandler(mc)
+			mockRateDeck := ratedeckhandler.NewMockRateDeckHandler(mc)
 
 			h := billingHandler{
-				utilHandler:    mockUtil,
-				db:             mockDB,
-				notifyHandler:  mockNotify,
-				accountHandler: mockAccount,
+				utilHandler:     mockUtil,
+				db:              mockDB,
+				notifyHandler:   mockNotify,
+				accountHandler:  mockAccount,
+				rateDeckHandler: mockRateDeck,
 			}
 			ctx := context.Background()
 
@@ -135,6 +139,9 @@ func Test_EventCMCallProgressing(t *testing.T) {
 
 			// BillingStart
 			mockAccount.EXPECT().GetByCustomerID(ctx, tt.call.CustomerID).Return(tt.responseAccount, nil)
+			if rate.IsRatable(tt.expectCostType) {
+				mockRateDeck.EXPECT().GetRate(ctx, tt.responseAccount, tt.expectCostType, &tt.call.Destination, gomock.Any()).Return(nil, nil)
+			}
 			mockUtil.EXPECT().UUIDCreate().Return(tt.responseUUID)
 			mockDB.EXPECT().BillingCreate(ctx, gomock.Any()).Return(nil)
 			mockDB.EXPECT().BillingGet(ctx, tt.responseUUID).Return(tt.expectBilling, nil)
@@ -154,7 +161,7 @@ func Test_EventCMCallHangup(t *testing.T) {
 
 		call *cmcall.Call
 
-		responseBilling        *billing.Billing
+		responseBilling         *billing.Billing
 		responseConsumedBilling *billing.Billing
 	}
 
@@ -208,12 +215,14 @@ func Test_EventCMCallHangup(t *testing.T) {
 			mockDB := dbhandler.NewMockDBHandler(mc)
 			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
 			mockAccount := accounthandler.NewMockAccountHandler(mc)
+			mockRateDeck := ratedeckhandler.NewMockRateDeckHandler(mc)
 
 			h := billingHandler{
-				utilHandler:    mockUtil,
-				db:             mockDB,
-				notifyHandler:  mockNotify,
-				accountHandler: mockAccount,
+				utilHandler:     mockUtil,
+				db:              mockDB,
+				notifyHandler:   mockNotify,
+				accountHandler:  mockAccount,
+				rateDeckHandler: mockRateDeck,
 			}
 			ctx := context.Background()
 
@@ -230,7 +239,6 @@ func Test_EventCMCallHangup(t *testing.T) {
 				billing.GetCostInfo(tt.responseBilling.CostType),
 				tt.call.TMHangup,
 			).Return(tt.responseConsumedBilling, nil)
-			mockNotify.EXPECT().PublishEvent(ctx, billing.EventTypeBillingUpdated, tt.responseConsumedBilling)
 
 			if err := h.EventCMCallHangup(ctx, tt.call); err != nil {
 				t.Errorf("Wrong match. expect: ok, got: %v", err)
@@ -343,12 +351,14 @@ func Test_EventMMMessageCreated(t *testing.T) {
 			mockDB := dbhandler.NewMockDBHandler(mc)
 			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
 			mockAccount := accounthandler.NewMockAccountHandler(mc)
+			mockRateDeck := ratedeckhandler.NewMockRateDeckHandler(mc)
 
 			h := billingHandler{
-				utilHandler:    mockUtil,
-				db:             mockDB,
-				notifyHandler:  mockNotify,
-				accountHandler: mockAccount,
+				utilHandler:     mockUtil,
+				db:              mockDB,
+				notifyHandler:   mockNotify,
+				accountHandler:  mockAccount,
+				rateDeckHandler: mockRateDeck,
 			}
 			ctx := context.Background()
 
@@ -361,6 +371,7 @@ func Test_EventMMMessageCreated(t *testing.T) {
 
 				// BillingStart -> Create
 				mockAccount.EXPECT().GetByCustomerID(ctx, tt.message.CustomerID).Return(tt.responseAccount, nil)
+				mockRateDeck.EXPECT().GetRate(ctx, tt.responseAccount, billing.CostTypeSMS, gomock.Any(), gomock.Any()).Return(nil, nil)
 				mockUtil.EXPECT().UUIDCreate().Return(tt.responseUUIDs[i])
 				mockDB.EXPECT().BillingCreate(ctx, gomock.Any()).Return(nil)
 				mockDB.EXPECT().BillingGet(ctx, tt.responseUUIDs[i]).Return(tt.responseBillings[i], nil)
@@ -376,7 +387,6 @@ func Test_EventMMMessageCreated(t *testing.T) {
 					billing.GetCostInfo(tt.responseBillings[i].CostType),
 					gomock.Any(), // tmBillingEnd
 				).Return(tt.responseConsumed[i], nil)
-				mockNotify.EXPECT().PublishEvent(ctx, billing.EventTypeBillingUpdated, tt.responseConsumed[i])
 			}
 
 			if err := h.EventMMMessageCreated(ctx, tt.message); err != nil {
@@ -452,12 +462,14 @@ func Test_EventNMNumberCreated(t *testing.T) {
 			mockDB := dbhandler.NewMockDBHandler(mc)
 			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
 			mockAccount := accounthandler.NewMockAccountHandler(mc)
+			mockRateDeck := ratedeckhandler.NewMockRateDeckHandler(mc)
 
 			h := billingHandler{
-				utilHandler:    mockUtil,
-				db:             mockDB,
-				notifyHandler:  mockNotify,
-				accountHandler: mockAccount,
+				utilHandler:     mockUtil,
+				db:              mockDB,
+				notifyHandler:   mockNotify,
+				accountHandler:  mockAccount,
+				rateDeckHandler: mockRateDeck,
 			}
 			ctx := context.Background()
 
@@ -481,7 +493,6 @@ func Test_EventNMNumberCreated(t *testing.T) {
 				billing.GetCostInfo(tt.responseBilling.CostType),
 				gomock.Any(), // tmBillingEnd
 			).Return(tt.responseConsumed, nil)
-			mockNotify.EXPECT().PublishEvent(ctx, billing.EventTypeBillingUpdated, tt.responseConsumed)
 
 			if err := h.EventNMNumberCreated(ctx, tt.number); err != nil {
 				t.Errorf("Wrong match. expect: ok, got: %v", err)
@@ -500,12 +511,14 @@ func Test_EventNMNumberCreated_virtual(t *testing.T) {
 	mockDB := dbhandler.NewMockDBHandler(mc)
 	mockNotify := notifyhandler.NewMockNotifyHandler(mc)
 	mockAccount := accounthandler.NewMockAccountHandler(mc)
+	mockRateDeck := ratedeckhandler.NewMockRateDeckHandler(mc)
 
 	h := billingHandler{
-		utilHandler:    mockUtil,
-		db:             mockDB,
-		notifyHandler:  mockNotify,
-		accountHandler: mockAccount,
+		utilHandler:     mockUtil,
+		db:              mockDB,
+		notifyHandler:   mockNotify,
+		accountHandler:  mockAccount,
+		rateDeckHandler: mockRateDeck,
 	}
 	ctx := context.Background()
 
@@ -598,12 +611,14 @@ func Test_EventNMNumberRenewed(t *testing.T) {
 			mockDB := dbhandler.NewMockDBHandler(mc)
 			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
 			mockAccount := accounthandler.NewMockAccountHandler(mc)
+			mockRateDeck := ratedeckhandler.NewMockRateDeckHandler(mc)
 
 			h := billingHandler{
-				utilHandler:    mockUtil,
-				db:             mockDB,
-				notifyHandler:  mockNotify,
-				accountHandler: mockAccount,
+				utilHandler:     mockUtil,
+				db:              mockDB,
+				notifyHandler:   mockNotify,
+				accountHandler:  mockAccount,
+				rateDeckHandler: mockRateDeck,
 			}
 			ctx := context.Background()
 
@@ -631,7 +646,6 @@ func Test_EventNMNumberRenewed(t *testing.T) {
 				billing.GetCostInfo(tt.responseBilling.CostType),
 				gomock.Any(), // tmBillingEnd
 			).Return(tt.responseConsumed, nil)
-			mockNotify.EXPECT().PublishEvent(ctx, billing.EventTypeBillingUpdated, tt.responseConsumed)
 
 			if err := h.EventNMNumberRenewed(ctx, tt.number); err != nil {
 				t.Errorf("Wrong match. expect: ok, got: %v", err)
@@ -649,12 +663,14 @@ func Test_EventNMNumberRenewed_virtual(t *testing.T) {
 	mockDB := dbhandler.NewMockDBHandler(mc)
 	mockNotify := notifyhandler.NewMockNotifyHandler(mc)
 	mockAccount := accounthandler.NewMockAccountHandler(mc)
+	mockRateDeck := ratedeckhandler.NewMockRateDeckHandler(mc)
 
 	h := billingHandler{
-		utilHandler:    mockUtil,
-		db:             mockDB,
-		notifyHandler:  mockNotify,
-		accountHandler: mockAccount,
+		utilHandler:     mockUtil,
+		db:              mockDB,
+		notifyHandler:   mockNotify,
+		accountHandler:  mockAccount,
+		rateDeckHandler: mockRateDeck,
 	}
 	ctx := context.Background()
 
@@ -1026,12 +1042,14 @@ func Test_EventCMCallHangup_nil_tmhangup(t *testing.T) {
 			mockDB := dbhandler.NewMockDBHandler(mc)
 			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
 			mockAccount := accounthandler.NewMockAccountHandler(mc)
+			mockRateDeck := ratedeckhandler.NewMockRateDeckHandler(mc)
 
 			h := billingHandler{
-				utilHandler:    mockUtil,
-				db:             mockDB,
-				notifyHandler:  mockNotify,
-				accountHandler: mockAccount,
+				utilHandler:     mockUtil,
+				db:              mockDB,
+				notifyHandler:   mockNotify,
+				accountHandler:  mockAccount,
+				rateDeckHandler: mockRateDeck,
 			}
 			ctx := context.Background()
 
@@ -1078,12 +1096,14 @@ func Test_EventCMCallHangup_billing_not_found(t *testing.T) {
 			mockDB := dbhandler.NewMockDBHandler(mc)
 			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
 			mockAccount := accounthandler.NewMockAccountHandler(mc)
+			mockRateDeck := ratedeckhandler.NewMockRateDeckHandler(mc)
 
 			h := billingHandler{
-				utilHandler:    mockUtil,
-				db:             mockDB,
-				notifyHandler:  mockNotify,
-				accountHandler: mockAccount,
+				utilHandler:     mockUtil,
+				db:              mockDB,
+				notifyHandler:   mockNotify,
+				accountHandler:  mockAccount,
+				rateDeckHandler: mockRateDeck,
 			}
 			ctx := context.Background()
 
@@ -1128,12 +1148,14 @@ func Test_EventMMMessageCreated_empty_targets(t *testing.T) {
 			mockDB := dbhandler.NewMockDBHandler(mc)
 			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
 			mockAccount := accounthandler.NewMockAccountHandler(mc)
+			mockRateDeck := ratedeckhandler.NewMockRateDeckHandler(mc)
 
 			h := billingHandler{
-				utilHandler:    mockUtil,
-				db:             mockDB,
-				notifyHandler:  mockNotify,
-				accountHandler: mockAccount,
+				utilHandler:     mockUtil,
+				db:              mockDB,
+				notifyHandler:   mockNotify,
+				accountHandler:  mockAccount,
+				rateDeckHandler: mockRateDeck,
 			}
 			ctx := context.Background()
 
@@ -1182,12 +1204,14 @@ func Test_EventMMMessageCreated_error_on_first_target(t *testing.T) {
 			mockDB := dbhandler.NewMockDBHandler(mc)
 			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
 			mockAccount := accounthandler.NewMockAccountHandler(mc)
+			mockRateDeck := ratedeckhandler.NewMockRateDeckHandler(mc)
 
 			h := billingHandler{
-				utilHandler:    mockUtil,
-				db:             mockDB,
-				notifyHandler:  mockNotify,
-				accountHandler: mockAccount,
+				utilHandler:     mockUtil,
+				db:              mockDB,
+				notifyHandler:   mockNotify,
+				accountHandler:  mockAccount,
+				rateDeckHandler: mockRateDeck,
 			}
 			ctx := context.Background()
 
@@ -1235,12 +1259,14 @@ func Test_EventNMNumberCreated_billing_error(t *testing.T) {
 			mockDB := dbhandler.NewMockDBHandler(mc)
 			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
 			mockAccount := accounthandler.NewMockAccountHandler(mc)
+			mockRateDeck := ratedeckhandler.NewMockRateDeckHandler(mc)
 
 			h := billingHandler{
-				utilHandler:    mockUtil,
-				db:             mockDB,
-				notifyHandler:  mockNotify,
-				accountHandler: mockAccount,
+				utilHandler:     mockUtil,
+				db:              mockDB,
+				notifyHandler:   mockNotify,
+				accountHandler:  mockAccount,
+				rateDeckHandler: mockRateDeck,
 			}
 			ctx := context.Background()
 
@@ -1424,12 +1450,14 @@ func Test_EventEMEmailCreated(t *testing.T) {
 			mockDB := dbhandler.NewMockDBHandler(mc)
 			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
 			mockAccount := accounthandler.NewMockAccountHandler(mc)
+			mockRateDeck := ratedeckhandler.NewMockRateDeckHandler(mc)
 
 			h := billingHandler{
-				utilHandler:    mockUtil,
-				db:             mockDB,
-				notifyHandler:  mockNotify,
-				accountHandler: mockAccount,
+				utilHandler:     mockUtil,
+				db:              mockDB,
+				notifyHandler:   mockNotify,
+				accountHandler:  mockAccount,
+				rateDeckHandler: mockRateDeck,
 			}
 			ctx := context.Background()
 
@@ -1457,7 +1485,6 @@ func Test_EventEMEmailCreated(t *testing.T) {
 					billing.GetCostInfo(tt.responseBillings[i].CostType),
 					gomock.Any(), // tmBillingEnd
 				).Return(tt.responseConsumed[i], nil)
-				mockNotify.EXPECT().PublishEvent(ctx, billing.EventTypeBillingUpdated, tt.responseConsumed[i])
 			}
 
 			if err := h.EventEMEmailCreated(ctx, tt.email); err != nil {
@@ -1504,12 +1531,14 @@ func Test_EventEMEmailCreated_billing_error(t *testing.T) {
 			mockDB := dbhandler.NewMockDBHandler(mc)
 			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
 			mockAccount := accounthandler.NewMockAccountHandler(mc)
+			mockRateDeck := ratedeckhandler.NewMockRateDeckHandler(mc)
 
 			h := billingHandler{
-				utilHandler:    mockUtil,
-				db:             mockDB,
-				notifyHandler:  mockNotify,
-				accountHandler: mockAccount,
+				utilHandler:     mockUtil,
+				db:              mockDB,
+				notifyHandler:   mockNotify,
+				accountHandler:  mockAccount,
+				rateDeckHandler: mockRateDeck,
 			}
 			ctx := context.Background()
 
@@ -1556,12 +1585,14 @@ func Test_EventEMEmailCreated_empty_destinations(t *testing.T) {
 			mockDB := dbhandler.NewMockDBHandler(mc)
 			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
 			mockAccount := accounthandler.NewMockAccountHandler(mc)
+			mockRateDeck := ratedeckhandler.NewMockRateDeckHandler(mc)
 
 			h := billingHandler{
-				utilHandler:    mockUtil,
-				db:             mockDB,
-				notifyHandler:  mockNotify,
-				accountHandler: mockAccount,
+				utilHandler:     mockUtil,
+				db:              mockDB,
+				notifyHandler:   mockNotify,
+				accountHandler:  mockAccount,
+				rateDeckHandler: mockRateDeck,
 			}
 			ctx := context.Background()
 
@@ -1606,12 +1637,14 @@ func Test_EventNMNumberRenewed_billing_error(t *testing.T) {
 			mockDB := dbhandler.NewMockDBHandler(mc)
 			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
 			mockAccount := accounthandler.NewMockAccountHandler(mc)
+			mockRateDeck := ratedeckhandler.NewMockRateDeckHandler(mc)
 
 			h := billingHandler{
-				utilHandler:    mockUtil,
-				db:             mockDB,
-				notifyHandler:  mockNotify,
-				accountHandler: mockAccount,
+				utilHandler:     mockUtil,
+				db:              mockDB,
+				notifyHandler:   mockNotify,
+				accountHandler:  mockAccount,
+				rateDeckHandler: mockRateDeck,
 			}
 			ctx := context.Background()
 
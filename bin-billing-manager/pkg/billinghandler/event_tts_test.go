@@ -183,7 +183,6 @@ func Test_EventTTSSpeakingStopped(t *testing.T) {
 				billing.GetCostInfo(tt.responseBilling.CostType),
 				tt.speaking.TMUpdate,
 			).Return(tt.responseConsumedBilling, nil)
-			mockNotify.EXPECT().PublishEvent(ctx, billing.EventTypeBillingUpdated, tt.responseConsumedBilling)
 
 			if err := h.EventTTSSpeakingStopped(ctx, tt.speaking); err != nil {
 				t.Errorf("Wrong match. expect: ok, got: %v", err)
@@ -6,9 +6,12 @@ import (
 	"context"
 	"time"
 
+	amaiassist "monorepo/bin-ai-manager/models/aiassist"
+	amaicall "monorepo/bin-ai-manager/models/aicall"
 	cmcall "monorepo/bin-call-manager/models/call"
 	cmrecording "monorepo/bin-call-manager/models/recording"
 
+	commonaddress "monorepo/bin-common-handler/models/address"
 	"monorepo/bin-common-handler/pkg/notifyhandler"
 	"monorepo/bin-common-handler/pkg/requesthandler"
 	"monorepo/bin-common-handler/pkg/utilhandler"
@@ -22,8 +25,11 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 
 	"monorepo/bin-billing-manager/models/billing"
+	"monorepo/bin-billing-manager/models/estimate"
+	"monorepo/bin-billing-manager/models/rate"
 	"monorepo/bin-billing-manager/pkg/accounthandler"
 	"monorepo/bin-billing-manager/pkg/dbhandler"
+	"monorepo/bin-billing-manager/pkg/ratedeckhandler"
 )
 
 // BillingHandler define
@@ -35,12 +41,16 @@ type BillingHandler interface {
 		referenceType billing.ReferenceType,
 		referenceID uuid.UUID,
 		costType billing.CostType,
+		rt *rate.Rate,
 		tmBillingStart *time.Time,
 	) (*billing.Billing, error)
 	Get(ctx context.Context, id uuid.UUID) (*billing.Billing, error)
 	GetByReferenceID(ctx context.Context, referenceID uuid.UUID) (*billing.Billing, error)
 	List(ctx context.Context, size uint64, token string, filters map[billing.Field]any) ([]*billing.Billing, error)
 
+	EstimateQuote(ctx context.Context, customerID uuid.UUID, costType billing.CostType, destination *commonaddress.Address, duration int) (*estimate.Estimate, error)
+	EstimateGetByReferenceID(ctx context.Context, referenceID uuid.UUID) (*estimate.Estimate, error)
+
 	EventCMCallProgressing(ctx context.Context, c *cmcall.Call) error
 	EventCMCallHangup(ctx context.Context, c *cmcall.Call) error
 	EventEMEmailCreated(ctx context.Context, e *ememail.Email) error
@@ -51,6 +61,8 @@ type BillingHandler interface {
 	EventTTSSpeakingStopped(ctx context.Context, s *tmspeaking.Speaking) error
 	EventCMRecordingStarted(ctx context.Context, r *cmrecording.Recording) error
 	EventCMRecordingFinished(ctx context.Context, r *cmrecording.Recording) error
+	EventAIAIcallTerminated(ctx context.Context, c *amaicall.AIcall) error
+	EventAIAIAssistTerminated(ctx context.Context, a *amaiassist.AIAssist) error
 }
 
 type billingHandler struct {
@@ -59,7 +71,10 @@ type billingHandler struct {
 	db            dbhandler.DBHandler
 	notifyHandler notifyhandler.NotifyHandler
 
-	accountHandler accounthandler.AccountHandler
+	accountHandler  accounthandler.AccountHandler
+	rateDeckHandler ratedeckhandler.RateDeckHandler
+
+	aiUsageMarkupPercent int // markup in percent applied to the ai usage cost
 }
 
 var (
@@ -109,6 +124,8 @@ func NewBillingHandler(
 	db dbhandler.DBHandler,
 	notifyHandler notifyhandler.NotifyHandler,
 	accountHandler accounthandler.AccountHandler,
+	rateDeckHandler ratedeckhandler.RateDeckHandler,
+	aiUsageMarkupPercent int,
 ) BillingHandler {
 	h := &billingHandler{
 		utilHandler:   utilhandler.NewUtilHandler(),
@@ -116,7 +133,10 @@ func NewBillingHandler(
 		db:            db,
 		notifyHandler: notifyHandler,
 
-		accountHandler: accountHandler,
+		accountHandler:  accountHandler,
+		rateDeckHandler: rateDeckHandler,
+
+		aiUsageMarkupPercent: aiUsageMarkupPercent,
 	}
 
 	return h
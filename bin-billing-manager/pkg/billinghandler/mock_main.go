@@ -11,9 +11,14 @@ package billinghandler
 
 import (
 	context "context"
+	aiassist "monorepo/bin-ai-manager/models/aiassist"
+	aicall "monorepo/bin-ai-manager/models/aicall"
 	billing "monorepo/bin-billing-manager/models/billing"
+	estimate "monorepo/bin-billing-manager/models/estimate"
+	rate "monorepo/bin-billing-manager/models/rate"
 	call "monorepo/bin-call-manager/models/call"
 	recording "monorepo/bin-call-manager/models/recording"
+	address "monorepo/bin-common-handler/models/address"
 	email "monorepo/bin-email-manager/models/email"
 	message "monorepo/bin-message-manager/models/message"
 	number "monorepo/bin-number-manager/models/number"
@@ -50,18 +55,76 @@ func (m *MockBillingHandler) EXPECT() *MockBillingHandlerMockRecorder {
 }
 
 // Create mocks base method.
-func (m *MockBillingHandler) Create(ctx context.Context, customerID, accountID uuid.UUID, referenceType billing.ReferenceType, referenceID uuid.UUID, costType billing.CostType, tmBillingStart *time.Time) (*billing.Billing, error) {
+func (m *MockBillingHandler) Create(ctx context.Context, customerID, accountID uuid.UUID, referenceType billing.ReferenceType, referenceID uuid.UUID, costType billing.CostType, rt *rate.Rate, tmBillingStart *time.Time) (*billing.Billing, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, customerID, accountID, referenceType, referenceID, costType, tmBillingStart)
+	ret := m.ctrl.Call(m, "Create", ctx, customerID, accountID, referenceType, referenceID, costType, rt, tmBillingStart)
 	ret0, _ := ret[0].(*billing.Billing)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockBillingHandlerMockRecorder) Create(ctx, customerID, accountID, referenceType, referenceID, costType, tmBillingStart any) *gomock.Call {
+func (mr *MockBillingHandlerMockRecorder) Create(ctx, customerID, accountID, referenceType, referenceID, costType, rt, tmBillingStart any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBillingHandler)(nil).Create), ctx, customerID, accountID, referenceType, referenceID, costType, tmBillingStart)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBillingHandler)(nil).Create), ctx, customerID, accountID, referenceType, referenceID, costType, rt, tmBillingStart)
+}
+
+// EstimateGetByReferenceID mocks base method.
+func (m *MockBillingHandler) EstimateGetByReferenceID(ctx context.Context, referenceID uuid.UUID) (*estimate.Estimate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EstimateGetByReferenceID", ctx, referenceID)
+	ret0, _ := ret[0].(*estimate.Estimate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EstimateGetByReferenceID indicates an expected call of EstimateGetByReferenceID.
+func (mr *MockBillingHandlerMockRecorder) EstimateGetByReferenceID(ctx, referenceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EstimateGetByReferenceID", reflect.TypeOf((*MockBillingHandler)(nil).EstimateGetByReferenceID), ctx, referenceID)
+}
+
+// EstimateQuote mocks base method.
+func (m *MockBillingHandler) EstimateQuote(ctx context.Context, customerID uuid.UUID, costType billing.CostType, destination *address.Address, duration int) (*estimate.Estimate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EstimateQuote", ctx, customerID, costType, destination, duration)
+	ret0, _ := ret[0].(*estimate.Estimate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EstimateQuote indicates an expected call of EstimateQuote.
+func (mr *MockBillingHandlerMockRecorder) EstimateQuote(ctx, customerID, costType, destination, duration any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EstimateQuote", reflect.TypeOf((*MockBillingHandler)(nil).EstimateQuote), ctx, customerID, costType, destination, duration)
+}
+
+// EventAIAIAssistTerminated mocks base method.
+func (m *MockBillingHandler) EventAIAIAssistTerminated(ctx context.Context, a *aiassist.AIAssist) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EventAIAIAssistTerminated", ctx, a)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EventAIAIAssistTerminated indicates an expected call of EventAIAIAssistTerminated.
+func (mr *MockBillingHandlerMockRecorder) EventAIAIAssistTerminated(ctx, a any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EventAIAIAssistTerminated", reflect.TypeOf((*MockBillingHandler)(nil).EventAIAIAssistTerminated), ctx, a)
+}
+
+// EventAIAIcallTerminated mocks base method.
+func (m *MockBillingHandler) EventAIAIcallTerminated(ctx context.Context, c *aicall.AIcall) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EventAIAIcallTerminated", ctx, c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EventAIAIcallTerminated indicates an expected call of EventAIAIcallTerminated.
+func (mr *MockBillingHandlerMockRecorder) EventAIAIcallTerminated(ctx, c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EventAIAIcallTerminated", reflect.TypeOf((*MockBillingHandler)(nil).EventAIAIcallTerminated), ctx, c)
 }
 
 // EventCMCallHangup mocks base method.
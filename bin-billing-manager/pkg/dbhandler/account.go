@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/gofrs/uuid"
@@ -476,3 +477,70 @@ func (h *handler) AccountSetStatus(ctx context.Context, id uuid.UUID, status acc
 
 	return nil
 }
+
+// AccountSetAutoTopUpPending marks the account's auto top-up as pending with the given request id.
+// It returns false without marking when another auto top-up has been pending since tmExpire.
+func (h *handler) AccountSetAutoTopUpPending(ctx context.Context, id uuid.UUID, pendingID uuid.UUID, tmExpire *time.Time) (bool, error) {
+	ts := h.utilHandler.TimeNow()
+
+	query, args, err := sq.Update(accountsTable).
+		SetMap(map[string]any{
+			"auto_topup_pending_id": pendingID.Bytes(),
+			"tm_auto_topup_pending": ts,
+			"tm_update":             ts,
+		}).
+		Where(sq.Eq{"id": id.Bytes()}).
+		Where(sq.Or{
+			sq.Eq{"tm_auto_topup_pending": nil},
+			sq.Lt{"tm_auto_topup_pending": tmExpire},
+		}).
+		ToSql()
+	if err != nil {
+		return false, fmt.Errorf("AccountSetAutoTopUpPending: could not build query. err: %v", err)
+	}
+
+	res, err := h.db.Exec(query, args...)
+	if err != nil {
+		return false, fmt.Errorf("AccountSetAutoTopUpPending: could not execute. err: %v", err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("AccountSetAutoTopUpPending: could not get affected rows. err: %v", err)
+	}
+	if affected == 0 {
+		return false, nil
+	}
+
+	// update the cache
+	_ = h.accountUpdateToCache(ctx, id)
+
+	return true, nil
+}
+
+// AccountClearAutoTopUpPending clears the account's pending auto top-up.
+func (h *handler) AccountClearAutoTopUpPending(ctx context.Context, id uuid.UUID) error {
+	ts := h.utilHandler.TimeNow()
+
+	query, args, err := sq.Update(accountsTable).
+		SetMap(map[string]any{
+			"auto_topup_pending_id": nil,
+			"tm_auto_topup_pending": nil,
+			"tm_update":             ts,
+		}).
+		Where(sq.Eq{"id": id.Bytes()}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("AccountClearAutoTopUpPending: could not build query. err: %v", err)
+	}
+
+	_, err = h.db.Exec(query, args...)
+	if err != nil {
+		return fmt.Errorf("AccountClearAutoTopUpPending: could not execute. err: %v", err)
+	}
+
+	// update the cache
+	_ = h.accountUpdateToCache(ctx, id)
+
+	return nil
+}
@@ -1507,3 +1507,200 @@ func Test_accountAdjustTokenWithLedger_begin_error(t *testing.T) {
 		t.Errorf("unfulfilled expectations: %v", err)
 	}
 }
+
+func Test_AccountSetAutoTopUpPending(t *testing.T) {
+
+	type test struct {
+		name    string
+		account *account.Account
+
+		pendingID uuid.UUID
+		tmExpire  *time.Time
+
+		responseCurTime *time.Time
+		expectRes       bool
+		expectAccount   *account.Account
+	}
+
+	tmCreate := time.Date(2023, 6, 8, 3, 22, 17, 995000000, time.UTC)
+	tmPending := time.Date(2023, 6, 8, 4, 0, 0, 0, time.UTC)
+	tmBefore := time.Date(2023, 6, 8, 3, 0, 0, 0, time.UTC)
+	tmAfter := time.Date(2023, 6, 8, 5, 0, 0, 0, time.UTC)
+
+	tests := []test{
+		{
+			name: "no auto top-up is pending",
+			account: &account.Account{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("1e5c4a2e-ab70-11f0-9d3f-2f6a1c8b4e01"),
+				},
+			},
+
+			pendingID: uuid.FromStringOrNil("1e8f2b6a-ab70-11f0-a1c4-3b7d2e9c5f01"),
+			tmExpire:  &tmBefore,
+
+			responseCurTime: &tmCreate,
+			expectRes:       true,
+			expectAccount: &account.Account{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("1e5c4a2e-ab70-11f0-9d3f-2f6a1c8b4e01"),
+				},
+				AutoTopUpPendingID: uuid.FromStringOrNil("1e8f2b6a-ab70-11f0-a1c4-3b7d2e9c5f01"),
+				TmAutoTopUpPending: &tmCreate,
+				TMCreate:           &tmCreate,
+				TMUpdate:           &tmCreate,
+			},
+		},
+		{
+			name: "another auto top-up is pending",
+			account: &account.Account{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("1ec1d3f6-ab70-11f0-b52e-4c8e3fad6a01"),
+				},
+				AutoTopUpPendingID: uuid.FromStringOrNil("1ef4a8c2-ab70-11f0-8e67-5d9f4abe7b01"),
+				TmAutoTopUpPending: &tmPending,
+			},
+
+			pendingID: uuid.FromStringOrNil("1f27e4ba-ab70-11f0-97d8-6ea05bcf8c01"),
+			tmExpire:  &tmBefore,
+
+			responseCurTime: &tmCreate,
+			expectRes:       false,
+			expectAccount: &account.Account{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("1ec1d3f6-ab70-11f0-b52e-4c8e3fad6a01"),
+				},
+				AutoTopUpPendingID: uuid.FromStringOrNil("1ef4a8c2-ab70-11f0-8e67-5d9f4abe7b01"),
+				TmAutoTopUpPending: &tmPending,
+				TMCreate:           &tmCreate,
+			},
+		},
+		{
+			name: "the pending auto top-up has expired",
+			account: &account.Account{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("1f5b0e92-ab70-11f0-a3b9-7fb16cd09d01"),
+				},
+				AutoTopUpPendingID: uuid.FromStringOrNil("1f8e2c7a-ab70-11f0-b0fa-80c27de1ae01"),
+				TmAutoTopUpPending: &tmPending,
+			},
+
+			pendingID: uuid.FromStringOrNil("1fc14d62-ab70-11f0-8c1b-91d38ef2bf01"),
+			tmExpire:  &tmAfter,
+
+			responseCurTime: &tmCreate,
+			expectRes:       true,
+			expectAccount: &account.Account{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("1f5b0e92-ab70-11f0-a3b9-7fb16cd09d01"),
+				},
+				AutoTopUpPendingID: uuid.FromStringOrNil("1fc14d62-ab70-11f0-8c1b-91d38ef2bf01"),
+				TmAutoTopUpPending: &tmCreate,
+				TMCreate:           &tmCreate,
+				TMUpdate:           &tmCreate,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockCache := cachehandler.NewMockCacheHandler(mc)
+
+			h := &handler{
+				utilHandler: mockUtil,
+				db:          dbTest,
+				cache:       mockCache,
+			}
+			ctx := context.Background()
+
+			mockUtil.EXPECT().TimeNow().Return(tt.responseCurTime)
+			mockCache.EXPECT().AccountSet(ctx, gomock.Any())
+			if err := h.AccountCreate(ctx, tt.account); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			mockUtil.EXPECT().TimeNow().Return(tt.responseCurTime)
+			if tt.expectRes {
+				mockCache.EXPECT().AccountSet(ctx, gomock.Any())
+			}
+			res, err := h.AccountSetAutoTopUpPending(ctx, tt.account.ID, tt.pendingID, tt.tmExpire)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+			if res != tt.expectRes {
+				t.Errorf("Wrong match. expect: %v, got: %v", tt.expectRes, res)
+			}
+
+			mockCache.EXPECT().AccountGet(ctx, tt.account.ID).Return(nil, fmt.Errorf(""))
+			mockCache.EXPECT().AccountSet(ctx, gomock.Any())
+			a, err := h.AccountGet(ctx, tt.account.ID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(tt.expectAccount, a) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectAccount, a)
+			}
+		})
+	}
+}
+
+func Test_AccountClearAutoTopUpPending(t *testing.T) {
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockUtil := utilhandler.NewMockUtilHandler(mc)
+	mockCache := cachehandler.NewMockCacheHandler(mc)
+
+	h := &handler{
+		utilHandler: mockUtil,
+		db:          dbTest,
+		cache:       mockCache,
+	}
+	ctx := context.Background()
+
+	tmCreate := time.Date(2023, 6, 8, 3, 22, 17, 995000000, time.UTC)
+	tmUpdate := time.Date(2023, 6, 8, 4, 0, 0, 0, time.UTC)
+
+	a := &account.Account{
+		Identity: commonidentity.Identity{
+			ID: uuid.FromStringOrNil("20062f6a-ab70-11f0-9e5c-a2e49f03c001"),
+		},
+		AutoTopUpPendingID: uuid.FromStringOrNil("2039a17e-ab70-11f0-aa2d-b3f5a014d101"),
+		TmAutoTopUpPending: &tmCreate,
+	}
+
+	mockUtil.EXPECT().TimeNow().Return(&tmCreate)
+	mockCache.EXPECT().AccountSet(ctx, gomock.Any())
+	if err := h.AccountCreate(ctx, a); err != nil {
+		t.Errorf("Wrong match. expect: ok, got: %v", err)
+	}
+
+	mockUtil.EXPECT().TimeNow().Return(&tmUpdate)
+	mockCache.EXPECT().AccountSet(ctx, gomock.Any())
+	if err := h.AccountClearAutoTopUpPending(ctx, a.ID); err != nil {
+		t.Errorf("Wrong match. expect: ok, got: %v", err)
+	}
+
+	mockCache.EXPECT().AccountGet(ctx, a.ID).Return(nil, fmt.Errorf(""))
+	mockCache.EXPECT().AccountSet(ctx, gomock.Any())
+	res, err := h.AccountGet(ctx, a.ID)
+	if err != nil {
+		t.Errorf("Wrong match. expect: ok, got: %v", err)
+	}
+
+	expectRes := &account.Account{
+		Identity: commonidentity.Identity{
+			ID: uuid.FromStringOrNil("20062f6a-ab70-11f0-9e5c-a2e49f03c001"),
+		},
+		TMCreate: &tmCreate,
+		TMUpdate: &tmUpdate,
+	}
+	if !reflect.DeepEqual(expectRes, res) {
+		t.Errorf("Wrong match.\nexpect: %v\ngot: %v", expectRes, res)
+	}
+}
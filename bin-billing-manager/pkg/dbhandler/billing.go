@@ -322,6 +322,7 @@ func (h *handler) BillingUpdate(ctx context.Context, id uuid.UUID, fields map[bi
 }
 
 // BillingSetStatusEnd sets the billing status to end with ledger columns.
+// The billing_updated event is stored in the outbox within the same transaction.
 func (h *handler) BillingSetStatusEnd(ctx context.Context, id uuid.UUID, billableUnits int, usageDuration int, amountToken int64, amountCredit int64, balanceTokenSnapshot int64, balanceCreditSnapshot int64, tmBillingEnd *time.Time) error {
 	q := `
 	UPDATE billing_billings
@@ -338,10 +339,30 @@ func (h *handler) BillingSetStatusEnd(ctx context.Context, id uuid.UUID, billabl
 	WHERE
 		id = ?
 	`
-	_, err := h.db.Exec(q, billing.StatusEnd, billableUnits, usageDuration, amountToken, amountCredit, balanceTokenSnapshot, balanceCreditSnapshot, tmBillingEnd, h.utilHandler.TimeNow(), id.Bytes())
+
+	tx, err := h.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("could not begin transaction. BillingSetStatusEnd. err: %v", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	_, err = tx.ExecContext(ctx, q, billing.StatusEnd, billableUnits, usageDuration, amountToken, amountCredit, balanceTokenSnapshot, balanceCreditSnapshot, tmBillingEnd, h.utilHandler.TimeNow(), id.Bytes())
 	if err != nil {
 		return fmt.Errorf("could not execute. BillingSetStatusEnd. err: %v", err)
 	}
+
+	updated, err := h.billingTXGet(ctx, tx, id)
+	if err != nil {
+		return fmt.Errorf("could not read updated billing. BillingSetStatusEnd. err: %v", err)
+	}
+	if err := h.outboxHandler.TXPublishEvent(ctx, tx, billing.EventTypeBillingUpdated, updated); err != nil {
+		return fmt.Errorf("could not store the billing event. BillingSetStatusEnd. err: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("could not commit. BillingSetStatusEnd. err: %v", err)
+	}
+
 	_ = h.billingUpdateToCache(ctx, id)
 	return nil
 }
@@ -354,7 +375,8 @@ type DeductionResult struct {
 
 // CalculateTokenCreditDeduction computes how many tokens and credits to deduct
 // for a given billing operation using the cost type's mode.
-func CalculateTokenCreditDeduction(balanceToken int64, billableUnits int, costInfo billing.CostInfo) DeductionResult {
+// The billed duration is the billed seconds of the duration cost types, which the rate deck's billing increments are charged by.
+func CalculateTokenCreditDeduction(balanceToken int64, billableUnits int, billedDuration int, costInfo billing.CostInfo) DeductionResult {
 	if billableUnits <= 0 {
 		return DeductionResult{}
 	}
@@ -366,7 +388,7 @@ func CalculateTokenCreditDeduction(balanceToken int64, billableUnits int, costIn
 	case billing.CostModeCreditOnly:
 		return DeductionResult{
 			TokenDeducted:  0,
-			CreditDeducted: int64(billableUnits) * costInfo.CreditPerUnit,
+			CreditDeducted: costInfo.CalculateCredit(billableUnits, billedDuration),
 		}
 
 	case billing.CostModeTokenFirst:
@@ -389,6 +411,36 @@ func CalculateTokenCreditDeduction(balanceToken int64, billableUnits int, costIn
 
 // BillingConsumeAndRecord atomically deducts from account and records in billing ledger.
 func (h *handler) BillingConsumeAndRecord(ctx context.Context, bill *billing.Billing, accountID uuid.UUID, billableUnits int, usageDuration int, costInfo billing.CostInfo, tmBillingEnd *time.Time) (*billing.Billing, error) {
+	billedDuration := costInfo.CalculateBilledDuration(usageDuration)
+	deduct := func(balanceToken int64) DeductionResult {
+		return CalculateTokenCreditDeduction(balanceToken, billableUnits, billedDuration, costInfo)
+	}
+
+	return h.billingConsumeAndRecord(ctx, bill, accountID, billableUnits, usageDuration, billedDuration, costInfo, deduct, tmBillingEnd)
+}
+
+// BillingConsumeCreditAndRecord atomically deducts the given credit from account and records in billing ledger.
+// The credit is calculated by the caller. i.e. the ai usage priced by its components.
+func (h *handler) BillingConsumeCreditAndRecord(ctx context.Context, bill *billing.Billing, accountID uuid.UUID, billableUnits int, usageDuration int, credit int64, tmBillingEnd *time.Time) (*billing.Billing, error) {
+	deduct := func(int64) DeductionResult {
+		return DeductionResult{CreditDeducted: max(credit, 0)}
+	}
+
+	return h.billingConsumeAndRecord(ctx, bill, accountID, billableUnits, usageDuration, 0, billing.CostInfo{Mode: billing.CostModeCreditOnly}, deduct, tmBillingEnd)
+}
+
+// billingConsumeAndRecord atomically deducts the result of the given deduct from account and records in billing ledger.
+func (h *handler) billingConsumeAndRecord(
+	ctx context.Context,
+	bill *billing.Billing,
+	accountID uuid.UUID,
+	billableUnits int,
+	usageDuration int,
+	billedDuration int,
+	costInfo billing.CostInfo,
+	deduct func(balanceToken int64) DeductionResult,
+	tmBillingEnd *time.Time,
+) (*billing.Billing, error) {
 	tx, err := h.db.BeginTx(ctx, nil)
 	if err != nil {
 		return nil, fmt.Errorf("BillingConsumeAndRecord: could not begin transaction. err: %v", err)
@@ -408,7 +460,7 @@ func (h *handler) BillingConsumeAndRecord(ctx context.Context, bill *billing.Bil
 	}
 
 	// Calculate token and credit deductions
-	d := CalculateTokenCreditDeduction(balanceToken, billableUnits, costInfo)
+	d := deduct(balanceToken)
 	tokenDeducted := d.TokenDeducted
 	creditDeducted := d.CreditDeducted
 
@@ -434,6 +486,7 @@ func (h *handler) BillingConsumeAndRecord(ctx context.Context, bill *billing.Bil
 			status = ?,
 			usage_duration = ?,
 			billable_units = ?,
+			billed_duration = ?,
 			rate_token_per_unit = ?,
 			rate_credit_per_unit = ?,
 			amount_token = ?,
@@ -446,6 +499,7 @@ func (h *handler) BillingConsumeAndRecord(ctx context.Context, bill *billing.Bil
 		billing.StatusEnd,
 		usageDuration,
 		billableUnits,
+		billedDuration,
 		costInfo.TokenPerUnit,
 		costInfo.CreditPerUnit,
 		-tokenDeducted,   // Negative: usage deducts
@@ -459,6 +513,15 @@ func (h *handler) BillingConsumeAndRecord(ctx context.Context, bill *billing.Bil
 		return nil, fmt.Errorf("BillingConsumeAndRecord: could not update billing. err: %v", err)
 	}
 
+	// Store the billing_updated event in the outbox, so the ledger entry and its event are committed together
+	updated, err := h.billingTXGet(ctx, tx, bill.ID)
+	if err != nil {
+		return nil, fmt.Errorf("BillingConsumeAndRecord: could not read updated billing. err: %v", err)
+	}
+	if err := h.outboxHandler.TXPublishEvent(ctx, tx, billing.EventTypeBillingUpdated, updated); err != nil {
+		return nil, fmt.Errorf("BillingConsumeAndRecord: could not store the billing event. err: %v", err)
+	}
+
 	if err := tx.Commit(); err != nil {
 		return nil, fmt.Errorf("BillingConsumeAndRecord: could not commit. err: %v", err)
 	}
@@ -467,13 +530,37 @@ func (h *handler) BillingConsumeAndRecord(ctx context.Context, bill *billing.Bil
 	_ = h.accountUpdateToCache(ctx, accountID)
 	_ = h.billingUpdateToCache(ctx, bill.ID)
 
-	// Return updated billing
-	updated, err := h.BillingGet(ctx, bill.ID)
+	return updated, nil
+}
+
+// billingTXGet returns billing from the DB in a transaction mode.
+func (h *handler) billingTXGet(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*billing.Billing, error) {
+	cols := commondatabasehandler.GetDBFields(billing.Billing{})
+
+	query, args, err := sq.Select(cols...).
+		From(billingsTable).
+		Where(sq.Eq{"id": id.Bytes()}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("billingTXGet: could not build query. err: %v", err)
+	}
+
+	rows, err := tx.QueryContext(ctx, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("BillingConsumeAndRecord: could not re-read billing. err: %v", err)
+		return nil, fmt.Errorf("billingTXGet: could not query. err: %v", err)
 	}
+	defer func() { _ = rows.Close() }()
 
-	return updated, nil
+	if !rows.Next() {
+		return nil, ErrNotFound
+	}
+
+	res, err := h.billingGetFromRow(rows)
+	if err != nil {
+		return nil, fmt.Errorf("billingTXGet: could not scan row. err: %v", err)
+	}
+
+	return res, nil
 }
 
 // BillingSetStatus sets the billing status
@@ -623,3 +710,75 @@ func isDuplicateKeyError(err error) bool {
 	errMsg := err.Error()
 	return strings.Contains(errMsg, "Duplicate entry") || strings.Contains(errMsg, "UNIQUE constraint failed")
 }
+
+// BillingSumUsageCredit returns the sum of the credit spent by the account's usage billings started since the given time.
+// The empty cost type sums all cost types.
+func (h *handler) BillingSumUsageCredit(ctx context.Context, accountID uuid.UUID, costType billing.CostType, tmStart time.Time) (int64, error) {
+	builder := sq.Select("coalesce(sum(amount_credit), 0)").
+		From(billingsTable).
+		Where(sq.Eq{
+			"account_id":       accountID.Bytes(),
+			"transaction_type": billing.TransactionTypeUsage,
+			"tm_delete":        nil,
+		}).
+		Where(sq.GtOrEq{"tm_billing_start": tmStart})
+
+	if costType != billing.CostTypeNone {
+		builder = builder.Where(sq.Eq{"cost_type": costType})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("BillingSumUsageCredit: could not build query. err: %v", err)
+	}
+
+	var res int64
+	if errScan := h.db.QueryRowContext(ctx, query, args...).Scan(&res); errScan != nil {
+		return 0, fmt.Errorf("BillingSumUsageCredit: could not query. err: %v", errScan)
+	}
+
+	// the usage's amount is negative
+	return -res, nil
+}
+
+// BillingListProgressingUsage returns the account's progressing usage billings started since the given time.
+// The empty cost type lists all cost types.
+func (h *handler) BillingListProgressingUsage(ctx context.Context, accountID uuid.UUID, costType billing.CostType, tmStart time.Time) ([]*billing.Billing, error) {
+	cols := commondatabasehandler.GetDBFields(billing.Billing{})
+
+	builder := sq.Select(cols...).
+		From(billingsTable).
+		Where(sq.Eq{
+			"account_id":       accountID.Bytes(),
+			"transaction_type": billing.TransactionTypeUsage,
+			"status":           billing.StatusProgressing,
+			"tm_delete":        nil,
+		}).
+		Where(sq.GtOrEq{"tm_billing_start": tmStart})
+
+	if costType != billing.CostTypeNone {
+		builder = builder.Where(sq.Eq{"cost_type": costType})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("BillingListProgressingUsage: could not build query. err: %v", err)
+	}
+
+	rows, err := h.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("BillingListProgressingUsage: could not query. err: %v", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	res := []*billing.Billing{}
+	for rows.Next() {
+		b, err := h.billingGetFromRow(rows)
+		if err != nil {
+			return nil, fmt.Errorf("BillingListProgressingUsage: could not scan row. err: %v", err)
+		}
+		res = append(res, b)
+	}
+
+	return res, nil
+}
@@ -5,10 +5,13 @@ import (
 	"database/sql"
 	"fmt"
 	"reflect"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 
 	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/outboxhandler"
 	"monorepo/bin-common-handler/pkg/utilhandler"
 
 	"github.com/DATA-DOG/go-sqlmock"
@@ -335,11 +338,13 @@ func Test_BillingSetStatusEnd(t *testing.T) {
 
 			mockUtil := utilhandler.NewMockUtilHandler(mc)
 			mockCache := cachehandler.NewMockCacheHandler(mc)
+			mockOutbox := outboxhandler.NewMockOutboxHandler(mc)
 
 			h := &handler{
-				utilHandler: mockUtil,
-				db:          dbTest,
-				cache:       mockCache,
+				utilHandler:   mockUtil,
+				db:            dbTest,
+				cache:         mockCache,
+				outboxHandler: mockOutbox,
 			}
 			ctx := context.Background()
 
@@ -350,6 +355,7 @@ func Test_BillingSetStatusEnd(t *testing.T) {
 			}
 
 			mockUtil.EXPECT().TimeNow().Return(tt.responseCurTime)
+			mockOutbox.EXPECT().TXPublishEvent(ctx, gomock.Any(), billing.EventTypeBillingUpdated, tt.expectRes).Return(nil)
 			mockCache.EXPECT().BillingSet(ctx, gomock.Any())
 			if err := h.BillingSetStatusEnd(ctx, tt.id, tt.billableUnits, tt.usageDuration, tt.amountToken, tt.amountCredit, tt.balanceTokenSnapshot, tt.balanceCreditSnapshot, tt.timestamp); err != nil {
 				t.Errorf("Wrong match. expect: ok, got: %v", err)
@@ -912,3 +918,221 @@ func Test_AccountTopUpTokens_sequential_CAS(t *testing.T) {
 		t.Errorf("unfulfilled expectations: %v", err)
 	}
 }
+
+func Test_BillingSumUsageCredit(t *testing.T) {
+
+	type test struct {
+		name string
+
+		accountID uuid.UUID
+		costType  billing.CostType
+		tmStart   time.Time
+
+		expectRes int64
+	}
+
+	accountID := uuid.FromStringOrNil("3c1a7d2e-0b61-11f0-b0a4-5f2c8e9d1a01")
+	tmCreate := time.Date(2024, 3, 15, 12, 0, 0, 0, time.UTC)
+
+	billings := []struct {
+		id              uuid.UUID
+		transactionType billing.TransactionType
+		costType        billing.CostType
+		amountCredit    int64
+		tmBillingStart  time.Time
+	}{
+		{uuid.FromStringOrNil("3c4b2f10-0b61-11f0-9a3e-7f1d2c3b4a01"), billing.TransactionTypeUsage, billing.CostTypeCallPSTNOutgoing, -30000, time.Date(2024, 3, 15, 9, 0, 0, 0, time.UTC)},
+		{uuid.FromStringOrNil("3c4b2f10-0b61-11f0-9a3e-7f1d2c3b4a02"), billing.TransactionTypeUsage, billing.CostTypeSMS, -10000, time.Date(2024, 3, 15, 10, 0, 0, 0, time.UTC)},
+		{uuid.FromStringOrNil("3c4b2f10-0b61-11f0-9a3e-7f1d2c3b4a03"), billing.TransactionTypeUsage, billing.CostTypeCallPSTNOutgoing, -20000, time.Date(2024, 3, 14, 23, 0, 0, 0, time.UTC)},
+		{uuid.FromStringOrNil("3c4b2f10-0b61-11f0-9a3e-7f1d2c3b4a04"), billing.TransactionTypeTopUp, billing.CostTypeNone, 5000000, time.Date(2024, 3, 15, 11, 0, 0, 0, time.UTC)},
+	}
+
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockCache := cachehandler.NewMockCacheHandler(mc)
+	mockUtil := utilhandler.NewMockUtilHandler(mc)
+
+	h := handler{
+		utilHandler: mockUtil,
+		db:          dbTest,
+		cache:       mockCache,
+	}
+	ctx := context.Background()
+
+	for _, b := range billings {
+		tmBillingStart := b.tmBillingStart
+		mockUtil.EXPECT().TimeNow().Return(&tmCreate)
+		mockCache.EXPECT().BillingSet(ctx, gomock.Any())
+		if err := h.BillingCreate(ctx, &billing.Billing{
+			Identity: commonidentity.Identity{
+				ID: b.id,
+			},
+			AccountID:       accountID,
+			TransactionType: b.transactionType,
+			ReferenceType:   billing.ReferenceTypeCall,
+			ReferenceID:     b.id,
+			CostType:        b.costType,
+			AmountCredit:    b.amountCredit,
+			IdempotencyKey:  b.id,
+			TMBillingStart:  &tmBillingStart,
+		}); err != nil {
+			t.Errorf("Wrong match. expect: ok, got: %v", err)
+		}
+	}
+
+	tests := []test{
+		{
+			name: "all cost types of the day",
+
+			accountID: accountID,
+			costType:  billing.CostTypeNone,
+			tmStart:   time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC),
+
+			expectRes: 40000,
+		},
+		{
+			name: "cost type of the day",
+
+			accountID: accountID,
+			costType:  billing.CostTypeCallPSTNOutgoing,
+			tmStart:   time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC),
+
+			expectRes: 30000,
+		},
+		{
+			name: "cost type of the month",
+
+			accountID: accountID,
+			costType:  billing.CostTypeCallPSTNOutgoing,
+			tmStart:   time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+
+			expectRes: 50000,
+		},
+		{
+			name: "no billing",
+
+			accountID: uuid.FromStringOrNil("3c9e6b3a-0b61-11f0-8f2d-2b7c1e4d5a01"),
+			costType:  billing.CostTypeNone,
+			tmStart:   time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+
+			expectRes: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := h.BillingSumUsageCredit(ctx, tt.accountID, tt.costType, tt.tmStart)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if res != tt.expectRes {
+				t.Errorf("Wrong match. expect: %d, got: %d", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_BillingListProgressingUsage(t *testing.T) {
+
+	accountID := uuid.FromStringOrNil("7b2e4c10-aeb5-11f1-9d1a-2b3c4d5e6f01")
+	tmCreate := time.Date(2024, 3, 15, 12, 0, 0, 0, time.UTC)
+
+	billings := []struct {
+		id             uuid.UUID
+		status         billing.Status
+		costType       billing.CostType
+		tmBillingStart time.Time
+	}{
+		{uuid.FromStringOrNil("7b7f5d21-aeb5-11f1-ae2b-3c4d5e6f7a01"), billing.StatusProgressing, billing.CostTypeCallPSTNOutgoing, time.Date(2024, 3, 15, 11, 0, 0, 0, time.UTC)},
+		{uuid.FromStringOrNil("7b7f5d21-aeb5-11f1-ae2b-3c4d5e6f7a02"), billing.StatusEnd, billing.CostTypeCallPSTNOutgoing, time.Date(2024, 3, 15, 10, 0, 0, 0, time.UTC)},
+		{uuid.FromStringOrNil("7b7f5d21-aeb5-11f1-ae2b-3c4d5e6f7a03"), billing.StatusProgressing, billing.CostTypeRecording, time.Date(2024, 3, 15, 11, 30, 0, 0, time.UTC)},
+		{uuid.FromStringOrNil("7b7f5d21-aeb5-11f1-ae2b-3c4d5e6f7a04"), billing.StatusProgressing, billing.CostTypeCallPSTNOutgoing, time.Date(2024, 3, 14, 23, 0, 0, 0, time.UTC)},
+	}
+
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockCache := cachehandler.NewMockCacheHandler(mc)
+	mockUtil := utilhandler.NewMockUtilHandler(mc)
+
+	h := handler{
+		utilHandler: mockUtil,
+		db:          dbTest,
+		cache:       mockCache,
+	}
+	ctx := context.Background()
+
+	for _, b := range billings {
+		tmBillingStart := b.tmBillingStart
+		mockUtil.EXPECT().TimeNow().Return(&tmCreate)
+		mockCache.EXPECT().BillingSet(ctx, gomock.Any())
+		if err := h.BillingCreate(ctx, &billing.Billing{
+			Identity: commonidentity.Identity{
+				ID: b.id,
+			},
+			AccountID:       accountID,
+			TransactionType: billing.TransactionTypeUsage,
+			Status:          b.status,
+			ReferenceType:   billing.ReferenceTypeCall,
+			ReferenceID:     b.id,
+			CostType:        b.costType,
+			IdempotencyKey:  b.id,
+			TMBillingStart:  &tmBillingStart,
+		}); err != nil {
+			t.Errorf("Wrong match. expect: ok, got: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name string
+
+		costType billing.CostType
+		tmStart  time.Time
+
+		expectIDs []uuid.UUID
+	}{
+		{
+			name: "all cost types of the day",
+
+			costType: billing.CostTypeNone,
+			tmStart:  time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC),
+
+			expectIDs: []uuid.UUID{
+				uuid.FromStringOrNil("7b7f5d21-aeb5-11f1-ae2b-3c4d5e6f7a01"),
+				uuid.FromStringOrNil("7b7f5d21-aeb5-11f1-ae2b-3c4d5e6f7a03"),
+			},
+		},
+		{
+			name: "cost type of the month",
+
+			costType: billing.CostTypeCallPSTNOutgoing,
+			tmStart:  time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+
+			expectIDs: []uuid.UUID{
+				uuid.FromStringOrNil("7b7f5d21-aeb5-11f1-ae2b-3c4d5e6f7a01"),
+				uuid.FromStringOrNil("7b7f5d21-aeb5-11f1-ae2b-3c4d5e6f7a04"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := h.BillingListProgressingUsage(ctx, accountID, tt.costType, tt.tmStart)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			ids := []uuid.UUID{}
+			for _, b := range res {
+				ids = append(ids, b.ID)
+			}
+			slices.SortFunc(ids, func(a, b uuid.UUID) int { return strings.Compare(a.String(), b.String()) })
+
+			if !reflect.DeepEqual(ids, tt.expectIDs) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectIDs, ids)
+			}
+		})
+	}
+}
@@ -11,9 +11,10 @@ func Test_CalculateTokenCreditDeduction(t *testing.T) {
 	tests := []struct {
 		name string
 
-		balanceToken  int64
-		billableUnits int
-		costInfo      billing.CostInfo
+		balanceToken   int64
+		billableUnits  int
+		billedDuration int
+		costInfo       billing.CostInfo
 
 		expectTokenDeducted  int64
 		expectCreditDeducted int64
@@ -65,6 +66,23 @@ func Test_CalculateTokenCreditDeduction(t *testing.T) {
 			expectTokenDeducted:  0,
 			expectCreditDeducted: 12000,
 		},
+		{
+			name:                 "credit only - rate deck's connection fee",
+			balanceToken:         1000,
+			billableUnits:        2,
+			costInfo:             billing.CostInfo{Mode: billing.CostModeCreditOnly, CreditPerUnit: 6000, ConnectionFee: 500},
+			expectTokenDeducted:  0,
+			expectCreditDeducted: 12500,
+		},
+		{
+			name:                 "credit only - rate deck's 60/6 increments",
+			balanceToken:         1000,
+			billableUnits:        2,
+			billedDuration:       66,
+			costInfo:             billing.CostInfo{Mode: billing.CostModeCreditOnly, CreditPerUnit: 6000, IncrementInitial: 60, IncrementSubsequent: 6},
+			expectTokenDeducted:  0,
+			expectCreditDeducted: 6600,
+		},
 		{
 			name:                 "credit only - number purchase",
 			balanceToken:         500,
@@ -257,7 +275,7 @@ func Test_CalculateTokenCreditDeduction(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := CalculateTokenCreditDeduction(tt.balanceToken, tt.billableUnits, tt.costInfo)
+			result := CalculateTokenCreditDeduction(tt.balanceToken, tt.billableUnits, tt.billedDuration, tt.costInfo)
 
 			if result.TokenDeducted != tt.expectTokenDeducted {
 				t.Errorf("TokenDeducted = %d, expected %d", result.TokenDeducted, tt.expectTokenDeducted)
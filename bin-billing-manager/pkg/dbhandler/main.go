@@ -8,6 +8,7 @@ import (
 	"errors"
 	"time"
 
+	"monorepo/bin-common-handler/pkg/outboxhandler"
 	"monorepo/bin-common-handler/pkg/utilhandler"
 
 	"github.com/gofrs/uuid"
@@ -15,6 +16,9 @@ import (
 	"monorepo/bin-billing-manager/models/account"
 	"monorepo/bin-billing-manager/models/billing"
 	"monorepo/bin-billing-manager/models/failedevent"
+	"monorepo/bin-billing-manager/models/rate"
+	"monorepo/bin-billing-manager/models/ratedeck"
+	"monorepo/bin-billing-manager/models/statement"
 	"monorepo/bin-billing-manager/pkg/cachehandler"
 )
 
@@ -34,6 +38,8 @@ type DBHandler interface {
 	AccountSubtractTokensWithCheck(ctx context.Context, accountID uuid.UUID, amount int64) error
 	AccountDelete(ctx context.Context, id uuid.UUID) error
 	AccountSetStatus(ctx context.Context, id uuid.UUID, status account.Status) error
+	AccountSetAutoTopUpPending(ctx context.Context, id uuid.UUID, pendingID uuid.UUID, tmExpire *time.Time) (bool, error)
+	AccountClearAutoTopUpPending(ctx context.Context, id uuid.UUID) error
 
 	// Paddle query methods
 	AccountGetByPaddleSubscriptionID(ctx context.Context, paddleSubscriptionID string) (*account.Account, error)
@@ -53,8 +59,36 @@ type DBHandler interface {
 	BillingUpdate(ctx context.Context, id uuid.UUID, fields map[billing.Field]any) error
 	BillingSetStatusEnd(ctx context.Context, id uuid.UUID, billableUnits int, usageDuration int, amountToken int64, amountCredit int64, balanceTokenSnapshot int64, balanceCreditSnapshot int64, tmBillingEnd *time.Time) error
 	BillingConsumeAndRecord(ctx context.Context, bill *billing.Billing, accountID uuid.UUID, billableUnits int, usageDuration int, costInfo billing.CostInfo, tmBillingEnd *time.Time) (*billing.Billing, error)
+	BillingConsumeCreditAndRecord(ctx context.Context, bill *billing.Billing, accountID uuid.UUID, billableUnits int, usageDuration int, credit int64, tmBillingEnd *time.Time) (*billing.Billing, error)
 	BillingSetStatus(ctx context.Context, id uuid.UUID, status billing.Status) error
 	BillingDelete(ctx context.Context, id uuid.UUID) error
+	BillingSumUsageCredit(ctx context.Context, accountID uuid.UUID, costType billing.CostType, tmStart time.Time) (int64, error)
+	BillingListProgressingUsage(ctx context.Context, accountID uuid.UUID, costType billing.CostType, tmStart time.Time) ([]*billing.Billing, error)
+	BillingAggregateItems(ctx context.Context, accountID uuid.UUID, tmStart time.Time, tmEnd time.Time) ([]statement.Item, error)
+	BillingAggregateDestinations(ctx context.Context, accountID uuid.UUID, tmStart time.Time, tmEnd time.Time, limit uint64) ([]statement.Destination, error)
+	BillingListByPeriod(ctx context.Context, accountID uuid.UUID, transactionType billing.TransactionType, tmStart time.Time, tmEnd time.Time) ([]*billing.Billing, error)
+	BillingGetLastBefore(ctx context.Context, accountID uuid.UUID, tm time.Time) (*billing.Billing, error)
+
+	RateDeckCreate(ctx context.Context, c *ratedeck.RateDeck) error
+	RateDeckGet(ctx context.Context, id uuid.UUID) (*ratedeck.RateDeck, error)
+	RateDeckList(ctx context.Context, size uint64, token string, filters map[ratedeck.Field]any) ([]*ratedeck.RateDeck, error)
+	RateDeckUpdate(ctx context.Context, id uuid.UUID, fields map[ratedeck.Field]any) error
+	RateDeckDelete(ctx context.Context, id uuid.UUID) error
+
+	RateCreate(ctx context.Context, c *rate.Rate) error
+	RateCreateMany(ctx context.Context, rates []*rate.Rate) error
+	RateGet(ctx context.Context, id uuid.UUID) (*rate.Rate, error)
+	RateGetByDestination(ctx context.Context, rateDeckID uuid.UUID, costType billing.CostType, destination string, tm time.Time) (*rate.Rate, error)
+	RateGetMax(ctx context.Context, rateDeckID uuid.UUID, costType billing.CostType, tm time.Time) (*rate.Rate, error)
+	RateList(ctx context.Context, size uint64, token string, filters map[rate.Field]any) ([]*rate.Rate, error)
+	RateDelete(ctx context.Context, id uuid.UUID) error
+
+	StatementCreate(ctx context.Context, c *statement.Statement) error
+	StatementGet(ctx context.Context, id uuid.UUID) (*statement.Statement, error)
+	StatementList(ctx context.Context, size uint64, token string, filters map[statement.Field]any) ([]*statement.Statement, error)
+	StatementClaim(ctx context.Context, id uuid.UUID, staleBefore time.Time) (bool, error)
+	StatementFinish(ctx context.Context, c *statement.Statement) (bool, error)
+	StatementRelease(ctx context.Context, id uuid.UUID) error
 
 	FailedEventCreate(ctx context.Context, c *failedevent.FailedEvent) error
 	FailedEventListPendingRetry(ctx context.Context, now time.Time) ([]*failedevent.FailedEvent, error)
@@ -64,9 +98,10 @@ type DBHandler interface {
 
 // handler database handler
 type handler struct {
-	utilHandler utilhandler.UtilHandler
-	db          *sql.DB
-	cache       cachehandler.CacheHandler
+	utilHandler   utilhandler.UtilHandler
+	db            *sql.DB
+	cache         cachehandler.CacheHandler
+	outboxHandler outboxhandler.OutboxHandler
 }
 
 // handler errors
@@ -76,13 +111,16 @@ var (
 	ErrDuplicateKey        = errors.New("duplicate key")
 )
 
+// OutboxTable is the outbox table of the billing-manager.
+const OutboxTable = "billing_outbox_events"
 
 // NewHandler creates DBHandler
-func NewHandler(db *sql.DB, cache cachehandler.CacheHandler) DBHandler {
+func NewHandler(db *sql.DB, cache cachehandler.CacheHandler, outboxHandler outboxhandler.OutboxHandler) DBHandler {
 	h := &handler{
-		utilHandler: utilhandler.NewUtilHandler(),
-		db:          db,
-		cache:       cache,
+		utilHandler:   utilhandler.NewUtilHandler(),
+		db:            db,
+		cache:         cache,
+		outboxHandler: outboxHandler,
 	}
 	return h
 }
@@ -14,6 +14,9 @@ import (
 	account "monorepo/bin-billing-manager/models/account"
 	billing "monorepo/bin-billing-manager/models/billing"
 	failedevent "monorepo/bin-billing-manager/models/failedevent"
+	rate "monorepo/bin-billing-manager/models/rate"
+	ratedeck "monorepo/bin-billing-manager/models/ratedeck"
+	statement "monorepo/bin-billing-manager/models/statement"
 	reflect "reflect"
 	time "time"
 
@@ -73,6 +76,20 @@ func (mr *MockDBHandlerMockRecorder) AccountAddTokens(ctx, accountID, amount any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AccountAddTokens", reflect.TypeOf((*MockDBHandler)(nil).AccountAddTokens), ctx, accountID, amount)
 }
 
+// AccountClearAutoTopUpPending mocks base method.
+func (m *MockDBHandler) AccountClearAutoTopUpPending(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AccountClearAutoTopUpPending", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AccountClearAutoTopUpPending indicates an expected call of AccountClearAutoTopUpPending.
+func (mr *MockDBHandlerMockRecorder) AccountClearAutoTopUpPending(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AccountClearAutoTopUpPending", reflect.TypeOf((*MockDBHandler)(nil).AccountClearAutoTopUpPending), ctx, id)
+}
+
 // AccountCreate mocks base method.
 func (m *MockDBHandler) AccountCreate(ctx context.Context, c *account.Account) error {
 	m.ctrl.T.Helper()
@@ -203,6 +220,21 @@ func (mr *MockDBHandlerMockRecorder) AccountPaddleTopUpTokens(ctx, accountID, cu
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AccountPaddleTopUpTokens", reflect.TypeOf((*MockDBHandler)(nil).AccountPaddleTopUpTokens), ctx, accountID, customerID, tokenAmount, planType, txnType, idempotencyKey)
 }
 
+// AccountSetAutoTopUpPending mocks base method.
+func (m *MockDBHandler) AccountSetAutoTopUpPending(ctx context.Context, id, pendingID uuid.UUID, tmExpire *time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AccountSetAutoTopUpPending", ctx, id, pendingID, tmExpire)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AccountSetAutoTopUpPending indicates an expected call of AccountSetAutoTopUpPending.
+func (mr *MockDBHandlerMockRecorder) AccountSetAutoTopUpPending(ctx, id, pendingID, tmExpire any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AccountSetAutoTopUpPending", reflect.TypeOf((*MockDBHandler)(nil).AccountSetAutoTopUpPending), ctx, id, pendingID, tmExpire)
+}
+
 // AccountSetStatus mocks base method.
 func (m *MockDBHandler) AccountSetStatus(ctx context.Context, id uuid.UUID, status account.Status) error {
 	m.ctrl.T.Helper()
@@ -302,6 +334,36 @@ func (mr *MockDBHandlerMockRecorder) AccountUpdate(ctx, id, fields any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AccountUpdate", reflect.TypeOf((*MockDBHandler)(nil).AccountUpdate), ctx, id, fields)
 }
 
+// BillingAggregateDestinations mocks base method.
+func (m *MockDBHandler) BillingAggregateDestinations(ctx context.Context, accountID uuid.UUID, tmStart, tmEnd time.Time, limit uint64) ([]statement.Destination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BillingAggregateDestinations", ctx, accountID, tmStart, tmEnd, limit)
+	ret0, _ := ret[0].([]statement.Destination)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BillingAggregateDestinations indicates an expected call of BillingAggregateDestinations.
+func (mr *MockDBHandlerMockRecorder) BillingAggregateDestinations(ctx, accountID, tmStart, tmEnd, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BillingAggregateDestinations", reflect.TypeOf((*MockDBHandler)(nil).BillingAggregateDestinations), ctx, accountID, tmStart, tmEnd, limit)
+}
+
+// BillingAggregateItems mocks base method.
+func (m *MockDBHandler) BillingAggregateItems(ctx context.Context, accountID uuid.UUID, tmStart, tmEnd time.Time) ([]statement.Item, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BillingAggregateItems", ctx, accountID, tmStart, tmEnd)
+	ret0, _ := ret[0].([]statement.Item)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BillingAggregateItems indicates an expected call of BillingAggregateItems.
+func (mr *MockDBHandlerMockRecorder) BillingAggregateItems(ctx, accountID, tmStart, tmEnd any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BillingAggregateItems", reflect.TypeOf((*MockDBHandler)(nil).BillingAggregateItems), ctx, accountID, tmStart, tmEnd)
+}
+
 // BillingConsumeAndRecord mocks base method.
 func (m *MockDBHandler) BillingConsumeAndRecord(ctx context.Context, bill *billing.Billing, accountID uuid.UUID, billableUnits, usageDuration int, costInfo billing.CostInfo, tmBillingEnd *time.Time) (*billing.Billing, error) {
 	m.ctrl.T.Helper()
@@ -317,6 +379,21 @@ func (mr *MockDBHandlerMockRecorder) BillingConsumeAndRecord(ctx, bill, accountI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BillingConsumeAndRecord", reflect.TypeOf((*MockDBHandler)(nil).BillingConsumeAndRecord), ctx, bill, accountID, billableUnits, usageDuration, costInfo, tmBillingEnd)
 }
 
+// BillingConsumeCreditAndRecord mocks base method.
+func (m *MockDBHandler) BillingConsumeCreditAndRecord(ctx context.Context, bill *billing.Billing, accountID uuid.UUID, billableUnits, usageDuration int, credit int64, tmBillingEnd *time.Time) (*billing.Billing, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BillingConsumeCreditAndRecord", ctx, bill, accountID, billableUnits, usageDuration, credit, tmBillingEnd)
+	ret0, _ := ret[0].(*billing.Billing)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BillingConsumeCreditAndRecord indicates an expected call of BillingConsumeCreditAndRecord.
+func (mr *MockDBHandlerMockRecorder) BillingConsumeCreditAndRecord(ctx, bill, accountID, billableUnits, usageDuration, credit, tmBillingEnd any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BillingConsumeCreditAndRecord", reflect.TypeOf((*MockDBHandler)(nil).BillingConsumeCreditAndRecord), ctx, bill, accountID, billableUnits, usageDuration, credit, tmBillingEnd)
+}
+
 // BillingCreate mocks base method.
 func (m *MockDBHandler) BillingCreate(ctx context.Context, c *billing.Billing) error {
 	m.ctrl.T.Helper()
@@ -405,6 +482,21 @@ func (mr *MockDBHandlerMockRecorder) BillingGetByReferenceTypeAndID(ctx, referen
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BillingGetByReferenceTypeAndID", reflect.TypeOf((*MockDBHandler)(nil).BillingGetByReferenceTypeAndID), ctx, referenceType, referenceID)
 }
 
+// BillingGetLastBefore mocks base method.
+func (m *MockDBHandler) BillingGetLastBefore(ctx context.Context, accountID uuid.UUID, tm time.Time) (*billing.Billing, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BillingGetLastBefore", ctx, accountID, tm)
+	ret0, _ := ret[0].(*billing.Billing)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BillingGetLastBefore indicates an expected call of BillingGetLastBefore.
+func (mr *MockDBHandlerMockRecorder) BillingGetLastBefore(ctx, accountID, tm any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BillingGetLastBefore", reflect.TypeOf((*MockDBHandler)(nil).BillingGetLastBefore), ctx, accountID, tm)
+}
+
 // BillingList mocks base method.
 func (m *MockDBHandler) BillingList(ctx context.Context, size uint64, token string, filters map[billing.Field]any) ([]*billing.Billing, error) {
 	m.ctrl.T.Helper()
@@ -420,6 +512,36 @@ func (mr *MockDBHandlerMockRecorder) BillingList(ctx, size, token, filters any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BillingList", reflect.TypeOf((*MockDBHandler)(nil).BillingList), ctx, size, token, filters)
 }
 
+// BillingListByPeriod mocks base method.
+func (m *MockDBHandler) BillingListByPeriod(ctx context.Context, accountID uuid.UUID, transactionType billing.TransactionType, tmStart, tmEnd time.Time) ([]*billing.Billing, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BillingListByPeriod", ctx, accountID, transactionType, tmStart, tmEnd)
+	ret0, _ := ret[0].([]*billing.Billing)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BillingListByPeriod indicates an expected call of BillingListByPeriod.
+func (mr *MockDBHandlerMockRecorder) BillingListByPeriod(ctx, accountID, transactionType, tmStart, tmEnd any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BillingListByPeriod", reflect.TypeOf((*MockDBHandler)(nil).BillingListByPeriod), ctx, accountID, transactionType, tmStart, tmEnd)
+}
+
+// BillingListProgressingUsage mocks base method.
+func (m *MockDBHandler) BillingListProgressingUsage(ctx context.Context, accountID uuid.UUID, costType billing.CostType, tmStart time.Time) ([]*billing.Billing, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BillingListProgressingUsage", ctx, accountID, costType, tmStart)
+	ret0, _ := ret[0].([]*billing.Billing)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BillingListProgressingUsage indicates an expected call of BillingListProgressingUsage.
+func (mr *MockDBHandlerMockRecorder) BillingListProgressingUsage(ctx, accountID, costType, tmStart any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BillingListProgressingUsage", reflect.TypeOf((*MockDBHandler)(nil).BillingListProgressingUsage), ctx, accountID, costType, tmStart)
+}
+
 // BillingSetStatus mocks base method.
 func (m *MockDBHandler) BillingSetStatus(ctx context.Context, id uuid.UUID, status billing.Status) error {
 	m.ctrl.T.Helper()
@@ -448,6 +570,21 @@ func (mr *MockDBHandlerMockRecorder) BillingSetStatusEnd(ctx, id, billableUnits,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BillingSetStatusEnd", reflect.TypeOf((*MockDBHandler)(nil).BillingSetStatusEnd), ctx, id, billableUnits, usageDuration, amountToken, amountCredit, balanceTokenSnapshot, balanceCreditSnapshot, tmBillingEnd)
 }
 
+// BillingSumUsageCredit mocks base method.
+func (m *MockDBHandler) BillingSumUsageCredit(ctx context.Context, accountID uuid.UUID, costType billing.CostType, tmStart time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BillingSumUsageCredit", ctx, accountID, costType, tmStart)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BillingSumUsageCredit indicates an expected call of BillingSumUsageCredit.
+func (mr *MockDBHandlerMockRecorder) BillingSumUsageCredit(ctx, accountID, costType, tmStart any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BillingSumUsageCredit", reflect.TypeOf((*MockDBHandler)(nil).BillingSumUsageCredit), ctx, accountID, costType, tmStart)
+}
+
 // BillingUpdate mocks base method.
 func (m *MockDBHandler) BillingUpdate(ctx context.Context, id uuid.UUID, fields map[billing.Field]any) error {
 	m.ctrl.T.Helper()
@@ -518,3 +655,265 @@ func (mr *MockDBHandlerMockRecorder) FailedEventUpdate(ctx, id, fields any) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailedEventUpdate", reflect.TypeOf((*MockDBHandler)(nil).FailedEventUpdate), ctx, id, fields)
 }
+
+// RateCreate mocks base method.
+func (m *MockDBHandler) RateCreate(ctx context.Context, c *rate.Rate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RateCreate", ctx, c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RateCreate indicates an expected call of RateCreate.
+func (mr *MockDBHandlerMockRecorder) RateCreate(ctx, c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RateCreate", reflect.TypeOf((*MockDBHandler)(nil).RateCreate), ctx, c)
+}
+
+// RateCreateMany mocks base method.
+func (m *MockDBHandler) RateCreateMany(ctx context.Context, rates []*rate.Rate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RateCreateMany", ctx, rates)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RateCreateMany indicates an expected call of RateCreateMany.
+func (mr *MockDBHandlerMockRecorder) RateCreateMany(ctx, rates any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RateCreateMany", reflect.TypeOf((*MockDBHandler)(nil).RateCreateMany), ctx, rates)
+}
+
+// RateDeckCreate mocks base method.
+func (m *MockDBHandler) RateDeckCreate(ctx context.Context, c *ratedeck.RateDeck) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RateDeckCreate", ctx, c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RateDeckCreate indicates an expected call of RateDeckCreate.
+func (mr *MockDBHandlerMockRecorder) RateDeckCreate(ctx, c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RateDeckCreate", reflect.TypeOf((*MockDBHandler)(nil).RateDeckCreate), ctx, c)
+}
+
+// RateDeckDelete mocks base method.
+func (m *MockDBHandler) RateDeckDelete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RateDeckDelete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RateDeckDelete indicates an expected call of RateDeckDelete.
+func (mr *MockDBHandlerMockRecorder) RateDeckDelete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RateDeckDelete", reflect.TypeOf((*MockDBHandler)(nil).RateDeckDelete), ctx, id)
+}
+
+// RateDeckGet mocks base method.
+func (m *MockDBHandler) RateDeckGet(ctx context.Context, id uuid.UUID) (*ratedeck.RateDeck, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RateDeckGet", ctx, id)
+	ret0, _ := ret[0].(*ratedeck.RateDeck)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RateDeckGet indicates an expected call of RateDeckGet.
+func (mr *MockDBHandlerMockRecorder) RateDeckGet(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RateDeckGet", reflect.TypeOf((*MockDBHandler)(nil).RateDeckGet), ctx, id)
+}
+
+// RateDeckList mocks base method.
+func (m *MockDBHandler) RateDeckList(ctx context.Context, size uint64, token string, filters map[ratedeck.Field]any) ([]*ratedeck.RateDeck, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RateDeckList", ctx, size, token, filters)
+	ret0, _ := ret[0].([]*ratedeck.RateDeck)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RateDeckList indicates an expected call of RateDeckList.
+func (mr *MockDBHandlerMockRecorder) RateDeckList(ctx, size, token, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RateDeckList", reflect.TypeOf((*MockDBHandler)(nil).RateDeckList), ctx, size, token, filters)
+}
+
+// RateDeckUpdate mocks base method.
+func (m *MockDBHandler) RateDeckUpdate(ctx context.Context, id uuid.UUID, fields map[ratedeck.Field]any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RateDeckUpdate", ctx, id, fields)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RateDeckUpdate indicates an expected call of RateDeckUpdate.
+func (mr *MockDBHandlerMockRecorder) RateDeckUpdate(ctx, id, fields any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RateDeckUpdate", reflect.TypeOf((*MockDBHandler)(nil).RateDeckUpdate), ctx, id, fields)
+}
+
+// RateDelete mocks base method.
+func (m *MockDBHandler) RateDelete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RateDelete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RateDelete indicates an expected call of RateDelete.
+func (mr *MockDBHandlerMockRecorder) RateDelete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RateDelete", reflect.TypeOf((*MockDBHandler)(nil).RateDelete), ctx, id)
+}
+
+// RateGet mocks base method.
+func (m *MockDBHandler) RateGet(ctx context.Context, id uuid.UUID) (*rate.Rate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RateGet", ctx, id)
+	ret0, _ := ret[0].(*rate.Rate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RateGet indicates an expected call of RateGet.
+func (mr *MockDBHandlerMockRecorder) RateGet(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RateGet", reflect.TypeOf((*MockDBHandler)(nil).RateGet), ctx, id)
+}
+
+// RateGetByDestination mocks base method.
+func (m *MockDBHandler) RateGetByDestination(ctx context.Context, rateDeckID uuid.UUID, costType billing.CostType, destination string, tm time.Time) (*rate.Rate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RateGetByDestination", ctx, rateDeckID, costType, destination, tm)
+	ret0, _ := ret[0].(*rate.Rate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RateGetByDestination indicates an expected call of RateGetByDestination.
+func (mr *MockDBHandlerMockRecorder) RateGetByDestination(ctx, rateDeckID, costType, destination, tm any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RateGetByDestination", reflect.TypeOf((*MockDBHandler)(nil).RateGetByDestination), ctx, rateDeckID, costType, destination, tm)
+}
+
+// RateGetMax mocks base method.
+func (m *MockDBHandler) RateGetMax(ctx context.Context, rateDeckID uuid.UUID, costType billing.CostType, tm time.Time) (*rate.Rate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RateGetMax", ctx, rateDeckID, costType, tm)
+	ret0, _ := ret[0].(*rate.Rate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RateGetMax indicates an expected call of RateGetMax.
+func (mr *MockDBHandlerMockRecorder) RateGetMax(ctx, rateDeckID, costType, tm any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RateGetMax", reflect.TypeOf((*MockDBHandler)(nil).RateGetMax), ctx, rateDeckID, costType, tm)
+}
+
+// RateList mocks base method.
+func (m *MockDBHandler) RateList(ctx context.Context, size uint64, token string, filters map[rate.Field]any) ([]*rate.Rate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RateList", ctx, size, token, filters)
+	ret0, _ := ret[0].([]*rate.Rate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RateList indicates an expected call of RateList.
+func (mr *MockDBHandlerMockRecorder) RateList(ctx, size, token, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RateList", reflect.TypeOf((*MockDBHandler)(nil).RateList), ctx, size, token, filters)
+}
+
+// StatementClaim mocks base method.
+func (m *MockDBHandler) StatementClaim(ctx context.Context, id uuid.UUID, staleBefore time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StatementClaim", ctx, id, staleBefore)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StatementClaim indicates an expected call of StatementClaim.
+func (mr *MockDBHandlerMockRecorder) StatementClaim(ctx, id, staleBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StatementClaim", reflect.TypeOf((*MockDBHandler)(nil).StatementClaim), ctx, id, staleBefore)
+}
+
+// StatementCreate mocks base method.
+func (m *MockDBHandler) StatementCreate(ctx context.Context, c *statement.Statement) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StatementCreate", ctx, c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StatementCreate indicates an expected call of StatementCreate.
+func (mr *MockDBHandlerMockRecorder) StatementCreate(ctx, c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StatementCreate", reflect.TypeOf((*MockDBHandler)(nil).StatementCreate), ctx, c)
+}
+
+// StatementFinish mocks base method.
+func (m *MockDBHandler) StatementFinish(ctx context.Context, c *statement.Statement) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StatementFinish", ctx, c)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StatementFinish indicates an expected call of StatementFinish.
+func (mr *MockDBHandlerMockRecorder) StatementFinish(ctx, c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StatementFinish", reflect.TypeOf((*MockDBHandler)(nil).StatementFinish), ctx, c)
+}
+
+// StatementGet mocks base method.
+func (m *MockDBHandler) StatementGet(ctx context.Context, id uuid.UUID) (*statement.Statement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StatementGet", ctx, id)
+	ret0, _ := ret[0].(*statement.Statement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StatementGet indicates an expected call of StatementGet.
+func (mr *MockDBHandlerMockRecorder) StatementGet(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StatementGet", reflect.TypeOf((*MockDBHandler)(nil).StatementGet), ctx, id)
+}
+
+// StatementList mocks base method.
+func (m *MockDBHandler) StatementList(ctx context.Context, size uint64, token string, filters map[statement.Field]any) ([]*statement.Statement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StatementList", ctx, size, token, filters)
+	ret0, _ := ret[0].([]*statement.Statement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StatementList indicates an expected call of StatementList.
+func (mr *MockDBHandlerMockRecorder) StatementList(ctx, size, token, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StatementList", reflect.TypeOf((*MockDBHandler)(nil).StatementList), ctx, size, token, filters)
+}
+
+// StatementRelease mocks base method.
+func (m *MockDBHandler) StatementRelease(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StatementRelease", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StatementRelease indicates an expected call of StatementRelease.
+func (mr *MockDBHandlerMockRecorder) StatementRelease(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StatementRelease", reflect.TypeOf((*MockDBHandler)(nil).StatementRelease), ctx, id)
+}
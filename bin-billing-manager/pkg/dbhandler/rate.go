@@ -0,0 +1,258 @@
+package dbhandler
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/gofrs/uuid"
+
+	commondatabasehandler "monorepo/bin-common-handler/pkg/databasehandler"
+
+	"monorepo/bin-billing-manager/models/billing"
+	"monorepo/bin-billing-manager/models/rate"
+)
+
+const (
+	ratesTable = "billing_rates"
+)
+
+// rateGetFromRow gets the rate from the row.
+func (h *handler) rateGetFromRow(row *sql.Rows) (*rate.Rate, error) {
+	res := &rate.Rate{}
+
+	if err := commondatabasehandler.ScanRow(row, res); err != nil {
+		return nil, fmt.Errorf("could not scan the row. rateGetFromRow. err: %v", err)
+	}
+
+	return res, nil
+}
+
+// RateCreate creates new rate record.
+func (h *handler) RateCreate(ctx context.Context, c *rate.Rate) error {
+	return h.RateCreateMany(ctx, []*rate.Rate{c})
+}
+
+// RateCreateMany creates the given rate records in a transaction.
+// None of the rates is created if any of them fails.
+func (h *handler) RateCreateMany(ctx context.Context, rates []*rate.Rate) error {
+	tx, err := h.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("RateCreateMany: could not begin transaction. err: %v", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	now := h.utilHandler.TimeNow()
+	for _, c := range rates {
+		c.TMCreate = now
+		c.TMUpdate = nil
+		c.TMDelete = nil
+
+		fields, err := commondatabasehandler.PrepareFields(c)
+		if err != nil {
+			return fmt.Errorf("RateCreateMany: could not prepare fields. err: %v", err)
+		}
+
+		query, args, err := sq.Insert(ratesTable).SetMap(fields).ToSql()
+		if err != nil {
+			return fmt.Errorf("RateCreateMany: could not build query. err: %v", err)
+		}
+
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("RateCreateMany: could not execute query. err: %v", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("RateCreateMany: could not commit. err: %v", err)
+	}
+
+	return nil
+}
+
+// RateGet returns rate.
+func (h *handler) RateGet(ctx context.Context, id uuid.UUID) (*rate.Rate, error) {
+	cols := commondatabasehandler.GetDBFields(rate.Rate{})
+
+	query, args, err := sq.Select(cols...).
+		From(ratesTable).
+		Where(sq.Eq{"id": id.Bytes()}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("RateGet: could not build query. err: %v", err)
+	}
+
+	rows, err := h.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("RateGet: could not query. err: %v", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	if !rows.Next() {
+		return nil, ErrNotFound
+	}
+
+	res, err := h.rateGetFromRow(rows)
+	if err != nil {
+		return nil, fmt.Errorf("RateGet: could not scan row. err: %v", err)
+	}
+
+	return res, nil
+}
+
+// RateList returns a list of rates.
+func (h *handler) RateList(ctx context.Context, size uint64, token string, filters map[rate.Field]any) ([]*rate.Rate, error) {
+	if token == "" {
+		token = h.utilHandler.TimeGetCurTime()
+	}
+
+	cols := commondatabasehandler.GetDBFields(rate.Rate{})
+
+	builder := sq.Select(cols...).
+		From(ratesTable).
+		Where(sq.Lt{"tm_create": token}).
+		OrderBy("tm_create desc").
+		Limit(size)
+
+	builder, err := commondatabasehandler.ApplyFields(builder, filters)
+	if err != nil {
+		return nil, fmt.Errorf("RateList: could not apply filters. err: %v", err)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("RateList: could not build query. err: %v", err)
+	}
+
+	rows, err := h.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("RateList: could not query. err: %v", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	res := []*rate.Rate{}
+	for rows.Next() {
+		u, err := h.rateGetFromRow(rows)
+		if err != nil {
+			return nil, fmt.Errorf("RateList: could not scan row. err: %v", err)
+		}
+		res = append(res, u)
+	}
+
+	return res, nil
+}
+
+// RateGetByDestination returns the rate deck's rate of the longest prefix matching the given destination number.
+// Only the rate effective at the given time is returned. Among the rates of the same prefix,
+// the rate of the latest effective start wins.
+func (h *handler) RateGetByDestination(ctx context.Context, rateDeckID uuid.UUID, costType billing.CostType, destination string, tm time.Time) (*rate.Rate, error) {
+	prefixes := make([]string, 0, len(destination)+1)
+	for i := 0; i <= len(destination); i++ {
+		prefixes = append(prefixes, destination[:i])
+	}
+
+	cols := commondatabasehandler.GetDBFields(rate.Rate{})
+
+	query, args, err := sq.Select(cols...).
+		From(ratesTable).
+		Where(sq.Eq{
+			"rate_deck_id": rateDeckID.Bytes(),
+			"cost_type":    string(costType),
+			"prefix":       prefixes,
+			"tm_delete":    nil,
+		}).
+		Where(sq.LtOrEq{"tm_effective_start": tm}).
+		Where(sq.Or{
+			sq.Eq{"tm_effective_end": nil},
+			sq.Gt{"tm_effective_end": tm},
+		}).
+		OrderBy("length(prefix) desc", "tm_effective_start desc").
+		Limit(1).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("RateGetByDestination: could not build query. err: %v", err)
+	}
+
+	rows, err := h.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("RateGetByDestination: could not query. err: %v", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	if !rows.Next() {
+		return nil, ErrNotFound
+	}
+
+	res, err := h.rateGetFromRow(rows)
+	if err != nil {
+		return nil, fmt.Errorf("RateGetByDestination: could not scan row. err: %v", err)
+	}
+
+	return res, nil
+}
+
+// RateGetMax returns the rate deck's most expensive rate of the given cost type effective at the given time.
+func (h *handler) RateGetMax(ctx context.Context, rateDeckID uuid.UUID, costType billing.CostType, tm time.Time) (*rate.Rate, error) {
+	cols := commondatabasehandler.GetDBFields(rate.Rate{})
+
+	query, args, err := sq.Select(cols...).
+		From(ratesTable).
+		Where(sq.Eq{
+			"rate_deck_id": rateDeckID.Bytes(),
+			"cost_type":    string(costType),
+			"tm_delete":    nil,
+		}).
+		Where(sq.LtOrEq{"tm_effective_start": tm}).
+		Where(sq.Or{
+			sq.Eq{"tm_effective_end": nil},
+			sq.Gt{"tm_effective_end": tm},
+		}).
+		OrderBy("credit_per_unit + connection_fee desc").
+		Limit(1).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("RateGetMax: could not build query. err: %v", err)
+	}
+
+	rows, err := h.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("RateGetMax: could not query. err: %v", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	if !rows.Next() {
+		return nil, ErrNotFound
+	}
+
+	res, err := h.rateGetFromRow(rows)
+	if err != nil {
+		return nil, fmt.Errorf("RateGetMax: could not scan row. err: %v", err)
+	}
+
+	return res, nil
+}
+
+// RateDelete deletes the rate.
+func (h *handler) RateDelete(ctx context.Context, id uuid.UUID) error {
+	ts := h.utilHandler.TimeNow()
+
+	query, args, err := sq.Update(ratesTable).
+		SetMap(map[string]any{
+			"tm_update": ts,
+			"tm_delete": ts,
+		}).
+		Where(sq.Eq{"id": id.Bytes()}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("RateDelete: could not build query. err: %v", err)
+	}
+
+	_, err = h.db.Exec(query, args...)
+	if err != nil {
+		return fmt.Errorf("RateDelete: could not execute. err: %v", err)
+	}
+
+	return nil
+}
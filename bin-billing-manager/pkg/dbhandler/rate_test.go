@@ -0,0 +1,411 @@
+package dbhandler
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"monorepo/bin-common-handler/pkg/utilhandler"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	"monorepo/bin-billing-manager/models/billing"
+	"monorepo/bin-billing-manager/models/rate"
+	"monorepo/bin-billing-manager/pkg/cachehandler"
+)
+
+func Test_RateCreate(t *testing.T) {
+
+	type test struct {
+		name string
+
+		rate *rate.Rate
+
+		responseCurTime *time.Time
+		expectRes       *rate.Rate
+	}
+
+	tmCreate := time.Date(2026, 10, 19, 3, 22, 17, 995000000, time.UTC)
+	tmEffectiveStart := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	tmEffectiveEnd := time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []test{
+		{
+			name: "have all fields",
+
+			rate: &rate.Rate{
+				ID:                  uuid.FromStringOrNil("3b5d7f91-ad9d-11f1-8c1d-2e3f4a5b6c7d"),
+				RateDeckID:          uuid.FromStringOrNil("3b9e1a2c-ad9d-11f1-9d2e-3f4a5b6c7d8e"),
+				CostType:            billing.CostTypeCallPSTNOutgoing,
+				Prefix:              "8210",
+				CreditPerUnit:       25000,
+				ConnectionFee:       1000,
+				IncrementInitial:    60,
+				IncrementSubsequent: 6,
+				TMEffectiveStart:    &tmEffectiveStart,
+				TMEffectiveEnd:      &tmEffectiveEnd,
+			},
+
+			responseCurTime: &tmCreate,
+			expectRes: &rate.Rate{
+				ID:                  uuid.FromStringOrNil("3b5d7f91-ad9d-11f1-8c1d-2e3f4a5b6c7d"),
+				RateDeckID:          uuid.FromStringOrNil("3b9e1a2c-ad9d-11f1-9d2e-3f4a5b6c7d8e"),
+				CostType:            billing.CostTypeCallPSTNOutgoing,
+				Prefix:              "8210",
+				CreditPerUnit:       25000,
+				ConnectionFee:       1000,
+				IncrementInitial:    60,
+				IncrementSubsequent: 6,
+				TMEffectiveStart:    &tmEffectiveStart,
+				TMEffectiveEnd:      &tmEffectiveEnd,
+				TMCreate:            &tmCreate,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockCache := cachehandler.NewMockCacheHandler(mc)
+			h := handler{
+				utilHandler: mockUtil,
+				db:          dbTest,
+				cache:       mockCache,
+			}
+			ctx := context.Background()
+
+			mockUtil.EXPECT().TimeNow().Return(tt.responseCurTime)
+			if err := h.RateCreate(ctx, tt.rate); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			res, err := h.RateGet(ctx, tt.rate.ID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(tt.expectRes, res) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_RateGetByDestination(t *testing.T) {
+
+	tmCreate := time.Date(2026, 10, 19, 3, 22, 17, 995000000, time.UTC)
+	tmOld := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	tmNew := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	tmFuture := time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	rateDeckID := uuid.FromStringOrNil("8d1f3b5d-ad9d-11f1-a3e4-5f6a7b8c9d0e")
+	rates := []*rate.Rate{
+		{
+			ID:               uuid.FromStringOrNil("8d6a2c4e-ad9d-11f1-b4f5-6a7b8c9d0e1f"),
+			RateDeckID:       rateDeckID,
+			CostType:         billing.CostTypeCallPSTNOutgoing,
+			Prefix:           "",
+			CreditPerUnit:    30000,
+			TMEffectiveStart: &tmOld,
+		},
+		{
+			ID:               uuid.FromStringOrNil("8db53d5f-ad9d-11f1-8506-7b8c9d0e1f2a"),
+			RateDeckID:       rateDeckID,
+			CostType:         billing.CostTypeCallPSTNOutgoing,
+			Prefix:           "82",
+			CreditPerUnit:    20000,
+			TMEffectiveStart: &tmOld,
+		},
+		{
+			ID:               uuid.FromStringOrNil("8e004e6a-ad9d-11f1-9617-8c9d0e1f2a3b"),
+			RateDeckID:       rateDeckID,
+			CostType:         billing.CostTypeCallPSTNOutgoing,
+			Prefix:           "8210",
+			CreditPerUnit:    25000,
+			TMEffectiveStart: &tmOld,
+			TMEffectiveEnd:   &tmNew,
+		},
+		{
+			ID:               uuid.FromStringOrNil("8e4b5f7b-ad9d-11f1-a728-9d0e1f2a3b4c"),
+			RateDeckID:       rateDeckID,
+			CostType:         billing.CostTypeCallPSTNOutgoing,
+			Prefix:           "8210",
+			CreditPerUnit:    22000,
+			TMEffectiveStart: &tmNew,
+		},
+		{
+			ID:               uuid.FromStringOrNil("8e96608c-ad9d-11f1-b839-0e1f2a3b4c5d"),
+			RateDeckID:       rateDeckID,
+			CostType:         billing.CostTypeCallPSTNOutgoing,
+			Prefix:           "8210",
+			CreditPerUnit:    19000,
+			TMEffectiveStart: &tmFuture,
+		},
+		{
+			ID:               uuid.FromStringOrNil("8ee1719d-ad9d-11f1-894a-1f2a3b4c5d6e"),
+			RateDeckID:       rateDeckID,
+			CostType:         billing.CostTypeSMS,
+			Prefix:           "1",
+			CreditPerUnit:    8000,
+			TMEffectiveStart: &tmOld,
+		},
+	}
+
+	tests := []struct {
+		name string
+
+		costType    billing.CostType
+		destination string
+		tm          time.Time
+
+		expectRateID uuid.UUID
+	}{
+		{
+			name: "longest prefix",
+
+			costType:    billing.CostTypeCallPSTNOutgoing,
+			destination: "821012345678",
+			tm:          time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC),
+
+			expectRateID: uuid.FromStringOrNil("8e4b5f7b-ad9d-11f1-a728-9d0e1f2a3b4c"),
+		},
+		{
+			name: "longest prefix before the newer rate was effective",
+
+			costType:    billing.CostTypeCallPSTNOutgoing,
+			destination: "821012345678",
+			tm:          time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC),
+
+			expectRateID: uuid.FromStringOrNil("8e004e6a-ad9d-11f1-9617-8c9d0e1f2a3b"),
+		},
+		{
+			name: "shorter prefix",
+
+			costType:    billing.CostTypeCallPSTNOutgoing,
+			destination: "82212345678",
+			tm:          time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC),
+
+			expectRateID: uuid.FromStringOrNil("8db53d5f-ad9d-11f1-8506-7b8c9d0e1f2a"),
+		},
+		{
+			name: "empty prefix matches every destination",
+
+			costType:    billing.CostTypeCallPSTNOutgoing,
+			destination: "15551234567",
+			tm:          time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC),
+
+			expectRateID: uuid.FromStringOrNil("8d6a2c4e-ad9d-11f1-b4f5-6a7b8c9d0e1f"),
+		},
+		{
+			name: "cost type",
+
+			costType:    billing.CostTypeSMS,
+			destination: "15551234567",
+			tm:          time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC),
+
+			expectRateID: uuid.FromStringOrNil("8ee1719d-ad9d-11f1-894a-1f2a3b4c5d6e"),
+		},
+	}
+
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockUtil := utilhandler.NewMockUtilHandler(mc)
+	mockCache := cachehandler.NewMockCacheHandler(mc)
+	h := handler{
+		utilHandler: mockUtil,
+		db:          dbTest,
+		cache:       mockCache,
+	}
+	ctx := context.Background()
+
+	mockUtil.EXPECT().TimeNow().Return(&tmCreate)
+	if err := h.RateCreateMany(ctx, rates); err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := h.RateGetByDestination(ctx, rateDeckID, tt.costType, tt.destination, tt.tm)
+			if err != nil {
+				t.Fatalf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if res.ID != tt.expectRateID {
+				t.Errorf("Wrong match. expect: %s, got: %s", tt.expectRateID, res.ID)
+			}
+		})
+	}
+
+	t.Run("no matching rate", func(t *testing.T) {
+		_, err := h.RateGetByDestination(ctx, rateDeckID, billing.CostTypeCallPSTNIncoming, "821012345678", time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC))
+		if err != ErrNotFound {
+			t.Errorf("Wrong match. expect: %v, got: %v", ErrNotFound, err)
+		}
+	})
+}
+
+func Test_RateGetMax(t *testing.T) {
+
+	tmCreate := time.Date(2026, 10, 19, 3, 22, 17, 995000000, time.UTC)
+	tmOld := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	tmNew := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	rateDeckID := uuid.FromStringOrNil("3a1c6f0e-aeb2-11f1-8c2d-4e5f6a7b8c9d")
+	rates := []*rate.Rate{
+		{
+			ID:               uuid.FromStringOrNil("3a6d7e1f-aeb2-11f1-9d3e-5f6a7b8c9d0e"),
+			RateDeckID:       rateDeckID,
+			CostType:         billing.CostTypeCallPSTNOutgoing,
+			Prefix:           "",
+			CreditPerUnit:    30000,
+			TMEffectiveStart: &tmOld,
+		},
+		{
+			ID:               uuid.FromStringOrNil("3abe8f2a-aeb2-11f1-ae4f-6a7b8c9d0e1f"),
+			RateDeckID:       rateDeckID,
+			CostType:         billing.CostTypeCallPSTNOutgoing,
+			Prefix:           "882",
+			CreditPerUnit:    900000,
+			TMEffectiveStart: &tmOld,
+			TMEffectiveEnd:   &tmNew,
+		},
+		{
+			ID:               uuid.FromStringOrNil("3b0fa03b-aeb2-11f1-bf5a-7b8c9d0e1f2a"),
+			RateDeckID:       rateDeckID,
+			CostType:         billing.CostTypeCallPSTNOutgoing,
+			Prefix:           "44",
+			CreditPerUnit:    20000,
+			ConnectionFee:    15000,
+			TMEffectiveStart: &tmOld,
+		},
+		{
+			ID:               uuid.FromStringOrNil("3b60b14c-aeb2-11f1-8a6b-8c9d0e1f2a3b"),
+			RateDeckID:       rateDeckID,
+			CostType:         billing.CostTypeSMS,
+			Prefix:           "1",
+			CreditPerUnit:    8000,
+			TMEffectiveStart: &tmOld,
+		},
+	}
+
+	tests := []struct {
+		name string
+
+		costType billing.CostType
+		tm       time.Time
+
+		expectRateID uuid.UUID
+	}{
+		{
+			name: "most expensive rate including the connection fee",
+
+			costType: billing.CostTypeCallPSTNOutgoing,
+			tm:       time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC),
+
+			expectRateID: uuid.FromStringOrNil("3b0fa03b-aeb2-11f1-bf5a-7b8c9d0e1f2a"),
+		},
+		{
+			name: "most expensive rate effective at the time",
+
+			costType: billing.CostTypeCallPSTNOutgoing,
+			tm:       time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC),
+
+			expectRateID: uuid.FromStringOrNil("3abe8f2a-aeb2-11f1-ae4f-6a7b8c9d0e1f"),
+		},
+		{
+			name: "cost type",
+
+			costType: billing.CostTypeSMS,
+			tm:       time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC),
+
+			expectRateID: uuid.FromStringOrNil("3b60b14c-aeb2-11f1-8a6b-8c9d0e1f2a3b"),
+		},
+	}
+
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockUtil := utilhandler.NewMockUtilHandler(mc)
+	mockCache := cachehandler.NewMockCacheHandler(mc)
+	h := handler{
+		utilHandler: mockUtil,
+		db:          dbTest,
+		cache:       mockCache,
+	}
+	ctx := context.Background()
+
+	mockUtil.EXPECT().TimeNow().Return(&tmCreate)
+	if err := h.RateCreateMany(ctx, rates); err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := h.RateGetMax(ctx, rateDeckID, tt.costType, tt.tm)
+			if err != nil {
+				t.Fatalf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if res.ID != tt.expectRateID {
+				t.Errorf("Wrong match. expect: %s, got: %s", tt.expectRateID, res.ID)
+			}
+		})
+	}
+
+	t.Run("no rate of the cost type", func(t *testing.T) {
+		_, err := h.RateGetMax(ctx, rateDeckID, billing.CostTypeCallPSTNIncoming, time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC))
+		if err != ErrNotFound {
+			t.Errorf("Wrong match. expect: %v, got: %v", ErrNotFound, err)
+		}
+	})
+}
+
+func Test_RateDelete(t *testing.T) {
+
+	tmCreate := time.Date(2026, 10, 19, 3, 22, 17, 995000000, time.UTC)
+	tmDelete := time.Date(2026, 10, 19, 3, 23, 17, 995000000, time.UTC)
+	tmEffectiveStart := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	r := &rate.Rate{
+		ID:               uuid.FromStringOrNil("c41a6e8a-ad9d-11f1-9a5b-2a3b4c5d6e7f"),
+		RateDeckID:       uuid.FromStringOrNil("c4653f9b-ad9d-11f1-ab6c-3b4c5d6e7f8a"),
+		CostType:         billing.CostTypeCallPSTNOutgoing,
+		Prefix:           "44",
+		CreditPerUnit:    15000,
+		TMEffectiveStart: &tmEffectiveStart,
+	}
+
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockUtil := utilhandler.NewMockUtilHandler(mc)
+	mockCache := cachehandler.NewMockCacheHandler(mc)
+	h := handler{
+		utilHandler: mockUtil,
+		db:          dbTest,
+		cache:       mockCache,
+	}
+	ctx := context.Background()
+
+	mockUtil.EXPECT().TimeNow().Return(&tmCreate)
+	if err := h.RateCreate(ctx, r); err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+
+	mockUtil.EXPECT().TimeNow().Return(&tmDelete)
+	if err := h.RateDelete(ctx, r.ID); err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+
+	// the deleted rate is not applied
+	_, err := h.RateGetByDestination(ctx, r.RateDeckID, r.CostType, "447700900123", time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC))
+	if err != ErrNotFound {
+		t.Errorf("Wrong match. expect: %v, got: %v", ErrNotFound, err)
+	}
+}
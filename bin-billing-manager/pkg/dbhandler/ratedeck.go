@@ -0,0 +1,165 @@
+package dbhandler
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/gofrs/uuid"
+
+	commondatabasehandler "monorepo/bin-common-handler/pkg/databasehandler"
+
+	"monorepo/bin-billing-manager/models/ratedeck"
+)
+
+const (
+	rateDecksTable = "billing_rate_decks"
+)
+
+// rateDeckGetFromRow gets the rate deck from the row.
+func (h *handler) rateDeckGetFromRow(row *sql.Rows) (*ratedeck.RateDeck, error) {
+	res := &ratedeck.RateDeck{}
+
+	if err := commondatabasehandler.ScanRow(row, res); err != nil {
+		return nil, fmt.Errorf("could not scan the row. rateDeckGetFromRow. err: %v", err)
+	}
+
+	return res, nil
+}
+
+// RateDeckCreate creates new rate deck record.
+func (h *handler) RateDeckCreate(ctx context.Context, c *ratedeck.RateDeck) error {
+	c.TMCreate = h.utilHandler.TimeNow()
+	c.TMUpdate = nil
+	c.TMDelete = nil
+
+	fields, err := commondatabasehandler.PrepareFields(c)
+	if err != nil {
+		return fmt.Errorf("RateDeckCreate: could not prepare fields. err: %v", err)
+	}
+
+	query, args, err := sq.Insert(rateDecksTable).SetMap(fields).ToSql()
+	if err != nil {
+		return fmt.Errorf("RateDeckCreate: could not build query. err: %v", err)
+	}
+
+	_, err = h.db.Exec(query, args...)
+	if err != nil {
+		return fmt.Errorf("RateDeckCreate: could not execute query. err: %v", err)
+	}
+
+	return nil
+}
+
+// RateDeckGet returns rate deck.
+func (h *handler) RateDeckGet(ctx context.Context, id uuid.UUID) (*ratedeck.RateDeck, error) {
+	cols := commondatabasehandler.GetDBFields(ratedeck.RateDeck{})
+
+	query, args, err := sq.Select(cols...).
+		From(rateDecksTable).
+		Where(sq.Eq{"id": id.Bytes()}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("RateDeckGet: could not build query. err: %v", err)
+	}
+
+	rows, err := h.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("RateDeckGet: could not query. err: %v", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	if !rows.Next() {
+		return nil, ErrNotFound
+	}
+
+	res, err := h.rateDeckGetFromRow(rows)
+	if err != nil {
+		return nil, fmt.Errorf("RateDeckGet: could not scan row. err: %v", err)
+	}
+
+	return res, nil
+}
+
+// RateDeckList returns a list of rate decks.
+func (h *handler) RateDeckList(ctx context.Context, size uint64, token string, filters map[ratedeck.Field]any) ([]*ratedeck.RateDeck, error) {
+	if token == "" {
+		token = h.utilHandler.TimeGetCurTime()
+	}
+
+	cols := commondatabasehandler.GetDBFields(ratedeck.RateDeck{})
+
+	builder := sq.Select(cols...).
+		From(rateDecksTable).
+		Where(sq.Lt{"tm_create": token}).
+		OrderBy("tm_create desc").
+		Limit(size)
+
+	builder, err := commondatabasehandler.ApplyFields(builder, filters)
+	if err != nil {
+		return nil, fmt.Errorf("RateDeckList: could not apply filters. err: %v", err)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("RateDeckList: could not build query. err: %v", err)
+	}
+
+	rows, err := h.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("RateDeckList: could not query. err: %v", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	res := []*ratedeck.RateDeck{}
+	for rows.Next() {
+		u, err := h.rateDeckGetFromRow(rows)
+		if err != nil {
+			return nil, fmt.Errorf("RateDeckList: could not scan row. err: %v", err)
+		}
+		res = append(res, u)
+	}
+
+	return res, nil
+}
+
+// RateDeckUpdate updates the rate deck fields.
+func (h *handler) RateDeckUpdate(ctx context.Context, id uuid.UUID, fields map[ratedeck.Field]any) error {
+	updateFields := make(map[string]any)
+	for k, v := range fields {
+		updateFields[string(k)] = v
+	}
+	updateFields["tm_update"] = h.utilHandler.TimeNow()
+
+	preparedFields, err := commondatabasehandler.PrepareFields(updateFields)
+	if err != nil {
+		return fmt.Errorf("RateDeckUpdate: could not prepare fields. err: %v", err)
+	}
+
+	query, args, err := sq.Update(rateDecksTable).
+		SetMap(preparedFields).
+		Where(sq.Eq{"id": id.Bytes()}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("RateDeckUpdate: could not build query. err: %v", err)
+	}
+
+	_, err = h.db.Exec(query, args...)
+	if err != nil {
+		return fmt.Errorf("RateDeckUpdate: could not execute. err: %v", err)
+	}
+
+	return nil
+}
+
+// RateDeckDelete deletes the rate deck.
+func (h *handler) RateDeckDelete(ctx context.Context, id uuid.UUID) error {
+	ts := h.utilHandler.TimeNow()
+
+	fields := map[ratedeck.Field]any{
+		ratedeck.FieldTMDelete: ts,
+	}
+
+	return h.RateDeckUpdate(ctx, id, fields)
+}
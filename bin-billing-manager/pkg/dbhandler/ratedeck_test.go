@@ -0,0 +1,306 @@
+package dbhandler
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"monorepo/bin-common-handler/pkg/utilhandler"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	"monorepo/bin-billing-manager/models/account"
+	"monorepo/bin-billing-manager/models/ratedeck"
+	"monorepo/bin-billing-manager/pkg/cachehandler"
+)
+
+func Test_RateDeckCreate(t *testing.T) {
+
+	type test struct {
+		name string
+
+		rateDeck *ratedeck.RateDeck
+
+		responseCurTime *time.Time
+		expectRes       *ratedeck.RateDeck
+	}
+
+	tmCreate := time.Date(2026, 10, 19, 3, 22, 17, 995000000, time.UTC)
+
+	tests := []test{
+		{
+			name: "have all fields",
+
+			rateDeck: &ratedeck.RateDeck{
+				ID:       uuid.FromStringOrNil("6c8f1e7a-ad9b-11f1-9a3e-4b7c8d9e0f1a"),
+				Name:     "professional",
+				Detail:   "rate deck of the professional plan",
+				PlanType: account.PlanTypeProfessional,
+			},
+
+			responseCurTime: &tmCreate,
+			expectRes: &ratedeck.RateDeck{
+				ID:       uuid.FromStringOrNil("6c8f1e7a-ad9b-11f1-9a3e-4b7c8d9e0f1a"),
+				Name:     "professional",
+				Detail:   "rate deck of the professional plan",
+				PlanType: account.PlanTypeProfessional,
+				TMCreate: &tmCreate,
+			},
+		},
+		{
+			name: "empty",
+
+			rateDeck: &ratedeck.RateDeck{
+				ID: uuid.FromStringOrNil("6cd2a4b0-ad9b-11f1-b1f2-8f3a4b5c6d7e"),
+			},
+
+			responseCurTime: &tmCreate,
+			expectRes: &ratedeck.RateDeck{
+				ID:       uuid.FromStringOrNil("6cd2a4b0-ad9b-11f1-b1f2-8f3a4b5c6d7e"),
+				TMCreate: &tmCreate,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockCache := cachehandler.NewMockCacheHandler(mc)
+			h := handler{
+				utilHandler: mockUtil,
+				db:          dbTest,
+				cache:       mockCache,
+			}
+			ctx := context.Background()
+
+			mockUtil.EXPECT().TimeNow().Return(tt.responseCurTime)
+			if err := h.RateDeckCreate(ctx, tt.rateDeck); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			res, err := h.RateDeckGet(ctx, tt.rateDeck.ID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(tt.expectRes, res) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_RateDeckList(t *testing.T) {
+
+	type test struct {
+		name string
+
+		rateDecks []*ratedeck.RateDeck
+		filters   map[ratedeck.Field]any
+
+		responseCurTime *time.Time
+		expectRes       []*ratedeck.RateDeck
+	}
+
+	tmCreate := time.Date(2026, 10, 19, 4, 22, 17, 995000000, time.UTC)
+
+	tests := []test{
+		{
+			name: "filter by plan type",
+
+			rateDecks: []*ratedeck.RateDeck{
+				{
+					ID:       uuid.FromStringOrNil("a1c3e5f7-ad9b-11f1-8d2e-3f4a5b6c7d8e"),
+					PlanType: account.PlanTypeBasic,
+				},
+				{
+					ID:       uuid.FromStringOrNil("a20b4d6f-ad9b-11f1-9e3f-4a5b6c7d8e9f"),
+					PlanType: account.PlanTypeFree,
+				},
+			},
+			filters: map[ratedeck.Field]any{
+				ratedeck.FieldPlanType: account.PlanTypeBasic,
+				ratedeck.FieldDeleted:  false,
+			},
+
+			responseCurTime: &tmCreate,
+			expectRes: []*ratedeck.RateDeck{
+				{
+					ID:       uuid.FromStringOrNil("a1c3e5f7-ad9b-11f1-8d2e-3f4a5b6c7d8e"),
+					PlanType: account.PlanTypeBasic,
+					TMCreate: &tmCreate,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockCache := cachehandler.NewMockCacheHandler(mc)
+			h := handler{
+				utilHandler: mockUtil,
+				db:          dbTest,
+				cache:       mockCache,
+			}
+			ctx := context.Background()
+
+			for _, d := range tt.rateDecks {
+				mockUtil.EXPECT().TimeNow().Return(tt.responseCurTime)
+				if err := h.RateDeckCreate(ctx, d); err != nil {
+					t.Errorf("Wrong match. expect: ok, got: %v", err)
+				}
+			}
+
+			mockUtil.EXPECT().TimeGetCurTime().Return("2026-10-20 00:00:00.000000")
+			res, err := h.RateDeckList(ctx, 10, "", tt.filters)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(tt.expectRes, res) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_RateDeckUpdate(t *testing.T) {
+
+	type test struct {
+		name string
+
+		rateDeck *ratedeck.RateDeck
+		fields   map[ratedeck.Field]any
+
+		responseCurTime *time.Time
+		expectRes       *ratedeck.RateDeck
+	}
+
+	tmCreate := time.Date(2026, 10, 19, 5, 22, 17, 995000000, time.UTC)
+	tmUpdate := time.Date(2026, 10, 19, 5, 23, 17, 995000000, time.UTC)
+
+	tests := []test{
+		{
+			name: "update the basic info and plan type",
+
+			rateDeck: &ratedeck.RateDeck{
+				ID: uuid.FromStringOrNil("e2b4d6f8-ad9b-11f1-a0b1-5c6d7e8f9a0b"),
+			},
+			fields: map[ratedeck.Field]any{
+				ratedeck.FieldName:     "new name",
+				ratedeck.FieldDetail:   "new detail",
+				ratedeck.FieldPlanType: account.PlanTypeBasic,
+			},
+
+			expectRes: &ratedeck.RateDeck{
+				ID:       uuid.FromStringOrNil("e2b4d6f8-ad9b-11f1-a0b1-5c6d7e8f9a0b"),
+				Name:     "new name",
+				Detail:   "new detail",
+				PlanType: account.PlanTypeBasic,
+				TMCreate: &tmCreate,
+				TMUpdate: &tmUpdate,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockCache := cachehandler.NewMockCacheHandler(mc)
+			h := handler{
+				utilHandler: mockUtil,
+				db:          dbTest,
+				cache:       mockCache,
+			}
+			ctx := context.Background()
+
+			mockUtil.EXPECT().TimeNow().Return(&tmCreate)
+			if err := h.RateDeckCreate(ctx, tt.rateDeck); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			mockUtil.EXPECT().TimeNow().Return(&tmUpdate)
+			if err := h.RateDeckUpdate(ctx, tt.rateDeck.ID, tt.fields); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			res, err := h.RateDeckGet(ctx, tt.rateDeck.ID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(tt.expectRes, res) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_RateDeckDelete(t *testing.T) {
+
+	type test struct {
+		name string
+
+		rateDeck *ratedeck.RateDeck
+	}
+
+	tmCreate := time.Date(2026, 10, 19, 6, 22, 17, 995000000, time.UTC)
+	tmDelete := time.Date(2026, 10, 19, 6, 23, 17, 995000000, time.UTC)
+
+	tests := []test{
+		{
+			name: "normal",
+
+			rateDeck: &ratedeck.RateDeck{
+				ID: uuid.FromStringOrNil("1f3a5c7e-ad9c-11f1-b2c3-6d7e8f9a0b1c"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockCache := cachehandler.NewMockCacheHandler(mc)
+			h := handler{
+				utilHandler: mockUtil,
+				db:          dbTest,
+				cache:       mockCache,
+			}
+			ctx := context.Background()
+
+			mockUtil.EXPECT().TimeNow().Return(&tmCreate)
+			if err := h.RateDeckCreate(ctx, tt.rateDeck); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			mockUtil.EXPECT().TimeNow().Return(&tmDelete).Times(2)
+			if err := h.RateDeckDelete(ctx, tt.rateDeck.ID); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			res, err := h.RateDeckGet(ctx, tt.rateDeck.ID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if res.TMDelete == nil || !res.TMDelete.Equal(tmDelete) {
+				t.Errorf("Wrong match. expect: %v, got: %v", tmDelete, res.TMDelete)
+			}
+		})
+	}
+}
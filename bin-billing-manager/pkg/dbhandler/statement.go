@@ -0,0 +1,409 @@
+package dbhandler
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/gofrs/uuid"
+
+	commondatabasehandler "monorepo/bin-common-handler/pkg/databasehandler"
+
+	"monorepo/bin-billing-manager/models/billing"
+	"monorepo/bin-billing-manager/models/statement"
+)
+
+const (
+	statementsTable = "billing_statements"
+)
+
+// statementGetFromRow gets the statement from the row.
+func (h *handler) statementGetFromRow(row *sql.Rows) (*statement.Statement, error) {
+	res := &statement.Statement{}
+
+	if err := commondatabasehandler.ScanRow(row, res); err != nil {
+		return nil, fmt.Errorf("could not scan the row. statementGetFromRow. err: %v", err)
+	}
+
+	if res.Items == nil {
+		res.Items = []statement.Item{}
+	}
+	if res.TopDestinations == nil {
+		res.TopDestinations = []statement.Destination{}
+	}
+	if res.TopUps == nil {
+		res.TopUps = []statement.TopUp{}
+	}
+
+	return res, nil
+}
+
+// StatementCreate creates new statement record.
+// It returns ErrDuplicateKey if the account already has the statement of the period.
+func (h *handler) StatementCreate(ctx context.Context, c *statement.Statement) error {
+	c.TMCreate = h.utilHandler.TimeNow()
+	c.TMUpdate = c.TMCreate // the progressing statement's tm_update is its claim time
+	c.TMDelete = nil
+
+	fields, err := commondatabasehandler.PrepareFields(c)
+	if err != nil {
+		return fmt.Errorf("StatementCreate: could not prepare fields. err: %v", err)
+	}
+
+	query, args, err := sq.Insert(statementsTable).SetMap(fields).ToSql()
+	if err != nil {
+		return fmt.Errorf("StatementCreate: could not build query. err: %v", err)
+	}
+
+	_, err = h.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		if isDuplicateKeyError(err) {
+			return ErrDuplicateKey
+		}
+		return fmt.Errorf("StatementCreate: could not execute query. err: %v", err)
+	}
+
+	return nil
+}
+
+// StatementGet returns statement.
+func (h *handler) StatementGet(ctx context.Context, id uuid.UUID) (*statement.Statement, error) {
+	cols := commondatabasehandler.GetDBFields(statement.Statement{})
+
+	query, args, err := sq.Select(cols...).
+		From(statementsTable).
+		Where(sq.Eq{"id": id.Bytes()}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("StatementGet: could not build query. err: %v", err)
+	}
+
+	rows, err := h.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("StatementGet: could not query. err: %v", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	if !rows.Next() {
+		return nil, ErrNotFound
+	}
+
+	res, err := h.statementGetFromRow(rows)
+	if err != nil {
+		return nil, fmt.Errorf("StatementGet: could not scan row. err: %v", err)
+	}
+
+	return res, nil
+}
+
+// StatementList returns a list of statements.
+func (h *handler) StatementList(ctx context.Context, size uint64, token string, filters map[statement.Field]any) ([]*statement.Statement, error) {
+	if token == "" {
+		token = h.utilHandler.TimeGetCurTime()
+	}
+
+	cols := commondatabasehandler.GetDBFields(statement.Statement{})
+
+	builder := sq.Select(cols...).
+		From(statementsTable).
+		Where(sq.Lt{"tm_create": token}).
+		OrderBy("tm_create desc").
+		Limit(size)
+
+	builder, err := commondatabasehandler.ApplyFields(builder, filters)
+	if err != nil {
+		return nil, fmt.Errorf("StatementList: could not apply filters. err: %v", err)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("StatementList: could not build query. err: %v", err)
+	}
+
+	rows, err := h.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("StatementList: could not query. err: %v", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	res := []*statement.Statement{}
+	for rows.Next() {
+		u, err := h.statementGetFromRow(rows)
+		if err != nil {
+			return nil, fmt.Errorf("StatementList: could not scan row. err: %v", err)
+		}
+		res = append(res, u)
+	}
+
+	return res, nil
+}
+
+// StatementClaim claims the progressing statement whose claim is older than the given time.
+// The claim of a lost generator expires this way, so the statement's generation is retried.
+// Returns true if claimed.
+func (h *handler) StatementClaim(ctx context.Context, id uuid.UUID, staleBefore time.Time) (bool, error) {
+	query, args, err := sq.Update(statementsTable).
+		Set("tm_update", h.utilHandler.TimeNow()).
+		Where(sq.Eq{
+			"id":     id.Bytes(),
+			"status": statement.StatusProgressing,
+		}).
+		Where(sq.Lt{"tm_update": staleBefore}).
+		ToSql()
+	if err != nil {
+		return false, fmt.Errorf("StatementClaim: could not build query. err: %v", err)
+	}
+
+	result, err := h.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return false, fmt.Errorf("StatementClaim: could not execute. err: %v", err)
+	}
+
+	n, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("StatementClaim: could not get rows affected. err: %v", err)
+	}
+
+	return n > 0, nil
+}
+
+// StatementFinish stores the generated content of the progressing statement and marks it done.
+// Returns false if the statement is not progressing anymore.
+func (h *handler) StatementFinish(ctx context.Context, c *statement.Statement) (bool, error) {
+	c.Status = statement.StatusDone
+	c.TMUpdate = h.utilHandler.TimeNow()
+
+	fields, err := commondatabasehandler.PrepareFields(map[statement.Field]any{
+		statement.FieldStatus: c.Status,
+
+		statement.FieldItems:           c.Items,
+		statement.FieldTopDestinations: c.TopDestinations,
+		statement.FieldTopUps:          c.TopUps,
+
+		statement.FieldTotalUsageToken:  c.TotalUsageToken,
+		statement.FieldTotalUsageCredit: c.TotalUsageCredit,
+		statement.FieldTotalTopUpToken:  c.TotalTopUpToken,
+		statement.FieldTotalTopUpCredit: c.TotalTopUpCredit,
+
+		statement.FieldBalanceTokenEnd:  c.BalanceTokenEnd,
+		statement.FieldBalanceCreditEnd: c.BalanceCreditEnd,
+
+		statement.FieldPDFFileID: c.PDFFileID,
+		statement.FieldCSVFileID: c.CSVFileID,
+
+		statement.FieldTMUpdate: c.TMUpdate,
+	})
+	if err != nil {
+		return false, fmt.Errorf("StatementFinish: could not prepare fields. err: %v", err)
+	}
+
+	query, args, err := sq.Update(statementsTable).
+		SetMap(fields).
+		Where(sq.Eq{
+			"id":     c.ID.Bytes(),
+			"status": statement.StatusProgressing,
+		}).
+		ToSql()
+	if err != nil {
+		return false, fmt.Errorf("StatementFinish: could not build query. err: %v", err)
+	}
+
+	result, err := h.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return false, fmt.Errorf("StatementFinish: could not execute. err: %v", err)
+	}
+
+	n, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("StatementFinish: could not get rows affected. err: %v", err)
+	}
+
+	return n > 0, nil
+}
+
+// StatementRelease deletes the progressing statement of the failed generation,
+// so the statement of the period can be generated again.
+func (h *handler) StatementRelease(ctx context.Context, id uuid.UUID) error {
+	query, args, err := sq.Delete(statementsTable).
+		Where(sq.Eq{
+			"id":     id.Bytes(),
+			"status": statement.StatusProgressing,
+		}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("StatementRelease: could not build query. err: %v", err)
+	}
+
+	if _, err := h.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("StatementRelease: could not execute. err: %v", err)
+	}
+
+	return nil
+}
+
+// billingPeriodBuilder returns the select builder of the account's finished ledger entries started in the period.
+func billingPeriodBuilder(builder sq.SelectBuilder, accountID uuid.UUID, tmStart time.Time, tmEnd time.Time) sq.SelectBuilder {
+	return builder.
+		From(billingsTable).
+		Where(sq.Eq{
+			"account_id": accountID.Bytes(),
+			"tm_delete":  nil,
+		}).
+		Where(sq.NotEq{"status": billing.StatusProgressing}).
+		Where(sq.GtOrEq{"tm_billing_start": tmStart}).
+		Where(sq.Lt{"tm_billing_start": tmEnd})
+}
+
+// BillingAggregateItems returns the account's ledger entries of the period
+// grouped by transaction type, reference type and cost type.
+func (h *handler) BillingAggregateItems(ctx context.Context, accountID uuid.UUID, tmStart time.Time, tmEnd time.Time) ([]statement.Item, error) {
+	builder := billingPeriodBuilder(sq.Select(
+		"transaction_type",
+		"reference_type",
+		"cost_type",
+		"count(*)",
+		"coalesce(sum(billable_units), 0)",
+		"coalesce(sum(usage_duration), 0)",
+		"coalesce(sum(amount_token), 0)",
+		"coalesce(sum(amount_credit), 0)",
+	), accountID, tmStart, tmEnd).
+		GroupBy("transaction_type", "reference_type", "cost_type").
+		OrderBy("transaction_type", "reference_type", "cost_type")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("BillingAggregateItems: could not build query. err: %v", err)
+	}
+
+	rows, err := h.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("BillingAggregateItems: could not query. err: %v", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	res := []statement.Item{}
+	for rows.Next() {
+		var costType sql.NullString
+		tmp := statement.Item{}
+		if errScan := rows.Scan(&tmp.TransactionType, &tmp.ReferenceType, &costType, &tmp.Count, &tmp.BillableUnits, &tmp.UsageDuration, &tmp.AmountToken, &tmp.AmountCredit); errScan != nil {
+			return nil, fmt.Errorf("BillingAggregateItems: could not scan row. err: %v", errScan)
+		}
+		tmp.CostType = billing.CostType(costType.String)
+		res = append(res, tmp)
+	}
+
+	return res, nil
+}
+
+// BillingAggregateDestinations returns the account's usage of the period grouped by the applied rate.
+// The destinations are ordered by the spent credit, the most first.
+func (h *handler) BillingAggregateDestinations(ctx context.Context, accountID uuid.UUID, tmStart time.Time, tmEnd time.Time, limit uint64) ([]statement.Destination, error) {
+	builder := billingPeriodBuilder(sq.Select(
+		"rate_id",
+		"cost_type",
+		"count(*)",
+		"coalesce(sum(usage_duration), 0)",
+		"coalesce(sum(amount_credit), 0) as total_credit",
+	), accountID, tmStart, tmEnd).
+		Where(sq.Eq{"transaction_type": billing.TransactionTypeUsage}).
+		Where(sq.Lt{"amount_credit": 0}).
+		GroupBy("rate_id", "cost_type").
+		OrderBy("total_credit asc").
+		Limit(limit)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("BillingAggregateDestinations: could not build query. err: %v", err)
+	}
+
+	rows, err := h.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("BillingAggregateDestinations: could not query. err: %v", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	res := []statement.Destination{}
+	for rows.Next() {
+		var rateID []byte
+		tmp := statement.Destination{}
+		if errScan := rows.Scan(&rateID, &tmp.CostType, &tmp.Count, &tmp.UsageDuration, &tmp.AmountCredit); errScan != nil {
+			return nil, fmt.Errorf("BillingAggregateDestinations: could not scan row. err: %v", errScan)
+		}
+		tmp.RateID = uuid.FromBytesOrNil(rateID)
+		res = append(res, tmp)
+	}
+
+	return res, nil
+}
+
+// BillingListByPeriod returns the account's finished ledger entries of the transaction type started in the period.
+// The entries are ordered by the billing start.
+func (h *handler) BillingListByPeriod(ctx context.Context, accountID uuid.UUID, transactionType billing.TransactionType, tmStart time.Time, tmEnd time.Time) ([]*billing.Billing, error) {
+	cols := commondatabasehandler.GetDBFields(billing.Billing{})
+
+	builder := billingPeriodBuilder(sq.Select(cols...), accountID, tmStart, tmEnd).
+		Where(sq.Eq{"transaction_type": transactionType}).
+		OrderBy("tm_billing_start asc")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("BillingListByPeriod: could not build query. err: %v", err)
+	}
+
+	rows, err := h.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("BillingListByPeriod: could not query. err: %v", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	res := []*billing.Billing{}
+	for rows.Next() {
+		u, err := h.billingGetFromRow(rows)
+		if err != nil {
+			return nil, fmt.Errorf("BillingListByPeriod: could not scan row. err: %v", err)
+		}
+		res = append(res, u)
+	}
+
+	return res, nil
+}
+
+// BillingGetLastBefore returns the account's last finished ledger entry recorded before the given time.
+// Its balance snapshots are the account's balances at the time.
+func (h *handler) BillingGetLastBefore(ctx context.Context, accountID uuid.UUID, tm time.Time) (*billing.Billing, error) {
+	cols := commondatabasehandler.GetDBFields(billing.Billing{})
+
+	query, args, err := sq.Select(cols...).
+		From(billingsTable).
+		Where(sq.Eq{
+			"account_id": accountID.Bytes(),
+			"tm_delete":  nil,
+		}).
+		Where(sq.NotEq{"status": billing.StatusProgressing}).
+		Where(sq.Lt{"tm_billing_end": tm}).
+		OrderBy("tm_billing_end desc").
+		Limit(1).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("BillingGetLastBefore: could not build query. err: %v", err)
+	}
+
+	rows, err := h.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("BillingGetLastBefore: could not query. err: %v", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	if !rows.Next() {
+		return nil, ErrNotFound
+	}
+
+	res, err := h.billingGetFromRow(rows)
+	if err != nil {
+		return nil, fmt.Errorf("BillingGetLastBefore: could not scan row. err: %v", err)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,491 @@
+package dbhandler
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	"monorepo/bin-billing-manager/models/billing"
+	"monorepo/bin-billing-manager/models/statement"
+	"monorepo/bin-billing-manager/pkg/cachehandler"
+)
+
+func Test_StatementCreate(t *testing.T) {
+
+	type test struct {
+		name string
+
+		statement *statement.Statement
+
+		responseCurTime *time.Time
+		expectRes       *statement.Statement
+	}
+
+	tmCreate := time.Date(2026, 10, 1, 3, 0, 0, 0, time.UTC)
+	tmPeriodStart := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	tmPeriodEnd := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []test{
+		{
+			name: "have all fields",
+
+			statement: &statement.Statement{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("5a1c8e2e-ac60-11f0-9a1b-3f4e5d6c7b8a"),
+					CustomerID: uuid.FromStringOrNil("5a4f0b7c-ac60-11f0-8c2d-1a2b3c4d5e6f"),
+				},
+				AccountID:     uuid.FromStringOrNil("5a7d3c9e-ac60-11f0-b3e4-7f8e9d0c1b2a"),
+				Status:        statement.StatusDone,
+				TMPeriodStart: &tmPeriodStart,
+				TMPeriodEnd:   &tmPeriodEnd,
+				Items: []statement.Item{
+					{
+						TransactionType: billing.TransactionTypeUsage,
+						ReferenceType:   billing.ReferenceTypeCall,
+						CostType:        billing.CostTypeCallPSTNOutgoing,
+						Count:           2,
+						BillableUnits:   3,
+						UsageDuration:   150,
+						AmountCredit:    -18000,
+					},
+				},
+				TopDestinations: []statement.Destination{
+					{
+						RateID:        uuid.FromStringOrNil("5aab6e10-ac60-11f0-a4f5-9c8b7a6d5e4f"),
+						Prefix:        "1",
+						CostType:      billing.CostTypeCallPSTNOutgoing,
+						Count:         2,
+						UsageDuration: 150,
+						AmountCredit:  -18000,
+					},
+				},
+				TopUps: []statement.TopUp{
+					{
+						BillingID:     uuid.FromStringOrNil("5ad9a0f2-ac60-11f0-8e6f-2d3c4b5a6978"),
+						ReferenceType: billing.ReferenceTypePaddleCreditPurchase,
+						AmountCredit:  10000000,
+						TMCreate:      &tmPeriodStart,
+					},
+				},
+				TotalUsageCredit: -18000,
+				TotalTopUpCredit: 10000000,
+				BalanceCreditEnd: 9982000,
+				PDFFileID:        uuid.FromStringOrNil("5b07d3d4-ac60-11f0-9b7a-5e6f7a8b9c0d"),
+				CSVFileID:        uuid.FromStringOrNil("5b3605b6-ac60-11f0-a1b2-8d9e0f1a2b3c"),
+			},
+
+			responseCurTime: &tmCreate,
+			expectRes: &statement.Statement{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("5a1c8e2e-ac60-11f0-9a1b-3f4e5d6c7b8a"),
+					CustomerID: uuid.FromStringOrNil("5a4f0b7c-ac60-11f0-8c2d-1a2b3c4d5e6f"),
+				},
+				AccountID:     uuid.FromStringOrNil("5a7d3c9e-ac60-11f0-b3e4-7f8e9d0c1b2a"),
+				Status:        statement.StatusDone,
+				TMPeriodStart: &tmPeriodStart,
+				TMPeriodEnd:   &tmPeriodEnd,
+				Items: []statement.Item{
+					{
+						TransactionType: billing.TransactionTypeUsage,
+						ReferenceType:   billing.ReferenceTypeCall,
+						CostType:        billing.CostTypeCallPSTNOutgoing,
+						Count:           2,
+						BillableUnits:   3,
+						UsageDuration:   150,
+						AmountCredit:    -18000,
+					},
+				},
+				TopDestinations: []statement.Destination{
+					{
+						RateID:        uuid.FromStringOrNil("5aab6e10-ac60-11f0-a4f5-9c8b7a6d5e4f"),
+						Prefix:        "1",
+						CostType:      billing.CostTypeCallPSTNOutgoing,
+						Count:         2,
+						UsageDuration: 150,
+						AmountCredit:  -18000,
+					},
+				},
+				TopUps: []statement.TopUp{
+					{
+						BillingID:     uuid.FromStringOrNil("5ad9a0f2-ac60-11f0-8e6f-2d3c4b5a6978"),
+						ReferenceType: billing.ReferenceTypePaddleCreditPurchase,
+						AmountCredit:  10000000,
+						TMCreate:      &tmPeriodStart,
+					},
+				},
+				TotalUsageCredit: -18000,
+				TotalTopUpCredit: 10000000,
+				BalanceCreditEnd: 9982000,
+				PDFFileID:        uuid.FromStringOrNil("5b07d3d4-ac60-11f0-9b7a-5e6f7a8b9c0d"),
+				CSVFileID:        uuid.FromStringOrNil("5b3605b6-ac60-11f0-a1b2-8d9e0f1a2b3c"),
+				TMCreate:         &tmCreate,
+				TMUpdate:         &tmCreate,
+			},
+		},
+		{
+			name: "empty",
+
+			statement: &statement.Statement{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("5b643798-ac60-11f0-b4c5-0e1f2a3b4c5d"),
+				},
+				AccountID:     uuid.FromStringOrNil("5b92697a-ac60-11f0-9d8e-3a4b5c6d7e8f"),
+				Status:        statement.StatusProgressing,
+				TMPeriodStart: &tmPeriodStart,
+				TMPeriodEnd:   &tmPeriodEnd,
+			},
+
+			responseCurTime: &tmCreate,
+			expectRes: &statement.Statement{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("5b643798-ac60-11f0-b4c5-0e1f2a3b4c5d"),
+				},
+				AccountID:       uuid.FromStringOrNil("5b92697a-ac60-11f0-9d8e-3a4b5c6d7e8f"),
+				Status:          statement.StatusProgressing,
+				TMPeriodStart:   &tmPeriodStart,
+				TMPeriodEnd:     &tmPeriodEnd,
+				Items:           []statement.Item{},
+				TopDestinations: []statement.Destination{},
+				TopUps:          []statement.TopUp{},
+				TMCreate:        &tmCreate,
+				TMUpdate:        &tmCreate,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockCache := cachehandler.NewMockCacheHandler(mc)
+			h := handler{
+				utilHandler: mockUtil,
+				db:          dbTest,
+				cache:       mockCache,
+			}
+			ctx := context.Background()
+
+			mockUtil.EXPECT().TimeNow().Return(tt.responseCurTime)
+			if err := h.StatementCreate(ctx, tt.statement); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			res, err := h.StatementGet(ctx, tt.statement.ID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+
+			// the same period of the account is not allowed
+			dup := *tt.statement
+			dup.ID = uuid.Must(uuid.NewV4())
+			mockUtil.EXPECT().TimeNow().Return(tt.responseCurTime)
+			if errDup := h.StatementCreate(ctx, &dup); errDup != ErrDuplicateKey {
+				t.Errorf("Wrong match. expect: %v, got: %v", ErrDuplicateKey, errDup)
+			}
+
+			mockUtil.EXPECT().TimeGetCurTime().Return("2026-12-01T00:00:00.000000Z")
+			list, err := h.StatementList(ctx, 10, "", map[statement.Field]any{
+				statement.FieldAccountID: tt.statement.AccountID,
+			})
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+			if len(list) != 1 || !reflect.DeepEqual(list[0], tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, list)
+			}
+		})
+	}
+}
+
+func Test_StatementClaimFinishRelease(t *testing.T) {
+
+	tmCreate := time.Date(2026, 10, 1, 3, 0, 0, 0, time.UTC)
+	tmClaim := time.Date(2026, 10, 1, 3, 40, 0, 0, time.UTC)
+	tmFinish := time.Date(2026, 10, 1, 3, 41, 0, 0, time.UTC)
+	tmPeriodStart := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	tmPeriodEnd := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockUtil := utilhandler.NewMockUtilHandler(mc)
+	h := handler{
+		utilHandler: mockUtil,
+		db:          dbTest,
+		cache:       cachehandler.NewMockCacheHandler(mc),
+	}
+	ctx := context.Background()
+
+	st := &statement.Statement{
+		Identity: commonidentity.Identity{
+			ID: uuid.FromStringOrNil("5c1e2f40-ac60-11f0-9a0b-7c8d9e0f1a2b"),
+		},
+		AccountID:     uuid.FromStringOrNil("5c4d6172-ac60-11f0-ab1c-8d9e0f1a2b3c"),
+		Status:        statement.StatusProgressing,
+		TMPeriodStart: &tmPeriodStart,
+		TMPeriodEnd:   &tmPeriodEnd,
+	}
+
+	mockUtil.EXPECT().TimeNow().Return(&tmCreate)
+	if err := h.StatementCreate(ctx, st); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	claim := func(staleBefore time.Time) bool {
+		mockUtil.EXPECT().TimeNow().Return(&tmClaim)
+		res, err := h.StatementClaim(ctx, st.ID, staleBefore)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return res
+	}
+
+	// the claim is not stale yet
+	if claim(tmCreate.Add(-time.Minute)) {
+		t.Errorf("Wrong match. expect: not claimed")
+	}
+
+	// the claim of the lost generator is stale
+	if !claim(tmCreate.Add(time.Minute)) {
+		t.Errorf("Wrong match. expect: claimed")
+	}
+
+	st.CSVFileID = uuid.FromStringOrNil("5c7bb3a4-ac60-11f0-bc2d-9e0f1a2b3c4d")
+	st.TotalUsageCredit = -18000
+	mockUtil.EXPECT().TimeNow().Return(&tmFinish)
+	finished, err := h.StatementFinish(ctx, st)
+	if err != nil || !finished {
+		t.Fatalf("Wrong match. expect: finished, got: %v, %v", finished, err)
+	}
+
+	res, err := h.StatementGet(ctx, st.ID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if res.Status != statement.StatusDone || res.CSVFileID != st.CSVFileID || res.TotalUsageCredit != -18000 || !res.TMUpdate.Equal(tmFinish) {
+		t.Errorf("Wrong match. got: %v", res)
+	}
+
+	// the done statement can't be claimed, finished or released
+	if claim(tmFinish.Add(time.Hour)) {
+		t.Errorf("Wrong match. expect: not claimed")
+	}
+	mockUtil.EXPECT().TimeNow().Return(&tmFinish)
+	if finished, _ := h.StatementFinish(ctx, st); finished {
+		t.Errorf("Wrong match. expect: not finished")
+	}
+	if errRelease := h.StatementRelease(ctx, st.ID); errRelease != nil {
+		t.Fatalf("Unexpected error: %v", errRelease)
+	}
+	if _, errGet := h.StatementGet(ctx, st.ID); errGet != nil {
+		t.Errorf("Wrong match. expect: ok, got: %v", errGet)
+	}
+}
+
+func Test_StatementRelease(t *testing.T) {
+
+	tmCreate := time.Date(2026, 10, 1, 3, 0, 0, 0, time.UTC)
+	tmPeriodStart := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockUtil := utilhandler.NewMockUtilHandler(mc)
+	h := handler{
+		utilHandler: mockUtil,
+		db:          dbTest,
+		cache:       cachehandler.NewMockCacheHandler(mc),
+	}
+	ctx := context.Background()
+
+	st := &statement.Statement{
+		Identity: commonidentity.Identity{
+			ID: uuid.FromStringOrNil("5caa0a86-ac60-11f0-8d3e-0f1a2b3c4d5e"),
+		},
+		AccountID:     uuid.FromStringOrNil("5cd8ed68-ac60-11f0-9e4f-1a2b3c4d5e6f"),
+		Status:        statement.StatusProgressing,
+		TMPeriodStart: &tmPeriodStart,
+	}
+
+	mockUtil.EXPECT().TimeNow().Return(&tmCreate)
+	if err := h.StatementCreate(ctx, st); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if err := h.StatementRelease(ctx, st.ID); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := h.StatementGet(ctx, st.ID); err != ErrNotFound {
+		t.Errorf("Wrong match. expect: %v, got: %v", ErrNotFound, err)
+	}
+
+	// the period can be generated again
+	mockUtil.EXPECT().TimeNow().Return(&tmCreate)
+	st.ID = uuid.FromStringOrNil("5d07cf4a-ac60-11f0-af50-2b3c4d5e6f70")
+	if err := h.StatementCreate(ctx, st); err != nil {
+		t.Errorf("Wrong match. expect: ok, got: %v", err)
+	}
+}
+
+func Test_StatementGet_NotFound(t *testing.T) {
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	h := handler{
+		utilHandler: utilhandler.NewMockUtilHandler(mc),
+		db:          dbTest,
+		cache:       cachehandler.NewMockCacheHandler(mc),
+	}
+
+	_, err := h.StatementGet(context.Background(), uuid.FromStringOrNil("5bc09b5c-ac60-11f0-8f9a-6b7c8d9e0f1a"))
+	if err != ErrNotFound {
+		t.Errorf("Wrong match. expect: %v, got: %v", ErrNotFound, err)
+	}
+}
+
+func Test_BillingAggregate(t *testing.T) {
+
+	accountID := uuid.FromStringOrNil("7d1e2f3a-ac60-11f0-9b8c-1d2e3f4a5b6c")
+	rateID := uuid.FromStringOrNil("7d4c5b6e-ac60-11f0-8a7b-4e5f6a7b8c9d")
+	tmCreate := time.Date(2026, 10, 1, 3, 0, 0, 0, time.UTC)
+	tmStart := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	tmEnd := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	billings := []struct {
+		id              uuid.UUID
+		transactionType billing.TransactionType
+		status          billing.Status
+		referenceType   billing.ReferenceType
+		costType        billing.CostType
+		rateID          uuid.UUID
+		usageDuration   int
+		billableUnits   int
+		amountCredit    int64
+		balanceCredit   int64
+		tmBillingStart  time.Time
+	}{
+		// previous period
+		{uuid.FromStringOrNil("7d7a8f90-ac60-11f0-a1a2-000000000001"), billing.TransactionTypeUsage, billing.StatusEnd, billing.ReferenceTypeCall, billing.CostTypeCallPSTNOutgoing, rateID, 60, 1, -6000, 994000, time.Date(2026, 8, 31, 23, 0, 0, 0, time.UTC)},
+
+		{uuid.FromStringOrNil("7d7a8f90-ac60-11f0-a1a2-000000000002"), billing.TransactionTypeTopUp, billing.StatusEnd, billing.ReferenceTypePaddleCreditPurchase, billing.CostTypeNone, uuid.Nil, 0, 0, 10000000, 10994000, time.Date(2026, 9, 2, 0, 0, 0, 0, time.UTC)},
+		{uuid.FromStringOrNil("7d7a8f90-ac60-11f0-a1a2-000000000003"), billing.TransactionTypeUsage, billing.StatusEnd, billing.ReferenceTypeCall, billing.CostTypeCallPSTNOutgoing, rateID, 90, 2, -12000, 10982000, time.Date(2026, 9, 3, 0, 0, 0, 0, time.UTC)},
+		{uuid.FromStringOrNil("7d7a8f90-ac60-11f0-a1a2-000000000004"), billing.TransactionTypeUsage, billing.StatusEnd, billing.ReferenceTypeCall, billing.CostTypeCallPSTNOutgoing, uuid.Nil, 60, 1, -6000, 10976000, time.Date(2026, 9, 4, 0, 0, 0, 0, time.UTC)},
+		{uuid.FromStringOrNil("7d7a8f90-ac60-11f0-a1a2-000000000005"), billing.TransactionTypeUsage, billing.StatusEnd, billing.ReferenceTypeSMS, billing.CostTypeSMS, uuid.Nil, 0, 1, -8000, 10968000, time.Date(2026, 9, 5, 0, 0, 0, 0, time.UTC)},
+		// not finished
+		{uuid.FromStringOrNil("7d7a8f90-ac60-11f0-a1a2-000000000006"), billing.TransactionTypeUsage, billing.StatusProgressing, billing.ReferenceTypeCall, billing.CostTypeCallPSTNOutgoing, rateID, 0, 0, 0, 0, time.Date(2026, 9, 30, 23, 0, 0, 0, time.UTC)},
+		// next period
+		{uuid.FromStringOrNil("7d7a8f90-ac60-11f0-a1a2-000000000007"), billing.TransactionTypeUsage, billing.StatusEnd, billing.ReferenceTypeSMS, billing.CostTypeSMS, uuid.Nil, 0, 1, -8000, 10960000, time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockCache := cachehandler.NewMockCacheHandler(mc)
+	mockUtil := utilhandler.NewMockUtilHandler(mc)
+
+	h := handler{
+		utilHandler: mockUtil,
+		db:          dbTest,
+		cache:       mockCache,
+	}
+	ctx := context.Background()
+
+	for _, b := range billings {
+		tmBillingStart := b.tmBillingStart
+		tmBillingEnd := b.tmBillingStart.Add(time.Minute)
+		mockUtil.EXPECT().TimeNow().Return(&tmCreate)
+		mockCache.EXPECT().BillingSet(ctx, gomock.Any())
+		if err := h.BillingCreate(ctx, &billing.Billing{
+			Identity: commonidentity.Identity{
+				ID: b.id,
+			},
+			AccountID:             accountID,
+			TransactionType:       b.transactionType,
+			Status:                b.status,
+			ReferenceType:         b.referenceType,
+			ReferenceID:           b.id,
+			CostType:              b.costType,
+			RateID:                b.rateID,
+			UsageDuration:         b.usageDuration,
+			BillableUnits:         b.billableUnits,
+			AmountCredit:          b.amountCredit,
+			BalanceCreditSnapshot: b.balanceCredit,
+			IdempotencyKey:        b.id,
+			TMBillingStart:        &tmBillingStart,
+			TMBillingEnd:          &tmBillingEnd,
+		}); err != nil {
+			t.Errorf("Wrong match. expect: ok, got: %v", err)
+		}
+	}
+
+	t.Run("items", func(t *testing.T) {
+		res, err := h.BillingAggregateItems(ctx, accountID, tmStart, tmEnd)
+		if err != nil {
+			t.Errorf("Wrong match. expect: ok, got: %v", err)
+		}
+
+		expectRes := []statement.Item{
+			{TransactionType: billing.TransactionTypeTopUp, ReferenceType: billing.ReferenceTypePaddleCreditPurchase, Count: 1, AmountCredit: 10000000},
+			{TransactionType: billing.TransactionTypeUsage, ReferenceType: billing.ReferenceTypeCall, CostType: billing.CostTypeCallPSTNOutgoing, Count: 2, BillableUnits: 3, UsageDuration: 150, AmountCredit: -18000},
+			{TransactionType: billing.TransactionTypeUsage, ReferenceType: billing.ReferenceTypeSMS, CostType: billing.CostTypeSMS, Count: 1, BillableUnits: 1, AmountCredit: -8000},
+		}
+		if !reflect.DeepEqual(res, expectRes) {
+			t.Errorf("Wrong match.\nexpect: %v\ngot: %v", expectRes, res)
+		}
+	})
+
+	t.Run("destinations", func(t *testing.T) {
+		res, err := h.BillingAggregateDestinations(ctx, accountID, tmStart, tmEnd, 2)
+		if err != nil {
+			t.Errorf("Wrong match. expect: ok, got: %v", err)
+		}
+
+		expectRes := []statement.Destination{
+			{RateID: rateID, CostType: billing.CostTypeCallPSTNOutgoing, Count: 1, UsageDuration: 90, AmountCredit: -12000},
+			{RateID: uuid.Nil, CostType: billing.CostTypeSMS, Count: 1, AmountCredit: -8000},
+		}
+		if !reflect.DeepEqual(res, expectRes) {
+			t.Errorf("Wrong match.\nexpect: %v\ngot: %v", expectRes, res)
+		}
+	})
+
+	t.Run("list by period", func(t *testing.T) {
+		res, err := h.BillingListByPeriod(ctx, accountID, billing.TransactionTypeTopUp, tmStart, tmEnd)
+		if err != nil {
+			t.Errorf("Wrong match. expect: ok, got: %v", err)
+		}
+
+		if len(res) != 1 || res[0].ID != billings[1].id {
+			t.Errorf("Wrong match. expect: %v, got: %v", billings[1].id, res)
+		}
+	})
+
+	t.Run("last before", func(t *testing.T) {
+		res, err := h.BillingGetLastBefore(ctx, accountID, tmEnd)
+		if err != nil {
+			t.Errorf("Wrong match. expect: ok, got: %v", err)
+		}
+
+		if res.ID != billings[4].id || res.BalanceCreditSnapshot != 10968000 {
+			t.Errorf("Wrong match. expect: %v, got: %v", billings[4].id, res)
+		}
+
+		_, err = h.BillingGetLastBefore(ctx, accountID, tmStart.AddDate(-1, 0, 0))
+		if err != ErrNotFound {
+			t.Errorf("Wrong match. expect: %v, got: %v", ErrNotFound, err)
+		}
+	})
+}
@@ -11,6 +11,7 @@ import (
 	cerrors "monorepo/bin-common-handler/models/errors"
 	"monorepo/bin-common-handler/models/sock"
 	"monorepo/bin-common-handler/pkg/sockhandler"
+	"monorepo/bin-common-handler/pkg/tracehandler"
 	"monorepo/bin-common-handler/pkg/utilhandler"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -21,6 +22,7 @@ import (
 	"monorepo/bin-billing-manager/pkg/dbhandler"
 	"monorepo/bin-billing-manager/pkg/failedeventhandler"
 	"monorepo/bin-billing-manager/pkg/paddlehandler"
+	"monorepo/bin-billing-manager/pkg/statementhandler"
 )
 
 // pagination parameters
@@ -46,6 +48,7 @@ type listenHandler struct {
 	billingHandler     billinghandler.BillingHandler
 	paddleHandler      paddlehandler.PaddleHandler
 	failedEventHandler failedeventhandler.FailedEventHandler
+	statementHandler   statementhandler.StatementHandler
 }
 
 var (
@@ -60,6 +63,7 @@ var (
 	regV1AccountsIDIsValidBalance        = regexp.MustCompile("/v1/accounts/" + regUUID + "/is_valid_balance$")
 	regV1AccountsIDIsValidResourceLimit = regexp.MustCompile("/v1/accounts/" + regUUID + "/is_valid_resource_limit$")
 	regV1AccountsIDIsValidPaymentInfo   = regexp.MustCompile("/v1/accounts/" + regUUID + "/payment_info$")
+	regV1AccountsIDSpendingSettings     = regexp.MustCompile("/v1/accounts/" + regUUID + "/spending_settings$")
 
 	regV1AccountsIsValidBalanceByCustomerID       = regexp.MustCompile("/v1/accounts/is_valid_balance_by_customer_id$")
 	regV1AccountsIsValidResourceLimitByCustomerID = regexp.MustCompile("/v1/accounts/is_valid_resource_limit_by_customer_id$")
@@ -80,6 +84,16 @@ var (
 
 	// failed events
 	regV1FailedEventsRetry = regexp.MustCompile("/v1/failed_events/retry$")
+
+	// statements
+	regV1Statements         = regexp.MustCompile("/v1/statements$")
+	regV1StatementsGet      = regexp.MustCompile(`/v1/statements\?`)
+	regV1StatementsID       = regexp.MustCompile("/v1/statements/" + regUUID + "$")
+	regV1StatementsGenerate = regexp.MustCompile("/v1/statements/generate$")
+
+	// estimates
+	regV1Estimates              = regexp.MustCompile("/v1/estimates$")
+	regV1EstimatesReferenceIDID = regexp.MustCompile("/v1/estimates/reference_id/" + regUUID + "$")
 )
 
 var (
@@ -152,6 +166,7 @@ func NewListenHandler(
 	billingHandler billinghandler.BillingHandler,
 	paddleHandler paddlehandler.PaddleHandler,
 	failedEventHandler failedeventhandler.FailedEventHandler,
+	statementHandler statementhandler.StatementHandler,
 ) ListenHandler {
 	h := &listenHandler{
 		sockHandler:        sockHandler,
@@ -160,6 +175,7 @@ func NewListenHandler(
 		billingHandler:     billingHandler,
 		paddleHandler:      paddleHandler,
 		failedEventHandler: failedEventHandler,
+		statementHandler:   statementHandler,
 	}
 
 	return h
@@ -193,7 +209,8 @@ func (h *listenHandler) processRequest(m *sock.Request) (*sock.Response, error)
 	var err error
 	var response *sock.Response
 
-	ctx := context.Background()
+	ctx, span := tracehandler.StartServerSpan(context.Background(), m)
+	defer span.End()
 	log := logrus.WithFields(
 		logrus.Fields{
 			"request": m,
@@ -249,6 +266,11 @@ func (h *listenHandler) processRequest(m *sock.Request) (*sock.Response, error)
 		response, err = h.processV1AccountsIDPaymentInfoPut(ctx, m)
 		requestType = "/v1/accounts/<account-id>/payment_info"
 
+	// PUT /accounts/<account-id>/spending_settings
+	case regV1AccountsIDSpendingSettings.MatchString(m.URI) && m.Method == sock.RequestMethodPut:
+		response, err = h.processV1AccountsIDSpendingSettingsPut(ctx, m)
+		requestType = "/v1/accounts/<account-id>/spending_settings"
+
 	// POST /accounts/is_valid_balance_by_customer_id
 	case regV1AccountsIsValidBalanceByCustomerID.MatchString(m.URI) && m.Method == sock.RequestMethodPost:
 		response, err = h.processV1AccountsIsValidBalanceByCustomerIDPost(ctx, m)
@@ -285,6 +307,42 @@ func (h *listenHandler) processRequest(m *sock.Request) (*sock.Response, error)
 		response, err = h.processV1BillingGet(ctx, m)
 		requestType = "/v1/billing"
 
+	////////////////////
+	// statements
+	////////////////////
+	// GET /statements
+	case regV1StatementsGet.MatchString(m.URI) && m.Method == sock.RequestMethodGet:
+		response, err = h.processV1StatementsGet(ctx, m)
+		requestType = "/v1/statements"
+
+	// POST /statements
+	case regV1Statements.MatchString(m.URI) && m.Method == sock.RequestMethodPost:
+		response, err = h.processV1StatementsPost(ctx, m)
+		requestType = "/v1/statements"
+
+	// POST /statements/generate
+	case regV1StatementsGenerate.MatchString(m.URI) && m.Method == sock.RequestMethodPost:
+		response, err = h.processV1StatementsGeneratePost(ctx, m)
+		requestType = "/v1/statements/generate"
+
+	// GET /statements/<statement-id>
+	case regV1StatementsID.MatchString(m.URI) && m.Method == sock.RequestMethodGet:
+		response, err = h.processV1StatementsIDGet(ctx, m)
+		requestType = "/v1/statements/<statement-id>"
+
+	////////////////////
+	// estimates
+	////////////////////
+	// POST /estimates
+	case regV1Estimates.MatchString(m.URI) && m.Method == sock.RequestMethodPost:
+		response, err = h.processV1EstimatesPost(ctx, m)
+		requestType = "/v1/estimates"
+
+	// GET /estimates/reference_id/<reference-id>
+	case regV1EstimatesReferenceIDID.MatchString(m.URI) && m.Method == sock.RequestMethodGet:
+		response, err = h.processV1EstimatesReferenceIDIDGet(ctx, m)
+		requestType = "/v1/estimates/reference_id/<reference-id>"
+
 	// POST /accounts/<account-id>/paddle_portal_session
 	case regV1AccountsIDPaddlePortalSession.MatchString(m.URI) && m.Method == sock.RequestMethodPost:
 		response, err = h.processV1AccountsIDPaddlePortalSessionPost(ctx, m)
@@ -51,3 +51,11 @@ type V1DataAccountsIDPaymentInfoPUT struct {
 	PaymentType   account.PaymentType   `json:"payment_type"`
 	PaymentMethod account.PaymentMethod `json:"payment_method"`
 }
+
+// V1DataAccountsIDSpendingSettingsPUT is request param define for PUT /accounts/<account-id>/spending_settings
+type V1DataAccountsIDSpendingSettingsPUT struct {
+	SpendLimits            []account.SpendLimit `json:"spend_limits"`
+	BalanceAlertThresholds []int64              `json:"balance_alert_thresholds"`
+	AutoTopUpThreshold     int64                `json:"auto_topup_threshold"`
+	AutoTopUpAmount        int64                `json:"auto_topup_amount"`
+}
@@ -0,0 +1,17 @@
+package request
+
+import (
+	commonaddress "monorepo/bin-common-handler/models/address"
+
+	"github.com/gofrs/uuid"
+
+	"monorepo/bin-billing-manager/models/billing"
+)
+
+// V1DataEstimatesPOST is request param define for POST /estimates
+type V1DataEstimatesPOST struct {
+	CustomerID  uuid.UUID              `json:"customer_id"`
+	CostType    billing.CostType       `json:"cost_type"`
+	Destination *commonaddress.Address `json:"destination,omitempty"`
+	Duration    int                    `json:"duration"` // seconds
+}
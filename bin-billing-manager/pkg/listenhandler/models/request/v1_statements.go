@@ -0,0 +1,13 @@
+package request
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+// V1DataStatementsPOST is request param define for POST /statements
+type V1DataStatementsPOST struct {
+	AccountID     uuid.UUID `json:"account_id"`
+	TMPeriodStart time.Time `json:"tm_period_start"`
+}
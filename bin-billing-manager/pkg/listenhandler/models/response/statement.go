@@ -0,0 +1,8 @@
+package response
+
+// V1ResponseStatementsGenerate is
+// v1 response type for
+// /v1/statements/generate POST
+type V1ResponseStatementsGenerate struct {
+	Requested int `json:"requested"` // the number of the requested statement generations
+}
@@ -464,3 +464,43 @@ func (h *listenHandler) processV1AccountsIDPaymentInfoPut(ctx context.Context, m
 
 	return res, nil
 }
+
+// processV1AccountsIDSpendingSettingsPut handles PUT /v1/accounts/<account-id>/spending_settings request
+func (h *listenHandler) processV1AccountsIDSpendingSettingsPut(ctx context.Context, m *sock.Request) (*sock.Response, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":    "processV1AccountsIDSpendingSettingsPut",
+		"request": m,
+	})
+
+	uriItems := strings.Split(m.URI, "/")
+	if len(uriItems) < 5 {
+		return simpleResponse(400), nil
+	}
+
+	accountID := uuid.FromStringOrNil(uriItems[3])
+
+	var req request.V1DataAccountsIDSpendingSettingsPUT
+	if err := json.Unmarshal([]byte(m.Data), &req); err != nil {
+		return nil, err
+	}
+
+	tmp, err := h.accountHandler.UpdateSpendingSettings(ctx, accountID, req.SpendLimits, req.BalanceAlertThresholds, req.AutoTopUpThreshold, req.AutoTopUpAmount)
+	if err != nil {
+		log.Errorf("Could not update the account's spending settings. err: %v", err)
+		return errorResponse(err), nil
+	}
+
+	data, err := json.Marshal(tmp)
+	if err != nil {
+		log.Errorf("Could not marshal spending settings response. err: %v", err)
+		return simpleResponse(500), nil
+	}
+
+	res := &sock.Response{
+		StatusCode: 200,
+		DataType:   "application/json",
+		Data:       data,
+	}
+
+	return res, nil
+}
@@ -48,7 +48,7 @@ func Test_processV1AccountsIDGet(t *testing.T) {
 			expectRes: &sock.Response{
 				StatusCode: 200,
 				DataType:   "application/json",
-				Data:       []byte(`{"id":"922907b6-0942-11ee-960e-f31d2cc10daa","customer_id":"00000000-0000-0000-0000-000000000000","status":"","name":"","detail":"","plan_type":"","plan_status":"","balance_credit":0,"balance_token":0,"payment_type":"","payment_method":"","paddle_subscription_id":"","paddle_customer_id":"","tm_last_topup":null,"tm_next_topup":null,"tm_create":null,"tm_update":null,"tm_delete":null}`),
+				Data:       []byte(`{"id":"922907b6-0942-11ee-960e-f31d2cc10daa","customer_id":"00000000-0000-0000-0000-000000000000","status":"","name":"","detail":"","plan_type":"","plan_status":"","rate_deck_id":"00000000-0000-0000-0000-000000000000","balance_credit":0,"balance_token":0,"payment_type":"","payment_method":"","spend_limits":null,"balance_alert_thresholds":null,"auto_topup_threshold":0,"auto_topup_amount":0,"auto_topup_pending_id":"00000000-0000-0000-0000-000000000000","tm_auto_topup_pending":null,"paddle_subscription_id":"","paddle_customer_id":"","tm_last_topup":null,"tm_next_topup":null,"tm_create":null,"tm_update":null,"tm_delete":null}`),
 			},
 		},
 	}
@@ -115,7 +115,7 @@ func Test_processV1AccountsIDPut(t *testing.T) {
 			expectRes: &sock.Response{
 				StatusCode: 200,
 				DataType:   "application/json",
-				Data:       []byte(`{"id":"3a952284-4ccf-11ee-bd5e-03a7d7220fad","customer_id":"00000000-0000-0000-0000-000000000000","status":"","name":"","detail":"","plan_type":"","plan_status":"","balance_credit":0,"balance_token":0,"payment_type":"","payment_method":"","paddle_subscription_id":"","paddle_customer_id":"","tm_last_topup":null,"tm_next_topup":null,"tm_create":null,"tm_update":null,"tm_delete":null}`),
+				Data:       []byte(`{"id":"3a952284-4ccf-11ee-bd5e-03a7d7220fad","customer_id":"00000000-0000-0000-0000-000000000000","status":"","name":"","detail":"","plan_type":"","plan_status":"","rate_deck_id":"00000000-0000-0000-0000-000000000000","balance_credit":0,"balance_token":0,"payment_type":"","payment_method":"","spend_limits":null,"balance_alert_thresholds":null,"auto_topup_threshold":0,"auto_topup_amount":0,"auto_topup_pending_id":"00000000-0000-0000-0000-000000000000","tm_auto_topup_pending":null,"paddle_subscription_id":"","paddle_customer_id":"","tm_last_topup":null,"tm_next_topup":null,"tm_create":null,"tm_update":null,"tm_delete":null}`),
 			},
 		},
 	}
@@ -180,7 +180,7 @@ func Test_processV1AccountsIDBalanceAddForcePost(t *testing.T) {
 			expectRes: &sock.Response{
 				StatusCode: 200,
 				DataType:   "application/json",
-				Data:       []byte(`{"id":"42d34adc-0dbb-11ee-a41b-eb337ba453c8","customer_id":"00000000-0000-0000-0000-000000000000","status":"","name":"","detail":"","plan_type":"","plan_status":"","balance_credit":0,"balance_token":0,"payment_type":"","payment_method":"","paddle_subscription_id":"","paddle_customer_id":"","tm_last_topup":null,"tm_next_topup":null,"tm_create":null,"tm_update":null,"tm_delete":null}`),
+				Data:       []byte(`{"id":"42d34adc-0dbb-11ee-a41b-eb337ba453c8","customer_id":"00000000-0000-0000-0000-000000000000","status":"","name":"","detail":"","plan_type":"","plan_status":"","rate_deck_id":"00000000-0000-0000-0000-000000000000","balance_credit":0,"balance_token":0,"payment_type":"","payment_method":"","spend_limits":null,"balance_alert_thresholds":null,"auto_topup_threshold":0,"auto_topup_amount":0,"auto_topup_pending_id":"00000000-0000-0000-0000-000000000000","tm_auto_topup_pending":null,"paddle_subscription_id":"","paddle_customer_id":"","tm_last_topup":null,"tm_next_topup":null,"tm_create":null,"tm_update":null,"tm_delete":null}`),
 			},
 		},
 	}
@@ -245,7 +245,7 @@ func Test_processV1AccountsIDBalanceSubtractForcePost(t *testing.T) {
 			expectRes: &sock.Response{
 				StatusCode: 200,
 				DataType:   "application/json",
-				Data:       []byte(`{"id":"43180e06-0dbb-11ee-8124-17d122da2950","customer_id":"00000000-0000-0000-0000-000000000000","status":"","name":"","detail":"","plan_type":"","plan_status":"","balance_credit":0,"balance_token":0,"payment_type":"","payment_method":"","paddle_subscription_id":"","paddle_customer_id":"","tm_last_topup":null,"tm_next_topup":null,"tm_create":null,"tm_update":null,"tm_delete":null}`),
+				Data:       []byte(`{"id":"43180e06-0dbb-11ee-8124-17d122da2950","customer_id":"00000000-0000-0000-0000-000000000000","status":"","name":"","detail":"","plan_type":"","plan_status":"","rate_deck_id":"00000000-0000-0000-0000-000000000000","balance_credit":0,"balance_token":0,"payment_type":"","payment_method":"","spend_limits":null,"balance_alert_thresholds":null,"auto_topup_threshold":0,"auto_topup_amount":0,"auto_topup_pending_id":"00000000-0000-0000-0000-000000000000","tm_auto_topup_pending":null,"paddle_subscription_id":"","paddle_customer_id":"","tm_last_topup":null,"tm_next_topup":null,"tm_create":null,"tm_update":null,"tm_delete":null}`),
 			},
 		},
 	}
@@ -377,7 +377,7 @@ func Test_processV1AccountsIDPaymentInfoPut(t *testing.T) {
 			expectRes: &sock.Response{
 				StatusCode: 200,
 				DataType:   "application/json",
-				Data:       []byte(`{"id":"512ab538-4cd2-11ee-91be-7779c29dd4f8","customer_id":"00000000-0000-0000-0000-000000000000","status":"","name":"","detail":"","plan_type":"","plan_status":"","balance_credit":0,"balance_token":0,"payment_type":"","payment_method":"","paddle_subscription_id":"","paddle_customer_id":"","tm_last_topup":null,"tm_next_topup":null,"tm_create":null,"tm_update":null,"tm_delete":null}`),
+				Data:       []byte(`{"id":"512ab538-4cd2-11ee-91be-7779c29dd4f8","customer_id":"00000000-0000-0000-0000-000000000000","status":"","name":"","detail":"","plan_type":"","plan_status":"","rate_deck_id":"00000000-0000-0000-0000-000000000000","balance_credit":0,"balance_token":0,"payment_type":"","payment_method":"","spend_limits":null,"balance_alert_thresholds":null,"auto_topup_threshold":0,"auto_topup_amount":0,"auto_topup_pending_id":"00000000-0000-0000-0000-000000000000","tm_auto_topup_pending":null,"paddle_subscription_id":"","paddle_customer_id":"","tm_last_topup":null,"tm_next_topup":null,"tm_create":null,"tm_update":null,"tm_delete":null}`),
 			},
 		},
 	}
@@ -542,3 +542,80 @@ func Test_processV1AccountsTopUpPost(t *testing.T) {
 		})
 	}
 }
+
+func Test_processV1AccountsIDSpendingSettingsPut(t *testing.T) {
+
+	type test struct {
+		name    string
+		request *sock.Request
+
+		responseAccount *account.Account
+
+		expectAccountID              uuid.UUID
+		expectSpendLimits            []account.SpendLimit
+		expectBalanceAlertThresholds []int64
+		expectAutoTopUpThreshold     int64
+		expectAutoTopUpAmount        int64
+		expectRes                    *sock.Response
+	}
+
+	tests := []test{
+		{
+			name: "normal",
+			request: &sock.Request{
+				URI:      "/v1/accounts/6a1f3c52-0b6e-11f0-a2b4-1b7e9c4d2f01/spending_settings",
+				Method:   sock.RequestMethodPut,
+				DataType: requesthandler.ContentTypeJSON,
+				Data:     []byte(`{"spend_limits":[{"period":"daily","cost_type":"call_pstn_outgoing","limit_credit":5000000}],"balance_alert_thresholds":[10000000,5000000],"auto_topup_threshold":2000000,"auto_topup_amount":20000000}`),
+			},
+
+			responseAccount: &account.Account{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("6a1f3c52-0b6e-11f0-a2b4-1b7e9c4d2f01"),
+				},
+			},
+
+			expectAccountID: uuid.FromStringOrNil("6a1f3c52-0b6e-11f0-a2b4-1b7e9c4d2f01"),
+			expectSpendLimits: []account.SpendLimit{
+				{
+					Period:      account.SpendLimitPeriodDaily,
+					CostType:    billing.CostTypeCallPSTNOutgoing,
+					LimitCredit: 5000000,
+				},
+			},
+			expectBalanceAlertThresholds: []int64{10000000, 5000000},
+			expectAutoTopUpThreshold:     2000000,
+			expectAutoTopUpAmount:        20000000,
+			expectRes: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"6a1f3c52-0b6e-11f0-a2b4-1b7e9c4d2f01","customer_id":"00000000-0000-0000-0000-000000000000","status":"","name":"","detail":"","plan_type":"","plan_status":"","rate_deck_id":"00000000-0000-0000-0000-000000000000","balance_credit":0,"balance_token":0,"payment_type":"","payment_method":"","spend_limits":null,"balance_alert_thresholds":null,"auto_topup_threshold":0,"auto_topup_amount":0,"auto_topup_pending_id":"00000000-0000-0000-0000-000000000000","tm_auto_topup_pending":null,"paddle_subscription_id":"","paddle_customer_id":"","tm_last_topup":null,"tm_next_topup":null,"tm_create":null,"tm_update":null,"tm_delete":null}`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockAccount := accounthandler.NewMockAccountHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:    mockSock,
+				accountHandler: mockAccount,
+			}
+
+			mockAccount.EXPECT().UpdateSpendingSettings(gomock.Any(), tt.expectAccountID, tt.expectSpendLimits, tt.expectBalanceAlertThresholds, tt.expectAutoTopUpThreshold, tt.expectAutoTopUpAmount).Return(tt.responseAccount, nil)
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.expectRes) != true {
+				t.Errorf("Wrong match.\nexepct: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
@@ -57,7 +57,7 @@ func Test_processV1BillingsGet(t *testing.T) {
 			expectRes: &sock.Response{
 				StatusCode: 200,
 				DataType:   "application/json",
-				Data:       []byte(`[{"id":"69cacd9e-f542-11ee-ab6d-afb3c2c93e56","customer_id":"00000000-0000-0000-0000-000000000000","account_id":"00000000-0000-0000-0000-000000000000","transaction_type":"","status":"","reference_type":"","reference_id":"00000000-0000-0000-0000-000000000000","cost_type":"","usage_duration":0,"billable_units":0,"rate_token_per_unit":0,"rate_credit_per_unit":0,"amount_token":0,"amount_credit":0,"balance_token_snapshot":0,"balance_credit_snapshot":0,"idempotency_key":"00000000-0000-0000-0000-000000000000","tm_billing_start":null,"tm_billing_end":null,"tm_create":null,"tm_update":null,"tm_delete":null},{"id":"6a1d387c-f542-11ee-b2cb-a36ed20fc369","customer_id":"00000000-0000-0000-0000-000000000000","account_id":"00000000-0000-0000-0000-000000000000","transaction_type":"","status":"","reference_type":"","reference_id":"00000000-0000-0000-0000-000000000000","cost_type":"","usage_duration":0,"billable_units":0,"rate_token_per_unit":0,"rate_credit_per_unit":0,"amount_token":0,"amount_credit":0,"balance_token_snapshot":0,"balance_credit_snapshot":0,"idempotency_key":"00000000-0000-0000-0000-000000000000","tm_billing_start":null,"tm_billing_end":null,"tm_create":null,"tm_update":null,"tm_delete":null}]`),
+				Data:       []byte(`[{"id":"69cacd9e-f542-11ee-ab6d-afb3c2c93e56","customer_id":"00000000-0000-0000-0000-000000000000","account_id":"00000000-0000-0000-0000-000000000000","transaction_type":"","status":"","reference_type":"","reference_id":"00000000-0000-0000-0000-000000000000","cost_type":"","usage_duration":0,"billable_units":0,"billed_duration":0,"rate_id":"00000000-0000-0000-0000-000000000000","rate_token_per_unit":0,"rate_credit_per_unit":0,"rate_connection_fee":0,"rate_increment_initial":0,"rate_increment_subsequent":0,"amount_token":0,"amount_credit":0,"balance_token_snapshot":0,"balance_credit_snapshot":0,"idempotency_key":"00000000-0000-0000-0000-000000000000","tm_billing_start":null,"tm_billing_end":null,"tm_create":null,"tm_update":null,"tm_delete":null},{"id":"6a1d387c-f542-11ee-b2cb-a36ed20fc369","customer_id":"00000000-0000-0000-0000-000000000000","account_id":"00000000-0000-0000-0000-000000000000","transaction_type":"","status":"","reference_type":"","reference_id":"00000000-0000-0000-0000-000000000000","cost_type":"","usage_duration":0,"billable_units":0,"billed_duration":0,"rate_id":"00000000-0000-0000-0000-000000000000","rate_token_per_unit":0,"rate_credit_per_unit":0,"rate_connection_fee":0,"rate_increment_initial":0,"rate_increment_subsequent":0,"amount_token":0,"amount_credit":0,"balance_token_snapshot":0,"balance_credit_snapshot":0,"idempotency_key":"00000000-0000-0000-0000-000000000000","tm_billing_start":null,"tm_billing_end":null,"tm_create":null,"tm_update":null,"tm_delete":null}]`),
 			},
 		},
 	}
@@ -0,0 +1,82 @@
+package listenhandler
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"monorepo/bin-common-handler/models/sock"
+
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+
+	"monorepo/bin-billing-manager/pkg/listenhandler/models/request"
+)
+
+// processV1EstimatesPost handles POST /v1/estimates request
+func (h *listenHandler) processV1EstimatesPost(ctx context.Context, m *sock.Request) (*sock.Response, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":    "processV1EstimatesPost",
+		"request": m,
+	})
+
+	var req request.V1DataEstimatesPOST
+	if err := json.Unmarshal(m.Data, &req); err != nil {
+		log.Errorf("Could not unmarshal the data. data: %v, err: %v", m.Data, err)
+		return simpleResponse(400), nil
+	}
+
+	tmp, err := h.billingHandler.EstimateQuote(ctx, req.CustomerID, req.CostType, req.Destination, req.Duration)
+	if err != nil {
+		log.Errorf("Could not estimate the cost. err: %v", err)
+		return errorResponse(err), nil
+	}
+
+	data, err := json.Marshal(tmp)
+	if err != nil {
+		log.Errorf("Could not marshal estimate. err: %v", err)
+		return simpleResponse(500), nil
+	}
+
+	res := &sock.Response{
+		StatusCode: 200,
+		DataType:   "application/json",
+		Data:       data,
+	}
+
+	return res, nil
+}
+
+// processV1EstimatesReferenceIDIDGet handles GET /v1/estimates/reference_id/<reference-id> request
+func (h *listenHandler) processV1EstimatesReferenceIDIDGet(ctx context.Context, m *sock.Request) (*sock.Response, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":    "processV1EstimatesReferenceIDIDGet",
+		"request": m,
+	})
+
+	uriItems := strings.Split(m.URI, "/")
+	if len(uriItems) < 5 {
+		return simpleResponse(400), nil
+	}
+	referenceID := uuid.FromStringOrNil(uriItems[4])
+
+	tmp, err := h.billingHandler.EstimateGetByReferenceID(ctx, referenceID)
+	if err != nil {
+		log.Errorf("Could not get the estimate. reference_id: %s, err: %v", referenceID, err)
+		return errorResponse(err), nil
+	}
+
+	data, err := json.Marshal(tmp)
+	if err != nil {
+		log.Errorf("Could not marshal estimate. err: %v", err)
+		return simpleResponse(500), nil
+	}
+
+	res := &sock.Response{
+		StatusCode: 200,
+		DataType:   "application/json",
+		Data:       data,
+	}
+
+	return res, nil
+}
@@ -0,0 +1,151 @@
+package listenhandler
+
+import (
+	"reflect"
+	"testing"
+
+	commonaddress "monorepo/bin-common-handler/models/address"
+	"monorepo/bin-common-handler/models/sock"
+	"monorepo/bin-common-handler/pkg/sockhandler"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+
+	"monorepo/bin-billing-manager/models/billing"
+	"monorepo/bin-billing-manager/models/estimate"
+	"monorepo/bin-billing-manager/pkg/billinghandler"
+)
+
+func Test_processV1EstimatesPost(t *testing.T) {
+
+	type test struct {
+		name    string
+		request *sock.Request
+
+		responseEstimate *estimate.Estimate
+
+		expectCustomerID  uuid.UUID
+		expectCostType    billing.CostType
+		expectDestination *commonaddress.Address
+		expectDuration    int
+		expectRes         *sock.Response
+	}
+
+	tests := []test{
+		{
+			name: "normal",
+			request: &sock.Request{
+				URI:      "/v1/estimates",
+				Method:   sock.RequestMethodPost,
+				DataType: "application/json",
+				Data:     []byte(`{"customer_id":"c1a2b3c4-b2d4-11f0-8d9e-0a1b2c3d4e5f","cost_type":"call_pstn_outgoing","destination":{"type":"tel","target":"+821012345678"},"duration":150}`),
+			},
+
+			responseEstimate: &estimate.Estimate{
+				CustomerID:   uuid.FromStringOrNil("c1a2b3c4-b2d4-11f0-8d9e-0a1b2c3d4e5f"),
+				CostType:     billing.CostTypeCallPSTNOutgoing,
+				Duration:     150,
+				AmountCredit: 30000,
+			},
+
+			expectCustomerID: uuid.FromStringOrNil("c1a2b3c4-b2d4-11f0-8d9e-0a1b2c3d4e5f"),
+			expectCostType:   billing.CostTypeCallPSTNOutgoing,
+			expectDestination: &commonaddress.Address{
+				Type:   commonaddress.TypeTel,
+				Target: "+821012345678",
+			},
+			expectDuration: 150,
+			expectRes: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"customer_id":"c1a2b3c4-b2d4-11f0-8d9e-0a1b2c3d4e5f","account_id":"00000000-0000-0000-0000-000000000000","billing_id":"00000000-0000-0000-0000-000000000000","reference_type":"","reference_id":"00000000-0000-0000-0000-000000000000","cost_type":"call_pstn_outgoing","rate_id":"00000000-0000-0000-0000-000000000000","rate_credit_per_unit":0,"rate_connection_fee":0,"rate_increment_initial":0,"rate_increment_subsequent":0,"duration":150,"billable_units":0,"billed_duration":0,"amount_credit":30000}`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockBilling := billinghandler.NewMockBillingHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:    mockSock,
+				billingHandler: mockBilling,
+			}
+
+			mockBilling.EXPECT().EstimateQuote(gomock.Any(), tt.expectCustomerID, tt.expectCostType, tt.expectDestination, tt.expectDuration).Return(tt.responseEstimate, nil)
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.expectRes) != true {
+				t.Errorf("Wrong match.\nexepct: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_processV1EstimatesReferenceIDIDGet(t *testing.T) {
+
+	type test struct {
+		name    string
+		request *sock.Request
+
+		responseEstimate *estimate.Estimate
+
+		expectReferenceID uuid.UUID
+		expectRes         *sock.Response
+	}
+
+	tests := []test{
+		{
+			name: "normal",
+			request: &sock.Request{
+				URI:    "/v1/estimates/reference_id/d2b3c4d5-b2d4-11f0-9e0f-1b2c3d4e5f6a",
+				Method: sock.RequestMethodGet,
+			},
+
+			responseEstimate: &estimate.Estimate{
+				ReferenceType: billing.ReferenceTypeCall,
+				ReferenceID:   uuid.FromStringOrNil("d2b3c4d5-b2d4-11f0-9e0f-1b2c3d4e5f6a"),
+				AmountCredit:  20000,
+			},
+
+			expectReferenceID: uuid.FromStringOrNil("d2b3c4d5-b2d4-11f0-9e0f-1b2c3d4e5f6a"),
+			expectRes: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"customer_id":"00000000-0000-0000-0000-000000000000","account_id":"00000000-0000-0000-0000-000000000000","billing_id":"00000000-0000-0000-0000-000000000000","reference_type":"call","reference_id":"d2b3c4d5-b2d4-11f0-9e0f-1b2c3d4e5f6a","cost_type":"","rate_id":"00000000-0000-0000-0000-000000000000","rate_credit_per_unit":0,"rate_connection_fee":0,"rate_increment_initial":0,"rate_increment_subsequent":0,"duration":0,"billable_units":0,"billed_duration":0,"amount_credit":20000}`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockBilling := billinghandler.NewMockBillingHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:    mockSock,
+				billingHandler: mockBilling,
+			}
+
+			mockBilling.EXPECT().EstimateGetByReferenceID(gomock.Any(), tt.expectReferenceID).Return(tt.responseEstimate, nil)
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.expectRes) != true {
+				t.Errorf("Wrong match.\nexepct: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
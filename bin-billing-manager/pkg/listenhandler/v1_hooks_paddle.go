@@ -22,6 +22,9 @@ type paddleEvent struct {
 	Data      json.RawMessage `json:"data"`
 }
 
+// paddleOriginSubscriptionCharge is the transaction origin of the subscription's one-time charges.
+const paddleOriginSubscriptionCharge = "subscription_charge"
+
 // paddleCustomData contains the VoIPBin customer ID embedded in Paddle's custom_data.
 type paddleCustomData struct {
 	CustomerID string `json:"customer_id"`
@@ -39,6 +42,7 @@ type paddleAdjustment struct {
 type paddleTransactionData struct {
 	ID             string            `json:"id"`
 	SubscriptionID *string           `json:"subscription_id"`
+	Origin         string            `json:"origin"` // subscription_charge for the one-time charges of the subscription
 	CustomData     *paddleCustomData `json:"custom_data"`
 	Details        struct {
 		Totals struct {
@@ -136,7 +140,8 @@ func (h *listenHandler) processV1HooksPaddlePost(ctx context.Context, m *sock.Re
 }
 
 // handlePaddleTransactionCompleted handles transaction.completed events.
-// If subscription_id is present → subscription renewal; otherwise → one-time credit purchase.
+// If origin is subscription_charge → auto top-up credit purchase;
+// if subscription_id is present → subscription renewal; otherwise → one-time credit purchase.
 func (h *listenHandler) handlePaddleTransactionCompleted(ctx context.Context, event *paddleEvent) (*sock.Response, error) {
 	log := logrus.WithFields(logrus.Fields{
 		"func":     "handlePaddleTransactionCompleted",
@@ -149,6 +154,11 @@ func (h *listenHandler) handlePaddleTransactionCompleted(ctx context.Context, ev
 		return simpleResponse(400), nil
 	}
 
+	// Auto top-up: one-time charge of the subscription
+	if txn.Origin == paddleOriginSubscriptionCharge && txn.SubscriptionID != nil && *txn.SubscriptionID != "" {
+		return h.handlePaddleSubscriptionCharge(ctx, event, &txn)
+	}
+
 	// Subscription renewal: has subscription_id
 	if txn.SubscriptionID != nil && *txn.SubscriptionID != "" {
 		log.Infof("Processing subscription renewal. transaction_id: %s, subscription_id: %s", txn.ID, *txn.SubscriptionID)
@@ -187,6 +197,45 @@ func (h *listenHandler) handlePaddleTransactionCompleted(ctx context.Context, ev
 	return simpleResponse(200), nil
 }
 
+// handlePaddleSubscriptionCharge handles the completed one-time charge of the subscription.
+// The charge is the auto top-up, so the amount is added to the subscription's account as credit
+// and the account's pending auto top-up is cleared.
+func (h *listenHandler) handlePaddleSubscriptionCharge(ctx context.Context, event *paddleEvent, txn *paddleTransactionData) (*sock.Response, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":            "handlePaddleSubscriptionCharge",
+		"event_id":        event.EventID,
+		"transaction_id":  txn.ID,
+		"subscription_id": *txn.SubscriptionID,
+	})
+
+	acc, err := h.accountHandler.GetByPaddleSubscriptionID(ctx, *txn.SubscriptionID)
+	if err != nil {
+		log.Errorf("Could not get the account of the subscription: %v", err)
+		return simpleResponse(500), nil
+	}
+
+	amountMicros, err := parsePaddleCentsToMicros(txn.Details.Totals.Total)
+	if err != nil {
+		log.Errorf("Could not parse transaction amount: %v", err)
+		return simpleResponse(400), nil
+	}
+
+	log.Infof("Processing auto top-up. account_id: %s, amount_micros: %d", acc.ID, amountMicros)
+	if err := h.accountHandler.PaddleCreditTopUp(ctx, acc.CustomerID, amountMicros, event.EventID); err != nil {
+		log.Errorf("Could not process auto top-up: %v", err)
+		return simpleResponse(500), nil
+	}
+
+	// the credit is added, so the next crossing of the threshold can be charged.
+	// the credit top-up is idempotent by the event, so the failed clear is retried by paddle.
+	if err := h.accountHandler.ClearAutoTopUpPending(ctx, acc.ID); err != nil {
+		log.Errorf("Could not clear the pending auto top-up: %v", err)
+		return simpleResponse(500), nil
+	}
+	log.Infof("Auto top-up completed. account_id: %s, amount_micros: %d", acc.ID, amountMicros)
+	return simpleResponse(200), nil
+}
+
 // handlePaddleSubscriptionCreated handles subscription.created events.
 func (h *listenHandler) handlePaddleSubscriptionCreated(ctx context.Context, event *paddleEvent) (*sock.Response, error) {
 	log := logrus.WithFields(logrus.Fields{
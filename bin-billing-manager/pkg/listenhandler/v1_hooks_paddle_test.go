@@ -69,6 +69,55 @@ func Test_processV1HooksPaddlePost(t *testing.T) {
 			},
 			expectRes: simpleResponse(200),
 		},
+		{
+			// "2000" = $20.00 → 2000 × 10,000 = 20,000,000 micros
+			name:   "transaction.completed - subscription charge of auto top-up",
+			paddle: `{"event_id":"evt_charge_001","event_type":"transaction.completed","data":{"id":"txn_charge_001","subscription_id":"sub_charge_001","origin":"subscription_charge","details":{"totals":{"total":"2000"}}}}`,
+			setup: func(m *accounthandler.MockAccountHandler, _ *paddlehandler.MockPaddleHandler) {
+				m.EXPECT().GetByPaddleSubscriptionID(gomock.Any(), "sub_charge_001").Return(&account.Account{
+					Identity: commonidentity.Identity{
+						ID:         uuid.FromStringOrNil("a0000012-0000-0000-0000-000000000001"),
+						CustomerID: uuid.FromStringOrNil("a0000012-0000-0000-0000-000000000002"),
+					},
+				}, nil)
+				m.EXPECT().PaddleCreditTopUp(
+					gomock.Any(),
+					uuid.FromStringOrNil("a0000012-0000-0000-0000-000000000002"),
+					int64(20000000),
+					"evt_charge_001",
+				).Return(nil)
+				m.EXPECT().ClearAutoTopUpPending(gomock.Any(), uuid.FromStringOrNil("a0000012-0000-0000-0000-000000000001")).Return(nil)
+			},
+			expectRes: simpleResponse(200),
+		},
+		{
+			name:   "transaction.completed - subscription charge fails to clear the pending auto top-up",
+			paddle: `{"event_id":"evt_charge_003","event_type":"transaction.completed","data":{"id":"txn_charge_003","subscription_id":"sub_charge_003","origin":"subscription_charge","details":{"totals":{"total":"2000"}}}}`,
+			setup: func(m *accounthandler.MockAccountHandler, _ *paddlehandler.MockPaddleHandler) {
+				m.EXPECT().GetByPaddleSubscriptionID(gomock.Any(), "sub_charge_003").Return(&account.Account{
+					Identity: commonidentity.Identity{
+						ID:         uuid.FromStringOrNil("a0000013-0000-0000-0000-000000000001"),
+						CustomerID: uuid.FromStringOrNil("a0000013-0000-0000-0000-000000000002"),
+					},
+				}, nil)
+				m.EXPECT().PaddleCreditTopUp(
+					gomock.Any(),
+					uuid.FromStringOrNil("a0000013-0000-0000-0000-000000000002"),
+					int64(20000000),
+					"evt_charge_003",
+				).Return(nil)
+				m.EXPECT().ClearAutoTopUpPending(gomock.Any(), uuid.FromStringOrNil("a0000013-0000-0000-0000-000000000001")).Return(fmt.Errorf("db error"))
+			},
+			expectRes: simpleResponse(500),
+		},
+		{
+			name:   "transaction.completed - subscription charge of unknown subscription",
+			paddle: `{"event_id":"evt_charge_002","event_type":"transaction.completed","data":{"id":"txn_charge_002","subscription_id":"sub_charge_002","origin":"subscription_charge","details":{"totals":{"total":"2000"}}}}`,
+			setup: func(m *accounthandler.MockAccountHandler, _ *paddlehandler.MockPaddleHandler) {
+				m.EXPECT().GetByPaddleSubscriptionID(gomock.Any(), "sub_charge_002").Return(nil, fmt.Errorf("not found"))
+			},
+			expectRes: simpleResponse(500),
+		},
 		{
 			name:   "subscription.created",
 			paddle: `{"event_id":"evt_sub_create_001","event_type":"subscription.created","data":{"id":"sub_001","customer_id":"ctm_paddle_001","custom_data":{"customer_id":"a0000003-0000-0000-0000-000000000001","plan_type":"basic"},"items":[{"price":{"product_id":"pro_basic"}}]}}`,
@@ -0,0 +1,284 @@
+package listenhandler
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"monorepo/bin-billing-manager/models/statement"
+	"monorepo/bin-billing-manager/pkg/statementhandler"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/models/sock"
+	"monorepo/bin-common-handler/pkg/sockhandler"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_processV1StatementsGet(t *testing.T) {
+
+	type test struct {
+		name    string
+		request *sock.Request
+
+		responseStatements []*statement.Statement
+
+		expectFilters map[statement.Field]any
+		expectSize    uint64
+		expectToken   string
+		expectRes     *sock.Response
+	}
+
+	tests := []test{
+		{
+			name: "normal",
+			request: &sock.Request{
+				URI:      "/v1/statements?page_size=10&page_token=2026-10-01T03:00:00.000000Z",
+				Method:   sock.RequestMethodGet,
+				DataType: "application/json",
+				Data:     []byte(`{"customer_id":"0c5b8e3a-b1a5-11f0-9d4e-6f2a1b3c4d50","deleted":false}`),
+			},
+
+			responseStatements: []*statement.Statement{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("0c8a3f1e-b1a5-11f0-8b2c-1e4d5f6a7b60"),
+					},
+				},
+			},
+
+			expectFilters: map[statement.Field]any{
+				statement.FieldCustomerID: uuid.FromStringOrNil("0c5b8e3a-b1a5-11f0-9d4e-6f2a1b3c4d50"),
+				statement.FieldDeleted:    false,
+			},
+			expectSize:  10,
+			expectToken: "2026-10-01T03:00:00.000000Z",
+			expectRes: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`[{"id":"0c8a3f1e-b1a5-11f0-8b2c-1e4d5f6a7b60","customer_id":"00000000-0000-0000-0000-000000000000","account_id":"00000000-0000-0000-0000-000000000000","status":"","tm_period_start":null,"tm_period_end":null,"items":null,"top_destinations":null,"top_ups":null,"total_usage_token":0,"total_usage_credit":0,"total_top_up_token":0,"total_top_up_credit":0,"balance_token_end":0,"balance_credit_end":0,"pdf_file_id":"00000000-0000-0000-0000-000000000000","csv_file_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}]`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockStatement := statementhandler.NewMockStatementHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:      mockSock,
+				statementHandler: mockStatement,
+			}
+
+			mockStatement.EXPECT().List(gomock.Any(), tt.expectSize, tt.expectToken, tt.expectFilters).Return(tt.responseStatements, nil)
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.expectRes) != true {
+				t.Errorf("Wrong match.\nexepct: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_processV1StatementsPost(t *testing.T) {
+
+	type test struct {
+		name    string
+		request *sock.Request
+
+		responseStatement *statement.Statement
+
+		expectAccountID     uuid.UUID
+		expectTMPeriodStart time.Time
+		expectRes           *sock.Response
+	}
+
+	tests := []test{
+		{
+			name: "normal",
+			request: &sock.Request{
+				URI:      "/v1/statements",
+				Method:   sock.RequestMethodPost,
+				DataType: "application/json",
+				Data:     []byte(`{"account_id":"3a1f6c2e-b1a5-11f0-a7d3-4c5e6f7a8b90","tm_period_start":"2026-09-01T00:00:00Z"}`),
+			},
+
+			responseStatement: &statement.Statement{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("3a4b8d2c-b1a5-11f0-95e1-7d8e9f0a1b20"),
+				},
+			},
+
+			expectAccountID:     uuid.FromStringOrNil("3a1f6c2e-b1a5-11f0-a7d3-4c5e6f7a8b90"),
+			expectTMPeriodStart: time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC),
+			expectRes: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"3a4b8d2c-b1a5-11f0-95e1-7d8e9f0a1b20","customer_id":"00000000-0000-0000-0000-000000000000","account_id":"00000000-0000-0000-0000-000000000000","status":"","tm_period_start":null,"tm_period_end":null,"items":null,"top_destinations":null,"top_ups":null,"total_usage_token":0,"total_usage_credit":0,"total_top_up_token":0,"total_top_up_credit":0,"balance_token_end":0,"balance_credit_end":0,"pdf_file_id":"00000000-0000-0000-0000-000000000000","csv_file_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockStatement := statementhandler.NewMockStatementHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:      mockSock,
+				statementHandler: mockStatement,
+			}
+
+			mockStatement.EXPECT().Create(gomock.Any(), tt.expectAccountID, tt.expectTMPeriodStart).Return(tt.responseStatement, nil)
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.expectRes) != true {
+				t.Errorf("Wrong match.\nexepct: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_processV1StatementsIDGet(t *testing.T) {
+
+	type test struct {
+		name    string
+		request *sock.Request
+
+		responseStatement *statement.Statement
+
+		expectID  uuid.UUID
+		expectRes *sock.Response
+	}
+
+	tests := []test{
+		{
+			name: "normal",
+			request: &sock.Request{
+				URI:    "/v1/statements/5e2a7c4b-b1a5-11f0-8f3d-2b3c4d5e6f70",
+				Method: sock.RequestMethodGet,
+			},
+
+			responseStatement: &statement.Statement{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("5e2a7c4b-b1a5-11f0-8f3d-2b3c4d5e6f70"),
+				},
+			},
+
+			expectID: uuid.FromStringOrNil("5e2a7c4b-b1a5-11f0-8f3d-2b3c4d5e6f70"),
+			expectRes: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"5e2a7c4b-b1a5-11f0-8f3d-2b3c4d5e6f70","customer_id":"00000000-0000-0000-0000-000000000000","account_id":"00000000-0000-0000-0000-000000000000","status":"","tm_period_start":null,"tm_period_end":null,"items":null,"top_destinations":null,"top_ups":null,"total_usage_token":0,"total_usage_credit":0,"total_top_up_token":0,"total_top_up_credit":0,"balance_token_end":0,"balance_credit_end":0,"pdf_file_id":"00000000-0000-0000-0000-000000000000","csv_file_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockStatement := statementhandler.NewMockStatementHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:      mockSock,
+				statementHandler: mockStatement,
+			}
+
+			mockStatement.EXPECT().Get(gomock.Any(), tt.expectID).Return(tt.responseStatement, nil)
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.expectRes) != true {
+				t.Errorf("Wrong match.\nexepct: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_processV1StatementsGeneratePost(t *testing.T) {
+
+	type test struct {
+		name    string
+		request *sock.Request
+
+		responseRequested int
+		responseErr       error
+
+		expectRes *sock.Response
+	}
+
+	tests := []test{
+		{
+			name: "normal",
+			request: &sock.Request{
+				URI:    "/v1/statements/generate",
+				Method: sock.RequestMethodPost,
+			},
+
+			responseRequested: 5,
+
+			expectRes: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"requested":5}`),
+			},
+		},
+		{
+			name: "handler error maps to non-2xx",
+			request: &sock.Request{
+				URI:    "/v1/statements/generate",
+				Method: sock.RequestMethodPost,
+			},
+
+			responseErr: fmt.Errorf("could not list accounts"),
+
+			expectRes: &sock.Response{
+				StatusCode: 500,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockStatement := statementhandler.NewMockStatementHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:      mockSock,
+				statementHandler: mockStatement,
+			}
+
+			mockStatement.EXPECT().GenerateDue(gomock.Any()).Return(tt.responseRequested, tt.responseErr)
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.expectRes) != true {
+				t.Errorf("Wrong match.\nexepct: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
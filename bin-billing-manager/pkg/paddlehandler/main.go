@@ -25,17 +25,19 @@ const (
 type PaddleHandler interface {
 	CreatePortalSession(ctx context.Context, paddleCustomerID string) (string, error)
 	GetPlanTypeByPriceID(priceID string) (account.PlanType, error)
+	ChargeSubscription(ctx context.Context, paddleSubscriptionID string, amountCents int64, idempotencyKey string) error
 }
 
 type paddleHandler struct {
-	apiKey     string
-	baseURL    string
-	httpClient *http.Client
-	priceMap   map[string]account.PlanType
+	apiKey          string
+	baseURL         string
+	httpClient      *http.Client
+	priceMap        map[string]account.PlanType
+	productIDCredit string
 }
 
 // NewPaddleHandler creates a new PaddleHandler.
-func NewPaddleHandler(apiKey string, priceIDBasic string, priceIDProfessional string) PaddleHandler {
+func NewPaddleHandler(apiKey string, priceIDBasic string, priceIDProfessional string, productIDCredit string) PaddleHandler {
 	priceMap := make(map[string]account.PlanType)
 	if priceIDBasic != "" {
 		priceMap[priceIDBasic] = account.PlanTypeBasic
@@ -50,7 +52,8 @@ func NewPaddleHandler(apiKey string, priceIDBasic string, priceIDProfessional st
 		httpClient: &http.Client{
 			Timeout: defaultTimeout,
 		},
-		priceMap: priceMap,
+		priceMap:        priceMap,
+		productIDCredit: productIDCredit,
 	}
 }
 
@@ -149,3 +152,91 @@ func (h *paddleHandler) GetPlanTypeByPriceID(priceID string) (account.PlanType,
 
 	return planType, nil
 }
+
+// subscriptionChargeRequest is the request body for Paddle's subscription one-time charge API.
+type subscriptionChargeRequest struct {
+	EffectiveFrom string                   `json:"effective_from"`
+	Items         []subscriptionChargeItem `json:"items"`
+}
+
+// subscriptionChargeItem is the non-catalog item of the subscription one-time charge.
+type subscriptionChargeItem struct {
+	Quantity int `json:"quantity"`
+	Price    struct {
+		Description string `json:"description"`
+		ProductID   string `json:"product_id"`
+		UnitPrice   struct {
+			Amount       string `json:"amount"`
+			CurrencyCode string `json:"currency_code"`
+		} `json:"unit_price"`
+	} `json:"price"`
+}
+
+// ChargeSubscription calls Paddle API to bill the credit purchase to the subscription's payment method immediately.
+// The credit is added when the transaction.completed webhook of the charge arrives.
+// The retried charge sends the same idempotency key, so Paddle bills it once.
+func (h *paddleHandler) ChargeSubscription(ctx context.Context, paddleSubscriptionID string, amountCents int64, idempotencyKey string) error {
+	log := logrus.WithFields(logrus.Fields{
+		"func":                   "ChargeSubscription",
+		"paddle_subscription_id": paddleSubscriptionID,
+		"amount_cents":           amountCents,
+		"idempotency_key":        idempotencyKey,
+	})
+
+	if paddleSubscriptionID == "" {
+		return fmt.Errorf("paddle_subscription_id is empty")
+	}
+	if idempotencyKey == "" {
+		return fmt.Errorf("idempotency_key is empty")
+	}
+	if amountCents <= 0 {
+		return fmt.Errorf("invalid amount: %d", amountCents)
+	}
+	if h.productIDCredit == "" {
+		return fmt.Errorf("paddle credit product id is not configured")
+	}
+
+	item := subscriptionChargeItem{
+		Quantity: 1,
+	}
+	item.Price.Description = "Credit auto top-up"
+	item.Price.ProductID = h.productIDCredit
+	item.Price.UnitPrice.Amount = fmt.Sprintf("%d", amountCents)
+	item.Price.UnitPrice.CurrencyCode = "USD"
+
+	reqBody := subscriptionChargeRequest{
+		EffectiveFrom: "immediately",
+		Items:         []subscriptionChargeItem{item},
+	}
+	body, err := json.Marshal(reqBody)
+	if err != nil {
+		return fmt.Errorf("could not marshal request: %w", err)
+	}
+
+	url := h.baseURL + "/subscriptions/" + paddleSubscriptionID + "/charge"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("could not create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+h.apiKey)
+	req.Header.Set("Idempotency-Key", idempotencyKey)
+
+	log.Infof("Calling Paddle API for subscription charge. paddle_subscription_id: %s", paddleSubscriptionID)
+
+	resp, err := h.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("paddle API request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		const maxResponseSize = 1 << 20 // 1 MB
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+		log.Errorf("Paddle API returned non-200 status. status: %d, body: %s", resp.StatusCode, string(respBody))
+		return fmt.Errorf("paddle API returned status %d", resp.StatusCode)
+	}
+
+	log.Infof("Subscription charged. paddle_subscription_id: %s", paddleSubscriptionID)
+	return nil
+}
@@ -2,6 +2,7 @@ package paddlehandler
 
 import (
 	"context"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -147,7 +148,7 @@ func Test_GetPlanTypeByPriceID(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			h := NewPaddleHandler("test-key", "pri_basic_123", "pri_pro_456")
+			h := NewPaddleHandler("test-key", "pri_basic_123", "pri_pro_456", "pro_credit_789")
 
 			plan, err := h.GetPlanTypeByPriceID(tt.priceID)
 			if (err != nil) != tt.expectErr {
@@ -195,7 +196,7 @@ func Test_NewPaddleHandler(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			h := NewPaddleHandler("test-key", tt.priceIDBasic, tt.priceIDProfessional)
+			h := NewPaddleHandler("test-key", tt.priceIDBasic, tt.priceIDProfessional, "pro_credit_789")
 			ph := h.(*paddleHandler)
 			if len(ph.priceMap) != tt.expectMapLen {
 				t.Errorf("priceMap length = %d, expected = %d", len(ph.priceMap), tt.expectMapLen)
@@ -206,3 +207,109 @@ func Test_NewPaddleHandler(t *testing.T) {
 		})
 	}
 }
+
+func Test_ChargeSubscription(t *testing.T) {
+	tests := []struct {
+		name                 string
+		paddleSubscriptionID string
+		amountCents          int64
+		idempotencyKey       string
+		productIDCredit      string
+		serverStatus         int
+		expectBody           string
+		expectErr            bool
+	}{
+		{
+			name:                 "successful charge",
+			paddleSubscriptionID: "sub_abc123",
+			amountCents:          2000,
+			idempotencyKey:       "2d6e1f3a-ab70-11f0-8b41-c4a6b125e201",
+			productIDCredit:      "pro_credit_789",
+			serverStatus:         200,
+			expectBody:           `{"effective_from":"immediately","items":[{"quantity":1,"price":{"description":"Credit auto top-up","product_id":"pro_credit_789","unit_price":{"amount":"2000","currency_code":"USD"}}}]}`,
+		},
+		{
+			name:                 "empty subscription ID",
+			paddleSubscriptionID: "",
+			amountCents:          2000,
+			idempotencyKey:       "2d6e1f3a-ab70-11f0-8b41-c4a6b125e201",
+			productIDCredit:      "pro_credit_789",
+			expectErr:            true,
+		},
+		{
+			name:                 "invalid amount",
+			paddleSubscriptionID: "sub_abc123",
+			amountCents:          0,
+			idempotencyKey:       "2d6e1f3a-ab70-11f0-8b41-c4a6b125e201",
+			productIDCredit:      "pro_credit_789",
+			expectErr:            true,
+		},
+		{
+			name:                 "empty idempotency key",
+			paddleSubscriptionID: "sub_abc123",
+			amountCents:          2000,
+			productIDCredit:      "pro_credit_789",
+			expectErr:            true,
+		},
+		{
+			name:                 "product ID not configured",
+			paddleSubscriptionID: "sub_abc123",
+			amountCents:          2000,
+			idempotencyKey:       "2d6e1f3a-ab70-11f0-8b41-c4a6b125e201",
+			productIDCredit:      "",
+			expectErr:            true,
+		},
+		{
+			name:                 "paddle returns 400",
+			paddleSubscriptionID: "sub_abc123",
+			amountCents:          2000,
+			idempotencyKey:       "2d6e1f3a-ab70-11f0-8b41-c4a6b125e201",
+			productIDCredit:      "pro_credit_789",
+			serverStatus:         400,
+			expectBody:           `{"effective_from":"immediately","items":[{"quantity":1,"price":{"description":"Credit auto top-up","product_id":"pro_credit_789","unit_price":{"amount":"2000","currency_code":"USD"}}}]}`,
+			expectErr:            true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			baseURL := "http://localhost:0"
+			if tt.serverStatus != 0 {
+				server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					if r.Header.Get("Authorization") != "Bearer test-api-key" {
+						t.Errorf("unexpected Authorization header: %s", r.Header.Get("Authorization"))
+					}
+					if r.Header.Get("Idempotency-Key") != tt.idempotencyKey {
+						t.Errorf("unexpected Idempotency-Key header: %s", r.Header.Get("Idempotency-Key"))
+					}
+					if r.URL.Path != "/subscriptions/"+tt.paddleSubscriptionID+"/charge" {
+						t.Errorf("unexpected path: %s", r.URL.Path)
+					}
+					if r.Method != http.MethodPost {
+						t.Errorf("unexpected method: %s", r.Method)
+					}
+					body, _ := io.ReadAll(r.Body)
+					if string(body) != tt.expectBody {
+						t.Errorf("unexpected body.\nexpect: %s\ngot: %s", tt.expectBody, body)
+					}
+					w.WriteHeader(tt.serverStatus)
+				}))
+				defer server.Close()
+				baseURL = server.URL
+			}
+
+			h := &paddleHandler{
+				apiKey:          "test-api-key",
+				baseURL:         baseURL,
+				httpClient:      http.DefaultClient,
+				priceMap:        map[string]account.PlanType{},
+				productIDCredit: tt.productIDCredit,
+			}
+
+			err := h.ChargeSubscription(context.Background(), tt.paddleSubscriptionID, tt.amountCents, tt.idempotencyKey)
+			if (err != nil) != tt.expectErr {
+				t.Errorf("error = %v, expectErr = %v", err, tt.expectErr)
+			}
+		})
+	}
+}
@@ -41,6 +41,20 @@ func (m *MockPaddleHandler) EXPECT() *MockPaddleHandlerMockRecorder {
 	return m.recorder
 }
 
+// ChargeSubscription mocks base method.
+func (m *MockPaddleHandler) ChargeSubscription(ctx context.Context, paddleSubscriptionID string, amountCents int64, idempotencyKey string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChargeSubscription", ctx, paddleSubscriptionID, amountCents, idempotencyKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChargeSubscription indicates an expected call of ChargeSubscription.
+func (mr *MockPaddleHandlerMockRecorder) ChargeSubscription(ctx, paddleSubscriptionID, amountCents, idempotencyKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChargeSubscription", reflect.TypeOf((*MockPaddleHandler)(nil).ChargeSubscription), ctx, paddleSubscriptionID, amountCents, idempotencyKey)
+}
+
 // CreatePortalSession mocks base method.
 func (m *MockPaddleHandler) CreatePortalSession(ctx context.Context, paddleCustomerID string) (string, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,189 @@
+package ratedeckhandler
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	cerrors "monorepo/bin-common-handler/models/errors"
+	commonoutline "monorepo/bin-common-handler/models/outline"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"monorepo/bin-billing-manager/models/billing"
+	"monorepo/bin-billing-manager/models/rate"
+)
+
+// list of the rate import's csv columns
+const (
+	importColumnCostType            = "cost_type"
+	importColumnPrefix              = "prefix"
+	importColumnCreditPerUnit       = "credit_per_unit"
+	importColumnConnectionFee       = "connection_fee"
+	importColumnIncrementInitial    = "increment_initial"
+	importColumnIncrementSubsequent = "increment_subsequent"
+	importColumnTMEffectiveStart    = "tm_effective_start"
+	importColumnTMEffectiveEnd      = "tm_effective_end"
+)
+
+// RateImport creates the rates of the given csv into the rate deck and returns the number of the created rates.
+// The csv must have a header row. The cost_type, prefix and credit_per_unit columns are required.
+// The effective times are RFC3339 timestamps.
+// The import is all or nothing. Any invalid row fails the whole import.
+func (h *rateDeckHandler) RateImport(ctx context.Context, rateDeckID uuid.UUID, src io.Reader) (int, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":         "RateImport",
+		"rate_deck_id": rateDeckID,
+	})
+
+	d, err := h.Get(ctx, rateDeckID)
+	if err != nil {
+		log.Errorf("Could not get the rate deck. err: %v", err)
+		return 0, err
+	}
+	if d.TMDelete != nil {
+		return 0, cerrors.FailedPrecondition(
+			commonoutline.ServiceNameBillingManager,
+			"RATE_DECK_DELETED",
+			"The rate deck was deleted.",
+		)
+	}
+
+	r := csv.NewReader(src)
+	r.TrimLeadingSpace = true
+	header, err := r.Read()
+	if err != nil {
+		return 0, invalidCSV("Could not read the csv header.").Wrap(err)
+	}
+
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range []string{importColumnCostType, importColumnPrefix, importColumnCreditPerUnit} {
+		if _, ok := columns[name]; !ok {
+			return 0, invalidCSV(fmt.Sprintf("The csv header has no %s column.", name))
+		}
+	}
+
+	rates := []*rate.Rate{}
+	for rowNumber := 2; ; rowNumber++ {
+		record, errRead := r.Read()
+		if errRead == io.EOF {
+			break
+		} else if errRead != nil {
+			return 0, invalidCSV(fmt.Sprintf("Could not read the row %d.", rowNumber)).Wrap(errRead)
+		}
+
+		tmp, errParse := h.parseRate(rateDeckID, record, columns)
+		if errParse != nil {
+			return 0, invalidCSV(fmt.Sprintf("The row %d is not valid. %v", rowNumber, errParse))
+		}
+
+		if errValidate := validateRate(tmp); errValidate != nil {
+			return 0, invalidCSV(fmt.Sprintf("The row %d is not valid. %s", rowNumber, errValidate.Message))
+		}
+		rates = append(rates, tmp)
+	}
+
+	if len(rates) == 0 {
+		return 0, invalidCSV("The csv has no rate.")
+	}
+
+	if errCreate := h.db.RateCreateMany(ctx, rates); errCreate != nil {
+		log.Errorf("Could not create the rates. err: %v", errCreate)
+		return 0, errors.Wrap(errCreate, "could not create the rates")
+	}
+	log.Debugf("Imported the rates. count: %d", len(rates))
+
+	return len(rates), nil
+}
+
+// parseRate returns the rate of the given csv record.
+func (h *rateDeckHandler) parseRate(rateDeckID uuid.UUID, record []string, columns map[string]int) (*rate.Rate, error) {
+	value := func(name string) string {
+		idx, ok := columns[name]
+		if !ok || idx >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[idx])
+	}
+
+	parseInt := func(name string) (int64, error) {
+		v := value(name)
+		if v == "" {
+			return 0, nil
+		}
+		res, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("the %s is not a number", name)
+		}
+		return res, nil
+	}
+
+	parseTime := func(name string) (*time.Time, error) {
+		v := value(name)
+		if v == "" {
+			return nil, nil
+		}
+		res, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return nil, fmt.Errorf("the %s is not a RFC3339 timestamp", name)
+		}
+		res = res.UTC()
+		return &res, nil
+	}
+
+	if value(importColumnCreditPerUnit) == "" {
+		return nil, fmt.Errorf("the %s is required", importColumnCreditPerUnit)
+	}
+	creditPerUnit, err := parseInt(importColumnCreditPerUnit)
+	if err != nil {
+		return nil, err
+	}
+	connectionFee, err := parseInt(importColumnConnectionFee)
+	if err != nil {
+		return nil, err
+	}
+	incrementInitial, err := parseInt(importColumnIncrementInitial)
+	if err != nil {
+		return nil, err
+	}
+	incrementSubsequent, err := parseInt(importColumnIncrementSubsequent)
+	if err != nil {
+		return nil, err
+	}
+	tmEffectiveStart, err := parseTime(importColumnTMEffectiveStart)
+	if err != nil {
+		return nil, err
+	}
+	tmEffectiveEnd, err := parseTime(importColumnTMEffectiveEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	res := h.newRate(
+		rateDeckID,
+		billing.CostType(value(importColumnCostType)),
+		value(importColumnPrefix),
+		creditPerUnit,
+		connectionFee,
+		int(incrementInitial),
+		int(incrementSubsequent),
+		tmEffectiveStart,
+		tmEffectiveEnd,
+	)
+
+	return res, nil
+}
+
+// invalidCSV returns the invalid argument error of the rate import.
+func invalidCSV(msg string) *cerrors.VoipbinError {
+	return cerrors.InvalidArgument(commonoutline.ServiceNameBillingManager, "INVALID_CSV", msg)
+}
@@ -0,0 +1,169 @@
+package ratedeckhandler
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"monorepo/bin-common-handler/pkg/notifyhandler"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+
+	"monorepo/bin-billing-manager/models/billing"
+	"monorepo/bin-billing-manager/models/rate"
+	"monorepo/bin-billing-manager/models/ratedeck"
+	"monorepo/bin-billing-manager/pkg/dbhandler"
+)
+
+func Test_RateImport(t *testing.T) {
+
+	tmNow := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	tmStart := time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)
+
+	type test struct {
+		name string
+
+		rateDeckID uuid.UUID
+		csv        string
+
+		responseUUIDs []uuid.UUID
+
+		expectRates []*rate.Rate
+	}
+
+	tests := []test{
+		{
+			name: "normal",
+
+			rateDeckID: uuid.FromStringOrNil("c1d2e3f4-ad2f-11f0-8a9b-0c1d2e3f4a5b"),
+			csv: "cost_type,prefix,credit_per_unit,connection_fee,increment_initial,increment_subsequent,tm_effective_start\n" +
+				"call_pstn_outgoing,+44,12000,5000,60,6,2026-11-01T00:00:00Z\n" +
+				"sms,82,8000,,,,\n",
+
+			responseUUIDs: []uuid.UUID{
+				uuid.FromStringOrNil("d2e3f4a5-ad2f-11f0-9bac-1d2e3f4a5b6c"),
+				uuid.FromStringOrNil("e3f4a5b6-ad2f-11f0-acbd-2e3f4a5b6c7d"),
+			},
+
+			expectRates: []*rate.Rate{
+				{
+					ID:                  uuid.FromStringOrNil("d2e3f4a5-ad2f-11f0-9bac-1d2e3f4a5b6c"),
+					RateDeckID:          uuid.FromStringOrNil("c1d2e3f4-ad2f-11f0-8a9b-0c1d2e3f4a5b"),
+					CostType:            billing.CostTypeCallPSTNOutgoing,
+					Prefix:              "44",
+					CreditPerUnit:       12000,
+					ConnectionFee:       5000,
+					IncrementInitial:    60,
+					IncrementSubsequent: 6,
+					TMEffectiveStart:    &tmStart,
+				},
+				{
+					ID:               uuid.FromStringOrNil("e3f4a5b6-ad2f-11f0-acbd-2e3f4a5b6c7d"),
+					RateDeckID:       uuid.FromStringOrNil("c1d2e3f4-ad2f-11f0-8a9b-0c1d2e3f4a5b"),
+					CostType:         billing.CostTypeSMS,
+					Prefix:           "82",
+					CreditPerUnit:    8000,
+					TMEffectiveStart: &tmNow,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+
+			h := rateDeckHandler{
+				utilHandler:   mockUtil,
+				db:            mockDB,
+				notifyHandler: mockNotify,
+			}
+			ctx := context.Background()
+
+			mockDB.EXPECT().RateDeckGet(ctx, tt.rateDeckID).Return(&ratedeck.RateDeck{ID: tt.rateDeckID}, nil)
+			mockUtil.EXPECT().TimeNow().Return(&tmNow).AnyTimes()
+			for _, id := range tt.responseUUIDs {
+				mockUtil.EXPECT().UUIDCreate().Return(id)
+			}
+			mockDB.EXPECT().RateCreateMany(ctx, tt.expectRates).Return(nil)
+
+			res, err := h.RateImport(ctx, tt.rateDeckID, strings.NewReader(tt.csv))
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if res != len(tt.expectRates) {
+				t.Errorf("Wrong match. expect: %d, got: %d", len(tt.expectRates), res)
+			}
+		})
+	}
+}
+
+func Test_RateImport_error(t *testing.T) {
+
+	tmNow := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+
+		csv string
+	}{
+		{
+			name: "empty",
+			csv:  "",
+		},
+		{
+			name: "missing required column",
+			csv:  "cost_type,prefix\nsms,82\n",
+		},
+		{
+			name: "no rate",
+			csv:  "cost_type,prefix,credit_per_unit\n",
+		},
+		{
+			name: "invalid number",
+			csv:  "cost_type,prefix,credit_per_unit\nsms,82,abc\n",
+		},
+		{
+			name: "invalid timestamp",
+			csv:  "cost_type,prefix,credit_per_unit,tm_effective_start\nsms,82,100,yesterday\n",
+		},
+		{
+			name: "invalid cost type",
+			csv:  "cost_type,prefix,credit_per_unit\nsms,82,100\nnumber,82,100\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+
+			h := rateDeckHandler{
+				utilHandler: mockUtil,
+				db:          mockDB,
+			}
+			ctx := context.Background()
+			rateDeckID := uuid.FromStringOrNil("f4a5b6c7-ad2f-11f0-bdce-3f4a5b6c7d8e")
+
+			mockDB.EXPECT().RateDeckGet(ctx, rateDeckID).Return(&ratedeck.RateDeck{ID: rateDeckID}, nil)
+			mockUtil.EXPECT().TimeNow().Return(&tmNow).AnyTimes()
+			mockUtil.EXPECT().UUIDCreate().Return(uuid.Must(uuid.NewV4())).AnyTimes()
+
+			if _, err := h.RateImport(ctx, rateDeckID, strings.NewReader(tt.csv)); err == nil {
+				t.Errorf("Wrong match. expect: error, got: ok")
+			}
+		})
+	}
+}
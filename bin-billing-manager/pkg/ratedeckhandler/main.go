@@ -0,0 +1,67 @@
+package ratedeckhandler
+
+//go:generate mockgen -package ratedeckhandler -destination ./mock_main.go -source main.go -build_flags=-mod=mod
+
+import (
+	"context"
+	"io"
+	"time"
+
+	commonaddress "monorepo/bin-common-handler/models/address"
+	"monorepo/bin-common-handler/pkg/notifyhandler"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+
+	"github.com/gofrs/uuid"
+
+	"monorepo/bin-billing-manager/models/account"
+	"monorepo/bin-billing-manager/models/billing"
+	"monorepo/bin-billing-manager/models/rate"
+	"monorepo/bin-billing-manager/models/ratedeck"
+	"monorepo/bin-billing-manager/pkg/dbhandler"
+)
+
+// RateDeckHandler define
+type RateDeckHandler interface {
+	Create(ctx context.Context, name string, detail string, planType account.PlanType) (*ratedeck.RateDeck, error)
+	Get(ctx context.Context, id uuid.UUID) (*ratedeck.RateDeck, error)
+	List(ctx context.Context, size uint64, token string, filters map[ratedeck.Field]any) ([]*ratedeck.RateDeck, error)
+	UpdateBasicInfo(ctx context.Context, id uuid.UUID, name string, detail string) (*ratedeck.RateDeck, error)
+	UpdatePlanType(ctx context.Context, id uuid.UUID, planType account.PlanType) (*ratedeck.RateDeck, error)
+	Delete(ctx context.Context, id uuid.UUID) (*ratedeck.RateDeck, error)
+
+	RateCreate(
+		ctx context.Context,
+		rateDeckID uuid.UUID,
+		costType billing.CostType,
+		prefix string,
+		creditPerUnit int64,
+		connectionFee int64,
+		incrementInitial int,
+		incrementSubsequent int,
+		tmEffectiveStart *time.Time,
+		tmEffectiveEnd *time.Time,
+	) (*rate.Rate, error)
+	RateGet(ctx context.Context, id uuid.UUID) (*rate.Rate, error)
+	RateList(ctx context.Context, size uint64, token string, filters map[rate.Field]any) ([]*rate.Rate, error)
+	RateDelete(ctx context.Context, id uuid.UUID) (*rate.Rate, error)
+	RateImport(ctx context.Context, rateDeckID uuid.UUID, src io.Reader) (int, error)
+
+	GetRate(ctx context.Context, a *account.Account, costType billing.CostType, destination *commonaddress.Address, tm *time.Time) (*rate.Rate, error)
+	GetRateByEngine(ctx context.Context, a *account.Account, costType billing.CostType, engine string, tm *time.Time) (*rate.Rate, error)
+	GetMaxRate(ctx context.Context, a *account.Account, costType billing.CostType, tm *time.Time) (*rate.Rate, error)
+}
+
+type rateDeckHandler struct {
+	utilHandler   utilhandler.UtilHandler
+	db            dbhandler.DBHandler
+	notifyHandler notifyhandler.NotifyHandler
+}
+
+// NewRateDeckHandler returns a new RateDeckHandler
+func NewRateDeckHandler(db dbhandler.DBHandler, notifyHandler notifyhandler.NotifyHandler) RateDeckHandler {
+	return &rateDeckHandler{
+		utilHandler:   utilhandler.NewUtilHandler(),
+		db:            db,
+		notifyHandler: notifyHandler,
+	}
+}
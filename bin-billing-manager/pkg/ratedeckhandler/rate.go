@@ -0,0 +1,337 @@
+package ratedeckhandler
+
+import (
+	"context"
+	stderrors "errors"
+	"strings"
+	"time"
+
+	commonaddress "monorepo/bin-common-handler/models/address"
+	cerrors "monorepo/bin-common-handler/models/errors"
+	commonoutline "monorepo/bin-common-handler/models/outline"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"monorepo/bin-billing-manager/models/account"
+	"monorepo/bin-billing-manager/models/billing"
+	"monorepo/bin-billing-manager/models/rate"
+	"monorepo/bin-billing-manager/models/ratedeck"
+	"monorepo/bin-billing-manager/pkg/dbhandler"
+)
+
+// RateCreate creates a new rate in the rate deck and returns the created rate.
+// If the tmEffectiveStart is nil, the rate is effective from now.
+func (h *rateDeckHandler) RateCreate(
+	ctx context.Context,
+	rateDeckID uuid.UUID,
+	costType billing.CostType,
+	prefix string,
+	creditPerUnit int64,
+	connectionFee int64,
+	incrementInitial int,
+	incrementSubsequent int,
+	tmEffectiveStart *time.Time,
+	tmEffectiveEnd *time.Time,
+) (*rate.Rate, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":         "RateCreate",
+		"rate_deck_id": rateDeckID,
+		"cost_type":    costType,
+		"prefix":       prefix,
+	})
+
+	d, err := h.Get(ctx, rateDeckID)
+	if err != nil {
+		log.Errorf("Could not get the rate deck. err: %v", err)
+		return nil, err
+	}
+	if d.TMDelete != nil {
+		return nil, cerrors.FailedPrecondition(
+			commonoutline.ServiceNameBillingManager,
+			"RATE_DECK_DELETED",
+			"The rate deck was deleted.",
+		)
+	}
+
+	r := h.newRate(rateDeckID, costType, prefix, creditPerUnit, connectionFee, incrementInitial, incrementSubsequent, tmEffectiveStart, tmEffectiveEnd)
+	if errValidate := validateRate(r); errValidate != nil {
+		return nil, errValidate
+	}
+
+	if errCreate := h.db.RateCreate(ctx, r); errCreate != nil {
+		log.Errorf("Could not create the rate. err: %v", errCreate)
+		return nil, errors.Wrap(errCreate, "could not create the rate")
+	}
+
+	res, err := h.RateGet(ctx, r.ID)
+	if err != nil {
+		log.Errorf("Could not get the created rate. err: %v", err)
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// newRate returns a new rate of the given values.
+func (h *rateDeckHandler) newRate(
+	rateDeckID uuid.UUID,
+	costType billing.CostType,
+	prefix string,
+	creditPerUnit int64,
+	connectionFee int64,
+	incrementInitial int,
+	incrementSubsequent int,
+	tmEffectiveStart *time.Time,
+	tmEffectiveEnd *time.Time,
+) *rate.Rate {
+	if tmEffectiveStart == nil {
+		tmEffectiveStart = h.utilHandler.TimeNow()
+	}
+
+	return &rate.Rate{
+		ID:                  h.utilHandler.UUIDCreate(),
+		RateDeckID:          rateDeckID,
+		CostType:            costType,
+		Prefix:              strings.TrimPrefix(prefix, "+"),
+		CreditPerUnit:       creditPerUnit,
+		ConnectionFee:       connectionFee,
+		IncrementInitial:    incrementInitial,
+		IncrementSubsequent: incrementSubsequent,
+		TMEffectiveStart:    tmEffectiveStart,
+		TMEffectiveEnd:      tmEffectiveEnd,
+	}
+}
+
+// validateRate returns an InvalidArgument error if the given rate is not valid.
+func validateRate(r *rate.Rate) *cerrors.VoipbinError {
+	invalid := func(msg string) *cerrors.VoipbinError {
+		return cerrors.InvalidArgument(commonoutline.ServiceNameBillingManager, "INVALID_RATE", msg)
+	}
+
+	if !rate.IsRatable(r.CostType) {
+		return invalid("The cost type can not be priced by a rate deck.")
+	}
+
+	if billing.IsAIUsageComponent(r.CostType) {
+		// the ai usage's components are priced by the engine. i.e. "openai.gpt-4o".
+		if r.ConnectionFee != 0 || r.IncrementInitial != 0 || r.IncrementSubsequent != 0 {
+			return invalid("The connection fee and the billing increments are not applicable to the ai usage.")
+		}
+	} else {
+		for _, c := range r.Prefix {
+			if c < '0' || c > '9' {
+				return invalid("The prefix must contain digits only.")
+			}
+		}
+	}
+
+	if r.CreditPerUnit < 0 || r.ConnectionFee < 0 {
+		return invalid("The price must not be negative.")
+	}
+
+	if r.IncrementInitial < 0 || r.IncrementSubsequent < 0 {
+		return invalid("The billing increments must not be negative.")
+	}
+	if r.IncrementInitial == 0 && r.IncrementSubsequent != 0 {
+		return invalid("The initial billing increment is required with the subsequent billing increment.")
+	}
+	if r.CostType == billing.CostTypeSMS && r.IncrementInitial != 0 {
+		return invalid("The billing increments are not applicable to sms.")
+	}
+
+	if r.TMEffectiveEnd != nil && !r.TMEffectiveEnd.After(*r.TMEffectiveStart) {
+		return invalid("The effective end must be after the effective start.")
+	}
+
+	return nil
+}
+
+// RateGet returns the rate.
+func (h *rateDeckHandler) RateGet(ctx context.Context, id uuid.UUID) (*rate.Rate, error) {
+	res, err := h.db.RateGet(ctx, id)
+	if err != nil {
+		if stderrors.Is(err, dbhandler.ErrNotFound) {
+			return nil, cerrors.NotFound(
+				commonoutline.ServiceNameBillingManager,
+				"RATE_NOT_FOUND",
+				"The rate was not found.",
+			).Wrap(err)
+		}
+		return nil, errors.Wrap(err, "could not get the rate")
+	}
+
+	return res, nil
+}
+
+// RateList returns a list of rates.
+func (h *rateDeckHandler) RateList(ctx context.Context, size uint64, token string, filters map[rate.Field]any) ([]*rate.Rate, error) {
+	res, err := h.db.RateList(ctx, size, token, filters)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get rates")
+	}
+
+	return res, nil
+}
+
+// RateDelete deletes the rate.
+func (h *rateDeckHandler) RateDelete(ctx context.Context, id uuid.UUID) (*rate.Rate, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":    "RateDelete",
+		"rate_id": id,
+	})
+
+	if errDelete := h.db.RateDelete(ctx, id); errDelete != nil {
+		log.Errorf("Could not delete the rate. err: %v", errDelete)
+		return nil, errors.Wrap(errDelete, "could not delete the rate")
+	}
+
+	res, err := h.RateGet(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get the deleted rate. err: %v", err)
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetRate returns the rate applied to the account's billing of the given cost type and destination at the given time.
+// The rate deck assigned to the account is used first, then the rate deck of the account's plan type.
+// It returns nil without error if no rate applies, in which case the default rate of the cost type is used.
+func (h *rateDeckHandler) GetRate(ctx context.Context, a *account.Account, costType billing.CostType, destination *commonaddress.Address, tm *time.Time) (*rate.Rate, error) {
+	if !rate.IsRatable(costType) || billing.IsAIUsageComponent(costType) || destination == nil || destination.Type != commonaddress.TypeTel {
+		return nil, nil
+	}
+
+	return h.getRate(ctx, a, costType, strings.TrimPrefix(destination.Target, "+"), tm)
+}
+
+// GetRateByEngine returns the rate of the ai usage's component applied to the account's billing of the given engine at the given time.
+// The engine is the ai engine model or the stt/tts type. The rate of the longest prefix matching the engine is applied.
+// It returns nil without error if no rate applies, in which case the default rate of the component is used.
+func (h *rateDeckHandler) GetRateByEngine(ctx context.Context, a *account.Account, costType billing.CostType, engine string, tm *time.Time) (*rate.Rate, error) {
+	if !billing.IsAIUsageComponent(costType) {
+		return nil, nil
+	}
+
+	return h.getRate(ctx, a, costType, engine, tm)
+}
+
+// getRate returns the rate of the longest prefix matching the given target in the rate deck applied to the account.
+func (h *rateDeckHandler) getRate(ctx context.Context, a *account.Account, costType billing.CostType, target string, tm *time.Time) (*rate.Rate, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":       "getRate",
+		"account_id": a.ID,
+		"cost_type":  costType,
+		"target":     target,
+	})
+
+	rateDeckID, err := h.getRateDeckID(ctx, a)
+	if err != nil {
+		log.Errorf("Could not get the rate deck of the account. err: %v", err)
+		return nil, err
+	}
+	if rateDeckID == uuid.Nil {
+		return nil, nil
+	}
+
+	if tm == nil {
+		tm = h.utilHandler.TimeNow()
+	}
+
+	res, err := h.db.RateGetByDestination(ctx, rateDeckID, costType, target, *tm)
+	if err != nil {
+		if stderrors.Is(err, dbhandler.ErrNotFound) {
+			return nil, nil
+		}
+		log.Errorf("Could not get the rate of the destination. err: %v", err)
+		return nil, errors.Wrap(err, "could not get the rate of the destination")
+	}
+	log.WithField("rate", res).Debugf("Found the rate. rate_id: %s", res.ID)
+
+	return res, nil
+}
+
+// GetMaxRate returns the most expensive rate of the given cost type in the rate deck applied to the account at the given time.
+// It is the worst case of the account's billing when the destination is not known yet.
+// It returns nil without error if no rate applies, in which case the default rate of the cost type is used.
+func (h *rateDeckHandler) GetMaxRate(ctx context.Context, a *account.Account, costType billing.CostType, tm *time.Time) (*rate.Rate, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":       "GetMaxRate",
+		"account_id": a.ID,
+		"cost_type":  costType,
+	})
+
+	if !rate.IsRatable(costType) {
+		return nil, nil
+	}
+
+	rateDeckID, err := h.getRateDeckID(ctx, a)
+	if err != nil {
+		log.Errorf("Could not get the rate deck of the account. err: %v", err)
+		return nil, err
+	}
+	if rateDeckID == uuid.Nil {
+		return nil, nil
+	}
+
+	if tm == nil {
+		tm = h.utilHandler.TimeNow()
+	}
+
+	res, err := h.db.RateGetMax(ctx, rateDeckID, costType, *tm)
+	if err != nil {
+		if stderrors.Is(err, dbhandler.ErrNotFound) {
+			return nil, nil
+		}
+		log.Errorf("Could not get the most expensive rate. err: %v", err)
+		return nil, errors.Wrap(err, "could not get the most expensive rate")
+	}
+
+	return res, nil
+}
+
+// getRateDeckID returns the id of the rate deck applied to the account.
+// It returns uuid.Nil if no rate deck applies.
+// Only one rate deck applies to a plan type. It returns an error rather than picking one
+// if more than one rate deck applies to the account's plan type.
+func (h *rateDeckHandler) getRateDeckID(ctx context.Context, a *account.Account) (uuid.UUID, error) {
+	if a.RateDeckID != uuid.Nil {
+		d, err := h.db.RateDeckGet(ctx, a.RateDeckID)
+		if err != nil && !stderrors.Is(err, dbhandler.ErrNotFound) {
+			return uuid.Nil, errors.Wrap(err, "could not get the assigned rate deck")
+		}
+		if err == nil && d.TMDelete == nil {
+			return d.ID, nil
+		}
+	}
+
+	if a.PlanType == "" {
+		return uuid.Nil, nil
+	}
+
+	filters := map[ratedeck.Field]any{
+		ratedeck.FieldPlanType: a.PlanType,
+		ratedeck.FieldDeleted:  false,
+	}
+	decks, err := h.db.RateDeckList(ctx, 2, "", filters)
+	if err != nil {
+		return uuid.Nil, errors.Wrap(err, "could not get the rate deck of the plan type")
+	}
+
+	switch len(decks) {
+	case 0:
+		return uuid.Nil, nil
+
+	case 1:
+		return decks[0].ID, nil
+
+	default:
+		return uuid.Nil, cerrors.FailedPrecondition(
+			commonoutline.ServiceNameBillingManager,
+			"PLAN_TYPE_RATE_DECK_AMBIGUOUS",
+			"More than one rate deck applies to the plan type.",
+		)
+	}
+}
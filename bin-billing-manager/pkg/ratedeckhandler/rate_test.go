@@ -0,0 +1,501 @@
+package ratedeckhandler
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	commonaddress "monorepo/bin-common-handler/models/address"
+	"monorepo/bin-common-handler/pkg/notifyhandler"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+
+	"monorepo/bin-billing-manager/models/account"
+	"monorepo/bin-billing-manager/models/billing"
+	"monorepo/bin-billing-manager/models/rate"
+	"monorepo/bin-billing-manager/models/ratedeck"
+	"monorepo/bin-billing-manager/pkg/dbhandler"
+)
+
+func Test_RateCreate(t *testing.T) {
+
+	tmNow := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	type test struct {
+		name string
+
+		rateDeckID          uuid.UUID
+		costType            billing.CostType
+		prefix              string
+		creditPerUnit       int64
+		connectionFee       int64
+		incrementInitial    int
+		incrementSubsequent int
+
+		responseRateDeck *ratedeck.RateDeck
+		responseUUID     uuid.UUID
+		responseRate     *rate.Rate
+
+		expectRate *rate.Rate
+	}
+
+	tests := []test{
+		{
+			name: "normal",
+
+			rateDeckID:          uuid.FromStringOrNil("2a0e6f7c-ad2f-11f0-9c1d-5b6a7c8d9e0f"),
+			costType:            billing.CostTypeCallPSTNOutgoing,
+			prefix:              "+4420",
+			creditPerUnit:       12000,
+			connectionFee:       5000,
+			incrementInitial:    60,
+			incrementSubsequent: 6,
+
+			responseRateDeck: &ratedeck.RateDeck{
+				ID: uuid.FromStringOrNil("2a0e6f7c-ad2f-11f0-9c1d-5b6a7c8d9e0f"),
+			},
+			responseUUID: uuid.FromStringOrNil("3f5a1b2c-ad2f-11f0-8e7d-6c5b4a392817"),
+			responseRate: &rate.Rate{
+				ID: uuid.FromStringOrNil("3f5a1b2c-ad2f-11f0-8e7d-6c5b4a392817"),
+			},
+
+			expectRate: &rate.Rate{
+				ID:                  uuid.FromStringOrNil("3f5a1b2c-ad2f-11f0-8e7d-6c5b4a392817"),
+				RateDeckID:          uuid.FromStringOrNil("2a0e6f7c-ad2f-11f0-9c1d-5b6a7c8d9e0f"),
+				CostType:            billing.CostTypeCallPSTNOutgoing,
+				Prefix:              "4420",
+				CreditPerUnit:       12000,
+				ConnectionFee:       5000,
+				IncrementInitial:    60,
+				IncrementSubsequent: 6,
+				TMEffectiveStart:    &tmNow,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+
+			h := rateDeckHandler{
+				utilHandler:   mockUtil,
+				db:            mockDB,
+				notifyHandler: mockNotify,
+			}
+			ctx := context.Background()
+
+			mockDB.EXPECT().RateDeckGet(ctx, tt.rateDeckID).Return(tt.responseRateDeck, nil)
+			mockUtil.EXPECT().TimeNow().Return(&tmNow)
+			mockUtil.EXPECT().UUIDCreate().Return(tt.responseUUID)
+			mockDB.EXPECT().RateCreate(ctx, tt.expectRate).Return(nil)
+			mockDB.EXPECT().RateGet(ctx, tt.responseUUID).Return(tt.responseRate, nil)
+
+			res, err := h.RateCreate(ctx, tt.rateDeckID, tt.costType, tt.prefix, tt.creditPerUnit, tt.connectionFee, tt.incrementInitial, tt.incrementSubsequent, nil, nil)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(tt.responseRate, res) == false {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.responseRate, res)
+			}
+		})
+	}
+}
+
+func Test_validateRate(t *testing.T) {
+
+	tmStart := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	tmBefore := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+
+		rate *rate.Rate
+
+		expectErr bool
+	}{
+		{
+			name: "per minute call",
+			rate: &rate.Rate{CostType: billing.CostTypeCallPSTNOutgoing, Prefix: "44", CreditPerUnit: 10000, TMEffectiveStart: &tmStart},
+		},
+		{
+			name: "catch-all sms",
+			rate: &rate.Rate{CostType: billing.CostTypeSMS, Prefix: "", CreditPerUnit: 8000, TMEffectiveStart: &tmStart},
+		},
+		{
+			name: "ai usage component of the engine",
+			rate: &rate.Rate{CostType: billing.CostTypeAICompletionToken, Prefix: "openai.gpt-4o", CreditPerUnit: 10000, TMEffectiveStart: &tmStart},
+		},
+		{
+			name:      "ai usage component with increments",
+			rate:      &rate.Rate{CostType: billing.CostTypeAISTT, Prefix: "deepgram", CreditPerUnit: 10000, IncrementInitial: 60, TMEffectiveStart: &tmStart},
+			expectErr: true,
+		},
+		{
+			name:      "not ratable cost type",
+			rate:      &rate.Rate{CostType: billing.CostTypeNumber, Prefix: "44", TMEffectiveStart: &tmStart},
+			expectErr: true,
+		},
+		{
+			name:      "non digit prefix",
+			rate:      &rate.Rate{CostType: billing.CostTypeSMS, Prefix: "44a", TMEffectiveStart: &tmStart},
+			expectErr: true,
+		},
+		{
+			name:      "negative price",
+			rate:      &rate.Rate{CostType: billing.CostTypeSMS, Prefix: "44", CreditPerUnit: -1, TMEffectiveStart: &tmStart},
+			expectErr: true,
+		},
+		{
+			name:      "subsequent increment without initial increment",
+			rate:      &rate.Rate{CostType: billing.CostTypeCallPSTNIncoming, Prefix: "44", IncrementSubsequent: 6, TMEffectiveStart: &tmStart},
+			expectErr: true,
+		},
+		{
+			name:      "sms with increments",
+			rate:      &rate.Rate{CostType: billing.CostTypeSMS, Prefix: "44", IncrementInitial: 60, IncrementSubsequent: 6, TMEffectiveStart: &tmStart},
+			expectErr: true,
+		},
+		{
+			name:      "effective end before start",
+			rate:      &rate.Rate{CostType: billing.CostTypeSMS, Prefix: "44", TMEffectiveStart: &tmStart, TMEffectiveEnd: &tmBefore},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateRate(tt.rate)
+			if (err != nil) != tt.expectErr {
+				t.Errorf("Wrong match. expect error: %v, got: %v", tt.expectErr, err)
+			}
+		})
+	}
+}
+
+func Test_GetRate(t *testing.T) {
+
+	tmNow := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	type test struct {
+		name string
+
+		account     *account.Account
+		costType    billing.CostType
+		destination *commonaddress.Address
+
+		responseAssignedDeck *ratedeck.RateDeck
+		responsePlanDecks    []*ratedeck.RateDeck
+		responseRate         *rate.Rate
+		responseRateErr      error
+
+		expectRateDeckID  uuid.UUID
+		expectDestination string
+		expectRes         *rate.Rate
+	}
+
+	tests := []test{
+		{
+			name: "assigned rate deck",
+
+			account: &account.Account{
+				PlanType:   account.PlanTypeBasic,
+				RateDeckID: uuid.FromStringOrNil("6a1b2c3d-ad2f-11f0-9a8b-7c6d5e4f3a2b"),
+			},
+			costType: billing.CostTypeCallPSTNOutgoing,
+			destination: &commonaddress.Address{
+				Type:   commonaddress.TypeTel,
+				Target: "+442012345678",
+			},
+
+			responseAssignedDeck: &ratedeck.RateDeck{
+				ID: uuid.FromStringOrNil("6a1b2c3d-ad2f-11f0-9a8b-7c6d5e4f3a2b"),
+			},
+			responseRate: &rate.Rate{
+				ID: uuid.FromStringOrNil("7b2c3d4e-ad2f-11f0-8b9c-8d7e6f5a4b3c"),
+			},
+
+			expectRateDeckID:  uuid.FromStringOrNil("6a1b2c3d-ad2f-11f0-9a8b-7c6d5e4f3a2b"),
+			expectDestination: "442012345678",
+			expectRes: &rate.Rate{
+				ID: uuid.FromStringOrNil("7b2c3d4e-ad2f-11f0-8b9c-8d7e6f5a4b3c"),
+			},
+		},
+		{
+			name: "assigned rate deck was deleted, falls back to the plan type's rate deck",
+
+			account: &account.Account{
+				PlanType:   account.PlanTypeBasic,
+				RateDeckID: uuid.FromStringOrNil("6a1b2c3d-ad2f-11f0-9a8b-7c6d5e4f3a2b"),
+			},
+			costType: billing.CostTypeSMS,
+			destination: &commonaddress.Address{
+				Type:   commonaddress.TypeTel,
+				Target: "+821012345678",
+			},
+
+			responseAssignedDeck: &ratedeck.RateDeck{
+				ID:       uuid.FromStringOrNil("6a1b2c3d-ad2f-11f0-9a8b-7c6d5e4f3a2b"),
+				TMDelete: &tmNow,
+			},
+			responsePlanDecks: []*ratedeck.RateDeck{
+				{
+					ID: uuid.FromStringOrNil("8c3d4e5f-ad2f-11f0-9cad-9e8f7a6b5c4d"),
+				},
+			},
+			responseRate: &rate.Rate{
+				ID: uuid.FromStringOrNil("9d4e5f6a-ad2f-11f0-adbe-af9e8d7c6b5a"),
+			},
+
+			expectRateDeckID:  uuid.FromStringOrNil("8c3d4e5f-ad2f-11f0-9cad-9e8f7a6b5c4d"),
+			expectDestination: "821012345678",
+			expectRes: &rate.Rate{
+				ID: uuid.FromStringOrNil("9d4e5f6a-ad2f-11f0-adbe-af9e8d7c6b5a"),
+			},
+		},
+		{
+			name: "no matching rate",
+
+			account: &account.Account{
+				PlanType: account.PlanTypeFree,
+			},
+			costType: billing.CostTypeCallPSTNIncoming,
+			destination: &commonaddress.Address{
+				Type:   commonaddress.TypeTel,
+				Target: "+15551234567",
+			},
+
+			responsePlanDecks: []*ratedeck.RateDeck{
+				{
+					ID: uuid.FromStringOrNil("ae5f6a7b-ad2f-11f0-becf-b0a9f8e7d6c5"),
+				},
+			},
+			responseRateErr: dbhandler.ErrNotFound,
+
+			expectRateDeckID:  uuid.FromStringOrNil("ae5f6a7b-ad2f-11f0-becf-b0a9f8e7d6c5"),
+			expectDestination: "15551234567",
+			expectRes:         nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+
+			h := rateDeckHandler{
+				utilHandler:   mockUtil,
+				db:            mockDB,
+				notifyHandler: mockNotify,
+			}
+			ctx := context.Background()
+
+			if tt.responseAssignedDeck != nil {
+				mockDB.EXPECT().RateDeckGet(ctx, tt.account.RateDeckID).Return(tt.responseAssignedDeck, nil)
+			}
+			if tt.responsePlanDecks != nil {
+				mockDB.EXPECT().RateDeckList(ctx, uint64(2), "", map[ratedeck.Field]any{
+					ratedeck.FieldPlanType: tt.account.PlanType,
+					ratedeck.FieldDeleted:  false,
+				}).Return(tt.responsePlanDecks, nil)
+			}
+			mockDB.EXPECT().RateGetByDestination(ctx, tt.expectRateDeckID, tt.costType, tt.expectDestination, tmNow).Return(tt.responseRate, tt.responseRateErr)
+
+			res, err := h.GetRate(ctx, tt.account, tt.costType, tt.destination, &tmNow)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(tt.expectRes, res) == false {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_GetRate_ambiguous_plan_type_rate_deck(t *testing.T) {
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockDB := dbhandler.NewMockDBHandler(mc)
+	h := rateDeckHandler{
+		db: mockDB,
+	}
+	ctx := context.Background()
+
+	a := &account.Account{
+		PlanType: account.PlanTypeBasic,
+	}
+	destination := &commonaddress.Address{
+		Type:   commonaddress.TypeTel,
+		Target: "+821012345678",
+	}
+
+	mockDB.EXPECT().RateDeckList(ctx, uint64(2), "", map[ratedeck.Field]any{
+		ratedeck.FieldPlanType: account.PlanTypeBasic,
+		ratedeck.FieldDeleted:  false,
+	}).Return([]*ratedeck.RateDeck{
+		{ID: uuid.FromStringOrNil("3c8e1a52-ad1f-11f0-8b3e-5f2d7a9c4e1b")},
+		{ID: uuid.FromStringOrNil("3cb7d2e4-ad1f-11f0-9a4c-6e3f8b0d5f2c")},
+	}, nil)
+
+	res, err := h.GetRate(ctx, a, billing.CostTypeCallPSTNOutgoing, destination, nil)
+	if err == nil {
+		t.Errorf("Wrong match. expect: error, got: %v", res)
+	}
+}
+
+func Test_GetRate_not_ratable(t *testing.T) {
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockDB := dbhandler.NewMockDBHandler(mc)
+	h := rateDeckHandler{
+		db: mockDB,
+	}
+
+	destination := &commonaddress.Address{
+		Type:   commonaddress.TypeTel,
+		Target: "+821012345678",
+	}
+
+	res, err := h.GetRate(context.Background(), &account.Account{PlanType: account.PlanTypeBasic}, billing.CostTypeCallVN, destination, nil)
+	if err != nil || res != nil {
+		t.Errorf("Wrong match. expect: nil, got: %v, %v", res, err)
+	}
+}
+
+func Test_GetMaxRate(t *testing.T) {
+
+	tmNow := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	type test struct {
+		name string
+
+		account  *account.Account
+		costType billing.CostType
+
+		responseAssignedDeck *ratedeck.RateDeck
+		responseRate         *rate.Rate
+		responseRateErr      error
+
+		expectRes *rate.Rate
+	}
+
+	tests := []test{
+		{
+			name: "most expensive rate of the assigned rate deck",
+
+			account: &account.Account{
+				RateDeckID: uuid.FromStringOrNil("5e2a7c10-aeb3-11f1-9b4d-2c3d4e5f6a7b"),
+			},
+			costType: billing.CostTypeCallPSTNOutgoing,
+
+			responseAssignedDeck: &ratedeck.RateDeck{
+				ID: uuid.FromStringOrNil("5e2a7c10-aeb3-11f1-9b4d-2c3d4e5f6a7b"),
+			},
+			responseRate: &rate.Rate{
+				ID:            uuid.FromStringOrNil("5e7b8d21-aeb3-11f1-ac5e-3d4e5f6a7b8c"),
+				CreditPerUnit: 900000,
+			},
+
+			expectRes: &rate.Rate{
+				ID:            uuid.FromStringOrNil("5e7b8d21-aeb3-11f1-ac5e-3d4e5f6a7b8c"),
+				CreditPerUnit: 900000,
+			},
+		},
+		{
+			name: "rate deck has no rate of the cost type",
+
+			account: &account.Account{
+				RateDeckID: uuid.FromStringOrNil("5ecc9e32-aeb3-11f1-bd6f-4e5f6a7b8c9d"),
+			},
+			costType: billing.CostTypeSMS,
+
+			responseAssignedDeck: &ratedeck.RateDeck{
+				ID: uuid.FromStringOrNil("5ecc9e32-aeb3-11f1-bd6f-4e5f6a7b8c9d"),
+			},
+			responseRateErr: dbhandler.ErrNotFound,
+
+			expectRes: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			h := rateDeckHandler{
+				db: mockDB,
+			}
+			ctx := context.Background()
+
+			mockDB.EXPECT().RateDeckGet(ctx, tt.account.RateDeckID).Return(tt.responseAssignedDeck, nil)
+			mockDB.EXPECT().RateGetMax(ctx, tt.responseAssignedDeck.ID, tt.costType, tmNow).Return(tt.responseRate, tt.responseRateErr)
+
+			res, err := h.GetMaxRate(ctx, tt.account, tt.costType, &tmNow)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(tt.expectRes, res) == false {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_GetRateByEngine(t *testing.T) {
+
+	tmNow := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockDB := dbhandler.NewMockDBHandler(mc)
+	h := rateDeckHandler{
+		db: mockDB,
+	}
+	ctx := context.Background()
+
+	a := &account.Account{
+		RateDeckID: uuid.FromStringOrNil("8c3a1e20-aebf-11f1-9a2b-3c4d5e6f7a01"),
+	}
+	responseRate := &rate.Rate{
+		ID:            uuid.FromStringOrNil("8c8b2f31-aebf-11f1-ab3c-4d5e6f7a8b01"),
+		CostType:      billing.CostTypeAICompletionToken,
+		Prefix:        "openai.gpt-4o",
+		CreditPerUnit: 10000,
+	}
+
+	mockDB.EXPECT().RateDeckGet(ctx, a.RateDeckID).Return(&ratedeck.RateDeck{ID: a.RateDeckID}, nil)
+	mockDB.EXPECT().RateGetByDestination(ctx, a.RateDeckID, billing.CostTypeAICompletionToken, "openai.gpt-4o-mini", tmNow).Return(responseRate, nil)
+
+	res, err := h.GetRateByEngine(ctx, a, billing.CostTypeAICompletionToken, "openai.gpt-4o-mini", &tmNow)
+	if err != nil {
+		t.Errorf("Wrong match. expect: ok, got: %v", err)
+	}
+	if !reflect.DeepEqual(res, responseRate) {
+		t.Errorf("Wrong match.\nexpect: %v\ngot: %v", responseRate, res)
+	}
+
+	// not an ai usage component
+	res, err = h.GetRateByEngine(ctx, a, billing.CostTypeSMS, "openai.gpt-4o-mini", &tmNow)
+	if err != nil || res != nil {
+		t.Errorf("Wrong match. expect: nil, got: %v, %v", res, err)
+	}
+}
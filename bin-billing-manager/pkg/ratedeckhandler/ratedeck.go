@@ -0,0 +1,191 @@
+package ratedeckhandler
+
+import (
+	"context"
+	stderrors "errors"
+
+	cerrors "monorepo/bin-common-handler/models/errors"
+	commonoutline "monorepo/bin-common-handler/models/outline"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"monorepo/bin-billing-manager/models/account"
+	"monorepo/bin-billing-manager/models/ratedeck"
+	"monorepo/bin-billing-manager/pkg/dbhandler"
+)
+
+// Create creates a new rate deck and returns the created rate deck.
+// If the plan type is given, the rate deck applies to the accounts of the plan type which have no assigned rate deck.
+func (h *rateDeckHandler) Create(ctx context.Context, name string, detail string, planType account.PlanType) (*ratedeck.RateDeck, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":      "Create",
+		"name":      name,
+		"plan_type": planType,
+	})
+
+	if errValidate := h.validatePlanType(ctx, uuid.Nil, planType); errValidate != nil {
+		return nil, errValidate
+	}
+
+	d := &ratedeck.RateDeck{
+		ID:       h.utilHandler.UUIDCreate(),
+		Name:     name,
+		Detail:   detail,
+		PlanType: planType,
+	}
+
+	if errCreate := h.db.RateDeckCreate(ctx, d); errCreate != nil {
+		log.Errorf("Could not create the rate deck. err: %v", errCreate)
+		return nil, errors.Wrap(errCreate, "could not create the rate deck")
+	}
+
+	res, err := h.db.RateDeckGet(ctx, d.ID)
+	if err != nil {
+		log.Errorf("Could not get the created rate deck. err: %v", err)
+		return nil, errors.Wrap(err, "could not get the created rate deck")
+	}
+	h.notifyHandler.PublishEvent(ctx, ratedeck.EventTypeRateDeckCreated, res)
+
+	return res, nil
+}
+
+// Get returns the rate deck.
+func (h *rateDeckHandler) Get(ctx context.Context, id uuid.UUID) (*ratedeck.RateDeck, error) {
+	res, err := h.db.RateDeckGet(ctx, id)
+	if err != nil {
+		if stderrors.Is(err, dbhandler.ErrNotFound) {
+			return nil, cerrors.NotFound(
+				commonoutline.ServiceNameBillingManager,
+				"RATE_DECK_NOT_FOUND",
+				"The rate deck was not found.",
+			).Wrap(err)
+		}
+		return nil, errors.Wrap(err, "could not get the rate deck")
+	}
+
+	return res, nil
+}
+
+// List returns a list of rate decks.
+func (h *rateDeckHandler) List(ctx context.Context, size uint64, token string, filters map[ratedeck.Field]any) ([]*ratedeck.RateDeck, error) {
+	res, err := h.db.RateDeckList(ctx, size, token, filters)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get rate decks")
+	}
+
+	return res, nil
+}
+
+// UpdateBasicInfo updates the rate deck's basic info.
+func (h *rateDeckHandler) UpdateBasicInfo(ctx context.Context, id uuid.UUID, name string, detail string) (*ratedeck.RateDeck, error) {
+	fields := map[ratedeck.Field]any{
+		ratedeck.FieldName:   name,
+		ratedeck.FieldDetail: detail,
+	}
+
+	return h.update(ctx, id, fields)
+}
+
+// UpdatePlanType updates the plan type the rate deck applies to.
+// Empty plan type detaches the rate deck from the plan.
+func (h *rateDeckHandler) UpdatePlanType(ctx context.Context, id uuid.UUID, planType account.PlanType) (*ratedeck.RateDeck, error) {
+	if errValidate := h.validatePlanType(ctx, id, planType); errValidate != nil {
+		return nil, errValidate
+	}
+
+	fields := map[ratedeck.Field]any{
+		ratedeck.FieldPlanType: planType,
+	}
+
+	return h.update(ctx, id, fields)
+}
+
+// update updates the rate deck's fields and returns the updated rate deck.
+func (h *rateDeckHandler) update(ctx context.Context, id uuid.UUID, fields map[ratedeck.Field]any) (*ratedeck.RateDeck, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":         "update",
+		"rate_deck_id": id,
+		"fields":       fields,
+	})
+
+	if _, err := h.Get(ctx, id); err != nil {
+		log.Errorf("Could not get the rate deck. err: %v", err)
+		return nil, err
+	}
+
+	if errUpdate := h.db.RateDeckUpdate(ctx, id, fields); errUpdate != nil {
+		log.Errorf("Could not update the rate deck. err: %v", errUpdate)
+		return nil, errors.Wrap(errUpdate, "could not update the rate deck")
+	}
+
+	res, err := h.Get(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get the updated rate deck. err: %v", err)
+		return nil, err
+	}
+	h.notifyHandler.PublishEvent(ctx, ratedeck.EventTypeRateDeckUpdated, res)
+
+	return res, nil
+}
+
+// Delete deletes the rate deck.
+// The accounts assigned to the deleted rate deck are billed by the rate deck of their plan type.
+func (h *rateDeckHandler) Delete(ctx context.Context, id uuid.UUID) (*ratedeck.RateDeck, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":         "Delete",
+		"rate_deck_id": id,
+	})
+
+	if errDelete := h.db.RateDeckDelete(ctx, id); errDelete != nil {
+		log.Errorf("Could not delete the rate deck. err: %v", errDelete)
+		return nil, errors.Wrap(errDelete, "could not delete the rate deck")
+	}
+
+	res, err := h.Get(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get the deleted rate deck. err: %v", err)
+		return nil, err
+	}
+	h.notifyHandler.PublishEvent(ctx, ratedeck.EventTypeRateDeckDeleted, res)
+
+	return res, nil
+}
+
+// validatePlanType returns an error if the given plan type is invalid,
+// or the other rate deck applies to the plan type already.
+func (h *rateDeckHandler) validatePlanType(ctx context.Context, id uuid.UUID, planType account.PlanType) error {
+	if planType == "" {
+		return nil
+	}
+
+	if _, ok := account.PlanLimitMap[planType]; !ok {
+		return cerrors.InvalidArgument(
+			commonoutline.ServiceNameBillingManager,
+			"INVALID_PLAN_TYPE",
+			"The plan type is not valid.",
+		)
+	}
+
+	filters := map[ratedeck.Field]any{
+		ratedeck.FieldPlanType: planType,
+		ratedeck.FieldDeleted:  false,
+	}
+	tmps, err := h.db.RateDeckList(ctx, 2, "", filters)
+	if err != nil {
+		return errors.Wrap(err, "could not get rate decks of the plan type")
+	}
+
+	for _, tmp := range tmps {
+		if tmp.ID != id {
+			return cerrors.FailedPrecondition(
+				commonoutline.ServiceNameBillingManager,
+				"PLAN_TYPE_RATE_DECK_EXISTS",
+				"The other rate deck applies to the plan type already.",
+			)
+		}
+	}
+
+	return nil
+}
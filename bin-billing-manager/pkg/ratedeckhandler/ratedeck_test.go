@@ -0,0 +1,271 @@
+package ratedeckhandler
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"monorepo/bin-common-handler/pkg/notifyhandler"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+
+	"monorepo/bin-billing-manager/models/account"
+	"monorepo/bin-billing-manager/models/ratedeck"
+	"monorepo/bin-billing-manager/pkg/dbhandler"
+)
+
+func Test_Create(t *testing.T) {
+
+	type test struct {
+		name string
+
+		deckName string
+		detail   string
+		planType account.PlanType
+
+		responsePlanDecks []*ratedeck.RateDeck
+		responseUUID      uuid.UUID
+		responseRateDeck  *ratedeck.RateDeck
+
+		expectRateDeck *ratedeck.RateDeck
+	}
+
+	tests := []test{
+		{
+			name: "normal",
+
+			deckName: "test name",
+			detail:   "test detail",
+			planType: account.PlanTypeBasic,
+
+			responsePlanDecks: []*ratedeck.RateDeck{},
+			responseUUID:      uuid.FromStringOrNil("5f2b4a4e-ad2e-11f0-8f0f-3f3b1ac0e6a1"),
+			responseRateDeck: &ratedeck.RateDeck{
+				ID: uuid.FromStringOrNil("5f2b4a4e-ad2e-11f0-8f0f-3f3b1ac0e6a1"),
+			},
+
+			expectRateDeck: &ratedeck.RateDeck{
+				ID:       uuid.FromStringOrNil("5f2b4a4e-ad2e-11f0-8f0f-3f3b1ac0e6a1"),
+				Name:     "test name",
+				Detail:   "test detail",
+				PlanType: account.PlanTypeBasic,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+
+			h := rateDeckHandler{
+				utilHandler:   mockUtil,
+				db:            mockDB,
+				notifyHandler: mockNotify,
+			}
+			ctx := context.Background()
+
+			mockDB.EXPECT().RateDeckList(ctx, uint64(2), "", gomock.Any()).Return(tt.responsePlanDecks, nil)
+			mockUtil.EXPECT().UUIDCreate().Return(tt.responseUUID)
+			mockDB.EXPECT().RateDeckCreate(ctx, tt.expectRateDeck).Return(nil)
+			mockDB.EXPECT().RateDeckGet(ctx, tt.responseUUID).Return(tt.responseRateDeck, nil)
+			mockNotify.EXPECT().PublishEvent(ctx, ratedeck.EventTypeRateDeckCreated, tt.responseRateDeck)
+
+			res, err := h.Create(ctx, tt.deckName, tt.detail, tt.planType)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(tt.responseRateDeck, res) == false {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.responseRateDeck, res)
+			}
+		})
+	}
+}
+
+func Test_Create_error(t *testing.T) {
+
+	type test struct {
+		name string
+
+		planType account.PlanType
+
+		responsePlanDecks []*ratedeck.RateDeck
+	}
+
+	tests := []test{
+		{
+			name: "invalid plan type",
+
+			planType: account.PlanType("invalid"),
+		},
+		{
+			name: "plan type has rate deck already",
+
+			planType: account.PlanTypeBasic,
+
+			responsePlanDecks: []*ratedeck.RateDeck{
+				{
+					ID: uuid.FromStringOrNil("9d6bb3f4-ad2e-11f0-b2a4-1b9a1bdfa0b0"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+
+			h := rateDeckHandler{
+				utilHandler:   mockUtil,
+				db:            mockDB,
+				notifyHandler: mockNotify,
+			}
+			ctx := context.Background()
+
+			if tt.responsePlanDecks != nil {
+				mockDB.EXPECT().RateDeckList(ctx, uint64(2), "", gomock.Any()).Return(tt.responsePlanDecks, nil)
+			}
+
+			if _, err := h.Create(ctx, "test", "", tt.planType); err == nil {
+				t.Errorf("Wrong match. expect: error, got: ok")
+			}
+		})
+	}
+}
+
+func Test_UpdatePlanType(t *testing.T) {
+
+	type test struct {
+		name string
+
+		id       uuid.UUID
+		planType account.PlanType
+
+		responsePlanDecks []*ratedeck.RateDeck
+		responseRateDeck  *ratedeck.RateDeck
+
+		expectFields map[ratedeck.Field]any
+	}
+
+	tests := []test{
+		{
+			name: "the rate deck applies to the plan type already",
+
+			id:       uuid.FromStringOrNil("c7b7b0b8-ad2e-11f0-a0cb-0f8e6d0c1f52"),
+			planType: account.PlanTypeProfessional,
+
+			responsePlanDecks: []*ratedeck.RateDeck{
+				{
+					ID: uuid.FromStringOrNil("c7b7b0b8-ad2e-11f0-a0cb-0f8e6d0c1f52"),
+				},
+			},
+			responseRateDeck: &ratedeck.RateDeck{
+				ID:       uuid.FromStringOrNil("c7b7b0b8-ad2e-11f0-a0cb-0f8e6d0c1f52"),
+				PlanType: account.PlanTypeProfessional,
+			},
+
+			expectFields: map[ratedeck.Field]any{
+				ratedeck.FieldPlanType: account.PlanTypeProfessional,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+
+			h := rateDeckHandler{
+				utilHandler:   mockUtil,
+				db:            mockDB,
+				notifyHandler: mockNotify,
+			}
+			ctx := context.Background()
+
+			mockDB.EXPECT().RateDeckList(ctx, uint64(2), "", gomock.Any()).Return(tt.responsePlanDecks, nil)
+			mockDB.EXPECT().RateDeckGet(ctx, tt.id).Return(tt.responseRateDeck, nil)
+			mockDB.EXPECT().RateDeckUpdate(ctx, tt.id, tt.expectFields).Return(nil)
+			mockDB.EXPECT().RateDeckGet(ctx, tt.id).Return(tt.responseRateDeck, nil)
+			mockNotify.EXPECT().PublishEvent(ctx, ratedeck.EventTypeRateDeckUpdated, tt.responseRateDeck)
+
+			res, err := h.UpdatePlanType(ctx, tt.id, tt.planType)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(tt.responseRateDeck, res) == false {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.responseRateDeck, res)
+			}
+		})
+	}
+}
+
+func Test_Delete(t *testing.T) {
+
+	type test struct {
+		name string
+
+		id uuid.UUID
+
+		responseRateDeck *ratedeck.RateDeck
+	}
+
+	tests := []test{
+		{
+			name: "normal",
+
+			id: uuid.FromStringOrNil("f0a4d2c6-ad2e-11f0-a4e8-8f1b2c3d4e5f"),
+
+			responseRateDeck: &ratedeck.RateDeck{
+				ID: uuid.FromStringOrNil("f0a4d2c6-ad2e-11f0-a4e8-8f1b2c3d4e5f"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+
+			h := rateDeckHandler{
+				utilHandler:   mockUtil,
+				db:            mockDB,
+				notifyHandler: mockNotify,
+			}
+			ctx := context.Background()
+
+			mockDB.EXPECT().RateDeckDelete(ctx, tt.id).Return(nil)
+			mockDB.EXPECT().RateDeckGet(ctx, tt.id).Return(tt.responseRateDeck, nil)
+			mockNotify.EXPECT().PublishEvent(ctx, ratedeck.EventTypeRateDeckDeleted, tt.responseRateDeck)
+
+			res, err := h.Delete(ctx, tt.id)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(tt.responseRateDeck, res) == false {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.responseRateDeck, res)
+			}
+		})
+	}
+}
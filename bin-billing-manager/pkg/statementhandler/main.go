@@ -0,0 +1,80 @@
+package statementhandler
+
+//go:generate mockgen -package statementhandler -destination ./mock_main.go -source main.go -build_flags=-mod=mod
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"monorepo/bin-common-handler/pkg/notifyhandler"
+	"monorepo/bin-common-handler/pkg/requesthandler"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+
+	"github.com/gofrs/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"monorepo/bin-billing-manager/models/statement"
+	"monorepo/bin-billing-manager/pkg/dbhandler"
+)
+
+// StatementHandler define
+type StatementHandler interface {
+	Create(ctx context.Context, accountID uuid.UUID, tmPeriodStart time.Time) (*statement.Statement, error)
+	Get(ctx context.Context, id uuid.UUID) (*statement.Statement, error)
+	List(ctx context.Context, size uint64, token string, filters map[statement.Field]any) ([]*statement.Statement, error)
+
+	GenerateDue(ctx context.Context) (int, error)
+	GenerateRequested(ctx context.Context, req *statement.GenerateRequest) error
+}
+
+// statementHandler define
+type statementHandler struct {
+	utilHandler   utilhandler.UtilHandler
+	reqHandler    requesthandler.RequestHandler
+	db            dbhandler.DBHandler
+	notifyHandler notifyhandler.NotifyHandler
+
+	httpClient *http.Client // uploads the files with the storage-manager's signed uris
+}
+
+const (
+	claimTimeout  = 30 * time.Minute // the progressing statement's claim older than this is taken over
+	uploadTimeout = 5 * time.Minute  // timeout of the file's upload
+)
+
+var (
+	metricsNamespace = "billing_manager"
+
+	// statement_create_total tracks the statement generations by result.
+	promStatementCreateTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "statement_create_total",
+			Help:      "Total number of statement generations by result.",
+		},
+		[]string{"result"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(
+		promStatementCreateTotal,
+	)
+}
+
+// NewStatementHandler returns a new StatementHandler
+func NewStatementHandler(
+	reqHandler requesthandler.RequestHandler,
+	db dbhandler.DBHandler,
+	notifyHandler notifyhandler.NotifyHandler,
+) StatementHandler {
+	return &statementHandler{
+		utilHandler:   utilhandler.NewUtilHandler(),
+		reqHandler:    reqHandler,
+		db:            db,
+		notifyHandler: notifyHandler,
+
+		httpClient: &http.Client{Timeout: uploadTimeout},
+	}
+}
@@ -0,0 +1,235 @@
+package statementhandler
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+
+	"github.com/go-pdf/fpdf"
+
+	"monorepo/bin-billing-manager/models/account"
+	"monorepo/bin-billing-manager/models/statement"
+)
+
+// csvHeader is the header of the statement's csv.
+// Each row is a line of a section: item, destination, top_up or total.
+var csvHeader = []string{
+	"section",
+	"transaction_type",
+	"reference_type",
+	"cost_type",
+	"prefix",
+	"billing_id",
+	"count",
+	"billable_units",
+	"usage_duration",
+	"amount_token",
+	"amount_credit_usd",
+	"tm_create",
+}
+
+// renderCSV returns the statement's csv.
+func renderCSV(st *statement.Statement) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	w := csv.NewWriter(buf)
+
+	records := [][]string{csvHeader}
+	for _, i := range st.Items {
+		records = append(records, []string{
+			"item",
+			string(i.TransactionType),
+			string(i.ReferenceType),
+			string(i.CostType),
+			"",
+			"",
+			strconv.Itoa(i.Count),
+			strconv.Itoa(i.BillableUnits),
+			strconv.Itoa(i.UsageDuration),
+			strconv.FormatInt(i.AmountToken, 10),
+			formatCredit(i.AmountCredit),
+			"",
+		})
+	}
+	for _, d := range st.TopDestinations {
+		records = append(records, []string{
+			"destination",
+			"",
+			"",
+			string(d.CostType),
+			d.Prefix,
+			"",
+			strconv.Itoa(d.Count),
+			"",
+			strconv.Itoa(d.UsageDuration),
+			"",
+			formatCredit(d.AmountCredit),
+			"",
+		})
+	}
+	for _, t := range st.TopUps {
+		tmCreate := ""
+		if t.TMCreate != nil {
+			tmCreate = t.TMCreate.UTC().Format("2006-01-02 15:04:05")
+		}
+		records = append(records, []string{
+			"top_up",
+			"",
+			string(t.ReferenceType),
+			"",
+			"",
+			t.BillingID.String(),
+			"",
+			"",
+			"",
+			strconv.FormatInt(t.AmountToken, 10),
+			formatCredit(t.AmountCredit),
+			tmCreate,
+		})
+	}
+	records = append(records,
+		[]string{"total", "usage", "", "", "", "", "", "", "", strconv.FormatInt(st.TotalUsageToken, 10), formatCredit(st.TotalUsageCredit), ""},
+		[]string{"total", "top_up", "", "", "", "", "", "", "", strconv.FormatInt(st.TotalTopUpToken, 10), formatCredit(st.TotalTopUpCredit), ""},
+		[]string{"total", "balance_end", "", "", "", "", "", "", "", strconv.FormatInt(st.BalanceTokenEnd, 10), formatCredit(st.BalanceCreditEnd), ""},
+	)
+
+	if err := w.WriteAll(records); err != nil {
+		return nil, fmt.Errorf("could not write the records: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// renderPDF returns the statement's pdf.
+func renderPDF(a *account.Account, st *statement.Statement) ([]byte, error) {
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetCreationDate(*st.TMPeriodEnd)
+	pdf.SetTitle("Billing statement", false)
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+
+	pdf.AddPage()
+
+	pdf.SetFont("Helvetica", "B", 16)
+	pdf.CellFormat(0, 10, "Billing statement", "", 1, "L", false, 0, "")
+
+	pdf.SetFont("Helvetica", "", 10)
+	pdf.CellFormat(0, 6, tr(fmt.Sprintf("Account: %s (%s)", a.Name, a.ID)), "", 1, "L", false, 0, "")
+	pdf.CellFormat(0, 6, fmt.Sprintf("Period: %s - %s (UTC)", st.TMPeriodStart.Format("2006-01-02"), st.TMPeriodEnd.AddDate(0, 0, -1).Format("2006-01-02")), "", 1, "L", false, 0, "")
+	pdf.Ln(4)
+
+	table := func(title string, widths []float64, header []string, rows [][]string) {
+		pdf.SetFont("Helvetica", "B", 12)
+		pdf.CellFormat(0, 8, title, "", 1, "L", false, 0, "")
+
+		pdf.SetFont("Helvetica", "B", 9)
+		pdf.SetFillColor(230, 230, 230)
+		for i, h := range header {
+			pdf.CellFormat(widths[i], 6, h, "1", 0, "L", true, 0, "")
+		}
+		pdf.Ln(-1)
+
+		pdf.SetFont("Helvetica", "", 9)
+		for _, row := range rows {
+			for i, v := range row {
+				pdf.CellFormat(widths[i], 6, tr(v), "1", 0, "L", false, 0, "")
+			}
+			pdf.Ln(-1)
+		}
+		pdf.Ln(4)
+	}
+
+	table(
+		"Summary",
+		[]float64{60, 50, 50},
+		[]string{"", "Token", "Credit (USD)"},
+		[][]string{
+			{"Usage", strconv.FormatInt(st.TotalUsageToken, 10), formatCredit(st.TotalUsageCredit)},
+			{"Top-up", strconv.FormatInt(st.TotalTopUpToken, 10), formatCredit(st.TotalTopUpCredit)},
+			{"Balance at the period end", strconv.FormatInt(st.BalanceTokenEnd, 10), formatCredit(st.BalanceCreditEnd)},
+		},
+	)
+
+	items := [][]string{}
+	for _, i := range st.Items {
+		items = append(items, []string{
+			string(i.TransactionType),
+			string(i.ReferenceType),
+			string(i.CostType),
+			strconv.Itoa(i.Count),
+			strconv.Itoa(i.BillableUnits),
+			strconv.FormatInt(i.AmountToken, 10),
+			formatCredit(i.AmountCredit),
+		})
+	}
+	table(
+		"Line items",
+		[]float64{25, 35, 40, 15, 20, 25, 30},
+		[]string{"Transaction", "Reference", "Cost type", "Count", "Units", "Token", "Credit (USD)"},
+		items,
+	)
+
+	destinations := [][]string{}
+	for _, d := range st.TopDestinations {
+		prefix := d.Prefix
+		if prefix == "" {
+			prefix = "(default)"
+		}
+		destinations = append(destinations, []string{
+			prefix,
+			string(d.CostType),
+			strconv.Itoa(d.Count),
+			strconv.Itoa(d.UsageDuration),
+			formatCredit(d.AmountCredit),
+		})
+	}
+	table(
+		"Top destinations",
+		[]float64{35, 45, 20, 35, 35},
+		[]string{"Prefix", "Cost type", "Count", "Duration (s)", "Credit (USD)"},
+		destinations,
+	)
+
+	topUps := [][]string{}
+	for _, t := range st.TopUps {
+		tmCreate := ""
+		if t.TMCreate != nil {
+			tmCreate = t.TMCreate.UTC().Format("2006-01-02 15:04:05")
+		}
+		topUps = append(topUps, []string{
+			tmCreate,
+			string(t.ReferenceType),
+			strconv.FormatInt(t.AmountToken, 10),
+			formatCredit(t.AmountCredit),
+		})
+	}
+	table(
+		"Top-ups",
+		[]float64{45, 55, 30, 40},
+		[]string{"Date (UTC)", "Reference", "Token", "Credit (USD)"},
+		topUps,
+	)
+
+	buf := &bytes.Buffer{}
+	if err := pdf.Output(buf); err != nil {
+		return nil, fmt.Errorf("could not write the pdf: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// formatCredit returns the USD string of the given credit micros.
+// It keeps at least 2 decimals, and the sub-cent digits only when they are non-zero.
+func formatCredit(micros int64) string {
+	sign := ""
+	if micros < 0 {
+		sign = "-"
+		micros = -micros
+	}
+
+	res := fmt.Sprintf("%s%d.%06d", sign, micros/1000000, micros%1000000)
+	for len(res) > 0 && res[len(res)-1] == '0' && res[len(res)-3] != '.' {
+		res = res[:len(res)-1]
+	}
+
+	return res
+}
@@ -0,0 +1,99 @@
+package statementhandler
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"monorepo/bin-billing-manager/models/account"
+	"monorepo/bin-billing-manager/models/billing"
+	"monorepo/bin-billing-manager/models/statement"
+)
+
+func Test_renderCSV(t *testing.T) {
+
+	tmTopUp := time.Date(2026, 9, 3, 10, 20, 30, 0, time.UTC)
+	st := &statement.Statement{
+		Items: []statement.Item{
+			{
+				TransactionType: billing.TransactionTypeUsage,
+				ReferenceType:   billing.ReferenceTypeCall,
+				CostType:        billing.CostTypeCallPSTNOutgoing,
+				Count:           3,
+				BillableUnits:   5,
+				UsageDuration:   280,
+				AmountCredit:    -30000,
+			},
+		},
+		TopDestinations: []statement.Destination{
+			{
+				Prefix:        "8210",
+				CostType:      billing.CostTypeCallPSTNOutgoing,
+				Count:         3,
+				UsageDuration: 280,
+				AmountCredit:  -30000,
+			},
+		},
+		TopUps: []statement.TopUp{
+			{
+				BillingID:     uuid.FromStringOrNil("d2f1a6b4-b1a4-11f0-8c3e-2a7b9d1e4f60"),
+				ReferenceType: billing.ReferenceTypePaddleCreditPurchase,
+				AmountCredit:  10000000,
+				TMCreate:      &tmTopUp,
+			},
+		},
+		TotalUsageCredit: -30000,
+		TotalTopUpCredit: 10000000,
+		BalanceCreditEnd: 9970000,
+	}
+
+	expectRes := `section,transaction_type,reference_type,cost_type,prefix,billing_id,count,billable_units,usage_duration,amount_token,amount_credit_usd,tm_create
+item,usage,call,call_pstn_outgoing,,,3,5,280,0,-0.03,
+destination,,,call_pstn_outgoing,8210,,3,,280,,-0.03,
+top_up,,paddle_credit_purchase,,,d2f1a6b4-b1a4-11f0-8c3e-2a7b9d1e4f60,,,,0,10.00,2026-09-03 10:20:30
+total,usage,,,,,,,,0,-0.03,
+total,top_up,,,,,,,,0,10.00,
+total,balance_end,,,,,,,,0,9.97,
+`
+
+	res, err := renderCSV(st)
+	if err != nil {
+		t.Errorf("Wrong match. expect: ok, got: %v", err)
+	}
+	if string(res) != expectRes {
+		t.Errorf("Wrong match.\nexpect: %s\ngot: %s", expectRes, res)
+	}
+}
+
+func Test_renderPDF(t *testing.T) {
+
+	start := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	a := &account.Account{
+		Name: "test account",
+	}
+	st := &statement.Statement{
+		TMPeriodStart: &start,
+		TMPeriodEnd:   &end,
+		Items: []statement.Item{
+			{
+				TransactionType: billing.TransactionTypeUsage,
+				ReferenceType:   billing.ReferenceTypeSMS,
+				CostType:        billing.CostTypeSMS,
+				Count:           1,
+				AmountCredit:    -8000,
+			},
+		},
+	}
+
+	res, err := renderPDF(a, st)
+	if err != nil {
+		t.Errorf("Wrong match. expect: ok, got: %v", err)
+	}
+	if !bytes.HasPrefix(res, []byte("%PDF-")) {
+		t.Errorf("Wrong match. expect: pdf, got: %s", res[:10])
+	}
+}
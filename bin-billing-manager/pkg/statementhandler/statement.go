@@ -0,0 +1,450 @@
+package statementhandler
+
+import (
+	"bytes"
+	"context"
+	stderrors "errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	cerrors "monorepo/bin-common-handler/models/errors"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	commonoutline "monorepo/bin-common-handler/models/outline"
+	smfile "monorepo/bin-storage-manager/models/file"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"monorepo/bin-billing-manager/models/account"
+	"monorepo/bin-billing-manager/models/billing"
+	"monorepo/bin-billing-manager/models/statement"
+	"monorepo/bin-billing-manager/pkg/dbhandler"
+)
+
+// Create generates the account's statement of the month starting at the given time.
+// The month must have ended.
+func (h *statementHandler) Create(ctx context.Context, accountID uuid.UUID, tmPeriodStart time.Time) (*statement.Statement, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":            "Create",
+		"account_id":      accountID,
+		"tm_period_start": tmPeriodStart,
+	})
+
+	start, end := statement.PeriodMonth(tmPeriodStart)
+	if !start.Equal(tmPeriodStart) {
+		return nil, cerrors.InvalidArgument(
+			commonoutline.ServiceNameBillingManager,
+			"INVALID_PERIOD",
+			"The period must start at the first day of a month in UTC.",
+		)
+	}
+	if end.After(*h.utilHandler.TimeNow()) {
+		return nil, cerrors.FailedPrecondition(
+			commonoutline.ServiceNameBillingManager,
+			"PERIOD_NOT_ENDED",
+			"The statement can be generated after the period ends.",
+		)
+	}
+
+	a, err := h.db.AccountGet(ctx, accountID)
+	if err != nil {
+		if stderrors.Is(err, dbhandler.ErrNotFound) {
+			return nil, cerrors.NotFound(
+				commonoutline.ServiceNameBillingManager,
+				"ACCOUNT_NOT_FOUND",
+				"The account was not found.",
+			).Wrap(err)
+		}
+		return nil, errors.Wrap(err, "could not get the account")
+	}
+
+	res, err := h.generate(ctx, a, start, end)
+	if err != nil {
+		if stderrors.Is(err, dbhandler.ErrDuplicateKey) {
+			return nil, cerrors.AlreadyExists(
+				commonoutline.ServiceNameBillingManager,
+				"STATEMENT_ALREADY_EXISTS",
+				"The statement of the period already exists.",
+			).Wrap(err)
+		}
+		log.Errorf("Could not generate the statement. err: %v", err)
+		return nil, errors.Wrap(err, "could not generate the statement")
+	}
+
+	return res, nil
+}
+
+// Get returns the statement.
+func (h *statementHandler) Get(ctx context.Context, id uuid.UUID) (*statement.Statement, error) {
+	res, err := h.db.StatementGet(ctx, id)
+	if err != nil {
+		if stderrors.Is(err, dbhandler.ErrNotFound) {
+			return nil, cerrors.NotFound(
+				commonoutline.ServiceNameBillingManager,
+				"STATEMENT_NOT_FOUND",
+				"The statement was not found.",
+			).Wrap(err)
+		}
+		return nil, errors.Wrap(err, "could not get the statement")
+	}
+
+	return res, nil
+}
+
+// List returns a list of statements.
+func (h *statementHandler) List(ctx context.Context, size uint64, token string, filters map[statement.Field]any) ([]*statement.Statement, error) {
+	res, err := h.db.StatementList(ctx, size, token, filters)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get statements")
+	}
+
+	return res, nil
+}
+
+// GenerateDue requests the previous month's statements of the accounts which don't have one yet.
+// Each statement is generated by the subscriber of the request event, so the failed generation is retried
+// without blocking the other accounts. It returns the number of the requested statements.
+func (h *statementHandler) GenerateDue(ctx context.Context) (int, error) {
+	log := logrus.WithField("func", "GenerateDue")
+
+	current, _ := statement.PeriodMonth(*h.utilHandler.TimeNow())
+	start, end := statement.PeriodMonth(current.AddDate(0, -1, 0))
+
+	filters := map[account.Field]any{
+		account.FieldDeleted: false,
+	}
+
+	requested := 0
+
+	// Paginate through all accounts
+	var pageToken string
+	for {
+		accounts, err := h.db.AccountList(ctx, 500, pageToken, filters)
+		if err != nil {
+			return requested, fmt.Errorf("GenerateDue: could not list accounts. err: %v", err)
+		}
+		if len(accounts) == 0 {
+			break
+		}
+
+		for _, a := range accounts {
+			if a.TMCreate != nil && !a.TMCreate.Before(end) {
+				// the account didn't exist in the period
+				continue
+			}
+
+			exists, errExists := h.exists(ctx, a.ID, start)
+			if errExists != nil {
+				// request anyway. the generation skips the existing statement.
+				log.Errorf("Could not check the existing statement. account_id: %s, err: %v", a.ID, errExists)
+			} else if exists {
+				continue
+			}
+
+			h.notifyHandler.PublishEvent(ctx, statement.EventTypeStatementGenerateRequested, &statement.GenerateRequest{
+				AccountID:     a.ID,
+				TMPeriodStart: &start,
+			})
+			requested++
+		}
+
+		if len(accounts) < 500 {
+			break
+		}
+		pageToken = accounts[len(accounts)-1].TMCreate.Format(time.RFC3339Nano)
+	}
+
+	return requested, nil
+}
+
+// GenerateRequested generates the statement requested by GenerateDue.
+// The deleted accounts and the existing statements are skipped.
+// The other errors are returned so the request is retried.
+func (h *statementHandler) GenerateRequested(ctx context.Context, req *statement.GenerateRequest) error {
+	log := logrus.WithFields(logrus.Fields{
+		"func":    "GenerateRequested",
+		"request": req,
+	})
+
+	if req.TMPeriodStart == nil {
+		log.Errorf("The request has no period. Ignoring.")
+		return nil
+	}
+	start, end := statement.PeriodMonth(*req.TMPeriodStart)
+
+	a, err := h.db.AccountGet(ctx, req.AccountID)
+	if err != nil {
+		if stderrors.Is(err, dbhandler.ErrNotFound) {
+			log.Infof("The account does not exist. Ignoring.")
+			return nil
+		}
+		return errors.Wrap(err, "could not get the account")
+	}
+	if a.TMDelete != nil {
+		log.Infof("The account has deleted. Ignoring.")
+		return nil
+	}
+
+	st, err := h.generate(ctx, a, start, end)
+	if err != nil {
+		if stderrors.Is(err, dbhandler.ErrDuplicateKey) {
+			log.Debugf("The statement exists already or is being generated by the other. account_id: %s", a.ID)
+			return nil
+		}
+		log.Errorf("Could not generate the statement. err: %v", err)
+		return errors.Wrap(err, "could not generate the statement")
+	}
+	log.Infof("Generated the statement. account_id: %s, statement_id: %s", a.ID, st.ID)
+
+	return nil
+}
+
+// exists returns true if the account has the generated statement of the period.
+func (h *statementHandler) exists(ctx context.Context, accountID uuid.UUID, tmPeriodStart time.Time) (bool, error) {
+	filters := map[statement.Field]any{
+		statement.FieldAccountID:     accountID,
+		statement.FieldTMPeriodStart: &tmPeriodStart,
+		statement.FieldStatus:        statement.StatusDone,
+		statement.FieldDeleted:       false,
+	}
+
+	tmp, err := h.db.StatementList(ctx, 1, "", filters)
+	if err != nil {
+		return false, err
+	}
+
+	return len(tmp) > 0, nil
+}
+
+// claim creates the progressing statement of the period, or takes over the one left by a lost generator.
+// It returns dbhandler.ErrDuplicateKey if the statement has generated or is being generated by the other.
+func (h *statementHandler) claim(ctx context.Context, a *account.Account, start time.Time, end time.Time) (*statement.Statement, error) {
+	res := &statement.Statement{
+		Identity: commonidentity.Identity{
+			ID:         h.utilHandler.UUIDCreate(),
+			CustomerID: a.CustomerID,
+		},
+		AccountID: a.ID,
+		Status:    statement.StatusProgressing,
+
+		TMPeriodStart: &start,
+		TMPeriodEnd:   &end,
+	}
+
+	errCreate := h.db.StatementCreate(ctx, res)
+	if errCreate == nil {
+		return res, nil
+	}
+	if !stderrors.Is(errCreate, dbhandler.ErrDuplicateKey) {
+		return nil, fmt.Errorf("could not create the statement: %w", errCreate)
+	}
+
+	filters := map[statement.Field]any{
+		statement.FieldAccountID:     a.ID,
+		statement.FieldTMPeriodStart: &start,
+	}
+	tmp, err := h.db.StatementList(ctx, 1, "", filters)
+	if err != nil {
+		return nil, fmt.Errorf("could not get the existing statement: %w", err)
+	}
+	if len(tmp) == 0 {
+		// released by the failed generator in the meantime
+		return nil, fmt.Errorf("could not find the existing statement")
+	}
+
+	cur := tmp[0]
+	if cur.Status != statement.StatusProgressing {
+		return nil, dbhandler.ErrDuplicateKey
+	}
+
+	claimed, err := h.db.StatementClaim(ctx, cur.ID, h.utilHandler.TimeNow().Add(-claimTimeout))
+	if err != nil {
+		return nil, fmt.Errorf("could not claim the statement: %w", err)
+	}
+	if !claimed {
+		return nil, dbhandler.ErrDuplicateKey
+	}
+	res.ID = cur.ID
+
+	return res, nil
+}
+
+// release deletes the files stored by the failed generation and releases the statement,
+// so the statement of the period can be generated again.
+func (h *statementHandler) release(ctx context.Context, st *statement.Statement) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":         "release",
+		"statement_id": st.ID,
+	})
+
+	for _, fileID := range []uuid.UUID{st.CSVFileID, st.PDFFileID} {
+		if fileID == uuid.Nil {
+			continue
+		}
+
+		if _, errDelete := h.reqHandler.StorageV1FileDelete(ctx, fileID, 60000); errDelete != nil {
+			log.Errorf("Could not delete the file. file_id: %s, err: %v", fileID, errDelete)
+		}
+	}
+
+	if errRelease := h.db.StatementRelease(ctx, st.ID); errRelease != nil {
+		log.Errorf("Could not release the statement. err: %v", errRelease)
+	}
+}
+
+// generate claims the statement of the period and fills it with the account's ledger of the period
+// and the rendered files. On failure the stored files are deleted and the claim is released.
+// It returns dbhandler.ErrDuplicateKey if the statement has generated or is being generated by the other.
+func (h *statementHandler) generate(ctx context.Context, a *account.Account, start time.Time, end time.Time) (*statement.Statement, error) {
+	st, err := h.claim(ctx, a, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	if errFill := h.fill(ctx, a, st); errFill != nil {
+		h.release(ctx, st)
+		promStatementCreateTotal.WithLabelValues("failure").Inc()
+		return nil, errFill
+	}
+
+	finished, err := h.db.StatementFinish(ctx, st)
+	if err != nil || !finished {
+		h.release(ctx, st)
+		promStatementCreateTotal.WithLabelValues("failure").Inc()
+		if err != nil {
+			return nil, fmt.Errorf("could not finish the statement: %w", err)
+		}
+		return nil, fmt.Errorf("the statement's claim was taken over")
+	}
+	promStatementCreateTotal.WithLabelValues("success").Inc()
+
+	res, err := h.db.StatementGet(ctx, st.ID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get the created statement: %w", err)
+	}
+	h.notifyHandler.PublishWebhookEvent(ctx, res.CustomerID, statement.EventTypeStatementCreated, res)
+
+	return res, nil
+}
+
+// fill rolls up the account's ledger of the period into the statement and stores the rendered files.
+func (h *statementHandler) fill(ctx context.Context, a *account.Account, st *statement.Statement) error {
+	start, end := *st.TMPeriodStart, *st.TMPeriodEnd
+
+	items, err := h.db.BillingAggregateItems(ctx, a.ID, start, end)
+	if err != nil {
+		return fmt.Errorf("could not aggregate the billing items: %w", err)
+	}
+
+	destinations, err := h.db.BillingAggregateDestinations(ctx, a.ID, start, end, statement.MaxTopDestinations)
+	if err != nil {
+		return fmt.Errorf("could not aggregate the billing destinations: %w", err)
+	}
+	for i, d := range destinations {
+		if d.RateID == uuid.Nil {
+			continue
+		}
+
+		r, errRate := h.db.RateGet(ctx, d.RateID)
+		if errRate != nil {
+			// the prefix is informative only
+			logrus.Errorf("Could not get the rate. rate_id: %s, err: %v", d.RateID, errRate)
+			continue
+		}
+		destinations[i].Prefix = r.Prefix
+	}
+
+	topUpBillings, err := h.db.BillingListByPeriod(ctx, a.ID, billing.TransactionTypeTopUp, start, end)
+	if err != nil {
+		return fmt.Errorf("could not get the top-up billings: %w", err)
+	}
+	topUps := []statement.TopUp{}
+	for _, b := range topUpBillings {
+		topUps = append(topUps, statement.TopUp{
+			BillingID:     b.ID,
+			ReferenceType: b.ReferenceType,
+			AmountToken:   b.AmountToken,
+			AmountCredit:  b.AmountCredit,
+			TMCreate:      b.TMCreate,
+		})
+	}
+
+	st.Items = items
+	st.TopDestinations = destinations
+	st.TopUps = topUps
+
+	for _, item := range items {
+		switch item.TransactionType {
+		case billing.TransactionTypeUsage:
+			st.TotalUsageToken += item.AmountToken
+			st.TotalUsageCredit += item.AmountCredit
+
+		case billing.TransactionTypeTopUp:
+			st.TotalTopUpToken += item.AmountToken
+			st.TotalTopUpCredit += item.AmountCredit
+		}
+	}
+
+	last, err := h.db.BillingGetLastBefore(ctx, a.ID, end)
+	if err != nil && !stderrors.Is(err, dbhandler.ErrNotFound) {
+		return fmt.Errorf("could not get the last billing of the period: %w", err)
+	}
+	if last != nil {
+		st.BalanceTokenEnd = last.BalanceTokenSnapshot
+		st.BalanceCreditEnd = last.BalanceCreditSnapshot
+	}
+
+	period := start.Format("2006-01")
+
+	csvData, err := renderCSV(st)
+	if err != nil {
+		return fmt.Errorf("could not render the csv: %w", err)
+	}
+	st.CSVFileID, err = h.storeFile(ctx, st, csvData, fmt.Sprintf("statement_%s.csv", period))
+	if err != nil {
+		return fmt.Errorf("could not store the csv: %w", err)
+	}
+
+	pdfData, err := renderPDF(a, st)
+	if err != nil {
+		return fmt.Errorf("could not render the pdf: %w", err)
+	}
+	st.PDFFileID, err = h.storeFile(ctx, st, pdfData, fmt.Sprintf("statement_%s.pdf", period))
+	if err != nil {
+		return fmt.Errorf("could not store the pdf: %w", err)
+	}
+
+	return nil
+}
+
+// storeFile uploads the data with the storage-manager's upload uri and creates the storage-manager file of it.
+func (h *statementHandler) storeFile(ctx context.Context, st *statement.Statement, data []byte, filename string) (uuid.UUID, error) {
+	u, err := h.reqHandler.StorageV1FileUploadURICreate(ctx)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("could not get the upload uri: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, u.URI, bytes.NewReader(data))
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("could not create the upload request: %w", err)
+	}
+
+	resp, err := h.httpClient.Do(req)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("could not upload the file: %w", err)
+	}
+	_ = resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return uuid.Nil, fmt.Errorf("could not upload the file. status_code: %d", resp.StatusCode)
+	}
+
+	f, err := h.reqHandler.StorageV1FileCreate(ctx, st.CustomerID, uuid.Nil, smfile.ReferenceTypeNone, uuid.Nil, smfile.TypeNone, "billing statement", fmt.Sprintf("account_id: %s, statement_id: %s", st.AccountID, st.ID), filename, u.BucketName, u.Filepath, 60000)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("could not create the file: %w", err)
+	}
+
+	return f.ID, nil
+}
@@ -0,0 +1,692 @@
+package statementhandler
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/notifyhandler"
+	"monorepo/bin-common-handler/pkg/requesthandler"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+	smfile "monorepo/bin-storage-manager/models/file"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+
+	"monorepo/bin-billing-manager/models/account"
+	"monorepo/bin-billing-manager/models/billing"
+	"monorepo/bin-billing-manager/models/rate"
+	"monorepo/bin-billing-manager/models/statement"
+	"monorepo/bin-billing-manager/pkg/dbhandler"
+)
+
+func Test_Create(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		accountID     uuid.UUID
+		tmPeriodStart time.Time
+
+		responseCurTime      *time.Time
+		responseAccount      *account.Account
+		responseItems        []statement.Item
+		responseDestinations []statement.Destination
+		responseRate         *rate.Rate
+		responseTopUps       []*billing.Billing
+		responseLast         *billing.Billing
+		responseUUID         uuid.UUID
+
+		expectStatement *statement.Statement
+	}{
+		{
+			name: "normal",
+
+			accountID:     uuid.FromStringOrNil("6f5d3b1a-b1a4-11f0-8a52-5b0d2d3c6b10"),
+			tmPeriodStart: time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC),
+
+			responseCurTime: timePtr(time.Date(2026, 10, 1, 3, 0, 0, 0, time.UTC)),
+			responseAccount: &account.Account{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("6f5d3b1a-b1a4-11f0-8a52-5b0d2d3c6b10"),
+					CustomerID: uuid.FromStringOrNil("6f8c2a7e-b1a4-11f0-9b0e-1f0a5d6c7e20"),
+				},
+				Name: "test account",
+			},
+			responseItems: []statement.Item{
+				{
+					TransactionType: billing.TransactionTypeUsage,
+					ReferenceType:   billing.ReferenceTypeCall,
+					CostType:        billing.CostTypeCallPSTNOutgoing,
+					Count:           3,
+					BillableUnits:   5,
+					UsageDuration:   280,
+					AmountCredit:    -30000,
+				},
+				{
+					TransactionType: billing.TransactionTypeTopUp,
+					ReferenceType:   billing.ReferenceTypePaddleCreditPurchase,
+					Count:           1,
+					AmountCredit:    10000000,
+				},
+			},
+			responseDestinations: []statement.Destination{
+				{
+					RateID:        uuid.FromStringOrNil("6fb6e1c4-b1a4-11f0-a2c3-03b4f9e1d930"),
+					CostType:      billing.CostTypeCallPSTNOutgoing,
+					Count:         3,
+					UsageDuration: 280,
+					AmountCredit:  -30000,
+				},
+			},
+			responseRate: &rate.Rate{
+				Prefix: "8210",
+			},
+			responseTopUps: []*billing.Billing{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("6fe0ab52-b1a4-11f0-b4d1-7b2c8e5f4a40"),
+					},
+					ReferenceType: billing.ReferenceTypePaddleCreditPurchase,
+					AmountCredit:  10000000,
+				},
+			},
+			responseLast: &billing.Billing{
+				BalanceTokenSnapshot:  100,
+				BalanceCreditSnapshot: 9970000,
+			},
+			responseUUID: uuid.FromStringOrNil("700a6ea0-b1a4-11f0-87f5-4b7e1c9d2a50"),
+
+			expectStatement: &statement.Statement{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("700a6ea0-b1a4-11f0-87f5-4b7e1c9d2a50"),
+					CustomerID: uuid.FromStringOrNil("6f8c2a7e-b1a4-11f0-9b0e-1f0a5d6c7e20"),
+				},
+				AccountID:     uuid.FromStringOrNil("6f5d3b1a-b1a4-11f0-8a52-5b0d2d3c6b10"),
+				Status:        statement.StatusProgressing,
+				TMPeriodStart: timePtr(time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)),
+				TMPeriodEnd:   timePtr(time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)),
+				Items: []statement.Item{
+					{
+						TransactionType: billing.TransactionTypeUsage,
+						ReferenceType:   billing.ReferenceTypeCall,
+						CostType:        billing.CostTypeCallPSTNOutgoing,
+						Count:           3,
+						BillableUnits:   5,
+						UsageDuration:   280,
+						AmountCredit:    -30000,
+					},
+					{
+						TransactionType: billing.TransactionTypeTopUp,
+						ReferenceType:   billing.ReferenceTypePaddleCreditPurchase,
+						Count:           1,
+						AmountCredit:    10000000,
+					},
+				},
+				TopDestinations: []statement.Destination{
+					{
+						RateID:        uuid.FromStringOrNil("6fb6e1c4-b1a4-11f0-a2c3-03b4f9e1d930"),
+						Prefix:        "8210",
+						CostType:      billing.CostTypeCallPSTNOutgoing,
+						Count:         3,
+						UsageDuration: 280,
+						AmountCredit:  -30000,
+					},
+				},
+				TopUps: []statement.TopUp{
+					{
+						BillingID:     uuid.FromStringOrNil("6fe0ab52-b1a4-11f0-b4d1-7b2c8e5f4a40"),
+						ReferenceType: billing.ReferenceTypePaddleCreditPurchase,
+						AmountCredit:  10000000,
+					},
+				},
+				TotalUsageCredit: -30000,
+				TotalTopUpCredit: 10000000,
+				BalanceTokenEnd:  100,
+				BalanceCreditEnd: 9970000,
+				CSVFileID:        uuid.FromStringOrNil("70883f3c-b1a4-11f0-b0c9-2e4f6a8b1c80"),
+				PDFFileID:        uuid.FromStringOrNil("70b1d6a2-b1a4-11f0-8d1a-6f3e2b9c4d90"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+			srv, uploaded := newTestUploadServer(t)
+
+			h := &statementHandler{
+				utilHandler:   mockUtil,
+				reqHandler:    mockReq,
+				db:            mockDB,
+				notifyHandler: mockNotify,
+				httpClient:    srv.Client(),
+			}
+			ctx := context.Background()
+
+			tmEnd := tt.tmPeriodStart.AddDate(0, 1, 0)
+
+			mockUtil.EXPECT().TimeNow().Return(tt.responseCurTime)
+			mockDB.EXPECT().AccountGet(ctx, tt.accountID).Return(tt.responseAccount, nil)
+			mockUtil.EXPECT().UUIDCreate().Return(tt.responseUUID)
+			mockDB.EXPECT().StatementCreate(ctx, gomock.Any()).Return(nil)
+
+			mockDB.EXPECT().BillingAggregateItems(ctx, tt.accountID, tt.tmPeriodStart, tmEnd).Return(tt.responseItems, nil)
+			mockDB.EXPECT().BillingAggregateDestinations(ctx, tt.accountID, tt.tmPeriodStart, tmEnd, uint64(statement.MaxTopDestinations)).Return(tt.responseDestinations, nil)
+			mockDB.EXPECT().RateGet(ctx, tt.responseDestinations[0].RateID).Return(tt.responseRate, nil)
+			mockDB.EXPECT().BillingListByPeriod(ctx, tt.accountID, billing.TransactionTypeTopUp, tt.tmPeriodStart, tmEnd).Return(tt.responseTopUps, nil)
+			mockDB.EXPECT().BillingGetLastBefore(ctx, tt.accountID, tmEnd).Return(tt.responseLast, nil)
+
+			mockReq.EXPECT().StorageV1FileUploadURICreate(ctx).Return(testUploadURI(srv), nil)
+			mockReq.EXPECT().StorageV1FileCreate(ctx, tt.responseAccount.CustomerID, uuid.Nil, smfile.ReferenceTypeNone, uuid.Nil, smfile.TypeNone, "billing statement", gomock.Any(), "statement_2026-09.csv", testUploadBucketName, testUploadFilepath, 60000).Return(&smfile.File{Identity: commonidentity.Identity{ID: tt.expectStatement.CSVFileID}}, nil)
+
+			mockReq.EXPECT().StorageV1FileUploadURICreate(ctx).Return(testUploadURI(srv), nil)
+			mockReq.EXPECT().StorageV1FileCreate(ctx, tt.responseAccount.CustomerID, uuid.Nil, smfile.ReferenceTypeNone, uuid.Nil, smfile.TypeNone, "billing statement", gomock.Any(), "statement_2026-09.pdf", testUploadBucketName, testUploadFilepath, 60000).Return(&smfile.File{Identity: commonidentity.Identity{ID: tt.expectStatement.PDFFileID}}, nil)
+
+			mockDB.EXPECT().StatementFinish(ctx, tt.expectStatement).Return(true, nil)
+			mockDB.EXPECT().StatementGet(ctx, tt.expectStatement.ID).Return(tt.expectStatement, nil)
+			mockNotify.EXPECT().PublishWebhookEvent(ctx, tt.expectStatement.CustomerID, statement.EventTypeStatementCreated, tt.expectStatement)
+
+			res, err := h.Create(ctx, tt.accountID, tt.tmPeriodStart)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectStatement) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectStatement, res)
+			}
+
+			if uploaded() != 2 {
+				t.Errorf("Wrong match. expect: 2 uploads, got: %d", uploaded())
+			}
+		})
+	}
+}
+
+func Test_Create_error(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		accountID     uuid.UUID
+		tmPeriodStart time.Time
+
+		responseCurTime *time.Time
+		responseAccount *account.Account
+		responseExists  []*statement.Statement
+	}{
+		{
+			name: "period does not start at the month start",
+
+			accountID:     uuid.FromStringOrNil("8b5c1d2e-b1a4-11f0-9f3a-7a1b2c3d4e50"),
+			tmPeriodStart: time.Date(2026, 9, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "period has not ended",
+
+			accountID:       uuid.FromStringOrNil("8b5c1d2e-b1a4-11f0-9f3a-7a1b2c3d4e50"),
+			tmPeriodStart:   time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+			responseCurTime: timePtr(time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)),
+		},
+		{
+			name: "statement already exists",
+
+			accountID:       uuid.FromStringOrNil("8b5c1d2e-b1a4-11f0-9f3a-7a1b2c3d4e50"),
+			tmPeriodStart:   time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC),
+			responseCurTime: timePtr(time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)),
+			responseAccount: &account.Account{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("8b5c1d2e-b1a4-11f0-9f3a-7a1b2c3d4e50"),
+				},
+			},
+			responseExists: []*statement.Statement{
+				{
+					Status: statement.StatusDone,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+
+			h := &statementHandler{
+				utilHandler: mockUtil,
+				db:          mockDB,
+			}
+			ctx := context.Background()
+
+			if tt.responseCurTime != nil {
+				mockUtil.EXPECT().TimeNow().Return(tt.responseCurTime)
+			}
+			if tt.responseAccount != nil {
+				mockDB.EXPECT().AccountGet(ctx, tt.accountID).Return(tt.responseAccount, nil)
+				mockUtil.EXPECT().UUIDCreate().Return(uuid.FromStringOrNil("8b8a3e4f-b1a4-11f0-a0b1-8c2d3e4f5a60"))
+				mockDB.EXPECT().StatementCreate(ctx, gomock.Any()).Return(dbhandler.ErrDuplicateKey)
+				mockDB.EXPECT().StatementList(ctx, uint64(1), "", gomock.Any()).Return(tt.responseExists, nil)
+			}
+
+			_, err := h.Create(ctx, tt.accountID, tt.tmPeriodStart)
+			if err == nil {
+				t.Errorf("Wrong match. expect: error, got: ok")
+			}
+		})
+	}
+}
+
+func Test_GenerateDue(t *testing.T) {
+
+	now := time.Date(2026, 10, 1, 3, 0, 0, 0, time.UTC)
+	start := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	before := time.Date(2026, 8, 1, 0, 0, 0, 0, time.UTC)
+
+	existAccount := &account.Account{
+		Identity: commonidentity.Identity{ID: uuid.FromStringOrNil("a4c1e8f2-b1a4-11f0-8a0d-3b6f1c2e4d01")},
+		TMCreate: &before,
+	}
+	newAccount := &account.Account{
+		Identity: commonidentity.Identity{ID: uuid.FromStringOrNil("a4c1e8f2-b1a4-11f0-8a0d-3b6f1c2e4d02")},
+		TMCreate: &now,
+	}
+	errorAccount := &account.Account{
+		Identity: commonidentity.Identity{ID: uuid.FromStringOrNil("a4c1e8f2-b1a4-11f0-8a0d-3b6f1c2e4d03")},
+		TMCreate: &before,
+	}
+	dueAccount := &account.Account{
+		Identity: commonidentity.Identity{ID: uuid.FromStringOrNil("a4c1e8f2-b1a4-11f0-8a0d-3b6f1c2e4d04")},
+		TMCreate: &before,
+	}
+
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockUtil := utilhandler.NewMockUtilHandler(mc)
+	mockDB := dbhandler.NewMockDBHandler(mc)
+	mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+
+	h := &statementHandler{
+		utilHandler:   mockUtil,
+		db:            mockDB,
+		notifyHandler: mockNotify,
+	}
+	ctx := context.Background()
+
+	mockUtil.EXPECT().TimeNow().Return(&now)
+	mockDB.EXPECT().AccountList(ctx, uint64(500), "", map[account.Field]any{account.FieldDeleted: false}).Return([]*account.Account{existAccount, newAccount, errorAccount, dueAccount}, nil)
+
+	// the statement already exists
+	mockDB.EXPECT().StatementList(ctx, uint64(1), "", map[statement.Field]any{
+		statement.FieldAccountID:     existAccount.ID,
+		statement.FieldTMPeriodStart: &start,
+		statement.FieldStatus:        statement.StatusDone,
+		statement.FieldDeleted:       false,
+	}).Return([]*statement.Statement{{}}, nil)
+
+	// the check fails. requested anyway
+	mockDB.EXPECT().StatementList(ctx, uint64(1), "", gomock.Any()).Return(nil, fmt.Errorf(""))
+	mockNotify.EXPECT().PublishEvent(ctx, statement.EventTypeStatementGenerateRequested, &statement.GenerateRequest{AccountID: errorAccount.ID, TMPeriodStart: &start})
+
+	mockDB.EXPECT().StatementList(ctx, uint64(1), "", gomock.Any()).Return([]*statement.Statement{}, nil)
+	mockNotify.EXPECT().PublishEvent(ctx, statement.EventTypeStatementGenerateRequested, &statement.GenerateRequest{AccountID: dueAccount.ID, TMPeriodStart: &start})
+
+	requested, err := h.GenerateDue(ctx)
+	if err != nil {
+		t.Errorf("Wrong match. expect: ok, got: %v", err)
+	}
+	if requested != 2 {
+		t.Errorf("Wrong match. expect: 2, got: %d", requested)
+	}
+}
+
+func Test_GenerateRequested_skip(t *testing.T) {
+
+	start := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	tmDelete := time.Date(2026, 10, 2, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+
+		req *statement.GenerateRequest
+
+		responseAccount    *account.Account
+		responseAccountErr error
+		responseExists     []*statement.Statement
+	}{
+		{
+			name: "account does not exist",
+
+			req: &statement.GenerateRequest{
+				AccountID:     uuid.FromStringOrNil("b61e2c4a-b1a4-11f0-9c3d-1e2f3a4b5c01"),
+				TMPeriodStart: &start,
+			},
+
+			responseAccountErr: dbhandler.ErrNotFound,
+		},
+		{
+			name: "account has deleted",
+
+			req: &statement.GenerateRequest{
+				AccountID:     uuid.FromStringOrNil("b61e2c4a-b1a4-11f0-9c3d-1e2f3a4b5c02"),
+				TMPeriodStart: &start,
+			},
+
+			responseAccount: &account.Account{
+				Identity: commonidentity.Identity{ID: uuid.FromStringOrNil("b61e2c4a-b1a4-11f0-9c3d-1e2f3a4b5c02")},
+				TMDelete: &tmDelete,
+			},
+		},
+		{
+			name: "statement has generated",
+
+			req: &statement.GenerateRequest{
+				AccountID:     uuid.FromStringOrNil("b61e2c4a-b1a4-11f0-9c3d-1e2f3a4b5c03"),
+				TMPeriodStart: &start,
+			},
+
+			responseAccount: &account.Account{
+				Identity: commonidentity.Identity{ID: uuid.FromStringOrNil("b61e2c4a-b1a4-11f0-9c3d-1e2f3a4b5c03")},
+			},
+			responseExists: []*statement.Statement{
+				{
+					Status: statement.StatusDone,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+
+			h := &statementHandler{
+				utilHandler: mockUtil,
+				db:          mockDB,
+			}
+			ctx := context.Background()
+
+			mockDB.EXPECT().AccountGet(ctx, tt.req.AccountID).Return(tt.responseAccount, tt.responseAccountErr)
+			if tt.responseExists != nil {
+				mockUtil.EXPECT().UUIDCreate().Return(uuid.FromStringOrNil("b6a4d8e0-b1a4-11f0-8f1a-2b3c4d5e6f70"))
+				mockDB.EXPECT().StatementCreate(ctx, gomock.Any()).Return(dbhandler.ErrDuplicateKey)
+				mockDB.EXPECT().StatementList(ctx, uint64(1), "", gomock.Any()).Return(tt.responseExists, nil)
+			}
+
+			if err := h.GenerateRequested(ctx, tt.req); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+		})
+	}
+}
+
+func Test_claim(t *testing.T) {
+
+	start := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	now := time.Date(2026, 10, 1, 3, 0, 0, 0, time.UTC)
+
+	a := &account.Account{
+		Identity: commonidentity.Identity{
+			ID:         uuid.FromStringOrNil("c27a1e3c-b1a4-11f0-8d2e-4f5a6b7c8d01"),
+			CustomerID: uuid.FromStringOrNil("c2a8f04e-b1a4-11f0-9e3f-5a6b7c8d9e02"),
+		},
+	}
+
+	tests := []struct {
+		name string
+
+		responseUUID      uuid.UUID
+		responseCreateErr error
+		responseExists    []*statement.Statement
+		responseClaimed   bool
+
+		expectID  uuid.UUID
+		expectErr error
+	}{
+		{
+			name: "created",
+
+			responseUUID: uuid.FromStringOrNil("c2d7a260-b1a4-11f0-af40-6b7c8d9e0f03"),
+
+			expectID: uuid.FromStringOrNil("c2d7a260-b1a4-11f0-af40-6b7c8d9e0f03"),
+		},
+		{
+			name: "statement has generated",
+
+			responseUUID:      uuid.FromStringOrNil("c2d7a260-b1a4-11f0-af40-6b7c8d9e0f03"),
+			responseCreateErr: dbhandler.ErrDuplicateKey,
+			responseExists: []*statement.Statement{
+				{
+					Identity: commonidentity.Identity{ID: uuid.FromStringOrNil("c3065472-b1a4-11f0-b051-7c8d9e0f1a04")},
+					Status:   statement.StatusDone,
+				},
+			},
+
+			expectErr: dbhandler.ErrDuplicateKey,
+		},
+		{
+			name: "statement of the lost generator is taken over",
+
+			responseUUID:      uuid.FromStringOrNil("c2d7a260-b1a4-11f0-af40-6b7c8d9e0f03"),
+			responseCreateErr: dbhandler.ErrDuplicateKey,
+			responseExists: []*statement.Statement{
+				{
+					Identity: commonidentity.Identity{ID: uuid.FromStringOrNil("c3065472-b1a4-11f0-b051-7c8d9e0f1a04")},
+					Status:   statement.StatusProgressing,
+				},
+			},
+			responseClaimed: true,
+
+			expectID: uuid.FromStringOrNil("c3065472-b1a4-11f0-b051-7c8d9e0f1a04"),
+		},
+		{
+			name: "statement is being generated by the other",
+
+			responseUUID:      uuid.FromStringOrNil("c2d7a260-b1a4-11f0-af40-6b7c8d9e0f03"),
+			responseCreateErr: dbhandler.ErrDuplicateKey,
+			responseExists: []*statement.Statement{
+				{
+					Identity: commonidentity.Identity{ID: uuid.FromStringOrNil("c3065472-b1a4-11f0-b051-7c8d9e0f1a04")},
+					Status:   statement.StatusProgressing,
+				},
+			},
+			responseClaimed: false,
+
+			expectErr: dbhandler.ErrDuplicateKey,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+
+			h := &statementHandler{
+				utilHandler: mockUtil,
+				db:          mockDB,
+			}
+			ctx := context.Background()
+
+			mockUtil.EXPECT().UUIDCreate().Return(tt.responseUUID)
+			mockDB.EXPECT().StatementCreate(ctx, &statement.Statement{
+				Identity: commonidentity.Identity{
+					ID:         tt.responseUUID,
+					CustomerID: a.CustomerID,
+				},
+				AccountID:     a.ID,
+				Status:        statement.StatusProgressing,
+				TMPeriodStart: &start,
+				TMPeriodEnd:   &end,
+			}).Return(tt.responseCreateErr)
+			if tt.responseExists != nil {
+				mockDB.EXPECT().StatementList(ctx, uint64(1), "", map[statement.Field]any{
+					statement.FieldAccountID:     a.ID,
+					statement.FieldTMPeriodStart: &start,
+				}).Return(tt.responseExists, nil)
+				if tt.responseExists[0].Status == statement.StatusProgressing {
+					mockUtil.EXPECT().TimeNow().Return(&now)
+					mockDB.EXPECT().StatementClaim(ctx, tt.responseExists[0].ID, now.Add(-claimTimeout)).Return(tt.responseClaimed, nil)
+				}
+			}
+
+			res, err := h.claim(ctx, a, start, end)
+			if err != tt.expectErr {
+				t.Fatalf("Wrong match. expect: %v, got: %v", tt.expectErr, err)
+			}
+			if tt.expectErr != nil {
+				return
+			}
+
+			if res.ID != tt.expectID {
+				t.Errorf("Wrong match. expect: %s, got: %s", tt.expectID, res.ID)
+			}
+		})
+	}
+}
+
+func Test_generate_release(t *testing.T) {
+
+	start := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	a := &account.Account{
+		Identity: commonidentity.Identity{
+			ID:         uuid.FromStringOrNil("d1b3e5f7-b1a4-11f0-8a9b-1c2d3e4f5a01"),
+			CustomerID: uuid.FromStringOrNil("d1e4a6c8-b1a4-11f0-9bac-2d3e4f5a6b02"),
+		},
+	}
+	statementID := uuid.FromStringOrNil("d2157c9a-b1a4-11f0-acbd-3e4f5a6b7c03")
+	csvFileID := uuid.FromStringOrNil("d2463e6c-b1a4-11f0-bdce-4f5a6b7c8d04")
+
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockUtil := utilhandler.NewMockUtilHandler(mc)
+	mockReq := requesthandler.NewMockRequestHandler(mc)
+	mockDB := dbhandler.NewMockDBHandler(mc)
+	srv, _ := newTestUploadServer(t)
+
+	h := &statementHandler{
+		utilHandler: mockUtil,
+		reqHandler:  mockReq,
+		db:          mockDB,
+		httpClient:  srv.Client(),
+	}
+	ctx := context.Background()
+
+	mockUtil.EXPECT().UUIDCreate().Return(statementID)
+	mockDB.EXPECT().StatementCreate(ctx, gomock.Any()).Return(nil)
+
+	mockDB.EXPECT().BillingAggregateItems(ctx, a.ID, start, end).Return([]statement.Item{}, nil)
+	mockDB.EXPECT().BillingAggregateDestinations(ctx, a.ID, start, end, uint64(statement.MaxTopDestinations)).Return([]statement.Destination{}, nil)
+	mockDB.EXPECT().BillingListByPeriod(ctx, a.ID, billing.TransactionTypeTopUp, start, end).Return([]*billing.Billing{}, nil)
+	mockDB.EXPECT().BillingGetLastBefore(ctx, a.ID, end).Return(nil, dbhandler.ErrNotFound)
+
+	// the csv is stored, the pdf's upload fails
+	mockReq.EXPECT().StorageV1FileUploadURICreate(ctx).Return(testUploadURI(srv), nil)
+	mockReq.EXPECT().StorageV1FileCreate(ctx, a.CustomerID, uuid.Nil, smfile.ReferenceTypeNone, uuid.Nil, smfile.TypeNone, "billing statement", gomock.Any(), "statement_2026-09.csv", testUploadBucketName, testUploadFilepath, 60000).Return(&smfile.File{Identity: commonidentity.Identity{ID: csvFileID}}, nil)
+	mockReq.EXPECT().StorageV1FileUploadURICreate(ctx).Return(&smfile.UploadURI{URI: srv.URL + "/forbidden"}, nil)
+
+	// the stored csv is deleted and the statement is released
+	mockReq.EXPECT().StorageV1FileDelete(ctx, csvFileID, 60000).Return(&smfile.File{}, nil)
+	mockDB.EXPECT().StatementRelease(ctx, statementID).Return(nil)
+
+	if _, err := h.generate(ctx, a, start, end); err == nil {
+		t.Errorf("Wrong match. expect: error, got: ok")
+	}
+}
+
+func Test_formatCredit(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		micros int64
+
+		expectRes string
+	}{
+		{"zero", 0, "0.00"},
+		{"dollars", 10000000, "10.00"},
+		{"cents", 1230000, "1.23"},
+		{"sub cent", -18000, "-0.018"},
+		{"micros", 1234567, "1.234567"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := formatCredit(tt.micros)
+			if res != tt.expectRes {
+				t.Errorf("Wrong match. expect: %s, got: %s", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
+
+const (
+	testUploadBucketName = "test-bucket-tmp"
+	testUploadFilepath   = "tmp/e1f2a3b4-b1a4-11f0-8c5d-6e7f8a9b0c01"
+)
+
+// newTestUploadServer returns the fake signed uri server.
+// PUT /upload accepts the body. The returned func returns the number of the uploads.
+func newTestUploadServer(t *testing.T) (*httptest.Server, func() int) {
+	t.Helper()
+
+	var mu sync.Mutex
+	uploaded := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/upload" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		_, _ = io.Copy(io.Discard, r.Body)
+		mu.Lock()
+		uploaded++
+		mu.Unlock()
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv, func() int {
+		mu.Lock()
+		defer mu.Unlock()
+		return uploaded
+	}
+}
+
+// testUploadURI returns the upload uri of the given fake server.
+func testUploadURI(srv *httptest.Server) *smfile.UploadURI {
+	return &smfile.UploadURI{
+		BucketName: testUploadBucketName,
+		Filepath:   testUploadFilepath,
+		URI:        srv.URL + "/upload",
+	}
+}
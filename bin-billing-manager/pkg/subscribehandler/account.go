@@ -0,0 +1,35 @@
+package subscribehandler
+
+import (
+	"context"
+	"encoding/json"
+
+	"monorepo/bin-common-handler/models/sock"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"monorepo/bin-billing-manager/models/account"
+)
+
+// processEventBMAccountAutoTopUpRequested handles the billing-manager's account_auto_topup_requested event
+func (h *subscribeHandler) processEventBMAccountAutoTopUpRequested(ctx context.Context, m *sock.Event) error {
+	log := logrus.WithFields(logrus.Fields{
+		"func":  "processEventBMAccountAutoTopUpRequested",
+		"event": m,
+	})
+	log.Debugf("Received account event. event: %s", m.Type)
+
+	var req account.AutoTopUpRequest
+	if err := json.Unmarshal([]byte(m.Data), &req); err != nil {
+		log.Errorf("Could not unmarshal the data. err: %v", err)
+		return errors.Wrap(err, "could not unmarshal the data")
+	}
+
+	if errCharge := h.accountHandler.ChargeAutoTopUp(ctx, &req); errCharge != nil {
+		log.Errorf("Could not charge the auto top-up. err: %v", errCharge)
+		return errCharge
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package subscribehandler
+
+import (
+	"fmt"
+	"testing"
+
+	"monorepo/bin-common-handler/models/sock"
+	"monorepo/bin-common-handler/pkg/sockhandler"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	"monorepo/bin-billing-manager/models/account"
+	"monorepo/bin-billing-manager/pkg/accounthandler"
+	"monorepo/bin-billing-manager/pkg/billinghandler"
+)
+
+func Test_processEventBMAccountAutoTopUpRequested(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		event *sock.Event
+
+		responseErr error
+
+		expectReq *account.AutoTopUpRequest
+		expectErr bool
+	}{
+		{
+			name: "normal",
+
+			event: &sock.Event{
+				Publisher: "billing-manager",
+				Type:      account.EventTypeAccountAutoTopUpRequested,
+				DataType:  "application/json",
+				Data:      []byte(`{"account_id":"4f1e2a60-aeb9-11f1-8c3d-1a2b3c4d5e6f","amount":20000000,"balance_credit":4990000}`),
+			},
+
+			expectReq: &account.AutoTopUpRequest{
+				AccountID:     uuid.FromStringOrNil("4f1e2a60-aeb9-11f1-8c3d-1a2b3c4d5e6f"),
+				Amount:        20000000,
+				BalanceCredit: 4990000,
+			},
+		},
+		{
+			name: "charge failure is returned for the retry",
+
+			event: &sock.Event{
+				Publisher: "billing-manager",
+				Type:      account.EventTypeAccountAutoTopUpRequested,
+				DataType:  "application/json",
+				Data:      []byte(`{"account_id":"4f6f3b71-aeb9-11f1-9d4e-2b3c4d5e6f7a","amount":10000000,"balance_credit":0}`),
+			},
+
+			responseErr: fmt.Errorf("paddle API returned status 500"),
+
+			expectReq: &account.AutoTopUpRequest{
+				AccountID: uuid.FromStringOrNil("4f6f3b71-aeb9-11f1-9d4e-2b3c4d5e6f7a"),
+				Amount:    10000000,
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockAccount := accounthandler.NewMockAccountHandler(mc)
+			mockBilling := billinghandler.NewMockBillingHandler(mc)
+
+			h := subscribeHandler{
+				sockHandler:    mockSock,
+				accountHandler: mockAccount,
+				billingHandler: mockBilling,
+			}
+
+			mockAccount.EXPECT().ChargeAutoTopUp(gomock.Any(), tt.expectReq).Return(tt.responseErr)
+
+			err := h.processEvent(tt.event)
+			if (err != nil) != tt.expectErr {
+				t.Errorf("Wrong match. expect error: %v, got: %v", tt.expectErr, err)
+			}
+		})
+	}
+}
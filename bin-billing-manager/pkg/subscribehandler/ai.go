@@ -0,0 +1,40 @@
+package subscribehandler
+
+import (
+	"context"
+	"encoding/json"
+
+	amaiassist "monorepo/bin-ai-manager/models/aiassist"
+	amaicall "monorepo/bin-ai-manager/models/aicall"
+	"monorepo/bin-common-handler/models/sock"
+
+	"github.com/pkg/errors"
+)
+
+// processEventAIAIcallTerminated handles the ai-manager's aicall_status_terminated event
+func (h *subscribeHandler) processEventAIAIcallTerminated(ctx context.Context, m *sock.Event) error {
+	var c amaicall.AIcall
+	if err := json.Unmarshal([]byte(m.Data), &c); err != nil {
+		return errors.Wrapf(err, "could not unmarshal the data. processEventAIAIcallTerminated. err: %v", err)
+	}
+
+	if errEvent := h.billingHandler.EventAIAIcallTerminated(ctx, &c); errEvent != nil {
+		return errors.Wrapf(errEvent, "could not handle the event. processEventAIAIcallTerminated. err: %v", errEvent)
+	}
+
+	return nil
+}
+
+// processEventAIAIAssistTerminated handles the ai-manager's aiassist_status_terminated event
+func (h *subscribeHandler) processEventAIAIAssistTerminated(ctx context.Context, m *sock.Event) error {
+	var a amaiassist.AIAssist
+	if err := json.Unmarshal([]byte(m.Data), &a); err != nil {
+		return errors.Wrapf(err, "could not unmarshal the data. processEventAIAIAssistTerminated. err: %v", err)
+	}
+
+	if errEvent := h.billingHandler.EventAIAIAssistTerminated(ctx, &a); errEvent != nil {
+		return errors.Wrapf(errEvent, "could not handle the event. processEventAIAIAssistTerminated. err: %v", errEvent)
+	}
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package subscribehandler
+
+import (
+	"testing"
+
+	amai "monorepo/bin-ai-manager/models/ai"
+	amaiassist "monorepo/bin-ai-manager/models/aiassist"
+	amaicall "monorepo/bin-ai-manager/models/aicall"
+	amusage "monorepo/bin-ai-manager/models/usage"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	commonoutline "monorepo/bin-common-handler/models/outline"
+	"monorepo/bin-common-handler/models/sock"
+	"monorepo/bin-common-handler/pkg/sockhandler"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	"monorepo/bin-billing-manager/pkg/billinghandler"
+)
+
+func Test_processEventAIAIcallTerminated(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		event *sock.Event
+
+		expectAIcall *amaicall.AIcall
+	}{
+		{
+			name: "normal",
+
+			event: &sock.Event{
+				Publisher: string(commonoutline.ServiceNameAIManager),
+				Type:      amaicall.EventTypeStatusTerminated,
+				DataType:  "application/json",
+				Data:      []byte(`{"id":"ac111111-0000-0000-0000-000000000001","usage":{"prompt_tokens":1200,"completion_tokens":300,"cached_tokens":100,"stt_seconds":12.5,"tts_seconds":8}}`),
+			},
+
+			expectAIcall: &amaicall.AIcall{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("ac111111-0000-0000-0000-000000000001"),
+				},
+				Usage: amusage.Usage{
+					PromptTokens:     1200,
+					CompletionTokens: 300,
+					CachedTokens:     100,
+					STTSeconds:       12.5,
+					TTSSeconds:       8,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockBilling := billinghandler.NewMockBillingHandler(mc)
+
+			h := subscribeHandler{
+				sockHandler:    mockSock,
+				billingHandler: mockBilling,
+			}
+
+			mockBilling.EXPECT().EventAIAIcallTerminated(gomock.Any(), tt.expectAIcall).Return(nil)
+
+			if err := h.processEvent(tt.event); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+		})
+	}
+}
+
+func Test_processEventAIAIAssistTerminated(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		event *sock.Event
+
+		expectAIAssist *amaiassist.AIAssist
+	}{
+		{
+			name: "normal",
+
+			event: &sock.Event{
+				Publisher: string(commonoutline.ServiceNameAIManager),
+				Type:      amaiassist.EventTypeStatusTerminated,
+				DataType:  "application/json",
+				Data:      []byte(`{"id":"ad111111-0000-0000-0000-000000000001","usage":{"prompt_tokens":4000,"completion_tokens":200},"engine_model":"gemini.gemini-2.5-flash"}`),
+			},
+
+			expectAIAssist: &amaiassist.AIAssist{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("ad111111-0000-0000-0000-000000000001"),
+				},
+				Usage: amusage.Usage{
+					PromptTokens:     4000,
+					CompletionTokens: 200,
+				},
+				EngineModel: amai.EngineModelGeminiGemini2Dot5Flash,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockBilling := billinghandler.NewMockBillingHandler(mc)
+
+			h := subscribeHandler{
+				sockHandler:    mockSock,
+				billingHandler: mockBilling,
+			}
+
+			mockBilling.EXPECT().EventAIAIAssistTerminated(gomock.Any(), tt.expectAIAssist).Return(nil)
+
+			if err := h.processEvent(tt.event); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+		})
+	}
+}
@@ -7,12 +7,15 @@ import (
 	"fmt"
 	"time"
 
+	amaiassist "monorepo/bin-ai-manager/models/aiassist"
+	amaicall "monorepo/bin-ai-manager/models/aicall"
 	cmcall "monorepo/bin-call-manager/models/call"
 	cmrecording "monorepo/bin-call-manager/models/recording"
 
 	commonoutline "monorepo/bin-common-handler/models/outline"
 	"monorepo/bin-common-handler/models/sock"
 	"monorepo/bin-common-handler/pkg/sockhandler"
+	"monorepo/bin-common-handler/pkg/tracehandler"
 
 	cscustomer "monorepo/bin-customer-manager/models/customer"
 	ememail "monorepo/bin-email-manager/models/email"
@@ -24,9 +27,12 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
 
+	"monorepo/bin-billing-manager/models/account"
+	"monorepo/bin-billing-manager/models/statement"
 	"monorepo/bin-billing-manager/pkg/accounthandler"
 	"monorepo/bin-billing-manager/pkg/billinghandler"
 	"monorepo/bin-billing-manager/pkg/failedeventhandler"
+	"monorepo/bin-billing-manager/pkg/statementhandler"
 )
 
 // SubscribeHandler interface
@@ -42,6 +48,7 @@ type subscribeHandler struct {
 
 	accountHandler      accounthandler.AccountHandler
 	billingHandler      billinghandler.BillingHandler
+	statementHandler    statementhandler.StatementHandler
 	failedEventHandler  failedeventhandler.FailedEventHandler
 }
 
@@ -74,6 +81,7 @@ func NewSubscribeHandler(
 	subscribeTargets []string,
 	accountHandler accounthandler.AccountHandler,
 	billingHandler billinghandler.BillingHandler,
+	statementHandler statementhandler.StatementHandler,
 	failedEventHandler failedeventhandler.FailedEventHandler,
 ) SubscribeHandler {
 	h := &subscribeHandler{
@@ -83,6 +91,7 @@ func NewSubscribeHandler(
 
 		accountHandler:     accountHandler,
 		billingHandler:     billingHandler,
+		statementHandler:   statementHandler,
 		failedEventHandler: failedEventHandler,
 	}
 
@@ -143,7 +152,8 @@ func (h *subscribeHandler) processEvent(m *sock.Event) error {
 		"message": m,
 	})
 	log.Debugf("Received subscribed event. publisher: %s, type: %s", m.Publisher, m.Type)
-	ctx := context.Background()
+	ctx, span := tracehandler.StartConsumerSpan(context.Background(), m)
+	defer span.End()
 
 	var err error
 
@@ -206,6 +216,24 @@ func (h *subscribeHandler) processEvent(m *sock.Event) error {
 	case m.Publisher == string(commonoutline.ServiceNameCallManager) && m.Type == cmrecording.EventTypeRecordingFinished:
 		err = h.processEventCMRecordingFinished(ctx, m)
 
+	//// billing-manager
+	// account
+	case m.Publisher == string(commonoutline.ServiceNameBillingManager) && m.Type == account.EventTypeAccountAutoTopUpRequested:
+		err = h.processEventBMAccountAutoTopUpRequested(ctx, m)
+
+	// statement
+	case m.Publisher == string(commonoutline.ServiceNameBillingManager) && m.Type == statement.EventTypeStatementGenerateRequested:
+		err = h.processEventBMStatementGenerateRequested(ctx, m)
+
+	//// ai-manager
+	// aicall
+	case m.Publisher == string(commonoutline.ServiceNameAIManager) && m.Type == amaicall.EventTypeStatusTerminated:
+		err = h.processEventAIAIcallTerminated(ctx, m)
+
+	// aiassist
+	case m.Publisher == string(commonoutline.ServiceNameAIManager) && m.Type == amaiassist.EventTypeStatusTerminated:
+		err = h.processEventAIAIAssistTerminated(ctx, m)
+
 	/////////////////////////////////////////////////////////////////////////////////////////////////
 	// No handler found
 	/////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,35 @@
+package subscribehandler
+
+import (
+	"context"
+	"encoding/json"
+
+	"monorepo/bin-common-handler/models/sock"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"monorepo/bin-billing-manager/models/statement"
+)
+
+// processEventBMStatementGenerateRequested handles the billing-manager's statement_generate_requested event
+func (h *subscribeHandler) processEventBMStatementGenerateRequested(ctx context.Context, m *sock.Event) error {
+	log := logrus.WithFields(logrus.Fields{
+		"func":  "processEventBMStatementGenerateRequested",
+		"event": m,
+	})
+	log.Debugf("Received statement event. event: %s", m.Type)
+
+	var req statement.GenerateRequest
+	if err := json.Unmarshal([]byte(m.Data), &req); err != nil {
+		log.Errorf("Could not unmarshal the data. err: %v", err)
+		return errors.Wrap(err, "could not unmarshal the data")
+	}
+
+	if errGenerate := h.statementHandler.GenerateRequested(ctx, &req); errGenerate != nil {
+		log.Errorf("Could not generate the statement. err: %v", errGenerate)
+		return errGenerate
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package subscribehandler
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"monorepo/bin-common-handler/models/sock"
+	"monorepo/bin-common-handler/pkg/sockhandler"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	"monorepo/bin-billing-manager/models/statement"
+	"monorepo/bin-billing-manager/pkg/statementhandler"
+)
+
+func Test_processEventBMStatementGenerateRequested(t *testing.T) {
+
+	tmPeriodStart := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		event *sock.Event
+
+		responseErr error
+
+		expectReq *statement.GenerateRequest
+		expectErr bool
+	}{
+		{
+			name: "normal",
+
+			event: &sock.Event{
+				Publisher: "billing-manager",
+				Type:      statement.EventTypeStatementGenerateRequested,
+				DataType:  "application/json",
+				Data:      []byte(`{"account_id":"e5a1c3d4-b1a4-11f0-8e2f-3a4b5c6d7e01","tm_period_start":"2026-09-01T00:00:00Z"}`),
+			},
+
+			expectReq: &statement.GenerateRequest{
+				AccountID:     uuid.FromStringOrNil("e5a1c3d4-b1a4-11f0-8e2f-3a4b5c6d7e01"),
+				TMPeriodStart: &tmPeriodStart,
+			},
+		},
+		{
+			name: "generation failure is returned for the retry",
+
+			event: &sock.Event{
+				Publisher: "billing-manager",
+				Type:      statement.EventTypeStatementGenerateRequested,
+				DataType:  "application/json",
+				Data:      []byte(`{"account_id":"e5d2f4e6-b1a4-11f0-9f30-4b5c6d7e8f02","tm_period_start":"2026-09-01T00:00:00Z"}`),
+			},
+
+			responseErr: fmt.Errorf("could not upload the file"),
+
+			expectReq: &statement.GenerateRequest{
+				AccountID:     uuid.FromStringOrNil("e5d2f4e6-b1a4-11f0-9f30-4b5c6d7e8f02"),
+				TMPeriodStart: &tmPeriodStart,
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockStatement := statementhandler.NewMockStatementHandler(mc)
+
+			h := subscribeHandler{
+				sockHandler:      mockSock,
+				statementHandler: mockStatement,
+			}
+
+			mockStatement.EXPECT().GenerateRequested(gomock.Any(), tt.expectReq).Return(tt.responseErr)
+
+			err := h.processEvent(tt.event)
+			if (err != nil) != tt.expectErr {
+				t.Errorf("Wrong match. expect error: %v, got: %v", tt.expectErr, err)
+			}
+		})
+	}
+}
@@ -7,6 +7,8 @@ create table billing_accounts(
   plan_type varchar(255),
   plan_status varchar(255) default 'active',
 
+  rate_deck_id binary(16),
+
   name    varchar(255),
   detail  text,
 
@@ -16,6 +18,14 @@ create table billing_accounts(
   payment_type      varchar(255),
   payment_method    varchar(255),
 
+  spend_limits              json,
+  balance_alert_thresholds  json,
+  auto_topup_threshold      bigint default 0,
+  auto_topup_amount         bigint default 0,
+
+  auto_topup_pending_id binary(16),
+  tm_auto_topup_pending datetime(6),
+
   paddle_subscription_id varchar(255),
   paddle_customer_id     varchar(255),
 
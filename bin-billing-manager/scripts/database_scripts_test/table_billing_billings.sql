@@ -12,9 +12,14 @@ create table billing_billings(
   cost_type             varchar(64),
   usage_duration        integer default 0,
   billable_units        integer default 0,
+  billed_duration       integer default 0,
 
-  rate_token_per_unit   bigint default 0,
-  rate_credit_per_unit  bigint default 0,
+  rate_id                   binary(16),
+  rate_token_per_unit       bigint default 0,
+  rate_credit_per_unit      bigint default 0,
+  rate_connection_fee       bigint default 0,
+  rate_increment_initial    integer default 0,
+  rate_increment_subsequent integer default 0,
 
   amount_token          bigint default 0,
   amount_credit         bigint default 0,
@@ -37,6 +42,7 @@ create table billing_billings(
 
 create index idx_billing_billings_customer_id on billing_billings(customer_id);
 create index idx_billing_billings_account_id on billing_billings(account_id);
+create index idx_billing_billings_account_id_tm_billing_start on billing_billings(account_id, tm_billing_start);
 create index idx_billing_billings_reference_id on billing_billings(reference_id);
 create unique index idx_billings_ref_type_id_active on billing_billings(reference_type, reference_id, tm_delete);
 create unique index ux_billing_billings_idempotency_key on billing_billings(idempotency_key);
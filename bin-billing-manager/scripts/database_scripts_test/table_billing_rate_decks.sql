@@ -0,0 +1,18 @@
+create table billing_rate_decks(
+  id binary(16),
+
+  name    varchar(255),
+  detail  text,
+
+  plan_type varchar(255),
+
+  -- timestamps
+  tm_create datetime(6),
+  tm_update datetime(6),
+  tm_delete datetime(6),
+
+  primary key(id)
+);
+
+create index idx_billing_rate_decks_plan_type on billing_rate_decks(plan_type);
+create index idx_billing_rate_decks_create on billing_rate_decks(tm_create);
@@ -0,0 +1,26 @@
+create table billing_rates(
+  id            binary(16),
+  rate_deck_id  binary(16),
+
+  cost_type varchar(64),
+  prefix    varchar(32),
+
+  credit_per_unit bigint default 0,
+  connection_fee  bigint default 0,
+
+  increment_initial    integer default 0,
+  increment_subsequent integer default 0,
+
+  tm_effective_start  datetime(6),
+  tm_effective_end    datetime(6),
+
+  -- timestamps
+  tm_create datetime(6),
+  tm_update datetime(6),
+  tm_delete datetime(6),
+
+  primary key(id)
+);
+
+create index idx_billing_rates_rate_deck_id_cost_type_prefix on billing_rates(rate_deck_id, cost_type, prefix);
+create index idx_billing_rates_create on billing_rates(tm_create);
@@ -0,0 +1,35 @@
+create table billing_statements(
+  id          binary(16),
+  customer_id binary(16),
+  account_id  binary(16),
+  status      varchar(16) default '',
+
+  tm_period_start datetime(6),
+  tm_period_end   datetime(6),
+
+  items             json,
+  top_destinations  json,
+  top_ups           json,
+
+  total_usage_token   bigint default 0,
+  total_usage_credit  bigint default 0,
+  total_top_up_token  bigint default 0,
+  total_top_up_credit bigint default 0,
+
+  balance_token_end   bigint default 0,
+  balance_credit_end  bigint default 0,
+
+  pdf_file_id binary(16),
+  csv_file_id binary(16),
+
+  -- timestamps
+  tm_create datetime(6),
+  tm_update datetime(6),
+  tm_delete datetime(6),
+
+  primary key(id)
+);
+
+create index idx_billing_statements_customer_id on billing_statements(customer_id);
+create unique index ux_billing_statements_account_id_tm_period_start on billing_statements(account_id, tm_period_start);
+create index idx_billing_statements_create on billing_statements(tm_create);
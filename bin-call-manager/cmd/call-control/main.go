@@ -23,6 +23,7 @@ import (
 	"monorepo/bin-common-handler/models/sock"
 	commondatabasehandler "monorepo/bin-common-handler/pkg/databasehandler"
 	"monorepo/bin-common-handler/pkg/notifyhandler"
+	"monorepo/bin-common-handler/pkg/outboxhandler"
 	"monorepo/bin-common-handler/pkg/requesthandler"
 	"monorepo/bin-common-handler/pkg/sockhandler"
 	"monorepo/bin-common-handler/pkg/utilhandler"
@@ -65,13 +66,16 @@ func initCache() (cachehandler.CacheHandler, error) {
 }
 
 func initCallHandler(sqlDB *sql.DB, cache cachehandler.CacheHandler) (callhandler.CallHandler, error) {
-	db := dbhandler.NewHandler(sqlDB, cache)
-	sockHandler := sockhandler.NewSockHandler(sock.TypeRabbitMQ, config.Get().RabbitMQAddress)
+	sockHandler := sockhandler.NewSockHandler(sock.Type(config.Get().SockType), config.Get().RabbitMQAddress)
 	sockHandler.Connect()
 
 	reqHandler := requesthandler.NewRequestHandler(sockHandler, serviceName)
 	notifyHandler := notifyhandler.NewNotifyHandler(sockHandler, reqHandler, commonoutline.QueueNameCallEvent, serviceName)
 
+	// the events stored by the cli are relayed by the call-manager daemon.
+	outboxHandler := outboxhandler.NewOutboxHandler(sqlDB, sockHandler, reqHandler, dbhandler.OutboxTable, commonoutline.QueueNameCallEvent, serviceName)
+	db := dbhandler.NewHandler(sqlDB, cache, outboxHandler)
+
 	channelHandler := channelhandler.NewChannelHandler(reqHandler, notifyHandler, db, cache)
 	bridgeHandler := bridgehandler.NewBridgeHandler(reqHandler, notifyHandler, db)
 	externalMediaHandler := externalmediahandler.NewExternalMediaHandler(reqHandler, notifyHandler, db, channelHandler, bridgeHandler, cache, config.Get().AsteriskWSPort)
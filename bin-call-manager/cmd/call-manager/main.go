@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"net/http"
 	"os"
@@ -19,8 +20,10 @@ import (
 	"github.com/spf13/cobra"
 
 	"monorepo/bin-common-handler/pkg/notifyhandler"
+	"monorepo/bin-common-handler/pkg/outboxhandler"
 	"monorepo/bin-common-handler/pkg/requesthandler"
 	"monorepo/bin-common-handler/pkg/sockhandler"
+	"monorepo/bin-common-handler/pkg/tracehandler"
 	"monorepo/bin-common-handler/pkg/utilhandler"
 
 	"monorepo/bin-call-manager/internal/config"
@@ -73,6 +76,10 @@ func runCommand(cmd *cobra.Command, args []string) error {
 	// init prometheus setting
 	initProm(cfg.PrometheusEndpoint, cfg.PrometheusListenAddress)
 
+	// init tracing
+	tracehandler.Init(common.Servicename)
+	defer tracehandler.Shutdown()
+
 	// connect to database
 	sqlDB, err := commondatabasehandler.Connect(cfg.DatabaseDSN)
 	if err != nil {
@@ -131,16 +138,21 @@ func initProm(endpoint, listen string) {
 func run(sqlDB *sql.DB, cache cachehandler.CacheHandler) error {
 	cfg := config.Get()
 
-	// dbhandler
-	db := dbhandler.NewHandler(sqlDB, cache)
-
 	// rabbitmq sock connect
-	sockHandler := sockhandler.NewSockHandler(sock.TypeRabbitMQ, cfg.RabbitMQAddress)
+	sockHandler := sockhandler.NewSockHandler(sock.Type(cfg.SockType), cfg.RabbitMQAddress)
 	sockHandler.Connect()
 
 	// create handlers
 	reqHandler := requesthandler.NewRequestHandler(sockHandler, common.Servicename)
 	notifyHandler := notifyhandler.NewNotifyHandler(sockHandler, reqHandler, commonoutline.QueueNameCallEvent, common.Servicename)
+
+	// outbox relay
+	outboxHandler := outboxhandler.NewOutboxHandler(sqlDB, sockHandler, reqHandler, dbhandler.OutboxTable, commonoutline.QueueNameCallEvent, common.Servicename)
+	outboxHandler.Run(context.Background())
+
+	// dbhandler
+	db := dbhandler.NewHandler(sqlDB, cache, outboxHandler)
+
 	channelHandler := channelhandler.NewChannelHandler(reqHandler, notifyHandler, db, cache)
 	bridgeHandler := bridgehandler.NewBridgeHandler(reqHandler, notifyHandler, db)
 	externalMediaHandler := externalmediahandler.NewExternalMediaHandler(reqHandler, notifyHandler, db, channelHandler, bridgeHandler, cache, cfg.AsteriskWSPort)
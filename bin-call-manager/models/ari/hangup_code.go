@@ -77,6 +77,7 @@ const (
 	/// VoIPBIN defined cause code.
 	ChannelCauseCallDurationTimeout ChannelCause = 200 // call progress timeout
 	ChannelCauseCallAMD             ChannelCause = 201 // call's amd hangup
+	ChannelCauseCallMaxCost         ChannelCause = 202 // call's max cost reached
 )
 
 // ChannelCauseAll list of all ChannelCauses
@@ -152,4 +153,5 @@ var ChannelCauseAll = []ChannelCause{
 
 	ChannelCauseCallDurationTimeout,
 	ChannelCauseCallAMD,
+	ChannelCauseCallMaxCost,
 }
@@ -59,6 +59,8 @@ type Call struct {
 	DialrouteID uuid.UUID       `json:"dialroute_id,omitempty" db:"dialroute_id,uuid"` // dialroute id(current use)
 	Dialroutes  []rmroute.Route `json:"dialroutes,omitempty" db:"dialroutes,json"`     // list of dialroutes for dialing.
 
+	MaxCost int64 `json:"max_cost,omitempty" db:"max_cost"` // max credit in micros the call can cost. the call is hung up when reached. 0 means no limit.
+
 	TMRinging     *time.Time `json:"tm_ringing,omitempty" db:"tm_ringing"`
 	TMProgressing *time.Time `json:"tm_progressing,omitempty" db:"tm_progressing"`
 	TMHangup      *time.Time `json:"tm_hangup,omitempty" db:"tm_hangup"`
@@ -167,6 +169,7 @@ const (
 	HangupReasonNoanswer HangupReason = "noanswer" // The call rejected with noanswer status.
 	HangupReasonDialout  HangupReason = "dialout"  // The call reached dialing timeout before it was answered. This timeout is fired by our time out(outgoing call).
 	HangupReasonAMD      HangupReason = "amd"      // the call's amd action result hung up the call.
+	HangupReasonMaxCost  HangupReason = "max_cost" // the call reached its max cost after it was answered.
 )
 
 // Test values
@@ -247,6 +250,9 @@ func calculateHangupReasonDirectionIncoming(lastStatus Status, cause ari.Channel
 	// | StatusProgressing    | ChannelCauseCallProgressTimeout | HangupReasonTimeout   |
 	// |                      | *                               | HangupReasonNormal    |
 	// +----------------------+---------------------------------+-----------------------+
+	// | StatusTerminating    | ChannelCauseCallMaxCost         | HangupReasonMaxCost   |
+	// |                      | *                               | HangupReasonNormal    |
+	// +----------------------+---------------------------------+-----------------------+
 	// | *                    | *                               | HangupReasonNormal    |
 	// +----------------------+---------------------------------+-----------------------+
 
@@ -267,6 +273,12 @@ func calculateHangupReasonDirectionIncoming(lastStatus Status, cause ari.Channel
 		}
 		return HangupReasonNormal
 
+	case StatusTerminating:
+		if cause == ari.ChannelCauseCallMaxCost {
+			return HangupReasonMaxCost
+		}
+		return HangupReasonNormal
+
 	default:
 		return HangupReasonNormal
 	}
@@ -292,6 +304,7 @@ func calculateHangupReasonDirectionOutgoing(lastStatus Status, cause ari.Channel
 	// |                      | *                               | HangupReasonNormal    |
 	// +----------------------+---------------------------------+-----------------------+
 	// | StatusTerminating    | ChannelCauseCallAMD             | HangupReasonAMD       |
+	// |                      | ChannelCauseCallMaxCost         | HangupReasonMaxCost   |
 	// |                      | *                               | HangupReasonNormal    |
 	// +----------------------+---------------------------------+-----------------------+
 	// | StatusHangup         | *                               | HangupReasonNormal  |
@@ -328,6 +341,8 @@ func calculateHangupReasonDirectionOutgoing(lastStatus Status, cause ari.Channel
 		switch cause {
 		case ari.ChannelCauseCallAMD:
 			return HangupReasonAMD
+		case ari.ChannelCauseCallMaxCost:
+			return HangupReasonMaxCost
 		default:
 			return HangupReasonNormal
 		}
@@ -483,6 +498,7 @@ func ConvertHangupReasonToChannelCause(reason HangupReason) ari.ChannelCause {
 		HangupReasonNoanswer: ari.ChannelCauseNoAnswer,       // The call rejected with noanswer status.
 		HangupReasonDialout:  ari.ChannelCauseNoAnswer,       // The call reached dialing timeout before it was answered. This timeout is fired by our time out(outgoing call).
 		HangupReasonAMD:      ari.ChannelCauseCallAMD,        // the call's amd action result hung up the call.
+		HangupReasonMaxCost:  ari.ChannelCauseCallMaxCost,    // the call reached its max cost after it was answered.
 	}
 
 	cause, ok := mapCause[reason]
@@ -320,15 +320,40 @@ func Test_calculateHangupReasonDirectionIncoming(t *testing.T) {
 			HangupReasonNormal,
 		},
 		{
-			"StatusCanceling/StatusTerminating/StatusHangup with all",
+			"StatusCanceling/StatusHangup with all",
 
 			[]Status{
 				StatusCanceling,
-				StatusTerminating,
 				StatusHangup,
 			},
 			ari.ChannelCauseAll,
 
+			HangupReasonNormal,
+		},
+		{
+			"StatusTerminating with max cost",
+
+			[]Status{
+				StatusTerminating,
+			},
+			[]ari.ChannelCause{
+				ari.ChannelCauseCallMaxCost,
+			},
+
+			HangupReasonMaxCost,
+		},
+		{
+			"StatusTerminating with others",
+
+			[]Status{
+				StatusTerminating,
+			},
+			[]ari.ChannelCause{
+				ari.ChannelCauseNormalClearing,
+				ari.ChannelCauseCallDurationTimeout,
+				ari.ChannelCauseCallAMD,
+			},
+
 			HangupReasonNormal,
 		},
 	}
@@ -594,6 +619,18 @@ func Test_calculateHangupReasonDirectionOutgoing(t *testing.T) {
 
 			HangupReasonCanceled,
 		},
+		{
+			"StatusTerminating with max cost",
+
+			[]Status{
+				StatusTerminating,
+			},
+			[]ari.ChannelCause{
+				ari.ChannelCauseCallMaxCost,
+			},
+
+			HangupReasonMaxCost,
+		},
 		{
 			"/StatusTerminating with amd",
 
@@ -40,6 +40,8 @@ const (
 	FieldDialrouteID Field = "dialroute_id" // dialroute_id
 	FieldDialroutes  Field = "dialroutes"   // dialroutes
 
+	FieldMaxCost Field = "max_cost" // max_cost
+
 	FieldTMRinging     Field = "tm_ringing"     // tm_ringing
 	FieldTMProgressing Field = "tm_progressing" // tm_progressing
 	FieldTMHangup      Field = "tm_hangup"      // tm_hangup
@@ -43,6 +43,9 @@ type WebhookMessage struct {
 	HangupBy     HangupBy     `json:"hangup_by,omitempty"`
 	HangupReason HangupReason `json:"hangup_reason,omitempty"`
 
+	MaxCost     int64 `json:"max_cost,omitempty"`     // max credit in micros the call can cost. 0 means no limit.
+	RunningCost int64 `json:"running_cost,omitempty"` // credit in micros accrued so far by the progressing call. not stored
+
 	// timestamp
 	TMProgressing *time.Time `json:"tm_progressing,omitempty"`
 	TMRinging     *time.Time `json:"tm_ringing,omitempty"`
@@ -80,6 +83,8 @@ func (h *Call) ConvertWebhookMessage() *WebhookMessage {
 		HangupBy:     h.HangupBy,
 		HangupReason: h.HangupReason,
 
+		MaxCost: h.MaxCost,
+
 		TMRinging:     h.TMRinging,
 		TMProgressing: h.TMProgressing,
 		TMHangup:      h.TMHangup,
@@ -126,6 +126,48 @@ func (h *handler) CallSet(ctx context.Context, call *call.Call) error {
 	return nil
 }
 
+const callMaxCostDeadlineNeverSentinel = "never"
+
+func callMaxCostDeadlineKey(id uuid.UUID) string {
+	return fmt.Sprintf("call:max_cost_deadline:%s", id)
+}
+
+// CallMaxCostDeadlineGet returns the cached time of the call reaching its max cost.
+// Returns (nil, nil) when the call never reaches its max cost.
+// Returns (nil, redis.Nil) when key is absent (cache miss).
+func (h *handler) CallMaxCostDeadlineGet(ctx context.Context, id uuid.UUID) (*time.Time, error) {
+	tmp, err := h.Cache.Get(ctx, callMaxCostDeadlineKey(id)).Result()
+	if err != nil {
+		return nil, err // redis.Nil means cache miss
+	}
+	if tmp == callMaxCostDeadlineNeverSentinel {
+		return nil, nil
+	}
+
+	res, err := time.Parse(time.RFC3339Nano, tmp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// CallMaxCostDeadlineSet caches the time of the call reaching its max cost with a 24-hour TTL.
+// The nil deadline means the call never reaches its max cost.
+func (h *handler) CallMaxCostDeadlineSet(ctx context.Context, id uuid.UUID, deadline *time.Time) error {
+	value := callMaxCostDeadlineNeverSentinel
+	if deadline != nil {
+		value = deadline.UTC().Format(time.RFC3339Nano)
+	}
+
+	return h.Cache.Set(ctx, callMaxCostDeadlineKey(id), value, time.Hour*24).Err()
+}
+
+// CallMaxCostDeadlineDelete removes the cached time of the call reaching its max cost.
+func (h *handler) CallMaxCostDeadlineDelete(ctx context.Context, id uuid.UUID) error {
+	return h.Cache.Del(ctx, callMaxCostDeadlineKey(id)).Err()
+}
+
 // ConfbridgeGet returns confbridge info
 func (h *handler) ConfbridgeGet(ctx context.Context, id uuid.UUID) (*confbridge.Confbridge, error) {
 	key := fmt.Sprintf("confbridge:%s", id)
@@ -4,6 +4,7 @@ package cachehandler
 
 import (
 	"context"
+	"time"
 
 	"github.com/go-redis/redis/v8"
 	"github.com/gofrs/uuid"
@@ -46,6 +47,14 @@ type CacheHandler interface {
 
 	CallGet(ctx context.Context, id uuid.UUID) (*call.Call, error)
 	CallSet(ctx context.Context, call *call.Call) error
+	// CallMaxCostDeadlineGet returns the cached time of the call reaching its max cost.
+	// Returns (nil, nil) when the call never reaches its max cost.
+	// Returns (nil, redis.Nil) when key is absent (cache miss).
+	CallMaxCostDeadlineGet(ctx context.Context, id uuid.UUID) (*time.Time, error)
+	// CallMaxCostDeadlineSet caches the time of the call reaching its max cost with a 24-hour TTL.
+	CallMaxCostDeadlineSet(ctx context.Context, id uuid.UUID, deadline *time.Time) error
+	// CallMaxCostDeadlineDelete removes the cached time of the call reaching its max cost.
+	CallMaxCostDeadlineDelete(ctx context.Context, id uuid.UUID) error
 
 	ChannelGet(ctx context.Context, id string) (*channel.Channel, error)
 	ChannelSet(ctx context.Context, channel *channel.Channel) error
@@ -21,6 +21,7 @@ import (
 	outboundconfig "monorepo/bin-call-manager/models/outboundconfig"
 	recording "monorepo/bin-call-manager/models/recording"
 	reflect "reflect"
+	time "time"
 
 	uuid "github.com/gofrs/uuid"
 	gomock "go.uber.org/mock/gomock"
@@ -152,6 +153,49 @@ func (mr *MockCacheHandlerMockRecorder) CallSet(ctx, call any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CallSet", reflect.TypeOf((*MockCacheHandler)(nil).CallSet), ctx, call)
 }
 
+// CallMaxCostDeadlineDelete mocks base method.
+func (m *MockCacheHandler) CallMaxCostDeadlineDelete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CallMaxCostDeadlineDelete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CallMaxCostDeadlineDelete indicates an expected call of CallMaxCostDeadlineDelete.
+func (mr *MockCacheHandlerMockRecorder) CallMaxCostDeadlineDelete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CallMaxCostDeadlineDelete", reflect.TypeOf((*MockCacheHandler)(nil).CallMaxCostDeadlineDelete), ctx, id)
+}
+
+// CallMaxCostDeadlineGet mocks base method.
+func (m *MockCacheHandler) CallMaxCostDeadlineGet(ctx context.Context, id uuid.UUID) (*time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CallMaxCostDeadlineGet", ctx, id)
+	ret0, _ := ret[0].(*time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CallMaxCostDeadlineGet indicates an expected call of CallMaxCostDeadlineGet.
+func (mr *MockCacheHandlerMockRecorder) CallMaxCostDeadlineGet(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CallMaxCostDeadlineGet", reflect.TypeOf((*MockCacheHandler)(nil).CallMaxCostDeadlineGet), ctx, id)
+}
+
+// CallMaxCostDeadlineSet mocks base method.
+func (m *MockCacheHandler) CallMaxCostDeadlineSet(ctx context.Context, id uuid.UUID, deadline *time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CallMaxCostDeadlineSet", ctx, id, deadline)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CallMaxCostDeadlineSet indicates an expected call of CallMaxCostDeadlineSet.
+func (mr *MockCacheHandlerMockRecorder) CallMaxCostDeadlineSet(ctx, id, deadline any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CallMaxCostDeadlineSet", reflect.TypeOf((*MockCacheHandler)(nil).CallMaxCostDeadlineSet), ctx, id, deadline)
+}
+
 // ChannelGet mocks base method.
 func (m *MockCacheHandler) ChannelGet(ctx context.Context, id string) (*channel.Channel, error) {
 	m.ctrl.T.Helper()
@@ -470,7 +470,6 @@ func TestARIChannelDestroyedContextTypeCall(t *testing.T) {
 			mockBridge.EXPECT().Destroy(ctx, tt.responseCall.BridgeID).Return(nil)
 			mockDB.EXPECT().CallSetHangup(ctx, tt.responseCall.ID, call.HangupReasonNormal, call.HangupByRemote).Return(nil)
 			mockDB.EXPECT().CallGet(ctx, tt.responseCall.ID).Return(tt.responseCall, nil)
-			mockNotify.EXPECT().PublishWebhookEvent(gomock.Any(), tt.responseCall.CustomerID, call.EventTypeCallHangup, tt.responseCall)
 			mockReq.EXPECT().FlowV1ActiveflowStop(ctx, tt.responseCall.ActiveflowID).Return(&fmactiveflow.Activeflow{}, nil)
 
 			if err := h.ARIChannelDestroyed(ctx, tt.channel); err != nil {
@@ -429,6 +429,37 @@ func (h *callHandler) UpdateConfbridgeID(ctx context.Context, id uuid.UUID, conf
 	return res, nil
 }
 
+// UpdateMaxCost updates the call's max cost.
+// The max cost is checked by the call's health check.
+func (h *callHandler) UpdateMaxCost(ctx context.Context, id uuid.UUID, maxCost int64) (*call.Call, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":     "UpdateMaxCost",
+		"call_id":  id,
+		"max_cost": maxCost,
+	})
+
+	if errSet := h.db.CallSetMaxCost(ctx, id, maxCost); errSet != nil {
+		log.Errorf("Could not set the max cost. err: %v", errSet)
+		return nil, errSet
+	}
+
+	// the cached deadline was calculated with the previous max cost.
+	if errDelete := h.db.CallMaxCostDeadlineDelete(ctx, id); errDelete != nil {
+		log.Errorf("Could not delete the call's max cost deadline. err: %v", errDelete)
+		return nil, errDelete
+	}
+
+	// get updated call
+	res, err := h.db.CallGet(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get updated call. err: %v", err)
+		return nil, err
+	}
+	h.notifyHandler.PublishWebhookEvent(ctx, res.CustomerID, call.EventTypeCallUpdated, res)
+
+	return res, nil
+}
+
 // UpdateHangupInfo updates call's the hangup info
 func (h *callHandler) UpdateHangupInfo(ctx context.Context, id uuid.UUID, reason call.HangupReason, hangupBy call.HangupBy) (*call.Call, error) {
 	log := logrus.WithFields(logrus.Fields{
@@ -449,7 +480,7 @@ func (h *callHandler) UpdateHangupInfo(ctx context.Context, id uuid.UUID, reason
 		log.Errorf("Could not get hungup call data. call: %s, err: %v", id, err)
 		return nil, err
 	}
-	h.notifyHandler.PublishWebhookEvent(ctx, res.CustomerID, call.EventTypeCallHangup, res)
+	// the call_hungup event was stored in the outbox together with the hangup. the outbox relay publishes it.
 	promCallHangupTotal.WithLabelValues(string(res.Direction), string(res.Type), string(reason)).Inc()
 
 	// track call duration
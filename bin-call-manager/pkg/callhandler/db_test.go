@@ -614,6 +614,76 @@ func Test_UpdateRecordingID(t *testing.T) {
 	}
 }
 
+func Test_UpdateMaxCost(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		id      uuid.UUID
+		maxCost int64
+
+		responseCall *call.Call
+
+		expectRes *call.Call
+	}{
+		{
+			"normal",
+
+			uuid.FromStringOrNil("c2e4f0a6-b2e1-11f0-9d7e-2b6f3c1a8e45"),
+			3000000,
+
+			&call.Call{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("c2e4f0a6-b2e1-11f0-9d7e-2b6f3c1a8e45"),
+					CustomerID: uuid.FromStringOrNil("c31a6d0e-b2e1-11f0-a4d1-8f5e2c7b9a01"),
+				},
+				MaxCost: 3000000,
+			},
+
+			&call.Call{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("c2e4f0a6-b2e1-11f0-9d7e-2b6f3c1a8e45"),
+					CustomerID: uuid.FromStringOrNil("c31a6d0e-b2e1-11f0-a4d1-8f5e2c7b9a01"),
+				},
+				MaxCost: 3000000,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+
+			h := &callHandler{
+				reqHandler:    mockReq,
+				db:            mockDB,
+				notifyHandler: mockNotify,
+			}
+
+			ctx := context.Background()
+
+			mockDB.EXPECT().CallSetMaxCost(ctx, tt.id, tt.maxCost).Return(nil)
+			mockDB.EXPECT().CallMaxCostDeadlineDelete(ctx, tt.id).Return(nil)
+			mockDB.EXPECT().CallGet(ctx, tt.id).Return(tt.responseCall, nil)
+			mockNotify.EXPECT().PublishWebhookEvent(ctx, tt.responseCall.CustomerID, call.EventTypeCallUpdated, tt.responseCall)
+
+			res, err := h.UpdateMaxCost(ctx, tt.id, tt.maxCost)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
 func Test_AddExternalMediaID(t *testing.T) {
 
 	tests := []struct {
@@ -793,7 +863,6 @@ func Test_UpdateHangup(t *testing.T) {
 
 			mockDB.EXPECT().CallSetHangup(ctx, tt.id, tt.reason, tt.hangupBy).Return(nil)
 			mockDB.EXPECT().CallGet(ctx, tt.id).Return(tt.responseCall, nil)
-			mockNotify.EXPECT().PublishWebhookEvent(ctx, tt.responseCall.CustomerID, call.EventTypeCallHangup, tt.responseCall)
 
 			_, err := h.UpdateHangupInfo(ctx, tt.id, tt.reason, tt.hangupBy)
 			if err != nil {
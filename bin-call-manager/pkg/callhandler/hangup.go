@@ -216,6 +216,7 @@ func (h *callHandler) isRetryable(ctx context.Context, c *call.Call, cn *channel
 
 		ari.ChannelCauseCallDurationTimeout,
 		ari.ChannelCauseCallAMD,
+		ari.ChannelCauseCallMaxCost,
 	}
 	for _, code := range notRetryableCodes {
 		if code == cn.HangupCause {
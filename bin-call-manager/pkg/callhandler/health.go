@@ -2,10 +2,13 @@ package callhandler
 
 import (
 	"context"
+	"math"
+	"time"
 
 	"monorepo/bin-call-manager/models/call"
 
 	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
 
@@ -58,9 +61,77 @@ func (h *callHandler) HealthCheck(ctx context.Context, id uuid.UUID, retryCount
 		retryCount = 0
 	}
 
+	delay := defaultHealthDelay
+	if c.MaxCost > 0 && c.Status == call.StatusProgressing {
+		remain, errRemain := h.getMaxCostRemain(ctx, c)
+		if errRemain != nil {
+			// the billing could not be started yet. check it again on the next health check.
+			log.Errorf("Could not get the call's remaining time of the max cost. err: %v", errRemain)
+		} else if remain == 0 {
+			log.Infof("The call has reached its max cost. Hanging up the call. call_id: %s, max_cost: %d", id, c.MaxCost)
+			_, _ = h.HangingUp(ctx, id, call.HangupReasonMaxCost)
+			return
+		} else if remain > 0 && remain*1000 < delay {
+			// check it again at the time of reaching the max cost
+			delay = remain * 1000
+		}
+	}
+
 	// send health check.
-	if errHealth := h.reqHandler.CallV1CallHealth(ctx, id, defaultHealthDelay, retryCount); errHealth != nil {
+	if errHealth := h.reqHandler.CallV1CallHealth(ctx, id, delay, retryCount); errHealth != nil {
 		log.Errorf("Could not send the call health check request. err: %v", errHealth)
 		return
 	}
 }
+
+// getMaxCostRemain returns the seconds left until the call reaches its max cost.
+// It returns 0 if the call has reached its max cost, and -1 if the call never reaches it.
+// The call's rate does not change during the call, so the time of reaching the max cost
+// is cached and the billing is asked only once instead of on every health check.
+// The cached time is deleted when the call's max cost is updated.
+func (h *callHandler) getMaxCostRemain(ctx context.Context, c *call.Call) (int, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":    "getMaxCostRemain",
+		"call_id": c.ID,
+	})
+
+	deadline, err := h.db.CallMaxCostDeadlineGet(ctx, c.ID)
+	if err != nil {
+		deadline, err = h.getMaxCostDeadline(ctx, c)
+		if err != nil {
+			return 0, err
+		}
+
+		if errSet := h.db.CallMaxCostDeadlineSet(ctx, c.ID, deadline); errSet != nil {
+			log.Errorf("Could not cache the call's max cost deadline. err: %v", errSet)
+		}
+	}
+
+	if deadline == nil {
+		return -1, nil
+	}
+
+	remain := deadline.Sub(*h.utilHandler.TimeNow())
+	if remain <= 0 {
+		return 0, nil
+	}
+
+	return int(math.Ceil(remain.Seconds())), nil
+}
+
+// getMaxCostDeadline returns the time of the call reaching its max cost from the call's cost estimate.
+// It returns nil if the call never reaches its max cost.
+func (h *callHandler) getMaxCostDeadline(ctx context.Context, c *call.Call) (*time.Time, error) {
+	e, err := h.reqHandler.BillingV1EstimateGetByReferenceID(ctx, c.ID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get the call's cost estimate")
+	}
+
+	maxDuration := e.GetCostInfo().CalculateMaxDuration(c.MaxCost)
+	if maxDuration < 0 {
+		return nil, nil
+	}
+
+	res := h.utilHandler.TimeNow().Add(time.Duration(max(maxDuration-e.Duration, 0)) * time.Second)
+	return &res, nil
+}
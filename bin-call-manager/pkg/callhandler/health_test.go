@@ -4,7 +4,12 @@ import (
 	"monorepo/bin-call-manager/pkg/testhelper"
 	"context"
 	"testing"
+	"time"
 
+	bmbilling "monorepo/bin-billing-manager/models/billing"
+	bmestimate "monorepo/bin-billing-manager/models/estimate"
+
+	"monorepo/bin-call-manager/models/ari"
 	"monorepo/bin-call-manager/models/call"
 	"monorepo/bin-call-manager/models/channel"
 	"monorepo/bin-call-manager/pkg/channelhandler"
@@ -15,6 +20,7 @@ import (
 	"monorepo/bin-common-handler/pkg/requesthandler"
 	"monorepo/bin-common-handler/pkg/utilhandler"
 
+	"github.com/go-redis/redis/v8"
 	"github.com/gofrs/uuid"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -133,3 +139,303 @@ func Test_HealthCheck(t *testing.T) {
 		})
 	}
 }
+
+func Test_HealthCheck_maxCost(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		id uuid.UUID
+
+		responseCall     *call.Call
+		responseChannel  *channel.Channel
+		responseCurTime     *time.Time
+		responseDeadline    *time.Time
+		responseDeadlineErr error
+		responseEstimate    *bmestimate.Estimate
+
+		expectDeadline *time.Time
+		expectHangup   bool
+		expectDelay    int
+	}{
+		{
+			name: "max cost not reached",
+
+			id: uuid.FromStringOrNil("5e8a1c3b-b2dc-11f0-9c4d-1a2b3c4d5e6f"),
+
+			responseCall: &call.Call{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("5e8a1c3b-b2dc-11f0-9c4d-1a2b3c4d5e6f"),
+				},
+				ChannelID: "5eb2d4f6-b2dc-11f0-8d5e-2b3c4d5e6f7a",
+				Status:    call.StatusProgressing,
+				MaxCost:   30000,
+			},
+			responseChannel: &channel.Channel{
+				ID: "5eb2d4f6-b2dc-11f0-8d5e-2b3c4d5e6f7a",
+			},
+			responseCurTime:     testhelper.TimePtr("2023-01-18T03:22:18.995000Z"),
+			responseDeadlineErr: redis.Nil,
+			responseEstimate: &bmestimate.Estimate{
+				CostType:          bmbilling.CostTypeCallPSTNOutgoing,
+				RateCreditPerUnit: 10000,
+				Duration:          100,
+			},
+
+			expectDeadline: testhelper.TimePtr("2023-01-18T03:23:38.995000Z"),
+
+			expectDelay: defaultHealthDelay,
+		},
+		{
+			name: "max cost reached in a few seconds",
+
+			id: uuid.FromStringOrNil("5e8a1c3b-b2dc-11f0-9c4d-1a2b3c4d5e6f"),
+
+			responseCall: &call.Call{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("5e8a1c3b-b2dc-11f0-9c4d-1a2b3c4d5e6f"),
+				},
+				ChannelID: "5eb2d4f6-b2dc-11f0-8d5e-2b3c4d5e6f7a",
+				Status:    call.StatusProgressing,
+				MaxCost:   30000,
+			},
+			responseChannel: &channel.Channel{
+				ID: "5eb2d4f6-b2dc-11f0-8d5e-2b3c4d5e6f7a",
+			},
+			responseCurTime:     testhelper.TimePtr("2023-01-18T03:22:18.995000Z"),
+			responseDeadlineErr: redis.Nil,
+			responseEstimate: &bmestimate.Estimate{
+				CostType:          bmbilling.CostTypeCallPSTNOutgoing,
+				RateCreditPerUnit: 10000,
+				Duration:          177,
+			},
+
+			expectDeadline: testhelper.TimePtr("2023-01-18T03:22:21.995000Z"),
+
+			expectDelay: 3000,
+		},
+		{
+			name: "max cost reached",
+
+			id: uuid.FromStringOrNil("5e8a1c3b-b2dc-11f0-9c4d-1a2b3c4d5e6f"),
+
+			responseCall: &call.Call{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("5e8a1c3b-b2dc-11f0-9c4d-1a2b3c4d5e6f"),
+				},
+				ChannelID: "5eb2d4f6-b2dc-11f0-8d5e-2b3c4d5e6f7a",
+				Status:    call.StatusProgressing,
+				MaxCost:   30000,
+			},
+			responseChannel: &channel.Channel{
+				ID: "5eb2d4f6-b2dc-11f0-8d5e-2b3c4d5e6f7a",
+			},
+			responseCurTime:     testhelper.TimePtr("2023-01-18T03:22:18.995000Z"),
+			responseDeadlineErr: redis.Nil,
+			responseEstimate: &bmestimate.Estimate{
+				CostType:          bmbilling.CostTypeCallPSTNOutgoing,
+				RateCreditPerUnit: 10000,
+				Duration:          180,
+			},
+
+			expectDeadline: testhelper.TimePtr("2023-01-18T03:22:18.995000Z"),
+
+			expectHangup: true,
+		},
+		{
+			name: "max cost deadline cached",
+
+			id: uuid.FromStringOrNil("5e8a1c3b-b2dc-11f0-9c4d-1a2b3c4d5e6f"),
+
+			responseCall: &call.Call{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("5e8a1c3b-b2dc-11f0-9c4d-1a2b3c4d5e6f"),
+				},
+				ChannelID: "5eb2d4f6-b2dc-11f0-8d5e-2b3c4d5e6f7a",
+				Status:    call.StatusProgressing,
+				MaxCost:   30000,
+			},
+			responseChannel: &channel.Channel{
+				ID: "5eb2d4f6-b2dc-11f0-8d5e-2b3c4d5e6f7a",
+			},
+			responseCurTime:  testhelper.TimePtr("2023-01-18T03:22:18.995000Z"),
+			responseDeadline: testhelper.TimePtr("2023-01-18T03:22:23.500000Z"),
+
+			expectDelay: 5000,
+		},
+		{
+			name: "max cost never reached cached",
+
+			id: uuid.FromStringOrNil("5e8a1c3b-b2dc-11f0-9c4d-1a2b3c4d5e6f"),
+
+			responseCall: &call.Call{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("5e8a1c3b-b2dc-11f0-9c4d-1a2b3c4d5e6f"),
+				},
+				ChannelID: "5eb2d4f6-b2dc-11f0-8d5e-2b3c4d5e6f7a",
+				Status:    call.StatusProgressing,
+				MaxCost:   30000,
+			},
+			responseChannel: &channel.Channel{
+				ID: "5eb2d4f6-b2dc-11f0-8d5e-2b3c4d5e6f7a",
+			},
+			responseCurTime: testhelper.TimePtr("2023-01-18T03:22:18.995000Z"),
+
+			expectDelay: defaultHealthDelay,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+			mockChannel := channelhandler.NewMockChannelHandler(mc)
+
+			h := &callHandler{
+				utilHandler:    mockUtil,
+				reqHandler:     mockReq,
+				db:             mockDB,
+				notifyHandler:  mockNotify,
+				channelHandler: mockChannel,
+			}
+			ctx := context.Background()
+
+			mockDB.EXPECT().CallGet(ctx, tt.id).Return(tt.responseCall, nil)
+			mockChannel.EXPECT().Get(ctx, tt.responseCall.ChannelID).Return(tt.responseChannel, nil)
+			mockUtil.EXPECT().TimeNow().Return(tt.responseCurTime).AnyTimes()
+			mockDB.EXPECT().CallMaxCostDeadlineGet(ctx, tt.id).Return(tt.responseDeadline, tt.responseDeadlineErr)
+			if tt.responseDeadlineErr != nil {
+				mockReq.EXPECT().BillingV1EstimateGetByReferenceID(ctx, tt.id).Return(tt.responseEstimate, nil)
+				mockDB.EXPECT().CallMaxCostDeadlineSet(ctx, tt.id, tt.expectDeadline).Return(nil)
+			}
+
+			if tt.expectHangup {
+				mockDB.EXPECT().CallGet(ctx, tt.id).Return(tt.responseCall, nil)
+				mockDB.EXPECT().CallSetStatus(ctx, tt.id, call.StatusTerminating).Return(nil)
+				mockDB.EXPECT().CallGet(ctx, tt.id).Return(tt.responseCall, nil)
+				mockNotify.EXPECT().PublishWebhookEvent(ctx, tt.responseCall.CustomerID, gomock.Any(), tt.responseCall)
+				mockChannel.EXPECT().HangingUp(ctx, tt.responseCall.ChannelID, ari.ChannelCauseCallMaxCost).Return(tt.responseChannel, nil)
+			} else {
+				mockReq.EXPECT().CallV1CallHealth(ctx, tt.id, tt.expectDelay, 0).Return(nil)
+			}
+
+			h.HealthCheck(ctx, tt.id, 0)
+		})
+	}
+}
+
+func Test_HealthCheck_maxCostUpdated(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		id         uuid.UUID
+		maxCost    int64
+		newMaxCost int64
+
+		responseCall     *call.Call
+		responseChannel  *channel.Channel
+		responseCurTime  *time.Time
+		responseEstimate *bmestimate.Estimate
+
+		expectDeadline    *time.Time
+		expectNewDeadline *time.Time
+	}{
+		{
+			name: "max cost lowered under the call's cost",
+
+			id:         uuid.FromStringOrNil("7c1d2e3f-b2dc-11f0-8a4b-3c4d5e6f7a8b"),
+			maxCost:    30000,
+			newMaxCost: 10000,
+
+			responseCall: &call.Call{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("7c1d2e3f-b2dc-11f0-8a4b-3c4d5e6f7a8b"),
+					CustomerID: uuid.FromStringOrNil("7c4a5b6c-b2dc-11f0-9b5c-4d5e6f7a8b9c"),
+				},
+				ChannelID: "7c78a9b0-b2dc-11f0-ac6d-5e6f7a8b9c0d",
+				Status:    call.StatusProgressing,
+			},
+			responseChannel: &channel.Channel{
+				ID: "7c78a9b0-b2dc-11f0-ac6d-5e6f7a8b9c0d",
+			},
+			responseCurTime: testhelper.TimePtr("2023-01-18T03:22:18.995000Z"),
+			responseEstimate: &bmestimate.Estimate{
+				CostType:          bmbilling.CostTypeCallPSTNOutgoing,
+				RateCreditPerUnit: 10000,
+				Duration:          100,
+			},
+
+			expectDeadline:    testhelper.TimePtr("2023-01-18T03:23:38.995000Z"),
+			expectNewDeadline: testhelper.TimePtr("2023-01-18T03:22:18.995000Z"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+			mockChannel := channelhandler.NewMockChannelHandler(mc)
+
+			h := &callHandler{
+				utilHandler:    mockUtil,
+				reqHandler:     mockReq,
+				db:             mockDB,
+				notifyHandler:  mockNotify,
+				channelHandler: mockChannel,
+			}
+			ctx := context.Background()
+
+			oldCall := *tt.responseCall
+			oldCall.MaxCost = tt.maxCost
+			newCall := *tt.responseCall
+			newCall.MaxCost = tt.newMaxCost
+
+			mockUtil.EXPECT().TimeNow().Return(tt.responseCurTime).AnyTimes()
+			mockChannel.EXPECT().Get(ctx, tt.responseCall.ChannelID).Return(tt.responseChannel, nil).AnyTimes()
+			mockReq.EXPECT().BillingV1EstimateGetByReferenceID(ctx, tt.id).Return(tt.responseEstimate, nil).AnyTimes()
+
+			gomock.InOrder(
+				// the deadline of the initial max cost is calculated and cached
+				mockDB.EXPECT().CallGet(ctx, tt.id).Return(&oldCall, nil),
+				mockDB.EXPECT().CallMaxCostDeadlineGet(ctx, tt.id).Return(nil, redis.Nil),
+				mockDB.EXPECT().CallMaxCostDeadlineSet(ctx, tt.id, tt.expectDeadline).Return(nil),
+				mockReq.EXPECT().CallV1CallHealth(ctx, tt.id, defaultHealthDelay, 0).Return(nil),
+
+				// the max cost update drops the cached deadline
+				mockDB.EXPECT().CallSetMaxCost(ctx, tt.id, tt.newMaxCost).Return(nil),
+				mockDB.EXPECT().CallMaxCostDeadlineDelete(ctx, tt.id).Return(nil),
+				mockDB.EXPECT().CallGet(ctx, tt.id).Return(&newCall, nil),
+				mockNotify.EXPECT().PublishWebhookEvent(ctx, newCall.CustomerID, call.EventTypeCallUpdated, &newCall),
+
+				// the next health check calculates the deadline of the new max cost and hangs up
+				mockDB.EXPECT().CallGet(ctx, tt.id).Return(&newCall, nil),
+				mockDB.EXPECT().CallMaxCostDeadlineGet(ctx, tt.id).Return(nil, redis.Nil),
+				mockDB.EXPECT().CallMaxCostDeadlineSet(ctx, tt.id, tt.expectNewDeadline).Return(nil),
+				mockDB.EXPECT().CallGet(ctx, tt.id).Return(&newCall, nil),
+				mockDB.EXPECT().CallSetStatus(ctx, tt.id, call.StatusTerminating).Return(nil),
+				mockDB.EXPECT().CallGet(ctx, tt.id).Return(&newCall, nil),
+				mockNotify.EXPECT().PublishWebhookEvent(ctx, newCall.CustomerID, gomock.Any(), &newCall),
+				mockChannel.EXPECT().HangingUp(ctx, tt.responseCall.ChannelID, ari.ChannelCauseCallMaxCost).Return(tt.responseChannel, nil),
+			)
+
+			h.HealthCheck(ctx, tt.id, 0)
+
+			if _, err := h.UpdateMaxCost(ctx, tt.id, tt.newMaxCost); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			h.HealthCheck(ctx, tt.id, 0)
+		})
+	}
+}
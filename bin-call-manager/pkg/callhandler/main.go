@@ -59,6 +59,7 @@ type CallHandler interface {
 	UpdateStatus(ctx context.Context, id uuid.UUID, status call.Status) (*call.Call, error)
 	UpdateRecordingID(ctx context.Context, id uuid.UUID, recordingID uuid.UUID) (*call.Call, error)
 	UpdateConfbridgeID(ctx context.Context, id uuid.UUID, confbridgeID uuid.UUID) (*call.Call, error)
+	UpdateMaxCost(ctx context.Context, id uuid.UUID, maxCost int64) (*call.Call, error)
 
 	CreateCallsOutgoing(
 		ctx context.Context,
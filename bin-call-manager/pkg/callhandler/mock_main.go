@@ -670,6 +670,21 @@ func (mr *MockCallHandlerMockRecorder) UpdateConfbridgeID(ctx, id, confbridgeID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateConfbridgeID", reflect.TypeOf((*MockCallHandler)(nil).UpdateConfbridgeID), ctx, id, confbridgeID)
 }
 
+// UpdateMaxCost mocks base method.
+func (m *MockCallHandler) UpdateMaxCost(ctx context.Context, id uuid.UUID, maxCost int64) (*call.Call, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMaxCost", ctx, id, maxCost)
+	ret0, _ := ret[0].(*call.Call)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMaxCost indicates an expected call of UpdateMaxCost.
+func (mr *MockCallHandlerMockRecorder) UpdateMaxCost(ctx, id, maxCost any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMaxCost", reflect.TypeOf((*MockCallHandler)(nil).UpdateMaxCost), ctx, id, maxCost)
+}
+
 // UpdateRecordingID mocks base method.
 func (m *MockCallHandler) UpdateRecordingID(ctx context.Context, id, recordingID uuid.UUID) (*call.Call, error) {
 	m.ctrl.T.Helper()
@@ -15,6 +15,8 @@ import (
 
 	nmnumber "monorepo/bin-number-manager/models/number"
 
+	omsuppressionblock "monorepo/bin-outdial-manager/models/suppressionblock"
+
 	rmroute "monorepo/bin-route-manager/models/route"
 
 	amagent "monorepo/bin-agent-manager/models/agent"
@@ -72,7 +74,20 @@ func (h *callHandler) CreateCallsOutgoing(
 	for _, destination := range destinations {
 		switch {
 		case destination.Type == commonaddress.TypeSIP || destination.Type == commonaddress.TypeTel:
-			c, err := h.CreateCallOutgoing(ctx, uuid.Nil, customerID, flowID, uuid.Nil, masterCallID, uuid.Nil, source, destination, earlyExecution, connect, anonymous, metadata, variables)
+			// the call id is given before the call is created, so the blocked attempt refers to it.
+			callID := h.utilHandler.UUIDCreate()
+			suppressed, err := h.isSuppressedDestination(ctx, customerID, &destination, callID)
+			if err != nil {
+				// the campaign calls don't come here. the campaign-manager checks their
+				// targets itself and stops on a failed check. the other calls are made
+				// by the customer directly, so a failed check does not block them.
+				log.WithField("destination", destination).Errorf("Could not check the destination's suppression. Creating the call anyway. destination_target: %s, err: %v", destination.Target, err)
+			} else if suppressed {
+				log.WithField("destination", destination).Infof("The destination is in the suppression lists. Skipping the destination. destination_target: %s", destination.Target)
+				continue
+			}
+
+			c, err := h.CreateCallOutgoing(ctx, callID, customerID, flowID, uuid.Nil, masterCallID, uuid.Nil, source, destination, earlyExecution, connect, anonymous, metadata, variables)
 			if err != nil {
 				log.WithField("destination", destination).Errorf("Could not create an outgoing call. destination_type: %s, err: %v", destination.Type, err)
 				continue
@@ -103,6 +118,22 @@ func (h *callHandler) CreateCallsOutgoing(
 	return resCalls, resGroupcalls, nil
 }
 
+// isSuppressedDestination returns true if the given destination is in the customer's suppression lists.
+// Only the tel type destination is subject to the suppression.
+// A blocked attempt is recorded with the given call id.
+func (h *callHandler) isSuppressedDestination(ctx context.Context, customerID uuid.UUID, destination *commonaddress.Address, callID uuid.UUID) (bool, error) {
+	if destination.Type != commonaddress.TypeTel {
+		return false, nil
+	}
+
+	res, err := h.reqHandler.OutdialV1SuppressionIsSuppressed(ctx, customerID, destination.Target, omsuppressionblock.ReferenceTypeCall, callID)
+	if err != nil {
+		return false, errors.Wrapf(err, "could not check the destination's suppression")
+	}
+
+	return res, nil
+}
+
 // CreateCallOutgoing creates a call for outgoing
 func (h *callHandler) CreateCallOutgoing(
 	ctx context.Context,
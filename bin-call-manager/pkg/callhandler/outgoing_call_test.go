@@ -21,6 +21,8 @@ import (
 
 	nmnumber "monorepo/bin-number-manager/models/number"
 
+	omsuppressionblock "monorepo/bin-outdial-manager/models/suppressionblock"
+
 	rmprovider "monorepo/bin-route-manager/models/provider"
 	rmroute "monorepo/bin-route-manager/models/route"
 
@@ -1147,6 +1149,108 @@ func Test_CreateCallOutgoing_TypeSIP_OutboundConfigFetchError_FailClosed(t *test
 	}
 }
 
+func Test_isSuppressedDestination(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		customerID   uuid.UUID
+		destination  *commonaddress.Address
+		callID       uuid.UUID
+
+		responseSuppressed bool
+		responseErr        error
+
+		expectCheck bool
+		expectRes   bool
+		expectErr   bool
+	}{
+		{
+			name: "tel destination not suppressed",
+
+			customerID: uuid.FromStringOrNil("7c1e2a3e-3c23-11f1-9d2b-4a6e8c1f3a01"),
+			destination: &commonaddress.Address{
+				Type:   commonaddress.TypeTel,
+				Target: "+821100000001",
+			},
+			callID:      uuid.FromStringOrNil("7c4b5d6a-3c23-11f1-8a1c-2d7f9e3b4b02"),
+
+			responseSuppressed: false,
+
+			expectCheck: true,
+			expectRes:   false,
+		},
+		{
+			name: "tel destination suppressed",
+
+			customerID: uuid.FromStringOrNil("7c1e2a3e-3c23-11f1-9d2b-4a6e8c1f3a01"),
+			destination: &commonaddress.Address{
+				Type:   commonaddress.TypeTel,
+				Target: "+821100000001",
+			},
+			callID:      uuid.FromStringOrNil("7c7e1f20-b0d2-11f0-9e41-2b5c8d1a7f03"),
+
+			responseSuppressed: true,
+
+			expectCheck: true,
+			expectRes:   true,
+		},
+		{
+			name: "check failed",
+
+			customerID: uuid.FromStringOrNil("7c1e2a3e-3c23-11f1-9d2b-4a6e8c1f3a01"),
+			destination: &commonaddress.Address{
+				Type:   commonaddress.TypeTel,
+				Target: "+821100000001",
+			},
+			callID:      uuid.FromStringOrNil("7cb0a4e6-b0d2-11f0-8c2d-6f1e3a9b5d04"),
+
+			responseErr: fmt.Errorf("error"),
+
+			expectCheck: true,
+			expectRes:   false,
+			expectErr:   true,
+		},
+		{
+			name: "sip destination is not subject to the suppression",
+
+			customerID: uuid.FromStringOrNil("7c1e2a3e-3c23-11f1-9d2b-4a6e8c1f3a01"),
+			destination: &commonaddress.Address{
+				Type:   commonaddress.TypeSIP,
+				Target: "test@voipbin.net",
+			},
+
+			expectCheck: false,
+			expectRes:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			h := &callHandler{
+				reqHandler: mockReq,
+			}
+			ctx := context.Background()
+
+			if tt.expectCheck {
+				mockReq.EXPECT().OutdialV1SuppressionIsSuppressed(ctx, tt.customerID, tt.destination.Target, omsuppressionblock.ReferenceTypeCall, tt.callID).Return(tt.responseSuppressed, tt.responseErr)
+			}
+
+			res, err := h.isSuppressedDestination(ctx, tt.customerID, tt.destination, tt.callID)
+			if (err != nil) != tt.expectErr {
+				t.Errorf("Wrong match. expect error: %v, got: %v", tt.expectErr, err)
+			}
+			if res != tt.expectRes {
+				t.Errorf("Wrong match. expect: %v, got: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
 func Test_createCallsOutgoingGroupcall_endpoint(t *testing.T) {
 
 	tests := []struct {
@@ -281,16 +281,85 @@ func (h *handler) CallSetStatus(ctx context.Context, id uuid.UUID, status call.S
 	})
 }
 
-// CallSetHangup sets the call status to hangup
+// CallSetHangup sets the call status to hangup.
+// The call_hungup event is stored in the outbox within the same transaction, so the
+// hangup and its event are committed together. The outbox relay publishes the event.
 func (h *handler) CallSetHangup(ctx context.Context, id uuid.UUID, reason call.HangupReason, hangupBy call.HangupBy) error {
 	ts := h.utilHandler.TimeNow()
-	return h.CallUpdate(ctx, id, map[call.Field]any{
+	fields, err := commondatabasehandler.PrepareFields(map[call.Field]any{
 		call.FieldStatus:       call.StatusHangup,
 		call.FieldHangupBy:     hangupBy,
 		call.FieldHangupReason: reason,
 		call.FieldTMHangup:     ts,
 		call.FieldTMUpdate:     ts,
 	})
+	if err != nil {
+		return fmt.Errorf("could not prepare fields. CallSetHangup. err: %v", err)
+	}
+
+	query, args, err := squirrel.
+		Update(callTable).
+		SetMap(fields).
+		Where(squirrel.Eq{string(call.FieldID): id.Bytes()}).
+		PlaceholderFormat(squirrel.Question).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("could not build query. CallSetHangup. err: %v", err)
+	}
+
+	tx, err := h.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("could not begin transaction. CallSetHangup. err: %v", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("could not execute query. CallSetHangup. err: %v", err)
+	}
+
+	c, err := h.callTXGet(ctx, tx, id)
+	if err != nil {
+		return fmt.Errorf("could not get hungup call. CallSetHangup. err: %v", err)
+	}
+
+	if err := h.outboxHandler.TXPublishWebhookEvent(ctx, tx, c.CustomerID, call.EventTypeCallHangup, c); err != nil {
+		return fmt.Errorf("could not store the hangup event. CallSetHangup. err: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("could not commit. CallSetHangup. err: %v", err)
+	}
+
+	_ = h.callSetToCache(ctx, c)
+	return nil
+}
+
+// callTXGet returns the call from the DB in a transaction mode.
+func (h *handler) callTXGet(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*call.Call, error) {
+	fields := commondatabasehandler.GetDBFields(&call.Call{})
+	query, args, err := squirrel.
+		Select(fields...).
+		From(callTable).
+		Where(squirrel.Eq{string(call.FieldID): id.Bytes()}).
+		PlaceholderFormat(squirrel.Question).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("could not build query. callTXGet. err: %v", err)
+	}
+
+	row, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("could not query. callTXGet. err: %v", err)
+	}
+	defer func() {
+		_ = row.Close()
+	}()
+
+	if !row.Next() {
+		return nil, ErrNotFound
+	}
+
+	return h.callGetFromRow(row)
 }
 
 // CallSetFlowID sets the call's flow_id
@@ -307,6 +376,13 @@ func (h *handler) CallSetConfbridgeID(ctx context.Context, id, confbridgeID uuid
 	})
 }
 
+// CallSetMaxCost sets the call's max cost
+func (h *handler) CallSetMaxCost(ctx context.Context, id uuid.UUID, maxCost int64) error {
+	return h.CallUpdate(ctx, id, map[call.Field]any{
+		call.FieldMaxCost: maxCost,
+	})
+}
+
 // CallSetActionAndActionNextHold sets the call action and action_next_hold
 func (h *handler) CallSetActionAndActionNextHold(ctx context.Context, id uuid.UUID, action *fmaction.Action, hold bool) error {
 	return h.CallUpdate(ctx, id, map[call.Field]any{
@@ -0,0 +1,23 @@
+package dbhandler
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+// CallMaxCostDeadlineGet returns the cached time of the call reaching its max cost.
+func (h *handler) CallMaxCostDeadlineGet(ctx context.Context, id uuid.UUID) (*time.Time, error) {
+	return h.cache.CallMaxCostDeadlineGet(ctx, id)
+}
+
+// CallMaxCostDeadlineSet caches the time of the call reaching its max cost.
+func (h *handler) CallMaxCostDeadlineSet(ctx context.Context, id uuid.UUID, deadline *time.Time) error {
+	return h.cache.CallMaxCostDeadlineSet(ctx, id, deadline)
+}
+
+// CallMaxCostDeadlineDelete removes the cached time of the call reaching its max cost.
+func (h *handler) CallMaxCostDeadlineDelete(ctx context.Context, id uuid.UUID) error {
+	return h.cache.CallMaxCostDeadlineDelete(ctx, id)
+}
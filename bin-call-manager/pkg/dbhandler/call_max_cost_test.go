@@ -0,0 +1,166 @@
+package dbhandler
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	"monorepo/bin-call-manager/pkg/cachehandler"
+	"monorepo/bin-call-manager/pkg/testhelper"
+)
+
+func Test_CallMaxCostDeadlineGet(t *testing.T) {
+	tests := []struct {
+		name        string
+		id          uuid.UUID
+		cacheReturn *time.Time
+		cacheErr    error
+		expectErr   bool
+	}{
+		{
+			name:        "successful get",
+			id:          uuid.FromStringOrNil("2f1f6c7a-ad1e-11f0-8a3b-6f4d2c1e9b7a"),
+			cacheReturn: testhelper.TimePtr("2023-01-18T03:22:18.995000Z"),
+			cacheErr:    nil,
+			expectErr:   false,
+		},
+		{
+			name:        "cache error",
+			id:          uuid.FromStringOrNil("2f4b8d2e-ad1e-11f0-9c7d-7a5e3d2f0c8b"),
+			cacheReturn: nil,
+			cacheErr:    fmt.Errorf("cache error"),
+			expectErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockCache := cachehandler.NewMockCacheHandler(mc)
+			h := handler{
+				cache: mockCache,
+			}
+
+			ctx := context.Background()
+
+			mockCache.EXPECT().
+				CallMaxCostDeadlineGet(ctx, tt.id).
+				Return(tt.cacheReturn, tt.cacheErr)
+
+			res, err := h.CallMaxCostDeadlineGet(ctx, tt.id)
+			if (err != nil) != tt.expectErr {
+				t.Errorf("CallMaxCostDeadlineGet() error = %v, expectErr %v", err, tt.expectErr)
+				return
+			}
+			if !tt.expectErr && res != tt.cacheReturn {
+				t.Errorf("CallMaxCostDeadlineGet() = %v, want %v", res, tt.cacheReturn)
+			}
+		})
+	}
+}
+
+func Test_CallMaxCostDeadlineSet(t *testing.T) {
+	tests := []struct {
+		name      string
+		id        uuid.UUID
+		deadline  *time.Time
+		cacheErr  error
+		expectErr bool
+	}{
+		{
+			name:      "successful set",
+			id:        uuid.FromStringOrNil("2f7a9e4c-ad1e-11f0-b1e2-8b6f4e3a1d9c"),
+			deadline:  testhelper.TimePtr("2023-01-18T03:22:18.995000Z"),
+			cacheErr:  nil,
+			expectErr: false,
+		},
+		{
+			name:      "never reaches the max cost",
+			id:        uuid.FromStringOrNil("2fa6c1b8-ad1e-11f0-8d4f-9c7a5f4b2e0d"),
+			deadline:  nil,
+			cacheErr:  nil,
+			expectErr: false,
+		},
+		{
+			name:      "cache error",
+			id:        uuid.FromStringOrNil("2fd2e3a6-ad1e-11f0-a6b3-0d8b6a5c3f1e"),
+			deadline:  testhelper.TimePtr("2023-01-18T03:22:18.995000Z"),
+			cacheErr:  fmt.Errorf("cache error"),
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockCache := cachehandler.NewMockCacheHandler(mc)
+			h := handler{
+				cache: mockCache,
+			}
+
+			ctx := context.Background()
+
+			mockCache.EXPECT().
+				CallMaxCostDeadlineSet(ctx, tt.id, tt.deadline).
+				Return(tt.cacheErr)
+
+			err := h.CallMaxCostDeadlineSet(ctx, tt.id, tt.deadline)
+			if (err != nil) != tt.expectErr {
+				t.Errorf("CallMaxCostDeadlineSet() error = %v, expectErr %v", err, tt.expectErr)
+			}
+		})
+	}
+}
+
+func Test_CallMaxCostDeadlineDelete(t *testing.T) {
+	tests := []struct {
+		name      string
+		id        uuid.UUID
+		cacheErr  error
+		expectErr bool
+	}{
+		{
+			name:      "successful delete",
+			id:        uuid.FromStringOrNil("30a1b2c3-ad1e-11f0-9e8d-1e9c7b6d4a2f"),
+			cacheErr:  nil,
+			expectErr: false,
+		},
+		{
+			name:      "cache error",
+			id:        uuid.FromStringOrNil("30cde4f5-ad1e-11f0-8f9e-2fad8c7e5b3a"),
+			cacheErr:  fmt.Errorf("cache error"),
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockCache := cachehandler.NewMockCacheHandler(mc)
+			h := handler{
+				cache: mockCache,
+			}
+
+			ctx := context.Background()
+
+			mockCache.EXPECT().
+				CallMaxCostDeadlineDelete(ctx, tt.id).
+				Return(tt.cacheErr)
+
+			err := h.CallMaxCostDeadlineDelete(ctx, tt.id)
+			if (err != nil) != tt.expectErr {
+				t.Errorf("CallMaxCostDeadlineDelete() error = %v, expectErr %v", err, tt.expectErr)
+			}
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 	commonaddress "monorepo/bin-common-handler/models/address"
 	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/outboxhandler"
 	"monorepo/bin-common-handler/pkg/utilhandler"
 
 	fmaction "monorepo/bin-flow-manager/models/action"
@@ -903,10 +904,12 @@ func Test_CallSetHangup(t *testing.T) {
 
 			mockUtil := utilhandler.NewMockUtilHandler(mc)
 			mockCache := cachehandler.NewMockCacheHandler(mc)
+			mockOutbox := outboxhandler.NewMockOutboxHandler(mc)
 			h := handler{
-				utilHandler: mockUtil,
-				db:          dbTest,
-				cache:       mockCache,
+				utilHandler:   mockUtil,
+				db:            dbTest,
+				cache:         mockCache,
+				outboxHandler: mockOutbox,
 			}
 
 			mockUtil.EXPECT().TimeNow().Return(tt.responseCurTime)
@@ -916,6 +919,7 @@ func Test_CallSetHangup(t *testing.T) {
 			}
 
 			mockUtil.EXPECT().TimeNow().Return(tt.responseCurTime)
+			mockOutbox.EXPECT().TXPublishWebhookEvent(gomock.Any(), gomock.Any(), tt.call.CustomerID, call.EventTypeCallHangup, gomock.Any()).Return(nil)
 			mockCache.EXPECT().CallSet(gomock.Any(), gomock.Any())
 			if err := h.CallSetHangup(context.Background(), tt.id, tt.reason, tt.hangupBy); err != nil {
 				t.Errorf("Wrong match. expect: ok, got: %v", err)
@@ -935,6 +939,52 @@ func Test_CallSetHangup(t *testing.T) {
 	}
 }
 
+func Test_CallSetHangup_outboxError(t *testing.T) {
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockUtil := utilhandler.NewMockUtilHandler(mc)
+	mockCache := cachehandler.NewMockCacheHandler(mc)
+	mockOutbox := outboxhandler.NewMockOutboxHandler(mc)
+	h := handler{
+		utilHandler:   mockUtil,
+		db:            dbTest,
+		cache:         mockCache,
+		outboxHandler: mockOutbox,
+	}
+
+	c := &call.Call{
+		Identity: commonidentity.Identity{
+			ID: uuid.FromStringOrNil("6b0e8a4c-ad8f-11f0-8d52-a7a0c1a4cf39"),
+		},
+		Status: call.StatusProgressing,
+	}
+
+	mockUtil.EXPECT().TimeNow().Return(testhelper.TimePtr("2020-04-18T03:22:17.995000Z"))
+	mockCache.EXPECT().CallSet(gomock.Any(), gomock.Any())
+	if err := h.CallCreate(context.Background(), c); err != nil {
+		t.Errorf("Wrong match. expect: ok, got: %v", err)
+	}
+
+	// the hangup must be rolled back when its event could not be stored.
+	mockUtil.EXPECT().TimeNow().Return(testhelper.TimePtr("2020-04-18T03:22:18.995000Z"))
+	mockOutbox.EXPECT().TXPublishWebhookEvent(gomock.Any(), gomock.Any(), c.CustomerID, call.EventTypeCallHangup, gomock.Any()).Return(fmt.Errorf(""))
+	if err := h.CallSetHangup(context.Background(), c.ID, call.HangupReasonNormal, call.HangupByLocal); err == nil {
+		t.Errorf("Wrong match. expect: error, got: ok")
+	}
+
+	mockCache.EXPECT().CallGet(gomock.Any(), c.ID).Return(nil, fmt.Errorf(""))
+	mockCache.EXPECT().CallSet(gomock.Any(), gomock.Any())
+	res, err := h.CallGet(context.Background(), c.ID)
+	if err != nil {
+		t.Errorf("Wrong match. expect: ok, got: %v", err)
+	}
+
+	if res.Status != call.StatusProgressing {
+		t.Errorf("Wrong match. expect: %s, got: %s", call.StatusProgressing, res.Status)
+	}
+}
+
 func Test_CallSetFlowID(t *testing.T) {
 
 	type test struct {
@@ -1117,6 +1167,96 @@ func Test_CallSetConfbridgeID(t *testing.T) {
 	}
 }
 
+func Test_CallSetMaxCost(t *testing.T) {
+
+	type test struct {
+		name string
+		call *call.Call
+
+		maxCost int64
+
+		responseCurTime *time.Time
+
+		expectCall *call.Call
+	}
+
+	tests := []test{
+		{
+			"normal",
+			&call.Call{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("3d1e7a52-b2da-11f0-8f4c-0b1c2d3e4f5a"),
+				},
+			},
+
+			3000000,
+			testhelper.TimePtr("2020-04-18T03:22:17.995000Z"),
+
+			&call.Call{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("3d1e7a52-b2da-11f0-8f4c-0b1c2d3e4f5a"),
+				},
+
+				ChainedCallIDs: []uuid.UUID{},
+				RecordingIDs:   []uuid.UUID{},
+				ExternalMediaIDs: []uuid.UUID{},
+
+				MaxCost: 3000000,
+
+				Data:       map[call.DataType]string{},
+				Metadata: map[string]interface{}{},
+				Dialroutes: []rmroute.Route{},
+
+				TMRinging:     nil,
+				TMProgressing: nil,
+				TMHangup:      nil,
+
+				TMCreate: testhelper.TimePtr("2020-04-18T03:22:17.995000Z"),
+				TMUpdate: testhelper.TimePtr("2020-04-18T03:22:17.995000Z"),
+				TMDelete: nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockCache := cachehandler.NewMockCacheHandler(mc)
+			h := handler{
+				utilHandler: mockUtil,
+				db:          dbTest,
+				cache:       mockCache,
+			}
+
+			mockUtil.EXPECT().TimeNow().Return(tt.responseCurTime)
+			mockCache.EXPECT().CallSet(gomock.Any(), gomock.Any())
+			if err := h.CallCreate(context.Background(), tt.call); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			mockUtil.EXPECT().TimeNow().Return(tt.responseCurTime)
+			mockCache.EXPECT().CallSet(gomock.Any(), gomock.Any())
+			if err := h.CallSetMaxCost(context.Background(), tt.call.ID, tt.maxCost); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			mockCache.EXPECT().CallGet(gomock.Any(), tt.call.ID).Return(nil, fmt.Errorf(""))
+			mockCache.EXPECT().CallSet(gomock.Any(), gomock.Any())
+			res, err := h.CallGet(context.Background(), tt.call.ID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(tt.expectCall, res) == false {
+				t.Errorf("Wrong match. expect: %v, got: %v", tt.expectCall, res)
+			}
+		})
+	}
+}
+
 func Test_CallSetActionAndActionNextHold(t *testing.T) {
 
 	type test struct {
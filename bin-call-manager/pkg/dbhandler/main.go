@@ -8,6 +8,7 @@ import (
 	"errors"
 	"time"
 
+	"monorepo/bin-common-handler/pkg/outboxhandler"
 	"monorepo/bin-common-handler/pkg/utilhandler"
 
 	fmaction "monorepo/bin-flow-manager/models/action"
@@ -53,6 +54,10 @@ type DBHandler interface {
 	CallSetBridgeID(ctx context.Context, id uuid.UUID, bridgeID string) error
 	CallSetChannelIDAndBridgeID(ctx context.Context, id uuid.UUID, channelID string, bridgeID string) error
 	CallSetConfbridgeID(ctx context.Context, id, confbridgeID uuid.UUID) error
+	CallSetMaxCost(ctx context.Context, id uuid.UUID, maxCost int64) error
+	CallMaxCostDeadlineGet(ctx context.Context, id uuid.UUID) (*time.Time, error)
+	CallMaxCostDeadlineSet(ctx context.Context, id uuid.UUID, deadline *time.Time) error
+	CallMaxCostDeadlineDelete(ctx context.Context, id uuid.UUID) error
 	CallSetData(ctx context.Context, id uuid.UUID, data map[call.DataType]string) error
 	CallAddExternalMediaID(ctx context.Context, id, externalMediaID uuid.UUID) error
 	CallRemoveExternalMediaID(ctx context.Context, id, externalMediaID uuid.UUID) error
@@ -155,9 +160,10 @@ type DBHandler interface {
 
 // handler database handler
 type handler struct {
-	utilHandler utilhandler.UtilHandler
-	db          *sql.DB
-	cache       cachehandler.CacheHandler
+	utilHandler   utilhandler.UtilHandler
+	db            *sql.DB
+	cache         cachehandler.CacheHandler
+	outboxHandler outboxhandler.OutboxHandler
 }
 
 // handler errors
@@ -165,13 +171,17 @@ var (
 	ErrNotFound = errors.New("record not found")
 )
 
+// OutboxTable is the outbox table of the call-manager.
+const OutboxTable = "call_outbox_events"
+
 
 // NewHandler creates DBHandler
-func NewHandler(db *sql.DB, cache cachehandler.CacheHandler) DBHandler {
+func NewHandler(db *sql.DB, cache cachehandler.CacheHandler, outboxHandler outboxhandler.OutboxHandler) DBHandler {
 	h := &handler{
-		utilHandler: utilhandler.NewUtilHandler(),
-		db:          db,
-		cache:       cache,
+		utilHandler:   utilhandler.NewUtilHandler(),
+		db:            db,
+		cache:         cache,
+		outboxHandler: outboxHandler,
 	}
 	return h
 }
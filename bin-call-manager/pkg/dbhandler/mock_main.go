@@ -197,17 +197,17 @@ func (mr *MockDBHandlerMockRecorder) CallApplicationAMDSet(ctx, channelID, app a
 }
 
 // CallCreate mocks base method.
-func (m *MockDBHandler) CallCreate(ctx context.Context, call *call.Call) error {
+func (m *MockDBHandler) CallCreate(ctx context.Context, arg1 *call.Call) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CallCreate", ctx, call)
+	ret := m.ctrl.Call(m, "CallCreate", ctx, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CallCreate indicates an expected call of CallCreate.
-func (mr *MockDBHandlerMockRecorder) CallCreate(ctx, call any) *gomock.Call {
+func (mr *MockDBHandlerMockRecorder) CallCreate(ctx, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CallCreate", reflect.TypeOf((*MockDBHandler)(nil).CallCreate), ctx, call)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CallCreate", reflect.TypeOf((*MockDBHandler)(nil).CallCreate), ctx, arg1)
 }
 
 // CallDelete mocks base method.
@@ -269,6 +269,49 @@ func (mr *MockDBHandlerMockRecorder) CallList(ctx, size, token, filters any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CallList", reflect.TypeOf((*MockDBHandler)(nil).CallList), ctx, size, token, filters)
 }
 
+// CallMaxCostDeadlineDelete mocks base method.
+func (m *MockDBHandler) CallMaxCostDeadlineDelete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CallMaxCostDeadlineDelete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CallMaxCostDeadlineDelete indicates an expected call of CallMaxCostDeadlineDelete.
+func (mr *MockDBHandlerMockRecorder) CallMaxCostDeadlineDelete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CallMaxCostDeadlineDelete", reflect.TypeOf((*MockDBHandler)(nil).CallMaxCostDeadlineDelete), ctx, id)
+}
+
+// CallMaxCostDeadlineGet mocks base method.
+func (m *MockDBHandler) CallMaxCostDeadlineGet(ctx context.Context, id uuid.UUID) (*time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CallMaxCostDeadlineGet", ctx, id)
+	ret0, _ := ret[0].(*time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CallMaxCostDeadlineGet indicates an expected call of CallMaxCostDeadlineGet.
+func (mr *MockDBHandlerMockRecorder) CallMaxCostDeadlineGet(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CallMaxCostDeadlineGet", reflect.TypeOf((*MockDBHandler)(nil).CallMaxCostDeadlineGet), ctx, id)
+}
+
+// CallMaxCostDeadlineSet mocks base method.
+func (m *MockDBHandler) CallMaxCostDeadlineSet(ctx context.Context, id uuid.UUID, deadline *time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CallMaxCostDeadlineSet", ctx, id, deadline)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CallMaxCostDeadlineSet indicates an expected call of CallMaxCostDeadlineSet.
+func (mr *MockDBHandlerMockRecorder) CallMaxCostDeadlineSet(ctx, id, deadline any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CallMaxCostDeadlineSet", reflect.TypeOf((*MockDBHandler)(nil).CallMaxCostDeadlineSet), ctx, id, deadline)
+}
+
 // CallRemoveChainedCallID mocks base method.
 func (m *MockDBHandler) CallRemoveChainedCallID(ctx context.Context, id, chainedCallID uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -298,17 +341,17 @@ func (mr *MockDBHandlerMockRecorder) CallRemoveExternalMediaID(ctx, id, external
 }
 
 // CallSetActionAndActionNextHold mocks base method.
-func (m *MockDBHandler) CallSetActionAndActionNextHold(ctx context.Context, id uuid.UUID, action *action.Action, hold bool) error {
+func (m *MockDBHandler) CallSetActionAndActionNextHold(ctx context.Context, id uuid.UUID, arg2 *action.Action, hold bool) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CallSetActionAndActionNextHold", ctx, id, action, hold)
+	ret := m.ctrl.Call(m, "CallSetActionAndActionNextHold", ctx, id, arg2, hold)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CallSetActionAndActionNextHold indicates an expected call of CallSetActionAndActionNextHold.
-func (mr *MockDBHandlerMockRecorder) CallSetActionAndActionNextHold(ctx, id, action, hold any) *gomock.Call {
+func (mr *MockDBHandlerMockRecorder) CallSetActionAndActionNextHold(ctx, id, arg2, hold any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CallSetActionAndActionNextHold", reflect.TypeOf((*MockDBHandler)(nil).CallSetActionAndActionNextHold), ctx, id, action, hold)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CallSetActionAndActionNextHold", reflect.TypeOf((*MockDBHandler)(nil).CallSetActionAndActionNextHold), ctx, id, arg2, hold)
 }
 
 // CallSetActionNextHold mocks base method.
@@ -437,6 +480,20 @@ func (mr *MockDBHandlerMockRecorder) CallSetMasterCallID(ctx, id, callID any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CallSetMasterCallID", reflect.TypeOf((*MockDBHandler)(nil).CallSetMasterCallID), ctx, id, callID)
 }
 
+// CallSetMaxCost mocks base method.
+func (m *MockDBHandler) CallSetMaxCost(ctx context.Context, id uuid.UUID, maxCost int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CallSetMaxCost", ctx, id, maxCost)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CallSetMaxCost indicates an expected call of CallSetMaxCost.
+func (mr *MockDBHandlerMockRecorder) CallSetMaxCost(ctx, id, maxCost any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CallSetMaxCost", reflect.TypeOf((*MockDBHandler)(nil).CallSetMaxCost), ctx, id, maxCost)
+}
+
 // CallSetMuteDirection mocks base method.
 func (m *MockDBHandler) CallSetMuteDirection(ctx context.Context, id uuid.UUID, muteDirection call.MuteDirection) error {
 	m.ctrl.T.Helper()
@@ -578,17 +635,17 @@ func (mr *MockDBHandlerMockRecorder) CallUpdate(ctx, id, fields any) *gomock.Cal
 }
 
 // ChannelCreate mocks base method.
-func (m *MockDBHandler) ChannelCreate(ctx context.Context, channel *channel.Channel) error {
+func (m *MockDBHandler) ChannelCreate(ctx context.Context, arg1 *channel.Channel) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ChannelCreate", ctx, channel)
+	ret := m.ctrl.Call(m, "ChannelCreate", ctx, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // ChannelCreate indicates an expected call of ChannelCreate.
-func (mr *MockDBHandlerMockRecorder) ChannelCreate(ctx, channel any) *gomock.Call {
+func (mr *MockDBHandlerMockRecorder) ChannelCreate(ctx, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChannelCreate", reflect.TypeOf((*MockDBHandler)(nil).ChannelCreate), ctx, channel)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChannelCreate", reflect.TypeOf((*MockDBHandler)(nil).ChannelCreate), ctx, arg1)
 }
 
 // ChannelEndAndDelete mocks base method.
@@ -16,6 +16,7 @@ import (
 	commonoutline "monorepo/bin-common-handler/models/outline"
 	"monorepo/bin-common-handler/models/sock"
 	"monorepo/bin-common-handler/pkg/sockhandler"
+	"monorepo/bin-common-handler/pkg/tracehandler"
 	"monorepo/bin-common-handler/pkg/utilhandler"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -77,6 +78,7 @@ var (
 	regV1CallsIDMOH               = regexp.MustCompile("/v1/calls/" + regUUID + "/moh$")
 	regV1CallsIDSilence           = regexp.MustCompile("/v1/calls/" + regUUID + "/silence$")
 	regV1CallsIDConfbridgeID      = regexp.MustCompile("/v1/calls/" + regUUID + "/confbridge_id$")
+	regV1CallsIDMaxCost           = regexp.MustCompile("/v1/calls/" + regUUID + "/max_cost$")
 	regV1CallsIDRecordingID       = regexp.MustCompile("/v1/calls/" + regUUID + "/recording_id$")
 	regV1CallsIDRecordingStart    = regexp.MustCompile("/v1/calls/" + regUUID + "/recording_start$")
 	regV1CallsIDRecordingStop     = regexp.MustCompile("/v1/calls/" + regUUID + "/recording_stop$")
@@ -254,7 +256,8 @@ func (h *listenHandler) processRequest(m *sock.Request) (*sock.Response, error)
 	var err error
 	var response *sock.Response
 
-	ctx := context.Background()
+	ctx, span := tracehandler.StartServerSpan(context.Background(), m)
+	defer span.End()
 
 	start := time.Now()
 	switch {
@@ -335,6 +338,11 @@ func (h *listenHandler) processRequest(m *sock.Request) (*sock.Response, error)
 		response, err = h.processV1CallsIDConfbridgeIDPut(ctx, m)
 		requestType = "/v1/calls/<call-id>/recording_id"
 
+	// PUT /calls/<call-id>/max_cost
+	case regV1CallsIDMaxCost.MatchString(m.URI) && m.Method == sock.RequestMethodPut:
+		response, err = h.processV1CallsIDMaxCostPut(ctx, m)
+		requestType = "/v1/calls/<call-id>/max_cost"
+
 	// PUT /calls/<call-id>/recording_id
 	case regV1CallsIDRecordingID.MatchString(m.URI) && m.Method == sock.RequestMethodPut:
 		response, err = h.processV1CallsIDRecordingIDPut(ctx, m)
@@ -120,6 +120,13 @@ type V1DataCallsIDConfbridgeIDPut struct {
 	ConfbridgeID uuid.UUID `json:"confbridge_id,omitempty"`
 }
 
+// V1DataCallsIDMaxCostPut is
+// v1 data type for
+// /v1/calls/<call-id>/max_cost PUT
+type V1DataCallsIDMaxCostPut struct {
+	MaxCost int64 `json:"max_cost"` // credit in micros. 0 means no limit
+}
+
 // V1DataCallsIDRecordingStartPost is
 // v1 data type for
 // /v1/calls/<call-id>/recording_start POST
@@ -12,6 +12,7 @@ import (
 	commonoutline "monorepo/bin-common-handler/models/outline"
 	"monorepo/bin-common-handler/models/sock"
 	"monorepo/bin-common-handler/pkg/sockhandler"
+	"monorepo/bin-common-handler/pkg/tracehandler"
 
 	cucustomer "monorepo/bin-customer-manager/models/customer"
 	fmactiveflow "monorepo/bin-flow-manager/models/activeflow"
@@ -176,7 +177,8 @@ func (h *subscribeHandler) processEvent(m *sock.Event) error {
 		"func":  "processEvent",
 		"event": m,
 	})
-	ctx := context.Background()
+	ctx, span := tracehandler.StartConsumerSpan(context.Background(), m)
+	defer span.End()
 
 	var err error
 	start := time.Now()
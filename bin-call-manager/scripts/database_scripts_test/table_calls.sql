@@ -48,6 +48,8 @@ create table call_calls(
   dialroute_id  binary(16),
   dialroutes    json,
 
+  max_cost  bigint default 0, -- max credit in micros the call can cost. 0 means no limit
+
   -- timestamps
   tm_create datetime(6),
   tm_update datetime(6),
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"os"
@@ -14,6 +15,7 @@ import (
 	"monorepo/bin-common-handler/pkg/notifyhandler"
 	"monorepo/bin-common-handler/pkg/requesthandler"
 	"monorepo/bin-common-handler/pkg/sockhandler"
+	"monorepo/bin-common-handler/pkg/tracehandler"
 
 	_ "github.com/go-sql-driver/mysql"
 	joonix "github.com/joonix/log"
@@ -25,7 +27,9 @@ import (
 	"monorepo/bin-campaign-manager/pkg/cachehandler"
 	"monorepo/bin-campaign-manager/pkg/campaigncallhandler"
 	"monorepo/bin-campaign-manager/pkg/campaignhandler"
+	"monorepo/bin-campaign-manager/pkg/campaignresultjobhandler"
 	"monorepo/bin-campaign-manager/pkg/dbhandler"
+	"monorepo/bin-campaign-manager/pkg/dispositionhandler"
 	"monorepo/bin-campaign-manager/pkg/listenhandler"
 	"monorepo/bin-campaign-manager/pkg/outplanhandler"
 	"monorepo/bin-campaign-manager/pkg/subscribehandler"
@@ -33,6 +37,10 @@ import (
 
 const serviceName = commonoutline.ServiceNameCampaignManager
 
+const (
+	jobRecoveryInterval = time.Minute // interval of the abandoned campaignresultjob sweep
+)
+
 // channels
 var chSigs = make(chan os.Signal, 1)
 var chDone = make(chan bool, 1)
@@ -73,6 +81,10 @@ func runService(cmd *cobra.Command, args []string) {
 
 	logrus.Info("The init finished.")
 
+	// init tracing
+	tracehandler.Init(serviceName)
+	defer tracehandler.Shutdown()
+
 	// create dbhandler
 	dbHandler, err := createDBHandler(cfg)
 	if err != nil {
@@ -146,7 +158,7 @@ func run(dbHandler dbhandler.DBHandler, cfg *config.Config) {
 	log := logrus.WithField("func", "run")
 
 	// rabbitmq sock connect
-	sockHandler := sockhandler.NewSockHandler(sock.TypeRabbitMQ, cfg.RabbitMQAddress)
+	sockHandler := sockhandler.NewSockHandler(sock.Type(cfg.SockType), cfg.RabbitMQAddress)
 	sockHandler.Connect()
 
 	// create handlers
@@ -156,9 +168,14 @@ func run(dbHandler dbhandler.DBHandler, cfg *config.Config) {
 	outplanHandler := outplanhandler.NewOutplanHandler(dbHandler, reqHandler, notifyHandler)
 	campaigncallHandler := campaigncallhandler.NewCampaigncallHandler(dbHandler, reqHandler, notifyHandler)
 	campaignHandler := campaignhandler.NewCampaignHandler(dbHandler, reqHandler, notifyHandler, campaigncallHandler, outplanHandler)
+	dispositionHandler := dispositionhandler.NewDispositionHandler(dbHandler, notifyHandler)
+	campaignResultJobHandler := campaignresultjobhandler.NewCampaignResultJobHandler(dbHandler, reqHandler, notifyHandler, campaignHandler)
+
+	// resume the campaignresultjobs abandoned by the restarted pods
+	go campaignResultJobHandler.RunRecovery(context.Background(), jobRecoveryInterval)
 
 	// run listen
-	if errListen := runListen(sockHandler, outplanHandler, campaignHandler, campaigncallHandler); errListen != nil {
+	if errListen := runListen(sockHandler, outplanHandler, campaignHandler, campaigncallHandler, dispositionHandler, campaignResultJobHandler); errListen != nil {
 		log.Errorf("Could not run the listen correctly. err: %v", errListen)
 		return
 	}
@@ -176,10 +193,12 @@ func runListen(
 	outplanHandler outplanhandler.OutplanHandler,
 	campaignHandler campaignhandler.CampaignHandler,
 	campaigncallHandler campaigncallhandler.CampaigncallHandler,
+	dispositionHandler dispositionhandler.DispositionHandler,
+	campaignResultJobHandler campaignresultjobhandler.CampaignResultJobHandler,
 ) error {
 	log := logrus.WithField("func", "runListen")
 
-	listenHandler := listenhandler.NewListenHandler(sockListen, outplanHandler, campaignHandler, campaigncallHandler)
+	listenHandler := listenhandler.NewListenHandler(sockListen, outplanHandler, campaignHandler, campaigncallHandler, dispositionHandler, campaignResultJobHandler)
 
 	// run the service
 	if errRun := listenHandler.Run(string(commonoutline.QueueNameCampaignRequest), string(commonoutline.QueueNameDelay)); errRun != nil {
@@ -201,6 +220,7 @@ func runSubscribe(
 	subscribeTargets := []string{
 		string(commonoutline.QueueNameCallEvent),
 		string(commonoutline.QueueNameFlowEvent),
+		string(commonoutline.QueueNameQueueEvent),
 	}
 	subscribeHandler := subscribehandler.NewSubscribeHandler(
 		sockListen,
@@ -0,0 +1,23 @@
+package campaign
+
+// CallingWindow defines the days and hours the callee can be called.
+// It's evaluated in the callee's local time.
+type CallingWindow struct {
+	Days  []Day  `json:"days,omitempty"` // empty means every day
+	Start string `json:"start"`          // start time(inclusive). "HH:MM"
+	End   string `json:"end"`            // end time(exclusive). "HH:MM". "24:00" is the end of the day
+}
+
+// Day defines
+type Day string
+
+// list of days
+const (
+	DaySunday    Day = "sunday"
+	DayMonday    Day = "monday"
+	DayTuesday   Day = "tuesday"
+	DayWednesday Day = "wednesday"
+	DayThursday  Day = "thursday"
+	DayFriday    Day = "friday"
+	DaySaturday  Day = "saturday"
+)
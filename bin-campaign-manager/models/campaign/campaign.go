@@ -24,6 +24,14 @@ type Campaign struct {
 	ServiceLevel int       `json:"service_level" db:"service_level"`
 	EndHandle    EndHandle `json:"end_handle" db:"end_handle"`
 
+	// pacing settings
+	DialMode       DialMode `json:"dial_mode" db:"dial_mode"`
+	MaxAbandonRate int      `json:"max_abandon_rate" db:"max_abandon_rate"` // max abandon rate(%) of the predictive dial mode. 0 uses the default
+
+	// calling window settings
+	CallingWindows []CallingWindow `json:"calling_windows" db:"calling_windows,json"` // the targets are dialed only in these windows of the callee's local time. empty means any time
+	Timezone       string          `json:"timezone" db:"timezone"`                    // IANA time zone used when the callee's time zone is unknown. empty means UTC
+
 	// action settings
 	FlowID  uuid.UUID         `json:"flow_id" db:"flow_id,uuid"` // flow id for campaign execution
 	Actions []fmaction.Action `json:"actions" db:"actions,json"` // this actions will be stored to the flow
@@ -67,6 +75,18 @@ const (
 	StatusRun      Status = "run"
 )
 
+// DialMode defines
+type DialMode string
+
+// list of DialModes
+const (
+	DialModeNone        DialMode = ""            // same as the power
+	DialModePower       DialMode = "power"       // dials the available agents * service_level(%) targets
+	DialModeProgressive DialMode = "progressive" // dials one target per available agent
+	DialModePredictive  DialMode = "predictive"  // adjusts the dial ratio from the answer rate, handle time and abandon rate
+	DialModePreview     DialMode = "preview"     // an agent accepts the target before it's dialed
+)
+
 // EndHandle defines
 type EndHandle string
 
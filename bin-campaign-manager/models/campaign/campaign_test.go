@@ -108,6 +108,52 @@ func TestStatusConstants(t *testing.T) {
 	}
 }
 
+func TestDialModeConstants(t *testing.T) {
+	tests := []struct {
+		name     string
+		constant DialMode
+		expected string
+	}{
+		{"dial_mode_none", DialModeNone, ""},
+		{"dial_mode_power", DialModePower, "power"},
+		{"dial_mode_progressive", DialModeProgressive, "progressive"},
+		{"dial_mode_predictive", DialModePredictive, "predictive"},
+		{"dial_mode_preview", DialModePreview, "preview"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if string(tt.constant) != tt.expected {
+				t.Errorf("Wrong constant value. expect: %s, got: %s", tt.expected, tt.constant)
+			}
+		})
+	}
+}
+
+func TestDayConstants(t *testing.T) {
+	tests := []struct {
+		name     string
+		constant Day
+		expected string
+	}{
+		{"day_sunday", DaySunday, "sunday"},
+		{"day_monday", DayMonday, "monday"},
+		{"day_tuesday", DayTuesday, "tuesday"},
+		{"day_wednesday", DayWednesday, "wednesday"},
+		{"day_thursday", DayThursday, "thursday"},
+		{"day_friday", DayFriday, "friday"},
+		{"day_saturday", DaySaturday, "saturday"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if string(tt.constant) != tt.expected {
+				t.Errorf("Wrong constant value. expect: %s, got: %s", tt.expected, tt.constant)
+			}
+		})
+	}
+}
+
 func TestEndHandleConstants(t *testing.T) {
 	tests := []struct {
 		name     string
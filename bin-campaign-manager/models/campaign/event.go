@@ -9,4 +9,6 @@ const (
 	EventTypeCampaignStatusRun      string = "campaign_status_run"      // the campaign updated
 	EventTypeCampaignStatusStopping string = "campaign_status_stopping" // the campaign updated
 	EventTypeCampaignStatusStop     string = "campaign_status_stop"     // the campaign updated
+
+	EventTypeCampaignPacing string = "campaign_pacing" // the campaign's pacing metrics
 )
@@ -16,6 +16,7 @@ func TestEventTypeConstants(t *testing.T) {
 		{"event_type_campaign_status_run", EventTypeCampaignStatusRun, "campaign_status_run"},
 		{"event_type_campaign_status_stopping", EventTypeCampaignStatusStopping, "campaign_status_stopping"},
 		{"event_type_campaign_status_stop", EventTypeCampaignStatusStop, "campaign_status_stop"},
+		{"event_type_campaign_pacing", EventTypeCampaignPacing, "campaign_pacing"},
 	}
 
 	for _, tt := range tests {
@@ -17,6 +17,12 @@ const (
 	FieldServiceLevel Field = "service_level" // service_level
 	FieldEndHandle    Field = "end_handle"    // end_handle
 
+	FieldDialMode       Field = "dial_mode"        // dial_mode
+	FieldMaxAbandonRate Field = "max_abandon_rate" // max_abandon_rate
+
+	FieldCallingWindows Field = "calling_windows" // calling_windows
+	FieldTimezone       Field = "timezone"        // timezone
+
 	FieldFlowID  Field = "flow_id" // flow_id
 	FieldActions Field = "actions" // actions
 
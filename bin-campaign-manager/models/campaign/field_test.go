@@ -19,6 +19,10 @@ func TestFieldConstants(t *testing.T) {
 		{"field_status", FieldStatus, "status"},
 		{"field_service_level", FieldServiceLevel, "service_level"},
 		{"field_end_handle", FieldEndHandle, "end_handle"},
+		{"field_dial_mode", FieldDialMode, "dial_mode"},
+		{"field_max_abandon_rate", FieldMaxAbandonRate, "max_abandon_rate"},
+		{"field_calling_windows", FieldCallingWindows, "calling_windows"},
+		{"field_timezone", FieldTimezone, "timezone"},
 		{"field_flow_id", FieldFlowID, "flow_id"},
 		{"field_actions", FieldActions, "actions"},
 		{"field_outplan_id", FieldOutplanID, "outplan_id"},
@@ -0,0 +1,43 @@
+package campaign
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+// Pacing defines the pacing metrics of the campaign's execution.
+type Pacing struct {
+	CampaignID uuid.UUID `json:"campaign_id"`
+	CustomerID uuid.UUID `json:"customer_id"`
+
+	DialMode DialMode `json:"dial_mode"`
+
+	AvailableAgents int `json:"available_agents"` // available agents of the campaign's queue
+	BusyAgents      int `json:"busy_agents"`      // busy agents of the campaign's queue. predictive dial mode only
+
+	Dialing    int `json:"dialing"`    // dialing campaigncalls
+	Previewing int `json:"previewing"` // campaigncalls waiting for an agent's accept
+	Capacity   int `json:"capacity"`   // max dialing and previewing campaigncalls
+
+	DialRatio float64 `json:"dial_ratio"` // dials per effective agent
+
+	Samples             int     `json:"samples"`                // done campaigncalls used for the statistics
+	AnswerRate          float64 `json:"answer_rate"`            // answered calls / done calls(%)
+	AbandonRate         float64 `json:"abandon_rate"`           // abandoned calls / answered calls(%)
+	AverageHandleTime   int     `json:"average_handle_time"`    // average duration of the answered calls(ms)
+	AverageTimeToAnswer int     `json:"average_time_to_answer"` // average duration from the dialing to the answer(ms)
+
+	TMCreate *time.Time `json:"tm_create"`
+}
+
+// CreateWebhookEvent generates the WebhookEvent
+func (h *Pacing) CreateWebhookEvent() ([]byte, error) {
+	m, err := json.Marshal(h)
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
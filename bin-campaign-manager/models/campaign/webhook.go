@@ -23,6 +23,12 @@ type WebhookMessage struct {
 	ServiceLevel int       `json:"service_level"`
 	EndHandle    EndHandle `json:"end_handle"`
 
+	DialMode       DialMode `json:"dial_mode"`
+	MaxAbandonRate int      `json:"max_abandon_rate"`
+
+	CallingWindows []CallingWindow `json:"calling_windows"`
+	Timezone       string          `json:"timezone"`
+
 	// action settings
 	Actions []fmaction.Action `json:"actions"` // this actions will be stored to the flow
 
@@ -52,6 +58,12 @@ func (h *Campaign) ConvertWebhookMessage() *WebhookMessage {
 		ServiceLevel: h.ServiceLevel,
 		EndHandle:    h.EndHandle,
 
+		DialMode:       h.DialMode,
+		MaxAbandonRate: h.MaxAbandonRate,
+
+		CallingWindows: h.CallingWindows,
+		Timezone:       h.Timezone,
+
 		Actions: h.Actions,
 
 		OutplanID: h.OutplanID,
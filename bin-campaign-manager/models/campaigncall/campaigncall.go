@@ -19,6 +19,7 @@ type Campaigncall struct {
 	OutdialID       uuid.UUID `json:"outdial_id" db:"outdial_id,uuid"`
 	OutdialTargetID uuid.UUID `json:"outdial_target_id" db:"outdial_target_id,uuid"`
 	QueueID         uuid.UUID `json:"queue_id" db:"queue_id,uuid"`
+	AgentID         uuid.UUID `json:"agent_id" db:"agent_id,uuid"` // agent who accepted the preview
 
 	ActiveflowID uuid.UUID `json:"activeflow_id" db:"activeflow_id,uuid"` // activeflow id
 	FlowID       uuid.UUID `json:"flow_id" db:"flow_id,uuid"`             // flow id
@@ -29,14 +30,21 @@ type Campaigncall struct {
 	Status Status `json:"status" db:"status"`
 	Result Result `json:"result" db:"result"`
 
+	Disposition string `json:"disposition" db:"disposition"` // the disposition's code. set by the agent, flow or ai
+
 	Source           *commonaddress.Address `json:"source" db:"source,json"`
 	Destination      *commonaddress.Address `json:"destination" db:"destination,json"`
 	DestinationIndex int                    `json:"destination_index" db:"destination_index"`
 	TryCount         int                    `json:"try_count" db:"try_count"`
 
-	TMCreate *time.Time `json:"tm_create" db:"tm_create"`
-	TMUpdate *time.Time `json:"tm_update" db:"tm_update"`
-	TMDelete *time.Time `json:"tm_delete" db:"tm_delete"`
+	Abandoned bool `json:"abandoned" db:"abandoned"` // the call answered but left the queue without an agent
+
+	TMCallback    *time.Time `json:"tm_callback" db:"tm_callback"`       // the scheduled callback the campaigncall was created for
+	TMProgressing *time.Time `json:"tm_progressing" db:"tm_progressing"` // the call answered
+	TMEnd         *time.Time `json:"tm_end" db:"tm_end"`                 // the campaigncall done
+	TMCreate      *time.Time `json:"tm_create" db:"tm_create"`
+	TMUpdate      *time.Time `json:"tm_update" db:"tm_update"`
+	TMDelete      *time.Time `json:"tm_delete" db:"tm_delete"`
 }
 
 // ReferenceType defines
@@ -54,6 +62,7 @@ type Status string
 
 // list of Status
 const (
+	StatusPreviewing  Status = "previewing"  // the campaigncall is waiting for an agent to accept(preview dial mode)
 	StatusDialing     Status = "dialing"     // the campaigncall is dialing(not answered yet)
 	StatusProgressing Status = "progressing" // the campaigncall is progressing(the call answered)
 	StatusDone        Status = "done"        // the campaigncall is hungup
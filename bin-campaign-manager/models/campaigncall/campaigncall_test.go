@@ -77,6 +77,7 @@ func TestStatusConstants(t *testing.T) {
 		constant Status
 		expected string
 	}{
+		{"status_previewing", StatusPreviewing, "previewing"},
 		{"status_dialing", StatusDialing, "dialing"},
 		{"status_progressing", StatusProgressing, "progressing"},
 		{"status_done", StatusDone, "done"},
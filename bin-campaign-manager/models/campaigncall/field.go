@@ -13,6 +13,7 @@ const (
 	FieldOutdialID       Field = "outdial_id"        // outdial_id
 	FieldOutdialTargetID Field = "outdial_target_id" // outdial_target_id
 	FieldQueueID         Field = "queue_id"          // queue_id
+	FieldAgentID         Field = "agent_id"          // agent_id
 
 	FieldActiveflowID Field = "activeflow_id" // activeflow_id
 	FieldFlowID       Field = "flow_id"       // flow_id
@@ -23,14 +24,21 @@ const (
 	FieldStatus Field = "status" // status
 	FieldResult Field = "result" // result
 
+	FieldDisposition Field = "disposition" // disposition
+
 	FieldSource           Field = "source"            // source
 	FieldDestination      Field = "destination"       // destination
 	FieldDestinationIndex Field = "destination_index" // destination_index
 	FieldTryCount         Field = "try_count"         // try_count
 
-	FieldTMCreate Field = "tm_create" // tm_create
-	FieldTMUpdate Field = "tm_update" // tm_update
-	FieldTMDelete Field = "tm_delete" // tm_delete
+	FieldAbandoned Field = "abandoned" // abandoned
+
+	FieldTMCallback    Field = "tm_callback"    // tm_callback
+	FieldTMProgressing Field = "tm_progressing" // tm_progressing
+	FieldTMEnd         Field = "tm_end"         // tm_end
+	FieldTMCreate      Field = "tm_create"      // tm_create
+	FieldTMUpdate      Field = "tm_update"      // tm_update
+	FieldTMDelete      Field = "tm_delete"      // tm_delete
 
 	// filter only
 	FieldDeleted Field = "deleted"
@@ -17,16 +17,22 @@ func TestFieldConstants(t *testing.T) {
 		{"field_outdial_id", FieldOutdialID, "outdial_id"},
 		{"field_outdial_target_id", FieldOutdialTargetID, "outdial_target_id"},
 		{"field_queue_id", FieldQueueID, "queue_id"},
+		{"field_agent_id", FieldAgentID, "agent_id"},
 		{"field_activeflow_id", FieldActiveflowID, "activeflow_id"},
 		{"field_flow_id", FieldFlowID, "flow_id"},
 		{"field_reference_type", FieldReferenceType, "reference_type"},
 		{"field_reference_id", FieldReferenceID, "reference_id"},
 		{"field_status", FieldStatus, "status"},
 		{"field_result", FieldResult, "result"},
+		{"field_disposition", FieldDisposition, "disposition"},
 		{"field_source", FieldSource, "source"},
 		{"field_destination", FieldDestination, "destination"},
 		{"field_destination_index", FieldDestinationIndex, "destination_index"},
 		{"field_try_count", FieldTryCount, "try_count"},
+		{"field_abandoned", FieldAbandoned, "abandoned"},
+		{"field_tm_callback", FieldTMCallback, "tm_callback"},
+		{"field_tm_progressing", FieldTMProgressing, "tm_progressing"},
+		{"field_tm_end", FieldTMEnd, "tm_end"},
 		{"field_tm_create", FieldTMCreate, "tm_create"},
 		{"field_tm_update", FieldTMUpdate, "tm_update"},
 		{"field_tm_delete", FieldTMDelete, "tm_delete"},
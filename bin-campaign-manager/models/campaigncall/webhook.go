@@ -20,6 +20,7 @@ type WebhookMessage struct {
 	OutdialID       uuid.UUID `json:"outdial_id"`
 	OutdialTargetID uuid.UUID `json:"outdial_target_id"`
 	QueueID         uuid.UUID `json:"queue_id"`
+	AgentID         uuid.UUID `json:"agent_id"`
 
 	ActiveflowID uuid.UUID `json:"activeflow_id"` // this is required
 	FlowID       uuid.UUID `json:"flow_id"`
@@ -30,14 +31,20 @@ type WebhookMessage struct {
 	Status Status `json:"status"`
 	Result Result `json:"result"`
 
+	Disposition string `json:"disposition"`
+
 	Source           *commonaddress.Address `json:"source"`
 	Destination      *commonaddress.Address `json:"destination"`
 	DestinationIndex int                    `json:"destination_index"`
 	TryCount         int                    `json:"try_count"`
 
-	TMCreate *time.Time `json:"tm_create"`
-	TMUpdate *time.Time `json:"tm_update"`
-	TMDelete *time.Time `json:"tm_delete"`
+	Abandoned bool `json:"abandoned"`
+
+	TMProgressing *time.Time `json:"tm_progressing"`
+	TMEnd         *time.Time `json:"tm_end"`
+	TMCreate      *time.Time `json:"tm_create"`
+	TMUpdate      *time.Time `json:"tm_update"`
+	TMDelete      *time.Time `json:"tm_delete"`
 }
 
 // ConvertWebhookMessage converts to the event
@@ -51,6 +58,7 @@ func (h *Campaigncall) ConvertWebhookMessage() *WebhookMessage {
 		OutdialID:       h.OutdialID,
 		OutdialTargetID: h.OutdialTargetID,
 		QueueID:         h.QueueID,
+		AgentID:         h.AgentID,
 
 		ActiveflowID: h.ActiveflowID,
 		FlowID:       h.FlowID,
@@ -61,14 +69,20 @@ func (h *Campaigncall) ConvertWebhookMessage() *WebhookMessage {
 		Status: h.Status,
 		Result: h.Result,
 
+		Disposition: h.Disposition,
+
 		Source:           h.Source,
 		Destination:      h.Destination,
 		DestinationIndex: h.DestinationIndex,
 		TryCount:         h.TryCount,
 
-		TMCreate: h.TMCreate,
-		TMUpdate: h.TMUpdate,
-		TMDelete: h.TMDelete,
+		Abandoned: h.Abandoned,
+
+		TMProgressing: h.TMProgressing,
+		TMEnd:         h.TMEnd,
+		TMCreate:      h.TMCreate,
+		TMUpdate:      h.TMUpdate,
+		TMDelete:      h.TMDelete,
 	}
 }
 
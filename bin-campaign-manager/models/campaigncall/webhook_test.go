@@ -39,6 +39,7 @@ func Test_ConvertWebhookMessage(t *testing.T) {
 				ReferenceID:     uuid.FromStringOrNil("15db949a-6ee0-11ee-9630-0fc99b395d5d"),
 				Status:          StatusProgressing,
 				Result:          ResultSuccess,
+				Disposition:     "sale",
 				Source: &commonaddress.Address{
 					Type:   commonaddress.TypeTel,
 					Target: "+821100000001",
@@ -69,6 +70,7 @@ func Test_ConvertWebhookMessage(t *testing.T) {
 				ReferenceID:     uuid.FromStringOrNil("15db949a-6ee0-11ee-9630-0fc99b395d5d"),
 				Status:          StatusProgressing,
 				Result:          ResultSuccess,
+				Disposition:     "sale",
 				Source: &commonaddress.Address{
 					Type:   commonaddress.TypeTel,
 					Target: "+821100000001",
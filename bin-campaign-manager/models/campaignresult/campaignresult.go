@@ -0,0 +1,81 @@
+package campaignresult
+
+import (
+	"time"
+
+	commonaddress "monorepo/bin-common-handler/models/address"
+
+	omoutdialtarget "monorepo/bin-outdial-manager/models/outdialtarget"
+
+	"github.com/gofrs/uuid"
+
+	"monorepo/bin-campaign-manager/models/campaigncall"
+)
+
+// CampaignResult defines the campaign's result of the outdial target.
+type CampaignResult struct {
+	CampaignID      uuid.UUID `json:"campaign_id"`
+	OutdialTargetID uuid.UUID `json:"outdial_target_id"`
+
+	Name   string                 `json:"name"`
+	Status omoutdialtarget.Status `json:"status"` // the outdial target's status
+
+	Attempts []Attempt `json:"attempts"` // the campaigncalls of the outdial target. oldest first
+
+	TMCreate *time.Time `json:"tm_create"` // the outdial target's tm_create
+}
+
+// Attempt defines the single dialing attempt to the outdial target.
+type Attempt struct {
+	CampaigncallID uuid.UUID `json:"campaigncall_id"`
+
+	Destination      *commonaddress.Address `json:"destination"`
+	DestinationIndex int                    `json:"destination_index"`
+	TryCount         int                    `json:"try_count"`
+
+	Status      campaigncall.Status `json:"status"`
+	Result      campaigncall.Result `json:"result"`
+	Disposition string              `json:"disposition"`
+	Abandoned   bool                `json:"abandoned"`
+
+	Duration     int `json:"duration"`      // milliseconds. from the dialing to the end
+	TalkDuration int `json:"talk_duration"` // milliseconds. from the answer to the end
+
+	TMCreate      *time.Time `json:"tm_create"`
+	TMProgressing *time.Time `json:"tm_progressing"`
+	TMEnd         *time.Time `json:"tm_end"`
+}
+
+// NewAttempt returns the attempt of the given campaigncall.
+// The durations are zero until the campaigncall is done.
+func NewAttempt(cc *campaigncall.Campaigncall) Attempt {
+	res := Attempt{
+		CampaigncallID: cc.ID,
+
+		Destination:      cc.Destination,
+		DestinationIndex: cc.DestinationIndex,
+		TryCount:         cc.TryCount,
+
+		Status:      cc.Status,
+		Result:      cc.Result,
+		Disposition: cc.Disposition,
+		Abandoned:   cc.Abandoned,
+
+		TMCreate:      cc.TMCreate,
+		TMProgressing: cc.TMProgressing,
+		TMEnd:         cc.TMEnd,
+	}
+
+	if cc.TMEnd == nil {
+		return res
+	}
+
+	if cc.TMCreate != nil {
+		res.Duration = int(cc.TMEnd.Sub(*cc.TMCreate).Milliseconds())
+	}
+	if cc.TMProgressing != nil {
+		res.TalkDuration = int(cc.TMEnd.Sub(*cc.TMProgressing).Milliseconds())
+	}
+
+	return res
+}
@@ -0,0 +1,119 @@
+package campaignresult
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	commonaddress "monorepo/bin-common-handler/models/address"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+
+	"github.com/gofrs/uuid"
+
+	"monorepo/bin-campaign-manager/models/campaigncall"
+)
+
+func Test_NewAttempt(t *testing.T) {
+
+	tmCreate := time.Date(2026, 10, 1, 3, 30, 0, 0, time.UTC)
+	tmProgressing := time.Date(2026, 10, 1, 3, 30, 10, 0, time.UTC)
+	tmEnd := time.Date(2026, 10, 1, 3, 31, 40, 0, time.UTC)
+
+	tests := []struct {
+		name string
+
+		campaigncall *campaigncall.Campaigncall
+
+		expectRes Attempt
+	}{
+		{
+			name: "answered",
+
+			campaigncall: &campaigncall.Campaigncall{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("c1f0a3b2-9c44-11f0-8c1e-4f7d2a9b6e30"),
+				},
+				Status:      campaigncall.StatusDone,
+				Result:      campaigncall.ResultSuccess,
+				Disposition: "sale",
+				Destination: &commonaddress.Address{
+					Type:   commonaddress.TypeTel,
+					Target: "+821100000001",
+				},
+				DestinationIndex: 1,
+				TryCount:         2,
+				TMCreate:         &tmCreate,
+				TMProgressing:    &tmProgressing,
+				TMEnd:            &tmEnd,
+			},
+
+			expectRes: Attempt{
+				CampaigncallID: uuid.FromStringOrNil("c1f0a3b2-9c44-11f0-8c1e-4f7d2a9b6e30"),
+				Destination: &commonaddress.Address{
+					Type:   commonaddress.TypeTel,
+					Target: "+821100000001",
+				},
+				DestinationIndex: 1,
+				TryCount:         2,
+				Status:           campaigncall.StatusDone,
+				Result:           campaigncall.ResultSuccess,
+				Disposition:      "sale",
+				Duration:         100000,
+				TalkDuration:     90000,
+				TMCreate:         &tmCreate,
+				TMProgressing:    &tmProgressing,
+				TMEnd:            &tmEnd,
+			},
+		},
+		{
+			name: "not answered",
+
+			campaigncall: &campaigncall.Campaigncall{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("c2336e5c-9c44-11f0-a2a1-0b9e6f3c7d41"),
+				},
+				Status:   campaigncall.StatusDone,
+				Result:   campaigncall.ResultFail,
+				TMCreate: &tmCreate,
+				TMEnd:    &tmEnd,
+			},
+
+			expectRes: Attempt{
+				CampaigncallID: uuid.FromStringOrNil("c2336e5c-9c44-11f0-a2a1-0b9e6f3c7d41"),
+				Status:         campaigncall.StatusDone,
+				Result:         campaigncall.ResultFail,
+				Duration:       100000,
+				TMCreate:       &tmCreate,
+				TMEnd:          &tmEnd,
+			},
+		},
+		{
+			name: "ongoing",
+
+			campaigncall: &campaigncall.Campaigncall{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("c2645f7e-9c44-11f0-9b0c-97a1d4e2f852"),
+				},
+				Status:        campaigncall.StatusProgressing,
+				TMCreate:      &tmCreate,
+				TMProgressing: &tmProgressing,
+			},
+
+			expectRes: Attempt{
+				CampaigncallID: uuid.FromStringOrNil("c2645f7e-9c44-11f0-9b0c-97a1d4e2f852"),
+				Status:         campaigncall.StatusProgressing,
+				TMCreate:       &tmCreate,
+				TMProgressing:  &tmProgressing,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := NewAttempt(tt.campaigncall)
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
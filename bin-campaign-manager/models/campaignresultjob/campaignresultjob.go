@@ -0,0 +1,39 @@
+package campaignresultjob
+
+import (
+	"time"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+
+	"github.com/gofrs/uuid"
+)
+
+// CampaignResultJob defines an asynchronous export of the campaign's results to the csv file.
+type CampaignResultJob struct {
+	commonidentity.Identity
+
+	CampaignID uuid.UUID `json:"campaign_id" db:"campaign_id,uuid"`
+
+	Status Status `json:"status" db:"status"`
+
+	FileID uuid.UUID `json:"file_id" db:"file_id,uuid"` // the exported csv file. empty until the job is done
+
+	TargetCount int `json:"target_count" db:"target_count"` // the number of the exported outdial targets
+	RowCount    int `json:"row_count" db:"row_count"`       // the number of the exported csv rows. each row is the dialing attempt
+
+	Detail string `json:"detail" db:"detail"` // the reason of the failure
+
+	TMCreate *time.Time `json:"tm_create" db:"tm_create"`
+	TMUpdate *time.Time `json:"tm_update" db:"tm_update"`
+	TMDelete *time.Time `json:"tm_delete" db:"tm_delete"`
+}
+
+// Status defines
+type Status string
+
+// list of statuses
+const (
+	StatusProcessing Status = "processing"
+	StatusDone       Status = "done"
+	StatusFailed     Status = "failed"
+)
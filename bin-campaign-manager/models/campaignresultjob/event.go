@@ -0,0 +1,7 @@
+package campaignresultjob
+
+// list of campaignresultjob event types
+const (
+	EventTypeCampaignResultJobCreated string = "campaignresultjob_created" // the campaignresultjob created
+	EventTypeCampaignResultJobUpdated string = "campaignresultjob_updated" // the campaignresultjob's progress or status updated
+)
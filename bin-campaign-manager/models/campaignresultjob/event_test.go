@@ -0,0 +1,24 @@
+package campaignresultjob
+
+import (
+	"testing"
+)
+
+func TestEventTypeConstants(t *testing.T) {
+	tests := []struct {
+		name     string
+		constant string
+		expected string
+	}{
+		{"event_type_campaignresultjob_created", EventTypeCampaignResultJobCreated, "campaignresultjob_created"},
+		{"event_type_campaignresultjob_updated", EventTypeCampaignResultJobUpdated, "campaignresultjob_updated"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.constant != tt.expected {
+				t.Errorf("Wrong constant value. expect: %s, got: %s", tt.expected, tt.constant)
+			}
+		})
+	}
+}
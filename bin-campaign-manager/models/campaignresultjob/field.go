@@ -0,0 +1,28 @@
+package campaignresultjob
+
+// Field type for typed field constants
+type Field string
+
+// Field constants for campaignresultjob
+const (
+	FieldID         Field = "id"
+	FieldCustomerID Field = "customer_id"
+
+	FieldCampaignID Field = "campaign_id"
+
+	FieldStatus Field = "status"
+
+	FieldFileID Field = "file_id"
+
+	FieldTargetCount Field = "target_count"
+	FieldRowCount    Field = "row_count"
+
+	FieldDetail Field = "detail"
+
+	FieldTMCreate Field = "tm_create"
+	FieldTMUpdate Field = "tm_update"
+	FieldTMDelete Field = "tm_delete"
+
+	// filter only
+	FieldDeleted Field = "deleted"
+)
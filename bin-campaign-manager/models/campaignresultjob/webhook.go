@@ -0,0 +1,64 @@
+package campaignresultjob
+
+import (
+	"encoding/json"
+	"time"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+
+	"github.com/gofrs/uuid"
+)
+
+// WebhookMessage defines
+type WebhookMessage struct {
+	commonidentity.Identity
+
+	CampaignID uuid.UUID `json:"campaign_id"`
+
+	Status Status `json:"status"`
+
+	FileID uuid.UUID `json:"file_id"`
+
+	TargetCount int `json:"target_count"`
+	RowCount    int `json:"row_count"`
+
+	Detail string `json:"detail"`
+
+	TMCreate *time.Time `json:"tm_create"`
+	TMUpdate *time.Time `json:"tm_update"`
+	TMDelete *time.Time `json:"tm_delete"`
+}
+
+// ConvertWebhookMessage converts to the event
+func (h *CampaignResultJob) ConvertWebhookMessage() *WebhookMessage {
+	return &WebhookMessage{
+		Identity: h.Identity,
+
+		CampaignID: h.CampaignID,
+
+		Status: h.Status,
+
+		FileID: h.FileID,
+
+		TargetCount: h.TargetCount,
+		RowCount:    h.RowCount,
+
+		Detail: h.Detail,
+
+		TMCreate: h.TMCreate,
+		TMUpdate: h.TMUpdate,
+		TMDelete: h.TMDelete,
+	}
+}
+
+// CreateWebhookEvent generates the WebhookEvent
+func (h *CampaignResultJob) CreateWebhookEvent() ([]byte, error) {
+	e := h.ConvertWebhookMessage()
+
+	m, err := json.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
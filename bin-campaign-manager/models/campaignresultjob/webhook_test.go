@@ -0,0 +1,64 @@
+package campaignresultjob
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+
+	"github.com/gofrs/uuid"
+)
+
+func Test_ConvertWebhookMessage(t *testing.T) {
+
+	tmCreate := time.Date(2026, 10, 1, 3, 30, 17, 0, time.UTC)
+
+	tests := []struct {
+		name string
+
+		data CampaignResultJob
+
+		expectRes *WebhookMessage
+	}{
+		{
+			name: "normal",
+
+			data: CampaignResultJob{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("5a1c7e2e-ad5c-11f0-8e3b-1d4f6a9c2b70"),
+					CustomerID: uuid.FromStringOrNil("5a4a9d30-ad5c-11f0-b6f1-4c8e2d7a3f90"),
+				},
+				CampaignID:  uuid.FromStringOrNil("5a78c0b2-ad5c-11f0-9c27-7f3a1e5d8b40"),
+				Status:      StatusDone,
+				FileID:      uuid.FromStringOrNil("5aa6e3c4-ad5c-11f0-a4d8-2e6b9f1c7d50"),
+				TargetCount: 2,
+				RowCount:    3,
+				TMCreate:    &tmCreate,
+			},
+
+			expectRes: &WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("5a1c7e2e-ad5c-11f0-8e3b-1d4f6a9c2b70"),
+					CustomerID: uuid.FromStringOrNil("5a4a9d30-ad5c-11f0-b6f1-4c8e2d7a3f90"),
+				},
+				CampaignID:  uuid.FromStringOrNil("5a78c0b2-ad5c-11f0-9c27-7f3a1e5d8b40"),
+				Status:      StatusDone,
+				FileID:      uuid.FromStringOrNil("5aa6e3c4-ad5c-11f0-a4d8-2e6b9f1c7d50"),
+				TargetCount: 2,
+				RowCount:    3,
+				TMCreate:    &tmCreate,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			res := tt.data.ConvertWebhookMessage()
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
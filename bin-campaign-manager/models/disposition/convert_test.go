@@ -0,0 +1,56 @@
+package disposition
+
+import (
+	"testing"
+
+	"github.com/gofrs/uuid"
+)
+
+func TestConvertStringMapToFieldMap(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     map[string]any
+		wantErr bool
+	}{
+		{
+			name: "valid_conversion",
+			src: map[string]any{
+				"customer_id": "a1c4e4a6-9c44-11f0-8d2e-7b6f0c4b1f7e",
+				"code":        "sale",
+				"deleted":     false,
+			},
+			wantErr: false,
+		},
+		{
+			name:    "empty_map",
+			src:     map[string]any{},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ConvertStringMapToFieldMap(tt.src)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ConvertStringMapToFieldMap() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got) != len(tt.src) {
+				t.Errorf("ConvertStringMapToFieldMap() result length = %v, expected %v", len(got), len(tt.src))
+			}
+		})
+	}
+}
+
+func TestConvertStringMapToFieldMap_UUID(t *testing.T) {
+	got, err := ConvertStringMapToFieldMap(map[string]any{
+		"customer_id": "a1c4e4a6-9c44-11f0-8d2e-7b6f0c4b1f7e",
+	})
+	if err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+
+	if _, ok := got[FieldCustomerID].(uuid.UUID); !ok {
+		t.Errorf("Wrong match. expect: uuid.UUID, got: %T", got[FieldCustomerID])
+	}
+}
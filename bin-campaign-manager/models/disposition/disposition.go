@@ -0,0 +1,33 @@
+package disposition
+
+import (
+	"time"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+)
+
+// Disposition defines the customer defined business outcome of the campaigncall.
+// e.g. "sale", "callback_requested", "wrong_number".
+type Disposition struct {
+	commonidentity.Identity
+
+	Code   string `json:"code" db:"code"` // unique in the customer. set to the campaigncall's disposition
+	Name   string `json:"name" db:"name"`
+	Detail string `json:"detail" db:"detail"`
+
+	RetryMode RetryMode `json:"retry_mode" db:"retry_mode"` // decides the outdial target's retry when the campaigncall is done
+
+	TMCreate *time.Time `json:"tm_create" db:"tm_create"`
+	TMUpdate *time.Time `json:"tm_update" db:"tm_update"`
+	TMDelete *time.Time `json:"tm_delete" db:"tm_delete"`
+}
+
+// RetryMode defines
+type RetryMode string
+
+// list of retry modes
+const (
+	RetryModeDefault RetryMode = "default"  // follows the campaigncall's result
+	RetryModeRetry   RetryMode = "retry"    // the outdial target goes back to idle, so it can be dialed again
+	RetryModeNoRetry RetryMode = "no_retry" // the outdial target is done. e.g. "wrong_number"
+)
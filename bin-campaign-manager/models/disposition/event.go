@@ -0,0 +1,8 @@
+package disposition
+
+// list of disposition event types
+const (
+	EventTypeDispositionCreated string = "disposition_created" // the disposition created
+	EventTypeDispositionUpdated string = "disposition_updated" // the disposition updated
+	EventTypeDispositionDeleted string = "disposition_deleted" // the disposition deleted
+)
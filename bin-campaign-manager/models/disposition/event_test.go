@@ -0,0 +1,25 @@
+package disposition
+
+import (
+	"testing"
+)
+
+func TestEventTypeConstants(t *testing.T) {
+	tests := []struct {
+		name     string
+		constant string
+		expected string
+	}{
+		{"event_type_disposition_created", EventTypeDispositionCreated, "disposition_created"},
+		{"event_type_disposition_updated", EventTypeDispositionUpdated, "disposition_updated"},
+		{"event_type_disposition_deleted", EventTypeDispositionDeleted, "disposition_deleted"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.constant != tt.expected {
+				t.Errorf("Wrong constant value. expect: %s, got: %s", tt.expected, tt.constant)
+			}
+		})
+	}
+}
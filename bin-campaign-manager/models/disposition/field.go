@@ -0,0 +1,22 @@
+package disposition
+
+// Field represents a database field name for Disposition
+type Field string
+
+const (
+	FieldID         Field = "id"          // id
+	FieldCustomerID Field = "customer_id" // customer_id
+
+	FieldCode   Field = "code"   // code
+	FieldName   Field = "name"   // name
+	FieldDetail Field = "detail" // detail
+
+	FieldRetryMode Field = "retry_mode" // retry_mode
+
+	FieldTMCreate Field = "tm_create" // tm_create
+	FieldTMUpdate Field = "tm_update" // tm_update
+	FieldTMDelete Field = "tm_delete" // tm_delete
+
+	// filter only
+	FieldDeleted Field = "deleted"
+)
@@ -0,0 +1,32 @@
+package disposition
+
+import (
+	"testing"
+)
+
+func TestFieldConstants(t *testing.T) {
+	tests := []struct {
+		name     string
+		constant Field
+		expected string
+	}{
+		{"field_id", FieldID, "id"},
+		{"field_customer_id", FieldCustomerID, "customer_id"},
+		{"field_code", FieldCode, "code"},
+		{"field_name", FieldName, "name"},
+		{"field_detail", FieldDetail, "detail"},
+		{"field_retry_mode", FieldRetryMode, "retry_mode"},
+		{"field_tm_create", FieldTMCreate, "tm_create"},
+		{"field_tm_update", FieldTMUpdate, "tm_update"},
+		{"field_tm_delete", FieldTMDelete, "tm_delete"},
+		{"field_deleted", FieldDeleted, "deleted"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if string(tt.constant) != tt.expected {
+				t.Errorf("Wrong constant value. expect: %s, got: %s", tt.expected, tt.constant)
+			}
+		})
+	}
+}
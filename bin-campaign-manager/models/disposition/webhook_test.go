@@ -0,0 +1,62 @@
+package disposition
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+
+	"github.com/gofrs/uuid"
+)
+
+func Test_ConvertWebhookMessage(t *testing.T) {
+
+	tmCreate := time.Date(2026, 10, 1, 3, 30, 17, 0, time.UTC)
+
+	tests := []struct {
+		name string
+
+		data Disposition
+
+		expectRes *WebhookMessage
+	}{
+		{
+			name: "normal",
+
+			data: Disposition{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("b2a0d2e4-9c44-11f0-9a47-8f2c3d1e4b5a"),
+					CustomerID: uuid.FromStringOrNil("b2d1c6f8-9c44-11f0-b1f3-2b7e9a0c5d6e"),
+				},
+				Code:      "wrong_number",
+				Name:      "Wrong number",
+				Detail:    "The callee is not the target.",
+				RetryMode: RetryModeNoRetry,
+				TMCreate:  &tmCreate,
+			},
+
+			expectRes: &WebhookMessage{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("b2a0d2e4-9c44-11f0-9a47-8f2c3d1e4b5a"),
+					CustomerID: uuid.FromStringOrNil("b2d1c6f8-9c44-11f0-b1f3-2b7e9a0c5d6e"),
+				},
+				Code:      "wrong_number",
+				Name:      "Wrong number",
+				Detail:    "The callee is not the target.",
+				RetryMode: RetryModeNoRetry,
+				TMCreate:  &tmCreate,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			res := tt.data.ConvertWebhookMessage()
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
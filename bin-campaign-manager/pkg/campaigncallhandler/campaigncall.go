@@ -3,6 +3,7 @@ package campaigncallhandler
 import (
 	"context"
 	stderrors "errors"
+	"time"
 
 	commonaddress "monorepo/bin-common-handler/models/address"
 	cerrors "monorepo/bin-common-handler/models/errors"
@@ -36,10 +37,104 @@ func (h *campaigncallHandler) Create(
 	destination *commonaddress.Address,
 	destinationIndex int,
 	tryCount int,
+) (*campaigncall.Campaigncall, error) {
+	return h.create(
+		ctx,
+		customerID,
+		campaignID,
+		outplanID,
+		outdialID,
+		outdialTargetID,
+		queueID,
+		uuid.Nil,
+		nil,
+		activeflowID,
+		flowID,
+		referenceType,
+		referenceID,
+		campaigncall.StatusDialing,
+		source,
+		destination,
+		destinationIndex,
+		tryCount,
+	)
+}
+
+// CreatePreview creates a new campaigncall waiting for an agent's accept.
+// The call is not made until the agent accepts the campaigncall.
+// If the agent id is given, the campaigncall is reserved for the agent.
+// The tm_callback is the scheduled callback the campaigncall was created for, if any.
+func (h *campaigncallHandler) CreatePreview(
+	ctx context.Context,
+	customerID uuid.UUID,
+
+	campaignID uuid.UUID,
+	outplanID uuid.UUID,
+	outdialID uuid.UUID,
+	outdialTargetID uuid.UUID,
+	queueID uuid.UUID,
+	agentID uuid.UUID,
+	tmCallback *time.Time,
+
+	activeflowID uuid.UUID,
+	flowID uuid.UUID,
+
+	referenceID uuid.UUID,
+	source *commonaddress.Address,
+	destination *commonaddress.Address,
+	destinationIndex int,
+	tryCount int,
+) (*campaigncall.Campaigncall, error) {
+	return h.create(
+		ctx,
+		customerID,
+		campaignID,
+		outplanID,
+		outdialID,
+		outdialTargetID,
+		queueID,
+		agentID,
+		tmCallback,
+		activeflowID,
+		flowID,
+		campaigncall.ReferenceTypeCall,
+		referenceID,
+		campaigncall.StatusPreviewing,
+		source,
+		destination,
+		destinationIndex,
+		tryCount,
+	)
+}
+
+// create creates a new campaigncall with the given status
+func (h *campaigncallHandler) create(
+	ctx context.Context,
+	customerID uuid.UUID,
+
+	campaignID uuid.UUID,
+	outplanID uuid.UUID,
+	outdialID uuid.UUID,
+	outdialTargetID uuid.UUID,
+	queueID uuid.UUID,
+	agentID uuid.UUID,
+	tmCallback *time.Time,
+
+	activeflowID uuid.UUID,
+	flowID uuid.UUID,
+
+	referenceType campaigncall.ReferenceType,
+	referenceID uuid.UUID,
+	status campaigncall.Status,
+	source *commonaddress.Address,
+	destination *commonaddress.Address,
+	destinationIndex int,
+	tryCount int,
 ) (*campaigncall.Campaigncall, error) {
 	log := logrus.WithFields(logrus.Fields{
-		"func":        "Create",
+		"func":        "create",
 		"customer_id": customerID,
+		"status":      status,
 	})
 
 	id := h.util.UUIDCreate()
@@ -54,17 +149,20 @@ func (h *campaigncallHandler) Create(
 		OutdialID:       outdialID,
 		OutdialTargetID: outdialTargetID,
 		QueueID:         queueID,
+		AgentID:         agentID,
 
 		ActiveflowID: activeflowID,
 		FlowID:       flowID,
 
 		ReferenceType:    referenceType,
 		ReferenceID:      referenceID,
-		Status:           campaigncall.StatusDialing,
+		Status:           status,
 		Source:           source,
 		Destination:      destination,
 		DestinationIndex: destinationIndex,
 		TryCount:         tryCount,
+
+		TMCallback: tmCallback,
 	}
 	log.WithField("campaigncall", t).Debug("Creating a new campaigncall.")
 
@@ -267,7 +365,7 @@ func (h *campaigncallHandler) updateStatusDone(ctx context.Context, id uuid.UUID
 		})
 	log.Debug("Getting campaigncalls.")
 
-	if err := h.db.CampaigncallUpdateStatusAndResult(ctx, id, campaigncall.StatusDone, result); err != nil {
+	if err := h.db.CampaigncallUpdateStatusDone(ctx, id, result); err != nil {
 		log.Errorf("Could not get UpdateStatus. err: %v", err)
 		return nil, err
 	}
@@ -521,7 +521,7 @@ func Test_updateStatusDone(t *testing.T) {
 
 			ctx := context.Background()
 
-			mockDB.EXPECT().CampaigncallUpdateStatusAndResult(ctx, tt.id, campaigncall.StatusDone, tt.result).Return(nil)
+			mockDB.EXPECT().CampaigncallUpdateStatusDone(ctx, tt.id, tt.result).Return(nil)
 			mockDB.EXPECT().CampaigncallGet(ctx, tt.id).Return(tt.responseCampaigncall, nil)
 			mockNotify.EXPECT().PublishWebhookEvent(ctx, tt.responseCampaigncall.CustomerID, campaigncall.EventTypeCampaigncallUpdated, tt.responseCampaigncall)
 
@@ -0,0 +1,111 @@
+package campaigncallhandler
+
+import (
+	"context"
+	stderrors "errors"
+
+	cerrors "monorepo/bin-common-handler/models/errors"
+	commonoutline "monorepo/bin-common-handler/models/outline"
+
+	omoutdialtarget "monorepo/bin-outdial-manager/models/outdialtarget"
+
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+
+	"monorepo/bin-campaign-manager/models/campaigncall"
+	"monorepo/bin-campaign-manager/models/disposition"
+	"monorepo/bin-campaign-manager/pkg/dbhandler"
+)
+
+// UpdateDisposition sets the customer's disposition code to the campaigncall. Empty code clears the disposition.
+// If the campaigncall is already done, the disposition's retry mode is applied to the outdial target right away.
+func (h *campaigncallHandler) UpdateDisposition(ctx context.Context, id uuid.UUID, code string) (*campaigncall.Campaigncall, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":            "UpdateDisposition",
+		"campaigncall_id": id,
+		"disposition":     code,
+	})
+	log.Debug("Updating the campaigncall's disposition.")
+
+	cc, err := h.Get(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get campaigncall. err: %v", err)
+		return nil, err
+	}
+
+	var d *disposition.Disposition
+	if code != "" {
+		d, err = h.db.DispositionGetByCode(ctx, cc.CustomerID, code)
+		if err != nil {
+			log.Errorf("Could not get the disposition. err: %v", err)
+			if stderrors.Is(err, dbhandler.ErrNotFound) {
+				return nil, cerrors.InvalidArgument(
+					commonoutline.ServiceNameCampaignManager,
+					"DISPOSITION_NOT_FOUND",
+					"The disposition code was not found.",
+				).Wrap(err)
+			}
+			return nil, err
+		}
+	}
+
+	if errUpdate := h.db.CampaigncallUpdateDisposition(ctx, id, code); errUpdate != nil {
+		log.Errorf("Could not update the campaigncall's disposition. err: %v", errUpdate)
+		return nil, errUpdate
+	}
+
+	res, err := h.Get(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get updated campaigncall. err: %v", err)
+		return nil, err
+	}
+	h.notifyHandler.PublishWebhookEvent(ctx, res.CustomerID, campaigncall.EventTypeCampaigncallUpdated, res)
+
+	if res.Status != campaigncall.StatusDone || d == nil || d.RetryMode == disposition.RetryModeDefault {
+		return res, nil
+	}
+
+	// the campaigncall is already done(e.g. set by the agent after the hangup).
+	otStatus := calcDialtargetStatusByRetryMode(d.RetryMode, omoutdialtarget.StatusIdle)
+	if _, errStatus := h.updateDialtargetStatus(ctx, res.OutdialTargetID, otStatus); errStatus != nil {
+		// the disposition is set already. just leave the log.
+		log.Errorf("Could not update the outdialtarget status. status: %s, err: %v", otStatus, errStatus)
+	}
+
+	return res, nil
+}
+
+// calcDialtargetStatusByDisposition returns the outdial target status overridden by the campaigncall's disposition.
+// Returns the given status if the campaigncall has no disposition or the disposition follows the result.
+func (h *campaigncallHandler) calcDialtargetStatusByDisposition(ctx context.Context, cc *campaigncall.Campaigncall, status omoutdialtarget.Status) omoutdialtarget.Status {
+	if cc.Disposition == "" {
+		return status
+	}
+
+	d, err := h.db.DispositionGetByCode(ctx, cc.CustomerID, cc.Disposition)
+	if err != nil {
+		// the disposition could be deleted after it was set. follow the result.
+		logrus.WithFields(logrus.Fields{
+			"func":            "calcDialtargetStatusByDisposition",
+			"campaigncall_id": cc.ID,
+			"disposition":     cc.Disposition,
+		}).Infof("Could not get the disposition. Following the result. err: %v", err)
+		return status
+	}
+
+	return calcDialtargetStatusByRetryMode(d.RetryMode, status)
+}
+
+// calcDialtargetStatusByRetryMode returns the outdial target status of the given retry mode.
+func calcDialtargetStatusByRetryMode(retryMode disposition.RetryMode, status omoutdialtarget.Status) omoutdialtarget.Status {
+	switch retryMode {
+	case disposition.RetryModeRetry:
+		return omoutdialtarget.StatusIdle
+
+	case disposition.RetryModeNoRetry:
+		return omoutdialtarget.StatusDone
+
+	default:
+		return status
+	}
+}
@@ -0,0 +1,297 @@
+package campaigncallhandler
+
+import (
+	"context"
+	reflect "reflect"
+	"testing"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/notifyhandler"
+	"monorepo/bin-common-handler/pkg/requesthandler"
+
+	omoutdialtarget "monorepo/bin-outdial-manager/models/outdialtarget"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	"monorepo/bin-campaign-manager/models/campaigncall"
+	"monorepo/bin-campaign-manager/models/disposition"
+	"monorepo/bin-campaign-manager/pkg/dbhandler"
+)
+
+func Test_UpdateDisposition(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		id   uuid.UUID
+		code string
+
+		responseCampaigncall *campaigncall.Campaigncall
+		responseDisposition  *disposition.Disposition
+
+		expectStatus omoutdialtarget.Status
+	}{
+		{
+			name: "ongoing campaigncall",
+
+			id:   uuid.FromStringOrNil("1e4c7a92-9c50-11f0-8b3d-5a2f9e1c6d01"),
+			code: "sale",
+
+			responseCampaigncall: &campaigncall.Campaigncall{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("1e4c7a92-9c50-11f0-8b3d-5a2f9e1c6d01"),
+					CustomerID: uuid.FromStringOrNil("1e7b3c54-9c50-11f0-a2e6-8d4c1f7b3e12"),
+				},
+				Status: campaigncall.StatusProgressing,
+			},
+			responseDisposition: &disposition.Disposition{
+				Code:      "sale",
+				RetryMode: disposition.RetryModeNoRetry,
+			},
+		},
+		{
+			name: "done campaigncall with no retry",
+
+			id:   uuid.FromStringOrNil("1eaa1f26-9c50-11f0-9c7f-3b6e2d8a4f23"),
+			code: "wrong_number",
+
+			responseCampaigncall: &campaigncall.Campaigncall{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("1eaa1f26-9c50-11f0-9c7f-3b6e2d8a4f23"),
+					CustomerID: uuid.FromStringOrNil("1e7b3c54-9c50-11f0-a2e6-8d4c1f7b3e12"),
+				},
+				OutdialTargetID: uuid.FromStringOrNil("1ed8e5f8-9c50-11f0-b5a1-6f9c3e2b7a34"),
+				Status:          campaigncall.StatusDone,
+			},
+			responseDisposition: &disposition.Disposition{
+				Code:      "wrong_number",
+				RetryMode: disposition.RetryModeNoRetry,
+			},
+
+			expectStatus: omoutdialtarget.StatusDone,
+		},
+		{
+			name: "done campaigncall with retry",
+
+			id:   uuid.FromStringOrNil("1f07b6ca-9c50-11f0-8e2c-9a1d4f6b8c45"),
+			code: "callback_requested",
+
+			responseCampaigncall: &campaigncall.Campaigncall{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("1f07b6ca-9c50-11f0-8e2c-9a1d4f6b8c45"),
+					CustomerID: uuid.FromStringOrNil("1e7b3c54-9c50-11f0-a2e6-8d4c1f7b3e12"),
+				},
+				OutdialTargetID: uuid.FromStringOrNil("1f36879c-9c50-11f0-a4d8-2c7e5b9f1d56"),
+				Status:          campaigncall.StatusDone,
+			},
+			responseDisposition: &disposition.Disposition{
+				Code:      "callback_requested",
+				RetryMode: disposition.RetryModeRetry,
+			},
+
+			expectStatus: omoutdialtarget.StatusIdle,
+		},
+		{
+			name: "clear",
+
+			id:   uuid.FromStringOrNil("1f65586e-9c50-11f0-9f3b-5e8a2c4d6f67"),
+			code: "",
+
+			responseCampaigncall: &campaigncall.Campaigncall{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("1f65586e-9c50-11f0-9f3b-5e8a2c4d6f67"),
+					CustomerID: uuid.FromStringOrNil("1e7b3c54-9c50-11f0-a2e6-8d4c1f7b3e12"),
+				},
+				Status: campaigncall.StatusDone,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			h := &campaigncallHandler{
+				db:            mockDB,
+				notifyHandler: mockNotify,
+				reqHandler:    mockReq,
+			}
+			ctx := context.Background()
+
+			mockDB.EXPECT().CampaigncallGet(ctx, tt.id).Return(tt.responseCampaigncall, nil)
+			if tt.code != "" {
+				mockDB.EXPECT().DispositionGetByCode(ctx, tt.responseCampaigncall.CustomerID, tt.code).Return(tt.responseDisposition, nil)
+			}
+			mockDB.EXPECT().CampaigncallUpdateDisposition(ctx, tt.id, tt.code).Return(nil)
+			mockDB.EXPECT().CampaigncallGet(ctx, tt.id).Return(tt.responseCampaigncall, nil)
+			mockNotify.EXPECT().PublishWebhookEvent(ctx, tt.responseCampaigncall.CustomerID, campaigncall.EventTypeCampaigncallUpdated, tt.responseCampaigncall)
+			if tt.expectStatus != "" {
+				mockReq.EXPECT().OutdialV1OutdialtargetUpdateStatus(ctx, tt.responseCampaigncall.OutdialTargetID, tt.expectStatus).Return(&omoutdialtarget.OutdialTarget{}, nil)
+			}
+
+			res, err := h.UpdateDisposition(ctx, tt.id, tt.code)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.responseCampaigncall) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.responseCampaigncall, res)
+			}
+		})
+	}
+}
+
+func Test_UpdateDisposition_error(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		id   uuid.UUID
+		code string
+
+		responseCampaigncall *campaigncall.Campaigncall
+	}{
+		{
+			name: "disposition not found",
+
+			id:   uuid.FromStringOrNil("4b1d8f3a-9c50-11f0-b6c2-7d3e9a1f5b78"),
+			code: "unknown",
+
+			responseCampaigncall: &campaigncall.Campaigncall{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("4b1d8f3a-9c50-11f0-b6c2-7d3e9a1f5b78"),
+					CustomerID: uuid.FromStringOrNil("4b4c6e1c-9c50-11f0-8a9d-1f5c7e3b2d89"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			h := &campaigncallHandler{
+				db: mockDB,
+			}
+			ctx := context.Background()
+
+			mockDB.EXPECT().CampaigncallGet(ctx, tt.id).Return(tt.responseCampaigncall, nil)
+			mockDB.EXPECT().DispositionGetByCode(ctx, tt.responseCampaigncall.CustomerID, tt.code).Return(nil, dbhandler.ErrNotFound)
+
+			if _, err := h.UpdateDisposition(ctx, tt.id, tt.code); err == nil {
+				t.Errorf("Wrong match. expect: error, got: ok")
+			}
+		})
+	}
+}
+
+func Test_Done_disposition(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		id     uuid.UUID
+		result campaigncall.Result
+
+		responseCampaigncall   *campaigncall.Campaigncall
+		responseDisposition    *disposition.Disposition
+		responseDispositionErr error
+
+		expectStatus omoutdialtarget.Status
+	}{
+		{
+			name: "failed call with no retry disposition",
+
+			id:     uuid.FromStringOrNil("6d2a4c8e-9c50-11f0-9e1f-4b7d2a6c8e90"),
+			result: campaigncall.ResultFail,
+
+			responseCampaigncall: &campaigncall.Campaigncall{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("6d2a4c8e-9c50-11f0-9e1f-4b7d2a6c8e90"),
+					CustomerID: uuid.FromStringOrNil("6d595b70-9c50-11f0-a3c7-8e2f5b1d4a01"),
+				},
+				OutdialTargetID: uuid.FromStringOrNil("6d883a52-9c50-11f0-8f6b-2d9c4e7a1b12"),
+				Disposition:     "wrong_number",
+			},
+			responseDisposition: &disposition.Disposition{
+				Code:      "wrong_number",
+				RetryMode: disposition.RetryModeNoRetry,
+			},
+
+			expectStatus: omoutdialtarget.StatusDone,
+		},
+		{
+			name: "success call with retry disposition",
+
+			id:     uuid.FromStringOrNil("6db71934-9c50-11f0-b2d4-5f1e8c3a7d23"),
+			result: campaigncall.ResultSuccess,
+
+			responseCampaigncall: &campaigncall.Campaigncall{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("6db71934-9c50-11f0-b2d4-5f1e8c3a7d23"),
+					CustomerID: uuid.FromStringOrNil("6d595b70-9c50-11f0-a3c7-8e2f5b1d4a01"),
+				},
+				OutdialTargetID: uuid.FromStringOrNil("6de5f816-9c50-11f0-9a8e-7c4b2f6d9e34"),
+				Disposition:     "callback_requested",
+			},
+			responseDisposition: &disposition.Disposition{
+				Code:      "callback_requested",
+				RetryMode: disposition.RetryModeRetry,
+			},
+
+			expectStatus: omoutdialtarget.StatusIdle,
+		},
+		{
+			name: "deleted disposition",
+
+			id:     uuid.FromStringOrNil("6e14d6f8-9c50-11f0-8c5a-9d3f1b7e2a45"),
+			result: campaigncall.ResultSuccess,
+
+			responseCampaigncall: &campaigncall.Campaigncall{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("6e14d6f8-9c50-11f0-8c5a-9d3f1b7e2a45"),
+					CustomerID: uuid.FromStringOrNil("6d595b70-9c50-11f0-a3c7-8e2f5b1d4a01"),
+				},
+				OutdialTargetID: uuid.FromStringOrNil("6e43b5da-9c50-11f0-a1b7-3e6c9a2d5f56"),
+				Disposition:     "old_code",
+			},
+			responseDispositionErr: dbhandler.ErrNotFound,
+
+			expectStatus: omoutdialtarget.StatusDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			h := &campaigncallHandler{
+				db:            mockDB,
+				notifyHandler: mockNotify,
+				reqHandler:    mockReq,
+			}
+			ctx := context.Background()
+
+			mockDB.EXPECT().CampaigncallUpdateStatusDone(ctx, tt.id, tt.result).Return(nil)
+			mockDB.EXPECT().CampaigncallGet(ctx, tt.id).Return(tt.responseCampaigncall, nil)
+			mockNotify.EXPECT().PublishWebhookEvent(ctx, tt.responseCampaigncall.CustomerID, campaigncall.EventTypeCampaigncallUpdated, tt.responseCampaigncall)
+			mockDB.EXPECT().DispositionGetByCode(ctx, tt.responseCampaigncall.CustomerID, tt.responseCampaigncall.Disposition).Return(tt.responseDisposition, tt.responseDispositionErr)
+			mockReq.EXPECT().OutdialV1OutdialtargetUpdateStatus(ctx, tt.responseCampaigncall.OutdialTargetID, tt.expectStatus).Return(&omoutdialtarget.OutdialTarget{}, nil)
+
+			if _, err := h.Done(ctx, tt.id, tt.result); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+		})
+	}
+}
@@ -72,3 +72,48 @@ func calcCampaigncallResultByCallHangupReason(reason cmcall.HangupReason) (campa
 
 	return res, nil
 }
+
+// EventHandleReferenceCallProgressing handles reference call's progressing(answered).
+func (h *campaigncallHandler) EventHandleReferenceCallProgressing(ctx context.Context, cc *campaigncall.Campaigncall) (*campaigncall.Campaigncall, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":            "EventHandleReferenceCallProgressing",
+		"campaigncall_id": cc.ID,
+	})
+
+	if cc.Status != campaigncall.StatusDialing {
+		// already progressing or done. nothing to do.
+		return cc, nil
+	}
+
+	res, err := h.Progressing(ctx, cc.ID)
+	if err != nil {
+		log.Errorf("Could not update the campaigncall to progressing. err: %v", err)
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// EventHandleQueuecallAbandoned handles the abandoned queuecall of the campaigncall.
+// The answered call left the queue without an agent.
+func (h *campaigncallHandler) EventHandleQueuecallAbandoned(ctx context.Context, cc *campaigncall.Campaigncall) (*campaigncall.Campaigncall, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":            "EventHandleQueuecallAbandoned",
+		"campaigncall_id": cc.ID,
+	})
+
+	if err := h.db.CampaigncallUpdateAbandoned(ctx, cc.ID, true); err != nil {
+		log.Errorf("Could not update the campaigncall abandoned. err: %v", err)
+		return nil, err
+	}
+	promCampaigncallAbandonedTotal.Inc()
+
+	res, err := h.Get(ctx, cc.ID)
+	if err != nil {
+		log.Errorf("Could not get updated campaigncall. err: %v", err)
+		return nil, err
+	}
+	h.notifyHandler.PublishWebhookEvent(ctx, res.CustomerID, campaigncall.EventTypeCampaigncallUpdated, res)
+
+	return res, nil
+}
@@ -59,7 +59,7 @@ func Test_EventHandleActiveflowDeleted(t *testing.T) {
 
 			ctx := context.Background()
 
-			mockDB.EXPECT().CampaigncallUpdateStatusAndResult(ctx, tt.campaigncall.ID, campaigncall.StatusDone, campaigncall.ResultSuccess).Return(nil)
+			mockDB.EXPECT().CampaigncallUpdateStatusDone(ctx, tt.campaigncall.ID, campaigncall.ResultSuccess).Return(nil)
 			mockDB.EXPECT().CampaigncallGet(ctx, tt.campaigncall.ID).Return(tt.response, nil)
 			mockReq.EXPECT().OutdialV1OutdialtargetUpdateStatus(ctx, tt.response.OutdialTargetID, omoutdialtarget.StatusDone).Return(&omoutdialtarget.OutdialTarget{}, nil)
 			mockNotify.EXPECT().PublishWebhookEvent(ctx, tt.response.CustomerID, campaigncall.EventTypeCampaigncallUpdated, tt.response)
@@ -286,7 +286,7 @@ func Test_EventHandleReferenceCallHungup(t *testing.T) {
 
 			ctx := context.Background()
 
-			mockDB.EXPECT().CampaigncallUpdateStatusAndResult(ctx, tt.campaigncall.ID, campaigncall.StatusDone, tt.expectResult).Return(nil)
+			mockDB.EXPECT().CampaigncallUpdateStatusDone(ctx, tt.campaigncall.ID, tt.expectResult).Return(nil)
 			mockDB.EXPECT().CampaigncallGet(ctx, tt.campaigncall.ID).Return(tt.response, nil)
 			mockReq.EXPECT().OutdialV1OutdialtargetUpdateStatus(ctx, tt.response.OutdialTargetID, tt.expectStatus).Return(&omoutdialtarget.OutdialTarget{}, nil)
 			mockNotify.EXPECT().PublishWebhookEvent(ctx, tt.response.CustomerID, campaigncall.EventTypeCampaigncallUpdated, tt.response)
@@ -298,3 +298,108 @@ func Test_EventHandleReferenceCallHungup(t *testing.T) {
 		})
 	}
 }
+
+func Test_EventHandleReferenceCallProgressing(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		campaigncall *campaigncall.Campaigncall
+		response     *campaigncall.Campaigncall
+	}{
+		{
+			"normal",
+
+			&campaigncall.Campaigncall{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("9d3e4f50-4e20-11f0-8a1b-2c3d4e5f6a11"),
+				},
+				Status: campaigncall.StatusDialing,
+			},
+			&campaigncall.Campaigncall{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("9d3e4f50-4e20-11f0-8a1b-2c3d4e5f6a11"),
+				},
+				Status: campaigncall.StatusProgressing,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+			h := &campaigncallHandler{
+				db:            mockDB,
+				notifyHandler: mockNotify,
+			}
+
+			ctx := context.Background()
+
+			mockDB.EXPECT().CampaigncallUpdateStatusProgressing(ctx, tt.campaigncall.ID).Return(nil)
+			mockDB.EXPECT().CampaigncallGet(ctx, tt.campaigncall.ID).Return(tt.response, nil)
+			mockNotify.EXPECT().PublishWebhookEvent(ctx, tt.response.CustomerID, campaigncall.EventTypeCampaigncallUpdated, tt.response)
+
+			_, err := h.EventHandleReferenceCallProgressing(ctx, tt.campaigncall)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+		})
+	}
+}
+
+func Test_EventHandleQueuecallAbandoned(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		campaigncall *campaigncall.Campaigncall
+		response     *campaigncall.Campaigncall
+	}{
+		{
+			"normal",
+
+			&campaigncall.Campaigncall{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("ae4f5061-4e20-11f0-9b2c-3d4e5f6a7b12"),
+				},
+				Status: campaigncall.StatusProgressing,
+			},
+			&campaigncall.Campaigncall{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("ae4f5061-4e20-11f0-9b2c-3d4e5f6a7b12"),
+				},
+				Status:    campaigncall.StatusProgressing,
+				Abandoned: true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+			h := &campaigncallHandler{
+				db:            mockDB,
+				notifyHandler: mockNotify,
+			}
+
+			ctx := context.Background()
+
+			mockDB.EXPECT().CampaigncallUpdateAbandoned(ctx, tt.campaigncall.ID, true).Return(nil)
+			mockDB.EXPECT().CampaigncallGet(ctx, tt.campaigncall.ID).Return(tt.response, nil)
+			mockNotify.EXPECT().PublishWebhookEvent(ctx, tt.response.CustomerID, campaigncall.EventTypeCampaigncallUpdated, tt.response)
+
+			_, err := h.EventHandleQueuecallAbandoned(ctx, tt.campaigncall)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+		})
+	}
+}
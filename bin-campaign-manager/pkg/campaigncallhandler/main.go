@@ -4,6 +4,7 @@ package campaigncallhandler
 
 import (
 	"context"
+	"time"
 
 	cmcall "monorepo/bin-call-manager/models/call"
 
@@ -39,12 +40,21 @@ var (
 		},
 		[]string{"result"},
 	)
+
+	promCampaigncallAbandonedTotal = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "campaigncall_abandoned_total",
+			Help:      "Total number of answered campaigncalls abandoned before an agent was connected.",
+		},
+	)
 )
 
 func init() {
 	prometheus.MustRegister(
 		promCampaigncallCreateTotal,
 		promCampaigncallDoneTotal,
+		promCampaigncallAbandonedTotal,
 	)
 }
 
@@ -78,6 +88,27 @@ type CampaigncallHandler interface {
 		destinationIndex int,
 		tryCount int,
 	) (*campaigncall.Campaigncall, error)
+	CreatePreview(
+		ctx context.Context,
+		customerID uuid.UUID,
+
+		campaignID uuid.UUID,
+		outplanID uuid.UUID,
+		outdialID uuid.UUID,
+		outdialTargetID uuid.UUID,
+		queueID uuid.UUID,
+		agentID uuid.UUID,
+		tmCallback *time.Time,
+
+		activeflowID uuid.UUID,
+		flowID uuid.UUID,
+
+		referenceID uuid.UUID,
+		source *commonaddress.Address,
+		destination *commonaddress.Address,
+		destinationIndex int,
+		tryCount int,
+	) (*campaigncall.Campaigncall, error)
 	Delete(ctx context.Context, id uuid.UUID) (*campaigncall.Campaigncall, error)
 	Get(ctx context.Context, id uuid.UUID) (*campaigncall.Campaigncall, error)
 	List(ctx context.Context, token string, limit uint64, filters map[campaigncall.Field]any) ([]*campaigncall.Campaigncall, error)
@@ -92,9 +123,18 @@ type CampaigncallHandler interface {
 	Done(ctx context.Context, id uuid.UUID, result campaigncall.Result) (*campaigncall.Campaigncall, error)
 	Progressing(ctx context.Context, id uuid.UUID) (*campaigncall.Campaigncall, error)
 
+	// disposition
+	UpdateDisposition(ctx context.Context, id uuid.UUID, disposition string) (*campaigncall.Campaigncall, error)
+
+	// preview
+	Accept(ctx context.Context, id uuid.UUID, agentID uuid.UUID) (*campaigncall.Campaigncall, error)
+	Skip(ctx context.Context, id uuid.UUID) (*campaigncall.Campaigncall, error)
+
 	// eventhandle
 	EventHandleReferenceCallHungup(ctx context.Context, c *cmcall.Call, cc *campaigncall.Campaigncall) (*campaigncall.Campaigncall, error)
 	EventHandleActiveflowDeleted(ctx context.Context, cc *campaigncall.Campaigncall) (*campaigncall.Campaigncall, error)
+	EventHandleReferenceCallProgressing(ctx context.Context, cc *campaigncall.Campaigncall) (*campaigncall.Campaigncall, error)
+	EventHandleQueuecallAbandoned(ctx context.Context, cc *campaigncall.Campaigncall) (*campaigncall.Campaigncall, error)
 }
 
 // NewCampaigncallHandler returns CampaignCallHandler
@@ -15,6 +15,7 @@ import (
 	campaigncall "monorepo/bin-campaign-manager/models/campaigncall"
 	address "monorepo/bin-common-handler/models/address"
 	reflect "reflect"
+	time "time"
 
 	uuid "github.com/gofrs/uuid"
 	gomock "go.uber.org/mock/gomock"
@@ -44,6 +45,21 @@ func (m *MockCampaigncallHandler) EXPECT() *MockCampaigncallHandlerMockRecorder
 	return m.recorder
 }
 
+// Accept mocks base method.
+func (m *MockCampaigncallHandler) Accept(ctx context.Context, id, agentID uuid.UUID) (*campaigncall.Campaigncall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Accept", ctx, id, agentID)
+	ret0, _ := ret[0].(*campaigncall.Campaigncall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Accept indicates an expected call of Accept.
+func (mr *MockCampaigncallHandlerMockRecorder) Accept(ctx, id, agentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Accept", reflect.TypeOf((*MockCampaigncallHandler)(nil).Accept), ctx, id, agentID)
+}
+
 // Create mocks base method.
 func (m *MockCampaigncallHandler) Create(ctx context.Context, customerID, campaignID, outplanID, outdialID, outdialTargetID, queueID, activeflowID, flowID uuid.UUID, referenceType campaigncall.ReferenceType, referenceID uuid.UUID, source, destination *address.Address, destinationIndex, tryCount int) (*campaigncall.Campaigncall, error) {
 	m.ctrl.T.Helper()
@@ -59,6 +75,21 @@ func (mr *MockCampaigncallHandlerMockRecorder) Create(ctx, customerID, campaignI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCampaigncallHandler)(nil).Create), ctx, customerID, campaignID, outplanID, outdialID, outdialTargetID, queueID, activeflowID, flowID, referenceType, referenceID, source, destination, destinationIndex, tryCount)
 }
 
+// CreatePreview mocks base method.
+func (m *MockCampaigncallHandler) CreatePreview(ctx context.Context, customerID, campaignID, outplanID, outdialID, outdialTargetID, queueID, agentID uuid.UUID, tmCallback *time.Time, activeflowID, flowID, referenceID uuid.UUID, source, destination *address.Address, destinationIndex, tryCount int) (*campaigncall.Campaigncall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePreview", ctx, customerID, campaignID, outplanID, outdialID, outdialTargetID, queueID, agentID, tmCallback, activeflowID, flowID, referenceID, source, destination, destinationIndex, tryCount)
+	ret0, _ := ret[0].(*campaigncall.Campaigncall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePreview indicates an expected call of CreatePreview.
+func (mr *MockCampaigncallHandlerMockRecorder) CreatePreview(ctx, customerID, campaignID, outplanID, outdialID, outdialTargetID, queueID, agentID, tmCallback, activeflowID, flowID, referenceID, source, destination, destinationIndex, tryCount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePreview", reflect.TypeOf((*MockCampaigncallHandler)(nil).CreatePreview), ctx, customerID, campaignID, outplanID, outdialID, outdialTargetID, queueID, agentID, tmCallback, activeflowID, flowID, referenceID, source, destination, destinationIndex, tryCount)
+}
+
 // Delete mocks base method.
 func (m *MockCampaigncallHandler) Delete(ctx context.Context, id uuid.UUID) (*campaigncall.Campaigncall, error) {
 	m.ctrl.T.Helper()
@@ -104,6 +135,21 @@ func (mr *MockCampaigncallHandlerMockRecorder) EventHandleActiveflowDeleted(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EventHandleActiveflowDeleted", reflect.TypeOf((*MockCampaigncallHandler)(nil).EventHandleActiveflowDeleted), ctx, cc)
 }
 
+// EventHandleQueuecallAbandoned mocks base method.
+func (m *MockCampaigncallHandler) EventHandleQueuecallAbandoned(ctx context.Context, cc *campaigncall.Campaigncall) (*campaigncall.Campaigncall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EventHandleQueuecallAbandoned", ctx, cc)
+	ret0, _ := ret[0].(*campaigncall.Campaigncall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EventHandleQueuecallAbandoned indicates an expected call of EventHandleQueuecallAbandoned.
+func (mr *MockCampaigncallHandlerMockRecorder) EventHandleQueuecallAbandoned(ctx, cc any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EventHandleQueuecallAbandoned", reflect.TypeOf((*MockCampaigncallHandler)(nil).EventHandleQueuecallAbandoned), ctx, cc)
+}
+
 // EventHandleReferenceCallHungup mocks base method.
 func (m *MockCampaigncallHandler) EventHandleReferenceCallHungup(ctx context.Context, c *call.Call, cc *campaigncall.Campaigncall) (*campaigncall.Campaigncall, error) {
 	m.ctrl.T.Helper()
@@ -119,6 +165,21 @@ func (mr *MockCampaigncallHandlerMockRecorder) EventHandleReferenceCallHungup(ct
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EventHandleReferenceCallHungup", reflect.TypeOf((*MockCampaigncallHandler)(nil).EventHandleReferenceCallHungup), ctx, c, cc)
 }
 
+// EventHandleReferenceCallProgressing mocks base method.
+func (m *MockCampaigncallHandler) EventHandleReferenceCallProgressing(ctx context.Context, cc *campaigncall.Campaigncall) (*campaigncall.Campaigncall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EventHandleReferenceCallProgressing", ctx, cc)
+	ret0, _ := ret[0].(*campaigncall.Campaigncall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EventHandleReferenceCallProgressing indicates an expected call of EventHandleReferenceCallProgressing.
+func (mr *MockCampaigncallHandlerMockRecorder) EventHandleReferenceCallProgressing(ctx, cc any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EventHandleReferenceCallProgressing", reflect.TypeOf((*MockCampaigncallHandler)(nil).EventHandleReferenceCallProgressing), ctx, cc)
+}
+
 // Get mocks base method.
 func (m *MockCampaigncallHandler) Get(ctx context.Context, id uuid.UUID) (*campaigncall.Campaigncall, error) {
 	m.ctrl.T.Helper()
@@ -253,3 +314,33 @@ func (mr *MockCampaigncallHandlerMockRecorder) Progressing(ctx, id any) *gomock.
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Progressing", reflect.TypeOf((*MockCampaigncallHandler)(nil).Progressing), ctx, id)
 }
+
+// Skip mocks base method.
+func (m *MockCampaigncallHandler) Skip(ctx context.Context, id uuid.UUID) (*campaigncall.Campaigncall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Skip", ctx, id)
+	ret0, _ := ret[0].(*campaigncall.Campaigncall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Skip indicates an expected call of Skip.
+func (mr *MockCampaigncallHandlerMockRecorder) Skip(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Skip", reflect.TypeOf((*MockCampaigncallHandler)(nil).Skip), ctx, id)
+}
+
+// UpdateDisposition mocks base method.
+func (m *MockCampaigncallHandler) UpdateDisposition(ctx context.Context, id uuid.UUID, disposition string) (*campaigncall.Campaigncall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDisposition", ctx, id, disposition)
+	ret0, _ := ret[0].(*campaigncall.Campaigncall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDisposition indicates an expected call of UpdateDisposition.
+func (mr *MockCampaigncallHandlerMockRecorder) UpdateDisposition(ctx, id, disposition any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDisposition", reflect.TypeOf((*MockCampaigncallHandler)(nil).UpdateDisposition), ctx, id, disposition)
+}
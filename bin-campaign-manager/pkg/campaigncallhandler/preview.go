@@ -0,0 +1,154 @@
+package campaigncallhandler
+
+import (
+	"context"
+	"fmt"
+
+	cerrors "monorepo/bin-common-handler/models/errors"
+	commonoutline "monorepo/bin-common-handler/models/outline"
+
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+
+	"monorepo/bin-campaign-manager/models/campaigncall"
+)
+
+// Accept accepts the previewing campaigncall by the agent and makes a call to the destination.
+// The campaigncall reserved for an agent(e.g. the scheduled callback) can be accepted by the agent only.
+func (h *campaigncallHandler) Accept(ctx context.Context, id uuid.UUID, agentID uuid.UUID) (*campaigncall.Campaigncall, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":            "Accept",
+		"campaigncall_id": id,
+		"agent_id":        agentID,
+	})
+	log.Debug("Accepting the campaigncall.")
+
+	cc, err := h.Get(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get campaigncall. err: %v", err)
+		return nil, err
+	}
+
+	if cc.Status != campaigncall.StatusPreviewing {
+		log.Errorf("The campaigncall is not previewing. status: %s", cc.Status)
+		return nil, cerrors.FailedPrecondition(
+			commonoutline.ServiceNameCampaignManager,
+			"CAMPAIGNCALL_NOT_PREVIEWING",
+			"The campaign call is not waiting for an agent.",
+		)
+	}
+
+	if cc.AgentID != uuid.Nil && cc.AgentID != agentID {
+		log.Errorf("The campaigncall is reserved for the other agent. reserved_agent_id: %s", cc.AgentID)
+		return nil, cerrors.FailedPrecondition(
+			commonoutline.ServiceNameCampaignManager,
+			"CAMPAIGNCALL_RESERVED",
+			"The campaign call is reserved for the other agent.",
+		)
+	}
+
+	if errValidate := h.validateAgent(ctx, cc.CustomerID, agentID); errValidate != nil {
+		log.Errorf("Could not validate the agent. err: %v", errValidate)
+		return nil, errValidate
+	}
+
+	// the campaigncall could be accepted by the other agent or expired after the check above.
+	// update it only if it is still previewing, so only one agent wins.
+	n, err := h.db.CampaigncallUpdateAgentIDAndStatusFromPreviewing(ctx, id, agentID, campaigncall.StatusDialing)
+	if err != nil {
+		log.Errorf("Could not update the campaigncall. err: %v", err)
+		return nil, err
+	}
+	if n == 0 {
+		log.Infof("The campaigncall is not previewing anymore.")
+		return nil, cerrors.FailedPrecondition(
+			commonoutline.ServiceNameCampaignManager,
+			"CAMPAIGNCALL_NOT_PREVIEWING",
+			"The campaign call is not waiting for an agent.",
+		)
+	}
+
+	res, err := h.Get(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get updated campaigncall. err: %v", err)
+		return nil, err
+	}
+	h.notifyHandler.PublishWebhookEvent(ctx, res.CustomerID, campaigncall.EventTypeCampaigncallUpdated, res)
+
+	// create a call
+	newCall, err := h.reqHandler.CallV1CallCreateWithID(
+		ctx,
+		res.ReferenceID,
+		res.CustomerID,
+		res.FlowID,
+		res.ActiveflowID,
+		uuid.Nil,
+		res.Source,
+		res.Destination,
+		uuid.Nil,
+		false,
+		false,
+		"",
+		nil,
+		nil,
+	)
+	if err != nil {
+		log.Errorf("Could not create a call. err: %v", err)
+		_, _ = h.Done(ctx, res.ID, campaigncall.ResultFail)
+		return nil, err
+	}
+	log.WithField("call", newCall).Debugf("Created a new call for the accepted campaigncall. call_id: %s", newCall.ID)
+
+	return res, nil
+}
+
+// validateAgent returns an error if the given agent does not exist or does not belong to the customer.
+func (h *campaigncallHandler) validateAgent(ctx context.Context, customerID uuid.UUID, agentID uuid.UUID) error {
+	a, err := h.reqHandler.AgentV1AgentGet(ctx, agentID)
+	if err != nil {
+		return fmt.Errorf("could not get agent info. err: %v", err)
+	}
+
+	if a.CustomerID != customerID || a.TMDelete != nil {
+		return cerrors.InvalidArgument(
+			commonoutline.ServiceNameCampaignManager,
+			"AGENT_NOT_FOUND",
+			"The agent was not found.",
+		)
+	}
+
+	return nil
+}
+
+// Skip skips the previewing campaigncall.
+// The outdial target goes back to idle, so it can be dialed again later.
+func (h *campaigncallHandler) Skip(ctx context.Context, id uuid.UUID) (*campaigncall.Campaigncall, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":            "Skip",
+		"campaigncall_id": id,
+	})
+	log.Debug("Skipping the campaigncall.")
+
+	cc, err := h.Get(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get campaigncall. err: %v", err)
+		return nil, err
+	}
+
+	if cc.Status != campaigncall.StatusPreviewing {
+		log.Errorf("The campaigncall is not previewing. status: %s", cc.Status)
+		return nil, cerrors.FailedPrecondition(
+			commonoutline.ServiceNameCampaignManager,
+			"CAMPAIGNCALL_NOT_PREVIEWING",
+			"The campaign call is not waiting for an agent.",
+		)
+	}
+
+	res, err := h.Done(ctx, id, campaigncall.ResultNone)
+	if err != nil {
+		log.Errorf("Could not done the campaigncall. err: %v", err)
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,304 @@
+package campaigncallhandler
+
+import (
+	"context"
+	reflect "reflect"
+	"testing"
+
+	amagent "monorepo/bin-agent-manager/models/agent"
+	cmcall "monorepo/bin-call-manager/models/call"
+	commonaddress "monorepo/bin-common-handler/models/address"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/notifyhandler"
+	"monorepo/bin-common-handler/pkg/requesthandler"
+
+	omoutdialtarget "monorepo/bin-outdial-manager/models/outdialtarget"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	"monorepo/bin-campaign-manager/models/campaigncall"
+	"monorepo/bin-campaign-manager/pkg/dbhandler"
+)
+
+func Test_Accept(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		id      uuid.UUID
+		agentID uuid.UUID
+
+		responseCampaigncall *campaigncall.Campaigncall
+		responseAgent        *amagent.Agent
+		responseUpdated      *campaigncall.Campaigncall
+	}{
+		{
+			name: "normal",
+
+			id:      uuid.FromStringOrNil("6a0f8f0c-4e20-11f0-9a63-9f1d2b3c4d01"),
+			agentID: uuid.FromStringOrNil("6a3c1e5a-4e20-11f0-8f51-4b7e2c1d9e02"),
+
+			responseCampaigncall: &campaigncall.Campaigncall{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("6a0f8f0c-4e20-11f0-9a63-9f1d2b3c4d01"),
+					CustomerID: uuid.FromStringOrNil("6a66d0b8-4e20-11f0-b0d4-1b9c8e7f6a03"),
+				},
+				Status: campaigncall.StatusPreviewing,
+			},
+			responseAgent: &amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("6a3c1e5a-4e20-11f0-8f51-4b7e2c1d9e02"),
+					CustomerID: uuid.FromStringOrNil("6a66d0b8-4e20-11f0-b0d4-1b9c8e7f6a03"),
+				},
+			},
+			responseUpdated: &campaigncall.Campaigncall{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("6a0f8f0c-4e20-11f0-9a63-9f1d2b3c4d01"),
+					CustomerID: uuid.FromStringOrNil("6a66d0b8-4e20-11f0-b0d4-1b9c8e7f6a03"),
+				},
+				AgentID:      uuid.FromStringOrNil("6a3c1e5a-4e20-11f0-8f51-4b7e2c1d9e02"),
+				ActiveflowID: uuid.FromStringOrNil("6a91f0a2-4e20-11f0-a7c2-63d5e4f3b204"),
+				FlowID:       uuid.FromStringOrNil("6abcb6e4-4e20-11f0-8b6e-df2a1c0b9e05"),
+				ReferenceID:  uuid.FromStringOrNil("6ae6a7d2-4e20-11f0-9c35-0f8e7d6c5b06"),
+				Status:       campaigncall.StatusDialing,
+				Source: &commonaddress.Address{
+					Type:   commonaddress.TypeTel,
+					Target: "+821100000001",
+				},
+				Destination: &commonaddress.Address{
+					Type:   commonaddress.TypeTel,
+					Target: "+821100000002",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			h := &campaigncallHandler{
+				db:            mockDB,
+				notifyHandler: mockNotify,
+				reqHandler:    mockReq,
+			}
+
+			ctx := context.Background()
+
+			mockDB.EXPECT().CampaigncallGet(ctx, tt.id).Return(tt.responseCampaigncall, nil)
+			mockReq.EXPECT().AgentV1AgentGet(ctx, tt.agentID).Return(tt.responseAgent, nil)
+			mockDB.EXPECT().CampaigncallUpdateAgentIDAndStatusFromPreviewing(ctx, tt.id, tt.agentID, campaigncall.StatusDialing).Return(int64(1), nil)
+			mockDB.EXPECT().CampaigncallGet(ctx, tt.id).Return(tt.responseUpdated, nil)
+			mockNotify.EXPECT().PublishWebhookEvent(ctx, tt.responseUpdated.CustomerID, campaigncall.EventTypeCampaigncallUpdated, tt.responseUpdated)
+			mockReq.EXPECT().CallV1CallCreateWithID(
+				ctx,
+				tt.responseUpdated.ReferenceID,
+				tt.responseUpdated.CustomerID,
+				tt.responseUpdated.FlowID,
+				tt.responseUpdated.ActiveflowID,
+				uuid.Nil,
+				tt.responseUpdated.Source,
+				tt.responseUpdated.Destination,
+				uuid.Nil,
+				false,
+				false,
+				"",
+				nil,
+				nil,
+			).Return(&cmcall.Call{}, nil)
+
+			res, err := h.Accept(ctx, tt.id, tt.agentID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.responseUpdated) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.responseUpdated, res)
+			}
+		})
+	}
+}
+
+func Test_Accept_error(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		id      uuid.UUID
+		agentID uuid.UUID
+
+		responseCampaigncall *campaigncall.Campaigncall
+		responseAgent        *amagent.Agent
+		responseRowsAffected int64
+	}{
+		{
+			name: "campaigncall is not previewing",
+
+			id:      uuid.FromStringOrNil("7b1e2a4c-4e20-11f0-8d2f-2a3b4c5d6e07"),
+			agentID: uuid.FromStringOrNil("7b4a9c6e-4e20-11f0-a1b3-7c8d9e0f1a08"),
+
+			responseCampaigncall: &campaigncall.Campaigncall{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("7b1e2a4c-4e20-11f0-8d2f-2a3b4c5d6e07"),
+				},
+				Status: campaigncall.StatusDialing,
+			},
+		},
+		{
+			name: "campaigncall is reserved for the other agent",
+
+			id:      uuid.FromStringOrNil("d2a4c6e8-4fa0-11f1-9b3d-1a2b3c4d5e6f"),
+			agentID: uuid.FromStringOrNil("7b4a9c6e-4e20-11f0-a1b3-7c8d9e0f1a08"),
+
+			responseCampaigncall: &campaigncall.Campaigncall{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("d2a4c6e8-4fa0-11f1-9b3d-1a2b3c4d5e6f"),
+				},
+				AgentID: uuid.FromStringOrNil("d2f1a3b5-4fa0-11f1-8c4e-7f8a9b0c1d2e"),
+				Status:  campaigncall.StatusPreviewing,
+			},
+		},
+		{
+			name: "agent belongs to the other customer",
+
+			id:      uuid.FromStringOrNil("5c1d3e6a-ae5e-11f1-8f2b-4a7c9e1d3b01"),
+			agentID: uuid.FromStringOrNil("5c4f6a8c-ae5e-11f1-b3d5-2e8a1c7f9d02"),
+
+			responseCampaigncall: &campaigncall.Campaigncall{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("5c1d3e6a-ae5e-11f1-8f2b-4a7c9e1d3b01"),
+					CustomerID: uuid.FromStringOrNil("5c7a9b1e-ae5e-11f1-a6c4-8d2f4b6e1a03"),
+				},
+				Status: campaigncall.StatusPreviewing,
+			},
+			responseAgent: &amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("5c4f6a8c-ae5e-11f1-b3d5-2e8a1c7f9d02"),
+					CustomerID: uuid.FromStringOrNil("5ca6c3f0-ae5e-11f1-9e7a-6b1d8f3c5e04"),
+				},
+			},
+		},
+		{
+			name: "campaigncall is accepted by the other agent in the meantime",
+
+			id:      uuid.FromStringOrNil("5cd2e512-ae5e-11f1-b8c1-3f9e5a7d2c05"),
+			agentID: uuid.FromStringOrNil("5cfe0734-ae5e-11f1-8a4d-7e2c6b9f1d06"),
+
+			responseCampaigncall: &campaigncall.Campaigncall{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("5cd2e512-ae5e-11f1-b8c1-3f9e5a7d2c05"),
+					CustomerID: uuid.FromStringOrNil("5d2a2956-ae5e-11f1-a3f7-1c8e4d6b2a07"),
+				},
+				Status: campaigncall.StatusPreviewing,
+			},
+			responseAgent: &amagent.Agent{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("5cfe0734-ae5e-11f1-8a4d-7e2c6b9f1d06"),
+					CustomerID: uuid.FromStringOrNil("5d2a2956-ae5e-11f1-a3f7-1c8e4d6b2a07"),
+				},
+			},
+			responseRowsAffected: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			h := &campaigncallHandler{
+				db:            mockDB,
+				notifyHandler: mockNotify,
+				reqHandler:    mockReq,
+			}
+
+			ctx := context.Background()
+
+			mockDB.EXPECT().CampaigncallGet(ctx, tt.id).Return(tt.responseCampaigncall, nil)
+			if tt.responseAgent != nil {
+				mockReq.EXPECT().AgentV1AgentGet(ctx, tt.agentID).Return(tt.responseAgent, nil)
+				if tt.responseAgent.CustomerID == tt.responseCampaigncall.CustomerID {
+					mockDB.EXPECT().CampaigncallUpdateAgentIDAndStatusFromPreviewing(ctx, tt.id, tt.agentID, campaigncall.StatusDialing).Return(tt.responseRowsAffected, nil)
+				}
+			}
+
+			_, err := h.Accept(ctx, tt.id, tt.agentID)
+			if err == nil {
+				t.Errorf("Wrong match. expect: error, got: ok")
+			}
+		})
+	}
+}
+
+func Test_Skip(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		id uuid.UUID
+
+		responseCampaigncall *campaigncall.Campaigncall
+		responseDone         *campaigncall.Campaigncall
+	}{
+		{
+			name: "normal",
+
+			id: uuid.FromStringOrNil("8c2d3e4f-4e20-11f0-9e4a-5b6c7d8e9f09"),
+
+			responseCampaigncall: &campaigncall.Campaigncall{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("8c2d3e4f-4e20-11f0-9e4a-5b6c7d8e9f09"),
+				},
+				Status: campaigncall.StatusPreviewing,
+			},
+			responseDone: &campaigncall.Campaigncall{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("8c2d3e4f-4e20-11f0-9e4a-5b6c7d8e9f09"),
+				},
+				OutdialTargetID: uuid.FromStringOrNil("8c5a6b7c-4e20-11f0-b2c1-9d0e1f2a3b10"),
+				Status:          campaigncall.StatusDone,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			h := &campaigncallHandler{
+				db:            mockDB,
+				notifyHandler: mockNotify,
+				reqHandler:    mockReq,
+			}
+
+			ctx := context.Background()
+
+			mockDB.EXPECT().CampaigncallGet(ctx, tt.id).Return(tt.responseCampaigncall, nil)
+			mockDB.EXPECT().CampaigncallUpdateStatusDone(ctx, tt.id, campaigncall.ResultNone).Return(nil)
+			mockDB.EXPECT().CampaigncallGet(ctx, tt.id).Return(tt.responseDone, nil)
+			mockNotify.EXPECT().PublishWebhookEvent(ctx, tt.responseDone.CustomerID, campaigncall.EventTypeCampaigncallUpdated, tt.responseDone)
+			mockReq.EXPECT().OutdialV1OutdialtargetUpdateStatus(ctx, tt.responseDone.OutdialTargetID, omoutdialtarget.StatusIdle).Return(&omoutdialtarget.OutdialTarget{}, nil)
+
+			res, err := h.Skip(ctx, tt.id)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.responseDone) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.responseDone, res)
+			}
+		})
+	}
+}
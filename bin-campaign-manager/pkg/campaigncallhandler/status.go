@@ -13,6 +13,7 @@ import (
 )
 
 // Done updates the campaigncall status to done and update the outdialtarget's status depends on the result.
+// The campaigncall's disposition overrides the result if the disposition has the retry mode.
 func (h *campaigncallHandler) Done(ctx context.Context, id uuid.UUID, result campaigncall.Result) (*campaigncall.Campaigncall, error) {
 	log := logrus.WithFields(logrus.Fields{
 		"func":            "Done",
@@ -35,10 +36,11 @@ func (h *campaigncallHandler) Done(ctx context.Context, id uuid.UUID, result cam
 		log.Errorf("Could not calculate the dialtarget status. err: %v", err)
 		return nil, err
 	}
+	otStatus = h.calcDialtargetStatusByDisposition(ctx, cc, otStatus)
 	log.Debugf("Calculated dialtarget status. status: %s", otStatus)
 
 	// send request to update outdial target
-	ot, err := h.reqHandler.OutdialV1OutdialtargetUpdateStatus(ctx, cc.OutdialTargetID, otStatus)
+	ot, err := h.updateDialtargetStatus(ctx, cc.OutdialTargetID, otStatus)
 	if err != nil {
 		log.Errorf("Could not update the outdialtarget status correctly. status: %s, err: %v", otStatus, err)
 		return nil, err
@@ -56,14 +58,26 @@ func (h *campaigncallHandler) Progressing(ctx context.Context, id uuid.UUID) (*c
 	})
 	log.Debug("Updating campaigncall status progress.")
 
-	// update campaigncall to done
-	cc, err := h.updateStatus(ctx, id, campaigncall.StatusProgressing)
+	// update campaigncall to progressing
+	if err := h.db.CampaigncallUpdateStatusProgressing(ctx, id); err != nil {
+		log.Errorf("Could not update the campaigncall status to progressing. err: %v", err)
+		return nil, err
+	}
+
+	res, err := h.Get(ctx, id)
 	if err != nil {
-		log.Errorf("Could not update the campaigncall status to done. err: %v", err)
+		log.Errorf("Could not get updated campaigncall. err: %v", err)
 		return nil, err
 	}
+	h.notifyHandler.PublishWebhookEvent(ctx, res.CustomerID, campaigncall.EventTypeCampaigncallUpdated, res)
 
-	return cc, nil
+	return res, nil
+}
+
+// updateDialtargetStatus updates the outdial target's status.
+// The outdial-manager puts the finished target back to idle if it has a scheduled callback, so the callback can be dialed.
+func (h *campaigncallHandler) updateDialtargetStatus(ctx context.Context, outdialTargetID uuid.UUID, status omoutdialtarget.Status) (*omoutdialtarget.OutdialTarget, error) {
+	return h.reqHandler.OutdialV1OutdialtargetUpdateStatus(ctx, outdialTargetID, status)
 }
 
 // calcDialtargetStatus returns calculated omoutdialtarget status based on campaigncall result
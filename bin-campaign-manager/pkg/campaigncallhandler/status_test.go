@@ -87,7 +87,7 @@ func Test_Done(t *testing.T) {
 
 			ctx := context.Background()
 
-			mockDB.EXPECT().CampaigncallUpdateStatusAndResult(ctx, tt.id, campaigncall.StatusDone, tt.result).Return(nil)
+			mockDB.EXPECT().CampaigncallUpdateStatusDone(ctx, tt.id, tt.result).Return(nil)
 			mockDB.EXPECT().CampaigncallGet(ctx, tt.id).Return(tt.responseCampaigncall, nil)
 			mockNotify.EXPECT().PublishWebhookEvent(ctx, tt.responseCampaigncall.CustomerID, campaigncall.EventTypeCampaigncallUpdated, tt.responseCampaigncall)
 
@@ -149,7 +149,7 @@ func Test_Progressing(t *testing.T) {
 
 			ctx := context.Background()
 
-			mockDB.EXPECT().CampaigncallUpdateStatus(ctx, tt.id, campaigncall.StatusProgressing).Return(nil)
+			mockDB.EXPECT().CampaigncallUpdateStatusProgressing(ctx, tt.id).Return(nil)
 			mockDB.EXPECT().CampaigncallGet(ctx, tt.id).Return(tt.responseCampaigncall, nil)
 			mockNotify.EXPECT().PublishWebhookEvent(ctx, tt.responseCampaigncall.CustomerID, campaigncall.EventTypeCampaigncallUpdated, tt.responseCampaigncall)
 
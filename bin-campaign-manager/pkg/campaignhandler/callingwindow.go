@@ -0,0 +1,109 @@
+package campaignhandler
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	cerrors "monorepo/bin-common-handler/models/errors"
+	commonoutline "monorepo/bin-common-handler/models/outline"
+
+	"monorepo/bin-campaign-manager/models/campaign"
+)
+
+// parseClock parses the "HH:MM" string and returns the minutes from the midnight.
+// "24:00" is allowed for the end of the day.
+func parseClock(s string) (int, error) {
+	if s == "24:00" {
+		return 24 * 60, nil
+	}
+
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("wrong clock format. clock: %s, err: %v", s, err)
+	}
+
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// validateCallingWindows returns error if the calling windows or the timezone are not valid.
+func validateCallingWindows(windows []campaign.CallingWindow, timezone string) error {
+	for _, w := range windows {
+		for _, d := range w.Days {
+			switch d {
+			case campaign.DaySunday, campaign.DayMonday, campaign.DayTuesday, campaign.DayWednesday, campaign.DayThursday, campaign.DayFriday, campaign.DaySaturday:
+				// valid
+			default:
+				return cerrors.InvalidArgument(commonoutline.ServiceNameCampaignManager, "INVALID_CALLING_WINDOW_DAY", fmt.Sprintf("Unsupported calling window day. day: %s", d))
+			}
+		}
+
+		start, errStart := parseClock(w.Start)
+		end, errEnd := parseClock(w.End)
+		if errStart != nil || errEnd != nil {
+			return cerrors.InvalidArgument(commonoutline.ServiceNameCampaignManager, "INVALID_CALLING_WINDOW_TIME", "The calling window's start and end must be in HH:MM format.")
+		}
+		if start >= end {
+			return cerrors.InvalidArgument(commonoutline.ServiceNameCampaignManager, "INVALID_CALLING_WINDOW_TIME", "The calling window's start must be earlier than its end.")
+		}
+	}
+
+	if timezone != "" {
+		if _, err := time.LoadLocation(timezone); err != nil {
+			return cerrors.InvalidArgument(commonoutline.ServiceNameCampaignManager, "INVALID_TIMEZONE", "The timezone is not a valid IANA time zone name.")
+		}
+	}
+
+	return nil
+}
+
+// isInCallingWindow returns true if the given local time is in the calling window.
+func isInCallingWindow(w campaign.CallingWindow, t time.Time) bool {
+	if len(w.Days) > 0 {
+		day := campaign.Day(strings.ToLower(t.Weekday().String()))
+		matched := false
+		for _, d := range w.Days {
+			if d == day {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+
+	start, errStart := parseClock(w.Start)
+	end, errEnd := parseClock(w.End)
+	if errStart != nil || errEnd != nil {
+		return false
+	}
+
+	cur := t.Hour()*60 + t.Minute()
+	return start <= cur && cur < end
+}
+
+// isCallable returns true if the given time is in one of the calling windows in every given location.
+// Returns true if the calling windows are empty.
+func isCallable(windows []campaign.CallingWindow, locations []*time.Location, now time.Time) bool {
+	if len(windows) == 0 {
+		return true
+	}
+
+	for _, loc := range locations {
+		local := now.In(loc)
+
+		open := false
+		for _, w := range windows {
+			if isInCallingWindow(w, local) {
+				open = true
+				break
+			}
+		}
+		if !open {
+			return false
+		}
+	}
+
+	return true
+}
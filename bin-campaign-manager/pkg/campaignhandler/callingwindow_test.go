@@ -0,0 +1,219 @@
+package campaignhandler
+
+import (
+	"testing"
+	"time"
+
+	"monorepo/bin-campaign-manager/models/campaign"
+)
+
+func Test_parseClock(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		clock string
+
+		expectRes int
+		expectErr bool
+	}{
+		{"midnight", "00:00", 0, false},
+		{"morning", "09:30", 570, false},
+		{"end of the day", "24:00", 1440, false},
+		{"hour out of range", "25:00", 0, true},
+		{"minute out of range", "09:60", 0, true},
+		{"no leading zero", "9:00", 540, false},
+		{"empty", "", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := parseClock(tt.clock)
+			if (err != nil) != tt.expectErr {
+				t.Errorf("Wrong match. expect error: %v, got: %v", tt.expectErr, err)
+			}
+
+			if res != tt.expectRes {
+				t.Errorf("Wrong match. expect: %d, got: %d", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_validateCallingWindows(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		windows  []campaign.CallingWindow
+		timezone string
+
+		expectErr bool
+	}{
+		{
+			name: "empty",
+
+			windows:  []campaign.CallingWindow{},
+			timezone: "",
+
+			expectErr: false,
+		},
+		{
+			name: "normal",
+
+			windows: []campaign.CallingWindow{
+				{Days: []campaign.Day{campaign.DayMonday, campaign.DayTuesday}, Start: "09:00", End: "21:00"},
+				{Days: []campaign.Day{campaign.DaySaturday}, Start: "10:00", End: "24:00"},
+			},
+			timezone: "Europe/London",
+
+			expectErr: false,
+		},
+		{
+			name: "invalid day",
+
+			windows: []campaign.CallingWindow{
+				{Days: []campaign.Day{"mon"}, Start: "09:00", End: "21:00"},
+			},
+
+			expectErr: true,
+		},
+		{
+			name: "invalid time format",
+
+			windows: []campaign.CallingWindow{
+				{Start: "9am", End: "21:00"},
+			},
+
+			expectErr: true,
+		},
+		{
+			name: "start is not earlier than end",
+
+			windows: []campaign.CallingWindow{
+				{Start: "21:00", End: "09:00"},
+			},
+
+			expectErr: true,
+		},
+		{
+			name: "invalid timezone",
+
+			windows:  []campaign.CallingWindow{},
+			timezone: "Mars/Olympus_Mons",
+
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateCallingWindows(tt.windows, tt.timezone)
+			if (err != nil) != tt.expectErr {
+				t.Errorf("Wrong match. expect error: %v, got: %v", tt.expectErr, err)
+			}
+		})
+	}
+}
+
+func Test_isCallable(t *testing.T) {
+
+	seoul, _ := time.LoadLocation("Asia/Seoul")
+	newYork, _ := time.LoadLocation("America/New_York")
+
+	tests := []struct {
+		name string
+
+		windows   []campaign.CallingWindow
+		locations []*time.Location
+		now       time.Time
+
+		expectRes bool
+	}{
+		{
+			name: "no windows",
+
+			windows:   []campaign.CallingWindow{},
+			locations: []*time.Location{seoul},
+			now:       time.Date(2026, 10, 19, 18, 0, 0, 0, time.UTC), // 03:00 monday in seoul
+
+			expectRes: true,
+		},
+		{
+			name: "in the window",
+
+			windows: []campaign.CallingWindow{
+				{Days: []campaign.Day{campaign.DayMonday}, Start: "09:00", End: "21:00"},
+			},
+			locations: []*time.Location{seoul},
+			now:       time.Date(2026, 10, 19, 1, 0, 0, 0, time.UTC), // 10:00 monday in seoul
+
+			expectRes: true,
+		},
+		{
+			name: "out of the hours",
+
+			windows: []campaign.CallingWindow{
+				{Start: "09:00", End: "21:00"},
+			},
+			locations: []*time.Location{seoul},
+			now:       time.Date(2026, 10, 19, 18, 0, 0, 0, time.UTC), // 03:00 tuesday in seoul
+
+			expectRes: false,
+		},
+		{
+			name: "out of the days",
+
+			windows: []campaign.CallingWindow{
+				{Days: []campaign.Day{campaign.DaySaturday, campaign.DaySunday}, Start: "09:00", End: "21:00"},
+			},
+			locations: []*time.Location{seoul},
+			now:       time.Date(2026, 10, 19, 1, 0, 0, 0, time.UTC), // 10:00 monday in seoul
+
+			expectRes: false,
+		},
+		{
+			name: "end is exclusive",
+
+			windows: []campaign.CallingWindow{
+				{Start: "09:00", End: "21:00"},
+			},
+			locations: []*time.Location{seoul},
+			now:       time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC), // 21:00 monday in seoul
+
+			expectRes: false,
+		},
+		{
+			name: "open in one of the windows",
+
+			windows: []campaign.CallingWindow{
+				{Days: []campaign.Day{campaign.DaySunday}, Start: "09:00", End: "12:00"},
+				{Days: []campaign.Day{campaign.DayMonday}, Start: "18:00", End: "24:00"},
+			},
+			locations: []*time.Location{seoul},
+			now:       time.Date(2026, 10, 19, 14, 59, 0, 0, time.UTC), // 23:59 monday in seoul
+
+			expectRes: true,
+		},
+		{
+			name: "closed in one of the locations",
+
+			windows: []campaign.CallingWindow{
+				{Start: "09:00", End: "21:00"},
+			},
+			locations: []*time.Location{seoul, newYork},
+			now:       time.Date(2026, 10, 19, 1, 0, 0, 0, time.UTC), // 10:00 in seoul, 21:00 in new york
+
+			expectRes: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := isCallable(tt.windows, tt.locations, tt.now)
+			if res != tt.expectRes {
+				t.Errorf("Wrong match. expect: %v, got: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
@@ -376,6 +376,74 @@ func (h *campaignHandler) UpdateServiceLevel(ctx context.Context, id uuid.UUID,
 	return res, nil
 }
 
+// UpdateDialMode updates campaign's dial_mode and max_abandon_rate
+func (h *campaignHandler) UpdateDialMode(ctx context.Context, id uuid.UUID, dialMode campaign.DialMode, maxAbandonRate int) (*campaign.Campaign, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":             "UpdateDialMode",
+		"id":               id,
+		"dial_mode":        dialMode,
+		"max_abandon_rate": maxAbandonRate,
+	})
+	log.Debug("Updating campaign dial_mode.")
+
+	c, err := h.Get(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get campaign. err: %v", err)
+		return nil, err
+	}
+
+	if errValidate := validateDialMode(c, dialMode, maxAbandonRate); errValidate != nil {
+		log.Errorf("Could not pass the dial mode validation. err: %v", errValidate)
+		return nil, errValidate
+	}
+
+	if err := h.db.CampaignUpdateDialMode(ctx, id, dialMode, maxAbandonRate); err != nil {
+		log.Errorf("Could not update campaign dial_mode. err: %v", err)
+		return nil, err
+	}
+
+	// get updated info
+	res, err := h.db.CampaignGet(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get updated campaign info. err: %v", err)
+		return nil, err
+	}
+	h.notifyHandler.PublishWebhookEvent(ctx, res.CustomerID, campaign.EventTypeCampaignUpdated, res)
+
+	return res, nil
+}
+
+// UpdateCallingWindows updates campaign's calling_windows and timezone
+func (h *campaignHandler) UpdateCallingWindows(ctx context.Context, id uuid.UUID, callingWindows []campaign.CallingWindow, timezone string) (*campaign.Campaign, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":            "UpdateCallingWindows",
+		"id":              id,
+		"calling_windows": callingWindows,
+		"timezone":        timezone,
+	})
+	log.Debug("Updating campaign calling_windows.")
+
+	if errValidate := validateCallingWindows(callingWindows, timezone); errValidate != nil {
+		log.Errorf("Could not pass the calling windows validation. err: %v", errValidate)
+		return nil, errValidate
+	}
+
+	if err := h.db.CampaignUpdateCallingWindows(ctx, id, callingWindows, timezone); err != nil {
+		log.Errorf("Could not update campaign calling_windows. err: %v", err)
+		return nil, err
+	}
+
+	// get updated info
+	res, err := h.db.CampaignGet(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get updated campaign info. err: %v", err)
+		return nil, err
+	}
+	h.notifyHandler.PublishWebhookEvent(ctx, res.CustomerID, campaign.EventTypeCampaignUpdated, res)
+
+	return res, nil
+}
+
 // UpdateActions updates campaign's actions
 func (h *campaignHandler) UpdateActions(ctx context.Context, id uuid.UUID, actions []fmaction.Action) (*campaign.Campaign, error) {
 	log := logrus.WithFields(logrus.Fields{
@@ -442,6 +510,31 @@ func (h *campaignHandler) createFlowActions(ctx context.Context, actions []fmact
 	return flowActions, nil
 }
 
+// validateDialMode returns error if the dial mode can not be applied to the campaign.
+func validateDialMode(c *campaign.Campaign, dialMode campaign.DialMode, maxAbandonRate int) error {
+	switch dialMode {
+	case campaign.DialModeNone, campaign.DialModePower:
+		// nothing to check
+
+	case campaign.DialModeProgressive, campaign.DialModePredictive, campaign.DialModePreview:
+		if c.QueueID == uuid.Nil {
+			return cerrors.InvalidArgument(commonoutline.ServiceNameCampaignManager, "CAMPAIGN_QUEUE_REQUIRED", "The dial mode requires the campaign's queue.")
+		}
+		if dialMode == campaign.DialModePreview && c.Type != campaign.TypeCall {
+			return cerrors.InvalidArgument(commonoutline.ServiceNameCampaignManager, "CAMPAIGN_TYPE_NOT_CALL", "The preview dial mode requires the call type campaign.")
+		}
+
+	default:
+		return cerrors.InvalidArgument(commonoutline.ServiceNameCampaignManager, "INVALID_DIAL_MODE", fmt.Sprintf("Unsupported dial mode. dial_mode: %s", dialMode))
+	}
+
+	if maxAbandonRate < 0 || maxAbandonRate > 100 {
+		return cerrors.InvalidArgument(commonoutline.ServiceNameCampaignManager, "INVALID_MAX_ABANDON_RATE", "The max abandon rate must be between 0 and 100.")
+	}
+
+	return nil
+}
+
 // updateExecuteStop updates the campaign execute to stop.
 // and it checks the campaign's stop-able then stops the campaign if it stop-able.
 // otherwise, stopping the campaign.
@@ -626,6 +626,249 @@ func Test_UpdateServiceLevel(t *testing.T) {
 	}
 }
 
+func Test_UpdateDialMode(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		id             uuid.UUID
+		dialMode       campaign.DialMode
+		maxAbandonRate int
+
+		responseCampaign *campaign.Campaign
+		response         *campaign.Campaign
+	}{
+		{
+			"predictive",
+
+			uuid.FromStringOrNil("3f1a2b3c-4e2b-11f0-8c1d-1a2b3c4d5e01"),
+			campaign.DialModePredictive,
+			5,
+
+			&campaign.Campaign{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("3f1a2b3c-4e2b-11f0-8c1d-1a2b3c4d5e01"),
+				},
+				Type:    campaign.TypeCall,
+				QueueID: uuid.FromStringOrNil("3f4b3c4d-4e2b-11f0-9d2e-2b3c4d5e6f02"),
+			},
+			&campaign.Campaign{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("3f1a2b3c-4e2b-11f0-8c1d-1a2b3c4d5e01"),
+					CustomerID: uuid.FromStringOrNil("3f7c4d5e-4e2b-11f0-ae3f-3c4d5e6f7a03"),
+				},
+				Type:           campaign.TypeCall,
+				QueueID:        uuid.FromStringOrNil("3f4b3c4d-4e2b-11f0-9d2e-2b3c4d5e6f02"),
+				DialMode:       campaign.DialModePredictive,
+				MaxAbandonRate: 5,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			h := &campaignHandler{
+				db:            mockDB,
+				notifyHandler: mockNotify,
+				reqHandler:    mockReq,
+			}
+
+			ctx := context.Background()
+
+			mockDB.EXPECT().CampaignGet(ctx, tt.id).Return(tt.responseCampaign, nil)
+			mockDB.EXPECT().CampaignUpdateDialMode(ctx, tt.id, tt.dialMode, tt.maxAbandonRate).Return(nil)
+			mockDB.EXPECT().CampaignGet(ctx, tt.id).Return(tt.response, nil)
+			mockNotify.EXPECT().PublishWebhookEvent(ctx, tt.response.CustomerID, campaign.EventTypeCampaignUpdated, tt.response)
+
+			res, err := h.UpdateDialMode(ctx, tt.id, tt.dialMode, tt.maxAbandonRate)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.response) != true {
+				t.Errorf("Wrong match.\nexpect: %v\n, got: %v\n", tt.response, res)
+			}
+		})
+	}
+}
+
+func Test_UpdateCallingWindows(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		id             uuid.UUID
+		callingWindows []campaign.CallingWindow
+		timezone       string
+
+		response *campaign.Campaign
+	}{
+		{
+			"normal",
+
+			uuid.FromStringOrNil("a2c1e0b4-adbb-11f0-8e61-5f0d7a3c9b01"),
+			[]campaign.CallingWindow{
+				{
+					Days:  []campaign.Day{campaign.DayMonday, campaign.DayFriday},
+					Start: "09:00",
+					End:   "21:00",
+				},
+			},
+			"America/New_York",
+
+			&campaign.Campaign{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("a2c1e0b4-adbb-11f0-8e61-5f0d7a3c9b01"),
+					CustomerID: uuid.FromStringOrNil("a2f0b7d6-adbb-11f0-9a0e-0b4c8d2e7f02"),
+				},
+				CallingWindows: []campaign.CallingWindow{
+					{
+						Days:  []campaign.Day{campaign.DayMonday, campaign.DayFriday},
+						Start: "09:00",
+						End:   "21:00",
+					},
+				},
+				Timezone: "America/New_York",
+			},
+		},
+		{
+			"empty",
+
+			uuid.FromStringOrNil("a31e6c4a-adbb-11f0-b7d3-2f6a9e1c4d03"),
+			[]campaign.CallingWindow{},
+			"",
+
+			&campaign.Campaign{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("a31e6c4a-adbb-11f0-b7d3-2f6a9e1c4d03"),
+					CustomerID: uuid.FromStringOrNil("a2f0b7d6-adbb-11f0-9a0e-0b4c8d2e7f02"),
+				},
+				CallingWindows: []campaign.CallingWindow{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+			h := &campaignHandler{
+				db:            mockDB,
+				notifyHandler: mockNotify,
+			}
+
+			ctx := context.Background()
+
+			mockDB.EXPECT().CampaignUpdateCallingWindows(ctx, tt.id, tt.callingWindows, tt.timezone).Return(nil)
+			mockDB.EXPECT().CampaignGet(ctx, tt.id).Return(tt.response, nil)
+			mockNotify.EXPECT().PublishWebhookEvent(ctx, tt.response.CustomerID, campaign.EventTypeCampaignUpdated, tt.response)
+
+			res, err := h.UpdateCallingWindows(ctx, tt.id, tt.callingWindows, tt.timezone)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.response) != true {
+				t.Errorf("Wrong match.\nexpect: %v\n, got: %v\n", tt.response, res)
+			}
+		})
+	}
+}
+
+func Test_validateDialMode(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		campaign       *campaign.Campaign
+		dialMode       campaign.DialMode
+		maxAbandonRate int
+
+		expectErr bool
+	}{
+		{
+			name: "power without queue",
+
+			campaign:       &campaign.Campaign{},
+			dialMode:       campaign.DialModePower,
+			maxAbandonRate: 0,
+
+			expectErr: false,
+		},
+		{
+			name: "predictive without queue",
+
+			campaign:       &campaign.Campaign{},
+			dialMode:       campaign.DialModePredictive,
+			maxAbandonRate: 3,
+
+			expectErr: true,
+		},
+		{
+			name: "preview with flow type",
+
+			campaign: &campaign.Campaign{
+				Type:    campaign.TypeFlow,
+				QueueID: uuid.FromStringOrNil("5a1b2c3d-4e2b-11f0-8f4a-4d5e6f7a8b04"),
+			},
+			dialMode:       campaign.DialModePreview,
+			maxAbandonRate: 0,
+
+			expectErr: true,
+		},
+		{
+			name: "preview with call type",
+
+			campaign: &campaign.Campaign{
+				Type:    campaign.TypeCall,
+				QueueID: uuid.FromStringOrNil("5a1b2c3d-4e2b-11f0-8f4a-4d5e6f7a8b04"),
+			},
+			dialMode:       campaign.DialModePreview,
+			maxAbandonRate: 0,
+
+			expectErr: false,
+		},
+		{
+			name: "unsupported dial mode",
+
+			campaign:       &campaign.Campaign{},
+			dialMode:       campaign.DialMode("unknown"),
+			maxAbandonRate: 0,
+
+			expectErr: true,
+		},
+		{
+			name: "invalid max abandon rate",
+
+			campaign: &campaign.Campaign{
+				QueueID: uuid.FromStringOrNil("5a1b2c3d-4e2b-11f0-8f4a-4d5e6f7a8b04"),
+			},
+			dialMode:       campaign.DialModePredictive,
+			maxAbandonRate: 101,
+
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateDialMode(tt.campaign, tt.dialMode, tt.maxAbandonRate)
+			if (err != nil) != tt.expectErr {
+				t.Errorf("Wrong match. expect error: %v, got: %v", tt.expectErr, err)
+			}
+		})
+	}
+}
+
 func Test_UpdateActions(t *testing.T) {
 
 	tests := []struct {
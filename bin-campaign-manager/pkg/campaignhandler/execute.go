@@ -9,8 +9,7 @@ import (
 	"monorepo/bin-flow-manager/models/activeflow"
 
 	omoutdialtarget "monorepo/bin-outdial-manager/models/outdialtarget"
-
-	amagent "monorepo/bin-agent-manager/models/agent"
+	omsuppressionblock "monorepo/bin-outdial-manager/models/suppressionblock"
 
 	"github.com/gofrs/uuid"
 	"github.com/sirupsen/logrus"
@@ -20,6 +19,10 @@ import (
 	"monorepo/bin-campaign-manager/models/outplan"
 )
 
+const (
+	callbackDeferInterval = time.Minute * 10 // postponing interval of the scheduled callback out of the calling windows
+)
+
 // Execute executes the campaign.
 func (h *campaignHandler) Execute(ctx context.Context, id uuid.UUID) {
 	log := logrus.WithFields(logrus.Fields{
@@ -86,7 +89,7 @@ func (h *campaignHandler) Execute(ctx context.Context, id uuid.UUID) {
 	}
 
 	// check the campaign is dial-able
-	if !h.isDialable(ctx, c.ID, c.QueueID, c.ServiceLevel) {
+	if !h.isDialable(ctx, c) {
 		log.Debugf("Campaign is not dialable now.")
 
 		// send an execute request with 5 seconds of delay
@@ -123,11 +126,24 @@ func (h *campaignHandler) Execute(ctx context.Context, id uuid.UUID) {
 		return
 	}
 
+	// check the target is in the calling windows
+	if len(c.CallingWindows) > 0 && !isCallable(c.CallingWindows, getTargetLocations(c, target, destination), *h.util.TimeNow()) {
+		log.Debugf("The target is out of the calling windows. Deferring the target. target_id: %s", target.ID)
+		h.deferTarget(ctx, target)
+
+		// send a campaign execute request with 500ms delay for the next target
+		_ = h.reqHandler.CampaignV1CampaignExecute(ctx, id, 500)
+		return
+	}
+
 	var cc *campaigncall.Campaigncall
-	switch c.Type {
-	case campaign.TypeCall:
+	switch {
+	case getDialMode(c) == campaign.DialModePreview, c.Type == campaign.TypeCall && target.CallbackAgentID != uuid.Nil:
+		// the callback for the specific agent waits for the agent's accept regardless of the dial mode.
+		cc, err = h.executePreview(ctx, c, p, target, destination, destinationIndex, tryCount)
+	case c.Type == campaign.TypeCall:
 		cc, err = h.executeCall(ctx, c, p, target, destination, destinationIndex, tryCount)
-	case campaign.TypeFlow:
+	case c.Type == campaign.TypeFlow:
 		cc, err = h.executeFlow(ctx, c, p, target, destination, destinationIndex, tryCount)
 	}
 	if err != nil {
@@ -149,7 +165,9 @@ func (h *campaignHandler) Execute(ctx context.Context, id uuid.UUID) {
 	}
 }
 
-// getTarget returns target for dialing
+// getTarget returns target for dialing.
+// The target whose next destination is in the customer's suppression lists is finished without dialing,
+// and the next available target is returned instead.
 func (h *campaignHandler) getTarget(ctx context.Context, c *campaign.Campaign, p *outplan.Outplan) (*omoutdialtarget.OutdialTarget, error) {
 	log := logrus.WithFields(logrus.Fields{
 		"func":     "getTarget",
@@ -157,34 +175,96 @@ func (h *campaignHandler) getTarget(ctx context.Context, c *campaign.Campaign, p
 		"outplan":  p,
 	})
 
-	// get available outdial target
-	targets, err := h.reqHandler.OutdialV1OutdialtargetGetsAvailable(
-		ctx,
-		c.OutdialID,
-		p.MaxTryCount0,
-		p.MaxTryCount1,
-		p.MaxTryCount2,
-		p.MaxTryCount3,
-		p.MaxTryCount4,
-		1,
-	)
-	if err != nil {
-		log.Errorf("Could not get available outdial target. Stopping the campaign. err: %v", err)
-		return nil, err
+	for {
+		// get available outdial target
+		targets, err := h.reqHandler.OutdialV1OutdialtargetGetsAvailable(
+			ctx,
+			c.OutdialID,
+			p.MaxTryCount0,
+			p.MaxTryCount1,
+			p.MaxTryCount2,
+			p.MaxTryCount3,
+			p.MaxTryCount4,
+			1,
+		)
+		if err != nil {
+			log.Errorf("Could not get available outdial target. Stopping the campaign. err: %v", err)
+			return nil, err
+		}
+
+		if len(targets) == 0 {
+			return nil, nil
+		}
+		res := targets[0]
+
+		suppressed, err := h.isSuppressedTarget(ctx, c, &res, p)
+		if err != nil {
+			log.Errorf("Could not check the target's suppression. err: %v", err)
+			return nil, err
+		}
+		if !suppressed {
+			return &res, nil
+		}
+
+		log.Infof("The target is in the suppression lists. Finishing the target without dialing. target_id: %s", res.ID)
+		promCampaignTargetSuppressedTotal.Inc()
+		if res.TMCallback != nil {
+			// the target with a pending callback would go back to idle instead of done.
+			if _, errCallback := h.reqHandler.OutdialV1OutdialtargetUpdateCallback(ctx, res.ID, nil, uuid.Nil); errCallback != nil {
+				log.Errorf("Could not cancel the suppressed target's callback. err: %v", errCallback)
+				return nil, errCallback
+			}
+		}
+		if _, errUpdate := h.reqHandler.OutdialV1OutdialtargetUpdateStatus(ctx, res.ID, omoutdialtarget.StatusDone); errUpdate != nil {
+			log.Errorf("Could not finish the suppressed target. err: %v", errUpdate)
+			return nil, errUpdate
+		}
 	}
+}
 
-	if len(targets) == 0 {
-		return nil, nil
+// isSuppressedTarget returns true if the target's next destination is in the customer's suppression lists.
+// Only the tel type destination is subject to the suppression.
+func (h *campaignHandler) isSuppressedTarget(ctx context.Context, c *campaign.Campaign, target *omoutdialtarget.OutdialTarget, p *outplan.Outplan) (bool, error) {
+	destination, _, _ := h.getTargetDestination(ctx, target, p)
+	if destination == nil || destination.Type != commonaddress.TypeTel {
+		return false, nil
 	}
 
-	res := targets[0]
-	return &res, nil
+	return h.reqHandler.OutdialV1SuppressionIsSuppressed(ctx, c.CustomerID, destination.Target, omsuppressionblock.ReferenceTypeCampaign, c.ID)
+}
+
+// deferTarget puts the target back to the end of the available targets without the try count change.
+// The target is retried after the outplan's try interval.
+// The scheduled callback is postponed by the callbackDeferInterval instead, so it does not block the other targets.
+func (h *campaignHandler) deferTarget(ctx context.Context, target *omoutdialtarget.OutdialTarget) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":      "deferTarget",
+		"target_id": target.ID,
+	})
+
+	promCampaignTargetDeferredTotal.Inc()
+	if target.TMCallback != nil {
+		tmCallback := h.util.TimeNow().Add(callbackDeferInterval)
+		if _, err := h.reqHandler.OutdialV1OutdialtargetUpdateCallback(ctx, target.ID, &tmCallback, target.CallbackAgentID); err != nil {
+			log.Errorf("Could not defer the target's callback. err: %v", err)
+		}
+		return
+	}
+
+	if _, err := h.reqHandler.OutdialV1OutdialtargetUpdateStatus(ctx, target.ID, omoutdialtarget.StatusIdle); err != nil {
+		log.Errorf("Could not defer the target. err: %v", err)
+	}
 }
 
 // getDestination returns outdialtarget and target address.
 // returns outdialtarget, destination, destinationindex, trycount, error
 func (h *campaignHandler) isDialableTarget(ctx context.Context, target *omoutdialtarget.OutdialTarget, interval int) bool {
 
+	// the scheduled callback is due. the available targets never contain the callback not due yet.
+	if target.TMCallback != nil {
+		return true
+	}
+
 	// is the target never tried before
 	if (target.TMCreate == nil && target.TMUpdate == nil) || (target.TMCreate != nil && target.TMUpdate != nil && target.TMCreate.Equal(*target.TMUpdate)) {
 		return true
@@ -276,6 +356,58 @@ func (h *campaignHandler) executeCall(
 	return cc, nil
 }
 
+// executePreview creates a new campaigncall waiting for an agent's accept.
+// The call is made when an agent accepts the campaigncall.
+// The callback for the specific agent is reserved for the agent.
+func (h *campaignHandler) executePreview(
+	ctx context.Context,
+	c *campaign.Campaign,
+	p *outplan.Outplan,
+	target *omoutdialtarget.OutdialTarget,
+	destination *commonaddress.Address,
+	destinationIndex int,
+	tryCount int,
+) (*campaigncall.Campaigncall, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":              "executePreview",
+		"campaign":          c,
+		"target":            target,
+		"destination_index": destinationIndex,
+	})
+	log.Debug("Execute executePreview.")
+
+	// create call_id
+	callID := h.util.UUIDCreate()
+	activeflowID := h.util.UUIDCreate()
+
+	cc, err := h.campaigncallHandler.CreatePreview(
+		ctx,
+		c.CustomerID,
+		c.ID,
+		c.OutplanID,
+		c.OutdialID,
+		target.ID,
+		c.QueueID,
+		target.CallbackAgentID,
+		target.TMCallback,
+
+		activeflowID,
+		c.FlowID,
+
+		callID,
+		p.Source,
+		destination,
+		destinationIndex,
+		tryCount,
+	)
+	if err != nil {
+		log.Errorf("Could not create a previewing campaigncall. err: %v", err)
+		return nil, err
+	}
+
+	return cc, nil
+}
+
 // executeFlow creates a new campaigncall for referencetype flow.
 func (h *campaignHandler) executeFlow(
 	ctx context.Context,
@@ -367,47 +499,39 @@ func (h *campaignHandler) executeFlow(
 }
 
 // isDialable returns true if a given campaign is dial-able
-func (h *campaignHandler) isDialable(ctx context.Context, campaignID uuid.UUID, queueID uuid.UUID, serviceLevel int) bool {
+func (h *campaignHandler) isDialable(ctx context.Context, c *campaign.Campaign) bool {
 	log := logrus.WithFields(logrus.Fields{
-		"func":          "isDialable",
-		"campaign_id":   campaignID,
-		"queue_id":      queueID,
-		"service_level": serviceLevel,
+		"func":        "isDialable",
+		"campaign_id": c.ID,
+		"queue_id":    c.QueueID,
+		"dial_mode":   c.DialMode,
 	})
 	log.Debug("Checking the campaign is dial-able.")
 
-	if queueID == uuid.Nil {
-		// the campaign has no queue_id.
+	if c.QueueID == uuid.Nil {
+		// the campaign has no queue_id. nothing to pace against,
+		// but the previewings of the callbacks reserved for agents still need to be swept.
+		if _, err := h.getPreviewings(ctx, c.ID); err != nil {
+			log.Errorf("Could not get previewing campaigncalls. err: %v", err)
+		}
 		return true
 	}
 
-	// get available agents
-	filters := map[amagent.Field]any{
-		amagent.FieldStatus: amagent.StatusAvailable,
-	}
-	agents, err := h.reqHandler.QueueV1QueueGetAgents(ctx, queueID, filters)
-	if err != nil {
-		log.Errorf("Could not get available agents. err: %v", err)
-		return false
-	}
-
-	// get queuecall dialings
-	dialings, err := h.campaigncallHandler.ListByCampaignIDAndStatus(ctx, campaignID, campaigncall.StatusDialing, "", 100)
+	pacing, err := h.getPacing(ctx, c)
 	if err != nil {
-		log.Errorf("Could not get campaigncalls. err: %v", err)
+		log.Errorf("Could not get the campaign pacing. err: %v", err)
 		return false
 	}
+	h.publishPacing(ctx, pacing)
 
 	// calculate the capacity
-	dialingLen := len(dialings)
-	agentCapacity := (len(agents) * serviceLevel) / 100.0
-
-	if agentCapacity <= dialingLen {
+	ongoing := pacing.Dialing + pacing.Previewing
+	if pacing.Capacity <= ongoing {
 		// currerntly the campaign has enough number of dialings already
-		log.Debugf("The campaign capacity is not enough. agent_capacity: %d, dialing_len: %d", agentCapacity, dialingLen)
+		log.Debugf("The campaign capacity is not enough. capacity: %d, ongoing: %d", pacing.Capacity, ongoing)
 		return false
 	}
-	log.Debugf("The campaign is dialable. agent_capacity: %d, dialing_len: %d", int(agentCapacity), dialingLen)
+	log.Debugf("The campaign is dialable. capacity: %d, ongoing: %d, dial_ratio: %f", pacing.Capacity, ongoing, pacing.DialRatio)
 
 	return true
 }
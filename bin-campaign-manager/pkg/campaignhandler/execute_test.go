@@ -15,6 +15,7 @@ import (
 	"monorepo/bin-flow-manager/models/activeflow"
 
 	omoutdialtarget "monorepo/bin-outdial-manager/models/outdialtarget"
+	omsuppressionblock "monorepo/bin-outdial-manager/models/suppressionblock"
 
 	amagent "monorepo/bin-agent-manager/models/agent"
 
@@ -136,6 +137,8 @@ func Test_ExecuteWithTypeFlow(t *testing.T) {
 				tt.responseOutplan.MaxTryCount4,
 				1,
 			).Return(tt.responseOmoutdialtarget, nil)
+			mockReq.EXPECT().OutdialV1SuppressionIsSuppressed(ctx, tt.responseCampaign.CustomerID, "+821100000001", omsuppressionblock.ReferenceTypeCampaign, tt.responseCampaign.ID).Return(false, nil)
+			mockCampaigncall.EXPECT().ListByCampaignIDAndStatus(ctx, tt.responseCampaign.ID, campaigncall.StatusPreviewing, "", uint64(pacingListLimit)).Return([]*campaigncall.Campaigncall{}, nil)
 
 			// executeFlow
 			mockUtil.EXPECT().UUIDCreate().Return(tt.responseUUID)
@@ -181,6 +184,158 @@ func Test_ExecuteWithTypeFlow(t *testing.T) {
 	}
 }
 
+func Test_ExecuteOutOfCallingWindows(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		id uuid.UUID
+
+		responseCampaign        *campaign.Campaign
+		responseOutplan         *outplan.Outplan
+		responseOmoutdialtarget []omoutdialtarget.OutdialTarget
+		responseCurTime         *time.Time
+	}{
+		{
+			name: "out of the destination's local calling window",
+
+			id: uuid.FromStringOrNil("c4d1e2a0-adbb-11f0-a6b2-7f3e9c1d5a01"),
+
+			responseCampaign: &campaign.Campaign{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("c4d1e2a0-adbb-11f0-a6b2-7f3e9c1d5a01"),
+				},
+				OutdialID: uuid.FromStringOrNil("c50a6f3e-adbb-11f0-8d4c-1b7e2f9a3c02"),
+				OutplanID: uuid.FromStringOrNil("c53c8b7a-adbb-11f0-b9e1-5d2a8c4f6e03"),
+				Status:    campaign.StatusRun,
+				Type:      campaign.TypeFlow,
+				CallingWindows: []campaign.CallingWindow{
+					{Start: "09:00", End: "21:00"},
+				},
+				Timezone: "UTC",
+			},
+			responseOutplan: &outplan.Outplan{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("c53c8b7a-adbb-11f0-b9e1-5d2a8c4f6e03"),
+				},
+				MaxTryCount0: 4,
+			},
+			responseOmoutdialtarget: []omoutdialtarget.OutdialTarget{
+				{
+					ID: uuid.FromStringOrNil("c56e1d2c-adbb-11f0-9f7a-3e6b1d8c2a04"),
+					Destination0: &commonaddress.Address{
+						Type:   commonaddress.TypeTel,
+						Target: "+821100000001",
+					},
+				},
+			},
+			responseCurTime: func() *time.Time {
+				res := time.Date(2026, 10, 19, 18, 0, 0, 0, time.UTC) // 03:00 in seoul
+				return &res
+			}(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockOutplan := outplanhandler.NewMockOutplanHandler(mc)
+			mockCampaigncall := campaigncallhandler.NewMockCampaigncallHandler(mc)
+			h := &campaignHandler{
+				util:                mockUtil,
+				db:                  mockDB,
+				reqHandler:          mockReq,
+				campaigncallHandler: mockCampaigncall,
+				outplanHandler:      mockOutplan,
+			}
+			ctx := context.Background()
+
+			mockDB.EXPECT().CampaignGet(ctx, tt.id).Return(tt.responseCampaign, nil)
+			mockOutplan.EXPECT().Get(ctx, tt.responseCampaign.OutplanID).Return(tt.responseOutplan, nil)
+			mockReq.EXPECT().OutdialV1OutdialtargetGetsAvailable(
+				ctx,
+				tt.responseCampaign.OutdialID,
+				tt.responseOutplan.MaxTryCount0,
+				tt.responseOutplan.MaxTryCount1,
+				tt.responseOutplan.MaxTryCount2,
+				tt.responseOutplan.MaxTryCount3,
+				tt.responseOutplan.MaxTryCount4,
+				1,
+			).Return(tt.responseOmoutdialtarget, nil)
+			mockReq.EXPECT().OutdialV1SuppressionIsSuppressed(ctx, tt.responseCampaign.CustomerID, "+821100000001", omsuppressionblock.ReferenceTypeCampaign, tt.responseCampaign.ID).Return(false, nil)
+			mockCampaigncall.EXPECT().ListByCampaignIDAndStatus(ctx, tt.responseCampaign.ID, campaigncall.StatusPreviewing, "", uint64(pacingListLimit)).Return([]*campaigncall.Campaigncall{}, nil)
+
+			// calling windows
+			mockUtil.EXPECT().TimeNow().Return(tt.responseCurTime)
+			mockReq.EXPECT().OutdialV1OutdialtargetUpdateStatus(ctx, tt.responseOmoutdialtarget[0].ID, omoutdialtarget.StatusIdle).Return(&tt.responseOmoutdialtarget[0], nil)
+			mockReq.EXPECT().CampaignV1CampaignExecute(ctx, tt.id, 500).Return(nil)
+
+			h.Execute(ctx, tt.id)
+		})
+	}
+}
+
+func Test_deferTarget(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		target          *omoutdialtarget.OutdialTarget
+		responseCurTime *time.Time
+
+		expectTMCallback *time.Time
+	}{
+		{
+			name: "normal target goes back to idle",
+
+			target: &omoutdialtarget.OutdialTarget{
+				ID: uuid.FromStringOrNil("9a3e5c70-4fa2-11f1-8d4f-3b4c5d6e7f80"),
+			},
+		},
+		{
+			name: "scheduled callback is postponed",
+
+			target: &omoutdialtarget.OutdialTarget{
+				ID:              uuid.FromStringOrNil("9a7f6da2-4fa2-11f1-9e50-4c5d6e7f8091"),
+				CallbackAgentID: uuid.FromStringOrNil("9ab47ed4-4fa2-11f1-af61-5d6e7f8091a2"),
+				TMCallback:      timePtr(time.Date(2026, 10, 22, 15, 0, 0, 0, time.UTC)),
+			},
+			responseCurTime: timePtr(time.Date(2026, 10, 22, 15, 0, 30, 0, time.UTC)),
+
+			expectTMCallback: timePtr(time.Date(2026, 10, 22, 15, 10, 30, 0, time.UTC)),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			h := &campaignHandler{
+				util:       mockUtil,
+				reqHandler: mockReq,
+			}
+			ctx := context.Background()
+
+			if tt.target.TMCallback != nil {
+				mockUtil.EXPECT().TimeNow().Return(tt.responseCurTime)
+				mockReq.EXPECT().OutdialV1OutdialtargetUpdateCallback(ctx, tt.target.ID, tt.expectTMCallback, tt.target.CallbackAgentID).Return(tt.target, nil)
+			} else {
+				mockReq.EXPECT().OutdialV1OutdialtargetUpdateStatus(ctx, tt.target.ID, omoutdialtarget.StatusIdle).Return(tt.target, nil)
+			}
+
+			h.deferTarget(ctx, tt.target)
+		})
+	}
+}
+
 func Test_getTarget(t *testing.T) {
 
 	tests := []struct {
@@ -291,6 +446,144 @@ func Test_getTarget(t *testing.T) {
 				tt.p.MaxTryCount4,
 				1,
 			).Return(tt.responseOutdialtarget, nil)
+			if len(tt.responseOutdialtarget) > 0 {
+				mockReq.EXPECT().OutdialV1SuppressionIsSuppressed(ctx, tt.c.CustomerID, tt.responseOutdialtarget[0].Destination0.Target, omsuppressionblock.ReferenceTypeCampaign, tt.c.ID).Return(false, nil)
+			}
+
+			res, err := h.getTarget(ctx, tt.c, tt.p)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.expectRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v\n", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_getTarget_suppressed(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		c *campaign.Campaign
+		p *outplan.Outplan
+
+		responseSuppressedTarget omoutdialtarget.OutdialTarget
+		responseNextTarget       omoutdialtarget.OutdialTarget
+
+		expectRes *omoutdialtarget.OutdialTarget
+	}{
+		{
+			"normal",
+
+			&campaign.Campaign{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("5b0d3a1e-3c1f-11f1-9b0e-4f2d0c6f2a11"),
+					CustomerID: uuid.FromStringOrNil("5b3a4f5c-3c1f-11f1-8f6d-1b7c2e9d3a22"),
+				},
+				OutdialID: uuid.FromStringOrNil("5b6590a4-3c1f-11f1-a0f2-3f8e4b1c5d33"),
+				Status:    campaign.StatusRun,
+				Type:      campaign.TypeFlow,
+			},
+			&outplan.Outplan{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("5b8f6c2e-3c1f-11f1-b4a7-7d1e5f2a6e44"),
+				},
+				MaxTryCount0: 4,
+			},
+
+			omoutdialtarget.OutdialTarget{
+				ID: uuid.FromStringOrNil("5bb8d1f0-3c1f-11f1-9c3b-0a6f8d3b7f55"),
+				Destination0: &commonaddress.Address{
+					Type:   commonaddress.TypeTel,
+					Target: "+821100000001",
+				},
+			},
+			omoutdialtarget.OutdialTarget{
+				ID: uuid.FromStringOrNil("5be2f4a8-3c1f-11f1-86e1-2c7a9e4c8a66"),
+				Destination0: &commonaddress.Address{
+					Type:   commonaddress.TypeTel,
+					Target: "+821100000002",
+				},
+			},
+
+			&omoutdialtarget.OutdialTarget{
+				ID: uuid.FromStringOrNil("5be2f4a8-3c1f-11f1-86e1-2c7a9e4c8a66"),
+				Destination0: &commonaddress.Address{
+					Type:   commonaddress.TypeTel,
+					Target: "+821100000002",
+				},
+			},
+		},
+		{
+			"suppressed target with the scheduled callback",
+
+			&campaign.Campaign{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("1f2a6c3e-ae69-11f1-8d4b-3a7e1c9f5b01"),
+					CustomerID: uuid.FromStringOrNil("1f5d8e70-ae69-11f1-9c2e-6b1f4d8a2c02"),
+				},
+				OutdialID: uuid.FromStringOrNil("1f90b0a2-ae69-11f1-a7f1-2e8c5b3d7a03"),
+				Status:    campaign.StatusRun,
+				Type:      campaign.TypeCall,
+			},
+			&outplan.Outplan{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("1fc3d2d4-ae69-11f1-b5a3-7d2f9e1c4b04"),
+				},
+				MaxTryCount0: 4,
+			},
+
+			omoutdialtarget.OutdialTarget{
+				ID: uuid.FromStringOrNil("1ff6f506-ae69-11f1-86d8-4c9a2e7f1d05"),
+				Destination0: &commonaddress.Address{
+					Type:   commonaddress.TypeTel,
+					Target: "+821100000001",
+				},
+				CallbackAgentID: uuid.FromStringOrNil("202a1738-ae69-11f1-9e4c-8b3d1f6a2e06"),
+				TMCallback:      timePtr(time.Date(2026, 10, 22, 15, 0, 0, 0, time.UTC)),
+			},
+			omoutdialtarget.OutdialTarget{
+				ID: uuid.FromStringOrNil("205d396a-ae69-11f1-a1b7-5e4c8d2f9a07"),
+				Destination0: &commonaddress.Address{
+					Type:   commonaddress.TypeTel,
+					Target: "+821100000002",
+				},
+			},
+
+			&omoutdialtarget.OutdialTarget{
+				ID: uuid.FromStringOrNil("205d396a-ae69-11f1-a1b7-5e4c8d2f9a07"),
+				Destination0: &commonaddress.Address{
+					Type:   commonaddress.TypeTel,
+					Target: "+821100000002",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			h := &campaignHandler{
+				reqHandler: mockReq,
+			}
+
+			ctx := context.Background()
+
+			mockReq.EXPECT().OutdialV1OutdialtargetGetsAvailable(ctx, tt.c.OutdialID, tt.p.MaxTryCount0, tt.p.MaxTryCount1, tt.p.MaxTryCount2, tt.p.MaxTryCount3, tt.p.MaxTryCount4, 1).Return([]omoutdialtarget.OutdialTarget{tt.responseSuppressedTarget}, nil)
+			mockReq.EXPECT().OutdialV1SuppressionIsSuppressed(ctx, tt.c.CustomerID, tt.responseSuppressedTarget.Destination0.Target, omsuppressionblock.ReferenceTypeCampaign, tt.c.ID).Return(true, nil)
+			if tt.responseSuppressedTarget.TMCallback != nil {
+				mockReq.EXPECT().OutdialV1OutdialtargetUpdateCallback(ctx, tt.responseSuppressedTarget.ID, nil, uuid.Nil).Return(&tt.responseSuppressedTarget, nil)
+			}
+			mockReq.EXPECT().OutdialV1OutdialtargetUpdateStatus(ctx, tt.responseSuppressedTarget.ID, omoutdialtarget.StatusDone).Return(&tt.responseSuppressedTarget, nil)
+
+			mockReq.EXPECT().OutdialV1OutdialtargetGetsAvailable(ctx, tt.c.OutdialID, tt.p.MaxTryCount0, tt.p.MaxTryCount1, tt.p.MaxTryCount2, tt.p.MaxTryCount3, tt.p.MaxTryCount4, 1).Return([]omoutdialtarget.OutdialTarget{tt.responseNextTarget}, nil)
+			mockReq.EXPECT().OutdialV1SuppressionIsSuppressed(ctx, tt.c.CustomerID, tt.responseNextTarget.Destination0.Target, omsuppressionblock.ReferenceTypeCampaign, tt.c.ID).Return(false, nil)
 
 			res, err := h.getTarget(ctx, tt.c, tt.p)
 			if err != nil {
@@ -365,6 +658,24 @@ func Test_isDialableTarget(t *testing.T) {
 
 			false,
 		},
+		{
+			"scheduled callback is due",
+
+			&omoutdialtarget.OutdialTarget{
+				ID: uuid.FromStringOrNil("1771246a-c3ff-11ec-8cf4-9fb7fc5301a8"),
+				Destination0: &commonaddress.Address{
+					Type:   commonaddress.TypeTel,
+					Target: "+821100000001",
+				},
+				TryCount0:  1,
+				TMCreate:   timePtr(time.Date(2022, 4, 18, 3, 0, 17, 995000000, time.UTC)),
+				TMUpdate:   timePtr(time.Date(2022, 4, 18, 3, 22, 17, 995000000, time.UTC)),
+				TMCallback: timePtr(time.Date(2022, 4, 18, 3, 30, 0, 0, time.UTC)),
+			},
+			315360000000, // 10 years
+
+			true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -542,9 +853,7 @@ func Test_isDialable(t *testing.T) {
 	tests := []struct {
 		name string
 
-		campaignID   uuid.UUID
-		queueID      uuid.UUID
-		serviceLevel int
+		campaign *campaign.Campaign
 
 		responseAgents        []amagent.Agent
 		responseCampaingcalls []*campaigncall.Campaigncall
@@ -552,22 +861,55 @@ func Test_isDialable(t *testing.T) {
 		expectRes bool
 	}{
 		{
-			"normal",
+			name: "power",
 
-			uuid.FromStringOrNil("54bbbdce-c406-11ec-b272-0b23f3d17d40"),
-			uuid.FromStringOrNil("54f6574a-c406-11ec-b9a9-3b91cde12b94"),
-			100,
+			campaign: &campaign.Campaign{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("54bbbdce-c406-11ec-b272-0b23f3d17d40"),
+				},
+				QueueID:      uuid.FromStringOrNil("54f6574a-c406-11ec-b9a9-3b91cde12b94"),
+				ServiceLevel: 100,
+			},
 
-			[]amagent.Agent{
+			responseAgents: []amagent.Agent{
 				{
 					Identity: commonidentity.Identity{
 						ID: uuid.FromStringOrNil("c1ec0278-c406-11ec-8804-c738ff121aa6"),
 					},
 				},
 			},
-			[]*campaigncall.Campaigncall{},
+			responseCampaingcalls: []*campaigncall.Campaigncall{},
 
-			true,
+			expectRes: true,
+		},
+		{
+			name: "progressive has no capacity",
+
+			campaign: &campaign.Campaign{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("0e6a2c4e-4e2a-11f0-8b1d-6f2e3a4b5c01"),
+				},
+				QueueID:      uuid.FromStringOrNil("0e9b3d5f-4e2a-11f0-9c2e-7a3f4b5c6d02"),
+				ServiceLevel: 300,
+				DialMode:     campaign.DialModeProgressive,
+			},
+
+			responseAgents: []amagent.Agent{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("0ec84e60-4e2a-11f0-a3f4-8b4a5c6d7e03"),
+					},
+				},
+			},
+			responseCampaingcalls: []*campaigncall.Campaigncall{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("0ef55f71-4e2a-11f0-b4a5-9c5b6d7e8f04"),
+					},
+				},
+			},
+
+			expectRes: false,
 		},
 	}
 
@@ -576,12 +918,14 @@ func Test_isDialable(t *testing.T) {
 			mc := gomock.NewController(t)
 			defer mc.Finish()
 
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
 			mockDB := dbhandler.NewMockDBHandler(mc)
 			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
 			mockReq := requesthandler.NewMockRequestHandler(mc)
 			mockOutplan := outplanhandler.NewMockOutplanHandler(mc)
 			mockCampaigncall := campaigncallhandler.NewMockCampaigncallHandler(mc)
 			h := &campaignHandler{
+				util:                mockUtil,
 				db:                  mockDB,
 				notifyHandler:       mockNotify,
 				reqHandler:          mockReq,
@@ -591,10 +935,13 @@ func Test_isDialable(t *testing.T) {
 
 			ctx := context.Background()
 
-			mockReq.EXPECT().QueueV1QueueGetAgents(ctx, tt.queueID, gomock.Any()).Return(tt.responseAgents, nil)
-			mockCampaigncall.EXPECT().ListByCampaignIDAndStatus(ctx, tt.campaignID, campaigncall.StatusDialing, gomock.Any(), uint64(100)).Return(tt.responseCampaingcalls, nil)
+			mockUtil.EXPECT().TimeNow().Return(timePtr(time.Now()))
+			mockReq.EXPECT().QueueV1QueueGetAgents(ctx, tt.campaign.QueueID, gomock.Any()).Return(tt.responseAgents, nil)
+			mockCampaigncall.EXPECT().ListByCampaignIDAndStatus(ctx, tt.campaign.ID, campaigncall.StatusDialing, gomock.Any(), uint64(100)).Return(tt.responseCampaingcalls, nil)
+			mockCampaigncall.EXPECT().ListByCampaignIDAndStatus(ctx, tt.campaign.ID, campaigncall.StatusPreviewing, gomock.Any(), uint64(100)).Return([]*campaigncall.Campaigncall{}, nil)
+			mockNotify.EXPECT().PublishWebhookEvent(ctx, tt.campaign.CustomerID, campaign.EventTypeCampaignPacing, gomock.Any())
 
-			res := h.isDialable(ctx, tt.campaignID, tt.queueID, tt.serviceLevel)
+			res := h.isDialable(ctx, tt.campaign)
 
 			if !reflect.DeepEqual(res, tt.expectRes) {
 				t.Errorf("Wrong match.\nexpect: %v\ngot: %v\n", tt.expectRes, res)
@@ -606,3 +953,150 @@ func Test_isDialable(t *testing.T) {
 func timePtr(t time.Time) *time.Time {
 	return &t
 }
+
+func Test_executePreview(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		campaign         *campaign.Campaign
+		outplan          *outplan.Outplan
+		target           *omoutdialtarget.OutdialTarget
+		destination      *commonaddress.Address
+		destinationIndex int
+		tryCount         int
+
+		responseCallID       uuid.UUID
+		responseActiveflowID uuid.UUID
+		responseCampaigncall *campaigncall.Campaigncall
+	}{
+		{
+			name: "normal",
+
+			campaign: &campaign.Campaign{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("c1ef9a01-4e2c-11f0-829d-9e0f1a2b3c09"),
+					CustomerID: uuid.FromStringOrNil("c21fab12-4e2c-11f0-93ae-0f1a2b3c4d10"),
+				},
+				Type:      campaign.TypeCall,
+				OutplanID: uuid.FromStringOrNil("c24fbc23-4e2c-11f0-a4bf-1a2b3c4d5e11"),
+				OutdialID: uuid.FromStringOrNil("c27fcd34-4e2c-11f0-b5c0-2b3c4d5e6f12"),
+				QueueID:   uuid.FromStringOrNil("c2afde45-4e2c-11f0-86d1-3c4d5e6f7a13"),
+				FlowID:    uuid.FromStringOrNil("c2dfef56-4e2c-11f0-97e2-4d5e6f7a8b14"),
+				DialMode:  campaign.DialModePreview,
+			},
+			outplan: &outplan.Outplan{
+				Source: &commonaddress.Address{
+					Type:   commonaddress.TypeTel,
+					Target: "+821100000001",
+				},
+			},
+			target: &omoutdialtarget.OutdialTarget{
+				ID: uuid.FromStringOrNil("c30ff067-4e2c-11f0-a8f3-5e6f7a8b9c15"),
+			},
+			destination: &commonaddress.Address{
+				Type:   commonaddress.TypeTel,
+				Target: "+821100000002",
+			},
+			destinationIndex: 0,
+			tryCount:         1,
+
+			responseCallID:       uuid.FromStringOrNil("c33f0178-4e2c-11f0-b904-6f7a8b9c0d16"),
+			responseActiveflowID: uuid.FromStringOrNil("c36f1289-4e2c-11f0-8a15-7a8b9c0d1e17"),
+			responseCampaigncall: &campaigncall.Campaigncall{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("c39f239a-4e2c-11f0-9b26-8b9c0d1e2f18"),
+				},
+				Status: campaigncall.StatusPreviewing,
+			},
+		},
+		{
+			name: "callback reserved for the agent",
+
+			campaign: &campaign.Campaign{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("7a2c4e60-4fa2-11f1-8f1b-2c3d4e5f6a7b"),
+					CustomerID: uuid.FromStringOrNil("7a6f1b92-4fa2-11f1-9d2c-3d4e5f6a7b8c"),
+				},
+				Type:      campaign.TypeCall,
+				OutplanID: uuid.FromStringOrNil("7aa3d4c4-4fa2-11f1-a83d-4e5f6a7b8c9d"),
+				OutdialID: uuid.FromStringOrNil("7ad8e6f6-4fa2-11f1-b94e-5f6a7b8c9d0e"),
+				QueueID:   uuid.FromStringOrNil("7b0df828-4fa2-11f1-8a5f-6a7b8c9d0e1f"),
+				FlowID:    uuid.FromStringOrNil("7b43095a-4fa2-11f1-9b60-7b8c9d0e1f2a"),
+				DialMode:  campaign.DialModePredictive,
+			},
+			outplan: &outplan.Outplan{
+				Source: &commonaddress.Address{
+					Type:   commonaddress.TypeTel,
+					Target: "+821100000001",
+				},
+			},
+			target: &omoutdialtarget.OutdialTarget{
+				ID:              uuid.FromStringOrNil("7b781a8c-4fa2-11f1-ac71-8c9d0e1f2a3b"),
+				CallbackAgentID: uuid.FromStringOrNil("7bad2bbe-4fa2-11f1-bd82-9d0e1f2a3b4c"),
+				TMCallback:      timePtr(time.Date(2026, 10, 22, 15, 0, 0, 0, time.UTC)),
+			},
+			destination: &commonaddress.Address{
+				Type:   commonaddress.TypeTel,
+				Target: "+821100000002",
+			},
+			destinationIndex: 0,
+			tryCount:         2,
+
+			responseCallID:       uuid.FromStringOrNil("7be23cf0-4fa2-11f1-8e93-0e1f2a3b4c5d"),
+			responseActiveflowID: uuid.FromStringOrNil("7c174e22-4fa2-11f1-9fa4-1f2a3b4c5d6e"),
+			responseCampaigncall: &campaigncall.Campaigncall{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("7c4c5f54-4fa2-11f1-a0b5-2a3b4c5d6e7f"),
+				},
+				AgentID: uuid.FromStringOrNil("7bad2bbe-4fa2-11f1-bd82-9d0e1f2a3b4c"),
+				Status:  campaigncall.StatusPreviewing,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockCampaigncall := campaigncallhandler.NewMockCampaigncallHandler(mc)
+			h := &campaignHandler{
+				util:                mockUtil,
+				campaigncallHandler: mockCampaigncall,
+			}
+			ctx := context.Background()
+
+			mockUtil.EXPECT().UUIDCreate().Return(tt.responseCallID)
+			mockUtil.EXPECT().UUIDCreate().Return(tt.responseActiveflowID)
+			mockCampaigncall.EXPECT().CreatePreview(
+				ctx,
+				tt.campaign.CustomerID,
+				tt.campaign.ID,
+				tt.campaign.OutplanID,
+				tt.campaign.OutdialID,
+				tt.target.ID,
+				tt.campaign.QueueID,
+				tt.target.CallbackAgentID,
+				tt.target.TMCallback,
+				tt.responseActiveflowID,
+				tt.campaign.FlowID,
+				tt.responseCallID,
+				tt.outplan.Source,
+				tt.destination,
+				tt.destinationIndex,
+				tt.tryCount,
+			).Return(tt.responseCampaigncall, nil)
+
+			res, err := h.executePreview(ctx, tt.campaign, tt.outplan, tt.target, tt.destination, tt.destinationIndex, tt.tryCount)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.responseCampaigncall) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.responseCampaigncall, res)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ package campaignhandler
 
 import (
 	"context"
+	"sync"
 
 	"monorepo/bin-common-handler/pkg/notifyhandler"
 	"monorepo/bin-common-handler/pkg/requesthandler"
@@ -15,6 +16,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 
 	"monorepo/bin-campaign-manager/models/campaign"
+	"monorepo/bin-campaign-manager/models/campaignresult"
 	"monorepo/bin-campaign-manager/pkg/campaigncallhandler"
 	"monorepo/bin-campaign-manager/pkg/dbhandler"
 	"monorepo/bin-campaign-manager/pkg/outplanhandler"
@@ -54,6 +56,32 @@ var (
 			Help:      "Total number of campaign execution loops.",
 		},
 	)
+
+	promCampaignTargetDeferredTotal = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "campaign_target_deferred_total",
+			Help:      "Total number of outdial targets deferred for being out of the calling windows.",
+		},
+	)
+
+	promCampaignTargetSuppressedTotal = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "campaign_target_suppressed_total",
+			Help:      "Total number of outdial targets skipped for being in the suppression lists.",
+		},
+	)
+
+	promCampaignDialRatio = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "campaign_dial_ratio",
+			Help:      "Dial ratio of the published campaign pacing by dial mode.",
+			Buckets:   []float64{0.5, 1, 1.25, 1.5, 2, 2.5, 3},
+		},
+		[]string{"dial_mode"},
+	)
 )
 
 func init() {
@@ -62,6 +90,9 @@ func init() {
 		promCampaignStatusRunTotal,
 		promCampaignStatusStopTotal,
 		promCampaignExecuteTotal,
+		promCampaignTargetDeferredTotal,
+		promCampaignTargetSuppressedTotal,
+		promCampaignDialRatio,
 	)
 }
 
@@ -74,6 +105,8 @@ type campaignHandler struct {
 
 	campaigncallHandler campaigncallhandler.CampaigncallHandler
 	outplanHandler      outplanhandler.OutplanHandler
+
+	pacingPublished sync.Map // campaign id -> time of the last published campaign_pacing event
 }
 
 // CampaignHandler interface
@@ -110,9 +143,13 @@ type CampaignHandler interface {
 	UpdateNextCampaignID(ctx context.Context, id, nextCampaignID uuid.UUID) (*campaign.Campaign, error)
 	UpdateServiceLevel(ctx context.Context, id uuid.UUID, serviceLevel int) (*campaign.Campaign, error)
 	UpdateActions(ctx context.Context, id uuid.UUID, actions []fmaction.Action) (*campaign.Campaign, error)
+	UpdateDialMode(ctx context.Context, id uuid.UUID, dialMode campaign.DialMode, maxAbandonRate int) (*campaign.Campaign, error)
+	UpdateCallingWindows(ctx context.Context, id uuid.UUID, callingWindows []campaign.CallingWindow, timezone string) (*campaign.Campaign, error)
 
 	UpdateStatus(ctx context.Context, id uuid.UUID, status campaign.Status) (*campaign.Campaign, error)
 
+	ResultList(ctx context.Context, id uuid.UUID, token string, size uint64) ([]*campaignresult.CampaignResult, error)
+
 	Execute(ctx context.Context, id uuid.UUID)
 
 	EventHandleActiveflowDeleted(ctx context.Context, campaignID uuid.UUID) error
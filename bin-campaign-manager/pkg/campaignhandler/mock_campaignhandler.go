@@ -12,6 +12,7 @@ package campaignhandler
 import (
 	context "context"
 	campaign "monorepo/bin-campaign-manager/models/campaign"
+	campaignresult "monorepo/bin-campaign-manager/models/campaignresult"
 	action "monorepo/bin-flow-manager/models/action"
 	reflect "reflect"
 
@@ -158,6 +159,21 @@ func (mr *MockCampaignHandlerMockRecorder) ListByCustomerID(ctx, customerID, tok
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByCustomerID", reflect.TypeOf((*MockCampaignHandler)(nil).ListByCustomerID), ctx, customerID, token, limit)
 }
 
+// ResultList mocks base method.
+func (m *MockCampaignHandler) ResultList(ctx context.Context, id uuid.UUID, token string, size uint64) ([]*campaignresult.CampaignResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResultList", ctx, id, token, size)
+	ret0, _ := ret[0].([]*campaignresult.CampaignResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResultList indicates an expected call of ResultList.
+func (mr *MockCampaignHandlerMockRecorder) ResultList(ctx, id, token, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResultList", reflect.TypeOf((*MockCampaignHandler)(nil).ResultList), ctx, id, token, size)
+}
+
 // UpdateActions mocks base method.
 func (m *MockCampaignHandler) UpdateActions(ctx context.Context, id uuid.UUID, actions []action.Action) (*campaign.Campaign, error) {
 	m.ctrl.T.Helper()
@@ -188,6 +204,36 @@ func (mr *MockCampaignHandlerMockRecorder) UpdateBasicInfo(ctx, id, name, detail
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBasicInfo", reflect.TypeOf((*MockCampaignHandler)(nil).UpdateBasicInfo), ctx, id, name, detail, campaignType, serviceLevel, endHandle)
 }
 
+// UpdateCallingWindows mocks base method.
+func (m *MockCampaignHandler) UpdateCallingWindows(ctx context.Context, id uuid.UUID, callingWindows []campaign.CallingWindow, timezone string) (*campaign.Campaign, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCallingWindows", ctx, id, callingWindows, timezone)
+	ret0, _ := ret[0].(*campaign.Campaign)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCallingWindows indicates an expected call of UpdateCallingWindows.
+func (mr *MockCampaignHandlerMockRecorder) UpdateCallingWindows(ctx, id, callingWindows, timezone any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCallingWindows", reflect.TypeOf((*MockCampaignHandler)(nil).UpdateCallingWindows), ctx, id, callingWindows, timezone)
+}
+
+// UpdateDialMode mocks base method.
+func (m *MockCampaignHandler) UpdateDialMode(ctx context.Context, id uuid.UUID, dialMode campaign.DialMode, maxAbandonRate int) (*campaign.Campaign, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDialMode", ctx, id, dialMode, maxAbandonRate)
+	ret0, _ := ret[0].(*campaign.Campaign)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDialMode indicates an expected call of UpdateDialMode.
+func (mr *MockCampaignHandlerMockRecorder) UpdateDialMode(ctx, id, dialMode, maxAbandonRate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDialMode", reflect.TypeOf((*MockCampaignHandler)(nil).UpdateDialMode), ctx, id, dialMode, maxAbandonRate)
+}
+
 // UpdateNextCampaignID mocks base method.
 func (m *MockCampaignHandler) UpdateNextCampaignID(ctx context.Context, id, nextCampaignID uuid.UUID) (*campaign.Campaign, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,251 @@
+package campaignhandler
+
+import (
+	"context"
+	"time"
+
+	amagent "monorepo/bin-agent-manager/models/agent"
+
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+
+	"monorepo/bin-campaign-manager/models/campaign"
+	"monorepo/bin-campaign-manager/models/campaigncall"
+)
+
+// list of pacing settings
+const (
+	pacingListLimit             = 100              // max campaigncalls fetched for the dialing/previewing counts and the statistics
+	pacingMinSamples            = 20               // min done campaigncalls to adjust the predictive dial ratio
+	pacingMaxDialRatio          = 3.0              // max dial ratio of the predictive dial mode
+	pacingDefaultMaxAbandonRate = 3                // default max abandon rate(%) of the predictive dial mode
+	pacingEventInterval         = time.Second * 10 // min interval of the campaign_pacing events of a campaign
+
+	previewTimeout = time.Second * 60 // previewing campaigncall is skipped if no agent accepts it in this time
+)
+
+// getDialMode returns the dial mode applied to the campaign.
+// The campaign without queue has no agent to pace against, so it's dialed as the power dial mode.
+// The preview dial mode makes a call after the accept, so it's for the call type campaign only.
+func getDialMode(c *campaign.Campaign) campaign.DialMode {
+	if c.QueueID == uuid.Nil {
+		return campaign.DialModePower
+	}
+
+	switch c.DialMode {
+	case campaign.DialModeNone:
+		return campaign.DialModePower
+
+	case campaign.DialModePreview:
+		if c.Type != campaign.TypeCall {
+			return campaign.DialModeProgressive
+		}
+	}
+
+	return c.DialMode
+}
+
+// getPacing returns the current pacing of the campaign.
+func (h *campaignHandler) getPacing(ctx context.Context, c *campaign.Campaign) (*campaign.Pacing, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":        "getPacing",
+		"campaign_id": c.ID,
+	})
+
+	res := &campaign.Pacing{
+		CampaignID: c.ID,
+		CustomerID: c.CustomerID,
+		DialMode:   getDialMode(c),
+		DialRatio:  1,
+		TMCreate:   h.util.TimeNow(),
+	}
+
+	// get available agents
+	availables, err := h.reqHandler.QueueV1QueueGetAgents(ctx, c.QueueID, map[amagent.Field]any{
+		amagent.FieldStatus: amagent.StatusAvailable,
+	})
+	if err != nil {
+		log.Errorf("Could not get available agents. err: %v", err)
+		return nil, err
+	}
+	res.AvailableAgents = len(availables)
+
+	// get dialings
+	dialings, err := h.campaigncallHandler.ListByCampaignIDAndStatus(ctx, c.ID, campaigncall.StatusDialing, "", pacingListLimit)
+	if err != nil {
+		log.Errorf("Could not get dialing campaigncalls. err: %v", err)
+		return nil, err
+	}
+	res.Dialing = len(dialings)
+
+	// the callback reserved for an agent is previewed in every dial mode,
+	// so the previewings are counted and swept regardless of the dial mode.
+	previewings, err := h.getPreviewings(ctx, c.ID)
+	if err != nil {
+		log.Errorf("Could not get previewing campaigncalls. err: %v", err)
+		return nil, err
+	}
+	res.Previewing = len(previewings)
+
+	switch res.DialMode {
+	case campaign.DialModePower:
+		res.DialRatio = float64(c.ServiceLevel) / 100
+		res.Capacity = (res.AvailableAgents * c.ServiceLevel) / 100
+
+	case campaign.DialModeProgressive:
+		res.Capacity = res.AvailableAgents
+
+	case campaign.DialModePreview:
+		res.Capacity = res.AvailableAgents
+
+	case campaign.DialModePredictive:
+		busies, errBusy := h.reqHandler.QueueV1QueueGetAgents(ctx, c.QueueID, map[amagent.Field]any{
+			amagent.FieldStatus: amagent.StatusBusy,
+		})
+		if errBusy != nil {
+			log.Errorf("Could not get busy agents. err: %v", errBusy)
+			return nil, errBusy
+		}
+		res.BusyAgents = len(busies)
+
+		recents, errRecent := h.campaigncallHandler.ListByCampaignID(ctx, c.ID, "", pacingListLimit)
+		if errRecent != nil {
+			log.Errorf("Could not get recent campaigncalls. err: %v", errRecent)
+			return nil, errRecent
+		}
+		calcPacingStatistics(res, recents)
+
+		res.DialRatio = calcPredictiveDialRatio(res, c.MaxAbandonRate)
+		res.Capacity = int(calcEffectiveAgents(res) * res.DialRatio)
+	}
+
+	return res, nil
+}
+
+// getPreviewings returns the previewing campaigncalls of the campaign.
+// The previewing campaigncall no agent accepted in the preview timeout is skipped.
+// The skipped callback is scheduled again with its original callback time, so it keeps its turn.
+func (h *campaignHandler) getPreviewings(ctx context.Context, campaignID uuid.UUID) ([]*campaigncall.Campaigncall, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":        "getPreviewings",
+		"campaign_id": campaignID,
+	})
+
+	tmp, err := h.campaigncallHandler.ListByCampaignIDAndStatus(ctx, campaignID, campaigncall.StatusPreviewing, "", pacingListLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	expire := time.Now().Add(-previewTimeout)
+	res := []*campaigncall.Campaigncall{}
+	for _, cc := range tmp {
+		if cc.TMCreate != nil && cc.TMCreate.Before(expire) {
+			log.Debugf("The previewing campaigncall is expired. Skipping it. campaigncall_id: %s", cc.ID)
+			if cc.TMCallback != nil {
+				if _, errCallback := h.reqHandler.OutdialV1OutdialtargetUpdateCallback(ctx, cc.OutdialTargetID, cc.TMCallback, cc.AgentID); errCallback != nil {
+					log.Errorf("Could not schedule the expired callback again. err: %v", errCallback)
+				}
+			}
+			if _, errDone := h.campaigncallHandler.Done(ctx, cc.ID, campaigncall.ResultNone); errDone != nil {
+				log.Errorf("Could not skip the expired campaigncall. err: %v", errDone)
+			}
+			continue
+		}
+		res = append(res, cc)
+	}
+
+	return res, nil
+}
+
+// calcPacingStatistics calculates the answer rate, abandon rate and durations from the recent campaigncalls.
+func calcPacingStatistics(p *campaign.Pacing, calls []*campaigncall.Campaigncall) {
+	answered := 0
+	abandoned := 0
+	handleTime := time.Duration(0)
+	handled := 0
+	timeToAnswer := time.Duration(0)
+
+	for _, cc := range calls {
+		if cc.Status != campaigncall.StatusDone {
+			continue
+		}
+		p.Samples++
+
+		if cc.TMProgressing == nil {
+			continue
+		}
+		answered++
+
+		if cc.Abandoned {
+			abandoned++
+		}
+		if cc.TMCreate != nil {
+			timeToAnswer += cc.TMProgressing.Sub(*cc.TMCreate)
+		}
+		if cc.TMUpdate != nil && cc.TMUpdate.After(*cc.TMProgressing) {
+			handleTime += cc.TMUpdate.Sub(*cc.TMProgressing)
+			handled++
+		}
+	}
+
+	if p.Samples > 0 {
+		p.AnswerRate = float64(answered) * 100 / float64(p.Samples)
+	}
+	if answered > 0 {
+		p.AbandonRate = float64(abandoned) * 100 / float64(answered)
+		p.AverageTimeToAnswer = int((timeToAnswer / time.Duration(answered)).Milliseconds())
+	}
+	if handled > 0 {
+		p.AverageHandleTime = int((handleTime / time.Duration(handled)).Milliseconds())
+	}
+}
+
+// calcPredictiveDialRatio returns the dial ratio of the predictive dial mode.
+// The ratio is the inverse of the answer rate, reduced as the abandon rate approaches the max abandon rate.
+// It stays at 1 until the campaign has enough samples or while the abandon rate exceeds the max.
+func calcPredictiveDialRatio(p *campaign.Pacing, maxAbandonRate int) float64 {
+	if maxAbandonRate <= 0 {
+		maxAbandonRate = pacingDefaultMaxAbandonRate
+	}
+
+	if p.Samples < pacingMinSamples || p.AnswerRate <= 0 || p.AbandonRate >= float64(maxAbandonRate) {
+		return 1
+	}
+
+	res := 100 / p.AnswerRate
+	res = 1 + (res-1)*(1-p.AbandonRate/float64(maxAbandonRate))
+	if res > pacingMaxDialRatio {
+		res = pacingMaxDialRatio
+	}
+
+	return res
+}
+
+// calcEffectiveAgents returns the agents expected to be available when the dialing calls are answered.
+// The busy agents count in proportion to the chance of finishing their calls in the average time to answer.
+func calcEffectiveAgents(p *campaign.Pacing) float64 {
+	res := float64(p.AvailableAgents)
+	if p.AverageHandleTime <= 0 || p.BusyAgents == 0 {
+		return res
+	}
+
+	ratio := float64(p.AverageTimeToAnswer) / float64(p.AverageHandleTime)
+	if ratio > 1 {
+		ratio = 1
+	}
+
+	return res + float64(p.BusyAgents)*ratio
+}
+
+// publishPacing publishes the campaign_pacing event of the given pacing.
+// The events of a campaign are published once per the pacing event interval.
+func (h *campaignHandler) publishPacing(ctx context.Context, p *campaign.Pacing) {
+	now := time.Now()
+	if last, ok := h.pacingPublished.Load(p.CampaignID); ok && now.Sub(last.(time.Time)) < pacingEventInterval {
+		return
+	}
+	h.pacingPublished.Store(p.CampaignID, now)
+
+	promCampaignDialRatio.WithLabelValues(string(p.DialMode)).Observe(p.DialRatio)
+	h.notifyHandler.PublishWebhookEvent(ctx, p.CustomerID, campaign.EventTypeCampaignPacing, p)
+}
@@ -0,0 +1,498 @@
+package campaignhandler
+
+import (
+	"context"
+	reflect "reflect"
+	"testing"
+	"time"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/notifyhandler"
+	"monorepo/bin-common-handler/pkg/requesthandler"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+
+	amagent "monorepo/bin-agent-manager/models/agent"
+	omoutdialtarget "monorepo/bin-outdial-manager/models/outdialtarget"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	"monorepo/bin-campaign-manager/models/campaign"
+	"monorepo/bin-campaign-manager/models/campaigncall"
+	"monorepo/bin-campaign-manager/pkg/campaigncallhandler"
+)
+
+func Test_getDialMode(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		campaign *campaign.Campaign
+
+		expectRes campaign.DialMode
+	}{
+		{
+			name: "no queue",
+
+			campaign: &campaign.Campaign{
+				DialMode: campaign.DialModePredictive,
+			},
+
+			expectRes: campaign.DialModePower,
+		},
+		{
+			name: "none",
+
+			campaign: &campaign.Campaign{
+				QueueID: uuid.FromStringOrNil("7c1d2e3f-4e2c-11f0-8a1b-1c2d3e4f5a01"),
+			},
+
+			expectRes: campaign.DialModePower,
+		},
+		{
+			name: "preview with flow type",
+
+			campaign: &campaign.Campaign{
+				Type:     campaign.TypeFlow,
+				QueueID:  uuid.FromStringOrNil("7c1d2e3f-4e2c-11f0-8a1b-1c2d3e4f5a01"),
+				DialMode: campaign.DialModePreview,
+			},
+
+			expectRes: campaign.DialModeProgressive,
+		},
+		{
+			name: "predictive",
+
+			campaign: &campaign.Campaign{
+				Type:     campaign.TypeCall,
+				QueueID:  uuid.FromStringOrNil("7c1d2e3f-4e2c-11f0-8a1b-1c2d3e4f5a01"),
+				DialMode: campaign.DialModePredictive,
+			},
+
+			expectRes: campaign.DialModePredictive,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := getDialMode(tt.campaign)
+			if res != tt.expectRes {
+				t.Errorf("Wrong match. expect: %s, got: %s", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_calcPacingStatistics(t *testing.T) {
+
+	tmCreate := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	tmProgressing := tmCreate.Add(time.Second * 10)
+	tmDone := tmProgressing.Add(time.Second * 60)
+
+	tests := []struct {
+		name string
+
+		calls []*campaigncall.Campaigncall
+
+		expectRes *campaign.Pacing
+	}{
+		{
+			name: "normal",
+
+			calls: []*campaigncall.Campaigncall{
+				{
+					Status:        campaigncall.StatusDone,
+					TMCreate:      &tmCreate,
+					TMProgressing: &tmProgressing,
+					TMUpdate:      &tmDone,
+				},
+				{
+					Status:        campaigncall.StatusDone,
+					Abandoned:     true,
+					TMCreate:      &tmCreate,
+					TMProgressing: &tmProgressing,
+					TMUpdate:      &tmDone,
+				},
+				{
+					Status:   campaigncall.StatusDone,
+					TMCreate: &tmCreate,
+					TMUpdate: &tmDone,
+				},
+				{
+					Status:   campaigncall.StatusDone,
+					TMCreate: &tmCreate,
+					TMUpdate: &tmDone,
+				},
+				{
+					// ongoing call is not a sample
+					Status:   campaigncall.StatusDialing,
+					TMCreate: &tmCreate,
+				},
+			},
+
+			expectRes: &campaign.Pacing{
+				Samples:             4,
+				AnswerRate:          50,
+				AbandonRate:         50,
+				AverageHandleTime:   60000,
+				AverageTimeToAnswer: 10000,
+			},
+		},
+		{
+			name: "empty",
+
+			calls: []*campaigncall.Campaigncall{},
+
+			expectRes: &campaign.Pacing{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := &campaign.Pacing{}
+			calcPacingStatistics(res, tt.calls)
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_calcPredictiveDialRatio(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		pacing         *campaign.Pacing
+		maxAbandonRate int
+
+		expectRes float64
+	}{
+		{
+			name: "not enough samples",
+
+			pacing: &campaign.Pacing{
+				Samples:    10,
+				AnswerRate: 50,
+			},
+			maxAbandonRate: 3,
+
+			expectRes: 1,
+		},
+		{
+			name: "no abandon",
+
+			pacing: &campaign.Pacing{
+				Samples:    100,
+				AnswerRate: 50,
+			},
+			maxAbandonRate: 3,
+
+			expectRes: 2,
+		},
+		{
+			name: "abandon rate reduces the ratio",
+
+			pacing: &campaign.Pacing{
+				Samples:     100,
+				AnswerRate:  50,
+				AbandonRate: 1.5,
+			},
+			maxAbandonRate: 3,
+
+			expectRes: 1.5,
+		},
+		{
+			name: "abandon rate exceeds the default max",
+
+			pacing: &campaign.Pacing{
+				Samples:     100,
+				AnswerRate:  50,
+				AbandonRate: 4,
+			},
+			maxAbandonRate: 0,
+
+			expectRes: 1,
+		},
+		{
+			name: "low answer rate is capped",
+
+			pacing: &campaign.Pacing{
+				Samples:    100,
+				AnswerRate: 10,
+			},
+			maxAbandonRate: 3,
+
+			expectRes: pacingMaxDialRatio,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := calcPredictiveDialRatio(tt.pacing, tt.maxAbandonRate)
+			if res != tt.expectRes {
+				t.Errorf("Wrong match. expect: %f, got: %f", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_calcEffectiveAgents(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		pacing *campaign.Pacing
+
+		expectRes float64
+	}{
+		{
+			name: "no statistics",
+
+			pacing: &campaign.Pacing{
+				AvailableAgents: 2,
+				BusyAgents:      4,
+			},
+
+			expectRes: 2,
+		},
+		{
+			name: "busy agents finishing soon",
+
+			pacing: &campaign.Pacing{
+				AvailableAgents:     2,
+				BusyAgents:          4,
+				AverageHandleTime:   60000,
+				AverageTimeToAnswer: 15000,
+			},
+
+			expectRes: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := calcEffectiveAgents(tt.pacing)
+			if res != tt.expectRes {
+				t.Errorf("Wrong match. expect: %f, got: %f", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_getPacing_predictive(t *testing.T) {
+
+	tmCreate := time.Now().Add(-time.Minute * 5)
+	tmProgressing := tmCreate.Add(time.Second * 15)
+	tmDone := tmProgressing.Add(time.Second * 60)
+
+	recents := []*campaigncall.Campaigncall{}
+	for i := 0; i < 40; i++ {
+		cc := &campaigncall.Campaigncall{
+			Status:   campaigncall.StatusDone,
+			TMCreate: &tmCreate,
+			TMUpdate: &tmDone,
+		}
+		if i%2 == 0 {
+			cc.TMProgressing = &tmProgressing
+		}
+		recents = append(recents, cc)
+	}
+
+	tests := []struct {
+		name string
+
+		campaign *campaign.Campaign
+
+		responseAvailables []amagent.Agent
+		responseBusies     []amagent.Agent
+		responseDialings   []*campaigncall.Campaigncall
+		responseRecents    []*campaigncall.Campaigncall
+
+		expectCapacity  int
+		expectDialRatio float64
+	}{
+		{
+			name: "normal",
+
+			campaign: &campaign.Campaign{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("9e2f3a4b-4e2c-11f0-9b2c-2d3e4f5a6b02"),
+				},
+				Type:     campaign.TypeCall,
+				QueueID:  uuid.FromStringOrNil("9e5a4b5c-4e2c-11f0-ac3d-3e4f5a6b7c03"),
+				DialMode: campaign.DialModePredictive,
+			},
+
+			responseAvailables: []amagent.Agent{{}, {}},
+			responseBusies:     []amagent.Agent{{}, {}, {}, {}},
+			responseDialings:   []*campaigncall.Campaigncall{},
+			responseRecents:    recents,
+
+			// effective agents: 2 + 4 * (15s / 60s) = 3, dial ratio: 100 / 50 = 2
+			expectCapacity:  6,
+			expectDialRatio: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockCampaigncall := campaigncallhandler.NewMockCampaigncallHandler(mc)
+			h := &campaignHandler{
+				util:                mockUtil,
+				notifyHandler:       mockNotify,
+				reqHandler:          mockReq,
+				campaigncallHandler: mockCampaigncall,
+			}
+
+			ctx := context.Background()
+
+			mockUtil.EXPECT().TimeNow().Return(timePtr(time.Now()))
+			mockReq.EXPECT().QueueV1QueueGetAgents(ctx, tt.campaign.QueueID, map[amagent.Field]any{amagent.FieldStatus: amagent.StatusAvailable}).Return(tt.responseAvailables, nil)
+			mockCampaigncall.EXPECT().ListByCampaignIDAndStatus(ctx, tt.campaign.ID, campaigncall.StatusDialing, "", uint64(pacingListLimit)).Return(tt.responseDialings, nil)
+			mockCampaigncall.EXPECT().ListByCampaignIDAndStatus(ctx, tt.campaign.ID, campaigncall.StatusPreviewing, "", uint64(pacingListLimit)).Return([]*campaigncall.Campaigncall{}, nil)
+			mockReq.EXPECT().QueueV1QueueGetAgents(ctx, tt.campaign.QueueID, map[amagent.Field]any{amagent.FieldStatus: amagent.StatusBusy}).Return(tt.responseBusies, nil)
+			mockCampaigncall.EXPECT().ListByCampaignID(ctx, tt.campaign.ID, "", uint64(pacingListLimit)).Return(tt.responseRecents, nil)
+
+			res, err := h.getPacing(ctx, tt.campaign)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if res.Capacity != tt.expectCapacity || res.DialRatio != tt.expectDialRatio {
+				t.Errorf("Wrong match. expect: %d/%f, got: %d/%f", tt.expectCapacity, tt.expectDialRatio, res.Capacity, res.DialRatio)
+			}
+		})
+	}
+}
+
+func Test_getPreviewings(t *testing.T) {
+
+	tmExpired := time.Now().Add(-previewTimeout * 2)
+	tmRecent := time.Now()
+	tmCallback := tmExpired.Add(-time.Second)
+
+	tests := []struct {
+		name string
+
+		campaignID uuid.UUID
+
+		responseCampaigncalls []*campaigncall.Campaigncall
+
+		expectSkipID uuid.UUID
+		expectRes    []*campaigncall.Campaigncall
+	}{
+		{
+			name: "expired previewing is skipped",
+
+			campaignID: uuid.FromStringOrNil("af3a4b5c-4e2c-11f0-bd4e-4f5a6b7c8d04"),
+
+			responseCampaigncalls: []*campaigncall.Campaigncall{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("af6b5c6d-4e2c-11f0-8e5f-5a6b7c8d9e05"),
+					},
+					TMCreate: &tmExpired,
+				},
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("af9c6d7e-4e2c-11f0-9f6a-6b7c8d9e0f06"),
+					},
+					TMCreate: &tmRecent,
+				},
+			},
+
+			expectSkipID: uuid.FromStringOrNil("af6b5c6d-4e2c-11f0-8e5f-5a6b7c8d9e05"),
+			expectRes: []*campaigncall.Campaigncall{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("af9c6d7e-4e2c-11f0-9f6a-6b7c8d9e0f06"),
+					},
+					TMCreate: &tmRecent,
+				},
+			},
+		},
+		{
+			name: "expired callback is scheduled again",
+
+			campaignID: uuid.FromStringOrNil("4a1e7c52-ae6a-11f1-9b3d-2f8c6e1a5d01"),
+
+			responseCampaigncalls: []*campaigncall.Campaigncall{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("4a51a084-ae6a-11f1-8c6e-7d3a1f9b2e02"),
+					},
+					OutdialTargetID: uuid.FromStringOrNil("4a84c2b6-ae6a-11f1-a2f9-1e6b8d4c7a03"),
+					AgentID:         uuid.FromStringOrNil("4ab7e4e8-ae6a-11f1-b4c1-9a2d5f8e3b04"),
+					TMCallback:      &tmCallback,
+					TMCreate:        &tmExpired,
+				},
+			},
+
+			expectSkipID: uuid.FromStringOrNil("4a51a084-ae6a-11f1-8c6e-7d3a1f9b2e02"),
+			expectRes:    []*campaigncall.Campaigncall{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockCampaigncall := campaigncallhandler.NewMockCampaigncallHandler(mc)
+			h := &campaignHandler{
+				reqHandler:          mockReq,
+				campaigncallHandler: mockCampaigncall,
+			}
+
+			ctx := context.Background()
+
+			mockCampaigncall.EXPECT().ListByCampaignIDAndStatus(ctx, tt.campaignID, campaigncall.StatusPreviewing, "", uint64(pacingListLimit)).Return(tt.responseCampaigncalls, nil)
+			for _, cc := range tt.responseCampaigncalls {
+				if cc.ID == tt.expectSkipID && cc.TMCallback != nil {
+					mockReq.EXPECT().OutdialV1OutdialtargetUpdateCallback(ctx, cc.OutdialTargetID, cc.TMCallback, cc.AgentID).Return(&omoutdialtarget.OutdialTarget{}, nil)
+				}
+			}
+			mockCampaigncall.EXPECT().Done(ctx, tt.expectSkipID, campaigncall.ResultNone).Return(&campaigncall.Campaigncall{}, nil)
+
+			res, err := h.getPreviewings(ctx, tt.campaignID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_publishPacing(t *testing.T) {
+
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+	h := &campaignHandler{
+		notifyHandler: mockNotify,
+	}
+
+	ctx := context.Background()
+	p := &campaign.Pacing{
+		CampaignID: uuid.FromStringOrNil("b0ad7e8f-4e2c-11f0-a07b-7c8d9e0f1a07"),
+		CustomerID: uuid.FromStringOrNil("b0de8f90-4e2c-11f0-b18c-8d9e0f1a2b08"),
+		DialMode:   campaign.DialModeProgressive,
+	}
+
+	// the second pacing in the interval is not published
+	mockNotify.EXPECT().PublishWebhookEvent(ctx, p.CustomerID, campaign.EventTypeCampaignPacing, p).Times(1)
+	h.publishPacing(ctx, p)
+	h.publishPacing(ctx, p)
+}
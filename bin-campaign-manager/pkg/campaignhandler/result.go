@@ -0,0 +1,75 @@
+package campaignhandler
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+
+	"monorepo/bin-campaign-manager/models/campaignresult"
+)
+
+// ResultList returns the campaign's results of the outdial targets.
+// It pages over the outdial targets of the campaign's outdial, and each result has the target's campaigncalls of the campaign.
+func (h *campaignHandler) ResultList(ctx context.Context, id uuid.UUID, token string, size uint64) ([]*campaignresult.CampaignResult, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":        "ResultList",
+		"campaign_id": id,
+		"token":       token,
+		"size":        size,
+	})
+
+	c, err := h.Get(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get campaign. err: %v", err)
+		return nil, err
+	}
+
+	res := []*campaignresult.CampaignResult{}
+	if c.OutdialID == uuid.Nil {
+		return res, nil
+	}
+
+	targets, err := h.reqHandler.OutdialV1OutdialtargetGetsByOutdialID(ctx, c.OutdialID, token, size)
+	if err != nil {
+		log.Errorf("Could not get outdial targets. err: %v", err)
+		return nil, err
+	}
+
+	targetIDs := make([]uuid.UUID, 0, len(targets))
+	for _, t := range targets {
+		targetIDs = append(targetIDs, t.ID)
+	}
+
+	ccs, err := h.db.CampaigncallListByCampaignIDAndOutdialTargetIDs(ctx, id, targetIDs)
+	if err != nil {
+		log.Errorf("Could not get campaigncalls. err: %v", err)
+		return nil, err
+	}
+
+	attempts := map[uuid.UUID][]campaignresult.Attempt{}
+	for _, cc := range ccs {
+		attempts[cc.OutdialTargetID] = append(attempts[cc.OutdialTargetID], campaignresult.NewAttempt(cc))
+	}
+
+	for _, t := range targets {
+		tmp := &campaignresult.CampaignResult{
+			CampaignID:      id,
+			OutdialTargetID: t.ID,
+
+			Name:   t.Name,
+			Status: t.Status,
+
+			Attempts: attempts[t.ID],
+
+			TMCreate: t.TMCreate,
+		}
+		if tmp.Attempts == nil {
+			tmp.Attempts = []campaignresult.Attempt{}
+		}
+
+		res = append(res, tmp)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,169 @@
+package campaignhandler
+
+import (
+	"context"
+	reflect "reflect"
+	"testing"
+	"time"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/requesthandler"
+
+	omoutdialtarget "monorepo/bin-outdial-manager/models/outdialtarget"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	"monorepo/bin-campaign-manager/models/campaign"
+	"monorepo/bin-campaign-manager/models/campaigncall"
+	"monorepo/bin-campaign-manager/models/campaignresult"
+	"monorepo/bin-campaign-manager/pkg/dbhandler"
+)
+
+func Test_ResultList(t *testing.T) {
+
+	tmCreate := time.Date(2026, 10, 1, 3, 30, 0, 0, time.UTC)
+	tmEnd := time.Date(2026, 10, 1, 3, 30, 30, 0, time.UTC)
+
+	tests := []struct {
+		name string
+
+		id    uuid.UUID
+		token string
+		size  uint64
+
+		responseCampaign      *campaign.Campaign
+		responseTargets       []omoutdialtarget.OutdialTarget
+		responseCampaigncalls []*campaigncall.Campaigncall
+
+		expectTargetIDs []uuid.UUID
+		expectRes       []*campaignresult.CampaignResult
+	}{
+		{
+			name: "normal",
+
+			id:    uuid.FromStringOrNil("8a1f3c5e-9c51-11f0-9d2b-4e7c1a3f5b01"),
+			token: "2026-10-02T00:00:00.000000Z",
+			size:  10,
+
+			responseCampaign: &campaign.Campaign{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("8a1f3c5e-9c51-11f0-9d2b-4e7c1a3f5b01"),
+				},
+				OutdialID: uuid.FromStringOrNil("8a4e2b40-9c51-11f0-a6c8-7b2d5e9f1c12"),
+			},
+			responseTargets: []omoutdialtarget.OutdialTarget{
+				{
+					ID:       uuid.FromStringOrNil("8a7d1a22-9c51-11f0-8e3f-1c6a9d2b4e23"),
+					Name:     "target 1",
+					Status:   omoutdialtarget.StatusDone,
+					TMCreate: &tmCreate,
+				},
+				{
+					ID:       uuid.FromStringOrNil("8aac0904-9c51-11f0-b7d1-5f3e8c1a6d34"),
+					Name:     "target 2",
+					Status:   omoutdialtarget.StatusIdle,
+					TMCreate: &tmCreate,
+				},
+			},
+			responseCampaigncalls: []*campaigncall.Campaigncall{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("8adaf7e6-9c51-11f0-9a4c-2d8b6f3e1a45"),
+					},
+					OutdialTargetID: uuid.FromStringOrNil("8a7d1a22-9c51-11f0-8e3f-1c6a9d2b4e23"),
+					Status:          campaigncall.StatusDone,
+					Result:          campaigncall.ResultSuccess,
+					Disposition:     "sale",
+					TryCount:        1,
+					TMCreate:        &tmCreate,
+					TMEnd:           &tmEnd,
+				},
+			},
+
+			expectTargetIDs: []uuid.UUID{
+				uuid.FromStringOrNil("8a7d1a22-9c51-11f0-8e3f-1c6a9d2b4e23"),
+				uuid.FromStringOrNil("8aac0904-9c51-11f0-b7d1-5f3e8c1a6d34"),
+			},
+			expectRes: []*campaignresult.CampaignResult{
+				{
+					CampaignID:      uuid.FromStringOrNil("8a1f3c5e-9c51-11f0-9d2b-4e7c1a3f5b01"),
+					OutdialTargetID: uuid.FromStringOrNil("8a7d1a22-9c51-11f0-8e3f-1c6a9d2b4e23"),
+					Name:            "target 1",
+					Status:          omoutdialtarget.StatusDone,
+					Attempts: []campaignresult.Attempt{
+						{
+							CampaigncallID: uuid.FromStringOrNil("8adaf7e6-9c51-11f0-9a4c-2d8b6f3e1a45"),
+							TryCount:       1,
+							Status:         campaigncall.StatusDone,
+							Result:         campaigncall.ResultSuccess,
+							Disposition:    "sale",
+							Duration:       30000,
+							TMCreate:       &tmCreate,
+							TMEnd:          &tmEnd,
+						},
+					},
+					TMCreate: &tmCreate,
+				},
+				{
+					CampaignID:      uuid.FromStringOrNil("8a1f3c5e-9c51-11f0-9d2b-4e7c1a3f5b01"),
+					OutdialTargetID: uuid.FromStringOrNil("8aac0904-9c51-11f0-b7d1-5f3e8c1a6d34"),
+					Name:            "target 2",
+					Status:          omoutdialtarget.StatusIdle,
+					Attempts:        []campaignresult.Attempt{},
+					TMCreate:        &tmCreate,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			h := &campaignHandler{
+				db:         mockDB,
+				reqHandler: mockReq,
+			}
+			ctx := context.Background()
+
+			mockDB.EXPECT().CampaignGet(ctx, tt.id).Return(tt.responseCampaign, nil)
+			mockReq.EXPECT().OutdialV1OutdialtargetGetsByOutdialID(ctx, tt.responseCampaign.OutdialID, tt.token, tt.size).Return(tt.responseTargets, nil)
+			mockDB.EXPECT().CampaigncallListByCampaignIDAndOutdialTargetIDs(ctx, tt.id, tt.expectTargetIDs).Return(tt.responseCampaigncalls, nil)
+
+			res, err := h.ResultList(ctx, tt.id, tt.token, tt.size)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_ResultList_noOutdial(t *testing.T) {
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockDB := dbhandler.NewMockDBHandler(mc)
+	h := &campaignHandler{
+		db: mockDB,
+	}
+	ctx := context.Background()
+
+	id := uuid.FromStringOrNil("b31c7e5a-9c51-11f0-8f6d-3a9e2c5b7d56")
+	mockDB.EXPECT().CampaignGet(ctx, id).Return(&campaign.Campaign{}, nil)
+
+	res, err := h.ResultList(ctx, id, "", 10)
+	if err != nil {
+		t.Errorf("Wrong match. expect: ok, got: %v", err)
+	}
+	if len(res) != 0 {
+		t.Errorf("Wrong match. expect: empty, got: %v", res)
+	}
+}
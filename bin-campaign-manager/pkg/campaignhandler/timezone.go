@@ -0,0 +1,654 @@
+package campaignhandler
+
+import (
+	"strings"
+	"time"
+
+	commonaddress "monorepo/bin-common-handler/models/address"
+	omoutdialtarget "monorepo/bin-outdial-manager/models/outdialtarget"
+
+	"github.com/sirupsen/logrus"
+
+	"monorepo/bin-campaign-manager/models/campaign"
+)
+
+// timezonePrefixes maps the E.164 number prefixes(without '+') to the time zones of the numbers.
+// The longest matched prefix is used. A prefix covering several time zones lists all of them,
+// and the calling window must be open in every listed time zone.
+var timezonePrefixes = map[string][]string{
+	"1":      {"America/New_York", "America/Chicago", "America/Denver", "America/Phoenix", "America/Los_Angeles", "America/Anchorage", "Pacific/Honolulu", "America/Halifax", "America/St_Johns"},
+	"1201":   {"America/New_York"},
+	"1202":   {"America/New_York"},
+	"1203":   {"America/New_York"},
+	"1204":   {"America/Winnipeg"},
+	"1205":   {"America/Chicago"},
+	"1206":   {"America/Los_Angeles"},
+	"1207":   {"America/New_York"},
+	"1208":   {"America/Denver", "America/Los_Angeles"},
+	"1209":   {"America/Los_Angeles"},
+	"1210":   {"America/Chicago"},
+	"1212":   {"America/New_York"},
+	"1213":   {"America/Los_Angeles"},
+	"1214":   {"America/Chicago"},
+	"1215":   {"America/New_York"},
+	"1216":   {"America/New_York"},
+	"1217":   {"America/Chicago"},
+	"1218":   {"America/Chicago"},
+	"1219":   {"America/Chicago"},
+	"1220":   {"America/New_York"},
+	"1223":   {"America/New_York"},
+	"1224":   {"America/Chicago"},
+	"1225":   {"America/Chicago"},
+	"1226":   {"America/Toronto"},
+	"1228":   {"America/Chicago"},
+	"1229":   {"America/New_York"},
+	"1231":   {"America/New_York"},
+	"1234":   {"America/New_York"},
+	"1236":   {"America/Vancouver"},
+	"1239":   {"America/New_York"},
+	"1240":   {"America/New_York"},
+	"1242":   {"America/Nassau"},
+	"1246":   {"America/Barbados"},
+	"1248":   {"America/New_York"},
+	"1249":   {"America/Toronto"},
+	"1250":   {"America/Vancouver"},
+	"1251":   {"America/Chicago"},
+	"1252":   {"America/New_York"},
+	"1253":   {"America/Los_Angeles"},
+	"1254":   {"America/Chicago"},
+	"1256":   {"America/Chicago"},
+	"1257":   {"America/Vancouver"},
+	"1260":   {"America/New_York"},
+	"1262":   {"America/Chicago"},
+	"1263":   {"America/Toronto"},
+	"1264":   {"America/Anguilla"},
+	"1267":   {"America/New_York"},
+	"1268":   {"America/Antigua"},
+	"1269":   {"America/New_York"},
+	"1270":   {"America/Chicago"},
+	"1272":   {"America/New_York"},
+	"1274":   {"America/Chicago"},
+	"1276":   {"America/New_York"},
+	"1279":   {"America/Los_Angeles"},
+	"1281":   {"America/Chicago"},
+	"1283":   {"America/New_York"},
+	"1284":   {"America/Tortola"},
+	"1289":   {"America/Toronto"},
+	"1301":   {"America/New_York"},
+	"1302":   {"America/New_York"},
+	"1303":   {"America/Denver"},
+	"1304":   {"America/New_York"},
+	"1305":   {"America/New_York"},
+	"1306":   {"America/Regina"},
+	"1307":   {"America/Denver"},
+	"1308":   {"America/Chicago", "America/Denver"},
+	"1309":   {"America/Chicago"},
+	"1310":   {"America/Los_Angeles"},
+	"1312":   {"America/Chicago"},
+	"1313":   {"America/New_York"},
+	"1314":   {"America/Chicago"},
+	"1315":   {"America/New_York"},
+	"1316":   {"America/Chicago"},
+	"1317":   {"America/New_York"},
+	"1318":   {"America/Chicago"},
+	"1319":   {"America/Chicago"},
+	"1320":   {"America/Chicago"},
+	"1321":   {"America/New_York"},
+	"1323":   {"America/Los_Angeles"},
+	"1325":   {"America/Chicago"},
+	"1326":   {"America/New_York"},
+	"1327":   {"America/Chicago"},
+	"1329":   {"America/New_York"},
+	"1330":   {"America/New_York"},
+	"1331":   {"America/Chicago"},
+	"1332":   {"America/New_York"},
+	"1334":   {"America/Chicago"},
+	"1336":   {"America/New_York"},
+	"1337":   {"America/Chicago"},
+	"1339":   {"America/New_York"},
+	"1340":   {"America/St_Thomas"},
+	"1341":   {"America/Los_Angeles"},
+	"1343":   {"America/Toronto"},
+	"1345":   {"America/Cayman"},
+	"1346":   {"America/Chicago"},
+	"1347":   {"America/New_York"},
+	"1350":   {"America/Los_Angeles"},
+	"1351":   {"America/New_York"},
+	"1352":   {"America/New_York"},
+	"1354":   {"America/Toronto"},
+	"1360":   {"America/Los_Angeles"},
+	"1361":   {"America/Chicago"},
+	"1363":   {"America/New_York"},
+	"1364":   {"America/Chicago"},
+	"1365":   {"America/Toronto"},
+	"1367":   {"America/Toronto"},
+	"1368":   {"America/Edmonton"},
+	"1380":   {"America/New_York"},
+	"1382":   {"America/Toronto"},
+	"1385":   {"America/Denver"},
+	"1386":   {"America/New_York"},
+	"1401":   {"America/New_York"},
+	"1402":   {"America/Chicago"},
+	"1403":   {"America/Edmonton"},
+	"1404":   {"America/New_York"},
+	"1405":   {"America/Chicago"},
+	"1406":   {"America/Denver"},
+	"1407":   {"America/New_York"},
+	"1408":   {"America/Los_Angeles"},
+	"1409":   {"America/Chicago"},
+	"1410":   {"America/New_York"},
+	"1412":   {"America/New_York"},
+	"1413":   {"America/New_York"},
+	"1414":   {"America/Chicago"},
+	"1415":   {"America/Los_Angeles"},
+	"1416":   {"America/Toronto"},
+	"1417":   {"America/Chicago"},
+	"1418":   {"America/Toronto"},
+	"1419":   {"America/New_York"},
+	"1423":   {"America/New_York"},
+	"1424":   {"America/Los_Angeles"},
+	"1425":   {"America/Los_Angeles"},
+	"1428":   {"America/Halifax"},
+	"1430":   {"America/Chicago"},
+	"1431":   {"America/Winnipeg"},
+	"1432":   {"America/Chicago"},
+	"1434":   {"America/New_York"},
+	"1435":   {"America/Denver"},
+	"1436":   {"America/New_York"},
+	"1437":   {"America/Toronto"},
+	"1438":   {"America/Toronto"},
+	"1440":   {"America/New_York"},
+	"1441":   {"Atlantic/Bermuda"},
+	"1442":   {"America/Los_Angeles"},
+	"1443":   {"America/New_York"},
+	"1445":   {"America/New_York"},
+	"1447":   {"America/Chicago"},
+	"1450":   {"America/Toronto"},
+	"1458":   {"America/Los_Angeles"},
+	"1463":   {"America/New_York"},
+	"1464":   {"America/Chicago"},
+	"1468":   {"America/Toronto"},
+	"1469":   {"America/Chicago"},
+	"1470":   {"America/New_York"},
+	"1472":   {"America/New_York"},
+	"1473":   {"America/Grenada"},
+	"1474":   {"America/Regina"},
+	"1475":   {"America/New_York"},
+	"1478":   {"America/New_York"},
+	"1479":   {"America/Chicago"},
+	"1480":   {"America/Phoenix"},
+	"1484":   {"America/New_York"},
+	"1501":   {"America/Chicago"},
+	"1502":   {"America/New_York"},
+	"1503":   {"America/Los_Angeles"},
+	"1504":   {"America/Chicago"},
+	"1505":   {"America/Denver"},
+	"1506":   {"America/Halifax"},
+	"1507":   {"America/Chicago"},
+	"1508":   {"America/New_York"},
+	"1509":   {"America/Los_Angeles"},
+	"1510":   {"America/Los_Angeles"},
+	"1512":   {"America/Chicago"},
+	"1513":   {"America/New_York"},
+	"1514":   {"America/Toronto"},
+	"1515":   {"America/Chicago"},
+	"1516":   {"America/New_York"},
+	"1517":   {"America/New_York"},
+	"1518":   {"America/New_York"},
+	"1519":   {"America/Toronto"},
+	"1520":   {"America/Phoenix"},
+	"1530":   {"America/Los_Angeles"},
+	"1531":   {"America/Chicago"},
+	"1534":   {"America/Chicago"},
+	"1539":   {"America/Chicago"},
+	"1540":   {"America/New_York"},
+	"1541":   {"America/Los_Angeles", "America/Denver"},
+	"1548":   {"America/Toronto"},
+	"1551":   {"America/New_York"},
+	"1557":   {"America/Chicago"},
+	"1559":   {"America/Los_Angeles"},
+	"1561":   {"America/New_York"},
+	"1562":   {"America/Los_Angeles"},
+	"1563":   {"America/Chicago"},
+	"1564":   {"America/Los_Angeles"},
+	"1567":   {"America/New_York"},
+	"1570":   {"America/New_York"},
+	"1571":   {"America/New_York"},
+	"1572":   {"America/Chicago"},
+	"1573":   {"America/Chicago"},
+	"1574":   {"America/New_York"},
+	"1575":   {"America/Denver"},
+	"1579":   {"America/Toronto"},
+	"1580":   {"America/Chicago"},
+	"1581":   {"America/Toronto"},
+	"1582":   {"America/New_York"},
+	"1584":   {"America/Winnipeg"},
+	"1585":   {"America/New_York"},
+	"1586":   {"America/New_York"},
+	"1587":   {"America/Edmonton"},
+	"1601":   {"America/Chicago"},
+	"1602":   {"America/Phoenix"},
+	"1603":   {"America/New_York"},
+	"1604":   {"America/Vancouver"},
+	"1605":   {"America/Chicago", "America/Denver"},
+	"1606":   {"America/New_York"},
+	"1607":   {"America/New_York"},
+	"1608":   {"America/Chicago"},
+	"1609":   {"America/New_York"},
+	"1610":   {"America/New_York"},
+	"1612":   {"America/Chicago"},
+	"1613":   {"America/Toronto"},
+	"1614":   {"America/New_York"},
+	"1615":   {"America/Chicago"},
+	"1616":   {"America/New_York"},
+	"1617":   {"America/New_York"},
+	"1618":   {"America/Chicago"},
+	"1619":   {"America/Los_Angeles"},
+	"1620":   {"America/Chicago"},
+	"1623":   {"America/Phoenix"},
+	"1626":   {"America/Los_Angeles"},
+	"1628":   {"America/Los_Angeles"},
+	"1629":   {"America/Chicago"},
+	"1630":   {"America/Chicago"},
+	"1631":   {"America/New_York"},
+	"1636":   {"America/Chicago"},
+	"1639":   {"America/Regina"},
+	"1640":   {"America/New_York"},
+	"1641":   {"America/Chicago"},
+	"1646":   {"America/New_York"},
+	"1647":   {"America/Toronto"},
+	"1649":   {"America/Grand_Turk"},
+	"1650":   {"America/Los_Angeles"},
+	"1651":   {"America/Chicago"},
+	"1656":   {"America/New_York"},
+	"1657":   {"America/Los_Angeles"},
+	"1658":   {"America/Jamaica"},
+	"1659":   {"America/Chicago"},
+	"1660":   {"America/Chicago"},
+	"1661":   {"America/Los_Angeles"},
+	"1662":   {"America/Chicago"},
+	"1664":   {"America/Montserrat"},
+	"1667":   {"America/New_York"},
+	"1669":   {"America/Los_Angeles"},
+	"1670":   {"Pacific/Saipan"},
+	"1671":   {"Pacific/Guam"},
+	"1672":   {"America/Vancouver"},
+	"1678":   {"America/New_York"},
+	"1679":   {"America/New_York"},
+	"1680":   {"America/New_York"},
+	"1681":   {"America/New_York"},
+	"1682":   {"America/Chicago"},
+	"1683":   {"America/Toronto"},
+	"1684":   {"Pacific/Pago_Pago"},
+	"1686":   {"America/New_York"},
+	"1689":   {"America/New_York"},
+	"1701":   {"America/Chicago", "America/Denver"},
+	"1702":   {"America/Los_Angeles"},
+	"1703":   {"America/New_York"},
+	"1704":   {"America/New_York"},
+	"1705":   {"America/Toronto"},
+	"1706":   {"America/New_York"},
+	"1707":   {"America/Los_Angeles"},
+	"1708":   {"America/Chicago"},
+	"1709":   {"America/St_Johns", "America/Halifax"},
+	"1712":   {"America/Chicago"},
+	"1713":   {"America/Chicago"},
+	"1714":   {"America/Los_Angeles"},
+	"1715":   {"America/Chicago"},
+	"1716":   {"America/New_York"},
+	"1717":   {"America/New_York"},
+	"1718":   {"America/New_York"},
+	"1719":   {"America/Denver"},
+	"1720":   {"America/Denver"},
+	"1721":   {"America/Lower_Princes"},
+	"1724":   {"America/New_York"},
+	"1725":   {"America/Los_Angeles"},
+	"1726":   {"America/Chicago"},
+	"1727":   {"America/New_York"},
+	"1730":   {"America/Chicago"},
+	"1731":   {"America/Chicago"},
+	"1732":   {"America/New_York"},
+	"1734":   {"America/New_York"},
+	"1737":   {"America/Chicago"},
+	"1740":   {"America/New_York"},
+	"1742":   {"America/Toronto"},
+	"1743":   {"America/New_York"},
+	"1747":   {"America/Los_Angeles"},
+	"1753":   {"America/Toronto"},
+	"1754":   {"America/New_York"},
+	"1757":   {"America/New_York"},
+	"1758":   {"America/St_Lucia"},
+	"1760":   {"America/Los_Angeles"},
+	"1762":   {"America/New_York"},
+	"1763":   {"America/Chicago"},
+	"1765":   {"America/New_York"},
+	"1767":   {"America/Dominica"},
+	"1769":   {"America/Chicago"},
+	"1770":   {"America/New_York"},
+	"1771":   {"America/New_York"},
+	"1772":   {"America/New_York"},
+	"1773":   {"America/Chicago"},
+	"1774":   {"America/New_York"},
+	"1775":   {"America/Los_Angeles"},
+	"1778":   {"America/Vancouver"},
+	"1779":   {"America/Chicago"},
+	"1780":   {"America/Edmonton"},
+	"1781":   {"America/New_York"},
+	"1782":   {"America/Halifax"},
+	"1784":   {"America/St_Vincent"},
+	"1785":   {"America/Chicago"},
+	"1786":   {"America/New_York"},
+	"1787":   {"America/Puerto_Rico"},
+	"1801":   {"America/Denver"},
+	"1802":   {"America/New_York"},
+	"1803":   {"America/New_York"},
+	"1804":   {"America/New_York"},
+	"1805":   {"America/Los_Angeles"},
+	"1806":   {"America/Chicago"},
+	"1807":   {"America/Toronto", "America/Winnipeg"},
+	"1808":   {"Pacific/Honolulu"},
+	"1809":   {"America/Santo_Domingo"},
+	"1810":   {"America/New_York"},
+	"1812":   {"America/New_York", "America/Chicago"},
+	"1813":   {"America/New_York"},
+	"1814":   {"America/New_York"},
+	"1815":   {"America/Chicago"},
+	"1816":   {"America/Chicago"},
+	"1817":   {"America/Chicago"},
+	"1818":   {"America/Los_Angeles"},
+	"1819":   {"America/Toronto"},
+	"1820":   {"America/Los_Angeles"},
+	"1825":   {"America/Edmonton"},
+	"1826":   {"America/New_York"},
+	"1828":   {"America/New_York"},
+	"1829":   {"America/Santo_Domingo"},
+	"1830":   {"America/Chicago"},
+	"1831":   {"America/Los_Angeles"},
+	"1832":   {"America/Chicago"},
+	"1835":   {"America/New_York"},
+	"1838":   {"America/New_York"},
+	"1839":   {"America/New_York"},
+	"1840":   {"America/Los_Angeles"},
+	"1843":   {"America/New_York"},
+	"1845":   {"America/New_York"},
+	"1847":   {"America/Chicago"},
+	"1848":   {"America/New_York"},
+	"1849":   {"America/Santo_Domingo"},
+	"1850":   {"America/New_York", "America/Chicago"},
+	"1854":   {"America/New_York"},
+	"1856":   {"America/New_York"},
+	"1857":   {"America/New_York"},
+	"1858":   {"America/Los_Angeles"},
+	"1859":   {"America/New_York"},
+	"1860":   {"America/New_York"},
+	"1861":   {"America/Chicago"},
+	"1862":   {"America/New_York"},
+	"1863":   {"America/New_York"},
+	"1864":   {"America/New_York"},
+	"1865":   {"America/New_York"},
+	"1867":   {"America/Whitehorse", "America/Edmonton", "America/Iqaluit"},
+	"1868":   {"America/Port_of_Spain"},
+	"1869":   {"America/St_Kitts"},
+	"1870":   {"America/Chicago"},
+	"1872":   {"America/Chicago"},
+	"1873":   {"America/Toronto"},
+	"1876":   {"America/Jamaica"},
+	"1878":   {"America/New_York"},
+	"1901":   {"America/Chicago"},
+	"1902":   {"America/Halifax"},
+	"1903":   {"America/Chicago"},
+	"1904":   {"America/New_York"},
+	"1906":   {"America/New_York", "America/Chicago"},
+	"1907":   {"America/Anchorage"},
+	"1908":   {"America/New_York"},
+	"1909":   {"America/Los_Angeles"},
+	"1910":   {"America/New_York"},
+	"1912":   {"America/New_York"},
+	"1913":   {"America/Chicago"},
+	"1914":   {"America/New_York"},
+	"1915":   {"America/Denver"},
+	"1916":   {"America/Los_Angeles"},
+	"1917":   {"America/New_York"},
+	"1918":   {"America/Chicago"},
+	"1919":   {"America/New_York"},
+	"1920":   {"America/Chicago"},
+	"1925":   {"America/Los_Angeles"},
+	"1928":   {"America/Phoenix"},
+	"1929":   {"America/New_York"},
+	"1930":   {"America/New_York", "America/Chicago"},
+	"1931":   {"America/Chicago"},
+	"1934":   {"America/New_York"},
+	"1936":   {"America/Chicago"},
+	"1937":   {"America/New_York"},
+	"1938":   {"America/Chicago"},
+	"1939":   {"America/Puerto_Rico"},
+	"1940":   {"America/Chicago"},
+	"1941":   {"America/New_York"},
+	"1943":   {"America/New_York"},
+	"1945":   {"America/Chicago"},
+	"1947":   {"America/New_York"},
+	"1948":   {"America/New_York"},
+	"1949":   {"America/Los_Angeles"},
+	"1951":   {"America/Los_Angeles"},
+	"1952":   {"America/Chicago"},
+	"1954":   {"America/New_York"},
+	"1956":   {"America/Chicago"},
+	"1959":   {"America/New_York"},
+	"1970":   {"America/Denver"},
+	"1971":   {"America/Los_Angeles"},
+	"1972":   {"America/Chicago"},
+	"1973":   {"America/New_York"},
+	"1975":   {"America/Chicago"},
+	"1978":   {"America/New_York"},
+	"1979":   {"America/Chicago"},
+	"1980":   {"America/New_York"},
+	"1983":   {"America/Denver"},
+	"1984":   {"America/New_York"},
+	"1985":   {"America/Chicago"},
+	"1986":   {"America/Denver", "America/Los_Angeles"},
+	"1989":   {"America/New_York"},
+	"20":     {"Africa/Cairo"},
+	"212":    {"Africa/Casablanca"},
+	"234":    {"Africa/Lagos"},
+	"254":    {"Africa/Nairobi"},
+	"27":     {"Africa/Johannesburg"},
+	"30":     {"Europe/Athens"},
+	"31":     {"Europe/Amsterdam"},
+	"32":     {"Europe/Brussels"},
+	"33":     {"Europe/Paris"},
+	"34":     {"Europe/Madrid"},
+	"346":    {"Europe/Madrid", "Atlantic/Canary"},
+	"347":    {"Europe/Madrid", "Atlantic/Canary"},
+	"34822":  {"Atlantic/Canary"},
+	"34828":  {"Atlantic/Canary"},
+	"34922":  {"Atlantic/Canary"},
+	"34928":  {"Atlantic/Canary"},
+	"351":    {"Europe/Lisbon"},
+	"351292": {"Atlantic/Azores"},
+	"351295": {"Atlantic/Azores"},
+	"351296": {"Atlantic/Azores"},
+	"3519":   {"Europe/Lisbon", "Atlantic/Azores"},
+	"353":    {"Europe/Dublin"},
+	"354":    {"Atlantic/Reykjavik"},
+	"358":    {"Europe/Helsinki"},
+	"359":    {"Europe/Sofia"},
+	"36":     {"Europe/Budapest"},
+	"370":    {"Europe/Vilnius"},
+	"371":    {"Europe/Riga"},
+	"372":    {"Europe/Tallinn"},
+	"375":    {"Europe/Minsk"},
+	"380":    {"Europe/Kyiv"},
+	"381":    {"Europe/Belgrade"},
+	"385":    {"Europe/Zagreb"},
+	"386":    {"Europe/Ljubljana"},
+	"39":     {"Europe/Rome"},
+	"40":     {"Europe/Bucharest"},
+	"41":     {"Europe/Zurich"},
+	"420":    {"Europe/Prague"},
+	"421":    {"Europe/Bratislava"},
+	"43":     {"Europe/Vienna"},
+	"44":     {"Europe/London"},
+	"45":     {"Europe/Copenhagen"},
+	"46":     {"Europe/Stockholm"},
+	"47":     {"Europe/Oslo"},
+	"48":     {"Europe/Warsaw"},
+	"49":     {"Europe/Berlin"},
+	"502":    {"America/Guatemala"},
+	"503":    {"America/El_Salvador"},
+	"504":    {"America/Tegucigalpa"},
+	"505":    {"America/Managua"},
+	"506":    {"America/Costa_Rica"},
+	"507":    {"America/Panama"},
+	"509":    {"America/Port-au-Prince"},
+	"51":     {"America/Lima"},
+	"52":     {"America/Mexico_City"},
+	"52612":  {"America/Mazatlan"},
+	"52616":  {"America/Tijuana"},
+	"52624":  {"America/Mazatlan"},
+	"52631":  {"America/Hermosillo"},
+	"52642":  {"America/Hermosillo"},
+	"52644":  {"America/Hermosillo"},
+	"52646":  {"America/Tijuana"},
+	"52656":  {"America/Ciudad_Juarez"},
+	"52661":  {"America/Tijuana"},
+	"52662":  {"America/Hermosillo"},
+	"52664":  {"America/Tijuana"},
+	"52665":  {"America/Tijuana"},
+	"52667":  {"America/Mazatlan"},
+	"52669":  {"America/Mazatlan"},
+	"52686":  {"America/Tijuana"},
+	"52983":  {"America/Cancun"},
+	"52984":  {"America/Cancun"},
+	"52987":  {"America/Cancun"},
+	"52998":  {"America/Cancun"},
+	"53":     {"America/Havana"},
+	"54":     {"America/Argentina/Buenos_Aires"},
+	"55":     {"America/Sao_Paulo"},
+	"5565":   {"America/Cuiaba"},
+	"5566":   {"America/Cuiaba"},
+	"5567":   {"America/Campo_Grande"},
+	"5568":   {"America/Rio_Branco"},
+	"5569":   {"America/Porto_Velho"},
+	"5592":   {"America/Manaus"},
+	"5595":   {"America/Boa_Vista"},
+	"5597":   {"America/Manaus"},
+	"56":     {"America/Santiago"},
+	"57":     {"America/Bogota"},
+	"58":     {"America/Caracas"},
+	"591":    {"America/La_Paz"},
+	"593":    {"America/Guayaquil"},
+	"595":    {"America/Asuncion"},
+	"598":    {"America/Montevideo"},
+	"60":     {"Asia/Kuala_Lumpur"},
+	"61":     {"Australia/Sydney", "Australia/Brisbane", "Australia/Adelaide", "Australia/Darwin", "Australia/Perth"},
+	"612":    {"Australia/Sydney"},
+	"613":    {"Australia/Melbourne", "Australia/Hobart"},
+	"617":    {"Australia/Brisbane"},
+	"618":    {"Australia/Adelaide", "Australia/Darwin", "Australia/Perth"},
+	"62":     {"Asia/Jakarta", "Asia/Makassar", "Asia/Jayapura"},
+	"6221":   {"Asia/Jakarta"},
+	"6222":   {"Asia/Jakarta"},
+	"6224":   {"Asia/Jakarta"},
+	"6231":   {"Asia/Jakarta"},
+	"62361":  {"Asia/Makassar"},
+	"62411":  {"Asia/Makassar"},
+	"6261":   {"Asia/Jakarta"},
+	"62967":  {"Asia/Jayapura"},
+	"63":     {"Asia/Manila"},
+	"64":     {"Pacific/Auckland"},
+	"65":     {"Asia/Singapore"},
+	"66":     {"Asia/Bangkok"},
+	"7":      {"Europe/Kaliningrad", "Europe/Moscow", "Europe/Samara", "Asia/Yekaterinburg", "Asia/Omsk", "Asia/Novosibirsk", "Asia/Krasnoyarsk", "Asia/Irkutsk", "Asia/Yakutsk", "Asia/Vladivostok", "Asia/Magadan", "Asia/Kamchatka"},
+	"7343":   {"Asia/Yekaterinburg"},
+	"7381":   {"Asia/Omsk"},
+	"7383":   {"Asia/Novosibirsk"},
+	"7391":   {"Asia/Krasnoyarsk"},
+	"7395":   {"Asia/Irkutsk"},
+	"7401":   {"Europe/Kaliningrad"},
+	"7423":   {"Asia/Vladivostok"},
+	"7495":   {"Europe/Moscow"},
+	"7499":   {"Europe/Moscow"},
+	"76":     {"Asia/Almaty"},
+	"77":     {"Asia/Almaty"},
+	"7812":   {"Europe/Moscow"},
+	"7846":   {"Europe/Samara"},
+	"81":     {"Asia/Tokyo"},
+	"82":     {"Asia/Seoul"},
+	"84":     {"Asia/Ho_Chi_Minh"},
+	"852":    {"Asia/Hong_Kong"},
+	"853":    {"Asia/Macau"},
+	"86":     {"Asia/Shanghai"},
+	"880":    {"Asia/Dhaka"},
+	"886":    {"Asia/Taipei"},
+	"90":     {"Europe/Istanbul"},
+	"91":     {"Asia/Kolkata"},
+	"92":     {"Asia/Karachi"},
+	"94":     {"Asia/Colombo"},
+	"965":    {"Asia/Kuwait"},
+	"966":    {"Asia/Riyadh"},
+	"968":    {"Asia/Muscat"},
+	"971":    {"Asia/Dubai"},
+	"972":    {"Asia/Jerusalem"},
+	"973":    {"Asia/Bahrain"},
+	"974":    {"Asia/Qatar"},
+	"977":    {"Asia/Kathmandu"},
+}
+
+// timezonePrefixMaxLen is the longest prefix length of the timezonePrefixes.
+const timezonePrefixMaxLen = 6
+
+// getTimezonesByNumber returns the time zone names of the given E.164 number.
+// Returns nil if the number's time zone is unknown.
+func getTimezonesByNumber(number string) []string {
+	if !strings.HasPrefix(number, "+") {
+		return nil
+	}
+	digits := number[1:]
+
+	for i := min(len(digits), timezonePrefixMaxLen); i > 0; i-- {
+		if res, ok := timezonePrefixes[digits[:i]]; ok {
+			return res
+		}
+	}
+
+	return nil
+}
+
+// getTargetLocations returns the locations of the callee to evaluate the calling windows.
+// The target's timezone comes first, then the time zones of the destination number,
+// then the campaign's timezone. UTC is used if none of them is available.
+func getTargetLocations(c *campaign.Campaign, target *omoutdialtarget.OutdialTarget, destination *commonaddress.Address) []*time.Location {
+	log := logrus.WithFields(logrus.Fields{
+		"func":        "getTargetLocations",
+		"campaign_id": c.ID,
+		"target_id":   target.ID,
+	})
+
+	names := []string{}
+	switch {
+	case target.Timezone != "":
+		names = append(names, target.Timezone)
+
+	case destination.Type == commonaddress.TypeTel && len(getTimezonesByNumber(destination.Target)) > 0:
+		names = append(names, getTimezonesByNumber(destination.Target)...)
+
+	case c.Timezone != "":
+		names = append(names, c.Timezone)
+	}
+
+	res := []*time.Location{}
+	for _, name := range names {
+		loc, err := time.LoadLocation(name)
+		if err != nil {
+			log.Errorf("Could not load the time zone. Skipping it. timezone: %s, err: %v", name, err)
+			continue
+		}
+		res = append(res, loc)
+	}
+
+	if len(res) == 0 {
+		res = append(res, time.UTC)
+	}
+
+	return res
+}
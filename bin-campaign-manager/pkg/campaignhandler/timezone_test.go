@@ -0,0 +1,130 @@
+package campaignhandler
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	commonaddress "monorepo/bin-common-handler/models/address"
+	omoutdialtarget "monorepo/bin-outdial-manager/models/outdialtarget"
+
+	"monorepo/bin-campaign-manager/models/campaign"
+)
+
+func Test_timezonePrefixes(t *testing.T) {
+	for prefix, names := range timezonePrefixes {
+		if len(prefix) > timezonePrefixMaxLen {
+			t.Errorf("Wrong match. The prefix is longer than the max length. prefix: %s", prefix)
+		}
+
+		for _, name := range names {
+			if _, err := time.LoadLocation(name); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v. prefix: %s", err, prefix)
+			}
+		}
+	}
+}
+
+func Test_getTimezonesByNumber(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		number string
+
+		expectRes []string
+	}{
+		{"korea", "+821100000001", []string{"Asia/Seoul"}},
+		{"us area code", "+12125550100", []string{"America/New_York"}},
+		{"us split area code", "+18505550100", []string{"America/New_York", "America/Chicago"}},
+		{"spain canary islands", "+34928000000", []string{"Atlantic/Canary"}},
+		{"spain mainland", "+34910000000", []string{"Europe/Madrid"}},
+		{"unknown country code", "+99900000000", nil},
+		{"not e.164", "0212555010", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := getTimezonesByNumber(tt.number)
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match. expect: %v, got: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_getTargetLocations(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		campaign    *campaign.Campaign
+		target      *omoutdialtarget.OutdialTarget
+		destination *commonaddress.Address
+
+		expectRes []string
+	}{
+		{
+			name: "target timezone",
+
+			campaign: &campaign.Campaign{Timezone: "Europe/London"},
+			target:   &omoutdialtarget.OutdialTarget{Timezone: "America/Denver"},
+			destination: &commonaddress.Address{
+				Type:   commonaddress.TypeTel,
+				Target: "+821100000001",
+			},
+
+			expectRes: []string{"America/Denver"},
+		},
+		{
+			name: "destination number",
+
+			campaign: &campaign.Campaign{Timezone: "Europe/London"},
+			target:   &omoutdialtarget.OutdialTarget{},
+			destination: &commonaddress.Address{
+				Type:   commonaddress.TypeTel,
+				Target: "+821100000001",
+			},
+
+			expectRes: []string{"Asia/Seoul"},
+		},
+		{
+			name: "campaign timezone",
+
+			campaign: &campaign.Campaign{Timezone: "Europe/London"},
+			target:   &omoutdialtarget.OutdialTarget{},
+			destination: &commonaddress.Address{
+				Type:   commonaddress.TypeEmail,
+				Target: "test@voipbin.net",
+			},
+
+			expectRes: []string{"Europe/London"},
+		},
+		{
+			name: "utc",
+
+			campaign: &campaign.Campaign{},
+			target:   &omoutdialtarget.OutdialTarget{},
+			destination: &commonaddress.Address{
+				Type:   commonaddress.TypeTel,
+				Target: "+99900000000",
+			},
+
+			expectRes: []string{"UTC"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := getTargetLocations(tt.campaign, tt.target, tt.destination)
+
+			names := []string{}
+			for _, loc := range res {
+				names = append(names, loc.String())
+			}
+			if !reflect.DeepEqual(names, tt.expectRes) {
+				t.Errorf("Wrong match. expect: %v, got: %v", tt.expectRes, names)
+			}
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package campaignresultjobhandler
+
+import (
+	"context"
+	stderrors "errors"
+
+	cerrors "monorepo/bin-common-handler/models/errors"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	commonoutline "monorepo/bin-common-handler/models/outline"
+
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+
+	"monorepo/bin-campaign-manager/models/campaignresultjob"
+	"monorepo/bin-campaign-manager/pkg/dbhandler"
+)
+
+// Create starts a new job which exports the campaign's results to the csv file.
+// Each row of the file is the dialing attempt. The outdial target without any attempt has a single row with the empty attempt columns.
+func (h *campaignResultJobHandler) Create(ctx context.Context, campaignID uuid.UUID) (*campaignresultjob.CampaignResultJob, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":        "Create",
+		"campaign_id": campaignID,
+	})
+
+	c, err := h.campaignHandler.Get(ctx, campaignID)
+	if err != nil {
+		log.Errorf("Could not get the campaign. err: %v", err)
+		return nil, err
+	}
+
+	if c.TMDelete != nil {
+		return nil, cerrors.NotFound(
+			commonoutline.ServiceNameCampaignManager,
+			"CAMPAIGN_NOT_FOUND",
+			"The campaign was not found.",
+		)
+	}
+
+	id := uuid.Must(uuid.NewV4())
+	j := &campaignresultjob.CampaignResultJob{
+		Identity: commonidentity.Identity{
+			ID:         id,
+			CustomerID: c.CustomerID,
+		},
+		CampaignID: c.ID,
+
+		Status: campaignresultjob.StatusProcessing,
+	}
+
+	if errCreate := h.db.CampaignResultJobCreate(ctx, j); errCreate != nil {
+		log.Errorf("Could not create the campaignresultjob. err: %v", errCreate)
+		return nil, errCreate
+	}
+
+	res, err := h.Get(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get the created campaignresultjob. err: %v", err)
+		return nil, err
+	}
+	log.WithField("campaignresultjob", res).Debugf("Created the campaignresultjob. campaignresultjob_id: %s", res.ID)
+	h.notifyHandler.PublishWebhookEvent(ctx, res.CustomerID, campaignresultjob.EventTypeCampaignResultJobCreated, res)
+
+	go h.run(res)
+
+	return res, nil
+}
+
+// Get returns the campaignresultjob.
+func (h *campaignResultJobHandler) Get(ctx context.Context, id uuid.UUID) (*campaignresultjob.CampaignResultJob, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":                 "Get",
+		"campaignresultjob_id": id,
+	})
+
+	res, err := h.db.CampaignResultJobGet(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get campaignresultjob. err: %v", err)
+		if stderrors.Is(err, dbhandler.ErrNotFound) {
+			return nil, cerrors.NotFound(
+				commonoutline.ServiceNameCampaignManager,
+				"CAMPAIGNRESULTJOB_NOT_FOUND",
+				"The campaignresultjob was not found.",
+			).Wrap(err)
+		}
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// List returns list of campaignresultjobs with filters.
+func (h *campaignResultJobHandler) List(ctx context.Context, token string, limit uint64, filters map[campaignresultjob.Field]any) ([]*campaignresultjob.CampaignResultJob, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":    "List",
+		"token":   token,
+		"limit":   limit,
+		"filters": filters,
+	})
+	log.Debug("Getting campaignresultjobs with filters.")
+
+	res, err := h.db.CampaignResultJobList(ctx, token, limit, filters)
+	if err != nil {
+		log.Errorf("Could not get campaignresultjobs. err: %v", err)
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// updateProgress stores the job's counts and notifies the progress.
+func (h *campaignResultJobHandler) updateProgress(ctx context.Context, j *campaignresultjob.CampaignResultJob) {
+	fields := map[campaignresultjob.Field]any{
+		campaignresultjob.FieldTargetCount: j.TargetCount,
+		campaignresultjob.FieldRowCount:    j.RowCount,
+	}
+	h.update(ctx, j, fields)
+}
+
+// finish stores the job's result with the given status.
+func (h *campaignResultJobHandler) finish(ctx context.Context, j *campaignresultjob.CampaignResultJob, status campaignresultjob.Status, detail string) {
+	j.Status = status
+	j.Detail = detail
+
+	fields := map[campaignresultjob.Field]any{
+		campaignresultjob.FieldStatus: status,
+
+		campaignresultjob.FieldFileID: j.FileID,
+
+		campaignresultjob.FieldTargetCount: j.TargetCount,
+		campaignresultjob.FieldRowCount:    j.RowCount,
+
+		campaignresultjob.FieldDetail: detail,
+	}
+	h.update(ctx, j, fields)
+}
+
+// update updates the job's fields and publishes the updated event.
+func (h *campaignResultJobHandler) update(ctx context.Context, j *campaignresultjob.CampaignResultJob, fields map[campaignresultjob.Field]any) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":                 "update",
+		"campaignresultjob_id": j.ID,
+	})
+
+	if errUpdate := h.db.CampaignResultJobUpdate(ctx, j.ID, fields); errUpdate != nil {
+		log.Errorf("Could not update the campaignresultjob. err: %v", errUpdate)
+		return
+	}
+
+	res, err := h.Get(ctx, j.ID)
+	if err != nil {
+		log.Errorf("Could not get updated campaignresultjob. err: %v", err)
+		return
+	}
+	h.notifyHandler.PublishWebhookEvent(ctx, res.CustomerID, campaignresultjob.EventTypeCampaignResultJobUpdated, res)
+}
@@ -0,0 +1,181 @@
+package campaignresultjobhandler
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	"monorepo/bin-campaign-manager/models/campaign"
+	"monorepo/bin-campaign-manager/models/campaignresultjob"
+	"monorepo/bin-campaign-manager/pkg/campaignhandler"
+	"monorepo/bin-campaign-manager/pkg/dbhandler"
+)
+
+func Test_Create_error(t *testing.T) {
+
+	tmDelete := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+
+		campaignID uuid.UUID
+
+		responseCampaign    *campaign.Campaign
+		responseCampaignErr error
+	}{
+		{
+			name: "campaign not found",
+
+			campaignID: uuid.FromStringOrNil("c21f3a40-ad5f-11f0-8d9e-7a9c1e3f5b10"),
+
+			responseCampaignErr: fmt.Errorf("not found"),
+		},
+		{
+			name: "campaign deleted",
+
+			campaignID: uuid.FromStringOrNil("c21f3a40-ad5f-11f0-8d9e-7a9c1e3f5b10"),
+
+			responseCampaign: &campaign.Campaign{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("c21f3a40-ad5f-11f0-8d9e-7a9c1e3f5b10"),
+					CustomerID: uuid.FromStringOrNil("c2504b52-ad5f-11f0-9eaf-8b1d2f4a6c20"),
+				},
+				TMDelete: &tmDelete,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockCampaign := campaignhandler.NewMockCampaignHandler(mc)
+
+			h := &campaignResultJobHandler{
+				db:              mockDB,
+				campaignHandler: mockCampaign,
+			}
+			ctx := context.Background()
+
+			mockCampaign.EXPECT().Get(ctx, tt.campaignID).Return(tt.responseCampaign, tt.responseCampaignErr)
+
+			if _, err := h.Create(ctx, tt.campaignID); err == nil {
+				t.Errorf("Wrong match. expect: error, got: ok")
+			}
+		})
+	}
+}
+
+func Test_Get(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		id uuid.UUID
+
+		responseJob *campaignresultjob.CampaignResultJob
+	}{
+		{
+			name: "normal",
+
+			id: uuid.FromStringOrNil("e31a4b50-ad5f-11f0-8f1a-9c2e4a6c8d10"),
+
+			responseJob: &campaignresultjob.CampaignResultJob{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("e31a4b50-ad5f-11f0-8f1a-9c2e4a6c8d10"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+
+			h := &campaignResultJobHandler{
+				db: mockDB,
+			}
+			ctx := context.Background()
+
+			mockDB.EXPECT().CampaignResultJobGet(ctx, tt.id).Return(tt.responseJob, nil)
+
+			res, err := h.Get(ctx, tt.id)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.responseJob) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.responseJob, res)
+			}
+		})
+	}
+}
+
+func Test_List(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		token   string
+		limit   uint64
+		filters map[campaignresultjob.Field]any
+
+		responseJobs []*campaignresultjob.CampaignResultJob
+	}{
+		{
+			name: "normal",
+
+			token: "2026-01-01T00:00:00.000000Z",
+			limit: 10,
+			filters: map[campaignresultjob.Field]any{
+				campaignresultjob.FieldCampaignID: uuid.FromStringOrNil("e34b5c62-ad5f-11f0-902b-1d3f5b7d9e20"),
+				campaignresultjob.FieldDeleted:    false,
+			},
+
+			responseJobs: []*campaignresultjob.CampaignResultJob{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("e37c6d74-ad5f-11f0-a13c-2e4a6c8e1f30"),
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+
+			h := &campaignResultJobHandler{
+				db: mockDB,
+			}
+			ctx := context.Background()
+
+			mockDB.EXPECT().CampaignResultJobList(ctx, tt.token, tt.limit, tt.filters).Return(tt.responseJobs, nil)
+
+			res, err := h.List(ctx, tt.token, tt.limit, tt.filters)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.responseJobs) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.responseJobs, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,184 @@
+package campaignresultjobhandler
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"monorepo/bin-common-handler/pkg/utilhandler"
+
+	omoutdialtarget "monorepo/bin-outdial-manager/models/outdialtarget"
+
+	"github.com/sirupsen/logrus"
+
+	"monorepo/bin-campaign-manager/models/campaignresult"
+	"monorepo/bin-campaign-manager/models/campaignresultjob"
+)
+
+const (
+	exportPageSize   = 100  // the number of the outdial targets fetched at once
+	progressInterval = 1000 // the number of the outdial targets between the progress updates
+)
+
+// exportHeader is the header row of the exported campaign results csv.
+var exportHeader = []string{
+	"outdial_target_id",
+	"name",
+	"target_status",
+	"campaigncall_id",
+	"destination",
+	"destination_index",
+	"try_count",
+	"status",
+	"result",
+	"disposition",
+	"abandoned",
+	"duration",
+	"talk_duration",
+	"tm_create",
+	"tm_progressing",
+	"tm_end",
+}
+
+// exportRun writes the campaign's results to the csv file.
+func (h *campaignResultJobHandler) exportRun(ctx context.Context, j *campaignresultjob.CampaignResultJob) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":                 "exportRun",
+		"campaignresultjob_id": j.ID,
+	})
+
+	if errExport := h.exportFile(ctx, j); errExport != nil {
+		log.Errorf("Could not export the campaign results. err: %v", errExport)
+		h.finish(ctx, j, campaignresultjob.StatusFailed, "Could not export the campaign results.")
+		return
+	}
+	log.Debugf("Exported the campaign results. targets: %d, rows: %d, file_id: %s", j.TargetCount, j.RowCount, j.FileID)
+
+	h.finish(ctx, j, campaignresultjob.StatusDone, "")
+}
+
+// exportFile writes the campaign's results to the csv file and stores it as the job's file.
+// The results are paged with the outdial target's (tm_create, id) cursor,
+// so the targets sharing the same tm_create are neither skipped nor duplicated.
+func (h *campaignResultJobHandler) exportFile(ctx context.Context, j *campaignresultjob.CampaignResultJob) error {
+	log := logrus.WithFields(logrus.Fields{
+		"func":                 "exportFile",
+		"campaignresultjob_id": j.ID,
+	})
+
+	dst, err := os.CreateTemp("", "campaign_results_*")
+	if err != nil {
+		return fmt.Errorf("could not create the temp file: %w", err)
+	}
+	defer func() {
+		_ = dst.Close()
+		_ = os.Remove(dst.Name())
+	}()
+
+	w := csv.NewWriter(dst)
+	if errWrite := w.Write(exportHeader); errWrite != nil {
+		return fmt.Errorf("could not write the header: %w", errWrite)
+	}
+
+	token := ""
+	for {
+		results, err := h.campaignHandler.ResultList(ctx, j.CampaignID, token, exportPageSize)
+		if err != nil {
+			return fmt.Errorf("could not get the campaign results: %w", err)
+		}
+
+		for _, r := range results {
+			records := exportRecords(r)
+			if errWrite := w.WriteAll(records); errWrite != nil {
+				return fmt.Errorf("could not write the campaign result: %w", errWrite)
+			}
+			j.TargetCount++
+			j.RowCount += len(records)
+			promCampaignResultJobRowTotal.Add(float64(len(records)))
+
+			if j.TargetCount%progressInterval == 0 {
+				h.updateProgress(ctx, j)
+			}
+		}
+
+		if len(results) < exportPageSize {
+			break
+		}
+
+		last := results[len(results)-1]
+		if last.TMCreate == nil {
+			return fmt.Errorf("the outdial target has no tm_create. outdial_target_id: %s", last.OutdialTargetID)
+		}
+		token = omoutdialtarget.CursorToken(last.TMCreate, last.OutdialTargetID)
+	}
+
+	w.Flush()
+	if errFlush := w.Error(); errFlush != nil {
+		return fmt.Errorf("could not flush the file: %w", errFlush)
+	}
+
+	fileID, err := h.storeFile(ctx, j, dst.Name(), "campaign results export", fmt.Sprintf("campaign_%s_results.csv", j.CampaignID))
+	if err != nil {
+		return fmt.Errorf("could not store the file: %w", err)
+	}
+	log.Debugf("Stored the exported file. file_id: %s", fileID)
+	j.FileID = fileID
+
+	return nil
+}
+
+// exportRecords returns the csv records of the given campaign result.
+func exportRecords(r *campaignresult.CampaignResult) [][]string {
+	target := []string{
+		r.OutdialTargetID.String(),
+		r.Name,
+		string(r.Status),
+	}
+
+	if len(r.Attempts) == 0 {
+		record := append([]string{}, target...)
+		for len(record) < len(exportHeader) {
+			record = append(record, "")
+		}
+		return [][]string{record}
+	}
+
+	res := [][]string{}
+	for _, at := range r.Attempts {
+		destination := ""
+		if at.Destination != nil {
+			destination = at.Destination.Target
+		}
+
+		record := append([]string{}, target...)
+		record = append(record,
+			at.CampaigncallID.String(),
+			destination,
+			strconv.Itoa(at.DestinationIndex),
+			strconv.Itoa(at.TryCount),
+			string(at.Status),
+			string(at.Result),
+			at.Disposition,
+			strconv.FormatBool(at.Abandoned),
+			strconv.Itoa(at.Duration),
+			strconv.Itoa(at.TalkDuration),
+			exportTime(at.TMCreate),
+			exportTime(at.TMProgressing),
+			exportTime(at.TMEnd),
+		)
+		res = append(res, record)
+	}
+
+	return res
+}
+
+// exportTime returns the csv value of the given timestamp. empty if not set.
+func exportTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(utilhandler.ISO8601Layout)
+}
@@ -0,0 +1,199 @@
+package campaignresultjobhandler
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	commonaddress "monorepo/bin-common-handler/models/address"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/requesthandler"
+	smfile "monorepo/bin-storage-manager/models/file"
+
+	omoutdialtarget "monorepo/bin-outdial-manager/models/outdialtarget"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	"monorepo/bin-campaign-manager/models/campaigncall"
+	"monorepo/bin-campaign-manager/models/campaignresult"
+	"monorepo/bin-campaign-manager/models/campaignresultjob"
+	"monorepo/bin-campaign-manager/pkg/campaignhandler"
+)
+
+func Test_exportFile(t *testing.T) {
+
+	tmCreate := time.Date(2026, 10, 1, 3, 30, 0, 0, time.UTC)
+	tmProgressing := time.Date(2026, 10, 1, 3, 30, 10, 0, time.UTC)
+	tmEnd := time.Date(2026, 10, 1, 3, 31, 40, 0, time.UTC)
+
+	tests := []struct {
+		name string
+
+		job *campaignresultjob.CampaignResultJob
+
+		responseResults []*campaignresult.CampaignResult
+		responseFile    *smfile.File
+
+		expectContent     string
+		expectTargetCount int
+		expectRowCount    int
+	}{
+		{
+			name: "normal",
+
+			job: &campaignresultjob.CampaignResultJob{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("6a1b3c40-ad5f-11f0-8e2f-1a3c5e7a9b10"),
+					CustomerID: uuid.FromStringOrNil("6a4c4d52-ad5f-11f0-9f3a-2b4d6f8b1c20"),
+				},
+				CampaignID: uuid.FromStringOrNil("6a7d5e64-ad5f-11f0-a04b-3c5e7a9c2d30"),
+			},
+
+			responseResults: []*campaignresult.CampaignResult{
+				{
+					OutdialTargetID: uuid.FromStringOrNil("6aae6f76-ad5f-11f0-b15c-4d6f8b1d3e40"),
+					Name:            "alice",
+					Status:          omoutdialtarget.StatusDone,
+					Attempts: []campaignresult.Attempt{
+						{
+							CampaigncallID: uuid.FromStringOrNil("6adf8088-ad5f-11f0-826d-5e7a9c2e4f50"),
+							Destination: &commonaddress.Address{
+								Type:   commonaddress.TypeTel,
+								Target: "+821100000001",
+							},
+							TryCount:      1,
+							Status:        campaigncall.StatusDone,
+							Result:        campaigncall.ResultSuccess,
+							Disposition:   "sale",
+							Duration:      100000,
+							TalkDuration:  90000,
+							TMCreate:      &tmCreate,
+							TMProgressing: &tmProgressing,
+							TMEnd:         &tmEnd,
+						},
+					},
+					TMCreate: &tmCreate,
+				},
+				{
+					OutdialTargetID: uuid.FromStringOrNil("6b10919a-ad5f-11f0-937e-6f8b1d3f5a60"),
+					Name:            "bob",
+					Status:          omoutdialtarget.StatusIdle,
+					Attempts:        []campaignresult.Attempt{},
+					TMCreate:        &tmCreate,
+				},
+			},
+			responseFile: &smfile.File{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("6b41a2ac-ad5f-11f0-a48f-7a9c2e4a6b70"),
+				},
+			},
+
+			expectContent: "outdial_target_id,name,target_status,campaigncall_id,destination,destination_index,try_count,status,result,disposition,abandoned,duration,talk_duration,tm_create,tm_progressing,tm_end\n" +
+				"6aae6f76-ad5f-11f0-b15c-4d6f8b1d3e40,alice,done,6adf8088-ad5f-11f0-826d-5e7a9c2e4f50,+821100000001,0,1,done,success,sale,false,100000,90000,2026-10-01T03:30:00.000000Z,2026-10-01T03:30:10.000000Z,2026-10-01T03:31:40.000000Z\n" +
+				"6b10919a-ad5f-11f0-937e-6f8b1d3f5a60,bob,idle,,,,,,,,,,,,,\n",
+			expectTargetCount: 2,
+			expectRowCount:    2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockCampaign := campaignhandler.NewMockCampaignHandler(mc)
+			srv, uploaded := newTestStorageServer(t)
+
+			h := &campaignResultJobHandler{
+				reqHandler:      mockReq,
+				campaignHandler: mockCampaign,
+				httpClient:      srv.Client(),
+			}
+			ctx := context.Background()
+
+			mockCampaign.EXPECT().ResultList(ctx, tt.job.CampaignID, "", uint64(exportPageSize)).Return(tt.responseResults, nil)
+
+			mockReq.EXPECT().StorageV1FileUploadURICreate(ctx).Return(testUploadURI(srv, "/upload"), nil)
+			mockReq.EXPECT().StorageV1FileCreate(ctx, tt.job.CustomerID, uuid.Nil, smfile.ReferenceTypeNone, uuid.Nil, smfile.TypeNone, gomock.Any(), gomock.Any(), gomock.Any(), testUploadBucketName, testUploadFilepath, 60000).Return(tt.responseFile, nil)
+
+			if err := h.exportFile(ctx, tt.job); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if content := uploaded(); content != tt.expectContent {
+				t.Errorf("Wrong match.\nexpect: %s\ngot: %s", tt.expectContent, content)
+			}
+
+			if tt.job.FileID != tt.responseFile.ID || tt.job.TargetCount != tt.expectTargetCount || tt.job.RowCount != tt.expectRowCount {
+				t.Errorf("Wrong match. expect: %s/%d/%d, got: %s/%d/%d", tt.responseFile.ID, tt.expectTargetCount, tt.expectRowCount, tt.job.FileID, tt.job.TargetCount, tt.job.RowCount)
+			}
+		})
+	}
+}
+
+func Test_exportFile_cursor(t *testing.T) {
+
+	// the imported targets share the same tm_create
+	tmCreate := time.Date(2026, 10, 1, 3, 30, 0, 0, time.UTC)
+
+	job := &campaignresultjob.CampaignResultJob{
+		Identity: commonidentity.Identity{
+			ID:         uuid.FromStringOrNil("8c1d2e30-ad5f-11f0-8a6b-1c3e5a7c9d10"),
+			CustomerID: uuid.FromStringOrNil("8c4e3f42-ad5f-11f0-9b7c-2d4f6b8d1e20"),
+		},
+		CampaignID: uuid.FromStringOrNil("8c7f5054-ad5f-11f0-ac8d-3e5a7c9e2f30"),
+	}
+
+	firstPage := []*campaignresult.CampaignResult{}
+	for range exportPageSize {
+		firstPage = append(firstPage, &campaignresult.CampaignResult{
+			OutdialTargetID: uuid.Must(uuid.NewV4()),
+			Attempts:        []campaignresult.Attempt{},
+			TMCreate:        &tmCreate,
+		})
+	}
+	secondPage := []*campaignresult.CampaignResult{
+		{
+			OutdialTargetID: uuid.FromStringOrNil("8cb06166-ad5f-11f0-bd9e-4f6b8d1f3a40"),
+			Attempts:        []campaignresult.Attempt{},
+			TMCreate:        &tmCreate,
+		},
+	}
+
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockReq := requesthandler.NewMockRequestHandler(mc)
+	mockCampaign := campaignhandler.NewMockCampaignHandler(mc)
+	srv, uploaded := newTestStorageServer(t)
+
+	h := &campaignResultJobHandler{
+		reqHandler:      mockReq,
+		campaignHandler: mockCampaign,
+		httpClient:      srv.Client(),
+	}
+	ctx := context.Background()
+
+	last := firstPage[len(firstPage)-1]
+	expectToken := omoutdialtarget.CursorToken(last.TMCreate, last.OutdialTargetID)
+
+	mockCampaign.EXPECT().ResultList(ctx, job.CampaignID, "", uint64(exportPageSize)).Return(firstPage, nil)
+	mockCampaign.EXPECT().ResultList(ctx, job.CampaignID, expectToken, uint64(exportPageSize)).Return(secondPage, nil)
+
+	mockReq.EXPECT().StorageV1FileUploadURICreate(ctx).Return(testUploadURI(srv, "/upload"), nil)
+	mockReq.EXPECT().StorageV1FileCreate(ctx, job.CustomerID, uuid.Nil, smfile.ReferenceTypeNone, uuid.Nil, smfile.TypeNone, gomock.Any(), gomock.Any(), gomock.Any(), testUploadBucketName, testUploadFilepath, 60000).Return(&smfile.File{}, nil)
+
+	if err := h.exportFile(ctx, job); err != nil {
+		t.Errorf("Wrong match. expect: ok, got: %v", err)
+	}
+
+	if job.TargetCount != exportPageSize+1 {
+		t.Errorf("Wrong match. expect: %d, got: %d", exportPageSize+1, job.TargetCount)
+	}
+	if !strings.Contains(uploaded(), secondPage[0].OutdialTargetID.String()) {
+		t.Errorf("Wrong match. expect: the second page's target, got: none")
+	}
+}
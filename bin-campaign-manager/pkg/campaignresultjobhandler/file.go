@@ -0,0 +1,57 @@
+package campaignresultjobhandler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+
+	smfile "monorepo/bin-storage-manager/models/file"
+
+	"github.com/gofrs/uuid"
+
+	"monorepo/bin-campaign-manager/models/campaignresultjob"
+)
+
+// storeFile uploads the given local file with the storage-manager's signed upload uri
+// and creates the storage-manager file of the job's customer.
+func (h *campaignResultJobHandler) storeFile(ctx context.Context, j *campaignresultjob.CampaignResultJob, localPath string, name string, filename string) (uuid.UUID, error) {
+	src, err := os.Open(localPath)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("could not open the local file: %w", err)
+	}
+	defer func() { _ = src.Close() }()
+
+	info, err := src.Stat()
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("could not stat the local file: %w", err)
+	}
+
+	u, err := h.reqHandler.StorageV1FileUploadURICreate(ctx)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("could not get the upload uri: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, u.URI, src)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("could not create the upload request: %w", err)
+	}
+	req.ContentLength = info.Size()
+
+	resp, err := h.httpClient.Do(req)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("could not upload the file: %w", err)
+	}
+	_ = resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return uuid.Nil, fmt.Errorf("could not upload the file. status_code: %d", resp.StatusCode)
+	}
+
+	f, err := h.reqHandler.StorageV1FileCreate(ctx, j.CustomerID, uuid.Nil, smfile.ReferenceTypeNone, uuid.Nil, smfile.TypeNone, name, fmt.Sprintf("campaign_id: %s, campaignresultjob_id: %s", j.CampaignID, j.ID), filename, u.BucketName, u.Filepath, 60000)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("could not create the file: %w", err)
+	}
+
+	return f.ID, nil
+}
@@ -0,0 +1,157 @@
+package campaignresultjobhandler
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/requesthandler"
+	smfile "monorepo/bin-storage-manager/models/file"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	"monorepo/bin-campaign-manager/models/campaignresultjob"
+)
+
+const (
+	testUploadBucketName = "test-bucket-tmp"
+	testUploadFilepath   = "tmp/3e1f5a70-ad5f-11f0-8c2d-4e6a8b1d3f50"
+)
+
+// newTestStorageServer returns the fake signed uri server.
+// PUT /upload stores the body, returned by the returned func.
+func newTestStorageServer(t *testing.T) (*httptest.Server, func() string) {
+	t.Helper()
+
+	var mu sync.Mutex
+	uploaded := ""
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPut && r.URL.Path == "/upload":
+			b, _ := io.ReadAll(r.Body)
+			mu.Lock()
+			uploaded = string(b)
+			mu.Unlock()
+
+		default:
+			w.WriteHeader(http.StatusForbidden)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv, func() string {
+		mu.Lock()
+		defer mu.Unlock()
+		return uploaded
+	}
+}
+
+// testUploadURI returns the upload uri of the given fake server.
+func testUploadURI(srv *httptest.Server, path string) *smfile.UploadURI {
+	return &smfile.UploadURI{
+		BucketName: testUploadBucketName,
+		Filepath:   testUploadFilepath,
+		URI:        srv.URL + path,
+	}
+}
+
+func Test_storeFile(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		job     *campaignresultjob.CampaignResultJob
+		content string
+		path    string
+
+		responseFile *smfile.File
+
+		expectErr bool
+	}{
+		{
+			name: "normal",
+
+			job: &campaignresultjob.CampaignResultJob{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("3e4f6b82-ad5f-11f0-9d3e-5f7b9c2e4a60"),
+					CustomerID: uuid.FromStringOrNil("3e7f7c94-ad5f-11f0-ae4f-6a8c1d3f5b70"),
+				},
+				CampaignID: uuid.FromStringOrNil("3eaf8da6-ad5f-11f0-bf5a-7b9d2e4a6c80"),
+			},
+			content: "outdial_target_id,name\n",
+			path:    "/upload",
+
+			responseFile: &smfile.File{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("3edf9eb8-ad5f-11f0-806b-8c1e3f5b7d90"),
+				},
+			},
+		},
+		{
+			name: "signed uri rejected",
+
+			job: &campaignresultjob.CampaignResultJob{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("3f0fafca-ad5f-11f0-917c-9d2f4a6c8ea0"),
+					CustomerID: uuid.FromStringOrNil("3e7f7c94-ad5f-11f0-ae4f-6a8c1d3f5b70"),
+				},
+				CampaignID: uuid.FromStringOrNil("3eaf8da6-ad5f-11f0-bf5a-7b9d2e4a6c80"),
+			},
+			content: "outdial_target_id,name\n",
+			path:    "/expired",
+
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			srv, uploaded := newTestStorageServer(t)
+
+			h := &campaignResultJobHandler{
+				reqHandler: mockReq,
+				httpClient: srv.Client(),
+			}
+			ctx := context.Background()
+
+			localPath := filepath.Join(t.TempDir(), "results.csv")
+			if errWrite := os.WriteFile(localPath, []byte(tt.content), 0600); errWrite != nil {
+				t.Fatalf("Could not write the local file. err: %v", errWrite)
+			}
+
+			mockReq.EXPECT().StorageV1FileUploadURICreate(ctx).Return(testUploadURI(srv, tt.path), nil)
+			if !tt.expectErr {
+				mockReq.EXPECT().StorageV1FileCreate(ctx, tt.job.CustomerID, uuid.Nil, smfile.ReferenceTypeNone, uuid.Nil, smfile.TypeNone, "campaign results export", gomock.Any(), "results.csv", testUploadBucketName, testUploadFilepath, 60000).Return(tt.responseFile, nil)
+			}
+
+			res, err := h.storeFile(ctx, tt.job, localPath, "campaign results export", "results.csv")
+			if tt.expectErr {
+				if err == nil {
+					t.Errorf("Wrong match. expect: error, got: ok")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if res != tt.responseFile.ID {
+				t.Errorf("Wrong match. expect: %s, got: %s", tt.responseFile.ID, res)
+			}
+			if content := uploaded(); content != tt.content {
+				t.Errorf("Wrong match.\nexpect: %s\ngot: %s", tt.content, content)
+			}
+		})
+	}
+}
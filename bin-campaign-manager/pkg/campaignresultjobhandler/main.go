@@ -0,0 +1,79 @@
+package campaignresultjobhandler
+
+//go:generate mockgen -package campaignresultjobhandler -destination ./mock_campaignresultjobhandler.go -source main.go -build_flags=-mod=mod
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"monorepo/bin-common-handler/pkg/notifyhandler"
+	"monorepo/bin-common-handler/pkg/requesthandler"
+
+	"github.com/gofrs/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"monorepo/bin-campaign-manager/models/campaignresultjob"
+	"monorepo/bin-campaign-manager/pkg/campaignhandler"
+	"monorepo/bin-campaign-manager/pkg/dbhandler"
+)
+
+// campaignResultJobHandler defines
+type campaignResultJobHandler struct {
+	db              dbhandler.DBHandler
+	reqHandler      requesthandler.RequestHandler
+	notifyHandler   notifyhandler.NotifyHandler
+	campaignHandler campaignhandler.CampaignHandler
+
+	httpClient *http.Client // uploads the exported file with the storage-manager's signed uri
+}
+
+// CampaignResultJobHandler interface
+type CampaignResultJobHandler interface {
+	Create(ctx context.Context, campaignID uuid.UUID) (*campaignresultjob.CampaignResultJob, error)
+	Get(ctx context.Context, id uuid.UUID) (*campaignresultjob.CampaignResultJob, error)
+	List(ctx context.Context, token string, limit uint64, filters map[campaignresultjob.Field]any) ([]*campaignresultjob.CampaignResultJob, error)
+
+	RunRecovery(ctx context.Context, interval time.Duration)
+}
+
+// NewCampaignResultJobHandler return CampaignResultJobHandler
+func NewCampaignResultJobHandler(
+	db dbhandler.DBHandler,
+	reqHandler requesthandler.RequestHandler,
+	notifyHandler notifyhandler.NotifyHandler,
+	campaignHandler campaignhandler.CampaignHandler,
+) CampaignResultJobHandler {
+	h := &campaignResultJobHandler{
+		db:              db,
+		reqHandler:      reqHandler,
+		notifyHandler:   notifyHandler,
+		campaignHandler: campaignHandler,
+
+		httpClient: &http.Client{Timeout: fileTransferTimeout},
+	}
+
+	return h
+}
+
+const (
+	fileTransferTimeout = 10 * time.Minute // timeout of the file's upload
+)
+
+var (
+	metricsNamespace = "campaign_manager"
+
+	promCampaignResultJobRowTotal = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "campaignresultjob_row_total",
+			Help:      "Total number of csv rows exported by the campaignresultjobs.",
+		},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(
+		promCampaignResultJobRowTotal,
+	)
+}
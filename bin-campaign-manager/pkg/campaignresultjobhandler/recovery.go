@@ -0,0 +1,139 @@
+package campaignresultjobhandler
+
+import (
+	"context"
+	"time"
+
+	"monorepo/bin-common-handler/pkg/utilhandler"
+
+	"github.com/sirupsen/logrus"
+
+	"monorepo/bin-campaign-manager/models/campaignresultjob"
+)
+
+const (
+	jobHeartbeatInterval = time.Minute     // the running job touches its tm_update every interval
+	jobStaleTimeout      = 5 * time.Minute // the processing job without the heartbeat for the timeout is considered abandoned
+
+	recoveryPageSize = 100
+)
+
+// run runs the job in the background with the heartbeat.
+// The export writes the whole file again, so the job is resumable from the start.
+func (h *campaignResultJobHandler) run(j *campaignresultjob.CampaignResultJob) {
+	ctx := context.Background()
+
+	ctxHeartbeat, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go h.heartbeat(ctxHeartbeat, j)
+
+	h.exportRun(ctx, j)
+}
+
+// heartbeat touches the running job's tm_update until the given context is done,
+// so the recovery does not take over the job.
+func (h *campaignResultJobHandler) heartbeat(ctx context.Context, j *campaignresultjob.CampaignResultJob) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":                 "heartbeat",
+		"campaignresultjob_id": j.ID,
+	})
+
+	ticker := time.NewTicker(jobHeartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-ticker.C:
+			if errHeartbeat := h.db.CampaignResultJobHeartbeat(ctx, j.ID); errHeartbeat != nil {
+				log.Errorf("Could not update the heartbeat. err: %v", errHeartbeat)
+			}
+		}
+	}
+}
+
+// RunRecovery resumes the processing jobs abandoned by the restarted or crashed pods.
+// It sweeps once on start and then every interval.
+func (h *campaignResultJobHandler) RunRecovery(ctx context.Context, interval time.Duration) {
+	log := logrus.WithField("func", "RunRecovery")
+
+	h.recoverStale(ctx)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Infof("Campaignresultjob recovery stopped")
+			return
+
+		case <-ticker.C:
+			h.recoverStale(ctx)
+		}
+	}
+}
+
+// recoverStale claims the processing jobs without the heartbeat and runs them again.
+func (h *campaignResultJobHandler) recoverStale(ctx context.Context) {
+	log := logrus.WithField("func", "recoverStale")
+
+	filters := map[campaignresultjob.Field]any{
+		campaignresultjob.FieldStatus:  campaignresultjob.StatusProcessing,
+		campaignresultjob.FieldDeleted: false,
+	}
+
+	cutoff := utilhandler.TimeNow().Add(-jobStaleTimeout)
+	token := ""
+	for {
+		jobs, err := h.db.CampaignResultJobList(ctx, token, recoveryPageSize, filters)
+		if err != nil {
+			log.Errorf("Could not get the processing campaignresultjobs. err: %v", err)
+			return
+		}
+
+		for _, j := range jobs {
+			h.recoverJob(ctx, j, cutoff)
+		}
+
+		if len(jobs) < recoveryPageSize || jobs[len(jobs)-1].TMCreate == nil {
+			return
+		}
+		token = jobs[len(jobs)-1].TMCreate.UTC().Format(utilhandler.ISO8601Layout)
+	}
+}
+
+// recoverJob claims the given job if it is abandoned and runs it again from the start.
+func (h *campaignResultJobHandler) recoverJob(ctx context.Context, j *campaignresultjob.CampaignResultJob, cutoff time.Time) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":                 "recoverJob",
+		"campaignresultjob_id": j.ID,
+	})
+
+	tmActive := j.TMUpdate
+	if tmActive == nil {
+		tmActive = j.TMCreate
+	}
+	if tmActive == nil || tmActive.After(cutoff) {
+		return
+	}
+
+	// the other pods sweep at the same time. only the one which updated the same tm_update wins.
+	claimed, err := h.db.CampaignResultJobClaim(ctx, j.ID, j.TMUpdate)
+	if err != nil {
+		log.Errorf("Could not claim the campaignresultjob. err: %v", err)
+		return
+	} else if claimed == 0 {
+		log.Debugf("The campaignresultjob was claimed by the other. campaignresultjob_id: %s", j.ID)
+		return
+	}
+	log.WithField("campaignresultjob", j).Infof("Recovering the abandoned campaignresultjob. campaignresultjob_id: %s", j.ID)
+
+	// restart with the fresh counts. the file is written again from the first outdial target.
+	j.TargetCount = 0
+	j.RowCount = 0
+
+	go h.run(j)
+}
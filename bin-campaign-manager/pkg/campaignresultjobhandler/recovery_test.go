@@ -0,0 +1,91 @@
+package campaignresultjobhandler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	"monorepo/bin-campaign-manager/models/campaignresultjob"
+	"monorepo/bin-campaign-manager/pkg/dbhandler"
+)
+
+func Test_recoverStale(t *testing.T) {
+
+	tmFresh := time.Now().Add(-time.Minute)
+	tmStale := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name string
+
+		responseJobs []*campaignresultjob.CampaignResultJob
+
+		expectClaims []*campaignresultjob.CampaignResultJob
+	}{
+		{
+			name: "the running job is not claimed",
+
+			responseJobs: []*campaignresultjob.CampaignResultJob{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("a41e2f30-ad5f-11f0-8b7c-5e7a9c1e3f10"),
+					},
+					Status:   campaignresultjob.StatusProcessing,
+					TMCreate: &tmStale,
+					TMUpdate: &tmFresh,
+				},
+			},
+		},
+		{
+			name: "the abandoned job claimed by the other pod",
+
+			responseJobs: []*campaignresultjob.CampaignResultJob{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("a44f3042-ad5f-11f0-9c8d-6f8b1d2f4a20"),
+					},
+					Status:   campaignresultjob.StatusProcessing,
+					TMCreate: &tmStale,
+					TMUpdate: &tmStale,
+				},
+			},
+
+			expectClaims: []*campaignresultjob.CampaignResultJob{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("a44f3042-ad5f-11f0-9c8d-6f8b1d2f4a20"),
+					},
+					TMUpdate: &tmStale,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+
+			h := &campaignResultJobHandler{
+				db: mockDB,
+			}
+			ctx := context.Background()
+
+			mockDB.EXPECT().CampaignResultJobList(ctx, "", uint64(recoveryPageSize), map[campaignresultjob.Field]any{
+				campaignresultjob.FieldStatus:  campaignresultjob.StatusProcessing,
+				campaignresultjob.FieldDeleted: false,
+			}).Return(tt.responseJobs, nil)
+			for _, j := range tt.expectClaims {
+				mockDB.EXPECT().CampaignResultJobClaim(ctx, j.ID, j.TMUpdate).Return(int64(0), nil)
+			}
+
+			h.recoverStale(ctx)
+		})
+	}
+}
@@ -405,3 +405,23 @@ func (h *handler) CampaignUpdateType(ctx context.Context, id uuid.UUID, campaign
 
 	return h.CampaignUpdate(ctx, id, fields)
 }
+
+// CampaignUpdateDialMode updates campaign's dial_mode and max_abandon_rate.
+func (h *handler) CampaignUpdateDialMode(ctx context.Context, id uuid.UUID, dialMode campaign.DialMode, maxAbandonRate int) error {
+	fields := map[campaign.Field]any{
+		campaign.FieldDialMode:       dialMode,
+		campaign.FieldMaxAbandonRate: maxAbandonRate,
+	}
+
+	return h.CampaignUpdate(ctx, id, fields)
+}
+
+// CampaignUpdateCallingWindows updates campaign's calling_windows and timezone.
+func (h *handler) CampaignUpdateCallingWindows(ctx context.Context, id uuid.UUID, callingWindows []campaign.CallingWindow, timezone string) error {
+	fields := map[campaign.Field]any{
+		campaign.FieldCallingWindows: callingWindows,
+		campaign.FieldTimezone:       timezone,
+	}
+
+	return h.CampaignUpdate(ctx, id, fields)
+}
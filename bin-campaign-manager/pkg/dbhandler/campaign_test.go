@@ -1373,3 +1373,205 @@ func Test_OutplanUpdateType(t *testing.T) {
 		})
 	}
 }
+
+func Test_CampaignUpdateDialMode(t *testing.T) {
+	tests := []struct {
+		name     string
+		campaign *campaign.Campaign
+
+		dialMode       campaign.DialMode
+		maxAbandonRate int
+
+		responseCurTime *time.Time
+		expectRes       *campaign.Campaign
+	}{
+		{
+			"normal",
+			&campaign.Campaign{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("0b0f4a5e-4e1b-11f0-9b4c-6f1c2ad5e7a1"),
+					CustomerID: uuid.FromStringOrNil("a1eca4cc-b741-11ec-85e0-8bb267cad154"),
+				},
+				Name:           "test name",
+				Detail:         "test detail",
+				Status:         campaign.StatusStop,
+				ServiceLevel:   100,
+				OutplanID:      uuid.FromStringOrNil("298c7482-b3d4-11ec-9ea5-ef75a2e6bfb6"),
+				OutdialID:      uuid.FromStringOrNil("29b93706-b3d4-11ec-b884-57ba15a12519"),
+				QueueID:        uuid.FromStringOrNil("29f12d00-b3d4-11ec-a884-dba81c6dc4da"),
+				NextCampaignID: uuid.FromStringOrNil("baf03152-b3d4-11ec-bfe4-eb0cddbd111d"),
+			},
+
+			campaign.DialModePredictive,
+			5,
+
+			&curTime,
+			&campaign.Campaign{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("0b0f4a5e-4e1b-11f0-9b4c-6f1c2ad5e7a1"),
+					CustomerID: uuid.FromStringOrNil("a1eca4cc-b741-11ec-85e0-8bb267cad154"),
+				},
+				Name:           "test name",
+				Detail:         "test detail",
+				Status:         campaign.StatusStop,
+				ServiceLevel:   100,
+				DialMode:       campaign.DialModePredictive,
+				MaxAbandonRate: 5,
+				OutplanID:      uuid.FromStringOrNil("298c7482-b3d4-11ec-9ea5-ef75a2e6bfb6"),
+				OutdialID:      uuid.FromStringOrNil("29b93706-b3d4-11ec-b884-57ba15a12519"),
+				QueueID:        uuid.FromStringOrNil("29f12d00-b3d4-11ec-a884-dba81c6dc4da"),
+				NextCampaignID: uuid.FromStringOrNil("baf03152-b3d4-11ec-bfe4-eb0cddbd111d"),
+				TMCreate:       &curTime,
+				TMUpdate:       &curTime,
+				TMDelete:       nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockCache := cachehandler.NewMockCacheHandler(mc)
+			h := handler{
+				util:  mockUtil,
+				db:    dbTest,
+				cache: mockCache,
+			}
+
+			ctx := context.Background()
+
+			mockUtil.EXPECT().TimeNow().Return(tt.responseCurTime)
+			mockCache.EXPECT().CampaignSet(ctx, gomock.Any()).Return(nil)
+			if err := h.CampaignCreate(ctx, tt.campaign); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			mockUtil.EXPECT().TimeNow().Return(tt.responseCurTime)
+			mockCache.EXPECT().CampaignSet(ctx, gomock.Any()).Return(nil)
+			if err := h.CampaignUpdateDialMode(ctx, tt.campaign.ID, tt.dialMode, tt.maxAbandonRate); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			mockCache.EXPECT().CampaignGet(ctx, tt.campaign.ID).Return(nil, fmt.Errorf(""))
+			mockCache.EXPECT().CampaignSet(ctx, gomock.Any())
+			res, err := h.CampaignGet(ctx, tt.campaign.ID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(tt.expectRes, res) == false {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_CampaignUpdateCallingWindows(t *testing.T) {
+	tests := []struct {
+		name     string
+		campaign *campaign.Campaign
+
+		callingWindows []campaign.CallingWindow
+		timezone       string
+
+		responseCurTime *time.Time
+		expectRes       *campaign.Campaign
+	}{
+		{
+			"normal",
+			&campaign.Campaign{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("d1a7c3e2-adbb-11f0-8b5f-4c9e2a7d1f01"),
+					CustomerID: uuid.FromStringOrNil("a1eca4cc-b741-11ec-85e0-8bb267cad154"),
+				},
+				Name:           "test name",
+				Detail:         "test detail",
+				Status:         campaign.StatusStop,
+				ServiceLevel:   100,
+				OutplanID:      uuid.FromStringOrNil("298c7482-b3d4-11ec-9ea5-ef75a2e6bfb6"),
+				OutdialID:      uuid.FromStringOrNil("29b93706-b3d4-11ec-b884-57ba15a12519"),
+				QueueID:        uuid.FromStringOrNil("29f12d00-b3d4-11ec-a884-dba81c6dc4da"),
+				NextCampaignID: uuid.FromStringOrNil("baf03152-b3d4-11ec-bfe4-eb0cddbd111d"),
+			},
+
+			[]campaign.CallingWindow{
+				{
+					Days:  []campaign.Day{campaign.DayMonday, campaign.DayTuesday},
+					Start: "09:00",
+					End:   "21:00",
+				},
+			},
+			"Asia/Seoul",
+
+			&curTime,
+			&campaign.Campaign{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("d1a7c3e2-adbb-11f0-8b5f-4c9e2a7d1f01"),
+					CustomerID: uuid.FromStringOrNil("a1eca4cc-b741-11ec-85e0-8bb267cad154"),
+				},
+				Name:         "test name",
+				Detail:       "test detail",
+				Status:       campaign.StatusStop,
+				ServiceLevel: 100,
+				CallingWindows: []campaign.CallingWindow{
+					{
+						Days:  []campaign.Day{campaign.DayMonday, campaign.DayTuesday},
+						Start: "09:00",
+						End:   "21:00",
+					},
+				},
+				Timezone:       "Asia/Seoul",
+				OutplanID:      uuid.FromStringOrNil("298c7482-b3d4-11ec-9ea5-ef75a2e6bfb6"),
+				OutdialID:      uuid.FromStringOrNil("29b93706-b3d4-11ec-b884-57ba15a12519"),
+				QueueID:        uuid.FromStringOrNil("29f12d00-b3d4-11ec-a884-dba81c6dc4da"),
+				NextCampaignID: uuid.FromStringOrNil("baf03152-b3d4-11ec-bfe4-eb0cddbd111d"),
+				TMCreate:       &curTime,
+				TMUpdate:       &curTime,
+				TMDelete:       nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockCache := cachehandler.NewMockCacheHandler(mc)
+			h := handler{
+				util:  mockUtil,
+				db:    dbTest,
+				cache: mockCache,
+			}
+
+			ctx := context.Background()
+
+			mockUtil.EXPECT().TimeNow().Return(tt.responseCurTime)
+			mockCache.EXPECT().CampaignSet(ctx, gomock.Any()).Return(nil)
+			if err := h.CampaignCreate(ctx, tt.campaign); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			mockUtil.EXPECT().TimeNow().Return(tt.responseCurTime)
+			mockCache.EXPECT().CampaignSet(ctx, gomock.Any()).Return(nil)
+			if err := h.CampaignUpdateCallingWindows(ctx, tt.campaign.ID, tt.callingWindows, tt.timezone); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			mockCache.EXPECT().CampaignGet(ctx, tt.campaign.ID).Return(nil, fmt.Errorf(""))
+			mockCache.EXPECT().CampaignSet(ctx, gomock.Any())
+			res, err := h.CampaignGet(ctx, tt.campaign.ID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(tt.expectRes, res) == false {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
@@ -349,6 +349,56 @@ func (h *handler) CampaigncallListOngoingByCampaignID(ctx context.Context, campa
 	return res, nil
 }
 
+// CampaigncallListByCampaignIDAndOutdialTargetIDs returns all campaigncalls of the given campaign and outdial targets.
+// The result is ordered by tm_create, oldest first.
+func (h *handler) CampaigncallListByCampaignIDAndOutdialTargetIDs(ctx context.Context, campaignID uuid.UUID, outdialTargetIDs []uuid.UUID) ([]*campaigncall.Campaigncall, error) {
+	res := []*campaigncall.Campaigncall{}
+	if len(outdialTargetIDs) == 0 {
+		return res, nil
+	}
+
+	targetIDs := make([][]byte, 0, len(outdialTargetIDs))
+	for _, id := range outdialTargetIDs {
+		targetIDs = append(targetIDs, id.Bytes())
+	}
+
+	fields := commondatabasehandler.GetDBFields(&campaigncall.Campaigncall{})
+	sb := squirrel.
+		Select(fields...).
+		From(campaigncallsTable).
+		Where(squirrel.Eq{string(campaigncall.FieldCampaignID): campaignID.Bytes()}).
+		Where(squirrel.Eq{string(campaigncall.FieldOutdialTargetID): targetIDs}).
+		Where(squirrel.Eq{string(campaigncall.FieldTMDelete): nil}).
+		OrderBy(string(campaigncall.FieldTMCreate)+" ASC", string(campaigncall.FieldID)+" ASC").
+		PlaceholderFormat(squirrel.Question)
+
+	query, args, err := sb.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("could not build query. CampaigncallListByCampaignIDAndOutdialTargetIDs. err: %v", err)
+	}
+
+	rows, err := h.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("could not query. CampaigncallListByCampaignIDAndOutdialTargetIDs. err: %v", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	for rows.Next() {
+		u, err := h.campaigncallGetFromRow(rows)
+		if err != nil {
+			return nil, fmt.Errorf("could not get data. CampaigncallListByCampaignIDAndOutdialTargetIDs, err: %v", err)
+		}
+		res = append(res, u)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error. CampaigncallListByCampaignIDAndOutdialTargetIDs. err: %v", err)
+	}
+
+	return res, nil
+}
+
 // CampaigncallUpdate updates campaigncall fields.
 func (h *handler) CampaigncallUpdate(ctx context.Context, id uuid.UUID, fields map[campaigncall.Field]any) error {
 	if len(fields) == 0 {
@@ -399,6 +449,91 @@ func (h *handler) CampaigncallUpdateStatusAndResult(ctx context.Context, id uuid
 	return h.CampaigncallUpdate(ctx, id, fields)
 }
 
+// CampaigncallUpdateStatusDone updates campaigncall's status to done with the result and sets the tm_end.
+func (h *handler) CampaigncallUpdateStatusDone(ctx context.Context, id uuid.UUID, result campaigncall.Result) error {
+	fields := map[campaigncall.Field]any{
+		campaigncall.FieldStatus: campaigncall.StatusDone,
+		campaigncall.FieldResult: result,
+		campaigncall.FieldTMEnd:  h.util.TimeNow(),
+	}
+
+	return h.CampaigncallUpdate(ctx, id, fields)
+}
+
+// CampaigncallUpdateStatusProgressing updates campaigncall's status to progressing and sets the tm_progressing.
+func (h *handler) CampaigncallUpdateStatusProgressing(ctx context.Context, id uuid.UUID) error {
+	fields := map[campaigncall.Field]any{
+		campaigncall.FieldStatus:        campaigncall.StatusProgressing,
+		campaigncall.FieldTMProgressing: h.util.TimeNow(),
+	}
+
+	return h.CampaigncallUpdate(ctx, id, fields)
+}
+
+// CampaigncallUpdateAgentIDAndStatusFromPreviewing updates campaigncall's agent_id and status
+// only if the campaigncall is still previewing and not reserved for the other agent.
+// It returns the number of updated rows, so the caller can tell whether it won the race.
+func (h *handler) CampaigncallUpdateAgentIDAndStatusFromPreviewing(ctx context.Context, id uuid.UUID, agentID uuid.UUID, status campaigncall.Status) (int64, error) {
+	fields := map[campaigncall.Field]any{
+		campaigncall.FieldAgentID:  agentID,
+		campaigncall.FieldStatus:   status,
+		campaigncall.FieldTMUpdate: h.util.TimeNow(),
+	}
+
+	tmpFields, err := commondatabasehandler.PrepareFields(fields)
+	if err != nil {
+		return 0, fmt.Errorf("CampaigncallUpdateAgentIDAndStatusFromPreviewing: prepare fields failed: %w", err)
+	}
+
+	q := squirrel.Update(campaigncallsTable).
+		SetMap(tmpFields).
+		Where(squirrel.Eq{string(campaigncall.FieldID): id.Bytes()}).
+		Where(squirrel.Eq{string(campaigncall.FieldStatus): string(campaigncall.StatusPreviewing)}).
+		Where(squirrel.Or{
+			squirrel.Eq{string(campaigncall.FieldAgentID): uuid.Nil.Bytes()},
+			squirrel.Eq{string(campaigncall.FieldAgentID): agentID.Bytes()},
+		}).
+		PlaceholderFormat(squirrel.Question)
+
+	sqlStr, args, err := q.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("CampaigncallUpdateAgentIDAndStatusFromPreviewing: build SQL failed: %w", err)
+	}
+
+	result, err := h.db.Exec(sqlStr, args...)
+	if err != nil {
+		return 0, fmt.Errorf("CampaigncallUpdateAgentIDAndStatusFromPreviewing: exec failed: %w", err)
+	}
+
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("CampaigncallUpdateAgentIDAndStatusFromPreviewing: rows affected failed: %w", err)
+	}
+
+	if n > 0 {
+		_ = h.campaigncallUpdateToCache(ctx, id)
+	}
+	return n, nil
+}
+
+// CampaigncallUpdateAbandoned updates campaigncall's abandoned.
+func (h *handler) CampaigncallUpdateAbandoned(ctx context.Context, id uuid.UUID, abandoned bool) error {
+	fields := map[campaigncall.Field]any{
+		campaigncall.FieldAbandoned: abandoned,
+	}
+
+	return h.CampaigncallUpdate(ctx, id, fields)
+}
+
+// CampaigncallUpdateDisposition updates campaigncall's disposition.
+func (h *handler) CampaigncallUpdateDisposition(ctx context.Context, id uuid.UUID, disposition string) error {
+	fields := map[campaigncall.Field]any{
+		campaigncall.FieldDisposition: disposition,
+	}
+
+	return h.CampaigncallUpdate(ctx, id, fields)
+}
+
 // CampaigncallDelete deletes the given campaigncall
 func (h *handler) CampaigncallDelete(ctx context.Context, id uuid.UUID) error {
 	ts := h.util.TimeNow()
@@ -1221,6 +1221,448 @@ func Test_CampaigncallUpdateStatus(t *testing.T) {
 	}
 }
 
+func Test_CampaigncallUpdateStatusProgressing(t *testing.T) {
+	tests := []struct {
+		name         string
+		campaigncall *campaigncall.Campaigncall
+
+		responseCurTime *time.Time
+		expectRes       *campaigncall.Campaigncall
+	}{
+		{
+			"normal",
+			&campaigncall.Campaigncall{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("1c2e7a40-4e1b-11f0-8d7e-0b6f3c2a9e11"),
+					CustomerID: uuid.FromStringOrNil("5f07bfd8-b4fe-11ec-9444-4b5ae1d828a2"),
+				},
+				CampaignID:      uuid.FromStringOrNil("a43632bc-b501-11ec-8c14-dbf345739172"),
+				OutplanID:       uuid.FromStringOrNil("5f6f2cea-b4fe-11ec-9b36-eb1f55d879de"),
+				OutdialID:       uuid.FromStringOrNil("5fa1b52a-b4fe-11ec-a2f7-f73dfe01ba97"),
+				OutdialTargetID: uuid.FromStringOrNil("5fd06ca8-b4fe-11ec-b8b8-1fd108444321"),
+				QueueID:         uuid.FromStringOrNil("6003b072-b4fe-11ec-afc8-df78feb301b9"),
+				ActiveflowID:    uuid.FromStringOrNil("6038a2b4-b4fe-11ec-885f-170de0f5681f"),
+				ReferenceType:   campaigncall.ReferenceTypeCall,
+				ReferenceID:     uuid.FromStringOrNil("606cda84-b4fe-11ec-8791-afef3711acc8"),
+				Status:          campaigncall.StatusDialing,
+				Source: &commonaddress.Address{
+					Type:   commonaddress.TypeTel,
+					Target: "+821100000001",
+				},
+				Destination: &commonaddress.Address{
+					Type:   commonaddress.TypeTel,
+					Target: "+821100000002",
+				},
+				DestinationIndex: 0,
+				TryCount:         1,
+			},
+
+			&curTime2,
+			&campaigncall.Campaigncall{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("1c2e7a40-4e1b-11f0-8d7e-0b6f3c2a9e11"),
+					CustomerID: uuid.FromStringOrNil("5f07bfd8-b4fe-11ec-9444-4b5ae1d828a2"),
+				},
+				CampaignID:      uuid.FromStringOrNil("a43632bc-b501-11ec-8c14-dbf345739172"),
+				OutplanID:       uuid.FromStringOrNil("5f6f2cea-b4fe-11ec-9b36-eb1f55d879de"),
+				OutdialID:       uuid.FromStringOrNil("5fa1b52a-b4fe-11ec-a2f7-f73dfe01ba97"),
+				OutdialTargetID: uuid.FromStringOrNil("5fd06ca8-b4fe-11ec-b8b8-1fd108444321"),
+				QueueID:         uuid.FromStringOrNil("6003b072-b4fe-11ec-afc8-df78feb301b9"),
+				ActiveflowID:    uuid.FromStringOrNil("6038a2b4-b4fe-11ec-885f-170de0f5681f"),
+				ReferenceType:   campaigncall.ReferenceTypeCall,
+				ReferenceID:     uuid.FromStringOrNil("606cda84-b4fe-11ec-8791-afef3711acc8"),
+				Status:          campaigncall.StatusProgressing,
+				Source: &commonaddress.Address{
+					Type:   commonaddress.TypeTel,
+					Target: "+821100000001",
+				},
+				Destination: &commonaddress.Address{
+					Type:   commonaddress.TypeTel,
+					Target: "+821100000002",
+				},
+				DestinationIndex: 0,
+				TryCount:         1,
+				TMProgressing:    &curTime2,
+				TMCreate:         &curTime2,
+				TMUpdate:         &curTime2,
+				TMDelete:         nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockCache := cachehandler.NewMockCacheHandler(mc)
+			h := handler{
+				util:  mockUtil,
+				db:    dbTest,
+				cache: mockCache,
+			}
+
+			ctx := context.Background()
+
+			mockUtil.EXPECT().TimeNow().Return(tt.responseCurTime)
+			mockCache.EXPECT().CampaigncallSet(ctx, gomock.Any()).Return(nil)
+			if err := h.CampaigncallCreate(ctx, tt.campaigncall); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			mockUtil.EXPECT().TimeNow().Return(tt.responseCurTime).Times(2)
+			mockCache.EXPECT().CampaigncallSet(ctx, gomock.Any()).Return(nil)
+			if err := h.CampaigncallUpdateStatusProgressing(ctx, tt.campaigncall.ID); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			mockCache.EXPECT().CampaigncallGet(ctx, tt.campaigncall.ID).Return(nil, fmt.Errorf(""))
+			mockCache.EXPECT().CampaigncallSet(ctx, gomock.Any())
+			res, err := h.CampaigncallGet(ctx, tt.campaigncall.ID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(tt.expectRes, res) == false {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_CampaigncallUpdateAgentIDAndStatusFromPreviewing(t *testing.T) {
+	tests := []struct {
+		name         string
+		campaigncall *campaigncall.Campaigncall
+
+		agentID uuid.UUID
+		status  campaigncall.Status
+
+		responseCurTime *time.Time
+
+		expectRowsAffected int64
+		expectRes          *campaigncall.Campaigncall
+	}{
+		{
+			"normal",
+			&campaigncall.Campaigncall{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("1c3f5b6c-4e1b-11f0-b1a2-5b8e4d3c2f33"),
+					CustomerID: uuid.FromStringOrNil("5f07bfd8-b4fe-11ec-9444-4b5ae1d828a2"),
+				},
+				CampaignID:      uuid.FromStringOrNil("a43632bc-b501-11ec-8c14-dbf345739172"),
+				OutplanID:       uuid.FromStringOrNil("5f6f2cea-b4fe-11ec-9b36-eb1f55d879de"),
+				OutdialID:       uuid.FromStringOrNil("5fa1b52a-b4fe-11ec-a2f7-f73dfe01ba97"),
+				OutdialTargetID: uuid.FromStringOrNil("5fd06ca8-b4fe-11ec-b8b8-1fd108444321"),
+				QueueID:         uuid.FromStringOrNil("6003b072-b4fe-11ec-afc8-df78feb301b9"),
+				ActiveflowID:    uuid.FromStringOrNil("6038a2b4-b4fe-11ec-885f-170de0f5681f"),
+				ReferenceType:   campaigncall.ReferenceTypeCall,
+				ReferenceID:     uuid.FromStringOrNil("606cda84-b4fe-11ec-8791-afef3711acc8"),
+				Status:          campaigncall.StatusPreviewing,
+				Source: &commonaddress.Address{
+					Type:   commonaddress.TypeTel,
+					Target: "+821100000001",
+				},
+				Destination: &commonaddress.Address{
+					Type:   commonaddress.TypeTel,
+					Target: "+821100000002",
+				},
+				DestinationIndex: 0,
+				TryCount:         1,
+			},
+
+			uuid.FromStringOrNil("1c5b0e8a-4e1b-11f0-a3f4-1f2b7c9d0e22"),
+			campaigncall.StatusDialing,
+
+			&curTime2,
+
+			1,
+			&campaigncall.Campaigncall{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("1c3f5b6c-4e1b-11f0-b1a2-5b8e4d3c2f33"),
+					CustomerID: uuid.FromStringOrNil("5f07bfd8-b4fe-11ec-9444-4b5ae1d828a2"),
+				},
+				CampaignID:      uuid.FromStringOrNil("a43632bc-b501-11ec-8c14-dbf345739172"),
+				OutplanID:       uuid.FromStringOrNil("5f6f2cea-b4fe-11ec-9b36-eb1f55d879de"),
+				OutdialID:       uuid.FromStringOrNil("5fa1b52a-b4fe-11ec-a2f7-f73dfe01ba97"),
+				OutdialTargetID: uuid.FromStringOrNil("5fd06ca8-b4fe-11ec-b8b8-1fd108444321"),
+				QueueID:         uuid.FromStringOrNil("6003b072-b4fe-11ec-afc8-df78feb301b9"),
+				AgentID:         uuid.FromStringOrNil("1c5b0e8a-4e1b-11f0-a3f4-1f2b7c9d0e22"),
+				ActiveflowID:    uuid.FromStringOrNil("6038a2b4-b4fe-11ec-885f-170de0f5681f"),
+				ReferenceType:   campaigncall.ReferenceTypeCall,
+				ReferenceID:     uuid.FromStringOrNil("606cda84-b4fe-11ec-8791-afef3711acc8"),
+				Status:          campaigncall.StatusDialing,
+				Source: &commonaddress.Address{
+					Type:   commonaddress.TypeTel,
+					Target: "+821100000001",
+				},
+				Destination: &commonaddress.Address{
+					Type:   commonaddress.TypeTel,
+					Target: "+821100000002",
+				},
+				DestinationIndex: 0,
+				TryCount:         1,
+				TMCreate:         &curTime2,
+				TMUpdate:         &curTime2,
+				TMDelete:         nil,
+			},
+		},
+		{
+			"campaigncall is not previewing",
+			&campaigncall.Campaigncall{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("8e2a4c10-ae5b-11f1-9c1e-3f6a2b8d4e01"),
+					CustomerID: uuid.FromStringOrNil("5f07bfd8-b4fe-11ec-9444-4b5ae1d828a2"),
+				},
+				CampaignID: uuid.FromStringOrNil("a43632bc-b501-11ec-8c14-dbf345739172"),
+				Status:     campaigncall.StatusDialing,
+				Source: &commonaddress.Address{
+					Type:   commonaddress.TypeTel,
+					Target: "+821100000001",
+				},
+				Destination: &commonaddress.Address{
+					Type:   commonaddress.TypeTel,
+					Target: "+821100000002",
+				},
+			},
+
+			uuid.FromStringOrNil("1c5b0e8a-4e1b-11f0-a3f4-1f2b7c9d0e22"),
+			campaigncall.StatusDialing,
+
+			&curTime2,
+
+			0,
+			&campaigncall.Campaigncall{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("8e2a4c10-ae5b-11f1-9c1e-3f6a2b8d4e01"),
+					CustomerID: uuid.FromStringOrNil("5f07bfd8-b4fe-11ec-9444-4b5ae1d828a2"),
+				},
+				CampaignID: uuid.FromStringOrNil("a43632bc-b501-11ec-8c14-dbf345739172"),
+				Status:     campaigncall.StatusDialing,
+				Source: &commonaddress.Address{
+					Type:   commonaddress.TypeTel,
+					Target: "+821100000001",
+				},
+				Destination: &commonaddress.Address{
+					Type:   commonaddress.TypeTel,
+					Target: "+821100000002",
+				},
+				TMCreate: &curTime2,
+				TMUpdate: nil,
+				TMDelete: nil,
+			},
+		},
+		{
+			"campaigncall is reserved for the other agent",
+			&campaigncall.Campaigncall{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("8e5d7f22-ae5b-11f1-b4a7-7c1e9d3f5a02"),
+					CustomerID: uuid.FromStringOrNil("5f07bfd8-b4fe-11ec-9444-4b5ae1d828a2"),
+				},
+				CampaignID: uuid.FromStringOrNil("a43632bc-b501-11ec-8c14-dbf345739172"),
+				AgentID:    uuid.FromStringOrNil("8e8f1a34-ae5b-11f1-a2d6-5b3c7e9f1d03"),
+				Status:     campaigncall.StatusPreviewing,
+				Source: &commonaddress.Address{
+					Type:   commonaddress.TypeTel,
+					Target: "+821100000001",
+				},
+				Destination: &commonaddress.Address{
+					Type:   commonaddress.TypeTel,
+					Target: "+821100000002",
+				},
+			},
+
+			uuid.FromStringOrNil("1c5b0e8a-4e1b-11f0-a3f4-1f2b7c9d0e22"),
+			campaigncall.StatusDialing,
+
+			&curTime2,
+
+			0,
+			&campaigncall.Campaigncall{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("8e5d7f22-ae5b-11f1-b4a7-7c1e9d3f5a02"),
+					CustomerID: uuid.FromStringOrNil("5f07bfd8-b4fe-11ec-9444-4b5ae1d828a2"),
+				},
+				CampaignID: uuid.FromStringOrNil("a43632bc-b501-11ec-8c14-dbf345739172"),
+				AgentID:    uuid.FromStringOrNil("8e8f1a34-ae5b-11f1-a2d6-5b3c7e9f1d03"),
+				Status:     campaigncall.StatusPreviewing,
+				Source: &commonaddress.Address{
+					Type:   commonaddress.TypeTel,
+					Target: "+821100000001",
+				},
+				Destination: &commonaddress.Address{
+					Type:   commonaddress.TypeTel,
+					Target: "+821100000002",
+				},
+				TMCreate: &curTime2,
+				TMUpdate: nil,
+				TMDelete: nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockCache := cachehandler.NewMockCacheHandler(mc)
+			h := handler{
+				util:  mockUtil,
+				db:    dbTest,
+				cache: mockCache,
+			}
+
+			ctx := context.Background()
+
+			mockUtil.EXPECT().TimeNow().Return(tt.responseCurTime)
+			mockCache.EXPECT().CampaigncallSet(ctx, gomock.Any()).Return(nil)
+			if err := h.CampaigncallCreate(ctx, tt.campaigncall); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			mockUtil.EXPECT().TimeNow().Return(tt.responseCurTime)
+			if tt.expectRowsAffected > 0 {
+				mockCache.EXPECT().CampaigncallSet(ctx, gomock.Any()).Return(nil)
+			}
+			n, err := h.CampaigncallUpdateAgentIDAndStatusFromPreviewing(ctx, tt.campaigncall.ID, tt.agentID, tt.status)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+			if n != tt.expectRowsAffected {
+				t.Errorf("Wrong match. expect: %d, got: %d", tt.expectRowsAffected, n)
+			}
+
+			mockCache.EXPECT().CampaigncallGet(ctx, tt.campaigncall.ID).Return(nil, fmt.Errorf(""))
+			mockCache.EXPECT().CampaigncallSet(ctx, gomock.Any())
+			res, err := h.CampaigncallGet(ctx, tt.campaigncall.ID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(tt.expectRes, res) == false {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_CampaigncallUpdateAbandoned(t *testing.T) {
+	tests := []struct {
+		name         string
+		campaigncall *campaigncall.Campaigncall
+
+		abandoned bool
+
+		responseCurTime *time.Time
+		expectRes       *campaigncall.Campaigncall
+	}{
+		{
+			"normal",
+			&campaigncall.Campaigncall{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("1c4d2e7e-4e1b-11f0-9e6f-3a7d1c8b4e44"),
+					CustomerID: uuid.FromStringOrNil("5f07bfd8-b4fe-11ec-9444-4b5ae1d828a2"),
+				},
+				CampaignID:      uuid.FromStringOrNil("a43632bc-b501-11ec-8c14-dbf345739172"),
+				OutplanID:       uuid.FromStringOrNil("5f6f2cea-b4fe-11ec-9b36-eb1f55d879de"),
+				OutdialID:       uuid.FromStringOrNil("5fa1b52a-b4fe-11ec-a2f7-f73dfe01ba97"),
+				OutdialTargetID: uuid.FromStringOrNil("5fd06ca8-b4fe-11ec-b8b8-1fd108444321"),
+				QueueID:         uuid.FromStringOrNil("6003b072-b4fe-11ec-afc8-df78feb301b9"),
+				ActiveflowID:    uuid.FromStringOrNil("6038a2b4-b4fe-11ec-885f-170de0f5681f"),
+				ReferenceType:   campaigncall.ReferenceTypeCall,
+				ReferenceID:     uuid.FromStringOrNil("606cda84-b4fe-11ec-8791-afef3711acc8"),
+				Status:          campaigncall.StatusProgressing,
+				Source: &commonaddress.Address{
+					Type:   commonaddress.TypeTel,
+					Target: "+821100000001",
+				},
+				Destination: &commonaddress.Address{
+					Type:   commonaddress.TypeTel,
+					Target: "+821100000002",
+				},
+				DestinationIndex: 0,
+				TryCount:         1,
+			},
+
+			true,
+
+			&curTime2,
+			&campaigncall.Campaigncall{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("1c4d2e7e-4e1b-11f0-9e6f-3a7d1c8b4e44"),
+					CustomerID: uuid.FromStringOrNil("5f07bfd8-b4fe-11ec-9444-4b5ae1d828a2"),
+				},
+				CampaignID:      uuid.FromStringOrNil("a43632bc-b501-11ec-8c14-dbf345739172"),
+				OutplanID:       uuid.FromStringOrNil("5f6f2cea-b4fe-11ec-9b36-eb1f55d879de"),
+				OutdialID:       uuid.FromStringOrNil("5fa1b52a-b4fe-11ec-a2f7-f73dfe01ba97"),
+				OutdialTargetID: uuid.FromStringOrNil("5fd06ca8-b4fe-11ec-b8b8-1fd108444321"),
+				QueueID:         uuid.FromStringOrNil("6003b072-b4fe-11ec-afc8-df78feb301b9"),
+				ActiveflowID:    uuid.FromStringOrNil("6038a2b4-b4fe-11ec-885f-170de0f5681f"),
+				ReferenceType:   campaigncall.ReferenceTypeCall,
+				ReferenceID:     uuid.FromStringOrNil("606cda84-b4fe-11ec-8791-afef3711acc8"),
+				Status:          campaigncall.StatusProgressing,
+				Source: &commonaddress.Address{
+					Type:   commonaddress.TypeTel,
+					Target: "+821100000001",
+				},
+				Destination: &commonaddress.Address{
+					Type:   commonaddress.TypeTel,
+					Target: "+821100000002",
+				},
+				DestinationIndex: 0,
+				TryCount:         1,
+				Abandoned:        true,
+				TMCreate:         &curTime2,
+				TMUpdate:         &curTime2,
+				TMDelete:         nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockCache := cachehandler.NewMockCacheHandler(mc)
+			h := handler{
+				util:  mockUtil,
+				db:    dbTest,
+				cache: mockCache,
+			}
+
+			ctx := context.Background()
+
+			mockUtil.EXPECT().TimeNow().Return(tt.responseCurTime)
+			mockCache.EXPECT().CampaigncallSet(ctx, gomock.Any()).Return(nil)
+			if err := h.CampaigncallCreate(ctx, tt.campaigncall); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			mockUtil.EXPECT().TimeNow().Return(tt.responseCurTime)
+			mockCache.EXPECT().CampaigncallSet(ctx, gomock.Any()).Return(nil)
+			if err := h.CampaigncallUpdateAbandoned(ctx, tt.campaigncall.ID, tt.abandoned); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			mockCache.EXPECT().CampaigncallGet(ctx, tt.campaigncall.ID).Return(nil, fmt.Errorf(""))
+			mockCache.EXPECT().CampaigncallSet(ctx, gomock.Any())
+			res, err := h.CampaigncallGet(ctx, tt.campaigncall.ID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(tt.expectRes, res) == false {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
 func Test_CampaigncallUpdateStatusAndResult(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -1400,3 +1842,273 @@ func Test_CampaigncallDelete(t *testing.T) {
 		})
 	}
 }
+
+func Test_CampaigncallUpdateStatusDone(t *testing.T) {
+	tests := []struct {
+		name         string
+		campaigncall *campaigncall.Campaigncall
+
+		result campaigncall.Result
+
+		responseCurTime *time.Time
+		expectRes       *campaigncall.Campaigncall
+	}{
+		{
+			"normal",
+			&campaigncall.Campaigncall{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("a1d7e3f0-9c4c-11f0-9b2e-3c5d7f1a2b64"),
+					CustomerID: uuid.FromStringOrNil("a2084c1e-9c4c-11f0-8e7d-6a2b4c9d1e75"),
+				},
+				CampaignID: uuid.FromStringOrNil("a2369d4a-9c4c-11f0-b3c1-9f8e7d6c5b86"),
+				Status:     campaigncall.StatusProgressing,
+			},
+
+			campaigncall.ResultSuccess,
+
+			&curTime2,
+			&campaigncall.Campaigncall{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("a1d7e3f0-9c4c-11f0-9b2e-3c5d7f1a2b64"),
+					CustomerID: uuid.FromStringOrNil("a2084c1e-9c4c-11f0-8e7d-6a2b4c9d1e75"),
+				},
+				CampaignID: uuid.FromStringOrNil("a2369d4a-9c4c-11f0-b3c1-9f8e7d6c5b86"),
+				Status:     campaigncall.StatusDone,
+				Result:     campaigncall.ResultSuccess,
+				TMEnd:      &curTime2,
+				TMCreate:   &curTime2,
+				TMUpdate:   &curTime2,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockCache := cachehandler.NewMockCacheHandler(mc)
+			h := handler{
+				util:  mockUtil,
+				db:    dbTest,
+				cache: mockCache,
+			}
+
+			ctx := context.Background()
+
+			mockUtil.EXPECT().TimeNow().Return(tt.responseCurTime)
+			mockCache.EXPECT().CampaigncallSet(ctx, gomock.Any()).Return(nil)
+			if err := h.CampaigncallCreate(ctx, tt.campaigncall); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			mockUtil.EXPECT().TimeNow().Return(tt.responseCurTime).Times(2)
+			mockCache.EXPECT().CampaigncallSet(ctx, gomock.Any()).Return(nil)
+			if err := h.CampaigncallUpdateStatusDone(ctx, tt.campaigncall.ID, tt.result); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			mockCache.EXPECT().CampaigncallGet(gomock.Any(), tt.campaigncall.ID).Return(nil, fmt.Errorf(""))
+			mockCache.EXPECT().CampaigncallSet(gomock.Any(), gomock.Any())
+			res, err := h.CampaigncallGet(ctx, tt.campaigncall.ID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(tt.expectRes, res) == false {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_CampaigncallUpdateDisposition(t *testing.T) {
+	tests := []struct {
+		name         string
+		campaigncall *campaigncall.Campaigncall
+
+		disposition string
+
+		responseCurTime *time.Time
+		expectRes       *campaigncall.Campaigncall
+	}{
+		{
+			"normal",
+			&campaigncall.Campaigncall{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("b4c2e8d6-9c4c-11f0-a5f3-2d7e9b1c4a97"),
+					CustomerID: uuid.FromStringOrNil("b4f1a7c2-9c4c-11f0-9c6e-5b3a8d2e1fa8"),
+				},
+				Status: campaigncall.StatusProgressing,
+			},
+
+			"sale",
+
+			&curTime2,
+			&campaigncall.Campaigncall{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("b4c2e8d6-9c4c-11f0-a5f3-2d7e9b1c4a97"),
+					CustomerID: uuid.FromStringOrNil("b4f1a7c2-9c4c-11f0-9c6e-5b3a8d2e1fa8"),
+				},
+				Status:      campaigncall.StatusProgressing,
+				Disposition: "sale",
+				TMCreate:    &curTime2,
+				TMUpdate:    &curTime2,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockCache := cachehandler.NewMockCacheHandler(mc)
+			h := handler{
+				util:  mockUtil,
+				db:    dbTest,
+				cache: mockCache,
+			}
+
+			ctx := context.Background()
+
+			mockUtil.EXPECT().TimeNow().Return(tt.responseCurTime)
+			mockCache.EXPECT().CampaigncallSet(ctx, gomock.Any()).Return(nil)
+			if err := h.CampaigncallCreate(ctx, tt.campaigncall); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			mockUtil.EXPECT().TimeNow().Return(tt.responseCurTime)
+			mockCache.EXPECT().CampaigncallSet(ctx, gomock.Any()).Return(nil)
+			if err := h.CampaigncallUpdateDisposition(ctx, tt.campaigncall.ID, tt.disposition); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			mockCache.EXPECT().CampaigncallGet(gomock.Any(), tt.campaigncall.ID).Return(nil, fmt.Errorf(""))
+			mockCache.EXPECT().CampaigncallSet(gomock.Any(), gomock.Any())
+			res, err := h.CampaigncallGet(ctx, tt.campaigncall.ID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(tt.expectRes, res) == false {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_CampaigncallListByCampaignIDAndOutdialTargetIDs(t *testing.T) {
+	tests := []struct {
+		name          string
+		campaigncalls []*campaigncall.Campaigncall
+
+		campaignID       uuid.UUID
+		outdialTargetIDs []uuid.UUID
+
+		responseCurTimes []*time.Time
+		expectRes        []*campaigncall.Campaigncall
+	}{
+		{
+			"normal",
+			[]*campaigncall.Campaigncall{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("c6a1f2e4-9c4c-11f0-8d4b-1e6c3a9f2b01"),
+					},
+					CampaignID:      uuid.FromStringOrNil("c6d3b8a0-9c4c-11f0-b2e9-4a7f1c5d3e12"),
+					OutdialTargetID: uuid.FromStringOrNil("c7026c5c-9c4c-11f0-9f1a-7c2e5b8a4d23"),
+					TryCount:        2,
+				},
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("c7314e18-9c4c-11f0-a7c6-2b9d4e1f6a34"),
+					},
+					CampaignID:      uuid.FromStringOrNil("c6d3b8a0-9c4c-11f0-b2e9-4a7f1c5d3e12"),
+					OutdialTargetID: uuid.FromStringOrNil("c7026c5c-9c4c-11f0-9f1a-7c2e5b8a4d23"),
+					TryCount:        1,
+				},
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("c75f2fd4-9c4c-11f0-8b3e-5d1a7c2f9b45"),
+					},
+					CampaignID:      uuid.FromStringOrNil("c6d3b8a0-9c4c-11f0-b2e9-4a7f1c5d3e12"),
+					OutdialTargetID: uuid.FromStringOrNil("c78e1190-9c4c-11f0-9e5d-8f4c2b6a1d56"),
+				},
+			},
+
+			uuid.FromStringOrNil("c6d3b8a0-9c4c-11f0-b2e9-4a7f1c5d3e12"),
+			[]uuid.UUID{
+				uuid.FromStringOrNil("c7026c5c-9c4c-11f0-9f1a-7c2e5b8a4d23"),
+			},
+
+			[]*time.Time{&curTime2, &curTime, &curTime},
+			[]*campaigncall.Campaigncall{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("c7314e18-9c4c-11f0-a7c6-2b9d4e1f6a34"),
+					},
+					CampaignID:      uuid.FromStringOrNil("c6d3b8a0-9c4c-11f0-b2e9-4a7f1c5d3e12"),
+					OutdialTargetID: uuid.FromStringOrNil("c7026c5c-9c4c-11f0-9f1a-7c2e5b8a4d23"),
+					TryCount:        1,
+					TMCreate:        &curTime,
+				},
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("c6a1f2e4-9c4c-11f0-8d4b-1e6c3a9f2b01"),
+					},
+					CampaignID:      uuid.FromStringOrNil("c6d3b8a0-9c4c-11f0-b2e9-4a7f1c5d3e12"),
+					OutdialTargetID: uuid.FromStringOrNil("c7026c5c-9c4c-11f0-9f1a-7c2e5b8a4d23"),
+					TryCount:        2,
+					TMCreate:        &curTime2,
+				},
+			},
+		},
+		{
+			"empty target ids",
+			[]*campaigncall.Campaigncall{},
+
+			uuid.FromStringOrNil("c6d3b8a0-9c4c-11f0-b2e9-4a7f1c5d3e12"),
+			[]uuid.UUID{},
+
+			[]*time.Time{},
+			[]*campaigncall.Campaigncall{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockCache := cachehandler.NewMockCacheHandler(mc)
+			h := handler{
+				util:  mockUtil,
+				db:    dbTest,
+				cache: mockCache,
+			}
+
+			ctx := context.Background()
+
+			for i, cc := range tt.campaigncalls {
+				mockUtil.EXPECT().TimeNow().Return(tt.responseCurTimes[i])
+				mockCache.EXPECT().CampaigncallSet(ctx, gomock.Any()).Return(nil)
+				if err := h.CampaigncallCreate(ctx, cc); err != nil {
+					t.Errorf("Wrong match. expect: ok, got: %v", err)
+				}
+			}
+
+			res, err := h.CampaigncallListByCampaignIDAndOutdialTargetIDs(ctx, tt.campaignID, tt.outdialTargetIDs)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(tt.expectRes, res) == false {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
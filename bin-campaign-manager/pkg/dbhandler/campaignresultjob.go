@@ -0,0 +1,224 @@
+package dbhandler
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/gofrs/uuid"
+
+	commondatabasehandler "monorepo/bin-common-handler/pkg/databasehandler"
+
+	"monorepo/bin-campaign-manager/models/campaignresultjob"
+)
+
+const (
+	campaignResultJobsTable = "campaign_campaignresultjobs"
+)
+
+// campaignResultJobGetFromRow gets the campaignresultjob from the row.
+func (h *handler) campaignResultJobGetFromRow(row *sql.Rows) (*campaignresultjob.CampaignResultJob, error) {
+	res := &campaignresultjob.CampaignResultJob{}
+
+	if err := commondatabasehandler.ScanRow(row, res); err != nil {
+		return nil, fmt.Errorf("could not scan the row. campaignResultJobGetFromRow. err: %v", err)
+	}
+
+	return res, nil
+}
+
+// CampaignResultJobCreate insert a new campaignresultjob record
+func (h *handler) CampaignResultJobCreate(ctx context.Context, j *campaignresultjob.CampaignResultJob) error {
+	j.TMCreate = h.util.TimeNow()
+	j.TMUpdate = nil
+	j.TMDelete = nil
+
+	fields, err := commondatabasehandler.PrepareFields(j)
+	if err != nil {
+		return fmt.Errorf("could not prepare fields. CampaignResultJobCreate. err: %v", err)
+	}
+
+	query, args, err := squirrel.
+		Insert(campaignResultJobsTable).
+		SetMap(fields).
+		PlaceholderFormat(squirrel.Question).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("could not build query. CampaignResultJobCreate. err: %v", err)
+	}
+
+	if _, err := h.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("could not execute query. CampaignResultJobCreate. err: %v", err)
+	}
+
+	return nil
+}
+
+// CampaignResultJobGet returns campaignresultjob.
+func (h *handler) CampaignResultJobGet(ctx context.Context, id uuid.UUID) (*campaignresultjob.CampaignResultJob, error) {
+	fields := commondatabasehandler.GetDBFields(&campaignresultjob.CampaignResultJob{})
+	query, args, err := squirrel.
+		Select(fields...).
+		From(campaignResultJobsTable).
+		Where(squirrel.Eq{string(campaignresultjob.FieldID): id.Bytes()}).
+		PlaceholderFormat(squirrel.Question).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("could not build sql. CampaignResultJobGet. err: %v", err)
+	}
+
+	row, err := h.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("could not query. CampaignResultJobGet. err: %v", err)
+	}
+	defer func() {
+		_ = row.Close()
+	}()
+
+	if !row.Next() {
+		if err := row.Err(); err != nil {
+			return nil, fmt.Errorf("row iteration error. CampaignResultJobGet. err: %v", err)
+		}
+		return nil, ErrNotFound
+	}
+
+	res, err := h.campaignResultJobGetFromRow(row)
+	if err != nil {
+		return nil, fmt.Errorf("could not get data from row. CampaignResultJobGet. id: %s, err: %v", id, err)
+	}
+
+	return res, nil
+}
+
+// CampaignResultJobList returns list of campaignresultjobs with filters.
+func (h *handler) CampaignResultJobList(ctx context.Context, token string, size uint64, filters map[campaignresultjob.Field]any) ([]*campaignresultjob.CampaignResultJob, error) {
+	if token == "" {
+		token = h.util.TimeGetCurTime()
+	}
+
+	fields := commondatabasehandler.GetDBFields(&campaignresultjob.CampaignResultJob{})
+	sb := squirrel.
+		Select(fields...).
+		From(campaignResultJobsTable).
+		Where(squirrel.Lt{string(campaignresultjob.FieldTMCreate): token}).
+		OrderBy(string(campaignresultjob.FieldTMCreate)+" DESC", string(campaignresultjob.FieldID)+" DESC").
+		Limit(size).
+		PlaceholderFormat(squirrel.Question)
+
+	sb, err := commondatabasehandler.ApplyFields(sb, filters)
+	if err != nil {
+		return nil, fmt.Errorf("could not apply filters. CampaignResultJobList. err: %v", err)
+	}
+
+	query, args, err := sb.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("could not build query. CampaignResultJobList. err: %v", err)
+	}
+
+	rows, err := h.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("could not query. CampaignResultJobList. err: %v", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	res := []*campaignresultjob.CampaignResultJob{}
+	for rows.Next() {
+		u, err := h.campaignResultJobGetFromRow(rows)
+		if err != nil {
+			return nil, fmt.Errorf("could not get data. CampaignResultJobList, err: %v", err)
+		}
+		res = append(res, u)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error. CampaignResultJobList. err: %v", err)
+	}
+
+	return res, nil
+}
+
+// CampaignResultJobUpdate updates the campaignresultjob's fields.
+func (h *handler) CampaignResultJobUpdate(ctx context.Context, id uuid.UUID, fields map[campaignresultjob.Field]any) error {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	fields[campaignresultjob.FieldTMUpdate] = h.util.TimeNow()
+
+	tmpFields, err := commondatabasehandler.PrepareFields(fields)
+	if err != nil {
+		return fmt.Errorf("CampaignResultJobUpdate: prepare fields failed: %w", err)
+	}
+
+	sqlStr, args, err := squirrel.Update(campaignResultJobsTable).
+		SetMap(tmpFields).
+		Where(squirrel.Eq{string(campaignresultjob.FieldID): id.Bytes()}).
+		PlaceholderFormat(squirrel.Question).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("CampaignResultJobUpdate: build SQL failed: %w", err)
+	}
+
+	if _, err := h.db.ExecContext(ctx, sqlStr, args...); err != nil {
+		return fmt.Errorf("CampaignResultJobUpdate: exec failed: %w", err)
+	}
+
+	return nil
+}
+
+// CampaignResultJobHeartbeat touches the processing campaignresultjob's tm_update.
+// The running job calls it periodically, so the recovery does not take over the job.
+func (h *handler) CampaignResultJobHeartbeat(ctx context.Context, id uuid.UUID) error {
+	sqlStr, args, err := squirrel.Update(campaignResultJobsTable).
+		Set(string(campaignresultjob.FieldTMUpdate), h.util.TimeNow()).
+		Where(squirrel.Eq{string(campaignresultjob.FieldID): id.Bytes()}).
+		Where(squirrel.Eq{string(campaignresultjob.FieldStatus): string(campaignresultjob.StatusProcessing)}).
+		PlaceholderFormat(squirrel.Question).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("CampaignResultJobHeartbeat: build SQL failed: %w", err)
+	}
+
+	if _, err := h.db.ExecContext(ctx, sqlStr, args...); err != nil {
+		return fmt.Errorf("CampaignResultJobHeartbeat: exec failed: %w", err)
+	}
+
+	return nil
+}
+
+// CampaignResultJobClaim takes over the abandoned processing campaignresultjob.
+// It touches the tm_update only if the job is still processing with the given tm_update,
+// and returns the number of updated rows, so the caller can tell whether it won the race.
+func (h *handler) CampaignResultJobClaim(ctx context.Context, id uuid.UUID, tmUpdate *time.Time) (int64, error) {
+	q := squirrel.Update(campaignResultJobsTable).
+		Set(string(campaignresultjob.FieldTMUpdate), h.util.TimeNow()).
+		Where(squirrel.Eq{string(campaignresultjob.FieldID): id.Bytes()}).
+		Where(squirrel.Eq{string(campaignresultjob.FieldStatus): string(campaignresultjob.StatusProcessing)}).
+		PlaceholderFormat(squirrel.Question)
+
+	if tmUpdate == nil {
+		q = q.Where(squirrel.Eq{string(campaignresultjob.FieldTMUpdate): nil})
+	} else {
+		q = q.Where(squirrel.Eq{string(campaignresultjob.FieldTMUpdate): *tmUpdate})
+	}
+
+	sqlStr, args, err := q.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("CampaignResultJobClaim: build SQL failed: %w", err)
+	}
+
+	result, err := h.db.ExecContext(ctx, sqlStr, args...)
+	if err != nil {
+		return 0, fmt.Errorf("CampaignResultJobClaim: exec failed: %w", err)
+	}
+
+	res, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("CampaignResultJobClaim: rows affected failed: %w", err)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,243 @@
+package dbhandler
+
+import (
+	"context"
+	reflect "reflect"
+	"testing"
+	"time"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	"monorepo/bin-campaign-manager/models/campaignresultjob"
+	"monorepo/bin-campaign-manager/pkg/cachehandler"
+)
+
+func Test_CampaignResultJobCreate(t *testing.T) {
+	tests := []struct {
+		name string
+		job  *campaignresultjob.CampaignResultJob
+
+		expectRes []*campaignresultjob.CampaignResultJob
+	}{
+		{
+			"normal",
+			&campaignresultjob.CampaignResultJob{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("7e1a3c50-ad5d-11f0-8b2e-4d6f8a1c3e70"),
+					CustomerID: uuid.FromStringOrNil("7e4b6d72-ad5d-11f0-9c3f-5e7a9b2d4f80"),
+				},
+				CampaignID: uuid.FromStringOrNil("7e7c8e94-ad5d-11f0-ad4a-6f8b1c3e5a90"),
+				Status:     campaignresultjob.StatusProcessing,
+			},
+
+			[]*campaignresultjob.CampaignResultJob{
+				{
+					Identity: commonidentity.Identity{
+						ID:         uuid.FromStringOrNil("7e1a3c50-ad5d-11f0-8b2e-4d6f8a1c3e70"),
+						CustomerID: uuid.FromStringOrNil("7e4b6d72-ad5d-11f0-9c3f-5e7a9b2d4f80"),
+					},
+					CampaignID: uuid.FromStringOrNil("7e7c8e94-ad5d-11f0-ad4a-6f8b1c3e5a90"),
+					Status:     campaignresultjob.StatusProcessing,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockCache := cachehandler.NewMockCacheHandler(mc)
+			h := handler{
+				util:  utilhandler.NewUtilHandler(),
+				db:    dbTest,
+				cache: mockCache,
+			}
+			ctx := context.Background()
+
+			if err := h.CampaignResultJobCreate(ctx, tt.job); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			tmp, err := h.CampaignResultJobGet(ctx, tt.job.ID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+			if tmp.ID != tt.job.ID || tmp.TMCreate == nil {
+				t.Errorf("Wrong match. expect: %s with tm_create, got: %s/%v", tt.job.ID, tmp.ID, tmp.TMCreate)
+			}
+
+			filters := map[campaignresultjob.Field]any{
+				campaignresultjob.FieldCampaignID: tt.job.CampaignID,
+				campaignresultjob.FieldDeleted:    false,
+			}
+			res, err := h.CampaignResultJobList(ctx, utilhandler.TimeGetCurTimeAdd(time.Second), 10, filters)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			for i, j := range res {
+				tt.expectRes[i].TMCreate = j.TMCreate
+			}
+			if !reflect.DeepEqual(tt.expectRes, res) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_CampaignResultJobUpdate(t *testing.T) {
+	tests := []struct {
+		name string
+		job  *campaignresultjob.CampaignResultJob
+
+		fields map[campaignresultjob.Field]any
+
+		expectStatus      campaignresultjob.Status
+		expectTargetCount int
+		expectFileID      uuid.UUID
+	}{
+		{
+			"normal",
+			&campaignresultjob.CampaignResultJob{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("9a2b4c60-ad5d-11f0-8e5f-7a9c1d3f5b10"),
+					CustomerID: uuid.FromStringOrNil("9a5c6d82-ad5d-11f0-9f6a-8b1d2e4a6c20"),
+				},
+				CampaignID: uuid.FromStringOrNil("9a8d7ea4-ad5d-11f0-a07b-9c2e3f5b7d30"),
+				Status:     campaignresultjob.StatusProcessing,
+			},
+
+			map[campaignresultjob.Field]any{
+				campaignresultjob.FieldStatus:      campaignresultjob.StatusDone,
+				campaignresultjob.FieldTargetCount: 10,
+				campaignresultjob.FieldFileID:      uuid.FromStringOrNil("9abe8fc6-ad5d-11f0-b18c-1d3f4a6c8e40"),
+			},
+
+			campaignresultjob.StatusDone,
+			10,
+			uuid.FromStringOrNil("9abe8fc6-ad5d-11f0-b18c-1d3f4a6c8e40"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockCache := cachehandler.NewMockCacheHandler(mc)
+			h := handler{
+				util:  utilhandler.NewUtilHandler(),
+				db:    dbTest,
+				cache: mockCache,
+			}
+			ctx := context.Background()
+
+			if err := h.CampaignResultJobCreate(ctx, tt.job); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if err := h.CampaignResultJobUpdate(ctx, tt.job.ID, tt.fields); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			res, err := h.CampaignResultJobGet(ctx, tt.job.ID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if res.Status != tt.expectStatus || res.TargetCount != tt.expectTargetCount || res.FileID != tt.expectFileID {
+				t.Errorf("Wrong match. expect: %s/%d/%s, got: %s/%d/%s", tt.expectStatus, tt.expectTargetCount, tt.expectFileID, res.Status, res.TargetCount, res.FileID)
+			}
+			if res.TMUpdate == nil {
+				t.Errorf("Wrong match. expect: tm_update, got: nil")
+			}
+		})
+	}
+}
+
+func Test_CampaignResultJobClaim(t *testing.T) {
+	tests := []struct {
+		name string
+		job  *campaignresultjob.CampaignResultJob
+	}{
+		{
+			"normal",
+			&campaignresultjob.CampaignResultJob{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("b62c4e70-ad5d-11f0-8a9d-2e4a5b7d9f50"),
+					CustomerID: uuid.FromStringOrNil("b65d6f92-ad5d-11f0-9bae-3f5b6c8e1a60"),
+				},
+				CampaignID: uuid.FromStringOrNil("b68e80b4-ad5d-11f0-acbf-4a6c7d9f2b70"),
+				Status:     campaignresultjob.StatusProcessing,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockCache := cachehandler.NewMockCacheHandler(mc)
+			h := handler{
+				util:  utilhandler.NewUtilHandler(),
+				db:    dbTest,
+				cache: mockCache,
+			}
+			ctx := context.Background()
+
+			if err := h.CampaignResultJobCreate(ctx, tt.job); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			// the first claim of the job never updated wins
+			n, err := h.CampaignResultJobClaim(ctx, tt.job.ID, nil)
+			if err != nil || n != 1 {
+				t.Errorf("Wrong match. expect: 1, got: %d, err: %v", n, err)
+			}
+
+			// the other claim with the stale tm_update loses
+			n, err = h.CampaignResultJobClaim(ctx, tt.job.ID, nil)
+			if err != nil || n != 0 {
+				t.Errorf("Wrong match. expect: 0, got: %d, err: %v", n, err)
+			}
+
+			// the heartbeat keeps the job processing with the new tm_update
+			if errHeartbeat := h.CampaignResultJobHeartbeat(ctx, tt.job.ID); errHeartbeat != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", errHeartbeat)
+			}
+			res, err := h.CampaignResultJobGet(ctx, tt.job.ID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+			if res.Status != campaignresultjob.StatusProcessing || res.TMUpdate == nil {
+				t.Errorf("Wrong match. expect: processing with tm_update, got: %s/%v", res.Status, res.TMUpdate)
+			}
+
+			// the claim with the observed tm_update wins
+			n, err = h.CampaignResultJobClaim(ctx, tt.job.ID, res.TMUpdate)
+			if err != nil || n != 1 {
+				t.Errorf("Wrong match. expect: 1, got: %d, err: %v", n, err)
+			}
+
+			// the finished job can't be claimed
+			if errUpdate := h.CampaignResultJobUpdate(ctx, tt.job.ID, map[campaignresultjob.Field]any{campaignresultjob.FieldStatus: campaignresultjob.StatusDone}); errUpdate != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", errUpdate)
+			}
+			res, err = h.CampaignResultJobGet(ctx, tt.job.ID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+			n, err = h.CampaignResultJobClaim(ctx, tt.job.ID, res.TMUpdate)
+			if err != nil || n != 0 {
+				t.Errorf("Wrong match. expect: 0, got: %d, err: %v", n, err)
+			}
+		})
+	}
+}
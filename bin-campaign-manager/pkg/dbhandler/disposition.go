@@ -0,0 +1,209 @@
+package dbhandler
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/gofrs/uuid"
+
+	commondatabasehandler "monorepo/bin-common-handler/pkg/databasehandler"
+
+	"monorepo/bin-campaign-manager/models/disposition"
+)
+
+const (
+	dispositionsTable = "campaign_dispositions"
+)
+
+// dispositionGetFromRow gets the disposition from the row.
+func (h *handler) dispositionGetFromRow(row *sql.Rows) (*disposition.Disposition, error) {
+	res := &disposition.Disposition{}
+
+	if err := commondatabasehandler.ScanRow(row, res); err != nil {
+		return nil, fmt.Errorf("could not scan the row. dispositionGetFromRow. err: %v", err)
+	}
+
+	return res, nil
+}
+
+// DispositionCreate insert a new disposition record
+func (h *handler) DispositionCreate(ctx context.Context, d *disposition.Disposition) error {
+	d.TMCreate = h.util.TimeNow()
+	d.TMUpdate = nil
+	d.TMDelete = nil
+
+	fields, err := commondatabasehandler.PrepareFields(d)
+	if err != nil {
+		return fmt.Errorf("could not prepare fields. DispositionCreate. err: %v", err)
+	}
+
+	query, args, err := squirrel.
+		Insert(dispositionsTable).
+		SetMap(fields).
+		PlaceholderFormat(squirrel.Question).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("could not build query. DispositionCreate. err: %v", err)
+	}
+
+	if _, err := h.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("could not execute query. DispositionCreate. err: %v", err)
+	}
+
+	return nil
+}
+
+// dispositionGetOne returns the first disposition matched to the given conditions.
+func (h *handler) dispositionGetOne(ctx context.Context, conditions squirrel.Sqlizer) (*disposition.Disposition, error) {
+	fields := commondatabasehandler.GetDBFields(&disposition.Disposition{})
+	query, args, err := squirrel.
+		Select(fields...).
+		From(dispositionsTable).
+		Where(conditions).
+		Limit(1).
+		PlaceholderFormat(squirrel.Question).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("could not build sql. dispositionGetOne. err: %v", err)
+	}
+
+	row, err := h.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("could not query. dispositionGetOne. err: %v", err)
+	}
+	defer func() {
+		_ = row.Close()
+	}()
+
+	if !row.Next() {
+		if err := row.Err(); err != nil {
+			return nil, fmt.Errorf("row iteration error. dispositionGetOne. err: %v", err)
+		}
+		return nil, ErrNotFound
+	}
+
+	return h.dispositionGetFromRow(row)
+}
+
+// DispositionGet returns disposition.
+func (h *handler) DispositionGet(ctx context.Context, id uuid.UUID) (*disposition.Disposition, error) {
+	return h.dispositionGetOne(ctx, squirrel.Eq{string(disposition.FieldID): id.Bytes()})
+}
+
+// DispositionGetByCode returns the customer's not deleted disposition of the given code.
+func (h *handler) DispositionGetByCode(ctx context.Context, customerID uuid.UUID, code string) (*disposition.Disposition, error) {
+	return h.dispositionGetOne(ctx, squirrel.Eq{
+		string(disposition.FieldCustomerID): customerID.Bytes(),
+		string(disposition.FieldCode):       code,
+		string(disposition.FieldTMDelete):   nil,
+	})
+}
+
+// DispositionList returns list of dispositions with filters.
+func (h *handler) DispositionList(ctx context.Context, token string, size uint64, filters map[disposition.Field]any) ([]*disposition.Disposition, error) {
+	if token == "" {
+		token = h.util.TimeGetCurTime()
+	}
+
+	fields := commondatabasehandler.GetDBFields(&disposition.Disposition{})
+	sb := squirrel.
+		Select(fields...).
+		From(dispositionsTable).
+		Where(squirrel.Lt{string(disposition.FieldTMCreate): token}).
+		OrderBy(string(disposition.FieldTMCreate)+" DESC", string(disposition.FieldID)+" DESC").
+		Limit(size).
+		PlaceholderFormat(squirrel.Question)
+
+	sb, err := commondatabasehandler.ApplyFields(sb, filters)
+	if err != nil {
+		return nil, fmt.Errorf("could not apply filters. DispositionList. err: %v", err)
+	}
+
+	query, args, err := sb.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("could not build query. DispositionList. err: %v", err)
+	}
+
+	rows, err := h.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("could not query. DispositionList. err: %v", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	res := []*disposition.Disposition{}
+	for rows.Next() {
+		u, err := h.dispositionGetFromRow(rows)
+		if err != nil {
+			return nil, fmt.Errorf("could not get data. DispositionList, err: %v", err)
+		}
+		res = append(res, u)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error. DispositionList. err: %v", err)
+	}
+
+	return res, nil
+}
+
+// DispositionUpdate updates disposition fields.
+func (h *handler) DispositionUpdate(ctx context.Context, id uuid.UUID, fields map[disposition.Field]any) error {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	fields[disposition.FieldTMUpdate] = h.util.TimeNow()
+
+	tmpFields, err := commondatabasehandler.PrepareFields(fields)
+	if err != nil {
+		return fmt.Errorf("DispositionUpdate: prepare fields failed: %w", err)
+	}
+
+	sqlStr, args, err := squirrel.Update(dispositionsTable).
+		SetMap(tmpFields).
+		Where(squirrel.Eq{string(disposition.FieldID): id.Bytes()}).
+		PlaceholderFormat(squirrel.Question).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("DispositionUpdate: build SQL failed: %w", err)
+	}
+
+	if _, err := h.db.ExecContext(ctx, sqlStr, args...); err != nil {
+		return fmt.Errorf("DispositionUpdate: exec failed: %w", err)
+	}
+
+	return nil
+}
+
+// DispositionDelete deletes the given disposition
+func (h *handler) DispositionDelete(ctx context.Context, id uuid.UUID) error {
+	ts := h.util.TimeNow()
+
+	fields := map[disposition.Field]any{
+		disposition.FieldTMUpdate: ts,
+		disposition.FieldTMDelete: ts,
+	}
+
+	tmpFields, err := commondatabasehandler.PrepareFields(fields)
+	if err != nil {
+		return fmt.Errorf("DispositionDelete: prepare fields failed: %w", err)
+	}
+
+	sqlStr, args, err := squirrel.Update(dispositionsTable).
+		SetMap(tmpFields).
+		Where(squirrel.Eq{string(disposition.FieldID): id.Bytes()}).
+		PlaceholderFormat(squirrel.Question).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("DispositionDelete: build SQL failed: %w", err)
+	}
+
+	if _, err := h.db.ExecContext(ctx, sqlStr, args...); err != nil {
+		return fmt.Errorf("DispositionDelete: exec failed: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,312 @@
+package dbhandler
+
+import (
+	"context"
+	reflect "reflect"
+	"testing"
+	"time"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	"monorepo/bin-campaign-manager/models/disposition"
+	"monorepo/bin-campaign-manager/pkg/cachehandler"
+)
+
+func Test_DispositionCreate(t *testing.T) {
+	tests := []struct {
+		name        string
+		disposition *disposition.Disposition
+
+		responseCurTime *time.Time
+		expectRes       *disposition.Disposition
+	}{
+		{
+			"normal",
+			&disposition.Disposition{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("3b0ea0f4-9c4b-11f0-8f5e-5b2d6c1a7e90"),
+					CustomerID: uuid.FromStringOrNil("3b3f7c2a-9c4b-11f0-a6c4-8f1e2d3c4b5a"),
+				},
+				Code:      "sale",
+				Name:      "Sale",
+				Detail:    "The callee bought the product.",
+				RetryMode: disposition.RetryModeNoRetry,
+			},
+
+			&curTime,
+			&disposition.Disposition{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("3b0ea0f4-9c4b-11f0-8f5e-5b2d6c1a7e90"),
+					CustomerID: uuid.FromStringOrNil("3b3f7c2a-9c4b-11f0-a6c4-8f1e2d3c4b5a"),
+				},
+				Code:      "sale",
+				Name:      "Sale",
+				Detail:    "The callee bought the product.",
+				RetryMode: disposition.RetryModeNoRetry,
+				TMCreate:  &curTime,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockCache := cachehandler.NewMockCacheHandler(mc)
+			h := handler{
+				util:  mockUtil,
+				db:    dbTest,
+				cache: mockCache,
+			}
+			ctx := context.Background()
+
+			mockUtil.EXPECT().TimeNow().Return(tt.responseCurTime)
+			if err := h.DispositionCreate(ctx, tt.disposition); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			res, err := h.DispositionGet(ctx, tt.disposition.ID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+			if !reflect.DeepEqual(tt.expectRes, res) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+
+			res, err = h.DispositionGetByCode(ctx, tt.disposition.CustomerID, tt.disposition.Code)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+			if !reflect.DeepEqual(tt.expectRes, res) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_DispositionList(t *testing.T) {
+	tests := []struct {
+		name         string
+		dispositions []*disposition.Disposition
+
+		filters map[disposition.Field]any
+
+		responseCurTime *time.Time
+		expectRes       []*disposition.Disposition
+	}{
+		{
+			"normal",
+			[]*disposition.Disposition{
+				{
+					Identity: commonidentity.Identity{
+						ID:         uuid.FromStringOrNil("5c1e6a2e-9c4b-11f0-9d1b-3f6a8e2c7b41"),
+						CustomerID: uuid.FromStringOrNil("5c4d7e8a-9c4b-11f0-84f7-1b9c2d3e4f50"),
+					},
+					Code:      "callback_requested",
+					RetryMode: disposition.RetryModeRetry,
+				},
+				{
+					Identity: commonidentity.Identity{
+						ID:         uuid.FromStringOrNil("5c7b3f9c-9c4b-11f0-b0e2-6d4c8a1f2e63"),
+						CustomerID: uuid.FromStringOrNil("5ca8f0b6-9c4b-11f0-8a3c-9e7d1b2c3a74"),
+					},
+					Code:      "callback_requested",
+					RetryMode: disposition.RetryModeRetry,
+				},
+			},
+
+			map[disposition.Field]any{
+				disposition.FieldCustomerID: uuid.FromStringOrNil("5c4d7e8a-9c4b-11f0-84f7-1b9c2d3e4f50"),
+				disposition.FieldDeleted:    false,
+			},
+
+			&curTime,
+			[]*disposition.Disposition{
+				{
+					Identity: commonidentity.Identity{
+						ID:         uuid.FromStringOrNil("5c1e6a2e-9c4b-11f0-9d1b-3f6a8e2c7b41"),
+						CustomerID: uuid.FromStringOrNil("5c4d7e8a-9c4b-11f0-84f7-1b9c2d3e4f50"),
+					},
+					Code:      "callback_requested",
+					RetryMode: disposition.RetryModeRetry,
+					TMCreate:  &curTime,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockCache := cachehandler.NewMockCacheHandler(mc)
+			h := handler{
+				util:  mockUtil,
+				db:    dbTest,
+				cache: mockCache,
+			}
+			ctx := context.Background()
+
+			for _, d := range tt.dispositions {
+				mockUtil.EXPECT().TimeNow().Return(tt.responseCurTime)
+				if err := h.DispositionCreate(ctx, d); err != nil {
+					t.Errorf("Wrong match. expect: ok, got: %v", err)
+				}
+			}
+
+			res, err := h.DispositionList(ctx, "2026-10-01T00:00:00.000000Z", 10, tt.filters)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+			if !reflect.DeepEqual(tt.expectRes, res) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_DispositionUpdate(t *testing.T) {
+	tests := []struct {
+		name        string
+		disposition *disposition.Disposition
+
+		fields map[disposition.Field]any
+
+		responseCurTime *time.Time
+		expectRes       *disposition.Disposition
+	}{
+		{
+			"normal",
+			&disposition.Disposition{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("7a2c9e14-9c4b-11f0-a1d5-2c8e4f6b9a01"),
+					CustomerID: uuid.FromStringOrNil("7a5b1d36-9c4b-11f0-9e0c-7f3a5b2c1d92"),
+				},
+				Code:      "wrong_number",
+				RetryMode: disposition.RetryModeDefault,
+			},
+
+			map[disposition.Field]any{
+				disposition.FieldName:      "Wrong number",
+				disposition.FieldRetryMode: disposition.RetryModeNoRetry,
+			},
+
+			&curTime,
+			&disposition.Disposition{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("7a2c9e14-9c4b-11f0-a1d5-2c8e4f6b9a01"),
+					CustomerID: uuid.FromStringOrNil("7a5b1d36-9c4b-11f0-9e0c-7f3a5b2c1d92"),
+				},
+				Code:      "wrong_number",
+				Name:      "Wrong number",
+				RetryMode: disposition.RetryModeNoRetry,
+				TMCreate:  &curTime,
+				TMUpdate:  &curTime,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockCache := cachehandler.NewMockCacheHandler(mc)
+			h := handler{
+				util:  mockUtil,
+				db:    dbTest,
+				cache: mockCache,
+			}
+			ctx := context.Background()
+
+			mockUtil.EXPECT().TimeNow().Return(tt.responseCurTime)
+			if err := h.DispositionCreate(ctx, tt.disposition); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			mockUtil.EXPECT().TimeNow().Return(tt.responseCurTime)
+			if err := h.DispositionUpdate(ctx, tt.disposition.ID, tt.fields); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			res, err := h.DispositionGet(ctx, tt.disposition.ID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+			if !reflect.DeepEqual(tt.expectRes, res) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_DispositionDelete(t *testing.T) {
+	tests := []struct {
+		name        string
+		disposition *disposition.Disposition
+
+		responseCurTime *time.Time
+	}{
+		{
+			"normal",
+			&disposition.Disposition{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("8e4f2a6c-9c4b-11f0-b7a3-5d1c9e2f4a83"),
+					CustomerID: uuid.FromStringOrNil("8e7c3b5e-9c4b-11f0-8c2d-1a6f4e3b2c94"),
+				},
+				Code:      "not_interested",
+				RetryMode: disposition.RetryModeNoRetry,
+			},
+
+			&curTime,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockCache := cachehandler.NewMockCacheHandler(mc)
+			h := handler{
+				util:  mockUtil,
+				db:    dbTest,
+				cache: mockCache,
+			}
+			ctx := context.Background()
+
+			mockUtil.EXPECT().TimeNow().Return(tt.responseCurTime)
+			if err := h.DispositionCreate(ctx, tt.disposition); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			mockUtil.EXPECT().TimeNow().Return(tt.responseCurTime)
+			if err := h.DispositionDelete(ctx, tt.disposition.ID); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			res, err := h.DispositionGet(ctx, tt.disposition.ID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+			if res.TMDelete == nil {
+				t.Errorf("Wrong match. expect: deleted, got: %v", res.TMDelete)
+			}
+
+			// the deleted disposition's code is free
+			if _, err := h.DispositionGetByCode(ctx, tt.disposition.CustomerID, tt.disposition.Code); err != ErrNotFound {
+				t.Errorf("Wrong match. expect: ErrNotFound, got: %v", err)
+			}
+		})
+	}
+}
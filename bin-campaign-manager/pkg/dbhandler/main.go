@@ -18,6 +18,8 @@ import (
 
 	"monorepo/bin-campaign-manager/models/campaign"
 	"monorepo/bin-campaign-manager/models/campaigncall"
+	"monorepo/bin-campaign-manager/models/campaignresultjob"
+	"monorepo/bin-campaign-manager/models/disposition"
 	"monorepo/bin-campaign-manager/models/outplan"
 	"monorepo/bin-campaign-manager/pkg/cachehandler"
 )
@@ -71,6 +73,8 @@ type DBHandler interface {
 	CampaignUpdateEndHandle(ctx context.Context, id uuid.UUID, endHandle campaign.EndHandle) error
 	CampaignUpdateActions(ctx context.Context, id uuid.UUID, actions []fmaction.Action) error
 	CampaignUpdateType(ctx context.Context, id uuid.UUID, campaignType campaign.Type) error
+	CampaignUpdateDialMode(ctx context.Context, id uuid.UUID, dialMode campaign.DialMode, maxAbandonRate int) error
+	CampaignUpdateCallingWindows(ctx context.Context, id uuid.UUID, callingWindows []campaign.CallingWindow, timezone string) error
 
 	// campaigncall
 	CampaigncallCreate(ctx context.Context, t *campaigncall.Campaigncall) error
@@ -83,9 +87,31 @@ type DBHandler interface {
 	CampaigncallListByCampaignID(ctx context.Context, campaignID uuid.UUID, token string, limit uint64) ([]*campaigncall.Campaigncall, error)
 	CampaigncallListByCampaignIDAndStatus(ctx context.Context, campaignID uuid.UUID, status campaigncall.Status, token string, limit uint64) ([]*campaigncall.Campaigncall, error)
 	CampaigncallListOngoingByCampaignID(ctx context.Context, campaignID uuid.UUID, token string, limit uint64) ([]*campaigncall.Campaigncall, error)
+	CampaigncallListByCampaignIDAndOutdialTargetIDs(ctx context.Context, campaignID uuid.UUID, outdialTargetIDs []uuid.UUID) ([]*campaigncall.Campaigncall, error)
 	CampaigncallUpdate(ctx context.Context, id uuid.UUID, fields map[campaigncall.Field]any) error
 	CampaigncallUpdateStatus(ctx context.Context, id uuid.UUID, status campaigncall.Status) error
 	CampaigncallUpdateStatusAndResult(ctx context.Context, id uuid.UUID, status campaigncall.Status, result campaigncall.Result) error
+	CampaigncallUpdateStatusDone(ctx context.Context, id uuid.UUID, result campaigncall.Result) error
+	CampaigncallUpdateStatusProgressing(ctx context.Context, id uuid.UUID) error
+	CampaigncallUpdateAgentIDAndStatusFromPreviewing(ctx context.Context, id uuid.UUID, agentID uuid.UUID, status campaigncall.Status) (int64, error)
+	CampaigncallUpdateAbandoned(ctx context.Context, id uuid.UUID, abandoned bool) error
+	CampaigncallUpdateDisposition(ctx context.Context, id uuid.UUID, disposition string) error
+
+	// campaignresultjob
+	CampaignResultJobCreate(ctx context.Context, j *campaignresultjob.CampaignResultJob) error
+	CampaignResultJobGet(ctx context.Context, id uuid.UUID) (*campaignresultjob.CampaignResultJob, error)
+	CampaignResultJobList(ctx context.Context, token string, size uint64, filters map[campaignresultjob.Field]any) ([]*campaignresultjob.CampaignResultJob, error)
+	CampaignResultJobUpdate(ctx context.Context, id uuid.UUID, fields map[campaignresultjob.Field]any) error
+	CampaignResultJobHeartbeat(ctx context.Context, id uuid.UUID) error
+	CampaignResultJobClaim(ctx context.Context, id uuid.UUID, tmUpdate *time.Time) (int64, error)
+
+	// disposition
+	DispositionCreate(ctx context.Context, d *disposition.Disposition) error
+	DispositionDelete(ctx context.Context, id uuid.UUID) error
+	DispositionGet(ctx context.Context, id uuid.UUID) (*disposition.Disposition, error)
+	DispositionGetByCode(ctx context.Context, customerID uuid.UUID, code string) (*disposition.Disposition, error)
+	DispositionList(ctx context.Context, token string, size uint64, filters map[disposition.Field]any) ([]*disposition.Disposition, error)
+	DispositionUpdate(ctx context.Context, id uuid.UUID, fields map[disposition.Field]any) error
 }
 
 // handler database handler
@@ -13,10 +13,13 @@ import (
 	context "context"
 	campaign "monorepo/bin-campaign-manager/models/campaign"
 	campaigncall "monorepo/bin-campaign-manager/models/campaigncall"
+	campaignresultjob "monorepo/bin-campaign-manager/models/campaignresultjob"
+	disposition "monorepo/bin-campaign-manager/models/disposition"
 	outplan "monorepo/bin-campaign-manager/models/outplan"
 	address "monorepo/bin-common-handler/models/address"
 	action "monorepo/bin-flow-manager/models/action"
 	reflect "reflect"
+	time "time"
 
 	uuid "github.com/gofrs/uuid"
 	gomock "go.uber.org/mock/gomock"
@@ -119,6 +122,93 @@ func (mr *MockDBHandlerMockRecorder) CampaignListByCustomerID(ctx, customerID, t
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CampaignListByCustomerID", reflect.TypeOf((*MockDBHandler)(nil).CampaignListByCustomerID), ctx, customerID, token, limit)
 }
 
+// CampaignResultJobClaim mocks base method.
+func (m *MockDBHandler) CampaignResultJobClaim(ctx context.Context, id uuid.UUID, tmUpdate *time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CampaignResultJobClaim", ctx, id, tmUpdate)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CampaignResultJobClaim indicates an expected call of CampaignResultJobClaim.
+func (mr *MockDBHandlerMockRecorder) CampaignResultJobClaim(ctx, id, tmUpdate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CampaignResultJobClaim", reflect.TypeOf((*MockDBHandler)(nil).CampaignResultJobClaim), ctx, id, tmUpdate)
+}
+
+// CampaignResultJobCreate mocks base method.
+func (m *MockDBHandler) CampaignResultJobCreate(ctx context.Context, j *campaignresultjob.CampaignResultJob) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CampaignResultJobCreate", ctx, j)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CampaignResultJobCreate indicates an expected call of CampaignResultJobCreate.
+func (mr *MockDBHandlerMockRecorder) CampaignResultJobCreate(ctx, j any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CampaignResultJobCreate", reflect.TypeOf((*MockDBHandler)(nil).CampaignResultJobCreate), ctx, j)
+}
+
+// CampaignResultJobGet mocks base method.
+func (m *MockDBHandler) CampaignResultJobGet(ctx context.Context, id uuid.UUID) (*campaignresultjob.CampaignResultJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CampaignResultJobGet", ctx, id)
+	ret0, _ := ret[0].(*campaignresultjob.CampaignResultJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CampaignResultJobGet indicates an expected call of CampaignResultJobGet.
+func (mr *MockDBHandlerMockRecorder) CampaignResultJobGet(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CampaignResultJobGet", reflect.TypeOf((*MockDBHandler)(nil).CampaignResultJobGet), ctx, id)
+}
+
+// CampaignResultJobHeartbeat mocks base method.
+func (m *MockDBHandler) CampaignResultJobHeartbeat(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CampaignResultJobHeartbeat", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CampaignResultJobHeartbeat indicates an expected call of CampaignResultJobHeartbeat.
+func (mr *MockDBHandlerMockRecorder) CampaignResultJobHeartbeat(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CampaignResultJobHeartbeat", reflect.TypeOf((*MockDBHandler)(nil).CampaignResultJobHeartbeat), ctx, id)
+}
+
+// CampaignResultJobList mocks base method.
+func (m *MockDBHandler) CampaignResultJobList(ctx context.Context, token string, size uint64, filters map[campaignresultjob.Field]any) ([]*campaignresultjob.CampaignResultJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CampaignResultJobList", ctx, token, size, filters)
+	ret0, _ := ret[0].([]*campaignresultjob.CampaignResultJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CampaignResultJobList indicates an expected call of CampaignResultJobList.
+func (mr *MockDBHandlerMockRecorder) CampaignResultJobList(ctx, token, size, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CampaignResultJobList", reflect.TypeOf((*MockDBHandler)(nil).CampaignResultJobList), ctx, token, size, filters)
+}
+
+// CampaignResultJobUpdate mocks base method.
+func (m *MockDBHandler) CampaignResultJobUpdate(ctx context.Context, id uuid.UUID, fields map[campaignresultjob.Field]any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CampaignResultJobUpdate", ctx, id, fields)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CampaignResultJobUpdate indicates an expected call of CampaignResultJobUpdate.
+func (mr *MockDBHandlerMockRecorder) CampaignResultJobUpdate(ctx, id, fields any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CampaignResultJobUpdate", reflect.TypeOf((*MockDBHandler)(nil).CampaignResultJobUpdate), ctx, id, fields)
+}
+
 // CampaignUpdate mocks base method.
 func (m *MockDBHandler) CampaignUpdate(ctx context.Context, id uuid.UUID, fields map[campaign.Field]any) error {
 	m.ctrl.T.Helper()
@@ -161,6 +251,34 @@ func (mr *MockDBHandlerMockRecorder) CampaignUpdateBasicInfo(ctx, id, name, deta
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CampaignUpdateBasicInfo", reflect.TypeOf((*MockDBHandler)(nil).CampaignUpdateBasicInfo), ctx, id, name, detail, campaignType, serviceLevel, endHandle)
 }
 
+// CampaignUpdateCallingWindows mocks base method.
+func (m *MockDBHandler) CampaignUpdateCallingWindows(ctx context.Context, id uuid.UUID, callingWindows []campaign.CallingWindow, timezone string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CampaignUpdateCallingWindows", ctx, id, callingWindows, timezone)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CampaignUpdateCallingWindows indicates an expected call of CampaignUpdateCallingWindows.
+func (mr *MockDBHandlerMockRecorder) CampaignUpdateCallingWindows(ctx, id, callingWindows, timezone any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CampaignUpdateCallingWindows", reflect.TypeOf((*MockDBHandler)(nil).CampaignUpdateCallingWindows), ctx, id, callingWindows, timezone)
+}
+
+// CampaignUpdateDialMode mocks base method.
+func (m *MockDBHandler) CampaignUpdateDialMode(ctx context.Context, id uuid.UUID, dialMode campaign.DialMode, maxAbandonRate int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CampaignUpdateDialMode", ctx, id, dialMode, maxAbandonRate)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CampaignUpdateDialMode indicates an expected call of CampaignUpdateDialMode.
+func (mr *MockDBHandlerMockRecorder) CampaignUpdateDialMode(ctx, id, dialMode, maxAbandonRate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CampaignUpdateDialMode", reflect.TypeOf((*MockDBHandler)(nil).CampaignUpdateDialMode), ctx, id, dialMode, maxAbandonRate)
+}
+
 // CampaignUpdateEndHandle mocks base method.
 func (m *MockDBHandler) CampaignUpdateEndHandle(ctx context.Context, id uuid.UUID, endHandle campaign.EndHandle) error {
 	m.ctrl.T.Helper()
@@ -376,6 +494,21 @@ func (mr *MockDBHandlerMockRecorder) CampaigncallListByCampaignID(ctx, campaignI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CampaigncallListByCampaignID", reflect.TypeOf((*MockDBHandler)(nil).CampaigncallListByCampaignID), ctx, campaignID, token, limit)
 }
 
+// CampaigncallListByCampaignIDAndOutdialTargetIDs mocks base method.
+func (m *MockDBHandler) CampaigncallListByCampaignIDAndOutdialTargetIDs(ctx context.Context, campaignID uuid.UUID, outdialTargetIDs []uuid.UUID) ([]*campaigncall.Campaigncall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CampaigncallListByCampaignIDAndOutdialTargetIDs", ctx, campaignID, outdialTargetIDs)
+	ret0, _ := ret[0].([]*campaigncall.Campaigncall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CampaigncallListByCampaignIDAndOutdialTargetIDs indicates an expected call of CampaigncallListByCampaignIDAndOutdialTargetIDs.
+func (mr *MockDBHandlerMockRecorder) CampaigncallListByCampaignIDAndOutdialTargetIDs(ctx, campaignID, outdialTargetIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CampaigncallListByCampaignIDAndOutdialTargetIDs", reflect.TypeOf((*MockDBHandler)(nil).CampaigncallListByCampaignIDAndOutdialTargetIDs), ctx, campaignID, outdialTargetIDs)
+}
+
 // CampaigncallListByCampaignIDAndStatus mocks base method.
 func (m *MockDBHandler) CampaigncallListByCampaignIDAndStatus(ctx context.Context, campaignID uuid.UUID, status campaigncall.Status, token string, limit uint64) ([]*campaigncall.Campaigncall, error) {
 	m.ctrl.T.Helper()
@@ -435,6 +568,49 @@ func (mr *MockDBHandlerMockRecorder) CampaigncallUpdate(ctx, id, fields any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CampaigncallUpdate", reflect.TypeOf((*MockDBHandler)(nil).CampaigncallUpdate), ctx, id, fields)
 }
 
+// CampaigncallUpdateAbandoned mocks base method.
+func (m *MockDBHandler) CampaigncallUpdateAbandoned(ctx context.Context, id uuid.UUID, abandoned bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CampaigncallUpdateAbandoned", ctx, id, abandoned)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CampaigncallUpdateAbandoned indicates an expected call of CampaigncallUpdateAbandoned.
+func (mr *MockDBHandlerMockRecorder) CampaigncallUpdateAbandoned(ctx, id, abandoned any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CampaigncallUpdateAbandoned", reflect.TypeOf((*MockDBHandler)(nil).CampaigncallUpdateAbandoned), ctx, id, abandoned)
+}
+
+// CampaigncallUpdateAgentIDAndStatusFromPreviewing mocks base method.
+func (m *MockDBHandler) CampaigncallUpdateAgentIDAndStatusFromPreviewing(ctx context.Context, id, agentID uuid.UUID, status campaigncall.Status) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CampaigncallUpdateAgentIDAndStatusFromPreviewing", ctx, id, agentID, status)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CampaigncallUpdateAgentIDAndStatusFromPreviewing indicates an expected call of CampaigncallUpdateAgentIDAndStatusFromPreviewing.
+func (mr *MockDBHandlerMockRecorder) CampaigncallUpdateAgentIDAndStatusFromPreviewing(ctx, id, agentID, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CampaigncallUpdateAgentIDAndStatusFromPreviewing", reflect.TypeOf((*MockDBHandler)(nil).CampaigncallUpdateAgentIDAndStatusFromPreviewing), ctx, id, agentID, status)
+}
+
+// CampaigncallUpdateDisposition mocks base method.
+func (m *MockDBHandler) CampaigncallUpdateDisposition(ctx context.Context, id uuid.UUID, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CampaigncallUpdateDisposition", ctx, id, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CampaigncallUpdateDisposition indicates an expected call of CampaigncallUpdateDisposition.
+func (mr *MockDBHandlerMockRecorder) CampaigncallUpdateDisposition(ctx, id, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CampaigncallUpdateDisposition", reflect.TypeOf((*MockDBHandler)(nil).CampaigncallUpdateDisposition), ctx, id, arg2)
+}
+
 // CampaigncallUpdateStatus mocks base method.
 func (m *MockDBHandler) CampaigncallUpdateStatus(ctx context.Context, id uuid.UUID, status campaigncall.Status) error {
 	m.ctrl.T.Helper()
@@ -463,6 +639,121 @@ func (mr *MockDBHandlerMockRecorder) CampaigncallUpdateStatusAndResult(ctx, id,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CampaigncallUpdateStatusAndResult", reflect.TypeOf((*MockDBHandler)(nil).CampaigncallUpdateStatusAndResult), ctx, id, status, result)
 }
 
+// CampaigncallUpdateStatusDone mocks base method.
+func (m *MockDBHandler) CampaigncallUpdateStatusDone(ctx context.Context, id uuid.UUID, result campaigncall.Result) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CampaigncallUpdateStatusDone", ctx, id, result)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CampaigncallUpdateStatusDone indicates an expected call of CampaigncallUpdateStatusDone.
+func (mr *MockDBHandlerMockRecorder) CampaigncallUpdateStatusDone(ctx, id, result any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CampaigncallUpdateStatusDone", reflect.TypeOf((*MockDBHandler)(nil).CampaigncallUpdateStatusDone), ctx, id, result)
+}
+
+// CampaigncallUpdateStatusProgressing mocks base method.
+func (m *MockDBHandler) CampaigncallUpdateStatusProgressing(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CampaigncallUpdateStatusProgressing", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CampaigncallUpdateStatusProgressing indicates an expected call of CampaigncallUpdateStatusProgressing.
+func (mr *MockDBHandlerMockRecorder) CampaigncallUpdateStatusProgressing(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CampaigncallUpdateStatusProgressing", reflect.TypeOf((*MockDBHandler)(nil).CampaigncallUpdateStatusProgressing), ctx, id)
+}
+
+// DispositionCreate mocks base method.
+func (m *MockDBHandler) DispositionCreate(ctx context.Context, d *disposition.Disposition) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DispositionCreate", ctx, d)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DispositionCreate indicates an expected call of DispositionCreate.
+func (mr *MockDBHandlerMockRecorder) DispositionCreate(ctx, d any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DispositionCreate", reflect.TypeOf((*MockDBHandler)(nil).DispositionCreate), ctx, d)
+}
+
+// DispositionDelete mocks base method.
+func (m *MockDBHandler) DispositionDelete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DispositionDelete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DispositionDelete indicates an expected call of DispositionDelete.
+func (mr *MockDBHandlerMockRecorder) DispositionDelete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DispositionDelete", reflect.TypeOf((*MockDBHandler)(nil).DispositionDelete), ctx, id)
+}
+
+// DispositionGet mocks base method.
+func (m *MockDBHandler) DispositionGet(ctx context.Context, id uuid.UUID) (*disposition.Disposition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DispositionGet", ctx, id)
+	ret0, _ := ret[0].(*disposition.Disposition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DispositionGet indicates an expected call of DispositionGet.
+func (mr *MockDBHandlerMockRecorder) DispositionGet(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DispositionGet", reflect.TypeOf((*MockDBHandler)(nil).DispositionGet), ctx, id)
+}
+
+// DispositionGetByCode mocks base method.
+func (m *MockDBHandler) DispositionGetByCode(ctx context.Context, customerID uuid.UUID, code string) (*disposition.Disposition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DispositionGetByCode", ctx, customerID, code)
+	ret0, _ := ret[0].(*disposition.Disposition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DispositionGetByCode indicates an expected call of DispositionGetByCode.
+func (mr *MockDBHandlerMockRecorder) DispositionGetByCode(ctx, customerID, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DispositionGetByCode", reflect.TypeOf((*MockDBHandler)(nil).DispositionGetByCode), ctx, customerID, code)
+}
+
+// DispositionList mocks base method.
+func (m *MockDBHandler) DispositionList(ctx context.Context, token string, size uint64, filters map[disposition.Field]any) ([]*disposition.Disposition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DispositionList", ctx, token, size, filters)
+	ret0, _ := ret[0].([]*disposition.Disposition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DispositionList indicates an expected call of DispositionList.
+func (mr *MockDBHandlerMockRecorder) DispositionList(ctx, token, size, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DispositionList", reflect.TypeOf((*MockDBHandler)(nil).DispositionList), ctx, token, size, filters)
+}
+
+// DispositionUpdate mocks base method.
+func (m *MockDBHandler) DispositionUpdate(ctx context.Context, id uuid.UUID, fields map[disposition.Field]any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DispositionUpdate", ctx, id, fields)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DispositionUpdate indicates an expected call of DispositionUpdate.
+func (mr *MockDBHandlerMockRecorder) DispositionUpdate(ctx, id, fields any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DispositionUpdate", reflect.TypeOf((*MockDBHandler)(nil).DispositionUpdate), ctx, id, fields)
+}
+
 // OutplanCreate mocks base method.
 func (m *MockDBHandler) OutplanCreate(ctx context.Context, t *outplan.Outplan) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,199 @@
+package dispositionhandler
+
+import (
+	"context"
+	stderrors "errors"
+	"regexp"
+
+	cerrors "monorepo/bin-common-handler/models/errors"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	commonoutline "monorepo/bin-common-handler/models/outline"
+
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+
+	"monorepo/bin-campaign-manager/models/disposition"
+	"monorepo/bin-campaign-manager/pkg/dbhandler"
+)
+
+// regCode is the valid disposition code. the code is matched by the flow and ai tools, so keep it simple.
+var regCode = regexp.MustCompile(`^[a-z0-9_]{1,64}$`)
+
+// Create creates a new disposition
+func (h *dispositionHandler) Create(
+	ctx context.Context,
+	customerID uuid.UUID,
+	code string,
+	name string,
+	detail string,
+	retryMode disposition.RetryMode,
+) (*disposition.Disposition, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":        "Create",
+		"customer_id": customerID,
+		"code":        code,
+	})
+
+	if !regCode.MatchString(code) {
+		return nil, cerrors.InvalidArgument(
+			commonoutline.ServiceNameCampaignManager,
+			"DISPOSITION_CODE_INVALID",
+			"The disposition code must be 1 to 64 characters of lowercase letters, digits and underscores.",
+		)
+	}
+
+	retryMode, err := validateRetryMode(retryMode)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = h.db.DispositionGetByCode(ctx, customerID, code)
+	if err == nil {
+		return nil, cerrors.AlreadyExists(
+			commonoutline.ServiceNameCampaignManager,
+			"DISPOSITION_CODE_ALREADY_EXISTS",
+			"The disposition code already exists.",
+		)
+	} else if !stderrors.Is(err, dbhandler.ErrNotFound) {
+		log.Errorf("Could not check the disposition code. err: %v", err)
+		return nil, err
+	}
+
+	id := h.util.UUIDCreate()
+	d := &disposition.Disposition{
+		Identity: commonidentity.Identity{
+			ID:         id,
+			CustomerID: customerID,
+		},
+
+		Code:   code,
+		Name:   name,
+		Detail: detail,
+
+		RetryMode: retryMode,
+	}
+	log.WithField("disposition", d).Debug("Creating a new disposition.")
+
+	if err := h.db.DispositionCreate(ctx, d); err != nil {
+		log.Errorf("Could not create the disposition. err: %v", err)
+		return nil, err
+	}
+
+	res, err := h.Get(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get created disposition. err: %v", err)
+		return nil, err
+	}
+	h.notifyHandler.PublishWebhookEvent(ctx, res.CustomerID, disposition.EventTypeDispositionCreated, res)
+
+	return res, nil
+}
+
+// Get returns disposition
+func (h *dispositionHandler) Get(ctx context.Context, id uuid.UUID) (*disposition.Disposition, error) {
+	res, err := h.db.DispositionGet(ctx, id)
+	if err != nil {
+		if stderrors.Is(err, dbhandler.ErrNotFound) {
+			return nil, cerrors.NotFound(
+				commonoutline.ServiceNameCampaignManager,
+				"DISPOSITION_NOT_FOUND",
+				"The disposition was not found.",
+			).Wrap(err)
+		}
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// List returns list of dispositions with filters
+func (h *dispositionHandler) List(ctx context.Context, token string, size uint64, filters map[disposition.Field]any) ([]*disposition.Disposition, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":    "List",
+		"token":   token,
+		"size":    size,
+		"filters": filters,
+	})
+
+	res, err := h.db.DispositionList(ctx, token, size, filters)
+	if err != nil {
+		log.Errorf("Could not get dispositions. err: %v", err)
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Update updates the disposition.
+// The code is not updatable, because the campaigncalls refer to the disposition by the code.
+func (h *dispositionHandler) Update(ctx context.Context, id uuid.UUID, name string, detail string, retryMode disposition.RetryMode) (*disposition.Disposition, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":           "Update",
+		"disposition_id": id,
+	})
+
+	retryMode, err := validateRetryMode(retryMode)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := map[disposition.Field]any{
+		disposition.FieldName:      name,
+		disposition.FieldDetail:    detail,
+		disposition.FieldRetryMode: retryMode,
+	}
+	if errUpdate := h.db.DispositionUpdate(ctx, id, fields); errUpdate != nil {
+		log.Errorf("Could not update the disposition. err: %v", errUpdate)
+		return nil, errUpdate
+	}
+
+	res, err := h.Get(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get updated disposition. err: %v", err)
+		return nil, err
+	}
+	h.notifyHandler.PublishWebhookEvent(ctx, res.CustomerID, disposition.EventTypeDispositionUpdated, res)
+
+	return res, nil
+}
+
+// Delete deletes the disposition.
+// The campaigncalls keep the deleted disposition's code.
+func (h *dispositionHandler) Delete(ctx context.Context, id uuid.UUID) (*disposition.Disposition, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":           "Delete",
+		"disposition_id": id,
+	})
+
+	if errDelete := h.db.DispositionDelete(ctx, id); errDelete != nil {
+		log.Errorf("Could not delete the disposition. err: %v", errDelete)
+		return nil, errDelete
+	}
+
+	res, err := h.Get(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get deleted disposition. err: %v", err)
+		return nil, err
+	}
+	h.notifyHandler.PublishWebhookEvent(ctx, res.CustomerID, disposition.EventTypeDispositionDeleted, res)
+
+	return res, nil
+}
+
+// validateRetryMode returns the validated retry mode. empty retry mode is the default.
+func validateRetryMode(retryMode disposition.RetryMode) (disposition.RetryMode, error) {
+	switch retryMode {
+	case "":
+		return disposition.RetryModeDefault, nil
+
+	case disposition.RetryModeDefault, disposition.RetryModeRetry, disposition.RetryModeNoRetry:
+		return retryMode, nil
+
+	default:
+		return "", cerrors.InvalidArgument(
+			commonoutline.ServiceNameCampaignManager,
+			"DISPOSITION_RETRY_MODE_INVALID",
+			"The retry_mode must be one of default, retry or no_retry.",
+		)
+	}
+}
@@ -0,0 +1,302 @@
+package dispositionhandler
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/notifyhandler"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+
+	"monorepo/bin-campaign-manager/models/disposition"
+	"monorepo/bin-campaign-manager/pkg/dbhandler"
+)
+
+func Test_Create(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		customerID      uuid.UUID
+		code            string
+		dispositionName string
+		detail          string
+		retryMode       disposition.RetryMode
+
+		responseUUID        uuid.UUID
+		responseDisposition *disposition.Disposition
+
+		expectDisposition *disposition.Disposition
+	}{
+		{
+			name: "normal",
+
+			customerID:      uuid.FromStringOrNil("0d4b2f6e-9c4e-11f0-8a1c-3e7b5d9f2c01"),
+			code:            "wrong_number",
+			dispositionName: "Wrong number",
+			detail:          "The callee is not the target.",
+			retryMode:       disposition.RetryModeNoRetry,
+
+			responseUUID: uuid.FromStringOrNil("0d7e1c3a-9c4e-11f0-b4d2-6f1a8c3e5b12"),
+			responseDisposition: &disposition.Disposition{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("0d7e1c3a-9c4e-11f0-b4d2-6f1a8c3e5b12"),
+				},
+			},
+
+			expectDisposition: &disposition.Disposition{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("0d7e1c3a-9c4e-11f0-b4d2-6f1a8c3e5b12"),
+					CustomerID: uuid.FromStringOrNil("0d4b2f6e-9c4e-11f0-8a1c-3e7b5d9f2c01"),
+				},
+				Code:      "wrong_number",
+				Name:      "Wrong number",
+				Detail:    "The callee is not the target.",
+				RetryMode: disposition.RetryModeNoRetry,
+			},
+		},
+		{
+			name: "empty retry mode",
+
+			customerID: uuid.FromStringOrNil("0d4b2f6e-9c4e-11f0-8a1c-3e7b5d9f2c01"),
+			code:       "sale",
+
+			responseUUID: uuid.FromStringOrNil("0dab7e52-9c4e-11f0-9c3f-2a5d7b1e8c23"),
+			responseDisposition: &disposition.Disposition{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("0dab7e52-9c4e-11f0-9c3f-2a5d7b1e8c23"),
+				},
+			},
+
+			expectDisposition: &disposition.Disposition{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("0dab7e52-9c4e-11f0-9c3f-2a5d7b1e8c23"),
+					CustomerID: uuid.FromStringOrNil("0d4b2f6e-9c4e-11f0-8a1c-3e7b5d9f2c01"),
+				},
+				Code:      "sale",
+				RetryMode: disposition.RetryModeDefault,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+			h := dispositionHandler{
+				util:          mockUtil,
+				db:            mockDB,
+				notifyHandler: mockNotify,
+			}
+			ctx := context.Background()
+
+			mockDB.EXPECT().DispositionGetByCode(ctx, tt.customerID, tt.code).Return(nil, dbhandler.ErrNotFound)
+			mockUtil.EXPECT().UUIDCreate().Return(tt.responseUUID)
+			mockDB.EXPECT().DispositionCreate(ctx, tt.expectDisposition).Return(nil)
+			mockDB.EXPECT().DispositionGet(ctx, tt.responseUUID).Return(tt.responseDisposition, nil)
+			mockNotify.EXPECT().PublishWebhookEvent(ctx, tt.responseDisposition.CustomerID, disposition.EventTypeDispositionCreated, tt.responseDisposition)
+
+			res, err := h.Create(ctx, tt.customerID, tt.code, tt.dispositionName, tt.detail, tt.retryMode)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.responseDisposition) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.responseDisposition, res)
+			}
+		})
+	}
+}
+
+func Test_Create_error(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		customerID uuid.UUID
+		code       string
+		retryMode  disposition.RetryMode
+
+		responseGetByCode    *disposition.Disposition
+		responseGetByCodeErr error
+	}{
+		{
+			name: "invalid code",
+
+			customerID: uuid.FromStringOrNil("2f1c8a4e-9c4e-11f0-a6b3-5c2e9d1f7a34"),
+			code:       "Wrong Number",
+			retryMode:  disposition.RetryModeNoRetry,
+		},
+		{
+			name: "invalid retry mode",
+
+			customerID: uuid.FromStringOrNil("2f1c8a4e-9c4e-11f0-a6b3-5c2e9d1f7a34"),
+			code:       "wrong_number",
+			retryMode:  "never",
+		},
+		{
+			name: "code already exists",
+
+			customerID: uuid.FromStringOrNil("2f1c8a4e-9c4e-11f0-a6b3-5c2e9d1f7a34"),
+			code:       "wrong_number",
+			retryMode:  disposition.RetryModeNoRetry,
+
+			responseGetByCode: &disposition.Disposition{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("2f4b7d60-9c4e-11f0-8e1f-9a3c6b2d4e45"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+			h := dispositionHandler{
+				util:          mockUtil,
+				db:            mockDB,
+				notifyHandler: mockNotify,
+			}
+			ctx := context.Background()
+
+			if tt.responseGetByCode != nil {
+				mockDB.EXPECT().DispositionGetByCode(ctx, tt.customerID, tt.code).Return(tt.responseGetByCode, nil)
+			}
+
+			if _, err := h.Create(ctx, tt.customerID, tt.code, "", "", tt.retryMode); err == nil {
+				t.Errorf("Wrong match. expect: error, got: ok")
+			}
+		})
+	}
+}
+
+func Test_Update(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		id              uuid.UUID
+		dispositionName string
+		detail          string
+		retryMode       disposition.RetryMode
+
+		responseDisposition *disposition.Disposition
+
+		expectFields map[disposition.Field]any
+	}{
+		{
+			name: "normal",
+
+			id:              uuid.FromStringOrNil("4a6e2c18-9c4e-11f0-9d7a-1b5f3e8c2a56"),
+			dispositionName: "Callback requested",
+			detail:          "The callee asked to call back later.",
+			retryMode:       disposition.RetryModeRetry,
+
+			responseDisposition: &disposition.Disposition{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("4a6e2c18-9c4e-11f0-9d7a-1b5f3e8c2a56"),
+					CustomerID: uuid.FromStringOrNil("4a9b3f2a-9c4e-11f0-b1c8-7e2d4a6f1b67"),
+				},
+			},
+
+			expectFields: map[disposition.Field]any{
+				disposition.FieldName:      "Callback requested",
+				disposition.FieldDetail:    "The callee asked to call back later.",
+				disposition.FieldRetryMode: disposition.RetryModeRetry,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+			h := dispositionHandler{
+				db:            mockDB,
+				notifyHandler: mockNotify,
+			}
+			ctx := context.Background()
+
+			mockDB.EXPECT().DispositionUpdate(ctx, tt.id, tt.expectFields).Return(nil)
+			mockDB.EXPECT().DispositionGet(ctx, tt.id).Return(tt.responseDisposition, nil)
+			mockNotify.EXPECT().PublishWebhookEvent(ctx, tt.responseDisposition.CustomerID, disposition.EventTypeDispositionUpdated, tt.responseDisposition)
+
+			res, err := h.Update(ctx, tt.id, tt.dispositionName, tt.detail, tt.retryMode)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.responseDisposition) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.responseDisposition, res)
+			}
+		})
+	}
+}
+
+func Test_Delete(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		id uuid.UUID
+
+		responseDisposition *disposition.Disposition
+	}{
+		{
+			name: "normal",
+
+			id: uuid.FromStringOrNil("6c1d9e3a-9c4e-11f0-a2f4-4d8b1c7e3a78"),
+
+			responseDisposition: &disposition.Disposition{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("6c1d9e3a-9c4e-11f0-a2f4-4d8b1c7e3a78"),
+					CustomerID: uuid.FromStringOrNil("6c4a8f5c-9c4e-11f0-9b6e-8f3e2a5d1c89"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+			h := dispositionHandler{
+				db:            mockDB,
+				notifyHandler: mockNotify,
+			}
+			ctx := context.Background()
+
+			mockDB.EXPECT().DispositionDelete(ctx, tt.id).Return(nil)
+			mockDB.EXPECT().DispositionGet(ctx, tt.id).Return(tt.responseDisposition, nil)
+			mockNotify.EXPECT().PublishWebhookEvent(ctx, tt.responseDisposition.CustomerID, disposition.EventTypeDispositionDeleted, tt.responseDisposition)
+
+			res, err := h.Delete(ctx, tt.id)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.responseDisposition) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.responseDisposition, res)
+			}
+		})
+	}
+}
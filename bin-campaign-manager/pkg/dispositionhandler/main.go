@@ -0,0 +1,45 @@
+package dispositionhandler
+
+//go:generate mockgen -package dispositionhandler -destination ./mock_dispositionhandler.go -source main.go -build_flags=-mod=mod
+
+import (
+	"context"
+
+	"monorepo/bin-common-handler/pkg/notifyhandler"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+
+	"github.com/gofrs/uuid"
+
+	"monorepo/bin-campaign-manager/models/disposition"
+	"monorepo/bin-campaign-manager/pkg/dbhandler"
+)
+
+// dispositionHandler defines
+type dispositionHandler struct {
+	util          utilhandler.UtilHandler
+	db            dbhandler.DBHandler
+	notifyHandler notifyhandler.NotifyHandler
+}
+
+// DispositionHandler interface
+type DispositionHandler interface {
+	Create(ctx context.Context, customerID uuid.UUID, code string, name string, detail string, retryMode disposition.RetryMode) (*disposition.Disposition, error)
+	Delete(ctx context.Context, id uuid.UUID) (*disposition.Disposition, error)
+	Get(ctx context.Context, id uuid.UUID) (*disposition.Disposition, error)
+	List(ctx context.Context, token string, size uint64, filters map[disposition.Field]any) ([]*disposition.Disposition, error)
+	Update(ctx context.Context, id uuid.UUID, name string, detail string, retryMode disposition.RetryMode) (*disposition.Disposition, error)
+}
+
+// NewDispositionHandler return DispositionHandler
+func NewDispositionHandler(
+	db dbhandler.DBHandler,
+	notifyHandler notifyhandler.NotifyHandler,
+) DispositionHandler {
+	h := &dispositionHandler{
+		util:          utilhandler.NewUtilHandler(),
+		db:            db,
+		notifyHandler: notifyHandler,
+	}
+
+	return h
+}
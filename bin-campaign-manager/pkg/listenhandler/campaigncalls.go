@@ -14,6 +14,7 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"monorepo/bin-campaign-manager/models/campaigncall"
+	"monorepo/bin-campaign-manager/pkg/listenhandler/models/request"
 )
 
 // v1CampaigncallsGet handles /v1/campaigncalls GET request
@@ -152,3 +153,123 @@ func (h *listenHandler) v1CampaigncallsIDDelete(ctx context.Context, m *sock.Req
 
 	return res, nil
 }
+
+// v1CampaigncallsIDAcceptPost handles /v1/campaigncalls/{id}/accept POST request
+func (h *listenHandler) v1CampaigncallsIDAcceptPost(ctx context.Context, m *sock.Request) (*sock.Response, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":    "v1CampaigncallsIDAcceptPost",
+		"request": m,
+	})
+
+	u, err := url.Parse(m.URI)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpVals := strings.Split(u.Path, "/")
+	id := uuid.FromStringOrNil(tmpVals[3])
+
+	var req request.V1DataCampaigncallsIDAcceptPost
+	if err := json.Unmarshal(m.Data, &req); err != nil {
+		log.Errorf("Could not unmarshal the data. err: %v", err)
+		return nil, err
+	}
+
+	tmp, err := h.campaigncallHandler.Accept(ctx, id, req.AgentID)
+	if err != nil {
+		log.Errorf("Could not accept the campaigncall. err: %v", err)
+		return nil, err
+	}
+
+	data, err := json.Marshal(tmp)
+	if err != nil {
+		log.Errorf("Could not marshal the res. err: %v", err)
+		return nil, err
+	}
+
+	res := &sock.Response{
+		StatusCode: 200,
+		DataType:   "application/json",
+		Data:       data,
+	}
+
+	return res, nil
+}
+
+// v1CampaigncallsIDSkipPost handles /v1/campaigncalls/{id}/skip POST request
+func (h *listenHandler) v1CampaigncallsIDSkipPost(ctx context.Context, m *sock.Request) (*sock.Response, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":    "v1CampaigncallsIDSkipPost",
+		"request": m,
+	})
+
+	u, err := url.Parse(m.URI)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpVals := strings.Split(u.Path, "/")
+	id := uuid.FromStringOrNil(tmpVals[3])
+
+	tmp, err := h.campaigncallHandler.Skip(ctx, id)
+	if err != nil {
+		log.Errorf("Could not skip the campaigncall. err: %v", err)
+		return nil, err
+	}
+
+	data, err := json.Marshal(tmp)
+	if err != nil {
+		log.Errorf("Could not marshal the res. err: %v", err)
+		return nil, err
+	}
+
+	res := &sock.Response{
+		StatusCode: 200,
+		DataType:   "application/json",
+		Data:       data,
+	}
+
+	return res, nil
+}
+
+// v1CampaigncallsIDDispositionPut handles /v1/campaigncalls/{id}/disposition PUT request
+func (h *listenHandler) v1CampaigncallsIDDispositionPut(ctx context.Context, m *sock.Request) (*sock.Response, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":    "v1CampaigncallsIDDispositionPut",
+		"request": m,
+	})
+
+	u, err := url.Parse(m.URI)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpVals := strings.Split(u.Path, "/")
+	id := uuid.FromStringOrNil(tmpVals[3])
+
+	var req request.V1DataCampaigncallsIDDispositionPut
+	if err := json.Unmarshal(m.Data, &req); err != nil {
+		log.Errorf("Could not unmarshal the data. err: %v", err)
+		return nil, err
+	}
+
+	tmp, err := h.campaigncallHandler.UpdateDisposition(ctx, id, req.Disposition)
+	if err != nil {
+		log.Errorf("Could not update the campaigncall's disposition. err: %v", err)
+		return nil, err
+	}
+
+	data, err := json.Marshal(tmp)
+	if err != nil {
+		log.Errorf("Could not marshal the res. err: %v", err)
+		return nil, err
+	}
+
+	res := &sock.Response{
+		StatusCode: 200,
+		DataType:   "application/json",
+		Data:       data,
+	}
+
+	return res, nil
+}
@@ -55,7 +55,7 @@ func Test_v1CampaigncallsGet_campaignID(t *testing.T) {
 			&sock.Response{
 				StatusCode: 200,
 				DataType:   "application/json",
-				Data:       []byte(`[{"id":"d4852cba-c849-11ec-986c-e360df927fc5","customer_id":"00000000-0000-0000-0000-000000000000","campaign_id":"00000000-0000-0000-0000-000000000000","outplan_id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","outdial_target_id":"00000000-0000-0000-0000-000000000000","queue_id":"00000000-0000-0000-0000-000000000000","activeflow_id":"00000000-0000-0000-0000-000000000000","flow_id":"00000000-0000-0000-0000-000000000000","reference_type":"","reference_id":"00000000-0000-0000-0000-000000000000","status":"","result":"","source":null,"destination":null,"destination_index":0,"try_count":0,"tm_create":null,"tm_update":null,"tm_delete":null}]`),
+				Data:       []byte(`[{"id":"d4852cba-c849-11ec-986c-e360df927fc5","customer_id":"00000000-0000-0000-0000-000000000000","campaign_id":"00000000-0000-0000-0000-000000000000","outplan_id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","outdial_target_id":"00000000-0000-0000-0000-000000000000","queue_id":"00000000-0000-0000-0000-000000000000","agent_id":"00000000-0000-0000-0000-000000000000","activeflow_id":"00000000-0000-0000-0000-000000000000","flow_id":"00000000-0000-0000-0000-000000000000","reference_type":"","reference_id":"00000000-0000-0000-0000-000000000000","status":"","result":"","disposition":"","source":null,"destination":null,"destination_index":0,"try_count":0,"abandoned":false,"tm_callback":null,"tm_progressing":null,"tm_end":null,"tm_create":null,"tm_update":null,"tm_delete":null}]`),
 			},
 		},
 	}
@@ -126,7 +126,7 @@ func Test_v1CampaigncallsGet_customerID(t *testing.T) {
 			&sock.Response{
 				StatusCode: 200,
 				DataType:   "application/json",
-				Data:       []byte(`[{"id":"a5fa0f84-6e31-11ee-a6f2-0bb6f14c4687","customer_id":"00000000-0000-0000-0000-000000000000","campaign_id":"00000000-0000-0000-0000-000000000000","outplan_id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","outdial_target_id":"00000000-0000-0000-0000-000000000000","queue_id":"00000000-0000-0000-0000-000000000000","activeflow_id":"00000000-0000-0000-0000-000000000000","flow_id":"00000000-0000-0000-0000-000000000000","reference_type":"","reference_id":"00000000-0000-0000-0000-000000000000","status":"","result":"","source":null,"destination":null,"destination_index":0,"try_count":0,"tm_create":null,"tm_update":null,"tm_delete":null}]`),
+				Data:       []byte(`[{"id":"a5fa0f84-6e31-11ee-a6f2-0bb6f14c4687","customer_id":"00000000-0000-0000-0000-000000000000","campaign_id":"00000000-0000-0000-0000-000000000000","outplan_id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","outdial_target_id":"00000000-0000-0000-0000-000000000000","queue_id":"00000000-0000-0000-0000-000000000000","agent_id":"00000000-0000-0000-0000-000000000000","activeflow_id":"00000000-0000-0000-0000-000000000000","flow_id":"00000000-0000-0000-0000-000000000000","reference_type":"","reference_id":"00000000-0000-0000-0000-000000000000","status":"","result":"","disposition":"","source":null,"destination":null,"destination_index":0,"try_count":0,"abandoned":false,"tm_callback":null,"tm_progressing":null,"tm_end":null,"tm_create":null,"tm_update":null,"tm_delete":null}]`),
 			},
 		},
 	}
@@ -188,7 +188,7 @@ func Test_v1CampaigncallsIDGet(t *testing.T) {
 			&sock.Response{
 				StatusCode: 200,
 				DataType:   "application/json",
-				Data:       []byte(`{"id":"5f7dd038-c84a-11ec-9943-936e5cfdeb4c","customer_id":"00000000-0000-0000-0000-000000000000","campaign_id":"00000000-0000-0000-0000-000000000000","outplan_id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","outdial_target_id":"00000000-0000-0000-0000-000000000000","queue_id":"00000000-0000-0000-0000-000000000000","activeflow_id":"00000000-0000-0000-0000-000000000000","flow_id":"00000000-0000-0000-0000-000000000000","reference_type":"","reference_id":"00000000-0000-0000-0000-000000000000","status":"","result":"","source":null,"destination":null,"destination_index":0,"try_count":0,"tm_create":null,"tm_update":null,"tm_delete":null}`),
+				Data:       []byte(`{"id":"5f7dd038-c84a-11ec-9943-936e5cfdeb4c","customer_id":"00000000-0000-0000-0000-000000000000","campaign_id":"00000000-0000-0000-0000-000000000000","outplan_id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","outdial_target_id":"00000000-0000-0000-0000-000000000000","queue_id":"00000000-0000-0000-0000-000000000000","agent_id":"00000000-0000-0000-0000-000000000000","activeflow_id":"00000000-0000-0000-0000-000000000000","flow_id":"00000000-0000-0000-0000-000000000000","reference_type":"","reference_id":"00000000-0000-0000-0000-000000000000","status":"","result":"","disposition":"","source":null,"destination":null,"destination_index":0,"try_count":0,"abandoned":false,"tm_callback":null,"tm_progressing":null,"tm_end":null,"tm_create":null,"tm_update":null,"tm_delete":null}`),
 			},
 		},
 	}
@@ -250,7 +250,7 @@ func Test_v1CampaigncallsIDDelete(t *testing.T) {
 			&sock.Response{
 				StatusCode: 200,
 				DataType:   "application/json",
-				Data:       []byte(`{"id":"ef345db4-d31a-11ee-b584-2f258c86723e","customer_id":"00000000-0000-0000-0000-000000000000","campaign_id":"00000000-0000-0000-0000-000000000000","outplan_id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","outdial_target_id":"00000000-0000-0000-0000-000000000000","queue_id":"00000000-0000-0000-0000-000000000000","activeflow_id":"00000000-0000-0000-0000-000000000000","flow_id":"00000000-0000-0000-0000-000000000000","reference_type":"","reference_id":"00000000-0000-0000-0000-000000000000","status":"","result":"","source":null,"destination":null,"destination_index":0,"try_count":0,"tm_create":null,"tm_update":null,"tm_delete":null}`),
+				Data:       []byte(`{"id":"ef345db4-d31a-11ee-b584-2f258c86723e","customer_id":"00000000-0000-0000-0000-000000000000","campaign_id":"00000000-0000-0000-0000-000000000000","outplan_id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","outdial_target_id":"00000000-0000-0000-0000-000000000000","queue_id":"00000000-0000-0000-0000-000000000000","agent_id":"00000000-0000-0000-0000-000000000000","activeflow_id":"00000000-0000-0000-0000-000000000000","flow_id":"00000000-0000-0000-0000-000000000000","reference_type":"","reference_id":"00000000-0000-0000-0000-000000000000","status":"","result":"","disposition":"","source":null,"destination":null,"destination_index":0,"try_count":0,"abandoned":false,"tm_callback":null,"tm_progressing":null,"tm_end":null,"tm_create":null,"tm_update":null,"tm_delete":null}`),
 			},
 		},
 	}
@@ -280,3 +280,196 @@ func Test_v1CampaigncallsIDDelete(t *testing.T) {
 		})
 	}
 }
+
+func Test_v1CampaigncallsIDAcceptPost(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		campaigncallID uuid.UUID
+		agentID        uuid.UUID
+
+		responseCampaigncall *campaigncall.Campaigncall
+
+		expectRes *sock.Response
+	}{
+		{
+			"normal",
+			&sock.Request{
+				URI:      "/v1/campaigncalls/f1a28e90-4e2e-11f0-8c8c-4b5c6d7e8f24/accept",
+				Method:   sock.RequestMethodPost,
+				DataType: "application/json",
+				Data:     []byte(`{"agent_id":"f1d29fa1-4e2e-11f0-9d9d-5c6d7e8f9a25"}`),
+			},
+
+			uuid.FromStringOrNil("f1a28e90-4e2e-11f0-8c8c-4b5c6d7e8f24"),
+			uuid.FromStringOrNil("f1d29fa1-4e2e-11f0-9d9d-5c6d7e8f9a25"),
+
+			&campaigncall.Campaigncall{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("f1a28e90-4e2e-11f0-8c8c-4b5c6d7e8f24"),
+				},
+			},
+
+			&sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"f1a28e90-4e2e-11f0-8c8c-4b5c6d7e8f24","customer_id":"00000000-0000-0000-0000-000000000000","campaign_id":"00000000-0000-0000-0000-000000000000","outplan_id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","outdial_target_id":"00000000-0000-0000-0000-000000000000","queue_id":"00000000-0000-0000-0000-000000000000","agent_id":"00000000-0000-0000-0000-000000000000","activeflow_id":"00000000-0000-0000-0000-000000000000","flow_id":"00000000-0000-0000-0000-000000000000","reference_type":"","reference_id":"00000000-0000-0000-0000-000000000000","status":"","result":"","disposition":"","source":null,"destination":null,"destination_index":0,"try_count":0,"abandoned":false,"tm_callback":null,"tm_progressing":null,"tm_end":null,"tm_create":null,"tm_update":null,"tm_delete":null}`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockCampaigncall := campaigncallhandler.NewMockCampaigncallHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:         mockSock,
+				campaigncallHandler: mockCampaigncall,
+			}
+
+			mockCampaigncall.EXPECT().Accept(gomock.Any(), tt.campaigncallID, tt.agentID).Return(tt.responseCampaigncall, nil)
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.expectRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_v1CampaigncallsIDSkipPost(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		campaigncallID uuid.UUID
+
+		responseCampaigncall *campaigncall.Campaigncall
+
+		expectRes *sock.Response
+	}{
+		{
+			"normal",
+			&sock.Request{
+				URI:      "/v1/campaigncalls/f202b0b2-4e2e-11f0-aeae-6d7e8f9a0b26/skip",
+				Method:   sock.RequestMethodPost,
+				DataType: "application/json",
+			},
+
+			uuid.FromStringOrNil("f202b0b2-4e2e-11f0-aeae-6d7e8f9a0b26"),
+
+			&campaigncall.Campaigncall{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("f202b0b2-4e2e-11f0-aeae-6d7e8f9a0b26"),
+				},
+			},
+
+			&sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"f202b0b2-4e2e-11f0-aeae-6d7e8f9a0b26","customer_id":"00000000-0000-0000-0000-000000000000","campaign_id":"00000000-0000-0000-0000-000000000000","outplan_id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","outdial_target_id":"00000000-0000-0000-0000-000000000000","queue_id":"00000000-0000-0000-0000-000000000000","agent_id":"00000000-0000-0000-0000-000000000000","activeflow_id":"00000000-0000-0000-0000-000000000000","flow_id":"00000000-0000-0000-0000-000000000000","reference_type":"","reference_id":"00000000-0000-0000-0000-000000000000","status":"","result":"","disposition":"","source":null,"destination":null,"destination_index":0,"try_count":0,"abandoned":false,"tm_callback":null,"tm_progressing":null,"tm_end":null,"tm_create":null,"tm_update":null,"tm_delete":null}`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockCampaigncall := campaigncallhandler.NewMockCampaigncallHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:         mockSock,
+				campaigncallHandler: mockCampaigncall,
+			}
+
+			mockCampaigncall.EXPECT().Skip(gomock.Any(), tt.campaigncallID).Return(tt.responseCampaigncall, nil)
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.expectRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_v1CampaigncallsIDDispositionPut(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		campaigncallID uuid.UUID
+		disposition    string
+
+		responseCampaigncall *campaigncall.Campaigncall
+
+		expectRes *sock.Response
+	}{
+		{
+			"normal",
+			&sock.Request{
+				URI:      "/v1/campaigncalls/5f52b9f4-ad7a-11f0-9e1d-2a6b7c8d9e88/disposition",
+				Method:   sock.RequestMethodPut,
+				DataType: "application/json",
+				Data:     []byte(`{"disposition":"sale"}`),
+			},
+
+			uuid.FromStringOrNil("5f52b9f4-ad7a-11f0-9e1d-2a6b7c8d9e88"),
+			"sale",
+
+			&campaigncall.Campaigncall{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("5f52b9f4-ad7a-11f0-9e1d-2a6b7c8d9e88"),
+				},
+				Disposition: "sale",
+			},
+
+			&sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"5f52b9f4-ad7a-11f0-9e1d-2a6b7c8d9e88","customer_id":"00000000-0000-0000-0000-000000000000","campaign_id":"00000000-0000-0000-0000-000000000000","outplan_id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","outdial_target_id":"00000000-0000-0000-0000-000000000000","queue_id":"00000000-0000-0000-0000-000000000000","agent_id":"00000000-0000-0000-0000-000000000000","activeflow_id":"00000000-0000-0000-0000-000000000000","flow_id":"00000000-0000-0000-0000-000000000000","reference_type":"","reference_id":"00000000-0000-0000-0000-000000000000","status":"","result":"","disposition":"sale","source":null,"destination":null,"destination_index":0,"try_count":0,"abandoned":false,"tm_callback":null,"tm_progressing":null,"tm_end":null,"tm_create":null,"tm_update":null,"tm_delete":null}`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockCampaigncall := campaigncallhandler.NewMockCampaigncallHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:         mockSock,
+				campaigncallHandler: mockCampaigncall,
+			}
+
+			mockCampaigncall.EXPECT().UpdateDisposition(gomock.Any(), tt.campaigncallID, tt.disposition).Return(tt.responseCampaigncall, nil)
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.expectRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,217 @@
+package listenhandler
+
+import (
+	"reflect"
+	"testing"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/models/sock"
+	"monorepo/bin-common-handler/pkg/sockhandler"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+
+	"monorepo/bin-campaign-manager/models/campaignresultjob"
+	"monorepo/bin-campaign-manager/pkg/campaignresultjobhandler"
+)
+
+func Test_v1CampaignresultjobsPost(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		campaignID uuid.UUID
+
+		responseJob *campaignresultjob.CampaignResultJob
+
+		expectRes *sock.Response
+	}{
+		{
+			"normal",
+			&sock.Request{
+				URI:      "/v1/campaignresultjobs",
+				Method:   sock.RequestMethodPost,
+				DataType: "application/json",
+				Data:     []byte(`{"campaign_id":"8a1c2e34-b5d6-11f0-9c1e-2f6b7a8c9d01"}`),
+			},
+
+			uuid.FromStringOrNil("8a1c2e34-b5d6-11f0-9c1e-2f6b7a8c9d01"),
+
+			&campaignresultjob.CampaignResultJob{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("8a4d5f60-b5d6-11f0-8e2a-6b1c3d5e7f02"),
+				},
+				CampaignID: uuid.FromStringOrNil("8a1c2e34-b5d6-11f0-9c1e-2f6b7a8c9d01"),
+				Status:     campaignresultjob.StatusProcessing,
+			},
+
+			&sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"8a4d5f60-b5d6-11f0-8e2a-6b1c3d5e7f02","customer_id":"00000000-0000-0000-0000-000000000000","campaign_id":"8a1c2e34-b5d6-11f0-9c1e-2f6b7a8c9d01","status":"processing","file_id":"00000000-0000-0000-0000-000000000000","target_count":0,"row_count":0,"detail":"","tm_create":null,"tm_update":null,"tm_delete":null}`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockJob := campaignresultjobhandler.NewMockCampaignResultJobHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:              mockSock,
+				campaignResultJobHandler: mockJob,
+			}
+
+			mockJob.EXPECT().Create(gomock.Any(), tt.campaignID).Return(tt.responseJob, nil)
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.expectRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_v1CampaignresultjobsGet(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		pageToken string
+		pageSize  uint64
+		filters   map[campaignresultjob.Field]any
+
+		responseJobs []*campaignresultjob.CampaignResultJob
+
+		expectRes *sock.Response
+	}{
+		{
+			"normal",
+			&sock.Request{
+				URI:      "/v1/campaignresultjobs?page_token=2020-10-10T03:30:17.000000Z&page_size=10",
+				Method:   sock.RequestMethodGet,
+				DataType: "application/json",
+				Data:     []byte(`{"campaign_id":"8a7e9b12-b5d6-11f0-a4f3-1d2e3f4a5b03","deleted":false}`),
+			},
+
+			"2020-10-10T03:30:17.000000Z",
+			10,
+			map[campaignresultjob.Field]any{
+				campaignresultjob.FieldCampaignID: uuid.FromStringOrNil("8a7e9b12-b5d6-11f0-a4f3-1d2e3f4a5b03"),
+				campaignresultjob.FieldDeleted:    false,
+			},
+
+			[]*campaignresultjob.CampaignResultJob{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("8aaf0c34-b5d6-11f0-b7d8-4e5f6a7b8c04"),
+					},
+				},
+			},
+
+			&sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`[{"id":"8aaf0c34-b5d6-11f0-b7d8-4e5f6a7b8c04","customer_id":"00000000-0000-0000-0000-000000000000","campaign_id":"00000000-0000-0000-0000-000000000000","status":"","file_id":"00000000-0000-0000-0000-000000000000","target_count":0,"row_count":0,"detail":"","tm_create":null,"tm_update":null,"tm_delete":null}]`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockJob := campaignresultjobhandler.NewMockCampaignResultJobHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:              mockSock,
+				campaignResultJobHandler: mockJob,
+			}
+
+			mockJob.EXPECT().List(gomock.Any(), tt.pageToken, tt.pageSize, tt.filters).Return(tt.responseJobs, nil)
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.expectRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_v1CampaignresultjobsIDGet(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		id uuid.UUID
+
+		responseJob *campaignresultjob.CampaignResultJob
+
+		expectRes *sock.Response
+	}{
+		{
+			"normal",
+			&sock.Request{
+				URI:      "/v1/campaignresultjobs/8ae01d56-b5d6-11f0-9a0b-7c8d9e0f1a05",
+				Method:   sock.RequestMethodGet,
+				DataType: "application/json",
+			},
+
+			uuid.FromStringOrNil("8ae01d56-b5d6-11f0-9a0b-7c8d9e0f1a05"),
+
+			&campaignresultjob.CampaignResultJob{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("8ae01d56-b5d6-11f0-9a0b-7c8d9e0f1a05"),
+				},
+				Status: campaignresultjob.StatusDone,
+				FileID: uuid.FromStringOrNil("8b112e78-b5d6-11f0-8c2d-0a1b2c3d4e06"),
+			},
+
+			&sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"8ae01d56-b5d6-11f0-9a0b-7c8d9e0f1a05","customer_id":"00000000-0000-0000-0000-000000000000","campaign_id":"00000000-0000-0000-0000-000000000000","status":"done","file_id":"8b112e78-b5d6-11f0-8c2d-0a1b2c3d4e06","target_count":0,"row_count":0,"detail":"","tm_create":null,"tm_update":null,"tm_delete":null}`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockJob := campaignresultjobhandler.NewMockCampaignResultJobHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:              mockSock,
+				campaignResultJobHandler: mockJob,
+			}
+
+			mockJob.EXPECT().Get(gomock.Any(), tt.id).Return(tt.responseJob, nil)
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.expectRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
@@ -364,6 +364,90 @@ func (h *listenHandler) v1CampaignsIDServiceLevelPut(ctx context.Context, m *soc
 	return res, nil
 }
 
+// v1CampaignsIDDialModePut handles /v1/campaigns/{id}/dial_mode PUT request
+func (h *listenHandler) v1CampaignsIDDialModePut(ctx context.Context, m *sock.Request) (*sock.Response, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":    "v1CampaignsIDDialModePut",
+		"request": m,
+	})
+
+	uriItems := strings.Split(m.URI, "/")
+	if len(uriItems) < 4 {
+		return simpleResponse(400), nil
+	}
+
+	id := uuid.FromStringOrNil(uriItems[3])
+
+	var req request.V1DataCampaignsIDDialModePut
+	if err := json.Unmarshal(m.Data, &req); err != nil {
+		log.Errorf("Could not marshal the data. err: %v", err)
+		return nil, err
+	}
+
+	// update
+	tmp, err := h.campaignHandler.UpdateDialMode(ctx, id, req.DialMode, req.MaxAbandonRate)
+	if err != nil {
+		log.Errorf("Could not update the campaign dial_mode. err: %v", err)
+		return nil, err
+	}
+
+	data, err := json.Marshal(tmp)
+	if err != nil {
+		log.Errorf("Could not marshal the res. err: %v", err)
+		return nil, err
+	}
+
+	res := &sock.Response{
+		StatusCode: 200,
+		DataType:   "application/json",
+		Data:       data,
+	}
+
+	return res, nil
+}
+
+// v1CampaignsIDCallingWindowsPut handles /v1/campaigns/{id}/calling_windows PUT request
+func (h *listenHandler) v1CampaignsIDCallingWindowsPut(ctx context.Context, m *sock.Request) (*sock.Response, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":    "v1CampaignsIDCallingWindowsPut",
+		"request": m,
+	})
+
+	uriItems := strings.Split(m.URI, "/")
+	if len(uriItems) < 4 {
+		return simpleResponse(400), nil
+	}
+
+	id := uuid.FromStringOrNil(uriItems[3])
+
+	var req request.V1DataCampaignsIDCallingWindowsPut
+	if err := json.Unmarshal(m.Data, &req); err != nil {
+		log.Errorf("Could not marshal the data. err: %v", err)
+		return nil, err
+	}
+
+	// update
+	tmp, err := h.campaignHandler.UpdateCallingWindows(ctx, id, req.CallingWindows, req.Timezone)
+	if err != nil {
+		log.Errorf("Could not update the campaign calling_windows. err: %v", err)
+		return nil, err
+	}
+
+	data, err := json.Marshal(tmp)
+	if err != nil {
+		log.Errorf("Could not marshal the res. err: %v", err)
+		return nil, err
+	}
+
+	res := &sock.Response{
+		StatusCode: 200,
+		DataType:   "application/json",
+		Data:       data,
+	}
+
+	return res, nil
+}
+
 // v1CampaignsIDActionsPut handles /v1/campaigns/{id}/actions PUT request
 func (h *listenHandler) v1CampaignsIDActionsPut(ctx context.Context, m *sock.Request) (*sock.Response, error) {
 	log := logrus.WithFields(logrus.Fields{
@@ -495,3 +579,44 @@ func (h *listenHandler) v1CampaignsIDNextCampaignIDPut(ctx context.Context, m *s
 
 	return res, nil
 }
+
+// v1CampaignsIDResultsGet handles /v1/campaigns/{id}/results GET request
+func (h *listenHandler) v1CampaignsIDResultsGet(ctx context.Context, m *sock.Request) (*sock.Response, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":    "v1CampaignsIDResultsGet",
+		"request": m,
+	})
+
+	u, err := url.Parse(m.URI)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpVals := strings.Split(u.Path, "/")
+	id := uuid.FromStringOrNil(tmpVals[3])
+
+	// parse the pagination params from URI
+	tmpSize, _ := strconv.Atoi(u.Query().Get(PageSize))
+	pageSize := uint64(tmpSize)
+	pageToken := u.Query().Get(PageToken)
+
+	tmp, err := h.campaignHandler.ResultList(ctx, id, pageToken, pageSize)
+	if err != nil {
+		log.Errorf("Could not get campaign results. err: %v", err)
+		return nil, err
+	}
+
+	data, err := json.Marshal(tmp)
+	if err != nil {
+		log.Errorf("Could not marshal the res. err: %v", err)
+		return nil, err
+	}
+
+	res := &sock.Response{
+		StatusCode: 200,
+		DataType:   "application/json",
+		Data:       data,
+	}
+
+	return res, nil
+}
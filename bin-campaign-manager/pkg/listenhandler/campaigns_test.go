@@ -14,6 +14,7 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"monorepo/bin-campaign-manager/models/campaign"
+	"monorepo/bin-campaign-manager/models/campaignresult"
 	"monorepo/bin-campaign-manager/pkg/campaignhandler"
 )
 
@@ -46,7 +47,7 @@ func Test_v1CampaignsPost(t *testing.T) {
 				URI:      "/v1/campaigns",
 				Method:   sock.RequestMethodPost,
 				DataType: "application/json",
-				Data:     []byte(`{"id":"3653adb2-c454-11ec-8c9f-7bcd6924ee69","customer_id":"60f76478-c454-11ec-969b-13906a5eea9d","type":"call","name":"test name","detail":"test detail","actions":[{"type":"answer"}],"service_level":100,"end_handle":"stop","outplan_id":"612a9186-c454-11ec-8afa-4327f7ed3a4e","outdial_id":"615dce2a-c454-11ec-aefb-a32f5d072685","queue_id":"61893ca4-c454-11ec-a2a2-3f4cd3113b05","next_campaign_id":"61b3c7f8-c454-11ec-9d0e-07f30d37566d"}`),
+				Data:     []byte(`{"id":"3653adb2-c454-11ec-8c9f-7bcd6924ee69","customer_id":"60f76478-c454-11ec-969b-13906a5eea9d","type":"call","name":"test name","detail":"test detail","actions":[{"type":"answer"}],"service_level":100,"end_handle":"stop","dial_mode":"","max_abandon_rate":0,"outplan_id":"612a9186-c454-11ec-8afa-4327f7ed3a4e","outdial_id":"615dce2a-c454-11ec-aefb-a32f5d072685","queue_id":"61893ca4-c454-11ec-a2a2-3f4cd3113b05","next_campaign_id":"61b3c7f8-c454-11ec-9d0e-07f30d37566d"}`),
 			},
 
 			uuid.FromStringOrNil("3653adb2-c454-11ec-8c9f-7bcd6924ee69"),
@@ -76,7 +77,7 @@ func Test_v1CampaignsPost(t *testing.T) {
 			&sock.Response{
 				StatusCode: 200,
 				DataType:   "application/json",
-				Data:       []byte(`{"id":"3653adb2-c454-11ec-8c9f-7bcd6924ee69","customer_id":"00000000-0000-0000-0000-000000000000","type":"","execute":"","name":"","detail":"","status":"","service_level":0,"end_handle":"","flow_id":"00000000-0000-0000-0000-000000000000","actions":null,"outplan_id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","queue_id":"00000000-0000-0000-0000-000000000000","next_campaign_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`),
+				Data:       []byte(`{"id":"3653adb2-c454-11ec-8c9f-7bcd6924ee69","customer_id":"00000000-0000-0000-0000-000000000000","type":"","execute":"","name":"","detail":"","status":"","service_level":0,"end_handle":"","dial_mode":"","max_abandon_rate":0,"calling_windows":null,"timezone":"","flow_id":"00000000-0000-0000-0000-000000000000","actions":null,"outplan_id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","queue_id":"00000000-0000-0000-0000-000000000000","next_campaign_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`),
 			},
 		},
 	}
@@ -147,7 +148,7 @@ func Test_v1CampaignsGet(t *testing.T) {
 			&sock.Response{
 				StatusCode: 200,
 				DataType:   "application/json",
-				Data:       []byte(`[{"id":"3653adb2-c454-11ec-8c9f-7bcd6924ee69","customer_id":"00000000-0000-0000-0000-000000000000","type":"","execute":"","name":"","detail":"","status":"","service_level":0,"end_handle":"","flow_id":"00000000-0000-0000-0000-000000000000","actions":null,"outplan_id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","queue_id":"00000000-0000-0000-0000-000000000000","next_campaign_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}]`),
+				Data:       []byte(`[{"id":"3653adb2-c454-11ec-8c9f-7bcd6924ee69","customer_id":"00000000-0000-0000-0000-000000000000","type":"","execute":"","name":"","detail":"","status":"","service_level":0,"end_handle":"","dial_mode":"","max_abandon_rate":0,"calling_windows":null,"timezone":"","flow_id":"00000000-0000-0000-0000-000000000000","actions":null,"outplan_id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","queue_id":"00000000-0000-0000-0000-000000000000","next_campaign_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}]`),
 			},
 		},
 	}
@@ -209,7 +210,7 @@ func Test_v1CampaignsIDGet(t *testing.T) {
 			&sock.Response{
 				StatusCode: 200,
 				DataType:   "application/json",
-				Data:       []byte(`{"id":"edb1a7ca-c459-11ec-b591-733bb55d7160","customer_id":"00000000-0000-0000-0000-000000000000","type":"","execute":"","name":"","detail":"","status":"","service_level":0,"end_handle":"","flow_id":"00000000-0000-0000-0000-000000000000","actions":null,"outplan_id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","queue_id":"00000000-0000-0000-0000-000000000000","next_campaign_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`),
+				Data:       []byte(`{"id":"edb1a7ca-c459-11ec-b591-733bb55d7160","customer_id":"00000000-0000-0000-0000-000000000000","type":"","execute":"","name":"","detail":"","status":"","service_level":0,"end_handle":"","dial_mode":"","max_abandon_rate":0,"calling_windows":null,"timezone":"","flow_id":"00000000-0000-0000-0000-000000000000","actions":null,"outplan_id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","queue_id":"00000000-0000-0000-0000-000000000000","next_campaign_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`),
 			},
 		},
 	}
@@ -271,7 +272,7 @@ func Test_v1CampaignsIDDelete(t *testing.T) {
 			&sock.Response{
 				StatusCode: 200,
 				DataType:   "application/json",
-				Data:       []byte(`{"id":"5a797d38-c45a-11ec-95be-bb5e6cfb1d96","customer_id":"00000000-0000-0000-0000-000000000000","type":"","execute":"","name":"","detail":"","status":"","service_level":0,"end_handle":"","flow_id":"00000000-0000-0000-0000-000000000000","actions":null,"outplan_id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","queue_id":"00000000-0000-0000-0000-000000000000","next_campaign_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`),
+				Data:       []byte(`{"id":"5a797d38-c45a-11ec-95be-bb5e6cfb1d96","customer_id":"00000000-0000-0000-0000-000000000000","type":"","execute":"","name":"","detail":"","status":"","service_level":0,"end_handle":"","dial_mode":"","max_abandon_rate":0,"calling_windows":null,"timezone":"","flow_id":"00000000-0000-0000-0000-000000000000","actions":null,"outplan_id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","queue_id":"00000000-0000-0000-0000-000000000000","next_campaign_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`),
 			},
 		},
 	}
@@ -344,7 +345,7 @@ func Test_v1CampaignsIDPut(t *testing.T) {
 			expectRes: &sock.Response{
 				StatusCode: 200,
 				DataType:   "application/json",
-				Data:       []byte(`{"id":"40b95d6c-c466-11ec-88ac-734fd1ce5539","customer_id":"00000000-0000-0000-0000-000000000000","type":"","execute":"","name":"","detail":"","status":"","service_level":0,"end_handle":"","flow_id":"00000000-0000-0000-0000-000000000000","actions":null,"outplan_id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","queue_id":"00000000-0000-0000-0000-000000000000","next_campaign_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`),
+				Data:       []byte(`{"id":"40b95d6c-c466-11ec-88ac-734fd1ce5539","customer_id":"00000000-0000-0000-0000-000000000000","type":"","execute":"","name":"","detail":"","status":"","service_level":0,"end_handle":"","dial_mode":"","max_abandon_rate":0,"calling_windows":null,"timezone":"","flow_id":"00000000-0000-0000-0000-000000000000","actions":null,"outplan_id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","queue_id":"00000000-0000-0000-0000-000000000000","next_campaign_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`),
 			},
 		},
 	}
@@ -470,7 +471,7 @@ func Test_v1CampaignsIDStatus(t *testing.T) {
 			&sock.Response{
 				StatusCode: 200,
 				DataType:   "application/json",
-				Data:       []byte(`{"id":"088b70c0-c45b-11ec-b93c-87920bba8787","customer_id":"00000000-0000-0000-0000-000000000000","type":"","execute":"","name":"","detail":"","status":"","service_level":0,"end_handle":"","flow_id":"00000000-0000-0000-0000-000000000000","actions":null,"outplan_id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","queue_id":"00000000-0000-0000-0000-000000000000","next_campaign_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`),
+				Data:       []byte(`{"id":"088b70c0-c45b-11ec-b93c-87920bba8787","customer_id":"00000000-0000-0000-0000-000000000000","type":"","execute":"","name":"","detail":"","status":"","service_level":0,"end_handle":"","dial_mode":"","max_abandon_rate":0,"calling_windows":null,"timezone":"","flow_id":"00000000-0000-0000-0000-000000000000","actions":null,"outplan_id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","queue_id":"00000000-0000-0000-0000-000000000000","next_campaign_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`),
 			},
 		},
 		{
@@ -494,7 +495,7 @@ func Test_v1CampaignsIDStatus(t *testing.T) {
 			&sock.Response{
 				StatusCode: 200,
 				DataType:   "application/json",
-				Data:       []byte(`{"id":"d26b0c58-c45a-11ec-b42d-3b261e615304","customer_id":"00000000-0000-0000-0000-000000000000","type":"","execute":"","name":"","detail":"","status":"","service_level":0,"end_handle":"","flow_id":"00000000-0000-0000-0000-000000000000","actions":null,"outplan_id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","queue_id":"00000000-0000-0000-0000-000000000000","next_campaign_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`),
+				Data:       []byte(`{"id":"d26b0c58-c45a-11ec-b42d-3b261e615304","customer_id":"00000000-0000-0000-0000-000000000000","type":"","execute":"","name":"","detail":"","status":"","service_level":0,"end_handle":"","dial_mode":"","max_abandon_rate":0,"calling_windows":null,"timezone":"","flow_id":"00000000-0000-0000-0000-000000000000","actions":null,"outplan_id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","queue_id":"00000000-0000-0000-0000-000000000000","next_campaign_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`),
 			},
 		},
 	}
@@ -559,7 +560,7 @@ func Test_v1CampaignsIDServiceLevelPut(t *testing.T) {
 			&sock.Response{
 				StatusCode: 200,
 				DataType:   "application/json",
-				Data:       []byte(`{"id":"088b70c0-c45b-11ec-b93c-87920bba8787","customer_id":"00000000-0000-0000-0000-000000000000","type":"","execute":"","name":"","detail":"","status":"","service_level":0,"end_handle":"","flow_id":"00000000-0000-0000-0000-000000000000","actions":null,"outplan_id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","queue_id":"00000000-0000-0000-0000-000000000000","next_campaign_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`),
+				Data:       []byte(`{"id":"088b70c0-c45b-11ec-b93c-87920bba8787","customer_id":"00000000-0000-0000-0000-000000000000","type":"","execute":"","name":"","detail":"","status":"","service_level":0,"end_handle":"","dial_mode":"","max_abandon_rate":0,"calling_windows":null,"timezone":"","flow_id":"00000000-0000-0000-0000-000000000000","actions":null,"outplan_id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","queue_id":"00000000-0000-0000-0000-000000000000","next_campaign_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`),
 			},
 		},
 	}
@@ -590,6 +591,146 @@ func Test_v1CampaignsIDServiceLevelPut(t *testing.T) {
 	}
 }
 
+func Test_v1CampaignsIDDialModePut(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		campaignID     uuid.UUID
+		dialMode       campaign.DialMode
+		maxAbandonRate int
+
+		responseCampaign *campaign.Campaign
+
+		expectRes *sock.Response
+	}{
+		{
+			"normal",
+			&sock.Request{
+				URI:      "/v1/campaigns/02132c3d-4e2f-11f0-bfbf-7e8f9a0b1c27/dial_mode",
+				Method:   sock.RequestMethodPut,
+				DataType: "application/json",
+				Data:     []byte(`{"dial_mode":"predictive","max_abandon_rate":5}`),
+			},
+
+			uuid.FromStringOrNil("02132c3d-4e2f-11f0-bfbf-7e8f9a0b1c27"),
+			campaign.DialModePredictive,
+			5,
+
+			&campaign.Campaign{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("02132c3d-4e2f-11f0-bfbf-7e8f9a0b1c27"),
+				},
+			},
+
+			&sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"02132c3d-4e2f-11f0-bfbf-7e8f9a0b1c27","customer_id":"00000000-0000-0000-0000-000000000000","type":"","execute":"","name":"","detail":"","status":"","service_level":0,"end_handle":"","dial_mode":"","max_abandon_rate":0,"calling_windows":null,"timezone":"","flow_id":"00000000-0000-0000-0000-000000000000","actions":null,"outplan_id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","queue_id":"00000000-0000-0000-0000-000000000000","next_campaign_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockCampaign := campaignhandler.NewMockCampaignHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:     mockSock,
+				campaignHandler: mockCampaign,
+			}
+
+			mockCampaign.EXPECT().UpdateDialMode(gomock.Any(), tt.campaignID, tt.dialMode, tt.maxAbandonRate).Return(tt.responseCampaign, nil)
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.expectRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_v1CampaignsIDCallingWindowsPut(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		campaignID     uuid.UUID
+		callingWindows []campaign.CallingWindow
+		timezone       string
+
+		responseCampaign *campaign.Campaign
+
+		expectRes *sock.Response
+	}{
+		{
+			"normal",
+			&sock.Request{
+				URI:      "/v1/campaigns/e3b5a1c4-adbb-11f0-9c2d-6a1f4e8b3d01/calling_windows",
+				Method:   sock.RequestMethodPut,
+				DataType: "application/json",
+				Data:     []byte(`{"calling_windows":[{"days":["monday","friday"],"start":"09:00","end":"21:00"}],"timezone":"America/New_York"}`),
+			},
+
+			uuid.FromStringOrNil("e3b5a1c4-adbb-11f0-9c2d-6a1f4e8b3d01"),
+			[]campaign.CallingWindow{
+				{
+					Days:  []campaign.Day{campaign.DayMonday, campaign.DayFriday},
+					Start: "09:00",
+					End:   "21:00",
+				},
+			},
+			"America/New_York",
+
+			&campaign.Campaign{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("e3b5a1c4-adbb-11f0-9c2d-6a1f4e8b3d01"),
+				},
+			},
+
+			&sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"e3b5a1c4-adbb-11f0-9c2d-6a1f4e8b3d01","customer_id":"00000000-0000-0000-0000-000000000000","type":"","execute":"","name":"","detail":"","status":"","service_level":0,"end_handle":"","dial_mode":"","max_abandon_rate":0,"calling_windows":null,"timezone":"","flow_id":"00000000-0000-0000-0000-000000000000","actions":null,"outplan_id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","queue_id":"00000000-0000-0000-0000-000000000000","next_campaign_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockCampaign := campaignhandler.NewMockCampaignHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:     mockSock,
+				campaignHandler: mockCampaign,
+			}
+
+			mockCampaign.EXPECT().UpdateCallingWindows(gomock.Any(), tt.campaignID, tt.callingWindows, tt.timezone).Return(tt.responseCampaign, nil)
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.expectRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
 func Test_v1CampaignsIDActionsPut(t *testing.T) {
 
 	tests := []struct {
@@ -628,7 +769,7 @@ func Test_v1CampaignsIDActionsPut(t *testing.T) {
 			&sock.Response{
 				StatusCode: 200,
 				DataType:   "application/json",
-				Data:       []byte(`{"id":"045cdfc4-c45c-11ec-915c-5b6e9c81d305","customer_id":"00000000-0000-0000-0000-000000000000","type":"","execute":"","name":"","detail":"","status":"","service_level":0,"end_handle":"","flow_id":"00000000-0000-0000-0000-000000000000","actions":null,"outplan_id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","queue_id":"00000000-0000-0000-0000-000000000000","next_campaign_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`),
+				Data:       []byte(`{"id":"045cdfc4-c45c-11ec-915c-5b6e9c81d305","customer_id":"00000000-0000-0000-0000-000000000000","type":"","execute":"","name":"","detail":"","status":"","service_level":0,"end_handle":"","dial_mode":"","max_abandon_rate":0,"calling_windows":null,"timezone":"","flow_id":"00000000-0000-0000-0000-000000000000","actions":null,"outplan_id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","queue_id":"00000000-0000-0000-0000-000000000000","next_campaign_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`),
 			},
 		},
 	}
@@ -699,7 +840,7 @@ func Test_v1CampaignsIDResourceInfoPut(t *testing.T) {
 			expectRes: &sock.Response{
 				StatusCode: 200,
 				DataType:   "application/json",
-				Data:       []byte(`{"id":"e74223b2-c6af-11ec-9f40-1f88a3e01636","customer_id":"00000000-0000-0000-0000-000000000000","type":"","execute":"","name":"","detail":"","status":"","service_level":0,"end_handle":"","flow_id":"00000000-0000-0000-0000-000000000000","actions":null,"outplan_id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","queue_id":"00000000-0000-0000-0000-000000000000","next_campaign_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`),
+				Data:       []byte(`{"id":"e74223b2-c6af-11ec-9f40-1f88a3e01636","customer_id":"00000000-0000-0000-0000-000000000000","type":"","execute":"","name":"","detail":"","status":"","service_level":0,"end_handle":"","dial_mode":"","max_abandon_rate":0,"calling_windows":null,"timezone":"","flow_id":"00000000-0000-0000-0000-000000000000","actions":null,"outplan_id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","queue_id":"00000000-0000-0000-0000-000000000000","next_campaign_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`),
 			},
 		},
 	}
@@ -764,7 +905,7 @@ func Test_v1CampaignsIDNextCampaignIDPut(t *testing.T) {
 			&sock.Response{
 				StatusCode: 200,
 				DataType:   "application/json",
-				Data:       []byte(`{"id":"e1f5109e-c6b0-11ec-a87d-1f8fe2380e97","customer_id":"00000000-0000-0000-0000-000000000000","type":"","execute":"","name":"","detail":"","status":"","service_level":0,"end_handle":"","flow_id":"00000000-0000-0000-0000-000000000000","actions":null,"outplan_id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","queue_id":"00000000-0000-0000-0000-000000000000","next_campaign_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`),
+				Data:       []byte(`{"id":"e1f5109e-c6b0-11ec-a87d-1f8fe2380e97","customer_id":"00000000-0000-0000-0000-000000000000","type":"","execute":"","name":"","detail":"","status":"","service_level":0,"end_handle":"","dial_mode":"","max_abandon_rate":0,"calling_windows":null,"timezone":"","flow_id":"00000000-0000-0000-0000-000000000000","actions":null,"outplan_id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","queue_id":"00000000-0000-0000-0000-000000000000","next_campaign_id":"00000000-0000-0000-0000-000000000000","tm_create":null,"tm_update":null,"tm_delete":null}`),
 			},
 		},
 	}
@@ -794,3 +935,78 @@ func Test_v1CampaignsIDNextCampaignIDPut(t *testing.T) {
 		})
 	}
 }
+
+func Test_v1CampaignsIDResultsGet(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		campaignID uuid.UUID
+		pageToken  string
+		pageSize   uint64
+
+		responseResults []*campaignresult.CampaignResult
+
+		expectRes *sock.Response
+	}{
+		{
+			"normal",
+			&sock.Request{
+				URI:      "/v1/campaigns/5f81c6a8-ad7a-11f0-b2f3-4c7d8e9f0a99/results?page_token=2020-10-10T03:30:17.000000Z&page_size=10",
+				Method:   sock.RequestMethodGet,
+				DataType: "application/json",
+			},
+
+			uuid.FromStringOrNil("5f81c6a8-ad7a-11f0-b2f3-4c7d8e9f0a99"),
+			"2020-10-10T03:30:17.000000Z",
+			10,
+
+			[]*campaignresult.CampaignResult{
+				{
+					CampaignID:      uuid.FromStringOrNil("5f81c6a8-ad7a-11f0-b2f3-4c7d8e9f0a99"),
+					OutdialTargetID: uuid.FromStringOrNil("5fb0d2ba-ad7a-11f0-8a61-6e8f9a0b1caa"),
+					Attempts: []campaignresult.Attempt{
+						{
+							CampaigncallID: uuid.FromStringOrNil("5fdfe0cc-ad7a-11f0-9f2a-8a9b0c1d2ebb"),
+							Disposition:    "sale",
+							Duration:       30000,
+							TalkDuration:   25000,
+						},
+					},
+				},
+			},
+
+			&sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`[{"campaign_id":"5f81c6a8-ad7a-11f0-b2f3-4c7d8e9f0a99","outdial_target_id":"5fb0d2ba-ad7a-11f0-8a61-6e8f9a0b1caa","name":"","status":"","attempts":[{"campaigncall_id":"5fdfe0cc-ad7a-11f0-9f2a-8a9b0c1d2ebb","destination":null,"destination_index":0,"try_count":0,"status":"","result":"","disposition":"sale","abandoned":false,"duration":30000,"talk_duration":25000,"tm_create":null,"tm_progressing":null,"tm_end":null}],"tm_create":null}]`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockCampaign := campaignhandler.NewMockCampaignHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:     mockSock,
+				campaignHandler: mockCampaign,
+			}
+
+			mockCampaign.EXPECT().ResultList(gomock.Any(), tt.campaignID, tt.pageToken, tt.pageSize).Return(tt.responseResults, nil)
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.expectRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,352 @@
+package listenhandler
+
+import (
+	"reflect"
+	"testing"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/models/sock"
+	"monorepo/bin-common-handler/pkg/sockhandler"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+
+	"monorepo/bin-campaign-manager/models/disposition"
+	"monorepo/bin-campaign-manager/pkg/dispositionhandler"
+)
+
+func Test_v1DispositionsPost(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		customerID      uuid.UUID
+		code            string
+		dispositionName string
+		detail          string
+		retryMode       disposition.RetryMode
+
+		responseDisposition *disposition.Disposition
+
+		expectRes *sock.Response
+	}{
+		{
+			"normal",
+			&sock.Request{
+				URI:      "/v1/dispositions",
+				Method:   sock.RequestMethodPost,
+				DataType: "application/json",
+				Data:     []byte(`{"customer_id":"5e0c1a6e-ad7a-11f0-9b53-5b0fbb3c2a11","code":"wrong_number","name":"Wrong number","detail":"test detail","retry_mode":"no_retry"}`),
+			},
+
+			uuid.FromStringOrNil("5e0c1a6e-ad7a-11f0-9b53-5b0fbb3c2a11"),
+			"wrong_number",
+			"Wrong number",
+			"test detail",
+			disposition.RetryModeNoRetry,
+
+			&disposition.Disposition{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("5e3b6c4a-ad7a-11f0-a3c1-8f2d4f1b5e22"),
+				},
+			},
+
+			&sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"5e3b6c4a-ad7a-11f0-a3c1-8f2d4f1b5e22","customer_id":"00000000-0000-0000-0000-000000000000","code":"","name":"","detail":"","retry_mode":"","tm_create":null,"tm_update":null,"tm_delete":null}`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockDisposition := dispositionhandler.NewMockDispositionHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:        mockSock,
+				dispositionHandler: mockDisposition,
+			}
+
+			mockDisposition.EXPECT().Create(gomock.Any(), tt.customerID, tt.code, tt.dispositionName, tt.detail, tt.retryMode).Return(tt.responseDisposition, nil)
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.expectRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_v1DispositionsGet(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		pageToken string
+		pageSize  uint64
+		filters   map[disposition.Field]any
+
+		responseDispositions []*disposition.Disposition
+
+		expectRes *sock.Response
+	}{
+		{
+			"normal",
+			&sock.Request{
+				URI:      "/v1/dispositions?page_token=2020-10-10T03:30:17.000000Z&page_size=10",
+				Method:   sock.RequestMethodGet,
+				DataType: "application/json",
+				Data:     []byte(`{"customer_id":"5e6a1d2c-ad7a-11f0-8f0e-3b7c9d1a2f33","deleted":false}`),
+			},
+
+			"2020-10-10T03:30:17.000000Z",
+			10,
+			map[disposition.Field]any{
+				disposition.FieldCustomerID: uuid.FromStringOrNil("5e6a1d2c-ad7a-11f0-8f0e-3b7c9d1a2f33"),
+				disposition.FieldDeleted:    false,
+			},
+
+			[]*disposition.Disposition{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("5e98d0a6-ad7a-11f0-b6a4-a71e0c3d4b44"),
+					},
+				},
+			},
+
+			&sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`[{"id":"5e98d0a6-ad7a-11f0-b6a4-a71e0c3d4b44","customer_id":"00000000-0000-0000-0000-000000000000","code":"","name":"","detail":"","retry_mode":"","tm_create":null,"tm_update":null,"tm_delete":null}]`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockDisposition := dispositionhandler.NewMockDispositionHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:        mockSock,
+				dispositionHandler: mockDisposition,
+			}
+
+			mockDisposition.EXPECT().List(gomock.Any(), tt.pageToken, tt.pageSize, tt.filters).Return(tt.responseDispositions, nil)
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.expectRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_v1DispositionsIDGet(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		dispositionID uuid.UUID
+
+		responseDisposition *disposition.Disposition
+
+		expectRes *sock.Response
+	}{
+		{
+			"normal",
+			&sock.Request{
+				URI:      "/v1/dispositions/5ec7a4d8-ad7a-11f0-9d0c-e7f1a2b3c455",
+				Method:   sock.RequestMethodGet,
+				DataType: "application/json",
+			},
+
+			uuid.FromStringOrNil("5ec7a4d8-ad7a-11f0-9d0c-e7f1a2b3c455"),
+
+			&disposition.Disposition{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("5ec7a4d8-ad7a-11f0-9d0c-e7f1a2b3c455"),
+				},
+			},
+
+			&sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"5ec7a4d8-ad7a-11f0-9d0c-e7f1a2b3c455","customer_id":"00000000-0000-0000-0000-000000000000","code":"","name":"","detail":"","retry_mode":"","tm_create":null,"tm_update":null,"tm_delete":null}`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockDisposition := dispositionhandler.NewMockDispositionHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:        mockSock,
+				dispositionHandler: mockDisposition,
+			}
+
+			mockDisposition.EXPECT().Get(gomock.Any(), tt.dispositionID).Return(tt.responseDisposition, nil)
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.expectRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_v1DispositionsIDPut(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		dispositionID   uuid.UUID
+		dispositionName string
+		detail          string
+		retryMode       disposition.RetryMode
+
+		responseDisposition *disposition.Disposition
+
+		expectRes *sock.Response
+	}{
+		{
+			"normal",
+			&sock.Request{
+				URI:      "/v1/dispositions/5ef5c1b2-ad7a-11f0-a0b7-0b3d5e6f7a66",
+				Method:   sock.RequestMethodPut,
+				DataType: "application/json",
+				Data:     []byte(`{"name":"Callback requested","detail":"update detail","retry_mode":"retry"}`),
+			},
+
+			uuid.FromStringOrNil("5ef5c1b2-ad7a-11f0-a0b7-0b3d5e6f7a66"),
+			"Callback requested",
+			"update detail",
+			disposition.RetryModeRetry,
+
+			&disposition.Disposition{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("5ef5c1b2-ad7a-11f0-a0b7-0b3d5e6f7a66"),
+				},
+			},
+
+			&sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"5ef5c1b2-ad7a-11f0-a0b7-0b3d5e6f7a66","customer_id":"00000000-0000-0000-0000-000000000000","code":"","name":"","detail":"","retry_mode":"","tm_create":null,"tm_update":null,"tm_delete":null}`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockDisposition := dispositionhandler.NewMockDispositionHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:        mockSock,
+				dispositionHandler: mockDisposition,
+			}
+
+			mockDisposition.EXPECT().Update(gomock.Any(), tt.dispositionID, tt.dispositionName, tt.detail, tt.retryMode).Return(tt.responseDisposition, nil)
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.expectRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_v1DispositionsIDDelete(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		dispositionID uuid.UUID
+
+		responseDisposition *disposition.Disposition
+
+		expectRes *sock.Response
+	}{
+		{
+			"normal",
+			&sock.Request{
+				URI:      "/v1/dispositions/5f23a8e0-ad7a-11f0-8c4e-9f5a6b7c8d77",
+				Method:   sock.RequestMethodDelete,
+				DataType: "application/json",
+			},
+
+			uuid.FromStringOrNil("5f23a8e0-ad7a-11f0-8c4e-9f5a6b7c8d77"),
+
+			&disposition.Disposition{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("5f23a8e0-ad7a-11f0-8c4e-9f5a6b7c8d77"),
+				},
+			},
+
+			&sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"5f23a8e0-ad7a-11f0-8c4e-9f5a6b7c8d77","customer_id":"00000000-0000-0000-0000-000000000000","code":"","name":"","detail":"","retry_mode":"","tm_create":null,"tm_update":null,"tm_delete":null}`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockDisposition := dispositionhandler.NewMockDispositionHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:        mockSock,
+				dispositionHandler: mockDisposition,
+			}
+
+			mockDisposition.EXPECT().Delete(gomock.Any(), tt.dispositionID).Return(tt.responseDisposition, nil)
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.expectRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
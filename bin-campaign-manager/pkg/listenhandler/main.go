@@ -11,13 +11,16 @@ import (
 	cerrors "monorepo/bin-common-handler/models/errors"
 	"monorepo/bin-common-handler/models/sock"
 	"monorepo/bin-common-handler/pkg/sockhandler"
+	"monorepo/bin-common-handler/pkg/tracehandler"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
 
 	"monorepo/bin-campaign-manager/pkg/campaigncallhandler"
 	"monorepo/bin-campaign-manager/pkg/campaignhandler"
+	"monorepo/bin-campaign-manager/pkg/campaignresultjobhandler"
 	"monorepo/bin-campaign-manager/pkg/dbhandler"
+	"monorepo/bin-campaign-manager/pkg/dispositionhandler"
 	"monorepo/bin-campaign-manager/pkg/outplanhandler"
 )
 
@@ -35,9 +38,11 @@ type ListenHandler interface {
 type listenHandler struct {
 	sockHandler sockhandler.SockHandler
 
-	campaignHandler     campaignhandler.CampaignHandler
-	campaigncallHandler campaigncallhandler.CampaigncallHandler
-	outplanHandler      outplanhandler.OutplanHandler
+	campaignHandler          campaignhandler.CampaignHandler
+	campaigncallHandler      campaigncallhandler.CampaigncallHandler
+	outplanHandler           outplanhandler.OutplanHandler
+	dispositionHandler       dispositionhandler.DispositionHandler
+	campaignResultJobHandler campaignresultjobhandler.CampaignResultJobHandler
 }
 
 var (
@@ -50,19 +55,35 @@ var (
 	regV1CampaignsIDExecute        = regexp.MustCompile("/v1/campaigns/" + regUUID + "/execute$")
 	regV1CampaignsIDStatus         = regexp.MustCompile("/v1/campaigns/" + regUUID + "/status$")
 	regV1CampaignsIDServiceLevel   = regexp.MustCompile("/v1/campaigns/" + regUUID + "/service_level$")
+	regV1CampaignsIDDialMode       = regexp.MustCompile("/v1/campaigns/" + regUUID + "/dial_mode$")
+	regV1CampaignsIDCallingWindows = regexp.MustCompile("/v1/campaigns/" + regUUID + "/calling_windows$")
 	regV1CampaignsIDActions        = regexp.MustCompile("/v1/campaigns/" + regUUID + "/actions$")
 	regV1CampaignsIDResourceInfo   = regexp.MustCompile("/v1/campaigns/" + regUUID + "/resource_info$")
 	regV1CampaignsIDNextCampaignID = regexp.MustCompile("/v1/campaigns/" + regUUID + "/next_campaign_id$")
+	regV1CampaignsIDResults        = regexp.MustCompile("/v1/campaigns/" + regUUID + `/results\?`)
 
 	// campaigncalls
-	regV1CampaigncallsGet = regexp.MustCompile(`/v1/campaigncalls\?`)
-	regV1CampaigncallsID  = regexp.MustCompile("/v1/campaigncalls/" + regUUID + "$")
+	regV1CampaigncallsGet           = regexp.MustCompile(`/v1/campaigncalls\?`)
+	regV1CampaigncallsID            = regexp.MustCompile("/v1/campaigncalls/" + regUUID + "$")
+	regV1CampaigncallsIDAccept      = regexp.MustCompile("/v1/campaigncalls/" + regUUID + "/accept$")
+	regV1CampaigncallsIDSkip        = regexp.MustCompile("/v1/campaigncalls/" + regUUID + "/skip$")
+	regV1CampaigncallsIDDisposition = regexp.MustCompile("/v1/campaigncalls/" + regUUID + "/disposition$")
 
 	// outplans
 	regV1Outplans        = regexp.MustCompile("/v1/outplans$")
 	regV1OutplansGet     = regexp.MustCompile(`/v1/outplans\?`)
 	regV1OutplansID      = regexp.MustCompile("/v1/outplans/" + regUUID + "$")
 	regV1OutplansIDDials = regexp.MustCompile("/v1/outplans/" + regUUID + "/dials$")
+
+	// dispositions
+	regV1Dispositions    = regexp.MustCompile("/v1/dispositions$")
+	regV1DispositionsGet = regexp.MustCompile(`/v1/dispositions\?`)
+	regV1DispositionsID  = regexp.MustCompile("/v1/dispositions/" + regUUID + "$")
+
+	// campaignresultjobs
+	regV1Campaignresultjobs    = regexp.MustCompile("/v1/campaignresultjobs$")
+	regV1CampaignresultjobsGet = regexp.MustCompile(`/v1/campaignresultjobs\?`)
+	regV1CampaignresultjobsID  = regexp.MustCompile("/v1/campaignresultjobs/" + regUUID + "$")
 )
 
 var (
@@ -127,13 +148,17 @@ func NewListenHandler(
 	outplanHandler outplanhandler.OutplanHandler,
 	campaignHandler campaignhandler.CampaignHandler,
 	campaigncallHandler campaigncallhandler.CampaigncallHandler,
+	dispositionHandler dispositionhandler.DispositionHandler,
+	campaignResultJobHandler campaignresultjobhandler.CampaignResultJobHandler,
 ) ListenHandler {
 	h := &listenHandler{
 		sockHandler: sockHandler,
 
-		campaignHandler:     campaignHandler,
-		campaigncallHandler: campaigncallHandler,
-		outplanHandler:      outplanHandler,
+		campaignHandler:          campaignHandler,
+		campaigncallHandler:      campaigncallHandler,
+		outplanHandler:           outplanHandler,
+		dispositionHandler:       dispositionHandler,
+		campaignResultJobHandler: campaignResultJobHandler,
 	}
 
 	return h
@@ -166,7 +191,8 @@ func (h *listenHandler) processRequest(m *sock.Request) (*sock.Response, error)
 	var err error
 	var response *sock.Response
 
-	ctx := context.Background()
+	ctx, span := tracehandler.StartServerSpan(context.Background(), m)
+	defer span.End()
 
 	logrus.WithFields(
 		logrus.Fields{
@@ -218,6 +244,16 @@ func (h *listenHandler) processRequest(m *sock.Request) (*sock.Response, error)
 		requestType = "/v1/campaigns/<campaign-id>/service_level"
 		response, err = h.v1CampaignsIDServiceLevelPut(ctx, m)
 
+	// /v1/campaigns/<campaign-id>/dial_mode
+	case regV1CampaignsIDDialMode.MatchString(m.URI) && m.Method == sock.RequestMethodPut:
+		requestType = "/v1/campaigns/<campaign-id>/dial_mode"
+		response, err = h.v1CampaignsIDDialModePut(ctx, m)
+
+	// /v1/campaigns/<campaign-id>/calling_windows
+	case regV1CampaignsIDCallingWindows.MatchString(m.URI) && m.Method == sock.RequestMethodPut:
+		requestType = "/v1/campaigns/<campaign-id>/calling_windows"
+		response, err = h.v1CampaignsIDCallingWindowsPut(ctx, m)
+
 	// /v1/campaigns/<campaign-id>/actions
 	case regV1CampaignsIDActions.MatchString(m.URI) && m.Method == sock.RequestMethodPut:
 		requestType = "/v1/campaigns/<campaign-id>/actions"
@@ -233,6 +269,11 @@ func (h *listenHandler) processRequest(m *sock.Request) (*sock.Response, error)
 		requestType = "/v1/campaigns/<campaign-id>/next_campaign_id"
 		response, err = h.v1CampaignsIDNextCampaignIDPut(ctx, m)
 
+	// /v1/campaigns/<campaign-id>/results
+	case regV1CampaignsIDResults.MatchString(m.URI) && m.Method == sock.RequestMethodGet:
+		requestType = "/v1/campaigns/<campaign-id>/results"
+		response, err = h.v1CampaignsIDResultsGet(ctx, m)
+
 	// campaigncalls
 	// /v1/campaigncalls
 	case regV1CampaigncallsGet.MatchString(m.URI) && m.Method == sock.RequestMethodGet:
@@ -249,6 +290,21 @@ func (h *listenHandler) processRequest(m *sock.Request) (*sock.Response, error)
 		requestType = "/v1/campaigncalls/<campaigncall-id>"
 		response, err = h.v1CampaigncallsIDDelete(ctx, m)
 
+	// /v1/campaigncalls/<campaigncall_id>/accept
+	case regV1CampaigncallsIDAccept.MatchString(m.URI) && m.Method == sock.RequestMethodPost:
+		requestType = "/v1/campaigncalls/<campaigncall-id>/accept"
+		response, err = h.v1CampaigncallsIDAcceptPost(ctx, m)
+
+	// /v1/campaigncalls/<campaigncall_id>/skip
+	case regV1CampaigncallsIDSkip.MatchString(m.URI) && m.Method == sock.RequestMethodPost:
+		requestType = "/v1/campaigncalls/<campaigncall-id>/skip"
+		response, err = h.v1CampaigncallsIDSkipPost(ctx, m)
+
+	// /v1/campaigncalls/<campaigncall_id>/disposition
+	case regV1CampaigncallsIDDisposition.MatchString(m.URI) && m.Method == sock.RequestMethodPut:
+		requestType = "/v1/campaigncalls/<campaigncall-id>/disposition"
+		response, err = h.v1CampaigncallsIDDispositionPut(ctx, m)
+
 	// outplans
 	// /v1/outplans
 	case regV1Outplans.MatchString(m.URI) && m.Method == sock.RequestMethodPost:
@@ -277,6 +333,44 @@ func (h *listenHandler) processRequest(m *sock.Request) (*sock.Response, error)
 		requestType = "/v1/outdials/<outplan-id>/dials"
 		response, err = h.v1OutplansIDDialsPut(ctx, m)
 
+	// dispositions
+	// /v1/dispositions
+	case regV1Dispositions.MatchString(m.URI) && m.Method == sock.RequestMethodPost:
+		requestType = "/v1/dispositions"
+		response, err = h.v1DispositionsPost(ctx, m)
+
+	case regV1DispositionsGet.MatchString(m.URI) && m.Method == sock.RequestMethodGet:
+		requestType = "/v1/dispositions"
+		response, err = h.v1DispositionsGet(ctx, m)
+
+	// /v1/dispositions/<disposition-id>
+	case regV1DispositionsID.MatchString(m.URI) && m.Method == sock.RequestMethodGet:
+		requestType = "/v1/dispositions/<disposition-id>"
+		response, err = h.v1DispositionsIDGet(ctx, m)
+
+	case regV1DispositionsID.MatchString(m.URI) && m.Method == sock.RequestMethodPut:
+		requestType = "/v1/dispositions/<disposition-id>"
+		response, err = h.v1DispositionsIDPut(ctx, m)
+
+	case regV1DispositionsID.MatchString(m.URI) && m.Method == sock.RequestMethodDelete:
+		requestType = "/v1/dispositions/<disposition-id>"
+		response, err = h.v1DispositionsIDDelete(ctx, m)
+
+	// campaignresultjobs
+	// /v1/campaignresultjobs
+	case regV1Campaignresultjobs.MatchString(m.URI) && m.Method == sock.RequestMethodPost:
+		requestType = "/v1/campaignresultjobs"
+		response, err = h.v1CampaignresultjobsPost(ctx, m)
+
+	case regV1CampaignresultjobsGet.MatchString(m.URI) && m.Method == sock.RequestMethodGet:
+		requestType = "/v1/campaignresultjobs"
+		response, err = h.v1CampaignresultjobsGet(ctx, m)
+
+	// /v1/campaignresultjobs/<campaignresultjob-id>
+	case regV1CampaignresultjobsID.MatchString(m.URI) && m.Method == sock.RequestMethodGet:
+		requestType = "/v1/campaignresultjobs/<campaignresultjob-id>"
+		response, err = h.v1CampaignresultjobsIDGet(ctx, m)
+
 	default:
 		logrus.WithFields(
 			logrus.Fields{
@@ -0,0 +1,19 @@
+package request
+
+import (
+	"github.com/gofrs/uuid"
+)
+
+// V1DataCampaigncallsIDAcceptPost is
+// v1 data type request struct for
+// /v1/campaigncalls/<campaigncall-id>/accept POST
+type V1DataCampaigncallsIDAcceptPost struct {
+	AgentID uuid.UUID `json:"agent_id"`
+}
+
+// V1DataCampaigncallsIDDispositionPut is
+// v1 data type request struct for
+// /v1/campaigncalls/<campaigncall-id>/disposition PUT
+type V1DataCampaigncallsIDDispositionPut struct {
+	Disposition string `json:"disposition"` // the disposition's code. empty clears the disposition
+}
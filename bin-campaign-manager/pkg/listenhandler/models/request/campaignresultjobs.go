@@ -0,0 +1,12 @@
+package request
+
+import (
+	"github.com/gofrs/uuid"
+)
+
+// V1DataCampaignresultjobsPost is
+// v1 data type request struct for
+// /v1/campaignresultjobs POST
+type V1DataCampaignresultjobsPost struct {
+	CampaignID uuid.UUID `json:"campaign_id"`
+}
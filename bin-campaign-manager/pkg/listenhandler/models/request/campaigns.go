@@ -82,3 +82,19 @@ type V1DataCampaignsIDResourceInfoPut struct {
 type V1DataCampaignsIDNextCampaignIDPut struct {
 	NextCampaignID uuid.UUID `json:"next_campaign_id"`
 }
+
+// V1DataCampaignsIDDialModePut is
+// v1 data type request struct for
+// /v1/campaigns/<campaign-id>/dial_mode PUT
+type V1DataCampaignsIDDialModePut struct {
+	DialMode       campaign.DialMode `json:"dial_mode"`
+	MaxAbandonRate int               `json:"max_abandon_rate"`
+}
+
+// V1DataCampaignsIDCallingWindowsPut is
+// v1 data type request struct for
+// /v1/campaigns/<campaign-id>/calling_windows PUT
+type V1DataCampaignsIDCallingWindowsPut struct {
+	CallingWindows []campaign.CallingWindow `json:"calling_windows"`
+	Timezone       string                   `json:"timezone"`
+}
@@ -0,0 +1,30 @@
+package request
+
+import (
+	"github.com/gofrs/uuid"
+
+	"monorepo/bin-campaign-manager/models/disposition"
+)
+
+// V1DataDispositionsPost is
+// v1 data type request struct for
+// /v1/dispositions POST
+type V1DataDispositionsPost struct {
+	CustomerID uuid.UUID `json:"customer_id"`
+
+	Code   string `json:"code"`
+	Name   string `json:"name"`
+	Detail string `json:"detail"`
+
+	RetryMode disposition.RetryMode `json:"retry_mode"`
+}
+
+// V1DataDispositionsIDPut is
+// v1 data type request struct for
+// /v1/dispositions/<disposition-id> PUT
+type V1DataDispositionsIDPut struct {
+	Name   string `json:"name"`
+	Detail string `json:"detail"`
+
+	RetryMode disposition.RetryMode `json:"retry_mode"`
+}
@@ -43,3 +43,30 @@ func (h *subscribeHandler) processEventCMCallHungup(ctx context.Context, m *sock
 
 	return nil
 }
+
+// processEventCMCallProgressing handles the call-manager's call_progressing event.
+func (h *subscribeHandler) processEventCMCallProgressing(ctx context.Context, m *sock.Event) error {
+	log := logrus.WithFields(logrus.Fields{
+		"func":  "processEventCMCallProgressing",
+		"event": m,
+	})
+
+	c := cmcall.Call{}
+	if err := json.Unmarshal([]byte(m.Data), &c); err != nil {
+		log.Errorf("Could not unmarshal the data. err: %v", err)
+		return err
+	}
+
+	// get campaigncall
+	cc, err := h.campaigncallHandler.GetByReferenceID(ctx, c.ID)
+	if err != nil {
+		// campaigncall does not exist.
+		return nil
+	}
+
+	if _, errEvent := h.campaigncallHandler.EventHandleReferenceCallProgressing(ctx, cc); errEvent != nil {
+		log.Errorf("Could not handle the event correctly. err: %v", errEvent)
+	}
+
+	return nil
+}
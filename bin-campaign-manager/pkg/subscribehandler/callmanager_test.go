@@ -66,3 +66,54 @@ func Test_processEventCMCallHangup(t *testing.T) {
 		})
 	}
 }
+
+func Test_processEventCMCallProgressing(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		event *sock.Event
+
+		callID uuid.UUID
+
+		responseCampaigncall *campaigncall.Campaigncall
+	}{
+		{
+			"normal",
+			&sock.Event{
+				Publisher: "call-manager",
+				Type:      cmcall.EventTypeCallProgressing,
+				DataType:  "application/json",
+				Data:      []byte(`{"id":"d4a03b4c-4e2d-11f0-8c37-9c0d1e2f3a19"}`),
+			},
+
+			uuid.FromStringOrNil("d4a03b4c-4e2d-11f0-8c37-9c0d1e2f3a19"),
+
+			&campaigncall.Campaigncall{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("d4d04c5d-4e2d-11f0-9d48-0d1e2f3a4b20"),
+				},
+				Status: campaigncall.StatusDialing,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockCampaign := campaignhandler.NewMockCampaignHandler(mc)
+			mockCampaigncall := campaigncallhandler.NewMockCampaigncallHandler(mc)
+			h := subscribeHandler{
+				campaignHandler:     mockCampaign,
+				campaigncallHandler: mockCampaigncall,
+			}
+
+			mockCampaigncall.EXPECT().GetByReferenceID(gomock.Any(), tt.callID).Return(tt.responseCampaigncall, nil)
+			mockCampaigncall.EXPECT().EventHandleReferenceCallProgressing(gomock.Any(), tt.responseCampaigncall).Return(tt.responseCampaigncall, nil)
+
+			h.processEvent(tt.event)
+		})
+	}
+}
@@ -12,9 +12,12 @@ import (
 	commonoutline "monorepo/bin-common-handler/models/outline"
 	"monorepo/bin-common-handler/models/sock"
 	"monorepo/bin-common-handler/pkg/sockhandler"
+	"monorepo/bin-common-handler/pkg/tracehandler"
 
 	fmactiveflow "monorepo/bin-flow-manager/models/activeflow"
 
+	qmqueuecall "monorepo/bin-queue-manager/models/queuecall"
+
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
 
@@ -25,8 +28,9 @@ import (
 
 // list of publishers
 const (
-	publisherCallManager = "call-manager"
-	publisherFlowManager = "flow-manager"
+	publisherCallManager  = "call-manager"
+	publisherFlowManager  = "flow-manager"
+	publisherQueueManager = "queue-manager"
 )
 
 // SubscribeHandler interface
@@ -135,7 +139,8 @@ func (h *subscribeHandler) processEvent(m *sock.Event) {
 		},
 	)
 
-	ctx := context.Background()
+	ctx, span := tracehandler.StartConsumerSpan(context.Background(), m)
+	defer span.End()
 
 	var err error
 	start := time.Now()
@@ -146,11 +151,19 @@ func (h *subscribeHandler) processEvent(m *sock.Event) {
 	case m.Publisher == publisherCallManager && (m.Type == string(cmcall.EventTypeCallHangup)):
 		err = h.processEventCMCallHungup(ctx, m)
 
+	case m.Publisher == publisherCallManager && (m.Type == string(cmcall.EventTypeCallProgressing)):
+		err = h.processEventCMCallProgressing(ctx, m)
+
 	//// flow-manager
 	// activeflow
 	case m.Publisher == publisherFlowManager && (m.Type == string(fmactiveflow.EventTypeActiveflowDeleted)):
 		err = h.processEventFMActiveflowDeleted(ctx, m)
 
+	//// queue-manager
+	// queuecall
+	case m.Publisher == publisherQueueManager && (m.Type == string(qmqueuecall.EventTypeQueuecallAbandoned)):
+		err = h.processEventQMQueuecallAbandoned(ctx, m)
+
 	/////////////////////////////////////////////////////////////////////////////////////////////////
 	// No handler found
 	/////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,38 @@
+package subscribehandler
+
+import (
+	"context"
+	"encoding/json"
+
+	"monorepo/bin-common-handler/models/sock"
+	qmqueuecall "monorepo/bin-queue-manager/models/queuecall"
+
+	"github.com/sirupsen/logrus"
+)
+
+// processEventQMQueuecallAbandoned handles the queue-manager's queuecall_abandoned event.
+func (h *subscribeHandler) processEventQMQueuecallAbandoned(ctx context.Context, m *sock.Event) error {
+	log := logrus.WithFields(logrus.Fields{
+		"func":  "processEventQMQueuecallAbandoned",
+		"event": m,
+	})
+
+	qc := qmqueuecall.Queuecall{}
+	if err := json.Unmarshal([]byte(m.Data), &qc); err != nil {
+		log.Errorf("Could not unmarshal the data. err: %v", err)
+		return err
+	}
+
+	// get campaigncall
+	cc, err := h.campaigncallHandler.GetByReferenceID(ctx, qc.ReferenceID)
+	if err != nil {
+		// campaigncall does not exist.
+		return nil
+	}
+
+	if _, errEvent := h.campaigncallHandler.EventHandleQueuecallAbandoned(ctx, cc); errEvent != nil {
+		log.Errorf("Could not handle the event correctly. err: %v", errEvent)
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package subscribehandler
+
+import (
+	"testing"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/models/sock"
+	qmqueuecall "monorepo/bin-queue-manager/models/queuecall"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	"monorepo/bin-campaign-manager/models/campaigncall"
+	"monorepo/bin-campaign-manager/pkg/campaigncallhandler"
+	"monorepo/bin-campaign-manager/pkg/campaignhandler"
+)
+
+func Test_processEventQMQueuecallAbandoned(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		event *sock.Event
+
+		callID uuid.UUID
+
+		responseCampaigncall *campaigncall.Campaigncall
+	}{
+		{
+			"normal",
+			&sock.Event{
+				Publisher: "queue-manager",
+				Type:      qmqueuecall.EventTypeQueuecallAbandoned,
+				DataType:  "application/json",
+				Data:      []byte(`{"id":"e5b15d6e-4e2d-11f0-ae59-1e2f3a4b5c21","reference_type":"call","reference_id":"e5e16e7f-4e2d-11f0-bf6a-2f3a4b5c6d22"}`),
+			},
+
+			uuid.FromStringOrNil("e5e16e7f-4e2d-11f0-bf6a-2f3a4b5c6d22"),
+
+			&campaigncall.Campaigncall{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("e6117f80-4e2d-11f0-807b-3a4b5c6d7e23"),
+				},
+				Status: campaigncall.StatusProgressing,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockCampaign := campaignhandler.NewMockCampaignHandler(mc)
+			mockCampaigncall := campaigncallhandler.NewMockCampaigncallHandler(mc)
+			h := subscribeHandler{
+				campaignHandler:     mockCampaign,
+				campaigncallHandler: mockCampaigncall,
+			}
+
+			mockCampaigncall.EXPECT().GetByReferenceID(gomock.Any(), tt.callID).Return(tt.responseCampaigncall, nil)
+			mockCampaigncall.EXPECT().EventHandleQueuecallAbandoned(gomock.Any(), tt.responseCampaigncall).Return(tt.responseCampaigncall, nil)
+
+			h.processEvent(tt.event)
+		})
+	}
+}
@@ -9,6 +9,7 @@ create table campaign_campaigncalls(
   outdial_id        binary(16),
   outdial_target_id binary(16),
   queue_id          binary(16),
+  agent_id          binary(16),
 
   activeflow_id     binary(16),
   flow_id           binary(16),
@@ -19,12 +20,19 @@ create table campaign_campaigncalls(
   status  varchar(255),
   result  varchar(255),
 
+  disposition varchar(255),
+
   source            json,
   destination       json,
   destination_index integer,
   try_count         integer,
 
+  abandoned boolean,
+
   -- timestamps
+  tm_callback datetime(6),  -- scheduled callback
+  tm_progressing datetime(6),  -- progressing(answered)
+  tm_end datetime(6),  -- end
   tm_create datetime(6),  -- create
   tm_update datetime(6),  -- update
   tm_delete datetime(6),  -- delete
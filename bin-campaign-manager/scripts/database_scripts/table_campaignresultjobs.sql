@@ -0,0 +1,26 @@
+create table campaign_campaignresultjobs(
+  -- identity
+  id          binary(16),
+  customer_id binary(16),
+
+  campaign_id binary(16),
+
+  status  varchar(255),
+
+  file_id binary(16),
+
+  target_count  integer,
+  row_count     integer,
+
+  detail    text,
+
+  -- timestamps
+  tm_create datetime(6),  -- create
+  tm_update datetime(6),  -- update
+  tm_delete datetime(6),  -- delete
+
+  primary key(id)
+);
+
+create index idx_campaign_campaignresultjobs_customer_id on campaign_campaignresultjobs(customer_id);
+create index idx_campaign_campaignresultjobs_campaign_id on campaign_campaignresultjobs(campaign_id);
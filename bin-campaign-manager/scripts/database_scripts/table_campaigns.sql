@@ -15,6 +15,12 @@ create table campaign_campaigns(
   service_level integer,
   end_handle  varchar(255),
 
+  dial_mode         varchar(255),
+  max_abandon_rate  integer,
+
+  calling_windows json,
+  timezone        varchar(255),
+
   flow_id binary(16),
   actions json,
 
@@ -0,0 +1,20 @@
+create table campaign_dispositions(
+  -- identity
+  id          binary(16),
+  customer_id binary(16),
+
+  code    varchar(255),
+  name    varchar(255),
+  detail  text,
+
+  retry_mode  varchar(255),
+
+  -- timestamps
+  tm_create datetime(6),  -- create
+  tm_update datetime(6),  -- update
+  tm_delete datetime(6),  -- delete
+
+  primary key(id)
+);
+
+create index idx_campaign_dispositions_customer_id_code on campaign_dispositions(customer_id, code);
@@ -0,0 +1,29 @@
+package outbox
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+// Event is an event stored in a service's outbox table in the same database
+// transaction as the state change it describes. The outbox relay publishes it
+// after the commit, so a broker outage or a crash between the two steps delays
+// the event instead of losing it.
+type Event struct {
+	ID         uuid.UUID `json:"id" db:"id,uuid"`
+	CustomerID uuid.UUID `json:"customer_id" db:"customer_id,uuid"` // webhook target. uuid.Nil publishes the event only.
+
+	EventType   string `json:"event_type" db:"event_type"`
+	DataType    string `json:"data_type" db:"data_type"`
+	Data        string `json:"data" db:"data"`                 // event payload
+	WebhookData string `json:"webhook_data" db:"webhook_data"` // webhook payload. empty if no webhook is published.
+
+	TraceContext map[string]string `json:"trace_context" db:"trace_context,json"` // W3C trace context of the producer. the relay continues the trace with it.
+
+	RetryCount int `json:"retry_count" db:"retry_count"` // number of relay attempts
+
+	TMLease   *time.Time `json:"tm_lease" db:"tm_lease"`     // relay lease expiry. the event is not picked up again before this time.
+	TMPublish *time.Time `json:"tm_publish" db:"tm_publish"` // nil until the event has been published
+	TMCreate  *time.Time `json:"tm_create" db:"tm_create"`
+}
@@ -0,0 +1,23 @@
+package outbox
+
+// Field represents an outbox event field for database queries.
+type Field string
+
+// List of fields
+const (
+	FieldID         Field = "id"
+	FieldCustomerID Field = "customer_id"
+
+	FieldEventType   Field = "event_type"
+	FieldDataType    Field = "data_type"
+	FieldData        Field = "data"
+	FieldWebhookData Field = "webhook_data"
+
+	FieldTraceContext Field = "trace_context"
+
+	FieldRetryCount Field = "retry_count"
+
+	FieldTMLease   Field = "tm_lease"
+	FieldTMPublish Field = "tm_publish"
+	FieldTMCreate  Field = "tm_create"
+)
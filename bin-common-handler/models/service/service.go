@@ -19,6 +19,7 @@ type Type string
 // list of types
 const (
 	TypeAIcall         Type = "aicall"
+	TypeAIExtract      Type = "ai_extract"
 	TypeAISummary      Type = "ai_summary"
 	TypeConferencecall Type = "conferencecall"
 	TypeQueuecall      Type = "queuecall"
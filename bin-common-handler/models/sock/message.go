@@ -16,6 +16,9 @@ type Request struct {
 	// Clients and managers receive it via logrus fields so inbound
 	// and outbound log lines can be joined by the same ID.
 	RequestID string `json:"request_id,omitempty"`
+	// TraceContext carries the W3C trace context (traceparent, tracestate)
+	// of the caller's span. See pkg/tracehandler.
+	TraceContext map[string]string `json:"trace_context,omitempty"`
 }
 
 // Response struct
@@ -31,6 +34,10 @@ type Event struct {
 	Publisher string          `json:"publisher"`
 	DataType  string          `json:"data_type"`
 	Data      json.RawMessage `json:"data,omitempty"`
+
+	// TraceContext carries the W3C trace context (traceparent, tracestate)
+	// of the publisher's span. See pkg/tracehandler.
+	TraceContext map[string]string `json:"trace_context,omitempty"`
 }
 
 // RequestMethod type
@@ -5,4 +5,6 @@ type Type string
 const (
 	TypeNone     Type = ""
 	TypeRabbitMQ Type = "rabbitMQ"
+	TypeMemory   Type = "memory" // in-process broker. for tests
+	TypeNATS     Type = "nats"   // nats jetstream
 )
@@ -12,6 +12,8 @@ func TestTypeConstants(t *testing.T) {
 	}{
 		{"type_none", TypeNone, ""},
 		{"type_rabbitmq", TypeRabbitMQ, "rabbitMQ"},
+		{"type_memory", TypeMemory, "memory"},
+		{"type_nats", TypeNATS, "nats"},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,256 @@
+package memoryhandler
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+
+	commonoutline "monorepo/bin-common-handler/models/outline"
+)
+
+// list of exchange kinds
+const (
+	exchangeKindFanout = "fanout"
+	exchangeKindDirect = "direct"
+	exchangeKindTopic  = "topic"
+)
+
+// defaultQueueSize is the max number of the messages waiting in a queue.
+// A message published to the full queue is dropped, same as the RabbitMQ queue with max-length.
+const defaultQueueSize = 10000
+
+// broker is the in-process message broker shared by the clients of the same name.
+type broker struct {
+	// mu protects queues and exchanges.
+	mu        sync.RWMutex
+	queues    map[string]*queue
+	exchanges map[string]*exchange
+}
+
+type queue struct {
+	name       string
+	deliveries chan *delivery
+
+	// hasConsumer is true when a consumer is registered to the queue.
+	// protected by broker.mu.
+	hasConsumer bool
+}
+
+type exchange struct {
+	name  string
+	kind  string
+	binds []*queueBind
+}
+
+type queueBind struct {
+	queue string
+	key   string
+}
+
+// delivery is the message in the queue.
+type delivery struct {
+	body       []byte
+	retryCount int
+
+	// reply receives the response body of the RPC request. nil if the publisher doesn't wait for the response.
+	reply chan []byte
+}
+
+func newBroker() *broker {
+	res := &broker{
+		queues:    map[string]*queue{},
+		exchanges: map[string]*exchange{},
+	}
+
+	// the delay exchange routes the delayed messages by the queue name.
+	res.exchanges[string(commonoutline.QueueNameDelay)] = &exchange{
+		name: string(commonoutline.QueueNameDelay),
+		kind: exchangeKindDirect,
+	}
+
+	return res
+}
+
+// queueDeclare declares the queue. It does nothing if the queue exists.
+func (b *broker) queueDeclare(name string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.queues[name]; ok {
+		return
+	}
+
+	b.queues[name] = &queue{
+		name:       name,
+		deliveries: make(chan *delivery, defaultQueueSize),
+	}
+}
+
+// queueGet returns the queue of the given name. nil if it doesn't exist.
+func (b *broker) queueGet(name string) *queue {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	return b.queues[name]
+}
+
+// exchangeDeclare declares the exchange. It returns error if the exchange exists with a different kind.
+func (b *broker) exchangeDeclare(name string, kind string) error {
+	switch kind {
+	case exchangeKindFanout, exchangeKindDirect, exchangeKindTopic:
+	default:
+		return fmt.Errorf("unsupported exchange kind. kind: %s", kind)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if e, ok := b.exchanges[name]; ok {
+		if e.kind != kind {
+			return fmt.Errorf("exchange exists with a different kind. name: %s, kind: %s", name, e.kind)
+		}
+		return nil
+	}
+
+	b.exchanges[name] = &exchange{
+		name: name,
+		kind: kind,
+	}
+
+	return nil
+}
+
+// queueBind binds the queue to the exchange with the key. Binding the same key twice is no-op.
+func (b *broker) queueBind(name string, key string, exchangeName string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.queues[name]; !ok {
+		return fmt.Errorf("no queue found")
+	}
+
+	e, ok := b.exchanges[exchangeName]
+	if !ok {
+		return fmt.Errorf("no exchange found. exchange: %s", exchangeName)
+	}
+
+	for _, bind := range e.binds {
+		if bind.queue == name && bind.key == key {
+			return nil
+		}
+	}
+	e.binds = append(e.binds, &queueBind{
+		queue: name,
+		key:   key,
+	})
+
+	return nil
+}
+
+// queueUnbind removes the bind of the queue and the exchange with the key.
+func (b *broker) queueUnbind(name string, key string, exchangeName string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.queues[name]; !ok {
+		return fmt.Errorf("no queue found")
+	}
+
+	e, ok := b.exchanges[exchangeName]
+	if !ok {
+		return fmt.Errorf("no exchange found. exchange: %s", exchangeName)
+	}
+
+	for i, bind := range e.binds {
+		if bind.queue == name && bind.key == key {
+			e.binds = append(e.binds[:i], e.binds[i+1:]...)
+			break
+		}
+	}
+
+	return nil
+}
+
+// publish routes the delivery to the queues.
+// The empty exchange name is the default exchange, which routes the delivery to the queue named by the key.
+// The unroutable delivery is dropped silently, same as the RabbitMQ.
+func (b *broker) publish(exchangeName string, key string, d *delivery) error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if exchangeName == "" {
+		if q, ok := b.queues[key]; ok {
+			q.enqueue(d)
+		}
+		return nil
+	}
+
+	e, ok := b.exchanges[exchangeName]
+	if !ok {
+		return fmt.Errorf("no exchange found. exchange: %s", exchangeName)
+	}
+
+	for _, bind := range e.binds {
+		if !e.match(bind.key, key) {
+			continue
+		}
+
+		if q, ok := b.queues[bind.queue]; ok {
+			q.enqueue(d)
+		}
+	}
+
+	return nil
+}
+
+// match returns true if the routing key matches the bind key of the exchange.
+func (e *exchange) match(bindKey string, routingKey string) bool {
+	switch e.kind {
+	case exchangeKindFanout:
+		return true
+
+	case exchangeKindDirect:
+		return bindKey == routingKey
+
+	case exchangeKindTopic:
+		return matchTopic(strings.Split(bindKey, "."), strings.Split(routingKey, "."))
+
+	default:
+		return false
+	}
+}
+
+// matchTopic matches the routing key words against the topic bind pattern words.
+// "*" matches exactly one word and "#" matches zero or more words.
+func matchTopic(pattern []string, words []string) bool {
+	if len(pattern) == 0 {
+		return len(words) == 0
+	}
+
+	switch pattern[0] {
+	case "#":
+		for i := 0; i <= len(words); i++ {
+			if matchTopic(pattern[1:], words[i:]) {
+				return true
+			}
+		}
+		return false
+
+	case "*":
+		return len(words) > 0 && matchTopic(pattern[1:], words[1:])
+
+	default:
+		return len(words) > 0 && pattern[0] == words[0] && matchTopic(pattern[1:], words[1:])
+	}
+}
+
+// enqueue puts the delivery into the queue. The delivery is dropped if the queue is full.
+func (q *queue) enqueue(d *delivery) {
+	select {
+	case q.deliveries <- d:
+	default:
+		logrus.WithField("queue", q.name).Errorf("The queue is full. Dropping the message.")
+	}
+}
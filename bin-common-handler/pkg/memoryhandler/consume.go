@@ -0,0 +1,196 @@
+package memoryhandler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	commonoutline "monorepo/bin-common-handler/models/outline"
+	"monorepo/bin-common-handler/models/sock"
+)
+
+// maxEventRetries is the number of retries applied to a failed event message
+// before it is dropped. Same as the rabbitmqhandler.
+const maxEventRetries = 3
+
+// retryBackoff holds the delay applied before the Nth retry. Same as the rabbitmqhandler.
+// Tests may shorten it.
+var retryBackoff = []time.Duration{5 * time.Second, 30 * time.Second, 120 * time.Second}
+
+// ConsumeMessage consumes the events from the queue with the worker pool.
+// It blocks until the context is done or the client is closed.
+// Same as the rabbitmqhandler, a failed event is retried with backoff and dropped after the retries.
+func (h *memory) ConsumeMessage(ctx context.Context, queueName string, consumerName string, exclusive bool, noLocal bool, noWait bool, numWorkers int, messageConsume sock.CbMsgConsume) error {
+	q, cctx, err := h.registerConsumer(ctx, queueName)
+	if err != nil {
+		return err
+	}
+	defer h.unregisterConsumer(q)
+
+	for i := 0; i < numWorkers; i++ {
+		go h.consumeMessageWorker(cctx, q, messageConsume)
+	}
+
+	<-cctx.Done()
+	return nil
+}
+
+// ConsumeRPC consumes the requests from the queue with the worker pool.
+// It blocks until the context is done or the client is closed.
+func (h *memory) ConsumeRPC(ctx context.Context, queueName string, consumerName string, exclusive bool, noLocal bool, noWait bool, numWorkers int, cbConsume sock.CbMsgRPC) error {
+	q, cctx, err := h.registerConsumer(ctx, queueName)
+	if err != nil {
+		return err
+	}
+	defer h.unregisterConsumer(q)
+
+	for i := 0; i < numWorkers; i++ {
+		go h.consumeRPCWorker(cctx, q, cbConsume)
+	}
+
+	<-cctx.Done()
+	return nil
+}
+
+// registerConsumer registers the consumer to the queue and returns the consumer's context,
+// which is canceled when the given context is done or the client is closed.
+// Same as the rabbitmqhandler, a queue can have only one consumer.
+func (h *memory) registerConsumer(ctx context.Context, queueName string) (*queue, context.Context, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		return nil, nil, fmt.Errorf("the handler is closed")
+	}
+
+	h.broker.mu.Lock()
+	defer h.broker.mu.Unlock()
+
+	q, ok := h.broker.queues[queueName]
+	if !ok {
+		return nil, nil, fmt.Errorf("queue '%s' not found", queueName)
+	}
+	if q.hasConsumer {
+		return nil, nil, fmt.Errorf("queue '%s' already has a registered consumer", queueName)
+	}
+	q.hasConsumer = true
+
+	cctx, cancel := context.WithCancel(ctx)
+	h.cancels = append(h.cancels, cancel)
+
+	return q, cctx, nil
+}
+
+// unregisterConsumer releases the queue for the next consumer.
+func (h *memory) unregisterConsumer(q *queue) {
+	h.broker.mu.Lock()
+	defer h.broker.mu.Unlock()
+
+	q.hasConsumer = false
+}
+
+func (h *memory) consumeMessageWorker(ctx context.Context, q *queue, messageConsume sock.CbMsgConsume) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case d := <-q.deliveries:
+			err := executeConsumeMessage(d, messageConsume)
+			h.retry(q, d, err)
+		}
+	}
+}
+
+// retry schedules the retry of the failed event, or drops it after the retries.
+func (h *memory) retry(q *queue, d *delivery, processErr error) {
+	if processErr == nil {
+		return
+	}
+
+	log := logrus.WithFields(logrus.Fields{"func": "retry", "queue": q.name})
+	if d.retryCount >= maxEventRetries {
+		log.Errorf("Message processing failed after %d retries, dropping. err: %v", maxEventRetries, processErr)
+		return
+	}
+
+	delay := retryBackoff[d.retryCount]
+	log.Warnf("Message processing failed, scheduling retry %d/%d in %v. err: %v", d.retryCount+1, maxEventRetries, delay, processErr)
+
+	h.publishWithDelay(string(commonoutline.QueueNameDelay), q.name, &delivery{
+		body:       d.body,
+		retryCount: d.retryCount + 1,
+	}, int(delay.Milliseconds()))
+}
+
+// executeConsumeMessage runs the callback with the given delivery.
+func executeConsumeMessage(d *delivery, messageConsume sock.CbMsgConsume) error {
+	var event sock.Event
+	if err := json.Unmarshal(d.body, &event); err != nil {
+		return fmt.Errorf("could out unmarshal the message. err: %v", err)
+	}
+
+	if err := messageConsume(&event); err != nil {
+		return fmt.Errorf("message consumer returns error. err: %v", err)
+	}
+
+	return nil
+}
+
+func (h *memory) consumeRPCWorker(ctx context.Context, q *queue, cbConsume sock.CbMsgRPC) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":  "consumeRPCWorker",
+		"queue": q.name,
+	})
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case d := <-q.deliveries:
+			if err := executeConsumeRPC(d, cbConsume); err != nil {
+				log.Errorf("Could not execute the consumer. err: %v", err)
+			}
+		}
+	}
+}
+
+// executeConsumeRPC runs the callback with the given delivery and replies the response.
+func executeConsumeRPC(d *delivery, cbConsume sock.CbMsgRPC) error {
+	var req sock.Request
+	if err := json.Unmarshal(d.body, &req); err != nil {
+		return fmt.Errorf("could not parse the message. message: %s, err: %v", string(d.body), err)
+	}
+
+	res, err := cbConsume(&req)
+	if err != nil {
+		// same as the rabbitmqhandler, reply 500 so the caller does not wait until the timeout.
+		if d.reply != nil {
+			res = &sock.Response{StatusCode: 500}
+			if errReply := reply(d, res); errReply != nil {
+				return errReply
+			}
+		}
+		return fmt.Errorf("message consumer returns error. err: %v", err)
+	} else if res == nil || d.reply == nil {
+		// nothing to return
+		return nil
+	}
+
+	return reply(d, res)
+}
+
+// reply sends the response to the waiting publisher.
+func reply(d *delivery, res *sock.Response) error {
+	resMsg, err := json.Marshal(res)
+	if err != nil {
+		return fmt.Errorf("could not marshal the response. res: %v, err: %v", res, err)
+	}
+
+	d.reply <- resMsg
+	return nil
+}
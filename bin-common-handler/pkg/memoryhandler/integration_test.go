@@ -0,0 +1,93 @@
+package memoryhandler_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	cmcall "monorepo/bin-call-manager/models/call"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	commonoutline "monorepo/bin-common-handler/models/outline"
+	"monorepo/bin-common-handler/models/sock"
+	"monorepo/bin-common-handler/pkg/memoryhandler"
+	"monorepo/bin-common-handler/pkg/notifyhandler"
+	"monorepo/bin-common-handler/pkg/requesthandler"
+	"monorepo/bin-common-handler/pkg/sockhandler"
+)
+
+// Test_services wires a fake call-manager and a flow-manager-like client over the in-process broker,
+// with the real requesthandler and notifyhandler.
+func Test_services(t *testing.T) {
+	name := t.Name()
+	memoryhandler.Reset(name)
+	defer memoryhandler.Reset(name)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	callID := uuid.FromStringOrNil("5e0a1c5a-7d5b-11ee-b59a-8f3c1d1f5a10")
+
+	// call-manager
+	callSock := sockhandler.NewSockHandler(sock.TypeMemory, name)
+	defer callSock.Close()
+	callReq := requesthandler.NewRequestHandler(callSock, commonoutline.ServiceNameCallManager)
+	callNotify := notifyhandler.NewNotifyHandler(callSock, callReq, commonoutline.QueueNameCallEvent, commonoutline.ServiceNameCallManager)
+
+	if err := callSock.QueueCreate(string(commonoutline.QueueNameCallRequest), "normal"); err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+	go func() {
+		_ = callSock.ConsumeRPC(ctx, string(commonoutline.QueueNameCallRequest), "call-manager", false, false, false, 1, func(r *sock.Request) (*sock.Response, error) {
+			c := &cmcall.Call{
+				Identity: commonidentity.Identity{
+					ID: callID,
+				},
+				Status: cmcall.StatusProgressing,
+			}
+			data, _ := json.Marshal(c)
+
+			// the state change is notified to the subscribers
+			callNotify.PublishEvent(context.Background(), cmcall.EventTypeCallUpdated, c)
+			return &sock.Response{StatusCode: 200, DataType: "application/json", Data: data}, nil
+		})
+	}()
+
+	// flow-manager
+	flowSock := sockhandler.NewSockHandler(sock.TypeMemory, name)
+	defer flowSock.Close()
+	flowReq := requesthandler.NewRequestHandler(flowSock, commonoutline.ServiceNameFlowManager)
+
+	if err := flowSock.QueueCreate(string(commonoutline.QueueNameFlowSubscribe), "normal"); err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+	if err := flowSock.QueueSubscribe(string(commonoutline.QueueNameFlowSubscribe), string(commonoutline.QueueNameCallEvent)); err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+	chEvent := make(chan *sock.Event, 1)
+	go func() {
+		_ = flowSock.ConsumeMessage(ctx, string(commonoutline.QueueNameFlowSubscribe), "flow-manager", false, false, false, 1, func(e *sock.Event) error {
+			chEvent <- e
+			return nil
+		})
+	}()
+
+	res, err := flowReq.CallV1CallGet(ctx, callID)
+	if err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+	if res.ID != callID || res.Status != cmcall.StatusProgressing {
+		t.Errorf("Wrong match. expect: %s/%s, got: %s/%s", callID, cmcall.StatusProgressing, res.ID, res.Status)
+	}
+
+	select {
+	case e := <-chEvent:
+		if e.Type != cmcall.EventTypeCallUpdated || e.Publisher != string(commonoutline.ServiceNameCallManager) {
+			t.Errorf("Wrong match. expect: %s/%s, got: %s/%s", cmcall.EventTypeCallUpdated, commonoutline.ServiceNameCallManager, e.Type, e.Publisher)
+		}
+	case <-time.After(time.Second * 3):
+		t.Fatalf("Timed out waiting for the event.")
+	}
+}
@@ -0,0 +1,95 @@
+package memoryhandler
+
+import (
+	"context"
+	"sync"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+
+	"monorepo/bin-common-handler/models/sock"
+)
+
+// Memory defines the in-process message broker interfaces.
+// It has the same interfaces as rabbitmqhandler.Rabbit, so it can be used as a SockHandler.
+type Memory interface {
+	Connect()
+	Close()
+
+	ConsumeMessage(ctx context.Context, queueName string, consumerName string, exclusive bool, noLocal bool, noWait bool, numWorkers int, messageConsume sock.CbMsgConsume) error
+	ConsumeRPC(ctx context.Context, queueName string, consumerName string, exclusive bool, noLocal bool, noWait bool, workerNum int, cbConsume sock.CbMsgRPC) error
+
+	TopicCreate(name string) error
+	TopicCreateWithKind(name string, kind string) error
+
+	EventPublish(topic string, key string, evt *sock.Event) error
+	EventPublishWithDelay(topic string, key string, evt *sock.Event, delay int) error
+
+	RequestPublish(ctx context.Context, queueName string, req *sock.Request) (*sock.Response, error)
+	RequestPublishWithDelay(key string, req *sock.Request, delay int) error
+
+	QueueCreate(name string, queueType string) error
+	QueueSubscribe(name string, topic string) error
+	QueueBind(name, key, exchange string, noWait bool, args amqp.Table) error
+	QueueUnbind(name, key, exchange string, args amqp.Table) error
+}
+
+// memory is a client of the in-process broker.
+type memory struct {
+	broker *broker
+
+	// mu protects cancels.
+	mu      sync.Mutex
+	closed  bool
+	cancels []context.CancelFunc
+}
+
+// list of broker registry
+var (
+	brokersMu sync.Mutex
+	brokers   = map[string]*broker{}
+)
+
+// NewMemory returns the client of the in-process broker of the given name.
+// The clients created with the same name share the broker, so the several
+// services in a single process (i.e. a test binary) can talk to each other
+// the same as they do through the RabbitMQ.
+func NewMemory(name string) Memory {
+	brokersMu.Lock()
+	defer brokersMu.Unlock()
+
+	b, ok := brokers[name]
+	if !ok {
+		b = newBroker()
+		brokers[name] = b
+	}
+
+	return &memory{
+		broker: b,
+	}
+}
+
+// Reset drops the in-process broker of the given name with all its queues and exchanges.
+// The next NewMemory call with the name gets a fresh broker.
+// Tests use this to isolate the broker state of each test.
+func Reset(name string) {
+	brokersMu.Lock()
+	defer brokersMu.Unlock()
+
+	delete(brokers, name)
+}
+
+// Connect does nothing. The in-process broker is always connected.
+func (h *memory) Connect() {}
+
+// Close stops the consumers started by this client.
+// The queues and exchanges stay in the broker for the other clients.
+func (h *memory) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.closed = true
+	for _, cancel := range h.cancels {
+		cancel()
+	}
+	h.cancels = nil
+}
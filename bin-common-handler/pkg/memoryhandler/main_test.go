@@ -0,0 +1,431 @@
+package memoryhandler
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"monorepo/bin-common-handler/models/sock"
+)
+
+// newTestMemory returns the client of a fresh broker for the test.
+func newTestMemory(t *testing.T) Memory {
+	name := t.Name()
+	Reset(name)
+
+	h := NewMemory(name)
+	t.Cleanup(func() {
+		h.Close()
+		Reset(name)
+	})
+
+	return h
+}
+
+// eventCollector collects the consumed events.
+type eventCollector struct {
+	mu     sync.Mutex
+	events []*sock.Event
+	ch     chan struct{}
+}
+
+func newEventCollector() *eventCollector {
+	return &eventCollector{
+		ch: make(chan struct{}, 100),
+	}
+}
+
+func (c *eventCollector) consume(e *sock.Event) error {
+	c.mu.Lock()
+	c.events = append(c.events, e)
+	c.mu.Unlock()
+
+	c.ch <- struct{}{}
+	return nil
+}
+
+// wait waits until the given number of events are consumed.
+func (c *eventCollector) wait(t *testing.T, count int) []*sock.Event {
+	for i := 0; i < count; i++ {
+		select {
+		case <-c.ch:
+		case <-time.After(time.Second * 3):
+			t.Fatalf("Timed out waiting for the events. expect: %d, got: %d", count, i)
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.events
+}
+
+// expectNothing fails if an event is consumed within the given duration.
+func (c *eventCollector) expectNothing(t *testing.T, d time.Duration) {
+	select {
+	case <-c.ch:
+		t.Errorf("Wrong match. expect: no event, got: event")
+	case <-time.After(d):
+	}
+}
+
+func Test_RequestPublish(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		request  *sock.Request
+		response *sock.Response
+		errCB    error
+
+		expectRes *sock.Response
+	}{
+		{
+			name: "normal",
+
+			request: &sock.Request{
+				URI:       "/v1/calls",
+				Method:    sock.RequestMethodGet,
+				Publisher: "api-manager",
+				DataType:  "application/json",
+			},
+			response: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"0f3f1a46-7d57-11ee-9c6d-2b2f4e0a7f7b"}`),
+			},
+
+			expectRes: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"0f3f1a46-7d57-11ee-9c6d-2b2f4e0a7f7b"}`),
+			},
+		},
+		{
+			name: "consumer returns error",
+
+			request: &sock.Request{
+				URI:    "/v1/calls",
+				Method: sock.RequestMethodGet,
+			},
+			errCB: fmt.Errorf("error"),
+
+			expectRes: &sock.Response{
+				StatusCode: 500,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newTestMemory(t)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			if err := h.QueueCreate("bin-manager.call-manager.request", "normal"); err != nil {
+				t.Fatalf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			var received *sock.Request
+			go func() {
+				_ = h.ConsumeRPC(ctx, "bin-manager.call-manager.request", "call-manager", false, false, false, 2, func(r *sock.Request) (*sock.Response, error) {
+					received = r
+					return tt.response, tt.errCB
+				})
+			}()
+
+			rctx, rcancel := context.WithTimeout(ctx, time.Second*3)
+			defer rcancel()
+			res, err := h.RequestPublish(rctx, "bin-manager.call-manager.request", tt.request)
+			if err != nil {
+				t.Fatalf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+			if !reflect.DeepEqual(received, tt.request) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.request, received)
+			}
+		})
+	}
+}
+
+func Test_RequestPublish_noConsumer(t *testing.T) {
+	h := newTestMemory(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*100)
+	defer cancel()
+
+	_, err := h.RequestPublish(ctx, "bin-manager.unknown.request", &sock.Request{URI: "/v1/calls", Method: sock.RequestMethodGet})
+	if err != context.DeadlineExceeded {
+		t.Errorf("Wrong match. expect: %v, got: %v", context.DeadlineExceeded, err)
+	}
+}
+
+func Test_EventPublish_routing(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		kind     string
+		bindKeys []string
+		keys     []string
+
+		expectTypes []string
+	}{
+		{
+			name: "fanout",
+
+			kind:     "fanout",
+			bindKeys: []string{""},
+			keys:     []string{"", "customer.1"},
+
+			expectTypes: []string{"0", "1"},
+		},
+		{
+			name: "direct",
+
+			kind:     "direct",
+			bindKeys: []string{"customer.1"},
+			keys:     []string{"customer.1", "customer.2"},
+
+			expectTypes: []string{"0"},
+		},
+		{
+			name: "topic",
+
+			kind:     "topic",
+			bindKeys: []string{"customer.*.call", "global.#"},
+			keys:     []string{"customer.1.call", "customer.1.conference", "customer.1.call.extra", "global", "global.a.b", "other"},
+
+			expectTypes: []string{"0", "3", "4"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newTestMemory(t)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			if err := h.TopicCreateWithKind("bin-manager.call-manager.event", tt.kind); err != nil {
+				t.Fatalf("Wrong match. expect: ok, got: %v", err)
+			}
+			if err := h.QueueCreate("bin-manager.webhook-manager.subscribe", "volatile"); err != nil {
+				t.Fatalf("Wrong match. expect: ok, got: %v", err)
+			}
+			for _, key := range tt.bindKeys {
+				if err := h.QueueBind("bin-manager.webhook-manager.subscribe", key, "bin-manager.call-manager.event", false, nil); err != nil {
+					t.Fatalf("Wrong match. expect: ok, got: %v", err)
+				}
+			}
+
+			c := newEventCollector()
+			go func() {
+				_ = h.ConsumeMessage(ctx, "bin-manager.webhook-manager.subscribe", "webhook-manager", false, false, false, 1, c.consume)
+			}()
+
+			for i, key := range tt.keys {
+				if err := h.EventPublish("bin-manager.call-manager.event", key, &sock.Event{Type: fmt.Sprintf("%d", i), Publisher: "call-manager"}); err != nil {
+					t.Fatalf("Wrong match. expect: ok, got: %v", err)
+				}
+			}
+
+			events := c.wait(t, len(tt.expectTypes))
+			c.expectNothing(t, time.Millisecond*100)
+
+			res := []string{}
+			for _, e := range events {
+				res = append(res, e.Type)
+			}
+			if !reflect.DeepEqual(res, tt.expectTypes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectTypes, res)
+			}
+		})
+	}
+}
+
+func Test_EventPublish_unbind(t *testing.T) {
+	h := newTestMemory(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if err := h.TopicCreate("bin-manager.call-manager.event"); err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+	if err := h.QueueCreate("bin-manager.flow-manager.subscribe", "normal"); err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+	if err := h.QueueSubscribe("bin-manager.flow-manager.subscribe", "bin-manager.call-manager.event"); err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+	if err := h.QueueUnbind("bin-manager.flow-manager.subscribe", "", "bin-manager.call-manager.event", nil); err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+
+	c := newEventCollector()
+	go func() {
+		_ = h.ConsumeMessage(ctx, "bin-manager.flow-manager.subscribe", "flow-manager", false, false, false, 1, c.consume)
+	}()
+
+	if err := h.EventPublish("bin-manager.call-manager.event", "", &sock.Event{Type: "call_created"}); err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+	c.expectNothing(t, time.Millisecond*100)
+}
+
+func Test_EventPublish_noExchange(t *testing.T) {
+	h := newTestMemory(t)
+
+	if err := h.EventPublish("bin-manager.unknown.event", "", &sock.Event{Type: "test"}); err == nil {
+		t.Errorf("Wrong match. expect: error, got: ok")
+	}
+}
+
+func Test_PublishWithDelay(t *testing.T) {
+	h := newTestMemory(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if err := h.QueueCreate("bin-manager.call-manager.request", "normal"); err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+
+	chReq := make(chan time.Time, 1)
+	go func() {
+		_ = h.ConsumeRPC(ctx, "bin-manager.call-manager.request", "call-manager", false, false, false, 1, func(r *sock.Request) (*sock.Response, error) {
+			chReq <- time.Now()
+			return &sock.Response{StatusCode: 200}, nil
+		})
+	}()
+
+	start := time.Now()
+	if err := h.RequestPublishWithDelay("bin-manager.call-manager.request", &sock.Request{URI: "/v1/calls", Method: sock.RequestMethodPost}, 200); err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+
+	select {
+	case tm := <-chReq:
+		if tm.Sub(start) < time.Millisecond*200 {
+			t.Errorf("Wrong match. expect: >= 200ms, got: %v", tm.Sub(start))
+		}
+	case <-time.After(time.Second * 3):
+		t.Fatalf("Timed out waiting for the delayed request.")
+	}
+}
+
+func Test_ConsumeMessage_retry(t *testing.T) {
+	h := newTestMemory(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	backoff := retryBackoff
+	retryBackoff = []time.Duration{time.Millisecond * 10, time.Millisecond * 10, time.Millisecond * 10}
+	defer func() {
+		retryBackoff = backoff
+	}()
+
+	if err := h.QueueCreate("bin-manager.flow-manager.subscribe", "normal"); err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+
+	chCalled := make(chan struct{}, 10)
+	go func() {
+		_ = h.ConsumeMessage(ctx, "bin-manager.flow-manager.subscribe", "flow-manager", false, false, false, 1, func(e *sock.Event) error {
+			chCalled <- struct{}{}
+			return fmt.Errorf("error")
+		})
+	}()
+
+	if err := h.EventPublish("", "bin-manager.flow-manager.subscribe", &sock.Event{Type: "call_created"}); err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+
+	// the original attempt and the retries
+	for i := 0; i < maxEventRetries+1; i++ {
+		select {
+		case <-chCalled:
+		case <-time.After(time.Second * 3):
+			t.Fatalf("Timed out waiting for the retry. expect: %d, got: %d", maxEventRetries+1, i)
+		}
+	}
+
+	select {
+	case <-chCalled:
+		t.Errorf("Wrong match. expect: dropped, got: retried")
+	case <-time.After(time.Millisecond * 100):
+	}
+}
+
+func Test_Consume_duplicateConsumer(t *testing.T) {
+	h := newTestMemory(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if err := h.ConsumeRPC(ctx, "bin-manager.unknown.request", "test", false, false, false, 1, nil); err == nil {
+		t.Errorf("Wrong match. expect: error, got: ok")
+	}
+
+	if err := h.QueueCreate("bin-manager.call-manager.request", "normal"); err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+
+	chDone := make(chan error, 1)
+	go func() {
+		chDone <- h.ConsumeRPC(ctx, "bin-manager.call-manager.request", "call-manager", false, false, false, 1, func(r *sock.Request) (*sock.Response, error) {
+			return nil, nil
+		})
+	}()
+	time.Sleep(time.Millisecond * 50)
+
+	if err := h.ConsumeRPC(ctx, "bin-manager.call-manager.request", "call-manager", false, false, false, 1, nil); err == nil {
+		t.Errorf("Wrong match. expect: error, got: ok")
+	}
+
+	// closing the handler stops the consumer
+	h.Close()
+	select {
+	case err := <-chDone:
+		if err != nil {
+			t.Errorf("Wrong match. expect: ok, got: %v", err)
+		}
+	case <-time.After(time.Second * 3):
+		t.Fatalf("Timed out waiting for the consumer to stop.")
+	}
+}
+
+func Test_NewMemory_sharedBroker(t *testing.T) {
+	name := t.Name()
+	Reset(name)
+	defer Reset(name)
+
+	callManager := NewMemory(name)
+	apiManager := NewMemory(name)
+	defer callManager.Close()
+	defer apiManager.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if err := callManager.QueueCreate("bin-manager.call-manager.request", "normal"); err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+	go func() {
+		_ = callManager.ConsumeRPC(ctx, "bin-manager.call-manager.request", "call-manager", false, false, false, 1, func(r *sock.Request) (*sock.Response, error) {
+			return &sock.Response{StatusCode: 200}, nil
+		})
+	}()
+
+	rctx, rcancel := context.WithTimeout(ctx, time.Second*3)
+	defer rcancel()
+	res, err := apiManager.RequestPublish(rctx, "bin-manager.call-manager.request", &sock.Request{URI: "/v1/calls", Method: sock.RequestMethodGet})
+	if err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+	if res.StatusCode != 200 {
+		t.Errorf("Wrong match. expect: 200, got: %d", res.StatusCode)
+	}
+}
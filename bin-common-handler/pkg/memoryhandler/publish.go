@@ -0,0 +1,88 @@
+package memoryhandler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	commonoutline "monorepo/bin-common-handler/models/outline"
+	"monorepo/bin-common-handler/models/sock"
+)
+
+// EventPublish publishes the event to the exchange with the routing key.
+func (h *memory) EventPublish(exchange string, key string, evt *sock.Event) error {
+	message, err := json.Marshal(evt)
+	if err != nil {
+		return err
+	}
+
+	return h.broker.publish(exchange, key, &delivery{body: message})
+}
+
+// EventPublishWithDelay publishes the event to the exchange with the routing key after the delay.
+// delay is ms.
+func (h *memory) EventPublishWithDelay(exchange string, key string, evt *sock.Event, delay int) error {
+	message, err := json.Marshal(evt)
+	if err != nil {
+		return err
+	}
+
+	h.publishWithDelay(exchange, key, &delivery{body: message}, delay)
+	return nil
+}
+
+// RequestPublish publishes the request to the queue and returns the response.
+// Same as the RabbitMQ, the request to the queue without consumer waits until the context is done.
+func (h *memory) RequestPublish(ctx context.Context, queueName string, req *sock.Request) (*sock.Response, error) {
+	reqMsg, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal the message. err: %v", err)
+	}
+
+	reply := make(chan []byte, 1)
+	if errPublish := h.broker.publish("", queueName, &delivery{body: reqMsg, reply: reply}); errPublish != nil {
+		return nil, fmt.Errorf("could not send a message. err: %v", errPublish)
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+
+	case resMsg := <-reply:
+		var res sock.Response
+		if err := json.Unmarshal(resMsg, &res); err != nil {
+			return nil, err
+		}
+		return &res, nil
+	}
+}
+
+// RequestPublishWithDelay sends the request to the queue of the given key after the delay.
+// The response is not returned.
+// delay is ms.
+func (h *memory) RequestPublishWithDelay(key string, req *sock.Request, delay int) error {
+	message, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	h.publishWithDelay(string(commonoutline.QueueNameDelay), key, &delivery{body: message}, delay)
+	return nil
+}
+
+// publishWithDelay publishes the delivery after the delay.
+// delay is ms.
+func (h *memory) publishWithDelay(exchange string, key string, d *delivery, delay int) {
+	time.AfterFunc(time.Duration(delay)*time.Millisecond, func() {
+		if err := h.broker.publish(exchange, key, d); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"func":     "publishWithDelay",
+				"exchange": exchange,
+				"key":      key,
+			}).Errorf("Could not publish the delayed message. err: %v", err)
+		}
+	})
+}
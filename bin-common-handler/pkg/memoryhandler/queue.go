@@ -0,0 +1,45 @@
+package memoryhandler
+
+import (
+	"fmt"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+
+	commonoutline "monorepo/bin-common-handler/models/outline"
+)
+
+// QueueCreate declares the queue and binds it to the delay exchange, same as the rabbitmqhandler.
+// The queue type is validated only. The in-process queues are neither durable nor expiring.
+func (h *memory) QueueCreate(name string, queueType string) error {
+	switch queueType {
+	case "volatile", "normal":
+	default:
+		return fmt.Errorf("invalid queue type. type: %s", queueType)
+	}
+
+	h.broker.queueDeclare(name)
+
+	// bind the delay exchange to the queue
+	if errBind := h.broker.queueBind(name, name, string(commonoutline.QueueNameDelay)); errBind != nil {
+		return fmt.Errorf("could not bind the queue and exchange. err: %v", errBind)
+	}
+
+	return nil
+}
+
+// QueueSubscribe binds the queue to the exchange with the empty key.
+func (h *memory) QueueSubscribe(name string, topic string) error {
+	return h.QueueBind(name, "", topic, false, nil)
+}
+
+// QueueBind binds the queue and the exchange with the key.
+// The noWait and args are ignored.
+func (h *memory) QueueBind(name, key, exchange string, noWait bool, args amqp.Table) error {
+	return h.broker.queueBind(name, key, exchange)
+}
+
+// QueueUnbind unbinds the queue and the exchange with the key.
+// The args are ignored.
+func (h *memory) QueueUnbind(name, key, exchange string, args amqp.Table) error {
+	return h.broker.queueUnbind(name, key, exchange)
+}
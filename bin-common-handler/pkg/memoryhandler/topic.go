@@ -0,0 +1,21 @@
+package memoryhandler
+
+import "fmt"
+
+// TopicCreate declares the fanout exchange.
+func (h *memory) TopicCreate(name string) error {
+	if errDeclare := h.broker.exchangeDeclare(name, exchangeKindFanout); errDeclare != nil {
+		return fmt.Errorf("could not declare the queue for event. err: %v", errDeclare)
+	}
+
+	return nil
+}
+
+// TopicCreateWithKind declares the exchange with the given kind. "fanout", "direct" and "topic" are supported.
+func (h *memory) TopicCreateWithKind(name string, kind string) error {
+	if errDeclare := h.broker.exchangeDeclare(name, kind); errDeclare != nil {
+		return fmt.Errorf("could not declare the exchange with kind %s. err: %v", kind, errDeclare)
+	}
+
+	return nil
+}
@@ -0,0 +1,341 @@
+package natshandler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/sirupsen/logrus"
+
+	"monorepo/bin-common-handler/models/sock"
+)
+
+// maxEventRetries is the number of retries applied to a failed event message
+// before it is dropped. Same as the rabbitmqhandler.
+const maxEventRetries = 3
+
+// retryBackoff holds the delay applied before the Nth retry. Same as the rabbitmqhandler.
+var retryBackoff = []time.Duration{5 * time.Second, 30 * time.Second, 120 * time.Second}
+
+// ConsumeMessage consumes the events from the queue with the worker pool.
+// It blocks until the context is done or the handler is closed.
+// Same as the rabbitmqhandler, a failed event is retried with backoff and dropped after the retries.
+func (h *natsHandler) ConsumeMessage(ctx context.Context, queueName string, consumerName string, exclusive bool, noLocal bool, noWait bool, numWorkers int, messageConsume sock.CbMsgConsume) error {
+	cctx, err := h.registerConsumer(ctx, queueName)
+	if err != nil {
+		return err
+	}
+	defer h.unregisterConsumer(queueName)
+
+	cons, err := h.js.Consumer(cctx, streamEvents, escapeToken(queueName))
+	if errors.Is(err, jetstream.ErrConsumerNotFound) {
+		return fmt.Errorf("queue '%s' not found", queueName)
+	} else if err != nil {
+		return fmt.Errorf("could not start consuming from queue '%s': %v", queueName, err)
+	}
+
+	messages := make(chan jetstream.Msg, numWorkers)
+	cc, err := consumeToChannel(cctx, cons, messages, numWorkers)
+	if err != nil {
+		return fmt.Errorf("could not start consuming from queue '%s': %v", queueName, err)
+	}
+	defer cc.Stop()
+
+	for i := 0; i < numWorkers; i++ {
+		go h.consumeMessageWorker(cctx, messages, queueName, messageConsume)
+	}
+
+	<-cctx.Done()
+	return nil
+}
+
+// registerConsumer enforces one consumer registration per queue in the process, same as the rabbitmqhandler.
+// It returns the consumer's context, which is cancelled on the unregister or Close.
+func (h *natsHandler) registerConsumer(ctx context.Context, queueName string) (context.Context, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, ok := h.consumers[queueName]; ok {
+		return nil, fmt.Errorf("queue '%s' already has a registered consumer", queueName)
+	}
+
+	cctx, cancel := context.WithCancel(ctx)
+	h.consumers[queueName] = cancel
+
+	return cctx, nil
+}
+
+// unregisterConsumer cancels the consumer's context and removes the registration,
+// so the queue can be consumed again.
+func (h *natsHandler) unregisterConsumer(queueName string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if cancel, ok := h.consumers[queueName]; ok {
+		cancel()
+		delete(h.consumers, queueName)
+	}
+}
+
+// consumeToChannel consumes the JetStream consumer's messages into the channel until the context is done.
+func consumeToChannel(ctx context.Context, cons jetstream.Consumer, messages chan<- jetstream.Msg, numWorkers int) (jetstream.ConsumeContext, error) {
+	return cons.Consume(func(m jetstream.Msg) {
+		select {
+		case messages <- m:
+		case <-ctx.Done():
+		}
+	}, jetstream.PullMaxMessages(numWorkers))
+}
+
+func (h *natsHandler) consumeMessageWorker(ctx context.Context, messages <-chan jetstream.Msg, queueName string, messageConsume sock.CbMsgConsume) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case m := <-messages:
+			err := executeConsumeMessage(m.Data(), messageConsume)
+			ackOrRetry(m, queueName, err)
+		}
+	}
+}
+
+// ackOrRetry acknowledges the processed event, or redelivers the failed one with the backoff.
+func ackOrRetry(m jetstream.Msg, queueName string, processErr error) {
+	log := logrus.WithFields(logrus.Fields{"func": "ackOrRetry", "queue": queueName})
+
+	if processErr == nil {
+		if err := m.Ack(); err != nil {
+			log.Errorf("Error acknowledging message: %v", err)
+		}
+		return
+	}
+
+	retryCount := 0
+	if md, err := m.Metadata(); err == nil {
+		retryCount = int(md.NumDelivered) - 1
+	}
+
+	if retryCount >= maxEventRetries {
+		log.Errorf("Message processing failed after %d retries, dropping. err: %v", maxEventRetries, processErr)
+		if err := m.Term(); err != nil {
+			log.Errorf("Error terminating (dropping) exhausted message: %v", err)
+		}
+		return
+	}
+
+	delay := retryBackoff[retryCount]
+	log.Warnf("Message processing failed, scheduling retry %d/%d in %v. err: %v", retryCount+1, maxEventRetries, delay, processErr)
+	if err := m.NakWithDelay(delay); err != nil {
+		log.Errorf("Error nacking message: %v", err)
+	}
+}
+
+// executeConsumeMessage runs the callback with the given message.
+func executeConsumeMessage(data []byte, messageConsume sock.CbMsgConsume) error {
+	var event sock.Event
+	if err := json.Unmarshal(data, &event); err != nil {
+		return fmt.Errorf("could out unmarshal the message. err: %v", err)
+	}
+
+	if err := messageConsume(&event); err != nil {
+		return fmt.Errorf("message consumer returns error. err: %v", err)
+	}
+
+	return nil
+}
+
+// ConsumeRPC consumes the requests of the queue with the worker pool.
+// The consumers of the same queue share the requests through the queue group,
+// and the delayed requests through the queue's durable consumer of the requests stream.
+// It blocks until the context is done or the handler is closed.
+func (h *natsHandler) ConsumeRPC(ctx context.Context, queueName string, consumerName string, exclusive bool, noLocal bool, noWait bool, numWorkers int, cbConsume sock.CbMsgRPC) error {
+	cctx, err := h.registerConsumer(ctx, queueName)
+	if err != nil {
+		return err
+	}
+	defer h.unregisterConsumer(queueName)
+
+	cons, err := h.js.CreateOrUpdateConsumer(cctx, streamRequests, jetstream.ConsumerConfig{
+		Durable:       escapeToken(queueName),
+		Description:   queueName,
+		FilterSubject: subjectRequest(queueName),
+		AckPolicy:     jetstream.AckExplicitPolicy,
+		AckWait:       defaultAckWait,
+	})
+	if err != nil {
+		return fmt.Errorf("could not declare the delayed request consumer of queue '%s': %v", queueName, err)
+	}
+
+	delayed := make(chan jetstream.Msg, numWorkers)
+	cc, err := consumeToChannel(cctx, cons, delayed, numWorkers)
+	if err != nil {
+		return fmt.Errorf("could not start consuming the delayed requests of queue '%s': %v", queueName, err)
+	}
+	defer cc.Stop()
+
+	messages := make(chan *nats.Msg, numWorkers)
+	sub, err := h.conn.ChanQueueSubscribe(subjectRPC(queueName), escapeToken(queueName), messages)
+	if err != nil {
+		return fmt.Errorf("could not start consuming from queue '%s': %v", queueName, err)
+	}
+	defer func() {
+		_ = sub.Unsubscribe()
+	}()
+
+	for i := 0; i < numWorkers; i++ {
+		go h.consumeRPCWorker(cctx, messages, delayed, cbConsume)
+	}
+
+	<-cctx.Done()
+	return nil
+}
+
+func (h *natsHandler) consumeRPCWorker(ctx context.Context, messages <-chan *nats.Msg, delayed <-chan jetstream.Msg, cbConsume sock.CbMsgRPC) {
+	log := logrus.WithFields(logrus.Fields{
+		"func": "consumeRPCWorker",
+	})
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case m := <-messages:
+			if err := executeConsumeRPC(m, cbConsume); err != nil {
+				log.Errorf("Could not execute the consumer. err: %v", err)
+			}
+
+		case m := <-delayed:
+			// nobody waits for the response of the delayed request.
+			// same as the rabbitmqhandler, the failed request is not redelivered.
+			if err := executeConsumeDelayedRPC(m.Data(), cbConsume); err != nil {
+				log.Errorf("Could not execute the consumer. err: %v", err)
+			}
+			if err := m.Ack(); err != nil {
+				log.Errorf("Error acknowledging the delayed request: %v", err)
+			}
+		}
+	}
+}
+
+// executeConsumeDelayedRPC runs the callback with the given delayed request.
+func executeConsumeDelayedRPC(data []byte, cbConsume sock.CbMsgRPC) error {
+	var req sock.Request
+	if err := json.Unmarshal(data, &req); err != nil {
+		return fmt.Errorf("could not parse the message. message: %s, err: %v", string(data), err)
+	}
+
+	if _, err := cbConsume(&req); err != nil {
+		return fmt.Errorf("message consumer returns error. err: %v", err)
+	}
+
+	return nil
+}
+
+// executeConsumeRPC runs the callback with the given message and replies the response.
+func executeConsumeRPC(m *nats.Msg, cbConsume sock.CbMsgRPC) error {
+	var req sock.Request
+	if err := json.Unmarshal(m.Data, &req); err != nil {
+		return fmt.Errorf("could not parse the message. message: %s, err: %v", string(m.Data), err)
+	}
+
+	res, err := cbConsume(&req)
+	if err != nil {
+		// same as the rabbitmqhandler, reply 500 so the caller does not wait until the timeout.
+		if m.Reply != "" {
+			if errReply := reply(m, &sock.Response{StatusCode: 500}); errReply != nil {
+				return errReply
+			}
+		}
+		return fmt.Errorf("message consumer returns error. err: %v", err)
+	} else if res == nil || m.Reply == "" {
+		// nothing to return
+		return nil
+	}
+
+	return reply(m, res)
+}
+
+// reply sends the response to the requester.
+func reply(m *nats.Msg, res *sock.Response) error {
+	resMsg, err := json.Marshal(res)
+	if err != nil {
+		return fmt.Errorf("could not marshal the response. res: %v, err: %v", res, err)
+	}
+
+	if err := m.Respond(resMsg); err != nil {
+		return fmt.Errorf("could not reply the message. message: %v, err: %v", res, err)
+	}
+
+	return nil
+}
+
+// runDelayWorker starts consuming the delay stream.
+// The delay consumer is shared by all the clients, so each delayed message is published once.
+func (h *natsHandler) runDelayWorker() error {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultRequestTimeout)
+	defer cancel()
+
+	cons, err := h.js.CreateOrUpdateConsumer(ctx, streamDelay, jetstream.ConsumerConfig{
+		Durable:       consumerDelay,
+		AckPolicy:     jetstream.AckExplicitPolicy,
+		AckWait:       time.Second * 30,
+		MaxDeliver:    -1,
+		MaxAckPending: -1, // the waiting messages are pending until their due time
+	})
+	if err != nil {
+		return fmt.Errorf("could not create the delay consumer. err: %v", err)
+	}
+
+	cc, err := cons.Consume(h.processDelay)
+	if err != nil {
+		return fmt.Errorf("could not consume the delay stream. err: %v", err)
+	}
+
+	h.mu.Lock()
+	h.stopDelay = cc.Stop
+	h.mu.Unlock()
+
+	return nil
+}
+
+// processDelay publishes the delayed message to its target if it's due. Otherwise, redelivers it at its due time.
+func (h *natsHandler) processDelay(m jetstream.Msg) {
+	log := logrus.WithFields(logrus.Fields{
+		"func": "processDelay",
+	})
+
+	target := m.Headers().Get(headerDelayTarget)
+	due, err := strconv.ParseInt(m.Headers().Get(headerDelayDue), 10, 64)
+	if target == "" || err != nil {
+		log.Errorf("Invalid delayed message. Dropping. target: %s, err: %v", target, err)
+		_ = m.Term()
+		return
+	}
+
+	if remaining := time.Until(time.UnixMilli(due)); remaining > 0 {
+		if errNak := m.NakWithDelay(remaining); errNak != nil {
+			log.Errorf("Could not nack the delayed message. err: %v", errNak)
+		}
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), defaultRequestTimeout)
+	_, err = h.js.Publish(ctx, target, m.Data())
+	cancel()
+	if err != nil {
+		log.Errorf("Could not publish the delayed message. Will retry. target: %s, err: %v", target, err)
+		_ = m.NakWithDelay(time.Second)
+		return
+	}
+
+	if errAck := m.Ack(); errAck != nil {
+		log.Errorf("Could not ack the delayed message. err: %v", errAck)
+	}
+}
@@ -0,0 +1,211 @@
+package natshandler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/sirupsen/logrus"
+
+	"monorepo/bin-common-handler/models/sock"
+)
+
+// Nats defines the NATS JetStream interfaces.
+// It has the same interfaces as rabbitmqhandler.Rabbit, so it can be used as a SockHandler.
+//
+// The RabbitMQ concepts are mapped to NATS as below.
+//   - RPC queue: core NATS request/reply on "voipbin.rpc.<queue>" with the queue group.
+//   - Exchange: subjects "voipbin.ex.<exchange>.<routing key>" in the VOIPBIN_EVENTS stream.
+//     The exchange kinds are shared through the voipbin_exchanges KV bucket.
+//   - Queue: a durable JetStream consumer of the VOIPBIN_EVENTS stream. The binds are the consumer's filter subjects.
+//     The default exchange delivers to "voipbin.q.<queue>". The binds are kept in the voipbin_queue_binds KV bucket.
+//   - Delayed request: "voipbin.rq.<queue>" in the VOIPBIN_REQUESTS work-queue stream.
+//     The RPC consumer of the queue consumes it through the durable consumer, so it's kept until the queue's service consumes it.
+//   - Delayed delivery: the VOIPBIN_DELAY work-queue stream. Every client runs the delay worker,
+//     which holds the message until its due time and then publishes it to the target.
+type Nats interface {
+	Connect()
+	Close()
+
+	ConsumeMessage(ctx context.Context, queueName string, consumerName string, exclusive bool, noLocal bool, noWait bool, numWorkers int, messageConsume sock.CbMsgConsume) error
+	ConsumeRPC(ctx context.Context, queueName string, consumerName string, exclusive bool, noLocal bool, noWait bool, workerNum int, cbConsume sock.CbMsgRPC) error
+
+	TopicCreate(name string) error
+	TopicCreateWithKind(name string, kind string) error
+
+	EventPublish(topic string, key string, evt *sock.Event) error
+	EventPublishWithDelay(topic string, key string, evt *sock.Event, delay int) error
+
+	RequestPublish(ctx context.Context, queueName string, req *sock.Request) (*sock.Response, error)
+	RequestPublishWithDelay(key string, req *sock.Request, delay int) error
+
+	QueueCreate(name string, queueType string) error
+	QueueSubscribe(name string, topic string) error
+	QueueBind(name, key, exchange string, noWait bool, args amqp.Table) error
+	QueueUnbind(name, key, exchange string, args amqp.Table) error
+}
+
+// List of stream and bucket names
+const (
+	streamEvents     = "VOIPBIN_EVENTS"
+	streamRequests   = "VOIPBIN_REQUESTS"
+	streamDelay      = "VOIPBIN_DELAY"
+	bucketExchanges  = "voipbin_exchanges"
+	bucketQueueBinds = "voipbin_queue_binds"
+
+	consumerDelay = "voipbin_delay"
+)
+
+// List of default variables
+const (
+	defaultRequestTimeout = time.Second * 5  // timeout for the JetStream API requests
+	defaultAckWait        = time.Minute * 5  // an event not acked within this time is redelivered
+	defaultVolatileExpire = time.Minute * 30 // a volatile queue without consumer is deleted after this time. same as the rabbitmqhandler
+)
+
+type natsHandler struct {
+	uri string
+
+	conn  *nats.Conn
+	js    jetstream.JetStream
+	kv    jetstream.KeyValue
+	binds jetstream.KeyValue
+
+	// mu protects consumers and stopDelay.
+	// consumers holds the cancel func of the running consumer of each queue.
+	mu        sync.Mutex
+	consumers map[string]context.CancelFunc
+	stopDelay func()
+}
+
+// NewNats creates the NATS JetStream handler.
+// uri: nats server url. i.e. nats://127.0.0.1:4222
+func NewNats(uri string) Nats {
+	return &natsHandler{
+		uri:       uri,
+		consumers: map[string]context.CancelFunc{},
+	}
+}
+
+// Connect connects to the NATS server, declares the streams and starts the delay worker.
+// It retries until it succeeds, same as the rabbitmqhandler.
+func (h *natsHandler) Connect() {
+	log := logrus.WithFields(logrus.Fields{
+		"func": "Connect",
+		"url":  h.uri,
+	})
+
+	for {
+		log.Debug("Connecting to nats.")
+		if err := h.connect(); err != nil {
+			log.Errorf("Could not connect to nats. Will retry again after 1 sec. err: %v", err)
+			time.Sleep(time.Second * 1)
+			continue
+		}
+
+		log.Debug("Connection established to nats.")
+		return
+	}
+}
+
+// connect connects to the NATS server and prepares the JetStream resources.
+func (h *natsHandler) connect() error {
+	conn, err := nats.Connect(h.uri, nats.MaxReconnects(-1), nats.ReconnectWait(time.Second))
+	if err != nil {
+		return err
+	}
+
+	js, err := jetstream.New(conn)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), defaultRequestTimeout)
+	defer cancel()
+
+	if _, err := js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:      streamEvents,
+		Subjects:  []string{subjectPrefixExchange + ">", subjectPrefixQueue + ">"},
+		Retention: jetstream.InterestPolicy,
+		Storage:   jetstream.FileStorage,
+	}); err != nil {
+		conn.Close()
+		return err
+	}
+
+	if _, err := js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:      streamRequests,
+		Subjects:  []string{subjectPrefixRequest + ">"},
+		Retention: jetstream.WorkQueuePolicy,
+		Storage:   jetstream.FileStorage,
+	}); err != nil {
+		conn.Close()
+		return err
+	}
+
+	if _, err := js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:      streamDelay,
+		Subjects:  []string{subjectDelay},
+		Retention: jetstream.WorkQueuePolicy,
+		Storage:   jetstream.FileStorage,
+	}); err != nil {
+		conn.Close()
+		return err
+	}
+
+	kv, err := js.CreateOrUpdateKeyValue(ctx, jetstream.KeyValueConfig{
+		Bucket:  bucketExchanges,
+		Storage: jetstream.FileStorage,
+	})
+	if err != nil {
+		conn.Close()
+		return err
+	}
+
+	binds, err := js.CreateOrUpdateKeyValue(ctx, jetstream.KeyValueConfig{
+		Bucket:  bucketQueueBinds,
+		Storage: jetstream.FileStorage,
+	})
+	if err != nil {
+		conn.Close()
+		return err
+	}
+
+	h.conn = conn
+	h.js = js
+	h.kv = kv
+	h.binds = binds
+
+	if err := h.runDelayWorker(); err != nil {
+		conn.Close()
+		return err
+	}
+
+	return nil
+}
+
+// Close stops the consumers and closes the connection.
+func (h *natsHandler) Close() {
+	logrus.WithFields(logrus.Fields{
+		"url": h.uri,
+	}).Info("Close the nats connection.")
+
+	h.mu.Lock()
+	for queueName, cancel := range h.consumers {
+		cancel()
+		delete(h.consumers, queueName)
+	}
+	if h.stopDelay != nil {
+		h.stopDelay()
+		h.stopDelay = nil
+	}
+	h.mu.Unlock()
+
+	if h.conn != nil {
+		_ = h.conn.Drain()
+	}
+}
@@ -0,0 +1,390 @@
+package natshandler
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats-server/v2/server"
+
+	"monorepo/bin-common-handler/models/sock"
+)
+
+// startServer runs the local nats-server with the JetStream enabled.
+func startServer(t *testing.T) string {
+	t.Helper()
+
+	s, err := server.NewServer(&server.Options{
+		Host:      "127.0.0.1",
+		Port:      -1,
+		JetStream: true,
+		StoreDir:  t.TempDir(),
+		NoLog:     true,
+		NoSigs:    true,
+	})
+	if err != nil {
+		t.Fatalf("Could not create the nats server. err: %v", err)
+	}
+
+	go s.Start()
+	if !s.ReadyForConnections(time.Second * 10) {
+		t.Fatalf("The nats server is not ready.")
+	}
+	t.Cleanup(s.Shutdown)
+
+	return s.ClientURL()
+}
+
+// newTestHandler returns the connected handler.
+func newTestHandler(t *testing.T, uri string) Nats {
+	t.Helper()
+
+	h := NewNats(uri).(*natsHandler)
+	if err := h.connect(); err != nil {
+		t.Fatalf("Could not connect. err: %v", err)
+	}
+	t.Cleanup(h.Close)
+
+	return h
+}
+
+// consumeEvents starts consuming the queue and returns the channel of the received events.
+func consumeEvents(ctx context.Context, t *testing.T, h Nats, queueName string) chan *sock.Event {
+	t.Helper()
+
+	res := make(chan *sock.Event, 10)
+	go func() {
+		_ = h.ConsumeMessage(ctx, queueName, "test", false, false, false, 1, func(e *sock.Event) error {
+			res <- e
+			return nil
+		})
+	}()
+
+	return res
+}
+
+// expectEvents checks the received event types in order and no more events.
+func expectEvents(t *testing.T, ch chan *sock.Event, types ...string) {
+	t.Helper()
+
+	for _, tp := range types {
+		select {
+		case e := <-ch:
+			if e.Type != tp {
+				t.Errorf("Wrong match. expect: %s, got: %s", tp, e.Type)
+			}
+		case <-time.After(time.Second * 3):
+			t.Fatalf("Timed out waiting for the event. expect: %s", tp)
+		}
+	}
+
+	select {
+	case e := <-ch:
+		t.Errorf("Wrong match. expect: no more event, got: %v", e)
+	case <-time.After(time.Millisecond * 300):
+	}
+}
+
+// request sends the request until the consumer gets ready.
+func request(ctx context.Context, t *testing.T, h Nats, queueName string, req *sock.Request) *sock.Response {
+	t.Helper()
+
+	deadline := time.Now().Add(time.Second * 3)
+	for {
+		res, err := h.RequestPublish(ctx, queueName, req)
+		if err == nil {
+			return res
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("Wrong match. expect: ok, got: %v", err)
+		}
+		time.Sleep(time.Millisecond * 50)
+	}
+}
+
+func Test_RequestPublish(t *testing.T) {
+	uri := startServer(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv := newTestHandler(t, uri)
+	client := newTestHandler(t, uri)
+
+	go func() {
+		_ = srv.ConsumeRPC(ctx, "bin-manager.test.request", "test", false, false, false, 2, func(r *sock.Request) (*sock.Response, error) {
+			if r.URI == "/v1/error" {
+				return nil, context.Canceled
+			}
+			return &sock.Response{StatusCode: 200, DataType: "application/json", Data: []byte(`{"uri":"` + r.URI + `"}`)}, nil
+		})
+	}()
+
+	res := request(ctx, t, client, "bin-manager.test.request", &sock.Request{URI: "/v1/calls", Method: sock.RequestMethodGet})
+	if res.StatusCode != 200 || string(res.Data) != `{"uri":"/v1/calls"}` {
+		t.Errorf("Wrong match. expect: 200, got: %d, %s", res.StatusCode, res.Data)
+	}
+
+	res = request(ctx, t, client, "bin-manager.test.request", &sock.Request{URI: "/v1/error", Method: sock.RequestMethodGet})
+	if res.StatusCode != 500 {
+		t.Errorf("Wrong match. expect: 500, got: %d", res.StatusCode)
+	}
+
+	// no consumer
+	if _, err := client.RequestPublish(ctx, "bin-manager.none.request", &sock.Request{URI: "/v1/calls"}); err == nil {
+		t.Errorf("Wrong match. expect: error, got: ok")
+	}
+}
+
+func Test_EventPublish_routing(t *testing.T) {
+	uri := startServer(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	publisher := newTestHandler(t, uri)
+	subscriber := newTestHandler(t, uri)
+
+	if err := publisher.TopicCreate("bin-manager.test.event"); err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+	if err := publisher.TopicCreateWithKind("bin-manager.test.topic", exchangeKindTopic); err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+	// the declared exchange can't change its kind
+	if err := subscriber.TopicCreateWithKind("bin-manager.test.topic", exchangeKindDirect); err == nil {
+		t.Errorf("Wrong match. expect: error, got: ok")
+	}
+
+	for _, q := range []string{"bin-manager.test.q1", "bin-manager.test.q2"} {
+		if err := subscriber.QueueCreate(q, "normal"); err != nil {
+			t.Fatalf("Wrong match. expect: ok, got: %v", err)
+		}
+		if err := subscriber.QueueSubscribe(q, "bin-manager.test.event"); err != nil {
+			t.Fatalf("Wrong match. expect: ok, got: %v", err)
+		}
+	}
+	if err := subscriber.QueueBind("bin-manager.test.q1", "call.*", "bin-manager.test.topic", false, nil); err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+	if err := subscriber.QueueBind("bin-manager.test.q2", "#", "bin-manager.test.topic", false, nil); err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+	if err := subscriber.QueueBind("bin-manager.test.q2", "call.#", "bin-manager.test.topic", false, nil); err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+
+	ch1 := consumeEvents(ctx, t, subscriber, "bin-manager.test.q1")
+	ch2 := consumeEvents(ctx, t, subscriber, "bin-manager.test.q2")
+
+	// fanout
+	if err := publisher.EventPublish("bin-manager.test.event", "", &sock.Event{Type: "fanout"}); err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+	expectEvents(t, ch1, "fanout")
+	expectEvents(t, ch2, "fanout")
+
+	// topic
+	if err := publisher.EventPublish("bin-manager.test.topic", "call.updated", &sock.Event{Type: "call_updated"}); err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+	if err := publisher.EventPublish("bin-manager.test.topic", "conference.created", &sock.Event{Type: "conference_created"}); err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+	expectEvents(t, ch1, "call_updated")
+	expectEvents(t, ch2, "call_updated", "conference_created")
+
+	// default exchange
+	if err := publisher.EventPublish("", "bin-manager.test.q1", &sock.Event{Type: "direct"}); err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+	expectEvents(t, ch1, "direct")
+	expectEvents(t, ch2)
+
+	// unbind
+	if err := subscriber.QueueUnbind("bin-manager.test.q1", "call.*", "bin-manager.test.topic", nil); err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+	if err := publisher.EventPublish("bin-manager.test.topic", "call.hangup", &sock.Event{Type: "call_hangup"}); err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+	expectEvents(t, ch1)
+	expectEvents(t, ch2, "call_hangup")
+}
+
+func Test_PublishWithDelay(t *testing.T) {
+	uri := startServer(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	h := newTestHandler(t, uri)
+
+	if err := h.QueueCreate("bin-manager.test.subscribe", "volatile"); err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+	chEvent := consumeEvents(ctx, t, h, "bin-manager.test.subscribe")
+
+	chRequest := make(chan time.Time, 1)
+	go func() {
+		_ = h.ConsumeRPC(ctx, "bin-manager.test.request", "test", false, false, false, 1, func(r *sock.Request) (*sock.Response, error) {
+			chRequest <- time.Now()
+			return &sock.Response{StatusCode: 200}, nil
+		})
+	}()
+	time.Sleep(time.Millisecond * 200)
+
+	start := time.Now()
+	if err := h.EventPublishWithDelay("bin-manager.delay", "bin-manager.test.subscribe", &sock.Event{Type: "delayed"}, 500); err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+	if err := h.RequestPublishWithDelay("bin-manager.test.request", &sock.Request{URI: "/v1/delayed"}, 500); err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+
+	select {
+	case e := <-chEvent:
+		if e.Type != "delayed" {
+			t.Errorf("Wrong match. expect: delayed, got: %s", e.Type)
+		}
+		if elapsed := time.Since(start); elapsed < time.Millisecond*500 {
+			t.Errorf("Wrong match. expect: >= 500ms, got: %v", elapsed)
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatalf("Timed out waiting for the delayed event.")
+	}
+
+	select {
+	case received := <-chRequest:
+		if elapsed := received.Sub(start); elapsed < time.Millisecond*500 {
+			t.Errorf("Wrong match. expect: >= 500ms, got: %v", elapsed)
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatalf("Timed out waiting for the delayed request.")
+	}
+}
+
+func Test_ConsumeMessage_retry(t *testing.T) {
+	orig := retryBackoff
+	retryBackoff = []time.Duration{time.Millisecond * 10, time.Millisecond * 10, time.Millisecond * 10}
+	defer func() { retryBackoff = orig }()
+
+	uri := startServer(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	h := newTestHandler(t, uri)
+	if err := h.QueueCreate("bin-manager.test.subscribe", "normal"); err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+
+	var count atomic.Int32
+	go func() {
+		_ = h.ConsumeMessage(ctx, "bin-manager.test.subscribe", "test", false, false, false, 1, func(e *sock.Event) error {
+			count.Add(1)
+			return context.Canceled
+		})
+	}()
+
+	if err := h.EventPublish("", "bin-manager.test.subscribe", &sock.Event{Type: "failing"}); err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+
+	time.Sleep(time.Second * 2)
+	if res := count.Load(); res != maxEventRetries+1 {
+		t.Errorf("Wrong match. expect: %d, got: %d", maxEventRetries+1, res)
+	}
+
+	// the second registration of the same queue fails
+	if err := h.ConsumeMessage(ctx, "bin-manager.test.subscribe", "test", false, false, false, 1, nil); err == nil {
+		t.Errorf("Wrong match. expect: error, got: ok")
+	}
+}
+
+func Test_RequestPublishWithDelay_durable(t *testing.T) {
+	uri := startServer(t)
+
+	srv := newTestHandler(t, uri)
+	client := newTestHandler(t, uri)
+
+	chRequest := make(chan string, 1)
+	consume := func(ctx context.Context) chan error {
+		res := make(chan error, 1)
+		go func() {
+			res <- srv.ConsumeRPC(ctx, "bin-manager.test.request", "test", false, false, false, 1, func(r *sock.Request) (*sock.Response, error) {
+				chRequest <- r.URI
+				return &sock.Response{StatusCode: 200}, nil
+			})
+		}()
+		return res
+	}
+
+	// the first consumer declares the queue's durable consumer and stops.
+	ctx1, cancel1 := context.WithCancel(context.Background())
+	done := consume(ctx1)
+	time.Sleep(time.Millisecond * 200)
+	cancel1()
+	if err := <-done; err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+
+	if err := client.RequestPublishWithDelay("bin-manager.test.request", &sock.Request{URI: "/v1/delayed"}, 100); err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+	time.Sleep(time.Millisecond * 500)
+
+	// the request is kept until the queue is consumed again.
+	ctx2, cancel2 := context.WithCancel(context.Background())
+	defer cancel2()
+	_ = consume(ctx2)
+
+	select {
+	case uri := <-chRequest:
+		if uri != "/v1/delayed" {
+			t.Errorf("Wrong match. expect: /v1/delayed, got: %s", uri)
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatalf("Timed out waiting for the delayed request.")
+	}
+}
+
+func Test_QueueBind_concurrent(t *testing.T) {
+	uri := startServer(t)
+
+	h1 := newTestHandler(t, uri)
+	h2 := newTestHandler(t, uri)
+
+	if err := h1.TopicCreateWithKind("bin-manager.test.event", "direct"); err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+	if err := h1.QueueCreate("bin-manager.test.subscribe", "normal"); err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+
+	keys := []string{"a", "b", "c", "d", "e", "f", "g", "h"}
+	errs := make(chan error, len(keys))
+	for i, key := range keys {
+		h := h1
+		if i%2 == 1 {
+			h = h2
+		}
+		go func(h Nats, key string) {
+			errs <- h.QueueBind("bin-manager.test.subscribe", key, "bin-manager.test.event", false, nil)
+		}(h, key)
+	}
+	for range keys {
+		if err := <-errs; err != nil {
+			t.Fatalf("Wrong match. expect: ok, got: %v", err)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ch := consumeEvents(ctx, t, h1, "bin-manager.test.subscribe")
+	time.Sleep(time.Millisecond * 200)
+
+	for _, key := range keys {
+		if err := h2.EventPublish("bin-manager.test.event", key, &sock.Event{Type: key}); err != nil {
+			t.Fatalf("Wrong match. expect: ok, got: %v", err)
+		}
+	}
+	expectEvents(t, ch, keys...)
+}
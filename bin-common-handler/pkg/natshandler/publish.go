@@ -0,0 +1,105 @@
+package natshandler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/nats-io/nats.go"
+
+	"monorepo/bin-common-handler/models/sock"
+)
+
+// List of delayed message headers
+const (
+	headerDelayTarget = "Voipbin-Delay-Target" // subject to publish after the delay
+	headerDelayDue    = "Voipbin-Delay-Due"    // due time. unix milliseconds
+)
+
+// EventPublish publishes the event to the exchange with the routing key.
+func (h *natsHandler) EventPublish(exchange string, key string, evt *sock.Event) error {
+	message, err := json.Marshal(evt)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), defaultRequestTimeout)
+	defer cancel()
+
+	if _, err := h.js.Publish(ctx, subjectEvent(exchange, key), message); err != nil {
+		return fmt.Errorf("could not send a message. err: %v", err)
+	}
+
+	return nil
+}
+
+// EventPublishWithDelay publishes the event to the exchange with the routing key after the delay.
+// delay is ms.
+func (h *natsHandler) EventPublishWithDelay(exchange string, key string, evt *sock.Event, delay int) error {
+	message, err := json.Marshal(evt)
+	if err != nil {
+		return err
+	}
+
+	return h.publishDelay(subjectEvent(exchange, key), message, delay)
+}
+
+// RequestPublish sends the request to the queue and returns the response.
+// Unlike the RabbitMQ, the request to the queue without consumer fails immediately.
+func (h *natsHandler) RequestPublish(ctx context.Context, queueName string, req *sock.Request) (*sock.Response, error) {
+	reqMsg, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal the message. err: %v", err)
+	}
+
+	msg, err := h.conn.RequestWithContext(ctx, subjectRPC(queueName), reqMsg)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return nil, context.DeadlineExceeded
+		}
+		return nil, fmt.Errorf("could not send a message. err: %v", err)
+	}
+
+	var res sock.Response
+	if err := json.Unmarshal(msg.Data, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// RequestPublishWithDelay sends the request to the queue of the given key after the delay.
+// The request is stored in the requests stream, so it's kept until the RPC consumer of the queue consumes it.
+// The response is not returned.
+// delay is ms.
+func (h *natsHandler) RequestPublishWithDelay(key string, req *sock.Request, delay int) error {
+	message, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	return h.publishDelay(subjectRequest(key), message, delay)
+}
+
+// publishDelay stores the message in the delay stream. The delay worker publishes it to the target after the delay.
+// delay is ms.
+func (h *natsHandler) publishDelay(target string, message []byte, delay int) error {
+	due := time.Now().Add(time.Duration(delay) * time.Millisecond)
+
+	msg := nats.NewMsg(subjectDelay)
+	msg.Header.Set(headerDelayTarget, target)
+	msg.Header.Set(headerDelayDue, strconv.FormatInt(due.UnixMilli(), 10))
+	msg.Data = message
+
+	ctx, cancel := context.WithTimeout(context.Background(), defaultRequestTimeout)
+	defer cancel()
+
+	if _, err := h.js.PublishMsg(ctx, msg); err != nil {
+		return fmt.Errorf("could not send the delayed message. err: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,238 @@
+package natshandler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/nats-io/nats.go/jetstream"
+	amqp "github.com/rabbitmq/amqp091-go"
+
+	commonoutline "monorepo/bin-common-handler/models/outline"
+)
+
+// metadataBindsRevision is the consumer metadata key of the queue binds revision applied to the filter subjects.
+const metadataBindsRevision = "voipbin_binds_revision"
+
+// queueBind is the bind of the queue and the exchange.
+type queueBind struct {
+	Exchange string `json:"exchange"`
+	Key      string `json:"key"`
+}
+
+// queueBinds is the queue binds entry of the queue binds bucket.
+type queueBinds struct {
+	// Created is the creation time of the queue's consumer.
+	// The binds of the deleted volatile queue don't apply to the queue re-created with the same name.
+	Created time.Time   `json:"created"`
+	Binds   []queueBind `json:"binds"`
+}
+
+// QueueCreate declares the queue as a durable consumer of the events stream.
+// The volatile queue is deleted after 30 minutes without consumer, same as the rabbitmqhandler.
+// It does nothing if the queue exists.
+func (h *natsHandler) QueueCreate(name string, queueType string) error {
+	cfg := jetstream.ConsumerConfig{
+		Durable:        escapeToken(name),
+		Description:    name,
+		FilterSubjects: []string{subjectQueue(name)},
+		AckPolicy:      jetstream.AckExplicitPolicy,
+		AckWait:        defaultAckWait,
+		MaxDeliver:     maxEventRetries + 1,
+		DeliverPolicy:  jetstream.DeliverNewPolicy,
+	}
+
+	switch queueType {
+	case "volatile":
+		cfg.InactiveThreshold = defaultVolatileExpire
+
+	case "normal":
+
+	default:
+		return fmt.Errorf("invalid queue type. type: %s", queueType)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), defaultRequestTimeout)
+	defer cancel()
+
+	_, err := h.js.Consumer(ctx, streamEvents, cfg.Durable)
+	if err == nil {
+		return nil
+	} else if !errors.Is(err, jetstream.ErrConsumerNotFound) {
+		return fmt.Errorf("could not get the queue. err: %v", err)
+	}
+
+	if _, errCreate := h.js.CreateConsumer(ctx, streamEvents, cfg); errCreate != nil && !errors.Is(errCreate, jetstream.ErrConsumerExists) {
+		return fmt.Errorf("could not declare the queue. err: %v", errCreate)
+	}
+
+	return nil
+}
+
+// QueueSubscribe binds the queue to the exchange with the empty key.
+func (h *natsHandler) QueueSubscribe(name string, topic string) error {
+	return h.QueueBind(name, "", topic, false, nil)
+}
+
+// QueueBind binds the queue and the exchange with the key.
+// The noWait and args are ignored.
+func (h *natsHandler) QueueBind(name, key, exchange string, noWait bool, args amqp.Table) error {
+	if exchange == string(commonoutline.QueueNameDelay) {
+		// the delayed messages are delivered to the queue without the bind.
+		return nil
+	}
+
+	return h.queueUpdateBinds(name, func(binds []queueBind) []queueBind {
+		for _, b := range binds {
+			if b.Exchange == exchange && b.Key == key {
+				return binds
+			}
+		}
+		return append(binds, queueBind{Exchange: exchange, Key: key})
+	})
+}
+
+// QueueUnbind unbinds the queue and the exchange with the key.
+// The args are ignored.
+func (h *natsHandler) QueueUnbind(name, key, exchange string, args amqp.Table) error {
+	if exchange == string(commonoutline.QueueNameDelay) {
+		return nil
+	}
+
+	return h.queueUpdateBinds(name, func(binds []queueBind) []queueBind {
+		res := []queueBind{}
+		for _, b := range binds {
+			if b.Exchange == exchange && b.Key == key {
+				continue
+			}
+			res = append(res, b)
+		}
+		return res
+	})
+}
+
+// queueUpdateBinds updates the binds of the queue and applies them to the consumer's filter subjects.
+// The binds are updated with the compare-and-swap on the queue binds bucket, so the concurrent updates
+// of the queue from the other services are not lost.
+func (h *natsHandler) queueUpdateBinds(name string, update func([]queueBind) []queueBind) error {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultRequestTimeout)
+	defer cancel()
+
+	for {
+		cons, err := h.js.Consumer(ctx, streamEvents, escapeToken(name))
+		if errors.Is(err, jetstream.ErrConsumerNotFound) {
+			return fmt.Errorf("no queue found")
+		} else if err != nil {
+			return fmt.Errorf("could not get the queue. err: %v", err)
+		}
+		created := cons.CachedInfo().Created
+
+		binds, revision, err := h.queueGetBinds(ctx, name)
+		if err != nil {
+			return err
+		}
+		if !binds.Created.Equal(created) {
+			binds = &queueBinds{Created: created}
+		}
+		binds.Binds = update(binds.Binds)
+
+		m, err := json.Marshal(binds)
+		if err != nil {
+			return fmt.Errorf("could not marshal the binds. err: %v", err)
+		}
+
+		if revision == 0 {
+			_, err = h.binds.Create(ctx, escapeToken(name), m)
+		} else {
+			_, err = h.binds.Update(ctx, escapeToken(name), m, revision)
+		}
+		if errors.Is(err, jetstream.ErrKeyExists) || errors.Is(err, jetstream.ErrKeyRevisionMismatch) {
+			// updated by the other one. retry with the latest binds.
+			continue
+		} else if err != nil {
+			return fmt.Errorf("could not update the queue binds. err: %v", err)
+		}
+
+		break
+	}
+
+	return h.queueApplyBinds(ctx, name)
+}
+
+// queueGetBinds returns the binds of the queue and its revision.
+// The revision is 0 if the queue has no binds entry.
+func (h *natsHandler) queueGetBinds(ctx context.Context, name string) (*queueBinds, uint64, error) {
+	entry, err := h.binds.Get(ctx, escapeToken(name))
+	if errors.Is(err, jetstream.ErrKeyNotFound) {
+		return &queueBinds{}, 0, nil
+	} else if err != nil {
+		return nil, 0, fmt.Errorf("could not get the queue binds. err: %v", err)
+	}
+
+	res := &queueBinds{}
+	if errUnmarshal := json.Unmarshal(entry.Value(), res); errUnmarshal != nil {
+		return nil, 0, fmt.Errorf("could not unmarshal the binds. err: %v", errUnmarshal)
+	}
+
+	return res, entry.Revision(), nil
+}
+
+// queueApplyBinds applies the latest binds of the queue to the consumer's filter subjects.
+// The consumer update has no compare-and-swap, so it re-applies until the binds are not changed
+// during the update. The last one applies the latest binds.
+func (h *natsHandler) queueApplyBinds(ctx context.Context, name string) error {
+	for {
+		cons, err := h.js.Consumer(ctx, streamEvents, escapeToken(name))
+		if err != nil {
+			return fmt.Errorf("could not get the queue. err: %v", err)
+		}
+		cfg := cons.CachedInfo().Config
+		created := cons.CachedInfo().Created
+
+		binds, revision, err := h.queueGetBinds(ctx, name)
+		if err != nil {
+			return err
+		}
+		if !binds.Created.Equal(created) {
+			binds = &queueBinds{}
+		}
+
+		// the queue always receives its default exchange deliveries.
+		// it also keeps the filter subjects from being empty, which means all subjects.
+		subjects := []string{subjectQueue(name)}
+		for _, b := range binds.Binds {
+			kind, errKind := h.exchangeKind(ctx, b.Exchange)
+			if errKind != nil {
+				return errKind
+			}
+
+			tmp, errSubjects := subjectsBind(kind, b.Exchange, b.Key)
+			if errSubjects != nil {
+				return errSubjects
+			}
+			subjects = append(subjects, tmp...)
+		}
+
+		cfg.FilterSubject = ""
+		cfg.FilterSubjects = filterSubjects(subjects)
+		if cfg.Metadata == nil {
+			cfg.Metadata = map[string]string{}
+		}
+		cfg.Metadata[metadataBindsRevision] = strconv.FormatUint(revision, 10)
+
+		if _, errUpdate := h.js.UpdateConsumer(ctx, streamEvents, cfg); errUpdate != nil {
+			return fmt.Errorf("could not update the queue binds. err: %v", errUpdate)
+		}
+
+		_, latest, err := h.queueGetBinds(ctx, name)
+		if err != nil {
+			return err
+		}
+		if latest == revision {
+			return nil
+		}
+	}
+}
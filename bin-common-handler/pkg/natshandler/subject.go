@@ -0,0 +1,155 @@
+package natshandler
+
+import (
+	"fmt"
+	"strings"
+
+	commonoutline "monorepo/bin-common-handler/models/outline"
+)
+
+// List of subjects
+const (
+	subjectPrefixExchange = "voipbin.ex."
+	subjectPrefixQueue    = "voipbin.q."
+	subjectPrefixRPC      = "voipbin.rpc."
+	subjectPrefixRequest  = "voipbin.rq."
+	subjectDelay          = "voipbin.delay"
+
+	// keyEmpty is the subject token of the empty routing key.
+	keyEmpty = "_"
+)
+
+// list of exchange kinds
+const (
+	exchangeKindFanout = "fanout"
+	exchangeKindDirect = "direct"
+	exchangeKindTopic  = "topic"
+)
+
+// escapeToken makes the name a single subject token. The exchange and queue names
+// contain dots, which are the subject token separators of NATS.
+func escapeToken(name string) string {
+	return strings.ReplaceAll(name, ".", "_")
+}
+
+// subjectQueue returns the subject of the queue's default exchange delivery.
+func subjectQueue(queueName string) string {
+	return subjectPrefixQueue + escapeToken(queueName)
+}
+
+// subjectRPC returns the request subject of the RPC queue.
+func subjectRPC(queueName string) string {
+	return subjectPrefixRPC + queueName
+}
+
+// subjectRequest returns the subject of the delayed request to the RPC queue.
+func subjectRequest(queueName string) string {
+	return subjectPrefixRequest + escapeToken(queueName)
+}
+
+// subjectEvent returns the subject of the event published to the exchange with the routing key.
+// The empty exchange is the default exchange, which delivers to the queue of the key.
+// The delay exchange delivers to the queue of the key, too.
+func subjectEvent(exchange string, key string) string {
+	if exchange == "" || exchange == string(commonoutline.QueueNameDelay) {
+		return subjectQueue(key)
+	}
+
+	if key == "" {
+		key = keyEmpty
+	}
+	return subjectPrefixExchange + escapeToken(exchange) + "." + key
+}
+
+// subjectsBind returns the filter subjects matching the messages routed by the bind.
+func subjectsBind(kind string, exchange string, key string) ([]string, error) {
+	prefix := subjectPrefixExchange + escapeToken(exchange) + "."
+
+	switch kind {
+	case exchangeKindFanout:
+		return []string{prefix + ">"}, nil
+
+	case exchangeKindDirect:
+		if key == "" {
+			key = keyEmpty
+		}
+		return []string{prefix + key}, nil
+
+	case exchangeKindTopic:
+		if key == "" {
+			return []string{prefix + keyEmpty}, nil
+		}
+
+		words := strings.Split(key, ".")
+		for i, w := range words {
+			if w == "#" && i != len(words)-1 {
+				return nil, fmt.Errorf("'#' is supported only as the last word of the bind key. key: %s", key)
+			}
+		}
+		if words[len(words)-1] != "#" {
+			return []string{prefix + key}, nil
+		}
+
+		// the "#" matches zero or more words, but the ">" matches one or more.
+		if len(words) == 1 {
+			return []string{prefix + ">"}, nil
+		}
+		base := strings.Join(words[:len(words)-1], ".")
+		return []string{prefix + base, prefix + base + ".>"}, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported exchange kind. kind: %s", kind)
+	}
+}
+
+// covers returns true if every subject matching the filter b matches the filter a, too.
+func covers(a string, b string) bool {
+	ta := strings.Split(a, ".")
+	tb := strings.Split(b, ".")
+
+	for i, t := range ta {
+		if t == ">" {
+			return len(tb) > i
+		}
+		if i >= len(tb) {
+			return false
+		}
+
+		switch t {
+		case "*":
+			if tb[i] == ">" {
+				return false
+			}
+		default:
+			if tb[i] != t {
+				return false
+			}
+		}
+	}
+
+	return len(ta) == len(tb)
+}
+
+// filterSubjects returns the filter subjects without the ones covered by the others.
+// The NATS consumer doesn't accept the overlapping filter subjects.
+func filterSubjects(subjects []string) []string {
+	res := []string{}
+	for i, s := range subjects {
+		covered := false
+		for j, o := range subjects {
+			if i == j {
+				continue
+			}
+			// for the identical subjects, the first one stays.
+			if covers(o, s) && (!covers(s, o) || j < i) {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			res = append(res, s)
+		}
+	}
+
+	return res
+}
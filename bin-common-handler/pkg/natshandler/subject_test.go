@@ -0,0 +1,223 @@
+package natshandler
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_subjectEvent(t *testing.T) {
+	tests := []struct {
+		name string
+
+		exchange string
+		key      string
+
+		expectRes string
+	}{
+		{
+			name: "default exchange",
+
+			exchange: "",
+			key:      "bin-manager.call-manager.request",
+
+			expectRes: "voipbin.q.bin-manager_call-manager_request",
+		},
+		{
+			name: "delay exchange",
+
+			exchange: "bin-manager.delay",
+			key:      "bin-manager.call-manager.subscribe",
+
+			expectRes: "voipbin.q.bin-manager_call-manager_subscribe",
+		},
+		{
+			name: "exchange with key",
+
+			exchange: "bin-manager.call-manager.event",
+			key:      "call.updated",
+
+			expectRes: "voipbin.ex.bin-manager_call-manager_event.call.updated",
+		},
+		{
+			name: "exchange with empty key",
+
+			exchange: "bin-manager.call-manager.event",
+			key:      "",
+
+			expectRes: "voipbin.ex.bin-manager_call-manager_event._",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := subjectEvent(tt.exchange, tt.key)
+			if res != tt.expectRes {
+				t.Errorf("Wrong match. expect: %s, got: %s", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_subjectsBind(t *testing.T) {
+	tests := []struct {
+		name string
+
+		kind     string
+		exchange string
+		key      string
+
+		expectRes []string
+	}{
+		{
+			name: "fanout ignores the key",
+
+			kind:     exchangeKindFanout,
+			exchange: "ex",
+			key:      "some.key",
+
+			expectRes: []string{"voipbin.ex.ex.>"},
+		},
+		{
+			name: "direct",
+
+			kind:     exchangeKindDirect,
+			exchange: "ex",
+			key:      "some.key",
+
+			expectRes: []string{"voipbin.ex.ex.some.key"},
+		},
+		{
+			name: "direct with empty key",
+
+			kind:     exchangeKindDirect,
+			exchange: "ex",
+			key:      "",
+
+			expectRes: []string{"voipbin.ex.ex._"},
+		},
+		{
+			name: "topic with star",
+
+			kind:     exchangeKindTopic,
+			exchange: "ex",
+			key:      "call.*",
+
+			expectRes: []string{"voipbin.ex.ex.call.*"},
+		},
+		{
+			name: "topic with hash only",
+
+			kind:     exchangeKindTopic,
+			exchange: "ex",
+			key:      "#",
+
+			expectRes: []string{"voipbin.ex.ex.>"},
+		},
+		{
+			name: "topic with trailing hash",
+
+			kind:     exchangeKindTopic,
+			exchange: "ex",
+			key:      "call.#",
+
+			expectRes: []string{"voipbin.ex.ex.call", "voipbin.ex.ex.call.>"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := subjectsBind(tt.kind, tt.exchange, tt.key)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_subjectsBind_error(t *testing.T) {
+	tests := []struct {
+		name string
+
+		kind string
+		key  string
+	}{
+		{
+			name: "hash in the middle",
+
+			kind: exchangeKindTopic,
+			key:  "call.#.updated",
+		},
+		{
+			name: "unsupported kind",
+
+			kind: "headers",
+			key:  "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := subjectsBind(tt.kind, "ex", tt.key); err == nil {
+				t.Errorf("Wrong match. expect: error, got: ok")
+			}
+		})
+	}
+}
+
+func Test_filterSubjects(t *testing.T) {
+	tests := []struct {
+		name string
+
+		subjects []string
+
+		expectRes []string
+	}{
+		{
+			name: "no overlap",
+
+			subjects: []string{"a.b", "a.c"},
+
+			expectRes: []string{"a.b", "a.c"},
+		},
+		{
+			name: "covered by the full wildcard",
+
+			subjects: []string{"a.b", "a.>", "a.c.d"},
+
+			expectRes: []string{"a.>"},
+		},
+		{
+			name: "covered by the token wildcard",
+
+			subjects: []string{"a.b", "a.*"},
+
+			expectRes: []string{"a.*"},
+		},
+		{
+			name: "the full wildcard does not cover the parent",
+
+			subjects: []string{"a", "a.>"},
+
+			expectRes: []string{"a", "a.>"},
+		},
+		{
+			name: "duplicated",
+
+			subjects: []string{"a.b", "a.b"},
+
+			expectRes: []string{"a.b"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := filterSubjects(tt.subjects)
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
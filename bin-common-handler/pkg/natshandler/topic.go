@@ -0,0 +1,70 @@
+package natshandler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// TopicCreate declares the fanout exchange.
+func (h *natsHandler) TopicCreate(name string) error {
+	if errDeclare := h.exchangeDeclare(name, exchangeKindFanout); errDeclare != nil {
+		return fmt.Errorf("could not declare the queue for event. err: %v", errDeclare)
+	}
+
+	return nil
+}
+
+// TopicCreateWithKind declares the exchange with the given kind. "fanout", "direct" and "topic" are supported.
+func (h *natsHandler) TopicCreateWithKind(name string, kind string) error {
+	if errDeclare := h.exchangeDeclare(name, kind); errDeclare != nil {
+		return fmt.Errorf("could not declare the exchange with kind %s. err: %v", kind, errDeclare)
+	}
+
+	return nil
+}
+
+// exchangeDeclare stores the exchange kind in the exchanges bucket, so the other services
+// binding their queues to the exchange know how to route.
+// It returns error if the exchange exists with a different kind.
+func (h *natsHandler) exchangeDeclare(name string, kind string) error {
+	switch kind {
+	case exchangeKindFanout, exchangeKindDirect, exchangeKindTopic:
+	default:
+		return fmt.Errorf("unsupported exchange kind. kind: %s", kind)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), defaultRequestTimeout)
+	defer cancel()
+
+	_, err := h.kv.Create(ctx, name, []byte(kind))
+	if err == nil {
+		return nil
+	} else if !errors.Is(err, jetstream.ErrKeyExists) {
+		return err
+	}
+
+	tmp, err := h.exchangeKind(ctx, name)
+	if err != nil {
+		return err
+	}
+	if tmp != kind {
+		return fmt.Errorf("exchange exists with a different kind. name: %s, kind: %s", name, tmp)
+	}
+
+	return nil
+}
+
+// exchangeKind returns the kind of the declared exchange.
+func (h *natsHandler) exchangeKind(ctx context.Context, name string) (string, error) {
+	entry, err := h.kv.Get(ctx, name)
+	if errors.Is(err, jetstream.ErrKeyNotFound) {
+		return "", fmt.Errorf("no exchange found. exchange: %s", name)
+	} else if err != nil {
+		return "", err
+	}
+
+	return string(entry.Value()), nil
+}
@@ -13,6 +13,7 @@ import (
 
 	commonoutline "monorepo/bin-common-handler/models/outline"
 	"monorepo/bin-common-handler/models/sock"
+	"monorepo/bin-common-handler/pkg/tracehandler"
 )
 
 // PublishWebhookEvent publishs the given event type of notification to the webhook and event queue.
@@ -59,7 +60,7 @@ func (h *notifyHandler) PublishEventRaw(ctx context.Context, eventType string, d
 		"data_type":  dataType,
 	})
 
-	if err := h.publishEvent(eventType, dataType, data, requestTimeoutDefault, 0); err != nil {
+	if err := h.publishEvent(ctx, eventType, dataType, data, requestTimeoutDefault, 0); err != nil {
 		log.Errorf("Could not publish the call event. err: %v", err)
 		return
 	}
@@ -79,14 +80,15 @@ func (h *notifyHandler) PublishEvent(ctx context.Context, eventType string, data
 		return
 	}
 
-	if err := h.publishEvent(string(eventType), string(wmwebhook.DataTypeJSON), m, requestTimeoutDefault, 0); err != nil {
+	if err := h.publishEvent(ctx, string(eventType), string(wmwebhook.DataTypeJSON), m, requestTimeoutDefault, 0); err != nil {
 		log.Errorf("Could not publish the call event. err: %v", err)
 		return
 	}
 }
 
 // publishEvent publishes a event to the event queue.
-func (h *notifyHandler) publishEvent(eventType string, dataType string, data json.RawMessage, timeout int, delay int) error {
+// The given context is used only as the parent of the event's trace span.
+func (h *notifyHandler) publishEvent(parent context.Context, eventType string, dataType string, data json.RawMessage, timeout int, delay int) error {
 
 	// create a event
 	evt := &sock.Event{
@@ -96,6 +98,9 @@ func (h *notifyHandler) publishEvent(eventType string, dataType string, data jso
 		Data:      data,
 	}
 
+	_, span := tracehandler.StartProducerSpan(parent, string(h.queueNotify), evt)
+	defer span.End()
+
 	// Note: Using context.Background() intentionally. Events are fire-and-forget notifications
 	// that should complete independently of the caller's context lifecycle. The timeout provides
 	// its own cancellation mechanism.
@@ -146,6 +151,9 @@ func (h *notifyHandler) PublishEventWithRoutingKey(ctx context.Context, eventTyp
 		Data:      m,
 	}
 
+	_, span := tracehandler.StartProducerSpan(ctx, string(h.queueNotify), evt)
+	defer span.End()
+
 	// Note: Using context.Background() intentionally, matching publishEvent's existing
 	// fire-and-forget rationale -- this event should complete independently of the caller's
 	// context lifecycle.
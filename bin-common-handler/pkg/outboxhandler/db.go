@@ -0,0 +1,156 @@
+package outboxhandler
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/gofrs/uuid"
+
+	"monorepo/bin-common-handler/models/outbox"
+	commondatabasehandler "monorepo/bin-common-handler/pkg/databasehandler"
+)
+
+// eventTXCreate inserts the outbox event within the given transaction.
+func (h *outboxHandler) eventTXCreate(tx *sql.Tx, e *outbox.Event) error {
+	e.RetryCount = 0
+	e.TMLease = nil
+	e.TMPublish = nil
+	e.TMCreate = h.utilHandler.TimeNow()
+
+	fields, err := commondatabasehandler.PrepareFields(e)
+	if err != nil {
+		return fmt.Errorf("could not prepare fields. eventTXCreate. err: %v", err)
+	}
+
+	query, args, err := squirrel.
+		Insert(h.table).
+		SetMap(fields).
+		PlaceholderFormat(squirrel.Question).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("could not build query. eventTXCreate. err: %v", err)
+	}
+
+	if _, err := tx.Exec(query, args...); err != nil {
+		return fmt.Errorf("could not execute query. eventTXCreate. err: %v", err)
+	}
+
+	return nil
+}
+
+// eventListPending returns the unpublished events which are not leased by the other relays.
+// The events are returned in the creation order.
+func (h *outboxHandler) eventListPending(ctx context.Context, now time.Time, size uint64) ([]*outbox.Event, error) {
+	fields := commondatabasehandler.GetDBFields(&outbox.Event{})
+	query, args, err := squirrel.
+		Select(fields...).
+		From(h.table).
+		Where(squirrel.Eq{string(outbox.FieldTMPublish): nil}).
+		Where(squirrel.Or{
+			squirrel.Eq{string(outbox.FieldTMLease): nil},
+			squirrel.Lt{string(outbox.FieldTMLease): now},
+		}).
+		OrderBy(string(outbox.FieldTMCreate) + " asc").
+		Limit(size).
+		PlaceholderFormat(squirrel.Question).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("could not build query. eventListPending. err: %v", err)
+	}
+
+	rows, err := h.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("could not query. eventListPending. err: %v", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	res := []*outbox.Event{}
+	for rows.Next() {
+		e := &outbox.Event{}
+		if err := commondatabasehandler.ScanRow(rows, e); err != nil {
+			return nil, fmt.Errorf("could not scan the row. eventListPending. err: %v", err)
+		}
+		res = append(res, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error. eventListPending. err: %v", err)
+	}
+
+	return res, nil
+}
+
+// eventClaim leases the event to this relay until the given lease time.
+// It returns false if the event was published or claimed by another relay in the meantime.
+func (h *outboxHandler) eventClaim(ctx context.Context, id uuid.UUID, now time.Time, lease time.Time) (bool, error) {
+	query, args, err := squirrel.
+		Update(h.table).
+		Set(string(outbox.FieldTMLease), lease).
+		Set(string(outbox.FieldRetryCount), squirrel.Expr(string(outbox.FieldRetryCount)+" + 1")).
+		Where(squirrel.Eq{string(outbox.FieldID): id.Bytes()}).
+		Where(squirrel.Eq{string(outbox.FieldTMPublish): nil}).
+		Where(squirrel.Or{
+			squirrel.Eq{string(outbox.FieldTMLease): nil},
+			squirrel.Lt{string(outbox.FieldTMLease): now},
+		}).
+		PlaceholderFormat(squirrel.Question).
+		ToSql()
+	if err != nil {
+		return false, fmt.Errorf("could not build query. eventClaim. err: %v", err)
+	}
+
+	res, err := h.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return false, fmt.Errorf("could not execute query. eventClaim. err: %v", err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("could not get affected rows. eventClaim. err: %v", err)
+	}
+
+	return affected == 1, nil
+}
+
+// eventSetPublished marks the event as published.
+func (h *outboxHandler) eventSetPublished(ctx context.Context, id uuid.UUID, tmPublish time.Time) error {
+	query, args, err := squirrel.
+		Update(h.table).
+		Set(string(outbox.FieldTMPublish), tmPublish).
+		Where(squirrel.Eq{string(outbox.FieldID): id.Bytes()}).
+		PlaceholderFormat(squirrel.Question).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("could not build query. eventSetPublished. err: %v", err)
+	}
+
+	if _, err := h.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("could not execute query. eventSetPublished. err: %v", err)
+	}
+
+	return nil
+}
+
+// eventDeletePublished deletes the events published before the given time.
+func (h *outboxHandler) eventDeletePublished(ctx context.Context, before time.Time) (int64, error) {
+	query, args, err := squirrel.
+		Delete(h.table).
+		Where(squirrel.NotEq{string(outbox.FieldTMPublish): nil}).
+		Where(squirrel.Lt{string(outbox.FieldTMPublish): before}).
+		PlaceholderFormat(squirrel.Question).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("could not build query. eventDeletePublished. err: %v", err)
+	}
+
+	res, err := h.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("could not execute query. eventDeletePublished. err: %v", err)
+	}
+
+	return res.RowsAffected()
+}
@@ -0,0 +1,129 @@
+package outboxhandler
+
+//go:generate mockgen -package outboxhandler -destination ./mock_main.go -source main.go -build_flags=-mod=mod
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+
+	commonoutline "monorepo/bin-common-handler/models/outline"
+	"monorepo/bin-common-handler/pkg/notifyhandler"
+	"monorepo/bin-common-handler/pkg/requesthandler"
+	"monorepo/bin-common-handler/pkg/sockhandler"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+)
+
+// OutboxHandler implements the transactional outbox.
+//
+// The TXPublish* functions store the event in the caller's transaction, so the
+// event exists if and only if the state change it describes was committed.
+// Run starts the relay, which publishes the stored events to the event exchange
+// and the webhook-manager with at-least-once delivery. Consumers must tolerate
+// duplicates.
+type OutboxHandler interface {
+	TXPublishEvent(ctx context.Context, tx *sql.Tx, eventType string, data interface{}) error
+	TXPublishWebhookEvent(ctx context.Context, tx *sql.Tx, customerID uuid.UUID, eventType string, data notifyhandler.WebhookMessage) error
+
+	Run(ctx context.Context)
+	Relay(ctx context.Context) (int, error)
+}
+
+type outboxHandler struct {
+	utilHandler utilhandler.UtilHandler
+	sockHandler sockhandler.SockHandler
+	reqHandler  requesthandler.RequestHandler
+	db          *sql.DB
+
+	table       string
+	queueNotify commonoutline.QueueName
+	publisher   commonoutline.ServiceName
+}
+
+// List of default variables
+const (
+	defaultRelayInterval   = time.Second      // interval between the relay runs
+	defaultRelayBatchSize  = uint64(100)      // max number of events for one relay run
+	defaultLeaseTimeout    = time.Second * 30 // an event claimed by a relay is not picked up again before this timeout
+	defaultRetention       = time.Hour * 24   // published events are kept for this duration
+	defaultCleanupInterval = time.Minute * 10 // interval between the published event cleanups
+	defaultPublishTimeout  = time.Second * 3  // timeout for publishing one event
+)
+
+// list of prometheus metrics
+var (
+	promOutboxRelayTotal   *prometheus.CounterVec
+	promOutboxRelayLatency *prometheus.HistogramVec
+
+	// initPrometheusMu/initPrometheusDone guard against duplicate MustRegister panics,
+	// same as notifyhandler.
+	initPrometheusMu   sync.Mutex
+	initPrometheusDone = map[string]bool{}
+)
+
+func initPrometheus(namespace string) {
+	initPrometheusMu.Lock()
+	defer initPrometheusMu.Unlock()
+
+	if initPrometheusDone[namespace] {
+		return
+	}
+	initPrometheusDone[namespace] = true
+
+	promOutboxRelayTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "outbox_relay_total",
+			Help:      "Total number of outbox event relay attempts by result.",
+		},
+		[]string{"type", "result"},
+	)
+
+	promOutboxRelayLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "outbox_relay_latency_seconds",
+			Help:      "Time between the outbox event creation and its publish.",
+			Buckets:   []float64{0.1, 0.5, 1, 5, 30, 60, 300, 3600},
+		},
+		[]string{"type"},
+	)
+
+	prometheus.MustRegister(
+		promOutboxRelayTotal,
+		promOutboxRelayLatency,
+	)
+}
+
+// NewOutboxHandler creates OutboxHandler
+// table: outbox table name of the service. i.e. call_outbox_events.
+// queueEvent: event exchange name. the relay publishes the events to this exchange.
+// publisher: publisher service name of the events.
+func NewOutboxHandler(
+	db *sql.DB,
+	sockHandler sockhandler.SockHandler,
+	reqHandler requesthandler.RequestHandler,
+	table string,
+	queueEvent commonoutline.QueueName,
+	publisher commonoutline.ServiceName,
+) OutboxHandler {
+	h := &outboxHandler{
+		utilHandler: utilhandler.NewUtilHandler(),
+		sockHandler: sockHandler,
+		reqHandler:  reqHandler,
+		db:          db,
+
+		table:       table,
+		queueNotify: queueEvent,
+		publisher:   publisher,
+	}
+
+	namespace := commonoutline.GetMetricNameSpace(publisher)
+	initPrometheus(namespace)
+
+	return h
+}
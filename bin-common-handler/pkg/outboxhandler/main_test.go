@@ -0,0 +1,71 @@
+package outboxhandler
+
+import (
+	"database/sql"
+	"encoding/json"
+	"os"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/sirupsen/logrus"
+
+	commonoutline "monorepo/bin-common-handler/models/outline"
+)
+
+var dbTest *sql.DB = nil // database for test
+
+const (
+	testTable     = "test_outbox_events"
+	testPublisher = commonoutline.ServiceName("test-manager")
+)
+
+const testSchema = `
+create table test_outbox_events(
+  id            binary(16),
+  customer_id   binary(16),
+
+  event_type    varchar(255),
+  data_type     varchar(255),
+  data          text,
+  webhook_data  text,
+  trace_context text,
+
+  retry_count   integer default 0,
+
+  tm_lease      datetime(6),
+  tm_publish    datetime(6),
+  tm_create     datetime(6),
+
+  primary key(id)
+);
+`
+
+type testEvent struct {
+	Name   string `json:"name"`
+	Detail string `json:"detail"`
+}
+
+func (e *testEvent) CreateWebhookEvent() ([]byte, error) {
+	return json.Marshal(map[string]string{"name": e.Name})
+}
+
+func TestMain(m *testing.M) {
+	db, err := sql.Open("sqlite3", `file::memory:?cache=shared`)
+	if err != nil {
+		logrus.Errorf("err: %v", err)
+	}
+	db.SetMaxOpenConns(1)
+
+	if _, err := db.Exec(testSchema); err != nil {
+		logrus.Errorf("Could not execute the sql. err: %v", err)
+	}
+
+	initPrometheus(commonoutline.GetMetricNameSpace(testPublisher))
+
+	dbTest = db
+	defer func() {
+		_ = dbTest.Close()
+	}()
+
+	os.Exit(m.Run())
+}
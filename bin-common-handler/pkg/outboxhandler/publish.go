@@ -0,0 +1,69 @@
+package outboxhandler
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/gofrs/uuid"
+
+	"monorepo/bin-common-handler/models/outbox"
+	"monorepo/bin-common-handler/pkg/notifyhandler"
+	"monorepo/bin-common-handler/pkg/tracehandler"
+	wmwebhook "monorepo/bin-webhook-manager/models/webhook"
+)
+
+// TXPublishEvent stores the event in the outbox within the given transaction.
+// The event is published to the event exchange after the transaction commits.
+// The trace context of the given context is stored with the event.
+func (h *outboxHandler) TXPublishEvent(ctx context.Context, tx *sql.Tx, eventType string, data interface{}) error {
+	m, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("could not marshal the event. err: %v", err)
+	}
+
+	e := &outbox.Event{
+		ID:        h.utilHandler.UUIDCreate(),
+		EventType: eventType,
+		DataType:  string(wmwebhook.DataTypeJSON),
+		Data:      string(m),
+
+		TraceContext: tracehandler.TraceContext(ctx),
+	}
+
+	return h.eventTXCreate(tx, e)
+}
+
+// TXPublishWebhookEvent stores the event in the outbox within the given transaction.
+// The event is published to the event exchange and to the customer's webhook
+// after the transaction commits. This is the transactional counterpart of
+// notifyhandler's PublishWebhookEvent.
+func (h *outboxHandler) TXPublishWebhookEvent(ctx context.Context, tx *sql.Tx, customerID uuid.UUID, eventType string, data notifyhandler.WebhookMessage) error {
+	m, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("could not marshal the event. err: %v", err)
+	}
+
+	webhookData := ""
+	if customerID != uuid.Nil {
+		tmp, err := data.CreateWebhookEvent()
+		if err != nil {
+			return fmt.Errorf("could not create the webhook event. err: %v", err)
+		}
+		webhookData = string(tmp)
+	}
+
+	e := &outbox.Event{
+		ID:          h.utilHandler.UUIDCreate(),
+		CustomerID:  customerID,
+		EventType:   eventType,
+		DataType:    string(wmwebhook.DataTypeJSON),
+		Data:        string(m),
+		WebhookData: webhookData,
+
+		TraceContext: tracehandler.TraceContext(ctx),
+	}
+
+	return h.eventTXCreate(tx, e)
+}
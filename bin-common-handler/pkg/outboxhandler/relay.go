@@ -0,0 +1,152 @@
+package outboxhandler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+
+	"monorepo/bin-common-handler/models/outbox"
+	"monorepo/bin-common-handler/models/sock"
+	"monorepo/bin-common-handler/pkg/tracehandler"
+	wmwebhook "monorepo/bin-webhook-manager/models/webhook"
+)
+
+// Run starts the relay loop in the background. The loop stops when the given context is canceled.
+// Every service replica may run the relay. The events are leased before publishing,
+// so the replicas don't publish the same event concurrently.
+func (h *outboxHandler) Run(ctx context.Context) {
+	go h.runRelay(ctx)
+}
+
+// runRelay relays the pending events periodically and cleans up the published ones.
+func (h *outboxHandler) runRelay(ctx context.Context) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":  "runRelay",
+		"table": h.table,
+	})
+	log.Debugf("Starting the outbox relay.")
+
+	ticker := time.NewTicker(defaultRelayInterval)
+	defer ticker.Stop()
+
+	tmCleanup := time.Now()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debugf("Stopping the outbox relay.")
+			return
+
+		case <-ticker.C:
+			// relay until the pending events are drained
+			for {
+				count, err := h.Relay(ctx)
+				if err != nil {
+					log.Errorf("Could not relay the outbox events. err: %v", err)
+					break
+				}
+				if count < int(defaultRelayBatchSize) {
+					break
+				}
+			}
+
+			if time.Since(tmCleanup) < defaultCleanupInterval {
+				continue
+			}
+			tmCleanup = time.Now()
+
+			deleted, err := h.eventDeletePublished(ctx, time.Now().UTC().Add(-defaultRetention))
+			if err != nil {
+				log.Errorf("Could not clean up the published outbox events. err: %v", err)
+				continue
+			}
+			log.Debugf("Cleaned up the published outbox events. deleted: %d", deleted)
+		}
+	}
+}
+
+// Relay publishes one batch of the pending outbox events and returns the number of the published events.
+// An event which could not be published stays pending and is retried after its lease expires.
+func (h *outboxHandler) Relay(ctx context.Context) (int, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":  "Relay",
+		"table": h.table,
+	})
+
+	now := time.Now().UTC()
+	events, err := h.eventListPending(ctx, now, defaultRelayBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("could not get pending events. err: %v", err)
+	}
+
+	res := 0
+	for _, e := range events {
+		claimed, err := h.eventClaim(ctx, e.ID, now, now.Add(defaultLeaseTimeout))
+		if err != nil {
+			log.Errorf("Could not claim the outbox event. event_id: %s, err: %v", e.ID, err)
+			continue
+		}
+		if !claimed {
+			// the other relay took it
+			continue
+		}
+
+		if errPublish := h.publish(ctx, e); errPublish != nil {
+			log.Errorf("Could not publish the outbox event. event_id: %s, event_type: %s, retry_count: %d, err: %v", e.ID, e.EventType, e.RetryCount+1, errPublish)
+			promOutboxRelayTotal.WithLabelValues(e.EventType, "failure").Inc()
+			continue
+		}
+
+		tmPublish := time.Now().UTC()
+		if errSet := h.eventSetPublished(ctx, e.ID, tmPublish); errSet != nil {
+			// the event will be published again after the lease expires.
+			log.Errorf("Could not mark the outbox event as published. event_id: %s, err: %v", e.ID, errSet)
+			continue
+		}
+
+		promOutboxRelayTotal.WithLabelValues(e.EventType, "success").Inc()
+		if e.TMCreate != nil {
+			promOutboxRelayLatency.WithLabelValues(e.EventType).Observe(tmPublish.Sub(*e.TMCreate).Seconds())
+		}
+		res++
+	}
+
+	return res, nil
+}
+
+// publish publishes the outbox event to the event exchange and, if it has one, to the customer's webhook.
+// The publish continues the trace of the producer which stored the event.
+func (h *outboxHandler) publish(ctx context.Context, e *outbox.Event) error {
+	ctx, cancel := context.WithTimeout(ctx, defaultPublishTimeout)
+	defer cancel()
+
+	evt := &sock.Event{
+		Type:         e.EventType,
+		Publisher:    string(h.publisher),
+		DataType:     e.DataType,
+		Data:         []byte(e.Data),
+		TraceContext: e.TraceContext,
+	}
+
+	ctx = tracehandler.ContextWithTraceContext(ctx, e.TraceContext)
+	ctx, span := tracehandler.StartProducerSpan(ctx, string(h.queueNotify), evt)
+	defer span.End()
+
+	if err := h.sockHandler.EventPublish(string(h.queueNotify), "", evt); err != nil {
+		tracehandler.RecordError(span, err)
+		return fmt.Errorf("could not publish the event. err: %v", err)
+	}
+
+	if e.CustomerID == uuid.Nil || e.WebhookData == "" {
+		return nil
+	}
+
+	if err := h.reqHandler.WebhookV1WebhookSend(ctx, e.CustomerID, wmwebhook.DataTypeJSON, e.EventType, []byte(e.WebhookData)); err != nil {
+		tracehandler.RecordError(span, err)
+		return fmt.Errorf("could not publish the webhook. err: %v", err)
+	}
+
+	return nil
+}
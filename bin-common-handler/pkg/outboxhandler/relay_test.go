@@ -0,0 +1,258 @@
+package outboxhandler
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	"monorepo/bin-common-handler/models/outbox"
+	commonoutline "monorepo/bin-common-handler/models/outline"
+	"monorepo/bin-common-handler/models/sock"
+	"monorepo/bin-common-handler/pkg/requesthandler"
+	"monorepo/bin-common-handler/pkg/sockhandler"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+	wmwebhook "monorepo/bin-webhook-manager/models/webhook"
+)
+
+func newTestHandler(mockSock sockhandler.SockHandler, mockReq requesthandler.RequestHandler) *outboxHandler {
+	return &outboxHandler{
+		utilHandler: utilhandler.NewUtilHandler(),
+		sockHandler: mockSock,
+		reqHandler:  mockReq,
+		db:          dbTest,
+
+		table:       testTable,
+		queueNotify: commonoutline.QueueNameCallEvent,
+		publisher:   testPublisher,
+	}
+}
+
+func Test_Relay(t *testing.T) {
+
+	tests := []struct {
+		name       string
+		customerID uuid.UUID
+		eventType  string
+		event      *testEvent
+
+		expectEvent   *sock.Event
+		expectWebhook []byte
+	}{
+		{
+			name:       "webhook event",
+			customerID: uuid.FromStringOrNil("5cd2f6a6-ad8c-11f0-9b1e-3b6e8c0f6d41"),
+			eventType:  "test_hungup",
+			event: &testEvent{
+				Name:   "test name",
+				Detail: "test detail",
+			},
+
+			expectEvent: &sock.Event{
+				Type:      "test_hungup",
+				Publisher: string(testPublisher),
+				DataType:  string(wmwebhook.DataTypeJSON),
+				Data:      []byte(`{"name":"test name","detail":"test detail"}`),
+			},
+			expectWebhook: []byte(`{"name":"test name"}`),
+		},
+		{
+			name:       "customer id is empty",
+			customerID: uuid.Nil,
+			eventType:  "test_hungup",
+			event: &testEvent{
+				Name:   "test name",
+				Detail: "test detail",
+			},
+
+			expectEvent: &sock.Event{
+				Type:      "test_hungup",
+				Publisher: string(testPublisher),
+				DataType:  string(wmwebhook.DataTypeJSON),
+				Data:      []byte(`{"name":"test name","detail":"test detail"}`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			h := newTestHandler(mockSock, mockReq)
+			ctx := context.Background()
+			_, _ = dbTest.Exec("delete from " + testTable)
+
+			tx, err := dbTest.Begin()
+			if err != nil {
+				t.Fatalf("Could not begin the transaction. err: %v", err)
+			}
+			if errPublish := h.TXPublishWebhookEvent(ctx, tx, tt.customerID, tt.eventType, tt.event); errPublish != nil {
+				t.Fatalf("Wrong match. expect: ok, got: %v", errPublish)
+			}
+			if errCommit := tx.Commit(); errCommit != nil {
+				t.Fatalf("Could not commit the transaction. err: %v", errCommit)
+			}
+
+			mockSock.EXPECT().EventPublish(string(commonoutline.QueueNameCallEvent), "", tt.expectEvent).Return(nil)
+			if tt.customerID != uuid.Nil {
+				mockReq.EXPECT().WebhookV1WebhookSend(gomock.Any(), tt.customerID, wmwebhook.DataTypeJSON, tt.eventType, tt.expectWebhook).Return(nil)
+			}
+
+			res, err := h.Relay(ctx)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+			if res != 1 {
+				t.Errorf("Wrong match. expect: 1, got: %d", res)
+			}
+
+			// published events are not relayed again
+			res, err = h.Relay(ctx)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+			if res != 0 {
+				t.Errorf("Wrong match. expect: 0, got: %d", res)
+			}
+		})
+	}
+}
+
+func Test_Relay_rollback(t *testing.T) {
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockSock := sockhandler.NewMockSockHandler(mc)
+	mockReq := requesthandler.NewMockRequestHandler(mc)
+	h := newTestHandler(mockSock, mockReq)
+	ctx := context.Background()
+	_, _ = dbTest.Exec("delete from " + testTable)
+
+	tx, err := dbTest.Begin()
+	if err != nil {
+		t.Fatalf("Could not begin the transaction. err: %v", err)
+	}
+	if errPublish := h.TXPublishEvent(ctx, tx, "test_created", &testEvent{Name: "test name"}); errPublish != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", errPublish)
+	}
+	_ = tx.Rollback()
+
+	// no publish expected. the event was rolled back with the transaction.
+	res, err := h.Relay(ctx)
+	if err != nil {
+		t.Errorf("Wrong match. expect: ok, got: %v", err)
+	}
+	if res != 0 {
+		t.Errorf("Wrong match. expect: 0, got: %d", res)
+	}
+}
+
+func Test_Relay_publishFailure(t *testing.T) {
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockSock := sockhandler.NewMockSockHandler(mc)
+	mockReq := requesthandler.NewMockRequestHandler(mc)
+	h := newTestHandler(mockSock, mockReq)
+	ctx := context.Background()
+	_, _ = dbTest.Exec("delete from " + testTable)
+
+	tx, err := dbTest.Begin()
+	if err != nil {
+		t.Fatalf("Could not begin the transaction. err: %v", err)
+	}
+	if errPublish := h.TXPublishEvent(ctx, tx, "test_created", &testEvent{Name: "test name"}); errPublish != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", errPublish)
+	}
+	if errCommit := tx.Commit(); errCommit != nil {
+		t.Fatalf("Could not commit the transaction. err: %v", errCommit)
+	}
+
+	mockSock.EXPECT().EventPublish(string(commonoutline.QueueNameCallEvent), "", gomock.Any()).Return(fmt.Errorf("broker is down"))
+	res, err := h.Relay(ctx)
+	if err != nil {
+		t.Errorf("Wrong match. expect: ok, got: %v", err)
+	}
+	if res != 0 {
+		t.Errorf("Wrong match. expect: 0, got: %d", res)
+	}
+
+	// the event is leased. the next relay run must not pick it up before the lease expires.
+	res, err = h.Relay(ctx)
+	if err != nil {
+		t.Errorf("Wrong match. expect: ok, got: %v", err)
+	}
+	if res != 0 {
+		t.Errorf("Wrong match. expect: 0, got: %d", res)
+	}
+
+	// after the lease expiry the event is pending again.
+	events, err := h.eventListPending(ctx, time.Now().UTC().Add(defaultLeaseTimeout+time.Second), defaultRelayBatchSize)
+	if err != nil {
+		t.Errorf("Wrong match. expect: ok, got: %v", err)
+	}
+	if len(events) != 1 {
+		t.Fatalf("Wrong match. expect: 1, got: %d", len(events))
+	}
+	if events[0].RetryCount != 1 {
+		t.Errorf("Wrong match. expect: 1, got: %d", events[0].RetryCount)
+	}
+	if events[0].TMPublish != nil {
+		t.Errorf("Wrong match. expect: nil, got: %v", events[0].TMPublish)
+	}
+}
+
+func Test_Relay_traceContext(t *testing.T) {
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockSock := sockhandler.NewMockSockHandler(mc)
+	mockReq := requesthandler.NewMockRequestHandler(mc)
+	h := newTestHandler(mockSock, mockReq)
+	ctx := context.Background()
+	_, _ = dbTest.Exec("delete from " + testTable)
+
+	traceContext := map[string]string{
+		"traceparent": "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+	}
+
+	tx, err := dbTest.Begin()
+	if err != nil {
+		t.Fatalf("Could not begin the transaction. err: %v", err)
+	}
+	if errCreate := h.eventTXCreate(tx, &outbox.Event{
+		ID:           uuid.FromStringOrNil("8e0c2a3e-ad8d-11f0-b0a5-7f4d1c2e3b41"),
+		EventType:    "test_created",
+		DataType:     string(wmwebhook.DataTypeJSON),
+		Data:         `{"name":"test name"}`,
+		TraceContext: traceContext,
+	}); errCreate != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", errCreate)
+	}
+	if errCommit := tx.Commit(); errCommit != nil {
+		t.Fatalf("Could not commit the transaction. err: %v", errCommit)
+	}
+
+	// the tracing is not configured in the test, so the stored trace context is passed through as it is.
+	mockSock.EXPECT().EventPublish(string(commonoutline.QueueNameCallEvent), "", &sock.Event{
+		Type:         "test_created",
+		Publisher:    string(testPublisher),
+		DataType:     string(wmwebhook.DataTypeJSON),
+		Data:         []byte(`{"name":"test name"}`),
+		TraceContext: traceContext,
+	}).Return(nil)
+
+	res, err := h.Relay(ctx)
+	if err != nil {
+		t.Errorf("Wrong match. expect: ok, got: %v", err)
+	}
+	if res != 1 {
+		t.Errorf("Wrong match. expect: 1, got: %d", res)
+	}
+}
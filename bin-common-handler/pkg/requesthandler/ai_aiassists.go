@@ -0,0 +1,139 @@
+package requesthandler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	amaiassist "monorepo/bin-ai-manager/models/aiassist"
+	amrequest "monorepo/bin-ai-manager/pkg/listenhandler/models/request"
+	"monorepo/bin-common-handler/models/sock"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+)
+
+// AIV1AIAssistList sends a request to ai-manager
+// to getting a list of aiassists info.
+// it returns detail list of aiassists info if it succeed.
+func (r *requestHandler) AIV1AIAssistList(ctx context.Context, pageToken string, pageSize uint64, filters map[amaiassist.Field]any) ([]amaiassist.AIAssist, error) {
+	uri := fmt.Sprintf("/v1/aiassists?page_token=%s&page_size=%d", url.QueryEscape(pageToken), pageSize)
+
+	m, err := json.Marshal(filters)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not marshal filters")
+	}
+
+	tmp, err := r.sendRequestAI(ctx, uri, sock.RequestMethodGet, "ai/aiassists", requestTimeoutDefault, 0, ContentTypeJSON, m)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []amaiassist.AIAssist
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return res, nil
+}
+
+// AIV1AIAssistStart sends a request to ai-manager
+// to starting an aiassist.
+// it returns started aiassist if it succeed.
+func (r *requestHandler) AIV1AIAssistStart(
+	ctx context.Context,
+	customerID uuid.UUID,
+	agentID uuid.UUID,
+	aiID uuid.UUID,
+	referenceType amaiassist.ReferenceType,
+	referenceID uuid.UUID,
+	language string,
+	checklist []amaiassist.ChecklistItem,
+) (*amaiassist.AIAssist, error) {
+	uri := "/v1/aiassists"
+
+	data := &amrequest.V1DataAIAssistsPost{
+		CustomerID: customerID,
+		AgentID:    agentID,
+		AIID:       aiID,
+
+		ReferenceType: referenceType,
+		ReferenceID:   referenceID,
+
+		Language:  language,
+		Checklist: checklist,
+	}
+
+	m, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	tmp, err := r.sendRequestAI(ctx, uri, sock.RequestMethodPost, "ai/aiassists", requestTimeoutDefault, 0, ContentTypeJSON, m)
+	if err != nil {
+		return nil, err
+	}
+
+	var res amaiassist.AIAssist
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return &res, nil
+}
+
+// AIV1AIAssistGet returns the aiassist.
+func (r *requestHandler) AIV1AIAssistGet(ctx context.Context, aiassistID uuid.UUID) (*amaiassist.AIAssist, error) {
+	uri := fmt.Sprintf("/v1/aiassists/%s", aiassistID.String())
+
+	tmp, err := r.sendRequestAI(ctx, uri, sock.RequestMethodGet, "ai/aiassists/<aiassist-id>", requestTimeoutDefault, 0, ContentTypeNone, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res amaiassist.AIAssist
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return &res, nil
+}
+
+// AIV1AIAssistDelete sends a request to ai-manager
+// to deleting an aiassist.
+// it returns deleted aiassist if it succeed.
+func (r *requestHandler) AIV1AIAssistDelete(ctx context.Context, aiassistID uuid.UUID) (*amaiassist.AIAssist, error) {
+	uri := fmt.Sprintf("/v1/aiassists/%s", aiassistID)
+
+	tmp, err := r.sendRequestAI(ctx, uri, sock.RequestMethodDelete, "ai/aiassists/<aiassist-id>", requestTimeoutDefault, 0, ContentTypeNone, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res amaiassist.AIAssist
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return &res, nil
+}
+
+// AIV1AIAssistStop sends a request to ai-manager
+// to stopping an aiassist.
+// it returns stopped aiassist if it succeed.
+func (r *requestHandler) AIV1AIAssistStop(ctx context.Context, aiassistID uuid.UUID) (*amaiassist.AIAssist, error) {
+	uri := fmt.Sprintf("/v1/aiassists/%s/stop", aiassistID)
+
+	tmp, err := r.sendRequestAI(ctx, uri, sock.RequestMethodPost, "ai/aiassists/<aiassist-id>/stop", requestTimeoutDefault, 0, ContentTypeNone, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res amaiassist.AIAssist
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return &res, nil
+}
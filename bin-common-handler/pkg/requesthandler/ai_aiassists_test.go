@@ -0,0 +1,249 @@
+package requesthandler
+
+import (
+	"context"
+	amaiassist "monorepo/bin-ai-manager/models/aiassist"
+	"monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/models/outline"
+	"monorepo/bin-common-handler/models/sock"
+	"monorepo/bin-common-handler/pkg/sockhandler"
+	"reflect"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_AIV1AIAssistList(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		pageToken string
+		pageSize  uint64
+		filters   map[amaiassist.Field]any
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+		expectRes     []amaiassist.AIAssist
+	}{
+		{
+			name: "normal",
+
+			pageToken: "2020-09-20T03:23:20.995000Z",
+			pageSize:  10,
+			filters: map[amaiassist.Field]any{
+				amaiassist.FieldDeleted:    false,
+				amaiassist.FieldCustomerID: uuid.FromStringOrNil("b20c6e4a-b1fc-11f0-8d3f-2e6b1a9c4d01"),
+			},
+
+			response: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`[{"id":"b2379a64-b1fc-11f0-a540-5f1c3d8e2b02","status":"progressing"}]`),
+			},
+
+			expectTarget: string(outline.QueueNameAIRequest),
+			expectRequest: &sock.Request{
+				URI:      "/v1/aiassists?page_token=2020-09-20T03%3A23%3A20.995000Z&page_size=10",
+				Method:   sock.RequestMethodGet,
+				DataType: "application/json",
+				Data:     []byte(`{"customer_id":"b20c6e4a-b1fc-11f0-8d3f-2e6b1a9c4d01","deleted":false}`),
+			},
+			expectRes: []amaiassist.AIAssist{
+				{
+					Identity: identity.Identity{
+						ID: uuid.FromStringOrNil("b2379a64-b1fc-11f0-a540-5f1c3d8e2b02"),
+					},
+					Status: amaiassist.StatusProgressing,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			h := requestHandler{
+				sock: mockSock,
+			}
+			ctx := context.Background()
+
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, err := h.AIV1AIAssistList(ctx, tt.pageToken, tt.pageSize, tt.filters)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(tt.expectRes, res) == false {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v\n", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_AIV1AIAssistStart(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		customerID    uuid.UUID
+		agentID       uuid.UUID
+		aiID          uuid.UUID
+		referenceType amaiassist.ReferenceType
+		referenceID   uuid.UUID
+		language      string
+		checklist     []amaiassist.ChecklistItem
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+		expectRes     *amaiassist.AIAssist
+	}{
+		{
+			name: "normal",
+
+			customerID:    uuid.FromStringOrNil("b2625c7e-b1fc-11f0-9b51-1d4e7a2f3c03"),
+			agentID:       uuid.FromStringOrNil("b28d1e98-b1fc-11f0-8e62-3a5f9c1b4d04"),
+			aiID:          uuid.FromStringOrNil("b2b7e0b2-b1fc-11f0-a773-6c2e8d3a5e05"),
+			referenceType: amaiassist.ReferenceTypeCall,
+			referenceID:   uuid.FromStringOrNil("b2e2a2cc-b1fc-11f0-9884-4b1f7e2c6f06"),
+			language:      "en-US",
+			checklist: []amaiassist.ChecklistItem{
+				{Name: "verify identity"},
+			},
+
+			response: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"b30d64e6-b1fc-11f0-8995-2e3a6d9b7a07"}`),
+			},
+
+			expectTarget: string(outline.QueueNameAIRequest),
+			expectRequest: &sock.Request{
+				URI:      "/v1/aiassists",
+				Method:   sock.RequestMethodPost,
+				DataType: "application/json",
+				Data:     []byte(`{"customer_id":"b2625c7e-b1fc-11f0-9b51-1d4e7a2f3c03","agent_id":"b28d1e98-b1fc-11f0-8e62-3a5f9c1b4d04","ai_id":"b2b7e0b2-b1fc-11f0-a773-6c2e8d3a5e05","reference_type":"call","reference_id":"b2e2a2cc-b1fc-11f0-9884-4b1f7e2c6f06","language":"en-US","checklist":[{"name":"verify identity","done":false}]}`),
+			},
+			expectRes: &amaiassist.AIAssist{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("b30d64e6-b1fc-11f0-8995-2e3a6d9b7a07"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			h := requestHandler{
+				sock: mockSock,
+			}
+			ctx := context.Background()
+
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, err := h.AIV1AIAssistStart(ctx, tt.customerID, tt.agentID, tt.aiID, tt.referenceType, tt.referenceID, tt.language, tt.checklist)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(tt.expectRes, res) == false {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v\n", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_AIV1AIAssistGetDeleteStop(t *testing.T) {
+
+	aiassistID := uuid.FromStringOrNil("b33826fe-b1fc-11f0-a3a6-5f7b1c4e8b08")
+
+	tests := []struct {
+		name string
+
+		call func(h *requestHandler) (*amaiassist.AIAssist, error)
+
+		expectRequest *sock.Request
+	}{
+		{
+			name: "get",
+
+			call: func(h *requestHandler) (*amaiassist.AIAssist, error) {
+				return h.AIV1AIAssistGet(context.Background(), aiassistID)
+			},
+
+			expectRequest: &sock.Request{
+				URI:    "/v1/aiassists/b33826fe-b1fc-11f0-a3a6-5f7b1c4e8b08",
+				Method: sock.RequestMethodGet,
+			},
+		},
+		{
+			name: "delete",
+
+			call: func(h *requestHandler) (*amaiassist.AIAssist, error) {
+				return h.AIV1AIAssistDelete(context.Background(), aiassistID)
+			},
+
+			expectRequest: &sock.Request{
+				URI:    "/v1/aiassists/b33826fe-b1fc-11f0-a3a6-5f7b1c4e8b08",
+				Method: sock.RequestMethodDelete,
+			},
+		},
+		{
+			name: "stop",
+
+			call: func(h *requestHandler) (*amaiassist.AIAssist, error) {
+				return h.AIV1AIAssistStop(context.Background(), aiassistID)
+			},
+
+			expectRequest: &sock.Request{
+				URI:    "/v1/aiassists/b33826fe-b1fc-11f0-a3a6-5f7b1c4e8b08/stop",
+				Method: sock.RequestMethodPost,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			h := &requestHandler{
+				sock: mockSock,
+			}
+
+			mockSock.EXPECT().RequestPublish(gomock.Any(), string(outline.QueueNameAIRequest), tt.expectRequest).Return(&sock.Response{
+				StatusCode: 200,
+				DataType:   ContentTypeJSON,
+				Data:       []byte(`{"id":"b33826fe-b1fc-11f0-a3a6-5f7b1c4e8b08"}`),
+			}, nil)
+
+			res, err := tt.call(h)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			expectRes := &amaiassist.AIAssist{
+				Identity: identity.Identity{
+					ID: aiassistID,
+				},
+			}
+			if reflect.DeepEqual(expectRes, res) == false {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v\n", expectRes, res)
+			}
+		})
+	}
+}
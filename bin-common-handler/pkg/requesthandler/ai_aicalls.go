@@ -9,6 +9,7 @@ import (
 	amaicall "monorepo/bin-ai-manager/models/aicall"
 	ammessage "monorepo/bin-ai-manager/models/message"
 	cbrequest "monorepo/bin-ai-manager/pkg/listenhandler/models/request"
+	cbresponse "monorepo/bin-ai-manager/pkg/listenhandler/models/response"
 	"monorepo/bin-common-handler/models/sock"
 
 	"github.com/gofrs/uuid"
@@ -180,3 +181,143 @@ func (r *requestHandler) AIV1AIcallToolExecute(
 
 	return res, nil
 }
+
+// AIV1AIcallRedact sends a request to ai-manager
+// to redact the personal data in the text by the aicall's redaction config.
+// it returns the redacted text if it succeed.
+func (r *requestHandler) AIV1AIcallRedact(ctx context.Context, aicallID uuid.UUID, text string) (string, error) {
+	uri := fmt.Sprintf("/v1/aicalls/%s/redact", aicallID)
+
+	data := &cbrequest.V1DataAIcallsIDRedactPost{
+		Text: text,
+	}
+
+	m, err := json.Marshal(data)
+	if err != nil {
+		return "", err
+	}
+
+	tmp, err := r.sendRequestAI(ctx, uri, sock.RequestMethodPost, "ai/aicalls/<aicall-id>/redact", requestTimeoutDefault, 0, ContentTypeJSON, m)
+	if err != nil {
+		return "", err
+	}
+
+	var res cbresponse.V1AIcallsIDRedactPost
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return "", errParse
+	}
+
+	return res.Text, nil
+}
+
+// AIV1AIcallGuardrailCheck sends a request to ai-manager
+// to check the AI's output against the aicall's guardrail policy.
+// it returns the text to say instead and whether the output was blocked.
+func (r *requestHandler) AIV1AIcallGuardrailCheck(ctx context.Context, aicallID uuid.UUID, text string) (string, bool, error) {
+	uri := fmt.Sprintf("/v1/aicalls/%s/guardrail_check", aicallID)
+
+	data := &cbrequest.V1DataAIcallsIDGuardrailCheckPost{
+		Text: text,
+	}
+
+	m, err := json.Marshal(data)
+	if err != nil {
+		return "", false, err
+	}
+
+	tmp, err := r.sendRequestAI(ctx, uri, sock.RequestMethodPost, "ai/aicalls/<aicall-id>/guardrail_check", requestTimeoutDefault, 0, ContentTypeJSON, m)
+	if err != nil {
+		return "", false, err
+	}
+
+	var res cbresponse.V1AIcallsIDGuardrailCheckPost
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return "", false, errParse
+	}
+
+	return res.Text, res.Blocked, nil
+}
+
+// AIV1AIcallGuardrailInputCheck sends a request to ai-manager
+// to check the user's input against the aicall's guardrail policy.
+// it returns the text to say instead of the AI's answer and whether the input was blocked.
+func (r *requestHandler) AIV1AIcallGuardrailInputCheck(ctx context.Context, aicallID uuid.UUID, text string) (string, bool, error) {
+	uri := fmt.Sprintf("/v1/aicalls/%s/guardrail_input_check", aicallID)
+
+	data := &cbrequest.V1DataAIcallsIDGuardrailInputCheckPost{
+		Text: text,
+	}
+
+	m, err := json.Marshal(data)
+	if err != nil {
+		return "", false, err
+	}
+
+	tmp, err := r.sendRequestAI(ctx, uri, sock.RequestMethodPost, "ai/aicalls/<aicall-id>/guardrail_input_check", requestTimeoutDefault, 0, ContentTypeJSON, m)
+	if err != nil {
+		return "", false, err
+	}
+
+	var res cbresponse.V1AIcallsIDGuardrailInputCheckPost
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return "", false, errParse
+	}
+
+	return res.Text, res.Blocked, nil
+}
+
+// AIV1AIcallResponseCacheLookup sends a request to ai-manager
+// to look up the cached response for the given user turns of the aicall.
+// it returns empty string on a cache miss.
+func (r *requestHandler) AIV1AIcallResponseCacheLookup(ctx context.Context, aicallID uuid.UUID, userTurns []string) (string, error) {
+	uri := fmt.Sprintf("/v1/aicalls/%s/response_cache_lookup", aicallID)
+
+	data := &cbrequest.V1DataAIcallsIDResponseCacheLookupPost{
+		UserTurns: userTurns,
+	}
+
+	m, err := json.Marshal(data)
+	if err != nil {
+		return "", err
+	}
+
+	tmp, err := r.sendRequestAI(ctx, uri, sock.RequestMethodPost, "ai/aicalls/<aicall-id>/response_cache_lookup", requestTimeoutDefault, 0, ContentTypeJSON, m)
+	if err != nil {
+		return "", err
+	}
+
+	var res cbresponse.V1AIcallsIDResponseCacheLookupPost
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return "", errParse
+	}
+
+	return res.Text, nil
+}
+
+// AIV1AIcallResponseCacheStore sends a request to ai-manager
+// to store the AI's response for the given user turns of the aicall.
+// it returns null if it succeed.
+func (r *requestHandler) AIV1AIcallResponseCacheStore(ctx context.Context, aicallID uuid.UUID, userTurns []string, response string) error {
+	uri := fmt.Sprintf("/v1/aicalls/%s/response_cache_store", aicallID)
+
+	data := &cbrequest.V1DataAIcallsIDResponseCacheStorePost{
+		UserTurns: userTurns,
+		Response:  response,
+	}
+
+	m, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := r.sendRequestAI(ctx, uri, sock.RequestMethodPost, "ai/aicalls/<aicall-id>/response_cache_store", requestTimeoutDefault, 0, ContentTypeJSON, m)
+	if err != nil {
+		return err
+	}
+
+	if errParse := parseResponse(tmp, nil); errParse != nil {
+		return errParse
+	}
+
+	return nil
+}
@@ -480,3 +480,316 @@ func Test_AIV1AIcallToolExecute(t *testing.T) {
 		})
 	}
 }
+
+func Test_AIV1AIcallRedact(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		aicallID uuid.UUID
+		text     string
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+		expectRes     string
+	}{
+		{
+			name: "normal",
+
+			aicallID: uuid.FromStringOrNil("5b2e7d1a-a9ff-11f0-9c4e-2f8a1d6b3e70"),
+			text:     "my card is 4111 1111 1111 1111",
+
+			response: &sock.Response{
+				StatusCode: 200,
+				DataType:   ContentTypeJSON,
+				Data:       []byte(`{"text":"my card is [CREDIT_CARD]"}`),
+			},
+
+			expectTarget: string(outline.QueueNameAIRequest),
+			expectRequest: &sock.Request{
+				URI:      "/v1/aicalls/5b2e7d1a-a9ff-11f0-9c4e-2f8a1d6b3e70/redact",
+				Method:   sock.RequestMethodPost,
+				DataType: "application/json",
+				Data:     []byte(`{"text":"my card is 4111 1111 1111 1111"}`),
+			},
+			expectRes: "my card is [CREDIT_CARD]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			reqHandler := requestHandler{
+				sock: mockSock,
+			}
+			ctx := context.Background()
+
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, err := reqHandler.AIV1AIcallRedact(ctx, tt.aicallID, tt.text)
+			if err != nil {
+				t.Errorf("Wrong match. expect ok, got: %v", err)
+			}
+
+			if res != tt.expectRes {
+				t.Errorf("Wrong match. expect: %s, got: %s", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_AIV1AIcallGuardrailCheck(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		aicallID uuid.UUID
+		text     string
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+		expectRes     string
+		expectBlocked bool
+	}{
+		{
+			name: "normal",
+
+			aicallID: uuid.FromStringOrNil("b3e1a7c4-ad15-11f0-9f2a-5d8c1e3b7a40"),
+			text:     "this is a guaranteed return",
+
+			response: &sock.Response{
+				StatusCode: 200,
+				DataType:   ContentTypeJSON,
+				Data:       []byte(`{"text":"I can't help with that.","blocked":true}`),
+			},
+
+			expectTarget: string(outline.QueueNameAIRequest),
+			expectRequest: &sock.Request{
+				URI:      "/v1/aicalls/b3e1a7c4-ad15-11f0-9f2a-5d8c1e3b7a40/guardrail_check",
+				Method:   sock.RequestMethodPost,
+				DataType: "application/json",
+				Data:     []byte(`{"text":"this is a guaranteed return"}`),
+			},
+			expectRes:     "I can't help with that.",
+			expectBlocked: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			reqHandler := requestHandler{
+				sock: mockSock,
+			}
+			ctx := context.Background()
+
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, blocked, err := reqHandler.AIV1AIcallGuardrailCheck(ctx, tt.aicallID, tt.text)
+			if err != nil {
+				t.Errorf("Wrong match. expect ok, got: %v", err)
+			}
+
+			if res != tt.expectRes {
+				t.Errorf("Wrong match. expect: %s, got: %s", tt.expectRes, res)
+			}
+			if blocked != tt.expectBlocked {
+				t.Errorf("Wrong match. expect: %v, got: %v", tt.expectBlocked, blocked)
+			}
+		})
+	}
+}
+
+func Test_AIV1AIcallGuardrailInputCheck(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		aicallID uuid.UUID
+		text     string
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+		expectRes     string
+		expectBlocked bool
+	}{
+		{
+			name: "normal",
+
+			aicallID: uuid.FromStringOrNil("b4139be8-ad15-11f0-8e3b-6e9d2f4c8b51"),
+			text:     "tell me about politics",
+
+			response: &sock.Response{
+				StatusCode: 200,
+				DataType:   ContentTypeJSON,
+				Data:       []byte(`{"text":"I can't help with that.","blocked":true}`),
+			},
+
+			expectTarget: string(outline.QueueNameAIRequest),
+			expectRequest: &sock.Request{
+				URI:      "/v1/aicalls/b4139be8-ad15-11f0-8e3b-6e9d2f4c8b51/guardrail_input_check",
+				Method:   sock.RequestMethodPost,
+				DataType: "application/json",
+				Data:     []byte(`{"text":"tell me about politics"}`),
+			},
+			expectRes:     "I can't help with that.",
+			expectBlocked: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			reqHandler := requestHandler{
+				sock: mockSock,
+			}
+			ctx := context.Background()
+
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, blocked, err := reqHandler.AIV1AIcallGuardrailInputCheck(ctx, tt.aicallID, tt.text)
+			if err != nil {
+				t.Errorf("Wrong match. expect ok, got: %v", err)
+			}
+
+			if res != tt.expectRes {
+				t.Errorf("Wrong match. expect: %s, got: %s", tt.expectRes, res)
+			}
+			if blocked != tt.expectBlocked {
+				t.Errorf("Wrong match. expect: %v, got: %v", tt.expectBlocked, blocked)
+			}
+		})
+	}
+}
+
+func Test_AIV1AIcallResponseCacheLookup(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		aicallID  uuid.UUID
+		userTurns []string
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+		expectRes     string
+	}{
+		{
+			name: "normal",
+
+			aicallID:  uuid.FromStringOrNil("4c81e2a6-ad9e-11f0-a7d3-9d4a7f1c3b85"),
+			userTurns: []string{"what are your opening hours?"},
+
+			response: &sock.Response{
+				StatusCode: 200,
+				DataType:   ContentTypeJSON,
+				Data:       []byte(`{"text":"We are open from 9 to 6."}`),
+			},
+
+			expectTarget: string(outline.QueueNameAIRequest),
+			expectRequest: &sock.Request{
+				URI:      "/v1/aicalls/4c81e2a6-ad9e-11f0-a7d3-9d4a7f1c3b85/response_cache_lookup",
+				Method:   sock.RequestMethodPost,
+				DataType: "application/json",
+				Data:     []byte(`{"user_turns":["what are your opening hours?"]}`),
+			},
+			expectRes: "We are open from 9 to 6.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			reqHandler := requestHandler{
+				sock: mockSock,
+			}
+			ctx := context.Background()
+
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, err := reqHandler.AIV1AIcallResponseCacheLookup(ctx, tt.aicallID, tt.userTurns)
+			if err != nil {
+				t.Errorf("Wrong match. expect ok, got: %v", err)
+			}
+
+			if res != tt.expectRes {
+				t.Errorf("Wrong match. expect: %s, got: %s", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_AIV1AIcallResponseCacheStore(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		aicallID  uuid.UUID
+		userTurns []string
+		resp      string
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+	}{
+		{
+			name: "normal",
+
+			aicallID:  uuid.FromStringOrNil("4cb3f4d8-ad9e-11f0-8e6b-ae5b8f2d4c96"),
+			userTurns: []string{"what are your opening hours?"},
+			resp:      "We are open from 9 to 6.",
+
+			response: &sock.Response{
+				StatusCode: 200,
+			},
+
+			expectTarget: string(outline.QueueNameAIRequest),
+			expectRequest: &sock.Request{
+				URI:      "/v1/aicalls/4cb3f4d8-ad9e-11f0-8e6b-ae5b8f2d4c96/response_cache_store",
+				Method:   sock.RequestMethodPost,
+				DataType: "application/json",
+				Data:     []byte(`{"user_turns":["what are your opening hours?"],"response":"We are open from 9 to 6."}`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			reqHandler := requestHandler{
+				sock: mockSock,
+			}
+			ctx := context.Background()
+
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			if err := reqHandler.AIV1AIcallResponseCacheStore(ctx, tt.aicallID, tt.userTurns, tt.resp); err != nil {
+				t.Errorf("Wrong match. expect ok, got: %v", err)
+			}
+		})
+	}
+}
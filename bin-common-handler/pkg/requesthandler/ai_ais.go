@@ -7,6 +7,9 @@ import (
 	"net/url"
 
 	amai "monorepo/bin-ai-manager/models/ai"
+	amguardrail "monorepo/bin-ai-manager/models/guardrail"
+	amredaction "monorepo/bin-ai-manager/models/redaction"
+	amresponsecache "monorepo/bin-ai-manager/models/responsecache"
 	amtool "monorepo/bin-ai-manager/models/tool"
 	amrequest "monorepo/bin-ai-manager/pkg/listenhandler/models/request"
 	"monorepo/bin-common-handler/models/sock"
@@ -77,6 +80,10 @@ func (r *requestHandler) AIV1AICreate(
 	sttLanguage string,
 	toolNames []amtool.ToolName,
 	autoAICallAuditEnabled bool,
+	engineFallbacks []amai.EngineFallback,
+	redactionConfig *amredaction.Config,
+	guardrailConfig *amguardrail.Config,
+	responseCacheConfig *amresponsecache.Config,
 ) (*amai.AI, error) {
 	uri := "/v1/ais"
 
@@ -91,6 +98,8 @@ func (r *requestHandler) AIV1AICreate(
 		EngineKey:   engineKey,
 		RagID:       ragID,
 
+		EngineFallbacks: engineFallbacks,
+
 		InitPrompt: initPrompt,
 
 		TTSType:    ttsType,
@@ -102,6 +111,11 @@ func (r *requestHandler) AIV1AICreate(
 		ToolNames: toolNames,
 
 		AutoAICallAuditEnabled: autoAICallAuditEnabled,
+
+		Redaction: redactionConfig,
+		Guardrail: guardrailConfig,
+
+		ResponseCache: responseCacheConfig,
 	}
 
 	m, err := json.Marshal(data)
@@ -198,6 +212,10 @@ func (r *requestHandler) AIV1AIUpdate(
 	sttLanguage string,
 	toolNames []amtool.ToolName,
 	autoAICallAuditEnabled bool,
+	engineFallbacks []amai.EngineFallback,
+	redactionConfig *amredaction.Config,
+	guardrailConfig *amguardrail.Config,
+	responseCacheConfig *amresponsecache.Config,
 ) (*amai.AI, error) {
 	uri := fmt.Sprintf("/v1/ais/%s", aiID)
 
@@ -211,6 +229,8 @@ func (r *requestHandler) AIV1AIUpdate(
 		EngineKey:   engineKey,
 		RagID:       ragID,
 
+		EngineFallbacks: engineFallbacks,
+
 		InitPrompt: initPrompt,
 
 		TTSType:    ttsType,
@@ -222,6 +242,11 @@ func (r *requestHandler) AIV1AIUpdate(
 		ToolNames: toolNames,
 
 		AutoAICallAuditEnabled: autoAICallAuditEnabled,
+
+		Redaction: redactionConfig,
+		Guardrail: guardrailConfig,
+
+		ResponseCache: responseCacheConfig,
 	}
 
 	m, err := json.Marshal(data)
@@ -6,6 +6,9 @@ import (
 	"testing"
 
 	amai "monorepo/bin-ai-manager/models/ai"
+	amguardrail "monorepo/bin-ai-manager/models/guardrail"
+	amredaction "monorepo/bin-ai-manager/models/redaction"
+	amresponsecache "monorepo/bin-ai-manager/models/responsecache"
 
 	"github.com/gofrs/uuid"
 	"go.uber.org/mock/gomock"
@@ -173,6 +176,10 @@ func Test_AIV1AICreate(t *testing.T) {
 		sttType                amai.STTType
 		sttLanguage            string
 		autoAICallAuditEnabled bool
+		engineFallbacks        []amai.EngineFallback
+		redactionConfig        *amredaction.Config
+		guardrailConfig        *amguardrail.Config
+		responseCacheConfig    *amresponsecache.Config
 
 		response *sock.Response
 
@@ -256,6 +263,136 @@ func Test_AIV1AICreate(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "engine_fallbacks",
+
+			customerID:  uuid.FromStringOrNil("eeaf1e90-237a-4da5-a978-a8fc0eb691d0"),
+			aiName:      "test name",
+			detail:      "test detail",
+			engineModel: amai.EngineModelOpenaiGPT5,
+			engineKey:   "test engine key",
+			engineFallbacks: []amai.EngineFallback{
+				{EngineModel: amai.EngineModelGeminiGemini2Dot5Flash, EngineKey: "fallback engine key"},
+			},
+
+			response: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"e6248322-de4f-4313-bd89-f9de1c6466a8"}`),
+			},
+
+			expectTarget: string(outline.QueueNameAIRequest),
+			expectRequest: &sock.Request{
+				URI:      "/v1/ais",
+				Method:   sock.RequestMethodPost,
+				DataType: "application/json",
+				Data:     []byte(`{"customer_id":"eeaf1e90-237a-4da5-a978-a8fc0eb691d0","name":"test name","detail":"test detail","engine_model":"openai.gpt-5","engine_key":"test engine key","rag_id":"00000000-0000-0000-0000-000000000000","engine_fallbacks":[{"engine_model":"gemini.gemini-2.5-flash","engine_key":"fallback engine key"}]}`),
+			},
+			expectRes: &amai.AI{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("e6248322-de4f-4313-bd89-f9de1c6466a8"),
+				},
+			},
+		},
+		{
+			name: "redaction",
+
+			customerID:  uuid.FromStringOrNil("0f3a6c1e-a9ff-11f0-8b2d-5e7c1a9f3d40"),
+			aiName:      "test name",
+			detail:      "test detail",
+			engineModel: amai.EngineModelOpenaiGPT5,
+			engineKey:   "test engine key",
+			redactionConfig: &amredaction.Config{
+				EntityTypes: []amredaction.EntityType{amredaction.EntityTypeCreditCard},
+				Mode:        amredaction.ModeMask,
+			},
+
+			response: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"0f6d2e8a-a9ff-11f0-a1c3-9b4e2f7d1c51"}`),
+			},
+
+			expectTarget: string(outline.QueueNameAIRequest),
+			expectRequest: &sock.Request{
+				URI:      "/v1/ais",
+				Method:   sock.RequestMethodPost,
+				DataType: "application/json",
+				Data:     []byte(`{"customer_id":"0f3a6c1e-a9ff-11f0-8b2d-5e7c1a9f3d40","name":"test name","detail":"test detail","engine_model":"openai.gpt-5","engine_key":"test engine key","rag_id":"00000000-0000-0000-0000-000000000000","redaction":{"entity_types":["credit_card"],"mode":"mask"}}`),
+			},
+			expectRes: &amai.AI{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("0f6d2e8a-a9ff-11f0-a1c3-9b4e2f7d1c51"),
+				},
+			},
+		},
+		{
+			name: "guardrail",
+
+			customerID:  uuid.FromStringOrNil("c4a2e6f8-ad15-11f0-8b3d-2e5a7c9f1d40"),
+			aiName:      "test name",
+			detail:      "test detail",
+			engineModel: amai.EngineModelOpenaiGPT5,
+			engineKey:   "test engine key",
+			guardrailConfig: &amguardrail.Config{
+				BlockedTopics: []string{"politics"},
+				Escalation: &amguardrail.Escalation{
+					Action: amguardrail.EscalationActionStopService,
+				},
+			},
+
+			response: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"c4d4f80a-ad15-11f0-a2e6-6f1b3d5c8e51"}`),
+			},
+
+			expectTarget: string(outline.QueueNameAIRequest),
+			expectRequest: &sock.Request{
+				URI:      "/v1/ais",
+				Method:   sock.RequestMethodPost,
+				DataType: "application/json",
+				Data:     []byte(`{"customer_id":"c4a2e6f8-ad15-11f0-8b3d-2e5a7c9f1d40","name":"test name","detail":"test detail","engine_model":"openai.gpt-5","engine_key":"test engine key","rag_id":"00000000-0000-0000-0000-000000000000","guardrail":{"blocked_topics":["politics"],"escalation":{"action":"stop_service","queue_id":"00000000-0000-0000-0000-000000000000"}}}`),
+			},
+			expectRes: &amai.AI{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("c4d4f80a-ad15-11f0-a2e6-6f1b3d5c8e51"),
+				},
+			},
+		},
+		{
+			name: "response cache",
+
+			customerID:  uuid.FromStringOrNil("5d1e8a30-ad9e-11f0-b2f4-bf6c9a3e5da7"),
+			aiName:      "test name",
+			detail:      "test detail",
+			engineModel: amai.EngineModelOpenaiGPT5,
+			engineKey:   "test engine key",
+			responseCacheConfig: &amresponsecache.Config{
+				Enabled:   true,
+				Threshold: 0.9,
+				TTL:       3600,
+			},
+
+			response: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"5d51c2f6-ad9e-11f0-9a05-c07dab4f6eb8"}`),
+			},
+
+			expectTarget: string(outline.QueueNameAIRequest),
+			expectRequest: &sock.Request{
+				URI:      "/v1/ais",
+				Method:   sock.RequestMethodPost,
+				DataType: "application/json",
+				Data:     []byte(`{"customer_id":"5d1e8a30-ad9e-11f0-b2f4-bf6c9a3e5da7","name":"test name","detail":"test detail","engine_model":"openai.gpt-5","engine_key":"test engine key","rag_id":"00000000-0000-0000-0000-000000000000","response_cache":{"enabled":true,"threshold":0.9,"ttl":3600}}`),
+			},
+			expectRes: &amai.AI{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("5d51c2f6-ad9e-11f0-9a05-c07dab4f6eb8"),
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -271,7 +408,7 @@ func Test_AIV1AICreate(t *testing.T) {
 
 			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
 
-			cf, err := reqHandler.AIV1AICreate(ctx, tt.customerID, tt.aiName, tt.detail, amai.TypeNone, tt.engineModel, tt.parameter, tt.engineKey, uuid.Nil, tt.initPrompt, tt.ttsType, tt.ttsVoiceID, tt.sttType, tt.sttLanguage, nil, tt.autoAICallAuditEnabled)
+			cf, err := reqHandler.AIV1AICreate(ctx, tt.customerID, tt.aiName, tt.detail, amai.TypeNone, tt.engineModel, tt.parameter, tt.engineKey, uuid.Nil, tt.initPrompt, tt.ttsType, tt.ttsVoiceID, tt.sttType, tt.sttLanguage, nil, tt.autoAICallAuditEnabled, tt.engineFallbacks, tt.redactionConfig, tt.guardrailConfig, tt.responseCacheConfig)
 			if err != nil {
 				t.Errorf("Wrong match. expect ok, got: %v", err)
 			}
@@ -362,6 +499,10 @@ func Test_AIV1AIUpdate(t *testing.T) {
 		sttType                amai.STTType
 		sttLanguage            string
 		autoAICallAuditEnabled bool
+		engineFallbacks        []amai.EngineFallback
+		redactionConfig        *amredaction.Config
+		guardrailConfig        *amguardrail.Config
+		responseCacheConfig    *amresponsecache.Config
 
 		response *sock.Response
 
@@ -460,7 +601,7 @@ func Test_AIV1AIUpdate(t *testing.T) {
 
 			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
 
-			cf, err := reqHandler.AIV1AIUpdate(ctx, tt.id, tt.aiName, tt.detail, amai.TypeNone, tt.engineModel, tt.parameter, tt.engineKey, uuid.Nil, tt.initPrompt, tt.ttsType, tt.ttsVoiceID, tt.sttType, tt.sttLanguage, nil, tt.autoAICallAuditEnabled)
+			cf, err := reqHandler.AIV1AIUpdate(ctx, tt.id, tt.aiName, tt.detail, amai.TypeNone, tt.engineModel, tt.parameter, tt.engineKey, uuid.Nil, tt.initPrompt, tt.ttsType, tt.ttsVoiceID, tt.sttType, tt.sttLanguage, nil, tt.autoAICallAuditEnabled, tt.engineFallbacks, tt.redactionConfig, tt.guardrailConfig, tt.responseCacheConfig)
 			if err != nil {
 				t.Errorf("Wrong match. expect ok, got: %v", err)
 			}
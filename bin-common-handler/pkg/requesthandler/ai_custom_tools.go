@@ -0,0 +1,168 @@
+package requesthandler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	amcustomtool "monorepo/bin-ai-manager/models/customtool"
+	amrequest "monorepo/bin-ai-manager/pkg/listenhandler/models/request"
+	"monorepo/bin-common-handler/models/sock"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+)
+
+// AIV1CustomToolList sends a request to ai-manager
+// to getting a list of custom tools info.
+// it returns detail list of custom tools info if it succeed.
+func (r *requestHandler) AIV1CustomToolList(ctx context.Context, pageToken string, pageSize uint64, filters map[amcustomtool.Field]any) ([]amcustomtool.CustomTool, error) {
+	uri := fmt.Sprintf("/v1/custom_tools?page_token=%s&page_size=%d", url.QueryEscape(pageToken), pageSize)
+
+	m, err := json.Marshal(filters)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not marshal filters")
+	}
+
+	tmp, err := r.sendRequestAI(ctx, uri, sock.RequestMethodGet, "ai/custom_tools", 30000, 0, ContentTypeJSON, m)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []amcustomtool.CustomTool
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return res, nil
+}
+
+// AIV1CustomToolGet returns the custom tool.
+func (r *requestHandler) AIV1CustomToolGet(ctx context.Context, customToolID uuid.UUID) (*amcustomtool.CustomTool, error) {
+	uri := fmt.Sprintf("/v1/custom_tools/%s", customToolID.String())
+
+	tmp, err := r.sendRequestAI(ctx, uri, sock.RequestMethodGet, "ai/custom_tools/<custom-tool-id>", requestTimeoutDefault, 0, ContentTypeNone, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res amcustomtool.CustomTool
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return &res, nil
+}
+
+// AIV1CustomToolCreate sends a request to ai-manager
+// to creating a custom tool.
+// it returns created custom tool if it succeed.
+func (r *requestHandler) AIV1CustomToolCreate(
+	ctx context.Context,
+	customerID uuid.UUID,
+	name string,
+	detail string,
+	description string,
+	parameters map[string]any,
+	endpointURL string,
+	headers map[string]string,
+	timeout int,
+	responseTemplate string,
+) (*amcustomtool.CustomTool, error) {
+	uri := "/v1/custom_tools"
+
+	data := &amrequest.V1DataCustomToolsPost{
+		CustomerID:       customerID,
+		Name:             name,
+		Detail:           detail,
+		Description:      description,
+		Parameters:       parameters,
+		URL:              endpointURL,
+		Headers:          headers,
+		Timeout:          timeout,
+		ResponseTemplate: responseTemplate,
+	}
+
+	m, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	tmp, err := r.sendRequestAI(ctx, uri, sock.RequestMethodPost, "ai/custom_tools", requestTimeoutDefault, 0, ContentTypeJSON, m)
+	if err != nil {
+		return nil, err
+	}
+
+	var res amcustomtool.CustomTool
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return &res, nil
+}
+
+// AIV1CustomToolDelete sends a request to ai-manager
+// to deleting a custom tool.
+// it returns deleted custom tool if it succeed.
+func (r *requestHandler) AIV1CustomToolDelete(ctx context.Context, customToolID uuid.UUID) (*amcustomtool.CustomTool, error) {
+	uri := fmt.Sprintf("/v1/custom_tools/%s", customToolID)
+
+	tmp, err := r.sendRequestAI(ctx, uri, sock.RequestMethodDelete, "ai/custom_tools/<custom-tool-id>", requestTimeoutDefault, 0, ContentTypeNone, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res amcustomtool.CustomTool
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return &res, nil
+}
+
+// AIV1CustomToolUpdate sends a request to ai-manager
+// to updating a custom tool.
+// it returns updated custom tool if it succeed.
+func (r *requestHandler) AIV1CustomToolUpdate(
+	ctx context.Context,
+	customToolID uuid.UUID,
+	name string,
+	detail string,
+	description string,
+	parameters map[string]any,
+	endpointURL string,
+	headers map[string]string,
+	timeout int,
+	responseTemplate string,
+) (*amcustomtool.CustomTool, error) {
+	uri := fmt.Sprintf("/v1/custom_tools/%s", customToolID)
+
+	data := &amrequest.V1DataCustomToolsIDPut{
+		Name:             name,
+		Detail:           detail,
+		Description:      description,
+		Parameters:       parameters,
+		URL:              endpointURL,
+		Headers:          headers,
+		Timeout:          timeout,
+		ResponseTemplate: responseTemplate,
+	}
+
+	m, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	tmp, err := r.sendRequestAI(ctx, uri, sock.RequestMethodPut, "ai/custom_tools/<custom-tool-id>", requestTimeoutDefault, 0, ContentTypeJSON, m)
+	if err != nil {
+		return nil, err
+	}
+
+	var res amcustomtool.CustomTool
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return &res, nil
+}
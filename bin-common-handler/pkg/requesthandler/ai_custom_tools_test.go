@@ -0,0 +1,369 @@
+package requesthandler
+
+import (
+	"context"
+	amcustomtool "monorepo/bin-ai-manager/models/customtool"
+	"monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/models/outline"
+	"monorepo/bin-common-handler/models/sock"
+	"monorepo/bin-common-handler/pkg/sockhandler"
+	"reflect"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_AIV1CustomToolList(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		pageToken string
+		pageSize  uint64
+		filters   map[amcustomtool.Field]any
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+		expectRes     []amcustomtool.CustomTool
+	}{
+		{
+			name: "normal",
+
+			pageToken: "2020-09-20T03:23:20.995000Z",
+			pageSize:  10,
+			filters: map[amcustomtool.Field]any{
+				amcustomtool.FieldDeleted:    false,
+				amcustomtool.FieldCustomerID: uuid.FromStringOrNil("4c1f3a5e-a8f0-11f0-9a5e-3f7d1c2b8e01"),
+			},
+
+			response: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`[{"id":"4c4b6d9a-a8f0-11f0-8f0e-9b3c7e1d2a02"}]`),
+			},
+
+			expectTarget: string(outline.QueueNameAIRequest),
+			expectRequest: &sock.Request{
+				URI:      "/v1/custom_tools?page_token=2020-09-20T03%3A23%3A20.995000Z&page_size=10",
+				Method:   sock.RequestMethodGet,
+				DataType: "application/json",
+				Data:     []byte(`{"customer_id":"4c1f3a5e-a8f0-11f0-9a5e-3f7d1c2b8e01","deleted":false}`),
+			},
+			expectRes: []amcustomtool.CustomTool{
+				{
+					Identity: identity.Identity{
+						ID: uuid.FromStringOrNil("4c4b6d9a-a8f0-11f0-8f0e-9b3c7e1d2a02"),
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			h := requestHandler{
+				sock: mockSock,
+			}
+			ctx := context.Background()
+
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, err := h.AIV1CustomToolList(ctx, tt.pageToken, tt.pageSize, tt.filters)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(tt.expectRes, res) == false {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v\n", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_AIV1CustomToolCreate(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		customerID       uuid.UUID
+		toolName         string
+		detail           string
+		description      string
+		parameters       map[string]any
+		url              string
+		headers          map[string]string
+		timeout          int
+		responseTemplate string
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+		expectRes     *amcustomtool.CustomTool
+	}{
+		{
+			name: "normal",
+
+			customerID:  uuid.FromStringOrNil("4c7a0d3c-a8f0-11f0-b1d4-5f2e8a9c3b03"),
+			toolName:    "lookup_order",
+			detail:      "test detail",
+			description: "Look up an order.",
+			parameters: map[string]any{
+				"type": "object",
+			},
+			url: "https://example.com/orders",
+			headers: map[string]string{
+				"X-Api-Key": "key",
+			},
+			timeout:          3000,
+			responseTemplate: "{{.status}}",
+
+			response: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"4ca6e1f8-a8f0-11f0-a7c2-1d6b4f8e2c04"}`),
+			},
+
+			expectTarget: string(outline.QueueNameAIRequest),
+			expectRequest: &sock.Request{
+				URI:      "/v1/custom_tools",
+				Method:   sock.RequestMethodPost,
+				DataType: "application/json",
+				Data:     []byte(`{"customer_id":"4c7a0d3c-a8f0-11f0-b1d4-5f2e8a9c3b03","name":"lookup_order","detail":"test detail","description":"Look up an order.","parameters":{"type":"object"},"url":"https://example.com/orders","headers":{"X-Api-Key":"key"},"timeout":3000,"response_template":"{{.status}}"}`),
+			},
+			expectRes: &amcustomtool.CustomTool{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("4ca6e1f8-a8f0-11f0-a7c2-1d6b4f8e2c04"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			reqHandler := requestHandler{
+				sock: mockSock,
+			}
+			ctx := context.Background()
+
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, err := reqHandler.AIV1CustomToolCreate(ctx, tt.customerID, tt.toolName, tt.detail, tt.description, tt.parameters, tt.url, tt.headers, tt.timeout, tt.responseTemplate)
+			if err != nil {
+				t.Errorf("Wrong match. expect ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_AIV1CustomToolGet(t *testing.T) {
+
+	tests := []struct {
+		name         string
+		customToolID uuid.UUID
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+		expectRes     *amcustomtool.CustomTool
+	}{
+		{
+			name:         "normal",
+			customToolID: uuid.FromStringOrNil("4cd2b6a4-a8f0-11f0-9b8e-6a3c2d1f4e05"),
+
+			response: &sock.Response{
+				StatusCode: 200,
+				DataType:   ContentTypeJSON,
+				Data:       []byte(`{"id":"4cd2b6a4-a8f0-11f0-9b8e-6a3c2d1f4e05"}`),
+			},
+
+			expectTarget: string(outline.QueueNameAIRequest),
+			expectRequest: &sock.Request{
+				URI:    "/v1/custom_tools/4cd2b6a4-a8f0-11f0-9b8e-6a3c2d1f4e05",
+				Method: sock.RequestMethodGet,
+			},
+			expectRes: &amcustomtool.CustomTool{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("4cd2b6a4-a8f0-11f0-9b8e-6a3c2d1f4e05"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			reqHandler := requestHandler{
+				sock: mockSock,
+			}
+			ctx := context.Background()
+
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, err := reqHandler.AIV1CustomToolGet(ctx, tt.customToolID)
+			if err != nil {
+				t.Errorf("Wrong match. expect ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_AIV1CustomToolDelete(t *testing.T) {
+
+	tests := []struct {
+		name         string
+		customToolID uuid.UUID
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+		expectRes     *amcustomtool.CustomTool
+	}{
+		{
+			name:         "normal",
+			customToolID: uuid.FromStringOrNil("4cfe8b50-a8f0-11f0-8d2a-7b4e3c2a5f06"),
+
+			response: &sock.Response{
+				StatusCode: 200,
+				DataType:   ContentTypeJSON,
+				Data:       []byte(`{"id":"4cfe8b50-a8f0-11f0-8d2a-7b4e3c2a5f06"}`),
+			},
+
+			expectTarget: string(outline.QueueNameAIRequest),
+			expectRequest: &sock.Request{
+				URI:    "/v1/custom_tools/4cfe8b50-a8f0-11f0-8d2a-7b4e3c2a5f06",
+				Method: sock.RequestMethodDelete,
+			},
+			expectRes: &amcustomtool.CustomTool{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("4cfe8b50-a8f0-11f0-8d2a-7b4e3c2a5f06"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			reqHandler := requestHandler{
+				sock: mockSock,
+			}
+			ctx := context.Background()
+
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, err := reqHandler.AIV1CustomToolDelete(ctx, tt.customToolID)
+			if err != nil {
+				t.Errorf("Wrong match. expect ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_AIV1CustomToolUpdate(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		customToolID     uuid.UUID
+		toolName         string
+		detail           string
+		description      string
+		parameters       map[string]any
+		url              string
+		headers          map[string]string
+		timeout          int
+		responseTemplate string
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+		expectRes     *amcustomtool.CustomTool
+	}{
+		{
+			name: "normal",
+
+			customToolID: uuid.FromStringOrNil("4d2a5f0c-a8f0-11f0-a3f6-8c5f4d3b6a07"),
+			toolName:     "lookup_order",
+			detail:       "updated detail",
+			description:  "Look up an order.",
+			url:          "https://example.com/orders/v2",
+			timeout:      5000,
+
+			response: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"4d2a5f0c-a8f0-11f0-a3f6-8c5f4d3b6a07"}`),
+			},
+
+			expectTarget: string(outline.QueueNameAIRequest),
+			expectRequest: &sock.Request{
+				URI:      "/v1/custom_tools/4d2a5f0c-a8f0-11f0-a3f6-8c5f4d3b6a07",
+				Method:   sock.RequestMethodPut,
+				DataType: "application/json",
+				Data:     []byte(`{"name":"lookup_order","detail":"updated detail","description":"Look up an order.","url":"https://example.com/orders/v2","timeout":5000}`),
+			},
+			expectRes: &amcustomtool.CustomTool{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("4d2a5f0c-a8f0-11f0-a3f6-8c5f4d3b6a07"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			reqHandler := requestHandler{
+				sock: mockSock,
+			}
+			ctx := context.Background()
+
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, err := reqHandler.AIV1CustomToolUpdate(ctx, tt.customToolID, tt.toolName, tt.detail, tt.description, tt.parameters, tt.url, tt.headers, tt.timeout, tt.responseTemplate)
+			if err != nil {
+				t.Errorf("Wrong match. expect ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package requesthandler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	amextraction "monorepo/bin-ai-manager/models/extraction"
+	amrequest "monorepo/bin-ai-manager/pkg/listenhandler/models/request"
+	"monorepo/bin-common-handler/models/sock"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+)
+
+// AIV1ExtractionList sends a request to ai-manager
+// to getting a list of extractions info.
+// it returns detail list of extractions info if it succeed.
+func (r *requestHandler) AIV1ExtractionList(ctx context.Context, pageToken string, pageSize uint64, filters map[amextraction.Field]any) ([]amextraction.Extraction, error) {
+	uri := fmt.Sprintf("/v1/extractions?page_token=%s&page_size=%d", url.QueryEscape(pageToken), pageSize)
+
+	m, err := json.Marshal(filters)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not marshal filters")
+	}
+
+	tmp, err := r.sendRequestAI(ctx, uri, sock.RequestMethodGet, "ai/extractions", requestTimeoutDefault, 0, ContentTypeJSON, m)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []amextraction.Extraction
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return res, nil
+}
+
+// AIV1ExtractionCreate sends a request to ai-manager
+// to creating an extraction.
+// it returns created extraction if it succeed.
+func (r *requestHandler) AIV1ExtractionCreate(
+	ctx context.Context,
+	customerID uuid.UUID,
+	activeflowID uuid.UUID,
+	onEndFlowID uuid.UUID,
+	referenceType amextraction.ReferenceType,
+	referenceID uuid.UUID,
+	language string,
+	schema map[string]any,
+	setVariables bool,
+	timeout int,
+) (*amextraction.Extraction, error) {
+	uri := "/v1/extractions"
+
+	data := &amrequest.V1DataExtractionsPost{
+		CustomerID: customerID,
+
+		ActiveflowID: activeflowID,
+		OnEndFlowID:  onEndFlowID,
+
+		ReferenceType: referenceType,
+		ReferenceID:   referenceID,
+
+		Language: language,
+
+		Schema:       schema,
+		SetVariables: setVariables,
+	}
+
+	m, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	tmp, err := r.sendRequestAI(ctx, uri, sock.RequestMethodPost, "ai/extractions", timeout, 0, ContentTypeJSON, m)
+	if err != nil {
+		return nil, err
+	}
+
+	var res amextraction.Extraction
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return &res, nil
+}
+
+// AIV1ExtractionGet returns the extraction.
+func (r *requestHandler) AIV1ExtractionGet(ctx context.Context, extractionID uuid.UUID) (*amextraction.Extraction, error) {
+
+	uri := fmt.Sprintf("/v1/extractions/%s", extractionID.String())
+
+	tmp, err := r.sendRequestAI(ctx, uri, sock.RequestMethodGet, "ai/extractions/<extraction-id>", requestTimeoutDefault, 0, ContentTypeNone, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res amextraction.Extraction
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return &res, nil
+}
+
+// AIV1ExtractionDelete sends a request to ai-manager
+// to deleting an extraction.
+// it returns deleted extraction if it succeed.
+func (r *requestHandler) AIV1ExtractionDelete(ctx context.Context, extractionID uuid.UUID) (*amextraction.Extraction, error) {
+	uri := fmt.Sprintf("/v1/extractions/%s", extractionID)
+
+	tmp, err := r.sendRequestAI(ctx, uri, sock.RequestMethodDelete, "ai/extractions/<extraction-id>", requestTimeoutDefault, 0, ContentTypeNone, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res amextraction.Extraction
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return &res, nil
+}
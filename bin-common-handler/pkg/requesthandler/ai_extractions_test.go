@@ -0,0 +1,302 @@
+package requesthandler
+
+import (
+	"context"
+	amextraction "monorepo/bin-ai-manager/models/extraction"
+	"monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/models/outline"
+	"monorepo/bin-common-handler/models/sock"
+	"monorepo/bin-common-handler/pkg/sockhandler"
+	"reflect"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_AIV1ExtractionList(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		pageToken string
+		pageSize  uint64
+		filters   map[amextraction.Field]any
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+		expectRes     []amextraction.Extraction
+	}{
+		{
+			name: "normal",
+
+			pageToken: "2020-09-20T03:23:20.995000Z",
+			pageSize:  10,
+			filters: map[amextraction.Field]any{
+				amextraction.FieldDeleted:    false,
+				amextraction.FieldCustomerID: uuid.FromStringOrNil("64a0cdf8-cbca-11f1-8c45-02fc00000001"),
+			},
+
+			response: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`[{"id":"64a0cf06-cbca-11f1-8c45-02fc00000001"},{"id":"64a0cf6a-cbca-11f1-8c45-02fc00000001"}]`),
+			},
+
+			expectTarget: string(outline.QueueNameAIRequest),
+			expectRequest: &sock.Request{
+				URI:      "/v1/extractions?page_token=2020-09-20T03%3A23%3A20.995000Z&page_size=10",
+				Method:   sock.RequestMethodGet,
+				DataType: "application/json",
+				Data:     []byte(`{"customer_id":"64a0cdf8-cbca-11f1-8c45-02fc00000001","deleted":false}`),
+			},
+			expectRes: []amextraction.Extraction{
+				{
+					Identity: identity.Identity{
+						ID: uuid.FromStringOrNil("64a0cf06-cbca-11f1-8c45-02fc00000001"),
+					},
+				},
+				{
+					Identity: identity.Identity{
+						ID: uuid.FromStringOrNil("64a0cf6a-cbca-11f1-8c45-02fc00000001"),
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			h := requestHandler{
+				sock: mockSock,
+			}
+			ctx := context.Background()
+
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, err := h.AIV1ExtractionList(ctx, tt.pageToken, tt.pageSize, tt.filters)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(tt.expectRes, res) == false {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v\n", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_AIV1ExtractionCreate(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		customerID    uuid.UUID
+		activeflowID  uuid.UUID
+		onEndFlowID   uuid.UUID
+		referenceType amextraction.ReferenceType
+		referenceID   uuid.UUID
+		language      string
+		schema        map[string]any
+		setVariables  bool
+		timeout       int
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+		expectRes     *amextraction.Extraction
+	}{
+		{
+			name: "normal",
+
+			customerID:    uuid.FromStringOrNil("64a0d118-cbca-11f1-8c45-02fc00000001"),
+			activeflowID:  uuid.FromStringOrNil("64a0d1b8-cbca-11f1-8c45-02fc00000001"),
+			onEndFlowID:   uuid.FromStringOrNil("64a0d212-cbca-11f1-8c45-02fc00000001"),
+			referenceType: amextraction.ReferenceTypeRecording,
+			referenceID:   uuid.FromStringOrNil("64a0d276-cbca-11f1-8c45-02fc00000001"),
+			language:      "en-US",
+			schema: map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"intent": map[string]any{"type": "string"},
+				},
+			},
+			setVariables: true,
+			timeout:      30000,
+
+			response: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"64a0d32a-cbca-11f1-8c45-02fc00000001"}`),
+			},
+
+			expectTarget: string(outline.QueueNameAIRequest),
+			expectRequest: &sock.Request{
+				URI:      "/v1/extractions",
+				Method:   sock.RequestMethodPost,
+				DataType: "application/json",
+				Data:     []byte(`{"customer_id":"64a0d118-cbca-11f1-8c45-02fc00000001","activeflow_id":"64a0d1b8-cbca-11f1-8c45-02fc00000001","on_end_flow_id":"64a0d212-cbca-11f1-8c45-02fc00000001","reference_type":"recording","reference_id":"64a0d276-cbca-11f1-8c45-02fc00000001","language":"en-US","schema":{"properties":{"intent":{"type":"string"}},"type":"object"},"set_variables":true}`),
+			},
+			expectRes: &amextraction.Extraction{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("64a0d32a-cbca-11f1-8c45-02fc00000001"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			reqHandler := requestHandler{
+				sock: mockSock,
+			}
+			ctx := context.Background()
+
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			cf, err := reqHandler.AIV1ExtractionCreate(ctx, tt.customerID, tt.activeflowID, tt.onEndFlowID, tt.referenceType, tt.referenceID, tt.language, tt.schema, tt.setVariables, tt.timeout)
+			if err != nil {
+				t.Errorf("Wrong match. expect ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(cf, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, cf)
+			}
+		})
+	}
+}
+
+func Test_AIV1ExtractionGet(t *testing.T) {
+
+	type test struct {
+		name      string
+		summaryID uuid.UUID
+
+		expectTarget  string
+		expectRequest *sock.Request
+
+		response  *sock.Response
+		expectRes *amextraction.Extraction
+	}
+
+	tests := []test{
+		{
+			name:      "normal",
+			summaryID: uuid.FromStringOrNil("64a0d4ce-cbca-11f1-8c45-02fc00000001"),
+
+			expectTarget: string(outline.QueueNameAIRequest),
+			expectRequest: &sock.Request{
+				URI:    "/v1/extractions/64a0d4ce-cbca-11f1-8c45-02fc00000001",
+				Method: sock.RequestMethodGet,
+			},
+
+			response: &sock.Response{
+				StatusCode: 200,
+				DataType:   ContentTypeJSON,
+				Data:       []byte(`{"id":"64a0d4ce-cbca-11f1-8c45-02fc00000001"}`),
+			},
+			expectRes: &amextraction.Extraction{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("64a0d4ce-cbca-11f1-8c45-02fc00000001"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			reqHandler := requestHandler{
+				sock: mockSock,
+			}
+			ctx := context.Background()
+
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, err := reqHandler.AIV1ExtractionGet(ctx, tt.summaryID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.expectRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_AIV1ExtractionDelete(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		aiID uuid.UUID
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+		expectRes     *amextraction.Extraction
+	}{
+		{
+			name: "normal",
+
+			aiID: uuid.FromStringOrNil("64a0d618-cbca-11f1-8c45-02fc00000001"),
+
+			response: &sock.Response{
+				StatusCode: 200,
+				DataType:   ContentTypeJSON,
+				Data:       []byte(`{"id":"64a0d618-cbca-11f1-8c45-02fc00000001"}`),
+			},
+
+			expectTarget: string(outline.QueueNameAIRequest),
+			expectRequest: &sock.Request{
+				URI:    "/v1/extractions/64a0d618-cbca-11f1-8c45-02fc00000001",
+				Method: sock.RequestMethodDelete,
+			},
+			expectRes: &amextraction.Extraction{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("64a0d618-cbca-11f1-8c45-02fc00000001"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			reqHandler := requestHandler{
+				sock: mockSock,
+			}
+			ctx := context.Background()
+
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, err := reqHandler.AIV1ExtractionDelete(ctx, tt.aiID)
+			if err != nil {
+				t.Errorf("Wrong match. expect ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package requesthandler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	amguardrailviolation "monorepo/bin-ai-manager/models/guardrailviolation"
+	"monorepo/bin-common-handler/models/sock"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+)
+
+// AIV1GuardrailViolationList sends a request to ai-manager
+// to getting a list of guardrail violations info.
+// it returns detail list of guardrail violations info if it succeed.
+func (r *requestHandler) AIV1GuardrailViolationList(ctx context.Context, pageToken string, pageSize uint64, filters map[amguardrailviolation.Field]any) ([]amguardrailviolation.GuardrailViolation, error) {
+	uri := fmt.Sprintf("/v1/guardrail_violations?page_token=%s&page_size=%d", url.QueryEscape(pageToken), pageSize)
+
+	m, err := json.Marshal(filters)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not marshal filters")
+	}
+
+	tmp, err := r.sendRequestAI(ctx, uri, sock.RequestMethodGet, "ai/guardrail_violations", requestTimeoutDefault, 0, ContentTypeJSON, m)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []amguardrailviolation.GuardrailViolation
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return res, nil
+}
+
+// AIV1GuardrailViolationGet returns the guardrail violation.
+func (r *requestHandler) AIV1GuardrailViolationGet(ctx context.Context, guardrailViolationID uuid.UUID) (*amguardrailviolation.GuardrailViolation, error) {
+
+	uri := fmt.Sprintf("/v1/guardrail_violations/%s", guardrailViolationID.String())
+
+	tmp, err := r.sendRequestAI(ctx, uri, sock.RequestMethodGet, "ai/guardrail_violations/<guardrail-violation-id>", requestTimeoutDefault, 0, ContentTypeNone, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res amguardrailviolation.GuardrailViolation
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return &res, nil
+}
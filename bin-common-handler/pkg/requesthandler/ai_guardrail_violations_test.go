@@ -0,0 +1,151 @@
+package requesthandler
+
+import (
+	"context"
+	amguardrailviolation "monorepo/bin-ai-manager/models/guardrailviolation"
+	"monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/models/outline"
+	"monorepo/bin-common-handler/models/sock"
+	"monorepo/bin-common-handler/pkg/sockhandler"
+	"reflect"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_AIV1GuardrailViolationList(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		pageToken string
+		pageSize  uint64
+		filters   map[amguardrailviolation.Field]any
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+		expectRes     []amguardrailviolation.GuardrailViolation
+	}{
+		{
+			name: "normal",
+
+			pageToken: "2020-09-20T03:23:20.995000Z",
+			pageSize:  10,
+			filters: map[amguardrailviolation.Field]any{
+				amguardrailviolation.FieldDeleted:    false,
+				amguardrailviolation.FieldCustomerID: uuid.FromStringOrNil("a1c3e5f0-ad15-11f0-9b2d-4e6a8c1f3b50"),
+			},
+
+			response: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`[{"id":"a1f5b7c2-ad15-11f0-8d4e-1b3c5e7f9a61","rule":"blocked_topic"}]`),
+			},
+
+			expectTarget: string(outline.QueueNameAIRequest),
+			expectRequest: &sock.Request{
+				URI:      "/v1/guardrail_violations?page_token=2020-09-20T03%3A23%3A20.995000Z&page_size=10",
+				Method:   sock.RequestMethodGet,
+				DataType: "application/json",
+				Data:     []byte(`{"customer_id":"a1c3e5f0-ad15-11f0-9b2d-4e6a8c1f3b50","deleted":false}`),
+			},
+			expectRes: []amguardrailviolation.GuardrailViolation{
+				{
+					Identity: identity.Identity{
+						ID: uuid.FromStringOrNil("a1f5b7c2-ad15-11f0-8d4e-1b3c5e7f9a61"),
+					},
+					Rule: amguardrailviolation.RuleBlockedTopic,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			h := requestHandler{
+				sock: mockSock,
+			}
+			ctx := context.Background()
+
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, err := h.AIV1GuardrailViolationList(ctx, tt.pageToken, tt.pageSize, tt.filters)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(tt.expectRes, res) == false {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v\n", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_AIV1GuardrailViolationGet(t *testing.T) {
+
+	type test struct {
+		name                 string
+		guardrailViolationID uuid.UUID
+
+		expectTarget  string
+		expectRequest *sock.Request
+
+		response  *sock.Response
+		expectRes *amguardrailviolation.GuardrailViolation
+	}
+
+	tests := []test{
+		{
+			name:                 "normal",
+			guardrailViolationID: uuid.FromStringOrNil("a227e9d4-ad15-11f0-a6f1-7c9e2a4d6b72"),
+
+			expectTarget: string(outline.QueueNameAIRequest),
+			expectRequest: &sock.Request{
+				URI:    "/v1/guardrail_violations/a227e9d4-ad15-11f0-a6f1-7c9e2a4d6b72",
+				Method: sock.RequestMethodGet,
+			},
+
+			response: &sock.Response{
+				StatusCode: 200,
+				DataType:   ContentTypeJSON,
+				Data:       []byte(`{"id":"a227e9d4-ad15-11f0-a6f1-7c9e2a4d6b72"}`),
+			},
+			expectRes: &amguardrailviolation.GuardrailViolation{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("a227e9d4-ad15-11f0-a6f1-7c9e2a4d6b72"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			reqHandler := requestHandler{
+				sock: mockSock,
+			}
+			ctx := context.Background()
+
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, err := reqHandler.AIV1GuardrailViolationGet(ctx, tt.guardrailViolationID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(res, tt.expectRes) != true {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package requesthandler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	ammcpserver "monorepo/bin-ai-manager/models/mcpserver"
+	amtool "monorepo/bin-ai-manager/models/tool"
+	amrequest "monorepo/bin-ai-manager/pkg/listenhandler/models/request"
+	"monorepo/bin-common-handler/models/sock"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+)
+
+// AIV1MCPServerList sends a request to ai-manager
+// to getting a list of mcp servers info.
+// it returns detail list of mcp servers info if it succeed.
+func (r *requestHandler) AIV1MCPServerList(ctx context.Context, pageToken string, pageSize uint64, filters map[ammcpserver.Field]any) ([]ammcpserver.MCPServer, error) {
+	uri := fmt.Sprintf("/v1/mcp_servers?page_token=%s&page_size=%d", url.QueryEscape(pageToken), pageSize)
+
+	m, err := json.Marshal(filters)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not marshal filters")
+	}
+
+	tmp, err := r.sendRequestAI(ctx, uri, sock.RequestMethodGet, "ai/mcp_servers", 30000, 0, ContentTypeJSON, m)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []ammcpserver.MCPServer
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return res, nil
+}
+
+// AIV1MCPServerGet returns the mcp server.
+func (r *requestHandler) AIV1MCPServerGet(ctx context.Context, mcpServerID uuid.UUID) (*ammcpserver.MCPServer, error) {
+	uri := fmt.Sprintf("/v1/mcp_servers/%s", mcpServerID.String())
+
+	tmp, err := r.sendRequestAI(ctx, uri, sock.RequestMethodGet, "ai/mcp_servers/<mcp-server-id>", requestTimeoutDefault, 0, ContentTypeNone, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res ammcpserver.MCPServer
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return &res, nil
+}
+
+// AIV1MCPServerCreate sends a request to ai-manager
+// to creating a mcp server.
+// it returns created mcp server if it succeed.
+func (r *requestHandler) AIV1MCPServerCreate(
+	ctx context.Context,
+	customerID uuid.UUID,
+	name string,
+	detail string,
+	transport ammcpserver.Transport,
+	endpointURL string,
+	headers map[string]string,
+	timeout int,
+	allowedTools []string,
+) (*ammcpserver.MCPServer, error) {
+	uri := "/v1/mcp_servers"
+
+	data := &amrequest.V1DataMCPServersPost{
+		CustomerID:   customerID,
+		Name:         name,
+		Detail:       detail,
+		Transport:    transport,
+		URL:          endpointURL,
+		Headers:      headers,
+		Timeout:      timeout,
+		AllowedTools: allowedTools,
+	}
+
+	m, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	tmp, err := r.sendRequestAI(ctx, uri, sock.RequestMethodPost, "ai/mcp_servers", requestTimeoutDefault, 0, ContentTypeJSON, m)
+	if err != nil {
+		return nil, err
+	}
+
+	var res ammcpserver.MCPServer
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return &res, nil
+}
+
+// AIV1MCPServerDelete sends a request to ai-manager
+// to deleting a mcp server.
+// it returns deleted mcp server if it succeed.
+func (r *requestHandler) AIV1MCPServerDelete(ctx context.Context, mcpServerID uuid.UUID) (*ammcpserver.MCPServer, error) {
+	uri := fmt.Sprintf("/v1/mcp_servers/%s", mcpServerID)
+
+	tmp, err := r.sendRequestAI(ctx, uri, sock.RequestMethodDelete, "ai/mcp_servers/<mcp-server-id>", requestTimeoutDefault, 0, ContentTypeNone, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res ammcpserver.MCPServer
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return &res, nil
+}
+
+// AIV1MCPServerUpdate sends a request to ai-manager
+// to updating a mcp server.
+// it returns updated mcp server if it succeed.
+func (r *requestHandler) AIV1MCPServerUpdate(
+	ctx context.Context,
+	mcpServerID uuid.UUID,
+	name string,
+	detail string,
+	transport ammcpserver.Transport,
+	endpointURL string,
+	headers map[string]string,
+	timeout int,
+	allowedTools []string,
+) (*ammcpserver.MCPServer, error) {
+	uri := fmt.Sprintf("/v1/mcp_servers/%s", mcpServerID)
+
+	data := &amrequest.V1DataMCPServersIDPut{
+		Name:         name,
+		Detail:       detail,
+		Transport:    transport,
+		URL:          endpointURL,
+		Headers:      headers,
+		Timeout:      timeout,
+		AllowedTools: allowedTools,
+	}
+
+	m, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	tmp, err := r.sendRequestAI(ctx, uri, sock.RequestMethodPut, "ai/mcp_servers/<mcp-server-id>", requestTimeoutDefault, 0, ContentTypeJSON, m)
+	if err != nil {
+		return nil, err
+	}
+
+	var res ammcpserver.MCPServer
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return &res, nil
+}
+
+// AIV1MCPServerToolList sends a request to ai-manager
+// to discovering the tools of an mcp server.
+// it returns the LLM-facing tool definitions if it succeed.
+func (r *requestHandler) AIV1MCPServerToolList(ctx context.Context, mcpServerID uuid.UUID) ([]amtool.Tool, error) {
+	uri := fmt.Sprintf("/v1/mcp_servers/%s/tools", mcpServerID)
+
+	// ai-manager connects to the mcp server, which may take up to the
+	// server's timeout (max 60 seconds).
+	tmp, err := r.sendRequestAI(ctx, uri, sock.RequestMethodGet, "ai/mcp_servers/<mcp-server-id>/tools", 65000, 0, ContentTypeNone, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []amtool.Tool
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return res, nil
+}
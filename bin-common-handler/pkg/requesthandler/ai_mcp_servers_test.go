@@ -0,0 +1,428 @@
+package requesthandler
+
+import (
+	"context"
+	ammcpserver "monorepo/bin-ai-manager/models/mcpserver"
+	amtool "monorepo/bin-ai-manager/models/tool"
+	"monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/models/outline"
+	"monorepo/bin-common-handler/models/sock"
+	"monorepo/bin-common-handler/pkg/sockhandler"
+	"reflect"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_AIV1MCPServerList(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		pageToken string
+		pageSize  uint64
+		filters   map[ammcpserver.Field]any
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+		expectRes     []ammcpserver.MCPServer
+	}{
+		{
+			name: "normal",
+
+			pageToken: "2020-09-20T03:23:20.995000Z",
+			pageSize:  10,
+			filters: map[ammcpserver.Field]any{
+				ammcpserver.FieldDeleted:    false,
+				ammcpserver.FieldCustomerID: uuid.FromStringOrNil("5a1c3e5a-8e51-11f1-9a5e-3f7d1c2b8e01"),
+			},
+
+			response: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`[{"id":"5a4d5f7b-8e51-11f1-8f0e-9b3c7e1d2a02"}]`),
+			},
+
+			expectTarget: string(outline.QueueNameAIRequest),
+			expectRequest: &sock.Request{
+				URI:      "/v1/mcp_servers?page_token=2020-09-20T03%3A23%3A20.995000Z&page_size=10",
+				Method:   sock.RequestMethodGet,
+				DataType: "application/json",
+				Data:     []byte(`{"customer_id":"5a1c3e5a-8e51-11f1-9a5e-3f7d1c2b8e01","deleted":false}`),
+			},
+			expectRes: []ammcpserver.MCPServer{
+				{
+					Identity: identity.Identity{
+						ID: uuid.FromStringOrNil("5a4d5f7b-8e51-11f1-8f0e-9b3c7e1d2a02"),
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			h := requestHandler{
+				sock: mockSock,
+			}
+			ctx := context.Background()
+
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, err := h.AIV1MCPServerList(ctx, tt.pageToken, tt.pageSize, tt.filters)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(tt.expectRes, res) == false {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v\n", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_AIV1MCPServerGet(t *testing.T) {
+
+	tests := []struct {
+		name        string
+		mcpServerID uuid.UUID
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+		expectRes     *ammcpserver.MCPServer
+	}{
+		{
+			name:        "normal",
+			mcpServerID: uuid.FromStringOrNil("5a7e719c-8e51-11f1-9b8e-6a3c2d1f4e05"),
+
+			response: &sock.Response{
+				StatusCode: 200,
+				DataType:   ContentTypeJSON,
+				Data:       []byte(`{"id":"5a7e719c-8e51-11f1-9b8e-6a3c2d1f4e05"}`),
+			},
+
+			expectTarget: string(outline.QueueNameAIRequest),
+			expectRequest: &sock.Request{
+				URI:    "/v1/mcp_servers/5a7e719c-8e51-11f1-9b8e-6a3c2d1f4e05",
+				Method: sock.RequestMethodGet,
+			},
+			expectRes: &ammcpserver.MCPServer{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("5a7e719c-8e51-11f1-9b8e-6a3c2d1f4e05"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			reqHandler := requestHandler{
+				sock: mockSock,
+			}
+			ctx := context.Background()
+
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, err := reqHandler.AIV1MCPServerGet(ctx, tt.mcpServerID)
+			if err != nil {
+				t.Errorf("Wrong match. expect ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_AIV1MCPServerDelete(t *testing.T) {
+
+	tests := []struct {
+		name        string
+		mcpServerID uuid.UUID
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+		expectRes     *ammcpserver.MCPServer
+	}{
+		{
+			name:        "normal",
+			mcpServerID: uuid.FromStringOrNil("5aaf83bd-8e51-11f1-8d2a-7b4e3c2a5f06"),
+
+			response: &sock.Response{
+				StatusCode: 200,
+				DataType:   ContentTypeJSON,
+				Data:       []byte(`{"id":"5aaf83bd-8e51-11f1-8d2a-7b4e3c2a5f06"}`),
+			},
+
+			expectTarget: string(outline.QueueNameAIRequest),
+			expectRequest: &sock.Request{
+				URI:    "/v1/mcp_servers/5aaf83bd-8e51-11f1-8d2a-7b4e3c2a5f06",
+				Method: sock.RequestMethodDelete,
+			},
+			expectRes: &ammcpserver.MCPServer{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("5aaf83bd-8e51-11f1-8d2a-7b4e3c2a5f06"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			reqHandler := requestHandler{
+				sock: mockSock,
+			}
+			ctx := context.Background()
+
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, err := reqHandler.AIV1MCPServerDelete(ctx, tt.mcpServerID)
+			if err != nil {
+				t.Errorf("Wrong match. expect ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_AIV1MCPServerCreate(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		customerID   uuid.UUID
+		serverName   string
+		detail       string
+		transport    ammcpserver.Transport
+		url          string
+		headers      map[string]string
+		timeout      int
+		allowedTools []string
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+		expectRes     *ammcpserver.MCPServer
+	}{
+		{
+			name: "normal",
+
+			customerID: uuid.FromStringOrNil("5ae095de-8e51-11f1-b1d4-5f2e8a9c3b03"),
+			serverName: "crm",
+			detail:     "test detail",
+			transport:  ammcpserver.TransportStreamableHTTP,
+			url:        "https://mcp.example.com/mcp",
+			headers: map[string]string{
+				"Authorization": "Bearer token",
+			},
+			timeout:      5000,
+			allowedTools: []string{"lookup_order"},
+
+			response: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"5b11a7ff-8e51-11f1-a7c2-1d6b4f8e2c04"}`),
+			},
+
+			expectTarget: string(outline.QueueNameAIRequest),
+			expectRequest: &sock.Request{
+				URI:      "/v1/mcp_servers",
+				Method:   sock.RequestMethodPost,
+				DataType: "application/json",
+				Data:     []byte(`{"customer_id":"5ae095de-8e51-11f1-b1d4-5f2e8a9c3b03","name":"crm","detail":"test detail","transport":"streamable_http","url":"https://mcp.example.com/mcp","headers":{"Authorization":"Bearer token"},"timeout":5000,"allowed_tools":["lookup_order"]}`),
+			},
+			expectRes: &ammcpserver.MCPServer{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("5b11a7ff-8e51-11f1-a7c2-1d6b4f8e2c04"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			reqHandler := requestHandler{
+				sock: mockSock,
+			}
+			ctx := context.Background()
+
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, err := reqHandler.AIV1MCPServerCreate(ctx, tt.customerID, tt.serverName, tt.detail, tt.transport, tt.url, tt.headers, tt.timeout, tt.allowedTools)
+			if err != nil {
+				t.Errorf("Wrong match. expect ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_AIV1MCPServerUpdate(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		mcpServerID  uuid.UUID
+		serverName   string
+		detail       string
+		transport    ammcpserver.Transport
+		url          string
+		headers      map[string]string
+		timeout      int
+		allowedTools []string
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+		expectRes     *ammcpserver.MCPServer
+	}{
+		{
+			name: "normal",
+
+			mcpServerID: uuid.FromStringOrNil("5b42ba20-8e51-11f1-a3f6-8c5f4d3b6a07"),
+			serverName:  "crm",
+			detail:      "updated detail",
+			transport:   ammcpserver.TransportSSE,
+			url:         "https://mcp.example.com/sse",
+			timeout:     3000,
+
+			response: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"5b42ba20-8e51-11f1-a3f6-8c5f4d3b6a07"}`),
+			},
+
+			expectTarget: string(outline.QueueNameAIRequest),
+			expectRequest: &sock.Request{
+				URI:      "/v1/mcp_servers/5b42ba20-8e51-11f1-a3f6-8c5f4d3b6a07",
+				Method:   sock.RequestMethodPut,
+				DataType: "application/json",
+				Data:     []byte(`{"name":"crm","detail":"updated detail","transport":"sse","url":"https://mcp.example.com/sse","timeout":3000}`),
+			},
+			expectRes: &ammcpserver.MCPServer{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("5b42ba20-8e51-11f1-a3f6-8c5f4d3b6a07"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			reqHandler := requestHandler{
+				sock: mockSock,
+			}
+			ctx := context.Background()
+
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, err := reqHandler.AIV1MCPServerUpdate(ctx, tt.mcpServerID, tt.serverName, tt.detail, tt.transport, tt.url, tt.headers, tt.timeout, tt.allowedTools)
+			if err != nil {
+				t.Errorf("Wrong match. expect ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_AIV1MCPServerToolList(t *testing.T) {
+
+	tests := []struct {
+		name        string
+		mcpServerID uuid.UUID
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+		expectRes     []amtool.Tool
+	}{
+		{
+			name:        "normal",
+			mcpServerID: uuid.FromStringOrNil("5b73cc41-8e51-11f1-9c07-9d6e5c4b7a08"),
+
+			response: &sock.Response{
+				StatusCode: 200,
+				DataType:   ContentTypeJSON,
+				Data:       []byte(`[{"name":"crm__lookup_order","description":"Look up an order.","parameters":{"type":"object"},"run_llm":true}]`),
+			},
+
+			expectTarget: string(outline.QueueNameAIRequest),
+			expectRequest: &sock.Request{
+				URI:    "/v1/mcp_servers/5b73cc41-8e51-11f1-9c07-9d6e5c4b7a08/tools",
+				Method: sock.RequestMethodGet,
+			},
+			expectRes: []amtool.Tool{
+				{
+					Name:        "crm__lookup_order",
+					Description: "Look up an order.",
+					Parameters:  map[string]any{"type": "object"},
+					RunLLM:      true,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			reqHandler := requestHandler{
+				sock: mockSock,
+			}
+			ctx := context.Background()
+
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, err := reqHandler.AIV1MCPServerToolList(ctx, tt.mcpServerID)
+			if err != nil {
+				t.Errorf("Wrong match. expect ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
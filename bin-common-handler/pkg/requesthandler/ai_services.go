@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 
 	amaicall "monorepo/bin-ai-manager/models/aicall"
+	aiextraction "monorepo/bin-ai-manager/models/extraction"
 	aisummary "monorepo/bin-ai-manager/models/summary"
 	airequest "monorepo/bin-ai-manager/pkg/listenhandler/models/request"
 	"monorepo/bin-common-handler/models/service"
@@ -95,6 +96,52 @@ func (r *requestHandler) AIV1ServiceTypeSummaryStart(
 	return &res, nil
 }
 
+// AIV1ServiceTypeExtractionStart sends a request to ai-manager
+// to starts an extraction service.
+// it returns created service if it succeed.
+func (r *requestHandler) AIV1ServiceTypeExtractionStart(
+	ctx context.Context,
+	customerID uuid.UUID,
+	activeflowID uuid.UUID,
+	onEndFlowID uuid.UUID,
+	referenceType aiextraction.ReferenceType,
+	referenceID uuid.UUID,
+	language string,
+	schema map[string]any,
+	setVariables bool,
+	requestTimeout int,
+) (*service.Service, error) {
+	uri := "/v1/services/type/extraction"
+
+	data := &airequest.V1DataServicesTypeExtractionPost{
+		CustomerID:    customerID,
+		ActiveflowID:  activeflowID,
+		OnEndFlowID:   onEndFlowID,
+		ReferenceType: referenceType,
+		ReferenceID:   referenceID,
+		Language:      language,
+		Schema:        schema,
+		SetVariables:  setVariables,
+	}
+
+	m, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	tmp, err := r.sendRequestAI(ctx, uri, sock.RequestMethodPost, "ai/services/type/extraction", requestTimeout, 0, ContentTypeJSON, m)
+	if err != nil {
+		return nil, err
+	}
+
+	var res service.Service
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return &res, nil
+}
+
 // AIV1ServiceTypeTaskStart sends a request to ai-manager
 // to starts a task service.
 // it returns created service if it succeed.
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	amaicall "monorepo/bin-ai-manager/models/aicall"
+	aiextraction "monorepo/bin-ai-manager/models/extraction"
 	aisummary "monorepo/bin-ai-manager/models/summary"
 	"monorepo/bin-common-handler/models/outline"
 	"monorepo/bin-common-handler/models/service"
@@ -172,6 +173,99 @@ func Test_AIV1ServiceTypeSummaryStart(t *testing.T) {
 	}
 }
 
+func Test_AIV1ServiceTypeExtractionStart(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		customerID     uuid.UUID
+		activeflowID   uuid.UUID
+		onEndFlowID    uuid.UUID
+		referenceType  aiextraction.ReferenceType
+		referenceID    uuid.UUID
+		language       string
+		schema         map[string]any
+		setVariables   bool
+		requestTimeout int
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+		expectRes     *service.Service
+	}{
+		{
+			name: "normal",
+
+			customerID:    uuid.FromStringOrNil("64a0dd34-cbca-11f1-8c45-02fc00000001"),
+			activeflowID:  uuid.FromStringOrNil("64a0dda2-cbca-11f1-8c45-02fc00000001"),
+			onEndFlowID:   uuid.FromStringOrNil("64a0ddfc-cbca-11f1-8c45-02fc00000001"),
+			referenceType: aiextraction.ReferenceTypeCall,
+			referenceID:   uuid.FromStringOrNil("64a0de56-cbca-11f1-8c45-02fc00000001"),
+			language:      "en-US",
+			schema: map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"order_id": map[string]any{"type": "string"},
+				},
+			},
+			requestTimeout: 5000,
+
+			response: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"64a0deec-cbca-11f1-8c45-02fc00000001"}`),
+			},
+
+			expectTarget: string(outline.QueueNameAIRequest),
+			expectRequest: &sock.Request{
+				URI:      "/v1/services/type/extraction",
+				Method:   sock.RequestMethodPost,
+				DataType: "application/json",
+				Data:     []byte(`{"customer_id":"64a0dd34-cbca-11f1-8c45-02fc00000001","activeflow_id":"64a0dda2-cbca-11f1-8c45-02fc00000001","on_end_flow_id":"64a0ddfc-cbca-11f1-8c45-02fc00000001","reference_type":"call","reference_id":"64a0de56-cbca-11f1-8c45-02fc00000001","language":"en-US","schema":{"properties":{"order_id":{"type":"string"}},"type":"object"}}`),
+			},
+			expectRes: &service.Service{
+				ID: uuid.FromStringOrNil("64a0deec-cbca-11f1-8c45-02fc00000001"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			reqHandler := requestHandler{
+				sock: mockSock,
+			}
+			ctx := context.Background()
+
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			cf, err := reqHandler.AIV1ServiceTypeExtractionStart(
+				ctx,
+				tt.customerID,
+				tt.activeflowID,
+				tt.onEndFlowID,
+				tt.referenceType,
+				tt.referenceID,
+				tt.language,
+				tt.schema,
+				tt.setVariables,
+				tt.requestTimeout,
+			)
+			if err != nil {
+				t.Errorf("Wrong match. expect ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(cf, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, cf)
+			}
+		})
+	}
+}
+
 func Test_AIV1ServiceTypeTaskStart(t *testing.T) {
 
 	tests := []struct {
@@ -0,0 +1,74 @@
+package requesthandler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	amtestrun "monorepo/bin-ai-manager/models/testrun"
+	"monorepo/bin-common-handler/models/sock"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+)
+
+// AIV1TestRunList sends a request to ai-manager
+// to getting a list of test runs info.
+// it returns detail list of test runs info if it succeed.
+func (r *requestHandler) AIV1TestRunList(ctx context.Context, pageToken string, pageSize uint64, filters map[amtestrun.Field]any) ([]amtestrun.TestRun, error) {
+	uri := fmt.Sprintf("/v1/test_runs?page_token=%s&page_size=%d", url.QueryEscape(pageToken), pageSize)
+
+	m, err := json.Marshal(filters)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not marshal filters")
+	}
+
+	tmp, err := r.sendRequestAI(ctx, uri, sock.RequestMethodGet, "ai/test_runs", 30000, 0, ContentTypeJSON, m)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []amtestrun.TestRun
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return res, nil
+}
+
+// AIV1TestRunGet returns the test run.
+func (r *requestHandler) AIV1TestRunGet(ctx context.Context, testRunID uuid.UUID) (*amtestrun.TestRun, error) {
+	uri := fmt.Sprintf("/v1/test_runs/%s", testRunID.String())
+
+	tmp, err := r.sendRequestAI(ctx, uri, sock.RequestMethodGet, "ai/test_runs/<test-run-id>", requestTimeoutDefault, 0, ContentTypeNone, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res amtestrun.TestRun
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return &res, nil
+}
+
+// AIV1TestRunDelete sends a request to ai-manager
+// to deleting a test run.
+// it returns deleted test run if it succeed.
+func (r *requestHandler) AIV1TestRunDelete(ctx context.Context, testRunID uuid.UUID) (*amtestrun.TestRun, error) {
+	uri := fmt.Sprintf("/v1/test_runs/%s", testRunID)
+
+	tmp, err := r.sendRequestAI(ctx, uri, sock.RequestMethodDelete, "ai/test_runs/<test-run-id>", requestTimeoutDefault, 0, ContentTypeNone, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res amtestrun.TestRun
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return &res, nil
+}
@@ -0,0 +1,156 @@
+package requesthandler
+
+import (
+	"context"
+	amtestrun "monorepo/bin-ai-manager/models/testrun"
+	"monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/models/outline"
+	"monorepo/bin-common-handler/models/sock"
+	"monorepo/bin-common-handler/pkg/sockhandler"
+	"reflect"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_AIV1TestRunList(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		pageToken string
+		pageSize  uint64
+		filters   map[amtestrun.Field]any
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+		expectRes     []amtestrun.TestRun
+	}{
+		{
+			name: "normal",
+
+			pageToken: "2020-09-20T03:23:20.995000Z",
+			pageSize:  10,
+			filters: map[amtestrun.Field]any{
+				amtestrun.FieldDeleted:     false,
+				amtestrun.FieldTestSuiteID: uuid.FromStringOrNil("7d1a3c5e-ad6d-11f0-9a62-3c5e7a9c1e08"),
+			},
+
+			response: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`[{"id":"7d48e784-ad6d-11f0-b7a3-6e8a0c2e4a09","status":"passed"}]`),
+			},
+
+			expectTarget: string(outline.QueueNameAIRequest),
+			expectRequest: &sock.Request{
+				URI:      "/v1/test_runs?page_token=2020-09-20T03%3A23%3A20.995000Z&page_size=10",
+				Method:   sock.RequestMethodGet,
+				DataType: "application/json",
+				Data:     []byte(`{"deleted":false,"test_suite_id":"7d1a3c5e-ad6d-11f0-9a62-3c5e7a9c1e08"}`),
+			},
+			expectRes: []amtestrun.TestRun{
+				{
+					Identity: identity.Identity{
+						ID: uuid.FromStringOrNil("7d48e784-ad6d-11f0-b7a3-6e8a0c2e4a09"),
+					},
+					Status: amtestrun.StatusPassed,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			h := requestHandler{
+				sock: mockSock,
+			}
+			ctx := context.Background()
+
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, err := h.AIV1TestRunList(ctx, tt.pageToken, tt.pageSize, tt.filters)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(tt.expectRes, res) == false {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v\n", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_AIV1TestRunGet(t *testing.T) {
+
+	tests := []struct {
+		name      string
+		testRunID uuid.UUID
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+		expectRes     *amtestrun.TestRun
+	}{
+		{
+			name:      "normal",
+			testRunID: uuid.FromStringOrNil("7d7709aa-ad6d-11f0-8cb4-9a2c4e6a8c10"),
+
+			response: &sock.Response{
+				StatusCode: 200,
+				DataType:   ContentTypeJSON,
+				Data:       []byte(`{"id":"7d7709aa-ad6d-11f0-8cb4-9a2c4e6a8c10","status":"failed","results":[{"scenario":"refund","passed":false,"turns":2}]}`),
+			},
+
+			expectTarget: string(outline.QueueNameAIRequest),
+			expectRequest: &sock.Request{
+				URI:    "/v1/test_runs/7d7709aa-ad6d-11f0-8cb4-9a2c4e6a8c10",
+				Method: sock.RequestMethodGet,
+			},
+			expectRes: &amtestrun.TestRun{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("7d7709aa-ad6d-11f0-8cb4-9a2c4e6a8c10"),
+				},
+				Status: amtestrun.StatusFailed,
+				Results: []amtestrun.Result{
+					{
+						Scenario: "refund",
+						Turns:    2,
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			reqHandler := requestHandler{
+				sock: mockSock,
+			}
+			ctx := context.Background()
+
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, err := reqHandler.AIV1TestRunGet(ctx, tt.testRunID)
+			if err != nil {
+				t.Errorf("Wrong match. expect ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,171 @@
+package requesthandler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	amtestrun "monorepo/bin-ai-manager/models/testrun"
+	amtestsuite "monorepo/bin-ai-manager/models/testsuite"
+	amrequest "monorepo/bin-ai-manager/pkg/listenhandler/models/request"
+	"monorepo/bin-common-handler/models/sock"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+)
+
+// AIV1TestSuiteList sends a request to ai-manager
+// to getting a list of test suites info.
+// it returns detail list of test suites info if it succeed.
+func (r *requestHandler) AIV1TestSuiteList(ctx context.Context, pageToken string, pageSize uint64, filters map[amtestsuite.Field]any) ([]amtestsuite.TestSuite, error) {
+	uri := fmt.Sprintf("/v1/test_suites?page_token=%s&page_size=%d", url.QueryEscape(pageToken), pageSize)
+
+	m, err := json.Marshal(filters)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not marshal filters")
+	}
+
+	tmp, err := r.sendRequestAI(ctx, uri, sock.RequestMethodGet, "ai/test_suites", 30000, 0, ContentTypeJSON, m)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []amtestsuite.TestSuite
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return res, nil
+}
+
+// AIV1TestSuiteGet returns the test suite.
+func (r *requestHandler) AIV1TestSuiteGet(ctx context.Context, testSuiteID uuid.UUID) (*amtestsuite.TestSuite, error) {
+	uri := fmt.Sprintf("/v1/test_suites/%s", testSuiteID.String())
+
+	tmp, err := r.sendRequestAI(ctx, uri, sock.RequestMethodGet, "ai/test_suites/<test-suite-id>", requestTimeoutDefault, 0, ContentTypeNone, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res amtestsuite.TestSuite
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return &res, nil
+}
+
+// AIV1TestSuiteCreate sends a request to ai-manager
+// to creating a test suite.
+// it returns created test suite if it succeed.
+func (r *requestHandler) AIV1TestSuiteCreate(
+	ctx context.Context,
+	customerID uuid.UUID,
+	aiID uuid.UUID,
+	name string,
+	detail string,
+	scenarios []amtestsuite.Scenario,
+) (*amtestsuite.TestSuite, error) {
+	uri := "/v1/test_suites"
+
+	data := &amrequest.V1DataTestSuitesPost{
+		CustomerID: customerID,
+		AIID:       aiID,
+		Name:       name,
+		Detail:     detail,
+		Scenarios:  scenarios,
+	}
+
+	m, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	tmp, err := r.sendRequestAI(ctx, uri, sock.RequestMethodPost, "ai/test_suites", requestTimeoutDefault, 0, ContentTypeJSON, m)
+	if err != nil {
+		return nil, err
+	}
+
+	var res amtestsuite.TestSuite
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return &res, nil
+}
+
+// AIV1TestSuiteDelete sends a request to ai-manager
+// to deleting a test suite.
+// it returns deleted test suite if it succeed.
+func (r *requestHandler) AIV1TestSuiteDelete(ctx context.Context, testSuiteID uuid.UUID) (*amtestsuite.TestSuite, error) {
+	uri := fmt.Sprintf("/v1/test_suites/%s", testSuiteID)
+
+	tmp, err := r.sendRequestAI(ctx, uri, sock.RequestMethodDelete, "ai/test_suites/<test-suite-id>", requestTimeoutDefault, 0, ContentTypeNone, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res amtestsuite.TestSuite
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return &res, nil
+}
+
+// AIV1TestSuiteUpdate sends a request to ai-manager
+// to updating a test suite.
+// it returns updated test suite if it succeed.
+func (r *requestHandler) AIV1TestSuiteUpdate(
+	ctx context.Context,
+	testSuiteID uuid.UUID,
+	name string,
+	detail string,
+	scenarios []amtestsuite.Scenario,
+) (*amtestsuite.TestSuite, error) {
+	uri := fmt.Sprintf("/v1/test_suites/%s", testSuiteID)
+
+	data := &amrequest.V1DataTestSuitesIDPut{
+		Name:      name,
+		Detail:    detail,
+		Scenarios: scenarios,
+	}
+
+	m, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	tmp, err := r.sendRequestAI(ctx, uri, sock.RequestMethodPut, "ai/test_suites/<test-suite-id>", requestTimeoutDefault, 0, ContentTypeJSON, m)
+	if err != nil {
+		return nil, err
+	}
+
+	var res amtestsuite.TestSuite
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return &res, nil
+}
+
+// AIV1TestSuiteRun sends a request to ai-manager
+// to starting a run of the test suite.
+// it returns the created test run if it succeed.
+// the scenarios are run in the background, poll the test run for the results.
+func (r *requestHandler) AIV1TestSuiteRun(ctx context.Context, testSuiteID uuid.UUID) (*amtestrun.TestRun, error) {
+	uri := fmt.Sprintf("/v1/test_suites/%s/run", testSuiteID)
+
+	tmp, err := r.sendRequestAI(ctx, uri, sock.RequestMethodPost, "ai/test_suites/<test-suite-id>/run", requestTimeoutDefault, 0, ContentTypeNone, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res amtestrun.TestRun
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return &res, nil
+}
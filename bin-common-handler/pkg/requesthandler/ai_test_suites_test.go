@@ -0,0 +1,235 @@
+package requesthandler
+
+import (
+	"context"
+	amtestrun "monorepo/bin-ai-manager/models/testrun"
+	amtestsuite "monorepo/bin-ai-manager/models/testsuite"
+	"monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/models/outline"
+	"monorepo/bin-common-handler/models/sock"
+	"monorepo/bin-common-handler/pkg/sockhandler"
+	"reflect"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_AIV1TestSuiteList(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		pageToken string
+		pageSize  uint64
+		filters   map[amtestsuite.Field]any
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+		expectRes     []amtestsuite.TestSuite
+	}{
+		{
+			name: "normal",
+
+			pageToken: "2020-09-20T03:23:20.995000Z",
+			pageSize:  10,
+			filters: map[amtestsuite.Field]any{
+				amtestsuite.FieldDeleted:    false,
+				amtestsuite.FieldCustomerID: uuid.FromStringOrNil("3b6f0e42-ad6d-11f0-8a51-6b2d4f8a1c01"),
+			},
+
+			response: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`[{"id":"3b9e2a78-ad6d-11f0-9c74-2f5b7d9e3a02"}]`),
+			},
+
+			expectTarget: string(outline.QueueNameAIRequest),
+			expectRequest: &sock.Request{
+				URI:      "/v1/test_suites?page_token=2020-09-20T03%3A23%3A20.995000Z&page_size=10",
+				Method:   sock.RequestMethodGet,
+				DataType: "application/json",
+				Data:     []byte(`{"customer_id":"3b6f0e42-ad6d-11f0-8a51-6b2d4f8a1c01","deleted":false}`),
+			},
+			expectRes: []amtestsuite.TestSuite{
+				{
+					Identity: identity.Identity{
+						ID: uuid.FromStringOrNil("3b9e2a78-ad6d-11f0-9c74-2f5b7d9e3a02"),
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			h := requestHandler{
+				sock: mockSock,
+			}
+			ctx := context.Background()
+
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, err := h.AIV1TestSuiteList(ctx, tt.pageToken, tt.pageSize, tt.filters)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(tt.expectRes, res) == false {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v\n", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_AIV1TestSuiteCreate(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		customerID uuid.UUID
+		aiID       uuid.UUID
+		suiteName  string
+		detail     string
+		scenarios  []amtestsuite.Scenario
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+		expectRes     *amtestsuite.TestSuite
+	}{
+		{
+			name: "normal",
+
+			customerID: uuid.FromStringOrNil("3bcc4d0e-ad6d-11f0-b1e8-4a6c8e0a2c03"),
+			aiID:       uuid.FromStringOrNil("3bfa6f34-ad6d-11f0-8d2f-7e9a1b3c5d04"),
+			suiteName:  "refund",
+			detail:     "test detail",
+			scenarios: []amtestsuite.Scenario{
+				{
+					Name:  "asks for a refund",
+					Type:  amtestsuite.ScenarioTypeScripted,
+					Turns: []string{"I want my money back."},
+					Assertions: []amtestsuite.Assertion{
+						{
+							Type: amtestsuite.AssertionTypeMustMention,
+							Text: "30 days",
+						},
+					},
+				},
+			},
+
+			response: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"3c28915a-ad6d-11f0-a43b-1c3e5a7b9d05"}`),
+			},
+
+			expectTarget: string(outline.QueueNameAIRequest),
+			expectRequest: &sock.Request{
+				URI:      "/v1/test_suites",
+				Method:   sock.RequestMethodPost,
+				DataType: "application/json",
+				Data:     []byte(`{"customer_id":"3bcc4d0e-ad6d-11f0-b1e8-4a6c8e0a2c03","ai_id":"3bfa6f34-ad6d-11f0-8d2f-7e9a1b3c5d04","name":"refund","detail":"test detail","scenarios":[{"name":"asks for a refund","type":"scripted","turns":["I want my money back."],"assertions":[{"type":"must_mention","text":"30 days"}]}]}`),
+			},
+			expectRes: &amtestsuite.TestSuite{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("3c28915a-ad6d-11f0-a43b-1c3e5a7b9d05"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			reqHandler := requestHandler{
+				sock: mockSock,
+			}
+			ctx := context.Background()
+
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, err := reqHandler.AIV1TestSuiteCreate(ctx, tt.customerID, tt.aiID, tt.suiteName, tt.detail, tt.scenarios)
+			if err != nil {
+				t.Errorf("Wrong match. expect ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_AIV1TestSuiteRun(t *testing.T) {
+
+	tests := []struct {
+		name        string
+		testSuiteID uuid.UUID
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+		expectRes     *amtestrun.TestRun
+	}{
+		{
+			name:        "normal",
+			testSuiteID: uuid.FromStringOrNil("3c56b380-ad6d-11f0-9f46-5b7d9f1a3c06"),
+
+			response: &sock.Response{
+				StatusCode: 200,
+				DataType:   ContentTypeJSON,
+				Data:       []byte(`{"id":"3c84d5a6-ad6d-11f0-8e51-8c0e2a4c6e07","test_suite_id":"3c56b380-ad6d-11f0-9f46-5b7d9f1a3c06","status":"progressing"}`),
+			},
+
+			expectTarget: string(outline.QueueNameAIRequest),
+			expectRequest: &sock.Request{
+				URI:    "/v1/test_suites/3c56b380-ad6d-11f0-9f46-5b7d9f1a3c06/run",
+				Method: sock.RequestMethodPost,
+			},
+			expectRes: &amtestrun.TestRun{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("3c84d5a6-ad6d-11f0-8e51-8c0e2a4c6e07"),
+				},
+				TestSuiteID: uuid.FromStringOrNil("3c56b380-ad6d-11f0-9f46-5b7d9f1a3c06"),
+				Status:      amtestrun.StatusProgressing,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			reqHandler := requestHandler{
+				sock: mockSock,
+			}
+			ctx := context.Background()
+
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, err := reqHandler.AIV1TestSuiteRun(ctx, tt.testSuiteID)
+			if err != nil {
+				t.Errorf("Wrong match. expect ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
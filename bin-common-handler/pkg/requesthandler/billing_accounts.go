@@ -104,6 +104,33 @@ func (r *requestHandler) BillingV1AccountUpdatePaymentInfo(ctx context.Context,
 	return &res, nil
 }
 
+// BillingV1AccountUpdateSpendingSettings updates a billing account's spend limits, low balance alert thresholds and auto top-up.
+func (r *requestHandler) BillingV1AccountUpdateSpendingSettings(ctx context.Context, accountID uuid.UUID, spendLimits []bmaccount.SpendLimit, balanceAlertThresholds []int64, autoTopUpThreshold int64, autoTopUpAmount int64) (*bmaccount.Account, error) {
+	uri := fmt.Sprintf("/v1/accounts/%s/spending_settings", accountID)
+
+	m, err := json.Marshal(bmrequest.V1DataAccountsIDSpendingSettingsPUT{
+		SpendLimits:            spendLimits,
+		BalanceAlertThresholds: balanceAlertThresholds,
+		AutoTopUpThreshold:     autoTopUpThreshold,
+		AutoTopUpAmount:        autoTopUpAmount,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tmp, err := r.sendRequestBilling(ctx, uri, sock.RequestMethodPut, "billing/accounts/<account-id>/spending_settings", requestTimeoutDefault, 0, ContentTypeJSON, m)
+	if err != nil {
+		return nil, err
+	}
+
+	var res bmaccount.Account
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return &res, nil
+}
+
 // BillingV1AccountAddBalanceForce adds the balance to the account in forcedly
 func (r *requestHandler) BillingV1AccountAddBalanceForce(ctx context.Context, accountID uuid.UUID, balance int64) (*bmaccount.Account, error) {
 	uri := fmt.Sprintf("/v1/accounts/%s/balance_add_force", accountID)
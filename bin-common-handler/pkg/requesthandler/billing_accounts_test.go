@@ -411,3 +411,81 @@ func Test_BillingV1AccountUpdatePaymentInfo(t *testing.T) {
 		})
 	}
 }
+
+func Test_BillingV1AccountUpdateSpendingSettings(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		accountID              uuid.UUID
+		spendLimits            []bmaccount.SpendLimit
+		balanceAlertThresholds []int64
+		autoTopUpThreshold     int64
+		autoTopUpAmount        int64
+
+		expectTarget  string
+		expectRequest *sock.Request
+		expectRes     *bmaccount.Account
+		response      *sock.Response
+	}{
+		{
+			name: "normal",
+
+			accountID: uuid.FromStringOrNil("8e3a1c5e-0b74-11f0-a1d2-3b4c5d6e7f01"),
+			spendLimits: []bmaccount.SpendLimit{
+				{
+					Period:      bmaccount.SpendLimitPeriodDaily,
+					CostType:    bmbilling.CostTypeSMS,
+					LimitCredit: 1000000,
+				},
+			},
+			balanceAlertThresholds: []int64{5000000},
+			autoTopUpThreshold:     2000000,
+			autoTopUpAmount:        20000000,
+
+			expectTarget: "bin-manager.billing-manager.request",
+			expectRequest: &sock.Request{
+				URI:      "/v1/accounts/8e3a1c5e-0b74-11f0-a1d2-3b4c5d6e7f01/spending_settings",
+				Method:   sock.RequestMethodPut,
+				DataType: ContentTypeJSON,
+				Data:     []byte(`{"spend_limits":[{"period":"daily","cost_type":"sms","limit_credit":1000000}],"balance_alert_thresholds":[5000000],"auto_topup_threshold":2000000,"auto_topup_amount":20000000}`),
+			},
+			expectRes: &bmaccount.Account{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("8e3a1c5e-0b74-11f0-a1d2-3b4c5d6e7f01"),
+				},
+			},
+
+			response: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"8e3a1c5e-0b74-11f0-a1d2-3b4c5d6e7f01"}`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			reqHandler := requestHandler{
+				sock: mockSock,
+			}
+
+			ctx := context.Background()
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, err := reqHandler.BillingV1AccountUpdateSpendingSettings(ctx, tt.accountID, tt.spendLimits, tt.balanceAlertThresholds, tt.autoTopUpThreshold, tt.autoTopUpAmount)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(tt.expectRes, res) {
+				t.Errorf("Wrong match. expect: %v, got: %v", tt.expectRes, res)
+			}
+
+		})
+	}
+}
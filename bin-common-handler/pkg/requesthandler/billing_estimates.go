@@ -0,0 +1,63 @@
+package requesthandler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	bmbilling "monorepo/bin-billing-manager/models/billing"
+	bmestimate "monorepo/bin-billing-manager/models/estimate"
+	bmrequest "monorepo/bin-billing-manager/pkg/listenhandler/models/request"
+	commonaddress "monorepo/bin-common-handler/models/address"
+	"monorepo/bin-common-handler/models/sock"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+)
+
+// BillingV1EstimateQuote returns the estimated cost of the usage of the given cost type, destination and duration.
+//
+// duration: seconds
+func (r *requestHandler) BillingV1EstimateQuote(ctx context.Context, customerID uuid.UUID, costType bmbilling.CostType, destination *commonaddress.Address, duration int) (*bmestimate.Estimate, error) {
+	uri := "/v1/estimates"
+
+	m, err := json.Marshal(bmrequest.V1DataEstimatesPOST{
+		CustomerID:  customerID,
+		CostType:    costType,
+		Destination: destination,
+		Duration:    duration,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not marshal the request")
+	}
+
+	tmp, err := r.sendRequestBilling(ctx, uri, sock.RequestMethodPost, "billing/estimates", requestTimeoutDefault, 0, ContentTypeJSON, m)
+	if err != nil {
+		return nil, err
+	}
+
+	var res bmestimate.Estimate
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return &res, nil
+}
+
+// BillingV1EstimateGetByReferenceID returns the estimated cost of the billing of the given reference id.
+// The cost of the progressing billing is accrued until now.
+func (r *requestHandler) BillingV1EstimateGetByReferenceID(ctx context.Context, referenceID uuid.UUID) (*bmestimate.Estimate, error) {
+	uri := fmt.Sprintf("/v1/estimates/reference_id/%s", referenceID.String())
+
+	tmp, err := r.sendRequestBilling(ctx, uri, sock.RequestMethodGet, "billing/estimates/reference_id/<reference-id>", requestTimeoutDefault, 0, ContentTypeJSON, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res bmestimate.Estimate
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return &res, nil
+}
@@ -0,0 +1,151 @@
+package requesthandler
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	bmbilling "monorepo/bin-billing-manager/models/billing"
+	bmestimate "monorepo/bin-billing-manager/models/estimate"
+	commonaddress "monorepo/bin-common-handler/models/address"
+	"monorepo/bin-common-handler/models/sock"
+	"monorepo/bin-common-handler/pkg/sockhandler"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_BillingV1EstimateQuote(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		customerID  uuid.UUID
+		costType    bmbilling.CostType
+		destination *commonaddress.Address
+		duration    int
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+		expectRes     *bmestimate.Estimate
+	}{
+		{
+			name: "normal",
+
+			customerID: uuid.FromStringOrNil("e1f2a3b4-b2d6-11f0-8c9d-2e3f4a5b6c7d"),
+			costType:   bmbilling.CostTypeCallPSTNOutgoing,
+			destination: &commonaddress.Address{
+				Type:   commonaddress.TypeTel,
+				Target: "+821012345678",
+			},
+			duration: 150,
+
+			response: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"customer_id":"e1f2a3b4-b2d6-11f0-8c9d-2e3f4a5b6c7d","amount_credit":30000}`),
+			},
+
+			expectTarget: "bin-manager.billing-manager.request",
+			expectRequest: &sock.Request{
+				URI:      "/v1/estimates",
+				Method:   sock.RequestMethodPost,
+				DataType: "application/json",
+				Data:     []byte(`{"customer_id":"e1f2a3b4-b2d6-11f0-8c9d-2e3f4a5b6c7d","cost_type":"call_pstn_outgoing","destination":{"type":"tel","target":"+821012345678"},"duration":150}`),
+			},
+			expectRes: &bmestimate.Estimate{
+				CustomerID:   uuid.FromStringOrNil("e1f2a3b4-b2d6-11f0-8c9d-2e3f4a5b6c7d"),
+				AmountCredit: 30000,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			h := requestHandler{
+				sock: mockSock,
+			}
+			ctx := context.Background()
+
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, err := h.BillingV1EstimateQuote(ctx, tt.customerID, tt.costType, tt.destination, tt.duration)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(tt.expectRes, res) {
+				t.Errorf("Wrong match. expect: %v, got: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_BillingV1EstimateGetByReferenceID(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		referenceID uuid.UUID
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+		expectRes     *bmestimate.Estimate
+	}{
+		{
+			name: "normal",
+
+			referenceID: uuid.FromStringOrNil("f2a3b4c5-b2d6-11f0-9d0e-3f4a5b6c7d8e"),
+
+			response: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"reference_id":"f2a3b4c5-b2d6-11f0-9d0e-3f4a5b6c7d8e","duration":125,"amount_credit":30000}`),
+			},
+
+			expectTarget: "bin-manager.billing-manager.request",
+			expectRequest: &sock.Request{
+				URI:      "/v1/estimates/reference_id/f2a3b4c5-b2d6-11f0-9d0e-3f4a5b6c7d8e",
+				Method:   sock.RequestMethodGet,
+				DataType: "application/json",
+			},
+			expectRes: &bmestimate.Estimate{
+				ReferenceID:  uuid.FromStringOrNil("f2a3b4c5-b2d6-11f0-9d0e-3f4a5b6c7d8e"),
+				Duration:     125,
+				AmountCredit: 30000,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			h := requestHandler{
+				sock: mockSock,
+			}
+			ctx := context.Background()
+
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, err := h.BillingV1EstimateGetByReferenceID(ctx, tt.referenceID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(tt.expectRes, res) {
+				t.Errorf("Wrong match. expect: %v, got: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package requesthandler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	bmstatement "monorepo/bin-billing-manager/models/statement"
+	"monorepo/bin-common-handler/models/sock"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+)
+
+// BillingV1StatementList returns list of statements.
+func (r *requestHandler) BillingV1StatementList(ctx context.Context, pageToken string, pageSize uint64, filters map[bmstatement.Field]any) ([]bmstatement.Statement, error) {
+	uri := fmt.Sprintf("/v1/statements?page_token=%s&page_size=%d", url.QueryEscape(pageToken), pageSize)
+
+	m, err := json.Marshal(filters)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not marshal filters")
+	}
+
+	tmp, err := r.sendRequestBilling(ctx, uri, sock.RequestMethodGet, "billing/statements", requestTimeoutDefault, 0, ContentTypeJSON, m)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []bmstatement.Statement
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return res, nil
+}
+
+// BillingV1StatementGet returns a single statement by ID.
+func (r *requestHandler) BillingV1StatementGet(ctx context.Context, statementID uuid.UUID) (*bmstatement.Statement, error) {
+	uri := fmt.Sprintf("/v1/statements/%s", statementID.String())
+
+	tmp, err := r.sendRequestBilling(ctx, uri, sock.RequestMethodGet, "billing/statements/<statement-id>", requestTimeoutDefault, 0, ContentTypeJSON, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res bmstatement.Statement
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return &res, nil
+}
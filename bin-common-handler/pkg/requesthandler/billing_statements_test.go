@@ -0,0 +1,150 @@
+package requesthandler
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	bmstatement "monorepo/bin-billing-manager/models/statement"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/models/sock"
+	"monorepo/bin-common-handler/pkg/sockhandler"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_BillingV1StatementList(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		size    uint64
+		token   string
+		filters map[bmstatement.Field]any
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+		expectRes     []bmstatement.Statement
+	}{
+		{
+			name: "normal",
+
+			size:  10,
+			token: "2026-10-01T03:00:00.000000Z",
+			filters: map[bmstatement.Field]any{
+				bmstatement.FieldCustomerID: uuid.FromStringOrNil("9b2e4c6a-b1a5-11f0-8e1f-3a4b5c6d7e80"),
+			},
+
+			response: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`[{"id":"9b5a1d3e-b1a5-11f0-a2b4-5c6d7e8f9a10"}]`),
+			},
+
+			expectTarget: "bin-manager.billing-manager.request",
+			expectRequest: &sock.Request{
+				URI:      "/v1/statements?page_token=2026-10-01T03%3A00%3A00.000000Z&page_size=10",
+				Method:   sock.RequestMethodGet,
+				DataType: "application/json",
+				Data:     []byte(`{"customer_id":"9b2e4c6a-b1a5-11f0-8e1f-3a4b5c6d7e80"}`),
+			},
+			expectRes: []bmstatement.Statement{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("9b5a1d3e-b1a5-11f0-a2b4-5c6d7e8f9a10"),
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			h := requestHandler{
+				sock: mockSock,
+			}
+			ctx := context.Background()
+
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, err := h.BillingV1StatementList(ctx, tt.token, tt.size, tt.filters)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(tt.expectRes, res) {
+				t.Errorf("Wrong match. expect: %v, got: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_BillingV1StatementGet(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		statementID uuid.UUID
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+		expectRes     *bmstatement.Statement
+	}{
+		{
+			name: "normal",
+
+			statementID: uuid.FromStringOrNil("c1d3e5f7-b1a5-11f0-9a2c-4b6d8f0a2c40"),
+
+			response: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"c1d3e5f7-b1a5-11f0-9a2c-4b6d8f0a2c40"}`),
+			},
+
+			expectTarget: "bin-manager.billing-manager.request",
+			expectRequest: &sock.Request{
+				URI:      "/v1/statements/c1d3e5f7-b1a5-11f0-9a2c-4b6d8f0a2c40",
+				Method:   sock.RequestMethodGet,
+				DataType: "application/json",
+			},
+			expectRes: &bmstatement.Statement{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("c1d3e5f7-b1a5-11f0-9a2c-4b6d8f0a2c40"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			h := requestHandler{
+				sock: mockSock,
+			}
+			ctx := context.Background()
+
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, err := h.BillingV1StatementGet(ctx, tt.statementID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(tt.expectRes, res) {
+				t.Errorf("Wrong match. expect: %v, got: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
@@ -532,6 +532,32 @@ func (r *requestHandler) CallV1CallUpdateConfbridgeID(ctx context.Context, callI
 	return &res, nil
 }
 
+// CallV1CallUpdateMaxCost sends a request to call-manager
+// to update the call's max cost.
+// it returns updated call if it succeed.
+func (r *requestHandler) CallV1CallUpdateMaxCost(ctx context.Context, callID uuid.UUID, maxCost int64) (*cmcall.Call, error) {
+	uri := fmt.Sprintf("/v1/calls/%s/max_cost", callID)
+
+	m, err := json.Marshal(cmrequest.V1DataCallsIDMaxCostPut{
+		MaxCost: maxCost,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tmp, err := r.sendRequestCall(ctx, uri, sock.RequestMethodPut, "call/calls/<call-id>/max_cost", requestTimeoutDefault, 0, ContentTypeJSON, m)
+	if err != nil {
+		return nil, err
+	}
+
+	var res cmcall.Call
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return &res, nil
+}
+
 // CallV1CallTalk sends a request to call-manager
 // to talk to the call directly.
 // it returns error if something went wrong.
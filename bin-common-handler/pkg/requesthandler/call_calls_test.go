@@ -1376,6 +1376,70 @@ func Test_CallV1CallUpdateConfbridgeID(t *testing.T) {
 	}
 }
 
+func Test_CallV1CallUpdateMaxCost(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		callID  uuid.UUID
+		maxCost int64
+
+		expectTarget  string
+		expectRequest *sock.Request
+		response      *sock.Response
+		expectRes     *cmcall.Call
+	}{
+		{
+			"normal",
+
+			uuid.FromStringOrNil("a4c1e7b2-b2dd-11f0-8e3f-4c5d6e7f8a9b"),
+			3000000,
+
+			"bin-manager.call-manager.request",
+			&sock.Request{
+				URI:      "/v1/calls/a4c1e7b2-b2dd-11f0-8e3f-4c5d6e7f8a9b/max_cost",
+				Method:   sock.RequestMethodPut,
+				DataType: "application/json",
+				Data:     []byte(`{"max_cost":3000000}`),
+			},
+			&sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"a4c1e7b2-b2dd-11f0-8e3f-4c5d6e7f8a9b"}`),
+			},
+			&cmcall.Call{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("a4c1e7b2-b2dd-11f0-8e3f-4c5d6e7f8a9b"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			reqHandler := requestHandler{
+				sock: mockSock,
+			}
+
+			ctx := context.Background()
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, err := reqHandler.CallV1CallUpdateMaxCost(ctx, tt.callID, tt.maxCost)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v\n", tt.expectRes, res)
+			}
+		})
+	}
+}
+
 func Test_CallV1CallTalk(t *testing.T) {
 
 	tests := []struct {
@@ -7,6 +7,7 @@ import (
 	"net/url"
 
 	cacampaigncall "monorepo/bin-campaign-manager/models/campaigncall"
+	carequest "monorepo/bin-campaign-manager/pkg/listenhandler/models/request"
 	"monorepo/bin-common-handler/models/sock"
 
 	"github.com/gofrs/uuid"
@@ -74,3 +75,79 @@ func (r *requestHandler) CampaignV1CampaigncallDelete(ctx context.Context, id uu
 
 	return &res, nil
 }
+
+// CampaignV1CampaigncallAccept sends a request to campaign-manager
+// to accept the previewing campaigncall by the agent.
+// it returns accepted campaigncall if it succeed.
+func (r *requestHandler) CampaignV1CampaigncallAccept(ctx context.Context, id uuid.UUID, agentID uuid.UUID) (*cacampaigncall.Campaigncall, error) {
+	uri := fmt.Sprintf("/v1/campaigncalls/%s/accept", id)
+
+	data := &carequest.V1DataCampaigncallsIDAcceptPost{
+		AgentID: agentID,
+	}
+
+	m, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	tmp, err := r.sendRequestCampaign(ctx, uri, sock.RequestMethodPost, "campaign/campaigncalls", requestTimeoutDefault, 0, ContentTypeJSON, m)
+	if err != nil {
+		return nil, err
+	}
+
+	var res cacampaigncall.Campaigncall
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return &res, nil
+}
+
+// CampaignV1CampaigncallSkip sends a request to campaign-manager
+// to skip the previewing campaigncall.
+// it returns skipped campaigncall if it succeed.
+func (r *requestHandler) CampaignV1CampaigncallSkip(ctx context.Context, id uuid.UUID) (*cacampaigncall.Campaigncall, error) {
+	uri := fmt.Sprintf("/v1/campaigncalls/%s/skip", id)
+
+	tmp, err := r.sendRequestCampaign(ctx, uri, sock.RequestMethodPost, "campaign/campaigncalls", requestTimeoutDefault, 0, ContentTypeJSON, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res cacampaigncall.Campaigncall
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return &res, nil
+}
+
+// CampaignV1CampaigncallUpdateDisposition sends a request to campaign-manager
+// to set the campaigncall's disposition.
+// the disposition is the customer's disposition code. empty disposition clears it.
+// it returns updated campaigncall if it succeed.
+func (r *requestHandler) CampaignV1CampaigncallUpdateDisposition(ctx context.Context, id uuid.UUID, disposition string) (*cacampaigncall.Campaigncall, error) {
+	uri := fmt.Sprintf("/v1/campaigncalls/%s/disposition", id)
+
+	data := &carequest.V1DataCampaigncallsIDDispositionPut{
+		Disposition: disposition,
+	}
+
+	m, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	tmp, err := r.sendRequestCampaign(ctx, uri, sock.RequestMethodPut, "campaign/campaigncalls", requestTimeoutDefault, 0, ContentTypeJSON, m)
+	if err != nil {
+		return nil, err
+	}
+
+	var res cacampaigncall.Campaigncall
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return &res, nil
+}
@@ -286,3 +286,198 @@ func Test_CampaignV1CampaigncallDelete(t *testing.T) {
 		})
 	}
 }
+
+func Test_CampaignV1CampaigncallAccept(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		campaigncallID uuid.UUID
+		agentID        uuid.UUID
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+		expectResult  *cacampaigncall.Campaigncall
+	}{
+		{
+			"normal",
+
+			uuid.FromStringOrNil("3c1f8a0e-4f2e-11f0-9a51-7f0c2d6b8e21"),
+			uuid.FromStringOrNil("3c4e7b9c-4f2e-11f0-b1d2-3b8e5f7a9c31"),
+
+			&sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"3c1f8a0e-4f2e-11f0-9a51-7f0c2d6b8e21"}`),
+			},
+
+			"bin-manager.campaign-manager.request",
+			&sock.Request{
+				URI:      "/v1/campaigncalls/3c1f8a0e-4f2e-11f0-9a51-7f0c2d6b8e21/accept",
+				Method:   sock.RequestMethodPost,
+				DataType: ContentTypeJSON,
+				Data:     []byte(`{"agent_id":"3c4e7b9c-4f2e-11f0-b1d2-3b8e5f7a9c31"}`),
+			},
+			&cacampaigncall.Campaigncall{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("3c1f8a0e-4f2e-11f0-9a51-7f0c2d6b8e21"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			reqHandler := requestHandler{
+				sock: mockSock,
+			}
+
+			ctx := context.Background()
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, err := reqHandler.CampaignV1CampaigncallAccept(ctx, tt.campaigncallID, tt.agentID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(*tt.expectResult, *res) == false {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v\n", *tt.expectResult, *res)
+			}
+		})
+	}
+}
+
+func Test_CampaignV1CampaigncallSkip(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		campaigncallID uuid.UUID
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+		expectResult  *cacampaigncall.Campaigncall
+	}{
+		{
+			"normal",
+
+			uuid.FromStringOrNil("5a7d2c3e-4f2e-11f0-8e4f-9b1a3c5d7e41"),
+			&sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"5a7d2c3e-4f2e-11f0-8e4f-9b1a3c5d7e41"}`),
+			},
+
+			"bin-manager.campaign-manager.request",
+			&sock.Request{
+				URI:      "/v1/campaigncalls/5a7d2c3e-4f2e-11f0-8e4f-9b1a3c5d7e41/skip",
+				Method:   sock.RequestMethodPost,
+				DataType: ContentTypeJSON,
+			},
+			&cacampaigncall.Campaigncall{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("5a7d2c3e-4f2e-11f0-8e4f-9b1a3c5d7e41"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			reqHandler := requestHandler{
+				sock: mockSock,
+			}
+
+			ctx := context.Background()
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, err := reqHandler.CampaignV1CampaigncallSkip(ctx, tt.campaigncallID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(*tt.expectResult, *res) == false {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v\n", *tt.expectResult, *res)
+			}
+		})
+	}
+}
+
+func Test_CampaignV1CampaigncallUpdateDisposition(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		campaigncallID uuid.UUID
+		disposition    string
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+		expectResult  *cacampaigncall.Campaigncall
+	}{
+		{
+			"normal",
+
+			uuid.FromStringOrNil("7b68f7d0-ad7b-11f0-b279-8a9b0c1d2e08"),
+			"sale",
+
+			&sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"7b68f7d0-ad7b-11f0-b279-8a9b0c1d2e08","disposition":"sale"}`),
+			},
+
+			"bin-manager.campaign-manager.request",
+			&sock.Request{
+				URI:      "/v1/campaigncalls/7b68f7d0-ad7b-11f0-b279-8a9b0c1d2e08/disposition",
+				Method:   sock.RequestMethodPut,
+				DataType: ContentTypeJSON,
+				Data:     []byte(`{"disposition":"sale"}`),
+			},
+			&cacampaigncall.Campaigncall{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("7b68f7d0-ad7b-11f0-b279-8a9b0c1d2e08"),
+				},
+				Disposition: "sale",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			reqHandler := requestHandler{
+				sock: mockSock,
+			}
+
+			ctx := context.Background()
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, err := reqHandler.CampaignV1CampaigncallUpdateDisposition(ctx, tt.campaigncallID, tt.disposition)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(*tt.expectResult, *res) == false {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v\n", *tt.expectResult, *res)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package requesthandler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	cacampaignresultjob "monorepo/bin-campaign-manager/models/campaignresultjob"
+	carequest "monorepo/bin-campaign-manager/pkg/listenhandler/models/request"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+
+	"monorepo/bin-common-handler/models/sock"
+)
+
+// CampaignV1CampaignresultjobCreate sends a request to campaign-manager
+// to start a new export of the campaign's results.
+// it returns created campaignresultjob if it succeed.
+func (r *requestHandler) CampaignV1CampaignresultjobCreate(ctx context.Context, campaignID uuid.UUID) (*cacampaignresultjob.CampaignResultJob, error) {
+	uri := "/v1/campaignresultjobs"
+
+	m, err := json.Marshal(&carequest.V1DataCampaignresultjobsPost{
+		CampaignID: campaignID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tmp, err := r.sendRequestCampaign(ctx, uri, sock.RequestMethodPost, "campaign/campaignresultjobs", requestTimeoutDefault, 0, ContentTypeJSON, m)
+	if err != nil {
+		return nil, err
+	}
+
+	var res cacampaignresultjob.CampaignResultJob
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return &res, nil
+}
+
+// CampaignV1CampaignresultjobGet sends a request to campaign-manager
+// to get the campaignresultjob.
+// it returns the campaignresultjob if it succeed.
+func (r *requestHandler) CampaignV1CampaignresultjobGet(ctx context.Context, id uuid.UUID) (*cacampaignresultjob.CampaignResultJob, error) {
+	uri := fmt.Sprintf("/v1/campaignresultjobs/%s", id)
+
+	tmp, err := r.sendRequestCampaign(ctx, uri, sock.RequestMethodGet, "campaign/campaignresultjobs", requestTimeoutDefault, 0, ContentTypeJSON, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res cacampaignresultjob.CampaignResultJob
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return &res, nil
+}
+
+// CampaignV1CampaignresultjobList sends a request to campaign-manager
+// to get a list of campaignresultjobs.
+// it returns list of campaignresultjobs if it succeed.
+func (r *requestHandler) CampaignV1CampaignresultjobList(ctx context.Context, pageToken string, pageSize uint64, filters map[cacampaignresultjob.Field]any) ([]cacampaignresultjob.CampaignResultJob, error) {
+	uri := fmt.Sprintf("/v1/campaignresultjobs?page_token=%s&page_size=%d", url.QueryEscape(pageToken), pageSize)
+
+	m, err := json.Marshal(filters)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not marshal filters")
+	}
+
+	tmp, err := r.sendRequestCampaign(ctx, uri, sock.RequestMethodGet, "campaign/campaignresultjobs", requestTimeoutDefault, 0, ContentTypeJSON, m)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []cacampaignresultjob.CampaignResultJob
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return res, nil
+}
@@ -0,0 +1,220 @@
+package requesthandler
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"reflect"
+	"testing"
+
+	cacampaignresultjob "monorepo/bin-campaign-manager/models/campaignresultjob"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+
+	"monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/models/sock"
+	"monorepo/bin-common-handler/pkg/sockhandler"
+)
+
+func Test_CampaignV1CampaignresultjobCreate(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		campaignID uuid.UUID
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+		expectResult  *cacampaignresultjob.CampaignResultJob
+	}{
+		{
+			"normal",
+
+			uuid.FromStringOrNil("c31a6e52-b5e0-11f0-9d4c-1b2c3d4e5f01"),
+
+			&sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"c34c7f74-b5e0-11f0-8a5d-2c3d4e5f6a02","campaign_id":"c31a6e52-b5e0-11f0-9d4c-1b2c3d4e5f01","status":"processing"}`),
+			},
+
+			"bin-manager.campaign-manager.request",
+			&sock.Request{
+				URI:      "/v1/campaignresultjobs",
+				Method:   sock.RequestMethodPost,
+				DataType: ContentTypeJSON,
+				Data:     []byte(`{"campaign_id":"c31a6e52-b5e0-11f0-9d4c-1b2c3d4e5f01"}`),
+			},
+			&cacampaignresultjob.CampaignResultJob{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("c34c7f74-b5e0-11f0-8a5d-2c3d4e5f6a02"),
+				},
+				CampaignID: uuid.FromStringOrNil("c31a6e52-b5e0-11f0-9d4c-1b2c3d4e5f01"),
+				Status:     cacampaignresultjob.StatusProcessing,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			reqHandler := requestHandler{
+				sock: mockSock,
+			}
+
+			ctx := context.Background()
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, err := reqHandler.CampaignV1CampaignresultjobCreate(ctx, tt.campaignID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(tt.expectResult, res) == false {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v\n", tt.expectResult, res)
+			}
+		})
+	}
+}
+
+func Test_CampaignV1CampaignresultjobGet(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		id uuid.UUID
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+		expectResult  *cacampaignresultjob.CampaignResultJob
+	}{
+		{
+			"normal",
+
+			uuid.FromStringOrNil("c37d9096-b5e0-11f0-b16e-3d4e5f6a7b03"),
+
+			&sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"c37d9096-b5e0-11f0-b16e-3d4e5f6a7b03"}`),
+			},
+
+			"bin-manager.campaign-manager.request",
+			&sock.Request{
+				URI:      "/v1/campaignresultjobs/c37d9096-b5e0-11f0-b16e-3d4e5f6a7b03",
+				Method:   sock.RequestMethodGet,
+				DataType: ContentTypeJSON,
+			},
+			&cacampaignresultjob.CampaignResultJob{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("c37d9096-b5e0-11f0-b16e-3d4e5f6a7b03"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			reqHandler := requestHandler{
+				sock: mockSock,
+			}
+
+			ctx := context.Background()
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, err := reqHandler.CampaignV1CampaignresultjobGet(ctx, tt.id)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(tt.expectResult, res) == false {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v\n", tt.expectResult, res)
+			}
+		})
+	}
+}
+
+func Test_CampaignV1CampaignresultjobList(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		campaignID uuid.UUID
+		pageToken  string
+		pageSize   uint64
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+		expectResult  []cacampaignresultjob.CampaignResultJob
+	}{
+		{
+			"normal",
+
+			uuid.FromStringOrNil("c3aea1b8-b5e0-11f0-a27f-4e5f6a7b8c04"),
+			"2020-09-20T03:23:20.995000Z",
+			10,
+
+			&sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`[{"id":"c3dfb2da-b5e0-11f0-9380-5f6a7b8c9d05"}]`),
+			},
+
+			"bin-manager.campaign-manager.request",
+			&sock.Request{
+				URI:      fmt.Sprintf("/v1/campaignresultjobs?page_token=%s&page_size=10", url.QueryEscape("2020-09-20T03:23:20.995000Z")),
+				Method:   sock.RequestMethodGet,
+				DataType: ContentTypeJSON,
+				Data:     []byte(`{"campaign_id":"c3aea1b8-b5e0-11f0-a27f-4e5f6a7b8c04"}`),
+			},
+			[]cacampaignresultjob.CampaignResultJob{
+				{
+					Identity: identity.Identity{
+						ID: uuid.FromStringOrNil("c3dfb2da-b5e0-11f0-9380-5f6a7b8c9d05"),
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			reqHandler := requestHandler{
+				sock: mockSock,
+			}
+
+			ctx := context.Background()
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			filters := map[cacampaignresultjob.Field]any{
+				cacampaignresultjob.FieldCampaignID: tt.campaignID,
+			}
+			res, err := reqHandler.CampaignV1CampaignresultjobList(ctx, tt.pageToken, tt.pageSize, filters)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(tt.expectResult, res) == false {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v\n", tt.expectResult, res)
+			}
+		})
+	}
+}
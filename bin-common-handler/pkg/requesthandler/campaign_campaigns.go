@@ -7,6 +7,7 @@ import (
 	"net/url"
 
 	cacampaign "monorepo/bin-campaign-manager/models/campaign"
+	cacampaignresult "monorepo/bin-campaign-manager/models/campaignresult"
 	carequest "monorepo/bin-campaign-manager/pkg/listenhandler/models/request"
 	"monorepo/bin-common-handler/models/sock"
 
@@ -243,6 +244,64 @@ func (r *requestHandler) CampaignV1CampaignUpdateServiceLevel(ctx context.Contex
 	return &res, nil
 }
 
+// CampaignV1CampaignUpdateDialMode sends a request to campaign-manager
+// to update the dial mode.
+// it returns updated campaign if it succeed.
+func (r *requestHandler) CampaignV1CampaignUpdateDialMode(ctx context.Context, id uuid.UUID, dialMode cacampaign.DialMode, maxAbandonRate int) (*cacampaign.Campaign, error) {
+	uri := fmt.Sprintf("/v1/campaigns/%s/dial_mode", id)
+
+	data := &carequest.V1DataCampaignsIDDialModePut{
+		DialMode:       dialMode,
+		MaxAbandonRate: maxAbandonRate,
+	}
+
+	m, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	tmp, err := r.sendRequestCampaign(ctx, uri, sock.RequestMethodPut, "campaign/campaigns", requestTimeoutDefault, 0, ContentTypeJSON, m)
+	if err != nil {
+		return nil, err
+	}
+
+	var res cacampaign.Campaign
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return &res, nil
+}
+
+// CampaignV1CampaignUpdateCallingWindows sends a request to campaign-manager
+// to update the calling windows.
+// it returns updated campaign if it succeed.
+func (r *requestHandler) CampaignV1CampaignUpdateCallingWindows(ctx context.Context, id uuid.UUID, callingWindows []cacampaign.CallingWindow, timezone string) (*cacampaign.Campaign, error) {
+	uri := fmt.Sprintf("/v1/campaigns/%s/calling_windows", id)
+
+	data := &carequest.V1DataCampaignsIDCallingWindowsPut{
+		CallingWindows: callingWindows,
+		Timezone:       timezone,
+	}
+
+	m, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	tmp, err := r.sendRequestCampaign(ctx, uri, sock.RequestMethodPut, "campaign/campaigns", requestTimeoutDefault, 0, ContentTypeJSON, m)
+	if err != nil {
+		return nil, err
+	}
+
+	var res cacampaign.Campaign
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return &res, nil
+}
+
 // CampaignV1CampaignUpdateActions sends a request to campaign-manager
 // to update the actions.
 // it returns updated campaign if it succeed.
@@ -329,3 +388,23 @@ func (r *requestHandler) CampaignV1CampaignUpdateNextCampaignID(ctx context.Cont
 
 	return &res, nil
 }
+
+// CampaignV1CampaignResultList sends a request to campaign-manager
+// to getting a list of the campaign's results.
+// the pagination follows the campaign's outdial targets.
+// it returns list of the campaign results if it succeed.
+func (r *requestHandler) CampaignV1CampaignResultList(ctx context.Context, id uuid.UUID, pageToken string, pageSize uint64) ([]cacampaignresult.CampaignResult, error) {
+	uri := fmt.Sprintf("/v1/campaigns/%s/results?page_token=%s&page_size=%d", id, url.QueryEscape(pageToken), pageSize)
+
+	tmp, err := r.sendRequestCampaign(ctx, uri, sock.RequestMethodGet, "campaign/campaigns/results", requestTimeoutDefault, 0, ContentTypeJSON, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []cacampaignresult.CampaignResult
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return res, nil
+}
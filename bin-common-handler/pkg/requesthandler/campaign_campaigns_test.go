@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	cacampaign "monorepo/bin-campaign-manager/models/campaign"
+	cacampaignresult "monorepo/bin-campaign-manager/models/campaignresult"
 
 	fmaction "monorepo/bin-flow-manager/models/action"
 
@@ -805,3 +806,219 @@ func Test_CampaignV1CampaignUpdateNextCampaignID(t *testing.T) {
 		})
 	}
 }
+
+func Test_CampaignV1CampaignUpdateDialMode(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		campaignID     uuid.UUID
+		dialMode       cacampaign.DialMode
+		maxAbandonRate int
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+		expectResult  *cacampaign.Campaign
+	}{
+		{
+			"normal",
+
+			uuid.FromStringOrNil("0f5b5d4a-4f2e-11f0-8d3c-0b3f2a1e9c11"),
+			cacampaign.DialModePredictive,
+			3,
+
+			&sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"0f5b5d4a-4f2e-11f0-8d3c-0b3f2a1e9c11"}`),
+			},
+
+			"bin-manager.campaign-manager.request",
+			&sock.Request{
+				URI:      "/v1/campaigns/0f5b5d4a-4f2e-11f0-8d3c-0b3f2a1e9c11/dial_mode",
+				Method:   sock.RequestMethodPut,
+				DataType: ContentTypeJSON,
+				Data:     []byte(`{"dial_mode":"predictive","max_abandon_rate":3}`),
+			},
+			&cacampaign.Campaign{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("0f5b5d4a-4f2e-11f0-8d3c-0b3f2a1e9c11"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			reqHandler := requestHandler{
+				sock: mockSock,
+			}
+
+			ctx := context.Background()
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, err := reqHandler.CampaignV1CampaignUpdateDialMode(ctx, tt.campaignID, tt.dialMode, tt.maxAbandonRate)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(*tt.expectResult, *res) == false {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v\n", *tt.expectResult, *res)
+			}
+		})
+	}
+}
+
+func Test_CampaignV1CampaignUpdateCallingWindows(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		campaignID     uuid.UUID
+		callingWindows []cacampaign.CallingWindow
+		timezone       string
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+		expectResult  *cacampaign.Campaign
+	}{
+		{
+			"normal",
+
+			uuid.FromStringOrNil("f1c2d3e4-adbb-11f0-a1b2-3c4d5e6f7a01"),
+			[]cacampaign.CallingWindow{
+				{
+					Days:  []cacampaign.Day{cacampaign.DayMonday},
+					Start: "09:00",
+					End:   "21:00",
+				},
+			},
+			"Asia/Seoul",
+
+			&sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"f1c2d3e4-adbb-11f0-a1b2-3c4d5e6f7a01"}`),
+			},
+
+			"bin-manager.campaign-manager.request",
+			&sock.Request{
+				URI:      "/v1/campaigns/f1c2d3e4-adbb-11f0-a1b2-3c4d5e6f7a01/calling_windows",
+				Method:   sock.RequestMethodPut,
+				DataType: ContentTypeJSON,
+				Data:     []byte(`{"calling_windows":[{"days":["monday"],"start":"09:00","end":"21:00"}],"timezone":"Asia/Seoul"}`),
+			},
+			&cacampaign.Campaign{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("f1c2d3e4-adbb-11f0-a1b2-3c4d5e6f7a01"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			reqHandler := requestHandler{
+				sock: mockSock,
+			}
+
+			ctx := context.Background()
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, err := reqHandler.CampaignV1CampaignUpdateCallingWindows(ctx, tt.campaignID, tt.callingWindows, tt.timezone)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(*tt.expectResult, *res) == false {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v\n", *tt.expectResult, *res)
+			}
+		})
+	}
+}
+
+func Test_CampaignV1CampaignResultList(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		campaignID uuid.UUID
+		pageToken  string
+		pageSize   uint64
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+		expectResult  []cacampaignresult.CampaignResult
+	}{
+		{
+			"normal",
+
+			uuid.FromStringOrNil("7b9808e2-ad7b-11f0-a388-9b0c1d2e3f09"),
+			"2020-09-20T03:23:20.995000Z",
+			10,
+
+			&sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`[{"campaign_id":"7b9808e2-ad7b-11f0-a388-9b0c1d2e3f09","outdial_target_id":"7bc719f4-ad7b-11f0-9497-0c1d2e3f4a10","attempts":[{"campaigncall_id":"7bf62b06-ad7b-11f0-85a6-1d2e3f4a5b11","disposition":"sale"}]}]`),
+			},
+
+			"bin-manager.campaign-manager.request",
+			&sock.Request{
+				URI:      fmt.Sprintf("/v1/campaigns/7b9808e2-ad7b-11f0-a388-9b0c1d2e3f09/results?page_token=%s&page_size=10", url.QueryEscape("2020-09-20T03:23:20.995000Z")),
+				Method:   sock.RequestMethodGet,
+				DataType: ContentTypeJSON,
+			},
+			[]cacampaignresult.CampaignResult{
+				{
+					CampaignID:      uuid.FromStringOrNil("7b9808e2-ad7b-11f0-a388-9b0c1d2e3f09"),
+					OutdialTargetID: uuid.FromStringOrNil("7bc719f4-ad7b-11f0-9497-0c1d2e3f4a10"),
+					Attempts: []cacampaignresult.Attempt{
+						{
+							CampaigncallID: uuid.FromStringOrNil("7bf62b06-ad7b-11f0-85a6-1d2e3f4a5b11"),
+							Disposition:    "sale",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			reqHandler := requestHandler{
+				sock: mockSock,
+			}
+
+			ctx := context.Background()
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, err := reqHandler.CampaignV1CampaignResultList(ctx, tt.campaignID, tt.pageToken, tt.pageSize)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(tt.expectResult, res) == false {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v\n", tt.expectResult, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package requesthandler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	cadisposition "monorepo/bin-campaign-manager/models/disposition"
+	carequest "monorepo/bin-campaign-manager/pkg/listenhandler/models/request"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+
+	"monorepo/bin-common-handler/models/sock"
+)
+
+// CampaignV1DispositionCreate sends a request to campaign-manager
+// to create a new disposition.
+// it returns created disposition if it succeed.
+func (r *requestHandler) CampaignV1DispositionCreate(
+	ctx context.Context,
+	customerID uuid.UUID,
+	code string,
+	name string,
+	detail string,
+	retryMode cadisposition.RetryMode,
+) (*cadisposition.Disposition, error) {
+	uri := "/v1/dispositions"
+
+	reqData := &carequest.V1DataDispositionsPost{
+		CustomerID: customerID,
+		Code:       code,
+		Name:       name,
+		Detail:     detail,
+		RetryMode:  retryMode,
+	}
+
+	m, err := json.Marshal(reqData)
+	if err != nil {
+		return nil, err
+	}
+
+	tmp, err := r.sendRequestCampaign(ctx, uri, sock.RequestMethodPost, "campaign/dispositions", requestTimeoutDefault, 0, ContentTypeJSON, m)
+	if err != nil {
+		return nil, err
+	}
+
+	var res cadisposition.Disposition
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return &res, nil
+}
+
+// CampaignV1DispositionList sends a request to campaign-manager
+// to getting a list of dispositions.
+// it returns detail list of dispositions if it succeed.
+func (r *requestHandler) CampaignV1DispositionList(ctx context.Context, pageToken string, pageSize uint64, filters map[cadisposition.Field]any) ([]cadisposition.Disposition, error) {
+	uri := fmt.Sprintf("/v1/dispositions?page_token=%s&page_size=%d", url.QueryEscape(pageToken), pageSize)
+
+	m, err := json.Marshal(filters)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not marshal filters")
+	}
+
+	tmp, err := r.sendRequestCampaign(ctx, uri, sock.RequestMethodGet, "campaign/dispositions", requestTimeoutDefault, 0, ContentTypeJSON, m)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []cadisposition.Disposition
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return res, nil
+}
+
+// CampaignV1DispositionGet sends a request to campaign-manager
+// to getting a detail disposition info.
+// it returns detail disposition info if it succeed.
+func (r *requestHandler) CampaignV1DispositionGet(ctx context.Context, id uuid.UUID) (*cadisposition.Disposition, error) {
+	uri := fmt.Sprintf("/v1/dispositions/%s", id)
+
+	tmp, err := r.sendRequestCampaign(ctx, uri, sock.RequestMethodGet, "campaign/dispositions", requestTimeoutDefault, 0, ContentTypeJSON, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res cadisposition.Disposition
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return &res, nil
+}
+
+// CampaignV1DispositionUpdate sends a request to campaign-manager
+// to update the disposition.
+// it returns updated disposition if it succeed.
+func (r *requestHandler) CampaignV1DispositionUpdate(ctx context.Context, id uuid.UUID, name string, detail string, retryMode cadisposition.RetryMode) (*cadisposition.Disposition, error) {
+	uri := fmt.Sprintf("/v1/dispositions/%s", id)
+
+	data := &carequest.V1DataDispositionsIDPut{
+		Name:      name,
+		Detail:    detail,
+		RetryMode: retryMode,
+	}
+
+	m, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	tmp, err := r.sendRequestCampaign(ctx, uri, sock.RequestMethodPut, "campaign/dispositions", requestTimeoutDefault, 0, ContentTypeJSON, m)
+	if err != nil {
+		return nil, err
+	}
+
+	var res cadisposition.Disposition
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return &res, nil
+}
+
+// CampaignV1DispositionDelete sends a request to campaign-manager
+// to deleting the disposition.
+// it returns deleted disposition if it succeed.
+func (r *requestHandler) CampaignV1DispositionDelete(ctx context.Context, id uuid.UUID) (*cadisposition.Disposition, error) {
+	uri := fmt.Sprintf("/v1/dispositions/%s", id)
+
+	tmp, err := r.sendRequestCampaign(ctx, uri, sock.RequestMethodDelete, "campaign/dispositions", requestTimeoutDefault, 0, ContentTypeJSON, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res cadisposition.Disposition
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return &res, nil
+}
@@ -0,0 +1,359 @@
+package requesthandler
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"reflect"
+	"testing"
+
+	cadisposition "monorepo/bin-campaign-manager/models/disposition"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+
+	"monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/models/sock"
+	"monorepo/bin-common-handler/pkg/sockhandler"
+)
+
+func Test_CampaignV1DispositionCreate(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		customerID      uuid.UUID
+		code            string
+		dispositionName string
+		detail          string
+		retryMode       cadisposition.RetryMode
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+		expectResult  *cadisposition.Disposition
+	}{
+		{
+			"normal",
+
+			uuid.FromStringOrNil("7a1f3c52-ad7b-11f0-8b0e-1f2a3b4c5d01"),
+			"wrong_number",
+			"Wrong number",
+			"test detail",
+			cadisposition.RetryModeNoRetry,
+
+			&sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"7a4e8b64-ad7b-11f0-9c1f-2a3b4c5d6e02"}`),
+			},
+
+			"bin-manager.campaign-manager.request",
+			&sock.Request{
+				URI:      "/v1/dispositions",
+				Method:   sock.RequestMethodPost,
+				DataType: ContentTypeJSON,
+				Data:     []byte(`{"customer_id":"7a1f3c52-ad7b-11f0-8b0e-1f2a3b4c5d01","code":"wrong_number","name":"Wrong number","detail":"test detail","retry_mode":"no_retry"}`),
+			},
+			&cadisposition.Disposition{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("7a4e8b64-ad7b-11f0-9c1f-2a3b4c5d6e02"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			reqHandler := requestHandler{
+				sock: mockSock,
+			}
+
+			ctx := context.Background()
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, err := reqHandler.CampaignV1DispositionCreate(ctx, tt.customerID, tt.code, tt.dispositionName, tt.detail, tt.retryMode)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(tt.expectResult, res) == false {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v\n", tt.expectResult, res)
+			}
+		})
+	}
+}
+
+func Test_CampaignV1DispositionList(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		customerID uuid.UUID
+		pageToken  string
+		pageSize   uint64
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+		expectResult  []cadisposition.Disposition
+	}{
+		{
+			"normal",
+
+			uuid.FromStringOrNil("7a7d9a76-ad7b-11f0-a20e-3b4c5d6e7f03"),
+			"2020-09-20T03:23:20.995000Z",
+			10,
+
+			&sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`[{"id":"7aacb288-ad7b-11f0-8e3d-4c5d6e7f8a04"}]`),
+			},
+
+			"bin-manager.campaign-manager.request",
+			&sock.Request{
+				URI:      fmt.Sprintf("/v1/dispositions?page_token=%s&page_size=10", url.QueryEscape("2020-09-20T03:23:20.995000Z")),
+				Method:   sock.RequestMethodGet,
+				DataType: ContentTypeJSON,
+				Data:     []byte(`{"customer_id":"7a7d9a76-ad7b-11f0-a20e-3b4c5d6e7f03"}`),
+			},
+			[]cadisposition.Disposition{
+				{
+					Identity: identity.Identity{
+						ID: uuid.FromStringOrNil("7aacb288-ad7b-11f0-8e3d-4c5d6e7f8a04"),
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			reqHandler := requestHandler{
+				sock: mockSock,
+			}
+
+			ctx := context.Background()
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			filters := map[cadisposition.Field]any{
+				cadisposition.FieldCustomerID: tt.customerID,
+			}
+			res, err := reqHandler.CampaignV1DispositionList(ctx, tt.pageToken, tt.pageSize, filters)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(tt.expectResult, res) == false {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v\n", tt.expectResult, res)
+			}
+		})
+	}
+}
+
+func Test_CampaignV1DispositionGet(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		dispositionID uuid.UUID
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+		expectResult  *cadisposition.Disposition
+	}{
+		{
+			"normal",
+
+			uuid.FromStringOrNil("7adbc49a-ad7b-11f0-b64c-5d6e7f8a9b05"),
+
+			&sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"7adbc49a-ad7b-11f0-b64c-5d6e7f8a9b05"}`),
+			},
+
+			"bin-manager.campaign-manager.request",
+			&sock.Request{
+				URI:      "/v1/dispositions/7adbc49a-ad7b-11f0-b64c-5d6e7f8a9b05",
+				Method:   sock.RequestMethodGet,
+				DataType: ContentTypeJSON,
+			},
+			&cadisposition.Disposition{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("7adbc49a-ad7b-11f0-b64c-5d6e7f8a9b05"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			reqHandler := requestHandler{
+				sock: mockSock,
+			}
+
+			ctx := context.Background()
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, err := reqHandler.CampaignV1DispositionGet(ctx, tt.dispositionID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(tt.expectResult, res) == false {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v\n", tt.expectResult, res)
+			}
+		})
+	}
+}
+
+func Test_CampaignV1DispositionUpdate(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		dispositionID   uuid.UUID
+		dispositionName string
+		detail          string
+		retryMode       cadisposition.RetryMode
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+		expectResult  *cadisposition.Disposition
+	}{
+		{
+			"normal",
+
+			uuid.FromStringOrNil("7b0ad5ac-ad7b-11f0-9a5b-6e7f8a9b0c06"),
+			"Callback requested",
+			"update detail",
+			cadisposition.RetryModeRetry,
+
+			&sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"7b0ad5ac-ad7b-11f0-9a5b-6e7f8a9b0c06"}`),
+			},
+
+			"bin-manager.campaign-manager.request",
+			&sock.Request{
+				URI:      "/v1/dispositions/7b0ad5ac-ad7b-11f0-9a5b-6e7f8a9b0c06",
+				Method:   sock.RequestMethodPut,
+				DataType: ContentTypeJSON,
+				Data:     []byte(`{"name":"Callback requested","detail":"update detail","retry_mode":"retry"}`),
+			},
+			&cadisposition.Disposition{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("7b0ad5ac-ad7b-11f0-9a5b-6e7f8a9b0c06"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			reqHandler := requestHandler{
+				sock: mockSock,
+			}
+
+			ctx := context.Background()
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, err := reqHandler.CampaignV1DispositionUpdate(ctx, tt.dispositionID, tt.dispositionName, tt.detail, tt.retryMode)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(tt.expectResult, res) == false {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v\n", tt.expectResult, res)
+			}
+		})
+	}
+}
+
+func Test_CampaignV1DispositionDelete(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		dispositionID uuid.UUID
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+		expectResult  *cadisposition.Disposition
+	}{
+		{
+			"normal",
+
+			uuid.FromStringOrNil("7b39e6be-ad7b-11f0-8f6a-7f8a9b0c1d07"),
+
+			&sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"7b39e6be-ad7b-11f0-8f6a-7f8a9b0c1d07"}`),
+			},
+
+			"bin-manager.campaign-manager.request",
+			&sock.Request{
+				URI:      "/v1/dispositions/7b39e6be-ad7b-11f0-8f6a-7f8a9b0c1d07",
+				Method:   sock.RequestMethodDelete,
+				DataType: ContentTypeJSON,
+			},
+			&cadisposition.Disposition{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("7b39e6be-ad7b-11f0-8f6a-7f8a9b0c1d07"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			reqHandler := requestHandler{
+				sock: mockSock,
+			}
+
+			ctx := context.Background()
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, err := reqHandler.CampaignV1DispositionDelete(ctx, tt.dispositionID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if reflect.DeepEqual(tt.expectResult, res) == false {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v\n", tt.expectResult, res)
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"monorepo/bin-common-handler/pkg/circuitbreakerhandler"
+	"monorepo/bin-common-handler/pkg/tracehandler"
 
 	cmari "monorepo/bin-call-manager/models/ari"
 	cmbridge "monorepo/bin-call-manager/models/bridge"
@@ -22,22 +23,37 @@ import (
 
 	bmaccount "monorepo/bin-billing-manager/models/account"
 	bmbilling "monorepo/bin-billing-manager/models/billing"
+	bmestimate "monorepo/bin-billing-manager/models/estimate"
+	bmstatement "monorepo/bin-billing-manager/models/statement"
 	cacampaign "monorepo/bin-campaign-manager/models/campaign"
 	cacampaigncall "monorepo/bin-campaign-manager/models/campaigncall"
+	cacampaignresult "monorepo/bin-campaign-manager/models/campaignresult"
+	cacampaignresultjob "monorepo/bin-campaign-manager/models/campaignresultjob"
+	cadisposition "monorepo/bin-campaign-manager/models/disposition"
 	caoutplan "monorepo/bin-campaign-manager/models/outplan"
 	csaccesskey "monorepo/bin-customer-manager/models/accesskey"
 	cscustomer "monorepo/bin-customer-manager/models/customer"
 
 	amai "monorepo/bin-ai-manager/models/ai"
+	amaiassist "monorepo/bin-ai-manager/models/aiassist"
 	amaiaudit "monorepo/bin-ai-manager/models/aiaudit"
 	amaicall "monorepo/bin-ai-manager/models/aicall"
 	amaiprompthistory "monorepo/bin-ai-manager/models/aiprompthistory"
 	amaipromptproposal "monorepo/bin-ai-manager/models/aipromptproposal"
 	amanalysis "monorepo/bin-ai-manager/models/analysis"
+	amcustomtool "monorepo/bin-ai-manager/models/customtool"
+	amextraction "monorepo/bin-ai-manager/models/extraction"
+	amguardrail "monorepo/bin-ai-manager/models/guardrail"
+	amguardrailviolation "monorepo/bin-ai-manager/models/guardrailviolation"
+	ammcpserver "monorepo/bin-ai-manager/models/mcpserver"
 	ammessage "monorepo/bin-ai-manager/models/message"
 	amparticipant "monorepo/bin-ai-manager/models/participant"
+	amredaction "monorepo/bin-ai-manager/models/redaction"
+	amresponsecache "monorepo/bin-ai-manager/models/responsecache"
 	amsummary "monorepo/bin-ai-manager/models/summary"
 	amteam "monorepo/bin-ai-manager/models/team"
+	amtestrun "monorepo/bin-ai-manager/models/testrun"
+	amtestsuite "monorepo/bin-ai-manager/models/testsuite"
 	amtool "monorepo/bin-ai-manager/models/tool"
 	commonaddress "monorepo/bin-common-handler/models/address"
 
@@ -70,6 +86,9 @@ import (
 
 	omoutdial "monorepo/bin-outdial-manager/models/outdial"
 	omoutdialtarget "monorepo/bin-outdial-manager/models/outdialtarget"
+	omoutdialtargetjob "monorepo/bin-outdial-manager/models/outdialtargetjob"
+	omsuppression "monorepo/bin-outdial-manager/models/suppression"
+	omsuppressionblock "monorepo/bin-outdial-manager/models/suppressionblock"
 	pmmessage "monorepo/bin-pipecat-manager/models/message"
 	pmpipecatcall "monorepo/bin-pipecat-manager/models/pipecatcall"
 	qmqueue "monorepo/bin-queue-manager/models/queue"
@@ -167,6 +186,20 @@ const defaultAstStasisApp = "voipbin"
 var (
 	promRequestProcessTime *prometheus.HistogramVec
 	promEventCount         *prometheus.CounterVec
+
+	promPolicyAttemptTotal         *prometheus.CounterVec
+	promPolicyResultTotal          *prometheus.CounterVec
+	promPolicyBudgetExhaustedTotal *prometheus.CounterVec
+)
+
+// list of request policy metric label values
+const (
+	policyAttemptFirst = "first"
+	policyAttemptRetry = "retry"
+	policyAttemptHedge = "hedge"
+
+	policyResultSuccess = "success"
+	policyResultFailure = "failure"
 )
 
 func initPrometheus(namespace string) {
@@ -192,9 +225,39 @@ func initPrometheus(namespace string) {
 		[]string{"event_type"},
 	)
 
+	promPolicyAttemptTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "request_policy_attempt_total",
+			Help:      "Total number of request attempts sent under the request policy. kind is first, retry or hedge.",
+		},
+		[]string{"policy", "kind"},
+	)
+
+	promPolicyResultTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "request_policy_result_total",
+			Help:      "Total number of requests completed under the request policy with the result.",
+		},
+		[]string{"policy", "result"},
+	)
+
+	promPolicyBudgetExhaustedTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "request_policy_budget_exhausted_total",
+			Help:      "Total number of retries and hedged requests skipped by the retry budget of the request policy.",
+		},
+		[]string{"policy"},
+	)
+
 	prometheus.MustRegister(
 		promRequestProcessTime,
 		promEventCount,
+		promPolicyAttemptTotal,
+		promPolicyResultTotal,
+		promPolicyBudgetExhaustedTotal,
 	)
 }
 
@@ -224,6 +287,10 @@ type RequestHandler interface {
 		sttLanguage string,
 		toolNames []amtool.ToolName,
 		autoAICallAuditEnabled bool,
+		engineFallbacks []amai.EngineFallback,
+		redactionConfig *amredaction.Config,
+		guardrailConfig *amguardrail.Config,
+		responseCacheConfig *amresponsecache.Config,
 	) (*amai.AI, error)
 	AIV1AIDelete(ctx context.Context, aiID uuid.UUID) (*amai.AI, error)
 	AIV1AIActivateInsight(ctx context.Context, aiID uuid.UUID) (*amai.AI, error)
@@ -245,6 +312,10 @@ type RequestHandler interface {
 		sttLanguage string,
 		toolNames []amtool.ToolName,
 		autoAICallAuditEnabled bool,
+		engineFallbacks []amai.EngineFallback,
+		redactionConfig *amredaction.Config,
+		guardrailConfig *amguardrail.Config,
+		responseCacheConfig *amresponsecache.Config,
 	) (*amai.AI, error)
 
 	// ai-manager prompt histories
@@ -278,6 +349,89 @@ type RequestHandler interface {
 	// ai-manager tools
 	AIV1ToolList(ctx context.Context) ([]amtool.Tool, error)
 
+	// ai-manager custom tools
+	AIV1CustomToolGet(ctx context.Context, customToolID uuid.UUID) (*amcustomtool.CustomTool, error)
+	AIV1CustomToolList(ctx context.Context, pageToken string, pageSize uint64, filters map[amcustomtool.Field]any) ([]amcustomtool.CustomTool, error)
+	AIV1CustomToolCreate(
+		ctx context.Context,
+		customerID uuid.UUID,
+		name string,
+		detail string,
+		description string,
+		parameters map[string]any,
+		endpointURL string,
+		headers map[string]string,
+		timeout int,
+		responseTemplate string,
+	) (*amcustomtool.CustomTool, error)
+	AIV1CustomToolDelete(ctx context.Context, customToolID uuid.UUID) (*amcustomtool.CustomTool, error)
+	AIV1CustomToolUpdate(
+		ctx context.Context,
+		customToolID uuid.UUID,
+		name string,
+		detail string,
+		description string,
+		parameters map[string]any,
+		endpointURL string,
+		headers map[string]string,
+		timeout int,
+		responseTemplate string,
+	) (*amcustomtool.CustomTool, error)
+
+	// ai-manager mcp servers
+	AIV1MCPServerGet(ctx context.Context, mcpServerID uuid.UUID) (*ammcpserver.MCPServer, error)
+	AIV1MCPServerList(ctx context.Context, pageToken string, pageSize uint64, filters map[ammcpserver.Field]any) ([]ammcpserver.MCPServer, error)
+	AIV1MCPServerCreate(
+		ctx context.Context,
+		customerID uuid.UUID,
+		name string,
+		detail string,
+		transport ammcpserver.Transport,
+		endpointURL string,
+		headers map[string]string,
+		timeout int,
+		allowedTools []string,
+	) (*ammcpserver.MCPServer, error)
+	AIV1MCPServerDelete(ctx context.Context, mcpServerID uuid.UUID) (*ammcpserver.MCPServer, error)
+	AIV1MCPServerUpdate(
+		ctx context.Context,
+		mcpServerID uuid.UUID,
+		name string,
+		detail string,
+		transport ammcpserver.Transport,
+		endpointURL string,
+		headers map[string]string,
+		timeout int,
+		allowedTools []string,
+	) (*ammcpserver.MCPServer, error)
+	AIV1MCPServerToolList(ctx context.Context, mcpServerID uuid.UUID) ([]amtool.Tool, error)
+
+	// ai-manager test suites
+	AIV1TestSuiteGet(ctx context.Context, testSuiteID uuid.UUID) (*amtestsuite.TestSuite, error)
+	AIV1TestSuiteList(ctx context.Context, pageToken string, pageSize uint64, filters map[amtestsuite.Field]any) ([]amtestsuite.TestSuite, error)
+	AIV1TestSuiteCreate(
+		ctx context.Context,
+		customerID uuid.UUID,
+		aiID uuid.UUID,
+		name string,
+		detail string,
+		scenarios []amtestsuite.Scenario,
+	) (*amtestsuite.TestSuite, error)
+	AIV1TestSuiteDelete(ctx context.Context, testSuiteID uuid.UUID) (*amtestsuite.TestSuite, error)
+	AIV1TestSuiteUpdate(
+		ctx context.Context,
+		testSuiteID uuid.UUID,
+		name string,
+		detail string,
+		scenarios []amtestsuite.Scenario,
+	) (*amtestsuite.TestSuite, error)
+	AIV1TestSuiteRun(ctx context.Context, testSuiteID uuid.UUID) (*amtestrun.TestRun, error)
+
+	// ai-manager test runs
+	AIV1TestRunGet(ctx context.Context, testRunID uuid.UUID) (*amtestrun.TestRun, error)
+	AIV1TestRunList(ctx context.Context, pageToken string, pageSize uint64, filters map[amtestrun.Field]any) ([]amtestrun.TestRun, error)
+	AIV1TestRunDelete(ctx context.Context, testRunID uuid.UUID) (*amtestrun.TestRun, error)
+
 	// ai-manager aicall
 	AIV1AIcallStart(ctx context.Context, assistanceType amaicall.AssistanceType, assistanceID uuid.UUID, activeflowID uuid.UUID, referenceType amaicall.ReferenceType, referenceID uuid.UUID) (*amaicall.AIcall, error)
 	AIV1AIcallList(ctx context.Context, pageToken string, pageSize uint64, filters map[amaicall.Field]any) ([]amaicall.AIcall, error)
@@ -292,6 +446,11 @@ type RequestHandler interface {
 		toolType ammessage.ToolType,
 		function *ammessage.FunctionCall,
 	) (map[string]any, error)
+	AIV1AIcallRedact(ctx context.Context, aicallID uuid.UUID, text string) (string, error)
+	AIV1AIcallGuardrailCheck(ctx context.Context, aicallID uuid.UUID, text string) (string, bool, error)
+	AIV1AIcallGuardrailInputCheck(ctx context.Context, aicallID uuid.UUID, text string) (string, bool, error)
+	AIV1AIcallResponseCacheLookup(ctx context.Context, aicallID uuid.UUID, userTurns []string) (string, error)
+	AIV1AIcallResponseCacheStore(ctx context.Context, aicallID uuid.UUID, userTurns []string, response string) error
 
 	// ai-manager message
 	AIV1MessageGetsByAIcallID(ctx context.Context, aicallID uuid.UUID, pageToken string, pageSize uint64, filters map[ammessage.Field]any) ([]ammessage.Message, error)
@@ -331,6 +490,18 @@ type RequestHandler interface {
 		language string,
 		requestTimeout int,
 	) (*service.Service, error)
+	AIV1ServiceTypeExtractionStart(
+		ctx context.Context,
+		customerID uuid.UUID,
+		activeflowID uuid.UUID,
+		onEndFlowID uuid.UUID,
+		referenceType amextraction.ReferenceType,
+		referenceID uuid.UUID,
+		language string,
+		schema map[string]any,
+		setVariables bool,
+		requestTimeout int,
+	) (*service.Service, error)
 	AIV1ServiceTypeTaskStart(ctx context.Context, assistanceType amaicall.AssistanceType, assistanceID uuid.UUID, activeflowID uuid.UUID) (*service.Service, error)
 	AIV1ServiceTypeAnalysisRun(ctx context.Context, req *amanalysis.Request, requestTimeout int) (*amanalysis.Response, error)
 
@@ -349,6 +520,43 @@ type RequestHandler interface {
 	AIV1SummaryGet(ctx context.Context, summaryID uuid.UUID) (*amsummary.Summary, error)
 	AIV1SummaryDelete(ctx context.Context, aiID uuid.UUID) (*amsummary.Summary, error)
 
+	// ai-manager extraction
+	AIV1ExtractionList(ctx context.Context, pageToken string, pageSize uint64, filters map[amextraction.Field]any) ([]amextraction.Extraction, error)
+	AIV1ExtractionCreate(
+		ctx context.Context,
+		customerID uuid.UUID,
+		activeflowID uuid.UUID,
+		onEndFlowID uuid.UUID,
+		referenceType amextraction.ReferenceType,
+		referenceID uuid.UUID,
+		language string,
+		schema map[string]any,
+		setVariables bool,
+		timeout int,
+	) (*amextraction.Extraction, error)
+	AIV1ExtractionGet(ctx context.Context, extractionID uuid.UUID) (*amextraction.Extraction, error)
+	AIV1ExtractionDelete(ctx context.Context, extractionID uuid.UUID) (*amextraction.Extraction, error)
+
+	// ai-manager aiassist
+	AIV1AIAssistList(ctx context.Context, pageToken string, pageSize uint64, filters map[amaiassist.Field]any) ([]amaiassist.AIAssist, error)
+	AIV1AIAssistStart(
+		ctx context.Context,
+		customerID uuid.UUID,
+		agentID uuid.UUID,
+		aiID uuid.UUID,
+		referenceType amaiassist.ReferenceType,
+		referenceID uuid.UUID,
+		language string,
+		checklist []amaiassist.ChecklistItem,
+	) (*amaiassist.AIAssist, error)
+	AIV1AIAssistGet(ctx context.Context, aiassistID uuid.UUID) (*amaiassist.AIAssist, error)
+	AIV1AIAssistDelete(ctx context.Context, aiassistID uuid.UUID) (*amaiassist.AIAssist, error)
+	AIV1AIAssistStop(ctx context.Context, aiassistID uuid.UUID) (*amaiassist.AIAssist, error)
+
+	// ai-manager guardrail violation
+	AIV1GuardrailViolationList(ctx context.Context, pageToken string, pageSize uint64, filters map[amguardrailviolation.Field]any) ([]amguardrailviolation.GuardrailViolation, error)
+	AIV1GuardrailViolationGet(ctx context.Context, guardrailViolationID uuid.UUID) (*amguardrailviolation.GuardrailViolation, error)
+
 	// ai-manager aiaudit
 	AIV1AIAuditCreate(ctx context.Context, customerID uuid.UUID, aicallID uuid.UUID, language string) ([]*amaiaudit.AIAudit, error)
 	AIV1AIAuditCreateWithDelay(ctx context.Context, customerID uuid.UUID, aicallID uuid.UUID, language string, delay int) error
@@ -481,6 +689,7 @@ type RequestHandler interface {
 	BillingV1AccountIsValidResourceLimitByCustomerID(ctx context.Context, customerID uuid.UUID, resourceType bmaccount.ResourceType) (bool, error)
 	BillingV1AccountUpdateBasicInfo(ctx context.Context, accountID uuid.UUID, name string, detail string) (*bmaccount.Account, error)
 	BillingV1AccountUpdatePaymentInfo(ctx context.Context, accountID uuid.UUID, paymentType bmaccount.PaymentType, paymentMethod bmaccount.PaymentMethod) (*bmaccount.Account, error)
+	BillingV1AccountUpdateSpendingSettings(ctx context.Context, accountID uuid.UUID, spendLimits []bmaccount.SpendLimit, balanceAlertThresholds []int64, autoTopUpThreshold int64, autoTopUpAmount int64) (*bmaccount.Account, error)
 	BillingV1AccountGets(ctx context.Context, pageToken string, pageSize uint64, filters map[bmaccount.Field]any) ([]bmaccount.Account, error)
 	BillingV1AccountPaddlePortalSession(ctx context.Context, accountID uuid.UUID) (string, error)
 
@@ -488,6 +697,14 @@ type RequestHandler interface {
 	BillingV1BillingList(ctx context.Context, pageToken string, pageSize uint64, filters map[bmbilling.Field]any) ([]bmbilling.Billing, error)
 	BillingV1BillingGet(ctx context.Context, billingID uuid.UUID) (*bmbilling.Billing, error)
 
+	// billing-manager statement
+	BillingV1StatementList(ctx context.Context, pageToken string, pageSize uint64, filters map[bmstatement.Field]any) ([]bmstatement.Statement, error)
+	BillingV1StatementGet(ctx context.Context, statementID uuid.UUID) (*bmstatement.Statement, error)
+
+	// billing-manager estimate
+	BillingV1EstimateQuote(ctx context.Context, customerID uuid.UUID, costType bmbilling.CostType, destination *commonaddress.Address, duration int) (*bmestimate.Estimate, error)
+	BillingV1EstimateGetByReferenceID(ctx context.Context, referenceID uuid.UUID) (*bmestimate.Estimate, error)
+
 	// billing-manager hooks
 	BillingV1PaddleHook(ctx context.Context, hm *hmhook.Hook) error
 
@@ -562,6 +779,7 @@ type RequestHandler interface {
 	CallV1CallSendDigits(ctx context.Context, callID uuid.UUID, digits string) error
 	CallV1CallTalk(ctx context.Context, callID uuid.UUID, text string, language string, provider string, voiceID string, rqeuestTimeout int) error
 	CallV1CallUpdateConfbridgeID(ctx context.Context, callID uuid.UUID, confbirdgeID uuid.UUID) (*cmcall.Call, error)
+	CallV1CallUpdateMaxCost(ctx context.Context, callID uuid.UUID, maxCost int64) (*cmcall.Call, error)
 	CallV1CallHangup(ctx context.Context, callID uuid.UUID) (*cmcall.Call, error)
 	CallV1CallHoldOn(ctx context.Context, callID uuid.UUID) error
 	CallV1CallHoldOff(ctx context.Context, callID uuid.UUID) error
@@ -718,14 +936,39 @@ type RequestHandler interface {
 	) (*cacampaign.Campaign, error)
 	CampaignV1CampaignUpdateStatus(ctx context.Context, id uuid.UUID, status cacampaign.Status) (*cacampaign.Campaign, error)
 	CampaignV1CampaignUpdateServiceLevel(ctx context.Context, id uuid.UUID, serviceLevel int) (*cacampaign.Campaign, error)
+	CampaignV1CampaignUpdateDialMode(ctx context.Context, id uuid.UUID, dialMode cacampaign.DialMode, maxAbandonRate int) (*cacampaign.Campaign, error)
+	CampaignV1CampaignUpdateCallingWindows(ctx context.Context, id uuid.UUID, callingWindows []cacampaign.CallingWindow, timezone string) (*cacampaign.Campaign, error)
 	CampaignV1CampaignUpdateActions(ctx context.Context, id uuid.UUID, actions []fmaction.Action) (*cacampaign.Campaign, error)
 	CampaignV1CampaignUpdateResourceInfo(ctx context.Context, id uuid.UUID, outplanID uuid.UUID, outdialID uuid.UUID, queueID uuid.UUID, nextCampaignID uuid.UUID) (*cacampaign.Campaign, error)
 	CampaignV1CampaignUpdateNextCampaignID(ctx context.Context, id uuid.UUID, nextCampaignID uuid.UUID) (*cacampaign.Campaign, error)
+	CampaignV1CampaignResultList(ctx context.Context, id uuid.UUID, pageToken string, pageSize uint64) ([]cacampaignresult.CampaignResult, error)
+
+	// campaign-manager campaignresultjobs
+	CampaignV1CampaignresultjobCreate(ctx context.Context, campaignID uuid.UUID) (*cacampaignresultjob.CampaignResultJob, error)
+	CampaignV1CampaignresultjobGet(ctx context.Context, id uuid.UUID) (*cacampaignresultjob.CampaignResultJob, error)
+	CampaignV1CampaignresultjobList(ctx context.Context, pageToken string, pageSize uint64, filters map[cacampaignresultjob.Field]any) ([]cacampaignresultjob.CampaignResultJob, error)
 
 	// campaign-manager campaigncalls
 	CampaignV1CampaigncallList(ctx context.Context, pageToken string, pageSize uint64, filters map[cacampaigncall.Field]any) ([]cacampaigncall.Campaigncall, error)
 	CampaignV1CampaigncallGet(ctx context.Context, id uuid.UUID) (*cacampaigncall.Campaigncall, error)
 	CampaignV1CampaigncallDelete(ctx context.Context, id uuid.UUID) (*cacampaigncall.Campaigncall, error)
+	CampaignV1CampaigncallAccept(ctx context.Context, id uuid.UUID, agentID uuid.UUID) (*cacampaigncall.Campaigncall, error)
+	CampaignV1CampaigncallSkip(ctx context.Context, id uuid.UUID) (*cacampaigncall.Campaigncall, error)
+	CampaignV1CampaigncallUpdateDisposition(ctx context.Context, id uuid.UUID, disposition string) (*cacampaigncall.Campaigncall, error)
+
+	// campaign-manager dispositions
+	CampaignV1DispositionCreate(
+		ctx context.Context,
+		customerID uuid.UUID,
+		code string,
+		name string,
+		detail string,
+		retryMode cadisposition.RetryMode,
+	) (*cadisposition.Disposition, error)
+	CampaignV1DispositionList(ctx context.Context, pageToken string, pageSize uint64, filters map[cadisposition.Field]any) ([]cadisposition.Disposition, error)
+	CampaignV1DispositionGet(ctx context.Context, id uuid.UUID) (*cadisposition.Disposition, error)
+	CampaignV1DispositionUpdate(ctx context.Context, id uuid.UUID, name string, detail string, retryMode cadisposition.RetryMode) (*cadisposition.Disposition, error)
+	CampaignV1DispositionDelete(ctx context.Context, id uuid.UUID) (*cadisposition.Disposition, error)
 
 	// campaign-manager outplans
 	CampaignV1OutplanCreate(
@@ -1155,6 +1398,7 @@ type RequestHandler interface {
 		name string,
 		detail string,
 		data string,
+		timezone string,
 		destination0 *commonaddress.Address,
 		destination1 *commonaddress.Address,
 		destination2 *commonaddress.Address,
@@ -1176,6 +1420,24 @@ type RequestHandler interface {
 	) ([]omoutdialtarget.OutdialTarget, error)
 	OutdialV1OutdialtargetUpdateStatusProgressing(ctx context.Context, outdialtargetID uuid.UUID, destinationIndex int) (*omoutdialtarget.OutdialTarget, error)
 	OutdialV1OutdialtargetUpdateStatus(ctx context.Context, outdialtargetID uuid.UUID, status omoutdialtarget.Status) (*omoutdialtarget.OutdialTarget, error)
+	OutdialV1OutdialtargetUpdateCallback(ctx context.Context, outdialtargetID uuid.UUID, tmCallback *time.Time, agentID uuid.UUID) (*omoutdialtarget.OutdialTarget, error)
+
+	// outdial-manager outdialtargetjob
+	OutdialV1OutdialtargetjobCreate(ctx context.Context, outdialID uuid.UUID, jobType omoutdialtargetjob.Type, fileID uuid.UUID, mapping *omoutdialtargetjob.Mapping) (*omoutdialtargetjob.OutdialTargetJob, error)
+	OutdialV1OutdialtargetjobGet(ctx context.Context, outdialtargetjobID uuid.UUID) (*omoutdialtargetjob.OutdialTargetJob, error)
+	OutdialV1OutdialtargetjobList(ctx context.Context, pageToken string, pageSize uint64, filters map[omoutdialtargetjob.Field]any) ([]omoutdialtargetjob.OutdialTargetJob, error)
+
+	// outdial-manager suppression
+	OutdialV1SuppressionCreate(ctx context.Context, customerID uuid.UUID, listType omsuppression.ListType, target string, detail string) (*omsuppression.Suppression, error)
+	OutdialV1SuppressionDelete(ctx context.Context, suppressionID uuid.UUID) (*omsuppression.Suppression, error)
+	OutdialV1SuppressionGet(ctx context.Context, suppressionID uuid.UUID) (*omsuppression.Suppression, error)
+	OutdialV1SuppressionImport(ctx context.Context, customerID uuid.UUID, listType omsuppression.ListType, targets []string, detail string) (int, int, error)
+	OutdialV1SuppressionIsSuppressed(ctx context.Context, customerID uuid.UUID, target string, referenceType omsuppressionblock.ReferenceType, referenceID uuid.UUID) (bool, error)
+	OutdialV1SuppressionList(ctx context.Context, pageToken string, pageSize uint64, filters map[omsuppression.Field]any) ([]omsuppression.Suppression, error)
+
+	// outdial-manager suppressionblock
+	OutdialV1SuppressionblockGet(ctx context.Context, suppressionblockID uuid.UUID) (*omsuppressionblock.SuppressionBlock, error)
+	OutdialV1SuppressionblockList(ctx context.Context, pageToken string, pageSize uint64, filters map[omsuppressionblock.Field]any) ([]omsuppressionblock.SuppressionBlock, error)
 
 	// pipecat-manager message
 	PipecatV1MessageSend(
@@ -1421,6 +1683,7 @@ type RequestHandler interface {
 	StorageV1FileList(ctx context.Context, pageToken string, pageSize uint64, filters map[smfile.Field]any) ([]smfile.File, error)
 	StorageV1FileDelete(ctx context.Context, fileID uuid.UUID, requestTimeout int) (*smfile.File, error)
 	StorageV1FileDownloadURIRefresh(ctx context.Context, fileID uuid.UUID) (string, error)
+	StorageV1FileUploadURICreate(ctx context.Context) (*smfile.UploadURI, error)
 
 	// tag-manager
 	TagV1TagCreate(ctx context.Context, customerID uuid.UUID, name string, detail string) (*tmtag.Tag, error)
@@ -1523,6 +1786,9 @@ type RequestHandler interface {
 	// rag-manager query
 	RagV1RagQuery(ctx context.Context, ragID uuid.UUID, queryText string, topK int) (*rmquery.Response, error)
 
+	// rag-manager embeddings
+	RagV1EmbeddingCreate(ctx context.Context, texts []string) ([][]float32, error)
+
 	// rag-manager rags
 	RagV1RagCreate(ctx context.Context, customerID uuid.UUID, name, description string, storageFileIDs []uuid.UUID, sourceURLs []string) (*rmrag.Rag, error)
 	RagV1RagGet(ctx context.Context, id uuid.UUID) (*rmrag.Rag, error)
@@ -1589,12 +1855,15 @@ type requestHandler struct {
 	utilHandler utilhandler.UtilHandler
 
 	cb circuitbreakerhandler.CircuitBreakerHandler
+
+	policies *policySet
 }
 
 // NewRequestHandler create RequesterHandler
 func NewRequestHandler(sock sockhandler.SockHandler, publisher commonoutline.ServiceName) RequestHandler {
 	namespace := commonoutline.GetMetricNameSpace(publisher)
 	initPrometheus(namespace)
+	tracehandler.Init(publisher)
 
 	h := &requestHandler{
 		sock: sock,
@@ -1602,6 +1871,7 @@ func NewRequestHandler(sock sockhandler.SockHandler, publisher commonoutline.Ser
 		publisher:   publisher,
 		utilHandler: utilhandler.NewUtilHandler(),
 		cb:          circuitbreakerhandler.NewCircuitBreakerHandler(namespace),
+		policies:    loadPoliciesFromEnv(),
 	}
 
 	return h
@@ -14,18 +14,30 @@ import (
 	json "encoding/json"
 	agent "monorepo/bin-agent-manager/models/agent"
 	ai "monorepo/bin-ai-manager/models/ai"
+	aiassist "monorepo/bin-ai-manager/models/aiassist"
 	aiaudit "monorepo/bin-ai-manager/models/aiaudit"
 	aicall "monorepo/bin-ai-manager/models/aicall"
 	aiprompthistory "monorepo/bin-ai-manager/models/aiprompthistory"
 	aipromptproposal "monorepo/bin-ai-manager/models/aipromptproposal"
 	analysis "monorepo/bin-ai-manager/models/analysis"
+	customtool "monorepo/bin-ai-manager/models/customtool"
+	extraction "monorepo/bin-ai-manager/models/extraction"
+	guardrail "monorepo/bin-ai-manager/models/guardrail"
+	guardrailviolation "monorepo/bin-ai-manager/models/guardrailviolation"
+	mcpserver "monorepo/bin-ai-manager/models/mcpserver"
 	message "monorepo/bin-ai-manager/models/message"
 	participant "monorepo/bin-ai-manager/models/participant"
+	redaction "monorepo/bin-ai-manager/models/redaction"
+	responsecache "monorepo/bin-ai-manager/models/responsecache"
 	summary "monorepo/bin-ai-manager/models/summary"
 	team "monorepo/bin-ai-manager/models/team"
+	testrun "monorepo/bin-ai-manager/models/testrun"
+	testsuite "monorepo/bin-ai-manager/models/testsuite"
 	tool "monorepo/bin-ai-manager/models/tool"
 	account "monorepo/bin-billing-manager/models/account"
 	billing "monorepo/bin-billing-manager/models/billing"
+	estimate "monorepo/bin-billing-manager/models/estimate"
+	statement "monorepo/bin-billing-manager/models/statement"
 	ari "monorepo/bin-call-manager/models/ari"
 	bridge "monorepo/bin-call-manager/models/bridge"
 	call "monorepo/bin-call-manager/models/call"
@@ -37,6 +49,9 @@ import (
 	recording "monorepo/bin-call-manager/models/recording"
 	campaign "monorepo/bin-campaign-manager/models/campaign"
 	campaigncall "monorepo/bin-campaign-manager/models/campaigncall"
+	campaignresult "monorepo/bin-campaign-manager/models/campaignresult"
+	campaignresultjob "monorepo/bin-campaign-manager/models/campaignresultjob"
+	disposition "monorepo/bin-campaign-manager/models/disposition"
 	outplan "monorepo/bin-campaign-manager/models/outplan"
 	address "monorepo/bin-common-handler/models/address"
 	outline "monorepo/bin-common-handler/models/outline"
@@ -66,6 +81,9 @@ import (
 	number "monorepo/bin-number-manager/models/number"
 	outdial "monorepo/bin-outdial-manager/models/outdial"
 	outdialtarget "monorepo/bin-outdial-manager/models/outdialtarget"
+	outdialtargetjob "monorepo/bin-outdial-manager/models/outdialtargetjob"
+	suppression "monorepo/bin-outdial-manager/models/suppression"
+	suppressionblock "monorepo/bin-outdial-manager/models/suppressionblock"
 	message2 "monorepo/bin-pipecat-manager/models/message"
 	pipecatcall "monorepo/bin-pipecat-manager/models/pipecatcall"
 	queue "monorepo/bin-queue-manager/models/queue"
@@ -150,6 +168,81 @@ func (mr *MockRequestHandlerMockRecorder) AIV1AIActivateInsight(ctx, aiID any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIV1AIActivateInsight", reflect.TypeOf((*MockRequestHandler)(nil).AIV1AIActivateInsight), ctx, aiID)
 }
 
+// AIV1AIAssistDelete mocks base method.
+func (m *MockRequestHandler) AIV1AIAssistDelete(ctx context.Context, aiassistID uuid.UUID) (*aiassist.AIAssist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIV1AIAssistDelete", ctx, aiassistID)
+	ret0, _ := ret[0].(*aiassist.AIAssist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AIV1AIAssistDelete indicates an expected call of AIV1AIAssistDelete.
+func (mr *MockRequestHandlerMockRecorder) AIV1AIAssistDelete(ctx, aiassistID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIV1AIAssistDelete", reflect.TypeOf((*MockRequestHandler)(nil).AIV1AIAssistDelete), ctx, aiassistID)
+}
+
+// AIV1AIAssistGet mocks base method.
+func (m *MockRequestHandler) AIV1AIAssistGet(ctx context.Context, aiassistID uuid.UUID) (*aiassist.AIAssist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIV1AIAssistGet", ctx, aiassistID)
+	ret0, _ := ret[0].(*aiassist.AIAssist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AIV1AIAssistGet indicates an expected call of AIV1AIAssistGet.
+func (mr *MockRequestHandlerMockRecorder) AIV1AIAssistGet(ctx, aiassistID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIV1AIAssistGet", reflect.TypeOf((*MockRequestHandler)(nil).AIV1AIAssistGet), ctx, aiassistID)
+}
+
+// AIV1AIAssistList mocks base method.
+func (m *MockRequestHandler) AIV1AIAssistList(ctx context.Context, pageToken string, pageSize uint64, filters map[aiassist.Field]any) ([]aiassist.AIAssist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIV1AIAssistList", ctx, pageToken, pageSize, filters)
+	ret0, _ := ret[0].([]aiassist.AIAssist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AIV1AIAssistList indicates an expected call of AIV1AIAssistList.
+func (mr *MockRequestHandlerMockRecorder) AIV1AIAssistList(ctx, pageToken, pageSize, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIV1AIAssistList", reflect.TypeOf((*MockRequestHandler)(nil).AIV1AIAssistList), ctx, pageToken, pageSize, filters)
+}
+
+// AIV1AIAssistStart mocks base method.
+func (m *MockRequestHandler) AIV1AIAssistStart(ctx context.Context, customerID, agentID, aiID uuid.UUID, referenceType aiassist.ReferenceType, referenceID uuid.UUID, language string, checklist []aiassist.ChecklistItem) (*aiassist.AIAssist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIV1AIAssistStart", ctx, customerID, agentID, aiID, referenceType, referenceID, language, checklist)
+	ret0, _ := ret[0].(*aiassist.AIAssist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AIV1AIAssistStart indicates an expected call of AIV1AIAssistStart.
+func (mr *MockRequestHandlerMockRecorder) AIV1AIAssistStart(ctx, customerID, agentID, aiID, referenceType, referenceID, language, checklist any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIV1AIAssistStart", reflect.TypeOf((*MockRequestHandler)(nil).AIV1AIAssistStart), ctx, customerID, agentID, aiID, referenceType, referenceID, language, checklist)
+}
+
+// AIV1AIAssistStop mocks base method.
+func (m *MockRequestHandler) AIV1AIAssistStop(ctx context.Context, aiassistID uuid.UUID) (*aiassist.AIAssist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIV1AIAssistStop", ctx, aiassistID)
+	ret0, _ := ret[0].(*aiassist.AIAssist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AIV1AIAssistStop indicates an expected call of AIV1AIAssistStop.
+func (mr *MockRequestHandlerMockRecorder) AIV1AIAssistStop(ctx, aiassistID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIV1AIAssistStop", reflect.TypeOf((*MockRequestHandler)(nil).AIV1AIAssistStop), ctx, aiassistID)
+}
+
 // AIV1AIAuditCreate mocks base method.
 func (m *MockRequestHandler) AIV1AIAuditCreate(ctx context.Context, customerID, aicallID uuid.UUID, language string) ([]*aiaudit.AIAudit, error) {
 	m.ctrl.T.Helper()
@@ -225,18 +318,18 @@ func (mr *MockRequestHandlerMockRecorder) AIV1AIAuditList(ctx, pageToken, pageSi
 }
 
 // AIV1AICreate mocks base method.
-func (m *MockRequestHandler) AIV1AICreate(ctx context.Context, customerID uuid.UUID, name, detail string, aiType ai.Type, engineModel ai.EngineModel, parameter map[string]any, engineKey string, ragID uuid.UUID, initPrompt string, ttsType ai.TTSType, ttsVoiceID string, sttType ai.STTType, sttLanguage string, toolNames []tool.ToolName, autoAICallAuditEnabled bool) (*ai.AI, error) {
+func (m *MockRequestHandler) AIV1AICreate(ctx context.Context, customerID uuid.UUID, name, detail string, aiType ai.Type, engineModel ai.EngineModel, parameter map[string]any, engineKey string, ragID uuid.UUID, initPrompt string, ttsType ai.TTSType, ttsVoiceID string, sttType ai.STTType, sttLanguage string, toolNames []tool.ToolName, autoAICallAuditEnabled bool, engineFallbacks []ai.EngineFallback, redactionConfig *redaction.Config, guardrailConfig *guardrail.Config, responseCacheConfig *responsecache.Config) (*ai.AI, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AIV1AICreate", ctx, customerID, name, detail, aiType, engineModel, parameter, engineKey, ragID, initPrompt, ttsType, ttsVoiceID, sttType, sttLanguage, toolNames, autoAICallAuditEnabled)
+	ret := m.ctrl.Call(m, "AIV1AICreate", ctx, customerID, name, detail, aiType, engineModel, parameter, engineKey, ragID, initPrompt, ttsType, ttsVoiceID, sttType, sttLanguage, toolNames, autoAICallAuditEnabled, engineFallbacks, redactionConfig, guardrailConfig, responseCacheConfig)
 	ret0, _ := ret[0].(*ai.AI)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AIV1AICreate indicates an expected call of AIV1AICreate.
-func (mr *MockRequestHandlerMockRecorder) AIV1AICreate(ctx, customerID, name, detail, aiType, engineModel, parameter, engineKey, ragID, initPrompt, ttsType, ttsVoiceID, sttType, sttLanguage, toolNames, autoAICallAuditEnabled any) *gomock.Call {
+func (mr *MockRequestHandlerMockRecorder) AIV1AICreate(ctx, customerID, name, detail, aiType, engineModel, parameter, engineKey, ragID, initPrompt, ttsType, ttsVoiceID, sttType, sttLanguage, toolNames, autoAICallAuditEnabled, engineFallbacks, redactionConfig, guardrailConfig, responseCacheConfig any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIV1AICreate", reflect.TypeOf((*MockRequestHandler)(nil).AIV1AICreate), ctx, customerID, name, detail, aiType, engineModel, parameter, engineKey, ragID, initPrompt, ttsType, ttsVoiceID, sttType, sttLanguage, toolNames, autoAICallAuditEnabled)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIV1AICreate", reflect.TypeOf((*MockRequestHandler)(nil).AIV1AICreate), ctx, customerID, name, detail, aiType, engineModel, parameter, engineKey, ragID, initPrompt, ttsType, ttsVoiceID, sttType, sttLanguage, toolNames, autoAICallAuditEnabled, engineFallbacks, redactionConfig, guardrailConfig, responseCacheConfig)
 }
 
 // AIV1AIDelete mocks base method.
@@ -435,18 +528,18 @@ func (mr *MockRequestHandlerMockRecorder) AIV1AIPromptProposalReject(ctx, custom
 }
 
 // AIV1AIUpdate mocks base method.
-func (m *MockRequestHandler) AIV1AIUpdate(ctx context.Context, aiID uuid.UUID, name, detail string, aiType ai.Type, engineModel ai.EngineModel, parameter map[string]any, engineKey string, ragID uuid.UUID, initPrompt string, ttsType ai.TTSType, ttsVoiceID string, sttType ai.STTType, sttLanguage string, toolNames []tool.ToolName, autoAICallAuditEnabled bool) (*ai.AI, error) {
+func (m *MockRequestHandler) AIV1AIUpdate(ctx context.Context, aiID uuid.UUID, name, detail string, aiType ai.Type, engineModel ai.EngineModel, parameter map[string]any, engineKey string, ragID uuid.UUID, initPrompt string, ttsType ai.TTSType, ttsVoiceID string, sttType ai.STTType, sttLanguage string, toolNames []tool.ToolName, autoAICallAuditEnabled bool, engineFallbacks []ai.EngineFallback, redactionConfig *redaction.Config, guardrailConfig *guardrail.Config, responseCacheConfig *responsecache.Config) (*ai.AI, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AIV1AIUpdate", ctx, aiID, name, detail, aiType, engineModel, parameter, engineKey, ragID, initPrompt, ttsType, ttsVoiceID, sttType, sttLanguage, toolNames, autoAICallAuditEnabled)
+	ret := m.ctrl.Call(m, "AIV1AIUpdate", ctx, aiID, name, detail, aiType, engineModel, parameter, engineKey, ragID, initPrompt, ttsType, ttsVoiceID, sttType, sttLanguage, toolNames, autoAICallAuditEnabled, engineFallbacks, redactionConfig, guardrailConfig, responseCacheConfig)
 	ret0, _ := ret[0].(*ai.AI)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AIV1AIUpdate indicates an expected call of AIV1AIUpdate.
-func (mr *MockRequestHandlerMockRecorder) AIV1AIUpdate(ctx, aiID, name, detail, aiType, engineModel, parameter, engineKey, ragID, initPrompt, ttsType, ttsVoiceID, sttType, sttLanguage, toolNames, autoAICallAuditEnabled any) *gomock.Call {
+func (mr *MockRequestHandlerMockRecorder) AIV1AIUpdate(ctx, aiID, name, detail, aiType, engineModel, parameter, engineKey, ragID, initPrompt, ttsType, ttsVoiceID, sttType, sttLanguage, toolNames, autoAICallAuditEnabled, engineFallbacks, redactionConfig, guardrailConfig, responseCacheConfig any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIV1AIUpdate", reflect.TypeOf((*MockRequestHandler)(nil).AIV1AIUpdate), ctx, aiID, name, detail, aiType, engineModel, parameter, engineKey, ragID, initPrompt, ttsType, ttsVoiceID, sttType, sttLanguage, toolNames, autoAICallAuditEnabled)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIV1AIUpdate", reflect.TypeOf((*MockRequestHandler)(nil).AIV1AIUpdate), ctx, aiID, name, detail, aiType, engineModel, parameter, engineKey, ragID, initPrompt, ttsType, ttsVoiceID, sttType, sttLanguage, toolNames, autoAICallAuditEnabled, engineFallbacks, redactionConfig, guardrailConfig, responseCacheConfig)
 }
 
 // AIV1AIcallDelete mocks base method.
@@ -479,6 +572,38 @@ func (mr *MockRequestHandlerMockRecorder) AIV1AIcallGet(ctx, aicallID any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIV1AIcallGet", reflect.TypeOf((*MockRequestHandler)(nil).AIV1AIcallGet), ctx, aicallID)
 }
 
+// AIV1AIcallGuardrailCheck mocks base method.
+func (m *MockRequestHandler) AIV1AIcallGuardrailCheck(ctx context.Context, aicallID uuid.UUID, text string) (string, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIV1AIcallGuardrailCheck", ctx, aicallID, text)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AIV1AIcallGuardrailCheck indicates an expected call of AIV1AIcallGuardrailCheck.
+func (mr *MockRequestHandlerMockRecorder) AIV1AIcallGuardrailCheck(ctx, aicallID, text any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIV1AIcallGuardrailCheck", reflect.TypeOf((*MockRequestHandler)(nil).AIV1AIcallGuardrailCheck), ctx, aicallID, text)
+}
+
+// AIV1AIcallGuardrailInputCheck mocks base method.
+func (m *MockRequestHandler) AIV1AIcallGuardrailInputCheck(ctx context.Context, aicallID uuid.UUID, text string) (string, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIV1AIcallGuardrailInputCheck", ctx, aicallID, text)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AIV1AIcallGuardrailInputCheck indicates an expected call of AIV1AIcallGuardrailInputCheck.
+func (mr *MockRequestHandlerMockRecorder) AIV1AIcallGuardrailInputCheck(ctx, aicallID, text any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIV1AIcallGuardrailInputCheck", reflect.TypeOf((*MockRequestHandler)(nil).AIV1AIcallGuardrailInputCheck), ctx, aicallID, text)
+}
+
 // AIV1AIcallList mocks base method.
 func (m *MockRequestHandler) AIV1AIcallList(ctx context.Context, pageToken string, pageSize uint64, filters map[aicall.Field]any) ([]aicall.AIcall, error) {
 	m.ctrl.T.Helper()
@@ -509,6 +634,50 @@ func (mr *MockRequestHandlerMockRecorder) AIV1AIcallParticipantList(ctx, aicallI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIV1AIcallParticipantList", reflect.TypeOf((*MockRequestHandler)(nil).AIV1AIcallParticipantList), ctx, aicallID, pageToken, pageSize)
 }
 
+// AIV1AIcallRedact mocks base method.
+func (m *MockRequestHandler) AIV1AIcallRedact(ctx context.Context, aicallID uuid.UUID, text string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIV1AIcallRedact", ctx, aicallID, text)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AIV1AIcallRedact indicates an expected call of AIV1AIcallRedact.
+func (mr *MockRequestHandlerMockRecorder) AIV1AIcallRedact(ctx, aicallID, text any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIV1AIcallRedact", reflect.TypeOf((*MockRequestHandler)(nil).AIV1AIcallRedact), ctx, aicallID, text)
+}
+
+// AIV1AIcallResponseCacheLookup mocks base method.
+func (m *MockRequestHandler) AIV1AIcallResponseCacheLookup(ctx context.Context, aicallID uuid.UUID, userTurns []string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIV1AIcallResponseCacheLookup", ctx, aicallID, userTurns)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AIV1AIcallResponseCacheLookup indicates an expected call of AIV1AIcallResponseCacheLookup.
+func (mr *MockRequestHandlerMockRecorder) AIV1AIcallResponseCacheLookup(ctx, aicallID, userTurns any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIV1AIcallResponseCacheLookup", reflect.TypeOf((*MockRequestHandler)(nil).AIV1AIcallResponseCacheLookup), ctx, aicallID, userTurns)
+}
+
+// AIV1AIcallResponseCacheStore mocks base method.
+func (m *MockRequestHandler) AIV1AIcallResponseCacheStore(ctx context.Context, aicallID uuid.UUID, userTurns []string, response string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIV1AIcallResponseCacheStore", ctx, aicallID, userTurns, response)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AIV1AIcallResponseCacheStore indicates an expected call of AIV1AIcallResponseCacheStore.
+func (mr *MockRequestHandlerMockRecorder) AIV1AIcallResponseCacheStore(ctx, aicallID, userTurns, response any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIV1AIcallResponseCacheStore", reflect.TypeOf((*MockRequestHandler)(nil).AIV1AIcallResponseCacheStore), ctx, aicallID, userTurns, response)
+}
+
 // AIV1AIcallStart mocks base method.
 func (m *MockRequestHandler) AIV1AIcallStart(ctx context.Context, assistanceType aicall.AssistanceType, assistanceID, activeflowID uuid.UUID, referenceType aicall.ReferenceType, referenceID uuid.UUID) (*aicall.AIcall, error) {
 	m.ctrl.T.Helper()
@@ -568,6 +737,261 @@ func (mr *MockRequestHandlerMockRecorder) AIV1AIcallToolExecute(ctx, aicallID, t
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIV1AIcallToolExecute", reflect.TypeOf((*MockRequestHandler)(nil).AIV1AIcallToolExecute), ctx, aicallID, toolID, toolType, function)
 }
 
+// AIV1CustomToolCreate mocks base method.
+func (m *MockRequestHandler) AIV1CustomToolCreate(ctx context.Context, customerID uuid.UUID, name, detail, description string, parameters map[string]any, endpointURL string, headers map[string]string, timeout int, responseTemplate string) (*customtool.CustomTool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIV1CustomToolCreate", ctx, customerID, name, detail, description, parameters, endpointURL, headers, timeout, responseTemplate)
+	ret0, _ := ret[0].(*customtool.CustomTool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AIV1CustomToolCreate indicates an expected call of AIV1CustomToolCreate.
+func (mr *MockRequestHandlerMockRecorder) AIV1CustomToolCreate(ctx, customerID, name, detail, description, parameters, endpointURL, headers, timeout, responseTemplate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIV1CustomToolCreate", reflect.TypeOf((*MockRequestHandler)(nil).AIV1CustomToolCreate), ctx, customerID, name, detail, description, parameters, endpointURL, headers, timeout, responseTemplate)
+}
+
+// AIV1CustomToolDelete mocks base method.
+func (m *MockRequestHandler) AIV1CustomToolDelete(ctx context.Context, customToolID uuid.UUID) (*customtool.CustomTool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIV1CustomToolDelete", ctx, customToolID)
+	ret0, _ := ret[0].(*customtool.CustomTool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AIV1CustomToolDelete indicates an expected call of AIV1CustomToolDelete.
+func (mr *MockRequestHandlerMockRecorder) AIV1CustomToolDelete(ctx, customToolID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIV1CustomToolDelete", reflect.TypeOf((*MockRequestHandler)(nil).AIV1CustomToolDelete), ctx, customToolID)
+}
+
+// AIV1CustomToolGet mocks base method.
+func (m *MockRequestHandler) AIV1CustomToolGet(ctx context.Context, customToolID uuid.UUID) (*customtool.CustomTool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIV1CustomToolGet", ctx, customToolID)
+	ret0, _ := ret[0].(*customtool.CustomTool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AIV1CustomToolGet indicates an expected call of AIV1CustomToolGet.
+func (mr *MockRequestHandlerMockRecorder) AIV1CustomToolGet(ctx, customToolID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIV1CustomToolGet", reflect.TypeOf((*MockRequestHandler)(nil).AIV1CustomToolGet), ctx, customToolID)
+}
+
+// AIV1CustomToolList mocks base method.
+func (m *MockRequestHandler) AIV1CustomToolList(ctx context.Context, pageToken string, pageSize uint64, filters map[customtool.Field]any) ([]customtool.CustomTool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIV1CustomToolList", ctx, pageToken, pageSize, filters)
+	ret0, _ := ret[0].([]customtool.CustomTool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AIV1CustomToolList indicates an expected call of AIV1CustomToolList.
+func (mr *MockRequestHandlerMockRecorder) AIV1CustomToolList(ctx, pageToken, pageSize, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIV1CustomToolList", reflect.TypeOf((*MockRequestHandler)(nil).AIV1CustomToolList), ctx, pageToken, pageSize, filters)
+}
+
+// AIV1CustomToolUpdate mocks base method.
+func (m *MockRequestHandler) AIV1CustomToolUpdate(ctx context.Context, customToolID uuid.UUID, name, detail, description string, parameters map[string]any, endpointURL string, headers map[string]string, timeout int, responseTemplate string) (*customtool.CustomTool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIV1CustomToolUpdate", ctx, customToolID, name, detail, description, parameters, endpointURL, headers, timeout, responseTemplate)
+	ret0, _ := ret[0].(*customtool.CustomTool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AIV1CustomToolUpdate indicates an expected call of AIV1CustomToolUpdate.
+func (mr *MockRequestHandlerMockRecorder) AIV1CustomToolUpdate(ctx, customToolID, name, detail, description, parameters, endpointURL, headers, timeout, responseTemplate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIV1CustomToolUpdate", reflect.TypeOf((*MockRequestHandler)(nil).AIV1CustomToolUpdate), ctx, customToolID, name, detail, description, parameters, endpointURL, headers, timeout, responseTemplate)
+}
+
+// AIV1ExtractionCreate mocks base method.
+func (m *MockRequestHandler) AIV1ExtractionCreate(ctx context.Context, customerID, activeflowID, onEndFlowID uuid.UUID, referenceType extraction.ReferenceType, referenceID uuid.UUID, language string, schema map[string]any, setVariables bool, timeout int) (*extraction.Extraction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIV1ExtractionCreate", ctx, customerID, activeflowID, onEndFlowID, referenceType, referenceID, language, schema, setVariables, timeout)
+	ret0, _ := ret[0].(*extraction.Extraction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AIV1ExtractionCreate indicates an expected call of AIV1ExtractionCreate.
+func (mr *MockRequestHandlerMockRecorder) AIV1ExtractionCreate(ctx, customerID, activeflowID, onEndFlowID, referenceType, referenceID, language, schema, setVariables, timeout any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIV1ExtractionCreate", reflect.TypeOf((*MockRequestHandler)(nil).AIV1ExtractionCreate), ctx, customerID, activeflowID, onEndFlowID, referenceType, referenceID, language, schema, setVariables, timeout)
+}
+
+// AIV1ExtractionDelete mocks base method.
+func (m *MockRequestHandler) AIV1ExtractionDelete(ctx context.Context, extractionID uuid.UUID) (*extraction.Extraction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIV1ExtractionDelete", ctx, extractionID)
+	ret0, _ := ret[0].(*extraction.Extraction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AIV1ExtractionDelete indicates an expected call of AIV1ExtractionDelete.
+func (mr *MockRequestHandlerMockRecorder) AIV1ExtractionDelete(ctx, extractionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIV1ExtractionDelete", reflect.TypeOf((*MockRequestHandler)(nil).AIV1ExtractionDelete), ctx, extractionID)
+}
+
+// AIV1ExtractionGet mocks base method.
+func (m *MockRequestHandler) AIV1ExtractionGet(ctx context.Context, extractionID uuid.UUID) (*extraction.Extraction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIV1ExtractionGet", ctx, extractionID)
+	ret0, _ := ret[0].(*extraction.Extraction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AIV1ExtractionGet indicates an expected call of AIV1ExtractionGet.
+func (mr *MockRequestHandlerMockRecorder) AIV1ExtractionGet(ctx, extractionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIV1ExtractionGet", reflect.TypeOf((*MockRequestHandler)(nil).AIV1ExtractionGet), ctx, extractionID)
+}
+
+// AIV1ExtractionList mocks base method.
+func (m *MockRequestHandler) AIV1ExtractionList(ctx context.Context, pageToken string, pageSize uint64, filters map[extraction.Field]any) ([]extraction.Extraction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIV1ExtractionList", ctx, pageToken, pageSize, filters)
+	ret0, _ := ret[0].([]extraction.Extraction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AIV1ExtractionList indicates an expected call of AIV1ExtractionList.
+func (mr *MockRequestHandlerMockRecorder) AIV1ExtractionList(ctx, pageToken, pageSize, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIV1ExtractionList", reflect.TypeOf((*MockRequestHandler)(nil).AIV1ExtractionList), ctx, pageToken, pageSize, filters)
+}
+
+// AIV1GuardrailViolationGet mocks base method.
+func (m *MockRequestHandler) AIV1GuardrailViolationGet(ctx context.Context, guardrailViolationID uuid.UUID) (*guardrailviolation.GuardrailViolation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIV1GuardrailViolationGet", ctx, guardrailViolationID)
+	ret0, _ := ret[0].(*guardrailviolation.GuardrailViolation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AIV1GuardrailViolationGet indicates an expected call of AIV1GuardrailViolationGet.
+func (mr *MockRequestHandlerMockRecorder) AIV1GuardrailViolationGet(ctx, guardrailViolationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIV1GuardrailViolationGet", reflect.TypeOf((*MockRequestHandler)(nil).AIV1GuardrailViolationGet), ctx, guardrailViolationID)
+}
+
+// AIV1GuardrailViolationList mocks base method.
+func (m *MockRequestHandler) AIV1GuardrailViolationList(ctx context.Context, pageToken string, pageSize uint64, filters map[guardrailviolation.Field]any) ([]guardrailviolation.GuardrailViolation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIV1GuardrailViolationList", ctx, pageToken, pageSize, filters)
+	ret0, _ := ret[0].([]guardrailviolation.GuardrailViolation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AIV1GuardrailViolationList indicates an expected call of AIV1GuardrailViolationList.
+func (mr *MockRequestHandlerMockRecorder) AIV1GuardrailViolationList(ctx, pageToken, pageSize, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIV1GuardrailViolationList", reflect.TypeOf((*MockRequestHandler)(nil).AIV1GuardrailViolationList), ctx, pageToken, pageSize, filters)
+}
+
+// AIV1MCPServerCreate mocks base method.
+func (m *MockRequestHandler) AIV1MCPServerCreate(ctx context.Context, customerID uuid.UUID, name, detail string, transport mcpserver.Transport, endpointURL string, headers map[string]string, timeout int, allowedTools []string) (*mcpserver.MCPServer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIV1MCPServerCreate", ctx, customerID, name, detail, transport, endpointURL, headers, timeout, allowedTools)
+	ret0, _ := ret[0].(*mcpserver.MCPServer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AIV1MCPServerCreate indicates an expected call of AIV1MCPServerCreate.
+func (mr *MockRequestHandlerMockRecorder) AIV1MCPServerCreate(ctx, customerID, name, detail, transport, endpointURL, headers, timeout, allowedTools any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIV1MCPServerCreate", reflect.TypeOf((*MockRequestHandler)(nil).AIV1MCPServerCreate), ctx, customerID, name, detail, transport, endpointURL, headers, timeout, allowedTools)
+}
+
+// AIV1MCPServerDelete mocks base method.
+func (m *MockRequestHandler) AIV1MCPServerDelete(ctx context.Context, mcpServerID uuid.UUID) (*mcpserver.MCPServer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIV1MCPServerDelete", ctx, mcpServerID)
+	ret0, _ := ret[0].(*mcpserver.MCPServer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AIV1MCPServerDelete indicates an expected call of AIV1MCPServerDelete.
+func (mr *MockRequestHandlerMockRecorder) AIV1MCPServerDelete(ctx, mcpServerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIV1MCPServerDelete", reflect.TypeOf((*MockRequestHandler)(nil).AIV1MCPServerDelete), ctx, mcpServerID)
+}
+
+// AIV1MCPServerGet mocks base method.
+func (m *MockRequestHandler) AIV1MCPServerGet(ctx context.Context, mcpServerID uuid.UUID) (*mcpserver.MCPServer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIV1MCPServerGet", ctx, mcpServerID)
+	ret0, _ := ret[0].(*mcpserver.MCPServer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AIV1MCPServerGet indicates an expected call of AIV1MCPServerGet.
+func (mr *MockRequestHandlerMockRecorder) AIV1MCPServerGet(ctx, mcpServerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIV1MCPServerGet", reflect.TypeOf((*MockRequestHandler)(nil).AIV1MCPServerGet), ctx, mcpServerID)
+}
+
+// AIV1MCPServerList mocks base method.
+func (m *MockRequestHandler) AIV1MCPServerList(ctx context.Context, pageToken string, pageSize uint64, filters map[mcpserver.Field]any) ([]mcpserver.MCPServer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIV1MCPServerList", ctx, pageToken, pageSize, filters)
+	ret0, _ := ret[0].([]mcpserver.MCPServer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AIV1MCPServerList indicates an expected call of AIV1MCPServerList.
+func (mr *MockRequestHandlerMockRecorder) AIV1MCPServerList(ctx, pageToken, pageSize, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIV1MCPServerList", reflect.TypeOf((*MockRequestHandler)(nil).AIV1MCPServerList), ctx, pageToken, pageSize, filters)
+}
+
+// AIV1MCPServerToolList mocks base method.
+func (m *MockRequestHandler) AIV1MCPServerToolList(ctx context.Context, mcpServerID uuid.UUID) ([]tool.Tool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIV1MCPServerToolList", ctx, mcpServerID)
+	ret0, _ := ret[0].([]tool.Tool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AIV1MCPServerToolList indicates an expected call of AIV1MCPServerToolList.
+func (mr *MockRequestHandlerMockRecorder) AIV1MCPServerToolList(ctx, mcpServerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIV1MCPServerToolList", reflect.TypeOf((*MockRequestHandler)(nil).AIV1MCPServerToolList), ctx, mcpServerID)
+}
+
+// AIV1MCPServerUpdate mocks base method.
+func (m *MockRequestHandler) AIV1MCPServerUpdate(ctx context.Context, mcpServerID uuid.UUID, name, detail string, transport mcpserver.Transport, endpointURL string, headers map[string]string, timeout int, allowedTools []string) (*mcpserver.MCPServer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIV1MCPServerUpdate", ctx, mcpServerID, name, detail, transport, endpointURL, headers, timeout, allowedTools)
+	ret0, _ := ret[0].(*mcpserver.MCPServer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AIV1MCPServerUpdate indicates an expected call of AIV1MCPServerUpdate.
+func (mr *MockRequestHandlerMockRecorder) AIV1MCPServerUpdate(ctx, mcpServerID, name, detail, transport, endpointURL, headers, timeout, allowedTools any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIV1MCPServerUpdate", reflect.TypeOf((*MockRequestHandler)(nil).AIV1MCPServerUpdate), ctx, mcpServerID, name, detail, transport, endpointURL, headers, timeout, allowedTools)
+}
+
 // AIV1MessageDelete mocks base method.
 func (m *MockRequestHandler) AIV1MessageDelete(ctx context.Context, messageID uuid.UUID) (*message.Message, error) {
 	m.ctrl.T.Helper()
@@ -658,6 +1082,21 @@ func (mr *MockRequestHandlerMockRecorder) AIV1ServiceTypeAnalysisRun(ctx, req, r
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIV1ServiceTypeAnalysisRun", reflect.TypeOf((*MockRequestHandler)(nil).AIV1ServiceTypeAnalysisRun), ctx, req, requestTimeout)
 }
 
+// AIV1ServiceTypeExtractionStart mocks base method.
+func (m *MockRequestHandler) AIV1ServiceTypeExtractionStart(ctx context.Context, customerID, activeflowID, onEndFlowID uuid.UUID, referenceType extraction.ReferenceType, referenceID uuid.UUID, language string, schema map[string]any, setVariables bool, requestTimeout int) (*service.Service, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIV1ServiceTypeExtractionStart", ctx, customerID, activeflowID, onEndFlowID, referenceType, referenceID, language, schema, setVariables, requestTimeout)
+	ret0, _ := ret[0].(*service.Service)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AIV1ServiceTypeExtractionStart indicates an expected call of AIV1ServiceTypeExtractionStart.
+func (mr *MockRequestHandlerMockRecorder) AIV1ServiceTypeExtractionStart(ctx, customerID, activeflowID, onEndFlowID, referenceType, referenceID, language, schema, setVariables, requestTimeout any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIV1ServiceTypeExtractionStart", reflect.TypeOf((*MockRequestHandler)(nil).AIV1ServiceTypeExtractionStart), ctx, customerID, activeflowID, onEndFlowID, referenceType, referenceID, language, schema, setVariables, requestTimeout)
+}
+
 // AIV1ServiceTypeSummaryStart mocks base method.
 func (m *MockRequestHandler) AIV1ServiceTypeSummaryStart(ctx context.Context, customerID, activeflowID, onEndFlowID uuid.UUID, referenceType summary.ReferenceType, referenceID uuid.UUID, language string, requestTimeout int) (*service.Service, error) {
 	m.ctrl.T.Helper()
@@ -838,6 +1277,141 @@ func (mr *MockRequestHandlerMockRecorder) AIV1TeamUpdate(ctx, teamID, name, deta
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIV1TeamUpdate", reflect.TypeOf((*MockRequestHandler)(nil).AIV1TeamUpdate), ctx, teamID, name, detail, startMemberID, members, parameter)
 }
 
+// AIV1TestRunDelete mocks base method.
+func (m *MockRequestHandler) AIV1TestRunDelete(ctx context.Context, testRunID uuid.UUID) (*testrun.TestRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIV1TestRunDelete", ctx, testRunID)
+	ret0, _ := ret[0].(*testrun.TestRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AIV1TestRunDelete indicates an expected call of AIV1TestRunDelete.
+func (mr *MockRequestHandlerMockRecorder) AIV1TestRunDelete(ctx, testRunID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIV1TestRunDelete", reflect.TypeOf((*MockRequestHandler)(nil).AIV1TestRunDelete), ctx, testRunID)
+}
+
+// AIV1TestRunGet mocks base method.
+func (m *MockRequestHandler) AIV1TestRunGet(ctx context.Context, testRunID uuid.UUID) (*testrun.TestRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIV1TestRunGet", ctx, testRunID)
+	ret0, _ := ret[0].(*testrun.TestRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AIV1TestRunGet indicates an expected call of AIV1TestRunGet.
+func (mr *MockRequestHandlerMockRecorder) AIV1TestRunGet(ctx, testRunID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIV1TestRunGet", reflect.TypeOf((*MockRequestHandler)(nil).AIV1TestRunGet), ctx, testRunID)
+}
+
+// AIV1TestRunList mocks base method.
+func (m *MockRequestHandler) AIV1TestRunList(ctx context.Context, pageToken string, pageSize uint64, filters map[testrun.Field]any) ([]testrun.TestRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIV1TestRunList", ctx, pageToken, pageSize, filters)
+	ret0, _ := ret[0].([]testrun.TestRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AIV1TestRunList indicates an expected call of AIV1TestRunList.
+func (mr *MockRequestHandlerMockRecorder) AIV1TestRunList(ctx, pageToken, pageSize, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIV1TestRunList", reflect.TypeOf((*MockRequestHandler)(nil).AIV1TestRunList), ctx, pageToken, pageSize, filters)
+}
+
+// AIV1TestSuiteCreate mocks base method.
+func (m *MockRequestHandler) AIV1TestSuiteCreate(ctx context.Context, customerID, aiID uuid.UUID, name, detail string, scenarios []testsuite.Scenario) (*testsuite.TestSuite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIV1TestSuiteCreate", ctx, customerID, aiID, name, detail, scenarios)
+	ret0, _ := ret[0].(*testsuite.TestSuite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AIV1TestSuiteCreate indicates an expected call of AIV1TestSuiteCreate.
+func (mr *MockRequestHandlerMockRecorder) AIV1TestSuiteCreate(ctx, customerID, aiID, name, detail, scenarios any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIV1TestSuiteCreate", reflect.TypeOf((*MockRequestHandler)(nil).AIV1TestSuiteCreate), ctx, customerID, aiID, name, detail, scenarios)
+}
+
+// AIV1TestSuiteDelete mocks base method.
+func (m *MockRequestHandler) AIV1TestSuiteDelete(ctx context.Context, testSuiteID uuid.UUID) (*testsuite.TestSuite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIV1TestSuiteDelete", ctx, testSuiteID)
+	ret0, _ := ret[0].(*testsuite.TestSuite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AIV1TestSuiteDelete indicates an expected call of AIV1TestSuiteDelete.
+func (mr *MockRequestHandlerMockRecorder) AIV1TestSuiteDelete(ctx, testSuiteID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIV1TestSuiteDelete", reflect.TypeOf((*MockRequestHandler)(nil).AIV1TestSuiteDelete), ctx, testSuiteID)
+}
+
+// AIV1TestSuiteGet mocks base method.
+func (m *MockRequestHandler) AIV1TestSuiteGet(ctx context.Context, testSuiteID uuid.UUID) (*testsuite.TestSuite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIV1TestSuiteGet", ctx, testSuiteID)
+	ret0, _ := ret[0].(*testsuite.TestSuite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AIV1TestSuiteGet indicates an expected call of AIV1TestSuiteGet.
+func (mr *MockRequestHandlerMockRecorder) AIV1TestSuiteGet(ctx, testSuiteID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIV1TestSuiteGet", reflect.TypeOf((*MockRequestHandler)(nil).AIV1TestSuiteGet), ctx, testSuiteID)
+}
+
+// AIV1TestSuiteList mocks base method.
+func (m *MockRequestHandler) AIV1TestSuiteList(ctx context.Context, pageToken string, pageSize uint64, filters map[testsuite.Field]any) ([]testsuite.TestSuite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIV1TestSuiteList", ctx, pageToken, pageSize, filters)
+	ret0, _ := ret[0].([]testsuite.TestSuite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AIV1TestSuiteList indicates an expected call of AIV1TestSuiteList.
+func (mr *MockRequestHandlerMockRecorder) AIV1TestSuiteList(ctx, pageToken, pageSize, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIV1TestSuiteList", reflect.TypeOf((*MockRequestHandler)(nil).AIV1TestSuiteList), ctx, pageToken, pageSize, filters)
+}
+
+// AIV1TestSuiteRun mocks base method.
+func (m *MockRequestHandler) AIV1TestSuiteRun(ctx context.Context, testSuiteID uuid.UUID) (*testrun.TestRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIV1TestSuiteRun", ctx, testSuiteID)
+	ret0, _ := ret[0].(*testrun.TestRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AIV1TestSuiteRun indicates an expected call of AIV1TestSuiteRun.
+func (mr *MockRequestHandlerMockRecorder) AIV1TestSuiteRun(ctx, testSuiteID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIV1TestSuiteRun", reflect.TypeOf((*MockRequestHandler)(nil).AIV1TestSuiteRun), ctx, testSuiteID)
+}
+
+// AIV1TestSuiteUpdate mocks base method.
+func (m *MockRequestHandler) AIV1TestSuiteUpdate(ctx context.Context, testSuiteID uuid.UUID, name, detail string, scenarios []testsuite.Scenario) (*testsuite.TestSuite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AIV1TestSuiteUpdate", ctx, testSuiteID, name, detail, scenarios)
+	ret0, _ := ret[0].(*testsuite.TestSuite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AIV1TestSuiteUpdate indicates an expected call of AIV1TestSuiteUpdate.
+func (mr *MockRequestHandlerMockRecorder) AIV1TestSuiteUpdate(ctx, testSuiteID, name, detail, scenarios any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AIV1TestSuiteUpdate", reflect.TypeOf((*MockRequestHandler)(nil).AIV1TestSuiteUpdate), ctx, testSuiteID, name, detail, scenarios)
+}
+
 // AIV1ToolList mocks base method.
 func (m *MockRequestHandler) AIV1ToolList(ctx context.Context) ([]tool.Tool, error) {
 	m.ctrl.T.Helper()
@@ -1782,6 +2356,21 @@ func (mr *MockRequestHandlerMockRecorder) BillingV1AccountUpdatePaymentInfo(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BillingV1AccountUpdatePaymentInfo", reflect.TypeOf((*MockRequestHandler)(nil).BillingV1AccountUpdatePaymentInfo), ctx, accountID, paymentType, paymentMethod)
 }
 
+// BillingV1AccountUpdateSpendingSettings mocks base method.
+func (m *MockRequestHandler) BillingV1AccountUpdateSpendingSettings(ctx context.Context, accountID uuid.UUID, spendLimits []account.SpendLimit, balanceAlertThresholds []int64, autoTopUpThreshold, autoTopUpAmount int64) (*account.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BillingV1AccountUpdateSpendingSettings", ctx, accountID, spendLimits, balanceAlertThresholds, autoTopUpThreshold, autoTopUpAmount)
+	ret0, _ := ret[0].(*account.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BillingV1AccountUpdateSpendingSettings indicates an expected call of BillingV1AccountUpdateSpendingSettings.
+func (mr *MockRequestHandlerMockRecorder) BillingV1AccountUpdateSpendingSettings(ctx, accountID, spendLimits, balanceAlertThresholds, autoTopUpThreshold, autoTopUpAmount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BillingV1AccountUpdateSpendingSettings", reflect.TypeOf((*MockRequestHandler)(nil).BillingV1AccountUpdateSpendingSettings), ctx, accountID, spendLimits, balanceAlertThresholds, autoTopUpThreshold, autoTopUpAmount)
+}
+
 // BillingV1BillingGet mocks base method.
 func (m *MockRequestHandler) BillingV1BillingGet(ctx context.Context, billingID uuid.UUID) (*billing.Billing, error) {
 	m.ctrl.T.Helper()
@@ -1800,30 +2389,90 @@ func (mr *MockRequestHandlerMockRecorder) BillingV1BillingGet(ctx, billingID any
 // BillingV1BillingList mocks base method.
 func (m *MockRequestHandler) BillingV1BillingList(ctx context.Context, pageToken string, pageSize uint64, filters map[billing.Field]any) ([]billing.Billing, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "BillingV1BillingList", ctx, pageToken, pageSize, filters)
-	ret0, _ := ret[0].([]billing.Billing)
+	ret := m.ctrl.Call(m, "BillingV1BillingList", ctx, pageToken, pageSize, filters)
+	ret0, _ := ret[0].([]billing.Billing)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BillingV1BillingList indicates an expected call of BillingV1BillingList.
+func (mr *MockRequestHandlerMockRecorder) BillingV1BillingList(ctx, pageToken, pageSize, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BillingV1BillingList", reflect.TypeOf((*MockRequestHandler)(nil).BillingV1BillingList), ctx, pageToken, pageSize, filters)
+}
+
+// BillingV1EstimateGetByReferenceID mocks base method.
+func (m *MockRequestHandler) BillingV1EstimateGetByReferenceID(ctx context.Context, referenceID uuid.UUID) (*estimate.Estimate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BillingV1EstimateGetByReferenceID", ctx, referenceID)
+	ret0, _ := ret[0].(*estimate.Estimate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BillingV1EstimateGetByReferenceID indicates an expected call of BillingV1EstimateGetByReferenceID.
+func (mr *MockRequestHandlerMockRecorder) BillingV1EstimateGetByReferenceID(ctx, referenceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BillingV1EstimateGetByReferenceID", reflect.TypeOf((*MockRequestHandler)(nil).BillingV1EstimateGetByReferenceID), ctx, referenceID)
+}
+
+// BillingV1EstimateQuote mocks base method.
+func (m *MockRequestHandler) BillingV1EstimateQuote(ctx context.Context, customerID uuid.UUID, costType billing.CostType, destination *address.Address, duration int) (*estimate.Estimate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BillingV1EstimateQuote", ctx, customerID, costType, destination, duration)
+	ret0, _ := ret[0].(*estimate.Estimate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BillingV1EstimateQuote indicates an expected call of BillingV1EstimateQuote.
+func (mr *MockRequestHandlerMockRecorder) BillingV1EstimateQuote(ctx, customerID, costType, destination, duration any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BillingV1EstimateQuote", reflect.TypeOf((*MockRequestHandler)(nil).BillingV1EstimateQuote), ctx, customerID, costType, destination, duration)
+}
+
+// BillingV1PaddleHook mocks base method.
+func (m *MockRequestHandler) BillingV1PaddleHook(ctx context.Context, hm *hook.Hook) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BillingV1PaddleHook", ctx, hm)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BillingV1PaddleHook indicates an expected call of BillingV1PaddleHook.
+func (mr *MockRequestHandlerMockRecorder) BillingV1PaddleHook(ctx, hm any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BillingV1PaddleHook", reflect.TypeOf((*MockRequestHandler)(nil).BillingV1PaddleHook), ctx, hm)
+}
+
+// BillingV1StatementGet mocks base method.
+func (m *MockRequestHandler) BillingV1StatementGet(ctx context.Context, statementID uuid.UUID) (*statement.Statement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BillingV1StatementGet", ctx, statementID)
+	ret0, _ := ret[0].(*statement.Statement)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// BillingV1BillingList indicates an expected call of BillingV1BillingList.
-func (mr *MockRequestHandlerMockRecorder) BillingV1BillingList(ctx, pageToken, pageSize, filters any) *gomock.Call {
+// BillingV1StatementGet indicates an expected call of BillingV1StatementGet.
+func (mr *MockRequestHandlerMockRecorder) BillingV1StatementGet(ctx, statementID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BillingV1BillingList", reflect.TypeOf((*MockRequestHandler)(nil).BillingV1BillingList), ctx, pageToken, pageSize, filters)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BillingV1StatementGet", reflect.TypeOf((*MockRequestHandler)(nil).BillingV1StatementGet), ctx, statementID)
 }
 
-// BillingV1PaddleHook mocks base method.
-func (m *MockRequestHandler) BillingV1PaddleHook(ctx context.Context, hm *hook.Hook) error {
+// BillingV1StatementList mocks base method.
+func (m *MockRequestHandler) BillingV1StatementList(ctx context.Context, pageToken string, pageSize uint64, filters map[statement.Field]any) ([]statement.Statement, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "BillingV1PaddleHook", ctx, hm)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "BillingV1StatementList", ctx, pageToken, pageSize, filters)
+	ret0, _ := ret[0].([]statement.Statement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// BillingV1PaddleHook indicates an expected call of BillingV1PaddleHook.
-func (mr *MockRequestHandlerMockRecorder) BillingV1PaddleHook(ctx, hm any) *gomock.Call {
+// BillingV1StatementList indicates an expected call of BillingV1StatementList.
+func (mr *MockRequestHandlerMockRecorder) BillingV1StatementList(ctx, pageToken, pageSize, filters any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BillingV1PaddleHook", reflect.TypeOf((*MockRequestHandler)(nil).BillingV1PaddleHook), ctx, hm)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BillingV1StatementList", reflect.TypeOf((*MockRequestHandler)(nil).BillingV1StatementList), ctx, pageToken, pageSize, filters)
 }
 
 // CallPublishEvent mocks base method.
@@ -2245,6 +2894,21 @@ func (mr *MockRequestHandlerMockRecorder) CallV1CallUpdateConfbridgeID(ctx, call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CallV1CallUpdateConfbridgeID", reflect.TypeOf((*MockRequestHandler)(nil).CallV1CallUpdateConfbridgeID), ctx, callID, confbirdgeID)
 }
 
+// CallV1CallUpdateMaxCost mocks base method.
+func (m *MockRequestHandler) CallV1CallUpdateMaxCost(ctx context.Context, callID uuid.UUID, maxCost int64) (*call.Call, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CallV1CallUpdateMaxCost", ctx, callID, maxCost)
+	ret0, _ := ret[0].(*call.Call)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CallV1CallUpdateMaxCost indicates an expected call of CallV1CallUpdateMaxCost.
+func (mr *MockRequestHandlerMockRecorder) CallV1CallUpdateMaxCost(ctx, callID, maxCost any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CallV1CallUpdateMaxCost", reflect.TypeOf((*MockRequestHandler)(nil).CallV1CallUpdateMaxCost), ctx, callID, maxCost)
+}
+
 // CallV1CallsCreate mocks base method.
 func (m *MockRequestHandler) CallV1CallsCreate(ctx context.Context, customerID, flowID, masterCallID uuid.UUID, source *address.Address, destinations []address.Address, ealryExecution, connect bool, anonymous string, metadata map[string]any, variables map[string]string) ([]*call.Call, []*groupcall.Groupcall, error) {
 	m.ctrl.T.Helper()
@@ -2926,6 +3590,21 @@ func (mr *MockRequestHandlerMockRecorder) CampaignV1CampaignList(ctx, pageToken,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CampaignV1CampaignList", reflect.TypeOf((*MockRequestHandler)(nil).CampaignV1CampaignList), ctx, pageToken, pageSize, filters)
 }
 
+// CampaignV1CampaignResultList mocks base method.
+func (m *MockRequestHandler) CampaignV1CampaignResultList(ctx context.Context, id uuid.UUID, pageToken string, pageSize uint64) ([]campaignresult.CampaignResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CampaignV1CampaignResultList", ctx, id, pageToken, pageSize)
+	ret0, _ := ret[0].([]campaignresult.CampaignResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CampaignV1CampaignResultList indicates an expected call of CampaignV1CampaignResultList.
+func (mr *MockRequestHandlerMockRecorder) CampaignV1CampaignResultList(ctx, id, pageToken, pageSize any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CampaignV1CampaignResultList", reflect.TypeOf((*MockRequestHandler)(nil).CampaignV1CampaignResultList), ctx, id, pageToken, pageSize)
+}
+
 // CampaignV1CampaignUpdateActions mocks base method.
 func (m *MockRequestHandler) CampaignV1CampaignUpdateActions(ctx context.Context, id uuid.UUID, actions []action.Action) (*campaign.Campaign, error) {
 	m.ctrl.T.Helper()
@@ -2956,6 +3635,36 @@ func (mr *MockRequestHandlerMockRecorder) CampaignV1CampaignUpdateBasicInfo(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CampaignV1CampaignUpdateBasicInfo", reflect.TypeOf((*MockRequestHandler)(nil).CampaignV1CampaignUpdateBasicInfo), ctx, id, name, detail, campaignType, serviceLevel, endHandle)
 }
 
+// CampaignV1CampaignUpdateCallingWindows mocks base method.
+func (m *MockRequestHandler) CampaignV1CampaignUpdateCallingWindows(ctx context.Context, id uuid.UUID, callingWindows []campaign.CallingWindow, timezone string) (*campaign.Campaign, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CampaignV1CampaignUpdateCallingWindows", ctx, id, callingWindows, timezone)
+	ret0, _ := ret[0].(*campaign.Campaign)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CampaignV1CampaignUpdateCallingWindows indicates an expected call of CampaignV1CampaignUpdateCallingWindows.
+func (mr *MockRequestHandlerMockRecorder) CampaignV1CampaignUpdateCallingWindows(ctx, id, callingWindows, timezone any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CampaignV1CampaignUpdateCallingWindows", reflect.TypeOf((*MockRequestHandler)(nil).CampaignV1CampaignUpdateCallingWindows), ctx, id, callingWindows, timezone)
+}
+
+// CampaignV1CampaignUpdateDialMode mocks base method.
+func (m *MockRequestHandler) CampaignV1CampaignUpdateDialMode(ctx context.Context, id uuid.UUID, dialMode campaign.DialMode, maxAbandonRate int) (*campaign.Campaign, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CampaignV1CampaignUpdateDialMode", ctx, id, dialMode, maxAbandonRate)
+	ret0, _ := ret[0].(*campaign.Campaign)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CampaignV1CampaignUpdateDialMode indicates an expected call of CampaignV1CampaignUpdateDialMode.
+func (mr *MockRequestHandlerMockRecorder) CampaignV1CampaignUpdateDialMode(ctx, id, dialMode, maxAbandonRate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CampaignV1CampaignUpdateDialMode", reflect.TypeOf((*MockRequestHandler)(nil).CampaignV1CampaignUpdateDialMode), ctx, id, dialMode, maxAbandonRate)
+}
+
 // CampaignV1CampaignUpdateNextCampaignID mocks base method.
 func (m *MockRequestHandler) CampaignV1CampaignUpdateNextCampaignID(ctx context.Context, id, nextCampaignID uuid.UUID) (*campaign.Campaign, error) {
 	m.ctrl.T.Helper()
@@ -3016,6 +3725,21 @@ func (mr *MockRequestHandlerMockRecorder) CampaignV1CampaignUpdateStatus(ctx, id
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CampaignV1CampaignUpdateStatus", reflect.TypeOf((*MockRequestHandler)(nil).CampaignV1CampaignUpdateStatus), ctx, id, status)
 }
 
+// CampaignV1CampaigncallAccept mocks base method.
+func (m *MockRequestHandler) CampaignV1CampaigncallAccept(ctx context.Context, id, agentID uuid.UUID) (*campaigncall.Campaigncall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CampaignV1CampaigncallAccept", ctx, id, agentID)
+	ret0, _ := ret[0].(*campaigncall.Campaigncall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CampaignV1CampaigncallAccept indicates an expected call of CampaignV1CampaigncallAccept.
+func (mr *MockRequestHandlerMockRecorder) CampaignV1CampaigncallAccept(ctx, id, agentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CampaignV1CampaigncallAccept", reflect.TypeOf((*MockRequestHandler)(nil).CampaignV1CampaigncallAccept), ctx, id, agentID)
+}
+
 // CampaignV1CampaigncallDelete mocks base method.
 func (m *MockRequestHandler) CampaignV1CampaigncallDelete(ctx context.Context, id uuid.UUID) (*campaigncall.Campaigncall, error) {
 	m.ctrl.T.Helper()
@@ -3061,6 +3785,156 @@ func (mr *MockRequestHandlerMockRecorder) CampaignV1CampaigncallList(ctx, pageTo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CampaignV1CampaigncallList", reflect.TypeOf((*MockRequestHandler)(nil).CampaignV1CampaigncallList), ctx, pageToken, pageSize, filters)
 }
 
+// CampaignV1CampaigncallSkip mocks base method.
+func (m *MockRequestHandler) CampaignV1CampaigncallSkip(ctx context.Context, id uuid.UUID) (*campaigncall.Campaigncall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CampaignV1CampaigncallSkip", ctx, id)
+	ret0, _ := ret[0].(*campaigncall.Campaigncall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CampaignV1CampaigncallSkip indicates an expected call of CampaignV1CampaigncallSkip.
+func (mr *MockRequestHandlerMockRecorder) CampaignV1CampaigncallSkip(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CampaignV1CampaigncallSkip", reflect.TypeOf((*MockRequestHandler)(nil).CampaignV1CampaigncallSkip), ctx, id)
+}
+
+// CampaignV1CampaigncallUpdateDisposition mocks base method.
+func (m *MockRequestHandler) CampaignV1CampaigncallUpdateDisposition(ctx context.Context, id uuid.UUID, arg2 string) (*campaigncall.Campaigncall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CampaignV1CampaigncallUpdateDisposition", ctx, id, arg2)
+	ret0, _ := ret[0].(*campaigncall.Campaigncall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CampaignV1CampaigncallUpdateDisposition indicates an expected call of CampaignV1CampaigncallUpdateDisposition.
+func (mr *MockRequestHandlerMockRecorder) CampaignV1CampaigncallUpdateDisposition(ctx, id, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CampaignV1CampaigncallUpdateDisposition", reflect.TypeOf((*MockRequestHandler)(nil).CampaignV1CampaigncallUpdateDisposition), ctx, id, arg2)
+}
+
+// CampaignV1CampaignresultjobCreate mocks base method.
+func (m *MockRequestHandler) CampaignV1CampaignresultjobCreate(ctx context.Context, campaignID uuid.UUID) (*campaignresultjob.CampaignResultJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CampaignV1CampaignresultjobCreate", ctx, campaignID)
+	ret0, _ := ret[0].(*campaignresultjob.CampaignResultJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CampaignV1CampaignresultjobCreate indicates an expected call of CampaignV1CampaignresultjobCreate.
+func (mr *MockRequestHandlerMockRecorder) CampaignV1CampaignresultjobCreate(ctx, campaignID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CampaignV1CampaignresultjobCreate", reflect.TypeOf((*MockRequestHandler)(nil).CampaignV1CampaignresultjobCreate), ctx, campaignID)
+}
+
+// CampaignV1CampaignresultjobGet mocks base method.
+func (m *MockRequestHandler) CampaignV1CampaignresultjobGet(ctx context.Context, id uuid.UUID) (*campaignresultjob.CampaignResultJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CampaignV1CampaignresultjobGet", ctx, id)
+	ret0, _ := ret[0].(*campaignresultjob.CampaignResultJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CampaignV1CampaignresultjobGet indicates an expected call of CampaignV1CampaignresultjobGet.
+func (mr *MockRequestHandlerMockRecorder) CampaignV1CampaignresultjobGet(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CampaignV1CampaignresultjobGet", reflect.TypeOf((*MockRequestHandler)(nil).CampaignV1CampaignresultjobGet), ctx, id)
+}
+
+// CampaignV1CampaignresultjobList mocks base method.
+func (m *MockRequestHandler) CampaignV1CampaignresultjobList(ctx context.Context, pageToken string, pageSize uint64, filters map[campaignresultjob.Field]any) ([]campaignresultjob.CampaignResultJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CampaignV1CampaignresultjobList", ctx, pageToken, pageSize, filters)
+	ret0, _ := ret[0].([]campaignresultjob.CampaignResultJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CampaignV1CampaignresultjobList indicates an expected call of CampaignV1CampaignresultjobList.
+func (mr *MockRequestHandlerMockRecorder) CampaignV1CampaignresultjobList(ctx, pageToken, pageSize, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CampaignV1CampaignresultjobList", reflect.TypeOf((*MockRequestHandler)(nil).CampaignV1CampaignresultjobList), ctx, pageToken, pageSize, filters)
+}
+
+// CampaignV1DispositionCreate mocks base method.
+func (m *MockRequestHandler) CampaignV1DispositionCreate(ctx context.Context, customerID uuid.UUID, code, name, detail string, retryMode disposition.RetryMode) (*disposition.Disposition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CampaignV1DispositionCreate", ctx, customerID, code, name, detail, retryMode)
+	ret0, _ := ret[0].(*disposition.Disposition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CampaignV1DispositionCreate indicates an expected call of CampaignV1DispositionCreate.
+func (mr *MockRequestHandlerMockRecorder) CampaignV1DispositionCreate(ctx, customerID, code, name, detail, retryMode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CampaignV1DispositionCreate", reflect.TypeOf((*MockRequestHandler)(nil).CampaignV1DispositionCreate), ctx, customerID, code, name, detail, retryMode)
+}
+
+// CampaignV1DispositionDelete mocks base method.
+func (m *MockRequestHandler) CampaignV1DispositionDelete(ctx context.Context, id uuid.UUID) (*disposition.Disposition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CampaignV1DispositionDelete", ctx, id)
+	ret0, _ := ret[0].(*disposition.Disposition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CampaignV1DispositionDelete indicates an expected call of CampaignV1DispositionDelete.
+func (mr *MockRequestHandlerMockRecorder) CampaignV1DispositionDelete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CampaignV1DispositionDelete", reflect.TypeOf((*MockRequestHandler)(nil).CampaignV1DispositionDelete), ctx, id)
+}
+
+// CampaignV1DispositionGet mocks base method.
+func (m *MockRequestHandler) CampaignV1DispositionGet(ctx context.Context, id uuid.UUID) (*disposition.Disposition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CampaignV1DispositionGet", ctx, id)
+	ret0, _ := ret[0].(*disposition.Disposition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CampaignV1DispositionGet indicates an expected call of CampaignV1DispositionGet.
+func (mr *MockRequestHandlerMockRecorder) CampaignV1DispositionGet(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CampaignV1DispositionGet", reflect.TypeOf((*MockRequestHandler)(nil).CampaignV1DispositionGet), ctx, id)
+}
+
+// CampaignV1DispositionList mocks base method.
+func (m *MockRequestHandler) CampaignV1DispositionList(ctx context.Context, pageToken string, pageSize uint64, filters map[disposition.Field]any) ([]disposition.Disposition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CampaignV1DispositionList", ctx, pageToken, pageSize, filters)
+	ret0, _ := ret[0].([]disposition.Disposition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CampaignV1DispositionList indicates an expected call of CampaignV1DispositionList.
+func (mr *MockRequestHandlerMockRecorder) CampaignV1DispositionList(ctx, pageToken, pageSize, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CampaignV1DispositionList", reflect.TypeOf((*MockRequestHandler)(nil).CampaignV1DispositionList), ctx, pageToken, pageSize, filters)
+}
+
+// CampaignV1DispositionUpdate mocks base method.
+func (m *MockRequestHandler) CampaignV1DispositionUpdate(ctx context.Context, id uuid.UUID, name, detail string, retryMode disposition.RetryMode) (*disposition.Disposition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CampaignV1DispositionUpdate", ctx, id, name, detail, retryMode)
+	ret0, _ := ret[0].(*disposition.Disposition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CampaignV1DispositionUpdate indicates an expected call of CampaignV1DispositionUpdate.
+func (mr *MockRequestHandlerMockRecorder) CampaignV1DispositionUpdate(ctx, id, name, detail, retryMode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CampaignV1DispositionUpdate", reflect.TypeOf((*MockRequestHandler)(nil).CampaignV1DispositionUpdate), ctx, id, name, detail, retryMode)
+}
+
 // CampaignV1OutplanCreate mocks base method.
 func (m *MockRequestHandler) CampaignV1OutplanCreate(ctx context.Context, customerID uuid.UUID, name, detail string, source *address.Address, dialTimeout, tryInterval, maxTryCount0, maxTryCount1, maxTryCount2, maxTryCount3, maxTryCount4 int) (*outplan.Outplan, error) {
 	m.ctrl.T.Helper()
@@ -5376,18 +6250,18 @@ func (mr *MockRequestHandlerMockRecorder) OutdialV1OutdialUpdateData(ctx, outdia
 }
 
 // OutdialV1OutdialtargetCreate mocks base method.
-func (m *MockRequestHandler) OutdialV1OutdialtargetCreate(ctx context.Context, outdialID uuid.UUID, name, detail, data string, destination0, destination1, destination2, destination3, destination4 *address.Address) (*outdialtarget.OutdialTarget, error) {
+func (m *MockRequestHandler) OutdialV1OutdialtargetCreate(ctx context.Context, outdialID uuid.UUID, name, detail, data, timezone string, destination0, destination1, destination2, destination3, destination4 *address.Address) (*outdialtarget.OutdialTarget, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "OutdialV1OutdialtargetCreate", ctx, outdialID, name, detail, data, destination0, destination1, destination2, destination3, destination4)
+	ret := m.ctrl.Call(m, "OutdialV1OutdialtargetCreate", ctx, outdialID, name, detail, data, timezone, destination0, destination1, destination2, destination3, destination4)
 	ret0, _ := ret[0].(*outdialtarget.OutdialTarget)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // OutdialV1OutdialtargetCreate indicates an expected call of OutdialV1OutdialtargetCreate.
-func (mr *MockRequestHandlerMockRecorder) OutdialV1OutdialtargetCreate(ctx, outdialID, name, detail, data, destination0, destination1, destination2, destination3, destination4 any) *gomock.Call {
+func (mr *MockRequestHandlerMockRecorder) OutdialV1OutdialtargetCreate(ctx, outdialID, name, detail, data, timezone, destination0, destination1, destination2, destination3, destination4 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutdialV1OutdialtargetCreate", reflect.TypeOf((*MockRequestHandler)(nil).OutdialV1OutdialtargetCreate), ctx, outdialID, name, detail, data, destination0, destination1, destination2, destination3, destination4)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutdialV1OutdialtargetCreate", reflect.TypeOf((*MockRequestHandler)(nil).OutdialV1OutdialtargetCreate), ctx, outdialID, name, detail, data, timezone, destination0, destination1, destination2, destination3, destination4)
 }
 
 // OutdialV1OutdialtargetDelete mocks base method.
@@ -5450,6 +6324,21 @@ func (mr *MockRequestHandlerMockRecorder) OutdialV1OutdialtargetGetsByOutdialID(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutdialV1OutdialtargetGetsByOutdialID", reflect.TypeOf((*MockRequestHandler)(nil).OutdialV1OutdialtargetGetsByOutdialID), ctx, outdialID, pageToken, pageSize)
 }
 
+// OutdialV1OutdialtargetUpdateCallback mocks base method.
+func (m *MockRequestHandler) OutdialV1OutdialtargetUpdateCallback(ctx context.Context, outdialtargetID uuid.UUID, tmCallback *time.Time, agentID uuid.UUID) (*outdialtarget.OutdialTarget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OutdialV1OutdialtargetUpdateCallback", ctx, outdialtargetID, tmCallback, agentID)
+	ret0, _ := ret[0].(*outdialtarget.OutdialTarget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OutdialV1OutdialtargetUpdateCallback indicates an expected call of OutdialV1OutdialtargetUpdateCallback.
+func (mr *MockRequestHandlerMockRecorder) OutdialV1OutdialtargetUpdateCallback(ctx, outdialtargetID, tmCallback, agentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutdialV1OutdialtargetUpdateCallback", reflect.TypeOf((*MockRequestHandler)(nil).OutdialV1OutdialtargetUpdateCallback), ctx, outdialtargetID, tmCallback, agentID)
+}
+
 // OutdialV1OutdialtargetUpdateStatus mocks base method.
 func (m *MockRequestHandler) OutdialV1OutdialtargetUpdateStatus(ctx context.Context, outdialtargetID uuid.UUID, status outdialtarget.Status) (*outdialtarget.OutdialTarget, error) {
 	m.ctrl.T.Helper()
@@ -5480,6 +6369,172 @@ func (mr *MockRequestHandlerMockRecorder) OutdialV1OutdialtargetUpdateStatusProg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutdialV1OutdialtargetUpdateStatusProgressing", reflect.TypeOf((*MockRequestHandler)(nil).OutdialV1OutdialtargetUpdateStatusProgressing), ctx, outdialtargetID, destinationIndex)
 }
 
+// OutdialV1OutdialtargetjobCreate mocks base method.
+func (m *MockRequestHandler) OutdialV1OutdialtargetjobCreate(ctx context.Context, outdialID uuid.UUID, jobType outdialtargetjob.Type, fileID uuid.UUID, mapping *outdialtargetjob.Mapping) (*outdialtargetjob.OutdialTargetJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OutdialV1OutdialtargetjobCreate", ctx, outdialID, jobType, fileID, mapping)
+	ret0, _ := ret[0].(*outdialtargetjob.OutdialTargetJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OutdialV1OutdialtargetjobCreate indicates an expected call of OutdialV1OutdialtargetjobCreate.
+func (mr *MockRequestHandlerMockRecorder) OutdialV1OutdialtargetjobCreate(ctx, outdialID, jobType, fileID, mapping any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutdialV1OutdialtargetjobCreate", reflect.TypeOf((*MockRequestHandler)(nil).OutdialV1OutdialtargetjobCreate), ctx, outdialID, jobType, fileID, mapping)
+}
+
+// OutdialV1OutdialtargetjobGet mocks base method.
+func (m *MockRequestHandler) OutdialV1OutdialtargetjobGet(ctx context.Context, outdialtargetjobID uuid.UUID) (*outdialtargetjob.OutdialTargetJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OutdialV1OutdialtargetjobGet", ctx, outdialtargetjobID)
+	ret0, _ := ret[0].(*outdialtargetjob.OutdialTargetJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OutdialV1OutdialtargetjobGet indicates an expected call of OutdialV1OutdialtargetjobGet.
+func (mr *MockRequestHandlerMockRecorder) OutdialV1OutdialtargetjobGet(ctx, outdialtargetjobID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutdialV1OutdialtargetjobGet", reflect.TypeOf((*MockRequestHandler)(nil).OutdialV1OutdialtargetjobGet), ctx, outdialtargetjobID)
+}
+
+// OutdialV1OutdialtargetjobList mocks base method.
+func (m *MockRequestHandler) OutdialV1OutdialtargetjobList(ctx context.Context, pageToken string, pageSize uint64, filters map[outdialtargetjob.Field]any) ([]outdialtargetjob.OutdialTargetJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OutdialV1OutdialtargetjobList", ctx, pageToken, pageSize, filters)
+	ret0, _ := ret[0].([]outdialtargetjob.OutdialTargetJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OutdialV1OutdialtargetjobList indicates an expected call of OutdialV1OutdialtargetjobList.
+func (mr *MockRequestHandlerMockRecorder) OutdialV1OutdialtargetjobList(ctx, pageToken, pageSize, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutdialV1OutdialtargetjobList", reflect.TypeOf((*MockRequestHandler)(nil).OutdialV1OutdialtargetjobList), ctx, pageToken, pageSize, filters)
+}
+
+// OutdialV1SuppressionCreate mocks base method.
+func (m *MockRequestHandler) OutdialV1SuppressionCreate(ctx context.Context, customerID uuid.UUID, listType suppression.ListType, target, detail string) (*suppression.Suppression, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OutdialV1SuppressionCreate", ctx, customerID, listType, target, detail)
+	ret0, _ := ret[0].(*suppression.Suppression)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OutdialV1SuppressionCreate indicates an expected call of OutdialV1SuppressionCreate.
+func (mr *MockRequestHandlerMockRecorder) OutdialV1SuppressionCreate(ctx, customerID, listType, target, detail any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutdialV1SuppressionCreate", reflect.TypeOf((*MockRequestHandler)(nil).OutdialV1SuppressionCreate), ctx, customerID, listType, target, detail)
+}
+
+// OutdialV1SuppressionDelete mocks base method.
+func (m *MockRequestHandler) OutdialV1SuppressionDelete(ctx context.Context, suppressionID uuid.UUID) (*suppression.Suppression, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OutdialV1SuppressionDelete", ctx, suppressionID)
+	ret0, _ := ret[0].(*suppression.Suppression)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OutdialV1SuppressionDelete indicates an expected call of OutdialV1SuppressionDelete.
+func (mr *MockRequestHandlerMockRecorder) OutdialV1SuppressionDelete(ctx, suppressionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutdialV1SuppressionDelete", reflect.TypeOf((*MockRequestHandler)(nil).OutdialV1SuppressionDelete), ctx, suppressionID)
+}
+
+// OutdialV1SuppressionGet mocks base method.
+func (m *MockRequestHandler) OutdialV1SuppressionGet(ctx context.Context, suppressionID uuid.UUID) (*suppression.Suppression, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OutdialV1SuppressionGet", ctx, suppressionID)
+	ret0, _ := ret[0].(*suppression.Suppression)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OutdialV1SuppressionGet indicates an expected call of OutdialV1SuppressionGet.
+func (mr *MockRequestHandlerMockRecorder) OutdialV1SuppressionGet(ctx, suppressionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutdialV1SuppressionGet", reflect.TypeOf((*MockRequestHandler)(nil).OutdialV1SuppressionGet), ctx, suppressionID)
+}
+
+// OutdialV1SuppressionImport mocks base method.
+func (m *MockRequestHandler) OutdialV1SuppressionImport(ctx context.Context, customerID uuid.UUID, listType suppression.ListType, targets []string, detail string) (int, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OutdialV1SuppressionImport", ctx, customerID, listType, targets, detail)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// OutdialV1SuppressionImport indicates an expected call of OutdialV1SuppressionImport.
+func (mr *MockRequestHandlerMockRecorder) OutdialV1SuppressionImport(ctx, customerID, listType, targets, detail any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutdialV1SuppressionImport", reflect.TypeOf((*MockRequestHandler)(nil).OutdialV1SuppressionImport), ctx, customerID, listType, targets, detail)
+}
+
+// OutdialV1SuppressionIsSuppressed mocks base method.
+func (m *MockRequestHandler) OutdialV1SuppressionIsSuppressed(ctx context.Context, customerID uuid.UUID, target string, referenceType suppressionblock.ReferenceType, referenceID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OutdialV1SuppressionIsSuppressed", ctx, customerID, target, referenceType, referenceID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OutdialV1SuppressionIsSuppressed indicates an expected call of OutdialV1SuppressionIsSuppressed.
+func (mr *MockRequestHandlerMockRecorder) OutdialV1SuppressionIsSuppressed(ctx, customerID, target, referenceType, referenceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutdialV1SuppressionIsSuppressed", reflect.TypeOf((*MockRequestHandler)(nil).OutdialV1SuppressionIsSuppressed), ctx, customerID, target, referenceType, referenceID)
+}
+
+// OutdialV1SuppressionList mocks base method.
+func (m *MockRequestHandler) OutdialV1SuppressionList(ctx context.Context, pageToken string, pageSize uint64, filters map[suppression.Field]any) ([]suppression.Suppression, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OutdialV1SuppressionList", ctx, pageToken, pageSize, filters)
+	ret0, _ := ret[0].([]suppression.Suppression)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OutdialV1SuppressionList indicates an expected call of OutdialV1SuppressionList.
+func (mr *MockRequestHandlerMockRecorder) OutdialV1SuppressionList(ctx, pageToken, pageSize, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutdialV1SuppressionList", reflect.TypeOf((*MockRequestHandler)(nil).OutdialV1SuppressionList), ctx, pageToken, pageSize, filters)
+}
+
+// OutdialV1SuppressionblockGet mocks base method.
+func (m *MockRequestHandler) OutdialV1SuppressionblockGet(ctx context.Context, suppressionblockID uuid.UUID) (*suppressionblock.SuppressionBlock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OutdialV1SuppressionblockGet", ctx, suppressionblockID)
+	ret0, _ := ret[0].(*suppressionblock.SuppressionBlock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OutdialV1SuppressionblockGet indicates an expected call of OutdialV1SuppressionblockGet.
+func (mr *MockRequestHandlerMockRecorder) OutdialV1SuppressionblockGet(ctx, suppressionblockID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutdialV1SuppressionblockGet", reflect.TypeOf((*MockRequestHandler)(nil).OutdialV1SuppressionblockGet), ctx, suppressionblockID)
+}
+
+// OutdialV1SuppressionblockList mocks base method.
+func (m *MockRequestHandler) OutdialV1SuppressionblockList(ctx context.Context, pageToken string, pageSize uint64, filters map[suppressionblock.Field]any) ([]suppressionblock.SuppressionBlock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OutdialV1SuppressionblockList", ctx, pageToken, pageSize, filters)
+	ret0, _ := ret[0].([]suppressionblock.SuppressionBlock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OutdialV1SuppressionblockList indicates an expected call of OutdialV1SuppressionblockList.
+func (mr *MockRequestHandlerMockRecorder) OutdialV1SuppressionblockList(ctx, pageToken, pageSize, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutdialV1SuppressionblockList", reflect.TypeOf((*MockRequestHandler)(nil).OutdialV1SuppressionblockList), ctx, pageToken, pageSize, filters)
+}
+
 // PipecatV1MessageSend mocks base method.
 func (m *MockRequestHandler) PipecatV1MessageSend(ctx context.Context, hostID string, pipecatcallID uuid.UUID, messageID, messageText string, runImmediately, audioResponse bool) (*message2.Message, error) {
 	m.ctrl.T.Helper()
@@ -5954,6 +7009,21 @@ func (mr *MockRequestHandlerMockRecorder) RTPEngineV1CommandsSend(ctx, rtpengine
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RTPEngineV1CommandsSend", reflect.TypeOf((*MockRequestHandler)(nil).RTPEngineV1CommandsSend), ctx, rtpengineID, command)
 }
 
+// RagV1EmbeddingCreate mocks base method.
+func (m *MockRequestHandler) RagV1EmbeddingCreate(ctx context.Context, texts []string) ([][]float32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RagV1EmbeddingCreate", ctx, texts)
+	ret0, _ := ret[0].([][]float32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RagV1EmbeddingCreate indicates an expected call of RagV1EmbeddingCreate.
+func (mr *MockRequestHandlerMockRecorder) RagV1EmbeddingCreate(ctx, texts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RagV1EmbeddingCreate", reflect.TypeOf((*MockRequestHandler)(nil).RagV1EmbeddingCreate), ctx, texts)
+}
+
 // RagV1RagAddSources mocks base method.
 func (m *MockRequestHandler) RagV1RagAddSources(ctx context.Context, ragID uuid.UUID, storageFileIDs []uuid.UUID, sourceURLs []string) (*rag.Rag, error) {
 	m.ctrl.T.Helper()
@@ -6836,6 +7906,21 @@ func (mr *MockRequestHandlerMockRecorder) StorageV1FileList(ctx, pageToken, page
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StorageV1FileList", reflect.TypeOf((*MockRequestHandler)(nil).StorageV1FileList), ctx, pageToken, pageSize, filters)
 }
 
+// StorageV1FileUploadURICreate mocks base method.
+func (m *MockRequestHandler) StorageV1FileUploadURICreate(ctx context.Context) (*file.UploadURI, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StorageV1FileUploadURICreate", ctx)
+	ret0, _ := ret[0].(*file.UploadURI)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StorageV1FileUploadURICreate indicates an expected call of StorageV1FileUploadURICreate.
+func (mr *MockRequestHandlerMockRecorder) StorageV1FileUploadURICreate(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StorageV1FileUploadURICreate", reflect.TypeOf((*MockRequestHandler)(nil).StorageV1FileUploadURICreate), ctx)
+}
+
 // StorageV1RecordingDelete mocks base method.
 func (m *MockRequestHandler) StorageV1RecordingDelete(ctx context.Context, recordingID uuid.UUID) error {
 	m.ctrl.T.Helper()
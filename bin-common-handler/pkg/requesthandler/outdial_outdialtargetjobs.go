@@ -0,0 +1,85 @@
+package requesthandler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	omoutdialtargetjob "monorepo/bin-outdial-manager/models/outdialtargetjob"
+	omrequest "monorepo/bin-outdial-manager/pkg/listenhandler/models/request"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+
+	"monorepo/bin-common-handler/models/sock"
+)
+
+// OutdialV1OutdialtargetjobCreate sends a request to outdial-manager
+// to start a new import or export job of the outdial's targets.
+// the fileID and mapping are used only for the import.
+func (r *requestHandler) OutdialV1OutdialtargetjobCreate(ctx context.Context, outdialID uuid.UUID, jobType omoutdialtargetjob.Type, fileID uuid.UUID, mapping *omoutdialtargetjob.Mapping) (*omoutdialtargetjob.OutdialTargetJob, error) {
+	uri := "/v1/outdialtargetjobs"
+
+	m, err := json.Marshal(&omrequest.V1DataOutdialtargetjobsPost{
+		OutdialID: outdialID,
+		Type:      jobType,
+		FileID:    fileID,
+		Mapping:   mapping,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tmp, err := r.sendRequestOutdial(ctx, uri, sock.RequestMethodPost, "outdial/outdialtargetjobs", requestTimeoutDefault, 0, ContentTypeJSON, m)
+	if err != nil {
+		return nil, err
+	}
+
+	var res omoutdialtargetjob.OutdialTargetJob
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return &res, nil
+}
+
+// OutdialV1OutdialtargetjobGet returns the outdialtargetjob.
+func (r *requestHandler) OutdialV1OutdialtargetjobGet(ctx context.Context, outdialtargetjobID uuid.UUID) (*omoutdialtargetjob.OutdialTargetJob, error) {
+	uri := fmt.Sprintf("/v1/outdialtargetjobs/%s", outdialtargetjobID)
+
+	tmp, err := r.sendRequestOutdial(ctx, uri, sock.RequestMethodGet, "outdial/outdialtargetjobs", requestTimeoutDefault, 0, ContentTypeJSON, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res omoutdialtargetjob.OutdialTargetJob
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return &res, nil
+}
+
+// OutdialV1OutdialtargetjobList sends a request to outdial-manager
+// to get a list of outdialtargetjobs.
+func (r *requestHandler) OutdialV1OutdialtargetjobList(ctx context.Context, pageToken string, pageSize uint64, filters map[omoutdialtargetjob.Field]any) ([]omoutdialtargetjob.OutdialTargetJob, error) {
+	uri := fmt.Sprintf("/v1/outdialtargetjobs?page_token=%s&page_size=%d", url.QueryEscape(pageToken), pageSize)
+
+	m, err := json.Marshal(filters)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not marshal filters")
+	}
+
+	tmp, err := r.sendRequestOutdial(ctx, uri, sock.RequestMethodGet, "outdial/outdialtargetjobs", requestTimeoutDefault, 0, ContentTypeJSON, m)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []omoutdialtargetjob.OutdialTargetJob
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return res, nil
+}
@@ -0,0 +1,249 @@
+package requesthandler
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	omoutdialtargetjob "monorepo/bin-outdial-manager/models/outdialtargetjob"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+
+	"monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/models/sock"
+	"monorepo/bin-common-handler/pkg/sockhandler"
+)
+
+func Test_OutdialV1OutdialtargetjobCreate(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		outdialID uuid.UUID
+		jobType   omoutdialtargetjob.Type
+		fileID    uuid.UUID
+		mapping   *omoutdialtargetjob.Mapping
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+		expectRes     *omoutdialtargetjob.OutdialTargetJob
+	}{
+		{
+			name: "import",
+
+			outdialID: uuid.FromStringOrNil("5d0e1f2a-2d52-11f1-8a01-4b5c6d7e8f01"),
+			jobType:   omoutdialtargetjob.TypeImport,
+			fileID:    uuid.FromStringOrNil("5d3b413e-2d52-11f1-9b12-5c6d7e8f9a02"),
+			mapping: &omoutdialtargetjob.Mapping{
+				Destination0: "phone",
+			},
+
+			response: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"5d686352-2d52-11f1-ac23-6d7e8f9a0b03"}`),
+			},
+
+			expectTarget: "bin-manager.outdial-manager.request",
+			expectRequest: &sock.Request{
+				URI:      "/v1/outdialtargetjobs",
+				Method:   sock.RequestMethodPost,
+				DataType: ContentTypeJSON,
+				Data:     []byte(`{"outdial_id":"5d0e1f2a-2d52-11f1-8a01-4b5c6d7e8f01","type":"import","file_id":"5d3b413e-2d52-11f1-9b12-5c6d7e8f9a02","mapping":{"destination_0":"phone"}}`),
+			},
+			expectRes: &omoutdialtargetjob.OutdialTargetJob{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("5d686352-2d52-11f1-ac23-6d7e8f9a0b03"),
+				},
+			},
+		},
+		{
+			name: "export",
+
+			outdialID: uuid.FromStringOrNil("5d0e1f2a-2d52-11f1-8a01-4b5c6d7e8f01"),
+			jobType:   omoutdialtargetjob.TypeExport,
+
+			response: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"5d958566-2d52-11f1-bd34-7e8f9a0b1c04"}`),
+			},
+
+			expectTarget: "bin-manager.outdial-manager.request",
+			expectRequest: &sock.Request{
+				URI:      "/v1/outdialtargetjobs",
+				Method:   sock.RequestMethodPost,
+				DataType: ContentTypeJSON,
+				Data:     []byte(`{"outdial_id":"5d0e1f2a-2d52-11f1-8a01-4b5c6d7e8f01","type":"export","file_id":"00000000-0000-0000-0000-000000000000"}`),
+			},
+			expectRes: &omoutdialtargetjob.OutdialTargetJob{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("5d958566-2d52-11f1-bd34-7e8f9a0b1c04"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			reqHandler := requestHandler{
+				sock: mockSock,
+			}
+
+			ctx := context.Background()
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, err := reqHandler.OutdialV1OutdialtargetjobCreate(ctx, tt.outdialID, tt.jobType, tt.fileID, tt.mapping)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_OutdialV1OutdialtargetjobGet(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		id uuid.UUID
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+		expectRes     *omoutdialtargetjob.OutdialTargetJob
+	}{
+		{
+			name: "normal",
+
+			id: uuid.FromStringOrNil("8e0f1a2b-2d52-11f1-8e45-8f9a0b1c2d01"),
+
+			response: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"8e0f1a2b-2d52-11f1-8e45-8f9a0b1c2d01","status":"done"}`),
+			},
+
+			expectTarget: "bin-manager.outdial-manager.request",
+			expectRequest: &sock.Request{
+				URI:      "/v1/outdialtargetjobs/8e0f1a2b-2d52-11f1-8e45-8f9a0b1c2d01",
+				Method:   sock.RequestMethodGet,
+				DataType: ContentTypeJSON,
+			},
+			expectRes: &omoutdialtargetjob.OutdialTargetJob{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("8e0f1a2b-2d52-11f1-8e45-8f9a0b1c2d01"),
+				},
+				Status: omoutdialtargetjob.StatusDone,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			reqHandler := requestHandler{
+				sock: mockSock,
+			}
+
+			ctx := context.Background()
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, err := reqHandler.OutdialV1OutdialtargetjobGet(ctx, tt.id)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_OutdialV1OutdialtargetjobList(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		pageToken string
+		pageSize  uint64
+		filters   map[omoutdialtargetjob.Field]any
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+		expectRes     []omoutdialtargetjob.OutdialTargetJob
+	}{
+		{
+			name: "normal",
+
+			pageToken: "2020-09-20T03:23:20.995000Z",
+			pageSize:  10,
+			filters: map[omoutdialtargetjob.Field]any{
+				omoutdialtargetjob.FieldOutdialID: uuid.FromStringOrNil("9f1a2b3c-2d52-11f1-9f56-9a0b1c2d3e01"),
+			},
+
+			response: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`[{"id":"9f474d50-2d52-11f1-a067-0b1c2d3e4f02"}]`),
+			},
+
+			expectTarget: "bin-manager.outdial-manager.request",
+			expectRequest: &sock.Request{
+				URI:      "/v1/outdialtargetjobs?page_token=2020-09-20T03%3A23%3A20.995000Z&page_size=10",
+				Method:   sock.RequestMethodGet,
+				DataType: ContentTypeJSON,
+				Data:     []byte(`{"outdial_id":"9f1a2b3c-2d52-11f1-9f56-9a0b1c2d3e01"}`),
+			},
+			expectRes: []omoutdialtargetjob.OutdialTargetJob{
+				{
+					Identity: identity.Identity{
+						ID: uuid.FromStringOrNil("9f474d50-2d52-11f1-a067-0b1c2d3e4f02"),
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			reqHandler := requestHandler{
+				sock: mockSock,
+			}
+
+			ctx := context.Background()
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, err := reqHandler.OutdialV1OutdialtargetjobList(ctx, tt.pageToken, tt.pageSize, tt.filters)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
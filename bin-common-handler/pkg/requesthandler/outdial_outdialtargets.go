@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"time"
 
 	omoutdialtarget "monorepo/bin-outdial-manager/models/outdialtarget"
 	omrequest "monorepo/bin-outdial-manager/pkg/listenhandler/models/request"
@@ -23,6 +24,7 @@ func (r *requestHandler) OutdialV1OutdialtargetCreate(
 	name string,
 	detail string,
 	data string,
+	timezone string,
 	destination0 *address.Address,
 	destination1 *address.Address,
 	destination2 *address.Address,
@@ -36,6 +38,7 @@ func (r *requestHandler) OutdialV1OutdialtargetCreate(
 		Name:         name,
 		Detail:       detail,
 		Data:         data,
+		Timezone:     timezone,
 		Destination0: destination0,
 		Destination1: destination1,
 		Destination2: destination2,
@@ -211,3 +214,33 @@ func (r *requestHandler) OutdialV1OutdialtargetUpdateStatus(ctx context.Context,
 
 	return &res, nil
 }
+
+// OutdialV1OutdialtargetUpdateCallback sends a request to outdial-manager
+// to schedule the outdial target's callback.
+// nil tmCallback cancels the scheduled callback.
+// it returns updated outdial target if it succeed.
+func (r *requestHandler) OutdialV1OutdialtargetUpdateCallback(ctx context.Context, outdialtargetID uuid.UUID, tmCallback *time.Time, agentID uuid.UUID) (*omoutdialtarget.OutdialTarget, error) {
+	uri := fmt.Sprintf("/v1/outdialtargets/%s/callback", outdialtargetID)
+
+	data := &omrequest.V1DataOutdialtargetsIDCallbackPut{
+		TMCallback: tmCallback,
+		AgentID:    agentID,
+	}
+
+	m, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	tmp, err := r.sendRequestOutdial(ctx, uri, sock.RequestMethodPut, "outdial/outdial_targets", requestTimeoutDefault, 0, ContentTypeJSON, m)
+	if err != nil {
+		return nil, err
+	}
+
+	var res omoutdialtarget.OutdialTarget
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return &res, nil
+}
@@ -6,6 +6,7 @@ import (
 	"net/url"
 	"reflect"
 	"testing"
+	"time"
 
 	omoutdialtarget "monorepo/bin-outdial-manager/models/outdialtarget"
 
@@ -26,6 +27,7 @@ func Test_OutdialV1OutdialtargetCreate(t *testing.T) {
 		outdialtargetName string
 		detail            string
 		data              string
+		timezone          string
 
 		destination0 *address.Address
 		destination1 *address.Address
@@ -45,6 +47,7 @@ func Test_OutdialV1OutdialtargetCreate(t *testing.T) {
 			outdialtargetName: "test name",
 			detail:            "test detail",
 			data:              "test data",
+			timezone:          "Asia/Seoul",
 
 			destination0: &address.Address{
 				Type:   address.TypeTel,
@@ -72,7 +75,7 @@ func Test_OutdialV1OutdialtargetCreate(t *testing.T) {
 				URI:      "/v1/outdials/05378520-b656-11ec-b5e4-bb71e495d2b6/targets",
 				Method:   sock.RequestMethodPost,
 				DataType: ContentTypeJSON,
-				Data:     []byte(`{"name":"test name","detail":"test detail","data":"test data","destination_0":{"type":"tel","target":"+821100000001"},"destination_1":{"type":"tel","target":"+821100000002"},"destination_2":{"type":"tel","target":"+821100000003"},"destination_3":{"type":"tel","target":"+821100000004"},"destination_4":{"type":"tel","target":"+821100000005"}}`),
+				Data:     []byte(`{"name":"test name","detail":"test detail","data":"test data","timezone":"Asia/Seoul","destination_0":{"type":"tel","target":"+821100000001"},"destination_1":{"type":"tel","target":"+821100000002"},"destination_2":{"type":"tel","target":"+821100000003"},"destination_3":{"type":"tel","target":"+821100000004"},"destination_4":{"type":"tel","target":"+821100000005"}}`),
 			},
 			response: &sock.Response{
 				StatusCode: 200,
@@ -131,7 +134,7 @@ func Test_OutdialV1OutdialtargetCreate(t *testing.T) {
 			ctx := context.Background()
 			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
 
-			_, err := reqHandler.OutdialV1OutdialtargetCreate(ctx, tt.outdialID, tt.outdialtargetName, tt.detail, tt.data, tt.destination0, tt.destination1, tt.destination2, tt.destination3, tt.destination4)
+			_, err := reqHandler.OutdialV1OutdialtargetCreate(ctx, tt.outdialID, tt.outdialtargetName, tt.detail, tt.data, tt.timezone, tt.destination0, tt.destination1, tt.destination2, tt.destination3, tt.destination4)
 			if err != nil {
 				t.Errorf("Wrong match. expect: ok, got: %v", err)
 			}
@@ -502,3 +505,84 @@ func Test_OutdialV1OutdialtargetUpdateStatus(t *testing.T) {
 		})
 	}
 }
+
+func Test_OutdialV1OutdialtargetUpdateCallback(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		outdialtargetID uuid.UUID
+		tmCallback      *time.Time
+		agentID         uuid.UUID
+
+		expectTarget  string
+		expectRequest *sock.Request
+
+		response *sock.Response
+	}{
+		{
+			"normal",
+
+			uuid.FromStringOrNil("c81f3a5e-4f9d-11f1-9e2b-4c5d6e7f8a90"),
+			func() *time.Time { t := time.Date(2026, 10, 22, 15, 0, 0, 0, time.UTC); return &t }(),
+			uuid.FromStringOrNil("c86d9b72-4f9d-11f1-a1f4-0b1c2d3e4f5a"),
+
+			"bin-manager.outdial-manager.request",
+			&sock.Request{
+				URI:      "/v1/outdialtargets/c81f3a5e-4f9d-11f1-9e2b-4c5d6e7f8a90/callback",
+				Method:   sock.RequestMethodPut,
+				DataType: ContentTypeJSON,
+				Data:     []byte(`{"tm_callback":"2026-10-22T15:00:00Z","agent_id":"c86d9b72-4f9d-11f1-a1f4-0b1c2d3e4f5a"}`),
+			},
+			&sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"c81f3a5e-4f9d-11f1-9e2b-4c5d6e7f8a90"}`),
+			},
+		},
+		{
+			"cancel",
+
+			uuid.FromStringOrNil("c8b6e41a-4f9d-11f1-8d63-5e6f7a8b9c0d"),
+			nil,
+			uuid.Nil,
+
+			"bin-manager.outdial-manager.request",
+			&sock.Request{
+				URI:      "/v1/outdialtargets/c8b6e41a-4f9d-11f1-8d63-5e6f7a8b9c0d/callback",
+				Method:   sock.RequestMethodPut,
+				DataType: ContentTypeJSON,
+				Data:     []byte(`{"tm_callback":null,"agent_id":"00000000-0000-0000-0000-000000000000"}`),
+			},
+			&sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"c8b6e41a-4f9d-11f1-8d63-5e6f7a8b9c0d"}`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			reqHandler := requestHandler{
+				sock: mockSock,
+			}
+
+			ctx := context.Background()
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, err := reqHandler.OutdialV1OutdialtargetUpdateCallback(ctx, tt.outdialtargetID, tt.tmCallback, tt.agentID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if res.ID != tt.outdialtargetID {
+				t.Errorf("Wrong match. expect: %v, got: %v", tt.outdialtargetID, res.ID)
+			}
+		})
+	}
+}
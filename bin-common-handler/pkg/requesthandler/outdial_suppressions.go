@@ -0,0 +1,203 @@
+package requesthandler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	omsuppression "monorepo/bin-outdial-manager/models/suppression"
+	omsuppressionblock "monorepo/bin-outdial-manager/models/suppressionblock"
+	omrequest "monorepo/bin-outdial-manager/pkg/listenhandler/models/request"
+	omresponse "monorepo/bin-outdial-manager/pkg/listenhandler/models/response"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+
+	"monorepo/bin-common-handler/models/sock"
+)
+
+// OutdialV1SuppressionCreate sends a request to outdial-manager
+// to add the target to the customer's suppression list.
+// it returns the existing suppression if the target is already in the list.
+func (r *requestHandler) OutdialV1SuppressionCreate(ctx context.Context, customerID uuid.UUID, listType omsuppression.ListType, target string, detail string) (*omsuppression.Suppression, error) {
+	uri := "/v1/suppressions"
+
+	m, err := json.Marshal(&omrequest.V1DataSuppressionsPost{
+		CustomerID: customerID,
+		ListType:   listType,
+		Target:     target,
+		Detail:     detail,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tmp, err := r.sendRequestOutdial(ctx, uri, sock.RequestMethodPost, "outdial/suppressions", requestTimeoutDefault, 0, ContentTypeJSON, m)
+	if err != nil {
+		return nil, err
+	}
+
+	var res omsuppression.Suppression
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return &res, nil
+}
+
+// OutdialV1SuppressionImport sends a request to outdial-manager
+// to add the targets to the customer's suppression list.
+// it returns the number of imported and skipped targets.
+func (r *requestHandler) OutdialV1SuppressionImport(ctx context.Context, customerID uuid.UUID, listType omsuppression.ListType, targets []string, detail string) (int, int, error) {
+	uri := "/v1/suppressions/import"
+
+	m, err := json.Marshal(&omrequest.V1DataSuppressionsImportPost{
+		CustomerID: customerID,
+		ListType:   listType,
+		Targets:    targets,
+		Detail:     detail,
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	tmp, err := r.sendRequestOutdial(ctx, uri, sock.RequestMethodPost, "outdial/suppressions/import", requestTimeoutDefault, 0, ContentTypeJSON, m)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var res omresponse.V1ResponseSuppressionsImport
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return 0, 0, errParse
+	}
+
+	return res.Imported, res.Skipped, nil
+}
+
+// OutdialV1SuppressionIsSuppressed sends a request to outdial-manager
+// to check the target is in the customer's suppression lists.
+// the outdial-manager records the blocked attempt with the given reference.
+func (r *requestHandler) OutdialV1SuppressionIsSuppressed(ctx context.Context, customerID uuid.UUID, target string, referenceType omsuppressionblock.ReferenceType, referenceID uuid.UUID) (bool, error) {
+	uri := "/v1/suppressions/is_suppressed"
+
+	m, err := json.Marshal(&omrequest.V1DataSuppressionsIsSuppressedPost{
+		CustomerID:    customerID,
+		Target:        target,
+		ReferenceType: referenceType,
+		ReferenceID:   referenceID,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	tmp, err := r.sendRequestOutdial(ctx, uri, sock.RequestMethodPost, "outdial/suppressions/is_suppressed", requestTimeoutDefault, 0, ContentTypeJSON, m)
+	if err != nil {
+		return false, err
+	}
+
+	var res omresponse.V1ResponseSuppressionsIsSuppressed
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return false, errParse
+	}
+
+	return res.Suppressed, nil
+}
+
+// OutdialV1SuppressionGet returns the suppression.
+func (r *requestHandler) OutdialV1SuppressionGet(ctx context.Context, suppressionID uuid.UUID) (*omsuppression.Suppression, error) {
+	uri := fmt.Sprintf("/v1/suppressions/%s", suppressionID)
+
+	tmp, err := r.sendRequestOutdial(ctx, uri, sock.RequestMethodGet, "outdial/suppressions", requestTimeoutDefault, 0, ContentTypeJSON, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res omsuppression.Suppression
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return &res, nil
+}
+
+// OutdialV1SuppressionList sends a request to outdial-manager
+// to get a list of suppressions.
+func (r *requestHandler) OutdialV1SuppressionList(ctx context.Context, pageToken string, pageSize uint64, filters map[omsuppression.Field]any) ([]omsuppression.Suppression, error) {
+	uri := fmt.Sprintf("/v1/suppressions?page_token=%s&page_size=%d", url.QueryEscape(pageToken), pageSize)
+
+	m, err := json.Marshal(filters)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not marshal filters")
+	}
+
+	tmp, err := r.sendRequestOutdial(ctx, uri, sock.RequestMethodGet, "outdial/suppressions", requestTimeoutDefault, 0, ContentTypeJSON, m)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []omsuppression.Suppression
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return res, nil
+}
+
+// OutdialV1SuppressionDelete sends a request to outdial-manager
+// to delete the suppression.
+func (r *requestHandler) OutdialV1SuppressionDelete(ctx context.Context, suppressionID uuid.UUID) (*omsuppression.Suppression, error) {
+	uri := fmt.Sprintf("/v1/suppressions/%s", suppressionID)
+
+	tmp, err := r.sendRequestOutdial(ctx, uri, sock.RequestMethodDelete, "outdial/suppressions", requestTimeoutDefault, 0, ContentTypeJSON, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res omsuppression.Suppression
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return &res, nil
+}
+
+// OutdialV1SuppressionblockGet returns the suppressionblock.
+func (r *requestHandler) OutdialV1SuppressionblockGet(ctx context.Context, suppressionblockID uuid.UUID) (*omsuppressionblock.SuppressionBlock, error) {
+	uri := fmt.Sprintf("/v1/suppressionblocks/%s", suppressionblockID)
+
+	tmp, err := r.sendRequestOutdial(ctx, uri, sock.RequestMethodGet, "outdial/suppressionblocks", requestTimeoutDefault, 0, ContentTypeJSON, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res omsuppressionblock.SuppressionBlock
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return &res, nil
+}
+
+// OutdialV1SuppressionblockList sends a request to outdial-manager
+// to get a list of suppressionblocks.
+func (r *requestHandler) OutdialV1SuppressionblockList(ctx context.Context, pageToken string, pageSize uint64, filters map[omsuppressionblock.Field]any) ([]omsuppressionblock.SuppressionBlock, error) {
+	uri := fmt.Sprintf("/v1/suppressionblocks?page_token=%s&page_size=%d", url.QueryEscape(pageToken), pageSize)
+
+	m, err := json.Marshal(filters)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not marshal filters")
+	}
+
+	tmp, err := r.sendRequestOutdial(ctx, uri, sock.RequestMethodGet, "outdial/suppressionblocks", requestTimeoutDefault, 0, ContentTypeJSON, m)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []omsuppressionblock.SuppressionBlock
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return res, nil
+}
@@ -0,0 +1,421 @@
+package requesthandler
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	omsuppression "monorepo/bin-outdial-manager/models/suppression"
+	omsuppressionblock "monorepo/bin-outdial-manager/models/suppressionblock"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+
+	"monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/models/sock"
+	"monorepo/bin-common-handler/pkg/sockhandler"
+)
+
+func Test_OutdialV1SuppressionCreate(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		customerID uuid.UUID
+		listType   omsuppression.ListType
+		target     string
+		detail     string
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+		expectRes     *omsuppression.Suppression
+	}{
+		{
+			name: "normal",
+
+			customerID: uuid.FromStringOrNil("d1e2f3a4-2b74-11f1-8b5c-2a3b4c5d6e01"),
+			listType:   omsuppression.ListTypeDNC,
+			target:     "+821100000001",
+			detail:     "test detail",
+
+			response: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"d21f2a3b-2b74-11f1-9c6d-3b4c5d6e7f02"}`),
+			},
+
+			expectTarget: "bin-manager.outdial-manager.request",
+			expectRequest: &sock.Request{
+				URI:      "/v1/suppressions",
+				Method:   sock.RequestMethodPost,
+				DataType: ContentTypeJSON,
+				Data:     []byte(`{"customer_id":"d1e2f3a4-2b74-11f1-8b5c-2a3b4c5d6e01","list_type":"dnc","target":"+821100000001","detail":"test detail"}`),
+			},
+			expectRes: &omsuppression.Suppression{
+				Identity: identity.Identity{
+					ID: uuid.FromStringOrNil("d21f2a3b-2b74-11f1-9c6d-3b4c5d6e7f02"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			reqHandler := requestHandler{
+				sock: mockSock,
+			}
+
+			ctx := context.Background()
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, err := reqHandler.OutdialV1SuppressionCreate(ctx, tt.customerID, tt.listType, tt.target, tt.detail)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_OutdialV1SuppressionImport(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		customerID uuid.UUID
+		listType   omsuppression.ListType
+		targets    []string
+
+		response *sock.Response
+
+		expectTarget   string
+		expectRequest  *sock.Request
+		expectImported int
+		expectSkipped  int
+	}{
+		{
+			name: "normal",
+
+			customerID: uuid.FromStringOrNil("f2a3b4c5-2b74-11f1-ad7e-4c5d6e7f8a01"),
+			listType:   omsuppression.ListTypeOptOut,
+			targets:    []string{"+821100000001", "+821100000002"},
+
+			response: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"imported":2,"skipped":0}`),
+			},
+
+			expectTarget: "bin-manager.outdial-manager.request",
+			expectRequest: &sock.Request{
+				URI:      "/v1/suppressions/import",
+				Method:   sock.RequestMethodPost,
+				DataType: ContentTypeJSON,
+				Data:     []byte(`{"customer_id":"f2a3b4c5-2b74-11f1-ad7e-4c5d6e7f8a01","list_type":"opt_out","targets":["+821100000001","+821100000002"],"detail":""}`),
+			},
+			expectImported: 2,
+			expectSkipped:  0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			reqHandler := requestHandler{
+				sock: mockSock,
+			}
+
+			ctx := context.Background()
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			imported, skipped, err := reqHandler.OutdialV1SuppressionImport(ctx, tt.customerID, tt.listType, tt.targets, "")
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if imported != tt.expectImported || skipped != tt.expectSkipped {
+				t.Errorf("Wrong match. expect: %d/%d, got: %d/%d", tt.expectImported, tt.expectSkipped, imported, skipped)
+			}
+		})
+	}
+}
+
+func Test_OutdialV1SuppressionIsSuppressed(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		customerID    uuid.UUID
+		target        string
+		referenceType omsuppressionblock.ReferenceType
+		referenceID   uuid.UUID
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+		expectRes     bool
+	}{
+		{
+			name: "suppressed",
+
+			customerID:    uuid.FromStringOrNil("13b4c5d6-2b75-11f1-be8f-5d6e7f8a9b01"),
+			target:        "+821100000001",
+			referenceType: omsuppressionblock.ReferenceTypeCall,
+			referenceID:   uuid.FromStringOrNil("13e1f2a3-2b75-11f1-8f9a-6e7f8a9b0c02"),
+
+			response: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"suppressed":true}`),
+			},
+
+			expectTarget: "bin-manager.outdial-manager.request",
+			expectRequest: &sock.Request{
+				URI:      "/v1/suppressions/is_suppressed",
+				Method:   sock.RequestMethodPost,
+				DataType: ContentTypeJSON,
+				Data:     []byte(`{"customer_id":"13b4c5d6-2b75-11f1-be8f-5d6e7f8a9b01","target":"+821100000001","reference_type":"call","reference_id":"13e1f2a3-2b75-11f1-8f9a-6e7f8a9b0c02"}`),
+			},
+			expectRes: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			reqHandler := requestHandler{
+				sock: mockSock,
+			}
+
+			ctx := context.Background()
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, err := reqHandler.OutdialV1SuppressionIsSuppressed(ctx, tt.customerID, tt.target, tt.referenceType, tt.referenceID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if res != tt.expectRes {
+				t.Errorf("Wrong match. expect: %v, got: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_OutdialV1SuppressionList(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		pageToken string
+		pageSize  uint64
+		filters   map[omsuppression.Field]any
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+		expectRes     []omsuppression.Suppression
+	}{
+		{
+			name: "normal",
+
+			pageToken: "2020-09-20 03:23:20.995000",
+			pageSize:  10,
+			filters: map[omsuppression.Field]any{
+				omsuppression.FieldDeleted: false,
+			},
+
+			response: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`[{"id":"34c5d6e7-2b75-11f1-a0ab-7f8a9b0c1d01"}]`),
+			},
+
+			expectTarget: "bin-manager.outdial-manager.request",
+			expectRequest: &sock.Request{
+				URI:      "/v1/suppressions?page_token=2020-09-20+03%3A23%3A20.995000&page_size=10",
+				Method:   sock.RequestMethodGet,
+				DataType: ContentTypeJSON,
+				Data:     []byte(`{"deleted":false}`),
+			},
+			expectRes: []omsuppression.Suppression{
+				{
+					Identity: identity.Identity{
+						ID: uuid.FromStringOrNil("34c5d6e7-2b75-11f1-a0ab-7f8a9b0c1d01"),
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			reqHandler := requestHandler{
+				sock: mockSock,
+			}
+
+			ctx := context.Background()
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, err := reqHandler.OutdialV1SuppressionList(ctx, tt.pageToken, tt.pageSize, tt.filters)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_OutdialV1SuppressionDelete(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		suppressionID uuid.UUID
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+	}{
+		{
+			name: "normal",
+
+			suppressionID: uuid.FromStringOrNil("55d6e7f8-2b75-11f1-b1bc-8a9b0c1d2e01"),
+
+			response: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"55d6e7f8-2b75-11f1-b1bc-8a9b0c1d2e01"}`),
+			},
+
+			expectTarget: "bin-manager.outdial-manager.request",
+			expectRequest: &sock.Request{
+				URI:      "/v1/suppressions/55d6e7f8-2b75-11f1-b1bc-8a9b0c1d2e01",
+				Method:   sock.RequestMethodDelete,
+				DataType: ContentTypeJSON,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			reqHandler := requestHandler{
+				sock: mockSock,
+			}
+
+			ctx := context.Background()
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, err := reqHandler.OutdialV1SuppressionDelete(ctx, tt.suppressionID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if res.ID != tt.suppressionID {
+				t.Errorf("Wrong match. expect: %s, got: %s", tt.suppressionID, res.ID)
+			}
+		})
+	}
+}
+
+func Test_OutdialV1SuppressionblockList(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		pageToken string
+		pageSize  uint64
+		filters   map[omsuppressionblock.Field]any
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+		expectRes     []omsuppressionblock.SuppressionBlock
+	}{
+		{
+			name: "normal",
+
+			pageToken: "2020-09-20 03:23:20.995000",
+			pageSize:  10,
+			filters: map[omsuppressionblock.Field]any{
+				omsuppressionblock.FieldDeleted: false,
+			},
+
+			response: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`[{"id":"76e7f8a9-2b75-11f1-82cd-9b0c1d2e3f01","reference_type":"campaign"}]`),
+			},
+
+			expectTarget: "bin-manager.outdial-manager.request",
+			expectRequest: &sock.Request{
+				URI:      "/v1/suppressionblocks?page_token=2020-09-20+03%3A23%3A20.995000&page_size=10",
+				Method:   sock.RequestMethodGet,
+				DataType: ContentTypeJSON,
+				Data:     []byte(`{"deleted":false}`),
+			},
+			expectRes: []omsuppressionblock.SuppressionBlock{
+				{
+					Identity: identity.Identity{
+						ID: uuid.FromStringOrNil("76e7f8a9-2b75-11f1-82cd-9b0c1d2e3f01"),
+					},
+					ReferenceType: omsuppressionblock.ReferenceTypeCampaign,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			reqHandler := requestHandler{
+				sock: mockSock,
+			}
+
+			ctx := context.Background()
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, err := reqHandler.OutdialV1SuppressionblockList(ctx, tt.pageToken, tt.pageSize, tt.filters)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,198 @@
+package requesthandler
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// envRequestPolicyFile is the environment variable of the request policy file path.
+const envRequestPolicyFile = "REQUEST_POLICY_FILE"
+
+// list of policy defaults
+const (
+	policyBackoffBaseDefault   = 100  // default backoff before the first retry(ms)
+	policyBackoffMaxDefault    = 2000 // default max backoff(ms)
+	policyRetryBudgetMaxTokens = 10.0 // max retries the budget allows in a burst
+)
+
+// Policy is the declarative timeout, retry and hedging policy of the requests.
+// The first policy matching the request's queue and uri is applied.
+// The request without matching policy is sent once with the call site's timeout.
+//
+// The retries and the hedged requests are sent for the GET requests only, because
+// the other methods are not idempotent. Only the send errors(i.e. timeout, broker failure)
+// are retried. The responses with error status code are returned as they are.
+type Policy struct {
+	Name      string `json:"name"`                 // policy name. used as the metric label
+	Queue     string `json:"queue,omitempty"`      // target queue pattern(i.e. "asterisk.*.request"). empty matches all queues
+	URIPrefix string `json:"uri_prefix,omitempty"` // request uri prefix. empty matches all uris
+
+	Timeout int `json:"timeout,omitempty"` // timeout of each attempt(ms). 0 uses the call site's timeout
+
+	MaxRetries  int `json:"max_retries,omitempty"`  // retries of the failed GET request
+	BackoffBase int `json:"backoff_base,omitempty"` // backoff before the first retry(ms). doubles on each retry
+	BackoffMax  int `json:"backoff_max,omitempty"`  // max backoff(ms)
+
+	HedgeDelay int `json:"hedge_delay,omitempty"` // sends a hedged GET request if no response within this time(ms). 0 disables the hedging
+
+	RetryBudget float64 `json:"retry_budget,omitempty"` // max ratio of the retries and hedged requests to the requests(i.e. 0.1). 0 means no limit
+
+	budget *retryBudget
+}
+
+// retryBudget limits the retries and hedged requests to the ratio of the requests.
+// Each request deposits the ratio and each retry withdraws one token.
+type retryBudget struct {
+	mu     sync.Mutex
+	ratio  float64
+	tokens float64
+}
+
+// policySet is the ordered list of the policies.
+type policySet struct {
+	policies []*Policy
+}
+
+// loadPoliciesFromEnv loads the policies from the file of the REQUEST_POLICY_FILE.
+// It returns nil if the file is not configured or invalid, so the requests are sent without policy.
+func loadPoliciesFromEnv() *policySet {
+	filename := os.Getenv(envRequestPolicyFile)
+	if filename == "" {
+		return nil
+	}
+
+	log := logrus.WithFields(logrus.Fields{
+		"func":     "loadPoliciesFromEnv",
+		"filename": filename,
+	})
+
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		log.Errorf("Could not read the request policy file. Sending the requests without policy. err: %v", err)
+		return nil
+	}
+
+	res, err := parsePolicies(data)
+	if err != nil {
+		log.Errorf("Could not parse the request policy file. Sending the requests without policy. err: %v", err)
+		return nil
+	}
+	log.Infof("Loaded the request policies. count: %d", len(res.policies))
+
+	return res
+}
+
+// parsePolicies parses the json array of the policies and validates them.
+func parsePolicies(data []byte) (*policySet, error) {
+	policies := []*Policy{}
+	if err := json.Unmarshal(data, &policies); err != nil {
+		return nil, fmt.Errorf("could not unmarshal the policies. err: %v", err)
+	}
+
+	names := map[string]bool{}
+	for i, p := range policies {
+		if p == nil || p.Name == "" {
+			return nil, fmt.Errorf("the policy has no name. index: %d", i)
+		}
+		if names[p.Name] {
+			return nil, fmt.Errorf("duplicated policy name. name: %s", p.Name)
+		}
+		names[p.Name] = true
+
+		if p.Timeout < 0 || p.MaxRetries < 0 || p.BackoffBase < 0 || p.BackoffMax < 0 || p.HedgeDelay < 0 || p.RetryBudget < 0 {
+			return nil, fmt.Errorf("the policy has a negative value. name: %s", p.Name)
+		}
+		if _, err := path.Match(p.Queue, ""); err != nil {
+			return nil, fmt.Errorf("invalid queue pattern. name: %s, queue: %s", p.Name, p.Queue)
+		}
+
+		if p.BackoffBase == 0 {
+			p.BackoffBase = policyBackoffBaseDefault
+		}
+		if p.BackoffMax == 0 {
+			p.BackoffMax = policyBackoffMaxDefault
+		}
+		if p.RetryBudget > 0 {
+			p.budget = &retryBudget{
+				ratio:  p.RetryBudget,
+				tokens: policyRetryBudgetMaxTokens,
+			}
+		}
+	}
+
+	return &policySet{policies: policies}, nil
+}
+
+// match returns the first policy matching the queue and uri.
+// It returns nil if nothing matches.
+func (s *policySet) match(queue string, uri string) *Policy {
+	if s == nil {
+		return nil
+	}
+
+	for _, p := range s.policies {
+		if p.Queue != "" {
+			if ok, _ := path.Match(p.Queue, queue); !ok {
+				continue
+			}
+		}
+		if !strings.HasPrefix(uri, p.URIPrefix) {
+			continue
+		}
+		return p
+	}
+
+	return nil
+}
+
+// backoff returns the backoff before the given retry. retry starts from 1.
+func (p *Policy) backoff(retry int) time.Duration {
+	res := p.BackoffBase
+	for i := 1; i < retry && res < p.BackoffMax; i++ {
+		res *= 2
+	}
+	if res > p.BackoffMax {
+		res = p.BackoffMax
+	}
+
+	return time.Millisecond * time.Duration(res)
+}
+
+// deposit records a request to the retry budget.
+func (p *Policy) deposit() {
+	if p.budget == nil {
+		return
+	}
+
+	p.budget.mu.Lock()
+	defer p.budget.mu.Unlock()
+
+	p.budget.tokens += p.budget.ratio
+	if p.budget.tokens > policyRetryBudgetMaxTokens {
+		p.budget.tokens = policyRetryBudgetMaxTokens
+	}
+}
+
+// withdraw returns true if the retry budget allows a retry or a hedged request.
+func (p *Policy) withdraw() bool {
+	if p.budget == nil {
+		return true
+	}
+
+	p.budget.mu.Lock()
+	defer p.budget.mu.Unlock()
+
+	if p.budget.tokens < 1 {
+		return false
+	}
+	p.budget.tokens--
+
+	return true
+}
@@ -0,0 +1,257 @@
+package requesthandler
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func Test_parsePolicies(t *testing.T) {
+	tests := []struct {
+		name string
+
+		data string
+
+		expectRes []Policy
+	}{
+		{
+			name: "defaults",
+
+			data: `[{"name":"call","queue":"bin-manager.call-manager.request","timeout":1000,"max_retries":2}]`,
+
+			expectRes: []Policy{
+				{
+					Name:        "call",
+					Queue:       "bin-manager.call-manager.request",
+					Timeout:     1000,
+					MaxRetries:  2,
+					BackoffBase: policyBackoffBaseDefault,
+					BackoffMax:  policyBackoffMaxDefault,
+				},
+			},
+		},
+		{
+			name: "all fields",
+
+			data: `[{"name":"asterisk","queue":"asterisk.*.request","uri_prefix":"/ari/channels","timeout":500,"max_retries":1,"backoff_base":50,"backoff_max":400,"hedge_delay":200,"retry_budget":0.2}]`,
+
+			expectRes: []Policy{
+				{
+					Name:        "asterisk",
+					Queue:       "asterisk.*.request",
+					URIPrefix:   "/ari/channels",
+					Timeout:     500,
+					MaxRetries:  1,
+					BackoffBase: 50,
+					BackoffMax:  400,
+					HedgeDelay:  200,
+					RetryBudget: 0.2,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := parsePolicies([]byte(tt.data))
+			if err != nil {
+				t.Fatalf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if len(res.policies) != len(tt.expectRes) {
+				t.Fatalf("Wrong match. expect: %d, got: %d", len(tt.expectRes), len(res.policies))
+			}
+			for i, p := range res.policies {
+				tmp := *p
+				tmp.budget = nil
+				if tmp != tt.expectRes[i] {
+					t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes[i], tmp)
+				}
+				if (p.RetryBudget > 0) != (p.budget != nil) {
+					t.Errorf("Wrong match. expect: budget for the retry_budget, got: %v", p.budget)
+				}
+			}
+		})
+	}
+}
+
+func Test_parsePolicies_error(t *testing.T) {
+	tests := []struct {
+		name string
+
+		data string
+	}{
+		{
+			name: "invalid json",
+
+			data: `{`,
+		},
+		{
+			name: "no name",
+
+			data: `[{"timeout":1000}]`,
+		},
+		{
+			name: "duplicated name",
+
+			data: `[{"name":"a"},{"name":"a"}]`,
+		},
+		{
+			name: "negative value",
+
+			data: `[{"name":"a","max_retries":-1}]`,
+		},
+		{
+			name: "invalid queue pattern",
+
+			data: `[{"name":"a","queue":"[call"}]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := parsePolicies([]byte(tt.data)); err == nil {
+				t.Errorf("Wrong match. expect: error, got: ok")
+			}
+		})
+	}
+}
+
+func Test_policySet_match(t *testing.T) {
+	policies, err := parsePolicies([]byte(`[
+		{"name":"call_get","queue":"bin-manager.call-manager.request","uri_prefix":"/v1/calls/"},
+		{"name":"call","queue":"bin-manager.call-manager.request"},
+		{"name":"asterisk","queue":"asterisk.*.request"}
+	]`))
+	if err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+
+	tests := []struct {
+		name string
+
+		queue string
+		uri   string
+
+		expectRes string
+	}{
+		{
+			name: "uri prefix matches first",
+
+			queue: "bin-manager.call-manager.request",
+			uri:   "/v1/calls/ab8e4ea4-7d5b-11ee-a2b6-4b9b0e4f1c1e",
+
+			expectRes: "call_get",
+		},
+		{
+			name: "queue only",
+
+			queue: "bin-manager.call-manager.request",
+			uri:   "/v1/groupcalls",
+
+			expectRes: "call",
+		},
+		{
+			name: "queue pattern",
+
+			queue: "asterisk.call.request",
+			uri:   "/ari/channels",
+
+			expectRes: "asterisk",
+		},
+		{
+			name: "no match",
+
+			queue: "bin-manager.flow-manager.request",
+			uri:   "/v1/flows",
+
+			expectRes: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := ""
+			if p := policies.match(tt.queue, tt.uri); p != nil {
+				res = p.Name
+			}
+			if res != tt.expectRes {
+				t.Errorf("Wrong match. expect: %s, got: %s", tt.expectRes, res)
+			}
+		})
+	}
+
+	// nil policy set matches nothing
+	var empty *policySet
+	if res := empty.match("bin-manager.call-manager.request", "/v1/calls"); res != nil {
+		t.Errorf("Wrong match. expect: nil, got: %v", res)
+	}
+}
+
+func Test_Policy_backoff(t *testing.T) {
+	p := &Policy{BackoffBase: 100, BackoffMax: 350}
+
+	expectRes := []time.Duration{
+		time.Millisecond * 100,
+		time.Millisecond * 200,
+		time.Millisecond * 350,
+		time.Millisecond * 350,
+	}
+	for i, expect := range expectRes {
+		if res := p.backoff(i + 1); res != expect {
+			t.Errorf("Wrong match. retry: %d, expect: %v, got: %v", i+1, expect, res)
+		}
+	}
+}
+
+func Test_Policy_retryBudget(t *testing.T) {
+	p := &Policy{
+		RetryBudget: 0.5,
+		budget: &retryBudget{
+			ratio:  0.5,
+			tokens: 1,
+		},
+	}
+
+	if !p.withdraw() {
+		t.Errorf("Wrong match. expect: true, got: false")
+	}
+	if p.withdraw() {
+		t.Errorf("Wrong match. expect: false, got: true")
+	}
+
+	p.deposit()
+	p.deposit()
+	if !p.withdraw() {
+		t.Errorf("Wrong match. expect: true, got: false")
+	}
+
+	// no budget means no limit
+	if !(&Policy{}).withdraw() {
+		t.Errorf("Wrong match. expect: true, got: false")
+	}
+}
+
+func Test_loadPoliciesFromEnv(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "policy.json")
+	if err := os.WriteFile(filename, []byte(`[{"name":"call","queue":"bin-manager.call-manager.request"}]`), 0600); err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+
+	t.Setenv(envRequestPolicyFile, filename)
+	res := loadPoliciesFromEnv()
+	if res == nil || len(res.policies) != 1 || res.policies[0].Name != "call" {
+		t.Errorf("Wrong match. expect: call policy, got: %v", res)
+	}
+
+	t.Setenv(envRequestPolicyFile, filepath.Join(t.TempDir(), "none.json"))
+	if res := loadPoliciesFromEnv(); res != nil {
+		t.Errorf("Wrong match. expect: nil, got: %v", res)
+	}
+
+	t.Setenv(envRequestPolicyFile, "")
+	if res := loadPoliciesFromEnv(); res != nil {
+		t.Errorf("Wrong match. expect: nil, got: %v", res)
+	}
+}
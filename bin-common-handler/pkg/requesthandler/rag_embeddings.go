@@ -0,0 +1,37 @@
+package requesthandler
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+
+	"monorepo/bin-common-handler/models/sock"
+	rmembedding "monorepo/bin-rag-manager/models/embedding"
+)
+
+// RagV1EmbeddingCreate sends a request to the rag-manager
+// to embed the given texts.
+// It returns the embeddings in the same order as the texts.
+func (r *requestHandler) RagV1EmbeddingCreate(ctx context.Context, texts []string) ([][]float32, error) {
+	uri := "/v1/embeddings"
+
+	m, err := json.Marshal(&rmembedding.Request{
+		Texts: texts,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not marshal request")
+	}
+
+	tmp, err := r.sendRequestRag(ctx, uri, sock.RequestMethodPost, "rag/embeddings", requestTimeoutDefault, 0, ContentTypeJSON, m)
+	if err != nil {
+		return nil, err
+	}
+
+	var res rmembedding.Response
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return res.Embeddings, nil
+}
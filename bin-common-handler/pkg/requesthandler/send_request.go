@@ -7,9 +7,11 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 
 	commonoutline "monorepo/bin-common-handler/models/outline"
 	"monorepo/bin-common-handler/models/sock"
+	"monorepo/bin-common-handler/pkg/tracehandler"
 )
 
 // SendRequest sends a request to the given destination.
@@ -34,14 +36,15 @@ func (r *requestHandler) sendRequest(ctx context.Context, queue commonoutline.Qu
 		Data:      data,
 	}
 
-	cctx, cancel := context.WithTimeout(ctx, time.Millisecond*time.Duration(timeout))
-	defer cancel()
+	ctx, span := tracehandler.StartClientSpan(ctx, string(queue), req)
+	defer span.End()
 
 	switch {
 	case delay > 0:
 		// send scheduled message.
 		// we don't expect the response message here.
 		if err := r.sendDelayedRequest(string(queue), resource, delay, req); err != nil {
+			tracehandler.RecordError(span, err)
 			return nil, errors.Wrapf(err, "could not send the delayed request. queue: %s, method: %s, uri: %s", queue, method, uri)
 		}
 		return nil, nil
@@ -50,25 +53,136 @@ func (r *requestHandler) sendRequest(ctx context.Context, queue commonoutline.Qu
 		// check circuit breaker before sending
 		if r.cb != nil {
 			if err := r.cb.Allow(string(queue)); err != nil {
+				tracehandler.RecordError(span, err)
 				return nil, errors.Wrapf(err, "could not send the request. queue: %s, method: %s, uri: %s", queue, method, uri)
 			}
 		}
 
-		res, err := r.sendDirectRequest(cctx, string(queue), resource, req)
+		policy := r.policies.match(string(queue), uri)
+		if policy != nil {
+			span.SetAttributes(attribute.String("rpc.policy", policy.Name))
+		}
+
+		res, err := r.sendRequestWithPolicy(ctx, policy, string(queue), resource, timeout, req)
 		if err != nil {
 			if r.cb != nil {
 				r.cb.RecordFailure(string(queue))
 			}
+			tracehandler.RecordError(span, err)
 			return nil, errors.Wrapf(err, "could not send the request. queue: %s, method: %s, uri: %s", queue, method, uri)
 		}
 
 		if r.cb != nil {
 			r.cb.RecordSuccess(string(queue))
 		}
+		if res != nil {
+			span.SetAttributes(attribute.Int("rpc.status_code", res.StatusCode))
+		}
 		return res, nil
 	}
 }
 
+// sendRequestWithPolicy sends the request to the target with the policy's timeout, retries and hedging.
+// Without policy, the request is sent once with the given timeout.
+// timeout: timeout(ms)
+func (r *requestHandler) sendRequestWithPolicy(ctx context.Context, policy *Policy, target string, resource string, timeout int, req *sock.Request) (*sock.Response, error) {
+	if policy == nil {
+		cctx, cancel := context.WithTimeout(ctx, time.Millisecond*time.Duration(timeout))
+		defer cancel()
+
+		return r.sendDirectRequest(cctx, target, resource, req)
+	}
+
+	if policy.Timeout > 0 {
+		timeout = policy.Timeout
+	}
+	idempotent := req.Method == sock.RequestMethodGet
+
+	policy.deposit()
+	promPolicyAttemptTotal.WithLabelValues(policy.Name, policyAttemptFirst).Inc()
+
+	for retry := 1; ; retry++ {
+		res, err := r.sendRequestAttempt(ctx, policy, target, resource, timeout, idempotent, req)
+		if err == nil {
+			promPolicyResultTotal.WithLabelValues(policy.Name, policyResultSuccess).Inc()
+			return res, nil
+		}
+
+		if !idempotent || retry > policy.MaxRetries || ctx.Err() != nil {
+			promPolicyResultTotal.WithLabelValues(policy.Name, policyResultFailure).Inc()
+			return nil, err
+		}
+		if !policy.withdraw() {
+			promPolicyBudgetExhaustedTotal.WithLabelValues(policy.Name).Inc()
+			promPolicyResultTotal.WithLabelValues(policy.Name, policyResultFailure).Inc()
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			promPolicyResultTotal.WithLabelValues(policy.Name, policyResultFailure).Inc()
+			return nil, err
+		case <-time.After(policy.backoff(retry)):
+		}
+		promPolicyAttemptTotal.WithLabelValues(policy.Name, policyAttemptRetry).Inc()
+	}
+}
+
+// sendRequestAttempt sends the request once. For the idempotent request with the hedge delay,
+// it sends one more request if no response arrives within the delay and returns the first successful response.
+// timeout: timeout(ms)
+func (r *requestHandler) sendRequestAttempt(ctx context.Context, policy *Policy, target string, resource string, timeout int, idempotent bool, req *sock.Request) (*sock.Response, error) {
+	if !idempotent || policy.HedgeDelay <= 0 {
+		cctx, cancel := context.WithTimeout(ctx, time.Millisecond*time.Duration(timeout))
+		defer cancel()
+
+		return r.sendDirectRequest(cctx, target, resource, req)
+	}
+
+	type result struct {
+		res *sock.Response
+		err error
+	}
+
+	// the request left behind is canceled when the first one returns.
+	actx, cancelAll := context.WithCancel(ctx)
+	defer cancelAll()
+
+	chResult := make(chan result, 2)
+	send := func() {
+		cctx, cancel := context.WithTimeout(actx, time.Millisecond*time.Duration(timeout))
+		defer cancel()
+
+		res, err := r.sendDirectRequest(cctx, target, resource, req)
+		chResult <- result{res: res, err: err}
+	}
+
+	go send()
+	inflight := 1
+
+	hedge := time.NewTimer(time.Millisecond * time.Duration(policy.HedgeDelay))
+	defer hedge.Stop()
+
+	for {
+		select {
+		case <-hedge.C:
+			if !policy.withdraw() {
+				promPolicyBudgetExhaustedTotal.WithLabelValues(policy.Name).Inc()
+				continue
+			}
+			promPolicyAttemptTotal.WithLabelValues(policy.Name, policyAttemptHedge).Inc()
+			go send()
+			inflight++
+
+		case tmp := <-chResult:
+			inflight--
+			if tmp.err == nil || inflight == 0 {
+				return tmp.res, tmp.err
+			}
+		}
+	}
+}
+
 // sendDirectRequest sends the request to the target without delay
 func (r *requestHandler) sendDirectRequest(ctx context.Context, target string, resource string, req *sock.Request) (*sock.Response, error) {
 
@@ -158,3 +158,156 @@ func Test_sendRequest_NilCircuitBreakerOnFailure(t *testing.T) {
 		t.Fatal("expected error, got nil")
 	}
 }
+
+func Test_sendRequest_PolicyRetriesGet(t *testing.T) {
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockSock := sockhandler.NewMockSockHandler(mc)
+	mockCB := circuitbreakerhandler.NewMockCircuitBreakerHandler(mc)
+
+	policies, err := parsePolicies([]byte(`[{"name":"test","queue":"test.*","max_retries":2,"backoff_base":1}]`))
+	if err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+
+	h := requestHandler{
+		sock:     mockSock,
+		cb:       mockCB,
+		policies: policies,
+	}
+
+	queue := commonoutline.QueueName("test.queue")
+	resp := &sock.Response{StatusCode: 200}
+
+	// the circuit breaker sees one request regardless of the retries
+	mockCB.EXPECT().Allow(string(queue)).Return(nil)
+	mockSock.EXPECT().RequestPublish(gomock.Any(), string(queue), gomock.Any()).Return(nil, fmt.Errorf("timeout"))
+	mockSock.EXPECT().RequestPublish(gomock.Any(), string(queue), gomock.Any()).Return(resp, nil)
+	mockCB.EXPECT().RecordSuccess(string(queue))
+
+	res, err := h.sendRequest(context.Background(), queue, "/test", sock.RequestMethodGet, "", 3000, 0, "", nil)
+	if err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+	if res != resp {
+		t.Errorf("Wrong match. expect: %v, got: %v", resp, res)
+	}
+}
+
+func Test_sendRequest_PolicyRetriesExhausted(t *testing.T) {
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockSock := sockhandler.NewMockSockHandler(mc)
+	mockCB := circuitbreakerhandler.NewMockCircuitBreakerHandler(mc)
+
+	policies, err := parsePolicies([]byte(`[{"name":"test","max_retries":2,"backoff_base":1}]`))
+	if err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+
+	h := requestHandler{
+		sock:     mockSock,
+		cb:       mockCB,
+		policies: policies,
+	}
+
+	queue := commonoutline.QueueName("test.queue")
+
+	mockCB.EXPECT().Allow(string(queue)).Return(nil)
+	mockSock.EXPECT().RequestPublish(gomock.Any(), string(queue), gomock.Any()).Return(nil, fmt.Errorf("timeout")).Times(3)
+	mockCB.EXPECT().RecordFailure(string(queue))
+
+	if _, err := h.sendRequest(context.Background(), queue, "/test", sock.RequestMethodGet, "", 3000, 0, "", nil); err == nil {
+		t.Errorf("Wrong match. expect: error, got: ok")
+	}
+}
+
+func Test_sendRequest_PolicyDoesNotRetryPost(t *testing.T) {
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockSock := sockhandler.NewMockSockHandler(mc)
+
+	policies, err := parsePolicies([]byte(`[{"name":"test","max_retries":2,"backoff_base":1,"hedge_delay":1}]`))
+	if err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+
+	h := requestHandler{
+		sock:     mockSock,
+		policies: policies,
+	}
+
+	queue := commonoutline.QueueName("test.queue")
+
+	mockSock.EXPECT().RequestPublish(gomock.Any(), string(queue), gomock.Any()).Return(nil, fmt.Errorf("timeout")).Times(1)
+
+	if _, err := h.sendRequest(context.Background(), queue, "/test", sock.RequestMethodPost, "", 3000, 0, "", json.RawMessage(`{}`)); err == nil {
+		t.Errorf("Wrong match. expect: error, got: ok")
+	}
+}
+
+func Test_sendRequest_PolicyRetryBudget(t *testing.T) {
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockSock := sockhandler.NewMockSockHandler(mc)
+
+	policies, err := parsePolicies([]byte(`[{"name":"test","max_retries":1,"backoff_base":1,"retry_budget":0.1}]`))
+	if err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+	// empty the budget
+	policies.policies[0].budget.tokens = 0
+
+	h := requestHandler{
+		sock:     mockSock,
+		policies: policies,
+	}
+
+	queue := commonoutline.QueueName("test.queue")
+
+	// the request deposits 0.1 token only, so no retry is sent
+	mockSock.EXPECT().RequestPublish(gomock.Any(), string(queue), gomock.Any()).Return(nil, fmt.Errorf("timeout")).Times(1)
+
+	if _, err := h.sendRequest(context.Background(), queue, "/test", sock.RequestMethodGet, "", 3000, 0, "", nil); err == nil {
+		t.Errorf("Wrong match. expect: error, got: ok")
+	}
+}
+
+func Test_sendRequest_PolicyHedge(t *testing.T) {
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockSock := sockhandler.NewMockSockHandler(mc)
+
+	policies, err := parsePolicies([]byte(`[{"name":"test","uri_prefix":"/v1/calls","hedge_delay":10}]`))
+	if err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+
+	h := requestHandler{
+		sock:     mockSock,
+		policies: policies,
+	}
+
+	queue := commonoutline.QueueName("test.queue")
+	resp := &sock.Response{StatusCode: 200}
+
+	// the first request hangs until canceled. the hedged one responds.
+	mockSock.EXPECT().RequestPublish(gomock.Any(), string(queue), gomock.Any()).DoAndReturn(func(ctx context.Context, _ string, _ *sock.Request) (*sock.Response, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+	mockSock.EXPECT().RequestPublish(gomock.Any(), string(queue), gomock.Any()).Return(resp, nil)
+
+	res, err := h.sendRequest(context.Background(), queue, "/v1/calls/ab8e4ea4-7d5b-11ee-a2b6-4b9b0e4f1c1e", sock.RequestMethodGet, "", 3000, 0, "", nil)
+	if err != nil {
+		t.Fatalf("Wrong match. expect: ok, got: %v", err)
+	}
+	if res != resp {
+		t.Errorf("Wrong match. expect: %v, got: %v", resp, res)
+	}
+}
@@ -194,3 +194,23 @@ func (r *requestHandler) StorageV1FileDownloadURIRefresh(ctx context.Context, fi
 
 	return res, nil
 }
+
+// StorageV1FileUploadURICreate sends a request to storage-manager
+// to create a signed upload URI of a new file's content.
+// The uploaded content is registered as a file with the StorageV1FileCreate using the returned bucket name and filepath.
+// it returns the upload URI if it succeeds.
+func (r *requestHandler) StorageV1FileUploadURICreate(ctx context.Context) (*smfile.UploadURI, error) {
+	uri := "/v1/files/upload_uri"
+
+	tmp, err := r.sendRequestStorage(ctx, uri, sock.RequestMethodPost, "storage/files/upload_uri", requestTimeoutDefault, 0, ContentTypeNone, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res smfile.UploadURI
+	if errParse := parseResponse(tmp, &res); errParse != nil {
+		return nil, errParse
+	}
+
+	return &res, nil
+}
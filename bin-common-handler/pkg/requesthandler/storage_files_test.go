@@ -378,3 +378,62 @@ func Test_StorageV1FileDelete(t *testing.T) {
 		})
 	}
 }
+
+func Test_StorageV1FileUploadURICreate(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		response *sock.Response
+
+		expectTarget  string
+		expectRequest *sock.Request
+		expectResult  *smfile.UploadURI
+	}{
+		{
+			name: "normal",
+
+			response: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"bucket_name":"test-bucket-tmp","filepath":"tmp/5f0c2a8e-ad70-11f0-9b3e-3f5a7c1d2e01","uri":"https://test.com/upload"}`),
+			},
+
+			expectTarget: "bin-manager.storage-manager.request",
+			expectRequest: &sock.Request{
+				URI:      "/v1/files/upload_uri",
+				Method:   sock.RequestMethodPost,
+				DataType: ContentTypeNone,
+			},
+			expectResult: &smfile.UploadURI{
+				BucketName: "test-bucket-tmp",
+				Filepath:   "tmp/5f0c2a8e-ad70-11f0-9b3e-3f5a7c1d2e01",
+				URI:        "https://test.com/upload",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			reqHandler := requestHandler{
+				sock: mockSock,
+			}
+
+			ctx := context.Background()
+			mockSock.EXPECT().RequestPublish(gomock.Any(), tt.expectTarget, tt.expectRequest).Return(tt.response, nil)
+
+			res, err := reqHandler.StorageV1FileUploadURICreate(ctx)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(tt.expectResult, res) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v\n", tt.expectResult, res)
+			}
+		})
+	}
+}
@@ -5,9 +5,12 @@ package sockhandler
 import (
 	"context"
 	"monorepo/bin-common-handler/models/sock"
+	"monorepo/bin-common-handler/pkg/memoryhandler"
+	"monorepo/bin-common-handler/pkg/natshandler"
 	"monorepo/bin-common-handler/pkg/rabbitmqhandler"
 
 	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/sirupsen/logrus"
 )
 
 type SockHandler interface {
@@ -32,6 +35,11 @@ type SockHandler interface {
 	QueueUnbind(name, key, exchange string, args amqp.Table) error            // NEW, Task 1.3
 }
 
+// NewSockHandler returns the SockHandler of the given type.
+// For the sock.TypeMemory, the serverURI is the name of the in-process broker.
+// The handlers created with the same name share the broker.
+// For the sock.TypeNATS, the serverURI is the nats server url. i.e. nats://127.0.0.1:4222
+// It returns nil for the unsupported type.
 func NewSockHandler(sockType sock.Type, serverURI string) SockHandler {
 
 	switch sockType {
@@ -39,7 +47,14 @@ func NewSockHandler(sockType sock.Type, serverURI string) SockHandler {
 	case sock.TypeRabbitMQ:
 		return rabbitmqhandler.NewRabbit(serverURI)
 
+	case sock.TypeMemory:
+		return memoryhandler.NewMemory(serverURI)
+
+	case sock.TypeNATS:
+		return natshandler.NewNats(serverURI)
+
 	default:
+		logrus.Errorf("Unsupported sock type. sock_type: %s", sockType)
 		return nil
 	}
 }
@@ -0,0 +1,101 @@
+package tracehandler
+
+import (
+	"context"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+
+	commonoutline "monorepo/bin-common-handler/models/outline"
+)
+
+// tracerName is the instrumentation name of the spans created by this package.
+const tracerName = "monorepo/bin-common-handler"
+
+// defaultShutdownTimeout is the time to flush the buffered spans on the shutdown.
+const defaultShutdownTimeout = time.Second * 5
+
+// List of environment variables of the OTLP exporter.
+// The tracing is enabled only if one of them is set.
+// The other standard OTEL_* variables (i.e. OTEL_TRACES_SAMPLER, OTEL_EXPORTER_OTLP_HEADERS)
+// are honored by the OpenTelemetry SDK.
+const (
+	envOTLPEndpoint       = "OTEL_EXPORTER_OTLP_ENDPOINT"
+	envOTLPTracesEndpoint = "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"
+)
+
+var (
+	initOnce sync.Once
+	provider *sdktrace.TracerProvider
+
+	// enabled is true when the tracing is configured.
+	// While it's false, the Start* functions return the given context as is,
+	// so the untraced processes see the exact same context as before.
+	enabled atomic.Bool
+)
+
+// Init configures the global tracer provider of the process.
+// It exports the spans via OTLP/HTTP when the OTLP endpoint is configured in the environment.
+// Otherwise, the global no-op tracer provider stays, so the spans cost nothing.
+// Only the first call takes effect.
+func Init(serviceName commonoutline.ServiceName) {
+	initOnce.Do(func() {
+		log := logrus.WithFields(logrus.Fields{
+			"func":         "Init",
+			"service_name": serviceName,
+		})
+
+		if os.Getenv(envOTLPEndpoint) == "" && os.Getenv(envOTLPTracesEndpoint) == "" {
+			log.Debugf("The OTLP endpoint is not configured. Tracing is disabled.")
+			return
+		}
+
+		exporter, err := otlptracehttp.New(context.Background())
+		if err != nil {
+			log.Errorf("Could not create the OTLP exporter. Tracing is disabled. err: %v", err)
+			return
+		}
+
+		res := resource.NewSchemaless(attribute.String("service.name", string(serviceName)))
+		provider = sdktrace.NewTracerProvider(
+			sdktrace.WithBatcher(exporter),
+			sdktrace.WithResource(res),
+		)
+
+		otel.SetTracerProvider(provider)
+		otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+		enabled.Store(true)
+		log.Debugf("Tracing is enabled.")
+	})
+}
+
+// Shutdown flushes the buffered spans and stops the exporter.
+// The service defers it right after the Init, so the spans of the last requests are not dropped on exit.
+// It does nothing if the tracing is disabled.
+func Shutdown() {
+	if provider == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), defaultShutdownTimeout)
+	defer cancel()
+
+	if err := provider.Shutdown(ctx); err != nil {
+		logrus.Errorf("Could not shutdown the tracer provider. err: %v", err)
+	}
+}
+
+// tracer returns the tracer of the global tracer provider.
+func tracer() trace.Tracer {
+	return otel.Tracer(tracerName)
+}
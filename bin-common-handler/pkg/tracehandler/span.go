@@ -0,0 +1,173 @@
+package tracehandler
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	"monorepo/bin-common-handler/models/sock"
+)
+
+// regUUID matches the uuids in the request uri.
+var regUUID = regexp.MustCompile(`[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}`)
+
+// StartClientSpan starts the client span of the RPC request sent to the given queue
+// and injects its trace context into the request.
+// The caller must end the returned span.
+func StartClientSpan(ctx context.Context, queue string, req *sock.Request) (context.Context, trace.Span) {
+	if !enabled.Load() {
+		return ctx, trace.SpanFromContext(ctx)
+	}
+
+	ctx, span := tracer().Start(ctx, spanNameRequest(req),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("messaging.system", "rabbitmq"),
+			attribute.String("messaging.destination.name", queue),
+			attribute.String("rpc.method", string(req.Method)),
+			attribute.String("rpc.uri", req.URI),
+		),
+	)
+	req.TraceContext = inject(ctx)
+
+	return ctx, span
+}
+
+// StartServerSpan starts the server span of the received RPC request.
+// The span continues the trace context carried by the request, if any.
+// The caller must end the returned span.
+func StartServerSpan(ctx context.Context, req *sock.Request) (context.Context, trace.Span) {
+	if !enabled.Load() {
+		return ctx, trace.SpanFromContext(ctx)
+	}
+
+	ctx = extract(ctx, req.TraceContext)
+
+	return tracer().Start(ctx, spanNameRequest(req),
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			attribute.String("messaging.system", "rabbitmq"),
+			attribute.String("rpc.method", string(req.Method)),
+			attribute.String("rpc.uri", req.URI),
+			attribute.String("rpc.publisher", req.Publisher),
+		),
+	)
+}
+
+// StartProducerSpan starts the producer span of the event published to the given exchange
+// and injects its trace context into the event.
+// The caller must end the returned span.
+func StartProducerSpan(ctx context.Context, exchange string, evt *sock.Event) (context.Context, trace.Span) {
+	if !enabled.Load() {
+		return ctx, trace.SpanFromContext(ctx)
+	}
+
+	ctx, span := tracer().Start(ctx, spanNameEvent(evt, "publish"),
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			attribute.String("messaging.system", "rabbitmq"),
+			attribute.String("messaging.destination.name", exchange),
+			attribute.String("messaging.event_type", evt.Type),
+		),
+	)
+	evt.TraceContext = inject(ctx)
+
+	return ctx, span
+}
+
+// StartConsumerSpan starts the consumer span of the received event.
+// The span continues the trace context carried by the event, if any.
+// The caller must end the returned span.
+func StartConsumerSpan(ctx context.Context, evt *sock.Event) (context.Context, trace.Span) {
+	if !enabled.Load() {
+		return ctx, trace.SpanFromContext(ctx)
+	}
+
+	ctx = extract(ctx, evt.TraceContext)
+
+	return tracer().Start(ctx, spanNameEvent(evt, "process"),
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			attribute.String("messaging.system", "rabbitmq"),
+			attribute.String("messaging.event_type", evt.Type),
+			attribute.String("messaging.publisher", evt.Publisher),
+		),
+	)
+}
+
+// TraceContext returns the trace context of the given context as a carrier.
+// It is for the messages which are stored before they are sent, i.e. the outbox events,
+// so the sender can continue the trace later with ContextWithTraceContext.
+func TraceContext(ctx context.Context) map[string]string {
+	if !enabled.Load() {
+		return nil
+	}
+
+	return inject(ctx)
+}
+
+// ContextWithTraceContext returns the context continuing the trace context of the given carrier.
+func ContextWithTraceContext(ctx context.Context, carrier map[string]string) context.Context {
+	if !enabled.Load() {
+		return ctx
+	}
+
+	return extract(ctx, carrier)
+}
+
+// inject returns the trace context of the given context as a carrier.
+// It returns nil if there is nothing to propagate, so the messages of the
+// untraced processes don't carry the empty field.
+func inject(ctx context.Context) map[string]string {
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+	if len(carrier) == 0 {
+		return nil
+	}
+
+	return carrier
+}
+
+// extract returns the context continuing the trace context of the given carrier.
+func extract(ctx context.Context, carrier map[string]string) context.Context {
+	if len(carrier) == 0 {
+		return ctx
+	}
+
+	return otel.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier(carrier))
+}
+
+// spanNameRequest returns the span name of the request.
+// The query string is dropped and the uuids are masked to keep the span names low-cardinality.
+// i.e. "GET /v1/calls/{id}"
+func spanNameRequest(req *sock.Request) string {
+	uri := req.URI
+	if idx := strings.Index(uri, "?"); idx >= 0 {
+		uri = uri[:idx]
+	}
+	uri = regUUID.ReplaceAllString(uri, "{id}")
+
+	return string(req.Method) + " " + uri
+}
+
+// spanNameEvent returns the span name of the event.
+// i.e. "call-manager call_hangup process"
+func spanNameEvent(evt *sock.Event, operation string) string {
+	return evt.Publisher + " " + evt.Type + " " + operation
+}
+
+// RecordError records the error on the span and marks the span as failed.
+func RecordError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
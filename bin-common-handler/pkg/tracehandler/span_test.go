@@ -0,0 +1,155 @@
+package tracehandler
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"monorepo/bin-common-handler/models/sock"
+)
+
+// setupTestProvider installs a recording tracer provider and the w3c propagator.
+func setupTestProvider(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	prevTP := otel.GetTracerProvider()
+	prevProp := otel.GetTextMapPropagator()
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	enabled.Store(true)
+	t.Cleanup(func() {
+		otel.SetTracerProvider(prevTP)
+		otel.SetTextMapPropagator(prevProp)
+		enabled.Store(false)
+	})
+
+	return recorder
+}
+
+func Test_Request_propagation(t *testing.T) {
+	recorder := setupTestProvider(t)
+
+	req := &sock.Request{
+		URI:       "/v1/calls/0f3f1a46-7d57-11ee-9c6d-2b2f4e0a7f7b?page_size=10",
+		Method:    sock.RequestMethodGet,
+		Publisher: "api-manager",
+	}
+
+	clientCtx, clientSpan := StartClientSpan(context.Background(), "bin-manager.call-manager.request", req)
+	if req.TraceContext["traceparent"] == "" {
+		t.Fatalf("Wrong match. expect: traceparent, got: %v", req.TraceContext)
+	}
+
+	_, serverSpan := StartServerSpan(context.Background(), req)
+	serverSpan.End()
+	clientSpan.End()
+
+	clientSC := trace.SpanContextFromContext(clientCtx)
+	if serverSpan.SpanContext().TraceID() != clientSC.TraceID() {
+		t.Errorf("Wrong match. expect: %v, got: %v", clientSC.TraceID(), serverSpan.SpanContext().TraceID())
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("Wrong match. expect: 2, got: %d", len(spans))
+	}
+	if spans[0].Parent().SpanID() != clientSC.SpanID() {
+		t.Errorf("Wrong match. expect: %v, got: %v", clientSC.SpanID(), spans[0].Parent().SpanID())
+	}
+	if spans[0].Name() != "GET /v1/calls/{id}" {
+		t.Errorf("Wrong match. expect: GET /v1/calls/{id}, got: %s", spans[0].Name())
+	}
+	if spans[0].SpanKind() != trace.SpanKindServer {
+		t.Errorf("Wrong match. expect: %v, got: %v", trace.SpanKindServer, spans[0].SpanKind())
+	}
+}
+
+func Test_Event_propagation(t *testing.T) {
+	recorder := setupTestProvider(t)
+
+	evt := &sock.Event{
+		Type:      "call_hangup",
+		Publisher: "call-manager",
+	}
+
+	producerCtx, producerSpan := StartProducerSpan(context.Background(), "bin-manager.call-manager.event", evt)
+	producerSpan.End()
+
+	_, consumerSpan := StartConsumerSpan(context.Background(), evt)
+	consumerSpan.End()
+
+	producerSC := trace.SpanContextFromContext(producerCtx)
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("Wrong match. expect: 2, got: %d", len(spans))
+	}
+	if spans[1].Parent().SpanID() != producerSC.SpanID() {
+		t.Errorf("Wrong match. expect: %v, got: %v", producerSC.SpanID(), spans[1].Parent().SpanID())
+	}
+	if spans[1].Name() != "call-manager call_hangup process" {
+		t.Errorf("Wrong match. expect: call-manager call_hangup process, got: %s", spans[1].Name())
+	}
+}
+
+func Test_TraceContext(t *testing.T) {
+	recorder := setupTestProvider(t)
+
+	// the producer stores the trace context with the message, i.e. an outbox event
+	parentCtx, parentSpan := tracer().Start(context.Background(), "call hangup")
+	carrier := TraceContext(parentCtx)
+	parentSpan.End()
+	if carrier["traceparent"] == "" {
+		t.Fatalf("Wrong match. expect: traceparent, got: %v", carrier)
+	}
+
+	// the sender continues the trace later
+	evt := &sock.Event{
+		Type:      "call_hungup",
+		Publisher: "call-manager",
+	}
+	_, producerSpan := StartProducerSpan(ContextWithTraceContext(context.Background(), carrier), "bin-manager.call-manager.event", evt)
+	producerSpan.End()
+
+	parentSC := parentSpan.SpanContext()
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("Wrong match. expect: 2, got: %d", len(spans))
+	}
+	if spans[1].SpanContext().TraceID() != parentSC.TraceID() {
+		t.Errorf("Wrong match. expect: %v, got: %v", parentSC.TraceID(), spans[1].SpanContext().TraceID())
+	}
+	if spans[1].Parent().SpanID() != parentSC.SpanID() {
+		t.Errorf("Wrong match. expect: %v, got: %v", parentSC.SpanID(), spans[1].Parent().SpanID())
+	}
+}
+
+func Test_disabled(t *testing.T) {
+	// tracing is not configured
+	req := &sock.Request{
+		URI:    "/v1/calls",
+		Method: sock.RequestMethodPost,
+	}
+
+	ctx := context.Background()
+	resCtx, span := StartClientSpan(ctx, "bin-manager.call-manager.request", req)
+	span.End()
+
+	if resCtx != ctx {
+		t.Errorf("Wrong match. expect: %v, got: %v", ctx, resCtx)
+	}
+	if req.TraceContext != nil {
+		t.Errorf("Wrong match. expect: nil, got: %v", req.TraceContext)
+	}
+
+	resCtx, span = StartServerSpan(ctx, req)
+	span.End()
+	if resCtx != ctx {
+		t.Errorf("Wrong match. expect: %v, got: %v", ctx, resCtx)
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"monorepo/bin-common-handler/pkg/notifyhandler"
 	"monorepo/bin-common-handler/pkg/requesthandler"
 	"monorepo/bin-common-handler/pkg/sockhandler"
+	"monorepo/bin-common-handler/pkg/tracehandler"
 
 	_ "github.com/go-sql-driver/mysql"
 	joonix "github.com/joonix/log"
@@ -74,6 +75,10 @@ func runMain(cmd *cobra.Command, args []string) {
 	// Init prometheus setting
 	initProm(cfg.PrometheusEndpoint, cfg.PrometheusListenAddress)
 
+	// init tracing
+	tracehandler.Init(serviceName)
+	defer tracehandler.Shutdown()
+
 	// connect to database
 	sqlDB, err := commondatabasehandler.Connect(cfg.DatabaseDSN)
 	if err != nil {
@@ -110,7 +115,7 @@ func run(sqlDB *sql.DB, cache cachehandler.CacheHandler, cfg *config.Config) err
 	db := dbhandler.NewHandler(sqlDB, cache)
 
 	// rabbitmq sock connect
-	sockHandler := sockhandler.NewSockHandler(sock.TypeRabbitMQ, cfg.RabbitMQAddress)
+	sockHandler := sockhandler.NewSockHandler(sock.Type(cfg.SockType), cfg.RabbitMQAddress)
 	sockHandler.Connect()
 
 	requestHandler := requesthandler.NewRequestHandler(sockHandler, serviceName)
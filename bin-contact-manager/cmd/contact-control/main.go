@@ -61,7 +61,7 @@ func initCache() (cachehandler.CacheHandler, error) {
 
 func initContactHandler(sqlDB *sql.DB, cache cachehandler.CacheHandler) (contacthandler.ContactHandler, error) {
 	db := dbhandler.NewHandler(sqlDB, cache)
-	sockHandler := sockhandler.NewSockHandler(sock.TypeRabbitMQ, config.Get().RabbitMQAddress)
+	sockHandler := sockhandler.NewSockHandler(sock.Type(config.Get().SockType), config.Get().RabbitMQAddress)
 	sockHandler.Connect()
 
 	reqHandler := requesthandler.NewRequestHandler(sockHandler, serviceName)
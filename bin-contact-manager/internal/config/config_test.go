@@ -239,6 +239,7 @@ func TestInitConfig(t *testing.T) {
 				cmd.Flags().String("prometheus_endpoint", "/metrics", "")
 				cmd.Flags().String("prometheus_listen_address", ":2112", "")
 				cmd.Flags().String("rabbitmq_address", "amqp://test", "")
+				cmd.Flags().String("sock_type", "rabbitMQ", "")
 				cmd.Flags().String("redis_address", "localhost:6379", "")
 				cmd.Flags().String("redis_database", "1", "")
 				cmd.Flags().String("redis_password", "", "")
@@ -321,6 +322,7 @@ func TestInitConfig_BindErrors(t *testing.T) {
 				"prometheus_endpoint",
 				"prometheus_listen_address",
 				"rabbitmq_address",
+				"sock_type",
 				"redis_address",
 				"redis_database",
 				"redis_password",
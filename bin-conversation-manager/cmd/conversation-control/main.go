@@ -51,7 +51,7 @@ func initHandlers() (conversationhandler.ConversationHandler, accounthandler.Acc
 		return nil, nil, nil, errors.Wrapf(err, "could not initialize the cache")
 	}
 
-	sockHandler := sockhandler.NewSockHandler(sock.TypeRabbitMQ, config.Get().RabbitMQAddress)
+	sockHandler := sockhandler.NewSockHandler(sock.Type(config.Get().SockType), config.Get().RabbitMQAddress)
 	sockHandler.Connect()
 
 	reqHandler := requesthandler.NewRequestHandler(sockHandler, serviceName)
@@ -9,6 +9,7 @@ import (
 
 	"monorepo/bin-common-handler/models/sock"
 	"monorepo/bin-common-handler/pkg/sockhandler"
+	"monorepo/bin-common-handler/pkg/tracehandler"
 
 	mmmessage "monorepo/bin-message-manager/models/message"
 
@@ -135,7 +136,8 @@ func (h *subscribeHandler) processEvent(m *sock.Event) {
 	)
 	log.Debugf("Received subscribed event. publisher: %s, type: %s", m.Publisher, m.Type)
 
-	ctx := context.Background()
+	ctx, span := tracehandler.StartConsumerSpan(context.Background(), m)
+	defer span.End()
 	var err error
 	start := time.Now()
 	switch {
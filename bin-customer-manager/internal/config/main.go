@@ -17,6 +17,7 @@ var (
 
 type Config struct {
 	RabbitMQAddress      string
+	SockType             string
 	PrometheusEndpoint   string
 	PrometheusListenAddr string
 	DatabaseDSN          string
@@ -43,6 +44,7 @@ func bindConfig(cmd *cobra.Command) error {
 	f := cmd.PersistentFlags()
 
 	f.String("rabbitmq_address", "", "RabbitMQ server address")
+	f.String("sock_type", "rabbitMQ", "Message broker type. rabbitMQ or nats")
 	f.String("prometheus_endpoint", "", "Prometheus metrics endpoint")
 	f.String("prometheus_listen_address", "", "Prometheus listen address")
 	f.String("database_dsn", "", "Database connection DSN")
@@ -53,6 +55,7 @@ func bindConfig(cmd *cobra.Command) error {
 
 	bindings := map[string]string{
 		"rabbitmq_address":          "RABBITMQ_ADDRESS",
+		"sock_type":                 "SOCK_TYPE",
 		"prometheus_endpoint":       "PROMETHEUS_ENDPOINT",
 		"prometheus_listen_address": "PROMETHEUS_LISTEN_ADDRESS",
 		"database_dsn":              "DATABASE_DSN",
@@ -83,6 +86,7 @@ func LoadGlobalConfig() {
 	once.Do(func() {
 		globalConfig = Config{
 			RabbitMQAddress:      viper.GetString("rabbitmq_address"),
+			SockType:             viper.GetString("sock_type"),
 			PrometheusEndpoint:   viper.GetString("prometheus_endpoint"),
 			PrometheusListenAddr: viper.GetString("prometheus_listen_address"),
 			DatabaseDSN:          viper.GetString("database_dsn"),
@@ -6,6 +6,9 @@ type MetadataKey = string
 const (
 	// MetadataKeyRTPDebug enables RTPEngine RTP capture (PCAP) for this customer's calls.
 	MetadataKeyRTPDebug MetadataKey = "rtp_debug"
+
+	// MetadataKeyPIIRedaction is the customer's default redaction of personal data in AI conversations.
+	MetadataKeyPIIRedaction MetadataKey = "pii_redaction"
 )
 
 // Metadata holds configuration flags for a customer.
@@ -13,4 +16,15 @@ const (
 // or by CustomerAdmin via PUT /customer/metadata.
 type Metadata struct {
 	RTPDebug bool `json:"rtp_debug"` // enable RTPEngine RTP capture (PCAP)
+
+	PIIRedaction *PIIRedaction `json:"pii_redaction,omitempty"` // default redaction of personal data in AI conversations
+}
+
+// PIIRedaction is the customer's default redaction of personal data in the AI
+// conversations. An AI's own redaction config overrides it. The values are
+// the ones of the ai-manager's redaction config.
+type PIIRedaction struct {
+	EntityTypes []string `json:"entity_types,omitempty"` // credit_card, iban, ssn, email, phone_number
+	Mode        string   `json:"mode,omitempty"`         // replace, mask, tokenize
+	ToolAccess  bool     `json:"tool_access,omitempty"`  // restore the tokenized values for the tools
 }
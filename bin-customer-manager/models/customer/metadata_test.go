@@ -2,6 +2,7 @@ package customer
 
 import (
 	"encoding/json"
+	"reflect"
 	"testing"
 )
 
@@ -52,3 +53,48 @@ func TestMetadata_RTPDebug_JSONRoundTrip(t *testing.T) {
 		})
 	}
 }
+
+func TestMetadata_PIIRedaction_JSONRoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		expect Metadata
+	}{
+		{
+			"pii_redaction set",
+			`{"rtp_debug":false,"pii_redaction":{"entity_types":["credit_card","email"],"mode":"tokenize","tool_access":true}}`,
+			Metadata{
+				PIIRedaction: &PIIRedaction{
+					EntityTypes: []string{"credit_card", "email"},
+					Mode:        "tokenize",
+					ToolAccess:  true,
+				},
+			},
+		},
+		{
+			"pii_redaction absent",
+			`{"rtp_debug":false}`,
+			Metadata{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Metadata
+			if err := json.Unmarshal([]byte(tt.input), &got); err != nil {
+				t.Fatalf("Unmarshal failed: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.expect) {
+				t.Errorf("Got %+v, expected %+v", got, tt.expect)
+			}
+
+			b, err := json.Marshal(got)
+			if err != nil {
+				t.Fatalf("Marshal failed: %v", err)
+			}
+			if string(b) != tt.input {
+				t.Errorf("Wrong match.\nexpect: %s\ngot: %s", tt.input, b)
+			}
+		})
+	}
+}
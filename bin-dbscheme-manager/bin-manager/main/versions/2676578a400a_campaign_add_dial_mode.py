@@ -0,0 +1,33 @@
+"""campaign_add_dial_mode
+
+Revision ID: 2676578a400a
+Revises: 3dbd91a2d50e
+Create Date: 2026-10-19 07:13:25.944037
+
+"""
+from alembic import op
+
+
+# revision identifiers, used by Alembic.
+revision = '2676578a400a'
+down_revision = '3dbd91a2d50e'
+branch_labels = None
+depends_on = None
+
+
+def upgrade():
+    op.execute("""alter table campaign_campaigns add column dial_mode varchar(255) default '' after end_handle;""")
+    op.execute("""alter table campaign_campaigns add column max_abandon_rate integer default 0 after dial_mode;""")
+
+    op.execute("""alter table campaign_campaigncalls add column agent_id binary(16) after queue_id;""")
+    op.execute("""alter table campaign_campaigncalls add column abandoned tinyint(1) default 0 after try_count;""")
+    op.execute("""alter table campaign_campaigncalls add column tm_progressing datetime(6) after abandoned;""")
+
+
+def downgrade():
+    op.execute("""alter table campaign_campaigncalls drop column tm_progressing;""")
+    op.execute("""alter table campaign_campaigncalls drop column abandoned;""")
+    op.execute("""alter table campaign_campaigncalls drop column agent_id;""")
+
+    op.execute("""alter table campaign_campaigns drop column max_abandon_rate;""")
+    op.execute("""alter table campaign_campaigns drop column dial_mode;""")
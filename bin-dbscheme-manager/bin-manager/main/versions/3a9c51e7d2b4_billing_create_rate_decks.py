@@ -0,0 +1,84 @@
+"""billing_create_rate_decks
+
+Revision ID: 3a9c51e7d2b4
+Revises: 887832e226d1
+Create Date: 2026-10-19 10:52:41.204118
+
+"""
+from alembic import op
+
+
+# revision identifiers, used by Alembic.
+revision = '3a9c51e7d2b4'
+down_revision = '887832e226d1'
+branch_labels = None
+depends_on = None
+
+
+def upgrade():
+    op.execute("""
+        create table billing_rate_decks(
+            id binary(16),
+
+            name    varchar(255),
+            detail  text,
+
+            plan_type varchar(255),
+
+            -- timestamps
+            tm_create datetime(6),  -- create
+            tm_update datetime(6),  -- update
+            tm_delete datetime(6),  -- delete
+
+            primary key(id)
+        );
+    """)
+    op.execute("""create index idx_billing_rate_decks_plan_type on billing_rate_decks(plan_type);""")
+    op.execute("""create index idx_billing_rate_decks_create on billing_rate_decks(tm_create);""")
+
+    op.execute("""
+        create table billing_rates(
+            id            binary(16),
+            rate_deck_id  binary(16),
+
+            cost_type varchar(64),
+            prefix    varchar(32),
+
+            credit_per_unit bigint not null default 0,
+            connection_fee  bigint not null default 0,
+
+            increment_initial    integer not null default 0,
+            increment_subsequent integer not null default 0,
+
+            tm_effective_start  datetime(6),
+            tm_effective_end    datetime(6),
+
+            -- timestamps
+            tm_create datetime(6),  -- create
+            tm_update datetime(6),  -- update
+            tm_delete datetime(6),  -- delete
+
+            primary key(id)
+        );
+    """)
+    op.execute("""create index idx_billing_rates_rate_deck_id_cost_type_prefix on billing_rates(rate_deck_id, cost_type, prefix);""")
+    op.execute("""create index idx_billing_rates_create on billing_rates(tm_create);""")
+
+    op.execute("""alter table billing_accounts add column rate_deck_id binary(16) after plan_status;""")
+
+    op.execute("""alter table billing_billings add column rate_id binary(16) after billable_units;""")
+    op.execute("""alter table billing_billings add column rate_connection_fee bigint not null default 0 after rate_credit_per_unit;""")
+    op.execute("""alter table billing_billings add column rate_increment_initial integer not null default 0 after rate_connection_fee;""")
+    op.execute("""alter table billing_billings add column rate_increment_subsequent integer not null default 0 after rate_increment_initial;""")
+
+
+def downgrade():
+    op.execute("""alter table billing_billings drop column rate_increment_subsequent;""")
+    op.execute("""alter table billing_billings drop column rate_increment_initial;""")
+    op.execute("""alter table billing_billings drop column rate_connection_fee;""")
+    op.execute("""alter table billing_billings drop column rate_id;""")
+
+    op.execute("""alter table billing_accounts drop column rate_deck_id;""")
+
+    op.execute("""drop table billing_rates;""")
+    op.execute("""drop table billing_rate_decks;""")
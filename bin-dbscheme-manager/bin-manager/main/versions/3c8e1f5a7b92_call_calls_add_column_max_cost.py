@@ -0,0 +1,24 @@
+"""call_calls_add_column_max_cost
+
+Revision ID: 3c8e1f5a7b92
+Revises: b7a3e5c91d28
+Create Date: 2026-10-19 18:02:41.551930
+
+"""
+from alembic import op
+import sqlalchemy as sa
+
+
+# revision identifiers, used by Alembic.
+revision = '3c8e1f5a7b92'
+down_revision = 'b7a3e5c91d28'
+branch_labels = None
+depends_on = None
+
+
+def upgrade():
+    op.execute("""ALTER TABLE call_calls ADD max_cost BIGINT NOT NULL DEFAULT 0 AFTER dialroutes;""")
+
+
+def downgrade():
+    op.execute("""ALTER TABLE call_calls DROP COLUMN max_cost;""")
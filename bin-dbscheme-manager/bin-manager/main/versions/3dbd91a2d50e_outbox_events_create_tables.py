@@ -0,0 +1,53 @@
+"""outbox_events_create_tables
+
+Revision ID: 3dbd91a2d50e
+Revises: ede50012c416
+Create Date: 2026-10-19 05:48:05.338117
+
+"""
+from alembic import op
+
+
+# revision identifiers, used by Alembic.
+revision = '3dbd91a2d50e'
+down_revision = 'ede50012c416'
+branch_labels = None
+depends_on = None
+
+
+def upgrade():
+    op.execute("""
+        CREATE TABLE IF NOT EXISTS call_outbox_events (
+            id              BINARY(16) PRIMARY KEY,
+            customer_id     BINARY(16) NOT NULL,
+            event_type      VARCHAR(255) NOT NULL,
+            data_type       VARCHAR(255) NOT NULL,
+            data            MEDIUMTEXT NOT NULL,
+            webhook_data    MEDIUMTEXT NOT NULL,
+            retry_count     INT NOT NULL DEFAULT 0,
+            tm_lease        DATETIME(6),
+            tm_publish      DATETIME(6),
+            tm_create       DATETIME(6) NOT NULL,
+            INDEX idx_call_outbox_events_tm_publish_tm_create (tm_publish, tm_create)
+        );
+    """)
+    op.execute("""
+        CREATE TABLE IF NOT EXISTS billing_outbox_events (
+            id              BINARY(16) PRIMARY KEY,
+            customer_id     BINARY(16) NOT NULL,
+            event_type      VARCHAR(255) NOT NULL,
+            data_type       VARCHAR(255) NOT NULL,
+            data            MEDIUMTEXT NOT NULL,
+            webhook_data    MEDIUMTEXT NOT NULL,
+            retry_count     INT NOT NULL DEFAULT 0,
+            tm_lease        DATETIME(6),
+            tm_publish      DATETIME(6),
+            tm_create       DATETIME(6) NOT NULL,
+            INDEX idx_billing_outbox_events_tm_publish_tm_create (tm_publish, tm_create)
+        );
+    """)
+
+
+def downgrade():
+    op.execute("""DROP TABLE IF EXISTS billing_outbox_events;""")
+    op.execute("""DROP TABLE IF EXISTS call_outbox_events;""")
@@ -0,0 +1,44 @@
+"""ai_custom_tools_create_table
+
+Revision ID: 4d2f7a9c1e63
+Revises: 3c8e1f5a7b92
+Create Date: 2026-10-19 20:14:05.318274
+
+"""
+from alembic import op
+
+
+# revision identifiers, used by Alembic.
+revision = '4d2f7a9c1e63'
+down_revision = '3c8e1f5a7b92'
+branch_labels = None
+depends_on = None
+
+
+def upgrade():
+    op.execute("""CREATE TABLE ai_custom_tools (
+        id            BINARY(16) NOT NULL,
+        customer_id   BINARY(16) NOT NULL,
+
+        name          VARCHAR(64) NOT NULL,
+        detail        TEXT NOT NULL,
+        description   TEXT NOT NULL,
+        parameters    JSON,
+
+        url                 TEXT NOT NULL,
+        headers             JSON,
+        timeout             INTEGER NOT NULL DEFAULT 0,
+        response_template   TEXT NOT NULL,
+
+        tm_create DATETIME(6),
+        tm_update DATETIME(6),
+        tm_delete DATETIME(6),
+
+        PRIMARY KEY(id)
+    );""")
+    op.execute("""CREATE INDEX idx_ai_custom_tools_customer_id ON ai_custom_tools(customer_id);""")
+    op.execute("""CREATE INDEX idx_ai_custom_tools_customer_id_name ON ai_custom_tools(customer_id, name);""")
+
+
+def downgrade():
+    op.execute("""drop table ai_custom_tools;""")
@@ -0,0 +1,49 @@
+"""campaign_create_dispositions
+
+Revision ID: 5850f6d04529
+Revises: ef42a7208301
+Create Date: 2026-10-19 09:25:05.884690
+
+"""
+from alembic import op
+
+
+# revision identifiers, used by Alembic.
+revision = '5850f6d04529'
+down_revision = 'ef42a7208301'
+branch_labels = None
+depends_on = None
+
+
+def upgrade():
+    op.execute("""
+        create table campaign_dispositions(
+            -- identity
+            id          binary(16),
+            customer_id binary(16),
+
+            code    varchar(255),
+            name    varchar(255),
+            detail  text,
+
+            retry_mode  varchar(255),
+
+            -- timestamps
+            tm_create datetime(6),  -- create
+            tm_update datetime(6),  -- update
+            tm_delete datetime(6),  -- delete
+
+            primary key(id)
+        );
+    """)
+    op.execute("""create index idx_campaign_dispositions_customer_id_code on campaign_dispositions(customer_id, code);""")
+
+    op.execute("""alter table campaign_campaigncalls add column disposition varchar(255) default '' after result;""")
+    op.execute("""alter table campaign_campaigncalls add column tm_end datetime(6) after tm_progressing;""")
+
+
+def downgrade():
+    op.execute("""alter table campaign_campaigncalls drop column tm_end;""")
+    op.execute("""alter table campaign_campaigncalls drop column disposition;""")
+
+    op.execute("""drop table campaign_dispositions;""")
@@ -0,0 +1,43 @@
+"""ai_mcp_servers_create_table
+
+Revision ID: 5e3a8b0d2f74
+Revises: 4d2f7a9c1e63
+Create Date: 2026-10-19 23:41:27.604119
+
+"""
+from alembic import op
+
+
+# revision identifiers, used by Alembic.
+revision = '5e3a8b0d2f74'
+down_revision = '4d2f7a9c1e63'
+branch_labels = None
+depends_on = None
+
+
+def upgrade():
+    op.execute("""CREATE TABLE ai_mcp_servers (
+        id            BINARY(16) NOT NULL,
+        customer_id   BINARY(16) NOT NULL,
+
+        name          VARCHAR(32) NOT NULL,
+        detail        TEXT NOT NULL,
+        transport     VARCHAR(32) NOT NULL,
+
+        url             TEXT NOT NULL,
+        headers         JSON,
+        timeout         INTEGER NOT NULL DEFAULT 0,
+        allowed_tools   JSON,
+
+        tm_create DATETIME(6),
+        tm_update DATETIME(6),
+        tm_delete DATETIME(6),
+
+        PRIMARY KEY(id)
+    );""")
+    op.execute("""CREATE INDEX idx_ai_mcp_servers_customer_id ON ai_mcp_servers(customer_id);""")
+    op.execute("""CREATE INDEX idx_ai_mcp_servers_customer_id_name ON ai_mcp_servers(customer_id, name);""")
+
+
+def downgrade():
+    op.execute("""drop table ai_mcp_servers;""")
@@ -0,0 +1,25 @@
+"""ai_ais add column engine_fallbacks, ai_messages add column engine_model
+
+Revision ID: 6a7c2e9f4b18
+Revises: 5e3a8b0d2f74
+Create Date: 2026-10-20 09:12:44.318502
+
+"""
+from alembic import op
+
+
+# revision identifiers, used by Alembic.
+revision = '6a7c2e9f4b18'
+down_revision = '5e3a8b0d2f74'
+branch_labels = None
+depends_on = None
+
+
+def upgrade():
+    op.execute("""ALTER TABLE ai_ais ADD COLUMN engine_fallbacks JSON AFTER rag_id;""")
+    op.execute("""ALTER TABLE ai_messages ADD COLUMN engine_model VARCHAR(255) NOT NULL DEFAULT '' AFTER tool_call_id;""")
+
+
+def downgrade():
+    op.execute("""ALTER TABLE ai_messages DROP COLUMN engine_model;""")
+    op.execute("""ALTER TABLE ai_ais DROP COLUMN engine_fallbacks;""")
@@ -0,0 +1,33 @@
+"""billing_accounts_add_spending_settings
+
+Revision ID: 6d2f8b41c7a3
+Revises: 3a9c51e7d2b4
+Create Date: 2026-10-19 14:08:17.530261
+
+"""
+from alembic import op
+
+
+# revision identifiers, used by Alembic.
+revision = '6d2f8b41c7a3'
+down_revision = '3a9c51e7d2b4'
+branch_labels = None
+depends_on = None
+
+
+def upgrade():
+    op.execute("""alter table billing_accounts add column spend_limits json after payment_method;""")
+    op.execute("""alter table billing_accounts add column balance_alert_thresholds json after spend_limits;""")
+    op.execute("""alter table billing_accounts add column auto_topup_threshold bigint not null default 0 after balance_alert_thresholds;""")
+    op.execute("""alter table billing_accounts add column auto_topup_amount bigint not null default 0 after auto_topup_threshold;""")
+
+    op.execute("""create index idx_billing_billings_account_id_tm_billing_start on billing_billings(account_id, tm_billing_start);""")
+
+
+def downgrade():
+    op.execute("""drop index idx_billing_billings_account_id_tm_billing_start on billing_billings;""")
+
+    op.execute("""alter table billing_accounts drop column auto_topup_amount;""")
+    op.execute("""alter table billing_accounts drop column auto_topup_threshold;""")
+    op.execute("""alter table billing_accounts drop column balance_alert_thresholds;""")
+    op.execute("""alter table billing_accounts drop column spend_limits;""")
@@ -0,0 +1,87 @@
+"""ai_messages, ai_aicalls, ai_summaries, ai_ai_audits add usage columns
+
+Revision ID: 7b8d3f0a5c29
+Revises: 6a7c2e9f4b18
+Create Date: 2026-10-21 10:27:05.614237
+
+"""
+from alembic import op
+
+
+# revision identifiers, used by Alembic.
+revision = '7b8d3f0a5c29'
+down_revision = '6a7c2e9f4b18'
+branch_labels = None
+depends_on = None
+
+
+def upgrade():
+    op.execute("""
+        ALTER TABLE ai_messages
+            ADD COLUMN prompt_tokens BIGINT NOT NULL DEFAULT 0 AFTER engine_model,
+            ADD COLUMN completion_tokens BIGINT NOT NULL DEFAULT 0 AFTER prompt_tokens,
+            ADD COLUMN cached_tokens BIGINT NOT NULL DEFAULT 0 AFTER completion_tokens,
+            ADD COLUMN stt_seconds DOUBLE NOT NULL DEFAULT 0 AFTER cached_tokens,
+            ADD COLUMN tts_seconds DOUBLE NOT NULL DEFAULT 0 AFTER stt_seconds;
+    """)
+    op.execute("""
+        ALTER TABLE ai_aicalls
+            ADD COLUMN prompt_tokens BIGINT NOT NULL DEFAULT 0 AFTER metadata,
+            ADD COLUMN completion_tokens BIGINT NOT NULL DEFAULT 0 AFTER prompt_tokens,
+            ADD COLUMN cached_tokens BIGINT NOT NULL DEFAULT 0 AFTER completion_tokens,
+            ADD COLUMN stt_seconds DOUBLE NOT NULL DEFAULT 0 AFTER cached_tokens,
+            ADD COLUMN tts_seconds DOUBLE NOT NULL DEFAULT 0 AFTER stt_seconds,
+            ADD COLUMN usage_pipecatcall_id BINARY(16) AFTER tts_seconds;
+    """)
+    op.execute("""
+        ALTER TABLE ai_summaries
+            ADD COLUMN prompt_tokens BIGINT NOT NULL DEFAULT 0 AFTER content,
+            ADD COLUMN completion_tokens BIGINT NOT NULL DEFAULT 0 AFTER prompt_tokens,
+            ADD COLUMN cached_tokens BIGINT NOT NULL DEFAULT 0 AFTER completion_tokens,
+            ADD COLUMN stt_seconds DOUBLE NOT NULL DEFAULT 0 AFTER cached_tokens,
+            ADD COLUMN tts_seconds DOUBLE NOT NULL DEFAULT 0 AFTER stt_seconds;
+    """)
+    op.execute("""
+        ALTER TABLE ai_ai_audits
+            ADD COLUMN prompt_tokens BIGINT NOT NULL DEFAULT 0 AFTER error,
+            ADD COLUMN completion_tokens BIGINT NOT NULL DEFAULT 0 AFTER prompt_tokens,
+            ADD COLUMN cached_tokens BIGINT NOT NULL DEFAULT 0 AFTER completion_tokens,
+            ADD COLUMN stt_seconds DOUBLE NOT NULL DEFAULT 0 AFTER cached_tokens,
+            ADD COLUMN tts_seconds DOUBLE NOT NULL DEFAULT 0 AFTER stt_seconds;
+    """)
+
+
+def downgrade():
+    op.execute("""
+        ALTER TABLE ai_ai_audits
+            DROP COLUMN tts_seconds,
+            DROP COLUMN stt_seconds,
+            DROP COLUMN cached_tokens,
+            DROP COLUMN completion_tokens,
+            DROP COLUMN prompt_tokens;
+    """)
+    op.execute("""
+        ALTER TABLE ai_summaries
+            DROP COLUMN tts_seconds,
+            DROP COLUMN stt_seconds,
+            DROP COLUMN cached_tokens,
+            DROP COLUMN completion_tokens,
+            DROP COLUMN prompt_tokens;
+    """)
+    op.execute("""
+        ALTER TABLE ai_aicalls
+            DROP COLUMN usage_pipecatcall_id,
+            DROP COLUMN tts_seconds,
+            DROP COLUMN stt_seconds,
+            DROP COLUMN cached_tokens,
+            DROP COLUMN completion_tokens,
+            DROP COLUMN prompt_tokens;
+    """)
+    op.execute("""
+        ALTER TABLE ai_messages
+            DROP COLUMN tts_seconds,
+            DROP COLUMN stt_seconds,
+            DROP COLUMN cached_tokens,
+            DROP COLUMN completion_tokens,
+            DROP COLUMN prompt_tokens;
+    """)
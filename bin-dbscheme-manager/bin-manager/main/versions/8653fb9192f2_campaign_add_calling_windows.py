@@ -0,0 +1,29 @@
+"""campaign_add_calling_windows
+
+Revision ID: 8653fb9192f2
+Revises: 2676578a400a
+Create Date: 2026-10-19 07:47:21.577031
+
+"""
+from alembic import op
+
+
+# revision identifiers, used by Alembic.
+revision = '8653fb9192f2'
+down_revision = '2676578a400a'
+branch_labels = None
+depends_on = None
+
+
+def upgrade():
+    op.execute("""alter table campaign_campaigns add column calling_windows json after max_abandon_rate;""")
+    op.execute("""alter table campaign_campaigns add column timezone varchar(255) default '' after calling_windows;""")
+
+    op.execute("""alter table outdial_outdialtargets add column timezone varchar(255) default '' after status;""")
+
+
+def downgrade():
+    op.execute("""alter table outdial_outdialtargets drop column timezone;""")
+
+    op.execute("""alter table campaign_campaigns drop column timezone;""")
+    op.execute("""alter table campaign_campaigns drop column calling_windows;""")
@@ -0,0 +1,27 @@
+"""outdial_outdialtargets_add_callback
+
+Revision ID: 887832e226d1
+Revises: 5850f6d04529
+Create Date: 2026-10-19 09:58:28.536015
+
+"""
+from alembic import op
+
+
+# revision identifiers, used by Alembic.
+revision = '887832e226d1'
+down_revision = '5850f6d04529'
+branch_labels = None
+depends_on = None
+
+
+def upgrade():
+    op.execute("""alter table outdial_outdialtargets add column callback_agent_id binary(16) after try_count_4;""")
+    op.execute("""alter table outdial_outdialtargets add column tm_callback datetime(6) after callback_agent_id;""")
+    op.execute("""create index idx_outdial_outdialtargets_tm_callback on outdial_outdialtargets(tm_callback);""")
+
+
+def downgrade():
+    op.execute("""drop index idx_outdial_outdialtargets_tm_callback on outdial_outdialtargets;""")
+    op.execute("""alter table outdial_outdialtargets drop column tm_callback;""")
+    op.execute("""alter table outdial_outdialtargets drop column callback_agent_id;""")
@@ -0,0 +1,55 @@
+"""ai_extractions_create_table
+
+Revision ID: 8c4e1a6b3d70
+Revises: 7b8d3f0a5c29
+Create Date: 2026-10-22 09:12:44.318520
+
+"""
+from alembic import op
+
+
+# revision identifiers, used by Alembic.
+revision = '8c4e1a6b3d70'
+down_revision = '7b8d3f0a5c29'
+branch_labels = None
+depends_on = None
+
+
+def upgrade():
+    op.execute("""CREATE TABLE ai_extractions (
+        id            BINARY(16) NOT NULL,
+        customer_id   BINARY(16) NOT NULL,
+
+        activeflow_id     BINARY(16) NOT NULL,
+        on_end_flow_id    BINARY(16) NOT NULL,
+
+        reference_type    VARCHAR(255) NOT NULL,
+        reference_id      BINARY(16) NOT NULL,
+
+        status    VARCHAR(16) NOT NULL,
+        language  VARCHAR(16) NOT NULL,
+
+        json_schema       JSON,
+        set_variables     BOOLEAN NOT NULL DEFAULT 0,
+
+        result            JSON,
+
+        prompt_tokens     BIGINT NOT NULL DEFAULT 0,
+        completion_tokens BIGINT NOT NULL DEFAULT 0,
+        cached_tokens     BIGINT NOT NULL DEFAULT 0,
+        stt_seconds       DOUBLE NOT NULL DEFAULT 0,
+        tts_seconds       DOUBLE NOT NULL DEFAULT 0,
+
+        tm_create DATETIME(6),
+        tm_update DATETIME(6),
+        tm_delete DATETIME(6),
+
+        PRIMARY KEY(id)
+    );""")
+    op.execute("""CREATE INDEX idx_ai_extractions_customer_id ON ai_extractions(customer_id);""")
+    op.execute("""CREATE INDEX idx_ai_extractions_activeflow_id ON ai_extractions(activeflow_id);""")
+    op.execute("""CREATE INDEX idx_ai_extractions_reference_id ON ai_extractions(reference_id);""")
+
+
+def downgrade():
+    op.execute("""drop table ai_extractions;""")
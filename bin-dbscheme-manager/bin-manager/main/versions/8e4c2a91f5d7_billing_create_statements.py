@@ -0,0 +1,58 @@
+"""billing_create_statements
+
+Revision ID: 8e4c2a91f5d7
+Revises: 6d2f8b41c7a3
+Create Date: 2026-10-19 16:21:09.418732
+
+"""
+from alembic import op
+
+
+# revision identifiers, used by Alembic.
+revision = '8e4c2a91f5d7'
+down_revision = '6d2f8b41c7a3'
+branch_labels = None
+depends_on = None
+
+
+def upgrade():
+    op.execute("""
+        create table billing_statements(
+            id          binary(16),
+            customer_id binary(16),
+            account_id  binary(16),
+            status      varchar(16) not null default '',  -- progressing, done
+
+            tm_period_start datetime(6),
+            tm_period_end   datetime(6),
+
+            items             json,
+            top_destinations  json,
+            top_ups           json,
+
+            total_usage_token   bigint not null default 0,
+            total_usage_credit  bigint not null default 0,
+            total_top_up_token  bigint not null default 0,
+            total_top_up_credit bigint not null default 0,
+
+            balance_token_end   bigint not null default 0,
+            balance_credit_end  bigint not null default 0,
+
+            pdf_file_id binary(16),
+            csv_file_id binary(16),
+
+            -- timestamps
+            tm_create datetime(6),  -- create
+            tm_update datetime(6),  -- update
+            tm_delete datetime(6),  -- delete
+
+            primary key(id)
+        );
+    """)
+    op.execute("""create index idx_billing_statements_customer_id on billing_statements(customer_id);""")
+    op.execute("""create unique index ux_billing_statements_account_id_tm_period_start on billing_statements(account_id, tm_period_start);""")
+    op.execute("""create index idx_billing_statements_create on billing_statements(tm_create);""")
+
+
+def downgrade():
+    op.execute("""drop table billing_statements;""")
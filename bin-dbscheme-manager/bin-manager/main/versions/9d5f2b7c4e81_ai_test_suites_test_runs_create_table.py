@@ -0,0 +1,62 @@
+"""ai_test_suites_test_runs_create_table
+
+Revision ID: 9d5f2b7c4e81
+Revises: 8c4e1a6b3d70
+Create Date: 2026-10-23 14:27:05.614203
+
+"""
+from alembic import op
+
+
+# revision identifiers, used by Alembic.
+revision = '9d5f2b7c4e81'
+down_revision = '8c4e1a6b3d70'
+branch_labels = None
+depends_on = None
+
+
+def upgrade():
+    op.execute("""CREATE TABLE ai_test_suites (
+        id            BINARY(16) NOT NULL,
+        customer_id   BINARY(16) NOT NULL,
+
+        ai_id         BINARY(16) NOT NULL,
+
+        name          VARCHAR(255) NOT NULL DEFAULT '',
+        detail        TEXT,
+        scenarios     JSON,
+
+        tm_create DATETIME(6),
+        tm_update DATETIME(6),
+        tm_delete DATETIME(6),
+
+        PRIMARY KEY(id)
+    );""")
+    op.execute("""CREATE INDEX idx_ai_test_suites_customer_id ON ai_test_suites(customer_id);""")
+    op.execute("""CREATE INDEX idx_ai_test_suites_ai_id ON ai_test_suites(ai_id);""")
+
+    op.execute("""CREATE TABLE ai_test_runs (
+        id            BINARY(16) NOT NULL,
+        customer_id   BINARY(16) NOT NULL,
+
+        test_suite_id     BINARY(16) NOT NULL,
+        ai_id             BINARY(16) NOT NULL,
+        prompt_history_id BINARY(16) NOT NULL,
+
+        status        VARCHAR(16) NOT NULL,
+        results       JSON,
+
+        tm_create DATETIME(6),
+        tm_update DATETIME(6),
+        tm_delete DATETIME(6),
+
+        PRIMARY KEY(id)
+    );""")
+    op.execute("""CREATE INDEX idx_ai_test_runs_customer_id ON ai_test_runs(customer_id);""")
+    op.execute("""CREATE INDEX idx_ai_test_runs_test_suite_id ON ai_test_runs(test_suite_id);""")
+    op.execute("""CREATE INDEX idx_ai_test_runs_ai_id ON ai_test_runs(ai_id);""")
+
+
+def downgrade():
+    op.execute("""drop table ai_test_runs;""")
+    op.execute("""drop table ai_test_suites;""")
@@ -0,0 +1,23 @@
+"""campaign_campaigncalls_add_column_tm_callback
+
+Revision ID: a7d3e9c1b584
+Revises: f2c8b5d1a7e4
+Create Date: 2026-10-28 10:41:19.532817
+
+"""
+from alembic import op
+
+
+# revision identifiers, used by Alembic.
+revision = 'a7d3e9c1b584'
+down_revision = 'f2c8b5d1a7e4'
+branch_labels = None
+depends_on = None
+
+
+def upgrade():
+    op.execute("""alter table campaign_campaigncalls add column tm_callback datetime(6) after abandoned;""")
+
+
+def downgrade():
+    op.execute("""alter table campaign_campaigncalls drop column tm_callback;""")
@@ -0,0 +1,25 @@
+"""billing_accounts_add_auto_topup_pending
+
+Revision ID: a8d4f2c6e1b9
+Revises: e3b7c9d1a4f6
+Create Date: 2026-11-02 15:47:09.318552
+
+"""
+from alembic import op
+
+
+# revision identifiers, used by Alembic.
+revision = 'a8d4f2c6e1b9'
+down_revision = 'e3b7c9d1a4f6'
+branch_labels = None
+depends_on = None
+
+
+def upgrade():
+    op.execute("""alter table billing_accounts add column auto_topup_pending_id binary(16) after auto_topup_amount;""")
+    op.execute("""alter table billing_accounts add column tm_auto_topup_pending datetime(6) after auto_topup_pending_id;""")
+
+
+def downgrade():
+    op.execute("""alter table billing_accounts drop column tm_auto_topup_pending;""")
+    op.execute("""alter table billing_accounts drop column auto_topup_pending_id;""")
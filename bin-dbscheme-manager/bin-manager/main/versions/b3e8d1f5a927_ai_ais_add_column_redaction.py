@@ -0,0 +1,23 @@
+"""ai_ais add column redaction
+
+Revision ID: b3e8d1f5a927
+Revises: 9d5f2b7c4e81
+Create Date: 2026-10-24 10:03:51.207416
+
+"""
+from alembic import op
+
+
+# revision identifiers, used by Alembic.
+revision = 'b3e8d1f5a927'
+down_revision = '9d5f2b7c4e81'
+branch_labels = None
+depends_on = None
+
+
+def upgrade():
+    op.execute("""ALTER TABLE ai_ais ADD COLUMN redaction JSON AFTER auto_aicall_audit_enabled;""")
+
+
+def downgrade():
+    op.execute("""ALTER TABLE ai_ais DROP COLUMN redaction;""")
@@ -0,0 +1,60 @@
+"""schedule_schedules_seed_billing_statement
+
+Revision ID: b7a3e5c91d28
+Revises: 8e4c2a91f5d7
+Create Date: 2026-10-19 16:24:52.107395
+
+Seeds the billing-monthly-statement schedule. The job requests the
+previous month's statements of the billing accounts which don't have
+one yet. Each statement is generated by billing-manager's own event
+subscriber, so the job itself only lists the accounts and returns fast.
+It runs daily, not monthly, so a statement missed on the 1st is
+requested again on the next run.
+
+target_data uses JSON_OBJECT() instead of a raw '{}' string literal:
+alembic op.execute() treats a bare colon as a bind-parameter marker.
+"""
+from alembic import op
+
+
+# revision identifiers, used by Alembic.
+revision = 'b7a3e5c91d28'
+down_revision = '8e4c2a91f5d7'
+branch_labels = None
+depends_on = None
+
+
+def upgrade():
+    op.execute("""
+        INSERT INTO schedule_schedules (
+            id, customer_id, name, detail, type, cron,
+            target_queue, target_uri, target_method, target_data_type, target_data,
+            timeout_ms, retry_max, enabled, tm_next_run, tm_create, tm_update
+        ) VALUES (
+            UNHEX(REPLACE(UUID(), '-', '')),
+            UNHEX('00000000000000000000000000000000'),
+            'billing-monthly-statement',
+            'Previous month statements of the billing accounts',
+            'rpc',
+            '0 3 * * *',
+            'bin-manager.billing-manager.request',
+            '/v1/statements/generate',
+            'POST',
+            'application/json',
+            JSON_OBJECT(),
+            300000,
+            2,
+            1,
+            NULL,
+            UTC_TIMESTAMP(6),
+            UTC_TIMESTAMP(6)
+        );
+    """)
+
+
+def downgrade():
+    op.execute("""
+        DELETE FROM schedule_schedules
+        WHERE name = 'billing-monthly-statement'
+          AND customer_id = UNHEX('00000000000000000000000000000000');
+    """)
@@ -0,0 +1,29 @@
+"""ai_aiassists_add_column_summary_engine_model_analyze
+
+Revision ID: b8e1d4f7a260
+Revises: c4e8a2f6d913
+Create Date: 2026-10-30 10:21:07.482913
+
+"""
+from alembic import op
+
+
+# revision identifiers, used by Alembic.
+revision = 'b8e1d4f7a260'
+down_revision = 'c4e8a2f6d913'
+branch_labels = None
+depends_on = None
+
+
+def upgrade():
+    op.execute("""alter table ai_aiassists add column summary text after sentiment;""")
+    op.execute("""alter table ai_aiassists add column engine_model varchar(255) not null default '' after tts_seconds;""")
+    op.execute("""alter table ai_aiassists add column analyze_pending boolean not null default false after engine_model;""")
+    op.execute("""alter table ai_aiassists add column tm_analyze_lease datetime(6) after analyze_pending;""")
+
+
+def downgrade():
+    op.execute("""alter table ai_aiassists drop column tm_analyze_lease;""")
+    op.execute("""alter table ai_aiassists drop column analyze_pending;""")
+    op.execute("""alter table ai_aiassists drop column engine_model;""")
+    op.execute("""alter table ai_aiassists drop column summary;""")
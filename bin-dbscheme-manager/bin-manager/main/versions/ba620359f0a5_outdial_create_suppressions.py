@@ -0,0 +1,71 @@
+"""outdial_create_suppressions
+
+Revision ID: ba620359f0a5
+Revises: 8653fb9192f2
+Create Date: 2026-10-19 08:16:41.966541
+
+"""
+from alembic import op
+
+
+# revision identifiers, used by Alembic.
+revision = 'ba620359f0a5'
+down_revision = '8653fb9192f2'
+branch_labels = None
+depends_on = None
+
+
+def upgrade():
+    op.execute("""
+        create table outdial_suppressions(
+            -- identity
+            id          binary(16),
+            customer_id binary(16),
+
+            list_type varchar(255),
+            target    varchar(255),
+
+            detail    text,
+
+            -- timestamps
+            tm_create datetime(6),  -- create
+            tm_update datetime(6),  -- update
+            tm_delete datetime(6),  -- delete
+
+            primary key(id)
+        );
+    """)
+    op.execute("""create index idx_outdial_suppressions_customer_id on outdial_suppressions(customer_id);""")
+    op.execute("""create index idx_outdial_suppressions_customer_id_target on outdial_suppressions(customer_id, target);""")
+
+    op.execute("""
+        create table outdial_suppressionblocks(
+            -- identity
+            id          binary(16),
+            customer_id binary(16),
+
+            suppression_id  binary(16),
+            list_type       varchar(255),
+            target          varchar(255),
+
+            reference_type  varchar(255),
+            reference_id    binary(16),
+
+            reason    text,
+
+            -- timestamps
+            tm_create datetime(6),  -- create
+            tm_update datetime(6),  -- update
+            tm_delete datetime(6),  -- delete
+
+            primary key(id)
+        );
+    """)
+    op.execute("""create index idx_outdial_suppressionblocks_customer_id on outdial_suppressionblocks(customer_id);""")
+    op.execute("""create index idx_outdial_suppressionblocks_suppression_id on outdial_suppressionblocks(suppression_id);""")
+    op.execute("""create index idx_outdial_suppressionblocks_reference_id on outdial_suppressionblocks(reference_id);""")
+
+
+def downgrade():
+    op.execute("""drop table outdial_suppressionblocks;""")
+    op.execute("""drop table outdial_suppressions;""")
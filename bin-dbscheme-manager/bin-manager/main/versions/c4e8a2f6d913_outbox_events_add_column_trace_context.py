@@ -0,0 +1,25 @@
+"""outbox_events_add_column_trace_context
+
+Revision ID: c4e8a2f6d913
+Revises: a7d3e9c1b584
+Create Date: 2026-10-29 09:12:44.301526
+
+"""
+from alembic import op
+
+
+# revision identifiers, used by Alembic.
+revision = 'c4e8a2f6d913'
+down_revision = 'a7d3e9c1b584'
+branch_labels = None
+depends_on = None
+
+
+def upgrade():
+    op.execute("""alter table call_outbox_events add column trace_context json after webhook_data;""")
+    op.execute("""alter table billing_outbox_events add column trace_context json after webhook_data;""")
+
+
+def downgrade():
+    op.execute("""alter table billing_outbox_events drop column trace_context;""")
+    op.execute("""alter table call_outbox_events drop column trace_context;""")
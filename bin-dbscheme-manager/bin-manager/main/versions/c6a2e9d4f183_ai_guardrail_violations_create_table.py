@@ -0,0 +1,48 @@
+"""ai_guardrail_violations_create_table
+
+Revision ID: c6a2e9d4f183
+Revises: b3e8d1f5a927
+Create Date: 2026-10-25 11:21:07.542193
+
+"""
+from alembic import op
+
+
+# revision identifiers, used by Alembic.
+revision = 'c6a2e9d4f183'
+down_revision = 'b3e8d1f5a927'
+branch_labels = None
+depends_on = None
+
+
+def upgrade():
+    op.execute("""ALTER TABLE ai_ais ADD COLUMN guardrail JSON AFTER redaction;""")
+
+    op.execute("""CREATE TABLE ai_guardrail_violations (
+        id            BINARY(16) NOT NULL,
+        customer_id   BINARY(16) NOT NULL,
+
+        aicall_id     BINARY(16) NOT NULL,
+        ai_id         BINARY(16) NOT NULL,
+        activeflow_id BINARY(16) NOT NULL,
+
+        rule      VARCHAR(255) NOT NULL,
+        detail    TEXT,
+        content   TEXT,
+
+        action    VARCHAR(255) NOT NULL,
+
+        tm_create DATETIME(6),
+        tm_update DATETIME(6),
+        tm_delete DATETIME(6),
+
+        PRIMARY KEY(id)
+    );""")
+    op.execute("""CREATE INDEX idx_ai_guardrail_violations_customer_id ON ai_guardrail_violations(customer_id);""")
+    op.execute("""CREATE INDEX idx_ai_guardrail_violations_aicall_id ON ai_guardrail_violations(aicall_id);""")
+    op.execute("""CREATE INDEX idx_ai_guardrail_violations_ai_id ON ai_guardrail_violations(ai_id);""")
+
+
+def downgrade():
+    op.execute("""drop table ai_guardrail_violations;""")
+    op.execute("""ALTER TABLE ai_ais DROP COLUMN guardrail;""")
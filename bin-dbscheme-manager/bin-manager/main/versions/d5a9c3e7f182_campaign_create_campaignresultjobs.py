@@ -0,0 +1,49 @@
+"""campaign_create_campaignresultjobs
+
+Revision ID: d5a9c3e7f182
+Revises: b8e1d4f7a260
+Create Date: 2026-10-31 09:12:44.318205
+
+"""
+from alembic import op
+
+
+# revision identifiers, used by Alembic.
+revision = 'd5a9c3e7f182'
+down_revision = 'b8e1d4f7a260'
+branch_labels = None
+depends_on = None
+
+
+def upgrade():
+    op.execute("""
+        create table campaign_campaignresultjobs(
+            -- identity
+            id            binary(16),   -- id
+            customer_id   binary(16),   -- customer id
+
+            campaign_id binary(16),
+
+            status  varchar(255),
+
+            file_id binary(16),
+
+            target_count  integer,
+            row_count     integer,
+
+            detail  text,
+
+            -- timestamps
+            tm_create datetime(6),  --
+            tm_update datetime(6),  --
+            tm_delete datetime(6),  --
+
+            primary key(id)
+        );
+    """)
+    op.execute("""create index idx_campaign_campaignresultjobs_customer_id on campaign_campaignresultjobs(customer_id);""")
+    op.execute("""create index idx_campaign_campaignresultjobs_campaign_id on campaign_campaignresultjobs(campaign_id);""")
+
+
+def downgrade():
+    op.execute("""drop table campaign_campaignresultjobs;""")
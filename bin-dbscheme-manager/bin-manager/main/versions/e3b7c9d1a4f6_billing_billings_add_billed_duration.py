@@ -0,0 +1,44 @@
+"""billing_billings_add_billed_duration
+
+Revision ID: e3b7c9d1a4f6
+Revises: d5a9c3e7f182
+Create Date: 2026-11-02 10:21:37.604118
+
+"""
+from alembic import op
+
+
+# revision identifiers, used by Alembic.
+revision = 'e3b7c9d1a4f6'
+down_revision = 'd5a9c3e7f182'
+branch_labels = None
+depends_on = None
+
+
+def upgrade():
+    op.execute("""alter table billing_billings add column billed_duration integer not null default 0 after billable_units;""")
+
+    # the billings with the rate deck's billing increments stored the billed seconds in the billable_units.
+    # move them to the billed_duration and keep the billable_units in minutes.
+    op.execute("""
+        update billing_billings set
+            billed_duration = billable_units,
+            billable_units = ceil(billable_units / 60)
+        where rate_increment_initial > 0;
+    """)
+    op.execute("""
+        update billing_billings set
+            billed_duration = billable_units * 60
+        where
+            rate_increment_initial = 0
+            and cost_type in ('call_pstn_outgoing', 'call_pstn_incoming', 'call_vn', 'tts', 'recording');
+    """)
+
+
+def downgrade():
+    op.execute("""
+        update billing_billings set
+            billable_units = billed_duration
+        where rate_increment_initial > 0;
+    """)
+    op.execute("""alter table billing_billings drop column billed_duration;""")
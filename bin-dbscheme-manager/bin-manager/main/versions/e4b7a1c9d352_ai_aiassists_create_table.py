@@ -0,0 +1,57 @@
+"""ai_aiassists_create_table
+
+Revision ID: e4b7a1c9d352
+Revises: c6a2e9d4f183
+Create Date: 2026-10-26 09:48:33.617204
+
+"""
+from alembic import op
+
+
+# revision identifiers, used by Alembic.
+revision = 'e4b7a1c9d352'
+down_revision = 'c6a2e9d4f183'
+branch_labels = None
+depends_on = None
+
+
+def upgrade():
+    op.execute("""CREATE TABLE ai_aiassists (
+        id            BINARY(16) NOT NULL,
+        customer_id   BINARY(16) NOT NULL,
+
+        owner_type    VARCHAR(255) NOT NULL,
+        owner_id      BINARY(16) NOT NULL,
+
+        ai_id         BINARY(16) NOT NULL,
+
+        reference_type    VARCHAR(255) NOT NULL,
+        reference_id      BINARY(16) NOT NULL,
+        transcribe_id     BINARY(16) NOT NULL,
+
+        status    VARCHAR(16) NOT NULL,
+        language  VARCHAR(16) NOT NULL,
+
+        checklist     JSON,
+        sentiment     VARCHAR(16) NOT NULL DEFAULT '',
+
+        prompt_tokens     BIGINT NOT NULL DEFAULT 0,
+        completion_tokens BIGINT NOT NULL DEFAULT 0,
+        cached_tokens     BIGINT NOT NULL DEFAULT 0,
+        stt_seconds       DOUBLE NOT NULL DEFAULT 0,
+        tts_seconds       DOUBLE NOT NULL DEFAULT 0,
+
+        tm_create DATETIME(6),
+        tm_update DATETIME(6),
+        tm_delete DATETIME(6),
+
+        PRIMARY KEY(id)
+    );""")
+    op.execute("""CREATE INDEX idx_ai_aiassists_customer_id ON ai_aiassists(customer_id);""")
+    op.execute("""CREATE INDEX idx_ai_aiassists_owner_id ON ai_aiassists(owner_id);""")
+    op.execute("""CREATE INDEX idx_ai_aiassists_reference_id ON ai_aiassists(reference_id);""")
+    op.execute("""CREATE INDEX idx_ai_aiassists_transcribe_id ON ai_aiassists(transcribe_id);""")
+
+
+def downgrade():
+    op.execute("""drop table ai_aiassists;""")
@@ -0,0 +1,56 @@
+"""outdial_create_outdialtargetjobs
+
+Revision ID: ef42a7208301
+Revises: ba620359f0a5
+Create Date: 2026-10-19 08:45:09.447495
+
+"""
+from alembic import op
+
+
+# revision identifiers, used by Alembic.
+revision = 'ef42a7208301'
+down_revision = 'ba620359f0a5'
+branch_labels = None
+depends_on = None
+
+
+def upgrade():
+    op.execute("""
+        create table outdial_outdialtargetjobs(
+            -- identity
+            id          binary(16),
+            customer_id binary(16),
+
+            outdial_id  binary(16),
+
+            type    varchar(255),
+            status  varchar(255),
+
+            file_id binary(16),
+            mapping json,
+
+            total_count     integer,
+            processed_count integer,
+            success_count   integer,
+            duplicate_count integer,
+            error_count     integer,
+
+            error_file_id binary(16),
+
+            detail    text,
+
+            -- timestamps
+            tm_create datetime(6),  -- create
+            tm_update datetime(6),  -- update
+            tm_delete datetime(6),  -- delete
+
+            primary key(id)
+        );
+    """)
+    op.execute("""create index idx_outdial_outdialtargetjobs_customer_id on outdial_outdialtargetjobs(customer_id);""")
+    op.execute("""create index idx_outdial_outdialtargetjobs_outdial_id on outdial_outdialtargetjobs(outdial_id);""")
+
+
+def downgrade():
+    op.execute("""drop table outdial_outdialtargetjobs;""")
@@ -0,0 +1,23 @@
+"""ai_ais_add_column_response_cache
+
+Revision ID: f2c8b5d1a7e4
+Revises: e4b7a1c9d352
+Create Date: 2026-10-27 14:12:46.208531
+
+"""
+from alembic import op
+
+
+# revision identifiers, used by Alembic.
+revision = 'f2c8b5d1a7e4'
+down_revision = 'e4b7a1c9d352'
+branch_labels = None
+depends_on = None
+
+
+def upgrade():
+    op.execute("""ALTER TABLE ai_ais ADD COLUMN response_cache JSON AFTER guardrail;""")
+
+
+def downgrade():
+    op.execute("""ALTER TABLE ai_ais DROP COLUMN response_cache;""")
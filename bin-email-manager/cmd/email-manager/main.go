@@ -12,6 +12,7 @@ import (
 	"monorepo/bin-common-handler/pkg/notifyhandler"
 	"monorepo/bin-common-handler/pkg/requesthandler"
 	"monorepo/bin-common-handler/pkg/sockhandler"
+	"monorepo/bin-common-handler/pkg/tracehandler"
 	"monorepo/bin-email-manager/internal/config"
 	"monorepo/bin-email-manager/pkg/emailhandler"
 
@@ -63,6 +64,10 @@ func runService() error {
 	// Initialize Prometheus
 	initProm(cfg.PrometheusEndpoint, cfg.PrometheusListenAddress)
 
+	// init tracing
+	tracehandler.Init(serviceName)
+	defer tracehandler.Shutdown()
+
 	// create dbhandler
 	dbHandler, err := createDBHandler(cfg)
 	if err != nil {
@@ -133,7 +138,7 @@ func run(dbHandler dbhandler.DBHandler, cfg *config.Config) {
 	log := logrus.WithField("func", "run")
 
 	// rabbitmq sock connect
-	sockHandler := sockhandler.NewSockHandler(sock.TypeRabbitMQ, cfg.RabbitMQAddress)
+	sockHandler := sockhandler.NewSockHandler(sock.Type(cfg.SockType), cfg.RabbitMQAddress)
 	sockHandler.Connect()
 
 	// create handlers
@@ -18,6 +18,7 @@ var (
 // Config holds all configuration for the email-manager service
 type Config struct {
 	RabbitMQAddress         string
+	SockType                string
 	PrometheusEndpoint      string
 	PrometheusListenAddress string
 	DatabaseDSN             string
@@ -43,6 +44,7 @@ func bindConfig(cmd *cobra.Command) error {
 	f := cmd.PersistentFlags()
 
 	f.String("rabbitmq_address", "", "RabbitMQ server address")
+	f.String("sock_type", "rabbitMQ", "Message broker type. rabbitMQ or nats")
 	f.String("prometheus_endpoint", "", "Prometheus metrics endpoint")
 	f.String("prometheus_listen_address", "", "Prometheus listen address")
 	f.String("database_dsn", "", "Database connection DSN")
@@ -54,6 +56,7 @@ func bindConfig(cmd *cobra.Command) error {
 
 	bindings := map[string]string{
 		"rabbitmq_address":          "RABBITMQ_ADDRESS",
+		"sock_type":                 "SOCK_TYPE",
 		"prometheus_endpoint":       "PROMETHEUS_ENDPOINT",
 		"prometheus_listen_address": "PROMETHEUS_LISTEN_ADDRESS",
 		"database_dsn":              "DATABASE_DSN",
@@ -87,6 +90,7 @@ func LoadGlobalConfig() {
 	once.Do(func() {
 		globalConfig = Config{
 			RabbitMQAddress:         viper.GetString("rabbitmq_address"),
+			SockType:                viper.GetString("sock_type"),
 			PrometheusEndpoint:      viper.GetString("prometheus_endpoint"),
 			PrometheusListenAddress: viper.GetString("prometheus_listen_address"),
 			DatabaseDSN:             viper.GetString("database_dsn"),
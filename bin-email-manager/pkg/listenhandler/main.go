@@ -13,6 +13,7 @@ import (
 	cerrors "monorepo/bin-common-handler/models/errors"
 	"monorepo/bin-common-handler/models/sock"
 	"monorepo/bin-common-handler/pkg/sockhandler"
+	"monorepo/bin-common-handler/pkg/tracehandler"
 	"monorepo/bin-common-handler/pkg/utilhandler"
 	"monorepo/bin-email-manager/pkg/dbhandler"
 	"monorepo/bin-email-manager/pkg/emailhandler"
@@ -149,7 +150,8 @@ func (h *listenHandler) processRequest(m *sock.Request) (*sock.Response, error)
 		"request": m,
 	})
 
-	ctx := context.Background()
+	ctx, span := tracehandler.StartServerSpan(context.Background(), m)
+	defer span.End()
 
 	var requestType string
 	var err error
@@ -60,6 +60,11 @@ const (
 	// required media: call
 	TypeAnswer Type = "answer"
 
+	// TypeAIExtract extracts structured data with ai.
+	// ai-manager
+	// required media: none
+	TypeAIExtract Type = "ai_extract"
+
 	// TypeAISummary starts a summary with ai.
 	// ai-manager
 	// required media: none
@@ -150,6 +155,11 @@ const (
 	// required media: call
 	TypeDigitsSend Type = "digits_send"
 
+	// TypeDispositionSet sets the disposition of the activeflow's campaigncall.
+	// campaign-manager
+	// required media: none
+	TypeDispositionSet Type = "disposition_set"
+
 	// TypeEcho echo the sound.
 	// call-manager
 	// required media: call
@@ -275,6 +285,7 @@ const (
 var TypeListAll []Type = []Type{
 	TypeAMD,
 	TypeAnswer,
+	TypeAIExtract,
 	TypeAISummary,
 	TypeAITalk,
 	TypeAITask,
@@ -293,6 +304,7 @@ var TypeListAll []Type = []Type{
 	TypeConversationSend,
 	TypeDigitsReceive,
 	TypeDigitsSend,
+	TypeDispositionSet,
 	TypeEcho,
 	TypeEmailSend,
 	TypeExternalMediaStart,
@@ -329,6 +341,7 @@ const (
 var MapRequiredMediasByType = map[Type][]MediaType{
 	TypeAMD:                 {MediaTypeRealTimeCommunication},
 	TypeAnswer:              {MediaTypeRealTimeCommunication},
+	TypeAIExtract:           {MediaTypeNonRealTimeCommunication},
 	TypeAISummary:           {MediaTypeNonRealTimeCommunication},
 	TypeAITalk:              {MediaTypeNone},
 	TypeAITask:              {MediaTypeNonRealTimeCommunication},
@@ -347,6 +360,7 @@ var MapRequiredMediasByType = map[Type][]MediaType{
 	TypeConversationSend:    {MediaTypeNone},
 	TypeDigitsReceive:       {MediaTypeRealTimeCommunication},
 	TypeDigitsSend:          {MediaTypeRealTimeCommunication},
+	TypeDispositionSet:      {MediaTypeNone},
 	TypeEcho:                {MediaTypeRealTimeCommunication},
 	TypeEmailSend:           {MediaTypeNone},
 	TypeEmpty:               {MediaTypeNone},
@@ -20,6 +20,7 @@ import (
 	commonaddress "monorepo/bin-common-handler/models/address"
 
 	amaicall "monorepo/bin-ai-manager/models/aicall"
+	amextraction "monorepo/bin-ai-manager/models/extraction"
 	amsummary "monorepo/bin-ai-manager/models/summary"
 	ememail "monorepo/bin-email-manager/models/email"
 
@@ -65,6 +66,16 @@ func ValidateAnonymous(anonymous string) bool {
 	return validAnonymousValues[anonymous]
 }
 
+// OptionAIExtract defines action ai_extract's option.
+type OptionAIExtract struct {
+	OnEndFlowID   uuid.UUID                  `json:"on_end_flow_id,omitempty"` // flow id for the end of extraction.
+	ReferenceType amextraction.ReferenceType `json:"reference_type,omitempty"`
+	ReferenceID   uuid.UUID                  `json:"reference_id,omitempty"`
+	Language      string                     `json:"language,omitempty"`
+	Schema        map[string]any             `json:"schema,omitempty"`        // json schema of the extracted data.
+	SetVariables  bool                       `json:"set_variables,omitempty"` // if true, sets each extracted field as a flow variable.
+}
+
 // OptionAISummary defines action ai_summary's option.
 type OptionAISummary struct {
 	OnEndFlowID   uuid.UUID               `json:"on_end_flow_id,omitempty"` // flow id for the end of recording.
@@ -250,6 +261,11 @@ type OptionDigitsSend struct {
 	Interval int    `json:"interval,omitempty"` // Interval between sending keys in milliseconds. Allowed values: Between 0 and 5000.
 }
 
+// OptionDispositionSet defines action disposition_set's option.
+type OptionDispositionSet struct {
+	Disposition string `json:"disposition,omitempty"` // the customer's disposition code. e.g. "sale"
+}
+
 // OptionEcho struct
 type OptionEcho struct {
 	Duration int `json:"duration,omitempty"`
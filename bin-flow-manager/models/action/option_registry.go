@@ -27,6 +27,7 @@ package action
 var OptionStructByType = map[Type]any{
 	TypeAMD:                 OptionAMD{},
 	TypeAnswer:              OptionAnswer{},
+	TypeAIExtract:           OptionAIExtract{},
 	TypeAISummary:           OptionAISummary{},
 	TypeAITalk:              OptionAITalk{},
 	TypeAITask:              OptionAITask{},
@@ -45,6 +46,7 @@ var OptionStructByType = map[Type]any{
 	TypeConversationSend:    OptionConversationSend{},
 	TypeDigitsReceive:       OptionDigitsReceive{},
 	TypeDigitsSend:          OptionDigitsSend{},
+	TypeDispositionSet:      OptionDispositionSet{},
 	TypeEcho:                OptionEcho{},
 	TypeEmailSend:           OptionEmailSend{},
 	TypeExternalMediaStart:  OptionExternalMediaStart{},
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	amaicall "monorepo/bin-ai-manager/models/aicall"
+	amextraction "monorepo/bin-ai-manager/models/extraction"
 	amsummary "monorepo/bin-ai-manager/models/summary"
 	commonaddress "monorepo/bin-common-handler/models/address"
 	ememail "monorepo/bin-email-manager/models/email"
@@ -1135,6 +1136,66 @@ func Test_OptionTranscribeRecording(t *testing.T) {
 	}
 }
 
+func Test_marshal_OptionAIExtract(t *testing.T) {
+	type test struct {
+		name string
+
+		option []byte
+
+		expectedRes OptionAIExtract
+	}
+
+	tests := []test{
+		{
+			name: "normal",
+
+			option: []byte(`{
+				"on_end_flow_id":"8ffb2d5e-cbca-11f1-8ac9-02fc00000001",
+				"reference_type":"call",
+				"reference_id":"8ffb2f2a-cbca-11f1-8ac9-02fc00000001",
+				"language":"en-US",
+				"schema":{"type":"object","properties":{"intent":{"type":"string"}}},
+				"set_variables":true
+			}`),
+
+			expectedRes: OptionAIExtract{
+				OnEndFlowID:   uuid.FromStringOrNil("8ffb2d5e-cbca-11f1-8ac9-02fc00000001"),
+				ReferenceType: amextraction.ReferenceTypeCall,
+				ReferenceID:   uuid.FromStringOrNil("8ffb2f2a-cbca-11f1-8ac9-02fc00000001"),
+				Language:      "en-US",
+				Schema: map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"intent": map[string]any{"type": "string"},
+					},
+				},
+				SetVariables: true,
+			},
+		},
+		{
+			name: "empty",
+
+			option: []byte(`{}`),
+
+			expectedRes: OptionAIExtract{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			res := OptionAIExtract{}
+			if err := json.Unmarshal(tt.option, &res); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(tt.expectedRes, res) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectedRes, res)
+			}
+		})
+	}
+}
+
 func Test_marshal_OptionAISummary(t *testing.T) {
 	type test struct {
 		name string
@@ -10,6 +10,8 @@ import (
 
 	cmconfbridge "monorepo/bin-call-manager/models/confbridge"
 
+	cacampaigncall "monorepo/bin-campaign-manager/models/campaigncall"
+
 	amaicall "monorepo/bin-ai-manager/models/aicall"
 
 	cfconferencecall "monorepo/bin-conference-manager/models/conferencecall"
@@ -1153,6 +1155,53 @@ func (h *activeflowHandler) actionHandleEmailSend(ctx context.Context, af *activ
 	return nil
 }
 
+// actionHandleAIExtract handles action ai_extract with activeflow.
+// it starts ai extract service.
+func (h *activeflowHandler) actionHandleAIExtract(ctx context.Context, af *activeflow.Activeflow) error {
+	log := logrus.WithFields(logrus.Fields{
+		"func":          "actionHandleAIExtract",
+		"activeflow_id": af.ID,
+	})
+	log.WithField("action", af.CurrentAction).Debugf("Executing action handle. type: %s, action_id: %s", af.CurrentAction.Type, af.CurrentAction.ID)
+
+	act := &af.CurrentAction
+
+	tmpOption, err := json.Marshal(act.Option)
+	if err != nil {
+		return errors.Wrapf(err, "could not marshal the option. err: %v", err)
+	}
+
+	var opt action.OptionAIExtract
+	if err := json.Unmarshal(tmpOption, &opt); err != nil {
+		return errors.Wrapf(err, "Could not unmarshal the option. err: %v", err)
+	}
+
+	// start service
+	sv, err := h.reqHandler.AIV1ServiceTypeExtractionStart(
+		ctx,
+		af.CustomerID,
+		af.ID,
+		opt.OnEndFlowID,
+		opt.ReferenceType,
+		opt.ReferenceID,
+		opt.Language,
+		opt.Schema,
+		opt.SetVariables,
+		60000,
+	)
+	if err != nil {
+		return errors.Wrap(err, "Could not start the service.")
+	}
+	log.WithField("service", sv).Debugf("Started service. service_type: %s, service_id: %s", sv.Type, sv.ID)
+
+	// push the actions
+	if errPush := h.PushStack(ctx, af, sv.ID, sv.PushActions); errPush != nil {
+		return errors.Wrapf(errPush, "Could not push the actions to the stack.")
+	}
+
+	return nil
+}
+
 // actionHandleAISummary handles action ai_summary with activeflow.
 // it starts ai summary service.
 func (h *activeflowHandler) actionHandleAISummary(ctx context.Context, af *activeflow.Activeflow) error {
@@ -1403,3 +1452,46 @@ func (h *activeflowHandler) actionHandleCaseCreate(ctx context.Context, af *acti
 	return nil
 }
 
+
+// actionHandleDispositionSet handles action disposition_set with activeflow.
+// It sets the disposition of the campaigncall which runs the activeflow.
+func (h *activeflowHandler) actionHandleDispositionSet(ctx context.Context, af *activeflow.Activeflow) error {
+	log := logrus.WithFields(logrus.Fields{
+		"func":          "actionHandleDispositionSet",
+		"activeflow_id": af.ID,
+	})
+	log.WithField("action", af.CurrentAction).Debugf("Executing action handle. type: %s, action_id: %s", af.CurrentAction.Type, af.CurrentAction.ID)
+
+	tmpOption, err := json.Marshal(af.CurrentAction.Option)
+	if err != nil {
+		return errors.Wrapf(err, "could not marshal the option. err: %v", err)
+	}
+
+	var opt action.OptionDispositionSet
+	if errUnmarshal := json.Unmarshal(tmpOption, &opt); errUnmarshal != nil {
+		log.Errorf("Could not unmarshal the option. err: %v", errUnmarshal)
+		return fmt.Errorf("could not unmarshal the option. err: %v", errUnmarshal)
+	}
+
+	filters := map[cacampaigncall.Field]any{
+		cacampaigncall.FieldActiveflowID: af.ID,
+		cacampaigncall.FieldDeleted:      false,
+	}
+	ccs, err := h.reqHandler.CampaignV1CampaigncallList(ctx, "", 1, filters)
+	if err != nil {
+		return errors.Wrapf(err, "could not get the campaigncall")
+	}
+	if len(ccs) == 0 {
+		// the activeflow was not started by the campaign.
+		log.Infof("The activeflow has no campaigncall. Skipping the disposition_set.")
+		return nil
+	}
+
+	cc, err := h.reqHandler.CampaignV1CampaigncallUpdateDisposition(ctx, ccs[0].ID, opt.Disposition)
+	if err != nil {
+		return errors.Wrapf(err, "could not update the campaigncall's disposition")
+	}
+	log.WithField("campaigncall", cc).Debugf("Updated the campaigncall's disposition. campaigncall_id: %s, disposition: %s", cc.ID, cc.Disposition)
+
+	return nil
+}
@@ -0,0 +1,160 @@
+package activeflowhandler
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	cacampaigncall "monorepo/bin-campaign-manager/models/campaigncall"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/requesthandler"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+
+	"monorepo/bin-flow-manager/models/action"
+	"monorepo/bin-flow-manager/models/activeflow"
+)
+
+func Test_actionHandleDispositionSet(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		af *activeflow.Activeflow
+
+		responseCampaigncalls []cacampaigncall.Campaigncall
+		responseCampaigncall  *cacampaigncall.Campaigncall
+
+		expectFilters     map[cacampaigncall.Field]any
+		expectDisposition string
+	}{
+		{
+			name: "normal",
+
+			af: &activeflow.Activeflow{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("8c2a6f1e-ad7c-11f0-8f3b-2e3f4a5b6c01"),
+					CustomerID: uuid.FromStringOrNil("8c59803a-ad7c-11f0-9a4c-3f4a5b6c7d02"),
+				},
+				CurrentAction: action.Action{
+					ID:   uuid.FromStringOrNil("8c889156-ad7c-11f0-a55d-4a5b6c7d8e03"),
+					Type: action.TypeDispositionSet,
+					Option: map[string]any{
+						"disposition": "callback_requested",
+					},
+				},
+			},
+
+			responseCampaigncalls: []cacampaigncall.Campaigncall{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("8cb7a272-ad7c-11f0-b16e-5b6c7d8e9f04"),
+					},
+				},
+			},
+			responseCampaigncall: &cacampaigncall.Campaigncall{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("8cb7a272-ad7c-11f0-b16e-5b6c7d8e9f04"),
+				},
+				Disposition: "callback_requested",
+			},
+
+			expectFilters: map[cacampaigncall.Field]any{
+				cacampaigncall.FieldActiveflowID: uuid.FromStringOrNil("8c2a6f1e-ad7c-11f0-8f3b-2e3f4a5b6c01"),
+				cacampaigncall.FieldDeleted:      false,
+			},
+			expectDisposition: "callback_requested",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+
+			h := &activeflowHandler{
+				reqHandler: mockReq,
+			}
+
+			ctx := context.Background()
+
+			mockReq.EXPECT().CampaignV1CampaigncallList(ctx, "", uint64(1), tt.expectFilters).Return(tt.responseCampaigncalls, nil)
+			mockReq.EXPECT().CampaignV1CampaigncallUpdateDisposition(ctx, tt.responseCampaigncalls[0].ID, tt.expectDisposition).Return(tt.responseCampaigncall, nil)
+
+			if errHandle := h.actionHandleDispositionSet(ctx, tt.af); errHandle != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", errHandle)
+			}
+		})
+	}
+}
+
+func Test_actionHandleDispositionSet_noCampaigncall(t *testing.T) {
+
+	af := &activeflow.Activeflow{
+		Identity: commonidentity.Identity{
+			ID: uuid.FromStringOrNil("8ce6b38e-ad7c-11f0-8c7f-6c7d8e9f0a05"),
+		},
+		CurrentAction: action.Action{
+			Type: action.TypeDispositionSet,
+			Option: map[string]any{
+				"disposition": "sale",
+			},
+		},
+	}
+
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockReq := requesthandler.NewMockRequestHandler(mc)
+
+	h := &activeflowHandler{
+		reqHandler: mockReq,
+	}
+
+	ctx := context.Background()
+
+	// the activeflow was not started by the campaign. the campaigncall's disposition must not be updated.
+	mockReq.EXPECT().CampaignV1CampaigncallList(ctx, "", uint64(1), gomock.Any()).Return([]cacampaigncall.Campaigncall{}, nil)
+
+	if errHandle := h.actionHandleDispositionSet(ctx, af); errHandle != nil {
+		t.Errorf("Wrong match. expect: ok, got: %v", errHandle)
+	}
+}
+
+func Test_actionHandleDispositionSet_error(t *testing.T) {
+
+	af := &activeflow.Activeflow{
+		Identity: commonidentity.Identity{
+			ID: uuid.FromStringOrNil("8d15c4aa-ad7c-11f0-9d80-7d8e9f0a1b06"),
+		},
+		CurrentAction: action.Action{
+			Type: action.TypeDispositionSet,
+			Option: map[string]any{
+				"disposition": "invalid",
+			},
+		},
+	}
+
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockReq := requesthandler.NewMockRequestHandler(mc)
+
+	h := &activeflowHandler{
+		reqHandler: mockReq,
+	}
+
+	ctx := context.Background()
+
+	campaigncallID := uuid.FromStringOrNil("8d44d5c6-ad7c-11f0-a891-8e9f0a1b2c07")
+	mockReq.EXPECT().CampaignV1CampaigncallList(ctx, "", uint64(1), gomock.Any()).Return([]cacampaigncall.Campaigncall{{Identity: commonidentity.Identity{ID: campaigncallID}}}, nil)
+	mockReq.EXPECT().CampaignV1CampaigncallUpdateDisposition(ctx, campaigncallID, "invalid").Return(nil, fmt.Errorf("unknown disposition"))
+
+	if errHandle := h.actionHandleDispositionSet(ctx, af); errHandle == nil {
+		t.Errorf("Wrong match. expect: error, got: ok")
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	amaicall "monorepo/bin-ai-manager/models/aicall"
+	amextraction "monorepo/bin-ai-manager/models/extraction"
 	amsummary "monorepo/bin-ai-manager/models/summary"
 	cmcall "monorepo/bin-call-manager/models/call"
 	cmconfbridge "monorepo/bin-call-manager/models/confbridge"
@@ -5103,6 +5104,138 @@ func Test_actionHandleAISummary(t *testing.T) {
 	}
 }
 
+func Test_actionHandleAIExtract(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		activeflow *activeflow.Activeflow
+
+		responseService *commonservice.Service
+		responseStack   *stack.Stack
+
+		expectedCustomerID    uuid.UUID
+		expectedActiveflowID  uuid.UUID
+		expectedOnEndFlowID   uuid.UUID
+		expectedReferenceType amextraction.ReferenceType
+		expectedReferenceID   uuid.UUID
+		expectedLanguage      string
+		expectedSchema        map[string]any
+		expectedSetVariables  bool
+	}{
+		{
+			name: "normal",
+
+			activeflow: &activeflow.Activeflow{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("8ffaf8c0-cbca-11f1-8ac9-02fc00000001"),
+					CustomerID: uuid.FromStringOrNil("8ffaf9ba-cbca-11f1-8ac9-02fc00000001"),
+				},
+				ReferenceType: activeflow.ReferenceTypeCall,
+				ReferenceID:   uuid.FromStringOrNil("8ffafa50-cbca-11f1-8ac9-02fc00000001"),
+				CurrentAction: action.Action{
+					ID:   uuid.FromStringOrNil("8ffafac8-cbca-11f1-8ac9-02fc00000001"),
+					Type: action.TypeAIExtract,
+					Option: map[string]any{
+						"on_end_flow_id": "8ffafb7c-cbca-11f1-8ac9-02fc00000001",
+						"reference_type": "call",
+						"reference_id":   "8ffafbea-cbca-11f1-8ac9-02fc00000001",
+						"language":       "en-US",
+						"schema": map[string]any{
+							"type": "object",
+							"properties": map[string]any{
+								"order_id": map[string]any{"type": "string"},
+							},
+						},
+						"set_variables": true,
+					},
+				},
+
+				StackMap: map[uuid.UUID]*stack.Stack{},
+			},
+
+			responseService: &commonservice.Service{
+				ID:   uuid.FromStringOrNil("8ffafcda-cbca-11f1-8ac9-02fc00000001"),
+				Type: commonservice.TypeAIExtract,
+				PushActions: []action.Action{
+					{
+						ID: uuid.FromStringOrNil("8ffafd70-cbca-11f1-8ac9-02fc00000001"),
+					},
+				},
+			},
+			responseStack: &stack.Stack{
+				ID: uuid.FromStringOrNil("8ffafcda-cbca-11f1-8ac9-02fc00000001"),
+				Actions: []action.Action{
+					{
+						ID: uuid.FromStringOrNil("8ffafd70-cbca-11f1-8ac9-02fc00000001"),
+					},
+				},
+			},
+
+			expectedCustomerID:    uuid.FromStringOrNil("8ffaf9ba-cbca-11f1-8ac9-02fc00000001"),
+			expectedActiveflowID:  uuid.FromStringOrNil("8ffaf8c0-cbca-11f1-8ac9-02fc00000001"),
+			expectedOnEndFlowID:   uuid.FromStringOrNil("8ffafb7c-cbca-11f1-8ac9-02fc00000001"),
+			expectedReferenceType: amextraction.ReferenceTypeCall,
+			expectedReferenceID:   uuid.FromStringOrNil("8ffafbea-cbca-11f1-8ac9-02fc00000001"),
+			expectedLanguage:      "en-US",
+			expectedSchema: map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"order_id": map[string]any{"type": "string"},
+				},
+			},
+			expectedSetVariables: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockAction := actionhandler.NewMockActionHandler(mc)
+			mockVariable := variablehandler.NewMockVariableHandler(mc)
+			mockStack := stackmaphandler.NewMockStackmapHandler(mc)
+
+			h := &activeflowHandler{
+				db:         mockDB,
+				reqHandler: mockReq,
+
+				actionHandler:   mockAction,
+				variableHandler: mockVariable,
+				stackmapHandler: mockStack,
+			}
+
+			ctx := context.Background()
+
+			mockReq.EXPECT().AIV1ServiceTypeExtractionStart(
+				ctx,
+				tt.expectedCustomerID,
+				tt.expectedActiveflowID,
+				tt.expectedOnEndFlowID,
+				tt.expectedReferenceType,
+				tt.expectedReferenceID,
+				tt.expectedLanguage,
+				tt.expectedSchema,
+				tt.expectedSetVariables,
+				60000,
+			).Return(tt.responseService, nil)
+
+			// push stack
+			mockStack.EXPECT().PushStackByActions(tt.activeflow.StackMap, tt.responseService.ID, tt.responseService.PushActions, tt.activeflow.CurrentStackID, tt.activeflow.CurrentAction.ID).Return(tt.responseStack, nil)
+			mockDB.EXPECT().ActiveflowUpdate(ctx, tt.activeflow.ID, gomock.Any()).Return(nil)
+
+			if errCall := h.actionHandleAIExtract(ctx, tt.activeflow); errCall != nil {
+				t.Errorf("Wrong match.\nexpect: ok\ngot: %v", errCall)
+			}
+
+			time.Sleep(500 * time.Millisecond)
+		})
+	}
+}
+
 func Test_actionHandleBlock(t *testing.T) {
 
 	tests := []struct {
@@ -158,6 +158,13 @@ func (h *activeflowHandler) executeAction(ctx context.Context, af *activeflow.Ac
 	}()
 
 	switch actionType {
+	case action.TypeAIExtract:
+		if errHandle := h.actionHandleAIExtract(ctx, af); errHandle != nil {
+			log.Errorf("Could not handle the ai extract action correctly. err: %v", errHandle)
+			return nil, errHandle
+		}
+		return &action.ActionNext, nil
+
 	case action.TypeAISummary:
 		if errHandle := h.actionHandleAISummary(ctx, af); errHandle != nil {
 			log.Errorf("Could not handle the ai summary action correctly. err: %v", errHandle)
@@ -252,6 +259,12 @@ func (h *activeflowHandler) executeAction(ctx context.Context, af *activeflow.Ac
 		}
 		return &action.ActionNext, nil
 
+	case action.TypeDispositionSet:
+		if errHandle := h.actionHandleDispositionSet(ctx, af); errHandle != nil {
+			log.Errorf("Could not set the disposition correctly. err: %v", errHandle)
+		}
+		return &action.ActionNext, nil
+
 	case action.TypeEmailSend:
 		if errHandle := h.actionHandleEmailSend(ctx, af); errHandle != nil {
 			log.Errorf("Could not send the email correctly. err: %v", errHandle)
@@ -241,6 +241,7 @@ var (
 
 // actionDispatchTarget maps action types to the service they dispatch to.
 var actionDispatchTarget = map[action.Type]string{
+	action.TypeAIExtract:          "ai-manager",
 	action.TypeAISummary:          "ai-manager",
 	action.TypeAITalk:             "ai-manager",
 	action.TypeAITask:             "ai-manager",
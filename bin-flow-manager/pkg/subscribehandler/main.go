@@ -12,6 +12,7 @@ import (
 	commonoutline "monorepo/bin-common-handler/models/outline"
 	"monorepo/bin-common-handler/models/sock"
 	"monorepo/bin-common-handler/pkg/sockhandler"
+	"monorepo/bin-common-handler/pkg/tracehandler"
 
 	cmcustomer "monorepo/bin-customer-manager/models/customer"
 	"monorepo/bin-flow-manager/pkg/activeflowhandler"
@@ -118,7 +119,8 @@ func (h *subscribeHandler) processEvent(m *sock.Event) {
 		"func":    "processEvent",
 		"message": m,
 	})
-	ctx := context.Background()
+	ctx, span := tracehandler.StartConsumerSpan(context.Background(), m)
+	defer span.End()
 
 	var err error
 	start := time.Now()
@@ -9,6 +9,7 @@ import (
 	nmnumber "monorepo/bin-number-manager/models/number"
 
 	commonaddress "monorepo/bin-common-handler/models/address"
+	omsuppression "monorepo/bin-outdial-manager/models/suppression"
 
 	"github.com/gofrs/uuid"
 	"github.com/pkg/errors"
@@ -39,9 +40,41 @@ func (h *messageHandler) Hook(ctx context.Context, uri string, data []byte) erro
 		return nil
 	}
 
+	if isOptOutKeyword(m.Text) {
+		h.optOut(ctx, num.CustomerID, m)
+	}
+
 	return nil
 }
 
+// isOptOutKeyword returns true if the given text is an opt-out keyword reply.
+func isOptOutKeyword(text string) bool {
+	_, ok := optOutKeywords[strings.ToUpper(strings.TrimSpace(text))]
+	return ok
+}
+
+// optOut adds the inbound message's sender to the customer's opt-out suppression list.
+func (h *messageHandler) optOut(ctx context.Context, customerID uuid.UUID, m *message.Message) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":        "optOut",
+		"customer_id": customerID,
+		"message_id":  m.ID,
+	})
+
+	if m.Source == nil || m.Source.Type != commonaddress.TypeTel {
+		// nothing to do.
+		return
+	}
+
+	detail := fmt.Sprintf("Opted out by the sms reply. message_id: %s", m.ID)
+	tmp, err := h.reqHandler.OutdialV1SuppressionCreate(ctx, customerID, omsuppression.ListTypeOptOut, m.Source.Target, detail)
+	if err != nil {
+		log.Errorf("Could not add the sender to the opt-out list. err: %v", err)
+		return
+	}
+	log.WithField("suppression", tmp).Debugf("Added the sender to the opt-out list. suppression_id: %s", tmp.ID)
+}
+
 // hookTelnyx telnyx type hook message.
 func (h *messageHandler) hookTelnyx(ctx context.Context, data []byte) (*message.Message, *nmnumber.Number, error) {
 	log := logrus.WithFields(logrus.Fields{
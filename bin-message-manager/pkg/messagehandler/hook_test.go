@@ -4,12 +4,14 @@ import (
 	"context"
 	"testing"
 
+	commonaddress "monorepo/bin-common-handler/models/address"
 	commonidentity "monorepo/bin-common-handler/models/identity"
 	"monorepo/bin-common-handler/pkg/notifyhandler"
 	"monorepo/bin-common-handler/pkg/requesthandler"
 	"monorepo/bin-common-handler/pkg/utilhandler"
 
 	nmnumber "monorepo/bin-number-manager/models/number"
+	omsuppression "monorepo/bin-outdial-manager/models/suppression"
 
 	"github.com/gofrs/uuid"
 	gomock "go.uber.org/mock/gomock"
@@ -143,3 +145,150 @@ func Test_Hook(t *testing.T) {
 		})
 	}
 }
+
+func Test_Hook_optOut(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		uri  string
+		data []byte
+
+		responseUUID    uuid.UUID
+		responseNumbers []nmnumber.Number
+		responseMessage *message.Message
+
+		expectCustomerID uuid.UUID
+		expectTarget     string
+		expectDetail     string
+	}{
+		{
+			name: "normal",
+
+			uri: "https://hook.voipbin.net/v1.0/hooks/telnyx",
+			data: []byte(`{
+				"data": {
+				  "event_type": "message.received",
+				  "id": "4c0d2e6a-3c22-11f1-9f3a-8b1d5c7e2a01",
+				  "payload": {
+					"direction": "inbound",
+					"from": {
+					  "phone_number": "+821100000002"
+					},
+					"id": "4c3b7f1c-3c22-11f1-a1c6-2e9f4d8b3b02",
+					"parts": 1,
+					"text": " Stop ",
+					"to": [
+					  {
+						"phone_number": "+15734531118"
+					  }
+					],
+					"type": "SMS"
+				  },
+				  "record_type": "event"
+				}
+			  }`),
+
+			responseUUID: uuid.FromStringOrNil("4c66a0d4-3c22-11f1-8e5b-5a2c9d1f4c03"),
+			responseNumbers: []nmnumber.Number{
+				{
+					Identity: commonidentity.Identity{
+						ID:         uuid.FromStringOrNil("4c91c38c-3c22-11f1-b3d7-7f4e1a6c5d04"),
+						CustomerID: uuid.FromStringOrNil("4cbce5f6-3c22-11f1-92f8-3d6b8e2f6e05"),
+					},
+					Number: "+15734531118",
+				},
+			},
+			responseMessage: &message.Message{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("4c66a0d4-3c22-11f1-8e5b-5a2c9d1f4c03"),
+					CustomerID: uuid.FromStringOrNil("4cbce5f6-3c22-11f1-92f8-3d6b8e2f6e05"),
+				},
+				Source: &commonaddress.Address{
+					Type:   commonaddress.TypeTel,
+					Target: "+821100000002",
+				},
+				Text:      " Stop ",
+				Direction: message.DirectionInbound,
+			},
+
+			expectCustomerID: uuid.FromStringOrNil("4cbce5f6-3c22-11f1-92f8-3d6b8e2f6e05"),
+			expectTarget:     "+821100000002",
+			expectDetail:     "Opted out by the sms reply. message_id: 4c66a0d4-3c22-11f1-8e5b-5a2c9d1f4c03",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+
+			h := &messageHandler{
+				utilHandler:   mockUtil,
+				db:            mockDB,
+				notifyHandler: mockNotify,
+				reqHandler:    mockReq,
+			}
+			ctx := context.Background()
+
+			mockReq.EXPECT().NumberV1NumberList(ctx, "", uint64(1), gomock.Any()).Return(tt.responseNumbers, nil)
+
+			mockUtil.EXPECT().UUIDCreate().Return(tt.responseUUID)
+			mockDB.EXPECT().MessageCreate(ctx, gomock.Any()).Return(nil)
+			mockDB.EXPECT().MessageGet(ctx, tt.responseUUID).Return(tt.responseMessage, nil)
+			mockNotify.EXPECT().PublishWebhookEvent(ctx, gomock.Any(), message.EventTypeMessageCreated, gomock.Any())
+
+			mockReq.EXPECT().OutdialV1SuppressionCreate(ctx, tt.expectCustomerID, omsuppression.ListTypeOptOut, tt.expectTarget, tt.expectDetail).Return(&omsuppression.Suppression{}, nil)
+
+			if errHook := h.Hook(ctx, tt.uri, tt.data); errHook != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", errHook)
+			}
+		})
+	}
+}
+
+func Test_isOptOutKeyword(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		text string
+
+		expectRes bool
+	}{
+		{
+			name:      "stop",
+			text:      "STOP",
+			expectRes: true,
+		},
+		{
+			name:      "lower case with spaces",
+			text:      "  unsubscribe\n",
+			expectRes: true,
+		},
+		{
+			name:      "sentence",
+			text:      "please stop sending",
+			expectRes: false,
+		},
+		{
+			name:      "empty",
+			text:      "",
+			expectRes: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := isOptOutKeyword(tt.text)
+			if res != tt.expectRes {
+				t.Errorf("Wrong match. expect: %v, got: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
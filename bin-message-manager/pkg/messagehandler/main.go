@@ -24,6 +24,16 @@ const (
 	hookTelnyx = "telnyx"
 )
 
+// optOutKeywords is the set of the inbound sms replies which opt the sender out of the customer's messages.
+var optOutKeywords = map[string]struct{}{
+	"STOP":        {},
+	"STOPALL":     {},
+	"UNSUBSCRIBE": {},
+	"CANCEL":      {},
+	"END":         {},
+	"QUIT":        {},
+}
+
 // MessageHandler defines
 type MessageHandler interface {
 	Get(ctx context.Context, id uuid.UUID) (*message.Message, error)
@@ -6,6 +6,9 @@ import (
 
 	bmbilling "monorepo/bin-billing-manager/models/billing"
 	commonaddress "monorepo/bin-common-handler/models/address"
+	cerrors "monorepo/bin-common-handler/models/errors"
+	commonoutline "monorepo/bin-common-handler/models/outline"
+	omsuppressionblock "monorepo/bin-outdial-manager/models/suppressionblock"
 
 	"github.com/gofrs/uuid"
 	"github.com/pkg/errors"
@@ -43,9 +46,25 @@ func (h *messageHandler) Send(ctx context.Context, id uuid.UUID, customerID uuid
 		destinations[i].Target, _ = commonaddress.NormalizeTarget(destinations[i].Type, destinations[i].Target)
 	}
 
+	// the message id is referenced by the suppression block records,
+	// so it has to be decided before the suppression check.
+	if id == uuid.Nil {
+		id = h.utilHandler.UUIDCreate()
+	}
+
 	// create targets
 	targets := []target.Target{}
 	for _, destination := range destinations {
+		suppressed, err := h.isSuppressedDestination(ctx, customerID, &destination, id)
+		if err != nil {
+			log.Errorf("Could not check the destination's suppression. err: %v", err)
+			return nil, errors.Wrap(err, "could not check the destination's suppression")
+		}
+		if suppressed {
+			log.Infof("The destination is in the suppression lists. Skipping the destination. target: %s", destination.Target)
+			continue
+		}
+
 		t := target.Target{
 			Destination: destination,
 			Status:      target.StatusQueued,
@@ -54,6 +73,15 @@ func (h *messageHandler) Send(ctx context.Context, id uuid.UUID, customerID uuid
 		targets = append(targets, t)
 	}
 
+	if len(targets) == 0 {
+		log.Infof("All destinations are in the suppression lists. message_id: %s", id)
+		return nil, cerrors.FailedPrecondition(
+			commonoutline.ServiceNameMessageManager,
+			"ALL_DESTINATIONS_SUPPRESSED",
+			"All destinations are in the suppression lists.",
+		)
+	}
+
 	// check the balance
 	count := len(targets)
 	valid, err := h.reqHandler.BillingV1AccountIsValidBalanceByCustomerID(ctx, customerID, bmbilling.ReferenceTypeSMS, "", count)
@@ -104,3 +132,13 @@ func (h *messageHandler) Send(ctx context.Context, id uuid.UUID, customerID uuid
 
 	return res, nil
 }
+
+// isSuppressedDestination returns true if the given destination is in the customer's suppression lists.
+// Only the tel type destination is subject to the suppression.
+func (h *messageHandler) isSuppressedDestination(ctx context.Context, customerID uuid.UUID, destination *commonaddress.Address, messageID uuid.UUID) (bool, error) {
+	if destination.Type != commonaddress.TypeTel {
+		return false, nil
+	}
+
+	return h.reqHandler.OutdialV1SuppressionIsSuppressed(ctx, customerID, destination.Target, omsuppressionblock.ReferenceTypeMessage, messageID)
+}
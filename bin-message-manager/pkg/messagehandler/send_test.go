@@ -2,6 +2,7 @@ package messagehandler
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
 	"testing"
@@ -9,11 +10,13 @@ import (
 
 	bmbilling "monorepo/bin-billing-manager/models/billing"
 	commonaddress "monorepo/bin-common-handler/models/address"
+	cerrors "monorepo/bin-common-handler/models/errors"
 	commonidentity "monorepo/bin-common-handler/models/identity"
 	"monorepo/bin-common-handler/pkg/notifyhandler"
 	"monorepo/bin-common-handler/pkg/requesthandler"
 	"monorepo/bin-common-handler/pkg/utilhandler"
 	cucustomer "monorepo/bin-customer-manager/models/customer"
+	omsuppressionblock "monorepo/bin-outdial-manager/models/suppressionblock"
 
 	"github.com/gofrs/uuid"
 	gomock "go.uber.org/mock/gomock"
@@ -154,6 +157,9 @@ func Test_Send(t *testing.T) {
 				IdentityVerificationStatus: cucustomer.IdentityVerificationStatusVerified,
 			}, nil)
 
+			for _, destination := range tt.destinations {
+				mockReq.EXPECT().OutdialV1SuppressionIsSuppressed(ctx, tt.customerID, destination.Target, omsuppressionblock.ReferenceTypeMessage, tt.id).Return(false, nil)
+			}
 			mockReq.EXPECT().BillingV1AccountIsValidBalanceByCustomerID(ctx, tt.customerID, bmbilling.ReferenceTypeSMS, "", len(tt.destinations)).Return(true, nil)
 
 			mockDB.EXPECT().MessageCreate(ctx, tt.expectMessage).Return(nil)
@@ -303,6 +309,9 @@ func Test_Send_NormalizesAddress(t *testing.T) {
 				IdentityVerificationStatus: cucustomer.IdentityVerificationStatusVerified,
 			}, nil)
 
+			for _, destination := range tt.expectDestinations {
+				mockReq.EXPECT().OutdialV1SuppressionIsSuppressed(ctx, tt.customerID, destination.Target, omsuppressionblock.ReferenceTypeMessage, tt.id).Return(false, nil)
+			}
 			mockReq.EXPECT().BillingV1AccountIsValidBalanceByCustomerID(ctx, tt.customerID, bmbilling.ReferenceTypeSMS, "", len(tt.destinations)).Return(true, nil)
 
 			// Capture-by-matcher: inspect the *message.Message persisted by the
@@ -345,3 +354,150 @@ func Test_Send_NormalizesAddress(t *testing.T) {
 		})
 	}
 }
+
+func Test_Send_Suppressed(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		id           uuid.UUID
+		customerID   uuid.UUID
+		source       *commonaddress.Address
+		destinations []commonaddress.Address
+		text         string
+
+		responseSuppressed []bool
+		responseMessage    *message.Message
+
+		expectTargets   []target.Target
+		expectErr       bool
+		expectErrReason string
+	}{
+		{
+			name: "suppressed destination is skipped",
+
+			id:         uuid.FromStringOrNil("2b4f6a8c-3c21-11f1-9a1e-6f3d2b8c4e01"),
+			customerID: uuid.FromStringOrNil("2b7a9c1e-3c21-11f1-8b2f-1e4c7d9a5f02"),
+			source: &commonaddress.Address{
+				Type:   commonaddress.TypeTel,
+				Target: "+821100000001",
+			},
+			destinations: []commonaddress.Address{
+				{
+					Type:   commonaddress.TypeTel,
+					Target: "+821100000002",
+				},
+				{
+					Type:   commonaddress.TypeTel,
+					Target: "+821100000003",
+				},
+			},
+			text: "hello world",
+
+			responseSuppressed: []bool{true, false},
+			responseMessage: &message.Message{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("2b4f6a8c-3c21-11f1-9a1e-6f3d2b8c4e01"),
+					CustomerID: uuid.FromStringOrNil("2b7a9c1e-3c21-11f1-8b2f-1e4c7d9a5f02"),
+				},
+			},
+
+			expectTargets: []target.Target{
+				{
+					Destination: commonaddress.Address{
+						Type:   commonaddress.TypeTel,
+						Target: "+821100000003",
+					},
+					Status: target.StatusQueued,
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "all destinations are suppressed",
+
+			id:         uuid.FromStringOrNil("2ba5c3f0-3c21-11f1-a7c4-3b6e9f1d2a03"),
+			customerID: uuid.FromStringOrNil("2b7a9c1e-3c21-11f1-8b2f-1e4c7d9a5f02"),
+			source: &commonaddress.Address{
+				Type:   commonaddress.TypeTel,
+				Target: "+821100000001",
+			},
+			destinations: []commonaddress.Address{
+				{
+					Type:   commonaddress.TypeTel,
+					Target: "+821100000002",
+				},
+			},
+			text: "hello world",
+
+			responseSuppressed: []bool{true},
+
+			expectErr:       true,
+			expectErrReason: "ALL_DESTINATIONS_SUPPRESSED",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+			mockBird := NewMockMessageHandlerMessagebird(mc)
+			mockTelnyx := NewMockMessageHandlerTelnyx(mc)
+
+			h := &messageHandler{
+				utilHandler:               mockUtil,
+				db:                        mockDB,
+				reqHandler:                mockReq,
+				notifyHandler:             mockNotify,
+				messageHandlerMessagebird: mockBird,
+				messageHandlerTelnyx:      mockTelnyx,
+			}
+			ctx := context.Background()
+
+			mockReq.EXPECT().CustomerV1CustomerGet(ctx, tt.customerID).Return(&cucustomer.Customer{
+				ID:                         tt.customerID,
+				IdentityVerificationStatus: cucustomer.IdentityVerificationStatusVerified,
+			}, nil)
+
+			for i, destination := range tt.destinations {
+				mockReq.EXPECT().OutdialV1SuppressionIsSuppressed(ctx, tt.customerID, destination.Target, omsuppressionblock.ReferenceTypeMessage, tt.id).Return(tt.responseSuppressed[i], nil)
+			}
+
+			if !tt.expectErr {
+				mockReq.EXPECT().BillingV1AccountIsValidBalanceByCustomerID(ctx, tt.customerID, bmbilling.ReferenceTypeSMS, "", len(tt.expectTargets)).Return(true, nil)
+
+				expectTargets := tt.expectTargets
+				mockDB.EXPECT().MessageCreate(ctx, gomock.Cond(func(m *message.Message) bool {
+					return reflect.DeepEqual(m.Targets, expectTargets)
+				})).Return(nil)
+				mockDB.EXPECT().MessageGet(ctx, tt.id).Return(tt.responseMessage, nil)
+				mockNotify.EXPECT().PublishWebhookEvent(ctx, tt.responseMessage.CustomerID, message.EventTypeMessageCreated, tt.responseMessage)
+
+				mockTelnyx.EXPECT().SendMessage(ctx, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("ignored")).AnyTimes()
+				mockBird.EXPECT().SendMessage(ctx, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("ignored")).AnyTimes()
+			}
+
+			res, err := h.Send(ctx, tt.id, tt.customerID, tt.source, tt.destinations, tt.text)
+			if (err != nil) != tt.expectErr {
+				t.Errorf("Wrong match. expect error: %v, got: %v", tt.expectErr, err)
+			}
+			if tt.expectErrReason != "" {
+				var ve *cerrors.VoipbinError
+				if !errors.As(err, &ve) || ve.Status != cerrors.StatusFailedPrecondition || ve.Reason != tt.expectErrReason {
+					t.Errorf("Wrong match. expect reason: %s, got: %v", tt.expectErrReason, err)
+				}
+			}
+
+			time.Sleep(time.Millisecond * 100)
+
+			if !reflect.DeepEqual(tt.responseMessage, res) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.responseMessage, res)
+			}
+		})
+	}
+}
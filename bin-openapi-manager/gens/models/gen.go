@@ -29,6 +29,69 @@ func (e AIManagerAIType) Valid() bool {
 	}
 }
 
+// Defines values for AIManagerAIAssistReferenceType.
+const (
+	AIManagerAIAssistReferenceTypeCall AIManagerAIAssistReferenceType = "call"
+	AIManagerAIAssistReferenceTypeNone AIManagerAIAssistReferenceType = ""
+)
+
+// Valid indicates whether the value is a known member of the AIManagerAIAssistReferenceType enum.
+func (e AIManagerAIAssistReferenceType) Valid() bool {
+	switch e {
+	case AIManagerAIAssistReferenceTypeCall:
+		return true
+	case AIManagerAIAssistReferenceTypeNone:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for AIManagerAIAssistSentiment.
+const (
+	AIManagerAIAssistSentimentNegative AIManagerAIAssistSentiment = "negative"
+	AIManagerAIAssistSentimentNeutral  AIManagerAIAssistSentiment = "neutral"
+	AIManagerAIAssistSentimentNone     AIManagerAIAssistSentiment = ""
+	AIManagerAIAssistSentimentPositive AIManagerAIAssistSentiment = "positive"
+)
+
+// Valid indicates whether the value is a known member of the AIManagerAIAssistSentiment enum.
+func (e AIManagerAIAssistSentiment) Valid() bool {
+	switch e {
+	case AIManagerAIAssistSentimentNegative:
+		return true
+	case AIManagerAIAssistSentimentNeutral:
+		return true
+	case AIManagerAIAssistSentimentNone:
+		return true
+	case AIManagerAIAssistSentimentPositive:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for AIManagerAIAssistStatus.
+const (
+	AIManagerAIAssistStatusNone        AIManagerAIAssistStatus = ""
+	AIManagerAIAssistStatusProgressing AIManagerAIAssistStatus = "progressing"
+	AIManagerAIAssistStatusTerminated  AIManagerAIAssistStatus = "terminated"
+)
+
+// Valid indicates whether the value is a known member of the AIManagerAIAssistStatus enum.
+func (e AIManagerAIAssistStatus) Valid() bool {
+	switch e {
+	case AIManagerAIAssistStatusNone:
+		return true
+	case AIManagerAIAssistStatusProgressing:
+		return true
+	case AIManagerAIAssistStatusTerminated:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for AIManagerAIAuditStatus.
 const (
 	AIManagerAIAuditStatusCompleted   AIManagerAIAuditStatus = "completed"
@@ -255,6 +318,7 @@ const (
 	AIManagerAIcallReferenceTypeConversation AIManagerAIcallReferenceType = "conversation"
 	AIManagerAIcallReferenceTypeNone         AIManagerAIcallReferenceType = ""
 	AIManagerAIcallReferenceTypeTask         AIManagerAIcallReferenceType = "task"
+	AIManagerAIcallReferenceTypeTestRun      AIManagerAIcallReferenceType = "test_run"
 )
 
 // Valid indicates whether the value is a known member of the AIManagerAIcallReferenceType enum.
@@ -270,6 +334,8 @@ func (e AIManagerAIcallReferenceType) Valid() bool {
 		return true
 	case AIManagerAIcallReferenceTypeTask:
 		return true
+	case AIManagerAIcallReferenceTypeTestRun:
+		return true
 	default:
 		return false
 	}
@@ -305,6 +371,120 @@ func (e AIManagerAIcallStatus) Valid() bool {
 	}
 }
 
+// Defines values for AIManagerExtractionReferenceType.
+const (
+	AIManagerExtractionReferenceTypeAIcall     AIManagerExtractionReferenceType = "aicall"
+	AIManagerExtractionReferenceTypeCall       AIManagerExtractionReferenceType = "call"
+	AIManagerExtractionReferenceTypeConference AIManagerExtractionReferenceType = "conference"
+	AIManagerExtractionReferenceTypeNone       AIManagerExtractionReferenceType = ""
+	AIManagerExtractionReferenceTypeRecording  AIManagerExtractionReferenceType = "recording"
+	AIManagerExtractionReferenceTypeTranscribe AIManagerExtractionReferenceType = "transcribe"
+)
+
+// Valid indicates whether the value is a known member of the AIManagerExtractionReferenceType enum.
+func (e AIManagerExtractionReferenceType) Valid() bool {
+	switch e {
+	case AIManagerExtractionReferenceTypeAIcall:
+		return true
+	case AIManagerExtractionReferenceTypeCall:
+		return true
+	case AIManagerExtractionReferenceTypeConference:
+		return true
+	case AIManagerExtractionReferenceTypeNone:
+		return true
+	case AIManagerExtractionReferenceTypeRecording:
+		return true
+	case AIManagerExtractionReferenceTypeTranscribe:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for AIManagerExtractionStatus.
+const (
+	AIManagerExtractionStatusDone        AIManagerExtractionStatus = "done"
+	AIManagerExtractionStatusFailed      AIManagerExtractionStatus = "failed"
+	AIManagerExtractionStatusNone        AIManagerExtractionStatus = ""
+	AIManagerExtractionStatusProgressing AIManagerExtractionStatus = "progressing"
+)
+
+// Valid indicates whether the value is a known member of the AIManagerExtractionStatus enum.
+func (e AIManagerExtractionStatus) Valid() bool {
+	switch e {
+	case AIManagerExtractionStatusDone:
+		return true
+	case AIManagerExtractionStatusFailed:
+		return true
+	case AIManagerExtractionStatusNone:
+		return true
+	case AIManagerExtractionStatusProgressing:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for AIManagerGuardrailEscalationAction.
+const (
+	AIManagerGuardrailEscalationActionNone        AIManagerGuardrailEscalationAction = ""
+	AIManagerGuardrailEscalationActionStopService AIManagerGuardrailEscalationAction = "stop_service"
+	AIManagerGuardrailEscalationActionTransfer    AIManagerGuardrailEscalationAction = "transfer"
+)
+
+// Valid indicates whether the value is a known member of the AIManagerGuardrailEscalationAction enum.
+func (e AIManagerGuardrailEscalationAction) Valid() bool {
+	switch e {
+	case AIManagerGuardrailEscalationActionNone:
+		return true
+	case AIManagerGuardrailEscalationActionStopService:
+		return true
+	case AIManagerGuardrailEscalationActionTransfer:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for AIManagerGuardrailViolationRule.
+const (
+	AIManagerGuardrailViolationRuleBlockedTopic            AIManagerGuardrailViolationRule = "blocked_topic"
+	AIManagerGuardrailViolationRuleForbiddenPhrase         AIManagerGuardrailViolationRule = "forbidden_phrase"
+	AIManagerGuardrailViolationRuleMaxConsecutiveToolCalls AIManagerGuardrailViolationRule = "max_consecutive_tool_calls"
+)
+
+// Valid indicates whether the value is a known member of the AIManagerGuardrailViolationRule enum.
+func (e AIManagerGuardrailViolationRule) Valid() bool {
+	switch e {
+	case AIManagerGuardrailViolationRuleBlockedTopic:
+		return true
+	case AIManagerGuardrailViolationRuleForbiddenPhrase:
+		return true
+	case AIManagerGuardrailViolationRuleMaxConsecutiveToolCalls:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for AIManagerMCPServerTransport.
+const (
+	AIManagerMCPServerTransportSSE            AIManagerMCPServerTransport = "sse"
+	AIManagerMCPServerTransportStreamableHTTP AIManagerMCPServerTransport = "streamable_http"
+)
+
+// Valid indicates whether the value is a known member of the AIManagerMCPServerTransport enum.
+func (e AIManagerMCPServerTransport) Valid() bool {
+	switch e {
+	case AIManagerMCPServerTransportSSE:
+		return true
+	case AIManagerMCPServerTransportStreamableHTTP:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for AIManagerMessageDirection.
 const (
 	AIManagerMessageDirectionIncoming AIManagerMessageDirection = "incoming"
@@ -359,6 +539,54 @@ func (e AIManagerMessageRole) Valid() bool {
 	}
 }
 
+// Defines values for AIManagerRedactionEntityType.
+const (
+	AIManagerRedactionEntityTypeCreditCard  AIManagerRedactionEntityType = "credit_card"
+	AIManagerRedactionEntityTypeEmail       AIManagerRedactionEntityType = "email"
+	AIManagerRedactionEntityTypeIBAN        AIManagerRedactionEntityType = "iban"
+	AIManagerRedactionEntityTypePhoneNumber AIManagerRedactionEntityType = "phone_number"
+	AIManagerRedactionEntityTypeSSN         AIManagerRedactionEntityType = "ssn"
+)
+
+// Valid indicates whether the value is a known member of the AIManagerRedactionEntityType enum.
+func (e AIManagerRedactionEntityType) Valid() bool {
+	switch e {
+	case AIManagerRedactionEntityTypeCreditCard:
+		return true
+	case AIManagerRedactionEntityTypeEmail:
+		return true
+	case AIManagerRedactionEntityTypeIBAN:
+		return true
+	case AIManagerRedactionEntityTypePhoneNumber:
+		return true
+	case AIManagerRedactionEntityTypeSSN:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for AIManagerRedactionMode.
+const (
+	AIManagerRedactionModeMask     AIManagerRedactionMode = "mask"
+	AIManagerRedactionModeReplace  AIManagerRedactionMode = "replace"
+	AIManagerRedactionModeTokenize AIManagerRedactionMode = "tokenize"
+)
+
+// Valid indicates whether the value is a known member of the AIManagerRedactionMode enum.
+func (e AIManagerRedactionMode) Valid() bool {
+	switch e {
+	case AIManagerRedactionModeMask:
+		return true
+	case AIManagerRedactionModeReplace:
+		return true
+	case AIManagerRedactionModeTokenize:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for AIManagerSummaryReferenceType.
 const (
 	AIManagerSummaryReferenceTypeCall       AIManagerSummaryReferenceType = "call"
@@ -407,6 +635,75 @@ func (e AIManagerSummaryStatus) Valid() bool {
 	}
 }
 
+// Defines values for AIManagerTestRunStatus.
+const (
+	AIManagerTestRunStatusFailed      AIManagerTestRunStatus = "failed"
+	AIManagerTestRunStatusNone        AIManagerTestRunStatus = ""
+	AIManagerTestRunStatusPassed      AIManagerTestRunStatus = "passed"
+	AIManagerTestRunStatusProgressing AIManagerTestRunStatus = "progressing"
+)
+
+// Valid indicates whether the value is a known member of the AIManagerTestRunStatus enum.
+func (e AIManagerTestRunStatus) Valid() bool {
+	switch e {
+	case AIManagerTestRunStatusFailed:
+		return true
+	case AIManagerTestRunStatusNone:
+		return true
+	case AIManagerTestRunStatusPassed:
+		return true
+	case AIManagerTestRunStatusProgressing:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for AIManagerTestSuiteAssertionType.
+const (
+	AIManagerTestSuiteAssertionTypeMaxTurns       AIManagerTestSuiteAssertionType = "max_turns"
+	AIManagerTestSuiteAssertionTypeMustMention    AIManagerTestSuiteAssertionType = "must_mention"
+	AIManagerTestSuiteAssertionTypeMustNotMention AIManagerTestSuiteAssertionType = "must_not_mention"
+	AIManagerTestSuiteAssertionTypeToolCalled     AIManagerTestSuiteAssertionType = "tool_called"
+	AIManagerTestSuiteAssertionTypeToolNotCalled  AIManagerTestSuiteAssertionType = "tool_not_called"
+)
+
+// Valid indicates whether the value is a known member of the AIManagerTestSuiteAssertionType enum.
+func (e AIManagerTestSuiteAssertionType) Valid() bool {
+	switch e {
+	case AIManagerTestSuiteAssertionTypeMaxTurns:
+		return true
+	case AIManagerTestSuiteAssertionTypeMustMention:
+		return true
+	case AIManagerTestSuiteAssertionTypeMustNotMention:
+		return true
+	case AIManagerTestSuiteAssertionTypeToolCalled:
+		return true
+	case AIManagerTestSuiteAssertionTypeToolNotCalled:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for AIManagerTestSuiteScenarioType.
+const (
+	AIManagerTestSuiteScenarioTypeScripted  AIManagerTestSuiteScenarioType = "scripted"
+	AIManagerTestSuiteScenarioTypeSimulated AIManagerTestSuiteScenarioType = "simulated"
+)
+
+// Valid indicates whether the value is a known member of the AIManagerTestSuiteScenarioType enum.
+func (e AIManagerTestSuiteScenarioType) Valid() bool {
+	switch e {
+	case AIManagerTestSuiteScenarioTypeScripted:
+		return true
+	case AIManagerTestSuiteScenarioTypeSimulated:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for AIManagerToolName.
 const (
 	AIManagerToolNameAll                    AIManagerToolName = "all"
@@ -425,6 +722,7 @@ const (
 	AIManagerToolNameSearchKnowledge        AIManagerToolName = "search_knowledge"
 	AIManagerToolNameSendEmail              AIManagerToolName = "send_email"
 	AIManagerToolNameSendMessage            AIManagerToolName = "send_message"
+	AIManagerToolNameSetDisposition         AIManagerToolName = "set_disposition"
 	AIManagerToolNameSetVariables           AIManagerToolName = "set_variables"
 	AIManagerToolNameStopFlow               AIManagerToolName = "stop_flow"
 	AIManagerToolNameStopMedia              AIManagerToolName = "stop_media"
@@ -466,6 +764,8 @@ func (e AIManagerToolName) Valid() bool {
 		return true
 	case AIManagerToolNameSendMessage:
 		return true
+	case AIManagerToolNameSetDisposition:
+		return true
 	case AIManagerToolNameSetVariables:
 		return true
 	case AIManagerToolNameStopFlow:
@@ -656,6 +956,24 @@ func (e BillingManagerAccountPlanType) Valid() bool {
 	}
 }
 
+// Defines values for BillingManagerAccountSpendLimitPeriod.
+const (
+	BillingManagerAccountSpendLimitPeriodDaily   BillingManagerAccountSpendLimitPeriod = "daily"
+	BillingManagerAccountSpendLimitPeriodMonthly BillingManagerAccountSpendLimitPeriod = "monthly"
+)
+
+// Valid indicates whether the value is a known member of the BillingManagerAccountSpendLimitPeriod enum.
+func (e BillingManagerAccountSpendLimitPeriod) Valid() bool {
+	switch e {
+	case BillingManagerAccountSpendLimitPeriodDaily:
+		return true
+	case BillingManagerAccountSpendLimitPeriodMonthly:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for BillingManagerAccountStatus.
 const (
 	BillingManagerAccountStatusActive  BillingManagerAccountStatus = "active"
@@ -679,6 +997,7 @@ func (e BillingManagerAccountStatus) Valid() bool {
 
 // Defines values for BillingManagerBillingCostType.
 const (
+	BillingManagerBillingCostTypeAIUsage          BillingManagerBillingCostType = "ai_usage"
 	BillingManagerBillingCostTypeCallDirectExt    BillingManagerBillingCostType = "call_direct_ext"
 	BillingManagerBillingCostTypeCallExtension    BillingManagerBillingCostType = "call_extension"
 	BillingManagerBillingCostTypeCallPSTNIncoming BillingManagerBillingCostType = "call_pstn_incoming"
@@ -696,6 +1015,8 @@ const (
 // Valid indicates whether the value is a known member of the BillingManagerBillingCostType enum.
 func (e BillingManagerBillingCostType) Valid() bool {
 	switch e {
+	case BillingManagerBillingCostTypeAIUsage:
+		return true
 	case BillingManagerBillingCostTypeCallDirectExt:
 		return true
 	case BillingManagerBillingCostTypeCallExtension:
@@ -775,6 +1096,8 @@ func (e BillingManagerBillingTransactionType) Valid() bool {
 
 // Defines values for BillingManagerBillingreferenceType.
 const (
+	BillingManagerBillingreferenceTypeAIAssist         BillingManagerBillingreferenceType = "aiassist"
+	BillingManagerBillingreferenceTypeAIcall           BillingManagerBillingreferenceType = "aicall"
 	BillingManagerBillingreferenceTypeCall             BillingManagerBillingreferenceType = "call"
 	BillingManagerBillingreferenceTypeCallExtension    BillingManagerBillingreferenceType = "call_extension"
 	BillingManagerBillingreferenceTypeCreditAdjustment BillingManagerBillingreferenceType = "credit_adjustment"
@@ -793,6 +1116,10 @@ const (
 // Valid indicates whether the value is a known member of the BillingManagerBillingreferenceType enum.
 func (e BillingManagerBillingreferenceType) Valid() bool {
 	switch e {
+	case BillingManagerBillingreferenceTypeAIAssist:
+		return true
+	case BillingManagerBillingreferenceTypeAIcall:
+		return true
 	case BillingManagerBillingreferenceTypeCall:
 		return true
 	case BillingManagerBillingreferenceTypeCallExtension:
@@ -870,6 +1197,7 @@ const (
 	CallManagerCallHangupReasonCancel   CallManagerCallHangupReason = "cancel"
 	CallManagerCallHangupReasonDialout  CallManagerCallHangupReason = "dialout"
 	CallManagerCallHangupReasonFailed   CallManagerCallHangupReason = "failed"
+	CallManagerCallHangupReasonMaxCost  CallManagerCallHangupReason = "max_cost"
 	CallManagerCallHangupReasonNoanswer CallManagerCallHangupReason = "noanswer"
 	CallManagerCallHangupReasonNone     CallManagerCallHangupReason = ""
 	CallManagerCallHangupReasonNormal   CallManagerCallHangupReason = "normal"
@@ -889,6 +1217,8 @@ func (e CallManagerCallHangupReason) Valid() bool {
 		return true
 	case CallManagerCallHangupReasonFailed:
 		return true
+	case CallManagerCallHangupReasonMaxCost:
+		return true
 	case CallManagerCallHangupReasonNoanswer:
 		return true
 	case CallManagerCallHangupReasonNone:
@@ -1226,6 +1556,66 @@ func (e CallManagerRecordingStatus) Valid() bool {
 	}
 }
 
+// Defines values for CampaignManagerCampaignCallingWindowDay.
+const (
+	CampaignManagerCampaignCallingWindowDayFriday    CampaignManagerCampaignCallingWindowDay = "friday"
+	CampaignManagerCampaignCallingWindowDayMonday    CampaignManagerCampaignCallingWindowDay = "monday"
+	CampaignManagerCampaignCallingWindowDaySaturday  CampaignManagerCampaignCallingWindowDay = "saturday"
+	CampaignManagerCampaignCallingWindowDaySunday    CampaignManagerCampaignCallingWindowDay = "sunday"
+	CampaignManagerCampaignCallingWindowDayThursday  CampaignManagerCampaignCallingWindowDay = "thursday"
+	CampaignManagerCampaignCallingWindowDayTuesday   CampaignManagerCampaignCallingWindowDay = "tuesday"
+	CampaignManagerCampaignCallingWindowDayWednesday CampaignManagerCampaignCallingWindowDay = "wednesday"
+)
+
+// Valid indicates whether the value is a known member of the CampaignManagerCampaignCallingWindowDay enum.
+func (e CampaignManagerCampaignCallingWindowDay) Valid() bool {
+	switch e {
+	case CampaignManagerCampaignCallingWindowDayFriday:
+		return true
+	case CampaignManagerCampaignCallingWindowDayMonday:
+		return true
+	case CampaignManagerCampaignCallingWindowDaySaturday:
+		return true
+	case CampaignManagerCampaignCallingWindowDaySunday:
+		return true
+	case CampaignManagerCampaignCallingWindowDayThursday:
+		return true
+	case CampaignManagerCampaignCallingWindowDayTuesday:
+		return true
+	case CampaignManagerCampaignCallingWindowDayWednesday:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for CampaignManagerCampaignDialMode.
+const (
+	CampaignManagerCampaignDialModeNone        CampaignManagerCampaignDialMode = ""
+	CampaignManagerCampaignDialModePower       CampaignManagerCampaignDialMode = "power"
+	CampaignManagerCampaignDialModePredictive  CampaignManagerCampaignDialMode = "predictive"
+	CampaignManagerCampaignDialModePreview     CampaignManagerCampaignDialMode = "preview"
+	CampaignManagerCampaignDialModeProgressive CampaignManagerCampaignDialMode = "progressive"
+)
+
+// Valid indicates whether the value is a known member of the CampaignManagerCampaignDialMode enum.
+func (e CampaignManagerCampaignDialMode) Valid() bool {
+	switch e {
+	case CampaignManagerCampaignDialModeNone:
+		return true
+	case CampaignManagerCampaignDialModePower:
+		return true
+	case CampaignManagerCampaignDialModePredictive:
+		return true
+	case CampaignManagerCampaignDialModePreview:
+		return true
+	case CampaignManagerCampaignDialModeProgressive:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for CampaignManagerCampaignEndHandle.
 const (
 	CampaignManagerCampaignEndHandleContinue CampaignManagerCampaignEndHandle = "continue"
@@ -1347,6 +1737,7 @@ func (e CampaignManagerCampaigncallResult) Valid() bool {
 const (
 	CampaignManagerCampaigncallStatusDialing     CampaignManagerCampaigncallStatus = "dialing"
 	CampaignManagerCampaigncallStatusDone        CampaignManagerCampaigncallStatus = "done"
+	CampaignManagerCampaigncallStatusPreviewing  CampaignManagerCampaigncallStatus = "previewing"
 	CampaignManagerCampaigncallStatusProgressing CampaignManagerCampaigncallStatus = "progressing"
 )
 
@@ -1357,6 +1748,8 @@ func (e CampaignManagerCampaigncallStatus) Valid() bool {
 		return true
 	case CampaignManagerCampaigncallStatusDone:
 		return true
+	case CampaignManagerCampaigncallStatusPreviewing:
+		return true
 	case CampaignManagerCampaigncallStatusProgressing:
 		return true
 	default:
@@ -1364,6 +1757,48 @@ func (e CampaignManagerCampaigncallStatus) Valid() bool {
 	}
 }
 
+// Defines values for CampaignManagerCampaignresultjobStatus.
+const (
+	CampaignManagerCampaignresultjobStatusDone       CampaignManagerCampaignresultjobStatus = "done"
+	CampaignManagerCampaignresultjobStatusFailed     CampaignManagerCampaignresultjobStatus = "failed"
+	CampaignManagerCampaignresultjobStatusProcessing CampaignManagerCampaignresultjobStatus = "processing"
+)
+
+// Valid indicates whether the value is a known member of the CampaignManagerCampaignresultjobStatus enum.
+func (e CampaignManagerCampaignresultjobStatus) Valid() bool {
+	switch e {
+	case CampaignManagerCampaignresultjobStatusDone:
+		return true
+	case CampaignManagerCampaignresultjobStatusFailed:
+		return true
+	case CampaignManagerCampaignresultjobStatusProcessing:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for CampaignManagerDispositionRetryMode.
+const (
+	CampaignManagerDispositionRetryModeDefault CampaignManagerDispositionRetryMode = "default"
+	CampaignManagerDispositionRetryModeNoRetry CampaignManagerDispositionRetryMode = "no_retry"
+	CampaignManagerDispositionRetryModeRetry   CampaignManagerDispositionRetryMode = "retry"
+)
+
+// Valid indicates whether the value is a known member of the CampaignManagerDispositionRetryMode enum.
+func (e CampaignManagerDispositionRetryMode) Valid() bool {
+	switch e {
+	case CampaignManagerDispositionRetryModeDefault:
+		return true
+	case CampaignManagerDispositionRetryModeNoRetry:
+		return true
+	case CampaignManagerDispositionRetryModeRetry:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for CommonAddressType.
 const (
 	CommonAddressTypeCommonAddressTypeAgent      CommonAddressType = "agent"
@@ -2029,6 +2464,7 @@ func (e FlowManagerActionOptionWebhookSendMethod) Valid() bool {
 
 // Defines values for FlowManagerActionType.
 const (
+	FlowManagerActionTypeAIExtract           FlowManagerActionType = "ai_extract"
 	FlowManagerActionTypeAISummary           FlowManagerActionType = "ai_summary"
 	FlowManagerActionTypeAITalk              FlowManagerActionType = "ai_talk"
 	FlowManagerActionTypeAMD                 FlowManagerActionType = "amd"
@@ -2046,6 +2482,7 @@ const (
 	FlowManagerActionTypeConversationSend    FlowManagerActionType = "conversation_send"
 	FlowManagerActionTypeDigitsReceive       FlowManagerActionType = "digits_receive"
 	FlowManagerActionTypeDigitsSend          FlowManagerActionType = "digits_send"
+	FlowManagerActionTypeDispositionSet      FlowManagerActionType = "disposition_set"
 	FlowManagerActionTypeEcho                FlowManagerActionType = "echo"
 	FlowManagerActionTypeEmailSend           FlowManagerActionType = "email_send"
 	FlowManagerActionTypeExternalMediaStart  FlowManagerActionType = "external_media_start"
@@ -2074,6 +2511,8 @@ const (
 // Valid indicates whether the value is a known member of the FlowManagerActionType enum.
 func (e FlowManagerActionType) Valid() bool {
 	switch e {
+	case FlowManagerActionTypeAIExtract:
+		return true
 	case FlowManagerActionTypeAISummary:
 		return true
 	case FlowManagerActionTypeAITalk:
@@ -2108,6 +2547,8 @@ func (e FlowManagerActionType) Valid() bool {
 		return true
 	case FlowManagerActionTypeDigitsSend:
 		return true
+	case FlowManagerActionTypeDispositionSet:
+		return true
 	case FlowManagerActionTypeEcho:
 		return true
 	case FlowManagerActionTypeEmailSend:
@@ -2462,45 +2903,126 @@ func (e OutdialManagerOutdialtargetStatus) Valid() bool {
 	}
 }
 
-// Defines values for QueueManagerQueueRoutingMethod.
+// Defines values for OutdialManagerOutdialtargetjobStatus.
 const (
-	QueueManagerQueueRoutingMethodNone   QueueManagerQueueRoutingMethod = ""
-	QueueManagerQueueRoutingMethodRandom QueueManagerQueueRoutingMethod = "random"
+	OutdialManagerOutdialtargetjobStatusDone       OutdialManagerOutdialtargetjobStatus = "done"
+	OutdialManagerOutdialtargetjobStatusFailed     OutdialManagerOutdialtargetjobStatus = "failed"
+	OutdialManagerOutdialtargetjobStatusProcessing OutdialManagerOutdialtargetjobStatus = "processing"
 )
 
-// Valid indicates whether the value is a known member of the QueueManagerQueueRoutingMethod enum.
-func (e QueueManagerQueueRoutingMethod) Valid() bool {
+// Valid indicates whether the value is a known member of the OutdialManagerOutdialtargetjobStatus enum.
+func (e OutdialManagerOutdialtargetjobStatus) Valid() bool {
 	switch e {
-	case QueueManagerQueueRoutingMethodNone:
+	case OutdialManagerOutdialtargetjobStatusDone:
 		return true
-	case QueueManagerQueueRoutingMethodRandom:
+	case OutdialManagerOutdialtargetjobStatusFailed:
+		return true
+	case OutdialManagerOutdialtargetjobStatusProcessing:
 		return true
 	default:
 		return false
 	}
 }
 
-// Defines values for QueueManagerQueuecallReferenceType.
+// Defines values for OutdialManagerOutdialtargetjobType.
 const (
-	QueueManagerQueuecallReferenceTypeCall QueueManagerQueuecallReferenceType = "call"
+	OutdialManagerOutdialtargetjobTypeExport OutdialManagerOutdialtargetjobType = "export"
+	OutdialManagerOutdialtargetjobTypeImport OutdialManagerOutdialtargetjobType = "import"
 )
 
-// Valid indicates whether the value is a known member of the QueueManagerQueuecallReferenceType enum.
-func (e QueueManagerQueuecallReferenceType) Valid() bool {
+// Valid indicates whether the value is a known member of the OutdialManagerOutdialtargetjobType enum.
+func (e OutdialManagerOutdialtargetjobType) Valid() bool {
 	switch e {
-	case QueueManagerQueuecallReferenceTypeCall:
+	case OutdialManagerOutdialtargetjobTypeExport:
+		return true
+	case OutdialManagerOutdialtargetjobTypeImport:
 		return true
 	default:
 		return false
 	}
 }
 
-// Defines values for QueueManagerQueuecallStatus.
+// Defines values for OutdialManagerSuppressionBlockReferenceType.
 const (
-	QueueManagerQueuecallStatusAbandoned  QueueManagerQueuecallStatus = "abandoned"
-	QueueManagerQueuecallStatusConnecting QueueManagerQueuecallStatus = "connecting"
-	QueueManagerQueuecallStatusDone       QueueManagerQueuecallStatus = "done"
-	QueueManagerQueuecallStatusInitiating QueueManagerQueuecallStatus = "initiating"
+	OutdialManagerSuppressionBlockReferenceTypeCall     OutdialManagerSuppressionBlockReferenceType = "call"
+	OutdialManagerSuppressionBlockReferenceTypeCampaign OutdialManagerSuppressionBlockReferenceType = "campaign"
+	OutdialManagerSuppressionBlockReferenceTypeMessage  OutdialManagerSuppressionBlockReferenceType = "message"
+)
+
+// Valid indicates whether the value is a known member of the OutdialManagerSuppressionBlockReferenceType enum.
+func (e OutdialManagerSuppressionBlockReferenceType) Valid() bool {
+	switch e {
+	case OutdialManagerSuppressionBlockReferenceTypeCall:
+		return true
+	case OutdialManagerSuppressionBlockReferenceTypeCampaign:
+		return true
+	case OutdialManagerSuppressionBlockReferenceTypeMessage:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OutdialManagerSuppressionListType.
+const (
+	OutdialManagerSuppressionListTypeDNC       OutdialManagerSuppressionListType = "dnc"
+	OutdialManagerSuppressionListTypeLitigator OutdialManagerSuppressionListType = "litigator"
+	OutdialManagerSuppressionListTypeOptOut    OutdialManagerSuppressionListType = "opt_out"
+)
+
+// Valid indicates whether the value is a known member of the OutdialManagerSuppressionListType enum.
+func (e OutdialManagerSuppressionListType) Valid() bool {
+	switch e {
+	case OutdialManagerSuppressionListTypeDNC:
+		return true
+	case OutdialManagerSuppressionListTypeLitigator:
+		return true
+	case OutdialManagerSuppressionListTypeOptOut:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for QueueManagerQueueRoutingMethod.
+const (
+	QueueManagerQueueRoutingMethodNone   QueueManagerQueueRoutingMethod = ""
+	QueueManagerQueueRoutingMethodRandom QueueManagerQueueRoutingMethod = "random"
+)
+
+// Valid indicates whether the value is a known member of the QueueManagerQueueRoutingMethod enum.
+func (e QueueManagerQueueRoutingMethod) Valid() bool {
+	switch e {
+	case QueueManagerQueueRoutingMethodNone:
+		return true
+	case QueueManagerQueueRoutingMethodRandom:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for QueueManagerQueuecallReferenceType.
+const (
+	QueueManagerQueuecallReferenceTypeCall QueueManagerQueuecallReferenceType = "call"
+)
+
+// Valid indicates whether the value is a known member of the QueueManagerQueuecallReferenceType enum.
+func (e QueueManagerQueuecallReferenceType) Valid() bool {
+	switch e {
+	case QueueManagerQueuecallReferenceTypeCall:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for QueueManagerQueuecallStatus.
+const (
+	QueueManagerQueuecallStatusAbandoned  QueueManagerQueuecallStatus = "abandoned"
+	QueueManagerQueuecallStatusConnecting QueueManagerQueuecallStatus = "connecting"
+	QueueManagerQueuecallStatusDone       QueueManagerQueuecallStatus = "done"
+	QueueManagerQueuecallStatusInitiating QueueManagerQueuecallStatus = "initiating"
 	QueueManagerQueuecallStatusKicking    QueueManagerQueuecallStatus = "kicking"
 	QueueManagerQueuecallStatusService    QueueManagerQueuecallStatus = "service"
 	QueueManagerQueuecallStatusWaiting    QueueManagerQueuecallStatus = "waiting"
@@ -3642,6 +4164,9 @@ type AIManagerAI struct {
 	// Example: direct.a1b2c3d4e5f6
 	DirectHash *string `json:"direct_hash,omitempty"`
 
+	// EngineFallbacks Engines tried in order when the primary engine fails during a call (max 3). Engines that keep failing are skipped until they recover.
+	EngineFallbacks *[]AIManagerAIEngineFallback `json:"engine_fallbacks,omitempty"`
+
 	// EngineKey API key or authentication key for the AI engine. Write-only; not returned in responses.
 	//
 	// Example: sk-...redacted...
@@ -3652,6 +4177,9 @@ type AIManagerAI struct {
 	// Example: openai.gpt-5
 	EngineModel *AIManagerAIEngineModel `json:"engine_model,omitempty"`
 
+	// Guardrail Guardrail policy of the AI. The user's speech is checked against the blocked topics before the AI answers, and the AI's spoken output is checked against the forbidden phrases before it reaches the text-to-speech. Every violation is recorded as an AI guardrail violation.
+	Guardrail *AIManagerGuardrail `json:"guardrail,omitempty"`
+
 	// Id The unique identifier of the AI.
 	//
 	// Example: 550e8400-e29b-41d4-a716-446655440000
@@ -3680,6 +4208,12 @@ type AIManagerAI struct {
 	// Example: a1b2c3d4-e5f6-7890-abcd-ef1234567890
 	RagId *string `json:"rag_id,omitempty"`
 
+	// Redaction Redaction of personal data in the AI conversations. The detected values are redacted before they are stored in the AI messages and before they reach the LLM.
+	Redaction *AIManagerRedaction `json:"redaction,omitempty"`
+
+	// ResponseCache Semantic response cache of the AI. When the user asks a question similar enough to one the AI already answered, the cached answer is said without running the LLM. The cache is kept per AI and dropped when the AI's `init_prompt` or its RAG changes. Available for the AI calls of a single AI only.
+	ResponseCache *AIManagerResponseCache `json:"response_cache,omitempty"`
+
 	// SmartTurnEnabled Enable smart turn detection using Pipecat's LocalSmartTurnAnalyzerV3. When enabled, forces VAD stop_secs to 0.2 for optimal turn-taking.
 	//
 	// Example: false
@@ -3739,6 +4273,108 @@ type AIManagerAI struct {
 // Example: normal
 type AIManagerAIType string
 
+// AIManagerAIAssist A real-time assist session for a human agent on a call. It follows the call's live transcripts and sends suggestions to the agent. Unlike the AI call, it never speaks into the call.
+type AIManagerAIAssist struct {
+	// AiId The unique identifier of the AI. Its init prompt guides the suggestions and its RAG answers the knowledge questions. Returned from the `GET /ais` response.
+	//
+	// Example: 550e8400-e29b-41d4-a716-446655440000
+	AiId *string `json:"ai_id,omitempty"`
+
+	// Checklist The compliance checklist and its progress.
+	Checklist *[]AIManagerAIAssistChecklistItem `json:"checklist,omitempty"`
+
+	// CustomerId The unique identifier of the associated customer. Returned from the `GET /customers` response.
+	//
+	// Example: 7c4d2f3a-1b8e-4f5c-9a6d-3e2f1a0b4c5d
+	CustomerId *string `json:"customer_id,omitempty"`
+
+	// Id The unique identifier of the AI assist.
+	//
+	// Example: 550e8400-e29b-41d4-a716-446655440000
+	Id *string `json:"id,omitempty"`
+
+	// Language Language of the call.
+	//
+	// Example: en-US
+	Language *string `json:"language,omitempty"`
+
+	// OwnerId The unique identifier of the assisted agent. Returned from the `GET /agents` response.
+	//
+	// Example: c2d3e4f5-a6b7-8901-2cde-f01234567890
+	OwnerId *string `json:"owner_id,omitempty"`
+
+	// OwnerType Type of the resource owner. Always agent.
+	//
+	// Example: agent
+	OwnerType *string `json:"owner_type,omitempty"`
+
+	// ReferenceId The unique identifier of the assisted call. Returned from the `GET /calls` response.
+	//
+	// Example: 550e8400-e29b-41d4-a716-446655440000
+	ReferenceId *string `json:"reference_id,omitempty"`
+
+	// ReferenceType Type of reference for the AI assist.
+	//
+	// Example: call
+	ReferenceType *AIManagerAIAssistReferenceType `json:"reference_type,omitempty"`
+
+	// Sentiment The customer's latest sentiment.
+	//
+	// Example: neutral
+	Sentiment *AIManagerAIAssistSentiment `json:"sentiment,omitempty"`
+
+	// Status Status of the AI assist.
+	//
+	// Example: progressing
+	Status *AIManagerAIAssistStatus `json:"status,omitempty"`
+
+	// TmCreate Timestamp when the AI assist was created.
+	//
+	// Example: 2026-01-15T09:30:00.000000Z
+	TmCreate *string `json:"tm_create,omitempty"`
+
+	// TmDelete Timestamp when the AI assist was deleted.
+	//
+	// Example: 2026-01-15T09:30:00.000000Z
+	TmDelete *string `json:"tm_delete,omitempty"`
+
+	// TmUpdate Timestamp when the AI assist was last updated.
+	//
+	// Example: 2026-01-15T09:30:00.000000Z
+	TmUpdate *string `json:"tm_update,omitempty"`
+
+	// Usage AI provider usage. LLM tokens and speech-to-text/text-to-speech audio seconds.
+	Usage *AIManagerUsage `json:"usage,omitempty"`
+}
+
+// AIManagerAIAssistChecklistItem A compliance item the agent has to cover in the call.
+type AIManagerAIAssistChecklistItem struct {
+	// Done True once the item was covered in the call.
+	//
+	// Example: false
+	Done *bool `json:"done,omitempty"`
+
+	// Name The compliance item.
+	//
+	// Example: Confirm the customer's date of birth
+	Name *string `json:"name,omitempty"`
+}
+
+// AIManagerAIAssistReferenceType Type of reference for the AI assist.
+//
+// Example: call
+type AIManagerAIAssistReferenceType string
+
+// AIManagerAIAssistSentiment The customer's latest sentiment.
+//
+// Example: neutral
+type AIManagerAIAssistSentiment string
+
+// AIManagerAIAssistStatus Status of the AI assist.
+//
+// Example: progressing
+type AIManagerAIAssistStatus string
+
 // AIManagerAIAudit defines model for AIManagerAIAudit.
 type AIManagerAIAudit struct {
 	// AiId The AI participant that was evaluated. Returned from the `GET /ais` response.
@@ -3811,6 +4447,9 @@ type AIManagerAIAudit struct {
 	//
 	// Example: 2026-01-15T09:30:00.000000Z
 	TmUpdate *string `json:"tm_update,omitempty"`
+
+	// Usage AI provider usage. LLM tokens and speech-to-text/text-to-speech audio seconds.
+	Usage *AIManagerUsage `json:"usage,omitempty"`
 }
 
 // AIManagerAIAuditStatus Status of the AI audit.
@@ -3818,6 +4457,19 @@ type AIManagerAIAudit struct {
 // Example: progressing
 type AIManagerAIAuditStatus string
 
+// AIManagerAIEngineFallback An alternative LLM engine the AI fails over to when the engines before it in the chain are rate-limited or unavailable.
+type AIManagerAIEngineFallback struct {
+	// EngineKey API key or authentication key for the fallback engine. Empty uses the platform key of the engine's provider.
+	//
+	// Example: sk-...redacted...
+	EngineKey *string `json:"engine_key,omitempty"`
+
+	// EngineModel Model of the AI engine. Uses target.model format (e.g., openai.gpt-5). The target prefix identifies the provider, and the model name follows after the dot.
+	//
+	// Example: openai.gpt-5
+	EngineModel AIManagerAIEngineModel `json:"engine_model"`
+}
+
 // AIManagerAIEngineModel Model of the AI engine. Uses target.model format (e.g., openai.gpt-5). The target prefix identifies the provider, and the model name follows after the dot.
 //
 // Example: openai.gpt-5
@@ -4051,6 +4703,9 @@ type AIManagerAIcall struct {
 	//
 	// Example: 2026-01-15T09:30:00.000000Z
 	TmUpdate *string `json:"tm_update,omitempty"`
+
+	// Usage AI provider usage. LLM tokens and speech-to-text/text-to-speech audio seconds.
+	Usage *AIManagerUsage `json:"usage,omitempty"`
 }
 
 // AIManagerAIcallAssistanceType Type of assistance entity associated with the AI call.
@@ -4068,121 +4723,491 @@ type AIManagerAIcallReferenceType string
 // Example: progressing
 type AIManagerAIcallStatus string
 
-// AIManagerMessage defines model for AIManagerMessage.
-type AIManagerMessage struct {
-	// ActiveAiId The unique identifier of the AI configuration that was active when this message was created. Returned from the `GET /ais` response.
+// AIManagerCustomTool defines model for AIManagerCustomTool.
+type AIManagerCustomTool struct {
+	// CustomerId The unique identifier of the associated customer. Returned from the `GET /customers` response.
 	//
-	// Example: a1b2c3d4-e5f6-7890-abcd-ef1234567890
-	ActiveAiId *string `json:"active_ai_id,omitempty"`
+	// Example: 7c4d2f3a-1b8e-4f5c-9a6d-3e2f1a0b4c5d
+	CustomerId string `json:"customer_id"`
 
-	// ActiveflowId The unique identifier of the activeflow. Returned from the `GET /activeflows` response.
+	// Description Description shown to the LLM that explains when and how to call the tool.
 	//
-	// Example: 550e8400-e29b-41d4-a716-446655440000
-	ActiveflowId *string `json:"activeflow_id,omitempty"`
+	// Example: Look up the status of a customer's order by order number.
+	Description string `json:"description"`
 
-	// AicallId The unique identifier of the associated AI call. Returned from the `GET /aicalls` response.
+	// Detail Free-form note about the custom tool. Not shown to the LLM.
 	//
-	// Example: 550e8400-e29b-41d4-a716-446655440000
-	AicallId *string `json:"aicall_id,omitempty"`
+	// Example: Order status lookup on the shop backend
+	Detail *string `json:"detail,omitempty"`
 
-	// Content Content of the message.
+	// Headers Extra HTTP headers sent with each request (max 20).
 	//
-	// Example: Hello, how can I help you today?
-	Content *string `json:"content,omitempty"`
+	// Example: {"X-Api-Key":"secret-key"}
+	Headers *map[string]string `json:"headers,omitempty"`
 
-	// CustomerId The unique identifier of the associated customer. Returned from the `GET /customers` response.
+	// Id The unique identifier of the custom tool.
 	//
-	// Example: 7c4d2f3a-1b8e-4f5c-9a6d-3e2f1a0b4c5d
-	CustomerId *string `json:"customer_id,omitempty"`
+	// Example: 3f1a6c2e-8b4d-4e7f-9a1c-2d5e8f0b3a6c
+	Id string `json:"id"`
 
-	// Direction Direction of the message.
+	// Name Function name exposed to the LLM. Must start with a letter and contain only letters, digits and underscores (max 64 characters). Must not collide with a built-in tool name. Add this name to an AI's `tool_names` to enable the tool for that AI.
 	//
-	// Example: incoming
-	Direction *AIManagerMessageDirection `json:"direction,omitempty"`
+	// Example: lookup_order
+	Name string `json:"name"`
 
-	// Id The unique identifier of the message.
+	// Parameters JSON Schema of the tool arguments. The root must be of type `object`.
 	//
-	// Example: 550e8400-e29b-41d4-a716-446655440000
-	Id *string `json:"id,omitempty"`
+	// Example: {"properties":{"order_number":{"type":"string"}},"required":["order_number"],"type":"object"}
+	Parameters *map[string]interface{} `json:"parameters,omitempty"`
 
-	// Role Role of the entity in the conversation.
+	// ResponseTemplate Optional Go text/template applied to the decoded JSON response before it is returned to the LLM. When empty, the raw response body is returned.
 	//
-	// Example: assistant
-	Role *AIManagerMessageRole `json:"role,omitempty"`
+	// Example: Order {{.order_number}} is {{.status}}.
+	ResponseTemplate *string `json:"response_template,omitempty"`
 
-	// TmCreate Timestamp when the message was created.
+	// Timeout Request timeout in milliseconds. Defaults to 5000, maximum 30000.
+	//
+	// Example: 5000
+	Timeout *int `json:"timeout,omitempty"`
+
+	// TmCreate Timestamp when the custom tool was created.
 	//
 	// Example: 2026-01-15T09:30:00.000000Z
 	TmCreate *string `json:"tm_create,omitempty"`
 
-	// ToolCallId The tool call ID this message is responding to.
+	// TmDelete Timestamp when the custom tool was deleted.
 	//
-	// Example: call_abc123
-	ToolCallId *string `json:"tool_call_id,omitempty"`
-
-	// ToolCalls List of tool calls made during the message.
-	ToolCalls *[]struct {
-		// Function The function call details.
-		Function *struct {
-			// Arguments The arguments to pass to the function as a JSON string.
-			//
-			// Example: {"location": "San Francisco"}
-			Arguments *string `json:"arguments,omitempty"`
-
-			// Name The name of the function to call.
-			//
-			// Example: get_weather
-			Name *string `json:"name,omitempty"`
-		} `json:"function,omitempty"`
+	// Example: 2026-01-15T09:30:00.000000Z
+	TmDelete *string `json:"tm_delete,omitempty"`
 
-		// Id The unique identifier of the tool call.
-		//
-		// Example: call_abc123
-		Id *string `json:"id,omitempty"`
+	// TmUpdate Timestamp when the custom tool was last updated.
+	//
+	// Example: 2026-01-15T09:30:00.000000Z
+	TmUpdate *string `json:"tm_update,omitempty"`
 
-		// Type The type of tool call.
-		//
-		// Example: function
-		Type *string `json:"type,omitempty"`
-	} `json:"tool_calls,omitempty"`
+	// Url HTTPS endpoint called when the LLM invokes the tool. The request is a `POST` signed with the customer's webhook secret in the `X-VoIPBIN-Signature` header. Private and reserved addresses are rejected.
+	//
+	// Example: https://shop.example.com/voipbin/lookup-order
+	Url string `json:"url"`
 }
 
-// AIManagerMessageDirection Direction of the message.
-//
-// Example: incoming
-type AIManagerMessageDirection string
-
-// AIManagerMessageRole Role of the entity in the conversation.
-//
-// Example: assistant
-type AIManagerMessageRole string
+// AIManagerExtraction defines model for AIManagerExtraction.
+type AIManagerExtraction struct {
+	// ActiveflowId The unique identifier of the activeflow. Returned from the `GET /activeflows` response.
+	//
+	// Example: 550e8400-e29b-41d4-a716-446655440000
+	ActiveflowId *string `json:"activeflow_id,omitempty"`
 
-// AIManagerParticipant defines model for AIManagerParticipant.
-type AIManagerParticipant struct {
-	// AiId The unique identifier of the AI agent. Returned from the `GET /ais` response.
+	// CustomerId The unique identifier of the associated customer. Returned from the `GET /customers` response.
 	//
-	// Example: 22222222-2222-2222-2222-222222222222
-	AiId *openapi_types.UUID `json:"ai_id,omitempty"`
+	// Example: 7c4d2f3a-1b8e-4f5c-9a6d-3e2f1a0b4c5d
+	CustomerId *string `json:"customer_id,omitempty"`
 
-	// AicallId The unique identifier of the AI call. Returned from the `GET /aicalls` response.
+	// Id The unique identifier of the extraction.
 	//
-	// Example: 11111111-1111-1111-1111-111111111111
-	AicallId *openapi_types.UUID `json:"aicall_id,omitempty"`
+	// Example: 550e8400-e29b-41d4-a716-446655440000
+	Id *string `json:"id,omitempty"`
 
-	// TmCreate The timestamp when the participation was recorded.
+	// Language Language of the extracted values.
 	//
-	// Example: 2026-05-22T10:00:00.000000Z
-	TmCreate *time.Time `json:"tm_create,omitempty"`
-}
+	// Example: en-US
+	Language *string `json:"language,omitempty"`
 
-// AIManagerPromptSnapshot defines model for AIManagerPromptSnapshot.
-type AIManagerPromptSnapshot struct {
-	// AiId The ID of the AI this snapshot belongs to.
+	// OnEndFlowId The unique identifier of the flow to execute when the extraction completes. Returned from the `POST /flows` or `GET /flows` response.
 	//
-	// Example: a1b2c3d4-e5f6-7890-abcd-ef1234567890
-	AiId *string `json:"ai_id,omitempty"`
+	// Example: 550e8400-e29b-41d4-a716-446655440000
+	OnEndFlowId *string `json:"on_end_flow_id,omitempty"`
 
-	// MemberId Zero UUID for single-AI calls; team member UUID for team calls.
+	// ReferenceId The unique identifier of the referenced resource. The actual resource type is determined by reference_type. Returned from the corresponding resource endpoint.
+	//
+	// Example: 550e8400-e29b-41d4-a716-446655440000
+	ReferenceId *string `json:"reference_id,omitempty"`
+
+	// ReferenceType Type of reference for the AI extraction.
+	//
+	// Example: call
+	ReferenceType *AIManagerExtractionReferenceType `json:"reference_type,omitempty"`
+
+	// Result Extracted data. Validated against the schema.
+	//
+	// Example: {"intent":"refund","order_id":"A-1024"}
+	Result *map[string]interface{} `json:"result,omitempty"`
+
+	// Schema JSON schema of the data to extract. The root must be an object with properties.
+	//
+	// Example: {"properties":{"intent":{"type":"string"},"order_id":{"type":"string"}},"type":"object"}
+	Schema *map[string]interface{} `json:"schema,omitempty"`
+
+	// SetVariables If true, each extracted field is set as the flow variable `voipbin.ai_extract.result.<field>`.
+	//
+	// Example: true
+	SetVariables *bool `json:"set_variables,omitempty"`
+
+	// Status Status of the AI extraction.
+	//
+	// Example: done
+	Status *AIManagerExtractionStatus `json:"status,omitempty"`
+
+	// TmCreate Timestamp when the extraction was created.
+	//
+	// Example: 2026-01-15T09:30:00.000000Z
+	TmCreate *string `json:"tm_create,omitempty"`
+
+	// TmDelete Timestamp when the extraction was deleted.
+	//
+	// Example: 2026-01-15T09:30:00.000000Z
+	TmDelete *string `json:"tm_delete,omitempty"`
+
+	// TmUpdate Timestamp when the extraction was last updated.
+	//
+	// Example: 2026-01-15T09:30:00.000000Z
+	TmUpdate *string `json:"tm_update,omitempty"`
+
+	// Usage AI provider usage. LLM tokens and speech-to-text/text-to-speech audio seconds.
+	Usage *AIManagerUsage `json:"usage,omitempty"`
+}
+
+// AIManagerExtractionReferenceType Type of reference for the AI extraction.
+//
+// Example: call
+type AIManagerExtractionReferenceType string
+
+// AIManagerExtractionStatus Status of the AI extraction.
+//
+// Example: done
+type AIManagerExtractionStatus string
+
+// AIManagerGuardrail Guardrail policy of the AI. The user's speech is checked against the blocked topics before the AI answers, and the AI's spoken output is checked against the forbidden phrases before it reaches the text-to-speech. Every violation is recorded as an AI guardrail violation.
+type AIManagerGuardrail struct {
+	// BlockedTopics Topics the AI must not talk about. Checked on the user's speech, so the AI never answers a question about them. Matched as whole words, case-insensitive. Up to 50 items of up to 200 characters each.
+	//
+	// Example: ["politics","investment advice"]
+	BlockedTopics *[]string `json:"blocked_topics,omitempty"`
+
+	// Disclaimer Disclaimer said at the start of the AI's first response in the session. Up to 1000 characters.
+	//
+	// Example: This call is handled by a virtual assistant and may be recorded.
+	Disclaimer *string `json:"disclaimer,omitempty"`
+
+	// Escalation Action taken when a guardrail rule triggers.
+	Escalation *AIManagerGuardrailEscalation `json:"escalation,omitempty"`
+
+	// ForbiddenPhrases Phrases the AI must never say. Matched case-insensitive. Up to 50 items of up to 200 characters each.
+	//
+	// Example: ["guaranteed return"]
+	ForbiddenPhrases *[]string `json:"forbidden_phrases,omitempty"`
+
+	// MaxConsecutiveToolCalls Maximum number of tool calls the AI can make without the user saying anything in between. The tool calls over the limit are refused. `0` is unlimited. Up to 100.
+	//
+	// Example: 5
+	MaxConsecutiveToolCalls *int `json:"max_consecutive_tool_calls,omitempty"`
+}
+
+// AIManagerGuardrailEscalation Action taken when a guardrail rule triggers.
+type AIManagerGuardrailEscalation struct {
+	// Action Escalation action of the guardrail. `stop_service` stops the AI and moves the flow on to the next action. `transfer` joins the call to the queue. The `transfer` action is available for calls only. For the other AI calls, the AI is stopped instead.
+	//
+	// Example: transfer
+	Action *AIManagerGuardrailEscalationAction `json:"action,omitempty"`
+
+	// Message Message said in place of the blocked output, or in place of the AI's answer to a blocked topic. When empty, nothing is said. Up to 1000 characters.
+	//
+	// Example: I'm sorry, I can't help with that. Let me transfer you to an agent.
+	Message *string `json:"message,omitempty"`
+
+	// QueueId The queue to transfer the call to. Required for the `transfer` action. Returned from the `GET /queues` response.
+	//
+	// Example: 550e8400-e29b-41d4-a716-446655440000
+	QueueId *string `json:"queue_id,omitempty"`
+}
+
+// AIManagerGuardrailEscalationAction Escalation action of the guardrail. `stop_service` stops the AI and moves the flow on to the next action. `transfer` joins the call to the queue. The `transfer` action is available for calls only. For the other AI calls, the AI is stopped instead.
+//
+// Example: transfer
+type AIManagerGuardrailEscalationAction string
+
+// AIManagerGuardrailViolation A guardrail rule triggered by an AI in an AI call. The records are never updated, so they can be used as an audit trail.
+type AIManagerGuardrailViolation struct {
+	// Action Escalation action of the guardrail. `stop_service` stops the AI and moves the flow on to the next action. `transfer` joins the call to the queue. The `transfer` action is available for calls only. For the other AI calls, the AI is stopped instead.
+	//
+	// Example: transfer
+	Action *AIManagerGuardrailEscalationAction `json:"action,omitempty"`
+
+	// ActiveflowId The unique identifier of the activeflow. Returned from the `GET /activeflows` response.
+	//
+	// Example: 550e8400-e29b-41d4-a716-446655440000
+	ActiveflowId *string `json:"activeflow_id,omitempty"`
+
+	// AiId The unique identifier of the AI that triggered the rule. Returned from the `GET /ais` response.
+	//
+	// Example: 550e8400-e29b-41d4-a716-446655440000
+	AiId *string `json:"ai_id,omitempty"`
+
+	// AicallId The unique identifier of the AI call. Returned from the `GET /aicalls` response.
+	//
+	// Example: 550e8400-e29b-41d4-a716-446655440000
+	AicallId *string `json:"aicall_id,omitempty"`
+
+	// Content The blocked output, the user's speech about a blocked topic, or the name of the refused tool call. Redacted when the AI call redacts personal data.
+	//
+	// Example: Let's talk about politics.
+	Content *string `json:"content,omitempty"`
+
+	// CustomerId The unique identifier of the associated customer. Returned from the `GET /customers` response.
+	//
+	// Example: 7c4d2f3a-1b8e-4f5c-9a6d-3e2f1a0b4c5d
+	CustomerId *string `json:"customer_id,omitempty"`
+
+	// Detail The matched topic or phrase, or the tool call count.
+	//
+	// Example: politics
+	Detail *string `json:"detail,omitempty"`
+
+	// Id The unique identifier of the guardrail violation.
+	//
+	// Example: 550e8400-e29b-41d4-a716-446655440000
+	Id *string `json:"id,omitempty"`
+
+	// Rule The guardrail rule that triggered.
+	//
+	// Example: blocked_topic
+	Rule *AIManagerGuardrailViolationRule `json:"rule,omitempty"`
+
+	// TmCreate Timestamp when the guardrail violation was created.
+	//
+	// Example: 2026-01-15T09:30:00.000000Z
+	TmCreate *string `json:"tm_create,omitempty"`
+
+	// TmDelete Timestamp when the guardrail violation was deleted.
+	//
+	// Example: 2026-01-15T09:30:00.000000Z
+	TmDelete *string `json:"tm_delete,omitempty"`
+
+	// TmUpdate Timestamp when the guardrail violation was last updated.
+	//
+	// Example: 2026-01-15T09:30:00.000000Z
+	TmUpdate *string `json:"tm_update,omitempty"`
+}
+
+// AIManagerGuardrailViolationRule The guardrail rule that triggered.
+//
+// Example: blocked_topic
+type AIManagerGuardrailViolationRule string
+
+// AIManagerMCPServer defines model for AIManagerMCPServer.
+type AIManagerMCPServer struct {
+	// AllowedTools Names of the server's tools the LLM may call. `read_resource` and `get_prompt` allow reading the server's resources and prompts. When empty, every tool is allowed.
+	//
+	// Example: ["lookup_order","read_resource"]
+	AllowedTools *[]string `json:"allowed_tools,omitempty"`
+
+	// CustomerId The unique identifier of the associated customer. Returned from the `GET /customers` response.
+	//
+	// Example: 7c4d2f3a-1b8e-4f5c-9a6d-3e2f1a0b4c5d
+	CustomerId string `json:"customer_id"`
+
+	// Detail Free-form note about the MCP server. Not shown to the LLM.
+	//
+	// Example: Internal CRM MCP server
+	Detail *string `json:"detail,omitempty"`
+
+	// Headers Extra HTTP headers sent with each request, typically for authentication (max 20).
+	//
+	// Example: {"Authorization":"Bearer secret-token"}
+	Headers *map[string]string `json:"headers,omitempty"`
+
+	// Id The unique identifier of the MCP server.
+	//
+	// Example: 9b2e4c6a-1d3f-4a5b-8c7d-0e1f2a3b4c5d
+	Id string `json:"id"`
+
+	// Name Name of the MCP server. Must start with a letter and contain only letters and digits (max 32 characters). The server's tools are exposed to the LLM as `<name>__<tool>`. Add this name to an AI's `tool_names` to enable all of the server's allowed tools for that AI.
+	//
+	// Example: crm
+	Name string `json:"name"`
+
+	// Timeout Timeout of a whole tool discovery or tool call in milliseconds. Defaults to 10000, maximum 60000.
+	//
+	// Example: 10000
+	Timeout *int `json:"timeout,omitempty"`
+
+	// TmCreate Timestamp when the MCP server was created.
+	//
+	// Example: 2026-01-15T09:30:00.000000Z
+	TmCreate *string `json:"tm_create,omitempty"`
+
+	// TmDelete Timestamp when the MCP server was deleted.
+	//
+	// Example: 2026-01-15T09:30:00.000000Z
+	TmDelete *string `json:"tm_delete,omitempty"`
+
+	// TmUpdate Timestamp when the MCP server was last updated.
+	//
+	// Example: 2026-01-15T09:30:00.000000Z
+	TmUpdate *string `json:"tm_update,omitempty"`
+
+	// Transport Transport used to reach the MCP server.
+	// - `streamable_http`: Streamable HTTP transport (a single endpoint accepting `POST` requests).
+	// - `sse`: Legacy HTTP+SSE transport (an event stream endpoint plus a message endpoint).
+	//
+	//
+	// Example: streamable_http
+	Transport AIManagerMCPServerTransport `json:"transport"`
+
+	// Url HTTPS endpoint of the MCP server. For the `sse` transport this is the event stream endpoint. Private and reserved addresses are rejected.
+	//
+	// Example: https://mcp.example.com/mcp
+	Url string `json:"url"`
+}
+
+// AIManagerMCPServerTool A tool discovered on an MCP server, as it is presented to the LLM.
+type AIManagerMCPServerTool struct {
+	// Description Description of the tool reported by the MCP server.
+	//
+	// Example: Look up the status of an order.
+	Description *string `json:"description,omitempty"`
+
+	// Name Function name exposed to the LLM, in the form `<server name>__<tool>`.
+	//
+	// Example: crm__lookup_order
+	Name string `json:"name"`
+
+	// Parameters JSON Schema of the tool arguments reported by the MCP server.
+	//
+	// Example: {"properties":{"order_number":{"type":"string"}},"type":"object"}
+	Parameters *map[string]interface{} `json:"parameters,omitempty"`
+}
+
+// AIManagerMCPServerTransport Transport used to reach the MCP server.
+// - `streamable_http`: Streamable HTTP transport (a single endpoint accepting `POST` requests).
+// - `sse`: Legacy HTTP+SSE transport (an event stream endpoint plus a message endpoint).
+//
+// Example: streamable_http
+type AIManagerMCPServerTransport string
+
+// AIManagerMessage defines model for AIManagerMessage.
+type AIManagerMessage struct {
+	// ActiveAiId The unique identifier of the AI configuration that was active when this message was created. Returned from the `GET /ais` response.
+	//
+	// Example: a1b2c3d4-e5f6-7890-abcd-ef1234567890
+	ActiveAiId *string `json:"active_ai_id,omitempty"`
+
+	// ActiveflowId The unique identifier of the activeflow. Returned from the `GET /activeflows` response.
+	//
+	// Example: 550e8400-e29b-41d4-a716-446655440000
+	ActiveflowId *string `json:"activeflow_id,omitempty"`
+
+	// AicallId The unique identifier of the associated AI call. Returned from the `GET /aicalls` response.
+	//
+	// Example: 550e8400-e29b-41d4-a716-446655440000
+	AicallId *string `json:"aicall_id,omitempty"`
+
+	// Content Content of the message.
+	//
+	// Example: Hello, how can I help you today?
+	Content *string `json:"content,omitempty"`
+
+	// CustomerId The unique identifier of the associated customer. Returned from the `GET /customers` response.
+	//
+	// Example: 7c4d2f3a-1b8e-4f5c-9a6d-3e2f1a0b4c5d
+	CustomerId *string `json:"customer_id,omitempty"`
+
+	// Direction Direction of the message.
+	//
+	// Example: incoming
+	Direction *AIManagerMessageDirection `json:"direction,omitempty"`
+
+	// EngineModel Model of the AI engine. Uses target.model format (e.g., openai.gpt-5). The target prefix identifies the provider, and the model name follows after the dot.
+	//
+	// Example: openai.gpt-5
+	EngineModel *AIManagerAIEngineModel `json:"engine_model,omitempty"`
+
+	// Id The unique identifier of the message.
+	//
+	// Example: 550e8400-e29b-41d4-a716-446655440000
+	Id *string `json:"id,omitempty"`
+
+	// Role Role of the entity in the conversation.
+	//
+	// Example: assistant
+	Role *AIManagerMessageRole `json:"role,omitempty"`
+
+	// TmCreate Timestamp when the message was created.
+	//
+	// Example: 2026-01-15T09:30:00.000000Z
+	TmCreate *string `json:"tm_create,omitempty"`
+
+	// ToolCallId The tool call ID this message is responding to.
+	//
+	// Example: call_abc123
+	ToolCallId *string `json:"tool_call_id,omitempty"`
+
+	// ToolCalls List of tool calls made during the message.
+	ToolCalls *[]struct {
+		// Function The function call details.
+		Function *struct {
+			// Arguments The arguments to pass to the function as a JSON string.
+			//
+			// Example: {"location": "San Francisco"}
+			Arguments *string `json:"arguments,omitempty"`
+
+			// Name The name of the function to call.
+			//
+			// Example: get_weather
+			Name *string `json:"name,omitempty"`
+		} `json:"function,omitempty"`
+
+		// Id The unique identifier of the tool call.
+		//
+		// Example: call_abc123
+		Id *string `json:"id,omitempty"`
+
+		// Type The type of tool call.
+		//
+		// Example: function
+		Type *string `json:"type,omitempty"`
+	} `json:"tool_calls,omitempty"`
+
+	// Usage AI provider usage. LLM tokens and speech-to-text/text-to-speech audio seconds.
+	Usage *AIManagerUsage `json:"usage,omitempty"`
+}
+
+// AIManagerMessageDirection Direction of the message.
+//
+// Example: incoming
+type AIManagerMessageDirection string
+
+// AIManagerMessageRole Role of the entity in the conversation.
+//
+// Example: assistant
+type AIManagerMessageRole string
+
+// AIManagerParticipant defines model for AIManagerParticipant.
+type AIManagerParticipant struct {
+	// AiId The unique identifier of the AI agent. Returned from the `GET /ais` response.
+	//
+	// Example: 22222222-2222-2222-2222-222222222222
+	AiId *openapi_types.UUID `json:"ai_id,omitempty"`
+
+	// AicallId The unique identifier of the AI call. Returned from the `GET /aicalls` response.
+	//
+	// Example: 11111111-1111-1111-1111-111111111111
+	AicallId *openapi_types.UUID `json:"aicall_id,omitempty"`
+
+	// TmCreate The timestamp when the participation was recorded.
+	//
+	// Example: 2026-05-22T10:00:00.000000Z
+	TmCreate *time.Time `json:"tm_create,omitempty"`
+}
+
+// AIManagerPromptSnapshot defines model for AIManagerPromptSnapshot.
+type AIManagerPromptSnapshot struct {
+	// AiId The ID of the AI this snapshot belongs to.
+	//
+	// Example: a1b2c3d4-e5f6-7890-abcd-ef1234567890
+	AiId *string `json:"ai_id,omitempty"`
+
+	// MemberId Zero UUID for single-AI calls; team member UUID for team calls.
 	//
 	// Example: 00000000-0000-0000-0000-000000000000
 	MemberId *string `json:"member_id,omitempty"`
@@ -4198,6 +5223,62 @@ type AIManagerPromptSnapshot struct {
 	PromptHistoryId *string `json:"prompt_history_id,omitempty"`
 }
 
+// AIManagerRedaction Redaction of personal data in the AI conversations. The detected values are redacted before they are stored in the AI messages and before they reach the LLM.
+type AIManagerRedaction struct {
+	// EntityTypes Kinds of personal data to redact. Empty turns the redaction off.
+	//
+	// Example: ["credit_card","email"]
+	EntityTypes *[]AIManagerRedactionEntityType `json:"entity_types,omitempty"`
+
+	// Mode How the detected personal data is redacted. `replace` replaces it with its kind (e.g. `[CREDIT_CARD]`). `mask` keeps the last 4 characters (e.g. `**** **** **** 1111`). `tokenize` replaces it with a token unique within the AI call (e.g. `[CREDIT_CARD_QWERTY]`).
+	//
+	// Example: replace
+	Mode *AIManagerRedactionMode `json:"mode,omitempty"`
+
+	// ToolAccess When true, the tokenized values are restored in the tool call arguments so the tools receive the real values. Requires the `tokenize` mode.
+	//
+	// Example: false
+	ToolAccess *bool `json:"tool_access,omitempty"`
+}
+
+// AIManagerRedactionEntityType Kind of personal data to redact.
+//
+// Example: credit_card
+type AIManagerRedactionEntityType string
+
+// AIManagerRedactionMode How the detected personal data is redacted. `replace` replaces it with its kind (e.g. `[CREDIT_CARD]`). `mask` keeps the last 4 characters (e.g. `**** **** **** 1111`). `tokenize` replaces it with a token unique within the AI call (e.g. `[CREDIT_CARD_QWERTY]`).
+//
+// Example: replace
+type AIManagerRedactionMode string
+
+// AIManagerResponseCache Semantic response cache of the AI. When the user asks a question similar enough to one the AI already answered, the cached answer is said without running the LLM. The cache is kept per AI and dropped when the AI's `init_prompt` or its RAG changes. Available for the AI calls of a single AI only.
+type AIManagerResponseCache struct {
+	// Enabled Whether the response cache is on.
+	//
+	// Example: true
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Threshold Minimum cosine similarity between the question and a cached question to reuse the answer. From `0.5` to `1`. Defaults to `0.95`.
+	//
+	// Example: 0.95
+	Threshold *float64 `json:"threshold,omitempty"`
+
+	// Ttl How long a cached answer is reused, in seconds. Up to 30 days. Defaults to 1 day.
+	//
+	// Example: 86400
+	Ttl *int `json:"ttl,omitempty"`
+
+	// Turns Number of the latest user turns the cache is keyed on. From `1` to `5`. Defaults to `1`.
+	//
+	// Example: 1
+	Turns *int `json:"turns,omitempty"`
+
+	// Variables Flow variables the answers depend on. Answers are reused only between the AI calls with the same values of these variables. Up to 10 items of up to 100 characters each.
+	//
+	// Example: ["customer.plan"]
+	Variables *[]string `json:"variables,omitempty"`
+}
+
 // AIManagerSummary defines model for AIManagerSummary.
 type AIManagerSummary struct {
 	// ActiveflowId The unique identifier of the activeflow. Returned from the `GET /activeflows` response.
@@ -4259,6 +5340,9 @@ type AIManagerSummary struct {
 	//
 	// Example: 2026-01-15T09:30:00.000000Z
 	TmUpdate *string `json:"tm_update,omitempty"`
+
+	// Usage AI provider usage. LLM tokens and speech-to-text/text-to-speech audio seconds.
+	Usage *AIManagerUsage `json:"usage,omitempty"`
 }
 
 // AIManagerSummaryReferenceType Type of reference for the AI summary.
@@ -4316,61 +5400,335 @@ type AIManagerTeam struct {
 	// Example: 2026-01-15T09:30:00.000000Z
 	TmCreate *string `json:"tm_create,omitempty"`
 
-	// TmDelete Timestamp when the team was deleted.
+	// TmDelete Timestamp when the team was deleted.
+	//
+	// Example: 2026-01-15T09:30:00.000000Z
+	TmDelete *string `json:"tm_delete,omitempty"`
+
+	// TmUpdate Timestamp when the team was last updated.
+	//
+	// Example: 2026-01-15T09:30:00.000000Z
+	TmUpdate *string `json:"tm_update,omitempty"`
+}
+
+// AIManagerTeamMember A member node in the team graph, backed by an existing AI configuration.
+type AIManagerTeamMember struct {
+	// AiId The AI configuration backing this member. Returned from the `POST /ais` or `GET /ais` response.
+	//
+	// Example: 550e8400-e29b-41d4-a716-446655440000
+	AiId string `json:"ai_id"`
+
+	// Id The unique identifier of this member within the team.
+	//
+	// Example: aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa
+	Id string `json:"id"`
+
+	// Name Display name for this member.
+	//
+	// Example: Greeter
+	Name string `json:"name"`
+
+	// Transitions List of transitions (edges) from this member to other members.
+	Transitions *[]AIManagerTeamTransition `json:"transitions,omitempty"`
+}
+
+// AIManagerTeamTransition A transition edge that triggers a switch from one member to another via LLM function calling.
+type AIManagerTeamTransition struct {
+	// Description Human-readable description of when this transition should be triggered. Used as the function description in LLM tool definitions.
+	//
+	// Example: Transfer to the specialist when the customer needs technical help
+	Description string `json:"description"`
+
+	// FunctionName The function name that the LLM calls to trigger this transition. Must not collide with reserved tool names.
+	//
+	// Example: transfer_to_specialist
+	FunctionName string `json:"function_name"`
+
+	// NextMemberId The member ID to transition to. Must reference an existing member in the team.
+	//
+	// Example: bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb
+	NextMemberId string `json:"next_member_id"`
+}
+
+// AIManagerTestRun defines model for AIManagerTestRun.
+type AIManagerTestRun struct {
+	// AiId The unique identifier of the AI under test. Returned from the `GET /ais` response.
+	//
+	// Example: 550e8400-e29b-41d4-a716-446655440000
+	AiId string `json:"ai_id"`
+
+	// CustomerId The unique identifier of the associated customer. Returned from the `GET /customers` response.
+	//
+	// Example: 7c4d2f3a-1b8e-4f5c-9a6d-3e2f1a0b4c5d
+	CustomerId string `json:"customer_id"`
+
+	// Id The unique identifier of the test run.
+	//
+	// Example: 8e7d6c5b-4a39-4281-9f0e-1d2c3b4a5f6e
+	Id string `json:"id"`
+
+	// PromptHistoryId The unique identifier of the AI's prompt history entry the run was made with. Compare runs of different prompt versions with it. Returned from the `GET /ais/{id}/prompt_histories` response.
+	//
+	// Example: 9a8b7c6d-5e4f-4302-a1b0-c9d8e7f6a5b4
+	PromptHistoryId *string `json:"prompt_history_id,omitempty"`
+
+	// Results Results of the scenarios, in the order of the test suite.
+	Results *[]AIManagerTestRunResult `json:"results,omitempty"`
+
+	// Status Status of the test run.
+	// - `progressing`: The scenarios are being run.
+	// - `passed`: Every scenario has passed.
+	// - `failed`: At least one scenario has failed.
+	//
+	//
+	// Example: passed
+	Status AIManagerTestRunStatus `json:"status"`
+
+	// TestSuiteId The unique identifier of the test suite. Returned from the `GET /test_suites` response.
+	//
+	// Example: 3f2a1b4c-5d6e-4f70-8a9b-0c1d2e3f4a5b
+	TestSuiteId string `json:"test_suite_id"`
+
+	// TmCreate Timestamp when the test run was created.
+	//
+	// Example: 2026-01-15T09:30:00.000000Z
+	TmCreate *string `json:"tm_create,omitempty"`
+
+	// TmDelete Timestamp when the test run was deleted.
+	//
+	// Example: 2026-01-15T09:30:00.000000Z
+	TmDelete *string `json:"tm_delete,omitempty"`
+
+	// TmUpdate Timestamp when the test run was last updated.
+	//
+	// Example: 2026-01-15T09:30:00.000000Z
+	TmUpdate *string `json:"tm_update,omitempty"`
+}
+
+// AIManagerTestRunAssertionResult Result of a single assertion.
+type AIManagerTestRunAssertionResult struct {
+	// Assertion A check made on the conversation of the scenario.
+	Assertion *AIManagerTestSuiteAssertion `json:"assertion,omitempty"`
+
+	// Passed Whether the assertion has passed.
+	//
+	// Example: false
+	Passed *bool `json:"passed,omitempty"`
+
+	// Reason Why the assertion has failed.
+	//
+	// Example: no reply mentioned the text.
+	Reason *string `json:"reason,omitempty"`
+}
+
+// AIManagerTestRunResult Result of a single scenario.
+type AIManagerTestRunResult struct {
+	// AicallId The unique identifier of the aicall the scenario was run with. The conversation is available from the `GET /aimessages` response.
+	//
+	// Example: 550e8400-e29b-41d4-a716-446655440000
+	AicallId *string `json:"aicall_id,omitempty"`
+
+	// Assertions Results of the scenario's assertions.
+	Assertions *[]AIManagerTestRunAssertionResult `json:"assertions,omitempty"`
+
+	// Error Set when the scenario could not be run to the end. The assertions are not evaluated.
+	//
+	// Example: could not get the reply of the user turn 1: the ai did not reply in 30s
+	Error *string `json:"error,omitempty"`
+
+	// Passed Whether every assertion of the scenario has passed.
+	//
+	// Example: true
+	Passed *bool `json:"passed,omitempty"`
+
+	// Scenario Name of the scenario.
+	//
+	// Example: asks for a refund
+	Scenario *string `json:"scenario,omitempty"`
+
+	// Turns Number of the user turns sent.
+	//
+	// Example: 2
+	Turns *int `json:"turns,omitempty"`
+}
+
+// AIManagerTestRunStatus Status of the test run.
+// - `progressing`: The scenarios are being run.
+// - `passed`: Every scenario has passed.
+// - `failed`: At least one scenario has failed.
+//
+// Example: passed
+type AIManagerTestRunStatus string
+
+// AIManagerTestSuite defines model for AIManagerTestSuite.
+type AIManagerTestSuite struct {
+	// AiId The unique identifier of the AI under test. Returned from the `GET /ais` response.
+	//
+	// Example: 550e8400-e29b-41d4-a716-446655440000
+	AiId string `json:"ai_id"`
+
+	// CustomerId The unique identifier of the associated customer. Returned from the `GET /customers` response.
+	//
+	// Example: 7c4d2f3a-1b8e-4f5c-9a6d-3e2f1a0b4c5d
+	CustomerId string `json:"customer_id"`
+
+	// Detail Free-form note about the test suite.
+	//
+	// Example: Checks the AI follows the 30 days refund policy.
+	Detail *string `json:"detail,omitempty"`
+
+	// Id The unique identifier of the test suite.
+	//
+	// Example: 3f2a1b4c-5d6e-4f70-8a9b-0c1d2e3f4a5b
+	Id string `json:"id"`
+
+	// Name Name of the test suite.
+	//
+	// Example: refund policy
+	Name string `json:"name"`
+
+	// Scenarios Scenarios of the test suite (max 20).
+	Scenarios *[]AIManagerTestSuiteScenario `json:"scenarios,omitempty"`
+
+	// TmCreate Timestamp when the test suite was created.
+	//
+	// Example: 2026-01-15T09:30:00.000000Z
+	TmCreate *string `json:"tm_create,omitempty"`
+
+	// TmDelete Timestamp when the test suite was deleted.
+	//
+	// Example: 2026-01-15T09:30:00.000000Z
+	TmDelete *string `json:"tm_delete,omitempty"`
+
+	// TmUpdate Timestamp when the test suite was last updated.
+	//
+	// Example: 2026-01-15T09:30:00.000000Z
+	TmUpdate *string `json:"tm_update,omitempty"`
+}
+
+// AIManagerTestSuiteAssertion A check made on the conversation of the scenario.
+type AIManagerTestSuiteAssertion struct {
+	// Arguments Arguments the tool call must have. Used by `tool_called` and `tool_not_called`.
+	//
+	// Example: {"run_llm":true}
+	Arguments *map[string]interface{} `json:"arguments,omitempty"`
+
+	// Text Text to look for in the AI's replies. Used by `must_mention` and `must_not_mention`.
+	//
+	// Example: 30 days
+	Text *string `json:"text,omitempty"`
+
+	// ToolName Name of the tool. Used by `tool_called` and `tool_not_called`.
+	//
+	// Example: connect_call
+	ToolName *string `json:"tool_name,omitempty"`
+
+	// Type Type of the assertion.
+	// - `tool_called`: The AI must call the tool `tool_name`. When `arguments` is given, every given argument must match the called one.
+	// - `tool_not_called`: The AI must not call the tool `tool_name` with the given `arguments`.
+	// - `must_mention`: One of the AI's replies must contain `text` (case-insensitive).
+	// - `must_not_mention`: None of the AI's replies may contain `text` (case-insensitive).
+	// - `max_turns`: The conversation must end within `value` user turns.
 	//
-	// Example: 2026-01-15T09:30:00.000000Z
-	TmDelete *string `json:"tm_delete,omitempty"`
+	//
+	// Example: tool_called
+	Type AIManagerTestSuiteAssertionType `json:"type"`
 
-	// TmUpdate Timestamp when the team was last updated.
+	// Value Maximum number of user turns. Used by `max_turns`.
 	//
-	// Example: 2026-01-15T09:30:00.000000Z
-	TmUpdate *string `json:"tm_update,omitempty"`
+	// Example: 5
+	Value *int `json:"value,omitempty"`
 }
 
-// AIManagerTeamMember A member node in the team graph, backed by an existing AI configuration.
-type AIManagerTeamMember struct {
-	// AiId The AI configuration backing this member. Returned from the `POST /ais` or `GET /ais` response.
+// AIManagerTestSuiteAssertionType Type of the assertion.
+// - `tool_called`: The AI must call the tool `tool_name`. When `arguments` is given, every given argument must match the called one.
+// - `tool_not_called`: The AI must not call the tool `tool_name` with the given `arguments`.
+// - `must_mention`: One of the AI's replies must contain `text` (case-insensitive).
+// - `must_not_mention`: None of the AI's replies may contain `text` (case-insensitive).
+// - `max_turns`: The conversation must end within `value` user turns.
+//
+// Example: tool_called
+type AIManagerTestSuiteAssertionType string
+
+// AIManagerTestSuiteScenario A single conversation of the test suite.
+type AIManagerTestSuiteScenario struct {
+	// Assertions Checks made on the conversation. The scenario passes when every assertion passes.
+	Assertions *[]AIManagerTestSuiteAssertion `json:"assertions,omitempty"`
+
+	// Goal What the simulated user wants to achieve. Required for the `simulated` scenario.
 	//
-	// Example: 550e8400-e29b-41d4-a716-446655440000
-	AiId string `json:"ai_id"`
+	// Example: Get a refund for the jacket.
+	Goal *string `json:"goal,omitempty"`
 
-	// Id The unique identifier of this member within the team.
+	// MaxTurns Maximum number of user turns of the `simulated` scenario. Defaults to 10, maximum 20.
 	//
-	// Example: aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa
-	Id string `json:"id"`
+	// Example: 10
+	MaxTurns *int `json:"max_turns,omitempty"`
 
-	// Name Display name for this member.
+	// Name Name of the scenario. Must be unique within the test suite.
 	//
-	// Example: Greeter
+	// Example: asks for a refund
 	Name string `json:"name"`
 
-	// Transitions List of transitions (edges) from this member to other members.
-	Transitions *[]AIManagerTeamTransition `json:"transitions,omitempty"`
-}
-
-// AIManagerTeamTransition A transition edge that triggers a switch from one member to another via LLM function calling.
-type AIManagerTeamTransition struct {
-	// Description Human-readable description of when this transition should be triggered. Used as the function description in LLM tool definitions.
+	// Persona Who the simulated user is. Required for the `simulated` scenario.
 	//
-	// Example: Transfer to the specialist when the customer needs technical help
-	Description string `json:"description"`
+	// Example: An impatient customer who bought a jacket last week.
+	Persona *string `json:"persona,omitempty"`
 
-	// FunctionName The function name that the LLM calls to trigger this transition. Must not collide with reserved tool names.
+	// Turns User messages sent in order. Required for the `scripted` scenario (max 20).
 	//
-	// Example: transfer_to_specialist
-	FunctionName string `json:"function_name"`
+	// Example: ["Hi, I bought a jacket last week.","I want my money back."]
+	Turns *[]string `json:"turns,omitempty"`
 
-	// NextMemberId The member ID to transition to. Must reference an existing member in the team.
+	// Type How the user turns of the scenario are made.
+	// - `scripted`: The user turns are given in `turns` and sent in order.
+	// - `simulated`: An LLM plays the `persona` and talks to the AI until the `goal` is reached or `max_turns` is reached.
 	//
-	// Example: bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb
-	NextMemberId string `json:"next_member_id"`
+	//
+	// Example: scripted
+	Type AIManagerTestSuiteScenarioType `json:"type"`
 }
 
+// AIManagerTestSuiteScenarioType How the user turns of the scenario are made.
+// - `scripted`: The user turns are given in `turns` and sent in order.
+// - `simulated`: An LLM plays the `persona` and talks to the AI until the `goal` is reached or `max_turns` is reached.
+//
+// Example: scripted
+type AIManagerTestSuiteScenarioType string
+
 // AIManagerToolName Name of an AI tool function. Use `all` to enable every available tool.
 //
 // Example: connect_call
 type AIManagerToolName string
 
+// AIManagerUsage AI provider usage. LLM tokens and speech-to-text/text-to-speech audio seconds.
+type AIManagerUsage struct {
+	// CachedTokens LLM input tokens served from the provider's prompt cache.
+	//
+	// Example: 800
+	CachedTokens *int64 `json:"cached_tokens,omitempty"`
+
+	// CompletionTokens LLM output tokens.
+	//
+	// Example: 300
+	CompletionTokens *int64 `json:"completion_tokens,omitempty"`
+
+	// PromptTokens LLM input tokens, including the cached ones.
+	//
+	// Example: 1200
+	PromptTokens *int64 `json:"prompt_tokens,omitempty"`
+
+	// SttSeconds Seconds of audio sent to the speech-to-text engine.
+	//
+	// Example: 42.5
+	SttSeconds *float64 `json:"stt_seconds,omitempty"`
+
+	// TtsSeconds Seconds of audio generated by the text-to-speech engine.
+	//
+	// Example: 31.2
+	TtsSeconds *float64 `json:"tts_seconds,omitempty"`
+}
+
 // AIManagerVADConfig Voice Activity Detection configuration. Omitted fields use Pipecat defaults (confidence=0.7, start_secs=0.2, stop_secs=0.2, min_volume=0.6).
 type AIManagerVADConfig struct {
 	// Confidence Minimum confidence threshold to detect voice. Range 0.0–1.0. Omitted fields use Pipecat default.
@@ -4530,6 +5888,21 @@ type AuthBootResponseType string
 
 // BillingManagerAccount defines model for BillingManagerAccount.
 type BillingManagerAccount struct {
+	// AutoTopupAmount The credit amount in micros charged to the subscription's payment method by the auto top-up. 0 disables the auto top-up.
+	//
+	// Example: 20000000
+	AutoTopupAmount *int64 `json:"auto_topup_amount,omitempty"`
+
+	// AutoTopupThreshold The credit balance in micros which triggers the auto top-up.
+	//
+	// Example: 5000000
+	AutoTopupThreshold *int64 `json:"auto_topup_threshold,omitempty"`
+
+	// BalanceAlertThresholds The credit balances in micros which trigger the low balance alert. The `account_balance_low` webhook event and an email are sent when the balance drops below the threshold.
+	//
+	// Example: [10000000,5000000]
+	BalanceAlertThresholds *[]int64 `json:"balance_alert_thresholds,omitempty"`
+
 	// BalanceCredit The credit balance of the account in micros (1 USD = 1,000,000).
 	//
 	// Example: 1500000
@@ -4590,6 +5963,9 @@ type BillingManagerAccount struct {
 	// Example: basic
 	PlanType *BillingManagerAccountPlanType `json:"plan_type,omitempty"`
 
+	// SpendLimits The spending caps of the account by period and cost type. Calls and messages are rejected once the period's spending reaches the cap.
+	SpendLimits *[]BillingManagerAccountSpendLimit `json:"spend_limits,omitempty"`
+
 	// TmCreate The timestamp when the account was created.
 	//
 	// Example: 2026-01-15T09:30:00.000000Z
@@ -4618,6 +5994,26 @@ type BillingManagerAccount struct {
 
 // BillingManagerAccountAdmin Internal billing account representation for project admins. Includes all fields including status.
 type BillingManagerAccountAdmin struct {
+	// AutoTopupAmount The credit amount in micros charged to the subscription's payment method by the auto top-up. 0 disables the auto top-up.
+	//
+	// Example: 20000000
+	AutoTopupAmount *int64 `json:"auto_topup_amount,omitempty"`
+
+	// AutoTopupPendingId The ID of the auto top-up whose charge is waiting for the payment to complete. Other auto top-ups are skipped meanwhile. Nil UUID means none is pending.
+	//
+	// Example: 00000000-0000-0000-0000-000000000000
+	AutoTopupPendingId *string `json:"auto_topup_pending_id,omitempty"`
+
+	// AutoTopupThreshold The credit balance in micros which triggers the auto top-up.
+	//
+	// Example: 5000000
+	AutoTopupThreshold *int64 `json:"auto_topup_threshold,omitempty"`
+
+	// BalanceAlertThresholds The credit balances in micros which trigger the low balance alert. The `account_balance_low` webhook event and an email are sent when the balance drops below the threshold.
+	//
+	// Example: [10000000,5000000]
+	BalanceAlertThresholds *[]int64 `json:"balance_alert_thresholds,omitempty"`
+
 	// BalanceCredit The credit balance of the account in micros (1 USD = 1,000,000).
 	//
 	// Example: 1500000
@@ -4678,11 +6074,24 @@ type BillingManagerAccountAdmin struct {
 	// Example: basic
 	PlanType *BillingManagerAccountPlanType `json:"plan_type,omitempty"`
 
+	// RateDeckId The ID of the rate deck assigned to the account. Nil UUID means the rate deck of the plan type.
+	//
+	// Example: 00000000-0000-0000-0000-000000000000
+	RateDeckId *string `json:"rate_deck_id,omitempty"`
+
+	// SpendLimits The spending caps of the account by period and cost type. Calls and messages are rejected once the period's spending reaches the cap.
+	SpendLimits *[]BillingManagerAccountSpendLimit `json:"spend_limits,omitempty"`
+
 	// Status The status of the billing account.
 	//
 	// Example: active
 	Status *BillingManagerAccountStatus `json:"status,omitempty"`
 
+	// TmAutoTopupPending The timestamp the pending auto top-up was charged. Null if none is pending.
+	//
+	// Example: 2026-01-15T09:30:00.000000Z
+	TmAutoTopupPending *string `json:"tm_auto_topup_pending,omitempty"`
+
 	// TmCreate The timestamp when the account was created.
 	//
 	// Example: 2026-01-15T09:30:00.000000Z
@@ -4729,6 +6138,29 @@ type BillingManagerAccountPlanStatus string
 // Example: basic
 type BillingManagerAccountPlanType string
 
+// BillingManagerAccountSpendLimit The spending cap of the account in the period.
+type BillingManagerAccountSpendLimit struct {
+	// CostType The cost type the spend limit applies to. Empty applies to all cost types.
+	//
+	// Example: call_pstn_outgoing
+	CostType *string `json:"cost_type,omitempty"`
+
+	// LimitCredit The spending cap of the period in micros (1 USD = 1,000,000).
+	//
+	// Example: 10000000
+	LimitCredit *int64 `json:"limit_credit,omitempty"`
+
+	// Period The period of the spend limit. The periods are based on UTC.
+	//
+	// Example: daily
+	Period *BillingManagerAccountSpendLimitPeriod `json:"period,omitempty"`
+}
+
+// BillingManagerAccountSpendLimitPeriod The period of the spend limit. The periods are based on UTC.
+//
+// Example: daily
+type BillingManagerAccountSpendLimitPeriod string
+
 // BillingManagerAccountStatus The status of the billing account.
 //
 // Example: active
@@ -4761,11 +6193,16 @@ type BillingManagerBilling struct {
 	// Example: 470
 	BalanceTokenSnapshot *int64 `json:"balance_token_snapshot,omitempty"`
 
-	// BillableUnits The number of billable units (e.g. minutes, rounded up).
+	// BillableUnits The number of billable units. The billed minutes, rounded up, for the duration cost types.
 	//
 	// Example: 3
 	BillableUnits *int `json:"billable_units,omitempty"`
 
+	// BilledDuration The billed duration in seconds after the billing increments. 0 for the non-duration cost types.
+	//
+	// Example: 126
+	BilledDuration *int `json:"billed_duration,omitempty"`
+
 	// CostType The classification of the billing cost.
 	//
 	// Example: call_pstn_outgoing
@@ -4786,11 +6223,31 @@ type BillingManagerBilling struct {
 	// Example: a1b2c3d4-e5f6-7890-abcd-ef1234567890
 	IdempotencyKey *string `json:"idempotency_key,omitempty"`
 
+	// RateConnectionFee The connection fee of the applied rate in micros, charged once per billing.
+	//
+	// Example: 5000
+	RateConnectionFee *int64 `json:"rate_connection_fee,omitempty"`
+
 	// RateCreditPerUnit The credit rate per billable unit in micros.
 	//
 	// Example: 50000
 	RateCreditPerUnit *int64 `json:"rate_credit_per_unit,omitempty"`
 
+	// RateId The ID of the rate deck's rate applied to this billing. Nil UUID means the default rate.
+	//
+	// Example: 4c1b6f2e-ad31-11f0-9f5e-2b3c4d5e6f7a
+	RateId *string `json:"rate_id,omitempty"`
+
+	// RateIncrementInitial The initial billing increment in seconds. 0 means per-minute billing.
+	//
+	// Example: 60
+	RateIncrementInitial *int `json:"rate_increment_initial,omitempty"`
+
+	// RateIncrementSubsequent The subsequent billing increment in seconds.
+	//
+	// Example: 6
+	RateIncrementSubsequent *int `json:"rate_increment_subsequent,omitempty"`
+
 	// RateTokenPerUnit The token rate per billable unit.
 	//
 	// Example: 10
@@ -4811,61 +6268,310 @@ type BillingManagerBilling struct {
 	// Example: progressing
 	Status *BillingManagerBillingStatus `json:"status,omitempty"`
 
-	// TmBillingEnd The end timestamp of the billing period.
+	// TmBillingEnd The end timestamp of the billing period.
+	//
+	// Example: 2026-01-15T09:30:00.000000Z
+	TmBillingEnd *string `json:"tm_billing_end,omitempty"`
+
+	// TmBillingStart The start timestamp of the billing period.
+	//
+	// Example: 2026-01-15T09:30:00.000000Z
+	TmBillingStart *string `json:"tm_billing_start,omitempty"`
+
+	// TmCreate The creation timestamp.
+	//
+	// Example: 2026-01-15T09:30:00.000000Z
+	TmCreate *string `json:"tm_create,omitempty"`
+
+	// TmDelete The deletion timestamp, if applicable.
+	//
+	// Example: 2026-01-15T09:30:00.000000Z
+	TmDelete *string `json:"tm_delete,omitempty"`
+
+	// TmUpdate The last update timestamp.
+	//
+	// Example: 2026-01-15T09:30:00.000000Z
+	TmUpdate *string `json:"tm_update,omitempty"`
+
+	// TransactionType The nature of the ledger entry.
+	//
+	// Example: usage
+	TransactionType *BillingManagerBillingTransactionType `json:"transaction_type,omitempty"`
+
+	// UsageDuration The actual usage duration in seconds.
+	//
+	// Example: 125
+	UsageDuration *int `json:"usage_duration,omitempty"`
+}
+
+// BillingManagerBillingCostType The classification of the billing cost.
+//
+// Example: call_pstn_outgoing
+type BillingManagerBillingCostType string
+
+// BillingManagerBillingStatus Status of the billing.
+//
+// Example: progressing
+type BillingManagerBillingStatus string
+
+// BillingManagerBillingTransactionType The nature of the ledger entry.
+//
+// Example: usage
+type BillingManagerBillingTransactionType string
+
+// BillingManagerBillingreferenceType The type of reference associated with this billing.
+//
+// Example: call
+type BillingManagerBillingreferenceType string
+
+// BillingManagerEstimate The credit cost of a usage priced by the rate applied to the customer's billing account. The amount is positive and does not consider the account's token balance.
+type BillingManagerEstimate struct {
+	// AmountCredit The estimated credit in micros.
+	//
+	// Example: 50000
+	AmountCredit *int64 `json:"amount_credit,omitempty"`
+
+	// BillableUnits The billable minutes of the duration, rounded up.
+	//
+	// Example: 5
+	BillableUnits *int `json:"billable_units,omitempty"`
+
+	// BilledDuration The billed duration in seconds after the billing increments.
+	//
+	// Example: 300
+	BilledDuration *int `json:"billed_duration,omitempty"`
+
+	// CostType The classification of the billing cost.
+	//
+	// Example: call_pstn_outgoing
+	CostType *BillingManagerBillingCostType `json:"cost_type,omitempty"`
+
+	// Duration The estimated duration in seconds.
+	//
+	// Example: 300
+	Duration *int `json:"duration,omitempty"`
+
+	// RateConnectionFee The credit charged once per billing in micros.
+	//
+	// Example: 0
+	RateConnectionFee *int64 `json:"rate_connection_fee,omitempty"`
+
+	// RateCreditPerUnit The credit per minute (or per unit for the non-duration cost types) in micros.
+	//
+	// Example: 10000
+	RateCreditPerUnit *int64 `json:"rate_credit_per_unit,omitempty"`
+
+	// RateId The rate deck's rate applied. Empty means the default rate of the cost type.
+	//
+	// Example: 6f1e2d3c-b2e4-11f0-8a7b-3c4d5e6f7a8b
+	RateId *string `json:"rate_id,omitempty"`
+
+	// RateIncrementInitial The initial billing increment in seconds. 0 means per-minute billing.
+	//
+	// Example: 60
+	RateIncrementInitial *int `json:"rate_increment_initial,omitempty"`
+
+	// RateIncrementSubsequent The subsequent billing increment in seconds.
+	//
+	// Example: 6
+	RateIncrementSubsequent *int `json:"rate_increment_subsequent,omitempty"`
+}
+
+// BillingManagerStatement The billing account's monthly statement. The amounts follow the ledger's sign (usage is negative, top-up is positive).
+type BillingManagerStatement struct {
+	// AccountId The billing account ID. Returned from the `GET /billing_accounts/{id}` response.
+	//
+	// Example: b8c9d0e1-f2a3-4567-8901-23456789abcd
+	AccountId *string `json:"account_id,omitempty"`
+
+	// BalanceCreditEnd The credit balance at the end of the period in micros.
+	//
+	// Example: 7400000
+	BalanceCreditEnd *int64 `json:"balance_credit_end,omitempty"`
+
+	// BalanceTokenEnd The token balance at the end of the period.
+	//
+	// Example: 700
+	BalanceTokenEnd *int64 `json:"balance_token_end,omitempty"`
+
+	// CsvFileId The file ID of the statement's CSV. Downloadable from the `GET /storage_files/{id}/file` endpoint.
+	//
+	// Example: 70883f3c-b1a4-11f0-b0c9-2e4f6a8b1c80
+	CsvFileId *string `json:"csv_file_id,omitempty"`
+
+	// CustomerId The customer's unique identifier. Returned from the `GET /customers` response.
+	//
+	// Example: 7c4d2f3a-1b8e-4f5c-9a6d-3e2f1a0b4c5d
+	CustomerId *string `json:"customer_id,omitempty"`
+
+	// Id The unique identifier of the statement.
+	//
+	// Example: 3a4b8d2c-b1a5-11f0-95e1-7d8e9f0a1b20
+	Id *string `json:"id,omitempty"`
+
+	// Items The line items of the period.
+	Items *[]BillingManagerStatementItem `json:"items,omitempty"`
+
+	// PdfFileId The file ID of the statement's PDF. Downloadable from the `GET /storage_files/{id}/file` endpoint.
+	//
+	// Example: 70b1d6a2-b1a4-11f0-8d1a-6f3e2b9c4d90
+	PdfFileId *string `json:"pdf_file_id,omitempty"`
+
+	// TmCreate The creation timestamp.
+	//
+	// Example: 2026-10-01T03:00:12.000000Z
+	TmCreate *string `json:"tm_create,omitempty"`
+
+	// TmDelete The deletion timestamp, if applicable.
+	//
+	// Example: 2026-10-01T03:00:12.000000Z
+	TmDelete *string `json:"tm_delete,omitempty"`
+
+	// TmPeriodEnd The end of the statement period (exclusive, UTC).
+	//
+	// Example: 2026-10-01T00:00:00.000000Z
+	TmPeriodEnd *string `json:"tm_period_end,omitempty"`
+
+	// TmPeriodStart The start of the statement period (inclusive, UTC).
+	//
+	// Example: 2026-09-01T00:00:00.000000Z
+	TmPeriodStart *string `json:"tm_period_start,omitempty"`
+
+	// TmUpdate The last update timestamp.
+	//
+	// Example: 2026-10-01T03:00:12.000000Z
+	TmUpdate *string `json:"tm_update,omitempty"`
+
+	// TopDestinations The destinations of the largest usage in the period. Up to 10.
+	TopDestinations *[]BillingManagerStatementDestination `json:"top_destinations,omitempty"`
+
+	// TopUps The top-ups of the period.
+	TopUps *[]BillingManagerStatementTopUp `json:"top_ups,omitempty"`
+
+	// TotalTopUpCredit The total credit top-up of the period in micros.
+	//
+	// Example: 10000000
+	TotalTopUpCredit *int64 `json:"total_top_up_credit,omitempty"`
+
+	// TotalTopUpToken The total token top-up of the period.
+	//
+	// Example: 1000
+	TotalTopUpToken *int64 `json:"total_top_up_token,omitempty"`
+
+	// TotalUsageCredit The total credit usage of the period in micros.
+	//
+	// Example: -2600000
+	TotalUsageCredit *int64 `json:"total_usage_credit,omitempty"`
+
+	// TotalUsageToken The total token usage of the period.
+	//
+	// Example: -300
+	TotalUsageToken *int64 `json:"total_usage_token,omitempty"`
+}
+
+// BillingManagerStatementDestination The period's usage billed by the same rate deck prefix.
+type BillingManagerStatementDestination struct {
+	// AmountCredit The sum of the credit deltas in micros.
+	//
+	// Example: -610000
+	AmountCredit *int64 `json:"amount_credit,omitempty"`
+
+	// CostType The classification of the billing cost.
+	//
+	// Example: call_pstn_outgoing
+	CostType *BillingManagerBillingCostType `json:"cost_type,omitempty"`
+
+	// Count The number of the billings.
+	//
+	// Example: 12
+	Count *int `json:"count,omitempty"`
+
+	// Prefix The destination prefix of the applied rate. Empty for the default rate.
+	//
+	// Example: 8210
+	Prefix *string `json:"prefix,omitempty"`
+
+	// RateId The ID of the rate deck's rate applied. Nil UUID means the default rate.
+	//
+	// Example: 4c1b6f2e-ad31-11f0-9f5e-2b3c4d5e6f7a
+	RateId *string `json:"rate_id,omitempty"`
+
+	// UsageDuration The sum of the usage durations in seconds.
+	//
+	// Example: 1830
+	UsageDuration *int `json:"usage_duration,omitempty"`
+}
+
+// BillingManagerStatementItem The period's ledger entries of the same transaction type, reference type and cost type.
+type BillingManagerStatementItem struct {
+	// AmountCredit The sum of the credit deltas in micros (negative for usage, positive for top-up).
+	//
+	// Example: -2600000
+	AmountCredit *int64 `json:"amount_credit,omitempty"`
+
+	// AmountToken The sum of the token deltas (negative for usage, positive for top-up).
 	//
-	// Example: 2026-01-15T09:30:00.000000Z
-	TmBillingEnd *string `json:"tm_billing_end,omitempty"`
+	// Example: -300
+	AmountToken *int64 `json:"amount_token,omitempty"`
 
-	// TmBillingStart The start timestamp of the billing period.
+	// BillableUnits The sum of the billable units.
 	//
-	// Example: 2026-01-15T09:30:00.000000Z
-	TmBillingStart *string `json:"tm_billing_start,omitempty"`
+	// Example: 130
+	BillableUnits *int `json:"billable_units,omitempty"`
 
-	// TmCreate The creation timestamp.
+	// CostType The classification of the billing cost.
 	//
-	// Example: 2026-01-15T09:30:00.000000Z
-	TmCreate *string `json:"tm_create,omitempty"`
+	// Example: call_pstn_outgoing
+	CostType *BillingManagerBillingCostType `json:"cost_type,omitempty"`
 
-	// TmDelete The deletion timestamp, if applicable.
+	// Count The number of the ledger entries.
 	//
-	// Example: 2026-01-15T09:30:00.000000Z
-	TmDelete *string `json:"tm_delete,omitempty"`
+	// Example: 42
+	Count *int `json:"count,omitempty"`
 
-	// TmUpdate The last update timestamp.
+	// ReferenceType The type of reference associated with this billing.
 	//
-	// Example: 2026-01-15T09:30:00.000000Z
-	TmUpdate *string `json:"tm_update,omitempty"`
+	// Example: call
+	ReferenceType *BillingManagerBillingreferenceType `json:"reference_type,omitempty"`
 
 	// TransactionType The nature of the ledger entry.
 	//
 	// Example: usage
 	TransactionType *BillingManagerBillingTransactionType `json:"transaction_type,omitempty"`
 
-	// UsageDuration The actual usage duration in seconds.
+	// UsageDuration The sum of the usage durations in seconds.
 	//
-	// Example: 125
+	// Example: 7420
 	UsageDuration *int `json:"usage_duration,omitempty"`
 }
 
-// BillingManagerBillingCostType The classification of the billing cost.
-//
-// Example: call_pstn_outgoing
-type BillingManagerBillingCostType string
+// BillingManagerStatementTopUp A top-up ledger entry of the period.
+type BillingManagerStatementTopUp struct {
+	// AmountCredit The credit added in micros.
+	//
+	// Example: 10000000
+	AmountCredit *int64 `json:"amount_credit,omitempty"`
 
-// BillingManagerBillingStatus Status of the billing.
-//
-// Example: progressing
-type BillingManagerBillingStatus string
+	// AmountToken The token added.
+	//
+	// Example: 0
+	AmountToken *int64 `json:"amount_token,omitempty"`
 
-// BillingManagerBillingTransactionType The nature of the ledger entry.
-//
-// Example: usage
-type BillingManagerBillingTransactionType string
+	// BillingId The ID of the top-up billing. Returned from the `GET /billings` response.
+	//
+	// Example: 550e8400-e29b-41d4-a716-446655440000
+	BillingId *string `json:"billing_id,omitempty"`
 
-// BillingManagerBillingreferenceType The type of reference associated with this billing.
-//
-// Example: call
-type BillingManagerBillingreferenceType string
+	// ReferenceType The type of reference associated with this billing.
+	//
+	// Example: call
+	ReferenceType *BillingManagerBillingreferenceType `json:"reference_type,omitempty"`
+
+	// TmCreate The timestamp of the top-up.
+	//
+	// Example: 2026-09-03T10:20:30.000000Z
+	TmCreate *string `json:"tm_create,omitempty"`
+}
 
 // CallManagerCall defines model for CallManagerCall.
 type CallManagerCall struct {
@@ -4924,6 +6630,11 @@ type CallManagerCall struct {
 	// Example: 4d5e6f7a-8b9c-0123-def0-123456789012
 	MasterCallId *string `json:"master_call_id,omitempty"`
 
+	// MaxCost The call's max cost in micros. The call is hung up with the `max_cost` hangup reason once its cost reaches it. 0 means no limit.
+	//
+	// Example: 3000000
+	MaxCost *int64 `json:"max_cost,omitempty"`
+
 	// Metadata Internal metadata for the call. Contains key-value pairs set by the system.
 	// Currently supported keys:
 	// - `rtp_debug` (boolean): When `true`, RTPEngine is capturing RTP traffic for this call.
@@ -4957,6 +6668,11 @@ type CallManagerCall struct {
 	// Example: ["e5f6a7b8-c9d0-1234-5678-90abcdef0123"]
 	RecordingIds *[]string `json:"recording_ids,omitempty"`
 
+	// RunningCost The credit in micros accrued so far by the progressing call.
+	//
+	// Example: 20000
+	RunningCost *int64 `json:"running_cost,omitempty"`
+
 	// Source Contains source or destination detail info.
 	Source *CommonAddress `json:"source,omitempty"`
 
@@ -5468,6 +7184,9 @@ type CampaignManagerCampaign struct {
 	// Actions Ordered list of actions to execute for each campaign call.
 	Actions *[]FlowManagerAction `json:"actions,omitempty"`
 
+	// CallingWindows The targets are dialed only in these windows of the callee's local time. Empty means any time.
+	CallingWindows *[]CampaignManagerCampaignCallingWindow `json:"calling_windows,omitempty"`
+
 	// CustomerId The unique identifier of the customer. Returned from the `GET /customers` response.
 	//
 	// Example: 7c4d2f3a-1b8e-4f5c-9a6d-3e2f1a0b4c5d
@@ -5478,6 +7197,11 @@ type CampaignManagerCampaign struct {
 	// Example: Quarterly customer outreach campaign
 	Detail *string `json:"detail,omitempty"`
 
+	// DialMode Dial mode of the campaign. Empty means power.
+	//
+	// Example: predictive
+	DialMode *CampaignManagerCampaignDialMode `json:"dial_mode,omitempty"`
+
 	// EndHandle Behavior of the campaign after outdial has no more targets.
 	//
 	// Example: stop
@@ -5488,6 +7212,11 @@ type CampaignManagerCampaign struct {
 	// Example: c3d4e5f6-a7b8-9012-3456-7890abcdef01
 	Id *string `json:"id,omitempty"`
 
+	// MaxAbandonRate Max abandon rate percentage of the predictive dial mode. 0 means the default 3.
+	//
+	// Example: 3
+	MaxAbandonRate *int `json:"max_abandon_rate,omitempty"`
+
 	// Name Display name of the campaign.
 	//
 	// Example: Q1 Outbound Campaign
@@ -5523,6 +7252,11 @@ type CampaignManagerCampaign struct {
 	// Example: run
 	Status *CampaignManagerCampaignStatus `json:"status,omitempty"`
 
+	// Timezone IANA time zone used when the callee's time zone is unknown. Empty means UTC.
+	//
+	// Example: America/New_York
+	Timezone *string `json:"timezone,omitempty"`
+
 	// TmCreate Timestamp when the campaign was created.
 	//
 	// Example: 2026-01-15T09:30:00.000000Z
@@ -5544,6 +7278,34 @@ type CampaignManagerCampaign struct {
 	Type *CampaignManagerCampaignType `json:"type,omitempty"`
 }
 
+// CampaignManagerCampaignCallingWindow Days and hours the callee can be called. Evaluated in the callee's local time.
+type CampaignManagerCampaignCallingWindow struct {
+	// Days Days of the week the window is open. Empty means every day.
+	//
+	// Example: ["monday","tuesday","wednesday","thursday","friday"]
+	Days *[]CampaignManagerCampaignCallingWindowDay `json:"days,omitempty"`
+
+	// End End time of the window in HH:MM format. Exclusive. 24:00 means the end of the day.
+	//
+	// Example: 21:00
+	End string `json:"end"`
+
+	// Start Start time of the window in HH:MM format. Inclusive.
+	//
+	// Example: 09:00
+	Start string `json:"start"`
+}
+
+// CampaignManagerCampaignCallingWindowDay Day of the week.
+//
+// Example: monday
+type CampaignManagerCampaignCallingWindowDay string
+
+// CampaignManagerCampaignDialMode Dial mode of the campaign. Empty means power.
+//
+// Example: predictive
+type CampaignManagerCampaignDialMode string
+
 // CampaignManagerCampaignEndHandle Behavior of the campaign after outdial has no more targets.
 //
 // Example: stop
@@ -5554,6 +7316,103 @@ type CampaignManagerCampaignEndHandle string
 // Example: run
 type CampaignManagerCampaignExecute string
 
+// CampaignManagerCampaignResult The campaign's result of a single outdial target.
+type CampaignManagerCampaignResult struct {
+	// Attempts The dialing attempts to the outdial target. Oldest first.
+	Attempts *[]CampaignManagerCampaignResultAttempt `json:"attempts,omitempty"`
+
+	// CampaignId The unique identifier of the campaign. Returned from the `POST /campaigns` or `GET /campaigns` response.
+	//
+	// Example: c3d4e5f6-a7b8-9012-3456-7890abcdef01
+	CampaignId *string `json:"campaign_id,omitempty"`
+
+	// Name Name of the outdial target.
+	//
+	// Example: John Smith
+	Name *string `json:"name,omitempty"`
+
+	// OutdialTargetId The unique identifier of the outbound dial target. Returned from the `GET /outdials/{id}/targets` response.
+	//
+	// Example: 3c4d5e6f-7a8b-9012-cdef-012345678901
+	OutdialTargetId *string `json:"outdial_target_id,omitempty"`
+
+	// Status Status of the outdial target.
+	//
+	// Example: done
+	Status *string `json:"status,omitempty"`
+
+	// TmCreate Timestamp when the outdial target was created.
+	//
+	// Example: 2026-01-15T09:00:00.000000Z
+	TmCreate *string `json:"tm_create,omitempty"`
+}
+
+// CampaignManagerCampaignResultAttempt A single dialing attempt to the outdial target.
+type CampaignManagerCampaignResultAttempt struct {
+	// Abandoned Whether the answered call left the queue before an agent was connected.
+	//
+	// Example: false
+	Abandoned *bool `json:"abandoned,omitempty"`
+
+	// CampaigncallId The unique identifier of the campaign call. Returned from the `GET /campaigncalls` response.
+	//
+	// Example: 550e8400-e29b-41d4-a716-446655440000
+	CampaigncallId *string `json:"campaigncall_id,omitempty"`
+
+	// Destination Contains source or destination detail info.
+	Destination *CommonAddress `json:"destination,omitempty"`
+
+	// DestinationIndex Index of the dialed destination.
+	//
+	// Example: 0
+	DestinationIndex *int `json:"destination_index,omitempty"`
+
+	// Disposition The business outcome of the campaign call. Empty if not set.
+	//
+	// Example: sale
+	Disposition *string `json:"disposition,omitempty"`
+
+	// Duration Duration in milliseconds from the dialing to the end. 0 until the campaign call is done.
+	//
+	// Example: 95000
+	Duration *int `json:"duration,omitempty"`
+
+	// Result Result of the campaign call attempt.
+	//
+	// Example: success
+	Result *CampaignManagerCampaigncallResult `json:"result,omitempty"`
+
+	// Status Status of the campaign call.
+	//
+	// Example: progressing
+	Status *CampaignManagerCampaigncallStatus `json:"status,omitempty"`
+
+	// TalkDuration Duration in milliseconds from the answer to the end. 0 if the call was not answered.
+	//
+	// Example: 80000
+	TalkDuration *int `json:"talk_duration,omitempty"`
+
+	// TmCreate Timestamp when the campaign call was created.
+	//
+	// Example: 2026-01-15T09:30:00.000000Z
+	TmCreate *string `json:"tm_create,omitempty"`
+
+	// TmEnd Timestamp when the campaign call was done.
+	//
+	// Example: 2026-01-15T09:31:35.000000Z
+	TmEnd *string `json:"tm_end,omitempty"`
+
+	// TmProgressing Timestamp when the campaign call was answered.
+	//
+	// Example: 2026-01-15T09:30:15.000000Z
+	TmProgressing *string `json:"tm_progressing,omitempty"`
+
+	// TryCount Number of dialing attempts made to the destination.
+	//
+	// Example: 1
+	TryCount *int `json:"try_count,omitempty"`
+}
+
 // CampaignManagerCampaignStatus Status of the campaign.
 //
 // Example: run
@@ -5566,11 +7425,21 @@ type CampaignManagerCampaignType string
 
 // CampaignManagerCampaigncall defines model for CampaignManagerCampaigncall.
 type CampaignManagerCampaigncall struct {
+	// Abandoned Whether the answered call left the queue before an agent was connected.
+	//
+	// Example: false
+	Abandoned *bool `json:"abandoned,omitempty"`
+
 	// ActiveflowId The unique identifier of the activeflow. Returned from the `GET /activeflows` response.
 	//
 	// Example: 550e8400-e29b-41d4-a716-446655440000
 	ActiveflowId *string `json:"activeflow_id,omitempty"`
 
+	// AgentId The unique identifier of the agent who accepted the previewing campaign call. Returned from the `GET /agents` response.
+	//
+	// Example: 4d5e6f7a-8b9c-0123-def0-123456789012
+	AgentId *string `json:"agent_id,omitempty"`
+
 	// CampaignId The unique identifier of the campaign. Returned from the `POST /campaigns` or `GET /campaigns` response.
 	//
 	// Example: c3d4e5f6-a7b8-9012-3456-7890abcdef01
@@ -5589,6 +7458,11 @@ type CampaignManagerCampaigncall struct {
 	// Example: 0
 	DestinationIndex *int `json:"destination_index,omitempty"`
 
+	// Disposition The business outcome of the campaign call. One of the customer's disposition codes. Returned from the `GET /dispositions` response. Empty if not set.
+	//
+	// Example: sale
+	Disposition *string `json:"disposition,omitempty"`
+
 	// FlowId The unique identifier of the flow. Returned from the `POST /flows` or `GET /flows` response.
 	//
 	// Example: 550e8400-e29b-41d4-a716-446655440000
@@ -5652,6 +7526,16 @@ type CampaignManagerCampaigncall struct {
 	// Example: 2026-01-15T09:30:00.000000Z
 	TmDelete *string `json:"tm_delete,omitempty"`
 
+	// TmEnd Timestamp when the campaign call was done.
+	//
+	// Example: 2026-01-15T09:32:00.000000Z
+	TmEnd *string `json:"tm_end,omitempty"`
+
+	// TmProgressing Timestamp when the campaign call was answered.
+	//
+	// Example: 2026-01-15T09:30:00.000000Z
+	TmProgressing *string `json:"tm_progressing,omitempty"`
+
 	// TmUpdate Timestamp when the campaign call was last updated.
 	//
 	// Example: 2026-01-15T09:30:00.000000Z
@@ -5678,6 +7562,122 @@ type CampaignManagerCampaigncallResult string
 // Example: progressing
 type CampaignManagerCampaigncallStatus string
 
+// CampaignManagerCampaignresultjob The asynchronous export of the campaign's results to a csv file.
+type CampaignManagerCampaignresultjob struct {
+	// CampaignId The unique identifier of the campaign. Returned from the `POST /campaigns` or `GET /campaigns` response.
+	//
+	// Example: c3d4e5f6-a7b8-9012-3456-7890abcdef01
+	CampaignId *string `json:"campaign_id,omitempty"`
+
+	// CustomerId The unique identifier for the customer associated with the campaign result job. Returned from the `GET /customers` response.
+	//
+	// Example: 7c4d2f3a-1b8e-4f5c-9a6d-3e2f1a0b4c5d
+	CustomerId *string `json:"customer_id,omitempty"`
+
+	// Detail The reason of the failure.
+	//
+	// Example:
+	Detail *string `json:"detail,omitempty"`
+
+	// FileId The unique identifier of the exported csv file. Empty until the job is done. Returned from the `GET /files` response.
+	//
+	// Example: 9e8d7c6b-5a4f-4e3d-8c2b-1a0f9e8d7c6b
+	FileId *string `json:"file_id,omitempty"`
+
+	// Id The unique identifier for the campaign result job.
+	//
+	// Example: 4d5e6f7a-8b9c-4d0e-9f1a-2b3c4d5e6f7a
+	Id *string `json:"id,omitempty"`
+
+	// RowCount The number of the exported csv rows. Each row is a dialing attempt.
+	//
+	// Example: 1450
+	RowCount *int `json:"row_count,omitempty"`
+
+	// Status The status of the campaign result job.
+	//
+	// Example: processing
+	Status *CampaignManagerCampaignresultjobStatus `json:"status,omitempty"`
+
+	// TargetCount The number of the exported outdial targets.
+	//
+	// Example: 1000
+	TargetCount *int `json:"target_count,omitempty"`
+
+	// TmCreate Timestamp when the campaign result job was created.
+	//
+	// Example: 2026-01-15T09:30:00.000000Z
+	TmCreate *string `json:"tm_create,omitempty"`
+
+	// TmDelete Timestamp when the campaign result job was deleted.
+	//
+	// Example: 2026-01-15T09:30:00.000000Z
+	TmDelete *string `json:"tm_delete,omitempty"`
+
+	// TmUpdate Timestamp when the campaign result job was last updated.
+	//
+	// Example: 2026-01-15T09:30:00.000000Z
+	TmUpdate *string `json:"tm_update,omitempty"`
+}
+
+// CampaignManagerCampaignresultjobStatus The status of the campaign result job.
+//
+// Example: processing
+type CampaignManagerCampaignresultjobStatus string
+
+// CampaignManagerDisposition A customer defined business outcome of the campaign call. e.g. sale, callback requested, wrong number.
+type CampaignManagerDisposition struct {
+	// Code The code of the disposition. Unique in the customer. Lowercase letters, digits and underscores.
+	//
+	// Example: wrong_number
+	Code *string `json:"code,omitempty"`
+
+	// CustomerId The unique identifier of the customer. Returned from the `GET /customers` response.
+	//
+	// Example: 7c4d2f3a-1b8e-4f5c-9a6d-3e2f1a0b4c5d
+	CustomerId *string `json:"customer_id,omitempty"`
+
+	// Detail Detailed description of the disposition.
+	//
+	// Example: The number belongs to someone else.
+	Detail *string `json:"detail,omitempty"`
+
+	// Id The unique identifier of the disposition.
+	//
+	// Example: 550e8400-e29b-41d4-a716-446655440000
+	Id *string `json:"id,omitempty"`
+
+	// Name Name of the disposition.
+	//
+	// Example: Wrong number
+	Name *string `json:"name,omitempty"`
+
+	// RetryMode Decides the outdial target's retry when the campaign call with the disposition is done.
+	//
+	// Example: no_retry
+	RetryMode *CampaignManagerDispositionRetryMode `json:"retry_mode,omitempty"`
+
+	// TmCreate Timestamp when the disposition was created.
+	//
+	// Example: 2026-01-15T09:30:00.000000Z
+	TmCreate *string `json:"tm_create,omitempty"`
+
+	// TmDelete Timestamp when the disposition was deleted.
+	//
+	// Example: 2026-01-15T09:30:00.000000Z
+	TmDelete *string `json:"tm_delete,omitempty"`
+
+	// TmUpdate Timestamp when the disposition was last updated.
+	//
+	// Example: 2026-01-15T09:30:00.000000Z
+	TmUpdate *string `json:"tm_update,omitempty"`
+}
+
+// CampaignManagerDispositionRetryMode Decides the outdial target's retry when the campaign call with the disposition is done.
+//
+// Example: no_retry
+type CampaignManagerDispositionRetryMode string
+
 // CampaignManagerOutplan defines model for CampaignManagerOutplan.
 type CampaignManagerOutplan struct {
 	// CustomerId The unique identifier of the customer. Returned from the `GET /customers` response.
@@ -6834,6 +8834,9 @@ type CustomerManagerEmailVerifyResult struct {
 // Updatable by CustomerAdmin via `PUT /customer/metadata`
 // or by ProjectSuperAdmin via `PUT /customers/{id}/metadata`.
 type CustomerManagerMetadata struct {
+	// PiiRedaction Redaction of personal data in the AI conversations. The detected values are redacted before they are stored in the AI messages and before they reach the LLM.
+	PiiRedaction *AIManagerRedaction `json:"pii_redaction,omitempty"`
+
 	// RtpDebug When set to `true`, RTPEngine captures RTP traffic as PCAP files for this customer's calls.
 	// Use this to debug audio quality issues (one-way audio, codec problems, jitter).
 	// Default is `false`. Enabling this increases storage usage — disable after debugging.
@@ -6967,6 +8970,7 @@ type FlowManagerAction struct {
 	NextId *string `json:"next_id,omitempty"`
 
 	// Option Additional options based on the `type` field.
+	// - For `FlowManagerActionTypeAIExtract`: see `FlowManagerActionOptionAIExtract`
 	// - For `FlowManagerActionTypeAISummary`: see `FlowManagerActionOptionAISummary`
 	// - For `FlowManagerActionTypeAITalk`: see `FlowManagerActionOptionAITalk`
 	// - For `FlowManagerActionTypeAMD`: see `FlowManagerActionOptionAMD`
@@ -6980,6 +8984,7 @@ type FlowManagerAction struct {
 	// - For `FlowManagerActionTypeConversationSend`: see FlowManagerActionOptionConversationSend
 	// - For `FlowManagerActionTypeDigitsReceive`: see FlowManagerActionOptionDigitsReceive
 	// - For `FlowManagerActionTypeDigitsSend`: see FlowManagerActionOptionDigitsSend
+	// - For `FlowManagerActionTypeDispositionSet`: see FlowManagerActionOptionDispositionSet
 	// - For `FlowManagerActionTypeEcho`: see FlowManagerActionOptionEcho
 	// - For `FlowManagerActionTypeEmailSend`: see FlowManagerActionOptionEmailSend
 	// - For `FlowManagerActionTypeExternalMediaStart`: see FlowManagerActionOptionExternalMediaStart
@@ -7004,15 +9009,48 @@ type FlowManagerAction struct {
 	// - ...
 	Option *map[string]interface{} `json:"option,omitempty"`
 
-	// TmExecute Timestamp when this action was executed.
+	// TmExecute Timestamp when this action was executed.
+	//
+	// Example: 2026-01-15T09:30:00.000000Z
+	TmExecute *string `json:"tm_execute,omitempty"`
+
+	// Type Type of the action.
+	//
+	// Example: talk
+	Type FlowManagerActionType `json:"type"`
+}
+
+// FlowManagerActionOptionAIExtract defines model for FlowManagerActionOptionAIExtract.
+type FlowManagerActionOptionAIExtract struct {
+	// Language BCP47 language code for the extracted values.
+	//
+	// Example: en-US
+	Language *string `json:"language,omitempty"`
+
+	// OnEndFlowId The unique identifier of the flow to execute when AI extraction completes. Returned from the `POST /flows` or `GET /flows` response.
+	//
+	// Example: a1b2c3d4-e5f6-7890-1234-567890abcdef
+	OnEndFlowId *string `json:"on_end_flow_id,omitempty"`
+
+	// ReferenceId The unique identifier of the referenced resource. Returned from the corresponding resource endpoint.
 	//
-	// Example: 2026-01-15T09:30:00.000000Z
-	TmExecute *string `json:"tm_execute,omitempty"`
+	// Example: f6a7b8c9-d0e1-2345-6789-0abcdef01234
+	ReferenceId *string `json:"reference_id,omitempty"`
 
-	// Type Type of the action.
+	// ReferenceType Type of reference for the AI extraction.
 	//
-	// Example: talk
-	Type FlowManagerActionType `json:"type"`
+	// Example: call
+	ReferenceType *AIManagerExtractionReferenceType `json:"reference_type,omitempty"`
+
+	// Schema JSON schema of the data to extract. The root must be an object with properties.
+	//
+	// Example: {"properties":{"intent":{"type":"string"}},"type":"object"}
+	Schema *map[string]interface{} `json:"schema,omitempty"`
+
+	// SetVariables If true, each extracted field is set as the flow variable `voipbin.ai_extract.result.<field>`.
+	//
+	// Example: true
+	SetVariables *bool `json:"set_variables,omitempty"`
 }
 
 // FlowManagerActionOptionAISummary defines model for FlowManagerActionOptionAISummary.
@@ -7243,6 +9281,14 @@ type FlowManagerActionOptionDigitsSend struct {
 	Interval *int `json:"interval,omitempty"`
 }
 
+// FlowManagerActionOptionDispositionSet defines model for FlowManagerActionOptionDispositionSet.
+type FlowManagerActionOptionDispositionSet struct {
+	// Disposition The code of the disposition to set on the activeflow's campaign call. Returned from the `GET /dispositions` response. Does nothing if the activeflow was not started by a campaign call.
+	//
+	// Example: sale
+	Disposition *string `json:"disposition,omitempty"`
+}
+
 // FlowManagerActionOptionEcho defines model for FlowManagerActionOptionEcho.
 type FlowManagerActionOptionEcho struct {
 	// Duration Duration of the echo in milliseconds.
@@ -8013,6 +10059,11 @@ type OutdialManagerOutdial struct {
 
 // OutdialManagerOutdialtarget defines model for OutdialManagerOutdialtarget.
 type OutdialManagerOutdialtarget struct {
+	// CallbackAgentId The agent who handles the scheduled callback. Returned from the `GET /agents` response. Empty means any agent.
+	//
+	// Example: 7c8d9e0f-1a2b-3c4d-5e6f-7a8b9c0d1e2f
+	CallbackAgentId *string `json:"callback_agent_id,omitempty"`
+
 	// Data The data associated with the outdial target.
 	//
 	// Example: vip-tag
@@ -8058,6 +10109,16 @@ type OutdialManagerOutdialtarget struct {
 	// Example: idle
 	Status *OutdialManagerOutdialtargetStatus `json:"status,omitempty"`
 
+	// Timezone IANA time zone of the callee. Empty means derived from the destination number.
+	//
+	// Example: America/New_York
+	Timezone *string `json:"timezone,omitempty"`
+
+	// TmCallback The scheduled callback timestamp. The campaign does not dial the outdial target until this time. Null means no callback is scheduled.
+	//
+	// Example: 2026-01-15T15:00:00.000000Z
+	TmCallback *string `json:"tm_callback,omitempty"`
+
 	// TmCreate The creation timestamp.
 	//
 	// Example: 2026-01-15T09:30:00.000000Z
@@ -8104,6 +10165,261 @@ type OutdialManagerOutdialtarget struct {
 // Example: idle
 type OutdialManagerOutdialtargetStatus string
 
+// OutdialManagerOutdialtargetjob defines model for OutdialManagerOutdialtargetjob.
+type OutdialManagerOutdialtargetjob struct {
+	// CustomerId The unique identifier for the customer associated with the outdial target job. Returned from the `GET /customers` response.
+	//
+	// Example: 7c4d2f3a-1b8e-4f5c-9a6d-3e2f1a0b4c5d
+	CustomerId *string `json:"customer_id,omitempty"`
+
+	// Detail The reason of the failure.
+	//
+	// Example:
+	Detail *string `json:"detail,omitempty"`
+
+	// DuplicateCount The number of the rows skipped because of the duplicated destination 0.
+	//
+	// Example: 4
+	DuplicateCount *int `json:"duplicate_count,omitempty"`
+
+	// ErrorCount The number of the invalid rows.
+	//
+	// Example: 6
+	ErrorCount *int `json:"error_count,omitempty"`
+
+	// ErrorFileId The unique identifier of the error report csv file listing the invalid rows with the reasons. Returned from the `GET /files` response.
+	//
+	// Example: 1b2c3d4e-5f6a-4b7c-8d9e-0f1a2b3c4d5e
+	ErrorFileId *string `json:"error_file_id,omitempty"`
+
+	// FileId The unique identifier of the csv file. The imported file for the `import` and the exported file for the `export`. Returned from the `GET /files` response.
+	//
+	// Example: 9e8d7c6b-5a4f-4e3d-8c2b-1a0f9e8d7c6b
+	FileId *string `json:"file_id,omitempty"`
+
+	// Id The unique identifier for the outdial target job.
+	//
+	// Example: 6a7b8c9d-0e1f-4a2b-8c3d-4e5f6a7b8c9d
+	Id *string `json:"id,omitempty"`
+
+	// Mapping The csv header names of the outdial target's fields. An omitted field uses the field's own name as the header name.
+	Mapping *OutdialManagerOutdialtargetjobMapping `json:"mapping,omitempty"`
+
+	// OutdialId The unique identifier of the outdial. Returned from the `POST /outdials` or `GET /outdials` response.
+	//
+	// Example: 3c4d5e6f-7a8b-9012-cdef-012345678901
+	OutdialId *string `json:"outdial_id,omitempty"`
+
+	// ProcessedCount The number of the processed rows.
+	//
+	// Example: 1000
+	ProcessedCount *int `json:"processed_count,omitempty"`
+
+	// Status The status of the outdial target job.
+	//
+	// Example: processing
+	Status *OutdialManagerOutdialtargetjobStatus `json:"status,omitempty"`
+
+	// SuccessCount The number of the imported or exported targets.
+	//
+	// Example: 990
+	SuccessCount *int `json:"success_count,omitempty"`
+
+	// TmCreate Timestamp when the outdial target job was created.
+	//
+	// Example: 2026-01-15T09:30:00.000000Z
+	TmCreate *string `json:"tm_create,omitempty"`
+
+	// TmDelete Timestamp when the outdial target job was deleted.
+	//
+	// Example: 2026-01-15T09:30:00.000000Z
+	TmDelete *string `json:"tm_delete,omitempty"`
+
+	// TmUpdate Timestamp when the outdial target job was last updated.
+	//
+	// Example: 2026-01-15T09:30:00.000000Z
+	TmUpdate *string `json:"tm_update,omitempty"`
+
+	// TotalCount The number of the csv data rows or the exported targets.
+	//
+	// Example: 1000
+	TotalCount *int `json:"total_count,omitempty"`
+
+	// Type The type of the outdial target job.
+	//
+	// Example: import
+	Type *OutdialManagerOutdialtargetjobType `json:"type,omitempty"`
+}
+
+// OutdialManagerOutdialtargetjobMapping The csv header names of the outdial target's fields. An omitted field uses the field's own name as the header name.
+type OutdialManagerOutdialtargetjobMapping struct {
+	// Data The header name of the target's data column.
+	//
+	// Example: account_no
+	Data *string `json:"data,omitempty"`
+
+	// Destination0 The header name of the target's destination 0 column.
+	//
+	// Example: mobile
+	Destination0 *string `json:"destination_0,omitempty"`
+
+	// Destination1 The header name of the target's destination 1 column.
+	//
+	// Example: home
+	Destination1 *string `json:"destination_1,omitempty"`
+
+	// Destination2 The header name of the target's destination 2 column.
+	//
+	// Example: office
+	Destination2 *string `json:"destination_2,omitempty"`
+
+	// Destination3 The header name of the target's destination 3 column.
+	//
+	// Example: phone_3
+	Destination3 *string `json:"destination_3,omitempty"`
+
+	// Destination4 The header name of the target's destination 4 column.
+	//
+	// Example: phone_4
+	Destination4 *string `json:"destination_4,omitempty"`
+
+	// Detail The header name of the target's detail column.
+	//
+	// Example: memo
+	Detail *string `json:"detail,omitempty"`
+
+	// Name The header name of the target's name column.
+	//
+	// Example: customer
+	Name *string `json:"name,omitempty"`
+
+	// Timezone The header name of the target's timezone column.
+	//
+	// Example: tz
+	Timezone *string `json:"timezone,omitempty"`
+}
+
+// OutdialManagerOutdialtargetjobStatus The status of the outdial target job.
+//
+// Example: processing
+type OutdialManagerOutdialtargetjobStatus string
+
+// OutdialManagerOutdialtargetjobType The type of the outdial target job.
+//
+// Example: import
+type OutdialManagerOutdialtargetjobType string
+
+// OutdialManagerSuppression defines model for OutdialManagerSuppression.
+type OutdialManagerSuppression struct {
+	// CustomerId The unique identifier for the customer associated with the suppression. Returned from the `GET /customers` response.
+	//
+	// Example: 7c4d2f3a-1b8e-4f5c-9a6d-3e2f1a0b4c5d
+	CustomerId *string `json:"customer_id,omitempty"`
+
+	// Detail The detailed description of the suppression.
+	//
+	// Example: Requested by phone
+	Detail *string `json:"detail,omitempty"`
+
+	// Id The unique identifier for the suppression.
+	//
+	// Example: 8f1c2d3e-4a5b-4c6d-9e7f-0a1b2c3d4e5f
+	Id *string `json:"id,omitempty"`
+
+	// ListType The type of the suppression list.
+	//
+	// Example: dnc
+	ListType *OutdialManagerSuppressionListType `json:"list_type,omitempty"`
+
+	// Target The suppressed destination number in E.164 format.
+	//
+	// Example: +15551234567
+	Target *string `json:"target,omitempty"`
+
+	// TmCreate Timestamp when the suppression was created.
+	//
+	// Example: 2026-01-15T09:30:00.000000Z
+	TmCreate *string `json:"tm_create,omitempty"`
+
+	// TmDelete Timestamp when the suppression was deleted.
+	//
+	// Example: 2026-01-15T09:30:00.000000Z
+	TmDelete *string `json:"tm_delete,omitempty"`
+
+	// TmUpdate Timestamp when the suppression was last updated.
+	//
+	// Example: 2026-01-15T09:30:00.000000Z
+	TmUpdate *string `json:"tm_update,omitempty"`
+}
+
+// OutdialManagerSuppressionBlock defines model for OutdialManagerSuppressionBlock.
+type OutdialManagerSuppressionBlock struct {
+	// CustomerId The unique identifier for the customer associated with the suppression block. Returned from the `GET /customers` response.
+	//
+	// Example: 7c4d2f3a-1b8e-4f5c-9a6d-3e2f1a0b4c5d
+	CustomerId *string `json:"customer_id,omitempty"`
+
+	// Id The unique identifier for the suppression block.
+	//
+	// Example: 1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d
+	Id *string `json:"id,omitempty"`
+
+	// ListType The type of the suppression list.
+	//
+	// Example: dnc
+	ListType *OutdialManagerSuppressionListType `json:"list_type,omitempty"`
+
+	// Reason The reason the attempt was blocked.
+	//
+	// Example: The destination is in the opt_out list.
+	Reason *string `json:"reason,omitempty"`
+
+	// ReferenceId The unique identifier of the blocked attempt's resource. The master call for the `call`, the message for the `message` and the campaign for the `campaign`.
+	//
+	// Example: c3d4e5f6-a7b8-9012-3456-7890abcdef01
+	ReferenceId *string `json:"reference_id,omitempty"`
+
+	// ReferenceType The type of the blocked outbound attempt.
+	//
+	// Example: call
+	ReferenceType *OutdialManagerSuppressionBlockReferenceType `json:"reference_type,omitempty"`
+
+	// SuppressionId The unique identifier of the suppression which blocked the attempt. Returned from the `GET /suppressions` response.
+	//
+	// Example: 8f1c2d3e-4a5b-4c6d-9e7f-0a1b2c3d4e5f
+	SuppressionId *string `json:"suppression_id,omitempty"`
+
+	// Target The blocked destination number in E.164 format.
+	//
+	// Example: +15551234567
+	Target *string `json:"target,omitempty"`
+
+	// TmCreate Timestamp when the attempt was blocked.
+	//
+	// Example: 2026-01-15T09:30:00.000000Z
+	TmCreate *string `json:"tm_create,omitempty"`
+
+	// TmDelete Timestamp when the suppression block was deleted.
+	//
+	// Example: 2026-01-15T09:30:00.000000Z
+	TmDelete *string `json:"tm_delete,omitempty"`
+
+	// TmUpdate Timestamp when the suppression block was last updated.
+	//
+	// Example: 2026-01-15T09:30:00.000000Z
+	TmUpdate *string `json:"tm_update,omitempty"`
+}
+
+// OutdialManagerSuppressionBlockReferenceType The type of the blocked outbound attempt.
+//
+// Example: call
+type OutdialManagerSuppressionBlockReferenceType string
+
+// OutdialManagerSuppressionListType The type of the suppression list.
+//
+// Example: dnc
+type OutdialManagerSuppressionListType string
+
 // QueueManagerQueue defines model for QueueManagerQueue.
 type QueueManagerQueue struct {
 	// CustomerId The unique identifier of the customer who owns this queue. Returned from the `GET /customers` response.
@@ -10095,6 +12411,38 @@ type GetAggregatedEventsParams struct {
 	PageToken *PageToken `form:"page_token,omitempty" json:"page_token,omitempty"`
 }
 
+// GetAiassistsParams defines parameters for GetAiassists.
+type GetAiassistsParams struct {
+	// PageSize Number of results to return per page.
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// PageToken Cursor token for pagination. Use the `next_page_token` value from the previous response.
+	PageToken *PageToken `form:"page_token,omitempty" json:"page_token,omitempty"`
+}
+
+// PostAiassistsJSONBody defines parameters for PostAiassists.
+type PostAiassistsJSONBody struct {
+	// AgentId The ID of the assisted agent. Defaults to the requesting agent.
+	AgentId *string `json:"agent_id,omitempty"`
+
+	// AiId The ID of the AI. Its init prompt guides the suggestions and its RAG answers the knowledge questions.
+	AiId string `json:"ai_id"`
+
+	// Checklist The compliance items the agent has to cover in the call.
+	Checklist *[]string `json:"checklist,omitempty"`
+
+	// Language The language of the call.
+	Language *string `json:"language,omitempty"`
+
+	// ReferenceId The ID of the call to assist.
+	ReferenceId string `json:"reference_id"`
+
+	// ReferenceType Type of reference for the AI assist.
+	//
+	// Example: call
+	ReferenceType AIManagerAIAssistReferenceType `json:"reference_type"`
+}
+
 // GetAiauditsParams defines parameters for GetAiaudits.
 type GetAiauditsParams struct {
 	// PageSize Number of results to return per page.
@@ -10153,6 +12501,53 @@ type GetAicallsIdParticipantsParams struct {
 	PageToken *PageToken `form:"page_token,omitempty" json:"page_token,omitempty"`
 }
 
+// GetAiextractionsParams defines parameters for GetAiextractions.
+type GetAiextractionsParams struct {
+	// PageSize Number of results to return per page.
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// PageToken Cursor token for pagination. Use the `next_page_token` value from the previous response.
+	PageToken *PageToken `form:"page_token,omitempty" json:"page_token,omitempty"`
+}
+
+// PostAiextractionsJSONBody defines parameters for PostAiextractions.
+type PostAiextractionsJSONBody struct {
+	// Language The language of the extracted values.
+	Language *string `json:"language,omitempty"`
+
+	// OnEndFlowId The ID of the flow to be executed when the ai extraction ends.
+	OnEndFlowId *string `json:"on_end_flow_id,omitempty"`
+
+	// ReferenceId The ID of the reference for the ai extraction.
+	ReferenceId string `json:"reference_id"`
+
+	// ReferenceType Type of reference for the AI extraction.
+	//
+	// Example: call
+	ReferenceType AIManagerExtractionReferenceType `json:"reference_type"`
+
+	// Schema JSON schema of the data to extract. The root must be an object with properties.
+	Schema map[string]interface{} `json:"schema"`
+
+	// SetVariables If true, each extracted field is set as a flow variable of the activeflow.
+	SetVariables *bool `json:"set_variables,omitempty"`
+}
+
+// GetAiguardrailviolationsParams defines parameters for GetAiguardrailviolations.
+type GetAiguardrailviolationsParams struct {
+	// PageSize Number of results to return per page.
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// PageToken Cursor token for pagination. Use the `next_page_token` value from the previous response.
+	PageToken *PageToken `form:"page_token,omitempty" json:"page_token,omitempty"`
+
+	// AicallId Filter by AI call ID.
+	AicallId *openapi_types.UUID `form:"aicall_id,omitempty" json:"aicall_id,omitempty"`
+
+	// AiId Filter by AI ID.
+	AiId *openapi_types.UUID `form:"ai_id,omitempty" json:"ai_id,omitempty"`
+}
+
 // GetAimessagesParams defines parameters for GetAimessages.
 type GetAimessagesParams struct {
 	// PageSize Number of results to return per page.
@@ -10220,6 +12615,9 @@ type PostAisJSONBody struct {
 	AutoAicallAuditEnabled *bool  `json:"auto_aicall_audit_enabled,omitempty"`
 	Detail                 string `json:"detail"`
 
+	// EngineFallbacks Engines tried in order when the primary engine fails during a call (max 3). The same model may be listed again with a different engine_key.
+	EngineFallbacks *[]AIManagerAIEngineFallback `json:"engine_fallbacks,omitempty"`
+
 	// EngineKey API key or credential for the AI engine.
 	EngineKey string `json:"engine_key"`
 
@@ -10227,8 +12625,11 @@ type PostAisJSONBody struct {
 	//
 	// Example: openai.gpt-5
 	EngineModel AIManagerAIEngineModel `json:"engine_model"`
-	InitPrompt  string                 `json:"init_prompt"`
-	Name        string                 `json:"name"`
+
+	// Guardrail Guardrail policy of the AI. The user's speech is checked against the blocked topics before the AI answers, and the AI's spoken output is checked against the forbidden phrases before it reaches the text-to-speech. Every violation is recorded as an AI guardrail violation.
+	Guardrail  *AIManagerGuardrail `json:"guardrail,omitempty"`
+	InitPrompt string              `json:"init_prompt"`
+	Name       string              `json:"name"`
 
 	// Parameter Data associated with the ai's engine, can be dynamic and vary based on the engine type.
 	Parameter map[string]interface{} `json:"parameter"`
@@ -10236,6 +12637,12 @@ type PostAisJSONBody struct {
 	// RagId The knowledge base ID (UUID) for the search_knowledge tool. Returned from the `id` field of the `GET /rags` response. Send empty string or omit to clear.
 	RagId *string `json:"rag_id,omitempty"`
 
+	// Redaction Redaction of personal data in the AI conversations. The detected values are redacted before they are stored in the AI messages and before they reach the LLM.
+	Redaction *AIManagerRedaction `json:"redaction,omitempty"`
+
+	// ResponseCache Semantic response cache of the AI. When the user asks a question similar enough to one the AI already answered, the cached answer is said without running the LLM. The cache is kept per AI and dropped when the AI's `init_prompt` or its RAG changes. Available for the AI calls of a single AI only.
+	ResponseCache *AIManagerResponseCache `json:"response_cache,omitempty"`
+
 	// SttLanguage STT language in BCP-47 format (e.g., ko-KR, en-US).
 	//
 	// Example: en-US
@@ -10273,6 +12680,9 @@ type PutAisIdJSONBody struct {
 	AutoAicallAuditEnabled *bool  `json:"auto_aicall_audit_enabled,omitempty"`
 	Detail                 string `json:"detail"`
 
+	// EngineFallbacks Engines tried in order when the primary engine fails during a call (max 3). The same model may be listed again with a different engine_key.
+	EngineFallbacks *[]AIManagerAIEngineFallback `json:"engine_fallbacks,omitempty"`
+
 	// EngineKey API key or credential for the AI engine.
 	EngineKey string `json:"engine_key"`
 
@@ -10280,8 +12690,11 @@ type PutAisIdJSONBody struct {
 	//
 	// Example: openai.gpt-5
 	EngineModel AIManagerAIEngineModel `json:"engine_model"`
-	InitPrompt  string                 `json:"init_prompt"`
-	Name        string                 `json:"name"`
+
+	// Guardrail Guardrail policy of the AI. The user's speech is checked against the blocked topics before the AI answers, and the AI's spoken output is checked against the forbidden phrases before it reaches the text-to-speech. Every violation is recorded as an AI guardrail violation.
+	Guardrail  *AIManagerGuardrail `json:"guardrail,omitempty"`
+	InitPrompt string              `json:"init_prompt"`
+	Name       string              `json:"name"`
 
 	// Parameter Data associated with the ai's engine, can be dynamic and vary based on the engine type.
 	Parameter map[string]interface{} `json:"parameter"`
@@ -10289,6 +12702,12 @@ type PutAisIdJSONBody struct {
 	// RagId The knowledge base ID (UUID) for the search_knowledge tool. Returned from the `id` field of the `GET /rags` response. Send empty string or omit to clear.
 	RagId *string `json:"rag_id,omitempty"`
 
+	// Redaction Redaction of personal data in the AI conversations. The detected values are redacted before they are stored in the AI messages and before they reach the LLM.
+	Redaction *AIManagerRedaction `json:"redaction,omitempty"`
+
+	// ResponseCache Semantic response cache of the AI. When the user asks a question similar enough to one the AI already answered, the cached answer is said without running the LLM. The cache is kept per AI and dropped when the AI's `init_prompt` or its RAG changes. Available for the AI calls of a single AI only.
+	ResponseCache *AIManagerResponseCache `json:"response_cache,omitempty"`
+
 	// SttLanguage STT language in BCP-47 format (e.g., ko-KR, en-US).
 	//
 	// Example: en-US
@@ -10418,6 +12837,27 @@ type PutBillingAccountPaymentInfoJSONBody struct {
 	PaymentType *BillingManagerAccountPaymentType `json:"payment_type,omitempty"`
 }
 
+// PutBillingAccountSpendingSettingsJSONBody defines parameters for PutBillingAccountSpendingSettings.
+type PutBillingAccountSpendingSettingsJSONBody struct {
+	// AutoTopupAmount The credit amount in micros charged by the auto top-up. Must be a multiple of 10000 (1 cent). 0 disables the auto top-up.
+	//
+	// Example: 20000000
+	AutoTopupAmount *int64 `json:"auto_topup_amount,omitempty"`
+
+	// AutoTopupThreshold The credit balance in micros which triggers the auto top-up.
+	//
+	// Example: 5000000
+	AutoTopupThreshold *int64 `json:"auto_topup_threshold,omitempty"`
+
+	// BalanceAlertThresholds The credit balances in micros which trigger the low balance alert. Up to 5 thresholds.
+	//
+	// Example: [10000000,5000000]
+	BalanceAlertThresholds *[]int64 `json:"balance_alert_thresholds,omitempty"`
+
+	// SpendLimits The spending caps by period and cost type. Up to 10 limits.
+	SpendLimits *[]BillingManagerAccountSpendLimit `json:"spend_limits,omitempty"`
+}
+
 // GetBillingAccountsParams defines parameters for GetBillingAccounts.
 type GetBillingAccountsParams struct {
 	// PageSize Number of results to return per page.
@@ -10450,10 +12890,35 @@ type PutBillingAccountsIdPaymentInfoJSONBody struct {
 	// Example: credit card
 	PaymentMethod *BillingManagerAccountPaymentMethod `json:"payment_method,omitempty"`
 
-	// PaymentType The type of payment associated with the account.
+	// PaymentType The type of payment associated with the account.
+	//
+	// Example: prepaid
+	PaymentType *BillingManagerAccountPaymentType `json:"payment_type,omitempty"`
+}
+
+// PostBillingEstimatesJSONBody defines parameters for PostBillingEstimates.
+type PostBillingEstimatesJSONBody struct {
+	// CostType The classification of the billing cost.
+	//
+	// Example: call_pstn_outgoing
+	CostType BillingManagerBillingCostType `json:"cost_type"`
+
+	// Destination Contains source or destination detail info.
+	Destination *CommonAddress `json:"destination,omitempty"`
+
+	// Duration The duration of the usage in seconds. Ignored for the non-duration cost types.
 	//
-	// Example: prepaid
-	PaymentType *BillingManagerAccountPaymentType `json:"payment_type,omitempty"`
+	// Example: 300
+	Duration *int `json:"duration,omitempty"`
+}
+
+// GetBillingStatementsParams defines parameters for GetBillingStatements.
+type GetBillingStatementsParams struct {
+	// PageSize Number of results to return per page.
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// PageToken Cursor token for pagination. Use the `next_page_token` value from the previous response.
+	PageToken *PageToken `form:"page_token,omitempty" json:"page_token,omitempty"`
 }
 
 // GetBillingsParams defines parameters for GetBillings.
@@ -10491,6 +12956,12 @@ type PostCallsJSONBody struct {
 	// Example: f1a2b3c4-d5e6-7890-1234-567890abcdef
 	FlowId *string `json:"flow_id,omitempty"`
 
+	// MaxCost Optional max cost of each created call in micros. The call is hung up with the `max_cost` hangup reason once its cost reaches it. 0 means no limit.
+	//
+	//
+	// Example: 3000000
+	MaxCost *int64 `json:"max_cost,omitempty"`
+
 	// Source Contains source or destination detail info.
 	Source *CommonAddress `json:"source,omitempty"`
 
@@ -10567,6 +13038,27 @@ type GetCampaigncallsParams struct {
 	PageToken *PageToken `form:"page_token,omitempty" json:"page_token,omitempty"`
 }
 
+// PostCampaigncallsIdAcceptJSONBody defines parameters for PostCampaigncallsIdAccept.
+type PostCampaigncallsIdAcceptJSONBody struct {
+	// AgentId The ID of the agent accepting the campaign call. Returned from the `GET /agents` response.
+	AgentId string `json:"agent_id"`
+}
+
+// PutCampaigncallsIdCallbackJSONBody defines parameters for PutCampaigncallsIdCallback.
+type PutCampaigncallsIdCallbackJSONBody struct {
+	// AgentId The ID of the agent who handles the callback. Returned from the `GET /agents` response. Empty means any agent.
+	AgentId *string `json:"agent_id,omitempty"`
+
+	// TmCallback The callback timestamp in ISO 8601 format. e.g. `2026-01-15T15:00:00Z`. Empty cancels the scheduled callback.
+	TmCallback string `json:"tm_callback"`
+}
+
+// PutCampaigncallsIdDispositionJSONBody defines parameters for PutCampaigncallsIdDisposition.
+type PutCampaigncallsIdDispositionJSONBody struct {
+	// Disposition The code of the disposition. Returned from the `GET /dispositions` response. Empty clears the disposition.
+	Disposition string `json:"disposition"`
+}
+
 // GetCampaignsParams defines parameters for GetCampaigns.
 type GetCampaignsParams struct {
 	// PageSize Number of results to return per page.
@@ -10640,6 +13132,15 @@ type PutCampaignsIdActionsJSONBody struct {
 	Actions []FlowManagerAction `json:"actions"`
 }
 
+// PutCampaignsIdCallingWindowsJSONBody defines parameters for PutCampaignsIdCallingWindows.
+type PutCampaignsIdCallingWindowsJSONBody struct {
+	// CallingWindows The campaign's calling windows. Empty means any time.
+	CallingWindows []CampaignManagerCampaignCallingWindow `json:"calling_windows"`
+
+	// Timezone IANA time zone used when the callee's time zone is unknown. Empty means UTC.
+	Timezone *string `json:"timezone,omitempty"`
+}
+
 // GetCampaignsIdCampaigncallsParams defines parameters for GetCampaignsIdCampaigncalls.
 type GetCampaignsIdCampaigncallsParams struct {
 	// PageSize Number of results to return per page.
@@ -10649,6 +13150,17 @@ type GetCampaignsIdCampaigncallsParams struct {
 	PageToken *PageToken `form:"page_token,omitempty" json:"page_token,omitempty"`
 }
 
+// PutCampaignsIdDialModeJSONBody defines parameters for PutCampaignsIdDialMode.
+type PutCampaignsIdDialModeJSONBody struct {
+	// DialMode Dial mode of the campaign. Empty means power.
+	//
+	// Example: predictive
+	DialMode CampaignManagerCampaignDialMode `json:"dial_mode"`
+
+	// MaxAbandonRate The max abandon rate percentage of the predictive dial mode. 0 means the default 3.
+	MaxAbandonRate *int `json:"max_abandon_rate,omitempty"`
+}
+
 // PutCampaignsIdNextCampaignIdJSONBody defines parameters for PutCampaignsIdNextCampaignId.
 type PutCampaignsIdNextCampaignIdJSONBody struct {
 	// NextCampaignId The next campaign's id.
@@ -10670,6 +13182,24 @@ type PutCampaignsIdResourceInfoJSONBody struct {
 	QueueId string `json:"queue_id"`
 }
 
+// GetCampaignsIdResultjobsParams defines parameters for GetCampaignsIdResultjobs.
+type GetCampaignsIdResultjobsParams struct {
+	// PageSize Number of results to return per page.
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// PageToken Cursor token for pagination. Use the `next_page_token` value from the previous response.
+	PageToken *PageToken `form:"page_token,omitempty" json:"page_token,omitempty"`
+}
+
+// GetCampaignsIdResultsParams defines parameters for GetCampaignsIdResults.
+type GetCampaignsIdResultsParams struct {
+	// PageSize Number of results to return per page.
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// PageToken Cursor token for pagination. Use the `next_page_token` value from the previous response.
+	PageToken *PageToken `form:"page_token,omitempty" json:"page_token,omitempty"`
+}
+
 // PutCampaignsIdServiceLevelJSONBody defines parameters for PutCampaignsIdServiceLevel.
 type PutCampaignsIdServiceLevelJSONBody struct {
 	// ServiceLevel The campaign's service level.
@@ -11215,6 +13745,101 @@ type PostConversationsIdMessagesJSONBody struct {
 	Text string `json:"text"`
 }
 
+// GetCustomToolsParams defines parameters for GetCustomTools.
+type GetCustomToolsParams struct {
+	// PageSize Number of results to return per page.
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// PageToken Cursor token for pagination. Use the `next_page_token` value from the previous response.
+	PageToken *PageToken `form:"page_token,omitempty" json:"page_token,omitempty"`
+}
+
+// PostCustomToolsJSONBody defines parameters for PostCustomTools.
+type PostCustomToolsJSONBody struct {
+	// Description Description shown to the LLM that explains when and how to call the tool.
+	//
+	// Example: Look up the status of a customer's order by order number.
+	Description string `json:"description"`
+
+	// Detail Free-form note about the custom tool. Not shown to the LLM.
+	//
+	// Example: Order status lookup on the shop backend
+	Detail *string `json:"detail,omitempty"`
+
+	// Headers Extra HTTP headers sent with each request (max 20).
+	//
+	// Example: {"X-Api-Key":"secret-key"}
+	Headers *map[string]string `json:"headers,omitempty"`
+
+	// Name Function name exposed to the LLM. Must start with a letter and contain only letters, digits and underscores (max 64 characters).
+	//
+	// Example: lookup_order
+	Name string `json:"name"`
+
+	// Parameters JSON Schema of the tool arguments. The root must be of type `object`.
+	//
+	// Example: {"properties":{"order_number":{"type":"string"}},"type":"object"}
+	Parameters *map[string]interface{} `json:"parameters,omitempty"`
+
+	// ResponseTemplate Optional Go text/template applied to the decoded JSON response before it is returned to the LLM.
+	//
+	// Example: Order {{.order_number}} is {{.status}}.
+	ResponseTemplate *string `json:"response_template,omitempty"`
+
+	// Timeout Request timeout in milliseconds. 0 uses the default of 5000, maximum 30000.
+	//
+	// Example: 5000
+	Timeout *int `json:"timeout,omitempty"`
+
+	// Url HTTPS endpoint called when the LLM invokes the tool. Private and reserved addresses are rejected.
+	//
+	// Example: https://shop.example.com/voipbin/lookup-order
+	Url string `json:"url"`
+}
+
+// PutCustomToolsIdJSONBody defines parameters for PutCustomToolsId.
+type PutCustomToolsIdJSONBody struct {
+	// Description Description shown to the LLM that explains when and how to call the tool.
+	//
+	// Example: Look up the status of a customer's order by order number.
+	Description string `json:"description"`
+
+	// Detail Free-form note about the custom tool. Not shown to the LLM.
+	//
+	// Example: Order status lookup on the shop backend
+	Detail *string `json:"detail,omitempty"`
+
+	// Headers Extra HTTP headers sent with each request (max 20).
+	//
+	// Example: {"X-Api-Key":"secret-key"}
+	Headers *map[string]string `json:"headers,omitempty"`
+
+	// Name Function name exposed to the LLM. Must start with a letter and contain only letters, digits and underscores (max 64 characters).
+	//
+	// Example: lookup_order
+	Name string `json:"name"`
+
+	// Parameters JSON Schema of the tool arguments. The root must be of type `object`.
+	//
+	// Example: {"properties":{"order_number":{"type":"string"}},"type":"object"}
+	Parameters *map[string]interface{} `json:"parameters,omitempty"`
+
+	// ResponseTemplate Optional Go text/template applied to the decoded JSON response before it is returned to the LLM.
+	//
+	// Example: Order {{.order_number}} is {{.status}}.
+	ResponseTemplate *string `json:"response_template,omitempty"`
+
+	// Timeout Request timeout in milliseconds. 0 uses the default of 5000, maximum 30000.
+	//
+	// Example: 5000
+	Timeout *int `json:"timeout,omitempty"`
+
+	// Url HTTPS endpoint called when the LLM invokes the tool. Private and reserved addresses are rejected.
+	//
+	// Example: https://shop.example.com/voipbin/lookup-order
+	Url string `json:"url"`
+}
+
 // PutCustomerJSONBody defines parameters for PutCustomer.
 type PutCustomerJSONBody struct {
 	// Address The customer's address.
@@ -11249,6 +13874,9 @@ type PutCustomerBillingAccountIdJSONBody struct {
 
 // PutCustomerMetadataJSONBody defines parameters for PutCustomerMetadata.
 type PutCustomerMetadataJSONBody struct {
+	// PiiRedaction Redaction of personal data in the AI conversations. The detected values are redacted before they are stored in the AI messages and before they reach the LLM.
+	PiiRedaction *AIManagerRedaction `json:"pii_redaction,omitempty"`
+
 	// RtpDebug When set to `true`, RTPEngine captures RTP traffic as PCAP files for this customer's calls.
 	// Default is `false`. Enabling this increases storage usage — disable after debugging.
 	//
@@ -11301,6 +13929,38 @@ type PutCustomersIdBillingAccountIdJSONBody struct {
 	BillingAccountId string `json:"billing_account_id"`
 }
 
+// GetDispositionsParams defines parameters for GetDispositions.
+type GetDispositionsParams struct {
+	// PageSize Number of results to return per page.
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// PageToken Cursor token for pagination. Use the `next_page_token` value from the previous response.
+	PageToken *PageToken `form:"page_token,omitempty" json:"page_token,omitempty"`
+}
+
+// PostDispositionsJSONBody defines parameters for PostDispositions.
+type PostDispositionsJSONBody struct {
+	Code   string  `json:"code"`
+	Detail *string `json:"detail,omitempty"`
+	Name   *string `json:"name,omitempty"`
+
+	// RetryMode Decides the outdial target's retry when the campaign call with the disposition is done.
+	//
+	// Example: no_retry
+	RetryMode *CampaignManagerDispositionRetryMode `json:"retry_mode,omitempty"`
+}
+
+// PutDispositionsIdJSONBody defines parameters for PutDispositionsId.
+type PutDispositionsIdJSONBody struct {
+	Detail string `json:"detail"`
+	Name   string `json:"name"`
+
+	// RetryMode Decides the outdial target's retry when the campaign call with the disposition is done.
+	//
+	// Example: no_retry
+	RetryMode CampaignManagerDispositionRetryMode `json:"retry_mode"`
+}
+
 // GetEmailsParams defines parameters for GetEmails.
 type GetEmailsParams struct {
 	// PageSize Number of results to return per page.
@@ -11422,6 +14082,97 @@ type PostGroupcallsJSONBody struct {
 	Source CommonAddress `json:"source"`
 }
 
+// GetMcpServersParams defines parameters for GetMcpServers.
+type GetMcpServersParams struct {
+	// PageSize Number of results to return per page.
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// PageToken Cursor token for pagination. Use the `next_page_token` value from the previous response.
+	PageToken *PageToken `form:"page_token,omitempty" json:"page_token,omitempty"`
+}
+
+// PostMcpServersJSONBody defines parameters for PostMcpServers.
+type PostMcpServersJSONBody struct {
+	// AllowedTools Names of the server's tools the LLM may call. When empty, every tool is allowed.
+	//
+	// Example: ["lookup_order"]
+	AllowedTools *[]string `json:"allowed_tools,omitempty"`
+
+	// Detail Free-form note about the MCP server. Not shown to the LLM.
+	//
+	// Example: Internal CRM MCP server
+	Detail *string `json:"detail,omitempty"`
+
+	// Headers Extra HTTP headers sent with each request, typically for authentication (max 20).
+	//
+	// Example: {"Authorization":"Bearer secret-token"}
+	Headers *map[string]string `json:"headers,omitempty"`
+
+	// Name Name of the MCP server. Must start with a letter and contain only letters and digits (max 32 characters). The server's tools are exposed to the LLM as `<name>__<tool>`.
+	//
+	// Example: crm
+	Name string `json:"name"`
+
+	// Timeout Timeout of a whole tool discovery or tool call in milliseconds. 0 uses the default of 10000, maximum 60000.
+	//
+	// Example: 10000
+	Timeout *int `json:"timeout,omitempty"`
+
+	// Transport Transport used to reach the MCP server.
+	// - `streamable_http`: Streamable HTTP transport (a single endpoint accepting `POST` requests).
+	// - `sse`: Legacy HTTP+SSE transport (an event stream endpoint plus a message endpoint).
+	//
+	//
+	// Example: streamable_http
+	Transport AIManagerMCPServerTransport `json:"transport"`
+
+	// Url HTTPS endpoint of the MCP server. Private and reserved addresses are rejected.
+	//
+	// Example: https://mcp.example.com/mcp
+	Url string `json:"url"`
+}
+
+// PutMcpServersIdJSONBody defines parameters for PutMcpServersId.
+type PutMcpServersIdJSONBody struct {
+	// AllowedTools Names of the server's tools the LLM may call. When empty, every tool is allowed.
+	//
+	// Example: ["lookup_order"]
+	AllowedTools *[]string `json:"allowed_tools,omitempty"`
+
+	// Detail Free-form note about the MCP server. Not shown to the LLM.
+	//
+	// Example: Internal CRM MCP server
+	Detail *string `json:"detail,omitempty"`
+
+	// Headers Extra HTTP headers sent with each request, typically for authentication (max 20).
+	//
+	// Example: {"Authorization":"Bearer secret-token"}
+	Headers *map[string]string `json:"headers,omitempty"`
+
+	// Name Name of the MCP server. Must start with a letter and contain only letters and digits (max 32 characters). The server's tools are exposed to the LLM as `<name>__<tool>`.
+	//
+	// Example: crm
+	Name string `json:"name"`
+
+	// Timeout Timeout of a whole tool discovery or tool call in milliseconds. 0 uses the default of 10000, maximum 60000.
+	//
+	// Example: 10000
+	Timeout *int `json:"timeout,omitempty"`
+
+	// Transport Transport used to reach the MCP server.
+	// - `streamable_http`: Streamable HTTP transport (a single endpoint accepting `POST` requests).
+	// - `sse`: Legacy HTTP+SSE transport (an event stream endpoint plus a message endpoint).
+	//
+	//
+	// Example: streamable_http
+	Transport AIManagerMCPServerTransport `json:"transport"`
+
+	// Url HTTPS endpoint of the MCP server. Private and reserved addresses are rejected.
+	//
+	// Example: https://mcp.example.com/mcp
+	Url string `json:"url"`
+}
+
 // GetMessagesParams defines parameters for GetMessages.
 type GetMessagesParams struct {
 	// PageSize Number of results to return per page.
@@ -11550,6 +14301,29 @@ type PutOutdialsIdDataJSONBody struct {
 	Data string `json:"data"`
 }
 
+// GetOutdialsIdTargetjobsParams defines parameters for GetOutdialsIdTargetjobs.
+type GetOutdialsIdTargetjobsParams struct {
+	// PageSize Number of results to return per page.
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// PageToken Cursor token for pagination. Use the `next_page_token` value from the previous response.
+	PageToken *PageToken `form:"page_token,omitempty" json:"page_token,omitempty"`
+}
+
+// PostOutdialsIdTargetjobsJSONBody defines parameters for PostOutdialsIdTargetjobs.
+type PostOutdialsIdTargetjobsJSONBody struct {
+	// FileId The ID of the csv file to import. Returned from the `POST /files` response. Required for the `import`.
+	FileId *string `json:"file_id,omitempty"`
+
+	// Mapping The csv header names of the outdial target's fields. An omitted field uses the field's own name as the header name.
+	Mapping *OutdialManagerOutdialtargetjobMapping `json:"mapping,omitempty"`
+
+	// Type The type of the outdial target job.
+	//
+	// Example: import
+	Type OutdialManagerOutdialtargetjobType `json:"type"`
+}
+
 // GetOutdialsIdTargetsParams defines parameters for GetOutdialsIdTargets.
 type GetOutdialsIdTargetsParams struct {
 	// PageSize Number of results to return per page.
@@ -11579,6 +14353,18 @@ type PostOutdialsIdTargetsJSONBody struct {
 	Destination4 CommonAddress `json:"destination_4"`
 	Detail       string        `json:"detail"`
 	Name         string        `json:"name"`
+
+	// Timezone IANA time zone of the callee. Empty means derived from the destination number.
+	Timezone *string `json:"timezone,omitempty"`
+}
+
+// PutOutdialsIdTargetsTargetIdCallbackJSONBody defines parameters for PutOutdialsIdTargetsTargetIdCallback.
+type PutOutdialsIdTargetsTargetIdCallbackJSONBody struct {
+	// AgentId The ID of the agent who handles the callback. Returned from the `GET /agents` response. Empty means any agent.
+	AgentId *string `json:"agent_id,omitempty"`
+
+	// TmCallback The callback timestamp in ISO 8601 format. e.g. `2026-01-15T15:00:00Z`. Empty cancels the scheduled callback.
+	TmCallback string `json:"tm_callback"`
 }
 
 // GetOutplansParams defines parameters for GetOutplans.
@@ -12642,6 +15428,46 @@ type PostStorageFilesMultipartBody struct {
 // PostStorageFilesMultipartBodyType defines parameters for PostStorageFiles.
 type PostStorageFilesMultipartBodyType string
 
+// GetSuppressionBlocksParams defines parameters for GetSuppressionBlocks.
+type GetSuppressionBlocksParams struct {
+	// PageSize Number of results to return per page.
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// PageToken Cursor token for pagination. Use the `next_page_token` value from the previous response.
+	PageToken *PageToken `form:"page_token,omitempty" json:"page_token,omitempty"`
+}
+
+// GetSuppressionsParams defines parameters for GetSuppressions.
+type GetSuppressionsParams struct {
+	// PageSize Number of results to return per page.
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// PageToken Cursor token for pagination. Use the `next_page_token` value from the previous response.
+	PageToken *PageToken `form:"page_token,omitempty" json:"page_token,omitempty"`
+}
+
+// PostSuppressionsJSONBody defines parameters for PostSuppressions.
+type PostSuppressionsJSONBody struct {
+	Detail *string `json:"detail,omitempty"`
+
+	// ListType The type of the suppression list.
+	//
+	// Example: dnc
+	ListType OutdialManagerSuppressionListType `json:"list_type"`
+	Target   string                            `json:"target"`
+}
+
+// PostSuppressionsImportJSONBody defines parameters for PostSuppressionsImport.
+type PostSuppressionsImportJSONBody struct {
+	Detail *string `json:"detail,omitempty"`
+
+	// ListType The type of the suppression list.
+	//
+	// Example: dnc
+	ListType OutdialManagerSuppressionListType `json:"list_type"`
+	Targets  []string                          `json:"targets"`
+}
+
 // GetTagsParams defines parameters for GetTags.
 type GetTagsParams struct {
 	// PageSize Number of results to return per page.
@@ -12724,6 +15550,67 @@ type PutTeamsIdJSONBody struct {
 	StartMemberId string `json:"start_member_id"`
 }
 
+// GetTestRunsParams defines parameters for GetTestRuns.
+type GetTestRunsParams struct {
+	// PageSize Number of results to return per page.
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// PageToken Cursor token for pagination. Use the `next_page_token` value from the previous response.
+	PageToken *PageToken `form:"page_token,omitempty" json:"page_token,omitempty"`
+
+	// TestSuiteId Returns only the runs of the given test suite.
+	TestSuiteId *string `form:"test_suite_id,omitempty" json:"test_suite_id,omitempty"`
+}
+
+// GetTestSuitesParams defines parameters for GetTestSuites.
+type GetTestSuitesParams struct {
+	// PageSize Number of results to return per page.
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// PageToken Cursor token for pagination. Use the `next_page_token` value from the previous response.
+	PageToken *PageToken `form:"page_token,omitempty" json:"page_token,omitempty"`
+
+	// AiId Returns only the test suites of the given AI.
+	AiId *string `form:"ai_id,omitempty" json:"ai_id,omitempty"`
+}
+
+// PostTestSuitesJSONBody defines parameters for PostTestSuites.
+type PostTestSuitesJSONBody struct {
+	// AiId The unique identifier of the AI under test. Returned from the `GET /ais` response.
+	//
+	// Example: 550e8400-e29b-41d4-a716-446655440000
+	AiId string `json:"ai_id"`
+
+	// Detail Free-form note about the test suite.
+	//
+	// Example: Checks the AI follows the 30 days refund policy.
+	Detail *string `json:"detail,omitempty"`
+
+	// Name Name of the test suite.
+	//
+	// Example: refund policy
+	Name string `json:"name"`
+
+	// Scenarios Scenarios of the test suite (1 to 20).
+	Scenarios []AIManagerTestSuiteScenario `json:"scenarios"`
+}
+
+// PutTestSuitesIdJSONBody defines parameters for PutTestSuitesId.
+type PutTestSuitesIdJSONBody struct {
+	// Detail Free-form note about the test suite.
+	//
+	// Example: Checks the AI follows the 30 days refund policy.
+	Detail *string `json:"detail,omitempty"`
+
+	// Name Name of the test suite.
+	//
+	// Example: refund policy
+	Name string `json:"name"`
+
+	// Scenarios Scenarios of the test suite (1 to 20).
+	Scenarios []AIManagerTestSuiteScenario `json:"scenarios"`
+}
+
 // GetTimelineAnalysesParams defines parameters for GetTimelineAnalyses.
 type GetTimelineAnalysesParams struct {
 	// PageSize Number of results to return per page.
@@ -13006,12 +15893,18 @@ type PutAgentsIdStatusJSONRequestBody PutAgentsIdStatusJSONBody
 // PutAgentsIdTagIdsJSONRequestBody defines body for PutAgentsIdTagIds for application/json ContentType.
 type PutAgentsIdTagIdsJSONRequestBody PutAgentsIdTagIdsJSONBody
 
+// PostAiassistsJSONRequestBody defines body for PostAiassists for application/json ContentType.
+type PostAiassistsJSONRequestBody PostAiassistsJSONBody
+
 // PostAiauditsJSONRequestBody defines body for PostAiaudits for application/json ContentType.
 type PostAiauditsJSONRequestBody PostAiauditsJSONBody
 
 // PostAicallsJSONRequestBody defines body for PostAicalls for application/json ContentType.
 type PostAicallsJSONRequestBody PostAicallsJSONBody
 
+// PostAiextractionsJSONRequestBody defines body for PostAiextractions for application/json ContentType.
+type PostAiextractionsJSONRequestBody PostAiextractionsJSONBody
+
 // PostAimessagesJSONRequestBody defines body for PostAimessages for application/json ContentType.
 type PostAimessagesJSONRequestBody PostAimessagesJSONBody
 
@@ -13051,6 +15944,9 @@ type PutBillingAccountJSONRequestBody PutBillingAccountJSONBody
 // PutBillingAccountPaymentInfoJSONRequestBody defines body for PutBillingAccountPaymentInfo for application/json ContentType.
 type PutBillingAccountPaymentInfoJSONRequestBody PutBillingAccountPaymentInfoJSONBody
 
+// PutBillingAccountSpendingSettingsJSONRequestBody defines body for PutBillingAccountSpendingSettings for application/json ContentType.
+type PutBillingAccountSpendingSettingsJSONRequestBody PutBillingAccountSpendingSettingsJSONBody
+
 // PutBillingAccountsIdJSONRequestBody defines body for PutBillingAccountsId for application/json ContentType.
 type PutBillingAccountsIdJSONRequestBody PutBillingAccountsIdJSONBody
 
@@ -13063,6 +15959,9 @@ type PostBillingAccountsIdBalanceSubtractForceJSONRequestBody PostBillingAccount
 // PutBillingAccountsIdPaymentInfoJSONRequestBody defines body for PutBillingAccountsIdPaymentInfo for application/json ContentType.
 type PutBillingAccountsIdPaymentInfoJSONRequestBody PutBillingAccountsIdPaymentInfoJSONBody
 
+// PostBillingEstimatesJSONRequestBody defines body for PostBillingEstimates for application/json ContentType.
+type PostBillingEstimatesJSONRequestBody PostBillingEstimatesJSONBody
+
 // PostCallsJSONRequestBody defines body for PostCalls for application/json ContentType.
 type PostCallsJSONRequestBody PostCallsJSONBody
 
@@ -13078,6 +15977,15 @@ type PostCallsIdRecordingStartJSONRequestBody PostCallsIdRecordingStartJSONBody
 // PostCallsIdTalkJSONRequestBody defines body for PostCallsIdTalk for application/json ContentType.
 type PostCallsIdTalkJSONRequestBody PostCallsIdTalkJSONBody
 
+// PostCampaigncallsIdAcceptJSONRequestBody defines body for PostCampaigncallsIdAccept for application/json ContentType.
+type PostCampaigncallsIdAcceptJSONRequestBody PostCampaigncallsIdAcceptJSONBody
+
+// PutCampaigncallsIdCallbackJSONRequestBody defines body for PutCampaigncallsIdCallback for application/json ContentType.
+type PutCampaigncallsIdCallbackJSONRequestBody PutCampaigncallsIdCallbackJSONBody
+
+// PutCampaigncallsIdDispositionJSONRequestBody defines body for PutCampaigncallsIdDisposition for application/json ContentType.
+type PutCampaigncallsIdDispositionJSONRequestBody PutCampaigncallsIdDispositionJSONBody
+
 // PostCampaignsJSONRequestBody defines body for PostCampaigns for application/json ContentType.
 type PostCampaignsJSONRequestBody PostCampaignsJSONBody
 
@@ -13087,6 +15995,12 @@ type PutCampaignsIdJSONRequestBody PutCampaignsIdJSONBody
 // PutCampaignsIdActionsJSONRequestBody defines body for PutCampaignsIdActions for application/json ContentType.
 type PutCampaignsIdActionsJSONRequestBody PutCampaignsIdActionsJSONBody
 
+// PutCampaignsIdCallingWindowsJSONRequestBody defines body for PutCampaignsIdCallingWindows for application/json ContentType.
+type PutCampaignsIdCallingWindowsJSONRequestBody PutCampaignsIdCallingWindowsJSONBody
+
+// PutCampaignsIdDialModeJSONRequestBody defines body for PutCampaignsIdDialMode for application/json ContentType.
+type PutCampaignsIdDialModeJSONRequestBody PutCampaignsIdDialModeJSONBody
+
 // PutCampaignsIdNextCampaignIdJSONRequestBody defines body for PutCampaignsIdNextCampaignId for application/json ContentType.
 type PutCampaignsIdNextCampaignIdJSONRequestBody PutCampaignsIdNextCampaignIdJSONBody
 
@@ -13159,6 +16073,12 @@ type PutConversationsIdJSONRequestBody PutConversationsIdJSONBody
 // PostConversationsIdMessagesJSONRequestBody defines body for PostConversationsIdMessages for application/json ContentType.
 type PostConversationsIdMessagesJSONRequestBody PostConversationsIdMessagesJSONBody
 
+// PostCustomToolsJSONRequestBody defines body for PostCustomTools for application/json ContentType.
+type PostCustomToolsJSONRequestBody PostCustomToolsJSONBody
+
+// PutCustomToolsIdJSONRequestBody defines body for PutCustomToolsId for application/json ContentType.
+type PutCustomToolsIdJSONRequestBody PutCustomToolsIdJSONBody
+
 // PutCustomerJSONRequestBody defines body for PutCustomer for application/json ContentType.
 type PutCustomerJSONRequestBody PutCustomerJSONBody
 
@@ -13180,6 +16100,12 @@ type PutCustomersIdBillingAccountIdJSONRequestBody PutCustomersIdBillingAccountI
 // PutCustomersIdMetadataJSONRequestBody defines body for PutCustomersIdMetadata for application/json ContentType.
 type PutCustomersIdMetadataJSONRequestBody = CustomerManagerMetadata
 
+// PostDispositionsJSONRequestBody defines body for PostDispositions for application/json ContentType.
+type PostDispositionsJSONRequestBody PostDispositionsJSONBody
+
+// PutDispositionsIdJSONRequestBody defines body for PutDispositionsId for application/json ContentType.
+type PutDispositionsIdJSONRequestBody PutDispositionsIdJSONBody
+
 // PostEmailsJSONRequestBody defines body for PostEmails for application/json ContentType.
 type PostEmailsJSONRequestBody PostEmailsJSONBody
 
@@ -13198,6 +16124,12 @@ type PutFlowsIdJSONRequestBody PutFlowsIdJSONBody
 // PostGroupcallsJSONRequestBody defines body for PostGroupcalls for application/json ContentType.
 type PostGroupcallsJSONRequestBody PostGroupcallsJSONBody
 
+// PostMcpServersJSONRequestBody defines body for PostMcpServers for application/json ContentType.
+type PostMcpServersJSONRequestBody PostMcpServersJSONBody
+
+// PutMcpServersIdJSONRequestBody defines body for PutMcpServersId for application/json ContentType.
+type PutMcpServersIdJSONRequestBody PutMcpServersIdJSONBody
+
 // PostMessagesJSONRequestBody defines body for PostMessages for application/json ContentType.
 type PostMessagesJSONRequestBody PostMessagesJSONBody
 
@@ -13237,9 +16169,15 @@ type PutOutdialsIdCampaignIdJSONRequestBody PutOutdialsIdCampaignIdJSONBody
 // PutOutdialsIdDataJSONRequestBody defines body for PutOutdialsIdData for application/json ContentType.
 type PutOutdialsIdDataJSONRequestBody PutOutdialsIdDataJSONBody
 
+// PostOutdialsIdTargetjobsJSONRequestBody defines body for PostOutdialsIdTargetjobs for application/json ContentType.
+type PostOutdialsIdTargetjobsJSONRequestBody PostOutdialsIdTargetjobsJSONBody
+
 // PostOutdialsIdTargetsJSONRequestBody defines body for PostOutdialsIdTargets for application/json ContentType.
 type PostOutdialsIdTargetsJSONRequestBody PostOutdialsIdTargetsJSONBody
 
+// PutOutdialsIdTargetsTargetIdCallbackJSONRequestBody defines body for PutOutdialsIdTargetsTargetIdCallback for application/json ContentType.
+type PutOutdialsIdTargetsTargetIdCallbackJSONRequestBody PutOutdialsIdTargetsTargetIdCallbackJSONBody
+
 // PostOutplansJSONRequestBody defines body for PostOutplans for application/json ContentType.
 type PostOutplansJSONRequestBody PostOutplansJSONBody
 
@@ -13378,6 +16316,12 @@ type PostStorageAccountsJSONRequestBody PostStorageAccountsJSONBody
 // PostStorageFilesMultipartRequestBody defines body for PostStorageFiles for multipart/form-data ContentType.
 type PostStorageFilesMultipartRequestBody PostStorageFilesMultipartBody
 
+// PostSuppressionsJSONRequestBody defines body for PostSuppressions for application/json ContentType.
+type PostSuppressionsJSONRequestBody PostSuppressionsJSONBody
+
+// PostSuppressionsImportJSONRequestBody defines body for PostSuppressionsImport for application/json ContentType.
+type PostSuppressionsImportJSONRequestBody PostSuppressionsImportJSONBody
+
 // PostTagsJSONRequestBody defines body for PostTags for application/json ContentType.
 type PostTagsJSONRequestBody PostTagsJSONBody
 
@@ -13390,6 +16334,12 @@ type PostTeamsJSONRequestBody PostTeamsJSONBody
 // PutTeamsIdJSONRequestBody defines body for PutTeamsId for application/json ContentType.
 type PutTeamsIdJSONRequestBody PutTeamsIdJSONBody
 
+// PostTestSuitesJSONRequestBody defines body for PostTestSuites for application/json ContentType.
+type PostTestSuitesJSONRequestBody PostTestSuitesJSONBody
+
+// PutTestSuitesIdJSONRequestBody defines body for PutTestSuitesId for application/json ContentType.
+type PutTestSuitesIdJSONRequestBody PutTestSuitesIdJSONBody
+
 // PostTimelineAnalysesJSONRequestBody defines body for PostTimelineAnalyses for application/json ContentType.
 type PostTimelineAnalysesJSONRequestBody PostTimelineAnalysesJSONBody
 
@@ -58,7 +58,7 @@ func initCache() (cachehandler.CacheHandler, error) {
 
 func initOutdialHandler(sqlDB *sql.DB, cache cachehandler.CacheHandler) (outdialhandler.OutdialHandler, error) {
 	db := dbhandler.NewHandler(sqlDB, cache)
-	sockHandler := sockhandler.NewSockHandler(sock.TypeRabbitMQ, config.Get().RabbitMQAddress)
+	sockHandler := sockhandler.NewSockHandler(sock.Type(config.Get().SockType), config.Get().RabbitMQAddress)
 	sockHandler.Connect()
 
 	reqHandler := requesthandler.NewRequestHandler(sockHandler, serviceName)
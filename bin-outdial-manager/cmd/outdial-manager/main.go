@@ -1,10 +1,12 @@
 package main
 
 import (
+	"context"
 	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	commonoutline "monorepo/bin-common-handler/models/outline"
 	"monorepo/bin-common-handler/models/sock"
@@ -13,6 +15,7 @@ import (
 	"monorepo/bin-common-handler/pkg/notifyhandler"
 	"monorepo/bin-common-handler/pkg/requesthandler"
 	"monorepo/bin-common-handler/pkg/sockhandler"
+	"monorepo/bin-common-handler/pkg/tracehandler"
 
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/pkg/errors"
@@ -26,10 +29,16 @@ import (
 	"monorepo/bin-outdial-manager/pkg/listenhandler"
 	"monorepo/bin-outdial-manager/pkg/outdialhandler"
 	"monorepo/bin-outdial-manager/pkg/outdialtargethandler"
+	"monorepo/bin-outdial-manager/pkg/outdialtargetjobhandler"
+	"monorepo/bin-outdial-manager/pkg/suppressionhandler"
 )
 
 const serviceName = commonoutline.ServiceNameOutdialManager
 
+const (
+	jobRecoveryInterval = time.Minute // interval of the abandoned outdialtargetjob sweep
+)
+
 // channels
 var chSigs = make(chan os.Signal, 1)
 var chDone = make(chan bool, 1)
@@ -61,6 +70,10 @@ func runDaemon() error {
 	log := logrus.WithField("func", "runDaemon")
 	log.WithField("config", config.Get()).Info("Starting outdial-manager...")
 
+	// init tracing
+	tracehandler.Init(serviceName)
+	defer tracehandler.Shutdown()
+
 	// create dbhandler
 	dbHandler, err := createDBHandler()
 	if err != nil {
@@ -122,7 +135,7 @@ func run(dbHandler dbhandler.DBHandler) error {
 	log := logrus.WithField("func", "run")
 
 	// rabbitmq sock connect
-	sockHandler := sockhandler.NewSockHandler(sock.TypeRabbitMQ, config.Get().RabbitMQAddress)
+	sockHandler := sockhandler.NewSockHandler(sock.Type(config.Get().SockType), config.Get().RabbitMQAddress)
 	sockHandler.Connect()
 
 	// create handlers
@@ -131,9 +144,15 @@ func run(dbHandler dbhandler.DBHandler) error {
 
 	outdialHandler := outdialhandler.NewOutdialHandler(dbHandler, reqHandler, notifyHandler)
 	outdialTargethandler := outdialtargethandler.NewOutdialTargetHandler(dbHandler, reqHandler, notifyHandler)
+	suppressionHandler := suppressionhandler.NewSuppressionHandler(dbHandler, reqHandler, notifyHandler)
+
+	outdialTargetJobHandler := outdialtargetjobhandler.NewOutdialTargetJobHandler(dbHandler, reqHandler, notifyHandler, outdialTargethandler)
+
+	// resume the outdialtargetjobs abandoned by the restarted pods
+	go outdialTargetJobHandler.RunRecovery(context.Background(), jobRecoveryInterval)
 
 	// run listen
-	if errListen := runListen(sockHandler, outdialHandler, outdialTargethandler); errListen != nil {
+	if errListen := runListen(sockHandler, outdialHandler, outdialTargethandler, outdialTargetJobHandler, suppressionHandler); errListen != nil {
 		log.Errorf("Could not run the listen correctly. err: %v", errListen)
 		return errors.Wrapf(errListen, "could not run the listen")
 	}
@@ -146,10 +165,12 @@ func runListen(
 	sockListen sockhandler.SockHandler,
 	outdialHandler outdialhandler.OutdialHandler,
 	outdialtargetHandler outdialtargethandler.OutdialTargetHandler,
+	outdialTargetJobHandler outdialtargetjobhandler.OutdialTargetJobHandler,
+	suppressionHandler suppressionhandler.SuppressionHandler,
 ) error {
 	log := logrus.WithField("func", "runListen")
 
-	listenHandler := listenhandler.NewListenHandler(sockListen, outdialHandler, outdialtargetHandler)
+	listenHandler := listenhandler.NewListenHandler(sockListen, outdialHandler, outdialtargetHandler, outdialTargetJobHandler, suppressionHandler)
 
 	// run the service
 	if errRun := listenHandler.Run(string(commonoutline.QueueNameOutdialRequest), string(commonoutline.QueueNameDelay)); errRun != nil {
@@ -0,0 +1,35 @@
+package outdialtarget
+
+import (
+	"strings"
+	"time"
+
+	"monorepo/bin-common-handler/pkg/utilhandler"
+
+	"github.com/gofrs/uuid"
+)
+
+// cursorSeparator separates the tm_create and the id of the cursor token.
+const cursorSeparator = ","
+
+// CursorToken returns the page token which points right after the given target.
+// Unlike the plain tm_create token, it does not skip the targets sharing the same tm_create,
+// such as the ones created by the same import.
+func CursorToken(tmCreate *time.Time, id uuid.UUID) string {
+	if tmCreate == nil {
+		return ""
+	}
+
+	return tmCreate.UTC().Format(utilhandler.ISO8601Layout) + cursorSeparator + id.String()
+}
+
+// ParseCursorToken splits the given page token into the tm_create and the id.
+// The id is empty if the token is the plain tm_create token.
+func ParseCursorToken(token string) (string, uuid.UUID) {
+	tmCreate, id, found := strings.Cut(token, cursorSeparator)
+	if !found {
+		return token, uuid.Nil
+	}
+
+	return tmCreate, uuid.FromStringOrNil(id)
+}
@@ -0,0 +1,97 @@
+package outdialtarget
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+func Test_CursorToken(t *testing.T) {
+
+	tmCreate := time.Date(2026, 10, 1, 3, 30, 0, 123456000, time.UTC)
+
+	tests := []struct {
+		name string
+
+		tmCreate *time.Time
+		id       uuid.UUID
+
+		expectRes string
+	}{
+		{
+			name: "normal",
+
+			tmCreate: &tmCreate,
+			id:       uuid.FromStringOrNil("4b0e6a3c-ad5a-11f0-9a4e-2f6c7d8e9b10"),
+
+			expectRes: "2026-10-01T03:30:00.123456Z,4b0e6a3c-ad5a-11f0-9a4e-2f6c7d8e9b10",
+		},
+		{
+			name: "tm_create is not set",
+
+			tmCreate: nil,
+			id:       uuid.FromStringOrNil("4b0e6a3c-ad5a-11f0-9a4e-2f6c7d8e9b10"),
+
+			expectRes: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := CursorToken(tt.tmCreate, tt.id)
+			if res != tt.expectRes {
+				t.Errorf("Wrong match. expect: %s, got: %s", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_ParseCursorToken(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		token string
+
+		expectTMCreate string
+		expectID       uuid.UUID
+	}{
+		{
+			name: "cursor token",
+
+			token: "2026-10-01T03:30:00.123456Z,4b0e6a3c-ad5a-11f0-9a4e-2f6c7d8e9b10",
+
+			expectTMCreate: "2026-10-01T03:30:00.123456Z",
+			expectID:       uuid.FromStringOrNil("4b0e6a3c-ad5a-11f0-9a4e-2f6c7d8e9b10"),
+		},
+		{
+			name: "plain tm_create token",
+
+			token: "2026-10-01T03:30:00.123456Z",
+
+			expectTMCreate: "2026-10-01T03:30:00.123456Z",
+			expectID:       uuid.Nil,
+		},
+		{
+			name: "empty",
+
+			token: "",
+
+			expectTMCreate: "",
+			expectID:       uuid.Nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmCreate, id := ParseCursorToken(tt.token)
+			if tmCreate != tt.expectTMCreate {
+				t.Errorf("Wrong match. expect: %s, got: %s", tt.expectTMCreate, tmCreate)
+			}
+			if id != tt.expectID {
+				t.Errorf("Wrong match. expect: %s, got: %s", tt.expectID, id)
+			}
+		})
+	}
+}
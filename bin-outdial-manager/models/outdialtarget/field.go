@@ -11,8 +11,9 @@ const (
 	FieldName   Field = "name"
 	FieldDetail Field = "detail"
 
-	FieldData   Field = "data"
-	FieldStatus Field = "status"
+	FieldData     Field = "data"
+	FieldStatus   Field = "status"
+	FieldTimezone Field = "timezone"
 
 	FieldDestination0 Field = "destination_0"
 	FieldDestination1 Field = "destination_1"
@@ -26,6 +27,9 @@ const (
 	FieldTryCount3 Field = "try_count_3"
 	FieldTryCount4 Field = "try_count_4"
 
+	FieldCallbackAgentID Field = "callback_agent_id"
+	FieldTMCallback      Field = "tm_callback"
+
 	FieldTMCreate Field = "tm_create"
 	FieldTMUpdate Field = "tm_update"
 	FieldTMDelete Field = "tm_delete"
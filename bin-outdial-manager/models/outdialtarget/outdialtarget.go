@@ -16,8 +16,9 @@ type OutdialTarget struct {
 	Name   string `json:"name" db:"name"`
 	Detail string `json:"detail" db:"detail"`
 
-	Data   string `json:"data" db:"data"`
-	Status Status `json:"status" db:"status"`
+	Data     string `json:"data" db:"data"`
+	Status   Status `json:"status" db:"status"`
+	Timezone string `json:"timezone" db:"timezone"` // IANA time zone of the callee. empty means derived from the destination
 
 	// destinations
 	Destination0 *commonaddress.Address `json:"destination_0" db:"destination_0,json"` // destination address 0
@@ -33,6 +34,10 @@ type OutdialTarget struct {
 	TryCount3 int `json:"try_count_3" db:"try_count_3"` // try count for destination 3
 	TryCount4 int `json:"try_count_4" db:"try_count_4"` // try count for destination 4
 
+	// scheduled callback
+	CallbackAgentID uuid.UUID  `json:"callback_agent_id" db:"callback_agent_id,uuid"` // the agent who handles the callback. empty means any agent
+	TMCallback      *time.Time `json:"tm_callback" db:"tm_callback"`                  // the target is not dialed until this time
+
 	TMCreate *time.Time `json:"tm_create" db:"tm_create"`
 	TMUpdate *time.Time `json:"tm_update" db:"tm_update"`
 	TMDelete *time.Time `json:"tm_delete" db:"tm_delete"`
@@ -17,8 +17,9 @@ type WebhookMessage struct {
 	Name   string `json:"name"`
 	Detail string `json:"detail"`
 
-	Data   string `json:"data"`
-	Status Status `json:"status"`
+	Data     string `json:"data"`
+	Status   Status `json:"status"`
+	Timezone string `json:"timezone"`
 
 	// destinations
 	Destination0 *commonaddress.Address `json:"destination_0"` // destination address 0
@@ -34,6 +35,10 @@ type WebhookMessage struct {
 	TryCount3 int `json:"try_count_3"` // try count for destination 3
 	TryCount4 int `json:"try_count_4"` // try count for destination 4
 
+	// scheduled callback
+	CallbackAgentID uuid.UUID  `json:"callback_agent_id"` // the agent who handles the callback. empty means any agent
+	TMCallback      *time.Time `json:"tm_callback"`       // the target is not dialed until this time
+
 	TMCreate *time.Time `json:"tm_create"`
 	TMUpdate *time.Time `json:"tm_update"`
 	TMDelete *time.Time `json:"tm_delete"`
@@ -48,8 +53,9 @@ func (h *OutdialTarget) ConvertWebhookMessage() *WebhookMessage {
 		Name:   h.Name,
 		Detail: h.Detail,
 
-		Data:   h.Data,
-		Status: h.Status,
+		Data:     h.Data,
+		Status:   h.Status,
+		Timezone: h.Timezone,
 
 		Destination0: h.Destination0,
 		Destination1: h.Destination1,
@@ -63,6 +69,9 @@ func (h *OutdialTarget) ConvertWebhookMessage() *WebhookMessage {
 		TryCount3: h.TryCount3,
 		TryCount4: h.TryCount4,
 
+		CallbackAgentID: h.CallbackAgentID,
+		TMCallback:      h.TMCallback,
+
 		TMCreate: h.TMCreate,
 		TMUpdate: h.TMUpdate,
 		TMDelete: h.TMDelete,
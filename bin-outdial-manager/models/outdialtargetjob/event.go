@@ -0,0 +1,7 @@
+package outdialtargetjob
+
+// list of outdialtargetjob event types
+const (
+	EventTypeOutdialTargetJobCreated string = "outdialtargetjob_created" // the outdialtargetjob created
+	EventTypeOutdialTargetJobUpdated string = "outdialtargetjob_updated" // the outdialtargetjob's progress or status updated
+)
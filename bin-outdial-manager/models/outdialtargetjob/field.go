@@ -0,0 +1,35 @@
+package outdialtargetjob
+
+// Field type for typed field constants
+type Field string
+
+// Field constants for outdialtargetjob
+const (
+	FieldID         Field = "id"
+	FieldCustomerID Field = "customer_id"
+
+	FieldOutdialID Field = "outdial_id"
+
+	FieldType   Field = "type"
+	FieldStatus Field = "status"
+
+	FieldFileID  Field = "file_id"
+	FieldMapping Field = "mapping"
+
+	FieldTotalCount     Field = "total_count"
+	FieldProcessedCount Field = "processed_count"
+	FieldSuccessCount   Field = "success_count"
+	FieldDuplicateCount Field = "duplicate_count"
+	FieldErrorCount     Field = "error_count"
+
+	FieldErrorFileID Field = "error_file_id"
+
+	FieldDetail Field = "detail"
+
+	FieldTMCreate Field = "tm_create"
+	FieldTMUpdate Field = "tm_update"
+	FieldTMDelete Field = "tm_delete"
+
+	// filter only
+	FieldDeleted Field = "deleted"
+)
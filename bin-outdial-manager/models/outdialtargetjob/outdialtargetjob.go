@@ -0,0 +1,84 @@
+package outdialtargetjob
+
+import (
+	"time"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+
+	"github.com/gofrs/uuid"
+)
+
+// OutdialTargetJob defines an asynchronous bulk import or export of the outdial's targets.
+type OutdialTargetJob struct {
+	commonidentity.Identity
+
+	OutdialID uuid.UUID `json:"outdial_id" db:"outdial_id,uuid"`
+
+	Type   Type   `json:"type" db:"type"`
+	Status Status `json:"status" db:"status"`
+
+	FileID  uuid.UUID `json:"file_id" db:"file_id,uuid"`           // import: the source csv file. export: the exported csv file
+	Mapping *Mapping  `json:"mapping,omitempty" db:"mapping,json"` // import only. column mapping of the csv file
+
+	TotalCount     int `json:"total_count" db:"total_count"`         // the number of the data rows
+	ProcessedCount int `json:"processed_count" db:"processed_count"` // the number of the processed rows
+	SuccessCount   int `json:"success_count" db:"success_count"`     // the number of the imported/exported targets
+	DuplicateCount int `json:"duplicate_count" db:"duplicate_count"` // import only. the number of the rows skipped as duplicated
+	ErrorCount     int `json:"error_count" db:"error_count"`         // import only. the number of the invalid rows
+
+	ErrorFileID uuid.UUID `json:"error_file_id" db:"error_file_id,uuid"` // import only. the error report csv file. empty if there was no invalid row
+
+	Detail string `json:"detail" db:"detail"` // the reason of the failure
+
+	TMCreate *time.Time `json:"tm_create" db:"tm_create"`
+	TMUpdate *time.Time `json:"tm_update" db:"tm_update"`
+	TMDelete *time.Time `json:"tm_delete" db:"tm_delete"`
+}
+
+// Mapping defines the csv header names of the outdial target's fields.
+// Empty mapping field means the field's default header name.
+type Mapping struct {
+	Name     string `json:"name,omitempty"`
+	Detail   string `json:"detail,omitempty"`
+	Data     string `json:"data,omitempty"`
+	Timezone string `json:"timezone,omitempty"`
+
+	Destination0 string `json:"destination_0,omitempty"`
+	Destination1 string `json:"destination_1,omitempty"`
+	Destination2 string `json:"destination_2,omitempty"`
+	Destination3 string `json:"destination_3,omitempty"`
+	Destination4 string `json:"destination_4,omitempty"`
+}
+
+// list of default csv header names.
+// the export uses these header names, so the exported file can be imported without the mapping.
+const (
+	HeaderName         = "name"
+	HeaderDetail       = "detail"
+	HeaderData         = "data"
+	HeaderTimezone     = "timezone"
+	HeaderDestination0 = "destination_0"
+	HeaderDestination1 = "destination_1"
+	HeaderDestination2 = "destination_2"
+	HeaderDestination3 = "destination_3"
+	HeaderDestination4 = "destination_4"
+)
+
+// Type defines
+type Type string
+
+// list of types
+const (
+	TypeImport Type = "import" // creates the outdial targets from the csv file
+	TypeExport Type = "export" // writes the outdial targets to the csv file
+)
+
+// Status defines
+type Status string
+
+// list of statuses
+const (
+	StatusProcessing Status = "processing"
+	StatusDone       Status = "done"
+	StatusFailed     Status = "failed"
+)
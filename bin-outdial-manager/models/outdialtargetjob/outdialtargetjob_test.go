@@ -0,0 +1,64 @@
+package outdialtargetjob
+
+import (
+	"testing"
+)
+
+func TestTypeConstants(t *testing.T) {
+	tests := []struct {
+		name     string
+		constant Type
+		expected string
+	}{
+		{
+			name:     "type_import",
+			constant: TypeImport,
+			expected: "import",
+		},
+		{
+			name:     "type_export",
+			constant: TypeExport,
+			expected: "export",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if string(tt.constant) != tt.expected {
+				t.Errorf("Wrong constant value. expect: %s, got: %s", tt.expected, tt.constant)
+			}
+		})
+	}
+}
+
+func TestStatusConstants(t *testing.T) {
+	tests := []struct {
+		name     string
+		constant Status
+		expected string
+	}{
+		{
+			name:     "status_processing",
+			constant: StatusProcessing,
+			expected: "processing",
+		},
+		{
+			name:     "status_done",
+			constant: StatusDone,
+			expected: "done",
+		},
+		{
+			name:     "status_failed",
+			constant: StatusFailed,
+			expected: "failed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if string(tt.constant) != tt.expected {
+				t.Errorf("Wrong constant value. expect: %s, got: %s", tt.expected, tt.constant)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package outdialtargetjob
+
+import (
+	"encoding/json"
+	"time"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+
+	"github.com/gofrs/uuid"
+)
+
+// WebhookMessage defines
+type WebhookMessage struct {
+	commonidentity.Identity
+
+	OutdialID uuid.UUID `json:"outdial_id"`
+
+	Type   Type   `json:"type"`
+	Status Status `json:"status"`
+
+	FileID  uuid.UUID `json:"file_id"`
+	Mapping *Mapping  `json:"mapping,omitempty"`
+
+	TotalCount     int `json:"total_count"`
+	ProcessedCount int `json:"processed_count"`
+	SuccessCount   int `json:"success_count"`
+	DuplicateCount int `json:"duplicate_count"`
+	ErrorCount     int `json:"error_count"`
+
+	ErrorFileID uuid.UUID `json:"error_file_id"`
+
+	Detail string `json:"detail"`
+
+	TMCreate *time.Time `json:"tm_create"`
+	TMUpdate *time.Time `json:"tm_update"`
+	TMDelete *time.Time `json:"tm_delete"`
+}
+
+// ConvertWebhookMessage converts to the event
+func (h *OutdialTargetJob) ConvertWebhookMessage() *WebhookMessage {
+	return &WebhookMessage{
+		Identity: h.Identity,
+
+		OutdialID: h.OutdialID,
+
+		Type:   h.Type,
+		Status: h.Status,
+
+		FileID:  h.FileID,
+		Mapping: h.Mapping,
+
+		TotalCount:     h.TotalCount,
+		ProcessedCount: h.ProcessedCount,
+		SuccessCount:   h.SuccessCount,
+		DuplicateCount: h.DuplicateCount,
+		ErrorCount:     h.ErrorCount,
+
+		ErrorFileID: h.ErrorFileID,
+
+		Detail: h.Detail,
+
+		TMCreate: h.TMCreate,
+		TMUpdate: h.TMUpdate,
+		TMDelete: h.TMDelete,
+	}
+}
+
+// CreateWebhookEvent generates the WebhookEvent
+func (h *OutdialTargetJob) CreateWebhookEvent() ([]byte, error) {
+	e := h.ConvertWebhookMessage()
+
+	m, err := json.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
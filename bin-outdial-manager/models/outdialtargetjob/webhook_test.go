@@ -0,0 +1,64 @@
+package outdialtargetjob
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+
+	"github.com/gofrs/uuid"
+)
+
+func TestCreateWebhookEvent(t *testing.T) {
+	tmCreate := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		job  *OutdialTargetJob
+	}{
+		{
+			name: "import",
+			job: &OutdialTargetJob{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("8b0f3a6e-2d3c-11f1-9c41-4f5e6d7c8b01"),
+					CustomerID: uuid.FromStringOrNil("8b3d5c82-2d3c-11f1-a2b7-5a6b7c8d9e02"),
+				},
+				OutdialID: uuid.FromStringOrNil("8b6a7e96-2d3c-11f1-b3c8-6b7c8d9e0f03"),
+				Type:      TypeImport,
+				Status:    StatusDone,
+				FileID:    uuid.FromStringOrNil("8b97a0aa-2d3c-11f1-84d9-7c8d9e0f1a04"),
+				Mapping: &Mapping{
+					Name:         "customer name",
+					Destination0: "phone",
+				},
+				TotalCount:     10,
+				ProcessedCount: 10,
+				SuccessCount:   7,
+				DuplicateCount: 1,
+				ErrorCount:     2,
+				ErrorFileID:    uuid.FromStringOrNil("8bc4c2be-2d3c-11f1-95ea-8d9e0f1a2b05"),
+				TMCreate:       &tmCreate,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := tt.job.CreateWebhookEvent()
+			if err != nil {
+				t.Fatalf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			res := WebhookMessage{}
+			if errUnmarshal := json.Unmarshal(m, &res); errUnmarshal != nil {
+				t.Fatalf("Could not unmarshal the webhook message. err: %v", errUnmarshal)
+			}
+
+			if !reflect.DeepEqual(&res, tt.job.ConvertWebhookMessage()) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.job.ConvertWebhookMessage(), res)
+			}
+		})
+	}
+}
@@ -0,0 +1,7 @@
+package suppression
+
+// list of suppression event types
+const (
+	EventTypeSuppressionCreated string = "suppression_created" // the suppression created
+	EventTypeSuppressionDeleted string = "suppression_deleted" // the suppression deleted
+)
@@ -0,0 +1,22 @@
+package suppression
+
+// Field type for typed field constants
+type Field string
+
+// Field constants for suppression
+const (
+	FieldID         Field = "id"
+	FieldCustomerID Field = "customer_id"
+
+	FieldListType Field = "list_type"
+	FieldTarget   Field = "target"
+
+	FieldDetail Field = "detail"
+
+	FieldTMCreate Field = "tm_create"
+	FieldTMUpdate Field = "tm_update"
+	FieldTMDelete Field = "tm_delete"
+
+	// filter only
+	FieldDeleted Field = "deleted"
+)
@@ -0,0 +1,31 @@
+package suppression
+
+import (
+	"time"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+)
+
+// Suppression defines a number which must not be contacted by the customer's outbound traffic.
+type Suppression struct {
+	commonidentity.Identity
+
+	ListType ListType `json:"list_type" db:"list_type"`
+	Target   string   `json:"target" db:"target"` // normalized tel number. i.e. +821100000001
+
+	Detail string `json:"detail" db:"detail"`
+
+	TMCreate *time.Time `json:"tm_create" db:"tm_create"`
+	TMUpdate *time.Time `json:"tm_update" db:"tm_update"`
+	TMDelete *time.Time `json:"tm_delete" db:"tm_delete"`
+}
+
+// ListType defines
+type ListType string
+
+// list of list types
+const (
+	ListTypeDNC       ListType = "dnc"       // do-not-call list
+	ListTypeOptOut    ListType = "opt_out"   // the callee opted out. i.e. replied STOP to the sms
+	ListTypeLitigator ListType = "litigator" // known litigators
+)
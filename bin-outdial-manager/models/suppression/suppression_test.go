@@ -0,0 +1,37 @@
+package suppression
+
+import (
+	"testing"
+)
+
+func TestListTypeConstants(t *testing.T) {
+	tests := []struct {
+		name     string
+		constant ListType
+		expected string
+	}{
+		{
+			name:     "list_type_dnc",
+			constant: ListTypeDNC,
+			expected: "dnc",
+		},
+		{
+			name:     "list_type_opt_out",
+			constant: ListTypeOptOut,
+			expected: "opt_out",
+		},
+		{
+			name:     "list_type_litigator",
+			constant: ListTypeLitigator,
+			expected: "litigator",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if string(tt.constant) != tt.expected {
+				t.Errorf("Wrong constant value. expect: %s, got: %s", tt.expected, tt.constant)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package suppression
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+
+	"github.com/gofrs/uuid"
+)
+
+func TestCreateWebhookEvent(t *testing.T) {
+	tmCreate := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		suppression *Suppression
+	}{
+		{
+			name: "normal",
+			suppression: &Suppression{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("3f0a9d3e-2b6b-11f1-9a1c-7b8e0b5f5a01"),
+					CustomerID: uuid.FromStringOrNil("3f3c6a7c-2b6b-11f1-8f57-0f7c3d9f1a02"),
+				},
+				ListType: ListTypeDNC,
+				Target:   "+821100000001",
+				Detail:   "test detail",
+				TMCreate: &tmCreate,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := tt.suppression.CreateWebhookEvent()
+			if err != nil {
+				t.Fatalf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			res := WebhookMessage{}
+			if errUnmarshal := json.Unmarshal(m, &res); errUnmarshal != nil {
+				t.Fatalf("Could not unmarshal the webhook message. err: %v", errUnmarshal)
+			}
+
+			if res.ID != tt.suppression.ID || res.ListType != tt.suppression.ListType || res.Target != tt.suppression.Target || res.Detail != tt.suppression.Detail {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.suppression, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,6 @@
+package suppressionblock
+
+// list of suppressionblock event types
+const (
+	EventTypeSuppressionBlockCreated string = "suppressionblock_created" // the outbound attempt was blocked
+)
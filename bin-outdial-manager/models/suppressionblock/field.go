@@ -0,0 +1,26 @@
+package suppressionblock
+
+// Field type for typed field constants
+type Field string
+
+// Field constants for suppressionblock
+const (
+	FieldID         Field = "id"
+	FieldCustomerID Field = "customer_id"
+
+	FieldSuppressionID Field = "suppression_id"
+	FieldListType      Field = "list_type"
+	FieldTarget        Field = "target"
+
+	FieldReferenceType Field = "reference_type"
+	FieldReferenceID   Field = "reference_id"
+
+	FieldReason Field = "reason"
+
+	FieldTMCreate Field = "tm_create"
+	FieldTMUpdate Field = "tm_update"
+	FieldTMDelete Field = "tm_delete"
+
+	// filter only
+	FieldDeleted Field = "deleted"
+)
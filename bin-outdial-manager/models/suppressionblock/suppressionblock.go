@@ -0,0 +1,39 @@
+package suppressionblock
+
+import (
+	"time"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+
+	"github.com/gofrs/uuid"
+
+	"monorepo/bin-outdial-manager/models/suppression"
+)
+
+// SuppressionBlock defines an outbound attempt which was blocked by the suppression.
+type SuppressionBlock struct {
+	commonidentity.Identity
+
+	SuppressionID uuid.UUID            `json:"suppression_id" db:"suppression_id,uuid"`
+	ListType      suppression.ListType `json:"list_type" db:"list_type"`
+	Target        string               `json:"target" db:"target"`
+
+	ReferenceType ReferenceType `json:"reference_type" db:"reference_type"`  // the blocked outbound channel
+	ReferenceID   uuid.UUID     `json:"reference_id" db:"reference_id,uuid"` // the resource which made the attempt. could be empty
+
+	Reason string `json:"reason" db:"reason"`
+
+	TMCreate *time.Time `json:"tm_create" db:"tm_create"`
+	TMUpdate *time.Time `json:"tm_update" db:"tm_update"`
+	TMDelete *time.Time `json:"tm_delete" db:"tm_delete"`
+}
+
+// ReferenceType defines
+type ReferenceType string
+
+// list of reference types
+const (
+	ReferenceTypeCall     ReferenceType = "call"     // the call. reference id is the master call id
+	ReferenceTypeMessage  ReferenceType = "message"  // the message. reference id is the message id
+	ReferenceTypeCampaign ReferenceType = "campaign" // the campaign. reference id is the campaign id
+)
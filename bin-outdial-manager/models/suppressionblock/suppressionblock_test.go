@@ -0,0 +1,37 @@
+package suppressionblock
+
+import (
+	"testing"
+)
+
+func TestReferenceTypeConstants(t *testing.T) {
+	tests := []struct {
+		name     string
+		constant ReferenceType
+		expected string
+	}{
+		{
+			name:     "reference_type_call",
+			constant: ReferenceTypeCall,
+			expected: "call",
+		},
+		{
+			name:     "reference_type_message",
+			constant: ReferenceTypeMessage,
+			expected: "message",
+		},
+		{
+			name:     "reference_type_campaign",
+			constant: ReferenceTypeCampaign,
+			expected: "campaign",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if string(tt.constant) != tt.expected {
+				t.Errorf("Wrong constant value. expect: %s, got: %s", tt.expected, tt.constant)
+			}
+		})
+	}
+}
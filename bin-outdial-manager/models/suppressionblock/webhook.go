@@ -0,0 +1,62 @@
+package suppressionblock
+
+import (
+	"encoding/json"
+	"time"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+
+	"github.com/gofrs/uuid"
+
+	"monorepo/bin-outdial-manager/models/suppression"
+)
+
+// WebhookMessage defines
+type WebhookMessage struct {
+	commonidentity.Identity
+
+	SuppressionID uuid.UUID            `json:"suppression_id"`
+	ListType      suppression.ListType `json:"list_type"`
+	Target        string               `json:"target"`
+
+	ReferenceType ReferenceType `json:"reference_type"`
+	ReferenceID   uuid.UUID     `json:"reference_id"`
+
+	Reason string `json:"reason"`
+
+	TMCreate *time.Time `json:"tm_create"`
+	TMUpdate *time.Time `json:"tm_update"`
+	TMDelete *time.Time `json:"tm_delete"`
+}
+
+// ConvertWebhookMessage converts to the event
+func (h *SuppressionBlock) ConvertWebhookMessage() *WebhookMessage {
+	return &WebhookMessage{
+		Identity: h.Identity,
+
+		SuppressionID: h.SuppressionID,
+		ListType:      h.ListType,
+		Target:        h.Target,
+
+		ReferenceType: h.ReferenceType,
+		ReferenceID:   h.ReferenceID,
+
+		Reason: h.Reason,
+
+		TMCreate: h.TMCreate,
+		TMUpdate: h.TMUpdate,
+		TMDelete: h.TMDelete,
+	}
+}
+
+// CreateWebhookEvent generates the WebhookEvent
+func (h *SuppressionBlock) CreateWebhookEvent() ([]byte, error) {
+	e := h.ConvertWebhookMessage()
+
+	m, err := json.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
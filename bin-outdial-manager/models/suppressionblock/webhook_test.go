@@ -0,0 +1,57 @@
+package suppressionblock
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+
+	"github.com/gofrs/uuid"
+
+	"monorepo/bin-outdial-manager/models/suppression"
+)
+
+func TestCreateWebhookEvent(t *testing.T) {
+	tmCreate := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		block *SuppressionBlock
+	}{
+		{
+			name: "normal",
+			block: &SuppressionBlock{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("5c1e8e5a-2b6b-11f1-a4a2-3bb1f1c0c101"),
+					CustomerID: uuid.FromStringOrNil("5c4d0f7e-2b6b-11f1-9a62-2f3d4e5f6a02"),
+				},
+				SuppressionID: uuid.FromStringOrNil("5c7b3c2a-2b6b-11f1-bb4d-7f1e2d3c4b03"),
+				ListType:      suppression.ListTypeOptOut,
+				Target:        "+821100000001",
+				ReferenceType: ReferenceTypeMessage,
+				ReferenceID:   uuid.FromStringOrNil("5ca8e1d6-2b6b-11f1-8c3e-0a1b2c3d4e04"),
+				Reason:        "The destination is in the opt_out list.",
+				TMCreate:      &tmCreate,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := tt.block.CreateWebhookEvent()
+			if err != nil {
+				t.Fatalf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			res := WebhookMessage{}
+			if errUnmarshal := json.Unmarshal(m, &res); errUnmarshal != nil {
+				t.Fatalf("Could not unmarshal the webhook message. err: %v", errUnmarshal)
+			}
+
+			if res.ID != tt.block.ID || res.SuppressionID != tt.block.SuppressionID || res.ReferenceType != tt.block.ReferenceType || res.ReferenceID != tt.block.ReferenceID || res.Reason != tt.block.Reason {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.block, res)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"database/sql"
 	"encoding/json"
 	"errors"
+	"time"
 
 	commonaddress "monorepo/bin-common-handler/models/address"
 	"monorepo/bin-common-handler/pkg/utilhandler"
@@ -16,6 +17,9 @@ import (
 	"monorepo/bin-outdial-manager/models/outdial"
 	"monorepo/bin-outdial-manager/models/outdialtarget"
 	"monorepo/bin-outdial-manager/models/outdialtargetcall"
+	"monorepo/bin-outdial-manager/models/outdialtargetjob"
+	"monorepo/bin-outdial-manager/models/suppression"
+	"monorepo/bin-outdial-manager/models/suppressionblock"
 	"monorepo/bin-outdial-manager/pkg/cachehandler"
 )
 
@@ -45,6 +49,7 @@ type DBHandler interface {
 		limit uint64,
 	) ([]*outdialtarget.OutdialTarget, error)
 	OutdialTargetUpdateProgressing(ctx context.Context, id uuid.UUID, destinationIndex int) error
+	OutdialTargetUpdateStatus(ctx context.Context, id uuid.UUID, status outdialtarget.Status) error
 
 	// outdialtargetcall
 	OutdialTargetCallCreate(ctx context.Context, t *outdialtargetcall.OutdialTargetCall) error
@@ -53,6 +58,25 @@ type DBHandler interface {
 	OutdialTargetCallGetByActiveflowID(ctx context.Context, activeflowID uuid.UUID) (*outdialtargetcall.OutdialTargetCall, error)
 	OutdialTargetCallList(ctx context.Context, token string, size uint64, filters map[outdialtargetcall.Field]any) ([]*outdialtargetcall.OutdialTargetCall, error)
 	OutdialTargetCallUpdate(ctx context.Context, id uuid.UUID, fields map[outdialtargetcall.Field]any) error
+
+	// outdialtargetjob
+	OutdialTargetJobCreate(ctx context.Context, j *outdialtargetjob.OutdialTargetJob) error
+	OutdialTargetJobGet(ctx context.Context, id uuid.UUID) (*outdialtargetjob.OutdialTargetJob, error)
+	OutdialTargetJobList(ctx context.Context, token string, size uint64, filters map[outdialtargetjob.Field]any) ([]*outdialtargetjob.OutdialTargetJob, error)
+	OutdialTargetJobUpdate(ctx context.Context, id uuid.UUID, fields map[outdialtargetjob.Field]any) error
+	OutdialTargetJobHeartbeat(ctx context.Context, id uuid.UUID) error
+	OutdialTargetJobClaim(ctx context.Context, id uuid.UUID, tmUpdate *time.Time) (int64, error)
+
+	// suppression
+	SuppressionCreate(ctx context.Context, s *suppression.Suppression) error
+	SuppressionDelete(ctx context.Context, id uuid.UUID) error
+	SuppressionGet(ctx context.Context, id uuid.UUID) (*suppression.Suppression, error)
+	SuppressionList(ctx context.Context, token string, size uint64, filters map[suppression.Field]any) ([]*suppression.Suppression, error)
+
+	// suppressionblock
+	SuppressionBlockCreate(ctx context.Context, b *suppressionblock.SuppressionBlock) error
+	SuppressionBlockGet(ctx context.Context, id uuid.UUID) (*suppressionblock.SuppressionBlock, error)
+	SuppressionBlockList(ctx context.Context, token string, size uint64, filters map[suppressionblock.Field]any) ([]*suppressionblock.SuppressionBlock, error)
 }
 
 // handler database handler
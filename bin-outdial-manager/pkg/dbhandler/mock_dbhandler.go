@@ -14,7 +14,11 @@ import (
 	outdial "monorepo/bin-outdial-manager/models/outdial"
 	outdialtarget "monorepo/bin-outdial-manager/models/outdialtarget"
 	outdialtargetcall "monorepo/bin-outdial-manager/models/outdialtargetcall"
+	outdialtargetjob "monorepo/bin-outdial-manager/models/outdialtargetjob"
+	suppression "monorepo/bin-outdial-manager/models/suppression"
+	suppressionblock "monorepo/bin-outdial-manager/models/suppressionblock"
 	reflect "reflect"
+	time "time"
 
 	uuid "github.com/gofrs/uuid"
 	gomock "go.uber.org/mock/gomock"
@@ -248,6 +252,93 @@ func (mr *MockDBHandlerMockRecorder) OutdialTargetGetAvailable(ctx, outdialID, t
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutdialTargetGetAvailable", reflect.TypeOf((*MockDBHandler)(nil).OutdialTargetGetAvailable), ctx, outdialID, tryCount0, tryCount1, tryCount2, tryCount3, tryCount4, limit)
 }
 
+// OutdialTargetJobClaim mocks base method.
+func (m *MockDBHandler) OutdialTargetJobClaim(ctx context.Context, id uuid.UUID, tmUpdate *time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OutdialTargetJobClaim", ctx, id, tmUpdate)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OutdialTargetJobClaim indicates an expected call of OutdialTargetJobClaim.
+func (mr *MockDBHandlerMockRecorder) OutdialTargetJobClaim(ctx, id, tmUpdate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutdialTargetJobClaim", reflect.TypeOf((*MockDBHandler)(nil).OutdialTargetJobClaim), ctx, id, tmUpdate)
+}
+
+// OutdialTargetJobCreate mocks base method.
+func (m *MockDBHandler) OutdialTargetJobCreate(ctx context.Context, j *outdialtargetjob.OutdialTargetJob) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OutdialTargetJobCreate", ctx, j)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OutdialTargetJobCreate indicates an expected call of OutdialTargetJobCreate.
+func (mr *MockDBHandlerMockRecorder) OutdialTargetJobCreate(ctx, j any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutdialTargetJobCreate", reflect.TypeOf((*MockDBHandler)(nil).OutdialTargetJobCreate), ctx, j)
+}
+
+// OutdialTargetJobGet mocks base method.
+func (m *MockDBHandler) OutdialTargetJobGet(ctx context.Context, id uuid.UUID) (*outdialtargetjob.OutdialTargetJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OutdialTargetJobGet", ctx, id)
+	ret0, _ := ret[0].(*outdialtargetjob.OutdialTargetJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OutdialTargetJobGet indicates an expected call of OutdialTargetJobGet.
+func (mr *MockDBHandlerMockRecorder) OutdialTargetJobGet(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutdialTargetJobGet", reflect.TypeOf((*MockDBHandler)(nil).OutdialTargetJobGet), ctx, id)
+}
+
+// OutdialTargetJobHeartbeat mocks base method.
+func (m *MockDBHandler) OutdialTargetJobHeartbeat(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OutdialTargetJobHeartbeat", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OutdialTargetJobHeartbeat indicates an expected call of OutdialTargetJobHeartbeat.
+func (mr *MockDBHandlerMockRecorder) OutdialTargetJobHeartbeat(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutdialTargetJobHeartbeat", reflect.TypeOf((*MockDBHandler)(nil).OutdialTargetJobHeartbeat), ctx, id)
+}
+
+// OutdialTargetJobList mocks base method.
+func (m *MockDBHandler) OutdialTargetJobList(ctx context.Context, token string, size uint64, filters map[outdialtargetjob.Field]any) ([]*outdialtargetjob.OutdialTargetJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OutdialTargetJobList", ctx, token, size, filters)
+	ret0, _ := ret[0].([]*outdialtargetjob.OutdialTargetJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OutdialTargetJobList indicates an expected call of OutdialTargetJobList.
+func (mr *MockDBHandlerMockRecorder) OutdialTargetJobList(ctx, token, size, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutdialTargetJobList", reflect.TypeOf((*MockDBHandler)(nil).OutdialTargetJobList), ctx, token, size, filters)
+}
+
+// OutdialTargetJobUpdate mocks base method.
+func (m *MockDBHandler) OutdialTargetJobUpdate(ctx context.Context, id uuid.UUID, fields map[outdialtargetjob.Field]any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OutdialTargetJobUpdate", ctx, id, fields)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OutdialTargetJobUpdate indicates an expected call of OutdialTargetJobUpdate.
+func (mr *MockDBHandlerMockRecorder) OutdialTargetJobUpdate(ctx, id, fields any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutdialTargetJobUpdate", reflect.TypeOf((*MockDBHandler)(nil).OutdialTargetJobUpdate), ctx, id, fields)
+}
+
 // OutdialTargetList mocks base method.
 func (m *MockDBHandler) OutdialTargetList(ctx context.Context, token string, size uint64, filters map[outdialtarget.Field]any) ([]*outdialtarget.OutdialTarget, error) {
 	m.ctrl.T.Helper()
@@ -291,6 +382,20 @@ func (mr *MockDBHandlerMockRecorder) OutdialTargetUpdateProgressing(ctx, id, des
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutdialTargetUpdateProgressing", reflect.TypeOf((*MockDBHandler)(nil).OutdialTargetUpdateProgressing), ctx, id, destinationIndex)
 }
 
+// OutdialTargetUpdateStatus mocks base method.
+func (m *MockDBHandler) OutdialTargetUpdateStatus(ctx context.Context, id uuid.UUID, status outdialtarget.Status) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OutdialTargetUpdateStatus", ctx, id, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OutdialTargetUpdateStatus indicates an expected call of OutdialTargetUpdateStatus.
+func (mr *MockDBHandlerMockRecorder) OutdialTargetUpdateStatus(ctx, id, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutdialTargetUpdateStatus", reflect.TypeOf((*MockDBHandler)(nil).OutdialTargetUpdateStatus), ctx, id, status)
+}
+
 // OutdialUpdate mocks base method.
 func (m *MockDBHandler) OutdialUpdate(ctx context.Context, id uuid.UUID, fields map[outdial.Field]any) error {
 	m.ctrl.T.Helper()
@@ -304,3 +409,105 @@ func (mr *MockDBHandlerMockRecorder) OutdialUpdate(ctx, id, fields any) *gomock.
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutdialUpdate", reflect.TypeOf((*MockDBHandler)(nil).OutdialUpdate), ctx, id, fields)
 }
+
+// SuppressionBlockCreate mocks base method.
+func (m *MockDBHandler) SuppressionBlockCreate(ctx context.Context, b *suppressionblock.SuppressionBlock) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuppressionBlockCreate", ctx, b)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SuppressionBlockCreate indicates an expected call of SuppressionBlockCreate.
+func (mr *MockDBHandlerMockRecorder) SuppressionBlockCreate(ctx, b any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuppressionBlockCreate", reflect.TypeOf((*MockDBHandler)(nil).SuppressionBlockCreate), ctx, b)
+}
+
+// SuppressionBlockGet mocks base method.
+func (m *MockDBHandler) SuppressionBlockGet(ctx context.Context, id uuid.UUID) (*suppressionblock.SuppressionBlock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuppressionBlockGet", ctx, id)
+	ret0, _ := ret[0].(*suppressionblock.SuppressionBlock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuppressionBlockGet indicates an expected call of SuppressionBlockGet.
+func (mr *MockDBHandlerMockRecorder) SuppressionBlockGet(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuppressionBlockGet", reflect.TypeOf((*MockDBHandler)(nil).SuppressionBlockGet), ctx, id)
+}
+
+// SuppressionBlockList mocks base method.
+func (m *MockDBHandler) SuppressionBlockList(ctx context.Context, token string, size uint64, filters map[suppressionblock.Field]any) ([]*suppressionblock.SuppressionBlock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuppressionBlockList", ctx, token, size, filters)
+	ret0, _ := ret[0].([]*suppressionblock.SuppressionBlock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuppressionBlockList indicates an expected call of SuppressionBlockList.
+func (mr *MockDBHandlerMockRecorder) SuppressionBlockList(ctx, token, size, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuppressionBlockList", reflect.TypeOf((*MockDBHandler)(nil).SuppressionBlockList), ctx, token, size, filters)
+}
+
+// SuppressionCreate mocks base method.
+func (m *MockDBHandler) SuppressionCreate(ctx context.Context, s *suppression.Suppression) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuppressionCreate", ctx, s)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SuppressionCreate indicates an expected call of SuppressionCreate.
+func (mr *MockDBHandlerMockRecorder) SuppressionCreate(ctx, s any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuppressionCreate", reflect.TypeOf((*MockDBHandler)(nil).SuppressionCreate), ctx, s)
+}
+
+// SuppressionDelete mocks base method.
+func (m *MockDBHandler) SuppressionDelete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuppressionDelete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SuppressionDelete indicates an expected call of SuppressionDelete.
+func (mr *MockDBHandlerMockRecorder) SuppressionDelete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuppressionDelete", reflect.TypeOf((*MockDBHandler)(nil).SuppressionDelete), ctx, id)
+}
+
+// SuppressionGet mocks base method.
+func (m *MockDBHandler) SuppressionGet(ctx context.Context, id uuid.UUID) (*suppression.Suppression, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuppressionGet", ctx, id)
+	ret0, _ := ret[0].(*suppression.Suppression)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuppressionGet indicates an expected call of SuppressionGet.
+func (mr *MockDBHandlerMockRecorder) SuppressionGet(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuppressionGet", reflect.TypeOf((*MockDBHandler)(nil).SuppressionGet), ctx, id)
+}
+
+// SuppressionList mocks base method.
+func (m *MockDBHandler) SuppressionList(ctx context.Context, token string, size uint64, filters map[suppression.Field]any) ([]*suppression.Suppression, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuppressionList", ctx, token, size, filters)
+	ret0, _ := ret[0].([]*suppression.Suppression)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuppressionList indicates an expected call of SuppressionList.
+func (mr *MockDBHandlerMockRecorder) SuppressionList(ctx, token, size, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuppressionList", reflect.TypeOf((*MockDBHandler)(nil).SuppressionList), ctx, token, size, filters)
+}
@@ -26,6 +26,7 @@ const (
 
 		data,
 		status,
+		timezone,
 
 		destination_0,
 		destination_1,
@@ -39,6 +40,9 @@ const (
 		try_count_3,
 		try_count_4,
 
+		callback_agent_id,
+		tm_callback,
+
 		tm_create,
 		tm_update,
 		tm_delete,
@@ -54,7 +58,8 @@ const (
 		status = "idle"
 		and des_0 + des_1 + des_2 + des_3 + des_4 > 0
 		and outdial_id = ?
-	order by tm_update asc
+		and (tm_callback is null or tm_callback <= ?)
+	order by tm_callback is null, tm_callback asc, tm_update asc
 	limit ?
 	`
 )
@@ -77,10 +82,11 @@ func (h *handler) outdialTargetGetFromRowForAvailable(row *sql.Rows) (*outdialta
 	var des0, des1, des2, des3, des4 sql.NullInt64
 
 	var id, outdialID sql.NullString
-	var name, detail, data, status sql.NullString
+	var name, detail, data, status, timezone sql.NullString
 	var destination0, destination1, destination2, destination3, destination4 sql.NullString
 	var tryCount0, tryCount1, tryCount2, tryCount3, tryCount4 sql.NullInt64
-	var tmCreate, tmUpdate, tmDelete sql.NullTime
+	var callbackAgentID sql.NullString
+	var tmCallback, tmCreate, tmUpdate, tmDelete sql.NullTime
 
 	if err := row.Scan(
 		&id,
@@ -89,6 +95,7 @@ func (h *handler) outdialTargetGetFromRowForAvailable(row *sql.Rows) (*outdialta
 		&detail,
 		&data,
 		&status,
+		&timezone,
 		&destination0,
 		&destination1,
 		&destination2,
@@ -99,6 +106,8 @@ func (h *handler) outdialTargetGetFromRowForAvailable(row *sql.Rows) (*outdialta
 		&tryCount2,
 		&tryCount3,
 		&tryCount4,
+		&callbackAgentID,
+		&tmCallback,
 		&tmCreate,
 		&tmUpdate,
 		&tmDelete,
@@ -130,6 +139,9 @@ func (h *handler) outdialTargetGetFromRowForAvailable(row *sql.Rows) (*outdialta
 	if status.Valid {
 		res.Status = outdialtarget.Status(status.String)
 	}
+	if timezone.Valid {
+		res.Timezone = timezone.String
+	}
 	if tryCount0.Valid {
 		res.TryCount0 = int(tryCount0.Int64)
 	}
@@ -145,6 +157,12 @@ func (h *handler) outdialTargetGetFromRowForAvailable(row *sql.Rows) (*outdialta
 	if tryCount4.Valid {
 		res.TryCount4 = int(tryCount4.Int64)
 	}
+	if callbackAgentID.Valid {
+		res.CallbackAgentID, _ = uuid.FromBytes([]byte(callbackAgentID.String))
+	}
+	if tmCallback.Valid {
+		res.TMCallback = &tmCallback.Time
+	}
 	if tmCreate.Valid {
 		res.TMCreate = &tmCreate.Time
 	}
@@ -305,19 +323,36 @@ func (h *handler) OutdialTargetGet(ctx context.Context, id uuid.UUID) (*outdialt
 
 // OutdialTargetList returns list of outdialtargets.
 func (h *handler) OutdialTargetList(ctx context.Context, token string, size uint64, filters map[outdialtarget.Field]any) ([]*outdialtarget.OutdialTarget, error) {
-	if token == "" {
-		token = h.utilHandler.TimeGetCurTime()
+	tmCreate, id := outdialtarget.ParseCursorToken(token)
+	if tmCreate == "" {
+		tmCreate = h.utilHandler.TimeGetCurTime()
 	}
 
 	fields := commondatabasehandler.GetDBFields(&outdialtarget.OutdialTarget{})
 	sb := squirrel.
 		Select(fields...).
 		From(outdialtargetsTable).
-		Where(squirrel.Lt{string(outdialtarget.FieldTMCreate): token}).
-		OrderBy(string(outdialtarget.FieldTMCreate) + " DESC").
+		OrderBy(string(outdialtarget.FieldTMCreate)+" DESC", string(outdialtarget.FieldID)+" DESC").
 		Limit(size).
 		PlaceholderFormat(squirrel.Question)
 
+	if id == uuid.Nil {
+		sb = sb.Where(squirrel.Lt{string(outdialtarget.FieldTMCreate): tmCreate})
+	} else {
+		// the cursor token. the targets sharing the cursor's tm_create continue in the id order.
+		tm, err := h.utilHandler.TimeParseWithError(tmCreate)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse the token. OutdialTargetList. err: %v", err)
+		}
+		sb = sb.Where(squirrel.Or{
+			squirrel.Lt{string(outdialtarget.FieldTMCreate): tm},
+			squirrel.And{
+				squirrel.Eq{string(outdialtarget.FieldTMCreate): tm},
+				squirrel.Lt{string(outdialtarget.FieldID): id.Bytes()},
+			},
+		})
+	}
+
 	sb, err := commondatabasehandler.ApplyFields(sb, filters)
 	if err != nil {
 		return nil, fmt.Errorf("could not apply filters. OutdialTargetList. err: %v", err)
@@ -416,17 +451,20 @@ func (h *handler) OutdialTargetDelete(ctx context.Context, id uuid.UUID) error {
 }
 
 // OutdialTargetUpdateProgressing updates outdialtarget's basic info.
+// The scheduled callback is cleared because the target is being dialed.
 func (h *handler) OutdialTargetUpdateProgressing(ctx context.Context, id uuid.UUID, destinationIndex int) error {
 	q := fmt.Sprintf(`
 	update outdial_outdialtargets set
 		try_count_%d = try_count_%d + 1,
 		status = ?,
+		callback_agent_id = ?,
+		tm_callback = null,
 		tm_update = ?
 	where
 		id = ?
 	`, destinationIndex, destinationIndex)
 
-	if _, err := h.db.Exec(q, outdialtarget.StatusProgressing, h.utilHandler.TimeNow(), id.Bytes()); err != nil {
+	if _, err := h.db.Exec(q, outdialtarget.StatusProgressing, uuid.Nil.Bytes(), h.utilHandler.TimeNow(), id.Bytes()); err != nil {
 		return fmt.Errorf("could not execute the query. OutdialTargetUpdateProgressing. err: %v", err)
 	}
 
@@ -436,7 +474,29 @@ func (h *handler) OutdialTargetUpdateProgressing(ctx context.Context, id uuid.UU
 	return nil
 }
 
+// OutdialTargetUpdateStatus updates outdialtarget's status.
+// The target finished while a callback is pending goes back to idle instead of done, so the callback can be dialed.
+func (h *handler) OutdialTargetUpdateStatus(ctx context.Context, id uuid.UUID, status outdialtarget.Status) error {
+	q := `
+	update outdial_outdialtargets set
+		status = case when ? = ? and tm_callback is not null then ? else ? end,
+		tm_update = ?
+	where
+		id = ?
+	`
+
+	if _, err := h.db.ExecContext(ctx, q, status, outdialtarget.StatusDone, outdialtarget.StatusIdle, status, h.utilHandler.TimeNow(), id.Bytes()); err != nil {
+		return fmt.Errorf("could not execute the query. OutdialTargetUpdateStatus. err: %v", err)
+	}
+
+	// set to the cache
+	_ = h.outdialTargetUpdateToCache(ctx, id)
+
+	return nil
+}
+
 // OutdialTargetGetAvailable returns available outdialtargets.
+// The target with a scheduled callback is excluded until the callback time, and returned first once it is due.
 func (h *handler) OutdialTargetGetAvailable(
 	ctx context.Context,
 	outdialID uuid.UUID,
@@ -458,7 +518,7 @@ func (h *handler) OutdialTargetGetAvailable(
 	}()
 
 	// query
-	rows, err := stmt.QueryContext(ctx, tryCount0, tryCount1, tryCount2, tryCount3, tryCount4, outdialID.Bytes(), limit)
+	rows, err := stmt.QueryContext(ctx, tryCount0, tryCount1, tryCount2, tryCount3, tryCount4, outdialID.Bytes(), h.utilHandler.TimeNow(), limit)
 	if err != nil {
 		return nil, fmt.Errorf("could not query. OutdialTargetGetAvailable. err: %v", err)
 	}
@@ -201,6 +201,74 @@ func Test_OutdialTargetList(t *testing.T) {
 	}
 }
 
+func Test_OutdialTargetList_cursor(t *testing.T) {
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockCache := cachehandler.NewMockCacheHandler(mc)
+	h := handler{
+		db:          dbTest,
+		cache:       mockCache,
+		utilHandler: utilhandler.NewUtilHandler(),
+	}
+
+	// the imported targets share the same tm_create
+	curTime := func() *time.Time { t := time.Date(2020, 4, 19, 3, 22, 17, 995000000, time.UTC); return &t }()
+	outdialID := uuid.FromStringOrNil("0c3b7d4e-ad5b-11f0-8f3a-5b2e1c9d7a60")
+	targets := []outdialtarget.OutdialTarget{
+		{
+			ID:        uuid.FromStringOrNil("0c6a2f1e-ad5b-11f0-b1c4-7e9d3a2b5f80"),
+			OutdialID: outdialID,
+			TMCreate:  curTime,
+		},
+		{
+			ID:        uuid.FromStringOrNil("0c98e8a2-ad5b-11f0-a7d2-3f1b6c8e4d90"),
+			OutdialID: outdialID,
+			TMCreate:  curTime,
+		},
+		{
+			ID:        uuid.FromStringOrNil("0cc7b3f4-ad5b-11f0-94e6-8a2d5f7c1b30"),
+			OutdialID: outdialID,
+			TMCreate:  curTime,
+		},
+	}
+
+	ctx := context.Background()
+	for _, target := range targets {
+		mockCache.EXPECT().OutdialTargetSet(gomock.Any(), gomock.Any())
+		if err := h.OutdialTargetCreate(ctx, &target); err != nil {
+			t.Errorf("Wrong match. expect: ok, got: %v", err)
+		}
+	}
+
+	filters := map[outdialtarget.Field]any{
+		outdialtarget.FieldOutdialID: outdialID,
+		outdialtarget.FieldDeleted:   false,
+	}
+
+	res := []uuid.UUID{}
+	token := ""
+	for range targets {
+		tmps, err := h.OutdialTargetList(ctx, token, 2, filters)
+		if err != nil {
+			t.Fatalf("Wrong match. expect: ok, got: %v", err)
+		}
+		if len(tmps) == 0 {
+			break
+		}
+
+		for _, tmp := range tmps {
+			res = append(res, tmp.ID)
+		}
+		token = outdialtarget.CursorToken(tmps[len(tmps)-1].TMCreate, tmps[len(tmps)-1].ID)
+	}
+
+	expectRes := []uuid.UUID{targets[2].ID, targets[1].ID, targets[0].ID}
+	if !reflect.DeepEqual(res, expectRes) {
+		t.Errorf("Wrong match.\nexpect: %v\ngot: %v", expectRes, res)
+	}
+}
+
 func Test_OutdialTargetUpdate(t *testing.T) {
 	mc := gomock.NewController(t)
 	defer mc.Finish()
@@ -424,3 +492,79 @@ func Test_OutdialTargetUpdateProgressing(t *testing.T) {
 		})
 	}
 }
+
+func Test_OutdialTargetUpdateStatus(t *testing.T) {
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockCache := cachehandler.NewMockCacheHandler(mc)
+
+	tmCallback := time.Date(2026, 10, 22, 15, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		outdialTarget *outdialtarget.OutdialTarget
+		status        outdialtarget.Status
+
+		expectStatus outdialtarget.Status
+	}{
+		{
+			"done",
+			&outdialtarget.OutdialTarget{
+				ID:     uuid.FromStringOrNil("3e0b7a52-ae64-11f1-9d1c-5b2e8f4a7c01"),
+				Status: outdialtarget.StatusProgressing,
+			},
+			outdialtarget.StatusDone,
+
+			outdialtarget.StatusDone,
+		},
+		{
+			"done with the scheduled callback",
+			&outdialtarget.OutdialTarget{
+				ID:         uuid.FromStringOrNil("3e3f1c84-ae64-11f1-8b4e-2d7a9c1e5f02"),
+				Status:     outdialtarget.StatusProgressing,
+				TMCallback: &tmCallback,
+			},
+			outdialtarget.StatusDone,
+
+			outdialtarget.StatusIdle,
+		},
+		{
+			"idle",
+			&outdialtarget.OutdialTarget{
+				ID:     uuid.FromStringOrNil("3e72bdb6-ae64-11f1-a6f3-7e1c4b8d2a03"),
+				Status: outdialtarget.StatusProgressing,
+			},
+			outdialtarget.StatusIdle,
+
+			outdialtarget.StatusIdle,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewHandler(dbTest, mockCache)
+
+			mockCache.EXPECT().OutdialTargetSet(gomock.Any(), gomock.Any())
+			if err := h.OutdialTargetCreate(context.Background(), tt.outdialTarget); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			mockCache.EXPECT().OutdialTargetSet(gomock.Any(), gomock.Any())
+			if err := h.OutdialTargetUpdateStatus(context.Background(), tt.outdialTarget.ID, tt.status); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			mockCache.EXPECT().OutdialTargetGet(gomock.Any(), tt.outdialTarget.ID).Return(nil, fmt.Errorf(""))
+			mockCache.EXPECT().OutdialTargetSet(gomock.Any(), gomock.Any())
+			res, err := h.OutdialTargetGet(context.Background(), tt.outdialTarget.ID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if res.Status != tt.expectStatus {
+				t.Errorf("Wrong match. expect: %s, got: %s", tt.expectStatus, res.Status)
+			}
+		})
+	}
+}
@@ -0,0 +1,222 @@
+package dbhandler
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/gofrs/uuid"
+
+	commondatabasehandler "monorepo/bin-common-handler/pkg/databasehandler"
+	"monorepo/bin-outdial-manager/models/outdialtargetjob"
+)
+
+const (
+	outdialTargetJobsTable = "outdial_outdialtargetjobs"
+)
+
+// outdialTargetJobGetFromRow gets the outdialtargetjob from the row.
+func (h *handler) outdialTargetJobGetFromRow(row *sql.Rows) (*outdialtargetjob.OutdialTargetJob, error) {
+	res := &outdialtargetjob.OutdialTargetJob{}
+
+	if err := commondatabasehandler.ScanRow(row, res); err != nil {
+		return nil, fmt.Errorf("could not scan the row. outdialTargetJobGetFromRow. err: %v", err)
+	}
+
+	return res, nil
+}
+
+// OutdialTargetJobCreate insert a new outdialtargetjob record
+func (h *handler) OutdialTargetJobCreate(ctx context.Context, j *outdialtargetjob.OutdialTargetJob) error {
+	fields, err := commondatabasehandler.PrepareFields(j)
+	if err != nil {
+		return fmt.Errorf("could not prepare fields. OutdialTargetJobCreate. err: %v", err)
+	}
+
+	sb := squirrel.
+		Insert(outdialTargetJobsTable).
+		SetMap(fields).
+		PlaceholderFormat(squirrel.Question)
+
+	query, args, err := sb.ToSql()
+	if err != nil {
+		return fmt.Errorf("could not build query. OutdialTargetJobCreate. err: %v", err)
+	}
+
+	if _, err := h.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("could not execute query. OutdialTargetJobCreate. err: %v", err)
+	}
+
+	return nil
+}
+
+// OutdialTargetJobGet returns outdialtargetjob.
+func (h *handler) OutdialTargetJobGet(ctx context.Context, id uuid.UUID) (*outdialtargetjob.OutdialTargetJob, error) {
+	fields := commondatabasehandler.GetDBFields(&outdialtargetjob.OutdialTargetJob{})
+	query, args, err := squirrel.
+		Select(fields...).
+		From(outdialTargetJobsTable).
+		Where(squirrel.Eq{string(outdialtargetjob.FieldID): id.Bytes()}).
+		PlaceholderFormat(squirrel.Question).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("could not build sql. OutdialTargetJobGet. err: %v", err)
+	}
+
+	row, err := h.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("could not query. OutdialTargetJobGet. err: %v", err)
+	}
+	defer func() {
+		_ = row.Close()
+	}()
+
+	if !row.Next() {
+		if err := row.Err(); err != nil {
+			return nil, fmt.Errorf("row iteration error. OutdialTargetJobGet. err: %v", err)
+		}
+		return nil, ErrNotFound
+	}
+
+	res, err := h.outdialTargetJobGetFromRow(row)
+	if err != nil {
+		return nil, fmt.Errorf("could not get data from row. OutdialTargetJobGet. id: %s, err: %v", id, err)
+	}
+
+	return res, nil
+}
+
+// OutdialTargetJobUpdate updates the outdialtargetjob's fields.
+func (h *handler) OutdialTargetJobUpdate(ctx context.Context, id uuid.UUID, fields map[outdialtargetjob.Field]any) error {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	fields[outdialtargetjob.FieldTMUpdate] = h.utilHandler.TimeNow()
+
+	tmpFields, err := commondatabasehandler.PrepareFields(fields)
+	if err != nil {
+		return fmt.Errorf("OutdialTargetJobUpdate: prepare fields failed: %w", err)
+	}
+
+	q := squirrel.Update(outdialTargetJobsTable).
+		SetMap(tmpFields).
+		Where(squirrel.Eq{string(outdialtargetjob.FieldID): id.Bytes()}).
+		PlaceholderFormat(squirrel.Question)
+
+	sqlStr, args, err := q.ToSql()
+	if err != nil {
+		return fmt.Errorf("OutdialTargetJobUpdate: build SQL failed: %w", err)
+	}
+
+	if _, err := h.db.ExecContext(ctx, sqlStr, args...); err != nil {
+		return fmt.Errorf("OutdialTargetJobUpdate: exec failed: %w", err)
+	}
+
+	return nil
+}
+
+// OutdialTargetJobHeartbeat touches the processing outdialtargetjob's tm_update.
+// The running job calls it periodically, so the recovery does not take over the job.
+func (h *handler) OutdialTargetJobHeartbeat(ctx context.Context, id uuid.UUID) error {
+	q := squirrel.Update(outdialTargetJobsTable).
+		Set(string(outdialtargetjob.FieldTMUpdate), h.utilHandler.TimeNow()).
+		Where(squirrel.Eq{string(outdialtargetjob.FieldID): id.Bytes()}).
+		Where(squirrel.Eq{string(outdialtargetjob.FieldStatus): string(outdialtargetjob.StatusProcessing)}).
+		PlaceholderFormat(squirrel.Question)
+
+	sqlStr, args, err := q.ToSql()
+	if err != nil {
+		return fmt.Errorf("OutdialTargetJobHeartbeat: build SQL failed: %w", err)
+	}
+
+	if _, err := h.db.ExecContext(ctx, sqlStr, args...); err != nil {
+		return fmt.Errorf("OutdialTargetJobHeartbeat: exec failed: %w", err)
+	}
+
+	return nil
+}
+
+// OutdialTargetJobClaim takes over the abandoned processing outdialtargetjob.
+// It touches the tm_update only if the job is still processing with the given tm_update,
+// and returns the number of updated rows, so the caller can tell whether it won the race.
+func (h *handler) OutdialTargetJobClaim(ctx context.Context, id uuid.UUID, tmUpdate *time.Time) (int64, error) {
+	q := squirrel.Update(outdialTargetJobsTable).
+		Set(string(outdialtargetjob.FieldTMUpdate), h.utilHandler.TimeNow()).
+		Where(squirrel.Eq{string(outdialtargetjob.FieldID): id.Bytes()}).
+		Where(squirrel.Eq{string(outdialtargetjob.FieldStatus): string(outdialtargetjob.StatusProcessing)}).
+		PlaceholderFormat(squirrel.Question)
+
+	if tmUpdate == nil {
+		q = q.Where(squirrel.Eq{string(outdialtargetjob.FieldTMUpdate): nil})
+	} else {
+		q = q.Where(squirrel.Eq{string(outdialtargetjob.FieldTMUpdate): *tmUpdate})
+	}
+
+	sqlStr, args, err := q.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("OutdialTargetJobClaim: build SQL failed: %w", err)
+	}
+
+	result, err := h.db.ExecContext(ctx, sqlStr, args...)
+	if err != nil {
+		return 0, fmt.Errorf("OutdialTargetJobClaim: exec failed: %w", err)
+	}
+
+	res, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("OutdialTargetJobClaim: rows affected failed: %w", err)
+	}
+
+	return res, nil
+}
+
+// OutdialTargetJobList returns list of outdialtargetjobs.
+func (h *handler) OutdialTargetJobList(ctx context.Context, token string, size uint64, filters map[outdialtargetjob.Field]any) ([]*outdialtargetjob.OutdialTargetJob, error) {
+	if token == "" {
+		token = h.utilHandler.TimeGetCurTime()
+	}
+
+	fields := commondatabasehandler.GetDBFields(&outdialtargetjob.OutdialTargetJob{})
+	sb := squirrel.
+		Select(fields...).
+		From(outdialTargetJobsTable).
+		Where(squirrel.Lt{string(outdialtargetjob.FieldTMCreate): token}).
+		OrderBy(string(outdialtargetjob.FieldTMCreate) + " DESC").
+		Limit(size).
+		PlaceholderFormat(squirrel.Question)
+
+	sb, err := commondatabasehandler.ApplyFields(sb, filters)
+	if err != nil {
+		return nil, fmt.Errorf("could not apply filters. OutdialTargetJobList. err: %v", err)
+	}
+
+	query, args, err := sb.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("could not build query. OutdialTargetJobList. err: %v", err)
+	}
+
+	rows, err := h.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("could not query. OutdialTargetJobList. err: %v", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	res := []*outdialtargetjob.OutdialTargetJob{}
+	for rows.Next() {
+		u, err := h.outdialTargetJobGetFromRow(rows)
+		if err != nil {
+			return nil, fmt.Errorf("could not get data. OutdialTargetJobList, err: %v", err)
+		}
+		res = append(res, u)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error. OutdialTargetJobList. err: %v", err)
+	}
+
+	return res, nil
+}
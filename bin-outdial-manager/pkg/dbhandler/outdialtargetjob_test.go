@@ -0,0 +1,263 @@
+package dbhandler
+
+import (
+	"context"
+	reflect "reflect"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+	"monorepo/bin-outdial-manager/models/outdialtargetjob"
+	"monorepo/bin-outdial-manager/pkg/cachehandler"
+)
+
+func Test_OutdialTargetJobCreate(t *testing.T) {
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockCache := cachehandler.NewMockCacheHandler(mc)
+	h := handler{
+		db:          dbTest,
+		cache:       mockCache,
+		utilHandler: utilhandler.NewUtilHandler(),
+	}
+
+	curTime := func() *time.Time { t := time.Date(2020, 4, 18, 3, 22, 17, 995000000, time.UTC); return &t }()
+
+	tests := []struct {
+		name string
+		job  *outdialtargetjob.OutdialTargetJob
+
+		expectRes []*outdialtargetjob.OutdialTargetJob
+	}{
+		{
+			"normal",
+			&outdialtargetjob.OutdialTargetJob{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("0f1c6e2a-2d3e-11f1-8a5b-1c2d3e4f5a01"),
+					CustomerID: uuid.FromStringOrNil("0f4a8c3e-2d3e-11f1-9b6c-2d3e4f5a6b02"),
+				},
+				OutdialID: uuid.FromStringOrNil("0f77ae52-2d3e-11f1-ac7d-3e4f5a6b7c03"),
+				Type:      outdialtargetjob.TypeImport,
+				Status:    outdialtargetjob.StatusProcessing,
+				FileID:    uuid.FromStringOrNil("0fa4d066-2d3e-11f1-bd8e-4f5a6b7c8d04"),
+				Mapping: &outdialtargetjob.Mapping{
+					Name:         "customer name",
+					Destination0: "phone",
+				},
+				TMCreate: curTime,
+			},
+
+			[]*outdialtargetjob.OutdialTargetJob{
+				{
+					Identity: commonidentity.Identity{
+						ID:         uuid.FromStringOrNil("0f1c6e2a-2d3e-11f1-8a5b-1c2d3e4f5a01"),
+						CustomerID: uuid.FromStringOrNil("0f4a8c3e-2d3e-11f1-9b6c-2d3e4f5a6b02"),
+					},
+					OutdialID: uuid.FromStringOrNil("0f77ae52-2d3e-11f1-ac7d-3e4f5a6b7c03"),
+					Type:      outdialtargetjob.TypeImport,
+					Status:    outdialtargetjob.StatusProcessing,
+					FileID:    uuid.FromStringOrNil("0fa4d066-2d3e-11f1-bd8e-4f5a6b7c8d04"),
+					Mapping: &outdialtargetjob.Mapping{
+						Name:         "customer name",
+						Destination0: "phone",
+					},
+					TMCreate: curTime,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			if err := h.OutdialTargetJobCreate(ctx, tt.job); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			tmp, err := h.OutdialTargetJobGet(ctx, tt.job.ID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+			if tmp.ID != tt.job.ID {
+				t.Errorf("Wrong match. expect: %s, got: %s", tt.job.ID, tmp.ID)
+			}
+
+			filters := map[outdialtargetjob.Field]any{
+				outdialtargetjob.FieldOutdialID: tt.job.OutdialID,
+				outdialtargetjob.FieldDeleted:   false,
+			}
+			res, err := h.OutdialTargetJobList(ctx, GetCurTime(), 10, filters)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			for i, j := range res {
+				tt.expectRes[i].TMCreate = j.TMCreate
+			}
+			if reflect.DeepEqual(tt.expectRes, res) == false {
+				t.Errorf("Wrong match. expect: %v, got: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_OutdialTargetJobUpdate(t *testing.T) {
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockCache := cachehandler.NewMockCacheHandler(mc)
+	h := handler{
+		db:          dbTest,
+		cache:       mockCache,
+		utilHandler: utilhandler.NewUtilHandler(),
+	}
+
+	tests := []struct {
+		name string
+		job  *outdialtargetjob.OutdialTargetJob
+
+		fields map[outdialtargetjob.Field]any
+
+		expectStatus         outdialtargetjob.Status
+		expectProcessedCount int
+		expectErrorFileID    uuid.UUID
+	}{
+		{
+			"normal",
+			&outdialtargetjob.OutdialTargetJob{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("5a2e8d10-2d3e-11f1-8e9f-5a6b7c8d9e01"),
+					CustomerID: uuid.FromStringOrNil("5a5baf24-2d3e-11f1-9fa0-6b7c8d9e0f02"),
+				},
+				OutdialID: uuid.FromStringOrNil("5a88d138-2d3e-11f1-a0b1-7c8d9e0f1a03"),
+				Type:      outdialtargetjob.TypeImport,
+				Status:    outdialtargetjob.StatusProcessing,
+			},
+
+			map[outdialtargetjob.Field]any{
+				outdialtargetjob.FieldStatus:         outdialtargetjob.StatusDone,
+				outdialtargetjob.FieldProcessedCount: 10,
+				outdialtargetjob.FieldErrorFileID:    uuid.FromStringOrNil("5ab5f34c-2d3e-11f1-b1c2-8d9e0f1a2b04"),
+			},
+
+			outdialtargetjob.StatusDone,
+			10,
+			uuid.FromStringOrNil("5ab5f34c-2d3e-11f1-b1c2-8d9e0f1a2b04"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			if err := h.OutdialTargetJobCreate(ctx, tt.job); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if err := h.OutdialTargetJobUpdate(ctx, tt.job.ID, tt.fields); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			res, err := h.OutdialTargetJobGet(ctx, tt.job.ID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if res.Status != tt.expectStatus || res.ProcessedCount != tt.expectProcessedCount || res.ErrorFileID != tt.expectErrorFileID {
+				t.Errorf("Wrong match. expect: %s/%d/%s, got: %s/%d/%s", tt.expectStatus, tt.expectProcessedCount, tt.expectErrorFileID, res.Status, res.ProcessedCount, res.ErrorFileID)
+			}
+			if res.TMUpdate == nil {
+				t.Errorf("Wrong match. expect: tm_update, got: nil")
+			}
+		})
+	}
+}
+
+func Test_OutdialTargetJobClaim(t *testing.T) {
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockCache := cachehandler.NewMockCacheHandler(mc)
+	h := handler{
+		db:          dbTest,
+		cache:       mockCache,
+		utilHandler: utilhandler.NewUtilHandler(),
+	}
+
+	tests := []struct {
+		name string
+		job  *outdialtargetjob.OutdialTargetJob
+	}{
+		{
+			"normal",
+			&outdialtargetjob.OutdialTargetJob{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("b31c2d3e-ad74-11f0-8c4d-5e6f7a8b9c01"),
+					CustomerID: uuid.FromStringOrNil("b34e5f60-ad74-11f0-9d5e-6f7a8b9c0d02"),
+				},
+				OutdialID: uuid.FromStringOrNil("b3808192-ad74-11f0-ae6f-7a8b9c0d1e03"),
+				Type:      outdialtargetjob.TypeImport,
+				Status:    outdialtargetjob.StatusProcessing,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			if err := h.OutdialTargetJobCreate(ctx, tt.job); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			// the first claim of the job never updated wins
+			n, err := h.OutdialTargetJobClaim(ctx, tt.job.ID, nil)
+			if err != nil || n != 1 {
+				t.Errorf("Wrong match. expect: 1, got: %d, err: %v", n, err)
+			}
+
+			// the other claim with the stale tm_update loses
+			n, err = h.OutdialTargetJobClaim(ctx, tt.job.ID, nil)
+			if err != nil || n != 0 {
+				t.Errorf("Wrong match. expect: 0, got: %d, err: %v", n, err)
+			}
+
+			// the heartbeat keeps the job processing with the new tm_update
+			if errHeartbeat := h.OutdialTargetJobHeartbeat(ctx, tt.job.ID); errHeartbeat != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", errHeartbeat)
+			}
+			res, err := h.OutdialTargetJobGet(ctx, tt.job.ID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+			if res.Status != outdialtargetjob.StatusProcessing || res.TMUpdate == nil {
+				t.Errorf("Wrong match. expect: processing with tm_update, got: %s/%v", res.Status, res.TMUpdate)
+			}
+
+			// the claim with the observed tm_update wins
+			n, err = h.OutdialTargetJobClaim(ctx, tt.job.ID, res.TMUpdate)
+			if err != nil || n != 1 {
+				t.Errorf("Wrong match. expect: 1, got: %d, err: %v", n, err)
+			}
+
+			// the finished job can't be claimed
+			if errUpdate := h.OutdialTargetJobUpdate(ctx, tt.job.ID, map[outdialtargetjob.Field]any{outdialtargetjob.FieldStatus: outdialtargetjob.StatusDone}); errUpdate != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", errUpdate)
+			}
+			res, err = h.OutdialTargetJobGet(ctx, tt.job.ID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+			n, err = h.OutdialTargetJobClaim(ctx, tt.job.ID, res.TMUpdate)
+			if err != nil || n != 0 {
+				t.Errorf("Wrong match. expect: 0, got: %d, err: %v", n, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package dbhandler
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/gofrs/uuid"
+
+	commondatabasehandler "monorepo/bin-common-handler/pkg/databasehandler"
+	"monorepo/bin-outdial-manager/models/suppression"
+)
+
+const (
+	suppressionsTable = "outdial_suppressions"
+)
+
+// suppressionGetFromRow gets the suppression from the row.
+func (h *handler) suppressionGetFromRow(row *sql.Rows) (*suppression.Suppression, error) {
+	res := &suppression.Suppression{}
+
+	if err := commondatabasehandler.ScanRow(row, res); err != nil {
+		return nil, fmt.Errorf("could not scan the row. suppressionGetFromRow. err: %v", err)
+	}
+
+	return res, nil
+}
+
+// SuppressionCreate insert a new suppression record
+func (h *handler) SuppressionCreate(ctx context.Context, s *suppression.Suppression) error {
+	fields, err := commondatabasehandler.PrepareFields(s)
+	if err != nil {
+		return fmt.Errorf("could not prepare fields. SuppressionCreate. err: %v", err)
+	}
+
+	sb := squirrel.
+		Insert(suppressionsTable).
+		SetMap(fields).
+		PlaceholderFormat(squirrel.Question)
+
+	query, args, err := sb.ToSql()
+	if err != nil {
+		return fmt.Errorf("could not build query. SuppressionCreate. err: %v", err)
+	}
+
+	if _, err := h.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("could not execute query. SuppressionCreate. err: %v", err)
+	}
+
+	return nil
+}
+
+// SuppressionGet returns suppression.
+func (h *handler) SuppressionGet(ctx context.Context, id uuid.UUID) (*suppression.Suppression, error) {
+	fields := commondatabasehandler.GetDBFields(&suppression.Suppression{})
+	query, args, err := squirrel.
+		Select(fields...).
+		From(suppressionsTable).
+		Where(squirrel.Eq{string(suppression.FieldID): id.Bytes()}).
+		PlaceholderFormat(squirrel.Question).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("could not build sql. SuppressionGet. err: %v", err)
+	}
+
+	row, err := h.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("could not query. SuppressionGet. err: %v", err)
+	}
+	defer func() {
+		_ = row.Close()
+	}()
+
+	if !row.Next() {
+		if err := row.Err(); err != nil {
+			return nil, fmt.Errorf("row iteration error. SuppressionGet. err: %v", err)
+		}
+		return nil, ErrNotFound
+	}
+
+	res, err := h.suppressionGetFromRow(row)
+	if err != nil {
+		return nil, fmt.Errorf("could not get data from row. SuppressionGet. id: %s, err: %v", id, err)
+	}
+
+	return res, nil
+}
+
+// SuppressionDelete deletes the suppression.
+func (h *handler) SuppressionDelete(ctx context.Context, id uuid.UUID) error {
+	ts := h.utilHandler.TimeNow()
+
+	fields := map[suppression.Field]any{
+		suppression.FieldTMUpdate: ts,
+		suppression.FieldTMDelete: ts,
+	}
+
+	tmpFields, err := commondatabasehandler.PrepareFields(fields)
+	if err != nil {
+		return fmt.Errorf("SuppressionDelete: prepare fields failed: %w", err)
+	}
+
+	sb := squirrel.Update(suppressionsTable).
+		SetMap(tmpFields).
+		Where(squirrel.Eq{string(suppression.FieldID): id.Bytes()}).
+		PlaceholderFormat(squirrel.Question)
+
+	sqlStr, args, err := sb.ToSql()
+	if err != nil {
+		return fmt.Errorf("SuppressionDelete: build SQL failed: %w", err)
+	}
+
+	if _, err := h.db.ExecContext(ctx, sqlStr, args...); err != nil {
+		return fmt.Errorf("could not execute. SuppressionDelete. err: %v", err)
+	}
+
+	return nil
+}
+
+// SuppressionList returns list of suppressions.
+func (h *handler) SuppressionList(ctx context.Context, token string, size uint64, filters map[suppression.Field]any) ([]*suppression.Suppression, error) {
+	if token == "" {
+		token = h.utilHandler.TimeGetCurTime()
+	}
+
+	fields := commondatabasehandler.GetDBFields(&suppression.Suppression{})
+	sb := squirrel.
+		Select(fields...).
+		From(suppressionsTable).
+		Where(squirrel.Lt{string(suppression.FieldTMCreate): token}).
+		OrderBy(string(suppression.FieldTMCreate) + " DESC").
+		Limit(size).
+		PlaceholderFormat(squirrel.Question)
+
+	sb, err := commondatabasehandler.ApplyFields(sb, filters)
+	if err != nil {
+		return nil, fmt.Errorf("could not apply filters. SuppressionList. err: %v", err)
+	}
+
+	query, args, err := sb.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("could not build query. SuppressionList. err: %v", err)
+	}
+
+	rows, err := h.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("could not query. SuppressionList. err: %v", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	res := []*suppression.Suppression{}
+	for rows.Next() {
+		u, err := h.suppressionGetFromRow(rows)
+		if err != nil {
+			return nil, fmt.Errorf("could not get data. SuppressionList, err: %v", err)
+		}
+		res = append(res, u)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error. SuppressionList. err: %v", err)
+	}
+
+	return res, nil
+}
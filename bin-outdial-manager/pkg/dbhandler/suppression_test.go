@@ -0,0 +1,215 @@
+package dbhandler
+
+import (
+	"context"
+	reflect "reflect"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+	"monorepo/bin-outdial-manager/models/suppression"
+	"monorepo/bin-outdial-manager/pkg/cachehandler"
+)
+
+func Test_SuppressionCreate(t *testing.T) {
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockCache := cachehandler.NewMockCacheHandler(mc)
+	h := handler{
+		db:          dbTest,
+		cache:       mockCache,
+		utilHandler: utilhandler.NewUtilHandler(),
+	}
+
+	curTime := func() *time.Time { t := time.Date(2020, 4, 18, 3, 22, 17, 995000000, time.UTC); return &t }()
+
+	tests := []struct {
+		name        string
+		suppression *suppression.Suppression
+		expectRes   *suppression.Suppression
+	}{
+		{
+			"normal",
+			&suppression.Suppression{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("8d0f3e6a-2b6e-11f1-b1a4-6f2e3d4c5b01"),
+					CustomerID: uuid.FromStringOrNil("8d3b7c2e-2b6e-11f1-9d8e-1a2b3c4d5e02"),
+				},
+				ListType: suppression.ListTypeDNC,
+				Target:   "+821100000001",
+				Detail:   "test detail",
+				TMCreate: curTime,
+			},
+			&suppression.Suppression{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("8d0f3e6a-2b6e-11f1-b1a4-6f2e3d4c5b01"),
+					CustomerID: uuid.FromStringOrNil("8d3b7c2e-2b6e-11f1-9d8e-1a2b3c4d5e02"),
+				},
+				ListType: suppression.ListTypeDNC,
+				Target:   "+821100000001",
+				Detail:   "test detail",
+				TMCreate: curTime,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			if err := h.SuppressionCreate(ctx, tt.suppression); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			res, err := h.SuppressionGet(ctx, tt.suppression.ID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			tt.expectRes.TMCreate = res.TMCreate
+			if reflect.DeepEqual(tt.expectRes, res) == false {
+				t.Errorf("Wrong match. expect: %v, got: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_SuppressionList(t *testing.T) {
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockCache := cachehandler.NewMockCacheHandler(mc)
+	h := handler{
+		db:          dbTest,
+		cache:       mockCache,
+		utilHandler: utilhandler.NewUtilHandler(),
+	}
+
+	curTime := func() *time.Time { t := time.Date(2020, 4, 18, 3, 22, 17, 995000000, time.UTC); return &t }()
+
+	tests := []struct {
+		name         string
+		suppressions []suppression.Suppression
+
+		filters map[suppression.Field]any
+
+		expectRes []uuid.UUID
+	}{
+		{
+			"filter by the target",
+			[]suppression.Suppression{
+				{
+					Identity: commonidentity.Identity{
+						ID:         uuid.FromStringOrNil("a3c1d6f8-2b6e-11f1-8e5b-4c3d2e1f0a01"),
+						CustomerID: uuid.FromStringOrNil("a3ef2b4c-2b6e-11f1-a7c6-5d4e3f2a1b02"),
+					},
+					ListType: suppression.ListTypeDNC,
+					Target:   "+821100000001",
+					TMCreate: curTime,
+				},
+				{
+					Identity: commonidentity.Identity{
+						ID:         uuid.FromStringOrNil("a41c7e90-2b6e-11f1-b3f2-6e5f4a3b2c03"),
+						CustomerID: uuid.FromStringOrNil("a3ef2b4c-2b6e-11f1-a7c6-5d4e3f2a1b02"),
+					},
+					ListType: suppression.ListTypeOptOut,
+					Target:   "+821100000002",
+					TMCreate: curTime,
+				},
+			},
+
+			map[suppression.Field]any{
+				suppression.FieldCustomerID: uuid.FromStringOrNil("a3ef2b4c-2b6e-11f1-a7c6-5d4e3f2a1b02"),
+				suppression.FieldTarget:     "+821100000002",
+				suppression.FieldDeleted:    false,
+			},
+
+			[]uuid.UUID{
+				uuid.FromStringOrNil("a41c7e90-2b6e-11f1-b3f2-6e5f4a3b2c03"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			for _, s := range tt.suppressions {
+				if err := h.SuppressionCreate(ctx, &s); err != nil {
+					t.Errorf("Wrong match. expect: ok, got: %v", err)
+				}
+			}
+
+			res, err := h.SuppressionList(ctx, GetCurTime(), 10, tt.filters)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			ids := []uuid.UUID{}
+			for _, s := range res {
+				ids = append(ids, s.ID)
+			}
+
+			if reflect.DeepEqual(tt.expectRes, ids) == false {
+				t.Errorf("Wrong match. expect: %v, got: %v", tt.expectRes, ids)
+			}
+		})
+	}
+}
+
+func Test_SuppressionDelete(t *testing.T) {
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockCache := cachehandler.NewMockCacheHandler(mc)
+	h := handler{
+		db:          dbTest,
+		cache:       mockCache,
+		utilHandler: utilhandler.NewUtilHandler(),
+	}
+
+	tests := []struct {
+		name        string
+		suppression *suppression.Suppression
+	}{
+		{
+			"normal",
+			&suppression.Suppression{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("c2a7e3b0-2b6e-11f1-9f4a-7a6b5c4d3e01"),
+					CustomerID: uuid.FromStringOrNil("c2d4f1a2-2b6e-11f1-8b3c-8b7c6d5e4f02"),
+				},
+				ListType: suppression.ListTypeLitigator,
+				Target:   "+821100000003",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			if err := h.SuppressionCreate(ctx, tt.suppression); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if err := h.SuppressionDelete(ctx, tt.suppression.ID); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			res, err := h.SuppressionGet(ctx, tt.suppression.ID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if res.TMDelete == nil {
+				t.Errorf("Wrong match. expect: deleted, got: %v", res)
+			}
+		})
+	}
+}
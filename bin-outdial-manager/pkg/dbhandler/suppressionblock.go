@@ -0,0 +1,136 @@
+package dbhandler
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/gofrs/uuid"
+
+	commondatabasehandler "monorepo/bin-common-handler/pkg/databasehandler"
+	"monorepo/bin-outdial-manager/models/suppressionblock"
+)
+
+const (
+	suppressionblocksTable = "outdial_suppressionblocks"
+)
+
+// suppressionBlockGetFromRow gets the suppressionblock from the row.
+func (h *handler) suppressionBlockGetFromRow(row *sql.Rows) (*suppressionblock.SuppressionBlock, error) {
+	res := &suppressionblock.SuppressionBlock{}
+
+	if err := commondatabasehandler.ScanRow(row, res); err != nil {
+		return nil, fmt.Errorf("could not scan the row. suppressionBlockGetFromRow. err: %v", err)
+	}
+
+	return res, nil
+}
+
+// SuppressionBlockCreate insert a new suppressionblock record
+func (h *handler) SuppressionBlockCreate(ctx context.Context, b *suppressionblock.SuppressionBlock) error {
+	fields, err := commondatabasehandler.PrepareFields(b)
+	if err != nil {
+		return fmt.Errorf("could not prepare fields. SuppressionBlockCreate. err: %v", err)
+	}
+
+	sb := squirrel.
+		Insert(suppressionblocksTable).
+		SetMap(fields).
+		PlaceholderFormat(squirrel.Question)
+
+	query, args, err := sb.ToSql()
+	if err != nil {
+		return fmt.Errorf("could not build query. SuppressionBlockCreate. err: %v", err)
+	}
+
+	if _, err := h.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("could not execute query. SuppressionBlockCreate. err: %v", err)
+	}
+
+	return nil
+}
+
+// SuppressionBlockGet returns suppressionblock.
+func (h *handler) SuppressionBlockGet(ctx context.Context, id uuid.UUID) (*suppressionblock.SuppressionBlock, error) {
+	fields := commondatabasehandler.GetDBFields(&suppressionblock.SuppressionBlock{})
+	query, args, err := squirrel.
+		Select(fields...).
+		From(suppressionblocksTable).
+		Where(squirrel.Eq{string(suppressionblock.FieldID): id.Bytes()}).
+		PlaceholderFormat(squirrel.Question).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("could not build sql. SuppressionBlockGet. err: %v", err)
+	}
+
+	row, err := h.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("could not query. SuppressionBlockGet. err: %v", err)
+	}
+	defer func() {
+		_ = row.Close()
+	}()
+
+	if !row.Next() {
+		if err := row.Err(); err != nil {
+			return nil, fmt.Errorf("row iteration error. SuppressionBlockGet. err: %v", err)
+		}
+		return nil, ErrNotFound
+	}
+
+	res, err := h.suppressionBlockGetFromRow(row)
+	if err != nil {
+		return nil, fmt.Errorf("could not get data from row. SuppressionBlockGet. id: %s, err: %v", id, err)
+	}
+
+	return res, nil
+}
+
+// SuppressionBlockList returns list of suppressionblocks.
+func (h *handler) SuppressionBlockList(ctx context.Context, token string, size uint64, filters map[suppressionblock.Field]any) ([]*suppressionblock.SuppressionBlock, error) {
+	if token == "" {
+		token = h.utilHandler.TimeGetCurTime()
+	}
+
+	fields := commondatabasehandler.GetDBFields(&suppressionblock.SuppressionBlock{})
+	sb := squirrel.
+		Select(fields...).
+		From(suppressionblocksTable).
+		Where(squirrel.Lt{string(suppressionblock.FieldTMCreate): token}).
+		OrderBy(string(suppressionblock.FieldTMCreate) + " DESC").
+		Limit(size).
+		PlaceholderFormat(squirrel.Question)
+
+	sb, err := commondatabasehandler.ApplyFields(sb, filters)
+	if err != nil {
+		return nil, fmt.Errorf("could not apply filters. SuppressionBlockList. err: %v", err)
+	}
+
+	query, args, err := sb.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("could not build query. SuppressionBlockList. err: %v", err)
+	}
+
+	rows, err := h.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("could not query. SuppressionBlockList. err: %v", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	res := []*suppressionblock.SuppressionBlock{}
+	for rows.Next() {
+		u, err := h.suppressionBlockGetFromRow(rows)
+		if err != nil {
+			return nil, fmt.Errorf("could not get data. SuppressionBlockList, err: %v", err)
+		}
+		res = append(res, u)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error. SuppressionBlockList. err: %v", err)
+	}
+
+	return res, nil
+}
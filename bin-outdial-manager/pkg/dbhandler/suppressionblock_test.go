@@ -0,0 +1,105 @@
+package dbhandler
+
+import (
+	"context"
+	reflect "reflect"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+	"monorepo/bin-outdial-manager/models/suppression"
+	"monorepo/bin-outdial-manager/models/suppressionblock"
+	"monorepo/bin-outdial-manager/pkg/cachehandler"
+)
+
+func Test_SuppressionBlockCreate(t *testing.T) {
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockCache := cachehandler.NewMockCacheHandler(mc)
+	h := handler{
+		db:          dbTest,
+		cache:       mockCache,
+		utilHandler: utilhandler.NewUtilHandler(),
+	}
+
+	curTime := func() *time.Time { t := time.Date(2020, 4, 18, 3, 22, 17, 995000000, time.UTC); return &t }()
+
+	tests := []struct {
+		name  string
+		block *suppressionblock.SuppressionBlock
+
+		expectRes []*suppressionblock.SuppressionBlock
+	}{
+		{
+			"normal",
+			&suppressionblock.SuppressionBlock{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("e1b3c5d7-2b6e-11f1-a9b8-9c8d7e6f5a01"),
+					CustomerID: uuid.FromStringOrNil("e1e0a2c4-2b6e-11f1-b7c6-0d9e8f7a6b02"),
+				},
+				SuppressionID: uuid.FromStringOrNil("e20d8f1a-2b6e-11f1-8d5e-1e0f9a8b7c03"),
+				ListType:      suppression.ListTypeDNC,
+				Target:        "+821100000001",
+				ReferenceType: suppressionblock.ReferenceTypeCampaign,
+				ReferenceID:   uuid.FromStringOrNil("e23a6c8e-2b6e-11f1-9e4f-2f1a0b9c8d04"),
+				Reason:        "The destination is in the dnc list.",
+				TMCreate:      curTime,
+			},
+
+			[]*suppressionblock.SuppressionBlock{
+				{
+					Identity: commonidentity.Identity{
+						ID:         uuid.FromStringOrNil("e1b3c5d7-2b6e-11f1-a9b8-9c8d7e6f5a01"),
+						CustomerID: uuid.FromStringOrNil("e1e0a2c4-2b6e-11f1-b7c6-0d9e8f7a6b02"),
+					},
+					SuppressionID: uuid.FromStringOrNil("e20d8f1a-2b6e-11f1-8d5e-1e0f9a8b7c03"),
+					ListType:      suppression.ListTypeDNC,
+					Target:        "+821100000001",
+					ReferenceType: suppressionblock.ReferenceTypeCampaign,
+					ReferenceID:   uuid.FromStringOrNil("e23a6c8e-2b6e-11f1-9e4f-2f1a0b9c8d04"),
+					Reason:        "The destination is in the dnc list.",
+					TMCreate:      curTime,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			if err := h.SuppressionBlockCreate(ctx, tt.block); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			tmp, err := h.SuppressionBlockGet(ctx, tt.block.ID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+			if tmp.ID != tt.block.ID {
+				t.Errorf("Wrong match. expect: %s, got: %s", tt.block.ID, tmp.ID)
+			}
+
+			filters := map[suppressionblock.Field]any{
+				suppressionblock.FieldCustomerID: tt.block.CustomerID,
+				suppressionblock.FieldDeleted:    false,
+			}
+			res, err := h.SuppressionBlockList(ctx, GetCurTime(), 10, filters)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			for i, b := range res {
+				tt.expectRes[i].TMCreate = b.TMCreate
+			}
+			if reflect.DeepEqual(tt.expectRes, res) == false {
+				t.Errorf("Wrong match. expect: %v, got: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
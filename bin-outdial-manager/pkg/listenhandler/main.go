@@ -11,6 +11,7 @@ import (
 	cerrors "monorepo/bin-common-handler/models/errors"
 	"monorepo/bin-common-handler/models/sock"
 	"monorepo/bin-common-handler/pkg/sockhandler"
+	"monorepo/bin-common-handler/pkg/tracehandler"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
@@ -18,6 +19,8 @@ import (
 	"monorepo/bin-outdial-manager/pkg/dbhandler"
 	"monorepo/bin-outdial-manager/pkg/outdialhandler"
 	"monorepo/bin-outdial-manager/pkg/outdialtargethandler"
+	"monorepo/bin-outdial-manager/pkg/outdialtargetjobhandler"
+	"monorepo/bin-outdial-manager/pkg/suppressionhandler"
 )
 
 // pagination parameters
@@ -34,8 +37,10 @@ type ListenHandler interface {
 type listenHandler struct {
 	sockHandler sockhandler.SockHandler
 
-	outdialHandler       outdialhandler.OutdialHandler
-	outdialTargetHandler outdialtargethandler.OutdialTargetHandler
+	outdialHandler          outdialhandler.OutdialHandler
+	outdialTargetHandler    outdialtargethandler.OutdialTargetHandler
+	outdialTargetJobHandler outdialtargetjobhandler.OutdialTargetJobHandler
+	suppressionHandler      suppressionhandler.SuppressionHandler
 }
 
 var (
@@ -55,6 +60,21 @@ var (
 	regV1OutdialtargetsID            = regexp.MustCompile("/v1/outdialtargets/" + regUUID + "$")
 	regV1OutdialtargetsIDProgressing = regexp.MustCompile("/v1/outdialtargets/" + regUUID + "/progressing$")
 	regV1OutdialtargetsIDStatus      = regexp.MustCompile("/v1/outdialtargets/" + regUUID + "/status$")
+	regV1OutdialtargetsIDCallback    = regexp.MustCompile("/v1/outdialtargets/" + regUUID + "/callback$")
+
+	// outdialtargetjobs
+	regV1Outdialtargetjobs    = regexp.MustCompile("/v1/outdialtargetjobs$")
+	regV1OutdialtargetjobsGet = regexp.MustCompile(`/v1/outdialtargetjobs(\?.*)?$`)
+	regV1OutdialtargetjobsID  = regexp.MustCompile("/v1/outdialtargetjobs/" + regUUID + "$")
+
+	// suppressions
+	regV1Suppressions             = regexp.MustCompile("/v1/suppressions$")
+	regV1SuppressionsGet          = regexp.MustCompile(`/v1/suppressions(\?.*)?$`)
+	regV1SuppressionsImport       = regexp.MustCompile("/v1/suppressions/import$")
+	regV1SuppressionsIsSuppressed = regexp.MustCompile("/v1/suppressions/is_suppressed$")
+	regV1SuppressionsID           = regexp.MustCompile("/v1/suppressions/" + regUUID + "$")
+	regV1SuppressionblocksGet     = regexp.MustCompile(`/v1/suppressionblocks(\?.*)?$`)
+	regV1SuppressionblocksID      = regexp.MustCompile("/v1/suppressionblocks/" + regUUID + "$")
 )
 
 var (
@@ -117,12 +137,16 @@ func NewListenHandler(
 	sockHandler sockhandler.SockHandler,
 	outdialHandler outdialhandler.OutdialHandler,
 	outdialTargetHandler outdialtargethandler.OutdialTargetHandler,
+	outdialTargetJobHandler outdialtargetjobhandler.OutdialTargetJobHandler,
+	suppressionHandler suppressionhandler.SuppressionHandler,
 ) ListenHandler {
 	h := &listenHandler{
 		sockHandler: sockHandler,
 
-		outdialHandler:       outdialHandler,
-		outdialTargetHandler: outdialTargetHandler,
+		outdialHandler:          outdialHandler,
+		outdialTargetHandler:    outdialTargetHandler,
+		outdialTargetJobHandler: outdialTargetJobHandler,
+		suppressionHandler:      suppressionHandler,
 	}
 
 	return h
@@ -152,7 +176,8 @@ func (h *listenHandler) processRequest(m *sock.Request) (*sock.Response, error)
 	var err error
 	var response *sock.Response
 
-	ctx := context.Background()
+	ctx, span := tracehandler.StartServerSpan(context.Background(), m)
+	defer span.End()
 
 	logrus.WithFields(
 		logrus.Fields{
@@ -230,6 +255,63 @@ func (h *listenHandler) processRequest(m *sock.Request) (*sock.Response, error)
 		requestType = "/outdialtargets/<outdialtarget-id>/status"
 		response, err = h.v1OutdialtargetsIDStatusPut(ctx, m)
 
+	// /v1/outdialtargets/<outdialtarget-id>/callback
+	case regV1OutdialtargetsIDCallback.MatchString(m.URI) && m.Method == sock.RequestMethodPut:
+		requestType = "/outdialtargets/<outdialtarget-id>/callback"
+		response, err = h.v1OutdialtargetsIDCallbackPut(ctx, m)
+
+	// outdialtargetjobs
+	case regV1Outdialtargetjobs.MatchString(m.URI) && m.Method == sock.RequestMethodPost:
+		requestType = "/outdialtargetjobs"
+		response, err = h.v1OutdialtargetjobsPost(ctx, m)
+
+	case regV1OutdialtargetjobsGet.MatchString(m.URI) && m.Method == sock.RequestMethodGet:
+		requestType = "/outdialtargetjobs"
+		response, err = h.v1OutdialtargetjobsGet(ctx, m)
+
+	// /v1/outdialtargetjobs/<outdialtargetjob-id>
+	case regV1OutdialtargetjobsID.MatchString(m.URI) && m.Method == sock.RequestMethodGet:
+		requestType = "/outdialtargetjobs/<outdialtargetjob-id>"
+		response, err = h.v1OutdialtargetjobsIDGet(ctx, m)
+
+	// suppressions
+	case regV1Suppressions.MatchString(m.URI) && m.Method == sock.RequestMethodPost:
+		requestType = "/suppressions"
+		response, err = h.v1SuppressionsPost(ctx, m)
+
+	case regV1SuppressionsGet.MatchString(m.URI) && m.Method == sock.RequestMethodGet:
+		requestType = "/suppressions"
+		response, err = h.v1SuppressionsGet(ctx, m)
+
+	// /v1/suppressions/import
+	case regV1SuppressionsImport.MatchString(m.URI) && m.Method == sock.RequestMethodPost:
+		requestType = "/suppressions/import"
+		response, err = h.v1SuppressionsImportPost(ctx, m)
+
+	// /v1/suppressions/is_suppressed
+	case regV1SuppressionsIsSuppressed.MatchString(m.URI) && m.Method == sock.RequestMethodPost:
+		requestType = "/suppressions/is_suppressed"
+		response, err = h.v1SuppressionsIsSuppressedPost(ctx, m)
+
+	// /v1/suppressions/<suppression-id>
+	case regV1SuppressionsID.MatchString(m.URI) && m.Method == sock.RequestMethodGet:
+		requestType = "/suppressions/<suppression-id>"
+		response, err = h.v1SuppressionsIDGet(ctx, m)
+
+	case regV1SuppressionsID.MatchString(m.URI) && m.Method == sock.RequestMethodDelete:
+		requestType = "/suppressions/<suppression-id>"
+		response, err = h.v1SuppressionsIDDelete(ctx, m)
+
+	// suppressionblocks
+	case regV1SuppressionblocksGet.MatchString(m.URI) && m.Method == sock.RequestMethodGet:
+		requestType = "/suppressionblocks"
+		response, err = h.v1SuppressionblocksGet(ctx, m)
+
+	// /v1/suppressionblocks/<suppressionblock-id>
+	case regV1SuppressionblocksID.MatchString(m.URI) && m.Method == sock.RequestMethodGet:
+		requestType = "/suppressionblocks/<suppressionblock-id>"
+		response, err = h.v1SuppressionblocksIDGet(ctx, m)
+
 	default:
 		logrus.WithFields(
 			logrus.Fields{
@@ -44,9 +44,10 @@ type V1DataOutdialsIDCampaignIDPut struct {
 // v1 data type request struct for
 // /v1/outdials/<outdial-id>/targets POST
 type V1DataOutdialsIDTargetsPost struct {
-	Name   string `json:"name"`   // name
-	Detail string `json:"detail"` // detail
-	Data   string `json:"data"`
+	Name     string `json:"name"`   // name
+	Detail   string `json:"detail"` // detail
+	Data     string `json:"data"`
+	Timezone string `json:"timezone,omitempty"` // IANA time zone of the callee
 
 	Destination0 *commonaddress.Address `json:"destination_0,omitempty"`
 	Destination1 *commonaddress.Address `json:"destination_1,omitempty"`
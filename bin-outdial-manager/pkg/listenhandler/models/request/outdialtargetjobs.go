@@ -0,0 +1,17 @@
+package request
+
+import (
+	"github.com/gofrs/uuid"
+
+	"monorepo/bin-outdial-manager/models/outdialtargetjob"
+)
+
+// V1DataOutdialtargetjobsPost is
+// v1 data type request struct for
+// /v1/outdialtargetjobs POST
+type V1DataOutdialtargetjobsPost struct {
+	OutdialID uuid.UUID                 `json:"outdial_id"`
+	Type      outdialtargetjob.Type     `json:"type"`
+	FileID    uuid.UUID                 `json:"file_id"`           // import only
+	Mapping   *outdialtargetjob.Mapping `json:"mapping,omitempty"` // import only
+}
@@ -1,6 +1,8 @@
 package request
 
 import (
+	"time"
+
 	commonaddress "monorepo/bin-common-handler/models/address"
 
 	"github.com/gofrs/uuid"
@@ -38,3 +40,11 @@ type V1DataOutdialtargetsIDProgressingPost struct {
 type V1DataOutdialtargetsIDStatusPut struct {
 	Status outdialtarget.Status `json:"status"`
 }
+
+// V1DataOutdialtargetsIDCallbackPut is
+// v1 data type request struct for
+// /v1/outdialtargets/<outdialtarget-id>/callback PUT
+type V1DataOutdialtargetsIDCallbackPut struct {
+	TMCallback *time.Time `json:"tm_callback"` // nil cancels the callback
+	AgentID    uuid.UUID  `json:"agent_id"`    // the agent who handles the callback. empty means any agent
+}
@@ -0,0 +1,38 @@
+package request
+
+import (
+	"github.com/gofrs/uuid"
+
+	"monorepo/bin-outdial-manager/models/suppression"
+	"monorepo/bin-outdial-manager/models/suppressionblock"
+)
+
+// V1DataSuppressionsPost is
+// v1 data type request struct for
+// /v1/suppressions POST
+type V1DataSuppressionsPost struct {
+	CustomerID uuid.UUID            `json:"customer_id"`
+	ListType   suppression.ListType `json:"list_type"`
+	Target     string               `json:"target"`
+	Detail     string               `json:"detail"`
+}
+
+// V1DataSuppressionsImportPost is
+// v1 data type request struct for
+// /v1/suppressions/import POST
+type V1DataSuppressionsImportPost struct {
+	CustomerID uuid.UUID            `json:"customer_id"`
+	ListType   suppression.ListType `json:"list_type"`
+	Targets    []string             `json:"targets"`
+	Detail     string               `json:"detail"`
+}
+
+// V1DataSuppressionsIsSuppressedPost is
+// v1 data type request struct for
+// /v1/suppressions/is_suppressed POST
+type V1DataSuppressionsIsSuppressedPost struct {
+	CustomerID    uuid.UUID                      `json:"customer_id"`
+	Target        string                         `json:"target"`
+	ReferenceType suppressionblock.ReferenceType `json:"reference_type"`
+	ReferenceID   uuid.UUID                      `json:"reference_id"`
+}
@@ -0,0 +1,16 @@
+package response
+
+// V1ResponseSuppressionsImport is
+// v1 response type for
+// /v1/suppressions/import POST
+type V1ResponseSuppressionsImport struct {
+	Imported int `json:"imported"`
+	Skipped  int `json:"skipped"`
+}
+
+// V1ResponseSuppressionsIsSuppressed is
+// v1 response type for
+// /v1/suppressions/is_suppressed POST
+type V1ResponseSuppressionsIsSuppressed struct {
+	Suppressed bool `json:"suppressed"`
+}
@@ -455,6 +455,7 @@ func (h *listenHandler) v1OutdialsIDTargetsPost(ctx context.Context, m *sock.Req
 		req.Name,
 		req.Detail,
 		req.Data,
+		req.Timezone,
 		req.Destination0,
 		req.Destination1,
 		req.Destination2,
@@ -388,7 +388,7 @@ func Test_v1OutdialsIDAvailableGet(t *testing.T) {
 			&sock.Response{
 				StatusCode: 200,
 				DataType:   "application/json",
-				Data:       []byte(`[{"id":"96abf56c-b36e-11ec-a539-d76994cf6863","outdial_id":"00000000-0000-0000-0000-000000000000","name":"","detail":"","data":"","status":"","destination_0":null,"destination_1":null,"destination_2":null,"destination_3":null,"destination_4":null,"try_count_0":0,"try_count_1":0,"try_count_2":0,"try_count_3":0,"try_count_4":0,"tm_create":null,"tm_update":null,"tm_delete":null}]`),
+				Data:       []byte(`[{"id":"96abf56c-b36e-11ec-a539-d76994cf6863","outdial_id":"00000000-0000-0000-0000-000000000000","name":"","detail":"","data":"","status":"","timezone":"","destination_0":null,"destination_1":null,"destination_2":null,"destination_3":null,"destination_4":null,"try_count_0":0,"try_count_1":0,"try_count_2":0,"try_count_3":0,"try_count_4":0,"callback_agent_id":"00000000-0000-0000-0000-000000000000","tm_callback":null,"tm_create":null,"tm_update":null,"tm_delete":null}]`),
 			},
 		},
 	}
@@ -431,6 +431,7 @@ func Test_v1OutdialsIDTargetsPost(t *testing.T) {
 		targetName   string
 		detail       string
 		data         string
+		timezone     string
 		destination0 *commonaddress.Address
 		destination1 *commonaddress.Address
 		destination2 *commonaddress.Address
@@ -446,13 +447,14 @@ func Test_v1OutdialsIDTargetsPost(t *testing.T) {
 				URI:      "/v1/outdials/9995c64e-b36f-11ec-9be0-d387edb25d6b/targets",
 				Method:   sock.RequestMethodPost,
 				DataType: "application/json",
-				Data:     []byte(`{"name": "test name", "detail": "test detail", "data": "test data", "destination_0": {"type": "tel", "target": "+821100000001"}, "destination_1": {"type": "tel", "target": "+821100000002"}, "destination_2": {"type": "tel", "target": "+821100000003"}, "destination_3": {"type": "tel", "target": "+821100000004"}, "destination_4": {"type": "tel", "target": "+821100000005"}}`),
+				Data:     []byte(`{"name": "test name", "detail": "test detail", "data": "test data", "timezone": "Asia/Seoul", "destination_0": {"type": "tel", "target": "+821100000001"}, "destination_1": {"type": "tel", "target": "+821100000002"}, "destination_2": {"type": "tel", "target": "+821100000003"}, "destination_3": {"type": "tel", "target": "+821100000004"}, "destination_4": {"type": "tel", "target": "+821100000005"}}`),
 			},
 
 			uuid.FromStringOrNil("9995c64e-b36f-11ec-9be0-d387edb25d6b"),
 			"test name",
 			"test detail",
 			"test data",
+			"Asia/Seoul",
 			&commonaddress.Address{
 				Type:   commonaddress.TypeTel,
 				Target: "+821100000001",
@@ -480,7 +482,7 @@ func Test_v1OutdialsIDTargetsPost(t *testing.T) {
 			&sock.Response{
 				StatusCode: 200,
 				DataType:   "application/json",
-				Data:       []byte(`{"id":"be545d6a-b36f-11ec-8ad5-03ccb4c40eeb","outdial_id":"00000000-0000-0000-0000-000000000000","name":"","detail":"","data":"","status":"","destination_0":null,"destination_1":null,"destination_2":null,"destination_3":null,"destination_4":null,"try_count_0":0,"try_count_1":0,"try_count_2":0,"try_count_3":0,"try_count_4":0,"tm_create":null,"tm_update":null,"tm_delete":null}`),
+				Data:       []byte(`{"id":"be545d6a-b36f-11ec-8ad5-03ccb4c40eeb","outdial_id":"00000000-0000-0000-0000-000000000000","name":"","detail":"","data":"","status":"","timezone":"","destination_0":null,"destination_1":null,"destination_2":null,"destination_3":null,"destination_4":null,"try_count_0":0,"try_count_1":0,"try_count_2":0,"try_count_3":0,"try_count_4":0,"callback_agent_id":"00000000-0000-0000-0000-000000000000","tm_callback":null,"tm_create":null,"tm_update":null,"tm_delete":null}`),
 			},
 		},
 		{
@@ -496,6 +498,7 @@ func Test_v1OutdialsIDTargetsPost(t *testing.T) {
 			"test name",
 			"test detail",
 			"test data",
+			"",
 			&commonaddress.Address{
 				Type:   commonaddress.TypeTel,
 				Target: "+821100000001",
@@ -511,7 +514,7 @@ func Test_v1OutdialsIDTargetsPost(t *testing.T) {
 			&sock.Response{
 				StatusCode: 200,
 				DataType:   "application/json",
-				Data:       []byte(`{"id":"be545d6a-b36f-11ec-8ad5-03ccb4c40eeb","outdial_id":"00000000-0000-0000-0000-000000000000","name":"","detail":"","data":"","status":"","destination_0":null,"destination_1":null,"destination_2":null,"destination_3":null,"destination_4":null,"try_count_0":0,"try_count_1":0,"try_count_2":0,"try_count_3":0,"try_count_4":0,"tm_create":null,"tm_update":null,"tm_delete":null}`),
+				Data:       []byte(`{"id":"be545d6a-b36f-11ec-8ad5-03ccb4c40eeb","outdial_id":"00000000-0000-0000-0000-000000000000","name":"","detail":"","data":"","status":"","timezone":"","destination_0":null,"destination_1":null,"destination_2":null,"destination_3":null,"destination_4":null,"try_count_0":0,"try_count_1":0,"try_count_2":0,"try_count_3":0,"try_count_4":0,"callback_agent_id":"00000000-0000-0000-0000-000000000000","tm_callback":null,"tm_create":null,"tm_update":null,"tm_delete":null}`),
 			},
 		},
 	}
@@ -531,7 +534,7 @@ func Test_v1OutdialsIDTargetsPost(t *testing.T) {
 				outdialTargetHandler: mockOutdialTarget,
 			}
 
-			mockOutdialTarget.EXPECT().Create(gomock.Any(), tt.outdialID, tt.targetName, tt.detail, tt.data, tt.destination0, tt.destination1, tt.destination2, tt.destination3, tt.destination4).Return(tt.outdialtarget, nil)
+			mockOutdialTarget.EXPECT().Create(gomock.Any(), tt.outdialID, tt.targetName, tt.detail, tt.data, tt.timezone, tt.destination0, tt.destination1, tt.destination2, tt.destination3, tt.destination4).Return(tt.outdialtarget, nil)
 
 			res, err := h.processRequest(tt.request)
 			if err != nil {
@@ -577,7 +580,7 @@ func Test_v1OutdialsIDTargetsGet(t *testing.T) {
 			&sock.Response{
 				StatusCode: 200,
 				DataType:   "application/json",
-				Data:       []byte(`[{"id":"5024139c-b36c-11ec-9b26-9b18d7d76e07","outdial_id":"00000000-0000-0000-0000-000000000000","name":"","detail":"","data":"","status":"","destination_0":null,"destination_1":null,"destination_2":null,"destination_3":null,"destination_4":null,"try_count_0":0,"try_count_1":0,"try_count_2":0,"try_count_3":0,"try_count_4":0,"tm_create":null,"tm_update":null,"tm_delete":null}]`),
+				Data:       []byte(`[{"id":"5024139c-b36c-11ec-9b26-9b18d7d76e07","outdial_id":"00000000-0000-0000-0000-000000000000","name":"","detail":"","data":"","status":"","timezone":"","destination_0":null,"destination_1":null,"destination_2":null,"destination_3":null,"destination_4":null,"try_count_0":0,"try_count_1":0,"try_count_2":0,"try_count_3":0,"try_count_4":0,"callback_agent_id":"00000000-0000-0000-0000-000000000000","tm_callback":null,"tm_create":null,"tm_update":null,"tm_delete":null}]`),
 			},
 		},
 		{
@@ -602,7 +605,7 @@ func Test_v1OutdialsIDTargetsGet(t *testing.T) {
 			&sock.Response{
 				StatusCode: 200,
 				DataType:   "application/json",
-				Data:       []byte(`[{"id":"e822590a-b372-11ec-b755-239020a9003b","outdial_id":"00000000-0000-0000-0000-000000000000","name":"","detail":"","data":"","status":"","destination_0":null,"destination_1":null,"destination_2":null,"destination_3":null,"destination_4":null,"try_count_0":0,"try_count_1":0,"try_count_2":0,"try_count_3":0,"try_count_4":0,"tm_create":null,"tm_update":null,"tm_delete":null},{"id":"e84d3828-b372-11ec-9936-af8200c58c02","outdial_id":"00000000-0000-0000-0000-000000000000","name":"","detail":"","data":"","status":"","destination_0":null,"destination_1":null,"destination_2":null,"destination_3":null,"destination_4":null,"try_count_0":0,"try_count_1":0,"try_count_2":0,"try_count_3":0,"try_count_4":0,"tm_create":null,"tm_update":null,"tm_delete":null}]`),
+				Data:       []byte(`[{"id":"e822590a-b372-11ec-b755-239020a9003b","outdial_id":"00000000-0000-0000-0000-000000000000","name":"","detail":"","data":"","status":"","timezone":"","destination_0":null,"destination_1":null,"destination_2":null,"destination_3":null,"destination_4":null,"try_count_0":0,"try_count_1":0,"try_count_2":0,"try_count_3":0,"try_count_4":0,"callback_agent_id":"00000000-0000-0000-0000-000000000000","tm_callback":null,"tm_create":null,"tm_update":null,"tm_delete":null},{"id":"e84d3828-b372-11ec-9936-af8200c58c02","outdial_id":"00000000-0000-0000-0000-000000000000","name":"","detail":"","data":"","status":"","timezone":"","destination_0":null,"destination_1":null,"destination_2":null,"destination_3":null,"destination_4":null,"try_count_0":0,"try_count_1":0,"try_count_2":0,"try_count_3":0,"try_count_4":0,"callback_agent_id":"00000000-0000-0000-0000-000000000000","tm_callback":null,"tm_create":null,"tm_update":null,"tm_delete":null}]`),
 			},
 		},
 	}
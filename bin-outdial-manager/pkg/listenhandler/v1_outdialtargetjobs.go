@@ -0,0 +1,159 @@
+package listenhandler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	cerrors "monorepo/bin-common-handler/models/errors"
+	commonoutline "monorepo/bin-common-handler/models/outline"
+	"monorepo/bin-common-handler/models/sock"
+
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+
+	"monorepo/bin-outdial-manager/models/outdialtargetjob"
+	"monorepo/bin-outdial-manager/pkg/listenhandler/models/request"
+)
+
+// v1OutdialtargetjobsPost handles /v1/outdialtargetjobs POST request
+// creates a new import or export outdialtargetjob.
+func (h *listenHandler) v1OutdialtargetjobsPost(ctx context.Context, m *sock.Request) (*sock.Response, error) {
+	log := logrus.WithFields(
+		logrus.Fields{
+			"func": "v1OutdialtargetjobsPost",
+		},
+	)
+	log.WithField("request", m).Debug("Executing v1OutdialtargetjobsPost.")
+
+	var req request.V1DataOutdialtargetjobsPost
+	if err := json.Unmarshal(m.Data, &req); err != nil {
+		log.Errorf("Could not marshal the data. err: %v", err)
+		return nil, err
+	}
+
+	var tmp *outdialtargetjob.OutdialTargetJob
+	var err error
+	switch req.Type {
+	case outdialtargetjob.TypeImport:
+		tmp, err = h.outdialTargetJobHandler.Import(ctx, req.OutdialID, req.FileID, req.Mapping)
+
+	case outdialtargetjob.TypeExport:
+		tmp, err = h.outdialTargetJobHandler.Export(ctx, req.OutdialID)
+
+	default:
+		err = cerrors.InvalidArgument(commonoutline.ServiceNameOutdialManager, "INVALID_TYPE", fmt.Sprintf("Unsupported outdialtargetjob type. type: %s", req.Type))
+	}
+	if err != nil {
+		log.Errorf("Could not create outdialtargetjob. err: %v", err)
+		return nil, err
+	}
+
+	data, err := json.Marshal(tmp)
+	if err != nil {
+		log.Errorf("Could not marshal the res. err: %v", err)
+		return nil, err
+	}
+
+	res := &sock.Response{
+		StatusCode: 200,
+		DataType:   "application/json",
+		Data:       data,
+	}
+
+	return res, nil
+}
+
+// v1OutdialtargetjobsGet handles /v1/outdialtargetjobs GET request
+func (h *listenHandler) v1OutdialtargetjobsGet(ctx context.Context, req *sock.Request) (*sock.Response, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":    "v1OutdialtargetjobsGet",
+		"request": req,
+	})
+
+	u, err := url.Parse(req.URI)
+	if err != nil {
+		return nil, err
+	}
+
+	// parse the pagination params from URI
+	tmpSize, _ := strconv.Atoi(u.Query().Get(PageSize))
+	pageSize := uint64(tmpSize)
+	pageToken := u.Query().Get(PageToken)
+
+	// Parse filters from request data (body)
+	var filters map[string]any
+	if len(req.Data) > 0 {
+		if err := json.Unmarshal(req.Data, &filters); err != nil {
+			log.Errorf("Could not unmarshal filters. err: %v", err)
+			return nil, fmt.Errorf("could not unmarshal filters: %w", err)
+		}
+	}
+
+	typedFilters, err := outdialtargetjob.ConvertStringMapToFieldMap(filters)
+	if err != nil {
+		log.Errorf("Could not convert filters. err: %v", err)
+		return nil, fmt.Errorf("could not convert filters: %w", err)
+	}
+
+	tmp, err := h.outdialTargetJobHandler.List(ctx, pageToken, pageSize, typedFilters)
+	if err != nil {
+		log.Errorf("Could not get outdialtargetjobs. err: %v", err)
+		return nil, err
+	}
+
+	data, err := json.Marshal(tmp)
+	if err != nil {
+		log.Errorf("Could not marshal the res. err: %v", err)
+		return nil, err
+	}
+
+	res := &sock.Response{
+		StatusCode: 200,
+		DataType:   "application/json",
+		Data:       data,
+	}
+
+	return res, nil
+}
+
+// v1OutdialtargetjobsIDGet handles /v1/outdialtargetjobs/<outdialtargetjob-id> GET request
+func (h *listenHandler) v1OutdialtargetjobsIDGet(ctx context.Context, m *sock.Request) (*sock.Response, error) {
+	u, err := url.Parse(m.URI)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpVals := strings.Split(u.Path, "/")
+	id := uuid.FromStringOrNil(tmpVals[3])
+	log := logrus.WithFields(
+		logrus.Fields{
+			"func":                "v1OutdialtargetjobsIDGet",
+			"outdialtargetjob_id": id,
+		},
+	)
+	log.WithField("request", m).Debug("Executing v1OutdialtargetjobsIDGet.")
+
+	tmp, err := h.outdialTargetJobHandler.Get(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get outdialtargetjob. err: %v", err)
+		return nil, err
+	}
+
+	data, err := json.Marshal(tmp)
+	if err != nil {
+		log.Errorf("Could not marshal the res. err: %v", err)
+		return nil, err
+	}
+
+	res := &sock.Response{
+		StatusCode: 200,
+		DataType:   "application/json",
+		Data:       data,
+	}
+
+	return res, nil
+}
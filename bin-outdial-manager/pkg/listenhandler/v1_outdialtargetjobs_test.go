@@ -0,0 +1,259 @@
+package listenhandler
+
+import (
+	"reflect"
+	"testing"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/models/sock"
+	"monorepo/bin-common-handler/pkg/sockhandler"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+
+	"monorepo/bin-outdial-manager/models/outdialtargetjob"
+	"monorepo/bin-outdial-manager/pkg/outdialtargetjobhandler"
+)
+
+func Test_v1OutdialtargetjobsPost(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		responseJob *outdialtargetjob.OutdialTargetJob
+
+		expectType      outdialtargetjob.Type
+		expectOutdialID uuid.UUID
+		expectFileID    uuid.UUID
+		expectMapping   *outdialtargetjob.Mapping
+	}{
+		{
+			name: "import",
+			request: &sock.Request{
+				URI:      "/v1/outdialtargetjobs",
+				Method:   sock.RequestMethodPost,
+				DataType: "application/json",
+				Data:     []byte(`{"outdial_id":"2b0c1d2e-2d50-11f1-8c01-3d4e5f6a7b01","type":"import","file_id":"2b393f42-2d50-11f1-9d12-4e5f6a7b8c02","mapping":{"name":"customer","destination_0":"phone"}}`),
+			},
+
+			responseJob: &outdialtargetjob.OutdialTargetJob{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("2b666156-2d50-11f1-ae23-5f6a7b8c9d03"),
+				},
+			},
+
+			expectType:      outdialtargetjob.TypeImport,
+			expectOutdialID: uuid.FromStringOrNil("2b0c1d2e-2d50-11f1-8c01-3d4e5f6a7b01"),
+			expectFileID:    uuid.FromStringOrNil("2b393f42-2d50-11f1-9d12-4e5f6a7b8c02"),
+			expectMapping: &outdialtargetjob.Mapping{
+				Name:         "customer",
+				Destination0: "phone",
+			},
+		},
+		{
+			name: "export",
+			request: &sock.Request{
+				URI:      "/v1/outdialtargetjobs",
+				Method:   sock.RequestMethodPost,
+				DataType: "application/json",
+				Data:     []byte(`{"outdial_id":"2b0c1d2e-2d50-11f1-8c01-3d4e5f6a7b01","type":"export"}`),
+			},
+
+			responseJob: &outdialtargetjob.OutdialTargetJob{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("2b93836a-2d50-11f1-bf34-6a7b8c9d0e04"),
+				},
+			},
+
+			expectType:      outdialtargetjob.TypeExport,
+			expectOutdialID: uuid.FromStringOrNil("2b0c1d2e-2d50-11f1-8c01-3d4e5f6a7b01"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockJob := outdialtargetjobhandler.NewMockOutdialTargetJobHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:             mockSock,
+				outdialTargetJobHandler: mockJob,
+			}
+
+			if tt.expectType == outdialtargetjob.TypeImport {
+				mockJob.EXPECT().Import(gomock.Any(), tt.expectOutdialID, tt.expectFileID, tt.expectMapping).Return(tt.responseJob, nil)
+			} else {
+				mockJob.EXPECT().Export(gomock.Any(), tt.expectOutdialID).Return(tt.responseJob, nil)
+			}
+
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if res.StatusCode != 200 {
+				t.Errorf("Wrong match. expect: 200, got: %d", res.StatusCode)
+			}
+		})
+	}
+}
+
+func Test_v1OutdialtargetjobsPost_invalidType(t *testing.T) {
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockSock := sockhandler.NewMockSockHandler(mc)
+	mockJob := outdialtargetjobhandler.NewMockOutdialTargetJobHandler(mc)
+
+	h := &listenHandler{
+		sockHandler:             mockSock,
+		outdialTargetJobHandler: mockJob,
+	}
+
+	req := &sock.Request{
+		URI:      "/v1/outdialtargetjobs",
+		Method:   sock.RequestMethodPost,
+		DataType: "application/json",
+		Data:     []byte(`{"outdial_id":"2b0c1d2e-2d50-11f1-8c01-3d4e5f6a7b01","type":"merge"}`),
+	}
+
+	res, err := h.processRequest(req)
+	if err != nil {
+		t.Errorf("Wrong match. expect: ok, got: %v", err)
+	}
+
+	if res.StatusCode != 400 {
+		t.Errorf("Wrong match. expect: 400, got: %d", res.StatusCode)
+	}
+}
+
+func Test_v1OutdialtargetjobsGet(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		responseJobs []*outdialtargetjob.OutdialTargetJob
+
+		expectPageToken string
+		expectPageSize  uint64
+		expectFilters   map[outdialtargetjob.Field]any
+	}{
+		{
+			name: "normal",
+			request: &sock.Request{
+				URI:      "/v1/outdialtargetjobs?page_token=2020-10-10T03:30:17.000000Z&page_size=10",
+				Method:   sock.RequestMethodGet,
+				DataType: "application/json",
+				Data:     []byte(`{"outdial_id":"7a0b1c2d-2d50-11f1-8045-7b8c9d0e1f01","deleted":false}`),
+			},
+
+			responseJobs: []*outdialtargetjob.OutdialTargetJob{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("7a383e41-2d50-11f1-9156-8c9d0e1f2a02"),
+					},
+				},
+			},
+
+			expectPageToken: "2020-10-10T03:30:17.000000Z",
+			expectPageSize:  10,
+			expectFilters: map[outdialtargetjob.Field]any{
+				outdialtargetjob.FieldOutdialID: uuid.FromStringOrNil("7a0b1c2d-2d50-11f1-8045-7b8c9d0e1f01"),
+				outdialtargetjob.FieldDeleted:   false,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockJob := outdialtargetjobhandler.NewMockOutdialTargetJobHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:             mockSock,
+				outdialTargetJobHandler: mockJob,
+			}
+
+			mockJob.EXPECT().List(gomock.Any(), tt.expectPageToken, tt.expectPageSize, tt.expectFilters).Return(tt.responseJobs, nil)
+
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if res.StatusCode != 200 {
+				t.Errorf("Wrong match. expect: 200, got: %d", res.StatusCode)
+			}
+		})
+	}
+}
+
+func Test_v1OutdialtargetjobsIDGet(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		responseJob *outdialtargetjob.OutdialTargetJob
+
+		expectID  uuid.UUID
+		expectRes *sock.Response
+	}{
+		{
+			name: "normal",
+			request: &sock.Request{
+				URI:    "/v1/outdialtargetjobs/9c0d1e2f-2d50-11f1-a267-9d0e1f2a3b01",
+				Method: sock.RequestMethodGet,
+			},
+
+			responseJob: &outdialtargetjob.OutdialTargetJob{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("9c0d1e2f-2d50-11f1-a267-9d0e1f2a3b01"),
+				},
+				Type:   outdialtargetjob.TypeExport,
+				Status: outdialtargetjob.StatusDone,
+			},
+
+			expectID: uuid.FromStringOrNil("9c0d1e2f-2d50-11f1-a267-9d0e1f2a3b01"),
+			expectRes: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"9c0d1e2f-2d50-11f1-a267-9d0e1f2a3b01","customer_id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","type":"export","status":"done","file_id":"00000000-0000-0000-0000-000000000000","total_count":0,"processed_count":0,"success_count":0,"duplicate_count":0,"error_count":0,"error_file_id":"00000000-0000-0000-0000-000000000000","detail":"","tm_create":null,"tm_update":null,"tm_delete":null}`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockJob := outdialtargetjobhandler.NewMockOutdialTargetJobHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:             mockSock,
+				outdialTargetJobHandler: mockJob,
+			}
+
+			mockJob.EXPECT().Get(gomock.Any(), tt.expectID).Return(tt.responseJob, nil)
+
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
@@ -181,3 +181,48 @@ func (h *listenHandler) v1OutdialtargetsIDStatusPut(ctx context.Context, m *sock
 
 	return res, nil
 }
+
+// v1OutdialtargetsIDCallbackPut handles /v1/outdialtargets/<outdialtarget-id>/callback PUT request
+func (h *listenHandler) v1OutdialtargetsIDCallbackPut(ctx context.Context, m *sock.Request) (*sock.Response, error) {
+	u, err := url.Parse(m.URI)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpVals := strings.Split(u.Path, "/")
+	id := uuid.FromStringOrNil(tmpVals[3])
+
+	log := logrus.WithFields(
+		logrus.Fields{
+			"func":             "v1OutdialtargetsIDCallbackPut",
+			"outdialtarget_id": id,
+		},
+	)
+	log.WithField("request", m).Debug("Executing v1OutdialtargetsIDCallbackPut.")
+
+	var req request.V1DataOutdialtargetsIDCallbackPut
+	if err := json.Unmarshal(m.Data, &req); err != nil {
+		log.Errorf("Could not marshal the data. err: %v", err)
+		return nil, err
+	}
+
+	tmp, err := h.outdialTargetHandler.UpdateCallback(ctx, id, req.TMCallback, req.AgentID)
+	if err != nil {
+		log.Errorf("Could not update outdialtarget callback. err: %v", err)
+		return nil, err
+	}
+
+	data, err := json.Marshal(tmp)
+	if err != nil {
+		log.Errorf("Could not marshal the res. err: %v", err)
+		return nil, err
+	}
+
+	res := &sock.Response{
+		StatusCode: 200,
+		DataType:   "application/json",
+		Data:       data,
+	}
+
+	return res, nil
+}
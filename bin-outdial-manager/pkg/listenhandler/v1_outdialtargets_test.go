@@ -3,6 +3,7 @@ package listenhandler
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"monorepo/bin-common-handler/models/sock"
 	"monorepo/bin-common-handler/pkg/sockhandler"
@@ -41,7 +42,7 @@ func Test_v1OutdialtargetsIDGet(t *testing.T) {
 			&sock.Response{
 				StatusCode: 200,
 				DataType:   "application/json",
-				Data:       []byte(`{"id":"50d5c500-c51a-11ec-9c67-eb2ec9b83a3b","outdial_id":"00000000-0000-0000-0000-000000000000","name":"","detail":"","data":"","status":"","destination_0":null,"destination_1":null,"destination_2":null,"destination_3":null,"destination_4":null,"try_count_0":0,"try_count_1":0,"try_count_2":0,"try_count_3":0,"try_count_4":0,"tm_create":null,"tm_update":null,"tm_delete":null}`),
+				Data:       []byte(`{"id":"50d5c500-c51a-11ec-9c67-eb2ec9b83a3b","outdial_id":"00000000-0000-0000-0000-000000000000","name":"","detail":"","data":"","status":"","timezone":"","destination_0":null,"destination_1":null,"destination_2":null,"destination_3":null,"destination_4":null,"try_count_0":0,"try_count_1":0,"try_count_2":0,"try_count_3":0,"try_count_4":0,"callback_agent_id":"00000000-0000-0000-0000-000000000000","tm_callback":null,"tm_create":null,"tm_update":null,"tm_delete":null}`),
 			},
 		},
 	}
@@ -96,7 +97,7 @@ func Test_v1OutdialtargetsIDDelete(t *testing.T) {
 			&sock.Response{
 				StatusCode: 200,
 				DataType:   "application/json",
-				Data:       []byte(`{"id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","name":"","detail":"","data":"","status":"","destination_0":null,"destination_1":null,"destination_2":null,"destination_3":null,"destination_4":null,"try_count_0":0,"try_count_1":0,"try_count_2":0,"try_count_3":0,"try_count_4":0,"tm_create":null,"tm_update":null,"tm_delete":null}`),
+				Data:       []byte(`{"id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","name":"","detail":"","data":"","status":"","timezone":"","destination_0":null,"destination_1":null,"destination_2":null,"destination_3":null,"destination_4":null,"try_count_0":0,"try_count_1":0,"try_count_2":0,"try_count_3":0,"try_count_4":0,"callback_agent_id":"00000000-0000-0000-0000-000000000000","tm_callback":null,"tm_create":null,"tm_update":null,"tm_delete":null}`),
 			},
 		},
 	}
@@ -154,7 +155,7 @@ func Test_v1OutdialtargetsIDProgressingPost(t *testing.T) {
 			&sock.Response{
 				StatusCode: 200,
 				DataType:   "application/json",
-				Data:       []byte(`{"id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","name":"","detail":"","data":"","status":"","destination_0":null,"destination_1":null,"destination_2":null,"destination_3":null,"destination_4":null,"try_count_0":0,"try_count_1":0,"try_count_2":0,"try_count_3":0,"try_count_4":0,"tm_create":null,"tm_update":null,"tm_delete":null}`),
+				Data:       []byte(`{"id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","name":"","detail":"","data":"","status":"","timezone":"","destination_0":null,"destination_1":null,"destination_2":null,"destination_3":null,"destination_4":null,"try_count_0":0,"try_count_1":0,"try_count_2":0,"try_count_3":0,"try_count_4":0,"callback_agent_id":"00000000-0000-0000-0000-000000000000","tm_callback":null,"tm_create":null,"tm_update":null,"tm_delete":null}`),
 			},
 		},
 	}
@@ -213,7 +214,7 @@ func Test_v1OutdialtargetsIDStatusPut(t *testing.T) {
 			&sock.Response{
 				StatusCode: 200,
 				DataType:   "application/json",
-				Data:       []byte(`{"id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","name":"","detail":"","data":"","status":"","destination_0":null,"destination_1":null,"destination_2":null,"destination_3":null,"destination_4":null,"try_count_0":0,"try_count_1":0,"try_count_2":0,"try_count_3":0,"try_count_4":0,"tm_create":null,"tm_update":null,"tm_delete":null}`),
+				Data:       []byte(`{"id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","name":"","detail":"","data":"","status":"","timezone":"","destination_0":null,"destination_1":null,"destination_2":null,"destination_3":null,"destination_4":null,"try_count_0":0,"try_count_1":0,"try_count_2":0,"try_count_3":0,"try_count_4":0,"callback_agent_id":"00000000-0000-0000-0000-000000000000","tm_callback":null,"tm_create":null,"tm_update":null,"tm_delete":null}`),
 			},
 		},
 	}
@@ -245,3 +246,63 @@ func Test_v1OutdialtargetsIDStatusPut(t *testing.T) {
 		})
 	}
 }
+
+func Test_v1OutdialtargetsIDCallbackPut(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		outdialtargetID uuid.UUID
+		tmCallback      *time.Time
+		agentID         uuid.UUID
+
+		expectRes *sock.Response
+	}{
+		{
+			"normal",
+			&sock.Request{
+				URI:      "/v1/outdialtargets/9e1c4f6a-4f9c-11f1-8a3d-5b6c7d8e9f01/callback",
+				Method:   sock.RequestMethodPut,
+				DataType: "application/json",
+				Data:     []byte(`{"tm_callback": "2026-10-22T15:00:00Z", "agent_id": "9e6a2d84-4f9c-11f1-b7c1-2a3b4c5d6e7f"}`),
+			},
+
+			uuid.FromStringOrNil("9e1c4f6a-4f9c-11f1-8a3d-5b6c7d8e9f01"),
+			func() *time.Time { t := time.Date(2026, 10, 22, 15, 0, 0, 0, time.UTC); return &t }(),
+			uuid.FromStringOrNil("9e6a2d84-4f9c-11f1-b7c1-2a3b4c5d6e7f"),
+
+			&sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"00000000-0000-0000-0000-000000000000","outdial_id":"00000000-0000-0000-0000-000000000000","name":"","detail":"","data":"","status":"","timezone":"","destination_0":null,"destination_1":null,"destination_2":null,"destination_3":null,"destination_4":null,"try_count_0":0,"try_count_1":0,"try_count_2":0,"try_count_3":0,"try_count_4":0,"callback_agent_id":"00000000-0000-0000-0000-000000000000","tm_callback":null,"tm_create":null,"tm_update":null,"tm_delete":null}`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockOutdialTargetHandler := outdialtargethandler.NewMockOutdialTargetHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:          mockSock,
+				outdialTargetHandler: mockOutdialTargetHandler,
+			}
+
+			mockOutdialTargetHandler.EXPECT().UpdateCallback(gomock.Any(), tt.outdialtargetID, tt.tmCallback, tt.agentID).Return(&outdialtarget.OutdialTarget{}, nil)
+
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(tt.expectRes, res) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
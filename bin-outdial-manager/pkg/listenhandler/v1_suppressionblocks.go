@@ -0,0 +1,108 @@
+package listenhandler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"monorepo/bin-common-handler/models/sock"
+
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+
+	"monorepo/bin-outdial-manager/models/suppressionblock"
+)
+
+// v1SuppressionblocksGet handles /v1/suppressionblocks GET request
+func (h *listenHandler) v1SuppressionblocksGet(ctx context.Context, req *sock.Request) (*sock.Response, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":    "v1SuppressionblocksGet",
+		"request": req,
+	})
+
+	u, err := url.Parse(req.URI)
+	if err != nil {
+		return nil, err
+	}
+
+	// parse the pagination params from URI
+	tmpSize, _ := strconv.Atoi(u.Query().Get(PageSize))
+	pageSize := uint64(tmpSize)
+	pageToken := u.Query().Get(PageToken)
+
+	// Parse filters from request data (body)
+	var filters map[string]any
+	if len(req.Data) > 0 {
+		if err := json.Unmarshal(req.Data, &filters); err != nil {
+			log.Errorf("Could not unmarshal filters. err: %v", err)
+			return nil, fmt.Errorf("could not unmarshal filters: %w", err)
+		}
+	}
+
+	typedFilters, err := suppressionblock.ConvertStringMapToFieldMap(filters)
+	if err != nil {
+		log.Errorf("Could not convert filters. err: %v", err)
+		return nil, fmt.Errorf("could not convert filters: %w", err)
+	}
+
+	tmp, err := h.suppressionHandler.BlockList(ctx, pageToken, pageSize, typedFilters)
+	if err != nil {
+		log.Errorf("Could not get suppressionblocks. err: %v", err)
+		return nil, err
+	}
+
+	data, err := json.Marshal(tmp)
+	if err != nil {
+		log.Errorf("Could not marshal the res. err: %v", err)
+		return nil, err
+	}
+
+	res := &sock.Response{
+		StatusCode: 200,
+		DataType:   "application/json",
+		Data:       data,
+	}
+
+	return res, nil
+}
+
+// v1SuppressionblocksIDGet handles /v1/suppressionblocks/<suppressionblock-id> GET request
+func (h *listenHandler) v1SuppressionblocksIDGet(ctx context.Context, m *sock.Request) (*sock.Response, error) {
+	u, err := url.Parse(m.URI)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpVals := strings.Split(u.Path, "/")
+	id := uuid.FromStringOrNil(tmpVals[3])
+	log := logrus.WithFields(
+		logrus.Fields{
+			"func":                "v1SuppressionblocksIDGet",
+			"suppressionblock_id": id,
+		},
+	)
+	log.WithField("request", m).Debug("Executing v1SuppressionblocksIDGet.")
+
+	tmp, err := h.suppressionHandler.BlockGet(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get suppressionblock. err: %v", err)
+		return nil, err
+	}
+
+	data, err := json.Marshal(tmp)
+	if err != nil {
+		log.Errorf("Could not marshal the res. err: %v", err)
+		return nil, err
+	}
+
+	res := &sock.Response{
+		StatusCode: 200,
+		DataType:   "application/json",
+		Data:       data,
+	}
+
+	return res, nil
+}
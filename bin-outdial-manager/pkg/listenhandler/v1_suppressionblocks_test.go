@@ -0,0 +1,141 @@
+package listenhandler
+
+import (
+	"reflect"
+	"testing"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/models/sock"
+	"monorepo/bin-common-handler/pkg/sockhandler"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+
+	"monorepo/bin-outdial-manager/models/suppressionblock"
+	"monorepo/bin-outdial-manager/pkg/suppressionhandler"
+)
+
+func Test_v1SuppressionblocksGet(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		responseBlocks []*suppressionblock.SuppressionBlock
+
+		expectPageToken string
+		expectPageSize  uint64
+		expectFilters   map[suppressionblock.Field]any
+		expectRes       *sock.Response
+	}{
+		{
+			name: "normal",
+			request: &sock.Request{
+				URI:      "/v1/suppressionblocks?page_token=2020-10-10T03:30:17.000000Z&page_size=10",
+				Method:   sock.RequestMethodGet,
+				DataType: "application/json",
+				Data:     []byte(`{"customer_id":"a0b1c2d3-2b72-11f1-828d-9e0f1a2b3c01","deleted":false}`),
+			},
+
+			responseBlocks: []*suppressionblock.SuppressionBlock{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("a0dfe0f1-2b72-11f1-939e-0f1a2b3c4d02"),
+					},
+				},
+			},
+
+			expectPageToken: "2020-10-10T03:30:17.000000Z",
+			expectPageSize:  10,
+			expectFilters: map[suppressionblock.Field]any{
+				suppressionblock.FieldCustomerID: uuid.FromStringOrNil("a0b1c2d3-2b72-11f1-828d-9e0f1a2b3c01"),
+				suppressionblock.FieldDeleted:    false,
+			},
+			expectRes: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`[{"id":"a0dfe0f1-2b72-11f1-939e-0f1a2b3c4d02","customer_id":"00000000-0000-0000-0000-000000000000","suppression_id":"00000000-0000-0000-0000-000000000000","list_type":"","target":"","reference_type":"","reference_id":"00000000-0000-0000-0000-000000000000","reason":"","tm_create":null,"tm_update":null,"tm_delete":null}]`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockSuppression := suppressionhandler.NewMockSuppressionHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:        mockSock,
+				suppressionHandler: mockSuppression,
+			}
+
+			mockSuppression.EXPECT().BlockList(gomock.Any(), tt.expectPageToken, tt.expectPageSize, tt.expectFilters).Return(tt.responseBlocks, nil)
+
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_v1SuppressionblocksIDGet(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		responseBlock *suppressionblock.SuppressionBlock
+
+		expectID uuid.UUID
+	}{
+		{
+			name: "normal",
+			request: &sock.Request{
+				URI:    "/v1/suppressionblocks/c1d2e3f4-2b72-11f1-a4af-1a2b3c4d5e01",
+				Method: sock.RequestMethodGet,
+			},
+
+			responseBlock: &suppressionblock.SuppressionBlock{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("c1d2e3f4-2b72-11f1-a4af-1a2b3c4d5e01"),
+				},
+			},
+
+			expectID: uuid.FromStringOrNil("c1d2e3f4-2b72-11f1-a4af-1a2b3c4d5e01"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockSuppression := suppressionhandler.NewMockSuppressionHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:        mockSock,
+				suppressionHandler: mockSuppression,
+			}
+
+			mockSuppression.EXPECT().BlockGet(gomock.Any(), tt.expectID).Return(tt.responseBlock, nil)
+
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if res.StatusCode != 200 {
+				t.Errorf("Wrong match. expect: 200, got: %d", res.StatusCode)
+			}
+		})
+	}
+}
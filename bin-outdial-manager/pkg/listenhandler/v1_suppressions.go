@@ -0,0 +1,261 @@
+package listenhandler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"monorepo/bin-common-handler/models/sock"
+
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+
+	"monorepo/bin-outdial-manager/models/suppression"
+	"monorepo/bin-outdial-manager/pkg/listenhandler/models/request"
+	"monorepo/bin-outdial-manager/pkg/listenhandler/models/response"
+)
+
+// v1SuppressionsPost handles /v1/suppressions POST request
+func (h *listenHandler) v1SuppressionsPost(ctx context.Context, m *sock.Request) (*sock.Response, error) {
+	log := logrus.WithFields(
+		logrus.Fields{
+			"func": "v1SuppressionsPost",
+		},
+	)
+	log.WithField("request", m).Debug("Executing v1SuppressionsPost.")
+
+	var req request.V1DataSuppressionsPost
+	if err := json.Unmarshal(m.Data, &req); err != nil {
+		log.Errorf("Could not marshal the data. err: %v", err)
+		return nil, err
+	}
+
+	tmp, err := h.suppressionHandler.Create(ctx, req.CustomerID, req.ListType, req.Target, req.Detail)
+	if err != nil {
+		log.Errorf("Could not create suppression. err: %v", err)
+		return nil, err
+	}
+
+	data, err := json.Marshal(tmp)
+	if err != nil {
+		log.Errorf("Could not marshal the res. err: %v", err)
+		return nil, err
+	}
+
+	res := &sock.Response{
+		StatusCode: 200,
+		DataType:   "application/json",
+		Data:       data,
+	}
+
+	return res, nil
+}
+
+// v1SuppressionsGet handles /v1/suppressions GET request
+func (h *listenHandler) v1SuppressionsGet(ctx context.Context, req *sock.Request) (*sock.Response, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":    "v1SuppressionsGet",
+		"request": req,
+	})
+
+	u, err := url.Parse(req.URI)
+	if err != nil {
+		return nil, err
+	}
+
+	// parse the pagination params from URI
+	tmpSize, _ := strconv.Atoi(u.Query().Get(PageSize))
+	pageSize := uint64(tmpSize)
+	pageToken := u.Query().Get(PageToken)
+
+	// Parse filters from request data (body)
+	var filters map[string]any
+	if len(req.Data) > 0 {
+		if err := json.Unmarshal(req.Data, &filters); err != nil {
+			log.Errorf("Could not unmarshal filters. err: %v", err)
+			return nil, fmt.Errorf("could not unmarshal filters: %w", err)
+		}
+	}
+
+	typedFilters, err := suppression.ConvertStringMapToFieldMap(filters)
+	if err != nil {
+		log.Errorf("Could not convert filters. err: %v", err)
+		return nil, fmt.Errorf("could not convert filters: %w", err)
+	}
+
+	tmp, err := h.suppressionHandler.List(ctx, pageToken, pageSize, typedFilters)
+	if err != nil {
+		log.Errorf("Could not get suppressions. err: %v", err)
+		return nil, err
+	}
+
+	data, err := json.Marshal(tmp)
+	if err != nil {
+		log.Errorf("Could not marshal the res. err: %v", err)
+		return nil, err
+	}
+
+	res := &sock.Response{
+		StatusCode: 200,
+		DataType:   "application/json",
+		Data:       data,
+	}
+
+	return res, nil
+}
+
+// v1SuppressionsImportPost handles /v1/suppressions/import POST request
+func (h *listenHandler) v1SuppressionsImportPost(ctx context.Context, m *sock.Request) (*sock.Response, error) {
+	log := logrus.WithFields(
+		logrus.Fields{
+			"func": "v1SuppressionsImportPost",
+		},
+	)
+	log.WithField("request", m).Debug("Executing v1SuppressionsImportPost.")
+
+	var req request.V1DataSuppressionsImportPost
+	if err := json.Unmarshal(m.Data, &req); err != nil {
+		log.Errorf("Could not marshal the data. err: %v", err)
+		return nil, err
+	}
+
+	imported, skipped, err := h.suppressionHandler.Import(ctx, req.CustomerID, req.ListType, req.Targets, req.Detail)
+	if err != nil {
+		log.Errorf("Could not import suppressions. err: %v", err)
+		return nil, err
+	}
+
+	data, err := json.Marshal(response.V1ResponseSuppressionsImport{
+		Imported: imported,
+		Skipped:  skipped,
+	})
+	if err != nil {
+		log.Errorf("Could not marshal the res. err: %v", err)
+		return nil, err
+	}
+
+	res := &sock.Response{
+		StatusCode: 200,
+		DataType:   "application/json",
+		Data:       data,
+	}
+
+	return res, nil
+}
+
+// v1SuppressionsIsSuppressedPost handles /v1/suppressions/is_suppressed POST request
+func (h *listenHandler) v1SuppressionsIsSuppressedPost(ctx context.Context, m *sock.Request) (*sock.Response, error) {
+	log := logrus.WithFields(
+		logrus.Fields{
+			"func": "v1SuppressionsIsSuppressedPost",
+		},
+	)
+	log.WithField("request", m).Debug("Executing v1SuppressionsIsSuppressedPost.")
+
+	var req request.V1DataSuppressionsIsSuppressedPost
+	if err := json.Unmarshal(m.Data, &req); err != nil {
+		log.Errorf("Could not marshal the data. err: %v", err)
+		return nil, err
+	}
+
+	suppressed, err := h.suppressionHandler.IsSuppressed(ctx, req.CustomerID, req.Target, req.ReferenceType, req.ReferenceID)
+	if err != nil {
+		log.Errorf("Could not check the suppression. err: %v", err)
+		return nil, err
+	}
+
+	data, err := json.Marshal(response.V1ResponseSuppressionsIsSuppressed{
+		Suppressed: suppressed,
+	})
+	if err != nil {
+		log.Errorf("Could not marshal the res. err: %v", err)
+		return nil, err
+	}
+
+	res := &sock.Response{
+		StatusCode: 200,
+		DataType:   "application/json",
+		Data:       data,
+	}
+
+	return res, nil
+}
+
+// v1SuppressionsIDGet handles /v1/suppressions/<suppression-id> GET request
+func (h *listenHandler) v1SuppressionsIDGet(ctx context.Context, m *sock.Request) (*sock.Response, error) {
+	u, err := url.Parse(m.URI)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpVals := strings.Split(u.Path, "/")
+	id := uuid.FromStringOrNil(tmpVals[3])
+	log := logrus.WithFields(
+		logrus.Fields{
+			"func":           "v1SuppressionsIDGet",
+			"suppression_id": id,
+		},
+	)
+	log.WithField("request", m).Debug("Executing v1SuppressionsIDGet.")
+
+	tmp, err := h.suppressionHandler.Get(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get suppression. err: %v", err)
+		return nil, err
+	}
+
+	data, err := json.Marshal(tmp)
+	if err != nil {
+		log.Errorf("Could not marshal the res. err: %v", err)
+		return nil, err
+	}
+
+	res := &sock.Response{
+		StatusCode: 200,
+		DataType:   "application/json",
+		Data:       data,
+	}
+
+	return res, nil
+}
+
+// v1SuppressionsIDDelete handles /v1/suppressions/<suppression-id> DELETE request
+func (h *listenHandler) v1SuppressionsIDDelete(ctx context.Context, m *sock.Request) (*sock.Response, error) {
+	u, err := url.Parse(m.URI)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpVals := strings.Split(u.Path, "/")
+	id := uuid.FromStringOrNil(tmpVals[3])
+	log := logrus.WithFields(
+		logrus.Fields{
+			"func":           "v1SuppressionsIDDelete",
+			"suppression_id": id,
+		},
+	)
+	log.WithField("request", m).Debug("Executing v1SuppressionsIDDelete.")
+
+	tmp, err := h.suppressionHandler.Delete(ctx, id)
+	if err != nil {
+		log.Errorf("Could not delete suppression. err: %v", err)
+		return nil, err
+	}
+
+	data, err := json.Marshal(tmp)
+	if err != nil {
+		log.Errorf("Could not marshal the res. err: %v", err)
+		return nil, err
+	}
+
+	res := &sock.Response{
+		StatusCode: 200,
+		DataType:   "application/json",
+		Data:       data,
+	}
+
+	return res, nil
+}
@@ -0,0 +1,344 @@
+package listenhandler
+
+import (
+	"reflect"
+	"testing"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/models/sock"
+	"monorepo/bin-common-handler/pkg/sockhandler"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/mock/gomock"
+
+	"monorepo/bin-outdial-manager/models/suppression"
+	"monorepo/bin-outdial-manager/models/suppressionblock"
+	"monorepo/bin-outdial-manager/pkg/suppressionhandler"
+)
+
+func Test_v1SuppressionsPost(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		responseSuppression *suppression.Suppression
+
+		expectCustomerID uuid.UUID
+		expectListType   suppression.ListType
+		expectTarget     string
+		expectDetail     string
+		expectRes        *sock.Response
+	}{
+		{
+			name: "normal",
+			request: &sock.Request{
+				URI:      "/v1/suppressions",
+				Method:   sock.RequestMethodPost,
+				DataType: "application/json",
+				Data:     []byte(`{"customer_id":"0b1c2d3e-2b72-11f1-8a0b-1c2d3e4f5a01","list_type":"dnc","target":"+821100000001","detail":"test detail"}`),
+			},
+
+			responseSuppression: &suppression.Suppression{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("0b4e5f6a-2b72-11f1-9b1c-2d3e4f5a6b02"),
+				},
+			},
+
+			expectCustomerID: uuid.FromStringOrNil("0b1c2d3e-2b72-11f1-8a0b-1c2d3e4f5a01"),
+			expectListType:   suppression.ListTypeDNC,
+			expectTarget:     "+821100000001",
+			expectDetail:     "test detail",
+			expectRes: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"0b4e5f6a-2b72-11f1-9b1c-2d3e4f5a6b02","customer_id":"00000000-0000-0000-0000-000000000000","list_type":"","target":"","detail":"","tm_create":null,"tm_update":null,"tm_delete":null}`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockSuppression := suppressionhandler.NewMockSuppressionHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:        mockSock,
+				suppressionHandler: mockSuppression,
+			}
+
+			mockSuppression.EXPECT().Create(gomock.Any(), tt.expectCustomerID, tt.expectListType, tt.expectTarget, tt.expectDetail).Return(tt.responseSuppression, nil)
+
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_v1SuppressionsGet(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		responseSuppressions []*suppression.Suppression
+
+		expectPageToken string
+		expectPageSize  uint64
+		expectFilters   map[suppression.Field]any
+		expectRes       *sock.Response
+	}{
+		{
+			name: "normal",
+			request: &sock.Request{
+				URI:      "/v1/suppressions?page_token=2020-10-10T03:30:17.000000Z&page_size=10",
+				Method:   sock.RequestMethodGet,
+				DataType: "application/json",
+				Data:     []byte(`{"customer_id":"2c3d4e5f-2b72-11f1-ac2d-3e4f5a6b7c01","deleted":false}`),
+			},
+
+			responseSuppressions: []*suppression.Suppression{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("2c6f7a8b-2b72-11f1-bd3e-4f5a6b7c8d02"),
+					},
+				},
+			},
+
+			expectPageToken: "2020-10-10T03:30:17.000000Z",
+			expectPageSize:  10,
+			expectFilters: map[suppression.Field]any{
+				suppression.FieldCustomerID: uuid.FromStringOrNil("2c3d4e5f-2b72-11f1-ac2d-3e4f5a6b7c01"),
+				suppression.FieldDeleted:    false,
+			},
+			expectRes: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`[{"id":"2c6f7a8b-2b72-11f1-bd3e-4f5a6b7c8d02","customer_id":"00000000-0000-0000-0000-000000000000","list_type":"","target":"","detail":"","tm_create":null,"tm_update":null,"tm_delete":null}]`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockSuppression := suppressionhandler.NewMockSuppressionHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:        mockSock,
+				suppressionHandler: mockSuppression,
+			}
+
+			mockSuppression.EXPECT().List(gomock.Any(), tt.expectPageToken, tt.expectPageSize, tt.expectFilters).Return(tt.responseSuppressions, nil)
+
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_v1SuppressionsImportPost(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		responseImported int
+		responseSkipped  int
+
+		expectCustomerID uuid.UUID
+		expectListType   suppression.ListType
+		expectTargets    []string
+		expectRes        *sock.Response
+	}{
+		{
+			name: "normal",
+			request: &sock.Request{
+				URI:      "/v1/suppressions/import",
+				Method:   sock.RequestMethodPost,
+				DataType: "application/json",
+				Data:     []byte(`{"customer_id":"4d5e6f7a-2b72-11f1-8e4f-5a6b7c8d9e01","list_type":"litigator","targets":["+821100000001","+821100000002"]}`),
+			},
+
+			responseImported: 1,
+			responseSkipped:  1,
+
+			expectCustomerID: uuid.FromStringOrNil("4d5e6f7a-2b72-11f1-8e4f-5a6b7c8d9e01"),
+			expectListType:   suppression.ListTypeLitigator,
+			expectTargets:    []string{"+821100000001", "+821100000002"},
+			expectRes: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"imported":1,"skipped":1}`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockSuppression := suppressionhandler.NewMockSuppressionHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:        mockSock,
+				suppressionHandler: mockSuppression,
+			}
+
+			mockSuppression.EXPECT().Import(gomock.Any(), tt.expectCustomerID, tt.expectListType, tt.expectTargets, "").Return(tt.responseImported, tt.responseSkipped, nil)
+
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_v1SuppressionsIsSuppressedPost(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		responseSuppressed bool
+
+		expectCustomerID    uuid.UUID
+		expectTarget        string
+		expectReferenceType suppressionblock.ReferenceType
+		expectReferenceID   uuid.UUID
+		expectRes           *sock.Response
+	}{
+		{
+			name: "normal",
+			request: &sock.Request{
+				URI:      "/v1/suppressions/is_suppressed",
+				Method:   sock.RequestMethodPost,
+				DataType: "application/json",
+				Data:     []byte(`{"customer_id":"6e7f8a9b-2b72-11f1-9f5a-6b7c8d9e0f01","target":"+821100000001","reference_type":"message","reference_id":"6eabbcd0-2b72-11f1-a06b-7c8d9e0f1a02"}`),
+			},
+
+			responseSuppressed: true,
+
+			expectCustomerID:    uuid.FromStringOrNil("6e7f8a9b-2b72-11f1-9f5a-6b7c8d9e0f01"),
+			expectTarget:        "+821100000001",
+			expectReferenceType: suppressionblock.ReferenceTypeMessage,
+			expectReferenceID:   uuid.FromStringOrNil("6eabbcd0-2b72-11f1-a06b-7c8d9e0f1a02"),
+			expectRes: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"suppressed":true}`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockSuppression := suppressionhandler.NewMockSuppressionHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:        mockSock,
+				suppressionHandler: mockSuppression,
+			}
+
+			mockSuppression.EXPECT().IsSuppressed(gomock.Any(), tt.expectCustomerID, tt.expectTarget, tt.expectReferenceType, tt.expectReferenceID).Return(tt.responseSuppressed, nil)
+
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_v1SuppressionsIDDelete(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		responseSuppression *suppression.Suppression
+
+		expectID  uuid.UUID
+		expectRes *sock.Response
+	}{
+		{
+			name: "normal",
+			request: &sock.Request{
+				URI:    "/v1/suppressions/8f9a0b1c-2b72-11f1-b17c-8d9e0f1a2b01",
+				Method: sock.RequestMethodDelete,
+			},
+
+			responseSuppression: &suppression.Suppression{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("8f9a0b1c-2b72-11f1-b17c-8d9e0f1a2b01"),
+				},
+			},
+
+			expectID: uuid.FromStringOrNil("8f9a0b1c-2b72-11f1-b17c-8d9e0f1a2b01"),
+			expectRes: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"id":"8f9a0b1c-2b72-11f1-b17c-8d9e0f1a2b01","customer_id":"00000000-0000-0000-0000-000000000000","list_type":"","target":"","detail":"","tm_create":null,"tm_update":null,"tm_delete":null}`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockSuppression := suppressionhandler.NewMockSuppressionHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:        mockSock,
+				suppressionHandler: mockSuppression,
+			}
+
+			mockSuppression.EXPECT().Delete(gomock.Any(), tt.expectID).Return(tt.responseSuppression, nil)
+
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ package outdialtargethandler
 
 import (
 	"context"
+	"time"
 
 	commonaddress "monorepo/bin-common-handler/models/address"
 	"monorepo/bin-common-handler/pkg/notifyhandler"
@@ -30,6 +31,7 @@ type OutdialTargetHandler interface {
 		name string,
 		detail string,
 		data string,
+		timezone string,
 		destination0 *commonaddress.Address,
 		destination1 *commonaddress.Address,
 		destination2 *commonaddress.Address,
@@ -53,6 +55,7 @@ type OutdialTargetHandler interface {
 
 	UpdateStatus(ctx context.Context, id uuid.UUID, status outdialtarget.Status) (*outdialtarget.OutdialTarget, error)
 	UpdateProgressing(ctx context.Context, id uuid.UUID, destinationIndex int) (*outdialtarget.OutdialTarget, error)
+	UpdateCallback(ctx context.Context, id uuid.UUID, tmCallback *time.Time, agentID uuid.UUID) (*outdialtarget.OutdialTarget, error)
 }
 
 // NewOutdialTargetHandler return OutdialTargetHandler
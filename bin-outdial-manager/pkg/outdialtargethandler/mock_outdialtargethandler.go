@@ -14,6 +14,7 @@ import (
 	address "monorepo/bin-common-handler/models/address"
 	outdialtarget "monorepo/bin-outdial-manager/models/outdialtarget"
 	reflect "reflect"
+	time "time"
 
 	uuid "github.com/gofrs/uuid"
 	gomock "go.uber.org/mock/gomock"
@@ -44,18 +45,18 @@ func (m *MockOutdialTargetHandler) EXPECT() *MockOutdialTargetHandlerMockRecorde
 }
 
 // Create mocks base method.
-func (m *MockOutdialTargetHandler) Create(ctx context.Context, outdialID uuid.UUID, name, detail, data string, destination0, destination1, destination2, destination3, destination4 *address.Address) (*outdialtarget.OutdialTarget, error) {
+func (m *MockOutdialTargetHandler) Create(ctx context.Context, outdialID uuid.UUID, name, detail, data, timezone string, destination0, destination1, destination2, destination3, destination4 *address.Address) (*outdialtarget.OutdialTarget, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, outdialID, name, detail, data, destination0, destination1, destination2, destination3, destination4)
+	ret := m.ctrl.Call(m, "Create", ctx, outdialID, name, detail, data, timezone, destination0, destination1, destination2, destination3, destination4)
 	ret0, _ := ret[0].(*outdialtarget.OutdialTarget)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockOutdialTargetHandlerMockRecorder) Create(ctx, outdialID, name, detail, data, destination0, destination1, destination2, destination3, destination4 any) *gomock.Call {
+func (mr *MockOutdialTargetHandlerMockRecorder) Create(ctx, outdialID, name, detail, data, timezone, destination0, destination1, destination2, destination3, destination4 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOutdialTargetHandler)(nil).Create), ctx, outdialID, name, detail, data, destination0, destination1, destination2, destination3, destination4)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOutdialTargetHandler)(nil).Create), ctx, outdialID, name, detail, data, timezone, destination0, destination1, destination2, destination3, destination4)
 }
 
 // Delete mocks base method.
@@ -118,6 +119,21 @@ func (mr *MockOutdialTargetHandlerMockRecorder) GetsByOutdialID(ctx, outdialID,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetsByOutdialID", reflect.TypeOf((*MockOutdialTargetHandler)(nil).GetsByOutdialID), ctx, outdialID, token, limit)
 }
 
+// UpdateCallback mocks base method.
+func (m *MockOutdialTargetHandler) UpdateCallback(ctx context.Context, id uuid.UUID, tmCallback *time.Time, agentID uuid.UUID) (*outdialtarget.OutdialTarget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCallback", ctx, id, tmCallback, agentID)
+	ret0, _ := ret[0].(*outdialtarget.OutdialTarget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCallback indicates an expected call of UpdateCallback.
+func (mr *MockOutdialTargetHandlerMockRecorder) UpdateCallback(ctx, id, tmCallback, agentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCallback", reflect.TypeOf((*MockOutdialTargetHandler)(nil).UpdateCallback), ctx, id, tmCallback, agentID)
+}
+
 // UpdateProgressing mocks base method.
 func (m *MockOutdialTargetHandler) UpdateProgressing(ctx context.Context, id uuid.UUID, destinationIndex int) (*outdialtarget.OutdialTarget, error) {
 	m.ctrl.T.Helper()
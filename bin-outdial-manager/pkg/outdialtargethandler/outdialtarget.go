@@ -3,6 +3,7 @@ package outdialtargethandler
 import (
 	"context"
 	stderrors "errors"
+	"time"
 
 	commonaddress "monorepo/bin-common-handler/models/address"
 	cerrors "monorepo/bin-common-handler/models/errors"
@@ -23,6 +24,7 @@ func (h *outdialTargetHandler) Create(
 	name string,
 	detail string,
 	data string,
+	timezone string,
 	destination0 *commonaddress.Address,
 	destination1 *commonaddress.Address,
 	destination2 *commonaddress.Address,
@@ -35,6 +37,17 @@ func (h *outdialTargetHandler) Create(
 			"outdial_id": outdialID,
 		})
 
+	if timezone != "" {
+		if _, err := time.LoadLocation(timezone); err != nil {
+			log.Errorf("Could not load the timezone. timezone: %s, err: %v", timezone, err)
+			return nil, cerrors.InvalidArgument(
+				commonoutline.ServiceNameOutdialManager,
+				"INVALID_TIMEZONE",
+				"The timezone is not a valid IANA time zone name.",
+			)
+		}
+	}
+
 	id := uuid.Must(uuid.NewV4())
 	ts := utilhandler.TimeNow()
 	t := &outdialtarget.OutdialTarget{
@@ -44,8 +57,9 @@ func (h *outdialTargetHandler) Create(
 		Name:   name,
 		Detail: detail,
 
-		Data:   data,
-		Status: outdialtarget.StatusIdle,
+		Data:     data,
+		Status:   outdialtarget.StatusIdle,
+		Timezone: timezone,
 
 		Destination0: destination0,
 		Destination1: destination1,
@@ -206,6 +220,7 @@ func (h *outdialTargetHandler) UpdateProgressing(ctx context.Context, id uuid.UU
 }
 
 // UpdateStatus updates the outdialtarget's status
+// The done status puts the target back to idle if it has a scheduled callback.
 func (h *outdialTargetHandler) UpdateStatus(ctx context.Context, id uuid.UUID, status outdialtarget.Status) (*outdialtarget.OutdialTarget, error) {
 	log := logrus.WithFields(
 		logrus.Fields{
@@ -214,12 +229,65 @@ func (h *outdialTargetHandler) UpdateStatus(ctx context.Context, id uuid.UUID, s
 			"status":           status,
 		})
 
+	if errUpdate := h.db.OutdialTargetUpdateStatus(ctx, id, status); errUpdate != nil {
+		log.Errorf("Could not update the outdialtarget status. err: %v", errUpdate)
+		return nil, errUpdate
+	}
+
+	// get updated
+	res, err := h.Get(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get updated outdialtarget. err: %v", err)
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// UpdateCallback schedules the outdialtarget to be dialed again at the given time.
+// The target is excluded from the available targets until the callback time.
+// If the agent id is given, the callback is handled by the agent. Nil callback time cancels the scheduled callback.
+func (h *outdialTargetHandler) UpdateCallback(ctx context.Context, id uuid.UUID, tmCallback *time.Time, agentID uuid.UUID) (*outdialtarget.OutdialTarget, error) {
+	log := logrus.WithFields(
+		logrus.Fields{
+			"func":             "UpdateCallback",
+			"outdialtarget_id": id,
+			"tm_callback":      tmCallback,
+			"agent_id":         agentID,
+		})
+
+	t, err := h.Get(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get outdialtarget. err: %v", err)
+		return nil, err
+	}
+
+	if t.TMDelete != nil {
+		log.Errorf("The outdialtarget has been deleted already.")
+		return nil, cerrors.FailedPrecondition(
+			commonoutline.ServiceNameOutdialManager,
+			"OUTDIAL_TARGET_DELETED",
+			"The outdial target has been deleted.",
+		)
+	}
+
+	if tmCallback == nil {
+		agentID = uuid.Nil
+	}
+
 	fields := map[outdialtarget.Field]any{
-		outdialtarget.FieldStatus: status,
+		outdialtarget.FieldCallbackAgentID: agentID,
+		outdialtarget.FieldTMCallback:      tmCallback,
+	}
+
+	// the finished target becomes dialable again for the callback.
+	// the progressing target goes back to idle when the current call is done.
+	if tmCallback != nil && t.Status == outdialtarget.StatusDone {
+		fields[outdialtarget.FieldStatus] = outdialtarget.StatusIdle
 	}
 
 	if errUpdate := h.db.OutdialTargetUpdate(ctx, id, fields); errUpdate != nil {
-		log.Errorf("Could not update the outdialtarget status. err: %v", errUpdate)
+		log.Errorf("Could not update the outdialtarget callback. err: %v", errUpdate)
 		return nil, errUpdate
 	}
 
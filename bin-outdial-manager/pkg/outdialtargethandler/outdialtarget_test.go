@@ -2,7 +2,9 @@ package outdialtargethandler
 
 import (
 	"context"
+	reflect "reflect"
 	"testing"
+	"time"
 
 	commonaddress "monorepo/bin-common-handler/models/address"
 	"monorepo/bin-common-handler/pkg/notifyhandler"
@@ -24,6 +26,7 @@ func Test_Create(t *testing.T) {
 		outdialName  string
 		detail       string
 		data         string
+		timezone     string
 		destination0 *commonaddress.Address
 		destination1 *commonaddress.Address
 		destination2 *commonaddress.Address
@@ -37,6 +40,7 @@ func Test_Create(t *testing.T) {
 			"test name",
 			"test detail",
 			"test data",
+			"America/New_York",
 			&commonaddress.Address{},
 			nil,
 			nil,
@@ -71,6 +75,7 @@ func Test_Create(t *testing.T) {
 				tt.outdialName,
 				tt.detail,
 				tt.data,
+				tt.timezone,
 				tt.destination0,
 				tt.destination1,
 				tt.destination2,
@@ -84,6 +89,36 @@ func Test_Create(t *testing.T) {
 	}
 }
 
+func Test_Create_invalidTimezone(t *testing.T) {
+
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockDB := dbhandler.NewMockDBHandler(mc)
+	h := outdialTargetHandler{
+		db: mockDB,
+	}
+
+	ctx := context.Background()
+
+	_, err := h.Create(
+		ctx,
+		uuid.FromStringOrNil("5e1c7a2a-4f3a-11f0-8d2b-0b7e3f5c9a11"),
+		"test name",
+		"test detail",
+		"test data",
+		"Mars/Olympus_Mons",
+		&commonaddress.Address{},
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+	if err == nil {
+		t.Errorf("Wrong match. expect: error, got: ok")
+	}
+}
+
 func Test_Delete(t *testing.T) {
 
 	tests := []struct {
@@ -303,7 +338,7 @@ func Test_UpdateStatus(t *testing.T) {
 
 			ctx := context.Background()
 
-			mockDB.EXPECT().OutdialTargetUpdate(ctx, tt.id, gomock.Any()).Return(nil)
+			mockDB.EXPECT().OutdialTargetUpdateStatus(ctx, tt.id, tt.status).Return(nil)
 			mockDB.EXPECT().OutdialTargetGet(ctx, tt.id).Return(&outdialtarget.OutdialTarget{Status: tt.status}, nil)
 
 			result, err := h.UpdateStatus(ctx, tt.id, tt.status)
@@ -369,3 +404,122 @@ func Test_UpdateProgressing(t *testing.T) {
 		})
 	}
 }
+
+func Test_UpdateCallback(t *testing.T) {
+
+	tmCallback := func() *time.Time { t := time.Date(2026, 10, 22, 15, 0, 0, 0, time.UTC); return &t }()
+
+	tests := []struct {
+		name string
+
+		id         uuid.UUID
+		tmCallback *time.Time
+		agentID    uuid.UUID
+
+		responseTarget *outdialtarget.OutdialTarget
+
+		expectFields map[outdialtarget.Field]any
+	}{
+		{
+			name: "done target goes back to idle",
+
+			id:         uuid.FromStringOrNil("3a6f2c1e-4f9a-11f1-8b2d-2f3e4d5c6b7a"),
+			tmCallback: tmCallback,
+			agentID:    uuid.FromStringOrNil("3ab4d8f0-4f9a-11f1-9c5e-6a7b8c9d0e1f"),
+
+			responseTarget: &outdialtarget.OutdialTarget{
+				ID:     uuid.FromStringOrNil("3a6f2c1e-4f9a-11f1-8b2d-2f3e4d5c6b7a"),
+				Status: outdialtarget.StatusDone,
+			},
+
+			expectFields: map[outdialtarget.Field]any{
+				outdialtarget.FieldCallbackAgentID: uuid.FromStringOrNil("3ab4d8f0-4f9a-11f1-9c5e-6a7b8c9d0e1f"),
+				outdialtarget.FieldTMCallback:      tmCallback,
+				outdialtarget.FieldStatus:          outdialtarget.StatusIdle,
+			},
+		},
+		{
+			name: "progressing target keeps the status",
+
+			id:         uuid.FromStringOrNil("3b0c9e62-4f9a-11f1-a4f7-1b2c3d4e5f60"),
+			tmCallback: tmCallback,
+			agentID:    uuid.Nil,
+
+			responseTarget: &outdialtarget.OutdialTarget{
+				ID:     uuid.FromStringOrNil("3b0c9e62-4f9a-11f1-a4f7-1b2c3d4e5f60"),
+				Status: outdialtarget.StatusProgressing,
+			},
+
+			expectFields: map[outdialtarget.Field]any{
+				outdialtarget.FieldCallbackAgentID: uuid.Nil,
+				outdialtarget.FieldTMCallback:      tmCallback,
+			},
+		},
+		{
+			name: "nil callback time cancels the callback",
+
+			id:         uuid.FromStringOrNil("3b5d7a14-4f9a-11f1-b8e2-7c8d9e0f1a2b"),
+			tmCallback: nil,
+			agentID:    uuid.FromStringOrNil("3ab4d8f0-4f9a-11f1-9c5e-6a7b8c9d0e1f"),
+
+			responseTarget: &outdialtarget.OutdialTarget{
+				ID:     uuid.FromStringOrNil("3b5d7a14-4f9a-11f1-b8e2-7c8d9e0f1a2b"),
+				Status: outdialtarget.StatusDone,
+			},
+
+			expectFields: map[outdialtarget.Field]any{
+				outdialtarget.FieldCallbackAgentID: uuid.Nil,
+				outdialtarget.FieldTMCallback:      (*time.Time)(nil),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			h := outdialTargetHandler{
+				db: mockDB,
+			}
+
+			ctx := context.Background()
+
+			mockDB.EXPECT().OutdialTargetGet(ctx, tt.id).Return(tt.responseTarget, nil)
+			mockDB.EXPECT().OutdialTargetUpdate(ctx, tt.id, tt.expectFields).Return(nil)
+			mockDB.EXPECT().OutdialTargetGet(ctx, tt.id).Return(tt.responseTarget, nil)
+
+			res, err := h.UpdateCallback(ctx, tt.id, tt.tmCallback, tt.agentID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.responseTarget) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.responseTarget, res)
+			}
+		})
+	}
+}
+
+func Test_UpdateCallback_deleted(t *testing.T) {
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockDB := dbhandler.NewMockDBHandler(mc)
+	h := outdialTargetHandler{
+		db: mockDB,
+	}
+
+	ctx := context.Background()
+	id := uuid.FromStringOrNil("3bb1f3c6-4f9a-11f1-85d3-3d4e5f6a7b8c")
+	tmDelete := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	tmCallback := time.Date(2026, 10, 22, 15, 0, 0, 0, time.UTC)
+
+	mockDB.EXPECT().OutdialTargetGet(ctx, id).Return(&outdialtarget.OutdialTarget{ID: id, TMDelete: &tmDelete}, nil)
+
+	if _, err := h.UpdateCallback(ctx, id, &tmCallback, uuid.Nil); err == nil {
+		t.Errorf("Wrong match. expect: error, got: ok")
+	}
+}
@@ -0,0 +1,155 @@
+package outdialtargetjobhandler
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"strconv"
+
+	commonaddress "monorepo/bin-common-handler/models/address"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+
+	"github.com/sirupsen/logrus"
+
+	"monorepo/bin-outdial-manager/models/outdialtarget"
+	"monorepo/bin-outdial-manager/models/outdialtargetjob"
+)
+
+const (
+	exportPageSize = 100
+)
+
+// exportHeader is the header of the exported csv file.
+// The target's fields use the default header names, so the exported file can be imported as it is.
+var exportHeader = []string{
+	"id",
+	outdialtargetjob.HeaderName,
+	outdialtargetjob.HeaderDetail,
+	outdialtargetjob.HeaderData,
+	outdialtargetjob.HeaderTimezone,
+	outdialtargetjob.HeaderDestination0,
+	outdialtargetjob.HeaderDestination1,
+	outdialtargetjob.HeaderDestination2,
+	outdialtargetjob.HeaderDestination3,
+	outdialtargetjob.HeaderDestination4,
+	"status",
+	"try_count_0",
+	"try_count_1",
+	"try_count_2",
+	"try_count_3",
+	"try_count_4",
+}
+
+// exportRun writes the outdial's targets to the csv file.
+func (h *outdialTargetJobHandler) exportRun(ctx context.Context, j *outdialtargetjob.OutdialTargetJob) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":                "exportRun",
+		"outdialtargetjob_id": j.ID,
+	})
+
+	if errExport := h.exportFile(ctx, j); errExport != nil {
+		log.Errorf("Could not export the targets. err: %v", errExport)
+		h.finish(ctx, j, outdialtargetjob.StatusFailed, failedDetail(errExport, "Could not export the targets."))
+		return
+	}
+	log.Debugf("Exported the targets. success: %d, file_id: %s", j.SuccessCount, j.FileID)
+
+	h.finish(ctx, j, outdialtargetjob.StatusDone, "")
+}
+
+// exportFile writes the outdial's targets to the csv file and stores it as the job's file.
+func (h *outdialTargetJobHandler) exportFile(ctx context.Context, j *outdialtargetjob.OutdialTargetJob) error {
+	log := logrus.WithFields(logrus.Fields{
+		"func":                "exportFile",
+		"outdialtargetjob_id": j.ID,
+	})
+
+	dst, err := os.CreateTemp("", "outdial_export_*")
+	if err != nil {
+		return fmt.Errorf("could not create the temp file: %w", err)
+	}
+	defer func() {
+		_ = dst.Close()
+		_ = os.Remove(dst.Name())
+	}()
+
+	w := csv.NewWriter(dst)
+	if errWrite := w.Write(exportHeader); errWrite != nil {
+		return fmt.Errorf("could not write the header: %w", errWrite)
+	}
+
+	filters := map[outdialtarget.Field]any{
+		outdialtarget.FieldOutdialID: j.OutdialID,
+		outdialtarget.FieldDeleted:   false,
+	}
+	token := ""
+	for {
+		targets, err := h.db.OutdialTargetList(ctx, token, exportPageSize, filters)
+		if err != nil {
+			return fmt.Errorf("could not get the targets: %w", err)
+		}
+
+		for _, t := range targets {
+			if errWrite := w.Write(exportRecord(t)); errWrite != nil {
+				return fmt.Errorf("could not write the target: %w", errWrite)
+			}
+			j.SuccessCount++
+			promOutdialTargetJobRowTotal.WithLabelValues(string(j.Type), "success").Inc()
+		}
+		j.TotalCount = j.SuccessCount
+		j.ProcessedCount = j.SuccessCount
+
+		if len(targets) < exportPageSize || targets[len(targets)-1].TMCreate == nil {
+			break
+		}
+		token = targets[len(targets)-1].TMCreate.UTC().Format(utilhandler.ISO8601Layout)
+
+		if j.ProcessedCount%progressInterval == 0 {
+			h.updateProgress(ctx, j)
+		}
+	}
+
+	w.Flush()
+	if errFlush := w.Error(); errFlush != nil {
+		return fmt.Errorf("could not flush the file: %w", errFlush)
+	}
+
+	fileID, err := h.storeFile(ctx, j, dst.Name(), "outdial target export", fmt.Sprintf("outdial_%s_targets.csv", j.OutdialID))
+	if err != nil {
+		return fmt.Errorf("could not store the file: %w", err)
+	}
+	log.Debugf("Stored the exported file. file_id: %s", fileID)
+	j.FileID = fileID
+
+	return nil
+}
+
+// exportRecord returns the csv record of the given target.
+func exportRecord(t *outdialtarget.OutdialTarget) []string {
+	destination := func(a *commonaddress.Address) string {
+		if a == nil {
+			return ""
+		}
+		return a.Target
+	}
+
+	return []string{
+		t.ID.String(),
+		t.Name,
+		t.Detail,
+		t.Data,
+		t.Timezone,
+		destination(t.Destination0),
+		destination(t.Destination1),
+		destination(t.Destination2),
+		destination(t.Destination3),
+		destination(t.Destination4),
+		string(t.Status),
+		strconv.Itoa(t.TryCount0),
+		strconv.Itoa(t.TryCount1),
+		strconv.Itoa(t.TryCount2),
+		strconv.Itoa(t.TryCount3),
+		strconv.Itoa(t.TryCount4),
+	}
+}
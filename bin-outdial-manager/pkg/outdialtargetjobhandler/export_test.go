@@ -0,0 +1,166 @@
+package outdialtargetjobhandler
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	commonaddress "monorepo/bin-common-handler/models/address"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/notifyhandler"
+	"monorepo/bin-common-handler/pkg/requesthandler"
+	smfile "monorepo/bin-storage-manager/models/file"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	"monorepo/bin-outdial-manager/models/outdialtarget"
+	"monorepo/bin-outdial-manager/models/outdialtargetjob"
+	"monorepo/bin-outdial-manager/pkg/dbhandler"
+)
+
+func Test_exportFile(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		job *outdialtargetjob.OutdialTargetJob
+
+		responseTargets []*outdialtarget.OutdialTarget
+		responseFile    *smfile.File
+
+		expectFilters map[outdialtarget.Field]any
+		expectContent string
+	}{
+		{
+			name: "normal",
+
+			job: &outdialtargetjob.OutdialTargetJob{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("f0a1b2c3-2d4d-11f1-8a78-8d9e0f1a2b01"),
+					CustomerID: uuid.FromStringOrNil("f0ced4d6-2d4d-11f1-9b89-9e0f1a2b3c02"),
+				},
+				OutdialID: uuid.FromStringOrNil("f0fbf6ea-2d4d-11f1-ac9a-0f1a2b3c4d03"),
+				Type:      outdialtargetjob.TypeExport,
+			},
+
+			responseTargets: []*outdialtarget.OutdialTarget{
+				{
+					ID:       uuid.FromStringOrNil("f12918fe-2d4d-11f1-bdab-1a2b3c4d5e04"),
+					Name:     "alice",
+					Data:     "vip, gold",
+					Timezone: "Asia/Seoul",
+					Status:   outdialtarget.StatusDone,
+					Destination0: &commonaddress.Address{
+						Type:   commonaddress.TypeTel,
+						Target: "+821100000001",
+					},
+					TryCount0: 2,
+				},
+			},
+			responseFile: &smfile.File{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("f1563b12-2d4d-11f1-8ebc-2b3c4d5e6f05"),
+				},
+			},
+
+			expectFilters: map[outdialtarget.Field]any{
+				outdialtarget.FieldOutdialID: uuid.FromStringOrNil("f0fbf6ea-2d4d-11f1-ac9a-0f1a2b3c4d03"),
+				outdialtarget.FieldDeleted:   false,
+			},
+			expectContent: "id,name,detail,data,timezone,destination_0,destination_1,destination_2,destination_3,destination_4,status,try_count_0,try_count_1,try_count_2,try_count_3,try_count_4\n" +
+				"f12918fe-2d4d-11f1-bdab-1a2b3c4d5e04,alice,,\"vip, gold\",Asia/Seoul,+821100000001,,,,,done,2,0,0,0,0\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+			srv, uploaded := newTestStorageServer(t, "")
+
+			h := &outdialTargetJobHandler{
+				db:            mockDB,
+				reqHandler:    mockReq,
+				notifyHandler: mockNotify,
+				httpClient:    srv.Client(),
+			}
+			ctx := context.Background()
+
+			mockDB.EXPECT().OutdialTargetList(ctx, "", uint64(exportPageSize), tt.expectFilters).Return(tt.responseTargets, nil)
+
+			mockReq.EXPECT().StorageV1FileUploadURICreate(ctx).Return(testUploadURI(srv), nil)
+			mockReq.EXPECT().StorageV1FileCreate(ctx, tt.job.CustomerID, uuid.Nil, smfile.ReferenceTypeNone, uuid.Nil, smfile.TypeNone, gomock.Any(), gomock.Any(), gomock.Any(), testUploadBucketName, testUploadFilepath, 60000).Return(tt.responseFile, nil)
+
+			if err := h.exportFile(ctx, tt.job); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if content := uploaded(); content != tt.expectContent {
+				t.Errorf("Wrong match.\nexpect: %s\ngot: %s", tt.expectContent, content)
+			}
+
+			if tt.job.FileID != tt.responseFile.ID || tt.job.SuccessCount != len(tt.responseTargets) {
+				t.Errorf("Wrong match. expect: %s/%d, got: %s/%d", tt.responseFile.ID, len(tt.responseTargets), tt.job.FileID, tt.job.SuccessCount)
+			}
+		})
+	}
+}
+
+func Test_exportRecord_importable(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		target *outdialtarget.OutdialTarget
+	}{
+		{
+			name: "normal",
+
+			target: &outdialtarget.OutdialTarget{
+				ID:       uuid.FromStringOrNil("4a0b1c2d-2d4e-11f1-9fcd-3c4d5e6f7a01"),
+				Name:     "alice",
+				Detail:   "detail",
+				Data:     "data",
+				Timezone: "America/New_York",
+				Destination0: &commonaddress.Address{
+					Type:   commonaddress.TypeTel,
+					Target: "+12125550100",
+				},
+				Destination2: &commonaddress.Address{
+					Type:   commonaddress.TypeTel,
+					Target: "+12125550102",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			columns, err := resolveColumns(exportHeader, nil)
+			if err != nil {
+				t.Fatalf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			row, reason := parseRow(exportRecord(tt.target), columns)
+			if reason != "" {
+				t.Fatalf("Wrong match. expect: ok, got: %s", reason)
+			}
+
+			res := []string{row.name, row.detail, row.data, row.timezone}
+			expectRes := []string{tt.target.Name, tt.target.Detail, tt.target.Data, tt.target.Timezone}
+			if !reflect.DeepEqual(res, expectRes) {
+				t.Errorf("Wrong match. expect: %s, got: %s", strings.Join(expectRes, ","), strings.Join(res, ","))
+			}
+
+			if !reflect.DeepEqual(row.destinations[0], tt.target.Destination0) || row.destinations[1] != nil || !reflect.DeepEqual(row.destinations[2], tt.target.Destination2) {
+				t.Errorf("Wrong match. expect: %v, got: %v", tt.target, row.destinations)
+			}
+		})
+	}
+}
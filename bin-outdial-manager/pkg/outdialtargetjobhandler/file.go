@@ -0,0 +1,102 @@
+package outdialtargetjobhandler
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+
+	smfile "monorepo/bin-storage-manager/models/file"
+
+	"github.com/gofrs/uuid"
+
+	"monorepo/bin-outdial-manager/models/outdialtargetjob"
+)
+
+// downloadFile downloads the storage-manager file's content to the temp file using the file's signed download uri.
+// The caller must remove the returned temp file.
+func (h *outdialTargetJobHandler) downloadFile(ctx context.Context, fileID uuid.UUID) (string, error) {
+	uri, err := h.reqHandler.StorageV1FileDownloadURIRefresh(ctx, fileID)
+	if err != nil {
+		return "", fmt.Errorf("could not get the download uri: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return "", fmt.Errorf("could not create the download request: %w", err)
+	}
+
+	resp, err := h.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("could not download the file: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("could not download the file. status_code: %d", resp.StatusCode)
+	}
+
+	tmpFile, err := os.CreateTemp("", "outdial_download_*")
+	if err != nil {
+		return "", fmt.Errorf("could not create temp file: %w", err)
+	}
+
+	// the file size was checked on the import. the limit guards the temp disk from the changed file.
+	written, err := io.Copy(tmpFile, io.LimitReader(resp.Body, maxImportFileSize+1))
+	if errClose := tmpFile.Close(); err == nil {
+		err = errClose
+	}
+	if err == nil && written > maxImportFileSize {
+		err = fmt.Errorf("the file is larger than %d bytes", maxImportFileSize)
+	}
+	if err != nil {
+		_ = os.Remove(tmpFile.Name())
+		return "", fmt.Errorf("could not download the file: %w", err)
+	}
+
+	return tmpFile.Name(), nil
+}
+
+// storeFile uploads the given local file with the storage-manager's signed upload uri
+// and creates the storage-manager file of the job's customer.
+func (h *outdialTargetJobHandler) storeFile(ctx context.Context, j *outdialtargetjob.OutdialTargetJob, localPath string, name string, filename string) (uuid.UUID, error) {
+	src, err := os.Open(localPath)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("could not open the local file: %w", err)
+	}
+	defer func() { _ = src.Close() }()
+
+	info, err := src.Stat()
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("could not stat the local file: %w", err)
+	}
+
+	u, err := h.reqHandler.StorageV1FileUploadURICreate(ctx)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("could not get the upload uri: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, u.URI, src)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("could not create the upload request: %w", err)
+	}
+	req.ContentLength = info.Size()
+
+	resp, err := h.httpClient.Do(req)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("could not upload the file: %w", err)
+	}
+	_ = resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return uuid.Nil, fmt.Errorf("could not upload the file. status_code: %d", resp.StatusCode)
+	}
+
+	f, err := h.reqHandler.StorageV1FileCreate(ctx, j.CustomerID, uuid.Nil, smfile.ReferenceTypeNone, uuid.Nil, smfile.TypeNone, name, fmt.Sprintf("outdial_id: %s, outdialtargetjob_id: %s", j.OutdialID, j.ID), filename, u.BucketName, u.Filepath, 60000)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("could not create the file: %w", err)
+	}
+
+	return f.ID, nil
+}
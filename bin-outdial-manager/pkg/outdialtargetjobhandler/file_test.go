@@ -0,0 +1,129 @@
+package outdialtargetjobhandler
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"testing"
+
+	"monorepo/bin-common-handler/pkg/requesthandler"
+	smfile "monorepo/bin-storage-manager/models/file"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+const (
+	testUploadBucketName = "test-bucket-tmp"
+	testUploadFilepath   = "tmp/7c2d3e4f-ad73-11f0-8a1b-2c3d4e5f6a01"
+)
+
+// newTestStorageServer returns the fake signed uri server.
+// GET /download returns the given content and PUT /upload stores the body, returned by the returned func.
+func newTestStorageServer(t *testing.T, content string) (*httptest.Server, func() string) {
+	t.Helper()
+
+	var mu sync.Mutex
+	uploaded := ""
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/download":
+			_, _ = io.WriteString(w, content)
+
+		case r.Method == http.MethodPut && r.URL.Path == "/upload":
+			b, _ := io.ReadAll(r.Body)
+			mu.Lock()
+			uploaded = string(b)
+			mu.Unlock()
+
+		default:
+			w.WriteHeader(http.StatusForbidden)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv, func() string {
+		mu.Lock()
+		defer mu.Unlock()
+		return uploaded
+	}
+}
+
+// testUploadURI returns the upload uri of the given fake server.
+func testUploadURI(srv *httptest.Server) *smfile.UploadURI {
+	return &smfile.UploadURI{
+		BucketName: testUploadBucketName,
+		Filepath:   testUploadFilepath,
+		URI:        srv.URL + "/upload",
+	}
+}
+
+func Test_downloadFile(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		fileID  uuid.UUID
+		content string
+		path    string
+
+		expectErr bool
+	}{
+		{
+			name: "normal",
+
+			fileID:  uuid.FromStringOrNil("7c5f6071-ad73-11f0-9b2c-3d4e5f6a7b02"),
+			content: "name,destination_0\nalice,+821100000001\n",
+			path:    "/download",
+		},
+		{
+			name: "signed uri rejected",
+
+			fileID: uuid.FromStringOrNil("7c918293-ad73-11f0-ac3d-4e5f6a7b8c03"),
+			path:   "/expired",
+
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			srv, _ := newTestStorageServer(t, tt.content)
+
+			h := &outdialTargetJobHandler{
+				reqHandler: mockReq,
+				httpClient: srv.Client(),
+			}
+			ctx := context.Background()
+
+			mockReq.EXPECT().StorageV1FileDownloadURIRefresh(ctx, tt.fileID).Return(srv.URL+tt.path, nil)
+
+			res, err := h.downloadFile(ctx, tt.fileID)
+			if tt.expectErr {
+				if err == nil {
+					t.Errorf("Wrong match. expect: error, got: ok")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Wrong match. expect: ok, got: %v", err)
+			}
+			defer func() { _ = os.Remove(res) }()
+
+			b, err := os.ReadFile(res)
+			if err != nil {
+				t.Fatalf("Could not read the downloaded file. err: %v", err)
+			}
+			if string(b) != tt.content {
+				t.Errorf("Wrong match.\nexpect: %s\ngot: %s", tt.content, string(b))
+			}
+		})
+	}
+}
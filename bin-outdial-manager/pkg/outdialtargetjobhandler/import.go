@@ -0,0 +1,396 @@
+package outdialtargetjobhandler
+
+import (
+	"context"
+	"encoding/csv"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	commonaddress "monorepo/bin-common-handler/models/address"
+	cerrors "monorepo/bin-common-handler/models/errors"
+	commonoutline "monorepo/bin-common-handler/models/outline"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+
+	"monorepo/bin-outdial-manager/models/outdialtarget"
+	"monorepo/bin-outdial-manager/models/outdialtargetjob"
+)
+
+const (
+	progressInterval = 1000 // the number of rows between the progress updates
+)
+
+// importColumns defines the column indexes of the outdial target's fields.
+// -1 means the field is not in the csv file.
+type importColumns struct {
+	name         int
+	detail       int
+	data         int
+	timezone     int
+	destinations [5]int
+}
+
+// importRow defines the parsed csv row.
+type importRow struct {
+	name         string
+	detail       string
+	data         string
+	timezone     string
+	destinations [5]*commonaddress.Address
+}
+
+// importRun downloads the job's csv file and creates the outdial targets.
+func (h *outdialTargetJobHandler) importRun(ctx context.Context, j *outdialtargetjob.OutdialTargetJob) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":                "importRun",
+		"outdialtargetjob_id": j.ID,
+	})
+
+	tmpPath, err := h.downloadFile(ctx, j.FileID)
+	if err != nil {
+		log.Errorf("Could not download the file. err: %v", err)
+		h.finish(ctx, j, outdialtargetjob.StatusFailed, "Could not download the file.")
+		return
+	}
+	defer func() { _ = os.Remove(tmpPath) }()
+
+	if errImport := h.importFile(ctx, j, tmpPath); errImport != nil {
+		log.Errorf("Could not import the file. err: %v", errImport)
+		h.finish(ctx, j, outdialtargetjob.StatusFailed, failedDetail(errImport, "Could not import the file."))
+		return
+	}
+	log.Debugf("Imported the file. success: %d, duplicate: %d, error: %d", j.SuccessCount, j.DuplicateCount, j.ErrorCount)
+
+	h.finish(ctx, j, outdialtargetjob.StatusDone, "")
+}
+
+// importFile creates the outdial targets from the given csv file.
+// The invalid rows are written to the error report file.
+// The invalid argument error's message is shown to the customer as the job's detail.
+func (h *outdialTargetJobHandler) importFile(ctx context.Context, j *outdialtargetjob.OutdialTargetJob, path string) error {
+	log := logrus.WithFields(logrus.Fields{
+		"func":                "importFile",
+		"outdialtargetjob_id": j.ID,
+	})
+
+	total, err := countRows(path)
+	if err != nil {
+		log.Errorf("Could not count the rows. err: %v", err)
+		return fmt.Errorf("could not read the csv file: %w", err)
+	}
+	j.TotalCount = total
+	h.updateProgress(ctx, j)
+
+	src, err := os.Open(path)
+	if err != nil {
+		log.Errorf("Could not open the file. err: %v", err)
+		return fmt.Errorf("could not read the csv file: %w", err)
+	}
+	defer func() { _ = src.Close() }()
+
+	r := newCSVReader(src)
+	header, err := r.Read()
+	if err != nil {
+		log.Errorf("Could not read the header. err: %v", err)
+		return cerrors.InvalidArgument(commonoutline.ServiceNameOutdialManager, "INVALID_CSV", "Could not read the csv header.").Wrap(err)
+	}
+
+	columns, err := resolveColumns(header, j.Mapping)
+	if err != nil {
+		return err
+	}
+
+	// error report
+	reportFile, err := os.CreateTemp("", "outdial_report_*")
+	if err != nil {
+		log.Errorf("Could not create the error report file. err: %v", err)
+		return fmt.Errorf("could not create the error report: %w", err)
+	}
+	defer func() {
+		_ = reportFile.Close()
+		_ = os.Remove(reportFile.Name())
+	}()
+	report := csv.NewWriter(reportFile)
+	if errWrite := report.Write(append([]string{"row", "reason"}, header...)); errWrite != nil {
+		log.Errorf("Could not write the error report header. err: %v", errWrite)
+		return fmt.Errorf("could not create the error report: %w", errWrite)
+	}
+
+	// the rows of the outdial's existing targets are duplicates.
+	// it also makes the import resumable after the restart.
+	seen, err := h.existingDestinations(ctx, j.OutdialID)
+	if err != nil {
+		log.Errorf("Could not get the existing targets. err: %v", err)
+		return fmt.Errorf("could not get the existing targets: %w", err)
+	}
+
+	for rowNumber := 2; ; rowNumber++ {
+		record, errRead := r.Read()
+		if errRead == io.EOF {
+			break
+		}
+
+		reason := ""
+		var row *importRow
+		var parseErr *csv.ParseError
+		switch {
+		case errRead == nil:
+			row, reason = parseRow(record, columns)
+
+		case stderrors.As(errRead, &parseErr):
+			reason = "Malformed csv row."
+
+		default:
+			log.Errorf("Could not read the row. row: %d, err: %v", rowNumber, errRead)
+			return fmt.Errorf("could not read the csv file: %w", errRead)
+		}
+
+		if reason == "" && seen[row.destinations[0].Target] {
+			j.DuplicateCount++
+			promOutdialTargetJobRowTotal.WithLabelValues(string(j.Type), "duplicate").Inc()
+		} else if reason == "" {
+			if errCreate := h.importTarget(ctx, j, row); errCreate != nil {
+				log.Errorf("Could not create the outdial target. row: %d, err: %v", rowNumber, errCreate)
+				return fmt.Errorf("could not create the outdial target. row: %d, err: %w", rowNumber, errCreate)
+			}
+			seen[row.destinations[0].Target] = true
+			j.SuccessCount++
+			promOutdialTargetJobRowTotal.WithLabelValues(string(j.Type), "success").Inc()
+		} else {
+			if errWrite := report.Write(append([]string{strconv.Itoa(rowNumber), reason}, record...)); errWrite != nil {
+				log.Errorf("Could not write the error report. err: %v", errWrite)
+				return fmt.Errorf("could not create the error report: %w", errWrite)
+			}
+			j.ErrorCount++
+			promOutdialTargetJobRowTotal.WithLabelValues(string(j.Type), "error").Inc()
+		}
+
+		j.ProcessedCount++
+		if j.ProcessedCount%progressInterval == 0 {
+			h.updateProgress(ctx, j)
+		}
+	}
+
+	report.Flush()
+	if errFlush := report.Error(); errFlush != nil {
+		log.Errorf("Could not flush the error report. err: %v", errFlush)
+		return fmt.Errorf("could not create the error report: %w", errFlush)
+	}
+
+	if j.ErrorCount == 0 {
+		return nil
+	}
+
+	fileID, err := h.storeFile(ctx, j, reportFile.Name(), "outdial target import errors", fmt.Sprintf("outdialtargetjob_%s_errors.csv", j.ID))
+	if err != nil {
+		log.Errorf("Could not store the error report. err: %v", err)
+		return fmt.Errorf("could not store the error report: %w", err)
+	}
+	j.ErrorFileID = fileID
+
+	return nil
+}
+
+// existingDestinations returns the destination_0 targets of the outdial's targets.
+func (h *outdialTargetJobHandler) existingDestinations(ctx context.Context, outdialID uuid.UUID) (map[string]bool, error) {
+	res := map[string]bool{}
+
+	filters := map[outdialtarget.Field]any{
+		outdialtarget.FieldOutdialID: outdialID,
+		outdialtarget.FieldDeleted:   false,
+	}
+	token := ""
+	for {
+		targets, err := h.db.OutdialTargetList(ctx, token, exportPageSize, filters)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, t := range targets {
+			if t.Destination0 != nil {
+				res[t.Destination0.Target] = true
+			}
+		}
+
+		if len(targets) < exportPageSize || targets[len(targets)-1].TMCreate == nil {
+			break
+		}
+		token = targets[len(targets)-1].TMCreate.UTC().Format(utilhandler.ISO8601Layout)
+	}
+
+	return res, nil
+}
+
+// importTarget creates the outdial target of the given row.
+func (h *outdialTargetJobHandler) importTarget(ctx context.Context, j *outdialtargetjob.OutdialTargetJob, row *importRow) error {
+	_, err := h.outdialTargetHandler.Create(
+		ctx,
+		j.OutdialID,
+		row.name,
+		row.detail,
+		row.data,
+		row.timezone,
+		row.destinations[0],
+		row.destinations[1],
+		row.destinations[2],
+		row.destinations[3],
+		row.destinations[4],
+	)
+	return err
+}
+
+// newCSVReader returns the csv reader which accepts the rows of the different field counts.
+func newCSVReader(src io.Reader) *csv.Reader {
+	res := csv.NewReader(src)
+	res.FieldsPerRecord = -1
+	res.TrimLeadingSpace = true
+
+	return res
+}
+
+// countRows returns the number of the data rows of the given csv file.
+func countRows(path string) (int, error) {
+	src, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = src.Close() }()
+
+	r := newCSVReader(src)
+	res := -1 // header
+	for {
+		_, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+
+		var parseErr *csv.ParseError
+		if err != nil && !stderrors.As(err, &parseErr) {
+			return 0, err
+		}
+		res++
+	}
+
+	if res < 0 {
+		return 0, nil
+	}
+	return res, nil
+}
+
+// resolveColumns returns the column indexes of the given header with the mapping.
+// Empty mapping field falls back to the default header name.
+func resolveColumns(header []string, mapping *outdialtargetjob.Mapping) (*importColumns, error) {
+	if mapping == nil {
+		mapping = &outdialtargetjob.Mapping{}
+	}
+
+	indexes := map[string]int{}
+	for i, name := range header {
+		if i == 0 {
+			name = strings.TrimPrefix(name, "\ufeff")
+		}
+		indexes[strings.TrimSpace(name)] = i
+	}
+
+	lookup := func(mapped string, defaultName string) (int, error) {
+		if mapped == "" {
+			if idx, ok := indexes[defaultName]; ok {
+				return idx, nil
+			}
+			return -1, nil
+		}
+
+		idx, ok := indexes[mapped]
+		if !ok {
+			return -1, cerrors.InvalidArgument(commonoutline.ServiceNameOutdialManager, "INVALID_MAPPING", fmt.Sprintf("The mapped column is not in the csv header. column: %s", mapped))
+		}
+		return idx, nil
+	}
+
+	res := &importColumns{}
+	targets := []struct {
+		dst         *int
+		mapped      string
+		defaultName string
+	}{
+		{&res.name, mapping.Name, outdialtargetjob.HeaderName},
+		{&res.detail, mapping.Detail, outdialtargetjob.HeaderDetail},
+		{&res.data, mapping.Data, outdialtargetjob.HeaderData},
+		{&res.timezone, mapping.Timezone, outdialtargetjob.HeaderTimezone},
+		{&res.destinations[0], mapping.Destination0, outdialtargetjob.HeaderDestination0},
+		{&res.destinations[1], mapping.Destination1, outdialtargetjob.HeaderDestination1},
+		{&res.destinations[2], mapping.Destination2, outdialtargetjob.HeaderDestination2},
+		{&res.destinations[3], mapping.Destination3, outdialtargetjob.HeaderDestination3},
+		{&res.destinations[4], mapping.Destination4, outdialtargetjob.HeaderDestination4},
+	}
+	for _, t := range targets {
+		idx, err := lookup(t.mapped, t.defaultName)
+		if err != nil {
+			return nil, err
+		}
+		*t.dst = idx
+	}
+
+	if res.destinations[0] < 0 {
+		return nil, cerrors.InvalidArgument(commonoutline.ServiceNameOutdialManager, "INVALID_MAPPING", fmt.Sprintf("The csv header has no %s column.", outdialtargetjob.HeaderDestination0))
+	}
+
+	return res, nil
+}
+
+// parseRow parses the given csv record.
+// Returns the reason if the record is invalid.
+func parseRow(record []string, columns *importColumns) (*importRow, string) {
+	value := func(idx int) string {
+		if idx < 0 || idx >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[idx])
+	}
+
+	res := &importRow{
+		name:     value(columns.name),
+		detail:   value(columns.detail),
+		data:     value(columns.data),
+		timezone: value(columns.timezone),
+	}
+
+	for i, idx := range columns.destinations {
+		v := value(idx)
+		if v == "" {
+			continue
+		}
+
+		target, err := commonaddress.NormalizeTarget(commonaddress.TypeTel, v)
+		if err == nil {
+			err = commonaddress.ValidateTarget(commonaddress.TypeTel, target)
+		}
+		if err != nil {
+			return nil, fmt.Sprintf("Invalid destination_%d. The destination must be a tel number in E.164 format.", i)
+		}
+
+		res.destinations[i] = &commonaddress.Address{
+			Type:   commonaddress.TypeTel,
+			Target: target,
+		}
+	}
+
+	if res.destinations[0] == nil {
+		return nil, "Empty destination_0."
+	}
+
+	if res.timezone != "" {
+		if _, err := time.LoadLocation(res.timezone); err != nil {
+			return nil, "Invalid timezone. The timezone must be a valid IANA time zone name."
+		}
+	}
+
+	return res, ""
+}
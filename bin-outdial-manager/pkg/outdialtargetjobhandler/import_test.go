@@ -0,0 +1,426 @@
+package outdialtargetjobhandler
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	commonaddress "monorepo/bin-common-handler/models/address"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/notifyhandler"
+	"monorepo/bin-common-handler/pkg/requesthandler"
+	smfile "monorepo/bin-storage-manager/models/file"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	"monorepo/bin-outdial-manager/models/outdialtarget"
+	"monorepo/bin-outdial-manager/models/outdialtargetjob"
+	"monorepo/bin-outdial-manager/pkg/dbhandler"
+	"monorepo/bin-outdial-manager/pkg/outdialtargethandler"
+)
+
+func Test_importFile(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		job     *outdialtargetjob.OutdialTargetJob
+		content string
+
+		responseExisting []*outdialtarget.OutdialTarget
+
+		expectCreates     [][]*commonaddress.Address
+		expectReport      bool
+		expectTotal       int
+		expectSuccess     int
+		expectDuplicate   int
+		expectError       int
+		expectErrorFileID uuid.UUID
+	}{
+		{
+			name: "default header names",
+
+			job: &outdialtargetjob.OutdialTargetJob{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("3c1f2e40-2d4a-11f1-9a01-1b2c3d4e5f01"),
+					CustomerID: uuid.FromStringOrNil("3c4d6e54-2d4a-11f1-ab12-2c3d4e5f6a02"),
+				},
+				OutdialID: uuid.FromStringOrNil("3c7a9068-2d4a-11f1-bc23-3d4e5f6a7b03"),
+				Type:      outdialtargetjob.TypeImport,
+				Mapping:   &outdialtargetjob.Mapping{},
+			},
+			content: "name,destination_0,destination_1\n" +
+				"alice,+821100000001,+821100000002\n" +
+				"bob,+82 1100-000003,\n",
+
+			expectCreates: [][]*commonaddress.Address{
+				{
+					{Type: commonaddress.TypeTel, Target: "+821100000001"},
+					{Type: commonaddress.TypeTel, Target: "+821100000002"},
+				},
+				{
+					{Type: commonaddress.TypeTel, Target: "+821100000003"},
+					nil,
+				},
+			},
+			expectTotal:   2,
+			expectSuccess: 2,
+		},
+		{
+			name: "mapped header names with invalid and duplicated rows",
+
+			job: &outdialtargetjob.OutdialTargetJob{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("6e0a1b2c-2d4a-11f1-8d34-4e5f6a7b8c01"),
+					CustomerID: uuid.FromStringOrNil("6e373d40-2d4a-11f1-9e45-5f6a7b8c9d02"),
+				},
+				OutdialID: uuid.FromStringOrNil("6e645f54-2d4a-11f1-af56-6a7b8c9d0e03"),
+				Type:      outdialtargetjob.TypeImport,
+				Mapping: &outdialtargetjob.Mapping{
+					Name:         "customer",
+					Destination0: "phone",
+					Destination1: "mobile",
+				},
+			},
+			content: "customer,phone,mobile\n" +
+				"alice,+821100000001,\n" +
+				"bob,abc,\n" +
+				"carol,,+821100000005\n" +
+				"alice again,+821100000001,\n",
+
+			expectCreates: [][]*commonaddress.Address{
+				{
+					{Type: commonaddress.TypeTel, Target: "+821100000001"},
+					nil,
+				},
+			},
+			expectReport:      true,
+			expectTotal:       4,
+			expectSuccess:     1,
+			expectDuplicate:   1,
+			expectError:       2,
+			expectErrorFileID: uuid.FromStringOrNil("6e918168-2d4a-11f1-b067-7b8c9d0e1f04"),
+		},
+		{
+			name: "rows of the existing targets are duplicated",
+
+			job: &outdialtargetjob.OutdialTargetJob{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("9a0b1c2d-ad72-11f0-8e4f-5a6b7c8d9e01"),
+					CustomerID: uuid.FromStringOrNil("9a3d4e5f-ad72-11f0-9f50-6b7c8d9e0f02"),
+				},
+				OutdialID: uuid.FromStringOrNil("9a6f7081-ad72-11f0-a061-7c8d9e0f1a03"),
+				Type:      outdialtargetjob.TypeImport,
+				Mapping:   &outdialtargetjob.Mapping{},
+			},
+			content: "name,destination_0\n" +
+				"alice,+821100000001\n" +
+				"bob,+821100000002\n",
+
+			responseExisting: []*outdialtarget.OutdialTarget{
+				{
+					ID: uuid.FromStringOrNil("9aa1b2c3-ad72-11f0-b172-8d9e0f1a2b04"),
+					Destination0: &commonaddress.Address{
+						Type:   commonaddress.TypeTel,
+						Target: "+821100000001",
+					},
+				},
+			},
+
+			expectCreates: [][]*commonaddress.Address{
+				{
+					{Type: commonaddress.TypeTel, Target: "+821100000002"},
+					nil,
+				},
+			},
+			expectTotal:     2,
+			expectSuccess:   1,
+			expectDuplicate: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+			mockTarget := outdialtargethandler.NewMockOutdialTargetHandler(mc)
+			srv, _ := newTestStorageServer(t, "")
+
+			h := &outdialTargetJobHandler{
+				db:                   mockDB,
+				reqHandler:           mockReq,
+				notifyHandler:        mockNotify,
+				outdialTargetHandler: mockTarget,
+				httpClient:           srv.Client(),
+			}
+			ctx := context.Background()
+
+			path := filepath.Join(t.TempDir(), "targets.csv")
+			if errWrite := os.WriteFile(path, []byte(tt.content), 0600); errWrite != nil {
+				t.Fatalf("Could not write the test file. err: %v", errWrite)
+			}
+
+			// total count update
+			mockDB.EXPECT().OutdialTargetJobUpdate(ctx, tt.job.ID, gomock.Any()).Return(nil)
+			mockDB.EXPECT().OutdialTargetJobGet(ctx, tt.job.ID).Return(tt.job, nil)
+			mockNotify.EXPECT().PublishWebhookEvent(ctx, tt.job.CustomerID, outdialtargetjob.EventTypeOutdialTargetJobUpdated, tt.job)
+
+			mockDB.EXPECT().OutdialTargetList(ctx, "", uint64(exportPageSize), map[outdialtarget.Field]any{
+				outdialtarget.FieldOutdialID: tt.job.OutdialID,
+				outdialtarget.FieldDeleted:   false,
+			}).Return(tt.responseExisting, nil)
+
+			for _, destinations := range tt.expectCreates {
+				mockTarget.EXPECT().Create(ctx, tt.job.OutdialID, gomock.Any(), "", "", "", destinations[0], destinations[1], nil, nil, nil).Return(&outdialtarget.OutdialTarget{}, nil)
+			}
+
+			if tt.expectReport {
+				mockReq.EXPECT().StorageV1FileUploadURICreate(ctx).Return(testUploadURI(srv), nil)
+				mockReq.EXPECT().StorageV1FileCreate(ctx, tt.job.CustomerID, uuid.Nil, smfile.ReferenceTypeNone, uuid.Nil, smfile.TypeNone, gomock.Any(), gomock.Any(), gomock.Any(), testUploadBucketName, testUploadFilepath, 60000).Return(&smfile.File{
+					Identity: commonidentity.Identity{
+						ID: tt.expectErrorFileID,
+					},
+				}, nil)
+			}
+
+			if err := h.importFile(ctx, tt.job, path); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if tt.job.TotalCount != tt.expectTotal || tt.job.SuccessCount != tt.expectSuccess || tt.job.DuplicateCount != tt.expectDuplicate || tt.job.ErrorCount != tt.expectError {
+				t.Errorf("Wrong match. expect: %d/%d/%d/%d, got: %d/%d/%d/%d", tt.expectTotal, tt.expectSuccess, tt.expectDuplicate, tt.expectError, tt.job.TotalCount, tt.job.SuccessCount, tt.job.DuplicateCount, tt.job.ErrorCount)
+			}
+
+			if tt.job.ErrorFileID != tt.expectErrorFileID {
+				t.Errorf("Wrong match. expect: %s, got: %s", tt.expectErrorFileID, tt.job.ErrorFileID)
+			}
+		})
+	}
+}
+
+func Test_resolveColumns(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		header  []string
+		mapping *outdialtargetjob.Mapping
+
+		expectRes *importColumns
+	}{
+		{
+			name: "default header names",
+
+			header:  []string{"\ufeffname", "data", " destination_0 ", "timezone"},
+			mapping: nil,
+
+			expectRes: &importColumns{
+				name:         0,
+				detail:       -1,
+				data:         1,
+				timezone:     3,
+				destinations: [5]int{2, -1, -1, -1, -1},
+			},
+		},
+		{
+			name: "mapped header names",
+
+			header: []string{"phone", "full name", "mobile", "detail"},
+			mapping: &outdialtargetjob.Mapping{
+				Name:         "full name",
+				Destination0: "phone",
+				Destination1: "mobile",
+			},
+
+			expectRes: &importColumns{
+				name:         1,
+				detail:       3,
+				data:         -1,
+				timezone:     -1,
+				destinations: [5]int{0, 2, -1, -1, -1},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := resolveColumns(tt.header, tt.mapping)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_resolveColumns_error(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		header  []string
+		mapping *outdialtargetjob.Mapping
+	}{
+		{
+			name: "no destination column",
+
+			header:  []string{"name", "phone"},
+			mapping: nil,
+		},
+		{
+			name: "mapped column is not in the header",
+
+			header: []string{"name", "destination_0"},
+			mapping: &outdialtargetjob.Mapping{
+				Data: "memo",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := resolveColumns(tt.header, tt.mapping); err == nil {
+				t.Errorf("Wrong match. expect: error, got: ok")
+			}
+		})
+	}
+}
+
+func Test_parseRow(t *testing.T) {
+
+	columns := &importColumns{
+		name:         0,
+		detail:       -1,
+		data:         1,
+		timezone:     2,
+		destinations: [5]int{3, 4, -1, -1, -1},
+	}
+
+	tests := []struct {
+		name string
+
+		record []string
+
+		expectRes    *importRow
+		expectReason string
+	}{
+		{
+			name: "normal",
+
+			record: []string{" alice ", "vip", "Asia/Seoul", "+82-1100-000001", ""},
+
+			expectRes: &importRow{
+				name:     "alice",
+				data:     "vip",
+				timezone: "Asia/Seoul",
+				destinations: [5]*commonaddress.Address{
+					{Type: commonaddress.TypeTel, Target: "+821100000001"},
+				},
+			},
+		},
+		{
+			name: "short record",
+
+			record: []string{"bob", "", "", "+821100000002"},
+
+			expectRes: &importRow{
+				name: "bob",
+				destinations: [5]*commonaddress.Address{
+					{Type: commonaddress.TypeTel, Target: "+821100000002"},
+				},
+			},
+		},
+		{
+			name: "empty destination_0",
+
+			record: []string{"carol", "", "", "", "+821100000003"},
+
+			expectReason: "Empty destination_0.",
+		},
+		{
+			name: "invalid destination",
+
+			record: []string{"dave", "", "", "+821100000004", "1234"},
+
+			expectReason: "Invalid destination_1. The destination must be a tel number in E.164 format.",
+		},
+		{
+			name: "invalid timezone",
+
+			record: []string{"erin", "", "Mars/Olympus", "+821100000005"},
+
+			expectReason: "Invalid timezone. The timezone must be a valid IANA time zone name.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, reason := parseRow(tt.record, columns)
+			if reason != tt.expectReason {
+				t.Errorf("Wrong match. expect: %s, got: %s", tt.expectReason, reason)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_countRows(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		content string
+
+		expectRes int
+	}{
+		{
+			name: "normal",
+
+			content:   "name,destination_0\nalice,+821100000001\nbob,+821100000002\n",
+			expectRes: 2,
+		},
+		{
+			name: "header only",
+
+			content:   "name,destination_0\n",
+			expectRes: 0,
+		},
+		{
+			name: "empty",
+
+			content:   "",
+			expectRes: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "targets.csv")
+			if errWrite := os.WriteFile(path, []byte(tt.content), 0600); errWrite != nil {
+				t.Fatalf("Could not write the test file. err: %v", errWrite)
+			}
+
+			res, err := countRows(path)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if res != tt.expectRes {
+				t.Errorf("Wrong match. expect: %d, got: %d", tt.expectRes, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package outdialtargetjobhandler
+
+//go:generate mockgen -package outdialtargetjobhandler -destination ./mock_outdialtargetjobhandler.go -source main.go -build_flags=-mod=mod
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"monorepo/bin-common-handler/pkg/notifyhandler"
+	"monorepo/bin-common-handler/pkg/requesthandler"
+
+	"github.com/gofrs/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"monorepo/bin-outdial-manager/models/outdialtargetjob"
+	"monorepo/bin-outdial-manager/pkg/dbhandler"
+	"monorepo/bin-outdial-manager/pkg/outdialtargethandler"
+)
+
+// outdialTargetJobHandler defines
+type outdialTargetJobHandler struct {
+	db                   dbhandler.DBHandler
+	reqHandler           requesthandler.RequestHandler
+	notifyHandler        notifyhandler.NotifyHandler
+	outdialTargetHandler outdialtargethandler.OutdialTargetHandler
+
+	httpClient *http.Client // downloads/uploads the files with the storage-manager's signed uris
+}
+
+// OutdialTargetJobHandler interface
+type OutdialTargetJobHandler interface {
+	Import(ctx context.Context, outdialID uuid.UUID, fileID uuid.UUID, mapping *outdialtargetjob.Mapping) (*outdialtargetjob.OutdialTargetJob, error)
+	Export(ctx context.Context, outdialID uuid.UUID) (*outdialtargetjob.OutdialTargetJob, error)
+
+	Get(ctx context.Context, id uuid.UUID) (*outdialtargetjob.OutdialTargetJob, error)
+	List(ctx context.Context, token string, limit uint64, filters map[outdialtargetjob.Field]any) ([]*outdialtargetjob.OutdialTargetJob, error)
+
+	RunRecovery(ctx context.Context, interval time.Duration)
+}
+
+// NewOutdialTargetJobHandler return OutdialTargetJobHandler
+func NewOutdialTargetJobHandler(
+	db dbhandler.DBHandler,
+	reqHandler requesthandler.RequestHandler,
+	notifyHandler notifyhandler.NotifyHandler,
+	outdialTargetHandler outdialtargethandler.OutdialTargetHandler,
+) OutdialTargetJobHandler {
+	h := &outdialTargetJobHandler{
+		db:                   db,
+		reqHandler:           reqHandler,
+		notifyHandler:        notifyHandler,
+		outdialTargetHandler: outdialTargetHandler,
+
+		httpClient: &http.Client{Timeout: fileTransferTimeout},
+	}
+
+	return h
+}
+
+const (
+	fileTransferTimeout = 10 * time.Minute // timeout of the file's download/upload
+)
+
+var (
+	metricsNamespace = "outdial_manager"
+
+	promOutdialTargetJobRowTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "outdialtargetjob_row_total",
+			Help:      "Total number of csv rows processed by the outdialtargetjobs.",
+		},
+		[]string{"type", "result"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(
+		promOutdialTargetJobRowTotal,
+	)
+}
@@ -0,0 +1,274 @@
+package outdialtargetjobhandler
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+
+	cerrors "monorepo/bin-common-handler/models/errors"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	commonoutline "monorepo/bin-common-handler/models/outline"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+
+	"monorepo/bin-outdial-manager/models/outdial"
+	"monorepo/bin-outdial-manager/models/outdialtargetjob"
+	"monorepo/bin-outdial-manager/pkg/dbhandler"
+)
+
+const (
+	maxImportFileSize = 100 * 1024 * 1024 // 100 MB
+)
+
+// Import starts a new job which creates the outdial targets from the given csv file.
+// The file must be a storage-manager file of the outdial's customer.
+func (h *outdialTargetJobHandler) Import(ctx context.Context, outdialID uuid.UUID, fileID uuid.UUID, mapping *outdialtargetjob.Mapping) (*outdialtargetjob.OutdialTargetJob, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":       "Import",
+		"outdial_id": outdialID,
+		"file_id":    fileID,
+	})
+
+	o, err := h.outdialGet(ctx, outdialID)
+	if err != nil {
+		log.Errorf("Could not get the outdial. err: %v", err)
+		return nil, err
+	}
+
+	f, err := h.reqHandler.StorageV1FileGet(ctx, fileID)
+	if err != nil {
+		log.Errorf("Could not get the file. err: %v", err)
+		return nil, cerrors.InvalidArgument(
+			commonoutline.ServiceNameOutdialManager,
+			"INVALID_FILE",
+			"Could not get the file.",
+		).Wrap(err)
+	}
+
+	if f.CustomerID != o.CustomerID || f.TMDelete != nil {
+		log.WithField("file", f).Infof("The file does not belong to the outdial's customer. file_customer_id: %s", f.CustomerID)
+		return nil, cerrors.InvalidArgument(
+			commonoutline.ServiceNameOutdialManager,
+			"INVALID_FILE",
+			"Could not get the file.",
+		)
+	}
+
+	if f.Filesize > maxImportFileSize {
+		return nil, cerrors.InvalidArgument(
+			commonoutline.ServiceNameOutdialManager,
+			"FILE_TOO_LARGE",
+			fmt.Sprintf("The file must not be larger than %d bytes.", maxImportFileSize),
+		)
+	}
+
+	if mapping == nil {
+		mapping = &outdialtargetjob.Mapping{}
+	}
+
+	res, err := h.create(ctx, o, outdialtargetjob.TypeImport, fileID, mapping)
+	if err != nil {
+		log.Errorf("Could not create the outdialtargetjob. err: %v", err)
+		return nil, err
+	}
+	log.WithField("outdialtargetjob", res).Debugf("Created the import outdialtargetjob. outdialtargetjob_id: %s", res.ID)
+
+	go h.run(res)
+
+	return res, nil
+}
+
+// Export starts a new job which writes the outdial's targets to the csv file.
+// The exported file uses the default header names, so it can be imported without the mapping.
+func (h *outdialTargetJobHandler) Export(ctx context.Context, outdialID uuid.UUID) (*outdialtargetjob.OutdialTargetJob, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":       "Export",
+		"outdial_id": outdialID,
+	})
+
+	o, err := h.outdialGet(ctx, outdialID)
+	if err != nil {
+		log.Errorf("Could not get the outdial. err: %v", err)
+		return nil, err
+	}
+
+	res, err := h.create(ctx, o, outdialtargetjob.TypeExport, uuid.Nil, nil)
+	if err != nil {
+		log.Errorf("Could not create the outdialtargetjob. err: %v", err)
+		return nil, err
+	}
+	log.WithField("outdialtargetjob", res).Debugf("Created the export outdialtargetjob. outdialtargetjob_id: %s", res.ID)
+
+	go h.run(res)
+
+	return res, nil
+}
+
+// Get returns the outdialtargetjob.
+func (h *outdialTargetJobHandler) Get(ctx context.Context, id uuid.UUID) (*outdialtargetjob.OutdialTargetJob, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":                "Get",
+		"outdialtargetjob_id": id,
+	})
+
+	res, err := h.db.OutdialTargetJobGet(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get outdialtargetjob. err: %v", err)
+		if stderrors.Is(err, dbhandler.ErrNotFound) {
+			return nil, cerrors.NotFound(
+				commonoutline.ServiceNameOutdialManager,
+				"OUTDIALTARGETJOB_NOT_FOUND",
+				"The outdialtargetjob was not found.",
+			).Wrap(err)
+		}
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// List returns list of outdialtargetjobs with filters.
+func (h *outdialTargetJobHandler) List(ctx context.Context, token string, limit uint64, filters map[outdialtargetjob.Field]any) ([]*outdialtargetjob.OutdialTargetJob, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":    "List",
+		"token":   token,
+		"limit":   limit,
+		"filters": filters,
+	})
+	log.Debug("Getting outdialtargetjobs with filters.")
+
+	res, err := h.db.OutdialTargetJobList(ctx, token, limit, filters)
+	if err != nil {
+		log.Errorf("Could not get outdialtargetjobs. err: %v", err)
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// create creates a new outdialtargetjob of the given outdial.
+func (h *outdialTargetJobHandler) create(ctx context.Context, o *outdial.Outdial, jobType outdialtargetjob.Type, fileID uuid.UUID, mapping *outdialtargetjob.Mapping) (*outdialtargetjob.OutdialTargetJob, error) {
+	id := uuid.Must(uuid.NewV4())
+	j := &outdialtargetjob.OutdialTargetJob{
+		Identity: commonidentity.Identity{
+			ID:         id,
+			CustomerID: o.CustomerID,
+		},
+		OutdialID: o.ID,
+
+		Type:   jobType,
+		Status: outdialtargetjob.StatusProcessing,
+
+		FileID:  fileID,
+		Mapping: mapping,
+
+		TMCreate: utilhandler.TimeNow(),
+		TMUpdate: nil,
+		TMDelete: nil,
+	}
+
+	if errCreate := h.db.OutdialTargetJobCreate(ctx, j); errCreate != nil {
+		return nil, errCreate
+	}
+
+	res, err := h.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	h.notifyHandler.PublishWebhookEvent(ctx, res.CustomerID, outdialtargetjob.EventTypeOutdialTargetJobCreated, res)
+
+	return res, nil
+}
+
+// outdialGet returns the outdial.
+func (h *outdialTargetJobHandler) outdialGet(ctx context.Context, id uuid.UUID) (*outdial.Outdial, error) {
+	res, err := h.db.OutdialGet(ctx, id)
+	if err != nil {
+		if stderrors.Is(err, dbhandler.ErrNotFound) {
+			return nil, cerrors.NotFound(
+				commonoutline.ServiceNameOutdialManager,
+				"OUTDIAL_NOT_FOUND",
+				"The outdial was not found.",
+			).Wrap(err)
+		}
+		return nil, err
+	}
+
+	if res.TMDelete != nil {
+		return nil, cerrors.NotFound(
+			commonoutline.ServiceNameOutdialManager,
+			"OUTDIAL_NOT_FOUND",
+			"The outdial was not found.",
+		)
+	}
+
+	return res, nil
+}
+
+// updateProgress stores the job's counts and notifies the progress.
+func (h *outdialTargetJobHandler) updateProgress(ctx context.Context, j *outdialtargetjob.OutdialTargetJob) {
+	fields := map[outdialtargetjob.Field]any{
+		outdialtargetjob.FieldTotalCount:     j.TotalCount,
+		outdialtargetjob.FieldProcessedCount: j.ProcessedCount,
+		outdialtargetjob.FieldSuccessCount:   j.SuccessCount,
+		outdialtargetjob.FieldDuplicateCount: j.DuplicateCount,
+		outdialtargetjob.FieldErrorCount:     j.ErrorCount,
+	}
+	h.update(ctx, j, fields)
+}
+
+// finish stores the job's result with the given status.
+func (h *outdialTargetJobHandler) finish(ctx context.Context, j *outdialtargetjob.OutdialTargetJob, status outdialtargetjob.Status, detail string) {
+	j.Status = status
+	j.Detail = detail
+
+	fields := map[outdialtargetjob.Field]any{
+		outdialtargetjob.FieldStatus: status,
+
+		outdialtargetjob.FieldFileID: j.FileID,
+
+		outdialtargetjob.FieldTotalCount:     j.TotalCount,
+		outdialtargetjob.FieldProcessedCount: j.ProcessedCount,
+		outdialtargetjob.FieldSuccessCount:   j.SuccessCount,
+		outdialtargetjob.FieldDuplicateCount: j.DuplicateCount,
+		outdialtargetjob.FieldErrorCount:     j.ErrorCount,
+
+		outdialtargetjob.FieldErrorFileID: j.ErrorFileID,
+
+		outdialtargetjob.FieldDetail: detail,
+	}
+	h.update(ctx, j, fields)
+}
+
+// update updates the job's fields and publishes the updated event.
+func (h *outdialTargetJobHandler) update(ctx context.Context, j *outdialtargetjob.OutdialTargetJob, fields map[outdialtargetjob.Field]any) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":                "update",
+		"outdialtargetjob_id": j.ID,
+	})
+
+	if errUpdate := h.db.OutdialTargetJobUpdate(ctx, j.ID, fields); errUpdate != nil {
+		log.Errorf("Could not update the outdialtargetjob. err: %v", errUpdate)
+		return
+	}
+
+	res, err := h.Get(ctx, j.ID)
+	if err != nil {
+		log.Errorf("Could not get updated outdialtargetjob. err: %v", err)
+		return
+	}
+	h.notifyHandler.PublishWebhookEvent(ctx, res.CustomerID, outdialtargetjob.EventTypeOutdialTargetJobUpdated, res)
+}
+
+// failedDetail returns the job's detail of the given failure.
+// Only the invalid argument's message is shown to the customer.
+func failedDetail(err error, defaultDetail string) string {
+	var ve *cerrors.VoipbinError
+	if stderrors.As(err, &ve) && ve.Status == cerrors.StatusInvalidArgument {
+		return ve.Message
+	}
+
+	return defaultDetail
+}
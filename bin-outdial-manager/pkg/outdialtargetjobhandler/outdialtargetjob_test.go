@@ -0,0 +1,252 @@
+package outdialtargetjobhandler
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/notifyhandler"
+	"monorepo/bin-common-handler/pkg/requesthandler"
+	smfile "monorepo/bin-storage-manager/models/file"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	"monorepo/bin-outdial-manager/models/outdial"
+	"monorepo/bin-outdial-manager/models/outdialtargetjob"
+	"monorepo/bin-outdial-manager/pkg/dbhandler"
+)
+
+func Test_Import_error(t *testing.T) {
+
+	tmDelete := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+
+		outdialID uuid.UUID
+		fileID    uuid.UUID
+
+		responseOutdial    *outdial.Outdial
+		responseOutdialErr error
+		responseFile       *smfile.File
+		responseFileErr    error
+	}{
+		{
+			name: "outdial not found",
+
+			outdialID: uuid.FromStringOrNil("a10e2f30-2d4c-11f1-8b01-1c2d3e4f5a01"),
+			fileID:    uuid.FromStringOrNil("a13b4144-2d4c-11f1-9c12-2d3e4f5a6b02"),
+
+			responseOutdialErr: dbhandler.ErrNotFound,
+		},
+		{
+			name: "file not found",
+
+			outdialID: uuid.FromStringOrNil("a10e2f30-2d4c-11f1-8b01-1c2d3e4f5a01"),
+			fileID:    uuid.FromStringOrNil("a13b4144-2d4c-11f1-9c12-2d3e4f5a6b02"),
+
+			responseOutdial: &outdial.Outdial{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("a10e2f30-2d4c-11f1-8b01-1c2d3e4f5a01"),
+					CustomerID: uuid.FromStringOrNil("a1686358-2d4c-11f1-ad23-3e4f5a6b7c03"),
+				},
+			},
+			responseFileErr: fmt.Errorf("not found"),
+		},
+		{
+			name: "file of the other customer",
+
+			outdialID: uuid.FromStringOrNil("a10e2f30-2d4c-11f1-8b01-1c2d3e4f5a01"),
+			fileID:    uuid.FromStringOrNil("a13b4144-2d4c-11f1-9c12-2d3e4f5a6b02"),
+
+			responseOutdial: &outdial.Outdial{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("a10e2f30-2d4c-11f1-8b01-1c2d3e4f5a01"),
+					CustomerID: uuid.FromStringOrNil("a1686358-2d4c-11f1-ad23-3e4f5a6b7c03"),
+				},
+			},
+			responseFile: &smfile.File{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("a13b4144-2d4c-11f1-9c12-2d3e4f5a6b02"),
+					CustomerID: uuid.FromStringOrNil("a195856c-2d4c-11f1-be34-4f5a6b7c8d04"),
+				},
+			},
+		},
+		{
+			name: "deleted file",
+
+			outdialID: uuid.FromStringOrNil("a10e2f30-2d4c-11f1-8b01-1c2d3e4f5a01"),
+			fileID:    uuid.FromStringOrNil("a13b4144-2d4c-11f1-9c12-2d3e4f5a6b02"),
+
+			responseOutdial: &outdial.Outdial{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("a10e2f30-2d4c-11f1-8b01-1c2d3e4f5a01"),
+					CustomerID: uuid.FromStringOrNil("a1686358-2d4c-11f1-ad23-3e4f5a6b7c03"),
+				},
+			},
+			responseFile: &smfile.File{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("a13b4144-2d4c-11f1-9c12-2d3e4f5a6b02"),
+					CustomerID: uuid.FromStringOrNil("a1686358-2d4c-11f1-ad23-3e4f5a6b7c03"),
+				},
+				TMDelete: &tmDelete,
+			},
+		},
+		{
+			name: "too large file",
+
+			outdialID: uuid.FromStringOrNil("a10e2f30-2d4c-11f1-8b01-1c2d3e4f5a01"),
+			fileID:    uuid.FromStringOrNil("a13b4144-2d4c-11f1-9c12-2d3e4f5a6b02"),
+
+			responseOutdial: &outdial.Outdial{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("a10e2f30-2d4c-11f1-8b01-1c2d3e4f5a01"),
+					CustomerID: uuid.FromStringOrNil("a1686358-2d4c-11f1-ad23-3e4f5a6b7c03"),
+				},
+			},
+			responseFile: &smfile.File{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("a13b4144-2d4c-11f1-9c12-2d3e4f5a6b02"),
+					CustomerID: uuid.FromStringOrNil("a1686358-2d4c-11f1-ad23-3e4f5a6b7c03"),
+				},
+				Filesize: maxImportFileSize + 1,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+
+			h := &outdialTargetJobHandler{
+				db:            mockDB,
+				reqHandler:    mockReq,
+				notifyHandler: mockNotify,
+			}
+			ctx := context.Background()
+
+			mockDB.EXPECT().OutdialGet(ctx, tt.outdialID).Return(tt.responseOutdial, tt.responseOutdialErr)
+			if tt.responseOutdialErr == nil {
+				mockReq.EXPECT().StorageV1FileGet(ctx, tt.fileID).Return(tt.responseFile, tt.responseFileErr)
+			}
+
+			if _, err := h.Import(ctx, tt.outdialID, tt.fileID, nil); err == nil {
+				t.Errorf("Wrong match. expect: error, got: ok")
+			}
+		})
+	}
+}
+
+func Test_Get(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		id uuid.UUID
+
+		responseJob *outdialtargetjob.OutdialTargetJob
+	}{
+		{
+			name: "normal",
+
+			id: uuid.FromStringOrNil("d20a3b4c-2d4c-11f1-8f45-5a6b7c8d9e01"),
+
+			responseJob: &outdialtargetjob.OutdialTargetJob{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("d20a3b4c-2d4c-11f1-8f45-5a6b7c8d9e01"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+
+			h := &outdialTargetJobHandler{
+				db: mockDB,
+			}
+			ctx := context.Background()
+
+			mockDB.EXPECT().OutdialTargetJobGet(ctx, tt.id).Return(tt.responseJob, nil)
+
+			res, err := h.Get(ctx, tt.id)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.responseJob) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.responseJob, res)
+			}
+		})
+	}
+}
+
+func Test_List(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		token   string
+		limit   uint64
+		filters map[outdialtargetjob.Field]any
+
+		responseJobs []*outdialtargetjob.OutdialTargetJob
+	}{
+		{
+			name: "normal",
+
+			token: "2026-01-01T00:00:00.000000Z",
+			limit: 10,
+			filters: map[outdialtargetjob.Field]any{
+				outdialtargetjob.FieldOutdialID: uuid.FromStringOrNil("d24f6d70-2d4c-11f1-a056-6b7c8d9e0f02"),
+				outdialtargetjob.FieldDeleted:   false,
+			},
+
+			responseJobs: []*outdialtargetjob.OutdialTargetJob{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("d27c8f84-2d4c-11f1-b167-7c8d9e0f1a03"),
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+
+			h := &outdialTargetJobHandler{
+				db: mockDB,
+			}
+			ctx := context.Background()
+
+			mockDB.EXPECT().OutdialTargetJobList(ctx, tt.token, tt.limit, tt.filters).Return(tt.responseJobs, nil)
+
+			res, err := h.List(ctx, tt.token, tt.limit, tt.filters)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.responseJobs) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.responseJobs, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package outdialtargetjobhandler
+
+import (
+	"context"
+	"time"
+
+	"monorepo/bin-common-handler/pkg/utilhandler"
+
+	"github.com/sirupsen/logrus"
+
+	"monorepo/bin-outdial-manager/models/outdialtargetjob"
+)
+
+const (
+	jobHeartbeatInterval = time.Minute     // the running job touches its tm_update every interval
+	jobStaleTimeout      = 5 * time.Minute // the processing job without the heartbeat for the timeout is considered abandoned
+
+	recoveryPageSize = 100
+)
+
+// run runs the job in the background with the heartbeat.
+// The job is resumable, because the import skips the targets already created.
+func (h *outdialTargetJobHandler) run(j *outdialtargetjob.OutdialTargetJob) {
+	ctx := context.Background()
+
+	ctxHeartbeat, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go h.heartbeat(ctxHeartbeat, j)
+
+	switch j.Type {
+	case outdialtargetjob.TypeImport:
+		h.importRun(ctx, j)
+
+	case outdialtargetjob.TypeExport:
+		h.exportRun(ctx, j)
+
+	default:
+		h.finish(ctx, j, outdialtargetjob.StatusFailed, "Unsupported job type.")
+	}
+}
+
+// heartbeat touches the running job's tm_update until the given context is done,
+// so the recovery does not take over the job.
+func (h *outdialTargetJobHandler) heartbeat(ctx context.Context, j *outdialtargetjob.OutdialTargetJob) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":                "heartbeat",
+		"outdialtargetjob_id": j.ID,
+	})
+
+	ticker := time.NewTicker(jobHeartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-ticker.C:
+			if errHeartbeat := h.db.OutdialTargetJobHeartbeat(ctx, j.ID); errHeartbeat != nil {
+				log.Errorf("Could not update the heartbeat. err: %v", errHeartbeat)
+			}
+		}
+	}
+}
+
+// RunRecovery resumes the processing jobs abandoned by the restarted or crashed pods.
+// It sweeps once on start and then every interval.
+func (h *outdialTargetJobHandler) RunRecovery(ctx context.Context, interval time.Duration) {
+	log := logrus.WithField("func", "RunRecovery")
+
+	h.recoverStale(ctx)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Infof("Outdialtargetjob recovery stopped")
+			return
+
+		case <-ticker.C:
+			h.recoverStale(ctx)
+		}
+	}
+}
+
+// recoverStale claims the processing jobs without the heartbeat and runs them again.
+func (h *outdialTargetJobHandler) recoverStale(ctx context.Context) {
+	log := logrus.WithField("func", "recoverStale")
+
+	filters := map[outdialtargetjob.Field]any{
+		outdialtargetjob.FieldStatus:  outdialtargetjob.StatusProcessing,
+		outdialtargetjob.FieldDeleted: false,
+	}
+
+	cutoff := utilhandler.TimeNow().Add(-jobStaleTimeout)
+	token := ""
+	for {
+		jobs, err := h.db.OutdialTargetJobList(ctx, token, recoveryPageSize, filters)
+		if err != nil {
+			log.Errorf("Could not get the processing outdialtargetjobs. err: %v", err)
+			return
+		}
+
+		for _, j := range jobs {
+			h.recoverJob(ctx, j, cutoff)
+		}
+
+		if len(jobs) < recoveryPageSize || jobs[len(jobs)-1].TMCreate == nil {
+			return
+		}
+		token = jobs[len(jobs)-1].TMCreate.UTC().Format(utilhandler.ISO8601Layout)
+	}
+}
+
+// recoverJob claims the given job if it is abandoned and runs it again from the start.
+func (h *outdialTargetJobHandler) recoverJob(ctx context.Context, j *outdialtargetjob.OutdialTargetJob, cutoff time.Time) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":                "recoverJob",
+		"outdialtargetjob_id": j.ID,
+	})
+
+	tmActive := j.TMUpdate
+	if tmActive == nil {
+		tmActive = j.TMCreate
+	}
+	if tmActive == nil || tmActive.After(cutoff) {
+		return
+	}
+
+	// the other pods sweep at the same time. only the one which updated the same tm_update wins.
+	claimed, err := h.db.OutdialTargetJobClaim(ctx, j.ID, j.TMUpdate)
+	if err != nil {
+		log.Errorf("Could not claim the outdialtargetjob. err: %v", err)
+		return
+	} else if claimed == 0 {
+		log.Debugf("The outdialtargetjob was claimed by the other. outdialtargetjob_id: %s", j.ID)
+		return
+	}
+	log.WithField("outdialtargetjob", j).Infof("Recovering the abandoned outdialtargetjob. outdialtargetjob_id: %s", j.ID)
+
+	// restart with the fresh counts. the import counts the targets created before the restart as duplicates.
+	j.TotalCount = 0
+	j.ProcessedCount = 0
+	j.SuccessCount = 0
+	j.DuplicateCount = 0
+	j.ErrorCount = 0
+
+	go h.run(j)
+}
@@ -0,0 +1,93 @@
+package outdialtargetjobhandler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	"monorepo/bin-outdial-manager/models/outdialtargetjob"
+	"monorepo/bin-outdial-manager/pkg/dbhandler"
+)
+
+func Test_recoverStale(t *testing.T) {
+
+	tmFresh := time.Now().Add(-time.Minute)
+	tmStale := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name string
+
+		responseJobs []*outdialtargetjob.OutdialTargetJob
+
+		expectClaims []*outdialtargetjob.OutdialTargetJob
+	}{
+		{
+			name: "the running job is not claimed",
+
+			responseJobs: []*outdialtargetjob.OutdialTargetJob{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("d10a1b2c-ad75-11f0-8e7f-8b9c0d1e2f01"),
+					},
+					Type:     outdialtargetjob.TypeImport,
+					Status:   outdialtargetjob.StatusProcessing,
+					TMCreate: &tmStale,
+					TMUpdate: &tmFresh,
+				},
+			},
+		},
+		{
+			name: "the abandoned job claimed by the other pod",
+
+			responseJobs: []*outdialtargetjob.OutdialTargetJob{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("d13c4d5e-ad75-11f0-9f80-9c0d1e2f3a02"),
+					},
+					Type:     outdialtargetjob.TypeExport,
+					Status:   outdialtargetjob.StatusProcessing,
+					TMCreate: &tmStale,
+					TMUpdate: &tmStale,
+				},
+			},
+
+			expectClaims: []*outdialtargetjob.OutdialTargetJob{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("d13c4d5e-ad75-11f0-9f80-9c0d1e2f3a02"),
+					},
+					TMUpdate: &tmStale,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+
+			h := &outdialTargetJobHandler{
+				db: mockDB,
+			}
+			ctx := context.Background()
+
+			mockDB.EXPECT().OutdialTargetJobList(ctx, "", uint64(recoveryPageSize), map[outdialtargetjob.Field]any{
+				outdialtargetjob.FieldStatus:  outdialtargetjob.StatusProcessing,
+				outdialtargetjob.FieldDeleted: false,
+			}).Return(tt.responseJobs, nil)
+			for _, j := range tt.expectClaims {
+				mockDB.EXPECT().OutdialTargetJobClaim(ctx, j.ID, j.TMUpdate).Return(int64(0), nil)
+			}
+
+			h.recoverStale(ctx)
+		})
+	}
+}
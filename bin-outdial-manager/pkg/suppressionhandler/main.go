@@ -0,0 +1,71 @@
+package suppressionhandler
+
+//go:generate mockgen -package suppressionhandler -destination ./mock_suppressionhandler.go -source main.go -build_flags=-mod=mod
+
+import (
+	"context"
+
+	"monorepo/bin-common-handler/pkg/notifyhandler"
+	"monorepo/bin-common-handler/pkg/requesthandler"
+
+	"github.com/gofrs/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"monorepo/bin-outdial-manager/models/suppression"
+	"monorepo/bin-outdial-manager/models/suppressionblock"
+	"monorepo/bin-outdial-manager/pkg/dbhandler"
+)
+
+// suppressionHandler defines
+type suppressionHandler struct {
+	db            dbhandler.DBHandler
+	reqHandler    requesthandler.RequestHandler
+	notifyHandler notifyhandler.NotifyHandler
+}
+
+// SuppressionHandler interface
+type SuppressionHandler interface {
+	Create(ctx context.Context, customerID uuid.UUID, listType suppression.ListType, target string, detail string) (*suppression.Suppression, error)
+	Import(ctx context.Context, customerID uuid.UUID, listType suppression.ListType, targets []string, detail string) (int, int, error)
+	Delete(ctx context.Context, id uuid.UUID) (*suppression.Suppression, error)
+	Get(ctx context.Context, id uuid.UUID) (*suppression.Suppression, error)
+	List(ctx context.Context, token string, limit uint64, filters map[suppression.Field]any) ([]*suppression.Suppression, error)
+
+	IsSuppressed(ctx context.Context, customerID uuid.UUID, target string, referenceType suppressionblock.ReferenceType, referenceID uuid.UUID) (bool, error)
+	BlockGet(ctx context.Context, id uuid.UUID) (*suppressionblock.SuppressionBlock, error)
+	BlockList(ctx context.Context, token string, limit uint64, filters map[suppressionblock.Field]any) ([]*suppressionblock.SuppressionBlock, error)
+}
+
+// NewSuppressionHandler return SuppressionHandler
+func NewSuppressionHandler(
+	db dbhandler.DBHandler,
+	reqHandler requesthandler.RequestHandler,
+	notifyHandler notifyhandler.NotifyHandler,
+) SuppressionHandler {
+	h := &suppressionHandler{
+		db:            db,
+		reqHandler:    reqHandler,
+		notifyHandler: notifyHandler,
+	}
+
+	return h
+}
+
+var (
+	metricsNamespace = "outdial_manager"
+
+	promSuppressionBlockTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "suppression_block_total",
+			Help:      "Total number of outbound attempts blocked by the suppression lists.",
+		},
+		[]string{"list_type", "reference_type"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(
+		promSuppressionBlockTotal,
+	)
+}
@@ -0,0 +1,233 @@
+package suppressionhandler
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+
+	commonaddress "monorepo/bin-common-handler/models/address"
+	cerrors "monorepo/bin-common-handler/models/errors"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	commonoutline "monorepo/bin-common-handler/models/outline"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+
+	"monorepo/bin-outdial-manager/models/suppression"
+	"monorepo/bin-outdial-manager/pkg/dbhandler"
+)
+
+const (
+	maxImportTargets = 10000 // the max number of targets of the single import
+)
+
+// Create creates a new suppression.
+// If the target is already in the given list, returns the existing suppression.
+func (h *suppressionHandler) Create(ctx context.Context, customerID uuid.UUID, listType suppression.ListType, target string, detail string) (*suppression.Suppression, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":        "Create",
+		"customer_id": customerID,
+		"list_type":   listType,
+		"target":      target,
+	})
+
+	if !isValidListType(listType) {
+		return nil, cerrors.InvalidArgument(commonoutline.ServiceNameOutdialManager, "INVALID_LIST_TYPE", fmt.Sprintf("Unsupported suppression list type. list_type: %s", listType))
+	}
+
+	normalized, ok := normalizeTarget(target)
+	if !ok {
+		return nil, cerrors.InvalidArgument(commonoutline.ServiceNameOutdialManager, "INVALID_TARGET", "The target must be a tel number in E.164 format.")
+	}
+
+	res, created, err := h.create(ctx, customerID, listType, normalized, detail)
+	if err != nil {
+		log.Errorf("Could not create the suppression. err: %v", err)
+		return nil, err
+	}
+
+	if created {
+		h.notifyHandler.PublishWebhookEvent(ctx, res.CustomerID, suppression.EventTypeSuppressionCreated, res)
+	}
+
+	return res, nil
+}
+
+// Import adds the given targets to the suppression list.
+// Invalid targets and the targets already in the list are skipped.
+// Returns the number of imported and skipped targets.
+func (h *suppressionHandler) Import(ctx context.Context, customerID uuid.UUID, listType suppression.ListType, targets []string, detail string) (int, int, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":        "Import",
+		"customer_id": customerID,
+		"list_type":   listType,
+	})
+
+	if !isValidListType(listType) {
+		return 0, 0, cerrors.InvalidArgument(commonoutline.ServiceNameOutdialManager, "INVALID_LIST_TYPE", fmt.Sprintf("Unsupported suppression list type. list_type: %s", listType))
+	}
+
+	if len(targets) > maxImportTargets {
+		return 0, 0, cerrors.InvalidArgument(commonoutline.ServiceNameOutdialManager, "TOO_MANY_TARGETS", fmt.Sprintf("The import can not have more than %d targets.", maxImportTargets))
+	}
+
+	imported := 0
+	skipped := 0
+	for _, target := range targets {
+		normalized, ok := normalizeTarget(target)
+		if !ok {
+			skipped++
+			continue
+		}
+
+		_, created, err := h.create(ctx, customerID, listType, normalized, detail)
+		if err != nil {
+			log.Errorf("Could not import the target. imported: %d, err: %v", imported, err)
+			return imported, skipped, err
+		}
+
+		if !created {
+			skipped++
+			continue
+		}
+		imported++
+	}
+	log.Debugf("Imported the suppression targets. imported: %d, skipped: %d", imported, skipped)
+
+	return imported, skipped, nil
+}
+
+// create creates a new suppression if the target is not in the list yet.
+// The target must be normalized.
+func (h *suppressionHandler) create(ctx context.Context, customerID uuid.UUID, listType suppression.ListType, target string, detail string) (*suppression.Suppression, bool, error) {
+	filters := map[suppression.Field]any{
+		suppression.FieldCustomerID: customerID,
+		suppression.FieldListType:   listType,
+		suppression.FieldTarget:     target,
+		suppression.FieldDeleted:    false,
+	}
+	tmps, err := h.db.SuppressionList(ctx, "", 1, filters)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(tmps) > 0 {
+		return tmps[0], false, nil
+	}
+
+	id := uuid.Must(uuid.NewV4())
+	s := &suppression.Suppression{
+		Identity: commonidentity.Identity{
+			ID:         id,
+			CustomerID: customerID,
+		},
+		ListType: listType,
+		Target:   target,
+
+		Detail: detail,
+
+		TMCreate: utilhandler.TimeNow(),
+		TMUpdate: nil,
+		TMDelete: nil,
+	}
+
+	if errCreate := h.db.SuppressionCreate(ctx, s); errCreate != nil {
+		return nil, false, errCreate
+	}
+
+	res, err := h.Get(ctx, id)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return res, true, nil
+}
+
+// Delete deletes the suppression.
+func (h *suppressionHandler) Delete(ctx context.Context, id uuid.UUID) (*suppression.Suppression, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":           "Delete",
+		"suppression_id": id,
+	})
+
+	if errDel := h.db.SuppressionDelete(ctx, id); errDel != nil {
+		log.Errorf("Could not delete the suppression. err: %v", errDel)
+		return nil, errDel
+	}
+
+	res, err := h.Get(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get deleted suppression. err: %v", err)
+		return nil, err
+	}
+	h.notifyHandler.PublishWebhookEvent(ctx, res.CustomerID, suppression.EventTypeSuppressionDeleted, res)
+
+	return res, nil
+}
+
+// Get returns the suppression.
+func (h *suppressionHandler) Get(ctx context.Context, id uuid.UUID) (*suppression.Suppression, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":           "Get",
+		"suppression_id": id,
+	})
+
+	res, err := h.db.SuppressionGet(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get suppression. err: %v", err)
+		if stderrors.Is(err, dbhandler.ErrNotFound) {
+			return nil, cerrors.NotFound(
+				commonoutline.ServiceNameOutdialManager,
+				"SUPPRESSION_NOT_FOUND",
+				"The suppression was not found.",
+			).Wrap(err)
+		}
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// List returns list of suppressions with filters.
+func (h *suppressionHandler) List(ctx context.Context, token string, limit uint64, filters map[suppression.Field]any) ([]*suppression.Suppression, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":    "List",
+		"token":   token,
+		"limit":   limit,
+		"filters": filters,
+	})
+	log.Debug("Getting suppressions with filters.")
+
+	res, err := h.db.SuppressionList(ctx, token, limit, filters)
+	if err != nil {
+		log.Errorf("Could not get suppressions. err: %v", err)
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// isValidListType returns true if the given list type is supported.
+func isValidListType(listType suppression.ListType) bool {
+	switch listType {
+	case suppression.ListTypeDNC, suppression.ListTypeOptOut, suppression.ListTypeLitigator:
+		return true
+	default:
+		return false
+	}
+}
+
+// normalizeTarget returns the normalized tel number.
+// Returns false if the given target is not a valid tel number.
+func normalizeTarget(target string) (string, bool) {
+	res, err := commonaddress.NormalizeTarget(commonaddress.TypeTel, target)
+	if err != nil {
+		return "", false
+	}
+
+	if errValidate := commonaddress.ValidateTarget(commonaddress.TypeTel, res); errValidate != nil {
+		return "", false
+	}
+
+	return res, true
+}
@@ -0,0 +1,289 @@
+package suppressionhandler
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/notifyhandler"
+	"monorepo/bin-common-handler/pkg/requesthandler"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	"monorepo/bin-outdial-manager/models/suppression"
+	"monorepo/bin-outdial-manager/pkg/dbhandler"
+)
+
+func Test_Create(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		customerID uuid.UUID
+		listType   suppression.ListType
+		target     string
+		detail     string
+
+		responseList []*suppression.Suppression
+
+		expectFilters map[suppression.Field]any
+		expectCreate  bool
+	}{
+		{
+			name: "normal",
+
+			customerID: uuid.FromStringOrNil("1a2b3c4d-2b70-11f1-8a9b-0c1d2e3f4a01"),
+			listType:   suppression.ListTypeDNC,
+			target:     " +82 1100-000001 ",
+			detail:     "test detail",
+
+			responseList: []*suppression.Suppression{},
+
+			expectFilters: map[suppression.Field]any{
+				suppression.FieldCustomerID: uuid.FromStringOrNil("1a2b3c4d-2b70-11f1-8a9b-0c1d2e3f4a01"),
+				suppression.FieldListType:   suppression.ListTypeDNC,
+				suppression.FieldTarget:     "+821100000001",
+				suppression.FieldDeleted:    false,
+			},
+			expectCreate: true,
+		},
+		{
+			name: "already exists",
+
+			customerID: uuid.FromStringOrNil("1a2b3c4d-2b70-11f1-8a9b-0c1d2e3f4a01"),
+			listType:   suppression.ListTypeOptOut,
+			target:     "+821100000001",
+
+			responseList: []*suppression.Suppression{
+				{
+					Identity: commonidentity.Identity{
+						ID: uuid.FromStringOrNil("1a5f6e7d-2b70-11f1-9c8b-1d2e3f4a5b02"),
+					},
+				},
+			},
+
+			expectFilters: map[suppression.Field]any{
+				suppression.FieldCustomerID: uuid.FromStringOrNil("1a2b3c4d-2b70-11f1-8a9b-0c1d2e3f4a01"),
+				suppression.FieldListType:   suppression.ListTypeOptOut,
+				suppression.FieldTarget:     "+821100000001",
+				suppression.FieldDeleted:    false,
+			},
+			expectCreate: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+			h := &suppressionHandler{
+				db:            mockDB,
+				reqHandler:    mockReq,
+				notifyHandler: mockNotify,
+			}
+
+			ctx := context.Background()
+
+			mockDB.EXPECT().SuppressionList(ctx, "", uint64(1), tt.expectFilters).Return(tt.responseList, nil)
+			if tt.expectCreate {
+				mockDB.EXPECT().SuppressionCreate(ctx, gomock.Any()).Return(nil)
+				mockDB.EXPECT().SuppressionGet(ctx, gomock.Any()).Return(&suppression.Suppression{}, nil)
+				mockNotify.EXPECT().PublishWebhookEvent(ctx, gomock.Any(), suppression.EventTypeSuppressionCreated, gomock.Any())
+			}
+
+			_, err := h.Create(ctx, tt.customerID, tt.listType, tt.target, tt.detail)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+		})
+	}
+}
+
+func Test_Create_error(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		listType suppression.ListType
+		target   string
+	}{
+		{
+			name: "invalid list type",
+
+			listType: suppression.ListType("wrong"),
+			target:   "+821100000001",
+		},
+		{
+			name: "invalid target",
+
+			listType: suppression.ListTypeDNC,
+			target:   "anonymous",
+		},
+		{
+			name: "too short target",
+
+			listType: suppression.ListTypeDNC,
+			target:   "+1234",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			h := &suppressionHandler{
+				db: mockDB,
+			}
+
+			ctx := context.Background()
+
+			if _, err := h.Create(ctx, uuid.Nil, tt.listType, tt.target, ""); err == nil {
+				t.Errorf("Wrong match. expect: error, got: ok")
+			}
+		})
+	}
+}
+
+func Test_Import(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		customerID uuid.UUID
+		listType   suppression.ListType
+		targets    []string
+
+		responseExists map[string]bool
+
+		expectImported int
+		expectSkipped  int
+	}{
+		{
+			name: "normal",
+
+			customerID: uuid.FromStringOrNil("3c4d5e6f-2b70-11f1-ab9c-2e3f4a5b6c01"),
+			listType:   suppression.ListTypeLitigator,
+			targets: []string{
+				"+821100000001",
+				"+821100000002",
+				"wrong number",
+			},
+
+			responseExists: map[string]bool{
+				"+821100000002": true,
+			},
+
+			expectImported: 1,
+			expectSkipped:  2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+			h := &suppressionHandler{
+				db:            mockDB,
+				reqHandler:    mockReq,
+				notifyHandler: mockNotify,
+			}
+
+			ctx := context.Background()
+
+			for _, target := range tt.targets {
+				if _, ok := normalizeTarget(target); !ok {
+					continue
+				}
+
+				filters := map[suppression.Field]any{
+					suppression.FieldCustomerID: tt.customerID,
+					suppression.FieldListType:   tt.listType,
+					suppression.FieldTarget:     target,
+					suppression.FieldDeleted:    false,
+				}
+				if tt.responseExists[target] {
+					mockDB.EXPECT().SuppressionList(ctx, "", uint64(1), filters).Return([]*suppression.Suppression{{}}, nil)
+					continue
+				}
+				mockDB.EXPECT().SuppressionList(ctx, "", uint64(1), filters).Return([]*suppression.Suppression{}, nil)
+				mockDB.EXPECT().SuppressionCreate(ctx, gomock.Any()).Return(nil)
+				mockDB.EXPECT().SuppressionGet(ctx, gomock.Any()).Return(&suppression.Suppression{}, nil)
+			}
+
+			imported, skipped, err := h.Import(ctx, tt.customerID, tt.listType, tt.targets, "")
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if imported != tt.expectImported || skipped != tt.expectSkipped {
+				t.Errorf("Wrong match. expect: %d/%d, got: %d/%d", tt.expectImported, tt.expectSkipped, imported, skipped)
+			}
+		})
+	}
+}
+
+func Test_Delete(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		id uuid.UUID
+
+		responseSuppression *suppression.Suppression
+	}{
+		{
+			name: "normal",
+
+			id: uuid.FromStringOrNil("5e6f7a8b-2b70-11f1-bc9d-3f4a5b6c7d01"),
+
+			responseSuppression: &suppression.Suppression{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("5e6f7a8b-2b70-11f1-bc9d-3f4a5b6c7d01"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+			h := &suppressionHandler{
+				db:            mockDB,
+				reqHandler:    mockReq,
+				notifyHandler: mockNotify,
+			}
+
+			ctx := context.Background()
+
+			mockDB.EXPECT().SuppressionDelete(ctx, tt.id).Return(nil)
+			mockDB.EXPECT().SuppressionGet(ctx, tt.id).Return(tt.responseSuppression, nil)
+			mockNotify.EXPECT().PublishWebhookEvent(ctx, tt.responseSuppression.CustomerID, suppression.EventTypeSuppressionDeleted, tt.responseSuppression)
+
+			res, err := h.Delete(ctx, tt.id)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.responseSuppression) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.responseSuppression, res)
+			}
+		})
+	}
+}
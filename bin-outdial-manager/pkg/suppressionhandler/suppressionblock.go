@@ -0,0 +1,128 @@
+package suppressionhandler
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+
+	cerrors "monorepo/bin-common-handler/models/errors"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	commonoutline "monorepo/bin-common-handler/models/outline"
+	"monorepo/bin-common-handler/pkg/utilhandler"
+
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+
+	"monorepo/bin-outdial-manager/models/suppression"
+	"monorepo/bin-outdial-manager/models/suppressionblock"
+	"monorepo/bin-outdial-manager/pkg/dbhandler"
+)
+
+// IsSuppressed returns true if the given target is in one of the customer's suppression lists.
+// Every suppressed attempt is recorded as a suppressionblock with the reason.
+// The target which is not a tel number is never suppressed.
+func (h *suppressionHandler) IsSuppressed(ctx context.Context, customerID uuid.UUID, target string, referenceType suppressionblock.ReferenceType, referenceID uuid.UUID) (bool, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":           "IsSuppressed",
+		"customer_id":    customerID,
+		"target":         target,
+		"reference_type": referenceType,
+		"reference_id":   referenceID,
+	})
+
+	normalized, ok := normalizeTarget(target)
+	if !ok {
+		return false, nil
+	}
+
+	filters := map[suppression.Field]any{
+		suppression.FieldCustomerID: customerID,
+		suppression.FieldTarget:     normalized,
+		suppression.FieldDeleted:    false,
+	}
+	tmps, err := h.db.SuppressionList(ctx, "", 1, filters)
+	if err != nil {
+		log.Errorf("Could not get suppressions. err: %v", err)
+		return false, err
+	}
+	if len(tmps) == 0 {
+		return false, nil
+	}
+	s := tmps[0]
+	log.WithField("suppression", s).Infof("The target is suppressed. suppression_id: %s, list_type: %s", s.ID, s.ListType)
+	promSuppressionBlockTotal.WithLabelValues(string(s.ListType), string(referenceType)).Inc()
+
+	// record the blocked attempt.
+	// the attempt must be blocked even though the record fails.
+	b := &suppressionblock.SuppressionBlock{
+		Identity: commonidentity.Identity{
+			ID:         uuid.Must(uuid.NewV4()),
+			CustomerID: customerID,
+		},
+		SuppressionID: s.ID,
+		ListType:      s.ListType,
+		Target:        normalized,
+
+		ReferenceType: referenceType,
+		ReferenceID:   referenceID,
+
+		Reason: fmt.Sprintf("The destination is in the %s list.", s.ListType),
+
+		TMCreate: utilhandler.TimeNow(),
+	}
+	if errCreate := h.db.SuppressionBlockCreate(ctx, b); errCreate != nil {
+		log.Errorf("Could not record the suppression block. err: %v", errCreate)
+		return true, nil
+	}
+
+	res, err := h.db.SuppressionBlockGet(ctx, b.ID)
+	if err != nil {
+		log.Errorf("Could not get created suppression block. err: %v", err)
+		return true, nil
+	}
+	h.notifyHandler.PublishWebhookEvent(ctx, res.CustomerID, suppressionblock.EventTypeSuppressionBlockCreated, res)
+
+	return true, nil
+}
+
+// BlockGet returns the suppressionblock.
+func (h *suppressionHandler) BlockGet(ctx context.Context, id uuid.UUID) (*suppressionblock.SuppressionBlock, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":                "BlockGet",
+		"suppressionblock_id": id,
+	})
+
+	res, err := h.db.SuppressionBlockGet(ctx, id)
+	if err != nil {
+		log.Errorf("Could not get suppressionblock. err: %v", err)
+		if stderrors.Is(err, dbhandler.ErrNotFound) {
+			return nil, cerrors.NotFound(
+				commonoutline.ServiceNameOutdialManager,
+				"SUPPRESSION_BLOCK_NOT_FOUND",
+				"The suppression block was not found.",
+			).Wrap(err)
+		}
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// BlockList returns list of suppressionblocks with filters.
+func (h *suppressionHandler) BlockList(ctx context.Context, token string, limit uint64, filters map[suppressionblock.Field]any) ([]*suppressionblock.SuppressionBlock, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":    "BlockList",
+		"token":   token,
+		"limit":   limit,
+		"filters": filters,
+	})
+	log.Debug("Getting suppressionblocks with filters.")
+
+	res, err := h.db.SuppressionBlockList(ctx, token, limit, filters)
+	if err != nil {
+		log.Errorf("Could not get suppressionblocks. err: %v", err)
+		return nil, err
+	}
+
+	return res, nil
+}
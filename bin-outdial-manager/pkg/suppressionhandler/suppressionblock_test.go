@@ -0,0 +1,137 @@
+package suppressionhandler
+
+import (
+	"context"
+	"testing"
+
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/notifyhandler"
+	"monorepo/bin-common-handler/pkg/requesthandler"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	"monorepo/bin-outdial-manager/models/suppression"
+	"monorepo/bin-outdial-manager/models/suppressionblock"
+	"monorepo/bin-outdial-manager/pkg/dbhandler"
+)
+
+func Test_IsSuppressed(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		customerID    uuid.UUID
+		target        string
+		referenceType suppressionblock.ReferenceType
+		referenceID   uuid.UUID
+
+		responseList []*suppression.Suppression
+
+		expectFilters map[suppression.Field]any
+		expectRes     bool
+	}{
+		{
+			name: "suppressed",
+
+			customerID:    uuid.FromStringOrNil("7a8b9c0d-2b70-11f1-8d9e-4a5b6c7d8e01"),
+			target:        "+821100000001",
+			referenceType: suppressionblock.ReferenceTypeCampaign,
+			referenceID:   uuid.FromStringOrNil("7ab8c9d0-2b70-11f1-9e0f-5b6c7d8e9f02"),
+
+			responseList: []*suppression.Suppression{
+				{
+					Identity: commonidentity.Identity{
+						ID:         uuid.FromStringOrNil("7ae5f6a7-2b70-11f1-af1a-6c7d8e9f0a03"),
+						CustomerID: uuid.FromStringOrNil("7a8b9c0d-2b70-11f1-8d9e-4a5b6c7d8e01"),
+					},
+					ListType: suppression.ListTypeDNC,
+					Target:   "+821100000001",
+				},
+			},
+
+			expectFilters: map[suppression.Field]any{
+				suppression.FieldCustomerID: uuid.FromStringOrNil("7a8b9c0d-2b70-11f1-8d9e-4a5b6c7d8e01"),
+				suppression.FieldTarget:     "+821100000001",
+				suppression.FieldDeleted:    false,
+			},
+			expectRes: true,
+		},
+		{
+			name: "not suppressed",
+
+			customerID:    uuid.FromStringOrNil("7a8b9c0d-2b70-11f1-8d9e-4a5b6c7d8e01"),
+			target:        "+821100000002",
+			referenceType: suppressionblock.ReferenceTypeMessage,
+			referenceID:   uuid.FromStringOrNil("7b12a3b4-2b70-11f1-b02b-7d8e9f0a1b04"),
+
+			responseList: []*suppression.Suppression{},
+
+			expectFilters: map[suppression.Field]any{
+				suppression.FieldCustomerID: uuid.FromStringOrNil("7a8b9c0d-2b70-11f1-8d9e-4a5b6c7d8e01"),
+				suppression.FieldTarget:     "+821100000002",
+				suppression.FieldDeleted:    false,
+			},
+			expectRes: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockNotify := notifyhandler.NewMockNotifyHandler(mc)
+			h := &suppressionHandler{
+				db:            mockDB,
+				reqHandler:    mockReq,
+				notifyHandler: mockNotify,
+			}
+
+			ctx := context.Background()
+
+			mockDB.EXPECT().SuppressionList(ctx, "", uint64(1), tt.expectFilters).Return(tt.responseList, nil)
+			if tt.expectRes {
+				s := tt.responseList[0]
+				mockDB.EXPECT().SuppressionBlockCreate(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, b *suppressionblock.SuppressionBlock) error {
+					if b.SuppressionID != s.ID || b.ListType != s.ListType || b.ReferenceType != tt.referenceType || b.ReferenceID != tt.referenceID || b.Reason == "" {
+						t.Errorf("Wrong match. got: %v", b)
+					}
+					return nil
+				})
+				mockDB.EXPECT().SuppressionBlockGet(ctx, gomock.Any()).Return(&suppressionblock.SuppressionBlock{}, nil)
+				mockNotify.EXPECT().PublishWebhookEvent(ctx, gomock.Any(), suppressionblock.EventTypeSuppressionBlockCreated, gomock.Any())
+			}
+
+			res, err := h.IsSuppressed(ctx, tt.customerID, tt.target, tt.referenceType, tt.referenceID)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if res != tt.expectRes {
+				t.Errorf("Wrong match. expect: %v, got: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_IsSuppressed_notTel(t *testing.T) {
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockDB := dbhandler.NewMockDBHandler(mc)
+	h := &suppressionHandler{
+		db: mockDB,
+	}
+
+	res, err := h.IsSuppressed(context.Background(), uuid.Nil, "sip:test@example.com", suppressionblock.ReferenceTypeCall, uuid.Nil)
+	if err != nil {
+		t.Errorf("Wrong match. expect: ok, got: %v", err)
+	}
+
+	if res {
+		t.Errorf("Wrong match. expect: false, got: true")
+	}
+}
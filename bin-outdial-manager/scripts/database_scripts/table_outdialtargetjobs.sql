@@ -0,0 +1,33 @@
+create table outdial_outdialtargetjobs(
+  -- identity
+  id          binary(16),
+  customer_id binary(16),
+
+  outdial_id  binary(16),
+
+  type    varchar(255),
+  status  varchar(255),
+
+  file_id binary(16),
+  mapping json,
+
+  total_count     integer,
+  processed_count integer,
+  success_count   integer,
+  duplicate_count integer,
+  error_count     integer,
+
+  error_file_id binary(16),
+
+  detail    text,
+
+  -- timestamps
+  tm_create datetime(6),  -- create
+  tm_update datetime(6),  -- update
+  tm_delete datetime(6),  -- delete
+
+  primary key(id)
+);
+
+create index idx_outdial_outdialtargetjobs_customer_id on outdial_outdialtargetjobs(customer_id);
+create index idx_outdial_outdialtargetjobs_outdial_id on outdial_outdialtargetjobs(outdial_id);
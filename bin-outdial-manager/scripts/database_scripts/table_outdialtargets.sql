@@ -8,6 +8,7 @@ create table outdial_outdialtargets(
 
   data    text,
   status  varchar(255),
+  timezone  varchar(255),
 
   -- destinations
   destination_0 json,
@@ -23,6 +24,10 @@ create table outdial_outdialtargets(
   try_count_3 integer,
   try_count_4 integer,
 
+  -- scheduled callback
+  callback_agent_id binary(16),
+  tm_callback       datetime(6),
+
   -- timestamps
   tm_create datetime(6),  -- create
   tm_update datetime(6),  -- update
@@ -32,3 +37,4 @@ create table outdial_outdialtargets(
 );
 
 create index idx_outdial_outdialtargets_outdial_id on outdial_outdialtargets(outdial_id);
+create index idx_outdial_outdialtargets_tm_callback on outdial_outdialtargets(tm_callback);
@@ -0,0 +1,25 @@
+create table outdial_suppressionblocks(
+  -- identity
+  id          binary(16),
+  customer_id binary(16),
+
+  suppression_id  binary(16),
+  list_type       varchar(255),
+  target          varchar(255),
+
+  reference_type  varchar(255),
+  reference_id    binary(16),
+
+  reason    text,
+
+  -- timestamps
+  tm_create datetime(6),  -- create
+  tm_update datetime(6),  -- update
+  tm_delete datetime(6),  -- delete
+
+  primary key(id)
+);
+
+create index idx_outdial_suppressionblocks_customer_id on outdial_suppressionblocks(customer_id);
+create index idx_outdial_suppressionblocks_suppression_id on outdial_suppressionblocks(suppression_id);
+create index idx_outdial_suppressionblocks_reference_id on outdial_suppressionblocks(reference_id);
@@ -0,0 +1,20 @@
+create table outdial_suppressions(
+  -- identity
+  id          binary(16),
+  customer_id binary(16),
+
+  list_type varchar(255),
+  target    varchar(255),
+
+  detail    text,
+
+  -- timestamps
+  tm_create datetime(6),  -- create
+  tm_update datetime(6),  -- update
+  tm_delete datetime(6),  -- delete
+
+  primary key(id)
+);
+
+create index idx_outdial_suppressions_customer_id on outdial_suppressions(customer_id);
+create index idx_outdial_suppressions_customer_id_target on outdial_suppressions(customer_id, target);
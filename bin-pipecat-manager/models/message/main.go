@@ -27,6 +27,16 @@ type Message struct {
 	// field exists to disambiguate). See VOIP-1234 design doc §4-1.
 	InReplyToMessageID uuid.UUID `json:"in_reply_to_message_id,omitempty"`
 
+	// EngineModel is the LLM engine model that generated the response, in
+	// bin-ai-manager's "<target>.<model>" form. It is the AI's primary engine
+	// unless the call failed over to one of the AI's engine fallbacks.
+	EngineModel string `json:"engine_model,omitempty"`
+
+	// Usage is the AI provider usage attributed to the message. The final
+	// user transcription carries the speech-to-text usage and the final bot
+	// LLM message carries the LLM token and text-to-speech usage.
+	Usage *pipecatcall.Usage `json:"usage,omitempty"`
+
 	Text     string `json:"text,omitempty"`
 	Sequence int    `json:"sequence,omitempty"`
 }
@@ -26,6 +26,10 @@ type Pipecatcall struct {
 	TTSLanguage string  `json:"tts_language,omitempty" db:"tts_language"`
 	TTSVoiceID  string  `json:"tts_voice_id,omitempty" db:"tts_voice_id"`
 
+	// Usage is the AI provider usage of the pipecatcall.
+	// Not stored. Set when the pipecatcall is terminated.
+	Usage *Usage `json:"usage,omitempty"`
+
 	TMCreate *time.Time `json:"tm_create" db:"tm_create"`
 	TMUpdate *time.Time `json:"tm_update" db:"tm_update"`
 	TMDelete *time.Time `json:"tm_delete" db:"tm_delete"`
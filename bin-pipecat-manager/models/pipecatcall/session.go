@@ -33,6 +33,16 @@ type Session struct {
 	// llm
 	LLMKey string `json:"-"`
 
+	// llmEngineModel and llmEngineKeyHash identify the engine currently
+	// answering for this session. They start as the first engine handed to
+	// the runner and are changed by LLM failover and team member switch
+	// notifications, which arrive on HTTP handler goroutines while the
+	// WebSocket read loop stamps the model on bot messages. Access only
+	// through SetLLMEngine, LLMEngineModel and LLMEngineKeyHash.
+	muLLMEngineModel sync.Mutex
+	llmEngineModel   string
+	llmEngineKeyHash string
+
 	// InReplyToMessageID correlation (VOIP-1234 §4-1): prevents cross-talk when
 	// an aicall is reused for a rapid sequence of send-text requests (e.g. an
 	// agent sending a second question before the first bot response arrives).
@@ -71,6 +81,16 @@ type Session struct {
 
 	// audio quality monitoring
 	DroppedFrames atomic.Int64 `json:"-"`
+
+	// AI provider usage. The audio goroutines add STT/TTS seconds while the
+	// WebSocket read loop adds LLM tokens and attaches the pending usage to
+	// the message events, so access only through AddUsage,
+	// TakePendingSTTUsage, TakePendingLLMUsage and Usage.
+	// usagePending holds the usage not attached to a message yet; usageTotal
+	// holds the usage of the whole session.
+	muUsage      sync.Mutex
+	usagePending Usage
+	usageTotal   Usage
 }
 
 // SetPendingInReplyToMessageID records the message ID that the next LLM
@@ -95,6 +115,88 @@ func (s *Session) SnapshotPendingInReplyToMessageID() uuid.UUID {
 	return s.pendingInReplyToMessageID
 }
 
+// SetLLMEngine records the engine model and the hash of the engine key
+// currently answering for this session. The key hash is empty for the
+// platform's key.
+func (s *Session) SetLLMEngine(engineModel string, engineKeyHash string) {
+	s.muLLMEngineModel.Lock()
+	defer s.muLLMEngineModel.Unlock()
+	s.llmEngineModel = engineModel
+	s.llmEngineKeyHash = engineKeyHash
+}
+
+// LLMEngineModel returns the engine model currently answering for this
+// session. Empty until the runner has been started.
+func (s *Session) LLMEngineModel() string {
+	s.muLLMEngineModel.Lock()
+	defer s.muLLMEngineModel.Unlock()
+	return s.llmEngineModel
+}
+
+// LLMEngineKeyHash returns the hash of the engine key currently answering
+// for this session. Empty for the platform's key.
+func (s *Session) LLMEngineKeyHash() string {
+	s.muLLMEngineModel.Lock()
+	defer s.muLLMEngineModel.Unlock()
+	return s.llmEngineKeyHash
+}
+
+// AddUsage records the given AI provider usage.
+func (s *Session) AddUsage(u Usage) {
+	s.muUsage.Lock()
+	defer s.muUsage.Unlock()
+	s.usagePending.Add(u)
+	s.usageTotal.Add(u)
+}
+
+// TakePendingSTTUsage returns the speech-to-text usage not attached to a
+// message yet and resets it. Returns nil if there is none.
+// The user transcription message carries it.
+func (s *Session) TakePendingSTTUsage() *Usage {
+	s.muUsage.Lock()
+	defer s.muUsage.Unlock()
+
+	res := &Usage{
+		STTSeconds: s.usagePending.STTSeconds,
+	}
+	if res.IsEmpty() {
+		return nil
+	}
+	s.usagePending.STTSeconds = 0
+
+	return res
+}
+
+// TakePendingLLMUsage returns the LLM token and text-to-speech usage not
+// attached to a message yet and resets it. Returns nil if there is none.
+// The bot LLM message carries it.
+func (s *Session) TakePendingLLMUsage() *Usage {
+	s.muUsage.Lock()
+	defer s.muUsage.Unlock()
+
+	res := &Usage{
+		PromptTokens:     s.usagePending.PromptTokens,
+		CompletionTokens: s.usagePending.CompletionTokens,
+		CachedTokens:     s.usagePending.CachedTokens,
+		TTSSeconds:       s.usagePending.TTSSeconds,
+	}
+	if res.IsEmpty() {
+		return nil
+	}
+	s.usagePending = Usage{
+		STTSeconds: s.usagePending.STTSeconds,
+	}
+
+	return res
+}
+
+// Usage returns the AI provider usage of the whole session.
+func (s *Session) Usage() Usage {
+	s.muUsage.Lock()
+	defer s.muUsage.Unlock()
+	return s.usageTotal
+}
+
 // SetConnAst sets the Asterisk WebSocket connection and signals readiness.
 // The channel close provides a happens-before guarantee: any goroutine that
 // reads <-ConnAstReady is guaranteed to see the ConnAst and ConnAstDone writes.
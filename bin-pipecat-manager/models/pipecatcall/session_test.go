@@ -50,3 +50,27 @@ func TestSession_PendingInReplyToMessageID_concurrentAccess(t *testing.T) {
 	}
 	<-done
 }
+
+func TestSession_LLMEngineModel(t *testing.T) {
+	s := &Session{}
+	if got := s.LLMEngineModel(); got != "" {
+		t.Fatalf("expected empty engine model, got %q", got)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 1000; i++ {
+			s.SetLLMEngine("openai.gpt-5", "")
+		}
+	}()
+
+	for i := 0; i < 1000; i++ {
+		_ = s.LLMEngineModel()
+	}
+	<-done
+
+	if got := s.LLMEngineModel(); got != "openai.gpt-5" {
+		t.Fatalf("expected openai.gpt-5, got %q", got)
+	}
+}
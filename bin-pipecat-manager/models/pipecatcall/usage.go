@@ -0,0 +1,25 @@
+package pipecatcall
+
+// Usage is the AI provider usage of a pipecatcall or of a single message.
+type Usage struct {
+	PromptTokens     int64 `json:"prompt_tokens,omitempty"`     // LLM input tokens, including the cached ones
+	CompletionTokens int64 `json:"completion_tokens,omitempty"` // LLM output tokens
+	CachedTokens     int64 `json:"cached_tokens,omitempty"`     // LLM input tokens served from the provider's prompt cache
+
+	STTSeconds float64 `json:"stt_seconds,omitempty"` // seconds of audio sent to the speech-to-text engine
+	TTSSeconds float64 `json:"tts_seconds,omitempty"` // seconds of audio generated by the text-to-speech engine
+}
+
+// IsEmpty returns true if the usage has nothing recorded.
+func (h *Usage) IsEmpty() bool {
+	return h.PromptTokens == 0 && h.CompletionTokens == 0 && h.CachedTokens == 0 && h.STTSeconds == 0 && h.TTSSeconds == 0
+}
+
+// Add adds the given usage to the usage.
+func (h *Usage) Add(u Usage) {
+	h.PromptTokens += u.PromptTokens
+	h.CompletionTokens += u.CompletionTokens
+	h.CachedTokens += u.CachedTokens
+	h.STTSeconds += u.STTSeconds
+	h.TTSSeconds += u.TTSSeconds
+}
@@ -0,0 +1,56 @@
+package pipecatcall
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestUsage_IsEmpty(t *testing.T) {
+	u := Usage{}
+	if !u.IsEmpty() {
+		t.Fatalf("expected empty usage")
+	}
+
+	u.Add(Usage{TTSSeconds: 0.02})
+	if u.IsEmpty() {
+		t.Fatalf("expected non-empty usage after Add")
+	}
+}
+
+func TestSession_Usage(t *testing.T) {
+	s := &Session{}
+
+	if res := s.TakePendingSTTUsage(); res != nil {
+		t.Fatalf("expected nil pending stt usage, got %v", res)
+	}
+	if res := s.TakePendingLLMUsage(); res != nil {
+		t.Fatalf("expected nil pending llm usage, got %v", res)
+	}
+
+	s.AddUsage(Usage{STTSeconds: 1.5})
+	s.AddUsage(Usage{PromptTokens: 1000, CompletionTokens: 20, CachedTokens: 800})
+	s.AddUsage(Usage{TTSSeconds: 2.25})
+
+	expectSTT := &Usage{STTSeconds: 1.5}
+	if res := s.TakePendingSTTUsage(); !reflect.DeepEqual(res, expectSTT) {
+		t.Errorf("Wrong match.\nexpect: %v\ngot: %v", expectSTT, res)
+	}
+
+	s.AddUsage(Usage{STTSeconds: 0.5})
+
+	expectLLM := &Usage{PromptTokens: 1000, CompletionTokens: 20, CachedTokens: 800, TTSSeconds: 2.25}
+	if res := s.TakePendingLLMUsage(); !reflect.DeepEqual(res, expectLLM) {
+		t.Errorf("Wrong match.\nexpect: %v\ngot: %v", expectLLM, res)
+	}
+
+	// the stt usage added in between stays pending
+	expectSTT = &Usage{STTSeconds: 0.5}
+	if res := s.TakePendingSTTUsage(); !reflect.DeepEqual(res, expectSTT) {
+		t.Errorf("Wrong match.\nexpect: %v\ngot: %v", expectSTT, res)
+	}
+
+	expectTotal := Usage{PromptTokens: 1000, CompletionTokens: 20, CachedTokens: 800, STTSeconds: 2, TTSSeconds: 2.25}
+	if res := s.Usage(); res != expectTotal {
+		t.Errorf("Wrong match.\nexpect: %v\ngot: %v", expectTotal, res)
+	}
+}
@@ -432,11 +432,27 @@ type RTVIBotStoppedSpeakingMessage struct {
 	Type  string `json:"type"`  // "bot-stopped-speaking"
 }
 
+// RTVIMetricsTokenUsage is the LLM token usage of a single LLM generation.
+type RTVIMetricsTokenUsage struct {
+	PromptTokens             int64  `json:"prompt_tokens"`
+	CompletionTokens         int64  `json:"completion_tokens"`
+	TotalTokens              int64  `json:"total_tokens"`
+	CacheReadInputTokens     *int64 `json:"cache_read_input_tokens,omitempty"`
+	CacheCreationInputTokens *int64 `json:"cache_creation_input_tokens,omitempty"`
+	ReasoningTokens          *int64 `json:"reasoning_tokens,omitempty"`
+}
+
+// RTVIMetricsData provides data for metrics messages.
+// Only the LLM token usage is typed; the other metrics are ignored.
+type RTVIMetricsData struct {
+	Tokens []RTVIMetricsTokenUsage `json:"tokens,omitempty"`
+}
+
 // RTVIMetricsMessage contains performance metrics.
 type RTVIMetricsMessage struct {
-	Label string                 `json:"label"` // RTVIMessageLabel
-	Type  string                 `json:"type"`  // "metrics"
-	Data  map[string]interface{} `json:"data"`
+	Label string          `json:"label"` // RTVIMessageLabel
+	Type  string          `json:"type"`  // "metrics"
+	Data  RTVIMetricsData `json:"data"`
 }
 
 // RTVIServerMessage is a generic server message for custom server-to-client messages.
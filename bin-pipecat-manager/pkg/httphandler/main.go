@@ -46,6 +46,12 @@ func (h *httpHandler) Run() error {
 	router.GET("/:id/ws", h.wsHandle)
 	router.POST("/:id/tools", h.toolHandle)
 	router.POST("/:id/member-switched", h.memberSwitchedHandle)
+	router.POST("/:id/llm-failover", h.llmFailoverHandle)
+	router.POST("/:id/redact", h.redactHandle)
+	router.POST("/:id/guardrail", h.guardrailHandle)
+	router.POST("/:id/guardrail/input", h.guardrailInputHandle)
+	router.POST("/:id/response-cache/lookup", h.responseCacheLookupHandle)
+	router.POST("/:id/response-cache/store", h.responseCacheStoreHandle)
 
 	server := &http.Server{
 		Handler: router,
@@ -124,3 +130,117 @@ func (h *httpHandler) memberSwitchedHandle(c *gin.Context) {
 		return
 	}
 }
+
+func (h *httpHandler) llmFailoverHandle(c *gin.Context) {
+	log := logrus.WithFields(logrus.Fields{
+		"func": "llmFailoverHandle",
+	})
+
+	id := uuid.FromStringOrNil(c.Param("id"))
+	if id == uuid.Nil {
+		log.Errorf("Invalid pipecatcall ID: %s", c.Param("id"))
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	if errHandle := h.pipecatcallHandler.RunnerLLMFailoverHandle(id, c); errHandle != nil {
+		log.Errorf("Could not handle llm-failover request. pipecatcall_id: %s, err: %v", id, errHandle)
+		c.JSON(http.StatusBadRequest, gin.H{"error": errHandle.Error()})
+		return
+	}
+}
+
+func (h *httpHandler) redactHandle(c *gin.Context) {
+	log := logrus.WithFields(logrus.Fields{
+		"func": "redactHandle",
+	})
+
+	id := uuid.FromStringOrNil(c.Param("id"))
+	if id == uuid.Nil {
+		log.Errorf("Invalid pipecatcall ID: %s", c.Param("id"))
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	if errHandle := h.pipecatcallHandler.RunnerRedactHandle(id, c); errHandle != nil {
+		log.Errorf("Could not handle redact request. pipecatcall_id: %s, err: %v", id, errHandle)
+		c.JSON(http.StatusBadRequest, gin.H{"error": errHandle.Error()})
+		return
+	}
+}
+
+func (h *httpHandler) guardrailHandle(c *gin.Context) {
+	log := logrus.WithFields(logrus.Fields{
+		"func": "guardrailHandle",
+	})
+
+	id := uuid.FromStringOrNil(c.Param("id"))
+	if id == uuid.Nil {
+		log.Errorf("Invalid pipecatcall ID: %s", c.Param("id"))
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	if errHandle := h.pipecatcallHandler.RunnerGuardrailHandle(id, c); errHandle != nil {
+		log.Errorf("Could not handle guardrail request. pipecatcall_id: %s, err: %v", id, errHandle)
+		c.JSON(http.StatusBadRequest, gin.H{"error": errHandle.Error()})
+		return
+	}
+}
+
+func (h *httpHandler) guardrailInputHandle(c *gin.Context) {
+	log := logrus.WithFields(logrus.Fields{
+		"func": "guardrailInputHandle",
+	})
+
+	id := uuid.FromStringOrNil(c.Param("id"))
+	if id == uuid.Nil {
+		log.Errorf("Invalid pipecatcall ID: %s", c.Param("id"))
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	if errHandle := h.pipecatcallHandler.RunnerGuardrailInputHandle(id, c); errHandle != nil {
+		log.Errorf("Could not handle guardrail input request. pipecatcall_id: %s, err: %v", id, errHandle)
+		c.JSON(http.StatusBadRequest, gin.H{"error": errHandle.Error()})
+		return
+	}
+}
+
+func (h *httpHandler) responseCacheLookupHandle(c *gin.Context) {
+	log := logrus.WithFields(logrus.Fields{
+		"func": "responseCacheLookupHandle",
+	})
+
+	id := uuid.FromStringOrNil(c.Param("id"))
+	if id == uuid.Nil {
+		log.Errorf("Invalid pipecatcall ID: %s", c.Param("id"))
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	if errHandle := h.pipecatcallHandler.RunnerResponseCacheLookupHandle(id, c); errHandle != nil {
+		log.Errorf("Could not handle response cache lookup request. pipecatcall_id: %s, err: %v", id, errHandle)
+		c.JSON(http.StatusBadRequest, gin.H{"error": errHandle.Error()})
+		return
+	}
+}
+
+func (h *httpHandler) responseCacheStoreHandle(c *gin.Context) {
+	log := logrus.WithFields(logrus.Fields{
+		"func": "responseCacheStoreHandle",
+	})
+
+	id := uuid.FromStringOrNil(c.Param("id"))
+	if id == uuid.Nil {
+		log.Errorf("Invalid pipecatcall ID: %s", c.Param("id"))
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	if errHandle := h.pipecatcallHandler.RunnerResponseCacheStoreHandle(id, c); errHandle != nil {
+		log.Errorf("Could not handle response cache store request. pipecatcall_id: %s, err: %v", id, errHandle)
+		c.JSON(http.StatusBadRequest, gin.H{"error": errHandle.Error()})
+		return
+	}
+}
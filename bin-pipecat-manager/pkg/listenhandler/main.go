@@ -11,6 +11,7 @@ import (
 	cerrors "monorepo/bin-common-handler/models/errors"
 	"monorepo/bin-common-handler/models/sock"
 	"monorepo/bin-common-handler/pkg/sockhandler"
+	"monorepo/bin-common-handler/pkg/tracehandler"
 	"monorepo/bin-common-handler/pkg/utilhandler"
 	"monorepo/bin-pipecat-manager/pkg/dbhandler"
 	"monorepo/bin-pipecat-manager/pkg/pipecatcallhandler"
@@ -198,7 +199,8 @@ func (h *listenHandler) processRequest(m *sock.Request) (*sock.Response, error)
 		"func":    "processRequest",
 		"request": m,
 	})
-	ctx := context.Background()
+	ctx, span := tracehandler.StartServerSpan(context.Background(), m)
+	defer span.End()
 
 	var requestType string
 	var err error
@@ -0,0 +1,90 @@
+package pipecatcallhandler
+
+import (
+	"fmt"
+	"net/http"
+
+	"monorepo/bin-pipecat-manager/models/pipecatcall"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// Conversation guardrails.
+//
+// When the aicall has a guardrail config, the Python runner sends every user
+// turn to /:id/guardrail/input before it reaches the LLM, and every sentence of
+// the LLM output to /:id/guardrail before it reaches the TTS. The ai-manager
+// checks it against the aicall's guardrail and returns the text to speak
+// instead, which may be empty, and whether the turn or the sentence was blocked.
+
+// RunnerGuardrailHandle handles the runner's request to check a sentence of
+// the LLM output against the guardrail.
+func (h *pipecatcallHandler) RunnerGuardrailHandle(id uuid.UUID, c *gin.Context) error {
+	log := logrus.WithFields(logrus.Fields{
+		"func":           "RunnerGuardrailHandle",
+		"pipecatcall_id": id,
+	})
+	ctx := c.Request.Context()
+
+	pc, err := h.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("could not get pipecatcall: %w", err)
+	}
+
+	if pc.ReferenceType != pipecatcall.ReferenceTypeAICall {
+		return fmt.Errorf("pipecatcall reference type is not ai-call. reference_type: %s", pc.ReferenceType)
+	}
+
+	request := struct {
+		Text string `json:"text"`
+	}{}
+	if errBind := c.BindJSON(&request); errBind != nil {
+		return fmt.Errorf("could not bind guardrail request JSON: %w", errBind)
+	}
+
+	res, blocked, err := h.requestHandler.AIV1AIcallGuardrailCheck(ctx, pc.ReferenceID, request.Text)
+	if err != nil {
+		return fmt.Errorf("could not check the text via ai-manager: %w", err)
+	}
+	log.Debugf("Checked the LLM output. aicall_id: %s, blocked: %v", pc.ReferenceID, blocked)
+
+	c.JSON(http.StatusOK, gin.H{"text": res, "blocked": blocked})
+	return nil
+}
+
+// RunnerGuardrailInputHandle handles the runner's request to check a user turn
+// against the guardrail before the LLM answers it.
+func (h *pipecatcallHandler) RunnerGuardrailInputHandle(id uuid.UUID, c *gin.Context) error {
+	log := logrus.WithFields(logrus.Fields{
+		"func":           "RunnerGuardrailInputHandle",
+		"pipecatcall_id": id,
+	})
+	ctx := c.Request.Context()
+
+	pc, err := h.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("could not get pipecatcall: %w", err)
+	}
+
+	if pc.ReferenceType != pipecatcall.ReferenceTypeAICall {
+		return fmt.Errorf("pipecatcall reference type is not ai-call. reference_type: %s", pc.ReferenceType)
+	}
+
+	request := struct {
+		Text string `json:"text"`
+	}{}
+	if errBind := c.BindJSON(&request); errBind != nil {
+		return fmt.Errorf("could not bind guardrail input request JSON: %w", errBind)
+	}
+
+	res, blocked, err := h.requestHandler.AIV1AIcallGuardrailInputCheck(ctx, pc.ReferenceID, request.Text)
+	if err != nil {
+		return fmt.Errorf("could not check the text via ai-manager: %w", err)
+	}
+	log.Debugf("Checked the user input. aicall_id: %s, blocked: %v", pc.ReferenceID, blocked)
+
+	c.JSON(http.StatusOK, gin.H{"text": res, "blocked": blocked})
+	return nil
+}
@@ -0,0 +1,318 @@
+package pipecatcallhandler
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	amai "monorepo/bin-ai-manager/models/ai"
+	amaicall "monorepo/bin-ai-manager/models/aicall"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/requesthandler"
+	"monorepo/bin-pipecat-manager/models/pipecatcall"
+	"monorepo/bin-pipecat-manager/pkg/dbhandler"
+	"monorepo/bin-pipecat-manager/pkg/toolhandler"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func Test_RunnerGuardrailHandle(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		id      uuid.UUID
+		reqBody []byte
+
+		responsePipecatcall *pipecatcall.Pipecatcall
+		responseText        string
+		responseBlocked     bool
+
+		expectText string
+		expectRes  string
+	}{
+		{
+			name: "normal",
+
+			id:      uuid.FromStringOrNil("d4b1e2a0-ad2d-11f0-9a3e-5f1d7c2b8e41"),
+			reqBody: []byte(`{"text":"This fund has a guaranteed return."}`),
+
+			responsePipecatcall: &pipecatcall.Pipecatcall{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("d4b1e2a0-ad2d-11f0-9a3e-5f1d7c2b8e41"),
+				},
+				ReferenceType: pipecatcall.ReferenceTypeAICall,
+				ReferenceID:   uuid.FromStringOrNil("d4e3f6b2-ad2d-11f0-8b7d-2a9e4f1c6d53"),
+			},
+			responseText:    "Let me connect you to one of our agents.",
+			responseBlocked: true,
+
+			expectText: "This fund has a guaranteed return.",
+			expectRes:  `{"blocked":true,"text":"Let me connect you to one of our agents."}`,
+		},
+		{
+			name: "passed",
+
+			id:      uuid.FromStringOrNil("9c2f6a1e-b0b4-11f0-8d3a-4e7b1c9f2a60"),
+			reqBody: []byte(`{"text":"How can I help you?"}`),
+
+			responsePipecatcall: &pipecatcall.Pipecatcall{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("9c2f6a1e-b0b4-11f0-8d3a-4e7b1c9f2a60"),
+				},
+				ReferenceType: pipecatcall.ReferenceTypeAICall,
+				ReferenceID:   uuid.FromStringOrNil("9c5d8e34-b0b4-11f0-a1f7-2b6c9d3e5f71"),
+			},
+			responseText: "How can I help you?",
+
+			expectText: "How can I help you?",
+			expectRes:  `{"blocked":false,"text":"How can I help you?"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			h := &pipecatcallHandler{
+				db:             mockDB,
+				requestHandler: mockReq,
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request, _ = http.NewRequest(http.MethodPost, "/"+tt.id.String()+"/guardrail", bytes.NewBuffer(tt.reqBody))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			mockDB.EXPECT().PipecatcallGet(gomock.Any(), tt.id).Return(tt.responsePipecatcall, nil)
+			mockReq.EXPECT().AIV1AIcallGuardrailCheck(gomock.Any(), tt.responsePipecatcall.ReferenceID, tt.expectText).Return(tt.responseText, tt.responseBlocked, nil)
+
+			if err := h.RunnerGuardrailHandle(tt.id, c); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if w.Body.String() != tt.expectRes {
+				t.Errorf("Wrong match.\nexpect: %s\ngot: %s", tt.expectRes, w.Body.String())
+			}
+		})
+	}
+}
+
+func Test_RunnerGuardrailHandle_notAIcall(t *testing.T) {
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockDB := dbhandler.NewMockDBHandler(mc)
+	mockReq := requesthandler.NewMockRequestHandler(mc)
+	h := &pipecatcallHandler{
+		db:             mockDB,
+		requestHandler: mockReq,
+	}
+
+	id := uuid.FromStringOrNil("d51a7d84-ad2d-11f0-a4c2-7e3b9d1f5a62")
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest(http.MethodPost, "/"+id.String()+"/guardrail", bytes.NewBufferString(`{"text":"hello"}`))
+	c.Request.Header.Set("Content-Type", "application/json")
+
+	mockDB.EXPECT().PipecatcallGet(gomock.Any(), id).Return(&pipecatcall.Pipecatcall{
+		Identity: commonidentity.Identity{
+			ID: id,
+		},
+		ReferenceType: pipecatcall.ReferenceTypeCall,
+	}, nil)
+
+	if err := h.RunnerGuardrailHandle(id, c); err == nil {
+		t.Errorf("Wrong match. expect: error, got: ok")
+	}
+}
+
+func Test_RunnerGuardrailInputHandle(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		id      uuid.UUID
+		reqBody []byte
+
+		responsePipecatcall *pipecatcall.Pipecatcall
+		responseText        string
+		responseBlocked     bool
+
+		expectText string
+		expectRes  string
+	}{
+		{
+			name: "normal",
+
+			id:      uuid.FromStringOrNil("e1a2b3c4-ad2d-11f0-9b1a-6a2e8d3c9f52"),
+			reqBody: []byte(`{"text":"Can you give me some investment advice?"}`),
+
+			responsePipecatcall: &pipecatcall.Pipecatcall{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("e1a2b3c4-ad2d-11f0-9b1a-6a2e8d3c9f52"),
+				},
+				ReferenceType: pipecatcall.ReferenceTypeAICall,
+				ReferenceID:   uuid.FromStringOrNil("e1d4c5e6-ad2d-11f0-8c2b-7b3f9e4dae63"),
+			},
+			responseText:    "Let me connect you to one of our agents.",
+			responseBlocked: true,
+
+			expectText: "Can you give me some investment advice?",
+			expectRes:  `{"blocked":true,"text":"Let me connect you to one of our agents."}`,
+		},
+		{
+			name: "passed",
+
+			id:      uuid.FromStringOrNil("e2065708-ad2d-11f0-9d3c-8c4fae5ebf74"),
+			reqBody: []byte(`{"text":"What is my balance?"}`),
+
+			responsePipecatcall: &pipecatcall.Pipecatcall{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("e2065708-ad2d-11f0-9d3c-8c4fae5ebf74"),
+				},
+				ReferenceType: pipecatcall.ReferenceTypeAICall,
+				ReferenceID:   uuid.FromStringOrNil("e237e92a-ad2d-11f0-ae4d-9d5abf6fc085"),
+			},
+
+			expectText: "What is my balance?",
+			expectRes:  `{"blocked":false,"text":""}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			h := &pipecatcallHandler{
+				db:             mockDB,
+				requestHandler: mockReq,
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request, _ = http.NewRequest(http.MethodPost, "/"+tt.id.String()+"/guardrail/input", bytes.NewBuffer(tt.reqBody))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			mockDB.EXPECT().PipecatcallGet(gomock.Any(), tt.id).Return(tt.responsePipecatcall, nil)
+			mockReq.EXPECT().AIV1AIcallGuardrailInputCheck(gomock.Any(), tt.responsePipecatcall.ReferenceID, tt.expectText).Return(tt.responseText, tt.responseBlocked, nil)
+
+			if err := h.RunnerGuardrailInputHandle(tt.id, c); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if w.Body.String() != tt.expectRes {
+				t.Errorf("Wrong match.\nexpect: %s\ngot: %s", tt.expectRes, w.Body.String())
+			}
+		})
+	}
+}
+
+func Test_RunnerGuardrailInputHandle_notAIcall(t *testing.T) {
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockDB := dbhandler.NewMockDBHandler(mc)
+	mockReq := requesthandler.NewMockRequestHandler(mc)
+	h := &pipecatcallHandler{
+		db:             mockDB,
+		requestHandler: mockReq,
+	}
+
+	id := uuid.FromStringOrNil("e2697b4c-ad2d-11f0-8f5e-ae6bc07ad196")
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest(http.MethodPost, "/"+id.String()+"/guardrail/input", bytes.NewBufferString(`{"text":"hello"}`))
+	c.Request.Header.Set("Content-Type", "application/json")
+
+	mockDB.EXPECT().PipecatcallGet(gomock.Any(), id).Return(&pipecatcall.Pipecatcall{
+		Identity: commonidentity.Identity{
+			ID: id,
+		},
+		ReferenceType: pipecatcall.ReferenceTypeCall,
+	}, nil)
+
+	if err := h.RunnerGuardrailInputHandle(id, c); err == nil {
+		t.Errorf("Wrong match. expect: error, got: ok")
+	}
+}
+
+// Test_runnerStartScript_guardrailEnabled verifies that the runner is told to
+// check the LLM output when the aicall has a guardrail config.
+func Test_runnerStartScript_guardrailEnabled(t *testing.T) {
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockReq := requesthandler.NewMockRequestHandler(mc)
+	mockTool := toolhandler.NewMockToolHandler(mc)
+	mockPython := NewMockPythonRunner(mc)
+
+	h := &pipecatcallHandler{
+		requestHandler: mockReq,
+		toolHandler:    mockTool,
+		pythonRunner:   mockPython,
+	}
+
+	aicallID := uuid.FromStringOrNil("d54c0e36-ad2d-11f0-b3d1-9f6a2e8c4b73")
+	aiID := uuid.FromStringOrNil("d57d92ea-ad2d-11f0-95e8-1c4d7b3a9e84")
+
+	pc := &pipecatcall.Pipecatcall{
+		Identity: commonidentity.Identity{
+			ID: uuid.FromStringOrNil("d5aef5a0-ad2d-11f0-8c6f-3e8b1d5f7a95"),
+		},
+		ReferenceType: pipecatcall.ReferenceTypeAICall,
+		ReferenceID:   aicallID,
+		LLMType:       pipecatcall.LLMType("openai.gpt-5"),
+	}
+	se := &pipecatcall.Session{
+		Ctx:    context.Background(),
+		LLMKey: "key-1",
+	}
+
+	mockReq.EXPECT().AIV1AIcallGet(gomock.Any(), aicallID).Return(&amaicall.AIcall{
+		Identity: commonidentity.Identity{
+			ID: aicallID,
+		},
+		AssistanceType: amaicall.AssistanceTypeAI,
+		AssistanceID:   aiID,
+		Metadata: map[string]any{
+			amaicall.MetaKeyGuardrail: map[string]any{
+				"forbidden_phrases": []any{"guaranteed return"},
+			},
+		},
+	}, nil)
+	mockReq.EXPECT().AIV1AIGet(gomock.Any(), aiID).Return(&amai.AI{
+		Identity: commonidentity.Identity{
+			ID: aiID,
+		},
+		Type:        amai.TypeNormal,
+		EngineModel: amai.EngineModel("openai.gpt-5"),
+		EngineKey:   "key-1",
+	}, nil)
+	mockTool.EXPECT().GetByNames(gomock.Any(), gomock.Any()).Return(nil)
+	mockTool.EXPECT().GetCustomTools(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	mockTool.EXPECT().GetMCPTools(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+	mockPython.EXPECT().Start(
+		gomock.Any(), pc.ID, gomock.Any(), gomock.Any(), gomock.Any(),
+		gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+		gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+		gomock.Any(), true, gomock.Any(),
+	).Return(nil)
+
+	if err := h.runnerStartScript(pc, se); err != nil {
+		t.Fatalf("runnerStartScript returned unexpected error: %v", err)
+	}
+}
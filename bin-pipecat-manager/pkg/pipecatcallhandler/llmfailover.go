@@ -0,0 +1,161 @@
+package pipecatcallhandler
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+
+	amai "monorepo/bin-ai-manager/models/ai"
+	"monorepo/bin-pipecat-manager/models/pipecatcall"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// LLM engine failover.
+//
+// An AI carries an ordered chain of engines: its primary EngineModel/EngineKey
+// followed by its EngineFallbacks. The whole chain is handed to the Python
+// runner, which switches to the next engine when the active one errors (rate
+// limit, outage) and replays the pending turn on it, so the caller never
+// notices. The runner reports every switch to /:id/llm-failover.
+//
+// Health is tracked per engine model and engine key by llmBreaker, shared by
+// all pipecatcalls of this host: a reported failover counts as a failure of the
+// engine that was left, and a completed bot response counts as a success of the
+// engine that produced it. An engine using a customer's own key has its own
+// breaker, and its authentication and rate limit errors are not counted at
+// all: they say nothing about the provider's health. When a runner starts,
+// engines whose breaker is open are moved to the end of the chain, so new
+// pipecatcalls stop trying them first while keeping them as a last resort.
+
+// llmBreakerNamespace is the Prometheus namespace of the LLM circuit breaker
+// metrics. It must differ from the one of the requesthandler's breaker.
+const llmBreakerNamespace = "pipecat_manager_llm"
+
+// llmKeyHashLength is the length of the engine key hash.
+const llmKeyHashLength = 16
+
+// llmBreakerTarget returns the circuit breaker target of the given engine model
+// and engine key hash. The engines using the platform's key share the target of
+// the model.
+func llmBreakerTarget(engineModel string, engineKeyHash string) string {
+	if engineKeyHash == "" {
+		return "llm:" + engineModel
+	}
+
+	return "llm:" + engineModel + ":" + engineKeyHash
+}
+
+// llmKeyHash returns the hash identifying the given engine key, or empty for
+// the platform's key. The runner computes the same hash (common.engine_key_hash),
+// so it can report the engine without sending the key back.
+func llmKeyHash(engineKey string) string {
+	if engineKey == "" {
+		return ""
+	}
+
+	sum := sha256.Sum256([]byte(engineKey))
+	return hex.EncodeToString(sum[:])[:llmKeyHashLength]
+}
+
+// llmIsCustomerKeyError returns true if the failover was caused by the
+// customer's own key being rejected or rate limited, rather than by the
+// provider. Such errors don't count as failures of the engine.
+func llmIsCustomerKeyError(engineKeyHash string, statusCode int) bool {
+	if engineKeyHash == "" {
+		return false
+	}
+
+	switch statusCode {
+	case http.StatusUnauthorized, http.StatusForbidden, http.StatusTooManyRequests:
+		return true
+	default:
+		return false
+	}
+}
+
+// llmEngineChainOrder returns the given engine chain with the engines whose
+// breaker is open moved to the end. The relative order within the healthy and
+// the unhealthy engines is kept.
+func (h *pipecatcallHandler) llmEngineChainOrder(chain []amai.EngineFallback) []amai.EngineFallback {
+	if len(chain) < 2 {
+		return chain
+	}
+
+	healthy := make([]amai.EngineFallback, 0, len(chain))
+	unhealthy := []amai.EngineFallback{}
+	for _, e := range chain {
+		if errAllow := h.llmBreaker.Allow(llmBreakerTarget(string(e.EngineModel), llmKeyHash(e.EngineKey))); errAllow != nil {
+			unhealthy = append(unhealthy, e)
+			continue
+		}
+		healthy = append(healthy, e)
+	}
+
+	return append(healthy, unhealthy...)
+}
+
+// llmEngineChainSplit splits a non-empty engine chain into the engine to start
+// with and its fallbacks. The fallbacks are nil when there are none.
+func llmEngineChainSplit(chain []amai.EngineFallback) (amai.EngineFallback, []amai.EngineFallback) {
+	if len(chain) < 2 {
+		return chain[0], nil
+	}
+
+	return chain[0], chain[1:]
+}
+
+// llmRecordSuccess records a completed bot response of the session's current engine.
+func (h *pipecatcallHandler) llmRecordSuccess(se *pipecatcall.Session) {
+	engineModel := se.LLMEngineModel()
+	if engineModel == "" {
+		return
+	}
+
+	h.llmBreaker.RecordSuccess(llmBreakerTarget(engineModel, se.LLMEngineKeyHash()))
+}
+
+// RunnerLLMFailoverHandle handles the runner's notification that it switched
+// to the next LLM engine of the chain.
+func (h *pipecatcallHandler) RunnerLLMFailoverHandle(id uuid.UUID, c *gin.Context) error {
+	log := logrus.WithFields(logrus.Fields{
+		"func":           "RunnerLLMFailoverHandle",
+		"pipecatcall_id": id,
+	})
+
+	se, err := h.SessionGet(id)
+	if err != nil {
+		return fmt.Errorf("could not get pipecatcall session: %w", err)
+	}
+
+	request := struct {
+		FromEngineModel   string `json:"from_engine_model"`
+		FromEngineKeyHash string `json:"from_engine_key_hash"`
+		ToEngineModel     string `json:"to_engine_model"`
+		ToEngineKeyHash   string `json:"to_engine_key_hash"`
+		Error             string `json:"error"`
+		StatusCode        int    `json:"status_code"` // provider's http status of the error. 0 if unknown.
+	}{}
+	if errBind := c.BindJSON(&request); errBind != nil {
+		return fmt.Errorf("could not bind llm-failover request JSON: %w", errBind)
+	}
+
+	if request.FromEngineModel == "" || request.ToEngineModel == "" {
+		return fmt.Errorf("invalid llm-failover request. from_engine_model: %s, to_engine_model: %s", request.FromEngineModel, request.ToEngineModel)
+	}
+
+	if llmIsCustomerKeyError(request.FromEngineKeyHash, request.StatusCode) {
+		log.Debugf("The customer's engine key was rejected. Not counted as an engine failure. engine_model: %s, status_code: %d", request.FromEngineModel, request.StatusCode)
+	} else {
+		h.llmBreaker.RecordFailure(llmBreakerTarget(request.FromEngineModel, request.FromEngineKeyHash))
+	}
+	se.SetLLMEngine(request.ToEngineModel, request.ToEngineKeyHash)
+	metricsLLMFailoverTotal.WithLabelValues(request.FromEngineModel, request.ToEngineModel).Inc()
+	log.Infof("LLM engine failed over. from: %s, to: %s, status_code: %d, error: %s", request.FromEngineModel, request.ToEngineModel, request.StatusCode, request.Error)
+
+	c.JSON(http.StatusOK, gin.H{"status": "ok"})
+	return nil
+}
@@ -0,0 +1,434 @@
+package pipecatcallhandler
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	amai "monorepo/bin-ai-manager/models/ai"
+	amaicall "monorepo/bin-ai-manager/models/aicall"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/circuitbreakerhandler"
+	"monorepo/bin-common-handler/pkg/requesthandler"
+	"monorepo/bin-pipecat-manager/models/pipecatcall"
+	"monorepo/bin-pipecat-manager/pkg/toolhandler"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func Test_llmEngineChainOrder(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		chain       []amai.EngineFallback
+		openTargets map[string]bool
+
+		expectRes []amai.EngineFallback
+	}{
+		{
+			name: "single engine is not checked",
+
+			chain: []amai.EngineFallback{
+				{EngineModel: "openai.gpt-5", EngineKey: "key-1"},
+			},
+
+			expectRes: []amai.EngineFallback{
+				{EngineModel: "openai.gpt-5", EngineKey: "key-1"},
+			},
+		},
+		{
+			name: "all healthy keeps the order",
+
+			chain: []amai.EngineFallback{
+				{EngineModel: "openai.gpt-5", EngineKey: "key-1"},
+				{EngineModel: "gemini.gemini-2.5-flash", EngineKey: "key-2"},
+				{EngineModel: "grok.grok-3", EngineKey: "key-3"},
+			},
+			openTargets: map[string]bool{},
+
+			expectRes: []amai.EngineFallback{
+				{EngineModel: "openai.gpt-5", EngineKey: "key-1"},
+				{EngineModel: "gemini.gemini-2.5-flash", EngineKey: "key-2"},
+				{EngineModel: "grok.grok-3", EngineKey: "key-3"},
+			},
+		},
+		{
+			name: "open engines move to the end",
+
+			chain: []amai.EngineFallback{
+				{EngineModel: "openai.gpt-5", EngineKey: "key-1"},
+				{EngineModel: "gemini.gemini-2.5-flash", EngineKey: "key-2"},
+				{EngineModel: "openai.gpt-5", EngineKey: "key-3"},
+				{EngineModel: "grok.grok-3", EngineKey: "key-4"},
+			},
+			openTargets: map[string]bool{
+				llmBreakerTarget("openai.gpt-5", llmKeyHash("key-1")): true,
+			},
+
+			expectRes: []amai.EngineFallback{
+				{EngineModel: "gemini.gemini-2.5-flash", EngineKey: "key-2"},
+				{EngineModel: "openai.gpt-5", EngineKey: "key-3"},
+				{EngineModel: "grok.grok-3", EngineKey: "key-4"},
+				{EngineModel: "openai.gpt-5", EngineKey: "key-1"},
+			},
+		},
+		{
+			name: "engines of the platform key share the breaker of the model",
+
+			chain: []amai.EngineFallback{
+				{EngineModel: "openai.gpt-5"},
+				{EngineModel: "gemini.gemini-2.5-flash"},
+				{EngineModel: "openai.gpt-5", EngineKey: "key-1"},
+			},
+			openTargets: map[string]bool{
+				"llm:openai.gpt-5": true,
+			},
+
+			expectRes: []amai.EngineFallback{
+				{EngineModel: "gemini.gemini-2.5-flash"},
+				{EngineModel: "openai.gpt-5", EngineKey: "key-1"},
+				{EngineModel: "openai.gpt-5"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockBreaker := circuitbreakerhandler.NewMockCircuitBreakerHandler(mc)
+			h := &pipecatcallHandler{
+				llmBreaker: mockBreaker,
+			}
+
+			mockBreaker.EXPECT().Allow(gomock.Any()).DoAndReturn(func(target string) error {
+				if tt.openTargets[target] {
+					return fmt.Errorf("circuit open")
+				}
+				return nil
+			}).AnyTimes()
+
+			res := h.llmEngineChainOrder(tt.chain)
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func Test_llmEngineChainSplit(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		chain []amai.EngineFallback
+
+		expectEngine    amai.EngineFallback
+		expectFallbacks []amai.EngineFallback
+	}{
+		{
+			name: "single engine",
+
+			chain: []amai.EngineFallback{
+				{EngineModel: "openai.gpt-5", EngineKey: "key-1"},
+			},
+
+			expectEngine: amai.EngineFallback{EngineModel: "openai.gpt-5", EngineKey: "key-1"},
+		},
+		{
+			name: "with fallbacks",
+
+			chain: []amai.EngineFallback{
+				{EngineModel: "openai.gpt-5", EngineKey: "key-1"},
+				{EngineModel: "grok.grok-3", EngineKey: "key-2"},
+			},
+
+			expectEngine: amai.EngineFallback{EngineModel: "openai.gpt-5", EngineKey: "key-1"},
+			expectFallbacks: []amai.EngineFallback{
+				{EngineModel: "grok.grok-3", EngineKey: "key-2"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine, fallbacks := llmEngineChainSplit(tt.chain)
+			if engine != tt.expectEngine {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectEngine, engine)
+			}
+			if !reflect.DeepEqual(fallbacks, tt.expectFallbacks) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectFallbacks, fallbacks)
+			}
+		})
+	}
+}
+
+func Test_RunnerLLMFailoverHandle(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		id      uuid.UUID
+		reqBody []byte
+
+		expectTarget        string
+		expectEngineModel   string
+		expectEngineKeyHash string
+	}{
+		{
+			name: "platform key",
+
+			id:      uuid.FromStringOrNil("3b0f6c52-9b7e-11f1-8c1a-4f2e6d8a9b01"),
+			reqBody: []byte(`{"from_engine_model":"openai.gpt-5","to_engine_model":"gemini.gemini-2.5-flash","error":"429 rate limited","status_code":429}`),
+
+			expectTarget:      "llm:openai.gpt-5",
+			expectEngineModel: "gemini.gemini-2.5-flash",
+		},
+		{
+			name: "customer key with a provider error",
+
+			id:      uuid.FromStringOrNil("6e0b6a8c-ad97-11f0-8a4e-2b7c9d1e3f01"),
+			reqBody: []byte(`{"from_engine_model":"openai.gpt-5","from_engine_key_hash":"1a2b3c4d5e6f7a8b","to_engine_model":"openai.gpt-5","to_engine_key_hash":"8b7a6f5e4d3c2b1a","error":"service unavailable","status_code":503}`),
+
+			expectTarget:        "llm:openai.gpt-5:1a2b3c4d5e6f7a8b",
+			expectEngineModel:   "openai.gpt-5",
+			expectEngineKeyHash: "8b7a6f5e4d3c2b1a",
+		},
+		{
+			name: "customer key rejected is not counted",
+
+			id:      uuid.FromStringOrNil("6e3f5c1a-ad97-11f0-9b5f-3c8d0e2f4a02"),
+			reqBody: []byte(`{"from_engine_model":"openai.gpt-5","from_engine_key_hash":"1a2b3c4d5e6f7a8b","to_engine_model":"gemini.gemini-2.5-flash","error":"Error code: 401","status_code":401}`),
+
+			expectEngineModel: "gemini.gemini-2.5-flash",
+		},
+		{
+			name: "customer key rate limited is not counted",
+
+			id:      uuid.FromStringOrNil("6e72c4de-ad97-11f0-8c60-4d9e1f3a5b03"),
+			reqBody: []byte(`{"from_engine_model":"openai.gpt-5","from_engine_key_hash":"1a2b3c4d5e6f7a8b","to_engine_model":"gemini.gemini-2.5-flash","error":"429 rate limited","status_code":429}`),
+
+			expectEngineModel: "gemini.gemini-2.5-flash",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockBreaker := circuitbreakerhandler.NewMockCircuitBreakerHandler(mc)
+			h := &pipecatcallHandler{
+				llmBreaker:            mockBreaker,
+				mapPipecatcallSession: map[uuid.UUID]*pipecatcall.Session{},
+			}
+
+			se := &pipecatcall.Session{
+				Identity: commonidentity.Identity{
+					ID: tt.id,
+				},
+			}
+			se.SetLLMEngine("openai.gpt-5", "")
+			h.mapPipecatcallSession[tt.id] = se
+
+			if tt.expectTarget != "" {
+				mockBreaker.EXPECT().RecordFailure(tt.expectTarget)
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request, _ = http.NewRequest(http.MethodPost, "/"+tt.id.String()+"/llm-failover", bytes.NewBuffer(tt.reqBody))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			if err := h.RunnerLLMFailoverHandle(tt.id, c); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if w.Code != http.StatusOK {
+				t.Errorf("Wrong match. expect: %d, got: %d", http.StatusOK, w.Code)
+			}
+			if res := se.LLMEngineModel(); res != tt.expectEngineModel {
+				t.Errorf("Wrong match. expect: %s, got: %s", tt.expectEngineModel, res)
+			}
+			if res := se.LLMEngineKeyHash(); res != tt.expectEngineKeyHash {
+				t.Errorf("Wrong match. expect: %s, got: %s", tt.expectEngineKeyHash, res)
+			}
+		})
+	}
+}
+
+func Test_RunnerLLMFailoverHandle_error(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		id      uuid.UUID
+		reqBody []byte
+	}{
+		{
+			name: "missing to_engine_model",
+
+			id:      uuid.FromStringOrNil("3b4a1e84-9b7e-11f1-9d2b-5a3f7e9bac02"),
+			reqBody: []byte(`{"from_engine_model":"openai.gpt-5"}`),
+		},
+		{
+			name: "invalid json",
+
+			id:      uuid.FromStringOrNil("3b4a1e84-9b7e-11f1-9d2b-5a3f7e9bac02"),
+			reqBody: []byte(`{`),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockBreaker := circuitbreakerhandler.NewMockCircuitBreakerHandler(mc)
+			h := &pipecatcallHandler{
+				llmBreaker: mockBreaker,
+				mapPipecatcallSession: map[uuid.UUID]*pipecatcall.Session{
+					tt.id: {},
+				},
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request, _ = http.NewRequest(http.MethodPost, "/"+tt.id.String()+"/llm-failover", bytes.NewBuffer(tt.reqBody))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			if err := h.RunnerLLMFailoverHandle(tt.id, c); err == nil {
+				t.Errorf("Wrong match. expect: error, got: ok")
+			}
+		})
+	}
+}
+
+// Test_runnerStartScript_engineFallbacks verifies that the runner is started
+// with the healthiest engine of the AI's chain and the rest as fallbacks, and
+// that the session records the engine it starts with.
+func Test_runnerStartScript_engineFallbacks(t *testing.T) {
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockReq := requesthandler.NewMockRequestHandler(mc)
+	mockTool := toolhandler.NewMockToolHandler(mc)
+	mockPython := NewMockPythonRunner(mc)
+	mockBreaker := circuitbreakerhandler.NewMockCircuitBreakerHandler(mc)
+
+	h := &pipecatcallHandler{
+		requestHandler: mockReq,
+		toolHandler:    mockTool,
+		pythonRunner:   mockPython,
+		llmBreaker:     mockBreaker,
+	}
+
+	aicallID := uuid.FromStringOrNil("3b84d0b6-9b7e-11f1-8e3c-6b4a8facbd03")
+	aiID := uuid.FromStringOrNil("3bbf7a2e-9b7e-11f1-9f4d-7c5b9abdce04")
+
+	pc := &pipecatcall.Pipecatcall{
+		Identity: commonidentity.Identity{
+			ID: uuid.FromStringOrNil("3bfa23a6-9b7e-11f1-a05e-8d6cabcedf05"),
+		},
+		ReferenceType: pipecatcall.ReferenceTypeAICall,
+		ReferenceID:   aicallID,
+		LLMType:       pipecatcall.LLMType("openai.gpt-5"),
+	}
+	se := &pipecatcall.Session{
+		Ctx:    context.Background(),
+		LLMKey: "key-1",
+	}
+
+	mockReq.EXPECT().AIV1AIcallGet(gomock.Any(), aicallID).Return(&amaicall.AIcall{
+		Identity: commonidentity.Identity{
+			ID: aicallID,
+		},
+		AssistanceType: amaicall.AssistanceTypeAI,
+		AssistanceID:   aiID,
+	}, nil)
+	mockReq.EXPECT().AIV1AIGet(gomock.Any(), aiID).Return(&amai.AI{
+		Identity: commonidentity.Identity{
+			ID: aiID,
+		},
+		Type:        amai.TypeNormal,
+		EngineModel: amai.EngineModel("openai.gpt-5"),
+		EngineKey:   "key-1",
+		EngineFallbacks: []amai.EngineFallback{
+			{EngineModel: "gemini.gemini-2.5-flash", EngineKey: "key-2"},
+			{EngineModel: "grok.grok-3", EngineKey: "key-3"},
+		},
+	}, nil)
+	mockTool.EXPECT().GetByNames(gomock.Any(), gomock.Any()).Return(nil)
+	mockTool.EXPECT().GetCustomTools(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	mockTool.EXPECT().GetMCPTools(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+	// the primary engine is rate-limited
+	mockBreaker.EXPECT().Allow(llmBreakerTarget("openai.gpt-5", llmKeyHash("key-1"))).Return(fmt.Errorf("circuit open"))
+	mockBreaker.EXPECT().Allow(llmBreakerTarget("gemini.gemini-2.5-flash", llmKeyHash("key-2"))).Return(nil)
+	mockBreaker.EXPECT().Allow(llmBreakerTarget("grok.grok-3", llmKeyHash("key-3"))).Return(nil)
+
+	mockPython.EXPECT().Start(
+		gomock.Any(), pc.ID,
+		"gemini.gemini-2.5-flash",
+		"key-2",
+		[]amai.EngineFallback{
+			{EngineModel: "grok.grok-3", EngineKey: "key-3"},
+			{EngineModel: "openai.gpt-5", EngineKey: "key-1"},
+		},
+		gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+		gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+		gomock.Any(), gomock.Any(), gomock.Any(),
+	).Return(nil)
+
+	if err := h.runnerStartScript(pc, se); err != nil {
+		t.Fatalf("runnerStartScript returned unexpected error: %v", err)
+	}
+
+	if res := se.LLMEngineModel(); res != "gemini.gemini-2.5-flash" {
+		t.Errorf("Wrong match. expect: gemini.gemini-2.5-flash, got: %s", res)
+	}
+	if res := se.LLMEngineKeyHash(); res != llmKeyHash("key-2") {
+		t.Errorf("Wrong match. expect: %s, got: %s", llmKeyHash("key-2"), res)
+	}
+}
+
+func Test_llmKeyHash(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		engineKey string
+
+		expectRes string
+	}{
+		{
+			name: "platform key",
+
+			engineKey: "",
+			expectRes: "",
+		},
+		{
+			name: "customer key",
+
+			// must match common.engine_key_hash of the runner
+			engineKey: "sk-test",
+			expectRes: "f3abf2a6cc4f0098",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if res := llmKeyHash(tt.engineKey); res != tt.expectRes {
+				t.Errorf("Wrong match. expect: %s, got: %s", tt.expectRes, res)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ package pipecatcallhandler
 
 import (
 	"context"
+	"monorepo/bin-common-handler/pkg/circuitbreakerhandler"
 	"monorepo/bin-common-handler/pkg/notifyhandler"
 	"monorepo/bin-common-handler/pkg/requesthandler"
 	"monorepo/bin-common-handler/pkg/utilhandler"
@@ -46,6 +47,12 @@ type PipecatcallHandler interface {
 	RunnerWebsocketHandle(id uuid.UUID, c *gin.Context) error
 	RunnerToolHandle(id uuid.UUID, c *gin.Context) error
 	RunnerMemberSwitchedHandle(id uuid.UUID, c *gin.Context) error
+	RunnerLLMFailoverHandle(id uuid.UUID, c *gin.Context) error
+	RunnerRedactHandle(id uuid.UUID, c *gin.Context) error
+	RunnerGuardrailHandle(id uuid.UUID, c *gin.Context) error
+	RunnerGuardrailInputHandle(id uuid.UUID, c *gin.Context) error
+	RunnerResponseCacheLookupHandle(id uuid.UUID, c *gin.Context) error
+	RunnerResponseCacheStoreHandle(id uuid.UUID, c *gin.Context) error
 
 	Ping(ctx context.Context) (*pipecatcall.PingResult, error)
 }
@@ -78,6 +85,10 @@ type pipecatcallHandler struct {
 	websocketHandler    WebsocketHandler
 	pipecatframeHandler PipecatframeHandler
 
+	// llmBreaker tracks the health of each LLM engine model across
+	// pipecatcalls. See llmfailover.go.
+	llmBreaker circuitbreakerhandler.CircuitBreakerHandler
+
 	hostID string
 
 	mapPipecatcallSession map[uuid.UUID]*pipecatcall.Session
@@ -107,6 +118,8 @@ func NewPipecatcallHandler(
 		websocketHandler:    NewWebsocketHandler(),
 		pipecatframeHandler: NewPipecatframeHandler(),
 
+		llmBreaker: circuitbreakerhandler.NewCircuitBreakerHandler(llmBreakerNamespace),
+
 		hostID: hostID,
 
 		mapPipecatcallSession: make(map[uuid.UUID]*pipecatcall.Session),
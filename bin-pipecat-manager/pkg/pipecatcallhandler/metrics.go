@@ -50,6 +50,16 @@ var (
 			Help: "Counter of runnerStartScript failing closed to an empty tool list after an AI lookup failure.",
 		},
 	)
+
+	// metricsLLMFailoverTotal counts the runner's switches from one LLM
+	// engine to the next one of the AI's engine chain.
+	metricsLLMFailoverTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "pipecat_manager_llm_failover_total",
+			Help: "Counter of LLM engine failovers reported by the runner, by engine model.",
+		},
+		[]string{"from", "to"},
+	)
 )
 
 func init() {
@@ -58,5 +68,6 @@ func init() {
 		metricsIdleWatchdogFired,
 		metricsFlushFinalizeOutcome,
 		metricsToolResolveFallbackTotal,
+		metricsLLMFailoverTotal,
 	)
 }
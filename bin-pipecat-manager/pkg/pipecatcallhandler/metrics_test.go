@@ -60,6 +60,10 @@ func TestNewMetrics_registered(t *testing.T) {
 			name:   "llm flush finalize outcome counter",
 			metric: "pipecat_manager_llm_flush_finalize_outcome_total",
 		},
+		{
+			name:   "llm failover counter",
+			metric: "pipecat_manager_llm_failover_total",
+		},
 	}
 
 	for _, tt := range tests {
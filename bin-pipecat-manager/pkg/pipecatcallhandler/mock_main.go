@@ -74,6 +74,48 @@ func (mr *MockPipecatcallHandlerMockRecorder) Ping(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockPipecatcallHandler)(nil).Ping), ctx)
 }
 
+// RunnerGuardrailHandle mocks base method.
+func (m *MockPipecatcallHandler) RunnerGuardrailHandle(id uuid.UUID, c *gin.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunnerGuardrailHandle", id, c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunnerGuardrailHandle indicates an expected call of RunnerGuardrailHandle.
+func (mr *MockPipecatcallHandlerMockRecorder) RunnerGuardrailHandle(id, c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunnerGuardrailHandle", reflect.TypeOf((*MockPipecatcallHandler)(nil).RunnerGuardrailHandle), id, c)
+}
+
+// RunnerGuardrailInputHandle mocks base method.
+func (m *MockPipecatcallHandler) RunnerGuardrailInputHandle(id uuid.UUID, c *gin.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunnerGuardrailInputHandle", id, c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunnerGuardrailInputHandle indicates an expected call of RunnerGuardrailInputHandle.
+func (mr *MockPipecatcallHandlerMockRecorder) RunnerGuardrailInputHandle(id, c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunnerGuardrailInputHandle", reflect.TypeOf((*MockPipecatcallHandler)(nil).RunnerGuardrailInputHandle), id, c)
+}
+
+// RunnerLLMFailoverHandle mocks base method.
+func (m *MockPipecatcallHandler) RunnerLLMFailoverHandle(id uuid.UUID, c *gin.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunnerLLMFailoverHandle", id, c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunnerLLMFailoverHandle indicates an expected call of RunnerLLMFailoverHandle.
+func (mr *MockPipecatcallHandlerMockRecorder) RunnerLLMFailoverHandle(id, c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunnerLLMFailoverHandle", reflect.TypeOf((*MockPipecatcallHandler)(nil).RunnerLLMFailoverHandle), id, c)
+}
+
 // RunnerMemberSwitchedHandle mocks base method.
 func (m *MockPipecatcallHandler) RunnerMemberSwitchedHandle(id uuid.UUID, c *gin.Context) error {
 	m.ctrl.T.Helper()
@@ -88,6 +130,48 @@ func (mr *MockPipecatcallHandlerMockRecorder) RunnerMemberSwitchedHandle(id, c a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunnerMemberSwitchedHandle", reflect.TypeOf((*MockPipecatcallHandler)(nil).RunnerMemberSwitchedHandle), id, c)
 }
 
+// RunnerRedactHandle mocks base method.
+func (m *MockPipecatcallHandler) RunnerRedactHandle(id uuid.UUID, c *gin.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunnerRedactHandle", id, c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunnerRedactHandle indicates an expected call of RunnerRedactHandle.
+func (mr *MockPipecatcallHandlerMockRecorder) RunnerRedactHandle(id, c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunnerRedactHandle", reflect.TypeOf((*MockPipecatcallHandler)(nil).RunnerRedactHandle), id, c)
+}
+
+// RunnerResponseCacheLookupHandle mocks base method.
+func (m *MockPipecatcallHandler) RunnerResponseCacheLookupHandle(id uuid.UUID, c *gin.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunnerResponseCacheLookupHandle", id, c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunnerResponseCacheLookupHandle indicates an expected call of RunnerResponseCacheLookupHandle.
+func (mr *MockPipecatcallHandlerMockRecorder) RunnerResponseCacheLookupHandle(id, c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunnerResponseCacheLookupHandle", reflect.TypeOf((*MockPipecatcallHandler)(nil).RunnerResponseCacheLookupHandle), id, c)
+}
+
+// RunnerResponseCacheStoreHandle mocks base method.
+func (m *MockPipecatcallHandler) RunnerResponseCacheStoreHandle(id uuid.UUID, c *gin.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunnerResponseCacheStoreHandle", id, c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunnerResponseCacheStoreHandle indicates an expected call of RunnerResponseCacheStoreHandle.
+func (mr *MockPipecatcallHandlerMockRecorder) RunnerResponseCacheStoreHandle(id, c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunnerResponseCacheStoreHandle", reflect.TypeOf((*MockPipecatcallHandler)(nil).RunnerResponseCacheStoreHandle), id, c)
+}
+
 // RunnerToolHandle mocks base method.
 func (m *MockPipecatcallHandler) RunnerToolHandle(id uuid.UUID, c *gin.Context) error {
 	m.ctrl.T.Helper()
@@ -44,17 +44,17 @@ func (m *MockPythonRunner) EXPECT() *MockPythonRunnerMockRecorder {
 }
 
 // Start mocks base method.
-func (m *MockPythonRunner) Start(ctx context.Context, pipecatcallID uuid.UUID, llmType, llmKey string, llmMessages []map[string]any, sttType, sttLanguage, ttsType, ttsLanguage, ttsVoiceID string, tools []tool.Tool, resolvedTeam *resolvedTeamData, vadConfig *ai.VADConfig, smartTurnEnabled bool) error {
+func (m *MockPythonRunner) Start(ctx context.Context, pipecatcallID uuid.UUID, llmType, llmKey string, llmFallbacks []ai.EngineFallback, llmMessages []map[string]any, sttType, sttLanguage, ttsType, ttsLanguage, ttsVoiceID string, tools []tool.Tool, resolvedTeam *resolvedTeamData, vadConfig *ai.VADConfig, smartTurnEnabled, redactionEnabled, guardrailEnabled, responseCacheEnabled bool) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Start", ctx, pipecatcallID, llmType, llmKey, llmMessages, sttType, sttLanguage, ttsType, ttsLanguage, ttsVoiceID, tools, resolvedTeam, vadConfig, smartTurnEnabled)
+	ret := m.ctrl.Call(m, "Start", ctx, pipecatcallID, llmType, llmKey, llmFallbacks, llmMessages, sttType, sttLanguage, ttsType, ttsLanguage, ttsVoiceID, tools, resolvedTeam, vadConfig, smartTurnEnabled, redactionEnabled, guardrailEnabled, responseCacheEnabled)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Start indicates an expected call of Start.
-func (mr *MockPythonRunnerMockRecorder) Start(ctx, pipecatcallID, llmType, llmKey, llmMessages, sttType, sttLanguage, ttsType, ttsLanguage, ttsVoiceID, tools, resolvedTeam, vadConfig, smartTurnEnabled any) *gomock.Call {
+func (mr *MockPythonRunnerMockRecorder) Start(ctx, pipecatcallID, llmType, llmKey, llmFallbacks, llmMessages, sttType, sttLanguage, ttsType, ttsLanguage, ttsVoiceID, tools, resolvedTeam, vadConfig, smartTurnEnabled, redactionEnabled, guardrailEnabled, responseCacheEnabled any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockPythonRunner)(nil).Start), ctx, pipecatcallID, llmType, llmKey, llmMessages, sttType, sttLanguage, ttsType, ttsLanguage, ttsVoiceID, tools, resolvedTeam, vadConfig, smartTurnEnabled)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockPythonRunner)(nil).Start), ctx, pipecatcallID, llmType, llmKey, llmFallbacks, llmMessages, sttType, sttLanguage, ttsType, ttsLanguage, ttsVoiceID, tools, resolvedTeam, vadConfig, smartTurnEnabled, redactionEnabled, guardrailEnabled, responseCacheEnabled)
 }
 
 // Stop mocks base method.
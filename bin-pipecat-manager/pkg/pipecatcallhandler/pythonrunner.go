@@ -42,6 +42,7 @@ type PythonRunner interface {
 		pipecatcallID uuid.UUID,
 		llmType string,
 		llmKey string,
+		llmFallbacks []amai.EngineFallback,
 		llmMessages []map[string]any,
 		sttType string,
 		sttLanguage string,
@@ -52,6 +53,9 @@ type PythonRunner interface {
 		resolvedTeam *resolvedTeamData,
 		vadConfig *amai.VADConfig,
 		smartTurnEnabled bool,
+		redactionEnabled bool,
+		guardrailEnabled bool,
+		responseCacheEnabled bool,
 	) error
 	Stop(ctx context.Context, pipecatcallID uuid.UUID) error
 }
@@ -65,6 +69,7 @@ func (h *pythonRunner) Start(
 	pipecatcallID uuid.UUID,
 	llmType string,
 	llmKey string,
+	llmFallbacks []amai.EngineFallback,
 	llmMessages []map[string]any,
 	sttType string,
 	sttLanguage string,
@@ -75,6 +80,9 @@ func (h *pythonRunner) Start(
 	resolvedTeam *resolvedTeamData,
 	vadConfig *amai.VADConfig,
 	smartTurnEnabled bool,
+	redactionEnabled bool,
+	guardrailEnabled bool,
+	responseCacheEnabled bool,
 ) error {
 	log := logrus.WithFields(logrus.Fields{
 		"func": "Start",
@@ -82,33 +90,41 @@ func (h *pythonRunner) Start(
 
 	// Request body structure for Python runner
 	reqBody := struct {
-		ID               uuid.UUID         `json:"id,omitempty"`
-		LLMType          string            `json:"llm_type,omitempty"`
-		LLMKey           string            `json:"llm_key,omitempty"`
-		LLMMessages      []map[string]any  `json:"llm_messages,omitempty"`
-		STTType          string            `json:"stt_type,omitempty"`
-		STTLanguage      string            `json:"stt_language,omitempty"`
-		TTSType          string            `json:"tts_type,omitempty"`
-		TTSLanguage      string            `json:"tts_language,omitempty"`
-		TTSVoiceID       string            `json:"tts_voice_id,omitempty"`
-		Tools            []aitool.Tool     `json:"tools,omitempty"` // Python defaults to [] via Field(default_factory=list) when absent
-		ResolvedTeam     *resolvedTeamData `json:"resolved_team,omitempty"`
-		VADConfig        *amai.VADConfig   `json:"vad_config,omitempty"`
-		SmartTurnEnabled bool              `json:"smart_turn_enabled"`
+		ID                   uuid.UUID             `json:"id,omitempty"`
+		LLMType              string                `json:"llm_type,omitempty"`
+		LLMKey               string                `json:"llm_key,omitempty"`
+		LLMFallbacks         []amai.EngineFallback `json:"llm_fallbacks,omitempty"` // tried in order when the LLM fails
+		LLMMessages          []map[string]any      `json:"llm_messages,omitempty"`
+		STTType              string                `json:"stt_type,omitempty"`
+		STTLanguage          string                `json:"stt_language,omitempty"`
+		TTSType              string                `json:"tts_type,omitempty"`
+		TTSLanguage          string                `json:"tts_language,omitempty"`
+		TTSVoiceID           string                `json:"tts_voice_id,omitempty"`
+		Tools                []aitool.Tool         `json:"tools,omitempty"` // Python defaults to [] via Field(default_factory=list) when absent
+		ResolvedTeam         *resolvedTeamData     `json:"resolved_team,omitempty"`
+		VADConfig            *amai.VADConfig       `json:"vad_config,omitempty"`
+		SmartTurnEnabled     bool                  `json:"smart_turn_enabled"`
+		RedactionEnabled     bool                  `json:"redaction_enabled"`      // redact the transcriptions via /:id/redact
+		GuardrailEnabled     bool                  `json:"guardrail_enabled"`      // check the user input and the LLM output via /:id/guardrail
+		ResponseCacheEnabled bool                  `json:"response_cache_enabled"` // answer repeated questions via /:id/response-cache
 	}{
-		ID:               pipecatcallID,
-		LLMType:          llmType,
-		LLMKey:           llmKey,
-		LLMMessages:      llmMessages,
-		STTType:          sttType,
-		STTLanguage:      sttLanguage,
-		TTSType:          ttsType,
-		TTSLanguage:      ttsLanguage,
-		TTSVoiceID:       ttsVoiceID,
-		Tools:            tools,
-		ResolvedTeam:     resolvedTeam,
-		VADConfig:        vadConfig,
-		SmartTurnEnabled: smartTurnEnabled,
+		ID:                   pipecatcallID,
+		LLMType:              llmType,
+		LLMKey:               llmKey,
+		LLMFallbacks:         llmFallbacks,
+		LLMMessages:          llmMessages,
+		STTType:              sttType,
+		STTLanguage:          sttLanguage,
+		TTSType:              ttsType,
+		TTSLanguage:          ttsLanguage,
+		TTSVoiceID:           ttsVoiceID,
+		Tools:                tools,
+		ResolvedTeam:         resolvedTeam,
+		VADConfig:            vadConfig,
+		SmartTurnEnabled:     smartTurnEnabled,
+		RedactionEnabled:     redactionEnabled,
+		GuardrailEnabled:     guardrailEnabled,
+		ResponseCacheEnabled: responseCacheEnabled,
 	}
 
 	jsonData, err := json.Marshal(reqBody)
@@ -0,0 +1,54 @@
+package pipecatcallhandler
+
+import (
+	"fmt"
+	"net/http"
+
+	"monorepo/bin-pipecat-manager/models/pipecatcall"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// PII redaction.
+//
+// When the aicall has a redaction config, the Python runner sends every final
+// transcription to /:id/redact before it reaches the LLM context. The text is
+// redacted by the ai-manager with the aicall's config, so the tokens issued
+// here are the same ones the stored messages get.
+
+// RunnerRedactHandle handles the runner's request to redact the personal data
+// of a transcription.
+func (h *pipecatcallHandler) RunnerRedactHandle(id uuid.UUID, c *gin.Context) error {
+	log := logrus.WithFields(logrus.Fields{
+		"func":           "RunnerRedactHandle",
+		"pipecatcall_id": id,
+	})
+	ctx := c.Request.Context()
+
+	pc, err := h.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("could not get pipecatcall: %w", err)
+	}
+
+	if pc.ReferenceType != pipecatcall.ReferenceTypeAICall {
+		return fmt.Errorf("pipecatcall reference type is not ai-call. reference_type: %s", pc.ReferenceType)
+	}
+
+	request := struct {
+		Text string `json:"text"`
+	}{}
+	if errBind := c.BindJSON(&request); errBind != nil {
+		return fmt.Errorf("could not bind redact request JSON: %w", errBind)
+	}
+
+	res, err := h.requestHandler.AIV1AIcallRedact(ctx, pc.ReferenceID, request.Text)
+	if err != nil {
+		return fmt.Errorf("could not redact the text via ai-manager: %w", err)
+	}
+	log.Debugf("Redacted the transcription. aicall_id: %s", pc.ReferenceID)
+
+	c.JSON(http.StatusOK, gin.H{"text": res})
+	return nil
+}
@@ -0,0 +1,185 @@
+package pipecatcallhandler
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	amai "monorepo/bin-ai-manager/models/ai"
+	amaicall "monorepo/bin-ai-manager/models/aicall"
+	amredaction "monorepo/bin-ai-manager/models/redaction"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/requesthandler"
+	"monorepo/bin-pipecat-manager/models/pipecatcall"
+	"monorepo/bin-pipecat-manager/pkg/dbhandler"
+	"monorepo/bin-pipecat-manager/pkg/toolhandler"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func Test_RunnerRedactHandle(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		id      uuid.UUID
+		reqBody []byte
+
+		responsePipecatcall *pipecatcall.Pipecatcall
+		responseText        string
+
+		expectText string
+		expectRes  string
+	}{
+		{
+			name: "normal",
+
+			id:      uuid.FromStringOrNil("c4b1e2a0-ad2c-11f0-9a3e-5f1d7c2b8e41"),
+			reqBody: []byte(`{"text":"my card is 4111 1111 1111 1111"}`),
+
+			responsePipecatcall: &pipecatcall.Pipecatcall{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("c4b1e2a0-ad2c-11f0-9a3e-5f1d7c2b8e41"),
+				},
+				ReferenceType: pipecatcall.ReferenceTypeAICall,
+				ReferenceID:   uuid.FromStringOrNil("c4e3f6b2-ad2c-11f0-8b7d-2a9e4f1c6d53"),
+			},
+			responseText: "my card is [CREDIT_CARD]",
+
+			expectText: "my card is 4111 1111 1111 1111",
+			expectRes:  `{"text":"my card is [CREDIT_CARD]"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			h := &pipecatcallHandler{
+				db:             mockDB,
+				requestHandler: mockReq,
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request, _ = http.NewRequest(http.MethodPost, "/"+tt.id.String()+"/redact", bytes.NewBuffer(tt.reqBody))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			mockDB.EXPECT().PipecatcallGet(gomock.Any(), tt.id).Return(tt.responsePipecatcall, nil)
+			mockReq.EXPECT().AIV1AIcallRedact(gomock.Any(), tt.responsePipecatcall.ReferenceID, tt.expectText).Return(tt.responseText, nil)
+
+			if err := h.RunnerRedactHandle(tt.id, c); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if w.Body.String() != tt.expectRes {
+				t.Errorf("Wrong match.\nexpect: %s\ngot: %s", tt.expectRes, w.Body.String())
+			}
+		})
+	}
+}
+
+func Test_RunnerRedactHandle_notAIcall(t *testing.T) {
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockDB := dbhandler.NewMockDBHandler(mc)
+	mockReq := requesthandler.NewMockRequestHandler(mc)
+	h := &pipecatcallHandler{
+		db:             mockDB,
+		requestHandler: mockReq,
+	}
+
+	id := uuid.FromStringOrNil("c51a7d84-ad2c-11f0-a4c2-7e3b9d1f5a62")
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest(http.MethodPost, "/"+id.String()+"/redact", bytes.NewBufferString(`{"text":"hello"}`))
+	c.Request.Header.Set("Content-Type", "application/json")
+
+	mockDB.EXPECT().PipecatcallGet(gomock.Any(), id).Return(&pipecatcall.Pipecatcall{
+		Identity: commonidentity.Identity{
+			ID: id,
+		},
+		ReferenceType: pipecatcall.ReferenceTypeCall,
+	}, nil)
+
+	if err := h.RunnerRedactHandle(id, c); err == nil {
+		t.Errorf("Wrong match. expect: error, got: ok")
+	}
+}
+
+// Test_runnerStartScript_redactionEnabled verifies that the runner is told to
+// redact the transcriptions when the aicall has a redaction config.
+func Test_runnerStartScript_redactionEnabled(t *testing.T) {
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockReq := requesthandler.NewMockRequestHandler(mc)
+	mockTool := toolhandler.NewMockToolHandler(mc)
+	mockPython := NewMockPythonRunner(mc)
+
+	h := &pipecatcallHandler{
+		requestHandler: mockReq,
+		toolHandler:    mockTool,
+		pythonRunner:   mockPython,
+	}
+
+	aicallID := uuid.FromStringOrNil("c54c0e36-ad2c-11f0-b3d1-9f6a2e8c4b73")
+	aiID := uuid.FromStringOrNil("c57d92ea-ad2c-11f0-95e8-1c4d7b3a9e84")
+
+	pc := &pipecatcall.Pipecatcall{
+		Identity: commonidentity.Identity{
+			ID: uuid.FromStringOrNil("c5aef5a0-ad2c-11f0-8c6f-3e8b1d5f7a95"),
+		},
+		ReferenceType: pipecatcall.ReferenceTypeAICall,
+		ReferenceID:   aicallID,
+		LLMType:       pipecatcall.LLMType("openai.gpt-5"),
+	}
+	se := &pipecatcall.Session{
+		Ctx:    context.Background(),
+		LLMKey: "key-1",
+	}
+
+	mockReq.EXPECT().AIV1AIcallGet(gomock.Any(), aicallID).Return(&amaicall.AIcall{
+		Identity: commonidentity.Identity{
+			ID: aicallID,
+		},
+		AssistanceType: amaicall.AssistanceTypeAI,
+		AssistanceID:   aiID,
+		Metadata: map[string]any{
+			amaicall.MetaKeyRedaction: map[string]any{
+				"entity_types": []any{string(amredaction.EntityTypeCreditCard)},
+			},
+		},
+	}, nil)
+	mockReq.EXPECT().AIV1AIGet(gomock.Any(), aiID).Return(&amai.AI{
+		Identity: commonidentity.Identity{
+			ID: aiID,
+		},
+		Type:        amai.TypeNormal,
+		EngineModel: amai.EngineModel("openai.gpt-5"),
+		EngineKey:   "key-1",
+	}, nil)
+	mockTool.EXPECT().GetByNames(gomock.Any(), gomock.Any()).Return(nil)
+	mockTool.EXPECT().GetCustomTools(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	mockTool.EXPECT().GetMCPTools(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+	mockPython.EXPECT().Start(
+		gomock.Any(), pc.ID, gomock.Any(), gomock.Any(), gomock.Any(),
+		gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+		gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+		true, gomock.Any(), gomock.Any(),
+	).Return(nil)
+
+	if err := h.runnerStartScript(pc, se); err != nil {
+		t.Fatalf("runnerStartScript returned unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,89 @@
+package pipecatcallhandler
+
+import (
+	"fmt"
+	"net/http"
+
+	"monorepo/bin-pipecat-manager/models/pipecatcall"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// Semantic response cache.
+//
+// When the aicall has the response cache enabled, the Python runner asks
+// /:id/response-cache/lookup for a cached answer to the latest user turns
+// before running the LLM, and sends the LLM's answer to
+// /:id/response-cache/store after a miss. The ai-manager does the matching.
+
+// RunnerResponseCacheLookupHandle handles the runner's request to look up a
+// cached response for the latest user turns.
+func (h *pipecatcallHandler) RunnerResponseCacheLookupHandle(id uuid.UUID, c *gin.Context) error {
+	log := logrus.WithFields(logrus.Fields{
+		"func":           "RunnerResponseCacheLookupHandle",
+		"pipecatcall_id": id,
+	})
+	ctx := c.Request.Context()
+
+	pc, err := h.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("could not get pipecatcall: %w", err)
+	}
+
+	if pc.ReferenceType != pipecatcall.ReferenceTypeAICall {
+		return fmt.Errorf("pipecatcall reference type is not ai-call. reference_type: %s", pc.ReferenceType)
+	}
+
+	request := struct {
+		UserTurns []string `json:"user_turns"`
+	}{}
+	if errBind := c.BindJSON(&request); errBind != nil {
+		return fmt.Errorf("could not bind response cache lookup request JSON: %w", errBind)
+	}
+
+	res, err := h.requestHandler.AIV1AIcallResponseCacheLookup(ctx, pc.ReferenceID, request.UserTurns)
+	if err != nil {
+		return fmt.Errorf("could not look up the response cache via ai-manager: %w", err)
+	}
+	log.Debugf("Looked up the response cache. aicall_id: %s, hit: %t", pc.ReferenceID, res != "")
+
+	c.JSON(http.StatusOK, gin.H{"text": res})
+	return nil
+}
+
+// RunnerResponseCacheStoreHandle handles the runner's request to store the
+// LLM's response for the latest user turns.
+func (h *pipecatcallHandler) RunnerResponseCacheStoreHandle(id uuid.UUID, c *gin.Context) error {
+	log := logrus.WithFields(logrus.Fields{
+		"func":           "RunnerResponseCacheStoreHandle",
+		"pipecatcall_id": id,
+	})
+	ctx := c.Request.Context()
+
+	pc, err := h.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("could not get pipecatcall: %w", err)
+	}
+
+	if pc.ReferenceType != pipecatcall.ReferenceTypeAICall {
+		return fmt.Errorf("pipecatcall reference type is not ai-call. reference_type: %s", pc.ReferenceType)
+	}
+
+	request := struct {
+		UserTurns []string `json:"user_turns"`
+		Response  string   `json:"response"`
+	}{}
+	if errBind := c.BindJSON(&request); errBind != nil {
+		return fmt.Errorf("could not bind response cache store request JSON: %w", errBind)
+	}
+
+	if errStore := h.requestHandler.AIV1AIcallResponseCacheStore(ctx, pc.ReferenceID, request.UserTurns, request.Response); errStore != nil {
+		return fmt.Errorf("could not store the response via ai-manager: %w", errStore)
+	}
+	log.Debugf("Stored the response. aicall_id: %s", pc.ReferenceID)
+
+	c.Status(http.StatusOK)
+	return nil
+}
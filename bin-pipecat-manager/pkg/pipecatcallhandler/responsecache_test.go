@@ -0,0 +1,272 @@
+package pipecatcallhandler
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	amai "monorepo/bin-ai-manager/models/ai"
+	amaicall "monorepo/bin-ai-manager/models/aicall"
+	commonidentity "monorepo/bin-common-handler/models/identity"
+	"monorepo/bin-common-handler/pkg/requesthandler"
+	"monorepo/bin-pipecat-manager/models/pipecatcall"
+	"monorepo/bin-pipecat-manager/pkg/dbhandler"
+	"monorepo/bin-pipecat-manager/pkg/toolhandler"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func Test_RunnerResponseCacheLookupHandle(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		id      uuid.UUID
+		reqBody []byte
+
+		responsePipecatcall *pipecatcall.Pipecatcall
+		responseText        string
+
+		expectUserTurns []string
+		expectRes       string
+	}{
+		{
+			name: "hit",
+
+			id:      uuid.FromStringOrNil("6e2a91c4-ad9e-11f0-8d17-d18ebc5f7fc9"),
+			reqBody: []byte(`{"user_turns":["what are your opening hours?"]}`),
+
+			responsePipecatcall: &pipecatcall.Pipecatcall{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("6e2a91c4-ad9e-11f0-8d17-d18ebc5f7fc9"),
+				},
+				ReferenceType: pipecatcall.ReferenceTypeAICall,
+				ReferenceID:   uuid.FromStringOrNil("6e5c2e1a-ad9e-11f0-9b29-e29fcd6080da"),
+			},
+			responseText: "We are open from 9 to 6.",
+
+			expectUserTurns: []string{"what are your opening hours?"},
+			expectRes:       `{"text":"We are open from 9 to 6."}`,
+		},
+		{
+			name: "miss",
+
+			id:      uuid.FromStringOrNil("6e8dc4a0-ad9e-11f0-a3b8-f3a0de7191eb"),
+			reqBody: []byte(`{"user_turns":["can I speak to a human?"]}`),
+
+			responsePipecatcall: &pipecatcall.Pipecatcall{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("6e8dc4a0-ad9e-11f0-a3b8-f3a0de7191eb"),
+				},
+				ReferenceType: pipecatcall.ReferenceTypeAICall,
+				ReferenceID:   uuid.FromStringOrNil("6ebf5a26-ad9e-11f0-8c4a-04b1ef82a2fc"),
+			},
+			responseText: "",
+
+			expectUserTurns: []string{"can I speak to a human?"},
+			expectRes:       `{"text":""}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockDB := dbhandler.NewMockDBHandler(mc)
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			h := &pipecatcallHandler{
+				db:             mockDB,
+				requestHandler: mockReq,
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request, _ = http.NewRequest(http.MethodPost, "/"+tt.id.String()+"/response-cache/lookup", bytes.NewBuffer(tt.reqBody))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			mockDB.EXPECT().PipecatcallGet(gomock.Any(), tt.id).Return(tt.responsePipecatcall, nil)
+			mockReq.EXPECT().AIV1AIcallResponseCacheLookup(gomock.Any(), tt.responsePipecatcall.ReferenceID, tt.expectUserTurns).Return(tt.responseText, nil)
+
+			if err := h.RunnerResponseCacheLookupHandle(tt.id, c); err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if w.Body.String() != tt.expectRes {
+				t.Errorf("Wrong match.\nexpect: %s\ngot: %s", tt.expectRes, w.Body.String())
+			}
+		})
+	}
+}
+
+func Test_RunnerResponseCacheLookupHandle_notAIcall(t *testing.T) {
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockDB := dbhandler.NewMockDBHandler(mc)
+	mockReq := requesthandler.NewMockRequestHandler(mc)
+	h := &pipecatcallHandler{
+		db:             mockDB,
+		requestHandler: mockReq,
+	}
+
+	id := uuid.FromStringOrNil("6ef0e8ce-ad9e-11f0-b5d6-15c2f093b30d")
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest(http.MethodPost, "/"+id.String()+"/response-cache/lookup", bytes.NewBufferString(`{"user_turns":["hello"]}`))
+	c.Request.Header.Set("Content-Type", "application/json")
+
+	mockDB.EXPECT().PipecatcallGet(gomock.Any(), id).Return(&pipecatcall.Pipecatcall{
+		Identity: commonidentity.Identity{
+			ID: id,
+		},
+		ReferenceType: pipecatcall.ReferenceTypeCall,
+	}, nil)
+
+	if err := h.RunnerResponseCacheLookupHandle(id, c); err == nil {
+		t.Errorf("Wrong match. expect: error, got: ok")
+	}
+}
+
+func Test_RunnerResponseCacheStoreHandle(t *testing.T) {
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+
+	mockDB := dbhandler.NewMockDBHandler(mc)
+	mockReq := requesthandler.NewMockRequestHandler(mc)
+	h := &pipecatcallHandler{
+		db:             mockDB,
+		requestHandler: mockReq,
+	}
+
+	id := uuid.FromStringOrNil("6f2275b4-ad9e-11f0-9e61-26d3a1a4c41e")
+	aicallID := uuid.FromStringOrNil("6f53f8a6-ad9e-11f0-8f72-37e4b2b5d52f")
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest(http.MethodPost, "/"+id.String()+"/response-cache/store", bytes.NewBufferString(`{"user_turns":["what are your opening hours?"],"response":"We are open from 9 to 6."}`))
+	c.Request.Header.Set("Content-Type", "application/json")
+
+	mockDB.EXPECT().PipecatcallGet(gomock.Any(), id).Return(&pipecatcall.Pipecatcall{
+		Identity: commonidentity.Identity{
+			ID: id,
+		},
+		ReferenceType: pipecatcall.ReferenceTypeAICall,
+		ReferenceID:   aicallID,
+	}, nil)
+	mockReq.EXPECT().AIV1AIcallResponseCacheStore(gomock.Any(), aicallID, []string{"what are your opening hours?"}, "We are open from 9 to 6.").Return(nil)
+
+	if err := h.RunnerResponseCacheStoreHandle(id, c); err != nil {
+		t.Errorf("Wrong match. expect: ok, got: %v", err)
+	}
+
+	if w.Code != http.StatusOK {
+		t.Errorf("Wrong match. expect: %d, got: %d", http.StatusOK, w.Code)
+	}
+}
+
+// Test_runnerStartScript_responseCacheEnabled verifies that the runner is told
+// to use the response cache only when the aicall has both the config and the scope.
+func Test_runnerStartScript_responseCacheEnabled(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		metadata map[string]any
+
+		expectEnabled bool
+	}{
+		{
+			name: "enabled",
+
+			metadata: map[string]any{
+				amaicall.MetaKeyResponseCache: map[string]any{
+					"enabled": true,
+				},
+				amaicall.MetaKeyResponseCacheScope: "3f9a1c7e5b2d8046",
+			},
+
+			expectEnabled: true,
+		},
+		{
+			name: "no scope",
+
+			metadata: map[string]any{
+				amaicall.MetaKeyResponseCache: map[string]any{
+					"enabled": true,
+				},
+			},
+
+			expectEnabled: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockReq := requesthandler.NewMockRequestHandler(mc)
+			mockTool := toolhandler.NewMockToolHandler(mc)
+			mockPython := NewMockPythonRunner(mc)
+
+			h := &pipecatcallHandler{
+				requestHandler: mockReq,
+				toolHandler:    mockTool,
+				pythonRunner:   mockPython,
+			}
+
+			aicallID := uuid.FromStringOrNil("6f85822e-ad9e-11f0-a8e3-48f5c3c6e640")
+			aiID := uuid.FromStringOrNil("6fb71c4c-ad9e-11f0-b1f4-5906d4d7f751")
+
+			pc := &pipecatcall.Pipecatcall{
+				Identity: commonidentity.Identity{
+					ID: uuid.FromStringOrNil("6fe8a0e0-ad9e-11f0-9c05-6a17e5e80862"),
+				},
+				ReferenceType: pipecatcall.ReferenceTypeAICall,
+				ReferenceID:   aicallID,
+				LLMType:       pipecatcall.LLMType("openai.gpt-5"),
+			}
+			se := &pipecatcall.Session{
+				Ctx:    context.Background(),
+				LLMKey: "key-1",
+			}
+
+			mockReq.EXPECT().AIV1AIcallGet(gomock.Any(), aicallID).Return(&amaicall.AIcall{
+				Identity: commonidentity.Identity{
+					ID: aicallID,
+				},
+				AssistanceType: amaicall.AssistanceTypeAI,
+				AssistanceID:   aiID,
+				Metadata:       tt.metadata,
+			}, nil)
+			mockReq.EXPECT().AIV1AIGet(gomock.Any(), aiID).Return(&amai.AI{
+				Identity: commonidentity.Identity{
+					ID: aiID,
+				},
+				Type:        amai.TypeNormal,
+				EngineModel: amai.EngineModel("openai.gpt-5"),
+				EngineKey:   "key-1",
+			}, nil)
+			mockTool.EXPECT().GetByNames(gomock.Any(), gomock.Any()).Return(nil)
+			mockTool.EXPECT().GetCustomTools(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			mockTool.EXPECT().GetMCPTools(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+			mockPython.EXPECT().Start(
+				gomock.Any(), pc.ID, gomock.Any(), gomock.Any(), gomock.Any(),
+				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+				gomock.Any(), gomock.Any(), tt.expectEnabled,
+			).Return(nil)
+
+			if err := h.runnerStartScript(pc, se); err != nil {
+				t.Fatalf("runnerStartScript returned unexpected error: %v", err)
+			}
+		})
+	}
+}
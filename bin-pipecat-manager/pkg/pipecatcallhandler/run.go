@@ -39,16 +39,19 @@ type resolvedMemberData struct {
 
 // resolvedAIData contains the AI engine configuration for a team member,
 // including credentials, model, prompt, and TTS/STT settings.
+// EngineModel/EngineKey is the first engine of the member's chain and
+// EngineFallbacks the rest, in the order the runner tries them.
 type resolvedAIData struct {
-	EngineModel      string          `json:"engine_model"`
-	EngineKey        string          `json:"engine_key"`
-	InitPrompt       string          `json:"init_prompt"`
-	Parameter        map[string]any  `json:"parameter,omitempty"`
-	TTSType          string          `json:"tts_type"`
-	TTSVoiceID       string          `json:"tts_voice_id"`
-	STTType          string          `json:"stt_type"`
-	VADConfig        *amai.VADConfig `json:"vad_config,omitempty"`
-	SmartTurnEnabled bool            `json:"smart_turn_enabled"`
+	EngineModel      string                `json:"engine_model"`
+	EngineKey        string                `json:"engine_key"`
+	EngineFallbacks  []amai.EngineFallback `json:"engine_fallbacks,omitempty"`
+	InitPrompt       string                `json:"init_prompt"`
+	Parameter        map[string]any        `json:"parameter,omitempty"`
+	TTSType          string                `json:"tts_type"`
+	TTSVoiceID       string                `json:"tts_voice_id"`
+	STTType          string                `json:"stt_type"`
+	VADConfig        *amai.VADConfig       `json:"vad_config,omitempty"`
+	SmartTurnEnabled bool                  `json:"smart_turn_enabled"`
 }
 
 func (h *pipecatcallHandler) runAsteriskReceivedMediaHandle(se *pipecatcall.Session) {
@@ -104,6 +107,9 @@ func (h *pipecatcallHandler) runAsteriskReceivedMediaHandle(se *pipecatcall.Sess
 
 		if errSend := h.pipecatframeHandler.SendAudio(se, packetID, data); errSend != nil {
 			log.Errorf("Could not send audio frame. err: %v", errSend)
+		} else {
+			// the audio goes to the stt engine
+			se.AddUsage(pipecatcall.Usage{STTSeconds: audioSeconds(defaultMediaSampleRate, len(data))})
 		}
 
 		packetID++
@@ -176,6 +182,8 @@ func (h *pipecatcallHandler) resolveTeamForPython(
 		logrus.WithField("ai", ai).Debugf("Retrieved AI info for member. member_id: %s, ai_id: %s", m.ID, m.AIID)
 
 		tools := h.toolHandler.GetByNames(ai.Type, ai.ToolNames)
+		tools = append(tools, h.toolHandler.GetCustomTools(ctx, ai.CustomerID, ai.Type, ai.ToolNames)...)
+		tools = append(tools, h.toolHandler.GetMCPTools(ctx, ai.CustomerID, ai.Type, ai.ToolNames)...)
 
 		// filter out search_knowledge if no RAG is configured
 		if ai.RagID == uuid.Nil {
@@ -193,12 +201,15 @@ func (h *pipecatcallHandler) resolveTeamForPython(
 			transitions = []amteam.Transition{}
 		}
 
+		engine, engineFallbacks := llmEngineChainSplit(h.llmEngineChainOrder(ai.EngineChain()))
+
 		resolved.Members = append(resolved.Members, resolvedMemberData{
 			ID:   m.ID,
 			Name: m.Name,
 			AI: resolvedAIData{
-				EngineModel:      string(ai.EngineModel),
-				EngineKey:        ai.EngineKey,
+				EngineModel:      string(engine.EngineModel),
+				EngineKey:        engine.EngineKey,
+				EngineFallbacks:  engineFallbacks,
 				InitPrompt:       ai.InitPrompt,
 				Parameter:        ai.Parameter,
 				TTSType:          string(ai.TTSType),
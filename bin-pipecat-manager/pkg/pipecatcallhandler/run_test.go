@@ -501,6 +501,8 @@ func Test_resolveTeamForPython(t *testing.T) {
 					ToolNames:   []aitool.ToolName{aitool.ToolNameSendEmail},
 				}, nil)
 
+				mockTool.EXPECT().GetCustomTools(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]aitool.Tool{}).AnyTimes()
+				mockTool.EXPECT().GetMCPTools(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]aitool.Tool{}).AnyTimes()
 				mockTool.EXPECT().GetByNames(amai.TypeNormal, []aitool.ToolName{aitool.ToolNameConnectCall}).Return([]aitool.Tool{
 					{
 						Name:        aitool.ToolNameConnectCall,
@@ -676,6 +678,8 @@ func Test_resolveTeamForPython(t *testing.T) {
 					TTSType:     amai.TTSTypeCartesia,
 					STTType:     amai.STTTypeDeepgram,
 				}, nil)
+				mockTool.EXPECT().GetCustomTools(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]aitool.Tool{}).AnyTimes()
+				mockTool.EXPECT().GetMCPTools(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]aitool.Tool{}).AnyTimes()
 				mockTool.EXPECT().GetByNames(gomock.Any(), gomock.Any()).Return([]aitool.Tool{})
 			},
 
@@ -722,6 +726,8 @@ func Test_resolveTeamForPython(t *testing.T) {
 					EngineKey:   "key-2",
 					InitPrompt:  "You are support",
 				}, nil)
+				mockTool.EXPECT().GetCustomTools(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]aitool.Tool{}).AnyTimes()
+				mockTool.EXPECT().GetMCPTools(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]aitool.Tool{}).AnyTimes()
 				mockTool.EXPECT().GetByNames(gomock.Any(), gomock.Any()).Return([]aitool.Tool{}).Times(2)
 			},
 
@@ -754,6 +760,8 @@ func Test_resolveTeamForPython(t *testing.T) {
 					EngineKey:   "key-1",
 					InitPrompt:  "You are a greeter",
 				}, nil)
+				mockTool.EXPECT().GetCustomTools(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]aitool.Tool{}).AnyTimes()
+				mockTool.EXPECT().GetMCPTools(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]aitool.Tool{}).AnyTimes()
 				mockTool.EXPECT().GetByNames(gomock.Any(), gomock.Any()).Return([]aitool.Tool{})
 			},
 
@@ -786,6 +794,8 @@ func Test_resolveTeamForPython(t *testing.T) {
 					EngineKey:   "key-1",
 					InitPrompt:  "You are a greeter",
 				}, nil)
+				mockTool.EXPECT().GetCustomTools(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]aitool.Tool{}).AnyTimes()
+				mockTool.EXPECT().GetMCPTools(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]aitool.Tool{}).AnyTimes()
 				mockTool.EXPECT().GetByNames(gomock.Any(), gomock.Any()).Return([]aitool.Tool{})
 			},
 
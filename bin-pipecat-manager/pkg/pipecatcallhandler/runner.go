@@ -107,6 +107,12 @@ func (h *pipecatcallHandler) runnerStartScript(pc *pipecatcall.Pipecatcall, se *
 	var resolvedTeam *resolvedTeamData
 	var vadConfig *amai.VADConfig
 	var smartTurnEnabled bool
+	var redactionEnabled bool
+	var guardrailEnabled bool
+	var responseCacheEnabled bool
+	llmType := string(pc.LLMType)
+	llmKey := se.LLMKey
+	var llmFallbacks []amai.EngineFallback
 
 	if pc.ReferenceType == pipecatcall.ReferenceTypeAICall {
 		aicall, err := h.requestHandler.AIV1AIcallGet(se.Ctx, pc.ReferenceID)
@@ -116,6 +122,9 @@ func (h *pipecatcallHandler) runnerStartScript(pc *pipecatcall.Pipecatcall, se *
 
 		vadConfig = aicall.AIVADConfig
 		smartTurnEnabled = aicall.AISmartTurnEnabled
+		redactionEnabled = aicall.RedactionConfig().IsEnabled()
+		guardrailEnabled = aicall.GuardrailConfig().IsEnabled()
+		responseCacheEnabled = aicall.ResponseCacheConfig().IsEnabled() && aicall.ResponseCacheScope() != ""
 
 		// Resolve team first — if team-backed, per-member tools come from resolvedTeam
 		resolvedTeam, err = h.resolveTeamForPython(se.Ctx, aicall)
@@ -126,6 +135,12 @@ func (h *pipecatcallHandler) runnerStartScript(pc *pipecatcall.Pipecatcall, se *
 		if resolvedTeam != nil {
 			// Team pipeline: per-member tools are in resolvedTeam, no top-level tools needed
 			log.WithField("team_id", resolvedTeam.ID).Debugf("Resolved team for python runner")
+			for _, m := range resolvedTeam.Members {
+				if m.ID == resolvedTeam.StartMemberID {
+					llmType = m.AI.EngineModel
+					break
+				}
+			}
 		} else {
 			// Single AI: resolve tools from the AI's configuration
 			ai, errAI := h.resolveAIFromAIcall(se.Ctx, aicall)
@@ -147,7 +162,20 @@ func (h *pipecatcallHandler) runnerStartScript(pc *pipecatcall.Pipecatcall, se *
 				}).WithError(errAI).Errorf("Could not resolve AI for pipecat session %s; failing closed to no tools (least-privilege over availability)", pc.ID)
 				tools = []aitool.Tool{}
 			} else {
+				// The pipecatcall's LLM is the primary engine; the runner
+				// fails over along the AI's fallbacks, healthiest first.
+				chain := append([]amai.EngineFallback{{
+					EngineModel: amai.EngineModel(pc.LLMType),
+					EngineKey:   se.LLMKey,
+				}}, ai.EngineFallbacks...)
+				engine, engineFallbacks := llmEngineChainSplit(h.llmEngineChainOrder(chain))
+				llmType = string(engine.EngineModel)
+				llmKey = engine.EngineKey
+				llmFallbacks = engineFallbacks
+
 				tools = h.toolHandler.GetByNames(ai.Type, ai.ToolNames)
+				tools = append(tools, h.toolHandler.GetCustomTools(se.Ctx, ai.CustomerID, ai.Type, ai.ToolNames)...)
+				tools = append(tools, h.toolHandler.GetMCPTools(se.Ctx, ai.CustomerID, ai.Type, ai.ToolNames)...)
 
 				// filter out search_knowledge if no RAG is configured
 				if ai.RagID == uuid.Nil {
@@ -169,12 +197,14 @@ func (h *pipecatcallHandler) runnerStartScript(pc *pipecatcall.Pipecatcall, se *
 		tools = h.toolHandler.GetByNames(amai.TypeNormal, []aitool.ToolName{aitool.ToolNameAll})
 	}
 	log.WithField("tool_count", len(tools)).Debugf("Retrieved tools for pipecat call")
+	se.SetLLMEngine(llmType, llmKeyHash(llmKey))
 
 	if errStart := h.pythonRunner.Start(
 		se.Ctx,
 		pc.ID,
-		string(pc.LLMType),
-		string(se.LLMKey),
+		llmType,
+		llmKey,
+		llmFallbacks,
 		pc.LLMMessages,
 		string(pc.STTType),
 		string(pc.STTLanguage),
@@ -185,6 +215,9 @@ func (h *pipecatcallHandler) runnerStartScript(pc *pipecatcall.Pipecatcall, se *
 		resolvedTeam,
 		vadConfig,
 		smartTurnEnabled,
+		redactionEnabled,
+		guardrailEnabled,
+		responseCacheEnabled,
 	); errStart != nil {
 		return errors.Wrapf(errStart, "could not start python client")
 	}
@@ -491,6 +524,7 @@ func (h *pipecatcallHandler) RunnerMemberSwitchedHandle(id uuid.UUID, c *gin.Con
 		TransitionFunctionName string             `json:"transition_function_name"`
 		FromMember             message.MemberInfo `json:"from_member"`
 		ToMember               message.MemberInfo `json:"to_member"`
+		ToEngineKeyHash        string             `json:"to_engine_key_hash"` // hash of the to_member's engine key. see llmKeyHash.
 	}{}
 	if errBind := c.BindJSON(&request); errBind != nil {
 		return fmt.Errorf("could not bind member-switched request JSON: %w", errBind)
@@ -510,6 +544,10 @@ func (h *pipecatcallHandler) RunnerMemberSwitchedHandle(id uuid.UUID, c *gin.Con
 	h.notifyHandler.PublishEvent(ctx, message.EventTypeTeamMemberSwitched, evt)
 	log.WithField("event", evt).Debugf("Published team member switched event.")
 
+	if request.ToMember.EngineModel != "" {
+		se.SetLLMEngine(request.ToMember.EngineModel, request.ToEngineKeyHash)
+	}
+
 	c.JSON(http.StatusOK, gin.H{"status": "ok"})
 	return nil
 }
@@ -573,7 +611,9 @@ func (h *pipecatcallHandler) receiveMessageFrameTypeMessage(se *pipecatcall.Sess
 			return nil
 		}
 
-		go h.notifyHandler.PublishEvent(se.Ctx, message.EventTypeUserTranscription, h.newMessageEvent(se, msg.Data.Text))
+		evt := h.newMessageEvent(se, msg.Data.Text)
+		evt.Usage = se.TakePendingSTTUsage()
+		go h.notifyHandler.PublishEvent(se.Ctx, message.EventTypeUserTranscription, evt)
 
 	case pipecatframe.RTVIFrameTypeUserLLMText:
 		msg := pipecatframe.RTVIUserLLMTextMessage{}
@@ -659,6 +699,23 @@ func (h *pipecatcallHandler) receiveMessageFrameTypeMessage(se *pipecatcall.Sess
 		}
 		timer.Stop()
 
+	case pipecatframe.RTVIFrameTypeMetrics:
+		msg := pipecatframe.RTVIMetricsMessage{}
+		if errUnmarshal := json.Unmarshal(m, &msg); errUnmarshal != nil {
+			return errors.Wrapf(errUnmarshal, "could not unmarshal metrics message")
+		}
+
+		for _, t := range msg.Data.Tokens {
+			u := pipecatcall.Usage{
+				PromptTokens:     t.PromptTokens,
+				CompletionTokens: t.CompletionTokens,
+			}
+			if t.CacheReadInputTokens != nil {
+				u.CachedTokens = *t.CacheReadInputTokens
+			}
+			se.AddUsage(u)
+		}
+
 	default:
 		log.WithField("frame", frame).Debugf("Unrecognized RTVI message type: %s", frame.Type)
 	}
@@ -879,11 +936,14 @@ func (h *pipecatcallHandler) publishFinalBotLLMEvent(ctx context.Context, se *pi
 		PipecatcallReferenceID:   se.PipecatcallReferenceID,
 		ActiveflowID:             se.ActiveflowID,
 		InReplyToMessageID:       se.LLMInReplyToMessageID,
+		EngineModel:              se.LLMEngineModel(),
+		Usage:                    se.TakePendingLLMUsage(),
 
 		Text: fullText,
 	}
 
 	h.notifyHandler.PublishEvent(ctx, message.EventTypeBotLLM, evt)
+	h.llmRecordSuccess(se)
 }
 
 func (h *pipecatcallHandler) runnerWebsocketHandleAudio(se *pipecatcall.Session, sampleRate int, numChannels int, data []byte) error {
@@ -891,6 +951,9 @@ func (h *pipecatcallHandler) runnerWebsocketHandleAudio(se *pipecatcall.Session,
 		return errors.Errorf("only mono audio is supported. num_channels: %d", numChannels)
 	}
 
+	// the audio is generated by the tts engine
+	se.AddUsage(pipecatcall.Usage{TTSSeconds: audioSeconds(sampleRate, len(data))})
+
 	audioData := data
 	if sampleRate != defaultMediaSampleRate {
 		var err error
@@ -920,3 +983,12 @@ func (h *pipecatcallHandler) runnerWebsocketHandleAudio(se *pipecatcall.Session,
 
 	return nil
 }
+
+// audioSeconds returns the duration in seconds of the given size of 16-bit mono PCM audio.
+func audioSeconds(sampleRate int, size int) float64 {
+	if sampleRate <= 0 {
+		return 0
+	}
+
+	return float64(size) / float64(sampleRate*2)
+}
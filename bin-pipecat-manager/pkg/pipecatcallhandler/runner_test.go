@@ -22,8 +22,9 @@ func Test_receiveMessageFrameTypeMessage(t *testing.T) {
 	tests := []struct {
 		name string
 
-		se *pipecatcall.Session
-		m  []byte
+		se           *pipecatcall.Session
+		pendingUsage pipecatcall.Usage
+		m            []byte
 
 		responseUUID  uuid.UUID
 		expectEvent   string
@@ -83,6 +84,40 @@ func Test_receiveMessageFrameTypeMessage(t *testing.T) {
 				Text:          "to by the way, who are you?",
 			},
 		},
+		{
+			name: "user-transcription carries the pending stt usage",
+			se: &pipecatcall.Session{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("2f0b5d2c-b3b0-11f0-8d51-3b5f2c6f0a01"),
+					CustomerID: uuid.FromStringOrNil("2f3a6c8e-b3b0-11f0-a1d4-8f0e2b1c7d02"),
+				},
+				Ctx: context.Background(),
+			},
+			pendingUsage: pipecatcall.Usage{
+				PromptTokens: 120,
+				STTSeconds:   2.5,
+			},
+			m: []byte(`{
+				"label": "rtvi-ai",
+				"type": "user-transcription",
+				"data": {"text": "hello", "user_id": "", "timestamp": "2025-10-22T02:38:39.119+00:00", "final": true}
+			}`),
+
+			responseUUID: uuid.FromStringOrNil("2f6a1e40-b3b0-11f0-9c37-4b2e7f8a1d03"),
+			expectEvent:  message.EventTypeUserTranscription,
+
+			expectMessage: message.Message{
+				Identity: commonidentity.Identity{
+					ID:         uuid.FromStringOrNil("2f6a1e40-b3b0-11f0-9c37-4b2e7f8a1d03"),
+					CustomerID: uuid.FromStringOrNil("2f3a6c8e-b3b0-11f0-a1d4-8f0e2b1c7d02"),
+				},
+				PipecatcallID: uuid.FromStringOrNil("2f0b5d2c-b3b0-11f0-8d51-3b5f2c6f0a01"),
+				Usage: &pipecatcall.Usage{
+					STTSeconds: 2.5,
+				},
+				Text: "hello",
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -97,6 +132,7 @@ func Test_receiveMessageFrameTypeMessage(t *testing.T) {
 				utilHandler:   mockUtil,
 			}
 
+			tt.se.AddUsage(tt.pendingUsage)
 			mockUtil.EXPECT().UUIDCreate().Return(tt.responseUUID)
 
 			// PublishEvent is now called in a goroutine; use WaitGroup to synchronize.
@@ -114,6 +150,68 @@ func Test_receiveMessageFrameTypeMessage(t *testing.T) {
 	}
 }
 
+func Test_receiveMessageFrameTypeMessage_metrics(t *testing.T) {
+
+	tests := []struct {
+		name string
+
+		m []byte
+
+		expectRes pipecatcall.Usage
+	}{
+		{
+			name: "token usage",
+
+			m: []byte(`{
+				"label": "rtvi-ai",
+				"type": "metrics",
+				"data": {
+					"tokens": [
+						{"prompt_tokens": 1200, "completion_tokens": 40, "total_tokens": 1240, "cache_read_input_tokens": 1024},
+						{"prompt_tokens": 300, "completion_tokens": 10, "total_tokens": 310}
+					]
+				}
+			}`),
+
+			expectRes: pipecatcall.Usage{
+				PromptTokens:     1500,
+				CompletionTokens: 50,
+				CachedTokens:     1024,
+			},
+		},
+		{
+			name: "processing metrics only",
+
+			m: []byte(`{
+				"label": "rtvi-ai",
+				"type": "metrics",
+				"data": {
+					"processing": [{"processor": "OpenAILLMService#0", "value": 0.42}]
+				}
+			}`),
+
+			expectRes: pipecatcall.Usage{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := pipecatcallHandler{}
+			se := &pipecatcall.Session{
+				Ctx: context.Background(),
+			}
+
+			if err := h.receiveMessageFrameTypeMessage(se, tt.m); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			if res := se.Usage(); res != tt.expectRes {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
+
 func Test_runnerHandleTextFrame(t *testing.T) {
 
 	t.Run("FLUSH_MEDIA is forwarded to Asterisk", func(t *testing.T) {
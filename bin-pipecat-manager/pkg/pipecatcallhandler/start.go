@@ -322,6 +322,11 @@ func (h *pipecatcallHandler) terminate(ctx context.Context, pc *pipecatcall.Pipe
 	h.flushAndFinalize(se)
 	log.Debugf("Flush done. Publishing terminated event. pipecatcall_id: %s", pc.ID)
 
+	// the terminated event carries the usage of the whole session
+	if u := se.Usage(); !u.IsEmpty() {
+		pc.Usage = &u
+	}
+
 	// Publish the pipecatcall_terminated event exactly once per pipecatcall.
 	// Use context.Background() because SessionStop below will cancel se.Ctx
 	// and we want the event to leave even when terminate is invoked from a
@@ -109,9 +109,12 @@ func Test_startReferenceTypeCall_externalMediaFailure(t *testing.T) {
 
 	// RunnerStart goroutine may call these before context is cancelled
 	mockTool.EXPECT().GetByNames(gomock.Any(), gomock.Any()).AnyTimes()
+	mockTool.EXPECT().GetCustomTools(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	mockTool.EXPECT().GetMCPTools(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 	mockPythonRunner.EXPECT().Start(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
 		gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
-		gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+		gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+		gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 	mockPythonRunner.EXPECT().Stop(gomock.Any(), gomock.Any()).AnyTimes()
 
 	err := h.startReferenceTypeCall(context.Background(), pc)
@@ -233,9 +236,12 @@ func Test_startReferenceTypeCall_websocketDialFailure(t *testing.T) {
 
 	// RunnerStart goroutine may call these before context is cancelled
 	mockTool.EXPECT().GetByNames(gomock.Any(), gomock.Any()).AnyTimes()
+	mockTool.EXPECT().GetCustomTools(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	mockTool.EXPECT().GetMCPTools(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 	mockPythonRunner.EXPECT().Start(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
 		gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
-		gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+		gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+		gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 	mockPythonRunner.EXPECT().Stop(gomock.Any(), gomock.Any()).AnyTimes()
 
 	err := h.startReferenceTypeCall(context.Background(), pc)
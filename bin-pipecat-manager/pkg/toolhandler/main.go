@@ -6,10 +6,13 @@ import (
 	"context"
 	"sync"
 
+	"github.com/gofrs/uuid"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 
 	amai "monorepo/bin-ai-manager/models/ai"
+	amcustomtool "monorepo/bin-ai-manager/models/customtool"
+	ammcpserver "monorepo/bin-ai-manager/models/mcpserver"
 	aitool "monorepo/bin-ai-manager/models/tool"
 	"monorepo/bin-common-handler/pkg/requesthandler"
 )
@@ -35,6 +38,17 @@ type ToolHandler interface {
 	// fresh by the caller from the AI record already in hand on every call,
 	// never cached across calls.
 	GetByNames(aiType amai.Type, names []aitool.ToolName) []aitool.Tool
+
+	// GetCustomTools returns the customer's custom tools explicitly listed
+	// in names. Custom tools are never cached (they are per-customer and
+	// editable at any time) and are only available to Normal AIs; the "all"
+	// selector does not include them.
+	GetCustomTools(ctx context.Context, customerID uuid.UUID, aiType amai.Type, names []aitool.ToolName) []aitool.Tool
+
+	// GetMCPTools returns the tools discovered on the customer's MCP servers
+	// explicitly listed in names. Like custom tools they are never cached and
+	// are only available to Normal AIs.
+	GetMCPTools(ctx context.Context, customerID uuid.UUID, aiType amai.Type, names []aitool.ToolName) []aitool.Tool
 }
 
 type toolHandler struct {
@@ -107,3 +121,87 @@ func (h *toolHandler) GetByNames(aiType amai.Type, names []aitool.ToolName) []ai
 
 	return result
 }
+
+// customToolListSize is the page size used when listing a customer's custom
+// tools. ai-manager doesn't cap the number of custom tools, but an AI can
+// only reference as many as fit in its tool_names.
+const customToolListSize = 100
+
+// GetCustomTools returns the customer's custom tools matching names as
+// LLM-facing tool definitions. A lookup failure is logged and degrades to
+// no custom tools rather than failing the session.
+func (h *toolHandler) GetCustomTools(ctx context.Context, customerID uuid.UUID, aiType amai.Type, names []aitool.ToolName) []aitool.Tool {
+	log := logrus.WithFields(logrus.Fields{
+		"func":        "GetCustomTools",
+		"customer_id": customerID,
+	})
+
+	if aiType != amai.TypeNormal || customerID == uuid.Nil || len(names) == 0 {
+		return []aitool.Tool{}
+	}
+
+	filters := map[amcustomtool.Field]any{
+		amcustomtool.FieldCustomerID: customerID,
+		amcustomtool.FieldDeleted:    false,
+	}
+	customTools, err := h.requestHandler.AIV1CustomToolList(ctx, "", customToolListSize, filters)
+	if err != nil {
+		log.Errorf("Could not get custom tools. err: %v", err)
+		return []aitool.Tool{}
+	}
+
+	result := make([]aitool.Tool, 0, len(customTools))
+	for _, ct := range customTools {
+		if !containsName(names, aitool.ToolName(ct.Name)) {
+			continue
+		}
+		result = append(result, ct.ConvertTool())
+	}
+
+	return result
+}
+
+// mcpServerListSize is the page size used when listing a customer's MCP
+// servers, for the same reason as customToolListSize.
+const mcpServerListSize = 100
+
+// GetMCPTools discovers the tools of the customer's MCP servers matching
+// names. Discovery runs in ai-manager, which connects to each server. A
+// server that can't be reached is logged and skipped so one broken server
+// doesn't take the others, or the session, down with it.
+func (h *toolHandler) GetMCPTools(ctx context.Context, customerID uuid.UUID, aiType amai.Type, names []aitool.ToolName) []aitool.Tool {
+	log := logrus.WithFields(logrus.Fields{
+		"func":        "GetMCPTools",
+		"customer_id": customerID,
+	})
+
+	if aiType != amai.TypeNormal || customerID == uuid.Nil || len(names) == 0 {
+		return []aitool.Tool{}
+	}
+
+	filters := map[ammcpserver.Field]any{
+		ammcpserver.FieldCustomerID: customerID,
+		ammcpserver.FieldDeleted:    false,
+	}
+	servers, err := h.requestHandler.AIV1MCPServerList(ctx, "", mcpServerListSize, filters)
+	if err != nil {
+		log.Errorf("Could not get mcp servers. err: %v", err)
+		return []aitool.Tool{}
+	}
+
+	result := []aitool.Tool{}
+	for _, s := range servers {
+		if !containsName(names, aitool.ToolName(s.Name)) {
+			continue
+		}
+
+		tools, errTools := h.requestHandler.AIV1MCPServerToolList(ctx, s.ID)
+		if errTools != nil {
+			log.Errorf("Could not discover the mcp server tools. mcp_server_id: %s, err: %v", s.ID, errTools)
+			continue
+		}
+		result = append(result, tools...)
+	}
+
+	return result
+}
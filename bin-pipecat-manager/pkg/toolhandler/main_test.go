@@ -7,9 +7,13 @@ import (
 	"testing"
 
 	amai "monorepo/bin-ai-manager/models/ai"
+	amcustomtool "monorepo/bin-ai-manager/models/customtool"
+	ammcpserver "monorepo/bin-ai-manager/models/mcpserver"
 	aitool "monorepo/bin-ai-manager/models/tool"
+	"monorepo/bin-common-handler/models/identity"
 	"monorepo/bin-common-handler/pkg/requesthandler"
 
+	"github.com/gofrs/uuid"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -266,6 +270,211 @@ func TestToolHandler_GetByNames(t *testing.T) {
 	}
 }
 
+func TestToolHandler_GetCustomTools(t *testing.T) {
+	customerID := uuid.FromStringOrNil("8a3c1e2e-a8f4-11f0-9d1b-3b7e2f4c5a01")
+	responseCustomTools := []amcustomtool.CustomTool{
+		{Name: "lookup_order", Description: "Look up an order", Parameters: map[string]any{"type": "object"}},
+		{Name: "check_stock", Description: "Check stock"},
+	}
+
+	tests := []struct {
+		name string
+
+		aiType amai.Type
+		names  []aitool.ToolName
+
+		expectList    bool
+		responseTools []amcustomtool.CustomTool
+		responseErr   error
+
+		expectNames []aitool.ToolName
+	}{
+		{
+			name: "normal - only the listed custom tools are returned",
+
+			aiType: amai.TypeNormal,
+			names:  []aitool.ToolName{aitool.ToolNameConnectCall, "lookup_order"},
+
+			expectList:    true,
+			responseTools: responseCustomTools,
+
+			expectNames: []aitool.ToolName{"lookup_order"},
+		},
+		{
+			name: "all selector does not include custom tools",
+
+			aiType: amai.TypeNormal,
+			names:  []aitool.ToolName{aitool.ToolNameAll},
+
+			expectList:    true,
+			responseTools: responseCustomTools,
+
+			expectNames: []aitool.ToolName{},
+		},
+		{
+			name: "insight ai never gets custom tools",
+
+			aiType: amai.TypeInsight,
+			names:  []aitool.ToolName{"lookup_order"},
+
+			expectNames: []aitool.ToolName{},
+		},
+		{
+			name: "list error degrades to no custom tools",
+
+			aiType: amai.TypeNormal,
+			names:  []aitool.ToolName{"lookup_order"},
+
+			expectList:  true,
+			responseErr: errors.New("connection error"),
+
+			expectNames: []aitool.ToolName{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockRequest := requesthandler.NewMockRequestHandler(mc)
+			h := NewToolHandler(mockRequest)
+			ctx := context.Background()
+
+			if tt.expectList {
+				filters := map[amcustomtool.Field]any{
+					amcustomtool.FieldCustomerID: customerID,
+					amcustomtool.FieldDeleted:    false,
+				}
+				mockRequest.EXPECT().AIV1CustomToolList(ctx, "", uint64(customToolListSize), filters).Return(tt.responseTools, tt.responseErr)
+			}
+
+			res := h.GetCustomTools(ctx, customerID, tt.aiType, tt.names)
+
+			got := make([]aitool.ToolName, 0, len(res))
+			for _, r := range res {
+				if !r.RunLLM {
+					t.Errorf("custom tool %s should run the LLM after execution", r.Name)
+				}
+				got = append(got, r.Name)
+			}
+			if !reflect.DeepEqual(got, tt.expectNames) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectNames, got)
+			}
+		})
+	}
+}
+
+func TestToolHandler_GetMCPTools(t *testing.T) {
+	customerID := uuid.FromStringOrNil("9b4d2f3f-8e61-11f1-a2c4-4c8f3a5d6b02")
+	crmID := uuid.FromStringOrNil("9b7e4150-8e61-11f1-8d35-6d9a4b6e7c03")
+	docsID := uuid.FromStringOrNil("9baf5261-8e61-11f1-9e46-8eab5c7f8d04")
+	responseServers := []ammcpserver.MCPServer{
+		{Identity: identity.Identity{ID: crmID}, Name: "crm"},
+		{Identity: identity.Identity{ID: docsID}, Name: "docs"},
+	}
+
+	tests := []struct {
+		name string
+
+		aiType amai.Type
+		names  []aitool.ToolName
+
+		expectList      bool
+		responseServers []ammcpserver.MCPServer
+		responseErr     error
+
+		expectDiscover  bool
+		responseTools   []aitool.Tool
+		responseToolErr error
+
+		expectNames []aitool.ToolName
+	}{
+		{
+			name: "normal - only the listed servers are discovered",
+
+			aiType: amai.TypeNormal,
+			names:  []aitool.ToolName{aitool.ToolNameConnectCall, "crm"},
+
+			expectList:      true,
+			responseServers: responseServers,
+
+			expectDiscover: true,
+			responseTools: []aitool.Tool{
+				{Name: "crm__lookup_order", RunLLM: true},
+				{Name: "crm__read_resource", RunLLM: true},
+			},
+
+			expectNames: []aitool.ToolName{"crm__lookup_order", "crm__read_resource"},
+		},
+		{
+			name: "discovery error skips the server",
+
+			aiType: amai.TypeNormal,
+			names:  []aitool.ToolName{"crm"},
+
+			expectList:      true,
+			responseServers: responseServers,
+
+			expectDiscover:  true,
+			responseToolErr: errors.New("mcp server unavailable"),
+
+			expectNames: []aitool.ToolName{},
+		},
+		{
+			name: "insight ai never gets mcp tools",
+
+			aiType: amai.TypeInsight,
+			names:  []aitool.ToolName{"crm"},
+
+			expectNames: []aitool.ToolName{},
+		},
+		{
+			name: "list error degrades to no mcp tools",
+
+			aiType: amai.TypeNormal,
+			names:  []aitool.ToolName{"crm"},
+
+			expectList:  true,
+			responseErr: errors.New("connection error"),
+
+			expectNames: []aitool.ToolName{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockRequest := requesthandler.NewMockRequestHandler(mc)
+			h := NewToolHandler(mockRequest)
+			ctx := context.Background()
+
+			if tt.expectList {
+				filters := map[ammcpserver.Field]any{
+					ammcpserver.FieldCustomerID: customerID,
+					ammcpserver.FieldDeleted:    false,
+				}
+				mockRequest.EXPECT().AIV1MCPServerList(ctx, "", uint64(mcpServerListSize), filters).Return(tt.responseServers, tt.responseErr)
+			}
+			if tt.expectDiscover {
+				mockRequest.EXPECT().AIV1MCPServerToolList(ctx, crmID).Return(tt.responseTools, tt.responseToolErr)
+			}
+
+			res := h.GetMCPTools(ctx, customerID, tt.aiType, tt.names)
+
+			got := make([]aitool.ToolName, 0, len(res))
+			for _, r := range res {
+				got = append(got, r.Name)
+			}
+			if !reflect.DeepEqual(got, tt.expectNames) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectNames, got)
+			}
+		})
+	}
+}
+
 // sortToolNames sorts tool names for comparison
 func sortToolNames(names []aitool.ToolName) []aitool.ToolName {
 	result := make([]aitool.ToolName, len(names))
@@ -15,6 +15,7 @@ import (
 	tool "monorepo/bin-ai-manager/models/tool"
 	reflect "reflect"
 
+	uuid "github.com/gofrs/uuid"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -69,3 +70,31 @@ func (mr *MockToolHandlerMockRecorder) GetByNames(aiType, names any) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByNames", reflect.TypeOf((*MockToolHandler)(nil).GetByNames), aiType, names)
 }
+
+// GetCustomTools mocks base method.
+func (m *MockToolHandler) GetCustomTools(ctx context.Context, customerID uuid.UUID, aiType ai.Type, names []tool.ToolName) []tool.Tool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomTools", ctx, customerID, aiType, names)
+	ret0, _ := ret[0].([]tool.Tool)
+	return ret0
+}
+
+// GetCustomTools indicates an expected call of GetCustomTools.
+func (mr *MockToolHandlerMockRecorder) GetCustomTools(ctx, customerID, aiType, names any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomTools", reflect.TypeOf((*MockToolHandler)(nil).GetCustomTools), ctx, customerID, aiType, names)
+}
+
+// GetMCPTools mocks base method.
+func (m *MockToolHandler) GetMCPTools(ctx context.Context, customerID uuid.UUID, aiType ai.Type, names []tool.ToolName) []tool.Tool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMCPTools", ctx, customerID, aiType, names)
+	ret0, _ := ret[0].([]tool.Tool)
+	return ret0
+}
+
+// GetMCPTools indicates an expected call of GetMCPTools.
+func (mr *MockToolHandlerMockRecorder) GetMCPTools(ctx, customerID, aiType, names any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMCPTools", reflect.TypeOf((*MockToolHandler)(nil).GetMCPTools), ctx, customerID, aiType, names)
+}
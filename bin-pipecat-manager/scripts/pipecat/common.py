@@ -1,7 +1,21 @@
+import hashlib
 import os
 
 PIPECATCALL_ADDRESS = os.environ.get("PIPECATCALL_ADDRESS", "localhost:8001")
 PIPECATCALL_HTTP_URL = "http://" + PIPECATCALL_ADDRESS
 PIPECATCALL_WS_URL = "ws://" + PIPECATCALL_ADDRESS
 
-PIPELINE_SESSION_TIMEOUT = 180 # seconds
\ No newline at end of file
+PIPELINE_SESSION_TIMEOUT = 180 # seconds
+
+ENGINE_KEY_HASH_LENGTH = 16
+
+
+def engine_key_hash(key: str | None) -> str:
+    """Return the hash identifying an engine key, or "" for the platform's key.
+
+    Go tracks the engine health per model and key hash (llmKeyHash), so the
+    runner reports engines by it instead of sending the key back.
+    """
+    if not key:
+        return ""
+    return hashlib.sha256(key.encode()).hexdigest()[:ENGINE_KEY_HASH_LENGTH]
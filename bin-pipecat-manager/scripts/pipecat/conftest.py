@@ -92,6 +92,10 @@ _mocks = {
     "tools": _make_mock_module("tool_register", "tool_unregister", "convert_to_openai_format", "get_tool_names"),
     "task": _make_mock_module("task_manager"),
     "routing_llm": _make_mock_module("RoutingLLMService"),
+    "failover_llm": _make_mock_module(FailoverLLMService=type("FailoverLLMService", (), {})),
+    "redaction": _make_mock_module("RedactionProcessor"),
+    "guardrail": _make_mock_module("GuardrailProcessor"),
+    "responsecache": _make_mock_module("ResponseCache"),
     "routing_tts": _make_mock_module("RoutingTTSService"),
     "routing_stt": _make_mock_module("RoutingSTTService"),
     "team_flow": _make_mock_module("build_team_flow"),
@@ -0,0 +1,249 @@
+import asyncio
+import re
+
+import aiohttp
+from loguru import logger
+
+import common
+from pipecat.frames.frames import (
+    CancelFrame,
+    EndFrame,
+    ErrorFrame,
+    Frame,
+    LLMContextFrame,
+    LLMFullResponseEndFrame,
+    LLMFullResponseStartFrame,
+    MetricsFrame,
+    StartFrame,
+)
+from pipecat.processors.frame_processor import FrameDirection, FrameProcessor, FrameProcessorSetup
+
+
+class FailoverLLMService(FrameProcessor):
+    """Fails over along an AI's engine chain when the active LLM errors.
+
+    Wraps one LLM service per engine, in the order they should be tried, and
+    delegates to the active one. When a generation fails on the active engine
+    before it produced any output (rate limit, outage), the next engine becomes
+    active and the same context is replayed on it, so the caller only notices a
+    slightly longer pause. The engine stays switched for the rest of the call.
+
+    The response start/end frames of an attempt are held back until the attempt
+    produces output, so an abandoned attempt leaves no empty response behind.
+    The last engine is not guarded: its errors are passed on as they are.
+
+    Every switch is reported to Go, which tracks engine health and records the
+    model used per message. The engines are reported by model and key hash,
+    with the provider's HTTP status of the error when it is known.
+    """
+
+    def __init__(self, pipecatcall_id: str, engines: list[tuple[str, any]], engine_key_hashes: list[str] | None = None):
+        """Initialize with the pipecatcall ID, a list of (engine_model, LLM service)
+        and the key hash of each engine (common.engine_key_hash). Without key
+        hashes, all engines are reported as using the platform's key.
+        """
+        super().__init__()
+        if not engines:
+            raise ValueError("FailoverLLMService requires at least one engine")
+        if engine_key_hashes is None:
+            engine_key_hashes = [""] * len(engines)
+        if len(engine_key_hashes) != len(engines):
+            raise ValueError("FailoverLLMService requires a key hash per engine")
+
+        self._pipecatcall_id = pipecatcall_id
+        self._engines = engines
+        self._engine_key_hashes = engine_key_hashes
+        self._active = 0
+
+        # state of the generation in progress
+        self._generating = False
+        self._output_started = False
+        self._attempt_error = None
+        self._attempt_status_code = 0
+        self._held_frames = []
+
+        # Override each service's push_frame to route output through us
+        for idx, (_, svc) in enumerate(self._engines):
+            svc.push_frame = self._create_routing_push(idx)
+
+    def _create_routing_push(self, idx: int):
+        async def routing_push(frame: Frame, direction: FrameDirection = FrameDirection.DOWNSTREAM):
+            # Engines other than the active one only see lifecycle frames;
+            # whatever they push in response is a duplicate.
+            if idx != self._active:
+                return
+
+            if self._can_fail_over():
+                if isinstance(frame, ErrorFrame) and not getattr(frame, "fatal", False):
+                    self._attempt_error = frame.error
+                    self._attempt_status_code = error_status_code(frame.error)
+                    return
+                if isinstance(frame, (LLMFullResponseStartFrame, LLMFullResponseEndFrame)):
+                    self._held_frames.append((frame, direction))
+                    return
+                if not isinstance(frame, MetricsFrame):
+                    self._output_started = True
+                    await self._flush_held_frames()
+
+            await self.push_frame(frame, direction)
+        return routing_push
+
+    def _can_fail_over(self) -> bool:
+        return self._generating and not self._output_started and self._active < len(self._engines) - 1
+
+    async def _flush_held_frames(self):
+        held, self._held_frames = self._held_frames, []
+        for frame, direction in held:
+            await self.push_frame(frame, direction)
+
+    async def setup(self, setup: FrameProcessorSetup):
+        await super().setup(setup)
+        for _, svc in self._engines:
+            await svc.setup(setup)
+
+    async def cleanup(self):
+        await super().cleanup()
+        for _, svc in self._engines:
+            await svc.cleanup()
+
+    async def process_frame(self, frame: Frame, direction: FrameDirection):
+        # Lifecycle frames must initialize us and propagate to all inner services.
+        if isinstance(frame, (StartFrame, CancelFrame, EndFrame)):
+            await super().process_frame(frame, direction)
+            for _, svc in self._engines:
+                await svc.process_frame(frame, direction)
+            return
+
+        if not isinstance(frame, LLMContextFrame):
+            await self.active_service.process_frame(frame, direction)
+            return
+
+        while True:
+            error = await self._run_attempt(frame, direction)
+            if error is None:
+                return
+            self._fail_over(error, self._attempt_status_code)
+
+    async def _run_attempt(self, frame: Frame, direction: FrameDirection) -> str | None:
+        """Run a generation on the active engine. Returns the error if it should be failed over."""
+        self._generating = True
+        self._output_started = False
+        self._attempt_error = None
+        self._attempt_status_code = 0
+        self._held_frames = []
+        try:
+            await self.active_service.process_frame(frame, direction)
+        except Exception as e:
+            if not self._can_fail_over():
+                raise
+            self._attempt_error = f"{type(e).__name__}: {e}"
+            self._attempt_status_code = error_status_code(e)
+        finally:
+            self._generating = False
+
+        if self._attempt_error is None:
+            await self._flush_held_frames()
+            return None
+
+        self._held_frames = []
+        return self._attempt_error
+
+    def _fail_over(self, error: str, status_code: int = 0):
+        from_model = self.active_engine_model
+        from_key_hash = self._engine_key_hashes[self._active]
+        self._active += 1
+        to_model = self.active_engine_model
+        to_key_hash = self._engine_key_hashes[self._active]
+
+        logger.warning(
+            f"[failover_llm] LLM engine failed, switching. pipecatcall_id={self._pipecatcall_id} "
+            f"from={from_model} to={to_model} status_code={status_code} error={error}"
+        )
+
+        # Fire-and-forget notification to Go
+        asyncio.create_task(_notify_llm_failover(
+            self._pipecatcall_id, from_model, to_model, error,
+            from_key_hash=from_key_hash, to_key_hash=to_key_hash, status_code=status_code,
+        ))
+
+    # Delegate function registration to all services so tools keep working after a switch.
+    # Signature matches RoutingLLMService.register_function.
+    def register_function(self, name=None, handler=None, *, cancel_on_interruption=None, timeout_secs=None, **kwargs):
+        if kwargs:
+            raise TypeError(
+                f"FailoverLLMService.register_function got unexpected kwargs: {list(kwargs)}"
+            )
+        for _, svc in self._engines:
+            svc.register_function(
+                name,
+                handler,
+                cancel_on_interruption=cancel_on_interruption,
+                timeout_secs=timeout_secs,
+            )
+
+    def unregister_function(self, name):
+        for _, svc in self._engines:
+            try:
+                svc.unregister_function(name)
+            except (KeyError, Exception):
+                pass
+
+    @property
+    def active_service(self):
+        """Return the currently active LLM service instance."""
+        return self._engines[self._active][1]
+
+    @property
+    def active_engine_model(self) -> str:
+        """Return the engine model of the currently active LLM service."""
+        return self._engines[self._active][0]
+
+
+# Matches the HTTP status in the provider errors, i.e. "Error code: 429 - {...}"
+# of the OpenAI SDK or "429 Too Many Requests" of the Google SDK.
+_STATUS_CODE_RE = re.compile(r"(?:\berror code:?\s*|\bstatus(?: code)?:?\s*|^)([1-5]\d\d)\b", re.IGNORECASE)
+
+
+def error_status_code(error) -> int:
+    """Return the provider's HTTP status of the given exception or error text, or 0 if unknown."""
+    for attr in ("status_code", "status", "code"):
+        value = getattr(error, attr, None)
+        if isinstance(value, int) and 100 <= value <= 599:
+            return value
+
+    match = _STATUS_CODE_RE.search(str(error or "").strip())
+    if match is None:
+        return 0
+    return int(match.group(1))
+
+
+async def _notify_llm_failover(
+    pipecatcall_id: str,
+    from_model: str,
+    to_model: str,
+    error: str,
+    from_key_hash: str = "",
+    to_key_hash: str = "",
+    status_code: int = 0,
+):
+    """Fire-and-forget HTTP notification to Go about an LLM failover."""
+    http_url = f"{common.PIPECATCALL_HTTP_URL}/{pipecatcall_id}/llm-failover"
+    http_body = {
+        "from_engine_model": from_model,
+        "from_engine_key_hash": from_key_hash,
+        "to_engine_model": to_model,
+        "to_engine_key_hash": to_key_hash,
+        "error": (error or "")[:500],
+        "status_code": status_code,
+    }
+
+    try:
+        async with aiohttp.ClientSession(timeout=aiohttp.ClientTimeout(total=10)) as session:
+            async with session.post(http_url, json=http_body) as response:
+                if response.status >= 400:
+                    text = await response.text()
+                    logger.warning(f"[failover_llm][llm-failover] HTTP {response.status}: {text[:500]}")
+                else:
+                    logger.debug(f"[failover_llm][llm-failover] Notification sent successfully")
+    except Exception as e:
+        logger.warning(f"[failover_llm][llm-failover] Failed to notify: {e}")
@@ -0,0 +1,237 @@
+import re
+
+import aiohttp
+from loguru import logger
+
+import common
+from pipecat.frames.frames import (
+    Frame,
+    InterruptionFrame,
+    LLMContextFrame,
+    LLMFullResponseEndFrame,
+    LLMFullResponseStartFrame,
+    LLMTextFrame,
+)
+from pipecat.processors.frame_processor import FrameDirection, FrameProcessor
+
+
+# a sentence ends with a latin terminator followed by a whitespace, or with a
+# full-width terminator. the whitespace is required so "3.5" is never split.
+_SENTENCE_END = re.compile(r"[.!?]\s+|[。！？]")
+
+
+class GuardrailInputProcessor(FrameProcessor):
+    """Checks the user's input against the aicall's blocked topics before the LLM.
+
+    Sits right before the LLM service (and the response cache lookup). The
+    latest user turn is checked by Go (and so by the ai-manager). When it talks
+    about a blocked topic, the context frame is dropped so the LLM never answers
+    it, and the returned escalation message is pushed as a whole LLM response.
+
+    Fails open: when the check request fails the LLM runs as usual. Its output
+    is still checked by the GuardrailProcessor.
+    """
+
+    def __init__(self, pipecatcall_id: str):
+        super().__init__()
+        self._pipecatcall_id = pipecatcall_id
+        self._session: aiohttp.ClientSession | None = None
+
+    async def cleanup(self):
+        await super().cleanup()
+        if self._session is not None and not self._session.closed:
+            await self._session.close()
+        self._session = None
+
+    async def process_frame(self, frame: Frame, direction: FrameDirection):
+        await super().process_frame(frame, direction)
+
+        if direction != FrameDirection.DOWNSTREAM or not isinstance(frame, LLMContextFrame):
+            await self.push_frame(frame, direction)
+            return
+
+        # the LLM runs again on the same user turn after a function call.
+        # only a fresh user turn is checked.
+        text = _last_user_text(frame.context.get_messages())
+        if not text:
+            await self.push_frame(frame, direction)
+            return
+
+        res, blocked = await _check_input(self._http_session(), self._pipecatcall_id, text)
+        if not blocked:
+            await self.push_frame(frame, direction)
+            return
+
+        logger.info(f"[guardrail][input] Blocked the user's input. pipecatcall_id: {self._pipecatcall_id}")
+        if not res:
+            return
+
+        await self.push_frame(LLMFullResponseStartFrame(), direction)
+        await self.push_frame(LLMTextFrame(res), direction)
+        await self.push_frame(LLMFullResponseEndFrame(), direction)
+
+    def _http_session(self) -> aiohttp.ClientSession:
+        """Return the session of the pipeline, so the checks reuse the connections."""
+        if self._session is None or self._session.closed:
+            self._session = aiohttp.ClientSession(timeout=aiohttp.ClientTimeout(total=2))
+        return self._session
+
+
+class GuardrailProcessor(FrameProcessor):
+    """Checks the LLM output against the aicall's guardrail before the TTS.
+
+    Sits right after the LLM service. The streamed text is buffered into whole
+    sentences, and each batch of sentences is checked by Go (and so by the
+    ai-manager), which returns the text to speak instead and whether it was
+    blocked. The returned text has the disclaimer in front of the first output,
+    or the escalation message in place of a blocked output. Once an output is
+    blocked, the rest of the response is dropped.
+
+    Fails closed: when the check request fails the output is treated as
+    blocked, so an unchecked text never reaches the TTS.
+    """
+
+    def __init__(self, pipecatcall_id: str):
+        super().__init__()
+        self._pipecatcall_id = pipecatcall_id
+        self._buffer = ""
+        self._blocked = False
+        self._session: aiohttp.ClientSession | None = None
+
+    async def cleanup(self):
+        await super().cleanup()
+        if self._session is not None and not self._session.closed:
+            await self._session.close()
+        self._session = None
+
+    async def process_frame(self, frame: Frame, direction: FrameDirection):
+        await super().process_frame(frame, direction)
+
+        if direction != FrameDirection.DOWNSTREAM:
+            await self.push_frame(frame, direction)
+            return
+
+        if isinstance(frame, LLMTextFrame):
+            self._buffer += frame.text
+            end = _last_sentence_end(self._buffer)
+            if end > 0:
+                text = self._buffer[:end]
+                self._buffer = self._buffer[end:]
+                await self._check_and_push(text, direction)
+            return
+
+        if isinstance(frame, LLMFullResponseStartFrame):
+            self._reset()
+        elif isinstance(frame, LLMFullResponseEndFrame):
+            if self._buffer:
+                text = self._buffer
+                self._buffer = ""
+                await self._check_and_push(text, direction)
+            self._reset()
+        elif isinstance(frame, InterruptionFrame):
+            self._reset()
+
+        await self.push_frame(frame, direction)
+
+    def _reset(self):
+        self._buffer = ""
+        self._blocked = False
+
+    async def _check_and_push(self, text: str, direction: FrameDirection):
+        if self._blocked or not text.strip():
+            return
+
+        res, blocked = await _check(self._http_session(), self._pipecatcall_id, text)
+        if blocked:
+            self._blocked = True
+
+        if not res:
+            return
+
+        # keep the space the check may have trimmed, so the sentences don't run together.
+        if text[-1].isspace() and not res[-1].isspace():
+            res += " "
+
+        await self.push_frame(LLMTextFrame(res), direction)
+
+    def _http_session(self) -> aiohttp.ClientSession:
+        """Return the session of the pipeline, so the checks reuse the connections."""
+        if self._session is None or self._session.closed:
+            self._session = aiohttp.ClientSession(timeout=aiohttp.ClientTimeout(total=3))
+        return self._session
+
+
+def _last_user_text(messages: list) -> str:
+    """Return the text of the last message, or empty if it is not the user's."""
+    if not messages:
+        return ""
+
+    message = messages[-1]
+    if not isinstance(message, dict) or message.get("role") != "user":
+        return ""
+
+    content = message.get("content")
+    if isinstance(content, str):
+        return content.strip()
+
+    if isinstance(content, list):
+        parts = [p.get("text", "") for p in content if isinstance(p, dict) and p.get("type") == "text"]
+        return " ".join(parts).strip()
+
+    return ""
+
+
+def _last_sentence_end(text: str) -> int:
+    """Return the end index of the last whole sentence in the text, or 0 if none."""
+    end = 0
+    for m in _SENTENCE_END.finditer(text):
+        end = m.end()
+    return end
+
+
+async def _check(session: aiohttp.ClientSession, pipecatcall_id: str, text: str) -> tuple[str, bool]:
+    """Return the text to speak and whether the text was blocked.
+
+    A text that could not be checked is blocked and dropped.
+    """
+    http_url = f"{common.PIPECATCALL_HTTP_URL}/{pipecatcall_id}/guardrail"
+    http_body = {
+        "text": text,
+    }
+
+    try:
+        async with session.post(http_url, json=http_body) as response:
+            if response.status >= 400:
+                body = await response.text()
+                logger.error(f"[guardrail][check] HTTP {response.status}: {body[:500]}. Dropping the output.")
+                return "", True
+
+            res = await response.json()
+            return res.get("text", ""), bool(res.get("blocked", False))
+    except Exception as e:
+        logger.error(f"[guardrail][check] Failed to check: {e}. Dropping the output.")
+        return "", True
+
+
+async def _check_input(session: aiohttp.ClientSession, pipecatcall_id: str, text: str) -> tuple[str, bool]:
+    """Return the text to speak instead and whether the user's input was blocked.
+
+    An input that could not be checked is not blocked.
+    """
+    http_url = f"{common.PIPECATCALL_HTTP_URL}/{pipecatcall_id}/guardrail/input"
+    http_body = {
+        "text": text,
+    }
+
+    try:
+        async with session.post(http_url, json=http_body) as response:
+            if response.status >= 400:
+                body = await response.text()
+                logger.warning(f"[guardrail][input] HTTP {response.status}: {body[:500]}")
+                return "", False
+
+            res = await response.json()
+            return res.get("text", ""), bool(res.get("blocked", False))
+    except Exception as e:
+        logger.warning(f"[guardrail][input] Failed to check: {e}")
+        return "", False
@@ -37,9 +37,17 @@ class Tool(BaseModel):
     class Config:
         extra = "ignore"
 
+class EngineFallback(BaseModel):
+    engine_model: str
+    engine_key: Optional[str] = None
+
+    class Config:
+        extra = "ignore"
+
 class ResolvedAI(BaseModel):
     engine_model: str
     engine_key: str
+    engine_fallbacks: Optional[List[EngineFallback]] = Field(default_factory=list)
     init_prompt: Optional[str] = None
     parameter: Optional[dict] = None
     tts_type: Optional[str] = None
@@ -81,6 +89,7 @@ class PipelineRequest(BaseModel):
     id: Optional[str] = None
     llm_type: Optional[str] = None
     llm_key: Optional[str] = None
+    llm_fallbacks: Optional[List[EngineFallback]] = Field(default_factory=list)
     llm_messages: Optional[List[Message]] = Field(default_factory=list)
     stt_type: Optional[str] = None
     stt_language: Optional[str] = None
@@ -91,6 +100,9 @@ class PipelineRequest(BaseModel):
     resolved_team: Optional[ResolvedTeam] = None
     vad_config: Optional[dict] = None
     smart_turn_enabled: Optional[bool] = False
+    redaction_enabled: Optional[bool] = False
+    guardrail_enabled: Optional[bool] = False
+    response_cache_enabled: Optional[bool] = False
 
 async def execute_pipeline_wrapper(id: str, ctx: dict):
     """Background task wrapper for execute_pipeline."""
@@ -108,6 +120,7 @@ async def run_pipeline_endpoint(req: PipelineRequest):
         "event": "run_request",
         "id": req.id,
         "llm_type": req.llm_type,
+        "llm_fallbacks": [f.engine_model for f in req.llm_fallbacks or []],
         "llm_message_count": msg_count,
         "stt_type": req.stt_type,
         "stt_language": req.stt_language,
@@ -115,6 +128,9 @@ async def run_pipeline_endpoint(req: PipelineRequest):
         "tts_language": req.tts_language,
         "tts_voice_id": req.tts_voice_id,
         "has_resolved_team": req.resolved_team is not None,
+        "redaction_enabled": req.redaction_enabled,
+        "guardrail_enabled": req.guardrail_enabled,
+        "response_cache_enabled": req.response_cache_enabled,
     }))
 
     try:
@@ -135,6 +151,10 @@ async def run_pipeline_endpoint(req: PipelineRequest):
             resolved_team=resolved_team_data,
             vad_config=req.vad_config,
             smart_turn_enabled=req.smart_turn_enabled,
+            llm_fallbacks=[f.model_dump() for f in req.llm_fallbacks or []],
+            redaction_enabled=req.redaction_enabled,
+            guardrail_enabled=req.guardrail_enabled,
+            response_cache_enabled=req.response_cache_enabled,
         )
     except ValueError as e:
         logger.error(f"Pipeline validation failed (id={req.id}): {e}")
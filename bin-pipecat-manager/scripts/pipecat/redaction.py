@@ -0,0 +1,54 @@
+import aiohttp
+from loguru import logger
+
+import common
+from pipecat.frames.frames import Frame, TranscriptionFrame
+from pipecat.processors.frame_processor import FrameDirection, FrameProcessor
+
+
+class RedactionProcessor(FrameProcessor):
+    """Redacts the personal data of the caller's transcriptions.
+
+    Sits right after the STT service, so a final transcription is redacted
+    before the user context aggregator adds it to the LLM context. The text is
+    redacted by Go (and so by the ai-manager) with the aicall's redaction
+    config, which keeps the tokens the same as in the stored messages.
+
+    Fails open: when the redaction request fails the transcription is passed on
+    as it is, so a call never stalls on it. The stored messages are redacted by
+    the ai-manager regardless.
+    """
+
+    def __init__(self, pipecatcall_id: str):
+        super().__init__()
+        self._pipecatcall_id = pipecatcall_id
+
+    async def process_frame(self, frame: Frame, direction: FrameDirection):
+        await super().process_frame(frame, direction)
+
+        if isinstance(frame, TranscriptionFrame) and frame.text:
+            frame.text = await _redact(self._pipecatcall_id, frame.text)
+
+        await self.push_frame(frame, direction)
+
+
+async def _redact(pipecatcall_id: str, text: str) -> str:
+    """Return the redacted text, or the given text if it could not be redacted."""
+    http_url = f"{common.PIPECATCALL_HTTP_URL}/{pipecatcall_id}/redact"
+    http_body = {
+        "text": text,
+    }
+
+    try:
+        async with aiohttp.ClientSession(timeout=aiohttp.ClientTimeout(total=3)) as session:
+            async with session.post(http_url, json=http_body) as response:
+                if response.status >= 400:
+                    body = await response.text()
+                    logger.warning(f"[redaction][redact] HTTP {response.status}: {body[:500]}")
+                    return text
+
+                res = await response.json()
+                return res.get("text", text)
+    except Exception as e:
+        logger.warning(f"[redaction][redact] Failed to redact: {e}")
+        return text
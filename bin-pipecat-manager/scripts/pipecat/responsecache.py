@@ -0,0 +1,186 @@
+import aiohttp
+from loguru import logger
+
+import common
+from pipecat.frames.frames import (
+    Frame,
+    FunctionCallsStartedFrame,
+    InterruptionFrame,
+    LLMContextFrame,
+    LLMFullResponseEndFrame,
+    LLMFullResponseStartFrame,
+    LLMTextFrame,
+)
+from pipecat.processors.frame_processor import FrameDirection, FrameProcessor
+
+
+# the most user turns sent to the lookup. the ai-manager keys the cache on the
+# AI's configured number of turns out of these.
+MAX_USER_TURNS = 5
+
+
+class ResponseCache:
+    """Answers repeated questions from the aicall's semantic response cache.
+
+    Holds the lookup and the store processors of a pipeline, which share the
+    user turns of the pending miss. The lookup sits right before the LLM
+    service and the store right after it, so a cached answer goes through the
+    rest of the pipeline (guardrail, TTS, context) like an LLM answer does.
+    """
+
+    def __init__(self, pipecatcall_id: str):
+        self.pipecatcall_id = pipecatcall_id
+        self.pending_user_turns = None
+
+    def lookup_processor(self) -> "ResponseCacheLookupProcessor":
+        return ResponseCacheLookupProcessor(self)
+
+    def store_processor(self) -> "ResponseCacheStoreProcessor":
+        return ResponseCacheStoreProcessor(self)
+
+
+class ResponseCacheLookupProcessor(FrameProcessor):
+    """Looks up the cached answer to the latest user turns before the LLM runs.
+
+    On a hit, the cached answer is pushed as a whole LLM response and the
+    context frame is dropped, so the LLM never runs. On a miss, the user turns
+    are kept for the store processor.
+
+    Fails open: when the lookup request fails the LLM runs as usual, so a call
+    never stalls on it.
+    """
+
+    def __init__(self, cache: ResponseCache):
+        super().__init__()
+        self._cache = cache
+
+    async def process_frame(self, frame: Frame, direction: FrameDirection):
+        await super().process_frame(frame, direction)
+
+        if direction != FrameDirection.DOWNSTREAM or not isinstance(frame, LLMContextFrame):
+            await self.push_frame(frame, direction)
+            return
+
+        # the LLM runs again on the same user turns after a function call.
+        # only a fresh user turn is looked up.
+        self._cache.pending_user_turns = None
+        user_turns = _user_turns(frame.context.get_messages())
+        if not user_turns:
+            await self.push_frame(frame, direction)
+            return
+
+        res = await _lookup(self._cache.pipecatcall_id, user_turns)
+        if not res:
+            self._cache.pending_user_turns = user_turns
+            await self.push_frame(frame, direction)
+            return
+
+        logger.info(f"[responsecache][lookup] Answered from the cache. pipecatcall_id: {self._cache.pipecatcall_id}")
+        await self.push_frame(LLMFullResponseStartFrame(), direction)
+        await self.push_frame(LLMTextFrame(res), direction)
+        await self.push_frame(LLMFullResponseEndFrame(), direction)
+
+
+class ResponseCacheStoreProcessor(FrameProcessor):
+    """Stores the LLM's answer to the user turns of the pending miss.
+
+    Answers with function calls, interrupted answers and cached answers are not
+    stored.
+    """
+
+    def __init__(self, cache: ResponseCache):
+        super().__init__()
+        self._cache = cache
+        self._buffer = ""
+
+    async def process_frame(self, frame: Frame, direction: FrameDirection):
+        await super().process_frame(frame, direction)
+
+        if direction != FrameDirection.DOWNSTREAM:
+            await self.push_frame(frame, direction)
+            return
+
+        if isinstance(frame, LLMFullResponseStartFrame):
+            self._buffer = ""
+        elif isinstance(frame, LLMTextFrame):
+            self._buffer += frame.text
+        elif isinstance(frame, (FunctionCallsStartedFrame, InterruptionFrame)):
+            self._cache.pending_user_turns = None
+        elif isinstance(frame, LLMFullResponseEndFrame):
+            await self.push_frame(frame, direction)
+
+            user_turns = self._cache.pending_user_turns
+            response = self._buffer.strip()
+            self._cache.pending_user_turns = None
+            self._buffer = ""
+            if user_turns and response:
+                await _store(self._cache.pipecatcall_id, user_turns, response)
+            return
+
+        await self.push_frame(frame, direction)
+
+
+def _user_turns(messages: list) -> list:
+    """Return the text of the latest user turns, or [] if the last message is not the user's."""
+    if not messages or not _is_user(messages[-1]):
+        return []
+
+    res = [_message_text(m) for m in messages if _is_user(m)]
+    res = [t for t in res if t]
+    return res[-MAX_USER_TURNS:]
+
+
+def _is_user(message) -> bool:
+    return isinstance(message, dict) and message.get("role") == "user"
+
+
+def _message_text(message: dict) -> str:
+    content = message.get("content")
+    if isinstance(content, str):
+        return content.strip()
+
+    if isinstance(content, list):
+        parts = [p.get("text", "") for p in content if isinstance(p, dict) and p.get("type") == "text"]
+        return " ".join(parts).strip()
+
+    return ""
+
+
+async def _lookup(pipecatcall_id: str, user_turns: list) -> str:
+    """Return the cached answer, or empty if there is none or it could not be looked up."""
+    http_url = f"{common.PIPECATCALL_HTTP_URL}/{pipecatcall_id}/response-cache/lookup"
+    http_body = {
+        "user_turns": user_turns,
+    }
+
+    try:
+        async with aiohttp.ClientSession(timeout=aiohttp.ClientTimeout(total=2)) as session:
+            async with session.post(http_url, json=http_body) as response:
+                if response.status >= 400:
+                    body = await response.text()
+                    logger.warning(f"[responsecache][lookup] HTTP {response.status}: {body[:500]}")
+                    return ""
+
+                res = await response.json()
+                return res.get("text", "")
+    except Exception as e:
+        logger.warning(f"[responsecache][lookup] Failed to look up: {e}")
+        return ""
+
+
+async def _store(pipecatcall_id: str, user_turns: list, response_text: str):
+    """Store the answer. Failures are logged only."""
+    http_url = f"{common.PIPECATCALL_HTTP_URL}/{pipecatcall_id}/response-cache/store"
+    http_body = {
+        "user_turns": user_turns,
+        "response": response_text,
+    }
+
+    try:
+        async with aiohttp.ClientSession(timeout=aiohttp.ClientTimeout(total=3)) as session:
+            async with session.post(http_url, json=http_body) as response:
+                if response.status >= 400:
+                    body = await response.text()
+                    logger.warning(f"[responsecache][store] HTTP {response.status}: {body[:500]}")
+    except Exception as e:
+        logger.warning(f"[responsecache][store] Failed to store: {e}")
@@ -44,6 +44,10 @@ from pipecat.transports.websocket.client import (
 from tools import tool_register, tool_unregister, convert_to_openai_format, get_tool_names
 from task import task_manager
 from routing_llm import RoutingLLMService
+from failover_llm import FailoverLLMService
+from redaction import RedactionProcessor
+from guardrail import GuardrailInputProcessor, GuardrailProcessor
+from responsecache import ResponseCache
 from routing_tts import RoutingTTSService
 from routing_stt import RoutingSTTService
 from team_flow import build_team_flow
@@ -91,6 +95,10 @@ async def init_pipeline(
     resolved_team: dict = None,
     vad_config: dict = None,
     smart_turn_enabled: bool = False,
+    llm_fallbacks: list = None,
+    redaction_enabled: bool = False,
+    guardrail_enabled: bool = False,
+    response_cache_enabled: bool = False,
 ) -> dict:
     """Initialize the pipeline. Returns context dict. Raises on failure."""
     if resolved_team:
@@ -101,6 +109,8 @@ async def init_pipeline(
             llm_messages=llm_messages,
             vad_config=vad_config,
             smart_turn_enabled=smart_turn_enabled,
+            redaction_enabled=redaction_enabled,
+            guardrail_enabled=guardrail_enabled,
         )
         ctx["type"] = "team"
         return ctx
@@ -111,6 +121,10 @@ async def init_pipeline(
             tts_voice_id, tools_data,
             vad_config=vad_config,
             smart_turn_enabled=smart_turn_enabled,
+            llm_fallbacks=llm_fallbacks,
+            redaction_enabled=redaction_enabled,
+            guardrail_enabled=guardrail_enabled,
+            response_cache_enabled=response_cache_enabled,
         )
         ctx["type"] = "single"
         return ctx
@@ -137,6 +151,10 @@ async def init_single_ai_pipeline(
     tools_data: list = None,
     vad_config: dict = None,
     smart_turn_enabled: bool = False,
+    llm_fallbacks: list = None,
+    redaction_enabled: bool = False,
+    guardrail_enabled: bool = False,
+    response_cache_enabled: bool = False,
 ) -> dict:
     """Initialize single AI pipeline. Returns context dict. Raises on failure."""
     total_start = time.monotonic()
@@ -183,7 +201,7 @@ async def init_single_ai_pipeline(
 
     async def init_llm():
         start = time.monotonic()
-        llm_service, aggregator = create_llm_service(llm_type, llm_key, llm_messages, openai_tools)
+        llm_service, aggregator = create_failover_llm_service(id, llm_type, llm_key, llm_fallbacks, llm_messages, openai_tools)
         logger.info(f"[INIT][llm] done in {time.monotonic() - start:.3f} sec. pipeline id={id}")
         return {
             "llm_service": llm_service,
@@ -227,8 +245,20 @@ async def init_single_ai_pipeline(
     if transport_input:
         pipeline_stages.append(transport_input.input())
         pipeline_stages.append(stt_service)
+        if redaction_enabled:
+            pipeline_stages.append(RedactionProcessor(id))
     pipeline_stages.append(llm_context_aggregator.user())
-    pipeline_stages.append(llm_service)
+    if guardrail_enabled:
+        pipeline_stages.append(GuardrailInputProcessor(id))
+    if response_cache_enabled:
+        response_cache = ResponseCache(id)
+        pipeline_stages.append(response_cache.lookup_processor())
+        pipeline_stages.append(llm_service)
+        pipeline_stages.append(response_cache.store_processor())
+    else:
+        pipeline_stages.append(llm_service)
+    if guardrail_enabled:
+        pipeline_stages.append(GuardrailProcessor(id))
     if tts_service:
         pipeline_stages.append(tts_service)
     pipeline_stages.append(llm_context_aggregator.assistant())
@@ -510,6 +540,30 @@ def create_llm_service(type: str, key: str, messages: list[dict], tools: list[di
         raise ValueError(f"Unsupported LLM service: {service_name}")
 
 
+def create_failover_llm_service(id: str, type: str, key: str, fallbacks: list[dict] | None, messages: list[dict], tools: list[dict]):
+    """Create the LLM service of an engine chain.
+
+    Without fallbacks this is create_llm_service. Otherwise the services of the
+    whole chain are wrapped in a FailoverLLMService. The fallback services share
+    the primary's aggregator: the universal LLMContext it produces, tools
+    included, is understood by every provider.
+    """
+    llm, aggregator = create_llm_service(type, key, messages, tools)
+    if not fallbacks:
+        return llm, aggregator
+
+    engines = [(type, llm)]
+    engine_key_hashes = [common.engine_key_hash(key)]
+    for fallback in fallbacks:
+        fallback_key = fallback.get("engine_key", "")
+        fallback_llm, _ = create_llm_service(fallback["engine_model"], fallback_key, [], [])
+        engines.append((fallback["engine_model"], fallback_llm))
+        engine_key_hashes.append(common.engine_key_hash(fallback_key))
+    logger.info(f"[INIT][llm] Engine chain: {[model for model, _ in engines]}. pipeline id={id}")
+
+    return FailoverLLMService(id, engines, engine_key_hashes), aggregator
+
+
 class UnpacedWebsocketClientOutputTransport(WebsocketClientOutputTransport):
     """Output transport that delivers audio faster than real-time.
 
@@ -570,6 +624,8 @@ async def init_team_pipeline(
     llm_messages: list = None,
     vad_config: dict = None,
     smart_turn_enabled: bool = False,
+    redaction_enabled: bool = False,
+    guardrail_enabled: bool = False,
 ) -> dict:
     """Initialize team pipeline. Returns context dict. Raises on failure."""
     total_start = time.monotonic()
@@ -595,7 +651,7 @@ async def init_team_pipeline(
         ai = member["ai"]
         start = time.monotonic()
 
-        llm_svc, _ = create_llm_service(ai["engine_model"], ai["engine_key"], [], [])
+        llm_svc, _ = create_failover_llm_service(id, ai["engine_model"], ai["engine_key"], ai.get("engine_fallbacks"), [], [])
         llm_services[mid] = llm_svc
 
         if ai.get("tts_type"):
@@ -661,8 +717,14 @@ async def init_team_pipeline(
     if routing_stt:
         pipeline_stages.append(transport_input.input())
         pipeline_stages.append(routing_stt)
+        if redaction_enabled:
+            pipeline_stages.append(RedactionProcessor(id))
     pipeline_stages.append(context_aggregator.user())
+    if guardrail_enabled:
+        pipeline_stages.append(GuardrailInputProcessor(id))
     pipeline_stages.append(routing_llm)
+    if guardrail_enabled:
+        pipeline_stages.append(GuardrailProcessor(id))
     if routing_tts:
         pipeline_stages.append(routing_tts)
     pipeline_stages.append(context_aggregator.assistant())
@@ -699,6 +761,8 @@ async def init_team_pipeline(
         active_llm = routing_llm.active_service
         if active_llm is None:
             raise ValueError(f"No active LLM service for start_member_id={start_member_id}")
+        if isinstance(active_llm, FailoverLLMService):
+            active_llm = active_llm.active_service
 
         flow_manager = FlowManager(
             task=task,
@@ -214,6 +214,7 @@ async def _notify_member_switched(
         "transition_function_name": function_name,
         "from_member": _build_member_info(from_member),
         "to_member": _build_member_info(to_member),
+        "to_engine_key_hash": common.engine_key_hash(to_member.get("ai", {}).get("engine_key", "")),
     }
 
     try:
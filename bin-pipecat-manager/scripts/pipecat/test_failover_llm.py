@@ -0,0 +1,303 @@
+"""Tests for FailoverLLMService.
+
+Covers delegation to the active engine, failover on exceptions and error
+frames, holding back the response frames of abandoned attempts, the unguarded
+last engine, and function registration fan-out.
+"""
+import sys
+import enum
+import pytest
+from unittest.mock import MagicMock, AsyncMock, patch
+
+
+class _FrameDirection(enum.Enum):
+    DOWNSTREAM = "downstream"
+    UPSTREAM = "upstream"
+
+
+class _StubFrameProcessor:
+    """Minimal stub replacing pipecat's FrameProcessor for unit tests."""
+    def __init__(self, **kwargs):
+        pass
+
+    async def setup(self, setup):
+        pass
+
+    async def cleanup(self):
+        pass
+
+    async def process_frame(self, frame, direction):
+        pass
+
+    async def push_frame(self, frame, direction=None):
+        pass
+
+
+class _ErrorFrame:
+    def __init__(self, error, fatal=False):
+        self.error = error
+        self.fatal = fatal
+
+
+_fp_mod = sys.modules["pipecat.processors.frame_processor"]
+_fp_mod.FrameProcessor = _StubFrameProcessor
+_fp_mod.FrameDirection = _FrameDirection
+
+_frames_mod = sys.modules["pipecat.frames.frames"]
+_frames_mod.ErrorFrame = _ErrorFrame
+_frames_mod.LLMContextFrame = type("LLMContextFrame", (), {})
+_frames_mod.LLMFullResponseStartFrame = type("LLMFullResponseStartFrame", (), {})
+_frames_mod.LLMFullResponseEndFrame = type("LLMFullResponseEndFrame", (), {})
+_frames_mod.LLMTextFrame = type("LLMTextFrame", (), {})
+_frames_mod.MetricsFrame = type("MetricsFrame", (), {})
+
+if "failover_llm" in sys.modules:
+    del sys.modules["failover_llm"]
+import failover_llm
+from failover_llm import FailoverLLMService, error_status_code
+
+
+def _make_service():
+    svc = MagicMock()
+    svc.process_frame = AsyncMock()
+    svc.setup = AsyncMock()
+    svc.cleanup = AsyncMock()
+    svc.register_function = MagicMock()
+    svc.unregister_function = MagicMock()
+    return svc
+
+
+def _make_failover(count=2):
+    services = [_make_service() for _ in range(count)]
+    models = ["openai.gpt-5", "gemini.gemini-2.5-flash", "grok.grok-3"][:count]
+    failover = FailoverLLMService("pipecatcall-1", list(zip(models, services)))
+    failover.push_frame = AsyncMock()
+    return failover, services
+
+
+def _responds(svc, *frames):
+    """Make svc push the given frames when it processes a frame."""
+    async def side_effect(frame, direction):
+        for f in frames:
+            await svc.push_frame(f, _FrameDirection.DOWNSTREAM)
+    svc.process_frame.side_effect = side_effect
+
+
+def _fails_with(svc, exc, *frames_before):
+    async def side_effect(frame, direction):
+        for f in frames_before:
+            await svc.push_frame(f, _FrameDirection.DOWNSTREAM)
+        raise exc
+    svc.process_frame.side_effect = side_effect
+
+
+def _pushed(failover):
+    return [c.args[0] for c in failover.push_frame.await_args_list]
+
+
+class TestInit:
+    def test_requires_an_engine(self):
+        with pytest.raises(ValueError):
+            FailoverLLMService("pipecatcall-1", [])
+
+    def test_requires_a_key_hash_per_engine(self):
+        with pytest.raises(ValueError):
+            FailoverLLMService("pipecatcall-1", [("openai.gpt-5", _make_service())], ["hash-1", "hash-2"])
+
+    def test_starts_with_first_engine(self):
+        failover, services = _make_failover()
+        assert failover.active_service is services[0]
+        assert failover.active_engine_model == "openai.gpt-5"
+
+
+class TestProcessFrame:
+    @pytest.mark.asyncio
+    async def test_success_on_primary(self):
+        failover, services = _make_failover()
+        start = _frames_mod.LLMFullResponseStartFrame()
+        text = _frames_mod.LLMTextFrame()
+        end = _frames_mod.LLMFullResponseEndFrame()
+        _responds(services[0], start, text, end)
+
+        with patch.object(failover_llm, "_notify_llm_failover", new=AsyncMock()) as mock_notify:
+            await failover.process_frame(_frames_mod.LLMContextFrame(), _FrameDirection.DOWNSTREAM)
+
+        assert _pushed(failover) == [start, text, end]
+        services[1].process_frame.assert_not_awaited()
+        mock_notify.assert_not_called()
+        assert failover.active_engine_model == "openai.gpt-5"
+
+    @pytest.mark.asyncio
+    async def test_fails_over_on_exception(self):
+        failover, services = _make_failover()
+        _fails_with(services[0], RuntimeError("429 rate limited"),
+                    _frames_mod.LLMFullResponseStartFrame(), _frames_mod.LLMFullResponseEndFrame())
+        text = _frames_mod.LLMTextFrame()
+        _responds(services[1], text)
+        frame = _frames_mod.LLMContextFrame()
+
+        with patch.object(failover_llm, "_notify_llm_failover", new=AsyncMock()) as mock_notify:
+            await failover.process_frame(frame, _FrameDirection.DOWNSTREAM)
+
+        # the abandoned attempt's start/end frames are dropped
+        assert _pushed(failover) == [text]
+        services[1].process_frame.assert_awaited_once_with(frame, _FrameDirection.DOWNSTREAM)
+        assert failover.active_engine_model == "gemini.gemini-2.5-flash"
+        mock_notify.assert_called_once()
+        assert mock_notify.call_args.args[:3] == ("pipecatcall-1", "openai.gpt-5", "gemini.gemini-2.5-flash")
+
+    @pytest.mark.asyncio
+    async def test_reports_key_hashes_and_status_code(self):
+        services = [_make_service(), _make_service()]
+        failover = FailoverLLMService(
+            "pipecatcall-1",
+            [("openai.gpt-5", services[0]), ("openai.gpt-5", services[1])],
+            ["customer-key-hash", ""],
+        )
+        failover.push_frame = AsyncMock()
+        _fails_with(services[0], RuntimeError("Error code: 429 - {'error': 'rate limited'}"))
+        _responds(services[1], _frames_mod.LLMTextFrame())
+
+        with patch.object(failover_llm, "_notify_llm_failover", new=AsyncMock()) as mock_notify:
+            await failover.process_frame(_frames_mod.LLMContextFrame(), _FrameDirection.DOWNSTREAM)
+
+        assert mock_notify.call_args.kwargs == {
+            "from_key_hash": "customer-key-hash",
+            "to_key_hash": "",
+            "status_code": 429,
+        }
+
+    @pytest.mark.asyncio
+    async def test_fails_over_on_error_frame(self):
+        failover, services = _make_failover()
+        _responds(services[0], _frames_mod.ErrorFrame("service unavailable"))
+        text = _frames_mod.LLMTextFrame()
+        _responds(services[1], text)
+
+        with patch.object(failover_llm, "_notify_llm_failover", new=AsyncMock()):
+            await failover.process_frame(_frames_mod.LLMContextFrame(), _FrameDirection.DOWNSTREAM)
+
+        assert _pushed(failover) == [text]
+        assert failover.active_engine_model == "gemini.gemini-2.5-flash"
+
+    @pytest.mark.asyncio
+    async def test_walks_the_whole_chain(self):
+        failover, services = _make_failover(count=3)
+        _fails_with(services[0], RuntimeError("boom"))
+        _fails_with(services[1], RuntimeError("boom"))
+        text = _frames_mod.LLMTextFrame()
+        _responds(services[2], text)
+
+        with patch.object(failover_llm, "_notify_llm_failover", new=AsyncMock()) as mock_notify:
+            await failover.process_frame(_frames_mod.LLMContextFrame(), _FrameDirection.DOWNSTREAM)
+
+        assert _pushed(failover) == [text]
+        assert failover.active_engine_model == "grok.grok-3"
+        assert mock_notify.call_count == 2
+
+    @pytest.mark.asyncio
+    async def test_no_failover_after_output_started(self):
+        failover, services = _make_failover()
+        _fails_with(services[0], RuntimeError("connection reset"), _frames_mod.LLMTextFrame())
+
+        with patch.object(failover_llm, "_notify_llm_failover", new=AsyncMock()) as mock_notify:
+            with pytest.raises(RuntimeError):
+                await failover.process_frame(_frames_mod.LLMContextFrame(), _FrameDirection.DOWNSTREAM)
+
+        services[1].process_frame.assert_not_awaited()
+        mock_notify.assert_not_called()
+        assert failover.active_engine_model == "openai.gpt-5"
+
+    @pytest.mark.asyncio
+    async def test_metrics_do_not_count_as_output(self):
+        failover, services = _make_failover()
+        _fails_with(services[0], RuntimeError("boom"), _frames_mod.MetricsFrame())
+        _responds(services[1], _frames_mod.LLMTextFrame())
+
+        with patch.object(failover_llm, "_notify_llm_failover", new=AsyncMock()):
+            await failover.process_frame(_frames_mod.LLMContextFrame(), _FrameDirection.DOWNSTREAM)
+
+        assert failover.active_engine_model == "gemini.gemini-2.5-flash"
+
+    @pytest.mark.asyncio
+    async def test_last_engine_errors_pass_through(self):
+        failover, services = _make_failover()
+        _fails_with(services[0], RuntimeError("boom"))
+        error = _frames_mod.ErrorFrame("still down")
+        _responds(services[1], error)
+
+        with patch.object(failover_llm, "_notify_llm_failover", new=AsyncMock()):
+            await failover.process_frame(_frames_mod.LLMContextFrame(), _FrameDirection.DOWNSTREAM)
+
+        assert _pushed(failover) == [error]
+
+    @pytest.mark.asyncio
+    async def test_other_frames_route_to_active_service(self):
+        failover, services = _make_failover()
+        frame = MagicMock()
+        await failover.process_frame(frame, _FrameDirection.DOWNSTREAM)
+
+        services[0].process_frame.assert_awaited_once_with(frame, _FrameDirection.DOWNSTREAM)
+        services[1].process_frame.assert_not_awaited()
+
+    @pytest.mark.asyncio
+    async def test_lifecycle_frames_propagate_to_all_services(self):
+        failover, services = _make_failover()
+        frame = _frames_mod.StartFrame()
+        await failover.process_frame(frame, _FrameDirection.DOWNSTREAM)
+
+        for svc in services:
+            svc.process_frame.assert_awaited_once_with(frame, _FrameDirection.DOWNSTREAM)
+
+    @pytest.mark.asyncio
+    async def test_inactive_service_output_is_dropped(self):
+        failover, services = _make_failover()
+        await services[1].push_frame(_frames_mod.StartFrame(), _FrameDirection.DOWNSTREAM)
+
+        failover.push_frame.assert_not_awaited()
+
+
+class TestRegisterFunction:
+    def test_delegates_to_all_services(self):
+        failover, services = _make_failover()
+        handler = MagicMock()
+        failover.register_function("test_fn", handler)
+
+        for svc in services:
+            svc.register_function.assert_called_once_with(
+                "test_fn",
+                handler,
+                cancel_on_interruption=None,
+                timeout_secs=None,
+            )
+
+    def test_rejects_unknown_kwargs(self):
+        failover, _ = _make_failover()
+        with pytest.raises(TypeError, match="start_callback"):
+            failover.register_function("fn", MagicMock(), start_callback=MagicMock())
+
+    def test_unregister_suppresses_errors(self):
+        failover, services = _make_failover()
+        services[0].unregister_function.side_effect = KeyError("nope")
+        failover.unregister_function("fn")
+
+        services[1].unregister_function.assert_called_once_with("fn")
+
+
+class TestErrorStatusCode:
+    @pytest.mark.parametrize("error,expected", [
+        ("Error code: 401 - {'error': 'invalid api key'}", 401),
+        ("429 Too Many Requests", 429),
+        ("status: 503", 503),
+        ("service unavailable", 0),
+        ("Timeout after 300 seconds", 0),
+        (None, 0),
+    ])
+    def test_from_text(self, error, expected):
+        assert error_status_code(error) == expected
+
+    def test_from_exception_attribute(self):
+        class _APIStatusError(Exception):
+            status_code = 403
+
+        assert error_status_code(_APIStatusError("forbidden")) == 403
@@ -0,0 +1,380 @@
+"""Tests for GuardrailInputProcessor and GuardrailProcessor.
+
+Covers checking the user's input before the LLM and failing open, and buffering the LLM output into sentences, replacing and dropping the
+blocked output, resetting on interruption, and failing closed when the check
+request fails.
+"""
+import sys
+import enum
+import pytest
+from unittest.mock import MagicMock, AsyncMock, patch
+
+
+class _FrameDirection(enum.Enum):
+    DOWNSTREAM = "downstream"
+    UPSTREAM = "upstream"
+
+
+class _StubFrameProcessor:
+    """Minimal stub replacing pipecat's FrameProcessor for unit tests."""
+    def __init__(self, **kwargs):
+        pass
+
+    async def process_frame(self, frame, direction):
+        pass
+
+    async def push_frame(self, frame, direction=None):
+        pass
+
+    async def cleanup(self):
+        pass
+
+
+class _LLMTextFrame:
+    def __init__(self, text):
+        self.text = text
+
+
+class _LLMFullResponseStartFrame:
+    pass
+
+
+class _LLMFullResponseEndFrame:
+    pass
+
+
+class _InterruptionFrame:
+    pass
+
+
+class _LLMContextFrame:
+    def __init__(self, messages):
+        self.context = MagicMock()
+        self.context.get_messages = MagicMock(return_value=messages)
+
+
+_fp_mod = sys.modules["pipecat.processors.frame_processor"]
+_fp_mod.FrameProcessor = _StubFrameProcessor
+_fp_mod.FrameDirection = _FrameDirection
+
+_frames_mod = sys.modules["pipecat.frames.frames"]
+_frames_mod.LLMTextFrame = _LLMTextFrame
+_frames_mod.LLMFullResponseStartFrame = _LLMFullResponseStartFrame
+_frames_mod.LLMFullResponseEndFrame = _LLMFullResponseEndFrame
+_frames_mod.InterruptionFrame = _InterruptionFrame
+_frames_mod.LLMContextFrame = _LLMContextFrame
+
+if "guardrail" in sys.modules:
+    del sys.modules["guardrail"]
+import guardrail
+from guardrail import GuardrailInputProcessor, GuardrailProcessor
+
+
+def _make_processor():
+    processor = GuardrailProcessor("pc-1")
+    processor.push_frame = AsyncMock()
+    return processor
+
+
+def _make_input_processor():
+    processor = GuardrailInputProcessor("pc-1")
+    processor.push_frame = AsyncMock()
+    return processor
+
+
+def _pushed_texts(processor):
+    return [
+        c.args[0].text
+        for c in processor.push_frame.await_args_list
+        if isinstance(c.args[0], _LLMTextFrame)
+    ]
+
+
+def _mock_session(status=200, body=None, exc=None):
+    """Return a mock aiohttp.ClientSession answering one POST."""
+    response = MagicMock()
+    response.status = status
+    response.json = AsyncMock(return_value=body or {})
+    response.text = AsyncMock(return_value="error")
+
+    post_ctx = MagicMock()
+    post_ctx.__aenter__ = AsyncMock(return_value=response)
+    post_ctx.__aexit__ = AsyncMock(return_value=False)
+
+    session = MagicMock()
+    if exc:
+        session.post = MagicMock(side_effect=exc)
+    else:
+        session.post = MagicMock(return_value=post_ctx)
+
+    return session
+
+
+async def _echo(session, pipecatcall_id, text):
+    return text, False
+
+
+class TestProcessFrame:
+    @pytest.mark.asyncio
+    async def test_sentences_are_checked_when_complete(self):
+        processor = _make_processor()
+
+        with patch.object(guardrail, "_check", new=AsyncMock(side_effect=_echo)) as mock_check:
+            await processor.process_frame(_LLMTextFrame("Hello"), _FrameDirection.DOWNSTREAM)
+            mock_check.assert_not_awaited()
+
+            await processor.process_frame(_LLMTextFrame(" there. How"), _FrameDirection.DOWNSTREAM)
+            assert mock_check.await_args.args[1:] == ("pc-1", "Hello there. ")
+
+            await processor.process_frame(_LLMTextFrame(" are you?"), _FrameDirection.DOWNSTREAM)
+            await processor.process_frame(_LLMFullResponseEndFrame(), _FrameDirection.DOWNSTREAM)
+
+        assert mock_check.await_count == 2
+        assert mock_check.await_args_list[1].args[1:] == ("pc-1", "How are you?")
+        assert _pushed_texts(processor) == ["Hello there. ", "How are you?"]
+
+    @pytest.mark.asyncio
+    async def test_decimal_is_not_split(self):
+        processor = _make_processor()
+
+        with patch.object(guardrail, "_check", new=AsyncMock(side_effect=_echo)) as mock_check:
+            await processor.process_frame(_LLMTextFrame("It costs 3.5 dollars"), _FrameDirection.DOWNSTREAM)
+
+        mock_check.assert_not_awaited()
+
+    @pytest.mark.asyncio
+    async def test_disclaimer_keeps_the_space(self):
+        processor = _make_processor()
+
+        with patch.object(guardrail, "_check", new=AsyncMock(return_value=("This is an AI assistant. Hello.", False))):
+            await processor.process_frame(_LLMTextFrame("Hello. "), _FrameDirection.DOWNSTREAM)
+
+        assert _pushed_texts(processor) == ["This is an AI assistant. Hello. "]
+
+    @pytest.mark.asyncio
+    async def test_blocked_output_drops_the_rest_of_the_response(self):
+        processor = _make_processor()
+
+        with patch.object(guardrail, "_check", new=AsyncMock(return_value=("Let me connect you to an agent.", True))) as mock_check:
+            await processor.process_frame(_LLMTextFrame("You should buy stocks. "), _FrameDirection.DOWNSTREAM)
+            await processor.process_frame(_LLMTextFrame("They always go up. "), _FrameDirection.DOWNSTREAM)
+            await processor.process_frame(_LLMFullResponseEndFrame(), _FrameDirection.DOWNSTREAM)
+
+        mock_check.assert_awaited_once()
+        assert mock_check.await_args.args[1:] == ("pc-1", "You should buy stocks. ")
+        assert _pushed_texts(processor) == ["Let me connect you to an agent. "]
+
+    @pytest.mark.asyncio
+    async def test_blocked_output_without_message_is_dropped(self):
+        processor = _make_processor()
+
+        with patch.object(guardrail, "_check", new=AsyncMock(return_value=("", True))):
+            await processor.process_frame(_LLMTextFrame("You should buy stocks. "), _FrameDirection.DOWNSTREAM)
+
+        assert _pushed_texts(processor) == []
+
+    @pytest.mark.asyncio
+    async def test_blocked_output_ending_with_the_text_is_blocked(self):
+        processor = _make_processor()
+
+        with patch.object(guardrail, "_check", new=AsyncMock(return_value=("I can't say that. Buy stocks.", True))) as mock_check:
+            await processor.process_frame(_LLMTextFrame("Buy stocks. "), _FrameDirection.DOWNSTREAM)
+            await processor.process_frame(_LLMTextFrame("They always go up. "), _FrameDirection.DOWNSTREAM)
+
+        mock_check.assert_awaited_once()
+        assert _pushed_texts(processor) == ["I can't say that. Buy stocks. "]
+
+    @pytest.mark.asyncio
+    async def test_new_response_is_checked_again(self):
+        processor = _make_processor()
+
+        with patch.object(guardrail, "_check", new=AsyncMock(return_value=("", True))):
+            await processor.process_frame(_LLMTextFrame("You should buy stocks. "), _FrameDirection.DOWNSTREAM)
+            await processor.process_frame(_LLMFullResponseEndFrame(), _FrameDirection.DOWNSTREAM)
+
+        with patch.object(guardrail, "_check", new=AsyncMock(side_effect=_echo)):
+            await processor.process_frame(_LLMFullResponseStartFrame(), _FrameDirection.DOWNSTREAM)
+            await processor.process_frame(_LLMTextFrame("How can I help? "), _FrameDirection.DOWNSTREAM)
+
+        assert _pushed_texts(processor) == ["How can I help? "]
+
+    @pytest.mark.asyncio
+    async def test_interruption_clears_the_buffer(self):
+        processor = _make_processor()
+
+        with patch.object(guardrail, "_check", new=AsyncMock(side_effect=_echo)) as mock_check:
+            await processor.process_frame(_LLMTextFrame("Half a sent"), _FrameDirection.DOWNSTREAM)
+            frame = _InterruptionFrame()
+            await processor.process_frame(frame, _FrameDirection.DOWNSTREAM)
+            await processor.process_frame(_LLMFullResponseEndFrame(), _FrameDirection.DOWNSTREAM)
+
+        mock_check.assert_not_awaited()
+        processor.push_frame.assert_any_await(frame, _FrameDirection.DOWNSTREAM)
+
+    @pytest.mark.asyncio
+    async def test_other_frames_pass_through(self):
+        processor = _make_processor()
+        frame = MagicMock()
+
+        with patch.object(guardrail, "_check", new=AsyncMock()) as mock_check:
+            await processor.process_frame(frame, _FrameDirection.DOWNSTREAM)
+
+        mock_check.assert_not_awaited()
+        processor.push_frame.assert_awaited_once_with(frame, _FrameDirection.DOWNSTREAM)
+
+    @pytest.mark.asyncio
+    async def test_upstream_text_passes_through(self):
+        processor = _make_processor()
+        frame = _LLMTextFrame("Hello. ")
+
+        with patch.object(guardrail, "_check", new=AsyncMock()) as mock_check:
+            await processor.process_frame(frame, _FrameDirection.UPSTREAM)
+
+        mock_check.assert_not_awaited()
+        processor.push_frame.assert_awaited_once_with(frame, _FrameDirection.UPSTREAM)
+
+
+class TestInputProcessFrame:
+    @pytest.mark.asyncio
+    async def test_passed_input_runs_the_llm(self):
+        processor = _make_input_processor()
+        frame = _LLMContextFrame([{"role": "user", "content": "What are your opening hours?"}])
+
+        with patch.object(guardrail, "_check_input", new=AsyncMock(return_value=("", False))) as mock_check:
+            await processor.process_frame(frame, _FrameDirection.DOWNSTREAM)
+
+        assert mock_check.await_args.args[1:] == ("pc-1", "What are your opening hours?")
+        processor.push_frame.assert_awaited_once_with(frame, _FrameDirection.DOWNSTREAM)
+
+    @pytest.mark.asyncio
+    async def test_blocked_input_is_answered_with_the_escalation_message(self):
+        processor = _make_input_processor()
+        frame = _LLMContextFrame([{"role": "user", "content": [{"type": "text", "text": "Should I buy stocks?"}]}])
+
+        with patch.object(guardrail, "_check_input", new=AsyncMock(return_value=("Let me connect you to an agent.", True))) as mock_check:
+            await processor.process_frame(frame, _FrameDirection.DOWNSTREAM)
+
+        assert mock_check.await_args.args[1:] == ("pc-1", "Should I buy stocks?")
+        pushed = [c.args[0] for c in processor.push_frame.await_args_list]
+        assert frame not in pushed
+        assert len(pushed) == 3
+        assert isinstance(pushed[0], _LLMFullResponseStartFrame)
+        assert _pushed_texts(processor) == ["Let me connect you to an agent."]
+        assert isinstance(pushed[2], _LLMFullResponseEndFrame)
+
+    @pytest.mark.asyncio
+    async def test_blocked_input_without_message_is_dropped(self):
+        processor = _make_input_processor()
+        frame = _LLMContextFrame([{"role": "user", "content": "Should I buy stocks?"}])
+
+        with patch.object(guardrail, "_check_input", new=AsyncMock(return_value=("", True))):
+            await processor.process_frame(frame, _FrameDirection.DOWNSTREAM)
+
+        processor.push_frame.assert_not_awaited()
+
+    @pytest.mark.asyncio
+    async def test_function_call_result_is_not_checked(self):
+        processor = _make_input_processor()
+        frame = _LLMContextFrame([
+            {"role": "user", "content": "Should I buy stocks?"},
+            {"role": "tool", "content": "{}"},
+        ])
+
+        with patch.object(guardrail, "_check_input", new=AsyncMock()) as mock_check:
+            await processor.process_frame(frame, _FrameDirection.DOWNSTREAM)
+
+        mock_check.assert_not_awaited()
+        processor.push_frame.assert_awaited_once_with(frame, _FrameDirection.DOWNSTREAM)
+
+    @pytest.mark.asyncio
+    async def test_other_frames_pass_through(self):
+        processor = _make_input_processor()
+        frame = _LLMTextFrame("Hello. ")
+
+        with patch.object(guardrail, "_check_input", new=AsyncMock()) as mock_check:
+            await processor.process_frame(frame, _FrameDirection.DOWNSTREAM)
+
+        mock_check.assert_not_awaited()
+        processor.push_frame.assert_awaited_once_with(frame, _FrameDirection.DOWNSTREAM)
+
+
+class TestCheckInput:
+    @pytest.mark.asyncio
+    async def test_returns_checked_input(self):
+        session = _mock_session(body={"text": "Let me connect you to an agent.", "blocked": True})
+
+        res = await guardrail._check_input(session, "pc-1", "Should I buy stocks?")
+
+        assert res == ("Let me connect you to an agent.", True)
+        session.post.assert_called_once_with("http://localhost/pc-1/guardrail/input", json={"text": "Should I buy stocks?"})
+
+    @pytest.mark.asyncio
+    async def test_http_error_fails_open(self):
+        session = _mock_session(status=400)
+
+        res = await guardrail._check_input(session, "pc-1", "Should I buy stocks?")
+
+        assert res == ("", False)
+
+    @pytest.mark.asyncio
+    async def test_exception_fails_open(self):
+        session = _mock_session(exc=Exception("connection refused"))
+
+        res = await guardrail._check_input(session, "pc-1", "Should I buy stocks?")
+
+        assert res == ("", False)
+
+
+class TestCheck:
+    @pytest.mark.asyncio
+    async def test_returns_checked_text(self):
+        session = _mock_session(body={"text": "Let me connect you to an agent.", "blocked": True})
+
+        res = await guardrail._check(session, "pc-1", "You should buy stocks.")
+
+        assert res == ("Let me connect you to an agent.", True)
+        session.post.assert_called_once_with("http://localhost/pc-1/guardrail", json={"text": "You should buy stocks."})
+
+    @pytest.mark.asyncio
+    async def test_passed_text(self):
+        session = _mock_session(body={"text": "How can I help?", "blocked": False})
+
+        res = await guardrail._check(session, "pc-1", "How can I help?")
+
+        assert res == ("How can I help?", False)
+
+    @pytest.mark.asyncio
+    async def test_http_error_fails_closed(self):
+        session = _mock_session(status=400)
+
+        res = await guardrail._check(session, "pc-1", "You should buy stocks.")
+
+        assert res == ("", True)
+
+    @pytest.mark.asyncio
+    async def test_exception_fails_closed(self):
+        session = _mock_session(exc=Exception("connection refused"))
+
+        res = await guardrail._check(session, "pc-1", "You should buy stocks.")
+
+        assert res == ("", True)
+
+
+class TestSession:
+    @pytest.mark.asyncio
+    async def test_session_is_reused_and_closed(self):
+        processor = _make_processor()
+        session = MagicMock()
+        session.closed = False
+        session.close = AsyncMock()
+
+        with patch.object(guardrail.aiohttp, "ClientSession", new=MagicMock(return_value=session)) as session_cls:
+            with patch.object(guardrail, "_check", new=AsyncMock(side_effect=_echo)) as mock_check:
+                await processor.process_frame(_LLMTextFrame("Hello. "), _FrameDirection.DOWNSTREAM)
+                await processor.process_frame(_LLMTextFrame("How are you? "), _FrameDirection.DOWNSTREAM)
+
+        session_cls.assert_called_once()
+        assert [c.args[0] for c in mock_check.await_args_list] == [session, session]
+
+        await processor.cleanup()
+        session.close.assert_awaited_once()
@@ -0,0 +1,140 @@
+"""Tests for RedactionProcessor.
+
+Covers rewriting the final transcriptions, passing the other frames through
+untouched, and failing open when the redaction request fails.
+"""
+import sys
+import enum
+import pytest
+from unittest.mock import MagicMock, AsyncMock, patch
+
+
+class _FrameDirection(enum.Enum):
+    DOWNSTREAM = "downstream"
+    UPSTREAM = "upstream"
+
+
+class _StubFrameProcessor:
+    """Minimal stub replacing pipecat's FrameProcessor for unit tests."""
+    def __init__(self, **kwargs):
+        pass
+
+    async def process_frame(self, frame, direction):
+        pass
+
+    async def push_frame(self, frame, direction=None):
+        pass
+
+
+class _TranscriptionFrame:
+    def __init__(self, text):
+        self.text = text
+
+
+_fp_mod = sys.modules["pipecat.processors.frame_processor"]
+_fp_mod.FrameProcessor = _StubFrameProcessor
+_fp_mod.FrameDirection = _FrameDirection
+
+_frames_mod = sys.modules["pipecat.frames.frames"]
+_frames_mod.TranscriptionFrame = _TranscriptionFrame
+
+if "redaction" in sys.modules:
+    del sys.modules["redaction"]
+import redaction
+from redaction import RedactionProcessor
+
+
+def _make_processor():
+    processor = RedactionProcessor("pc-1")
+    processor.push_frame = AsyncMock()
+    return processor
+
+
+def _mock_session(status=200, body=None, exc=None):
+    """Return a mock aiohttp.ClientSession class answering one POST."""
+    response = MagicMock()
+    response.status = status
+    response.json = AsyncMock(return_value=body or {})
+    response.text = AsyncMock(return_value="error")
+
+    post_ctx = MagicMock()
+    post_ctx.__aenter__ = AsyncMock(return_value=response)
+    post_ctx.__aexit__ = AsyncMock(return_value=False)
+
+    session = MagicMock()
+    if exc:
+        session.post = MagicMock(side_effect=exc)
+    else:
+        session.post = MagicMock(return_value=post_ctx)
+
+    session_ctx = MagicMock()
+    session_ctx.__aenter__ = AsyncMock(return_value=session)
+    session_ctx.__aexit__ = AsyncMock(return_value=False)
+
+    return MagicMock(return_value=session_ctx), session
+
+
+class TestProcessFrame:
+    @pytest.mark.asyncio
+    async def test_transcription_is_redacted(self):
+        processor = _make_processor()
+        frame = _TranscriptionFrame("my card is 4111 1111 1111 1111")
+
+        with patch.object(redaction, "_redact", new=AsyncMock(return_value="my card is [CREDIT_CARD]")) as mock_redact:
+            await processor.process_frame(frame, _FrameDirection.DOWNSTREAM)
+
+        mock_redact.assert_awaited_once_with("pc-1", "my card is 4111 1111 1111 1111")
+        assert frame.text == "my card is [CREDIT_CARD]"
+        processor.push_frame.assert_awaited_once_with(frame, _FrameDirection.DOWNSTREAM)
+
+    @pytest.mark.asyncio
+    async def test_other_frames_pass_through(self):
+        processor = _make_processor()
+        frame = MagicMock()
+
+        with patch.object(redaction, "_redact", new=AsyncMock()) as mock_redact:
+            await processor.process_frame(frame, _FrameDirection.DOWNSTREAM)
+
+        mock_redact.assert_not_awaited()
+        processor.push_frame.assert_awaited_once_with(frame, _FrameDirection.DOWNSTREAM)
+
+    @pytest.mark.asyncio
+    async def test_empty_transcription_is_not_sent(self):
+        processor = _make_processor()
+        frame = _TranscriptionFrame("")
+
+        with patch.object(redaction, "_redact", new=AsyncMock()) as mock_redact:
+            await processor.process_frame(frame, _FrameDirection.DOWNSTREAM)
+
+        mock_redact.assert_not_awaited()
+        processor.push_frame.assert_awaited_once_with(frame, _FrameDirection.DOWNSTREAM)
+
+
+class TestRedact:
+    @pytest.mark.asyncio
+    async def test_returns_redacted_text(self):
+        session_cls, session = _mock_session(body={"text": "call [PHONE_NUMBER]"})
+
+        with patch.object(redaction.aiohttp, "ClientSession", new=session_cls):
+            res = await redaction._redact("pc-1", "call +821012345678")
+
+        assert res == "call [PHONE_NUMBER]"
+        session.post.assert_called_once_with("http://localhost/pc-1/redact", json={"text": "call +821012345678"})
+
+    @pytest.mark.asyncio
+    async def test_http_error_fails_open(self):
+        session_cls, _ = _mock_session(status=400)
+
+        with patch.object(redaction.aiohttp, "ClientSession", new=session_cls):
+            res = await redaction._redact("pc-1", "call +821012345678")
+
+        assert res == "call +821012345678"
+
+    @pytest.mark.asyncio
+    async def test_exception_fails_open(self):
+        session_cls, _ = _mock_session(exc=Exception("connection refused"))
+
+        with patch.object(redaction.aiohttp, "ClientSession", new=session_cls):
+            res = await redaction._redact("pc-1", "call +821012345678")
+
+        assert res == "call +821012345678"
@@ -0,0 +1,286 @@
+"""Tests for the response cache processors.
+
+Covers answering from the cache on a hit, storing the LLM's answer after a
+miss, skipping function calls and interruptions, and failing open when the
+lookup request fails.
+"""
+import sys
+import enum
+import pytest
+from unittest.mock import MagicMock, AsyncMock, patch
+
+
+class _FrameDirection(enum.Enum):
+    DOWNSTREAM = "downstream"
+    UPSTREAM = "upstream"
+
+
+class _StubFrameProcessor:
+    """Minimal stub replacing pipecat's FrameProcessor for unit tests."""
+    def __init__(self, **kwargs):
+        pass
+
+    async def process_frame(self, frame, direction):
+        pass
+
+    async def push_frame(self, frame, direction=None):
+        pass
+
+
+class _LLMTextFrame:
+    def __init__(self, text):
+        self.text = text
+
+
+class _LLMFullResponseStartFrame:
+    pass
+
+
+class _LLMFullResponseEndFrame:
+    pass
+
+
+class _InterruptionFrame:
+    pass
+
+
+class _FunctionCallsStartedFrame:
+    pass
+
+
+class _LLMContextFrame:
+    def __init__(self, messages):
+        self.context = MagicMock()
+        self.context.get_messages = MagicMock(return_value=messages)
+
+
+_fp_mod = sys.modules["pipecat.processors.frame_processor"]
+_fp_mod.FrameProcessor = _StubFrameProcessor
+_fp_mod.FrameDirection = _FrameDirection
+
+_frames_mod = sys.modules["pipecat.frames.frames"]
+_frames_mod.LLMTextFrame = _LLMTextFrame
+_frames_mod.LLMFullResponseStartFrame = _LLMFullResponseStartFrame
+_frames_mod.LLMFullResponseEndFrame = _LLMFullResponseEndFrame
+_frames_mod.InterruptionFrame = _InterruptionFrame
+_frames_mod.FunctionCallsStartedFrame = _FunctionCallsStartedFrame
+_frames_mod.LLMContextFrame = _LLMContextFrame
+
+if "responsecache" in sys.modules:
+    del sys.modules["responsecache"]
+import responsecache
+from responsecache import ResponseCache
+
+
+def _make_processors():
+    cache = ResponseCache("pc-1")
+    lookup = cache.lookup_processor()
+    lookup.push_frame = AsyncMock()
+    store = cache.store_processor()
+    store.push_frame = AsyncMock()
+    return cache, lookup, store
+
+
+def _pushed(processor):
+    return [c.args[0] for c in processor.push_frame.await_args_list]
+
+
+def _mock_session(status=200, body=None, exc=None):
+    """Return a mock aiohttp.ClientSession class answering one POST."""
+    response = MagicMock()
+    response.status = status
+    response.json = AsyncMock(return_value=body or {})
+    response.text = AsyncMock(return_value="error")
+
+    post_ctx = MagicMock()
+    post_ctx.__aenter__ = AsyncMock(return_value=response)
+    post_ctx.__aexit__ = AsyncMock(return_value=False)
+
+    session = MagicMock()
+    if exc:
+        session.post = MagicMock(side_effect=exc)
+    else:
+        session.post = MagicMock(return_value=post_ctx)
+
+    session_ctx = MagicMock()
+    session_ctx.__aenter__ = AsyncMock(return_value=session)
+    session_ctx.__aexit__ = AsyncMock(return_value=False)
+
+    return MagicMock(return_value=session_ctx), session
+
+
+_MESSAGES = [
+    {"role": "system", "content": "You are a helpful assistant."},
+    {"role": "user", "content": "Hi."},
+    {"role": "assistant", "content": "Hello, how can I help?"},
+    {"role": "user", "content": "What are your opening hours?"},
+]
+
+
+class TestLookupProcessor:
+    @pytest.mark.asyncio
+    async def test_hit_answers_from_the_cache(self):
+        cache, lookup, _ = _make_processors()
+        frame = _LLMContextFrame(_MESSAGES)
+
+        with patch.object(responsecache, "_lookup", new=AsyncMock(return_value="We are open from 9 to 6.")) as mock_lookup:
+            await lookup.process_frame(frame, _FrameDirection.DOWNSTREAM)
+
+        mock_lookup.assert_awaited_once_with("pc-1", ["Hi.", "What are your opening hours?"])
+        pushed = _pushed(lookup)
+        assert frame not in pushed
+        assert isinstance(pushed[0], _LLMFullResponseStartFrame)
+        assert pushed[1].text == "We are open from 9 to 6."
+        assert isinstance(pushed[2], _LLMFullResponseEndFrame)
+        assert cache.pending_user_turns is None
+
+    @pytest.mark.asyncio
+    async def test_miss_runs_the_llm(self):
+        cache, lookup, _ = _make_processors()
+        frame = _LLMContextFrame(_MESSAGES)
+
+        with patch.object(responsecache, "_lookup", new=AsyncMock(return_value="")):
+            await lookup.process_frame(frame, _FrameDirection.DOWNSTREAM)
+
+        lookup.push_frame.assert_awaited_once_with(frame, _FrameDirection.DOWNSTREAM)
+        assert cache.pending_user_turns == ["Hi.", "What are your opening hours?"]
+
+    @pytest.mark.asyncio
+    async def test_function_result_is_not_looked_up(self):
+        cache, lookup, _ = _make_processors()
+        cache.pending_user_turns = ["What are your opening hours?"]
+        frame = _LLMContextFrame(_MESSAGES + [{"role": "tool", "content": "{}"}])
+
+        with patch.object(responsecache, "_lookup", new=AsyncMock()) as mock_lookup:
+            await lookup.process_frame(frame, _FrameDirection.DOWNSTREAM)
+
+        mock_lookup.assert_not_awaited()
+        lookup.push_frame.assert_awaited_once_with(frame, _FrameDirection.DOWNSTREAM)
+        assert cache.pending_user_turns is None
+
+    @pytest.mark.asyncio
+    async def test_other_frames_pass_through(self):
+        _, lookup, _ = _make_processors()
+        frame = MagicMock()
+
+        with patch.object(responsecache, "_lookup", new=AsyncMock()) as mock_lookup:
+            await lookup.process_frame(frame, _FrameDirection.DOWNSTREAM)
+
+        mock_lookup.assert_not_awaited()
+        lookup.push_frame.assert_awaited_once_with(frame, _FrameDirection.DOWNSTREAM)
+
+
+class TestStoreProcessor:
+    @pytest.mark.asyncio
+    async def test_answer_after_miss_is_stored(self):
+        cache, _, store = _make_processors()
+        cache.pending_user_turns = ["What are your opening hours?"]
+
+        with patch.object(responsecache, "_store", new=AsyncMock()) as mock_store:
+            await store.process_frame(_LLMFullResponseStartFrame(), _FrameDirection.DOWNSTREAM)
+            await store.process_frame(_LLMTextFrame("We are open "), _FrameDirection.DOWNSTREAM)
+            await store.process_frame(_LLMTextFrame("from 9 to 6."), _FrameDirection.DOWNSTREAM)
+            await store.process_frame(_LLMFullResponseEndFrame(), _FrameDirection.DOWNSTREAM)
+
+        mock_store.assert_awaited_once_with("pc-1", ["What are your opening hours?"], "We are open from 9 to 6.")
+        assert len(_pushed(store)) == 4
+        assert cache.pending_user_turns is None
+
+    @pytest.mark.asyncio
+    async def test_cached_answer_is_not_stored(self):
+        _, _, store = _make_processors()
+
+        with patch.object(responsecache, "_store", new=AsyncMock()) as mock_store:
+            await store.process_frame(_LLMFullResponseStartFrame(), _FrameDirection.DOWNSTREAM)
+            await store.process_frame(_LLMTextFrame("We are open from 9 to 6."), _FrameDirection.DOWNSTREAM)
+            await store.process_frame(_LLMFullResponseEndFrame(), _FrameDirection.DOWNSTREAM)
+
+        mock_store.assert_not_awaited()
+
+    @pytest.mark.asyncio
+    async def test_function_call_is_not_stored(self):
+        cache, _, store = _make_processors()
+        cache.pending_user_turns = ["Book a table for two."]
+
+        with patch.object(responsecache, "_store", new=AsyncMock()) as mock_store:
+            await store.process_frame(_LLMFullResponseStartFrame(), _FrameDirection.DOWNSTREAM)
+            await store.process_frame(_FunctionCallsStartedFrame(), _FrameDirection.DOWNSTREAM)
+            await store.process_frame(_LLMTextFrame("Let me check."), _FrameDirection.DOWNSTREAM)
+            await store.process_frame(_LLMFullResponseEndFrame(), _FrameDirection.DOWNSTREAM)
+
+        mock_store.assert_not_awaited()
+
+    @pytest.mark.asyncio
+    async def test_interrupted_answer_is_not_stored(self):
+        cache, _, store = _make_processors()
+        cache.pending_user_turns = ["What are your opening hours?"]
+
+        with patch.object(responsecache, "_store", new=AsyncMock()) as mock_store:
+            await store.process_frame(_LLMFullResponseStartFrame(), _FrameDirection.DOWNSTREAM)
+            await store.process_frame(_LLMTextFrame("We are open "), _FrameDirection.DOWNSTREAM)
+            await store.process_frame(_InterruptionFrame(), _FrameDirection.DOWNSTREAM)
+            await store.process_frame(_LLMFullResponseEndFrame(), _FrameDirection.DOWNSTREAM)
+
+        mock_store.assert_not_awaited()
+
+
+class TestUserTurns:
+    def test_text_parts_are_joined(self):
+        messages = [{"role": "user", "content": [{"type": "text", "text": "Opening"}, {"type": "text", "text": "hours?"}]}]
+
+        assert responsecache._user_turns(messages) == ["Opening hours?"]
+
+    def test_latest_turns_only(self):
+        messages = [{"role": "user", "content": f"turn {i}"} for i in range(8)]
+
+        assert responsecache._user_turns(messages) == ["turn 3", "turn 4", "turn 5", "turn 6", "turn 7"]
+
+    def test_last_message_not_user(self):
+        assert responsecache._user_turns(_MESSAGES[:3]) == []
+
+
+class TestLookup:
+    @pytest.mark.asyncio
+    async def test_returns_cached_text(self):
+        session_cls, session = _mock_session(body={"text": "We are open from 9 to 6."})
+
+        with patch.object(responsecache.aiohttp, "ClientSession", new=session_cls):
+            res = await responsecache._lookup("pc-1", ["What are your opening hours?"])
+
+        assert res == "We are open from 9 to 6."
+        session.post.assert_called_once_with(
+            "http://localhost/pc-1/response-cache/lookup",
+            json={"user_turns": ["What are your opening hours?"]},
+        )
+
+    @pytest.mark.asyncio
+    async def test_http_error_fails_open(self):
+        session_cls, _ = _mock_session(status=400)
+
+        with patch.object(responsecache.aiohttp, "ClientSession", new=session_cls):
+            res = await responsecache._lookup("pc-1", ["What are your opening hours?"])
+
+        assert res == ""
+
+    @pytest.mark.asyncio
+    async def test_exception_fails_open(self):
+        session_cls, _ = _mock_session(exc=Exception("connection refused"))
+
+        with patch.object(responsecache.aiohttp, "ClientSession", new=session_cls):
+            res = await responsecache._lookup("pc-1", ["What are your opening hours?"])
+
+        assert res == ""
+
+
+class TestStore:
+    @pytest.mark.asyncio
+    async def test_posts_the_answer(self):
+        session_cls, session = _mock_session()
+
+        with patch.object(responsecache.aiohttp, "ClientSession", new=session_cls):
+            await responsecache._store("pc-1", ["What are your opening hours?"], "We are open from 9 to 6.")
+
+        session.post.assert_called_once_with(
+            "http://localhost/pc-1/response-cache/store",
+            json={"user_turns": ["What are your opening hours?"], "response": "We are open from 9 to 6."},
+        )
@@ -61,7 +61,7 @@ func initCache() (cachehandler.CacheHandler, error) {
 
 func initQueueHandler(sqlDB *sql.DB, cache cachehandler.CacheHandler) (queuehandler.QueueHandler, error) {
 	db := dbhandler.NewHandler(sqlDB, cache)
-	sockHandler := sockhandler.NewSockHandler(sock.TypeRabbitMQ, config.Get().RabbitMQAddress)
+	sockHandler := sockhandler.NewSockHandler(sock.Type(config.Get().SockType), config.Get().RabbitMQAddress)
 	sockHandler.Connect()
 
 	reqHandler := requesthandler.NewRequestHandler(sockHandler, serviceName)
@@ -506,7 +506,7 @@ func initQueuecallHandler() (queuecallhandler.QueuecallHandler, error) {
 	}
 
 	dbHandler := dbhandler.NewHandler(db, cache)
-	sockHandler := sockhandler.NewSockHandler(sock.TypeRabbitMQ, config.Get().RabbitMQAddress)
+	sockHandler := sockhandler.NewSockHandler(sock.Type(config.Get().SockType), config.Get().RabbitMQAddress)
 	sockHandler.Connect()
 
 	reqHandler := requesthandler.NewRequestHandler(sockHandler, serviceName)
@@ -0,0 +1,12 @@
+package embedding
+
+// Request represents an embedding request
+type Request struct {
+	Texts []string `json:"texts"`
+}
+
+// Response represents an embedding response.
+// Embeddings are in the same order as the request's texts.
+type Response struct {
+	Embeddings [][]float32 `json:"embeddings"`
+}
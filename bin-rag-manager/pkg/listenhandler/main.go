@@ -14,6 +14,7 @@ import (
 	cerrors "monorepo/bin-common-handler/models/errors"
 	"monorepo/bin-common-handler/models/sock"
 	"monorepo/bin-common-handler/pkg/sockhandler"
+	"monorepo/bin-common-handler/pkg/tracehandler"
 
 	"monorepo/bin-rag-manager/pkg/dbhandler"
 	"monorepo/bin-rag-manager/pkg/raghandler"
@@ -38,6 +39,9 @@ var (
 
 	// query route
 	regV1Query = regexp.MustCompile(`^/v1/query$`)
+
+	// embeddings
+	regV1Embeddings = regexp.MustCompile(`^/v1/embeddings$`)
 )
 
 // ListenHandler interface for rag-manager listen handler
@@ -97,7 +101,8 @@ func (h *listenHandler) processRequest(m *sock.Request) (*sock.Response, error)
 	})
 	log.Debugf("Received request. method: %s, uri: %s", m.Method, m.URI)
 
-	ctx := context.Background()
+	ctx, span := tracehandler.StartServerSpan(context.Background(), m)
+	defer span.End()
 	start := time.Now()
 	var requestType string
 	var response *sock.Response
@@ -139,6 +144,11 @@ func (h *listenHandler) processRequest(m *sock.Request) (*sock.Response, error)
 		response, err = h.processV1QueryPost(ctx, m)
 		requestType = "/v1/query"
 
+	// embedding route
+	case regV1Embeddings.MatchString(m.URI) && m.Method == sock.RequestMethodPost:
+		response, err = h.processV1EmbeddingsPost(ctx, m)
+		requestType = "/v1/embeddings"
+
 	default:
 		log.Errorf("Could not find the handler. method: %s, uri: %s", m.Method, m.URI)
 		response = simpleResponse(404)
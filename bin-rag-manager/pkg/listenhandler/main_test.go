@@ -13,6 +13,7 @@ import (
 	"github.com/gofrs/uuid"
 
 	"monorepo/bin-rag-manager/models/document"
+	"monorepo/bin-rag-manager/models/embedding"
 	"monorepo/bin-rag-manager/models/query"
 	"monorepo/bin-rag-manager/models/rag"
 
@@ -118,6 +119,9 @@ func (m *mockRagHandlerForListen) DocumentList(_ context.Context, _ uint64, _ st
 func (m *mockRagHandlerForListen) QueryRag(_ context.Context, _ uuid.UUID, _ string, _ int) (*query.Response, error) {
 	return nil, fmt.Errorf("not implemented")
 }
+func (m *mockRagHandlerForListen) EmbeddingCreate(_ context.Context, _ []string) (*embedding.Response, error) {
+	return nil, fmt.Errorf("not implemented")
+}
 func (m *mockRagHandlerForListen) DocumentIngestPendingAll(_ context.Context) {}
 func (m *mockRagHandlerForListen) RunIngestionTicker(_ context.Context, _ time.Duration) {}
 
@@ -0,0 +1,36 @@
+package listenhandler
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/sirupsen/logrus"
+
+	"monorepo/bin-common-handler/models/sock"
+	"monorepo/bin-rag-manager/models/embedding"
+)
+
+func (h *listenHandler) processV1EmbeddingsPost(ctx context.Context, m *sock.Request) (*sock.Response, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func": "processV1EmbeddingsPost",
+	})
+
+	var req embedding.Request
+	if err := json.Unmarshal(m.Data, &req); err != nil {
+		log.Errorf("Could not unmarshal request. err: %v", err)
+		return simpleResponse(400), nil
+	}
+
+	if len(req.Texts) == 0 {
+		log.Errorf("Missing texts in embedding request.")
+		return simpleResponse(400), nil
+	}
+
+	res, err := h.ragHandler.EmbeddingCreate(ctx, req.Texts)
+	if err != nil {
+		log.Errorf("Could not create embeddings. err: %v", err)
+		return errorResponse(err), nil
+	}
+
+	return jsonResponse(200, res), nil
+}
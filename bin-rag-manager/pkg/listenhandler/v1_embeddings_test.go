@@ -0,0 +1,109 @@
+package listenhandler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	cerrors "monorepo/bin-common-handler/models/errors"
+	commonoutline "monorepo/bin-common-handler/models/outline"
+	"monorepo/bin-common-handler/models/sock"
+	"monorepo/bin-rag-manager/models/embedding"
+	"monorepo/bin-rag-manager/pkg/raghandler"
+)
+
+func Test_processV1EmbeddingsPost(t *testing.T) {
+	tests := []struct {
+		name string
+
+		request *sock.Request
+
+		expectTexts []string
+		responseRes *embedding.Response
+		responseErr error
+
+		expectStatus int
+		expectRes    *embedding.Response
+	}{
+		{
+			name: "normal",
+			request: &sock.Request{
+				URI:    "/v1/embeddings",
+				Method: sock.RequestMethodPost,
+				Data:   []byte(`{"texts":["what are your opening hours"]}`),
+			},
+
+			expectTexts: []string{"what are your opening hours"},
+			responseRes: &embedding.Response{
+				Embeddings: [][]float32{{0.1, 0.2}},
+			},
+
+			expectStatus: http.StatusOK,
+			expectRes: &embedding.Response{
+				Embeddings: [][]float32{{0.1, 0.2}},
+			},
+		},
+		{
+			name: "invalid argument",
+			request: &sock.Request{
+				URI:    "/v1/embeddings",
+				Method: sock.RequestMethodPost,
+				Data:   []byte(`{"texts":[""]}`),
+			},
+
+			expectTexts: []string{""},
+			responseErr: cerrors.InvalidArgument(commonoutline.ServiceNameRagManager, "EMBEDDING_TEXT_EMPTY", "Empty text to embed."),
+
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "missing texts",
+			request: &sock.Request{
+				URI:    "/v1/embeddings",
+				Method: sock.RequestMethodPost,
+				Data:   []byte(`{}`),
+			},
+
+			expectStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockRag := raghandler.NewMockRagHandler(mc)
+			h := newTestHandler(t, mockRag)
+
+			if tt.expectTexts != nil {
+				mockRag.EXPECT().EmbeddingCreate(gomock.Any(), tt.expectTexts).Return(tt.responseRes, tt.responseErr)
+			}
+
+			res, err := h.processV1EmbeddingsPost(context.Background(), tt.request)
+			if err != nil {
+				t.Fatalf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if res.StatusCode != tt.expectStatus {
+				t.Fatalf("Wrong match. expect status: %d, got: %d", tt.expectStatus, res.StatusCode)
+			}
+
+			if tt.expectRes == nil {
+				return
+			}
+
+			var got embedding.Response
+			if errUnmarshal := json.Unmarshal(res.Data, &got); errUnmarshal != nil {
+				t.Fatalf("Could not unmarshal the response. err: %v", errUnmarshal)
+			}
+			if !reflect.DeepEqual(&got, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, &got)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package raghandler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	cerrors "monorepo/bin-common-handler/models/errors"
+	commonoutline "monorepo/bin-common-handler/models/outline"
+	"monorepo/bin-rag-manager/models/embedding"
+)
+
+// maxEmbeddingTexts limits how many texts can be embedded in a single request.
+const maxEmbeddingTexts = 16
+
+// EmbeddingCreate embeds the given texts with the rag's embedder.
+// The texts are embedded as queries, so the embeddings of two similar
+// questions can be compared with each other.
+func (h *ragHandler) EmbeddingCreate(ctx context.Context, texts []string) (*embedding.Response, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":  "EmbeddingCreate",
+		"count": len(texts),
+	})
+
+	if len(texts) == 0 {
+		return nil, cerrors.InvalidArgument(commonoutline.ServiceNameRagManager, "EMBEDDING_TEXTS_EMPTY", "No texts to embed.")
+	}
+	if len(texts) > maxEmbeddingTexts {
+		return nil, cerrors.InvalidArgument(
+			commonoutline.ServiceNameRagManager,
+			"EMBEDDING_TEXTS_TOO_MANY",
+			fmt.Sprintf("Too many texts to embed. Max: %d.", maxEmbeddingTexts),
+		)
+	}
+
+	res := &embedding.Response{
+		Embeddings: make([][]float32, 0, len(texts)),
+	}
+	for _, text := range texts {
+		if strings.TrimSpace(text) == "" {
+			return nil, cerrors.InvalidArgument(commonoutline.ServiceNameRagManager, "EMBEDDING_TEXT_EMPTY", "Empty text to embed.")
+		}
+
+		tmp, err := h.embedder.EmbedText(ctx, text)
+		if err != nil {
+			log.Errorf("Could not embed text. err: %v", err)
+			return nil, fmt.Errorf("could not embed text: %w", err)
+		}
+		res.Embeddings = append(res.Embeddings, tmp)
+	}
+	log.Debugf("Embedded texts. count: %d", len(res.Embeddings))
+
+	return res, nil
+}
@@ -0,0 +1,84 @@
+package raghandler
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"monorepo/bin-rag-manager/models/embedding"
+)
+
+// fakeEmbedder returns the length of the text as the embedding.
+type fakeEmbedder struct {
+	err error
+}
+
+func (e *fakeEmbedder) EmbedTexts(ctx context.Context, texts []string) ([][]float32, error) {
+	return nil, fmt.Errorf("not expected")
+}
+
+func (e *fakeEmbedder) EmbedText(ctx context.Context, text string) ([]float32, error) {
+	if e.err != nil {
+		return nil, e.err
+	}
+	return []float32{float32(len(text))}, nil
+}
+
+func Test_EmbeddingCreate(t *testing.T) {
+	tests := []struct {
+		name string
+
+		texts    []string
+		embedErr error
+
+		expectRes   *embedding.Response
+		expectError bool
+	}{
+		{
+			name:  "normal",
+			texts: []string{"hi", "what are your opening hours"},
+			expectRes: &embedding.Response{
+				Embeddings: [][]float32{{2}, {27}},
+			},
+		},
+		{
+			name:        "no texts",
+			texts:       []string{},
+			expectError: true,
+		},
+		{
+			name:        "too many texts",
+			texts:       make([]string, maxEmbeddingTexts+1),
+			expectError: true,
+		},
+		{
+			name:        "empty text",
+			texts:       []string{"hi", "  "},
+			expectError: true,
+		},
+		{
+			name:        "embedder error",
+			texts:       []string{"hi"},
+			embedErr:    fmt.Errorf("unavailable"),
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &ragHandler{
+				embedder: &fakeEmbedder{err: tt.embedErr},
+			}
+
+			res, err := h.EmbeddingCreate(context.Background(), tt.texts)
+			if (err != nil) != tt.expectError {
+				t.Fatalf("Wrong match. expect error: %v, got: %v", tt.expectError, err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 	"monorepo/bin-common-handler/pkg/requesthandler"
 	"monorepo/bin-rag-manager/models/document"
+	"monorepo/bin-rag-manager/models/embedding"
 	"monorepo/bin-rag-manager/models/query"
 	"monorepo/bin-rag-manager/models/rag"
 	"monorepo/bin-rag-manager/pkg/bucketreader"
@@ -31,6 +32,7 @@ type RagHandler interface {
 	DocumentList(ctx context.Context, size uint64, token string, filters map[document.Field]any) ([]*document.Document, error)
 
 	QueryRag(ctx context.Context, ragID uuid.UUID, queryText string, topK int) (*query.Response, error)
+	EmbeddingCreate(ctx context.Context, texts []string) (*embedding.Response, error)
 
 	DocumentIngestPendingAll(ctx context.Context)
 	RunIngestionTicker(ctx context.Context, interval time.Duration)
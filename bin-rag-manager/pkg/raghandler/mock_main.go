@@ -12,6 +12,7 @@ package raghandler
 import (
 	context "context"
 	document "monorepo/bin-rag-manager/models/document"
+	embedding "monorepo/bin-rag-manager/models/embedding"
 	query "monorepo/bin-rag-manager/models/query"
 	rag "monorepo/bin-rag-manager/models/rag"
 	reflect "reflect"
@@ -87,6 +88,21 @@ func (mr *MockRagHandlerMockRecorder) DocumentList(ctx, size, token, filters any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DocumentList", reflect.TypeOf((*MockRagHandler)(nil).DocumentList), ctx, size, token, filters)
 }
 
+// EmbeddingCreate mocks base method.
+func (m *MockRagHandler) EmbeddingCreate(ctx context.Context, texts []string) (*embedding.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EmbeddingCreate", ctx, texts)
+	ret0, _ := ret[0].(*embedding.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EmbeddingCreate indicates an expected call of EmbeddingCreate.
+func (mr *MockRagHandlerMockRecorder) EmbeddingCreate(ctx, texts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EmbeddingCreate", reflect.TypeOf((*MockRagHandler)(nil).EmbeddingCreate), ctx, texts)
+}
+
 // QueryRag mocks base method.
 func (m *MockRagHandler) QueryRag(ctx context.Context, ragID uuid.UUID, queryText string, topK int) (*query.Response, error) {
 	m.ctrl.T.Helper()
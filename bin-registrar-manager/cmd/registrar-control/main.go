@@ -97,7 +97,7 @@ func initExtensionHandler() (extensionhandler.ExtensionHandler, error) {
 	dbAst := dbhandler.NewHandler(sqlAsterisk, cache)
 	dbBin := dbhandler.NewHandler(sqlBin, cache)
 
-	sockHandler := sockhandler.NewSockHandler(sock.TypeRabbitMQ, config.Get().RabbitMQAddress)
+	sockHandler := sockhandler.NewSockHandler(sock.Type(config.Get().SockType), config.Get().RabbitMQAddress)
 	sockHandler.Connect()
 
 	reqHandler := requesthandler.NewRequestHandler(sockHandler, serviceName)
@@ -119,7 +119,7 @@ func initTrunkHandler() (trunkhandler.TrunkHandler, error) {
 
 	dbBin := dbhandler.NewHandler(sqlBin, cache)
 
-	sockHandler := sockhandler.NewSockHandler(sock.TypeRabbitMQ, config.Get().RabbitMQAddress)
+	sockHandler := sockhandler.NewSockHandler(sock.Type(config.Get().SockType), config.Get().RabbitMQAddress)
 	sockHandler.Connect()
 
 	reqHandler := requesthandler.NewRequestHandler(sockHandler, serviceName)
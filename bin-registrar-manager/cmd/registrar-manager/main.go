@@ -14,6 +14,7 @@ import (
 	"monorepo/bin-common-handler/pkg/notifyhandler"
 	"monorepo/bin-common-handler/pkg/requesthandler"
 	"monorepo/bin-common-handler/pkg/sockhandler"
+	"monorepo/bin-common-handler/pkg/tracehandler"
 
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/pkg/errors"
@@ -76,6 +77,10 @@ func runDaemon() error {
 		"domain_name_trunk":         cfg.DomainNameTrunk,
 	}).Info("Starting registrar-manager...")
 
+	// init tracing
+	tracehandler.Init(serviceName)
+	defer tracehandler.Shutdown()
+
 	sqlDBBin, err := commondatabasehandler.Connect(config.Get().DatabaseDSNBin)
 	if err != nil {
 		return errors.Wrapf(err, "could not connect to the voipbin database")
@@ -139,7 +144,7 @@ func run(sqlAst *sql.DB, sqlBin *sql.DB, cache cachehandler.CacheHandler) error
 	dbAst := dbhandler.NewHandler(sqlAst, cache)
 	dbBin := dbhandler.NewHandler(sqlBin, cache)
 
-	sockHandler := sockhandler.NewSockHandler(sock.TypeRabbitMQ, config.Get().RabbitMQAddress)
+	sockHandler := sockhandler.NewSockHandler(sock.Type(config.Get().SockType), config.Get().RabbitMQAddress)
 	sockHandler.Connect()
 
 	// create handlers
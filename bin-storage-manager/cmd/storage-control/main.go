@@ -63,7 +63,7 @@ func initAccountHandler() (accounthandler.AccountHandler, error) {
 	}
 
 	dbHandler := dbhandler.NewHandler(db, cache)
-	sockHandler := sockhandler.NewSockHandler(sock.TypeRabbitMQ, config.Get().RabbitMQAddress)
+	sockHandler := sockhandler.NewSockHandler(sock.Type(config.Get().SockType), config.Get().RabbitMQAddress)
 	sockHandler.Connect()
 
 	reqHandler := requesthandler.NewRequestHandler(sockHandler, serviceName)
@@ -82,7 +82,7 @@ func initCache() (cachehandler.CacheHandler, error) {
 
 func initHandler(sqlDB *sql.DB, cache cachehandler.CacheHandler) (storagehandler.StorageHandler, error) {
 	db := dbhandler.NewHandler(sqlDB, cache)
-	sockHandler := sockhandler.NewSockHandler(sock.TypeRabbitMQ, config.Get().RabbitMQAddress)
+	sockHandler := sockhandler.NewSockHandler(sock.Type(config.Get().SockType), config.Get().RabbitMQAddress)
 	sockHandler.Connect()
 
 	reqHandler := requesthandler.NewRequestHandler(sockHandler, serviceName)
@@ -21,6 +21,7 @@ type Config struct {
 	PrometheusEndpoint      string
 	PrometheusListenAddress string
 	RabbitMQAddress         string
+	SockType                string
 	RedisAddress            string
 	RedisDatabase           int
 	RedisPassword           string
@@ -48,6 +49,7 @@ func bindConfig(cmd *cobra.Command) error {
 	f.String("prometheus_endpoint", "/metrics", "Prometheus metrics endpoint")
 	f.String("prometheus_listen_address", ":2112", "Prometheus listen address")
 	f.String("rabbitmq_address", "", "RabbitMQ server address")
+	f.String("sock_type", "rabbitMQ", "Message broker type. rabbitMQ or nats")
 	f.String("redis_address", "", "Redis server address")
 	f.String("redis_password", "", "Redis password")
 	f.Int("redis_database", 0, "Redis database index")
@@ -60,6 +62,7 @@ func bindConfig(cmd *cobra.Command) error {
 		"prometheus_endpoint":       "PROMETHEUS_ENDPOINT",
 		"prometheus_listen_address": "PROMETHEUS_LISTEN_ADDRESS",
 		"rabbitmq_address":          "RABBITMQ_ADDRESS",
+		"sock_type":                 "SOCK_TYPE",
 		"redis_address":             "REDIS_ADDRESS",
 		"redis_password":            "REDIS_PASSWORD",
 		"redis_database":            "REDIS_DATABASE",
@@ -95,6 +98,7 @@ func LoadGlobalConfig() {
 			PrometheusEndpoint:      viper.GetString("prometheus_endpoint"),
 			PrometheusListenAddress: viper.GetString("prometheus_listen_address"),
 			RabbitMQAddress:         viper.GetString("rabbitmq_address"),
+			SockType:                viper.GetString("sock_type"),
 			RedisAddress:            viper.GetString("redis_address"),
 			RedisDatabase:           viper.GetInt("redis_database"),
 			RedisPassword:           viper.GetString("redis_password"),
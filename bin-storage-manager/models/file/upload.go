@@ -0,0 +1,14 @@
+package file
+
+import "time"
+
+// UploadURI defines the signed uri for uploading a new file's content to the tmp bucket.
+// The uploaded object is registered with the file create request using the BucketName and Filepath.
+type UploadURI struct {
+	BucketName string `json:"bucket_name"`
+	Filepath   string `json:"filepath"`
+
+	URI string `json:"uri"` // signed uri. upload the content with the PUT request.
+
+	TMExpire *time.Time `json:"tm_expire"`
+}
@@ -171,3 +171,25 @@ func (h *fileHandler) bucketfileGenerateDownloadURI(bucketName string, filepath
 
 	return res, nil
 }
+
+// bucketfileGenerateUploadURI returns google cloud storage signed url for file upload.
+func (h *fileHandler) bucketfileGenerateUploadURI(bucketName string, filepath string, expire time.Time) (string, error) {
+	if len(h.privateKey) == 0 || h.accessID == "" {
+		return "", newErrSigningNotConfigured()
+	}
+
+	opts := &storage.SignedURLOptions{
+		Scheme:         storage.SigningSchemeV4,
+		Method:         "PUT",
+		GoogleAccessID: h.accessID,
+		PrivateKey:     h.privateKey,
+		Expires:        expire,
+	}
+
+	res, err := storage.SignedURL(bucketName, filepath, opts)
+	if err != nil {
+		return "", errors.Wrapf(err, "could not get the signed url. bucket_name: %s, filepath: %s", bucketName, filepath)
+	}
+
+	return res, nil
+}
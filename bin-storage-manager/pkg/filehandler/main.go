@@ -59,6 +59,7 @@ type FileHandler interface {
 	CompressCreate(ctx context.Context, files []*file.File) (string, string, error)
 	DownloadURIGet(ctx context.Context, bucketName string, filepath string, expire time.Duration) (string, string, error)
 	DownloadURIRefresh(ctx context.Context, id uuid.UUID) (string, error)
+	UploadURICreate(ctx context.Context) (*file.UploadURI, error)
 
 	IsExist(ctx context.Context, bucketName string, filepath string) bool
 
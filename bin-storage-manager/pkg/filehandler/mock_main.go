@@ -192,3 +192,18 @@ func (mr *MockFileHandlerMockRecorder) List(ctx, token, size, filters any) *gomo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockFileHandler)(nil).List), ctx, token, size, filters)
 }
+
+// UploadURICreate mocks base method.
+func (m *MockFileHandler) UploadURICreate(ctx context.Context) (*file.UploadURI, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadURICreate", ctx)
+	ret0, _ := ret[0].(*file.UploadURI)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadURICreate indicates an expected call of UploadURICreate.
+func (mr *MockFileHandlerMockRecorder) UploadURICreate(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadURICreate", reflect.TypeOf((*MockFileHandler)(nil).UploadURICreate), ctx)
+}
@@ -0,0 +1,43 @@
+package filehandler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"monorepo/bin-storage-manager/models/file"
+)
+
+const (
+	// uploadURLExpiration is the duration for GCS signed upload URLs.
+	uploadURLExpiration = time.Hour
+)
+
+// UploadURICreate returns a signed upload URL of a new object in the tmp bucket.
+// The uploaded object becomes a file with the Create.
+func (h *fileHandler) UploadURICreate(ctx context.Context) (*file.UploadURI, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func": "UploadURICreate",
+	})
+
+	filepath := fmt.Sprintf("%s/%s", bucketDirectoryTmp, h.utilHandler.UUIDCreate())
+	tmExpire := time.Now().UTC().Add(uploadURLExpiration)
+
+	uri, err := h.bucketfileGenerateUploadURI(h.bucketTmp, filepath, tmExpire)
+	if err != nil {
+		log.Errorf("Could not generate upload URI. err: %v", err)
+		return nil, err
+	}
+	log.Debugf("Generated upload URI. bucket_name: %s, filepath: %s", h.bucketTmp, filepath)
+
+	res := &file.UploadURI{
+		BucketName: h.bucketTmp,
+		Filepath:   filepath,
+		URI:        uri,
+		TMExpire:   h.utilHandler.TimeNowAdd(uploadURLExpiration),
+	}
+
+	return res, nil
+}
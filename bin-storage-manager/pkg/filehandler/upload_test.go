@@ -0,0 +1,100 @@
+package filehandler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"monorepo/bin-common-handler/pkg/utilhandler"
+
+	"github.com/gofrs/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func Test_UploadURICreate(t *testing.T) {
+
+	tmExpire := time.Date(2026, 10, 19, 11, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+
+		signingConfigured bool
+		bucketTmp         string
+
+		responseUUID uuid.UUID
+
+		expectErr bool
+	}{
+		{
+			name: "normal",
+
+			signingConfigured: true,
+			bucketTmp:         "test-bucket-tmp",
+
+			responseUUID: uuid.FromStringOrNil("8a0b6a1c-ad6e-11f0-8f1e-6f3d2b1c0a01"),
+		},
+		{
+			name: "no signing credential configured",
+
+			signingConfigured: false,
+			bucketTmp:         "test-bucket-tmp",
+
+			responseUUID: uuid.FromStringOrNil("8a0b6a1c-ad6e-11f0-8f1e-6f3d2b1c0a02"),
+
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockUtil := utilhandler.NewMockUtilHandler(mc)
+
+			accessID := ""
+			var privateKey []byte
+			if tt.signingConfigured {
+				accessID, privateKey = newTestSigningKey(t)
+			}
+
+			h := &fileHandler{
+				utilHandler: mockUtil,
+				bucketTmp:   tt.bucketTmp,
+
+				accessID:   accessID,
+				privateKey: privateKey,
+			}
+			ctx := context.Background()
+
+			mockUtil.EXPECT().UUIDCreate().Return(tt.responseUUID)
+			if !tt.expectErr {
+				mockUtil.EXPECT().TimeNowAdd(uploadURLExpiration).Return(&tmExpire)
+			}
+
+			res, err := h.UploadURICreate(ctx)
+			if tt.expectErr {
+				if err == nil {
+					t.Errorf("Wrong match. expect: error, got: ok")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			expectFilepath := fmt.Sprintf("%s/%s", bucketDirectoryTmp, tt.responseUUID)
+			if res.BucketName != tt.bucketTmp || res.Filepath != expectFilepath {
+				t.Errorf("Wrong match. expect: %s/%s, got: %s/%s", tt.bucketTmp, expectFilepath, res.BucketName, res.Filepath)
+			}
+			if !strings.Contains(res.URI, expectFilepath) {
+				t.Errorf("Wrong match. expect: signed uri of %s, got: %s", expectFilepath, res.URI)
+			}
+			if res.TMExpire != &tmExpire {
+				t.Errorf("Wrong match. expect: %v, got: %v", tmExpire, res.TMExpire)
+			}
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	cerrors "monorepo/bin-common-handler/models/errors"
 	"monorepo/bin-common-handler/models/sock"
 	"monorepo/bin-common-handler/pkg/sockhandler"
+	"monorepo/bin-common-handler/pkg/tracehandler"
 	"monorepo/bin-common-handler/pkg/utilhandler"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -52,6 +53,7 @@ var (
 	regV1Files                     = regexp.MustCompile("/v1/files$")
 	regV1FilesID                   = regexp.MustCompile("/v1/files/" + regUUID + "$")
 	regV1FilesIDDownloadURIRefresh = regexp.MustCompile("/v1/files/" + regUUID + "/download_uri_refresh$")
+	regV1FilesUploadURI            = regexp.MustCompile("/v1/files/upload_uri$")
 
 	// compress
 	regV1Compressfiles = regexp.MustCompile("/v1/compressfiles$")
@@ -156,7 +158,8 @@ func (h *listenHandler) processRequest(m *sock.Request) (*sock.Response, error)
 	var err error
 	var response *sock.Response
 
-	ctx := context.Background()
+	ctx, span := tracehandler.StartServerSpan(context.Background(), m)
+	defer span.End()
 
 	start := time.Now()
 	switch {
@@ -196,6 +199,10 @@ func (h *listenHandler) processRequest(m *sock.Request) (*sock.Response, error)
 		requestType = "/files"
 		response, err = h.v1FilesGet(ctx, m)
 
+	case regV1FilesUploadURI.MatchString(m.URI) && m.Method == sock.RequestMethodPost:
+		requestType = "/files/upload_uri"
+		response, err = h.v1FilesUploadURIPost(ctx, m)
+
 	case regV1FilesIDDownloadURIRefresh.MatchString(m.URI) && m.Method == sock.RequestMethodPost:
 		requestType = "/files/<file-id>/download_uri_refresh"
 		response, err = h.v1FilesIDDownloadURIRefresh(ctx, m)
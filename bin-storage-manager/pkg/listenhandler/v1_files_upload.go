@@ -0,0 +1,38 @@
+package listenhandler
+
+import (
+	"context"
+	"encoding/json"
+
+	"monorepo/bin-common-handler/models/sock"
+
+	"github.com/sirupsen/logrus"
+)
+
+// v1FilesUploadURIPost handles /v1/files/upload_uri POST request
+func (h *listenHandler) v1FilesUploadURIPost(ctx context.Context, m *sock.Request) (*sock.Response, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func":    "v1FilesUploadURIPost",
+		"request": m,
+	})
+
+	tmp, err := h.storageHandler.FileUploadURICreate(ctx)
+	if err != nil {
+		log.Errorf("Could not create upload URI. err: %v", err)
+		return nil, err
+	}
+
+	data, err := json.Marshal(tmp)
+	if err != nil {
+		log.Errorf("Could not marshal the res. err: %v", err)
+		return nil, err
+	}
+
+	res := &sock.Response{
+		StatusCode: 200,
+		DataType:   "application/json",
+		Data:       data,
+	}
+
+	return res, nil
+}
@@ -0,0 +1,74 @@
+package listenhandler
+
+import (
+	reflect "reflect"
+	"testing"
+	"time"
+
+	"monorepo/bin-common-handler/models/sock"
+	"monorepo/bin-common-handler/pkg/sockhandler"
+
+	gomock "go.uber.org/mock/gomock"
+
+	"monorepo/bin-storage-manager/models/file"
+	"monorepo/bin-storage-manager/pkg/storagehandler"
+)
+
+func Test_v1FilesUploadURIPost(t *testing.T) {
+
+	tmExpire := time.Date(2026, 10, 19, 11, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		request *sock.Request
+
+		responseUploadURI *file.UploadURI
+		expectRes         *sock.Response
+	}{
+		{
+			name: "normal",
+			request: &sock.Request{
+				URI:    "/v1/files/upload_uri",
+				Method: sock.RequestMethodPost,
+			},
+
+			responseUploadURI: &file.UploadURI{
+				BucketName: "test-bucket-tmp",
+				Filepath:   "tmp/3c1f5e6a-ad6e-11f0-8d0b-1b7c4f6a2e01",
+				URI:        "https://storage.googleapis.com/test-bucket-tmp/tmp/3c1f5e6a-ad6e-11f0-8d0b-1b7c4f6a2e01?X-Goog-Signature=test",
+				TMExpire:   &tmExpire,
+			},
+			expectRes: &sock.Response{
+				StatusCode: 200,
+				DataType:   "application/json",
+				Data:       []byte(`{"bucket_name":"test-bucket-tmp","filepath":"tmp/3c1f5e6a-ad6e-11f0-8d0b-1b7c4f6a2e01","uri":"https://storage.googleapis.com/test-bucket-tmp/tmp/3c1f5e6a-ad6e-11f0-8d0b-1b7c4f6a2e01?X-Goog-Signature=test","tm_expire":"2026-10-19T11:00:00Z"}`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			defer mc.Finish()
+
+			mockSock := sockhandler.NewMockSockHandler(mc)
+			mockStorage := storagehandler.NewMockStorageHandler(mc)
+
+			h := &listenHandler{
+				sockHandler:    mockSock,
+				storageHandler: mockStorage,
+			}
+
+			mockStorage.EXPECT().FileUploadURICreate(gomock.Any()).Return(tt.responseUploadURI, nil)
+
+			res, err := h.processRequest(tt.request)
+			if err != nil {
+				t.Errorf("Wrong match. expect: ok, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, tt.expectRes) {
+				t.Errorf("Wrong match.\nexpect: %v\ngot: %v", tt.expectRes, res)
+			}
+		})
+	}
+}
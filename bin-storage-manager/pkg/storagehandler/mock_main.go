@@ -134,6 +134,21 @@ func (mr *MockStorageHandlerMockRecorder) FileList(ctx, token, size, filters any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FileList", reflect.TypeOf((*MockStorageHandler)(nil).FileList), ctx, token, size, filters)
 }
 
+// FileUploadURICreate mocks base method.
+func (m *MockStorageHandler) FileUploadURICreate(ctx context.Context) (*file.UploadURI, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FileUploadURICreate", ctx)
+	ret0, _ := ret[0].(*file.UploadURI)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FileUploadURICreate indicates an expected call of FileUploadURICreate.
+func (mr *MockStorageHandlerMockRecorder) FileUploadURICreate(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FileUploadURICreate", reflect.TypeOf((*MockStorageHandler)(nil).FileUploadURICreate), ctx)
+}
+
 // RecordingDelete mocks base method.
 func (m *MockStorageHandler) RecordingDelete(ctx context.Context, id uuid.UUID) error {
 	m.ctrl.T.Helper()
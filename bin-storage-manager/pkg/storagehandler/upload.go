@@ -0,0 +1,24 @@
+package storagehandler
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+
+	"monorepo/bin-storage-manager/models/file"
+)
+
+// FileUploadURICreate returns a signed upload URL of a new file's content.
+func (h *storageHandler) FileUploadURICreate(ctx context.Context) (*file.UploadURI, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"func": "FileUploadURICreate",
+	})
+
+	res, err := h.fileHandler.UploadURICreate(ctx)
+	if err != nil {
+		log.Errorf("Could not create upload URI. err: %v", err)
+		return nil, err
+	}
+
+	return res, nil
+}
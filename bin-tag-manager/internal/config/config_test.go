@@ -110,6 +110,7 @@ func TestInitConfig(t *testing.T) {
 			cmd.Flags().String("prometheus_endpoint", tt.prometheusEndpoint, "")
 			cmd.Flags().String("prometheus_listen_address", tt.prometheusListenAddress, "")
 			cmd.Flags().String("rabbitmq_address", tt.rabbitmqAddress, "")
+			cmd.Flags().String("sock_type", "rabbitMQ", "")
 			cmd.Flags().String("redis_address", tt.redisAddress, "")
 			cmd.Flags().String("redis_password", tt.redisPassword, "")
 			cmd.Flags().Int("redis_database", tt.redisDatabase, "")
@@ -43,7 +43,7 @@ func initHandlers() (chathandler.ChatHandler, messagehandler.MessageHandler, par
 		return nil, nil, nil, nil, errors.Wrap(err, "could not connect to the database")
 	}
 
-	sockHandler := sockhandler.NewSockHandler(sock.TypeRabbitMQ, config.Get().RabbitMQAddress)
+	sockHandler := sockhandler.NewSockHandler(sock.Type(config.Get().SockType), config.Get().RabbitMQAddress)
 	sockHandler.Connect()
 
 	redisClient := redis.NewClient(&redis.Options{
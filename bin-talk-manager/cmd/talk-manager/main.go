@@ -16,6 +16,7 @@ import (
 	commonnotify "monorepo/bin-common-handler/pkg/notifyhandler"
 	commonreq "monorepo/bin-common-handler/pkg/requesthandler"
 	commonsock "monorepo/bin-common-handler/pkg/sockhandler"
+	"monorepo/bin-common-handler/pkg/tracehandler"
 	commonutil "monorepo/bin-common-handler/pkg/utilhandler"
 	commonoutline "monorepo/bin-common-handler/models/outline"
 	"monorepo/bin-common-handler/models/sock"
@@ -64,6 +65,10 @@ func runDaemon() error {
 	cfg := config.Get()
 	logger.WithField("config", cfg).Info("Starting talk-manager...")
 
+	// init tracing
+	tracehandler.Init(commonoutline.ServiceNameTalkManager)
+	defer tracehandler.Shutdown()
+
 	// Initialize database
 	db, err := commondb.Connect(cfg.DatabaseDSN)
 	if err != nil {
@@ -72,7 +77,7 @@ func runDaemon() error {
 	defer commondb.Close(db)
 
 	// Initialize RabbitMQ
-	sockHandler := commonsock.NewSockHandler(sock.TypeRabbitMQ, cfg.RabbitMQAddress)
+	sockHandler := commonsock.NewSockHandler(sock.Type(cfg.SockType), cfg.RabbitMQAddress)
 	sockHandler.Connect()
 	defer sockHandler.Close()
 	logger.Info("RabbitMQ initialized")
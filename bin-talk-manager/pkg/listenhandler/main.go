@@ -21,6 +21,7 @@ import (
 	commonsock "monorepo/bin-common-handler/models/sock"
 	commonsockhandler "monorepo/bin-common-handler/pkg/sockhandler"
 	commonutil "monorepo/bin-common-handler/pkg/utilhandler"
+	"monorepo/bin-common-handler/pkg/tracehandler"
 )
 
 // Regex patterns for URI matching (allow optional query parameters)
@@ -85,7 +86,8 @@ func (h *listenHandler) Listen(ctx context.Context) error {
 
 // processRequest routes incoming requests to appropriate handlers
 func (h *listenHandler) processRequest(m *commonsock.Request) (*commonsock.Response, error) {
-	ctx := context.Background()
+	ctx, span := tracehandler.StartServerSpan(context.Background(), m)
+	defer span.End()
 	logrus.Debugf("Received request: %s %s", m.Method, m.URI)
 
 	var response *commonsock.Response
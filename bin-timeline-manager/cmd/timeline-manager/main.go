@@ -24,6 +24,7 @@ import (
 	commondatabasehandler "monorepo/bin-common-handler/pkg/databasehandler"
 	"monorepo/bin-common-handler/pkg/requesthandler"
 	"monorepo/bin-common-handler/pkg/sockhandler"
+	"monorepo/bin-common-handler/pkg/tracehandler"
 
 	"monorepo/bin-timeline-manager/internal/config"
 	"monorepo/bin-timeline-manager/pkg/analysisdbhandler"
@@ -70,6 +71,10 @@ func runDaemon() error {
 	log := logrus.WithField("func", "runDaemon")
 	log.WithField("config", config.Get()).Info("Starting timeline-manager...")
 
+	// init tracing
+	tracehandler.Init(commonoutline.ServiceNameTimelineManager)
+	defer tracehandler.Shutdown()
+
 	// Run database migrations before starting services
 	if errMigrate := runMigrations(); errMigrate != nil {
 		return errors.Wrapf(errMigrate, "could not run migrations")
@@ -163,7 +168,7 @@ func initProm(endpoint, listen string) {
 func runServices(ctx context.Context) (<-chan struct{}, error) {
 	db := dbhandler.NewHandler(config.Get().ClickHouseAddress, config.Get().ClickHouseDatabase)
 
-	sockHandler := sockhandler.NewSockHandler(sock.TypeRabbitMQ, config.Get().RabbitMQAddress)
+	sockHandler := sockhandler.NewSockHandler(sock.Type(config.Get().SockType), config.Get().RabbitMQAddress)
 	sockHandler.Connect()
 
 	evtHandler := eventhandler.NewEventHandler(db)
@@ -11,6 +11,7 @@ import (
 	"monorepo/bin-call-manager/models/common"
 	cmconfbridge "monorepo/bin-call-manager/models/confbridge"
 	"monorepo/bin-common-handler/pkg/sockhandler"
+	"monorepo/bin-common-handler/pkg/tracehandler"
 
 	commonoutline "monorepo/bin-common-handler/models/outline"
 	"monorepo/bin-common-handler/models/sock"
@@ -148,7 +149,8 @@ func (h *subscribeHandler) processEvent(m *sock.Event) error {
 		"func":  "processEvent",
 		"event": m,
 	})
-	ctx := context.Background()
+	ctx, span := tracehandler.StartConsumerSpan(context.Background(), m)
+	defer span.End()
 
 	var err error
 	start := time.Now()
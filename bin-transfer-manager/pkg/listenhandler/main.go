@@ -11,6 +11,7 @@ import (
 	cerrors "monorepo/bin-common-handler/models/errors"
 	"monorepo/bin-common-handler/models/sock"
 	"monorepo/bin-common-handler/pkg/sockhandler"
+	"monorepo/bin-common-handler/pkg/tracehandler"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
@@ -152,7 +153,8 @@ func (h *listenHandler) processRequest(m *sock.Request) (*sock.Response, error)
 	var requestType string
 	var err error
 	var response *sock.Response
-	ctx := context.Background()
+	ctx, span := tracehandler.StartServerSpan(context.Background(), m)
+	defer span.End()
 
 	log.Debugf("Received request. method: %s, uri: %s", m.Method, m.URI)
 
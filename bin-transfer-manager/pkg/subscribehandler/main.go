@@ -12,6 +12,7 @@ import (
 
 	"monorepo/bin-common-handler/models/sock"
 	"monorepo/bin-common-handler/pkg/sockhandler"
+	"monorepo/bin-common-handler/pkg/tracehandler"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
@@ -128,7 +129,8 @@ func (h *subscribeHandler) processEvent(m *sock.Event) {
 
 	var err error
 	start := time.Now()
-	ctx := context.Background()
+	ctx, span := tracehandler.StartConsumerSpan(context.Background(), m)
+	defer span.End()
 	switch {
 
 	//// call-manager
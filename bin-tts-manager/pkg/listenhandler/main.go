@@ -11,6 +11,7 @@ import (
 	cerrors "monorepo/bin-common-handler/models/errors"
 	"monorepo/bin-common-handler/models/sock"
 	"monorepo/bin-common-handler/pkg/sockhandler"
+	"monorepo/bin-common-handler/pkg/tracehandler"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
@@ -165,7 +166,8 @@ func (h *listenHandler) processRequest(m *sock.Request) (*sock.Response, error)
 
 	log.Debugf("Received request. uri: %s, method: %s", m.URI, m.Method)
 
-	ctx := context.Background()
+	ctx, span := tracehandler.StartServerSpan(context.Background(), m)
+	defer span.End()
 	start := time.Now()
 	switch {
 
@@ -12,6 +12,7 @@ import (
 	cerrors "monorepo/bin-common-handler/models/errors"
 	"monorepo/bin-common-handler/models/sock"
 	"monorepo/bin-common-handler/pkg/sockhandler"
+	"monorepo/bin-common-handler/pkg/tracehandler"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
@@ -151,7 +152,8 @@ func (h *listenHandler) processRequest(m *sock.Request) (*sock.Response, error)
 	})
 
 	start := time.Now()
-	ctx := context.Background()
+	ctx, span := tracehandler.StartServerSpan(context.Background(), m)
+	defer span.End()
 
 	switch {
 	/////////////////////////////////////////////////////////////////////////////////////////////////
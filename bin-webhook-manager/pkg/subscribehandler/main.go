@@ -10,6 +10,7 @@ import (
 
 	"monorepo/bin-common-handler/models/sock"
 	"monorepo/bin-common-handler/pkg/sockhandler"
+	"monorepo/bin-common-handler/pkg/tracehandler"
 
 	cscustomer "monorepo/bin-customer-manager/models/customer"
 	fmactiveflow "monorepo/bin-flow-manager/models/activeflow"
@@ -132,6 +133,9 @@ func (h *subscribeHandler) processEvent(m *sock.Event) {
 	)
 	log.Debugf("Received subscribed event. publisher: %s, type: %s", m.Publisher, m.Type)
 
+	_, span := tracehandler.StartConsumerSpan(context.Background(), m)
+	defer span.End()
+
 	var err error
 	start := time.Now()
 	switch {
@@ -11,6 +11,7 @@ import (
 	"monorepo/bin-common-handler/pkg/notifyhandler"
 	"monorepo/bin-common-handler/pkg/requesthandler"
 	"monorepo/bin-common-handler/pkg/sockhandler"
+	"monorepo/bin-common-handler/pkg/tracehandler"
 
 	"github.com/go-redis/redis/v8"
 	"github.com/ivahaev/amigo"
@@ -42,6 +43,7 @@ var (
 	interfaceName = ""
 
 	rabbitMQAddress     = ""
+	sockType            = ""
 	rabbitMQQueueListen = ""
 
 	redisAddress  = ""
@@ -68,9 +70,13 @@ var chSigs = make(chan os.Signal, 1)
 func main() {
 	log := logrus.WithField("func", "main")
 
+	// init tracing
+	tracehandler.Init(serviceName)
+	defer tracehandler.Shutdown()
+
 	// connect to rabbitmq
 	log.Debugf("rabbitmq address: %s", rabbitMQAddress)
-	sockHandler := sockhandler.NewSockHandler(sock.TypeRabbitMQ, rabbitMQAddress)
+	sockHandler := sockhandler.NewSockHandler(sock.Type(sockType), rabbitMQAddress)
 	sockHandler.Connect()
 
 	// connect to ami
@@ -16,6 +16,7 @@ import (
 
 var (
 	rabbitMQAddress     = ""
+	sockType            = ""
 	rabbitMQQueueListen = ""
 
 	interfaceName = ""
@@ -42,7 +43,7 @@ func main() {
 	log.Debugf("Volatile listen queue name: %s", rabbitQueueListenVolatile)
 
 	log.Debugf("Connecting to RabbitMQ. address: %s", rabbitMQAddress)
-	sockHandler := sockhandler.NewSockHandler(sock.TypeRabbitMQ, rabbitMQAddress)
+	sockHandler := sockhandler.NewSockHandler(sock.Type(sockType), rabbitMQAddress)
 	sockHandler.Connect()
 
 	listenHandler := listenhandler.NewListenHandler(
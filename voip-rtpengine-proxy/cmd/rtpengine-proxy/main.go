@@ -26,6 +26,7 @@ var (
 	rtpengineNGTimeout = ""
 
 	rabbitMQAddress     = ""
+	sockType            = ""
 	rabbitMQQueueListen = ""
 
 	redisAddress  = ""
@@ -61,7 +62,7 @@ func main() {
 	volatileQueue := fmt.Sprintf("rtpengine.%s.request", proxyID)
 	log.Infof("Permanent queue: %s, Volatile queue: %s", permanentQueue, volatileQueue)
 
-	sockHandler := sockhandler.NewSockHandler(sock.TypeRabbitMQ, rabbitMQAddress)
+	sockHandler := sockhandler.NewSockHandler(sock.Type(sockType), rabbitMQAddress)
 	sockHandler.Connect()
 
 	ngTimeout, err := time.ParseDuration(rtpengineNGTimeout)